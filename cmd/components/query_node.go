@@ -21,6 +21,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/querynode"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 
 	grpcquerynode "github.com/milvus-io/milvus/internal/distributed/querynode"
@@ -67,3 +68,8 @@ func (q *QueryNode) Stop() error {
 func (q *QueryNode) GetComponentStates(ctx context.Context, request *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
 	return q.svr.GetComponentStates(ctx, request)
 }
+
+// GetDrainState returns QueryNode's drain state
+func (q *QueryNode) GetDrainState() querynode.DrainState {
+	return q.svr.GetDrainState()
+}
@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roles
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/querynode"
+	"github.com/milvus-io/milvus/internal/util/healthz"
+)
+
+// DrainInterface defines the interface of the component which could be drained for graceful scale-in.
+type DrainInterface interface {
+	// GetDrainState returns the drain state of the component.
+	GetDrainState() querynode.DrainState
+}
+
+// queryNodeDrainHandler reports whether the query node is safe to terminate by GET. It's read only, the drain is
+// started by the Drain RPC of the query node.
+type queryNodeDrainHandler struct {
+	node DrainInterface
+}
+
+func (handler *queryNodeDrainHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeDrainResponse(w, http.StatusMethodNotAllowed, fmt.Sprintf("method %s is not allowed", r.Method))
+		return
+	}
+	state := handler.node.GetDrainState()
+	if state != querynode.DrainStateSafeToTerminate {
+		writeDrainResponse(w, http.StatusServiceUnavailable, state.String())
+		return
+	}
+	writeDrainResponse(w, http.StatusOK, state.String())
+}
+
+func writeDrainResponse(w http.ResponseWriter, code int, message string) {
+	w.Header().Set(healthz.ContentTypeHeader, healthz.ContentTypeText)
+	w.WriteHeader(code)
+	_, err := fmt.Fprint(w, message)
+	if err != nil {
+		log.Warn("failed to send response",
+			zap.Error(err))
+	}
+}
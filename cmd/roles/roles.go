@@ -46,6 +46,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoord"
 	"github.com/milvus-io/milvus/internal/querynode"
 	"github.com/milvus-io/milvus/internal/rootcoord"
+	"github.com/milvus-io/milvus/internal/util/debugserver"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/healthz"
 	"github.com/milvus-io/milvus/internal/util/logutil"
@@ -204,6 +205,7 @@ func (mr *MilvusRoles) runQueryNode(ctx context.Context, localMsg bool, alias st
 		}
 		if !localMsg {
 			http.Handle(healthz.HealthzRouterPath, &componentsHealthzHandler{component: qn})
+			http.Handle(healthz.DrainRouterPath, &queryNodeDrainHandler{node: qn})
 		}
 		wg.Done()
 		_ = qn.Run()
@@ -344,6 +346,36 @@ func (mr *MilvusRoles) runIndexNode(ctx context.Context, localMsg bool, alias st
 	return in
 }
 
+// debugServerName returns the name of the process in the names of the dumps
+func (mr *MilvusRoles) debugServerName(local bool) string {
+	if local {
+		return typeutil.StandaloneRole
+	}
+	roles := []struct {
+		enabled bool
+		name    string
+	}{
+		{mr.EnableRootCoord, typeutil.RootCoordRole},
+		{mr.EnableProxy, typeutil.ProxyRole},
+		{mr.EnableQueryCoord, typeutil.QueryCoordRole},
+		{mr.EnableQueryNode, typeutil.QueryNodeRole},
+		{mr.EnableDataCoord, typeutil.DataCoordRole},
+		{mr.EnableDataNode, typeutil.DataNodeRole},
+		{mr.EnableIndexCoord, typeutil.IndexCoordRole},
+		{mr.EnableIndexNode, typeutil.IndexNodeRole},
+	}
+	var names []string
+	for _, role := range roles {
+		if role.enabled {
+			names = append(names, role.name)
+		}
+	}
+	if len(names) == 0 {
+		return "milvus"
+	}
+	return strings.Join(names, "-")
+}
+
 // Run Milvus components.
 func (mr *MilvusRoles) Run(local bool, alias string) {
 	log.Info("starting running Milvus components")
@@ -374,6 +406,7 @@ func (mr *MilvusRoles) Run(local bool, alias string) {
 		if err := os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.ClusterDeployMode); err != nil {
 			log.Error("Failed to set deploy mode: ", zap.Error(err))
 		}
+		Params.Init()
 	}
 
 	if os.Getenv(metricsinfo.DeployModeEnvKey) == metricsinfo.StandaloneDeployMode {
@@ -494,6 +527,12 @@ func (mr *MilvusRoles) Run(local bool, alias string) {
 	}
 
 	metrics.ServeHTTP(Registry)
+	if Params.CommonCfg.DebugServerEnabled {
+		ds := debugserver.NewServer(mr.debugServerName(local), fmt.Sprintf(":%d", Params.CommonCfg.DebugServerPort),
+			Params.CommonCfg.DebugServerDumpDir)
+		ds.Start()
+		defer ds.Stop()
+	}
 	sc := make(chan os.Signal, 1)
 	signal.Notify(sc,
 		syscall.SIGHUP,
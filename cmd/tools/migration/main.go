@@ -0,0 +1,108 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// migration re-publishes the messages retained in the rocksmq of a standalone deployment into pulsar or kafka,
+// and rewrites the message positions saved in meta accordingly, so that the unflushed data is kept when
+// upgrading to the cluster mode. Milvus must be stopped during the migration, and started with the target mq after.
+package main
+
+import (
+	"context"
+	"flag"
+	"sync/atomic"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"go.uber.org/zap"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/mqimpl/rocksmq/server"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	kafkawrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/kafka"
+	pulsarwrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/pulsar"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+)
+
+var (
+	etcdAddr     = flag.String("etcd", "127.0.0.1:2379", "Etcd Endpoint to connect")
+	metaRootPath = flag.String("metaRootPath", "by-dev/meta", "Meta root path of milvus in etcd")
+	rocksmqPath  = flag.String("rocksmqPath", "/var/lib/milvus/rdb_data", "Path of the rocksmq data to migrate")
+
+	target        = flag.String("target", "pulsar", "Target mq to migrate to, pulsar or kafka")
+	pulsarAddress = flag.String("pulsarAddress", "pulsar://localhost:6650", "Address of pulsar")
+	kafkaBrokers  = flag.String("kafkaBrokers", "localhost:9092", "Broker list of kafka")
+
+	topicPrefix = flag.String("topicPrefix", "", "Only migrate the topics with the prefix")
+	dryRun      = flag.Bool("dryRun", false, "Only print what would be migrated")
+)
+
+func newTargetClient() mqwrapper.Client {
+	switch *target {
+	case "pulsar":
+		client, err := pulsarwrapper.NewClient(pulsar.ClientOptions{URL: *pulsarAddress})
+		if err != nil || client == nil {
+			log.Fatal("failed to create pulsar client", zap.String("address", *pulsarAddress), zap.Error(err))
+		}
+		return client
+	case "kafka":
+		return kafkawrapper.NewKafkaClientInstance(*kafkaBrokers)
+	default:
+		log.Fatal("unknown target mq", zap.String("target", *target))
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	ctx := context.Background()
+
+	etcdCli, err := etcd.GetRemoteEtcdClient([]string{*etcdAddr})
+	if err != nil {
+		log.Fatal("failed to connect to etcd", zap.Error(err))
+	}
+	metaKV := etcdkv.NewEtcdKV(etcdCli, *metaRootPath)
+	defer metaKV.Close()
+	if err = checkNotMigrated(metaKV); err != nil {
+		log.Fatal("migration is not allowed", zap.Error(err))
+	}
+
+	// retention must not purge messages during the migration
+	atomic.StoreInt64(&server.RocksmqRetentionTimeInSecs, -1)
+	atomic.StoreInt64(&server.RocksmqRetentionSizeInMB, -1)
+	rmq, err := server.NewRocksMQ(*rocksmqPath, nil)
+	if err != nil {
+		log.Fatal("failed to open rocksmq", zap.String("path", *rocksmqPath), zap.Error(err))
+	}
+	defer rmq.Close()
+
+	client := newTargetClient()
+	defer client.Close()
+
+	m := &migrator{
+		source:      rmq,
+		target:      client,
+		topicPrefix: *topicPrefix,
+		dryRun:      *dryRun,
+	}
+	pm, err := m.migrate(ctx)
+	if err != nil {
+		log.Fatal("failed to migrate rocksmq", zap.Error(err))
+	}
+	if err = rewritePositions(metaKV, pm, *dryRun); err != nil {
+		log.Fatal("failed to rewrite message positions", zap.Error(err))
+	}
+	log.Info("migration done", zap.String("target", *target), zap.Bool("dryRun", *dryRun))
+}
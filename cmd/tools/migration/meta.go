@@ -0,0 +1,196 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+)
+
+const (
+	// metaMigrationDoneKey is saved after the positions are rewritten, so that the migration won't be applied twice
+	metaMigrationDoneKey = "mq-migration/done"
+
+	// maxTxnNum is the max number of keys saved in one etcd txn
+	maxTxnNum = 128
+)
+
+// positionMeta describes a kind of meta containing message positions
+type positionMeta struct {
+	prefix string
+	// rewrite maps the positions of the value in place, returns whether the value changed
+	rewrite func(pm *positionMapper, value []byte) ([]byte, bool, error)
+}
+
+// positionMetas are the metas containing message positions, the prefixes are relative to the meta root path
+var positionMetas = []positionMeta{
+	{prefix: "datacoord-meta/s/", rewrite: rewriteSegmentInfo},
+	{prefix: "channelwatch/", rewrite: rewriteChannelWatchInfo},
+	{prefix: "queryCoord-deltaChannel/", rewrite: rewriteVchannelInfo},
+	{prefix: "root-coord/collection/", rewrite: rewriteCollectionInfo},
+}
+
+func mapSegmentInfo(pm *positionMapper, info *datapb.SegmentInfo) bool {
+	if info == nil {
+		return false
+	}
+	changed := pm.mapPosition(info.GetStartPosition())
+	changed = pm.mapPosition(info.GetDmlPosition()) || changed
+	return changed
+}
+
+func mapVchannelInfo(pm *positionMapper, info *datapb.VchannelInfo) bool {
+	if info == nil {
+		return false
+	}
+	changed := pm.mapPosition(info.GetSeekPosition())
+	for _, segments := range [][]*datapb.SegmentInfo{info.GetUnflushedSegments(), info.GetFlushedSegments(), info.GetDroppedSegments()} {
+		for _, segment := range segments {
+			changed = mapSegmentInfo(pm, segment) || changed
+		}
+	}
+	return changed
+}
+
+func rewriteSegmentInfo(pm *positionMapper, value []byte) ([]byte, bool, error) {
+	info := &datapb.SegmentInfo{}
+	if err := proto.Unmarshal(value, info); err != nil {
+		return nil, false, err
+	}
+	if !mapSegmentInfo(pm, info) {
+		return nil, false, nil
+	}
+	ret, err := proto.Marshal(info)
+	return ret, true, err
+}
+
+func rewriteChannelWatchInfo(pm *positionMapper, value []byte) ([]byte, bool, error) {
+	info := &datapb.ChannelWatchInfo{}
+	if err := proto.Unmarshal(value, info); err != nil {
+		return nil, false, err
+	}
+	if !mapVchannelInfo(pm, info.GetVchan()) {
+		return nil, false, nil
+	}
+	ret, err := proto.Marshal(info)
+	return ret, true, err
+}
+
+func rewriteVchannelInfo(pm *positionMapper, value []byte) ([]byte, bool, error) {
+	info := &datapb.VchannelInfo{}
+	if err := proto.Unmarshal(value, info); err != nil {
+		return nil, false, err
+	}
+	if !mapVchannelInfo(pm, info) {
+		return nil, false, nil
+	}
+	ret, err := proto.Marshal(info)
+	return ret, true, err
+}
+
+// rewriteCollectionInfo maps the start positions of the physical channels of a collection
+func rewriteCollectionInfo(pm *positionMapper, value []byte) ([]byte, bool, error) {
+	info := &etcdpb.CollectionInfo{}
+	if err := proto.Unmarshal(value, info); err != nil {
+		return nil, false, err
+	}
+	changed := false
+	for _, pos := range info.GetStartPositions() {
+		if newID, ok := pm.mapMsgID(pos.GetKey(), pos.GetData()); ok {
+			pos.Data = newID
+			changed = true
+		}
+	}
+	if !changed {
+		return nil, false, nil
+	}
+	ret, err := proto.Marshal(info)
+	return ret, true, err
+}
+
+// checkNotMigrated returns error if the migration has been applied to the meta
+func checkNotMigrated(metaKV kv.BaseKV) error {
+	done, err := metaKV.Load(metaMigrationDoneKey)
+	if err == nil && done != "" {
+		return fmt.Errorf("rocksmq has been migrated at %s", done)
+	}
+	return nil
+}
+
+// rewritePositions rewrites the message positions of all the metas in metaKV,
+// nothing is saved if dryRun is set.
+func rewritePositions(metaKV kv.BaseKV, pm *positionMapper, dryRun bool) error {
+	for _, meta := range positionMetas {
+		keys, values, err := metaKV.LoadWithPrefix(meta.prefix)
+		if err != nil {
+			return fmt.Errorf("failed to load meta with prefix %s, %w", meta.prefix, err)
+		}
+		kvs := make(map[string]string)
+		for i := range keys {
+			newValue, changed, err := meta.rewrite(pm, []byte(values[i]))
+			if err != nil {
+				// tombstones and other values not containing positions
+				log.Warn("skip invalid meta", zap.String("key", keys[i]), zap.Error(err))
+				continue
+			}
+			if changed {
+				kvs[keys[i]] = string(newValue)
+			}
+		}
+		log.Info("rewrite message positions of meta",
+			zap.String("prefix", meta.prefix),
+			zap.Int("numKeys", len(keys)),
+			zap.Int("numChanged", len(kvs)),
+			zap.Bool("dryRun", dryRun))
+		if dryRun || len(kvs) == 0 {
+			continue
+		}
+		if err = multiSaveInBatch(metaKV, kvs); err != nil {
+			return fmt.Errorf("failed to save meta with prefix %s, %w", meta.prefix, err)
+		}
+	}
+	log.Info("rewrite message positions done", zap.Int("mapped", pm.mapped), zap.Int("missed", pm.missed))
+	if dryRun {
+		return nil
+	}
+	return metaKV.Save(metaMigrationDoneKey, time.Now().Format(time.RFC3339))
+}
+
+func multiSaveInBatch(metaKV kv.BaseKV, kvs map[string]string) error {
+	batch := make(map[string]string)
+	for k, v := range kvs {
+		batch[k] = v
+		if len(batch) >= maxTxnNum {
+			if err := metaKV.MultiSave(batch); err != nil {
+				return err
+			}
+			batch = make(map[string]string)
+		}
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+	return metaKV.MultiSave(batch)
+}
@@ -0,0 +1,147 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/rmq"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+const (
+	testPChannel = "by-dev-rootcoord-dml_0"
+	testVChannel = "by-dev-rootcoord-dml_0_100v0"
+)
+
+func genTestPositionMapper() *positionMapper {
+	pm := newPositionMapper()
+	mapping := &topicMapping{earliestID: []byte("earliest")}
+	mapping.append(10, []byte("new-10"))
+	mapping.append(20, []byte("new-20"))
+	mapping.append(30, []byte("new-30"))
+	pm.addTopic(testPChannel, mapping)
+	pm.addTopic("empty-topic", &topicMapping{earliestID: []byte("earliest")})
+	return pm
+}
+
+func TestTopicMapping(t *testing.T) {
+	pm := genTestPositionMapper()
+	mapping := pm.topics[testPChannel]
+
+	assert.Equal(t, []byte("new-20"), mapping.get(20))
+	// purged by retention, mapped to the next message
+	assert.Equal(t, []byte("new-10"), mapping.get(5))
+	assert.Equal(t, []byte("new-30"), mapping.get(25))
+	// after the last message
+	assert.Equal(t, []byte("new-30"), mapping.get(35))
+	assert.Equal(t, []byte("earliest"), pm.topics["empty-topic"].get(10))
+}
+
+func TestPositionMapper(t *testing.T) {
+	pm := genTestPositionMapper()
+
+	pos := &internalpb.MsgPosition{ChannelName: testVChannel, MsgID: rmq.SerializeRmqID(20)}
+	assert.True(t, pm.mapPosition(pos))
+	assert.Equal(t, []byte("new-20"), pos.MsgID)
+
+	// not a rocksmq id
+	assert.False(t, pm.mapPosition(pos))
+
+	pos = &internalpb.MsgPosition{ChannelName: "unknown_1v0", MsgID: rmq.SerializeRmqID(20)}
+	assert.False(t, pm.mapPosition(pos))
+	assert.False(t, pm.mapPosition(nil))
+	assert.Equal(t, 1, pm.mapped)
+	assert.Equal(t, 1, pm.missed)
+}
+
+func TestRewritePositions(t *testing.T) {
+	metaKV := memkv.NewMemoryKV()
+	segment := &datapb.SegmentInfo{
+		ID:            1,
+		InsertChannel: testVChannel,
+		StartPosition: &internalpb.MsgPosition{ChannelName: testVChannel, MsgID: rmq.SerializeRmqID(10)},
+		DmlPosition:   &internalpb.MsgPosition{ChannelName: testVChannel, MsgID: rmq.SerializeRmqID(30)},
+	}
+	value, err := proto.Marshal(segment)
+	assert.NoError(t, err)
+	assert.NoError(t, metaKV.Save("datacoord-meta/s/100/1/1", string(value)))
+
+	vchan := &datapb.VchannelInfo{
+		ChannelName:       testVChannel,
+		SeekPosition:      &internalpb.MsgPosition{ChannelName: testVChannel, MsgID: rmq.SerializeRmqID(20)},
+		UnflushedSegments: []*datapb.SegmentInfo{segment},
+	}
+	value, err = proto.Marshal(&datapb.ChannelWatchInfo{Vchan: vchan})
+	assert.NoError(t, err)
+	assert.NoError(t, metaKV.Save("channelwatch/1/"+testVChannel, string(value)))
+
+	collection := &etcdpb.CollectionInfo{
+		ID:             100,
+		StartPositions: []*commonpb.KeyDataPair{{Key: testPChannel, Data: rmq.SerializeRmqID(10)}},
+	}
+	value, err = proto.Marshal(collection)
+	assert.NoError(t, err)
+	assert.NoError(t, metaKV.Save("root-coord/collection/100", string(value)))
+	// tombstone is skipped
+	assert.NoError(t, metaKV.Save("root-coord/collection/101", string([]byte{0xE2, 0x9B, 0xBC})))
+
+	t.Run("test dry run", func(t *testing.T) {
+		err := rewritePositions(metaKV, genTestPositionMapper(), true)
+		assert.NoError(t, err)
+		assert.NoError(t, checkNotMigrated(metaKV))
+
+		value, err := metaKV.Load("datacoord-meta/s/100/1/1")
+		assert.NoError(t, err)
+		info := &datapb.SegmentInfo{}
+		assert.NoError(t, proto.Unmarshal([]byte(value), info))
+		assert.Equal(t, rmq.SerializeRmqID(10), info.GetStartPosition().GetMsgID())
+	})
+
+	t.Run("test rewrite", func(t *testing.T) {
+		err := rewritePositions(metaKV, genTestPositionMapper(), false)
+		assert.NoError(t, err)
+		assert.Error(t, checkNotMigrated(metaKV))
+
+		value, err := metaKV.Load("datacoord-meta/s/100/1/1")
+		assert.NoError(t, err)
+		info := &datapb.SegmentInfo{}
+		assert.NoError(t, proto.Unmarshal([]byte(value), info))
+		assert.Equal(t, []byte("new-10"), info.GetStartPosition().GetMsgID())
+		assert.Equal(t, []byte("new-30"), info.GetDmlPosition().GetMsgID())
+
+		value, err = metaKV.Load("channelwatch/1/" + testVChannel)
+		assert.NoError(t, err)
+		watchInfo := &datapb.ChannelWatchInfo{}
+		assert.NoError(t, proto.Unmarshal([]byte(value), watchInfo))
+		assert.Equal(t, []byte("new-20"), watchInfo.GetVchan().GetSeekPosition().GetMsgID())
+		assert.Equal(t, []byte("new-10"), watchInfo.GetVchan().GetUnflushedSegments()[0].GetStartPosition().GetMsgID())
+
+		value, err = metaKV.Load("root-coord/collection/100")
+		assert.NoError(t, err)
+		collInfo := &etcdpb.CollectionInfo{}
+		assert.NoError(t, proto.Unmarshal([]byte(value), collInfo))
+		assert.Equal(t, []byte("new-10"), collInfo.GetStartPositions()[0].GetData())
+	})
+}
@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/mqimpl/rocksmq/server"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+// migrationReaderPrefix is the subscription role prefix of readers created by the migration
+const migrationReaderPrefix = "migration-"
+
+// rocksmqSource is the subset of rocksmq used by the migration
+type rocksmqSource interface {
+	ListTopics() ([]string, error)
+	CreateReader(topicName string, startMsgID server.UniqueID, messageIDInclusive bool, subscriptionRolePrefix string) (string, error)
+	Next(ctx context.Context, topicName string, readerName string) (*server.ConsumerMessage, error)
+	HasNext(topicName string, readerName string) bool
+	CloseReader(topicName string, readerName string)
+}
+
+// migrator re-publishes the messages retained in rocksmq into the target mq
+type migrator struct {
+	source      rocksmqSource
+	target      mqwrapper.Client
+	topicPrefix string
+	dryRun      bool
+}
+
+// listTopics returns the rocksmq topics to migrate in order
+func (m *migrator) listTopics() ([]string, error) {
+	topics, err := m.source.ListTopics()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		if strings.HasPrefix(topic, m.topicPrefix) {
+			ret = append(ret, topic)
+		}
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+// migrate re-publishes all the topics and returns the mapper of message positions
+func (m *migrator) migrate(ctx context.Context) (*positionMapper, error) {
+	topics, err := m.listTopics()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rocksmq topics, %w", err)
+	}
+	log.Info("start to migrate rocksmq topics", zap.Int("numTopics", len(topics)), zap.Bool("dryRun", m.dryRun))
+
+	pm := newPositionMapper()
+	for _, topic := range topics {
+		mapping, err := m.migrateTopic(ctx, topic)
+		if err != nil {
+			return nil, fmt.Errorf("failed to migrate topic %s, %w", topic, err)
+		}
+		pm.addTopic(topic, mapping)
+	}
+	return pm, nil
+}
+
+// migrateTopic re-publishes the messages of topic in order, from the earliest retained one
+func (m *migrator) migrateTopic(ctx context.Context, topic string) (*topicMapping, error) {
+	readerName, err := m.source.CreateReader(topic, server.DefaultMessageID, true, migrationReaderPrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer m.source.CloseReader(topic, readerName)
+
+	mapping := &topicMapping{
+		earliestID: m.target.EarliestMessageID().Serialize(),
+	}
+	var producer mqwrapper.Producer
+	if !m.dryRun {
+		producer, err = m.target.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+		if err != nil {
+			return nil, err
+		}
+		defer producer.Close()
+	}
+
+	for m.source.HasNext(topic, readerName) {
+		msg, err := m.source.Next(ctx, topic, readerName)
+		if err != nil {
+			return nil, err
+		}
+		if m.dryRun {
+			mapping.append(msg.MsgID, nil)
+			continue
+		}
+		newID, err := producer.Send(ctx, &mqwrapper.ProducerMessage{Payload: msg.Payload})
+		if err != nil {
+			return nil, err
+		}
+		mapping.append(msg.MsgID, newID.Serialize())
+	}
+	log.Info("migrate rocksmq topic done", zap.String("topic", topic), zap.Int("numMsgs", len(mapping.oldIDs)))
+	return mapping, nil
+}
@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/rmq"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// topicMapping records the message id in the target mq of each message re-published from a rocksmq topic,
+// old ids are appended in the order of the rocksmq topic, so they are always ascending.
+type topicMapping struct {
+	oldIDs []int64
+	newIDs [][]byte
+	// earliestID is used for the positions of topics without any retained message
+	earliestID []byte
+}
+
+func (m *topicMapping) append(oldID int64, newID []byte) {
+	m.oldIDs = append(m.oldIDs, oldID)
+	m.newIDs = append(m.newIDs, newID)
+}
+
+// get returns the new id of the first message whose old id is not less than oldID.
+// Consumers seek inclusively and filter by timestamp, so seeking to the next retained message is equivalent,
+// positions after the last message are mapped to the last message.
+func (m *topicMapping) get(oldID int64) []byte {
+	if len(m.oldIDs) == 0 {
+		return m.earliestID
+	}
+	idx := sort.Search(len(m.oldIDs), func(i int) bool {
+		return m.oldIDs[i] >= oldID
+	})
+	if idx == len(m.oldIDs) {
+		idx = len(m.oldIDs) - 1
+	}
+	return m.newIDs[idx]
+}
+
+// positionMapper rewrites the rocksmq message positions into the ones of the target mq
+type positionMapper struct {
+	topics map[string]*topicMapping
+	// number of positions mapped
+	mapped int
+	// number of positions whose channel is not migrated
+	missed int
+}
+
+func newPositionMapper() *positionMapper {
+	return &positionMapper{
+		topics: make(map[string]*topicMapping),
+	}
+}
+
+func (pm *positionMapper) addTopic(topic string, mapping *topicMapping) {
+	pm.topics[topic] = mapping
+}
+
+// getMapping returns the mapping of the channel, virtual channels are mapped by their physical channel
+func (pm *positionMapper) getMapping(channel string) (*topicMapping, bool) {
+	if mapping, ok := pm.topics[channel]; ok {
+		return mapping, true
+	}
+	mapping, ok := pm.topics[funcutil.ToPhysicalChannel(channel)]
+	return mapping, ok
+}
+
+// rmqIDLen is the length of a serialized rocksmq message id
+const rmqIDLen = 8
+
+// mapMsgID returns the new id of a serialized rocksmq id of the channel
+func (pm *positionMapper) mapMsgID(channel string, msgID []byte) ([]byte, bool) {
+	if len(msgID) != rmqIDLen {
+		return nil, false
+	}
+	mapping, ok := pm.getMapping(channel)
+	if !ok {
+		pm.missed++
+		return nil, false
+	}
+	pm.mapped++
+	return mapping.get(rmq.DeserializeRmqID(msgID)), true
+}
+
+// mapPosition rewrites the msg id of position in place, returns whether the position changed
+func (pm *positionMapper) mapPosition(pos *internalpb.MsgPosition) bool {
+	if pos == nil {
+		return false
+	}
+	newID, ok := pm.mapMsgID(pos.GetChannelName(), pos.GetMsgID())
+	if !ok {
+		return false
+	}
+	pos.MsgID = newID
+	return true
+}
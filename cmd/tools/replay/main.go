@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// replay re-publishes the messages of a DML channel from a given position into a target channel with throttling,
+// e.g. to rebuild the growing segments of a querynode, or to feed a new downstream consumer in recovery and
+// migration. It replays until the latest message of the source channel when it starts.
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	kafkawrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/kafka"
+	natswrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/nats"
+	pulsarwrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/pulsar"
+)
+
+var (
+	mqType        = flag.String("mq", "pulsar", "Mq of the channels, pulsar, kafka or nats")
+	pulsarAddress = flag.String("pulsarAddress", "pulsar://localhost:6650", "Address of pulsar")
+	kafkaBrokers  = flag.String("kafkaBrokers", "localhost:9092", "Broker list of kafka")
+	natsAddress   = flag.String("natsAddress", "nats://localhost:4222", "Address of nats")
+
+	channel       = flag.String("channel", "", "DML channel to replay")
+	targetChannel = flag.String("targetChannel", "", "Channel to replay into")
+	position      = flag.String("position", "", "Base64 encoded message id to replay from, e.g. the msgID of a checkpoint, replay from the earliest if empty")
+	inclusive     = flag.Bool("inclusive", false, "Whether the message at the position is replayed")
+
+	msgRate     = flag.Float64("rate", 1000, "Max messages replayed per second, unlimited if <= 0")
+	byteRateMB  = flag.Float64("rateMB", 16, "Max MB replayed per second, unlimited if <= 0")
+	idleTimeout = flag.Duration("idleTimeout", 30*time.Second, "Stop if no message is consumed in the duration")
+	dryRun      = flag.Bool("dryRun", false, "Only consume and count the messages to replay")
+)
+
+func newClient() (mqwrapper.Client, error) {
+	switch *mqType {
+	case "pulsar":
+		client, err := pulsarwrapper.NewClient(pulsar.ClientOptions{URL: *pulsarAddress})
+		if err == nil && client == nil {
+			err = fmt.Errorf("failed to connect to pulsar %s", *pulsarAddress)
+		}
+		return client, err
+	case "kafka":
+		return kafkawrapper.NewKafkaClientInstance(*kafkaBrokers), nil
+	case "nats":
+		return natswrapper.NewClient(*natsAddress)
+	default:
+		return nil, fmt.Errorf("unknown mq %s", *mqType)
+	}
+}
+
+func main() {
+	flag.Parse()
+	if *channel == "" || *targetChannel == "" {
+		log.Fatal("channel and targetChannel must be specified")
+	}
+	if *channel == *targetChannel {
+		log.Fatal("can't replay a channel into itself", zap.String("channel", *channel))
+	}
+
+	client, err := newClient()
+	if err != nil {
+		log.Fatal("failed to create mq client", zap.String("mq", *mqType), zap.Error(err))
+	}
+	defer client.Close()
+
+	var start mqwrapper.MessageID
+	if *position != "" {
+		id, err := base64.StdEncoding.DecodeString(*position)
+		if err != nil {
+			log.Fatal("invalid position", zap.String("position", *position), zap.Error(err))
+		}
+		start, err = client.BytesToMsgID(id)
+		if err != nil {
+			log.Fatal("invalid position", zap.String("position", *position), zap.Error(err))
+		}
+	}
+
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       *channel,
+		SubscriptionName:            fmt.Sprintf("replay-%s-%d", *channel, time.Now().UnixNano()),
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+		BufSize:                     1024,
+	})
+	if err != nil {
+		log.Fatal("failed to subscribe the channel", zap.String("channel", *channel), zap.Error(err))
+	}
+	defer consumer.Close()
+
+	var producer mqwrapper.Producer
+	if !*dryRun {
+		producer, err = client.CreateProducer(mqwrapper.ProducerOptions{Topic: *targetChannel})
+		if err != nil {
+			log.Fatal("failed to create producer", zap.String("channel", *targetChannel), zap.Error(err))
+		}
+		defer producer.Close()
+	}
+
+	r := &replayer{
+		consumer:    consumer,
+		producer:    producer,
+		throttler:   newThrottler(*msgRate, *byteRateMB*1024*1024),
+		idleTimeout: *idleTimeout,
+		dryRun:      *dryRun,
+	}
+	count, err := r.replay(context.Background(), start, *inclusive)
+	if err != nil {
+		log.Fatal("failed to replay", zap.String("channel", *channel), zap.Int("numMsgs", count), zap.Error(err))
+	}
+	log.Info("replay done", zap.String("channel", *channel), zap.String("targetChannel", *targetChannel),
+		zap.Int("numMsgs", count), zap.Bool("dryRun", *dryRun))
+}
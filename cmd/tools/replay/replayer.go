@@ -0,0 +1,140 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+// throttler paces the replay under the message rate and byte rate, a rate <= 0 means unlimited
+type throttler struct {
+	msgRate  float64 // messages per second
+	byteRate float64 // bytes per second
+
+	start time.Time
+	msgs  int64
+	bytes int64
+
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+func newThrottler(msgRate, byteRate float64) *throttler {
+	return &throttler{
+		msgRate:  msgRate,
+		byteRate: byteRate,
+		start:    time.Now(),
+		now:      time.Now,
+		sleep:    time.Sleep,
+	}
+}
+
+// wait blocks until a message of size bytes is allowed to be sent
+func (t *throttler) wait(size int) {
+	t.msgs++
+	t.bytes += int64(size)
+
+	var expected time.Duration
+	if t.msgRate > 0 {
+		expected = time.Duration(float64(t.msgs) / t.msgRate * float64(time.Second))
+	}
+	if t.byteRate > 0 {
+		if d := time.Duration(float64(t.bytes) / t.byteRate * float64(time.Second)); d > expected {
+			expected = d
+		}
+	}
+	if elapsed := t.now().Sub(t.start); expected > elapsed {
+		t.sleep(expected - elapsed)
+	}
+}
+
+// replayer re-publishes the messages of the source channel into the target channel,
+// until the latest message of the source channel when the replay starts
+type replayer struct {
+	consumer    mqwrapper.Consumer
+	producer    mqwrapper.Producer
+	throttler   *throttler
+	idleTimeout time.Duration
+	dryRun      bool
+}
+
+// replay seeks to the start position if any, and returns the number of messages replayed
+func (r *replayer) replay(ctx context.Context, start mqwrapper.MessageID, inclusive bool) (int, error) {
+	latest, err := r.consumer.GetLatestMsgID()
+	if err != nil {
+		return 0, err
+	}
+	if start != nil {
+		if err := r.consumer.Seek(start, inclusive); err != nil {
+			return 0, err
+		}
+	}
+
+	count := 0
+	idle := time.NewTimer(r.idleTimeout)
+	defer idle.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return count, ctx.Err()
+		case <-idle.C:
+			// nothing after the start position, or the latest message is purged
+			log.Info("no more message to replay", zap.Duration("idleTimeout", r.idleTimeout), zap.Int("numMsgs", count))
+			return count, nil
+		case msg, ok := <-r.consumer.Chan():
+			if !ok {
+				return count, errors.New("consumer closed")
+			}
+			r.throttler.wait(len(msg.Payload()))
+			if !r.dryRun {
+				_, err := r.producer.Send(ctx, &mqwrapper.ProducerMessage{
+					Payload:    msg.Payload(),
+					Properties: msg.Properties(),
+				})
+				if err != nil {
+					return count, err
+				}
+			}
+			r.consumer.Ack(msg)
+			count++
+			if count%10000 == 0 {
+				log.Info("replaying", zap.Int("numMsgs", count))
+			}
+
+			reached, err := latest.LessOrEqualThan(msg.ID().Serialize())
+			if err != nil {
+				return count, err
+			}
+			if reached {
+				log.Info("replayed to the latest message", zap.Int("numMsgs", count))
+				return count, nil
+			}
+
+			if !idle.Stop() {
+				<-idle.C
+			}
+			idle.Reset(r.idleTimeout)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+type mockMsgID int64
+
+func (id mockMsgID) Serialize() []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(id))
+	return b
+}
+
+func (id mockMsgID) AtEarliestPosition() bool {
+	return id <= 0
+}
+
+func (id mockMsgID) LessOrEqualThan(msgID []byte) (bool, error) {
+	return int64(id) <= int64(binary.BigEndian.Uint64(msgID)), nil
+}
+
+type mockMessage struct {
+	id      mockMsgID
+	payload []byte
+}
+
+func (m *mockMessage) Topic() string {
+	return "source"
+}
+
+func (m *mockMessage) Properties() map[string]string {
+	return map[string]string{"id": string(m.payload)}
+}
+
+func (m *mockMessage) Payload() []byte {
+	return m.payload
+}
+
+func (m *mockMessage) ID() mqwrapper.MessageID {
+	return m.id
+}
+
+// mockConsumer delivers the messages after the seek position
+type mockConsumer struct {
+	mqwrapper.Consumer
+	msgs   []*mockMessage
+	ch     chan mqwrapper.Message
+	acked  int
+	latest mockMsgID
+}
+
+func newMockConsumer(n int) *mockConsumer {
+	c := &mockConsumer{ch: make(chan mqwrapper.Message, n), latest: mockMsgID(n)}
+	for i := 1; i <= n; i++ {
+		c.msgs = append(c.msgs, &mockMessage{id: mockMsgID(i), payload: []byte{byte(i)}})
+	}
+	return c
+}
+
+func (c *mockConsumer) Seek(id mqwrapper.MessageID, inclusive bool) error {
+	start := int(id.(mockMsgID))
+	if !inclusive {
+		start++
+	}
+	c.msgs = c.msgs[start-1:]
+	return nil
+}
+
+func (c *mockConsumer) Chan() <-chan mqwrapper.Message {
+	// the messages produced after the replay starts are not replayed
+	for _, msg := range c.msgs {
+		c.ch <- msg
+	}
+	c.msgs = nil
+	return c.ch
+}
+
+func (c *mockConsumer) Ack(mqwrapper.Message) { c.acked++ }
+
+func (c *mockConsumer) GetLatestMsgID() (mqwrapper.MessageID, error) {
+	return c.latest, nil
+}
+
+type mockProducer struct {
+	mqwrapper.Producer
+	msgs []*mqwrapper.ProducerMessage
+}
+
+func (p *mockProducer) Send(ctx context.Context, msg *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
+	p.msgs = append(p.msgs, msg)
+	return mockMsgID(len(p.msgs)), nil
+}
+
+func TestReplayer_Replay(t *testing.T) {
+	consumer := newMockConsumer(10)
+	producer := &mockProducer{}
+	r := &replayer{
+		consumer:    consumer,
+		producer:    producer,
+		throttler:   newThrottler(0, 0),
+		idleTimeout: time.Second,
+	}
+	count, err := r.replay(context.Background(), mockMsgID(3), false)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, count)
+	assert.Equal(t, 7, consumer.acked)
+	assert.Len(t, producer.msgs, 7)
+	assert.Equal(t, []byte{4}, producer.msgs[0].Payload)
+	assert.Equal(t, map[string]string{"id": string([]byte{4})}, producer.msgs[0].Properties)
+
+	// replay from the earliest
+	consumer = newMockConsumer(10)
+	producer = &mockProducer{}
+	r.consumer, r.producer = consumer, producer
+	count, err = r.replay(context.Background(), nil, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, count)
+
+	// stop if nothing after the position
+	consumer = newMockConsumer(10)
+	consumer.latest = 11
+	r.consumer, r.dryRun = consumer, true
+	r.idleTimeout = 10 * time.Millisecond
+	count, err = r.replay(context.Background(), mockMsgID(5), true)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, count)
+}
+
+func TestThrottler(t *testing.T) {
+	now := time.Now()
+	var slept time.Duration
+	th := newThrottler(10, 100)
+	th.start = now
+	th.now = func() time.Time { return now }
+	th.sleep = func(d time.Duration) { slept += d }
+
+	// 1 message of 10 bytes needs 100ms by both rates
+	th.wait(10)
+	assert.Equal(t, 100*time.Millisecond, slept)
+
+	// the byte rate is the bottleneck
+	now = now.Add(100 * time.Millisecond)
+	slept = 0
+	th.wait(90)
+	assert.Equal(t, 900*time.Millisecond, slept)
+
+	// unlimited
+	slept = 0
+	th = newThrottler(0, 0)
+	th.sleep = func(d time.Duration) { slept += d }
+	th.wait(1 << 20)
+	assert.Zero(t, slept)
+}
@@ -52,6 +52,108 @@ const (
 
 	// NotRegisteredID means node is not registered into etcd.
 	NotRegisteredID = int64(-1)
+
+	// PartitionKeyParam is the type param marking the scalar field as the partition key if "true",
+	// the entities are routed to the implicit partitions by the hash of the key
+	PartitionKeyParam = "partition_key"
+
+	// PartitionKeyNumParam is the type param of the partition key field, the number of the implicit partitions
+	PartitionKeyNumParam = "num_partitions"
+
+	// DynamicFieldParam is the type param marking the VarChar field as the dynamic field if "true", the keys of the
+	// entities undeclared in the schema are stored in it as a JSON object
+	DynamicFieldParam = "dynamic_field"
+
+	// EnableDynamicFieldParam is the type param of the primary key field, the collection is created with a hidden
+	// dynamic field named MetaFieldName if "true" and no dynamic field is declared
+	EnableDynamicFieldParam = "enable_dynamic_field"
+
+	// MetaFieldName is the name of the hidden dynamic field, which is not a valid name of the declared fields
+	MetaFieldName = "$meta"
+
+	// JSONFieldParam is the type param marking the VarChar field as a JSON field if "true", each value is a JSON object
+	// whose paths could be filtered in queries, e.g. field["a"]["b"] > 1. The dynamic field is a JSON field as well.
+	JSONFieldParam = "json"
+
+	// ArrayElementTypeParam is the type param marking the VarChar field as an array field of the scalar element type,
+	// e.g. Int64, each value is a JSON array of the elements which could be filtered by array_contains in queries
+	ArrayElementTypeParam = "element_type"
+
+	// BloomFilterCardinalityParam is the type param of the primary key field, the expected number of the entities
+	// of a segment the pk bloom filter is sized for
+	BloomFilterCardinalityParam = "bloom_filter_cardinality"
+
+	// BloomFilterFPRParam is the type param of the primary key field, the false positive rate of the pk bloom filter
+	// at the expected cardinality
+	BloomFilterFPRParam = "bloom_filter_fpr"
+
+	// The collection properties below are kept in the properties of the collection schema, they could be set as the
+	// type params of the primary key field as well when the collection is created.
+
+	// CollectionTTLParam is the property of the collection, the time to live of the entities in seconds,
+	// the expired entities are filtered from search and query results and purged by compaction
+	CollectionTTLParam = "collection_ttl"
+
+	// CollectionReplicaNumberParam is the property of the collection, the number of replicas to load the
+	// collection with if the load request doesn't specify it
+	CollectionReplicaNumberParam = "replica_number"
+
+	// CollectionMmapEnabledParam is the property of the collection, the load mode of the collection, the sealed
+	// segments are memory mapped from the local disk if "true", and loaded into memory if "false"
+	CollectionMmapEnabledParam = "mmap_enabled"
+
+	// CollectionSwapEnabledParam is the property of the collection, the sealed segments of the collection are
+	// swapped out to the local disk once not searched for a check interval if "true", and always kept in memory if
+	// "false", the ones of the collections without it are swapped out by queryNode.coldSegment.idleTimeout
+	CollectionSwapEnabledParam = "swap_enabled"
+
+	// CollectionLoadPriorityParam is the property of the collection, the load tasks of the collections with
+	// higher priorities are scheduled first
+	CollectionLoadPriorityParam = "load_priority"
+
+	// CollectionMaxInsertBatchSizeParam is the property of the collection, the max number of rows per insert
+	// request to the collection, instead of proxy.maxInsertBatchSize
+	CollectionMaxInsertBatchSizeParam = "max_insert_batch_size"
+
+	// CollectionMaxTopKParam is the property of the collection, the max topk of the searches on the collection,
+	// instead of proxy.maxTopK
+	CollectionMaxTopKParam = "max_topk"
+
+	// CollectionAnnotationPrefix prefixes the properties of the collection which are the annotations of the
+	// collection set by the users, e.g. "collection.annotation.owner"
+	CollectionAnnotationPrefix = "collection.annotation."
+
+	// FieldAnnotationPrefix prefixes the type params of the field which are the annotations of the field set by the
+	// users, e.g. "annotation.unit"
+	FieldAnnotationPrefix = "annotation."
+
+	// DefaultDatabase is the database of the collections created without specifying a database
+	DefaultDatabase = "default"
+
+	// The database properties below are the quotas of the database, zero means unlimited.
+
+	// DatabaseDMLRowsPerSecParam is the property of the database, the rows inserted and deleted per second
+	// in all the collections of the database
+	DatabaseDMLRowsPerSecParam = "dml_rows_per_sec"
+
+	// DatabaseSearchQPSParam is the property of the database, the searches per second in all the collections
+	// of the database
+	DatabaseSearchQPSParam = "search_qps"
+
+	// DatabaseMaxQueryConcurrencyParam is the property of the database, the queries running concurrently in all
+	// the collections of the database
+	DatabaseMaxQueryConcurrencyParam = "max_query_concurrency"
+
+	// DatabaseMaxCollectionsParam is the property of the database, the number of the collections in the database
+	DatabaseMaxCollectionsParam = "max_collections"
+
+	// DefaultValueParam is the type param of the scalar field, the value of the field for the entities inserted
+	// without it, such as the entities written before the field was added to the collection
+	DefaultValueParam = "default_value"
+
+	// IndexRebuildParam is the extra param of creating index, the existing index of the same name is rebuilt with the
+	// new params if "true", and keeps serving the segments until the new one is built on them
+	IndexRebuildParam = "rebuild"
 )
 
 // Endian is type alias of binary.LittleEndian.
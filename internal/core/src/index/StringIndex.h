@@ -49,11 +49,18 @@ class StringIndex : public ScalarIndex<std::string> {
             auto prefix = dataset->Get<std::string>(PREFIX_VALUE);
             return PrefixMatch(prefix);
         }
+        if (op == PostfixMatchOp) {
+            auto postfix = dataset->Get<std::string>(POSTFIX_VALUE);
+            return PostfixMatch(postfix);
+        }
         return ScalarIndex<std::string>::Query(dataset);
     }
 
     virtual const TargetBitmapPtr
     PrefixMatch(std::string prefix) = 0;
+
+    virtual const TargetBitmapPtr
+    PostfixMatch(std::string postfix) = 0;
 };
 using StringIndexPtr = std::unique_ptr<StringIndex>;
 }  // namespace milvus::scalar
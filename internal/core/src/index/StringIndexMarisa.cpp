@@ -12,6 +12,7 @@
 #include "index/StringIndexMarisa.h"
 #include "index/Utils.h"
 #include "index/Index.h"
+#include "common/Utils.h"
 
 #include <boost/uuid/uuid.hpp>
 #include <boost/uuid/uuid_io.hpp>
@@ -174,6 +175,25 @@ StringIndexMarisa::PrefixMatch(std::string prefix) {
     return bitset;
 }
 
+const TargetBitmapPtr
+StringIndexMarisa::PostfixMatch(std::string postfix) {
+    TargetBitmapPtr bitset = std::make_unique<TargetBitmap>(str_ids_.size());
+    // the trie only indexes prefixes, check the distinct strings one by one.
+    marisa::Agent agent;
+    for (const auto& [str_id, offsets] : str_ids_to_offsets_) {
+        agent.set_query(str_id);
+        trie_.reverse_lookup(agent);
+        std::string str(agent.key().ptr(), agent.key().length());
+        if (!milvus::PostfixMatch(str, postfix)) {
+            continue;
+        }
+        for (auto offset : offsets) {
+            bitset->set(offset);
+        }
+    }
+    return bitset;
+}
+
 void
 StringIndexMarisa::fill_str_ids(size_t n, const std::string* values) {
     str_ids_.resize(n);
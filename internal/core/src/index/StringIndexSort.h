@@ -48,6 +48,10 @@ class StringIndexSort : public ScalarIndexSort<std::string> {
             auto prefix = dataset->Get<std::string>(PREFIX_VALUE);
             return PrefixMatch(prefix);
         }
+        if (op == PostfixMatchOp) {
+            auto postfix = dataset->Get<std::string>(POSTFIX_VALUE);
+            return PostfixMatch(postfix);
+        }
         return ScalarIndex<std::string>::Query(dataset);
     }
 
@@ -62,6 +66,18 @@ class StringIndexSort : public ScalarIndexSort<std::string> {
         }
         return bitset;
     }
+
+    const TargetBitmapPtr
+    PostfixMatch(std::string postfix) {
+        auto data = GetData();
+        TargetBitmapPtr bitset = std::make_unique<TargetBitmap>(data.size());
+        for (size_t i = 0; i < data.size(); i++) {
+            if (milvus::PostfixMatch(data[i].a_, postfix)) {
+                bitset->set(data[i].idx_);
+            }
+        }
+        return bitset;
+    }
 };
 using StringIndexSortPtr = std::unique_ptr<StringIndexSort>;
 
@@ -22,6 +22,7 @@
 #include <optional>
 #include <string>
 #include <utility>
+#include <variant>
 #include <vector>
 
 #include "common/Schema.h"
@@ -218,4 +219,131 @@ struct CompareExpr : Expr {
     accept(ExprVisitor&) override;
 };
 
+// Note: the fields aren't nullable, only the paths of JSON fields could be null
+struct NullExpr : Expr {
+    enum class OpType { Invalid = 0, IsNull = 1, IsNotNull = 2 };
+    const FieldId field_id_;
+    const DataType data_type_;
+    const OpType op_type_;
+    // the path of the JSON field, empty for the value of the field itself
+    const std::vector<std::string> path_;
+
+    NullExpr(const FieldId field_id, const DataType data_type, const OpType op_type, std::vector<std::string> path)
+        : field_id_(field_id), data_type_(data_type), op_type_(op_type), path_(std::move(path)) {
+    }
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
+// JSONPathExpr is a predicate on the value at the path of a JSON field, which is a VarChar field storing JSON objects.
+// The path exists if its value isn't null, and the comparisons are false if the path doesn't exist or its value is of
+// another type than the value compared with.
+struct JSONPathExpr : Expr {
+    using ValueType = std::variant<std::monostate, bool, double, std::string>;
+    const FieldId field_id_;
+    const DataType data_type_;
+    const std::vector<std::string> path_;
+    // Invalid checks the existence of the path
+    const OpType op_type_;
+    // the numbers are compared as double, and the bools only by Equal and NotEqual
+    const ValueType value_;
+    const bool not_;
+
+    JSONPathExpr(const FieldId field_id,
+                 const DataType data_type,
+                 std::vector<std::string> path,
+                 const OpType op_type,
+                 ValueType value,
+                 const bool is_not)
+        : field_id_(field_id),
+          data_type_(data_type),
+          path_(std::move(path)),
+          op_type_(op_type),
+          value_(std::move(value)),
+          not_(is_not) {
+    }
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
+// ArrayExpr is a predicate on the elements or the length of an array field, which is a VarChar field storing JSON
+// arrays. The numbers are compared as int64 if both are integers, otherwise as double.
+struct ArrayExpr : Expr {
+    enum class ArrayOpType { Invalid = 0, Contains = 1, ContainsAny = 2, Length = 3 };
+    using ValueType = std::variant<bool, int64_t, double, std::string>;
+    const FieldId field_id_;
+    const DataType data_type_;
+    const ArrayOpType op_type_;
+    // the elements to look for by Contains and ContainsAny
+    const std::vector<ValueType> values_;
+    // the comparison of the length by Length
+    const OpType length_op_;
+    const int64_t length_;
+    const bool not_;
+
+    ArrayExpr(const FieldId field_id,
+              const DataType data_type,
+              const ArrayOpType op_type,
+              std::vector<ValueType> values,
+              const OpType length_op,
+              const int64_t length,
+              const bool is_not)
+        : field_id_(field_id),
+          data_type_(data_type),
+          op_type_(op_type),
+          values_(std::move(values)),
+          length_op_(length_op),
+          length_(length),
+          not_(is_not) {
+    }
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
+// ArithOperand is an operand of ArithCompareExpr: a numeric field, a value, or the arithmetic operation of two
+// operands
+struct ArithOperand;
+using ArithOperandPtr = std::unique_ptr<ArithOperand>;
+
+struct ArithOperand {
+    enum class Kind { Column = 0, Value = 1, Arith = 2 };
+    Kind kind_;
+
+    // Column
+    FieldId field_id_{-1};
+    DataType data_type_ = DataType::NONE;
+
+    // Value, the integers are int64 and the others are double
+    bool is_integer_ = true;
+    int64_t int64_val_ = 0;
+    double float_val_ = 0;
+
+    // Arith
+    ArithOpType arith_op_ = ArithOpType::Unknown;
+    ArithOperandPtr left_;
+    ArithOperandPtr right_;
+};
+
+// ArithCompareExpr compares two arithmetic operands, e.g. a + b > c * 2. The operands are computed as int64 if both
+// sides of the operation are integers, otherwise as double.
+struct ArithCompareExpr : Expr {
+    const ArithOperandPtr left_;
+    const ArithOperandPtr right_;
+    const OpType op_type_;
+
+    ArithCompareExpr(ArithOperandPtr left, ArithOperandPtr right, const OpType op_type)
+        : left_(std::move(left)), right_(std::move(right)), op_type_(op_type) {
+    }
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
 }  // namespace milvus::query
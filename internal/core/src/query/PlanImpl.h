@@ -54,6 +54,8 @@ struct Plan {
     std::unique_ptr<VectorPlanNode> plan_node_;
     std::map<std::string, FieldId> tag2field_;  // PlaceholderName -> FieldId
     std::vector<FieldId> target_entries_;
+    // entities inserted before it are expired, 0 means never expire
+    Timestamp expire_timestamp_ = 0;
     void
     check_identical(Plan& other);
 
@@ -90,6 +92,8 @@ struct RetrievePlan {
     const Schema& schema_;
     std::unique_ptr<RetrievePlanNode> plan_node_;
     std::vector<FieldId> field_ids_;
+    // entities inserted before it are expired, 0 means never expire
+    Timestamp expire_timestamp_ = 0;
 };
 
 using PlanPtr = std::unique_ptr<Plan>;
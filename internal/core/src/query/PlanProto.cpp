@@ -411,6 +411,163 @@ ProtoParser::ParseBinaryArithOpEvalRangeExpr(const proto::plan::BinaryArithOpEva
     return result;
 }
 
+ExprPtr
+ProtoParser::ParseNullExpr(const proto::plan::NullExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto data_type = schema[field_id].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+
+    auto op = static_cast<NullExpr::OpType>(expr_pb.op());
+    Assert(op == NullExpr::OpType::IsNull || op == NullExpr::OpType::IsNotNull);
+    std::vector<std::string> path(expr_pb.path().begin(), expr_pb.path().end());
+    // only the JSON fields, which are VarChar fields, have paths
+    Assert(path.empty() || data_type == DataType::VARCHAR);
+    return std::make_unique<NullExpr>(field_id, data_type, op, std::move(path));
+}
+
+ArithOperandPtr
+ProtoParser::ParseArithOperand(const proto::plan::Expr& expr_pb) {
+    using ppe = proto::plan::Expr;
+    auto operand = std::make_unique<ArithOperand>();
+    switch (expr_pb.expr_case()) {
+        case ppe::kColumnExpr: {
+            auto& column_info = expr_pb.column_expr().info();
+            auto field_id = FieldId(column_info.field_id());
+            auto data_type = schema[field_id].get_data_type();
+            Assert(data_type == static_cast<DataType>(column_info.data_type()));
+            Assert(datatype_is_integer(data_type) || datatype_is_floating(data_type));
+            operand->kind_ = ArithOperand::Kind::Column;
+            operand->field_id_ = field_id;
+            operand->data_type_ = data_type;
+            return operand;
+        }
+        case ppe::kValueExpr: {
+            auto& value_proto = expr_pb.value_expr().value();
+            operand->kind_ = ArithOperand::Kind::Value;
+            if (value_proto.val_case() == planpb::GenericValue::kInt64Val) {
+                operand->is_integer_ = true;
+                operand->int64_val_ = value_proto.int64_val();
+            } else {
+                Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
+                operand->is_integer_ = false;
+                operand->float_val_ = value_proto.float_val();
+            }
+            return operand;
+        }
+        case ppe::kBinaryArithExpr: {
+            auto& arith_proto = expr_pb.binary_arith_expr();
+            operand->kind_ = ArithOperand::Kind::Arith;
+            operand->arith_op_ = static_cast<ArithOpType>(arith_proto.op());
+            Assert(operand->arith_op_ != ArithOpType::Unknown);
+            operand->left_ = ParseArithOperand(arith_proto.left());
+            operand->right_ = ParseArithOperand(arith_proto.right());
+            return operand;
+        }
+        default:
+            PanicInfo("unsupported arithmetic operand");
+    }
+}
+
+ExprPtr
+ProtoParser::ParseArithCompareExpr(const proto::plan::ArithCompareExpr& expr_pb) {
+    auto left = ParseArithOperand(expr_pb.left());
+    auto right = ParseArithOperand(expr_pb.right());
+    return std::make_unique<ArithCompareExpr>(std::move(left), std::move(right), static_cast<OpType>(expr_pb.op()));
+}
+
+ExprPtr
+ProtoParser::ParseJSONPathExpr(const proto::plan::JSONPathExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto data_type = schema[field_id].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+    // the JSON fields are VarChar fields
+    Assert(data_type == DataType::VARCHAR);
+
+    std::vector<std::string> path(expr_pb.path().begin(), expr_pb.path().end());
+    Assert(!path.empty());
+    auto op = static_cast<OpType>(expr_pb.op());
+    JSONPathExpr::ValueType value;
+    auto& value_proto = expr_pb.value();
+    switch (value_proto.val_case()) {
+        case planpb::GenericValue::kBoolVal: {
+            value = value_proto.bool_val();
+            break;
+        }
+        case planpb::GenericValue::kInt64Val: {
+            value = static_cast<double>(value_proto.int64_val());
+            break;
+        }
+        case planpb::GenericValue::kFloatVal: {
+            value = value_proto.float_val();
+            break;
+        }
+        case planpb::GenericValue::kStringVal: {
+            value = value_proto.string_val();
+            break;
+        }
+        default: {
+            break;
+        }
+    }
+    // the existence check has no value, and the comparisons have one
+    Assert((op == OpType::Invalid) == std::holds_alternative<std::monostate>(value));
+    return std::make_unique<JSONPathExpr>(field_id, data_type, std::move(path), op, std::move(value), expr_pb.not_());
+}
+
+ExprPtr
+ProtoParser::ParseArrayExpr(const proto::plan::ArrayExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto data_type = schema[field_id].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+    // the array fields are VarChar fields
+    Assert(data_type == DataType::VARCHAR);
+
+    auto op = static_cast<ArrayExpr::ArrayOpType>(expr_pb.op());
+    std::vector<ArrayExpr::ValueType> values;
+    for (auto& value_proto : expr_pb.values()) {
+        switch (value_proto.val_case()) {
+            case planpb::GenericValue::kBoolVal: {
+                values.emplace_back(value_proto.bool_val());
+                break;
+            }
+            case planpb::GenericValue::kInt64Val: {
+                values.emplace_back(value_proto.int64_val());
+                break;
+            }
+            case planpb::GenericValue::kFloatVal: {
+                values.emplace_back(value_proto.float_val());
+                break;
+            }
+            case planpb::GenericValue::kStringVal: {
+                values.emplace_back(value_proto.string_val());
+                break;
+            }
+            default: {
+                PanicInfo("unsupported value of array expr");
+            }
+        }
+    }
+    switch (op) {
+        case ArrayExpr::ArrayOpType::Contains:
+        case ArrayExpr::ArrayOpType::ContainsAny: {
+            Assert(!values.empty());
+            break;
+        }
+        case ArrayExpr::ArrayOpType::Length: {
+            Assert(expr_pb.length_op() != planpb::OpType::Invalid);
+            break;
+        }
+        default: {
+            PanicInfo("unsupported op of array expr");
+        }
+    }
+    return std::make_unique<ArrayExpr>(field_id, data_type, op, std::move(values),
+                                       static_cast<OpType>(expr_pb.length_op()), expr_pb.length(), expr_pb.not_());
+}
+
 ExprPtr
 ProtoParser::ParseExpr(const proto::plan::Expr& expr_pb) {
     using ppe = proto::plan::Expr;
@@ -436,6 +593,18 @@ ProtoParser::ParseExpr(const proto::plan::Expr& expr_pb) {
         case ppe::kBinaryArithOpEvalRangeExpr: {
             return ParseBinaryArithOpEvalRangeExpr(expr_pb.binary_arith_op_eval_range_expr());
         }
+        case ppe::kNullExpr: {
+            return ParseNullExpr(expr_pb.null_expr());
+        }
+        case ppe::kArithCompareExpr: {
+            return ParseArithCompareExpr(expr_pb.arith_compare_expr());
+        }
+        case ppe::kJsonPathExpr: {
+            return ParseJSONPathExpr(expr_pb.json_path_expr());
+        }
+        case ppe::kArrayExpr: {
+            return ParseArrayExpr(expr_pb.array_expr());
+        }
         default:
             PanicInfo("unsupported expr proto node");
     }
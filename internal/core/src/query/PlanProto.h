@@ -50,6 +50,21 @@ class ProtoParser {
     ExprPtr
     ParseBinaryExpr(const proto::plan::BinaryExpr& expr_pb);
 
+    ExprPtr
+    ParseNullExpr(const proto::plan::NullExpr& expr_pb);
+
+    ArithOperandPtr
+    ParseArithOperand(const proto::plan::Expr& expr_pb);
+
+    ExprPtr
+    ParseArithCompareExpr(const proto::plan::ArithCompareExpr& expr_pb);
+
+    ExprPtr
+    ParseJSONPathExpr(const proto::plan::JSONPathExpr& expr_pb);
+
+    ExprPtr
+    ParseArrayExpr(const proto::plan::ArrayExpr& expr_pb);
+
     ExprPtr
     ParseExpr(const proto::plan::Expr& expr_pb);
 
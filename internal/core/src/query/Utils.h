@@ -12,8 +12,10 @@
 #pragma once
 
 #include <string>
+#include <vector>
 #include "query/Expr.h"
 #include "common/Utils.h"
+#include "utils/Json.h"
 
 namespace milvus::query {
 
@@ -35,4 +37,49 @@ Match<std::string>(const std::string& str, const std::string& val, OpType op) {
             PanicInfo("not supported");
     }
 }
+
+// ParseJSONRow parses a row of a VarChar field storing JSON, an empty row is an empty object. The rows which aren't
+// valid JSON are parsed as discarded values, which have no paths.
+inline json
+ParseJSONRow(const std::string& row) {
+    if (row.empty()) {
+        return json::object();
+    }
+    return json::parse(row, nullptr, false);
+}
+
+// ParseArrayRow parses a row of a VarChar field storing JSON arrays, an empty row is an empty array. The rows which
+// aren't valid JSON arrays are parsed as empty arrays as well.
+inline json
+ParseArrayRow(const std::string& row) {
+    auto value = row.empty() ? json::array() : json::parse(row, nullptr, false);
+    if (!value.is_array()) {
+        return json::array();
+    }
+    return value;
+}
+
+// GetJSONPath returns the value at the path of the JSON value, nullptr if the path doesn't exist
+inline const json*
+GetJSONPath(const json& value, const std::vector<std::string>& path) {
+    auto current = &value;
+    for (const auto& key : path) {
+        if (!current->is_object()) {
+            return nullptr;
+        }
+        auto iter = current->find(key);
+        if (iter == current->end()) {
+            return nullptr;
+        }
+        current = &(*iter);
+    }
+    return current;
+}
+
+// IsJSONPathNull returns whether the path of the JSON value doesn't exist or its value is null
+inline bool
+IsJSONPathNull(const json& value, const std::vector<std::string>& path) {
+    auto target = GetJSONPath(value, path);
+    return target == nullptr || target->is_null() || target->is_discarded();
+}
 }  // namespace milvus::query
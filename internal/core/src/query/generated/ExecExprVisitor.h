@@ -44,6 +44,18 @@ class ExecExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
+    void
+    visit(ArithCompareExpr& expr) override;
+
+    void
+    visit(JSONPathExpr& expr) override;
+
+    void
+    visit(ArrayExpr& expr) override;
+
  public:
     ExecExprVisitor(const segcore::SegmentInternalInterface& segment, int64_t row_count, Timestamp timestamp)
         : segment_(segment), row_count_(row_count), timestamp_(timestamp) {
@@ -72,6 +84,10 @@ class ExecExprVisitor : public ExprVisitor {
     auto
     ExecUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> BitsetType;
 
+    template <typename T, typename CmpFunc>
+    auto
+    ExecBinaryArithOpEvalRangeVisitorImpl(BinaryArithOpEvalRangeExprImpl<T>& expr, CmpFunc cmp_func) -> BitsetType;
+
     template <typename T>
     auto
     ExecBinaryArithOpEvalRangeVisitorDispatcher(BinaryArithOpEvalRangeExpr& expr_raw) -> BitsetType;
@@ -88,6 +104,10 @@ class ExecExprVisitor : public ExprVisitor {
     auto
     ExecCompareExprDispatcher(CompareExpr& expr, CmpFunc cmp_func) -> BitsetType;
 
+    template <typename CmpFunc>
+    auto
+    ExecArithCompareExprDispatcher(ArithCompareExpr& expr, CmpFunc cmp_func) -> BitsetType;
+
  private:
     const segcore::SegmentInternalInterface& segment_;
     Timestamp timestamp_;
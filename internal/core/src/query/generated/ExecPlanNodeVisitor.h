@@ -41,6 +41,11 @@ class ExecPlanNodeVisitor : public PlanNodeVisitor {
         : segment_(segment), timestamp_(timestamp) {
     }
 
+    void
+    set_expire_timestamp(Timestamp expire_timestamp) {
+        expire_timestamp_ = expire_timestamp;
+    }
+
     SearchResult
     get_moved_result(PlanNode& node) {
         assert(!search_result_opt_.has_value());
@@ -72,6 +77,7 @@ class ExecPlanNodeVisitor : public PlanNodeVisitor {
  private:
     const segcore::SegmentInterface& segment_;
     Timestamp timestamp_;
+    Timestamp expire_timestamp_ = 0;
     PlaceholderGroup placeholder_group_;
 
     SearchResultOpt search_result_opt_;
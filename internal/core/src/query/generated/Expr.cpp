@@ -49,4 +49,24 @@ CompareExpr::accept(ExprVisitor& visitor) {
     visitor.visit(*this);
 }
 
+void
+NullExpr::accept(ExprVisitor& visitor) {
+    visitor.visit(*this);
+}
+
+void
+ArithCompareExpr::accept(ExprVisitor& visitor) {
+    visitor.visit(*this);
+}
+
+void
+JSONPathExpr::accept(ExprVisitor& visitor) {
+    visitor.visit(*this);
+}
+
+void
+ArrayExpr::accept(ExprVisitor& visitor) {
+    visitor.visit(*this);
+}
+
 }  // namespace milvus::query
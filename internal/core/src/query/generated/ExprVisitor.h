@@ -39,5 +39,17 @@ class ExprVisitor {
 
     virtual void
     visit(CompareExpr&) = 0;
+
+    virtual void
+    visit(NullExpr&) = 0;
+
+    virtual void
+    visit(ArithCompareExpr&) = 0;
+
+    virtual void
+    visit(JSONPathExpr&) = 0;
+
+    virtual void
+    visit(ArrayExpr&) = 0;
 };
 }  // namespace milvus::query
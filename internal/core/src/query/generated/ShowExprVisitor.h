@@ -40,6 +40,18 @@ class ShowExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
+    void
+    visit(ArithCompareExpr& expr) override;
+
+    void
+    visit(JSONPathExpr& expr) override;
+
+    void
+    visit(ArrayExpr& expr) override;
+
  public:
     Json
 
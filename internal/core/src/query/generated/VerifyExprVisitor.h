@@ -44,6 +44,18 @@ class VerifyExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
+    void
+    visit(ArithCompareExpr& expr) override;
+
+    void
+    visit(JSONPathExpr& expr) override;
+
+    void
+    visit(ArrayExpr& expr) override;
+
  public:
 };
 }  // namespace milvus::query
@@ -9,7 +9,10 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <cmath>
 #include <deque>
+#include <functional>
+#include <limits>
 #include <optional>
 #include <unordered_set>
 #include <utility>
@@ -50,6 +53,10 @@ class ExecExprVisitor : ExprVisitor {
     auto
     ExecUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> BitsetType;
 
+    template <typename T, typename CmpFunc>
+    auto
+    ExecBinaryArithOpEvalRangeVisitorImpl(BinaryArithOpEvalRangeExprImpl<T>& expr, CmpFunc cmp_func) -> BitsetType;
+
     template <typename T>
     auto
     ExecBinaryArithOpEvalRangeVisitorDispatcher(BinaryArithOpEvalRangeExpr& expr_raw) -> BitsetType;
@@ -66,6 +73,10 @@ class ExecExprVisitor : ExprVisitor {
     auto
     ExecCompareExprDispatcher(CompareExpr& expr, CmpFunc cmp_func) -> BitsetType;
 
+    template <typename CmpFunc>
+    auto
+    ExecArithCompareExprDispatcher(ArithCompareExpr& expr, CmpFunc cmp_func) -> BitsetType;
+
  private:
     const segcore::SegmentInternalInterface& segment_;
     int64_t row_count_;
@@ -255,7 +266,16 @@ ExecExprVisitor::ExecUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> Bi
             auto elem_func = [val, op](T x) { return Match(x, val, op); };
             return ExecRangeVisitorImpl<T>(expr.field_id_, index_func, elem_func);
         }
-        // TODO: PostfixMatch
+        case OpType::PostfixMatch: {
+            auto index_func = [val](Index* index) {
+                auto dataset = std::make_unique<knowhere::Dataset>();
+                dataset->Set(scalar::OPERATOR_TYPE, Operator::PostfixMatchOp);
+                dataset->Set(scalar::POSTFIX_VALUE, val);
+                return index->Query(std::move(dataset));
+            };
+            auto elem_func = [val, op](T x) { return Match(x, val, op); };
+            return ExecRangeVisitorImpl<T>(expr.field_id_, index_func, elem_func);
+        }
         default: {
             PanicInfo("unsupported range node");
         }
@@ -265,74 +285,65 @@ ExecExprVisitor::ExecUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> Bi
 
 #pragma clang diagnostic push
 #pragma ide diagnostic ignored "Simplify"
+template <typename T, typename CmpFunc>
+auto
+ExecExprVisitor::ExecBinaryArithOpEvalRangeVisitorImpl(BinaryArithOpEvalRangeExprImpl<T>& expr, CmpFunc cmp_func)
+    -> BitsetType {
+    auto right_operand = expr.right_operand_;
+    auto val = expr.value_;
+
+    switch (expr.arith_op_) {
+        case ArithOpType::Add: {
+            auto elem_func = [val, right_operand, cmp_func](T x) { return cmp_func(x + right_operand, val); };
+            return ExecDataRangeVisitorImpl<T>(expr.field_id_, elem_func);
+        }
+        case ArithOpType::Sub: {
+            auto elem_func = [val, right_operand, cmp_func](T x) { return cmp_func(x - right_operand, val); };
+            return ExecDataRangeVisitorImpl<T>(expr.field_id_, elem_func);
+        }
+        case ArithOpType::Mul: {
+            auto elem_func = [val, right_operand, cmp_func](T x) { return cmp_func(x * right_operand, val); };
+            return ExecDataRangeVisitorImpl<T>(expr.field_id_, elem_func);
+        }
+        case ArithOpType::Div: {
+            auto elem_func = [val, right_operand, cmp_func](T x) { return cmp_func(x / right_operand, val); };
+            return ExecDataRangeVisitorImpl<T>(expr.field_id_, elem_func);
+        }
+        case ArithOpType::Mod: {
+            auto elem_func = [val, right_operand, cmp_func](T x) {
+                return cmp_func(static_cast<T>(fmod(x, right_operand)), val);
+            };
+            return ExecDataRangeVisitorImpl<T>(expr.field_id_, elem_func);
+        }
+        default: {
+            PanicInfo("unsupported arithmetic operation");
+        }
+    }
+}
+
 template <typename T>
 auto
 ExecExprVisitor::ExecBinaryArithOpEvalRangeVisitorDispatcher(BinaryArithOpEvalRangeExpr& expr_raw) -> BitsetType {
     auto& expr = static_cast<BinaryArithOpEvalRangeExprImpl<T>&>(expr_raw);
-    using Index = scalar::ScalarIndex<T>;
-    auto arith_op = expr.arith_op_;
-    auto right_operand = expr.right_operand_;
-    auto op = expr.op_type_;
-    auto val = expr.value_;
 
-    switch (op) {
+    switch (expr.op_type_) {
         case OpType::Equal: {
-            switch (arith_op) {
-                case ArithOpType::Add: {
-                    auto elem_func = [val, right_operand](T x) { return ((x + right_operand) == val); };
-                    return ExecDataRangeVisitorImpl<T>(expr.field_id_, elem_func);
-                }
-                case ArithOpType::Sub: {
-                    auto elem_func = [val, right_operand](T x) { return ((x - right_operand) == val); };
-                    return ExecDataRangeVisitorImpl<T>(expr.field_id_, elem_func);
-                }
-                case ArithOpType::Mul: {
-                    auto elem_func = [val, right_operand](T x) { return ((x * right_operand) == val); };
-                    return ExecDataRangeVisitorImpl<T>(expr.field_id_, elem_func);
-                }
-                case ArithOpType::Div: {
-                    auto elem_func = [val, right_operand](T x) { return ((x / right_operand) == val); };
-                    return ExecDataRangeVisitorImpl<T>(expr.field_id_, elem_func);
-                }
-                case ArithOpType::Mod: {
-                    auto elem_func = [val, right_operand](T x) {
-                        return (static_cast<T>(fmod(x, right_operand)) == val);
-                    };
-                    return ExecDataRangeVisitorImpl<T>(expr.field_id_, elem_func);
-                }
-                default: {
-                    PanicInfo("unsupported arithmetic operation");
-                }
-            }
+            return ExecBinaryArithOpEvalRangeVisitorImpl(expr, std::equal_to<>{});
         }
         case OpType::NotEqual: {
-            switch (arith_op) {
-                case ArithOpType::Add: {
-                    auto elem_func = [val, right_operand](T x) { return ((x + right_operand) != val); };
-                    return ExecDataRangeVisitorImpl<T>(expr.field_id_, elem_func);
-                }
-                case ArithOpType::Sub: {
-                    auto elem_func = [val, right_operand](T x) { return ((x - right_operand) != val); };
-                    return ExecDataRangeVisitorImpl<T>(expr.field_id_, elem_func);
-                }
-                case ArithOpType::Mul: {
-                    auto elem_func = [val, right_operand](T x) { return ((x * right_operand) != val); };
-                    return ExecDataRangeVisitorImpl<T>(expr.field_id_, elem_func);
-                }
-                case ArithOpType::Div: {
-                    auto elem_func = [val, right_operand](T x) { return ((x / right_operand) != val); };
-                    return ExecDataRangeVisitorImpl<T>(expr.field_id_, elem_func);
-                }
-                case ArithOpType::Mod: {
-                    auto elem_func = [val, right_operand](T x) {
-                        return (static_cast<T>(fmod(x, right_operand)) != val);
-                    };
-                    return ExecDataRangeVisitorImpl<T>(expr.field_id_, elem_func);
-                }
-                default: {
-                    PanicInfo("unsupported arithmetic operation");
-                }
-            }
+            return ExecBinaryArithOpEvalRangeVisitorImpl(expr, std::not_equal_to<>{});
+        }
+        case OpType::GreaterThan: {
+            return ExecBinaryArithOpEvalRangeVisitorImpl(expr, std::greater<>{});
+        }
+        case OpType::GreaterEqual: {
+            return ExecBinaryArithOpEvalRangeVisitorImpl(expr, std::greater_equal<>{});
+        }
+        case OpType::LessThan: {
+            return ExecBinaryArithOpEvalRangeVisitorImpl(expr, std::less<>{});
+        }
+        case OpType::LessEqual: {
+            return ExecBinaryArithOpEvalRangeVisitorImpl(expr, std::less_equal<>{});
         }
         default: {
             PanicInfo("unsupported range node with arithmetic operation");
@@ -620,8 +631,10 @@ ExecExprVisitor::visit(CompareExpr& expr) {
             res = ExecCompareExprDispatcher(expr, MatchOp<OpType::PrefixMatch>{});
             break;
         }
-            // case OpType::PostfixMatch: {
-            // }
+        case OpType::PostfixMatch: {
+            res = ExecCompareExprDispatcher(expr, MatchOp<OpType::PostfixMatch>{});
+            break;
+        }
         default: {
             PanicInfo("unsupported optype");
         }
@@ -766,4 +779,348 @@ ExecExprVisitor::visit(TermExpr& expr) {
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
     bitset_opt_ = std::move(res);
 }
+
+void
+ExecExprVisitor::visit(NullExpr& expr) {
+    auto& field_meta = segment_.get_schema()[expr.field_id_];
+    AssertInfo(expr.data_type_ == field_meta.get_data_type(),
+               "[ExecExprVisitor]DataType of expr isn't field_meta data type");
+    auto is_null = expr.op_type_ == NullExpr::OpType::IsNull;
+    AssertInfo(is_null || expr.op_type_ == NullExpr::OpType::IsNotNull, "[ExecExprVisitor]Invalid null op");
+    BitsetType res;
+    if (expr.path_.empty()) {
+        // the fields aren't nullable
+        res = BitsetType(row_count_, !is_null);
+    } else {
+        AssertInfo(expr.data_type_ == DataType::VARCHAR, "[ExecExprVisitor]JSON field isn't VarChar field");
+        auto& path = expr.path_;
+        auto elem_func = [&path, is_null](const std::string& x) {
+            return IsJSONPathNull(ParseJSONRow(x), path) == is_null;
+        };
+        res = ExecDataRangeVisitorImpl<std::string>(expr.field_id_, elem_func);
+    }
+    AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    bitset_opt_ = std::move(res);
+}
+
+namespace {
+// ArithValue is the value of an arithmetic operand of a row, the integers are int64 and the others are double
+struct ArithValue {
+    bool is_integer;
+    int64_t int64_val;
+    double float_val;
+
+    double
+    as_double() const {
+        return is_integer ? static_cast<double>(int64_val) : float_val;
+    }
+};
+
+// ArithAccessor returns the value of the operand of the row in the chunk, std::nullopt if an integer is divided by zero
+using ArithAccessor = std::function<std::optional<ArithValue>(int64_t)>;
+
+std::optional<ArithValue>
+ArithCompute(ArithOpType op, const ArithValue& x, const ArithValue& y) {
+    if (x.is_integer && y.is_integer) {
+        // the integers wrap around on overflow
+        auto a = static_cast<uint64_t>(x.int64_val);
+        auto b = static_cast<uint64_t>(y.int64_val);
+        switch (op) {
+            case ArithOpType::Add:
+                return ArithValue{true, static_cast<int64_t>(a + b), 0};
+            case ArithOpType::Sub:
+                return ArithValue{true, static_cast<int64_t>(a - b), 0};
+            case ArithOpType::Mul:
+                return ArithValue{true, static_cast<int64_t>(a * b), 0};
+            case ArithOpType::Div:
+            case ArithOpType::Mod: {
+                if (y.int64_val == 0) {
+                    return std::nullopt;
+                }
+                if (y.int64_val == -1) {
+                    return ArithValue{true, op == ArithOpType::Div ? static_cast<int64_t>(0 - a) : 0, 0};
+                }
+                auto res = op == ArithOpType::Div ? x.int64_val / y.int64_val : x.int64_val % y.int64_val;
+                return ArithValue{true, res, 0};
+            }
+            default:
+                PanicInfo("unsupported arithmetic operation");
+        }
+    }
+    auto a = x.as_double();
+    auto b = y.as_double();
+    switch (op) {
+        case ArithOpType::Add:
+            return ArithValue{false, 0, a + b};
+        case ArithOpType::Sub:
+            return ArithValue{false, 0, a - b};
+        case ArithOpType::Mul:
+            return ArithValue{false, 0, a * b};
+        case ArithOpType::Div:
+            return ArithValue{false, 0, a / b};
+        case ArithOpType::Mod:
+            return ArithValue{false, 0, fmod(a, b)};
+        default:
+            PanicInfo("unsupported arithmetic operation");
+    }
+}
+
+template <typename T>
+ArithAccessor
+ArithColumnAccessor(const segcore::SegmentInternalInterface& segment, FieldId field_id, int64_t chunk_id) {
+    auto chunk_data = segment.chunk_data<T>(field_id, chunk_id).data();
+    if constexpr (std::is_integral_v<T>) {
+        return [chunk_data](int64_t i) -> std::optional<ArithValue> {
+            return ArithValue{true, static_cast<int64_t>(chunk_data[i]), 0};
+        };
+    } else {
+        return [chunk_data](int64_t i) -> std::optional<ArithValue> {
+            return ArithValue{false, 0, static_cast<double>(chunk_data[i])};
+        };
+    }
+}
+
+ArithAccessor
+GetArithAccessor(const segcore::SegmentInternalInterface& segment, const ArithOperand& operand, int64_t chunk_id) {
+    switch (operand.kind_) {
+        case ArithOperand::Kind::Value: {
+            ArithValue value{operand.is_integer_, operand.int64_val_, operand.float_val_};
+            return [value](int64_t) -> std::optional<ArithValue> { return value; };
+        }
+        case ArithOperand::Kind::Column: {
+            switch (operand.data_type_) {
+                case DataType::INT8:
+                    return ArithColumnAccessor<int8_t>(segment, operand.field_id_, chunk_id);
+                case DataType::INT16:
+                    return ArithColumnAccessor<int16_t>(segment, operand.field_id_, chunk_id);
+                case DataType::INT32:
+                    return ArithColumnAccessor<int32_t>(segment, operand.field_id_, chunk_id);
+                case DataType::INT64:
+                    return ArithColumnAccessor<int64_t>(segment, operand.field_id_, chunk_id);
+                case DataType::FLOAT:
+                    return ArithColumnAccessor<float>(segment, operand.field_id_, chunk_id);
+                case DataType::DOUBLE:
+                    return ArithColumnAccessor<double>(segment, operand.field_id_, chunk_id);
+                default:
+                    PanicInfo("unsupported datatype");
+            }
+        }
+        case ArithOperand::Kind::Arith: {
+            auto left = GetArithAccessor(segment, *operand.left_, chunk_id);
+            auto right = GetArithAccessor(segment, *operand.right_, chunk_id);
+            auto op = operand.arith_op_;
+            return [left, right, op](int64_t i) -> std::optional<ArithValue> {
+                auto x = left(i);
+                auto y = right(i);
+                if (!x.has_value() || !y.has_value()) {
+                    return std::nullopt;
+                }
+                return ArithCompute(op, x.value(), y.value());
+            };
+        }
+        default:
+            PanicInfo("unsupported arithmetic operand");
+    }
+}
+}  // namespace
+
+template <typename CmpFunc>
+auto
+ExecExprVisitor::ExecArithCompareExprDispatcher(ArithCompareExpr& expr, CmpFunc cmp_func) -> BitsetType {
+    auto size_per_chunk = segment_.size_per_chunk();
+    auto num_chunk = upper_div(row_count_, size_per_chunk);
+    std::deque<BitsetType> bitsets;
+    for (int64_t chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+        auto size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
+        auto left = GetArithAccessor(segment_, *expr.left_, chunk_id);
+        auto right = GetArithAccessor(segment_, *expr.right_, chunk_id);
+
+        BitsetType bitset(size);
+        for (int i = 0; i < size; ++i) {
+            auto x = left(i);
+            auto y = right(i);
+            if (!x.has_value() || !y.has_value()) {
+                // the rows dividing integers by zero don't match
+                bitset[i] = false;
+            } else if (x->is_integer && y->is_integer) {
+                bitset[i] = cmp_func(x->int64_val, y->int64_val);
+            } else {
+                bitset[i] = cmp_func(x->as_double(), y->as_double());
+            }
+        }
+        bitsets.emplace_back(std::move(bitset));
+    }
+    auto final_result = Assemble(bitsets);
+    AssertInfo(final_result.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    return final_result;
+}
+
+void
+ExecExprVisitor::visit(ArithCompareExpr& expr) {
+    BitsetType res;
+    switch (expr.op_type_) {
+        case OpType::Equal: {
+            res = ExecArithCompareExprDispatcher(expr, std::equal_to<>{});
+            break;
+        }
+        case OpType::NotEqual: {
+            res = ExecArithCompareExprDispatcher(expr, std::not_equal_to<>{});
+            break;
+        }
+        case OpType::GreaterEqual: {
+            res = ExecArithCompareExprDispatcher(expr, std::greater_equal<>{});
+            break;
+        }
+        case OpType::GreaterThan: {
+            res = ExecArithCompareExprDispatcher(expr, std::greater<>{});
+            break;
+        }
+        case OpType::LessEqual: {
+            res = ExecArithCompareExprDispatcher(expr, std::less_equal<>{});
+            break;
+        }
+        case OpType::LessThan: {
+            res = ExecArithCompareExprDispatcher(expr, std::less<>{});
+            break;
+        }
+        default: {
+            PanicInfo("unsupported optype");
+        }
+    }
+    AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    bitset_opt_ = std::move(res);
+}
+
+// MatchJSONPath evaluates the predicate on the JSON value, without the negation of it
+static bool
+MatchJSONPath(const json& value, const JSONPathExpr& expr) {
+    if (IsJSONPathNull(value, expr.path_)) {
+        return false;
+    }
+    if (expr.op_type_ == OpType::Invalid) {
+        return true;
+    }
+    auto target = GetJSONPath(value, expr.path_);
+    int cmp = 0;
+    if (target->is_number()) {
+        auto y = std::get_if<double>(&expr.value_);
+        if (y == nullptr) {
+            return false;
+        }
+        auto x = target->get<double>();
+        cmp = x < *y ? -1 : (x > *y ? 1 : 0);
+    } else if (target->is_string()) {
+        auto y = std::get_if<std::string>(&expr.value_);
+        if (y == nullptr) {
+            return false;
+        }
+        cmp = target->get_ref<const std::string&>().compare(*y);
+    } else if (target->is_boolean()) {
+        auto y = std::get_if<bool>(&expr.value_);
+        if (y == nullptr || (expr.op_type_ != OpType::Equal && expr.op_type_ != OpType::NotEqual)) {
+            return false;
+        }
+        cmp = target->get<bool>() == *y ? 0 : 1;
+    } else {
+        return false;
+    }
+    switch (expr.op_type_) {
+        case OpType::Equal:
+            return cmp == 0;
+        case OpType::NotEqual:
+            return cmp != 0;
+        case OpType::GreaterThan:
+            return cmp > 0;
+        case OpType::GreaterEqual:
+            return cmp >= 0;
+        case OpType::LessThan:
+            return cmp < 0;
+        case OpType::LessEqual:
+            return cmp <= 0;
+        default:
+            return false;
+    }
+}
+
+void
+ExecExprVisitor::visit(JSONPathExpr& expr) {
+    auto& field_meta = segment_.get_schema()[expr.field_id_];
+    AssertInfo(expr.data_type_ == field_meta.get_data_type(),
+               "[ExecExprVisitor]DataType of expr isn't field_meta data type");
+    AssertInfo(expr.data_type_ == DataType::VARCHAR, "[ExecExprVisitor]JSON field isn't VarChar field");
+    auto elem_func = [&expr](const std::string& x) { return MatchJSONPath(ParseJSONRow(x), expr) != expr.not_; };
+    auto res = ExecDataRangeVisitorImpl<std::string>(expr.field_id_, elem_func);
+    AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    bitset_opt_ = std::move(res);
+}
+
+// ArrayElementEqual compares the numbers as int64 if both are integers, otherwise as double
+static bool
+ArrayElementEqual(const json& element, const ArrayExpr::ValueType& value) {
+    if (element.is_number()) {
+        auto element_is_int64 = element.is_number_integer() &&
+                                (!element.is_number_unsigned() ||
+                                 element.get<uint64_t>() <= static_cast<uint64_t>(std::numeric_limits<int64_t>::max()));
+        if (auto y = std::get_if<int64_t>(&value)) {
+            return element_is_int64 ? element.get<int64_t>() == *y : element.get<double>() == static_cast<double>(*y);
+        }
+        if (auto y = std::get_if<double>(&value)) {
+            return element.get<double>() == *y;
+        }
+        return false;
+    }
+    if (element.is_string()) {
+        auto y = std::get_if<std::string>(&value);
+        return y != nullptr && element.get_ref<const std::string&>() == *y;
+    }
+    if (element.is_boolean()) {
+        auto y = std::get_if<bool>(&value);
+        return y != nullptr && element.get<bool>() == *y;
+    }
+    return false;
+}
+
+// MatchArray evaluates the predicate on the JSON array, without the negation of it
+static bool
+MatchArray(const json& elements, const ArrayExpr& expr) {
+    if (expr.op_type_ == ArrayExpr::ArrayOpType::Length) {
+        auto n = static_cast<int64_t>(elements.size());
+        switch (expr.length_op_) {
+            case OpType::Equal:
+                return n == expr.length_;
+            case OpType::NotEqual:
+                return n != expr.length_;
+            case OpType::GreaterThan:
+                return n > expr.length_;
+            case OpType::GreaterEqual:
+                return n >= expr.length_;
+            case OpType::LessThan:
+                return n < expr.length_;
+            case OpType::LessEqual:
+                return n <= expr.length_;
+            default:
+                return false;
+        }
+    }
+    for (const auto& element : elements) {
+        for (const auto& value : expr.values_) {
+            if (ArrayElementEqual(element, value)) {
+                return true;
+            }
+        }
+    }
+    return false;
+}
+
+void
+ExecExprVisitor::visit(ArrayExpr& expr) {
+    auto& field_meta = segment_.get_schema()[expr.field_id_];
+    AssertInfo(expr.data_type_ == field_meta.get_data_type(),
+               "[ExecExprVisitor]DataType of expr isn't field_meta data type");
+    AssertInfo(expr.data_type_ == DataType::VARCHAR, "[ExecExprVisitor]Array field isn't VarChar field");
+    auto elem_func = [&expr](const std::string& x) { return MatchArray(ParseArrayRow(x), expr) != expr.not_; };
+    auto res = ExecDataRangeVisitorImpl<std::string>(expr.field_id_, elem_func);
+    AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    bitset_opt_ = std::move(res);
+}
 }  // namespace milvus::query
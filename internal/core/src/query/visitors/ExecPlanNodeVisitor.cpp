@@ -31,6 +31,11 @@ class ExecPlanNodeVisitor : PlanNodeVisitor {
         : segment_(segment), timestamp_(timestamp), placeholder_group_(placeholder_group) {
     }
 
+    void
+    set_expire_timestamp(Timestamp expire_timestamp) {
+        expire_timestamp_ = expire_timestamp;
+    }
+
     SearchResult
     get_moved_result(PlanNode& node) {
         assert(!search_result_opt_.has_value());
@@ -49,6 +54,7 @@ class ExecPlanNodeVisitor : PlanNodeVisitor {
  private:
     const segcore::SegmentInterface& segment_;
     Timestamp timestamp_;
+    Timestamp expire_timestamp_ = 0;
     const PlaceholderGroup& placeholder_group_;
 
     SearchResultOpt search_result_opt_;
@@ -97,6 +103,9 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
     }
 
     segment->mask_with_timestamps(bitset_holder, timestamp_);
+    if (expire_timestamp_ > 0) {
+        segment->mask_with_expiration(bitset_holder, expire_timestamp_);
+    }
     bitset_holder.flip();
 
     segment->mask_with_delete(bitset_holder, active_count, timestamp_);
@@ -127,6 +136,9 @@ ExecPlanNodeVisitor::visit(RetrievePlanNode& node) {
     }
 
     segment->mask_with_timestamps(bitset_holder, timestamp_);
+    if (expire_timestamp_ > 0) {
+        segment->mask_with_expiration(bitset_holder, expire_timestamp_);
+    }
     bitset_holder.flip();
 
     segment->mask_with_delete(bitset_holder, active_count, timestamp_);
@@ -64,4 +64,40 @@ ExtractInfoExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
     plan_info_.add_involved_field(expr.field_id_);
 }
 
+void
+ExtractInfoExprVisitor::visit(NullExpr& expr) {
+    plan_info_.add_involved_field(expr.field_id_);
+}
+
+static void
+ExtractArithOperandInfo(ExtractedPlanInfo& plan_info, const ArithOperand& operand) {
+    switch (operand.kind_) {
+        case ArithOperand::Kind::Column:
+            plan_info.add_involved_field(operand.field_id_);
+            break;
+        case ArithOperand::Kind::Arith:
+            ExtractArithOperandInfo(plan_info, *operand.left_);
+            ExtractArithOperandInfo(plan_info, *operand.right_);
+            break;
+        default:
+            break;
+    }
+}
+
+void
+ExtractInfoExprVisitor::visit(ArithCompareExpr& expr) {
+    ExtractArithOperandInfo(plan_info_, *expr.left_);
+    ExtractArithOperandInfo(plan_info_, *expr.right_);
+}
+
+void
+ExtractInfoExprVisitor::visit(JSONPathExpr& expr) {
+    plan_info_.add_involved_field(expr.field_id_);
+}
+
+void
+ExtractInfoExprVisitor::visit(ArrayExpr& expr) {
+    plan_info_.add_involved_field(expr.field_id_);
+}
+
 }  // namespace milvus::query
@@ -297,4 +297,100 @@ ShowExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
     }
 }
 
+void
+ShowExprVisitor::visit(NullExpr& expr) {
+    AssertInfo(!json_opt_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+    using proto::plan::NullExpr_NullOp;
+    using proto::plan::NullExpr_NullOp_Name;
+
+    Json res{{"expr_type", "Null"},
+             {"field_id", expr.field_id_.get()},
+             {"data_type", datatype_name(expr.data_type_)},
+             {"op", NullExpr_NullOp_Name(static_cast<NullExpr_NullOp>(expr.op_type_))},
+             {"path", expr.path_}};
+    json_opt_ = res;
+}
+
+static Json
+ArithOperandExtract(const ArithOperand& operand) {
+    using proto::plan::ArithOpType;
+    using proto::plan::ArithOpType_Name;
+    switch (operand.kind_) {
+        case ArithOperand::Kind::Column:
+            return Json{{"field_id", operand.field_id_.get()}, {"data_type", datatype_name(operand.data_type_)}};
+        case ArithOperand::Kind::Value:
+            if (operand.is_integer_) {
+                return Json{{"value", operand.int64_val_}};
+            }
+            return Json{{"value", operand.float_val_}};
+        case ArithOperand::Kind::Arith:
+            return Json{{"arith_op", ArithOpType_Name(static_cast<ArithOpType>(operand.arith_op_))},
+                        {"left", ArithOperandExtract(*operand.left_)},
+                        {"right", ArithOperandExtract(*operand.right_)}};
+        default:
+            PanicInfo("unsupported arithmetic operand");
+    }
+}
+
+void
+ShowExprVisitor::visit(ArithCompareExpr& expr) {
+    using proto::plan::OpType;
+    using proto::plan::OpType_Name;
+    AssertInfo(!json_opt_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+
+    Json res{{"expr_type", "ArithCompare"},
+             {"left", ArithOperandExtract(*expr.left_)},
+             {"right", ArithOperandExtract(*expr.right_)},
+             {"op", OpType_Name(static_cast<OpType>(expr.op_type_))}};
+    json_opt_ = res;
+}
+
+void
+ShowExprVisitor::visit(JSONPathExpr& expr) {
+    using proto::plan::OpType;
+    using proto::plan::OpType_Name;
+    AssertInfo(!json_opt_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+
+    auto value = std::visit(
+        [](const auto& v) -> Json {
+            if constexpr (std::is_same_v<std::decay_t<decltype(v)>, std::monostate>) {
+                return nullptr;
+            } else {
+                return v;
+            }
+        },
+        expr.value_);
+    Json res{{"expr_type", "JSONPath"},
+             {"field_id", expr.field_id_.get()},
+             {"data_type", datatype_name(expr.data_type_)},
+             {"path", expr.path_},
+             {"op", OpType_Name(static_cast<OpType>(expr.op_type_))},
+             {"value", std::move(value)},
+             {"not", expr.not_}};
+    json_opt_ = res;
+}
+
+void
+ShowExprVisitor::visit(ArrayExpr& expr) {
+    using proto::plan::ArrayExpr_ArrayOp;
+    using proto::plan::ArrayExpr_ArrayOp_Name;
+    using proto::plan::OpType;
+    using proto::plan::OpType_Name;
+    AssertInfo(!json_opt_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+
+    std::vector<Json> values;
+    for (auto& value : expr.values_) {
+        values.push_back(std::visit([](const auto& v) -> Json { return v; }, value));
+    }
+    Json res{{"expr_type", "Array"},
+             {"field_id", expr.field_id_.get()},
+             {"data_type", datatype_name(expr.data_type_)},
+             {"op", ArrayExpr_ArrayOp_Name(static_cast<ArrayExpr_ArrayOp>(expr.op_type_))},
+             {"values", values},
+             {"length_op", OpType_Name(static_cast<OpType>(expr.length_op_))},
+             {"length", expr.length_},
+             {"not", expr.not_}};
+    json_opt_ = res;
+}
+
 }  // namespace milvus::query
@@ -47,4 +47,24 @@ VerifyExprVisitor::visit(CompareExpr& expr) {
     // TODO
 }
 
+void
+VerifyExprVisitor::visit(NullExpr& expr) {
+    // TODO
+}
+
+void
+VerifyExprVisitor::visit(ArithCompareExpr& expr) {
+    // TODO
+}
+
+void
+VerifyExprVisitor::visit(JSONPathExpr& expr) {
+    // TODO
+}
+
+void
+VerifyExprVisitor::visit(ArrayExpr& expr) {
+    // TODO
+}
+
 }  // namespace milvus::query
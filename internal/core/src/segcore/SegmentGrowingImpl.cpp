@@ -402,4 +402,16 @@ SegmentGrowingImpl::mask_with_timestamps(BitsetType& bitset_chunk, Timestamp tim
     // DO NOTHING
 }
 
+void
+SegmentGrowingImpl::mask_with_expiration(BitsetType& bitset_chunk, Timestamp expire_timestamp) const {
+    // the bitset covers the active entities only
+    auto& ts_vec = this->insert_record_.timestamps_;
+    int64_t size = bitset_chunk.size();
+    for (int64_t i = 0; i < size; ++i) {
+        if (ts_vec[i] < expire_timestamp) {
+            bitset_chunk[i] = false;
+        }
+    }
+}
+
 }  // namespace milvus::segcore
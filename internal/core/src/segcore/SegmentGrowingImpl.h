@@ -162,6 +162,9 @@ class SegmentGrowingImpl : public SegmentGrowing {
     void
     mask_with_timestamps(BitsetType& bitset_chunk, Timestamp timestamp) const override;
 
+    void
+    mask_with_expiration(BitsetType& bitset_chunk, Timestamp expire_timestamp) const override;
+
     void
     vector_search(int64_t vec_count,
                   query::SearchInfo search_info,
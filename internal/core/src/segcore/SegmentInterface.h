@@ -136,6 +136,10 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual void
     mask_with_timestamps(BitsetType& bitset_chunk, Timestamp timestamp) const = 0;
 
+    // mask the entities inserted before expire_timestamp
+    virtual void
+    mask_with_expiration(BitsetType& bitset_chunk, Timestamp expire_timestamp) const = 0;
+
     // count of chunks
     virtual int64_t
     num_chunk() const = 0;
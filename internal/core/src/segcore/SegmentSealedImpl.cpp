@@ -737,4 +737,16 @@ SegmentSealedImpl::mask_with_timestamps(BitsetType& bitset_chunk, Timestamp time
     bitset_chunk &= mask;
 }
 
+void
+SegmentSealedImpl::mask_with_expiration(BitsetType& bitset_chunk, Timestamp expire_timestamp) const {
+    AssertInfo(insert_record_.timestamps_.num_chunk() == 1, "num chunk not equal to 1 for sealed segment");
+    auto timestamps_data = insert_record_.timestamps_.get_chunk(0);
+    auto size = std::min<int64_t>(bitset_chunk.size(), timestamps_data.size());
+    for (int64_t i = 0; i < size; ++i) {
+        if (timestamps_data[i] < expire_timestamp) {
+            bitset_chunk[i] = false;
+        }
+    }
+}
+
 }  // namespace milvus::segcore
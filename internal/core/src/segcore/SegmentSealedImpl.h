@@ -128,6 +128,9 @@ class SegmentSealedImpl : public SegmentSealed {
     void
     mask_with_timestamps(BitsetType& bitset_chunk, Timestamp timestamp) const override;
 
+    void
+    mask_with_expiration(BitsetType& bitset_chunk, Timestamp expire_timestamp) const override;
+
     void
     vector_search(int64_t vec_count,
                   query::SearchInfo search_info,
@@ -306,6 +306,11 @@ get_deleted_bitmap(int64_t del_barrier,
         for (auto iter = iter_b; iter != iter_e; ++iter) {
             auto insert_row_offset = iter->second;
             AssertInfo(insert_row_offset < insert_barrier, "Timestamp offset is larger than insert barrier");
+            // the deletion only applies to the rows inserted at or before it, the same as compaction, so the rows
+            // inserted again after the deletion are kept
+            if (insert_record.timestamps_[insert_row_offset] > delete_record.timestamps_[del_index]) {
+                continue;
+            }
             if (delete_record.timestamps_[del_index] > query_timestamp) {
                 // the deletion record do not take effect in search/query, and reset bitmap to 0
                 bitmap->reset(insert_row_offset);
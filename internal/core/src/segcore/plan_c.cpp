@@ -116,6 +116,12 @@ GetMetricType(CSearchPlan plan) {
     return strdup(metric_str.c_str());
 }
 
+void
+SetSearchPlanExpireTimestamp(CSearchPlan c_plan, uint64_t expire_timestamp) {
+    auto plan = (milvus::query::Plan*)c_plan;
+    plan->expire_timestamp_ = expire_timestamp;
+}
+
 void
 DeleteSearchPlan(CSearchPlan cPlan) {
     auto plan = (milvus::query::Plan*)cPlan;
@@ -159,6 +165,12 @@ CreateRetrievePlanByExpr(CCollection c_col,
     }
 }
 
+void
+SetRetrievePlanExpireTimestamp(CRetrievePlan c_plan, uint64_t expire_timestamp) {
+    auto plan = (milvus::query::RetrievePlan*)c_plan;
+    plan->expire_timestamp_ = expire_timestamp;
+}
+
 void
 DeleteRetrievePlan(CRetrievePlan c_plan) {
     auto plan = (milvus::query::RetrievePlan*)c_plan;
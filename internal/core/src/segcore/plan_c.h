@@ -45,6 +45,10 @@ GetTopK(CSearchPlan plan);
 const char*
 GetMetricType(CSearchPlan plan);
 
+// the entities inserted before expire_timestamp are filtered from the search results
+void
+SetSearchPlanExpireTimestamp(CSearchPlan plan, uint64_t expire_timestamp);
+
 void
 DeleteSearchPlan(CSearchPlan plan);
 
@@ -57,6 +61,10 @@ CreateRetrievePlanByExpr(CCollection c_col,
                          const int64_t size,
                          CRetrievePlan* res_plan);
 
+// the entities inserted before expire_timestamp are filtered from the retrieve results
+void
+SetRetrievePlanExpireTimestamp(CRetrievePlan plan, uint64_t expire_timestamp);
+
 void
 DeleteRetrievePlan(CRetrievePlan plan);
 
@@ -10,12 +10,15 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #include <boost/format.hpp>
+#include <google/protobuf/text_format.h>
 #include <gtest/gtest.h>
 #include <regex>
+#include <set>
 
 #include "query/Expr.h"
 #include "query/Plan.h"
 #include "query/PlanNode.h"
+#include "query/PlanProto.h"
 #include "query/generated/ShowPlanNodeVisitor.h"
 #include "query/generated/ExecExprVisitor.h"
 #include "segcore/SegmentGrowingImpl.h"
@@ -680,6 +683,56 @@ TEST(Expr, TestBinaryArithOpEvalRange) {
             }
         })",
          [](int64_t v) { return (v + 500) != 2500; }, DataType::INT64},
+        // Add test cases for BinaryArithOpEvalRangeExpr GT, GE, LT and LE of various data types
+        {R"("GT": {
+            "ADD": {
+                "right_operand": 4,
+                "value": 8
+            }
+        })",
+         [](int8_t v) { return (v + 4) > 8; }, DataType::INT8},
+        {R"("GE": {
+            "SUB": {
+                "right_operand": 500,
+                "value": 1500
+            }
+        })",
+         [](int16_t v) { return (v - 500) >= 1500; }, DataType::INT16},
+        {R"("LT": {
+            "MUL": {
+                "right_operand": 2,
+                "value": 4000
+            }
+        })",
+         [](int32_t v) { return (v * 2) < 4000; }, DataType::INT32},
+        {R"("LE": {
+            "DIV": {
+                "right_operand": 2,
+                "value": 1000
+            }
+        })",
+         [](int64_t v) { return (v / 2) <= 1000; }, DataType::INT64},
+        {R"("GT": {
+            "MOD": {
+                "right_operand": 100,
+                "value": 50
+            }
+        })",
+         [](int32_t v) { return (v % 100) > 50; }, DataType::INT32},
+        {R"("LT": {
+            "ADD": {
+                "right_operand": 500,
+                "value": 2500
+            }
+        })",
+         [](float v) { return (v + 500) < 2500; }, DataType::FLOAT},
+        {R"("GE": {
+            "SUB": {
+                "right_operand": 500,
+                "value": 2500
+            }
+        })",
+         [](double v) { return (v - 500) >= 2500; }, DataType::DOUBLE},
     };
 
     std::string dsl_string_tmp = R"({
@@ -960,3 +1013,355 @@ TEST(Expr, TestBinaryArithOpEvalRangeExceptions) {
         }
     }
 }
+
+TEST(Expr, TestArithCompare) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    auto i32_fid = schema->AddDebugField("age1", DataType::INT32);
+    auto i64_fid = schema->AddDebugField("age2", DataType::INT64);
+    auto double_fid = schema->AddDebugField("age3", DataType::DOUBLE);
+    schema->set_primary_field_id(i64_fid);
+
+    auto column = [](FieldId field_id, const std::string& data_type) {
+        return boost::str(boost::format(R"(column_expr: < info: < field_id: %1% data_type: %2% > >)") %
+                          field_id.get() % data_type);
+    };
+    auto arith = [](const std::string& left, const std::string& right, const std::string& op) {
+        return boost::str(boost::format(R"(binary_arith_expr: < left: < %1% > right: < %2% > op: %3% >)") % left %
+                          right % op);
+    };
+    auto age1 = column(i32_fid, "Int32");
+    auto age2 = column(i64_fid, "Int64");
+    auto age3 = column(double_fid, "Double");
+
+    std::vector<std::tuple<std::string, std::string, std::string,
+                           std::function<bool(int32_t, int64_t, double)>>>
+        testcases = {
+            // age1 + age2 > age3 * 2
+            {arith(age1, age2, "Add"), arith(age3, R"(value_expr: < value: < float_val: 2 > >)", "Mul"), "GreaterThan",
+             [](int32_t a, int64_t b, double c) { return static_cast<double>(a + b) > c * 2; }},
+            // age2 - age1 <= 100
+            {arith(age2, age1, "Sub"), R"(value_expr: < value: < int64_val: 100 > >)", "LessEqual",
+             [](int32_t a, int64_t b, double c) { return b - a <= 100; }},
+            // age2 % age1 == 0, the rows of age1 == 0 don't match
+            {arith(age2, age1, "Mod"), R"(value_expr: < value: < int64_val: 0 > >)", "Equal",
+             [](int32_t a, int64_t b, double c) { return a != 0 && b % a == 0; }},
+            // age2 / (age1 - age1) != 0 divides by zero
+            {arith(age2, arith(age1, age1, "Sub"), "Div"), R"(value_expr: < value: < int64_val: 0 > >)", "NotEqual",
+             [](int32_t a, int64_t b, double c) { return false; }},
+            // age1 * 3 != age2 / 2, the integers are divided as integers
+            {arith(age1, R"(value_expr: < value: < int64_val: 3 > >)", "Mul"),
+             arith(age2, R"(value_expr: < value: < int64_val: 2 > >)", "Div"), "NotEqual",
+             [](int32_t a, int64_t b, double c) { return static_cast<int64_t>(a) * 3 != b / 2; }},
+        };
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    std::vector<int32_t> age1_col;
+    std::vector<int64_t> age2_col;
+    std::vector<double> age3_col;
+    int num_iters = 10;
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        auto new_age1_col = raw_data.get_col<int32_t>(i32_fid);
+        auto new_age2_col = raw_data.get_col<int64_t>(i64_fid);
+        auto new_age3_col = raw_data.get_col<double>(double_fid);
+        age1_col.insert(age1_col.end(), new_age1_col.begin(), new_age1_col.end());
+        age2_col.insert(age2_col.end(), new_age2_col.begin(), new_age2_col.end());
+        age3_col.insert(age3_col.end(), new_age3_col.begin(), new_age3_col.end());
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto [left, right, op, ref_func] : testcases) {
+        auto proto_text = boost::str(boost::format(R"(
+vector_anns: <
+  field_id: %1%
+  predicates: <
+    arith_compare_expr: <
+      left: < %2% >
+      right: < %3% >
+      op: %4%
+    >
+  >
+  query_info: <
+    topk: 10
+    round_decimal: 3
+    metric_type: "L2"
+    search_params: "{\"nprobe\": 10}"
+  >
+  placeholder_tag: "$0"
+>
+)") % vec_fid.get() % left % right % op);
+        proto::plan::PlanNode node_proto;
+        ASSERT_TRUE(google::protobuf::TextFormat::ParseFromString(proto_text, &node_proto)) << proto_text;
+        auto plan = ProtoParser(*schema).CreatePlan(node_proto);
+        auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+        EXPECT_EQ(final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            auto ans = final[i];
+            auto ref = ref_func(age1_col[i], age2_col[i], age3_col[i]);
+            ASSERT_EQ(ans, ref) << proto_text << "@" << i << "!!"
+                                << boost::format("[%1%, %2%, %3%]") % age1_col[i] % age2_col[i] % age3_col[i];
+        }
+    }
+}
+
+TEST(Expr, TestNull) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    auto json_fid = schema->AddDebugField("info", DataType::VARCHAR);
+    auto i64_fid = schema->AddDebugField("age", DataType::INT64);
+    schema->set_primary_field_id(i64_fid);
+
+    // the rows of the JSON field, and whether the path ["a", "b"] of them is null
+    std::vector<std::tuple<std::string, bool>> rows = {
+        {R"({"a": {"b": 1}})", false},  {R"({"a": {"b": "x"}})", false}, {R"({"a": {"b": {}}})", false},
+        {R"({"a": {"b": null}})", true}, {R"({"a": {"c": 1}})", true},    {R"({"a": 1})", true},
+        {R"({"b": 1})", true},           {R"({})", true},                 {"", true},
+    };
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    std::vector<bool> null_col;
+    int num_iters = 10;
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        for (auto& field_data : *raw_data.raw_->mutable_fields_data()) {
+            if (field_data.field_id() != json_fid.get()) {
+                continue;
+            }
+            auto data = field_data.mutable_scalars()->mutable_string_data()->mutable_data();
+            for (int i = 0; i < N; ++i) {
+                auto& [row, is_null] = rows[(iter * N + i) % rows.size()];
+                *data->Mutable(i) = row;
+                null_col.push_back(is_null);
+            }
+        }
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+
+    std::vector<std::tuple<FieldId, std::string, std::string, std::string, std::function<bool(bool)>>> testcases = {
+        {json_fid, "VarChar", "IsNull", R"(path: "a" path: "b")", [](bool is_null) { return is_null; }},
+        {json_fid, "VarChar", "IsNotNull", R"(path: "a" path: "b")", [](bool is_null) { return !is_null; }},
+        // the fields aren't nullable
+        {i64_fid, "Int64", "IsNull", "", [](bool is_null) { return false; }},
+        {i64_fid, "Int64", "IsNotNull", "", [](bool is_null) { return true; }},
+    };
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto [field_id, data_type, op, path, ref_func] : testcases) {
+        auto proto_text = boost::str(boost::format(R"(
+vector_anns: <
+  field_id: %1%
+  predicates: <
+    null_expr: <
+      column_info: <
+        field_id: %2%
+        data_type: %3%
+      >
+      op: %4%
+      %5%
+    >
+  >
+  query_info: <
+    topk: 10
+    round_decimal: 3
+    metric_type: "L2"
+    search_params: "{\"nprobe\": 10}"
+  >
+  placeholder_tag: "$0"
+>
+)") % vec_fid.get() % field_id.get() % data_type % op % path);
+        proto::plan::PlanNode node_proto;
+        ASSERT_TRUE(google::protobuf::TextFormat::ParseFromString(proto_text, &node_proto)) << proto_text;
+        auto plan = ProtoParser(*schema).CreatePlan(node_proto);
+        auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+        EXPECT_EQ(final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            ASSERT_EQ(final[i], ref_func(null_col[i])) << proto_text << "@" << i;
+        }
+    }
+}
+
+TEST(Expr, TestJSONPath) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    auto json_fid = schema->AddDebugField("meta", DataType::VARCHAR);
+    auto i64_fid = schema->AddDebugField("age", DataType::INT64);
+    schema->set_primary_field_id(i64_fid);
+
+    std::vector<std::string> rows = {
+        R"({"a": {"b": 1}})",   R"({"a": {"b": 2.5}})", R"({"a": {"b": "1"}})", R"({"a": {"b": true}})",
+        R"({"a": {"b": null}})", R"({"a": {"c": 1}})",   R"({"a": 1})",          "",
+    };
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    std::vector<std::string> json_col;
+    int num_iters = 10;
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        for (auto& field_data : *raw_data.raw_->mutable_fields_data()) {
+            if (field_data.field_id() != json_fid.get()) {
+                continue;
+            }
+            auto data = field_data.mutable_scalars()->mutable_string_data()->mutable_data();
+            for (int i = 0; i < N; ++i) {
+                auto& row = rows[(iter * N + i) % rows.size()];
+                *data->Mutable(i) = row;
+                json_col.push_back(row);
+            }
+        }
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+
+    // the predicates on meta["a"]["b"], and the rows matching them
+    std::vector<std::tuple<std::string, std::set<std::string>>> testcases = {
+        {R"(op: Equal value: < int64_val: 1 >)", {rows[0]}},
+        {R"(op: Equal value: < float_val: 1 >)", {rows[0]}},
+        {R"(op: GreaterThan value: < float_val: 1 >)", {rows[1]}},
+        {R"(op: NotEqual value: < float_val: 1 >)", {rows[1]}},
+        {R"(op: LessEqual value: < string_val: "1" >)", {rows[2]}},
+        {R"(op: Equal value: < bool_val: true >)", {rows[3]}},
+        {R"(op: NotEqual value: < bool_val: false >)", {rows[3]}},
+        {R"(op: Invalid)", {rows[0], rows[1], rows[2], rows[3]}},
+        {R"(op: Invalid not: true)", {rows[4], rows[5], rows[6], rows[7]}},
+        {R"(op: Equal value: < int64_val: 1 > not: true)",
+         {rows[1], rows[2], rows[3], rows[4], rows[5], rows[6], rows[7]}},
+    };
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto [predicate, matched] : testcases) {
+        auto proto_text = boost::str(boost::format(R"(
+vector_anns: <
+  field_id: %1%
+  predicates: <
+    json_path_expr: <
+      column_info: <
+        field_id: %2%
+        data_type: VarChar
+      >
+      path: "a"
+      path: "b"
+      %3%
+    >
+  >
+  query_info: <
+    topk: 10
+    round_decimal: 3
+    metric_type: "L2"
+    search_params: "{\"nprobe\": 10}"
+  >
+  placeholder_tag: "$0"
+>
+)") % vec_fid.get() % json_fid.get() % predicate);
+        proto::plan::PlanNode node_proto;
+        ASSERT_TRUE(google::protobuf::TextFormat::ParseFromString(proto_text, &node_proto)) << proto_text;
+        auto plan = ProtoParser(*schema).CreatePlan(node_proto);
+        auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+        EXPECT_EQ(final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            ASSERT_EQ(final[i], matched.count(json_col[i]) > 0) << predicate << "@" << i << "!!" << json_col[i];
+        }
+    }
+}
+
+TEST(Expr, TestArray) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    auto array_fid = schema->AddDebugField("tags", DataType::VARCHAR);
+    auto i64_fid = schema->AddDebugField("age", DataType::INT64);
+    schema->set_primary_field_id(i64_fid);
+
+    std::vector<std::string> rows = {
+        R"([1, 2])", R"([2.5])", R"(["a", "b", "c"])", R"([true, null])", R"([])", "",
+    };
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    std::vector<std::string> array_col;
+    int num_iters = 10;
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        for (auto& field_data : *raw_data.raw_->mutable_fields_data()) {
+            if (field_data.field_id() != array_fid.get()) {
+                continue;
+            }
+            auto data = field_data.mutable_scalars()->mutable_string_data()->mutable_data();
+            for (int i = 0; i < N; ++i) {
+                auto& row = rows[(iter * N + i) % rows.size()];
+                *data->Mutable(i) = row;
+                array_col.push_back(row);
+            }
+        }
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+
+    // the predicates on tags, and the rows matching them
+    std::vector<std::tuple<std::string, std::set<std::string>>> testcases = {
+        {R"(op: Contains values: < int64_val: 2 >)", {rows[0]}},
+        {R"(op: Contains values: < float_val: 2 >)", {rows[0]}},
+        {R"(op: Contains values: < float_val: 2.5 >)", {rows[1]}},
+        {R"(op: Contains values: < string_val: "2" >)", {}},
+        {R"(op: ContainsAny values: < string_val: "c" > values: < bool_val: true >)", {rows[2], rows[3]}},
+        {R"(op: ContainsAny values: < int64_val: 1 > values: < bool_val: false > not: true)",
+         {rows[1], rows[2], rows[3], rows[4], rows[5]}},
+        {R"(op: Length length_op: Equal length: 0)", {rows[4], rows[5]}},
+        {R"(op: Length length_op: GreaterEqual length: 2)", {rows[0], rows[2], rows[3]}},
+        {R"(op: Length length_op: LessThan length: 3 not: true)", {rows[2]}},
+    };
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto [predicate, matched] : testcases) {
+        auto proto_text = boost::str(boost::format(R"(
+vector_anns: <
+  field_id: %1%
+  predicates: <
+    array_expr: <
+      column_info: <
+        field_id: %2%
+        data_type: VarChar
+      >
+      %3%
+    >
+  >
+  query_info: <
+    topk: 10
+    round_decimal: 3
+    metric_type: "L2"
+    search_params: "{\"nprobe\": 10}"
+  >
+  placeholder_tag: "$0"
+>
+)") % vec_fid.get() % array_fid.get() % predicate);
+        proto::plan::PlanNode node_proto;
+        ASSERT_TRUE(google::protobuf::TextFormat::ParseFromString(proto_text, &node_proto)) << proto_text;
+        auto plan = ProtoParser(*schema).CreatePlan(node_proto);
+        auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+        EXPECT_EQ(final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            ASSERT_EQ(final[i], matched.count(array_col[i]) > 0) << predicate << "@" << i << "!!" << array_col[i];
+        }
+    }
+}
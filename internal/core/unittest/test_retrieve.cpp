@@ -10,6 +10,7 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #include <gtest/gtest.h>
+#include <set>
 
 #include "query/ExprImpl.h"
 #include "segcore/ScalarIndex.h"
@@ -272,6 +273,40 @@ TEST(Retrieve, LargeTimestamp) {
     }
 }
 
+TEST(Retrieve, Expiration) {
+    auto schema = std::make_shared<Schema>();
+    auto fid_64 = schema->AddDebugField("i64", DataType::INT64);
+    auto DIM = 16;
+    auto fid_vec = schema->AddDebugField("vector_64", DataType::VECTOR_FLOAT, DIM, MetricType::METRIC_L2);
+    schema->set_primary_field_id(fid_64);
+
+    int64_t N = 100;
+    int64_t req_size = 10;
+    int choose_sep = 3;
+    auto choose = [=](int i) { return i * choose_sep % N; };
+    auto dataset = DataGen(schema, N);
+    auto segment = CreateSealedSegment(schema);
+    SealedLoader(dataset, *segment);
+    auto i64_col = dataset.get_col<int64_t>(fid_64);
+
+    auto plan = std::make_unique<query::RetrievePlan>(*schema);
+    std::vector<int64_t> values;
+    for (int i = 0; i < req_size; ++i) {
+        values.emplace_back(i64_col[choose(i)]);
+    }
+    auto term_expr = std::make_unique<query::TermExprImpl<int64_t>>(fid_64, DataType::INT64, values);
+    plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+    plan->plan_node_->predicate_ = std::move(term_expr);
+    plan->field_ids_ = std::vector<FieldId>{fid_64};
+
+    // the entities at timestamp 0, 3, 6 and 9 are expired
+    plan->expire_timestamp_ = 10;
+    auto retrieve_results = segment->Retrieve(plan.get(), N);
+    Assert(retrieve_results->fields_data_size() == 1);
+    auto field0_data = retrieve_results->fields_data(0).scalars().long_data();
+    ASSERT_EQ(field0_data.data_size(), req_size - 4);
+}
+
 TEST(Retrieve, Delete) {
     auto schema = std::make_shared<Schema>();
     auto fid_64 = schema->AddDebugField("i64", DataType::INT64);
@@ -366,3 +401,120 @@ TEST(Retrieve, Delete) {
         ASSERT_EQ(field1_data.data_size(), DIM * size);
     }
 }
+
+TEST(Retrieve, DeleteAtInsertTimestamp) {
+    auto schema = std::make_shared<Schema>();
+    auto fid_64 = schema->AddDebugField("i64", DataType::INT64);
+    auto DIM = 16;
+    schema->AddDebugField("vector_64", DataType::VECTOR_FLOAT, DIM, MetricType::METRIC_L2);
+    schema->set_primary_field_id(fid_64);
+
+    // the pk and the timestamp of the i-th row are both i
+    int64_t N = 10;
+    auto dataset = DataGen(schema, N);
+    auto i64_col = dataset.get_col<int64_t>(fid_64);
+
+    auto plan = std::make_unique<query::RetrievePlan>(*schema);
+    std::vector<int64_t> values(i64_col.begin(), i64_col.end());
+    auto term_expr = std::make_unique<query::TermExprImpl<int64_t>>(fid_64, DataType::INT64, values);
+    plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+    plan->plan_node_->predicate_ = std::move(term_expr);
+    plan->field_ids_ = std::vector<FieldId>{fid_64};
+
+    // the deletion applies to the rows inserted at or before it: pk 3 and pk 4 inserted at the timestamp of the deletion
+    // are deleted, pk 5 inserted after it is kept
+    auto retrieve_pks = [&](SegmentInterface& segment, Timestamp timestamp) {
+        auto retrieve_results = segment.Retrieve(plan.get(), timestamp);
+        auto field0_data = retrieve_results->fields_data(0).scalars().long_data();
+        return std::set<int64_t>(field0_data.data().begin(), field0_data.data().end());
+    };
+    auto check = [&](SegmentInterface& segment) {
+        int64_t del_count = 3;
+        std::vector<idx_t> del_pks{3, 4, 5};
+        auto ids = std::make_unique<IdArray>();
+        ids->mutable_int_id()->mutable_data()->Add(del_pks.begin(), del_pks.end());
+        std::vector<Timestamp> del_timestamps{4, 4, 4};
+        auto reserved_offset = segment.PreDelete(del_count);
+        segment.Delete(reserved_offset, del_count, ids.get(), del_timestamps.data());
+
+        auto pks = retrieve_pks(segment, 100);
+        ASSERT_EQ(pks.size(), static_cast<size_t>(N - 2));
+        ASSERT_EQ(pks.count(3), 0);
+        ASSERT_EQ(pks.count(4), 0);
+        ASSERT_EQ(pks.count(5), 1);
+
+        ASSERT_EQ(retrieve_pks(segment, 4), (std::set<int64_t>{0, 1, 2}));
+        // the deletion is not visible before its timestamp
+        ASSERT_EQ(retrieve_pks(segment, 3), (std::set<int64_t>{0, 1, 2, 3}));
+    };
+
+    auto sealed = CreateSealedSegment(schema);
+    SealedLoader(dataset, *sealed);
+    check(*sealed);
+
+    auto growing = CreateGrowingSegment(schema);
+    auto reserved_begin = growing->PreInsert(N);
+    growing->Insert(reserved_begin, N, dataset.row_ids_.data(), dataset.timestamps_.data(), dataset.raw_);
+    check(*growing);
+}
+
+TEST(Retrieve, DeleteReplay) {
+    auto schema = std::make_shared<Schema>();
+    auto fid_64 = schema->AddDebugField("i64", DataType::INT64);
+    auto DIM = 16;
+    schema->AddDebugField("vector_64", DataType::VECTOR_FLOAT, DIM, MetricType::METRIC_L2);
+    schema->set_primary_field_id(fid_64);
+
+    // the pk and the timestamp of the i-th row are both i
+    int64_t N = 10;
+    auto dataset = DataGen(schema, N);
+    auto i64_col = dataset.get_col<int64_t>(fid_64);
+
+    auto plan = std::make_unique<query::RetrievePlan>(*schema);
+    std::vector<int64_t> values(i64_col.begin(), i64_col.end());
+    auto term_expr = std::make_unique<query::TermExprImpl<int64_t>>(fid_64, DataType::INT64, values);
+    plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+    plan->plan_node_->predicate_ = std::move(term_expr);
+    plan->field_ids_ = std::vector<FieldId>{fid_64};
+
+    auto retrieve_pks = [&](SegmentInterface& segment, Timestamp timestamp) {
+        auto retrieve_results = segment.Retrieve(plan.get(), timestamp);
+        auto field0_data = retrieve_results->fields_data(0).scalars().long_data();
+        return std::multiset<int64_t>(field0_data.data().begin(), field0_data.data().end());
+    };
+
+    // the plain deletions replayed at timestamp 5, pk 7 is inserted after them
+    int64_t del_count = 4;
+    std::vector<idx_t> del_pks{0, 1, 2, 7};
+    std::vector<Timestamp> del_timestamps(del_count, 5);
+    auto del_ids = std::make_unique<IdArray>();
+    del_ids->mutable_int_id()->mutable_data()->Add(del_pks.begin(), del_pks.end());
+    auto check = [&](SegmentInterface& segment) {
+        ASSERT_EQ(retrieve_pks(segment, 4), (std::multiset<int64_t>{0, 1, 2, 3, 4}));
+        ASSERT_EQ(retrieve_pks(segment, 100), (std::multiset<int64_t>{3, 4, 5, 6, 7, 8, 9}));
+    };
+
+    // the growing segment consumes the deletions from the dml channel
+    auto growing = CreateGrowingSegment(schema);
+    auto reserved_begin = growing->PreInsert(N);
+    growing->Insert(reserved_begin, N, dataset.row_ids_.data(), dataset.timestamps_.data(), dataset.raw_);
+    auto reserved_offset = growing->PreDelete(del_count);
+    growing->Delete(reserved_offset, del_count, del_ids.get(), del_timestamps.data());
+    check(*growing);
+
+    // the sealed segment handed off loads the same deletions from the delta logs
+    auto sealed = CreateSealedSegment(schema);
+    SealedLoader(dataset, *sealed);
+    LoadDeletedRecordInfo info = {del_timestamps.data(), del_ids.get(), del_count};
+    sealed->LoadDeletedRecord(info);
+    check(*sealed);
+
+    // pk 0, 1 and 2 inserted again at timestamp 20, 21 and 22 are visible after that
+    int64_t reinsert_count = 3;
+    auto reinserted = DataGen(schema, reinsert_count, 42, 20);
+    reserved_begin = growing->PreInsert(reinsert_count);
+    growing->Insert(reserved_begin, reinsert_count, reinserted.row_ids_.data(), reinserted.timestamps_.data(),
+                    reinserted.raw_);
+    ASSERT_EQ(retrieve_pks(*growing, 19), (std::multiset<int64_t>{3, 4, 5, 6, 7, 8, 9}));
+    ASSERT_EQ(retrieve_pks(*growing, 100), (std::multiset<int64_t>{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}));
+}
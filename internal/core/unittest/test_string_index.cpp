@@ -103,6 +103,18 @@ TEST_F(StringIndexMarisaTest, PrefixMatch) {
     }
 }
 
+TEST_F(StringIndexMarisaTest, PostfixMatch) {
+    auto index = milvus::scalar::CreateStringIndexMarisa();
+    index->BuildWithDataset(str_ds);
+
+    for (size_t i = 0; i < strs.size(); i++) {
+        auto str = strs[i];
+        auto bitset = index->PostfixMatch(str);
+        ASSERT_EQ(bitset->size(), strs.size());
+        ASSERT_TRUE(bitset->test(i));
+    }
+}
+
 TEST_F(StringIndexMarisaTest, Query) {
     auto index = milvus::scalar::CreateStringIndexMarisa();
     index->BuildWithDataset(str_ds);
@@ -129,6 +129,16 @@ func (c *channelStateTimer) removeTimers(channels []string) {
 	}
 }
 
+// hasRunningTimers returns whether there are channels waiting for the acks of datanodes
+func (c *channelStateTimer) hasRunningTimers() bool {
+	running := false
+	c.runningTimers.Range(func(_, _ interface{}) bool {
+		running = true
+		return false
+	})
+	return running
+}
+
 func (c *channelStateTimer) stopIfExsit(e *ackEvent) {
 	stop, ok := c.runningTimers.LoadAndDelete(e.channelName)
 	if ok && e.ackType != watchTimeoutAck && e.ackType != releaseTimeoutAck {
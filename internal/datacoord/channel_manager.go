@@ -52,11 +52,15 @@ type ChannelManager struct {
 	assignPolicy     ChannelAssignPolicy
 	reassignPolicy   ChannelReassignPolicy
 	bgChecker        ChannelBGChecker
+	balancePolicy    ChannelBalancePolicy
 	msgstreamFactory msgstream.Factory
 
-	stateChecker channelStateChecker
-	stopChecker  context.CancelFunc
-	stateTimer   *channelStateTimer
+	stateChecker   channelStateChecker
+	balanceChecker channelBalanceChecker
+	stopChecker    context.CancelFunc
+	stateTimer     *channelStateTimer
+
+	balanceTargets map[string]int64 // channel name -> node to watch the channel once released for balance
 }
 
 type channel struct {
@@ -83,6 +87,10 @@ func withStateChecker() ChannelManagerOpt {
 	return func(c *ChannelManager) { c.stateChecker = c.watchChannelStatesLoop }
 }
 
+func withBalanceChecker() ChannelManagerOpt {
+	return func(c *ChannelManager) { c.balanceChecker = c.balanceChannelsLoop }
+}
+
 // NewChannelManager creates and returns a new ChannelManager instance.
 func NewChannelManager(
 	kv kv.MetaKv, // for TxnKv and MetaKv
@@ -90,11 +98,12 @@ func NewChannelManager(
 	options ...ChannelManagerOpt,
 ) (*ChannelManager, error) {
 	c := &ChannelManager{
-		ctx:        context.TODO(),
-		h:          h,
-		factory:    NewChannelPolicyFactoryV1(kv),
-		store:      NewChannelStore(kv),
-		stateTimer: newChannelStateTimer(kv),
+		ctx:            context.TODO(),
+		h:              h,
+		factory:        NewChannelPolicyFactoryV1(kv),
+		store:          NewChannelStore(kv),
+		stateTimer:     newChannelStateTimer(kv),
+		balanceTargets: make(map[string]int64),
 	}
 
 	if err := c.store.Reload(); err != nil {
@@ -110,6 +119,11 @@ func NewChannelManager(
 	c.assignPolicy = c.factory.NewAssignPolicy()
 	c.reassignPolicy = c.factory.NewReassignPolicy()
 	c.bgChecker = c.factory.NewBgChecker()
+	c.balancePolicy = c.factory.NewBalancePolicy()
+	if c.balanceChecker != nil {
+		// channels are moved to the new nodes by the balance checker, which releases them from the old nodes first
+		c.registerPolicy = BufferChannelAssignPolicy
+	}
 	return c, nil
 }
 
@@ -148,11 +162,17 @@ func (c *ChannelManager) Startup(ctx context.Context, nodes []int64) error {
 	// Unwatch and drop channel with drop flag.
 	c.unwatchDroppedChannels()
 
-	if c.stateChecker != nil {
+	if c.stateChecker != nil || c.balanceChecker != nil {
 		ctx1, cancel := context.WithCancel(ctx)
 		c.stopChecker = cancel
-		go c.stateChecker(ctx1)
-		log.Debug("starting etcd states checker")
+		if c.stateChecker != nil {
+			go c.stateChecker(ctx1)
+			log.Debug("starting etcd states checker")
+		}
+		if c.balanceChecker != nil {
+			go c.balanceChecker(ctx1)
+			log.Debug("starting channel balance checker")
+		}
 	}
 
 	log.Info("cluster start up",
@@ -613,10 +633,7 @@ func (c *ChannelManager) cleanUpAndDelete(nodeID UniqueID, channelName string) e
 	}
 
 	if !c.isMarkedDrop(channelName) {
-		reallocates := &NodeChannelInfo{nodeID, []*channel{chToCleanUp}}
-
-		// reassign policy won't choose the same Node for a ressignment of a channel
-		updates := c.reassignPolicy(c.store, []*NodeChannelInfo{reallocates})
+		updates := c.getReassignUpdates(nodeID, chToCleanUp)
 		if len(updates) <= 0 {
 			log.Warn("fail to reassign channel to other nodes, add channel to buffer", zap.String("channel name", channelName))
 			updates.Add(bufferID, []*channel{chToCleanUp})
@@ -735,10 +752,7 @@ func (c *ChannelManager) toDelete(nodeID UniqueID, channelName string) error {
 	}
 
 	if !c.isMarkedDrop(channelName) {
-		reallocates := &NodeChannelInfo{nodeID, []*channel{ch}}
-
-		// reassign policy won't choose the same Node for a ressignment of a channel
-		updates := c.reassignPolicy(c.store, []*NodeChannelInfo{reallocates})
+		updates := c.getReassignUpdates(nodeID, ch)
 		if len(updates) <= 0 {
 			log.Warn("fail to reassign channel to other nodes, add to the buffer", zap.String("channel name", channelName))
 			updates.Add(bufferID, []*channel{ch})
@@ -766,6 +780,73 @@ func (c *ChannelManager) toDelete(nodeID UniqueID, channelName string) error {
 	return nil
 }
 
+// getReassignUpdates returns the updates to watch the channel released from the node. The channel goes to the
+// node picked by balance if any, otherwise reassign policy decides, which won't choose the same node.
+func (c *ChannelManager) getReassignUpdates(nodeID UniqueID, ch *channel) ChannelOpSet {
+	target, ok := c.balanceTargets[ch.Name]
+	delete(c.balanceTargets, ch.Name)
+	if ok && target != nodeID && c.store.GetNode(target) != nil {
+		var updates ChannelOpSet
+		updates.Add(target, []*channel{ch})
+		return updates
+	}
+
+	reallocates := &NodeChannelInfo{nodeID, []*channel{ch}}
+	return c.reassignPolicy(c.store, []*NodeChannelInfo{reallocates})
+}
+
+type channelBalanceChecker func(context.Context)
+
+func (c *ChannelManager) balanceChannelsLoop(ctx context.Context) {
+	defer logutil.LogPanic()
+
+	ticker := time.NewTicker(Params.DataCoordCfg.ChannelBalanceInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("channel balance loop quit")
+			return
+		case <-ticker.C:
+			c.balance()
+		}
+	}
+}
+
+// balance releases the channels picked by balance policy from their nodes, and the channels are watched by the
+// target nodes once released, see getReassignUpdates. The old node stops consuming a channel before the new one
+// starts, which recovers the unflushed segments from the channel checkpoint, so no data is lost during the handover.
+func (c *ChannelManager) balance() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// wait for the channels in transition, including the ones moved by the last round
+	if c.stateTimer.hasRunningTimers() {
+		return
+	}
+	if info := c.store.GetBufferChannelInfo(); info != nil && len(info.Channels) > 0 {
+		return
+	}
+	// targets left are stale, e.g. the channel is reassigned as the old node is gone
+	c.balanceTargets = make(map[string]int64)
+
+	moves := c.balancePolicy(c.store, Params.DataCoordCfg.ChannelBalanceMaxMoves)
+	for _, move := range moves {
+		if c.isMarkedDrop(move.Channel.Name) {
+			continue
+		}
+		updates := getReleaseOp(move.From, move.Channel)
+		if err := c.updateWithTimer(updates, datapb.ChannelWatchState_ToRelease); err != nil {
+			log.Warn("fail to release channel for balance", zap.String("channel name", move.Channel.Name),
+				zap.Int64("nodeID", move.From), zap.Error(err))
+			continue
+		}
+		c.balanceTargets[move.Channel.Name] = move.To
+		log.Info("release channel for balance", zap.String("channel name", move.Channel.Name),
+			zap.Int64("from", move.From), zap.Int64("to", move.To))
+	}
+}
+
 func (c *ChannelManager) getChannelByNodeAndName(nodeID UniqueID, channelName string) *channel {
 	var ret *channel
 
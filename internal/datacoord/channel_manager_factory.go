@@ -33,6 +33,8 @@ type ChannelPolicyFactory interface {
 	NewReassignPolicy() ChannelReassignPolicy
 	// NewBgChecker creates a new background checker.
 	NewBgChecker() ChannelBGChecker
+	// NewBalancePolicy creates a new channel balance policy.
+	NewBalancePolicy() ChannelBalancePolicy
 }
 
 // ChannelPolicyFactoryV1 equal to policy batch
@@ -70,6 +72,11 @@ func (f *ChannelPolicyFactoryV1) NewBgChecker() ChannelBGChecker {
 	return BgCheckWithMaxWatchDuration(f.kv)
 }
 
+// NewBalancePolicy implementing ChannelPolicyFactory returns AverageBalancePolicy.
+func (f *ChannelPolicyFactoryV1) NewBalancePolicy() ChannelBalancePolicy {
+	return AverageBalancePolicy
+}
+
 // ConsistentHashChannelPolicyFactory use consistent hash to determine channel assignment
 type ConsistentHashChannelPolicyFactory struct {
 	hashring *consistent.Consistent
@@ -106,3 +113,8 @@ func (f *ConsistentHashChannelPolicyFactory) NewReassignPolicy() ChannelReassign
 func (f *ConsistentHashChannelPolicyFactory) NewBgChecker() ChannelBGChecker {
 	return EmptyBgChecker
 }
+
+// NewBalancePolicy creates a new balance policy, channels are placed by the hash ring and never balanced
+func (f *ConsistentHashChannelPolicyFactory) NewBalancePolicy() ChannelBalancePolicy {
+	return EmptyBalancePolicy
+}
@@ -440,6 +440,51 @@ func TestChannelManager(t *testing.T) {
 		// channel is added to bufferID because there's only one node left
 		checkWatchInfoWithState(t, metakv, datapb.ChannelWatchState_ToWatch, bufferID, remainTest.chName, collectionID)
 	})
+	t.Run("test balance", func(t *testing.T) {
+		defer metakv.RemoveWithPrefix("")
+		var (
+			collectionID       = UniqueID(10)
+			nodeID, nodeToAdd  = UniqueID(120), UniqueID(121)
+			channel1, channel2 = "balance-chan-1", "balance-chan-2"
+		)
+
+		chManager, err := NewChannelManager(metakv, newMockHandler())
+		require.NoError(t, err)
+		chManager.store.Add(nodeID)
+		for _, ch := range []string{channel1, channel2} {
+			err = chManager.store.Update(getOpsWithWatchInfo(nodeID, &channel{ch, collectionID}))
+			require.NoError(t, err)
+		}
+		chManager.store.Add(nodeToAdd)
+
+		chManager.balance()
+		require.Equal(t, 1, len(chManager.balanceTargets))
+		var moved string
+		for ch, target := range chManager.balanceTargets {
+			moved = ch
+			assert.Equal(t, nodeToAdd, target)
+		}
+		checkWatchInfoWithState(t, metakv, datapb.ChannelWatchState_ToRelease, nodeID, moved, collectionID)
+
+		// no more moves until the channel is released
+		chManager.balance()
+		assert.Equal(t, 1, len(chManager.balanceTargets))
+		chManager.stateTimer.stopIfExsit(&ackEvent{releaseSuccessAck, moved, nodeID})
+
+		err = chManager.toDelete(nodeID, moved)
+		assert.NoError(t, err)
+		chManager.stateTimer.removeTimers([]string{moved})
+
+		assert.Empty(t, chManager.balanceTargets)
+		assert.Equal(t, 1, len(chManager.store.GetNode(nodeID).Channels))
+		assert.ElementsMatch(t, []*channel{{moved, collectionID}}, chManager.store.GetNode(nodeToAdd).Channels)
+		checkWatchInfoWithState(t, metakv, datapb.ChannelWatchState_ToWatch, nodeToAdd, moved, collectionID)
+
+		// balanced
+		chManager.balance()
+		assert.Empty(t, chManager.balanceTargets)
+	})
+
 	t.Run("test cleanUpAndDelete", func(t *testing.T) {
 		defer metakv.RemoveWithPrefix("")
 		var collectionID = UniqueID(6)
@@ -0,0 +1,223 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// collectionSealPrefix is the etcd prefix of per-collection segment sealing properties, the key is
+// {MetaRootPath}/datacoord/collection-seal/{collectionID} and the value is a json encoded collectionSealProperties,
+// datacoord watches the prefix so that the properties could be changed at runtime. The properties are keyed by ID
+// so that they are neither lost by renaming the collection nor inherited by another one created with its name.
+const collectionSealPrefix = "datacoord/collection-seal"
+
+// collectionSealProperties overrides the global segment sealing thresholds for a collection,
+// zero value means using the global one.
+type collectionSealProperties struct {
+	MaxSize     float64 `json:"maxSize"`     // max size of a segment in MB
+	MaxRows     int64   `json:"maxRows"`     // max number of rows of a segment
+	MaxIdleTime int64   `json:"maxIdleTime"` // seconds a growing segment is sealed after its last allocation expires
+}
+
+// collectionSealConfigs maintains the per-collection sealing properties configured in etcd
+type collectionSealConfigs struct {
+	etcdCli *clientv3.Client
+	prefix  string
+
+	mu         sync.RWMutex
+	properties map[UniqueID]*collectionSealProperties // collection ID -> properties
+}
+
+func newCollectionSealConfigs(etcdCli *clientv3.Client, metaRootPath string) *collectionSealConfigs {
+	return &collectionSealConfigs{
+		etcdCli:    etcdCli,
+		prefix:     path.Join(metaRootPath, collectionSealPrefix) + "/",
+		properties: make(map[UniqueID]*collectionSealProperties),
+	}
+}
+
+// start loads all the sealing properties and watches the changes until ctx done
+func (c *collectionSealConfigs) start(ctx context.Context) error {
+	revision, err := c.reload(ctx)
+	if err != nil {
+		return err
+	}
+	go c.watch(ctx, revision)
+	return nil
+}
+
+func (c *collectionSealConfigs) reload(ctx context.Context) (int64, error) {
+	resp, err := c.etcdCli.Get(ctx, c.prefix, clientv3.WithPrefix())
+	if err != nil {
+		return 0, err
+	}
+	properties := make(map[UniqueID]*collectionSealProperties, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		collectionID, property, err := c.parse(kv.Key, kv.Value)
+		if err != nil {
+			log.Warn("invalid collection seal properties, ignored", zap.String("key", string(kv.Key)), zap.Error(err))
+			continue
+		}
+		properties[collectionID] = property
+	}
+
+	c.mu.Lock()
+	c.properties = properties
+	c.mu.Unlock()
+	log.Info("collection seal properties loaded", zap.Int("num", len(properties)))
+	return resp.Header.Revision, nil
+}
+
+func (c *collectionSealConfigs) watch(ctx context.Context, revision int64) {
+	watchChan := c.etcdCli.Watch(ctx, c.prefix, clientv3.WithPrefix(), clientv3.WithRev(revision+1))
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("collection seal properties watch loop exit")
+			return
+		case resp, ok := <-watchChan:
+			if !ok {
+				return
+			}
+			if err := resp.Err(); err != nil {
+				log.Warn("watch collection seal properties failed, reload", zap.Error(err))
+				revision, err = c.reload(ctx)
+				if err != nil {
+					log.Warn("reload collection seal properties failed", zap.Error(err))
+					return
+				}
+				watchChan = c.etcdCli.Watch(ctx, c.prefix, clientv3.WithPrefix(), clientv3.WithRev(revision+1))
+				continue
+			}
+			for _, event := range resp.Events {
+				c.apply(event)
+			}
+		}
+	}
+}
+
+func (c *collectionSealConfigs) apply(event *clientv3.Event) {
+	if event.Type == clientv3.EventTypeDelete {
+		collectionID, err := c.parseCollectionID(event.Kv.Key)
+		if err != nil {
+			return
+		}
+		c.mu.Lock()
+		delete(c.properties, collectionID)
+		c.mu.Unlock()
+		return
+	}
+	collectionID, property, err := c.parse(event.Kv.Key, event.Kv.Value)
+	if err != nil {
+		log.Warn("invalid collection seal properties, ignored", zap.String("key", string(event.Kv.Key)), zap.Error(err))
+		return
+	}
+	c.mu.Lock()
+	c.properties[collectionID] = property
+	c.mu.Unlock()
+	log.Info("collection seal properties updated", zap.Int64("collectionID", collectionID), zap.Any("properties", property))
+}
+
+func (c *collectionSealConfigs) parseCollectionID(key []byte) (UniqueID, error) {
+	collectionID, err := strconv.ParseInt(strings.TrimPrefix(string(key), c.prefix), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid collection ID in key %s", string(key))
+	}
+	return collectionID, nil
+}
+
+func (c *collectionSealConfigs) parse(key, value []byte) (UniqueID, *collectionSealProperties, error) {
+	collectionID, err := c.parseCollectionID(key)
+	if err != nil {
+		return 0, nil, err
+	}
+	property := &collectionSealProperties{}
+	if err := json.Unmarshal(value, property); err != nil {
+		return 0, nil, err
+	}
+	if property.MaxSize < 0 || property.MaxRows < 0 || property.MaxIdleTime < 0 {
+		return 0, nil, errors.New("negative seal properties")
+	}
+	return collectionID, property, nil
+}
+
+// get returns the sealing properties of the collection, nil if not configured
+func (c *collectionSealConfigs) get(collectionID UniqueID) *collectionSealProperties {
+	if c == nil {
+		return nil
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.properties[collectionID]
+}
+
+// calByCollectionPolicy returns the calUpperLimitPolicy estimating the max rows of a segment by the max size and
+// the max rows configured for the collection, the global max size is used if not configured.
+func calByCollectionPolicy(configs *collectionSealConfigs) calUpperLimitPolicy {
+	return func(collectionID UniqueID, schema *schemapb.CollectionSchema) (int, error) {
+		property := configs.get(collectionID)
+		if property == nil {
+			return calBySchemaPolicy(schema)
+		}
+		maxRows, err := calBySchemaPolicy(schema)
+		if err != nil {
+			return -1, err
+		}
+		if property.MaxSize > 0 {
+			sizePerRecord, err := typeutil.EstimateSizePerRecord(schema)
+			if err != nil {
+				return -1, err
+			}
+			maxRows = int(property.MaxSize * 1024 * 1024 / float64(sizePerRecord))
+		}
+		if property.MaxRows > 0 && int(property.MaxRows) < maxRows {
+			maxRows = int(property.MaxRows)
+		}
+		return maxRows, nil
+	}
+}
+
+// sealByCollectionIdlePolicy returns the segmentSealPolicy sealing the segments of the collections with max idle time
+// configured, once no allocation is made for that long.
+func sealByCollectionIdlePolicy(configs *collectionSealConfigs) segmentSealPolicy {
+	return func(segment *SegmentInfo, ts Timestamp) bool {
+		property := configs.get(segment.GetCollectionID())
+		if property == nil || property.MaxIdleTime == 0 || segment.GetLastExpireTime() == 0 {
+			return false
+		}
+		pts, _ := tsoutil.ParseTS(ts)
+		epts, _ := tsoutil.ParseTS(segment.GetLastExpireTime())
+		return pts.Sub(epts) >= time.Duration(property.MaxIdleTime)*time.Second
+	}
+}
@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func TestCollectionSealConfigs_parse(t *testing.T) {
+	configs := newCollectionSealConfigs(nil, "by-dev/meta")
+
+	collectionID, property, err := configs.parse([]byte("by-dev/meta/datacoord/collection-seal/1"),
+		[]byte(`{"maxSize": 64, "maxRows": 1000, "maxIdleTime": 10}`))
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(1), collectionID)
+	assert.Equal(t, &collectionSealProperties{MaxSize: 64, MaxRows: 1000, MaxIdleTime: 10}, property)
+
+	_, _, err = configs.parse([]byte("by-dev/meta/datacoord/collection-seal/1"), []byte(`{"maxRows": -1}`))
+	assert.Error(t, err)
+	_, _, err = configs.parse([]byte("by-dev/meta/datacoord/collection-seal/1"), []byte(`invalid`))
+	assert.Error(t, err)
+	_, _, err = configs.parse([]byte("by-dev/meta/datacoord/collection-seal/"), []byte(`{}`))
+	assert.Error(t, err)
+	_, _, err = configs.parse([]byte("by-dev/meta/datacoord/collection-seal/coll"), []byte(`{}`))
+	assert.Error(t, err)
+
+	var nilConfigs *collectionSealConfigs
+	assert.Nil(t, nilConfigs.get(1))
+}
+
+func TestCalByCollectionPolicy(t *testing.T) {
+	Params.Init()
+	schema := &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+		},
+	}
+	configs := newCollectionSealConfigs(nil, "by-dev/meta")
+	policy := calByCollectionPolicy(configs)
+
+	expected, err := calBySchemaPolicy(schema)
+	assert.NoError(t, err)
+	rows, err := policy(1, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, rows)
+
+	configs.properties[1] = &collectionSealProperties{MaxSize: 1}
+	rows, err = policy(1, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, 1024*1024/8, rows)
+
+	configs.properties[1] = &collectionSealProperties{MaxSize: 1, MaxRows: 100}
+	rows, err = policy(1, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, rows)
+
+	// the properties of another collection are not applied
+	rows, err = policy(2, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, rows)
+
+	configs.properties[1] = &collectionSealProperties{MaxRows: 100}
+	rows, err = policy(1, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, rows)
+}
+
+func TestSealByCollectionIdlePolicy(t *testing.T) {
+	configs := newCollectionSealConfigs(nil, "by-dev/meta")
+	policy := sealByCollectionIdlePolicy(configs)
+
+	now := time.Now()
+	segment := &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:             1,
+			CollectionID:   1,
+			LastExpireTime: tsoutil.ComposeTSByTime(now, 0),
+		},
+	}
+	ts := tsoutil.ComposeTSByTime(now.Add(20*time.Second), 0)
+	assert.False(t, policy(segment, ts))
+
+	configs.properties[1] = &collectionSealProperties{MaxIdleTime: 30}
+	assert.False(t, policy(segment, ts))
+
+	configs.properties[1] = &collectionSealProperties{MaxIdleTime: 10}
+	assert.True(t, policy(segment, ts))
+
+	segment.CollectionID = 2
+	assert.False(t, policy(segment, ts))
+}
@@ -208,8 +208,11 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 	}
 	c.plans[planID] = c.plans[planID].shadowClone(setState(completed), setResult(result))
 	c.executingTaskNum--
-	if c.plans[planID].plan.GetType() == datapb.CompactionType_MergeCompaction ||
-		c.plans[planID].plan.GetType() == datapb.CompactionType_MixCompaction {
+	// the merged segment is reported as flushed, so that its index is built and querycoord hands it off,
+	// no segment is generated if all the entities of the merged segments are deleted
+	if (c.plans[planID].plan.GetType() == datapb.CompactionType_MergeCompaction ||
+		c.plans[planID].plan.GetType() == datapb.CompactionType_MixCompaction) &&
+		result.GetNumOfRows() > 0 {
 		c.flushCh <- result.GetSegmentID()
 	}
 	// TODO: when to clean task list
@@ -26,16 +26,14 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/util/logutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
 
 const (
-	signalBufferSize                = 100
-	maxLittleSegmentNum             = 10
-	maxCompactionTimeoutInSeconds   = 60
-	singleCompactionRatioThreshold  = 0.2
-	singleCompactionDeltaLogMaxSize = 10 * 1024 * 1024 //10MiB
-	globalCompactionInterval        = 60 * time.Second
+	signalBufferSize              = 100
+	maxCompactionTimeoutInSeconds = 60
 )
 
 type timetravel struct {
@@ -88,13 +86,13 @@ func newCompactionTrigger(meta *meta, compactionHandler compactionPlanContext, a
 		singleCompactionPolicy:          (singleCompactionFunc)(chooseAllBinlogs),
 		mergeCompactionPolicy:           (mergeCompactionFunc)(greedyMergeCompaction),
 		compactionHandler:               compactionHandler,
-		mergeCompactionSegmentThreshold: maxLittleSegmentNum,
+		mergeCompactionSegmentThreshold: Params.DataCoordCfg.MinSegmentToMerge,
 	}
 }
 
 func (t *compactionTrigger) start() {
 	t.quit = make(chan struct{})
-	t.globalTrigger = time.NewTicker(globalCompactionInterval)
+	t.globalTrigger = time.NewTicker(Params.DataCoordCfg.GlobalCompactionInterval)
 	t.wg.Add(2)
 	go func() {
 		defer logutil.LogPanic()
@@ -111,7 +109,7 @@ func (t *compactionTrigger) start() {
 					t.handleGlobalSignal(signal)
 				default:
 					t.handleSignal(signal)
-					t.globalTrigger.Reset(globalCompactionInterval)
+					t.globalTrigger.Reset(Params.DataCoordCfg.GlobalCompactionInterval)
 				}
 			}
 		}
@@ -402,29 +400,34 @@ func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, force bool, t
 
 	var plans []*datapb.CompactionPlan
 
-	generatePlan := func(segment *SegmentInfo) {
+	// the segments of a bucket are merged into one, which must not exceed the max row num of the segment
+	selectBucket := func(segment *SegmentInfo) []*SegmentInfo {
 		var bucket []*SegmentInfo
 		bucket = append(bucket, segment)
-		free := segment.GetMaxRowNum()
+		free := segment.GetMaxRowNum() - segment.GetNumOfRows()
 		result, free := greedySelect(internalCandidates, free)
 		bucket = append(bucket, result...)
 		result, _ = greedySelect(mergeCandidates, free)
 		bucket = append(bucket, result...)
-
-		plans = append(plans, segmentsToPlan(bucket, timetravel))
+		return bucket
 	}
 
 	var segment *SegmentInfo
 	for internalCandidates.Len() > 0 {
 		segment = heap.Pop(internalCandidates).(*SegmentInfo)
-		generatePlan(segment)
+		plans = append(plans, segmentsToPlan(selectBucket(segment), timetravel))
 	}
 
 	// merge compaction need 2 or more segment candidates
 	for mergeCandidates.Len() > 1 &&
 		(mergeCandidates.Len() >= t.mergeCompactionSegmentThreshold || force) {
 		segment = heap.Pop(mergeCandidates).(*SegmentInfo)
-		generatePlan(segment)
+		bucket := selectBucket(segment)
+		// the small segment has no other segment fitting in, rewriting it alone reduces nothing
+		if len(bucket) == 1 {
+			continue
+		}
+		plans = append(plans, segmentsToPlan(bucket, timetravel))
 	}
 
 	return plans
@@ -473,8 +476,10 @@ func (t *compactionTrigger) getCandidateSegments(channel string, partitionID Uni
 	return res
 }
 
+// isSmallSegment returns whether the segment has fewer rows than the small proportion of the max, the small segments
+// of a channel and partition are merged together to keep the number of the segments to search bounded.
 func (t *compactionTrigger) isSmallSegment(segment *SegmentInfo) bool {
-	return segment.GetNumOfRows() < segment.GetMaxRowNum()/2
+	return float64(segment.GetNumOfRows()) < float64(segment.GetMaxRowNum())*Params.DataCoordCfg.SegmentSmallProportion
 }
 
 func (t *compactionTrigger) shouldDoMergeCompaction(segments []*SegmentInfo) bool {
@@ -512,6 +517,11 @@ func (t *compactionTrigger) shouldDoSingleCompaction(segment *SegmentInfo, timet
 		return false
 	}
 
+	// all the entities of the segment are expired, compact it to purge them
+	if t.isExpiredSegment(segment) {
+		return true
+	}
+
 	totalDeletedRows := 0
 	totalDeleteLogSize := int64(0)
 	for _, fbl := range segment.GetDeltalogs() {
@@ -524,7 +534,27 @@ func (t *compactionTrigger) shouldDoSingleCompaction(segment *SegmentInfo, timet
 	}
 
 	// currently delta log size and delete ratio policy is applied
-	return float32(totalDeletedRows)/float32(segment.NumOfRows) >= singleCompactionRatioThreshold || totalDeleteLogSize > singleCompactionDeltaLogMaxSize
+	return float64(totalDeletedRows)/float64(segment.NumOfRows) >= Params.DataCoordCfg.SingleCompactionRatio ||
+		totalDeleteLogSize > Params.DataCoordCfg.SingleCompactionDeltaLog
+}
+
+// isExpiredSegment returns whether all the entities of the segment are expired, which is judged by the dml position
+// of the segment since no entity of the segment is inserted after it
+func (t *compactionTrigger) isExpiredSegment(segment *SegmentInfo) bool {
+	coll := t.meta.GetCollection(segment.GetCollectionID())
+	if coll == nil || segment.GetDmlPosition() == nil {
+		return false
+	}
+	collectionTTL, err := typeutil.GetCollectionTTL(coll.GetSchema())
+	if err != nil {
+		log.Warn("invalid collection ttl, ignored", zap.Int64("collectionID", coll.GetID()), zap.Error(err))
+	}
+	ttl := Params.CommonCfg.GetEntityTTL(collectionTTL)
+	if ttl <= 0 {
+		return false
+	}
+	dmlTime, _ := tsoutil.ParseTS(segment.GetDmlPosition().GetTimestamp())
+	return dmlTime.Add(ttl).Before(time.Now())
 }
 
 func (t *compactionTrigger) hasValidDeltaLogs(segment *SegmentInfo, timetravel *timetravel) bool {
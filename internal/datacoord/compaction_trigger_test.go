@@ -18,14 +18,20 @@ package datacoord
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"testing"
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/common"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -75,6 +81,7 @@ func disableSingleCompaction(segment *SegmentInfo, timetravel *timetravel) *data
 }
 
 func Test_compactionTrigger_forceTriggerCompaction(t *testing.T) {
+	Params.Init()
 	type fields struct {
 		meta                   *meta
 		allocator              allocator
@@ -298,6 +305,7 @@ func sortPlanCompactionBinlogs(plan *datapb.CompactionPlan) {
 }
 
 func Test_compactionTrigger_triggerCompaction(t *testing.T) {
+	Params.Init()
 	type fields struct {
 		meta                            *meta
 		allocator                       allocator
@@ -719,6 +727,7 @@ func sameSegmentInfos(s1, s2 []*datapb.CompactionSegmentBinlogs) bool {
 }
 
 func Test_compactionTrigger_singleTriggerCompaction(t *testing.T) {
+	Params.Init()
 	type fields struct {
 		meta                   *meta
 		allocator              allocator
@@ -1159,3 +1168,329 @@ func Test_handleSignal(t *testing.T) {
 		got.handleSignal(signal)
 	})
 }
+
+func Test_compactionTrigger_isSmallSegment(t *testing.T) {
+	Params.Init()
+	defer func(proportion float64) {
+		Params.DataCoordCfg.SegmentSmallProportion = proportion
+	}(Params.DataCoordCfg.SegmentSmallProportion)
+
+	tr := &compactionTrigger{}
+	segment := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{NumOfRows: 300, MaxRowNum: 1000}}
+
+	Params.DataCoordCfg.SegmentSmallProportion = 0.5
+	assert.True(t, tr.isSmallSegment(segment))
+
+	Params.DataCoordCfg.SegmentSmallProportion = 0.2
+	assert.False(t, tr.isSmallSegment(segment))
+}
+
+func Test_compactionTrigger_isExpiredSegment(t *testing.T) {
+	Params.Init()
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+		},
+		Properties: []*commonpb.KeyValuePair{{Key: common.CollectionTTLParam, Value: "864000"}},
+	}
+	tr := &compactionTrigger{
+		meta: &meta{
+			collections: map[UniqueID]*datapb.CollectionInfo{
+				1: {ID: 1, Schema: schema},
+				2: {ID: 2, Schema: &schemapb.CollectionSchema{}},
+			},
+		},
+	}
+	newSegment := func(collectionID UniqueID, age time.Duration) *SegmentInfo {
+		return &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+			CollectionID: collectionID,
+			DmlPosition:  &internalpb.MsgPosition{Timestamp: tsoutil.ComposeTSByTime(time.Now().Add(-age), 0)},
+		}}
+	}
+
+	assert.True(t, tr.isExpiredSegment(newSegment(1, 11*24*time.Hour)))
+	assert.False(t, tr.isExpiredSegment(newSegment(1, 24*time.Hour)))
+	// no ttl
+	assert.False(t, tr.isExpiredSegment(newSegment(2, 11*24*time.Hour)))
+	// unknown collection
+	assert.False(t, tr.isExpiredSegment(newSegment(3, 11*24*time.Hour)))
+	// no dml position
+	assert.False(t, tr.isExpiredSegment(&SegmentInfo{SegmentInfo: &datapb.SegmentInfo{CollectionID: 1}}))
+}
+
+func Test_compactionTrigger_mergeSmallSegments(t *testing.T) {
+	Params.Init()
+	newMeta := func(rows ...int64) *meta {
+		m := &meta{client: memkv.NewMemoryKV(), segments: NewSegmentsInfo()}
+		for i, n := range rows {
+			id := UniqueID(i + 1)
+			err := m.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+				ID:             id,
+				CollectionID:   1,
+				PartitionID:    1,
+				InsertChannel:  "ch1",
+				NumOfRows:      n,
+				MaxRowNum:      1000,
+				LastExpireTime: 100,
+				State:          commonpb.SegmentState_Flushed,
+				Binlogs:        []*datapb.FieldBinlog{getFieldBinlogPaths(1, fmt.Sprintf("binlog%d", id))},
+				StartPosition:  &internalpb.MsgPosition{Timestamp: uint64(id)},
+				DmlPosition:    &internalpb.MsgPosition{Timestamp: uint64(id + 10)},
+			}))
+			assert.Nil(t, err)
+		}
+		return m
+	}
+	generatePlan := func(m *meta) *datapb.CompactionPlan {
+		spy := &spyCompactionHandler{spyChan: make(chan *datapb.CompactionPlan, 2)}
+		tr := &compactionTrigger{
+			meta:                            m,
+			allocator:                       newMockAllocator(),
+			compactionHandler:               spy,
+			mergeCompactionSegmentThreshold: 4,
+		}
+		tr.handleGlobalSignal(&compactionSignal{id: 1, isGlobal: true, timetravel: &timetravel{200}})
+		assert.LessOrEqual(t, len(spy.spyChan), 1)
+		select {
+		case plan := <-spy.spyChan:
+			return plan
+		default:
+			return nil
+		}
+	}
+	complete := func(m *meta, plan *datapb.CompactionPlan, result *datapb.CompactionResult) chan UniqueID {
+		flushCh := make(chan UniqueID, 1)
+		c := &compactionPlanHandler{
+			plans: map[int64]*compactionTask{
+				plan.PlanID: {triggerInfo: &compactionSignal{id: 1}, state: executing, plan: plan},
+			},
+			meta:    m,
+			flushCh: flushCh,
+		}
+		result.PlanID = plan.PlanID
+		err := c.completeCompaction(result)
+		assert.Nil(t, err)
+		return flushCh
+	}
+
+	t.Run("merge small segments and hand off", func(t *testing.T) {
+		// segment 5 is not small and stays as is
+		m := newMeta(100, 200, 150, 50, 900)
+		plan := generatePlan(m)
+		assert.NotNil(t, plan)
+		assert.Equal(t, datapb.CompactionType_MixCompaction, plan.GetType())
+		assert.Equal(t, "ch1", plan.GetChannel())
+		sortPlanCompactionBinlogs(plan)
+		var merged []UniqueID
+		for _, binlogs := range plan.GetSegmentBinlogs() {
+			merged = append(merged, binlogs.GetSegmentID())
+		}
+		assert.Equal(t, []UniqueID{1, 2, 3, 4}, merged)
+
+		flushCh := complete(m, plan, &datapb.CompactionResult{
+			SegmentID:  6,
+			NumOfRows:  500,
+			InsertLogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "binlog6")},
+		})
+		assert.Equal(t, 1, len(flushCh))
+		segmentID := <-flushCh
+		assert.EqualValues(t, 6, segmentID)
+		for _, id := range merged {
+			assert.Nil(t, m.GetSegment(id))
+		}
+		assert.NotNil(t, m.GetSegment(5))
+		segment := m.GetSegment(segmentID)
+		assert.Equal(t, commonpb.SegmentState_Flushing, segment.GetState())
+		assert.EqualValues(t, 500, segment.GetNumOfRows())
+		assert.True(t, segment.GetCreatedByCompaction())
+		assert.ElementsMatch(t, merged, segment.GetCompactionFrom())
+		assert.EqualValues(t, 1, segment.GetStartPosition().GetTimestamp())
+		assert.EqualValues(t, 14, segment.GetDmlPosition().GetTimestamp())
+
+		// root coord builds the index of the merged segment, then querycoord hands it off to replace the merged ones
+		svr := &Server{meta: m, rootCoordClient: &rootCoordSegFlushComplete{flag: true}}
+		err := svr.postFlush(context.TODO(), segmentID)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.SegmentState_Flushed, m.GetSegment(segmentID).GetState())
+		value, err := m.client.Load(buildQuerySegmentPath(1, 1, segmentID))
+		assert.Nil(t, err)
+		handoff := &querypb.SegmentInfo{}
+		err = proto.Unmarshal([]byte(value), handoff)
+		assert.Nil(t, err)
+		assert.True(t, handoff.GetCreatedByCompaction())
+		assert.ElementsMatch(t, merged, handoff.GetCompactionFrom())
+	})
+
+	t.Run("too few small segments", func(t *testing.T) {
+		m := newMeta(100, 200, 150, 900)
+		assert.Nil(t, generatePlan(m))
+	})
+
+	t.Run("merged segment within max row num", func(t *testing.T) {
+		defer func(proportion float64) {
+			Params.DataCoordCfg.SegmentSmallProportion = proportion
+		}(Params.DataCoordCfg.SegmentSmallProportion)
+		Params.DataCoordCfg.SegmentSmallProportion = 0.9
+
+		// no two of the small segments fit in one segment
+		m := newMeta(600, 700, 800, 850)
+		assert.Nil(t, generatePlan(m))
+
+		m = newMeta(600, 300, 800, 100)
+		plan := generatePlan(m)
+		assert.NotNil(t, plan)
+		sortPlanCompactionBinlogs(plan)
+		var rows int64
+		for _, binlogs := range plan.GetSegmentBinlogs() {
+			rows += m.GetSegment(binlogs.GetSegmentID()).GetNumOfRows()
+		}
+		assert.LessOrEqual(t, rows, int64(1000))
+	})
+
+	t.Run("all entities deleted", func(t *testing.T) {
+		m := newMeta(100, 200, 150, 50)
+		plan := generatePlan(m)
+		assert.NotNil(t, plan)
+
+		flushCh := complete(m, plan, &datapb.CompactionResult{SegmentID: 5})
+		assert.Equal(t, 0, len(flushCh))
+		for i := UniqueID(1); i <= 5; i++ {
+			assert.Nil(t, m.GetSegment(i))
+		}
+	})
+}
+
+func Test_compactionTrigger_compactDeletedSegment(t *testing.T) {
+	Params.Init()
+	newMeta := func() *meta {
+		m := &meta{client: memkv.NewMemoryKV(), segments: NewSegmentsInfo()}
+		deltalogs := []*datapb.Binlog{
+			{EntriesNum: 300, TimestampTo: 150, LogPath: "deltalog1"},
+			// deleted ratio below the threshold
+			{EntriesNum: 100, TimestampTo: 150, LogPath: "deltalog2"},
+			// deleted after the time travel
+			{EntriesNum: 300, TimestampTo: 250, LogPath: "deltalog3"},
+		}
+		for i, l := range deltalogs {
+			id := UniqueID(i + 1)
+			err := m.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+				ID:             id,
+				CollectionID:   1,
+				PartitionID:    1,
+				InsertChannel:  "ch1",
+				NumOfRows:      1000,
+				MaxRowNum:      1000,
+				LastExpireTime: 100,
+				State:          commonpb.SegmentState_Flushed,
+				Binlogs:        []*datapb.FieldBinlog{getFieldBinlogPaths(1, fmt.Sprintf("binlog%d", id))},
+				Deltalogs:      []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []*datapb.Binlog{l}}},
+				StartPosition:  &internalpb.MsgPosition{Timestamp: uint64(id)},
+				DmlPosition:    &internalpb.MsgPosition{Timestamp: uint64(id + 10)},
+			}))
+			assert.Nil(t, err)
+		}
+		return m
+	}
+	generatePlans := func(m *meta) []*datapb.CompactionPlan {
+		spy := &spyCompactionHandler{spyChan: make(chan *datapb.CompactionPlan, 3)}
+		tr := &compactionTrigger{
+			meta:                            m,
+			allocator:                       newMockAllocator(),
+			compactionHandler:               spy,
+			mergeCompactionSegmentThreshold: 4,
+		}
+		// the delta logs of segment 1 are saved
+		tr.handleSignal(&compactionSignal{id: 1, collectionID: 1, partitionID: 1, segmentID: 1, channel: "ch1", timetravel: &timetravel{200}})
+		close(spy.spyChan)
+		var plans []*datapb.CompactionPlan
+		for plan := range spy.spyChan {
+			plans = append(plans, plan)
+		}
+		return plans
+	}
+	complete := func(m *meta, plan *datapb.CompactionPlan, result *datapb.CompactionResult) chan UniqueID {
+		flushCh := make(chan UniqueID, 1)
+		c := &compactionPlanHandler{
+			plans: map[int64]*compactionTask{
+				plan.PlanID: {triggerInfo: &compactionSignal{id: 1}, state: executing, plan: plan},
+			},
+			meta:    m,
+			flushCh: flushCh,
+		}
+		result.PlanID = plan.PlanID
+		err := c.completeCompaction(result)
+		assert.Nil(t, err)
+		return flushCh
+	}
+
+	t.Run("compact segment beyond deleted ratio", func(t *testing.T) {
+		m := newMeta()
+		plans := generatePlans(m)
+		assert.Equal(t, 1, len(plans))
+		plan := plans[0]
+		assert.Equal(t, datapb.CompactionType_MixCompaction, plan.GetType())
+		assert.EqualValues(t, 200, plan.GetTimetravel())
+		assert.Equal(t, 1, len(plan.GetSegmentBinlogs()))
+		assert.EqualValues(t, 1, plan.GetSegmentBinlogs()[0].GetSegmentID())
+		assert.EqualValues(t, "deltalog1", plan.GetSegmentBinlogs()[0].GetDeltalogs()[0].GetBinlogs()[0].GetLogPath())
+
+		// the deleted entities are removed from the compacted segment
+		flushCh := complete(m, plan, &datapb.CompactionResult{
+			SegmentID:  4,
+			NumOfRows:  700,
+			InsertLogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "binlog4")},
+		})
+		assert.Equal(t, 1, len(flushCh))
+		segmentID := <-flushCh
+		assert.EqualValues(t, 4, segmentID)
+		assert.Nil(t, m.GetSegment(1))
+		segment := m.GetSegment(segmentID)
+		assert.EqualValues(t, 700, segment.GetNumOfRows())
+		assert.Empty(t, segment.GetDeltalogs())
+		assert.Equal(t, []UniqueID{1}, segment.GetCompactionFrom())
+		assert.NotNil(t, m.GetSegment(2))
+		assert.NotNil(t, m.GetSegment(3))
+
+		// the compacted segment is indexed and handed off to replace the segment with deletions
+		svr := &Server{meta: m, rootCoordClient: &rootCoordSegFlushComplete{flag: true}}
+		err := svr.postFlush(context.TODO(), segmentID)
+		assert.Nil(t, err)
+		value, err := m.client.Load(buildQuerySegmentPath(1, 1, segmentID))
+		assert.Nil(t, err)
+		handoff := &querypb.SegmentInfo{}
+		err = proto.Unmarshal([]byte(value), handoff)
+		assert.Nil(t, err)
+		assert.Equal(t, []UniqueID{1}, handoff.GetCompactionFrom())
+	})
+
+	t.Run("lower deleted ratio threshold", func(t *testing.T) {
+		defer func(ratio float64) {
+			Params.DataCoordCfg.SingleCompactionRatio = ratio
+		}(Params.DataCoordCfg.SingleCompactionRatio)
+		Params.DataCoordCfg.SingleCompactionRatio = 0.1
+
+		plans := generatePlans(newMeta())
+		var compacted []UniqueID
+		for _, plan := range plans {
+			assert.Equal(t, 1, len(plan.GetSegmentBinlogs()))
+			compacted = append(compacted, plan.GetSegmentBinlogs()[0].GetSegmentID())
+		}
+		assert.ElementsMatch(t, []UniqueID{1, 2}, compacted)
+	})
+
+	t.Run("all entities deleted", func(t *testing.T) {
+		m := newMeta()
+		plans := generatePlans(m)
+		assert.Equal(t, 1, len(plans))
+
+		flushCh := complete(m, plans[0], &datapb.CompactionResult{SegmentID: 4})
+		assert.Equal(t, 0, len(flushCh))
+		assert.Nil(t, m.GetSegment(1))
+		assert.Nil(t, m.GetSegment(4))
+	})
+}
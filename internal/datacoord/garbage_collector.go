@@ -19,6 +19,7 @@ package datacoord
 import (
 	"context"
 	"path"
+	"strconv"
 	"sync"
 	"time"
 
@@ -34,6 +35,10 @@ const (
 	insertLogPrefix = `insert_log`
 	statsLogPrefix  = `stats_log`
 	deltaLogPrefix  = `delta_log`
+
+	// deleteSnapshotPrefix is the prefix of the delete snapshots of sealed segments saved by query nodes,
+	// the snapshots are saved as {prefix}/{collectionID}/{partitionID}/{segmentID}/{timestamp}
+	deleteSnapshotPrefix = `delete_snapshot`
 )
 
 // GcOption garbage collection options
@@ -43,6 +48,7 @@ type GcOption struct {
 	checkInterval    time.Duration // each interval
 	missingTolerance time.Duration // key missing in meta tolerace time
 	dropTolerance    time.Duration // dropped segment related key tolerance time
+	dryRun           bool          // only report the garbage files without removing them
 	bucketName       string
 	rootPath         string
 }
@@ -61,7 +67,7 @@ type garbageCollector struct {
 
 // newGarbageCollector create garbage collector with meta and option
 func newGarbageCollector(meta *meta, opt GcOption) *garbageCollector {
-	log.Info("GC with option", zap.Bool("enabled", opt.enabled), zap.Duration("interval", opt.checkInterval),
+	log.Info("GC with option", zap.Bool("enabled", opt.enabled), zap.Bool("dryRun", opt.dryRun), zap.Duration("interval", opt.checkInterval),
 		zap.Duration("missingTolerance", opt.missingTolerance), zap.Duration("dropTolerance", opt.dropTolerance))
 	return &garbageCollector{
 		meta:    meta,
@@ -137,13 +143,42 @@ func (gc *garbageCollector) scan() {
 			m++
 			// not found in meta, check last modified time exceeds tolerance duration
 			if time.Since(info.LastModified) > gc.option.missingTolerance {
-				// ignore error since it could be cleaned up next time
 				removedKeys = append(removedKeys, info.Key)
+				if gc.option.dryRun {
+					continue
+				}
+				// ignore error since it could be cleaned up next time
 				_ = gc.option.cli.RemoveObject(context.TODO(), gc.option.bucketName, info.Key, minio.RemoveObjectOptions{})
 			}
 		}
 	}
-	log.Info("scan result", zap.Int("valid", v), zap.Int("missing", m), zap.Strings("removed keys", removedKeys))
+	removedKeys = append(removedKeys, gc.scanDeleteSnapshots()...)
+	log.Info("scan result", zap.Int("valid", v), zap.Int("missing", m), zap.Bool("dryRun", gc.option.dryRun),
+		zap.Strings("removed keys", removedKeys))
+}
+
+// scanDeleteSnapshots removes the delete snapshots of the segments dropped or missing in meta, returns the keys removed
+func (gc *garbageCollector) scanDeleteSnapshots() []string {
+	var removedKeys []string
+	prefix := path.Join(gc.option.rootPath, deleteSnapshotPrefix) + "/"
+	for info := range gc.option.cli.ListObjects(context.TODO(), gc.option.bucketName, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	}) {
+		segmentID, err := strconv.ParseInt(path.Base(path.Dir(info.Key)), 10, 64)
+		if err == nil && gc.meta.GetSegment(segmentID) != nil {
+			continue
+		}
+		if time.Since(info.LastModified) > gc.option.missingTolerance {
+			removedKeys = append(removedKeys, info.Key)
+			if gc.option.dryRun {
+				continue
+			}
+			// ignore error since it could be cleaned up next time
+			_ = gc.option.cli.RemoveObject(context.TODO(), gc.option.bucketName, info.Key, minio.RemoveObjectOptions{})
+		}
+	}
+	return removedKeys
 }
 
 func (gc *garbageCollector) clearEtcd() {
@@ -156,6 +191,10 @@ func (gc *garbageCollector) clearEtcd() {
 			continue
 		}
 		logs := getLogs(sinfo)
+		if gc.option.dryRun {
+			log.Info("dropped segment to remove", zap.Int64("segmentID", sinfo.GetID()), zap.Int("logs", len(logs)))
+			continue
+		}
 		if gc.removeLogs(logs) {
 			_ = gc.meta.DropSegment(sinfo.GetID())
 		}
@@ -177,6 +177,26 @@ func Test_garbageCollector_scan(t *testing.T) {
 
 		gc.close()
 	})
+	t.Run("missing dry run", func(t *testing.T) {
+		gc := newGarbageCollector(meta, GcOption{
+			cli:              cli,
+			enabled:          true,
+			checkInterval:    time.Minute * 30,
+			missingTolerance: 0,
+			dropTolerance:    0,
+			dryRun:           true,
+			bucketName:       bucketName,
+			rootPath:         rootPath,
+		})
+		gc.scan()
+		gc.clearEtcd()
+		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, insertLogPrefix), inserts[1:])
+		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, statsLogPrefix), stats[1:])
+		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, deltaLogPrefix), delta[1:])
+		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, `indexes`), others)
+
+		gc.close()
+	})
 	t.Run("missing gc all", func(t *testing.T) {
 		gc := newGarbageCollector(meta, GcOption{
 			cli:              cli,
@@ -197,6 +217,35 @@ func Test_garbageCollector_scan(t *testing.T) {
 
 		gc.close()
 	})
+	t.Run("delete snapshots of segments not in meta", func(t *testing.T) {
+		segment := buildSegment(1, 10, 200, "ch")
+		segment.State = commonpb.SegmentState_Flushed
+		err = meta.AddSegment(segment)
+		require.NoError(t, err)
+
+		content := []byte("test")
+		var snapshots []string
+		for _, segmentID := range []string{"200", "300"} {
+			key := path.Join(rootPath, deleteSnapshotPrefix, "1", "10", segmentID, "1000")
+			info, err := cli.PutObject(context.TODO(), bucketName, key, bytes.NewReader(content), int64(len(content)), minio.PutObjectOptions{})
+			require.NoError(t, err)
+			snapshots = append(snapshots, info.Key)
+		}
+
+		gc := newGarbageCollector(meta, GcOption{
+			cli:              cli,
+			enabled:          true,
+			checkInterval:    time.Minute * 30,
+			missingTolerance: 0,
+			dropTolerance:    0,
+			bucketName:       bucketName,
+			rootPath:         rootPath,
+		})
+		gc.scan()
+		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, deleteSnapshotPrefix), snapshots[:1])
+
+		gc.close()
+	})
 
 	cleanupOSS(cli, bucketName, rootPath)
 }
@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"go.uber.org/zap"
 )
 
@@ -115,6 +116,19 @@ func (m *meta) GetCollection(collectionID UniqueID) *datapb.CollectionInfo {
 	return collection
 }
 
+// RefreshCollection replaces the cached schema of the collection, it's a no-op if the collection is not cached
+func (m *meta) RefreshCollection(collectionID UniqueID, schema *schemapb.CollectionSchema) {
+	m.Lock()
+	defer m.Unlock()
+	collection, ok := m.collections[collectionID]
+	if !ok {
+		return
+	}
+	clone := proto.Clone(collection).(*datapb.CollectionInfo)
+	clone.Schema = schema
+	m.collections[collectionID] = clone
+}
+
 // chanPartSegments is an internal result struct, which is aggregates of SegmentInfos with same collectionID, partitionID and channelName
 type chanPartSegments struct {
 	collecionID UniqueID
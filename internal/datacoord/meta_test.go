@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -698,3 +699,23 @@ func Test_meta_GetSegmentsOfCollection(t *testing.T) {
 		})
 	}
 }
+
+func Test_meta_RefreshCollection(t *testing.T) {
+	schema := newTestSchema()
+	m := &meta{
+		collections: map[UniqueID]*datapb.CollectionInfo{
+			1: {ID: 1, Schema: schema},
+		},
+	}
+	collection := m.GetCollection(1)
+	renamed := proto.Clone(schema).(*schemapb.CollectionSchema)
+	renamed.Name = "renamed"
+
+	m.RefreshCollection(1, renamed)
+	assert.Equal(t, "renamed", m.GetCollection(1).GetSchema().GetName())
+	// the collection returned before is not changed
+	assert.Equal(t, schema.GetName(), collection.GetSchema().GetName())
+
+	m.RefreshCollection(2, renamed)
+	assert.Nil(t, m.GetCollection(2))
+}
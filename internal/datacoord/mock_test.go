@@ -222,6 +222,34 @@ func (m *mockRootCoordService) AlterAlias(ctx context.Context, req *milvuspb.Alt
 	panic("implement me")
 }
 
+func (m *mockRootCoordService) RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) AddCollectionField(ctx context.Context, req *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) AlterDatabase(ctx context.Context, req *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	panic("implement me")
+}
+
 func newMockRootCoordService() *mockRootCoordService {
 	return &mockRootCoordService{state: internalpb.StateCode_Healthy}
 }
@@ -465,6 +493,11 @@ func (m *mockRootCoordService) ListImportTasks(ctx context.Context, in *milvuspb
 	panic("not implemented") // TODO: Implement
 }
 
+// Cancel a pending or working import task
+func (m *mockRootCoordService) CancelImport(ctx context.Context, in *milvuspb.CancelImportRequest) (*milvuspb.GetImportStateResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 // Report impot task state to rootcoord
 func (m *mockRootCoordService) ReportImport(ctx context.Context, req *rootcoordpb.ImportResult) (*commonpb.Status, error) {
 	panic("not implemented") // TODO: Implement
@@ -628,6 +661,34 @@ func (m *mockRootCoordService) GetCredential(ctx context.Context, req *rootcoord
 	panic("implement me")
 }
 
+func (m *mockRootCoordService) SaveRole(ctx context.Context, req *milvuspb.SaveRoleRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) ListRoles(ctx context.Context, req *milvuspb.ListRolesRequest) (*milvuspb.ListRolesResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) SaveUserRoles(ctx context.Context, req *milvuspb.SaveUserRolesRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) ListUserRoles(ctx context.Context, req *milvuspb.ListUserRolesRequest) (*milvuspb.ListUserRolesResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) CreateAPIKey(ctx context.Context, req *milvuspb.CreateAPIKeyRequest) (*milvuspb.CreateAPIKeyResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) RevokeAPIKey(ctx context.Context, req *milvuspb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
 type mockHandler struct {
 }
 
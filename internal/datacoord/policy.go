@@ -426,6 +426,61 @@ func AverageReassignPolicy(store ROChannelStore, reassigns []*NodeChannelInfo) C
 	return ret
 }
 
+// ChannelMove moves a channel from a node to another
+type ChannelMove struct {
+	Channel *channel
+	From    int64
+	To      int64
+}
+
+// ChannelBalancePolicy picks at most maxMoves channels to move for balancing the channels between nodes
+type ChannelBalancePolicy func(store ROChannelStore, maxMoves int) []*ChannelMove
+
+// EmptyBalancePolicy does nothing
+func EmptyBalancePolicy(store ROChannelStore, maxMoves int) []*ChannelMove {
+	return nil
+}
+
+// AverageBalancePolicy moves channels from the node with the most channels to the one with the fewest,
+// until they differ by at most one channel
+func AverageBalancePolicy(store ROChannelStore, maxMoves int) []*ChannelMove {
+	infos := store.GetNodesChannels()
+	if len(infos) < 2 {
+		return nil
+	}
+
+	nodes := make([]int64, 0, len(infos))
+	channels := make(map[int64][]*channel, len(infos))
+	for _, info := range infos {
+		nodes = append(nodes, info.NodeID)
+		channels[info.NodeID] = append([]*channel{}, info.Channels...)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+
+	moves := make([]*ChannelMove, 0)
+	for len(moves) < maxMoves {
+		from, to := nodes[0], nodes[0]
+		for _, id := range nodes {
+			if len(channels[id]) > len(channels[from]) {
+				from = id
+			}
+			if len(channels[id]) < len(channels[to]) {
+				to = id
+			}
+		}
+		if len(channels[from])-len(channels[to]) <= 1 {
+			break
+		}
+
+		last := len(channels[from]) - 1
+		ch := channels[from][last]
+		channels[from] = channels[from][:last]
+		channels[to] = append(channels[to], ch)
+		moves = append(moves, &ChannelMove{Channel: ch, From: from, To: to})
+	}
+	return moves
+}
+
 // ChannelBGChecker check nodes' channels and return the channels needed to be reallocated.
 type ChannelBGChecker func(channels []*NodeChannelInfo, ts time.Time) ([]*NodeChannelInfo, error)
 
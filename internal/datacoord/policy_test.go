@@ -368,6 +368,81 @@ func TestAverageReassignPolicy(t *testing.T) {
 	}
 }
 
+func TestAverageBalancePolicy(t *testing.T) {
+	type args struct {
+		store    ROChannelStore
+		maxMoves int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []*ChannelMove
+	}{
+		{
+			"test only one node",
+			args{
+				&ChannelStore{
+					memkv.NewMemoryKV(),
+					map[int64]*NodeChannelInfo{
+						1: {1, []*channel{{"chan1", 1}, {"chan2", 1}}},
+					},
+				},
+				10,
+			},
+			nil,
+		},
+		{
+			"test balanced",
+			args{
+				&ChannelStore{
+					memkv.NewMemoryKV(),
+					map[int64]*NodeChannelInfo{
+						1: {1, []*channel{{"chan1", 1}, {"chan2", 1}}},
+						2: {2, []*channel{{"chan3", 1}}},
+					},
+				},
+				10,
+			},
+			[]*ChannelMove{},
+		},
+		{
+			"test move to new node",
+			args{
+				&ChannelStore{
+					memkv.NewMemoryKV(),
+					map[int64]*NodeChannelInfo{
+						1: {1, []*channel{{"chan1", 1}, {"chan2", 1}, {"chan3", 1}}},
+						2: {2, []*channel{{"chan4", 1}, {"chan5", 1}, {"chan6", 1}}},
+						3: {3, []*channel{}},
+					},
+				},
+				10,
+			},
+			[]*ChannelMove{{&channel{"chan3", 1}, 1, 3}, {&channel{"chan6", 1}, 2, 3}},
+		},
+		{
+			"test max moves",
+			args{
+				&ChannelStore{
+					memkv.NewMemoryKV(),
+					map[int64]*NodeChannelInfo{
+						1: {1, []*channel{{"chan1", 1}, {"chan2", 1}, {"chan3", 1}, {"chan4", 1}}},
+						2: {2, []*channel{}},
+					},
+				},
+				1,
+			},
+			[]*ChannelMove{{&channel{"chan4", 1}, 1, 2}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AverageBalancePolicy(tt.args.store, tt.args.maxMoves)
+			assert.EqualValues(t, tt.want, got)
+		})
+	}
+}
+
 func TestBgCheckWithMaxWatchDuration(t *testing.T) {
 	type watch struct {
 		nodeID int64
@@ -27,7 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
-type calUpperLimitPolicy func(schema *schemapb.CollectionSchema) (int, error)
+type calUpperLimitPolicy func(collectionID UniqueID, schema *schemapb.CollectionSchema) (int, error)
 
 func calBySchemaPolicy(schema *schemapb.CollectionSchema) (int, error) {
 	if schema == nil {
@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"go.uber.org/zap"
@@ -164,7 +165,9 @@ func withFlushPolicy(policy flushPolicy) allocOption {
 }
 
 func defaultCalUpperLimitPolicy() calUpperLimitPolicy {
-	return calBySchemaPolicy
+	return func(_ UniqueID, schema *schemapb.CollectionSchema) (int, error) {
+		return calBySchemaPolicy(schema)
+	}
 }
 
 func defaultAllocatePolicy() AllocatePolicy {
@@ -331,7 +334,7 @@ func (s *SegmentManager) estimateMaxNumOfRows(collectionID UniqueID) (int, error
 	if collMeta == nil {
 		return -1, fmt.Errorf("failed to get collection %d", collectionID)
 	}
-	return s.estimatePolicy(collMeta.Schema)
+	return s.estimatePolicy(collectionID, collMeta.Schema)
 }
 
 // DropSegment drop the segment from manager.
@@ -250,7 +250,7 @@ func TestAllocRowsLargerThanOneSegment(t *testing.T) {
 	assert.Nil(t, err)
 	meta.AddCollection(&datapb.CollectionInfo{ID: collID, Schema: schema})
 
-	var mockPolicy = func(schema *schemapb.CollectionSchema) (int, error) {
+	var mockPolicy = func(collectionID UniqueID, schema *schemapb.CollectionSchema) (int, error) {
 		return 1, nil
 	}
 	segmentManager := newSegmentManager(meta, mockAllocator, withCalUpperLimitPolicy(mockPolicy))
@@ -272,7 +272,7 @@ func TestExpireAllocation(t *testing.T) {
 	assert.Nil(t, err)
 	meta.AddCollection(&datapb.CollectionInfo{ID: collID, Schema: schema})
 
-	var mockPolicy = func(schema *schemapb.CollectionSchema) (int, error) {
+	var mockPolicy = func(collectionID UniqueID, schema *schemapb.CollectionSchema) (int, error) {
 		return 10000000, nil
 	}
 	segmentManager := newSegmentManager(meta, mockAllocator, withCalUpperLimitPolicy(mockPolicy))
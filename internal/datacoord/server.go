@@ -118,6 +118,7 @@ type Server struct {
 	garbageCollector *garbageCollector
 	gcOpt            GcOption
 	handler          Handler
+	sealConfigs      *collectionSealConfigs
 
 	compactionTrigger trigger
 	compactionHandler compactionPlanContext
@@ -282,7 +283,9 @@ func (s *Server) Start() error {
 		s.createCompactionTrigger()
 	}
 
-	s.startSegmentManager()
+	if err = s.startSegmentManager(); err != nil {
+		return err
+	}
 	if err = s.initServiceDiscovery(); err != nil {
 		return err
 	}
@@ -305,8 +308,13 @@ func (s *Server) initCluster() error {
 		return nil
 	}
 
+	opts := []ChannelManagerOpt{withMsgstreamFactory(s.factory), withStateChecker()}
+	if Params.DataCoordCfg.ChannelBalanceEnabled {
+		opts = append(opts, withBalanceChecker())
+	}
+
 	var err error
-	s.channelManager, err = NewChannelManager(s.kvClient, s.handler, withMsgstreamFactory(s.factory), withStateChecker())
+	s.channelManager, err = NewChannelManager(s.kvClient, s.handler, opts...)
 	if err != nil {
 		return err
 	}
@@ -341,7 +349,14 @@ func (s *Server) stopCompactionTrigger() {
 func (s *Server) initGarbageCollection() error {
 	var cli *minio.Client
 	var err error
-	if Params.DataCoordCfg.EnableGarbageCollection {
+	enabled := Params.DataCoordCfg.EnableGarbageCollection
+	// the garbage collector lists the objects by the minio client, it doesn't support other object storages yet
+	if enabled && (Params.CommonCfg.StorageType == "azure" || Params.CommonCfg.StorageType == "gcs") {
+		log.Warn("garbage collection is disabled as it doesn't support the storage type",
+			zap.String("storageType", Params.CommonCfg.StorageType))
+		enabled = false
+	}
+	if enabled {
 		cli, err = minio.New(Params.MinioCfg.Address, &minio.Options{
 			Creds:  credentials.NewStaticV4(Params.MinioCfg.AccessKeyID, Params.MinioCfg.SecretAccessKey, ""),
 			Secure: Params.MinioCfg.UseSSL,
@@ -375,13 +390,14 @@ func (s *Server) initGarbageCollection() error {
 
 	s.garbageCollector = newGarbageCollector(s.meta, GcOption{
 		cli:        cli,
-		enabled:    Params.DataCoordCfg.EnableGarbageCollection,
+		enabled:    enabled,
 		bucketName: Params.MinioCfg.BucketName,
 		rootPath:   Params.MinioCfg.RootPath,
 
 		checkInterval:    Params.DataCoordCfg.GCInterval,
 		missingTolerance: Params.DataCoordCfg.GCMissingTolerance,
 		dropTolerance:    Params.DataCoordCfg.GCDropTolerance,
+		dryRun:           Params.DataCoordCfg.GCDryRun,
 	})
 	return nil
 }
@@ -410,10 +426,18 @@ func (s *Server) initServiceDiscovery() error {
 	return nil
 }
 
-func (s *Server) startSegmentManager() {
-	if s.segmentManager == nil {
-		s.segmentManager = newSegmentManager(s.meta, s.allocator)
+func (s *Server) startSegmentManager() error {
+	if s.segmentManager != nil {
+		return nil
+	}
+	s.sealConfigs = newCollectionSealConfigs(s.etcdCli, Params.EtcdCfg.MetaRootPath)
+	if err := s.sealConfigs.start(s.ctx); err != nil {
+		return err
 	}
+	s.segmentManager = newSegmentManager(s.meta, s.allocator,
+		withCalUpperLimitPolicy(calByCollectionPolicy(s.sealConfigs)),
+		withSegmentSealPolices(append(defaultSegmentSealPolicy(), sealByCollectionIdlePolicy(s.sealConfigs))...))
+	return nil
 }
 
 func (s *Server) initMeta() error {
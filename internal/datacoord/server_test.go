@@ -44,6 +44,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/etcd"
@@ -853,6 +854,59 @@ func TestSaveBinlogPaths(t *testing.T) {
 		assert.EqualValues(t, segmentInfo.NumOfRows, 10)
 	})
 
+	t.Run("trigger compaction with delta logs of flushed segment", func(t *testing.T) {
+		Params.DataCoordCfg.EnableCompaction = true
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		triggered := make(chan UniqueID, 2)
+		svr.compactionTrigger.stop()
+		svr.compactionTrigger = &mockCompactionTrigger{
+			methods: map[string]interface{}{
+				"triggerSingleCompaction": func(collectionID int64, partitionID int64, segmentID int64, channel string, tt *timetravel) error {
+					triggered <- segmentID
+					return nil
+				},
+				"stop": func() {},
+			},
+		}
+
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 0})
+		segments := []struct {
+			id    UniqueID
+			state commonpb.SegmentState
+		}{
+			{0, commonpb.SegmentState_Growing},
+			{1, commonpb.SegmentState_Flushed},
+		}
+		for _, segment := range segments {
+			err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+				ID:            segment.id,
+				InsertChannel: "ch1",
+				State:         segment.state,
+			}))
+			assert.Nil(t, err)
+		}
+
+		err := svr.channelManager.AddNode(0)
+		assert.Nil(t, err)
+		err = svr.channelManager.Watch(&channel{"ch1", 0})
+		assert.Nil(t, err)
+
+		for _, segment := range segments {
+			resp, err := svr.SaveBinlogPaths(context.Background(), &datapb.SaveBinlogPathsRequest{
+				SegmentID: segment.id,
+				Deltalogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, fmt.Sprintf("deltalog%d", segment.id))},
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		}
+
+		// the delta logs of the growing segment are compacted once it is flushed
+		assert.Equal(t, 1, len(triggered))
+		assert.EqualValues(t, 1, <-triggered)
+	})
+
 	t.Run("with closed server", func(t *testing.T) {
 		svr := newTestServer(t, nil)
 		closeTestServer(t, svr)
@@ -2473,6 +2527,36 @@ func TestImport(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
 	})
+
+	t.Run("test refresh collection", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 100, Schema: &schemapb.CollectionSchema{Name: "old"}})
+		status, err := svr.RefreshCollection(context.TODO(), &datapb.RefreshCollectionRequest{CollectionID: 100})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+		status, err = svr.RefreshCollection(context.TODO(), &datapb.RefreshCollectionRequest{
+			CollectionID: 100,
+			Schema:       &schemapb.CollectionSchema{Name: "new"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.Equal(t, "new", svr.meta.GetCollection(100).GetSchema().GetName())
+	})
+
+	t.Run("test refresh collection w/ closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+
+		status, err := svr.RefreshCollection(context.TODO(), &datapb.RefreshCollectionRequest{
+			CollectionID: 100,
+			Schema:       &schemapb.CollectionSchema{Name: "new"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
 }
 
 // https://github.com/milvus-io/milvus/issues/15659
@@ -368,23 +368,25 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 	if req.GetFlushed() {
 		s.segmentManager.DropSegment(ctx, req.SegmentID)
 		s.flushCh <- req.SegmentID
+	}
+
+	// the delta logs saved for a flushed segment may make its deleted ratio exceed the threshold of compaction
+	if Params.DataCoordCfg.EnableCompaction &&
+		(req.GetFlushed() || (isFlush(segment) && len(req.GetDeltalogs()) > 0)) {
+		cctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
+		defer cancel()
 
-		if Params.DataCoordCfg.EnableCompaction {
-			cctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
-			defer cancel()
-
-			tt, err := getTimetravelReverseTime(cctx, s.allocator)
-			if err == nil {
-				err = s.compactionTrigger.triggerSingleCompaction(segment.GetCollectionID(),
-					segment.GetPartitionID(), segmentID, segment.GetInsertChannel(), tt)
-				if err != nil {
-					log.Warn("failed to trigger single compaction", zap.Int64("segment ID", segmentID))
-				} else {
-					log.Info("compaction triggered for segment", zap.Int64("segment ID", segmentID))
-				}
+		tt, err := getTimetravelReverseTime(cctx, s.allocator)
+		if err == nil {
+			err = s.compactionTrigger.triggerSingleCompaction(segment.GetCollectionID(),
+				segment.GetPartitionID(), segmentID, segment.GetInsertChannel(), tt)
+			if err != nil {
+				log.Warn("failed to trigger single compaction", zap.Int64("segment ID", segmentID))
 			} else {
-				log.Warn("failed to get time travel reverse time")
+				log.Info("compaction triggered for segment", zap.Int64("segment ID", segmentID))
 			}
+		} else {
+			log.Warn("failed to get time travel reverse time")
 		}
 	}
 	resp.ErrorCode = commonpb.ErrorCode_Success
@@ -1056,6 +1058,27 @@ func (s *Server) UpdateSegmentStatistics(ctx context.Context, req *datapb.Update
 	}, nil
 }
 
+// RefreshCollection replaces the schema cached for the collection once it's changed by RootCoord, e.g. renamed,
+// it's a no-op if the collection is not cached
+func (s *Server) RefreshCollection(ctx context.Context, req *datapb.RefreshCollectionRequest) (*commonpb.Status, error) {
+	log.Info("receive RefreshCollection request", zap.Int64("collectionID", req.GetCollectionID()))
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	if s.isClosed() {
+		log.Warn("failed to refresh collection for closed server")
+		resp.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.GetNodeID())
+		return resp, nil
+	}
+	if req.GetSchema() == nil {
+		resp.Reason = "schema is required to refresh the collection"
+		return resp, nil
+	}
+	s.meta.RefreshCollection(req.GetCollectionID(), req.GetSchema())
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
 // getDiff returns the difference of base and remove. i.e. all items that are in `base` but not in `remove`.
 func getDiff(base, remove []int64) []int64 {
 	mb := make(map[int64]struct{}, len(remove))
@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/compressor"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
@@ -175,7 +176,7 @@ func (b *binlogIO) upload(
 
 	// If there are delta binlogs
 	if dData.RowCount > 0 {
-		k, v, err := b.genDeltaBlobs(dData, meta.GetID(), partID, segID)
+		k, blob, err := b.genDeltaBlobs(dData, meta.GetID(), partID, segID)
 		if err != nil {
 			log.Warn("generate delta blobs wrong",
 				zap.Int64("collectionID", meta.GetID()),
@@ -184,13 +185,14 @@ func (b *binlogIO) upload(
 			return nil, err
 		}
 
-		kvs[k] = v
+		kvs[k] = blob.GetValue()
 		p.deltaInfo = append(p.deltaInfo, &datapb.FieldBinlog{
 			FieldID: 0, // TODO: Not useful on deltalogs, FieldID shall be ID of primary key field
 			Binlogs: []*datapb.Binlog{{
 				EntriesNum: dData.RowCount,
 				LogPath:    k,
-				LogSize:    int64(len(v)),
+				LogSize:    int64(len(blob.GetValue())),
+				MemorySize: blob.MemorySize,
 			}},
 		})
 	}
@@ -217,9 +219,23 @@ func (b *binlogIO) upload(
 	return p, nil
 }
 
-// genDeltaBlobs returns key, value
-func (b *binlogIO) genDeltaBlobs(data *DeleteData, collID, partID, segID UniqueID) (string, []byte, error) {
-	dCodec := storage.NewDeleteCodec()
+// newInsertCodec returns the InsertCodec compressing the binlogs as configured
+func newInsertCodec(meta *etcdpb.CollectionMeta) *storage.InsertCodec {
+	codec := storage.NewInsertCodec(meta)
+	codec.CompressType = compressor.CompressType(Params.DataNodeCfg.BinlogCompression)
+	return codec
+}
+
+// newDeleteCodec returns the DeleteCodec compressing the binlogs as configured
+func newDeleteCodec() *storage.DeleteCodec {
+	codec := storage.NewDeleteCodec()
+	codec.CompressType = compressor.CompressType(Params.DataNodeCfg.BinlogCompression)
+	return codec
+}
+
+// genDeltaBlobs returns key, blob
+func (b *binlogIO) genDeltaBlobs(data *DeleteData, collID, partID, segID UniqueID) (string, *Blob, error) {
+	dCodec := newDeleteCodec()
 
 	blob, err := dCodec.Serialize(collID, partID, segID, data)
 	if err != nil {
@@ -233,12 +249,12 @@ func (b *binlogIO) genDeltaBlobs(data *DeleteData, collID, partID, segID UniqueI
 
 	key := path.Join(Params.DataNodeCfg.DeleteBinlogRootPath, k)
 
-	return key, blob.GetValue(), nil
+	return key, blob, nil
 }
 
 // genInsertBlobs returns kvs, insert-paths, stats-paths
 func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta *etcdpb.CollectionMeta) (map[string][]byte, map[UniqueID]*datapb.FieldBinlog, map[UniqueID]*datapb.FieldBinlog, error) {
-	inCodec := storage.NewInsertCodec(meta)
+	inCodec := newInsertCodec(meta)
 	inlogs, statslogs, err := inCodec.Serialize(partID, segID, data)
 	if err != nil {
 		return nil, nil, nil, err
@@ -270,7 +286,7 @@ func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta
 		kvs[key] = value
 		inpaths[fID] = &datapb.FieldBinlog{
 			FieldID: fID,
-			Binlogs: []*datapb.Binlog{{LogSize: int64(fileLen), LogPath: key}},
+			Binlogs: []*datapb.Binlog{{LogSize: int64(fileLen), MemorySize: blob.MemorySize, LogPath: key}},
 		}
 	}
 
@@ -287,7 +303,7 @@ func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta
 		kvs[key] = value
 		statspaths[fID] = &datapb.FieldBinlog{
 			FieldID: fID,
-			Binlogs: []*datapb.Binlog{{LogSize: int64(fileLen), LogPath: key}},
+			Binlogs: []*datapb.Binlog{{LogSize: int64(fileLen), MemorySize: blob.MemorySize, LogPath: key}},
 		}
 	}
 
@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/compressor"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -249,6 +250,28 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 		assert.Empty(t, v)
 	})
 
+	t.Run("Test genDeltaBlobs compressed", func(t *testing.T) {
+		defer func(compression string) { Params.DataNodeCfg.BinlogCompression = compression }(Params.DataNodeCfg.BinlogCompression)
+		Params.DataNodeCfg.BinlogCompression = "zstd"
+
+		pk := newInt64PrimaryKey(1)
+		dData := &DeleteData{Pks: []primaryKey{pk}, Tss: []uint64{1}, RowCount: 1}
+		_, v, err := b.genDeltaBlobs(dData, 1, 1, 1)
+		assert.NoError(t, err)
+		typ, ok := compressor.DetectCompressType(v.GetValue())
+		assert.True(t, ok)
+		assert.Equal(t, compressor.CompressTypeZstd, typ)
+
+		// the memory size is the one of the plain binlog
+		plain, err := storage.NewDeleteCodec().Serialize(1, 1, 1, dData)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(len(plain.GetValue())), v.MemorySize)
+
+		_, _, data, err := storage.NewDeleteCodec().Deserialize([]*Blob{{Value: v.GetValue()}})
+		assert.NoError(t, err)
+		assert.Equal(t, []primaryKey{pk}, data.Pks)
+	})
+
 	t.Run("Test genInsertBlobs", func(t *testing.T) {
 		f := &MetaFactory{}
 		tests := []struct {
@@ -274,6 +297,11 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 				assert.Equal(t, 1, len(pstats))
 				assert.Equal(t, 12, len(pin))
 				assert.Equal(t, 13, len(kvs))
+				for _, fieldBinlog := range pin {
+					binlog := fieldBinlog.GetBinlogs()[0]
+					assert.Equal(t, int64(len(kvs[binlog.GetLogPath()])), binlog.GetLogSize())
+					assert.Equal(t, binlog.GetLogSize(), binlog.GetMemorySize())
+				}
 
 				log.Debug("test paths",
 					zap.Any("kvs no.", len(kvs)),
@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+// backpressureDelay is how long an insert buffer node pauses consuming after each message pack
+// while the insert buffers of datanode exceed the high watermark
+const backpressureDelay = 100 * time.Millisecond
+
+// bufferWatermark sums up the memory of the insert buffers of all the vchannels of datanode. Once it exceeds the
+// high watermark, datanode is pressured until the buffers are flushed below the low watermark, the collections
+// consumed are reported on the transitions so that proxies could reject the inserts to them.
+type bufferWatermark struct {
+	high int64
+	low  int64
+
+	mu          sync.Mutex
+	buffered    map[string]int64    // vchannel -> bytes of the insert buffers
+	collections map[string]UniqueID // vchannel -> collection ID
+	pressured   bool
+	report      func(collections []UniqueID) // called with nil once the pressure is relieved
+}
+
+func newBufferWatermark(high, low int64, report func(collections []UniqueID)) *bufferWatermark {
+	return &bufferWatermark{
+		high:        high,
+		low:         low,
+		buffered:    make(map[string]int64),
+		collections: make(map[string]UniqueID),
+		report:      report,
+	}
+}
+
+// update sets the memory of the insert buffers of the vchannel
+func (w *bufferWatermark) update(vchannel string, collectionID UniqueID, bytes int64) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.buffered[vchannel] = bytes
+	w.collections[vchannel] = collectionID
+	w.check()
+}
+
+// remove forgets the vchannel once its flowgraph is closed
+func (w *bufferWatermark) remove(vchannel string) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.buffered, vchannel)
+	delete(w.collections, vchannel)
+	w.check()
+}
+
+// isPressured returns whether the insert buffers exceeded the high watermark and are not flushed below the low one
+func (w *bufferWatermark) isPressured() bool {
+	if w == nil {
+		return false
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.pressured
+}
+
+// check reports the transition of the pressure, the report is made with the lock held to keep the order.
+func (w *bufferWatermark) check() {
+	var total int64
+	for _, bytes := range w.buffered {
+		total += bytes
+	}
+	switch {
+	case !w.pressured && total >= w.high:
+		w.pressured = true
+		log.Warn("insert buffers exceed the high watermark", zap.Int64("buffered", total), zap.Int64("high", w.high))
+		w.report(w.pressuredCollections())
+	case w.pressured && total < w.low:
+		w.pressured = false
+		log.Info("insert buffers are below the low watermark", zap.Int64("buffered", total), zap.Int64("low", w.low))
+		w.report(nil)
+	}
+}
+
+func (w *bufferWatermark) pressuredCollections() []UniqueID {
+	set := make(map[UniqueID]struct{})
+	collections := make([]UniqueID, 0, len(w.collections))
+	for _, collectionID := range w.collections {
+		if _, ok := set[collectionID]; ok {
+			continue
+		}
+		set[collectionID] = struct{}{}
+		collections = append(collections, collectionID)
+	}
+	sort.Slice(collections, func(i, j int) bool { return collections[i] < collections[j] })
+	return collections
+}
+
+// reportBackpressure returns the report function of bufferWatermark, which puts the pressured collections
+// to {MetaRootPath}/datanode/backpressure/{nodeID} with the lease of the session, so that the key is removed
+// with the session if datanode is gone.
+func reportBackpressure(ctx context.Context, etcdCli *clientv3.Client, session *sessionutil.Session, metaRootPath string,
+	nodeID UniqueID) func(collections []UniqueID) {
+	key := path.Join(metaRootPath, util.DataNodeBackpressurePrefix, strconv.FormatInt(nodeID, 10))
+	return func(collections []UniqueID) {
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		if len(collections) == 0 {
+			if _, err := etcdCli.Delete(ctx, key); err != nil {
+				log.Warn("failed to remove the backpressure of datanode", zap.String("key", key), zap.Error(err))
+			}
+			return
+		}
+		value, err := json.Marshal(collections)
+		if err != nil {
+			log.Warn("failed to marshal the pressured collections", zap.Error(err))
+			return
+		}
+		if _, err := etcdCli.Put(ctx, key, string(value), clientv3.WithLease(session.LeaseID())); err != nil {
+			log.Warn("failed to report the backpressure of datanode", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
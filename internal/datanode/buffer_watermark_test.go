@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBufferWatermark(t *testing.T) {
+	var reports [][]UniqueID
+	w := newBufferWatermark(100, 50, func(collections []UniqueID) {
+		reports = append(reports, collections)
+	})
+
+	w.update("ch-1", 1, 40)
+	w.update("ch-2", 2, 40)
+	assert.False(t, w.isPressured())
+	assert.Empty(t, reports)
+
+	w.update("ch-3", 1, 20)
+	assert.True(t, w.isPressured())
+	assert.Equal(t, [][]UniqueID{{1, 2}}, reports)
+
+	// stays pressured above the low watermark
+	w.update("ch-1", 1, 0)
+	assert.True(t, w.isPressured())
+	assert.Len(t, reports, 1)
+
+	w.remove("ch-2")
+	assert.False(t, w.isPressured())
+	assert.Equal(t, [][]UniqueID{{1, 2}, nil}, reports)
+
+	var nilWatermark *bufferWatermark
+	nilWatermark.update("ch-1", 1, 100)
+	nilWatermark.remove("ch-1")
+	assert.False(t, nilWatermark.isPressured())
+}
+
+func TestBufferData_memorySize(t *testing.T) {
+	Params.Init()
+	bd, err := newBufferData(128)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), bd.memorySize())
+
+	bd.updateSize(bd.limit / 2)
+	assert.InDelta(t, Params.DataNodeCfg.FlushInsertBufferSize/2, bd.memorySize(), 512)
+}
@@ -186,6 +186,18 @@ func (t *compactionTask) mergeDeltalogs(dBlobs map[UniqueID][]*Blob, timetravelT
 	return pk2ts, dbuff, nil
 }
 
+// pkValue returns the comparable value of the primary key
+func pkValue(pk primaryKey) interface{} {
+	switch key := pk.(type) {
+	case *storage.Int64PrimaryKey:
+		return key.Value
+	case *storage.VarCharPrimaryKey:
+		return key.Value
+	default:
+		return pk
+	}
+}
+
 // nano2Milli transfers nanoseconds to milliseconds in unit
 func nano2Milli(nano time.Duration) float64 {
 	return float64(nano) / float64(time.Millisecond)
@@ -201,19 +213,24 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[primaryKey]Timestamp
 		expired          int64 // the number of expired entity
 		err              error
 
+		ttl         = t.getEntityTTL(schema)
 		iDatas      = make([]*InsertData, 0)
 		fID2Type    = make(map[UniqueID]schemapb.DataType)
 		fID2Content = make(map[UniqueID][]interface{})
+		fID2Default = make(map[UniqueID]interface{})
 	)
 
-	isDeletedValue := func(v *storage.Value) bool {
-		for pk, ts := range delta {
-			if pk.EQ(v.PK) && uint64(v.Timestamp) <= ts {
-				return true
-			}
+	// index the deletions by the pk value, a heavily deleted segment is then merged in linear time
+	deleted := make(map[interface{}]Timestamp, len(delta))
+	for pk, ts := range delta {
+		if key := pkValue(pk); ts > deleted[key] {
+			deleted[key] = ts
 		}
+	}
 
-		return false
+	isDeletedValue := func(v *storage.Value) bool {
+		ts, ok := deleted[pkValue(v.PK)]
+		return ok && uint64(v.Timestamp) <= ts
 	}
 
 	// get dim
@@ -233,6 +250,18 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[primaryKey]Timestamp
 		}
 	}
 
+	// get default values
+	for _, fs := range schema.GetFields() {
+		value, ok, err := typeutil.GetDefaultValue(fs)
+		if err != nil {
+			log.Warn("invalid default value", zap.Int64("fieldID", fs.GetFieldID()), zap.Error(err))
+			return nil, 0, err
+		}
+		if ok {
+			fID2Default[fs.GetFieldID()] = value
+		}
+	}
+
 	expired = 0
 	for mergeItr.HasNext() {
 		//  no error if HasNext() returns true
@@ -250,7 +279,7 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[primaryKey]Timestamp
 
 		ts := Timestamp(v.Timestamp)
 		// Filtering expired entity
-		if t.isExpiredEntity(ts, currentTs) {
+		if t.isExpiredEntity(ts, currentTs, ttl) {
 			expired++
 			continue
 		}
@@ -267,6 +296,12 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[primaryKey]Timestamp
 			}
 			fID2Content[fID] = append(fID2Content[fID], vInter)
 		}
+		// the rows written before the fields were added to the collection
+		for fID, value := range fID2Default {
+			if _, ok := row[fID]; !ok {
+				fID2Content[fID] = append(fID2Content[fID], value)
+			}
+		}
 	}
 
 	// calculate numRows from rowID field, fieldID 0
@@ -401,9 +436,8 @@ func (t *compactionTask) compact() error {
 		// Get the number of field binlog files from non-empty segment
 		var binlogNum int
 		for _, b := range s.GetFieldBinlogs() {
-			if b != nil {
+			if len(b.GetBinlogs()) > binlogNum {
 				binlogNum = len(b.GetBinlogs())
-				break
 			}
 		}
 		// Unable to deal with all empty segments cases, so return error
@@ -415,7 +449,13 @@ func (t *compactionTask) compact() error {
 		for idx := 0; idx < binlogNum; idx++ {
 			var ps []string
 			for _, f := range s.GetFieldBinlogs() {
-				ps = append(ps, f.GetBinlogs()[idx].GetLogPath())
+				// the field added to the collection while the segment was being written has no binlog
+				// of the earlier flushes, the rows are filled with the default value in merge
+				offset := binlogNum - len(f.GetBinlogs())
+				if idx < offset {
+					continue
+				}
+				ps = append(ps, f.GetBinlogs()[idx-offset].GetLogPath())
 			}
 
 			g.Go(func() error {
@@ -744,6 +784,13 @@ func (t *compactionTask) getSegmentMeta(segID UniqueID) (UniqueID, UniqueID, *et
 	if err != nil {
 		return -1, -1, nil, err
 	}
+	// the segments to compact may have the binlogs of the fields added after the schema was cached
+	if t.hasUnknownBinlogs(sch) {
+		sch, err = t.refreshCollectionSchema(collID, 0)
+		if err != nil {
+			return -1, -1, nil, err
+		}
+	}
 
 	meta := &etcdpb.CollectionMeta{
 		ID:     collID,
@@ -752,6 +799,21 @@ func (t *compactionTask) getSegmentMeta(segID UniqueID) (UniqueID, UniqueID, *et
 	return collID, partID, meta, nil
 }
 
+func (t *compactionTask) hasUnknownBinlogs(schema *schemapb.CollectionSchema) bool {
+	fieldIDs := make(map[UniqueID]struct{}, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		fieldIDs[field.GetFieldID()] = struct{}{}
+	}
+	for _, segmentBinlogs := range t.plan.GetSegmentBinlogs() {
+		for _, fieldBinlog := range segmentBinlogs.GetFieldBinlogs() {
+			if _, ok := fieldIDs[fieldBinlog.GetFieldID()]; !ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (t *compactionTask) getCollection() UniqueID {
 	return t.getCollectionID()
 }
@@ -760,14 +822,23 @@ func (t *compactionTask) GetCurrentTime() typeutil.Timestamp {
 	return tsoutil.GetCurrentTime()
 }
 
-func (t *compactionTask) isExpiredEntity(ts, now Timestamp) bool {
+// getEntityTTL returns the ttl of the entities of the collection, an invalid ttl of the collection is ignored
+func (t *compactionTask) getEntityTTL(schema *schemapb.CollectionSchema) time.Duration {
+	collectionTTL, err := typeutil.GetCollectionTTL(schema)
+	if err != nil {
+		log.Warn("invalid collection ttl, ignored", zap.Int64("planID", t.getPlanID()), zap.Error(err))
+	}
+	return Params.CommonCfg.GetEntityTTL(collectionTTL)
+}
+
+func (t *compactionTask) isExpiredEntity(ts, now Timestamp, ttl time.Duration) bool {
 	// entity expire is not enabled if duration <= 0
-	if Params.CommonCfg.EntityExpirationTTL <= 0 {
+	if ttl <= 0 {
 		return false
 	}
 
 	pts, _ := tsoutil.ParseTS(ts)
 	pnow, _ := tsoutil.ParseTS(now)
-	expireTime := pts.Add(Params.CommonCfg.EntityExpirationTTL)
+	expireTime := pts.Add(ttl)
 	return expireTime.Before(pnow)
 }
@@ -19,10 +19,13 @@ package datanode
 import (
 	"context"
 	"math"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/common"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -298,6 +301,57 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			assert.NotEmpty(t, idata[0].Data)
 		})
 
+		t.Run("Merge with deletion at the insert timestamp", func(t *testing.T) {
+			Params.CommonCfg.EntityExpirationTTL = 0
+			iData := genInsertDataWithExpiredTS()
+			meta := NewMetaFactory().GetCollectionMeta(1, "test", schemapb.DataType_Int64)
+
+			iblobs, err := getInsertBlobs(100, iData, meta)
+			require.NoError(t, err)
+
+			// the entity inserted after the deletion is kept, as the one upserted is inserted after its deletion
+			iitr, err := storage.NewInsertBinlogIterator(iblobs, 106, schemapb.DataType_Int64)
+			require.NoError(t, err)
+			dm := map[primaryKey]Timestamp{
+				newInt64PrimaryKey(1): 329749364735999999,
+			}
+			ct := &compactionTask{}
+			_, numOfRow, err := ct.merge(storage.NewMergeIterator([]iterator{iitr}), dm, meta.GetSchema(), ct.GetCurrentTime())
+			assert.NoError(t, err)
+			assert.Equal(t, int64(2), numOfRow)
+
+			// the entity inserted at the timestamp of the deletion is deleted
+			iitr, err = storage.NewInsertBinlogIterator(iblobs, 106, schemapb.DataType_Int64)
+			require.NoError(t, err)
+			dm = map[primaryKey]Timestamp{
+				newInt64PrimaryKey(1): 329749364736000000,
+			}
+			_, numOfRow, err = ct.merge(storage.NewMergeIterator([]iterator{iitr}), dm, meta.GetSchema(), ct.GetCurrentTime())
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), numOfRow)
+
+			// the entity inserted after the deletion is kept
+			iitr, err = storage.NewInsertBinlogIterator(iblobs, 106, schemapb.DataType_Int64)
+			require.NoError(t, err)
+			dm = map[primaryKey]Timestamp{
+				newInt64PrimaryKey(1): 329749364735999999,
+			}
+			_, numOfRow, err = ct.merge(storage.NewMergeIterator([]iterator{iitr}), dm, meta.GetSchema(), ct.GetCurrentTime())
+			assert.NoError(t, err)
+			assert.Equal(t, int64(2), numOfRow)
+
+			// the latest of the deletions of the same pk applies
+			iitr, err = storage.NewInsertBinlogIterator(iblobs, 106, schemapb.DataType_Int64)
+			require.NoError(t, err)
+			dm = map[primaryKey]Timestamp{
+				newInt64PrimaryKey(1): 329749364736000000,
+				newInt64PrimaryKey(1): 329749364735999999,
+			}
+			_, numOfRow, err = ct.merge(storage.NewMergeIterator([]iterator{iitr}), dm, meta.GetSchema(), ct.GetCurrentTime())
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), numOfRow)
+		})
+
 		t.Run("Merge with expiration", func(t *testing.T) {
 			Params.CommonCfg.EntityExpirationTTL = 864000 // 10 days in seconds
 			iData := genInsertDataWithExpiredTS()
@@ -322,6 +376,58 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			assert.Equal(t, int64(1), numOfRow)
 			assert.Equal(t, 1, len(idata))
 		})
+
+		t.Run("Merge with collection ttl", func(t *testing.T) {
+			Params.CommonCfg.EntityExpirationTTL = 0
+			iData := genInsertDataWithExpiredTS()
+			meta := NewMetaFactory().GetCollectionMeta(1, "test", schemapb.DataType_Int64)
+			meta.Schema.Properties = append(meta.Schema.Properties, &commonpb.KeyValuePair{
+				Key:   common.CollectionTTLParam,
+				Value: strconv.FormatInt(Params.CommonCfg.RetentionDuration, 10),
+			})
+
+			iblobs, err := getInsertBlobs(100, iData, meta)
+			require.NoError(t, err)
+
+			iitr, err := storage.NewInsertBinlogIterator(iblobs, 106, schemapb.DataType_Int64)
+			require.NoError(t, err)
+
+			mitr := storage.NewMergeIterator([]iterator{iitr})
+
+			ct := &compactionTask{}
+			idata, numOfRow, err := ct.merge(mitr, map[primaryKey]Timestamp{}, meta.GetSchema(), genTimestamp())
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), numOfRow)
+			assert.Equal(t, 1, len(idata))
+		})
+		t.Run("Merge with added field", func(t *testing.T) {
+			Params.CommonCfg.EntityExpirationTTL = 0
+			iData := genInsertDataWithExpiredTS()
+			meta := NewMetaFactory().GetCollectionMeta(1, "test", schemapb.DataType_Int64)
+
+			iblobs, err := getInsertBlobs(100, iData, meta)
+			require.NoError(t, err)
+
+			iitr, err := storage.NewInsertBinlogIterator(iblobs, 106, schemapb.DataType_Int64)
+			require.NoError(t, err)
+
+			mitr := storage.NewMergeIterator([]iterator{iitr})
+
+			// the field is added after the binlogs are written
+			meta.Schema.Fields = append(meta.Schema.Fields, &schemapb.FieldSchema{
+				FieldID:    200,
+				Name:       "added",
+				DataType:   schemapb.DataType_Int32,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueParam, Value: "5"}},
+			})
+
+			ct := &compactionTask{}
+			idata, numOfRow, err := ct.merge(mitr, map[primaryKey]Timestamp{}, meta.GetSchema(), ct.GetCurrentTime())
+			assert.NoError(t, err)
+			assert.Equal(t, int64(2), numOfRow)
+			assert.Equal(t, 1, len(idata))
+			assert.Equal(t, []int32{5, 5}, idata[0].Data[200].(*storage.Int32FieldData).Data)
+		})
 	})
 
 	t.Run("Test isExpiredEntity", func(t *testing.T) {
@@ -329,57 +435,57 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			Params.CommonCfg.EntityExpirationTTL = math.MaxInt64
 
 			ct := &compactionTask{}
-			res := ct.isExpiredEntity(0, genTimestamp())
+			res := ct.isExpiredEntity(0, genTimestamp(), Params.CommonCfg.EntityExpirationTTL)
 			assert.Equal(t, false, res)
 
-			res = ct.isExpiredEntity(math.MaxInt64, genTimestamp())
+			res = ct.isExpiredEntity(math.MaxInt64, genTimestamp(), Params.CommonCfg.EntityExpirationTTL)
 			assert.Equal(t, false, res)
 
-			res = ct.isExpiredEntity(0, math.MaxInt64)
+			res = ct.isExpiredEntity(0, math.MaxInt64, Params.CommonCfg.EntityExpirationTTL)
 			assert.Equal(t, true, res)
 
-			res = ct.isExpiredEntity(math.MaxInt64, math.MaxInt64)
+			res = ct.isExpiredEntity(math.MaxInt64, math.MaxInt64, Params.CommonCfg.EntityExpirationTTL)
 			assert.Equal(t, false, res)
 
-			res = ct.isExpiredEntity(math.MaxInt64, 0)
+			res = ct.isExpiredEntity(math.MaxInt64, 0, Params.CommonCfg.EntityExpirationTTL)
 			assert.Equal(t, false, res)
 		})
 		t.Run("When CompactionEntityExpiration is set MAX_ENTITY_EXPIRATION = 0", func(t *testing.T) {
 			Params.CommonCfg.EntityExpirationTTL = 0 // 0 means expiration is not enabled
 
 			ct := &compactionTask{}
-			res := ct.isExpiredEntity(0, genTimestamp())
+			res := ct.isExpiredEntity(0, genTimestamp(), Params.CommonCfg.EntityExpirationTTL)
 			assert.Equal(t, false, res)
 
-			res = ct.isExpiredEntity(math.MaxInt64, genTimestamp())
+			res = ct.isExpiredEntity(math.MaxInt64, genTimestamp(), Params.CommonCfg.EntityExpirationTTL)
 			assert.Equal(t, false, res)
 
-			res = ct.isExpiredEntity(0, math.MaxInt64)
+			res = ct.isExpiredEntity(0, math.MaxInt64, Params.CommonCfg.EntityExpirationTTL)
 			assert.Equal(t, false, res)
 
-			res = ct.isExpiredEntity(math.MaxInt64, math.MaxInt64)
+			res = ct.isExpiredEntity(math.MaxInt64, math.MaxInt64, Params.CommonCfg.EntityExpirationTTL)
 			assert.Equal(t, false, res)
 
-			res = ct.isExpiredEntity(math.MaxInt64, 0)
+			res = ct.isExpiredEntity(math.MaxInt64, 0, Params.CommonCfg.EntityExpirationTTL)
 			assert.Equal(t, false, res)
 		})
 		t.Run("When CompactionEntityExpiration is set 10 days", func(t *testing.T) {
 			Params.CommonCfg.EntityExpirationTTL = 864000 // 10 days in seconds
 
 			ct := &compactionTask{}
-			res := ct.isExpiredEntity(0, genTimestamp())
+			res := ct.isExpiredEntity(0, genTimestamp(), Params.CommonCfg.EntityExpirationTTL)
 			assert.Equal(t, true, res)
 
-			res = ct.isExpiredEntity(math.MaxInt64, genTimestamp())
+			res = ct.isExpiredEntity(math.MaxInt64, genTimestamp(), Params.CommonCfg.EntityExpirationTTL)
 			assert.Equal(t, false, res)
 
-			res = ct.isExpiredEntity(0, math.MaxInt64)
+			res = ct.isExpiredEntity(0, math.MaxInt64, Params.CommonCfg.EntityExpirationTTL)
 			assert.Equal(t, true, res)
 
-			res = ct.isExpiredEntity(math.MaxInt64, math.MaxInt64)
+			res = ct.isExpiredEntity(math.MaxInt64, math.MaxInt64, Params.CommonCfg.EntityExpirationTTL)
 			assert.Equal(t, false, res)
 
-			res = ct.isExpiredEntity(math.MaxInt64, 0)
+			res = ct.isExpiredEntity(math.MaxInt64, 0, Params.CommonCfg.EntityExpirationTTL)
 			assert.Equal(t, false, res)
 		})
 	})
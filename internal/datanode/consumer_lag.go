@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+)
+
+// consumerLagKey is the label values of a consumer reported to prometheus
+type consumerLagKey struct {
+	channel      string
+	subscription string
+}
+
+// reportConsumerLags reports the lags of the msgstream consumers of the flowgraphs to prometheus periodically,
+// so that the data not flushed in time could be traced to the lag of the mq.
+func (node *DataNode) reportConsumerLags(ctx context.Context) {
+	ticker := time.NewTicker(Params.CommonCfg.MsgStreamLagReportInterval)
+	defer ticker.Stop()
+	reported := make(map[consumerLagKey]struct{})
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reported = setConsumerLagMetrics(node.flowgraphManager.getConsumerLags(), reported)
+		}
+	}
+}
+
+// setConsumerLagMetrics sets the metrics of the lags and deletes the ones of the consumers reported last time
+// but gone, returns the consumers reported this time
+func setConsumerLagMetrics(lags []*msgstream.ConsumerLag, lastReported map[consumerLagKey]struct{}) map[consumerLagKey]struct{} {
+	nodeID := strconv.FormatInt(Params.DataNodeCfg.GetNodeID(), 10)
+	reported := make(map[consumerLagKey]struct{}, len(lags))
+	for _, lag := range lags {
+		metrics.DataNodeConsumerMsgLag.WithLabelValues(nodeID, lag.Channel, lag.Subscription).Set(float64(lag.MsgLag))
+		metrics.DataNodeConsumerTimeLag.WithLabelValues(nodeID, lag.Channel, lag.Subscription).Set(lag.TimeLag.Seconds())
+		reported[consumerLagKey{channel: lag.Channel, subscription: lag.Subscription}] = struct{}{}
+	}
+	for key := range lastReported {
+		if _, ok := reported[key]; !ok {
+			metrics.DataNodeConsumerMsgLag.DeleteLabelValues(nodeID, key.channel, key.subscription)
+			metrics.DataNodeConsumerTimeLag.DeleteLabelValues(nodeID, key.channel, key.subscription)
+		}
+	}
+	return reported
+}
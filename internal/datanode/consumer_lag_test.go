@@ -0,0 +1,49 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+)
+
+func TestSetConsumerLagMetrics(t *testing.T) {
+	lags := []*msgstream.ConsumerLag{
+		{Channel: "ch-1", Subscription: "sub-1", MsgLag: 10, TimeLag: 2 * time.Second},
+		{Channel: "ch-2", Subscription: "sub-2", MsgLag: -1, TimeLag: time.Second},
+	}
+	reported := setConsumerLagMetrics(lags, nil)
+	assert.Len(t, reported, 2)
+
+	nodeID := strconv.FormatInt(Params.DataNodeCfg.GetNodeID(), 10)
+	assert.Equal(t, float64(10), testutil.ToFloat64(metrics.DataNodeConsumerMsgLag.WithLabelValues(nodeID, "ch-1", "sub-1")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.DataNodeConsumerTimeLag.WithLabelValues(nodeID, "ch-1", "sub-1")))
+	assert.Equal(t, float64(-1), testutil.ToFloat64(metrics.DataNodeConsumerMsgLag.WithLabelValues(nodeID, "ch-2", "sub-2")))
+
+	// the metrics of the consumers gone are deleted
+	reported = setConsumerLagMetrics(lags[:1], reported)
+	assert.Len(t, reported, 1)
+	assert.False(t, metrics.DataNodeConsumerMsgLag.DeleteLabelValues(nodeID, "ch-2", "sub-2"))
+	assert.False(t, metrics.DataNodeConsumerTimeLag.DeleteLabelValues(nodeID, "ch-2", "sub-2"))
+}
@@ -112,6 +112,7 @@ type DataNode struct {
 	clearSignal        chan string // vchannel name
 	segmentCache       *Cache
 	compactionExecutor *compactionExecutor
+	bufferWatermark    *bufferWatermark // nil if backpressure disabled
 
 	etcdCli   *clientv3.Client
 	rootCoord types.RootCoord
@@ -470,10 +471,18 @@ func (node *DataNode) Start() error {
 
 	FilterThreshold = rep.GetTimestamp()
 
+	if Params.DataNodeCfg.BackpressureEnabled {
+		node.bufferWatermark = newBufferWatermark(Params.DataNodeCfg.BackpressureHighWatermark,
+			Params.DataNodeCfg.BackpressureLowWatermark,
+			reportBackpressure(node.ctx, node.etcdCli, node.session, Params.EtcdCfg.MetaRootPath, Params.DataNodeCfg.GetNodeID()))
+	}
+
 	go node.BackGroundGC(node.clearSignal)
 
 	go node.compactionExecutor.start(node.ctx)
 
+	go node.reportConsumerLags(node.ctx)
+
 	// Start node watch node
 	go node.StartWatchChannels(node.ctx)
 
@@ -724,6 +733,9 @@ func (node *DataNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRe
 
 		return systemInfoMetrics, nil
 	}
+	if metricType == metricsinfo.ConsumerLagsMetrics {
+		return node.getConsumerLagsMetrics()
+	}
 
 	log.Debug("DataNode.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("node_id", Params.DataNodeCfg.GetNodeID()),
@@ -797,9 +809,16 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 		AutoIds:    make([]int64, 0),
 		RowCount:   0,
 	}
+	// the report fails if rootcoord rejects it, e.g. the task is canceled, so that the import is aborted
 	reportFunc := func(res *rootcoordpb.ImportResult) error {
-		_, err := node.rootCoord.ReportImport(ctx, res)
-		return err
+		status, err := node.rootCoord.ReportImport(ctx, res)
+		if err != nil {
+			return err
+		}
+		if status.GetErrorCode() != commonpb.ErrorCode_Success {
+			return errors.New(status.GetReason())
+		}
+		return nil
 	}
 
 	if !node.isHealthy() {
@@ -811,7 +830,7 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 
 		msg := msgDataNodeIsUnhealthy(Params.DataNodeCfg.GetNodeID())
 		importResult.State = commonpb.ImportState_ImportFailed
-		importResult.Infos = append(importResult.Infos, &commonpb.KeyValuePair{Key: "failed_reason", Value: msg})
+		importResult.Infos = append(importResult.Infos, &commonpb.KeyValuePair{Key: importutil.FailedReasonKey, Value: msg})
 		reportFunc(importResult)
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -832,7 +851,7 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 		msg := "DataNode alloc ts failed"
 		log.Warn(msg)
 		importResult.State = commonpb.ImportState_ImportFailed
-		importResult.Infos = append(importResult.Infos, &commonpb.KeyValuePair{Key: "failed_reason", Value: msg})
+		importResult.Infos = append(importResult.Infos, &commonpb.KeyValuePair{Key: importutil.FailedReasonKey, Value: msg})
 		reportFunc(importResult)
 		if err != nil {
 			return &commonpb.Status{
@@ -848,7 +867,7 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 	schema, err := metaService.getCollectionSchema(ctx, req.GetImportTask().GetCollectionId(), 0)
 	if err != nil {
 		importResult.State = commonpb.ImportState_ImportFailed
-		importResult.Infos = append(importResult.Infos, &commonpb.KeyValuePair{Key: "failed_reason", Value: err.Error()})
+		importResult.Infos = append(importResult.Infos, &commonpb.KeyValuePair{Key: importutil.FailedReasonKey, Value: err.Error()})
 		reportFunc(importResult)
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -867,7 +886,7 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 	err = importWrapper.Import(req.GetImportTask().GetFiles(), req.GetImportTask().GetRowBased(), false)
 	if err != nil {
 		importResult.State = commonpb.ImportState_ImportFailed
-		importResult.Infos = append(importResult.Infos, &commonpb.KeyValuePair{Key: "failed_reason", Value: err.Error()})
+		importResult.Infos = append(importResult.Infos, &commonpb.KeyValuePair{Key: importutil.FailedReasonKey, Value: err.Error()})
 		reportFunc(importResult)
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -952,7 +971,7 @@ func importFlushReqFunc(node *DataNode, req *datapb.ImportTaskRequest, res *root
 			ID:     req.GetImportTask().GetCollectionId(),
 			Schema: schema,
 		}
-		inCodec := storage.NewInsertCodec(meta)
+		inCodec := newInsertCodec(meta)
 
 		binLogs, statsBinlogs, err := inCodec.Serialize(req.GetImportTask().GetPartitionId(), segmentID, data.buffer)
 		if err != nil {
@@ -988,6 +1007,7 @@ func importFlushReqFunc(node *DataNode, req *datapb.ImportTaskRequest, res *root
 				TimestampTo:   0, //TODO,
 				LogPath:       key,
 				LogSize:       int64(len(blob.Value)),
+				MemorySize:    blob.MemorySize,
 			}
 			field2Logidx[fieldID] = logidx
 		}
@@ -1014,6 +1034,7 @@ func importFlushReqFunc(node *DataNode, req *datapb.ImportTaskRequest, res *root
 				TimestampTo:   0, //TODO,
 				LogPath:       key,
 				LogSize:       int64(len(blob.Value)),
+				MemorySize:    blob.MemorySize,
 			}
 		}
 
@@ -321,6 +321,14 @@ func TestDataNode(t *testing.T) {
 		log.Info("Test DataNode.GetMetrics",
 			zap.String("name", resp.ComponentName),
 			zap.String("response", resp.Response))
+
+		node.flowgraphManager = newFlowgraphManager()
+		req, err = metricsinfo.ConstructRequestByMetricType(metricsinfo.ConsumerLagsMetrics)
+		assert.NoError(t, err)
+		resp, err = node.GetMetrics(node.ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, "[]", resp.Response)
 	})
 
 	t.Run("Test Import", func(t *testing.T) {
@@ -37,6 +37,7 @@ type dataSyncService struct {
 	ctx          context.Context
 	cancelFn     context.CancelFunc
 	fg           *flowgraph.TimeTickedFlowGraph // internal flowgraph processes insert/delta messages
+	inputNode    *flowgraph.InputNode           // input node of fg consuming the dml channel
 	flushCh      chan flushMsg                  // chan to notify flush
 	replica      Replica                        // segment replica stores meta
 	idAllocator  allocatorInterface             // id/timestamp allocator
@@ -50,6 +51,7 @@ type dataSyncService struct {
 	flushManager     flushManager // flush manager handles flush process
 	chunkManager     storage.ChunkManager
 	compactor        *compactionExecutor // reference to compaction executor
+	watermark        *bufferWatermark    // memory watermark of the insert buffers of datanode, nil if backpressure disabled
 }
 
 func newDataSyncService(ctx context.Context,
@@ -63,6 +65,7 @@ func newDataSyncService(ctx context.Context,
 	flushingSegCache *Cache,
 	chunkManager storage.ChunkManager,
 	compactor *compactionExecutor,
+	watermark *bufferWatermark,
 ) (*dataSyncService, error) {
 
 	if replica == nil {
@@ -86,6 +89,7 @@ func newDataSyncService(ctx context.Context,
 		flushingSegCache: flushingSegCache,
 		chunkManager:     chunkManager,
 		compactor:        compactor,
+		watermark:        watermark,
 	}
 
 	if err := service.initNodes(vchan); err != nil {
@@ -105,6 +109,7 @@ type nodeConfig struct {
 	vChannelName string
 	replica      Replica // Segment replica
 	allocator    allocatorInterface
+	watermark    *bufferWatermark
 
 	// defaults
 	parallelConfig
@@ -206,16 +211,18 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 		vChannelName: vchanInfo.GetChannelName(),
 		replica:      dsService.replica,
 		allocator:    dsService.idAllocator,
+		watermark:    dsService.watermark,
 
 		parallelConfig: newParallelConfig(),
 	}
 
 	var err error
 	var dmStreamNode Node
-	dmStreamNode, err = newDmInputNode(dsService.ctx, vchanInfo.GetSeekPosition(), c)
+	dsService.inputNode, err = newDmInputNode(dsService.ctx, vchanInfo.GetSeekPosition(), c)
 	if err != nil {
 		return err
 	}
+	dmStreamNode = dsService.inputNode
 
 	var ddNode Node = newDDNode(dsService.ctx, dsService.collectionID, vchanInfo, dsService.msFactory, dsService.compactor)
 	var insertBufferNode Node
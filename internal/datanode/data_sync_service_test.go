@@ -155,6 +155,7 @@ func TestDataSyncService_newDataSyncService(te *testing.T) {
 				newCache(),
 				cm,
 				newCompactionExecutor(),
+				nil,
 			)
 
 			if !test.isValidCase {
@@ -228,7 +229,7 @@ func TestDataSyncService_Start(t *testing.T) {
 	}
 
 	signalCh := make(chan string, 100)
-	sync, err := newDataSyncService(ctx, flushChan, replica, allocFactory, factory, vchan, signalCh, &DataCoordFactory{}, newCache(), cm, newCompactionExecutor())
+	sync, err := newDataSyncService(ctx, flushChan, replica, allocFactory, factory, vchan, signalCh, &DataCoordFactory{}, newCache(), cm, newCompactionExecutor(), nil)
 
 	assert.Nil(t, err)
 	// sync.replica.addCollection(collMeta.ID, collMeta.Schema)
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/opentracing/opentracing-go"
@@ -50,10 +51,12 @@ type (
 
 type insertBufferNode struct {
 	BaseNode
+	collectionID UniqueID
 	channelName  string
 	insertBuffer sync.Map // SegmentID to BufferData
 	replica      Replica
 	idAllocator  allocatorInterface
+	watermark    *bufferWatermark
 
 	flushMap         sync.Map
 	flushChan        <-chan flushMsg
@@ -139,12 +142,21 @@ func (bd *BufferData) updateSize(no int64) {
 	bd.size += no
 }
 
+// memorySize estimates the bytes buffered, the limit is the rows FlushInsertBufferSize could hold
+func (bd *BufferData) memorySize() int64 {
+	if bd.limit <= 0 {
+		return 0
+	}
+	return bd.size * Params.DataNodeCfg.FlushInsertBufferSize / bd.limit
+}
+
 func (ibNode *insertBufferNode) Name() string {
 	return "ibNode-" + ibNode.channelName
 }
 
 func (ibNode *insertBufferNode) Close() {
 	ibNode.ttMerger.close()
+	ibNode.watermark.remove(ibNode.channelName)
 
 	if ibNode.timeTickStream != nil {
 		ibNode.timeTickStream.Close()
@@ -303,6 +315,32 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 			}
 		}
 
+		// Flush the largest buffer of the vchannel if datanode is under memory pressure
+		if ibNode.watermark.isPressured() {
+			if segmentID, buf := ibNode.largestBuffer(); buf != nil {
+				dup := false
+				for _, task := range flushTaskList {
+					if task.segmentID == segmentID {
+						dup = true
+						break
+					}
+				}
+				if !dup {
+					log.Info("Auto flush by memory pressure",
+						zap.Int64("segment id", segmentID),
+						zap.String("vchannel name", ibNode.channelName),
+					)
+					flushTaskList = append(flushTaskList, flushTask{
+						buffer:    buf,
+						segmentID: segmentID,
+						flushed:   false,
+						dropped:   false,
+						auto:      true,
+					})
+				}
+			}
+		}
+
 		// Manual Flush
 		select {
 		case fmsg := <-ibNode.flushChan:
@@ -362,6 +400,14 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 		}
 	}
 
+	if ibNode.watermark != nil {
+		ibNode.watermark.update(ibNode.channelName, ibNode.collectionID, ibNode.bufferedMemory())
+		// slow down consuming until the buffers are flushed below the low watermark
+		if ibNode.watermark.isPressured() {
+			time.Sleep(backpressureDelay)
+		}
+	}
+
 	if err := ibNode.writeHardTimeTick(fgMsg.timeRange.timestampMax, seg2Upload); err != nil {
 		log.Error("send hard time tick into pulsar channel failed", zap.Error(err))
 	}
@@ -383,6 +429,32 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 	return []Msg{&res}
 }
 
+// largestBuffer returns the insert buffer of the vchannel with the most rows, nil if nothing buffered
+func (ibNode *insertBufferNode) largestBuffer() (UniqueID, *BufferData) {
+	var (
+		segmentID UniqueID
+		largest   *BufferData
+	)
+	ibNode.insertBuffer.Range(func(k, v interface{}) bool {
+		buf := v.(*BufferData)
+		if buf.size > 0 && (largest == nil || buf.size > largest.size) {
+			segmentID, largest = k.(UniqueID), buf
+		}
+		return true
+	})
+	return segmentID, largest
+}
+
+// bufferedMemory returns the estimated bytes of all the insert buffers of the vchannel
+func (ibNode *insertBufferNode) bufferedMemory() int64 {
+	var bytes int64
+	ibNode.insertBuffer.Range(func(_, v interface{}) bool {
+		bytes += v.(*BufferData).memorySize()
+		return true
+	})
+	return bytes
+}
+
 // updateSegStatesInReplica updates statistics in replica for the segments in insertMsgs.
 //  If the segment doesn't exist, a new segment will be created.
 //  The segment number of rows will be updated in mem, waiting to be uploaded to DataCoord.
@@ -439,6 +511,16 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 		log.Error("Get schema wrong:", zap.Error(err))
 		return err
 	}
+	// the fields added to the collection after the schema was cached come with the insert msg
+	refreshed := false
+	if hasUnknownFields(collSchema, msg.GetFieldsData()) {
+		collSchema, err = ibNode.replica.refreshCollectionSchema(collectionID, msg.EndTs())
+		if err != nil {
+			log.Error("Refresh schema wrong:", zap.Error(err))
+			return err
+		}
+		refreshed = true
+	}
 
 	// Get Dimension
 	// TODO GOOSE: under assumption that there's only 1 Vector field in one collection schema
@@ -464,6 +546,12 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 
 	buffer := bd.(*BufferData)
 	// idata := buffer.buffer
+	if refreshed && buffer.buffer != nil {
+		// the rows buffered before lack the added fields, which are filled with the default values
+		if err := storage.FillDefaultInsertData(buffer.buffer, collSchema, int(buffer.size)); err != nil {
+			return err
+		}
+	}
 
 	addedBuffer, err := storage.InsertMsgToInsertData(msg, collSchema)
 	if err != nil {
@@ -494,6 +582,23 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 	return nil
 }
 
+// hasUnknownFields returns whether the fields data contains the fields not in the schema
+func hasUnknownFields(schema *schemapb.CollectionSchema, fieldsData []*schemapb.FieldData) bool {
+	for _, fieldData := range fieldsData {
+		found := false
+		for _, field := range schema.GetFields() {
+			if field.GetFieldID() == fieldData.GetFieldId() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return true
+		}
+	}
+	return false
+}
+
 // writeHardTimeTick writes timetick once insertBufferNode operates.
 func (ibNode *insertBufferNode) writeHardTimeTick(ts Timestamp, segmentIDs []int64) error {
 	ibNode.ttLogger.LogTs(ts)
@@ -567,10 +672,12 @@ func newInsertBufferNode(ctx context.Context, collID UniqueID, flushCh <-chan fl
 		flushingSegCache: flushingSegCache,
 		flushManager:     fm,
 
-		replica:     config.replica,
-		idAllocator: config.allocator,
-		channelName: config.vChannelName,
-		ttMerger:    mt,
-		ttLogger:    &timeTickLogger{vChannelName: config.vChannelName},
+		replica:      config.replica,
+		idAllocator:  config.allocator,
+		collectionID: config.collectionID,
+		channelName:  config.vChannelName,
+		watermark:    config.watermark,
+		ttMerger:     mt,
+		ttLogger:     &timeTickLogger{vChannelName: config.vChannelName},
 	}, nil
 }
@@ -679,6 +679,15 @@ func TestInsertBufferNode_bufferInsertMsg(t *testing.T) {
 	}
 }
 
+func TestInsertBufferNode_hasUnknownFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{FieldID: 100}, {FieldID: 101}},
+	}
+	assert.False(t, hasUnknownFields(schema, nil))
+	assert.False(t, hasUnknownFields(schema, []*schemapb.FieldData{{FieldId: 100}, {FieldId: 101}}))
+	assert.True(t, hasUnknownFields(schema, []*schemapb.FieldData{{FieldId: 100}, {FieldId: 102}}))
+}
+
 func TestInsertBufferNode_updateSegStatesInReplica(te *testing.T) {
 	cm := storage.NewLocalChunkManager(storage.RootPath(insertNodeTestDir))
 	defer cm.RemoveWithPrefix("")
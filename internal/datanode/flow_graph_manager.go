@@ -22,6 +22,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 
 	"go.uber.org/zap"
@@ -55,7 +56,7 @@ func (fm *flowgraphManager) addAndStart(dn *DataNode, vchan *datapb.VchannelInfo
 
 	var alloc allocatorInterface = newAllocator(dn.rootCoord)
 
-	dataSyncService, err := newDataSyncService(dn.ctx, make(chan flushMsg, 100), replica, alloc, dn.factory, vchan, dn.clearSignal, dn.dataCoord, dn.segmentCache, dn.chunkManager, dn.compactionExecutor)
+	dataSyncService, err := newDataSyncService(dn.ctx, make(chan flushMsg, 100), replica, alloc, dn.factory, vchan, dn.clearSignal, dn.dataCoord, dn.segmentCache, dn.chunkManager, dn.compactionExecutor, dn.bufferWatermark)
 	if err != nil {
 		log.Warn("new data sync service fail", zap.String("vChannelName", vchan.GetChannelName()), zap.Error(err))
 		return err
@@ -104,6 +105,18 @@ func (fm *flowgraphManager) getFlushCh(segID UniqueID) (chan<- flushMsg, error)
 	return nil, fmt.Errorf("cannot find segment %d in all flowgraphs", segID)
 }
 
+// getConsumerLags returns the lags of the consumers of all the flowgraphs
+func (fm *flowgraphManager) getConsumerLags() []*msgstream.ConsumerLag {
+	var lags []*msgstream.ConsumerLag
+	fm.flowgraphs.Range(func(key, value interface{}) bool {
+		if inputNode := value.(*dataSyncService).inputNode; inputNode != nil {
+			lags = append(lags, inputNode.ConsumerLags()...)
+		}
+		return true
+	})
+	return lags
+}
+
 func (fm *flowgraphManager) getFlowgraphService(vchan string) (*dataSyncService, bool) {
 	fg, ok := fm.flowgraphs.Load(vchan)
 	if ok {
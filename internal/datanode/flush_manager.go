@@ -342,7 +342,7 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 	}
 
 	// encode data and convert output data
-	inCodec := storage.NewInsertCodec(meta)
+	inCodec := newInsertCodec(meta)
 
 	binLogs, statsBinlogs, err := inCodec.Serialize(partID, segmentID, data.buffer)
 	if err != nil {
@@ -377,6 +377,7 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 			TimestampTo:   0, //TODO,
 			LogPath:       key,
 			LogSize:       int64(len(blob.Value)),
+			MemorySize:    blob.MemorySize,
 		}
 		field2Logidx[fieldID] = logidx
 	}
@@ -403,6 +404,7 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 			TimestampTo:   0, //TODO,
 			LogPath:       key,
 			LogSize:       int64(len(blob.Value)),
+			MemorySize:    blob.MemorySize,
 		}
 	}
 
@@ -431,7 +433,7 @@ func (m *rendezvousFlushManager) flushDelData(data *DelDataBuf, segmentID Unique
 		return err
 	}
 
-	delCodec := storage.NewDeleteCodec()
+	delCodec := newDeleteCodec()
 
 	blob, err := delCodec.Serialize(collID, partID, segmentID, data.delData)
 	if err != nil {
@@ -448,6 +450,7 @@ func (m *rendezvousFlushManager) flushDelData(data *DelDataBuf, segmentID Unique
 	blobPath := path.Join(Params.DataNodeCfg.DeleteBinlogRootPath, blobKey)
 	kvs := map[string][]byte{blobPath: blob.Value[:]}
 	data.LogSize = int64(len(blob.Value))
+	data.MemorySize = blob.MemorySize
 	data.LogPath = blobPath
 	log.Info("delete blob path", zap.String("path", blobPath))
 	m.handleDeleteTask(segmentID, &flushBufferDeleteTask{
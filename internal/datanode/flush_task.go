@@ -150,6 +150,7 @@ func (t *flushTaskRunner) runFlushDel(task flushDeleteTask, deltaLogs *DelDataBu
 			t.deltaLogs = []*datapb.Binlog{
 				{
 					LogSize:       deltaLogs.GetLogSize(),
+					MemorySize:    deltaLogs.GetMemorySize(),
 					LogPath:       deltaLogs.GetLogPath(),
 					TimestampFrom: deltaLogs.GetTimestampFrom(),
 					TimestampTo:   deltaLogs.GetTimestampTo(),
@@ -18,6 +18,8 @@ package datanode
 
 import (
 	"context"
+	"encoding/json"
+	"sort"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
@@ -73,3 +75,37 @@ func (node *DataNode) getSystemInfoMetrics(ctx context.Context, req *milvuspb.Ge
 		ComponentName: metricsinfo.ConstructComponentName(typeutil.DataNodeRole, Params.DataNodeCfg.GetNodeID()),
 	}, nil
 }
+
+// getConsumerLagsMetrics returns the lags of the msgstream consumers of the flowgraphs of DataNode
+func (node *DataNode) getConsumerLagsMetrics() (*milvuspb.GetMetricsResponse, error) {
+	lags := make([]metricsinfo.ConsumerLag, 0)
+	for _, lag := range node.flowgraphManager.getConsumerLags() {
+		lags = append(lags, metricsinfo.ConsumerLag{
+			Channel:        lag.Channel,
+			Subscription:   lag.Subscription,
+			MsgLag:         lag.MsgLag,
+			TimeLagSeconds: lag.TimeLag.Seconds(),
+		})
+	}
+	sort.Slice(lags, func(i, j int) bool { return lags[i].Channel < lags[j].Channel })
+	resp, err := json.Marshal(lags)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			Response:      "",
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.DataNodeRole, Params.DataNodeCfg.GetNodeID()),
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.DataNodeRole, Params.DataNodeCfg.GetNodeID()),
+	}, nil
+}
@@ -35,12 +35,6 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 )
 
-const (
-	// TODO silverxia maybe need set from config
-	bloomFilterSize       uint    = 100000
-	maxBloomFalsePositive float64 = 0.005
-)
-
 type primaryKey = storage.PrimaryKey
 type int64PrimaryKey = storage.Int64PrimaryKey
 type varCharPrimaryKey = storage.VarCharPrimaryKey
@@ -52,6 +46,7 @@ var newVarCharPrimaryKey = storage.NewVarCharPrimaryKey
 type Replica interface {
 	getCollectionID() UniqueID
 	getCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error)
+	refreshCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error)
 	getCollectionAndPartitionID(segID UniqueID) (collID, partitionID UniqueID, err error)
 
 	listAllSegmentIDs() []UniqueID
@@ -101,6 +96,7 @@ type Segment struct {
 // It implements `Replica` interface.
 type SegmentReplica struct {
 	collectionID UniqueID
+	schemaMu     sync.RWMutex
 	collSchema   *schemapb.CollectionSchema
 
 	segMu             sync.RWMutex
@@ -277,7 +273,7 @@ func (replica *SegmentReplica) addNewSegment(segID, collID, partitionID UniqueID
 		startPos:   startPos,
 		endPos:     endPos,
 
-		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
+		pkFilter: replica.newPKFilter(),
 	}
 
 	seg.isNew.Store(true)
@@ -362,7 +358,7 @@ func (replica *SegmentReplica) addNormalSegment(segID, collID, partitionID Uniqu
 		channelName:  channelName,
 		numRows:      numOfRows,
 
-		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
+		pkFilter: replica.newPKFilter(),
 	}
 
 	if cp != nil {
@@ -410,8 +406,7 @@ func (replica *SegmentReplica) addFlushedSegment(segID, collID, partitionID Uniq
 		channelName:  channelName,
 		numRows:      numOfRows,
 
-		//TODO silverxia, normal segments bloom filter and pk range should be loaded from serialized files
-		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
+		pkFilter: replica.newPKFilter(),
 	}
 
 	err := replica.initPKBloomFilter(seg, statsBinlogs, recoverTs)
@@ -472,7 +467,7 @@ func (replica *SegmentReplica) initPKBloomFilter(s *Segment, statsBinlogs []*dat
 		return err
 	}
 	for _, stat := range stats {
-		err = s.pkFilter.Merge(stat.BF)
+		s.pkFilter, err = storage.MergeBloomFilter(s.pkFilter, stat.BF)
 		if err != nil {
 			return err
 		}
@@ -677,6 +672,8 @@ func (replica *SegmentReplica) getCollectionSchema(collID UniqueID, ts Timestamp
 		return nil, fmt.Errorf("not supported collection %v", collID)
 	}
 
+	replica.schemaMu.Lock()
+	defer replica.schemaMu.Unlock()
 	if replica.collSchema == nil {
 		sch, err := replica.metaService.getCollectionSchema(context.Background(), collID, ts)
 		if err != nil {
@@ -689,6 +686,33 @@ func (replica *SegmentReplica) getCollectionSchema(collID UniqueID, ts Timestamp
 	return replica.collSchema, nil
 }
 
+// refreshCollectionSchema gets the collection schema from rootcoord again, it's called once the fields added to
+// the collection after the schema was cached are found.
+func (replica *SegmentReplica) refreshCollectionSchema(collID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error) {
+	if !replica.validCollection(collID) {
+		return nil, fmt.Errorf("not supported collection %v", collID)
+	}
+
+	sch, err := replica.metaService.getCollectionSchema(context.Background(), collID, ts)
+	if err != nil {
+		log.Error("Grpc error", zap.Error(err))
+		return nil, err
+	}
+	replica.schemaMu.Lock()
+	replica.collSchema = sch
+	replica.schemaMu.Unlock()
+	log.Info("collection schema refreshed", zap.Int64("collectionID", collID), zap.Int("numFields", len(sch.GetFields())))
+	return sch, nil
+}
+
+// newPKFilter returns an empty pk bloom filter built with the parameters of the collection,
+// the defaults are used if the schema is not fetched yet.
+func (replica *SegmentReplica) newPKFilter() *bloom.BloomFilter {
+	replica.schemaMu.RLock()
+	defer replica.schemaMu.RUnlock()
+	return storage.GetCollectionBloomFilterParams(replica.collSchema).NewBloomFilter()
+}
+
 func (replica *SegmentReplica) validCollection(collID UniqueID) bool {
 	return collID == replica.collectionID
 }
@@ -734,7 +758,7 @@ func (replica *SegmentReplica) mergeFlushedSegments(segID, collID, partID, planI
 		channelName:  channelName,
 		numRows:      numOfRows,
 
-		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
+		pkFilter: replica.newPKFilter(),
 	}
 
 	replica.segMu.Lock()
@@ -750,7 +774,12 @@ func (replica *SegmentReplica) mergeFlushedSegments(segID, collID, partID, planI
 		replica.compactedSegments[ID] = s
 		delete(replica.flushedSegments, ID)
 
-		seg.pkFilter.Merge(s.pkFilter)
+		merged, err := storage.MergeBloomFilter(seg.pkFilter, s.pkFilter)
+		if err != nil {
+			log.Warn("failed to merge pk bloom filter of compacted segment", zap.Int64("segmentID", ID), zap.Error(err))
+			continue
+		}
+		seg.pkFilter = merged
 	}
 	replica.segMu.Unlock()
 
@@ -787,7 +816,7 @@ func (replica *SegmentReplica) addFlushedSegmentWithPKs(segID, collID, partID Un
 		channelName:  channelName,
 		numRows:      numOfRows,
 
-		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
+		pkFilter: replica.newPKFilter(),
 	}
 
 	seg.updatePKRange(ids)
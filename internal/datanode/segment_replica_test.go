@@ -54,7 +54,7 @@ func (kv *mockDataCM) MultiRead(keys []string) ([][]byte, error) {
 		FieldID: common.RowIDField,
 		Min:     0,
 		Max:     10,
-		BF:      bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
+		BF:      bloom.NewWithEstimates(storage.DefaultBloomFilterCardinality, storage.DefaultBloomFilterFPR),
 	}
 	buffer, _ := json.Marshal(stats)
 	return [][]byte{buffer}, nil
@@ -582,6 +582,27 @@ func TestSegmentReplica_InterfaceMethod(t *testing.T) {
 		rc.setCollectionID(1)
 	})
 
+	t.Run("Test_refreshCollectionSchema", func(t *testing.T) {
+		sr, err := newReplica(context.Background(), rc, cm, 1)
+		assert.Nil(t, err)
+		sr.collSchema = &schemapb.CollectionSchema{}
+
+		s, err := sr.refreshCollectionSchema(1, Timestamp(0))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, s.GetFields())
+		s2, err := sr.getCollectionSchema(1, Timestamp(0))
+		assert.NoError(t, err)
+		assert.Equal(t, s, s2)
+
+		_, err = sr.refreshCollectionSchema(2, Timestamp(0))
+		assert.Error(t, err)
+
+		rc.setCollectionID(-1)
+		_, err = sr.refreshCollectionSchema(1, Timestamp(0))
+		assert.Error(t, err)
+		rc.setCollectionID(1)
+	})
+
 	t.Run("Test listAllSegmentIDs", func(t *testing.T) {
 		sr := &SegmentReplica{
 			newSegments:     map[UniqueID]*Segment{1: {segmentID: 1}},
@@ -53,6 +53,11 @@ func NewClient(ctx context.Context, metaRoot string, etcdCli *clientv3.Client) (
 		return nil, err
 	}
 	ClientParams.InitOnce(typeutil.DataCoordRole)
+	tlsConfig, err := ClientParams.InternalClientTLSConfig()
+	if err != nil {
+		log.Debug("DataCoordClient NewClient failed", zap.Error(err))
+		return nil, err
+	}
 	client := &Client{
 		grpcClient: &grpcclient.ClientBase{
 			ClientMaxRecvSize: ClientParams.ClientMaxRecvSize,
@@ -60,6 +65,7 @@ func NewClient(ctx context.Context, metaRoot string, etcdCli *clientv3.Client) (
 			DialTimeout:       ClientParams.DialTimeout,
 			KeepAliveTime:     ClientParams.KeepAliveTime,
 			KeepAliveTimeout:  ClientParams.KeepAliveTimeout,
+			TLSConfig:         tlsConfig,
 		},
 		sess: sess,
 	}
@@ -531,3 +537,17 @@ func (c *Client) UpdateSegmentStatistics(ctx context.Context, req *datapb.Update
 	}
 	return ret.(*commonpb.Status), err
 }
+
+// RefreshCollection replaces the schema cached for the collection.
+func (c *Client) RefreshCollection(ctx context.Context, req *datapb.RefreshCollectionRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataCoordClient).RefreshCollection(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
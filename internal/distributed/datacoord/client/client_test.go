@@ -129,6 +129,9 @@ func Test_NewClient(t *testing.T) {
 
 		r24, err := client.UpdateSegmentStatistics(ctx, nil)
 		retCheck(retNotNil, r24, err)
+
+		r25, err := client.RefreshCollection(ctx, nil)
+		retCheck(retNotNil, r25, err)
 	}
 
 	client.grpcClient = &mock.ClientBase{
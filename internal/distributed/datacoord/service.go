@@ -29,6 +29,7 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 
 	"github.com/milvus-io/milvus/internal/datacoord"
@@ -144,13 +145,24 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	}
 
 	opts := trace.GetInterceptorOpts()
-	s.grpcServer = grpc.NewServer(
+	grpcOpts := []grpc.ServerOption{
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(ot.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)))
+		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)),
+	}
+	tlsConfig, err := Params.InternalServerTLSConfig()
+	if err != nil {
+		log.Error("DataCoord GrpcServer:failed to load internal tls config", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+	if tlsConfig != nil {
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	s.grpcServer = grpc.NewServer(grpcOpts...)
 	datapb.RegisterDataCoordServer(s.grpcServer, s)
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
 	if err := s.grpcServer.Serve(lis); err != nil {
@@ -340,3 +352,8 @@ func (s *Server) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*da
 func (s *Server) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	return s.dataCoord.UpdateSegmentStatistics(ctx, req)
 }
+
+// RefreshCollection is the dataCoord service caller of RefreshCollection.
+func (s *Server) RefreshCollection(ctx context.Context, req *datapb.RefreshCollectionRequest) (*commonpb.Status, error) {
+	return s.dataCoord.RefreshCollection(ctx, req)
+}
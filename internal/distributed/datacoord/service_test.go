@@ -58,6 +58,7 @@ type MockDataCoord struct {
 	setSegmentStateResp  *datapb.SetSegmentStateResponse
 	importResp           *datapb.ImportTaskResponse
 	updateSegStatResp    *commonpb.Status
+	refreshCollResp      *commonpb.Status
 }
 
 func (m *MockDataCoord) Init() error {
@@ -179,6 +180,10 @@ func (m *MockDataCoord) UpdateSegmentStatistics(ctx context.Context, req *datapb
 	return m.updateSegStatResp, m.err
 }
 
+func (m *MockDataCoord) RefreshCollection(ctx context.Context, req *datapb.RefreshCollectionRequest) (*commonpb.Status, error) {
+	return m.refreshCollResp, m.err
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 func Test_NewServer(t *testing.T) {
 	ctx := context.Background()
@@ -420,6 +425,17 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("refresh collection", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			refreshCollResp: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+		}
+		resp, err := server.RefreshCollection(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	err := server.Stop()
 	assert.Nil(t, err)
 }
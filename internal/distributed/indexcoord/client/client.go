@@ -53,6 +53,11 @@ func NewClient(ctx context.Context, metaRoot string, etcdCli *clientv3.Client) (
 		return nil, err
 	}
 	ClientParams.InitOnce(typeutil.IndexCoordRole)
+	tlsConfig, err := ClientParams.InternalClientTLSConfig()
+	if err != nil {
+		log.Debug("IndexCoordClient NewClient failed", zap.Error(err))
+		return nil, err
+	}
 	client := &Client{
 		grpcClient: &grpcclient.ClientBase{
 			ClientMaxRecvSize: ClientParams.ClientMaxRecvSize,
@@ -60,6 +65,7 @@ func NewClient(ctx context.Context, metaRoot string, etcdCli *clientv3.Client) (
 			DialTimeout:       ClientParams.DialTimeout,
 			KeepAliveTime:     ClientParams.KeepAliveTime,
 			KeepAliveTimeout:  ClientParams.KeepAliveTimeout,
+			TLSConfig:         tlsConfig,
 		},
 		sess: sess,
 	}
@@ -181,6 +187,34 @@ func (c *Client) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (
 	return ret.(*commonpb.Status), err
 }
 
+// CancelIndexBuild sends the cancel index build request to IndexCoord.
+func (c *Client) CancelIndexBuild(ctx context.Context, req *indexpb.CancelIndexBuildRequest) (*milvuspb.CancelIndexBuildResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexCoordClient).CancelIndexBuild(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*milvuspb.CancelIndexBuildResponse), err
+}
+
+// PrioritizeIndexBuild sends the prioritize index build request to IndexCoord.
+func (c *Client) PrioritizeIndexBuild(ctx context.Context, req *indexpb.PrioritizeIndexBuildRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(indexpb.IndexCoordClient).PrioritizeIndexBuild(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
 // GetIndexStates gets the index states from IndexCoord.
 func (c *Client) GetIndexStates(ctx context.Context, req *indexpb.GetIndexStatesRequest) (*indexpb.GetIndexStatesResponse, error) {
 	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
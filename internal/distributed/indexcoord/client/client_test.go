@@ -99,6 +99,24 @@ func TestIndexCoordClient(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	t.Run("CancelIndexBuild", func(t *testing.T) {
+		req := &indexpb.CancelIndexBuildRequest{
+			IndexBuildID: 1,
+		}
+		resp, err := icc.CancelIndexBuild(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("PrioritizeIndexBuild", func(t *testing.T) {
+		req := &indexpb.PrioritizeIndexBuildRequest{
+			CollectionID: 1,
+		}
+		resp, err := icc.PrioritizeIndexBuild(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	t.Run("GetIndexStates", func(t *testing.T) {
 		req := &indexpb.GetIndexStatesRequest{
 			IndexBuildIDs: []int64{0},
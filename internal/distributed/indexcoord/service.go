@@ -27,6 +27,7 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 
 	ot "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
@@ -190,6 +191,16 @@ func (s *Server) DropIndex(ctx context.Context, request *indexpb.DropIndexReques
 	return s.indexcoord.DropIndex(ctx, request)
 }
 
+// CancelIndexBuild sends the cancel index build request to IndexCoord.
+func (s *Server) CancelIndexBuild(ctx context.Context, req *indexpb.CancelIndexBuildRequest) (*milvuspb.CancelIndexBuildResponse, error) {
+	return s.indexcoord.CancelIndexBuild(ctx, req)
+}
+
+// PrioritizeIndexBuild sends the prioritize index build request to IndexCoord.
+func (s *Server) PrioritizeIndexBuild(ctx context.Context, req *indexpb.PrioritizeIndexBuildRequest) (*commonpb.Status, error) {
+	return s.indexcoord.PrioritizeIndexBuild(ctx, req)
+}
+
 // GetIndexFilePaths gets the index file paths from IndexCoord.
 func (s *Server) GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
 	return s.indexcoord.GetIndexFilePaths(ctx, req)
@@ -226,13 +237,24 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	defer cancel()
 
 	opts := trace.GetInterceptorOpts()
-	s.grpcServer = grpc.NewServer(
+	grpcOpts := []grpc.ServerOption{
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(ot.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)))
+		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)),
+	}
+	tlsConfig, err := Params.InternalServerTLSConfig()
+	if err != nil {
+		log.Error("IndexCoord GrpcServer:failed to load internal tls config", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+	if tlsConfig != nil {
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	s.grpcServer = grpc.NewServer(grpcOpts...)
 	indexpb.RegisterIndexCoordServer(s.grpcServer, s)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
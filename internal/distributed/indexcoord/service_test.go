@@ -98,6 +98,24 @@ func TestIndexCoordinateServer(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	t.Run("CancelIndexBuild", func(t *testing.T) {
+		req := &indexpb.CancelIndexBuildRequest{
+			IndexBuildID: 1,
+		}
+		resp, err := server.CancelIndexBuild(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("PrioritizeIndexBuild", func(t *testing.T) {
+		req := &indexpb.PrioritizeIndexBuildRequest{
+			CollectionID: 1,
+		}
+		resp, err := server.PrioritizeIndexBuild(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	t.Run("GetIndexFilePaths", func(t *testing.T) {
 		req := &indexpb.GetIndexFilePathsRequest{
 			IndexBuildIDs: []UniqueID{0, 1},
@@ -45,6 +45,10 @@ func NewClient(ctx context.Context, addr string) (*Client, error) {
 		return nil, fmt.Errorf("address is empty")
 	}
 	ClientParams.InitOnce(typeutil.ProxyRole)
+	tlsConfig, err := ClientParams.InternalClientTLSConfig()
+	if err != nil {
+		return nil, err
+	}
 	client := &Client{
 		addr: addr,
 		grpcClient: &grpcclient.ClientBase{
@@ -53,6 +57,7 @@ func NewClient(ctx context.Context, addr string) (*Client, error) {
 			DialTimeout:       ClientParams.DialTimeout,
 			KeepAliveTime:     ClientParams.KeepAliveTime,
 			KeepAliveTimeout:  ClientParams.KeepAliveTimeout,
+			TLSConfig:         tlsConfig,
 		},
 	}
 	client.grpcClient.SetRole(typeutil.ProxyRole)
@@ -1,23 +1,60 @@
 package httpserver
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/types"
 )
 
+// Authorizer authenticates the http requests and authorizes the operations of them,
+// the operations are named after the methods of the gRPC service of proxy.
+type Authorizer interface {
+	Authenticate(ctx context.Context, header http.Header) (context.Context, error)
+	Authorize(ctx context.Context, operation string, req interface{}) error
+}
+
 // Handlers handles http requests
 type Handlers struct {
-	proxy types.ProxyComponent
+	proxy      types.ProxyComponent
+	authorizer Authorizer
 }
 
-// NewHandlers creates a new Handlers
-func NewHandlers(proxy types.ProxyComponent) *Handlers {
+// NewHandlers creates a new Handlers, the requests are not authenticated if authorizer is nil
+func NewHandlers(proxy types.ProxyComponent, authorizer Authorizer) *Handlers {
 	return &Handlers{
-		proxy: proxy,
+		proxy:      proxy,
+		authorizer: authorizer,
+	}
+}
+
+// authorize returns the context of the request carrying the authenticated user,
+// if the user is allowed to do the operation with req.
+func (h *Handlers) authorize(c *gin.Context, operation string, req interface{}) (context.Context, error) {
+	if h.authorizer == nil {
+		return c, nil
 	}
+	ctx, err := h.authorizer.Authenticate(c.Request.Context(), c.Request.Header)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errUnauthenticated, err)
+	}
+	if err := h.authorizer.Authorize(ctx, operation, req); err != nil {
+		return nil, fmt.Errorf("%w: %v", errPermissionDenied, err)
+	}
+	return ctx, nil
+}
+
+// bindAndAuthorize binds the body to req and authorizes the operation with it
+func (h *Handlers) bindAndAuthorize(c *gin.Context, operation string, req interface{}) (context.Context, error) {
+	err := shouldBind(c, req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.authorize(c, operation, req)
 }
 
 // RegisterRouters registers routes to given router
@@ -32,7 +69,17 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.POST("/collection/load", wrapHandler(h.handleLoadCollection))
 	router.DELETE("/collection/load", wrapHandler(h.handleReleaseCollection))
 	router.GET("/collection/statistics", wrapHandler(h.handleGetCollectionStatistics))
+	router.GET("/collection/loading-progress", wrapHandler(h.handleGetLoadingProgress))
+	router.GET("/collection/release-jobs", wrapHandler(h.handleGetReleaseJobs))
 	router.GET("/collections", wrapHandler(h.handleShowCollections))
+	router.PATCH("/collection/name", wrapHandler(h.handleRenameCollection))
+	router.POST("/collection/field", wrapHandler(h.handleAddCollectionField))
+	router.PATCH("/collection/properties", wrapHandler(h.handleAlterCollection))
+
+	router.POST("/database", wrapHandler(h.handleCreateDatabase))
+	router.DELETE("/database", wrapHandler(h.handleDropDatabase))
+	router.PATCH("/database", wrapHandler(h.handleAlterDatabase))
+	router.GET("/databases", wrapHandler(h.handleListDatabases))
 
 	router.POST("/partition", wrapHandler(h.handleCreatePartition))
 	router.DELETE("/partition", wrapHandler(h.handleDropPartition))
@@ -51,11 +98,16 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.GET("/index/state", wrapHandler(h.handleGetIndexState))
 	router.GET("/index/progress", wrapHandler(h.handleGetIndexBuildProgress))
 	router.DELETE("/index", wrapHandler(h.handleDropIndex))
+	router.DELETE("/index/build", wrapHandler(h.handleCancelIndexBuild))
 
 	router.POST("/entities", wrapHandler(h.handleInsert))
+	router.PUT("/entities", wrapHandler(h.handleUpsert))
 	router.DELETE("/entities", wrapHandler(h.handleDelete))
 	router.POST("/search", wrapHandler(h.handleSearch))
 	router.POST("/query", wrapHandler(h.handleQuery))
+	router.POST("/explain", wrapHandler(h.handleExplain))
+	router.POST("/template", wrapHandler(h.handleRegisterTemplate))
+	router.DELETE("/template", wrapHandler(h.handleDropTemplate))
 
 	router.POST("/persist", wrapHandler(h.handleFlush))
 	router.GET("/distance", wrapHandler(h.handleCalcDistance))
@@ -65,12 +117,17 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 
 	router.GET("/metrics", wrapHandler(h.handleGetMetrics))
 	router.POST("/load-balance", wrapHandler(h.handleLoadBalance))
+	router.POST("/node/cordon", wrapHandler(h.handleCordonNode))
+	router.DELETE("/node/cordon", wrapHandler(h.handleUncordonNode))
+	router.GET("/node/cordon", wrapHandler(h.handleGetCordonedNodes))
+	router.POST("/balance", wrapHandler(h.handleBalance))
 	router.GET("/compaction/state", wrapHandler(h.handleGetCompactionState))
 	router.GET("/compaction/plans", wrapHandler(h.handleGetCompactionStateWithPlans))
 	router.POST("/compaction", wrapHandler(h.handleManualCompaction))
 
 	router.POST("/import", wrapHandler(h.handleImport))
 	router.GET("/import/state", wrapHandler(h.handleGetImportState))
+	router.POST("/import/cancel", wrapHandler(h.handleCancelImport))
 }
 
 func (h *Handlers) handleGetHealth(c *gin.Context) (interface{}, error) {
@@ -80,360 +137,582 @@ func (h *Handlers) handleGetHealth(c *gin.Context) (interface{}, error) {
 func (h *Handlers) handleDummy(c *gin.Context) (interface{}, error) {
 	req := milvuspb.DummyRequest{}
 	// use ShouldBind to supports binding JSON, XML, YAML, and protobuf.
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "Dummy", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.Dummy(c, &req)
+	return h.proxy.Dummy(ctx, &req)
 }
 
 func (h *Handlers) handleCreateCollection(c *gin.Context) (interface{}, error) {
-	req := milvuspb.CreateCollectionRequest{}
-	err := shouldBind(c, &req)
+	req := createCollectionRequest{}
+	ctx, err := h.bindAndAuthorize(c, "CreateCollection", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.CreateCollection(c, &req)
+	return h.proxy.CreateCollection(ctx, req.toRequest())
 }
 
 func (h *Handlers) handleDropCollection(c *gin.Context) (interface{}, error) {
 	req := milvuspb.DropCollectionRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "DropCollection", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.DropCollection(c, &req)
+	return h.proxy.DropCollection(ctx, &req)
 }
 
 func (h *Handlers) handleHasCollection(c *gin.Context) (interface{}, error) {
 	req := milvuspb.HasCollectionRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "HasCollection", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.HasCollection(c, &req)
+	return h.proxy.HasCollection(ctx, &req)
 }
 
 func (h *Handlers) handleDescribeCollection(c *gin.Context) (interface{}, error) {
 	req := milvuspb.DescribeCollectionRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "DescribeCollection", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.DescribeCollection(c, &req)
+	return h.proxy.DescribeCollection(ctx, &req)
 }
 
 func (h *Handlers) handleLoadCollection(c *gin.Context) (interface{}, error) {
 	req := milvuspb.LoadCollectionRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "LoadCollection", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.LoadCollection(c, &req)
+	return h.proxy.LoadCollection(ctx, &req)
 }
 
 func (h *Handlers) handleReleaseCollection(c *gin.Context) (interface{}, error) {
 	req := milvuspb.ReleaseCollectionRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "ReleaseCollection", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.ReleaseCollection(c, &req)
+	return h.proxy.ReleaseCollection(ctx, &req)
 }
 
 func (h *Handlers) handleGetCollectionStatistics(c *gin.Context) (interface{}, error) {
 	req := milvuspb.GetCollectionStatisticsRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "GetCollectionStatistics", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
+	}
+	return h.proxy.GetCollectionStatistics(ctx, &req)
+}
+
+func (h *Handlers) handleGetLoadingProgress(c *gin.Context) (interface{}, error) {
+	req := milvuspb.GetLoadingProgressRequest{}
+	ctx, err := h.bindAndAuthorize(c, "GetLoadingProgress", &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.GetLoadingProgress(ctx, &req)
+}
+
+func (h *Handlers) handleGetReleaseJobs(c *gin.Context) (interface{}, error) {
+	req := milvuspb.GetReleaseJobsRequest{}
+	ctx, err := h.bindAndAuthorize(c, "GetReleaseJobs", &req)
+	if err != nil {
+		return nil, err
 	}
-	return h.proxy.GetCollectionStatistics(c, &req)
+	return h.proxy.GetReleaseJobs(ctx, &req)
 }
 
 func (h *Handlers) handleShowCollections(c *gin.Context) (interface{}, error) {
 	req := milvuspb.ShowCollectionsRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "ShowCollections", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.ShowCollections(c, &req)
+	return h.proxy.ShowCollections(ctx, &req)
+}
+
+func (h *Handlers) handleRenameCollection(c *gin.Context) (interface{}, error) {
+	req := milvuspb.RenameCollectionRequest{}
+	ctx, err := h.bindAndAuthorize(c, "RenameCollection", &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.RenameCollection(ctx, &req)
+}
+
+func (h *Handlers) handleAddCollectionField(c *gin.Context) (interface{}, error) {
+	req := milvuspb.AddCollectionFieldRequest{}
+	ctx, err := h.bindAndAuthorize(c, "AddCollectionField", &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.AddCollectionField(ctx, &req)
+}
+
+func (h *Handlers) handleAlterCollection(c *gin.Context) (interface{}, error) {
+	req := milvuspb.AlterCollectionRequest{}
+	ctx, err := h.bindAndAuthorize(c, "AlterCollection", &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.AlterCollection(ctx, &req)
+}
+
+func (h *Handlers) handleCreateDatabase(c *gin.Context) (interface{}, error) {
+	req := milvuspb.CreateDatabaseRequest{}
+	ctx, err := h.bindAndAuthorize(c, "CreateDatabase", &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.CreateDatabase(ctx, &req)
+}
+
+func (h *Handlers) handleDropDatabase(c *gin.Context) (interface{}, error) {
+	req := milvuspb.DropDatabaseRequest{}
+	ctx, err := h.bindAndAuthorize(c, "DropDatabase", &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.DropDatabase(ctx, &req)
+}
+
+func (h *Handlers) handleAlterDatabase(c *gin.Context) (interface{}, error) {
+	req := milvuspb.AlterDatabaseRequest{}
+	ctx, err := h.bindAndAuthorize(c, "AlterDatabase", &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.AlterDatabase(ctx, &req)
+}
+
+func (h *Handlers) handleListDatabases(c *gin.Context) (interface{}, error) {
+	req := milvuspb.ListDatabasesRequest{}
+	ctx, err := h.bindAndAuthorize(c, "ListDatabases", &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.ListDatabases(ctx, &req)
 }
 
 func (h *Handlers) handleCreatePartition(c *gin.Context) (interface{}, error) {
 	req := milvuspb.CreatePartitionRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "CreatePartition", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.CreatePartition(c, &req)
+	return h.proxy.CreatePartition(ctx, &req)
 }
 
 func (h *Handlers) handleDropPartition(c *gin.Context) (interface{}, error) {
 	req := milvuspb.DropPartitionRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "DropPartition", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.DropPartition(c, &req)
+	return h.proxy.DropPartition(ctx, &req)
 }
 
 func (h *Handlers) handleHasPartition(c *gin.Context) (interface{}, error) {
 	req := milvuspb.HasPartitionRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "HasPartition", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.HasPartition(c, &req)
+	return h.proxy.HasPartition(ctx, &req)
 }
 
 func (h *Handlers) handleLoadPartitions(c *gin.Context) (interface{}, error) {
 	req := milvuspb.LoadPartitionsRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "LoadPartitions", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.LoadPartitions(c, &req)
+	return h.proxy.LoadPartitions(ctx, &req)
 }
 
 func (h *Handlers) handleReleasePartitions(c *gin.Context) (interface{}, error) {
 	req := milvuspb.ReleasePartitionsRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "ReleasePartitions", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.ReleasePartitions(c, &req)
+	return h.proxy.ReleasePartitions(ctx, &req)
 }
 
 func (h *Handlers) handleGetPartitionStatistics(c *gin.Context) (interface{}, error) {
 	req := milvuspb.GetPartitionStatisticsRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "GetPartitionStatistics", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.GetPartitionStatistics(c, &req)
+	return h.proxy.GetPartitionStatistics(ctx, &req)
 }
 
 func (h *Handlers) handleShowPartitions(c *gin.Context) (interface{}, error) {
 	req := milvuspb.ShowPartitionsRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "ShowPartitions", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.ShowPartitions(c, &req)
+	return h.proxy.ShowPartitions(ctx, &req)
 }
 
 func (h *Handlers) handleCreateAlias(c *gin.Context) (interface{}, error) {
 	req := milvuspb.CreateAliasRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "CreateAlias", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.CreateAlias(c, &req)
+	return h.proxy.CreateAlias(ctx, &req)
 }
 
 func (h *Handlers) handleDropAlias(c *gin.Context) (interface{}, error) {
 	req := milvuspb.DropAliasRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "DropAlias", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.DropAlias(c, &req)
+	return h.proxy.DropAlias(ctx, &req)
 }
 
 func (h *Handlers) handleAlterAlias(c *gin.Context) (interface{}, error) {
 	req := milvuspb.AlterAliasRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "AlterAlias", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.AlterAlias(c, &req)
+	return h.proxy.AlterAlias(ctx, &req)
 }
 
 func (h *Handlers) handleCreateIndex(c *gin.Context) (interface{}, error) {
 	req := milvuspb.CreateIndexRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "CreateIndex", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.CreateIndex(c, &req)
+	return h.proxy.CreateIndex(ctx, &req)
 }
 
 func (h *Handlers) handleDescribeIndex(c *gin.Context) (interface{}, error) {
 	req := milvuspb.DescribeIndexRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "DescribeIndex", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.DescribeIndex(c, &req)
+	return h.proxy.DescribeIndex(ctx, &req)
 }
 
 func (h *Handlers) handleGetIndexState(c *gin.Context) (interface{}, error) {
 	req := milvuspb.GetIndexStateRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "GetIndexState", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.GetIndexState(c, &req)
+	return h.proxy.GetIndexState(ctx, &req)
 }
 
 func (h *Handlers) handleGetIndexBuildProgress(c *gin.Context) (interface{}, error) {
 	req := milvuspb.GetIndexBuildProgressRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "GetIndexBuildProgress", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.GetIndexBuildProgress(c, &req)
+	return h.proxy.GetIndexBuildProgress(ctx, &req)
 }
 
 func (h *Handlers) handleDropIndex(c *gin.Context) (interface{}, error) {
 	req := milvuspb.DropIndexRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "DropIndex", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
+	}
+	return h.proxy.DropIndex(ctx, &req)
+}
+
+func (h *Handlers) handleCancelIndexBuild(c *gin.Context) (interface{}, error) {
+	req := milvuspb.CancelIndexBuildRequest{}
+	ctx, err := h.bindAndAuthorize(c, "CancelIndexBuild", &req)
+	if err != nil {
+		return nil, err
 	}
-	return h.proxy.DropIndex(c, &req)
+	return h.proxy.CancelIndexBuild(ctx, &req)
 }
 
 func (h *Handlers) handleInsert(c *gin.Context) (interface{}, error) {
-	req := milvuspb.InsertRequest{}
-	err := shouldBind(c, &req)
+	req := insertRequest{}
+	ctx, err := h.bindAndAuthorize(c, "Insert", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
+	}
+	status, err := h.fillFieldsData(ctx, &req)
+	if err != nil {
+		return nil, err
 	}
-	return h.proxy.Insert(c, &req)
+	if status != nil {
+		return &milvuspb.MutationResult{Status: status}, nil
+	}
+	return h.proxy.Insert(ctx, &req.InsertRequest)
+}
+
+func (h *Handlers) handleUpsert(c *gin.Context) (interface{}, error) {
+	req := insertRequest{}
+	ctx, err := h.bindAndAuthorize(c, "Upsert", &req)
+	if err != nil {
+		return nil, err
+	}
+	status, err := h.fillFieldsData(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	if status != nil {
+		return &milvuspb.MutationResult{Status: status}, nil
+	}
+	return h.proxy.Upsert(ctx, &milvuspb.UpsertRequest{
+		Base:           req.Base,
+		DbName:         req.DbName,
+		CollectionName: req.CollectionName,
+		PartitionName:  req.PartitionName,
+		FieldsData:     req.FieldsData,
+		HashKeys:       req.HashKeys,
+		NumRows:        req.NumRows,
+	})
+}
+
+// fillFieldsData converts the rows of req into the columns by the schema of the collection,
+// the status is returned if failed to describe the collection.
+func (h *Handlers) fillFieldsData(ctx context.Context, req *insertRequest) (*commonpb.Status, error) {
+	if len(req.Rows) == 0 {
+		return nil, nil
+	}
+	resp, err := h.proxy.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return resp.GetStatus(), nil
+	}
+	fieldsData, err := rowsToFieldsData(resp.GetSchema(), req.Rows)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errBadRequest, err)
+	}
+	req.FieldsData = fieldsData
+	req.NumRows = uint32(len(req.Rows))
+	return nil, nil
 }
 
 func (h *Handlers) handleDelete(c *gin.Context) (interface{}, error) {
 	req := milvuspb.DeleteRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "Delete", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.Delete(c, &req)
+	return h.proxy.Delete(ctx, &req)
 }
 
 func (h *Handlers) handleSearch(c *gin.Context) (interface{}, error) {
-	req := milvuspb.SearchRequest{}
-	err := shouldBind(c, &req)
+	req := searchRequest{}
+	ctx, err := h.bindAndAuthorize(c, "Search", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
+	}
+	searchReq, err := req.toRequest()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errBadRequest, err)
 	}
-	return h.proxy.Search(c, &req)
+	return h.proxy.Search(ctx, searchReq)
 }
 
 func (h *Handlers) handleQuery(c *gin.Context) (interface{}, error) {
 	req := milvuspb.QueryRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "Query", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
+	}
+	return h.proxy.Query(ctx, &req)
+}
+
+func (h *Handlers) handleRegisterTemplate(c *gin.Context) (interface{}, error) {
+	req := milvuspb.RegisterTemplateRequest{}
+	ctx, err := h.bindAndAuthorize(c, "RegisterTemplate", &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.RegisterTemplate(ctx, &req)
+}
+
+func (h *Handlers) handleDropTemplate(c *gin.Context) (interface{}, error) {
+	req := milvuspb.DropTemplateRequest{}
+	ctx, err := h.bindAndAuthorize(c, "DropTemplate", &req)
+	if err != nil {
+		return nil, err
 	}
-	return h.proxy.Query(c, &req)
+	return h.proxy.DropTemplate(ctx, &req)
+}
+
+func (h *Handlers) handleExplain(c *gin.Context) (interface{}, error) {
+	req := milvuspb.ExplainRequest{}
+	ctx, err := h.bindAndAuthorize(c, "Explain", &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.Explain(ctx, &req)
 }
 
 func (h *Handlers) handleFlush(c *gin.Context) (interface{}, error) {
 	req := milvuspb.FlushRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "Flush", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.Flush(c, &req)
+	return h.proxy.Flush(ctx, &req)
 }
 
 func (h *Handlers) handleCalcDistance(c *gin.Context) (interface{}, error) {
 	req := milvuspb.CalcDistanceRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "CalcDistance", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.CalcDistance(c, &req)
+	return h.proxy.CalcDistance(ctx, &req)
 }
 
 func (h *Handlers) handleGetFlushState(c *gin.Context) (interface{}, error) {
 	req := milvuspb.GetFlushStateRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "GetFlushState", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.GetFlushState(c, &req)
+	return h.proxy.GetFlushState(ctx, &req)
 }
 
 func (h *Handlers) handleGetPersistentSegmentInfo(c *gin.Context) (interface{}, error) {
 	req := milvuspb.GetPersistentSegmentInfoRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "GetPersistentSegmentInfo", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.GetPersistentSegmentInfo(c, &req)
+	return h.proxy.GetPersistentSegmentInfo(ctx, &req)
 }
 
 func (h *Handlers) handleGetQuerySegmentInfo(c *gin.Context) (interface{}, error) {
 	req := milvuspb.GetQuerySegmentInfoRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "GetQuerySegmentInfo", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.GetQuerySegmentInfo(c, &req)
+	return h.proxy.GetQuerySegmentInfo(ctx, &req)
 }
 
 func (h *Handlers) handleGetMetrics(c *gin.Context) (interface{}, error) {
 	req := milvuspb.GetMetricsRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "GetMetrics", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.GetMetrics(c, &req)
+	return h.proxy.GetMetrics(ctx, &req)
 }
 
 func (h *Handlers) handleLoadBalance(c *gin.Context) (interface{}, error) {
 	req := milvuspb.LoadBalanceRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "LoadBalance", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
+	}
+	return h.proxy.LoadBalance(ctx, &req)
+}
+
+func (h *Handlers) handleCordonNode(c *gin.Context) (interface{}, error) {
+	req := milvuspb.CordonNodeRequest{}
+	ctx, err := h.bindAndAuthorize(c, "CordonNode", &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.CordonNode(ctx, &req)
+}
+
+func (h *Handlers) handleUncordonNode(c *gin.Context) (interface{}, error) {
+	req := milvuspb.UncordonNodeRequest{}
+	ctx, err := h.bindAndAuthorize(c, "UncordonNode", &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.UncordonNode(ctx, &req)
+}
+
+func (h *Handlers) handleGetCordonedNodes(c *gin.Context) (interface{}, error) {
+	req := milvuspb.GetCordonedNodesRequest{}
+	ctx, err := h.bindAndAuthorize(c, "GetCordonedNodes", &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.GetCordonedNodes(ctx, &req)
+}
+
+func (h *Handlers) handleBalance(c *gin.Context) (interface{}, error) {
+	req := milvuspb.BalanceRequest{}
+	ctx, err := h.bindAndAuthorize(c, "Balance", &req)
+	if err != nil {
+		return nil, err
 	}
-	return h.proxy.LoadBalance(c, &req)
+	return h.proxy.Balance(ctx, &req)
 }
 
 func (h *Handlers) handleGetCompactionState(c *gin.Context) (interface{}, error) {
 	req := milvuspb.GetCompactionStateRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "GetCompactionState", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.GetCompactionState(c, &req)
+	return h.proxy.GetCompactionState(ctx, &req)
 }
 
 func (h *Handlers) handleGetCompactionStateWithPlans(c *gin.Context) (interface{}, error) {
 	req := milvuspb.GetCompactionPlansRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "GetCompactionStateWithPlans", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.GetCompactionStateWithPlans(c, &req)
+	return h.proxy.GetCompactionStateWithPlans(ctx, &req)
 }
 
 func (h *Handlers) handleManualCompaction(c *gin.Context) (interface{}, error) {
 	req := milvuspb.ManualCompactionRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "ManualCompaction", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.ManualCompaction(c, &req)
+	return h.proxy.ManualCompaction(ctx, &req)
 }
 
 func (h *Handlers) handleImport(c *gin.Context) (interface{}, error) {
 	req := milvuspb.ImportRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "Import", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
 	}
-	return h.proxy.Import(c, &req)
+	return h.proxy.Import(ctx, &req)
 }
 
 func (h *Handlers) handleGetImportState(c *gin.Context) (interface{}, error) {
 	req := milvuspb.GetImportStateRequest{}
-	err := shouldBind(c, &req)
+	ctx, err := h.bindAndAuthorize(c, "GetImportState", &req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+		return nil, err
+	}
+	return h.proxy.GetImportState(ctx, &req)
+}
+
+func (h *Handlers) handleCancelImport(c *gin.Context) (interface{}, error) {
+	req := milvuspb.CancelImportRequest{}
+	ctx, err := h.bindAndAuthorize(c, "CancelImport", &req)
+	if err != nil {
+		return nil, err
 	}
-	return h.proxy.GetImportState(c, &req)
+	return h.proxy.CancelImport(ctx, &req)
 }
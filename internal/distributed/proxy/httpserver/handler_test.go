@@ -12,6 +12,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
+	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
@@ -61,6 +62,14 @@ func (mockProxyComponent) GetCollectionStatistics(ctx context.Context, request *
 	return &milvuspb.GetCollectionStatisticsResponse{Status: testStatus}, nil
 }
 
+func (mockProxyComponent) GetLoadingProgress(ctx context.Context, request *milvuspb.GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error) {
+	return &milvuspb.GetLoadingProgressResponse{Status: testStatus}, nil
+}
+
+func (mockProxyComponent) GetReleaseJobs(ctx context.Context, request *milvuspb.GetReleaseJobsRequest) (*milvuspb.GetReleaseJobsResponse, error) {
+	return &milvuspb.GetReleaseJobsResponse{Status: testStatus}, nil
+}
+
 func (mockProxyComponent) ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
 	return &milvuspb.ShowCollectionsResponse{Status: testStatus}, nil
 }
@@ -103,6 +112,34 @@ func (mockProxyComponent) AlterAlias(ctx context.Context, request *milvuspb.Alte
 	return testStatus, nil
 }
 
+func (mockProxyComponent) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (mockProxyComponent) AddCollectionField(ctx context.Context, request *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (mockProxyComponent) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (mockProxyComponent) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (mockProxyComponent) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (mockProxyComponent) AlterDatabase(ctx context.Context, request *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (mockProxyComponent) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return &milvuspb.ListDatabasesResponse{Status: testStatus}, nil
+}
+
 func (mockProxyComponent) CreateIndex(ctx context.Context, request *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
 	return testStatus, nil
 }
@@ -123,6 +160,10 @@ func (mockProxyComponent) DropIndex(ctx context.Context, request *milvuspb.DropI
 	return testStatus, nil
 }
 
+func (mockProxyComponent) CancelIndexBuild(ctx context.Context, request *milvuspb.CancelIndexBuildRequest) (*milvuspb.CancelIndexBuildResponse, error) {
+	return &milvuspb.CancelIndexBuildResponse{Status: testStatus}, nil
+}
+
 func (mockProxyComponent) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
 	if request.CollectionName == "" {
 		return nil, errors.New("body parse err")
@@ -130,6 +171,13 @@ func (mockProxyComponent) Insert(ctx context.Context, request *milvuspb.InsertRe
 	return &milvuspb.MutationResult{Acknowledged: true}, nil
 }
 
+func (mockProxyComponent) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	if request.CollectionName == "" {
+		return nil, errors.New("body parse err")
+	}
+	return &milvuspb.MutationResult{Acknowledged: true}, nil
+}
+
 func (mockProxyComponent) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
 	if request.Expr == "" {
 		return nil, errors.New("body parse err")
@@ -161,6 +209,18 @@ func (mockProxyComponent) Query(ctx context.Context, request *milvuspb.QueryRequ
 	return &queryResult, nil
 }
 
+func (mockProxyComponent) RegisterTemplate(ctx context.Context, request *milvuspb.RegisterTemplateRequest) (*milvuspb.RegisterTemplateResponse, error) {
+	return &milvuspb.RegisterTemplateResponse{Status: testStatus}, nil
+}
+
+func (mockProxyComponent) DropTemplate(ctx context.Context, request *milvuspb.DropTemplateRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (mockProxyComponent) Explain(ctx context.Context, request *milvuspb.ExplainRequest) (*milvuspb.ExplainResponse, error) {
+	return &milvuspb.ExplainResponse{Status: testStatus}, nil
+}
+
 var flushResult = milvuspb.FlushResponse{
 	DbName: "default",
 }
@@ -207,6 +267,22 @@ func (mockProxyComponent) LoadBalance(ctx context.Context, request *milvuspb.Loa
 	return testStatus, nil
 }
 
+func (mockProxyComponent) CordonNode(ctx context.Context, request *milvuspb.CordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return &milvuspb.CordonedNodesResponse{Status: testStatus}, nil
+}
+
+func (mockProxyComponent) UncordonNode(ctx context.Context, request *milvuspb.UncordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return &milvuspb.CordonedNodesResponse{Status: testStatus}, nil
+}
+
+func (mockProxyComponent) GetCordonedNodes(ctx context.Context, request *milvuspb.GetCordonedNodesRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return &milvuspb.CordonedNodesResponse{Status: testStatus}, nil
+}
+
+func (mockProxyComponent) Balance(ctx context.Context, request *milvuspb.BalanceRequest) (*milvuspb.BalanceResponse, error) {
+	return &milvuspb.BalanceResponse{Status: testStatus}, nil
+}
+
 func (mockProxyComponent) GetCompactionState(ctx context.Context, request *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	return &milvuspb.GetCompactionStateResponse{Status: testStatus}, nil
 }
@@ -227,9 +303,13 @@ func (mockProxyComponent) GetImportState(ctx context.Context, request *milvuspb.
 	return &milvuspb.GetImportStateResponse{Status: testStatus}, nil
 }
 
+func (mockProxyComponent) CancelImport(ctx context.Context, request *milvuspb.CancelImportRequest) (*milvuspb.GetImportStateResponse, error) {
+	return &milvuspb.GetImportStateResponse{Status: testStatus}, nil
+}
+
 func TestHandlers(t *testing.T) {
 	mockProxy := &mockProxyComponent{}
-	h := NewHandlers(mockProxy)
+	h := NewHandlers(mockProxy, nil)
 	testEngine := gin.New()
 	h.RegisterRoutesTo(testEngine)
 
@@ -325,10 +405,46 @@ func TestHandlers(t *testing.T) {
 			http.MethodGet, "/collection/statistics", emptyBody,
 			http.StatusOK, &milvuspb.GetCollectionStatisticsResponse{Status: testStatus},
 		},
+		{
+			http.MethodGet, "/collection/loading-progress", emptyBody,
+			http.StatusOK, &milvuspb.GetLoadingProgressResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/collection/release-jobs", emptyBody,
+			http.StatusOK, &milvuspb.GetReleaseJobsResponse{Status: testStatus},
+		},
 		{
 			http.MethodGet, "/collections", emptyBody,
 			http.StatusOK, &milvuspb.ShowCollectionsResponse{Status: testStatus},
 		},
+		{
+			http.MethodPatch, "/collection/name", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodPost, "/collection/field", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodPatch, "/collection/properties", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodPost, "/database", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodDelete, "/database", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodPatch, "/database", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodGet, "/databases", emptyBody,
+			http.StatusOK, &milvuspb.ListDatabasesResponse{Status: testStatus},
+		},
 		{
 			http.MethodPost, "/partition", emptyBody,
 			http.StatusOK, testStatus,
@@ -389,10 +505,18 @@ func TestHandlers(t *testing.T) {
 			http.MethodDelete, "/index", emptyBody,
 			http.StatusOK, testStatus,
 		},
+		{
+			http.MethodDelete, "/index/build", emptyBody,
+			http.StatusOK, &milvuspb.CancelIndexBuildResponse{Status: testStatus},
+		},
 		{
 			http.MethodPost, "/entities", &milvuspb.InsertRequest{CollectionName: "c1"},
 			http.StatusOK, &milvuspb.MutationResult{Acknowledged: true},
 		},
+		{
+			http.MethodPut, "/entities", &milvuspb.InsertRequest{CollectionName: "c1"},
+			http.StatusOK, &milvuspb.MutationResult{Acknowledged: true},
+		},
 		{
 			http.MethodDelete, "/entities", milvuspb.DeleteRequest{Expr: "some expr"},
 			http.StatusOK, &milvuspb.MutationResult{Acknowledged: true},
@@ -405,6 +529,18 @@ func TestHandlers(t *testing.T) {
 			http.MethodPost, "/query", milvuspb.QueryRequest{Expr: "some expr"},
 			http.StatusOK, &queryResult,
 		},
+		{
+			http.MethodPost, "/explain", milvuspb.ExplainRequest{Expr: "some expr"},
+			http.StatusOK, &milvuspb.ExplainResponse{Status: testStatus},
+		},
+		{
+			http.MethodPost, "/template", milvuspb.RegisterTemplateRequest{Expr: "some expr"},
+			http.StatusOK, &milvuspb.RegisterTemplateResponse{Status: testStatus},
+		},
+		{
+			http.MethodDelete, "/template", milvuspb.DropTemplateRequest{TemplateID: 1},
+			http.StatusOK, testStatus,
+		},
 		{
 			http.MethodPost, "/persist", milvuspb.FlushRequest{CollectionNames: []string{"c1"}},
 			http.StatusOK, flushResult,
@@ -436,6 +572,22 @@ func TestHandlers(t *testing.T) {
 			http.MethodPost, "/load-balance", emptyBody,
 			http.StatusOK, testStatus,
 		},
+		{
+			http.MethodPost, "/node/cordon", emptyBody,
+			http.StatusOK, &milvuspb.CordonedNodesResponse{Status: testStatus},
+		},
+		{
+			http.MethodDelete, "/node/cordon", emptyBody,
+			http.StatusOK, &milvuspb.CordonedNodesResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/node/cordon", emptyBody,
+			http.StatusOK, &milvuspb.CordonedNodesResponse{Status: testStatus},
+		},
+		{
+			http.MethodPost, "/balance", emptyBody,
+			http.StatusOK, &milvuspb.BalanceResponse{Status: testStatus},
+		},
 		{
 			http.MethodGet, "/compaction/state", emptyBody,
 			http.StatusOK, &milvuspb.GetCompactionStateResponse{Status: testStatus},
@@ -456,6 +608,10 @@ func TestHandlers(t *testing.T) {
 			http.MethodGet, "/import/state", emptyBody,
 			http.StatusOK, &milvuspb.GetImportStateResponse{Status: testStatus},
 		},
+		{
+			http.MethodPost, "/import/cancel", emptyBody,
+			http.StatusOK, &milvuspb.GetImportStateResponse{Status: testStatus},
+		},
 	}
 	for _, tt := range testCases {
 		t.Run(fmt.Sprintf("%s %s %d", tt.httpMethod, tt.path, tt.expectedStatus), func(t *testing.T) {
@@ -481,3 +637,115 @@ func TestHandlers(t *testing.T) {
 		})
 	}
 }
+
+type mockAuthorizer struct {
+	authenticateErr error
+	authorizeErr    error
+}
+
+func (m mockAuthorizer) Authenticate(ctx context.Context, header http.Header) (context.Context, error) {
+	return ctx, m.authenticateErr
+}
+
+func (m mockAuthorizer) Authorize(ctx context.Context, operation string, req interface{}) error {
+	return m.authorizeErr
+}
+
+func TestHandlers_Authorize(t *testing.T) {
+	serve := func(authorizer Authorizer) int {
+		testEngine := gin.New()
+		NewHandlers(&mockProxyComponent{}, authorizer).RegisterRoutesTo(testEngine)
+		req := httptest.NewRequest(http.MethodPost, "/collection", bytes.NewReader([]byte("{}")))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		return w.Code
+	}
+	assert.Equal(t, http.StatusOK, serve(mockAuthorizer{}))
+	assert.Equal(t, http.StatusUnauthorized, serve(mockAuthorizer{authenticateErr: errors.New("mock")}))
+	assert.Equal(t, http.StatusForbidden, serve(mockAuthorizer{authorizeErr: errors.New("mock")}))
+}
+
+type mockRowsProxyComponent struct {
+	mockProxyComponent
+	describeStatus *commonpb.Status
+	insertRequest  *milvuspb.InsertRequest
+	searchRequest  *milvuspb.SearchRequest
+	createRequest  *milvuspb.CreateCollectionRequest
+}
+
+func (m *mockRowsProxyComponent) DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	return &milvuspb.DescribeCollectionResponse{Status: m.describeStatus, Schema: testRowsSchema()}, nil
+}
+
+func (m *mockRowsProxyComponent) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	m.insertRequest = request
+	return &milvuspb.MutationResult{Acknowledged: true}, nil
+}
+
+func (m *mockRowsProxyComponent) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	m.searchRequest = request
+	return &searchResult, nil
+}
+
+func (m *mockRowsProxyComponent) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
+	m.createRequest = request
+	return testStatus, nil
+}
+
+func TestHandlers_JSONFriendly(t *testing.T) {
+	mockProxy := &mockRowsProxyComponent{describeStatus: &commonpb.Status{}}
+	testEngine := gin.New()
+	NewHandlers(mockProxy, nil).RegisterRoutesTo(testEngine)
+	serve := func(method, path, body string) int {
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	t.Run("insert rows", func(t *testing.T) {
+		code := serve(http.MethodPost, "/entities", `{"collection_name": "c1",
+			"rows": [{"pk": 9007199254740993, "vec": [1, 2]}, {"pk": 2, "vec": [3.5, 4]}]}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, uint32(2), mockProxy.insertRequest.NumRows)
+		assert.Equal(t, []int64{9007199254740993, 2}, mockProxy.insertRequest.FieldsData[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []float32{1, 2, 3.5, 4}, mockProxy.insertRequest.FieldsData[1].GetVectors().GetFloatVector().GetData())
+
+		code = serve(http.MethodPost, "/entities", `{"collection_name": "c1", "rows": [{"pk": 1}]}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		mockProxy.describeStatus = &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}
+		defer func() { mockProxy.describeStatus = &commonpb.Status{} }()
+		mockProxy.insertRequest = nil
+		code = serve(http.MethodPost, "/entities", `{"collection_name": "c1", "rows": [{"pk": 1, "vec": [1, 2]}]}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Nil(t, mockProxy.insertRequest)
+	})
+
+	t.Run("search vectors", func(t *testing.T) {
+		code := serve(http.MethodPost, "/search", `{"collection_name": "c1", "vectors": [[1, 2]]}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, commonpb.DslType_BoolExprV1, mockProxy.searchRequest.DslType)
+		placeholderGroup := &milvuspb.PlaceholderGroup{}
+		assert.NoError(t, proto.Unmarshal(mockProxy.searchRequest.PlaceholderGroup, placeholderGroup))
+		assert.Equal(t, [][]byte{{0, 0, 0x80, 0x3f, 0, 0, 0, 0x40}}, placeholderGroup.Placeholders[0].Values)
+	})
+
+	t.Run("create collection with schema object", func(t *testing.T) {
+		code := serve(http.MethodPost, "/collection", `{"collection_name": "c1",
+			"schema": {"name": "c1", "fields": [{"name": "pk", "is_primary_key": true, "data_type": "Int64"}]}}`)
+		assert.Equal(t, http.StatusOK, code)
+		schema := &schemapb.CollectionSchema{}
+		assert.NoError(t, proto.Unmarshal(mockProxy.createRequest.Schema, schema))
+		assert.Equal(t, schemapb.DataType_Int64, schema.Fields[0].DataType)
+		assert.True(t, schema.Fields[0].IsPrimaryKey)
+
+		schemaBytes, _ := json.Marshal([]byte("dummy"))
+		code = serve(http.MethodPost, "/collection", `{"collection_name": "c1", "schema": `+string(schemaBytes)+`}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []byte("dummy"), mockProxy.createRequest.Schema)
+
+		code = serve(http.MethodPost, "/collection", `{"collection_name": "c1", "schema": {"fields": 1}}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
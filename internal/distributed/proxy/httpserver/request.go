@@ -0,0 +1,398 @@
+package httpserver
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// collectionSchema is the marshaled schema, which is either the base64 encoded bytes or the schema object in json,
+// e.g. {"name": "book", "fields": [{"name": "book_id", "is_primary_key": true, "data_type": "Int64"}]}
+type collectionSchema []byte
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *collectionSchema) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '{' {
+		var b []byte
+		if err := json.Unmarshal(data, &b); err != nil {
+			return err
+		}
+		*s = b
+		return nil
+	}
+	schema := &schemapb.CollectionSchema{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(data), schema); err != nil {
+		return err
+	}
+	b, err := proto.Marshal(schema)
+	if err != nil {
+		return err
+	}
+	*s = b
+	return nil
+}
+
+// createCollectionRequest is the CreateCollectionRequest accepting the schema object in json
+type createCollectionRequest struct {
+	milvuspb.CreateCollectionRequest `yaml:",inline"`
+	Schema                           collectionSchema `json:"schema,omitempty" yaml:"-"`
+}
+
+// toRequest returns the request of proxy
+func (r *createCollectionRequest) toRequest() *milvuspb.CreateCollectionRequest {
+	if len(r.Schema) > 0 {
+		r.CreateCollectionRequest.Schema = r.Schema
+	}
+	return &r.CreateCollectionRequest
+}
+
+// entityRows are the entities keyed by the field names, the numbers are decoded as json.Number to keep the precision
+// of int64
+type entityRows []map[string]interface{}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (rows *entityRows) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var r []map[string]interface{}
+	if err := decoder.Decode(&r); err != nil {
+		return err
+	}
+	*rows = r
+	return nil
+}
+
+// insertRequest is the InsertRequest accepting the entities in rows besides the columns in fields_data,
+// e.g. {"collection_name": "book", "rows": [{"book_id": 1, "book_intro": [0.1, 0.2]}]}
+type insertRequest struct {
+	milvuspb.InsertRequest `yaml:",inline"`
+	Rows                   entityRows `json:"rows,omitempty" yaml:"rows,omitempty"`
+}
+
+// searchRequest is the SearchRequest accepting the float vectors to search with besides the placeholder_group,
+// e.g. {"collection_name": "book", "vectors": [[0.1, 0.2]], "dsl": "book_id > 0", "search_params": [...]}
+type searchRequest struct {
+	milvuspb.SearchRequest `yaml:",inline"`
+	Vectors                [][]float32 `json:"vectors,omitempty" yaml:"vectors,omitempty"`
+}
+
+// toRequest returns the request of proxy, the vectors are converted into the placeholder group of the boolean
+// expression search
+func (r *searchRequest) toRequest() (*milvuspb.SearchRequest, error) {
+	if len(r.Vectors) == 0 {
+		return &r.SearchRequest, nil
+	}
+	values := make([][]byte, 0, len(r.Vectors))
+	for _, vector := range r.Vectors {
+		value := make([]byte, 4*len(vector))
+		for i, f := range vector {
+			binary.LittleEndian.PutUint32(value[4*i:], math.Float32bits(f))
+		}
+		values = append(values, value)
+	}
+	placeholderGroup, err := proto.Marshal(&milvuspb.PlaceholderGroup{
+		Placeholders: []*milvuspb.PlaceholderValue{{
+			Tag:    "$0",
+			Type:   milvuspb.PlaceholderType_FloatVector,
+			Values: values,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.PlaceholderGroup = placeholderGroup
+	r.DslType = commonpb.DslType_BoolExprV1
+	return &r.SearchRequest, nil
+}
+
+func toInt64(v interface{}) (int64, error) {
+	switch n := v.(type) {
+	case json.Number:
+		return n.Int64()
+	case int:
+		return int64(n), nil
+	case int64:
+		return n, nil
+	case uint64:
+		if n > math.MaxInt64 {
+			return 0, fmt.Errorf("%d overflows int64", n)
+		}
+		return int64(n), nil
+	default:
+		return 0, fmt.Errorf("%v is not an integer", v)
+	}
+}
+
+func toFloat64(v interface{}) (float64, error) {
+	switch n := v.(type) {
+	case json.Number:
+		return n.Float64()
+	case float64:
+		return n, nil
+	case int, int64, uint64:
+		i, err := toInt64(n)
+		return float64(i), err
+	default:
+		return 0, fmt.Errorf("%v is not a number", v)
+	}
+}
+
+func toVector(v interface{}) ([]interface{}, error) {
+	vector, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%v is not an array", v)
+	}
+	return vector, nil
+}
+
+func fieldDim(field *schemapb.FieldSchema) (int64, error) {
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == "dim" {
+			return strconv.ParseInt(kv.GetValue(), 10, 64)
+		}
+	}
+	return 0, fmt.Errorf("dimension of field %s not found", field.GetName())
+}
+
+func isDynamicField(field *schemapb.FieldSchema) bool {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(common.DynamicFieldParam, field.GetTypeParams())
+	if err != nil {
+		return false
+	}
+	isDynamic, err := strconv.ParseBool(value)
+	return err == nil && isDynamic
+}
+
+// dynamicValues returns the JSON objects of the keys undeclared in the schema of the rows
+func dynamicValues(schema *schemapb.CollectionSchema, rows entityRows) ([]interface{}, error) {
+	declared := make(map[string]struct{})
+	for _, field := range schema.GetFields() {
+		declared[field.GetName()] = struct{}{}
+	}
+	values := make([]interface{}, 0, len(rows))
+	for _, row := range rows {
+		obj := make(map[string]interface{})
+		for key, v := range row {
+			if _, ok := declared[key]; !ok {
+				obj[key] = v
+			}
+		}
+		b, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, string(b))
+	}
+	return values, nil
+}
+
+// rowsToFieldsData converts the entities into the columns by the schema, the auto id primary key is skipped.
+// The keys undeclared in the schema are stored in the dynamic field if any.
+func rowsToFieldsData(schema *schemapb.CollectionSchema, rows entityRows) ([]*schemapb.FieldData, error) {
+	fields := schema.GetFields()
+	// the hidden dynamic field isn't described, the keys undeclared are sent to it by its name
+	if enabled, _ := typeutil.IsDynamicFieldEnabled(schema); enabled {
+		hasDynamicField := false
+		for _, field := range fields {
+			hasDynamicField = hasDynamicField || isDynamicField(field)
+		}
+		if !hasDynamicField {
+			fields = append(fields[:len(fields):len(fields)], &schemapb.FieldSchema{
+				Name:       common.MetaFieldName,
+				DataType:   schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DynamicFieldParam, Value: "true"}},
+			})
+		}
+	}
+
+	fieldsData := make([]*schemapb.FieldData, 0, len(fields))
+	for _, field := range fields {
+		if field.GetIsPrimaryKey() && field.GetAutoID() {
+			continue
+		}
+		if isDynamicField(field) {
+			values, err := dynamicValues(schema, rows)
+			if err != nil {
+				return nil, fmt.Errorf("illegal value of field %s: %v", field.GetName(), err)
+			}
+			fieldData, err := valuesToFieldData(field, values)
+			if err != nil {
+				return nil, fmt.Errorf("illegal value of field %s: %v", field.GetName(), err)
+			}
+			fieldsData = append(fieldsData, fieldData)
+			continue
+		}
+		values := make([]interface{}, 0, len(rows))
+		for i, row := range rows {
+			v, ok := row[field.GetName()]
+			if !ok {
+				return nil, fmt.Errorf("field %s missing in row %d", field.GetName(), i)
+			}
+			// the values of JSON and array fields are accepted as objects and arrays as well as their JSON strings
+			_, isObj := v.(map[string]interface{})
+			_, isArray := v.([]interface{})
+			if (isObj && typeutil.IsJSONField(field)) || (isArray && typeutil.IsArrayField(field)) {
+				b, err := json.Marshal(v)
+				if err != nil {
+					return nil, fmt.Errorf("illegal value of field %s: %v", field.GetName(), err)
+				}
+				v = string(b)
+			}
+			values = append(values, v)
+		}
+		fieldData, err := valuesToFieldData(field, values)
+		if err != nil {
+			return nil, fmt.Errorf("illegal value of field %s: %v", field.GetName(), err)
+		}
+		fieldsData = append(fieldsData, fieldData)
+	}
+	return fieldsData, nil
+}
+
+func valuesToFieldData(field *schemapb.FieldSchema, values []interface{}) (*schemapb.FieldData, error) {
+	fieldData := &schemapb.FieldData{
+		Type:      field.GetDataType(),
+		FieldName: field.GetName(),
+		FieldId:   field.GetFieldID(),
+	}
+	scalars := &schemapb.ScalarField{}
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		data := make([]bool, 0, len(values))
+		for _, v := range values {
+			b, ok := v.(bool)
+			if !ok {
+				return nil, fmt.Errorf("%v is not a bool", v)
+			}
+			data = append(data, b)
+		}
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		data := make([]int32, 0, len(values))
+		for _, v := range values {
+			i, err := toInt64(v)
+			if err != nil {
+				return nil, err
+			}
+			if i < math.MinInt32 || i > math.MaxInt32 {
+				return nil, fmt.Errorf("%d overflows %s", i, field.GetDataType().String())
+			}
+			data = append(data, int32(i))
+		}
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+	case schemapb.DataType_Int64:
+		data := make([]int64, 0, len(values))
+		for _, v := range values {
+			i, err := toInt64(v)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, i)
+		}
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+	case schemapb.DataType_Float:
+		data := make([]float32, 0, len(values))
+		for _, v := range values {
+			f, err := toFloat64(v)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, float32(f))
+		}
+		scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}
+	case schemapb.DataType_Double:
+		data := make([]float64, 0, len(values))
+		for _, v := range values {
+			f, err := toFloat64(v)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, f)
+		}
+		scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		data := make([]string, 0, len(values))
+		for _, v := range values {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("%v is not a string", v)
+			}
+			data = append(data, s)
+		}
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}
+	case schemapb.DataType_FloatVector:
+		dim, err := fieldDim(field)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]float32, 0, int64(len(values))*dim)
+		for _, v := range values {
+			vector, err := toVector(v)
+			if err != nil {
+				return nil, err
+			}
+			if int64(len(vector)) != dim {
+				return nil, fmt.Errorf("the length %d of the vector doesn't match dimension %d", len(vector), dim)
+			}
+			for _, e := range vector {
+				f, err := toFloat64(e)
+				if err != nil {
+					return nil, err
+				}
+				data = append(data, float32(f))
+			}
+		}
+		fieldData.Field = &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+			Dim:  dim,
+			Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: data}},
+		}}
+		return fieldData, nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := fieldDim(field)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, 0, int64(len(values))*dim/8)
+		for _, v := range values {
+			vector, err := toVector(v)
+			if err != nil {
+				return nil, err
+			}
+			if int64(len(vector)) != dim/8 {
+				return nil, fmt.Errorf("the length %d of the vector doesn't match dimension %d", len(vector), dim)
+			}
+			for _, e := range vector {
+				i, err := toInt64(e)
+				if err != nil {
+					return nil, err
+				}
+				if i < 0 || i > math.MaxUint8 {
+					return nil, fmt.Errorf("%d is not a byte", i)
+				}
+				data = append(data, byte(i))
+			}
+		}
+		fieldData.Field = &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+			Dim:  dim,
+			Data: &schemapb.VectorField_BinaryVector{BinaryVector: data},
+		}}
+		return fieldData, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %s", field.GetDataType().String())
+	}
+	fieldData.Field = &schemapb.FieldData_Scalars{Scalars: scalars}
+	return fieldData, nil
+}
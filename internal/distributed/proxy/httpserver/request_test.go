@@ -0,0 +1,147 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func testRowsSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "c1",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+}
+
+func TestRowsToFieldsData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", IsPrimaryKey: true, AutoID: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "bool", DataType: schemapb.DataType_Bool},
+			{FieldID: 102, Name: "int8", DataType: schemapb.DataType_Int8},
+			{FieldID: 103, Name: "int64", DataType: schemapb.DataType_Int64},
+			{FieldID: 104, Name: "float", DataType: schemapb.DataType_Float},
+			{FieldID: 105, Name: "double", DataType: schemapb.DataType_Double},
+			{FieldID: 106, Name: "varchar", DataType: schemapb.DataType_VarChar},
+			{FieldID: 107, Name: "binary_vector", DataType: schemapb.DataType_BinaryVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "16"}}},
+		},
+	}
+	rows := entityRows{}
+	err := json.Unmarshal([]byte(`[
+		{"bool": true, "int8": 1, "int64": 10, "float": 1.5, "double": 2.5, "varchar": "a", "binary_vector": [1, 2]},
+		{"bool": false, "int8": 2, "int64": 20, "float": 3, "double": 4, "varchar": "b", "binary_vector": [3, 4]}
+	]`), &rows)
+	assert.NoError(t, err)
+
+	fieldsData, err := rowsToFieldsData(schema, rows)
+	assert.NoError(t, err)
+	// the auto id is skipped
+	assert.Equal(t, 7, len(fieldsData))
+	assert.Equal(t, []bool{true, false}, fieldsData[0].GetScalars().GetBoolData().GetData())
+	assert.Equal(t, []int32{1, 2}, fieldsData[1].GetScalars().GetIntData().GetData())
+	assert.Equal(t, []int64{10, 20}, fieldsData[2].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []float32{1.5, 3}, fieldsData[3].GetScalars().GetFloatData().GetData())
+	assert.Equal(t, []float64{2.5, 4}, fieldsData[4].GetScalars().GetDoubleData().GetData())
+	assert.Equal(t, []string{"a", "b"}, fieldsData[5].GetScalars().GetStringData().GetData())
+	assert.Equal(t, []byte{1, 2, 3, 4}, fieldsData[6].GetVectors().GetBinaryVector())
+	assert.Equal(t, int64(16), fieldsData[6].GetVectors().GetDim())
+
+	illegalRows := []entityRows{
+		{{"bool": 1}},
+		{{"int8": "1"}},
+		{{"int8": json.Number("2147483648")}},
+		{{"int64": json.Number("1.5")}},
+		{{"float": true}},
+		{{"varchar": 1}},
+		{{"binary_vector": []interface{}{json.Number("1")}}},
+		{{"binary_vector": []interface{}{json.Number("256"), json.Number("1")}}},
+		{{"binary_vector": "1"}},
+	}
+	for _, illegal := range illegalRows {
+		row := map[string]interface{}{
+			"bool": true, "int8": 1, "int64": 10, "float": 1.5, "double": 2.5, "varchar": "a",
+			"binary_vector": []interface{}{1, 2},
+		}
+		for k, v := range illegal[0] {
+			row[k] = v
+		}
+		_, err := rowsToFieldsData(schema, entityRows{row})
+		assert.Error(t, err, illegal)
+	}
+
+	// the undeclared keys are stored in the dynamic field
+	err = json.Unmarshal([]byte(`[{"id": 1, "color": "red", "price": 12345678901234567}, {"id": 2}]`), &rows)
+	assert.NoError(t, err)
+	fieldsData, err = rowsToFieldsData(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "extra", DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DynamicFieldParam, Value: "true"}}},
+		},
+	}, rows)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(fieldsData))
+	assert.Equal(t, []string{`{"color":"red","price":12345678901234567}`, `{}`},
+		fieldsData[1].GetScalars().GetStringData().GetData())
+
+	// the undeclared keys are sent to the hidden dynamic field of the collection enabling it
+	err = json.Unmarshal([]byte(`[{"id": 1, "color": "red"}]`), &rows)
+	assert.NoError(t, err)
+	fieldsData, err = rowsToFieldsData(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", IsPrimaryKey: true, DataType: schemapb.DataType_Int64,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.EnableDynamicFieldParam, Value: "true"}}},
+		},
+	}, rows)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(fieldsData))
+	assert.Equal(t, common.MetaFieldName, fieldsData[1].GetFieldName())
+	assert.Equal(t, []string{`{"color":"red"}`}, fieldsData[1].GetScalars().GetStringData().GetData())
+
+	// the values of JSON fields are objects or strings
+	err = json.Unmarshal([]byte(`[{"id": 1, "meta": {"a": {"b": 1}}}, {"id": 2, "meta": "{}"}]`), &rows)
+	assert.NoError(t, err)
+	fieldsData, err = rowsToFieldsData(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "meta", DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.JSONFieldParam, Value: "true"}}},
+		},
+	}, rows)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{`{"a":{"b":1}}`, `{}`}, fieldsData[1].GetScalars().GetStringData().GetData())
+
+	// the values of array fields are arrays or strings
+	err = json.Unmarshal([]byte(`[{"id": 1, "tags": ["a", "b"]}, {"id": 2, "tags": "[]"}]`), &rows)
+	assert.NoError(t, err)
+	fieldsData, err = rowsToFieldsData(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "tags", DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.ArrayElementTypeParam, Value: "VarChar"}}},
+		},
+	}, rows)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{`["a","b"]`, `[]`}, fieldsData[1].GetScalars().GetStringData().GetData())
+
+	// the dimension is missing
+	_, err = rowsToFieldsData(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{Name: "vec", DataType: schemapb.DataType_FloatVector}},
+	}, entityRows{{"vec": []interface{}{1}}})
+	assert.Error(t, err)
+
+	// unsupported data type
+	_, err = rowsToFieldsData(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{Name: "none", DataType: schemapb.DataType_None}},
+	}, entityRows{{"none": 1}})
+	assert.Error(t, err)
+}
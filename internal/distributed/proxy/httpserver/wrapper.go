@@ -10,7 +10,9 @@ import (
 )
 
 var (
-	errBadRequest = errors.New("bad request")
+	errBadRequest       = errors.New("bad request")
+	errUnauthenticated  = errors.New("unauthenticated")
+	errPermissionDenied = errors.New("permission denied")
 )
 
 // handlerFunc handles http request with gin context
@@ -39,6 +41,20 @@ func wrapHandler(handle handlerFunc) gin.HandlerFunc {
 				}
 				c.Negotiate(http.StatusBadRequest, bodyFormatNegotiate)
 				return
+			case errors.Is(err, errUnauthenticated):
+				bodyFormatNegotiate.Data = ErrResponse{
+					ErrorCode: commonpb.ErrorCode_PermissionDenied,
+					Reason:    err.Error(),
+				}
+				c.Negotiate(http.StatusUnauthorized, bodyFormatNegotiate)
+				return
+			case errors.Is(err, errPermissionDenied):
+				bodyFormatNegotiate.Data = ErrResponse{
+					ErrorCode: commonpb.ErrorCode_PermissionDenied,
+					Reason:    err.Error(),
+				}
+				c.Negotiate(http.StatusForbidden, bodyFormatNegotiate)
+				return
 			default:
 				bodyFormatNegotiate.Data = ErrResponse{
 					ErrorCode: commonpb.ErrorCode_UnexpectedError,
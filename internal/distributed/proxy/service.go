@@ -18,11 +18,8 @@ package grpcproxy
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"net"
 	"net/http"
 	"strconv"
@@ -63,6 +60,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -126,7 +124,7 @@ func (s *Server) startHTTPServer(port int) {
 	}
 	ginHandler := gin.Default()
 	apiv1 := ginHandler.Group("/api/v1")
-	httpserver.NewHandlers(s.proxy).RegisterRoutesTo(apiv1)
+	httpserver.NewHandlers(s.proxy, proxy.HTTPAuthorizer{}).RegisterRoutesTo(apiv1)
 	s.httpServerMtx.Lock()
 	s.httpServer = &http.Server{
 		Addr:         fmt.Sprintf(":%d", port),
@@ -181,7 +179,10 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			ot.UnaryServerInterceptor(opts...),
+			proxy.SizeInterceptor(Params.ServerMaxRecvSize, Params.ServerMaxSendSize),
 			grpc_auth.UnaryServerInterceptor(proxy.AuthenticationInterceptor),
+			proxy.DatabaseInterceptor(),
+			proxy.PrivilegeInterceptor(),
 		)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			ot.StreamServerInterceptor(opts...),
@@ -189,29 +190,12 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 	}
 
 	if Params.TLSEnabled {
-		cert, err := tls.LoadX509KeyPair(Params.ServerPemPath, Params.ServerKeyPath)
+		// the client certificates are required unless the CA is not configured
+		tlsConf, err := tlsutil.NewServerConfig(Params.ServerPemPath, Params.ServerKeyPath, Params.CaPemPath)
 		if err != nil {
-			log.Warn("proxy cant load x509 key pair", zap.Error(err))
+			log.Warn("proxy cant load tls config", zap.Error(err))
 			panic(err)
 		}
-
-		certPool := x509.NewCertPool()
-		rootBuf, err := ioutil.ReadFile(Params.CaPemPath)
-		if err != nil {
-			log.Warn("failed read ca pem", zap.Error(err))
-			panic(err)
-		}
-		if !certPool.AppendCertsFromPEM(rootBuf) {
-			log.Warn("fail to append ca to cert")
-			panic("fail to append ca to cert")
-		}
-
-		tlsConf := &tls.Config{
-			ClientAuth:   tls.RequireAndVerifyClientCert,
-			Certificates: []tls.Certificate{cert},
-			ClientCAs:    certPool,
-			MinVersion:   tls.VersionTLS13,
-		}
 		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(tlsConf)))
 	}
 	s.grpcExternalServer = grpc.NewServer(grpcOpts...)
@@ -252,20 +236,35 @@ func (s *Server) startInternalGrpc(grpcPort int, errChan chan error) {
 	log.Debug("Proxy internal server already listen on tcp", zap.Int("port", grpcPort))
 
 	opts := trace.GetInterceptorOpts()
-	s.grpcInternalServer = grpc.NewServer(
+	grpcOpts := []grpc.ServerOption{
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			ot.UnaryServerInterceptor(opts...),
+			proxy.SizeInterceptor(Params.ServerMaxRecvSize, Params.ServerMaxSendSize),
 			grpc_auth.UnaryServerInterceptor(proxy.AuthenticationInterceptor),
+			proxy.DatabaseInterceptor(),
+			// the members are authenticated by their client certificates only on the internal server
+			proxy.MemberInterceptor(),
+			proxy.PrivilegeInterceptor(),
 		)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			ot.StreamServerInterceptor(opts...),
 			grpc_auth.StreamServerInterceptor(proxy.AuthenticationInterceptor),
 		)),
-	)
+	}
+	tlsConfig, err := Params.InternalServerTLSConfig()
+	if err != nil {
+		log.Warn("Proxy internal server failed to load internal tls config", zap.Error(err))
+		errChan <- err
+		return
+	}
+	if tlsConfig != nil {
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	s.grpcInternalServer = grpc.NewServer(grpcOpts...)
 	proxypb.RegisterProxyServer(s.grpcInternalServer, s)
 	milvuspb.RegisterMilvusServiceServer(s.grpcInternalServer, s)
 	grpc_health_v1.RegisterHealthServer(s.grpcInternalServer, s)
@@ -649,6 +648,11 @@ func (s *Server) DropIndex(ctx context.Context, request *milvuspb.DropIndexReque
 	return s.proxy.DropIndex(ctx, request)
 }
 
+// CancelIndexBuild notifies Proxy to cancel the unfinished index builds
+func (s *Server) CancelIndexBuild(ctx context.Context, request *milvuspb.CancelIndexBuildRequest) (*milvuspb.CancelIndexBuildResponse, error) {
+	return s.proxy.CancelIndexBuild(ctx, request)
+}
+
 // DescribeIndex notifies Proxy to get index describe
 func (s *Server) DescribeIndex(ctx context.Context, request *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
 	return s.proxy.DescribeIndex(ctx, request)
@@ -673,6 +677,10 @@ func (s *Server) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*
 	return s.proxy.Delete(ctx, request)
 }
 
+func (s *Server) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	return s.proxy.Upsert(ctx, request)
+}
+
 func (s *Server) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	return s.proxy.Search(ctx, request)
 }
@@ -685,6 +693,21 @@ func (s *Server) Query(ctx context.Context, request *milvuspb.QueryRequest) (*mi
 	return s.proxy.Query(ctx, request)
 }
 
+// RegisterTemplate registers a search or query template
+func (s *Server) RegisterTemplate(ctx context.Context, req *milvuspb.RegisterTemplateRequest) (*milvuspb.RegisterTemplateResponse, error) {
+	return s.proxy.RegisterTemplate(ctx, req)
+}
+
+// DropTemplate drops a search or query template registered
+func (s *Server) DropTemplate(ctx context.Context, req *milvuspb.DropTemplateRequest) (*commonpb.Status, error) {
+	return s.proxy.DropTemplate(ctx, req)
+}
+
+// Explain explains the plan of a search or query without executing it
+func (s *Server) Explain(ctx context.Context, req *milvuspb.ExplainRequest) (*milvuspb.ExplainResponse, error) {
+	return s.proxy.Explain(ctx, req)
+}
+
 func (s *Server) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	return s.proxy.CalcDistance(ctx, request)
 }
@@ -721,6 +744,26 @@ func (s *Server) LoadBalance(ctx context.Context, request *milvuspb.LoadBalanceR
 	return s.proxy.LoadBalance(ctx, request)
 }
 
+// CordonNode cordons the query node
+func (s *Server) CordonNode(ctx context.Context, req *milvuspb.CordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return s.proxy.CordonNode(ctx, req)
+}
+
+// UncordonNode uncordons the query node
+func (s *Server) UncordonNode(ctx context.Context, req *milvuspb.UncordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return s.proxy.UncordonNode(ctx, req)
+}
+
+// GetCordonedNodes gets the cordoned query nodes
+func (s *Server) GetCordonedNodes(ctx context.Context, req *milvuspb.GetCordonedNodesRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return s.proxy.GetCordonedNodes(ctx, req)
+}
+
+// Balance triggers a balance round of the query nodes
+func (s *Server) Balance(ctx context.Context, req *milvuspb.BalanceRequest) (*milvuspb.BalanceResponse, error) {
+	return s.proxy.Balance(ctx, req)
+}
+
 // CreateAlias notifies Proxy to create alias
 func (s *Server) CreateAlias(ctx context.Context, request *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	return s.proxy.CreateAlias(ctx, request)
@@ -736,6 +779,41 @@ func (s *Server) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasReq
 	return s.proxy.AlterAlias(ctx, request)
 }
 
+// RenameCollection renames the specified collection.
+func (s *Server) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.RenameCollection(ctx, request)
+}
+
+// AddCollectionField adds a field to the specified collection.
+func (s *Server) AddCollectionField(ctx context.Context, request *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.AddCollectionField(ctx, request)
+}
+
+// AlterCollection sets or removes the properties of the specified collection.
+func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.AlterCollection(ctx, request)
+}
+
+// CreateDatabase creates the specified database.
+func (s *Server) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateDatabase(ctx, request)
+}
+
+// DropDatabase drops the specified database.
+func (s *Server) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.DropDatabase(ctx, request)
+}
+
+// AlterDatabase sets the quotas of the specified database.
+func (s *Server) AlterDatabase(ctx context.Context, request *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.AlterDatabase(ctx, request)
+}
+
+// ListDatabases lists the databases.
+func (s *Server) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return s.proxy.ListDatabases(ctx, request)
+}
+
 // GetCompactionState gets the state of a compaction
 func (s *Server) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	return s.proxy.GetCompactionState(ctx, req)
@@ -775,10 +853,25 @@ func (s *Server) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTa
 	return s.proxy.ListImportTasks(ctx, req)
 }
 
+// CancelImport cancels a pending or working import task
+func (s *Server) CancelImport(ctx context.Context, req *milvuspb.CancelImportRequest) (*milvuspb.GetImportStateResponse, error) {
+	return s.proxy.CancelImport(ctx, req)
+}
+
 func (s *Server) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
 	return s.proxy.GetReplicas(ctx, req)
 }
 
+// GetLoadingProgress gets the progress of loading a collection
+func (s *Server) GetLoadingProgress(ctx context.Context, req *milvuspb.GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error) {
+	return s.proxy.GetLoadingProgress(ctx, req)
+}
+
+// GetReleaseJobs gets the release jobs of a collection
+func (s *Server) GetReleaseJobs(ctx context.Context, req *milvuspb.GetReleaseJobsRequest) (*milvuspb.GetReleaseJobsResponse, error) {
+	return s.proxy.GetReleaseJobs(ctx, req)
+}
+
 // Check is required by gRPC healthy checking
 func (s *Server) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
 	ret := &grpc_health_v1.HealthCheckResponse{
@@ -844,3 +937,38 @@ func (s *Server) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCrede
 func (s *Server) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
 	return s.proxy.ListCredUsers(ctx, req)
 }
+
+// SaveRole creates the role or replaces the grants of it.
+func (s *Server) SaveRole(ctx context.Context, request *milvuspb.SaveRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.SaveRole(ctx, request)
+}
+
+// DropRole drops the role and unbinds it from the users.
+func (s *Server) DropRole(ctx context.Context, request *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.DropRole(ctx, request)
+}
+
+// ListRoles lists the roles and their grants.
+func (s *Server) ListRoles(ctx context.Context, request *milvuspb.ListRolesRequest) (*milvuspb.ListRolesResponse, error) {
+	return s.proxy.ListRoles(ctx, request)
+}
+
+// SaveUserRoles binds the roles to the user.
+func (s *Server) SaveUserRoles(ctx context.Context, request *milvuspb.SaveUserRolesRequest) (*commonpb.Status, error) {
+	return s.proxy.SaveUserRoles(ctx, request)
+}
+
+// ListUserRoles lists the roles bound to the users.
+func (s *Server) ListUserRoles(ctx context.Context, request *milvuspb.ListUserRolesRequest) (*milvuspb.ListUserRolesResponse, error) {
+	return s.proxy.ListUserRoles(ctx, request)
+}
+
+// CreateAPIKey creates an API key authenticating the requests as the user.
+func (s *Server) CreateAPIKey(ctx context.Context, request *milvuspb.CreateAPIKeyRequest) (*milvuspb.CreateAPIKeyResponse, error) {
+	return s.proxy.CreateAPIKey(ctx, request)
+}
+
+// RevokeAPIKey revokes the API key.
+func (s *Server) RevokeAPIKey(ctx context.Context, request *milvuspb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	return s.proxy.RevokeAPIKey(ctx, request)
+}
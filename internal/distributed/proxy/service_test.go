@@ -166,6 +166,34 @@ func (m *MockRootCoord) AlterAlias(ctx context.Context, req *milvuspb.AlterAlias
 	return nil, nil
 }
 
+func (m *MockRootCoord) RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) AddCollectionField(ctx context.Context, req *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) AlterDatabase(ctx context.Context, req *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return nil, nil
+}
+
 func (m *MockRootCoord) AllocTimestamp(ctx context.Context, req *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error) {
 	return nil, nil
 }
@@ -214,6 +242,10 @@ func (m *MockRootCoord) ListImportTasks(ctx context.Context, in *milvuspb.ListIm
 	return nil, nil
 }
 
+func (m *MockRootCoord) CancelImport(ctx context.Context, req *milvuspb.CancelImportRequest) (*milvuspb.GetImportStateResponse, error) {
+	return nil, nil
+}
+
 func (m *MockRootCoord) ReportImport(ctx context.Context, req *rootcoordpb.ImportResult) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -238,6 +270,34 @@ func (m *MockRootCoord) GetCredential(ctx context.Context, req *rootcoordpb.GetC
 	return nil, nil
 }
 
+func (m *MockRootCoord) SaveRole(ctx context.Context, req *milvuspb.SaveRoleRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListRoles(ctx context.Context, req *milvuspb.ListRolesRequest) (*milvuspb.ListRolesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) SaveUserRoles(ctx context.Context, req *milvuspb.SaveUserRolesRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListUserRoles(ctx context.Context, req *milvuspb.ListUserRolesRequest) (*milvuspb.ListUserRolesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) CreateAPIKey(ctx context.Context, req *milvuspb.CreateAPIKeyRequest) (*milvuspb.CreateAPIKeyResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) RevokeAPIKey(ctx context.Context, req *milvuspb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockIndexCoord struct {
 	MockBase
@@ -271,6 +331,14 @@ func (m *MockIndexCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexRe
 	return nil, nil
 }
 
+func (m *MockIndexCoord) CancelIndexBuild(ctx context.Context, req *indexpb.CancelIndexBuildRequest) (*milvuspb.CancelIndexBuildResponse, error) {
+	return nil, nil
+}
+
+func (m *MockIndexCoord) PrioritizeIndexBuild(ctx context.Context, req *indexpb.PrioritizeIndexBuildRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockIndexCoord) GetIndexStates(ctx context.Context, req *indexpb.GetIndexStatesRequest) (*indexpb.GetIndexStatesResponse, error) {
 	return nil, nil
 }
@@ -392,6 +460,38 @@ func (m *MockQueryCoord) GetShardLeaders(ctx context.Context, req *querypb.GetSh
 	return nil, nil
 }
 
+func (m *MockQueryCoord) SyncNewCreatedPartition(ctx context.Context, req *querypb.SyncNewCreatedPartitionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockQueryCoord) RefreshCollection(ctx context.Context, req *querypb.RefreshCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockQueryCoord) GetLoadingProgress(ctx context.Context, req *querypb.GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error) {
+	return nil, nil
+}
+
+func (m *MockQueryCoord) GetReleaseJobs(ctx context.Context, req *querypb.GetReleaseJobsRequest) (*milvuspb.GetReleaseJobsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockQueryCoord) CordonNode(ctx context.Context, req *milvuspb.CordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockQueryCoord) UncordonNode(ctx context.Context, req *milvuspb.UncordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockQueryCoord) GetCordonedNodes(ctx context.Context, req *milvuspb.GetCordonedNodesRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockQueryCoord) Balance(ctx context.Context, req *milvuspb.BalanceRequest) (*milvuspb.BalanceResponse, error) {
+	return nil, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockDataCoord struct {
 	MockBase
@@ -506,6 +606,10 @@ func (m *MockDataCoord) UpdateSegmentStatistics(ctx context.Context, req *datapb
 	return nil, nil
 }
 
+func (m *MockDataCoord) RefreshCollection(ctx context.Context, req *datapb.RefreshCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockProxy struct {
 	MockBase
@@ -609,6 +713,10 @@ func (m *MockProxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexRe
 	return nil, nil
 }
 
+func (m *MockProxy) CancelIndexBuild(ctx context.Context, request *milvuspb.CancelIndexBuildRequest) (*milvuspb.CancelIndexBuildResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) DescribeIndex(ctx context.Context, request *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
 	return nil, nil
 }
@@ -625,6 +733,10 @@ func (m *MockProxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 	return nil, nil
 }
 
+func (m *MockProxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
 	return nil, nil
 }
@@ -641,6 +753,18 @@ func (m *MockProxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (
 	return nil, nil
 }
 
+func (m *MockProxy) RegisterTemplate(ctx context.Context, req *milvuspb.RegisterTemplateRequest) (*milvuspb.RegisterTemplateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropTemplate(ctx context.Context, req *milvuspb.DropTemplateRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) Explain(ctx context.Context, req *milvuspb.ExplainRequest) (*milvuspb.ExplainResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	return nil, nil
 }
@@ -673,6 +797,22 @@ func (m *MockProxy) LoadBalance(ctx context.Context, request *milvuspb.LoadBalan
 	return nil, nil
 }
 
+func (m *MockProxy) CordonNode(ctx context.Context, req *milvuspb.CordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) UncordonNode(ctx context.Context, req *milvuspb.UncordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetCordonedNodes(ctx context.Context, req *milvuspb.GetCordonedNodesRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) Balance(ctx context.Context, req *milvuspb.BalanceRequest) (*milvuspb.BalanceResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) CreateAlias(ctx context.Context, request *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -685,6 +825,34 @@ func (m *MockProxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAlias
 	return nil, nil
 }
 
+func (m *MockProxy) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) AddCollectionField(ctx context.Context, request *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) AlterDatabase(ctx context.Context, request *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) SetRootCoordClient(rootCoord types.RootCoord) {
 
 }
@@ -744,10 +912,22 @@ func (m *MockProxy) ListImportTasks(ctx context.Context, in *milvuspb.ListImport
 	return nil, nil
 }
 
+func (m *MockProxy) CancelImport(ctx context.Context, req *milvuspb.CancelImportRequest) (*milvuspb.GetImportStateResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
 	return nil, nil
 }
 
+func (m *MockProxy) GetLoadingProgress(ctx context.Context, req *milvuspb.GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetReleaseJobs(ctx context.Context, req *milvuspb.GetReleaseJobsRequest) (*milvuspb.GetReleaseJobsResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -776,6 +956,34 @@ func (m *MockProxy) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUse
 	return nil, nil
 }
 
+func (m *MockProxy) SaveRole(ctx context.Context, req *milvuspb.SaveRoleRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListRoles(ctx context.Context, req *milvuspb.ListRolesRequest) (*milvuspb.ListRolesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) SaveUserRoles(ctx context.Context, req *milvuspb.SaveUserRolesRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListUserRoles(ctx context.Context, req *milvuspb.ListUserRolesRequest) (*milvuspb.ListUserRolesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CreateAPIKey(ctx context.Context, req *milvuspb.CreateAPIKeyRequest) (*milvuspb.CreateAPIKeyResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RevokeAPIKey(ctx context.Context, req *milvuspb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 func Test_NewServer(t *testing.T) {
 	ctx := context.Background()
@@ -899,6 +1107,11 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("CancelIndexBuild", func(t *testing.T) {
+		_, err := server.CancelIndexBuild(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("DescribeIndex", func(t *testing.T) {
 		_, err := server.DescribeIndex(ctx, nil)
 		assert.Nil(t, err)
@@ -924,6 +1137,11 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Upsert", func(t *testing.T) {
+		_, err := server.Upsert(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("Search", func(t *testing.T) {
 		_, err := server.Search(ctx, nil)
 		assert.Nil(t, err)
@@ -939,6 +1157,21 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("RegisterTemplate", func(t *testing.T) {
+		_, err := server.RegisterTemplate(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DropTemplate", func(t *testing.T) {
+		_, err := server.DropTemplate(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Explain", func(t *testing.T) {
+		_, err := server.Explain(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("CalcDistance", func(t *testing.T) {
 		_, err := server.CalcDistance(ctx, nil)
 		assert.Nil(t, err)
@@ -979,6 +1212,26 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("CordonNode", func(t *testing.T) {
+		_, err := server.CordonNode(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("UncordonNode", func(t *testing.T) {
+		_, err := server.UncordonNode(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetCordonedNodes", func(t *testing.T) {
+		_, err := server.GetCordonedNodes(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Balance", func(t *testing.T) {
+		_, err := server.Balance(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("CreateAlias", func(t *testing.T) {
 		_, err := server.CreateAlias(ctx, nil)
 		assert.Nil(t, err)
@@ -994,6 +1247,91 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("RenameCollection", func(t *testing.T) {
+		_, err := server.RenameCollection(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetLoadingProgress", func(t *testing.T) {
+		_, err := server.GetLoadingProgress(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetReleaseJobs", func(t *testing.T) {
+		_, err := server.GetReleaseJobs(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CancelImport", func(t *testing.T) {
+		_, err := server.CancelImport(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("AddCollectionField", func(t *testing.T) {
+		_, err := server.AddCollectionField(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("AlterCollection", func(t *testing.T) {
+		_, err := server.AlterCollection(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CreateDatabase", func(t *testing.T) {
+		_, err := server.CreateDatabase(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DropDatabase", func(t *testing.T) {
+		_, err := server.DropDatabase(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("AlterDatabase", func(t *testing.T) {
+		_, err := server.AlterDatabase(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ListDatabases", func(t *testing.T) {
+		_, err := server.ListDatabases(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("SaveRole", func(t *testing.T) {
+		_, err := server.SaveRole(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DropRole", func(t *testing.T) {
+		_, err := server.DropRole(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ListRoles", func(t *testing.T) {
+		_, err := server.ListRoles(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("SaveUserRoles", func(t *testing.T) {
+		_, err := server.SaveUserRoles(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ListUserRoles", func(t *testing.T) {
+		_, err := server.ListUserRoles(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CreateAPIKey", func(t *testing.T) {
+		_, err := server.CreateAPIKey(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("RevokeAPIKey", func(t *testing.T) {
+		_, err := server.RevokeAPIKey(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("GetCompactionState", func(t *testing.T) {
 		_, err := server.GetCompactionState(ctx, nil)
 		assert.Nil(t, err)
@@ -52,6 +52,11 @@ func NewClient(ctx context.Context, metaRoot string, etcdCli *clientv3.Client) (
 		return nil, err
 	}
 	ClientParams.InitOnce(typeutil.QueryCoordRole)
+	tlsConfig, err := ClientParams.InternalClientTLSConfig()
+	if err != nil {
+		log.Debug("QueryCoordClient NewClient failed", zap.Error(err))
+		return nil, err
+	}
 	client := &Client{
 		grpcClient: &grpcclient.ClientBase{
 			ClientMaxRecvSize: ClientParams.ClientMaxRecvSize,
@@ -59,6 +64,7 @@ func NewClient(ctx context.Context, metaRoot string, etcdCli *clientv3.Client) (
 			DialTimeout:       ClientParams.DialTimeout,
 			KeepAliveTime:     ClientParams.KeepAliveTime,
 			KeepAliveTimeout:  ClientParams.KeepAliveTimeout,
+			TLSConfig:         tlsConfig,
 		},
 		sess: sess,
 	}
@@ -331,3 +337,115 @@ func (c *Client) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeade
 	}
 	return ret.(*querypb.GetShardLeadersResponse), err
 }
+
+// SyncNewCreatedPartition adds the partition created to the collection loaded.
+func (c *Client) SyncNewCreatedPartition(ctx context.Context, req *querypb.SyncNewCreatedPartitionRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(querypb.QueryCoordClient).SyncNewCreatedPartition(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// RefreshCollection replaces the schema of a loaded collection.
+func (c *Client) RefreshCollection(ctx context.Context, req *querypb.RefreshCollectionRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(querypb.QueryCoordClient).RefreshCollection(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// GetLoadingProgress returns the progress of loading a collection.
+func (c *Client) GetLoadingProgress(ctx context.Context, req *querypb.GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(querypb.QueryCoordClient).GetLoadingProgress(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*milvuspb.GetLoadingProgressResponse), err
+}
+
+// GetReleaseJobs returns the release jobs of a collection.
+func (c *Client) GetReleaseJobs(ctx context.Context, req *querypb.GetReleaseJobsRequest) (*milvuspb.GetReleaseJobsResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(querypb.QueryCoordClient).GetReleaseJobs(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*milvuspb.GetReleaseJobsResponse), err
+}
+
+// CordonNode cordons the query node.
+func (c *Client) CordonNode(ctx context.Context, req *milvuspb.CordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(querypb.QueryCoordClient).CordonNode(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*milvuspb.CordonedNodesResponse), err
+}
+
+// UncordonNode uncordons the query node.
+func (c *Client) UncordonNode(ctx context.Context, req *milvuspb.UncordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(querypb.QueryCoordClient).UncordonNode(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*milvuspb.CordonedNodesResponse), err
+}
+
+// GetCordonedNodes gets the cordoned query nodes.
+func (c *Client) GetCordonedNodes(ctx context.Context, req *milvuspb.GetCordonedNodesRequest) (*milvuspb.CordonedNodesResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(querypb.QueryCoordClient).GetCordonedNodes(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*milvuspb.CordonedNodesResponse), err
+}
+
+// Balance plans a balance round of the query nodes.
+func (c *Client) Balance(ctx context.Context, req *milvuspb.BalanceRequest) (*milvuspb.BalanceResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(querypb.QueryCoordClient).Balance(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*milvuspb.BalanceResponse), err
+}
@@ -113,6 +113,30 @@ func Test_NewClient(t *testing.T) {
 
 		r18, err := client.GetShardLeaders(ctx, nil)
 		retCheck(retNotNil, r18, err)
+
+		r19, err := client.SyncNewCreatedPartition(ctx, nil)
+		retCheck(retNotNil, r19, err)
+
+		r20, err := client.RefreshCollection(ctx, nil)
+		retCheck(retNotNil, r20, err)
+
+		r21, err := client.GetLoadingProgress(ctx, nil)
+		retCheck(retNotNil, r21, err)
+
+		r22, err := client.GetReleaseJobs(ctx, nil)
+		retCheck(retNotNil, r22, err)
+
+		r23, err := client.CordonNode(ctx, nil)
+		retCheck(retNotNil, r23, err)
+
+		r24, err := client.UncordonNode(ctx, nil)
+		retCheck(retNotNil, r24, err)
+
+		r25, err := client.GetCordonedNodes(ctx, nil)
+		retCheck(retNotNil, r25, err)
+
+		r26, err := client.Balance(ctx, nil)
+		retCheck(retNotNil, r26, err)
 	}
 
 	client.grpcClient = &mock.ClientBase{
@@ -28,6 +28,7 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 
 	dcc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
@@ -254,13 +255,24 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	defer cancel()
 
 	opts := trace.GetInterceptorOpts()
-	s.grpcServer = grpc.NewServer(
+	grpcOpts := []grpc.ServerOption{
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(ot.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)))
+		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)),
+	}
+	tlsConfig, err := Params.InternalServerTLSConfig()
+	if err != nil {
+		log.Error("QueryCoord GrpcServer:failed to load internal tls config", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+	if tlsConfig != nil {
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	s.grpcServer = grpc.NewServer(grpcOpts...)
 	querypb.RegisterQueryCoordServer(s.grpcServer, s)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
@@ -400,3 +412,43 @@ func (s *Server) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasReque
 func (s *Server) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
 	return s.queryCoord.GetShardLeaders(ctx, req)
 }
+
+// SyncNewCreatedPartition adds the partition created to the collection loaded.
+func (s *Server) SyncNewCreatedPartition(ctx context.Context, req *querypb.SyncNewCreatedPartitionRequest) (*commonpb.Status, error) {
+	return s.queryCoord.SyncNewCreatedPartition(ctx, req)
+}
+
+// RefreshCollection replaces the schema of a loaded collection.
+func (s *Server) RefreshCollection(ctx context.Context, req *querypb.RefreshCollectionRequest) (*commonpb.Status, error) {
+	return s.queryCoord.RefreshCollection(ctx, req)
+}
+
+// GetLoadingProgress returns the progress of loading a collection.
+func (s *Server) GetLoadingProgress(ctx context.Context, req *querypb.GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error) {
+	return s.queryCoord.GetLoadingProgress(ctx, req)
+}
+
+// GetReleaseJobs returns the release jobs of a collection.
+func (s *Server) GetReleaseJobs(ctx context.Context, req *querypb.GetReleaseJobsRequest) (*milvuspb.GetReleaseJobsResponse, error) {
+	return s.queryCoord.GetReleaseJobs(ctx, req)
+}
+
+// CordonNode cordons the query node.
+func (s *Server) CordonNode(ctx context.Context, req *milvuspb.CordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return s.queryCoord.CordonNode(ctx, req)
+}
+
+// UncordonNode uncordons the query node.
+func (s *Server) UncordonNode(ctx context.Context, req *milvuspb.UncordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return s.queryCoord.UncordonNode(ctx, req)
+}
+
+// GetCordonedNodes gets the cordoned query nodes.
+func (s *Server) GetCordonedNodes(ctx context.Context, req *milvuspb.GetCordonedNodesRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return s.queryCoord.GetCordonedNodes(ctx, req)
+}
+
+// Balance plans a balance round of the query nodes.
+func (s *Server) Balance(ctx context.Context, req *milvuspb.BalanceRequest) (*milvuspb.BalanceResponse, error) {
+	return s.queryCoord.Balance(ctx, req)
+}
@@ -152,6 +152,38 @@ func (m *MockQueryCoord) GetShardLeaders(ctx context.Context, req *querypb.GetSh
 	return m.shardLeadersResp, m.err
 }
 
+func (m *MockQueryCoord) SyncNewCreatedPartition(ctx context.Context, req *querypb.SyncNewCreatedPartitionRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockQueryCoord) RefreshCollection(ctx context.Context, req *querypb.RefreshCollectionRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockQueryCoord) GetLoadingProgress(ctx context.Context, req *querypb.GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error) {
+	return &milvuspb.GetLoadingProgressResponse{Status: m.status}, m.err
+}
+
+func (m *MockQueryCoord) GetReleaseJobs(ctx context.Context, req *querypb.GetReleaseJobsRequest) (*milvuspb.GetReleaseJobsResponse, error) {
+	return &milvuspb.GetReleaseJobsResponse{Status: m.status}, m.err
+}
+
+func (m *MockQueryCoord) CordonNode(ctx context.Context, req *milvuspb.CordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return &milvuspb.CordonedNodesResponse{Status: m.status}, m.err
+}
+
+func (m *MockQueryCoord) UncordonNode(ctx context.Context, req *milvuspb.UncordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return &milvuspb.CordonedNodesResponse{Status: m.status}, m.err
+}
+
+func (m *MockQueryCoord) GetCordonedNodes(ctx context.Context, req *milvuspb.GetCordonedNodesRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return &milvuspb.CordonedNodesResponse{Status: m.status}, m.err
+}
+
+func (m *MockQueryCoord) Balance(ctx context.Context, req *milvuspb.BalanceRequest) (*milvuspb.BalanceResponse, error) {
+	return &milvuspb.BalanceResponse{Status: m.status}, m.err
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockRootCoord struct {
 	types.RootCoord
@@ -384,6 +416,62 @@ func Test_NewServer(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	t.Run("SyncNewCreatedPartition", func(t *testing.T) {
+		req := &querypb.SyncNewCreatedPartitionRequest{}
+		resp, err := server.SyncNewCreatedPartition(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("RefreshCollection", func(t *testing.T) {
+		req := &querypb.RefreshCollectionRequest{}
+		resp, err := server.RefreshCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("GetLoadingProgress", func(t *testing.T) {
+		req := &querypb.GetLoadingProgressRequest{}
+		resp, err := server.GetLoadingProgress(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("GetReleaseJobs", func(t *testing.T) {
+		req := &querypb.GetReleaseJobsRequest{}
+		resp, err := server.GetReleaseJobs(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("CordonNode", func(t *testing.T) {
+		req := &milvuspb.CordonNodeRequest{}
+		resp, err := server.CordonNode(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("UncordonNode", func(t *testing.T) {
+		req := &milvuspb.UncordonNodeRequest{}
+		resp, err := server.UncordonNode(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("GetCordonedNodes", func(t *testing.T) {
+		req := &milvuspb.GetCordonedNodesRequest{}
+		resp, err := server.GetCordonedNodes(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("Balance", func(t *testing.T) {
+		req := &milvuspb.BalanceRequest{}
+		resp, err := server.Balance(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	t.Run("GetMetrics", func(t *testing.T) {
 		req := &milvuspb.GetMetricsRequest{
 			Request: "",
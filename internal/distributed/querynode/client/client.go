@@ -46,6 +46,10 @@ func NewClient(ctx context.Context, addr string) (*Client, error) {
 		return nil, fmt.Errorf("addr is empty")
 	}
 	ClientParams.InitOnce(typeutil.QueryNodeRole)
+	tlsConfig, err := ClientParams.InternalClientTLSConfig()
+	if err != nil {
+		return nil, err
+	}
 	client := &Client{
 		addr: addr,
 		grpcClient: &grpcclient.ClientBase{
@@ -54,6 +58,7 @@ func NewClient(ctx context.Context, addr string) (*Client, error) {
 			DialTimeout:       ClientParams.DialTimeout,
 			KeepAliveTime:     ClientParams.KeepAliveTime,
 			KeepAliveTimeout:  ClientParams.KeepAliveTimeout,
+			TLSConfig:         tlsConfig,
 		},
 	}
 	client.grpcClient.SetRole(typeutil.QueryNodeRole)
@@ -273,6 +278,20 @@ func (c *Client) Query(ctx context.Context, req *querypb.QueryRequest) (*interna
 	return ret.(*internalpb.RetrieveResults), err
 }
 
+// ReleaseChannels releases the dml channels of a collection in QueryNode.
+func (c *Client) ReleaseChannels(ctx context.Context, req *querypb.ReleaseChannelsRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(querypb.QueryNodeClient).ReleaseChannels(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
 // GetSegmentInfo gets the information of the specified segments in QueryNode.
 func (c *Client) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
@@ -301,6 +320,48 @@ func (c *Client) SyncReplicaSegments(ctx context.Context, req *querypb.SyncRepli
 	return ret.(*commonpb.Status), err
 }
 
+// RefreshCollection refreshes the schema and adds the new partitions of a loaded collection in place.
+func (c *Client) RefreshCollection(ctx context.Context, req *querypb.LoadPartitionsRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(querypb.QueryNodeClient).RefreshCollection(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// Drain starts draining the QueryNode for graceful scale-in.
+func (c *Client) Drain(ctx context.Context, req *querypb.DrainRequest) (*querypb.DrainResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(querypb.QueryNodeClient).Drain(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*querypb.DrainResponse), err
+}
+
+// GetSegmentLoadingProgress returns the progress of the segments being loaded by QueryNode.
+func (c *Client) GetSegmentLoadingProgress(ctx context.Context, req *querypb.GetLoadingProgressRequest) (*querypb.GetSegmentLoadingProgressResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(querypb.QueryNodeClient).GetSegmentLoadingProgress(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*querypb.GetSegmentLoadingProgressResponse), err
+}
+
 // GetMetrics gets the metrics information of QueryNode.
 func (c *Client) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
@@ -107,6 +107,18 @@ func Test_NewClient(t *testing.T) {
 
 		r16, err := client.SyncReplicaSegments(ctx, nil)
 		retCheck(retNotNil, r16, err)
+
+		r17, err := client.RefreshCollection(ctx, nil)
+		retCheck(retNotNil, r17, err)
+
+		r18, err := client.Drain(ctx, nil)
+		retCheck(retNotNil, r18, err)
+
+		r19, err := client.GetSegmentLoadingProgress(ctx, nil)
+		retCheck(retNotNil, r19, err)
+
+		r20, err := client.ReleaseChannels(ctx, nil)
+		retCheck(retNotNil, r20, err)
 	}
 
 	client.grpcClient = &mock.ClientBase{
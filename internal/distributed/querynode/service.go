@@ -29,6 +29,7 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -170,13 +171,24 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	}
 
 	opts := trace.GetInterceptorOpts()
-	s.grpcServer = grpc.NewServer(
+	grpcOpts := []grpc.ServerOption{
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(ot.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)))
+		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)),
+	}
+	tlsConfig, err := Params.InternalServerTLSConfig()
+	if err != nil {
+		log.Error("QueryNode GrpcServer:failed to load internal tls config", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+	if tlsConfig != nil {
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	s.grpcServer = grpc.NewServer(grpcOpts...)
 	querypb.RegisterQueryNodeServer(s.grpcServer, s)
 
 	ctx, cancel := context.WithCancel(s.ctx)
@@ -300,6 +312,11 @@ func (s *Server) ReleaseSegments(ctx context.Context, req *querypb.ReleaseSegmen
 	return s.querynode.ReleaseSegments(ctx, req)
 }
 
+// ReleaseChannels releases the dml channels of a collection in QueryNode.
+func (s *Server) ReleaseChannels(ctx context.Context, req *querypb.ReleaseChannelsRequest) (*commonpb.Status, error) {
+	return s.querynode.ReleaseChannels(ctx, req)
+}
+
 // GetSegmentInfo gets the information of the specified segments in QueryNode.
 func (s *Server) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 	return s.querynode.GetSegmentInfo(ctx, req)
@@ -320,7 +337,36 @@ func (s *Server) SyncReplicaSegments(ctx context.Context, req *querypb.SyncRepli
 	return s.querynode.SyncReplicaSegments(ctx, req)
 }
 
+// RefreshCollection refreshes the schema and adds the new partitions of a loaded collection in place.
+func (s *Server) RefreshCollection(ctx context.Context, req *querypb.LoadPartitionsRequest) (*commonpb.Status, error) {
+	return s.querynode.RefreshCollection(ctx, req)
+}
+
+// Drain starts draining the QueryNode, new tasks are rejected and the data are handed off to other nodes.
+func (s *Server) Drain(ctx context.Context, req *querypb.DrainRequest) (*querypb.DrainResponse, error) {
+	return s.querynode.Drain(ctx, req)
+}
+
+// GetSegmentLoadingProgress returns the progress of the segments being loaded by QueryNode.
+func (s *Server) GetSegmentLoadingProgress(ctx context.Context, req *querypb.GetLoadingProgressRequest) (*querypb.GetSegmentLoadingProgressResponse, error) {
+	return s.querynode.GetSegmentLoadingProgress(ctx, req)
+}
+
 // GetMetrics gets the metrics information of QueryNode.
 func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.querynode.GetMetrics(ctx, req)
 }
+
+// drainableQueryNode is the QueryNode which reports its drain state
+type drainableQueryNode interface {
+	GetDrainState() qn.DrainState
+}
+
+// GetDrainState returns the drain state of the QueryNode.
+func (s *Server) GetDrainState() qn.DrainState {
+	node, ok := s.querynode.(drainableQueryNode)
+	if !ok {
+		return qn.DrainStateNone
+	}
+	return node.GetDrainState()
+}
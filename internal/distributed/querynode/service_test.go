@@ -108,6 +108,10 @@ func (m *MockQueryNode) ReleaseSegments(ctx context.Context, req *querypb.Releas
 	return m.status, m.err
 }
 
+func (m *MockQueryNode) ReleaseChannels(ctx context.Context, req *querypb.ReleaseChannelsRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 func (m *MockQueryNode) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 	return m.infoResp, m.err
 }
@@ -128,6 +132,18 @@ func (m *MockQueryNode) SyncReplicaSegments(ctx context.Context, req *querypb.Sy
 	return m.status, m.err
 }
 
+func (m *MockQueryNode) RefreshCollection(ctx context.Context, req *querypb.LoadPartitionsRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockQueryNode) Drain(ctx context.Context, req *querypb.DrainRequest) (*querypb.DrainResponse, error) {
+	return &querypb.DrainResponse{Status: m.status}, m.err
+}
+
+func (m *MockQueryNode) GetSegmentLoadingProgress(ctx context.Context, req *querypb.GetLoadingProgressRequest) (*querypb.GetSegmentLoadingProgressResponse, error) {
+	return &querypb.GetSegmentLoadingProgressResponse{Status: m.status}, m.err
+}
+
 func (m *MockQueryNode) SetEtcdClient(client *clientv3.Client) {
 }
 
@@ -306,6 +322,13 @@ func Test_NewServer(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	t.Run("ReleaseChannels", func(t *testing.T) {
+		req := &querypb.ReleaseChannelsRequest{}
+		resp, err := server.ReleaseChannels(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	t.Run("GetSegmentInfo", func(t *testing.T) {
 		req := &querypb.GetSegmentInfoRequest{}
 		resp, err := server.GetSegmentInfo(ctx, req)
@@ -343,6 +366,27 @@ func Test_NewServer(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
 	})
 
+	t.Run("RefreshCollection", func(t *testing.T) {
+		req := &querypb.LoadPartitionsRequest{}
+		resp, err := server.RefreshCollection(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("Drain", func(t *testing.T) {
+		req := &querypb.DrainRequest{}
+		resp, err := server.Drain(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("GetSegmentLoadingProgress", func(t *testing.T) {
+		req := &querypb.GetLoadingProgressRequest{}
+		resp, err := server.GetSegmentLoadingProgress(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
 	err = server.Stop()
 	assert.Nil(t, err)
 }
@@ -161,6 +161,51 @@ func Test_NewClient(t *testing.T) {
 
 		r34, err := client.ListCredUsers(ctx, nil)
 		retCheck(retNotNil, r34, err)
+
+		r36, err := client.RenameCollection(ctx, nil)
+		retCheck(retNotNil, r36, err)
+
+		r37, err := client.AddCollectionField(ctx, nil)
+		retCheck(retNotNil, r37, err)
+
+		r38, err := client.AlterCollection(ctx, nil)
+		retCheck(retNotNil, r38, err)
+
+		r39, err := client.CreateDatabase(ctx, nil)
+		retCheck(retNotNil, r39, err)
+
+		r40, err := client.DropDatabase(ctx, nil)
+		retCheck(retNotNil, r40, err)
+
+		r41, err := client.AlterDatabase(ctx, nil)
+		retCheck(retNotNil, r41, err)
+
+		r42, err := client.ListDatabases(ctx, nil)
+		retCheck(retNotNil, r42, err)
+
+		r43, err := client.SaveRole(ctx, nil)
+		retCheck(retNotNil, r43, err)
+
+		r44, err := client.DropRole(ctx, nil)
+		retCheck(retNotNil, r44, err)
+
+		r45, err := client.ListRoles(ctx, nil)
+		retCheck(retNotNil, r45, err)
+
+		r46, err := client.SaveUserRoles(ctx, nil)
+		retCheck(retNotNil, r46, err)
+
+		r47, err := client.ListUserRoles(ctx, nil)
+		retCheck(retNotNil, r47, err)
+
+		r48, err := client.CreateAPIKey(ctx, nil)
+		retCheck(retNotNil, r48, err)
+
+		r49, err := client.RevokeAPIKey(ctx, nil)
+		retCheck(retNotNil, r49, err)
+
+		r50, err := client.CancelImport(ctx, nil)
+		retCheck(retNotNil, r50, err)
 	}
 
 	client.grpcClient = &mock.ClientBase{
@@ -312,6 +357,8 @@ func Test_NewClient(t *testing.T) {
 
 	r35Timeout, err := client.ListImportTasks(shortCtx, nil)
 	retCheck(r35Timeout, err)
+	r36Timeout, err := client.CancelImport(shortCtx, nil)
+	retCheck(r36Timeout, err)
 
 	// clean up
 	err = client.Stop()
@@ -28,6 +28,7 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 
 	pnc "github.com/milvus-io/milvus/internal/distributed/proxy/client"
@@ -93,6 +94,41 @@ func (s *Server) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasReq
 	return s.rootCoord.AlterAlias(ctx, request)
 }
 
+// RenameCollection renames the specified collection.
+func (s *Server) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RenameCollection(ctx, request)
+}
+
+// AddCollectionField adds a field to the specified collection.
+func (s *Server) AddCollectionField(ctx context.Context, request *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AddCollectionField(ctx, request)
+}
+
+// AlterCollection sets or removes the properties of the specified collection.
+func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AlterCollection(ctx, request)
+}
+
+// CreateDatabase creates the specified database.
+func (s *Server) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateDatabase(ctx, request)
+}
+
+// DropDatabase drops the specified database.
+func (s *Server) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropDatabase(ctx, request)
+}
+
+// AlterDatabase sets the quotas of the specified database.
+func (s *Server) AlterDatabase(ctx context.Context, request *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AlterDatabase(ctx, request)
+}
+
+// ListDatabases lists the databases.
+func (s *Server) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return s.rootCoord.ListDatabases(ctx, request)
+}
+
 // NewServer create a new RootCoord grpc server.
 func NewServer(ctx context.Context, factory dependency.Factory) (*Server, error) {
 	ctx1, cancel := context.WithCancel(ctx)
@@ -251,13 +287,24 @@ func (s *Server) startGrpcLoop(port int) {
 	defer cancel()
 
 	opts := trace.GetInterceptorOpts()
-	s.grpcServer = grpc.NewServer(
+	grpcOpts := []grpc.ServerOption{
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(ot.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)))
+		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)),
+	}
+	tlsConfig, err := Params.InternalServerTLSConfig()
+	if err != nil {
+		log.Error("RootCoord GrpcServer:failed to load internal tls config", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+	if tlsConfig != nil {
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	s.grpcServer = grpc.NewServer(grpcOpts...)
 	rootcoordpb.RegisterRootCoordServer(s.grpcServer, s)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
@@ -454,6 +501,11 @@ func (s *Server) ListImportTasks(ctx context.Context, in *milvuspb.ListImportTas
 	return s.rootCoord.ListImportTasks(ctx, in)
 }
 
+// Cancel a pending or working import task
+func (s *Server) CancelImport(ctx context.Context, in *milvuspb.CancelImportRequest) (*milvuspb.GetImportStateResponse, error) {
+	return s.rootCoord.CancelImport(ctx, in)
+}
+
 // Report impot task state to datacoord
 func (s *Server) ReportImport(ctx context.Context, in *rootcoordpb.ImportResult) (*commonpb.Status, error) {
 	return s.rootCoord.ReportImport(ctx, in)
@@ -478,3 +530,38 @@ func (s *Server) DeleteCredential(ctx context.Context, request *milvuspb.DeleteC
 func (s *Server) ListCredUsers(ctx context.Context, request *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
 	return s.rootCoord.ListCredUsers(ctx, request)
 }
+
+// SaveRole creates the role or replaces the grants of it.
+func (s *Server) SaveRole(ctx context.Context, request *milvuspb.SaveRoleRequest) (*commonpb.Status, error) {
+	return s.rootCoord.SaveRole(ctx, request)
+}
+
+// DropRole drops the role and unbinds it from the users.
+func (s *Server) DropRole(ctx context.Context, request *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropRole(ctx, request)
+}
+
+// ListRoles lists the roles and their grants.
+func (s *Server) ListRoles(ctx context.Context, request *milvuspb.ListRolesRequest) (*milvuspb.ListRolesResponse, error) {
+	return s.rootCoord.ListRoles(ctx, request)
+}
+
+// SaveUserRoles binds the roles to the user.
+func (s *Server) SaveUserRoles(ctx context.Context, request *milvuspb.SaveUserRolesRequest) (*commonpb.Status, error) {
+	return s.rootCoord.SaveUserRoles(ctx, request)
+}
+
+// ListUserRoles lists the roles bound to the users.
+func (s *Server) ListUserRoles(ctx context.Context, request *milvuspb.ListUserRolesRequest) (*milvuspb.ListUserRolesResponse, error) {
+	return s.rootCoord.ListUserRoles(ctx, request)
+}
+
+// CreateAPIKey creates an API key authenticating the requests as the user.
+func (s *Server) CreateAPIKey(ctx context.Context, request *milvuspb.CreateAPIKeyRequest) (*milvuspb.CreateAPIKeyResponse, error) {
+	return s.rootCoord.CreateAPIKey(ctx, request)
+}
+
+// RevokeAPIKey revokes the API key.
+func (s *Server) RevokeAPIKey(ctx context.Context, request *milvuspb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RevokeAPIKey(ctx, request)
+}
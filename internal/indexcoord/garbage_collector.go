@@ -0,0 +1,121 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// garbageCollector removes the index files of the builds no longer in meta, such as the files left by the failed
+// recycles of the deleted indexes. The object storage doesn't tell when the files are written, so a build is
+// removed only after it has been found orphaned for the missing tolerance.
+type garbageCollector struct {
+	chunkManager     storage.ChunkManager
+	metaTable        *metaTable
+	rootPath         string
+	missingTolerance time.Duration
+	dryRun           bool
+
+	orphans map[UniqueID]time.Time // index build id -> the time it is found orphaned at first
+}
+
+func newGarbageCollector(chunkManager storage.ChunkManager, metaTable *metaTable, rootPath string,
+	missingTolerance time.Duration, dryRun bool) *garbageCollector {
+	return &garbageCollector{
+		chunkManager:     chunkManager,
+		metaTable:        metaTable,
+		rootPath:         strings.TrimSuffix(rootPath, "/"),
+		missingTolerance: missingTolerance,
+		dryRun:           dryRun,
+		orphans:          make(map[UniqueID]time.Time),
+	}
+}
+
+// parseIndexBuildID returns the index build id of the index file path, which is {rootPath}/{buildID}/{version}/{file}
+func (gc *garbageCollector) parseIndexBuildID(filePath string) (UniqueID, bool) {
+	filePath = strings.TrimPrefix(filePath, "/")
+	if !strings.HasPrefix(filePath, gc.rootPath+"/") {
+		return 0, false
+	}
+	buildID := strings.SplitN(strings.TrimPrefix(filePath, gc.rootPath+"/"), "/", 2)[0]
+	id, err := strconv.ParseInt(buildID, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+// scan lists the index builds in the object storage and removes the ones orphaned for the missing tolerance,
+// the removed build ids are returned, or the ones to remove in dry run mode.
+func (gc *garbageCollector) scan() ([]UniqueID, error) {
+	filePaths, err := gc.chunkManager.ListWithPrefix(gc.rootPath + "/")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	found := make(map[UniqueID]struct{})
+	var removed []UniqueID
+	for _, filePath := range filePaths {
+		buildID, ok := gc.parseIndexBuildID(filePath)
+		if !ok {
+			continue
+		}
+		if _, ok := found[buildID]; ok {
+			continue
+		}
+		found[buildID] = struct{}{}
+		if gc.metaTable.HasIndexBuildID(buildID) {
+			continue
+		}
+		since, ok := gc.orphans[buildID]
+		if !ok {
+			gc.orphans[buildID] = now
+			since = now
+		}
+		if now.Sub(since) < gc.missingTolerance {
+			continue
+		}
+		removed = append(removed, buildID)
+		if gc.dryRun {
+			continue
+		}
+		if err := gc.chunkManager.RemoveWithPrefix(gc.rootPath + "/" + strconv.FormatInt(buildID, 10) + "/"); err != nil {
+			// it could be removed next time
+			log.Warn("IndexCoord remove orphaned index files failed", zap.Int64("indexBuildID", buildID), zap.Error(err))
+			continue
+		}
+		delete(gc.orphans, buildID)
+	}
+	// the builds gone or back in meta are not orphans any more
+	for buildID := range gc.orphans {
+		if _, ok := found[buildID]; !ok || gc.metaTable.HasIndexBuildID(buildID) {
+			delete(gc.orphans, buildID)
+		}
+	}
+
+	log.Info("IndexCoord garbage collection scan result", zap.Int("builds", len(found)),
+		zap.Int("orphans", len(gc.orphans)), zap.Bool("dryRun", gc.dryRun), zap.Int64s("removed", removed))
+	return removed, nil
+}
@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestGarbageCollector_parseIndexBuildID(t *testing.T) {
+	gc := newGarbageCollector(nil, nil, "files/index_files", time.Hour, false)
+
+	id, ok := gc.parseIndexBuildID("files/index_files/100/1/IVF")
+	assert.True(t, ok)
+	assert.Equal(t, UniqueID(100), id)
+
+	id, ok = gc.parseIndexBuildID("/files/index_files/200/")
+	assert.True(t, ok)
+	assert.Equal(t, UniqueID(200), id)
+
+	_, ok = gc.parseIndexBuildID("files/index_files/not-id/1/IVF")
+	assert.False(t, ok)
+	_, ok = gc.parseIndexBuildID("files/insert_log/100")
+	assert.False(t, ok)
+}
+
+func TestGarbageCollector_scan(t *testing.T) {
+	chunkManager := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	rootPath := "files/index_files"
+	assert.NoError(t, chunkManager.Write(rootPath+"/1/1/IVF", []byte("used")))
+	assert.NoError(t, chunkManager.Write(rootPath+"/2/1/IVF", []byte("orphaned")))
+	assert.NoError(t, chunkManager.Write(rootPath+"/2/2/IVF", []byte("orphaned")))
+
+	mt := &metaTable{
+		indexBuildID2Meta: map[UniqueID]Meta{
+			1: {indexMeta: &indexpb.IndexMeta{IndexBuildID: 1}},
+		},
+	}
+
+	t.Run("within tolerance", func(t *testing.T) {
+		gc := newGarbageCollector(chunkManager, mt, rootPath, time.Hour, false)
+		removed, err := gc.scan()
+		assert.NoError(t, err)
+		assert.Empty(t, removed)
+		assert.Contains(t, gc.orphans, UniqueID(2))
+		assert.True(t, chunkManager.Exist(rootPath+"/2/1/IVF"))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		gc := newGarbageCollector(chunkManager, mt, rootPath, 0, true)
+		removed, err := gc.scan()
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []UniqueID{2}, removed)
+		assert.True(t, chunkManager.Exist(rootPath+"/2/1/IVF"))
+	})
+
+	t.Run("remove orphaned", func(t *testing.T) {
+		gc := newGarbageCollector(chunkManager, mt, rootPath, 0, false)
+		removed, err := gc.scan()
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []UniqueID{2}, removed)
+		assert.False(t, chunkManager.Exist(rootPath+"/2/1/IVF"))
+		assert.False(t, chunkManager.Exist(rootPath+"/2/2/IVF"))
+		assert.True(t, chunkManager.Exist(rootPath+"/1/1/IVF"))
+		assert.Empty(t, gc.orphans)
+	})
+}
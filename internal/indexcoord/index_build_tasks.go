@@ -0,0 +1,169 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+// indexBuildCanceledReason is the fail reason of the index builds canceled by users
+const indexBuildCanceledReason = "canceled by user"
+
+// isIndexBuildCanceled returns whether the index build is canceled by users
+func isIndexBuildCanceled(indexMeta *indexpb.IndexMeta) bool {
+	return indexMeta.GetState() == commonpb.IndexState_Failed && indexMeta.GetFailReason() == indexBuildCanceledReason
+}
+
+// parseSegmentFromDataPaths returns the collection and segment ids in the binlog paths of the build index request,
+// which are ${root}/insert_log/${collection_id}/${partition_id}/${segment_id}/${field_id}/${log_idx}
+func parseSegmentFromDataPaths(dataPaths []string) (collectionID UniqueID, segmentID UniqueID, ok bool) {
+	if len(dataPaths) == 0 {
+		return 0, 0, false
+	}
+	parts := strings.Split(strings.TrimRight(dataPaths[0], "/"), "/")
+	if len(parts) < 5 {
+		return 0, 0, false
+	}
+	collectionID, err := strconv.ParseInt(parts[len(parts)-5], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	segmentID, err = strconv.ParseInt(parts[len(parts)-3], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return collectionID, segmentID, true
+}
+
+// indexBuildPriorities records the collections whose index builds are assigned to index nodes ahead of the others,
+// which are the collections being loaded usually.
+type indexBuildPriorities struct {
+	mu          sync.RWMutex
+	collections map[UniqueID]struct{}
+}
+
+func newIndexBuildPriorities() *indexBuildPriorities {
+	return &indexBuildPriorities{
+		collections: make(map[UniqueID]struct{}),
+	}
+}
+
+func (p *indexBuildPriorities) prioritize(collectionID UniqueID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.collections[collectionID] = struct{}{}
+}
+
+func (p *indexBuildPriorities) isPrioritized(indexMeta *indexpb.IndexMeta) bool {
+	collectionID, _, ok := parseSegmentFromDataPaths(indexMeta.GetReq().GetDataPaths())
+	if !ok {
+		return false
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	_, ok = p.collections[collectionID]
+	return ok
+}
+
+// sortTasks sorts the unassigned tasks, the ones of the prioritized collections first, then the ones of lower
+// versions. The priorities of the collections without unassigned tasks are dropped.
+func (p *indexBuildPriorities) sortTasks(metas []Meta) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	prioritized := make(map[UniqueID]bool, len(metas))
+	pending := make(map[UniqueID]struct{})
+	for _, meta := range metas {
+		collectionID, _, ok := parseSegmentFromDataPaths(meta.indexMeta.GetReq().GetDataPaths())
+		if !ok {
+			continue
+		}
+		if _, ok := p.collections[collectionID]; ok {
+			prioritized[meta.indexMeta.GetIndexBuildID()] = true
+			pending[collectionID] = struct{}{}
+		}
+	}
+	for collectionID := range p.collections {
+		if _, ok := pending[collectionID]; !ok {
+			delete(p.collections, collectionID)
+		}
+	}
+
+	sort.SliceStable(metas, func(i, j int) bool {
+		pi, pj := prioritized[metas[i].indexMeta.GetIndexBuildID()], prioritized[metas[j].indexMeta.GetIndexBuildID()]
+		if pi != pj {
+			return pi
+		}
+		return metas[i].indexMeta.GetVersion() < metas[j].indexMeta.GetVersion()
+	})
+}
+
+// getIndexBuildProgress returns the per-segment progress of the index builds of the collection and of the index,
+// the filters are ignored if they're 0.
+func getIndexBuildProgress(metas []Meta, collectionID UniqueID, indexID UniqueID, priorities *indexBuildPriorities) *metricsinfo.IndexBuildProgress {
+	progress := &metricsinfo.IndexBuildProgress{
+		Tasks: make([]metricsinfo.IndexBuildTask, 0, len(metas)),
+	}
+	for _, meta := range metas {
+		indexMeta := meta.indexMeta
+		if indexID != 0 && indexMeta.GetReq().GetIndexID() != indexID {
+			continue
+		}
+		taskCollectionID, segmentID, _ := parseSegmentFromDataPaths(indexMeta.GetReq().GetDataPaths())
+		if collectionID != 0 && taskCollectionID != collectionID {
+			continue
+		}
+		task := metricsinfo.IndexBuildTask{
+			IndexBuildID: indexMeta.GetIndexBuildID(),
+			IndexID:      indexMeta.GetReq().GetIndexID(),
+			IndexName:    indexMeta.GetReq().GetIndexName(),
+			CollectionID: taskCollectionID,
+			SegmentID:    segmentID,
+			NumRows:      indexMeta.GetReq().GetNumRows(),
+			State:        indexMeta.GetState().String(),
+			NodeID:       indexMeta.GetNodeID(),
+			Attempts:     indexMeta.GetVersion(),
+			FailedReason: indexMeta.GetFailReason(),
+			Canceled:     isIndexBuildCanceled(indexMeta),
+		}
+		progress.TotalRows += task.NumRows
+		switch indexMeta.GetState() {
+		case commonpb.IndexState_Unissued:
+			progress.Pending++
+			task.Prioritized = priorities.isPrioritized(indexMeta)
+		case commonpb.IndexState_InProgress:
+			progress.InProgress++
+		case commonpb.IndexState_Finished:
+			progress.Finished++
+			progress.IndexedRows += task.NumRows
+		case commonpb.IndexState_Failed:
+			progress.Failed++
+		}
+		progress.Tasks = append(progress.Tasks, task)
+	}
+	sort.Slice(progress.Tasks, func(i, j int) bool {
+		return progress.Tasks[i].IndexBuildID < progress.Tasks[j].IndexBuildID
+	})
+	return progress
+}
@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+func newIndexBuildMeta(indexBuildID, collectionID, segmentID UniqueID, state commonpb.IndexState, version int64) Meta {
+	return Meta{
+		indexMeta: &indexpb.IndexMeta{
+			IndexBuildID: indexBuildID,
+			State:        state,
+			Version:      version,
+			Req: &indexpb.BuildIndexRequest{
+				IndexID:   1,
+				IndexName: "idx",
+				NumRows:   100,
+				DataPaths: []string{fmt.Sprintf("files/insert_log/%d/10/%d/101/1", collectionID, segmentID)},
+			},
+		},
+	}
+}
+
+func TestParseSegmentFromDataPaths(t *testing.T) {
+	collectionID, segmentID, ok := parseSegmentFromDataPaths([]string{"files/insert_log/1/2/3/101/4"})
+	assert.True(t, ok)
+	assert.Equal(t, UniqueID(1), collectionID)
+	assert.Equal(t, UniqueID(3), segmentID)
+
+	_, _, ok = parseSegmentFromDataPaths(nil)
+	assert.False(t, ok)
+	_, _, ok = parseSegmentFromDataPaths([]string{"101/4"})
+	assert.False(t, ok)
+	_, _, ok = parseSegmentFromDataPaths([]string{"files/insert_log/a/2/3/101/4"})
+	assert.False(t, ok)
+}
+
+func TestIndexBuildPriorities_SortTasks(t *testing.T) {
+	p := newIndexBuildPriorities()
+	p.prioritize(2)
+	p.prioritize(3)
+	metas := []Meta{
+		newIndexBuildMeta(1, 1, 11, commonpb.IndexState_Unissued, 0),
+		newIndexBuildMeta(2, 2, 21, commonpb.IndexState_Unissued, 2),
+		newIndexBuildMeta(3, 1, 12, commonpb.IndexState_Unissued, 1),
+		newIndexBuildMeta(4, 2, 22, commonpb.IndexState_Unissued, 1),
+	}
+	p.sortTasks(metas)
+	buildIDs := make([]UniqueID, 0, len(metas))
+	for _, meta := range metas {
+		buildIDs = append(buildIDs, meta.indexMeta.GetIndexBuildID())
+	}
+	assert.Equal(t, []UniqueID{4, 2, 1, 3}, buildIDs)
+
+	// collection 3 has no unassigned tasks
+	assert.True(t, p.isPrioritized(metas[0].indexMeta))
+	_, ok := p.collections[3]
+	assert.False(t, ok)
+}
+
+func TestIndexCoord_PrioritizeIndexBuild(t *testing.T) {
+	ic := &IndexCoord{buildPriorities: newIndexBuildPriorities()}
+	ic.UpdateStateCode(internalpb.StateCode_Healthy)
+
+	status, err := ic.PrioritizeIndexBuild(context.Background(), &indexpb.PrioritizeIndexBuildRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+	status, err = ic.PrioritizeIndexBuild(context.Background(), &indexpb.PrioritizeIndexBuildRequest{CollectionID: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	meta := newIndexBuildMeta(1, 2, 21, commonpb.IndexState_Unissued, 0)
+	assert.True(t, ic.buildPriorities.isPrioritized(meta.indexMeta))
+
+	resp, err := ic.CancelIndexBuild(context.Background(), &indexpb.CancelIndexBuildRequest{CollectionID: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
+
+func TestGetIndexBuildProgress(t *testing.T) {
+	canceled := newIndexBuildMeta(4, 1, 14, commonpb.IndexState_Failed, 1)
+	canceled.indexMeta.FailReason = indexBuildCanceledReason
+	metas := []Meta{
+		newIndexBuildMeta(3, 1, 13, commonpb.IndexState_Unissued, 0),
+		newIndexBuildMeta(1, 1, 11, commonpb.IndexState_Finished, 1),
+		newIndexBuildMeta(2, 1, 12, commonpb.IndexState_InProgress, 1),
+		canceled,
+		newIndexBuildMeta(5, 2, 21, commonpb.IndexState_Finished, 1),
+	}
+	p := newIndexBuildPriorities()
+	p.prioritize(1)
+
+	progress := getIndexBuildProgress(metas, 1, 0, p)
+	assert.Len(t, progress.Tasks, 4)
+	assert.Equal(t, UniqueID(1), progress.Tasks[0].IndexBuildID)
+	assert.Equal(t, UniqueID(11), progress.Tasks[0].SegmentID)
+	assert.Equal(t, 1, progress.Pending)
+	assert.Equal(t, 1, progress.InProgress)
+	assert.Equal(t, 1, progress.Finished)
+	assert.Equal(t, 1, progress.Failed)
+	assert.Equal(t, int64(400), progress.TotalRows)
+	assert.Equal(t, int64(100), progress.IndexedRows)
+	assert.True(t, progress.Tasks[2].Prioritized)
+	assert.True(t, progress.Tasks[3].Canceled)
+
+	progress = getIndexBuildProgress(metas, 0, 2, p)
+	assert.Empty(t, progress.Tasks)
+	progress = getIndexBuildProgress(metas, 0, 0, p)
+	assert.Len(t, progress.Tasks, 5)
+}
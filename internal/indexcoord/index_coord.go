@@ -18,10 +18,10 @@ package indexcoord
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"math/rand"
 	"os"
-	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -90,6 +90,8 @@ type IndexCoord struct {
 
 	metaTable   *metaTable
 	nodeManager *NodeManager
+	// buildPriorities are the collections whose index builds are assigned first
+	buildPriorities *indexBuildPriorities
 
 	metricsCacheManager *metricsinfo.MetricsCacheManager
 
@@ -123,6 +125,7 @@ func NewIndexCoord(ctx context.Context, factory dependency.Factory) (*IndexCoord
 		assignTaskInterval: time.Second * 3,
 		taskLimit:          20,
 		factory:            factory,
+		buildPriorities:    newIndexBuildPriorities(),
 	}
 	i.UpdateStateCode(internalpb.StateCode_Abnormal)
 	return i, nil
@@ -263,6 +266,11 @@ func (i *IndexCoord) Start() error {
 		i.loopWg.Add(1)
 		go i.recycleUnusedIndexFiles()
 
+		if Params.IndexCoordCfg.EnableGarbageCollection {
+			i.loopWg.Add(1)
+			go i.recycleOrphanedIndexFiles()
+		}
+
 		i.loopWg.Add(1)
 		go i.assignTaskLoop()
 
@@ -558,6 +566,78 @@ func (i *IndexCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexReques
 	return ret, nil
 }
 
+// CancelIndexBuild sets the unfinished index builds of the collection to be failed, the one of IndexBuildID, or all
+// of the index of IndexName.
+func (i *IndexCoord) CancelIndexBuild(ctx context.Context, req *indexpb.CancelIndexBuildRequest) (*milvuspb.CancelIndexBuildResponse, error) {
+	log.Info("IndexCoord CancelIndexBuild", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("indexName", req.GetIndexName()), zap.Int64("indexBuildID", req.GetIndexBuildID()))
+	if !i.isHealthy() {
+		errMsg := "IndexCoord is not healthy"
+		log.Warn(errMsg)
+		return &milvuspb.CancelIndexBuildResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    errMsg,
+			},
+		}, nil
+	}
+	if req.GetIndexBuildID() == 0 && req.GetIndexName() == "" {
+		return &milvuspb.CancelIndexBuildResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "index build id or index name is required to cancel the index builds",
+			},
+		}, nil
+	}
+
+	canceled, nodeIDs, err := i.metaTable.CancelIndexBuilds(req.GetCollectionID(), req.GetIndexName(), req.GetIndexBuildID())
+	for _, nodeID := range nodeIDs {
+		// the index nodes abandon the canceled builds without updating the index meta
+		i.nodeManager.pq.IncPriority(nodeID, -1)
+	}
+	if err != nil {
+		log.Warn("IndexCoord CancelIndexBuild failed", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		return &milvuspb.CancelIndexBuildResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			CanceledIndexBuildIDs: canceled,
+		}, nil
+	}
+	return &milvuspb.CancelIndexBuildResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		CanceledIndexBuildIDs: canceled,
+	}, nil
+}
+
+// PrioritizeIndexBuild assigns the index builds of the collection to index nodes ahead of the others until none of
+// them is pending.
+func (i *IndexCoord) PrioritizeIndexBuild(ctx context.Context, req *indexpb.PrioritizeIndexBuildRequest) (*commonpb.Status, error) {
+	log.Info("IndexCoord PrioritizeIndexBuild", zap.Int64("collectionID", req.GetCollectionID()))
+	if !i.isHealthy() {
+		errMsg := "IndexCoord is not healthy"
+		log.Warn(errMsg)
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    errMsg,
+		}, nil
+	}
+	if req.GetCollectionID() == 0 {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "collection id is required to prioritize the index builds",
+		}, nil
+	}
+
+	i.buildPriorities.prioritize(req.GetCollectionID())
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 // GetIndexFilePaths gets the index file paths from IndexCoord.
 func (i *IndexCoord) GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
 	log.Debug("IndexCoord GetIndexFilePaths", zap.Int("number of IndexBuildIds", len(req.IndexBuildIDs)))
@@ -663,6 +743,10 @@ func (i *IndexCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsReq
 		return metrics, nil
 	}
 
+	if metricType == metricsinfo.IndexBuildTasksMetrics {
+		return i.getIndexBuildMetrics(req.Request), nil
+	}
+
 	log.Debug("IndexCoord.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("node id", i.session.ServerID),
 		zap.String("req", req.Request),
@@ -677,6 +761,41 @@ func (i *IndexCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsReq
 	}, nil
 }
 
+// getIndexBuildMetrics handles the GetMetrics requests listing the progress of the index builds
+func (i *IndexCoord) getIndexBuildMetrics(req string) *milvuspb.GetMetricsResponse {
+	collectionID, err := metricsinfo.ParseCollectionID(req)
+	var indexID UniqueID
+	if err == nil {
+		indexID, err = metricsinfo.ParseIndexID(req)
+	}
+	if err != nil {
+		log.Warn("IndexCoord.GetMetrics failed", zap.String("metric type", metricsinfo.IndexBuildTasksMetrics), zap.Error(err))
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}
+	}
+	ret := getIndexBuildProgress(i.metaTable.GetIndexMetas(), collectionID, indexID, i.buildPriorities)
+	resp, err := json.Marshal(ret)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.IndexCoordRole, i.session.ServerID),
+	}
+}
+
 func (i *IndexCoord) tsLoop() {
 	tsoTicker := time.NewTicker(tso.UpdateTimestampStep)
 	defer tsoTicker.Stop()
@@ -750,6 +869,32 @@ func (i *IndexCoord) recycleUnusedIndexFiles() {
 	}
 }
 
+// recycleOrphanedIndexFiles is used to delete the index files of the index builds no longer in meta.
+func (i *IndexCoord) recycleOrphanedIndexFiles() {
+	ctx, cancel := context.WithCancel(i.loopCtx)
+
+	defer cancel()
+	defer i.loopWg.Done()
+
+	gc := newGarbageCollector(i.chunkManager, i.metaTable, Params.IndexCoordCfg.IndexStorageRootPath,
+		Params.IndexCoordCfg.GCMissingTolerance, Params.IndexCoordCfg.GCDryRun)
+	timeTicker := time.NewTicker(Params.IndexCoordCfg.GCInterval)
+	defer timeTicker.Stop()
+	log.Debug("IndexCoord start recycleOrphanedIndexFiles loop",
+		zap.Duration("interval", Params.IndexCoordCfg.GCInterval), zap.Bool("dryRun", Params.IndexCoordCfg.GCDryRun))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timeTicker.C:
+			if _, err := gc.scan(); err != nil {
+				log.Warn("IndexCoord recycleOrphanedIndexFiles scan failed", zap.Error(err))
+			}
+		}
+	}
+}
+
 // watchNodeLoop is used to monitor IndexNode going online and offline.
 //go:norace
 // fix datarace in unittest
@@ -894,9 +1039,7 @@ func (i *IndexCoord) assignTaskLoop() {
 				continue
 			}
 			metas := i.metaTable.GetUnassignedTasks(serverIDs)
-			sort.Slice(metas, func(i, j int) bool {
-				return metas[i].indexMeta.Version <= metas[j].indexMeta.Version
-			})
+			i.buildPriorities.sortTasks(metas)
 			// only log if we find unassigned tasks
 			if len(metas) != 0 {
 				log.Debug("IndexCoord find unassigned tasks ", zap.Int("Unassigned tasks number", len(metas)), zap.Int64s("Available IndexNode IDs", serverIDs))
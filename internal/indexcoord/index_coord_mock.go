@@ -173,6 +173,34 @@ func (icm *Mock) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (
 	}, nil
 }
 
+// CancelIndexBuild cancels the index builds, if Param `Failure` is true, it will return an error.
+func (icm *Mock) CancelIndexBuild(ctx context.Context, req *indexpb.CancelIndexBuildRequest) (*milvuspb.CancelIndexBuildResponse, error) {
+	if icm.Failure {
+		return &milvuspb.CancelIndexBuildResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			},
+		}, errors.New("IndexCoordinate CancelIndexBuild failed")
+	}
+	return &milvuspb.CancelIndexBuildResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+// PrioritizeIndexBuild prioritizes the index builds of the collection, if Param `Failure` is true, it will return an error.
+func (icm *Mock) PrioritizeIndexBuild(ctx context.Context, req *indexpb.PrioritizeIndexBuildRequest) (*commonpb.Status, error) {
+	if icm.Failure {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		}, errors.New("IndexCoordinate PrioritizeIndexBuild failed")
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 // GetIndexStates gets the indexes states, if Param `Failure` is true, it will return an error.
 // Under normal circumstances the state of each index is `IndexState_Finished`.
 func (icm *Mock) GetIndexStates(ctx context.Context, req *indexpb.GetIndexStatesRequest) (*indexpb.GetIndexStatesResponse, error) {
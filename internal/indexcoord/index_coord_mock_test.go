@@ -95,6 +95,18 @@ func TestIndexCoordMock(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	t.Run("CancelIndexBuild", func(t *testing.T) {
+		resp, err := icm.CancelIndexBuild(ctx, &indexpb.CancelIndexBuildRequest{IndexBuildID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("PrioritizeIndexBuild", func(t *testing.T) {
+		resp, err := icm.PrioritizeIndexBuild(ctx, &indexpb.PrioritizeIndexBuildRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
 	t.Run("GetIndexFilePaths", func(t *testing.T) {
 		req := &indexpb.GetIndexFilePathsRequest{
 			IndexBuildIDs: []UniqueID{0, 1},
@@ -180,6 +192,18 @@ func TestIndexCoordMockError(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
 	})
 
+	t.Run("CancelIndexBuild", func(t *testing.T) {
+		resp, err := icm.CancelIndexBuild(ctx, &indexpb.CancelIndexBuildRequest{IndexBuildID: 1})
+		assert.NotNil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("PrioritizeIndexBuild", func(t *testing.T) {
+		resp, err := icm.PrioritizeIndexBuild(ctx, &indexpb.PrioritizeIndexBuildRequest{CollectionID: 1})
+		assert.NotNil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+	})
+
 	t.Run("GetIndexFilePaths", func(t *testing.T) {
 		req := &indexpb.GetIndexFilePathsRequest{
 			IndexBuildIDs: []UniqueID{0, 1},
@@ -338,6 +338,14 @@ func TestIndexCoord_NotHealthy(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
 
+	cancelResp, err := ic.CancelIndexBuild(context.Background(), &indexpb.CancelIndexBuildRequest{IndexBuildID: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, cancelResp.GetStatus().GetErrorCode())
+
+	status, err = ic.PrioritizeIndexBuild(context.Background(), &indexpb.PrioritizeIndexBuildRequest{CollectionID: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+
 	req3 := &indexpb.GetIndexStatesRequest{}
 	resp2, err := ic.GetIndexStates(context.Background(), req3)
 	assert.Nil(t, err)
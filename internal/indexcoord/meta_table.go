@@ -545,6 +545,15 @@ func (mt *metaTable) GetNodeTaskStats() map[UniqueID]int {
 	return nodePriority
 }
 
+// HasIndexBuildID returns whether the index meta of the indexBuildID exists.
+func (mt *metaTable) HasIndexBuildID(indexBuildID UniqueID) bool {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+
+	_, ok := mt.indexBuildID2Meta[indexBuildID]
+	return ok
+}
+
 // GetIndexMetaByIndexBuildID get the index meta of the specified indexBuildID.
 func (mt *metaTable) GetIndexMetaByIndexBuildID(indexBuildID UniqueID) *indexpb.IndexMeta {
 	mt.lock.RLock()
@@ -558,3 +567,90 @@ func (mt *metaTable) GetIndexMetaByIndexBuildID(indexBuildID UniqueID) *indexpb.
 	}
 	return proto.Clone(meta.indexMeta).(*indexpb.IndexMeta)
 }
+
+// GetIndexMetas gets the index metas of the indexes not deleted.
+func (mt *metaTable) GetIndexMetas() []Meta {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+
+	metas := make([]Meta, 0, len(mt.indexBuildID2Meta))
+	for _, meta := range mt.indexBuildID2Meta {
+		if meta.indexMeta.MarkDeleted {
+			continue
+		}
+		metas = append(metas, Meta{indexMeta: proto.Clone(meta.indexMeta).(*indexpb.IndexMeta), revision: meta.revision})
+	}
+	return metas
+}
+
+// CancelIndexBuilds sets the unfinished index builds of the collection to be failed, the one of indexBuildID, or all
+// of the index of indexName if indexBuildID is 0. The version is updated too, so that the index node building it
+// abandons the result. It returns the canceled index builds and the index nodes of the ones in progress.
+func (mt *metaTable) CancelIndexBuilds(collectionID UniqueID, indexName string, indexBuildID UniqueID) ([]UniqueID, []UniqueID, error) {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	if indexBuildID != 0 {
+		meta, ok := mt.indexBuildID2Meta[indexBuildID]
+		if !ok || meta.indexMeta.MarkDeleted {
+			return nil, nil, fmt.Errorf("index not exists with ID = %d", indexBuildID)
+		}
+		if taskCollectionID, _, _ := parseSegmentFromDataPaths(meta.indexMeta.Req.GetDataPaths()); taskCollectionID != collectionID {
+			return nil, nil, fmt.Errorf("index build %d does not belong to collection %d", indexBuildID, collectionID)
+		}
+		if meta.indexMeta.State == commonpb.IndexState_Finished || meta.indexMeta.State == commonpb.IndexState_Failed {
+			return nil, nil, fmt.Errorf("index build %d has been %s", indexBuildID, meta.indexMeta.State.String())
+		}
+	}
+
+	canceled := make([]UniqueID, 0)
+	var nodeIDs []UniqueID
+	for buildID, meta := range mt.indexBuildID2Meta {
+		if indexBuildID != 0 && buildID != indexBuildID {
+			continue
+		}
+		if indexBuildID == 0 && meta.indexMeta.Req.GetIndexName() != indexName {
+			continue
+		}
+		if taskCollectionID, _, _ := parseSegmentFromDataPaths(meta.indexMeta.Req.GetDataPaths()); taskCollectionID != collectionID {
+			continue
+		}
+		if meta.indexMeta.MarkDeleted || (meta.indexMeta.State != commonpb.IndexState_Unissued &&
+			meta.indexMeta.State != commonpb.IndexState_InProgress) {
+			continue
+		}
+		state := meta.indexMeta.State
+		cancel := func(m *Meta) {
+			m.indexMeta.State = commonpb.IndexState_Failed
+			m.indexMeta.FailReason = indexBuildCanceledReason
+			m.indexMeta.Version = m.indexMeta.Version + 1
+		}
+		/* #nosec G601 */
+		cancel(&meta)
+		if err := mt.saveIndexMeta(&meta); err != nil {
+			fn := func() error {
+				m, err := mt.reloadMeta(buildID)
+				if m == nil {
+					return err
+				}
+				cancel(m)
+				return mt.saveIndexMeta(m)
+			}
+			if err2 := retry.Do(context.TODO(), fn, retry.Attempts(5)); err2 != nil {
+				log.Error("IndexCoord metaTable CancelIndexBuilds failed", zap.Int64("indexBuildID", buildID), zap.Error(err2))
+				return canceled, nodeIDs, err2
+			}
+		}
+		if state == commonpb.IndexState_Unissued {
+			metrics.IndexCoordIndexTaskCounter.WithLabelValues(metrics.UnissuedIndexTaskLabel).Dec()
+		} else {
+			metrics.IndexCoordIndexTaskCounter.WithLabelValues(metrics.InProgressIndexTaskLabel).Dec()
+			nodeIDs = append(nodeIDs, meta.indexMeta.NodeID)
+		}
+		metrics.IndexCoordIndexTaskCounter.WithLabelValues(metrics.FailedIndexTaskLabel).Inc()
+		canceled = append(canceled, buildID)
+	}
+	log.Info("IndexCoord metaTable CancelIndexBuilds", zap.Int64("collectionID", collectionID),
+		zap.String("indexName", indexName), zap.Int64("indexBuildID", indexBuildID), zap.Int64s("canceled", canceled))
+	return canceled, nodeIDs, nil
+}
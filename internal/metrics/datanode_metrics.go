@@ -64,6 +64,30 @@ var (
 			nodeIDLabelName,
 		})
 
+	DataNodeConsumerMsgLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "consumer_msg_lag",
+			Help:      "number of messages not consumed yet per subscription, -1 if unknown to the mq",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+			subscriptionLabelName,
+		})
+
+	DataNodeConsumerTimeLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "consumer_time_lag",
+			Help:      "seconds elapsed since the timestamp of the last message consumed per subscription",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+			subscriptionLabelName,
+		})
+
 	DataNodeNumProducers = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -170,6 +194,8 @@ func RegisterDataNode(registry *prometheus.Registry) {
 	registry.MustRegister(DataNodeConsumeMsgRowsCount)
 	registry.MustRegister(DataNodeFlushedSize)
 	registry.MustRegister(DataNodeNumConsumers)
+	registry.MustRegister(DataNodeConsumerMsgLag)
+	registry.MustRegister(DataNodeConsumerTimeLag)
 	registry.MustRegister(DataNodeNumProducers)
 	registry.MustRegister(DataNodeTimeSync)
 	registry.MustRegister(DataNodeNumUnflushedSegments)
@@ -44,12 +44,19 @@ const (
 
 	InsertLabel = "insert"
 	DeleteLabel = "delete"
+	UpsertLabel = "upsert"
 	SearchLabel = "search"
 	QueryLabel  = "query"
 
 	CacheHitLabel  = "hit"
 	CacheMissLabel = "miss"
 
+	RequestLabel  = "request"
+	ResponseLabel = "response"
+
+	GCModeServingLabel = "serving"
+	GCModeLoadingLabel = "loading"
+
 	UnissuedIndexTaskLabel   = "unissued"
 	InProgressIndexTaskLabel = "in-progress"
 	FinishedIndexTaskLabel   = "finished"
@@ -74,12 +81,18 @@ const (
 	usernameLabelName        = "username"
 	cacheNameLabelName       = "cache_name"
 	cacheStateLabelName      = "cache_state"
+	directionLabelName       = "direction"
+	gcModeLabelName          = "gc_mode"
+	subscriptionLabelName    = "subscription"
 )
 
 var (
 	// buckets involves durations in milliseconds,
 	// [1 2 4 8 16 32 64 128 256 512 1024 2048 4096 8192 16384 32768 65536 1.31072e+05]
 	buckets = prometheus.ExponentialBuckets(1, 2, 18)
+
+	// sizeBuckets involves message sizes in bytes, from 256B to 1GB
+	sizeBuckets = prometheus.ExponentialBuckets(256, 4, 12)
 )
 
 //ServeHTTP serves prometheus http service
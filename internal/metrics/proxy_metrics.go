@@ -223,6 +223,35 @@ var (
 			Help:      "latency of each DQL request excluding search and query",
 			Buckets:   buckets, // unit: ms
 		}, []string{nodeIDLabelName, functionLabelName})
+
+	// ProxyRequestSize records the size of each grpc request received by proxy.
+	ProxyRequestSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "req_size",
+			Help:      "size of each grpc request",
+			Buckets:   sizeBuckets, // unit: byte
+		}, []string{nodeIDLabelName, functionLabelName})
+
+	// ProxyResponseSize records the size of each grpc response sent by proxy.
+	ProxyResponseSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "resp_size",
+			Help:      "size of each grpc response",
+			Buckets:   sizeBuckets, // unit: byte
+		}, []string{nodeIDLabelName, functionLabelName})
+
+	// ProxyNearGrpcLimitCount records the number of messages whose size is close to the grpc message size limit.
+	ProxyNearGrpcLimitCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "near_grpc_limit_count",
+			Help:      "count of messages close to the grpc message size limit",
+		}, []string{nodeIDLabelName, functionLabelName, directionLabelName})
 )
 
 //RegisterProxy registers Proxy metrics
@@ -255,4 +284,8 @@ func RegisterProxy(registry *prometheus.Registry) {
 	registry.MustRegister(ProxyDMLReqLatency)
 	registry.MustRegister(ProxyDQLReqLatency)
 
+	registry.MustRegister(ProxyRequestSize)
+	registry.MustRegister(ProxyResponseSize)
+	registry.MustRegister(ProxyNearGrpcLimitCount)
+
 }
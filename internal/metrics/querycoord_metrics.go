@@ -109,6 +109,14 @@ var (
 			Name:      "querynode_num",
 			Help:      "number of QueryNodes managered by QueryCoord",
 		}, []string{})
+
+	QueryCoordNumPendingHandoffs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "pending_handoff_num",
+			Help:      "number of indexed segments waiting to be handed off",
+		}, []string{})
 )
 
 //RegisterQueryCoord registers QueryCoord metrics
@@ -123,4 +131,5 @@ func RegisterQueryCoord(registry *prometheus.Registry) {
 	registry.MustRegister(QueryCoordNumParentTasks)
 	registry.MustRegister(QueryCoordChildTaskLatency)
 	registry.MustRegister(QueryCoordNumQueryNodes)
+	registry.MustRegister(QueryCoordNumPendingHandoffs)
 }
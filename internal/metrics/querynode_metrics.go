@@ -53,6 +53,47 @@ var (
 			nodeIDLabelName,
 		})
 
+	QueryNodeNumSwappedOutSegments = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "swapped_out_segment_num",
+			Help:      "number of sealed segments swapped out to local disk",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeGCMode = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "gc_mode",
+			Help:      "current gc tuning mode, 1 for the active mode",
+		}, []string{
+			nodeIDLabelName,
+			gcModeLabelName,
+		})
+
+	QueryNodeGOGC = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "gogc",
+			Help:      "current GOGC set by gc tuner",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeGOMEMLIMIT = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "gomemlimit",
+			Help:      "current GOMEMLIMIT in bytes set by gc tuner, 0 if not managed",
+		}, []string{
+			nodeIDLabelName,
+		})
+
 	QueryNodeNumDmlChannels = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -83,6 +124,30 @@ var (
 			nodeIDLabelName,
 		})
 
+	QueryNodeConsumerMsgLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "consumer_msg_lag",
+			Help:      "number of messages not consumed yet per subscription, -1 if unknown to the mq",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+			subscriptionLabelName,
+		})
+
+	QueryNodeConsumerTimeLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "consumer_time_lag",
+			Help:      "seconds elapsed since the timestamp of the last message consumed per subscription",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+			subscriptionLabelName,
+		})
+
 	QueryNodeSQCount = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: milvusNamespace,
@@ -156,6 +221,73 @@ var (
 			queryTypeLabelName,
 		})
 
+	QueryNodeShardSQCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "shard_sq_req_count",
+			Help:      "count of search / query request per shard",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+			channelNameLabelName,
+			queryTypeLabelName,
+			statusLabelName,
+		})
+
+	QueryNodeShardSQLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "shard_sq_latency",
+			Help:      "latency of search or query per shard",
+			Buckets:   buckets,
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+			channelNameLabelName,
+			queryTypeLabelName,
+		})
+
+	QueryNodeShardReduceLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "shard_reduce_latency",
+			Help:      "latency of reduce search result per shard",
+			Buckets:   buckets,
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+			channelNameLabelName,
+			queryTypeLabelName,
+		})
+
+	QueryNodeShardScannedRows = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "shard_scanned_rows",
+			Help:      "number of rows of the segments scanned by search or query per shard",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+			channelNameLabelName,
+			queryTypeLabelName,
+		})
+
+	QueryNodeShardCacheHitRatio = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "shard_cache_hit_ratio",
+			Help:      "hit ratio of the local vector cache per shard",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+			channelNameLabelName,
+		})
+
 	QueryNodeLoadSegmentLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: milvusNamespace,
@@ -195,15 +327,26 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeNumCollections)
 	registry.MustRegister(QueryNodeNumPartitions)
 	registry.MustRegister(QueryNodeNumSegments)
+	registry.MustRegister(QueryNodeNumSwappedOutSegments)
+	registry.MustRegister(QueryNodeGCMode)
+	registry.MustRegister(QueryNodeGOGC)
+	registry.MustRegister(QueryNodeGOMEMLIMIT)
 	registry.MustRegister(QueryNodeNumDmlChannels)
 	registry.MustRegister(QueryNodeNumDeltaChannels)
 	registry.MustRegister(QueryNodeNumConsumers)
+	registry.MustRegister(QueryNodeConsumerMsgLag)
+	registry.MustRegister(QueryNodeConsumerTimeLag)
 	registry.MustRegister(QueryNodeSQCount)
 	registry.MustRegister(QueryNodeSQReqLatency)
 	registry.MustRegister(QueryNodeSQLatencyInQueue)
 	registry.MustRegister(QueryNodeSQSegmentLatency)
 	registry.MustRegister(QueryNodeSQSegmentLatencyInCore)
 	registry.MustRegister(QueryNodeReduceLatency)
+	registry.MustRegister(QueryNodeShardSQCount)
+	registry.MustRegister(QueryNodeShardSQLatency)
+	registry.MustRegister(QueryNodeShardReduceLatency)
+	registry.MustRegister(QueryNodeShardScannedRows)
+	registry.MustRegister(QueryNodeShardCacheHitRatio)
 	registry.MustRegister(QueryNodeLoadSegmentLatency)
 	//	registry.MustRegister(QueryNodeServiceTime)
 	registry.MustRegister(QueryNodeNumFlowGraphs)
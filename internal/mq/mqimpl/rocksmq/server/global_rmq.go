@@ -19,13 +19,16 @@ package server
 import (
 	"errors"
 	"os"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"go.uber.org/zap"
 )
@@ -94,13 +97,67 @@ func InitRocksMQ(path string) error {
 				log.Warn("rocksmq.retentionSizeInMB is invalid, using default value 0")
 			}
 		}
+		TopicRetentionConfigs = loadTopicRetentionConfigs(&params)
 		log.Debug("", zap.Any("RocksmqRetentionTimeInMinutes", rawRmqRetentionTimeInMinutes),
-			zap.Any("RocksmqRetentionSizeInMB", RocksmqRetentionSizeInMB), zap.Any("RocksmqPageSize", RocksmqPageSize))
+			zap.Any("RocksmqRetentionSizeInMB", RocksmqRetentionSizeInMB), zap.Any("RocksmqPageSize", RocksmqPageSize),
+			zap.Any("TopicRetentionConfigs", TopicRetentionConfigs))
 		Rmq, finalErr = NewRocksMQ(path, nil)
 	})
 	return finalErr
 }
 
+// topicRetentionConfigPrefix is the prefix of the retention policies configured by topic name prefix
+const topicRetentionConfigPrefix = "rocksmq.topicRetention."
+
+// loadTopicRetentionConfigs loads rocksmq.topicRetention.<topic prefix>.retentionTimeInMinutes and
+// rocksmq.topicRetention.<topic prefix>.retentionSizeInMB, the invalid ones are ignored
+func loadTopicRetentionConfigs(base *paramtable.BaseTable) []TopicRetentionConfig {
+	configPrefix := strings.ToLower(topicRetentionConfigPrefix)
+	keys, values, err := base.LoadRange(configPrefix, typeutil.AddOne(configPrefix), 0)
+	if err != nil {
+		log.Warn("failed to load rocksmq topic retention configs", zap.Error(err))
+		return nil
+	}
+
+	configs := make(map[string]*TopicRetentionConfig)
+	for i, key := range keys {
+		suffix := key[len(configPrefix):]
+		idx := strings.LastIndex(suffix, ".")
+		if idx <= 0 {
+			log.Warn("rocksmq topic retention config is invalid", zap.String("key", key))
+			continue
+		}
+		topicPrefix, field := suffix[:idx], suffix[idx+1:]
+		value, err := strconv.ParseInt(values[i], 10, 64)
+		if err != nil {
+			log.Warn("rocksmq topic retention config is invalid", zap.String("key", key), zap.String("value", values[i]))
+			continue
+		}
+		config, ok := configs[topicPrefix]
+		if !ok {
+			config = &TopicRetentionConfig{TopicPrefix: topicPrefix}
+			configs[topicPrefix] = config
+		}
+		switch field {
+		case "retentiontimeinminutes":
+			config.TimeInMinutes = value
+		case "retentionsizeinmb":
+			config.SizeInMB = value
+		default:
+			log.Warn("unknown rocksmq topic retention config", zap.String("key", key))
+		}
+	}
+
+	ret := make([]TopicRetentionConfig, 0, len(configs))
+	for _, config := range configs {
+		ret = append(ret, *config)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].TopicPrefix < ret[j].TopicPrefix
+	})
+	return ret
+}
+
 // CloseRocksMQ is used to close global rocksmq
 func CloseRocksMQ() {
 	log.Debug("Close Rocksmq!")
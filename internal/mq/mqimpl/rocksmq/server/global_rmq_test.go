@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
 func Test_InitRmq(t *testing.T) {
@@ -75,6 +76,23 @@ func Test_InitRocksMQ(t *testing.T) {
 	Rmq.RegisterConsumer(consumer)
 }
 
+func Test_loadTopicRetentionConfigs(t *testing.T) {
+	var base paramtable.BaseTable
+	base.Init()
+	assert.Empty(t, loadTopicRetentionConfigs(&base))
+
+	base.Save("rocksmq.topicRetention.by-dev-rootcoord-dml.retentionTimeInMinutes", "1440")
+	base.Save("rocksmq.topicRetention.by-dev-rootcoord-dml.retentionSizeInMB", "4096")
+	base.Save("rocksmq.topicRetention.by-dev-datacoord.retentionSizeInMB", "-1")
+	base.Save("rocksmq.topicRetention.by-dev-querycoord.retentionSizeInMB", "abc")
+	base.Save("rocksmq.topicRetention.invalid", "1")
+	configs := loadTopicRetentionConfigs(&base)
+	assert.Equal(t, []TopicRetentionConfig{
+		{TopicPrefix: "by-dev-datacoord", TopicRetention: TopicRetention{SizeInMB: -1}},
+		{TopicPrefix: "by-dev-rootcoord-dml", TopicRetention: TopicRetention{TimeInMinutes: 1440, SizeInMB: 4096}},
+	}, configs)
+}
+
 func Test_InitRocksMQError(t *testing.T) {
 	once = sync.Once{}
 	dir := "/tmp/milvus/"
@@ -41,6 +41,10 @@ type RocksMQ interface {
 	DestroyConsumerGroup(topicName string, groupName string) error
 	Close()
 
+	SetTopicRetention(topicName string, retention TopicRetention) error
+	GetTopicRetention(topicName string) (TopicRetention, error)
+	CompactTopic(topicName string) error
+
 	RegisterConsumer(consumer *Consumer) error
 	GetLatestMsg(topicName string) (int64, error)
 
@@ -13,6 +13,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
@@ -71,6 +72,9 @@ const (
 	// acked_ts/topicName/pageId, record the latest ack ts of each page, will be purged on retention or destroy of the topic
 	AckedTsTitle = "acked_ts/"
 
+	// topic_retention/topicName, record the retention policy set by SetTopicRetention, cleaned up on destroy of the topic
+	TopicRetentionTitle = "topic_retention/"
+
 	// only in memory
 	CurrentIDSuffix = "current_id"
 
@@ -109,10 +113,6 @@ func parsePageID(key string) (int64, error) {
 	return strconv.ParseInt(stringSlice[2], 10, 64)
 }
 
-func checkRetention() bool {
-	return RocksmqRetentionTimeInSecs != -1 || RocksmqRetentionSizeInMB != -1
-}
-
 func getNowTs(idAllocator allocator.GIDAllocator) (int64, error) {
 	err := idAllocator.UpdateID()
 	if err != nil {
@@ -217,9 +217,8 @@ func NewRocksMQ(name string, idAllocator allocator.GIDAllocator) (*rocksmq, erro
 	}
 	rmq.retentionInfo = ri
 
-	if checkRetention() {
-		rmq.retentionInfo.startRetentionInfo()
-	}
+	// the retention may be enabled for some topics even if it's disabled globally
+	rmq.retentionInfo.startRetentionInfo()
 	atomic.StoreInt64(&rmq.state, RmqStateHealthy)
 	// TODO add this to monitor metrics
 	go func() {
@@ -378,8 +377,10 @@ func (rmq *rocksmq) DestroyTopic(topicName string) error {
 	topicIDKey := TopicIDTitle + topicName
 	// message size of this topic
 	msgSizeKey := MessageSizeTitle + topicName
+	// retention policy of this topic
+	topicRetentionKey := TopicRetentionTitle + topicName
 	var removedKeys []string
-	removedKeys = append(removedKeys, topicIDKey, msgSizeKey)
+	removedKeys = append(removedKeys, topicIDKey, msgSizeKey, topicRetentionKey)
 	// Batch remove, atomic operation
 	err = rmq.kv.MultiRemove(removedKeys)
 	if err != nil {
@@ -389,6 +390,7 @@ func (rmq *rocksmq) DestroyTopic(topicName string) error {
 	// clean up retention info
 	topicMu.Delete(topicName)
 	rmq.retentionInfo.topicRetetionTime.Delete(topicName)
+	rmq.retentionInfo.topicRetentions.Delete(topicName)
 
 	// clean up reader
 	if val, ok := rmq.readers.LoadAndDelete(topicName); ok {
@@ -400,6 +402,68 @@ func (rmq *rocksmq) DestroyTopic(topicName string) error {
 	return nil
 }
 
+// SetTopicRetention overrides the retention policy of the topic, the policy is persisted and survives restarts
+func (rmq *rocksmq) SetTopicRetention(topicName string, retention TopicRetention) error {
+	if rmq.isClosed() {
+		return errors.New(RmqNotServingErrMsg)
+	}
+	if _, ok := topicMu.Load(topicName); !ok {
+		return fmt.Errorf("topic name = %s not exist", topicName)
+	}
+	value, err := json.Marshal(retention)
+	if err != nil {
+		return err
+	}
+	if err := rmq.kv.Save(TopicRetentionTitle+topicName, string(value)); err != nil {
+		return err
+	}
+	rmq.retentionInfo.topicRetentions.Store(topicName, retention)
+	log.Debug("Rocksmq set topic retention successfully", zap.String("topic", topicName),
+		zap.Int64("timeInMinutes", retention.TimeInMinutes), zap.Int64("sizeInMB", retention.SizeInMB))
+	return nil
+}
+
+// GetTopicRetention returns the retention policy taking effect on the topic, the retention time or size is -1 if disabled
+func (rmq *rocksmq) GetTopicRetention(topicName string) (TopicRetention, error) {
+	if _, ok := topicMu.Load(topicName); !ok {
+		return TopicRetention{}, fmt.Errorf("topic name = %s not exist", topicName)
+	}
+	timeInSecs, sizeInMB := rmq.retentionInfo.getRetentionLimits(topicName)
+	retention := TopicRetention{TimeInMinutes: -1, SizeInMB: -1}
+	if timeInSecs >= 0 {
+		retention.TimeInMinutes = timeInSecs / 60
+	}
+	if sizeInMB >= 0 {
+		retention.SizeInMB = sizeInMB
+	}
+	return retention, nil
+}
+
+// CompactTopic cleans up the expired messages of the topic right now instead of waiting for the retention ticker,
+// and compacts the messages range of the topic in rocksdb to reclaim the disk space
+func (rmq *rocksmq) CompactTopic(topicName string) error {
+	if rmq.isClosed() {
+		return errors.New(RmqNotServingErrMsg)
+	}
+	if _, ok := topicMu.Load(topicName); !ok {
+		return fmt.Errorf("topic name = %s not exist", topicName)
+	}
+	start := time.Now()
+
+	rmq.retentionInfo.mutex.RLock()
+	err := rmq.retentionInfo.expiredCleanUp(topicName)
+	rmq.retentionInfo.mutex.RUnlock()
+	if err != nil {
+		return err
+	}
+	rmq.retentionInfo.topicRetetionTime.Store(topicName, time.Now().Unix())
+
+	topicPrefix := topicName + "/"
+	rmq.store.CompactRange(gorocksdb.Range{Start: []byte(topicPrefix), Limit: []byte(typeutil.AddOne(topicPrefix))})
+	log.Debug("Rocksmq compact topic successfully", zap.String("topic", topicName), zap.Int64("elapsed", time.Since(start).Milliseconds()))
+	return nil
+}
+
 // ExistConsumerGroup check if a consumer exists and return the existed consumer
 func (rmq *rocksmq) ExistConsumerGroup(topicName, groupName string) (bool, *Consumer, error) {
 	key := constructCurrentID(topicName, groupName)
@@ -470,6 +534,22 @@ func (rmq *rocksmq) GetLatestMsg(topicName string) (int64, error) {
 	return msgID, nil
 }
 
+// ListTopics returns the names of all the topics in rocksmq
+func (rmq *rocksmq) ListTopics() ([]string, error) {
+	if rmq.isClosed() {
+		return nil, errors.New(RmqNotServingErrMsg)
+	}
+	keys, _, err := rmq.kv.LoadWithPrefix(TopicIDTitle)
+	if err != nil {
+		return nil, err
+	}
+	topics := make([]string, 0, len(keys))
+	for _, key := range keys {
+		topics = append(topics, strings.TrimPrefix(key, TopicIDTitle))
+	}
+	return topics, nil
+}
+
 // DestroyConsumerGroup removes a consumer group from rocksdb_kv
 func (rmq *rocksmq) DestroyConsumerGroup(topicName, groupName string) error {
 	if rmq.isClosed() {
@@ -914,12 +914,19 @@ func TestRocksmq_GetLatestMsg(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, msgID, ids[loopNum-1])
 
+	topics, err := rmq.ListTopics()
+	assert.Nil(t, err)
+	assert.Contains(t, topics, channelName)
+	assert.Contains(t, topics, channelName2)
+
 	// test close rmq
 	rmq.DestroyTopic(channelName)
 	rmq.Close()
 	msgID, err = rmq.GetLatestMsg(channelName)
 	assert.Equal(t, msgID, int64(DefaultMessageID))
 	assert.NotNil(t, err)
+	_, err = rmq.ListTopics()
+	assert.NotNil(t, err)
 }
 
 func TestRocksmq_Close(t *testing.T) {
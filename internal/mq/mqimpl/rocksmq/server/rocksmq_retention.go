@@ -12,9 +12,11 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
 	"path"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -40,10 +42,28 @@ const (
 // TickerTimeInSeconds is the time of expired check, default 10 minutes
 var TickerTimeInSeconds int64 = 60
 
+// TopicRetention is the retention policy of a topic, a negative value disables the retention by time or size,
+// and zero falls back to the global retention time or size
+type TopicRetention struct {
+	TimeInMinutes int64 `json:"time_in_minutes"`
+	SizeInMB      int64 `json:"size_in_mb"`
+}
+
+// TopicRetentionConfig is the retention policy of the topics with the name prefix
+type TopicRetentionConfig struct {
+	TopicPrefix string
+	TopicRetention
+}
+
+// TopicRetentionConfigs is the retention policies by topic name prefix, the longest matched prefix takes effect
+var TopicRetentionConfigs []TopicRetentionConfig
+
 type retentionInfo struct {
 	// key is topic name, value is last retention time
 	topicRetetionTime sync.Map
-	mutex             sync.RWMutex
+	// key is topic name, value is the TopicRetention set by SetTopicRetention
+	topicRetentions sync.Map
+	mutex           sync.RWMutex
 
 	kv *rocksdbkv.RocksdbKV
 	db *gorocksdb.DB
@@ -72,9 +92,51 @@ func initRetentionInfo(kv *rocksdbkv.RocksdbKV, db *gorocksdb.DB) (*retentionInf
 		ri.topicRetetionTime.Store(topic, time.Now().Unix())
 		topicMu.Store(topic, new(sync.Mutex))
 	}
+	// Get the retention policies set by SetTopicRetention
+	retentionKeys, retentionValues, err := ri.kv.LoadWithPrefix(TopicRetentionTitle)
+	if err != nil {
+		return nil, err
+	}
+	for i, key := range retentionKeys {
+		var retention TopicRetention
+		if err := json.Unmarshal([]byte(retentionValues[i]), &retention); err != nil {
+			return nil, err
+		}
+		ri.topicRetentions.Store(key[len(TopicRetentionTitle):], retention)
+	}
 	return ri, nil
 }
 
+// getRetentionLimits returns the retention time in seconds and size in MB taking effect on the topic,
+// the policy set by SetTopicRetention overrides the one configured by topic prefix, which overrides the global one
+func (ri *retentionInfo) getRetentionLimits(topic string) (int64, int64) {
+	timeInSecs := atomic.LoadInt64(&RocksmqRetentionTimeInSecs)
+	sizeInMB := atomic.LoadInt64(&RocksmqRetentionSizeInMB)
+
+	var retention TopicRetention
+	if val, ok := ri.topicRetentions.Load(topic); ok {
+		retention = val.(TopicRetention)
+	} else {
+		matched := -1
+		for _, config := range TopicRetentionConfigs {
+			if len(config.TopicPrefix) > matched && strings.HasPrefix(strings.ToLower(topic), config.TopicPrefix) {
+				retention = config.TopicRetention
+				matched = len(config.TopicPrefix)
+			}
+		}
+	}
+
+	if retention.TimeInMinutes < 0 {
+		timeInSecs = -1
+	} else if retention.TimeInMinutes > 0 {
+		timeInSecs = retention.TimeInMinutes * 60
+	}
+	if retention.SizeInMB != 0 {
+		sizeInMB = retention.SizeInMB
+	}
+	return timeInSecs, sizeInMB
+}
+
 // Before do retention, load retention info from rocksdb to retention info structure in goroutines.
 // Because loadRetentionInfo may need some time, so do this asynchronously. Finally start retention goroutine.
 func (ri *retentionInfo) startRetentionInfo() {
@@ -97,7 +159,6 @@ func (ri *retentionInfo) retention() error {
 			return nil
 		case t := <-ticker.C:
 			timeNow := t.Unix()
-			checkTime := atomic.LoadInt64(&RocksmqRetentionTimeInSecs) / 10
 			ri.mutex.RLock()
 			ri.topicRetetionTime.Range(func(k, v interface{}) bool {
 				topic, _ := k.(string)
@@ -106,6 +167,11 @@ func (ri *retentionInfo) retention() error {
 					log.Warn("Can't parse lastRetention to int64", zap.String("topic", topic), zap.Any("value", v))
 					return true
 				}
+				timeInSecs, sizeInMB := ri.getRetentionLimits(topic)
+				if timeInSecs < 0 && sizeInMB < 0 {
+					return true
+				}
+				checkTime := timeInSecs / 10
 				if lastRetentionTs+checkTime < timeNow {
 					err := ri.expiredCleanUp(topic)
 					if err != nil {
@@ -140,6 +206,7 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 	var pageEndID UniqueID
 	var err error
 
+	retentionTimeInSecs, retentionSizeInMB := ri.getRetentionLimits(topic)
 	fixedAckedTsKey := constructKey(AckedTsTitle, topic)
 	// calculate total acked size, simply add all page info
 	totalAckedSize, err := ri.calculateTopicAckedSize(topic)
@@ -181,7 +248,7 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 		if err != nil {
 			return err
 		}
-		if msgTimeExpiredCheck(ackedTs) {
+		if msgTimeExpiredCheck(ackedTs, retentionTimeInSecs) {
 			pageEndID = pageID
 			pValue := pageIter.Value()
 			size, err := strconv.ParseInt(string(pValue.Data()), 10, 64)
@@ -220,7 +287,7 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 			return err
 		}
 		curDeleteSize := deletedAckedSize + size
-		if msgSizeExpiredCheck(curDeleteSize, totalAckedSize) {
+		if msgSizeExpiredCheck(curDeleteSize, totalAckedSize, retentionSizeInMB) {
 			pageEndID, err = parsePageID(pKeyStr)
 			if err != nil {
 				return err
@@ -359,16 +426,16 @@ func DeleteMessages(db *gorocksdb.DB, topic string, startID, endID UniqueID) err
 	return nil
 }
 
-func msgTimeExpiredCheck(ackedTs int64) bool {
-	if RocksmqRetentionTimeInSecs < 0 {
+func msgTimeExpiredCheck(ackedTs, retentionTimeInSecs int64) bool {
+	if retentionTimeInSecs < 0 {
 		return false
 	}
-	return ackedTs+atomic.LoadInt64(&RocksmqRetentionTimeInSecs) < time.Now().Unix()
+	return ackedTs+retentionTimeInSecs < time.Now().Unix()
 }
 
-func msgSizeExpiredCheck(deletedAckedSize, ackedSize int64) bool {
-	if RocksmqRetentionSizeInMB < 0 {
+func msgSizeExpiredCheck(deletedAckedSize, ackedSize, retentionSizeInMB int64) bool {
+	if retentionSizeInMB < 0 {
 		return false
 	}
-	return ackedSize-deletedAckedSize > atomic.LoadInt64(&RocksmqRetentionSizeInMB)*MB
+	return ackedSize-deletedAckedSize > retentionSizeInMB*MB
 }
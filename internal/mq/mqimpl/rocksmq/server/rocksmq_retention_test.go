@@ -634,3 +634,116 @@ func TestRmqRetention_PageSizeExpire(t *testing.T) {
 	// make sure clean up happens
 	assert.True(t, newRes[0].MsgID > ids[0])
 }
+
+func TestRmqRetention_TopicRetention(t *testing.T) {
+	err := os.MkdirAll(retentionPath, os.ModePerm)
+	assert.NoError(t, err)
+	defer os.RemoveAll(retentionPath)
+	atomic.StoreInt64(&RocksmqRetentionSizeInMB, 8192)
+	atomic.StoreInt64(&RocksmqRetentionTimeInSecs, 10080*60)
+	TopicRetentionConfigs = []TopicRetentionConfig{
+		{TopicPrefix: "topic", TopicRetention: TopicRetention{TimeInMinutes: 60}},
+		{TopicPrefix: "topic_b", TopicRetention: TopicRetention{SizeInMB: -1}},
+	}
+	defer func() { TopicRetentionConfigs = nil }()
+
+	rocksdbPath := retentionPath + "db_topic_retention"
+	rmq, err := NewRocksMQ(rocksdbPath, nil)
+	assert.NoError(t, err)
+
+	_, err = rmq.GetTopicRetention("topic_a")
+	assert.Error(t, err)
+	assert.Error(t, rmq.SetTopicRetention("topic_a", TopicRetention{}))
+
+	assert.NoError(t, rmq.CreateTopic("topic_a"))
+	assert.NoError(t, rmq.CreateTopic("topic_b"))
+	assert.NoError(t, rmq.CreateTopic("other"))
+
+	retention, err := rmq.GetTopicRetention("other")
+	assert.NoError(t, err)
+	assert.Equal(t, TopicRetention{TimeInMinutes: 10080, SizeInMB: 8192}, retention)
+	retention, err = rmq.GetTopicRetention("topic_a")
+	assert.NoError(t, err)
+	assert.Equal(t, TopicRetention{TimeInMinutes: 60, SizeInMB: 8192}, retention)
+	// the longest matched prefix takes effect
+	retention, err = rmq.GetTopicRetention("topic_b")
+	assert.NoError(t, err)
+	assert.Equal(t, TopicRetention{TimeInMinutes: 10080, SizeInMB: -1}, retention)
+
+	assert.NoError(t, rmq.SetTopicRetention("topic_a", TopicRetention{TimeInMinutes: -1, SizeInMB: 1024}))
+	retention, err = rmq.GetTopicRetention("topic_a")
+	assert.NoError(t, err)
+	assert.Equal(t, TopicRetention{TimeInMinutes: -1, SizeInMB: 1024}, retention)
+	rmq.Close()
+
+	// the policy set is persisted
+	rmq, err = NewRocksMQ(rocksdbPath, nil)
+	assert.NoError(t, err)
+	defer rmq.Close()
+	retention, err = rmq.GetTopicRetention("topic_a")
+	assert.NoError(t, err)
+	assert.Equal(t, TopicRetention{TimeInMinutes: -1, SizeInMB: 1024}, retention)
+
+	assert.NoError(t, rmq.DestroyTopic("topic_a"))
+	assert.NoError(t, rmq.CreateTopic("topic_a"))
+	retention, err = rmq.GetTopicRetention("topic_a")
+	assert.NoError(t, err)
+	assert.Equal(t, TopicRetention{TimeInMinutes: 60, SizeInMB: 8192}, retention)
+}
+
+func TestRmqRetention_CompactTopic(t *testing.T) {
+	err := os.MkdirAll(retentionPath, os.ModePerm)
+	assert.NoError(t, err)
+	defer os.RemoveAll(retentionPath)
+	// the retention is disabled globally, and the ticker won't fire during the test
+	atomic.StoreInt64(&RocksmqRetentionSizeInMB, -1)
+	atomic.StoreInt64(&RocksmqRetentionTimeInSecs, -1)
+	atomic.StoreInt64(&RocksmqPageSize, 10)
+	atomic.StoreInt64(&TickerTimeInSeconds, 60)
+
+	rocksdbPath := retentionPath + "db_compact"
+	rmq, err := NewRocksMQ(rocksdbPath, nil)
+	assert.NoError(t, err)
+	defer rmq.Close()
+
+	topicName := "topic_a"
+	assert.Error(t, rmq.CompactTopic(topicName))
+	err = rmq.CreateTopic(topicName)
+	assert.NoError(t, err)
+	defer rmq.DestroyTopic(topicName)
+
+	// need to be larger than 1M
+	msgNum := 100000
+	pMsgs := make([]ProducerMessage, msgNum)
+	for i := 0; i < msgNum; i++ {
+		pMsgs[i] = ProducerMessage{Payload: []byte("message_" + strconv.Itoa(i))}
+	}
+	ids, err := rmq.Produce(topicName, pMsgs)
+	assert.NoError(t, err)
+
+	groupName := "test_group"
+	err = rmq.CreateConsumerGroup(topicName, groupName)
+	assert.NoError(t, err)
+	rmq.RegisterConsumer(&Consumer{Topic: topicName, GroupName: groupName})
+	for i := 0; i < msgNum; i++ {
+		_, err := rmq.Consume(topicName, groupName, 1)
+		assert.NoError(t, err)
+	}
+
+	// nothing is cleaned up by the global policy
+	assert.NoError(t, rmq.CompactTopic(topicName))
+	err = rmq.Seek(topicName, groupName, ids[0])
+	assert.NoError(t, err)
+	res, err := rmq.Consume(topicName, groupName, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, ids[0], res[0].MsgID)
+
+	assert.NoError(t, rmq.SetTopicRetention(topicName, TopicRetention{TimeInMinutes: -1, SizeInMB: 1}))
+	assert.NoError(t, rmq.CompactTopic(topicName))
+	err = rmq.Seek(topicName, groupName, ids[0])
+	assert.NoError(t, err)
+	res, err = rmq.Consume(topicName, groupName, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res))
+	assert.True(t, res[0].MsgID > ids[0])
+}
@@ -0,0 +1,42 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/compressor"
+)
+
+// compressPayload compresses the payload of the insert and delete messages, which carry the bulk of the
+// entities, the other messages and the empty compress type leave the payload as it is.
+// The compressed payloads are told from the plain ones by the magic number of the compressed frame,
+// which never collides with the leading tag of the message header, so that the consumers could
+// decompress them transparently whatever the producers are configured with.
+func compressPayload(typ compressor.CompressType, msgType commonpb.MsgType, payload []byte) ([]byte, error) {
+	if typ == "" || (msgType != commonpb.MsgType_Insert && msgType != commonpb.MsgType_Delete) {
+		return payload, nil
+	}
+	return compressor.CompressBytes(typ, payload, nil)
+}
+
+// decompressPayload returns the decompressed payload if it is compressed, otherwise the payload itself
+func decompressPayload(payload []byte) ([]byte, error) {
+	if _, ok := compressor.DetectCompressType(payload); !ok {
+		return payload, nil
+	}
+	return compressor.DecompressBytes(payload, nil)
+}
@@ -0,0 +1,61 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/compressor"
+)
+
+func TestPayloadCompression(t *testing.T) {
+	insertMsg := getTsMsg(commonpb.MsgType_Insert, 1)
+	mb, err := insertMsg.Marshal(insertMsg)
+	assert.NoError(t, err)
+	payload, err := convertToByteArray(mb)
+	assert.NoError(t, err)
+
+	for _, typ := range []compressor.CompressType{compressor.CompressTypeZstd, compressor.CompressTypeLz4} {
+		compressed, err := compressPayload(typ, commonpb.MsgType_Insert, payload)
+		assert.NoError(t, err)
+		detected, ok := compressor.DetectCompressType(compressed)
+		assert.True(t, ok)
+		assert.Equal(t, typ, detected)
+
+		decompressed, err := decompressPayload(compressed)
+		assert.NoError(t, err)
+		assert.Equal(t, payload, decompressed)
+	}
+
+	// the other messages and the empty compress type are left as they are
+	compressed, err := compressPayload(compressor.CompressTypeZstd, commonpb.MsgType_TimeTick, payload)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, compressed)
+	compressed, err = compressPayload("", commonpb.MsgType_Insert, payload)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, compressed)
+
+	decompressed, err := decompressPayload(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, decompressed)
+
+	_, err = compressPayload("snappy", commonpb.MsgType_Delete, payload)
+	assert.Error(t, err)
+}
@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// ConsumerLag is how far a consumer of a msgstream falls behind its channel
+type ConsumerLag struct {
+	Channel      string
+	Subscription string
+	// MsgLag is the number of the messages not consumed yet, -1 if the message ids of the mq can't tell it
+	MsgLag int64
+	// TimeLag is the time elapsed since the timestamp of the last message consumed, which grows as well
+	// when the time ticks stop arriving
+	TimeLag time.Duration
+}
+
+// ConsumerLagReporter is implemented by the msgstreams which report the lags of their consumers
+type ConsumerLagReporter interface {
+	// GetConsumerLags returns the lags of the consumers which have consumed any message
+	GetConsumerLags() []*ConsumerLag
+}
+
+// consumedMsg is the last message consumed by a consumer
+type consumedMsg struct {
+	id MessageID
+	ts Timestamp
+}
+
+var _ ConsumerLagReporter = (*mqMsgStream)(nil)
+
+// recordConsumed records the last message consumed by the consumer for the lag
+func (ms *mqMsgStream) recordConsumed(consumer mqwrapper.Consumer, id MessageID, ts Timestamp) {
+	ms.consumedLock.Lock()
+	defer ms.consumedLock.Unlock()
+	ms.consumed[consumer] = consumedMsg{id: id, ts: ts}
+}
+
+// GetConsumerLags returns the lags of the consumers which have consumed any message, the message lags are
+// only known on the mqs numbering the messages one by one, such as kafka and nats.
+func (ms *mqMsgStream) GetConsumerLags() []*ConsumerLag {
+	ms.consumerLock.Lock()
+	consumers := make(map[string]mqwrapper.Consumer, len(ms.consumers))
+	for channel, consumer := range ms.consumers {
+		consumers[channel] = consumer
+	}
+	ms.consumerLock.Unlock()
+
+	now := time.Now()
+	lags := make([]*ConsumerLag, 0, len(consumers))
+	for channel, consumer := range consumers {
+		ms.consumedLock.Lock()
+		consumed, ok := ms.consumed[consumer]
+		ms.consumedLock.Unlock()
+		if !ok {
+			continue
+		}
+
+		physicalTime, _ := tsoutil.ParseTS(consumed.ts)
+		lag := &ConsumerLag{
+			Channel:      channel,
+			Subscription: consumer.Subscription(),
+			MsgLag:       -1,
+			TimeLag:      now.Sub(physicalTime),
+		}
+		if consumedID, ok := consumed.id.(mqwrapper.OffsetMessageID); ok {
+			latestID, err := consumer.GetLatestMsgID()
+			if err != nil {
+				log.Warn("failed to get the latest message id for the consumer lag", zap.String("channel", channel), zap.Error(err))
+			} else if latestID, ok := latestID.(mqwrapper.OffsetMessageID); ok {
+				lag.MsgLag = latestID.Offset() - consumedID.Offset()
+				if lag.MsgLag < 0 {
+					lag.MsgLag = 0
+				}
+			}
+		}
+		lags = append(lags, lag)
+	}
+	return lags
+}
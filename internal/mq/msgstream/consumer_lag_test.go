@@ -0,0 +1,87 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+type offsetID struct {
+	offset int64
+}
+
+func (id *offsetID) Serialize() []byte                    { return nil }
+func (id *offsetID) AtEarliestPosition() bool             { return id.offset == 0 }
+func (id *offsetID) LessOrEqualThan([]byte) (bool, error) { return false, nil }
+func (id *offsetID) Offset() int64                        { return id.offset }
+
+// plainID is a message id without offset, like the pulsar message id
+type plainID struct {
+	mqwrapper.MessageID
+}
+
+type lagConsumer struct {
+	mqwrapper.Consumer
+	latest MessageID
+	err    error
+}
+
+func (c *lagConsumer) Subscription() string { return "sub" }
+
+func (c *lagConsumer) GetLatestMsgID() (MessageID, error) {
+	return c.latest, c.err
+}
+
+func TestMqMsgStream_GetConsumerLags(t *testing.T) {
+	stream, err := NewMqMsgStream(context.Background(), 100, 100, nil, nil)
+	assert.NoError(t, err)
+
+	offsetConsumer := &lagConsumer{latest: &offsetID{offset: 10}}
+	plainConsumer := &lagConsumer{latest: &offsetID{offset: 10}}
+	failedConsumer := &lagConsumer{err: errors.New("mock")}
+	idleConsumer := &lagConsumer{latest: &offsetID{offset: 10}}
+	stream.consumers = map[string]mqwrapper.Consumer{
+		"offset": offsetConsumer,
+		"plain":  plainConsumer,
+		"failed": failedConsumer,
+		"idle":   idleConsumer,
+	}
+
+	ts := tsoutil.ComposeTSByTime(time.Now().Add(-time.Minute), 0)
+	stream.recordConsumed(offsetConsumer, &offsetID{offset: 4}, ts)
+	stream.recordConsumed(plainConsumer, &plainID{}, ts)
+	stream.recordConsumed(failedConsumer, &offsetID{offset: 4}, ts)
+
+	lags := make(map[string]*ConsumerLag)
+	for _, lag := range stream.GetConsumerLags() {
+		lags[lag.Channel] = lag
+	}
+	assert.Len(t, lags, 3)
+	assert.Equal(t, int64(6), lags["offset"].MsgLag)
+	assert.Equal(t, "sub", lags["offset"].Subscription)
+	assert.GreaterOrEqual(t, lags["offset"].TimeLag, time.Minute)
+	assert.Equal(t, int64(-1), lags["plain"].MsgLag)
+	assert.Equal(t, int64(-1), lags["failed"].MsgLag)
+}
@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/util/compressor"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// names of the builtin msgstream implementations
+const (
+	RocksmqFactoryName = "rocksmq"
+	PulsarFactoryName  = "pulsar"
+	KafkaFactoryName   = "kafka"
+	NatsFactoryName    = "nats"
+)
+
+// FactoryCreator creates a msgstream Factory from the configs of the component
+type FactoryCreator func(params *paramtable.ComponentParam) (Factory, error)
+
+var factoryRegistry = struct {
+	mu       sync.RWMutex
+	creators map[string]FactoryCreator
+}{creators: make(map[string]FactoryCreator)}
+
+func init() {
+	mustRegisterFactory(RocksmqFactoryName, newRmsFactoryFromParams)
+	mustRegisterFactory(PulsarFactoryName, newPmsFactoryFromParams)
+	mustRegisterFactory(KafkaFactoryName, newKmsFactoryFromParams)
+	mustRegisterFactory(NatsFactoryName, newNmsFactoryFromParams)
+}
+
+// RegisterFactory registers a msgstream implementation by name, so that it can be selected by
+// common.msgStream.type without modifying the core code. External modules usually call it in init().
+func RegisterFactory(name string, creator FactoryCreator) error {
+	if name == "" {
+		return fmt.Errorf("msgstream factory name is empty")
+	}
+	if creator == nil {
+		return fmt.Errorf("msgstream factory creator of %s is nil", name)
+	}
+
+	factoryRegistry.mu.Lock()
+	defer factoryRegistry.mu.Unlock()
+	if _, ok := factoryRegistry.creators[name]; ok {
+		return fmt.Errorf("msgstream factory %s is already registered", name)
+	}
+	factoryRegistry.creators[name] = creator
+	return nil
+}
+
+func mustRegisterFactory(name string, creator FactoryCreator) {
+	if err := RegisterFactory(name, creator); err != nil {
+		panic(err)
+	}
+}
+
+// RegisteredFactories returns the sorted names of the registered msgstream implementations
+func RegisteredFactories() []string {
+	factoryRegistry.mu.RLock()
+	defer factoryRegistry.mu.RUnlock()
+
+	names := make([]string, 0, len(factoryRegistry.creators))
+	for name := range factoryRegistry.creators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewFactoryByName creates the msgstream Factory of the implementation registered by name
+func NewFactoryByName(name string, params *paramtable.ComponentParam) (Factory, error) {
+	factoryRegistry.mu.RLock()
+	creator, ok := factoryRegistry.creators[name]
+	factoryRegistry.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("msgstream factory %s is not registered, registered: %v", name, RegisteredFactories())
+	}
+	return creator(params)
+}
+
+func newRmsFactoryFromParams(params *paramtable.ComponentParam) (Factory, error) {
+	factory := NewRmsFactory(params.RocksmqCfg.Path)
+	factory.PayloadCompression = compressor.CompressType(params.CommonCfg.MsgStreamCompression)
+	return factory, nil
+}
+
+func newPmsFactoryFromParams(params *paramtable.ComponentParam) (Factory, error) {
+	factory := NewPmsFactory(&params.PulsarCfg)
+	factory.PayloadCompression = compressor.CompressType(params.CommonCfg.MsgStreamCompression)
+	return factory, nil
+}
+
+func newKmsFactoryFromParams(params *paramtable.ComponentParam) (Factory, error) {
+	factory := NewKmsFactory(&params.KafkaCfg)
+	factory.PayloadCompression = compressor.CompressType(params.CommonCfg.MsgStreamCompression)
+	return factory, nil
+}
+
+func newNmsFactoryFromParams(params *paramtable.ComponentParam) (Factory, error) {
+	factory := NewNmsFactory(&params.NatsCfg)
+	factory.PayloadCompression = compressor.CompressType(params.CommonCfg.MsgStreamCompression)
+	return factory, nil
+}
@@ -0,0 +1,78 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+type mockFactory struct{}
+
+func (f *mockFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *mockFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *mockFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestRegisterFactory(t *testing.T) {
+	assert.Subset(t, RegisteredFactories(), []string{RocksmqFactoryName, PulsarFactoryName, KafkaFactoryName, NatsFactoryName})
+
+	creator := func(params *paramtable.ComponentParam) (Factory, error) {
+		return &mockFactory{}, nil
+	}
+	assert.Error(t, RegisterFactory("", creator))
+	assert.Error(t, RegisterFactory("mock", nil))
+	assert.Error(t, RegisterFactory(PulsarFactoryName, creator))
+
+	assert.NoError(t, RegisterFactory("mock", creator))
+	assert.Contains(t, RegisteredFactories(), "mock")
+	assert.Error(t, RegisterFactory("mock", creator))
+
+	factory, err := NewFactoryByName("mock", &paramtable.ComponentParam{})
+	assert.NoError(t, err)
+	assert.IsType(t, &mockFactory{}, factory)
+
+	_, err = NewFactoryByName("unknown", &paramtable.ComponentParam{})
+	assert.Error(t, err)
+}
+
+func TestNewFactoryByName_Builtin(t *testing.T) {
+	compression := Params.CommonCfg.MsgStreamCompression
+	defer func() { Params.CommonCfg.MsgStreamCompression = compression }()
+	Params.CommonCfg.MsgStreamCompression = "zstd"
+
+	factory, err := NewFactoryByName(PulsarFactoryName, &Params)
+	assert.NoError(t, err)
+	assert.Equal(t, Params.PulsarCfg.Address, factory.(*PmsFactory).PulsarAddress)
+	assert.EqualValues(t, "zstd", factory.(*PmsFactory).PayloadCompression)
+
+	factory, err = NewFactoryByName(KafkaFactoryName, &Params)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "zstd", factory.(*KmsFactory).PayloadCompression)
+}
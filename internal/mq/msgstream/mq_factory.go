@@ -22,12 +22,14 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/compressor"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 
 	rmqimplserver "github.com/milvus-io/milvus/internal/mq/mqimpl/rocksmq/server"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	kafkawrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/kafka"
+	natswrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/nats"
 	puslarmqwrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/pulsar"
 	rmqwrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/rmq"
 )
@@ -36,9 +38,16 @@ import (
 type PmsFactory struct {
 	dispatcherFactory ProtoUDFactory
 	// the following members must be public, so that mapstructure.Decode() can access them
-	PulsarAddress  string
-	ReceiveBufSize int64
-	PulsarBufSize  int64
+	PulsarAddress      string
+	ReceiveBufSize     int64
+	PulsarBufSize      int64
+	PayloadCompression compressor.CompressType
+
+	PulsarAuthPlugin                 string
+	PulsarAuthParams                 string
+	PulsarTLSTrustCertsFilePath      string
+	PulsarTLSAllowInsecureConnection bool
+	PulsarTLSValidateHostname        bool
 }
 
 func NewPmsFactory(config *paramtable.PulsarConfig) *PmsFactory {
@@ -46,25 +55,67 @@ func NewPmsFactory(config *paramtable.PulsarConfig) *PmsFactory {
 		PulsarBufSize:  1024,
 		ReceiveBufSize: 1024,
 		PulsarAddress:  config.Address,
+
+		PulsarAuthPlugin:                 config.AuthPlugin,
+		PulsarAuthParams:                 config.AuthParams,
+		PulsarTLSTrustCertsFilePath:      config.TLSTrustCertsFilePath,
+		PulsarTLSAllowInsecureConnection: config.TLSAllowInsecureConnection,
+		PulsarTLSValidateHostname:        config.TLSValidateHostname,
+	}
+}
+
+// clientOptions returns the options of pulsar client with the authentication and TLS configs
+func (f *PmsFactory) clientOptions() (pulsar.ClientOptions, error) {
+	opts := pulsar.ClientOptions{
+		URL:                        f.PulsarAddress,
+		TLSTrustCertsFilePath:      f.PulsarTLSTrustCertsFilePath,
+		TLSAllowInsecureConnection: f.PulsarTLSAllowInsecureConnection,
+		TLSValidateHostname:        f.PulsarTLSValidateHostname,
+	}
+	if f.PulsarAuthPlugin != "" {
+		auth, err := pulsar.NewAuthentication(f.PulsarAuthPlugin, f.PulsarAuthParams)
+		if err != nil {
+			return opts, err
+		}
+		opts.Authentication = auth
 	}
+	return opts, nil
 }
 
 // NewMsgStream is used to generate a new Msgstream object
 func (f *PmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
-	pulsarClient, err := puslarmqwrapper.NewClient(pulsar.ClientOptions{URL: f.PulsarAddress})
+	opts, err := f.clientOptions()
+	if err != nil {
+		return nil, err
+	}
+	pulsarClient, err := puslarmqwrapper.NewClient(opts)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := NewMqMsgStream(ctx, f.ReceiveBufSize, f.PulsarBufSize, pulsarClient, f.dispatcherFactory.NewUnmarshalDispatcher())
 	if err != nil {
 		return nil, err
 	}
-	return NewMqMsgStream(ctx, f.ReceiveBufSize, f.PulsarBufSize, pulsarClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	stream.setPayloadCompression(f.PayloadCompression)
+	return stream, nil
 }
 
 // NewTtMsgStream is used to generate a new TtMsgstream object
 func (f *PmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
-	pulsarClient, err := puslarmqwrapper.NewClient(pulsar.ClientOptions{URL: f.PulsarAddress})
+	opts, err := f.clientOptions()
 	if err != nil {
 		return nil, err
 	}
-	return NewMqTtMsgStream(ctx, f.ReceiveBufSize, f.PulsarBufSize, pulsarClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	pulsarClient, err := puslarmqwrapper.NewClient(opts)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := NewMqTtMsgStream(ctx, f.ReceiveBufSize, f.PulsarBufSize, pulsarClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	if err != nil {
+		return nil, err
+	}
+	stream.setPayloadCompression(f.PayloadCompression)
+	return stream, nil
 }
 
 // NewQueryMsgStream is used to generate a new QueryMsgstream object
@@ -76,8 +127,9 @@ func (f *PmsFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
 type RmsFactory struct {
 	dispatcherFactory ProtoUDFactory
 	// the following members must be public, so that mapstructure.Decode() can access them
-	ReceiveBufSize int64
-	RmqBufSize     int64
+	ReceiveBufSize     int64
+	RmqBufSize         int64
+	PayloadCompression compressor.CompressType
 }
 
 // NewMsgStream is used to generate a new Msgstream object
@@ -86,7 +138,12 @@ func (f *RmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewMqMsgStream(ctx, f.ReceiveBufSize, f.RmqBufSize, rmqClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	stream, err := NewMqMsgStream(ctx, f.ReceiveBufSize, f.RmqBufSize, rmqClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	if err != nil {
+		return nil, err
+	}
+	stream.setPayloadCompression(f.PayloadCompression)
+	return stream, nil
 }
 
 // NewTtMsgStream is used to generate a new TtMsgstream object
@@ -95,7 +152,12 @@ func (f *RmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewMqTtMsgStream(ctx, f.ReceiveBufSize, f.RmqBufSize, rmqClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	stream, err := NewMqTtMsgStream(ctx, f.ReceiveBufSize, f.RmqBufSize, rmqClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	if err != nil {
+		return nil, err
+	}
+	stream.setPayloadCompression(f.PayloadCompression)
+	return stream, nil
 }
 
 // NewQueryMsgStream is used to generate a new QueryMsgstream object
@@ -104,7 +166,12 @@ func (f *RmsFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewMqMsgStream(ctx, f.ReceiveBufSize, f.RmqBufSize, rmqClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	stream, err := NewMqMsgStream(ctx, f.ReceiveBufSize, f.RmqBufSize, rmqClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	if err != nil {
+		return nil, err
+	}
+	stream.setPayloadCompression(f.PayloadCompression)
+	return stream, nil
 }
 
 // NewRmsFactory is used to generate a new RmsFactory object
@@ -123,30 +190,112 @@ func NewRmsFactory(path string) *RmsFactory {
 }
 
 type KmsFactory struct {
-	dispatcherFactory ProtoUDFactory
-	KafkaAddress      string
-	ReceiveBufSize    int64
+	dispatcherFactory  ProtoUDFactory
+	KafkaAddress       string
+	ReceiveBufSize     int64
+	PayloadCompression compressor.CompressType
+	// librdkafka configs of the SASL and SSL credentials
+	KafkaSecurityConfig map[string]string
 }
 
 func (f *KmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
-	kafkaClient := kafkawrapper.NewKafkaClientInstance(f.KafkaAddress)
-	return NewMqMsgStream(ctx, f.ReceiveBufSize, -1, kafkaClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	kafkaClient := kafkawrapper.NewKafkaClientInstanceWithConfig(f.KafkaAddress, f.KafkaSecurityConfig)
+	stream, err := NewMqMsgStream(ctx, f.ReceiveBufSize, -1, kafkaClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	if err != nil {
+		return nil, err
+	}
+	stream.setPayloadCompression(f.PayloadCompression)
+	return stream, nil
 }
 
 func (f *KmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
-	kafkaClient := kafkawrapper.NewKafkaClientInstance(f.KafkaAddress)
-	return NewMqTtMsgStream(ctx, f.ReceiveBufSize, -1, kafkaClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	kafkaClient := kafkawrapper.NewKafkaClientInstanceWithConfig(f.KafkaAddress, f.KafkaSecurityConfig)
+	stream, err := NewMqTtMsgStream(ctx, f.ReceiveBufSize, -1, kafkaClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	if err != nil {
+		return nil, err
+	}
+	stream.setPayloadCompression(f.PayloadCompression)
+	return stream, nil
 }
 
 func (f *KmsFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
 	return f.NewMsgStream(ctx)
 }
 
-func NewKmsFactory(config *paramtable.KafkaConfig) Factory {
+func NewKmsFactory(config *paramtable.KafkaConfig) *KmsFactory {
 	f := &KmsFactory{
+		dispatcherFactory:   ProtoUDFactory{},
+		ReceiveBufSize:      1024,
+		KafkaAddress:        config.Address,
+		KafkaSecurityConfig: kafkaSecurityConfig(config),
+	}
+	return f
+}
+
+// kafkaSecurityConfig maps the SASL and SSL configs to librdkafka configs, the empty ones are skipped
+func kafkaSecurityConfig(config *paramtable.KafkaConfig) map[string]string {
+	securityConfig := make(map[string]string)
+	for key, value := range map[string]string{
+		"security.protocol":        config.SecurityProtocol,
+		"sasl.mechanisms":          config.SaslMechanisms,
+		"sasl.username":            config.SaslUsername,
+		"sasl.password":            config.SaslPassword,
+		"sasl.oauthbearer.config":  config.SaslOauthbearerConfig,
+		"ssl.ca.location":          config.SSLCaLocation,
+		"ssl.certificate.location": config.SSLCertificateLocation,
+		"ssl.key.location":         config.SSLKeyLocation,
+		"ssl.key.password":         config.SSLKeyPassword,
+	} {
+		if value != "" {
+			securityConfig[key] = value
+		}
+	}
+	return securityConfig
+}
+
+// NmsFactory is a NATS JetStream msgstream factory that implemented Factory interface(msgstream.go)
+type NmsFactory struct {
+	dispatcherFactory  ProtoUDFactory
+	NatsAddress        string
+	ReceiveBufSize     int64
+	PayloadCompression compressor.CompressType
+}
+
+func (f *NmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
+	natsClient, err := natswrapper.NewClient(f.NatsAddress)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := NewMqMsgStream(ctx, f.ReceiveBufSize, -1, natsClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	if err != nil {
+		return nil, err
+	}
+	stream.setPayloadCompression(f.PayloadCompression)
+	return stream, nil
+}
+
+func (f *NmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
+	natsClient, err := natswrapper.NewClient(f.NatsAddress)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := NewMqTtMsgStream(ctx, f.ReceiveBufSize, -1, natsClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+	if err != nil {
+		return nil, err
+	}
+	stream.setPayloadCompression(f.PayloadCompression)
+	return stream, nil
+}
+
+func (f *NmsFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
+	return f.NewMsgStream(ctx)
+}
+
+func NewNmsFactory(config *paramtable.NatsConfig) *NmsFactory {
+	f := &NmsFactory{
 		dispatcherFactory: ProtoUDFactory{},
 		ReceiveBufSize:    1024,
-		KafkaAddress:      config.Address,
+		NatsAddress:       config.Address,
 	}
 	return f
 }
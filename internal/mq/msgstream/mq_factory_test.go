@@ -22,6 +22,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
 func TestPmsFactory(t *testing.T) {
@@ -69,3 +71,70 @@ func TestKafkaFactory(t *testing.T) {
 	_, err = kmsFactory.NewQueryMsgStream(ctx)
 	assert.Nil(t, err)
 }
+
+func TestNatsFactory(t *testing.T) {
+	if !Params.NatsEnable() {
+		t.Skip("nats is not configured")
+	}
+	nmsFactory := NewNmsFactory(&Params.NatsCfg)
+
+	ctx := context.Background()
+	_, err := nmsFactory.NewMsgStream(ctx)
+	assert.Nil(t, err)
+
+	_, err = nmsFactory.NewTtMsgStream(ctx)
+	assert.Nil(t, err)
+
+	_, err = nmsFactory.NewQueryMsgStream(ctx)
+	assert.Nil(t, err)
+}
+
+func TestPmsFactory_ClientOptions(t *testing.T) {
+	pmsFactory := NewPmsFactory(&paramtable.PulsarConfig{
+		Address:                    "pulsar+ssl://localhost:6651",
+		TLSTrustCertsFilePath:      "/path/to/ca.cert.pem",
+		TLSAllowInsecureConnection: true,
+	})
+	opts, err := pmsFactory.clientOptions()
+	assert.NoError(t, err)
+	assert.Equal(t, "pulsar+ssl://localhost:6651", opts.URL)
+	assert.Equal(t, "/path/to/ca.cert.pem", opts.TLSTrustCertsFilePath)
+	assert.True(t, opts.TLSAllowInsecureConnection)
+	assert.Nil(t, opts.Authentication)
+
+	pmsFactory.PulsarAuthPlugin = "token"
+	pmsFactory.PulsarAuthParams = `{"token":"abc"}`
+	opts, err = pmsFactory.clientOptions()
+	assert.NoError(t, err)
+	assert.NotNil(t, opts.Authentication)
+
+	pmsFactory.PulsarAuthParams = ""
+	_, err = pmsFactory.clientOptions()
+	assert.Error(t, err)
+
+	pmsFactory.PulsarAuthPlugin = "unknown"
+	_, err = pmsFactory.clientOptions()
+	assert.Error(t, err)
+	_, err = pmsFactory.NewMsgStream(context.Background())
+	assert.Error(t, err)
+}
+
+func TestKafkaSecurityConfig(t *testing.T) {
+	assert.Empty(t, kafkaSecurityConfig(&paramtable.KafkaConfig{Address: "localhost:9092"}))
+
+	kmsFactory := NewKmsFactory(&paramtable.KafkaConfig{
+		Address:          "localhost:9092",
+		SecurityProtocol: "SASL_SSL",
+		SaslMechanisms:   "PLAIN",
+		SaslUsername:     "milvus",
+		SaslPassword:     "abc",
+		SSLCaLocation:    "/path/to/ca.pem",
+	})
+	assert.Equal(t, map[string]string{
+		"security.protocol": "SASL_SSL",
+		"sasl.mechanisms":   "PLAIN",
+		"sasl.username":     "milvus",
+		"sasl.password":     "abc",
+		"ssl.ca.location":   "/path/to/ca.pem",
+	}, kmsFactory.KafkaSecurityConfig)
+}
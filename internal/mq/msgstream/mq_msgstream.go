@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/compressor"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/opentracing/opentracing-go"
@@ -58,6 +59,11 @@ type mqMsgStream struct {
 	readerLock   *sync.Mutex
 	closed       int32
 	onceChan     sync.Once
+
+	payloadCompression compressor.CompressType
+
+	consumedLock *sync.Mutex
+	consumed     map[mqwrapper.Consumer]consumedMsg
 }
 
 // NewMqMsgStream is used to generate a new mqMsgStream object
@@ -88,6 +94,8 @@ func NewMqMsgStream(ctx context.Context,
 		streamCancel: streamCancel,
 		producerLock: &sync.Mutex{},
 		consumerLock: &sync.Mutex{},
+		consumedLock: &sync.Mutex{},
+		consumed:     make(map[mqwrapper.Consumer]consumedMsg),
 		readerLock:   &sync.Mutex{},
 		wait:         &sync.WaitGroup{},
 		closed:       0,
@@ -181,6 +189,11 @@ func (ms *mqMsgStream) SetRepackFunc(repackFunc RepackFunc) {
 	ms.repackFunc = repackFunc
 }
 
+// setPayloadCompression sets the compress type of the insert and delete payloads produced, empty for none
+func (ms *mqMsgStream) setPayloadCompression(typ compressor.CompressType) {
+	ms.payloadCompression = typ
+}
+
 func (ms *mqMsgStream) Start() {
 }
 
@@ -278,6 +291,11 @@ func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
 				return err
 			}
 
+			m, err = compressPayload(ms.payloadCompression, v.Msgs[i].Type(), m)
+			if err != nil {
+				return err
+			}
+
 			msg := &mqwrapper.ProducerMessage{Payload: m, Properties: map[string]string{}}
 
 			trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
@@ -344,6 +362,11 @@ func (ms *mqMsgStream) ProduceMark(msgPack *MsgPack) (map[string][]MessageID, er
 				return ids, err
 			}
 
+			m, err = compressPayload(ms.payloadCompression, tsMsg.Type(), m)
+			if err != nil {
+				return ids, err
+			}
+
 			msg := &mqwrapper.ProducerMessage{Payload: m, Properties: map[string]string{}}
 
 			trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
@@ -455,14 +478,18 @@ func (ms *mqMsgStream) getTsMsgFromConsumerMsg(msg mqwrapper.Message) (TsMsg, er
 	if msg.Payload() == nil {
 		return nil, fmt.Errorf("failed to unmarshal message header, payload is empty")
 	}
-	err := proto.Unmarshal(msg.Payload(), &header)
+	payload, err := decompressPayload(msg.Payload())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress message payload, err %s", err.Error())
+	}
+	err = proto.Unmarshal(payload, &header)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal message header, err %s", err.Error())
 	}
 	if header.Base == nil {
 		return nil, fmt.Errorf("failed to unmarshal message, header is uncomplete")
 	}
-	tsMsg, err := ms.unmarshal.Unmarshal(msg.Payload(), header.Base.MsgType)
+	tsMsg, err := ms.unmarshal.Unmarshal(payload, header.Base.MsgType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal tsMsg, err %s", err.Error())
 	}
@@ -497,6 +524,7 @@ func (ms *mqMsgStream) receiveMsg(consumer mqwrapper.Consumer) {
 				log.Error("Failed to getTsMsgFromConsumerMsg", zap.Error(err))
 				continue
 			}
+			ms.recordConsumed(consumer, msg.ID(), tsMsg.EndTs())
 			pos := tsMsg.Position()
 			tsMsg.SetPosition(&MsgPosition{
 				ChannelName: pos.ChannelName,
@@ -791,6 +819,7 @@ func (ms *MqTtMsgStream) consumeToTtMsg(consumer mqwrapper.Consumer) {
 				log.Error("Failed to getTsMsgFromConsumerMsg", zap.Error(err))
 				continue
 			}
+			ms.recordConsumed(consumer, msg.ID(), tsMsg.EndTs())
 
 			sp, ok := ExtractFromPulsarMsgProperties(tsMsg, msg.Properties())
 			if ok {
@@ -894,15 +923,20 @@ func (ms *MqTtMsgStream) Seek(msgPositions []*internalpb.MsgPosition) error {
 				}
 				consumer.Ack(msg)
 
+				payload, err := decompressPayload(msg.Payload())
+				if err != nil {
+					return fmt.Errorf("failed to decompress message payload, err %s", err.Error())
+				}
 				headerMsg := commonpb.MsgHeader{}
-				err := proto.Unmarshal(msg.Payload(), &headerMsg)
+				err = proto.Unmarshal(payload, &headerMsg)
 				if err != nil {
 					return fmt.Errorf("failed to unmarshal message header, err %s", err.Error())
 				}
-				tsMsg, err := ms.unmarshal.Unmarshal(msg.Payload(), headerMsg.Base.MsgType)
+				tsMsg, err := ms.unmarshal.Unmarshal(payload, headerMsg.Base.MsgType)
 				if err != nil {
 					return fmt.Errorf("failed to unmarshal tsMsg, err %s", err.Error())
 				}
+				ms.recordConsumed(consumer, msg.ID(), tsMsg.EndTs())
 				if tsMsg.Type() == commonpb.MsgType_TimeTick && tsMsg.BeginTs() >= mp.Timestamp {
 					runLoop = false
 					break
@@ -43,6 +43,7 @@ import (
 	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/compressor"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -1494,6 +1495,31 @@ func TestStream_RmqMsgStream_Insert(t *testing.T) {
 	Close(rocksdbName, inputStream, outputStream, etcdKV)
 }
 
+func TestStream_RmqMsgStream_InsertCompressed(t *testing.T) {
+	producerChannels := []string{"insert1"}
+	consumerChannels := []string{"insert1"}
+	consumerGroupName := "InsertCompressedGroup"
+
+	msgPack := MsgPack{}
+	msgPack.Msgs = append(msgPack.Msgs, getTsMsg(commonpb.MsgType_Insert, 1))
+	msgPack.Msgs = append(msgPack.Msgs, getTsMsg(commonpb.MsgType_Insert, 3))
+
+	rocksdbName := "/tmp/rocksmq_insert_compressed"
+	etcdKV := initRmq(rocksdbName)
+	ctx := context.Background()
+	inputStream, outputStream := initRmqStream(ctx, producerChannels, consumerChannels, consumerGroupName)
+	inputStream.(*mqMsgStream).setPayloadCompression(compressor.CompressTypeZstd)
+	err := inputStream.Produce(&msgPack)
+	require.NoErrorf(t, err, fmt.Sprintf("produce error = %v", err))
+
+	for _, msg := range msgPack.Msgs {
+		result := consumer(ctx, outputStream)
+		require.Equal(t, 1, len(result.Msgs))
+		assert.Equal(t, msg.(*InsertMsg).InsertRequest, result.Msgs[0].(*InsertMsg).InsertRequest)
+	}
+	Close(rocksdbName, inputStream, outputStream, etcdKV)
+}
+
 func TestStream_RmqTtMsgStream_Insert(t *testing.T) {
 	producerChannels := []string{"insert1", "insert2"}
 	consumerChannels := []string{"insert1", "insert2"}
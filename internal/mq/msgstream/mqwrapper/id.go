@@ -25,3 +25,11 @@ type MessageID interface {
 
 	LessOrEqualThan(msgID []byte) (bool, error)
 }
+
+// OffsetMessageID is the message id numbered one by one in a topic, such as the kafka offset and the nats
+// stream sequence, so that the number of the messages between two ids is known
+type OffsetMessageID interface {
+	MessageID
+
+	Offset() int64
+}
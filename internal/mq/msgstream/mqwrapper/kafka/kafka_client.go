@@ -30,6 +30,15 @@ func NewKafkaClientInstance(address string) *kafkaClient {
 	return &kafkaClient{basicConfig: config}
 }
 
+// NewKafkaClientInstanceWithConfig creates a kafka client with the extra configs, such as the SASL and SSL ones
+func NewKafkaClientInstanceWithConfig(address string, extraConfig map[string]string) *kafkaClient {
+	kc := NewKafkaClientInstance(address)
+	for k, v := range extraConfig {
+		kc.basicConfig.SetKey(k, v)
+	}
+	return kc
+}
+
 func cloneKafkaConfig(config kafka.ConfigMap) *kafka.ConfigMap {
 	newConfig := make(kafka.ConfigMap)
 	for k, v := range config {
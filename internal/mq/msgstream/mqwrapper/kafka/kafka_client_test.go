@@ -374,6 +374,22 @@ func TestKafkaClient_EarliestMessageID(t *testing.T) {
 	assert.NotNil(t, mid)
 }
 
+func TestKafkaClient_NewKafkaClientInstanceWithConfig(t *testing.T) {
+	kc := NewKafkaClientInstanceWithConfig("localhost:9092", map[string]string{
+		"security.protocol": "SASL_SSL",
+		"sasl.mechanisms":   "PLAIN",
+	})
+	assert.Equal(t, "localhost:9092", kc.basicConfig["bootstrap.servers"])
+	assert.Equal(t, "SASL_SSL", kc.basicConfig["security.protocol"])
+	assert.Equal(t, "PLAIN", kc.basicConfig["sasl.mechanisms"])
+
+	// the security configs are inherited by the producer and consumer configs
+	config := kc.newConsumerConfig("group", mqwrapper.SubscriptionPositionEarliest)
+	value, err := config.Get("security.protocol", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "SASL_SSL", value)
+}
+
 func createKafkaClient(t *testing.T) *kafkaClient {
 	kafkaAddress, _ := Params.Load("_KafkaBrokerList")
 	kc := NewKafkaClientInstance(kafkaAddress)
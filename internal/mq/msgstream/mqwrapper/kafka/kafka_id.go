@@ -9,7 +9,7 @@ type kafkaID struct {
 	messageID int64
 }
 
-var _ mqwrapper.MessageID = &kafkaID{}
+var _ mqwrapper.OffsetMessageID = &kafkaID{}
 
 func (kid *kafkaID) Serialize() []byte {
 	return SerializeKafkaID(kid.messageID)
@@ -23,6 +23,10 @@ func (kid *kafkaID) LessOrEqualThan(msgID []byte) (bool, error) {
 	return kid.messageID <= DeserializeKafkaID(msgID), nil
 }
 
+func (kid *kafkaID) Offset() int64 {
+	return kid.messageID
+}
+
 func SerializeKafkaID(messageID int64) []byte {
 	b := make([]byte, 8)
 	common.Endian.PutUint64(b, uint64(messageID))
@@ -43,6 +43,11 @@ func TestKafkaID_LessOrEqualThan(t *testing.T) {
 	}
 }
 
+func TestKafkaID_Offset(t *testing.T) {
+	rid := &kafkaID{messageID: 8}
+	assert.Equal(t, int64(8), rid.Offset())
+}
+
 func Test_SerializeKafkaID(t *testing.T) {
 	bin := SerializeKafkaID(10)
 	assert.NotNil(t, bin)
@@ -0,0 +1,108 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+// natsClient is the client of NATS JetStream, every topic is a stream of a single subject named as the topic
+type natsClient struct {
+	conn *nats.Conn
+	js   nats.JetStreamContext
+}
+
+var _ mqwrapper.Client = &natsClient{}
+
+// NewClient connects to the NATS server of address, JetStream must be enabled on the server
+func NewClient(address string) (*natsClient, error) {
+	conn, err := nats.Connect(address, nats.Name("milvus"), nats.MaxReconnects(-1))
+	if err != nil {
+		log.Error("connect to nats failed", zap.String("address", address), zap.Error(err))
+		return nil, err
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &natsClient{conn: conn, js: js}, nil
+}
+
+// ensureStream creates the stream of topic if it doesn't exist, the messages are persisted in file
+func (nc *natsClient) ensureStream(topic string) error {
+	_, err := nc.js.StreamInfo(topic)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, nats.ErrStreamNotFound) {
+		return err
+	}
+	_, err = nc.js.AddStream(&nats.StreamConfig{
+		Name:     topic,
+		Subjects: []string{topic},
+		Storage:  nats.FileStorage,
+	})
+	if errors.Is(err, nats.ErrStreamNameAlreadyInUse) {
+		// created by another client concurrently
+		return nil
+	}
+	return err
+}
+
+func (nc *natsClient) CreateProducer(options mqwrapper.ProducerOptions) (mqwrapper.Producer, error) {
+	if err := nc.ensureStream(options.Topic); err != nil {
+		log.Error("create nats stream failed", zap.String("topic", options.Topic), zap.Error(err))
+		return nil, err
+	}
+	return &natsProducer{js: nc.js, topic: options.Topic}, nil
+}
+
+func (nc *natsClient) Subscribe(options mqwrapper.ConsumerOptions) (mqwrapper.Consumer, error) {
+	if err := nc.ensureStream(options.Topic); err != nil {
+		log.Error("create nats stream failed", zap.String("topic", options.Topic), zap.Error(err))
+		return nil, err
+	}
+	return newNatsConsumer(nc.js, options), nil
+}
+
+func (nc *natsClient) EarliestMessageID() mqwrapper.MessageID {
+	return &natsID{messageID: 0}
+}
+
+func (nc *natsClient) StringToMsgID(id string) (mqwrapper.MessageID, error) {
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &natsID{messageID: seq}, nil
+}
+
+func (nc *natsClient) BytesToMsgID(id []byte) (mqwrapper.MessageID, error) {
+	return &natsID{messageID: DeserializeNatsID(id)}, nil
+}
+
+func (nc *natsClient) Close() {
+	nc.conn.Close()
+}
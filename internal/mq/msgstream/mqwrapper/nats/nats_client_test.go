@@ -0,0 +1,152 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+var Params paramtable.BaseTable
+
+func TestMain(m *testing.M) {
+	Params.Init()
+	rand.Seed(time.Now().UnixNano())
+	exitCode := m.Run()
+	os.Exit(exitCode)
+}
+
+// createNatsClient connects to the NATS server configured, the test is skipped if NATS isn't configured
+func createNatsClient(t *testing.T) *natsClient {
+	natsAddress, _ := Params.Load("_NatsAddress")
+	if natsAddress == "" {
+		t.Skip("nats is not configured")
+	}
+	nc, err := NewClient(natsAddress)
+	assert.Nil(t, err)
+	return nc
+}
+
+func produceData(ctx context.Context, t *testing.T, producer mqwrapper.Producer, data []int) []mqwrapper.MessageID {
+	var ids []mqwrapper.MessageID
+	for _, v := range data {
+		msg := &mqwrapper.ProducerMessage{
+			Payload:    []byte(strconv.Itoa(v)),
+			Properties: map[string]string{"value": strconv.Itoa(v)},
+		}
+		id, err := producer.Send(ctx, msg)
+		assert.Nil(t, err)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func consumeData(t *testing.T, consumer mqwrapper.Consumer, num int) []int {
+	var data []int
+	for i := 0; i < num; i++ {
+		select {
+		case msg := <-consumer.Chan():
+			v, err := strconv.Atoi(string(msg.Payload()))
+			assert.Nil(t, err)
+			assert.Equal(t, string(msg.Payload()), msg.Properties()["value"])
+			consumer.Ack(msg)
+			data = append(data, v)
+		case <-time.After(10 * time.Second):
+			assert.FailNow(t, "consume timeout")
+		}
+	}
+	return data
+}
+
+func TestNatsClient_ProduceConsume(t *testing.T) {
+	nc := createNatsClient(t)
+	defer nc.Close()
+	ctx := context.Background()
+	topic := fmt.Sprintf("test-topic-%d", rand.Int())
+
+	producer, err := nc.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	assert.Nil(t, err)
+	defer producer.Close()
+	ids := produceData(ctx, t, producer, []int{1, 2, 3})
+	assert.Equal(t, uint64(1), ids[0].(*natsID).messageID)
+	assert.Equal(t, uint64(3), ids[2].(*natsID).messageID)
+
+	consumer, err := nc.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+	assert.Equal(t, "sub", consumer.Subscription())
+	assert.Equal(t, []int{1, 2, 3}, consumeData(t, consumer, 3))
+
+	produceData(ctx, t, producer, []int{4})
+	assert.Equal(t, []int{4}, consumeData(t, consumer, 1))
+}
+
+func TestNatsClient_Seek(t *testing.T) {
+	nc := createNatsClient(t)
+	defer nc.Close()
+	ctx := context.Background()
+	topic := fmt.Sprintf("test-topic-%d", rand.Int())
+
+	producer, err := nc.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	assert.Nil(t, err)
+	defer producer.Close()
+	ids := produceData(ctx, t, producer, []int{1, 2, 3, 4})
+
+	consumer, err := nc.Subscribe(mqwrapper.ConsumerOptions{Topic: topic, SubscriptionName: "sub"})
+	assert.Nil(t, err)
+	defer consumer.Close()
+
+	seekID, err := nc.BytesToMsgID(ids[1].Serialize())
+	assert.Nil(t, err)
+	err = consumer.Seek(seekID, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{3, 4}, consumeData(t, consumer, 2))
+
+	err = consumer.Seek(seekID, true)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{2, 3, 4}, consumeData(t, consumer, 3))
+
+	err = consumer.Seek(nc.EarliestMessageID(), true)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, consumeData(t, consumer, 4))
+}
+
+func TestNatsClient_StringToMsgID(t *testing.T) {
+	nc := &natsClient{}
+	id, err := nc.StringToMsgID("5")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), id.(*natsID).messageID)
+
+	_, err = nc.StringToMsgID("-1")
+	assert.NotNil(t, err)
+
+	assert.True(t, nc.EarliestMessageID().AtEarliestPosition())
+}
@@ -0,0 +1,164 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"sync"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+// Consumer reads the stream of the topic by an ordered consumer, which is an ephemeral JetStream consumer delivering
+// the messages in order without acks. The positions are kept by msgstream and the consumer seeks to them, like kafka.
+type Consumer struct {
+	js         nats.JetStreamContext
+	topic      string
+	subName    string
+	position   mqwrapper.SubscriptionInitialPosition
+	msgChannel chan mqwrapper.Message
+
+	subMu   sync.Mutex // guards sub and subDone
+	sub     *nats.Subscription
+	subDone chan struct{} // closed when sub is unsubscribed
+
+	closeCh   chan struct{}
+	chanOnce  sync.Once
+	closeOnce sync.Once
+}
+
+func newNatsConsumer(js nats.JetStreamContext, options mqwrapper.ConsumerOptions) *Consumer {
+	bufSize := options.BufSize
+	if bufSize <= 0 {
+		bufSize = 1024
+	}
+	return &Consumer{
+		js:         js,
+		topic:      options.Topic,
+		subName:    options.SubscriptionName,
+		position:   options.SubscriptionInitialPosition,
+		msgChannel: make(chan mqwrapper.Message, bufSize),
+		closeCh:    make(chan struct{}),
+	}
+}
+
+// subscribe replaces the current subscription by the one delivering from start
+func (nc *Consumer) subscribe(start nats.SubOpt) error {
+	nc.subMu.Lock()
+	defer nc.subMu.Unlock()
+	nc.unsubscribe()
+
+	done := make(chan struct{})
+	sub, err := nc.js.Subscribe(nc.topic, func(msg *nats.Msg) {
+		nc.deliver(msg, done)
+	}, nats.OrderedConsumer(), start)
+	if err != nil {
+		return err
+	}
+	nc.sub, nc.subDone = sub, done
+	return nil
+}
+
+// unsubscribe stops the current subscription, the caller must hold subMu
+func (nc *Consumer) unsubscribe() {
+	if nc.sub == nil {
+		return
+	}
+	close(nc.subDone)
+	if err := nc.sub.Unsubscribe(); err != nil {
+		log.Warn("nats consumer unsubscribe failed", zap.String("topic", nc.topic), zap.Error(err))
+	}
+	nc.sub, nc.subDone = nil, nil
+}
+
+// deliver pushes msg to the message channel unless the subscription is stopped
+func (nc *Consumer) deliver(msg *nats.Msg, done chan struct{}) {
+	meta, err := msg.Metadata()
+	if err != nil {
+		log.Warn("nats consumer get msg metadata failed", zap.String("topic", nc.topic), zap.Error(err))
+		return
+	}
+	select {
+	case nc.msgChannel <- &natsMessage{msg: msg, seq: meta.Sequence.Stream}:
+	case <-done:
+	case <-nc.closeCh:
+	}
+}
+
+func (nc *Consumer) Subscription() string {
+	return nc.subName
+}
+
+// Chan starts to deliver from the initial position if the consumer hasn't been seeked
+func (nc *Consumer) Chan() <-chan mqwrapper.Message {
+	nc.chanOnce.Do(func() {
+		nc.subMu.Lock()
+		started := nc.sub != nil
+		nc.subMu.Unlock()
+		if started {
+			return
+		}
+
+		start := nats.DeliverNew()
+		if nc.position == mqwrapper.SubscriptionPositionEarliest {
+			start = nats.DeliverAll()
+		}
+		if err := nc.subscribe(start); err != nil {
+			log.Error("nats consumer subscribe failed", zap.String("topic", nc.topic), zap.String("subName", nc.subName), zap.Error(err))
+			panic(err)
+		}
+	})
+	return nc.msgChannel
+}
+
+func (nc *Consumer) Seek(id mqwrapper.MessageID, inclusive bool) error {
+	seq := id.(*natsID).messageID
+	if !inclusive {
+		seq++
+	}
+	log.Debug("nats consumer seek", zap.String("topic", nc.topic), zap.Uint64("start seq", seq), zap.Bool("inclusive", inclusive))
+
+	start := nats.DeliverAll()
+	if seq > 1 {
+		start = nats.StartSequence(seq)
+	}
+	return nc.subscribe(start)
+}
+
+// Ack is a no-op, the ordered consumer doesn't ack messages
+func (nc *Consumer) Ack(message mqwrapper.Message) {
+}
+
+func (nc *Consumer) GetLatestMsgID() (mqwrapper.MessageID, error) {
+	info, err := nc.js.StreamInfo(nc.topic)
+	if err != nil {
+		return nil, err
+	}
+	return &natsID{messageID: info.State.LastSeq}, nil
+}
+
+func (nc *Consumer) Close() {
+	nc.closeOnce.Do(func() {
+		close(nc.closeCh)
+		nc.subMu.Lock()
+		defer nc.subMu.Unlock()
+		nc.unsubscribe()
+	})
+}
@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+func TestNatsConsumer_Latest(t *testing.T) {
+	nc := createNatsClient(t)
+	defer nc.Close()
+	ctx := context.Background()
+	topic := fmt.Sprintf("test-topic-%d", rand.Int())
+
+	producer, err := nc.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	assert.Nil(t, err)
+	defer producer.Close()
+	produceData(ctx, t, producer, []int{1, 2})
+
+	consumer, err := nc.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionLatest,
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+	msgChan := consumer.Chan()
+	select {
+	case <-msgChan:
+		assert.FailNow(t, "the messages before subscribing are consumed")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	produceData(ctx, t, producer, []int{3})
+	assert.Equal(t, []int{3}, consumeData(t, consumer, 1))
+}
+
+func TestNatsConsumer_GetLatestMsgID(t *testing.T) {
+	nc := createNatsClient(t)
+	defer nc.Close()
+	ctx := context.Background()
+	topic := fmt.Sprintf("test-topic-%d", rand.Int())
+
+	consumer, err := nc.Subscribe(mqwrapper.ConsumerOptions{Topic: topic, SubscriptionName: "sub"})
+	assert.Nil(t, err)
+	defer consumer.Close()
+	latestMsgID, err := consumer.GetLatestMsgID()
+	assert.Nil(t, err)
+	assert.True(t, latestMsgID.AtEarliestPosition())
+
+	producer, err := nc.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	assert.Nil(t, err)
+	defer producer.Close()
+	produceData(ctx, t, producer, []int{1, 2, 3})
+
+	latestMsgID, err = consumer.GetLatestMsgID()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), latestMsgID.(*natsID).messageID)
+}
+
+func TestNatsConsumer_Close(t *testing.T) {
+	nc := createNatsClient(t)
+	defer nc.Close()
+	topic := fmt.Sprintf("test-topic-%d", rand.Int())
+
+	consumer, err := nc.Subscribe(mqwrapper.ConsumerOptions{Topic: topic, SubscriptionName: "sub"})
+	assert.Nil(t, err)
+	consumer.Chan()
+	consumer.Close()
+	consumer.Close()
+	assert.Nil(t, consumer.(*Consumer).sub)
+}
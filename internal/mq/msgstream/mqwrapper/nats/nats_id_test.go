@@ -0,0 +1,64 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNatsID_Serialize(t *testing.T) {
+	nid := &natsID{messageID: 8}
+	bin := nid.Serialize()
+	assert.NotNil(t, bin)
+	assert.NotZero(t, len(bin))
+}
+
+func TestNatsID_AtEarliestPosition(t *testing.T) {
+	nid := &natsID{messageID: 8}
+	assert.False(t, nid.AtEarliestPosition())
+
+	nid = &natsID{messageID: 0}
+	assert.True(t, nid.AtEarliestPosition())
+}
+
+func TestNatsID_LessOrEqualThan(t *testing.T) {
+	nid1 := &natsID{messageID: 8}
+	nid2 := &natsID{messageID: 1}
+	ret, err := nid1.LessOrEqualThan(nid2.Serialize())
+	assert.Nil(t, err)
+	assert.False(t, ret)
+
+	ret, err = nid2.LessOrEqualThan(nid1.Serialize())
+	assert.Nil(t, err)
+	assert.True(t, ret)
+
+	ret, err = nid1.LessOrEqualThan(nid1.Serialize())
+	assert.Nil(t, err)
+	assert.True(t, ret)
+}
+
+func TestNatsID_Offset(t *testing.T) {
+	nid := &natsID{messageID: 8}
+	assert.Equal(t, int64(8), nid.Offset())
+}
+
+func Test_DeserializeNatsID(t *testing.T) {
+	bin := SerializeNatsID(5)
+	assert.Equal(t, uint64(5), DeserializeNatsID(bin))
+}
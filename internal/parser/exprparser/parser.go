@@ -0,0 +1,360 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package exprparser parses the boolean expressions of the plan parser of proxy into the ast of ant. It reads the
+// tokens of the lexer of ant, and parses the subset of the grammar of ant used by the filters, with the same
+// precedences, extended by the like operator and the null checks:
+//
+//	expr     := unary (binaryOp expr | like expr | is [not] null)*
+//	unary    := (not | ! | - | +) unary | primary
+//	primary  := literal | identifier | identifier(args) | [args] | (expr) followed by the indexes, e.g. a["b"][0]
+//
+// like is parsed as the binary node of LikeOperator, and the null checks as the unary nodes of IsNullOperator and
+// IsNotNullOperator. The keywords are matched in either lower or upper case, and they are only the operators at the
+// positions of operators, so the fields and the string literals could contain them.
+package exprparser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/antonmedv/expr/ast"
+	"github.com/antonmedv/expr/file"
+	"github.com/antonmedv/expr/parser/lexer"
+)
+
+const (
+	// LikeOperator is the operator of the binary nodes of like, e.g. name like "prefix%"
+	LikeOperator = "like"
+	// IsNullOperator is the operator of the unary nodes of is null, e.g. info["a"] is null
+	IsNullOperator = "is null"
+	// IsNotNullOperator is the operator of the unary nodes of is not null
+	IsNotNullOperator = "is not null"
+)
+
+type associativity int
+
+const (
+	left associativity = iota + 1
+	right
+)
+
+type operator struct {
+	precedence    int
+	associativity associativity
+}
+
+var unaryOperators = map[string]operator{
+	"not": {50, left},
+	"!":   {50, left},
+	"-":   {500, left},
+	"+":   {500, left},
+}
+
+var binaryOperators = map[string]operator{
+	"or":         {10, left},
+	"||":         {10, left},
+	"and":        {15, left},
+	"&&":         {15, left},
+	"==":         {20, left},
+	"!=":         {20, left},
+	"<":          {20, left},
+	">":          {20, left},
+	">=":         {20, left},
+	"<=":         {20, left},
+	"not in":     {20, left},
+	"in":         {20, left},
+	"contains":   {20, left},
+	"startsWith": {20, left},
+	"endsWith":   {20, left},
+	"+":          {30, left},
+	"-":          {30, left},
+	"*":          {60, left},
+	"/":          {60, left},
+	"%":          {60, left},
+	"**":         {70, right},
+}
+
+// likeOperator and nullCheck have the precedence of the comparisons
+var (
+	likeOperator = operator{20, left}
+	nullCheck    = operator{20, left}
+)
+
+type parser struct {
+	tokens  []lexer.Token
+	current lexer.Token
+	pos     int
+	err     *file.Error
+}
+
+// Parse returns the root node of the ast of the expression
+func Parse(input string) (ast.Node, error) {
+	source := file.NewSource(input)
+
+	tokens, err := lexer.Lex(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{
+		tokens:  tokens,
+		current: tokens[0],
+	}
+
+	node := p.parseExpression(0)
+
+	if !p.current.Is(lexer.EOF) {
+		p.error("unexpected token %v", p.current)
+	}
+
+	if p.err != nil {
+		return nil, p.err.Bind(source)
+	}
+	return node, nil
+}
+
+func (p *parser) error(format string, args ...interface{}) {
+	if p.err == nil {
+		p.err = &file.Error{
+			Location: p.current.Location,
+			Message:  fmt.Sprintf(format, args...),
+		}
+	}
+}
+
+func (p *parser) next() {
+	p.pos++
+	if p.pos >= len(p.tokens) {
+		p.error("unexpected end of expression")
+		return
+	}
+	p.current = p.tokens[p.pos]
+}
+
+func (p *parser) expect(kind lexer.Kind, values ...string) {
+	if p.current.Is(kind, values...) {
+		p.next()
+		return
+	}
+	p.error("unexpected token %v", p.current)
+}
+
+// isKeyword returns whether the token is the word in lower or upper case, the lexer of ant lexes not as an operator
+// and the others as identifiers
+func isKeyword(token lexer.Token, word string) bool {
+	if token.Kind != lexer.Identifier && token.Kind != lexer.Operator {
+		return false
+	}
+	return token.Value == word || token.Value == strings.ToUpper(word)
+}
+
+func (p *parser) parseExpression(precedence int) ast.Node {
+	nodeLeft := p.parsePrimary()
+
+	token := p.current
+	for p.err == nil {
+		if token.Is(lexer.Operator) {
+			op, ok := binaryOperators[token.Value]
+			if !ok || op.precedence < precedence {
+				break
+			}
+			p.next()
+			nodeLeft = p.parseBinary(token, token.Value, op, nodeLeft)
+		} else if isKeyword(token, "like") && likeOperator.precedence >= precedence {
+			p.next()
+			nodeLeft = p.parseBinary(token, LikeOperator, likeOperator, nodeLeft)
+		} else if isKeyword(token, "is") && nullCheck.precedence >= precedence {
+			p.next()
+			nodeLeft = p.parseNullCheck(token, nodeLeft)
+		} else {
+			break
+		}
+		token = p.current
+	}
+	return nodeLeft
+}
+
+func (p *parser) parseBinary(token lexer.Token, name string, op operator, nodeLeft ast.Node) ast.Node {
+	var nodeRight ast.Node
+	if op.associativity == left {
+		nodeRight = p.parseExpression(op.precedence + 1)
+	} else {
+		nodeRight = p.parseExpression(op.precedence)
+	}
+	node := &ast.BinaryNode{
+		Operator: name,
+		Left:     nodeLeft,
+		Right:    nodeRight,
+	}
+	node.SetLocation(token.Location)
+	return node
+}
+
+// parseNullCheck parses the rest of is [not] null, the token is is
+func (p *parser) parseNullCheck(token lexer.Token, nodeLeft ast.Node) ast.Node {
+	name := IsNullOperator
+	if isKeyword(p.current, "not") {
+		name = IsNotNullOperator
+		p.next()
+	}
+	if !isKeyword(p.current, "null") {
+		p.error("unexpected token %v, expect null", p.current)
+		return nodeLeft
+	}
+	p.next()
+	node := &ast.UnaryNode{
+		Operator: name,
+		Node:     nodeLeft,
+	}
+	node.SetLocation(token.Location)
+	return node
+}
+
+func (p *parser) parsePrimary() ast.Node {
+	token := p.current
+
+	if token.Is(lexer.Operator) {
+		if op, ok := unaryOperators[token.Value]; ok {
+			p.next()
+			expr := p.parseExpression(op.precedence)
+			node := &ast.UnaryNode{
+				Operator: token.Value,
+				Node:     expr,
+			}
+			node.SetLocation(token.Location)
+			return p.parsePostfixExpression(node)
+		}
+	}
+
+	if token.Is(lexer.Bracket, "(") {
+		p.next()
+		expr := p.parseExpression(0)
+		p.expect(lexer.Bracket, ")")
+		return p.parsePostfixExpression(expr)
+	}
+
+	return p.parsePrimaryExpression()
+}
+
+func (p *parser) parsePrimaryExpression() ast.Node {
+	var node ast.Node
+	token := p.current
+
+	switch token.Kind {
+	case lexer.Identifier:
+		p.next()
+		switch token.Value {
+		case "true":
+			node = &ast.BoolNode{Value: true}
+		case "false":
+			node = &ast.BoolNode{Value: false}
+		default:
+			if p.current.Is(lexer.Bracket, "(") {
+				node = &ast.FunctionNode{
+					Name:      token.Value,
+					Arguments: p.parseArguments(),
+				}
+			} else {
+				node = &ast.IdentifierNode{Value: token.Value}
+			}
+		}
+
+	case lexer.Number:
+		p.next()
+		node = p.parseNumber(token)
+
+	case lexer.String:
+		p.next()
+		node = &ast.StringNode{Value: token.Value}
+
+	default:
+		if !token.Is(lexer.Bracket, "[") {
+			p.error("unexpected token %v", token)
+			return &ast.NilNode{}
+		}
+		node = &ast.ArrayNode{Nodes: p.parseArrayElements()}
+	}
+
+	node.SetLocation(token.Location)
+	return p.parsePostfixExpression(node)
+}
+
+func (p *parser) parseNumber(token lexer.Token) ast.Node {
+	value := strings.Replace(token.Value, "_", "", -1)
+	// the prefixed integers are hex, octal or binary, the others are decimal even if they start with 0
+	base := 10
+	if len(value) > 1 && value[0] == '0' && strings.ContainsAny(value[1:2], "xXoObB") {
+		base = 0
+	} else if strings.ContainsAny(value, ".eE") {
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			p.error("invalid float literal: %v", err)
+		}
+		return &ast.FloatNode{Value: number}
+	}
+	number, err := strconv.ParseInt(value, base, 64)
+	if err != nil {
+		p.error("invalid integer literal: %v", err)
+	}
+	return &ast.IntegerNode{Value: int(number)}
+}
+
+func (p *parser) parseArrayElements() []ast.Node {
+	nodes := make([]ast.Node, 0)
+	p.expect(lexer.Bracket, "[")
+	for !p.current.Is(lexer.Bracket, "]") && p.err == nil {
+		if len(nodes) > 0 {
+			p.expect(lexer.Operator, ",")
+			if p.current.Is(lexer.Bracket, "]") {
+				break
+			}
+		}
+		nodes = append(nodes, p.parseExpression(0))
+	}
+	p.expect(lexer.Bracket, "]")
+	return nodes
+}
+
+func (p *parser) parseArguments() []ast.Node {
+	nodes := make([]ast.Node, 0)
+	p.expect(lexer.Bracket, "(")
+	for !p.current.Is(lexer.Bracket, ")") && p.err == nil {
+		if len(nodes) > 0 {
+			p.expect(lexer.Operator, ",")
+		}
+		nodes = append(nodes, p.parseExpression(0))
+	}
+	p.expect(lexer.Bracket, ")")
+	return nodes
+}
+
+// parsePostfixExpression parses the indexes following the node, e.g. the keys of the paths of JSON fields
+func (p *parser) parsePostfixExpression(node ast.Node) ast.Node {
+	for p.current.Is(lexer.Bracket, "[") && p.err == nil {
+		token := p.current
+		p.next()
+		index := p.parseExpression(0)
+		p.expect(lexer.Bracket, "]")
+		node = &ast.IndexNode{
+			Node:  node,
+			Index: index,
+		}
+		node.SetLocation(token.Location)
+	}
+	return node
+}
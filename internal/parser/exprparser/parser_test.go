@@ -0,0 +1,179 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exprparser
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/antonmedv/expr/ast"
+	"github.com/antonmedv/expr/file"
+	ant_parser "github.com/antonmedv/expr/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// show prints the node with the parentheses around every operation
+func show(node ast.Node) string {
+	switch n := node.(type) {
+	case *ast.IdentifierNode:
+		return n.Value
+	case *ast.IntegerNode:
+		return fmt.Sprint(n.Value)
+	case *ast.FloatNode:
+		return fmt.Sprint(n.Value)
+	case *ast.BoolNode:
+		return fmt.Sprint(n.Value)
+	case *ast.StringNode:
+		return fmt.Sprintf("%q", n.Value)
+	case *ast.UnaryNode:
+		if n.Operator == IsNullOperator || n.Operator == IsNotNullOperator {
+			return fmt.Sprintf("(%s %s)", show(n.Node), n.Operator)
+		}
+		return fmt.Sprintf("(%s %s)", n.Operator, show(n.Node))
+	case *ast.BinaryNode:
+		return fmt.Sprintf("(%s %s %s)", show(n.Left), n.Operator, show(n.Right))
+	case *ast.IndexNode:
+		return fmt.Sprintf("%s[%s]", show(n.Node), show(n.Index))
+	case *ast.FunctionNode:
+		args := make([]string, 0, len(n.Arguments))
+		for _, arg := range n.Arguments {
+			args = append(args, show(arg))
+		}
+		return fmt.Sprintf("%s(%s)", n.Name, strings.Join(args, ", "))
+	case *ast.ArrayNode:
+		nodes := make([]string, 0, len(n.Nodes))
+		for _, node := range n.Nodes {
+			nodes = append(nodes, show(node))
+		}
+		return fmt.Sprintf("[%s]", strings.Join(nodes, ", "))
+	}
+	return fmt.Sprintf("%T", node)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`a > 1 && b < 2 || not c == 3`, `(((a > 1) && (b < 2)) || ((not c) == 3))`},
+		{`a + b * c ** d ** 2 % 3 - -1`, `((a + ((b * (c ** (d ** 2))) % 3)) - (- 1))`},
+		{`a in [1, 2.5, "x", true,] and b not in []`, `((a in [1, 2.5, "x", true]) and (b not in []))`},
+		{`1 < a <= 2`, `((1 < a) <= 2)`},
+		{`0x1F + 010 + 1_000 + 1e3`, `(((31 + 10) + 1000) + 1000)`},
+		{`info["a"]["b"] == 'it\'s'`, `(info["a"]["b"] == "it's")`},
+		{`array_contains(arr, 1) or array_length(arr) > 2`, `(array_contains(arr, 1) or (array_length(arr) > 2))`},
+		{`name startsWith "a" && name endsWith "b"`, `((name startsWith "a") && (name endsWith "b"))`},
+		{`(a + 1) * 2 != b`, `(((a + 1) * 2) != b)`},
+		{`$min < a`, `($min < a)`},
+	}
+	for _, test := range tests {
+		node, err := Parse(test.expr)
+		require.NoError(t, err, test.expr)
+		assert.Equal(t, test.want, show(node), test.expr)
+
+		// the expressions of ant are parsed the same as ant
+		tree, err := ant_parser.Parse(test.expr)
+		require.NoError(t, err, test.expr)
+		assert.Equal(t, show(tree.Node), show(node), test.expr)
+	}
+}
+
+func TestParse_Like(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`name like "prefix%"`, `(name like "prefix%")`},
+		{`name LIKE "%postfix"`, `(name like "%postfix")`},
+		{`a > 1 and name like "a%" or b`, `(((a > 1) and (name like "a%")) or b)`},
+		{`not (name like "%")`, `(not (name like "%"))`},
+		// like in the string literals isn't an operator
+		{`name like "like%"`, `(name like "like%")`},
+		{`name == "a like b"`, `(name == "a like b")`},
+		{`name == 'it\'s like "x" LIKE'`, `(name == "it's like \"x\" LIKE")`},
+		{`name in ["like", 'LIKE'] && name like "l%"`, `((name in ["like", "LIKE"]) && (name like "l%"))`},
+		// nor in the names of fields
+		{`likes like "like%"`, `(likes like "like%")`},
+		{`like like "x"`, `(like like "x")`},
+		{`unlike > 1`, `(unlike > 1)`},
+		{`info["like"] == 1`, `(info["like"] == 1)`},
+		// contains written by users isn't like
+		{`name like "a%" && name contains "b"`, `((name like "a%") && (name contains "b"))`},
+	}
+	for _, test := range tests {
+		node, err := Parse(test.expr)
+		require.NoError(t, err, test.expr)
+		assert.Equal(t, test.want, show(node), test.expr)
+	}
+}
+
+func TestParse_Null(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`a is null`, `(a is null)`},
+		{`a IS NOT NULL`, `(a is not null)`},
+		{`info["a"]["b"] is not null`, `(info["a"]["b"] is not null)`},
+		{`a is null and b is not null or c > 1`, `(((a is null) and (b is not null)) or (c > 1))`},
+		{`not (a is null)`, `(not (a is null))`},
+		{`a == "is null" && b is null`, `((a == "is null") && (b is null))`},
+		{`is > 1 && null is null`, `((is > 1) && (null is null))`},
+	}
+	for _, test := range tests {
+		node, err := Parse(test.expr)
+		require.NoError(t, err, test.expr)
+		assert.Equal(t, test.want, show(node), test.expr)
+	}
+}
+
+func TestParse_Location(t *testing.T) {
+	node, err := Parse("a == \"中文\" &&\n\tb like \"a%\"")
+	require.NoError(t, err)
+	like := node.(*ast.BinaryNode).Right.(*ast.BinaryNode)
+	assert.Equal(t, LikeOperator, like.Operator)
+	assert.Equal(t, file.Location{Line: 2, Column: 3}, like.Location())
+}
+
+func TestParse_Invalid(t *testing.T) {
+	exprs := []string{
+		``,
+		`a >`,
+		`(a > 1`,
+		`a > 1)`,
+		`a like`,
+		`a is`,
+		`a is not`,
+		`a is nil`,
+		`a is not 1`,
+		`a[1`,
+		`f(a, `,
+		`[1, 2`,
+		`a > 1 ? 2 : 3`,
+		`a.b > 1`,
+		`a matches "x"`,
+		`"unterminated`,
+		`0xZZ > 1`,
+		`a > 99999999999999999999`,
+	}
+	for _, expr := range exprs {
+		_, err := Parse(expr)
+		assert.Error(t, err, expr)
+	}
+}
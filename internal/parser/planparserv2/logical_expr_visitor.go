@@ -14,4 +14,6 @@ type LogicalExprVisitor interface {
 	VisitBinaryArithExpr(expr *planpb.BinaryArithExpr) interface{}
 	VisitValueExpr(expr *planpb.ValueExpr) interface{}
 	VisitColumnExpr(expr *planpb.ColumnExpr) interface{}
+	VisitNullExpr(expr *planpb.NullExpr) interface{}
+	VisitArithCompareExpr(expr *planpb.ArithCompareExpr) interface{}
 }
@@ -47,6 +47,17 @@ func findLastNotOfWildcards(pattern string) int {
 	return loc
 }
 
+// findFirstNotOfWildcards find the first location not of leading wildcards.
+func findFirstNotOfWildcards(pattern string) int {
+	loc := 0
+	for ; loc < len(pattern); loc++ {
+		if _, ok := wildcards[pattern[loc]]; !ok {
+			break
+		}
+	}
+	return loc
+}
+
 // translatePatternMatch translates pattern to related op type and operand.
 func translatePatternMatch(pattern string) (op planpb.OpType, operand string, err error) {
 	l := len(pattern)
@@ -66,10 +77,22 @@ func translatePatternMatch(pattern string) (op planpb.OpType, operand string, er
 		// prefix match.
 		return planpb.OpType_PrefixMatch, pattern[:loc+1], nil
 	}
+	if loc >= l-1 {
+		first := findFirstNotOfWildcards(pattern)
+		if !hasWildcards(pattern[first:]) {
+			// postfix match.
+			return planpb.OpType_PostfixMatch, pattern[first:], nil
+		}
+	}
 
 	return planpb.OpType_Invalid, "", fmt.Errorf(
 		"unsupported pattern: %s, "+
-			"only prefix pattern match like %s "+
+			"only prefix pattern match like %s, postfix pattern match like %s "+
 			"and equal match like %s(no wildcards) are supported",
-		pattern, "ab%", "ab")
+		pattern, "ab%", "%ab", "ab")
+}
+
+// TranslatePatternMatch translates the pattern of like to related op type and operand, for the parsers out of this package.
+func TranslatePatternMatch(pattern string) (op planpb.OpType, operand string, err error) {
+	return translatePatternMatch(pattern)
 }
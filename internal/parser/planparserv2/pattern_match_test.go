@@ -115,6 +115,30 @@ func Test_translatePatternMatch(t *testing.T) {
 			wantOperand: "",
 			wantErr:     false,
 		},
+		{
+			args:        args{pattern: "%suffix"},
+			wantOp:      planpb.OpType_PostfixMatch,
+			wantOperand: "suffix",
+			wantErr:     false,
+		},
+		{
+			args:        args{pattern: "%%suffix"},
+			wantOp:      planpb.OpType_PostfixMatch,
+			wantOperand: "suffix",
+			wantErr:     false,
+		},
+		{
+			args:        args{pattern: "%infix%"},
+			wantOp:      planpb.OpType_Invalid,
+			wantOperand: "",
+			wantErr:     true,
+		},
+		{
+			args:        args{pattern: "%in%fix"},
+			wantOp:      planpb.OpType_Invalid,
+			wantOperand: "",
+			wantErr:     true,
+		},
 		{
 			args:        args{pattern: "prefix%suffix"},
 			wantOp:      planpb.OpType_Invalid,
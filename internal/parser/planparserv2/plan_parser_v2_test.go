@@ -118,6 +118,7 @@ func TestExpr_Like(t *testing.T) {
 	exprStrs := []string{
 		`VarCharField like "prefix%"`,
 		`VarCharField like "equal"`,
+		`VarCharField like "%postfix"`,
 	}
 	for _, exprStr := range exprStrs {
 		assertValidExpr(t, helper, exprStr)
@@ -126,6 +127,7 @@ func TestExpr_Like(t *testing.T) {
 	// TODO: enable these after regex-match is supported.
 	unsupported := []string{
 		`VarCharField like "not_%_supported"`,
+		`VarCharField like "%not_supported%"`,
 	}
 	for _, exprStr := range unsupported {
 		assertInvalidExpr(t, helper, exprStr)
@@ -171,25 +173,70 @@ func TestExpr_BinaryArith(t *testing.T) {
 	exprStrs := []string{
 		`Int64Field % 10 == 9`,
 		`Int64Field % 10 != 9`,
-	}
-	for _, exprStr := range exprStrs {
-		assertValidExpr(t, helper, exprStr)
-	}
-
-	// TODO: enable these after execution backend is ready.
-	unsupported := []string{
 		`Int8Field + 1 < 2`,
 		`Int16Field - 3 <= 4`,
 		`Int32Field * 5 > 6`,
 		`Int64Field / 7 >= 8`,
 		`FloatField + 11 < 12`,
 		`DoubleField - 13 < 14`,
+		`2 < Int8Field + 1`,
+		`Int8Field + Int16Field < 2`,
+		`10 - Int64Field == 0`,
+		`2.5 / DoubleField > 1`,
+		`Int8Field + Int16Field > Int32Field * 2`,
+		`(Int64Field + 1) % Int32Field == 0`,
+		`Int64Field * 2 <= FloatField`,
+		`Int8Field + FloatField > DoubleField`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	unsupported := []string{
+		`1 < Int8Field + 1 < 3`,
+		`Int8Field + 1 in [1, 2]`,
+		`FloatField % Int64Field == 0`,
+		`Int8Field + Int16Field == 1.5`,
+		`Int8Field + 1.5 > FloatField`,
+		`Int64Field + 1 > VarCharField`,
 	}
 	for _, exprStr := range unsupported {
 		assertInvalidExpr(t, helper, exprStr)
 	}
 }
 
+func TestExpr_ArithCompare(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	// the constant is always the right side, the comparison is reversed if it's written at the left
+	for _, exprStr := range []string{`10 - Int64Field == 0`, `0 == 10 - Int64Field`} {
+		expr, err := ParseExpr(helper, exprStr)
+		assert.NoError(t, err, exprStr)
+		arithCompare := expr.GetArithCompareExpr()
+		assert.NotNil(t, arithCompare, exprStr)
+		assert.Equal(t, planpb.OpType_Equal, arithCompare.GetOp())
+		assert.Equal(t, planpb.ArithOpType_Sub, arithCompare.GetLeft().GetBinaryArithExpr().GetOp())
+		assert.Equal(t, int64(0), arithCompare.GetRight().GetValueExpr().GetValue().GetInt64Val())
+	}
+
+	// the sides without constants are kept as written
+	expr, err := ParseExpr(helper, `DoubleField < 1 + 2 / Int8Field`)
+	assert.NoError(t, err)
+	arithCompare := expr.GetArithCompareExpr()
+	assert.Equal(t, planpb.OpType_LessThan, arithCompare.GetOp())
+	assert.Equal(t, schemapb.DataType_Double, arithCompare.GetLeft().GetColumnExpr().GetInfo().GetDataType())
+	assert.Equal(t, planpb.ArithOpType_Add, arithCompare.GetRight().GetBinaryArithExpr().GetOp())
+
+	expr, err = ParseExpr(helper, `Int8Field + Int16Field > Int32Field`)
+	assert.NoError(t, err)
+	arithCompare = expr.GetArithCompareExpr()
+	assert.Equal(t, planpb.OpType_GreaterThan, arithCompare.GetOp())
+	assert.Equal(t, planpb.ArithOpType_Add, arithCompare.GetLeft().GetBinaryArithExpr().GetOp())
+	assert.Equal(t, schemapb.DataType_Int32, arithCompare.GetRight().GetColumnExpr().GetInfo().GetDataType())
+}
+
 func TestExpr_Value(t *testing.T) {
 	schema := newTestSchema()
 	helper, err := typeutil.CreateSchemaHelper(schema)
@@ -59,6 +59,10 @@ func (v *ShowExprVisitor) VisitExpr(expr *planpb.Expr) interface{} {
 		js["expr"] = v.VisitValueExpr(realExpr.ValueExpr)
 	case *planpb.Expr_ColumnExpr:
 		js["expr"] = v.VisitColumnExpr(realExpr.ColumnExpr)
+	case *planpb.Expr_NullExpr:
+		js["expr"] = v.VisitNullExpr(realExpr.NullExpr)
+	case *planpb.Expr_ArithCompareExpr:
+		js["expr"] = v.VisitArithCompareExpr(realExpr.ArithCompareExpr)
 	default:
 		js["expr"] = ""
 	}
@@ -155,6 +159,24 @@ func (v *ShowExprVisitor) VisitColumnExpr(expr *planpb.ColumnExpr) interface{} {
 	return js
 }
 
+func (v *ShowExprVisitor) VisitNullExpr(expr *planpb.NullExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "null"
+	js["column_info"] = extractColumnInfo(expr.GetColumnInfo())
+	js["op"] = expr.Op.String()
+	js["path"] = expr.GetPath()
+	return js
+}
+
+func (v *ShowExprVisitor) VisitArithCompareExpr(expr *planpb.ArithCompareExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "arith_compare"
+	js["left_expr"] = v.VisitExpr(expr.GetLeft())
+	js["right_expr"] = v.VisitExpr(expr.GetRight())
+	js["op"] = expr.Op.String()
+	return js
+}
+
 func NewShowExprVisitor() LogicalExprVisitor {
 	return &ShowExprVisitor{}
 }
@@ -128,6 +128,10 @@ func calcDataType(left, right *ExprWithType, reverse bool) (schemapb.DataType, e
 	if reverse {
 		return getSameType(right.dataType, left.dataType)
 	}
+	if right.expr.GetValueExpr() == nil && typeutil.IsFloatingType(right.dataType) {
+		// the integer fields could be computed with the floating fields, but not with the floating constants
+		return getSameType(right.dataType, left.dataType)
+	}
 	return getSameType(left.dataType, right.dataType)
 }
 
@@ -201,21 +205,16 @@ func combineBinaryArithExpr(op planpb.OpType, arithOp planpb.ArithOpType, column
 
 func handleBinaryArithExpr(op planpb.OpType, arithExpr *planpb.BinaryArithExpr, valueExpr *planpb.ValueExpr) (*planpb.Expr, error) {
 	switch op {
-	case planpb.OpType_Equal, planpb.OpType_NotEqual:
+	case planpb.OpType_Equal, planpb.OpType_NotEqual,
+		planpb.OpType_GreaterThan, planpb.OpType_GreaterEqual, planpb.OpType_LessThan, planpb.OpType_LessEqual:
 		break
 	default:
-		// TODO: enable this after execution is ready.
 		return nil, fmt.Errorf("%s is not supported in execution backend", op)
 	}
 
 	leftExpr, leftValue := arithExpr.Left.GetColumnExpr(), arithExpr.Left.GetValueExpr()
 	rightExpr, rightValue := arithExpr.Right.GetColumnExpr(), arithExpr.Right.GetValueExpr()
 
-	if leftExpr != nil && rightExpr != nil {
-		// a + b == 3
-		return nil, fmt.Errorf("not supported to do arithmetic operations between multiple fields")
-	}
-
 	if leftValue != nil && rightValue != nil {
 		// 2 + 1 == 3
 		return nil, fmt.Errorf("unexpected, should be optimized already")
@@ -238,12 +237,28 @@ func handleBinaryArithExpr(op planpb.OpType, arithExpr *planpb.BinaryArithExpr,
 		switch arithExpr.GetOp() {
 		case planpb.ArithOpType_Add, planpb.ArithOpType_Mul:
 			return combineBinaryArithExpr(op, arithExpr.GetOp(), rightExpr.GetInfo(), leftValue.GetValue(), valueExpr.GetValue()), nil
-		default:
-			return nil, fmt.Errorf("todo")
 		}
-	} else {
-		// (a + b) / 2 == 3
-		return nil, fmt.Errorf("complicated arithmetic operations are not supported")
+	}
+	// a + b == 3
+	// 2 - a == 3
+	// (a + b) / 2 == 3
+	return combineArithCompareExpr(op, &planpb.Expr{
+		Expr: &planpb.Expr_BinaryArithExpr{BinaryArithExpr: arithExpr},
+	}, &planpb.Expr{
+		Expr: &planpb.Expr_ValueExpr{ValueExpr: valueExpr},
+	}), nil
+}
+
+// combineArithCompareExpr compares the arithmetic expressions evaluated row by row, e.g. a + b > c
+func combineArithCompareExpr(op planpb.OpType, left, right *planpb.Expr) *planpb.Expr {
+	return &planpb.Expr{
+		Expr: &planpb.Expr_ArithCompareExpr{
+			ArithCompareExpr: &planpb.ArithCompareExpr{
+				Left:  left,
+				Right: right,
+				Op:    op,
+			},
+		},
 	}
 }
 
@@ -284,6 +299,17 @@ func handleCompare(op planpb.OpType, left *ExprWithType, right *ExprWithType) (*
 	leftColumnInfo := toColumnInfo(left)
 	rightColumnInfo := toColumnInfo(right)
 
+	if left.expr.GetBinaryArithExpr() != nil || right.expr.GetBinaryArithExpr() != nil {
+		// a + b > c
+		if !typeutil.IsArithmetic(left.dataType) || !typeutil.IsArithmetic(right.dataType) {
+			return nil, fmt.Errorf("arithmetic expressions can only be compared with integer or floating expressions")
+		}
+		if op == planpb.OpType_Invalid {
+			return nil, fmt.Errorf("unsupported op type: %s", op)
+		}
+		return combineArithCompareExpr(op, left.expr, right.expr), nil
+	}
+
 	if leftColumnInfo == nil || rightColumnInfo == nil {
 		return nil, fmt.Errorf("only comparison between two fields is supported")
 	}
@@ -238,6 +238,9 @@ const (
 	MsgType_CreateAlias        MsgType = 108
 	MsgType_DropAlias          MsgType = 109
 	MsgType_AlterAlias         MsgType = 110
+	MsgType_RenameCollection   MsgType = 111
+	MsgType_AddCollectionField MsgType = 112
+	MsgType_AlterCollection    MsgType = 113
 	// DEFINITION REQUESTS: PARTITION
 	MsgType_CreatePartition   MsgType = 200
 	MsgType_DropPartition     MsgType = 201
@@ -300,6 +303,11 @@ const (
 	MsgType_DeleteCredential  MsgType = 1502
 	MsgType_UpdateCredential  MsgType = 1503
 	MsgType_ListCredUsernames MsgType = 1504
+	// Database
+	MsgType_CreateDatabase MsgType = 1800
+	MsgType_DropDatabase   MsgType = 1801
+	MsgType_AlterDatabase  MsgType = 1802
+	MsgType_ListDatabases  MsgType = 1803
 )
 
 var MsgType_name = map[int32]string{
@@ -315,6 +323,9 @@ var MsgType_name = map[int32]string{
 	108:  "CreateAlias",
 	109:  "DropAlias",
 	110:  "AlterAlias",
+	111:  "RenameCollection",
+	112:  "AddCollectionField",
+	113:  "AlterCollection",
 	200:  "CreatePartition",
 	201:  "DropPartition",
 	202:  "HasPartition",
@@ -369,6 +380,10 @@ var MsgType_name = map[int32]string{
 	1502: "DeleteCredential",
 	1503: "UpdateCredential",
 	1504: "ListCredUsernames",
+	1800: "CreateDatabase",
+	1801: "DropDatabase",
+	1802: "AlterDatabase",
+	1803: "ListDatabases",
 }
 
 var MsgType_value = map[string]int32{
@@ -384,6 +399,9 @@ var MsgType_value = map[string]int32{
 	"CreateAlias":              108,
 	"DropAlias":                109,
 	"AlterAlias":               110,
+	"RenameCollection":         111,
+	"AddCollectionField":       112,
+	"AlterCollection":          113,
 	"CreatePartition":          200,
 	"DropPartition":            201,
 	"HasPartition":             202,
@@ -438,6 +456,10 @@ var MsgType_value = map[string]int32{
 	"DeleteCredential":         1502,
 	"UpdateCredential":         1503,
 	"ListCredUsernames":        1504,
+	"CreateDatabase":           1800,
+	"DropDatabase":             1801,
+	"AlterDatabase":            1802,
+	"ListDatabases":            1803,
 }
 
 func (x MsgType) String() string {
@@ -975,115 +997,119 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 1755 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4b, 0x73, 0x1c, 0xb7,
-	0x11, 0xe6, 0xec, 0xae, 0xb8, 0x5c, 0x2c, 0x1f, 0x10, 0xf8, 0x10, 0x2d, 0xd1, 0x8e, 0xc2, 0x93,
-	0x8a, 0x55, 0x96, 0x92, 0xa8, 0x2a, 0x39, 0xf9, 0x40, 0xee, 0x90, 0xd4, 0x96, 0x48, 0x8a, 0xd9,
-	0x25, 0x65, 0x57, 0x0e, 0x51, 0x81, 0x33, 0xcd, 0x5d, 0x44, 0x18, 0x60, 0x02, 0x60, 0x28, 0x6e,
-	0x4e, 0x8e, 0xf3, 0x07, 0x12, 0x5d, 0x72, 0xcd, 0x0f, 0x48, 0x52, 0x79, 0x27, 0x3f, 0x21, 0xef,
-	0x73, 0x9c, 0xf7, 0x31, 0x3f, 0x20, 0x4f, 0x3f, 0x53, 0x8d, 0x99, 0x9d, 0x19, 0x49, 0xf6, 0xc9,
-	0x37, 0xf4, 0xd7, 0x8d, 0x0f, 0x8d, 0xee, 0x46, 0x37, 0xc8, 0x7c, 0xa4, 0x93, 0x44, 0xab, 0xdb,
-	0xa9, 0xd1, 0x4e, 0xb3, 0xe5, 0x44, 0xc8, 0x8b, 0xcc, 0xe6, 0xd2, 0xed, 0x5c, 0xb5, 0xf9, 0x88,
-	0xcc, 0x0e, 0x1d, 0x77, 0x99, 0x65, 0xaf, 0x11, 0x02, 0xc6, 0x68, 0xf3, 0x28, 0xd2, 0x31, 0xac,
-	0x07, 0x37, 0x83, 0x5b, 0x8b, 0x9f, 0x7b, 0xe5, 0xf6, 0x47, 0xec, 0xb9, 0xbd, 0x8b, 0x66, 0x3d,
-	0x1d, 0xc3, 0xa0, 0x03, 0xd3, 0x25, 0x5b, 0x23, 0xb3, 0x06, 0xb8, 0xd5, 0x6a, 0xbd, 0x71, 0x33,
-	0xb8, 0xd5, 0x19, 0x14, 0xd2, 0xe6, 0xe7, 0xc9, 0xfc, 0x7d, 0x98, 0x3c, 0xe4, 0x32, 0x83, 0x63,
-	0x2e, 0x0c, 0xa3, 0xa4, 0xf9, 0x18, 0x26, 0x9e, 0xbf, 0x33, 0xc0, 0x25, 0x5b, 0x21, 0x57, 0x2e,
-	0x50, 0x5d, 0x6c, 0xcc, 0x85, 0xcd, 0xbb, 0xa4, 0x7b, 0x1f, 0x26, 0x21, 0x77, 0xfc, 0x63, 0xb6,
-	0x31, 0xd2, 0x8a, 0xb9, 0xe3, 0x7e, 0xd7, 0xfc, 0xc0, 0xaf, 0x37, 0x37, 0x48, 0x6b, 0x47, 0xea,
-	0xb3, 0x8a, 0x32, 0xf0, 0xca, 0x82, 0xf2, 0x55, 0xd2, 0xde, 0x8e, 0x63, 0x03, 0xd6, 0xb2, 0x45,
-	0xd2, 0x10, 0x69, 0xc1, 0xd6, 0x10, 0x29, 0x92, 0xa5, 0xda, 0x38, 0x4f, 0xd6, 0x1c, 0xf8, 0xf5,
-	0xe6, 0xd3, 0x80, 0xb4, 0x0f, 0xed, 0x68, 0x87, 0x5b, 0x60, 0x5f, 0x20, 0x73, 0x89, 0x1d, 0x3d,
-	0x72, 0x93, 0x74, 0x1a, 0x9a, 0x8d, 0x8f, 0x0c, 0xcd, 0xa1, 0x1d, 0x9d, 0x4c, 0x52, 0x18, 0xb4,
-	0x93, 0x7c, 0x81, 0x9e, 0x24, 0x76, 0xd4, 0x0f, 0x0b, 0xe6, 0x5c, 0x60, 0x1b, 0xa4, 0xe3, 0x44,
-	0x02, 0xd6, 0xf1, 0x24, 0x5d, 0x6f, 0xde, 0x0c, 0x6e, 0xb5, 0x06, 0x15, 0xc0, 0xae, 0x93, 0x39,
-	0xab, 0x33, 0x13, 0x41, 0x3f, 0x5c, 0x6f, 0xf9, 0x6d, 0xa5, 0xbc, 0xf9, 0x1a, 0xe9, 0x1c, 0xda,
-	0xd1, 0x3d, 0xe0, 0x31, 0x18, 0xf6, 0x19, 0xd2, 0x3a, 0xe3, 0x36, 0xf7, 0xa8, 0xfb, 0xf1, 0x1e,
-	0xe1, 0x0d, 0x06, 0xde, 0x72, 0xf3, 0xcb, 0x64, 0x3e, 0x3c, 0x3c, 0xf8, 0x04, 0x0c, 0xe8, 0xba,
-	0x1d, 0x73, 0x13, 0x1f, 0xf1, 0x64, 0x9a, 0xb1, 0x0a, 0xd8, 0x7a, 0x3a, 0x4b, 0x3a, 0x65, 0x79,
-	0xb0, 0x2e, 0x69, 0x0f, 0xb3, 0x28, 0x02, 0x6b, 0xe9, 0x0c, 0x5b, 0x26, 0x4b, 0xa7, 0x0a, 0x2e,
-	0x53, 0x88, 0x1c, 0xc4, 0xde, 0x86, 0x06, 0xec, 0x2a, 0x59, 0xe8, 0x69, 0xa5, 0x20, 0x72, 0x7b,
-	0x5c, 0x48, 0x88, 0x69, 0x83, 0xad, 0x10, 0x7a, 0x0c, 0x26, 0x11, 0xd6, 0x0a, 0xad, 0x42, 0x50,
-	0x02, 0x62, 0xda, 0x64, 0xd7, 0xc8, 0x72, 0x4f, 0x4b, 0x09, 0x91, 0x13, 0x5a, 0x1d, 0x69, 0xb7,
-	0x7b, 0x29, 0xac, 0xb3, 0xb4, 0x85, 0xb4, 0x7d, 0x29, 0x61, 0xc4, 0xe5, 0xb6, 0x19, 0x65, 0x09,
-	0x28, 0x47, 0xaf, 0x20, 0x47, 0x01, 0x86, 0x22, 0x01, 0x85, 0x4c, 0xb4, 0x5d, 0x43, 0xfb, 0x2a,
-	0x86, 0x4b, 0xcc, 0x0f, 0x9d, 0x63, 0x2f, 0x91, 0xd5, 0x02, 0xad, 0x1d, 0xc0, 0x13, 0xa0, 0x1d,
-	0xb6, 0x44, 0xba, 0x85, 0xea, 0xe4, 0xc1, 0xf1, 0x7d, 0x4a, 0x6a, 0x0c, 0x03, 0xfd, 0x64, 0x00,
-	0x91, 0x36, 0x31, 0xed, 0xd6, 0x5c, 0x78, 0x08, 0x91, 0xd3, 0xa6, 0x1f, 0xd2, 0x79, 0x74, 0xb8,
-	0x00, 0x87, 0xc0, 0x4d, 0x34, 0x1e, 0x80, 0xcd, 0xa4, 0xa3, 0x0b, 0x8c, 0x92, 0xf9, 0x3d, 0x21,
-	0xe1, 0x48, 0xbb, 0x3d, 0x9d, 0xa9, 0x98, 0x2e, 0xb2, 0x45, 0x42, 0x0e, 0xc1, 0xf1, 0x22, 0x02,
-	0x4b, 0x78, 0x6c, 0x8f, 0x47, 0x63, 0x28, 0x00, 0xca, 0xd6, 0x08, 0xeb, 0x71, 0xa5, 0xb4, 0xeb,
-	0x19, 0xe0, 0x0e, 0xf6, 0xb4, 0x8c, 0xc1, 0xd0, 0xab, 0xe8, 0xce, 0x33, 0xb8, 0x90, 0x40, 0x59,
-	0x65, 0x1d, 0x82, 0x84, 0xd2, 0x7a, 0xb9, 0xb2, 0x2e, 0x70, 0xb4, 0x5e, 0x41, 0xe7, 0x77, 0x32,
-	0x21, 0x63, 0x1f, 0x92, 0x3c, 0x2d, 0xab, 0xe8, 0x63, 0xe1, 0xfc, 0xd1, 0x41, 0x7f, 0x78, 0x42,
-	0xd7, 0xd8, 0x2a, 0xb9, 0x5a, 0x20, 0x87, 0xe0, 0x8c, 0x88, 0x7c, 0xf0, 0xae, 0xa1, 0xab, 0x0f,
-	0x32, 0xf7, 0xe0, 0xfc, 0x10, 0x12, 0x6d, 0x26, 0x74, 0x1d, 0x13, 0xea, 0x99, 0xa6, 0x29, 0xa2,
-	0x2f, 0xe1, 0x09, 0xbb, 0x49, 0xea, 0x26, 0x55, 0x78, 0xe9, 0x75, 0x76, 0x83, 0x5c, 0x3b, 0x4d,
-	0x63, 0xee, 0xa0, 0x9f, 0xe0, 0x63, 0x3b, 0xe1, 0xf6, 0x31, 0x5e, 0x37, 0x33, 0x40, 0x6f, 0xb0,
-	0xeb, 0x64, 0xed, 0xd9, 0x5c, 0x94, 0xc1, 0xda, 0xc0, 0x8d, 0xf9, 0x6d, 0x7b, 0x06, 0x62, 0x50,
-	0x4e, 0x70, 0x39, 0xdd, 0xf8, 0x72, 0xc5, 0xfa, 0xa2, 0xf2, 0x15, 0x54, 0xe6, 0x37, 0x7f, 0x51,
-	0xf9, 0x29, 0xb6, 0x4e, 0x56, 0xf6, 0xc1, 0xbd, 0xa8, 0xb9, 0x89, 0x9a, 0x03, 0x61, 0xbd, 0xea,
-	0xd4, 0x82, 0xb1, 0x53, 0xcd, 0xa7, 0x19, 0x23, 0x8b, 0x47, 0xda, 0x0d, 0xb1, 0xf8, 0x0f, 0xfc,
-	0x73, 0xa2, 0x9b, 0x8c, 0x91, 0x85, 0x30, 0x1c, 0xc0, 0x57, 0x33, 0xb0, 0x6e, 0xc0, 0x23, 0xa0,
-	0xff, 0x68, 0x6f, 0xbd, 0x41, 0x88, 0x8f, 0x09, 0x36, 0x5a, 0xc0, 0x5d, 0x95, 0x74, 0xa4, 0x15,
-	0xd0, 0x19, 0x36, 0x4f, 0xe6, 0x4e, 0x95, 0xb0, 0x36, 0x83, 0x98, 0x06, 0x58, 0x0f, 0x7d, 0x75,
-	0x6c, 0xf4, 0x08, 0x5b, 0x15, 0x6d, 0xa0, 0x76, 0x4f, 0x28, 0x61, 0xc7, 0xfe, 0x25, 0x10, 0x32,
-	0x5b, 0x14, 0x46, 0x6b, 0xeb, 0xad, 0x80, 0xcc, 0x0f, 0x61, 0x84, 0x55, 0x9f, 0x93, 0xaf, 0x10,
-	0x5a, 0x97, 0x2b, 0xfa, 0x32, 0x1f, 0x01, 0xbe, 0xca, 0x7d, 0xa3, 0x9f, 0x08, 0x35, 0xa2, 0x0d,
-	0x64, 0x1b, 0x02, 0x97, 0x9e, 0xb9, 0x4b, 0xda, 0x7b, 0x32, 0xf3, 0xc7, 0xb4, 0xfc, 0xa1, 0x28,
-	0xa0, 0xd9, 0x15, 0x54, 0x85, 0x46, 0xa7, 0x29, 0xc4, 0x74, 0x96, 0x2d, 0x90, 0x4e, 0x9e, 0x35,
-	0xd4, 0xb5, 0xb7, 0xde, 0x26, 0xbe, 0x4f, 0xfa, 0x76, 0xb7, 0x40, 0x3a, 0xa7, 0x2a, 0x86, 0x73,
-	0xa1, 0x20, 0xa6, 0x33, 0xbe, 0xe4, 0xf2, 0x64, 0x55, 0xb9, 0x8f, 0x31, 0x02, 0x48, 0x56, 0xc3,
-	0x00, 0xeb, 0xe6, 0x1e, 0xb7, 0x35, 0xe8, 0x1c, 0xeb, 0x38, 0x04, 0x1b, 0x19, 0x71, 0x56, 0xdf,
-	0x3e, 0xc2, 0x7a, 0x1a, 0x8e, 0xf5, 0x93, 0x0a, 0xb3, 0x74, 0x8c, 0x27, 0xed, 0x83, 0x1b, 0x4e,
-	0xac, 0x83, 0xa4, 0xa7, 0xd5, 0xb9, 0x18, 0x59, 0x2a, 0xf0, 0xa4, 0x03, 0xcd, 0xe3, 0xda, 0xf6,
-	0xaf, 0x60, 0x25, 0x0f, 0x40, 0x02, 0xb7, 0x75, 0xd6, 0xc7, 0xfe, 0xd1, 0x79, 0x57, 0xb7, 0xa5,
-	0xe0, 0x96, 0x4a, 0xbc, 0x0a, 0x7a, 0x99, 0x8b, 0x09, 0x26, 0x65, 0x5b, 0x3a, 0x30, 0xb9, 0xac,
-	0xd8, 0x0a, 0x59, 0xca, 0xed, 0x8f, 0xb9, 0x71, 0xc2, 0x93, 0xfc, 0x32, 0xf0, 0xe9, 0x37, 0x3a,
-	0xad, 0xb0, 0x5f, 0x61, 0x8f, 0x9b, 0xbf, 0xc7, 0x6d, 0x05, 0xfd, 0x3a, 0x60, 0x6b, 0xe4, 0xea,
-	0xf4, 0x6a, 0x15, 0xfe, 0x9b, 0x80, 0x2d, 0x93, 0x45, 0xbc, 0x5a, 0x89, 0x59, 0xfa, 0x5b, 0x0f,
-	0xe2, 0x25, 0x6a, 0xe0, 0xef, 0x3c, 0x43, 0x71, 0x8b, 0x1a, 0xfe, 0x7b, 0x7f, 0x18, 0x32, 0x14,
-	0x45, 0x60, 0xe9, 0x3b, 0x01, 0x7a, 0x3a, 0x3d, 0xac, 0x80, 0xe9, 0xbb, 0xde, 0x10, 0x59, 0x4b,
-	0xc3, 0xf7, 0xbc, 0x61, 0xc1, 0x59, 0xa2, 0xef, 0x7b, 0xf4, 0x1e, 0x57, 0xb1, 0x3e, 0x3f, 0x2f,
-	0xd1, 0x0f, 0x02, 0xb6, 0x4e, 0x96, 0x71, 0xfb, 0x0e, 0x97, 0x5c, 0x45, 0x95, 0xfd, 0x87, 0x01,
-	0x5b, 0x25, 0xf4, 0xb9, 0xe3, 0x2c, 0x7d, 0xb3, 0xc1, 0xe8, 0x34, 0xbe, 0xbe, 0xf8, 0xe9, 0x77,
-	0x1b, 0x3e, 0x56, 0x85, 0x61, 0x8e, 0x7d, 0xaf, 0xc1, 0x16, 0xf3, 0xa0, 0xe7, 0xf2, 0xf7, 0x1b,
-	0xac, 0x4b, 0x66, 0xfb, 0xca, 0x82, 0x71, 0xf4, 0x9b, 0x58, 0x9f, 0xb3, 0xf9, 0x03, 0xa6, 0xdf,
-	0xc2, 0x67, 0x70, 0xc5, 0xd7, 0x27, 0x7d, 0xea, 0x15, 0x79, 0x93, 0xa5, 0xff, 0x6c, 0xfa, 0x08,
-	0xd4, 0x3b, 0xee, 0xbf, 0x9a, 0x78, 0xd2, 0x3e, 0xb8, 0xea, 0xd5, 0xd1, 0x7f, 0x37, 0xd9, 0x75,
-	0xb2, 0x3a, 0xc5, 0x7c, 0xff, 0x2b, 0xdf, 0xdb, 0x7f, 0x9a, 0x6c, 0x83, 0x5c, 0xc3, 0x66, 0x50,
-	0x96, 0x07, 0x6e, 0x12, 0xd6, 0x89, 0xc8, 0xd2, 0xff, 0x36, 0xd9, 0x0d, 0xb2, 0xb6, 0x0f, 0xae,
-	0x0c, 0x7b, 0x4d, 0xf9, 0xbf, 0x26, 0x5b, 0x20, 0x73, 0x03, 0x6c, 0x90, 0x70, 0x01, 0xf4, 0x9d,
-	0x26, 0xe6, 0x6e, 0x2a, 0x16, 0xee, 0xbc, 0xdb, 0xc4, 0x88, 0xbe, 0xce, 0x5d, 0x34, 0x0e, 0x93,
-	0xde, 0x98, 0x2b, 0x05, 0xd2, 0xd2, 0xf7, 0x9a, 0x18, 0xb7, 0x01, 0x24, 0xfa, 0x02, 0x6a, 0xf0,
-	0xfb, 0x38, 0xf8, 0x98, 0x37, 0xfe, 0x62, 0x06, 0x66, 0x52, 0x2a, 0x3e, 0x68, 0x62, 0x06, 0x72,
-	0xfb, 0x67, 0x35, 0x1f, 0x36, 0xd9, 0xcb, 0x64, 0x3d, 0x7f, 0xd3, 0xd3, 0xf8, 0xa3, 0x72, 0x04,
-	0x7d, 0x75, 0xae, 0xe9, 0x9b, 0xad, 0x92, 0x31, 0x04, 0xe9, 0x78, 0xb9, 0xef, 0xeb, 0x2d, 0xf4,
-	0x0b, 0xdf, 0x50, 0xd5, 0xcf, 0x2c, 0x7d, 0xab, 0x85, 0x89, 0xdb, 0x07, 0x37, 0x80, 0x54, 0x8a,
-	0x88, 0x5b, 0xfa, 0x0d, 0x8f, 0x14, 0xcc, 0x9e, 0xf2, 0x0f, 0x2d, 0xb6, 0x44, 0x48, 0xfe, 0xf4,
-	0x3c, 0xf0, 0xf6, 0x94, 0x0a, 0x27, 0xe4, 0x05, 0x98, 0x89, 0x47, 0xff, 0x58, 0x1e, 0x50, 0x6b,
-	0x50, 0xf4, 0x4f, 0x2d, 0x0c, 0xd9, 0x89, 0x48, 0xe0, 0x44, 0x44, 0x8f, 0xe9, 0x0f, 0x3a, 0x18,
-	0x32, 0x7f, 0xa3, 0x23, 0x1d, 0x03, 0xda, 0x58, 0xfa, 0xc3, 0x0e, 0xd6, 0x05, 0x96, 0x5b, 0x5e,
-	0x17, 0x3f, 0xf2, 0x72, 0xd1, 0x64, 0xfb, 0x21, 0xfd, 0x31, 0x4e, 0x6a, 0x52, 0xc8, 0x27, 0xc3,
-	0x07, 0xf4, 0x27, 0x1d, 0x3c, 0x6a, 0x5b, 0x4a, 0x1d, 0x71, 0x57, 0x16, 0xfd, 0x4f, 0x3b, 0xf8,
-	0x6a, 0x6a, 0xa7, 0x17, 0x59, 0xfb, 0x59, 0x07, 0x63, 0x5f, 0xe0, 0xbe, 0xa6, 0x42, 0x6c, 0x9b,
-	0x3f, 0xf7, 0xac, 0xf8, 0x01, 0x45, 0x4f, 0x4e, 0x1c, 0xfd, 0x85, 0xb7, 0x7b, 0x7e, 0xf8, 0xd0,
-	0x3f, 0x77, 0x8b, 0xfa, 0xaa, 0x61, 0x7f, 0xe9, 0xe6, 0xcf, 0xe0, 0xd9, 0x69, 0x43, 0xff, 0xea,
-	0xe1, 0xe7, 0x27, 0x14, 0xfd, 0x5b, 0x17, 0x1d, 0xab, 0x0f, 0x19, 0xc5, 0x13, 0xb0, 0xf4, 0xef,
-	0xdd, 0xad, 0x4d, 0xd2, 0x0e, 0xad, 0xf4, 0xad, 0xb5, 0x4d, 0x9a, 0xa1, 0x95, 0x74, 0x06, 0x3b,
-	0xd1, 0x8e, 0xd6, 0x72, 0xf7, 0x32, 0x35, 0x0f, 0x3f, 0x4b, 0x83, 0xad, 0x1d, 0xb2, 0xd4, 0xd3,
-	0x49, 0xca, 0xcb, 0x52, 0xf5, 0xdd, 0x34, 0x6f, 0xc3, 0x10, 0xe7, 0x61, 0x9e, 0xc1, 0x76, 0xb6,
-	0x7b, 0x09, 0x51, 0xe6, 0x9b, 0x76, 0x80, 0x22, 0x6e, 0x42, 0x07, 0x63, 0xda, 0xd8, 0x7a, 0x83,
-	0xd0, 0x9e, 0x56, 0x56, 0x58, 0x07, 0x2a, 0x9a, 0x1c, 0xc0, 0x05, 0x48, 0x3f, 0x1a, 0x9c, 0xd1,
-	0x6a, 0x44, 0x67, 0xfc, 0x4f, 0x0e, 0xfc, 0x8f, 0x2c, 0x1f, 0x20, 0x3b, 0x38, 0x8d, 0xfd, 0x77,
-	0x6d, 0x91, 0x90, 0xdd, 0x0b, 0x50, 0x2e, 0xe3, 0x52, 0x4e, 0x68, 0x13, 0xe5, 0x5e, 0x66, 0x9d,
-	0x4e, 0xc4, 0xd7, 0xfc, 0x88, 0xfa, 0x76, 0x40, 0xba, 0xf9, 0xb4, 0x28, 0x5d, 0xcb, 0xc5, 0x63,
-	0x50, 0xb1, 0xf0, 0xe4, 0xf8, 0xdb, 0xf0, 0x50, 0x31, 0xd7, 0x82, 0xca, 0x68, 0xe8, 0xb8, 0x71,
-	0xd3, 0x6f, 0x61, 0x0e, 0x85, 0xfa, 0x89, 0x92, 0x9a, 0xc7, 0x7e, 0x64, 0x95, 0x5b, 0x8f, 0xb9,
-	0xb1, 0x7e, 0x6e, 0xe1, 0x67, 0xac, 0xe0, 0x37, 0xfe, 0x3e, 0x31, 0xbd, 0x52, 0x81, 0xd5, 0x9d,
-	0x67, 0x77, 0x5e, 0x27, 0x8b, 0x42, 0x4f, 0x7f, 0xbc, 0x23, 0x93, 0x46, 0x3b, 0xdd, 0x9e, 0xff,
-	0xf1, 0x1e, 0xe3, 0xef, 0xf7, 0x38, 0xf8, 0xd2, 0xdd, 0x91, 0x70, 0xe3, 0xec, 0x0c, 0xff, 0xc1,
-	0x77, 0x72, 0xb3, 0x57, 0x85, 0x2e, 0x56, 0x77, 0x84, 0x72, 0x98, 0x27, 0x79, 0xc7, 0xff, 0x95,
-	0xef, 0xe4, 0x7f, 0xe5, 0xf4, 0xec, 0x3b, 0x41, 0x70, 0x36, 0xeb, 0xa1, 0xbb, 0xff, 0x0f, 0x00,
-	0x00, 0xff, 0xff, 0xeb, 0x8d, 0xa6, 0x5d, 0x7f, 0x0d, 0x00, 0x00,
+	// 1811 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4b, 0x73, 0x1c, 0x49,
+	0x11, 0x56, 0xcf, 0x8c, 0x35, 0x9e, 0x1a, 0x69, 0x94, 0x2e, 0x3d, 0xac, 0xb5, 0xb5, 0x8b, 0xd1,
+	0xc9, 0xa1, 0x88, 0xb5, 0x01, 0x47, 0xc0, 0x69, 0x0f, 0xd2, 0x8c, 0x24, 0x4f, 0x58, 0x92, 0xc5,
+	0x8c, 0xe4, 0xdd, 0xe0, 0x80, 0xa3, 0xd4, 0x9d, 0x1a, 0x15, 0xee, 0xae, 0x9a, 0xad, 0xaa, 0x96,
+	0x35, 0x9c, 0x96, 0xe5, 0xc2, 0xe3, 0x02, 0xbe, 0x70, 0xe5, 0x07, 0x00, 0xc1, 0x1b, 0x7e, 0x02,
+	0xef, 0x33, 0x6f, 0x38, 0x72, 0xe0, 0xc8, 0x73, 0x9f, 0x44, 0x56, 0xf7, 0x74, 0xb7, 0xed, 0xdd,
+	0x13, 0xb7, 0xce, 0x2f, 0x33, 0xbf, 0xcc, 0xce, 0xcc, 0xca, 0x2a, 0x36, 0x17, 0xea, 0x24, 0xd1,
+	0xea, 0xd6, 0xd8, 0x68, 0xa7, 0xf9, 0x62, 0x22, 0xe3, 0xf3, 0xd4, 0x66, 0xd2, 0xad, 0x4c, 0xb5,
+	0xfe, 0x90, 0xcd, 0x0e, 0x9d, 0x70, 0xa9, 0xe5, 0xaf, 0x30, 0x86, 0xc6, 0x68, 0xf3, 0x30, 0xd4,
+	0x11, 0xae, 0x06, 0x37, 0x82, 0x9b, 0x9d, 0x4f, 0xbc, 0x74, 0xeb, 0x03, 0x7c, 0x6e, 0x6d, 0x93,
+	0x59, 0x57, 0x47, 0x38, 0x68, 0xe1, 0xf4, 0x93, 0xaf, 0xb0, 0x59, 0x83, 0xc2, 0x6a, 0xb5, 0x5a,
+	0xbb, 0x11, 0xdc, 0x6c, 0x0d, 0x72, 0x69, 0xfd, 0x93, 0x6c, 0xee, 0x1e, 0x4e, 0x1e, 0x88, 0x38,
+	0xc5, 0x43, 0x21, 0x0d, 0x07, 0x56, 0x7f, 0x84, 0x13, 0xcf, 0xdf, 0x1a, 0xd0, 0x27, 0x5f, 0x62,
+	0x97, 0xce, 0x49, 0x9d, 0x3b, 0x66, 0xc2, 0xfa, 0x1d, 0xd6, 0xbe, 0x87, 0x93, 0x9e, 0x70, 0xe2,
+	0x43, 0xdc, 0x38, 0x6b, 0x44, 0xc2, 0x09, 0xef, 0x35, 0x37, 0xf0, 0xdf, 0xeb, 0x6b, 0xac, 0xb1,
+	0x15, 0xeb, 0x93, 0x92, 0x32, 0xf0, 0xca, 0x9c, 0xf2, 0x65, 0xd6, 0xdc, 0x8c, 0x22, 0x83, 0xd6,
+	0xf2, 0x0e, 0xab, 0xc9, 0x71, 0xce, 0x56, 0x93, 0x63, 0x22, 0x1b, 0x6b, 0xe3, 0x3c, 0x59, 0x7d,
+	0xe0, 0xbf, 0xd7, 0x9f, 0x04, 0xac, 0xb9, 0x6f, 0x47, 0x5b, 0xc2, 0x22, 0xff, 0x14, 0xbb, 0x9c,
+	0xd8, 0xd1, 0x43, 0x37, 0x19, 0x4f, 0x4b, 0xb3, 0xf6, 0x81, 0xa5, 0xd9, 0xb7, 0xa3, 0xa3, 0xc9,
+	0x18, 0x07, 0xcd, 0x24, 0xfb, 0xa0, 0x4c, 0x12, 0x3b, 0xea, 0xf7, 0x72, 0xe6, 0x4c, 0xe0, 0x6b,
+	0xac, 0xe5, 0x64, 0x82, 0xd6, 0x89, 0x64, 0xbc, 0x5a, 0xbf, 0x11, 0xdc, 0x6c, 0x0c, 0x4a, 0x80,
+	0x5f, 0x63, 0x97, 0xad, 0x4e, 0x4d, 0x88, 0xfd, 0xde, 0x6a, 0xc3, 0xbb, 0x15, 0xf2, 0xfa, 0x2b,
+	0xac, 0xb5, 0x6f, 0x47, 0x77, 0x51, 0x44, 0x68, 0xf8, 0xc7, 0x58, 0xe3, 0x44, 0xd8, 0x2c, 0xa3,
+	0xf6, 0x87, 0x67, 0x44, 0x7f, 0x30, 0xf0, 0x96, 0xeb, 0x9f, 0x65, 0x73, 0xbd, 0xfd, 0xbd, 0xff,
+	0x83, 0x81, 0x52, 0xb7, 0x67, 0xc2, 0x44, 0x07, 0x22, 0x99, 0x76, 0xac, 0x04, 0x36, 0x9e, 0xcc,
+	0xb2, 0x56, 0x31, 0x1e, 0xbc, 0xcd, 0x9a, 0xc3, 0x34, 0x0c, 0xd1, 0x5a, 0x98, 0xe1, 0x8b, 0x6c,
+	0xe1, 0x58, 0xe1, 0xc5, 0x18, 0x43, 0x87, 0x91, 0xb7, 0x81, 0x80, 0x5f, 0x61, 0xf3, 0x5d, 0xad,
+	0x14, 0x86, 0x6e, 0x47, 0xc8, 0x18, 0x23, 0xa8, 0xf1, 0x25, 0x06, 0x87, 0x68, 0x12, 0x69, 0xad,
+	0xd4, 0xaa, 0x87, 0x4a, 0x62, 0x04, 0x75, 0x7e, 0x95, 0x2d, 0x76, 0x75, 0x1c, 0x63, 0xe8, 0xa4,
+	0x56, 0x07, 0xda, 0x6d, 0x5f, 0x48, 0xeb, 0x2c, 0x34, 0x88, 0xb6, 0x1f, 0xc7, 0x38, 0x12, 0xf1,
+	0xa6, 0x19, 0xa5, 0x09, 0x2a, 0x07, 0x97, 0x88, 0x23, 0x07, 0x7b, 0x32, 0x41, 0x45, 0x4c, 0xd0,
+	0xac, 0xa0, 0x7d, 0x15, 0xe1, 0x05, 0xf5, 0x07, 0x2e, 0xf3, 0x17, 0xd8, 0x72, 0x8e, 0x56, 0x02,
+	0x88, 0x04, 0xa1, 0xc5, 0x17, 0x58, 0x3b, 0x57, 0x1d, 0xdd, 0x3f, 0xbc, 0x07, 0xac, 0xc2, 0x30,
+	0xd0, 0x8f, 0x07, 0x18, 0x6a, 0x13, 0x41, 0xbb, 0x92, 0xc2, 0x03, 0x0c, 0x9d, 0x36, 0xfd, 0x1e,
+	0xcc, 0x51, 0xc2, 0x39, 0x38, 0x44, 0x61, 0xc2, 0xb3, 0x01, 0xda, 0x34, 0x76, 0x30, 0xcf, 0x81,
+	0xcd, 0xed, 0xc8, 0x18, 0x0f, 0xb4, 0xdb, 0xd1, 0xa9, 0x8a, 0xa0, 0xc3, 0x3b, 0x8c, 0xed, 0xa3,
+	0x13, 0x79, 0x05, 0x16, 0x28, 0x6c, 0x57, 0x84, 0x67, 0x98, 0x03, 0xc0, 0x57, 0x18, 0xef, 0x0a,
+	0xa5, 0xb4, 0xeb, 0x1a, 0x14, 0x0e, 0x77, 0x74, 0x1c, 0xa1, 0x81, 0x2b, 0x94, 0xce, 0x53, 0xb8,
+	0x8c, 0x11, 0x78, 0x69, 0xdd, 0xc3, 0x18, 0x0b, 0xeb, 0xc5, 0xd2, 0x3a, 0xc7, 0xc9, 0x7a, 0x89,
+	0x92, 0xdf, 0x4a, 0x65, 0x1c, 0xf9, 0x92, 0x64, 0x6d, 0x59, 0xa6, 0x1c, 0xf3, 0xe4, 0x0f, 0xf6,
+	0xfa, 0xc3, 0x23, 0x58, 0xe1, 0xcb, 0xec, 0x4a, 0x8e, 0xec, 0xa3, 0x33, 0x32, 0xf4, 0xc5, 0xbb,
+	0x4a, 0xa9, 0xde, 0x4f, 0xdd, 0xfd, 0xd3, 0x7d, 0x4c, 0xb4, 0x99, 0xc0, 0x2a, 0x35, 0xd4, 0x33,
+	0x4d, 0x5b, 0x04, 0x2f, 0x50, 0x84, 0xed, 0x64, 0xec, 0x26, 0x65, 0x79, 0xe1, 0x1a, 0xbf, 0xce,
+	0xae, 0x1e, 0x8f, 0x23, 0xe1, 0xb0, 0x9f, 0xd0, 0x61, 0x3b, 0x12, 0xf6, 0x11, 0xfd, 0x6e, 0x6a,
+	0x10, 0xae, 0xf3, 0x6b, 0x6c, 0xe5, 0xe9, 0x5e, 0x14, 0xc5, 0x5a, 0x23, 0xc7, 0xec, 0x6f, 0xbb,
+	0x06, 0x23, 0x54, 0x4e, 0x8a, 0x78, 0xea, 0xf8, 0x62, 0xc9, 0xfa, 0xbc, 0xf2, 0x25, 0x52, 0x66,
+	0x7f, 0xfe, 0xbc, 0xf2, 0x23, 0x7c, 0x95, 0x2d, 0xed, 0xa2, 0x7b, 0x5e, 0x73, 0x83, 0x34, 0x7b,
+	0xd2, 0x7a, 0xd5, 0xb1, 0x45, 0x63, 0xa7, 0x9a, 0x8f, 0x72, 0xce, 0x3a, 0x07, 0xda, 0x0d, 0x69,
+	0xf8, 0xf7, 0xfc, 0x71, 0x82, 0x75, 0xce, 0xd9, 0x7c, 0xaf, 0x37, 0xc0, 0xd7, 0x53, 0xb4, 0x6e,
+	0x20, 0x42, 0x84, 0xbf, 0x35, 0x37, 0x5e, 0x63, 0xcc, 0xd7, 0x84, 0x16, 0x2d, 0x92, 0x57, 0x29,
+	0x1d, 0x68, 0x85, 0x30, 0xc3, 0xe7, 0xd8, 0xe5, 0x63, 0x25, 0xad, 0x4d, 0x31, 0x82, 0x80, 0xe6,
+	0xa1, 0xaf, 0x0e, 0x8d, 0x1e, 0xd1, 0xaa, 0x82, 0x1a, 0x69, 0x77, 0xa4, 0x92, 0xf6, 0xcc, 0x9f,
+	0x04, 0xc6, 0x66, 0xf3, 0xc1, 0x68, 0x6c, 0xbc, 0x19, 0xb0, 0xb9, 0x21, 0x8e, 0x68, 0xea, 0x33,
+	0xf2, 0x25, 0x06, 0x55, 0xb9, 0xa4, 0x2f, 0xfa, 0x11, 0xd0, 0xa9, 0xdc, 0x35, 0xfa, 0xb1, 0x54,
+	0x23, 0xa8, 0x11, 0xdb, 0x10, 0x45, 0xec, 0x99, 0xdb, 0xac, 0xb9, 0x13, 0xa7, 0x3e, 0x4c, 0xc3,
+	0x07, 0x25, 0x81, 0xcc, 0x2e, 0x91, 0xaa, 0x67, 0xf4, 0x78, 0x8c, 0x11, 0xcc, 0xf2, 0x79, 0xd6,
+	0xca, 0xba, 0x46, 0xba, 0xe6, 0xc6, 0xdf, 0xdb, 0x7e, 0x4f, 0xfa, 0x75, 0x37, 0xcf, 0x5a, 0xc7,
+	0x2a, 0xc2, 0x53, 0xa9, 0x30, 0x82, 0x19, 0x3f, 0x72, 0x59, 0xb3, 0xca, 0xde, 0x47, 0x54, 0x01,
+	0x22, 0xab, 0x60, 0x48, 0x73, 0x73, 0x57, 0xd8, 0x0a, 0x74, 0x4a, 0x73, 0xdc, 0x43, 0x1b, 0x1a,
+	0x79, 0x52, 0x75, 0x1f, 0xd1, 0x3c, 0x0d, 0xcf, 0xf4, 0xe3, 0x12, 0xb3, 0x70, 0x46, 0x91, 0x76,
+	0xd1, 0x0d, 0x27, 0xd6, 0x61, 0xd2, 0xd5, 0xea, 0x54, 0x8e, 0x2c, 0x48, 0x8a, 0xb4, 0xa7, 0x45,
+	0x54, 0x71, 0xff, 0x1c, 0x4d, 0xf2, 0x00, 0x63, 0x14, 0xb6, 0xca, 0xfa, 0xc8, 0x1f, 0x3a, 0x9f,
+	0xea, 0x66, 0x2c, 0x85, 0x85, 0x98, 0x7e, 0x85, 0xb2, 0xcc, 0xc4, 0x84, 0x9a, 0xb2, 0x19, 0x3b,
+	0x34, 0x99, 0xac, 0x28, 0xe0, 0x00, 0x95, 0x48, 0xaa, 0x2c, 0x9a, 0x72, 0xde, 0x8c, 0x2a, 0xf1,
+	0x76, 0x24, 0xc6, 0x11, 0x8c, 0x29, 0x67, 0xef, 0x5d, 0x31, 0x7e, 0x9d, 0x2f, 0xb1, 0x85, 0x2c,
+	0xe4, 0xa1, 0x30, 0x4e, 0x7a, 0xf0, 0x67, 0x81, 0x9f, 0x20, 0xa3, 0xc7, 0x25, 0xf6, 0x73, 0x5a,
+	0x93, 0x73, 0x77, 0x85, 0x2d, 0xa1, 0x5f, 0x04, 0x7c, 0x85, 0x5d, 0x99, 0x56, 0xa7, 0xc4, 0x7f,
+	0x19, 0xf0, 0x45, 0xd6, 0xa1, 0xea, 0x14, 0x98, 0x85, 0x5f, 0x79, 0x90, 0xea, 0x50, 0x01, 0x7f,
+	0xed, 0x19, 0xf2, 0x42, 0x54, 0xf0, 0xdf, 0xf8, 0x60, 0xc4, 0x90, 0xcf, 0x91, 0x85, 0xb7, 0x02,
+	0xca, 0x74, 0x1a, 0x2c, 0x87, 0xe1, 0x6d, 0x6f, 0x48, 0xac, 0x85, 0xe1, 0x3b, 0xde, 0x30, 0xe7,
+	0x2c, 0xd0, 0x77, 0x3d, 0x7a, 0x57, 0xa8, 0x48, 0x9f, 0x9e, 0x16, 0xe8, 0x7b, 0x01, 0x5f, 0x65,
+	0x8b, 0xe4, 0xbe, 0x25, 0x62, 0xa1, 0xc2, 0xd2, 0xfe, 0xfd, 0x80, 0x2f, 0x33, 0x78, 0x26, 0x9c,
+	0x85, 0x37, 0x6a, 0x1c, 0xa6, 0x2d, 0xf2, 0xe7, 0x07, 0xbe, 0x55, 0xf3, 0xb5, 0xca, 0x0d, 0x33,
+	0xec, 0xdb, 0x35, 0xde, 0xc9, 0xfa, 0x96, 0xc9, 0xdf, 0xa9, 0xf1, 0x36, 0x9b, 0xed, 0x2b, 0x8b,
+	0xc6, 0xc1, 0xd7, 0x68, 0xc4, 0x67, 0xb3, 0x1d, 0x00, 0x5f, 0xa7, 0x93, 0x74, 0xc9, 0x8f, 0x38,
+	0x3c, 0xf1, 0x8a, 0x6c, 0x4f, 0xc3, 0x3f, 0xea, 0xbe, 0x02, 0xd5, 0xa5, 0xfd, 0xcf, 0x3a, 0x45,
+	0xda, 0x45, 0x57, 0x1e, 0x5c, 0xf8, 0x57, 0x9d, 0x5f, 0x63, 0xcb, 0x53, 0xcc, 0xaf, 0xd0, 0xe2,
+	0xc8, 0xfe, 0xbb, 0xce, 0xd7, 0xd8, 0x55, 0xda, 0x27, 0x45, 0xbb, 0xc9, 0x49, 0x5a, 0x27, 0x43,
+	0x0b, 0xff, 0xa9, 0xf3, 0xeb, 0x6c, 0x65, 0x17, 0x5d, 0x51, 0xf6, 0x8a, 0xf2, 0xbf, 0x75, 0x3e,
+	0xcf, 0x2e, 0x0f, 0x68, 0xc7, 0xe2, 0x39, 0xc2, 0x5b, 0x75, 0xea, 0xdd, 0x54, 0xcc, 0xd3, 0x79,
+	0xbb, 0x4e, 0x15, 0x7d, 0x55, 0xb8, 0xf0, 0xac, 0x97, 0x74, 0xcf, 0x84, 0x52, 0x18, 0x5b, 0x78,
+	0xa7, 0x4e, 0x75, 0x1b, 0x60, 0xa2, 0xcf, 0xb1, 0x02, 0xbf, 0x4b, 0x77, 0x27, 0xf7, 0xc6, 0x9f,
+	0x4e, 0xd1, 0x4c, 0x0a, 0xc5, 0x7b, 0x75, 0xea, 0x40, 0x66, 0xff, 0xb4, 0xe6, 0xfd, 0x3a, 0x7f,
+	0x91, 0xad, 0x66, 0x6b, 0x61, 0x5a, 0x7f, 0x52, 0x8e, 0xb0, 0xaf, 0x4e, 0x35, 0xbc, 0xd1, 0x28,
+	0x18, 0x7b, 0x18, 0x3b, 0x51, 0xf8, 0x7d, 0xa1, 0x41, 0x79, 0xed, 0x62, 0x75, 0x25, 0x5a, 0x78,
+	0xb3, 0x41, 0x8d, 0xdb, 0x45, 0x37, 0xc0, 0x71, 0x2c, 0x43, 0x61, 0xe1, 0x8b, 0x1e, 0xc9, 0x99,
+	0x3d, 0xe5, 0x6f, 0x1b, 0x7c, 0x81, 0xb1, 0xec, 0xf4, 0x7a, 0xe0, 0x77, 0x53, 0x2a, 0xba, 0x64,
+	0xcf, 0xd1, 0x4c, 0x3c, 0xfa, 0xfb, 0x22, 0x40, 0x65, 0xc7, 0xc1, 0x1f, 0x1a, 0x54, 0xb2, 0x23,
+	0x99, 0xe0, 0x91, 0x0c, 0x1f, 0xc1, 0x77, 0x5b, 0x54, 0x32, 0xff, 0x47, 0x07, 0x3a, 0x42, 0xb2,
+	0xb1, 0xf0, 0xbd, 0x16, 0xcd, 0x05, 0x8d, 0x5b, 0x36, 0x17, 0xdf, 0xf7, 0x72, 0xbe, 0xa7, 0xfb,
+	0x3d, 0xf8, 0x01, 0x5d, 0xf6, 0x2c, 0x97, 0x8f, 0x86, 0xf7, 0xe1, 0x87, 0x2d, 0x0a, 0xb5, 0x19,
+	0xc7, 0x3a, 0x14, 0xae, 0x18, 0xfa, 0x1f, 0xb5, 0xe8, 0xd4, 0x54, 0xa2, 0xe7, 0x5d, 0xfb, 0x71,
+	0x8b, 0x6a, 0x9f, 0xe3, 0x7e, 0xa6, 0x7a, 0xb4, 0x79, 0x7f, 0xe2, 0x59, 0xe9, 0x0d, 0x4b, 0x99,
+	0x1c, 0x39, 0xf8, 0xa9, 0xb7, 0x7b, 0xf6, 0xfe, 0x82, 0x3f, 0xb6, 0xf3, 0xf9, 0xaa, 0x60, 0x7f,
+	0x6a, 0x67, 0xc7, 0xe0, 0xe9, 0x0b, 0x0b, 0xfe, 0xec, 0xe1, 0x67, 0x2f, 0x39, 0xf8, 0x4b, 0x9b,
+	0x12, 0xab, 0xde, 0x53, 0xb4, 0x9a, 0x2c, 0xfc, 0x95, 0x5e, 0x27, 0x9d, 0x2c, 0x20, 0xe5, 0x41,
+	0x4f, 0x38, 0xf8, 0x52, 0x87, 0x26, 0x9c, 0x0e, 0x49, 0x01, 0x7d, 0xb9, 0x43, 0x19, 0xf8, 0x15,
+	0x55, 0x60, 0x5f, 0xf1, 0x18, 0x71, 0x4e, 0x21, 0x0b, 0x5f, 0xed, 0x6c, 0xac, 0xb3, 0x66, 0xcf,
+	0xc6, 0x7e, 0xdb, 0x37, 0x59, 0xbd, 0x67, 0x63, 0x98, 0xa1, 0xe5, 0xb8, 0xa5, 0x75, 0xbc, 0x7d,
+	0x31, 0x36, 0x0f, 0x3e, 0x0e, 0xc1, 0xc6, 0x16, 0x5b, 0xe8, 0xea, 0x64, 0x2c, 0x8a, 0xd1, 0xf7,
+	0x0b, 0x3e, 0xbb, 0x19, 0x30, 0xca, 0xda, 0x36, 0x43, 0x1b, 0x76, 0xfb, 0x02, 0xc3, 0xd4, 0xdf,
+	0x23, 0x01, 0x89, 0xe4, 0x44, 0x3f, 0x1c, 0x41, 0x6d, 0xe3, 0x35, 0x06, 0x5d, 0xad, 0xac, 0xb4,
+	0x0e, 0x55, 0x38, 0xd9, 0xc3, 0x73, 0x8c, 0xfd, 0x6d, 0xe5, 0x8c, 0x56, 0x23, 0x98, 0xf1, 0x8f,
+	0x4b, 0xf4, 0x8f, 0xc4, 0xec, 0x4e, 0xdb, 0xa2, 0x07, 0x02, 0x79, 0x52, 0x36, 0xdb, 0xe7, 0xa8,
+	0x5c, 0x2a, 0xe2, 0x78, 0x02, 0x75, 0x92, 0xbb, 0xa9, 0x75, 0x3a, 0x91, 0x9f, 0xf7, 0xb7, 0xe6,
+	0x37, 0x02, 0xd6, 0xce, 0x2e, 0xb0, 0x22, 0xb5, 0x4c, 0x3c, 0x44, 0x15, 0x49, 0x4f, 0x4e, 0x0f,
+	0x20, 0x0f, 0xe5, 0x57, 0x6d, 0x50, 0x1a, 0x0d, 0x9d, 0x30, 0x6e, 0xfa, 0x52, 0xcd, 0xa0, 0x9e,
+	0x7e, 0xac, 0x62, 0x2d, 0x22, 0x7f, 0x8b, 0x16, 0xae, 0x87, 0xc2, 0x58, 0x8a, 0xe7, 0xdf, 0x87,
+	0x39, 0xbf, 0xf1, 0xff, 0x13, 0xc1, 0xa5, 0x12, 0x2c, 0xff, 0x79, 0x76, 0xeb, 0x55, 0xd6, 0x91,
+	0x7a, 0xfa, 0x08, 0x1f, 0x99, 0x71, 0xb8, 0xd5, 0xee, 0xfa, 0x47, 0xf8, 0x21, 0x3d, 0xc8, 0x0f,
+	0x83, 0xcf, 0xdc, 0x19, 0x49, 0x77, 0x96, 0x9e, 0xd0, 0xd3, 0xfc, 0x76, 0x66, 0xf6, 0xb2, 0xd4,
+	0xf9, 0xd7, 0x6d, 0xa9, 0x1c, 0xf5, 0x3d, 0xbe, 0xed, 0x9f, 0xef, 0xb7, 0xb3, 0xe7, 0xfb, 0xf8,
+	0xe4, 0x9b, 0x41, 0x70, 0x32, 0xeb, 0xa1, 0x3b, 0xff, 0x1b, 0x00, 0x8d, 0xa8, 0xaa, 0x1d, 0x12,
+	0x0e, 0x00, 0x00,
 }
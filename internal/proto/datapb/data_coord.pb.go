@@ -2228,6 +2228,7 @@ type Binlog struct {
 	TimestampTo          uint64   `protobuf:"varint,3,opt,name=timestamp_to,json=timestampTo,proto3" json:"timestamp_to,omitempty"`
 	LogPath              string   `protobuf:"bytes,4,opt,name=log_path,json=logPath,proto3" json:"log_path,omitempty"`
 	LogSize              int64    `protobuf:"varint,5,opt,name=log_size,json=logSize,proto3" json:"log_size,omitempty"`
+	MemorySize           int64    `protobuf:"varint,6,opt,name=memory_size,json=memorySize,proto3" json:"memory_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2293,6 +2294,13 @@ func (m *Binlog) GetLogSize() int64 {
 	return 0
 }
 
+func (m *Binlog) GetMemorySize() int64 {
+	if m != nil {
+		return m.MemorySize
+	}
+	return 0
+}
+
 type GetRecoveryInfoResponse struct {
 	Status               *commonpb.Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Channels             []*VchannelInfo   `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
@@ -3721,6 +3729,61 @@ func (m *UpdateSegmentStatisticsRequest) GetStats() []*SegmentStats {
 	return nil
 }
 
+type RefreshCollectionRequest struct {
+	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64                      `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Schema               *schemapb.CollectionSchema `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *RefreshCollectionRequest) Reset()         { *m = RefreshCollectionRequest{} }
+func (m *RefreshCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshCollectionRequest) ProtoMessage()    {}
+func (*RefreshCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{59}
+}
+
+func (m *RefreshCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshCollectionRequest.Unmarshal(m, b)
+}
+func (m *RefreshCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *RefreshCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshCollectionRequest.Merge(m, src)
+}
+func (m *RefreshCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshCollectionRequest.Size(m)
+}
+func (m *RefreshCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshCollectionRequest proto.InternalMessageInfo
+
+func (m *RefreshCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RefreshCollectionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *RefreshCollectionRequest) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
 	proto.RegisterEnum("milvus.proto.data.CompactionType", CompactionType_name, CompactionType_value)
@@ -3783,229 +3846,232 @@ func init() {
 	proto.RegisterType((*ImportTaskResponse)(nil), "milvus.proto.data.ImportTaskResponse")
 	proto.RegisterType((*ImportTaskRequest)(nil), "milvus.proto.data.ImportTaskRequest")
 	proto.RegisterType((*UpdateSegmentStatisticsRequest)(nil), "milvus.proto.data.UpdateSegmentStatisticsRequest")
+	proto.RegisterType((*RefreshCollectionRequest)(nil), "milvus.proto.data.RefreshCollectionRequest")
 }
 
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 3466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x1b, 0xcb, 0x6e, 0x1b, 0xd7,
-	0xd5, 0xc3, 0x97, 0xc8, 0xc3, 0x87, 0xa8, 0x6b, 0x45, 0xa6, 0x69, 0x5b, 0x96, 0xc7, 0xb1, 0xa3,
-	0x38, 0x8e, 0x9d, 0xc8, 0x09, 0x62, 0x34, 0x2f, 0x44, 0x92, 0xa5, 0x10, 0x95, 0x54, 0x65, 0xa4,
-	0xc4, 0x45, 0x53, 0x74, 0x30, 0xe2, 0x5c, 0x51, 0x13, 0x71, 0x66, 0xe8, 0x99, 0xa1, 0x65, 0x65,
-	0x13, 0xa3, 0x01, 0x0a, 0xa4, 0x28, 0xfa, 0x40, 0x37, 0x2d, 0xd0, 0x45, 0x51, 0xa0, 0x40, 0x1f,
-	0x9b, 0x02, 0x41, 0x17, 0x6d, 0xd1, 0x4d, 0x57, 0x41, 0xbb, 0xe8, 0x27, 0x74, 0xd9, 0x5d, 0xbf,
-	0xa1, 0xb8, 0x8f, 0xb9, 0xf3, 0xe0, 0x90, 0x1c, 0x89, 0x76, 0xbc, 0xd3, 0x3d, 0x73, 0xce, 0xb9,
-	0xe7, 0x9e, 0x7b, 0xde, 0xbc, 0x82, 0xba, 0xae, 0x79, 0x9a, 0xda, 0xb6, 0x6d, 0x47, 0xbf, 0xd5,
-	0x73, 0x6c, 0xcf, 0x46, 0x33, 0xa6, 0xd1, 0x7d, 0xd8, 0x77, 0xd9, 0xea, 0x16, 0xf9, 0xdc, 0xac,
-	0xb4, 0x6d, 0xd3, 0xb4, 0x2d, 0x06, 0x6a, 0xd6, 0x0c, 0xcb, 0xc3, 0x8e, 0xa5, 0x75, 0xf9, 0xba,
-	0x12, 0x26, 0x68, 0x56, 0xdc, 0xf6, 0x01, 0x36, 0x35, 0xb6, 0x92, 0xa7, 0x20, 0x7f, 0xcf, 0xec,
-	0x79, 0xc7, 0xf2, 0x2f, 0x24, 0xa8, 0xac, 0x75, 0xfb, 0xee, 0x81, 0x82, 0x1f, 0xf4, 0xb1, 0xeb,
-	0xa1, 0x57, 0x20, 0xb7, 0xa7, 0xb9, 0xb8, 0x21, 0x2d, 0x48, 0x8b, 0xe5, 0xa5, 0x8b, 0xb7, 0x22,
-	0xbb, 0xf2, 0xfd, 0x36, 0xdd, 0xce, 0xb2, 0xe6, 0x62, 0x85, 0x62, 0x22, 0x04, 0x39, 0x7d, 0xaf,
-	0xb5, 0xda, 0xc8, 0x2c, 0x48, 0x8b, 0x59, 0x85, 0xfe, 0x8d, 0xe6, 0x01, 0x5c, 0xdc, 0x31, 0xb1,
-	0xe5, 0xb5, 0x56, 0xdd, 0x46, 0x76, 0x21, 0xbb, 0x98, 0x55, 0x42, 0x10, 0x24, 0x43, 0xa5, 0x6d,
-	0x77, 0xbb, 0xb8, 0xed, 0x19, 0xb6, 0xd5, 0x5a, 0x6d, 0xe4, 0x28, 0x6d, 0x04, 0x26, 0xff, 0x4a,
-	0x82, 0x2a, 0x17, 0xcd, 0xed, 0xd9, 0x96, 0x8b, 0xd1, 0x1d, 0x28, 0xb8, 0x9e, 0xe6, 0xf5, 0x5d,
-	0x2e, 0xdd, 0x85, 0x44, 0xe9, 0x76, 0x28, 0x8a, 0xc2, 0x51, 0x13, 0xc5, 0x8b, 0x6f, 0x9f, 0x1d,
-	0xdc, 0x3e, 0x76, 0x84, 0x5c, 0xfc, 0x08, 0xf2, 0xcf, 0x24, 0xa8, 0xef, 0xf8, 0x4b, 0x5f, 0x7b,
-	0xb3, 0x90, 0x6f, 0xdb, 0x7d, 0xcb, 0xa3, 0x02, 0x56, 0x15, 0xb6, 0x40, 0x57, 0xa0, 0xd2, 0x3e,
-	0xd0, 0x2c, 0x0b, 0x77, 0x55, 0x4b, 0x33, 0x31, 0x15, 0xa5, 0xa4, 0x94, 0x39, 0x6c, 0x4b, 0x33,
-	0x71, 0x2a, 0x89, 0x16, 0xa0, 0xdc, 0xd3, 0x1c, 0xcf, 0x88, 0xe8, 0x2c, 0x0c, 0x92, 0x7f, 0x2d,
-	0xc1, 0xdc, 0x7b, 0xae, 0x6b, 0x74, 0xac, 0x01, 0xc9, 0xe6, 0xa0, 0x60, 0xd9, 0x3a, 0x6e, 0xad,
-	0x52, 0xd1, 0xb2, 0x0a, 0x5f, 0xa1, 0x0b, 0x50, 0xea, 0x61, 0xec, 0xa8, 0x8e, 0xdd, 0xf5, 0x05,
-	0x2b, 0x12, 0x80, 0x62, 0x77, 0x31, 0xfa, 0x00, 0x66, 0xdc, 0x18, 0x23, 0x76, 0x9b, 0xe5, 0xa5,
-	0xab, 0xb7, 0x06, 0xec, 0xf1, 0x56, 0x7c, 0x53, 0x65, 0x90, 0x5a, 0x7e, 0x9c, 0x81, 0xb3, 0x02,
-	0x8f, 0xc9, 0x4a, 0xfe, 0x26, 0x9a, 0x73, 0x71, 0x47, 0x88, 0xc7, 0x16, 0x69, 0x34, 0x27, 0x54,
-	0x9e, 0x0d, 0xab, 0x3c, 0x85, 0x81, 0xc5, 0xf5, 0x99, 0x1f, 0xd0, 0x27, 0xba, 0x0c, 0x65, 0xfc,
-	0xa8, 0x67, 0x38, 0x58, 0xf5, 0x0c, 0x13, 0x37, 0x0a, 0x0b, 0xd2, 0x62, 0x4e, 0x01, 0x06, 0xda,
-	0x35, 0xcc, 0xb0, 0x45, 0x4e, 0xa5, 0xb6, 0x48, 0xf9, 0x37, 0x12, 0x9c, 0x1b, 0xb8, 0x25, 0x6e,
-	0xe2, 0x0a, 0xd4, 0xe9, 0xc9, 0x03, 0xcd, 0x10, 0x63, 0x27, 0x0a, 0xbf, 0x3e, 0x4a, 0xe1, 0x01,
-	0xba, 0x32, 0x40, 0x1f, 0x12, 0x32, 0x93, 0x5e, 0xc8, 0x43, 0x38, 0xb7, 0x8e, 0x3d, 0xbe, 0x01,
-	0xf9, 0x86, 0xdd, 0xd3, 0x87, 0x88, 0xa8, 0x2f, 0x65, 0x06, 0x7c, 0xe9, 0x4f, 0x19, 0xe1, 0x4b,
-	0x74, 0xab, 0x96, 0xb5, 0x6f, 0xa3, 0x8b, 0x50, 0x12, 0x28, 0xdc, 0x2a, 0x02, 0x00, 0x7a, 0x03,
-	0xf2, 0x44, 0x52, 0x66, 0x12, 0xb5, 0xa5, 0x2b, 0xc9, 0x67, 0x0a, 0xf1, 0x54, 0x18, 0x3e, 0x6a,
-	0x41, 0xcd, 0xf5, 0x34, 0xc7, 0x53, 0x7b, 0xb6, 0x4b, 0xef, 0x99, 0x1a, 0x4e, 0x79, 0x49, 0x8e,
-	0x72, 0x10, 0xc1, 0x74, 0xd3, 0xed, 0x6c, 0x73, 0x4c, 0xa5, 0x4a, 0x29, 0xfd, 0x25, 0xba, 0x07,
-	0x15, 0x6c, 0xe9, 0x01, 0xa3, 0x5c, 0x6a, 0x46, 0x65, 0x6c, 0xe9, 0x82, 0x4d, 0x70, 0x3f, 0xf9,
-	0xf4, 0xf7, 0xf3, 0x23, 0x09, 0x1a, 0x83, 0x17, 0x34, 0x49, 0xa0, 0x7c, 0x93, 0x11, 0x61, 0x76,
-	0x41, 0x23, 0x3d, 0x5c, 0x5c, 0x92, 0xc2, 0x49, 0x64, 0x03, 0x9e, 0x0b, 0xa4, 0xa1, 0x5f, 0x9e,
-	0x9a, 0xb1, 0x7c, 0x2e, 0xc1, 0x5c, 0x7c, 0xaf, 0x49, 0xce, 0xfd, 0x1a, 0xe4, 0x0d, 0x6b, 0xdf,
-	0xf6, 0x8f, 0x3d, 0x3f, 0xc2, 0xcf, 0xc8, 0x5e, 0x0c, 0x59, 0x36, 0xe1, 0xc2, 0x3a, 0xf6, 0x5a,
-	0x96, 0x8b, 0x1d, 0x6f, 0xd9, 0xb0, 0xba, 0x76, 0x67, 0x5b, 0xf3, 0x0e, 0x26, 0xf0, 0x91, 0x88,
-	0xb9, 0x67, 0x62, 0xe6, 0x2e, 0xff, 0x4e, 0x82, 0x8b, 0xc9, 0xfb, 0xf1, 0xa3, 0x37, 0xa1, 0xb8,
-	0x6f, 0xe0, 0xae, 0x4e, 0x74, 0x26, 0x51, 0x9d, 0x89, 0x35, 0xf1, 0x95, 0x1e, 0x41, 0xe6, 0x27,
-	0xbc, 0x32, 0xc4, 0x40, 0x77, 0x3c, 0xc7, 0xb0, 0x3a, 0x1b, 0x86, 0xeb, 0x29, 0x0c, 0x3f, 0xa4,
-	0xcf, 0x6c, 0x7a, 0xcb, 0xfc, 0xa1, 0x04, 0xf3, 0xeb, 0xd8, 0x5b, 0x11, 0xa1, 0x96, 0x7c, 0x37,
-	0x5c, 0xcf, 0x68, 0xbb, 0x4f, 0xb6, 0xc8, 0x48, 0x91, 0x33, 0xe5, 0x9f, 0x48, 0x70, 0x79, 0xa8,
-	0x30, 0x5c, 0x75, 0x3c, 0x94, 0xf8, 0x81, 0x36, 0x39, 0x94, 0x7c, 0x13, 0x1f, 0x7f, 0xa4, 0x75,
-	0xfb, 0x78, 0x5b, 0x33, 0x1c, 0x16, 0x4a, 0x4e, 0x19, 0x58, 0xff, 0x28, 0xc1, 0xa5, 0x75, 0xec,
-	0x6d, 0xfb, 0x69, 0xe6, 0x19, 0x6a, 0x27, 0x45, 0x45, 0xf1, 0x63, 0x76, 0x99, 0x89, 0xd2, 0x3e,
-	0x13, 0xf5, 0xcd, 0x53, 0x3f, 0x08, 0x39, 0xe4, 0x0a, 0xab, 0x05, 0xb8, 0xf2, 0xe4, 0xc7, 0x59,
-	0xa8, 0x7c, 0xc4, 0xeb, 0x03, 0x9a, 0x46, 0xe2, 0x7a, 0x90, 0x92, 0xf5, 0x10, 0x2a, 0x29, 0x92,
-	0xaa, 0x8c, 0x75, 0xa8, 0xba, 0x18, 0x1f, 0x9e, 0x26, 0x69, 0x54, 0x08, 0xa1, 0x08, 0xf6, 0x1b,
-	0x30, 0xd3, 0xb7, 0xf6, 0x49, 0x59, 0x8b, 0x75, 0x7e, 0x0a, 0x56, 0x5d, 0x8e, 0x8f, 0x3c, 0x83,
-	0x84, 0xe8, 0x7d, 0x98, 0x8e, 0xf3, 0xca, 0xa7, 0xe2, 0x15, 0x27, 0x43, 0x2d, 0xa8, 0xeb, 0x8e,
-	0xdd, 0xeb, 0x61, 0x5d, 0x75, 0x7d, 0x56, 0x85, 0x74, 0xac, 0x38, 0x9d, 0xcf, 0x4a, 0xfe, 0x42,
-	0x82, 0xb9, 0xfb, 0x9a, 0xd7, 0x3e, 0x58, 0x35, 0xf9, 0xe5, 0x4c, 0x60, 0xda, 0x6f, 0x43, 0xe9,
-	0x21, 0xbf, 0x08, 0x3f, 0x7e, 0x5d, 0x4e, 0x10, 0x28, 0x7c, 0xe5, 0x4a, 0x40, 0x21, 0x7f, 0x25,
-	0xc1, 0x2c, 0x6d, 0x22, 0x7c, 0xe9, 0xbe, 0x7e, 0x27, 0x1b, 0xd3, 0x48, 0xa0, 0xeb, 0x50, 0x33,
-	0x35, 0xe7, 0x70, 0x27, 0xc0, 0xc9, 0x53, 0x9c, 0x18, 0x54, 0x7e, 0x04, 0xc0, 0x57, 0x9b, 0x6e,
-	0xe7, 0x14, 0xf2, 0xdf, 0x85, 0x29, 0xbe, 0x2b, 0xf7, 0xb7, 0x71, 0x17, 0xeb, 0xa3, 0xcb, 0xff,
-	0x94, 0xa0, 0x16, 0x44, 0x50, 0xea, 0x55, 0x35, 0xc8, 0x08, 0x5f, 0xca, 0xb4, 0x56, 0xd1, 0xdb,
-	0x50, 0x60, 0x0d, 0x26, 0xe7, 0x7d, 0x2d, 0xca, 0x9b, 0x37, 0x9f, 0xa1, 0x30, 0x4c, 0x01, 0x0a,
-	0x27, 0x22, 0x3a, 0x12, 0x51, 0x47, 0xf4, 0x8b, 0x01, 0x04, 0xb5, 0x60, 0x3a, 0x5a, 0xb4, 0xf9,
-	0x3e, 0xb3, 0x30, 0x2c, 0xda, 0xac, 0x6a, 0x9e, 0x46, 0x83, 0x4d, 0x2d, 0x52, 0xb3, 0xb9, 0xf2,
-	0xff, 0xf2, 0x50, 0x0e, 0x9d, 0x72, 0xe0, 0x24, 0xf1, 0x2b, 0xcd, 0x8c, 0x8f, 0x9b, 0xd9, 0xc1,
-	0xce, 0xe1, 0x1a, 0xd4, 0x0c, 0x9a, 0xab, 0x55, 0x6e, 0x8a, 0x34, 0xb8, 0x96, 0x94, 0x2a, 0x83,
-	0x72, 0xbf, 0x40, 0xf3, 0x50, 0xb6, 0xfa, 0xa6, 0x6a, 0xef, 0xab, 0x8e, 0x7d, 0xe4, 0xf2, 0x16,
-	0xa4, 0x64, 0xf5, 0xcd, 0x6f, 0xed, 0x2b, 0xf6, 0x91, 0x1b, 0x54, 0xb9, 0x85, 0x13, 0x56, 0xb9,
-	0xf3, 0x50, 0x36, 0xb5, 0x47, 0x84, 0xab, 0x6a, 0xf5, 0x4d, 0xda, 0x9d, 0x64, 0x95, 0x92, 0xa9,
-	0x3d, 0x52, 0xec, 0xa3, 0xad, 0xbe, 0x89, 0x16, 0xa1, 0xde, 0xd5, 0x5c, 0x4f, 0x0d, 0xb7, 0x37,
-	0x45, 0xda, 0xde, 0xd4, 0x08, 0xfc, 0x5e, 0xd0, 0xe2, 0x0c, 0xd6, 0xcb, 0xa5, 0x09, 0xea, 0x65,
-	0xdd, 0xec, 0x06, 0x8c, 0x20, 0x7d, 0xbd, 0xac, 0x9b, 0x5d, 0xc1, 0xe6, 0x2e, 0x4c, 0xed, 0xd1,
-	0x0a, 0xc8, 0x6d, 0x94, 0x87, 0x46, 0xa8, 0x35, 0x52, 0xfc, 0xb0, 0x42, 0x49, 0xf1, 0xd1, 0xd1,
-	0x5b, 0x50, 0xa2, 0xa9, 0x87, 0xd2, 0x56, 0x52, 0xd1, 0x06, 0x04, 0x84, 0x5a, 0xc7, 0x5d, 0x4f,
-	0xa3, 0xd4, 0xd5, 0x74, 0xd4, 0x82, 0x00, 0xbd, 0x02, 0x67, 0xdb, 0x0e, 0xd6, 0x3c, 0xac, 0x2f,
-	0x1f, 0xaf, 0xd8, 0x66, 0x4f, 0xa3, 0xc6, 0xd4, 0xa8, 0x2d, 0x48, 0x8b, 0x45, 0x25, 0xe9, 0x13,
-	0x09, 0x0c, 0x6d, 0xb1, 0x5a, 0x73, 0x6c, 0xb3, 0x31, 0xcd, 0x02, 0x43, 0x14, 0x8a, 0x2e, 0x01,
-	0xf8, 0xa1, 0x5b, 0xf3, 0x1a, 0x75, 0x7a, 0x8b, 0x25, 0x0e, 0x79, 0xcf, 0x93, 0x3f, 0x83, 0xd9,
-	0xc0, 0x42, 0x42, 0xb7, 0x31, 0x78, 0xb1, 0xd2, 0x69, 0x2f, 0x76, 0x74, 0xed, 0xfa, 0xef, 0x1c,
-	0xcc, 0xed, 0x68, 0x0f, 0xf1, 0xd3, 0x2f, 0x93, 0x53, 0xc5, 0xe3, 0x0d, 0x98, 0xa1, 0x95, 0xf1,
-	0x52, 0x48, 0x9e, 0x11, 0x19, 0x38, 0x7c, 0x9d, 0x83, 0x84, 0xe8, 0x5d, 0x52, 0x3a, 0xe0, 0xf6,
-	0xe1, 0xb6, 0x6d, 0x04, 0xd9, 0xf7, 0x52, 0x02, 0x9f, 0x15, 0x81, 0xa5, 0x84, 0x29, 0xd0, 0xf6,
-	0x60, 0x68, 0x63, 0x79, 0xf7, 0x85, 0x91, 0xfd, 0x57, 0xa0, 0xfd, 0x78, 0x84, 0x43, 0x0d, 0x98,
-	0xe2, 0xd9, 0x9d, 0xfa, 0x7d, 0x51, 0xf1, 0x97, 0x68, 0x1b, 0xce, 0xb2, 0x13, 0xec, 0x70, 0xa3,
-	0x66, 0x87, 0x2f, 0xa6, 0x3a, 0x7c, 0x12, 0x69, 0xd4, 0x27, 0x4a, 0x27, 0xf5, 0x89, 0x06, 0x4c,
-	0x71, 0x3b, 0xa5, 0xb1, 0xa0, 0xa8, 0xf8, 0x4b, 0x72, 0xcd, 0x86, 0xd9, 0xb3, 0x1d, 0xcf, 0xb0,
-	0x3a, 0x8d, 0x32, 0xfd, 0x16, 0x00, 0x48, 0x8b, 0x01, 0x81, 0x3e, 0xc7, 0x4c, 0x0a, 0xde, 0x81,
-	0xa2, 0xb0, 0xf0, 0x4c, 0x6a, 0x0b, 0x17, 0x34, 0xf1, 0x18, 0x9d, 0x8d, 0xc5, 0x68, 0xf9, 0x5f,
-	0x12, 0x54, 0x56, 0xc9, 0x91, 0x36, 0xec, 0x0e, 0xcd, 0x28, 0xd7, 0xa0, 0xe6, 0xe0, 0xb6, 0xed,
-	0xe8, 0x2a, 0xb6, 0x3c, 0xc7, 0xc0, 0xac, 0x1b, 0xcd, 0x29, 0x55, 0x06, 0xbd, 0xc7, 0x80, 0x04,
-	0x8d, 0x84, 0x5d, 0xd7, 0xd3, 0xcc, 0x9e, 0xba, 0x4f, 0xdc, 0x3b, 0xc3, 0xd0, 0x04, 0x94, 0x7a,
-	0xf7, 0x15, 0xa8, 0x04, 0x68, 0x9e, 0x4d, 0xf7, 0xcf, 0x29, 0x65, 0x01, 0xdb, 0xb5, 0xd1, 0xf3,
-	0x50, 0xa3, 0x3a, 0x55, 0xbb, 0x76, 0x47, 0x25, 0x9d, 0x1b, 0x4f, 0x36, 0x15, 0x9d, 0x8b, 0x45,
-	0xee, 0x2a, 0x8a, 0xe5, 0x1a, 0x9f, 0x62, 0x9e, 0x6e, 0x04, 0xd6, 0x8e, 0xf1, 0x29, 0x26, 0xb9,
-	0xbe, 0x4a, 0x72, 0xe7, 0x96, 0xad, 0xe3, 0xdd, 0x53, 0x56, 0x1a, 0x29, 0xa6, 0x76, 0x17, 0xa1,
-	0x24, 0x4e, 0xc0, 0x8f, 0x14, 0x00, 0xd0, 0x1a, 0xd4, 0xfc, 0x22, 0x54, 0x65, 0xbd, 0x45, 0x6e,
-	0x68, 0xe5, 0x17, 0xca, 0x7e, 0xae, 0x52, 0xf5, 0xc9, 0xe8, 0x52, 0x5e, 0x83, 0x4a, 0xf8, 0x33,
-	0xd9, 0x75, 0x27, 0x6e, 0x28, 0x02, 0x40, 0xac, 0x71, 0xab, 0x6f, 0x92, 0x3b, 0xe5, 0x81, 0xc5,
-	0x5f, 0xca, 0x9f, 0x4b, 0x50, 0xe5, 0x29, 0x7b, 0x47, 0x4c, 0x95, 0xe9, 0xd1, 0x24, 0x7a, 0x34,
-	0xfa, 0x37, 0xfa, 0x46, 0x74, 0x24, 0xf5, 0x7c, 0x62, 0x10, 0xa0, 0x4c, 0x68, 0x75, 0x1c, 0xc9,
-	0xd7, 0x69, 0x7a, 0xd9, 0xc7, 0xc4, 0xd0, 0xf8, 0xd5, 0x50, 0x43, 0x6b, 0xc0, 0x94, 0xa6, 0xeb,
-	0x0e, 0x76, 0x5d, 0x2e, 0x87, 0xbf, 0x24, 0x5f, 0x1e, 0x62, 0xc7, 0xf5, 0x4d, 0x3e, 0xab, 0xf8,
-	0x4b, 0xf4, 0x16, 0x14, 0x45, 0x39, 0x9d, 0x4d, 0x2a, 0xa1, 0xc2, 0x72, 0xf2, 0xde, 0x4b, 0x50,
-	0xc8, 0x7f, 0xce, 0x40, 0x8d, 0x2b, 0x6c, 0x99, 0xe7, 0xd4, 0xd1, 0xce, 0xb7, 0x0c, 0x95, 0xfd,
-	0xc0, 0xf7, 0x47, 0xcd, 0x58, 0xc2, 0x21, 0x22, 0x42, 0x33, 0xce, 0x01, 0xa3, 0x59, 0x3d, 0x37,
-	0x51, 0x56, 0xcf, 0x9f, 0x34, 0x82, 0x0d, 0xd6, 0x79, 0x85, 0x84, 0x3a, 0x4f, 0xfe, 0x2e, 0x94,
-	0x43, 0x0c, 0x68, 0x84, 0x66, 0xc3, 0x19, 0xae, 0x31, 0x7f, 0x89, 0xee, 0x04, 0xb5, 0x0d, 0x53,
-	0xd5, 0xf9, 0x04, 0x59, 0x62, 0x65, 0x8d, 0xfc, 0x7b, 0x09, 0x0a, 0x9c, 0xf3, 0x65, 0x28, 0xf3,
-	0xa0, 0x43, 0xeb, 0x3e, 0xc6, 0x1d, 0x38, 0x88, 0x14, 0x7e, 0x4f, 0x2e, 0xea, 0x9c, 0x87, 0x62,
-	0x2c, 0xde, 0x4c, 0xf1, 0xb4, 0xe0, 0x7f, 0x0a, 0x05, 0x19, 0xf2, 0x89, 0xc6, 0x97, 0xaf, 0x24,
-	0x3a, 0x58, 0x56, 0x70, 0xdb, 0x7e, 0x88, 0x9d, 0xe3, 0xc9, 0xc7, 0x77, 0x6f, 0x86, 0x0c, 0x3a,
-	0x65, 0x7f, 0x28, 0x08, 0xd0, 0x9b, 0x81, 0xba, 0xb3, 0x49, 0xd3, 0x8b, 0x70, 0x84, 0xe1, 0xe6,
-	0x18, 0xa8, 0xfd, 0xa7, 0x6c, 0x10, 0x19, 0x3d, 0xca, 0x69, 0xeb, 0x9a, 0x27, 0xd2, 0x76, 0xc8,
-	0x3f, 0x97, 0xe0, 0xfc, 0x3a, 0xf6, 0xd6, 0xa2, 0xcd, 0xfd, 0xb3, 0x96, 0xca, 0x84, 0x66, 0x92,
-	0x50, 0x93, 0xdc, 0x7a, 0x13, 0x8a, 0x62, 0x4c, 0xc1, 0x46, 0xc4, 0x62, 0x2d, 0xff, 0x40, 0x82,
-	0x06, 0xdf, 0x85, 0xee, 0x49, 0x4a, 0xea, 0x2e, 0xf6, 0xb0, 0xfe, 0x75, 0xf7, 0xcd, 0x7f, 0x97,
-	0xa0, 0x1e, 0x8e, 0xf8, 0x34, 0x68, 0xbf, 0x0e, 0x79, 0x3a, 0x9e, 0xe0, 0x12, 0x8c, 0x35, 0x56,
-	0x86, 0x4d, 0x42, 0x06, 0x2d, 0xf3, 0x76, 0x45, 0x72, 0xe2, 0xcb, 0x20, 0xed, 0x64, 0x4f, 0x9e,
-	0x76, 0x78, 0x1a, 0xb6, 0xfb, 0x84, 0x2f, 0x1b, 0xff, 0x05, 0x00, 0xf9, 0xcb, 0x0c, 0x34, 0x82,
-	0x7e, 0xe4, 0x6b, 0x8f, 0xfb, 0x43, 0xaa, 0xd5, 0xec, 0x13, 0xaa, 0x56, 0x73, 0x93, 0xc7, 0xfa,
-	0x7c, 0x52, 0xac, 0xff, 0x5b, 0x06, 0x6a, 0x81, 0xd6, 0xb6, 0xbb, 0x9a, 0x85, 0xe6, 0xa0, 0xd0,
-	0xeb, 0x6a, 0xc1, 0xf4, 0x91, 0xaf, 0xd0, 0x8e, 0xa8, 0x73, 0xa2, 0x7a, 0x7a, 0x29, 0xe9, 0x0e,
-	0x87, 0x5c, 0x84, 0x12, 0x63, 0x41, 0xda, 0x41, 0xd6, 0x50, 0xd0, 0xa6, 0x9e, 0xd7, 0x56, 0xcc,
-	0x58, 0x48, 0x3f, 0x7f, 0x13, 0x10, 0xbf, 0x61, 0xd5, 0xb0, 0x54, 0x17, 0xb7, 0x6d, 0x4b, 0x67,
-	0x77, 0x9f, 0x57, 0xea, 0xfc, 0x4b, 0xcb, 0xda, 0x61, 0x70, 0xf4, 0x3a, 0xe4, 0xbc, 0xe3, 0x1e,
-	0x8b, 0xe2, 0xb5, 0xc4, 0xe8, 0x18, 0xc8, 0xb5, 0x7b, 0xdc, 0xc3, 0x0a, 0x45, 0x47, 0xf3, 0x00,
-	0x84, 0x95, 0xe7, 0x68, 0x0f, 0x79, 0x4a, 0xcc, 0x29, 0x21, 0x08, 0xb1, 0x66, 0x5f, 0x87, 0x53,
-	0x2c, 0x75, 0xf0, 0xa5, 0xfc, 0x97, 0x0c, 0xd4, 0x03, 0x96, 0x0a, 0x76, 0xfb, 0x5d, 0x6f, 0xa8,
-	0xfe, 0x46, 0x37, 0x83, 0xe3, 0xea, 0x86, 0x77, 0xa1, 0xcc, 0xef, 0xf3, 0x04, 0xf6, 0x00, 0x8c,
-	0x64, 0x63, 0x84, 0x81, 0xe6, 0x9f, 0x90, 0x81, 0x16, 0x4e, 0x68, 0xa0, 0xf2, 0x0e, 0xcc, 0xf9,
-	0x71, 0x2f, 0x40, 0xd8, 0xc4, 0x9e, 0x36, 0xa2, 0xe0, 0xb8, 0x0c, 0x65, 0x96, 0xcf, 0x58, 0x22,
-	0x67, 0xa5, 0x3a, 0xec, 0x89, 0x0e, 0x57, 0xfe, 0x1e, 0xcc, 0xd2, 0xb8, 0x11, 0x1f, 0xe5, 0xa6,
-	0x99, 0xab, 0xcb, 0xa2, 0x11, 0x20, 0x45, 0x3f, 0xb3, 0xee, 0x92, 0x12, 0x81, 0xc9, 0x1b, 0xf0,
-	0x5c, 0x8c, 0xff, 0x04, 0x79, 0x81, 0x94, 0x42, 0x73, 0x3b, 0xd1, 0x9f, 0x45, 0x4f, 0x9f, 0xfd,
-	0x2e, 0x89, 0xc9, 0xad, 0x6a, 0xe8, 0x71, 0xfb, 0xd2, 0xd1, 0x3b, 0x50, 0xb2, 0xf0, 0x91, 0x1a,
-	0x0e, 0xbe, 0x29, 0x06, 0x74, 0x45, 0x0b, 0x1f, 0xd1, 0xbf, 0xe4, 0x2d, 0x38, 0x37, 0x20, 0xea,
-	0x24, 0x67, 0xff, 0xab, 0x04, 0xe7, 0x57, 0x1d, 0xbb, 0xf7, 0x91, 0xe1, 0x78, 0x7d, 0xad, 0x1b,
-	0xfd, 0x61, 0xe4, 0xe9, 0xb4, 0x71, 0xef, 0x87, 0xd2, 0x30, 0x8b, 0xcb, 0x37, 0x13, 0xcc, 0x75,
-	0x50, 0x28, 0x7e, 0xe8, 0x50, 0xd2, 0xfe, 0x6f, 0x36, 0x49, 0x78, 0x8e, 0x37, 0x26, 0xd9, 0xa4,
-	0xa9, 0x52, 0x12, 0xa7, 0x3e, 0xd9, 0xd3, 0x4e, 0x7d, 0x86, 0x78, 0x7e, 0xee, 0x09, 0x79, 0xfe,
-	0x89, 0xdb, 0x90, 0xf7, 0x21, 0x3a, 0x91, 0xa3, 0x21, 0xf7, 0x54, 0xa3, 0xbc, 0x65, 0x80, 0x60,
-	0x3a, 0xc5, 0x5f, 0xb5, 0xa4, 0x61, 0x13, 0xa2, 0x22, 0xb7, 0x25, 0xa2, 0x2c, 0x9d, 0x2a, 0x47,
-	0xe6, 0x25, 0x1f, 0x40, 0x33, 0xc9, 0x4a, 0x27, 0xb1, 0xfc, 0x2f, 0x33, 0x00, 0x2d, 0x3a, 0x1d,
-	0xda, 0xd5, 0xdc, 0xc3, 0xd3, 0x55, 0x94, 0x57, 0xa1, 0x1a, 0x18, 0x4c, 0xe0, 0xef, 0x61, 0x2b,
-	0xd2, 0x89, 0x4b, 0x88, 0xc2, 0x96, 0xe0, 0x0c, 0x14, 0xbb, 0x3a, 0xe5, 0x13, 0xf2, 0x1a, 0x66,
-	0x14, 0xb1, 0xa0, 0x87, 0x2e, 0x40, 0xc9, 0xb1, 0x8f, 0x54, 0xe2, 0x66, 0x3a, 0xcd, 0xad, 0x45,
-	0xa5, 0xe8, 0xd8, 0x47, 0xc4, 0xf9, 0x74, 0x74, 0x0e, 0xa6, 0x3c, 0xcd, 0x3d, 0x24, 0xfc, 0x0b,
-	0x2c, 0xdd, 0x91, 0x65, 0x4b, 0x47, 0xb3, 0x90, 0xdf, 0x37, 0xba, 0xd8, 0x6d, 0x4c, 0x51, 0x96,
-	0x6c, 0x81, 0xde, 0xf0, 0xdf, 0x2f, 0x14, 0x53, 0xff, 0xfe, 0xca, 0x9e, 0x30, 0x7c, 0x25, 0xc1,
-	0x74, 0xa0, 0x35, 0x1a, 0x80, 0x48, 0x4c, 0xa3, 0xf1, 0x6c, 0xc5, 0xd6, 0x59, 0xa8, 0xa8, 0x0d,
-	0xf9, 0x89, 0x85, 0x11, 0xb2, 0xa8, 0x15, 0x90, 0x8c, 0xaa, 0xcb, 0xc9, 0xb9, 0xc8, 0xa1, 0x0d,
-	0xdd, 0xff, 0x85, 0xa7, 0xe0, 0xd8, 0x47, 0x2d, 0x5d, 0x68, 0x83, 0x3d, 0xe3, 0x62, 0x55, 0x28,
-	0xd1, 0xc6, 0x0a, 0x7d, 0xc9, 0x75, 0x15, 0xaa, 0xd8, 0x71, 0x6c, 0x47, 0x35, 0xb1, 0xeb, 0x6a,
-	0x1d, 0xcc, 0x8b, 0xae, 0x0a, 0x05, 0x6e, 0x32, 0x98, 0xfc, 0x9f, 0x2c, 0xd4, 0x82, 0xa3, 0xf8,
-	0xbf, 0xeb, 0x18, 0xba, 0xff, 0xbb, 0x8e, 0xa1, 0x93, 0x60, 0xee, 0xb0, 0x50, 0x18, 0x0a, 0xe6,
-	0x1c, 0xd2, 0xd2, 0x49, 0x1e, 0x24, 0x0e, 0x66, 0xd9, 0x3a, 0x0e, 0x2e, 0x16, 0x7c, 0x10, 0xbf,
-	0xd7, 0x88, 0x7d, 0xe4, 0x52, 0xd8, 0x47, 0x3e, 0x85, 0x7d, 0x14, 0x12, 0xec, 0x63, 0x0e, 0x0a,
-	0x7b, 0xfd, 0xf6, 0x21, 0xf6, 0x78, 0x79, 0xc4, 0x57, 0x51, 0xbb, 0x29, 0xc6, 0xec, 0x46, 0x98,
-	0x47, 0x29, 0x6c, 0x1e, 0x17, 0xa0, 0xc4, 0x7e, 0x5c, 0x50, 0x3d, 0x97, 0x4e, 0x59, 0xb3, 0x4a,
-	0x91, 0x01, 0x76, 0x5d, 0x74, 0xd7, 0xef, 0x1d, 0xca, 0x49, 0x8e, 0x4e, 0x23, 0x4e, 0xcc, 0x42,
-	0xfc, 0xce, 0xe1, 0x2e, 0x34, 0x0e, 0x70, 0xdf, 0xa1, 0x6f, 0x01, 0x54, 0xfa, 0x58, 0xf5, 0x41,
-	0x1f, 0x3b, 0xc7, 0xda, 0x5e, 0x17, 0x37, 0x2a, 0x54, 0xb0, 0x39, 0xf1, 0x7d, 0x55, 0xf3, 0xb4,
-	0x0f, 0xfc, 0xaf, 0xe8, 0x35, 0x98, 0x8b, 0x51, 0x1a, 0x96, 0x8e, 0x1f, 0x61, 0xbd, 0x51, 0xa5,
-	0x74, 0xb3, 0x11, 0xba, 0x16, 0xfb, 0x26, 0x7f, 0x02, 0x28, 0x90, 0x64, 0xb2, 0xde, 0x31, 0x76,
-	0xd5, 0x99, 0xf8, 0x55, 0xcb, 0x7f, 0x90, 0x60, 0x26, 0xbc, 0xd9, 0x69, 0x13, 0xe8, 0x3b, 0x50,
-	0x66, 0x33, 0x6b, 0x95, 0x38, 0x30, 0xef, 0x1e, 0x2f, 0x8d, 0xd4, 0xb1, 0x02, 0x46, 0x10, 0xc7,
-	0xae, 0x42, 0xf5, 0xc8, 0x76, 0x0e, 0x0d, 0xab, 0xa3, 0x12, 0xc9, 0x7c, 0xb7, 0xa9, 0x70, 0xe0,
-	0x16, 0x81, 0xc9, 0x5f, 0x48, 0x30, 0xff, 0x61, 0x4f, 0xd7, 0x3c, 0x1c, 0xaa, 0x24, 0x26, 0x7d,
-	0x50, 0xf2, 0xba, 0xff, 0xa6, 0x23, 0x93, 0x6e, 0xee, 0xca, 0xb0, 0x6f, 0xfc, 0x52, 0x82, 0x99,
-	0x81, 0x5e, 0x13, 0xd5, 0x00, 0x3e, 0xb4, 0xda, 0xbc, 0x09, 0xaf, 0x9f, 0x41, 0x15, 0x28, 0xfa,
-	0x2d, 0x79, 0x5d, 0x42, 0x65, 0x98, 0xda, 0xb5, 0x29, 0x76, 0x3d, 0x83, 0xea, 0x50, 0x61, 0x84,
-	0xfd, 0x76, 0x1b, 0xbb, 0x6e, 0x3d, 0x2b, 0x20, 0x6b, 0x9a, 0xd1, 0xed, 0x3b, 0xb8, 0x9e, 0x43,
-	0x55, 0x28, 0xed, 0xda, 0x0a, 0xee, 0x62, 0xcd, 0xc5, 0xf5, 0x3c, 0x42, 0x50, 0xe3, 0x0b, 0x9f,
-	0xa8, 0x10, 0x82, 0xf9, 0x64, 0x53, 0x37, 0xf6, 0xc3, 0x5d, 0x19, 0x69, 0x55, 0xd0, 0x39, 0x38,
-	0xfb, 0xa1, 0xa5, 0xe3, 0x7d, 0xc3, 0xc2, 0x7a, 0xf0, 0xa9, 0x7e, 0x06, 0x9d, 0x85, 0xe9, 0x96,
-	0x65, 0x61, 0x27, 0x04, 0x94, 0x08, 0x70, 0x13, 0x3b, 0x1d, 0x1c, 0x02, 0x66, 0xd0, 0x0c, 0x54,
-	0x37, 0x8d, 0x47, 0x21, 0x50, 0x76, 0xe9, 0x1f, 0xcf, 0x41, 0x89, 0x18, 0xee, 0x8a, 0x6d, 0x3b,
-	0x3a, 0xea, 0x01, 0xa2, 0xcf, 0x8f, 0xcc, 0x9e, 0x6d, 0x89, 0x77, 0x7a, 0xe8, 0x95, 0x21, 0x09,
-	0x75, 0x10, 0x95, 0x5f, 0x61, 0xf3, 0xfa, 0x10, 0x8a, 0x18, 0xba, 0x7c, 0x06, 0x99, 0x74, 0x47,
-	0xd2, 0xea, 0xed, 0x1a, 0xed, 0x43, 0xff, 0x87, 0xe6, 0x11, 0x3b, 0xc6, 0x50, 0xfd, 0x1d, 0x63,
-	0xcf, 0xff, 0xf8, 0x82, 0xbd, 0x11, 0xf3, 0xfd, 0x4f, 0x3e, 0x83, 0x1e, 0xc0, 0xec, 0x3a, 0x0e,
-	0xd9, 0x9c, 0xbf, 0xe1, 0xd2, 0xf0, 0x0d, 0x07, 0x90, 0x4f, 0xb8, 0xe5, 0x06, 0xe4, 0xe9, 0x5c,
-	0x07, 0x25, 0x99, 0x65, 0xf8, 0x31, 0x7b, 0x73, 0x61, 0x38, 0x82, 0xe0, 0xf6, 0x09, 0x4c, 0xc7,
-	0x1e, 0xe3, 0xa2, 0x17, 0x13, 0xc8, 0x92, 0x9f, 0x55, 0x37, 0x6f, 0xa4, 0x41, 0x15, 0x7b, 0x75,
-	0xa0, 0x16, 0x7d, 0xbc, 0x84, 0x16, 0x13, 0xe8, 0x13, 0x1f, 0x52, 0x36, 0x5f, 0x4c, 0x81, 0x29,
-	0x36, 0x32, 0xa1, 0x1e, 0x7f, 0x1c, 0x8a, 0x6e, 0x8c, 0x64, 0x10, 0x35, 0xb7, 0x97, 0x52, 0xe1,
-	0x8a, 0xed, 0x8e, 0xa9, 0x11, 0x0c, 0x3c, 0x4e, 0x44, 0xb7, 0x92, 0xd9, 0x0c, 0x7b, 0x35, 0xd9,
-	0xbc, 0x9d, 0x1a, 0x5f, 0x6c, 0xfd, 0x7d, 0x36, 0x4f, 0x4e, 0x7a, 0xe0, 0x87, 0x5e, 0x4d, 0x66,
-	0x37, 0xe2, 0x65, 0x62, 0x73, 0xe9, 0x24, 0x24, 0x42, 0x88, 0xcf, 0xe8, 0x20, 0x38, 0xe1, 0x91,
-	0x5c, 0xdc, 0xef, 0x7c, 0x7e, 0xc3, 0x5f, 0xff, 0x35, 0x5f, 0x3d, 0x01, 0x85, 0x10, 0xc0, 0x8e,
-	0x3f, 0xbf, 0xf5, 0xdd, 0xf0, 0xf6, 0x58, 0xab, 0x39, 0x9d, 0x0f, 0x7e, 0x0c, 0xd3, 0xb1, 0x9f,
-	0xf4, 0x13, 0xbd, 0x26, 0xf9, 0x67, 0xff, 0xe6, 0xa8, 0x34, 0xcd, 0x5c, 0x32, 0x36, 0x57, 0x47,
-	0x43, 0xac, 0x3f, 0x61, 0xf6, 0xde, 0xbc, 0x91, 0x06, 0x55, 0x1c, 0xc4, 0xa5, 0xe1, 0x32, 0x36,
-	0x9b, 0x46, 0x37, 0x93, 0x79, 0x24, 0xcf, 0xd5, 0x9b, 0x2f, 0xa7, 0xc4, 0x16, 0x9b, 0xaa, 0x00,
-	0xeb, 0xd8, 0xdb, 0xc4, 0x9e, 0x43, 0x6c, 0xe4, 0x7a, 0xa2, 0xca, 0x03, 0x04, 0x7f, 0x9b, 0x17,
-	0xc6, 0xe2, 0x89, 0x0d, 0xbe, 0x0d, 0xc8, 0x4f, 0xb1, 0xa1, 0x07, 0x25, 0x57, 0x47, 0x8e, 0xef,
-	0xd8, 0xac, 0x6d, 0xdc, 0xdd, 0x3c, 0x80, 0xfa, 0xa6, 0x66, 0x91, 0xc6, 0x2d, 0xe0, 0x7b, 0x33,
-	0x51, 0xb0, 0x38, 0xda, 0x10, 0x6d, 0x0d, 0xc5, 0x16, 0x87, 0x39, 0x12, 0x39, 0x54, 0x13, 0x2e,
-	0x88, 0xe3, 0xb1, 0x25, 0xd0, 0x46, 0x0c, 0x71, 0x48, 0x6c, 0x19, 0x81, 0x2f, 0x36, 0x7e, 0x2c,
-	0xd1, 0x47, 0xde, 0x31, 0x84, 0xfb, 0x86, 0x77, 0xb0, 0xdd, 0xd5, 0x2c, 0x37, 0x8d, 0x08, 0x14,
-	0xf1, 0x04, 0x22, 0x70, 0x7c, 0x21, 0x82, 0x0e, 0xd5, 0xc8, 0x74, 0x0c, 0x25, 0xbd, 0x0a, 0x49,
-	0x9a, 0xcf, 0x35, 0x17, 0xc7, 0x23, 0x8a, 0x5d, 0x0e, 0xa0, 0xea, 0xdb, 0x2b, 0x53, 0xee, 0x8b,
-	0xc3, 0x24, 0x0d, 0x70, 0x86, 0xb8, 0x5b, 0x32, 0x6a, 0xd8, 0xdd, 0x06, 0x9b, 0x7f, 0x94, 0x6e,
-	0x68, 0x34, 0xca, 0xdd, 0x86, 0x4f, 0x14, 0x58, 0x3c, 0x89, 0x0d, 0xda, 0x92, 0x83, 0x55, 0xe2,
-	0xdc, 0x30, 0x31, 0x9e, 0x0c, 0x99, 0xdb, 0xc9, 0x67, 0xd0, 0x7d, 0x28, 0xb0, 0x6a, 0x1e, 0x3d,
-	0x3f, 0xba, 0xd0, 0xe7, 0xdc, 0xaf, 0x8d, 0xc1, 0x12, 0x8c, 0x0f, 0xe1, 0xdc, 0x90, 0x32, 0x3f,
-	0x31, 0xcf, 0x8d, 0x6e, 0x09, 0xc6, 0x78, 0xf9, 0xd2, 0x6f, 0xf3, 0x50, 0xf4, 0x9f, 0x1a, 0x3c,
-	0x83, 0x1a, 0xf6, 0x19, 0x14, 0x95, 0x1f, 0xc3, 0x74, 0xec, 0xcd, 0x72, 0xa2, 0x8d, 0x24, 0xbf,
-	0x6b, 0x1e, 0x17, 0x34, 0xef, 0xf3, 0xff, 0x64, 0x14, 0xf9, 0xe5, 0x85, 0x61, 0x85, 0x69, 0x3c,
-	0xb5, 0x8c, 0x61, 0xfc, 0xd4, 0x13, 0xc9, 0x16, 0x40, 0x28, 0xd0, 0x8f, 0xfe, 0xfd, 0x87, 0xc4,
-	0xae, 0x71, 0x02, 0x6f, 0x9e, 0xd0, 0x3d, 0x46, 0xb3, 0x5b, 0xbe, 0xf3, 0x9d, 0x57, 0x3b, 0x86,
-	0x77, 0xd0, 0xdf, 0x23, 0x5f, 0x6e, 0x33, 0xd4, 0x97, 0x0d, 0x9b, 0xff, 0x75, 0xdb, 0x37, 0x90,
-	0xdb, 0x94, 0xfa, 0x36, 0xd9, 0xa3, 0xb7, 0xb7, 0x57, 0xa0, 0xab, 0x3b, 0xff, 0x0f, 0x00, 0x00,
-	0xff, 0xff, 0x99, 0xf2, 0x88, 0x67, 0x64, 0x3b, 0x00, 0x00,
+	// 3500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3b, 0x49, 0x6f, 0x1c, 0xc7,
+	0xd5, 0xea, 0xd9, 0xe7, 0xcd, 0xc2, 0x61, 0x49, 0xa6, 0x46, 0xa3, 0x8d, 0x6a, 0x5b, 0x32, 0x2d,
+	0xcb, 0x94, 0x4c, 0xd9, 0xf8, 0x84, 0xcf, 0x1b, 0x2c, 0x51, 0xa2, 0x07, 0x1f, 0xa9, 0x8f, 0x6e,
+	0xd2, 0x56, 0x10, 0x07, 0x69, 0x34, 0xa7, 0x8b, 0xc3, 0x36, 0xa7, 0xbb, 0x47, 0xdd, 0x3d, 0xa2,
+	0xe8, 0x8b, 0x85, 0x04, 0x08, 0x90, 0x20, 0xc8, 0x82, 0x5c, 0x12, 0x20, 0x87, 0x20, 0x41, 0x80,
+	0x2c, 0x87, 0x04, 0x30, 0x72, 0x48, 0x82, 0xdc, 0x8d, 0xe4, 0x90, 0x9f, 0x90, 0x63, 0x6e, 0xf9,
+	0x0d, 0x41, 0x2d, 0x5d, 0xbd, 0xce, 0x4c, 0x93, 0x23, 0x59, 0xb7, 0xa9, 0x57, 0xef, 0xbd, 0x7a,
+	0x55, 0xf5, 0xf6, 0xae, 0x81, 0x96, 0xae, 0x79, 0x9a, 0xda, 0xb3, 0x6d, 0x47, 0x5f, 0x1e, 0x3a,
+	0xb6, 0x67, 0xa3, 0x79, 0xd3, 0x18, 0x3c, 0x1a, 0xb9, 0x6c, 0xb4, 0x4c, 0xa6, 0x3b, 0xf5, 0x9e,
+	0x6d, 0x9a, 0xb6, 0xc5, 0x40, 0x9d, 0xa6, 0x61, 0x79, 0xd8, 0xb1, 0xb4, 0x01, 0x1f, 0xd7, 0xc3,
+	0x04, 0x9d, 0xba, 0xdb, 0xdb, 0xc3, 0xa6, 0xc6, 0x46, 0x72, 0x19, 0x8a, 0x77, 0xcd, 0xa1, 0x77,
+	0x28, 0xff, 0x54, 0x82, 0xfa, 0xbd, 0xc1, 0xc8, 0xdd, 0x53, 0xf0, 0xc3, 0x11, 0x76, 0x3d, 0x74,
+	0x03, 0x0a, 0x3b, 0x9a, 0x8b, 0xdb, 0xd2, 0xa2, 0xb4, 0x54, 0x5b, 0x39, 0xb7, 0x1c, 0x59, 0x95,
+	0xaf, 0xb7, 0xe1, 0xf6, 0x6f, 0x6b, 0x2e, 0x56, 0x28, 0x26, 0x42, 0x50, 0xd0, 0x77, 0xba, 0xab,
+	0xed, 0xdc, 0xa2, 0xb4, 0x94, 0x57, 0xe8, 0x6f, 0x74, 0x01, 0xc0, 0xc5, 0x7d, 0x13, 0x5b, 0x5e,
+	0x77, 0xd5, 0x6d, 0xe7, 0x17, 0xf3, 0x4b, 0x79, 0x25, 0x04, 0x41, 0x32, 0xd4, 0x7b, 0xf6, 0x60,
+	0x80, 0x7b, 0x9e, 0x61, 0x5b, 0xdd, 0xd5, 0x76, 0x81, 0xd2, 0x46, 0x60, 0xf2, 0xcf, 0x25, 0x68,
+	0x70, 0xd1, 0xdc, 0xa1, 0x6d, 0xb9, 0x18, 0xdd, 0x84, 0x92, 0xeb, 0x69, 0xde, 0xc8, 0xe5, 0xd2,
+	0x9d, 0x4d, 0x95, 0x6e, 0x8b, 0xa2, 0x28, 0x1c, 0x35, 0x55, 0xbc, 0xf8, 0xf2, 0xf9, 0xe4, 0xf2,
+	0xb1, 0x2d, 0x14, 0xe2, 0x5b, 0x90, 0x7f, 0x2c, 0x41, 0x6b, 0xcb, 0x1f, 0xfa, 0xa7, 0x77, 0x0a,
+	0x8a, 0x3d, 0x7b, 0x64, 0x79, 0x54, 0xc0, 0x86, 0xc2, 0x06, 0xe8, 0x12, 0xd4, 0x7b, 0x7b, 0x9a,
+	0x65, 0xe1, 0x81, 0x6a, 0x69, 0x26, 0xa6, 0xa2, 0x54, 0x95, 0x1a, 0x87, 0xdd, 0xd7, 0x4c, 0x9c,
+	0x49, 0xa2, 0x45, 0xa8, 0x0d, 0x35, 0xc7, 0x33, 0x22, 0x67, 0x16, 0x06, 0xc9, 0xbf, 0x90, 0x60,
+	0xe1, 0x7d, 0xd7, 0x35, 0xfa, 0x56, 0x42, 0xb2, 0x05, 0x28, 0x59, 0xb6, 0x8e, 0xbb, 0xab, 0x54,
+	0xb4, 0xbc, 0xc2, 0x47, 0xe8, 0x2c, 0x54, 0x87, 0x18, 0x3b, 0xaa, 0x63, 0x0f, 0x7c, 0xc1, 0x2a,
+	0x04, 0xa0, 0xd8, 0x03, 0x8c, 0x3e, 0x84, 0x79, 0x37, 0xc6, 0x88, 0xdd, 0x66, 0x6d, 0xe5, 0xc5,
+	0xe5, 0x84, 0x3e, 0x2e, 0xc7, 0x17, 0x55, 0x92, 0xd4, 0xf2, 0x93, 0x1c, 0x9c, 0x14, 0x78, 0x4c,
+	0x56, 0xf2, 0x9b, 0x9c, 0x9c, 0x8b, 0xfb, 0x42, 0x3c, 0x36, 0xc8, 0x72, 0x72, 0xe2, 0xc8, 0xf3,
+	0xe1, 0x23, 0xcf, 0xa0, 0x60, 0xf1, 0xf3, 0x2c, 0x26, 0xce, 0x13, 0x5d, 0x84, 0x1a, 0x7e, 0x3c,
+	0x34, 0x1c, 0xac, 0x7a, 0x86, 0x89, 0xdb, 0xa5, 0x45, 0x69, 0xa9, 0xa0, 0x00, 0x03, 0x6d, 0x1b,
+	0x66, 0x58, 0x23, 0xcb, 0x99, 0x35, 0x52, 0xfe, 0xa5, 0x04, 0xa7, 0x13, 0xb7, 0xc4, 0x55, 0x5c,
+	0x81, 0x16, 0xdd, 0x79, 0x70, 0x32, 0x44, 0xd9, 0xc9, 0x81, 0x5f, 0x99, 0x74, 0xe0, 0x01, 0xba,
+	0x92, 0xa0, 0x0f, 0x09, 0x99, 0xcb, 0x2e, 0xe4, 0x3e, 0x9c, 0x5e, 0xc3, 0x1e, 0x5f, 0x80, 0xcc,
+	0x61, 0xf7, 0xf8, 0x2e, 0x22, 0x6a, 0x4b, 0xb9, 0x84, 0x2d, 0xfd, 0x31, 0x07, 0xad, 0xf0, 0x52,
+	0x5d, 0x6b, 0xd7, 0x46, 0xe7, 0xa0, 0x2a, 0x50, 0xb8, 0x56, 0x04, 0x00, 0xf4, 0x3f, 0x50, 0x24,
+	0x92, 0x32, 0x95, 0x68, 0xae, 0x5c, 0x4a, 0xdf, 0x53, 0x88, 0xa7, 0xc2, 0xf0, 0x51, 0x17, 0x9a,
+	0xae, 0xa7, 0x39, 0x9e, 0x3a, 0xb4, 0x5d, 0x7a, 0xcf, 0x54, 0x71, 0x6a, 0x2b, 0x72, 0x94, 0x83,
+	0x70, 0xa6, 0x1b, 0x6e, 0x7f, 0x93, 0x63, 0x2a, 0x0d, 0x4a, 0xe9, 0x0f, 0xd1, 0x5d, 0xa8, 0x63,
+	0x4b, 0x0f, 0x18, 0x15, 0x32, 0x33, 0xaa, 0x61, 0x4b, 0x17, 0x6c, 0x82, 0xfb, 0x29, 0x66, 0xbf,
+	0x9f, 0xef, 0x4b, 0xd0, 0x4e, 0x5e, 0xd0, 0x2c, 0x8e, 0xf2, 0x2d, 0x46, 0x84, 0xd9, 0x05, 0x4d,
+	0xb4, 0x70, 0x71, 0x49, 0x0a, 0x27, 0x91, 0x0d, 0x78, 0x21, 0x90, 0x86, 0xce, 0x3c, 0x33, 0x65,
+	0xf9, 0xb6, 0x04, 0x0b, 0xf1, 0xb5, 0x66, 0xd9, 0xf7, 0x1b, 0x50, 0x34, 0xac, 0x5d, 0xdb, 0xdf,
+	0xf6, 0x85, 0x09, 0x76, 0x46, 0xd6, 0x62, 0xc8, 0xb2, 0x09, 0x67, 0xd7, 0xb0, 0xd7, 0xb5, 0x5c,
+	0xec, 0x78, 0xb7, 0x0d, 0x6b, 0x60, 0xf7, 0x37, 0x35, 0x6f, 0x6f, 0x06, 0x1b, 0x89, 0xa8, 0x7b,
+	0x2e, 0xa6, 0xee, 0xf2, 0x6f, 0x24, 0x38, 0x97, 0xbe, 0x1e, 0xdf, 0x7a, 0x07, 0x2a, 0xbb, 0x06,
+	0x1e, 0xe8, 0xdd, 0x55, 0xe6, 0x30, 0xf2, 0x8a, 0x18, 0x13, 0x5b, 0x19, 0x12, 0x64, 0xbe, 0xc3,
+	0x4b, 0x63, 0x14, 0x74, 0xcb, 0x73, 0x0c, 0xab, 0xbf, 0x6e, 0xb8, 0x9e, 0xc2, 0xf0, 0x43, 0xe7,
+	0x99, 0xcf, 0xae, 0x99, 0xdf, 0x93, 0xe0, 0xc2, 0x1a, 0xf6, 0xee, 0x08, 0x57, 0x4b, 0xe6, 0x0d,
+	0xd7, 0x33, 0x7a, 0xee, 0xd3, 0x4d, 0x32, 0x32, 0xc4, 0x4c, 0xf9, 0x87, 0x12, 0x5c, 0x1c, 0x2b,
+	0x0c, 0x3f, 0x3a, 0xee, 0x4a, 0x7c, 0x47, 0x9b, 0xee, 0x4a, 0xfe, 0x0f, 0x1f, 0x7e, 0xac, 0x0d,
+	0x46, 0x78, 0x53, 0x33, 0x1c, 0xe6, 0x4a, 0x8e, 0xe9, 0x58, 0x7f, 0x2f, 0xc1, 0xf9, 0x35, 0xec,
+	0x6d, 0xfa, 0x61, 0xe6, 0x39, 0x9e, 0x4e, 0x86, 0x8c, 0xe2, 0x07, 0xec, 0x32, 0x53, 0xa5, 0x7d,
+	0x2e, 0xc7, 0x77, 0x81, 0xda, 0x41, 0xc8, 0x20, 0xef, 0xb0, 0x5c, 0x80, 0x1f, 0x9e, 0xfc, 0x24,
+	0x0f, 0xf5, 0x8f, 0x79, 0x7e, 0x40, 0xa6, 0x13, 0xe7, 0x20, 0xa5, 0x9f, 0x43, 0x28, 0xa5, 0x48,
+	0xcb, 0x32, 0xd6, 0xa0, 0xe1, 0x62, 0xbc, 0x7f, 0x9c, 0xa0, 0x51, 0x27, 0x84, 0xfe, 0x08, 0xad,
+	0xc3, 0xfc, 0xc8, 0xda, 0x25, 0x69, 0x2d, 0xd6, 0xf9, 0x2e, 0x58, 0x76, 0x39, 0xdd, 0xf3, 0x24,
+	0x09, 0xd1, 0x07, 0x30, 0x17, 0xe7, 0x55, 0xcc, 0xc4, 0x2b, 0x4e, 0x86, 0xba, 0xd0, 0xd2, 0x1d,
+	0x7b, 0x38, 0xc4, 0xba, 0xea, 0xfa, 0xac, 0x4a, 0xd9, 0x58, 0x71, 0x3a, 0x9f, 0x95, 0xfc, 0x5d,
+	0x09, 0x16, 0x1e, 0x68, 0x5e, 0x6f, 0x6f, 0xd5, 0xe4, 0x97, 0x33, 0x83, 0x6a, 0xbf, 0x03, 0xd5,
+	0x47, 0xfc, 0x22, 0x7c, 0xff, 0x75, 0x31, 0x45, 0xa0, 0xf0, 0x95, 0x2b, 0x01, 0x85, 0xfc, 0xa5,
+	0x04, 0xa7, 0x68, 0x11, 0xe1, 0x4b, 0xf7, 0xd5, 0x1b, 0xd9, 0x94, 0x42, 0x02, 0x5d, 0x81, 0xa6,
+	0xa9, 0x39, 0xfb, 0x5b, 0x01, 0x4e, 0x91, 0xe2, 0xc4, 0xa0, 0xf2, 0x63, 0x00, 0x3e, 0xda, 0x70,
+	0xfb, 0xc7, 0x90, 0xff, 0x16, 0x94, 0xf9, 0xaa, 0xdc, 0xde, 0xa6, 0x5d, 0xac, 0x8f, 0x2e, 0xff,
+	0x5d, 0x82, 0x66, 0xe0, 0x41, 0xa9, 0x55, 0x35, 0x21, 0x27, 0x6c, 0x29, 0xd7, 0x5d, 0x45, 0xef,
+	0x40, 0x89, 0x15, 0x98, 0x9c, 0xf7, 0xe5, 0x28, 0x6f, 0x36, 0xb7, 0x1c, 0x72, 0xc3, 0x14, 0xa0,
+	0x70, 0x22, 0x72, 0x46, 0xc2, 0xeb, 0x88, 0x7a, 0x31, 0x80, 0xa0, 0x2e, 0xcc, 0x45, 0x93, 0x36,
+	0xdf, 0x66, 0x16, 0xc7, 0x79, 0x9b, 0x55, 0xcd, 0xd3, 0xa8, 0xb3, 0x69, 0x46, 0x72, 0x36, 0x57,
+	0xfe, 0x4f, 0x11, 0x6a, 0xa1, 0x5d, 0x26, 0x76, 0x12, 0xbf, 0xd2, 0xdc, 0x74, 0xbf, 0x99, 0x4f,
+	0x56, 0x0e, 0x97, 0xa1, 0x69, 0xd0, 0x58, 0xad, 0x72, 0x55, 0xa4, 0xce, 0xb5, 0xaa, 0x34, 0x18,
+	0x94, 0xdb, 0x05, 0xba, 0x00, 0x35, 0x6b, 0x64, 0xaa, 0xf6, 0xae, 0xea, 0xd8, 0x07, 0x2e, 0x2f,
+	0x41, 0xaa, 0xd6, 0xc8, 0xfc, 0xff, 0x5d, 0xc5, 0x3e, 0x70, 0x83, 0x2c, 0xb7, 0x74, 0xc4, 0x2c,
+	0xf7, 0x02, 0xd4, 0x4c, 0xed, 0x31, 0xe1, 0xaa, 0x5a, 0x23, 0x93, 0x56, 0x27, 0x79, 0xa5, 0x6a,
+	0x6a, 0x8f, 0x15, 0xfb, 0xe0, 0xfe, 0xc8, 0x44, 0x4b, 0xd0, 0x1a, 0x68, 0xae, 0xa7, 0x86, 0xcb,
+	0x9b, 0x0a, 0x2d, 0x6f, 0x9a, 0x04, 0x7e, 0x37, 0x28, 0x71, 0x92, 0xf9, 0x72, 0x75, 0x86, 0x7c,
+	0x59, 0x37, 0x07, 0x01, 0x23, 0xc8, 0x9e, 0x2f, 0xeb, 0xe6, 0x40, 0xb0, 0xb9, 0x05, 0xe5, 0x1d,
+	0x9a, 0x01, 0xb9, 0xed, 0xda, 0x58, 0x0f, 0x75, 0x8f, 0x24, 0x3f, 0x2c, 0x51, 0x52, 0x7c, 0x74,
+	0xf4, 0x36, 0x54, 0x69, 0xe8, 0xa1, 0xb4, 0xf5, 0x4c, 0xb4, 0x01, 0x01, 0xa1, 0xd6, 0xf1, 0xc0,
+	0xd3, 0x28, 0x75, 0x23, 0x1b, 0xb5, 0x20, 0x40, 0x37, 0xe0, 0x64, 0xcf, 0xc1, 0x9a, 0x87, 0xf5,
+	0xdb, 0x87, 0x77, 0x6c, 0x73, 0xa8, 0x51, 0x65, 0x6a, 0x37, 0x17, 0xa5, 0xa5, 0x8a, 0x92, 0x36,
+	0x45, 0x1c, 0x43, 0x4f, 0x8c, 0xee, 0x39, 0xb6, 0xd9, 0x9e, 0x63, 0x8e, 0x21, 0x0a, 0x45, 0xe7,
+	0x01, 0x7c, 0xd7, 0xad, 0x79, 0xed, 0x16, 0xbd, 0xc5, 0x2a, 0x87, 0xbc, 0xef, 0xc9, 0x9f, 0xc3,
+	0xa9, 0x40, 0x43, 0x42, 0xb7, 0x91, 0xbc, 0x58, 0xe9, 0xb8, 0x17, 0x3b, 0x39, 0x77, 0xfd, 0x67,
+	0x01, 0x16, 0xb6, 0xb4, 0x47, 0xf8, 0xd9, 0xa7, 0xc9, 0x99, 0xfc, 0xf1, 0x3a, 0xcc, 0xd3, 0xcc,
+	0x78, 0x25, 0x24, 0x4f, 0xbb, 0x90, 0xe9, 0x3a, 0x93, 0x84, 0xe8, 0x3d, 0x92, 0x3a, 0xe0, 0xde,
+	0xfe, 0xa6, 0x6d, 0x04, 0xd1, 0xf7, 0x7c, 0x0a, 0x9f, 0x3b, 0x02, 0x4b, 0x09, 0x53, 0xa0, 0xcd,
+	0xa4, 0x6b, 0x63, 0x71, 0xf7, 0xe5, 0x89, 0xf5, 0x57, 0x70, 0xfa, 0x71, 0x0f, 0x87, 0xda, 0x50,
+	0xe6, 0xd1, 0x9d, 0xda, 0x7d, 0x45, 0xf1, 0x87, 0x68, 0x13, 0x4e, 0xb2, 0x1d, 0x6c, 0x71, 0xa5,
+	0x66, 0x9b, 0xaf, 0x64, 0xda, 0x7c, 0x1a, 0x69, 0xd4, 0x26, 0xaa, 0x47, 0xb5, 0x89, 0x36, 0x94,
+	0xb9, 0x9e, 0x52, 0x5f, 0x50, 0x51, 0xfc, 0x21, 0xb9, 0x66, 0xc3, 0x1c, 0xda, 0x8e, 0x67, 0x58,
+	0xfd, 0x76, 0x8d, 0xce, 0x05, 0x00, 0x52, 0x62, 0x40, 0x70, 0x9e, 0x53, 0x3a, 0x05, 0xef, 0x42,
+	0x45, 0x68, 0x78, 0x2e, 0xb3, 0x86, 0x0b, 0x9a, 0xb8, 0x8f, 0xce, 0xc7, 0x7c, 0xb4, 0xfc, 0x0f,
+	0x09, 0xea, 0xab, 0x64, 0x4b, 0xeb, 0x76, 0x9f, 0x46, 0x94, 0xcb, 0xd0, 0x74, 0x70, 0xcf, 0x76,
+	0x74, 0x15, 0x5b, 0x9e, 0x63, 0x60, 0x56, 0x8d, 0x16, 0x94, 0x06, 0x83, 0xde, 0x65, 0x40, 0x82,
+	0x46, 0xdc, 0xae, 0xeb, 0x69, 0xe6, 0x50, 0xdd, 0x25, 0xe6, 0x9d, 0x63, 0x68, 0x02, 0x4a, 0xad,
+	0xfb, 0x12, 0xd4, 0x03, 0x34, 0xcf, 0xa6, 0xeb, 0x17, 0x94, 0x9a, 0x80, 0x6d, 0xdb, 0xe8, 0x25,
+	0x68, 0xd2, 0x33, 0x55, 0x07, 0x76, 0x5f, 0x25, 0x95, 0x1b, 0x0f, 0x36, 0x75, 0x9d, 0x8b, 0x45,
+	0xee, 0x2a, 0x8a, 0xe5, 0x1a, 0x9f, 0x61, 0x1e, 0x6e, 0x04, 0xd6, 0x96, 0xf1, 0x19, 0x26, 0xb1,
+	0xbe, 0x41, 0x62, 0xe7, 0x7d, 0x5b, 0xc7, 0xdb, 0xc7, 0xcc, 0x34, 0x32, 0x74, 0xed, 0xce, 0x41,
+	0x55, 0xec, 0x80, 0x6f, 0x29, 0x00, 0xa0, 0x7b, 0xd0, 0xf4, 0x93, 0x50, 0x95, 0xd5, 0x16, 0x85,
+	0xb1, 0x99, 0x5f, 0x28, 0xfa, 0xb9, 0x4a, 0xc3, 0x27, 0xa3, 0x43, 0xf9, 0x1e, 0xd4, 0xc3, 0xd3,
+	0x64, 0xd5, 0xad, 0xb8, 0xa2, 0x08, 0x00, 0xd1, 0xc6, 0xfb, 0x23, 0x93, 0xdc, 0x29, 0x77, 0x2c,
+	0xfe, 0x90, 0xb4, 0x1c, 0x1a, 0x3c, 0x64, 0x6f, 0x89, 0xae, 0x32, 0xdd, 0x9a, 0x44, 0xb7, 0x46,
+	0x7f, 0xa3, 0xff, 0x8d, 0xb6, 0xa4, 0x5e, 0x4a, 0x75, 0x02, 0x94, 0x09, 0xcd, 0x8e, 0x23, 0xf1,
+	0x3a, 0x4b, 0x2d, 0xfb, 0x84, 0x28, 0x1a, 0xbf, 0x1a, 0xaa, 0x68, 0x6d, 0x28, 0x6b, 0xba, 0xee,
+	0x60, 0xd7, 0xe5, 0x72, 0xf8, 0x43, 0x32, 0xf3, 0x08, 0x3b, 0xae, 0xaf, 0xf2, 0x79, 0xc5, 0x1f,
+	0xa2, 0xb7, 0xa1, 0x22, 0xd2, 0xe9, 0x7c, 0x5a, 0x0a, 0x15, 0x96, 0x93, 0xd7, 0x5e, 0x82, 0x42,
+	0xfe, 0x53, 0x0e, 0x9a, 0xfc, 0xc0, 0x6e, 0xf3, 0x98, 0x3a, 0xd9, 0xf8, 0x6e, 0x43, 0x7d, 0x37,
+	0xb0, 0xfd, 0x49, 0x3d, 0x96, 0xb0, 0x8b, 0x88, 0xd0, 0x4c, 0x33, 0xc0, 0x68, 0x54, 0x2f, 0xcc,
+	0x14, 0xd5, 0x8b, 0x47, 0xf5, 0x60, 0xc9, 0x3c, 0xaf, 0x94, 0x92, 0xe7, 0xc9, 0xdf, 0x80, 0x5a,
+	0x88, 0x01, 0xf5, 0xd0, 0xac, 0x39, 0xc3, 0x4f, 0xcc, 0x1f, 0xa2, 0x9b, 0x41, 0x6e, 0xc3, 0x8e,
+	0xea, 0x4c, 0x8a, 0x2c, 0xb1, 0xb4, 0x86, 0xd8, 0x6c, 0x89, 0x73, 0x26, 0x1d, 0x6b, 0xe6, 0x5f,
+	0x68, 0xde, 0xc7, 0xb8, 0x03, 0x07, 0x91, 0xc4, 0xef, 0xe9, 0x79, 0x9d, 0x33, 0x50, 0x89, 0xf9,
+	0x9b, 0x32, 0x0f, 0x0b, 0xfe, 0x54, 0xc8, 0xc9, 0x94, 0x07, 0xcc, 0xbf, 0x10, 0x01, 0x4d, 0x6c,
+	0xda, 0xce, 0x21, 0x9b, 0x2d, 0x31, 0x01, 0x19, 0x88, 0x3a, 0xa0, 0x2f, 0x25, 0xda, 0x79, 0x56,
+	0x70, 0xcf, 0x7e, 0x84, 0x9d, 0xc3, 0xd9, 0xfb, 0x7b, 0x6f, 0x85, 0x34, 0x3e, 0x63, 0x01, 0x29,
+	0x08, 0xd0, 0x5b, 0xc1, 0x7d, 0xe4, 0xd3, 0xda, 0x1b, 0x61, 0x17, 0xc4, 0xf5, 0x35, 0xb8, 0x97,
+	0x1f, 0xb1, 0x4e, 0x65, 0x74, 0x2b, 0xc7, 0x4d, 0x7c, 0x9e, 0x4a, 0x5d, 0x22, 0xff, 0x44, 0x82,
+	0x33, 0x6b, 0xd8, 0xbb, 0x17, 0xad, 0xfe, 0x9f, 0xb7, 0x54, 0x26, 0x74, 0xd2, 0x84, 0x9a, 0xe5,
+	0xd6, 0x3b, 0x50, 0x11, 0x7d, 0x0c, 0xd6, 0x43, 0x16, 0x63, 0xf9, 0x3b, 0x12, 0xb4, 0xf9, 0x2a,
+	0x74, 0x4d, 0x92, 0x73, 0x0f, 0xb0, 0x87, 0xf5, 0xaf, 0xba, 0xb0, 0xfe, 0x9b, 0x04, 0xad, 0x70,
+	0x48, 0x20, 0xb3, 0xe8, 0x4d, 0x28, 0xd2, 0xfe, 0x05, 0x97, 0x60, 0xaa, 0xb2, 0x32, 0x6c, 0xe2,
+	0x53, 0x68, 0x1e, 0xb8, 0x2d, 0xa2, 0x17, 0x1f, 0x06, 0x71, 0x29, 0x7f, 0xf4, 0xb8, 0xc4, 0xe3,
+	0xb4, 0x3d, 0x22, 0x7c, 0x59, 0x7f, 0x30, 0x00, 0xc8, 0x5f, 0xe4, 0xa0, 0x1d, 0x14, 0x2c, 0x5f,
+	0x79, 0x60, 0x18, 0x93, 0xce, 0xe6, 0x9f, 0x52, 0x3a, 0x5b, 0x98, 0x3d, 0x18, 0x14, 0xd3, 0x82,
+	0xc1, 0x5f, 0x73, 0xd0, 0x0c, 0x4e, 0x6d, 0x73, 0xa0, 0x59, 0xe4, 0xeb, 0xec, 0x70, 0xa0, 0x05,
+	0xed, 0x49, 0x3e, 0x42, 0x5b, 0x22, 0x11, 0x8a, 0x9e, 0xd3, 0xab, 0x69, 0x77, 0x38, 0xe6, 0x22,
+	0x94, 0x18, 0x0b, 0x52, 0x2f, 0xb2, 0x8a, 0x83, 0x56, 0xfd, 0x3c, 0xf9, 0x62, 0xca, 0x42, 0x0a,
+	0xfe, 0x6b, 0x80, 0xf8, 0x0d, 0xab, 0x86, 0xa5, 0xba, 0xb8, 0x67, 0x5b, 0x3a, 0xbb, 0xfb, 0xa2,
+	0xd2, 0xe2, 0x33, 0x5d, 0x6b, 0x8b, 0xc1, 0xd1, 0x9b, 0x50, 0xf0, 0x0e, 0x87, 0xcc, 0xcd, 0x37,
+	0x57, 0x2e, 0x4d, 0x94, 0x6b, 0xfb, 0x70, 0x88, 0x15, 0x8a, 0x4e, 0x1a, 0x3e, 0x84, 0x95, 0xe7,
+	0x68, 0x8f, 0x78, 0xcc, 0x2c, 0x28, 0x21, 0x08, 0xd1, 0x66, 0xff, 0x0c, 0xcb, 0x2c, 0xb6, 0xf0,
+	0xa1, 0xfc, 0xe7, 0x1c, 0xb4, 0x02, 0x96, 0x0a, 0x76, 0x47, 0x03, 0x6f, 0xec, 0xf9, 0x4d, 0xae,
+	0x16, 0xa7, 0x25, 0x16, 0xef, 0x41, 0x8d, 0xdf, 0xe7, 0x11, 0xf4, 0x01, 0x18, 0xc9, 0xfa, 0x04,
+	0x05, 0x2d, 0x3e, 0x25, 0x05, 0x2d, 0x1d, 0x51, 0x41, 0xe5, 0x2d, 0x58, 0xf0, 0xfd, 0x5e, 0x80,
+	0xb0, 0x81, 0x3d, 0x6d, 0x42, 0x46, 0x72, 0x11, 0x6a, 0x2c, 0x9e, 0xb1, 0x48, 0xcf, 0x72, 0x79,
+	0xd8, 0x11, 0x25, 0xb0, 0xfc, 0x4d, 0x38, 0x45, 0xfd, 0x46, 0xbc, 0xd7, 0x9b, 0xa5, 0xf1, 0x2e,
+	0x43, 0x3d, 0x54, 0x15, 0x30, 0xed, 0xae, 0x2a, 0x11, 0x98, 0xbc, 0x0e, 0x2f, 0xc4, 0xf8, 0xcf,
+	0x10, 0x17, 0xe4, 0xdf, 0x4a, 0xe4, 0x0c, 0x22, 0xdf, 0x4d, 0x8f, 0x1f, 0xfd, 0xce, 0x8b, 0xd6,
+	0xae, 0x6a, 0xe8, 0x71, 0xfd, 0xd2, 0xd1, 0xbb, 0x50, 0xb5, 0xf0, 0x81, 0x1a, 0x76, 0xbe, 0x19,
+	0x3a, 0x78, 0x15, 0x0b, 0x1f, 0xd0, 0x5f, 0xf2, 0x7d, 0x38, 0x9d, 0x10, 0x75, 0x96, 0xbd, 0xff,
+	0x45, 0x82, 0x33, 0xab, 0x8e, 0x3d, 0xfc, 0xd8, 0x70, 0xbc, 0x91, 0x36, 0x88, 0x7e, 0x39, 0x79,
+	0x36, 0x75, 0xde, 0x07, 0xa1, 0x30, 0xcc, 0xfc, 0xf2, 0xb5, 0x14, 0x75, 0x4d, 0x0a, 0xc5, 0x37,
+	0x1d, 0x0a, 0xda, 0xff, 0xce, 0xc3, 0x99, 0xb1, 0x78, 0x53, 0x82, 0x4d, 0x96, 0x2c, 0x25, 0xb5,
+	0x2d, 0x94, 0x3f, 0x6e, 0x5b, 0x68, 0x8c, 0xe5, 0x17, 0x9e, 0x92, 0xe5, 0x1f, 0xb9, 0x4e, 0xf9,
+	0x00, 0xa2, 0x2d, 0xbb, 0x76, 0x29, 0x73, 0x27, 0x24, 0x4a, 0x88, 0x6e, 0x03, 0x04, 0xed, 0xab,
+	0x76, 0x39, 0x33, 0x9b, 0x10, 0x15, 0xb9, 0x2d, 0xe1, 0x65, 0xdb, 0x95, 0x98, 0xdb, 0x95, 0x3f,
+	0x84, 0x4e, 0x9a, 0x96, 0xce, 0xa2, 0xf9, 0x5f, 0xe4, 0x00, 0xba, 0xb4, 0x7d, 0xb4, 0xad, 0xb9,
+	0xfb, 0xc7, 0xcb, 0x28, 0x5f, 0x84, 0x46, 0xa0, 0x30, 0x81, 0xbd, 0x87, 0xb5, 0x48, 0x27, 0x26,
+	0x21, 0x12, 0x5b, 0x82, 0x93, 0x48, 0x76, 0x75, 0xca, 0x27, 0x64, 0x35, 0x4c, 0x29, 0x62, 0x4e,
+	0x8f, 0x3c, 0xcb, 0x22, 0xbd, 0x7b, 0x62, 0x66, 0x3a, 0x8d, 0xad, 0x15, 0xa5, 0xe2, 0xd8, 0x07,
+	0xc4, 0xf8, 0x74, 0x74, 0x1a, 0xca, 0x9e, 0xe6, 0xee, 0x13, 0xfe, 0xac, 0x7e, 0x2a, 0x91, 0x61,
+	0x57, 0x27, 0x6f, 0xa1, 0x76, 0x8d, 0x01, 0x26, 0xaf, 0x91, 0x08, 0x4b, 0x36, 0x20, 0x1f, 0x11,
+	0xd8, 0x03, 0x87, 0x4a, 0xe6, 0x0f, 0xb4, 0x14, 0x9f, 0x94, 0x62, 0x73, 0xc1, 0xa9, 0x51, 0x07,
+	0x44, 0x7c, 0x1a, 0xf5, 0x67, 0x77, 0x6c, 0x9d, 0xb9, 0x8a, 0xe6, 0x98, 0x6f, 0x30, 0x8c, 0x90,
+	0x79, 0xad, 0x80, 0x64, 0x52, 0x5e, 0x4e, 0xf6, 0x45, 0x36, 0x6d, 0xe8, 0xfe, 0x27, 0xa0, 0x92,
+	0x63, 0x1f, 0x74, 0x75, 0x71, 0x1a, 0xec, 0x9d, 0x17, 0xcb, 0x42, 0xc9, 0x69, 0xdc, 0x21, 0x63,
+	0x72, 0x9e, 0xd8, 0x71, 0x6c, 0x47, 0x35, 0xb1, 0xeb, 0x6a, 0x7d, 0xcc, 0x93, 0xae, 0x3a, 0x05,
+	0x6e, 0x30, 0x98, 0xfc, 0xaf, 0x3c, 0x34, 0x83, 0xad, 0xf8, 0x1f, 0x7e, 0x0c, 0xdd, 0xff, 0xf0,
+	0x63, 0xe8, 0xc4, 0x99, 0x3b, 0xcc, 0x15, 0x86, 0x9c, 0x39, 0x87, 0x74, 0x75, 0x12, 0x07, 0x89,
+	0x81, 0x59, 0xb6, 0x8e, 0x83, 0x8b, 0x05, 0x1f, 0xc4, 0xef, 0x35, 0xa2, 0x1f, 0x85, 0x0c, 0xfa,
+	0x51, 0xcc, 0xa0, 0x1f, 0xa5, 0x14, 0xfd, 0x58, 0x80, 0xd2, 0xce, 0xa8, 0xb7, 0x8f, 0x3d, 0x9e,
+	0x1e, 0xf1, 0x51, 0x54, 0x6f, 0x2a, 0x31, 0xbd, 0x11, 0xea, 0x51, 0x0d, 0xab, 0xc7, 0x59, 0xa8,
+	0xb2, 0xaf, 0x0f, 0xaa, 0xe7, 0xd2, 0x36, 0x6c, 0x5e, 0xa9, 0x30, 0xc0, 0xb6, 0x8b, 0x6e, 0xf9,
+	0xb5, 0x43, 0x2d, 0xcd, 0xd0, 0xa9, 0xc7, 0x89, 0x69, 0x88, 0x5f, 0x39, 0xdc, 0x82, 0xf6, 0x1e,
+	0x1e, 0x39, 0xf4, 0xb1, 0x80, 0x4a, 0x10, 0xd5, 0x87, 0x23, 0xec, 0x1c, 0x6a, 0x3b, 0x03, 0xdc,
+	0xae, 0x53, 0xc1, 0x16, 0xc4, 0x3c, 0xe9, 0x6a, 0x7d, 0xe8, 0xcf, 0xa2, 0x37, 0x60, 0x21, 0x46,
+	0x69, 0x58, 0x3a, 0x7e, 0x8c, 0xf5, 0x76, 0x83, 0xd2, 0x9d, 0x8a, 0xd0, 0x75, 0xd9, 0x9c, 0xfc,
+	0x29, 0xa0, 0x40, 0x92, 0xd9, 0x6a, 0xc7, 0xd8, 0x55, 0xe7, 0xe2, 0x57, 0x2d, 0xff, 0x4e, 0x82,
+	0xf9, 0xf0, 0x62, 0xc7, 0x0d, 0xa0, 0xef, 0x42, 0x8d, 0x35, 0xb5, 0x55, 0x62, 0xc0, 0xbc, 0x7a,
+	0x3c, 0x3f, 0xf1, 0x8c, 0x15, 0x30, 0xc4, 0x6f, 0xa2, 0x2a, 0x07, 0xb6, 0xb3, 0x6f, 0x58, 0x7d,
+	0x95, 0x48, 0xe6, 0x9b, 0x4d, 0x9d, 0x03, 0x49, 0xa3, 0x90, 0x7e, 0x8e, 0xbf, 0xf0, 0xd1, 0x50,
+	0xd7, 0x3c, 0x1c, 0xca, 0x24, 0x66, 0x7d, 0x71, 0xf2, 0xa6, 0xff, 0xe8, 0x23, 0x97, 0xad, 0x31,
+	0xcb, 0xb0, 0xe5, 0x3f, 0x48, 0xd0, 0x56, 0xf0, 0xae, 0x83, 0x49, 0xd1, 0xed, 0x9b, 0xc5, 0xb3,
+	0xed, 0x3e, 0x04, 0x5f, 0xa6, 0xf3, 0xc7, 0xf8, 0x32, 0x7d, 0xf5, 0x67, 0x12, 0xcc, 0x27, 0xaa,
+	0x63, 0xd4, 0x04, 0xf8, 0xc8, 0xea, 0xf1, 0xb6, 0x41, 0xeb, 0x04, 0xaa, 0x43, 0xc5, 0x6f, 0x22,
+	0xb4, 0x24, 0x54, 0x83, 0xf2, 0xb6, 0x4d, 0xb1, 0x5b, 0x39, 0xd4, 0x82, 0x3a, 0x23, 0x1c, 0xf5,
+	0x7a, 0xd8, 0x75, 0x5b, 0x79, 0x01, 0xb9, 0xa7, 0x19, 0x83, 0x91, 0x83, 0x5b, 0x05, 0xd4, 0x80,
+	0xea, 0xb6, 0xad, 0xe0, 0x01, 0xd6, 0x5c, 0xdc, 0x2a, 0x22, 0x04, 0x4d, 0x3e, 0xf0, 0x89, 0x4a,
+	0x21, 0x98, 0x4f, 0x56, 0xbe, 0xba, 0x0b, 0xcd, 0x68, 0x71, 0x85, 0x4e, 0xc3, 0xc9, 0x8f, 0x2c,
+	0x1d, 0xef, 0x1a, 0x16, 0xd6, 0x83, 0xa9, 0xd6, 0x09, 0x74, 0x12, 0xe6, 0xba, 0x96, 0x85, 0x9d,
+	0x10, 0x50, 0x22, 0xc0, 0x0d, 0xec, 0xf4, 0x71, 0x08, 0x98, 0x43, 0xf3, 0xd0, 0xd8, 0x30, 0x1e,
+	0x87, 0x40, 0xf9, 0x95, 0x5f, 0x2d, 0x40, 0x95, 0x98, 0xda, 0x1d, 0xdb, 0x76, 0x74, 0x34, 0x04,
+	0x44, 0x5f, 0x54, 0x99, 0x43, 0xdb, 0x12, 0x4f, 0x0f, 0xd1, 0x8d, 0x31, 0x29, 0x40, 0x12, 0x95,
+	0x5f, 0x77, 0xe7, 0xca, 0x18, 0x8a, 0x18, 0xba, 0x7c, 0x02, 0x99, 0x74, 0x45, 0x52, 0x9c, 0x6e,
+	0x1b, 0xbd, 0x7d, 0xff, 0xdb, 0xf9, 0x84, 0x15, 0x63, 0xa8, 0xfe, 0x8a, 0xb1, 0x17, 0x8d, 0x7c,
+	0xc0, 0x9e, 0xbd, 0xf9, 0x1e, 0x43, 0x3e, 0x81, 0x1e, 0xc2, 0xa9, 0x35, 0x1c, 0xb2, 0x12, 0x7f,
+	0xc1, 0x95, 0xf1, 0x0b, 0x26, 0x90, 0x8f, 0xb8, 0xe4, 0x3a, 0x14, 0x69, 0x27, 0x0a, 0xa5, 0x19,
+	0x52, 0xf8, 0x7d, 0x7e, 0x67, 0x71, 0x3c, 0x82, 0xe0, 0xf6, 0x29, 0xcc, 0xc5, 0xde, 0x17, 0xa3,
+	0x57, 0x52, 0xc8, 0xd2, 0x5f, 0x8a, 0x77, 0xae, 0x66, 0x41, 0x15, 0x6b, 0xf5, 0xa1, 0x19, 0x7d,
+	0x8f, 0x85, 0x96, 0x52, 0xe8, 0x53, 0xdf, 0x86, 0x76, 0x5e, 0xc9, 0x80, 0x29, 0x16, 0x32, 0xa1,
+	0x15, 0x7f, 0xef, 0x8a, 0xae, 0x4e, 0x64, 0x10, 0x55, 0xb7, 0x57, 0x33, 0xe1, 0x8a, 0xe5, 0x0e,
+	0xe1, 0x54, 0xda, 0x7b, 0x4b, 0xb4, 0x9c, 0xce, 0x66, 0xdc, 0x43, 0xd0, 0xce, 0xf5, 0xcc, 0xf8,
+	0x62, 0xe9, 0x6f, 0xb1, 0x0e, 0x78, 0xda, 0x9b, 0x45, 0xf4, 0x7a, 0x3a, 0xbb, 0x09, 0x8f, 0x2d,
+	0x3b, 0x2b, 0x47, 0x21, 0x11, 0x42, 0x7c, 0x0e, 0x0b, 0xe9, 0xef, 0xfe, 0xd0, 0x8d, 0x74, 0x7e,
+	0xe3, 0x1f, 0x34, 0x76, 0x5e, 0x3f, 0x02, 0x85, 0x10, 0xc0, 0x8e, 0xbf, 0x28, 0xf6, 0xcd, 0xf0,
+	0xfa, 0x54, 0xad, 0x39, 0x9e, 0x0d, 0x7e, 0x02, 0x73, 0xb1, 0x57, 0x0a, 0xa9, 0x56, 0x93, 0xfe,
+	0x92, 0xa1, 0x33, 0x29, 0xb1, 0x60, 0x26, 0x19, 0xfb, 0x12, 0x80, 0xc6, 0x68, 0x7f, 0xca, 0xd7,
+	0x82, 0xce, 0xd5, 0x2c, 0xa8, 0x62, 0x23, 0x2e, 0x75, 0x97, 0xb1, 0x6e, 0x3a, 0xba, 0x96, 0xce,
+	0x23, 0xfd, 0x4b, 0x40, 0xe7, 0xb5, 0x8c, 0xd8, 0x62, 0x51, 0x15, 0x60, 0x0d, 0x7b, 0x1b, 0xd8,
+	0x73, 0x88, 0x8e, 0x5c, 0x49, 0x3d, 0xf2, 0x00, 0xc1, 0x5f, 0xe6, 0xe5, 0xa9, 0x78, 0x62, 0x81,
+	0xaf, 0x01, 0xf2, 0x43, 0x6c, 0xe8, 0x8d, 0xcc, 0x8b, 0x13, 0x1b, 0x8e, 0xac, 0x3b, 0x38, 0xed,
+	0x6e, 0x1e, 0x42, 0x6b, 0x43, 0xb3, 0x48, 0xa9, 0x19, 0xf0, 0xbd, 0x96, 0x2a, 0x58, 0x1c, 0x6d,
+	0xcc, 0x69, 0x8d, 0xc5, 0x16, 0x9b, 0x39, 0x10, 0x31, 0x54, 0x13, 0x26, 0x88, 0xd1, 0x72, 0x2a,
+	0x9b, 0x24, 0xe2, 0x18, 0xdf, 0x32, 0x01, 0x5f, 0x2c, 0xfc, 0x44, 0x82, 0xb3, 0x49, 0x84, 0x07,
+	0x86, 0xb7, 0x47, 0xfa, 0xd0, 0x6e, 0x16, 0x11, 0x28, 0xe2, 0x11, 0x44, 0xe0, 0xf8, 0x42, 0x04,
+	0x1d, 0x1a, 0x91, 0x7e, 0x1e, 0x4a, 0x7b, 0xe8, 0x92, 0xd6, 0x51, 0xec, 0x2c, 0x4d, 0x47, 0x14,
+	0xab, 0xec, 0x41, 0xc3, 0xd7, 0x57, 0x76, 0xb8, 0xaf, 0x8c, 0x93, 0x34, 0xc0, 0x19, 0x63, 0x6e,
+	0xe9, 0xa8, 0x61, 0x73, 0x4b, 0xb6, 0x2b, 0x50, 0xb6, 0x36, 0xd7, 0x24, 0x73, 0x1b, 0xdf, 0x03,
+	0x61, 0xfe, 0x24, 0xd6, 0x1a, 0x4c, 0x77, 0x56, 0xa9, 0x9d, 0xce, 0xce, 0xd5, 0x2c, 0xa8, 0x62,
+	0xad, 0x07, 0x50, 0x62, 0xf5, 0x07, 0x7a, 0x69, 0x72, 0x69, 0xc2, 0xb9, 0x5f, 0x9e, 0x82, 0x25,
+	0x18, 0xef, 0xc3, 0xe9, 0x31, 0x85, 0x49, 0x6a, 0x9c, 0x9b, 0x5c, 0xc4, 0x4c, 0xb3, 0x72, 0x15,
+	0xe6, 0x13, 0x95, 0x07, 0x4a, 0x4b, 0x0a, 0xc6, 0xd5, 0x27, 0x53, 0x16, 0x58, 0xf9, 0x75, 0x11,
+	0x2a, 0xfe, 0xf3, 0x8c, 0xe7, 0x90, 0x24, 0x3f, 0x87, 0xac, 0xf5, 0x13, 0x98, 0x8b, 0xbd, 0xf3,
+	0x4e, 0x55, 0xc2, 0xf4, 0xb7, 0xe0, 0xd3, 0xee, 0xeb, 0x01, 0xff, 0xf7, 0xa7, 0x08, 0x60, 0x2f,
+	0x8f, 0xcb, 0x7c, 0xe3, 0xb1, 0x6b, 0xaa, 0x22, 0x3c, 0xe3, 0x48, 0x75, 0x1f, 0x20, 0x14, 0x49,
+	0x26, 0x7f, 0x12, 0x23, 0xce, 0x71, 0x9a, 0xc0, 0x1b, 0x47, 0xb4, 0xbf, 0xc9, 0xec, 0x6e, 0xdf,
+	0xfc, 0xfa, 0xeb, 0x7d, 0xc3, 0xdb, 0x1b, 0xed, 0x90, 0x99, 0xeb, 0x0c, 0xf5, 0x35, 0xc3, 0xe6,
+	0xbf, 0xae, 0xfb, 0x0a, 0x72, 0x9d, 0x52, 0x5f, 0x27, 0x6b, 0x0c, 0x77, 0x76, 0x4a, 0x74, 0x74,
+	0xf3, 0xbf, 0x03, 0x00, 0x24, 0x23, 0x5f, 0xb0, 0x98, 0x3c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4047,6 +4113,8 @@ type DataCoordClient interface {
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*ImportTaskResponse, error)
 	UpdateSegmentStatistics(ctx context.Context, in *UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// replaces the schema cached for the collection once it's changed by RootCoord
+	RefreshCollection(ctx context.Context, in *RefreshCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type dataCoordClient struct {
@@ -4282,6 +4350,15 @@ func (c *dataCoordClient) UpdateSegmentStatistics(ctx context.Context, in *Updat
 	return out, nil
 }
 
+func (c *dataCoordClient) RefreshCollection(ctx context.Context, in *RefreshCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/RefreshCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataCoordServer is the server API for DataCoord service.
 type DataCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -4311,6 +4388,8 @@ type DataCoordServer interface {
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(context.Context, *ImportTaskRequest) (*ImportTaskResponse, error)
 	UpdateSegmentStatistics(context.Context, *UpdateSegmentStatisticsRequest) (*commonpb.Status, error)
+	// replaces the schema cached for the collection once it's changed by RootCoord
+	RefreshCollection(context.Context, *RefreshCollectionRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedDataCoordServer can be embedded to have forward compatible implementations.
@@ -4392,6 +4471,9 @@ func (*UnimplementedDataCoordServer) Import(ctx context.Context, req *ImportTask
 func (*UnimplementedDataCoordServer) UpdateSegmentStatistics(ctx context.Context, req *UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSegmentStatistics not implemented")
 }
+func (*UnimplementedDataCoordServer) RefreshCollection(ctx context.Context, req *RefreshCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshCollection not implemented")
+}
 
 func RegisterDataCoordServer(s *grpc.Server, srv DataCoordServer) {
 	s.RegisterService(&_DataCoord_serviceDesc, srv)
@@ -4847,6 +4929,24 @@ func _DataCoord_UpdateSegmentStatistics_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_RefreshCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).RefreshCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/RefreshCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).RefreshCollection(ctx, req.(*RefreshCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataCoord",
 	HandlerType: (*DataCoordServer)(nil),
@@ -4951,6 +5051,10 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSegmentStatistics",
 			Handler:    _DataCoord_UpdateSegmentStatistics_Handler,
 		},
+		{
+			MethodName: "RefreshCollection",
+			Handler:    _DataCoord_RefreshCollection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -192,6 +192,7 @@ type CollectionInfo struct {
 	ShardsNum                  int32                      `protobuf:"varint,10,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
 	StartPositions             []*commonpb.KeyDataPair    `protobuf:"bytes,11,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
 	ConsistencyLevel           commonpb.ConsistencyLevel  `protobuf:"varint,12,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	DbName                     string                     `protobuf:"bytes,13,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}                   `json:"-"`
 	XXX_unrecognized           []byte                     `json:"-"`
 	XXX_sizecache              int32                      `json:"-"`
@@ -306,6 +307,13 @@ func (m *CollectionInfo) GetConsistencyLevel() commonpb.ConsistencyLevel {
 	return commonpb.ConsistencyLevel_Strong
 }
 
+func (m *CollectionInfo) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
 type SegmentIndexInfo struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -484,50 +492,51 @@ func init() {
 func init() { proto.RegisterFile("etcd_meta.proto", fileDescriptor_975d306d62b73e88) }
 
 var fileDescriptor_975d306d62b73e88 = []byte{
-	// 714 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6a, 0xdb, 0x4c,
-	0x14, 0x45, 0x91, 0x63, 0x47, 0xd7, 0x8a, 0x93, 0xcc, 0xf7, 0xb5, 0x0c, 0x21, 0x6d, 0x15, 0x41,
-	0x8a, 0xa0, 0xd4, 0xa6, 0x49, 0xe9, 0xae, 0xd0, 0xd6, 0x22, 0x60, 0xda, 0x06, 0x33, 0x09, 0x5d,
-	0x74, 0x23, 0xc6, 0xd2, 0xd8, 0x1e, 0xd0, 0x8f, 0xd1, 0x8c, 0x42, 0xbc, 0xeb, 0xba, 0x2f, 0xda,
-	0x45, 0xdf, 0xa1, 0x14, 0xcd, 0x48, 0xf2, 0x4f, 0x92, 0x65, 0x77, 0xbe, 0x67, 0xee, 0xb9, 0xba,
-	0x73, 0xe6, 0x1c, 0xc3, 0x01, 0x93, 0x61, 0x14, 0x24, 0x4c, 0xd2, 0xfe, 0x22, 0xcf, 0x64, 0x86,
-	0x8e, 0x12, 0x1e, 0xdf, 0x16, 0x42, 0x57, 0xfd, 0xf2, 0xf4, 0xd8, 0x0e, 0xb3, 0x24, 0xc9, 0x52,
-	0x0d, 0x1d, 0xdb, 0x22, 0x9c, 0xb3, 0xa4, 0x6a, 0x77, 0x7f, 0x18, 0x60, 0x8d, 0xf3, 0xec, 0x6e,
-	0xf9, 0x95, 0x49, 0x8a, 0x7a, 0xb0, 0x33, 0xf2, 0xb1, 0xe1, 0x18, 0x9e, 0x49, 0x76, 0x46, 0x3e,
-	0x7a, 0x07, 0x1d, 0x1a, 0x45, 0x39, 0x13, 0x02, 0xef, 0x38, 0x86, 0xd7, 0x3d, 0x3f, 0xe9, 0x6f,
-	0x8c, 0xaf, 0x06, 0x7f, 0xd4, 0x3d, 0xa4, 0x6e, 0x46, 0xaf, 0xe0, 0x28, 0x67, 0xa2, 0x88, 0x65,
-	0x10, 0xce, 0x69, 0x9a, 0xb2, 0x78, 0xe4, 0x0b, 0x6c, 0x3a, 0xa6, 0x67, 0x91, 0x43, 0x7d, 0x30,
-	0x6c, 0x70, 0xf7, 0xa7, 0x01, 0xd6, 0x28, 0x8d, 0xd8, 0xdd, 0x28, 0x9d, 0x66, 0xe8, 0x19, 0x00,
-	0x2f, 0x8b, 0x20, 0xa5, 0x09, 0x53, 0xab, 0x58, 0xc4, 0x52, 0xc8, 0x15, 0x4d, 0x18, 0xc2, 0xd0,
-	0x51, 0xc5, 0xc8, 0x57, 0x1b, 0x99, 0xa4, 0x2e, 0x91, 0x0f, 0xb6, 0x26, 0x2e, 0x68, 0x4e, 0x13,
-	0xfd, 0xb9, 0xee, 0xf9, 0xe9, 0x83, 0x0b, 0x7f, 0x66, 0xcb, 0x6f, 0x34, 0x2e, 0xd8, 0x98, 0xf2,
-	0x9c, 0x74, 0x15, 0x6d, 0xac, 0x58, 0xae, 0x0f, 0xbd, 0x4b, 0xce, 0xe2, 0x68, 0xb5, 0x10, 0x86,
-	0xce, 0x94, 0xc7, 0x2c, 0x6a, 0x84, 0xa9, 0xcb, 0xc7, 0x77, 0x71, 0xff, 0xb4, 0xa0, 0x37, 0xcc,
-	0xe2, 0x98, 0x85, 0x92, 0x67, 0xa9, 0x1a, 0xb3, 0x2d, 0xed, 0x7b, 0x68, 0xeb, 0x87, 0xa8, 0x94,
-	0x3d, 0xdb, 0x5c, 0xb4, 0x7a, 0xa4, 0xd5, 0x90, 0x6b, 0x05, 0x90, 0x8a, 0x84, 0x5e, 0x40, 0x37,
-	0xcc, 0x19, 0x95, 0x2c, 0x90, 0x3c, 0x61, 0xd8, 0x74, 0x0c, 0xaf, 0x45, 0x40, 0x43, 0x37, 0x3c,
-	0x61, 0xc8, 0x05, 0x7b, 0x41, 0x73, 0xc9, 0xd5, 0x02, 0xbe, 0xc0, 0x2d, 0xc7, 0xf4, 0x4c, 0xb2,
-	0x81, 0xa1, 0x97, 0xd0, 0x6b, 0xea, 0x52, 0x5d, 0x81, 0x77, 0xd5, 0x1b, 0x6d, 0xa1, 0xe8, 0x12,
-	0xf6, 0xa7, 0xa5, 0x28, 0x81, 0xba, 0x1f, 0x13, 0xb8, 0xfd, 0x90, 0xb6, 0xa5, 0xd7, 0xfa, 0x9b,
-	0xe2, 0x11, 0x7b, 0xda, 0xd4, 0x4c, 0xa0, 0x73, 0x78, 0x72, 0xcb, 0x73, 0x59, 0xd0, 0xb8, 0xf6,
-	0x85, 0x7a, 0x65, 0x81, 0x3b, 0xea, 0xb3, 0xff, 0x55, 0x87, 0x95, 0x37, 0xf4, 0xb7, 0xdf, 0xc2,
-	0xd3, 0xc5, 0x7c, 0x29, 0x78, 0x78, 0x8f, 0xb4, 0xa7, 0x48, 0xff, 0xd7, 0xa7, 0x1b, 0xac, 0x0f,
-	0x70, 0xd2, 0xdc, 0x21, 0xd0, 0xaa, 0x44, 0x4a, 0x29, 0x21, 0x69, 0xb2, 0x10, 0xd8, 0x72, 0x4c,
-	0xaf, 0x45, 0x8e, 0x9b, 0x9e, 0xa1, 0x6e, 0xb9, 0x69, 0x3a, 0x4a, 0x1f, 0x8a, 0x39, 0xcd, 0x23,
-	0x11, 0xa4, 0x45, 0x82, 0xc1, 0x31, 0xbc, 0x5d, 0x62, 0x69, 0xe4, 0xaa, 0x48, 0xd0, 0x08, 0x0e,
-	0x84, 0xa4, 0xb9, 0x0c, 0x16, 0x99, 0x50, 0x13, 0x04, 0xee, 0x2a, 0x51, 0x9c, 0xc7, 0x0c, 0xe7,
-	0x53, 0x49, 0x95, 0xdf, 0x7a, 0x8a, 0x38, 0xae, 0x79, 0x88, 0xc0, 0x51, 0x98, 0xa5, 0x82, 0x0b,
-	0xc9, 0xd2, 0x70, 0x19, 0xc4, 0xec, 0x96, 0xc5, 0xd8, 0x76, 0x0c, 0xaf, 0xb7, 0x6d, 0x8a, 0x6a,
-	0xd8, 0x70, 0xd5, 0xfd, 0xa5, 0x6c, 0x26, 0x87, 0xe1, 0x16, 0xe2, 0xfe, 0x32, 0xe0, 0xf0, 0x9a,
-	0xcd, 0x12, 0x96, 0xca, 0x95, 0x93, 0x5d, 0xb0, 0xc3, 0x95, 0x29, 0x6b, 0x33, 0x6e, 0x60, 0xc8,
-	0x81, 0xee, 0x9a, 0x45, 0x2a, 0x5f, 0xaf, 0x43, 0xe8, 0x04, 0x2c, 0x51, 0x4d, 0xf6, 0x95, 0xef,
-	0x4c, 0xb2, 0x02, 0x74, 0x5a, 0xca, 0x27, 0xf7, 0x71, 0xab, 0x4e, 0x8b, 0x2a, 0xd7, 0xd3, 0xb2,
-	0xbb, 0x99, 0x5c, 0x0c, 0x9d, 0x49, 0xc1, 0x15, 0xa7, 0xad, 0x4f, 0xaa, 0x12, 0x9d, 0x82, 0xcd,
-	0x52, 0x3a, 0x89, 0x99, 0x76, 0x1e, 0xee, 0x38, 0x86, 0xb7, 0x47, 0xba, 0x1a, 0x53, 0x17, 0x73,
-	0x7f, 0x1b, 0xeb, 0x51, 0x7b, 0xf0, 0x5f, 0xec, 0x5f, 0x47, 0xed, 0x39, 0x40, 0x23, 0x40, 0x1d,
-	0xb4, 0x35, 0x04, 0x9d, 0xad, 0xc5, 0x2c, 0x90, 0x74, 0x56, 0xc7, 0x6c, 0xbf, 0x41, 0x6f, 0xe8,
-	0x4c, 0xdc, 0x4b, 0x6c, 0xfb, 0x7e, 0x62, 0x3f, 0x5d, 0x7c, 0x7f, 0x33, 0xe3, 0x72, 0x5e, 0x4c,
-	0x4a, 0x2f, 0x0c, 0xf4, 0x35, 0x5e, 0xf3, 0xac, 0xfa, 0x35, 0xe0, 0xa9, 0x64, 0x79, 0x4a, 0xe3,
-	0x81, 0xba, 0xd9, 0xa0, 0x4c, 0xe4, 0x62, 0x32, 0x69, 0xab, 0xea, 0xe2, 0x6f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xf7, 0x5a, 0x33, 0x47, 0x2c, 0x06, 0x00, 0x00,
+	// 727 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcb, 0x6e, 0xdb, 0x38,
+	0x14, 0x85, 0x22, 0x3f, 0xa2, 0x6b, 0xc5, 0x49, 0x38, 0x2f, 0x22, 0xc8, 0xcc, 0x28, 0x02, 0x32,
+	0x10, 0x30, 0x18, 0x1b, 0xe3, 0x14, 0xdd, 0x15, 0x68, 0x6b, 0x21, 0x80, 0xd1, 0x36, 0x30, 0x98,
+	0xa0, 0x8b, 0x6e, 0x04, 0x5a, 0xa2, 0x6d, 0x02, 0x7a, 0x18, 0x22, 0x15, 0xc4, 0xbb, 0xae, 0xfb,
+	0x03, 0xfd, 0xc4, 0x2e, 0xfa, 0x13, 0x85, 0x48, 0x49, 0xb6, 0x13, 0x67, 0xd9, 0x9d, 0xef, 0xe1,
+	0x3d, 0x57, 0x97, 0x87, 0xe7, 0x18, 0x8e, 0x99, 0x0c, 0xa3, 0x20, 0x61, 0x92, 0x0e, 0x56, 0x79,
+	0x26, 0x33, 0x74, 0x9a, 0xf0, 0xf8, 0xbe, 0x10, 0xba, 0x1a, 0x94, 0xa7, 0x67, 0x76, 0x98, 0x25,
+	0x49, 0x96, 0x6a, 0xe8, 0xcc, 0x16, 0xe1, 0x92, 0x25, 0x55, 0xbb, 0xfb, 0xd9, 0x00, 0x6b, 0x9a,
+	0x67, 0x0f, 0xeb, 0x0f, 0x4c, 0x52, 0xd4, 0x87, 0x83, 0x89, 0x8f, 0x0d, 0xc7, 0xf0, 0x4c, 0x72,
+	0x30, 0xf1, 0xd1, 0x4b, 0xe8, 0xd2, 0x28, 0xca, 0x99, 0x10, 0xf8, 0xc0, 0x31, 0xbc, 0xde, 0xe8,
+	0x7c, 0xb0, 0x33, 0xbe, 0x1a, 0xfc, 0x46, 0xf7, 0x90, 0xba, 0x19, 0xfd, 0x0b, 0xa7, 0x39, 0x13,
+	0x45, 0x2c, 0x83, 0x70, 0x49, 0xd3, 0x94, 0xc5, 0x13, 0x5f, 0x60, 0xd3, 0x31, 0x3d, 0x8b, 0x9c,
+	0xe8, 0x83, 0x71, 0x83, 0xbb, 0x5f, 0x0c, 0xb0, 0x26, 0x69, 0xc4, 0x1e, 0x26, 0xe9, 0x3c, 0x43,
+	0x7f, 0x02, 0xf0, 0xb2, 0x08, 0x52, 0x9a, 0x30, 0xb5, 0x8a, 0x45, 0x2c, 0x85, 0xdc, 0xd0, 0x84,
+	0x21, 0x0c, 0x5d, 0x55, 0x4c, 0x7c, 0xb5, 0x91, 0x49, 0xea, 0x12, 0xf9, 0x60, 0x6b, 0xe2, 0x8a,
+	0xe6, 0x34, 0xd1, 0x9f, 0xeb, 0x8d, 0x2e, 0xf6, 0x2e, 0xfc, 0x8e, 0xad, 0x3f, 0xd2, 0xb8, 0x60,
+	0x53, 0xca, 0x73, 0xd2, 0x53, 0xb4, 0xa9, 0x62, 0xb9, 0x3e, 0xf4, 0xaf, 0x39, 0x8b, 0xa3, 0xcd,
+	0x42, 0x18, 0xba, 0x73, 0x1e, 0xb3, 0xa8, 0x11, 0xa6, 0x2e, 0x9f, 0xdf, 0xc5, 0xfd, 0xda, 0x86,
+	0xfe, 0x38, 0x8b, 0x63, 0x16, 0x4a, 0x9e, 0xa5, 0x6a, 0xcc, 0x63, 0x69, 0x5f, 0x41, 0x47, 0x3f,
+	0x44, 0xa5, 0xec, 0xe5, 0xee, 0xa2, 0xd5, 0x23, 0x6d, 0x86, 0xdc, 0x2a, 0x80, 0x54, 0x24, 0xf4,
+	0x37, 0xf4, 0xc2, 0x9c, 0x51, 0xc9, 0x02, 0xc9, 0x13, 0x86, 0x4d, 0xc7, 0xf0, 0x5a, 0x04, 0x34,
+	0x74, 0xc7, 0x13, 0x86, 0x5c, 0xb0, 0x57, 0x34, 0x97, 0x5c, 0x2d, 0xe0, 0x0b, 0xdc, 0x72, 0x4c,
+	0xcf, 0x24, 0x3b, 0x18, 0xfa, 0x07, 0xfa, 0x4d, 0x5d, 0xaa, 0x2b, 0x70, 0x5b, 0xbd, 0xd1, 0x23,
+	0x14, 0x5d, 0xc3, 0xd1, 0xbc, 0x14, 0x25, 0x50, 0xf7, 0x63, 0x02, 0x77, 0xf6, 0x69, 0x5b, 0x7a,
+	0x6d, 0xb0, 0x2b, 0x1e, 0xb1, 0xe7, 0x4d, 0xcd, 0x04, 0x1a, 0xc1, 0x6f, 0xf7, 0x3c, 0x97, 0x05,
+	0x8d, 0x6b, 0x5f, 0xa8, 0x57, 0x16, 0xb8, 0xab, 0x3e, 0xfb, 0x4b, 0x75, 0x58, 0x79, 0x43, 0x7f,
+	0xfb, 0x05, 0xfc, 0xbe, 0x5a, 0xae, 0x05, 0x0f, 0x9f, 0x90, 0x0e, 0x15, 0xe9, 0xd7, 0xfa, 0x74,
+	0x87, 0xf5, 0x1a, 0xce, 0x9b, 0x3b, 0x04, 0x5a, 0x95, 0x48, 0x29, 0x25, 0x24, 0x4d, 0x56, 0x02,
+	0x5b, 0x8e, 0xe9, 0xb5, 0xc8, 0x59, 0xd3, 0x33, 0xd6, 0x2d, 0x77, 0x4d, 0x47, 0xe9, 0x43, 0xb1,
+	0xa4, 0x79, 0x24, 0x82, 0xb4, 0x48, 0x30, 0x38, 0x86, 0xd7, 0x26, 0x96, 0x46, 0x6e, 0x8a, 0x04,
+	0x4d, 0xe0, 0x58, 0x48, 0x9a, 0xcb, 0x60, 0x95, 0x09, 0x35, 0x41, 0xe0, 0x9e, 0x12, 0xc5, 0x79,
+	0xce, 0x70, 0x3e, 0x95, 0x54, 0xf9, 0xad, 0xaf, 0x88, 0xd3, 0x9a, 0x87, 0x08, 0x9c, 0x86, 0x59,
+	0x2a, 0xb8, 0x90, 0x2c, 0x0d, 0xd7, 0x41, 0xcc, 0xee, 0x59, 0x8c, 0x6d, 0xc7, 0xf0, 0xfa, 0xa3,
+	0xcb, 0xbd, 0xc3, 0xc6, 0x9b, 0xee, 0xf7, 0x65, 0x33, 0x39, 0x09, 0x1f, 0x21, 0xe8, 0x0f, 0xe8,
+	0x46, 0x33, 0x1d, 0xa1, 0x23, 0x15, 0xa1, 0x4e, 0x34, 0x2b, 0x95, 0x71, 0xbf, 0x19, 0x70, 0x72,
+	0xcb, 0x16, 0x09, 0x4b, 0xe5, 0xc6, 0xe2, 0x2e, 0xd8, 0xe1, 0xc6, 0xad, 0xb5, 0x4b, 0x77, 0x30,
+	0xe4, 0x40, 0x6f, 0xcb, 0x3b, 0x95, 0xe1, 0xb7, 0x21, 0x74, 0x0e, 0x96, 0xa8, 0x26, 0xfb, 0xca,
+	0x90, 0x26, 0xd9, 0x00, 0x3a, 0x46, 0xa5, 0x17, 0x7c, 0xdc, 0xaa, 0x63, 0xa4, 0xca, 0xed, 0x18,
+	0xb5, 0x77, 0x23, 0x8d, 0xa1, 0x3b, 0x2b, 0xb8, 0xe2, 0x74, 0xf4, 0x49, 0x55, 0xa2, 0x0b, 0xb0,
+	0x59, 0x4a, 0x67, 0x31, 0xd3, 0x96, 0xc4, 0x5d, 0xc7, 0xf0, 0x0e, 0x49, 0x4f, 0x63, 0xea, 0x62,
+	0xee, 0x77, 0x63, 0x3b, 0x83, 0x7b, 0xff, 0xde, 0x7e, 0x76, 0x06, 0xff, 0x02, 0x68, 0x04, 0xa8,
+	0x13, 0xb8, 0x85, 0xa0, 0xcb, 0xad, 0xfc, 0x05, 0x92, 0x2e, 0xea, 0xfc, 0x1d, 0x35, 0xe8, 0x1d,
+	0x5d, 0x88, 0x27, 0x51, 0xee, 0x3c, 0x8d, 0xf2, 0xdb, 0xab, 0x4f, 0xff, 0x2f, 0xb8, 0x5c, 0x16,
+	0xb3, 0xd2, 0x24, 0x43, 0x7d, 0x8d, 0xff, 0x78, 0x56, 0xfd, 0x1a, 0xf2, 0x54, 0xb2, 0x3c, 0xa5,
+	0xf1, 0x50, 0xdd, 0x6c, 0x58, 0x46, 0x75, 0x35, 0x9b, 0x75, 0x54, 0x75, 0xf5, 0x63, 0x00, 0x4f,
+	0xef, 0xb2, 0xcf, 0x45, 0x06, 0x00, 0x00,
 }
@@ -823,6 +823,116 @@ func (m *DropIndexRequest) GetIndexID() int64 {
 	return 0
 }
 
+type CancelIndexBuildRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	IndexName            string            `protobuf:"bytes,3,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexBuildID         int64             `protobuf:"varint,4,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CancelIndexBuildRequest) Reset()         { *m = CancelIndexBuildRequest{} }
+func (m *CancelIndexBuildRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelIndexBuildRequest) ProtoMessage()    {}
+func (*CancelIndexBuildRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{13}
+}
+
+func (m *CancelIndexBuildRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelIndexBuildRequest.Unmarshal(m, b)
+}
+func (m *CancelIndexBuildRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelIndexBuildRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelIndexBuildRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelIndexBuildRequest.Merge(m, src)
+}
+func (m *CancelIndexBuildRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelIndexBuildRequest.Size(m)
+}
+func (m *CancelIndexBuildRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelIndexBuildRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelIndexBuildRequest proto.InternalMessageInfo
+
+func (m *CancelIndexBuildRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CancelIndexBuildRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CancelIndexBuildRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *CancelIndexBuildRequest) GetIndexBuildID() int64 {
+	if m != nil {
+		return m.IndexBuildID
+	}
+	return 0
+}
+
+type PrioritizeIndexBuildRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PrioritizeIndexBuildRequest) Reset()         { *m = PrioritizeIndexBuildRequest{} }
+func (m *PrioritizeIndexBuildRequest) String() string { return proto.CompactTextString(m) }
+func (*PrioritizeIndexBuildRequest) ProtoMessage()    {}
+func (*PrioritizeIndexBuildRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{14}
+}
+
+func (m *PrioritizeIndexBuildRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrioritizeIndexBuildRequest.Unmarshal(m, b)
+}
+func (m *PrioritizeIndexBuildRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrioritizeIndexBuildRequest.Marshal(b, m, deterministic)
+}
+func (m *PrioritizeIndexBuildRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrioritizeIndexBuildRequest.Merge(m, src)
+}
+func (m *PrioritizeIndexBuildRequest) XXX_Size() int {
+	return xxx_messageInfo_PrioritizeIndexBuildRequest.Size(m)
+}
+func (m *PrioritizeIndexBuildRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrioritizeIndexBuildRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrioritizeIndexBuildRequest proto.InternalMessageInfo
+
+func (m *PrioritizeIndexBuildRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *PrioritizeIndexBuildRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*RegisterNodeRequest)(nil), "milvus.proto.index.RegisterNodeRequest")
 	proto.RegisterType((*RegisterNodeResponse)(nil), "milvus.proto.index.RegisterNodeResponse")
@@ -837,80 +947,87 @@ func init() {
 	proto.RegisterType((*GetIndexFilePathsResponse)(nil), "milvus.proto.index.GetIndexFilePathsResponse")
 	proto.RegisterType((*IndexMeta)(nil), "milvus.proto.index.IndexMeta")
 	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.index.DropIndexRequest")
+	proto.RegisterType((*CancelIndexBuildRequest)(nil), "milvus.proto.index.CancelIndexBuildRequest")
+	proto.RegisterType((*PrioritizeIndexBuildRequest)(nil), "milvus.proto.index.PrioritizeIndexBuildRequest")
 }
 
 func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
 
 var fileDescriptor_f9e019eb3fda53c2 = []byte{
-	// 1073 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x36, 0x4d, 0x5b, 0x3f, 0x23, 0xc5, 0x8d, 0xb7, 0x69, 0xc0, 0x28, 0x0d, 0x22, 0xb3, 0xf9,
-	0x51, 0x8b, 0x44, 0x0e, 0x94, 0xa6, 0x3d, 0x15, 0x68, 0x6d, 0x21, 0x86, 0x51, 0x38, 0x30, 0xd6,
-	0x46, 0x0f, 0x05, 0x0a, 0x61, 0x2d, 0x8e, 0xec, 0x45, 0xf8, 0x23, 0x73, 0x57, 0x49, 0xed, 0x63,
-	0xd1, 0x7b, 0x6f, 0xe9, 0xa3, 0xf4, 0xd8, 0x67, 0xc8, 0xe3, 0xf4, 0x56, 0xec, 0x72, 0x29, 0x91,
-	0x12, 0xe5, 0xc8, 0x75, 0xd3, 0x5e, 0x72, 0xe3, 0xcc, 0x7e, 0xb3, 0xb3, 0xf3, 0xed, 0xec, 0xc7,
-	0x81, 0x75, 0x1e, 0x7a, 0xf8, 0x73, 0xaf, 0x1f, 0x45, 0xb1, 0xd7, 0x1e, 0xc6, 0x91, 0x8c, 0x08,
-	0x09, 0xb8, 0xff, 0x6a, 0x24, 0x12, 0xab, 0xad, 0xd7, 0x1b, 0xf5, 0x7e, 0x14, 0x04, 0x51, 0x98,
-	0xf8, 0x1a, 0x6b, 0x3c, 0x94, 0x18, 0x87, 0xcc, 0x37, 0x76, 0x3d, 0x1b, 0xd1, 0xa8, 0x8b, 0xfe,
-	0x09, 0x06, 0x2c, 0xb1, 0xdc, 0xdf, 0x2d, 0xf8, 0x98, 0xe2, 0x31, 0x17, 0x12, 0xe3, 0x17, 0x91,
-	0x87, 0x14, 0x4f, 0x47, 0x28, 0x24, 0x79, 0x02, 0x2b, 0x47, 0x4c, 0xa0, 0x63, 0x35, 0xad, 0x56,
-	0xad, 0xf3, 0x69, 0x3b, 0x97, 0xd4, 0x64, 0xdb, 0x13, 0xc7, 0x5b, 0x4c, 0x20, 0xd5, 0x48, 0xf2,
-	0x15, 0x94, 0x99, 0xe7, 0xc5, 0x28, 0x84, 0xb3, 0x7c, 0x41, 0xd0, 0x77, 0x09, 0x86, 0xa6, 0x60,
-	0x72, 0x13, 0x4a, 0x61, 0xe4, 0xe1, 0x6e, 0xd7, 0xb1, 0x9b, 0x56, 0xcb, 0xa6, 0xc6, 0x72, 0x7f,
-	0xb3, 0xe0, 0x46, 0xfe, 0x64, 0x62, 0x18, 0x85, 0x02, 0xc9, 0x53, 0x28, 0x09, 0xc9, 0xe4, 0x48,
-	0x98, 0xc3, 0xdd, 0x2e, 0xcc, 0x73, 0xa0, 0x21, 0xd4, 0x40, 0xc9, 0x16, 0xd4, 0x78, 0xc8, 0x65,
-	0x6f, 0xc8, 0x62, 0x16, 0xa4, 0x27, 0xdc, 0x68, 0x4f, 0x71, 0x69, 0x68, 0xdb, 0x0d, 0xb9, 0xdc,
-	0xd7, 0x40, 0x0a, 0x7c, 0xfc, 0xed, 0x7e, 0x03, 0x9f, 0xec, 0xa0, 0xdc, 0x55, 0x8c, 0xab, 0xdd,
-	0x51, 0xa4, 0x64, 0xdd, 0x83, 0x6b, 0xfa, 0x1e, 0xb6, 0x46, 0xdc, 0xf7, 0x76, 0xbb, 0xea, 0x60,
-	0x76, 0xcb, 0xa6, 0x79, 0xa7, 0xfb, 0x87, 0x05, 0x55, 0x1d, 0xbc, 0x1b, 0x0e, 0x22, 0xf2, 0x0c,
-	0x56, 0xd5, 0xd1, 0x12, 0x86, 0xd7, 0x3a, 0x77, 0x0b, 0x8b, 0x98, 0xe4, 0xa2, 0x09, 0x9a, 0xb8,
-	0x50, 0xcf, 0xee, 0xaa, 0x0b, 0xb1, 0x69, 0xce, 0x47, 0x1c, 0x28, 0x6b, 0x7b, 0x4c, 0x69, 0x6a,
-	0x92, 0x3b, 0x00, 0x49, 0x43, 0x85, 0x2c, 0x40, 0x67, 0xa5, 0x69, 0xb5, 0xaa, 0xb4, 0xaa, 0x3d,
-	0x2f, 0x58, 0x80, 0xea, 0x2a, 0x62, 0x64, 0x22, 0x0a, 0x9d, 0x55, 0xbd, 0x64, 0x2c, 0xf7, 0x57,
-	0x0b, 0x6e, 0x4e, 0x57, 0x7e, 0x95, 0xcb, 0x78, 0x96, 0x04, 0xa1, 0xba, 0x07, 0xbb, 0x55, 0xeb,
-	0xdc, 0x69, 0xcf, 0xf6, 0x74, 0x7b, 0x4c, 0x15, 0x35, 0x60, 0xf7, 0xed, 0x32, 0x90, 0xed, 0x18,
-	0x99, 0x44, 0xbd, 0x96, 0xb2, 0x3f, 0x4d, 0x89, 0x55, 0x40, 0x49, 0xbe, 0xf0, 0xe5, 0xe9, 0xc2,
-	0xe7, 0x33, 0xe6, 0x40, 0xf9, 0x15, 0xc6, 0x82, 0x47, 0xa1, 0xa6, 0xcb, 0xa6, 0xa9, 0x49, 0x6e,
-	0x43, 0x35, 0x40, 0xc9, 0x7a, 0x43, 0x26, 0x4f, 0x0c, 0x5f, 0x15, 0xe5, 0xd8, 0x67, 0xf2, 0x44,
-	0xe5, 0xf3, 0x98, 0x59, 0x14, 0x4e, 0xa9, 0x69, 0xab, 0x7c, 0xca, 0xa3, 0x56, 0x75, 0x37, 0xca,
-	0xb3, 0x21, 0xa6, 0xdd, 0x58, 0xd6, 0x2c, 0x6c, 0x14, 0x52, 0xf7, 0x3d, 0x9e, 0xfd, 0xc0, 0xfc,
-	0x11, 0xee, 0x33, 0x1e, 0x53, 0x50, 0x51, 0x49, 0x37, 0x92, 0xae, 0x29, 0x3b, 0xdd, 0xa4, 0xb2,
-	0xe8, 0x26, 0x35, 0x1d, 0x66, 0x7a, 0xfa, 0xaf, 0x65, 0x58, 0x4f, 0x48, 0xfa, 0xcf, 0x28, 0xcd,
-	0x73, 0xb3, 0xfa, 0x0e, 0x6e, 0x4a, 0xff, 0x06, 0x37, 0xe5, 0x7f, 0xc2, 0x0d, 0xb9, 0x05, 0x95,
-	0x70, 0x14, 0xf4, 0xe2, 0xe8, 0xb5, 0x62, 0x57, 0xd7, 0x10, 0x8e, 0x02, 0x1a, 0xbd, 0x16, 0x64,
-	0x1b, 0xea, 0x03, 0x8e, 0xbe, 0xd7, 0x4b, 0xc4, 0xd4, 0xa9, 0xea, 0xe6, 0x6f, 0xe6, 0x13, 0x18,
-	0xa1, 0x7d, 0xae, 0x80, 0x07, 0xfa, 0x9b, 0xd6, 0x06, 0x13, 0xc3, 0x0d, 0x80, 0x64, 0xa9, 0xbf,
-	0xca, 0x8b, 0x5a, 0x40, 0x16, 0xdc, 0x6f, 0xc1, 0x49, 0x1f, 0xf1, 0x73, 0xee, 0xa3, 0x66, 0xfb,
-	0x72, 0x0a, 0xf6, 0xa7, 0x05, 0xeb, 0xb9, 0x78, 0xad, 0x64, 0xef, 0xeb, 0xc0, 0xa4, 0x05, 0xd7,
-	0x93, 0x5b, 0x1c, 0x70, 0x1f, 0x4d, 0xbb, 0xd8, 0xba, 0x5d, 0xd6, 0x78, 0xae, 0x0a, 0xf2, 0x10,
-	0x3e, 0x12, 0x18, 0x73, 0xe6, 0xf3, 0x73, 0xf4, 0x7a, 0x82, 0x9f, 0x27, 0xe2, 0xb6, 0x42, 0xd7,
-	0x26, 0xee, 0x03, 0x7e, 0x8e, 0xee, 0x1b, 0x0b, 0x6e, 0x15, 0x90, 0x70, 0x15, 0xea, 0xbb, 0x00,
-	0x99, 0xf3, 0x25, 0x82, 0x76, 0x7f, 0xae, 0xa0, 0x65, 0x99, 0xa3, 0xd5, 0x41, 0x7a, 0x04, 0xf7,
-	0x17, 0xdb, 0xfc, 0x1c, 0xf6, 0x50, 0xb2, 0x85, 0xde, 0xdf, 0xf8, 0x07, 0xb2, 0x7c, 0xa9, 0x1f,
-	0xc8, 0x5d, 0xa8, 0x0d, 0x18, 0xf7, 0x7b, 0x46, 0xe8, 0x6d, 0xfd, 0x6e, 0x41, 0xb9, 0xa8, 0xf6,
-	0x90, 0xaf, 0xc1, 0x8e, 0xf1, 0x54, 0xf3, 0x37, 0xa7, 0x90, 0x19, 0xbd, 0xa0, 0x2a, 0xa2, 0xf0,
-	0xba, 0x56, 0x0b, 0xaf, 0x6b, 0x03, 0xea, 0x01, 0x8b, 0x5f, 0xf6, 0x3c, 0xf4, 0x51, 0xa2, 0xe7,
-	0x94, 0x9a, 0x56, 0xab, 0x42, 0x6b, 0xca, 0xd7, 0x4d, 0x5c, 0x99, 0xa9, 0xa0, 0x9c, 0x9d, 0x0a,
-	0xb2, 0x7a, 0x5c, 0xc9, 0xeb, 0x71, 0x03, 0x2a, 0x31, 0xf6, 0xcf, 0xfa, 0x3e, 0x7a, 0xfa, 0x39,
-	0x56, 0xe8, 0xd8, 0x26, 0xf7, 0x61, 0xd2, 0x08, 0x49, 0x7b, 0x80, 0x6e, 0x8f, 0x6b, 0x63, 0xaf,
-	0xee, 0x8e, 0x47, 0x70, 0xbd, 0x1b, 0x47, 0xc3, 0x9c, 0x14, 0x66, 0x74, 0xcc, 0xca, 0xe9, 0x58,
-	0xe7, 0x6d, 0x09, 0x40, 0x43, 0xb7, 0xd5, 0x74, 0x46, 0x86, 0x40, 0x76, 0x50, 0x6e, 0x47, 0xc1,
-	0x30, 0x0a, 0x31, 0x94, 0xc9, 0x7f, 0x92, 0x3c, 0x99, 0x33, 0x62, 0xcc, 0x42, 0x4d, 0xc2, 0xc6,
-	0x83, 0x39, 0x11, 0x53, 0x70, 0x77, 0x89, 0x04, 0x3a, 0xe3, 0x21, 0x0f, 0xf0, 0x90, 0xf7, 0x5f,
-	0x6e, 0x9f, 0xb0, 0x30, 0x44, 0xff, 0xa2, 0x8c, 0x53, 0xd0, 0x34, 0xe3, 0x67, 0xf9, 0x08, 0x63,
-	0x1c, 0xc8, 0x98, 0x87, 0xc7, 0xe9, 0xdb, 0x70, 0x97, 0xc8, 0x29, 0xdc, 0xd8, 0x41, 0x9d, 0x9d,
-	0x0b, 0xc9, 0xfb, 0x22, 0x4d, 0xd8, 0x99, 0x9f, 0x70, 0x06, 0x7c, 0xc9, 0x94, 0x3f, 0x01, 0x4c,
-	0x9a, 0x8d, 0x2c, 0xd6, 0x8c, 0xb3, 0x04, 0x4e, 0xc3, 0xc6, 0xdb, 0x73, 0x58, 0xcb, 0x8f, 0x35,
-	0xe4, 0xf3, 0xa2, 0xd8, 0xc2, 0xa1, 0xaf, 0xf1, 0xc5, 0x22, 0xd0, 0x71, 0xaa, 0x18, 0xd6, 0x67,
-	0x74, 0x87, 0x3c, 0xba, 0x68, 0x8b, 0x69, 0x8d, 0x6e, 0x3c, 0x5e, 0x10, 0x3d, 0xce, 0xb9, 0x0f,
-	0xd5, 0x71, 0x3b, 0x93, 0x7b, 0x45, 0xd1, 0xd3, 0xdd, 0xde, 0xb8, 0x48, 0xf1, 0xdc, 0x25, 0xd2,
-	0x03, 0xd8, 0x41, 0xb9, 0x87, 0x32, 0xe6, 0x7d, 0x41, 0x1e, 0x14, 0x5e, 0xe2, 0x04, 0x90, 0x6e,
-	0xfa, 0xf0, 0x9d, 0xb8, 0xf4, 0xc8, 0x9d, 0x37, 0x2b, 0x46, 0x06, 0xd5, 0xc4, 0xff, 0xe1, 0x49,
-	0xbd, 0x87, 0x27, 0x75, 0x08, 0xb5, 0xcc, 0x0c, 0x4d, 0x0a, 0x1f, 0xcb, 0xec, 0x90, 0xfd, 0x7f,
-	0x37, 0xc6, 0xd6, 0x97, 0x3f, 0x76, 0x8e, 0xb9, 0x3c, 0x19, 0x1d, 0xa9, 0xd4, 0x9b, 0x09, 0xf2,
-	0x31, 0x8f, 0xcc, 0xd7, 0x66, 0xca, 0xd0, 0xa6, 0xde, 0x69, 0x53, 0x97, 0x31, 0x3c, 0x3a, 0x2a,
-	0x69, 0xf3, 0xe9, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x29, 0x0d, 0x3e, 0x47, 0x0f, 0x00,
-	0x00,
+	// 1162 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xef, 0x66, 0x13, 0xff, 0x79, 0x76, 0x43, 0x33, 0x84, 0xe2, 0x3a, 0x54, 0x75, 0x97, 0x36,
+	0x35, 0xd0, 0x3a, 0x95, 0x4b, 0xe1, 0x84, 0x04, 0xb1, 0xd5, 0xc8, 0x42, 0xa9, 0xa2, 0x49, 0xc4,
+	0x01, 0x09, 0x59, 0x13, 0xef, 0x73, 0x32, 0xea, 0xfe, 0x71, 0x76, 0xc6, 0x2d, 0xc9, 0x11, 0x71,
+	0xe7, 0x56, 0x3e, 0x04, 0x37, 0x2e, 0x1c, 0xf9, 0x0c, 0x7c, 0x1c, 0x6e, 0x68, 0x67, 0x67, 0xd7,
+	0xde, 0xf5, 0x3a, 0x71, 0x1a, 0x5a, 0x2e, 0xdc, 0xf6, 0xbd, 0xf9, 0xbd, 0x79, 0xf3, 0x7e, 0xf3,
+	0xe6, 0x37, 0xb3, 0xb0, 0xc6, 0x3d, 0x1b, 0x7f, 0xec, 0x0f, 0x7c, 0x3f, 0xb0, 0x5b, 0xa3, 0xc0,
+	0x97, 0x3e, 0x21, 0x2e, 0x77, 0x5e, 0x8e, 0x45, 0x64, 0xb5, 0xd4, 0x78, 0xbd, 0x3a, 0xf0, 0x5d,
+	0xd7, 0xf7, 0x22, 0x5f, 0x7d, 0x95, 0x7b, 0x12, 0x03, 0x8f, 0x39, 0xda, 0xae, 0x4e, 0x47, 0xd4,
+	0xab, 0x62, 0x70, 0x8c, 0x2e, 0x8b, 0x2c, 0xeb, 0x57, 0x03, 0xde, 0xa7, 0x78, 0xc4, 0x85, 0xc4,
+	0xe0, 0xb9, 0x6f, 0x23, 0xc5, 0x93, 0x31, 0x0a, 0x49, 0x1e, 0xc3, 0xf2, 0x21, 0x13, 0x58, 0x33,
+	0x1a, 0x46, 0xb3, 0xd2, 0xfe, 0xa8, 0x95, 0x4a, 0xaa, 0xb3, 0xed, 0x8a, 0xa3, 0x6d, 0x26, 0x90,
+	0x2a, 0x24, 0xf9, 0x02, 0x8a, 0xcc, 0xb6, 0x03, 0x14, 0xa2, 0xb6, 0x74, 0x4e, 0xd0, 0x37, 0x11,
+	0x86, 0xc6, 0x60, 0x72, 0x13, 0x0a, 0x9e, 0x6f, 0x63, 0xaf, 0x5b, 0x33, 0x1b, 0x46, 0xd3, 0xa4,
+	0xda, 0xb2, 0x7e, 0x31, 0x60, 0x3d, 0xbd, 0x32, 0x31, 0xf2, 0x3d, 0x81, 0xe4, 0x09, 0x14, 0x84,
+	0x64, 0x72, 0x2c, 0xf4, 0xe2, 0x36, 0x72, 0xf3, 0xec, 0x2b, 0x08, 0xd5, 0x50, 0xb2, 0x0d, 0x15,
+	0xee, 0x71, 0xd9, 0x1f, 0xb1, 0x80, 0xb9, 0xf1, 0x0a, 0xef, 0xb6, 0x32, 0x5c, 0x6a, 0xda, 0x7a,
+	0x1e, 0x97, 0x7b, 0x0a, 0x48, 0x81, 0x27, 0xdf, 0xd6, 0x57, 0xf0, 0xc1, 0x0e, 0xca, 0x5e, 0xc8,
+	0x78, 0x38, 0x3b, 0x8a, 0x98, 0xac, 0x7b, 0x70, 0x5d, 0xed, 0xc3, 0xf6, 0x98, 0x3b, 0x76, 0xaf,
+	0x1b, 0x2e, 0xcc, 0x6c, 0x9a, 0x34, 0xed, 0xb4, 0xfe, 0x30, 0xa0, 0xac, 0x82, 0x7b, 0xde, 0xd0,
+	0x27, 0x4f, 0x61, 0x25, 0x5c, 0x5a, 0xc4, 0xf0, 0x6a, 0xfb, 0x4e, 0x6e, 0x11, 0x93, 0x5c, 0x34,
+	0x42, 0x13, 0x0b, 0xaa, 0xd3, 0xb3, 0xaa, 0x42, 0x4c, 0x9a, 0xf2, 0x91, 0x1a, 0x14, 0x95, 0x9d,
+	0x50, 0x1a, 0x9b, 0xe4, 0x36, 0x40, 0xd4, 0x50, 0x1e, 0x73, 0xb1, 0xb6, 0xdc, 0x30, 0x9a, 0x65,
+	0x5a, 0x56, 0x9e, 0xe7, 0xcc, 0xc5, 0x70, 0x2b, 0x02, 0x64, 0xc2, 0xf7, 0x6a, 0x2b, 0x6a, 0x48,
+	0x5b, 0xd6, 0xcf, 0x06, 0xdc, 0xcc, 0x56, 0x7e, 0x95, 0xcd, 0x78, 0x1a, 0x05, 0x61, 0xb8, 0x0f,
+	0x66, 0xb3, 0xd2, 0xbe, 0xdd, 0x9a, 0xed, 0xe9, 0x56, 0x42, 0x15, 0xd5, 0x60, 0xeb, 0xaf, 0x25,
+	0x20, 0x9d, 0x00, 0x99, 0x44, 0x35, 0x16, 0xb3, 0x9f, 0xa5, 0xc4, 0xc8, 0xa1, 0x24, 0x5d, 0xf8,
+	0x52, 0xb6, 0xf0, 0xf9, 0x8c, 0xd5, 0xa0, 0xf8, 0x12, 0x03, 0xc1, 0x7d, 0x4f, 0xd1, 0x65, 0xd2,
+	0xd8, 0x24, 0x1b, 0x50, 0x76, 0x51, 0xb2, 0xfe, 0x88, 0xc9, 0x63, 0xcd, 0x57, 0x29, 0x74, 0xec,
+	0x31, 0x79, 0x1c, 0xe6, 0xb3, 0x99, 0x1e, 0x14, 0xb5, 0x42, 0xc3, 0x0c, 0xf3, 0xd9, 0x2c, 0x1a,
+	0x55, 0xdd, 0x28, 0x4f, 0x47, 0x18, 0x77, 0x63, 0xb1, 0x61, 0xce, 0x76, 0xa3, 0xa6, 0xee, 0x5b,
+	0x3c, 0xfd, 0x8e, 0x39, 0x63, 0xdc, 0x63, 0x3c, 0xa0, 0x10, 0x46, 0x45, 0xdd, 0x48, 0xba, 0xba,
+	0xec, 0x78, 0x92, 0xd2, 0xa2, 0x93, 0x54, 0x54, 0x98, 0xee, 0xe9, 0xbf, 0x97, 0x60, 0x2d, 0x22,
+	0xe9, 0x9d, 0x51, 0x9a, 0xe6, 0x66, 0xe5, 0x02, 0x6e, 0x0a, 0xff, 0x06, 0x37, 0xc5, 0x37, 0xe1,
+	0x86, 0xdc, 0x82, 0x92, 0x37, 0x76, 0xfb, 0x81, 0xff, 0x2a, 0x64, 0x57, 0xd5, 0xe0, 0x8d, 0x5d,
+	0xea, 0xbf, 0x12, 0xa4, 0x03, 0xd5, 0x21, 0x47, 0xc7, 0xee, 0x47, 0x62, 0x5a, 0x2b, 0xab, 0xe6,
+	0x6f, 0xa4, 0x13, 0x44, 0x63, 0xad, 0x67, 0x21, 0x70, 0x5f, 0x7d, 0xd3, 0xca, 0x70, 0x62, 0x58,
+	0x2e, 0x90, 0x69, 0xea, 0xaf, 0x72, 0xa2, 0x16, 0x90, 0x05, 0xeb, 0x6b, 0xa8, 0xc5, 0x87, 0xf8,
+	0x19, 0x77, 0x50, 0xb1, 0x7d, 0x39, 0x05, 0xfb, 0xd3, 0x80, 0xb5, 0x54, 0xbc, 0x52, 0xb2, 0xb7,
+	0xb5, 0x60, 0xd2, 0x84, 0x1b, 0xd1, 0x2e, 0x0e, 0xb9, 0x83, 0xba, 0x5d, 0x4c, 0xd5, 0x2e, 0xab,
+	0x3c, 0x55, 0x05, 0x79, 0x00, 0xef, 0x09, 0x0c, 0x38, 0x73, 0xf8, 0x19, 0xda, 0x7d, 0xc1, 0xcf,
+	0x22, 0x71, 0x5b, 0xa6, 0xab, 0x13, 0xf7, 0x3e, 0x3f, 0x43, 0xeb, 0xb5, 0x01, 0xb7, 0x72, 0x48,
+	0xb8, 0x0a, 0xf5, 0x5d, 0x80, 0xa9, 0xf5, 0x45, 0x82, 0x76, 0x7f, 0xae, 0xa0, 0x4d, 0x33, 0x47,
+	0xcb, 0x43, 0x6d, 0x09, 0xeb, 0x27, 0x53, 0x5f, 0x0e, 0xbb, 0x28, 0xd9, 0x42, 0xe7, 0x2f, 0xb9,
+	0x40, 0x96, 0x2e, 0x75, 0x81, 0xdc, 0x81, 0xca, 0x90, 0x71, 0xa7, 0xaf, 0x85, 0xde, 0x54, 0xe7,
+	0x16, 0x42, 0x17, 0x55, 0x1e, 0xf2, 0x25, 0x98, 0x01, 0x9e, 0x28, 0xfe, 0xe6, 0x14, 0x32, 0xa3,
+	0x17, 0x34, 0x8c, 0xc8, 0xdd, 0xae, 0x95, 0xdc, 0xed, 0xba, 0x0b, 0x55, 0x97, 0x05, 0x2f, 0xfa,
+	0x36, 0x3a, 0x28, 0xd1, 0xae, 0x15, 0x1a, 0x46, 0xb3, 0x44, 0x2b, 0xa1, 0xaf, 0x1b, 0xb9, 0xa6,
+	0x5e, 0x05, 0xc5, 0xe9, 0x57, 0xc1, 0xb4, 0x1e, 0x97, 0xd2, 0x7a, 0x5c, 0x87, 0x52, 0x80, 0x83,
+	0xd3, 0x81, 0x83, 0xb6, 0x3a, 0x8e, 0x25, 0x9a, 0xd8, 0xe4, 0x3e, 0x4c, 0x1a, 0x21, 0x6a, 0x0f,
+	0x50, 0xed, 0x71, 0x3d, 0xf1, 0xaa, 0xee, 0x78, 0x08, 0x37, 0xba, 0x81, 0x3f, 0x4a, 0x49, 0xe1,
+	0x94, 0x8e, 0x19, 0x29, 0x1d, 0xb3, 0x7e, 0x37, 0xe0, 0xc3, 0x0e, 0xf3, 0x06, 0xe8, 0xf4, 0x92,
+	0x7d, 0x79, 0xf3, 0xe7, 0x93, 0x05, 0xd5, 0x81, 0xef, 0x38, 0x38, 0x90, 0xdc, 0xf7, 0x26, 0x07,
+	0x62, 0xda, 0x97, 0x91, 0x5c, 0x33, 0x2b, 0xb9, 0xd9, 0xae, 0x59, 0xce, 0x11, 0x01, 0x01, 0x1b,
+	0x7b, 0x01, 0xf7, 0x03, 0x2e, 0xf9, 0x19, 0xbe, 0xa3, 0x75, 0xb7, 0x7f, 0x2b, 0x01, 0xa8, 0x5c,
+	0x9d, 0xf0, 0x1d, 0x4b, 0x46, 0x40, 0x76, 0x50, 0x76, 0x7c, 0x77, 0xe4, 0x7b, 0xe8, 0xc9, 0xe8,
+	0x45, 0x41, 0x1e, 0xcf, 0x79, 0x8c, 0xcd, 0x42, 0xf5, 0x62, 0xeb, 0x9b, 0x73, 0x22, 0x32, 0x70,
+	0xeb, 0x1a, 0x71, 0x55, 0xc6, 0x03, 0xee, 0xe2, 0x01, 0x1f, 0xbc, 0xe8, 0x1c, 0x33, 0xcf, 0x43,
+	0xe7, 0xbc, 0x8c, 0x19, 0x68, 0x9c, 0xf1, 0xe3, 0x74, 0x84, 0x36, 0xf6, 0x65, 0xc0, 0xbd, 0xa3,
+	0x58, 0x45, 0xac, 0x6b, 0xe4, 0x04, 0xd6, 0x77, 0x50, 0x65, 0xe7, 0x42, 0xf2, 0x81, 0x88, 0x13,
+	0xb6, 0xe7, 0x27, 0x9c, 0x01, 0x5f, 0x32, 0xe5, 0x0f, 0x00, 0x93, 0x63, 0x49, 0x16, 0x3b, 0xb6,
+	0xf5, 0xcd, 0x8b, 0x60, 0xc9, 0xf4, 0x1c, 0x56, 0xd3, 0x0f, 0x40, 0xf2, 0x49, 0x5e, 0x6c, 0xee,
+	0xf3, 0xb8, 0xfe, 0xe9, 0x22, 0xd0, 0x24, 0x55, 0x00, 0x6b, 0x33, 0x0a, 0x4d, 0x1e, 0x9e, 0x37,
+	0x45, 0xf6, 0x36, 0xab, 0x3f, 0x5a, 0x10, 0x9d, 0xe4, 0xdc, 0x83, 0x72, 0x72, 0xf0, 0xc9, 0xbd,
+	0xbc, 0xe8, 0xac, 0x2e, 0xd4, 0xcf, 0xbb, 0x1b, 0xac, 0x6b, 0x64, 0x04, 0x37, 0xb2, 0xda, 0x40,
+	0x3e, 0xcb, 0x9b, 0x78, 0x8e, 0x82, 0x64, 0x6b, 0xd0, 0xc6, 0x2c, 0x3a, 0xa9, 0x61, 0x08, 0xeb,
+	0x79, 0x27, 0x9b, 0x6c, 0xe5, 0x65, 0x3d, 0x47, 0x03, 0x2e, 0xaa, 0xac, 0x0f, 0xb0, 0x83, 0x72,
+	0x17, 0x65, 0xc0, 0x07, 0x82, 0x6c, 0xe6, 0x2e, 0x73, 0x02, 0x88, 0x27, 0x7d, 0x70, 0x21, 0x2e,
+	0x2e, 0xa4, 0xfd, 0x7a, 0x59, 0x5f, 0x85, 0xe1, 0x5f, 0xdf, 0xff, 0x62, 0xf1, 0x16, 0xc4, 0xe2,
+	0x00, 0x2a, 0x53, 0xff, 0x51, 0x24, 0x57, 0x06, 0x66, 0x7f, 0xb4, 0xfe, 0xeb, 0xc6, 0xd8, 0xfe,
+	0xfc, 0xfb, 0xf6, 0x11, 0x97, 0xc7, 0xe3, 0xc3, 0x30, 0xf5, 0x56, 0x84, 0x7c, 0xc4, 0x7d, 0xfd,
+	0xb5, 0x15, 0x33, 0xb4, 0xa5, 0x66, 0xda, 0x52, 0x65, 0x8c, 0x0e, 0x0f, 0x0b, 0xca, 0x7c, 0xf2,
+	0xcf, 0x00, 0x90, 0x05, 0x11, 0x35, 0x4b, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -932,6 +1049,9 @@ type IndexCoordClient interface {
 	GetIndexStates(ctx context.Context, in *GetIndexStatesRequest, opts ...grpc.CallOption) (*GetIndexStatesResponse, error)
 	GetIndexFilePaths(ctx context.Context, in *GetIndexFilePathsRequest, opts ...grpc.CallOption) (*GetIndexFilePathsResponse, error)
 	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CancelIndexBuild(ctx context.Context, in *CancelIndexBuildRequest, opts ...grpc.CallOption) (*milvuspb.CancelIndexBuildResponse, error)
+	// assigns the index builds of the collection to index nodes ahead of the others until none of them is pending
+	PrioritizeIndexBuild(ctx context.Context, in *PrioritizeIndexBuildRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 }
@@ -1007,6 +1127,24 @@ func (c *indexCoordClient) DropIndex(ctx context.Context, in *DropIndexRequest,
 	return out, nil
 }
 
+func (c *indexCoordClient) CancelIndexBuild(ctx context.Context, in *CancelIndexBuildRequest, opts ...grpc.CallOption) (*milvuspb.CancelIndexBuildResponse, error) {
+	out := new(milvuspb.CancelIndexBuildResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/CancelIndexBuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) PrioritizeIndexBuild(ctx context.Context, in *PrioritizeIndexBuildRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/PrioritizeIndexBuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *indexCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	out := new(milvuspb.GetMetricsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetMetrics", in, out, opts...)
@@ -1025,6 +1163,9 @@ type IndexCoordServer interface {
 	GetIndexStates(context.Context, *GetIndexStatesRequest) (*GetIndexStatesResponse, error)
 	GetIndexFilePaths(context.Context, *GetIndexFilePathsRequest) (*GetIndexFilePathsResponse, error)
 	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
+	CancelIndexBuild(context.Context, *CancelIndexBuildRequest) (*milvuspb.CancelIndexBuildResponse, error)
+	// assigns the index builds of the collection to index nodes ahead of the others until none of them is pending
+	PrioritizeIndexBuild(context.Context, *PrioritizeIndexBuildRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -1054,6 +1195,12 @@ func (*UnimplementedIndexCoordServer) GetIndexFilePaths(ctx context.Context, req
 func (*UnimplementedIndexCoordServer) DropIndex(ctx context.Context, req *DropIndexRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropIndex not implemented")
 }
+func (*UnimplementedIndexCoordServer) CancelIndexBuild(ctx context.Context, req *CancelIndexBuildRequest) (*milvuspb.CancelIndexBuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelIndexBuild not implemented")
+}
+func (*UnimplementedIndexCoordServer) PrioritizeIndexBuild(ctx context.Context, req *PrioritizeIndexBuildRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrioritizeIndexBuild not implemented")
+}
 func (*UnimplementedIndexCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
@@ -1188,6 +1335,42 @@ func _IndexCoord_DropIndex_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IndexCoord_CancelIndexBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelIndexBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).CancelIndexBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/CancelIndexBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).CancelIndexBuild(ctx, req.(*CancelIndexBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_PrioritizeIndexBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrioritizeIndexBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).PrioritizeIndexBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/PrioritizeIndexBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).PrioritizeIndexBuild(ctx, req.(*PrioritizeIndexBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IndexCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.GetMetricsRequest)
 	if err := dec(in); err != nil {
@@ -1238,6 +1421,14 @@ var _IndexCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DropIndex",
 			Handler:    _IndexCoord_DropIndex_Handler,
 		},
+		{
+			MethodName: "CancelIndexBuild",
+			Handler:    _IndexCoord_CancelIndexBuild_Handler,
+		},
+		{
+			MethodName: "PrioritizeIndexBuild",
+			Handler:    _IndexCoord_PrioritizeIndexBuild_Handler,
+		},
 		{
 			MethodName: "GetMetrics",
 			Handler:    _IndexCoord_GetMetrics_Handler,
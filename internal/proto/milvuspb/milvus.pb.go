@@ -26,6 +26,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type ReleaseJobState int32
+
+const (
+	ReleaseJobState_ReleaseJobUnknown   ReleaseJobState = 0
+	ReleaseJobState_ReleaseJobRunning   ReleaseJobState = 1
+	ReleaseJobState_ReleaseJobCompleted ReleaseJobState = 2
+	ReleaseJobState_ReleaseJobFailed    ReleaseJobState = 3
+)
+
+var ReleaseJobState_name = map[int32]string{
+	0: "ReleaseJobUnknown",
+	1: "ReleaseJobRunning",
+	2: "ReleaseJobCompleted",
+	3: "ReleaseJobFailed",
+}
+
+var ReleaseJobState_value = map[string]int32{
+	"ReleaseJobUnknown":   0,
+	"ReleaseJobRunning":   1,
+	"ReleaseJobCompleted": 2,
+	"ReleaseJobFailed":    3,
+}
+
+func (x ReleaseJobState) String() string {
+	return proto.EnumName(ReleaseJobState_name, int32(x))
+}
+
+func (ReleaseJobState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{0}
+}
+
 //
 // This is for ShowCollectionsRequest type field.
 type ShowType int32
@@ -52,7 +83,7 @@ func (x ShowType) String() string {
 }
 
 func (ShowType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{0}
+	return fileDescriptor_02345ba45cc0e303, []int{1}
 }
 
 type PlaceholderType int32
@@ -80,7 +111,65 @@ func (x PlaceholderType) String() string {
 }
 
 func (PlaceholderType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{1}
+	return fileDescriptor_02345ba45cc0e303, []int{2}
+}
+
+// how a segment is scanned by a search or query
+type SegmentScan int32
+
+const (
+	SegmentScan_ScanByFilter     SegmentScan = 0
+	SegmentScan_ScanByIndex      SegmentScan = 1
+	SegmentScan_ScanByBruteForce SegmentScan = 2
+)
+
+var SegmentScan_name = map[int32]string{
+	0: "ScanByFilter",
+	1: "ScanByIndex",
+	2: "ScanByBruteForce",
+}
+
+var SegmentScan_value = map[string]int32{
+	"ScanByFilter":     0,
+	"ScanByIndex":      1,
+	"ScanByBruteForce": 2,
+}
+
+func (x SegmentScan) String() string {
+	return proto.EnumName(SegmentScan_name, int32(x))
+}
+
+func (SegmentScan) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{3}
+}
+
+type CordonedNodeState int32
+
+const (
+	CordonedNodeState_CordonedNodeUnknown CordonedNodeState = 0
+	// the segments and channels are being moved off the node
+	CordonedNodeState_CordonedNodeDraining CordonedNodeState = 1
+	CordonedNodeState_CordonedNodeDrained  CordonedNodeState = 2
+)
+
+var CordonedNodeState_name = map[int32]string{
+	0: "CordonedNodeUnknown",
+	1: "CordonedNodeDraining",
+	2: "CordonedNodeDrained",
+}
+
+var CordonedNodeState_value = map[string]int32{
+	"CordonedNodeUnknown":  0,
+	"CordonedNodeDraining": 1,
+	"CordonedNodeDrained":  2,
+}
+
+func (x CordonedNodeState) String() string {
+	return proto.EnumName(CordonedNodeState_name, int32(x))
+}
+
+func (CordonedNodeState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{4}
 }
 
 type CreateAliasRequest struct {
@@ -265,1406 +354,1409 @@ func (m *AlterAliasRequest) GetAlias() string {
 }
 
 //*
-// Create collection in milvus
-type CreateCollectionRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The unique collection name in milvus.(Required)
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The serialized `schema.CollectionSchema`(Required)
-	Schema []byte `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
-	// Once set, no modification is allowed (Optional)
-	// https://github.com/milvus-io/milvus/issues/6690
-	ShardsNum int32 `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
-	// The consistency level that the collection used, modification is not supported now.
-	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,6,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+// Rename a collection, the aliases of the collection still refer to it
+type RenameCollectionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	OldName              string            `protobuf:"bytes,3,opt,name=oldName,proto3" json:"oldName,omitempty"`
+	NewName              string            `protobuf:"bytes,4,opt,name=newName,proto3" json:"newName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *CreateCollectionRequest) Reset()         { *m = CreateCollectionRequest{} }
-func (m *CreateCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateCollectionRequest) ProtoMessage()    {}
-func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+func (m *RenameCollectionRequest) Reset()         { *m = RenameCollectionRequest{} }
+func (m *RenameCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*RenameCollectionRequest) ProtoMessage()    {}
+func (*RenameCollectionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{3}
 }
 
-func (m *CreateCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateCollectionRequest.Unmarshal(m, b)
+func (m *RenameCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenameCollectionRequest.Unmarshal(m, b)
 }
-func (m *CreateCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateCollectionRequest.Marshal(b, m, deterministic)
+func (m *RenameCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenameCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateCollectionRequest.Merge(m, src)
+func (m *RenameCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameCollectionRequest.Merge(m, src)
 }
-func (m *CreateCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateCollectionRequest.Size(m)
+func (m *RenameCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_RenameCollectionRequest.Size(m)
 }
-func (m *CreateCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateCollectionRequest.DiscardUnknown(m)
+func (m *RenameCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_RenameCollectionRequest proto.InternalMessageInfo
 
-func (m *CreateCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *RenameCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *CreateCollectionRequest) GetDbName() string {
+func (m *RenameCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *CreateCollectionRequest) GetCollectionName() string {
+func (m *RenameCollectionRequest) GetOldName() string {
 	if m != nil {
-		return m.CollectionName
+		return m.OldName
 	}
 	return ""
 }
 
-func (m *CreateCollectionRequest) GetSchema() []byte {
-	if m != nil {
-		return m.Schema
-	}
-	return nil
-}
-
-func (m *CreateCollectionRequest) GetShardsNum() int32 {
-	if m != nil {
-		return m.ShardsNum
-	}
-	return 0
-}
-
-func (m *CreateCollectionRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+func (m *RenameCollectionRequest) GetNewName() string {
 	if m != nil {
-		return m.ConsistencyLevel
+		return m.NewName
 	}
-	return commonpb.ConsistencyLevel_Strong
+	return ""
 }
 
 //*
-// Drop collection in milvus, also will drop data in collection.
-type DropCollectionRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The unique collection name in milvus.(Required)
-	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Add a scalar field with a default value to an existing collection, the entities written before read the default value
+type AddCollectionFieldRequest struct {
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Field                *schemapb.FieldSchema `protobuf:"bytes,4,opt,name=field,proto3" json:"field,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *DropCollectionRequest) Reset()         { *m = DropCollectionRequest{} }
-func (m *DropCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*DropCollectionRequest) ProtoMessage()    {}
-func (*DropCollectionRequest) Descriptor() ([]byte, []int) {
+func (m *AddCollectionFieldRequest) Reset()         { *m = AddCollectionFieldRequest{} }
+func (m *AddCollectionFieldRequest) String() string { return proto.CompactTextString(m) }
+func (*AddCollectionFieldRequest) ProtoMessage()    {}
+func (*AddCollectionFieldRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{4}
 }
 
-func (m *DropCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropCollectionRequest.Unmarshal(m, b)
+func (m *AddCollectionFieldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddCollectionFieldRequest.Unmarshal(m, b)
 }
-func (m *DropCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropCollectionRequest.Marshal(b, m, deterministic)
+func (m *AddCollectionFieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddCollectionFieldRequest.Marshal(b, m, deterministic)
 }
-func (m *DropCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropCollectionRequest.Merge(m, src)
+func (m *AddCollectionFieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddCollectionFieldRequest.Merge(m, src)
 }
-func (m *DropCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_DropCollectionRequest.Size(m)
+func (m *AddCollectionFieldRequest) XXX_Size() int {
+	return xxx_messageInfo_AddCollectionFieldRequest.Size(m)
 }
-func (m *DropCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropCollectionRequest.DiscardUnknown(m)
+func (m *AddCollectionFieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddCollectionFieldRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_AddCollectionFieldRequest proto.InternalMessageInfo
 
-func (m *DropCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *AddCollectionFieldRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DropCollectionRequest) GetDbName() string {
+func (m *AddCollectionFieldRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *DropCollectionRequest) GetCollectionName() string {
+func (m *AddCollectionFieldRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
+func (m *AddCollectionFieldRequest) GetField() *schemapb.FieldSchema {
+	if m != nil {
+		return m.Field
+	}
+	return nil
+}
+
 //*
-// Check collection exist in milvus or not.
-type HasCollectionRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want to check.
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// If time_stamp is not zero, will return true when time_stamp >= created collection timestamp, otherwise will return false.
-	TimeStamp            uint64   `protobuf:"varint,4,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Set or remove the properties of a collection, the property with an empty value is removed
+type AlterCollectionRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Properties           []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *HasCollectionRequest) Reset()         { *m = HasCollectionRequest{} }
-func (m *HasCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*HasCollectionRequest) ProtoMessage()    {}
-func (*HasCollectionRequest) Descriptor() ([]byte, []int) {
+func (m *AlterCollectionRequest) Reset()         { *m = AlterCollectionRequest{} }
+func (m *AlterCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*AlterCollectionRequest) ProtoMessage()    {}
+func (*AlterCollectionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{5}
 }
 
-func (m *HasCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HasCollectionRequest.Unmarshal(m, b)
+func (m *AlterCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlterCollectionRequest.Unmarshal(m, b)
 }
-func (m *HasCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HasCollectionRequest.Marshal(b, m, deterministic)
+func (m *AlterCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlterCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *HasCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HasCollectionRequest.Merge(m, src)
+func (m *AlterCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterCollectionRequest.Merge(m, src)
 }
-func (m *HasCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_HasCollectionRequest.Size(m)
+func (m *AlterCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_AlterCollectionRequest.Size(m)
 }
-func (m *HasCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_HasCollectionRequest.DiscardUnknown(m)
+func (m *AlterCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HasCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_AlterCollectionRequest proto.InternalMessageInfo
 
-func (m *HasCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *AlterCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *HasCollectionRequest) GetDbName() string {
+func (m *AlterCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *HasCollectionRequest) GetCollectionName() string {
+func (m *AlterCollectionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *HasCollectionRequest) GetTimeStamp() uint64 {
+func (m *AlterCollectionRequest) GetProperties() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.TimeStamp
+		return m.Properties
 	}
-	return 0
+	return nil
 }
 
-type BoolResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Value                bool             `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+//*
+// Create a database, the collections are named in the scope of their database.
+// The properties are the quotas of the database.
+type CreateDatabaseRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Properties           []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *BoolResponse) Reset()         { *m = BoolResponse{} }
-func (m *BoolResponse) String() string { return proto.CompactTextString(m) }
-func (*BoolResponse) ProtoMessage()    {}
-func (*BoolResponse) Descriptor() ([]byte, []int) {
+func (m *CreateDatabaseRequest) Reset()         { *m = CreateDatabaseRequest{} }
+func (m *CreateDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDatabaseRequest) ProtoMessage()    {}
+func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{6}
 }
 
-func (m *BoolResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BoolResponse.Unmarshal(m, b)
+func (m *CreateDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDatabaseRequest.Unmarshal(m, b)
 }
-func (m *BoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BoolResponse.Marshal(b, m, deterministic)
+func (m *CreateDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDatabaseRequest.Marshal(b, m, deterministic)
 }
-func (m *BoolResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BoolResponse.Merge(m, src)
+func (m *CreateDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDatabaseRequest.Merge(m, src)
 }
-func (m *BoolResponse) XXX_Size() int {
-	return xxx_messageInfo_BoolResponse.Size(m)
+func (m *CreateDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDatabaseRequest.Size(m)
 }
-func (m *BoolResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BoolResponse.DiscardUnknown(m)
+func (m *CreateDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDatabaseRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BoolResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateDatabaseRequest proto.InternalMessageInfo
 
-func (m *BoolResponse) GetStatus() *commonpb.Status {
+func (m *CreateDatabaseRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *BoolResponse) GetValue() bool {
+func (m *CreateDatabaseRequest) GetDbName() string {
 	if m != nil {
-		return m.Value
+		return m.DbName
 	}
-	return false
+	return ""
 }
 
-type StringResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Value                string           `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *CreateDatabaseRequest) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
 }
 
-func (m *StringResponse) Reset()         { *m = StringResponse{} }
-func (m *StringResponse) String() string { return proto.CompactTextString(m) }
-func (*StringResponse) ProtoMessage()    {}
-func (*StringResponse) Descriptor() ([]byte, []int) {
+//*
+// Drop a database without collections
+type DropDatabaseRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DropDatabaseRequest) Reset()         { *m = DropDatabaseRequest{} }
+func (m *DropDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*DropDatabaseRequest) ProtoMessage()    {}
+func (*DropDatabaseRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{7}
 }
 
-func (m *StringResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StringResponse.Unmarshal(m, b)
+func (m *DropDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropDatabaseRequest.Unmarshal(m, b)
 }
-func (m *StringResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StringResponse.Marshal(b, m, deterministic)
+func (m *DropDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropDatabaseRequest.Marshal(b, m, deterministic)
 }
-func (m *StringResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StringResponse.Merge(m, src)
+func (m *DropDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropDatabaseRequest.Merge(m, src)
 }
-func (m *StringResponse) XXX_Size() int {
-	return xxx_messageInfo_StringResponse.Size(m)
+func (m *DropDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_DropDatabaseRequest.Size(m)
 }
-func (m *StringResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StringResponse.DiscardUnknown(m)
+func (m *DropDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropDatabaseRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StringResponse proto.InternalMessageInfo
+var xxx_messageInfo_DropDatabaseRequest proto.InternalMessageInfo
 
-func (m *StringResponse) GetStatus() *commonpb.Status {
+func (m *DropDatabaseRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *StringResponse) GetValue() string {
+func (m *DropDatabaseRequest) GetDbName() string {
 	if m != nil {
-		return m.Value
+		return m.DbName
 	}
 	return ""
 }
 
 //*
-// Get collection meta datas like: schema, collectionID, shards number ...
-type DescribeCollectionRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want to describe, you can pass collection_name or collectionID
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The collection ID you want to describe
-	CollectionID int64 `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	// If time_stamp is not zero, will describe collection success when time_stamp >= created collection timestamp, otherwise will throw error.
-	TimeStamp            uint64   `protobuf:"varint,5,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Set the quotas of a database, the quotas not in the properties are unchanged
+type AlterDatabaseRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Properties           []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *DescribeCollectionRequest) Reset()         { *m = DescribeCollectionRequest{} }
-func (m *DescribeCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*DescribeCollectionRequest) ProtoMessage()    {}
-func (*DescribeCollectionRequest) Descriptor() ([]byte, []int) {
+func (m *AlterDatabaseRequest) Reset()         { *m = AlterDatabaseRequest{} }
+func (m *AlterDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*AlterDatabaseRequest) ProtoMessage()    {}
+func (*AlterDatabaseRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{8}
 }
 
-func (m *DescribeCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeCollectionRequest.Unmarshal(m, b)
+func (m *AlterDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlterDatabaseRequest.Unmarshal(m, b)
 }
-func (m *DescribeCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeCollectionRequest.Marshal(b, m, deterministic)
+func (m *AlterDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlterDatabaseRequest.Marshal(b, m, deterministic)
 }
-func (m *DescribeCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeCollectionRequest.Merge(m, src)
+func (m *AlterDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterDatabaseRequest.Merge(m, src)
 }
-func (m *DescribeCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_DescribeCollectionRequest.Size(m)
+func (m *AlterDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_AlterDatabaseRequest.Size(m)
 }
-func (m *DescribeCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeCollectionRequest.DiscardUnknown(m)
+func (m *AlterDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterDatabaseRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_AlterDatabaseRequest proto.InternalMessageInfo
 
-func (m *DescribeCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *AlterDatabaseRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DescribeCollectionRequest) GetDbName() string {
+func (m *AlterDatabaseRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *DescribeCollectionRequest) GetCollectionName() string {
+func (m *AlterDatabaseRequest) GetProperties() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.CollectionName
+		return m.Properties
 	}
-	return ""
+	return nil
 }
 
-func (m *DescribeCollectionRequest) GetCollectionID() int64 {
-	if m != nil {
-		return m.CollectionID
-	}
-	return 0
+type ListDatabasesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DescribeCollectionRequest) GetTimeStamp() uint64 {
+func (m *ListDatabasesRequest) Reset()         { *m = ListDatabasesRequest{} }
+func (m *ListDatabasesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesRequest) ProtoMessage()    {}
+func (*ListDatabasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{9}
+}
+
+func (m *ListDatabasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesRequest.Unmarshal(m, b)
+}
+func (m *ListDatabasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDatabasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesRequest.Merge(m, src)
+}
+func (m *ListDatabasesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesRequest.Size(m)
+}
+func (m *ListDatabasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatabasesRequest proto.InternalMessageInfo
+
+func (m *ListDatabasesRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.TimeStamp
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
 //*
-// DescribeCollection Response
-type DescribeCollectionResponse struct {
-	// Contain error_code and reason
-	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// The schema param when you created collection.
-	Schema *schemapb.CollectionSchema `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
-	// The collection id
-	CollectionID int64 `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	// System design related, users should not perceive
-	VirtualChannelNames []string `protobuf:"bytes,4,rep,name=virtual_channel_names,json=virtualChannelNames,proto3" json:"virtual_channel_names,omitempty"`
-	// System design related, users should not perceive
-	PhysicalChannelNames []string `protobuf:"bytes,5,rep,name=physical_channel_names,json=physicalChannelNames,proto3" json:"physical_channel_names,omitempty"`
-	// Hybrid timestamp in milvus
-	CreatedTimestamp uint64 `protobuf:"varint,6,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
-	// The utc timestamp calculated by created_timestamp
-	CreatedUtcTimestamp uint64 `protobuf:"varint,7,opt,name=created_utc_timestamp,json=createdUtcTimestamp,proto3" json:"created_utc_timestamp,omitempty"`
-	// The shards number you set.
-	ShardsNum int32 `protobuf:"varint,8,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
-	// The aliases of this collection
-	Aliases []string `protobuf:"bytes,9,rep,name=aliases,proto3" json:"aliases,omitempty"`
-	// The message ID/posititon when collection is created
-	StartPositions []*commonpb.KeyDataPair `protobuf:"bytes,10,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
-	// The consistency level that the collection used, modification is not supported now.
-	ConsistencyLevel commonpb.ConsistencyLevel `protobuf:"varint,11,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
-	// The collection name
-	CollectionName       string   `protobuf:"bytes,12,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// The databases sorted by name, the default database first
+type ListDatabasesResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DbNames              []string         `protobuf:"bytes,2,rep,name=db_names,json=dbNames,proto3" json:"db_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *DescribeCollectionResponse) Reset()         { *m = DescribeCollectionResponse{} }
-func (m *DescribeCollectionResponse) String() string { return proto.CompactTextString(m) }
-func (*DescribeCollectionResponse) ProtoMessage()    {}
-func (*DescribeCollectionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{9}
+func (m *ListDatabasesResponse) Reset()         { *m = ListDatabasesResponse{} }
+func (m *ListDatabasesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesResponse) ProtoMessage()    {}
+func (*ListDatabasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{10}
 }
 
-func (m *DescribeCollectionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeCollectionResponse.Unmarshal(m, b)
+func (m *ListDatabasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesResponse.Unmarshal(m, b)
 }
-func (m *DescribeCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeCollectionResponse.Marshal(b, m, deterministic)
+func (m *ListDatabasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesResponse.Marshal(b, m, deterministic)
 }
-func (m *DescribeCollectionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeCollectionResponse.Merge(m, src)
+func (m *ListDatabasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesResponse.Merge(m, src)
 }
-func (m *DescribeCollectionResponse) XXX_Size() int {
-	return xxx_messageInfo_DescribeCollectionResponse.Size(m)
+func (m *ListDatabasesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesResponse.Size(m)
 }
-func (m *DescribeCollectionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeCollectionResponse.DiscardUnknown(m)
+func (m *ListDatabasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeCollectionResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListDatabasesResponse proto.InternalMessageInfo
 
-func (m *DescribeCollectionResponse) GetStatus() *commonpb.Status {
+func (m *ListDatabasesResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *DescribeCollectionResponse) GetSchema() *schemapb.CollectionSchema {
+func (m *ListDatabasesResponse) GetDbNames() []string {
 	if m != nil {
-		return m.Schema
+		return m.DbNames
 	}
 	return nil
 }
 
-func (m *DescribeCollectionResponse) GetCollectionID() int64 {
-	if m != nil {
-		return m.CollectionID
-	}
-	return 0
+//*
+// Create collection in milvus
+type CreateCollectionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The unique collection name in milvus.(Required)
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The serialized `schema.CollectionSchema`(Required)
+	Schema []byte `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	// Once set, no modification is allowed (Optional)
+	// https://github.com/milvus-io/milvus/issues/6690
+	ShardsNum int32 `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	// The consistency level that the collection used, modification is not supported now.
+	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,6,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *DescribeCollectionResponse) GetVirtualChannelNames() []string {
-	if m != nil {
-		return m.VirtualChannelNames
-	}
-	return nil
+func (m *CreateCollectionRequest) Reset()         { *m = CreateCollectionRequest{} }
+func (m *CreateCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCollectionRequest) ProtoMessage()    {}
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{11}
 }
 
-func (m *DescribeCollectionResponse) GetPhysicalChannelNames() []string {
-	if m != nil {
-		return m.PhysicalChannelNames
-	}
-	return nil
+func (m *CreateCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCollectionRequest.Unmarshal(m, b)
+}
+func (m *CreateCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCollectionRequest.Merge(m, src)
+}
+func (m *CreateCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCollectionRequest.Size(m)
+}
+func (m *CreateCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCollectionRequest.DiscardUnknown(m)
 }
 
-func (m *DescribeCollectionResponse) GetCreatedTimestamp() uint64 {
+var xxx_messageInfo_CreateCollectionRequest proto.InternalMessageInfo
+
+func (m *CreateCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.CreatedTimestamp
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *DescribeCollectionResponse) GetCreatedUtcTimestamp() uint64 {
+func (m *CreateCollectionRequest) GetDbName() string {
 	if m != nil {
-		return m.CreatedUtcTimestamp
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-func (m *DescribeCollectionResponse) GetShardsNum() int32 {
+func (m *CreateCollectionRequest) GetCollectionName() string {
 	if m != nil {
-		return m.ShardsNum
+		return m.CollectionName
 	}
-	return 0
+	return ""
 }
 
-func (m *DescribeCollectionResponse) GetAliases() []string {
+func (m *CreateCollectionRequest) GetSchema() []byte {
 	if m != nil {
-		return m.Aliases
+		return m.Schema
 	}
 	return nil
 }
 
-func (m *DescribeCollectionResponse) GetStartPositions() []*commonpb.KeyDataPair {
+func (m *CreateCollectionRequest) GetShardsNum() int32 {
 	if m != nil {
-		return m.StartPositions
+		return m.ShardsNum
 	}
-	return nil
+	return 0
 }
 
-func (m *DescribeCollectionResponse) GetConsistencyLevel() commonpb.ConsistencyLevel {
+func (m *CreateCollectionRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
 	if m != nil {
 		return m.ConsistencyLevel
 	}
 	return commonpb.ConsistencyLevel_Strong
 }
 
-func (m *DescribeCollectionResponse) GetCollectionName() string {
-	if m != nil {
-		return m.CollectionName
-	}
-	return ""
-}
-
 //*
-// Load collection data into query nodes, then you can do vector search on this collection.
-type LoadCollectionRequest struct {
+// Drop collection in milvus, also will drop data in collection.
+type DropCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want to load
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The replica number to load, default by 1
-	ReplicaNumber        int32    `protobuf:"varint,4,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	// The unique collection name in milvus.(Required)
+	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LoadCollectionRequest) Reset()         { *m = LoadCollectionRequest{} }
-func (m *LoadCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*LoadCollectionRequest) ProtoMessage()    {}
-func (*LoadCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{10}
+func (m *DropCollectionRequest) Reset()         { *m = DropCollectionRequest{} }
+func (m *DropCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*DropCollectionRequest) ProtoMessage()    {}
+func (*DropCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{12}
 }
 
-func (m *LoadCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoadCollectionRequest.Unmarshal(m, b)
+func (m *DropCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropCollectionRequest.Unmarshal(m, b)
 }
-func (m *LoadCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoadCollectionRequest.Marshal(b, m, deterministic)
+func (m *DropCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *LoadCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoadCollectionRequest.Merge(m, src)
+func (m *DropCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropCollectionRequest.Merge(m, src)
 }
-func (m *LoadCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_LoadCollectionRequest.Size(m)
+func (m *DropCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_DropCollectionRequest.Size(m)
 }
-func (m *LoadCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoadCollectionRequest.DiscardUnknown(m)
+func (m *DropCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoadCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_DropCollectionRequest proto.InternalMessageInfo
 
-func (m *LoadCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *DropCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *LoadCollectionRequest) GetDbName() string {
+func (m *DropCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *LoadCollectionRequest) GetCollectionName() string {
+func (m *DropCollectionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *LoadCollectionRequest) GetReplicaNumber() int32 {
-	if m != nil {
-		return m.ReplicaNumber
-	}
-	return 0
-}
-
 //*
-// Release collection data from query nodes, then you can't do vector search on this collection.
-type ReleaseCollectionRequest struct {
+// Check collection exist in milvus or not.
+type HasCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want to release
-	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The collection name you want to check.
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// If time_stamp is not zero, will return true when time_stamp >= created collection timestamp, otherwise will return false.
+	TimeStamp            uint64   `protobuf:"varint,4,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReleaseCollectionRequest) Reset()         { *m = ReleaseCollectionRequest{} }
-func (m *ReleaseCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*ReleaseCollectionRequest) ProtoMessage()    {}
-func (*ReleaseCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{11}
+func (m *HasCollectionRequest) Reset()         { *m = HasCollectionRequest{} }
+func (m *HasCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*HasCollectionRequest) ProtoMessage()    {}
+func (*HasCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{13}
 }
 
-func (m *ReleaseCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReleaseCollectionRequest.Unmarshal(m, b)
+func (m *HasCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HasCollectionRequest.Unmarshal(m, b)
 }
-func (m *ReleaseCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReleaseCollectionRequest.Marshal(b, m, deterministic)
+func (m *HasCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HasCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *ReleaseCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReleaseCollectionRequest.Merge(m, src)
+func (m *HasCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasCollectionRequest.Merge(m, src)
 }
-func (m *ReleaseCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_ReleaseCollectionRequest.Size(m)
+func (m *HasCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_HasCollectionRequest.Size(m)
 }
-func (m *ReleaseCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReleaseCollectionRequest.DiscardUnknown(m)
+func (m *HasCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReleaseCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_HasCollectionRequest proto.InternalMessageInfo
 
-func (m *ReleaseCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *HasCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *ReleaseCollectionRequest) GetDbName() string {
+func (m *HasCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *ReleaseCollectionRequest) GetCollectionName() string {
+func (m *HasCollectionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-//*
-// Get collection statistics like row_count.
-type GetCollectionStatisticsRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want get statistics
-	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *HasCollectionRequest) GetTimeStamp() uint64 {
+	if m != nil {
+		return m.TimeStamp
+	}
+	return 0
 }
 
-func (m *GetCollectionStatisticsRequest) Reset()         { *m = GetCollectionStatisticsRequest{} }
-func (m *GetCollectionStatisticsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCollectionStatisticsRequest) ProtoMessage()    {}
-func (*GetCollectionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{12}
+type BoolResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Value                bool             `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *GetCollectionStatisticsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCollectionStatisticsRequest.Unmarshal(m, b)
+func (m *BoolResponse) Reset()         { *m = BoolResponse{} }
+func (m *BoolResponse) String() string { return proto.CompactTextString(m) }
+func (*BoolResponse) ProtoMessage()    {}
+func (*BoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{14}
 }
-func (m *GetCollectionStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCollectionStatisticsRequest.Marshal(b, m, deterministic)
+
+func (m *BoolResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BoolResponse.Unmarshal(m, b)
 }
-func (m *GetCollectionStatisticsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCollectionStatisticsRequest.Merge(m, src)
+func (m *BoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BoolResponse.Marshal(b, m, deterministic)
 }
-func (m *GetCollectionStatisticsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCollectionStatisticsRequest.Size(m)
+func (m *BoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoolResponse.Merge(m, src)
 }
-func (m *GetCollectionStatisticsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCollectionStatisticsRequest.DiscardUnknown(m)
+func (m *BoolResponse) XXX_Size() int {
+	return xxx_messageInfo_BoolResponse.Size(m)
+}
+func (m *BoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoolResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCollectionStatisticsRequest proto.InternalMessageInfo
+var xxx_messageInfo_BoolResponse proto.InternalMessageInfo
 
-func (m *GetCollectionStatisticsRequest) GetBase() *commonpb.MsgBase {
+func (m *BoolResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *GetCollectionStatisticsRequest) GetDbName() string {
-	if m != nil {
-		return m.DbName
-	}
-	return ""
-}
-
-func (m *GetCollectionStatisticsRequest) GetCollectionName() string {
+func (m *BoolResponse) GetValue() bool {
 	if m != nil {
-		return m.CollectionName
+		return m.Value
 	}
-	return ""
+	return false
 }
 
-//*
-// Will return collection statistics in stats field like [{key:"row_count",value:"1"}]
-type GetCollectionStatisticsResponse struct {
-	// Contain error_code and reason
-	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// Collection statistics data
-	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+type StringResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Value                string           `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *GetCollectionStatisticsResponse) Reset()         { *m = GetCollectionStatisticsResponse{} }
-func (m *GetCollectionStatisticsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCollectionStatisticsResponse) ProtoMessage()    {}
-func (*GetCollectionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{13}
+func (m *StringResponse) Reset()         { *m = StringResponse{} }
+func (m *StringResponse) String() string { return proto.CompactTextString(m) }
+func (*StringResponse) ProtoMessage()    {}
+func (*StringResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{15}
 }
 
-func (m *GetCollectionStatisticsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCollectionStatisticsResponse.Unmarshal(m, b)
+func (m *StringResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StringResponse.Unmarshal(m, b)
 }
-func (m *GetCollectionStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCollectionStatisticsResponse.Marshal(b, m, deterministic)
+func (m *StringResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StringResponse.Marshal(b, m, deterministic)
 }
-func (m *GetCollectionStatisticsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCollectionStatisticsResponse.Merge(m, src)
+func (m *StringResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StringResponse.Merge(m, src)
 }
-func (m *GetCollectionStatisticsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCollectionStatisticsResponse.Size(m)
+func (m *StringResponse) XXX_Size() int {
+	return xxx_messageInfo_StringResponse.Size(m)
 }
-func (m *GetCollectionStatisticsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCollectionStatisticsResponse.DiscardUnknown(m)
+func (m *StringResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StringResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCollectionStatisticsResponse proto.InternalMessageInfo
+var xxx_messageInfo_StringResponse proto.InternalMessageInfo
 
-func (m *GetCollectionStatisticsResponse) GetStatus() *commonpb.Status {
+func (m *StringResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *GetCollectionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
+func (m *StringResponse) GetValue() string {
 	if m != nil {
-		return m.Stats
+		return m.Value
 	}
-	return nil
+	return ""
 }
 
-//
-// List collections
-type ShowCollectionsRequest struct {
+//*
+// Get collection meta datas like: schema, collectionID, shards number ...
+type DescribeCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// Not useful for now
-	TimeStamp uint64 `protobuf:"varint,3,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
-	// Decide return Loaded collections or All collections(Optional)
-	Type ShowType `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
-	// When type is InMemory, will return these collection's inMemory_percentages.(Optional)
-	CollectionNames      []string `protobuf:"bytes,5,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	// The collection name you want to describe, you can pass collection_name or collectionID
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The collection ID you want to describe
+	CollectionID int64 `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// If time_stamp is not zero, will describe collection success when time_stamp >= created collection timestamp, otherwise will throw error.
+	TimeStamp            uint64   `protobuf:"varint,5,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShowCollectionsRequest) Reset()         { *m = ShowCollectionsRequest{} }
-func (m *ShowCollectionsRequest) String() string { return proto.CompactTextString(m) }
-func (*ShowCollectionsRequest) ProtoMessage()    {}
-func (*ShowCollectionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{14}
+func (m *DescribeCollectionRequest) Reset()         { *m = DescribeCollectionRequest{} }
+func (m *DescribeCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeCollectionRequest) ProtoMessage()    {}
+func (*DescribeCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{16}
 }
 
-func (m *ShowCollectionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowCollectionsRequest.Unmarshal(m, b)
+func (m *DescribeCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeCollectionRequest.Unmarshal(m, b)
 }
-func (m *ShowCollectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowCollectionsRequest.Marshal(b, m, deterministic)
+func (m *DescribeCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *ShowCollectionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowCollectionsRequest.Merge(m, src)
+func (m *DescribeCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeCollectionRequest.Merge(m, src)
 }
-func (m *ShowCollectionsRequest) XXX_Size() int {
-	return xxx_messageInfo_ShowCollectionsRequest.Size(m)
+func (m *DescribeCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeCollectionRequest.Size(m)
 }
-func (m *ShowCollectionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowCollectionsRequest.DiscardUnknown(m)
+func (m *DescribeCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowCollectionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_DescribeCollectionRequest proto.InternalMessageInfo
 
-func (m *ShowCollectionsRequest) GetBase() *commonpb.MsgBase {
+func (m *DescribeCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *ShowCollectionsRequest) GetDbName() string {
+func (m *DescribeCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *ShowCollectionsRequest) GetTimeStamp() uint64 {
+func (m *DescribeCollectionRequest) GetCollectionName() string {
 	if m != nil {
-		return m.TimeStamp
+		return m.CollectionName
 	}
-	return 0
+	return ""
 }
 
-func (m *ShowCollectionsRequest) GetType() ShowType {
+func (m *DescribeCollectionRequest) GetCollectionID() int64 {
 	if m != nil {
-		return m.Type
+		return m.CollectionID
 	}
-	return ShowType_All
+	return 0
 }
 
-func (m *ShowCollectionsRequest) GetCollectionNames() []string {
+func (m *DescribeCollectionRequest) GetTimeStamp() uint64 {
 	if m != nil {
-		return m.CollectionNames
+		return m.TimeStamp
 	}
-	return nil
+	return 0
 }
 
-//
-// Return basic collection infos.
-type ShowCollectionsResponse struct {
+//*
+// DescribeCollection Response
+type DescribeCollectionResponse struct {
 	// Contain error_code and reason
 	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// Collection name array
-	CollectionNames []string `protobuf:"bytes,2,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
-	// Collection Id array
-	CollectionIds []int64 `protobuf:"varint,3,rep,packed,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"`
-	// Hybrid timestamps in milvus
-	CreatedTimestamps []uint64 `protobuf:"varint,4,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
+	// The schema param when you created collection.
+	Schema *schemapb.CollectionSchema `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// The collection id
+	CollectionID int64 `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// System design related, users should not perceive
+	VirtualChannelNames []string `protobuf:"bytes,4,rep,name=virtual_channel_names,json=virtualChannelNames,proto3" json:"virtual_channel_names,omitempty"`
+	// System design related, users should not perceive
+	PhysicalChannelNames []string `protobuf:"bytes,5,rep,name=physical_channel_names,json=physicalChannelNames,proto3" json:"physical_channel_names,omitempty"`
+	// Hybrid timestamp in milvus
+	CreatedTimestamp uint64 `protobuf:"varint,6,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
 	// The utc timestamp calculated by created_timestamp
-	CreatedUtcTimestamps []uint64 `protobuf:"varint,5,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
-	// Load percentage on querynode when type is InMemory
-	InMemoryPercentages  []int64  `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
+	CreatedUtcTimestamp uint64 `protobuf:"varint,7,opt,name=created_utc_timestamp,json=createdUtcTimestamp,proto3" json:"created_utc_timestamp,omitempty"`
+	// The shards number you set.
+	ShardsNum int32 `protobuf:"varint,8,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	// The aliases of this collection
+	Aliases []string `protobuf:"bytes,9,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// The message ID/posititon when collection is created
+	StartPositions []*commonpb.KeyDataPair `protobuf:"bytes,10,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
+	// The consistency level that the collection used, modification is not supported now.
+	ConsistencyLevel commonpb.ConsistencyLevel `protobuf:"varint,11,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	// The collection name
+	CollectionName       string   `protobuf:"bytes,12,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShowCollectionsResponse) Reset()         { *m = ShowCollectionsResponse{} }
-func (m *ShowCollectionsResponse) String() string { return proto.CompactTextString(m) }
-func (*ShowCollectionsResponse) ProtoMessage()    {}
-func (*ShowCollectionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{15}
+func (m *DescribeCollectionResponse) Reset()         { *m = DescribeCollectionResponse{} }
+func (m *DescribeCollectionResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeCollectionResponse) ProtoMessage()    {}
+func (*DescribeCollectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{17}
 }
 
-func (m *ShowCollectionsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowCollectionsResponse.Unmarshal(m, b)
+func (m *DescribeCollectionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeCollectionResponse.Unmarshal(m, b)
 }
-func (m *ShowCollectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowCollectionsResponse.Marshal(b, m, deterministic)
+func (m *DescribeCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeCollectionResponse.Marshal(b, m, deterministic)
 }
-func (m *ShowCollectionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowCollectionsResponse.Merge(m, src)
+func (m *DescribeCollectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeCollectionResponse.Merge(m, src)
 }
-func (m *ShowCollectionsResponse) XXX_Size() int {
-	return xxx_messageInfo_ShowCollectionsResponse.Size(m)
+func (m *DescribeCollectionResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeCollectionResponse.Size(m)
 }
-func (m *ShowCollectionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowCollectionsResponse.DiscardUnknown(m)
+func (m *DescribeCollectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeCollectionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowCollectionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_DescribeCollectionResponse proto.InternalMessageInfo
 
-func (m *ShowCollectionsResponse) GetStatus() *commonpb.Status {
+func (m *DescribeCollectionResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *ShowCollectionsResponse) GetCollectionNames() []string {
+func (m *DescribeCollectionResponse) GetSchema() *schemapb.CollectionSchema {
 	if m != nil {
-		return m.CollectionNames
+		return m.Schema
 	}
 	return nil
 }
 
-func (m *ShowCollectionsResponse) GetCollectionIds() []int64 {
+func (m *DescribeCollectionResponse) GetCollectionID() int64 {
 	if m != nil {
-		return m.CollectionIds
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *DescribeCollectionResponse) GetVirtualChannelNames() []string {
+	if m != nil {
+		return m.VirtualChannelNames
 	}
 	return nil
 }
 
-func (m *ShowCollectionsResponse) GetCreatedTimestamps() []uint64 {
+func (m *DescribeCollectionResponse) GetPhysicalChannelNames() []string {
 	if m != nil {
-		return m.CreatedTimestamps
+		return m.PhysicalChannelNames
 	}
 	return nil
 }
 
-func (m *ShowCollectionsResponse) GetCreatedUtcTimestamps() []uint64 {
+func (m *DescribeCollectionResponse) GetCreatedTimestamp() uint64 {
 	if m != nil {
-		return m.CreatedUtcTimestamps
+		return m.CreatedTimestamp
+	}
+	return 0
+}
+
+func (m *DescribeCollectionResponse) GetCreatedUtcTimestamp() uint64 {
+	if m != nil {
+		return m.CreatedUtcTimestamp
+	}
+	return 0
+}
+
+func (m *DescribeCollectionResponse) GetShardsNum() int32 {
+	if m != nil {
+		return m.ShardsNum
+	}
+	return 0
+}
+
+func (m *DescribeCollectionResponse) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
 	}
 	return nil
 }
 
-func (m *ShowCollectionsResponse) GetInMemoryPercentages() []int64 {
+func (m *DescribeCollectionResponse) GetStartPositions() []*commonpb.KeyDataPair {
 	if m != nil {
-		return m.InMemoryPercentages
+		return m.StartPositions
 	}
 	return nil
 }
 
-//
-// Create partition in created collection.
-type CreatePartitionRequest struct {
+func (m *DescribeCollectionResponse) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *DescribeCollectionResponse) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+//*
+// Load collection data into query nodes, then you can do vector search on this collection.
+type LoadCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
+	// The collection name you want to load
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition name you want to create.
-	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	// The replica number to load, default by 1
+	ReplicaNumber int32 `protobuf:"varint,4,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	// The names of the fields to load, all the fields are loaded if empty
+	LoadFields           []string `protobuf:"bytes,5,rep,name=load_fields,json=loadFields,proto3" json:"load_fields,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{} }
-func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
-func (*CreatePartitionRequest) ProtoMessage()    {}
-func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{16}
+func (m *LoadCollectionRequest) Reset()         { *m = LoadCollectionRequest{} }
+func (m *LoadCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadCollectionRequest) ProtoMessage()    {}
+func (*LoadCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{18}
 }
 
-func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreatePartitionRequest.Unmarshal(m, b)
+func (m *LoadCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadCollectionRequest.Unmarshal(m, b)
 }
-func (m *CreatePartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreatePartitionRequest.Marshal(b, m, deterministic)
+func (m *LoadCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *CreatePartitionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreatePartitionRequest.Merge(m, src)
+func (m *LoadCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadCollectionRequest.Merge(m, src)
 }
-func (m *CreatePartitionRequest) XXX_Size() int {
-	return xxx_messageInfo_CreatePartitionRequest.Size(m)
+func (m *LoadCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadCollectionRequest.Size(m)
 }
-func (m *CreatePartitionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreatePartitionRequest.DiscardUnknown(m)
+func (m *LoadCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreatePartitionRequest proto.InternalMessageInfo
+var xxx_messageInfo_LoadCollectionRequest proto.InternalMessageInfo
 
-func (m *CreatePartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *LoadCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *CreatePartitionRequest) GetDbName() string {
+func (m *LoadCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *CreatePartitionRequest) GetCollectionName() string {
+func (m *LoadCollectionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *CreatePartitionRequest) GetPartitionName() string {
+func (m *LoadCollectionRequest) GetReplicaNumber() int32 {
 	if m != nil {
-		return m.PartitionName
+		return m.ReplicaNumber
 	}
-	return ""
+	return 0
 }
 
-//
-// Drop partition in created collection.
-type DropPartitionRequest struct {
+func (m *LoadCollectionRequest) GetLoadFields() []string {
+	if m != nil {
+		return m.LoadFields
+	}
+	return nil
+}
+
+//*
+// Release collection data from query nodes, then you can't do vector search on this collection.
+type ReleaseCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition name you want to drop
-	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	// The collection name you want to release
+	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
-func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
-func (*DropPartitionRequest) ProtoMessage()    {}
-func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{17}
+func (m *ReleaseCollectionRequest) Reset()         { *m = ReleaseCollectionRequest{} }
+func (m *ReleaseCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseCollectionRequest) ProtoMessage()    {}
+func (*ReleaseCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{19}
 }
 
-func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropPartitionRequest.Unmarshal(m, b)
+func (m *ReleaseCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseCollectionRequest.Unmarshal(m, b)
 }
-func (m *DropPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropPartitionRequest.Marshal(b, m, deterministic)
+func (m *ReleaseCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *DropPartitionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropPartitionRequest.Merge(m, src)
+func (m *ReleaseCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseCollectionRequest.Merge(m, src)
 }
-func (m *DropPartitionRequest) XXX_Size() int {
-	return xxx_messageInfo_DropPartitionRequest.Size(m)
+func (m *ReleaseCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseCollectionRequest.Size(m)
 }
-func (m *DropPartitionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropPartitionRequest.DiscardUnknown(m)
+func (m *ReleaseCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropPartitionRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReleaseCollectionRequest proto.InternalMessageInfo
 
-func (m *DropPartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *ReleaseCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DropPartitionRequest) GetDbName() string {
+func (m *ReleaseCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *DropPartitionRequest) GetCollectionName() string {
+func (m *ReleaseCollectionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *DropPartitionRequest) GetPartitionName() string {
-	if m != nil {
-		return m.PartitionName
-	}
-	return ""
+//*
+// Get the release jobs of a collection, or of all the collections if collection_name is empty
+type GetReleaseJobsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-//
-// Check if partition exist in collection or not.
-type HasPartitionRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition name you want to check
-	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *HasPartitionRequest) Reset()         { *m = HasPartitionRequest{} }
-func (m *HasPartitionRequest) String() string { return proto.CompactTextString(m) }
-func (*HasPartitionRequest) ProtoMessage()    {}
-func (*HasPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{18}
+func (m *GetReleaseJobsRequest) Reset()         { *m = GetReleaseJobsRequest{} }
+func (m *GetReleaseJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReleaseJobsRequest) ProtoMessage()    {}
+func (*GetReleaseJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{20}
 }
 
-func (m *HasPartitionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HasPartitionRequest.Unmarshal(m, b)
+func (m *GetReleaseJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReleaseJobsRequest.Unmarshal(m, b)
 }
-func (m *HasPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HasPartitionRequest.Marshal(b, m, deterministic)
+func (m *GetReleaseJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReleaseJobsRequest.Marshal(b, m, deterministic)
 }
-func (m *HasPartitionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HasPartitionRequest.Merge(m, src)
+func (m *GetReleaseJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReleaseJobsRequest.Merge(m, src)
 }
-func (m *HasPartitionRequest) XXX_Size() int {
-	return xxx_messageInfo_HasPartitionRequest.Size(m)
+func (m *GetReleaseJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReleaseJobsRequest.Size(m)
 }
-func (m *HasPartitionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_HasPartitionRequest.DiscardUnknown(m)
+func (m *GetReleaseJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReleaseJobsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HasPartitionRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetReleaseJobsRequest proto.InternalMessageInfo
 
-func (m *HasPartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *GetReleaseJobsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *HasPartitionRequest) GetDbName() string {
+func (m *GetReleaseJobsRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *HasPartitionRequest) GetCollectionName() string {
+func (m *GetReleaseJobsRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *HasPartitionRequest) GetPartitionName() string {
-	if m != nil {
-		return m.PartitionName
-	}
-	return ""
-}
-
-//
-// Load specific partitions data of one collection into query nodes
-// Then you can get these data as result when you do vector search on this collection.
-type LoadPartitionsRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition names you want to load
-	PartitionNames []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	// The replicas number you would load, 1 by default
-	ReplicaNumber        int32    `protobuf:"varint,5,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ReleaseJob struct {
+	JobID                int64           `protobuf:"varint,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	CollectionID         int64           `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	State                ReleaseJobState `protobuf:"varint,3,opt,name=state,proto3,enum=milvus.proto.milvus.ReleaseJobState" json:"state,omitempty"`
+	Reason               string          `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	StartTime            string          `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              string          `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
-func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
-func (*LoadPartitionsRequest) ProtoMessage()    {}
-func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{19}
+func (m *ReleaseJob) Reset()         { *m = ReleaseJob{} }
+func (m *ReleaseJob) String() string { return proto.CompactTextString(m) }
+func (*ReleaseJob) ProtoMessage()    {}
+func (*ReleaseJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{21}
 }
 
-func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoadPartitionsRequest.Unmarshal(m, b)
+func (m *ReleaseJob) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseJob.Unmarshal(m, b)
 }
-func (m *LoadPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoadPartitionsRequest.Marshal(b, m, deterministic)
+func (m *ReleaseJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseJob.Marshal(b, m, deterministic)
 }
-func (m *LoadPartitionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoadPartitionsRequest.Merge(m, src)
+func (m *ReleaseJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseJob.Merge(m, src)
 }
-func (m *LoadPartitionsRequest) XXX_Size() int {
-	return xxx_messageInfo_LoadPartitionsRequest.Size(m)
+func (m *ReleaseJob) XXX_Size() int {
+	return xxx_messageInfo_ReleaseJob.Size(m)
 }
-func (m *LoadPartitionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoadPartitionsRequest.DiscardUnknown(m)
+func (m *ReleaseJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseJob.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoadPartitionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReleaseJob proto.InternalMessageInfo
 
-func (m *LoadPartitionsRequest) GetBase() *commonpb.MsgBase {
+func (m *ReleaseJob) GetJobID() int64 {
 	if m != nil {
-		return m.Base
+		return m.JobID
 	}
-	return nil
+	return 0
 }
 
-func (m *LoadPartitionsRequest) GetDbName() string {
+func (m *ReleaseJob) GetCollectionID() int64 {
 	if m != nil {
-		return m.DbName
+		return m.CollectionID
 	}
-	return ""
+	return 0
 }
 
-func (m *LoadPartitionsRequest) GetCollectionName() string {
+func (m *ReleaseJob) GetState() ReleaseJobState {
 	if m != nil {
-		return m.CollectionName
+		return m.State
+	}
+	return ReleaseJobState_ReleaseJobUnknown
+}
+
+func (m *ReleaseJob) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
 	return ""
 }
 
-func (m *LoadPartitionsRequest) GetPartitionNames() []string {
+func (m *ReleaseJob) GetStartTime() string {
 	if m != nil {
-		return m.PartitionNames
+		return m.StartTime
 	}
-	return nil
+	return ""
 }
 
-func (m *LoadPartitionsRequest) GetReplicaNumber() int32 {
+func (m *ReleaseJob) GetEndTime() string {
 	if m != nil {
-		return m.ReplicaNumber
+		return m.EndTime
 	}
-	return 0
+	return ""
 }
 
-//
-// Release specific partitions data of one collection from query nodes.
-// Then you can not get these data as result when you do vector search on this collection.
-type ReleasePartitionsRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition names you want to release
-	PartitionNames       []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetReleaseJobsResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// ordered by job id
+	Jobs                 []*ReleaseJob `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsRequest{} }
-func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
-func (*ReleasePartitionsRequest) ProtoMessage()    {}
-func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{20}
+func (m *GetReleaseJobsResponse) Reset()         { *m = GetReleaseJobsResponse{} }
+func (m *GetReleaseJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReleaseJobsResponse) ProtoMessage()    {}
+func (*GetReleaseJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{22}
 }
 
-func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReleasePartitionsRequest.Unmarshal(m, b)
+func (m *GetReleaseJobsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReleaseJobsResponse.Unmarshal(m, b)
 }
-func (m *ReleasePartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReleasePartitionsRequest.Marshal(b, m, deterministic)
+func (m *GetReleaseJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReleaseJobsResponse.Marshal(b, m, deterministic)
 }
-func (m *ReleasePartitionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReleasePartitionsRequest.Merge(m, src)
+func (m *GetReleaseJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReleaseJobsResponse.Merge(m, src)
 }
-func (m *ReleasePartitionsRequest) XXX_Size() int {
-	return xxx_messageInfo_ReleasePartitionsRequest.Size(m)
+func (m *GetReleaseJobsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReleaseJobsResponse.Size(m)
 }
-func (m *ReleasePartitionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReleasePartitionsRequest.DiscardUnknown(m)
+func (m *GetReleaseJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReleaseJobsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReleasePartitionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetReleaseJobsResponse proto.InternalMessageInfo
 
-func (m *ReleasePartitionsRequest) GetBase() *commonpb.MsgBase {
+func (m *GetReleaseJobsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *ReleasePartitionsRequest) GetDbName() string {
-	if m != nil {
-		return m.DbName
-	}
-	return ""
-}
-
-func (m *ReleasePartitionsRequest) GetCollectionName() string {
-	if m != nil {
-		return m.CollectionName
-	}
-	return ""
-}
-
-func (m *ReleasePartitionsRequest) GetPartitionNames() []string {
+func (m *GetReleaseJobsResponse) GetJobs() []*ReleaseJob {
 	if m != nil {
-		return m.PartitionNames
+		return m.Jobs
 	}
 	return nil
 }
 
-//
-// Get partition statistics like row_count.
-type GetPartitionStatisticsRequest struct {
+//*
+// Get collection statistics like row_count.
+type GetCollectionStatisticsRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition name you want to collect statistics
-	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	// The collection name you want get statistics
+	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetPartitionStatisticsRequest) Reset()         { *m = GetPartitionStatisticsRequest{} }
-func (m *GetPartitionStatisticsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPartitionStatisticsRequest) ProtoMessage()    {}
-func (*GetPartitionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{21}
+func (m *GetCollectionStatisticsRequest) Reset()         { *m = GetCollectionStatisticsRequest{} }
+func (m *GetCollectionStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCollectionStatisticsRequest) ProtoMessage()    {}
+func (*GetCollectionStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{23}
 }
 
-func (m *GetPartitionStatisticsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPartitionStatisticsRequest.Unmarshal(m, b)
+func (m *GetCollectionStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCollectionStatisticsRequest.Unmarshal(m, b)
 }
-func (m *GetPartitionStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPartitionStatisticsRequest.Marshal(b, m, deterministic)
+func (m *GetCollectionStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCollectionStatisticsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetPartitionStatisticsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPartitionStatisticsRequest.Merge(m, src)
+func (m *GetCollectionStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollectionStatisticsRequest.Merge(m, src)
 }
-func (m *GetPartitionStatisticsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPartitionStatisticsRequest.Size(m)
+func (m *GetCollectionStatisticsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCollectionStatisticsRequest.Size(m)
 }
-func (m *GetPartitionStatisticsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPartitionStatisticsRequest.DiscardUnknown(m)
+func (m *GetCollectionStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollectionStatisticsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPartitionStatisticsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetCollectionStatisticsRequest proto.InternalMessageInfo
 
-func (m *GetPartitionStatisticsRequest) GetBase() *commonpb.MsgBase {
+func (m *GetCollectionStatisticsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *GetPartitionStatisticsRequest) GetDbName() string {
+func (m *GetCollectionStatisticsRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *GetPartitionStatisticsRequest) GetCollectionName() string {
+func (m *GetCollectionStatisticsRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *GetPartitionStatisticsRequest) GetPartitionName() string {
-	if m != nil {
-		return m.PartitionName
-	}
-	return ""
-}
-
-type GetPartitionStatisticsResponse struct {
-	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+//*
+// Will return collection statistics in stats field like [{key:"row_count",value:"1"}]
+type GetCollectionStatisticsResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Collection statistics data
 	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *GetPartitionStatisticsResponse) Reset()         { *m = GetPartitionStatisticsResponse{} }
-func (m *GetPartitionStatisticsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPartitionStatisticsResponse) ProtoMessage()    {}
-func (*GetPartitionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{22}
+func (m *GetCollectionStatisticsResponse) Reset()         { *m = GetCollectionStatisticsResponse{} }
+func (m *GetCollectionStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCollectionStatisticsResponse) ProtoMessage()    {}
+func (*GetCollectionStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{24}
 }
 
-func (m *GetPartitionStatisticsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPartitionStatisticsResponse.Unmarshal(m, b)
+func (m *GetCollectionStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCollectionStatisticsResponse.Unmarshal(m, b)
 }
-func (m *GetPartitionStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPartitionStatisticsResponse.Marshal(b, m, deterministic)
+func (m *GetCollectionStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCollectionStatisticsResponse.Marshal(b, m, deterministic)
 }
-func (m *GetPartitionStatisticsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPartitionStatisticsResponse.Merge(m, src)
+func (m *GetCollectionStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollectionStatisticsResponse.Merge(m, src)
 }
-func (m *GetPartitionStatisticsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPartitionStatisticsResponse.Size(m)
+func (m *GetCollectionStatisticsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCollectionStatisticsResponse.Size(m)
 }
-func (m *GetPartitionStatisticsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPartitionStatisticsResponse.DiscardUnknown(m)
+func (m *GetCollectionStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollectionStatisticsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPartitionStatisticsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetCollectionStatisticsResponse proto.InternalMessageInfo
 
-func (m *GetPartitionStatisticsResponse) GetStatus() *commonpb.Status {
+func (m *GetCollectionStatisticsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *GetPartitionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
+func (m *GetCollectionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
 	if m != nil {
 		return m.Stats
 	}
@@ -1672,4195 +1764,6938 @@ func (m *GetPartitionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
 }
 
 //
-// List all partitions for particular collection
-type ShowPartitionsRequest struct {
+// List collections
+type ShowCollectionsRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want to describe, you can pass collection_name or collectionID
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The collection id in milvus
-	CollectionID int64 `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	// When type is InMemory, will return these patitions's inMemory_percentages.(Optional)
-	PartitionNames []string `protobuf:"bytes,5,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	// Decide return Loaded partitions or All partitions(Optional)
-	Type                 ShowType `protobuf:"varint,6,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
+	// Not useful for now
+	TimeStamp uint64 `protobuf:"varint,3,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
+	// Decide return Loaded collections or All collections(Optional)
+	Type ShowType `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
+	// When type is InMemory, will return these collection's inMemory_percentages.(Optional)
+	CollectionNames      []string `protobuf:"bytes,5,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
-func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
-func (*ShowPartitionsRequest) ProtoMessage()    {}
-func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{23}
+func (m *ShowCollectionsRequest) Reset()         { *m = ShowCollectionsRequest{} }
+func (m *ShowCollectionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ShowCollectionsRequest) ProtoMessage()    {}
+func (*ShowCollectionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{25}
 }
 
-func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowPartitionsRequest.Unmarshal(m, b)
+func (m *ShowCollectionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowCollectionsRequest.Unmarshal(m, b)
 }
-func (m *ShowPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowPartitionsRequest.Marshal(b, m, deterministic)
+func (m *ShowCollectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowCollectionsRequest.Marshal(b, m, deterministic)
 }
-func (m *ShowPartitionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowPartitionsRequest.Merge(m, src)
+func (m *ShowCollectionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowCollectionsRequest.Merge(m, src)
 }
-func (m *ShowPartitionsRequest) XXX_Size() int {
-	return xxx_messageInfo_ShowPartitionsRequest.Size(m)
+func (m *ShowCollectionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ShowCollectionsRequest.Size(m)
 }
-func (m *ShowPartitionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowPartitionsRequest.DiscardUnknown(m)
+func (m *ShowCollectionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowCollectionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowPartitionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ShowCollectionsRequest proto.InternalMessageInfo
 
-func (m *ShowPartitionsRequest) GetBase() *commonpb.MsgBase {
+func (m *ShowCollectionsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *ShowPartitionsRequest) GetDbName() string {
+func (m *ShowCollectionsRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *ShowPartitionsRequest) GetCollectionName() string {
-	if m != nil {
-		return m.CollectionName
-	}
-	return ""
-}
-
-func (m *ShowPartitionsRequest) GetCollectionID() int64 {
+func (m *ShowCollectionsRequest) GetTimeStamp() uint64 {
 	if m != nil {
-		return m.CollectionID
+		return m.TimeStamp
 	}
 	return 0
 }
 
-func (m *ShowPartitionsRequest) GetPartitionNames() []string {
+func (m *ShowCollectionsRequest) GetType() ShowType {
 	if m != nil {
-		return m.PartitionNames
+		return m.Type
 	}
-	return nil
+	return ShowType_All
 }
 
-func (m *ShowPartitionsRequest) GetType() ShowType {
+func (m *ShowCollectionsRequest) GetCollectionNames() []string {
 	if m != nil {
-		return m.Type
+		return m.CollectionNames
 	}
-	return ShowType_All
+	return nil
 }
 
 //
-// List all partitions for particular collection response.
-// The returned datas are all rows, we can format to columns by therir index.
-type ShowPartitionsResponse struct {
+// Return basic collection infos.
+type ShowCollectionsResponse struct {
 	// Contain error_code and reason
 	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// All partition names for this collection
-	PartitionNames []string `protobuf:"bytes,2,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	// All partition ids for this collection
-	PartitionIDs []int64 `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
-	// All hybrid timestamps
+	// Collection name array
+	CollectionNames []string `protobuf:"bytes,2,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	// Collection Id array
+	CollectionIds []int64 `protobuf:"varint,3,rep,packed,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"`
+	// Hybrid timestamps in milvus
 	CreatedTimestamps []uint64 `protobuf:"varint,4,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
-	// All utc timestamps calculated by created_timestamps
+	// The utc timestamp calculated by created_timestamp
 	CreatedUtcTimestamps []uint64 `protobuf:"varint,5,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
-	// Load percentage on querynode
+	// Load percentage on querynode when type is InMemory
 	InMemoryPercentages  []int64  `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{} }
-func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
-func (*ShowPartitionsResponse) ProtoMessage()    {}
-func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{24}
+func (m *ShowCollectionsResponse) Reset()         { *m = ShowCollectionsResponse{} }
+func (m *ShowCollectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ShowCollectionsResponse) ProtoMessage()    {}
+func (*ShowCollectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{26}
 }
 
-func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowPartitionsResponse.Unmarshal(m, b)
+func (m *ShowCollectionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowCollectionsResponse.Unmarshal(m, b)
 }
-func (m *ShowPartitionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowPartitionsResponse.Marshal(b, m, deterministic)
+func (m *ShowCollectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowCollectionsResponse.Marshal(b, m, deterministic)
 }
-func (m *ShowPartitionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowPartitionsResponse.Merge(m, src)
+func (m *ShowCollectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowCollectionsResponse.Merge(m, src)
 }
-func (m *ShowPartitionsResponse) XXX_Size() int {
-	return xxx_messageInfo_ShowPartitionsResponse.Size(m)
+func (m *ShowCollectionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ShowCollectionsResponse.Size(m)
 }
-func (m *ShowPartitionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowPartitionsResponse.DiscardUnknown(m)
+func (m *ShowCollectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowCollectionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowPartitionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ShowCollectionsResponse proto.InternalMessageInfo
 
-func (m *ShowPartitionsResponse) GetStatus() *commonpb.Status {
+func (m *ShowCollectionsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *ShowPartitionsResponse) GetPartitionNames() []string {
+func (m *ShowCollectionsResponse) GetCollectionNames() []string {
 	if m != nil {
-		return m.PartitionNames
+		return m.CollectionNames
 	}
 	return nil
 }
 
-func (m *ShowPartitionsResponse) GetPartitionIDs() []int64 {
+func (m *ShowCollectionsResponse) GetCollectionIds() []int64 {
 	if m != nil {
-		return m.PartitionIDs
+		return m.CollectionIds
 	}
 	return nil
 }
 
-func (m *ShowPartitionsResponse) GetCreatedTimestamps() []uint64 {
+func (m *ShowCollectionsResponse) GetCreatedTimestamps() []uint64 {
 	if m != nil {
 		return m.CreatedTimestamps
 	}
 	return nil
 }
 
-func (m *ShowPartitionsResponse) GetCreatedUtcTimestamps() []uint64 {
+func (m *ShowCollectionsResponse) GetCreatedUtcTimestamps() []uint64 {
 	if m != nil {
 		return m.CreatedUtcTimestamps
 	}
 	return nil
 }
 
-func (m *ShowPartitionsResponse) GetInMemoryPercentages() []int64 {
+func (m *ShowCollectionsResponse) GetInMemoryPercentages() []int64 {
 	if m != nil {
 		return m.InMemoryPercentages
 	}
 	return nil
 }
 
-type DescribeSegmentRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	SegmentID            int64             `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+//
+// Create partition in created collection.
+type CreatePartitionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The partition name you want to create.
+	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DescribeSegmentRequest) Reset()         { *m = DescribeSegmentRequest{} }
-func (m *DescribeSegmentRequest) String() string { return proto.CompactTextString(m) }
-func (*DescribeSegmentRequest) ProtoMessage()    {}
-func (*DescribeSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{25}
+func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{} }
+func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePartitionRequest) ProtoMessage()    {}
+func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{27}
 }
 
-func (m *DescribeSegmentRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeSegmentRequest.Unmarshal(m, b)
+func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePartitionRequest.Unmarshal(m, b)
 }
-func (m *DescribeSegmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeSegmentRequest.Marshal(b, m, deterministic)
+func (m *CreatePartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePartitionRequest.Marshal(b, m, deterministic)
 }
-func (m *DescribeSegmentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeSegmentRequest.Merge(m, src)
+func (m *CreatePartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePartitionRequest.Merge(m, src)
 }
-func (m *DescribeSegmentRequest) XXX_Size() int {
-	return xxx_messageInfo_DescribeSegmentRequest.Size(m)
+func (m *CreatePartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreatePartitionRequest.Size(m)
 }
-func (m *DescribeSegmentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeSegmentRequest.DiscardUnknown(m)
+func (m *CreatePartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePartitionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeSegmentRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreatePartitionRequest proto.InternalMessageInfo
 
-func (m *DescribeSegmentRequest) GetBase() *commonpb.MsgBase {
+func (m *CreatePartitionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DescribeSegmentRequest) GetCollectionID() int64 {
+func (m *CreatePartitionRequest) GetDbName() string {
 	if m != nil {
-		return m.CollectionID
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-func (m *DescribeSegmentRequest) GetSegmentID() int64 {
+func (m *CreatePartitionRequest) GetCollectionName() string {
 	if m != nil {
-		return m.SegmentID
+		return m.CollectionName
 	}
-	return 0
+	return ""
 }
 
-type DescribeSegmentResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	IndexID              int64            `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	BuildID              int64            `protobuf:"varint,3,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	EnableIndex          bool             `protobuf:"varint,4,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
-	FieldID              int64            `protobuf:"varint,5,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *CreatePartitionRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
 }
 
-func (m *DescribeSegmentResponse) Reset()         { *m = DescribeSegmentResponse{} }
-func (m *DescribeSegmentResponse) String() string { return proto.CompactTextString(m) }
-func (*DescribeSegmentResponse) ProtoMessage()    {}
-func (*DescribeSegmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{26}
+//
+// Drop partition in created collection.
+type DropPartitionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The partition name you want to drop
+	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DescribeSegmentResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeSegmentResponse.Unmarshal(m, b)
-}
-func (m *DescribeSegmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeSegmentResponse.Marshal(b, m, deterministic)
+func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
+func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*DropPartitionRequest) ProtoMessage()    {}
+func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{28}
 }
-func (m *DescribeSegmentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeSegmentResponse.Merge(m, src)
+
+func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropPartitionRequest.Unmarshal(m, b)
 }
-func (m *DescribeSegmentResponse) XXX_Size() int {
-	return xxx_messageInfo_DescribeSegmentResponse.Size(m)
+func (m *DropPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropPartitionRequest.Marshal(b, m, deterministic)
 }
-func (m *DescribeSegmentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeSegmentResponse.DiscardUnknown(m)
+func (m *DropPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropPartitionRequest.Merge(m, src)
+}
+func (m *DropPartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_DropPartitionRequest.Size(m)
+}
+func (m *DropPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropPartitionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeSegmentResponse proto.InternalMessageInfo
+var xxx_messageInfo_DropPartitionRequest proto.InternalMessageInfo
 
-func (m *DescribeSegmentResponse) GetStatus() *commonpb.Status {
+func (m *DropPartitionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *DescribeSegmentResponse) GetIndexID() int64 {
-	if m != nil {
-		return m.IndexID
-	}
-	return 0
-}
-
-func (m *DescribeSegmentResponse) GetBuildID() int64 {
+func (m *DropPartitionRequest) GetDbName() string {
 	if m != nil {
-		return m.BuildID
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-func (m *DescribeSegmentResponse) GetEnableIndex() bool {
+func (m *DropPartitionRequest) GetCollectionName() string {
 	if m != nil {
-		return m.EnableIndex
+		return m.CollectionName
 	}
-	return false
+	return ""
 }
 
-func (m *DescribeSegmentResponse) GetFieldID() int64 {
+func (m *DropPartitionRequest) GetPartitionName() string {
 	if m != nil {
-		return m.FieldID
+		return m.PartitionName
 	}
-	return 0
+	return ""
 }
 
-type ShowSegmentsRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+//
+// Check if partition exist in collection or not.
+type HasPartitionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The partition name you want to check
+	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShowSegmentsRequest) Reset()         { *m = ShowSegmentsRequest{} }
-func (m *ShowSegmentsRequest) String() string { return proto.CompactTextString(m) }
-func (*ShowSegmentsRequest) ProtoMessage()    {}
-func (*ShowSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{27}
+func (m *HasPartitionRequest) Reset()         { *m = HasPartitionRequest{} }
+func (m *HasPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*HasPartitionRequest) ProtoMessage()    {}
+func (*HasPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{29}
 }
 
-func (m *ShowSegmentsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowSegmentsRequest.Unmarshal(m, b)
+func (m *HasPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HasPartitionRequest.Unmarshal(m, b)
 }
-func (m *ShowSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowSegmentsRequest.Marshal(b, m, deterministic)
+func (m *HasPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HasPartitionRequest.Marshal(b, m, deterministic)
 }
-func (m *ShowSegmentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowSegmentsRequest.Merge(m, src)
+func (m *HasPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasPartitionRequest.Merge(m, src)
 }
-func (m *ShowSegmentsRequest) XXX_Size() int {
-	return xxx_messageInfo_ShowSegmentsRequest.Size(m)
+func (m *HasPartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_HasPartitionRequest.Size(m)
 }
-func (m *ShowSegmentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowSegmentsRequest.DiscardUnknown(m)
+func (m *HasPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasPartitionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowSegmentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_HasPartitionRequest proto.InternalMessageInfo
 
-func (m *ShowSegmentsRequest) GetBase() *commonpb.MsgBase {
+func (m *HasPartitionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *ShowSegmentsRequest) GetCollectionID() int64 {
-	if m != nil {
-		return m.CollectionID
-	}
-	return 0
-}
-
-func (m *ShowSegmentsRequest) GetPartitionID() int64 {
+func (m *HasPartitionRequest) GetDbName() string {
 	if m != nil {
-		return m.PartitionID
+		return m.DbName
 	}
-	return 0
-}
-
-type ShowSegmentsResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	SegmentIDs           []int64          `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
-}
-
-func (m *ShowSegmentsResponse) Reset()         { *m = ShowSegmentsResponse{} }
-func (m *ShowSegmentsResponse) String() string { return proto.CompactTextString(m) }
-func (*ShowSegmentsResponse) ProtoMessage()    {}
-func (*ShowSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{28}
-}
-
-func (m *ShowSegmentsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowSegmentsResponse.Unmarshal(m, b)
-}
-func (m *ShowSegmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowSegmentsResponse.Marshal(b, m, deterministic)
-}
-func (m *ShowSegmentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowSegmentsResponse.Merge(m, src)
-}
-func (m *ShowSegmentsResponse) XXX_Size() int {
-	return xxx_messageInfo_ShowSegmentsResponse.Size(m)
-}
-func (m *ShowSegmentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowSegmentsResponse.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_ShowSegmentsResponse proto.InternalMessageInfo
-
-func (m *ShowSegmentsResponse) GetStatus() *commonpb.Status {
+func (m *HasPartitionRequest) GetCollectionName() string {
 	if m != nil {
-		return m.Status
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-func (m *ShowSegmentsResponse) GetSegmentIDs() []int64 {
+func (m *HasPartitionRequest) GetPartitionName() string {
 	if m != nil {
-		return m.SegmentIDs
+		return m.PartitionName
 	}
-	return nil
+	return ""
 }
 
 //
-// Create index for vector datas
-type CreateIndexRequest struct {
+// Load specific partitions data of one collection into query nodes
+// Then you can get these data as result when you do vector search on this collection.
+type LoadPartitionsRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The particular collection name you want to create index.
+	// The collection name in milvus
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The vector field name in this particular collection
-	FieldName string `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	// Support keys: index_type,metric_type, params. Different index_type may has different params.
-	ExtraParams []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=extra_params,json=extraParams,proto3" json:"extra_params,omitempty"`
-	// Version before 2.0.2 doesn't contain index_name, we use default index name.
-	IndexName            string   `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	// The partition names you want to load
+	PartitionNames []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// The replicas number you would load, 1 by default
+	ReplicaNumber int32 `protobuf:"varint,5,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	// The names of the fields to load, all the fields are loaded if empty
+	LoadFields           []string `protobuf:"bytes,6,rep,name=load_fields,json=loadFields,proto3" json:"load_fields,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
-func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateIndexRequest) ProtoMessage()    {}
-func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{29}
+func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
+func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadPartitionsRequest) ProtoMessage()    {}
+func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{30}
 }
 
-func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateIndexRequest.Unmarshal(m, b)
+func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadPartitionsRequest.Unmarshal(m, b)
 }
-func (m *CreateIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateIndexRequest.Marshal(b, m, deterministic)
+func (m *LoadPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadPartitionsRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateIndexRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateIndexRequest.Merge(m, src)
+func (m *LoadPartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadPartitionsRequest.Merge(m, src)
 }
-func (m *CreateIndexRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateIndexRequest.Size(m)
+func (m *LoadPartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadPartitionsRequest.Size(m)
 }
-func (m *CreateIndexRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateIndexRequest.DiscardUnknown(m)
+func (m *LoadPartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadPartitionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateIndexRequest proto.InternalMessageInfo
+var xxx_messageInfo_LoadPartitionsRequest proto.InternalMessageInfo
 
-func (m *CreateIndexRequest) GetBase() *commonpb.MsgBase {
+func (m *LoadPartitionsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *CreateIndexRequest) GetDbName() string {
+func (m *LoadPartitionsRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *CreateIndexRequest) GetCollectionName() string {
+func (m *LoadPartitionsRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *CreateIndexRequest) GetFieldName() string {
+func (m *LoadPartitionsRequest) GetPartitionNames() []string {
 	if m != nil {
-		return m.FieldName
+		return m.PartitionNames
 	}
-	return ""
+	return nil
 }
 
-func (m *CreateIndexRequest) GetExtraParams() []*commonpb.KeyValuePair {
+func (m *LoadPartitionsRequest) GetReplicaNumber() int32 {
 	if m != nil {
-		return m.ExtraParams
+		return m.ReplicaNumber
 	}
-	return nil
+	return 0
 }
 
-func (m *CreateIndexRequest) GetIndexName() string {
+func (m *LoadPartitionsRequest) GetLoadFields() []string {
 	if m != nil {
-		return m.IndexName
+		return m.LoadFields
 	}
-	return ""
+	return nil
 }
 
 //
-// Get created index information.
-// Current release of Milvus only supports showing latest built index.
-type DescribeIndexRequest struct {
+// Release specific partitions data of one collection from query nodes.
+// Then you can not get these data as result when you do vector search on this collection.
+type ReleasePartitionsRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The particular collection name in Milvus
+	// The collection name in milvus
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The vector field name in this particular collection
-	FieldName string `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	// No need to set up for now @2021.06.30
-	IndexName            string   `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	// The partition names you want to release
+	PartitionNames       []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DescribeIndexRequest) Reset()         { *m = DescribeIndexRequest{} }
-func (m *DescribeIndexRequest) String() string { return proto.CompactTextString(m) }
-func (*DescribeIndexRequest) ProtoMessage()    {}
-func (*DescribeIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{30}
+func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsRequest{} }
+func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleasePartitionsRequest) ProtoMessage()    {}
+func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{31}
 }
 
-func (m *DescribeIndexRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeIndexRequest.Unmarshal(m, b)
+func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleasePartitionsRequest.Unmarshal(m, b)
 }
-func (m *DescribeIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeIndexRequest.Marshal(b, m, deterministic)
+func (m *ReleasePartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleasePartitionsRequest.Marshal(b, m, deterministic)
 }
-func (m *DescribeIndexRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeIndexRequest.Merge(m, src)
+func (m *ReleasePartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleasePartitionsRequest.Merge(m, src)
 }
-func (m *DescribeIndexRequest) XXX_Size() int {
-	return xxx_messageInfo_DescribeIndexRequest.Size(m)
+func (m *ReleasePartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleasePartitionsRequest.Size(m)
 }
-func (m *DescribeIndexRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeIndexRequest.DiscardUnknown(m)
+func (m *ReleasePartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleasePartitionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeIndexRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReleasePartitionsRequest proto.InternalMessageInfo
 
-func (m *DescribeIndexRequest) GetBase() *commonpb.MsgBase {
+func (m *ReleasePartitionsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DescribeIndexRequest) GetDbName() string {
+func (m *ReleasePartitionsRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *DescribeIndexRequest) GetCollectionName() string {
+func (m *ReleasePartitionsRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *DescribeIndexRequest) GetFieldName() string {
-	if m != nil {
-		return m.FieldName
-	}
-	return ""
-}
-
-func (m *DescribeIndexRequest) GetIndexName() string {
+func (m *ReleasePartitionsRequest) GetPartitionNames() []string {
 	if m != nil {
-		return m.IndexName
+		return m.PartitionNames
 	}
-	return ""
+	return nil
 }
 
 //
-// Index informations
-type IndexDescription struct {
-	// Index name
-	IndexName string `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	// Index id
-	IndexID int64 `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	// Will return index_type, metric_type, params(like nlist).
-	Params []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
-	// The vector field name
-	FieldName            string   `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+// Get partition statistics like row_count.
+type GetPartitionStatisticsRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The partition name you want to collect statistics
+	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *IndexDescription) Reset()         { *m = IndexDescription{} }
-func (m *IndexDescription) String() string { return proto.CompactTextString(m) }
-func (*IndexDescription) ProtoMessage()    {}
-func (*IndexDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{31}
+func (m *GetPartitionStatisticsRequest) Reset()         { *m = GetPartitionStatisticsRequest{} }
+func (m *GetPartitionStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPartitionStatisticsRequest) ProtoMessage()    {}
+func (*GetPartitionStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{32}
 }
 
-func (m *IndexDescription) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IndexDescription.Unmarshal(m, b)
+func (m *GetPartitionStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPartitionStatisticsRequest.Unmarshal(m, b)
 }
-func (m *IndexDescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IndexDescription.Marshal(b, m, deterministic)
+func (m *GetPartitionStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPartitionStatisticsRequest.Marshal(b, m, deterministic)
 }
-func (m *IndexDescription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IndexDescription.Merge(m, src)
+func (m *GetPartitionStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPartitionStatisticsRequest.Merge(m, src)
 }
-func (m *IndexDescription) XXX_Size() int {
-	return xxx_messageInfo_IndexDescription.Size(m)
+func (m *GetPartitionStatisticsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPartitionStatisticsRequest.Size(m)
 }
-func (m *IndexDescription) XXX_DiscardUnknown() {
-	xxx_messageInfo_IndexDescription.DiscardUnknown(m)
+func (m *GetPartitionStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPartitionStatisticsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IndexDescription proto.InternalMessageInfo
+var xxx_messageInfo_GetPartitionStatisticsRequest proto.InternalMessageInfo
 
-func (m *IndexDescription) GetIndexName() string {
+func (m *GetPartitionStatisticsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.IndexName
+		return m.Base
 	}
-	return ""
+	return nil
 }
 
-func (m *IndexDescription) GetIndexID() int64 {
+func (m *GetPartitionStatisticsRequest) GetDbName() string {
 	if m != nil {
-		return m.IndexID
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-func (m *IndexDescription) GetParams() []*commonpb.KeyValuePair {
+func (m *GetPartitionStatisticsRequest) GetCollectionName() string {
 	if m != nil {
-		return m.Params
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-func (m *IndexDescription) GetFieldName() string {
+func (m *GetPartitionStatisticsRequest) GetPartitionName() string {
 	if m != nil {
-		return m.FieldName
+		return m.PartitionName
 	}
 	return ""
 }
 
-//
-// Describe index response
-type DescribeIndexResponse struct {
-	// Response status
-	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// All index informations, for now only return tha latest index you created for the collection.
-	IndexDescriptions    []*IndexDescription `protobuf:"bytes,2,rep,name=index_descriptions,json=indexDescriptions,proto3" json:"index_descriptions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type GetPartitionStatisticsResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *DescribeIndexResponse) Reset()         { *m = DescribeIndexResponse{} }
-func (m *DescribeIndexResponse) String() string { return proto.CompactTextString(m) }
-func (*DescribeIndexResponse) ProtoMessage()    {}
-func (*DescribeIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{32}
+func (m *GetPartitionStatisticsResponse) Reset()         { *m = GetPartitionStatisticsResponse{} }
+func (m *GetPartitionStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPartitionStatisticsResponse) ProtoMessage()    {}
+func (*GetPartitionStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{33}
 }
 
-func (m *DescribeIndexResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeIndexResponse.Unmarshal(m, b)
+func (m *GetPartitionStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPartitionStatisticsResponse.Unmarshal(m, b)
 }
-func (m *DescribeIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeIndexResponse.Marshal(b, m, deterministic)
+func (m *GetPartitionStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPartitionStatisticsResponse.Marshal(b, m, deterministic)
 }
-func (m *DescribeIndexResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeIndexResponse.Merge(m, src)
+func (m *GetPartitionStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPartitionStatisticsResponse.Merge(m, src)
 }
-func (m *DescribeIndexResponse) XXX_Size() int {
-	return xxx_messageInfo_DescribeIndexResponse.Size(m)
+func (m *GetPartitionStatisticsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPartitionStatisticsResponse.Size(m)
 }
-func (m *DescribeIndexResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeIndexResponse.DiscardUnknown(m)
+func (m *GetPartitionStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPartitionStatisticsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeIndexResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetPartitionStatisticsResponse proto.InternalMessageInfo
 
-func (m *DescribeIndexResponse) GetStatus() *commonpb.Status {
+func (m *GetPartitionStatisticsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *DescribeIndexResponse) GetIndexDescriptions() []*IndexDescription {
+func (m *GetPartitionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.IndexDescriptions
+		return m.Stats
 	}
 	return nil
 }
 
 //
-//  Get index building progress
-type GetIndexBuildProgressRequest struct {
+// List all partitions for particular collection
+type ShowPartitionsRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
+	// The collection name you want to describe, you can pass collection_name or collectionID
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The vector field name in this collection
-	FieldName string `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	// Not useful for now
-	IndexName            string   `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	// The collection id in milvus
+	CollectionID int64 `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// When type is InMemory, will return these patitions's inMemory_percentages.(Optional)
+	PartitionNames []string `protobuf:"bytes,5,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// Decide return Loaded partitions or All partitions(Optional)
+	Type                 ShowType `protobuf:"varint,6,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetIndexBuildProgressRequest) Reset()         { *m = GetIndexBuildProgressRequest{} }
-func (m *GetIndexBuildProgressRequest) String() string { return proto.CompactTextString(m) }
-func (*GetIndexBuildProgressRequest) ProtoMessage()    {}
-func (*GetIndexBuildProgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{33}
+func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
+func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ShowPartitionsRequest) ProtoMessage()    {}
+func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{34}
 }
 
-func (m *GetIndexBuildProgressRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetIndexBuildProgressRequest.Unmarshal(m, b)
+func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowPartitionsRequest.Unmarshal(m, b)
 }
-func (m *GetIndexBuildProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetIndexBuildProgressRequest.Marshal(b, m, deterministic)
+func (m *ShowPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowPartitionsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetIndexBuildProgressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetIndexBuildProgressRequest.Merge(m, src)
+func (m *ShowPartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowPartitionsRequest.Merge(m, src)
 }
-func (m *GetIndexBuildProgressRequest) XXX_Size() int {
-	return xxx_messageInfo_GetIndexBuildProgressRequest.Size(m)
+func (m *ShowPartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ShowPartitionsRequest.Size(m)
 }
-func (m *GetIndexBuildProgressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetIndexBuildProgressRequest.DiscardUnknown(m)
+func (m *ShowPartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowPartitionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetIndexBuildProgressRequest proto.InternalMessageInfo
+var xxx_messageInfo_ShowPartitionsRequest proto.InternalMessageInfo
 
-func (m *GetIndexBuildProgressRequest) GetBase() *commonpb.MsgBase {
+func (m *ShowPartitionsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *GetIndexBuildProgressRequest) GetDbName() string {
+func (m *ShowPartitionsRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *GetIndexBuildProgressRequest) GetCollectionName() string {
+func (m *ShowPartitionsRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *GetIndexBuildProgressRequest) GetFieldName() string {
+func (m *ShowPartitionsRequest) GetCollectionID() int64 {
 	if m != nil {
-		return m.FieldName
+		return m.CollectionID
 	}
-	return ""
+	return 0
 }
 
-func (m *GetIndexBuildProgressRequest) GetIndexName() string {
+func (m *ShowPartitionsRequest) GetPartitionNames() []string {
 	if m != nil {
-		return m.IndexName
+		return m.PartitionNames
 	}
-	return ""
-}
-
-type GetIndexBuildProgressResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	IndexedRows          int64            `protobuf:"varint,2,opt,name=indexed_rows,json=indexedRows,proto3" json:"indexed_rows,omitempty"`
-	TotalRows            int64            `protobuf:"varint,3,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	return nil
 }
 
-func (m *GetIndexBuildProgressResponse) Reset()         { *m = GetIndexBuildProgressResponse{} }
-func (m *GetIndexBuildProgressResponse) String() string { return proto.CompactTextString(m) }
-func (*GetIndexBuildProgressResponse) ProtoMessage()    {}
-func (*GetIndexBuildProgressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{34}
+func (m *ShowPartitionsRequest) GetType() ShowType {
+	if m != nil {
+		return m.Type
+	}
+	return ShowType_All
 }
 
-func (m *GetIndexBuildProgressResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetIndexBuildProgressResponse.Unmarshal(m, b)
+//
+// List all partitions for particular collection response.
+// The returned datas are all rows, we can format to columns by therir index.
+type ShowPartitionsResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// All partition names for this collection
+	PartitionNames []string `protobuf:"bytes,2,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// All partition ids for this collection
+	PartitionIDs []int64 `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	// All hybrid timestamps
+	CreatedTimestamps []uint64 `protobuf:"varint,4,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
+	// All utc timestamps calculated by created_timestamps
+	CreatedUtcTimestamps []uint64 `protobuf:"varint,5,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
+	// Load percentage on querynode
+	InMemoryPercentages  []int64  `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *GetIndexBuildProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetIndexBuildProgressResponse.Marshal(b, m, deterministic)
+
+func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{} }
+func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ShowPartitionsResponse) ProtoMessage()    {}
+func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{35}
 }
-func (m *GetIndexBuildProgressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetIndexBuildProgressResponse.Merge(m, src)
+
+func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowPartitionsResponse.Unmarshal(m, b)
 }
-func (m *GetIndexBuildProgressResponse) XXX_Size() int {
-	return xxx_messageInfo_GetIndexBuildProgressResponse.Size(m)
+func (m *ShowPartitionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowPartitionsResponse.Marshal(b, m, deterministic)
 }
-func (m *GetIndexBuildProgressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetIndexBuildProgressResponse.DiscardUnknown(m)
+func (m *ShowPartitionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowPartitionsResponse.Merge(m, src)
+}
+func (m *ShowPartitionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ShowPartitionsResponse.Size(m)
+}
+func (m *ShowPartitionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowPartitionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetIndexBuildProgressResponse proto.InternalMessageInfo
+var xxx_messageInfo_ShowPartitionsResponse proto.InternalMessageInfo
 
-func (m *GetIndexBuildProgressResponse) GetStatus() *commonpb.Status {
+func (m *ShowPartitionsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *GetIndexBuildProgressResponse) GetIndexedRows() int64 {
-	if m != nil {
-		return m.IndexedRows
-	}
-	return 0
-}
-
-func (m *GetIndexBuildProgressResponse) GetTotalRows() int64 {
-	if m != nil {
-		return m.TotalRows
-	}
-	return 0
-}
-
-type GetIndexStateRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
-}
-
-func (m *GetIndexStateRequest) Reset()         { *m = GetIndexStateRequest{} }
-func (m *GetIndexStateRequest) String() string { return proto.CompactTextString(m) }
-func (*GetIndexStateRequest) ProtoMessage()    {}
-func (*GetIndexStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{35}
-}
-
-func (m *GetIndexStateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetIndexStateRequest.Unmarshal(m, b)
-}
-func (m *GetIndexStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetIndexStateRequest.Marshal(b, m, deterministic)
-}
-func (m *GetIndexStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetIndexStateRequest.Merge(m, src)
-}
-func (m *GetIndexStateRequest) XXX_Size() int {
-	return xxx_messageInfo_GetIndexStateRequest.Size(m)
-}
-func (m *GetIndexStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetIndexStateRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetIndexStateRequest proto.InternalMessageInfo
-
-func (m *GetIndexStateRequest) GetBase() *commonpb.MsgBase {
+func (m *ShowPartitionsResponse) GetPartitionNames() []string {
 	if m != nil {
-		return m.Base
+		return m.PartitionNames
 	}
 	return nil
 }
 
-func (m *GetIndexStateRequest) GetDbName() string {
+func (m *ShowPartitionsResponse) GetPartitionIDs() []int64 {
 	if m != nil {
-		return m.DbName
+		return m.PartitionIDs
 	}
-	return ""
+	return nil
 }
 
-func (m *GetIndexStateRequest) GetCollectionName() string {
+func (m *ShowPartitionsResponse) GetCreatedTimestamps() []uint64 {
 	if m != nil {
-		return m.CollectionName
+		return m.CreatedTimestamps
 	}
-	return ""
+	return nil
 }
 
-func (m *GetIndexStateRequest) GetFieldName() string {
+func (m *ShowPartitionsResponse) GetCreatedUtcTimestamps() []uint64 {
 	if m != nil {
-		return m.FieldName
+		return m.CreatedUtcTimestamps
 	}
-	return ""
+	return nil
 }
 
-func (m *GetIndexStateRequest) GetIndexName() string {
+func (m *ShowPartitionsResponse) GetInMemoryPercentages() []int64 {
 	if m != nil {
-		return m.IndexName
+		return m.InMemoryPercentages
 	}
-	return ""
+	return nil
 }
 
-type GetIndexStateResponse struct {
-	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	State                commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
-	FailReason           string              `protobuf:"bytes,3,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type DescribeSegmentRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	SegmentID            int64             `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetIndexStateResponse) Reset()         { *m = GetIndexStateResponse{} }
-func (m *GetIndexStateResponse) String() string { return proto.CompactTextString(m) }
-func (*GetIndexStateResponse) ProtoMessage()    {}
-func (*GetIndexStateResponse) Descriptor() ([]byte, []int) {
+func (m *DescribeSegmentRequest) Reset()         { *m = DescribeSegmentRequest{} }
+func (m *DescribeSegmentRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeSegmentRequest) ProtoMessage()    {}
+func (*DescribeSegmentRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{36}
 }
 
-func (m *GetIndexStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetIndexStateResponse.Unmarshal(m, b)
+func (m *DescribeSegmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeSegmentRequest.Unmarshal(m, b)
 }
-func (m *GetIndexStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetIndexStateResponse.Marshal(b, m, deterministic)
+func (m *DescribeSegmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeSegmentRequest.Marshal(b, m, deterministic)
 }
-func (m *GetIndexStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetIndexStateResponse.Merge(m, src)
+func (m *DescribeSegmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeSegmentRequest.Merge(m, src)
 }
-func (m *GetIndexStateResponse) XXX_Size() int {
-	return xxx_messageInfo_GetIndexStateResponse.Size(m)
+func (m *DescribeSegmentRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeSegmentRequest.Size(m)
 }
-func (m *GetIndexStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetIndexStateResponse.DiscardUnknown(m)
+func (m *DescribeSegmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeSegmentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetIndexStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_DescribeSegmentRequest proto.InternalMessageInfo
 
-func (m *GetIndexStateResponse) GetStatus() *commonpb.Status {
+func (m *DescribeSegmentRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *GetIndexStateResponse) GetState() commonpb.IndexState {
+func (m *DescribeSegmentRequest) GetCollectionID() int64 {
 	if m != nil {
-		return m.State
+		return m.CollectionID
 	}
-	return commonpb.IndexState_IndexStateNone
+	return 0
 }
 
-func (m *GetIndexStateResponse) GetFailReason() string {
+func (m *DescribeSegmentRequest) GetSegmentID() int64 {
 	if m != nil {
-		return m.FailReason
+		return m.SegmentID
 	}
-	return ""
+	return 0
 }
 
-type DropIndexRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type DescribeSegmentResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IndexID              int64            `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	BuildID              int64            `protobuf:"varint,3,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	EnableIndex          bool             `protobuf:"varint,4,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
+	FieldID              int64            `protobuf:"varint,5,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
-func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
-func (*DropIndexRequest) ProtoMessage()    {}
-func (*DropIndexRequest) Descriptor() ([]byte, []int) {
+func (m *DescribeSegmentResponse) Reset()         { *m = DescribeSegmentResponse{} }
+func (m *DescribeSegmentResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeSegmentResponse) ProtoMessage()    {}
+func (*DescribeSegmentResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{37}
 }
 
-func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropIndexRequest.Unmarshal(m, b)
+func (m *DescribeSegmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeSegmentResponse.Unmarshal(m, b)
 }
-func (m *DropIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropIndexRequest.Marshal(b, m, deterministic)
+func (m *DescribeSegmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeSegmentResponse.Marshal(b, m, deterministic)
 }
-func (m *DropIndexRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropIndexRequest.Merge(m, src)
+func (m *DescribeSegmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeSegmentResponse.Merge(m, src)
 }
-func (m *DropIndexRequest) XXX_Size() int {
-	return xxx_messageInfo_DropIndexRequest.Size(m)
+func (m *DescribeSegmentResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeSegmentResponse.Size(m)
 }
-func (m *DropIndexRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropIndexRequest.DiscardUnknown(m)
+func (m *DescribeSegmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeSegmentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropIndexRequest proto.InternalMessageInfo
+var xxx_messageInfo_DescribeSegmentResponse proto.InternalMessageInfo
 
-func (m *DropIndexRequest) GetBase() *commonpb.MsgBase {
+func (m *DescribeSegmentResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *DropIndexRequest) GetDbName() string {
+func (m *DescribeSegmentResponse) GetIndexID() int64 {
 	if m != nil {
-		return m.DbName
+		return m.IndexID
 	}
-	return ""
+	return 0
 }
 
-func (m *DropIndexRequest) GetCollectionName() string {
+func (m *DescribeSegmentResponse) GetBuildID() int64 {
 	if m != nil {
-		return m.CollectionName
+		return m.BuildID
 	}
-	return ""
+	return 0
 }
 
-func (m *DropIndexRequest) GetFieldName() string {
+func (m *DescribeSegmentResponse) GetEnableIndex() bool {
 	if m != nil {
-		return m.FieldName
+		return m.EnableIndex
 	}
-	return ""
+	return false
 }
 
-func (m *DropIndexRequest) GetIndexName() string {
+func (m *DescribeSegmentResponse) GetFieldID() int64 {
 	if m != nil {
-		return m.IndexName
+		return m.FieldID
 	}
-	return ""
+	return 0
 }
 
-type InsertRequest struct {
-	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	FieldsData           []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
-	HashKeys             []uint32              `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
-	NumRows              uint32                `protobuf:"varint,7,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type ShowSegmentsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
-func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
-func (*InsertRequest) ProtoMessage()    {}
-func (*InsertRequest) Descriptor() ([]byte, []int) {
+func (m *ShowSegmentsRequest) Reset()         { *m = ShowSegmentsRequest{} }
+func (m *ShowSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ShowSegmentsRequest) ProtoMessage()    {}
+func (*ShowSegmentsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{38}
 }
 
-func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InsertRequest.Unmarshal(m, b)
+func (m *ShowSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowSegmentsRequest.Unmarshal(m, b)
 }
-func (m *InsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InsertRequest.Marshal(b, m, deterministic)
+func (m *ShowSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowSegmentsRequest.Marshal(b, m, deterministic)
 }
-func (m *InsertRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertRequest.Merge(m, src)
+func (m *ShowSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowSegmentsRequest.Merge(m, src)
 }
-func (m *InsertRequest) XXX_Size() int {
-	return xxx_messageInfo_InsertRequest.Size(m)
+func (m *ShowSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_ShowSegmentsRequest.Size(m)
 }
-func (m *InsertRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertRequest.DiscardUnknown(m)
+func (m *ShowSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowSegmentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InsertRequest proto.InternalMessageInfo
+var xxx_messageInfo_ShowSegmentsRequest proto.InternalMessageInfo
 
-func (m *InsertRequest) GetBase() *commonpb.MsgBase {
+func (m *ShowSegmentsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *InsertRequest) GetDbName() string {
-	if m != nil {
-		return m.DbName
-	}
-	return ""
-}
-
-func (m *InsertRequest) GetCollectionName() string {
-	if m != nil {
-		return m.CollectionName
-	}
-	return ""
-}
-
-func (m *InsertRequest) GetPartitionName() string {
-	if m != nil {
-		return m.PartitionName
-	}
-	return ""
-}
-
-func (m *InsertRequest) GetFieldsData() []*schemapb.FieldData {
-	if m != nil {
-		return m.FieldsData
-	}
-	return nil
-}
-
-func (m *InsertRequest) GetHashKeys() []uint32 {
+func (m *ShowSegmentsRequest) GetCollectionID() int64 {
 	if m != nil {
-		return m.HashKeys
+		return m.CollectionID
 	}
-	return nil
+	return 0
 }
 
-func (m *InsertRequest) GetNumRows() uint32 {
+func (m *ShowSegmentsRequest) GetPartitionID() int64 {
 	if m != nil {
-		return m.NumRows
+		return m.PartitionID
 	}
 	return 0
 }
 
-type MutationResult struct {
+type ShowSegmentsResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	IDs                  *schemapb.IDs    `protobuf:"bytes,2,opt,name=IDs,proto3" json:"IDs,omitempty"`
-	SuccIndex            []uint32         `protobuf:"varint,3,rep,packed,name=succ_index,json=succIndex,proto3" json:"succ_index,omitempty"`
-	ErrIndex             []uint32         `protobuf:"varint,4,rep,packed,name=err_index,json=errIndex,proto3" json:"err_index,omitempty"`
-	Acknowledged         bool             `protobuf:"varint,5,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
-	InsertCnt            int64            `protobuf:"varint,6,opt,name=insert_cnt,json=insertCnt,proto3" json:"insert_cnt,omitempty"`
-	DeleteCnt            int64            `protobuf:"varint,7,opt,name=delete_cnt,json=deleteCnt,proto3" json:"delete_cnt,omitempty"`
-	UpsertCnt            int64            `protobuf:"varint,8,opt,name=upsert_cnt,json=upsertCnt,proto3" json:"upsert_cnt,omitempty"`
-	Timestamp            uint64           `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SegmentIDs           []int64          `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *MutationResult) Reset()         { *m = MutationResult{} }
-func (m *MutationResult) String() string { return proto.CompactTextString(m) }
-func (*MutationResult) ProtoMessage()    {}
-func (*MutationResult) Descriptor() ([]byte, []int) {
+func (m *ShowSegmentsResponse) Reset()         { *m = ShowSegmentsResponse{} }
+func (m *ShowSegmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*ShowSegmentsResponse) ProtoMessage()    {}
+func (*ShowSegmentsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{39}
 }
 
-func (m *MutationResult) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MutationResult.Unmarshal(m, b)
+func (m *ShowSegmentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowSegmentsResponse.Unmarshal(m, b)
 }
-func (m *MutationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MutationResult.Marshal(b, m, deterministic)
+func (m *ShowSegmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowSegmentsResponse.Marshal(b, m, deterministic)
 }
-func (m *MutationResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MutationResult.Merge(m, src)
+func (m *ShowSegmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowSegmentsResponse.Merge(m, src)
 }
-func (m *MutationResult) XXX_Size() int {
-	return xxx_messageInfo_MutationResult.Size(m)
+func (m *ShowSegmentsResponse) XXX_Size() int {
+	return xxx_messageInfo_ShowSegmentsResponse.Size(m)
 }
-func (m *MutationResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_MutationResult.DiscardUnknown(m)
+func (m *ShowSegmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowSegmentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MutationResult proto.InternalMessageInfo
+var xxx_messageInfo_ShowSegmentsResponse proto.InternalMessageInfo
 
-func (m *MutationResult) GetStatus() *commonpb.Status {
+func (m *ShowSegmentsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *MutationResult) GetIDs() *schemapb.IDs {
-	if m != nil {
-		return m.IDs
-	}
-	return nil
-}
-
-func (m *MutationResult) GetSuccIndex() []uint32 {
-	if m != nil {
-		return m.SuccIndex
-	}
-	return nil
-}
-
-func (m *MutationResult) GetErrIndex() []uint32 {
+func (m *ShowSegmentsResponse) GetSegmentIDs() []int64 {
 	if m != nil {
-		return m.ErrIndex
+		return m.SegmentIDs
 	}
 	return nil
 }
 
-func (m *MutationResult) GetAcknowledged() bool {
-	if m != nil {
-		return m.Acknowledged
-	}
-	return false
-}
-
-func (m *MutationResult) GetInsertCnt() int64 {
-	if m != nil {
-		return m.InsertCnt
-	}
-	return 0
-}
-
-func (m *MutationResult) GetDeleteCnt() int64 {
-	if m != nil {
-		return m.DeleteCnt
-	}
-	return 0
-}
-
-func (m *MutationResult) GetUpsertCnt() int64 {
-	if m != nil {
-		return m.UpsertCnt
-	}
-	return 0
-}
-
-func (m *MutationResult) GetTimestamp() uint64 {
-	if m != nil {
-		return m.Timestamp
-	}
-	return 0
-}
-
-type DeleteRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	Expr                 string            `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
-	HashKeys             []uint32          `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+//
+// Create index for vector datas
+type CreateIndexRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The particular collection name you want to create index.
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The vector field name in this particular collection
+	FieldName string `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	// Support keys: index_type,metric_type, params. Different index_type may has different params.
+	ExtraParams []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=extra_params,json=extraParams,proto3" json:"extra_params,omitempty"`
+	// Version before 2.0.2 doesn't contain index_name, we use default index name.
+	IndexName            string   `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
-func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteRequest) ProtoMessage()    {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
+func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
+func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateIndexRequest) ProtoMessage()    {}
+func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{40}
 }
 
-func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateIndexRequest.Unmarshal(m, b)
 }
-func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+func (m *CreateIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateIndexRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteRequest.Merge(m, src)
+func (m *CreateIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateIndexRequest.Merge(m, src)
 }
-func (m *DeleteRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteRequest.Size(m)
+func (m *CreateIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateIndexRequest.Size(m)
 }
-func (m *DeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+func (m *CreateIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateIndexRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateIndexRequest proto.InternalMessageInfo
 
-func (m *DeleteRequest) GetBase() *commonpb.MsgBase {
+func (m *CreateIndexRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DeleteRequest) GetDbName() string {
+func (m *CreateIndexRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *DeleteRequest) GetCollectionName() string {
+func (m *CreateIndexRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *DeleteRequest) GetPartitionName() string {
+func (m *CreateIndexRequest) GetFieldName() string {
 	if m != nil {
-		return m.PartitionName
+		return m.FieldName
 	}
 	return ""
 }
 
-func (m *DeleteRequest) GetExpr() string {
+func (m *CreateIndexRequest) GetExtraParams() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.Expr
+		return m.ExtraParams
 	}
-	return ""
+	return nil
 }
 
-func (m *DeleteRequest) GetHashKeys() []uint32 {
+func (m *CreateIndexRequest) GetIndexName() string {
 	if m != nil {
-		return m.HashKeys
+		return m.IndexName
 	}
-	return nil
+	return ""
 }
 
-type PlaceholderValue struct {
-	Tag  string          `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Type PlaceholderType `protobuf:"varint,2,opt,name=type,proto3,enum=milvus.proto.milvus.PlaceholderType" json:"type,omitempty"`
-	// values is a 2d-array, every array contains a vector
-	Values               [][]byte `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+//
+// Get created index information.
+// Current release of Milvus only supports showing latest built index.
+type DescribeIndexRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The particular collection name in Milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The vector field name in this particular collection
+	FieldName string `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	// No need to set up for now @2021.06.30
+	IndexName            string   `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PlaceholderValue) Reset()         { *m = PlaceholderValue{} }
-func (m *PlaceholderValue) String() string { return proto.CompactTextString(m) }
-func (*PlaceholderValue) ProtoMessage()    {}
-func (*PlaceholderValue) Descriptor() ([]byte, []int) {
+func (m *DescribeIndexRequest) Reset()         { *m = DescribeIndexRequest{} }
+func (m *DescribeIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeIndexRequest) ProtoMessage()    {}
+func (*DescribeIndexRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{41}
 }
 
-func (m *PlaceholderValue) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PlaceholderValue.Unmarshal(m, b)
+func (m *DescribeIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeIndexRequest.Unmarshal(m, b)
 }
-func (m *PlaceholderValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PlaceholderValue.Marshal(b, m, deterministic)
+func (m *DescribeIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeIndexRequest.Marshal(b, m, deterministic)
 }
-func (m *PlaceholderValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlaceholderValue.Merge(m, src)
+func (m *DescribeIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeIndexRequest.Merge(m, src)
 }
-func (m *PlaceholderValue) XXX_Size() int {
-	return xxx_messageInfo_PlaceholderValue.Size(m)
+func (m *DescribeIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeIndexRequest.Size(m)
 }
-func (m *PlaceholderValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlaceholderValue.DiscardUnknown(m)
+func (m *DescribeIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeIndexRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PlaceholderValue proto.InternalMessageInfo
+var xxx_messageInfo_DescribeIndexRequest proto.InternalMessageInfo
 
-func (m *PlaceholderValue) GetTag() string {
+func (m *DescribeIndexRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Tag
+		return m.Base
 	}
-	return ""
+	return nil
 }
 
-func (m *PlaceholderValue) GetType() PlaceholderType {
+func (m *DescribeIndexRequest) GetDbName() string {
 	if m != nil {
-		return m.Type
+		return m.DbName
 	}
-	return PlaceholderType_None
+	return ""
 }
 
-func (m *PlaceholderValue) GetValues() [][]byte {
+func (m *DescribeIndexRequest) GetCollectionName() string {
 	if m != nil {
-		return m.Values
+		return m.CollectionName
 	}
-	return nil
-}
-
-type PlaceholderGroup struct {
-	Placeholders         []*PlaceholderValue `protobuf:"bytes,1,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
-}
-
-func (m *PlaceholderGroup) Reset()         { *m = PlaceholderGroup{} }
-func (m *PlaceholderGroup) String() string { return proto.CompactTextString(m) }
-func (*PlaceholderGroup) ProtoMessage()    {}
-func (*PlaceholderGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{42}
+	return ""
 }
 
-func (m *PlaceholderGroup) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PlaceholderGroup.Unmarshal(m, b)
-}
-func (m *PlaceholderGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PlaceholderGroup.Marshal(b, m, deterministic)
-}
-func (m *PlaceholderGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlaceholderGroup.Merge(m, src)
-}
-func (m *PlaceholderGroup) XXX_Size() int {
-	return xxx_messageInfo_PlaceholderGroup.Size(m)
-}
-func (m *PlaceholderGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlaceholderGroup.DiscardUnknown(m)
+func (m *DescribeIndexRequest) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
 }
 
-var xxx_messageInfo_PlaceholderGroup proto.InternalMessageInfo
-
-func (m *PlaceholderGroup) GetPlaceholders() []*PlaceholderValue {
+func (m *DescribeIndexRequest) GetIndexName() string {
 	if m != nil {
-		return m.Placeholders
+		return m.IndexName
 	}
-	return nil
+	return ""
 }
 
-type SearchRequest struct {
-	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	Dsl            string            `protobuf:"bytes,5,opt,name=dsl,proto3" json:"dsl,omitempty"`
-	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte                   `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType         `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	OutputFields         []string                 `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	SearchParams         []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
-	TravelTimestamp      uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+//
+// Index informations
+type IndexDescription struct {
+	// Index name
+	IndexName string `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	// Index id
+	IndexID int64 `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	// Will return index_type, metric_type, params(like nlist).
+	Params []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	// The vector field name
+	FieldName            string   `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
-func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
-func (*SearchRequest) ProtoMessage()    {}
-func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{43}
+func (m *IndexDescription) Reset()         { *m = IndexDescription{} }
+func (m *IndexDescription) String() string { return proto.CompactTextString(m) }
+func (*IndexDescription) ProtoMessage()    {}
+func (*IndexDescription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{42}
 }
 
-func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
+func (m *IndexDescription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexDescription.Unmarshal(m, b)
 }
-func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+func (m *IndexDescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexDescription.Marshal(b, m, deterministic)
 }
-func (m *SearchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchRequest.Merge(m, src)
+func (m *IndexDescription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexDescription.Merge(m, src)
 }
-func (m *SearchRequest) XXX_Size() int {
-	return xxx_messageInfo_SearchRequest.Size(m)
+func (m *IndexDescription) XXX_Size() int {
+	return xxx_messageInfo_IndexDescription.Size(m)
 }
-func (m *SearchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+func (m *IndexDescription) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexDescription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
-
-func (m *SearchRequest) GetBase() *commonpb.MsgBase {
-	if m != nil {
-		return m.Base
-	}
-	return nil
-}
+var xxx_messageInfo_IndexDescription proto.InternalMessageInfo
 
-func (m *SearchRequest) GetDbName() string {
+func (m *IndexDescription) GetIndexName() string {
 	if m != nil {
-		return m.DbName
+		return m.IndexName
 	}
 	return ""
 }
 
-func (m *SearchRequest) GetCollectionName() string {
+func (m *IndexDescription) GetIndexID() int64 {
 	if m != nil {
-		return m.CollectionName
+		return m.IndexID
 	}
-	return ""
+	return 0
 }
 
-func (m *SearchRequest) GetPartitionNames() []string {
+func (m *IndexDescription) GetParams() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.PartitionNames
+		return m.Params
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetDsl() string {
+func (m *IndexDescription) GetFieldName() string {
 	if m != nil {
-		return m.Dsl
+		return m.FieldName
 	}
 	return ""
 }
 
-func (m *SearchRequest) GetPlaceholderGroup() []byte {
+//
+// Describe index response
+type DescribeIndexResponse struct {
+	// Response status
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// All index informations, for now only return tha latest index you created for the collection.
+	IndexDescriptions    []*IndexDescription `protobuf:"bytes,2,rep,name=index_descriptions,json=indexDescriptions,proto3" json:"index_descriptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *DescribeIndexResponse) Reset()         { *m = DescribeIndexResponse{} }
+func (m *DescribeIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeIndexResponse) ProtoMessage()    {}
+func (*DescribeIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{43}
+}
+
+func (m *DescribeIndexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeIndexResponse.Unmarshal(m, b)
+}
+func (m *DescribeIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeIndexResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeIndexResponse.Merge(m, src)
+}
+func (m *DescribeIndexResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeIndexResponse.Size(m)
+}
+func (m *DescribeIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeIndexResponse proto.InternalMessageInfo
+
+func (m *DescribeIndexResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.PlaceholderGroup
+		return m.Status
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetDslType() commonpb.DslType {
+func (m *DescribeIndexResponse) GetIndexDescriptions() []*IndexDescription {
 	if m != nil {
-		return m.DslType
-	}
-	return commonpb.DslType_Dsl
-}
-
-func (m *SearchRequest) GetOutputFields() []string {
-	if m != nil {
-		return m.OutputFields
-	}
-	return nil
-}
-
-func (m *SearchRequest) GetSearchParams() []*commonpb.KeyValuePair {
-	if m != nil {
-		return m.SearchParams
+		return m.IndexDescriptions
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetTravelTimestamp() uint64 {
-	if m != nil {
-		return m.TravelTimestamp
-	}
-	return 0
-}
-
-func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
-	if m != nil {
-		return m.GuaranteeTimestamp
-	}
-	return 0
-}
-
-type Hits struct {
-	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
-	RowData              [][]byte  `protobuf:"bytes,2,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
-	Scores               []float32 `protobuf:"fixed32,3,rep,packed,name=scores,proto3" json:"scores,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+//
+//  Get index building progress
+type GetIndexBuildProgressRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The vector field name in this collection
+	FieldName string `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	// Not useful for now
+	IndexName            string   `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Hits) Reset()         { *m = Hits{} }
-func (m *Hits) String() string { return proto.CompactTextString(m) }
-func (*Hits) ProtoMessage()    {}
-func (*Hits) Descriptor() ([]byte, []int) {
+func (m *GetIndexBuildProgressRequest) Reset()         { *m = GetIndexBuildProgressRequest{} }
+func (m *GetIndexBuildProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetIndexBuildProgressRequest) ProtoMessage()    {}
+func (*GetIndexBuildProgressRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{44}
 }
 
-func (m *Hits) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Hits.Unmarshal(m, b)
+func (m *GetIndexBuildProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexBuildProgressRequest.Unmarshal(m, b)
 }
-func (m *Hits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Hits.Marshal(b, m, deterministic)
+func (m *GetIndexBuildProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexBuildProgressRequest.Marshal(b, m, deterministic)
 }
-func (m *Hits) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Hits.Merge(m, src)
+func (m *GetIndexBuildProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexBuildProgressRequest.Merge(m, src)
 }
-func (m *Hits) XXX_Size() int {
-	return xxx_messageInfo_Hits.Size(m)
+func (m *GetIndexBuildProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetIndexBuildProgressRequest.Size(m)
 }
-func (m *Hits) XXX_DiscardUnknown() {
-	xxx_messageInfo_Hits.DiscardUnknown(m)
+func (m *GetIndexBuildProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexBuildProgressRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Hits proto.InternalMessageInfo
+var xxx_messageInfo_GetIndexBuildProgressRequest proto.InternalMessageInfo
 
-func (m *Hits) GetIDs() []int64 {
+func (m *GetIndexBuildProgressRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.IDs
+		return m.Base
 	}
 	return nil
 }
 
-func (m *Hits) GetRowData() [][]byte {
+func (m *GetIndexBuildProgressRequest) GetDbName() string {
 	if m != nil {
-		return m.RowData
+		return m.DbName
 	}
-	return nil
+	return ""
 }
 
-func (m *Hits) GetScores() []float32 {
+func (m *GetIndexBuildProgressRequest) GetCollectionName() string {
 	if m != nil {
-		return m.Scores
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-type SearchResults struct {
-	Status               *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Results              *schemapb.SearchResultData `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
-	CollectionName       string                     `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+func (m *GetIndexBuildProgressRequest) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
 }
 
-func (m *SearchResults) Reset()         { *m = SearchResults{} }
-func (m *SearchResults) String() string { return proto.CompactTextString(m) }
-func (*SearchResults) ProtoMessage()    {}
-func (*SearchResults) Descriptor() ([]byte, []int) {
+func (m *GetIndexBuildProgressRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+type GetIndexBuildProgressResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IndexedRows          int64            `protobuf:"varint,2,opt,name=indexed_rows,json=indexedRows,proto3" json:"indexed_rows,omitempty"`
+	TotalRows            int64            `protobuf:"varint,3,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetIndexBuildProgressResponse) Reset()         { *m = GetIndexBuildProgressResponse{} }
+func (m *GetIndexBuildProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetIndexBuildProgressResponse) ProtoMessage()    {}
+func (*GetIndexBuildProgressResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{45}
 }
 
-func (m *SearchResults) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SearchResults.Unmarshal(m, b)
+func (m *GetIndexBuildProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexBuildProgressResponse.Unmarshal(m, b)
 }
-func (m *SearchResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SearchResults.Marshal(b, m, deterministic)
+func (m *GetIndexBuildProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexBuildProgressResponse.Marshal(b, m, deterministic)
 }
-func (m *SearchResults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchResults.Merge(m, src)
+func (m *GetIndexBuildProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexBuildProgressResponse.Merge(m, src)
 }
-func (m *SearchResults) XXX_Size() int {
-	return xxx_messageInfo_SearchResults.Size(m)
+func (m *GetIndexBuildProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetIndexBuildProgressResponse.Size(m)
 }
-func (m *SearchResults) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchResults.DiscardUnknown(m)
+func (m *GetIndexBuildProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexBuildProgressResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchResults proto.InternalMessageInfo
+var xxx_messageInfo_GetIndexBuildProgressResponse proto.InternalMessageInfo
 
-func (m *SearchResults) GetStatus() *commonpb.Status {
+func (m *GetIndexBuildProgressResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *SearchResults) GetResults() *schemapb.SearchResultData {
+func (m *GetIndexBuildProgressResponse) GetIndexedRows() int64 {
 	if m != nil {
-		return m.Results
+		return m.IndexedRows
 	}
-	return nil
+	return 0
 }
 
-func (m *SearchResults) GetCollectionName() string {
+func (m *GetIndexBuildProgressResponse) GetTotalRows() int64 {
 	if m != nil {
-		return m.CollectionName
+		return m.TotalRows
 	}
-	return ""
+	return 0
 }
 
-type FlushRequest struct {
+//*
+// Cancel the unfinished index builds of a collection, the one of index_buildID, or all of the index of index_name
+type CancelIndexBuildRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionNames      []string          `protobuf:"bytes,3,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	IndexName            string            `protobuf:"bytes,4,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexBuildID         int64             `protobuf:"varint,5,opt,name=index_buildID,json=indexBuildID,proto3" json:"index_buildID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
-func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
-func (*FlushRequest) ProtoMessage()    {}
-func (*FlushRequest) Descriptor() ([]byte, []int) {
+func (m *CancelIndexBuildRequest) Reset()         { *m = CancelIndexBuildRequest{} }
+func (m *CancelIndexBuildRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelIndexBuildRequest) ProtoMessage()    {}
+func (*CancelIndexBuildRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{46}
 }
 
-func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FlushRequest.Unmarshal(m, b)
+func (m *CancelIndexBuildRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelIndexBuildRequest.Unmarshal(m, b)
 }
-func (m *FlushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FlushRequest.Marshal(b, m, deterministic)
+func (m *CancelIndexBuildRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelIndexBuildRequest.Marshal(b, m, deterministic)
 }
-func (m *FlushRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FlushRequest.Merge(m, src)
+func (m *CancelIndexBuildRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelIndexBuildRequest.Merge(m, src)
 }
-func (m *FlushRequest) XXX_Size() int {
-	return xxx_messageInfo_FlushRequest.Size(m)
+func (m *CancelIndexBuildRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelIndexBuildRequest.Size(m)
 }
-func (m *FlushRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FlushRequest.DiscardUnknown(m)
+func (m *CancelIndexBuildRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelIndexBuildRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FlushRequest proto.InternalMessageInfo
+var xxx_messageInfo_CancelIndexBuildRequest proto.InternalMessageInfo
 
-func (m *FlushRequest) GetBase() *commonpb.MsgBase {
+func (m *CancelIndexBuildRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *FlushRequest) GetDbName() string {
+func (m *CancelIndexBuildRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *FlushRequest) GetCollectionNames() []string {
+func (m *CancelIndexBuildRequest) GetCollectionName() string {
 	if m != nil {
-		return m.CollectionNames
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-type FlushResponse struct {
-	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	DbName               string                         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollSegIDs           map[string]*schemapb.LongArray `protobuf:"bytes,3,rep,name=coll_segIDs,json=collSegIDs,proto3" json:"coll_segIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+func (m *CancelIndexBuildRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
 }
 
-func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
-func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
-func (*FlushResponse) ProtoMessage()    {}
-func (*FlushResponse) Descriptor() ([]byte, []int) {
+func (m *CancelIndexBuildRequest) GetIndexBuildID() int64 {
+	if m != nil {
+		return m.IndexBuildID
+	}
+	return 0
+}
+
+type CancelIndexBuildResponse struct {
+	Status                *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CanceledIndexBuildIDs []int64          `protobuf:"varint,2,rep,packed,name=canceled_index_buildIDs,json=canceledIndexBuildIDs,proto3" json:"canceled_index_buildIDs,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
+	XXX_unrecognized      []byte           `json:"-"`
+	XXX_sizecache         int32            `json:"-"`
+}
+
+func (m *CancelIndexBuildResponse) Reset()         { *m = CancelIndexBuildResponse{} }
+func (m *CancelIndexBuildResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelIndexBuildResponse) ProtoMessage()    {}
+func (*CancelIndexBuildResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{47}
 }
 
-func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FlushResponse.Unmarshal(m, b)
+func (m *CancelIndexBuildResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelIndexBuildResponse.Unmarshal(m, b)
 }
-func (m *FlushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FlushResponse.Marshal(b, m, deterministic)
+func (m *CancelIndexBuildResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelIndexBuildResponse.Marshal(b, m, deterministic)
 }
-func (m *FlushResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FlushResponse.Merge(m, src)
+func (m *CancelIndexBuildResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelIndexBuildResponse.Merge(m, src)
 }
-func (m *FlushResponse) XXX_Size() int {
-	return xxx_messageInfo_FlushResponse.Size(m)
+func (m *CancelIndexBuildResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelIndexBuildResponse.Size(m)
 }
-func (m *FlushResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FlushResponse.DiscardUnknown(m)
+func (m *CancelIndexBuildResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelIndexBuildResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FlushResponse proto.InternalMessageInfo
+var xxx_messageInfo_CancelIndexBuildResponse proto.InternalMessageInfo
 
-func (m *FlushResponse) GetStatus() *commonpb.Status {
+func (m *CancelIndexBuildResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *FlushResponse) GetDbName() string {
-	if m != nil {
-		return m.DbName
-	}
-	return ""
-}
-
-func (m *FlushResponse) GetCollSegIDs() map[string]*schemapb.LongArray {
+func (m *CancelIndexBuildResponse) GetCanceledIndexBuildIDs() []int64 {
 	if m != nil {
-		return m.CollSegIDs
+		return m.CanceledIndexBuildIDs
 	}
 	return nil
 }
 
-type QueryRequest struct {
+type GetIndexStateRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	Expr                 string            `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
-	OutputFields         []string          `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	PartitionNames       []string          `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	TravelTimestamp      uint64            `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64            `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
-func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRequest) ProtoMessage()    {}
-func (*QueryRequest) Descriptor() ([]byte, []int) {
+func (m *GetIndexStateRequest) Reset()         { *m = GetIndexStateRequest{} }
+func (m *GetIndexStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetIndexStateRequest) ProtoMessage()    {}
+func (*GetIndexStateRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{48}
 }
 
-func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryRequest.Unmarshal(m, b)
+func (m *GetIndexStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexStateRequest.Unmarshal(m, b)
 }
-func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+func (m *GetIndexStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexStateRequest.Marshal(b, m, deterministic)
 }
-func (m *QueryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRequest.Merge(m, src)
+func (m *GetIndexStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexStateRequest.Merge(m, src)
 }
-func (m *QueryRequest) XXX_Size() int {
-	return xxx_messageInfo_QueryRequest.Size(m)
+func (m *GetIndexStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetIndexStateRequest.Size(m)
 }
-func (m *QueryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+func (m *GetIndexStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetIndexStateRequest proto.InternalMessageInfo
 
-func (m *QueryRequest) GetBase() *commonpb.MsgBase {
+func (m *GetIndexStateRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *QueryRequest) GetDbName() string {
+func (m *GetIndexStateRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *QueryRequest) GetCollectionName() string {
+func (m *GetIndexStateRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *QueryRequest) GetExpr() string {
+func (m *GetIndexStateRequest) GetFieldName() string {
 	if m != nil {
-		return m.Expr
+		return m.FieldName
 	}
 	return ""
 }
 
-func (m *QueryRequest) GetOutputFields() []string {
-	if m != nil {
-		return m.OutputFields
-	}
-	return nil
-}
-
-func (m *QueryRequest) GetPartitionNames() []string {
-	if m != nil {
-		return m.PartitionNames
-	}
-	return nil
-}
-
-func (m *QueryRequest) GetTravelTimestamp() uint64 {
-	if m != nil {
-		return m.TravelTimestamp
-	}
-	return 0
-}
-
-func (m *QueryRequest) GetGuaranteeTimestamp() uint64 {
+func (m *GetIndexStateRequest) GetIndexName() string {
 	if m != nil {
-		return m.GuaranteeTimestamp
+		return m.IndexName
 	}
-	return 0
+	return ""
 }
 
-type QueryResults struct {
-	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
-	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type GetIndexStateResponse struct {
+	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	FailReason           string              `protobuf:"bytes,3,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *QueryResults) Reset()         { *m = QueryResults{} }
-func (m *QueryResults) String() string { return proto.CompactTextString(m) }
-func (*QueryResults) ProtoMessage()    {}
-func (*QueryResults) Descriptor() ([]byte, []int) {
+func (m *GetIndexStateResponse) Reset()         { *m = GetIndexStateResponse{} }
+func (m *GetIndexStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetIndexStateResponse) ProtoMessage()    {}
+func (*GetIndexStateResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{49}
 }
 
-func (m *QueryResults) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryResults.Unmarshal(m, b)
+func (m *GetIndexStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexStateResponse.Unmarshal(m, b)
 }
-func (m *QueryResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryResults.Marshal(b, m, deterministic)
+func (m *GetIndexStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexStateResponse.Marshal(b, m, deterministic)
 }
-func (m *QueryResults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryResults.Merge(m, src)
+func (m *GetIndexStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexStateResponse.Merge(m, src)
 }
-func (m *QueryResults) XXX_Size() int {
-	return xxx_messageInfo_QueryResults.Size(m)
+func (m *GetIndexStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetIndexStateResponse.Size(m)
 }
-func (m *QueryResults) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryResults.DiscardUnknown(m)
+func (m *GetIndexStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryResults proto.InternalMessageInfo
+var xxx_messageInfo_GetIndexStateResponse proto.InternalMessageInfo
 
-func (m *QueryResults) GetStatus() *commonpb.Status {
+func (m *GetIndexStateResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *QueryResults) GetFieldsData() []*schemapb.FieldData {
+func (m *GetIndexStateResponse) GetState() commonpb.IndexState {
 	if m != nil {
-		return m.FieldsData
+		return m.State
 	}
-	return nil
+	return commonpb.IndexState_IndexStateNone
 }
 
-func (m *QueryResults) GetCollectionName() string {
+func (m *GetIndexStateResponse) GetFailReason() string {
 	if m != nil {
-		return m.CollectionName
+		return m.FailReason
 	}
 	return ""
 }
 
-type VectorIDs struct {
-	CollectionName       string        `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	FieldName            string        `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	IdArray              *schemapb.IDs `protobuf:"bytes,3,opt,name=id_array,json=idArray,proto3" json:"id_array,omitempty"`
-	PartitionNames       []string      `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type DropIndexRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
-func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
-func (*VectorIDs) ProtoMessage()    {}
-func (*VectorIDs) Descriptor() ([]byte, []int) {
+func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
+func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*DropIndexRequest) ProtoMessage()    {}
+func (*DropIndexRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{50}
 }
 
-func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VectorIDs.Unmarshal(m, b)
+func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropIndexRequest.Unmarshal(m, b)
 }
-func (m *VectorIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VectorIDs.Marshal(b, m, deterministic)
+func (m *DropIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropIndexRequest.Marshal(b, m, deterministic)
 }
-func (m *VectorIDs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VectorIDs.Merge(m, src)
+func (m *DropIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropIndexRequest.Merge(m, src)
 }
-func (m *VectorIDs) XXX_Size() int {
-	return xxx_messageInfo_VectorIDs.Size(m)
+func (m *DropIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_DropIndexRequest.Size(m)
 }
-func (m *VectorIDs) XXX_DiscardUnknown() {
-	xxx_messageInfo_VectorIDs.DiscardUnknown(m)
+func (m *DropIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropIndexRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VectorIDs proto.InternalMessageInfo
+var xxx_messageInfo_DropIndexRequest proto.InternalMessageInfo
 
-func (m *VectorIDs) GetCollectionName() string {
+func (m *DropIndexRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.CollectionName
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropIndexRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
 	}
 	return ""
 }
 
-func (m *VectorIDs) GetFieldName() string {
+func (m *DropIndexRequest) GetCollectionName() string {
 	if m != nil {
-		return m.FieldName
+		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *VectorIDs) GetIdArray() *schemapb.IDs {
+func (m *DropIndexRequest) GetFieldName() string {
 	if m != nil {
-		return m.IdArray
+		return m.FieldName
 	}
-	return nil
+	return ""
 }
 
-func (m *VectorIDs) GetPartitionNames() []string {
+func (m *DropIndexRequest) GetIndexName() string {
 	if m != nil {
-		return m.PartitionNames
+		return m.IndexName
 	}
-	return nil
+	return ""
 }
 
-type VectorsArray struct {
-	// Types that are valid to be assigned to Array:
-	//	*VectorsArray_IdArray
-	//	*VectorsArray_DataArray
-	Array                isVectorsArray_Array `protobuf_oneof:"array"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type InsertRequest struct {
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	HashKeys             []uint32              `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
+	NumRows              uint32                `protobuf:"varint,7,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
-func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
-func (*VectorsArray) ProtoMessage()    {}
-func (*VectorsArray) Descriptor() ([]byte, []int) {
+func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
+func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertRequest) ProtoMessage()    {}
+func (*InsertRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{51}
 }
 
-func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VectorsArray.Unmarshal(m, b)
+func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InsertRequest.Unmarshal(m, b)
 }
-func (m *VectorsArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VectorsArray.Marshal(b, m, deterministic)
+func (m *InsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InsertRequest.Marshal(b, m, deterministic)
 }
-func (m *VectorsArray) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VectorsArray.Merge(m, src)
+func (m *InsertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertRequest.Merge(m, src)
 }
-func (m *VectorsArray) XXX_Size() int {
-	return xxx_messageInfo_VectorsArray.Size(m)
+func (m *InsertRequest) XXX_Size() int {
+	return xxx_messageInfo_InsertRequest.Size(m)
 }
-func (m *VectorsArray) XXX_DiscardUnknown() {
-	xxx_messageInfo_VectorsArray.DiscardUnknown(m)
+func (m *InsertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VectorsArray proto.InternalMessageInfo
-
-type isVectorsArray_Array interface {
-	isVectorsArray_Array()
-}
+var xxx_messageInfo_InsertRequest proto.InternalMessageInfo
 
-type VectorsArray_IdArray struct {
-	IdArray *VectorIDs `protobuf:"bytes,1,opt,name=id_array,json=idArray,proto3,oneof"`
+func (m *InsertRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
 }
 
-type VectorsArray_DataArray struct {
-	DataArray *schemapb.VectorField `protobuf:"bytes,2,opt,name=data_array,json=dataArray,proto3,oneof"`
+func (m *InsertRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
 }
 
-func (*VectorsArray_IdArray) isVectorsArray_Array() {}
-
-func (*VectorsArray_DataArray) isVectorsArray_Array() {}
+func (m *InsertRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
 
-func (m *VectorsArray) GetArray() isVectorsArray_Array {
+func (m *InsertRequest) GetPartitionName() string {
 	if m != nil {
-		return m.Array
+		return m.PartitionName
 	}
-	return nil
+	return ""
 }
 
-func (m *VectorsArray) GetIdArray() *VectorIDs {
-	if x, ok := m.GetArray().(*VectorsArray_IdArray); ok {
-		return x.IdArray
+func (m *InsertRequest) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
 	}
 	return nil
 }
 
-func (m *VectorsArray) GetDataArray() *schemapb.VectorField {
-	if x, ok := m.GetArray().(*VectorsArray_DataArray); ok {
-		return x.DataArray
+func (m *InsertRequest) GetHashKeys() []uint32 {
+	if m != nil {
+		return m.HashKeys
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*VectorsArray) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*VectorsArray_IdArray)(nil),
-		(*VectorsArray_DataArray)(nil),
+func (m *InsertRequest) GetNumRows() uint32 {
+	if m != nil {
+		return m.NumRows
 	}
+	return 0
 }
 
-type CalcDistanceRequest struct {
-	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	OpLeft               *VectorsArray            `protobuf:"bytes,2,opt,name=op_left,json=opLeft,proto3" json:"op_left,omitempty"`
-	OpRight              *VectorsArray            `protobuf:"bytes,3,opt,name=op_right,json=opRight,proto3" json:"op_right,omitempty"`
-	Params               []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+// UpsertRequest replaces the rows with the same primary keys, the rows not exist are inserted
+type UpsertRequest struct {
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	HashKeys             []uint32              `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
+	NumRows              uint32                `protobuf:"varint,7,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *CalcDistanceRequest) Reset()         { *m = CalcDistanceRequest{} }
-func (m *CalcDistanceRequest) String() string { return proto.CompactTextString(m) }
-func (*CalcDistanceRequest) ProtoMessage()    {}
-func (*CalcDistanceRequest) Descriptor() ([]byte, []int) {
+func (m *UpsertRequest) Reset()         { *m = UpsertRequest{} }
+func (m *UpsertRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertRequest) ProtoMessage()    {}
+func (*UpsertRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{52}
 }
 
-func (m *CalcDistanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CalcDistanceRequest.Unmarshal(m, b)
+func (m *UpsertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertRequest.Unmarshal(m, b)
 }
-func (m *CalcDistanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CalcDistanceRequest.Marshal(b, m, deterministic)
+func (m *UpsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertRequest.Marshal(b, m, deterministic)
 }
-func (m *CalcDistanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CalcDistanceRequest.Merge(m, src)
+func (m *UpsertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertRequest.Merge(m, src)
 }
-func (m *CalcDistanceRequest) XXX_Size() int {
-	return xxx_messageInfo_CalcDistanceRequest.Size(m)
+func (m *UpsertRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertRequest.Size(m)
 }
-func (m *CalcDistanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CalcDistanceRequest.DiscardUnknown(m)
+func (m *UpsertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CalcDistanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpsertRequest proto.InternalMessageInfo
 
-func (m *CalcDistanceRequest) GetBase() *commonpb.MsgBase {
+func (m *UpsertRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *CalcDistanceRequest) GetOpLeft() *VectorsArray {
+func (m *UpsertRequest) GetDbName() string {
 	if m != nil {
-		return m.OpLeft
+		return m.DbName
 	}
-	return nil
+	return ""
 }
 
-func (m *CalcDistanceRequest) GetOpRight() *VectorsArray {
+func (m *UpsertRequest) GetCollectionName() string {
 	if m != nil {
-		return m.OpRight
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *UpsertRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *UpsertRequest) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
 	}
 	return nil
 }
 
-func (m *CalcDistanceRequest) GetParams() []*commonpb.KeyValuePair {
+func (m *UpsertRequest) GetHashKeys() []uint32 {
 	if m != nil {
-		return m.Params
+		return m.HashKeys
 	}
 	return nil
 }
 
-type CalcDistanceResults struct {
-	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// num(op_left)*num(op_right) distance values, "HAMMIN" return integer distance
-	//
-	// Types that are valid to be assigned to Array:
-	//	*CalcDistanceResults_IntDist
-	//	*CalcDistanceResults_FloatDist
-	Array                isCalcDistanceResults_Array `protobuf_oneof:"array"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+func (m *UpsertRequest) GetNumRows() uint32 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
 }
 
-func (m *CalcDistanceResults) Reset()         { *m = CalcDistanceResults{} }
-func (m *CalcDistanceResults) String() string { return proto.CompactTextString(m) }
-func (*CalcDistanceResults) ProtoMessage()    {}
-func (*CalcDistanceResults) Descriptor() ([]byte, []int) {
+type MutationResult struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IDs                  *schemapb.IDs    `protobuf:"bytes,2,opt,name=IDs,proto3" json:"IDs,omitempty"`
+	SuccIndex            []uint32         `protobuf:"varint,3,rep,packed,name=succ_index,json=succIndex,proto3" json:"succ_index,omitempty"`
+	ErrIndex             []uint32         `protobuf:"varint,4,rep,packed,name=err_index,json=errIndex,proto3" json:"err_index,omitempty"`
+	Acknowledged         bool             `protobuf:"varint,5,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	InsertCnt            int64            `protobuf:"varint,6,opt,name=insert_cnt,json=insertCnt,proto3" json:"insert_cnt,omitempty"`
+	DeleteCnt            int64            `protobuf:"varint,7,opt,name=delete_cnt,json=deleteCnt,proto3" json:"delete_cnt,omitempty"`
+	UpsertCnt            int64            `protobuf:"varint,8,opt,name=upsert_cnt,json=upsertCnt,proto3" json:"upsert_cnt,omitempty"`
+	Timestamp            uint64           `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *MutationResult) Reset()         { *m = MutationResult{} }
+func (m *MutationResult) String() string { return proto.CompactTextString(m) }
+func (*MutationResult) ProtoMessage()    {}
+func (*MutationResult) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{53}
 }
 
-func (m *CalcDistanceResults) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CalcDistanceResults.Unmarshal(m, b)
+func (m *MutationResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MutationResult.Unmarshal(m, b)
 }
-func (m *CalcDistanceResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CalcDistanceResults.Marshal(b, m, deterministic)
+func (m *MutationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MutationResult.Marshal(b, m, deterministic)
 }
-func (m *CalcDistanceResults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CalcDistanceResults.Merge(m, src)
+func (m *MutationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MutationResult.Merge(m, src)
 }
-func (m *CalcDistanceResults) XXX_Size() int {
-	return xxx_messageInfo_CalcDistanceResults.Size(m)
+func (m *MutationResult) XXX_Size() int {
+	return xxx_messageInfo_MutationResult.Size(m)
 }
-func (m *CalcDistanceResults) XXX_DiscardUnknown() {
-	xxx_messageInfo_CalcDistanceResults.DiscardUnknown(m)
+func (m *MutationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MutationResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CalcDistanceResults proto.InternalMessageInfo
+var xxx_messageInfo_MutationResult proto.InternalMessageInfo
 
-func (m *CalcDistanceResults) GetStatus() *commonpb.Status {
+func (m *MutationResult) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-type isCalcDistanceResults_Array interface {
-	isCalcDistanceResults_Array()
-}
-
-type CalcDistanceResults_IntDist struct {
-	IntDist *schemapb.IntArray `protobuf:"bytes,2,opt,name=int_dist,json=intDist,proto3,oneof"`
-}
-
-type CalcDistanceResults_FloatDist struct {
-	FloatDist *schemapb.FloatArray `protobuf:"bytes,3,opt,name=float_dist,json=floatDist,proto3,oneof"`
-}
-
-func (*CalcDistanceResults_IntDist) isCalcDistanceResults_Array() {}
-
-func (*CalcDistanceResults_FloatDist) isCalcDistanceResults_Array() {}
-
-func (m *CalcDistanceResults) GetArray() isCalcDistanceResults_Array {
+func (m *MutationResult) GetIDs() *schemapb.IDs {
 	if m != nil {
-		return m.Array
+		return m.IDs
 	}
 	return nil
 }
 
-func (m *CalcDistanceResults) GetIntDist() *schemapb.IntArray {
-	if x, ok := m.GetArray().(*CalcDistanceResults_IntDist); ok {
-		return x.IntDist
+func (m *MutationResult) GetSuccIndex() []uint32 {
+	if m != nil {
+		return m.SuccIndex
 	}
 	return nil
 }
 
-func (m *CalcDistanceResults) GetFloatDist() *schemapb.FloatArray {
-	if x, ok := m.GetArray().(*CalcDistanceResults_FloatDist); ok {
-		return x.FloatDist
+func (m *MutationResult) GetErrIndex() []uint32 {
+	if m != nil {
+		return m.ErrIndex
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*CalcDistanceResults) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*CalcDistanceResults_IntDist)(nil),
-		(*CalcDistanceResults_FloatDist)(nil),
-	}
-}
-
-type PersistentSegmentInfo struct {
-	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	CollectionID         int64                 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64                 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	NumRows              int64                 `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	State                commonpb.SegmentState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
-}
-
-func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
-func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
-func (*PersistentSegmentInfo) ProtoMessage()    {}
-func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{54}
-}
-
-func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PersistentSegmentInfo.Unmarshal(m, b)
-}
-func (m *PersistentSegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PersistentSegmentInfo.Marshal(b, m, deterministic)
-}
-func (m *PersistentSegmentInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PersistentSegmentInfo.Merge(m, src)
-}
-func (m *PersistentSegmentInfo) XXX_Size() int {
-	return xxx_messageInfo_PersistentSegmentInfo.Size(m)
-}
-func (m *PersistentSegmentInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_PersistentSegmentInfo.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_PersistentSegmentInfo proto.InternalMessageInfo
-
-func (m *PersistentSegmentInfo) GetSegmentID() int64 {
+func (m *MutationResult) GetAcknowledged() bool {
 	if m != nil {
-		return m.SegmentID
+		return m.Acknowledged
 	}
-	return 0
+	return false
 }
 
-func (m *PersistentSegmentInfo) GetCollectionID() int64 {
+func (m *MutationResult) GetInsertCnt() int64 {
 	if m != nil {
-		return m.CollectionID
+		return m.InsertCnt
 	}
 	return 0
 }
 
-func (m *PersistentSegmentInfo) GetPartitionID() int64 {
+func (m *MutationResult) GetDeleteCnt() int64 {
 	if m != nil {
-		return m.PartitionID
+		return m.DeleteCnt
 	}
 	return 0
 }
 
-func (m *PersistentSegmentInfo) GetNumRows() int64 {
+func (m *MutationResult) GetUpsertCnt() int64 {
 	if m != nil {
-		return m.NumRows
+		return m.UpsertCnt
 	}
 	return 0
 }
 
-func (m *PersistentSegmentInfo) GetState() commonpb.SegmentState {
+func (m *MutationResult) GetTimestamp() uint64 {
 	if m != nil {
-		return m.State
+		return m.Timestamp
 	}
-	return commonpb.SegmentState_SegmentStateNone
+	return 0
 }
 
-type GetPersistentSegmentInfoRequest struct {
+type DeleteRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	Expr                 string            `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
+	HashKeys             []uint32          `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSegmentInfoRequest{} }
-func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
-func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{55}
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{54}
 }
 
-func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Unmarshal(m, b)
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Marshal(b, m, deterministic)
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPersistentSegmentInfoRequest.Merge(m, src)
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Size(m)
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPersistentSegmentInfoRequest.DiscardUnknown(m)
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPersistentSegmentInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
 
-func (m *GetPersistentSegmentInfoRequest) GetBase() *commonpb.MsgBase {
+func (m *DeleteRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *GetPersistentSegmentInfoRequest) GetDbName() string {
+func (m *DeleteRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *GetPersistentSegmentInfoRequest) GetCollectionName() string {
+func (m *DeleteRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-type GetPersistentSegmentInfoResponse struct {
-	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Infos                []*PersistentSegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *DeleteRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
 }
 
-func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentSegmentInfoResponse{} }
-func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
-func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{56}
+func (m *DeleteRequest) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
 }
 
-func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Unmarshal(m, b)
+func (m *DeleteRequest) GetHashKeys() []uint32 {
+	if m != nil {
+		return m.HashKeys
+	}
+	return nil
 }
-func (m *GetPersistentSegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Marshal(b, m, deterministic)
+
+type PlaceholderValue struct {
+	Tag  string          `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Type PlaceholderType `protobuf:"varint,2,opt,name=type,proto3,enum=milvus.proto.milvus.PlaceholderType" json:"type,omitempty"`
+	// values is a 2d-array, every array contains a vector
+	Values               [][]byte `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *GetPersistentSegmentInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPersistentSegmentInfoResponse.Merge(m, src)
+
+func (m *PlaceholderValue) Reset()         { *m = PlaceholderValue{} }
+func (m *PlaceholderValue) String() string { return proto.CompactTextString(m) }
+func (*PlaceholderValue) ProtoMessage()    {}
+func (*PlaceholderValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{55}
 }
-func (m *GetPersistentSegmentInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Size(m)
+
+func (m *PlaceholderValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlaceholderValue.Unmarshal(m, b)
 }
-func (m *GetPersistentSegmentInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPersistentSegmentInfoResponse.DiscardUnknown(m)
+func (m *PlaceholderValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlaceholderValue.Marshal(b, m, deterministic)
+}
+func (m *PlaceholderValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaceholderValue.Merge(m, src)
+}
+func (m *PlaceholderValue) XXX_Size() int {
+	return xxx_messageInfo_PlaceholderValue.Size(m)
+}
+func (m *PlaceholderValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaceholderValue.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPersistentSegmentInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_PlaceholderValue proto.InternalMessageInfo
 
-func (m *GetPersistentSegmentInfoResponse) GetStatus() *commonpb.Status {
+func (m *PlaceholderValue) GetTag() string {
 	if m != nil {
-		return m.Status
+		return m.Tag
 	}
-	return nil
+	return ""
 }
 
-func (m *GetPersistentSegmentInfoResponse) GetInfos() []*PersistentSegmentInfo {
+func (m *PlaceholderValue) GetType() PlaceholderType {
 	if m != nil {
-		return m.Infos
+		return m.Type
+	}
+	return PlaceholderType_None
+}
+
+func (m *PlaceholderValue) GetValues() [][]byte {
+	if m != nil {
+		return m.Values
 	}
 	return nil
 }
 
-type QuerySegmentInfo struct {
-	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	CollectionID         int64                 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64                 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	MemSize              int64                 `protobuf:"varint,4,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
-	NumRows              int64                 `protobuf:"varint,5,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	IndexName            string                `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	IndexID              int64                 `protobuf:"varint,7,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	NodeID               int64                 `protobuf:"varint,8,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	State                commonpb.SegmentState `protobuf:"varint,9,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type PlaceholderGroup struct {
+	Placeholders         []*PlaceholderValue `protobuf:"bytes,1,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *QuerySegmentInfo) Reset()         { *m = QuerySegmentInfo{} }
-func (m *QuerySegmentInfo) String() string { return proto.CompactTextString(m) }
-func (*QuerySegmentInfo) ProtoMessage()    {}
-func (*QuerySegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{57}
+func (m *PlaceholderGroup) Reset()         { *m = PlaceholderGroup{} }
+func (m *PlaceholderGroup) String() string { return proto.CompactTextString(m) }
+func (*PlaceholderGroup) ProtoMessage()    {}
+func (*PlaceholderGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{56}
 }
 
-func (m *QuerySegmentInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QuerySegmentInfo.Unmarshal(m, b)
+func (m *PlaceholderGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlaceholderGroup.Unmarshal(m, b)
 }
-func (m *QuerySegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QuerySegmentInfo.Marshal(b, m, deterministic)
+func (m *PlaceholderGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlaceholderGroup.Marshal(b, m, deterministic)
 }
-func (m *QuerySegmentInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySegmentInfo.Merge(m, src)
+func (m *PlaceholderGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaceholderGroup.Merge(m, src)
 }
-func (m *QuerySegmentInfo) XXX_Size() int {
-	return xxx_messageInfo_QuerySegmentInfo.Size(m)
+func (m *PlaceholderGroup) XXX_Size() int {
+	return xxx_messageInfo_PlaceholderGroup.Size(m)
 }
-func (m *QuerySegmentInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySegmentInfo.DiscardUnknown(m)
+func (m *PlaceholderGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaceholderGroup.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySegmentInfo proto.InternalMessageInfo
+var xxx_messageInfo_PlaceholderGroup proto.InternalMessageInfo
 
-func (m *QuerySegmentInfo) GetSegmentID() int64 {
+func (m *PlaceholderGroup) GetPlaceholders() []*PlaceholderValue {
 	if m != nil {
-		return m.SegmentID
+		return m.Placeholders
 	}
-	return 0
+	return nil
 }
 
-func (m *QuerySegmentInfo) GetCollectionID() int64 {
-	if m != nil {
-		return m.CollectionID
-	}
-	return 0
+type SearchRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	Dsl            string            `protobuf:"bytes,5,opt,name=dsl,proto3" json:"dsl,omitempty"`
+	// serialized `PlaceholderGroup`
+	PlaceholderGroup     []byte                   `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType              commonpb.DslType         `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	OutputFields         []string                 `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	SearchParams         []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	TravelTimestamp      uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp   uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	TemplateID           int64                    `protobuf:"varint,12,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	TemplateParams       string                   `protobuf:"bytes,13,opt,name=template_params,json=templateParams,proto3" json:"template_params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *QuerySegmentInfo) GetPartitionID() int64 {
-	if m != nil {
-		return m.PartitionID
-	}
-	return 0
+func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
+func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()    {}
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{57}
 }
 
-func (m *QuerySegmentInfo) GetMemSize() int64 {
+func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
+}
+func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchRequest.Merge(m, src)
+}
+func (m *SearchRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchRequest.Size(m)
+}
+func (m *SearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+
+func (m *SearchRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.MemSize
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *QuerySegmentInfo) GetNumRows() int64 {
+func (m *SearchRequest) GetDbName() string {
 	if m != nil {
-		return m.NumRows
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-func (m *QuerySegmentInfo) GetIndexName() string {
+func (m *SearchRequest) GetCollectionName() string {
 	if m != nil {
-		return m.IndexName
+		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *QuerySegmentInfo) GetIndexID() int64 {
+func (m *SearchRequest) GetPartitionNames() []string {
 	if m != nil {
-		return m.IndexID
+		return m.PartitionNames
 	}
-	return 0
+	return nil
 }
 
-func (m *QuerySegmentInfo) GetNodeID() int64 {
+func (m *SearchRequest) GetDsl() string {
 	if m != nil {
-		return m.NodeID
+		return m.Dsl
 	}
-	return 0
+	return ""
 }
 
-func (m *QuerySegmentInfo) GetState() commonpb.SegmentState {
+func (m *SearchRequest) GetPlaceholderGroup() []byte {
 	if m != nil {
-		return m.State
+		return m.PlaceholderGroup
 	}
-	return commonpb.SegmentState_SegmentStateNone
+	return nil
 }
 
-type GetQuerySegmentInfoRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *SearchRequest) GetDslType() commonpb.DslType {
+	if m != nil {
+		return m.DslType
+	}
+	return commonpb.DslType_Dsl
 }
 
-func (m *GetQuerySegmentInfoRequest) Reset()         { *m = GetQuerySegmentInfoRequest{} }
-func (m *GetQuerySegmentInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetQuerySegmentInfoRequest) ProtoMessage()    {}
-func (*GetQuerySegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{58}
+func (m *SearchRequest) GetOutputFields() []string {
+	if m != nil {
+		return m.OutputFields
+	}
+	return nil
 }
 
-func (m *GetQuerySegmentInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetQuerySegmentInfoRequest.Unmarshal(m, b)
-}
-func (m *GetQuerySegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetQuerySegmentInfoRequest.Marshal(b, m, deterministic)
-}
-func (m *GetQuerySegmentInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetQuerySegmentInfoRequest.Merge(m, src)
-}
-func (m *GetQuerySegmentInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_GetQuerySegmentInfoRequest.Size(m)
-}
-func (m *GetQuerySegmentInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetQuerySegmentInfoRequest.DiscardUnknown(m)
+func (m *SearchRequest) GetSearchParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.SearchParams
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetQuerySegmentInfoRequest proto.InternalMessageInfo
+func (m *SearchRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
+}
 
-func (m *GetQuerySegmentInfoRequest) GetBase() *commonpb.MsgBase {
+func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
 	if m != nil {
-		return m.Base
+		return m.GuaranteeTimestamp
 	}
-	return nil
+	return 0
 }
 
-func (m *GetQuerySegmentInfoRequest) GetDbName() string {
+func (m *SearchRequest) GetTemplateID() int64 {
 	if m != nil {
-		return m.DbName
+		return m.TemplateID
 	}
-	return ""
+	return 0
 }
 
-func (m *GetQuerySegmentInfoRequest) GetCollectionName() string {
+func (m *SearchRequest) GetTemplateParams() string {
 	if m != nil {
-		return m.CollectionName
+		return m.TemplateParams
 	}
 	return ""
 }
 
-type GetQuerySegmentInfoResponse struct {
-	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Infos                []*QuerySegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type Hits struct {
+	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
+	RowData              [][]byte  `protobuf:"bytes,2,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
+	Scores               []float32 `protobuf:"fixed32,3,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *GetQuerySegmentInfoResponse) Reset()         { *m = GetQuerySegmentInfoResponse{} }
-func (m *GetQuerySegmentInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetQuerySegmentInfoResponse) ProtoMessage()    {}
-func (*GetQuerySegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{59}
+func (m *Hits) Reset()         { *m = Hits{} }
+func (m *Hits) String() string { return proto.CompactTextString(m) }
+func (*Hits) ProtoMessage()    {}
+func (*Hits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{58}
 }
 
-func (m *GetQuerySegmentInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetQuerySegmentInfoResponse.Unmarshal(m, b)
+func (m *Hits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Hits.Unmarshal(m, b)
 }
-func (m *GetQuerySegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetQuerySegmentInfoResponse.Marshal(b, m, deterministic)
+func (m *Hits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Hits.Marshal(b, m, deterministic)
 }
-func (m *GetQuerySegmentInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetQuerySegmentInfoResponse.Merge(m, src)
+func (m *Hits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hits.Merge(m, src)
 }
-func (m *GetQuerySegmentInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_GetQuerySegmentInfoResponse.Size(m)
+func (m *Hits) XXX_Size() int {
+	return xxx_messageInfo_Hits.Size(m)
 }
-func (m *GetQuerySegmentInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetQuerySegmentInfoResponse.DiscardUnknown(m)
+func (m *Hits) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hits.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetQuerySegmentInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_Hits proto.InternalMessageInfo
 
-func (m *GetQuerySegmentInfoResponse) GetStatus() *commonpb.Status {
+func (m *Hits) GetIDs() []int64 {
 	if m != nil {
-		return m.Status
+		return m.IDs
 	}
 	return nil
 }
 
-func (m *GetQuerySegmentInfoResponse) GetInfos() []*QuerySegmentInfo {
+func (m *Hits) GetRowData() [][]byte {
 	if m != nil {
-		return m.Infos
+		return m.RowData
 	}
 	return nil
 }
 
-type DummyRequest struct {
-	RequestType          string   `protobuf:"bytes,1,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Hits) GetScores() []float32 {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
 }
 
-func (m *DummyRequest) Reset()         { *m = DummyRequest{} }
-func (m *DummyRequest) String() string { return proto.CompactTextString(m) }
-func (*DummyRequest) ProtoMessage()    {}
-func (*DummyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{60}
+type SearchResults struct {
+	Status               *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results              *schemapb.SearchResultData `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
+	CollectionName       string                     `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-func (m *DummyRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DummyRequest.Unmarshal(m, b)
+func (m *SearchResults) Reset()         { *m = SearchResults{} }
+func (m *SearchResults) String() string { return proto.CompactTextString(m) }
+func (*SearchResults) ProtoMessage()    {}
+func (*SearchResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{59}
 }
-func (m *DummyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DummyRequest.Marshal(b, m, deterministic)
+
+func (m *SearchResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResults.Unmarshal(m, b)
 }
-func (m *DummyRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DummyRequest.Merge(m, src)
+func (m *SearchResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResults.Marshal(b, m, deterministic)
 }
-func (m *DummyRequest) XXX_Size() int {
-	return xxx_messageInfo_DummyRequest.Size(m)
+func (m *SearchResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResults.Merge(m, src)
 }
-func (m *DummyRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DummyRequest.DiscardUnknown(m)
+func (m *SearchResults) XXX_Size() int {
+	return xxx_messageInfo_SearchResults.Size(m)
+}
+func (m *SearchResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResults.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DummyRequest proto.InternalMessageInfo
+var xxx_messageInfo_SearchResults proto.InternalMessageInfo
 
-func (m *DummyRequest) GetRequestType() string {
+func (m *SearchResults) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.RequestType
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SearchResults) GetResults() *schemapb.SearchResultData {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *SearchResults) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
 	}
 	return ""
 }
 
-type DummyResponse struct {
-	Response             string   `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type FlushRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionNames      []string          `protobuf:"bytes,3,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DummyResponse) Reset()         { *m = DummyResponse{} }
-func (m *DummyResponse) String() string { return proto.CompactTextString(m) }
-func (*DummyResponse) ProtoMessage()    {}
-func (*DummyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{61}
+func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
+func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
+func (*FlushRequest) ProtoMessage()    {}
+func (*FlushRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{60}
 }
 
-func (m *DummyResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DummyResponse.Unmarshal(m, b)
+func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlushRequest.Unmarshal(m, b)
 }
-func (m *DummyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DummyResponse.Marshal(b, m, deterministic)
+func (m *FlushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlushRequest.Marshal(b, m, deterministic)
 }
-func (m *DummyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DummyResponse.Merge(m, src)
+func (m *FlushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushRequest.Merge(m, src)
 }
-func (m *DummyResponse) XXX_Size() int {
-	return xxx_messageInfo_DummyResponse.Size(m)
+func (m *FlushRequest) XXX_Size() int {
+	return xxx_messageInfo_FlushRequest.Size(m)
 }
-func (m *DummyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DummyResponse.DiscardUnknown(m)
+func (m *FlushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DummyResponse proto.InternalMessageInfo
+var xxx_messageInfo_FlushRequest proto.InternalMessageInfo
 
-func (m *DummyResponse) GetResponse() string {
+func (m *FlushRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Response
+		return m.Base
 	}
-	return ""
+	return nil
 }
 
-type RegisterLinkRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *FlushRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
 }
 
-func (m *RegisterLinkRequest) Reset()         { *m = RegisterLinkRequest{} }
-func (m *RegisterLinkRequest) String() string { return proto.CompactTextString(m) }
-func (*RegisterLinkRequest) ProtoMessage()    {}
-func (*RegisterLinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{62}
-}
-
-func (m *RegisterLinkRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RegisterLinkRequest.Unmarshal(m, b)
-}
-func (m *RegisterLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RegisterLinkRequest.Marshal(b, m, deterministic)
-}
-func (m *RegisterLinkRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterLinkRequest.Merge(m, src)
-}
-func (m *RegisterLinkRequest) XXX_Size() int {
-	return xxx_messageInfo_RegisterLinkRequest.Size(m)
-}
-func (m *RegisterLinkRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterLinkRequest.DiscardUnknown(m)
+func (m *FlushRequest) GetCollectionNames() []string {
+	if m != nil {
+		return m.CollectionNames
+	}
+	return nil
 }
 
-var xxx_messageInfo_RegisterLinkRequest proto.InternalMessageInfo
-
-type RegisterLinkResponse struct {
-	Address              *commonpb.Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Status               *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type FlushResponse struct {
+	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DbName               string                         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollSegIDs           map[string]*schemapb.LongArray `protobuf:"bytes,3,rep,name=coll_segIDs,json=collSegIDs,proto3" json:"coll_segIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
-func (m *RegisterLinkResponse) Reset()         { *m = RegisterLinkResponse{} }
-func (m *RegisterLinkResponse) String() string { return proto.CompactTextString(m) }
-func (*RegisterLinkResponse) ProtoMessage()    {}
-func (*RegisterLinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{63}
+func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
+func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
+func (*FlushResponse) ProtoMessage()    {}
+func (*FlushResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{61}
 }
 
-func (m *RegisterLinkResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RegisterLinkResponse.Unmarshal(m, b)
+func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlushResponse.Unmarshal(m, b)
 }
-func (m *RegisterLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RegisterLinkResponse.Marshal(b, m, deterministic)
+func (m *FlushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlushResponse.Marshal(b, m, deterministic)
 }
-func (m *RegisterLinkResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterLinkResponse.Merge(m, src)
+func (m *FlushResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushResponse.Merge(m, src)
 }
-func (m *RegisterLinkResponse) XXX_Size() int {
-	return xxx_messageInfo_RegisterLinkResponse.Size(m)
+func (m *FlushResponse) XXX_Size() int {
+	return xxx_messageInfo_FlushResponse.Size(m)
 }
-func (m *RegisterLinkResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterLinkResponse.DiscardUnknown(m)
+func (m *FlushResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterLinkResponse proto.InternalMessageInfo
+var xxx_messageInfo_FlushResponse proto.InternalMessageInfo
 
-func (m *RegisterLinkResponse) GetAddress() *commonpb.Address {
+func (m *FlushResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Address
+		return m.Status
 	}
 	return nil
 }
 
-func (m *RegisterLinkResponse) GetStatus() *commonpb.Status {
+func (m *FlushResponse) GetDbName() string {
 	if m != nil {
-		return m.Status
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *FlushResponse) GetCollSegIDs() map[string]*schemapb.LongArray {
+	if m != nil {
+		return m.CollSegIDs
 	}
 	return nil
 }
 
-type GetMetricsRequest struct {
+type QueryRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Request              string            `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Expr                 string            `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	OutputFields         []string          `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	PartitionNames       []string          `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	TravelTimestamp      uint64            `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp   uint64            `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	TemplateID           int64             `protobuf:"varint,9,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	TemplateParams       string            `protobuf:"bytes,10,opt,name=template_params,json=templateParams,proto3" json:"template_params,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetMetricsRequest) Reset()         { *m = GetMetricsRequest{} }
-func (m *GetMetricsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetMetricsRequest) ProtoMessage()    {}
-func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{64}
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{62}
 }
 
-func (m *GetMetricsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMetricsRequest.Unmarshal(m, b)
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRequest.Unmarshal(m, b)
 }
-func (m *GetMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMetricsRequest.Marshal(b, m, deterministic)
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
 }
-func (m *GetMetricsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMetricsRequest.Merge(m, src)
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
 }
-func (m *GetMetricsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetMetricsRequest.Size(m)
+func (m *QueryRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRequest.Size(m)
 }
-func (m *GetMetricsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMetricsRequest.DiscardUnknown(m)
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMetricsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
 
-func (m *GetMetricsRequest) GetBase() *commonpb.MsgBase {
+func (m *QueryRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *GetMetricsRequest) GetRequest() string {
+func (m *QueryRequest) GetDbName() string {
 	if m != nil {
-		return m.Request
+		return m.DbName
 	}
 	return ""
 }
 
-type GetMetricsResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Response             string           `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
-	ComponentName        string           `protobuf:"bytes,3,opt,name=component_name,json=componentName,proto3" json:"component_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *QueryRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
 }
 
-func (m *GetMetricsResponse) Reset()         { *m = GetMetricsResponse{} }
-func (m *GetMetricsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetMetricsResponse) ProtoMessage()    {}
-func (*GetMetricsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{65}
+func (m *QueryRequest) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
 }
 
-func (m *GetMetricsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMetricsResponse.Unmarshal(m, b)
-}
-func (m *GetMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMetricsResponse.Marshal(b, m, deterministic)
-}
-func (m *GetMetricsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMetricsResponse.Merge(m, src)
-}
-func (m *GetMetricsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetMetricsResponse.Size(m)
+func (m *QueryRequest) GetOutputFields() []string {
+	if m != nil {
+		return m.OutputFields
+	}
+	return nil
 }
-func (m *GetMetricsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMetricsResponse.DiscardUnknown(m)
+
+func (m *QueryRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetMetricsResponse proto.InternalMessageInfo
+func (m *QueryRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
+}
 
-func (m *GetMetricsResponse) GetStatus() *commonpb.Status {
+func (m *QueryRequest) GetGuaranteeTimestamp() uint64 {
 	if m != nil {
-		return m.Status
+		return m.GuaranteeTimestamp
 	}
-	return nil
+	return 0
 }
 
-func (m *GetMetricsResponse) GetResponse() string {
+func (m *QueryRequest) GetTemplateID() int64 {
 	if m != nil {
-		return m.Response
+		return m.TemplateID
 	}
-	return ""
+	return 0
 }
 
-func (m *GetMetricsResponse) GetComponentName() string {
+func (m *QueryRequest) GetTemplateParams() string {
 	if m != nil {
-		return m.ComponentName
+		return m.TemplateParams
 	}
 	return ""
 }
 
-//
-// Do load balancing operation from src_nodeID to dst_nodeID.
-type LoadBalanceRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	SrcNodeID            int64             `protobuf:"varint,2,opt,name=src_nodeID,json=srcNodeID,proto3" json:"src_nodeID,omitempty"`
-	DstNodeIDs           []int64           `protobuf:"varint,3,rep,packed,name=dst_nodeIDs,json=dstNodeIDs,proto3" json:"dst_nodeIDs,omitempty"`
-	SealedSegmentIDs     []int64           `protobuf:"varint,4,rep,packed,name=sealed_segmentIDs,json=sealedSegmentIDs,proto3" json:"sealed_segmentIDs,omitempty"`
-	CollectionName       string            `protobuf:"bytes,5,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type QueryResults struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
-func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*LoadBalanceRequest) ProtoMessage()    {}
-func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{66}
+func (m *QueryResults) Reset()         { *m = QueryResults{} }
+func (m *QueryResults) String() string { return proto.CompactTextString(m) }
+func (*QueryResults) ProtoMessage()    {}
+func (*QueryResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{63}
 }
 
-func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoadBalanceRequest.Unmarshal(m, b)
+func (m *QueryResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryResults.Unmarshal(m, b)
 }
-func (m *LoadBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoadBalanceRequest.Marshal(b, m, deterministic)
+func (m *QueryResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryResults.Marshal(b, m, deterministic)
 }
-func (m *LoadBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoadBalanceRequest.Merge(m, src)
+func (m *QueryResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResults.Merge(m, src)
 }
-func (m *LoadBalanceRequest) XXX_Size() int {
-	return xxx_messageInfo_LoadBalanceRequest.Size(m)
+func (m *QueryResults) XXX_Size() int {
+	return xxx_messageInfo_QueryResults.Size(m)
 }
-func (m *LoadBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoadBalanceRequest.DiscardUnknown(m)
+func (m *QueryResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResults.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoadBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryResults proto.InternalMessageInfo
 
-func (m *LoadBalanceRequest) GetBase() *commonpb.MsgBase {
+func (m *QueryResults) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *LoadBalanceRequest) GetSrcNodeID() int64 {
+func (m *QueryResults) GetFieldsData() []*schemapb.FieldData {
 	if m != nil {
-		return m.SrcNodeID
-	}
-	return 0
-}
-
-func (m *LoadBalanceRequest) GetDstNodeIDs() []int64 {
-	if m != nil {
-		return m.DstNodeIDs
-	}
-	return nil
-}
-
-func (m *LoadBalanceRequest) GetSealedSegmentIDs() []int64 {
-	if m != nil {
-		return m.SealedSegmentIDs
+		return m.FieldsData
 	}
 	return nil
 }
 
-func (m *LoadBalanceRequest) GetCollectionName() string {
+func (m *QueryResults) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-type ManualCompactionRequest struct {
-	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	Timetravel           uint64   `protobuf:"varint,2,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type RegisterTemplateRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Expr                 string            `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	AnnsField            string            `protobuf:"bytes,5,opt,name=anns_field,json=annsField,proto3" json:"anns_field,omitempty"`
+	OutputFields         []string          `protobuf:"bytes,6,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ManualCompactionRequest) Reset()         { *m = ManualCompactionRequest{} }
-func (m *ManualCompactionRequest) String() string { return proto.CompactTextString(m) }
-func (*ManualCompactionRequest) ProtoMessage()    {}
-func (*ManualCompactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{67}
+func (m *RegisterTemplateRequest) Reset()         { *m = RegisterTemplateRequest{} }
+func (m *RegisterTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterTemplateRequest) ProtoMessage()    {}
+func (*RegisterTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{64}
 }
 
-func (m *ManualCompactionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ManualCompactionRequest.Unmarshal(m, b)
+func (m *RegisterTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterTemplateRequest.Unmarshal(m, b)
 }
-func (m *ManualCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ManualCompactionRequest.Marshal(b, m, deterministic)
+func (m *RegisterTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterTemplateRequest.Marshal(b, m, deterministic)
 }
-func (m *ManualCompactionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ManualCompactionRequest.Merge(m, src)
+func (m *RegisterTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterTemplateRequest.Merge(m, src)
 }
-func (m *ManualCompactionRequest) XXX_Size() int {
-	return xxx_messageInfo_ManualCompactionRequest.Size(m)
+func (m *RegisterTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterTemplateRequest.Size(m)
 }
-func (m *ManualCompactionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ManualCompactionRequest.DiscardUnknown(m)
+func (m *RegisterTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterTemplateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ManualCompactionRequest proto.InternalMessageInfo
+var xxx_messageInfo_RegisterTemplateRequest proto.InternalMessageInfo
 
-func (m *ManualCompactionRequest) GetCollectionID() int64 {
+func (m *RegisterTemplateRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.CollectionID
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *ManualCompactionRequest) GetTimetravel() uint64 {
+func (m *RegisterTemplateRequest) GetDbName() string {
 	if m != nil {
-		return m.Timetravel
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-type ManualCompactionResponse struct {
+func (m *RegisterTemplateRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *RegisterTemplateRequest) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+func (m *RegisterTemplateRequest) GetAnnsField() string {
+	if m != nil {
+		return m.AnnsField
+	}
+	return ""
+}
+
+func (m *RegisterTemplateRequest) GetOutputFields() []string {
+	if m != nil {
+		return m.OutputFields
+	}
+	return nil
+}
+
+type RegisterTemplateResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	CompactionID         int64            `protobuf:"varint,2,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	TemplateID           int64            `protobuf:"varint,2,opt,name=templateID,proto3" json:"templateID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *ManualCompactionResponse) Reset()         { *m = ManualCompactionResponse{} }
-func (m *ManualCompactionResponse) String() string { return proto.CompactTextString(m) }
-func (*ManualCompactionResponse) ProtoMessage()    {}
-func (*ManualCompactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{68}
+func (m *RegisterTemplateResponse) Reset()         { *m = RegisterTemplateResponse{} }
+func (m *RegisterTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterTemplateResponse) ProtoMessage()    {}
+func (*RegisterTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{65}
 }
 
-func (m *ManualCompactionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ManualCompactionResponse.Unmarshal(m, b)
+func (m *RegisterTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterTemplateResponse.Unmarshal(m, b)
 }
-func (m *ManualCompactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ManualCompactionResponse.Marshal(b, m, deterministic)
+func (m *RegisterTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterTemplateResponse.Marshal(b, m, deterministic)
 }
-func (m *ManualCompactionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ManualCompactionResponse.Merge(m, src)
+func (m *RegisterTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterTemplateResponse.Merge(m, src)
 }
-func (m *ManualCompactionResponse) XXX_Size() int {
-	return xxx_messageInfo_ManualCompactionResponse.Size(m)
+func (m *RegisterTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_RegisterTemplateResponse.Size(m)
 }
-func (m *ManualCompactionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ManualCompactionResponse.DiscardUnknown(m)
+func (m *RegisterTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterTemplateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ManualCompactionResponse proto.InternalMessageInfo
+var xxx_messageInfo_RegisterTemplateResponse proto.InternalMessageInfo
 
-func (m *ManualCompactionResponse) GetStatus() *commonpb.Status {
+func (m *RegisterTemplateResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *ManualCompactionResponse) GetCompactionID() int64 {
+func (m *RegisterTemplateResponse) GetTemplateID() int64 {
 	if m != nil {
-		return m.CompactionID
+		return m.TemplateID
 	}
 	return 0
 }
 
-type GetCompactionStateRequest struct {
-	CompactionID         int64    `protobuf:"varint,1,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type DropTemplateRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TemplateID           int64             `protobuf:"varint,2,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	DbName               string            `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,4,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetCompactionStateRequest) Reset()         { *m = GetCompactionStateRequest{} }
-func (m *GetCompactionStateRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCompactionStateRequest) ProtoMessage()    {}
-func (*GetCompactionStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{69}
+func (m *DropTemplateRequest) Reset()         { *m = DropTemplateRequest{} }
+func (m *DropTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*DropTemplateRequest) ProtoMessage()    {}
+func (*DropTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{66}
 }
 
-func (m *GetCompactionStateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCompactionStateRequest.Unmarshal(m, b)
+func (m *DropTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropTemplateRequest.Unmarshal(m, b)
 }
-func (m *GetCompactionStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCompactionStateRequest.Marshal(b, m, deterministic)
+func (m *DropTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropTemplateRequest.Marshal(b, m, deterministic)
 }
-func (m *GetCompactionStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCompactionStateRequest.Merge(m, src)
+func (m *DropTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropTemplateRequest.Merge(m, src)
 }
-func (m *GetCompactionStateRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCompactionStateRequest.Size(m)
+func (m *DropTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_DropTemplateRequest.Size(m)
 }
-func (m *GetCompactionStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCompactionStateRequest.DiscardUnknown(m)
+func (m *DropTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropTemplateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCompactionStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_DropTemplateRequest proto.InternalMessageInfo
 
-func (m *GetCompactionStateRequest) GetCompactionID() int64 {
+func (m *DropTemplateRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.CompactionID
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropTemplateRequest) GetTemplateID() int64 {
+	if m != nil {
+		return m.TemplateID
 	}
 	return 0
 }
 
-type GetCompactionStateResponse struct {
-	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	State                commonpb.CompactionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.CompactionState" json:"state,omitempty"`
-	ExecutingPlanNo      int64                    `protobuf:"varint,3,opt,name=executingPlanNo,proto3" json:"executingPlanNo,omitempty"`
-	TimeoutPlanNo        int64                    `protobuf:"varint,4,opt,name=timeoutPlanNo,proto3" json:"timeoutPlanNo,omitempty"`
-	CompletedPlanNo      int64                    `protobuf:"varint,5,opt,name=completedPlanNo,proto3" json:"completedPlanNo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *DropTemplateRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
 }
 
-func (m *GetCompactionStateResponse) Reset()         { *m = GetCompactionStateResponse{} }
-func (m *GetCompactionStateResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCompactionStateResponse) ProtoMessage()    {}
-func (*GetCompactionStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{70}
+func (m *DropTemplateRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
 }
 
-func (m *GetCompactionStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCompactionStateResponse.Unmarshal(m, b)
+//*
+// Explain the plan of a search or query without executing it, it's a search if anns_field is set
+type ExplainRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	Expr           string            `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
+	AnnsField      string            `protobuf:"bytes,6,opt,name=anns_field,json=annsField,proto3" json:"anns_field,omitempty"`
+	Topk           int64             `protobuf:"varint,7,opt,name=topk,proto3" json:"topk,omitempty"`
+	Nq             int64             `protobuf:"varint,8,opt,name=nq,proto3" json:"nq,omitempty"`
+	// the index specific params of search, such as {"nprobe": 10}
+	SearchParams         string   `protobuf:"bytes,9,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *GetCompactionStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCompactionStateResponse.Marshal(b, m, deterministic)
+
+func (m *ExplainRequest) Reset()         { *m = ExplainRequest{} }
+func (m *ExplainRequest) String() string { return proto.CompactTextString(m) }
+func (*ExplainRequest) ProtoMessage()    {}
+func (*ExplainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{67}
 }
-func (m *GetCompactionStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCompactionStateResponse.Merge(m, src)
+
+func (m *ExplainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainRequest.Unmarshal(m, b)
 }
-func (m *GetCompactionStateResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCompactionStateResponse.Size(m)
+func (m *ExplainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainRequest.Marshal(b, m, deterministic)
 }
-func (m *GetCompactionStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCompactionStateResponse.DiscardUnknown(m)
+func (m *ExplainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainRequest.Merge(m, src)
+}
+func (m *ExplainRequest) XXX_Size() int {
+	return xxx_messageInfo_ExplainRequest.Size(m)
+}
+func (m *ExplainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCompactionStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExplainRequest proto.InternalMessageInfo
 
-func (m *GetCompactionStateResponse) GetStatus() *commonpb.Status {
+func (m *ExplainRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *GetCompactionStateResponse) GetState() commonpb.CompactionState {
+func (m *ExplainRequest) GetDbName() string {
 	if m != nil {
-		return m.State
+		return m.DbName
 	}
-	return commonpb.CompactionState_UndefiedState
+	return ""
 }
 
-func (m *GetCompactionStateResponse) GetExecutingPlanNo() int64 {
+func (m *ExplainRequest) GetCollectionName() string {
 	if m != nil {
-		return m.ExecutingPlanNo
+		return m.CollectionName
 	}
-	return 0
+	return ""
 }
 
-func (m *GetCompactionStateResponse) GetTimeoutPlanNo() int64 {
+func (m *ExplainRequest) GetPartitionNames() []string {
 	if m != nil {
-		return m.TimeoutPlanNo
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *ExplainRequest) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+func (m *ExplainRequest) GetAnnsField() string {
+	if m != nil {
+		return m.AnnsField
+	}
+	return ""
+}
+
+func (m *ExplainRequest) GetTopk() int64 {
+	if m != nil {
+		return m.Topk
 	}
 	return 0
 }
 
-func (m *GetCompactionStateResponse) GetCompletedPlanNo() int64 {
+func (m *ExplainRequest) GetNq() int64 {
 	if m != nil {
-		return m.CompletedPlanNo
+		return m.Nq
 	}
 	return 0
 }
 
-type GetCompactionPlansRequest struct {
-	CompactionID         int64    `protobuf:"varint,1,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ExplainRequest) GetSearchParams() string {
+	if m != nil {
+		return m.SearchParams
+	}
+	return ""
 }
 
-func (m *GetCompactionPlansRequest) Reset()         { *m = GetCompactionPlansRequest{} }
-func (m *GetCompactionPlansRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCompactionPlansRequest) ProtoMessage()    {}
-func (*GetCompactionPlansRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{71}
+type ExplainedSegment struct {
+	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	PartitionID          int64                 `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	NodeIds              []int64               `protobuf:"varint,3,rep,packed,name=nodeIds,proto3" json:"nodeIds,omitempty"`
+	State                commonpb.SegmentState `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
+	NumRows              int64                 `protobuf:"varint,5,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	IndexName            string                `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID              int64                 `protobuf:"varint,7,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	Scan                 SegmentScan           `protobuf:"varint,8,opt,name=scan,proto3,enum=milvus.proto.milvus.SegmentScan" json:"scan,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *GetCompactionPlansRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCompactionPlansRequest.Unmarshal(m, b)
+func (m *ExplainedSegment) Reset()         { *m = ExplainedSegment{} }
+func (m *ExplainedSegment) String() string { return proto.CompactTextString(m) }
+func (*ExplainedSegment) ProtoMessage()    {}
+func (*ExplainedSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{68}
 }
-func (m *GetCompactionPlansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCompactionPlansRequest.Marshal(b, m, deterministic)
+
+func (m *ExplainedSegment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainedSegment.Unmarshal(m, b)
 }
-func (m *GetCompactionPlansRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCompactionPlansRequest.Merge(m, src)
+func (m *ExplainedSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainedSegment.Marshal(b, m, deterministic)
 }
-func (m *GetCompactionPlansRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCompactionPlansRequest.Size(m)
+func (m *ExplainedSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainedSegment.Merge(m, src)
 }
-func (m *GetCompactionPlansRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCompactionPlansRequest.DiscardUnknown(m)
+func (m *ExplainedSegment) XXX_Size() int {
+	return xxx_messageInfo_ExplainedSegment.Size(m)
+}
+func (m *ExplainedSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainedSegment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCompactionPlansRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExplainedSegment proto.InternalMessageInfo
 
-func (m *GetCompactionPlansRequest) GetCompactionID() int64 {
+func (m *ExplainedSegment) GetSegmentID() int64 {
 	if m != nil {
-		return m.CompactionID
+		return m.SegmentID
 	}
 	return 0
 }
 
-type GetCompactionPlansResponse struct {
-	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	State                commonpb.CompactionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.CompactionState" json:"state,omitempty"`
-	MergeInfos           []*CompactionMergeInfo   `protobuf:"bytes,3,rep,name=mergeInfos,proto3" json:"mergeInfos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *ExplainedSegment) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
 }
 
-func (m *GetCompactionPlansResponse) Reset()         { *m = GetCompactionPlansResponse{} }
-func (m *GetCompactionPlansResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCompactionPlansResponse) ProtoMessage()    {}
-func (*GetCompactionPlansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{72}
+func (m *ExplainedSegment) GetNodeIds() []int64 {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
 }
 
-func (m *GetCompactionPlansResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCompactionPlansResponse.Unmarshal(m, b)
-}
-func (m *GetCompactionPlansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCompactionPlansResponse.Marshal(b, m, deterministic)
-}
-func (m *GetCompactionPlansResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCompactionPlansResponse.Merge(m, src)
-}
-func (m *GetCompactionPlansResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCompactionPlansResponse.Size(m)
-}
-func (m *GetCompactionPlansResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCompactionPlansResponse.DiscardUnknown(m)
+func (m *ExplainedSegment) GetState() commonpb.SegmentState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.SegmentState_SegmentStateNone
 }
 
-var xxx_messageInfo_GetCompactionPlansResponse proto.InternalMessageInfo
+func (m *ExplainedSegment) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
 
-func (m *GetCompactionPlansResponse) GetStatus() *commonpb.Status {
+func (m *ExplainedSegment) GetIndexName() string {
 	if m != nil {
-		return m.Status
+		return m.IndexName
 	}
-	return nil
+	return ""
 }
 
-func (m *GetCompactionPlansResponse) GetState() commonpb.CompactionState {
+func (m *ExplainedSegment) GetIndexID() int64 {
 	if m != nil {
-		return m.State
+		return m.IndexID
 	}
-	return commonpb.CompactionState_UndefiedState
+	return 0
 }
 
-func (m *GetCompactionPlansResponse) GetMergeInfos() []*CompactionMergeInfo {
+func (m *ExplainedSegment) GetScan() SegmentScan {
 	if m != nil {
-		return m.MergeInfos
+		return m.Scan
 	}
-	return nil
+	return SegmentScan_ScanByFilter
 }
 
-type CompactionMergeInfo struct {
-	Sources              []int64  `protobuf:"varint,1,rep,packed,name=sources,proto3" json:"sources,omitempty"`
-	Target               int64    `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+type ExplainCost struct {
+	Segments        int64 `protobuf:"varint,1,opt,name=segments,proto3" json:"segments,omitempty"`
+	Rows            int64 `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	IndexedSegments int64 `protobuf:"varint,3,opt,name=indexed_segments,json=indexedSegments,proto3" json:"indexed_segments,omitempty"`
+	BruteForceRows  int64 `protobuf:"varint,4,opt,name=brute_force_rows,json=bruteForceRows,proto3" json:"brute_force_rows,omitempty"`
+	// the number of distances computed by brute force, nq * brute_force_rows
+	Distances            int64    `protobuf:"varint,5,opt,name=distances,proto3" json:"distances,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CompactionMergeInfo) Reset()         { *m = CompactionMergeInfo{} }
-func (m *CompactionMergeInfo) String() string { return proto.CompactTextString(m) }
-func (*CompactionMergeInfo) ProtoMessage()    {}
-func (*CompactionMergeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{73}
+func (m *ExplainCost) Reset()         { *m = ExplainCost{} }
+func (m *ExplainCost) String() string { return proto.CompactTextString(m) }
+func (*ExplainCost) ProtoMessage()    {}
+func (*ExplainCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{69}
 }
 
-func (m *CompactionMergeInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CompactionMergeInfo.Unmarshal(m, b)
+func (m *ExplainCost) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainCost.Unmarshal(m, b)
 }
-func (m *CompactionMergeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CompactionMergeInfo.Marshal(b, m, deterministic)
+func (m *ExplainCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainCost.Marshal(b, m, deterministic)
 }
-func (m *CompactionMergeInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CompactionMergeInfo.Merge(m, src)
+func (m *ExplainCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainCost.Merge(m, src)
 }
-func (m *CompactionMergeInfo) XXX_Size() int {
-	return xxx_messageInfo_CompactionMergeInfo.Size(m)
+func (m *ExplainCost) XXX_Size() int {
+	return xxx_messageInfo_ExplainCost.Size(m)
 }
-func (m *CompactionMergeInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_CompactionMergeInfo.DiscardUnknown(m)
+func (m *ExplainCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainCost.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CompactionMergeInfo proto.InternalMessageInfo
+var xxx_messageInfo_ExplainCost proto.InternalMessageInfo
 
-func (m *CompactionMergeInfo) GetSources() []int64 {
+func (m *ExplainCost) GetSegments() int64 {
 	if m != nil {
-		return m.Sources
+		return m.Segments
 	}
-	return nil
+	return 0
 }
 
-func (m *CompactionMergeInfo) GetTarget() int64 {
+func (m *ExplainCost) GetRows() int64 {
 	if m != nil {
-		return m.Target
+		return m.Rows
 	}
 	return 0
 }
 
-type GetFlushStateRequest struct {
-	SegmentIDs           []int64  `protobuf:"varint,1,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ExplainCost) GetIndexedSegments() int64 {
+	if m != nil {
+		return m.IndexedSegments
+	}
+	return 0
 }
 
-func (m *GetFlushStateRequest) Reset()         { *m = GetFlushStateRequest{} }
-func (m *GetFlushStateRequest) String() string { return proto.CompactTextString(m) }
-func (*GetFlushStateRequest) ProtoMessage()    {}
-func (*GetFlushStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{74}
+func (m *ExplainCost) GetBruteForceRows() int64 {
+	if m != nil {
+		return m.BruteForceRows
+	}
+	return 0
 }
 
-func (m *GetFlushStateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetFlushStateRequest.Unmarshal(m, b)
+func (m *ExplainCost) GetDistances() int64 {
+	if m != nil {
+		return m.Distances
+	}
+	return 0
 }
-func (m *GetFlushStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetFlushStateRequest.Marshal(b, m, deterministic)
+
+type ExplainResponse struct {
+	Status       *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CollectionID int64            `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	IsSearch     bool             `protobuf:"varint,3,opt,name=is_search,json=isSearch,proto3" json:"is_search,omitempty"`
+	// the plan with the expression AST in protobuf text format
+	Plan                 string              `protobuf:"bytes,4,opt,name=plan,proto3" json:"plan,omitempty"`
+	Segments             []*ExplainedSegment `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
+	EstimatedCost        *ExplainCost        `protobuf:"bytes,6,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
-func (m *GetFlushStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFlushStateRequest.Merge(m, src)
+
+func (m *ExplainResponse) Reset()         { *m = ExplainResponse{} }
+func (m *ExplainResponse) String() string { return proto.CompactTextString(m) }
+func (*ExplainResponse) ProtoMessage()    {}
+func (*ExplainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{70}
 }
-func (m *GetFlushStateRequest) XXX_Size() int {
-	return xxx_messageInfo_GetFlushStateRequest.Size(m)
+
+func (m *ExplainResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainResponse.Unmarshal(m, b)
 }
-func (m *GetFlushStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFlushStateRequest.DiscardUnknown(m)
+func (m *ExplainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainResponse.Marshal(b, m, deterministic)
+}
+func (m *ExplainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainResponse.Merge(m, src)
+}
+func (m *ExplainResponse) XXX_Size() int {
+	return xxx_messageInfo_ExplainResponse.Size(m)
+}
+func (m *ExplainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFlushStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExplainResponse proto.InternalMessageInfo
 
-func (m *GetFlushStateRequest) GetSegmentIDs() []int64 {
+func (m *ExplainResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.SegmentIDs
+		return m.Status
 	}
 	return nil
 }
 
-type GetFlushStateResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Flushed              bool             `protobuf:"varint,2,opt,name=flushed,proto3" json:"flushed,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *ExplainResponse) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
 }
 
-func (m *GetFlushStateResponse) Reset()         { *m = GetFlushStateResponse{} }
-func (m *GetFlushStateResponse) String() string { return proto.CompactTextString(m) }
-func (*GetFlushStateResponse) ProtoMessage()    {}
-func (*GetFlushStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{75}
+func (m *ExplainResponse) GetIsSearch() bool {
+	if m != nil {
+		return m.IsSearch
+	}
+	return false
 }
 
-func (m *GetFlushStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetFlushStateResponse.Unmarshal(m, b)
-}
-func (m *GetFlushStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetFlushStateResponse.Marshal(b, m, deterministic)
-}
-func (m *GetFlushStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFlushStateResponse.Merge(m, src)
-}
-func (m *GetFlushStateResponse) XXX_Size() int {
-	return xxx_messageInfo_GetFlushStateResponse.Size(m)
-}
-func (m *GetFlushStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFlushStateResponse.DiscardUnknown(m)
+func (m *ExplainResponse) GetPlan() string {
+	if m != nil {
+		return m.Plan
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetFlushStateResponse proto.InternalMessageInfo
-
-func (m *GetFlushStateResponse) GetStatus() *commonpb.Status {
+func (m *ExplainResponse) GetSegments() []*ExplainedSegment {
 	if m != nil {
-		return m.Status
+		return m.Segments
 	}
 	return nil
 }
 
-func (m *GetFlushStateResponse) GetFlushed() bool {
+func (m *ExplainResponse) GetEstimatedCost() *ExplainCost {
 	if m != nil {
-		return m.Flushed
+		return m.EstimatedCost
 	}
-	return false
+	return nil
 }
 
-type ImportRequest struct {
-	CollectionName       string                   `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string                   `protobuf:"bytes,2,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	ChannelNames         []string                 `protobuf:"bytes,3,rep,name=channel_names,json=channelNames,proto3" json:"channel_names,omitempty"`
-	RowBased             bool                     `protobuf:"varint,4,opt,name=row_based,json=rowBased,proto3" json:"row_based,omitempty"`
-	Files                []string                 `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
-	Options              []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+type VectorIDs struct {
+	CollectionName       string        `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FieldName            string        `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IdArray              *schemapb.IDs `protobuf:"bytes,3,opt,name=id_array,json=idArray,proto3" json:"id_array,omitempty"`
+	PartitionNames       []string      `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ImportRequest) Reset()         { *m = ImportRequest{} }
-func (m *ImportRequest) String() string { return proto.CompactTextString(m) }
-func (*ImportRequest) ProtoMessage()    {}
-func (*ImportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{76}
+func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
+func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
+func (*VectorIDs) ProtoMessage()    {}
+func (*VectorIDs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{71}
 }
 
-func (m *ImportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ImportRequest.Unmarshal(m, b)
+func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VectorIDs.Unmarshal(m, b)
 }
-func (m *ImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ImportRequest.Marshal(b, m, deterministic)
+func (m *VectorIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VectorIDs.Marshal(b, m, deterministic)
 }
-func (m *ImportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImportRequest.Merge(m, src)
+func (m *VectorIDs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VectorIDs.Merge(m, src)
 }
-func (m *ImportRequest) XXX_Size() int {
-	return xxx_messageInfo_ImportRequest.Size(m)
+func (m *VectorIDs) XXX_Size() int {
+	return xxx_messageInfo_VectorIDs.Size(m)
 }
-func (m *ImportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImportRequest.DiscardUnknown(m)
+func (m *VectorIDs) XXX_DiscardUnknown() {
+	xxx_messageInfo_VectorIDs.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImportRequest proto.InternalMessageInfo
+var xxx_messageInfo_VectorIDs proto.InternalMessageInfo
 
-func (m *ImportRequest) GetCollectionName() string {
+func (m *VectorIDs) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *ImportRequest) GetPartitionName() string {
+func (m *VectorIDs) GetFieldName() string {
 	if m != nil {
-		return m.PartitionName
+		return m.FieldName
 	}
 	return ""
 }
 
-func (m *ImportRequest) GetChannelNames() []string {
+func (m *VectorIDs) GetIdArray() *schemapb.IDs {
 	if m != nil {
-		return m.ChannelNames
+		return m.IdArray
 	}
 	return nil
 }
 
-func (m *ImportRequest) GetRowBased() bool {
+func (m *VectorIDs) GetPartitionNames() []string {
 	if m != nil {
-		return m.RowBased
+		return m.PartitionNames
 	}
-	return false
+	return nil
 }
 
-func (m *ImportRequest) GetFiles() []string {
-	if m != nil {
-		return m.Files
-	}
-	return nil
+type VectorsArray struct {
+	// Types that are valid to be assigned to Array:
+	//	*VectorsArray_IdArray
+	//	*VectorsArray_DataArray
+	Array                isVectorsArray_Array `protobuf_oneof:"array"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *ImportRequest) GetOptions() []*commonpb.KeyValuePair {
-	if m != nil {
-		return m.Options
-	}
-	return nil
+func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
+func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
+func (*VectorsArray) ProtoMessage()    {}
+func (*VectorsArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{72}
 }
 
-type ImportResponse struct {
+func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VectorsArray.Unmarshal(m, b)
+}
+func (m *VectorsArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VectorsArray.Marshal(b, m, deterministic)
+}
+func (m *VectorsArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VectorsArray.Merge(m, src)
+}
+func (m *VectorsArray) XXX_Size() int {
+	return xxx_messageInfo_VectorsArray.Size(m)
+}
+func (m *VectorsArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_VectorsArray.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VectorsArray proto.InternalMessageInfo
+
+type isVectorsArray_Array interface {
+	isVectorsArray_Array()
+}
+
+type VectorsArray_IdArray struct {
+	IdArray *VectorIDs `protobuf:"bytes,1,opt,name=id_array,json=idArray,proto3,oneof"`
+}
+
+type VectorsArray_DataArray struct {
+	DataArray *schemapb.VectorField `protobuf:"bytes,2,opt,name=data_array,json=dataArray,proto3,oneof"`
+}
+
+func (*VectorsArray_IdArray) isVectorsArray_Array() {}
+
+func (*VectorsArray_DataArray) isVectorsArray_Array() {}
+
+func (m *VectorsArray) GetArray() isVectorsArray_Array {
+	if m != nil {
+		return m.Array
+	}
+	return nil
+}
+
+func (m *VectorsArray) GetIdArray() *VectorIDs {
+	if x, ok := m.GetArray().(*VectorsArray_IdArray); ok {
+		return x.IdArray
+	}
+	return nil
+}
+
+func (m *VectorsArray) GetDataArray() *schemapb.VectorField {
+	if x, ok := m.GetArray().(*VectorsArray_DataArray); ok {
+		return x.DataArray
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*VectorsArray) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*VectorsArray_IdArray)(nil),
+		(*VectorsArray_DataArray)(nil),
+	}
+}
+
+type CalcDistanceRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	OpLeft               *VectorsArray            `protobuf:"bytes,2,opt,name=op_left,json=opLeft,proto3" json:"op_left,omitempty"`
+	OpRight              *VectorsArray            `protobuf:"bytes,3,opt,name=op_right,json=opRight,proto3" json:"op_right,omitempty"`
+	Params               []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *CalcDistanceRequest) Reset()         { *m = CalcDistanceRequest{} }
+func (m *CalcDistanceRequest) String() string { return proto.CompactTextString(m) }
+func (*CalcDistanceRequest) ProtoMessage()    {}
+func (*CalcDistanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{73}
+}
+
+func (m *CalcDistanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CalcDistanceRequest.Unmarshal(m, b)
+}
+func (m *CalcDistanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CalcDistanceRequest.Marshal(b, m, deterministic)
+}
+func (m *CalcDistanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalcDistanceRequest.Merge(m, src)
+}
+func (m *CalcDistanceRequest) XXX_Size() int {
+	return xxx_messageInfo_CalcDistanceRequest.Size(m)
+}
+func (m *CalcDistanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalcDistanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalcDistanceRequest proto.InternalMessageInfo
+
+func (m *CalcDistanceRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CalcDistanceRequest) GetOpLeft() *VectorsArray {
+	if m != nil {
+		return m.OpLeft
+	}
+	return nil
+}
+
+func (m *CalcDistanceRequest) GetOpRight() *VectorsArray {
+	if m != nil {
+		return m.OpRight
+	}
+	return nil
+}
+
+func (m *CalcDistanceRequest) GetParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type CalcDistanceResults struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// num(op_left)*num(op_right) distance values, "HAMMIN" return integer distance
+	//
+	// Types that are valid to be assigned to Array:
+	//	*CalcDistanceResults_IntDist
+	//	*CalcDistanceResults_FloatDist
+	Array                isCalcDistanceResults_Array `protobuf_oneof:"array"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *CalcDistanceResults) Reset()         { *m = CalcDistanceResults{} }
+func (m *CalcDistanceResults) String() string { return proto.CompactTextString(m) }
+func (*CalcDistanceResults) ProtoMessage()    {}
+func (*CalcDistanceResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{74}
+}
+
+func (m *CalcDistanceResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CalcDistanceResults.Unmarshal(m, b)
+}
+func (m *CalcDistanceResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CalcDistanceResults.Marshal(b, m, deterministic)
+}
+func (m *CalcDistanceResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalcDistanceResults.Merge(m, src)
+}
+func (m *CalcDistanceResults) XXX_Size() int {
+	return xxx_messageInfo_CalcDistanceResults.Size(m)
+}
+func (m *CalcDistanceResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalcDistanceResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalcDistanceResults proto.InternalMessageInfo
+
+func (m *CalcDistanceResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type isCalcDistanceResults_Array interface {
+	isCalcDistanceResults_Array()
+}
+
+type CalcDistanceResults_IntDist struct {
+	IntDist *schemapb.IntArray `protobuf:"bytes,2,opt,name=int_dist,json=intDist,proto3,oneof"`
+}
+
+type CalcDistanceResults_FloatDist struct {
+	FloatDist *schemapb.FloatArray `protobuf:"bytes,3,opt,name=float_dist,json=floatDist,proto3,oneof"`
+}
+
+func (*CalcDistanceResults_IntDist) isCalcDistanceResults_Array() {}
+
+func (*CalcDistanceResults_FloatDist) isCalcDistanceResults_Array() {}
+
+func (m *CalcDistanceResults) GetArray() isCalcDistanceResults_Array {
+	if m != nil {
+		return m.Array
+	}
+	return nil
+}
+
+func (m *CalcDistanceResults) GetIntDist() *schemapb.IntArray {
+	if x, ok := m.GetArray().(*CalcDistanceResults_IntDist); ok {
+		return x.IntDist
+	}
+	return nil
+}
+
+func (m *CalcDistanceResults) GetFloatDist() *schemapb.FloatArray {
+	if x, ok := m.GetArray().(*CalcDistanceResults_FloatDist); ok {
+		return x.FloatDist
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*CalcDistanceResults) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*CalcDistanceResults_IntDist)(nil),
+		(*CalcDistanceResults_FloatDist)(nil),
+	}
+}
+
+type PersistentSegmentInfo struct {
+	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID         int64                 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64                 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	NumRows              int64                 `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	State                commonpb.SegmentState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
+func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
+func (*PersistentSegmentInfo) ProtoMessage()    {}
+func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{75}
+}
+
+func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PersistentSegmentInfo.Unmarshal(m, b)
+}
+func (m *PersistentSegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PersistentSegmentInfo.Marshal(b, m, deterministic)
+}
+func (m *PersistentSegmentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersistentSegmentInfo.Merge(m, src)
+}
+func (m *PersistentSegmentInfo) XXX_Size() int {
+	return xxx_messageInfo_PersistentSegmentInfo.Size(m)
+}
+func (m *PersistentSegmentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersistentSegmentInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersistentSegmentInfo proto.InternalMessageInfo
+
+func (m *PersistentSegmentInfo) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetState() commonpb.SegmentState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.SegmentState_SegmentStateNone
+}
+
+type GetPersistentSegmentInfoRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSegmentInfoRequest{} }
+func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
+func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{76}
+}
+
+func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Unmarshal(m, b)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPersistentSegmentInfoRequest.Merge(m, src)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Size(m)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPersistentSegmentInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPersistentSegmentInfoRequest proto.InternalMessageInfo
+
+func (m *GetPersistentSegmentInfoRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetPersistentSegmentInfoRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetPersistentSegmentInfoRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type GetPersistentSegmentInfoResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Infos                []*PersistentSegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentSegmentInfoResponse{} }
+func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
+func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{77}
+}
+
+func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Unmarshal(m, b)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPersistentSegmentInfoResponse.Merge(m, src)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Size(m)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPersistentSegmentInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPersistentSegmentInfoResponse proto.InternalMessageInfo
+
+func (m *GetPersistentSegmentInfoResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetPersistentSegmentInfoResponse) GetInfos() []*PersistentSegmentInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type QuerySegmentInfo struct {
+	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID         int64                 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64                 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	MemSize              int64                 `protobuf:"varint,4,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
+	NumRows              int64                 `protobuf:"varint,5,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	IndexName            string                `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID              int64                 `protobuf:"varint,7,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	NodeID               int64                 `protobuf:"varint,8,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	State                commonpb.SegmentState `protobuf:"varint,9,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *QuerySegmentInfo) Reset()         { *m = QuerySegmentInfo{} }
+func (m *QuerySegmentInfo) String() string { return proto.CompactTextString(m) }
+func (*QuerySegmentInfo) ProtoMessage()    {}
+func (*QuerySegmentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{78}
+}
+
+func (m *QuerySegmentInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuerySegmentInfo.Unmarshal(m, b)
+}
+func (m *QuerySegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuerySegmentInfo.Marshal(b, m, deterministic)
+}
+func (m *QuerySegmentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySegmentInfo.Merge(m, src)
+}
+func (m *QuerySegmentInfo) XXX_Size() int {
+	return xxx_messageInfo_QuerySegmentInfo.Size(m)
+}
+func (m *QuerySegmentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySegmentInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySegmentInfo proto.InternalMessageInfo
+
+func (m *QuerySegmentInfo) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetMemSize() int64 {
+	if m != nil {
+		return m.MemSize
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *QuerySegmentInfo) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetState() commonpb.SegmentState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.SegmentState_SegmentStateNone
+}
+
+type GetQuerySegmentInfoRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetQuerySegmentInfoRequest) Reset()         { *m = GetQuerySegmentInfoRequest{} }
+func (m *GetQuerySegmentInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetQuerySegmentInfoRequest) ProtoMessage()    {}
+func (*GetQuerySegmentInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{79}
+}
+
+func (m *GetQuerySegmentInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQuerySegmentInfoRequest.Unmarshal(m, b)
+}
+func (m *GetQuerySegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQuerySegmentInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetQuerySegmentInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuerySegmentInfoRequest.Merge(m, src)
+}
+func (m *GetQuerySegmentInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetQuerySegmentInfoRequest.Size(m)
+}
+func (m *GetQuerySegmentInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuerySegmentInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuerySegmentInfoRequest proto.InternalMessageInfo
+
+func (m *GetQuerySegmentInfoRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetQuerySegmentInfoRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetQuerySegmentInfoRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type GetQuerySegmentInfoResponse struct {
+	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Infos                []*QuerySegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetQuerySegmentInfoResponse) Reset()         { *m = GetQuerySegmentInfoResponse{} }
+func (m *GetQuerySegmentInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetQuerySegmentInfoResponse) ProtoMessage()    {}
+func (*GetQuerySegmentInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{80}
+}
+
+func (m *GetQuerySegmentInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQuerySegmentInfoResponse.Unmarshal(m, b)
+}
+func (m *GetQuerySegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQuerySegmentInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetQuerySegmentInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuerySegmentInfoResponse.Merge(m, src)
+}
+func (m *GetQuerySegmentInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetQuerySegmentInfoResponse.Size(m)
+}
+func (m *GetQuerySegmentInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuerySegmentInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuerySegmentInfoResponse proto.InternalMessageInfo
+
+func (m *GetQuerySegmentInfoResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetQuerySegmentInfoResponse) GetInfos() []*QuerySegmentInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type DummyRequest struct {
+	RequestType          string   `protobuf:"bytes,1,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DummyRequest) Reset()         { *m = DummyRequest{} }
+func (m *DummyRequest) String() string { return proto.CompactTextString(m) }
+func (*DummyRequest) ProtoMessage()    {}
+func (*DummyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{81}
+}
+
+func (m *DummyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DummyRequest.Unmarshal(m, b)
+}
+func (m *DummyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DummyRequest.Marshal(b, m, deterministic)
+}
+func (m *DummyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DummyRequest.Merge(m, src)
+}
+func (m *DummyRequest) XXX_Size() int {
+	return xxx_messageInfo_DummyRequest.Size(m)
+}
+func (m *DummyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DummyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DummyRequest proto.InternalMessageInfo
+
+func (m *DummyRequest) GetRequestType() string {
+	if m != nil {
+		return m.RequestType
+	}
+	return ""
+}
+
+type DummyResponse struct {
+	Response             string   `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DummyResponse) Reset()         { *m = DummyResponse{} }
+func (m *DummyResponse) String() string { return proto.CompactTextString(m) }
+func (*DummyResponse) ProtoMessage()    {}
+func (*DummyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{82}
+}
+
+func (m *DummyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DummyResponse.Unmarshal(m, b)
+}
+func (m *DummyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DummyResponse.Marshal(b, m, deterministic)
+}
+func (m *DummyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DummyResponse.Merge(m, src)
+}
+func (m *DummyResponse) XXX_Size() int {
+	return xxx_messageInfo_DummyResponse.Size(m)
+}
+func (m *DummyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DummyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DummyResponse proto.InternalMessageInfo
+
+func (m *DummyResponse) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
+type RegisterLinkRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterLinkRequest) Reset()         { *m = RegisterLinkRequest{} }
+func (m *RegisterLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterLinkRequest) ProtoMessage()    {}
+func (*RegisterLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{83}
+}
+
+func (m *RegisterLinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterLinkRequest.Unmarshal(m, b)
+}
+func (m *RegisterLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterLinkRequest.Marshal(b, m, deterministic)
+}
+func (m *RegisterLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterLinkRequest.Merge(m, src)
+}
+func (m *RegisterLinkRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterLinkRequest.Size(m)
+}
+func (m *RegisterLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterLinkRequest proto.InternalMessageInfo
+
+type RegisterLinkResponse struct {
+	Address              *commonpb.Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Status               *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RegisterLinkResponse) Reset()         { *m = RegisterLinkResponse{} }
+func (m *RegisterLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterLinkResponse) ProtoMessage()    {}
+func (*RegisterLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{84}
+}
+
+func (m *RegisterLinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterLinkResponse.Unmarshal(m, b)
+}
+func (m *RegisterLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterLinkResponse.Marshal(b, m, deterministic)
+}
+func (m *RegisterLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterLinkResponse.Merge(m, src)
+}
+func (m *RegisterLinkResponse) XXX_Size() int {
+	return xxx_messageInfo_RegisterLinkResponse.Size(m)
+}
+func (m *RegisterLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterLinkResponse proto.InternalMessageInfo
+
+func (m *RegisterLinkResponse) GetAddress() *commonpb.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *RegisterLinkResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type GetMetricsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Request              string            `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetMetricsRequest) Reset()         { *m = GetMetricsRequest{} }
+func (m *GetMetricsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMetricsRequest) ProtoMessage()    {}
+func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{85}
+}
+
+func (m *GetMetricsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetricsRequest.Unmarshal(m, b)
+}
+func (m *GetMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetricsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMetricsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetricsRequest.Merge(m, src)
+}
+func (m *GetMetricsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMetricsRequest.Size(m)
+}
+func (m *GetMetricsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetricsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetricsRequest proto.InternalMessageInfo
+
+func (m *GetMetricsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetMetricsRequest) GetRequest() string {
+	if m != nil {
+		return m.Request
+	}
+	return ""
+}
+
+type GetMetricsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Response             string           `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	ComponentName        string           `protobuf:"bytes,3,opt,name=component_name,json=componentName,proto3" json:"component_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetMetricsResponse) Reset()         { *m = GetMetricsResponse{} }
+func (m *GetMetricsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMetricsResponse) ProtoMessage()    {}
+func (*GetMetricsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{86}
+}
+
+func (m *GetMetricsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetricsResponse.Unmarshal(m, b)
+}
+func (m *GetMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetricsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetMetricsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetricsResponse.Merge(m, src)
+}
+func (m *GetMetricsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMetricsResponse.Size(m)
+}
+func (m *GetMetricsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetricsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetricsResponse proto.InternalMessageInfo
+
+func (m *GetMetricsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetMetricsResponse) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
+func (m *GetMetricsResponse) GetComponentName() string {
+	if m != nil {
+		return m.ComponentName
+	}
+	return ""
+}
+
+//
+// Do load balancing operation from src_nodeID to dst_nodeID.
+type LoadBalanceRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SrcNodeID            int64             `protobuf:"varint,2,opt,name=src_nodeID,json=srcNodeID,proto3" json:"src_nodeID,omitempty"`
+	DstNodeIDs           []int64           `protobuf:"varint,3,rep,packed,name=dst_nodeIDs,json=dstNodeIDs,proto3" json:"dst_nodeIDs,omitempty"`
+	SealedSegmentIDs     []int64           `protobuf:"varint,4,rep,packed,name=sealed_segmentIDs,json=sealedSegmentIDs,proto3" json:"sealed_segmentIDs,omitempty"`
+	CollectionName       string            `protobuf:"bytes,5,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
+func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadBalanceRequest) ProtoMessage()    {}
+func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{87}
+}
+
+func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadBalanceRequest.Unmarshal(m, b)
+}
+func (m *LoadBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *LoadBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadBalanceRequest.Merge(m, src)
+}
+func (m *LoadBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadBalanceRequest.Size(m)
+}
+func (m *LoadBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadBalanceRequest proto.InternalMessageInfo
+
+func (m *LoadBalanceRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *LoadBalanceRequest) GetSrcNodeID() int64 {
+	if m != nil {
+		return m.SrcNodeID
+	}
+	return 0
+}
+
+func (m *LoadBalanceRequest) GetDstNodeIDs() []int64 {
+	if m != nil {
+		return m.DstNodeIDs
+	}
+	return nil
+}
+
+func (m *LoadBalanceRequest) GetSealedSegmentIDs() []int64 {
+	if m != nil {
+		return m.SealedSegmentIDs
+	}
+	return nil
+}
+
+func (m *LoadBalanceRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+//*
+// Cordon the query node, no new segments or channels are assigned to it
+// and the ones it serves are drained off to the other nodes
+type CordonNodeRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CordonNodeRequest) Reset()         { *m = CordonNodeRequest{} }
+func (m *CordonNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*CordonNodeRequest) ProtoMessage()    {}
+func (*CordonNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{88}
+}
+
+func (m *CordonNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CordonNodeRequest.Unmarshal(m, b)
+}
+func (m *CordonNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CordonNodeRequest.Marshal(b, m, deterministic)
+}
+func (m *CordonNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CordonNodeRequest.Merge(m, src)
+}
+func (m *CordonNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_CordonNodeRequest.Size(m)
+}
+func (m *CordonNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CordonNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CordonNodeRequest proto.InternalMessageInfo
+
+func (m *CordonNodeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CordonNodeRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+//*
+// Make the cordoned query node assignable again
+type UncordonNodeRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UncordonNodeRequest) Reset()         { *m = UncordonNodeRequest{} }
+func (m *UncordonNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*UncordonNodeRequest) ProtoMessage()    {}
+func (*UncordonNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{89}
+}
+
+func (m *UncordonNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UncordonNodeRequest.Unmarshal(m, b)
+}
+func (m *UncordonNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UncordonNodeRequest.Marshal(b, m, deterministic)
+}
+func (m *UncordonNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UncordonNodeRequest.Merge(m, src)
+}
+func (m *UncordonNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_UncordonNodeRequest.Size(m)
+}
+func (m *UncordonNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UncordonNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UncordonNodeRequest proto.InternalMessageInfo
+
+func (m *UncordonNodeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UncordonNodeRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+type GetCordonedNodesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetCordonedNodesRequest) Reset()         { *m = GetCordonedNodesRequest{} }
+func (m *GetCordonedNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCordonedNodesRequest) ProtoMessage()    {}
+func (*GetCordonedNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{90}
+}
+
+func (m *GetCordonedNodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCordonedNodesRequest.Unmarshal(m, b)
+}
+func (m *GetCordonedNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCordonedNodesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCordonedNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCordonedNodesRequest.Merge(m, src)
+}
+func (m *GetCordonedNodesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCordonedNodesRequest.Size(m)
+}
+func (m *GetCordonedNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCordonedNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCordonedNodesRequest proto.InternalMessageInfo
+
+func (m *GetCordonedNodesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type CordonedNode struct {
+	NodeID               int64             `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	State                CordonedNodeState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.milvus.CordonedNodeState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CordonedNode) Reset()         { *m = CordonedNode{} }
+func (m *CordonedNode) String() string { return proto.CompactTextString(m) }
+func (*CordonedNode) ProtoMessage()    {}
+func (*CordonedNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{91}
+}
+
+func (m *CordonedNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CordonedNode.Unmarshal(m, b)
+}
+func (m *CordonedNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CordonedNode.Marshal(b, m, deterministic)
+}
+func (m *CordonedNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CordonedNode.Merge(m, src)
+}
+func (m *CordonedNode) XXX_Size() int {
+	return xxx_messageInfo_CordonedNode.Size(m)
+}
+func (m *CordonedNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_CordonedNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CordonedNode proto.InternalMessageInfo
+
+func (m *CordonedNode) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *CordonedNode) GetState() CordonedNodeState {
+	if m != nil {
+		return m.State
+	}
+	return CordonedNodeState_CordonedNodeUnknown
+}
+
+type CordonedNodesResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the cordoned nodes after the request, ordered by node id
+	Nodes                []*CordonedNode `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CordonedNodesResponse) Reset()         { *m = CordonedNodesResponse{} }
+func (m *CordonedNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*CordonedNodesResponse) ProtoMessage()    {}
+func (*CordonedNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{92}
+}
+
+func (m *CordonedNodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CordonedNodesResponse.Unmarshal(m, b)
+}
+func (m *CordonedNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CordonedNodesResponse.Marshal(b, m, deterministic)
+}
+func (m *CordonedNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CordonedNodesResponse.Merge(m, src)
+}
+func (m *CordonedNodesResponse) XXX_Size() int {
+	return xxx_messageInfo_CordonedNodesResponse.Size(m)
+}
+func (m *CordonedNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CordonedNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CordonedNodesResponse proto.InternalMessageInfo
+
+func (m *CordonedNodesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CordonedNodesResponse) GetNodes() []*CordonedNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+//*
+// Trigger a balance round of the query nodes, or return the planned moves without executing them if dry_run is true
+type BalanceRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DryRun               bool              `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BalanceRequest) Reset()         { *m = BalanceRequest{} }
+func (m *BalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*BalanceRequest) ProtoMessage()    {}
+func (*BalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{93}
+}
+
+func (m *BalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceRequest.Unmarshal(m, b)
+}
+func (m *BalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *BalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceRequest.Merge(m, src)
+}
+func (m *BalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_BalanceRequest.Size(m)
+}
+func (m *BalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceRequest proto.InternalMessageInfo
+
+func (m *BalanceRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *BalanceRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+// a segment or a dml channel planned to move between the query nodes of a replica
+type BalanceMove struct {
+	CollectionID int64 `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	ReplicaID    int64 `protobuf:"varint,2,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	SegmentID    int64 `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	// the dml channel to move, empty if a segment is moved
+	Channel      string `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	SourceNodeID int64  `protobuf:"varint,5,opt,name=source_nodeID,json=sourceNodeID,proto3" json:"source_nodeID,omitempty"`
+	DstNodeID    int64  `protobuf:"varint,6,opt,name=dst_nodeID,json=dstNodeID,proto3" json:"dst_nodeID,omitempty"`
+	// the load the move balances, such as memory, segment bytes, qps or channels
+	Reason               string   `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BalanceMove) Reset()         { *m = BalanceMove{} }
+func (m *BalanceMove) String() string { return proto.CompactTextString(m) }
+func (*BalanceMove) ProtoMessage()    {}
+func (*BalanceMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{94}
+}
+
+func (m *BalanceMove) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceMove.Unmarshal(m, b)
+}
+func (m *BalanceMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceMove.Marshal(b, m, deterministic)
+}
+func (m *BalanceMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceMove.Merge(m, src)
+}
+func (m *BalanceMove) XXX_Size() int {
+	return xxx_messageInfo_BalanceMove.Size(m)
+}
+func (m *BalanceMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceMove proto.InternalMessageInfo
+
+func (m *BalanceMove) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *BalanceMove) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *BalanceMove) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *BalanceMove) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *BalanceMove) GetSourceNodeID() int64 {
+	if m != nil {
+		return m.SourceNodeID
+	}
+	return 0
+}
+
+func (m *BalanceMove) GetDstNodeID() int64 {
+	if m != nil {
+		return m.DstNodeID
+	}
+	return 0
+}
+
+func (m *BalanceMove) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type BalanceResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DryRun bool             `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// the moves are executed in the background unless dry_run
+	Moves                []*BalanceMove `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BalanceResponse) Reset()         { *m = BalanceResponse{} }
+func (m *BalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*BalanceResponse) ProtoMessage()    {}
+func (*BalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{95}
+}
+
+func (m *BalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceResponse.Unmarshal(m, b)
+}
+func (m *BalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceResponse.Marshal(b, m, deterministic)
+}
+func (m *BalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceResponse.Merge(m, src)
+}
+func (m *BalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_BalanceResponse.Size(m)
+}
+func (m *BalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceResponse proto.InternalMessageInfo
+
+func (m *BalanceResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *BalanceResponse) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *BalanceResponse) GetMoves() []*BalanceMove {
+	if m != nil {
+		return m.Moves
+	}
+	return nil
+}
+
+type ManualCompactionRequest struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Timetravel           uint64   `protobuf:"varint,2,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManualCompactionRequest) Reset()         { *m = ManualCompactionRequest{} }
+func (m *ManualCompactionRequest) String() string { return proto.CompactTextString(m) }
+func (*ManualCompactionRequest) ProtoMessage()    {}
+func (*ManualCompactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{96}
+}
+
+func (m *ManualCompactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManualCompactionRequest.Unmarshal(m, b)
+}
+func (m *ManualCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManualCompactionRequest.Marshal(b, m, deterministic)
+}
+func (m *ManualCompactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManualCompactionRequest.Merge(m, src)
+}
+func (m *ManualCompactionRequest) XXX_Size() int {
+	return xxx_messageInfo_ManualCompactionRequest.Size(m)
+}
+func (m *ManualCompactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManualCompactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManualCompactionRequest proto.InternalMessageInfo
+
+func (m *ManualCompactionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ManualCompactionRequest) GetTimetravel() uint64 {
+	if m != nil {
+		return m.Timetravel
+	}
+	return 0
+}
+
+type ManualCompactionResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CompactionID         int64            `protobuf:"varint,2,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ManualCompactionResponse) Reset()         { *m = ManualCompactionResponse{} }
+func (m *ManualCompactionResponse) String() string { return proto.CompactTextString(m) }
+func (*ManualCompactionResponse) ProtoMessage()    {}
+func (*ManualCompactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{97}
+}
+
+func (m *ManualCompactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManualCompactionResponse.Unmarshal(m, b)
+}
+func (m *ManualCompactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManualCompactionResponse.Marshal(b, m, deterministic)
+}
+func (m *ManualCompactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManualCompactionResponse.Merge(m, src)
+}
+func (m *ManualCompactionResponse) XXX_Size() int {
+	return xxx_messageInfo_ManualCompactionResponse.Size(m)
+}
+func (m *ManualCompactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManualCompactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManualCompactionResponse proto.InternalMessageInfo
+
+func (m *ManualCompactionResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ManualCompactionResponse) GetCompactionID() int64 {
+	if m != nil {
+		return m.CompactionID
+	}
+	return 0
+}
+
+type GetCompactionStateRequest struct {
+	CompactionID         int64    `protobuf:"varint,1,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCompactionStateRequest) Reset()         { *m = GetCompactionStateRequest{} }
+func (m *GetCompactionStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionStateRequest) ProtoMessage()    {}
+func (*GetCompactionStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{98}
+}
+
+func (m *GetCompactionStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionStateRequest.Unmarshal(m, b)
+}
+func (m *GetCompactionStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionStateRequest.Merge(m, src)
+}
+func (m *GetCompactionStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionStateRequest.Size(m)
+}
+func (m *GetCompactionStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionStateRequest proto.InternalMessageInfo
+
+func (m *GetCompactionStateRequest) GetCompactionID() int64 {
+	if m != nil {
+		return m.CompactionID
+	}
+	return 0
+}
+
+type GetCompactionStateResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                commonpb.CompactionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.CompactionState" json:"state,omitempty"`
+	ExecutingPlanNo      int64                    `protobuf:"varint,3,opt,name=executingPlanNo,proto3" json:"executingPlanNo,omitempty"`
+	TimeoutPlanNo        int64                    `protobuf:"varint,4,opt,name=timeoutPlanNo,proto3" json:"timeoutPlanNo,omitempty"`
+	CompletedPlanNo      int64                    `protobuf:"varint,5,opt,name=completedPlanNo,proto3" json:"completedPlanNo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetCompactionStateResponse) Reset()         { *m = GetCompactionStateResponse{} }
+func (m *GetCompactionStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionStateResponse) ProtoMessage()    {}
+func (*GetCompactionStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{99}
+}
+
+func (m *GetCompactionStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionStateResponse.Unmarshal(m, b)
+}
+func (m *GetCompactionStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionStateResponse.Merge(m, src)
+}
+func (m *GetCompactionStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionStateResponse.Size(m)
+}
+func (m *GetCompactionStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionStateResponse proto.InternalMessageInfo
+
+func (m *GetCompactionStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCompactionStateResponse) GetState() commonpb.CompactionState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.CompactionState_UndefiedState
+}
+
+func (m *GetCompactionStateResponse) GetExecutingPlanNo() int64 {
+	if m != nil {
+		return m.ExecutingPlanNo
+	}
+	return 0
+}
+
+func (m *GetCompactionStateResponse) GetTimeoutPlanNo() int64 {
+	if m != nil {
+		return m.TimeoutPlanNo
+	}
+	return 0
+}
+
+func (m *GetCompactionStateResponse) GetCompletedPlanNo() int64 {
+	if m != nil {
+		return m.CompletedPlanNo
+	}
+	return 0
+}
+
+type GetCompactionPlansRequest struct {
+	CompactionID         int64    `protobuf:"varint,1,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCompactionPlansRequest) Reset()         { *m = GetCompactionPlansRequest{} }
+func (m *GetCompactionPlansRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionPlansRequest) ProtoMessage()    {}
+func (*GetCompactionPlansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{100}
+}
+
+func (m *GetCompactionPlansRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionPlansRequest.Unmarshal(m, b)
+}
+func (m *GetCompactionPlansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionPlansRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionPlansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionPlansRequest.Merge(m, src)
+}
+func (m *GetCompactionPlansRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionPlansRequest.Size(m)
+}
+func (m *GetCompactionPlansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionPlansRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionPlansRequest proto.InternalMessageInfo
+
+func (m *GetCompactionPlansRequest) GetCompactionID() int64 {
+	if m != nil {
+		return m.CompactionID
+	}
+	return 0
+}
+
+type GetCompactionPlansResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                commonpb.CompactionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.CompactionState" json:"state,omitempty"`
+	MergeInfos           []*CompactionMergeInfo   `protobuf:"bytes,3,rep,name=mergeInfos,proto3" json:"mergeInfos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetCompactionPlansResponse) Reset()         { *m = GetCompactionPlansResponse{} }
+func (m *GetCompactionPlansResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionPlansResponse) ProtoMessage()    {}
+func (*GetCompactionPlansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{101}
+}
+
+func (m *GetCompactionPlansResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionPlansResponse.Unmarshal(m, b)
+}
+func (m *GetCompactionPlansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionPlansResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionPlansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionPlansResponse.Merge(m, src)
+}
+func (m *GetCompactionPlansResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionPlansResponse.Size(m)
+}
+func (m *GetCompactionPlansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionPlansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionPlansResponse proto.InternalMessageInfo
+
+func (m *GetCompactionPlansResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCompactionPlansResponse) GetState() commonpb.CompactionState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.CompactionState_UndefiedState
+}
+
+func (m *GetCompactionPlansResponse) GetMergeInfos() []*CompactionMergeInfo {
+	if m != nil {
+		return m.MergeInfos
+	}
+	return nil
+}
+
+type CompactionMergeInfo struct {
+	Sources              []int64  `protobuf:"varint,1,rep,packed,name=sources,proto3" json:"sources,omitempty"`
+	Target               int64    `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompactionMergeInfo) Reset()         { *m = CompactionMergeInfo{} }
+func (m *CompactionMergeInfo) String() string { return proto.CompactTextString(m) }
+func (*CompactionMergeInfo) ProtoMessage()    {}
+func (*CompactionMergeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{102}
+}
+
+func (m *CompactionMergeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionMergeInfo.Unmarshal(m, b)
+}
+func (m *CompactionMergeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionMergeInfo.Marshal(b, m, deterministic)
+}
+func (m *CompactionMergeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionMergeInfo.Merge(m, src)
+}
+func (m *CompactionMergeInfo) XXX_Size() int {
+	return xxx_messageInfo_CompactionMergeInfo.Size(m)
+}
+func (m *CompactionMergeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionMergeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionMergeInfo proto.InternalMessageInfo
+
+func (m *CompactionMergeInfo) GetSources() []int64 {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *CompactionMergeInfo) GetTarget() int64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+type GetFlushStateRequest struct {
+	SegmentIDs           []int64  `protobuf:"varint,1,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFlushStateRequest) Reset()         { *m = GetFlushStateRequest{} }
+func (m *GetFlushStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFlushStateRequest) ProtoMessage()    {}
+func (*GetFlushStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{103}
+}
+
+func (m *GetFlushStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFlushStateRequest.Unmarshal(m, b)
+}
+func (m *GetFlushStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFlushStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetFlushStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFlushStateRequest.Merge(m, src)
+}
+func (m *GetFlushStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFlushStateRequest.Size(m)
+}
+func (m *GetFlushStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFlushStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFlushStateRequest proto.InternalMessageInfo
+
+func (m *GetFlushStateRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type GetFlushStateResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Flushed              bool             `protobuf:"varint,2,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetFlushStateResponse) Reset()         { *m = GetFlushStateResponse{} }
+func (m *GetFlushStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFlushStateResponse) ProtoMessage()    {}
+func (*GetFlushStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{104}
+}
+
+func (m *GetFlushStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFlushStateResponse.Unmarshal(m, b)
+}
+func (m *GetFlushStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFlushStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetFlushStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFlushStateResponse.Merge(m, src)
+}
+func (m *GetFlushStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFlushStateResponse.Size(m)
+}
+func (m *GetFlushStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFlushStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFlushStateResponse proto.InternalMessageInfo
+
+func (m *GetFlushStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetFlushStateResponse) GetFlushed() bool {
+	if m != nil {
+		return m.Flushed
+	}
+	return false
+}
+
+type ImportRequest struct {
+	CollectionName       string                   `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string                   `protobuf:"bytes,2,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	ChannelNames         []string                 `protobuf:"bytes,3,rep,name=channel_names,json=channelNames,proto3" json:"channel_names,omitempty"`
+	RowBased             bool                     `protobuf:"varint,4,opt,name=row_based,json=rowBased,proto3" json:"row_based,omitempty"`
+	Files                []string                 `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+	Options              []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	DbName               string                   `protobuf:"bytes,7,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ImportRequest) Reset()         { *m = ImportRequest{} }
+func (m *ImportRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportRequest) ProtoMessage()    {}
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{105}
+}
+
+func (m *ImportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportRequest.Unmarshal(m, b)
+}
+func (m *ImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportRequest.Merge(m, src)
+}
+func (m *ImportRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportRequest.Size(m)
+}
+func (m *ImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportRequest proto.InternalMessageInfo
+
+func (m *ImportRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ImportRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *ImportRequest) GetChannelNames() []string {
+	if m != nil {
+		return m.ChannelNames
+	}
+	return nil
+}
+
+func (m *ImportRequest) GetRowBased() bool {
+	if m != nil {
+		return m.RowBased
+	}
+	return false
+}
+
+func (m *ImportRequest) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ImportRequest) GetOptions() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *ImportRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+type ImportResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Tasks                []int64          `protobuf:"varint,2,rep,packed,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ImportResponse) Reset()         { *m = ImportResponse{} }
+func (m *ImportResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportResponse) ProtoMessage()    {}
+func (*ImportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{106}
+}
+
+func (m *ImportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResponse.Unmarshal(m, b)
+}
+func (m *ImportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResponse.Merge(m, src)
+}
+func (m *ImportResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportResponse.Size(m)
+}
+func (m *ImportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResponse proto.InternalMessageInfo
+
+func (m *ImportResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ImportResponse) GetTasks() []int64 {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type GetImportStateRequest struct {
+	Task                 int64    `protobuf:"varint,1,opt,name=task,proto3" json:"task,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetImportStateRequest) Reset()         { *m = GetImportStateRequest{} }
+func (m *GetImportStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetImportStateRequest) ProtoMessage()    {}
+func (*GetImportStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{107}
+}
+
+func (m *GetImportStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetImportStateRequest.Unmarshal(m, b)
+}
+func (m *GetImportStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetImportStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetImportStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetImportStateRequest.Merge(m, src)
+}
+func (m *GetImportStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetImportStateRequest.Size(m)
+}
+func (m *GetImportStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetImportStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetImportStateRequest proto.InternalMessageInfo
+
+func (m *GetImportStateRequest) GetTask() int64 {
+	if m != nil {
+		return m.Task
+	}
+	return 0
+}
+
+type GetImportStateResponse struct {
+	Status                 *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                  commonpb.ImportState     `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
+	RowCount               int64                    `protobuf:"varint,3,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	IdList                 []int64                  `protobuf:"varint,4,rep,packed,name=id_list,json=idList,proto3" json:"id_list,omitempty"`
+	Infos                  []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=infos,proto3" json:"infos,omitempty"`
+	Id                     int64                    `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"`
+	HeuristicDataQueryable bool                     `protobuf:"varint,7,opt,name=heuristic_data_queryable,json=heuristicDataQueryable,proto3" json:"heuristic_data_queryable,omitempty"`
+	HeuristicDataIndexed   bool                     `protobuf:"varint,8,opt,name=heuristic_data_indexed,json=heuristicDataIndexed,proto3" json:"heuristic_data_indexed,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                 `json:"-"`
+	XXX_unrecognized       []byte                   `json:"-"`
+	XXX_sizecache          int32                    `json:"-"`
+}
+
+func (m *GetImportStateResponse) Reset()         { *m = GetImportStateResponse{} }
+func (m *GetImportStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetImportStateResponse) ProtoMessage()    {}
+func (*GetImportStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{108}
+}
+
+func (m *GetImportStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetImportStateResponse.Unmarshal(m, b)
+}
+func (m *GetImportStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetImportStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetImportStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetImportStateResponse.Merge(m, src)
+}
+func (m *GetImportStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetImportStateResponse.Size(m)
+}
+func (m *GetImportStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetImportStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetImportStateResponse proto.InternalMessageInfo
+
+func (m *GetImportStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetImportStateResponse) GetState() commonpb.ImportState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.ImportState_ImportPending
+}
+
+func (m *GetImportStateResponse) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *GetImportStateResponse) GetIdList() []int64 {
+	if m != nil {
+		return m.IdList
+	}
+	return nil
+}
+
+func (m *GetImportStateResponse) GetInfos() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+func (m *GetImportStateResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetImportStateResponse) GetHeuristicDataQueryable() bool {
+	if m != nil {
+		return m.HeuristicDataQueryable
+	}
+	return false
+}
+
+func (m *GetImportStateResponse) GetHeuristicDataIndexed() bool {
+	if m != nil {
+		return m.HeuristicDataIndexed
+	}
+	return false
+}
+
+type CancelImportRequest struct {
+	Task                 int64    `protobuf:"varint,1,opt,name=task,proto3" json:"task,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelImportRequest) Reset()         { *m = CancelImportRequest{} }
+func (m *CancelImportRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelImportRequest) ProtoMessage()    {}
+func (*CancelImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{109}
+}
+
+func (m *CancelImportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelImportRequest.Unmarshal(m, b)
+}
+func (m *CancelImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelImportRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelImportRequest.Merge(m, src)
+}
+func (m *CancelImportRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelImportRequest.Size(m)
+}
+func (m *CancelImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelImportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelImportRequest proto.InternalMessageInfo
+
+func (m *CancelImportRequest) GetTask() int64 {
+	if m != nil {
+		return m.Task
+	}
+	return 0
+}
+
+type ListImportTasksRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListImportTasksRequest) Reset()         { *m = ListImportTasksRequest{} }
+func (m *ListImportTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListImportTasksRequest) ProtoMessage()    {}
+func (*ListImportTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{110}
+}
+
+func (m *ListImportTasksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListImportTasksRequest.Unmarshal(m, b)
+}
+func (m *ListImportTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListImportTasksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListImportTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListImportTasksRequest.Merge(m, src)
+}
+func (m *ListImportTasksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListImportTasksRequest.Size(m)
+}
+func (m *ListImportTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListImportTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListImportTasksRequest proto.InternalMessageInfo
+
+type ListImportTasksResponse struct {
+	Status               *commonpb.Status          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Tasks                []*GetImportStateResponse `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ListImportTasksResponse) Reset()         { *m = ListImportTasksResponse{} }
+func (m *ListImportTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListImportTasksResponse) ProtoMessage()    {}
+func (*ListImportTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{111}
+}
+
+func (m *ListImportTasksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListImportTasksResponse.Unmarshal(m, b)
+}
+func (m *ListImportTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListImportTasksResponse.Marshal(b, m, deterministic)
+}
+func (m *ListImportTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListImportTasksResponse.Merge(m, src)
+}
+func (m *ListImportTasksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListImportTasksResponse.Size(m)
+}
+func (m *ListImportTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListImportTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListImportTasksResponse proto.InternalMessageInfo
+
+func (m *ListImportTasksResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListImportTasksResponse) GetTasks() []*GetImportStateResponse {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type GetReplicasRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	WithShardNodes       bool              `protobuf:"varint,3,opt,name=with_shard_nodes,json=withShardNodes,proto3" json:"with_shard_nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetReplicasRequest) Reset()         { *m = GetReplicasRequest{} }
+func (m *GetReplicasRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReplicasRequest) ProtoMessage()    {}
+func (*GetReplicasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{112}
+}
+
+func (m *GetReplicasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReplicasRequest.Unmarshal(m, b)
+}
+func (m *GetReplicasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReplicasRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReplicasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicasRequest.Merge(m, src)
+}
+func (m *GetReplicasRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReplicasRequest.Size(m)
+}
+func (m *GetReplicasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicasRequest proto.InternalMessageInfo
+
+func (m *GetReplicasRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetReplicasRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetReplicasRequest) GetWithShardNodes() bool {
+	if m != nil {
+		return m.WithShardNodes
+	}
+	return false
+}
+
+type GetReplicasResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Tasks                []int64          `protobuf:"varint,2,rep,packed,name=tasks,proto3" json:"tasks,omitempty"`
+	Replicas             []*ReplicaInfo   `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *ImportResponse) Reset()         { *m = ImportResponse{} }
-func (m *ImportResponse) String() string { return proto.CompactTextString(m) }
-func (*ImportResponse) ProtoMessage()    {}
-func (*ImportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{77}
+func (m *GetReplicasResponse) Reset()         { *m = GetReplicasResponse{} }
+func (m *GetReplicasResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReplicasResponse) ProtoMessage()    {}
+func (*GetReplicasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{113}
+}
+
+func (m *GetReplicasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReplicasResponse.Unmarshal(m, b)
+}
+func (m *GetReplicasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReplicasResponse.Marshal(b, m, deterministic)
+}
+func (m *GetReplicasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicasResponse.Merge(m, src)
+}
+func (m *GetReplicasResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReplicasResponse.Size(m)
+}
+func (m *GetReplicasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicasResponse proto.InternalMessageInfo
+
+func (m *GetReplicasResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetReplicasResponse) GetReplicas() []*ReplicaInfo {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+//*
+// Get the progress of loading a collection, which is being loaded or has been loaded
+type GetLoadingProgressRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetLoadingProgressRequest) Reset()         { *m = GetLoadingProgressRequest{} }
+func (m *GetLoadingProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLoadingProgressRequest) ProtoMessage()    {}
+func (*GetLoadingProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{114}
+}
+
+func (m *GetLoadingProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoadingProgressRequest.Unmarshal(m, b)
+}
+func (m *GetLoadingProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoadingProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLoadingProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoadingProgressRequest.Merge(m, src)
+}
+func (m *GetLoadingProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLoadingProgressRequest.Size(m)
+}
+func (m *GetLoadingProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoadingProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoadingProgressRequest proto.InternalMessageInfo
+
+func (m *GetLoadingProgressRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetLoadingProgressRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetLoadingProgressRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type SegmentLoadingProgress struct {
+	SegmentID    int64 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID int64 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	NodeID       int64 `protobuf:"varint,3,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	NumRows      int64 `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	// the loaded fraction of the segment, between 0 and 1
+	Progress             float64  `protobuf:"fixed64,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentLoadingProgress) Reset()         { *m = SegmentLoadingProgress{} }
+func (m *SegmentLoadingProgress) String() string { return proto.CompactTextString(m) }
+func (*SegmentLoadingProgress) ProtoMessage()    {}
+func (*SegmentLoadingProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{115}
+}
+
+func (m *SegmentLoadingProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentLoadingProgress.Unmarshal(m, b)
+}
+func (m *SegmentLoadingProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentLoadingProgress.Marshal(b, m, deterministic)
+}
+func (m *SegmentLoadingProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentLoadingProgress.Merge(m, src)
+}
+func (m *SegmentLoadingProgress) XXX_Size() int {
+	return xxx_messageInfo_SegmentLoadingProgress.Size(m)
+}
+func (m *SegmentLoadingProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentLoadingProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentLoadingProgress proto.InternalMessageInfo
+
+func (m *SegmentLoadingProgress) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SegmentLoadingProgress) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SegmentLoadingProgress) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *SegmentLoadingProgress) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *SegmentLoadingProgress) GetProgress() float64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+type GetLoadingProgressResponse struct {
+	Status       *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CollectionID int64            `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// the loaded percentage of the rows, 100 only if the collection is loaded
+	Percentage      int64                     `protobuf:"varint,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	PendingSegments []*SegmentLoadingProgress `protobuf:"bytes,4,rep,name=pending_segments,json=pendingSegments,proto3" json:"pending_segments,omitempty"`
+	// the query nodes failed to report the progress of their segments
+	Errors               []string `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLoadingProgressResponse) Reset()         { *m = GetLoadingProgressResponse{} }
+func (m *GetLoadingProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLoadingProgressResponse) ProtoMessage()    {}
+func (*GetLoadingProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{116}
+}
+
+func (m *GetLoadingProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoadingProgressResponse.Unmarshal(m, b)
+}
+func (m *GetLoadingProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoadingProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLoadingProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoadingProgressResponse.Merge(m, src)
+}
+func (m *GetLoadingProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLoadingProgressResponse.Size(m)
+}
+func (m *GetLoadingProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoadingProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoadingProgressResponse proto.InternalMessageInfo
+
+func (m *GetLoadingProgressResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetLoadingProgressResponse) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetLoadingProgressResponse) GetPercentage() int64 {
+	if m != nil {
+		return m.Percentage
+	}
+	return 0
+}
+
+func (m *GetLoadingProgressResponse) GetPendingSegments() []*SegmentLoadingProgress {
+	if m != nil {
+		return m.PendingSegments
+	}
+	return nil
+}
+
+func (m *GetLoadingProgressResponse) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type ReplicaInfo struct {
+	ReplicaID            int64           `protobuf:"varint,1,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	CollectionID         int64           `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIds         []int64         `protobuf:"varint,3,rep,packed,name=partition_ids,json=partitionIds,proto3" json:"partition_ids,omitempty"`
+	ShardReplicas        []*ShardReplica `protobuf:"bytes,4,rep,name=shard_replicas,json=shardReplicas,proto3" json:"shard_replicas,omitempty"`
+	NodeIds              []int64         `protobuf:"varint,5,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReplicaInfo) Reset()         { *m = ReplicaInfo{} }
+func (m *ReplicaInfo) String() string { return proto.CompactTextString(m) }
+func (*ReplicaInfo) ProtoMessage()    {}
+func (*ReplicaInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{117}
 }
 
-func (m *ImportResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ImportResponse.Unmarshal(m, b)
+func (m *ReplicaInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicaInfo.Unmarshal(m, b)
 }
-func (m *ImportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ImportResponse.Marshal(b, m, deterministic)
+func (m *ReplicaInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicaInfo.Marshal(b, m, deterministic)
 }
-func (m *ImportResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImportResponse.Merge(m, src)
+func (m *ReplicaInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaInfo.Merge(m, src)
 }
-func (m *ImportResponse) XXX_Size() int {
-	return xxx_messageInfo_ImportResponse.Size(m)
+func (m *ReplicaInfo) XXX_Size() int {
+	return xxx_messageInfo_ReplicaInfo.Size(m)
 }
-func (m *ImportResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImportResponse.DiscardUnknown(m)
+func (m *ReplicaInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImportResponse proto.InternalMessageInfo
+var xxx_messageInfo_ReplicaInfo proto.InternalMessageInfo
 
-func (m *ImportResponse) GetStatus() *commonpb.Status {
+func (m *ReplicaInfo) GetReplicaID() int64 {
 	if m != nil {
-		return m.Status
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *ReplicaInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ReplicaInfo) GetPartitionIds() []int64 {
+	if m != nil {
+		return m.PartitionIds
 	}
 	return nil
 }
 
-func (m *ImportResponse) GetTasks() []int64 {
+func (m *ReplicaInfo) GetShardReplicas() []*ShardReplica {
 	if m != nil {
-		return m.Tasks
+		return m.ShardReplicas
 	}
 	return nil
 }
 
-type GetImportStateRequest struct {
-	Task                 int64    `protobuf:"varint,1,opt,name=task,proto3" json:"task,omitempty"`
+func (m *ReplicaInfo) GetNodeIds() []int64 {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
+}
+
+type ShardReplica struct {
+	LeaderID      int64  `protobuf:"varint,1,opt,name=leaderID,proto3" json:"leaderID,omitempty"`
+	LeaderAddr    string `protobuf:"bytes,2,opt,name=leader_addr,json=leaderAddr,proto3" json:"leader_addr,omitempty"`
+	DmChannelName string `protobuf:"bytes,3,opt,name=dm_channel_name,json=dmChannelName,proto3" json:"dm_channel_name,omitempty"`
+	// optional, DO NOT save it in meta, set it only for GetReplicas()
+	// if with_shard_nodes is true
+	NodeIds              []int64  `protobuf:"varint,4,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetImportStateRequest) Reset()         { *m = GetImportStateRequest{} }
-func (m *GetImportStateRequest) String() string { return proto.CompactTextString(m) }
-func (*GetImportStateRequest) ProtoMessage()    {}
-func (*GetImportStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{78}
+func (m *ShardReplica) Reset()         { *m = ShardReplica{} }
+func (m *ShardReplica) String() string { return proto.CompactTextString(m) }
+func (*ShardReplica) ProtoMessage()    {}
+func (*ShardReplica) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{118}
 }
 
-func (m *GetImportStateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetImportStateRequest.Unmarshal(m, b)
+func (m *ShardReplica) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardReplica.Unmarshal(m, b)
 }
-func (m *GetImportStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetImportStateRequest.Marshal(b, m, deterministic)
+func (m *ShardReplica) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardReplica.Marshal(b, m, deterministic)
 }
-func (m *GetImportStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetImportStateRequest.Merge(m, src)
+func (m *ShardReplica) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardReplica.Merge(m, src)
 }
-func (m *GetImportStateRequest) XXX_Size() int {
-	return xxx_messageInfo_GetImportStateRequest.Size(m)
+func (m *ShardReplica) XXX_Size() int {
+	return xxx_messageInfo_ShardReplica.Size(m)
 }
-func (m *GetImportStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetImportStateRequest.DiscardUnknown(m)
+func (m *ShardReplica) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardReplica.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetImportStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_ShardReplica proto.InternalMessageInfo
 
-func (m *GetImportStateRequest) GetTask() int64 {
+func (m *ShardReplica) GetLeaderID() int64 {
 	if m != nil {
-		return m.Task
+		return m.LeaderID
 	}
 	return 0
 }
 
-type GetImportStateResponse struct {
-	Status                 *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	State                  commonpb.ImportState     `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
-	RowCount               int64                    `protobuf:"varint,3,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
-	IdList                 []int64                  `protobuf:"varint,4,rep,packed,name=id_list,json=idList,proto3" json:"id_list,omitempty"`
-	Infos                  []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=infos,proto3" json:"infos,omitempty"`
-	Id                     int64                    `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"`
-	HeuristicDataQueryable bool                     `protobuf:"varint,7,opt,name=heuristic_data_queryable,json=heuristicDataQueryable,proto3" json:"heuristic_data_queryable,omitempty"`
-	HeuristicDataIndexed   bool                     `protobuf:"varint,8,opt,name=heuristic_data_indexed,json=heuristicDataIndexed,proto3" json:"heuristic_data_indexed,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}                 `json:"-"`
-	XXX_unrecognized       []byte                   `json:"-"`
-	XXX_sizecache          int32                    `json:"-"`
+func (m *ShardReplica) GetLeaderAddr() string {
+	if m != nil {
+		return m.LeaderAddr
+	}
+	return ""
 }
 
-func (m *GetImportStateResponse) Reset()         { *m = GetImportStateResponse{} }
-func (m *GetImportStateResponse) String() string { return proto.CompactTextString(m) }
-func (*GetImportStateResponse) ProtoMessage()    {}
-func (*GetImportStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{79}
+func (m *ShardReplica) GetDmChannelName() string {
+	if m != nil {
+		return m.DmChannelName
+	}
+	return ""
 }
 
-func (m *GetImportStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetImportStateResponse.Unmarshal(m, b)
+func (m *ShardReplica) GetNodeIds() []int64 {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
 }
-func (m *GetImportStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetImportStateResponse.Marshal(b, m, deterministic)
+
+type CreateCredentialRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// ciphertext password
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// create time
+	CreatedUtcTimestamps uint64 `protobuf:"varint,4,opt,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
+	// modify time
+	ModifiedUtcTimestamps uint64   `protobuf:"varint,5,opt,name=modified_utc_timestamps,json=modifiedUtcTimestamps,proto3" json:"modified_utc_timestamps,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
-func (m *GetImportStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetImportStateResponse.Merge(m, src)
+
+func (m *CreateCredentialRequest) Reset()         { *m = CreateCredentialRequest{} }
+func (m *CreateCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCredentialRequest) ProtoMessage()    {}
+func (*CreateCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{119}
 }
-func (m *GetImportStateResponse) XXX_Size() int {
-	return xxx_messageInfo_GetImportStateResponse.Size(m)
+
+func (m *CreateCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCredentialRequest.Unmarshal(m, b)
 }
-func (m *GetImportStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetImportStateResponse.DiscardUnknown(m)
+func (m *CreateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCredentialRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCredentialRequest.Merge(m, src)
+}
+func (m *CreateCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCredentialRequest.Size(m)
+}
+func (m *CreateCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetImportStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateCredentialRequest proto.InternalMessageInfo
 
-func (m *GetImportStateResponse) GetStatus() *commonpb.Status {
+func (m *CreateCredentialRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *GetImportStateResponse) GetState() commonpb.ImportState {
+func (m *CreateCredentialRequest) GetUsername() string {
 	if m != nil {
-		return m.State
+		return m.Username
 	}
-	return commonpb.ImportState_ImportPending
+	return ""
+}
+
+func (m *CreateCredentialRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *CreateCredentialRequest) GetCreatedUtcTimestamps() uint64 {
+	if m != nil {
+		return m.CreatedUtcTimestamps
+	}
+	return 0
+}
+
+func (m *CreateCredentialRequest) GetModifiedUtcTimestamps() uint64 {
+	if m != nil {
+		return m.ModifiedUtcTimestamps
+	}
+	return 0
+}
+
+type UpdateCredentialRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// old password
+	OldPassword string `protobuf:"bytes,3,opt,name=oldPassword,proto3" json:"oldPassword,omitempty"`
+	// new password
+	NewPassword string `protobuf:"bytes,4,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	// create time
+	CreatedUtcTimestamps uint64 `protobuf:"varint,5,opt,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
+	// modify time
+	ModifiedUtcTimestamps uint64   `protobuf:"varint,6,opt,name=modified_utc_timestamps,json=modifiedUtcTimestamps,proto3" json:"modified_utc_timestamps,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *UpdateCredentialRequest) Reset()         { *m = UpdateCredentialRequest{} }
+func (m *UpdateCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCredentialRequest) ProtoMessage()    {}
+func (*UpdateCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{120}
+}
+
+func (m *UpdateCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCredentialRequest.Unmarshal(m, b)
+}
+func (m *UpdateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCredentialRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCredentialRequest.Merge(m, src)
+}
+func (m *UpdateCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCredentialRequest.Size(m)
+}
+func (m *UpdateCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCredentialRequest.DiscardUnknown(m)
 }
 
-func (m *GetImportStateResponse) GetRowCount() int64 {
+var xxx_messageInfo_UpdateCredentialRequest proto.InternalMessageInfo
+
+func (m *UpdateCredentialRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.RowCount
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *GetImportStateResponse) GetIdList() []int64 {
+func (m *UpdateCredentialRequest) GetUsername() string {
 	if m != nil {
-		return m.IdList
+		return m.Username
 	}
-	return nil
+	return ""
 }
 
-func (m *GetImportStateResponse) GetInfos() []*commonpb.KeyValuePair {
+func (m *UpdateCredentialRequest) GetOldPassword() string {
 	if m != nil {
-		return m.Infos
+		return m.OldPassword
 	}
-	return nil
+	return ""
 }
 
-func (m *GetImportStateResponse) GetId() int64 {
+func (m *UpdateCredentialRequest) GetNewPassword() string {
 	if m != nil {
-		return m.Id
+		return m.NewPassword
 	}
-	return 0
+	return ""
 }
 
-func (m *GetImportStateResponse) GetHeuristicDataQueryable() bool {
+func (m *UpdateCredentialRequest) GetCreatedUtcTimestamps() uint64 {
 	if m != nil {
-		return m.HeuristicDataQueryable
+		return m.CreatedUtcTimestamps
 	}
-	return false
+	return 0
 }
 
-func (m *GetImportStateResponse) GetHeuristicDataIndexed() bool {
+func (m *UpdateCredentialRequest) GetModifiedUtcTimestamps() uint64 {
 	if m != nil {
-		return m.HeuristicDataIndexed
+		return m.ModifiedUtcTimestamps
 	}
-	return false
+	return 0
 }
 
-type ListImportTasksRequest struct {
+type DeleteCredentialRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListImportTasksRequest) Reset()         { *m = ListImportTasksRequest{} }
-func (m *ListImportTasksRequest) String() string { return proto.CompactTextString(m) }
-func (*ListImportTasksRequest) ProtoMessage()    {}
-func (*ListImportTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{80}
+func (m *DeleteCredentialRequest) Reset()         { *m = DeleteCredentialRequest{} }
+func (m *DeleteCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCredentialRequest) ProtoMessage()    {}
+func (*DeleteCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{121}
 }
 
-func (m *ListImportTasksRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListImportTasksRequest.Unmarshal(m, b)
+func (m *DeleteCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCredentialRequest.Unmarshal(m, b)
 }
-func (m *ListImportTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListImportTasksRequest.Marshal(b, m, deterministic)
+func (m *DeleteCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCredentialRequest.Marshal(b, m, deterministic)
 }
-func (m *ListImportTasksRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListImportTasksRequest.Merge(m, src)
+func (m *DeleteCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCredentialRequest.Merge(m, src)
 }
-func (m *ListImportTasksRequest) XXX_Size() int {
-	return xxx_messageInfo_ListImportTasksRequest.Size(m)
+func (m *DeleteCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCredentialRequest.Size(m)
 }
-func (m *ListImportTasksRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListImportTasksRequest.DiscardUnknown(m)
+func (m *DeleteCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListImportTasksRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteCredentialRequest proto.InternalMessageInfo
 
-type ListImportTasksResponse struct {
-	Status               *commonpb.Status          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Tasks                []*GetImportStateResponse `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+func (m *DeleteCredentialRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
 }
 
-func (m *ListImportTasksResponse) Reset()         { *m = ListImportTasksResponse{} }
-func (m *ListImportTasksResponse) String() string { return proto.CompactTextString(m) }
-func (*ListImportTasksResponse) ProtoMessage()    {}
-func (*ListImportTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{81}
+func (m *DeleteCredentialRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
 }
 
-func (m *ListImportTasksResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListImportTasksResponse.Unmarshal(m, b)
+type ListCredUsersResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// username array
+	Usernames            []string `protobuf:"bytes,2,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ListImportTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListImportTasksResponse.Marshal(b, m, deterministic)
+
+func (m *ListCredUsersResponse) Reset()         { *m = ListCredUsersResponse{} }
+func (m *ListCredUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCredUsersResponse) ProtoMessage()    {}
+func (*ListCredUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{122}
 }
-func (m *ListImportTasksResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListImportTasksResponse.Merge(m, src)
+
+func (m *ListCredUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCredUsersResponse.Unmarshal(m, b)
 }
-func (m *ListImportTasksResponse) XXX_Size() int {
-	return xxx_messageInfo_ListImportTasksResponse.Size(m)
+func (m *ListCredUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCredUsersResponse.Marshal(b, m, deterministic)
 }
-func (m *ListImportTasksResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListImportTasksResponse.DiscardUnknown(m)
+func (m *ListCredUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCredUsersResponse.Merge(m, src)
+}
+func (m *ListCredUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCredUsersResponse.Size(m)
+}
+func (m *ListCredUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCredUsersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListImportTasksResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListCredUsersResponse proto.InternalMessageInfo
 
-func (m *ListImportTasksResponse) GetStatus() *commonpb.Status {
+func (m *ListCredUsersResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *ListImportTasksResponse) GetTasks() []*GetImportStateResponse {
+func (m *ListCredUsersResponse) GetUsernames() []string {
 	if m != nil {
-		return m.Tasks
+		return m.Usernames
 	}
 	return nil
 }
 
-type GetReplicasRequest struct {
+type ListCredUsersRequest struct {
+	// Not useful for now
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	WithShardNodes       bool              `protobuf:"varint,3,opt,name=with_shard_nodes,json=withShardNodes,proto3" json:"with_shard_nodes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetReplicasRequest) Reset()         { *m = GetReplicasRequest{} }
-func (m *GetReplicasRequest) String() string { return proto.CompactTextString(m) }
-func (*GetReplicasRequest) ProtoMessage()    {}
-func (*GetReplicasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{82}
+func (m *ListCredUsersRequest) Reset()         { *m = ListCredUsersRequest{} }
+func (m *ListCredUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCredUsersRequest) ProtoMessage()    {}
+func (*ListCredUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{123}
 }
 
-func (m *GetReplicasRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetReplicasRequest.Unmarshal(m, b)
+func (m *ListCredUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCredUsersRequest.Unmarshal(m, b)
 }
-func (m *GetReplicasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetReplicasRequest.Marshal(b, m, deterministic)
+func (m *ListCredUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCredUsersRequest.Marshal(b, m, deterministic)
 }
-func (m *GetReplicasRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetReplicasRequest.Merge(m, src)
+func (m *ListCredUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCredUsersRequest.Merge(m, src)
 }
-func (m *GetReplicasRequest) XXX_Size() int {
-	return xxx_messageInfo_GetReplicasRequest.Size(m)
+func (m *ListCredUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCredUsersRequest.Size(m)
 }
-func (m *GetReplicasRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetReplicasRequest.DiscardUnknown(m)
+func (m *ListCredUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCredUsersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetReplicasRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListCredUsersRequest proto.InternalMessageInfo
 
-func (m *GetReplicasRequest) GetBase() *commonpb.MsgBase {
+func (m *ListCredUsersRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *GetReplicasRequest) GetCollectionID() int64 {
+//*
+// The operations allowed on the collection, the collection is "*" for all the collections of the database
+type GrantEntity struct {
+	// the default database if empty and all the databases if "*"
+	DbName         string `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// the names of the RPCs, or "*" for all of them
+	Operations           []string `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GrantEntity) Reset()         { *m = GrantEntity{} }
+func (m *GrantEntity) String() string { return proto.CompactTextString(m) }
+func (*GrantEntity) ProtoMessage()    {}
+func (*GrantEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{124}
+}
+
+func (m *GrantEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantEntity.Unmarshal(m, b)
+}
+func (m *GrantEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantEntity.Marshal(b, m, deterministic)
+}
+func (m *GrantEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantEntity.Merge(m, src)
+}
+func (m *GrantEntity) XXX_Size() int {
+	return xxx_messageInfo_GrantEntity.Size(m)
+}
+func (m *GrantEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantEntity proto.InternalMessageInfo
+
+func (m *GrantEntity) GetDbName() string {
 	if m != nil {
-		return m.CollectionID
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-func (m *GetReplicasRequest) GetWithShardNodes() bool {
+func (m *GrantEntity) GetCollectionName() string {
 	if m != nil {
-		return m.WithShardNodes
+		return m.CollectionName
 	}
-	return false
+	return ""
 }
 
-type GetReplicasResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Replicas             []*ReplicaInfo   `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *GrantEntity) GetOperations() []string {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
 }
 
-func (m *GetReplicasResponse) Reset()         { *m = GetReplicasResponse{} }
-func (m *GetReplicasResponse) String() string { return proto.CompactTextString(m) }
-func (*GetReplicasResponse) ProtoMessage()    {}
-func (*GetReplicasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{83}
+type RoleEntity struct {
+	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Grants               []*GrantEntity `protobuf:"bytes,2,rep,name=grants,proto3" json:"grants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *GetReplicasResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetReplicasResponse.Unmarshal(m, b)
+func (m *RoleEntity) Reset()         { *m = RoleEntity{} }
+func (m *RoleEntity) String() string { return proto.CompactTextString(m) }
+func (*RoleEntity) ProtoMessage()    {}
+func (*RoleEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{125}
 }
-func (m *GetReplicasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetReplicasResponse.Marshal(b, m, deterministic)
+
+func (m *RoleEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleEntity.Unmarshal(m, b)
 }
-func (m *GetReplicasResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetReplicasResponse.Merge(m, src)
+func (m *RoleEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleEntity.Marshal(b, m, deterministic)
 }
-func (m *GetReplicasResponse) XXX_Size() int {
-	return xxx_messageInfo_GetReplicasResponse.Size(m)
+func (m *RoleEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleEntity.Merge(m, src)
 }
-func (m *GetReplicasResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetReplicasResponse.DiscardUnknown(m)
+func (m *RoleEntity) XXX_Size() int {
+	return xxx_messageInfo_RoleEntity.Size(m)
+}
+func (m *RoleEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleEntity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetReplicasResponse proto.InternalMessageInfo
+var xxx_messageInfo_RoleEntity proto.InternalMessageInfo
 
-func (m *GetReplicasResponse) GetStatus() *commonpb.Status {
+func (m *RoleEntity) GetName() string {
 	if m != nil {
-		return m.Status
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *GetReplicasResponse) GetReplicas() []*ReplicaInfo {
+func (m *RoleEntity) GetGrants() []*GrantEntity {
 	if m != nil {
-		return m.Replicas
+		return m.Grants
 	}
 	return nil
 }
 
-type ReplicaInfo struct {
-	ReplicaID            int64           `protobuf:"varint,1,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
-	CollectionID         int64           `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionIds         []int64         `protobuf:"varint,3,rep,packed,name=partition_ids,json=partitionIds,proto3" json:"partition_ids,omitempty"`
-	ShardReplicas        []*ShardReplica `protobuf:"bytes,4,rep,name=shard_replicas,json=shardReplicas,proto3" json:"shard_replicas,omitempty"`
-	NodeIds              []int64         `protobuf:"varint,5,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+//*
+// Create the role or replace the grants of it
+type SaveRoleRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Role                 *RoleEntity       `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-
-func (m *ReplicaInfo) Reset()         { *m = ReplicaInfo{} }
-func (m *ReplicaInfo) String() string { return proto.CompactTextString(m) }
-func (*ReplicaInfo) ProtoMessage()    {}
-func (*ReplicaInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{84}
+
+func (m *SaveRoleRequest) Reset()         { *m = SaveRoleRequest{} }
+func (m *SaveRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*SaveRoleRequest) ProtoMessage()    {}
+func (*SaveRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{126}
 }
 
-func (m *ReplicaInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReplicaInfo.Unmarshal(m, b)
+func (m *SaveRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SaveRoleRequest.Unmarshal(m, b)
 }
-func (m *ReplicaInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReplicaInfo.Marshal(b, m, deterministic)
+func (m *SaveRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SaveRoleRequest.Marshal(b, m, deterministic)
 }
-func (m *ReplicaInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplicaInfo.Merge(m, src)
+func (m *SaveRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveRoleRequest.Merge(m, src)
 }
-func (m *ReplicaInfo) XXX_Size() int {
-	return xxx_messageInfo_ReplicaInfo.Size(m)
+func (m *SaveRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_SaveRoleRequest.Size(m)
 }
-func (m *ReplicaInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplicaInfo.DiscardUnknown(m)
+func (m *SaveRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplicaInfo proto.InternalMessageInfo
+var xxx_messageInfo_SaveRoleRequest proto.InternalMessageInfo
 
-func (m *ReplicaInfo) GetReplicaID() int64 {
+func (m *SaveRoleRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.ReplicaID
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *ReplicaInfo) GetCollectionID() int64 {
+func (m *SaveRoleRequest) GetRole() *RoleEntity {
 	if m != nil {
-		return m.CollectionID
+		return m.Role
 	}
-	return 0
+	return nil
 }
 
-func (m *ReplicaInfo) GetPartitionIds() []int64 {
-	if m != nil {
-		return m.PartitionIds
-	}
-	return nil
+type DropRoleRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RoleName             string            `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ReplicaInfo) GetShardReplicas() []*ShardReplica {
+func (m *DropRoleRequest) Reset()         { *m = DropRoleRequest{} }
+func (m *DropRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*DropRoleRequest) ProtoMessage()    {}
+func (*DropRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{127}
+}
+
+func (m *DropRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropRoleRequest.Unmarshal(m, b)
+}
+func (m *DropRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *DropRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropRoleRequest.Merge(m, src)
+}
+func (m *DropRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_DropRoleRequest.Size(m)
+}
+func (m *DropRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropRoleRequest proto.InternalMessageInfo
+
+func (m *DropRoleRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.ShardReplicas
+		return m.Base
 	}
 	return nil
 }
 
-func (m *ReplicaInfo) GetNodeIds() []int64 {
+func (m *DropRoleRequest) GetRoleName() string {
 	if m != nil {
-		return m.NodeIds
+		return m.RoleName
 	}
-	return nil
+	return ""
 }
 
-type ShardReplica struct {
-	LeaderID      int64  `protobuf:"varint,1,opt,name=leaderID,proto3" json:"leaderID,omitempty"`
-	LeaderAddr    string `protobuf:"bytes,2,opt,name=leader_addr,json=leaderAddr,proto3" json:"leader_addr,omitempty"`
-	DmChannelName string `protobuf:"bytes,3,opt,name=dm_channel_name,json=dmChannelName,proto3" json:"dm_channel_name,omitempty"`
-	// optional, DO NOT save it in meta, set it only for GetReplicas()
-	// if with_shard_nodes is true
-	NodeIds              []int64  `protobuf:"varint,4,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ListRolesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ShardReplica) Reset()         { *m = ShardReplica{} }
-func (m *ShardReplica) String() string { return proto.CompactTextString(m) }
-func (*ShardReplica) ProtoMessage()    {}
-func (*ShardReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{85}
+func (m *ListRolesRequest) Reset()         { *m = ListRolesRequest{} }
+func (m *ListRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRolesRequest) ProtoMessage()    {}
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{128}
 }
 
-func (m *ShardReplica) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShardReplica.Unmarshal(m, b)
+func (m *ListRolesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRolesRequest.Unmarshal(m, b)
 }
-func (m *ShardReplica) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShardReplica.Marshal(b, m, deterministic)
+func (m *ListRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRolesRequest.Marshal(b, m, deterministic)
 }
-func (m *ShardReplica) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShardReplica.Merge(m, src)
+func (m *ListRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRolesRequest.Merge(m, src)
 }
-func (m *ShardReplica) XXX_Size() int {
-	return xxx_messageInfo_ShardReplica.Size(m)
+func (m *ListRolesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRolesRequest.Size(m)
 }
-func (m *ShardReplica) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShardReplica.DiscardUnknown(m)
+func (m *ListRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRolesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShardReplica proto.InternalMessageInfo
+var xxx_messageInfo_ListRolesRequest proto.InternalMessageInfo
 
-func (m *ShardReplica) GetLeaderID() int64 {
+func (m *ListRolesRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.LeaderID
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *ShardReplica) GetLeaderAddr() string {
-	if m != nil {
-		return m.LeaderAddr
-	}
-	return ""
+//*
+// The roles sorted by name
+type ListRolesResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Roles                []*RoleEntity    `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *ShardReplica) GetDmChannelName() string {
+func (m *ListRolesResponse) Reset()         { *m = ListRolesResponse{} }
+func (m *ListRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRolesResponse) ProtoMessage()    {}
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{129}
+}
+
+func (m *ListRolesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRolesResponse.Unmarshal(m, b)
+}
+func (m *ListRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRolesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRolesResponse.Merge(m, src)
+}
+func (m *ListRolesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRolesResponse.Size(m)
+}
+func (m *ListRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRolesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRolesResponse proto.InternalMessageInfo
+
+func (m *ListRolesResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.DmChannelName
+		return m.Status
 	}
-	return ""
+	return nil
 }
 
-func (m *ShardReplica) GetNodeIds() []int64 {
+func (m *ListRolesResponse) GetRoles() []*RoleEntity {
 	if m != nil {
-		return m.NodeIds
+		return m.Roles
 	}
 	return nil
 }
 
-type CreateCredentialRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// username
-	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	// ciphertext password
-	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	// create time
-	CreatedUtcTimestamps uint64 `protobuf:"varint,4,opt,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
-	// modify time
-	ModifiedUtcTimestamps uint64   `protobuf:"varint,5,opt,name=modified_utc_timestamps,json=modifiedUtcTimestamps,proto3" json:"modified_utc_timestamps,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
-	XXX_unrecognized      []byte   `json:"-"`
-	XXX_sizecache         int32    `json:"-"`
+//*
+// Bind the roles to the user, the roles bound before are replaced and an empty list unbinds them
+type SaveUserRolesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	RoleNames            []string          `protobuf:"bytes,3,rep,name=role_names,json=roleNames,proto3" json:"role_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *CreateCredentialRequest) Reset()         { *m = CreateCredentialRequest{} }
-func (m *CreateCredentialRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateCredentialRequest) ProtoMessage()    {}
-func (*CreateCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{86}
+func (m *SaveUserRolesRequest) Reset()         { *m = SaveUserRolesRequest{} }
+func (m *SaveUserRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*SaveUserRolesRequest) ProtoMessage()    {}
+func (*SaveUserRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{130}
 }
 
-func (m *CreateCredentialRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateCredentialRequest.Unmarshal(m, b)
+func (m *SaveUserRolesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SaveUserRolesRequest.Unmarshal(m, b)
 }
-func (m *CreateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateCredentialRequest.Marshal(b, m, deterministic)
+func (m *SaveUserRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SaveUserRolesRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateCredentialRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateCredentialRequest.Merge(m, src)
+func (m *SaveUserRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveUserRolesRequest.Merge(m, src)
 }
-func (m *CreateCredentialRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateCredentialRequest.Size(m)
+func (m *SaveUserRolesRequest) XXX_Size() int {
+	return xxx_messageInfo_SaveUserRolesRequest.Size(m)
 }
-func (m *CreateCredentialRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateCredentialRequest.DiscardUnknown(m)
+func (m *SaveUserRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveUserRolesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateCredentialRequest proto.InternalMessageInfo
+var xxx_messageInfo_SaveUserRolesRequest proto.InternalMessageInfo
 
-func (m *CreateCredentialRequest) GetBase() *commonpb.MsgBase {
+func (m *SaveUserRolesRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *CreateCredentialRequest) GetUsername() string {
+func (m *SaveUserRolesRequest) GetUsername() string {
 	if m != nil {
 		return m.Username
 	}
 	return ""
 }
 
-func (m *CreateCredentialRequest) GetPassword() string {
+func (m *SaveUserRolesRequest) GetRoleNames() []string {
 	if m != nil {
-		return m.Password
+		return m.RoleNames
 	}
-	return ""
+	return nil
 }
 
-func (m *CreateCredentialRequest) GetCreatedUtcTimestamps() uint64 {
+type UserRolesEntity struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	RoleNames            []string `protobuf:"bytes,2,rep,name=role_names,json=roleNames,proto3" json:"role_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserRolesEntity) Reset()         { *m = UserRolesEntity{} }
+func (m *UserRolesEntity) String() string { return proto.CompactTextString(m) }
+func (*UserRolesEntity) ProtoMessage()    {}
+func (*UserRolesEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{131}
+}
+
+func (m *UserRolesEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserRolesEntity.Unmarshal(m, b)
+}
+func (m *UserRolesEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserRolesEntity.Marshal(b, m, deterministic)
+}
+func (m *UserRolesEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserRolesEntity.Merge(m, src)
+}
+func (m *UserRolesEntity) XXX_Size() int {
+	return xxx_messageInfo_UserRolesEntity.Size(m)
+}
+func (m *UserRolesEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserRolesEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserRolesEntity proto.InternalMessageInfo
+
+func (m *UserRolesEntity) GetUsername() string {
 	if m != nil {
-		return m.CreatedUtcTimestamps
+		return m.Username
 	}
-	return 0
+	return ""
 }
 
-func (m *CreateCredentialRequest) GetModifiedUtcTimestamps() uint64 {
+func (m *UserRolesEntity) GetRoleNames() []string {
 	if m != nil {
-		return m.ModifiedUtcTimestamps
+		return m.RoleNames
 	}
-	return 0
+	return nil
 }
 
-type UpdateCredentialRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// username
-	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	// old password
-	OldPassword string `protobuf:"bytes,3,opt,name=oldPassword,proto3" json:"oldPassword,omitempty"`
-	// new password
-	NewPassword string `protobuf:"bytes,4,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
-	// create time
-	CreatedUtcTimestamps uint64 `protobuf:"varint,5,opt,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
-	// modify time
-	ModifiedUtcTimestamps uint64   `protobuf:"varint,6,opt,name=modified_utc_timestamps,json=modifiedUtcTimestamps,proto3" json:"modified_utc_timestamps,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
-	XXX_unrecognized      []byte   `json:"-"`
-	XXX_sizecache         int32    `json:"-"`
+type ListUserRolesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *UpdateCredentialRequest) Reset()         { *m = UpdateCredentialRequest{} }
-func (m *UpdateCredentialRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateCredentialRequest) ProtoMessage()    {}
-func (*UpdateCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{87}
+func (m *ListUserRolesRequest) Reset()         { *m = ListUserRolesRequest{} }
+func (m *ListUserRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUserRolesRequest) ProtoMessage()    {}
+func (*ListUserRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{132}
 }
 
-func (m *UpdateCredentialRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateCredentialRequest.Unmarshal(m, b)
+func (m *ListUserRolesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUserRolesRequest.Unmarshal(m, b)
 }
-func (m *UpdateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateCredentialRequest.Marshal(b, m, deterministic)
+func (m *ListUserRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUserRolesRequest.Marshal(b, m, deterministic)
 }
-func (m *UpdateCredentialRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateCredentialRequest.Merge(m, src)
+func (m *ListUserRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUserRolesRequest.Merge(m, src)
 }
-func (m *UpdateCredentialRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateCredentialRequest.Size(m)
+func (m *ListUserRolesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUserRolesRequest.Size(m)
 }
-func (m *UpdateCredentialRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateCredentialRequest.DiscardUnknown(m)
+func (m *ListUserRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUserRolesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateCredentialRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListUserRolesRequest proto.InternalMessageInfo
 
-func (m *UpdateCredentialRequest) GetBase() *commonpb.MsgBase {
+func (m *ListUserRolesRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *UpdateCredentialRequest) GetUsername() string {
-	if m != nil {
-		return m.Username
-	}
-	return ""
+//*
+// The roles bound to the users sorted by username
+type ListUserRolesResponse struct {
+	Status               *commonpb.Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	UserRoles            []*UserRolesEntity `protobuf:"bytes,2,rep,name=user_roles,json=userRoles,proto3" json:"user_roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *UpdateCredentialRequest) GetOldPassword() string {
-	if m != nil {
-		return m.OldPassword
-	}
-	return ""
+func (m *ListUserRolesResponse) Reset()         { *m = ListUserRolesResponse{} }
+func (m *ListUserRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUserRolesResponse) ProtoMessage()    {}
+func (*ListUserRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{133}
 }
 
-func (m *UpdateCredentialRequest) GetNewPassword() string {
-	if m != nil {
-		return m.NewPassword
-	}
-	return ""
+func (m *ListUserRolesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUserRolesResponse.Unmarshal(m, b)
+}
+func (m *ListUserRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUserRolesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListUserRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUserRolesResponse.Merge(m, src)
+}
+func (m *ListUserRolesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListUserRolesResponse.Size(m)
+}
+func (m *ListUserRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUserRolesResponse.DiscardUnknown(m)
 }
 
-func (m *UpdateCredentialRequest) GetCreatedUtcTimestamps() uint64 {
+var xxx_messageInfo_ListUserRolesResponse proto.InternalMessageInfo
+
+func (m *ListUserRolesResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.CreatedUtcTimestamps
+		return m.Status
 	}
-	return 0
+	return nil
 }
 
-func (m *UpdateCredentialRequest) GetModifiedUtcTimestamps() uint64 {
+func (m *ListUserRolesResponse) GetUserRoles() []*UserRolesEntity {
 	if m != nil {
-		return m.ModifiedUtcTimestamps
+		return m.UserRoles
 	}
-	return 0
+	return nil
 }
 
-type DeleteCredentialRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+//*
+// Create an API key authenticating the requests as the user
+type CreateAPIKeyRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DeleteCredentialRequest) Reset()         { *m = DeleteCredentialRequest{} }
-func (m *DeleteCredentialRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteCredentialRequest) ProtoMessage()    {}
-func (*DeleteCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{88}
+func (m *CreateAPIKeyRequest) Reset()         { *m = CreateAPIKeyRequest{} }
+func (m *CreateAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAPIKeyRequest) ProtoMessage()    {}
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{134}
 }
 
-func (m *DeleteCredentialRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteCredentialRequest.Unmarshal(m, b)
+func (m *CreateAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAPIKeyRequest.Unmarshal(m, b)
 }
-func (m *DeleteCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteCredentialRequest.Marshal(b, m, deterministic)
+func (m *CreateAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAPIKeyRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteCredentialRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteCredentialRequest.Merge(m, src)
+func (m *CreateAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAPIKeyRequest.Merge(m, src)
 }
-func (m *DeleteCredentialRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteCredentialRequest.Size(m)
+func (m *CreateAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAPIKeyRequest.Size(m)
 }
-func (m *DeleteCredentialRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteCredentialRequest.DiscardUnknown(m)
+func (m *CreateAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAPIKeyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteCredentialRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateAPIKeyRequest proto.InternalMessageInfo
 
-func (m *DeleteCredentialRequest) GetBase() *commonpb.MsgBase {
+func (m *CreateAPIKeyRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DeleteCredentialRequest) GetUsername() string {
+func (m *CreateAPIKeyRequest) GetUsername() string {
 	if m != nil {
 		return m.Username
 	}
 	return ""
 }
 
-type ListCredUsersResponse struct {
-	// Contain error_code and reason
-	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// username array
-	Usernames            []string `protobuf:"bytes,2,rep,name=usernames,proto3" json:"usernames,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+//*
+// The API key is only returned here, root coord only keeps the hash of it
+type CreateAPIKeyResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ApiKey               string           `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *ListCredUsersResponse) Reset()         { *m = ListCredUsersResponse{} }
-func (m *ListCredUsersResponse) String() string { return proto.CompactTextString(m) }
-func (*ListCredUsersResponse) ProtoMessage()    {}
-func (*ListCredUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{89}
+func (m *CreateAPIKeyResponse) Reset()         { *m = CreateAPIKeyResponse{} }
+func (m *CreateAPIKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAPIKeyResponse) ProtoMessage()    {}
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{135}
 }
 
-func (m *ListCredUsersResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListCredUsersResponse.Unmarshal(m, b)
+func (m *CreateAPIKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAPIKeyResponse.Unmarshal(m, b)
 }
-func (m *ListCredUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListCredUsersResponse.Marshal(b, m, deterministic)
+func (m *CreateAPIKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAPIKeyResponse.Marshal(b, m, deterministic)
 }
-func (m *ListCredUsersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListCredUsersResponse.Merge(m, src)
+func (m *CreateAPIKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAPIKeyResponse.Merge(m, src)
 }
-func (m *ListCredUsersResponse) XXX_Size() int {
-	return xxx_messageInfo_ListCredUsersResponse.Size(m)
+func (m *CreateAPIKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateAPIKeyResponse.Size(m)
 }
-func (m *ListCredUsersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListCredUsersResponse.DiscardUnknown(m)
+func (m *CreateAPIKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAPIKeyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListCredUsersResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateAPIKeyResponse proto.InternalMessageInfo
 
-func (m *ListCredUsersResponse) GetStatus() *commonpb.Status {
+func (m *CreateAPIKeyResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *ListCredUsersResponse) GetUsernames() []string {
+func (m *CreateAPIKeyResponse) GetApiKey() string {
 	if m != nil {
-		return m.Usernames
+		return m.ApiKey
 	}
-	return nil
+	return ""
 }
 
-type ListCredUsersRequest struct {
-	// Not useful for now
+type RevokeAPIKeyRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ApiKey               string            `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ListCredUsersRequest) Reset()         { *m = ListCredUsersRequest{} }
-func (m *ListCredUsersRequest) String() string { return proto.CompactTextString(m) }
-func (*ListCredUsersRequest) ProtoMessage()    {}
-func (*ListCredUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{90}
+func (m *RevokeAPIKeyRequest) Reset()         { *m = RevokeAPIKeyRequest{} }
+func (m *RevokeAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeAPIKeyRequest) ProtoMessage()    {}
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{136}
 }
 
-func (m *ListCredUsersRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListCredUsersRequest.Unmarshal(m, b)
+func (m *RevokeAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Unmarshal(m, b)
 }
-func (m *ListCredUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListCredUsersRequest.Marshal(b, m, deterministic)
+func (m *RevokeAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Marshal(b, m, deterministic)
 }
-func (m *ListCredUsersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListCredUsersRequest.Merge(m, src)
+func (m *RevokeAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAPIKeyRequest.Merge(m, src)
 }
-func (m *ListCredUsersRequest) XXX_Size() int {
-	return xxx_messageInfo_ListCredUsersRequest.Size(m)
+func (m *RevokeAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Size(m)
 }
-func (m *ListCredUsersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListCredUsersRequest.DiscardUnknown(m)
+func (m *RevokeAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAPIKeyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListCredUsersRequest proto.InternalMessageInfo
+var xxx_messageInfo_RevokeAPIKeyRequest proto.InternalMessageInfo
 
-func (m *ListCredUsersRequest) GetBase() *commonpb.MsgBase {
+func (m *RevokeAPIKeyRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
+func (m *RevokeAPIKeyRequest) GetApiKey() string {
+	if m != nil {
+		return m.ApiKey
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("milvus.proto.milvus.ReleaseJobState", ReleaseJobState_name, ReleaseJobState_value)
 	proto.RegisterEnum("milvus.proto.milvus.ShowType", ShowType_name, ShowType_value)
 	proto.RegisterEnum("milvus.proto.milvus.PlaceholderType", PlaceholderType_name, PlaceholderType_value)
+	proto.RegisterEnum("milvus.proto.milvus.SegmentScan", SegmentScan_name, SegmentScan_value)
+	proto.RegisterEnum("milvus.proto.milvus.CordonedNodeState", CordonedNodeState_name, CordonedNodeState_value)
 	proto.RegisterType((*CreateAliasRequest)(nil), "milvus.proto.milvus.CreateAliasRequest")
 	proto.RegisterType((*DropAliasRequest)(nil), "milvus.proto.milvus.DropAliasRequest")
 	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.milvus.AlterAliasRequest")
+	proto.RegisterType((*RenameCollectionRequest)(nil), "milvus.proto.milvus.RenameCollectionRequest")
+	proto.RegisterType((*AddCollectionFieldRequest)(nil), "milvus.proto.milvus.AddCollectionFieldRequest")
+	proto.RegisterType((*AlterCollectionRequest)(nil), "milvus.proto.milvus.AlterCollectionRequest")
+	proto.RegisterType((*CreateDatabaseRequest)(nil), "milvus.proto.milvus.CreateDatabaseRequest")
+	proto.RegisterType((*DropDatabaseRequest)(nil), "milvus.proto.milvus.DropDatabaseRequest")
+	proto.RegisterType((*AlterDatabaseRequest)(nil), "milvus.proto.milvus.AlterDatabaseRequest")
+	proto.RegisterType((*ListDatabasesRequest)(nil), "milvus.proto.milvus.ListDatabasesRequest")
+	proto.RegisterType((*ListDatabasesResponse)(nil), "milvus.proto.milvus.ListDatabasesResponse")
 	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.milvus.CreateCollectionRequest")
 	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.milvus.DropCollectionRequest")
 	proto.RegisterType((*HasCollectionRequest)(nil), "milvus.proto.milvus.HasCollectionRequest")
@@ -5870,6 +8705,9 @@ func init() {
 	proto.RegisterType((*DescribeCollectionResponse)(nil), "milvus.proto.milvus.DescribeCollectionResponse")
 	proto.RegisterType((*LoadCollectionRequest)(nil), "milvus.proto.milvus.LoadCollectionRequest")
 	proto.RegisterType((*ReleaseCollectionRequest)(nil), "milvus.proto.milvus.ReleaseCollectionRequest")
+	proto.RegisterType((*GetReleaseJobsRequest)(nil), "milvus.proto.milvus.GetReleaseJobsRequest")
+	proto.RegisterType((*ReleaseJob)(nil), "milvus.proto.milvus.ReleaseJob")
+	proto.RegisterType((*GetReleaseJobsResponse)(nil), "milvus.proto.milvus.GetReleaseJobsResponse")
 	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.milvus.GetCollectionStatisticsRequest")
 	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.milvus.GetCollectionStatisticsResponse")
 	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.milvus.ShowCollectionsRequest")
@@ -5893,10 +8731,13 @@ func init() {
 	proto.RegisterType((*DescribeIndexResponse)(nil), "milvus.proto.milvus.DescribeIndexResponse")
 	proto.RegisterType((*GetIndexBuildProgressRequest)(nil), "milvus.proto.milvus.GetIndexBuildProgressRequest")
 	proto.RegisterType((*GetIndexBuildProgressResponse)(nil), "milvus.proto.milvus.GetIndexBuildProgressResponse")
+	proto.RegisterType((*CancelIndexBuildRequest)(nil), "milvus.proto.milvus.CancelIndexBuildRequest")
+	proto.RegisterType((*CancelIndexBuildResponse)(nil), "milvus.proto.milvus.CancelIndexBuildResponse")
 	proto.RegisterType((*GetIndexStateRequest)(nil), "milvus.proto.milvus.GetIndexStateRequest")
 	proto.RegisterType((*GetIndexStateResponse)(nil), "milvus.proto.milvus.GetIndexStateResponse")
 	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.milvus.DropIndexRequest")
 	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.milvus.InsertRequest")
+	proto.RegisterType((*UpsertRequest)(nil), "milvus.proto.milvus.UpsertRequest")
 	proto.RegisterType((*MutationResult)(nil), "milvus.proto.milvus.MutationResult")
 	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.milvus.DeleteRequest")
 	proto.RegisterType((*PlaceholderValue)(nil), "milvus.proto.milvus.PlaceholderValue")
@@ -5909,6 +8750,13 @@ func init() {
 	proto.RegisterMapType((map[string]*schemapb.LongArray)(nil), "milvus.proto.milvus.FlushResponse.CollSegIDsEntry")
 	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.milvus.QueryRequest")
 	proto.RegisterType((*QueryResults)(nil), "milvus.proto.milvus.QueryResults")
+	proto.RegisterType((*RegisterTemplateRequest)(nil), "milvus.proto.milvus.RegisterTemplateRequest")
+	proto.RegisterType((*RegisterTemplateResponse)(nil), "milvus.proto.milvus.RegisterTemplateResponse")
+	proto.RegisterType((*DropTemplateRequest)(nil), "milvus.proto.milvus.DropTemplateRequest")
+	proto.RegisterType((*ExplainRequest)(nil), "milvus.proto.milvus.ExplainRequest")
+	proto.RegisterType((*ExplainedSegment)(nil), "milvus.proto.milvus.ExplainedSegment")
+	proto.RegisterType((*ExplainCost)(nil), "milvus.proto.milvus.ExplainCost")
+	proto.RegisterType((*ExplainResponse)(nil), "milvus.proto.milvus.ExplainResponse")
 	proto.RegisterType((*VectorIDs)(nil), "milvus.proto.milvus.VectorIDs")
 	proto.RegisterType((*VectorsArray)(nil), "milvus.proto.milvus.VectorsArray")
 	proto.RegisterType((*CalcDistanceRequest)(nil), "milvus.proto.milvus.CalcDistanceRequest")
@@ -5926,6 +8774,14 @@ func init() {
 	proto.RegisterType((*GetMetricsRequest)(nil), "milvus.proto.milvus.GetMetricsRequest")
 	proto.RegisterType((*GetMetricsResponse)(nil), "milvus.proto.milvus.GetMetricsResponse")
 	proto.RegisterType((*LoadBalanceRequest)(nil), "milvus.proto.milvus.LoadBalanceRequest")
+	proto.RegisterType((*CordonNodeRequest)(nil), "milvus.proto.milvus.CordonNodeRequest")
+	proto.RegisterType((*UncordonNodeRequest)(nil), "milvus.proto.milvus.UncordonNodeRequest")
+	proto.RegisterType((*GetCordonedNodesRequest)(nil), "milvus.proto.milvus.GetCordonedNodesRequest")
+	proto.RegisterType((*CordonedNode)(nil), "milvus.proto.milvus.CordonedNode")
+	proto.RegisterType((*CordonedNodesResponse)(nil), "milvus.proto.milvus.CordonedNodesResponse")
+	proto.RegisterType((*BalanceRequest)(nil), "milvus.proto.milvus.BalanceRequest")
+	proto.RegisterType((*BalanceMove)(nil), "milvus.proto.milvus.BalanceMove")
+	proto.RegisterType((*BalanceResponse)(nil), "milvus.proto.milvus.BalanceResponse")
 	proto.RegisterType((*ManualCompactionRequest)(nil), "milvus.proto.milvus.ManualCompactionRequest")
 	proto.RegisterType((*ManualCompactionResponse)(nil), "milvus.proto.milvus.ManualCompactionResponse")
 	proto.RegisterType((*GetCompactionStateRequest)(nil), "milvus.proto.milvus.GetCompactionStateRequest")
@@ -5939,10 +8795,14 @@ func init() {
 	proto.RegisterType((*ImportResponse)(nil), "milvus.proto.milvus.ImportResponse")
 	proto.RegisterType((*GetImportStateRequest)(nil), "milvus.proto.milvus.GetImportStateRequest")
 	proto.RegisterType((*GetImportStateResponse)(nil), "milvus.proto.milvus.GetImportStateResponse")
+	proto.RegisterType((*CancelImportRequest)(nil), "milvus.proto.milvus.CancelImportRequest")
 	proto.RegisterType((*ListImportTasksRequest)(nil), "milvus.proto.milvus.ListImportTasksRequest")
 	proto.RegisterType((*ListImportTasksResponse)(nil), "milvus.proto.milvus.ListImportTasksResponse")
 	proto.RegisterType((*GetReplicasRequest)(nil), "milvus.proto.milvus.GetReplicasRequest")
 	proto.RegisterType((*GetReplicasResponse)(nil), "milvus.proto.milvus.GetReplicasResponse")
+	proto.RegisterType((*GetLoadingProgressRequest)(nil), "milvus.proto.milvus.GetLoadingProgressRequest")
+	proto.RegisterType((*SegmentLoadingProgress)(nil), "milvus.proto.milvus.SegmentLoadingProgress")
+	proto.RegisterType((*GetLoadingProgressResponse)(nil), "milvus.proto.milvus.GetLoadingProgressResponse")
 	proto.RegisterType((*ReplicaInfo)(nil), "milvus.proto.milvus.ReplicaInfo")
 	proto.RegisterType((*ShardReplica)(nil), "milvus.proto.milvus.ShardReplica")
 	proto.RegisterType((*CreateCredentialRequest)(nil), "milvus.proto.milvus.CreateCredentialRequest")
@@ -5950,285 +8810,408 @@ func init() {
 	proto.RegisterType((*DeleteCredentialRequest)(nil), "milvus.proto.milvus.DeleteCredentialRequest")
 	proto.RegisterType((*ListCredUsersResponse)(nil), "milvus.proto.milvus.ListCredUsersResponse")
 	proto.RegisterType((*ListCredUsersRequest)(nil), "milvus.proto.milvus.ListCredUsersRequest")
+	proto.RegisterType((*GrantEntity)(nil), "milvus.proto.milvus.GrantEntity")
+	proto.RegisterType((*RoleEntity)(nil), "milvus.proto.milvus.RoleEntity")
+	proto.RegisterType((*SaveRoleRequest)(nil), "milvus.proto.milvus.SaveRoleRequest")
+	proto.RegisterType((*DropRoleRequest)(nil), "milvus.proto.milvus.DropRoleRequest")
+	proto.RegisterType((*ListRolesRequest)(nil), "milvus.proto.milvus.ListRolesRequest")
+	proto.RegisterType((*ListRolesResponse)(nil), "milvus.proto.milvus.ListRolesResponse")
+	proto.RegisterType((*SaveUserRolesRequest)(nil), "milvus.proto.milvus.SaveUserRolesRequest")
+	proto.RegisterType((*UserRolesEntity)(nil), "milvus.proto.milvus.UserRolesEntity")
+	proto.RegisterType((*ListUserRolesRequest)(nil), "milvus.proto.milvus.ListUserRolesRequest")
+	proto.RegisterType((*ListUserRolesResponse)(nil), "milvus.proto.milvus.ListUserRolesResponse")
+	proto.RegisterType((*CreateAPIKeyRequest)(nil), "milvus.proto.milvus.CreateAPIKeyRequest")
+	proto.RegisterType((*CreateAPIKeyResponse)(nil), "milvus.proto.milvus.CreateAPIKeyResponse")
+	proto.RegisterType((*RevokeAPIKeyRequest)(nil), "milvus.proto.milvus.RevokeAPIKeyRequest")
 }
 
 func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
 
 var fileDescriptor_02345ba45cc0e303 = []byte{
-	// 4366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3c, 0x4d, 0x8f, 0x1c, 0xc7,
-	0x75, 0xdb, 0x33, 0x3b, 0x5f, 0x6f, 0x66, 0x76, 0x87, 0xb5, 0x5f, 0xa3, 0xa1, 0x28, 0x2e, 0x5b,
-	0xa2, 0xb4, 0x22, 0x2d, 0xd2, 0x5a, 0xca, 0x92, 0x22, 0x39, 0x91, 0x49, 0x6e, 0x44, 0x2e, 0x44,
-	0x32, 0xab, 0x5e, 0xc9, 0x86, 0x23, 0x10, 0x8d, 0xda, 0xe9, 0xda, 0xd9, 0x0e, 0x7b, 0xba, 0x47,
-	0x5d, 0x35, 0x5c, 0xae, 0x4e, 0x06, 0x1c, 0xe4, 0x03, 0x56, 0x64, 0x18, 0x31, 0x92, 0xf8, 0x90,
-	0x20, 0xc8, 0x07, 0x90, 0x1c, 0x12, 0xc4, 0x0e, 0x90, 0x04, 0xb9, 0x24, 0x87, 0x1c, 0x72, 0x08,
-	0x90, 0x8f, 0x4b, 0x10, 0xf8, 0x92, 0x3f, 0x90, 0x43, 0x00, 0x1f, 0x73, 0x08, 0xea, 0xa3, 0x7b,
-	0xba, 0x7b, 0xaa, 0x67, 0x67, 0x39, 0xa6, 0xb9, 0xbc, 0x4d, 0xbf, 0x7a, 0xaf, 0xea, 0xd5, 0xab,
-	0x57, 0xaf, 0x5e, 0xbd, 0xf7, 0x6a, 0xa0, 0xd1, 0x77, 0xbd, 0x87, 0x43, 0x7a, 0x65, 0x10, 0x06,
-	0x2c, 0x40, 0x4b, 0xc9, 0xaf, 0x2b, 0xf2, 0xa3, 0xd3, 0xe8, 0x06, 0xfd, 0x7e, 0xe0, 0x4b, 0x60,
-	0xa7, 0x41, 0xbb, 0x07, 0xa4, 0x8f, 0xe5, 0x97, 0xf9, 0x07, 0x06, 0xa0, 0x9b, 0x21, 0xc1, 0x8c,
-	0x5c, 0xf7, 0x5c, 0x4c, 0x2d, 0xf2, 0xe9, 0x90, 0x50, 0x86, 0xbe, 0x0c, 0xf3, 0x7b, 0x98, 0x92,
-	0xb6, 0xb1, 0x6e, 0x6c, 0xd4, 0x37, 0x9f, 0xbf, 0x92, 0xea, 0x56, 0x75, 0x77, 0x97, 0xf6, 0x6e,
-	0x60, 0x4a, 0x2c, 0x81, 0x89, 0xd6, 0xa0, 0xe2, 0xec, 0xd9, 0x3e, 0xee, 0x93, 0x76, 0x61, 0xdd,
-	0xd8, 0xa8, 0x59, 0x65, 0x67, 0xef, 0x1e, 0xee, 0x13, 0xf4, 0x0a, 0x2c, 0x76, 0x03, 0xcf, 0x23,
-	0x5d, 0xe6, 0x06, 0xbe, 0x44, 0x28, 0x0a, 0x84, 0x85, 0x11, 0x58, 0x20, 0x2e, 0x43, 0x09, 0x73,
-	0x1e, 0xda, 0xf3, 0xa2, 0x59, 0x7e, 0x98, 0x14, 0x5a, 0x5b, 0x61, 0x30, 0x78, 0x52, 0xdc, 0xc5,
-	0x83, 0x16, 0x93, 0x83, 0xfe, 0xbe, 0x01, 0x67, 0xae, 0x7b, 0x8c, 0x84, 0xa7, 0x54, 0x28, 0xbf,
-	0x57, 0x80, 0x35, 0xb9, 0x6a, 0x37, 0x63, 0xf4, 0xa7, 0xc9, 0xe5, 0x2a, 0x94, 0xa5, 0x56, 0x09,
-	0x36, 0x1b, 0x96, 0xfa, 0x42, 0xe7, 0x00, 0xe8, 0x01, 0x0e, 0x1d, 0x6a, 0xfb, 0xc3, 0x7e, 0xbb,
-	0xb4, 0x6e, 0x6c, 0x94, 0xac, 0x9a, 0x84, 0xdc, 0x1b, 0xf6, 0x91, 0x05, 0x67, 0xba, 0x81, 0x4f,
-	0x5d, 0xca, 0x88, 0xdf, 0x3d, 0xb2, 0x3d, 0xf2, 0x90, 0x78, 0xed, 0xf2, 0xba, 0xb1, 0xb1, 0xb0,
-	0x79, 0x51, 0xcb, 0xf7, 0xcd, 0x11, 0xf6, 0x1d, 0x8e, 0x6c, 0xb5, 0xba, 0x19, 0x88, 0xf9, 0x1d,
-	0x03, 0x56, 0xb8, 0xc2, 0x9c, 0x0a, 0xc1, 0x98, 0x7f, 0x6e, 0xc0, 0xf2, 0x6d, 0x4c, 0x4f, 0xc7,
-	0x2a, 0x9d, 0x03, 0x60, 0x6e, 0x9f, 0xd8, 0x94, 0xe1, 0xfe, 0x40, 0xac, 0xd4, 0xbc, 0x55, 0xe3,
-	0x90, 0x5d, 0x0e, 0x30, 0xbf, 0x09, 0x8d, 0x1b, 0x41, 0xe0, 0x59, 0x84, 0x0e, 0x02, 0x9f, 0x12,
-	0x74, 0x0d, 0xca, 0x94, 0x61, 0x36, 0xa4, 0x8a, 0xc9, 0xb3, 0x5a, 0x26, 0x77, 0x05, 0x8a, 0xa5,
-	0x50, 0xb9, 0xbe, 0x3e, 0xc4, 0xde, 0x50, 0xf2, 0x58, 0xb5, 0xe4, 0x87, 0xf9, 0x09, 0x2c, 0xec,
-	0xb2, 0xd0, 0xf5, 0x7b, 0x3f, 0xc5, 0xce, 0x6b, 0x51, 0xe7, 0xff, 0x61, 0xc0, 0x73, 0x5b, 0x84,
-	0x76, 0x43, 0x77, 0xef, 0x94, 0x6c, 0x07, 0x13, 0x1a, 0x23, 0xc8, 0xf6, 0x96, 0x10, 0x75, 0xd1,
-	0x4a, 0xc1, 0x32, 0x8b, 0x51, 0xca, 0x2e, 0xc6, 0xb7, 0x4a, 0xd0, 0xd1, 0x4d, 0x6a, 0x16, 0xf1,
-	0xfd, 0x7c, 0xbc, 0x4b, 0x0b, 0x82, 0x28, 0xb3, 0xc7, 0xd4, 0xb9, 0x30, 0x1a, 0x6d, 0x57, 0x00,
-	0xe2, 0xcd, 0x9c, 0x9d, 0x55, 0x51, 0x33, 0xab, 0x4d, 0x58, 0x79, 0xe8, 0x86, 0x6c, 0x88, 0x3d,
-	0xbb, 0x7b, 0x80, 0x7d, 0x9f, 0x78, 0x42, 0x4e, 0xdc, 0x7c, 0x15, 0x37, 0x6a, 0xd6, 0x92, 0x6a,
-	0xbc, 0x29, 0xdb, 0xb8, 0xb0, 0x28, 0x7a, 0x03, 0x56, 0x07, 0x07, 0x47, 0xd4, 0xed, 0x8e, 0x11,
-	0x95, 0x04, 0xd1, 0x72, 0xd4, 0x9a, 0xa2, 0xba, 0x0c, 0x67, 0xba, 0xc2, 0x02, 0x3a, 0x36, 0x97,
-	0x9a, 0x14, 0x63, 0x59, 0x88, 0xb1, 0xa5, 0x1a, 0x3e, 0x8a, 0xe0, 0x9c, 0xad, 0x08, 0x79, 0xc8,
-	0xba, 0x09, 0x82, 0x8a, 0x20, 0x58, 0x52, 0x8d, 0x1f, 0xb3, 0xee, 0x88, 0x26, 0x6d, 0xbb, 0xaa,
-	0x59, 0xdb, 0xd5, 0x86, 0x8a, 0xb0, 0xc5, 0x84, 0xb6, 0x6b, 0x82, 0xcd, 0xe8, 0x13, 0x6d, 0xc3,
-	0x22, 0x65, 0x38, 0x64, 0xf6, 0x20, 0xa0, 0x2e, 0x97, 0x0b, 0x6d, 0xc3, 0x7a, 0x71, 0xa3, 0xbe,
-	0xb9, 0xae, 0x5d, 0xa4, 0x0f, 0xc8, 0xd1, 0x16, 0x66, 0x78, 0x07, 0xbb, 0xa1, 0xb5, 0x20, 0x08,
-	0x77, 0x22, 0x3a, 0xbd, 0x81, 0xac, 0xcf, 0x64, 0x20, 0x75, 0x5a, 0xdc, 0xd0, 0xda, 0xae, 0x1f,
-	0x19, 0xb0, 0x72, 0x27, 0xc0, 0xce, 0xe9, 0xd8, 0x53, 0x17, 0x61, 0x21, 0x24, 0x03, 0xcf, 0xed,
-	0x62, 0xbe, 0x1e, 0x7b, 0x24, 0x14, 0xbb, 0xaa, 0x64, 0x35, 0x15, 0xf4, 0x9e, 0x00, 0x9a, 0x5f,
-	0x18, 0xd0, 0xb6, 0x88, 0x47, 0x30, 0x3d, 0x1d, 0xb6, 0xc0, 0xfc, 0xbe, 0x01, 0x2f, 0xdc, 0x22,
-	0x2c, 0xb1, 0xab, 0x18, 0x66, 0x2e, 0x65, 0x6e, 0xf7, 0x69, 0xfa, 0x15, 0xe6, 0x77, 0x0d, 0x38,
-	0x9f, 0xcb, 0xd6, 0x2c, 0x46, 0xe6, 0x2d, 0x28, 0xf1, 0x5f, 0xb4, 0x5d, 0x10, 0x3a, 0x7f, 0x21,
-	0x4f, 0xe7, 0xbf, 0xce, 0x6d, 0xb7, 0x50, 0x7a, 0x89, 0x6f, 0xfe, 0xb7, 0x01, 0xab, 0xbb, 0x07,
-	0xc1, 0xe1, 0x88, 0xa5, 0x27, 0x21, 0xa0, 0xb4, 0xd9, 0x2d, 0x66, 0xcc, 0x2e, 0x7a, 0x1d, 0xe6,
-	0xd9, 0xd1, 0x80, 0x08, 0xdd, 0x5a, 0xd8, 0x3c, 0x77, 0x45, 0xe3, 0x4e, 0x5f, 0xe1, 0x4c, 0x7e,
-	0x74, 0x34, 0x20, 0x96, 0x40, 0x45, 0xaf, 0x42, 0x2b, 0x23, 0xf2, 0xc8, 0x70, 0x2d, 0xa6, 0x65,
-	0x4e, 0xcd, 0xbf, 0x2b, 0xc0, 0xda, 0xd8, 0x14, 0x67, 0x11, 0xb6, 0x6e, 0xec, 0x82, 0x76, 0x6c,
-	0xbe, 0x7f, 0x12, 0xa8, 0xae, 0xc3, 0x3d, 0xde, 0xe2, 0x46, 0xd1, 0x6a, 0x26, 0xec, 0xb7, 0x43,
-	0xd1, 0x6b, 0x80, 0xc6, 0xcc, 0xaa, 0xb4, 0xde, 0xf3, 0xd6, 0x99, 0xac, 0x5d, 0x15, 0xb6, 0x5b,
-	0x6b, 0x58, 0xa5, 0x08, 0xe6, 0xad, 0x65, 0x8d, 0x65, 0xa5, 0xe8, 0x75, 0x58, 0x76, 0xfd, 0xbb,
-	0xa4, 0x1f, 0x84, 0x47, 0xf6, 0x80, 0x84, 0x5d, 0xe2, 0x33, 0xdc, 0x23, 0xb4, 0x5d, 0x16, 0x1c,
-	0x2d, 0x45, 0x6d, 0x3b, 0xa3, 0x26, 0xf3, 0xaf, 0x0d, 0x58, 0x95, 0x1e, 0xef, 0x0e, 0x0e, 0x99,
-	0x7b, 0x0a, 0xac, 0xd1, 0x20, 0xe2, 0x43, 0xe2, 0x49, 0xff, 0xbc, 0x19, 0x43, 0xc5, 0x2e, 0xfb,
-	0xa1, 0x01, 0xcb, 0xdc, 0x19, 0x7d, 0x96, 0x78, 0xfe, 0x2b, 0x03, 0x96, 0x6e, 0x63, 0xfa, 0x2c,
-	0xb1, 0xfc, 0x63, 0x75, 0x52, 0xc5, 0x3c, 0x3f, 0xd5, 0x2b, 0xdb, 0x2b, 0xb0, 0x98, 0x66, 0x3a,
-	0xf2, 0x7e, 0x16, 0x52, 0x5c, 0x53, 0xcd, 0x91, 0x56, 0xd2, 0x1d, 0x69, 0x7f, 0x3b, 0x3a, 0xd2,
-	0x9e, 0xad, 0x09, 0x9a, 0x7f, 0x6f, 0xc0, 0xb9, 0x5b, 0x84, 0xc5, 0x5c, 0x9f, 0x8a, 0xa3, 0x6f,
-	0x5a, 0xa5, 0xfa, 0x42, 0x1e, 0xdc, 0x5a, 0xe6, 0x9f, 0xca, 0x01, 0xf9, 0x9d, 0x02, 0xac, 0xf0,
-	0xd3, 0xe3, 0x74, 0x28, 0xc1, 0x34, 0x77, 0x1c, 0x8d, 0xa2, 0x94, 0xb4, 0x3b, 0x21, 0x3a, 0x76,
-	0xcb, 0x53, 0x1f, 0xbb, 0xe6, 0x8f, 0x0a, 0xd2, 0x5d, 0x48, 0x4a, 0x63, 0x96, 0x65, 0xd1, 0xf0,
-	0x5a, 0xd0, 0xf2, 0x6a, 0x42, 0x23, 0x86, 0x6c, 0x6f, 0x45, 0xc7, 0x68, 0x0a, 0x76, 0x6a, 0x4f,
-	0xd1, 0xcf, 0x0d, 0x58, 0x8d, 0x6e, 0x95, 0xbb, 0xa4, 0xd7, 0x27, 0x3e, 0x7b, 0x7c, 0x1d, 0xca,
-	0x6a, 0x40, 0x41, 0xa3, 0x01, 0xcf, 0x43, 0x8d, 0xca, 0x71, 0xe2, 0x0b, 0xe3, 0x08, 0x60, 0xfe,
-	0x83, 0x01, 0x6b, 0x63, 0xec, 0xcc, 0xb2, 0x88, 0x6d, 0xa8, 0xb8, 0xbe, 0x43, 0x1e, 0xc5, 0xdc,
-	0x44, 0x9f, 0xbc, 0x65, 0x6f, 0xe8, 0x7a, 0x4e, 0xcc, 0x46, 0xf4, 0x89, 0x2e, 0x40, 0x83, 0xf8,
-	0x78, 0xcf, 0x23, 0xb6, 0xc0, 0x15, 0x8a, 0x5c, 0xb5, 0xea, 0x12, 0xb6, 0xcd, 0x41, 0x9c, 0x78,
-	0xdf, 0x25, 0x82, 0xb8, 0x24, 0x89, 0xd5, 0xa7, 0xf9, 0x5b, 0x06, 0x2c, 0x71, 0x2d, 0x54, 0xdc,
-	0xd3, 0x27, 0x2b, 0xcd, 0x75, 0xa8, 0x27, 0xd4, 0x4c, 0x4d, 0x24, 0x09, 0x32, 0x1f, 0xc0, 0x72,
-	0x9a, 0x9d, 0x59, 0xa4, 0xf9, 0x02, 0x40, 0xbc, 0x56, 0x72, 0x37, 0x14, 0xad, 0x04, 0xc4, 0xfc,
-	0xbc, 0x10, 0xc5, 0x8e, 0x85, 0x98, 0x9e, 0x72, 0x68, 0x4b, 0x2c, 0x49, 0xd2, 0x9e, 0xd7, 0x04,
-	0x44, 0x34, 0x6f, 0x41, 0x83, 0x3c, 0x62, 0x21, 0xb6, 0x07, 0x38, 0xc4, 0x7d, 0xb9, 0xad, 0xa6,
-	0x32, 0xbd, 0x75, 0x41, 0xb6, 0x23, 0xa8, 0xf8, 0x20, 0x42, 0x45, 0xe4, 0x20, 0x65, 0x39, 0x88,
-	0x80, 0x88, 0x03, 0xe3, 0x9f, 0xb9, 0xb3, 0xa7, 0xb4, 0xf9, 0xb4, 0x0b, 0x24, 0x3d, 0x95, 0x52,
-	0x76, 0x2a, 0x7f, 0x6a, 0x40, 0x4b, 0x4c, 0x41, 0xce, 0x67, 0xc0, 0xbb, 0xcd, 0xd0, 0x18, 0x19,
-	0x9a, 0x09, 0x7b, 0xef, 0xe7, 0xa0, 0xac, 0xe4, 0x5e, 0x9c, 0x56, 0xee, 0x8a, 0xe0, 0x98, 0x69,
-	0x98, 0x7f, 0x64, 0xc0, 0x4a, 0x46, 0xe4, 0xb3, 0x28, 0xfc, 0x47, 0x80, 0xe4, 0x0c, 0x9d, 0xd1,
-	0xb4, 0xa3, 0x73, 0xfa, 0xa2, 0xf6, 0x50, 0xca, 0x0a, 0xc9, 0x3a, 0xe3, 0x66, 0x20, 0xd4, 0xfc,
-	0x37, 0x03, 0x9e, 0xbf, 0x45, 0x98, 0x40, 0xbd, 0xc1, 0x8d, 0xce, 0x4e, 0x18, 0xf4, 0x42, 0x42,
-	0xe9, 0xb3, 0xab, 0x1f, 0xbf, 0x23, 0x1d, 0x3b, 0xdd, 0x94, 0x66, 0x91, 0xff, 0x05, 0x68, 0x88,
-	0x31, 0x88, 0x63, 0x87, 0xc1, 0x21, 0x55, 0x7a, 0x54, 0x57, 0x30, 0x2b, 0x38, 0x14, 0x0a, 0xc1,
-	0x02, 0x86, 0x3d, 0x89, 0xa0, 0x4e, 0x14, 0x01, 0xe1, 0xcd, 0x62, 0x0f, 0x46, 0x8c, 0xf1, 0xce,
-	0xc9, 0xb3, 0x2b, 0xe3, 0x3f, 0x31, 0x60, 0x25, 0x33, 0x95, 0x59, 0x64, 0xfb, 0x15, 0xe9, 0x76,
-	0xca, 0xc9, 0x2c, 0x6c, 0x9e, 0xd7, 0xd2, 0x24, 0x06, 0x93, 0xd8, 0xe8, 0x3c, 0xd4, 0xf7, 0xb1,
-	0xeb, 0xd9, 0x21, 0xc1, 0x34, 0xf0, 0xd5, 0x44, 0x81, 0x83, 0x2c, 0x01, 0x31, 0xff, 0xc9, 0x90,
-	0x09, 0xba, 0x67, 0xdc, 0xe2, 0xfd, 0x71, 0x01, 0x9a, 0xdb, 0x3e, 0x25, 0x21, 0x3b, 0xfd, 0x57,
-	0x13, 0xf4, 0x1e, 0xd4, 0xc5, 0xc4, 0xa8, 0xed, 0x60, 0x86, 0xd5, 0x69, 0xf6, 0x82, 0x36, 0x9a,
-	0xff, 0x3e, 0xc7, 0xdb, 0xc2, 0x0c, 0x5b, 0x52, 0x3a, 0x94, 0xff, 0x46, 0x67, 0xa1, 0x76, 0x80,
-	0xe9, 0x81, 0xfd, 0x80, 0x1c, 0x49, 0x7f, 0xb1, 0x69, 0x55, 0x39, 0xe0, 0x03, 0x72, 0x44, 0xd1,
-	0x73, 0x50, 0xf5, 0x87, 0x7d, 0xb9, 0xc1, 0x2a, 0xeb, 0xc6, 0x46, 0xd3, 0xaa, 0xf8, 0xc3, 0xbe,
-	0xd8, 0x5e, 0xff, 0x52, 0x80, 0x85, 0xbb, 0x43, 0x7e, 0x11, 0x12, 0xb9, 0x88, 0xa1, 0xc7, 0x1e,
-	0x4f, 0x19, 0x2f, 0x41, 0x51, 0xba, 0x14, 0x9c, 0xa2, 0xad, 0x65, 0x7c, 0x7b, 0x8b, 0x5a, 0x1c,
-	0x49, 0xc4, 0xe1, 0x87, 0xdd, 0xae, 0xf2, 0xce, 0x8a, 0x82, 0xd9, 0x1a, 0x87, 0x48, 0xdf, 0xec,
-	0x2c, 0xd4, 0x48, 0x18, 0xc6, 0xbe, 0x9b, 0x98, 0x0a, 0x09, 0x43, 0xd9, 0x68, 0x42, 0x03, 0x77,
-	0x1f, 0xf8, 0xc1, 0xa1, 0x47, 0x9c, 0x1e, 0x71, 0xc4, 0xb2, 0x57, 0xad, 0x14, 0x4c, 0x2a, 0x06,
-	0x5f, 0x78, 0xbb, 0xeb, 0x33, 0x71, 0xaa, 0x17, 0xb9, 0x62, 0x70, 0xc8, 0x4d, 0x9f, 0xf1, 0x66,
-	0x87, 0x78, 0x84, 0x11, 0xd1, 0x5c, 0x91, 0xcd, 0x12, 0xa2, 0x9a, 0x87, 0x83, 0x98, 0xba, 0x2a,
-	0x9b, 0x25, 0x84, 0x37, 0x3f, 0x0f, 0xb5, 0x51, 0xb2, 0xa1, 0x36, 0x8a, 0x36, 0x0a, 0x80, 0xf9,
-	0x63, 0x03, 0x9a, 0x5b, 0xa2, 0xab, 0x67, 0x40, 0xe9, 0x10, 0xcc, 0x93, 0x47, 0x83, 0x50, 0x6d,
-	0x1d, 0xf1, 0x7b, 0xa2, 0x1e, 0x99, 0x0f, 0xa1, 0xb5, 0xe3, 0xe1, 0x2e, 0x39, 0x08, 0x3c, 0x87,
-	0x84, 0xe2, 0x6c, 0x47, 0x2d, 0x28, 0x32, 0xdc, 0x53, 0xce, 0x03, 0xff, 0x89, 0xde, 0x56, 0x57,
-	0x3f, 0x69, 0x96, 0x5e, 0xd2, 0x9e, 0xb2, 0x89, 0x6e, 0x12, 0x81, 0xd7, 0x55, 0x28, 0x8b, 0x04,
-	0xa0, 0x74, 0x2b, 0x1a, 0x96, 0xfa, 0x32, 0xef, 0xa7, 0xc6, 0xbd, 0x15, 0x06, 0xc3, 0x01, 0xda,
-	0x86, 0xc6, 0x60, 0x04, 0xe3, 0xba, 0x9a, 0x7f, 0xa6, 0x67, 0x99, 0xb6, 0x52, 0xa4, 0xe6, 0xff,
-	0x14, 0xa1, 0xb9, 0x4b, 0x70, 0xd8, 0x3d, 0x78, 0x26, 0x82, 0x4c, 0x2d, 0x28, 0x3a, 0xd4, 0x53,
-	0xab, 0xc6, 0x7f, 0xa2, 0xcb, 0x70, 0x26, 0x31, 0x21, 0xbb, 0xc7, 0x05, 0x24, 0xf4, 0xbe, 0x61,
-	0xb5, 0x06, 0x59, 0xc1, 0xbd, 0x05, 0x55, 0x87, 0x7a, 0xb6, 0x58, 0xa2, 0x8a, 0x58, 0x22, 0xfd,
-	0xfc, 0xb6, 0xa8, 0x27, 0x96, 0xa6, 0xe2, 0xc8, 0x1f, 0xe8, 0x45, 0x68, 0x06, 0x43, 0x36, 0x18,
-	0x32, 0x5b, 0xda, 0x9d, 0x76, 0x55, 0xb0, 0xd7, 0x90, 0x40, 0x61, 0x96, 0x28, 0x7a, 0x1f, 0x9a,
-	0x54, 0x88, 0x32, 0x72, 0xcc, 0x6b, 0xd3, 0x3a, 0x88, 0x0d, 0x49, 0xa7, 0x3c, 0xf3, 0x57, 0xa1,
-	0xc5, 0x42, 0xfc, 0x90, 0x78, 0x89, 0xd4, 0x1e, 0x88, 0xdd, 0xb6, 0x28, 0xe1, 0xa3, 0xb4, 0xde,
-	0x55, 0x58, 0xea, 0x0d, 0x71, 0x88, 0x7d, 0x46, 0x48, 0x02, 0xbb, 0x2e, 0xb0, 0x51, 0xdc, 0x14,
-	0x13, 0x98, 0x1f, 0xc0, 0xfc, 0x6d, 0x97, 0x09, 0x41, 0x72, 0x9b, 0x65, 0x88, 0x6b, 0x90, 0xb0,
-	0x4c, 0xcf, 0x41, 0x35, 0x0c, 0x0e, 0xa5, 0x0d, 0x2e, 0x08, 0x15, 0xac, 0x84, 0xc1, 0xa1, 0x30,
-	0xb0, 0xa2, 0x20, 0x22, 0x08, 0x95, 0x6e, 0x16, 0x2c, 0xf5, 0x65, 0xfe, 0xa5, 0x31, 0x52, 0x1e,
-	0x6e, 0x3e, 0xe9, 0xe3, 0xd9, 0xcf, 0xf7, 0xa0, 0x12, 0x4a, 0xfa, 0x89, 0xa9, 0xdc, 0xe4, 0x48,
-	0xe2, 0x0c, 0x88, 0xa8, 0xa6, 0xcf, 0x13, 0xfd, 0xaa, 0x01, 0x8d, 0xf7, 0xbd, 0x21, 0x7d, 0x12,
-	0xca, 0xae, 0xcb, 0x5e, 0x14, 0xf5, 0x99, 0x93, 0xef, 0x15, 0xa0, 0xa9, 0xd8, 0x98, 0xc5, 0x09,
-	0xca, 0x65, 0x65, 0x17, 0xea, 0x7c, 0x48, 0x9b, 0x92, 0x5e, 0x14, 0xd3, 0xa9, 0x6f, 0x6e, 0x6a,
-	0xcd, 0x43, 0x8a, 0x0d, 0x91, 0x2d, 0xdf, 0x15, 0x44, 0xbf, 0xe8, 0xb3, 0xf0, 0xc8, 0x82, 0x6e,
-	0x0c, 0xe8, 0xdc, 0x87, 0xc5, 0x4c, 0x33, 0x57, 0xa2, 0x07, 0xe4, 0x28, 0xb2, 0x7f, 0x0f, 0xc8,
-	0x11, 0x7a, 0x23, 0x59, 0xd3, 0x90, 0x77, 0x8a, 0xdf, 0x09, 0xfc, 0xde, 0xf5, 0x30, 0xc4, 0x47,
-	0xaa, 0xe6, 0xe1, 0x9d, 0xc2, 0xdb, 0x86, 0xf9, 0x8f, 0x05, 0x68, 0x7c, 0x38, 0x24, 0xe1, 0xd1,
-	0xd3, 0xb4, 0x43, 0xd1, 0xa9, 0x30, 0x9f, 0x38, 0x15, 0xc6, 0xb6, 0x7e, 0x49, 0xb3, 0xf5, 0x35,
-	0x06, 0xac, 0xac, 0x35, 0x60, 0xba, 0xbd, 0x5d, 0x39, 0xd1, 0xde, 0xae, 0xe6, 0xee, 0xed, 0xbf,
-	0x30, 0x62, 0x11, 0xce, 0xb4, 0x1b, 0x53, 0xee, 0x58, 0xe1, 0xc4, 0xee, 0xd8, 0xd4, 0xbb, 0xf1,
-	0x87, 0x06, 0xd4, 0xbe, 0x4e, 0xba, 0x2c, 0x08, 0xb9, 0xfd, 0xd1, 0x90, 0x19, 0x53, 0xb8, 0xc6,
-	0x85, 0xac, 0x6b, 0x7c, 0x0d, 0xaa, 0xae, 0x63, 0x63, 0xae, 0x5f, 0x62, 0xdc, 0x49, 0x2e, 0x59,
-	0xc5, 0x75, 0x84, 0x22, 0x4e, 0x9f, 0x04, 0xf8, 0x5d, 0x03, 0x1a, 0x92, 0x67, 0x2a, 0x29, 0xdf,
-	0x4d, 0x0c, 0x67, 0xe8, 0x94, 0x5e, 0x7d, 0xc4, 0x13, 0xbd, 0x3d, 0x37, 0x1a, 0xf6, 0x3a, 0x00,
-	0x17, 0xb2, 0x22, 0x97, 0x7b, 0x66, 0x5d, 0xcb, 0xad, 0x24, 0x17, 0x02, 0xbf, 0x3d, 0x67, 0xd5,
-	0x38, 0x95, 0xe8, 0xe2, 0x46, 0x05, 0x4a, 0x82, 0xda, 0xfc, 0x3f, 0x03, 0x96, 0x6e, 0x62, 0xaf,
-	0xbb, 0xe5, 0x52, 0x86, 0xfd, 0xee, 0x0c, 0x4e, 0xd8, 0x3b, 0x50, 0x09, 0x06, 0xb6, 0x47, 0xf6,
-	0x99, 0x62, 0xe9, 0xc2, 0x84, 0x19, 0x49, 0x31, 0x58, 0xe5, 0x60, 0x70, 0x87, 0xec, 0x33, 0xf4,
-	0x55, 0xa8, 0x06, 0x03, 0x3b, 0x74, 0x7b, 0x07, 0x4c, 0x49, 0x7f, 0x0a, 0xe2, 0x4a, 0x30, 0xb0,
-	0x38, 0x45, 0x22, 0xb6, 0x32, 0x7f, 0xc2, 0xd8, 0x8a, 0xf9, 0xef, 0x63, 0xd3, 0x9f, 0x61, 0x0f,
-	0xbc, 0x03, 0x55, 0xd7, 0x67, 0xb6, 0xe3, 0xd2, 0x48, 0x04, 0xe7, 0xf4, 0x3a, 0xe4, 0x33, 0x31,
-	0x03, 0xb1, 0xa6, 0x3e, 0xe3, 0x63, 0xa3, 0xaf, 0x01, 0xec, 0x7b, 0x01, 0x56, 0xd4, 0x52, 0x06,
-	0xe7, 0xf5, 0xdb, 0x87, 0xa3, 0x45, 0xf4, 0x35, 0x41, 0xc4, 0x7b, 0x18, 0x2d, 0xe9, 0xbf, 0x1a,
-	0xb0, 0xb2, 0x43, 0x42, 0x59, 0xf1, 0xc2, 0x54, 0x18, 0x74, 0xdb, 0xdf, 0x0f, 0xd2, 0x91, 0x68,
-	0x23, 0x13, 0x89, 0xfe, 0xe9, 0x44, 0x5f, 0x53, 0x37, 0x27, 0x99, 0x0f, 0x89, 0x6e, 0x4e, 0x51,
-	0xd6, 0x47, 0xde, 0x3c, 0x17, 0x72, 0x96, 0x49, 0xf1, 0x9b, 0xbc, 0x80, 0x9b, 0xbf, 0x2d, 0x0b,
-	0x35, 0xb4, 0x93, 0x7a, 0x7c, 0x85, 0x5d, 0x05, 0x65, 0xe9, 0x33, 0x76, 0xff, 0x65, 0xc8, 0xd8,
-	0x8e, 0x1c, 0x43, 0xf4, 0x03, 0x03, 0xd6, 0xf3, 0xb9, 0x9a, 0xe5, 0x88, 0xfe, 0x1a, 0x94, 0x5c,
-	0x7f, 0x3f, 0x88, 0xc2, 0x6e, 0x97, 0xf4, 0x2e, 0xba, 0x76, 0x5c, 0x49, 0x68, 0xfe, 0x4d, 0x01,
-	0x5a, 0xc2, 0xa8, 0x3f, 0x85, 0xe5, 0xef, 0x93, 0xbe, 0x4d, 0xdd, 0xcf, 0x48, 0xb4, 0xfc, 0x7d,
-	0xd2, 0xdf, 0x75, 0x3f, 0x23, 0x29, 0xcd, 0x28, 0xa5, 0x35, 0x63, 0x72, 0x54, 0x39, 0x19, 0x56,
-	0xad, 0xa4, 0xc3, 0xaa, 0xab, 0x50, 0xf6, 0x03, 0x87, 0x6c, 0x6f, 0xa9, 0x6b, 0xa7, 0xfa, 0x1a,
-	0xa9, 0x5a, 0xed, 0x84, 0xaa, 0xf6, 0x85, 0x01, 0x9d, 0x5b, 0x84, 0x65, 0x65, 0xf7, 0xf4, 0xb4,
-	0xec, 0xbb, 0x06, 0x9c, 0xd5, 0x32, 0x34, 0x8b, 0x82, 0xbd, 0x9b, 0x56, 0x30, 0xfd, 0x1d, 0x70,
-	0x6c, 0x48, 0xa5, 0x5b, 0xaf, 0x43, 0x63, 0x6b, 0xd8, 0xef, 0xc7, 0x2e, 0xd7, 0x05, 0x68, 0x84,
-	0xf2, 0xa7, 0xbc, 0x22, 0xc9, 0xf3, 0xb7, 0xae, 0x60, 0xfc, 0x22, 0x64, 0x5e, 0x86, 0xa6, 0x22,
-	0x51, 0x5c, 0x77, 0xa0, 0x1a, 0xaa, 0xdf, 0x0a, 0x3f, 0xfe, 0x36, 0x57, 0x60, 0xc9, 0x22, 0x3d,
-	0xae, 0xda, 0xe1, 0x1d, 0xd7, 0x7f, 0xa0, 0x86, 0x31, 0xbf, 0x6d, 0xc0, 0x72, 0x1a, 0xae, 0xfa,
-	0x7a, 0x13, 0x2a, 0xd8, 0x71, 0x42, 0x42, 0xe9, 0xc4, 0x65, 0xb9, 0x2e, 0x71, 0xac, 0x08, 0x39,
-	0x21, 0xb9, 0xc2, 0xd4, 0x92, 0x33, 0x6d, 0x38, 0x73, 0x8b, 0xb0, 0xbb, 0x84, 0x85, 0x33, 0x65,
-	0xf0, 0xdb, 0xfc, 0xf2, 0x22, 0x88, 0x95, 0x5a, 0x44, 0x9f, 0xe6, 0xe7, 0x06, 0xa0, 0xe4, 0x08,
-	0xb3, 0x2c, 0x73, 0x52, 0xca, 0x85, 0xb4, 0x94, 0x65, 0x2d, 0x54, 0x7f, 0x10, 0xf8, 0xc4, 0x67,
-	0x49, 0x77, 0xab, 0x19, 0x43, 0xa3, 0xb2, 0x12, 0x74, 0x27, 0xc0, 0xce, 0x0d, 0xec, 0xcd, 0xe6,
-	0x1e, 0x9c, 0x03, 0xa0, 0x61, 0xd7, 0x56, 0xbb, 0xb5, 0xa0, 0xac, 0x4f, 0xd8, 0xbd, 0x27, 0x37,
-	0xec, 0x79, 0xa8, 0x3b, 0x94, 0xa9, 0xe6, 0x28, 0xa1, 0x0c, 0x0e, 0x65, 0xb2, 0x5d, 0xd4, 0xba,
-	0x52, 0x82, 0x3d, 0xe2, 0xd8, 0x89, 0x7c, 0xdc, 0xbc, 0x40, 0x6b, 0xc9, 0x86, 0xdd, 0x18, 0xae,
-	0xd9, 0x5c, 0x25, 0xed, 0xe6, 0xba, 0x0f, 0x6b, 0x77, 0xb1, 0x3f, 0xc4, 0xde, 0xcd, 0xa0, 0x3f,
-	0xc0, 0xa9, 0x3a, 0xc9, 0xac, 0x39, 0x34, 0x34, 0xe6, 0xf0, 0x05, 0x59, 0x48, 0x27, 0x5d, 0x70,
-	0x31, 0xa7, 0x79, 0x2b, 0x01, 0x31, 0x29, 0xb4, 0xc7, 0xbb, 0x9f, 0x65, 0x41, 0x05, 0x53, 0x51,
-	0x57, 0x49, 0x1b, 0x3d, 0x82, 0x99, 0xef, 0xc1, 0x73, 0xa2, 0xa8, 0x31, 0x02, 0xa5, 0x52, 0x00,
-	0xd9, 0x0e, 0x0c, 0x4d, 0x07, 0xbf, 0x5e, 0x10, 0x26, 0x70, 0xac, 0x87, 0x59, 0x18, 0x7f, 0x27,
-	0x1d, 0x79, 0x7f, 0x29, 0xa7, 0x70, 0x37, 0x3d, 0xa2, 0x0a, 0xbf, 0x6f, 0xc0, 0x22, 0x79, 0x44,
-	0xba, 0x43, 0xe6, 0xfa, 0xbd, 0x1d, 0x0f, 0xfb, 0xf7, 0x02, 0x75, 0xf0, 0x64, 0xc1, 0xe8, 0x25,
-	0x68, 0x72, 0xe9, 0x07, 0x43, 0xa6, 0xf0, 0xe4, 0x09, 0x94, 0x06, 0xf2, 0xfe, 0xf8, 0x7c, 0x3d,
-	0xc2, 0x88, 0xa3, 0xf0, 0xe4, 0x71, 0x94, 0x05, 0x8f, 0x89, 0x92, 0x83, 0xe9, 0x49, 0x44, 0xf9,
-	0x9f, 0x46, 0x46, 0x94, 0xaa, 0x87, 0xa7, 0x25, 0xca, 0xdb, 0x00, 0x7d, 0x12, 0xf6, 0xc8, 0xb6,
-	0x30, 0xfe, 0xf2, 0x86, 0xbf, 0xa1, 0x35, 0xfe, 0xa3, 0x0e, 0xee, 0x46, 0x04, 0x56, 0x82, 0xd6,
-	0xbc, 0x05, 0x4b, 0x1a, 0x14, 0x6e, 0xd7, 0x68, 0x30, 0x0c, 0xbb, 0x24, 0x0a, 0x12, 0x45, 0x9f,
-	0xfc, 0x1c, 0x64, 0x38, 0xec, 0x11, 0xa6, 0x94, 0x56, 0x7d, 0x99, 0x6f, 0x8a, 0x64, 0x95, 0x08,
-	0x28, 0xa4, 0x34, 0x35, 0x9d, 0x78, 0x37, 0xc6, 0x12, 0xef, 0xfb, 0x22, 0x33, 0x94, 0xa4, 0x9b,
-	0xb1, 0x68, 0x62, 0x9f, 0x77, 0x45, 0x1c, 0xf5, 0x68, 0x23, 0xfa, 0x34, 0x7f, 0x62, 0x40, 0x73,
-	0xbb, 0x3f, 0x08, 0x46, 0x49, 0x91, 0xa9, 0xaf, 0x9c, 0xe3, 0x41, 0xe5, 0x82, 0x2e, 0xa8, 0xfc,
-	0x22, 0x34, 0xd3, 0x25, 0xff, 0x32, 0xfe, 0xd3, 0xe8, 0x26, 0x4b, 0xfd, 0xcf, 0x42, 0x2d, 0x0c,
-	0x0e, 0x6d, 0x6e, 0x4a, 0x1d, 0x55, 0x9e, 0x51, 0x0d, 0x83, 0x43, 0x6e, 0x60, 0x1d, 0xb4, 0x0c,
-	0xa5, 0x7d, 0xd7, 0x8b, 0x2b, 0x8b, 0xe4, 0x07, 0x7a, 0x97, 0x5f, 0xc8, 0x64, 0xfa, 0xb6, 0x3c,
-	0xed, 0xbd, 0x28, 0xa2, 0x30, 0x3f, 0x81, 0x85, 0x68, 0xd6, 0x33, 0xbe, 0x56, 0x61, 0x98, 0x3e,
-	0x88, 0x2a, 0x27, 0xe4, 0x87, 0x79, 0x59, 0x66, 0xf5, 0x44, 0xff, 0xa9, 0x45, 0x47, 0x30, 0xcf,
-	0x31, 0xd4, 0x5e, 0x12, 0xbf, 0xcd, 0x9f, 0x14, 0x60, 0x35, 0x8b, 0x3d, 0x0b, 0x4b, 0x6f, 0xa6,
-	0xf7, 0x8f, 0xfe, 0x41, 0x42, 0x72, 0x34, 0xb5, 0x77, 0xd4, 0x0a, 0x74, 0x83, 0xa1, 0xcf, 0x94,
-	0x01, 0xe2, 0x2b, 0x70, 0x93, 0x7f, 0xa3, 0x35, 0xa8, 0xb8, 0x8e, 0xed, 0xf1, 0xbb, 0x9b, 0x3c,
-	0x93, 0xca, 0xae, 0x73, 0x87, 0xdf, 0xeb, 0xde, 0x8a, 0x3c, 0xad, 0xa9, 0xcb, 0x2d, 0x24, 0x3e,
-	0x5a, 0x80, 0x82, 0xeb, 0xa8, 0x54, 0x4c, 0xc1, 0x75, 0xd0, 0xdb, 0xd0, 0x3e, 0x20, 0xc3, 0x50,
-	0x54, 0xdf, 0x89, 0x18, 0x8b, 0xfd, 0x29, 0xf7, 0xcf, 0xf0, 0x9e, 0x27, 0x83, 0xd2, 0x55, 0x6b,
-	0x35, 0x6e, 0xdf, 0xc2, 0x0c, 0x7f, 0x18, 0xb5, 0xa2, 0x37, 0x60, 0x35, 0x43, 0xa9, 0x92, 0xc9,
-	0xc2, 0x67, 0xae, 0x5a, 0xcb, 0x29, 0xba, 0x6d, 0xd9, 0x66, 0xb6, 0x61, 0x95, 0x4f, 0x40, 0x0a,
-	0xe2, 0x23, 0xbe, 0x6c, 0x91, 0x23, 0xf6, 0x3d, 0x03, 0xd6, 0xc6, 0x9a, 0x66, 0x59, 0x91, 0xeb,
-	0x49, 0x25, 0xa9, 0x6f, 0x5e, 0xd6, 0x1a, 0x24, 0xbd, 0x0a, 0x44, 0x1a, 0xf5, 0x7d, 0xe9, 0x35,
-	0x59, 0xb2, 0x68, 0xf4, 0x09, 0x97, 0x20, 0x6d, 0x40, 0xeb, 0xd0, 0x65, 0x07, 0xb6, 0x78, 0x08,
-	0x23, 0x5c, 0x16, 0x99, 0x85, 0xaf, 0x5a, 0x0b, 0x1c, 0xbe, 0xcb, 0xc1, 0xdc, 0x6d, 0xa1, 0xe6,
-	0x6f, 0x18, 0xb0, 0x94, 0x62, 0x6b, 0x16, 0x31, 0x7d, 0x95, 0x7b, 0x73, 0xb2, 0x23, 0x25, 0xa9,
-	0x75, 0xad, 0xa4, 0xd4, 0x68, 0xc2, 0x64, 0xc7, 0x14, 0xe6, 0x7f, 0x19, 0x50, 0x4f, 0xb4, 0xf0,
-	0xcb, 0xa0, 0x6a, 0x1b, 0x5d, 0x06, 0x63, 0xc0, 0x54, 0x62, 0x78, 0x11, 0x46, 0x86, 0x2c, 0x51,
-	0x4c, 0x9f, 0xa8, 0x02, 0x74, 0x28, 0xba, 0x0d, 0x0b, 0x52, 0x4c, 0x31, 0xeb, 0xda, 0x18, 0x4d,
-	0x5c, 0xdf, 0x88, 0x43, 0x47, 0x71, 0x69, 0x35, 0x69, 0xe2, 0x4b, 0xa6, 0x64, 0x03, 0x87, 0x88,
-	0x91, 0x4a, 0xf2, 0x6c, 0x11, 0xde, 0xa2, 0x43, 0xf9, 0xa5, 0xad, 0x91, 0x24, 0xe5, 0x8e, 0xaf,
-	0x47, 0xb0, 0x43, 0xc2, 0x78, 0x6e, 0xf1, 0x37, 0xf7, 0x34, 0xe5, 0x6f, 0x9b, 0x5f, 0x04, 0x94,
-	0x49, 0x06, 0x09, 0xe2, 0x77, 0x04, 0xf4, 0x32, 0x2c, 0x3a, 0xfd, 0xd4, 0x2b, 0xac, 0xc8, 0x35,
-	0x76, 0xfa, 0x89, 0xe7, 0x57, 0x29, 0x86, 0xe6, 0xd3, 0x0c, 0xfd, 0xaf, 0x11, 0xbf, 0x4d, 0x0d,
-	0x89, 0x43, 0x7c, 0xe6, 0x62, 0xef, 0xf1, 0x75, 0xb2, 0x03, 0xd5, 0x21, 0x25, 0x61, 0xe2, 0x04,
-	0x89, 0xbf, 0x79, 0xdb, 0x00, 0x53, 0x7a, 0x18, 0x84, 0x8e, 0xe2, 0x32, 0xfe, 0x9e, 0x50, 0x52,
-	0x29, 0xdf, 0x3d, 0xea, 0x4b, 0x2a, 0xdf, 0x84, 0xb5, 0x7e, 0xe0, 0xb8, 0xfb, 0xae, 0xae, 0x12,
-	0x93, 0x93, 0xad, 0x44, 0xcd, 0x29, 0x3a, 0xf3, 0x07, 0x05, 0x58, 0xfb, 0x78, 0xe0, 0xfc, 0x0c,
-	0xe6, 0xbc, 0x0e, 0xf5, 0xc0, 0x73, 0x76, 0xd2, 0xd3, 0x4e, 0x82, 0x38, 0x86, 0x4f, 0x0e, 0x63,
-	0x0c, 0x19, 0x98, 0x4f, 0x82, 0x26, 0x96, 0x9b, 0x3e, 0x96, 0x6c, 0xca, 0x93, 0x64, 0xd3, 0x83,
-	0x35, 0x99, 0xe3, 0x7e, 0xc2, 0xa2, 0x31, 0x7f, 0x05, 0x56, 0xb8, 0x69, 0xe6, 0xc3, 0x7c, 0x4c,
-	0x49, 0x38, 0xa3, 0xc5, 0x79, 0x1e, 0x6a, 0x51, 0xcf, 0x51, 0x25, 0xf0, 0x08, 0x60, 0xde, 0x86,
-	0xe5, 0xcc, 0x58, 0x8f, 0x39, 0xa3, 0x4b, 0x17, 0xa0, 0x1a, 0x55, 0x36, 0xa3, 0x0a, 0x14, 0xaf,
-	0x7b, 0x5e, 0x6b, 0x0e, 0x35, 0xa0, 0xba, 0xad, 0xca, 0x77, 0x5b, 0xc6, 0xa5, 0x5f, 0x80, 0xc5,
-	0x4c, 0x06, 0x1c, 0x55, 0x61, 0xfe, 0x5e, 0xe0, 0x93, 0xd6, 0x1c, 0x6a, 0x41, 0xe3, 0x86, 0xeb,
-	0xe3, 0xf0, 0x48, 0xc6, 0x87, 0x5b, 0x0e, 0x5a, 0x84, 0xba, 0x88, 0x93, 0x2a, 0x00, 0xd9, 0xfc,
-	0xb3, 0x8b, 0xd0, 0xbc, 0x2b, 0x18, 0xd9, 0x25, 0xe1, 0x43, 0xb7, 0x4b, 0x90, 0x0d, 0xad, 0xec,
-	0xf3, 0x71, 0xf4, 0x25, 0xbd, 0x2f, 0xac, 0x7f, 0x65, 0xde, 0x99, 0x24, 0x43, 0x73, 0x0e, 0x7d,
-	0x02, 0x0b, 0xe9, 0x47, 0xd8, 0x48, 0x1f, 0xc8, 0xd3, 0xbe, 0xd4, 0x3e, 0xae, 0x73, 0x1b, 0x9a,
-	0xa9, 0x37, 0xd5, 0xe8, 0x55, 0x6d, 0xdf, 0xba, 0x77, 0xd7, 0x1d, 0xbd, 0xed, 0x4d, 0xbe, 0x7b,
-	0x96, 0xdc, 0xa7, 0x1f, 0x3e, 0xe6, 0x70, 0xaf, 0x7d, 0x1d, 0x79, 0x1c, 0xf7, 0x18, 0xce, 0x8c,
-	0x3d, 0x50, 0x44, 0xaf, 0xe5, 0x9c, 0x66, 0xfa, 0x87, 0x8c, 0xc7, 0x0d, 0x71, 0x08, 0x68, 0xfc,
-	0xed, 0x30, 0xba, 0xa2, 0x5f, 0x81, 0xbc, 0x97, 0xd3, 0x9d, 0xab, 0x53, 0xe3, 0xc7, 0x82, 0xfb,
-	0x35, 0x03, 0xd6, 0x72, 0x5e, 0x15, 0xa2, 0x6b, 0x79, 0xae, 0xcd, 0x84, 0xa7, 0x91, 0x9d, 0x37,
-	0x4e, 0x46, 0x14, 0x33, 0xe2, 0xc3, 0x62, 0xe6, 0xa1, 0x1d, 0xba, 0x9c, 0xfb, 0xaa, 0x60, 0xfc,
-	0xc5, 0x61, 0xe7, 0x4b, 0xd3, 0x21, 0xc7, 0xe3, 0xdd, 0x87, 0xc5, 0xcc, 0xeb, 0xb4, 0x9c, 0xf1,
-	0xf4, 0x6f, 0xd8, 0x8e, 0x5b, 0xd0, 0x6f, 0x42, 0x33, 0xf5, 0x8c, 0x2c, 0x47, 0xe3, 0x75, 0x4f,
-	0xcd, 0x8e, 0xeb, 0xfa, 0x3e, 0x34, 0x92, 0xaf, 0xbd, 0xd0, 0x46, 0xde, 0x5e, 0x1a, 0xeb, 0xf8,
-	0x24, 0x5b, 0x69, 0xf4, 0x4a, 0x63, 0xc2, 0x56, 0x1a, 0x7b, 0xd8, 0x32, 0xfd, 0x56, 0x4a, 0xf4,
-	0x3f, 0x71, 0x2b, 0x9d, 0x78, 0x88, 0x6f, 0x1b, 0xe2, 0x06, 0xa6, 0x79, 0x05, 0x84, 0x36, 0xf3,
-	0x74, 0x33, 0xff, 0xbd, 0x53, 0xe7, 0xda, 0x89, 0x68, 0x62, 0x29, 0x3e, 0x80, 0x85, 0xf4, 0x5b,
-	0x97, 0x1c, 0x29, 0x6a, 0x9f, 0x07, 0x75, 0x2e, 0x4f, 0x85, 0x1b, 0x0f, 0xf6, 0x31, 0xd4, 0x13,
-	0xff, 0x08, 0x83, 0x5e, 0x99, 0xa0, 0xc7, 0xc9, 0xbf, 0x47, 0x39, 0x4e, 0x92, 0x1f, 0x42, 0x2d,
-	0xfe, 0x23, 0x17, 0x74, 0x31, 0x57, 0x7f, 0x4f, 0xd2, 0xe5, 0x2e, 0xc0, 0xe8, 0x5f, 0x5a, 0xd0,
-	0xcb, 0xda, 0x3e, 0xc7, 0xfe, 0xc6, 0xe5, 0xb8, 0x4e, 0xe3, 0xe9, 0xcb, 0x12, 0xc2, 0x49, 0xd3,
-	0x4f, 0xd6, 0xbc, 0x1e, 0xd7, 0xed, 0x01, 0x34, 0x53, 0x95, 0xea, 0x79, 0x5b, 0x58, 0xf3, 0x80,
-	0xa0, 0x73, 0x69, 0x1a, 0xd4, 0x78, 0xfd, 0x0e, 0xa0, 0x99, 0xaa, 0x1b, 0xce, 0x19, 0x49, 0x57,
-	0x26, 0x9d, 0x33, 0x92, 0xb6, 0x0c, 0xd9, 0x9c, 0x43, 0xdf, 0x4a, 0x94, 0x28, 0xa7, 0xca, 0xc0,
-	0xd1, 0xeb, 0x13, 0xfb, 0xd1, 0x55, 0xc1, 0x77, 0x36, 0x4f, 0x42, 0x12, 0xb3, 0xa0, 0xb4, 0x4a,
-	0x8a, 0x34, 0x5f, 0xab, 0x4e, 0xb2, 0x52, 0xbb, 0x50, 0x96, 0x95, 0xc0, 0xc8, 0xcc, 0xa9, 0xf9,
-	0x4f, 0x94, 0x09, 0x77, 0x5e, 0xd4, 0xe2, 0xa4, 0x8b, 0x64, 0x65, 0xa7, 0xd2, 0x0b, 0xce, 0xe9,
-	0x34, 0x55, 0x06, 0x3a, 0x6d, 0xa7, 0x16, 0x94, 0x65, 0x89, 0x57, 0x4e, 0xa7, 0xa9, 0x32, 0xc5,
-	0xce, 0x64, 0x1c, 0x91, 0xfb, 0x37, 0xe7, 0xd0, 0x0e, 0x94, 0x44, 0x60, 0x11, 0x5d, 0x98, 0x54,
-	0xfd, 0x34, 0xa9, 0xc7, 0x54, 0x81, 0x94, 0x39, 0x87, 0x7e, 0x09, 0x4a, 0x22, 0x20, 0x93, 0xd3,
-	0x63, 0xb2, 0x84, 0xa9, 0x33, 0x11, 0x25, 0x62, 0xd1, 0x81, 0x46, 0xb2, 0x6e, 0x21, 0xe7, 0xc8,
-	0xd2, 0x54, 0x76, 0x74, 0xa6, 0xc1, 0x8c, 0x46, 0x91, 0xdb, 0x68, 0x14, 0x64, 0xcd, 0xdf, 0x46,
-	0x63, 0x01, 0xdc, 0xfc, 0x6d, 0x34, 0x1e, 0xb3, 0x35, 0xe7, 0xd0, 0x6f, 0x1a, 0xd0, 0xce, 0x4b,
-	0xa6, 0xa3, 0x5c, 0x0f, 0x68, 0x52, 0x45, 0x40, 0xe7, 0x2b, 0x27, 0xa4, 0x8a, 0x79, 0xf9, 0x4c,
-	0x04, 0x6d, 0xc6, 0xd2, 0xe7, 0x57, 0xf3, 0xfa, 0xcb, 0x49, 0x16, 0x77, 0xbe, 0x3c, 0x3d, 0x41,
-	0x3c, 0xf6, 0x1e, 0xd4, 0x13, 0x01, 0xa3, 0x1c, 0xcb, 0x3b, 0x1e, 0xe9, 0xca, 0x59, 0x55, 0x4d,
-	0xec, 0x49, 0xaa, 0xb7, 0xc8, 0xc6, 0xe6, 0x28, 0x63, 0x32, 0xb9, 0x9b, 0xa3, 0xde, 0xa9, 0x64,
-	0xae, 0x39, 0x87, 0x08, 0x34, 0x92, 0xa9, 0xd9, 0x1c, 0x6d, 0xd4, 0x64, 0x75, 0x3b, 0xaf, 0x4e,
-	0x81, 0x19, 0x0f, 0x63, 0x03, 0x8c, 0x52, 0xa3, 0x39, 0x67, 0xdd, 0x58, 0x76, 0xb6, 0xf3, 0xca,
-	0xb1, 0x78, 0xc9, 0x63, 0x3f, 0x91, 0xec, 0xcc, 0x91, 0xfe, 0x78, 0x3a, 0x74, 0x8a, 0xbb, 0xc8,
-	0x78, 0x42, 0x2d, 0xe7, 0x2e, 0x92, 0x9b, 0xbb, 0xeb, 0x5c, 0x9d, 0x1a, 0x3f, 0x9e, 0xcf, 0xa7,
-	0xd0, 0xca, 0x26, 0x20, 0x73, 0xee, 0xb8, 0x39, 0x69, 0xd0, 0xce, 0x6b, 0x53, 0x62, 0x27, 0xcf,
-	0xc3, 0xb3, 0xe3, 0x3c, 0x7d, 0xc3, 0x65, 0x07, 0x22, 0xf7, 0x35, 0xcd, 0xac, 0x93, 0x69, 0xb6,
-	0x69, 0x66, 0x9d, 0x4a, 0xaa, 0xa9, 0xc3, 0x4b, 0x84, 0x8a, 0xf3, 0x0e, 0xaf, 0x64, 0x3a, 0x27,
-	0xe7, 0x9c, 0x49, 0x27, 0x3f, 0xa4, 0xfb, 0x99, 0x0e, 0x41, 0xa3, 0x4b, 0x53, 0xc5, 0xa9, 0x27,
-	0xb9, 0x9f, 0xfa, 0x98, 0xb6, 0xbc, 0xba, 0x65, 0x22, 0xec, 0x39, 0x57, 0x29, 0x7d, 0x88, 0x3e,
-	0xe7, 0xea, 0x96, 0x13, 0xb4, 0x17, 0x1b, 0xab, 0x95, 0x0d, 0x57, 0x4e, 0x8e, 0x85, 0x64, 0xc3,
-	0x58, 0xc7, 0x87, 0x2b, 0x5a, 0xd9, 0xd8, 0x60, 0xce, 0x00, 0x39, 0x21, 0xc4, 0x29, 0x06, 0xc8,
-	0x46, 0xd8, 0x72, 0x06, 0xc8, 0x09, 0xc4, 0x4d, 0xe1, 0xbb, 0xa6, 0xa2, 0x5d, 0x39, 0x47, 0xa1,
-	0x2e, 0x22, 0x96, 0x73, 0x14, 0x6a, 0x03, 0x75, 0xe6, 0xdc, 0xe6, 0x10, 0x1a, 0x3b, 0x61, 0xf0,
-	0xe8, 0x28, 0x0a, 0x54, 0xfd, 0x6c, 0x8c, 0xeb, 0x8d, 0x6f, 0xc0, 0x82, 0x1b, 0xe3, 0xf4, 0xc2,
-	0x41, 0xf7, 0x46, 0x5d, 0x06, 0xcc, 0x76, 0x38, 0xf1, 0x8e, 0xf1, 0xcb, 0xd7, 0x7a, 0x2e, 0x3b,
-	0x18, 0xee, 0x71, 0xc9, 0x5c, 0x95, 0x68, 0xaf, 0xb9, 0x81, 0xfa, 0x75, 0xd5, 0xf5, 0x19, 0x09,
-	0x7d, 0xec, 0x5d, 0x15, 0x43, 0x29, 0xe8, 0x60, 0xef, 0x0f, 0x0d, 0x63, 0xaf, 0x2c, 0x40, 0xd7,
-	0xfe, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x07, 0x9d, 0x01, 0x89, 0xa6, 0x53, 0x00, 0x00,
+	// 6126 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0xdd, 0x8f, 0x1c, 0xc7,
+	0x71, 0x38, 0x67, 0x77, 0x6f, 0x3f, 0x6a, 0x77, 0xef, 0x96, 0x73, 0x5f, 0xab, 0xe1, 0x87, 0x8e,
+	0x43, 0x7d, 0x1c, 0x8f, 0x26, 0x69, 0x1d, 0x65, 0x59, 0x96, 0xf5, 0xfb, 0xc9, 0xe4, 0x9d, 0x48,
+	0x5e, 0xf8, 0xe1, 0xd3, 0x9c, 0x28, 0x41, 0x56, 0x84, 0xf5, 0xdc, 0x4e, 0xdf, 0xde, 0xe8, 0x66,
+	0x67, 0x96, 0x33, 0xb3, 0x24, 0x4f, 0x2f, 0x31, 0xe2, 0x38, 0x8e, 0x61, 0x5b, 0x86, 0x11, 0x23,
+	0xb1, 0x81, 0x24, 0x08, 0x12, 0x1b, 0x41, 0x1e, 0xf2, 0x0d, 0x38, 0x41, 0x5e, 0xf2, 0x12, 0x20,
+	0x79, 0x08, 0xe0, 0x38, 0x09, 0x10, 0x04, 0x7e, 0x09, 0x10, 0xe4, 0x0f, 0x08, 0x90, 0xc7, 0x3c,
+	0x04, 0xfd, 0x35, 0xdb, 0x33, 0xdb, 0xb3, 0x3b, 0xc7, 0xd5, 0xf9, 0x8e, 0x40, 0x9e, 0x76, 0xbb,
+	0xba, 0xba, 0xbb, 0xba, 0xba, 0xaa, 0xba, 0xba, 0xbb, 0xba, 0x07, 0x6a, 0x5d, 0xdb, 0x79, 0xd8,
+	0x0f, 0x2e, 0xf7, 0x7c, 0x2f, 0xf4, 0xd4, 0x59, 0x31, 0x75, 0x99, 0x26, 0xb4, 0x5a, 0xdb, 0xeb,
+	0x76, 0x3d, 0x97, 0x02, 0xb5, 0x5a, 0xd0, 0xde, 0x45, 0x5d, 0x93, 0xa6, 0xf4, 0xdf, 0x51, 0x40,
+	0x5d, 0xf3, 0x91, 0x19, 0xa2, 0x6b, 0x8e, 0x6d, 0x06, 0x06, 0x7a, 0xd0, 0x47, 0x41, 0xa8, 0x7e,
+	0x1a, 0x0a, 0xdb, 0x66, 0x80, 0x9a, 0xca, 0x92, 0xb2, 0x5c, 0x5d, 0x3d, 0x7d, 0x39, 0x56, 0x2d,
+	0xab, 0xee, 0x6e, 0xd0, 0xb9, 0x6e, 0x06, 0xc8, 0x20, 0x98, 0xea, 0x22, 0x94, 0xac, 0xed, 0x96,
+	0x6b, 0x76, 0x51, 0x33, 0xb7, 0xa4, 0x2c, 0x57, 0x8c, 0xa2, 0xb5, 0x7d, 0xcf, 0xec, 0x22, 0xf5,
+	0x45, 0x98, 0x69, 0x7b, 0x8e, 0x83, 0xda, 0xa1, 0xed, 0xb9, 0x14, 0x21, 0x4f, 0x10, 0xa6, 0x07,
+	0x60, 0x82, 0x38, 0x07, 0x53, 0x26, 0xa6, 0xa1, 0x59, 0x20, 0xd9, 0x34, 0xa1, 0x07, 0xd0, 0x58,
+	0xf7, 0xbd, 0xde, 0x61, 0x51, 0x17, 0x35, 0x9a, 0x17, 0x1b, 0xfd, 0x6d, 0x05, 0x4e, 0x5e, 0x73,
+	0x42, 0xe4, 0x1f, 0x53, 0xa6, 0x7c, 0x5f, 0x81, 0x45, 0x03, 0xe1, 0x62, 0x6b, 0x11, 0xfa, 0x21,
+	0x50, 0xd9, 0x84, 0x92, 0xe7, 0x58, 0xf7, 0x06, 0xd4, 0xf1, 0x24, 0xce, 0x71, 0xd1, 0x23, 0x92,
+	0x43, 0x09, 0xe3, 0x49, 0xfd, 0xef, 0x14, 0x78, 0xe6, 0x9a, 0x65, 0x0d, 0xe8, 0xba, 0x61, 0x23,
+	0xc7, 0x3a, 0x4a, 0x16, 0xbe, 0x02, 0x53, 0x3b, 0x98, 0x06, 0x42, 0x69, 0x75, 0x75, 0x29, 0xde,
+	0x28, 0xd3, 0x06, 0x42, 0xe5, 0x16, 0xf9, 0x6f, 0x50, 0x74, 0xfd, 0x27, 0x0a, 0x2c, 0x10, 0x21,
+	0x38, 0x54, 0x1e, 0x67, 0xee, 0xc6, 0x35, 0x80, 0x9e, 0xef, 0xf5, 0x90, 0x1f, 0xda, 0x08, 0x8b,
+	0x43, 0x7e, 0xb9, 0xba, 0x7a, 0x4e, 0xda, 0xf2, 0x6d, 0xb4, 0xff, 0x8e, 0xe9, 0xf4, 0xd1, 0xa6,
+	0x69, 0xfb, 0x86, 0x50, 0x48, 0xff, 0x91, 0x02, 0xf3, 0x54, 0xd9, 0xd7, 0xcd, 0xd0, 0xc4, 0x74,
+	0x1d, 0x42, 0x87, 0xe2, 0x74, 0xe6, 0x9f, 0x84, 0xce, 0x2f, 0xc3, 0x2c, 0xd6, 0xf9, 0xc3, 0x23,
+	0x52, 0xff, 0xa1, 0x02, 0x73, 0x64, 0x6c, 0x8f, 0x37, 0x23, 0x6e, 0xc1, 0xdc, 0x1d, 0x3b, 0x08,
+	0x39, 0x91, 0x4f, 0x6e, 0x89, 0xf4, 0x0e, 0xcc, 0x27, 0x6a, 0x0a, 0x7a, 0x9e, 0x1b, 0x20, 0xf5,
+	0x2a, 0x14, 0x83, 0xd0, 0x0c, 0xfb, 0x01, 0xab, 0xec, 0x94, 0xb4, 0xb2, 0x2d, 0x82, 0x62, 0x30,
+	0x54, 0xf5, 0x19, 0x28, 0xb3, 0x3e, 0x07, 0xcd, 0xdc, 0x52, 0x1e, 0xeb, 0x3f, 0xed, 0x74, 0xa0,
+	0x7f, 0x3f, 0x07, 0x8b, 0x54, 0xc6, 0x8e, 0x87, 0xda, 0x2c, 0x40, 0x91, 0xaa, 0x38, 0x51, 0xff,
+	0x9a, 0xc1, 0x52, 0xea, 0x19, 0x80, 0x60, 0xd7, 0xf4, 0xad, 0xa0, 0xe5, 0xf6, 0xbb, 0xcd, 0xa9,
+	0x25, 0x65, 0x79, 0xca, 0xa8, 0x50, 0xc8, 0xbd, 0x7e, 0x57, 0x35, 0xe0, 0x64, 0xdb, 0x73, 0x03,
+	0x3b, 0x08, 0x91, 0xdb, 0xde, 0x6f, 0x39, 0xe8, 0x21, 0x72, 0x9a, 0xc5, 0x25, 0x65, 0x79, 0x7a,
+	0xf5, 0x79, 0x29, 0xdd, 0x6b, 0x03, 0xec, 0x3b, 0x18, 0xd9, 0x68, 0xb4, 0x13, 0x10, 0xfd, 0x9b,
+	0x0a, 0xcc, 0x63, 0xb9, 0x3e, 0x16, 0x8c, 0xd1, 0xff, 0x50, 0x81, 0xb9, 0x5b, 0x66, 0x70, 0x3c,
+	0x46, 0xe9, 0x0c, 0x40, 0x68, 0x77, 0x51, 0x2b, 0x08, 0xcd, 0x6e, 0x8f, 0x8c, 0x54, 0xc1, 0xa8,
+	0x60, 0xc8, 0x16, 0x06, 0xe8, 0xef, 0x41, 0xed, 0xba, 0xe7, 0x39, 0x93, 0x09, 0xed, 0x1c, 0x4c,
+	0x3d, 0xc4, 0x5a, 0x46, 0x68, 0x2c, 0x1b, 0x34, 0xa1, 0xbf, 0x0f, 0xd3, 0x5b, 0xa1, 0x6f, 0xbb,
+	0x9d, 0x4f, 0xb0, 0xf2, 0x0a, 0xaf, 0xfc, 0x9f, 0x14, 0x78, 0x66, 0x1d, 0x05, 0x6d, 0xdf, 0xde,
+	0x3e, 0x26, 0xea, 0xa0, 0x43, 0x6d, 0x00, 0xd9, 0x58, 0x27, 0xac, 0xce, 0x1b, 0x31, 0x58, 0x62,
+	0x30, 0xa6, 0x92, 0x83, 0xf1, 0x95, 0x29, 0xd0, 0x64, 0x9d, 0x9a, 0x84, 0x7d, 0xff, 0x2f, 0xd2,
+	0xd2, 0x1c, 0x29, 0xf4, 0xbc, 0x74, 0x92, 0x1e, 0xb4, 0xc6, 0x66, 0x6a, 0xae, 0xcc, 0xc9, 0x5e,
+	0xe5, 0x25, 0xbd, 0x5a, 0x85, 0xf9, 0x87, 0xb6, 0x1f, 0xf6, 0x4d, 0xa7, 0xd5, 0xde, 0x35, 0x5d,
+	0x17, 0x39, 0xcc, 0x80, 0x15, 0x88, 0x01, 0x9b, 0x65, 0x99, 0x6b, 0x34, 0x8f, 0x18, 0x33, 0xf5,
+	0x65, 0x58, 0xe8, 0xed, 0xee, 0x07, 0x76, 0x7b, 0xa8, 0xd0, 0x14, 0x29, 0x34, 0xc7, 0x73, 0x63,
+	0xa5, 0x2e, 0xc2, 0xc9, 0x36, 0xb1, 0x80, 0x56, 0x0b, 0x73, 0x8d, 0xb2, 0xb1, 0x48, 0xd8, 0xd8,
+	0x60, 0x19, 0x6f, 0x73, 0x38, 0x26, 0x8b, 0x23, 0xf7, 0xc3, 0xb6, 0x50, 0xa0, 0x44, 0x0a, 0xcc,
+	0xb2, 0xcc, 0xfb, 0x61, 0x7b, 0x50, 0x26, 0x6e, 0xbb, 0xca, 0x49, 0xdb, 0xd5, 0x84, 0x12, 0x71,
+	0x13, 0x51, 0xd0, 0xac, 0x50, 0xe3, 0xcc, 0x92, 0xea, 0x06, 0xcc, 0x04, 0xa1, 0xe9, 0x87, 0xad,
+	0x9e, 0x17, 0xd8, 0x98, 0x2f, 0x41, 0x13, 0x96, 0xf2, 0xc3, 0x4e, 0xd1, 0x60, 0x5e, 0xc2, 0x13,
+	0x06, 0x99, 0x96, 0xa6, 0x49, 0xc1, 0x4d, 0x5e, 0x4e, 0x6e, 0x20, 0xab, 0x13, 0x19, 0x48, 0x99,
+	0x14, 0xd7, 0xa4, 0xb6, 0xeb, 0x9f, 0x15, 0x98, 0xbf, 0xe3, 0x99, 0xd6, 0xf1, 0xd0, 0xa9, 0xe7,
+	0x61, 0xda, 0x47, 0x3d, 0xc7, 0x6e, 0x9b, 0x78, 0x3c, 0xb6, 0x91, 0x4f, 0xb4, 0x6a, 0xca, 0xa8,
+	0x33, 0xe8, 0x3d, 0x02, 0x54, 0x9f, 0x85, 0xaa, 0xe3, 0x99, 0x56, 0x8b, 0x78, 0x97, 0x5c, 0x82,
+	0x00, 0x83, 0x88, 0xf3, 0x19, 0xe8, 0x1f, 0x2b, 0xd0, 0x34, 0x90, 0x83, 0xcc, 0xe0, 0x78, 0x18,
+	0x0b, 0x32, 0x61, 0xdd, 0x44, 0x21, 0xa3, 0xe9, 0x17, 0xbc, 0xed, 0xa3, 0x5c, 0x0a, 0xe9, 0xff,
+	0xa2, 0x00, 0x0c, 0x48, 0xc1, 0x16, 0xf7, 0x43, 0x6f, 0x7b, 0x63, 0x9d, 0xd0, 0x90, 0x37, 0x68,
+	0x62, 0xc8, 0x12, 0xe4, 0x24, 0x96, 0xe0, 0x35, 0x98, 0x0a, 0x42, 0x33, 0xa4, 0xed, 0x4c, 0xaf,
+	0x3e, 0x77, 0x59, 0xb2, 0x68, 0xbe, 0x3c, 0x68, 0x09, 0x9b, 0x2a, 0x64, 0xd0, 0x22, 0xd8, 0x9d,
+	0xf0, 0x91, 0x19, 0x78, 0x2e, 0x5b, 0xf7, 0xb0, 0x14, 0x51, 0x49, 0xa2, 0x59, 0x58, 0x81, 0x89,
+	0xcd, 0xac, 0x18, 0x15, 0x02, 0xc1, 0x6a, 0x8b, 0x1d, 0x26, 0xe4, 0x52, 0x73, 0x40, 0x2c, 0x41,
+	0xc5, 0x28, 0x21, 0x97, 0x58, 0x01, 0xfd, 0x97, 0x15, 0x58, 0x48, 0x32, 0x79, 0x12, 0x53, 0x7a,
+	0x15, 0x0a, 0x1f, 0x7a, 0xdb, 0xd4, 0x2f, 0xab, 0xae, 0x3e, 0x3b, 0xa6, 0x73, 0x06, 0x41, 0xd6,
+	0xbf, 0xa7, 0xc0, 0xd9, 0x9b, 0x28, 0x14, 0x0c, 0x6c, 0x68, 0x86, 0x76, 0x10, 0xda, 0xed, 0x23,
+	0x1d, 0xf2, 0xef, 0x28, 0xf0, 0x6c, 0x2a, 0x59, 0x93, 0x30, 0xe9, 0xb3, 0x54, 0x04, 0x38, 0x97,
+	0x32, 0xb8, 0xe5, 0x14, 0x5f, 0xff, 0x77, 0x05, 0x16, 0xb6, 0x76, 0xbd, 0x47, 0x03, 0x92, 0x0e,
+	0x83, 0x41, 0xf1, 0x19, 0x38, 0x9f, 0x98, 0x81, 0xd5, 0x97, 0xa0, 0x10, 0xee, 0xf7, 0xe8, 0xd2,
+	0x7b, 0x7a, 0xf5, 0x8c, 0x74, 0x88, 0x31, 0x91, 0x6f, 0xef, 0xf7, 0x90, 0x41, 0x50, 0xd5, 0x0b,
+	0xd0, 0x48, 0xb0, 0x9c, 0x5b, 0xa0, 0x99, 0x38, 0xcf, 0x03, 0xfd, 0xaf, 0x72, 0xb0, 0x38, 0xd4,
+	0xc5, 0x49, 0x98, 0x2d, 0x6b, 0x3b, 0x27, 0x6d, 0x1b, 0x9b, 0x52, 0x01, 0xd5, 0xb6, 0xe8, 0xba,
+	0x29, 0x6f, 0xd4, 0x05, 0x05, 0xb6, 0x02, 0xf5, 0x12, 0xa8, 0x43, 0x33, 0x2c, 0x9d, 0xc8, 0x0b,
+	0xc6, 0xc9, 0xe4, 0x14, 0x4b, 0xa6, 0x71, 0xe9, 0x1c, 0x4b, 0x59, 0x50, 0x30, 0xe6, 0x24, 0x93,
+	0x6c, 0xa0, 0xbe, 0x04, 0x73, 0xb6, 0x7b, 0x17, 0x75, 0x3d, 0x7f, 0xbf, 0xd5, 0x43, 0x7e, 0x1b,
+	0xb9, 0xa1, 0xd9, 0x41, 0x41, 0xb3, 0x48, 0x28, 0x9a, 0xe5, 0x79, 0x9b, 0x83, 0x2c, 0xfd, 0x2f,
+	0x14, 0x58, 0xa0, 0x8b, 0x9f, 0x4d, 0xd3, 0x0f, 0xed, 0x63, 0x30, 0x31, 0xf5, 0x38, 0x1d, 0x14,
+	0x8f, 0x1a, 0xad, 0x7a, 0x04, 0x25, 0x5a, 0xf6, 0x67, 0x0a, 0xcc, 0xe1, 0x75, 0xc9, 0xd3, 0x44,
+	0xf3, 0x9f, 0x2a, 0x30, 0x7b, 0xcb, 0x0c, 0x9e, 0x26, 0x92, 0xff, 0x87, 0x39, 0x2d, 0x11, 0xcd,
+	0x47, 0xba, 0xb1, 0xf8, 0x22, 0xcc, 0xc4, 0x89, 0xe6, 0x8e, 0xf0, 0x74, 0x8c, 0xea, 0x40, 0xe2,
+	0xdd, 0x4c, 0x65, 0xf0, 0x6e, 0x8a, 0x43, 0xde, 0xcd, 0x5f, 0x0e, 0xbc, 0x9b, 0xa7, 0x8b, 0x03,
+	0xfa, 0x5f, 0x2b, 0x70, 0xe6, 0x26, 0x0a, 0x23, 0xaa, 0x8f, 0xc5, 0xdc, 0x98, 0x55, 0xea, 0x3e,
+	0xa6, 0x33, 0xbb, 0x94, 0xf8, 0x23, 0x99, 0x41, 0xbf, 0x99, 0x83, 0x79, 0x3c, 0xbd, 0x1c, 0x0f,
+	0x21, 0xc8, 0xb2, 0x1e, 0x96, 0x08, 0xca, 0x94, 0x54, 0x55, 0xf8, 0xbc, 0x5c, 0xcc, 0x3c, 0x2f,
+	0xeb, 0x7f, 0x9e, 0x83, 0x85, 0x24, 0x37, 0x26, 0x19, 0x16, 0x09, 0xad, 0x39, 0x29, 0xad, 0x3a,
+	0xd4, 0x22, 0xc8, 0xc6, 0x3a, 0x9f, 0x67, 0x63, 0xb0, 0x63, 0x3b, 0xcd, 0x7e, 0x4b, 0x81, 0x05,
+	0xbe, 0x03, 0xb1, 0x85, 0x3a, 0x5d, 0xe4, 0x86, 0x4f, 0x2e, 0x43, 0x59, 0x56, 0x0c, 0xa7, 0xa1,
+	0x12, 0xd0, 0x76, 0xa2, 0xcd, 0x85, 0x01, 0x40, 0xff, 0x1b, 0x05, 0x16, 0x87, 0xc8, 0x99, 0x64,
+	0x10, 0x9b, 0x50, 0xb2, 0x5d, 0x0b, 0x3d, 0x8e, 0xa8, 0xe1, 0x49, 0x9c, 0xb3, 0xdd, 0xb7, 0x1d,
+	0x2b, 0x22, 0x83, 0x27, 0xd5, 0x73, 0x50, 0x43, 0xae, 0xb9, 0xed, 0xa0, 0x16, 0xc1, 0x25, 0x82,
+	0x5c, 0x36, 0xaa, 0x14, 0xb6, 0x81, 0x41, 0xb8, 0x30, 0xb1, 0xce, 0x1b, 0xeb, 0xc4, 0x84, 0xe7,
+	0x0d, 0x9e, 0xd4, 0xbf, 0xad, 0xc0, 0x2c, 0x96, 0x42, 0x46, 0x7d, 0x70, 0xb8, 0xdc, 0x5c, 0x82,
+	0xaa, 0x20, 0x66, 0xac, 0x23, 0x22, 0x48, 0xdf, 0x83, 0xb9, 0x38, 0x39, 0x93, 0x70, 0xf3, 0x2c,
+	0x40, 0x34, 0x56, 0x54, 0x1b, 0xf2, 0x86, 0x00, 0xd1, 0xbf, 0x95, 0xe3, 0x47, 0xa0, 0x84, 0x4d,
+	0x47, 0xbc, 0x0d, 0x4a, 0x86, 0x44, 0xb4, 0xe7, 0x15, 0x02, 0x21, 0xd9, 0xeb, 0x50, 0x43, 0x8f,
+	0x43, 0xdf, 0x6c, 0xf5, 0x4c, 0xdf, 0xec, 0x52, 0xb5, 0xca, 0x64, 0x7a, 0xab, 0xa4, 0xd8, 0x26,
+	0x29, 0x85, 0x1b, 0x21, 0x22, 0x42, 0x1b, 0xa1, 0xab, 0xd1, 0x0a, 0x81, 0x90, 0x09, 0xe3, 0xef,
+	0xb1, 0x37, 0xc8, 0xa4, 0xf9, 0xb8, 0x33, 0x24, 0xde, 0x95, 0xa9, 0x64, 0x57, 0x7e, 0xa4, 0x40,
+	0x83, 0x74, 0x81, 0xf6, 0xa7, 0x87, 0xab, 0x4d, 0x94, 0x51, 0x12, 0x65, 0x46, 0xe8, 0xde, 0xe7,
+	0xa0, 0xc8, 0xf8, 0x9e, 0xf9, 0x2c, 0x87, 0x15, 0x18, 0xd3, 0x0d, 0xfd, 0xf7, 0xf0, 0xc1, 0x40,
+	0x9c, 0xe5, 0x93, 0x08, 0xfc, 0xdb, 0xa0, 0xd2, 0x1e, 0x5a, 0x83, 0x6e, 0xf3, 0x79, 0xfa, 0x79,
+	0xe9, 0xa4, 0x94, 0x64, 0x92, 0x71, 0xd2, 0x4e, 0x40, 0x02, 0xfd, 0x1f, 0x15, 0x38, 0x7d, 0x13,
+	0x85, 0x04, 0xf5, 0x3a, 0x36, 0x3a, 0x9b, 0xbe, 0xd7, 0xf1, 0x51, 0x10, 0x3c, 0xbd, 0xf2, 0xf1,
+	0x1b, 0xd4, 0xb1, 0x93, 0x75, 0x69, 0x12, 0xfe, 0x9f, 0x83, 0x1a, 0x69, 0x03, 0x59, 0x2d, 0xdf,
+	0x7b, 0x14, 0x30, 0x39, 0xaa, 0x32, 0x98, 0xe1, 0x3d, 0x22, 0x02, 0x11, 0x7a, 0xa1, 0xe9, 0x50,
+	0x04, 0x36, 0xa3, 0x10, 0x08, 0xce, 0xd6, 0x7f, 0xaa, 0xc0, 0xe2, 0x9a, 0xe9, 0xb6, 0x91, 0x33,
+	0xa0, 0xed, 0x88, 0xd9, 0x2c, 0xf0, 0xb1, 0x90, 0xd4, 0x99, 0xf3, 0x50, 0xa7, 0xd9, 0x7c, 0x6e,
+	0xa2, 0xd3, 0x4b, 0xcd, 0x8e, 0x88, 0xdf, 0x58, 0xd7, 0xbf, 0xae, 0x40, 0x73, 0xb8, 0x4f, 0x93,
+	0xf0, 0xf9, 0x15, 0x58, 0x6c, 0x93, 0x0a, 0x91, 0xd5, 0x8a, 0xb5, 0xcf, 0xad, 0xfc, 0x3c, 0xcf,
+	0xde, 0x10, 0x08, 0x09, 0x88, 0x85, 0xe3, 0xc3, 0x4e, 0x37, 0xf7, 0x9e, 0x5a, 0x09, 0xfe, 0x21,
+	0xdd, 0xa1, 0x15, 0xbb, 0x32, 0x09, 0x47, 0x3f, 0xc3, 0x77, 0x46, 0x73, 0xc4, 0x83, 0x7d, 0x56,
+	0x5a, 0x46, 0x68, 0x8c, 0x62, 0xe3, 0xb5, 0xdf, 0x8e, 0x69, 0x3b, 0x2d, 0xb6, 0x33, 0x4a, 0x3b,
+	0x0a, 0x18, 0x64, 0x10, 0x88, 0xfe, 0xb7, 0x0a, 0x8d, 0xe2, 0x79, 0xca, 0xe7, 0x93, 0xdf, 0xcf,
+	0x41, 0x7d, 0xc3, 0x0d, 0x90, 0x1f, 0x1e, 0xff, 0x85, 0x9f, 0xfa, 0x06, 0x54, 0x49, 0xc7, 0x82,
+	0x96, 0x65, 0x86, 0x26, 0xf3, 0x15, 0xce, 0xa6, 0x07, 0xbf, 0xe0, 0x93, 0x1e, 0x83, 0x72, 0x27,
+	0xc0, 0xff, 0xd5, 0x53, 0x50, 0xd9, 0x35, 0x83, 0xdd, 0xd6, 0x1e, 0xda, 0xa7, 0xde, 0x78, 0xdd,
+	0x28, 0x63, 0xc0, 0x6d, 0xb4, 0x4f, 0x22, 0x00, 0xdc, 0x7e, 0x97, 0x9a, 0x2f, 0x7c, 0x52, 0x55,
+	0x37, 0x4a, 0x6e, 0xbf, 0x4b, 0x8c, 0x17, 0xe6, 0xd2, 0xfd, 0xde, 0xff, 0x71, 0x69, 0x34, 0x97,
+	0xfe, 0x21, 0x07, 0xd3, 0x77, 0xfb, 0xa1, 0xc9, 0xce, 0x4e, 0xfb, 0x4e, 0xf8, 0x64, 0x2a, 0xbb,
+	0x02, 0x79, 0x6a, 0xf0, 0x70, 0x89, 0xa6, 0x94, 0xf0, 0x8d, 0xf5, 0xc0, 0xc0, 0x48, 0xe4, 0x90,
+	0xa2, 0xdf, 0x6e, 0xb3, 0x15, 0x42, 0x9e, 0x10, 0x5b, 0xc1, 0x10, 0xba, 0x3e, 0x38, 0x05, 0x15,
+	0xe4, 0xfb, 0xd1, 0xfa, 0x81, 0x74, 0x05, 0xf9, 0x3e, 0xcd, 0xd4, 0xa1, 0x66, 0xb6, 0xf7, 0x5c,
+	0xef, 0x91, 0x83, 0xac, 0x0e, 0xb2, 0x88, 0x72, 0x94, 0x8d, 0x18, 0x8c, 0xaa, 0x0f, 0x1e, 0xf8,
+	0x56, 0xdb, 0x0d, 0x89, 0x67, 0x99, 0x37, 0x2a, 0x14, 0xb2, 0xe6, 0x86, 0x38, 0xdb, 0x42, 0x0e,
+	0x0a, 0x11, 0xc9, 0x2e, 0xd1, 0x6c, 0x0a, 0x61, 0xd9, 0xfd, 0x5e, 0x54, 0xba, 0x4c, 0xb3, 0x29,
+	0x04, 0x67, 0x9f, 0x86, 0xca, 0xe0, 0x70, 0xb4, 0x32, 0xd8, 0x12, 0x27, 0x00, 0xfd, 0x67, 0x0a,
+	0xd4, 0xd7, 0x49, 0x55, 0x4f, 0x81, 0xd0, 0xa9, 0x50, 0x40, 0x8f, 0x7b, 0x3e, 0x33, 0x30, 0xe4,
+	0xff, 0x48, 0x39, 0xd2, 0x1f, 0x42, 0x63, 0xd3, 0x31, 0xdb, 0x68, 0xd7, 0x73, 0x2c, 0xe4, 0x13,
+	0xff, 0x52, 0x6d, 0x40, 0x3e, 0x34, 0x3b, 0xcc, 0x81, 0xc5, 0x7f, 0xd5, 0x57, 0xd9, 0xf6, 0x43,
+	0x6e, 0xc4, 0xb1, 0x96, 0x50, 0x8d, 0x70, 0x3a, 0xb0, 0x00, 0x45, 0x12, 0xb0, 0x40, 0x5d, 0xdb,
+	0x9a, 0xc1, 0x52, 0xfa, 0x07, 0xb1, 0x76, 0x6f, 0xfa, 0x5e, 0xbf, 0xa7, 0x6e, 0x40, 0xad, 0x37,
+	0x80, 0x61, 0x59, 0x4d, 0xf7, 0x2b, 0x93, 0x44, 0x1b, 0xb1, 0xa2, 0xfa, 0x8f, 0x0b, 0x50, 0xdf,
+	0x42, 0xa6, 0xdf, 0xde, 0x7d, 0x2a, 0x76, 0x42, 0x1b, 0x90, 0xb7, 0x02, 0x87, 0x8d, 0x1a, 0xfe,
+	0x8b, 0x4f, 0xfa, 0x85, 0x0e, 0xb5, 0x3a, 0x98, 0x41, 0x44, 0xee, 0x6b, 0x46, 0xa3, 0x97, 0x64,
+	0xdc, 0x67, 0xa1, 0x6c, 0x05, 0x4e, 0x8b, 0x0c, 0x51, 0x89, 0x0c, 0x91, 0xbc, 0x7f, 0xeb, 0x81,
+	0x43, 0x86, 0xa6, 0x64, 0xd1, 0x3f, 0xd8, 0xbd, 0xf2, 0xfa, 0x61, 0xaf, 0x1f, 0xf2, 0xcd, 0xd5,
+	0x32, 0x21, 0xaf, 0x46, 0x81, 0x74, 0x7b, 0x55, 0xbd, 0x01, 0xf5, 0x80, 0xb0, 0x92, 0x2f, 0x0e,
+	0x2b, 0x59, 0x17, 0x29, 0x35, 0x5a, 0x8e, 0xad, 0x0e, 0x2f, 0x40, 0x23, 0xf4, 0xcd, 0x87, 0xc8,
+	0x11, 0x42, 0x11, 0x80, 0x68, 0xdb, 0x0c, 0x85, 0x0f, 0xc2, 0x10, 0xae, 0xc0, 0x6c, 0xa7, 0x6f,
+	0xfa, 0xa6, 0x1b, 0x22, 0x24, 0x60, 0x57, 0x09, 0xb6, 0x1a, 0x65, 0x0d, 0x0a, 0x9c, 0x05, 0x08,
+	0x51, 0xb7, 0xe7, 0xe0, 0xa5, 0xf6, 0x3a, 0x39, 0xda, 0xcf, 0x1b, 0x02, 0x04, 0x8f, 0x04, 0x4f,
+	0xf1, 0x5e, 0xd4, 0xe9, 0x90, 0x71, 0x30, 0x25, 0x52, 0xbf, 0x0d, 0x85, 0x5b, 0x76, 0x48, 0x46,
+	0x64, 0x63, 0x9d, 0x8a, 0x60, 0x9e, 0x9a, 0xb8, 0x67, 0xa0, 0xec, 0x7b, 0x8f, 0xa8, 0x31, 0xcf,
+	0x11, 0x59, 0x2e, 0xf9, 0xde, 0x23, 0x62, 0xa9, 0x49, 0x24, 0x98, 0xe7, 0x33, 0x21, 0xcf, 0x19,
+	0x2c, 0xa5, 0xff, 0xb1, 0x32, 0x90, 0x42, 0x6c, 0x87, 0x83, 0x27, 0x33, 0xc4, 0x6f, 0x40, 0xc9,
+	0xa7, 0xe5, 0x47, 0xc6, 0xb0, 0x88, 0x2d, 0x91, 0xc9, 0x84, 0x97, 0xca, 0x7e, 0x2a, 0xfa, 0x2b,
+	0x0a, 0xd4, 0x6e, 0x38, 0xfd, 0xe0, 0x30, 0xb4, 0x46, 0x76, 0x56, 0x97, 0x97, 0x9f, 0x13, 0x7e,
+	0x37, 0x07, 0x75, 0x46, 0xc6, 0x24, 0x3e, 0x67, 0x2a, 0x29, 0x5b, 0x50, 0xc5, 0x4d, 0xb6, 0x02,
+	0xd4, 0xe1, 0x1b, 0x94, 0xd5, 0xd5, 0x55, 0xa9, 0x9d, 0x89, 0x91, 0x41, 0xc2, 0x84, 0xb6, 0x48,
+	0xa1, 0x37, 0xdd, 0xd0, 0xdf, 0x37, 0xa0, 0x1d, 0x01, 0xb4, 0x0f, 0x60, 0x26, 0x91, 0x8d, 0x85,
+	0x68, 0x0f, 0xed, 0x73, 0x43, 0xba, 0x87, 0xf6, 0xd5, 0x97, 0xc5, 0x60, 0xae, 0x34, 0x77, 0xe0,
+	0x8e, 0xe7, 0x76, 0xae, 0xf9, 0xbe, 0xb9, 0xcf, 0x82, 0xbd, 0x5e, 0xcb, 0xbd, 0xaa, 0xe8, 0xdf,
+	0xc8, 0x43, 0xed, 0xad, 0x3e, 0xf2, 0xf7, 0x8f, 0xd2, 0xa0, 0xf1, 0xe9, 0xa5, 0x20, 0x4c, 0x2f,
+	0x43, 0x36, 0x64, 0x4a, 0x62, 0x43, 0x24, 0x96, 0xb0, 0x28, 0xb5, 0x84, 0x32, 0x23, 0x51, 0x3a,
+	0x90, 0x91, 0x28, 0x67, 0x34, 0x12, 0x95, 0x2c, 0x46, 0x02, 0xa4, 0x46, 0xe2, 0x8f, 0x94, 0x68,
+	0x2c, 0x26, 0x52, 0xeb, 0x98, 0x83, 0x98, 0x3b, 0xb0, 0x83, 0x98, 0x59, 0xad, 0xff, 0x93, 0x04,
+	0xf5, 0x77, 0xec, 0x20, 0x44, 0xfe, 0xdb, 0xac, 0x2b, 0xc7, 0x4d, 0x8c, 0xce, 0x00, 0x98, 0xae,
+	0x1b, 0x50, 0x21, 0xe2, 0x0b, 0x24, 0x0c, 0x21, 0x7d, 0x1f, 0x96, 0xb2, 0xe2, 0xb0, 0x94, 0xe9,
+	0x1e, 0x34, 0x87, 0xbb, 0x39, 0xe1, 0x06, 0xaf, 0x20, 0x31, 0xb9, 0xa4, 0xc4, 0xe0, 0x48, 0x57,
+	0x12, 0x4f, 0x3e, 0x39, 0x53, 0xc7, 0xb4, 0x24, 0x32, 0x3d, 0x3f, 0x8e, 0xe9, 0x05, 0x79, 0x54,
+	0x6e, 0x0e, 0xa6, 0xdf, 0x7c, 0xdc, 0x73, 0x4c, 0xdb, 0x7d, 0x2a, 0x7c, 0x22, 0x99, 0x2b, 0x1b,
+	0x17, 0x92, 0x62, 0x52, 0x48, 0x54, 0x28, 0x84, 0x5e, 0x6f, 0x8f, 0x2d, 0x00, 0xc8, 0x7f, 0x75,
+	0x1a, 0x72, 0xee, 0x03, 0xe6, 0xf3, 0xe7, 0xdc, 0x07, 0x58, 0x90, 0x92, 0xde, 0x0c, 0xae, 0x25,
+	0xe6, 0xaa, 0xe8, 0x7f, 0x92, 0x83, 0x06, 0xe3, 0x15, 0xb2, 0xd8, 0x59, 0x41, 0xfc, 0xa8, 0x46,
+	0x49, 0x1c, 0xd5, 0x24, 0x8f, 0x1e, 0x72, 0x43, 0x47, 0x0f, 0xe4, 0x66, 0x8b, 0x67, 0xa1, 0x8d,
+	0x28, 0xf4, 0x84, 0x27, 0xf9, 0x89, 0x27, 0x0f, 0xbb, 0x91, 0x7b, 0x56, 0x8c, 0x8c, 0xd8, 0xf6,
+	0x88, 0xb8, 0x0a, 0x64, 0x07, 0x2f, 0x6c, 0x15, 0x38, 0x66, 0x2f, 0x5e, 0xdc, 0x8c, 0x2e, 0xc5,
+	0x37, 0xa3, 0x5f, 0x86, 0x42, 0xd0, 0x36, 0x5d, 0xc2, 0xb2, 0xe9, 0x64, 0xf8, 0x26, 0x4b, 0x70,
+	0x5a, 0xda, 0xa6, 0x6b, 0x10, 0x6c, 0x1c, 0x35, 0x51, 0x65, 0x1c, 0x5b, 0xf3, 0x82, 0x50, 0xd5,
+	0xa0, 0xcc, 0x78, 0x13, 0x30, 0x5e, 0x45, 0x69, 0x3c, 0x4c, 0xc2, 0xee, 0x25, 0xf9, 0x8f, 0xed,
+	0x3e, 0xdf, 0xd9, 0x8c, 0xca, 0xd1, 0xcd, 0xcb, 0x19, 0x06, 0xdf, 0xe2, 0xc5, 0x97, 0xa1, 0xb1,
+	0xed, 0xf7, 0x43, 0xd4, 0xda, 0xf1, 0xfc, 0x36, 0xa2, 0x9d, 0xa7, 0x87, 0xab, 0xd3, 0x04, 0x7e,
+	0x03, 0x83, 0x09, 0x0f, 0x4e, 0x43, 0xc5, 0xb2, 0x83, 0xd0, 0x74, 0xdb, 0x88, 0xf3, 0x67, 0x00,
+	0xd0, 0xff, 0x20, 0x07, 0x33, 0x91, 0x42, 0x4c, 0x62, 0x25, 0xb2, 0x9c, 0x4c, 0x9d, 0x82, 0x8a,
+	0x1d, 0xb4, 0xa8, 0x90, 0x91, 0x8e, 0x95, 0x8d, 0xb2, 0x1d, 0x50, 0x97, 0x0d, 0x33, 0xa4, 0xe7,
+	0x98, 0x3c, 0xf0, 0x8f, 0xfc, 0x57, 0xaf, 0x09, 0x0c, 0x9c, 0x1a, 0xb1, 0x10, 0x4a, 0x8a, 0xa9,
+	0xc0, 0xe7, 0x9b, 0x30, 0x8d, 0x82, 0xd0, 0xee, 0x92, 0x73, 0xd3, 0xb6, 0x17, 0xd0, 0x85, 0x73,
+	0x75, 0x75, 0x69, 0x54, 0x45, 0x78, 0xf4, 0x8c, 0x7a, 0x54, 0x0e, 0x27, 0x71, 0x18, 0x4f, 0xe5,
+	0x1d, 0xd4, 0x0e, 0x3d, 0x1f, 0x3b, 0xc2, 0x12, 0x55, 0x57, 0x32, 0x6c, 0x89, 0xe5, 0x92, 0x5b,
+	0x62, 0x57, 0xa1, 0x6c, 0x5b, 0x2d, 0x13, 0x3b, 0x3a, 0xcd, 0xfc, 0x98, 0x4d, 0x86, 0x92, 0x6d,
+	0x11, 0x8f, 0x28, 0x7b, 0x68, 0xc5, 0x6f, 0x2a, 0x50, 0xa3, 0x34, 0x07, 0xb4, 0xe4, 0xe7, 0x85,
+	0xe6, 0x14, 0x99, 0xf7, 0xc5, 0x12, 0x51, 0x47, 0x6f, 0x9d, 0x18, 0x34, 0x7b, 0x0d, 0x00, 0x4f,
+	0xd2, 0xac, 0x78, 0x6e, 0xc4, 0x75, 0x2f, 0x5a, 0x9c, 0xd8, 0xa3, 0x5b, 0x27, 0x8c, 0x0a, 0x2e,
+	0x45, 0xaa, 0xb8, 0x5e, 0x82, 0x29, 0x52, 0x1a, 0x47, 0xeb, 0xcc, 0xae, 0x99, 0x4e, 0x7b, 0x9d,
+	0x49, 0xe2, 0x93, 0x5b, 0xe3, 0xd7, 0xa0, 0xe4, 0xf5, 0x5a, 0x0e, 0xda, 0x09, 0x19, 0x49, 0xe7,
+	0x46, 0xf4, 0x88, 0xb2, 0xc1, 0x28, 0x7a, 0xbd, 0x3b, 0x68, 0x27, 0x54, 0x5f, 0x87, 0xb2, 0xd7,
+	0x6b, 0xf9, 0x76, 0x67, 0x37, 0x6c, 0xe6, 0xb3, 0x16, 0x2e, 0x79, 0x3d, 0x03, 0x97, 0x10, 0x4e,
+	0xac, 0x0a, 0x07, 0x3c, 0xb1, 0xd2, 0x7f, 0x3a, 0xd4, 0xfd, 0x09, 0x7c, 0xa8, 0xd7, 0xa0, 0x6c,
+	0xbb, 0x61, 0x0b, 0x2b, 0x35, 0x63, 0xc1, 0x19, 0xb9, 0x0c, 0xb9, 0x21, 0xe9, 0x01, 0x19, 0x53,
+	0x37, 0xc4, 0x6d, 0xab, 0x5f, 0x00, 0xd8, 0x71, 0x3c, 0x93, 0x95, 0xa6, 0x3c, 0x78, 0x56, 0xee,
+	0x7e, 0x61, 0x34, 0x5e, 0xbe, 0x42, 0x0a, 0xe1, 0x1a, 0x06, 0x43, 0xfa, 0x13, 0x05, 0xe6, 0x37,
+	0x91, 0x4f, 0x63, 0xce, 0x43, 0xa6, 0x89, 0x1b, 0xee, 0x8e, 0x37, 0x66, 0xd2, 0xf8, 0x44, 0xce,
+	0xb4, 0x63, 0xb3, 0x40, 0x21, 0x3e, 0x0b, 0x44, 0x33, 0xcb, 0xd4, 0xc1, 0x66, 0x16, 0xfd, 0xd7,
+	0x69, 0x7c, 0xac, 0xb4, 0x53, 0x4f, 0x2e, 0xb0, 0x0b, 0xc0, 0xfc, 0x85, 0x84, 0xf7, 0xf0, 0x02,
+	0x24, 0x6c, 0x47, 0x8a, 0x23, 0xfb, 0x03, 0x05, 0x96, 0xd2, 0xa9, 0x9a, 0xc4, 0x86, 0x7f, 0x01,
+	0xa6, 0x6c, 0x77, 0xc7, 0xe3, 0x87, 0x99, 0x2b, 0xf2, 0x4d, 0x27, 0x69, 0xbb, 0xb4, 0xa0, 0xfe,
+	0xe3, 0x1c, 0x34, 0xc8, 0xa2, 0xe0, 0x08, 0x86, 0xbf, 0x8b, 0xba, 0xad, 0xc0, 0xfe, 0x08, 0xf1,
+	0xe1, 0xef, 0xa2, 0xee, 0x96, 0xfd, 0xd1, 0xe1, 0xf8, 0x07, 0x0b, 0x50, 0x24, 0x7e, 0xcb, 0x3a,
+	0x73, 0xaa, 0x58, 0x6a, 0x20, 0x6a, 0x95, 0x03, 0x8a, 0xda, 0xc7, 0x0a, 0x68, 0x37, 0x51, 0x98,
+	0xe4, 0xdd, 0xd1, 0x49, 0xd9, 0x77, 0x14, 0x38, 0x25, 0x25, 0x68, 0x12, 0x01, 0xfb, 0x7c, 0x5c,
+	0xc0, 0xe4, 0x93, 0xf9, 0x50, 0x93, 0x4c, 0xb6, 0x5e, 0x82, 0xda, 0x7a, 0xbf, 0xdb, 0x8d, 0xd6,
+	0xfe, 0xe7, 0xa0, 0xe6, 0xd3, 0xbf, 0x74, 0xd3, 0x8f, 0xce, 0xbf, 0x55, 0x06, 0xc3, 0x5b, 0x7b,
+	0xfa, 0x45, 0xa8, 0xb3, 0x22, 0x8c, 0x6a, 0x0d, 0xca, 0x3e, 0xfb, 0xcf, 0xf0, 0xa3, 0xb4, 0x3e,
+	0x0f, 0xb3, 0x7c, 0xe1, 0x74, 0xc7, 0x76, 0xf7, 0x58, 0x33, 0xfa, 0x57, 0x15, 0x98, 0x8b, 0xc3,
+	0x59, 0x5d, 0xaf, 0x40, 0xc9, 0xb4, 0x2c, 0x1f, 0x05, 0xc1, 0xc8, 0x61, 0xb9, 0x46, 0x71, 0x0c,
+	0x8e, 0x2c, 0x70, 0x2e, 0x97, 0x99, 0x73, 0x7a, 0x0b, 0x4e, 0xde, 0x44, 0xe1, 0x5d, 0x14, 0xfa,
+	0x13, 0xc5, 0x45, 0x36, 0xf1, 0x2e, 0x1a, 0x29, 0xcc, 0xc4, 0x82, 0x27, 0x71, 0xd0, 0x97, 0x2a,
+	0xb6, 0x30, 0xc9, 0x30, 0x8b, 0x5c, 0xce, 0xc5, 0xb9, 0x4c, 0x43, 0xd0, 0xbb, 0x3d, 0xcf, 0x45,
+	0x6e, 0x28, 0x2e, 0x91, 0xea, 0x11, 0x94, 0x88, 0xdf, 0xcf, 0x14, 0x50, 0x71, 0x34, 0xef, 0x75,
+	0xd3, 0x99, 0xcc, 0x3d, 0xc0, 0x87, 0x32, 0x7e, 0xbb, 0xc5, 0xb4, 0x35, 0xc7, 0xac, 0x8f, 0xdf,
+	0xbe, 0x47, 0x00, 0xf8, 0x6c, 0xd5, 0x0a, 0x42, 0x96, 0xcd, 0xd7, 0x24, 0x60, 0x05, 0x21, 0xcd,
+	0x27, 0xb7, 0xcd, 0x02, 0x64, 0x3a, 0x03, 0x97, 0x7c, 0x63, 0x9d, 0xce, 0xf7, 0x79, 0xa3, 0x41,
+	0x33, 0xb6, 0x22, 0xb8, 0x44, 0xb9, 0xa6, 0xa4, 0xca, 0xf5, 0x01, 0x9c, 0x5c, 0xf3, 0x7c, 0xcb,
+	0x73, 0x71, 0x2b, 0x13, 0xe9, 0x78, 0xac, 0x5f, 0x2c, 0xa5, 0xb7, 0x60, 0xf6, 0xbe, 0xdb, 0x3e,
+	0xc4, 0x06, 0x6e, 0xc3, 0x22, 0xb9, 0x37, 0x82, 0x5b, 0x40, 0x16, 0x6e, 0x63, 0x82, 0xbb, 0xd3,
+	0x16, 0xd4, 0xc4, 0x9a, 0x84, 0x46, 0x95, 0x98, 0x6d, 0x7d, 0x3d, 0x7e, 0x7a, 0xfe, 0x82, 0xd4,
+	0x78, 0x88, 0x35, 0xc5, 0x0c, 0xec, 0xd7, 0xf0, 0xe5, 0xfc, 0x38, 0xc1, 0x13, 0xc6, 0xe7, 0x62,
+	0xb2, 0x52, 0xe2, 0x73, 0x25, 0xc4, 0x18, 0x14, 0x1f, 0x5f, 0x88, 0x9d, 0x58, 0xa6, 0xf1, 0x06,
+	0x84, 0xbf, 0xdf, 0xf2, 0xfb, 0x2e, 0xbb, 0x6c, 0x5b, 0xb4, 0xfc, 0x7d, 0xa3, 0xef, 0xea, 0xff,
+	0xa1, 0x40, 0x95, 0xd5, 0x7e, 0xd7, 0x7b, 0x38, 0x1c, 0x2e, 0xa8, 0xc8, 0x83, 0x2f, 0x59, 0xa8,
+	0xf9, 0x40, 0x3f, 0x22, 0xc0, 0xe8, 0xd0, 0x4c, 0x6c, 0x4e, 0xd8, 0xbd, 0x4d, 0xfe, 0x4e, 0x05,
+	0x4b, 0x92, 0x1d, 0x06, 0xaf, 0x8f, 0x97, 0xa6, 0x6c, 0x2c, 0x59, 0xcc, 0x0a, 0x05, 0x32, 0xe5,
+	0xc3, 0x27, 0x96, 0x91, 0xf2, 0xf1, 0x03, 0xcd, 0x48, 0xf7, 0x84, 0xcb, 0x60, 0x25, 0xf1, 0x32,
+	0x18, 0x5e, 0xd5, 0xcc, 0x44, 0x3c, 0x9c, 0x74, 0x6f, 0x5c, 0xc6, 0x47, 0xfc, 0xa6, 0x45, 0xd7,
+	0x7b, 0x18, 0x3d, 0x2b, 0x20, 0x5f, 0x2b, 0x0a, 0x8c, 0x36, 0x28, 0xba, 0xfe, 0x01, 0x2c, 0xde,
+	0x35, 0x5d, 0x7c, 0xcd, 0xd5, 0xeb, 0xf6, 0xcc, 0xd8, 0x05, 0xc3, 0x2c, 0x43, 0x71, 0x96, 0xde,
+	0x4b, 0xa2, 0x7b, 0xbc, 0x84, 0xa4, 0x82, 0x21, 0x40, 0xf4, 0x00, 0x9a, 0xc3, 0xd5, 0x4f, 0xbc,
+	0x68, 0xe7, 0x55, 0x89, 0xbe, 0xd7, 0x00, 0xa6, 0xbf, 0x01, 0xcf, 0x10, 0x5d, 0xe7, 0xa0, 0x58,
+	0x48, 0x4f, 0xb2, 0x02, 0x45, 0x52, 0xc1, 0xd7, 0x73, 0xa0, 0xc9, 0x6a, 0x98, 0x84, 0xf0, 0xd7,
+	0xe2, 0xb6, 0xe0, 0xb9, 0x94, 0x2b, 0xb1, 0xf1, 0x16, 0x69, 0x11, 0x75, 0x19, 0x66, 0xd0, 0x63,
+	0xd4, 0xee, 0x87, 0xb6, 0xdb, 0xd9, 0x74, 0x4c, 0xf7, 0x9e, 0xc7, 0x37, 0x59, 0x12, 0x60, 0xf5,
+	0x39, 0xa8, 0x63, 0xee, 0x7b, 0xfd, 0x90, 0xe1, 0x51, 0xcf, 0x32, 0x0e, 0xc4, 0xf5, 0xe1, 0xfe,
+	0x3a, 0x28, 0x44, 0x16, 0xc3, 0xa3, 0xc2, 0x9e, 0x04, 0x0f, 0xb1, 0x12, 0x83, 0x83, 0x83, 0xb0,
+	0xf2, 0x5f, 0x15, 0xd0, 0x64, 0x35, 0x1c, 0x15, 0x2b, 0x6f, 0x01, 0x74, 0x91, 0xdf, 0x41, 0x1b,
+	0xc4, 0xa9, 0xa3, 0xca, 0xb2, 0x9c, 0x62, 0x0a, 0x79, 0x05, 0x77, 0x79, 0x01, 0x43, 0x28, 0xab,
+	0xdf, 0x84, 0x59, 0x09, 0x0a, 0x36, 0x30, 0xd4, 0x62, 0xf0, 0x53, 0x48, 0x9e, 0xc4, 0xc6, 0x21,
+	0x34, 0xfd, 0x0e, 0x0a, 0xf9, 0xd4, 0x44, 0x53, 0xfa, 0x2b, 0x24, 0xf8, 0x8c, 0x9c, 0x58, 0xc5,
+	0x24, 0x35, 0x1e, 0xa6, 0xac, 0x0c, 0x85, 0x29, 0xef, 0xc0, 0x7c, 0xa2, 0xdc, 0x84, 0x21, 0xe6,
+	0x3b, 0xb8, 0x2a, 0x64, 0x31, 0xcb, 0xc2, 0x93, 0xfa, 0xb7, 0x71, 0x90, 0x53, 0xb7, 0xe7, 0x0d,
+	0xc2, 0x77, 0x32, 0x6f, 0x25, 0x0d, 0x87, 0x3f, 0xe4, 0x64, 0xe1, 0x0f, 0xe7, 0xa1, 0x1e, 0xbf,
+	0x4c, 0x4f, 0x0f, 0x18, 0x6b, 0x6d, 0xf1, 0x12, 0xfd, 0x29, 0xa8, 0xe0, 0x83, 0x5c, 0x3c, 0x9d,
+	0x58, 0x2c, 0x98, 0x1d, 0x9f, 0xec, 0xe2, 0x49, 0xc6, 0xc2, 0x77, 0x7f, 0x77, 0x6c, 0x27, 0xba,
+	0x87, 0x41, 0x13, 0xea, 0xe7, 0xf1, 0x46, 0x0b, 0x0d, 0x76, 0x2d, 0x66, 0xdd, 0xef, 0xe0, 0x25,
+	0xc4, 0x3d, 0xf3, 0x52, 0xec, 0xa9, 0x98, 0xf7, 0x61, 0x9a, 0xb3, 0x63, 0xc2, 0x07, 0x22, 0x42,
+	0x33, 0xd8, 0xe3, 0xa1, 0x89, 0x34, 0xa1, 0x5f, 0xa4, 0xe1, 0x7b, 0xa4, 0xfe, 0x98, 0x34, 0xe0,
+	0x4d, 0x72, 0x33, 0xd8, 0x63, 0x4a, 0x46, 0xfe, 0xeb, 0xff, 0x9d, 0x83, 0x85, 0x24, 0xf6, 0x64,
+	0xf1, 0x93, 0x31, 0xc5, 0x92, 0xbf, 0x01, 0x20, 0xb6, 0xc6, 0x94, 0x8a, 0x0d, 0x4d, 0xdb, 0xeb,
+	0xbb, 0x21, 0xb3, 0x4c, 0x78, 0x68, 0xd6, 0x70, 0x1a, 0xf3, 0xd1, 0xb6, 0x5a, 0x0e, 0xde, 0xac,
+	0xa1, 0x4e, 0x68, 0xd1, 0xb6, 0xf0, 0xcb, 0x33, 0xd8, 0x21, 0xa1, 0x4b, 0xab, 0xcc, 0x51, 0xeb,
+	0x14, 0x1f, 0x9f, 0x0d, 0xd8, 0x16, 0x9b, 0x7c, 0x73, 0xb6, 0xa5, 0xbe, 0x0a, 0xcd, 0x5d, 0xd4,
+	0xf7, 0xc9, 0x25, 0x26, 0x72, 0x28, 0xd7, 0x7a, 0x80, 0x17, 0x64, 0xf8, 0x9e, 0x03, 0x19, 0xba,
+	0xb2, 0xb1, 0x10, 0xe5, 0xe3, 0x13, 0xb8, 0xb7, 0x78, 0x2e, 0xbe, 0xa0, 0x92, 0x28, 0xc9, 0x76,
+	0xad, 0xc9, 0x22, 0xb9, 0x6c, 0xcc, 0xc5, 0xca, 0x6d, 0xd0, 0x3c, 0xfd, 0x02, 0xde, 0x09, 0x23,
+	0x71, 0xab, 0x31, 0xad, 0x90, 0x8d, 0x50, 0x13, 0x16, 0x70, 0x5f, 0x29, 0xe2, 0xdb, 0x78, 0x84,
+	0xf9, 0x22, 0xed, 0xbb, 0x0a, 0x2c, 0x0e, 0x65, 0x4d, 0x32, 0x78, 0xd7, 0x44, 0x79, 0xaa, 0xae,
+	0x5e, 0x94, 0x1a, 0x35, 0xb9, 0xb4, 0x70, 0xe1, 0xfb, 0x1e, 0x5d, 0x51, 0x19, 0xd4, 0x97, 0x3a,
+	0xe4, 0x4b, 0x1f, 0xcb, 0xd0, 0x78, 0x64, 0x87, 0xbb, 0x2d, 0xf2, 0x4c, 0x45, 0x8b, 0xba, 0xa6,
+	0x74, 0x87, 0x7d, 0x1a, 0xc3, 0xb7, 0x30, 0x98, 0xb8, 0xbd, 0xfa, 0xaf, 0x29, 0x30, 0x1b, 0x23,
+	0x6b, 0x12, 0x36, 0xbd, 0x8e, 0x57, 0x7a, 0xb4, 0x22, 0xc6, 0xa9, 0xa5, 0x94, 0x1b, 0xf1, 0xd4,
+	0xa1, 0xc4, 0x66, 0x3f, 0x2a, 0x81, 0xf7, 0x18, 0xf0, 0x84, 0x88, 0xd7, 0x79, 0x78, 0xd2, 0x3d,
+	0xfa, 0x80, 0x77, 0x7c, 0x96, 0xb9, 0xc0, 0xd6, 0x73, 0x09, 0xaa, 0x3e, 0x81, 0x5d, 0xac, 0xc1,
+	0xc2, 0x26, 0x1f, 0x5b, 0xd8, 0x8c, 0xd8, 0xba, 0xd4, 0xa0, 0xdc, 0x63, 0x04, 0x10, 0xa7, 0x42,
+	0x31, 0xa2, 0xb4, 0xfe, 0x35, 0xea, 0x57, 0x0d, 0x71, 0xef, 0xb0, 0x4f, 0x71, 0xce, 0x02, 0x0c,
+	0x2e, 0x92, 0xb1, 0xae, 0x08, 0x10, 0xf5, 0x1d, 0x68, 0xf4, 0x90, 0x8b, 0x69, 0x1a, 0x9c, 0x62,
+	0x15, 0x46, 0x68, 0x91, 0x9c, 0xdf, 0xc6, 0x0c, 0xab, 0x24, 0x3a, 0xf2, 0x5a, 0x80, 0x22, 0xf2,
+	0x7d, 0xcf, 0xe7, 0xd3, 0x12, 0x4b, 0xe9, 0xff, 0xa6, 0x40, 0x55, 0x90, 0xaf, 0xf8, 0x82, 0x46,
+	0x49, 0x2e, 0x68, 0xb2, 0xf4, 0xf0, 0x3c, 0x0c, 0xa6, 0x54, 0xe1, 0x96, 0xbc, 0x70, 0x7b, 0xcf,
+	0x0a, 0xd4, 0x5b, 0x30, 0x4d, 0x95, 0x2d, 0x52, 0x80, 0xc2, 0x88, 0xa5, 0x20, 0x51, 0x40, 0x46,
+	0xa5, 0x51, 0x0f, 0x84, 0x14, 0x0d, 0x63, 0xf5, 0x2c, 0x44, 0x5a, 0x9a, 0x8a, 0x1d, 0x8a, 0xe2,
+	0x6d, 0xc1, 0x9a, 0x58, 0x14, 0x0b, 0x84, 0x83, 0x4c, 0x0b, 0xf9, 0x51, 0xdf, 0xa2, 0x34, 0xb9,
+	0x23, 0x4c, 0xfe, 0xb7, 0xf0, 0x56, 0x13, 0x53, 0x01, 0xa0, 0x20, 0xbc, 0x0b, 0xa5, 0xbe, 0x00,
+	0x33, 0x56, 0x37, 0xf6, 0xd2, 0x0e, 0xdf, 0x7c, 0xb1, 0xba, 0xc2, 0x13, 0x3b, 0x31, 0x82, 0x0a,
+	0x71, 0x82, 0xfe, 0x4b, 0x89, 0xde, 0x1f, 0xf3, 0x91, 0x85, 0xdc, 0xd0, 0x36, 0x9d, 0x27, 0x57,
+	0x58, 0x0d, 0xca, 0xfd, 0x00, 0xf9, 0x82, 0xc6, 0x46, 0x69, 0x9c, 0xd7, 0x33, 0x83, 0xe0, 0x91,
+	0xe7, 0x5b, 0x8c, 0xca, 0x28, 0x3d, 0xe2, 0x2a, 0x24, 0x7d, 0xdb, 0x4a, 0x7e, 0x15, 0xf2, 0x15,
+	0x58, 0xec, 0x7a, 0x96, 0xbd, 0x63, 0xcb, 0x6e, 0x50, 0xe2, 0x62, 0xf3, 0x3c, 0x3b, 0x56, 0x4e,
+	0xff, 0x41, 0x0e, 0x16, 0xef, 0xf7, 0xac, 0x9f, 0x43, 0x9f, 0x97, 0xa0, 0xea, 0x39, 0xd6, 0x66,
+	0xbc, 0xdb, 0x22, 0x08, 0x63, 0xb8, 0xe8, 0x51, 0x84, 0x41, 0x57, 0xdd, 0x22, 0x68, 0xe4, 0x35,
+	0xd1, 0x27, 0xe2, 0x4d, 0x71, 0x14, 0x6f, 0x3a, 0xf8, 0x6e, 0xa6, 0x83, 0x0e, 0x9d, 0x35, 0xfa,
+	0x87, 0xf4, 0x85, 0x3d, 0xdc, 0xcc, 0xfd, 0x00, 0xf9, 0x13, 0xda, 0xb9, 0xd3, 0x50, 0xe1, 0x35,
+	0xf3, 0x1b, 0xbc, 0x03, 0x00, 0x7f, 0x17, 0x50, 0x68, 0xeb, 0x49, 0xf7, 0xb6, 0x3c, 0xa8, 0xde,
+	0xf4, 0x4d, 0x37, 0x7c, 0xd3, 0x0d, 0xed, 0x70, 0x5f, 0x9c, 0xa0, 0x94, 0x71, 0x13, 0x54, 0x4e,
+	0xba, 0x06, 0x38, 0x0b, 0xe0, 0xf5, 0x90, 0x6f, 0x52, 0x3f, 0x9c, 0x7a, 0xf6, 0x02, 0x44, 0xff,
+	0x12, 0x80, 0xe1, 0x39, 0x88, 0xb5, 0xa7, 0x42, 0x41, 0x68, 0x8c, 0xfc, 0x57, 0x5f, 0x85, 0x62,
+	0x07, 0x93, 0x34, 0x7a, 0xc2, 0x16, 0xa8, 0x36, 0x18, 0xbe, 0xfe, 0x18, 0x66, 0xb6, 0xcc, 0x87,
+	0x08, 0xd7, 0xff, 0xe4, 0x63, 0x7c, 0x15, 0xc7, 0x3d, 0x38, 0x3c, 0xf6, 0x2f, 0xe5, 0xfd, 0x9c,
+	0xa8, 0x07, 0x06, 0x41, 0xd6, 0xbf, 0x0c, 0x33, 0x38, 0xc2, 0x68, 0xb2, 0x96, 0x89, 0x5f, 0xed,
+	0x20, 0x91, 0xbb, 0x65, 0x0c, 0x20, 0x13, 0xff, 0x3a, 0x34, 0xf0, 0x90, 0xe3, 0x16, 0x26, 0x18,
+	0xee, 0x5f, 0x82, 0x93, 0x42, 0x2d, 0x13, 0x5e, 0x15, 0xc2, 0xb4, 0x8d, 0x79, 0x67, 0x68, 0xc0,
+	0x27, 0x8a, 0x4d, 0x0e, 0x2b, 0xf0, 0x18, 0x61, 0xb1, 0x9d, 0xac, 0x2f, 0x23, 0xed, 0xd4, 0x19,
+	0x80, 0x88, 0x95, 0x5c, 0x0a, 0x2b, 0x9c, 0x97, 0x81, 0x7e, 0x07, 0x66, 0x22, 0x02, 0x98, 0x24,
+	0x8a, 0xb5, 0x29, 0x23, 0x6b, 0xcb, 0x25, 0x6b, 0x63, 0xda, 0x38, 0x79, 0x97, 0xf0, 0x2a, 0x61,
+	0x3e, 0x51, 0xd5, 0x24, 0x63, 0xb4, 0x06, 0x80, 0xfb, 0xd0, 0x12, 0x07, 0x4a, 0x7e, 0x2d, 0x20,
+	0xc1, 0x0d, 0x6a, 0x6b, 0x08, 0x40, 0x6f, 0xc3, 0x2c, 0x7b, 0x20, 0x7a, 0x73, 0xe3, 0x36, 0xda,
+	0x3f, 0x1c, 0xe3, 0x69, 0xc1, 0x5c, 0xbc, 0x91, 0x09, 0x77, 0x4d, 0xcd, 0x9e, 0x8d, 0x6f, 0x52,
+	0x70, 0x2f, 0xdb, 0xec, 0xd9, 0xb7, 0xd1, 0x3e, 0x7e, 0x57, 0xd6, 0x40, 0x0f, 0xbd, 0xbd, 0x89,
+	0xbb, 0x92, 0xd6, 0xc2, 0x8a, 0x0b, 0x33, 0x89, 0x87, 0xc3, 0xd4, 0x79, 0x38, 0x39, 0x00, 0xdd,
+	0x77, 0xf1, 0x75, 0x19, 0xb7, 0x71, 0x22, 0x0e, 0x36, 0xfa, 0xae, 0x6b, 0xbb, 0x9d, 0x86, 0xa2,
+	0x2e, 0xc2, 0xec, 0x00, 0xbc, 0xc6, 0xb7, 0xe7, 0x1a, 0x39, 0x75, 0x0e, 0x1a, 0x83, 0x8c, 0x1b,
+	0xa6, 0xed, 0x20, 0xab, 0x91, 0x5f, 0x39, 0x07, 0x65, 0xfe, 0xa0, 0x84, 0x5a, 0x82, 0xfc, 0x35,
+	0xc7, 0x69, 0x9c, 0x50, 0x6b, 0x50, 0xde, 0x60, 0xaf, 0x26, 0x34, 0x94, 0x95, 0xff, 0x0f, 0x33,
+	0x89, 0x4b, 0x1f, 0x6a, 0x19, 0x0a, 0xf7, 0x3c, 0x17, 0x35, 0x4e, 0xa8, 0x0d, 0xa8, 0x5d, 0xb7,
+	0x5d, 0xd3, 0xdf, 0xa7, 0x01, 0x24, 0x0d, 0x4b, 0x9d, 0x81, 0x2a, 0x09, 0xa4, 0x60, 0x00, 0xb4,
+	0x72, 0x03, 0xaa, 0x42, 0x20, 0x19, 0x2e, 0x81, 0x7f, 0xaf, 0xef, 0xdf, 0xb0, 0x9d, 0x10, 0xf9,
+	0x8d, 0x13, 0xb8, 0x04, 0x85, 0x90, 0x05, 0x73, 0x43, 0xc1, 0xa4, 0x52, 0xc0, 0xf5, 0x28, 0xd0,
+	0xab, 0x91, 0x5b, 0x69, 0xc1, 0x49, 0xf1, 0xb8, 0x81, 0x32, 0x67, 0x11, 0x66, 0x45, 0xe0, 0x80,
+	0x3d, 0x4d, 0x98, 0x13, 0x33, 0xd6, 0x7d, 0xd3, 0x1e, 0x70, 0x68, 0x28, 0x07, 0x73, 0x68, 0xf5,
+	0xb7, 0x3e, 0x07, 0xf5, 0xbb, 0x64, 0xe8, 0xb6, 0x90, 0xff, 0xd0, 0x6e, 0x23, 0xb5, 0x05, 0x8d,
+	0xe4, 0x53, 0xb4, 0xea, 0xa7, 0xe4, 0xbb, 0x7f, 0xf2, 0x17, 0x6b, 0xb5, 0x51, 0xf2, 0xa6, 0x9f,
+	0x50, 0xdf, 0x87, 0xe9, 0xf8, 0x83, 0xae, 0xaa, 0x3c, 0x24, 0x41, 0xfa, 0xea, 0xeb, 0xb8, 0xca,
+	0x5b, 0x50, 0x8f, 0xbd, 0xcf, 0xaa, 0x5e, 0x90, 0xd6, 0x2d, 0x7b, 0xc3, 0x55, 0x93, 0xfb, 0xf8,
+	0xe2, 0x1b, 0xaa, 0x94, 0xfa, 0xf8, 0x23, 0x8a, 0x29, 0xd4, 0x4b, 0x5f, 0x5a, 0x1c, 0x47, 0xbd,
+	0x19, 0xc9, 0xb7, 0x50, 0xff, 0xa5, 0x51, 0xaf, 0xd1, 0x1d, 0xb8, 0x89, 0x3d, 0x98, 0x8e, 0x3f,
+	0x9c, 0x97, 0x42, 0xbf, 0xf4, 0x09, 0x43, 0xed, 0x62, 0x26, 0xdc, 0x88, 0x59, 0x8f, 0x40, 0x1d,
+	0x7e, 0xf4, 0x54, 0xbd, 0x2c, 0x1f, 0xee, 0xb4, 0x27, 0x5f, 0xb5, 0x2b, 0x99, 0xf1, 0xa3, 0x86,
+	0x7f, 0x55, 0x61, 0x67, 0x99, 0xc3, 0x6f, 0xe0, 0xa9, 0x57, 0xd3, 0xfa, 0x30, 0xe2, 0x21, 0x3f,
+	0xed, 0xe5, 0x83, 0x15, 0x8a, 0x08, 0x71, 0x61, 0x26, 0xf1, 0x2c, 0x9c, 0x7a, 0x31, 0xf5, 0x89,
+	0x9b, 0xe1, 0xf7, 0xf1, 0xb4, 0x4f, 0x65, 0x43, 0x8e, 0xda, 0xc3, 0x57, 0x35, 0xe2, 0x6f, 0xa9,
+	0xa5, 0xb4, 0x27, 0x7f, 0x71, 0x6d, 0x9c, 0xf4, 0xbc, 0x07, 0xf5, 0xd8, 0xa3, 0x67, 0x29, 0xea,
+	0x25, 0x7b, 0x18, 0x6d, 0x5c, 0xd5, 0x1f, 0x40, 0x4d, 0x7c, 0x9b, 0x4c, 0x5d, 0x4e, 0x53, 0xdc,
+	0xa1, 0x8a, 0x0f, 0xa2, 0xb7, 0x51, 0xe1, 0x60, 0x84, 0xde, 0x0e, 0xbd, 0xb2, 0x94, 0x5d, 0x6f,
+	0x85, 0xfa, 0x47, 0xea, 0xed, 0x81, 0x9b, 0xf8, 0x2a, 0x7d, 0xf1, 0x52, 0xf2, 0x24, 0x95, 0xba,
+	0x9a, 0x26, 0x9b, 0xe9, 0x8f, 0x6f, 0x69, 0x57, 0x0f, 0x54, 0x26, 0xe2, 0xe2, 0x1e, 0x4c, 0xc7,
+	0x1f, 0x5e, 0x4a, 0xe1, 0xa2, 0xf4, 0xad, 0x2a, 0xed, 0x62, 0x26, 0xdc, 0xa8, 0xb1, 0xfb, 0x50,
+	0x15, 0xbe, 0xb2, 0xa1, 0xbe, 0x38, 0x42, 0x8e, 0xc5, 0x4f, 0x4e, 0x8c, 0xe3, 0xe4, 0x5b, 0x50,
+	0x89, 0x3e, 0x8e, 0xa1, 0x3e, 0x9f, 0x2a, 0xbf, 0x07, 0xa9, 0x72, 0x0b, 0x60, 0xf0, 0xe5, 0x0b,
+	0x55, 0x1e, 0xc3, 0x30, 0xf4, 0x69, 0x8c, 0xf1, 0x53, 0x59, 0x23, 0xf9, 0xb9, 0x8a, 0x94, 0x89,
+	0x38, 0xe5, 0xab, 0x16, 0xe3, 0x1a, 0x68, 0x83, 0x3a, 0xfc, 0xd1, 0x89, 0x14, 0xeb, 0x9c, 0xfa,
+	0x75, 0x8a, 0xf1, 0x6a, 0x3d, 0x93, 0xf8, 0x1e, 0x44, 0x8a, 0x41, 0x92, 0x7f, 0x35, 0x22, 0x83,
+	0x33, 0x11, 0xff, 0x38, 0x43, 0x8a, 0x40, 0x4a, 0xbf, 0xe0, 0x30, 0xae, 0xf2, 0x77, 0xa1, 0x26,
+	0x7e, 0x52, 0x21, 0xc5, 0x24, 0x49, 0xbe, 0xba, 0x90, 0xc1, 0x8c, 0xc6, 0x3e, 0xa4, 0x90, 0x62,
+	0x46, 0x65, 0x1f, 0x5b, 0x18, 0x57, 0xf5, 0x2e, 0xd4, 0x63, 0xdf, 0x2c, 0x48, 0xa9, 0x5a, 0xf6,
+	0x85, 0x04, 0x6d, 0x25, 0x0b, 0xea, 0xb0, 0x7a, 0xd2, 0xbb, 0xee, 0xa3, 0xd4, 0x53, 0x7c, 0xc2,
+	0x22, 0x43, 0x07, 0x62, 0xcf, 0xfa, 0xa4, 0x4d, 0x31, 0x92, 0xd7, 0x96, 0xb4, 0x95, 0x2c, 0xa8,
+	0x51, 0x07, 0x76, 0xa1, 0x1e, 0x7b, 0x06, 0x24, 0xa5, 0x25, 0xd9, 0xab, 0x27, 0xda, 0x4a, 0x16,
+	0xd4, 0xa8, 0xa5, 0xaf, 0x08, 0x2f, 0x8e, 0xc4, 0xde, 0xcc, 0x51, 0x5f, 0x1a, 0x59, 0x8f, 0xec,
+	0xc9, 0x20, 0x6d, 0xf5, 0x20, 0x45, 0x22, 0x12, 0x98, 0xd5, 0xa3, 0x2c, 0x4d, 0xb7, 0x7a, 0x07,
+	0x19, 0xa9, 0x07, 0xd0, 0x48, 0xbe, 0x4d, 0x93, 0xb6, 0x52, 0x90, 0x3f, 0xcb, 0xa3, 0x5d, 0xca,
+	0x88, 0x1d, 0xf5, 0x62, 0x0b, 0x8a, 0xf4, 0x2d, 0x11, 0x55, 0x4f, 0x79, 0x93, 0x49, 0x78, 0x42,
+	0x43, 0x3b, 0x2f, 0xc5, 0x89, 0x3f, 0x20, 0x41, 0x2b, 0xa5, 0xbb, 0x9d, 0x29, 0x95, 0xc6, 0x9e,
+	0x48, 0x38, 0x40, 0xa5, 0xf4, 0x3d, 0x8f, 0x94, 0x4a, 0x63, 0x8f, 0x7d, 0x64, 0xad, 0xd4, 0x80,
+	0x22, 0xbb, 0x57, 0xa3, 0xa7, 0x1c, 0xba, 0x08, 0xef, 0x02, 0x68, 0xa3, 0x71, 0x70, 0x95, 0x78,
+	0x14, 0x37, 0x61, 0x8a, 0xc4, 0x47, 0xa8, 0xe7, 0x46, 0xdd, 0x12, 0x1e, 0x55, 0x63, 0xec, 0x22,
+	0xb1, 0x7e, 0x42, 0xfd, 0x22, 0x4c, 0x91, 0xe3, 0xe3, 0x94, 0x1a, 0xc5, 0xab, 0xbe, 0xda, 0x48,
+	0x14, 0x4e, 0xe2, 0x03, 0x68, 0xf0, 0x58, 0x5d, 0x7e, 0x1d, 0x31, 0x75, 0x26, 0x94, 0x5e, 0x05,
+	0xd5, 0x2e, 0x65, 0xc4, 0x8e, 0xfa, 0xc0, 0x4c, 0x7f, 0xd4, 0x5c, 0xba, 0xe9, 0x4f, 0x36, 0x35,
+	0x46, 0x69, 0xde, 0x81, 0x12, 0xbb, 0x8e, 0xa4, 0x9e, 0x1f, 0x75, 0x59, 0x89, 0x57, 0xf7, 0xdc,
+	0x68, 0xa4, 0x88, 0x60, 0x1c, 0x6f, 0x29, 0x5c, 0x3d, 0x49, 0x21, 0x58, 0x72, 0x39, 0x47, 0xcb,
+	0x82, 0xc9, 0x47, 0x82, 0x9a, 0xcc, 0x41, 0x3c, 0x4d, 0xba, 0xc9, 0x1c, 0x8a, 0xd5, 0xd1, 0x56,
+	0xb2, 0xa0, 0x46, 0xfd, 0xf9, 0x86, 0x02, 0xcd, 0xb4, 0xfb, 0x10, 0x6a, 0xea, 0x6a, 0x6c, 0xd4,
+	0xa5, 0x0e, 0xed, 0x33, 0x07, 0x2c, 0x15, 0xd1, 0xf2, 0x11, 0x39, 0x5b, 0x1f, 0xba, 0x01, 0x71,
+	0x25, 0xad, 0xbe, 0x94, 0x78, 0x7f, 0xed, 0xd3, 0xd9, 0x0b, 0x44, 0x6d, 0x6f, 0x43, 0x55, 0x38,
+	0xd7, 0x4f, 0x99, 0x65, 0x87, 0x03, 0x12, 0xb4, 0xe5, 0xf1, 0x88, 0xe2, 0x32, 0x7d, 0xf8, 0xc8,
+	0x39, 0xc5, 0x11, 0x4c, 0x3d, 0xd9, 0xd7, 0xae, 0x64, 0xc6, 0x8f, 0x1a, 0xde, 0x84, 0x29, 0x12,
+	0xc9, 0x9f, 0x62, 0x29, 0xc4, 0x8b, 0x01, 0x9a, 0x3e, 0x0a, 0x25, 0xaa, 0x11, 0x41, 0x4d, 0x0c,
+	0xeb, 0x4f, 0x51, 0x03, 0xc9, 0x8d, 0x00, 0xed, 0x42, 0x06, 0xcc, 0xa8, 0x99, 0x16, 0xc0, 0x20,
+	0xac, 0x3e, 0xc5, 0xe1, 0x1f, 0x8a, 0xec, 0xd7, 0x5e, 0x1c, 0x8b, 0x27, 0x3a, 0x57, 0x42, 0xa0,
+	0x7c, 0xca, 0xb0, 0x0f, 0x87, 0xd2, 0x8f, 0xb3, 0x3e, 0xdb, 0x00, 0x83, 0x10, 0x75, 0x75, 0x54,
+	0xb0, 0xb5, 0x10, 0x62, 0xae, 0xad, 0x8c, 0xc0, 0x4b, 0xc4, 0x5d, 0xeb, 0x27, 0xd4, 0x1d, 0xa8,
+	0x89, 0x71, 0xea, 0x29, 0x43, 0x20, 0x09, 0x65, 0x3f, 0x60, 0x3b, 0x2e, 0x34, 0x92, 0xe1, 0xea,
+	0x29, 0xb3, 0x42, 0x4a, 0x54, 0xfb, 0x01, 0xdb, 0x7b, 0x07, 0x4a, 0x7c, 0x38, 0xce, 0x8f, 0x0a,
+	0x1d, 0x1e, 0x6d, 0xb9, 0x13, 0x21, 0xce, 0x91, 0xf6, 0x25, 0x62, 0x31, 0xd3, 0xb5, 0x4f, 0x1e,
+	0xb3, 0xab, 0x5d, 0xc9, 0x8c, 0x1f, 0x35, 0xfc, 0x00, 0x1a, 0xc9, 0xc0, 0xe3, 0x14, 0x06, 0xa6,
+	0x84, 0x3f, 0x6b, 0x97, 0x32, 0x62, 0x8b, 0x8e, 0xf0, 0xa9, 0x61, 0x9a, 0xde, 0xb5, 0xc3, 0x5d,
+	0x12, 0xf3, 0x9a, 0xa5, 0xd7, 0x62, 0x78, 0xad, 0x76, 0x25, 0x33, 0x7e, 0xcc, 0x85, 0x24, 0xe1,
+	0x5d, 0x69, 0x2e, 0xa4, 0x18, 0xb0, 0xa6, 0x9d, 0x1f, 0x89, 0x23, 0xee, 0x8b, 0xc4, 0xc3, 0xc6,
+	0xd2, 0x77, 0x55, 0x87, 0xe3, 0x16, 0xb5, 0x83, 0xc4, 0xa1, 0xd1, 0x3d, 0xc5, 0x44, 0x54, 0x5c,
+	0xca, 0x92, 0x5a, 0x1e, 0x56, 0xa7, 0x7d, 0x2a, 0x1b, 0x72, 0xd4, 0x5e, 0x07, 0x6a, 0xcc, 0x25,
+	0xa7, 0x7c, 0x5b, 0x1e, 0xe5, 0xb5, 0xc7, 0xb8, 0x77, 0xc0, 0x8e, 0x0d, 0x8e, 0x1e, 0xa2, 0xb0,
+	0x83, 0xd1, 0x47, 0x0f, 0xc9, 0xe8, 0x84, 0x0c, 0x5b, 0x2a, 0xc9, 0x90, 0x8f, 0x94, 0x06, 0x52,
+	0x22, 0x43, 0x32, 0x34, 0x90, 0x0c, 0x9c, 0x48, 0x69, 0x20, 0x25, 0xbe, 0x22, 0xe3, 0xf2, 0x3e,
+	0x0a, 0x62, 0x18, 0xb1, 0xbc, 0x4f, 0x06, 0x3a, 0x68, 0x2b, 0x59, 0x50, 0x05, 0x2f, 0xbe, 0xcc,
+	0xe3, 0x02, 0x54, 0xb9, 0x29, 0x4b, 0x84, 0x0d, 0x8c, 0x23, 0xfd, 0x8b, 0x50, 0xe6, 0xc7, 0xfd,
+	0x29, 0x15, 0x26, 0xa2, 0x01, 0xc6, 0x55, 0xf8, 0x8b, 0x50, 0x89, 0xce, 0xe5, 0x53, 0x96, 0xb4,
+	0xc9, 0xd3, 0x7f, 0xed, 0x85, 0x71, 0x68, 0x51, 0xff, 0xdf, 0x83, 0x7a, 0xec, 0xcc, 0x3d, 0x85,
+	0xd3, 0xb2, 0x73, 0xf9, 0x8c, 0x83, 0x38, 0xae, 0x6a, 0xd9, 0xf9, 0xb8, 0xb6, 0x92, 0x05, 0x55,
+	0x74, 0x87, 0xc4, 0x23, 0xe2, 0x34, 0xd5, 0x1d, 0x3e, 0xaa, 0xd6, 0x2e, 0x64, 0xc0, 0x14, 0x57,
+	0x4b, 0xe2, 0x19, 0x71, 0xaa, 0xd7, 0x35, 0x74, 0x8c, 0x3c, 0x86, 0x53, 0xab, 0x7d, 0xa8, 0x6d,
+	0xfa, 0xde, 0xe3, 0x7d, 0x7e, 0x38, 0xf9, 0xf3, 0x71, 0xef, 0xae, 0xbf, 0x0b, 0xd3, 0x76, 0x84,
+	0xd3, 0xf1, 0x7b, 0xed, 0xeb, 0x55, 0x7a, 0x48, 0xba, 0x89, 0x0b, 0x6f, 0x2a, 0x5f, 0xba, 0xda,
+	0xb1, 0xc3, 0xdd, 0xfe, 0x36, 0xa6, 0xf7, 0x0a, 0x45, 0xbb, 0x64, 0x7b, 0xec, 0xdf, 0x15, 0xdb,
+	0x0d, 0x91, 0xef, 0x9a, 0xce, 0x15, 0xd2, 0x14, 0x83, 0xf6, 0xb6, 0x7f, 0x57, 0x51, 0xb6, 0x8b,
+	0x04, 0x74, 0xf5, 0x7f, 0x07, 0x00, 0x3d, 0x93, 0xbf, 0x56, 0x81, 0x7a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6248,6 +9231,7 @@ type MilvusServiceClient interface {
 	HasCollection(ctx context.Context, in *HasCollectionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
 	LoadCollection(ctx context.Context, in *LoadCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetReleaseJobs(ctx context.Context, in *GetReleaseJobsRequest, opts ...grpc.CallOption) (*GetReleaseJobsResponse, error)
 	DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error)
 	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
 	ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error)
@@ -6261,27 +9245,44 @@ type MilvusServiceClient interface {
 	CreateAlias(ctx context.Context, in *CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropAlias(ctx context.Context, in *DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AlterAlias(ctx context.Context, in *AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AddCollectionField(ctx context.Context, in *AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AlterCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AlterDatabase(ctx context.Context, in *AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error)
 	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
 	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
 	GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error)
 	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CancelIndexBuild(ctx context.Context, in *CancelIndexBuildRequest, opts ...grpc.CallOption) (*CancelIndexBuildResponse, error)
 	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error)
+	Upsert(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
 	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error)
+	RegisterTemplate(ctx context.Context, in *RegisterTemplateRequest, opts ...grpc.CallOption) (*RegisterTemplateResponse, error)
+	DropTemplate(ctx context.Context, in *DropTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Explain(ctx context.Context, in *ExplainRequest, opts ...grpc.CallOption) (*ExplainResponse, error)
 	CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error)
 	GetFlushState(ctx context.Context, in *GetFlushStateRequest, opts ...grpc.CallOption) (*GetFlushStateResponse, error)
 	GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error)
 	GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error)
 	GetReplicas(ctx context.Context, in *GetReplicasRequest, opts ...grpc.CallOption) (*GetReplicasResponse, error)
+	GetLoadingProgress(ctx context.Context, in *GetLoadingProgressRequest, opts ...grpc.CallOption) (*GetLoadingProgressResponse, error)
 	Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error)
 	// TODO: remove
 	RegisterLink(ctx context.Context, in *RegisterLinkRequest, opts ...grpc.CallOption) (*RegisterLinkResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error)
 	LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CordonNode(ctx context.Context, in *CordonNodeRequest, opts ...grpc.CallOption) (*CordonedNodesResponse, error)
+	UncordonNode(ctx context.Context, in *UncordonNodeRequest, opts ...grpc.CallOption) (*CordonedNodesResponse, error)
+	GetCordonedNodes(ctx context.Context, in *GetCordonedNodesRequest, opts ...grpc.CallOption) (*CordonedNodesResponse, error)
+	Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
 	GetCompactionState(ctx context.Context, in *GetCompactionStateRequest, opts ...grpc.CallOption) (*GetCompactionStateResponse, error)
 	ManualCompaction(ctx context.Context, in *ManualCompactionRequest, opts ...grpc.CallOption) (*ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(ctx context.Context, in *GetCompactionPlansRequest, opts ...grpc.CallOption) (*GetCompactionPlansResponse, error)
@@ -6289,11 +9290,19 @@ type MilvusServiceClient interface {
 	Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error)
 	GetImportState(ctx context.Context, in *GetImportStateRequest, opts ...grpc.CallOption) (*GetImportStateResponse, error)
 	ListImportTasks(ctx context.Context, in *ListImportTasksRequest, opts ...grpc.CallOption) (*ListImportTasksResponse, error)
+	CancelImport(ctx context.Context, in *CancelImportRequest, opts ...grpc.CallOption) (*GetImportStateResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+27+--+Support+Basic+Authentication
 	CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ListCredUsers(ctx context.Context, in *ListCredUsersRequest, opts ...grpc.CallOption) (*ListCredUsersResponse, error)
+	SaveRole(ctx context.Context, in *SaveRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
+	SaveUserRoles(ctx context.Context, in *SaveUserRolesRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListUserRoles(ctx context.Context, in *ListUserRolesRequest, opts ...grpc.CallOption) (*ListUserRolesResponse, error)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type milvusServiceClient struct {
@@ -6349,6 +9358,15 @@ func (c *milvusServiceClient) ReleaseCollection(ctx context.Context, in *Release
 	return out, nil
 }
 
+func (c *milvusServiceClient) GetReleaseJobs(ctx context.Context, in *GetReleaseJobsRequest, opts ...grpc.CallOption) (*GetReleaseJobsResponse, error) {
+	out := new(GetReleaseJobsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetReleaseJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error) {
 	out := new(DescribeCollectionResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DescribeCollection", in, out, opts...)
@@ -6466,6 +9484,69 @@ func (c *milvusServiceClient) AlterAlias(ctx context.Context, in *AlterAliasRequ
 	return out, nil
 }
 
+func (c *milvusServiceClient) RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RenameCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) AddCollectionField(ctx context.Context, in *AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AddCollectionField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) AlterCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AlterCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) AlterDatabase(ctx context.Context, in *AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AlterDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error) {
+	out := new(ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateIndex", in, out, opts...)
@@ -6511,6 +9592,15 @@ func (c *milvusServiceClient) DropIndex(ctx context.Context, in *DropIndexReques
 	return out, nil
 }
 
+func (c *milvusServiceClient) CancelIndexBuild(ctx context.Context, in *CancelIndexBuildRequest, opts ...grpc.CallOption) (*CancelIndexBuildResponse, error) {
+	out := new(CancelIndexBuildResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CancelIndexBuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error) {
 	out := new(MutationResult)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Insert", in, out, opts...)
@@ -6529,6 +9619,15 @@ func (c *milvusServiceClient) Delete(ctx context.Context, in *DeleteRequest, opt
 	return out, nil
 }
 
+func (c *milvusServiceClient) Upsert(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
 	out := new(SearchResults)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Search", in, out, opts...)
@@ -6556,6 +9655,33 @@ func (c *milvusServiceClient) Query(ctx context.Context, in *QueryRequest, opts
 	return out, nil
 }
 
+func (c *milvusServiceClient) RegisterTemplate(ctx context.Context, in *RegisterTemplateRequest, opts ...grpc.CallOption) (*RegisterTemplateResponse, error) {
+	out := new(RegisterTemplateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RegisterTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropTemplate(ctx context.Context, in *DropTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Explain(ctx context.Context, in *ExplainRequest, opts ...grpc.CallOption) (*ExplainResponse, error) {
+	out := new(ExplainResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Explain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error) {
 	out := new(CalcDistanceResults)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CalcDistance", in, out, opts...)
@@ -6601,6 +9727,15 @@ func (c *milvusServiceClient) GetReplicas(ctx context.Context, in *GetReplicasRe
 	return out, nil
 }
 
+func (c *milvusServiceClient) GetLoadingProgress(ctx context.Context, in *GetLoadingProgressRequest, opts ...grpc.CallOption) (*GetLoadingProgressResponse, error) {
+	out := new(GetLoadingProgressResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetLoadingProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error) {
 	out := new(DummyResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Dummy", in, out, opts...)
@@ -6628,99 +9763,207 @@ func (c *milvusServiceClient) GetMetrics(ctx context.Context, in *GetMetricsRequ
 	return out, nil
 }
 
-func (c *milvusServiceClient) LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+func (c *milvusServiceClient) LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/LoadBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CordonNode(ctx context.Context, in *CordonNodeRequest, opts ...grpc.CallOption) (*CordonedNodesResponse, error) {
+	out := new(CordonedNodesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CordonNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) UncordonNode(ctx context.Context, in *UncordonNodeRequest, opts ...grpc.CallOption) (*CordonedNodesResponse, error) {
+	out := new(CordonedNodesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/UncordonNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetCordonedNodes(ctx context.Context, in *GetCordonedNodesRequest, opts ...grpc.CallOption) (*CordonedNodesResponse, error) {
+	out := new(CordonedNodesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCordonedNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error) {
+	out := new(BalanceResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Balance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetCompactionState(ctx context.Context, in *GetCompactionStateRequest, opts ...grpc.CallOption) (*GetCompactionStateResponse, error) {
+	out := new(GetCompactionStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCompactionState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ManualCompaction(ctx context.Context, in *ManualCompactionRequest, opts ...grpc.CallOption) (*ManualCompactionResponse, error) {
+	out := new(ManualCompactionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ManualCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetCompactionStateWithPlans(ctx context.Context, in *GetCompactionPlansRequest, opts ...grpc.CallOption) (*GetCompactionPlansResponse, error) {
+	out := new(GetCompactionPlansResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCompactionStateWithPlans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
+	out := new(ImportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetImportState(ctx context.Context, in *GetImportStateRequest, opts ...grpc.CallOption) (*GetImportStateResponse, error) {
+	out := new(GetImportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetImportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListImportTasks(ctx context.Context, in *ListImportTasksRequest, opts ...grpc.CallOption) (*ListImportTasksResponse, error) {
+	out := new(ListImportTasksResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListImportTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CancelImport(ctx context.Context, in *CancelImportRequest, opts ...grpc.CallOption) (*GetImportStateResponse, error) {
+	out := new(GetImportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CancelImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/LoadBalance", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateCredential", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) GetCompactionState(ctx context.Context, in *GetCompactionStateRequest, opts ...grpc.CallOption) (*GetCompactionStateResponse, error) {
-	out := new(GetCompactionStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCompactionState", in, out, opts...)
+func (c *milvusServiceClient) UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/UpdateCredential", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) ManualCompaction(ctx context.Context, in *ManualCompactionRequest, opts ...grpc.CallOption) (*ManualCompactionResponse, error) {
-	out := new(ManualCompactionResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ManualCompaction", in, out, opts...)
+func (c *milvusServiceClient) DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DeleteCredential", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) GetCompactionStateWithPlans(ctx context.Context, in *GetCompactionPlansRequest, opts ...grpc.CallOption) (*GetCompactionPlansResponse, error) {
-	out := new(GetCompactionPlansResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCompactionStateWithPlans", in, out, opts...)
+func (c *milvusServiceClient) ListCredUsers(ctx context.Context, in *ListCredUsersRequest, opts ...grpc.CallOption) (*ListCredUsersResponse, error) {
+	out := new(ListCredUsersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListCredUsers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
-	out := new(ImportResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Import", in, out, opts...)
+func (c *milvusServiceClient) SaveRole(ctx context.Context, in *SaveRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/SaveRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) GetImportState(ctx context.Context, in *GetImportStateRequest, opts ...grpc.CallOption) (*GetImportStateResponse, error) {
-	out := new(GetImportStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetImportState", in, out, opts...)
+func (c *milvusServiceClient) DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) ListImportTasks(ctx context.Context, in *ListImportTasksRequest, opts ...grpc.CallOption) (*ListImportTasksResponse, error) {
-	out := new(ListImportTasksResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListImportTasks", in, out, opts...)
+func (c *milvusServiceClient) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error) {
+	out := new(ListRolesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListRoles", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+func (c *milvusServiceClient) SaveUserRoles(ctx context.Context, in *SaveUserRolesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateCredential", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/SaveUserRoles", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/UpdateCredential", in, out, opts...)
+func (c *milvusServiceClient) ListUserRoles(ctx context.Context, in *ListUserRolesRequest, opts ...grpc.CallOption) (*ListUserRolesResponse, error) {
+	out := new(ListUserRolesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListUserRoles", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DeleteCredential", in, out, opts...)
+func (c *milvusServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateAPIKey", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) ListCredUsers(ctx context.Context, in *ListCredUsersRequest, opts ...grpc.CallOption) (*ListCredUsersResponse, error) {
-	out := new(ListCredUsersResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListCredUsers", in, out, opts...)
+func (c *milvusServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RevokeAPIKey", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6734,6 +9977,7 @@ type MilvusServiceServer interface {
 	HasCollection(context.Context, *HasCollectionRequest) (*BoolResponse, error)
 	LoadCollection(context.Context, *LoadCollectionRequest) (*commonpb.Status, error)
 	ReleaseCollection(context.Context, *ReleaseCollectionRequest) (*commonpb.Status, error)
+	GetReleaseJobs(context.Context, *GetReleaseJobsRequest) (*GetReleaseJobsResponse, error)
 	DescribeCollection(context.Context, *DescribeCollectionRequest) (*DescribeCollectionResponse, error)
 	GetCollectionStatistics(context.Context, *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error)
 	ShowCollections(context.Context, *ShowCollectionsRequest) (*ShowCollectionsResponse, error)
@@ -6747,27 +9991,44 @@ type MilvusServiceServer interface {
 	CreateAlias(context.Context, *CreateAliasRequest) (*commonpb.Status, error)
 	DropAlias(context.Context, *DropAliasRequest) (*commonpb.Status, error)
 	AlterAlias(context.Context, *AlterAliasRequest) (*commonpb.Status, error)
+	RenameCollection(context.Context, *RenameCollectionRequest) (*commonpb.Status, error)
+	AddCollectionField(context.Context, *AddCollectionFieldRequest) (*commonpb.Status, error)
+	AlterCollection(context.Context, *AlterCollectionRequest) (*commonpb.Status, error)
+	CreateDatabase(context.Context, *CreateDatabaseRequest) (*commonpb.Status, error)
+	DropDatabase(context.Context, *DropDatabaseRequest) (*commonpb.Status, error)
+	AlterDatabase(context.Context, *AlterDatabaseRequest) (*commonpb.Status, error)
+	ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error)
 	CreateIndex(context.Context, *CreateIndexRequest) (*commonpb.Status, error)
 	DescribeIndex(context.Context, *DescribeIndexRequest) (*DescribeIndexResponse, error)
 	GetIndexState(context.Context, *GetIndexStateRequest) (*GetIndexStateResponse, error)
 	GetIndexBuildProgress(context.Context, *GetIndexBuildProgressRequest) (*GetIndexBuildProgressResponse, error)
 	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
+	CancelIndexBuild(context.Context, *CancelIndexBuildRequest) (*CancelIndexBuildResponse, error)
 	Insert(context.Context, *InsertRequest) (*MutationResult, error)
 	Delete(context.Context, *DeleteRequest) (*MutationResult, error)
+	Upsert(context.Context, *UpsertRequest) (*MutationResult, error)
 	Search(context.Context, *SearchRequest) (*SearchResults, error)
 	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
 	Query(context.Context, *QueryRequest) (*QueryResults, error)
+	RegisterTemplate(context.Context, *RegisterTemplateRequest) (*RegisterTemplateResponse, error)
+	DropTemplate(context.Context, *DropTemplateRequest) (*commonpb.Status, error)
+	Explain(context.Context, *ExplainRequest) (*ExplainResponse, error)
 	CalcDistance(context.Context, *CalcDistanceRequest) (*CalcDistanceResults, error)
 	GetFlushState(context.Context, *GetFlushStateRequest) (*GetFlushStateResponse, error)
 	GetPersistentSegmentInfo(context.Context, *GetPersistentSegmentInfoRequest) (*GetPersistentSegmentInfoResponse, error)
 	GetQuerySegmentInfo(context.Context, *GetQuerySegmentInfoRequest) (*GetQuerySegmentInfoResponse, error)
 	GetReplicas(context.Context, *GetReplicasRequest) (*GetReplicasResponse, error)
+	GetLoadingProgress(context.Context, *GetLoadingProgressRequest) (*GetLoadingProgressResponse, error)
 	Dummy(context.Context, *DummyRequest) (*DummyResponse, error)
 	// TODO: remove
 	RegisterLink(context.Context, *RegisterLinkRequest) (*RegisterLinkResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *GetMetricsRequest) (*GetMetricsResponse, error)
 	LoadBalance(context.Context, *LoadBalanceRequest) (*commonpb.Status, error)
+	CordonNode(context.Context, *CordonNodeRequest) (*CordonedNodesResponse, error)
+	UncordonNode(context.Context, *UncordonNodeRequest) (*CordonedNodesResponse, error)
+	GetCordonedNodes(context.Context, *GetCordonedNodesRequest) (*CordonedNodesResponse, error)
+	Balance(context.Context, *BalanceRequest) (*BalanceResponse, error)
 	GetCompactionState(context.Context, *GetCompactionStateRequest) (*GetCompactionStateResponse, error)
 	ManualCompaction(context.Context, *ManualCompactionRequest) (*ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(context.Context, *GetCompactionPlansRequest) (*GetCompactionPlansResponse, error)
@@ -6775,11 +10036,19 @@ type MilvusServiceServer interface {
 	Import(context.Context, *ImportRequest) (*ImportResponse, error)
 	GetImportState(context.Context, *GetImportStateRequest) (*GetImportStateResponse, error)
 	ListImportTasks(context.Context, *ListImportTasksRequest) (*ListImportTasksResponse, error)
+	CancelImport(context.Context, *CancelImportRequest) (*GetImportStateResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+27+--+Support+Basic+Authentication
 	CreateCredential(context.Context, *CreateCredentialRequest) (*commonpb.Status, error)
 	UpdateCredential(context.Context, *UpdateCredentialRequest) (*commonpb.Status, error)
 	DeleteCredential(context.Context, *DeleteCredentialRequest) (*commonpb.Status, error)
 	ListCredUsers(context.Context, *ListCredUsersRequest) (*ListCredUsersResponse, error)
+	SaveRole(context.Context, *SaveRoleRequest) (*commonpb.Status, error)
+	DropRole(context.Context, *DropRoleRequest) (*commonpb.Status, error)
+	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
+	SaveUserRoles(context.Context, *SaveUserRolesRequest) (*commonpb.Status, error)
+	ListUserRoles(context.Context, *ListUserRolesRequest) (*ListUserRolesResponse, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedMilvusServiceServer can be embedded to have forward compatible implementations.
@@ -6801,6 +10070,9 @@ func (*UnimplementedMilvusServiceServer) LoadCollection(ctx context.Context, req
 func (*UnimplementedMilvusServiceServer) ReleaseCollection(ctx context.Context, req *ReleaseCollectionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReleaseCollection not implemented")
 }
+func (*UnimplementedMilvusServiceServer) GetReleaseJobs(ctx context.Context, req *GetReleaseJobsRequest) (*GetReleaseJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseJobs not implemented")
+}
 func (*UnimplementedMilvusServiceServer) DescribeCollection(ctx context.Context, req *DescribeCollectionRequest) (*DescribeCollectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeCollection not implemented")
 }
@@ -6840,6 +10112,27 @@ func (*UnimplementedMilvusServiceServer) DropAlias(ctx context.Context, req *Dro
 func (*UnimplementedMilvusServiceServer) AlterAlias(ctx context.Context, req *AlterAliasRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AlterAlias not implemented")
 }
+func (*UnimplementedMilvusServiceServer) RenameCollection(ctx context.Context, req *RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
+}
+func (*UnimplementedMilvusServiceServer) AddCollectionField(ctx context.Context, req *AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollectionField not implemented")
+}
+func (*UnimplementedMilvusServiceServer) AlterCollection(ctx context.Context, req *AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterCollection not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateDatabase(ctx context.Context, req *CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropDatabase(ctx context.Context, req *DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) AlterDatabase(ctx context.Context, req *AlterDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListDatabases(ctx context.Context, req *ListDatabasesRequest) (*ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CreateIndex(ctx context.Context, req *CreateIndexRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
 }
@@ -6855,12 +10148,18 @@ func (*UnimplementedMilvusServiceServer) GetIndexBuildProgress(ctx context.Conte
 func (*UnimplementedMilvusServiceServer) DropIndex(ctx context.Context, req *DropIndexRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropIndex not implemented")
 }
+func (*UnimplementedMilvusServiceServer) CancelIndexBuild(ctx context.Context, req *CancelIndexBuildRequest) (*CancelIndexBuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelIndexBuild not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Insert(ctx context.Context, req *InsertRequest) (*MutationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
 }
 func (*UnimplementedMilvusServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*MutationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedMilvusServiceServer) Upsert(ctx context.Context, req *UpsertRequest) (*MutationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upsert not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Search(ctx context.Context, req *SearchRequest) (*SearchResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
@@ -6870,6 +10169,15 @@ func (*UnimplementedMilvusServiceServer) Flush(ctx context.Context, req *FlushRe
 func (*UnimplementedMilvusServiceServer) Query(ctx context.Context, req *QueryRequest) (*QueryResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (*UnimplementedMilvusServiceServer) RegisterTemplate(ctx context.Context, req *RegisterTemplateRequest) (*RegisterTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterTemplate not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropTemplate(ctx context.Context, req *DropTemplateRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropTemplate not implemented")
+}
+func (*UnimplementedMilvusServiceServer) Explain(ctx context.Context, req *ExplainRequest) (*ExplainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Explain not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CalcDistance(ctx context.Context, req *CalcDistanceRequest) (*CalcDistanceResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalcDistance not implemented")
 }
@@ -6885,6 +10193,9 @@ func (*UnimplementedMilvusServiceServer) GetQuerySegmentInfo(ctx context.Context
 func (*UnimplementedMilvusServiceServer) GetReplicas(ctx context.Context, req *GetReplicasRequest) (*GetReplicasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReplicas not implemented")
 }
+func (*UnimplementedMilvusServiceServer) GetLoadingProgress(ctx context.Context, req *GetLoadingProgressRequest) (*GetLoadingProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoadingProgress not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Dummy(ctx context.Context, req *DummyRequest) (*DummyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Dummy not implemented")
 }
@@ -6897,6 +10208,18 @@ func (*UnimplementedMilvusServiceServer) GetMetrics(ctx context.Context, req *Ge
 func (*UnimplementedMilvusServiceServer) LoadBalance(ctx context.Context, req *LoadBalanceRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoadBalance not implemented")
 }
+func (*UnimplementedMilvusServiceServer) CordonNode(ctx context.Context, req *CordonNodeRequest) (*CordonedNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CordonNode not implemented")
+}
+func (*UnimplementedMilvusServiceServer) UncordonNode(ctx context.Context, req *UncordonNodeRequest) (*CordonedNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncordonNode not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetCordonedNodes(ctx context.Context, req *GetCordonedNodesRequest) (*CordonedNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCordonedNodes not implemented")
+}
+func (*UnimplementedMilvusServiceServer) Balance(ctx context.Context, req *BalanceRequest) (*BalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
+}
 func (*UnimplementedMilvusServiceServer) GetCompactionState(ctx context.Context, req *GetCompactionStateRequest) (*GetCompactionStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionState not implemented")
 }
@@ -6915,6 +10238,9 @@ func (*UnimplementedMilvusServiceServer) GetImportState(ctx context.Context, req
 func (*UnimplementedMilvusServiceServer) ListImportTasks(ctx context.Context, req *ListImportTasksRequest) (*ListImportTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListImportTasks not implemented")
 }
+func (*UnimplementedMilvusServiceServer) CancelImport(ctx context.Context, req *CancelImportRequest) (*GetImportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelImport not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CreateCredential(ctx context.Context, req *CreateCredentialRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
 }
@@ -6927,6 +10253,27 @@ func (*UnimplementedMilvusServiceServer) DeleteCredential(ctx context.Context, r
 func (*UnimplementedMilvusServiceServer) ListCredUsers(ctx context.Context, req *ListCredUsersRequest) (*ListCredUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCredUsers not implemented")
 }
+func (*UnimplementedMilvusServiceServer) SaveRole(ctx context.Context, req *SaveRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropRole(ctx context.Context, req *DropRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListRoles(ctx context.Context, req *ListRolesRequest) (*ListRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (*UnimplementedMilvusServiceServer) SaveUserRoles(ctx context.Context, req *SaveUserRolesRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveUserRoles not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListUserRoles(ctx context.Context, req *ListUserRolesRequest) (*ListUserRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserRoles not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (*UnimplementedMilvusServiceServer) RevokeAPIKey(ctx context.Context, req *RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
 
 func RegisterMilvusServiceServer(s *grpc.Server, srv MilvusServiceServer) {
 	s.RegisterService(&_MilvusService_serviceDesc, srv)
@@ -7022,6 +10369,24 @@ func _MilvusService_ReleaseCollection_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_GetReleaseJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetReleaseJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetReleaseJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetReleaseJobs(ctx, req.(*GetReleaseJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_DescribeCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeCollectionRequest)
 	if err := dec(in); err != nil {
@@ -7256,6 +10621,132 @@ func _MilvusService_AlterAlias_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).RenameCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/RenameCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).RenameCollection(ctx, req.(*RenameCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_AddCollectionField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCollectionFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).AddCollectionField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/AddCollectionField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).AddCollectionField(ctx, req.(*AddCollectionFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_AlterCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).AlterCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/AlterCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).AlterCollection(ctx, req.(*AlterCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, req.(*CreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropDatabase(ctx, req.(*DropDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_AlterDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).AlterDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/AlterDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).AlterDatabase(ctx, req.(*AlterDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListDatabases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListDatabases(ctx, req.(*ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateIndexRequest)
 	if err := dec(in); err != nil {
@@ -7346,92 +10837,182 @@ func _MilvusService_DropIndex_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InsertRequest)
+func _MilvusService_CancelIndexBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelIndexBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CancelIndexBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CancelIndexBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CancelIndexBuild(ctx, req.(*CancelIndexBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).Insert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/Insert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).Insert(ctx, req.(*InsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_Upsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).Upsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/Upsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).Upsert(ctx, req.(*UpsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).Insert(ctx, in)
+		return srv.(MilvusServiceServer).Flush(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/Insert",
+		FullMethod: "/milvus.proto.milvus.MilvusService/Flush",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).Insert(ctx, req.(*InsertRequest))
+		return srv.(MilvusServiceServer).Flush(ctx, req.(*FlushRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
+func _MilvusService_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).Delete(ctx, in)
+		return srv.(MilvusServiceServer).Query(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/Delete",
+		FullMethod: "/milvus.proto.milvus.MilvusService/Query",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).Delete(ctx, req.(*DeleteRequest))
+		return srv.(MilvusServiceServer).Query(ctx, req.(*QueryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchRequest)
+func _MilvusService_RegisterTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).Search(ctx, in)
+		return srv.(MilvusServiceServer).RegisterTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/Search",
+		FullMethod: "/milvus.proto.milvus.MilvusService/RegisterTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).Search(ctx, req.(*SearchRequest))
+		return srv.(MilvusServiceServer).RegisterTemplate(ctx, req.(*RegisterTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FlushRequest)
+func _MilvusService_DropTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).Flush(ctx, in)
+		return srv.(MilvusServiceServer).DropTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/Flush",
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).Flush(ctx, req.(*FlushRequest))
+		return srv.(MilvusServiceServer).DropTemplate(ctx, req.(*DropTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRequest)
+func _MilvusService_Explain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).Query(ctx, in)
+		return srv.(MilvusServiceServer).Explain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/Query",
+		FullMethod: "/milvus.proto.milvus.MilvusService/Explain",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).Query(ctx, req.(*QueryRequest))
+		return srv.(MilvusServiceServer).Explain(ctx, req.(*ExplainRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -7526,6 +11107,24 @@ func _MilvusService_GetReplicas_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_GetLoadingProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoadingProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetLoadingProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetLoadingProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetLoadingProgress(ctx, req.(*GetLoadingProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_Dummy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DummyRequest)
 	if err := dec(in); err != nil {
@@ -7598,6 +11197,78 @@ func _MilvusService_LoadBalance_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_CordonNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CordonNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CordonNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CordonNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CordonNode(ctx, req.(*CordonNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_UncordonNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UncordonNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).UncordonNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/UncordonNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).UncordonNode(ctx, req.(*UncordonNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GetCordonedNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCordonedNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetCordonedNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetCordonedNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetCordonedNodes(ctx, req.(*GetCordonedNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/Balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).Balance(ctx, req.(*BalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_GetCompactionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCompactionStateRequest)
 	if err := dec(in); err != nil {
@@ -7706,6 +11377,24 @@ func _MilvusService_ListImportTasks_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_CancelImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CancelImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CancelImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CancelImport(ctx, req.(*CancelImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCredentialRequest)
 	if err := dec(in); err != nil {
@@ -7778,6 +11467,132 @@ func _MilvusService_ListCredUsers_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_SaveRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).SaveRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/SaveRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).SaveRole(ctx, req.(*SaveRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropRole(ctx, req.(*DropRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListRoles(ctx, req.(*ListRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_SaveUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).SaveUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/SaveUserRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).SaveUserRoles(ctx, req.(*SaveUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListUserRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListUserRoles(ctx, req.(*ListUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/RevokeAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MilvusService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.milvus.MilvusService",
 	HandlerType: (*MilvusServiceServer)(nil),
@@ -7802,6 +11617,10 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReleaseCollection",
 			Handler:    _MilvusService_ReleaseCollection_Handler,
 		},
+		{
+			MethodName: "GetReleaseJobs",
+			Handler:    _MilvusService_GetReleaseJobs_Handler,
+		},
 		{
 			MethodName: "DescribeCollection",
 			Handler:    _MilvusService_DescribeCollection_Handler,
@@ -7854,6 +11673,34 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AlterAlias",
 			Handler:    _MilvusService_AlterAlias_Handler,
 		},
+		{
+			MethodName: "RenameCollection",
+			Handler:    _MilvusService_RenameCollection_Handler,
+		},
+		{
+			MethodName: "AddCollectionField",
+			Handler:    _MilvusService_AddCollectionField_Handler,
+		},
+		{
+			MethodName: "AlterCollection",
+			Handler:    _MilvusService_AlterCollection_Handler,
+		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _MilvusService_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _MilvusService_DropDatabase_Handler,
+		},
+		{
+			MethodName: "AlterDatabase",
+			Handler:    _MilvusService_AlterDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _MilvusService_ListDatabases_Handler,
+		},
 		{
 			MethodName: "CreateIndex",
 			Handler:    _MilvusService_CreateIndex_Handler,
@@ -7874,6 +11721,10 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DropIndex",
 			Handler:    _MilvusService_DropIndex_Handler,
 		},
+		{
+			MethodName: "CancelIndexBuild",
+			Handler:    _MilvusService_CancelIndexBuild_Handler,
+		},
 		{
 			MethodName: "Insert",
 			Handler:    _MilvusService_Insert_Handler,
@@ -7882,6 +11733,10 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _MilvusService_Delete_Handler,
 		},
+		{
+			MethodName: "Upsert",
+			Handler:    _MilvusService_Upsert_Handler,
+		},
 		{
 			MethodName: "Search",
 			Handler:    _MilvusService_Search_Handler,
@@ -7894,6 +11749,18 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Query",
 			Handler:    _MilvusService_Query_Handler,
 		},
+		{
+			MethodName: "RegisterTemplate",
+			Handler:    _MilvusService_RegisterTemplate_Handler,
+		},
+		{
+			MethodName: "DropTemplate",
+			Handler:    _MilvusService_DropTemplate_Handler,
+		},
+		{
+			MethodName: "Explain",
+			Handler:    _MilvusService_Explain_Handler,
+		},
 		{
 			MethodName: "CalcDistance",
 			Handler:    _MilvusService_CalcDistance_Handler,
@@ -7914,6 +11781,10 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetReplicas",
 			Handler:    _MilvusService_GetReplicas_Handler,
 		},
+		{
+			MethodName: "GetLoadingProgress",
+			Handler:    _MilvusService_GetLoadingProgress_Handler,
+		},
 		{
 			MethodName: "Dummy",
 			Handler:    _MilvusService_Dummy_Handler,
@@ -7930,6 +11801,22 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LoadBalance",
 			Handler:    _MilvusService_LoadBalance_Handler,
 		},
+		{
+			MethodName: "CordonNode",
+			Handler:    _MilvusService_CordonNode_Handler,
+		},
+		{
+			MethodName: "UncordonNode",
+			Handler:    _MilvusService_UncordonNode_Handler,
+		},
+		{
+			MethodName: "GetCordonedNodes",
+			Handler:    _MilvusService_GetCordonedNodes_Handler,
+		},
+		{
+			MethodName: "Balance",
+			Handler:    _MilvusService_Balance_Handler,
+		},
 		{
 			MethodName: "GetCompactionState",
 			Handler:    _MilvusService_GetCompactionState_Handler,
@@ -7954,6 +11841,10 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListImportTasks",
 			Handler:    _MilvusService_ListImportTasks_Handler,
 		},
+		{
+			MethodName: "CancelImport",
+			Handler:    _MilvusService_CancelImport_Handler,
+		},
 		{
 			MethodName: "CreateCredential",
 			Handler:    _MilvusService_CreateCredential_Handler,
@@ -7970,6 +11861,34 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListCredUsers",
 			Handler:    _MilvusService_ListCredUsers_Handler,
 		},
+		{
+			MethodName: "SaveRole",
+			Handler:    _MilvusService_SaveRole_Handler,
+		},
+		{
+			MethodName: "DropRole",
+			Handler:    _MilvusService_DropRole_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _MilvusService_ListRoles_Handler,
+		},
+		{
+			MethodName: "SaveUserRoles",
+			Handler:    _MilvusService_SaveUserRoles_Handler,
+		},
+		{
+			MethodName: "ListUserRoles",
+			Handler:    _MilvusService_ListUserRoles_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _MilvusService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _MilvusService_RevokeAPIKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "milvus.proto",
@@ -160,6 +160,65 @@ func (BinaryExpr_BinaryOp) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{10, 0}
 }
 
+type ArrayExpr_ArrayOp int32
+
+const (
+	ArrayExpr_Invalid     ArrayExpr_ArrayOp = 0
+	ArrayExpr_Contains    ArrayExpr_ArrayOp = 1
+	ArrayExpr_ContainsAny ArrayExpr_ArrayOp = 2
+	ArrayExpr_Length      ArrayExpr_ArrayOp = 3
+)
+
+var ArrayExpr_ArrayOp_name = map[int32]string{
+	0: "Invalid",
+	1: "Contains",
+	2: "ContainsAny",
+	3: "Length",
+}
+
+var ArrayExpr_ArrayOp_value = map[string]int32{
+	"Invalid":     0,
+	"Contains":    1,
+	"ContainsAny": 2,
+	"Length":      3,
+}
+
+func (x ArrayExpr_ArrayOp) String() string {
+	return proto.EnumName(ArrayExpr_ArrayOp_name, int32(x))
+}
+
+func (ArrayExpr_ArrayOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{15, 0}
+}
+
+type NullExpr_NullOp int32
+
+const (
+	NullExpr_Invalid   NullExpr_NullOp = 0
+	NullExpr_IsNull    NullExpr_NullOp = 1
+	NullExpr_IsNotNull NullExpr_NullOp = 2
+)
+
+var NullExpr_NullOp_name = map[int32]string{
+	0: "Invalid",
+	1: "IsNull",
+	2: "IsNotNull",
+}
+
+var NullExpr_NullOp_value = map[string]int32{
+	"Invalid":   0,
+	"IsNull":    1,
+	"IsNotNull": 2,
+}
+
+func (x NullExpr_NullOp) String() string {
+	return proto.EnumName(NullExpr_NullOp_name, int32(x))
+}
+
+func (NullExpr_NullOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{16, 0}
+}
+
 type GenericValue struct {
 	// Types that are valid to be assigned to Val:
 	//	*GenericValue_BoolVal
@@ -985,6 +1044,279 @@ func (m *BinaryArithOpEvalRangeExpr) GetValue() *GenericValue {
 	return nil
 }
 
+// JSONPathExpr is a predicate on the value at the path of a JSON field, which is a VarChar field of JSON objects.
+// The path exists if its value isn't null, and the comparisons are false if the path doesn't exist or its value is of
+// another type than the value compared with.
+type JSONPathExpr struct {
+	ColumnInfo *ColumnInfo `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Path       []string    `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+	// Invalid checks the existence of the path, the value is compared with by the other comparisons
+	Op                   OpType        `protobuf:"varint,3,opt,name=op,proto3,enum=milvus.proto.plan.OpType" json:"op,omitempty"`
+	Value                *GenericValue `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Not                  bool          `protobuf:"varint,5,opt,name=not,proto3" json:"not,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *JSONPathExpr) Reset()         { *m = JSONPathExpr{} }
+func (m *JSONPathExpr) String() string { return proto.CompactTextString(m) }
+func (*JSONPathExpr) ProtoMessage()    {}
+func (*JSONPathExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{14}
+}
+
+func (m *JSONPathExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JSONPathExpr.Unmarshal(m, b)
+}
+func (m *JSONPathExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JSONPathExpr.Marshal(b, m, deterministic)
+}
+func (m *JSONPathExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONPathExpr.Merge(m, src)
+}
+func (m *JSONPathExpr) XXX_Size() int {
+	return xxx_messageInfo_JSONPathExpr.Size(m)
+}
+func (m *JSONPathExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONPathExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JSONPathExpr proto.InternalMessageInfo
+
+func (m *JSONPathExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *JSONPathExpr) GetPath() []string {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *JSONPathExpr) GetOp() OpType {
+	if m != nil {
+		return m.Op
+	}
+	return OpType_Invalid
+}
+
+func (m *JSONPathExpr) GetValue() *GenericValue {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *JSONPathExpr) GetNot() bool {
+	if m != nil {
+		return m.Not
+	}
+	return false
+}
+
+// ArrayExpr is a predicate on the elements or the length of an array field, which is a VarChar field of JSON arrays.
+// The numbers are compared as int64 if both are integers, otherwise as double.
+type ArrayExpr struct {
+	ColumnInfo *ColumnInfo       `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Op         ArrayExpr_ArrayOp `protobuf:"varint,2,opt,name=op,proto3,enum=milvus.proto.plan.ArrayExpr_ArrayOp" json:"op,omitempty"`
+	// the elements to look for by Contains and ContainsAny
+	Values []*GenericValue `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	// the comparison of the length by Length
+	LengthOp             OpType   `protobuf:"varint,4,opt,name=length_op,json=lengthOp,proto3,enum=milvus.proto.plan.OpType" json:"length_op,omitempty"`
+	Length               int64    `protobuf:"varint,5,opt,name=length,proto3" json:"length,omitempty"`
+	Not                  bool     `protobuf:"varint,6,opt,name=not,proto3" json:"not,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArrayExpr) Reset()         { *m = ArrayExpr{} }
+func (m *ArrayExpr) String() string { return proto.CompactTextString(m) }
+func (*ArrayExpr) ProtoMessage()    {}
+func (*ArrayExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{15}
+}
+
+func (m *ArrayExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArrayExpr.Unmarshal(m, b)
+}
+func (m *ArrayExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArrayExpr.Marshal(b, m, deterministic)
+}
+func (m *ArrayExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArrayExpr.Merge(m, src)
+}
+func (m *ArrayExpr) XXX_Size() int {
+	return xxx_messageInfo_ArrayExpr.Size(m)
+}
+func (m *ArrayExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArrayExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArrayExpr proto.InternalMessageInfo
+
+func (m *ArrayExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *ArrayExpr) GetOp() ArrayExpr_ArrayOp {
+	if m != nil {
+		return m.Op
+	}
+	return ArrayExpr_Invalid
+}
+
+func (m *ArrayExpr) GetValues() []*GenericValue {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *ArrayExpr) GetLengthOp() OpType {
+	if m != nil {
+		return m.LengthOp
+	}
+	return OpType_Invalid
+}
+
+func (m *ArrayExpr) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *ArrayExpr) GetNot() bool {
+	if m != nil {
+		return m.Not
+	}
+	return false
+}
+
+// NullExpr checks whether the value of a field, or the value at the path of a JSON field, is null. The fields
+// aren't nullable, the paths are null if they don't exist or their values are JSON null.
+type NullExpr struct {
+	ColumnInfo *ColumnInfo     `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Op         NullExpr_NullOp `protobuf:"varint,2,opt,name=op,proto3,enum=milvus.proto.plan.NullExpr_NullOp" json:"op,omitempty"`
+	// the path of the JSON field, empty for the value of the field itself
+	Path                 []string `protobuf:"bytes,3,rep,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NullExpr) Reset()         { *m = NullExpr{} }
+func (m *NullExpr) String() string { return proto.CompactTextString(m) }
+func (*NullExpr) ProtoMessage()    {}
+func (*NullExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{16}
+}
+
+func (m *NullExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NullExpr.Unmarshal(m, b)
+}
+func (m *NullExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NullExpr.Marshal(b, m, deterministic)
+}
+func (m *NullExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NullExpr.Merge(m, src)
+}
+func (m *NullExpr) XXX_Size() int {
+	return xxx_messageInfo_NullExpr.Size(m)
+}
+func (m *NullExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_NullExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NullExpr proto.InternalMessageInfo
+
+func (m *NullExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *NullExpr) GetOp() NullExpr_NullOp {
+	if m != nil {
+		return m.Op
+	}
+	return NullExpr_Invalid
+}
+
+func (m *NullExpr) GetPath() []string {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+// ArithCompareExpr compares two arithmetic expressions, each of which is a tree of BinaryArithExprs whose leaves are
+// the ColumnExprs of numeric fields and the ValueExprs, e.g. a + b > c * 2
+type ArithCompareExpr struct {
+	Left                 *Expr    `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"`
+	Right                *Expr    `protobuf:"bytes,2,opt,name=right,proto3" json:"right,omitempty"`
+	Op                   OpType   `protobuf:"varint,3,opt,name=op,proto3,enum=milvus.proto.plan.OpType" json:"op,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArithCompareExpr) Reset()         { *m = ArithCompareExpr{} }
+func (m *ArithCompareExpr) String() string { return proto.CompactTextString(m) }
+func (*ArithCompareExpr) ProtoMessage()    {}
+func (*ArithCompareExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{17}
+}
+
+func (m *ArithCompareExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArithCompareExpr.Unmarshal(m, b)
+}
+func (m *ArithCompareExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArithCompareExpr.Marshal(b, m, deterministic)
+}
+func (m *ArithCompareExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArithCompareExpr.Merge(m, src)
+}
+func (m *ArithCompareExpr) XXX_Size() int {
+	return xxx_messageInfo_ArithCompareExpr.Size(m)
+}
+func (m *ArithCompareExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArithCompareExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArithCompareExpr proto.InternalMessageInfo
+
+func (m *ArithCompareExpr) GetLeft() *Expr {
+	if m != nil {
+		return m.Left
+	}
+	return nil
+}
+
+func (m *ArithCompareExpr) GetRight() *Expr {
+	if m != nil {
+		return m.Right
+	}
+	return nil
+}
+
+func (m *ArithCompareExpr) GetOp() OpType {
+	if m != nil {
+		return m.Op
+	}
+	return OpType_Invalid
+}
+
 type Expr struct {
 	// Types that are valid to be assigned to Expr:
 	//	*Expr_TermExpr
@@ -997,6 +1329,10 @@ type Expr struct {
 	//	*Expr_BinaryArithExpr
 	//	*Expr_ValueExpr
 	//	*Expr_ColumnExpr
+	//	*Expr_JsonPathExpr
+	//	*Expr_ArrayExpr
+	//	*Expr_NullExpr
+	//	*Expr_ArithCompareExpr
 	Expr                 isExpr_Expr `protobuf_oneof:"expr"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
@@ -1007,7 +1343,7 @@ func (m *Expr) Reset()         { *m = Expr{} }
 func (m *Expr) String() string { return proto.CompactTextString(m) }
 func (*Expr) ProtoMessage()    {}
 func (*Expr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{14}
+	return fileDescriptor_2d655ab2f7683c23, []int{18}
 }
 
 func (m *Expr) XXX_Unmarshal(b []byte) error {
@@ -1072,6 +1408,22 @@ type Expr_ColumnExpr struct {
 	ColumnExpr *ColumnExpr `protobuf:"bytes,10,opt,name=column_expr,json=columnExpr,proto3,oneof"`
 }
 
+type Expr_JsonPathExpr struct {
+	JsonPathExpr *JSONPathExpr `protobuf:"bytes,11,opt,name=json_path_expr,json=jsonPathExpr,proto3,oneof"`
+}
+
+type Expr_ArrayExpr struct {
+	ArrayExpr *ArrayExpr `protobuf:"bytes,12,opt,name=array_expr,json=arrayExpr,proto3,oneof"`
+}
+
+type Expr_NullExpr struct {
+	NullExpr *NullExpr `protobuf:"bytes,13,opt,name=null_expr,json=nullExpr,proto3,oneof"`
+}
+
+type Expr_ArithCompareExpr struct {
+	ArithCompareExpr *ArithCompareExpr `protobuf:"bytes,14,opt,name=arith_compare_expr,json=arithCompareExpr,proto3,oneof"`
+}
+
 func (*Expr_TermExpr) isExpr_Expr() {}
 
 func (*Expr_UnaryExpr) isExpr_Expr() {}
@@ -1092,6 +1444,14 @@ func (*Expr_ValueExpr) isExpr_Expr() {}
 
 func (*Expr_ColumnExpr) isExpr_Expr() {}
 
+func (*Expr_JsonPathExpr) isExpr_Expr() {}
+
+func (*Expr_ArrayExpr) isExpr_Expr() {}
+
+func (*Expr_NullExpr) isExpr_Expr() {}
+
+func (*Expr_ArithCompareExpr) isExpr_Expr() {}
+
 func (m *Expr) GetExpr() isExpr_Expr {
 	if m != nil {
 		return m.Expr
@@ -1169,6 +1529,34 @@ func (m *Expr) GetColumnExpr() *ColumnExpr {
 	return nil
 }
 
+func (m *Expr) GetJsonPathExpr() *JSONPathExpr {
+	if x, ok := m.GetExpr().(*Expr_JsonPathExpr); ok {
+		return x.JsonPathExpr
+	}
+	return nil
+}
+
+func (m *Expr) GetArrayExpr() *ArrayExpr {
+	if x, ok := m.GetExpr().(*Expr_ArrayExpr); ok {
+		return x.ArrayExpr
+	}
+	return nil
+}
+
+func (m *Expr) GetNullExpr() *NullExpr {
+	if x, ok := m.GetExpr().(*Expr_NullExpr); ok {
+		return x.NullExpr
+	}
+	return nil
+}
+
+func (m *Expr) GetArithCompareExpr() *ArithCompareExpr {
+	if x, ok := m.GetExpr().(*Expr_ArithCompareExpr); ok {
+		return x.ArithCompareExpr
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Expr) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1182,6 +1570,10 @@ func (*Expr) XXX_OneofWrappers() []interface{} {
 		(*Expr_BinaryArithExpr)(nil),
 		(*Expr_ValueExpr)(nil),
 		(*Expr_ColumnExpr)(nil),
+		(*Expr_JsonPathExpr)(nil),
+		(*Expr_ArrayExpr)(nil),
+		(*Expr_NullExpr)(nil),
+		(*Expr_ArithCompareExpr)(nil),
 	}
 }
 
@@ -1200,7 +1592,7 @@ func (m *VectorANNS) Reset()         { *m = VectorANNS{} }
 func (m *VectorANNS) String() string { return proto.CompactTextString(m) }
 func (*VectorANNS) ProtoMessage()    {}
 func (*VectorANNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{15}
+	return fileDescriptor_2d655ab2f7683c23, []int{19}
 }
 
 func (m *VectorANNS) XXX_Unmarshal(b []byte) error {
@@ -1271,7 +1663,7 @@ func (m *PlanNode) Reset()         { *m = PlanNode{} }
 func (m *PlanNode) String() string { return proto.CompactTextString(m) }
 func (*PlanNode) ProtoMessage()    {}
 func (*PlanNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{16}
+	return fileDescriptor_2d655ab2f7683c23, []int{20}
 }
 
 func (m *PlanNode) XXX_Unmarshal(b []byte) error {
@@ -1349,6 +1741,8 @@ func init() {
 	proto.RegisterEnum("milvus.proto.plan.ArithOpType", ArithOpType_name, ArithOpType_value)
 	proto.RegisterEnum("milvus.proto.plan.UnaryExpr_UnaryOp", UnaryExpr_UnaryOp_name, UnaryExpr_UnaryOp_value)
 	proto.RegisterEnum("milvus.proto.plan.BinaryExpr_BinaryOp", BinaryExpr_BinaryOp_name, BinaryExpr_BinaryOp_value)
+	proto.RegisterEnum("milvus.proto.plan.ArrayExpr_ArrayOp", ArrayExpr_ArrayOp_name, ArrayExpr_ArrayOp_value)
+	proto.RegisterEnum("milvus.proto.plan.NullExpr_NullOp", NullExpr_NullOp_name, NullExpr_NullOp_value)
 	proto.RegisterType((*GenericValue)(nil), "milvus.proto.plan.GenericValue")
 	proto.RegisterType((*QueryInfo)(nil), "milvus.proto.plan.QueryInfo")
 	proto.RegisterType((*ColumnInfo)(nil), "milvus.proto.plan.ColumnInfo")
@@ -1363,6 +1757,10 @@ func init() {
 	proto.RegisterType((*BinaryArithOp)(nil), "milvus.proto.plan.BinaryArithOp")
 	proto.RegisterType((*BinaryArithExpr)(nil), "milvus.proto.plan.BinaryArithExpr")
 	proto.RegisterType((*BinaryArithOpEvalRangeExpr)(nil), "milvus.proto.plan.BinaryArithOpEvalRangeExpr")
+	proto.RegisterType((*JSONPathExpr)(nil), "milvus.proto.plan.JSONPathExpr")
+	proto.RegisterType((*ArrayExpr)(nil), "milvus.proto.plan.ArrayExpr")
+	proto.RegisterType((*NullExpr)(nil), "milvus.proto.plan.NullExpr")
+	proto.RegisterType((*ArithCompareExpr)(nil), "milvus.proto.plan.ArithCompareExpr")
 	proto.RegisterType((*Expr)(nil), "milvus.proto.plan.Expr")
 	proto.RegisterType((*VectorANNS)(nil), "milvus.proto.plan.VectorANNS")
 	proto.RegisterType((*PlanNode)(nil), "milvus.proto.plan.PlanNode")
@@ -1371,92 +1769,109 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 1387 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x73, 0xdb, 0x44,
-	0x14, 0xb7, 0x2c, 0x3b, 0x96, 0x9e, 0x1d, 0x47, 0xd5, 0x85, 0x7e, 0xd0, 0x26, 0x88, 0x0e, 0x0d,
-	0x65, 0x9a, 0x4c, 0x69, 0x69, 0xa7, 0x65, 0x0a, 0xf9, 0x2a, 0x49, 0x86, 0x36, 0x09, 0x6a, 0x9a,
-	0x03, 0x17, 0xcd, 0x5a, 0xda, 0xd8, 0x3b, 0x95, 0xb5, 0xea, 0x6a, 0xe5, 0xd6, 0x67, 0x6e, 0xdc,
-	0xb8, 0xc3, 0x15, 0xee, 0xdc, 0x38, 0xf1, 0x0f, 0x70, 0xe0, 0xc8, 0x9d, 0xff, 0x82, 0x13, 0xb3,
-	0x6f, 0xe5, 0xaf, 0x8e, 0xdd, 0x38, 0x43, 0x67, 0xb8, 0xed, 0xbe, 0x7d, 0xef, 0xb7, 0xef, 0xfd,
-	0xf6, 0xed, 0xdb, 0xb7, 0x00, 0x69, 0x4c, 0x92, 0xb5, 0x54, 0x70, 0xc9, 0xdd, 0x0b, 0x5d, 0x16,
-	0xf7, 0xf2, 0x4c, 0xcf, 0xd6, 0xd4, 0xc2, 0xe5, 0x46, 0x16, 0x76, 0x68, 0x97, 0x68, 0x91, 0xf7,
-	0x83, 0x01, 0x8d, 0x5d, 0x9a, 0x50, 0xc1, 0xc2, 0x13, 0x12, 0xe7, 0xd4, 0xbd, 0x02, 0x56, 0x8b,
-	0xf3, 0x38, 0xe8, 0x91, 0xf8, 0xa2, 0xb1, 0x62, 0xac, 0x5a, 0x7b, 0x25, 0xbf, 0xa6, 0x24, 0x27,
-	0x24, 0x76, 0xaf, 0x82, 0xcd, 0x12, 0x79, 0xef, 0x2e, 0xae, 0x96, 0x57, 0x8c, 0x55, 0x73, 0xaf,
-	0xe4, 0x5b, 0x28, 0x2a, 0x96, 0x4f, 0x63, 0x4e, 0x24, 0x2e, 0x9b, 0x2b, 0xc6, 0xaa, 0xa1, 0x96,
-	0x51, 0xa4, 0x96, 0x97, 0x01, 0x32, 0x29, 0x58, 0xd2, 0xc6, 0xf5, 0xca, 0x8a, 0xb1, 0x6a, 0xef,
-	0x95, 0x7c, 0x5b, 0xcb, 0x4e, 0x48, 0xbc, 0x55, 0x05, 0xb3, 0x47, 0x62, 0xef, 0x7b, 0x03, 0xec,
-	0x6f, 0x72, 0x2a, 0xfa, 0xfb, 0xc9, 0x29, 0x77, 0x5d, 0xa8, 0x48, 0x9e, 0xbe, 0x40, 0x67, 0x4c,
-	0x1f, 0xc7, 0xee, 0x32, 0xd4, 0xbb, 0x54, 0x0a, 0x16, 0x06, 0xb2, 0x9f, 0x52, 0xdc, 0xca, 0xf6,
-	0x41, 0x8b, 0x8e, 0xfb, 0x29, 0x75, 0x3f, 0x84, 0xc5, 0x8c, 0x12, 0x11, 0x76, 0x82, 0x94, 0x08,
-	0xd2, 0xcd, 0xf4, 0x6e, 0x7e, 0x43, 0x0b, 0x8f, 0x50, 0xa6, 0x94, 0x04, 0xcf, 0x93, 0x28, 0x88,
-	0x68, 0xc8, 0xba, 0x24, 0xbe, 0x58, 0xc5, 0x2d, 0x1a, 0x28, 0xdc, 0xd1, 0x32, 0xef, 0x67, 0x03,
-	0x60, 0x9b, 0xc7, 0x79, 0x37, 0x41, 0x6f, 0x2e, 0x81, 0x75, 0xca, 0x68, 0x1c, 0x05, 0x2c, 0x2a,
-	0x3c, 0xaa, 0xe1, 0x7c, 0x3f, 0x72, 0x1f, 0x82, 0x1d, 0x11, 0x49, 0xb4, 0x4b, 0x8a, 0x9c, 0xe6,
-	0xa7, 0x57, 0xd7, 0x26, 0xf8, 0x2f, 0x98, 0xdf, 0x21, 0x92, 0x28, 0x2f, 0x7d, 0x2b, 0x2a, 0x46,
-	0xee, 0x75, 0x68, 0xb2, 0x2c, 0x48, 0x05, 0xeb, 0x12, 0xd1, 0x0f, 0x5e, 0xd0, 0x3e, 0xc6, 0x64,
-	0xf9, 0x0d, 0x96, 0x1d, 0x69, 0xe1, 0xd7, 0xb4, 0xef, 0x5e, 0x01, 0x9b, 0x65, 0x01, 0xc9, 0x25,
-	0xdf, 0xdf, 0xc1, 0x88, 0x2c, 0xdf, 0x62, 0xd9, 0x26, 0xce, 0xbd, 0x2f, 0x07, 0x7e, 0x3e, 0x7e,
-	0x9d, 0x0a, 0xf7, 0x36, 0x54, 0x58, 0x72, 0xca, 0xd1, 0xc7, 0xfa, 0x9b, 0x7e, 0x60, 0x82, 0x8c,
-	0x82, 0xf2, 0x51, 0xd5, 0xdb, 0x02, 0x1b, 0x53, 0x00, 0xed, 0x3f, 0x83, 0x6a, 0x4f, 0x4d, 0x0a,
-	0x80, 0xe5, 0x29, 0x00, 0xe3, 0x69, 0xe3, 0x6b, 0x6d, 0xef, 0x57, 0x03, 0x9a, 0xcf, 0x13, 0x22,
-	0xfa, 0x3e, 0x49, 0xda, 0x1a, 0xe9, 0x0b, 0xa8, 0x87, 0xb8, 0x55, 0x30, 0xbf, 0x43, 0x10, 0x8e,
-	0x18, 0xff, 0x18, 0xca, 0x3c, 0x2d, 0xf8, 0xbc, 0x34, 0xc5, 0xec, 0x30, 0x45, 0x2e, 0xcb, 0x3c,
-	0x1d, 0x39, 0x6d, 0x9e, 0xcb, 0xe9, 0x5f, 0xca, 0xb0, 0xb4, 0xc5, 0xde, 0xad, 0xd7, 0x37, 0x60,
-	0x29, 0xe6, 0xaf, 0xa8, 0x08, 0x58, 0x12, 0xc6, 0x79, 0xc6, 0x7a, 0x3a, 0x25, 0x2c, 0xbf, 0x89,
-	0xe2, 0xfd, 0x81, 0x54, 0x29, 0xe6, 0x69, 0x3a, 0xa1, 0xa8, 0x8f, 0xbe, 0x89, 0xe2, 0x91, 0xe2,
-	0x06, 0xd4, 0x35, 0xa2, 0x0e, 0xb1, 0x32, 0x5f, 0x88, 0x80, 0x36, 0xfa, 0x6a, 0x6f, 0x40, 0x5d,
-	0x6f, 0xa5, 0x11, 0xaa, 0x73, 0x22, 0xa0, 0x0d, 0x8e, 0xbd, 0x3f, 0x0c, 0xa8, 0x6f, 0xf3, 0x6e,
-	0x4a, 0x84, 0x66, 0x69, 0x17, 0x9c, 0x98, 0x9e, 0xca, 0xe0, 0xdc, 0x54, 0x35, 0x95, 0xd9, 0xd8,
-	0xb5, 0xda, 0x87, 0x0b, 0x82, 0xb5, 0x3b, 0x93, 0x48, 0xe5, 0x79, 0x90, 0x96, 0xd0, 0x6e, 0xfb,
-	0xcd, 0x7c, 0x31, 0xe7, 0xc8, 0x17, 0xef, 0x3b, 0x03, 0xac, 0x63, 0x2a, 0xba, 0xef, 0xe4, 0xc4,
-	0xef, 0xc3, 0x02, 0xf2, 0x9a, 0x5d, 0x2c, 0xaf, 0x98, 0xf3, 0x10, 0x5b, 0xa8, 0xab, 0x12, 0x6c,
-	0xe3, 0x9d, 0x41, 0x37, 0xee, 0xa2, 0xfb, 0x06, 0xba, 0x7f, 0x7d, 0x0a, 0xc4, 0x50, 0x53, 0x8f,
-	0x0e, 0x53, 0xcc, 0xfc, 0x5b, 0x50, 0x0d, 0x3b, 0x2c, 0x8e, 0x0a, 0xce, 0xde, 0x9b, 0x62, 0xa8,
-	0x6c, 0x7c, 0xad, 0xe5, 0x2d, 0x43, 0xad, 0xb0, 0x76, 0xeb, 0x50, 0xdb, 0x4f, 0x7a, 0x24, 0x66,
-	0x91, 0x53, 0x72, 0x6b, 0x60, 0x1e, 0x70, 0xe9, 0x18, 0xde, 0x5f, 0x06, 0x80, 0xbe, 0x12, 0xe8,
-	0xd4, 0xbd, 0x31, 0xa7, 0x3e, 0x9a, 0x82, 0x3d, 0x52, 0x2d, 0x86, 0x85, 0x5b, 0x9f, 0x40, 0x45,
-	0x1d, 0xf4, 0x59, 0x5e, 0xa1, 0x92, 0x8a, 0x01, 0xcf, 0xb2, 0xb8, 0xbd, 0xb3, 0x63, 0x40, 0x2d,
-	0xef, 0x1e, 0x58, 0x83, 0xbd, 0x26, 0x83, 0x68, 0x02, 0x3c, 0xe1, 0x6d, 0x16, 0x92, 0x78, 0x33,
-	0x89, 0x1c, 0xc3, 0x5d, 0x04, 0xbb, 0x98, 0x1f, 0x0a, 0xa7, 0xec, 0xfd, 0x69, 0xc0, 0xa2, 0x36,
-	0xdc, 0x14, 0x4c, 0x76, 0x0e, 0xd3, 0xff, 0x7c, 0xf2, 0x0f, 0xc0, 0x22, 0x0a, 0x2a, 0x18, 0xd6,
-	0xa9, 0x6b, 0x53, 0x8c, 0x8b, 0xdd, 0x30, 0xf9, 0x6a, 0xa4, 0xd8, 0x7a, 0x07, 0x16, 0x75, 0xde,
-	0xf3, 0x94, 0x0a, 0x92, 0x44, 0xf3, 0x56, 0xae, 0x06, 0x5a, 0x1d, 0x6a, 0x23, 0xef, 0x27, 0x63,
-	0x50, 0xc0, 0x70, 0x13, 0x3c, 0xb2, 0x01, 0xf5, 0xc6, 0xb9, 0xa8, 0x2f, 0xcf, 0x43, 0xbd, 0xbb,
-	0x36, 0x76, 0xc5, 0xce, 0x0a, 0x55, 0xdd, 0xb3, 0xdf, 0xcb, 0x70, 0x79, 0x82, 0xf2, 0xc7, 0x3d,
-	0x12, 0xbf, 0xbb, 0x5a, 0xfb, 0x7f, 0xf3, 0x5f, 0x94, 0x9c, 0xca, 0xb9, 0x9e, 0xa8, 0xea, 0xb9,
-	0x9e, 0xa8, 0x7f, 0xaa, 0x50, 0x41, 0xae, 0x1e, 0x82, 0x2d, 0xa9, 0xe8, 0x06, 0xf4, 0x75, 0x2a,
-	0x0a, 0xa6, 0xae, 0x4c, 0xc1, 0x18, 0x54, 0x35, 0xd5, 0x7f, 0xc9, 0x41, 0x85, 0x7b, 0x04, 0x90,
-	0xab, 0x43, 0xd0, 0xc6, 0xfa, 0xa8, 0xdf, 0x7f, 0x5b, 0x89, 0x51, 0xdd, 0x59, 0x3e, 0x2c, 0x02,
-	0x1b, 0x50, 0x6f, 0xb1, 0x91, 0xbd, 0x39, 0xf3, 0x98, 0x46, 0xd5, 0x60, 0xaf, 0xe4, 0x43, 0x6b,
-	0x54, 0x46, 0xb6, 0xa1, 0x11, 0xea, 0xd7, 0x43, 0x43, 0xe8, 0x37, 0xec, 0xda, 0xd4, 0x93, 0x1e,
-	0x3e, 0x32, 0x7b, 0x25, 0xbf, 0x1e, 0x8e, 0xbd, 0x39, 0x4f, 0xc1, 0xd1, 0x51, 0x08, 0x95, 0x40,
-	0x1a, 0x48, 0x93, 0xf9, 0xc1, 0xac, 0x58, 0x86, 0xa9, 0xb6, 0x57, 0xf2, 0x9b, 0xf9, 0xe4, 0x43,
-	0x7f, 0x04, 0x17, 0x8a, 0xa8, 0xc6, 0xf0, 0x16, 0x10, 0xcf, 0x9b, 0x19, 0xdb, 0x38, 0xe0, 0x52,
-	0xeb, 0x8d, 0xd6, 0x41, 0xc2, 0x72, 0x81, 0x38, 0xc8, 0xca, 0x80, 0xf6, 0x48, 0x3c, 0x8e, 0x5f,
-	0x43, 0xfc, 0x5b, 0x33, 0xf1, 0xa7, 0x5d, 0x93, 0xbd, 0x92, 0x7f, 0xb9, 0x35, 0xfb, 0x12, 0x8d,
-	0xe2, 0xd0, 0xbb, 0xe2, 0x3e, 0xd6, 0x19, 0x71, 0x0c, 0xcb, 0xc5, 0x28, 0x8e, 0x51, 0x05, 0x79,
-	0x04, 0x80, 0xc9, 0xa7, 0xa1, 0xec, 0x99, 0xe9, 0x32, 0x6c, 0x1a, 0x55, 0xba, 0xf4, 0x86, 0x1d,
-	0xe4, 0xc6, 0xf0, 0x56, 0xa3, 0x3d, 0x9c, 0x71, 0xab, 0x07, 0xe9, 0x12, 0x0e, 0x67, 0x5b, 0x0b,
-	0x50, 0x51, 0xa6, 0xde, 0xdf, 0x06, 0xc0, 0x09, 0x0d, 0x25, 0x17, 0x9b, 0x07, 0x07, 0xcf, 0x8a,
-	0x2e, 0x58, 0x7b, 0xab, 0xbf, 0x28, 0xaa, 0x0b, 0xd6, 0x01, 0x4d, 0xf4, 0xe7, 0xe5, 0xc9, 0xfe,
-	0xfc, 0x3e, 0x40, 0x2a, 0x68, 0xc4, 0x42, 0x22, 0x69, 0x76, 0xd6, 0x23, 0x33, 0xa6, 0xea, 0x7e,
-	0x0e, 0xf0, 0x52, 0x7d, 0x47, 0x74, 0x79, 0xaa, 0xcc, 0x24, 0x62, 0xf8, 0x67, 0xf1, 0xed, 0x97,
-	0xc3, 0xef, 0xcb, 0x0d, 0x58, 0x4a, 0x63, 0x12, 0xd2, 0x0e, 0x8f, 0x23, 0x2a, 0x02, 0x49, 0xda,
-	0x98, 0xad, 0xb6, 0xdf, 0x1c, 0x13, 0x1f, 0x93, 0xb6, 0xf7, 0x9b, 0x01, 0xd6, 0x51, 0x4c, 0x92,
-	0x03, 0x1e, 0x61, 0xab, 0xd6, 0xc3, 0x88, 0x03, 0x92, 0x24, 0xd9, 0x5b, 0x4a, 0xe2, 0x88, 0x17,
-	0x45, 0x9e, 0xb6, 0xd9, 0x4c, 0x92, 0xcc, 0x7d, 0x30, 0x11, 0xed, 0xdb, 0xeb, 0xba, 0x32, 0x1d,
-	0x8b, 0x77, 0x15, 0x1c, 0x9e, 0xcb, 0x34, 0x97, 0xc1, 0x80, 0x4a, 0x45, 0x97, 0xb9, 0x6a, 0xfa,
-	0x4d, 0x2d, 0xff, 0x4a, 0x33, 0x9a, 0xa9, 0x13, 0x4a, 0x78, 0x44, 0x6f, 0xfe, 0x68, 0xc0, 0x82,
-	0x2e, 0x72, 0x93, 0x4f, 0xf1, 0x12, 0xd4, 0x77, 0x05, 0x25, 0x92, 0x8a, 0xe3, 0x0e, 0x49, 0x1c,
-	0xc3, 0x75, 0xa0, 0x51, 0x08, 0x1e, 0xbf, 0xcc, 0x49, 0xec, 0x94, 0xdd, 0x06, 0x58, 0x4f, 0x68,
-	0x96, 0xe1, 0xba, 0x89, 0x6f, 0x35, 0xcd, 0x32, 0xbd, 0x58, 0x71, 0x6d, 0xa8, 0xea, 0x61, 0x55,
-	0xe9, 0x1d, 0x70, 0xa9, 0x67, 0x0b, 0x0a, 0xf8, 0x48, 0xd0, 0x53, 0xf6, 0xfa, 0x29, 0x91, 0x61,
-	0xc7, 0xa9, 0x29, 0xe0, 0x23, 0x9e, 0xc9, 0xa1, 0xc4, 0x52, 0xb6, 0x7a, 0x68, 0xdf, 0xdc, 0x85,
-	0xfa, 0x58, 0xf5, 0x57, 0x2e, 0x3e, 0x4f, 0x5e, 0x24, 0xfc, 0x55, 0xa2, 0x5b, 0x9e, 0xcd, 0x48,
-	0xb5, 0x09, 0x35, 0x30, 0x9f, 0xe5, 0x2d, 0xa7, 0xac, 0x06, 0x4f, 0xf3, 0xd8, 0x31, 0xd5, 0x60,
-	0x87, 0xf5, 0x9c, 0x0a, 0x4a, 0x78, 0xe4, 0x54, 0xb7, 0xee, 0x7c, 0x7b, 0xbb, 0xcd, 0x64, 0x27,
-	0x6f, 0xad, 0x85, 0xbc, 0xbb, 0xae, 0xc9, 0xbc, 0xc5, 0x78, 0x31, 0x5a, 0x67, 0x89, 0xa4, 0x22,
-	0x21, 0xf1, 0x3a, 0xf2, 0xbb, 0xae, 0xf8, 0x4d, 0x5b, 0xad, 0x05, 0x9c, 0xdd, 0xf9, 0x37, 0x00,
-	0x00, 0xff, 0xff, 0xc8, 0xcd, 0x73, 0x91, 0x98, 0x0f, 0x00, 0x00,
+	// 1664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x73, 0xdc, 0x4a,
+	0x11, 0x5f, 0xad, 0xf6, 0x43, 0xea, 0x5d, 0xaf, 0x95, 0x39, 0x80, 0x5f, 0xc2, 0x7b, 0x36, 0x7a,
+	0x29, 0x30, 0x8f, 0x8a, 0xc3, 0x7b, 0x2f, 0xe4, 0xd5, 0x7b, 0x14, 0x1f, 0x6b, 0x3b, 0xd8, 0x86,
+	0xc4, 0x36, 0x4a, 0x5e, 0x0e, 0x5c, 0x54, 0xb3, 0xd2, 0xd8, 0x3b, 0x44, 0x3b, 0xa3, 0x48, 0xa3,
+	0x4d, 0xf6, 0xcc, 0x8d, 0x1b, 0xc5, 0x89, 0x2a, 0xb8, 0xc2, 0x9d, 0x1b, 0x27, 0x2e, 0x1c, 0x39,
+	0x70, 0xa2, 0xb8, 0x71, 0xe0, 0x1f, 0xa1, 0xa6, 0x47, 0xda, 0x8f, 0xd4, 0xae, 0xed, 0x2d, 0x4c,
+	0x71, 0xeb, 0xee, 0xe9, 0xee, 0xe9, 0xfe, 0x4d, 0x4f, 0xb7, 0x46, 0x00, 0x69, 0x42, 0xc5, 0x5e,
+	0x9a, 0x49, 0x25, 0xc9, 0x9d, 0x11, 0x4f, 0xc6, 0x45, 0x6e, 0xb8, 0x3d, 0xbd, 0x70, 0xb7, 0x9b,
+	0x47, 0x43, 0x36, 0xa2, 0x46, 0xe4, 0xff, 0xda, 0x82, 0xee, 0x11, 0x13, 0x2c, 0xe3, 0xd1, 0x4b,
+	0x9a, 0x14, 0x8c, 0xdc, 0x03, 0x67, 0x20, 0x65, 0x12, 0x8e, 0x69, 0xb2, 0x65, 0xed, 0x58, 0xbb,
+	0xce, 0x71, 0x2d, 0x68, 0x6b, 0xc9, 0x4b, 0x9a, 0x90, 0xf7, 0xc1, 0xe5, 0x42, 0x3d, 0x7e, 0x84,
+	0xab, 0xf5, 0x1d, 0x6b, 0xd7, 0x3e, 0xae, 0x05, 0x0e, 0x8a, 0xca, 0xe5, 0x8b, 0x44, 0x52, 0x85,
+	0xcb, 0xf6, 0x8e, 0xb5, 0x6b, 0xe9, 0x65, 0x14, 0xe9, 0xe5, 0x6d, 0x80, 0x5c, 0x65, 0x5c, 0x5c,
+	0xe2, 0x7a, 0x63, 0xc7, 0xda, 0x75, 0x8f, 0x6b, 0x81, 0x6b, 0x64, 0x2f, 0x69, 0xb2, 0xdf, 0x04,
+	0x7b, 0x4c, 0x13, 0xff, 0x57, 0x16, 0xb8, 0x3f, 0x2b, 0x58, 0x36, 0x39, 0x11, 0x17, 0x92, 0x10,
+	0x68, 0x28, 0x99, 0xbe, 0xc2, 0x60, 0xec, 0x00, 0x69, 0xb2, 0x0d, 0x9d, 0x11, 0x53, 0x19, 0x8f,
+	0x42, 0x35, 0x49, 0x19, 0x6e, 0xe5, 0x06, 0x60, 0x44, 0x2f, 0x26, 0x29, 0x23, 0x1f, 0xc2, 0x46,
+	0xce, 0x68, 0x16, 0x0d, 0xc3, 0x94, 0x66, 0x74, 0x94, 0x9b, 0xdd, 0x82, 0xae, 0x11, 0x9e, 0xa3,
+	0x4c, 0x2b, 0x65, 0xb2, 0x10, 0x71, 0x18, 0xb3, 0x88, 0x8f, 0x68, 0xb2, 0xd5, 0xc4, 0x2d, 0xba,
+	0x28, 0x3c, 0x34, 0x32, 0xff, 0x0f, 0x16, 0xc0, 0x81, 0x4c, 0x8a, 0x91, 0xc0, 0x68, 0xde, 0x03,
+	0xe7, 0x82, 0xb3, 0x24, 0x0e, 0x79, 0x5c, 0x46, 0xd4, 0x46, 0xfe, 0x24, 0x26, 0x5f, 0x80, 0x1b,
+	0x53, 0x45, 0x4d, 0x48, 0x1a, 0x9c, 0xde, 0x27, 0xef, 0xef, 0x2d, 0xe0, 0x5f, 0x22, 0x7f, 0x48,
+	0x15, 0xd5, 0x51, 0x06, 0x4e, 0x5c, 0x52, 0xe4, 0x3e, 0xf4, 0x78, 0x1e, 0xa6, 0x19, 0x1f, 0xd1,
+	0x6c, 0x12, 0xbe, 0x62, 0x13, 0xcc, 0xc9, 0x09, 0xba, 0x3c, 0x3f, 0x37, 0xc2, 0x9f, 0xb2, 0x09,
+	0xb9, 0x07, 0x2e, 0xcf, 0x43, 0x5a, 0x28, 0x79, 0x72, 0x88, 0x19, 0x39, 0x81, 0xc3, 0xf3, 0x3e,
+	0xf2, 0xfe, 0x0f, 0xab, 0x38, 0x9f, 0xbc, 0x4d, 0x33, 0xf2, 0x31, 0x34, 0xb8, 0xb8, 0x90, 0x18,
+	0x63, 0xe7, 0xdd, 0x38, 0xb0, 0x40, 0x66, 0x49, 0x05, 0xa8, 0xea, 0xef, 0x83, 0x8b, 0x25, 0x80,
+	0xf6, 0xdf, 0x85, 0xe6, 0x58, 0x33, 0xa5, 0x83, 0xed, 0x25, 0x0e, 0xe6, 0xcb, 0x26, 0x30, 0xda,
+	0xfe, 0x9f, 0x2c, 0xe8, 0x7d, 0x29, 0x68, 0x36, 0x09, 0xa8, 0xb8, 0x34, 0x9e, 0x7e, 0x00, 0x9d,
+	0x08, 0xb7, 0x0a, 0x6f, 0x1e, 0x10, 0x44, 0x33, 0xc4, 0xbf, 0x05, 0x75, 0x99, 0x96, 0x78, 0xbe,
+	0xb7, 0xc4, 0xec, 0x2c, 0x45, 0x2c, 0xeb, 0x32, 0x9d, 0x05, 0x6d, 0xaf, 0x15, 0xf4, 0x1f, 0xeb,
+	0xb0, 0xb9, 0xcf, 0x6f, 0x37, 0xea, 0x6f, 0xc2, 0x66, 0x22, 0xdf, 0xb0, 0x2c, 0xe4, 0x22, 0x4a,
+	0x8a, 0x9c, 0x8f, 0x4d, 0x49, 0x38, 0x41, 0x0f, 0xc5, 0x27, 0x95, 0x54, 0x2b, 0x16, 0x69, 0xba,
+	0xa0, 0x68, 0x8e, 0xbe, 0x87, 0xe2, 0x99, 0xe2, 0x8f, 0xa0, 0x63, 0x3c, 0x9a, 0x14, 0x1b, 0x37,
+	0x4b, 0x11, 0xd0, 0x06, 0x69, 0xed, 0xc1, 0x6c, 0x65, 0x3c, 0x34, 0x6f, 0xe8, 0x01, 0x6d, 0x90,
+	0xf6, 0xff, 0x66, 0x41, 0xe7, 0x40, 0x8e, 0x52, 0x9a, 0x19, 0x94, 0x8e, 0xc0, 0x4b, 0xd8, 0x85,
+	0x0a, 0xd7, 0x86, 0xaa, 0xa7, 0xcd, 0x66, 0x3c, 0x39, 0x81, 0x3b, 0x19, 0xbf, 0x1c, 0x2e, 0x7a,
+	0xaa, 0xdf, 0xc4, 0xd3, 0x26, 0xda, 0x1d, 0xbc, 0x5b, 0x2f, 0xf6, 0x0d, 0xea, 0xc5, 0xff, 0xa5,
+	0x05, 0xce, 0x0b, 0x96, 0x8d, 0x6e, 0xe5, 0xc4, 0x3f, 0x83, 0x16, 0xe2, 0x9a, 0x6f, 0xd5, 0x77,
+	0xec, 0x9b, 0x00, 0x5b, 0xaa, 0xeb, 0x16, 0xec, 0xe2, 0x9d, 0xc1, 0x30, 0x1e, 0x61, 0xf8, 0x16,
+	0x86, 0x7f, 0x7f, 0x89, 0x8b, 0xa9, 0xa6, 0xa1, 0xce, 0x52, 0xac, 0xfc, 0x07, 0xd0, 0x8c, 0x86,
+	0x3c, 0x89, 0x4b, 0xcc, 0xbe, 0xba, 0xc4, 0x50, 0xdb, 0x04, 0x46, 0xcb, 0xdf, 0x86, 0x76, 0x69,
+	0x4d, 0x3a, 0xd0, 0x3e, 0x11, 0x63, 0x9a, 0xf0, 0xd8, 0xab, 0x91, 0x36, 0xd8, 0xa7, 0x52, 0x79,
+	0x96, 0xff, 0x4f, 0x0b, 0xc0, 0x5c, 0x09, 0x0c, 0xea, 0xf1, 0x5c, 0x50, 0xdf, 0x58, 0xe2, 0x7b,
+	0xa6, 0x5a, 0x92, 0x65, 0x58, 0xdf, 0x86, 0x86, 0x3e, 0xe8, 0xeb, 0xa2, 0x42, 0x25, 0x9d, 0x03,
+	0x9e, 0xe5, 0x96, 0x7d, 0xb5, 0xb6, 0xd1, 0xf2, 0x1f, 0x83, 0xb3, 0xcf, 0x97, 0x25, 0xd1, 0x03,
+	0x78, 0x2a, 0x2f, 0x79, 0x44, 0x93, 0xbe, 0x88, 0x3d, 0x8b, 0x6c, 0x80, 0x5b, 0xf2, 0x67, 0x99,
+	0x57, 0xf7, 0xff, 0x6e, 0xc1, 0x86, 0x31, 0xec, 0x67, 0x5c, 0x0d, 0xcf, 0xd2, 0xff, 0xfa, 0xe4,
+	0x3f, 0x07, 0x87, 0x6a, 0x57, 0xe1, 0xb4, 0x4f, 0x7d, 0xb0, 0xc4, 0xb8, 0xdc, 0x0d, 0x8b, 0xaf,
+	0x4d, 0xcb, 0xad, 0x0f, 0x61, 0xc3, 0xd4, 0xbd, 0x4c, 0x59, 0x46, 0x45, 0x7c, 0xd3, 0xce, 0xd5,
+	0x45, 0xab, 0x33, 0x63, 0xe4, 0xff, 0xde, 0xaa, 0x1a, 0x18, 0x6e, 0x82, 0x47, 0x56, 0x41, 0x6f,
+	0xad, 0x05, 0x7d, 0xfd, 0x26, 0xd0, 0x93, 0xbd, 0xb9, 0x2b, 0x76, 0x5d, 0xaa, 0xfa, 0x9e, 0xfd,
+	0xa5, 0x0e, 0x77, 0x17, 0x20, 0x7f, 0x32, 0xa6, 0xc9, 0xed, 0xf5, 0xda, 0xff, 0x37, 0xfe, 0x65,
+	0xcb, 0x69, 0xac, 0x35, 0xa2, 0x9a, 0x6b, 0x8d, 0xa8, 0x7f, 0x58, 0xd0, 0xfd, 0xc9, 0xf3, 0xb3,
+	0xd3, 0x73, 0xaa, 0x86, 0xb7, 0x82, 0x19, 0x81, 0x46, 0x4a, 0xd5, 0x10, 0x7b, 0x95, 0x1b, 0x20,
+	0xbd, 0x46, 0xe7, 0x9c, 0xa5, 0xd1, 0x58, 0x27, 0x0d, 0xe2, 0x81, 0x2d, 0xa4, 0xc2, 0xdc, 0x9d,
+	0x40, 0x93, 0xfe, 0xbf, 0xea, 0xe0, 0xf6, 0xb3, 0x8c, 0x4e, 0x6e, 0x25, 0xab, 0x47, 0x73, 0xdf,
+	0x0a, 0xf7, 0x97, 0xd6, 0x40, 0xb9, 0x93, 0xa1, 0xca, 0x2e, 0x35, 0xeb, 0xdc, 0xf6, 0x5a, 0x9d,
+	0x9b, 0x3c, 0x06, 0x37, 0x61, 0xe2, 0xd2, 0x54, 0xde, 0xb5, 0xc7, 0xef, 0x18, 0xdd, 0xb3, 0x94,
+	0x7c, 0x05, 0x5a, 0x86, 0x2e, 0xbf, 0x38, 0x4b, 0xae, 0x82, 0xa7, 0x35, 0x83, 0xa7, 0x0f, 0xed,
+	0x32, 0xd2, 0xc5, 0x1e, 0xd7, 0x05, 0xe7, 0x40, 0x0a, 0x45, 0xb9, 0xc8, 0x3d, 0x8b, 0x6c, 0x42,
+	0xa7, 0xe2, 0xfa, 0x62, 0xe2, 0xd5, 0x09, 0x40, 0xeb, 0x29, 0xba, 0xf4, 0x6c, 0xff, 0xaf, 0x16,
+	0x38, 0xa7, 0x45, 0x92, 0xdc, 0x0a, 0xc0, 0x9f, 0xcc, 0x01, 0xec, 0x2f, 0x31, 0xab, 0x36, 0x42,
+	0xa2, 0x84, 0xb7, 0x2a, 0x35, 0x7b, 0x56, 0x6a, 0xfe, 0x77, 0xa0, 0x65, 0x34, 0x16, 0xd3, 0x02,
+	0x68, 0x9d, 0xe4, 0x7a, 0xc1, 0xb4, 0xed, 0x93, 0xfc, 0x54, 0x2a, 0x64, 0xeb, 0xfe, 0x6f, 0x2d,
+	0xf0, 0xf0, 0x0a, 0xcf, 0x7f, 0x7f, 0xfc, 0x2f, 0x9b, 0xdc, 0x1a, 0xdf, 0x11, 0xbf, 0x71, 0xa0,
+	0x81, 0xf1, 0x7c, 0x01, 0xae, 0x62, 0xd9, 0x28, 0x64, 0x6f, 0xd3, 0xac, 0x0c, 0xea, 0xde, 0x12,
+	0xd3, 0xea, 0x9b, 0x43, 0xbf, 0x8e, 0x54, 0x49, 0x93, 0xef, 0x03, 0x14, 0xba, 0x45, 0x1a, 0x63,
+	0x13, 0xe3, 0xd7, 0xae, 0xfa, 0x00, 0xd0, 0x6f, 0xa7, 0xa2, 0x62, 0xf4, 0xc7, 0xdd, 0x80, 0xcf,
+	0xec, 0xed, 0x95, 0x27, 0x3b, 0x9b, 0xd5, 0xc7, 0xb5, 0x00, 0x06, 0x53, 0x8e, 0x1c, 0x40, 0x37,
+	0x32, 0xd8, 0x1a, 0x17, 0xe6, 0x6a, 0x7f, 0xb0, 0xb4, 0x38, 0xa6, 0x47, 0x70, 0x5c, 0x0b, 0x3a,
+	0xd1, 0x8c, 0x25, 0xcf, 0xc0, 0x33, 0x59, 0x64, 0xba, 0xbd, 0x1b, 0x47, 0xa6, 0xd5, 0x7d, 0x7d,
+	0x55, 0x2e, 0xd3, 0x41, 0x70, 0x5c, 0x0b, 0x7a, 0xc5, 0x82, 0x84, 0x9c, 0xc3, 0x9d, 0x01, 0x7f,
+	0xd7, 0x5f, 0x0b, 0xfd, 0xf9, 0x2b, 0x73, 0x9b, 0x77, 0xb8, 0x39, 0x58, 0x14, 0x11, 0x05, 0xdb,
+	0xa5, 0xc7, 0x6a, 0x66, 0x84, 0x6c, 0x4c, 0x93, 0x79, 0xff, 0x6d, 0xf4, 0xff, 0x60, 0xa5, 0xff,
+	0x65, 0x43, 0xec, 0xb8, 0x16, 0xdc, 0x1d, 0xac, 0x5c, 0x9d, 0xcb, 0xc3, 0xec, 0x8a, 0xfb, 0x38,
+	0xd7, 0xe4, 0x31, 0x1d, 0xe6, 0xb3, 0x3c, 0xa6, 0x22, 0x5d, 0x2e, 0xd8, 0x85, 0x8c, 0x2b, 0x77,
+	0x65, 0xb9, 0x4c, 0x9f, 0x74, 0xba, 0x5c, 0xc6, 0x15, 0xa3, 0xcb, 0xa5, 0x6c, 0x04, 0x68, 0x0f,
+	0xd7, 0x34, 0x82, 0xaa, 0x5c, 0xa2, 0x29, 0x47, 0x8e, 0xa0, 0xf7, 0x8b, 0x5c, 0x8a, 0x50, 0xdf,
+	0x67, 0xe3, 0xa4, 0xb3, 0x72, 0x16, 0xcc, 0x8f, 0xae, 0xe3, 0x5a, 0xd0, 0xd5, 0x86, 0xe7, 0x74,
+	0x96, 0x09, 0xd5, 0x3d, 0xce, 0x38, 0xe9, 0xae, 0xcc, 0x64, 0xda, 0xbc, 0x75, 0x26, 0xb4, 0x62,
+	0xf4, 0x9d, 0x13, 0x45, 0x92, 0x18, 0xeb, 0x8d, 0x95, 0x77, 0xae, 0xea, 0x4c, 0xfa, 0xce, 0x89,
+	0x92, 0x26, 0xcf, 0x81, 0x98, 0xf3, 0x58, 0x28, 0xfc, 0x1e, 0x3a, 0xf9, 0x70, 0xd5, 0x37, 0xc4,
+	0x62, 0xf5, 0x7b, 0xf4, 0x1d, 0xd9, 0x7e, 0x0b, 0x1a, 0xda, 0x8d, 0xff, 0x6f, 0x0b, 0xe0, 0x25,
+	0x8b, 0x94, 0xcc, 0xfa, 0xa7, 0xa7, 0xcf, 0xcb, 0xc7, 0xbb, 0x39, 0xc6, 0x2d, 0xab, 0x7a, 0xbc,
+	0x9b, 0x93, 0x5e, 0xf8, 0xad, 0x50, 0x5f, 0xfc, 0xad, 0xf0, 0x19, 0x40, 0x9a, 0xb1, 0x98, 0x47,
+	0x54, 0xe1, 0x84, 0xba, 0xb2, 0x77, 0xcd, 0xa9, 0x92, 0xef, 0x01, 0xbc, 0xd6, 0x7f, 0x51, 0x4c,
+	0xab, 0x6f, 0xac, 0xc4, 0x75, 0xfa, 0xab, 0x25, 0x70, 0x5f, 0x57, 0xa4, 0x7e, 0x96, 0xa6, 0x09,
+	0x8d, 0xd8, 0x50, 0x26, 0x31, 0xcb, 0x42, 0x45, 0x2f, 0xf1, 0x1a, 0xbb, 0x41, 0x6f, 0x4e, 0xfc,
+	0x82, 0x5e, 0xfa, 0x7f, 0xb6, 0xc0, 0x39, 0x4f, 0xa8, 0x38, 0x95, 0x31, 0xbe, 0x30, 0xc7, 0x98,
+	0x71, 0x48, 0x85, 0xc8, 0xaf, 0x18, 0x2f, 0x33, 0x5c, 0x74, 0x55, 0x19, 0x9b, 0xbe, 0x10, 0x39,
+	0xf9, 0x7c, 0x21, 0xdb, 0xab, 0x3b, 0xb5, 0x36, 0x9d, 0xcb, 0x77, 0x17, 0x3c, 0x59, 0xa8, 0xb4,
+	0x50, 0x61, 0x05, 0xa5, 0x19, 0xe8, 0x76, 0xd0, 0x33, 0xf2, 0x1f, 0x1b, 0x44, 0x73, 0x7d, 0x42,
+	0x42, 0xc6, 0xec, 0xa3, 0xdf, 0x59, 0xd0, 0x32, 0x6d, 0x7c, 0x71, 0x0c, 0x6d, 0x42, 0xe7, 0x28,
+	0x63, 0x54, 0xb1, 0xec, 0xc5, 0x90, 0x0a, 0xcf, 0x22, 0x1e, 0x74, 0x4b, 0xc1, 0x93, 0xd7, 0x05,
+	0x4d, 0xbc, 0xba, 0x1e, 0xc0, 0x4f, 0x59, 0x9e, 0xe3, 0xba, 0x8d, 0x4f, 0x0c, 0x96, 0xe7, 0x66,
+	0xb1, 0x41, 0x5c, 0x68, 0x1a, 0xb2, 0xa9, 0xf5, 0x4e, 0xa5, 0x32, 0x5c, 0x4b, 0x3b, 0x3e, 0xcf,
+	0xd8, 0x05, 0x7f, 0xfb, 0x8c, 0xaa, 0x68, 0xe8, 0xb5, 0xb5, 0xe3, 0x73, 0x99, 0xab, 0xa9, 0xc4,
+	0xd1, 0xb6, 0x86, 0x74, 0x3f, 0x3a, 0x82, 0xce, 0xdc, 0x47, 0xab, 0x0e, 0xf1, 0x4b, 0xf1, 0x4a,
+	0xc8, 0x37, 0xc2, 0xbc, 0xd4, 0xfa, 0xb1, 0x7e, 0xdd, 0xb4, 0xc1, 0x7e, 0x5e, 0x0c, 0xbc, 0xba,
+	0x26, 0x9e, 0x15, 0x89, 0x67, 0x6b, 0xe2, 0x90, 0x8f, 0xbd, 0x06, 0x4a, 0x64, 0xec, 0x35, 0xf7,
+	0x3f, 0xfd, 0xf9, 0xc7, 0x97, 0x5c, 0x0d, 0x8b, 0xc1, 0x5e, 0x24, 0x47, 0x0f, 0x0d, 0x98, 0x0f,
+	0xb8, 0x2c, 0xa9, 0x87, 0x5c, 0x28, 0x96, 0x09, 0x9a, 0x3c, 0x44, 0x7c, 0x1f, 0x6a, 0x7c, 0xd3,
+	0xc1, 0xa0, 0x85, 0xdc, 0xa7, 0xff, 0x19, 0x00, 0x17, 0x2f, 0x73, 0x44, 0x4f, 0x14, 0x00, 0x00,
 }
@@ -368,6 +368,7 @@ type LoadCollectionRequest struct {
 	CollectionID         int64                      `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
 	ReplicaNumber        int32                      `protobuf:"varint,5,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	LoadFields           []string                   `protobuf:"bytes,6,rep,name=load_fields,json=loadFields,proto3" json:"load_fields,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -433,6 +434,13 @@ func (m *LoadCollectionRequest) GetReplicaNumber() int32 {
 	return 0
 }
 
+func (m *LoadCollectionRequest) GetLoadFields() []string {
+	if m != nil {
+		return m.LoadFields
+	}
+	return nil
+}
+
 type ReleaseCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -503,6 +511,7 @@ type LoadPartitionsRequest struct {
 	PartitionIDs         []int64                    `protobuf:"varint,4,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
 	ReplicaNumber        int32                      `protobuf:"varint,6,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	LoadFields           []string                   `protobuf:"bytes,7,rep,name=load_fields,json=loadFields,proto3" json:"load_fields,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -575,6 +584,123 @@ func (m *LoadPartitionsRequest) GetReplicaNumber() int32 {
 	return 0
 }
 
+func (m *LoadPartitionsRequest) GetLoadFields() []string {
+	if m != nil {
+		return m.LoadFields
+	}
+	return nil
+}
+
+type SyncNewCreatedPartitionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SyncNewCreatedPartitionRequest) Reset()         { *m = SyncNewCreatedPartitionRequest{} }
+func (m *SyncNewCreatedPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncNewCreatedPartitionRequest) ProtoMessage()    {}
+func (*SyncNewCreatedPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{7}
+}
+
+func (m *SyncNewCreatedPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncNewCreatedPartitionRequest.Unmarshal(m, b)
+}
+func (m *SyncNewCreatedPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncNewCreatedPartitionRequest.Marshal(b, m, deterministic)
+}
+func (m *SyncNewCreatedPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncNewCreatedPartitionRequest.Merge(m, src)
+}
+func (m *SyncNewCreatedPartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_SyncNewCreatedPartitionRequest.Size(m)
+}
+func (m *SyncNewCreatedPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncNewCreatedPartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncNewCreatedPartitionRequest proto.InternalMessageInfo
+
+func (m *SyncNewCreatedPartitionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SyncNewCreatedPartitionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SyncNewCreatedPartitionRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+type RefreshCollectionRequest struct {
+	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64                      `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Schema               *schemapb.CollectionSchema `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *RefreshCollectionRequest) Reset()         { *m = RefreshCollectionRequest{} }
+func (m *RefreshCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshCollectionRequest) ProtoMessage()    {}
+func (*RefreshCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{8}
+}
+
+func (m *RefreshCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshCollectionRequest.Unmarshal(m, b)
+}
+func (m *RefreshCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *RefreshCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshCollectionRequest.Merge(m, src)
+}
+func (m *RefreshCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshCollectionRequest.Size(m)
+}
+func (m *RefreshCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshCollectionRequest proto.InternalMessageInfo
+
+func (m *RefreshCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RefreshCollectionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *RefreshCollectionRequest) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
 type ReleasePartitionsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -590,7 +716,7 @@ func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsReque
 func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleasePartitionsRequest) ProtoMessage()    {}
 func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{7}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{9}
 }
 
 func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -646,6 +772,69 @@ func (m *ReleasePartitionsRequest) GetNodeID() int64 {
 	return 0
 }
 
+type ReleaseChannelsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	CollectionID         int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Channels             []string          `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReleaseChannelsRequest) Reset()         { *m = ReleaseChannelsRequest{} }
+func (m *ReleaseChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseChannelsRequest) ProtoMessage()    {}
+func (*ReleaseChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{10}
+}
+
+func (m *ReleaseChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseChannelsRequest.Unmarshal(m, b)
+}
+func (m *ReleaseChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseChannelsRequest.Marshal(b, m, deterministic)
+}
+func (m *ReleaseChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseChannelsRequest.Merge(m, src)
+}
+func (m *ReleaseChannelsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseChannelsRequest.Size(m)
+}
+func (m *ReleaseChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseChannelsRequest proto.InternalMessageInfo
+
+func (m *ReleaseChannelsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ReleaseChannelsRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ReleaseChannelsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ReleaseChannelsRequest) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
 type CreateQueryChannelRequest struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	ProxyID              int64    `protobuf:"varint,2,opt,name=proxyID,proto3" json:"proxyID,omitempty"`
@@ -658,7 +847,7 @@ func (m *CreateQueryChannelRequest) Reset()         { *m = CreateQueryChannelReq
 func (m *CreateQueryChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateQueryChannelRequest) ProtoMessage()    {}
 func (*CreateQueryChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{8}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{11}
 }
 
 func (m *CreateQueryChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -706,7 +895,7 @@ func (m *CreateQueryChannelResponse) Reset()         { *m = CreateQueryChannelRe
 func (m *CreateQueryChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateQueryChannelResponse) ProtoMessage()    {}
 func (*CreateQueryChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{9}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{12}
 }
 
 func (m *CreateQueryChannelResponse) XXX_Unmarshal(b []byte) error {
@@ -762,7 +951,7 @@ func (m *GetPartitionStatesRequest) Reset()         { *m = GetPartitionStatesReq
 func (m *GetPartitionStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatesRequest) ProtoMessage()    {}
 func (*GetPartitionStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{10}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{13}
 }
 
 func (m *GetPartitionStatesRequest) XXX_Unmarshal(b []byte) error {
@@ -823,7 +1012,7 @@ func (m *GetPartitionStatesResponse) Reset()         { *m = GetPartitionStatesRe
 func (m *GetPartitionStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatesResponse) ProtoMessage()    {}
 func (*GetPartitionStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{11}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{14}
 }
 
 func (m *GetPartitionStatesResponse) XXX_Unmarshal(b []byte) error {
@@ -871,7 +1060,7 @@ func (m *GetSegmentInfoRequest) Reset()         { *m = GetSegmentInfoRequest{} }
 func (m *GetSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSegmentInfoRequest) ProtoMessage()    {}
 func (*GetSegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{12}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{15}
 }
 
 func (m *GetSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -925,7 +1114,7 @@ func (m *GetSegmentInfoResponse) Reset()         { *m = GetSegmentInfoResponse{}
 func (m *GetSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSegmentInfoResponse) ProtoMessage()    {}
 func (*GetSegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{13}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{16}
 }
 
 func (m *GetSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -972,7 +1161,7 @@ func (m *GetShardLeadersRequest) Reset()         { *m = GetShardLeadersRequest{}
 func (m *GetShardLeadersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetShardLeadersRequest) ProtoMessage()    {}
 func (*GetShardLeadersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{14}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{17}
 }
 
 func (m *GetShardLeadersRequest) XXX_Unmarshal(b []byte) error {
@@ -1019,7 +1208,7 @@ func (m *GetShardLeadersResponse) Reset()         { *m = GetShardLeadersResponse
 func (m *GetShardLeadersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetShardLeadersResponse) ProtoMessage()    {}
 func (*GetShardLeadersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{15}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{18}
 }
 
 func (m *GetShardLeadersResponse) XXX_Unmarshal(b []byte) error {
@@ -1054,6 +1243,100 @@ func (m *GetShardLeadersResponse) GetShards() []*ShardLeadersList {
 	return nil
 }
 
+type GetLoadingProgressRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetLoadingProgressRequest) Reset()         { *m = GetLoadingProgressRequest{} }
+func (m *GetLoadingProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLoadingProgressRequest) ProtoMessage()    {}
+func (*GetLoadingProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{19}
+}
+
+func (m *GetLoadingProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoadingProgressRequest.Unmarshal(m, b)
+}
+func (m *GetLoadingProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoadingProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLoadingProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoadingProgressRequest.Merge(m, src)
+}
+func (m *GetLoadingProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLoadingProgressRequest.Size(m)
+}
+func (m *GetLoadingProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoadingProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoadingProgressRequest proto.InternalMessageInfo
+
+func (m *GetLoadingProgressRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetLoadingProgressRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetReleaseJobsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetReleaseJobsRequest) Reset()         { *m = GetReleaseJobsRequest{} }
+func (m *GetReleaseJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReleaseJobsRequest) ProtoMessage()    {}
+func (*GetReleaseJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{20}
+}
+
+func (m *GetReleaseJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReleaseJobsRequest.Unmarshal(m, b)
+}
+func (m *GetReleaseJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReleaseJobsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReleaseJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReleaseJobsRequest.Merge(m, src)
+}
+func (m *GetReleaseJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReleaseJobsRequest.Size(m)
+}
+func (m *GetReleaseJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReleaseJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReleaseJobsRequest proto.InternalMessageInfo
+
+func (m *GetReleaseJobsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetReleaseJobsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
 type ShardLeadersList struct {
 	ChannelName          string   `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
 	NodeIds              []int64  `protobuf:"varint,2,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
@@ -1067,7 +1350,7 @@ func (m *ShardLeadersList) Reset()         { *m = ShardLeadersList{} }
 func (m *ShardLeadersList) String() string { return proto.CompactTextString(m) }
 func (*ShardLeadersList) ProtoMessage()    {}
 func (*ShardLeadersList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{16}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{21}
 }
 
 func (m *ShardLeadersList) XXX_Unmarshal(b []byte) error {
@@ -1127,7 +1410,7 @@ func (m *AddQueryChannelRequest) Reset()         { *m = AddQueryChannelRequest{}
 func (m *AddQueryChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*AddQueryChannelRequest) ProtoMessage()    {}
 func (*AddQueryChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{17}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
 }
 
 func (m *AddQueryChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -1212,7 +1495,7 @@ func (m *RemoveQueryChannelRequest) Reset()         { *m = RemoveQueryChannelReq
 func (m *RemoveQueryChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveQueryChannelRequest) ProtoMessage()    {}
 func (*RemoveQueryChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{18}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
 }
 
 func (m *RemoveQueryChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -1281,7 +1564,7 @@ func (m *LoadMetaInfo) Reset()         { *m = LoadMetaInfo{} }
 func (m *LoadMetaInfo) String() string { return proto.CompactTextString(m) }
 func (*LoadMetaInfo) ProtoMessage()    {}
 func (*LoadMetaInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{19}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
 }
 
 func (m *LoadMetaInfo) XXX_Unmarshal(b []byte) error {
@@ -1342,7 +1625,7 @@ func (m *WatchDmChannelsRequest) Reset()         { *m = WatchDmChannelsRequest{}
 func (m *WatchDmChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchDmChannelsRequest) ProtoMessage()    {}
 func (*WatchDmChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{20}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
 }
 
 func (m *WatchDmChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -1442,7 +1725,7 @@ func (m *WatchDeltaChannelsRequest) Reset()         { *m = WatchDeltaChannelsReq
 func (m *WatchDeltaChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchDeltaChannelsRequest) ProtoMessage()    {}
 func (*WatchDeltaChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{21}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
 }
 
 func (m *WatchDeltaChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -1528,7 +1811,7 @@ func (m *SegmentLoadInfo) Reset()         { *m = SegmentLoadInfo{} }
 func (m *SegmentLoadInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentLoadInfo) ProtoMessage()    {}
 func (*SegmentLoadInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
 }
 
 func (m *SegmentLoadInfo) XXX_Unmarshal(b []byte) error {
@@ -1658,7 +1941,7 @@ func (m *FieldIndexInfo) Reset()         { *m = FieldIndexInfo{} }
 func (m *FieldIndexInfo) String() string { return proto.CompactTextString(m) }
 func (*FieldIndexInfo) ProtoMessage()    {}
 func (*FieldIndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
 }
 
 func (m *FieldIndexInfo) XXX_Unmarshal(b []byte) error {
@@ -1753,7 +2036,7 @@ func (m *LoadSegmentsRequest) Reset()         { *m = LoadSegmentsRequest{} }
 func (m *LoadSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadSegmentsRequest) ProtoMessage()    {}
 func (*LoadSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
 }
 
 func (m *LoadSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1847,7 +2130,7 @@ func (m *ReleaseSegmentsRequest) Reset()         { *m = ReleaseSegmentsRequest{}
 func (m *ReleaseSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleaseSegmentsRequest) ProtoMessage()    {}
 func (*ReleaseSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
 }
 
 func (m *ReleaseSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1924,7 +2207,7 @@ func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{31}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -1987,7 +2270,7 @@ func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{32}
 }
 
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
@@ -2049,7 +2332,7 @@ func (m *SyncReplicaSegmentsRequest) Reset()         { *m = SyncReplicaSegmentsR
 func (m *SyncReplicaSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncReplicaSegmentsRequest) ProtoMessage()    {}
 func (*SyncReplicaSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{33}
 }
 
 func (m *SyncReplicaSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2091,45 +2374,179 @@ func (m *SyncReplicaSegmentsRequest) GetReplicaSegments() []*ReplicaSegmentsInfo
 	return nil
 }
 
-type ReplicaSegmentsInfo struct {
-	NodeId               int64    `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	PartitionId          int64    `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
-	SegmentIds           []int64  `protobuf:"varint,3,rep,packed,name=segment_ids,json=segmentIds,proto3" json:"segment_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type DrainRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ReplicaSegmentsInfo) Reset()         { *m = ReplicaSegmentsInfo{} }
-func (m *ReplicaSegmentsInfo) String() string { return proto.CompactTextString(m) }
-func (*ReplicaSegmentsInfo) ProtoMessage()    {}
-func (*ReplicaSegmentsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
+func (m *DrainRequest) Reset()         { *m = DrainRequest{} }
+func (m *DrainRequest) String() string { return proto.CompactTextString(m) }
+func (*DrainRequest) ProtoMessage()    {}
+func (*DrainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{34}
 }
 
-func (m *ReplicaSegmentsInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReplicaSegmentsInfo.Unmarshal(m, b)
+func (m *DrainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DrainRequest.Unmarshal(m, b)
 }
-func (m *ReplicaSegmentsInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReplicaSegmentsInfo.Marshal(b, m, deterministic)
+func (m *DrainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DrainRequest.Marshal(b, m, deterministic)
 }
-func (m *ReplicaSegmentsInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplicaSegmentsInfo.Merge(m, src)
+func (m *DrainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainRequest.Merge(m, src)
 }
-func (m *ReplicaSegmentsInfo) XXX_Size() int {
-	return xxx_messageInfo_ReplicaSegmentsInfo.Size(m)
+func (m *DrainRequest) XXX_Size() int {
+	return xxx_messageInfo_DrainRequest.Size(m)
 }
-func (m *ReplicaSegmentsInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplicaSegmentsInfo.DiscardUnknown(m)
+func (m *DrainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplicaSegmentsInfo proto.InternalMessageInfo
+var xxx_messageInfo_DrainRequest proto.InternalMessageInfo
 
-func (m *ReplicaSegmentsInfo) GetNodeId() int64 {
+func (m *DrainRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.NodeId
+		return m.Base
 	}
-	return 0
+	return nil
+}
+
+type DrainResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// one of none, draining, handed_off and safe_to_terminate
+	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrainResponse) Reset()         { *m = DrainResponse{} }
+func (m *DrainResponse) String() string { return proto.CompactTextString(m) }
+func (*DrainResponse) ProtoMessage()    {}
+func (*DrainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{35}
+}
+
+func (m *DrainResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DrainResponse.Unmarshal(m, b)
+}
+func (m *DrainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DrainResponse.Marshal(b, m, deterministic)
+}
+func (m *DrainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainResponse.Merge(m, src)
+}
+func (m *DrainResponse) XXX_Size() int {
+	return xxx_messageInfo_DrainResponse.Size(m)
+}
+func (m *DrainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainResponse proto.InternalMessageInfo
+
+func (m *DrainResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DrainResponse) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+type GetSegmentLoadingProgressResponse struct {
+	Status               *commonpb.Status                   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Segments             []*milvuspb.SegmentLoadingProgress `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *GetSegmentLoadingProgressResponse) Reset()         { *m = GetSegmentLoadingProgressResponse{} }
+func (m *GetSegmentLoadingProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentLoadingProgressResponse) ProtoMessage()    {}
+func (*GetSegmentLoadingProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{36}
+}
+
+func (m *GetSegmentLoadingProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentLoadingProgressResponse.Unmarshal(m, b)
+}
+func (m *GetSegmentLoadingProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentLoadingProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentLoadingProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentLoadingProgressResponse.Merge(m, src)
+}
+func (m *GetSegmentLoadingProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentLoadingProgressResponse.Size(m)
+}
+func (m *GetSegmentLoadingProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentLoadingProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentLoadingProgressResponse proto.InternalMessageInfo
+
+func (m *GetSegmentLoadingProgressResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetSegmentLoadingProgressResponse) GetSegments() []*milvuspb.SegmentLoadingProgress {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type ReplicaSegmentsInfo struct {
+	NodeId               int64    `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	PartitionId          int64    `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	SegmentIds           []int64  `protobuf:"varint,3,rep,packed,name=segment_ids,json=segmentIds,proto3" json:"segment_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplicaSegmentsInfo) Reset()         { *m = ReplicaSegmentsInfo{} }
+func (m *ReplicaSegmentsInfo) String() string { return proto.CompactTextString(m) }
+func (*ReplicaSegmentsInfo) ProtoMessage()    {}
+func (*ReplicaSegmentsInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{37}
+}
+
+func (m *ReplicaSegmentsInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicaSegmentsInfo.Unmarshal(m, b)
+}
+func (m *ReplicaSegmentsInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicaSegmentsInfo.Marshal(b, m, deterministic)
+}
+func (m *ReplicaSegmentsInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaSegmentsInfo.Merge(m, src)
+}
+func (m *ReplicaSegmentsInfo) XXX_Size() int {
+	return xxx_messageInfo_ReplicaSegmentsInfo.Size(m)
+}
+func (m *ReplicaSegmentsInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaSegmentsInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaSegmentsInfo proto.InternalMessageInfo
+
+func (m *ReplicaSegmentsInfo) GetNodeId() int64 {
+	if m != nil {
+		return m.NodeId
+	}
+	return 0
 }
 
 func (m *ReplicaSegmentsInfo) GetPartitionId() int64 {
@@ -2159,7 +2576,7 @@ func (m *HandoffSegmentsRequest) Reset()         { *m = HandoffSegmentsRequest{}
 func (m *HandoffSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*HandoffSegmentsRequest) ProtoMessage()    {}
 func (*HandoffSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{38}
 }
 
 func (m *HandoffSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2210,7 +2627,7 @@ func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
 func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceRequest) ProtoMessage()    {}
 func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{31}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{39}
 }
 
 func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -2288,7 +2705,7 @@ func (m *DmChannelWatchInfo) Reset()         { *m = DmChannelWatchInfo{} }
 func (m *DmChannelWatchInfo) String() string { return proto.CompactTextString(m) }
 func (*DmChannelWatchInfo) ProtoMessage()    {}
 func (*DmChannelWatchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{32}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{40}
 }
 
 func (m *DmChannelWatchInfo) XXX_Unmarshal(b []byte) error {
@@ -2359,7 +2776,7 @@ func (m *QueryChannelInfo) Reset()         { *m = QueryChannelInfo{} }
 func (m *QueryChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*QueryChannelInfo) ProtoMessage()    {}
 func (*QueryChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{33}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{41}
 }
 
 func (m *QueryChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -2428,7 +2845,7 @@ func (m *PartitionStates) Reset()         { *m = PartitionStates{} }
 func (m *PartitionStates) String() string { return proto.CompactTextString(m) }
 func (*PartitionStates) ProtoMessage()    {}
 func (*PartitionStates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{34}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{42}
 }
 
 func (m *PartitionStates) XXX_Unmarshal(b []byte) error {
@@ -2495,7 +2912,7 @@ func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
 func (m *SegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentInfo) ProtoMessage()    {}
 func (*SegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{35}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{43}
 }
 
 func (m *SegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -2640,7 +3057,7 @@ func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
 func (m *CollectionInfo) String() string { return proto.CompactTextString(m) }
 func (*CollectionInfo) ProtoMessage()    {}
 func (*CollectionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{36}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{44}
 }
 
 func (m *CollectionInfo) XXX_Unmarshal(b []byte) error {
@@ -2736,7 +3153,7 @@ func (m *UnsubscribeChannels) Reset()         { *m = UnsubscribeChannels{} }
 func (m *UnsubscribeChannels) String() string { return proto.CompactTextString(m) }
 func (*UnsubscribeChannels) ProtoMessage()    {}
 func (*UnsubscribeChannels) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{37}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{45}
 }
 
 func (m *UnsubscribeChannels) XXX_Unmarshal(b []byte) error {
@@ -2783,7 +3200,7 @@ func (m *UnsubscribeChannelInfo) Reset()         { *m = UnsubscribeChannelInfo{}
 func (m *UnsubscribeChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*UnsubscribeChannelInfo) ProtoMessage()    {}
 func (*UnsubscribeChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{38}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{46}
 }
 
 func (m *UnsubscribeChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -2833,7 +3250,7 @@ func (m *SegmentChangeInfo) Reset()         { *m = SegmentChangeInfo{} }
 func (m *SegmentChangeInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentChangeInfo) ProtoMessage()    {}
 func (*SegmentChangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{39}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{47}
 }
 
 func (m *SegmentChangeInfo) XXX_Unmarshal(b []byte) error {
@@ -2894,7 +3311,7 @@ func (m *SealedSegmentsChangeInfo) Reset()         { *m = SealedSegmentsChangeIn
 func (m *SealedSegmentsChangeInfo) String() string { return proto.CompactTextString(m) }
 func (*SealedSegmentsChangeInfo) ProtoMessage()    {}
 func (*SealedSegmentsChangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{40}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{48}
 }
 
 func (m *SealedSegmentsChangeInfo) XXX_Unmarshal(b []byte) error {
@@ -2940,7 +3357,10 @@ func init() {
 	proto.RegisterType((*LoadCollectionRequest)(nil), "milvus.proto.query.LoadCollectionRequest")
 	proto.RegisterType((*ReleaseCollectionRequest)(nil), "milvus.proto.query.ReleaseCollectionRequest")
 	proto.RegisterType((*LoadPartitionsRequest)(nil), "milvus.proto.query.LoadPartitionsRequest")
+	proto.RegisterType((*SyncNewCreatedPartitionRequest)(nil), "milvus.proto.query.SyncNewCreatedPartitionRequest")
+	proto.RegisterType((*RefreshCollectionRequest)(nil), "milvus.proto.query.RefreshCollectionRequest")
 	proto.RegisterType((*ReleasePartitionsRequest)(nil), "milvus.proto.query.ReleasePartitionsRequest")
+	proto.RegisterType((*ReleaseChannelsRequest)(nil), "milvus.proto.query.ReleaseChannelsRequest")
 	proto.RegisterType((*CreateQueryChannelRequest)(nil), "milvus.proto.query.CreateQueryChannelRequest")
 	proto.RegisterType((*CreateQueryChannelResponse)(nil), "milvus.proto.query.CreateQueryChannelResponse")
 	proto.RegisterType((*GetPartitionStatesRequest)(nil), "milvus.proto.query.GetPartitionStatesRequest")
@@ -2949,6 +3369,8 @@ func init() {
 	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.query.GetSegmentInfoResponse")
 	proto.RegisterType((*GetShardLeadersRequest)(nil), "milvus.proto.query.GetShardLeadersRequest")
 	proto.RegisterType((*GetShardLeadersResponse)(nil), "milvus.proto.query.GetShardLeadersResponse")
+	proto.RegisterType((*GetLoadingProgressRequest)(nil), "milvus.proto.query.GetLoadingProgressRequest")
+	proto.RegisterType((*GetReleaseJobsRequest)(nil), "milvus.proto.query.GetReleaseJobsRequest")
 	proto.RegisterType((*ShardLeadersList)(nil), "milvus.proto.query.ShardLeadersList")
 	proto.RegisterType((*AddQueryChannelRequest)(nil), "milvus.proto.query.AddQueryChannelRequest")
 	proto.RegisterType((*RemoveQueryChannelRequest)(nil), "milvus.proto.query.RemoveQueryChannelRequest")
@@ -2962,6 +3384,9 @@ func init() {
 	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.query.SearchRequest")
 	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.query.QueryRequest")
 	proto.RegisterType((*SyncReplicaSegmentsRequest)(nil), "milvus.proto.query.SyncReplicaSegmentsRequest")
+	proto.RegisterType((*DrainRequest)(nil), "milvus.proto.query.DrainRequest")
+	proto.RegisterType((*DrainResponse)(nil), "milvus.proto.query.DrainResponse")
+	proto.RegisterType((*GetSegmentLoadingProgressResponse)(nil), "milvus.proto.query.GetSegmentLoadingProgressResponse")
 	proto.RegisterType((*ReplicaSegmentsInfo)(nil), "milvus.proto.query.ReplicaSegmentsInfo")
 	proto.RegisterType((*HandoffSegmentsRequest)(nil), "milvus.proto.query.HandoffSegmentsRequest")
 	proto.RegisterType((*LoadBalanceRequest)(nil), "milvus.proto.query.LoadBalanceRequest")
@@ -2979,188 +3404,209 @@ func init() {
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 2884 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0xcb, 0x8f, 0x1c, 0x47,
-	0xf9, 0xdb, 0xf3, 0xda, 0x99, 0x6f, 0x9e, 0xae, 0xb5, 0x37, 0xe3, 0xf9, 0xd9, 0x89, 0xd3, 0x8e,
-	0x1d, 0xff, 0x1c, 0xb2, 0x36, 0x1b, 0x40, 0x89, 0x80, 0x43, 0xbc, 0x8b, 0x37, 0x4b, 0xec, 0xcd,
-	0xa6, 0xd7, 0x0e, 0x60, 0x45, 0x6a, 0x7a, 0xa6, 0x6b, 0x77, 0x5b, 0xe9, 0xc7, 0xb8, 0xab, 0xc7,
-	0xf6, 0xe6, 0x8c, 0x90, 0x78, 0x09, 0x71, 0xe2, 0x82, 0x72, 0x02, 0x01, 0x12, 0x11, 0x20, 0x71,
-	0xc9, 0x05, 0x21, 0x2e, 0x5c, 0xf9, 0x0b, 0x10, 0x37, 0xfe, 0x02, 0x8e, 0x48, 0xa8, 0x5e, 0x3d,
-	0xfd, 0xa8, 0xde, 0x99, 0xdd, 0xc1, 0x71, 0x84, 0xb8, 0x75, 0x7d, 0xfd, 0x55, 0x7d, 0xcf, 0xfa,
-	0x1e, 0x55, 0x05, 0x67, 0x1e, 0x4e, 0x70, 0x78, 0x64, 0x8e, 0x82, 0x20, 0xb4, 0xd7, 0xc6, 0x61,
-	0x10, 0x05, 0x08, 0x79, 0x8e, 0xfb, 0x68, 0x42, 0xf8, 0x68, 0x8d, 0xfd, 0x1f, 0xb4, 0x46, 0x81,
-	0xe7, 0x05, 0x3e, 0x87, 0x0d, 0x5a, 0x49, 0x8c, 0x41, 0xc7, 0xf1, 0x23, 0x1c, 0xfa, 0x96, 0x2b,
-	0xff, 0x92, 0xd1, 0x21, 0xf6, 0x2c, 0x31, 0xea, 0xd9, 0x56, 0x64, 0x25, 0xd7, 0xd7, 0xbf, 0xa3,
-	0xc1, 0xea, 0xde, 0x61, 0xf0, 0x78, 0x23, 0x70, 0x5d, 0x3c, 0x8a, 0x9c, 0xc0, 0x27, 0x06, 0x7e,
-	0x38, 0xc1, 0x24, 0x42, 0x37, 0xa1, 0x32, 0xb4, 0x08, 0xee, 0x6b, 0x97, 0xb4, 0x6b, 0xcd, 0xf5,
-	0x0b, 0x6b, 0x29, 0x4e, 0x04, 0x0b, 0x77, 0xc9, 0xc1, 0x2d, 0x8b, 0x60, 0x83, 0x61, 0x22, 0x04,
-	0x15, 0x7b, 0xb8, 0xbd, 0xd9, 0x2f, 0x5d, 0xd2, 0xae, 0x95, 0x0d, 0xf6, 0x8d, 0x5e, 0x82, 0xf6,
-	0x28, 0x5e, 0x7b, 0x7b, 0x93, 0xf4, 0xcb, 0x97, 0xca, 0xd7, 0xca, 0x46, 0x1a, 0xa8, 0xff, 0x52,
-	0x83, 0xe7, 0x72, 0x6c, 0x90, 0x71, 0xe0, 0x13, 0x8c, 0x5e, 0x83, 0x1a, 0x89, 0xac, 0x68, 0x42,
-	0x04, 0x27, 0xff, 0xa7, 0xe4, 0x64, 0x8f, 0xa1, 0x18, 0x02, 0x35, 0x4f, 0xb6, 0xa4, 0x20, 0x8b,
-	0x3e, 0x0f, 0x67, 0x1d, 0xff, 0x2e, 0xf6, 0x82, 0xf0, 0xc8, 0x1c, 0xe3, 0x70, 0x84, 0xfd, 0xc8,
-	0x3a, 0xc0, 0x92, 0xc7, 0x15, 0xf9, 0x6f, 0x77, 0xfa, 0x4b, 0xff, 0x85, 0x06, 0xe7, 0x28, 0xa7,
-	0xbb, 0x56, 0x18, 0x39, 0x4f, 0x41, 0x5f, 0x3a, 0xb4, 0x92, 0x3c, 0xf6, 0xcb, 0xec, 0x5f, 0x0a,
-	0x46, 0x71, 0xc6, 0x92, 0x3c, 0x95, 0xad, 0xc2, 0xd8, 0x4d, 0xc1, 0xf4, 0x9f, 0x0b, 0xc3, 0x26,
-	0xf9, 0x5c, 0x44, 0xa1, 0x59, 0x9a, 0xa5, 0x3c, 0xcd, 0xd3, 0xa8, 0xf3, 0x1f, 0x1a, 0x9c, 0xbb,
-	0x13, 0x58, 0xf6, 0xd4, 0xf0, 0x9f, 0xbe, 0x3a, 0xbf, 0x0a, 0x35, 0xbe, 0x4b, 0xfa, 0x15, 0x46,
-	0xeb, 0x4a, 0x9a, 0x96, 0xd8, 0x41, 0x53, 0x0e, 0xf7, 0x18, 0xc0, 0x10, 0x93, 0xd0, 0x15, 0xe8,
-	0x84, 0x78, 0xec, 0x3a, 0x23, 0xcb, 0xf4, 0x27, 0xde, 0x10, 0x87, 0xfd, 0xea, 0x25, 0xed, 0x5a,
-	0xd5, 0x68, 0x0b, 0xe8, 0x0e, 0x03, 0xea, 0x3f, 0xd3, 0xa0, 0x6f, 0x60, 0x17, 0x5b, 0x04, 0x3f,
-	0x4b, 0x61, 0x57, 0xa1, 0xe6, 0x07, 0x36, 0xde, 0xde, 0x64, 0xc2, 0x96, 0x0d, 0x31, 0xd2, 0x7f,
-	0x50, 0xe2, 0x86, 0xf8, 0x8c, 0xfb, 0x75, 0xc2, 0x58, 0xd5, 0xff, 0x8c, 0xb1, 0x6a, 0x2a, 0x63,
-	0xfd, 0x69, 0x6a, 0xac, 0xcf, 0xba, 0x42, 0xa6, 0x06, 0xad, 0xa6, 0x0c, 0xfa, 0x2d, 0x38, 0xbf,
-	0x11, 0x62, 0x2b, 0xc2, 0xef, 0xd2, 0xa4, 0xb1, 0x71, 0x68, 0xf9, 0x3e, 0x76, 0xa5, 0x08, 0x59,
-	0xe2, 0x9a, 0x82, 0x78, 0x1f, 0x96, 0xc7, 0x61, 0xf0, 0xe4, 0x28, 0xe6, 0x5b, 0x0e, 0xf5, 0x5f,
-	0x69, 0x30, 0x50, 0xad, 0xbd, 0x48, 0x7c, 0xb9, 0x0c, 0x6d, 0x91, 0xfd, 0xf8, 0x6a, 0x8c, 0x66,
-	0xc3, 0x68, 0x3d, 0x4c, 0x50, 0x40, 0x37, 0xe1, 0x2c, 0x47, 0x0a, 0x31, 0x99, 0xb8, 0x51, 0x8c,
-	0x5b, 0x66, 0xb8, 0x88, 0xfd, 0x33, 0xd8, 0x2f, 0x31, 0x43, 0xff, 0xb5, 0x06, 0xe7, 0xb7, 0x70,
-	0x14, 0x1b, 0x91, 0x52, 0xc5, 0x9f, 0xd1, 0x90, 0xfd, 0xb1, 0x06, 0x03, 0x15, 0xaf, 0x8b, 0xa8,
-	0xf5, 0x01, 0xac, 0xc6, 0x34, 0x4c, 0x1b, 0x93, 0x51, 0xe8, 0x8c, 0x99, 0x33, 0xb3, 0x00, 0xde,
-	0x5c, 0xbf, 0xbc, 0x96, 0x2f, 0x30, 0xd6, 0xb2, 0x1c, 0x9c, 0x8b, 0x97, 0xd8, 0x4c, 0xac, 0xa0,
-	0xff, 0x48, 0x83, 0x73, 0x5b, 0x38, 0xda, 0xc3, 0x07, 0x1e, 0xf6, 0xa3, 0x6d, 0x7f, 0x3f, 0x38,
-	0xbd, 0x5e, 0x9f, 0x07, 0x20, 0x62, 0x9d, 0x38, 0xb9, 0x24, 0x20, 0xf3, 0xe8, 0x98, 0xd5, 0x32,
-	0x59, 0x7e, 0x16, 0xd1, 0xdd, 0x17, 0xa1, 0xea, 0xf8, 0xfb, 0x81, 0x54, 0xd5, 0x0b, 0x2a, 0x55,
-	0x25, 0x89, 0x71, 0x6c, 0xdd, 0xe7, 0x5c, 0x1c, 0x5a, 0xa1, 0x7d, 0x07, 0x5b, 0x36, 0x0e, 0x17,
-	0x70, 0xb7, 0xac, 0xd8, 0x25, 0x85, 0xd8, 0x3f, 0xd4, 0xe0, 0xb9, 0x1c, 0xc1, 0x45, 0xe4, 0xfe,
-	0x0a, 0xd4, 0x08, 0x5d, 0x4c, 0x0a, 0xfe, 0x92, 0x52, 0xf0, 0x04, 0xb9, 0x3b, 0x0e, 0x89, 0x0c,
-	0x31, 0x47, 0x0f, 0xa0, 0x97, 0xfd, 0x87, 0x5e, 0x84, 0x96, 0xd8, 0xaa, 0xa6, 0x6f, 0x79, 0x5c,
-	0x01, 0x0d, 0xa3, 0x29, 0x60, 0x3b, 0x96, 0x87, 0xd1, 0x79, 0xa8, 0xd3, 0xc0, 0x65, 0x3a, 0xb6,
-	0x34, 0xff, 0x32, 0x0b, 0x64, 0x36, 0x41, 0x17, 0x01, 0xd8, 0x2f, 0xcb, 0xb6, 0x43, 0x5e, 0x4c,
-	0x34, 0x8c, 0x06, 0x85, 0xbc, 0x49, 0x01, 0xfa, 0xbf, 0x4a, 0xb0, 0xfa, 0xa6, 0x6d, 0xab, 0xc2,
-	0xdc, 0xc9, 0x15, 0x3e, 0x8d, 0xa6, 0xa5, 0x64, 0x34, 0x9d, 0x6b, 0x8f, 0xe7, 0x42, 0x58, 0xe5,
-	0x04, 0x21, 0xac, 0x5a, 0x14, 0xc2, 0xd0, 0x16, 0xb4, 0x09, 0xc6, 0x1f, 0x98, 0xe3, 0x80, 0xb0,
-	0x3d, 0xc8, 0x32, 0x56, 0x73, 0x5d, 0x4f, 0x4b, 0x13, 0xd7, 0xfd, 0x77, 0xc9, 0xc1, 0xae, 0xc0,
-	0x34, 0x5a, 0x74, 0xa2, 0x1c, 0xa1, 0xfb, 0xb0, 0x7a, 0xe0, 0x06, 0x43, 0xcb, 0x35, 0x09, 0xb6,
-	0x5c, 0x6c, 0x9b, 0x62, 0x7f, 0x91, 0xfe, 0xf2, 0x7c, 0x0e, 0x7e, 0x96, 0x4f, 0xdf, 0x63, 0xb3,
-	0xc5, 0x0f, 0xa2, 0xff, 0x5d, 0x83, 0xf3, 0x06, 0xf6, 0x82, 0x47, 0xf8, 0xbf, 0xd5, 0x04, 0xfa,
-	0x4f, 0x34, 0x68, 0xd1, 0xe2, 0xe8, 0x2e, 0x8e, 0x2c, 0xaa, 0x09, 0xf4, 0x06, 0x34, 0xdc, 0xc0,
-	0xb2, 0xcd, 0xe8, 0x68, 0xcc, 0x45, 0xeb, 0x64, 0x45, 0xe3, 0xda, 0xa3, 0x93, 0xee, 0x1d, 0x8d,
-	0xb1, 0x51, 0x77, 0xc5, 0xd7, 0x3c, 0x5b, 0x3a, 0x97, 0x2d, 0xca, 0x8a, 0x6c, 0xf1, 0xe7, 0x32,
-	0xac, 0x7e, 0xc3, 0x8a, 0x46, 0x87, 0x9b, 0x9e, 0x60, 0x93, 0x3c, 0x1b, 0x9d, 0xcf, 0x53, 0xa4,
-	0xc4, 0xa1, 0xb4, 0xaa, 0xf2, 0x34, 0xda, 0x95, 0xae, 0xbd, 0x27, 0xcc, 0x90, 0x08, 0xa5, 0x89,
-	0x62, 0xaf, 0x76, 0x9a, 0x62, 0x6f, 0x03, 0xda, 0xf8, 0xc9, 0xc8, 0x9d, 0xd0, 0xb0, 0xc2, 0xa8,
-	0x73, 0x3f, 0x7f, 0x5e, 0x41, 0x3d, 0xe9, 0xe6, 0x2d, 0x31, 0x69, 0x5b, 0xf0, 0xc0, 0x4d, 0xed,
-	0xe1, 0xc8, 0xea, 0xd7, 0x19, 0x1b, 0x97, 0x8a, 0x4c, 0x2d, 0xfd, 0x83, 0x9b, 0x9b, 0x8e, 0xd0,
-	0x05, 0x68, 0x88, 0xd2, 0x72, 0x7b, 0xb3, 0xdf, 0x60, 0xea, 0x9b, 0x02, 0xf4, 0x8f, 0x4a, 0x70,
-	0x9e, 0x1b, 0x11, 0xbb, 0x91, 0xf5, 0x6c, 0xed, 0x18, 0xdb, 0xa8, 0x72, 0x22, 0x1b, 0x5d, 0x04,
-	0x90, 0x15, 0xb5, 0x63, 0x8b, 0x1a, 0x34, 0x96, 0xd0, 0x4e, 0xab, 0xaf, 0x71, 0x52, 0xf5, 0xe9,
-	0x7f, 0xac, 0x40, 0x57, 0xd8, 0x86, 0x62, 0xb0, 0xcd, 0x77, 0x01, 0x1a, 0x71, 0x65, 0x20, 0x2a,
-	0xd7, 0x29, 0x00, 0x5d, 0x82, 0x66, 0xc2, 0xf5, 0x84, 0x1e, 0x92, 0xa0, 0xb9, 0x94, 0x21, 0xeb,
-	0xbc, 0x4a, 0xa2, 0xce, 0xbb, 0x08, 0xb0, 0xef, 0x4e, 0xc8, 0xa1, 0x19, 0x39, 0x1e, 0x96, 0x92,
-	0x32, 0xc8, 0x3d, 0xc7, 0xc3, 0xe8, 0x4d, 0x68, 0x0d, 0x1d, 0xdf, 0x0d, 0x0e, 0xcc, 0xb1, 0x15,
-	0x1d, 0x92, 0x7e, 0xad, 0xd0, 0xd9, 0x6e, 0x3b, 0xd8, 0xb5, 0x6f, 0x31, 0x5c, 0xa3, 0xc9, 0xe7,
-	0xec, 0xd2, 0x29, 0xe8, 0x79, 0x68, 0xfa, 0x13, 0xcf, 0x0c, 0xf6, 0xcd, 0x30, 0x78, 0x4c, 0xdd,
-	0x95, 0x91, 0xf0, 0x27, 0xde, 0x3b, 0xfb, 0x46, 0xf0, 0x98, 0x66, 0xe6, 0x06, 0xcd, 0xd1, 0xc4,
-	0x0d, 0x0e, 0x48, 0xbf, 0x3e, 0xd7, 0xfa, 0xd3, 0x09, 0x74, 0xb6, 0x4d, 0xdd, 0x8c, 0xcd, 0x6e,
-	0xcc, 0x37, 0x3b, 0x9e, 0x80, 0xae, 0x42, 0x67, 0x14, 0x78, 0x63, 0x8b, 0x69, 0xe8, 0x76, 0x18,
-	0x78, 0x7d, 0x60, 0x1b, 0x3d, 0x03, 0x45, 0x1b, 0xd0, 0x74, 0x7c, 0x1b, 0x3f, 0x11, 0x5b, 0xae,
-	0xc9, 0xe8, 0xe8, 0x2a, 0x93, 0x33, 0x42, 0xdb, 0x14, 0x97, 0x19, 0x1d, 0x1c, 0xf9, 0x49, 0x68,
-	0xc1, 0x20, 0x2c, 0x6a, 0x12, 0xe7, 0x43, 0xdc, 0x6f, 0x71, 0x2b, 0x0a, 0xd8, 0x9e, 0xf3, 0x21,
-	0xa6, 0x9d, 0x9c, 0xe3, 0x13, 0x1c, 0x4e, 0xe3, 0x77, 0x9b, 0xc5, 0xef, 0x36, 0x87, 0xca, 0xd0,
-	0xfd, 0xdb, 0x12, 0x74, 0xd2, 0x84, 0x68, 0x63, 0xb3, 0xcf, 0x20, 0xd2, 0x7b, 0xe4, 0x90, 0x92,
-	0xc5, 0xbe, 0x35, 0x74, 0x69, 0xbc, 0xb0, 0xf1, 0x13, 0xe6, 0x3c, 0x75, 0xa3, 0xc9, 0x61, 0x6c,
-	0x01, 0xea, 0x04, 0x5c, 0x3c, 0x56, 0xc8, 0xf0, 0xc6, 0xa3, 0xc1, 0x20, 0xac, 0x8c, 0xe9, 0xc3,
-	0x32, 0x17, 0x43, 0xba, 0x8e, 0x1c, 0xd2, 0x3f, 0xc3, 0x89, 0xc3, 0xa8, 0x72, 0xd7, 0x91, 0x43,
-	0xb4, 0x09, 0x2d, 0xbe, 0xe4, 0xd8, 0x0a, 0x2d, 0x4f, 0x3a, 0xce, 0x8b, 0xca, 0xed, 0xfe, 0x36,
-	0x3e, 0x7a, 0xcf, 0x72, 0x27, 0x78, 0xd7, 0x72, 0x42, 0x83, 0x2b, 0x7a, 0x97, 0xcd, 0x42, 0xd7,
-	0xa0, 0xc7, 0x57, 0xd9, 0x77, 0x5c, 0x2c, 0x5c, 0x70, 0x99, 0xd5, 0x4a, 0x1d, 0x06, 0xbf, 0xed,
-	0xb8, 0x98, 0x7b, 0x59, 0x2c, 0x02, 0x53, 0x6d, 0x9d, 0x3b, 0x19, 0x83, 0x50, 0xc5, 0xea, 0xdf,
-	0x2d, 0xc3, 0x0a, 0xdd, 0x6b, 0x32, 0xc1, 0x9f, 0x3e, 0x1a, 0x5d, 0x04, 0xb0, 0x49, 0x64, 0xa6,
-	0x22, 0x52, 0xc3, 0x26, 0xd1, 0x0e, 0x0f, 0x4a, 0x6f, 0xc8, 0x80, 0x53, 0x2e, 0x6e, 0x45, 0x32,
-	0x7b, 0x3f, 0x9f, 0x18, 0x4e, 0x75, 0x64, 0x73, 0x19, 0xda, 0x24, 0x98, 0x84, 0x23, 0x6c, 0xa6,
-	0x5a, 0xe7, 0x16, 0x07, 0xee, 0xa8, 0x63, 0x66, 0x4d, 0x79, 0x74, 0x94, 0x88, 0x6e, 0xcb, 0x8b,
-	0x25, 0x87, 0x7a, 0x36, 0x39, 0xfc, 0x4d, 0x83, 0x55, 0x71, 0x08, 0xb1, 0xb8, 0x2d, 0x8a, 0x32,
-	0x83, 0x0c, 0x74, 0xe5, 0x63, 0x1a, 0xda, 0xca, 0x1c, 0x59, 0xbf, 0xaa, 0xc8, 0xfa, 0xe9, 0xa6,
-	0xae, 0x96, 0x6d, 0xea, 0xf4, 0xdf, 0x69, 0xd0, 0xde, 0xc3, 0x56, 0x38, 0x3a, 0x94, 0x72, 0x7d,
-	0x09, 0xca, 0x21, 0x7e, 0x28, 0xc4, 0x7a, 0xa9, 0xa0, 0xc2, 0x4d, 0x4d, 0x31, 0xe8, 0x04, 0xf4,
-	0x02, 0x34, 0x6d, 0xcf, 0xcd, 0x9c, 0x1d, 0x80, 0xed, 0xb9, 0xb2, 0xe6, 0x4b, 0xb3, 0x52, 0xce,
-	0xf5, 0x97, 0x57, 0xa1, 0xeb, 0x10, 0x93, 0xb5, 0x30, 0xa6, 0xcb, 0x3a, 0x17, 0x26, 0x75, 0xdd,
-	0x68, 0x3b, 0x24, 0xd1, 0xce, 0xe8, 0xbf, 0xd7, 0xa0, 0xf5, 0x2e, 0x2f, 0x10, 0x39, 0xc7, 0xaf,
-	0x27, 0x39, 0xbe, 0x5a, 0xc0, 0xb1, 0x81, 0xa3, 0xd0, 0xc1, 0x8f, 0xf0, 0xb3, 0xe1, 0xf9, 0x2f,
-	0x1a, 0x0c, 0xf6, 0x8e, 0xfc, 0x91, 0xc1, 0x3d, 0x6b, 0x71, 0x5f, 0xba, 0x0c, 0xed, 0x47, 0xa9,
-	0x7e, 0x4e, 0x9c, 0xd5, 0x3c, 0x4a, 0x36, 0x74, 0x06, 0xf4, 0x64, 0x5d, 0x10, 0xf7, 0x19, 0x7c,
-	0xa3, 0xbf, 0xac, 0xda, 0x21, 0x19, 0xe6, 0xd8, 0x46, 0xe9, 0x86, 0x69, 0xa0, 0x1e, 0xc2, 0x8a,
-	0x02, 0x0f, 0x3d, 0x07, 0xcb, 0xa2, 0x77, 0x14, 0x01, 0x9d, 0x3b, 0xb7, 0x4d, 0xe3, 0xf9, 0xf4,
-	0xf4, 0xc3, 0xb1, 0xf3, 0xc5, 0x80, 0x4d, 0xad, 0x20, 0x33, 0x0d, 0x6d, 0x3d, 0x33, 0x5a, 0xb6,
-	0x89, 0xfe, 0x63, 0x0d, 0x56, 0xdf, 0xb2, 0x7c, 0x3b, 0xd8, 0xdf, 0x5f, 0x5c, 0x73, 0x1b, 0x71,
-	0x5e, 0xdb, 0x3e, 0xc9, 0xc9, 0x42, 0x6a, 0x92, 0xfe, 0x9b, 0x12, 0x20, 0x1a, 0x50, 0x6e, 0x59,
-	0xae, 0xe5, 0x8f, 0xf0, 0xe9, 0xb9, 0xb9, 0x02, 0x9d, 0x54, 0x18, 0x8c, 0x6f, 0x49, 0x92, 0x71,
-	0x90, 0xa0, 0xb7, 0xa1, 0x33, 0xe4, 0xa4, 0xcc, 0x10, 0x5b, 0x24, 0xf0, 0x59, 0xb0, 0xe8, 0xa8,
-	0xcf, 0x05, 0xee, 0x85, 0xce, 0xc1, 0x01, 0x0e, 0x37, 0x02, 0xdf, 0xe6, 0x3d, 0x68, 0x7b, 0x28,
-	0xd9, 0xa4, 0x53, 0x99, 0xd7, 0xc7, 0x39, 0x41, 0x36, 0x0b, 0x10, 0x27, 0x05, 0x82, 0x5e, 0x81,
-	0x33, 0xe9, 0xf6, 0x74, 0x1a, 0x5d, 0x7a, 0x24, 0xd9, 0x79, 0xaa, 0x8e, 0x85, 0x14, 0x31, 0x5a,
-	0xff, 0x83, 0x06, 0x28, 0xee, 0x91, 0x58, 0xb1, 0xcd, 0x9c, 0x66, 0x9e, 0x23, 0xd0, 0x0b, 0xd0,
-	0xb0, 0xe5, 0x4c, 0xe1, 0xe4, 0x53, 0x00, 0xdd, 0x06, 0x5c, 0x0c, 0x93, 0x06, 0x74, 0x6c, 0xcb,
-	0x42, 0x92, 0x03, 0xef, 0x30, 0x58, 0x3a, 0xc4, 0x57, 0x32, 0x21, 0x3e, 0x75, 0xea, 0x51, 0x4d,
-	0x9d, 0x7a, 0xe8, 0x1f, 0x97, 0xa0, 0x97, 0x6c, 0xa8, 0xe7, 0x66, 0xfa, 0xe9, 0x9c, 0xa4, 0x1e,
-	0x73, 0x7a, 0x50, 0x59, 0xe0, 0xf4, 0x20, 0x7f, 0xba, 0x51, 0x3d, 0xdd, 0xe9, 0x86, 0xfe, 0x91,
-	0x06, 0xdd, 0xcc, 0xc1, 0x65, 0xb6, 0x17, 0xd0, 0xf2, 0xbd, 0xc0, 0xeb, 0x50, 0xa5, 0x05, 0x32,
-	0x0f, 0x61, 0x1d, 0x75, 0x9d, 0x9a, 0x5e, 0xd5, 0xe0, 0x13, 0xd0, 0x0d, 0x58, 0x51, 0x5c, 0x76,
-	0x09, 0x1f, 0x40, 0xf9, 0xbb, 0x2e, 0xfd, 0x93, 0x0a, 0x34, 0x13, 0xfa, 0x98, 0xd1, 0xc6, 0xcc,
-	0x73, 0x4c, 0x90, 0x11, 0xaf, 0x9c, 0x17, 0xaf, 0xe0, 0xb6, 0x87, 0xfa, 0x9d, 0x87, 0x3d, 0x5e,
-	0x00, 0x8a, 0x6a, 0xd4, 0xc3, 0x1e, 0xab, 0xab, 0xa9, 0x4b, 0x4e, 0x3c, 0xde, 0x80, 0xf0, 0xed,
-	0xb4, 0xec, 0x4f, 0x3c, 0xd6, 0x7e, 0xa4, 0x6b, 0xdf, 0xe5, 0x63, 0x6a, 0xdf, 0x7a, 0xba, 0xf6,
-	0x4d, 0xed, 0xa3, 0x46, 0x76, 0x1f, 0xcd, 0xdb, 0x59, 0xdc, 0x84, 0x95, 0x11, 0xbb, 0x75, 0xb0,
-	0x6f, 0x1d, 0x6d, 0xc4, 0xbf, 0xfa, 0x4d, 0x96, 0xf3, 0x54, 0xbf, 0xd0, 0x6d, 0xea, 0x5c, 0xa2,
-	0x8d, 0x60, 0x56, 0x6e, 0x31, 0x2b, 0xab, 0x4b, 0x6b, 0x61, 0x1b, 0x6e, 0x64, 0x19, 0x71, 0xd9,
-	0x28, 0xdb, 0xd3, 0xb4, 0x4f, 0xd5, 0xd3, 0xbc, 0x00, 0xcd, 0x69, 0xa3, 0x4c, 0xfa, 0x1d, 0x1e,
-	0xf9, 0xe2, 0x4e, 0x99, 0xa4, 0x82, 0x41, 0x37, 0x1d, 0x0c, 0xfe, 0x5a, 0x86, 0xce, 0xb4, 0x9a,
-	0x9d, 0x3b, 0x14, 0xcc, 0x73, 0x69, 0xbb, 0x03, 0xbd, 0x69, 0x8e, 0x64, 0x5a, 0x3a, 0xb6, 0x20,
-	0xcf, 0xde, 0x0d, 0x74, 0xc7, 0x99, 0x3d, 0x97, 0x3a, 0x1a, 0xab, 0x9c, 0xe8, 0x68, 0x6c, 0xc1,
-	0xbb, 0xbd, 0xd7, 0xe0, 0x5c, 0xc8, 0xcb, 0x65, 0xdb, 0x4c, 0x89, 0xcd, 0x2b, 0xcf, 0xb3, 0xf2,
-	0xe7, 0x6e, 0x52, 0xfc, 0x82, 0x6d, 0xbc, 0x5c, 0xb4, 0x8d, 0xb3, 0x66, 0xac, 0xe7, 0xcc, 0x98,
-	0xbf, 0x62, 0x6c, 0xa8, 0xae, 0x18, 0xef, 0xc3, 0xca, 0x7d, 0x9f, 0x4c, 0x86, 0x64, 0x14, 0x3a,
-	0x43, 0x2c, 0x8f, 0x7e, 0xe6, 0x32, 0xeb, 0x00, 0xea, 0x22, 0x5e, 0x73, 0x93, 0x36, 0x8c, 0x78,
-	0xac, 0x7f, 0x5f, 0x83, 0xd5, 0xfc, 0xba, 0xcc, 0x63, 0xa6, 0xc1, 0x40, 0x4b, 0x05, 0x83, 0x6f,
-	0xc2, 0xca, 0x74, 0x79, 0x33, 0xb5, 0x72, 0x41, 0xb1, 0xa6, 0x60, 0xdc, 0x40, 0xd3, 0x35, 0x24,
-	0x4c, 0xff, 0xa7, 0x06, 0x67, 0xc4, 0xb6, 0xa2, 0xb0, 0x03, 0x76, 0xa4, 0x46, 0x13, 0x54, 0xe0,
-	0xbb, 0x8e, 0x1f, 0x77, 0x5f, 0x42, 0x46, 0x0e, 0x14, 0xdd, 0xd7, 0x5b, 0xd0, 0x15, 0x48, 0x71,
-	0x9e, 0x99, 0xb3, 0x58, 0xea, 0xf0, 0x79, 0x71, 0x86, 0xb9, 0x02, 0x9d, 0x60, 0x7f, 0x3f, 0x49,
-	0x8f, 0x07, 0xca, 0xb6, 0x80, 0x0a, 0x82, 0x5f, 0x87, 0x9e, 0x44, 0x3b, 0x69, 0x66, 0xeb, 0x8a,
-	0x89, 0x71, 0x9d, 0xfa, 0x3d, 0x0d, 0xfa, 0xe9, 0x3c, 0x97, 0x10, 0xff, 0xe4, 0x75, 0xda, 0x97,
-	0xd3, 0x17, 0x51, 0x57, 0x8e, 0xe1, 0x67, 0x4a, 0x47, 0xb4, 0xca, 0xd7, 0x3f, 0x84, 0x4e, 0x7a,
-	0xcf, 0xa2, 0x16, 0xd4, 0x77, 0x82, 0xe8, 0x6b, 0x4f, 0x1c, 0x12, 0xf5, 0x96, 0x50, 0x07, 0x60,
-	0x27, 0x88, 0x76, 0x43, 0x4c, 0xb0, 0x1f, 0xf5, 0x34, 0x04, 0x50, 0x7b, 0xc7, 0xdf, 0x74, 0xc8,
-	0x07, 0xbd, 0x12, 0x5a, 0x11, 0x29, 0xd5, 0x72, 0xb7, 0xc5, 0x46, 0xe8, 0x95, 0xe9, 0xf4, 0x78,
-	0x54, 0x41, 0x3d, 0x68, 0xc5, 0x28, 0x5b, 0xbb, 0xf7, 0x7b, 0x55, 0xd4, 0x80, 0x2a, 0xff, 0xac,
-	0x5d, 0xb7, 0xa1, 0x97, 0xad, 0x07, 0xe9, 0x9a, 0xf7, 0xfd, 0xb7, 0xfd, 0xe0, 0x71, 0x0c, 0xea,
-	0x2d, 0xa1, 0x26, 0x2c, 0x8b, 0x1a, 0xbb, 0xa7, 0xa1, 0x2e, 0x34, 0x13, 0xe5, 0x6d, 0xaf, 0x44,
-	0x01, 0x5b, 0xe1, 0x78, 0x24, 0x0a, 0x5d, 0xce, 0x02, 0xb5, 0xda, 0x66, 0xf0, 0xd8, 0xef, 0x55,
-	0xae, 0xdf, 0x82, 0xba, 0x0c, 0x26, 0x14, 0x95, 0xaf, 0xee, 0xd3, 0x61, 0x6f, 0x09, 0x9d, 0x81,
-	0x76, 0xea, 0x59, 0x43, 0x4f, 0x43, 0x08, 0x3a, 0xe9, 0x27, 0x27, 0xbd, 0xd2, 0xfa, 0x4f, 0xdb,
-	0x00, 0xbc, 0xda, 0x0a, 0x82, 0xd0, 0x46, 0x63, 0x40, 0x5b, 0x38, 0xa2, 0x99, 0x24, 0xf0, 0x65,
-	0x16, 0x20, 0xe8, 0x66, 0x41, 0x51, 0x92, 0x47, 0x15, 0xac, 0x0e, 0x8a, 0x1a, 0xc2, 0x0c, 0xba,
-	0xbe, 0x84, 0x3c, 0x46, 0xf1, 0x9e, 0xe3, 0xe1, 0x7b, 0xce, 0xe8, 0x83, 0xb8, 0x4c, 0x2b, 0xa6,
-	0x98, 0x41, 0x95, 0x14, 0x33, 0x41, 0x5b, 0x0c, 0xf6, 0xa2, 0xd0, 0xf1, 0x0f, 0xe4, 0xb5, 0xa0,
-	0xbe, 0x84, 0x1e, 0xc2, 0xd9, 0x2d, 0xcc, 0xa8, 0x3b, 0x24, 0x72, 0x46, 0x44, 0x12, 0x5c, 0x2f,
-	0x26, 0x98, 0x43, 0x3e, 0x21, 0x49, 0x17, 0xba, 0x99, 0x27, 0x5e, 0xe8, 0xba, 0xfa, 0x66, 0x51,
-	0xf5, 0x1c, 0x6d, 0xf0, 0xca, 0x5c, 0xb8, 0x31, 0x35, 0x07, 0x3a, 0xe9, 0xe7, 0x4f, 0xe8, 0xff,
-	0x8b, 0x16, 0xc8, 0xbd, 0xf0, 0x18, 0x5c, 0x9f, 0x07, 0x35, 0x26, 0xf5, 0x80, 0xfb, 0xd3, 0x2c,
-	0x52, 0xca, 0xd7, 0x35, 0x83, 0xe3, 0x6e, 0x64, 0xf5, 0x25, 0xf4, 0x6d, 0x38, 0x93, 0x7b, 0x87,
-	0x82, 0x3e, 0xa7, 0x6e, 0xa0, 0xd5, 0xcf, 0x55, 0x66, 0x51, 0x78, 0x90, 0xdd, 0x0d, 0xc5, 0xdc,
-	0xe7, 0xde, 0x2d, 0xcd, 0xcf, 0x7d, 0x62, 0xf9, 0xe3, 0xb8, 0x3f, 0x31, 0x85, 0x09, 0xa0, 0xfc,
-	0x4b, 0x14, 0xf4, 0xaa, 0x8a, 0x44, 0xe1, 0x6b, 0x98, 0xc1, 0xda, 0xbc, 0xe8, 0xb1, 0xc9, 0x27,
-	0x6c, 0xb7, 0x66, 0xdb, 0x0d, 0x25, 0xd9, 0xc2, 0xd7, 0x27, 0x6a, 0xb2, 0xc5, 0x0f, 0x40, 0xb8,
-	0x53, 0xa7, 0x1f, 0x38, 0xa8, 0x6d, 0xa5, 0x7c, 0x94, 0xa1, 0x76, 0x6a, 0xf5, 0x7b, 0x09, 0x7d,
-	0x09, 0xdd, 0x4b, 0x05, 0x61, 0x74, 0xb5, 0xc8, 0x27, 0xd2, 0x87, 0x10, 0xb3, 0xcc, 0x65, 0x02,
-	0x6c, 0xe1, 0xe8, 0x2e, 0x8e, 0x42, 0x67, 0x44, 0xb2, 0x8b, 0x8a, 0xc1, 0x14, 0x41, 0x2e, 0xfa,
-	0xf2, 0x4c, 0xbc, 0x98, 0xed, 0x21, 0x34, 0xb7, 0x70, 0x24, 0x0e, 0x89, 0x08, 0x2a, 0x9c, 0x29,
-	0x31, 0x24, 0x89, 0x6b, 0xb3, 0x11, 0x93, 0x81, 0x2c, 0xf3, 0xde, 0x02, 0x15, 0xea, 0x36, 0xff,
-	0x0a, 0x44, 0x1d, 0xc8, 0x0a, 0x1e, 0x70, 0xe8, 0x4b, 0xeb, 0x9f, 0xb4, 0xa0, 0xc1, 0xbc, 0x90,
-	0x66, 0xbc, 0xff, 0x25, 0xa6, 0xa7, 0x90, 0x98, 0xde, 0x87, 0x6e, 0xe6, 0xfd, 0x88, 0xda, 0x9e,
-	0xea, 0x47, 0x26, 0xb3, 0x5c, 0x7e, 0x08, 0x28, 0xff, 0x3a, 0x42, 0x1d, 0x2a, 0x0a, 0x5f, 0x51,
-	0xcc, 0xa2, 0xf1, 0x3e, 0x74, 0x33, 0x4f, 0x01, 0xd4, 0x12, 0xa8, 0xdf, 0x0b, 0xcc, 0x21, 0x41,
-	0xfe, 0x8e, 0x5a, 0x2d, 0x41, 0xe1, 0x5d, 0xf6, 0x2c, 0x1a, 0xef, 0xf1, 0x07, 0x16, 0x71, 0xd1,
-	0xfe, 0x72, 0x51, 0xbc, 0xc9, 0x9c, 0xc1, 0x3e, 0xfb, 0x0c, 0xf4, 0xf4, 0x33, 0xf4, 0xfb, 0xd0,
-	0xcd, 0x5c, 0x03, 0xa9, 0xad, 0xab, 0xbe, 0x2b, 0x9a, 0xb5, 0xfa, 0xa7, 0x98, 0x53, 0x6c, 0x58,
-	0x51, 0xdc, 0x43, 0x20, 0x65, 0x1e, 0x2c, 0xbe, 0xb0, 0x98, 0x25, 0xd0, 0x1e, 0xd4, 0xf8, 0x0d,
-	0x11, 0x7a, 0x51, 0xdd, 0x28, 0x25, 0x6e, 0x8f, 0x06, 0xb3, 0xee, 0x98, 0xc8, 0xc4, 0x8d, 0xf8,
-	0xa2, 0x55, 0xb6, 0x2f, 0x91, 0xf2, 0x7a, 0x2f, 0x79, 0x23, 0x34, 0x98, 0x7d, 0x09, 0x24, 0x17,
-	0x7d, 0xda, 0xd9, 0xf0, 0xd6, 0x17, 0x1e, 0xac, 0x1f, 0x38, 0xd1, 0xe1, 0x64, 0x48, 0x95, 0x74,
-	0x83, 0x63, 0xbe, 0xea, 0x04, 0xe2, 0xeb, 0x86, 0x64, 0xed, 0x06, 0x5b, 0xe9, 0x06, 0x93, 0x65,
-	0x3c, 0x1c, 0xd6, 0xd8, 0xf0, 0xb5, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xad, 0xe5, 0x14, 0x2e,
-	0x8f, 0x32, 0x00, 0x00,
+	// 3219 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1b, 0x5d, 0x8f, 0x1c, 0x47,
+	0xd1, 0xb3, 0x1f, 0x77, 0xbb, 0xb5, 0x9f, 0xee, 0xb3, 0xcf, 0xeb, 0x25, 0x76, 0xce, 0xe3, 0x8f,
+	0x1c, 0x76, 0x72, 0x36, 0x17, 0x82, 0x12, 0x01, 0x12, 0xf1, 0x1d, 0xbe, 0x5c, 0x62, 0x5f, 0x2e,
+	0x73, 0xb6, 0x01, 0x2b, 0x68, 0x99, 0xdd, 0xe9, 0xdb, 0x1b, 0x32, 0x1f, 0xeb, 0xe9, 0x59, 0xdb,
+	0x97, 0x57, 0x10, 0x02, 0x04, 0x42, 0xbc, 0xf1, 0x80, 0x22, 0x81, 0x40, 0x80, 0x44, 0x14, 0x90,
+	0x78, 0xe1, 0x05, 0x21, 0x5e, 0x78, 0xe1, 0x81, 0x5f, 0x00, 0xfc, 0x09, 0x1e, 0x91, 0x50, 0x7f,
+	0xcc, 0xec, 0x7c, 0xf4, 0xdc, 0xce, 0xdd, 0xfa, 0xe2, 0x08, 0xf1, 0xb6, 0x5d, 0x53, 0xdd, 0x55,
+	0x5d, 0x55, 0x5d, 0x5d, 0x55, 0x5d, 0x0b, 0x27, 0x1f, 0x8e, 0xb1, 0xb7, 0xdf, 0x1b, 0xb8, 0xae,
+	0x67, 0xac, 0x8c, 0x3c, 0xd7, 0x77, 0x11, 0xb2, 0x4d, 0xeb, 0xd1, 0x98, 0xf0, 0xd1, 0x0a, 0xfb,
+	0xde, 0xad, 0x0f, 0x5c, 0xdb, 0x76, 0x1d, 0x0e, 0xeb, 0xd6, 0xa3, 0x18, 0xdd, 0xa6, 0xe9, 0xf8,
+	0xd8, 0x73, 0x74, 0x2b, 0xf8, 0x4a, 0x06, 0x7b, 0xd8, 0xd6, 0xc5, 0xa8, 0x6d, 0xe8, 0xbe, 0x1e,
+	0x5d, 0x5f, 0xfd, 0xb6, 0x02, 0x8b, 0x3b, 0x7b, 0xee, 0xe3, 0x35, 0xd7, 0xb2, 0xf0, 0xc0, 0x37,
+	0x5d, 0x87, 0x68, 0xf8, 0xe1, 0x18, 0x13, 0x1f, 0xdd, 0x80, 0x52, 0x5f, 0x27, 0xb8, 0xa3, 0x2c,
+	0x29, 0xcb, 0xb5, 0xd5, 0xe7, 0x56, 0x62, 0x9c, 0x08, 0x16, 0xee, 0x90, 0xe1, 0x4d, 0x9d, 0x60,
+	0x8d, 0x61, 0x22, 0x04, 0x25, 0xa3, 0xbf, 0xb9, 0xde, 0x29, 0x2c, 0x29, 0xcb, 0x45, 0x8d, 0xfd,
+	0x46, 0x97, 0xa0, 0x31, 0x08, 0xd7, 0xde, 0x5c, 0x27, 0x9d, 0xe2, 0x52, 0x71, 0xb9, 0xa8, 0xc5,
+	0x81, 0xea, 0xaf, 0x14, 0x38, 0x93, 0x62, 0x83, 0x8c, 0x5c, 0x87, 0x60, 0xf4, 0x32, 0xcc, 0x11,
+	0x5f, 0xf7, 0xc7, 0x44, 0x70, 0xf2, 0x29, 0x29, 0x27, 0x3b, 0x0c, 0x45, 0x13, 0xa8, 0x69, 0xb2,
+	0x05, 0x09, 0x59, 0xf4, 0x19, 0x38, 0x65, 0x3a, 0x77, 0xb0, 0xed, 0x7a, 0xfb, 0xbd, 0x11, 0xf6,
+	0x06, 0xd8, 0xf1, 0xf5, 0x21, 0x0e, 0x78, 0x5c, 0x08, 0xbe, 0x6d, 0x4f, 0x3e, 0xa9, 0xbf, 0x54,
+	0xe0, 0x34, 0xe5, 0x74, 0x5b, 0xf7, 0x7c, 0xf3, 0x18, 0xe4, 0xa5, 0x42, 0x3d, 0xca, 0x63, 0xa7,
+	0xc8, 0xbe, 0xc5, 0x60, 0x14, 0x67, 0x14, 0x90, 0xa7, 0x7b, 0x2b, 0x31, 0x76, 0x63, 0x30, 0xf5,
+	0x17, 0x42, 0xb1, 0x51, 0x3e, 0x67, 0x11, 0x68, 0x92, 0x66, 0x21, 0x4d, 0xf3, 0x28, 0xe2, 0xfc,
+	0x6e, 0x01, 0x4e, 0xdf, 0x76, 0x75, 0x63, 0xa2, 0xf8, 0x8f, 0x5f, 0x9c, 0x5f, 0x84, 0x39, 0x7e,
+	0x4a, 0x3a, 0x25, 0x46, 0xeb, 0x72, 0x9c, 0x16, 0xff, 0xb6, 0x32, 0xe1, 0x70, 0x87, 0x01, 0x34,
+	0x31, 0x09, 0x5d, 0x86, 0xa6, 0x87, 0x47, 0x96, 0x39, 0xd0, 0x7b, 0xce, 0xd8, 0xee, 0x63, 0xaf,
+	0x53, 0x5e, 0x52, 0x96, 0xcb, 0x5a, 0x43, 0x40, 0xb7, 0x18, 0x10, 0x3d, 0x0f, 0x35, 0xcb, 0xd5,
+	0x8d, 0xde, 0xae, 0x89, 0x2d, 0x83, 0x74, 0xe6, 0x96, 0x8a, 0xcb, 0x55, 0x0d, 0x28, 0xe8, 0x16,
+	0x83, 0xa8, 0x3f, 0x55, 0xa0, 0xa3, 0x61, 0x0b, 0xeb, 0x04, 0x3f, 0x4b, 0x69, 0x2c, 0xc2, 0x9c,
+	0xe3, 0x1a, 0x78, 0x73, 0x9d, 0x49, 0xa3, 0xa8, 0x89, 0x91, 0xfa, 0x5b, 0xa1, 0xa9, 0x4f, 0xb8,
+	0xe1, 0x47, 0xb4, 0x59, 0x7e, 0x3a, 0xda, 0x9c, 0xcb, 0xa1, 0xcd, 0xf9, 0x94, 0x36, 0x7f, 0xa2,
+	0xc0, 0xf9, 0x9d, 0x7d, 0x67, 0xb0, 0x85, 0x1f, 0xaf, 0x79, 0x58, 0xf7, 0xf1, 0x44, 0x70, 0x47,
+	0x97, 0x5b, 0x52, 0x46, 0x05, 0x89, 0x8c, 0x96, 0xa0, 0x16, 0x91, 0x87, 0x10, 0x63, 0x14, 0xa4,
+	0x7e, 0xc4, 0x0c, 0x6d, 0xd7, 0xc3, 0x64, 0xef, 0x69, 0x18, 0x5a, 0x1e, 0xa6, 0x26, 0x4a, 0x29,
+	0x1e, 0x41, 0x29, 0xea, 0x9f, 0x27, 0x47, 0xe3, 0x93, 0x6e, 0x7e, 0x93, 0xe3, 0x53, 0x8e, 0x1d,
+	0x9f, 0x9f, 0x2b, 0xb0, 0x18, 0x9c, 0xee, 0x3d, 0xdd, 0x71, 0xb0, 0x35, 0xc3, 0x06, 0x26, 0x44,
+	0x0a, 0x51, 0x22, 0xb9, 0x36, 0xd1, 0x85, 0xca, 0x40, 0x30, 0xc0, 0x36, 0x50, 0xd5, 0xc2, 0xb1,
+	0xfa, 0x35, 0x38, 0xcb, 0x8d, 0xf5, 0x1d, 0x1a, 0x68, 0x08, 0x3e, 0x03, 0x36, 0x93, 0x8b, 0x2b,
+	0x92, 0xc5, 0x3b, 0x30, 0x3f, 0xf2, 0xdc, 0x27, 0xfb, 0x21, 0x67, 0xc1, 0x50, 0xfd, 0xb5, 0x02,
+	0x5d, 0xd9, 0xda, 0xb3, 0xdc, 0x49, 0x17, 0xa1, 0x21, 0x22, 0x26, 0xbe, 0x1a, 0xa3, 0x59, 0xd5,
+	0xea, 0x0f, 0x23, 0x14, 0xd0, 0x0d, 0x38, 0xc5, 0x91, 0x3c, 0x4c, 0xc6, 0x96, 0x1f, 0xe2, 0x16,
+	0x19, 0x2e, 0x62, 0xdf, 0x34, 0xf6, 0x49, 0xcc, 0x50, 0x7f, 0xa3, 0xc0, 0xd9, 0x0d, 0xec, 0x87,
+	0x96, 0x46, 0xa9, 0xe2, 0x4f, 0xe8, 0x35, 0xff, 0xa1, 0x02, 0x5d, 0x19, 0xaf, 0xb3, 0x88, 0xf5,
+	0x01, 0x2c, 0x86, 0x34, 0x7a, 0x06, 0x26, 0x03, 0xcf, 0x1c, 0xd1, 0xdf, 0xfc, 0xd2, 0xaf, 0xad,
+	0x5e, 0x5c, 0x49, 0x07, 0xa5, 0x2b, 0x49, 0x0e, 0x4e, 0x87, 0x4b, 0xac, 0x47, 0x56, 0x50, 0x7f,
+	0xa8, 0xc0, 0xe9, 0x0d, 0xec, 0xef, 0xe0, 0xa1, 0x8d, 0x1d, 0x7f, 0xd3, 0xd9, 0x75, 0x8f, 0x2e,
+	0xd7, 0xf3, 0x00, 0x44, 0xac, 0x13, 0x06, 0x24, 0x11, 0x48, 0x1e, 0x19, 0xb3, 0xf8, 0x37, 0xc9,
+	0xcf, 0x2c, 0xb2, 0x7b, 0x05, 0xca, 0xa6, 0xb3, 0xeb, 0x06, 0xa2, 0x7a, 0x5e, 0x26, 0xaa, 0x28,
+	0x31, 0x8e, 0xad, 0x3a, 0x9c, 0x8b, 0x3d, 0xdd, 0x33, 0x6e, 0x63, 0xdd, 0xc0, 0x1e, 0x39, 0x56,
+	0x7f, 0xac, 0xfe, 0x40, 0x81, 0x33, 0x29, 0x82, 0xb3, 0xec, 0xfb, 0x0b, 0x30, 0x47, 0xe8, 0x62,
+	0xc1, 0xc6, 0x2f, 0x49, 0x37, 0x1e, 0x21, 0x77, 0xdb, 0x24, 0xbe, 0x26, 0xe6, 0xa8, 0x0f, 0xd9,
+	0x81, 0xa3, 0xd1, 0x85, 0xe9, 0x0c, 0xb7, 0x3d, 0x77, 0xe8, 0x61, 0x72, 0xcc, 0x12, 0xb0, 0x99,
+	0x1d, 0x0a, 0x8f, 0xfc, 0xa6, 0xdb, 0x3f, 0x66, 0x72, 0x2e, 0xb4, 0x93, 0xbb, 0x47, 0x17, 0xa0,
+	0x2e, 0x9c, 0x51, 0xcf, 0xd1, 0x6d, 0x4e, 0xb1, 0xaa, 0xd5, 0x04, 0x6c, 0x4b, 0xb7, 0x31, 0x3a,
+	0x0b, 0x15, 0xea, 0xda, 0x7b, 0xa6, 0x11, 0x18, 0xf8, 0x3c, 0x1d, 0x6f, 0x1a, 0x04, 0x9d, 0x03,
+	0x60, 0x9f, 0x74, 0xc3, 0xf0, 0x78, 0x88, 0x5d, 0xd5, 0xaa, 0x14, 0xf2, 0x3a, 0x05, 0xa8, 0xff,
+	0x29, 0xc0, 0xe2, 0xeb, 0x86, 0x21, 0x73, 0xe4, 0x1f, 0xef, 0x7d, 0x93, 0x72, 0xd2, 0xa5, 0x43,
+	0x38, 0xe9, 0x72, 0x96, 0x93, 0x46, 0x1b, 0xd0, 0x20, 0x18, 0xbf, 0xd7, 0x1b, 0xb9, 0x84, 0x79,
+	0x19, 0x16, 0xa6, 0xd5, 0x56, 0xd5, 0xf8, 0x6e, 0xc2, 0x6c, 0xf8, 0x0e, 0x19, 0x6e, 0x0b, 0x4c,
+	0xad, 0x4e, 0x27, 0x06, 0x23, 0x74, 0x0f, 0x16, 0x87, 0x96, 0xdb, 0xd7, 0xad, 0x1e, 0xc1, 0xba,
+	0x85, 0x8d, 0x9e, 0xf0, 0x20, 0x3c, 0xa8, 0xcb, 0x71, 0x84, 0x4f, 0xf1, 0xe9, 0x3b, 0x6c, 0xb6,
+	0xf8, 0x40, 0xd4, 0x7f, 0x29, 0x70, 0x56, 0xc3, 0xb6, 0xfb, 0x08, 0xff, 0xaf, 0xaa, 0x40, 0xfd,
+	0xb1, 0x02, 0x75, 0x7a, 0x66, 0xef, 0x60, 0x5f, 0xa7, 0x92, 0x40, 0xaf, 0x41, 0x95, 0x05, 0xc5,
+	0xfe, 0xfe, 0x88, 0x6f, 0xad, 0x99, 0xdc, 0x1a, 0x97, 0x1e, 0x9d, 0x74, 0x77, 0x7f, 0x84, 0xb5,
+	0x8a, 0x25, 0x7e, 0xe5, 0x0a, 0x22, 0x93, 0xf7, 0x61, 0x51, 0x72, 0x1f, 0xfe, 0xa5, 0x08, 0x8b,
+	0x5f, 0xd1, 0xfd, 0xc1, 0xde, 0xba, 0xfd, 0x6c, 0xc3, 0xac, 0x3c, 0xb1, 0x62, 0x78, 0x59, 0x94,
+	0x65, 0x96, 0x46, 0x6b, 0x35, 0x2b, 0xf7, 0x85, 0x1a, 0x22, 0x97, 0x45, 0x24, 0x98, 0x9e, 0x3b,
+	0x4a, 0x86, 0xb3, 0x06, 0x0d, 0xfc, 0x64, 0x60, 0x8d, 0xa9, 0x5b, 0x61, 0xd4, 0xb9, 0x9d, 0x9f,
+	0x97, 0x50, 0x8f, 0x9a, 0x79, 0x5d, 0x4c, 0xda, 0x14, 0x3c, 0x70, 0x55, 0xdb, 0xd8, 0xd7, 0x3b,
+	0x15, 0xc6, 0xc6, 0x52, 0x96, 0xaa, 0x03, 0xfb, 0xe0, 0xea, 0xa6, 0x23, 0xf4, 0x1c, 0x54, 0x45,
+	0x3e, 0xb5, 0xb9, 0xde, 0xa9, 0x32, 0xf1, 0x4d, 0x00, 0xea, 0x07, 0x05, 0x38, 0xcb, 0x95, 0x88,
+	0x2d, 0x5f, 0x7f, 0xb6, 0x7a, 0x0c, 0x75, 0x54, 0x3a, 0x94, 0x8e, 0xce, 0x01, 0x04, 0x69, 0xa4,
+	0x69, 0x74, 0xca, 0xf1, 0x1d, 0x1a, 0x71, 0xf1, 0x55, 0x0f, 0x2b, 0x3e, 0xf5, 0x4f, 0x25, 0x68,
+	0x09, 0xdd, 0x50, 0x0c, 0xfa, 0x95, 0x8a, 0x34, 0x8c, 0x7d, 0x44, 0x6c, 0x3e, 0x01, 0x24, 0xb3,
+	0xc2, 0x42, 0x2a, 0x2b, 0xcc, 0x25, 0x8c, 0x20, 0x92, 0x2d, 0x45, 0x22, 0xd9, 0x73, 0x00, 0xbb,
+	0xd6, 0x98, 0xec, 0xf5, 0x7c, 0xd3, 0xc6, 0xc1, 0x4e, 0x19, 0xe4, 0xae, 0x69, 0x63, 0xf4, 0x3a,
+	0xd4, 0xfb, 0xa6, 0x63, 0xb9, 0xc3, 0xde, 0x48, 0xf7, 0xf7, 0x78, 0xdd, 0x43, 0x6e, 0x6c, 0x2c,
+	0x71, 0xbe, 0xc9, 0x70, 0xb5, 0x1a, 0x9f, 0xb3, 0x4d, 0xa7, 0xa0, 0xf3, 0x50, 0x73, 0xc6, 0x76,
+	0xcf, 0xdd, 0xed, 0x79, 0xee, 0x63, 0x6a, 0xae, 0x8c, 0x84, 0x33, 0xb6, 0xdf, 0xde, 0xd5, 0xdc,
+	0xc7, 0x34, 0xf6, 0xa8, 0x12, 0x5f, 0xf7, 0x89, 0xe5, 0x0e, 0x49, 0xa7, 0x92, 0x6b, 0xfd, 0xc9,
+	0x04, 0x3a, 0xdb, 0xa0, 0x66, 0xc6, 0x66, 0x57, 0xf3, 0xcd, 0x0e, 0x27, 0xa0, 0x2b, 0xd0, 0x1c,
+	0xb8, 0xf6, 0x48, 0x67, 0x12, 0xba, 0xe5, 0xb9, 0x76, 0x07, 0xd8, 0x41, 0x4f, 0x40, 0xd1, 0x1a,
+	0xd4, 0x4c, 0xc7, 0xc0, 0x4f, 0xc4, 0x91, 0xab, 0x2d, 0x15, 0xd3, 0x97, 0x15, 0x57, 0x39, 0x23,
+	0xb4, 0x49, 0x71, 0x99, 0xd2, 0xc1, 0x0c, 0x7e, 0x12, 0x1a, 0x30, 0x08, 0x8d, 0xf6, 0x88, 0xf9,
+	0x3e, 0xee, 0xd4, 0xb9, 0x16, 0x05, 0x6c, 0xc7, 0x7c, 0x1f, 0xd3, 0xf2, 0x85, 0xe9, 0x10, 0xec,
+	0x4d, 0xfc, 0x77, 0x83, 0xf9, 0xef, 0x06, 0x87, 0x06, 0xae, 0xfb, 0xa3, 0x02, 0x34, 0xe3, 0x84,
+	0x68, 0xea, 0xc6, 0x8a, 0x19, 0xa1, 0xf5, 0x04, 0x43, 0x4a, 0x16, 0x3b, 0x7a, 0xdf, 0xa2, 0xfe,
+	0xc2, 0xc0, 0x4f, 0x98, 0xf1, 0x54, 0xb4, 0x1a, 0x87, 0xb1, 0x05, 0xa8, 0x11, 0xf0, 0xed, 0xb1,
+	0x40, 0x86, 0xa7, 0x56, 0x55, 0x06, 0x61, 0x61, 0x4c, 0x07, 0xe6, 0xf9, 0x36, 0x02, 0xd3, 0x09,
+	0x86, 0xf4, 0x4b, 0x7f, 0x6c, 0x32, 0xaa, 0xdc, 0x74, 0x82, 0x21, 0x5a, 0x87, 0x3a, 0x5f, 0x72,
+	0xa4, 0x7b, 0xba, 0x1d, 0x18, 0xce, 0x05, 0xe9, 0x71, 0x7f, 0x0b, 0xef, 0xdf, 0xd7, 0xad, 0x31,
+	0xde, 0xd6, 0x4d, 0x4f, 0xe3, 0x82, 0xde, 0x66, 0xb3, 0xd0, 0x32, 0xb4, 0xf9, 0x2a, 0xbb, 0xa6,
+	0x85, 0x85, 0x09, 0xf2, 0x62, 0x4d, 0x93, 0xc1, 0x6f, 0x99, 0x16, 0xe6, 0x56, 0x16, 0x6e, 0x81,
+	0x89, 0xb6, 0xc2, 0x8d, 0x8c, 0x41, 0xa8, 0x60, 0xd5, 0xef, 0x14, 0x61, 0x81, 0x9e, 0xb5, 0xe0,
+	0x82, 0x3f, 0xba, 0x37, 0x3a, 0x07, 0x60, 0x10, 0xbf, 0x17, 0xf3, 0x48, 0x55, 0x83, 0xf8, 0x5b,
+	0x0c, 0x80, 0x5e, 0x0b, 0x1c, 0x4e, 0x31, 0x3b, 0xd9, 0x4a, 0x9c, 0xfd, 0xf4, 0xc5, 0x70, 0xa4,
+	0x42, 0xe6, 0x45, 0x68, 0x10, 0x77, 0xec, 0x0d, 0x70, 0x2f, 0x56, 0xc1, 0xa8, 0x73, 0xe0, 0x96,
+	0xdc, 0x67, 0xce, 0x49, 0xab, 0x3d, 0x11, 0xef, 0x36, 0x3f, 0xdb, 0xe5, 0x50, 0x49, 0x5e, 0x0e,
+	0xff, 0x98, 0x14, 0x52, 0x66, 0xd7, 0x45, 0xd6, 0xcd, 0x10, 0x38, 0xba, 0xe2, 0x01, 0x29, 0x7b,
+	0x29, 0xc7, 0xad, 0x5f, 0x96, 0xdc, 0xfa, 0xf1, 0xb4, 0x75, 0x2e, 0x99, 0xb6, 0xaa, 0xbf, 0x53,
+	0xa0, 0xb1, 0x83, 0x75, 0x6f, 0xb0, 0x17, 0xec, 0xeb, 0x73, 0x50, 0xf4, 0xf0, 0x43, 0xb1, 0xad,
+	0x4b, 0x19, 0x11, 0x6e, 0x6c, 0x8a, 0x46, 0x27, 0xd0, 0x22, 0xa5, 0x61, 0x5b, 0x89, 0xea, 0x08,
+	0x18, 0xb6, 0x15, 0xc4, 0x7c, 0x71, 0x56, 0x8a, 0xa9, 0x0c, 0xfa, 0x0a, 0xb4, 0x4c, 0xd2, 0x63,
+	0x49, 0x5a, 0xcf, 0x62, 0x99, 0x0b, 0xdb, 0x75, 0x45, 0x6b, 0x98, 0x24, 0x92, 0xce, 0xa8, 0xbf,
+	0x57, 0xa0, 0xfe, 0x0e, 0x0f, 0x10, 0x39, 0xc7, 0xaf, 0x46, 0x39, 0xbe, 0x92, 0xc1, 0xb1, 0x86,
+	0x7d, 0xcf, 0xc4, 0x8f, 0xf0, 0xb3, 0xe1, 0xf9, 0xaf, 0x0a, 0x74, 0x69, 0x81, 0x56, 0xe3, 0x96,
+	0x35, 0xbb, 0x2d, 0x5d, 0x84, 0xc6, 0xa3, 0x58, 0x3e, 0x27, 0xaa, 0x51, 0x8f, 0xa2, 0x09, 0x9d,
+	0x06, 0xed, 0x20, 0x2e, 0x08, 0xf3, 0x0c, 0x7e, 0xd0, 0x5f, 0x90, 0x9d, 0x90, 0x04, 0x73, 0xec,
+	0xa0, 0xb4, 0xbc, 0x38, 0x50, 0xfd, 0x12, 0xd4, 0xd7, 0x3d, 0xdd, 0x3c, 0x7a, 0x09, 0x57, 0x7d,
+	0x00, 0x0d, 0xb1, 0xc2, 0x2c, 0x35, 0x80, 0x53, 0x50, 0xa6, 0xbf, 0x82, 0x8d, 0xf3, 0x81, 0xfa,
+	0x33, 0x05, 0x2e, 0x4c, 0x2a, 0x2c, 0xa9, 0x1c, 0x7f, 0x16, 0x82, 0x1b, 0x50, 0x09, 0x85, 0xc8,
+	0xcb, 0x0e, 0xd7, 0xe2, 0xd3, 0xc4, 0x20, 0x83, 0x76, 0x38, 0x59, 0xf5, 0x60, 0x41, 0x22, 0x69,
+	0x74, 0x06, 0xe6, 0x45, 0xf6, 0xdd, 0x51, 0x22, 0xee, 0xc1, 0xa0, 0x37, 0xe2, 0xa4, 0x42, 0x66,
+	0x1a, 0xe9, 0x70, 0xca, 0xa0, 0x76, 0x1c, 0xdc, 0xd5, 0xa6, 0xc1, 0x75, 0x1c, 0xb1, 0x53, 0x83,
+	0xa8, 0x3f, 0x52, 0x60, 0xf1, 0x0d, 0xdd, 0x31, 0xdc, 0xdd, 0xdd, 0xd9, 0x6d, 0x6f, 0x2d, 0x8c,
+	0x0c, 0x36, 0x0f, 0x53, 0x7d, 0x8a, 0x4d, 0xa2, 0x2f, 0x3c, 0x88, 0xca, 0xe8, 0xa6, 0x6e, 0xe9,
+	0xce, 0x00, 0x1f, 0x9d, 0x9b, 0xcb, 0xd0, 0x8c, 0x5d, 0x24, 0xe1, 0xeb, 0x6b, 0xf4, 0x26, 0x21,
+	0xe8, 0x2d, 0x68, 0xf6, 0x39, 0xa9, 0x9e, 0x87, 0x75, 0xe2, 0x3a, 0xcc, 0xdd, 0x36, 0xe5, 0xb5,
+	0xa3, 0xbb, 0x9e, 0x39, 0x1c, 0x62, 0x6f, 0xcd, 0x75, 0x0c, 0x9e, 0xc5, 0x37, 0xfa, 0x01, 0x9b,
+	0x74, 0x2a, 0xf3, 0x1b, 0xe1, 0xad, 0x1a, 0xa4, 0x5b, 0x10, 0x5e, 0xab, 0x04, 0x5d, 0x83, 0x93,
+	0xf1, 0x04, 0x7f, 0xe2, 0x9f, 0xdb, 0x24, 0x9a, 0xbb, 0xcb, 0x4a, 0x87, 0x92, 0x5b, 0x4e, 0xfd,
+	0x83, 0x02, 0x28, 0xcc, 0x32, 0x59, 0xba, 0xc2, 0x8c, 0x26, 0x4f, 0x99, 0xfc, 0x39, 0xa8, 0x1a,
+	0xc1, 0x4c, 0x71, 0x5a, 0x26, 0x00, 0xea, 0x48, 0xf8, 0x36, 0x7a, 0xf4, 0x4a, 0xc4, 0x46, 0x10,
+	0x8a, 0x73, 0xe0, 0x6d, 0x06, 0x8b, 0x5f, 0x92, 0xa5, 0xc4, 0x25, 0x19, 0xab, 0x1b, 0x95, 0x63,
+	0x75, 0x23, 0xf5, 0xc3, 0x02, 0xb4, 0xa3, 0x25, 0x89, 0xdc, 0x4c, 0x1f, 0x4f, 0xb5, 0xfd, 0x80,
+	0xfa, 0x4b, 0x69, 0x86, 0xfa, 0x4b, 0xba, 0x3e, 0x54, 0x3e, 0x5a, 0x7d, 0x48, 0xfd, 0x40, 0x81,
+	0x56, 0xa2, 0xb8, 0x9d, 0xcc, 0xa6, 0x94, 0x74, 0x36, 0xf5, 0x6a, 0xd4, 0x17, 0x36, 0xe5, 0x91,
+	0x7e, 0x7c, 0x55, 0xe1, 0x2f, 0xd1, 0x75, 0x58, 0x90, 0x3c, 0xa2, 0x0b, 0x1b, 0x40, 0xe9, 0x37,
+	0x74, 0xf5, 0x8f, 0x25, 0xa8, 0x45, 0xe4, 0x31, 0x25, 0x11, 0x7c, 0x2a, 0x4f, 0x88, 0x59, 0x8f,
+	0xc4, 0xd4, 0xee, 0x6c, 0x6c, 0xf3, 0x10, 0x5a, 0xc4, 0xf3, 0x36, 0xb6, 0x59, 0x66, 0x42, 0x4d,
+	0x72, 0x6c, 0xf3, 0x14, 0x8e, 0x1f, 0xa7, 0x79, 0x67, 0x6c, 0xb3, 0x04, 0x2e, 0x9e, 0x3d, 0xcc,
+	0x1f, 0x90, 0x3d, 0x54, 0xe2, 0xd9, 0x43, 0xec, 0x1c, 0x55, 0x93, 0xe7, 0x28, 0x6f, 0x6e, 0x76,
+	0x03, 0x16, 0x06, 0xfc, 0x89, 0xf6, 0xe6, 0xfe, 0x5a, 0xf8, 0xa9, 0x53, 0x63, 0x51, 0x83, 0xec,
+	0x13, 0xba, 0x05, 0x0d, 0x21, 0xd1, 0x1e, 0xd7, 0x72, 0x9d, 0x69, 0x59, 0x9e, 0x9c, 0x08, 0xdd,
+	0x70, 0x25, 0xd7, 0x49, 0x64, 0x94, 0xcc, 0x0a, 0x1b, 0x47, 0xca, 0x0a, 0x9f, 0x87, 0xda, 0xa4,
+	0xd4, 0x40, 0x3a, 0x4d, 0xee, 0xf9, 0xc2, 0x5a, 0x03, 0x89, 0x39, 0x83, 0x56, 0xdc, 0x19, 0xfc,
+	0xbd, 0x08, 0xcd, 0x49, 0x3e, 0x90, 0xdb, 0x15, 0xe4, 0x69, 0x06, 0xd9, 0x82, 0xf6, 0xe4, 0x8e,
+	0x64, 0x52, 0x3a, 0x30, 0xa5, 0x49, 0xbe, 0x1f, 0xb5, 0x46, 0x71, 0x40, 0xbc, 0xb8, 0x58, 0x3a,
+	0x54, 0x71, 0x71, 0xc6, 0x96, 0x80, 0x97, 0xe1, 0xb4, 0xc7, 0x13, 0x0e, 0xa3, 0x17, 0xdb, 0x36,
+	0x8f, 0xdd, 0x4f, 0x05, 0x1f, 0xb7, 0xa3, 0xdb, 0xcf, 0x38, 0xc6, 0xf3, 0x59, 0xc7, 0x38, 0xa9,
+	0xc6, 0x4a, 0x4a, 0x8d, 0xe9, 0xce, 0x84, 0xaa, 0xa4, 0x33, 0x41, 0xbd, 0x07, 0x0b, 0xf7, 0x1c,
+	0x32, 0xee, 0xd3, 0x47, 0xb7, 0x7e, 0xf8, 0xd6, 0x9c, 0x4b, 0xad, 0xd1, 0xa7, 0xe1, 0x42, 0xe2,
+	0x69, 0xf8, 0xfb, 0x0a, 0x2c, 0xa6, 0xd7, 0x65, 0x16, 0x33, 0x71, 0x06, 0x4a, 0xcc, 0x19, 0x7c,
+	0x15, 0x16, 0x26, 0xcb, 0xf7, 0x62, 0x2b, 0x67, 0x84, 0xbb, 0x12, 0xc6, 0x35, 0x34, 0x59, 0x23,
+	0x80, 0xa9, 0xff, 0x56, 0xe0, 0xa4, 0x38, 0x56, 0x14, 0x36, 0x64, 0x45, 0x49, 0x7a, 0x41, 0xb9,
+	0x8e, 0x65, 0x3a, 0xb8, 0x17, 0x63, 0xa7, 0xce, 0x81, 0x22, 0x7f, 0x7d, 0x03, 0x5a, 0x02, 0x29,
+	0x11, 0x3a, 0x4e, 0xbd, 0x67, 0x9a, 0x7c, 0x5e, 0x78, 0xc3, 0x5c, 0x86, 0xa6, 0xbb, 0xbb, 0x1b,
+	0xa5, 0xc7, 0x1d, 0x65, 0x43, 0x40, 0x05, 0xc1, 0x37, 0xa1, 0x1d, 0xa0, 0x1d, 0xf6, 0x66, 0x6b,
+	0x89, 0x89, 0x61, 0xa4, 0xff, 0x3d, 0x05, 0x3a, 0xf1, 0x7b, 0x2e, 0xb2, 0xfd, 0xc3, 0xc7, 0x69,
+	0x9f, 0x8f, 0x3f, 0x56, 0x5e, 0x3e, 0x80, 0x9f, 0x09, 0x1d, 0x51, 0x6c, 0xb8, 0xfa, 0x3e, 0x34,
+	0xe3, 0x67, 0x16, 0xd5, 0xa1, 0xb2, 0xe5, 0xfa, 0x5f, 0x7e, 0x62, 0x12, 0xbf, 0x7d, 0x02, 0x35,
+	0x01, 0xb6, 0x5c, 0x7f, 0xdb, 0xc3, 0x04, 0x3b, 0x7e, 0x5b, 0x41, 0x00, 0x73, 0x6f, 0x3b, 0xeb,
+	0x26, 0x79, 0xaf, 0x5d, 0x40, 0x0b, 0xe2, 0x4a, 0xd5, 0xad, 0x4d, 0x71, 0x10, 0xda, 0x45, 0x3a,
+	0x3d, 0x1c, 0x95, 0x50, 0x1b, 0xea, 0x21, 0xca, 0xc6, 0xf6, 0xbd, 0x76, 0x19, 0x55, 0xa1, 0xcc,
+	0x7f, 0xce, 0x5d, 0x35, 0xa0, 0x9d, 0x8c, 0x07, 0xe9, 0x9a, 0xf7, 0x9c, 0xb7, 0x1c, 0xf7, 0x71,
+	0x08, 0x6a, 0x9f, 0x40, 0x35, 0x98, 0x17, 0x31, 0x76, 0x5b, 0x41, 0x2d, 0xa8, 0x45, 0xc2, 0xdb,
+	0x76, 0x81, 0x02, 0x36, 0xbc, 0xd1, 0x40, 0x04, 0xba, 0x9c, 0x05, 0xaa, 0xb5, 0x75, 0xf7, 0xb1,
+	0xd3, 0x2e, 0x5d, 0xbd, 0x09, 0x95, 0xc0, 0x99, 0x50, 0x54, 0xbe, 0xba, 0x43, 0x87, 0xed, 0x13,
+	0xe8, 0x24, 0x34, 0x62, 0xdd, 0x50, 0x6d, 0x05, 0x21, 0x68, 0xc6, 0x5b, 0xd9, 0xda, 0x85, 0xd5,
+	0x7f, 0x2e, 0x00, 0xf0, 0x68, 0xcb, 0x75, 0x3d, 0x03, 0x8d, 0x00, 0x6d, 0x60, 0x9f, 0xde, 0x24,
+	0xae, 0x13, 0xdc, 0x02, 0x04, 0xdd, 0xc8, 0x08, 0x4a, 0xd2, 0xa8, 0x82, 0xd5, 0x6e, 0x56, 0x4a,
+	0x9d, 0x40, 0x57, 0x4f, 0x20, 0x9b, 0x51, 0xa4, 0xa5, 0xd8, 0xbb, 0xe6, 0xe0, 0xbd, 0x30, 0x4c,
+	0xcb, 0xa6, 0x98, 0x40, 0x0d, 0x28, 0x5e, 0x94, 0x67, 0x56, 0xbe, 0x67, 0x3a, 0xc3, 0x20, 0x8b,
+	0x53, 0x4f, 0xa0, 0x87, 0x70, 0x8a, 0x26, 0x7b, 0xbe, 0xee, 0x9b, 0xc4, 0x37, 0x07, 0x24, 0x20,
+	0xb8, 0x9a, 0x4d, 0x30, 0x85, 0x7c, 0x48, 0x92, 0x16, 0xb4, 0x12, 0xad, 0xa3, 0xe8, 0xaa, 0xfc,
+	0xf5, 0x59, 0xd6, 0xe6, 0xda, 0xbd, 0x96, 0x0b, 0x37, 0xa4, 0x66, 0x42, 0x33, 0xde, 0x56, 0x89,
+	0x3e, 0x9d, 0xb5, 0x40, 0xaa, 0x55, 0xa9, 0x7b, 0x35, 0x0f, 0x6a, 0x48, 0xea, 0x01, 0xb7, 0xa7,
+	0x69, 0xa4, 0xa4, 0x4d, 0x79, 0xdd, 0x83, 0x12, 0x68, 0xf5, 0x04, 0xfa, 0x06, 0x9c, 0x4c, 0x35,
+	0x54, 0xa1, 0x17, 0xe5, 0x25, 0x08, 0x79, 0xdf, 0xd5, 0x34, 0x0a, 0x0f, 0x92, 0xa7, 0x21, 0x9b,
+	0xfb, 0x54, 0x17, 0x5a, 0x7e, 0xee, 0x23, 0xcb, 0x1f, 0xc4, 0xfd, 0xa1, 0x29, 0x8c, 0x01, 0xa5,
+	0xbb, 0x95, 0xd0, 0x4b, 0x32, 0x12, 0x99, 0x1d, 0x53, 0xdd, 0x95, 0xbc, 0xe8, 0xa1, 0xca, 0xc7,
+	0xec, 0xb4, 0x26, 0xd3, 0x0d, 0x29, 0xd9, 0xcc, 0x0e, 0xa5, 0xee, 0x4a, 0x5e, 0xf4, 0xa8, 0x51,
+	0xc7, 0x9b, 0x60, 0xe4, 0xba, 0x92, 0x36, 0xee, 0x74, 0xaf, 0xe6, 0x41, 0x0d, 0x49, 0xdd, 0x8d,
+	0x39, 0x61, 0x74, 0x25, 0xcb, 0x26, 0xe2, 0x45, 0x88, 0x69, 0xea, 0xea, 0x01, 0x6c, 0x60, 0xff,
+	0x0e, 0xf6, 0x3d, 0x73, 0x40, 0x92, 0x8b, 0x8a, 0xc1, 0x04, 0x21, 0x58, 0xf4, 0x85, 0xa9, 0x78,
+	0x21, 0xdb, 0x7d, 0xa8, 0xb1, 0x76, 0x11, 0x16, 0x69, 0x11, 0x94, 0x39, 0x33, 0xc0, 0x08, 0x48,
+	0x2c, 0x4f, 0x47, 0x8c, 0x3a, 0xb2, 0x44, 0x4f, 0x0e, 0xca, 0x94, 0x6d, 0xba, 0x53, 0xa8, 0x7b,
+	0x2d, 0x17, 0x6e, 0x84, 0xda, 0x99, 0x8c, 0xfe, 0x54, 0xb4, 0x2a, 0x5b, 0xe9, 0xe0, 0x66, 0xd6,
+	0x5c, 0x27, 0x36, 0xd1, 0x72, 0x9a, 0x75, 0x62, 0xe5, 0x9d, 0xa9, 0xd3, 0x28, 0x3c, 0x62, 0x47,
+	0x27, 0x51, 0xe3, 0xcb, 0x3c, 0x3a, 0xf2, 0x5e, 0xa3, 0xee, 0xf5, 0x2c, 0x75, 0x65, 0xd4, 0x2d,
+	0xd5, 0x13, 0xe8, 0x9b, 0xec, 0xec, 0x44, 0x1a, 0x89, 0x32, 0xcf, 0x4e, 0xba, 0xd9, 0xa8, 0x7b,
+	0x2d, 0xdb, 0x3c, 0x22, 0xb8, 0x11, 0x2b, 0x84, 0x35, 0xd7, 0x33, 0x5c, 0x87, 0x46, 0x29, 0x19,
+	0x66, 0x3e, 0x41, 0xc8, 0x38, 0xa0, 0x31, 0x3c, 0x6c, 0x50, 0xcc, 0x28, 0x8d, 0x5d, 0xa8, 0xdf,
+	0x73, 0x06, 0x13, 0x2a, 0x72, 0x0b, 0x8e, 0xa2, 0x1c, 0x8d, 0x8e, 0x03, 0x6d, 0x16, 0xdf, 0x44,
+	0xbe, 0xa2, 0x17, 0xa5, 0x2b, 0x24, 0xd1, 0x8e, 0x46, 0xef, 0x3e, 0xcc, 0x07, 0x4e, 0x47, 0x1e,
+	0x58, 0x24, 0x3c, 0xce, 0xa5, 0x83, 0x91, 0x82, 0x75, 0x57, 0xff, 0xd6, 0x82, 0x2a, 0xf3, 0xe6,
+	0x4c, 0x5a, 0xff, 0x0f, 0xf0, 0x9e, 0x7e, 0x80, 0xf7, 0x2e, 0xb4, 0x12, 0x9d, 0x6c, 0x72, 0xbf,
+	0x28, 0x6f, 0x77, 0x9b, 0xe6, 0x37, 0xfa, 0x80, 0xd2, 0x7d, 0x5a, 0x72, 0xbf, 0x91, 0xd9, 0xcf,
+	0x35, 0x8d, 0xc6, 0xbb, 0xd0, 0x4a, 0x34, 0x25, 0xc9, 0x77, 0x20, 0xef, 0x5c, 0xca, 0xb1, 0x83,
+	0x74, 0xb7, 0x8c, 0x7c, 0x07, 0x99, 0x5d, 0x35, 0xd3, 0x68, 0xdc, 0xe7, 0xad, 0x5e, 0x61, 0xf2,
+	0xfb, 0x42, 0xd6, 0xbd, 0x9d, 0x78, 0xcb, 0x78, 0xf6, 0x91, 0xdc, 0xf1, 0x47, 0xba, 0xef, 0x42,
+	0x2b, 0xf1, 0x20, 0x2d, 0xd7, 0xae, 0xfc, 0xd5, 0x3a, 0xff, 0xea, 0x07, 0xdb, 0x8e, 0xfc, 0xcf,
+	0x05, 0xd3, 0x56, 0xff, 0x18, 0x23, 0x3f, 0x03, 0x16, 0x24, 0xef, 0xad, 0x68, 0x25, 0x2b, 0xd8,
+	0x90, 0x3f, 0xcc, 0x4e, 0xdb, 0xd0, 0xd7, 0x65, 0x81, 0xc6, 0xd3, 0xcb, 0x9b, 0xb6, 0xa0, 0xcc,
+	0x5e, 0x4a, 0x91, 0xb4, 0xa1, 0x21, 0xfa, 0x0c, 0xdb, 0xbd, 0x70, 0x00, 0x46, 0x28, 0x94, 0x6f,
+	0xf1, 0xff, 0x1a, 0xc8, 0x5f, 0x28, 0x0f, 0x1b, 0xbd, 0xbc, 0x72, 0xb0, 0x3e, 0xb2, 0x63, 0x98,
+	0x1d, 0x98, 0xe3, 0xed, 0x03, 0x48, 0xca, 0x74, 0xac, 0xb5, 0xa0, 0x3b, 0xad, 0x01, 0x81, 0x8c,
+	0x2d, 0x9f, 0xb0, 0x45, 0xcb, 0xcc, 0x55, 0xca, 0x45, 0x15, 0x6d, 0x17, 0xe8, 0x4e, 0xef, 0x10,
+	0x08, 0x16, 0x3d, 0xee, 0x40, 0xff, 0xe6, 0x67, 0x1f, 0xac, 0x0e, 0x4d, 0x7f, 0x6f, 0xdc, 0xa7,
+	0xaa, 0xbf, 0xce, 0x31, 0x5f, 0x32, 0x5d, 0xf1, 0xeb, 0x7a, 0xc0, 0xda, 0x75, 0xb6, 0xd2, 0x75,
+	0xb6, 0x97, 0x51, 0xbf, 0x3f, 0xc7, 0x86, 0x2f, 0xff, 0x77, 0x00, 0xf0, 0xc6, 0x75, 0xf5, 0xc2,
+	0x3b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3193,6 +3639,19 @@ type QueryCoordClient interface {
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+23+--+Multiple+memory+replication+design
 	GetReplicas(ctx context.Context, in *milvuspb.GetReplicasRequest, opts ...grpc.CallOption) (*milvuspb.GetReplicasResponse, error)
 	GetShardLeaders(ctx context.Context, in *GetShardLeadersRequest, opts ...grpc.CallOption) (*GetShardLeadersResponse, error)
+	SyncNewCreatedPartition(ctx context.Context, in *SyncNewCreatedPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// replaces the schema of a loaded collection once it's changed by RootCoord, and sends it to the query nodes
+	RefreshCollection(ctx context.Context, in *RefreshCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// the progress of the segments being loaded is collected from the query nodes
+	GetLoadingProgress(ctx context.Context, in *GetLoadingProgressRequest, opts ...grpc.CallOption) (*milvuspb.GetLoadingProgressResponse, error)
+	// returns the release jobs of the collection, or of all the collections if collectionID is 0
+	GetReleaseJobs(ctx context.Context, in *GetReleaseJobsRequest, opts ...grpc.CallOption) (*milvuspb.GetReleaseJobsResponse, error)
+	// the cordoned query nodes are never assigned new segments or channels, the ones they serve are drained off
+	CordonNode(ctx context.Context, in *milvuspb.CordonNodeRequest, opts ...grpc.CallOption) (*milvuspb.CordonedNodesResponse, error)
+	UncordonNode(ctx context.Context, in *milvuspb.UncordonNodeRequest, opts ...grpc.CallOption) (*milvuspb.CordonedNodesResponse, error)
+	GetCordonedNodes(ctx context.Context, in *milvuspb.GetCordonedNodesRequest, opts ...grpc.CallOption) (*milvuspb.CordonedNodesResponse, error)
+	// plans a balance round on demand, the moves are executed in the background unless it's a dry run
+	Balance(ctx context.Context, in *milvuspb.BalanceRequest, opts ...grpc.CallOption) (*milvuspb.BalanceResponse, error)
 }
 
 type queryCoordClient struct {
@@ -3347,6 +3806,78 @@ func (c *queryCoordClient) GetShardLeaders(ctx context.Context, in *GetShardLead
 	return out, nil
 }
 
+func (c *queryCoordClient) SyncNewCreatedPartition(ctx context.Context, in *SyncNewCreatedPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/SyncNewCreatedPartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) RefreshCollection(ctx context.Context, in *RefreshCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/RefreshCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetLoadingProgress(ctx context.Context, in *GetLoadingProgressRequest, opts ...grpc.CallOption) (*milvuspb.GetLoadingProgressResponse, error) {
+	out := new(milvuspb.GetLoadingProgressResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetLoadingProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetReleaseJobs(ctx context.Context, in *GetReleaseJobsRequest, opts ...grpc.CallOption) (*milvuspb.GetReleaseJobsResponse, error) {
+	out := new(milvuspb.GetReleaseJobsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetReleaseJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) CordonNode(ctx context.Context, in *milvuspb.CordonNodeRequest, opts ...grpc.CallOption) (*milvuspb.CordonedNodesResponse, error) {
+	out := new(milvuspb.CordonedNodesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/CordonNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) UncordonNode(ctx context.Context, in *milvuspb.UncordonNodeRequest, opts ...grpc.CallOption) (*milvuspb.CordonedNodesResponse, error) {
+	out := new(milvuspb.CordonedNodesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/UncordonNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetCordonedNodes(ctx context.Context, in *milvuspb.GetCordonedNodesRequest, opts ...grpc.CallOption) (*milvuspb.CordonedNodesResponse, error) {
+	out := new(milvuspb.CordonedNodesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetCordonedNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) Balance(ctx context.Context, in *milvuspb.BalanceRequest, opts ...grpc.CallOption) (*milvuspb.BalanceResponse, error) {
+	out := new(milvuspb.BalanceResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/Balance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryCoordServer is the server API for QueryCoord service.
 type QueryCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -3367,6 +3898,19 @@ type QueryCoordServer interface {
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+23+--+Multiple+memory+replication+design
 	GetReplicas(context.Context, *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error)
 	GetShardLeaders(context.Context, *GetShardLeadersRequest) (*GetShardLeadersResponse, error)
+	SyncNewCreatedPartition(context.Context, *SyncNewCreatedPartitionRequest) (*commonpb.Status, error)
+	// replaces the schema of a loaded collection once it's changed by RootCoord, and sends it to the query nodes
+	RefreshCollection(context.Context, *RefreshCollectionRequest) (*commonpb.Status, error)
+	// the progress of the segments being loaded is collected from the query nodes
+	GetLoadingProgress(context.Context, *GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error)
+	// returns the release jobs of the collection, or of all the collections if collectionID is 0
+	GetReleaseJobs(context.Context, *GetReleaseJobsRequest) (*milvuspb.GetReleaseJobsResponse, error)
+	// the cordoned query nodes are never assigned new segments or channels, the ones they serve are drained off
+	CordonNode(context.Context, *milvuspb.CordonNodeRequest) (*milvuspb.CordonedNodesResponse, error)
+	UncordonNode(context.Context, *milvuspb.UncordonNodeRequest) (*milvuspb.CordonedNodesResponse, error)
+	GetCordonedNodes(context.Context, *milvuspb.GetCordonedNodesRequest) (*milvuspb.CordonedNodesResponse, error)
+	// plans a balance round on demand, the moves are executed in the background unless it's a dry run
+	Balance(context.Context, *milvuspb.BalanceRequest) (*milvuspb.BalanceResponse, error)
 }
 
 // UnimplementedQueryCoordServer can be embedded to have forward compatible implementations.
@@ -3421,6 +3965,30 @@ func (*UnimplementedQueryCoordServer) GetReplicas(ctx context.Context, req *milv
 func (*UnimplementedQueryCoordServer) GetShardLeaders(ctx context.Context, req *GetShardLeadersRequest) (*GetShardLeadersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetShardLeaders not implemented")
 }
+func (*UnimplementedQueryCoordServer) SyncNewCreatedPartition(ctx context.Context, req *SyncNewCreatedPartitionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncNewCreatedPartition not implemented")
+}
+func (*UnimplementedQueryCoordServer) RefreshCollection(ctx context.Context, req *RefreshCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshCollection not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetLoadingProgress(ctx context.Context, req *GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoadingProgress not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetReleaseJobs(ctx context.Context, req *GetReleaseJobsRequest) (*milvuspb.GetReleaseJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseJobs not implemented")
+}
+func (*UnimplementedQueryCoordServer) CordonNode(ctx context.Context, req *milvuspb.CordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CordonNode not implemented")
+}
+func (*UnimplementedQueryCoordServer) UncordonNode(ctx context.Context, req *milvuspb.UncordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncordonNode not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetCordonedNodes(ctx context.Context, req *milvuspb.GetCordonedNodesRequest) (*milvuspb.CordonedNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCordonedNodes not implemented")
+}
+func (*UnimplementedQueryCoordServer) Balance(ctx context.Context, req *milvuspb.BalanceRequest) (*milvuspb.BalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
+}
 
 func RegisterQueryCoordServer(s *grpc.Server, srv QueryCoordServer) {
 	s.RegisterService(&_QueryCoord_serviceDesc, srv)
@@ -3714,6 +4282,150 @@ func _QueryCoord_GetShardLeaders_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryCoord_SyncNewCreatedPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncNewCreatedPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).SyncNewCreatedPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/SyncNewCreatedPartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).SyncNewCreatedPartition(ctx, req.(*SyncNewCreatedPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_RefreshCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).RefreshCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/RefreshCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).RefreshCollection(ctx, req.(*RefreshCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetLoadingProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoadingProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetLoadingProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetLoadingProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetLoadingProgress(ctx, req.(*GetLoadingProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetReleaseJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetReleaseJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetReleaseJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetReleaseJobs(ctx, req.(*GetReleaseJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_CordonNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CordonNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).CordonNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/CordonNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).CordonNode(ctx, req.(*milvuspb.CordonNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_UncordonNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.UncordonNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).UncordonNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/UncordonNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).UncordonNode(ctx, req.(*milvuspb.UncordonNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetCordonedNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetCordonedNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetCordonedNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetCordonedNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetCordonedNodes(ctx, req.(*milvuspb.GetCordonedNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.BalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/Balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).Balance(ctx, req.(*milvuspb.BalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.query.QueryCoord",
 	HandlerType: (*QueryCoordServer)(nil),
@@ -3782,6 +4494,38 @@ var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetShardLeaders",
 			Handler:    _QueryCoord_GetShardLeaders_Handler,
 		},
+		{
+			MethodName: "SyncNewCreatedPartition",
+			Handler:    _QueryCoord_SyncNewCreatedPartition_Handler,
+		},
+		{
+			MethodName: "RefreshCollection",
+			Handler:    _QueryCoord_RefreshCollection_Handler,
+		},
+		{
+			MethodName: "GetLoadingProgress",
+			Handler:    _QueryCoord_GetLoadingProgress_Handler,
+		},
+		{
+			MethodName: "GetReleaseJobs",
+			Handler:    _QueryCoord_GetReleaseJobs_Handler,
+		},
+		{
+			MethodName: "CordonNode",
+			Handler:    _QueryCoord_CordonNode_Handler,
+		},
+		{
+			MethodName: "UncordonNode",
+			Handler:    _QueryCoord_UncordonNode_Handler,
+		},
+		{
+			MethodName: "GetCordonedNodes",
+			Handler:    _QueryCoord_GetCordonedNodes_Handler,
+		},
+		{
+			MethodName: "Balance",
+			Handler:    _QueryCoord_Balance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query_coord.proto",
@@ -3802,8 +4546,15 @@ type QueryNodeClient interface {
 	ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ReleaseSegments(ctx context.Context, in *ReleaseSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// releases the dml channels of a collection and their delta channels, the sealed segments are kept
+	ReleaseChannels(ctx context.Context, in *ReleaseChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
 	SyncReplicaSegments(ctx context.Context, in *SyncReplicaSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// refreshes the schema and adds the new partitions of a loaded collection in place
+	RefreshCollection(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// starts draining the node for graceful scale-in, it's idempotent and returns the drain state
+	Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error)
+	GetSegmentLoadingProgress(ctx context.Context, in *GetLoadingProgressRequest, opts ...grpc.CallOption) (*GetSegmentLoadingProgressResponse, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*internalpb.SearchResults, error)
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*internalpb.RetrieveResults, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
@@ -3917,6 +4668,15 @@ func (c *queryNodeClient) ReleaseSegments(ctx context.Context, in *ReleaseSegmen
 	return out, nil
 }
 
+func (c *queryNodeClient) ReleaseChannels(ctx context.Context, in *ReleaseChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/ReleaseChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryNodeClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error) {
 	out := new(GetSegmentInfoResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/GetSegmentInfo", in, out, opts...)
@@ -3935,6 +4695,33 @@ func (c *queryNodeClient) SyncReplicaSegments(ctx context.Context, in *SyncRepli
 	return out, nil
 }
 
+func (c *queryNodeClient) RefreshCollection(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/RefreshCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error) {
+	out := new(DrainResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/Drain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) GetSegmentLoadingProgress(ctx context.Context, in *GetLoadingProgressRequest, opts ...grpc.CallOption) (*GetSegmentLoadingProgressResponse, error) {
+	out := new(GetSegmentLoadingProgressResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/GetSegmentLoadingProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryNodeClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*internalpb.SearchResults, error) {
 	out := new(internalpb.SearchResults)
 	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/Search", in, out, opts...)
@@ -3975,8 +4762,15 @@ type QueryNodeServer interface {
 	ReleaseCollection(context.Context, *ReleaseCollectionRequest) (*commonpb.Status, error)
 	ReleasePartitions(context.Context, *ReleasePartitionsRequest) (*commonpb.Status, error)
 	ReleaseSegments(context.Context, *ReleaseSegmentsRequest) (*commonpb.Status, error)
+	// releases the dml channels of a collection and their delta channels, the sealed segments are kept
+	ReleaseChannels(context.Context, *ReleaseChannelsRequest) (*commonpb.Status, error)
 	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
 	SyncReplicaSegments(context.Context, *SyncReplicaSegmentsRequest) (*commonpb.Status, error)
+	// refreshes the schema and adds the new partitions of a loaded collection in place
+	RefreshCollection(context.Context, *LoadPartitionsRequest) (*commonpb.Status, error)
+	// starts draining the node for graceful scale-in, it's idempotent and returns the drain state
+	Drain(context.Context, *DrainRequest) (*DrainResponse, error)
+	GetSegmentLoadingProgress(context.Context, *GetLoadingProgressRequest) (*GetSegmentLoadingProgressResponse, error)
 	Search(context.Context, *SearchRequest) (*internalpb.SearchResults, error)
 	Query(context.Context, *QueryRequest) (*internalpb.RetrieveResults, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
@@ -4020,12 +4814,24 @@ func (*UnimplementedQueryNodeServer) ReleasePartitions(ctx context.Context, req
 func (*UnimplementedQueryNodeServer) ReleaseSegments(ctx context.Context, req *ReleaseSegmentsRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSegments not implemented")
 }
+func (*UnimplementedQueryNodeServer) ReleaseChannels(ctx context.Context, req *ReleaseChannelsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseChannels not implemented")
+}
 func (*UnimplementedQueryNodeServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
 }
 func (*UnimplementedQueryNodeServer) SyncReplicaSegments(ctx context.Context, req *SyncReplicaSegmentsRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncReplicaSegments not implemented")
 }
+func (*UnimplementedQueryNodeServer) RefreshCollection(ctx context.Context, req *LoadPartitionsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshCollection not implemented")
+}
+func (*UnimplementedQueryNodeServer) Drain(ctx context.Context, req *DrainRequest) (*DrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
+func (*UnimplementedQueryNodeServer) GetSegmentLoadingProgress(ctx context.Context, req *GetLoadingProgressRequest) (*GetSegmentLoadingProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentLoadingProgress not implemented")
+}
 func (*UnimplementedQueryNodeServer) Search(ctx context.Context, req *SearchRequest) (*internalpb.SearchResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
@@ -4238,6 +5044,24 @@ func _QueryNode_ReleaseSegments_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryNode_ReleaseChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).ReleaseChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/ReleaseChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).ReleaseChannels(ctx, req.(*ReleaseChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryNode_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSegmentInfoRequest)
 	if err := dec(in); err != nil {
@@ -4274,6 +5098,60 @@ func _QueryNode_SyncReplicaSegments_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryNode_RefreshCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).RefreshCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/RefreshCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).RefreshCollection(ctx, req.(*LoadPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/Drain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).Drain(ctx, req.(*DrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_GetSegmentLoadingProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoadingProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).GetSegmentLoadingProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/GetSegmentLoadingProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).GetSegmentLoadingProgress(ctx, req.(*GetLoadingProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryNode_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchRequest)
 	if err := dec(in); err != nil {
@@ -4376,6 +5254,10 @@ var _QueryNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReleaseSegments",
 			Handler:    _QueryNode_ReleaseSegments_Handler,
 		},
+		{
+			MethodName: "ReleaseChannels",
+			Handler:    _QueryNode_ReleaseChannels_Handler,
+		},
 		{
 			MethodName: "GetSegmentInfo",
 			Handler:    _QueryNode_GetSegmentInfo_Handler,
@@ -4384,6 +5266,18 @@ var _QueryNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SyncReplicaSegments",
 			Handler:    _QueryNode_SyncReplicaSegments_Handler,
 		},
+		{
+			MethodName: "RefreshCollection",
+			Handler:    _QueryNode_RefreshCollection_Handler,
+		},
+		{
+			MethodName: "Drain",
+			Handler:    _QueryNode_Drain_Handler,
+		},
+		{
+			MethodName: "GetSegmentLoadingProgress",
+			Handler:    _QueryNode_GetSegmentLoadingProgress_Handler,
+		},
 		{
 			MethodName: "Search",
 			Handler:    _QueryNode_Search_Handler,
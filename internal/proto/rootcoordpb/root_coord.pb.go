@@ -675,97 +675,110 @@ func init() {
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 1440 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xdd, 0x72, 0xd3, 0x46,
-	0x14, 0xc6, 0x36, 0xf9, 0xf1, 0xb1, 0x13, 0x87, 0x1d, 0x02, 0xae, 0x61, 0x5a, 0xe3, 0xb6, 0xe0,
-	0xf0, 0xe3, 0x30, 0x61, 0x86, 0x52, 0xee, 0x48, 0x4c, 0xc1, 0x53, 0x32, 0x03, 0x32, 0x74, 0xe8,
-	0x0f, 0xa3, 0xae, 0xad, 0x83, 0xa3, 0x89, 0xac, 0x35, 0xda, 0x35, 0x49, 0x2e, 0x3b, 0xd3, 0xfb,
-	0xbe, 0x49, 0x1f, 0xa2, 0x7d, 0x94, 0xbe, 0x48, 0x67, 0xb5, 0xd2, 0x5a, 0x92, 0x25, 0x47, 0x21,
-	0xdc, 0x69, 0x57, 0x9f, 0xbe, 0xef, 0xec, 0x77, 0x76, 0xcf, 0xee, 0x0a, 0x36, 0x3c, 0xc6, 0x84,
-	0x39, 0x64, 0xcc, 0xb3, 0x3a, 0x13, 0x8f, 0x09, 0x46, 0xae, 0x8c, 0x6d, 0xe7, 0xe3, 0x94, 0xab,
-	0x56, 0x47, 0xbe, 0xf6, 0xdf, 0x36, 0xaa, 0x43, 0x36, 0x1e, 0x33, 0x57, 0xf5, 0x37, 0xaa, 0x51,
-	0x54, 0x63, 0xdd, 0x76, 0x05, 0x7a, 0x2e, 0x75, 0x82, 0x76, 0x65, 0xe2, 0xb1, 0xe3, 0x93, 0xa0,
-	0xb1, 0x61, 0x51, 0x41, 0xa3, 0x12, 0x8d, 0x1a, 0x8a, 0xa1, 0x65, 0x8e, 0x51, 0x50, 0xd5, 0xd1,
-	0x32, 0x61, 0xf3, 0x89, 0xe3, 0xb0, 0xe1, 0x6b, 0x7b, 0x8c, 0x5c, 0xd0, 0xf1, 0xc4, 0xc0, 0x0f,
-	0x53, 0xe4, 0x82, 0xdc, 0x87, 0x8b, 0x03, 0xca, 0xb1, 0x5e, 0x68, 0x16, 0xda, 0x95, 0x9d, 0xeb,
-	0x9d, 0x58, 0x6c, 0x41, 0x40, 0xfb, 0x7c, 0xb4, 0x4b, 0x39, 0x1a, 0x3e, 0x92, 0x5c, 0x86, 0xa5,
-	0x21, 0x9b, 0xba, 0xa2, 0x5e, 0x6a, 0x16, 0xda, 0x6b, 0x86, 0x6a, 0xb4, 0xfe, 0x28, 0xc0, 0x95,
-	0xa4, 0x02, 0x9f, 0x30, 0x97, 0x23, 0x79, 0x00, 0xcb, 0x5c, 0x50, 0x31, 0xe5, 0x81, 0xc8, 0xb5,
-	0x54, 0x91, 0xbe, 0x0f, 0x31, 0x02, 0x28, 0xb9, 0x0e, 0x65, 0x11, 0x32, 0xd5, 0x8b, 0xcd, 0x42,
-	0xfb, 0xa2, 0x31, 0xeb, 0xc8, 0x88, 0xe1, 0x2d, 0xac, 0xfb, 0x21, 0xf4, 0xba, 0x9f, 0x61, 0x74,
-	0xc5, 0x28, 0xb3, 0x03, 0x35, 0xcd, 0x7c, 0x9e, 0x51, 0xad, 0x43, 0xb1, 0xd7, 0xf5, 0xa9, 0x4b,
-	0x46, 0xb1, 0xd7, 0xcd, 0x18, 0xc7, 0x3f, 0x45, 0xa8, 0xf6, 0xc6, 0x13, 0xe6, 0x09, 0x03, 0xf9,
-	0xd4, 0x11, 0x9f, 0xa6, 0x75, 0x15, 0x56, 0x04, 0xe5, 0x87, 0xa6, 0x6d, 0x05, 0x82, 0xcb, 0xb2,
-	0xd9, 0xb3, 0xc8, 0x57, 0x50, 0x91, 0x13, 0xc6, 0x65, 0x16, 0xca, 0x97, 0x25, 0xff, 0x25, 0x84,
-	0x5d, 0x3d, 0x8b, 0x3c, 0x84, 0x25, 0xc9, 0x81, 0xf5, 0x8b, 0xcd, 0x42, 0x7b, 0x7d, 0xa7, 0x99,
-	0xaa, 0xa6, 0x02, 0x94, 0x9a, 0x68, 0x28, 0x38, 0x69, 0xc0, 0x2a, 0xc7, 0xd1, 0x18, 0x5d, 0xc1,
-	0xeb, 0x4b, 0xcd, 0x52, 0xbb, 0x64, 0xe8, 0x36, 0xf9, 0x02, 0x56, 0xe9, 0x54, 0x30, 0xd3, 0xb6,
-	0x78, 0x7d, 0xd9, 0x7f, 0xb7, 0x22, 0xdb, 0x3d, 0x8b, 0x93, 0x6b, 0x50, 0xf6, 0xd8, 0x91, 0xa9,
-	0x8c, 0x58, 0xf1, 0xa3, 0x59, 0xf5, 0xd8, 0xd1, 0x9e, 0x6c, 0x93, 0xef, 0x60, 0xc9, 0x76, 0xdf,
-	0x33, 0x5e, 0x5f, 0x6d, 0x96, 0xda, 0x95, 0x9d, 0x1b, 0xa9, 0xb1, 0xfc, 0x88, 0x27, 0x3f, 0x51,
-	0x67, 0x8a, 0x2f, 0xa9, 0xed, 0x19, 0x0a, 0xdf, 0xfa, 0xab, 0x00, 0x57, 0xbb, 0xc8, 0x87, 0x9e,
-	0x3d, 0xc0, 0x7e, 0x10, 0xc5, 0xa7, 0x4f, 0x8b, 0x16, 0x54, 0x87, 0xcc, 0x71, 0x70, 0x28, 0x6c,
-	0xe6, 0xea, 0x14, 0xc6, 0xfa, 0xc8, 0x97, 0x00, 0xc1, 0x70, 0x7b, 0x5d, 0x5e, 0x2f, 0xf9, 0x83,
-	0x8c, 0xf4, 0xb4, 0xa6, 0x50, 0x0b, 0x02, 0x91, 0xc4, 0x3d, 0xf7, 0x3d, 0x9b, 0xa3, 0x2d, 0xa4,
-	0xd0, 0x36, 0xa1, 0x32, 0xa1, 0x9e, 0xb0, 0x63, 0xca, 0xd1, 0x2e, 0xb9, 0x56, 0xb4, 0x4c, 0x90,
-	0xce, 0x59, 0x47, 0xeb, 0xbf, 0x22, 0x54, 0x03, 0x5d, 0xa9, 0xc9, 0x49, 0x17, 0xca, 0x72, 0x4c,
-	0xa6, 0xf4, 0x29, 0xb0, 0xe0, 0x56, 0x27, 0xbd, 0x26, 0x75, 0x12, 0x01, 0x1b, 0xab, 0x83, 0x30,
-	0xf4, 0x2e, 0x54, 0x6c, 0xd7, 0xc2, 0x63, 0x53, 0xa5, 0xa7, 0xe8, 0xa7, 0xe7, 0xeb, 0x38, 0x8f,
-	0xac, 0x42, 0x1d, 0xad, 0x6d, 0xe1, 0xb1, 0xcf, 0x01, 0x76, 0xf8, 0xc8, 0x09, 0xc2, 0x25, 0x3c,
-	0x16, 0x1e, 0x35, 0xa3, 0x5c, 0x25, 0x9f, 0xeb, 0xfb, 0x53, 0x62, 0xf2, 0x09, 0x3a, 0x4f, 0xe5,
-	0xd7, 0x9a, 0x9b, 0x3f, 0x75, 0x85, 0x77, 0x62, 0xd4, 0x30, 0xde, 0xdb, 0xf8, 0x1d, 0x2e, 0xa7,
-	0x01, 0xc9, 0x06, 0x94, 0x0e, 0xf1, 0x24, 0xb0, 0x5d, 0x3e, 0x92, 0x1d, 0x58, 0xfa, 0x28, 0xa7,
-	0x92, 0xef, 0xf3, 0xdc, 0xdc, 0xf0, 0x07, 0x34, 0x1b, 0x89, 0x82, 0x3e, 0x2e, 0x3e, 0x2a, 0xb4,
-	0xfe, 0x2d, 0x42, 0x7d, 0x7e, 0xba, 0x9d, 0xa7, 0x56, 0xe4, 0x99, 0x72, 0x23, 0x58, 0x0b, 0x12,
-	0x1d, 0xb3, 0x6e, 0x37, 0xcb, 0xba, 0xac, 0x08, 0x63, 0x9e, 0x2a, 0x0f, 0xab, 0x3c, 0xd2, 0xd5,
-	0x40, 0xb8, 0x34, 0x07, 0x49, 0x71, 0xef, 0x71, 0xdc, 0xbd, 0x6f, 0xf2, 0xa4, 0x30, 0xea, 0xa2,
-	0x05, 0x97, 0x9f, 0xa1, 0xd8, 0xf3, 0xd0, 0x42, 0x57, 0xd8, 0xd4, 0xf9, 0xf4, 0x05, 0xdb, 0x80,
-	0xd5, 0x29, 0x97, 0x3b, 0xe6, 0x58, 0x05, 0x53, 0x36, 0x74, 0xbb, 0xf5, 0x67, 0x01, 0x36, 0x13,
-	0x32, 0xe7, 0x49, 0xd4, 0x02, 0x29, 0xf9, 0x6e, 0x42, 0x39, 0x3f, 0x62, 0x9e, 0x2a, 0xb4, 0x65,
-	0x43, 0xb7, 0x77, 0xfe, 0xbe, 0x0e, 0x65, 0x83, 0x31, 0xb1, 0x27, 0x2d, 0x21, 0x13, 0x20, 0x32,
-	0x26, 0x36, 0x9e, 0x30, 0x17, 0x5d, 0x55, 0x58, 0x39, 0xb9, 0x1f, 0x0f, 0x40, 0x9f, 0x02, 0xe6,
-	0xa1, 0x81, 0x55, 0x8d, 0x9b, 0x19, 0x5f, 0x24, 0xe0, 0xad, 0x0b, 0x64, 0xec, 0x2b, 0xca, 0xfd,
-	0xfa, 0xb5, 0x3d, 0x3c, 0xdc, 0x3b, 0xa0, 0xae, 0x8b, 0xce, 0x22, 0xc5, 0x04, 0x34, 0x54, 0x4c,
-	0x2c, 0xfa, 0xa0, 0xd1, 0x17, 0x9e, 0xed, 0x8e, 0x42, 0x67, 0x5b, 0x17, 0xc8, 0x07, 0x3f, 0xb7,
-	0x52, 0xdd, 0xe6, 0xc2, 0x1e, 0xf2, 0x50, 0x70, 0x27, 0x5b, 0x70, 0x0e, 0x7c, 0x46, 0x49, 0x13,
-	0x36, 0xf6, 0x3c, 0xa4, 0x02, 0xf7, 0xf4, 0xa2, 0x21, 0x77, 0x53, 0x3f, 0x4d, 0xc2, 0x42, 0xa1,
-	0x45, 0x13, 0xa0, 0x75, 0x81, 0xfc, 0x0a, 0xeb, 0x5d, 0x8f, 0x4d, 0x22, 0xf4, 0xb7, 0x53, 0xe9,
-	0xe3, 0xa0, 0x9c, 0xe4, 0x26, 0xac, 0x3d, 0xa7, 0x3c, 0xc2, 0xbd, 0x95, 0xca, 0x1d, 0xc3, 0x84,
-	0xd4, 0x37, 0x52, 0xa1, 0xbb, 0x8c, 0x39, 0x11, 0x7b, 0x8e, 0x80, 0x84, 0x05, 0x21, 0xa2, 0xd2,
-	0x49, 0x1f, 0xc1, 0x1c, 0x30, 0x94, 0xda, 0xce, 0x8d, 0xd7, 0xc2, 0x6f, 0xa0, 0xa2, 0x0c, 0x7f,
-	0xe2, 0xd8, 0x94, 0x93, 0x5b, 0x0b, 0x52, 0xe2, 0x23, 0x72, 0x1a, 0xf6, 0x0a, 0xca, 0xd2, 0x68,
-	0x45, 0xfa, 0x6d, 0x66, 0x22, 0xce, 0x42, 0xd9, 0x07, 0x78, 0xe2, 0x08, 0xf4, 0x14, 0xe7, 0xcd,
-	0x54, 0xce, 0x19, 0x20, 0x27, 0xa9, 0x0b, 0xb5, 0xfe, 0x81, 0x3c, 0xe0, 0x84, 0xd6, 0x70, 0x72,
-	0x27, 0x7d, 0x42, 0xc7, 0x51, 0x21, 0xfd, 0xdd, 0x7c, 0x60, 0x6d, 0xf7, 0x3b, 0xa8, 0x29, 0x33,
-	0x5f, 0x86, 0x87, 0x86, 0x0c, 0xbd, 0x04, 0x2a, 0xe7, 0x70, 0x7e, 0x86, 0x35, 0x69, 0xeb, 0x8c,
-	0x7c, 0x2b, 0xd3, 0xfa, 0xb3, 0x52, 0xbf, 0x83, 0xea, 0x73, 0xca, 0x67, 0xcc, 0xed, 0xac, 0x15,
-	0x30, 0x47, 0x9c, 0x6b, 0x01, 0x1c, 0xc2, 0xba, 0x74, 0x4d, 0x7f, 0xcc, 0x33, 0x96, 0x6f, 0x1c,
-	0x14, 0x4a, 0xdc, 0xc9, 0x85, 0xd5, 0x62, 0x2e, 0xd4, 0x12, 0xdb, 0x6f, 0x46, 0x16, 0x12, 0xa8,
-	0xc5, 0x59, 0x9f, 0x03, 0x6b, 0x3d, 0x84, 0xaa, 0x8c, 0x25, 0xdc, 0xea, 0x33, 0xbc, 0x8b, 0x42,
-	0x42, 0xa5, 0xad, 0x1c, 0xc8, 0x48, 0x11, 0xd9, 0x48, 0x9e, 0x2a, 0xc8, 0x76, 0xfe, 0xf3, 0x87,
-	0x52, 0xbc, 0x7f, 0xd6, 0x03, 0x4b, 0xb4, 0x88, 0xf8, 0xe7, 0xb1, 0x85, 0x45, 0xc4, 0x47, 0xe4,
-	0x9c, 0x72, 0x07, 0xb0, 0x16, 0x8a, 0x2a, 0xe2, 0xad, 0x85, 0xbe, 0xc7, 0xa8, 0x6f, 0xe7, 0x81,
-	0xea, 0x01, 0x04, 0xe5, 0x4a, 0xa9, 0x64, 0x97, 0xab, 0xb3, 0x04, 0xff, 0x21, 0xb8, 0x01, 0xeb,
-	0x4b, 0x38, 0xb9, 0x97, 0xe5, 0x6c, 0xea, 0xef, 0x80, 0x46, 0x27, 0x2f, 0x5c, 0x8f, 0xe2, 0x37,
-	0x58, 0x09, 0xae, 0xc6, 0xc9, 0xf2, 0x98, 0xf8, 0x58, 0xdf, 0xca, 0x1b, 0xb7, 0x4e, 0xc5, 0x69,
-	0x76, 0x0a, 0x9b, 0x6f, 0x26, 0x96, 0xdc, 0x9a, 0xd5, 0x01, 0x20, 0x3c, 0x82, 0x24, 0xb3, 0x32,
-	0x3b, 0xe6, 0xc4, 0x71, 0xfb, 0x7c, 0x74, 0x9a, 0x67, 0x0e, 0x5c, 0x35, 0xd0, 0x41, 0xca, 0xb1,
-	0xfb, 0xea, 0xc5, 0x3e, 0x72, 0x4e, 0x47, 0xd8, 0x17, 0x1e, 0xd2, 0x71, 0xf2, 0x68, 0xa2, 0xfe,
-	0xb9, 0x64, 0x80, 0x73, 0x66, 0x68, 0x08, 0x9b, 0xc1, 0x5c, 0xfe, 0xc1, 0x99, 0xf2, 0x03, 0x79,
-	0x2a, 0x73, 0x50, 0xa0, 0x95, 0xac, 0x05, 0xf2, 0x3a, 0xde, 0x49, 0x45, 0xe6, 0x18, 0x92, 0x09,
-	0xf0, 0x0c, 0xc5, 0x3e, 0x0a, 0xcf, 0x1e, 0x66, 0xed, 0x5a, 0x33, 0x40, 0x46, 0x5a, 0x52, 0x70,
-	0x3a, 0x2d, 0x7d, 0x58, 0x56, 0xf7, 0x7f, 0xd2, 0x4a, 0xfd, 0x28, 0xfc, 0x7b, 0xb1, 0xe8, 0xb4,
-	0xa6, 0xff, 0x70, 0x44, 0xaa, 0xf1, 0x33, 0x14, 0x91, 0xff, 0x0a, 0x19, 0xd5, 0x38, 0x0e, 0x5a,
-	0x5c, 0x8d, 0x93, 0xd8, 0x68, 0x35, 0x7e, 0x61, 0xf3, 0xe0, 0xe5, 0x6b, 0xca, 0x0f, 0xb3, 0xf6,
-	0xe0, 0x04, 0x6a, 0x71, 0x35, 0x9e, 0x03, 0x47, 0x1c, 0xab, 0x1a, 0x28, 0x5f, 0x04, 0xbe, 0x65,
-	0x5e, 0x8d, 0xa2, 0x3f, 0x7e, 0x4e, 0xcb, 0xf3, 0x5b, 0x7d, 0xbe, 0xd5, 0x57, 0x99, 0x64, 0x21,
-	0x99, 0x2d, 0x0c, 0x0d, 0x91, 0xb7, 0xae, 0x1c, 0xcc, 0xc1, 0xba, 0xfb, 0xdc, 0xcc, 0xa6, 0xdc,
-	0x2f, 0xe4, 0x44, 0x8e, 0x30, 0x67, 0x6d, 0x6d, 0x71, 0x58, 0xfe, 0x02, 0x2e, 0xd3, 0x20, 0xbf,
-	0x7b, 0xc3, 0xd1, 0xe3, 0x19, 0x05, 0x3c, 0x86, 0x59, 0x5c, 0xc0, 0x13, 0xd0, 0xc8, 0x1c, 0x5a,
-	0x8b, 0x5d, 0x23, 0x93, 0xe3, 0x98, 0x25, 0x35, 0xed, 0x52, 0xdb, 0xb8, 0x97, 0x13, 0x1d, 0xea,
-	0xed, 0x3e, 0xfa, 0xe5, 0xe1, 0xc8, 0x16, 0x07, 0xd3, 0x81, 0x1c, 0xf3, 0xb6, 0xfa, 0xf8, 0x9e,
-	0xcd, 0x82, 0xa7, 0xed, 0x30, 0x21, 0xdb, 0x3e, 0xdf, 0xb6, 0xe6, 0x9b, 0x0c, 0x06, 0xcb, 0x7e,
-	0xd7, 0x83, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xec, 0x82, 0x28, 0xae, 0x8d, 0x16, 0x00, 0x00,
+	// 1646 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5d, 0x73, 0xd3, 0x38,
+	0x17, 0x26, 0x09, 0x6d, 0x93, 0xd3, 0xf4, 0x03, 0x0d, 0x85, 0xbc, 0x81, 0x79, 0xdf, 0x90, 0x17,
+	0x68, 0x5a, 0x20, 0x65, 0xca, 0x0c, 0xcb, 0x72, 0xd7, 0x36, 0x7c, 0x64, 0xa0, 0x03, 0x38, 0xb0,
+	0xc0, 0x2e, 0x4c, 0x56, 0x8d, 0x45, 0xea, 0xa9, 0x63, 0x05, 0x4b, 0xe9, 0xc7, 0xe5, 0xce, 0xec,
+	0xfd, 0xfe, 0xa1, 0xbd, 0xda, 0xfd, 0x29, 0xfb, 0x47, 0x76, 0x64, 0xd9, 0x8a, 0xed, 0x58, 0x8e,
+	0x53, 0xb8, 0xb3, 0xa4, 0x47, 0xcf, 0x73, 0x74, 0x8e, 0x74, 0x74, 0x2c, 0x58, 0x75, 0x29, 0xe5,
+	0xdd, 0x1e, 0xa5, 0xae, 0xd9, 0x1c, 0xba, 0x94, 0x53, 0x74, 0x65, 0x60, 0xd9, 0xc7, 0x23, 0x26,
+	0x5b, 0x4d, 0x31, 0xec, 0x8d, 0x56, 0xcb, 0x3d, 0x3a, 0x18, 0x50, 0x47, 0xf6, 0x57, 0xcb, 0x61,
+	0x54, 0x75, 0xd9, 0x72, 0x38, 0x71, 0x1d, 0x6c, 0xfb, 0xed, 0xc5, 0xa1, 0x4b, 0x4f, 0xcf, 0xfc,
+	0xc6, 0xaa, 0x89, 0x39, 0x0e, 0x4b, 0x54, 0x57, 0x08, 0xef, 0x99, 0xdd, 0x01, 0xe1, 0x58, 0x76,
+	0xd4, 0xbb, 0xb0, 0xb6, 0x63, 0xdb, 0xb4, 0xf7, 0xd6, 0x1a, 0x10, 0xc6, 0xf1, 0x60, 0x68, 0x90,
+	0xaf, 0x23, 0xc2, 0x38, 0xba, 0x0f, 0x17, 0x0f, 0x30, 0x23, 0x95, 0x5c, 0x2d, 0xd7, 0x58, 0xdc,
+	0xbe, 0xde, 0x8c, 0xd8, 0xe6, 0x1b, 0xb4, 0xcf, 0xfa, 0xbb, 0x98, 0x11, 0xc3, 0x43, 0xa2, 0xcb,
+	0x30, 0xd7, 0xa3, 0x23, 0x87, 0x57, 0x0a, 0xb5, 0x5c, 0x63, 0xc9, 0x90, 0x8d, 0xfa, 0x6f, 0x39,
+	0xb8, 0x12, 0x57, 0x60, 0x43, 0xea, 0x30, 0x82, 0x1e, 0xc0, 0x3c, 0xe3, 0x98, 0x8f, 0x98, 0x2f,
+	0x72, 0x2d, 0x51, 0xa4, 0xe3, 0x41, 0x0c, 0x1f, 0x8a, 0xae, 0x43, 0x89, 0x07, 0x4c, 0x95, 0x7c,
+	0x2d, 0xd7, 0xb8, 0x68, 0x8c, 0x3b, 0x34, 0x36, 0x7c, 0x80, 0x65, 0xcf, 0x84, 0x76, 0xeb, 0x3b,
+	0xac, 0x2e, 0x1f, 0x66, 0xb6, 0x61, 0x45, 0x31, 0x7f, 0xcb, 0xaa, 0x96, 0x21, 0xdf, 0x6e, 0x79,
+	0xd4, 0x05, 0x23, 0xdf, 0x6e, 0x69, 0xd6, 0xf1, 0x57, 0x1e, 0xca, 0xed, 0xc1, 0x90, 0xba, 0xdc,
+	0x20, 0x6c, 0x64, 0xf3, 0xf3, 0x69, 0x5d, 0x85, 0x05, 0x8e, 0xd9, 0x51, 0xd7, 0x32, 0x7d, 0xc1,
+	0x79, 0xd1, 0x6c, 0x9b, 0xe8, 0x7f, 0xb0, 0x28, 0x36, 0x8c, 0x43, 0x4d, 0x22, 0x06, 0x0b, 0xde,
+	0x20, 0x04, 0x5d, 0x6d, 0x13, 0x3d, 0x84, 0x39, 0xc1, 0x41, 0x2a, 0x17, 0x6b, 0xb9, 0xc6, 0xf2,
+	0x76, 0x2d, 0x51, 0x4d, 0x1a, 0x28, 0x34, 0x89, 0x21, 0xe1, 0xa8, 0x0a, 0x45, 0x46, 0xfa, 0x03,
+	0xe2, 0x70, 0x56, 0x99, 0xab, 0x15, 0x1a, 0x05, 0x43, 0xb5, 0xd1, 0x7f, 0xa0, 0x88, 0x47, 0x9c,
+	0x76, 0x2d, 0x93, 0x55, 0xe6, 0xbd, 0xb1, 0x05, 0xd1, 0x6e, 0x9b, 0x0c, 0x5d, 0x83, 0x92, 0x4b,
+	0x4f, 0xba, 0xd2, 0x11, 0x0b, 0x9e, 0x35, 0x45, 0x97, 0x9e, 0xec, 0x89, 0x36, 0xfa, 0x01, 0xe6,
+	0x2c, 0xe7, 0x0b, 0x65, 0x95, 0x62, 0xad, 0xd0, 0x58, 0xdc, 0xbe, 0x91, 0x68, 0xcb, 0x0b, 0x72,
+	0xf6, 0x13, 0xb6, 0x47, 0xe4, 0x35, 0xb6, 0x5c, 0x43, 0xe2, 0xeb, 0x7f, 0xe4, 0xe0, 0x6a, 0x8b,
+	0xb0, 0x9e, 0x6b, 0x1d, 0x90, 0x8e, 0x6f, 0xc5, 0xf9, 0xb7, 0x45, 0x1d, 0xca, 0x3d, 0x6a, 0xdb,
+	0xa4, 0xc7, 0x2d, 0xea, 0xa8, 0x10, 0x46, 0xfa, 0xd0, 0x7f, 0x01, 0xfc, 0xe5, 0xb6, 0x5b, 0xac,
+	0x52, 0xf0, 0x16, 0x19, 0xea, 0xa9, 0x8f, 0x60, 0xc5, 0x37, 0x44, 0x10, 0xb7, 0x9d, 0x2f, 0x74,
+	0x82, 0x36, 0x97, 0x40, 0x5b, 0x83, 0xc5, 0x21, 0x76, 0xb9, 0x15, 0x51, 0x0e, 0x77, 0x89, 0xb3,
+	0xa2, 0x64, 0xfc, 0x70, 0x8e, 0x3b, 0xea, 0xff, 0xe4, 0xa1, 0xec, 0xeb, 0x0a, 0x4d, 0x86, 0x5a,
+	0x50, 0x12, 0x6b, 0xea, 0x0a, 0x3f, 0xf9, 0x2e, 0x58, 0x6f, 0x26, 0xe7, 0xa4, 0x66, 0xcc, 0x60,
+	0xa3, 0x78, 0x10, 0x98, 0xde, 0x82, 0x45, 0xcb, 0x31, 0xc9, 0x69, 0x57, 0x86, 0x27, 0xef, 0x85,
+	0xe7, 0xff, 0x51, 0x1e, 0x91, 0x85, 0x9a, 0x4a, 0xdb, 0x24, 0xa7, 0x1e, 0x07, 0x58, 0xc1, 0x27,
+	0x43, 0x04, 0x2e, 0x91, 0x53, 0xee, 0xe2, 0x6e, 0x98, 0xab, 0xe0, 0x71, 0xfd, 0x38, 0xc5, 0x26,
+	0x8f, 0xa0, 0xf9, 0x44, 0xcc, 0x56, 0xdc, 0xec, 0x89, 0xc3, 0xdd, 0x33, 0x63, 0x85, 0x44, 0x7b,
+	0xab, 0xbf, 0xc2, 0xe5, 0x24, 0x20, 0x5a, 0x85, 0xc2, 0x11, 0x39, 0xf3, 0xdd, 0x2e, 0x3e, 0xd1,
+	0x36, 0xcc, 0x1d, 0x8b, 0xad, 0x54, 0xc9, 0x27, 0xed, 0x0d, 0x6f, 0x41, 0xe3, 0x95, 0x48, 0xe8,
+	0xe3, 0xfc, 0xa3, 0x5c, 0xfd, 0xef, 0x3c, 0x54, 0x26, 0xb7, 0xdb, 0xb7, 0xe4, 0x8a, 0x2c, 0x5b,
+	0xae, 0x0f, 0x4b, 0x7e, 0xa0, 0x23, 0xae, 0xdb, 0xd5, 0xb9, 0x4e, 0x67, 0x61, 0xc4, 0xa7, 0xd2,
+	0x87, 0x65, 0x16, 0xea, 0xaa, 0x12, 0xb8, 0x34, 0x01, 0x49, 0xf0, 0xde, 0xe3, 0xa8, 0xf7, 0x6e,
+	0x66, 0x09, 0x61, 0xd8, 0x8b, 0x26, 0x5c, 0x7e, 0x46, 0xf8, 0x9e, 0x4b, 0x4c, 0xe2, 0x70, 0x0b,
+	0xdb, 0xe7, 0x3f, 0xb0, 0x55, 0x28, 0x8e, 0x98, 0xb8, 0x31, 0x07, 0xd2, 0x98, 0x92, 0xa1, 0xda,
+	0xf5, 0xdf, 0x73, 0xb0, 0x16, 0x93, 0xf9, 0x96, 0x40, 0xa5, 0x48, 0x89, 0xb1, 0x21, 0x66, 0xec,
+	0x84, 0xba, 0x32, 0xd1, 0x96, 0x0c, 0xd5, 0xde, 0xfe, 0x73, 0x1d, 0x4a, 0x06, 0xa5, 0x7c, 0x4f,
+	0xb8, 0x04, 0x0d, 0x01, 0x09, 0x9b, 0xe8, 0x60, 0x48, 0x1d, 0xe2, 0xc8, 0xc4, 0xca, 0xd0, 0xfd,
+	0xa8, 0x01, 0xaa, 0x0a, 0x98, 0x84, 0xfa, 0xae, 0xaa, 0xde, 0xd6, 0xcc, 0x88, 0xc1, 0xeb, 0x17,
+	0xd0, 0xc0, 0x53, 0x14, 0xf7, 0xf5, 0x5b, 0xab, 0x77, 0xb4, 0x77, 0x88, 0x1d, 0x87, 0xd8, 0x69,
+	0x8a, 0x31, 0x68, 0xa0, 0x18, 0x3b, 0xf4, 0x7e, 0xa3, 0xc3, 0x5d, 0xcb, 0xe9, 0x07, 0x9e, 0xad,
+	0x5f, 0x40, 0x5f, 0xbd, 0xd8, 0x0a, 0x75, 0x8b, 0x71, 0xab, 0xc7, 0x02, 0xc1, 0x6d, 0xbd, 0xe0,
+	0x04, 0x78, 0x46, 0xc9, 0x2e, 0xac, 0xee, 0xb9, 0x04, 0x73, 0xb2, 0xa7, 0x0e, 0x0d, 0xba, 0x9b,
+	0x38, 0x35, 0x0e, 0x0b, 0x84, 0xd2, 0x36, 0x40, 0xfd, 0x02, 0xfa, 0x05, 0x96, 0x5b, 0x2e, 0x1d,
+	0x86, 0xe8, 0x37, 0x13, 0xe9, 0xa3, 0xa0, 0x8c, 0xe4, 0x5d, 0x58, 0x7a, 0x8e, 0x59, 0x88, 0x7b,
+	0x23, 0x91, 0x3b, 0x82, 0x09, 0xa8, 0x6f, 0x24, 0x42, 0x77, 0x29, 0xb5, 0x43, 0xee, 0x39, 0x01,
+	0x14, 0x24, 0x84, 0x90, 0x4a, 0x33, 0x79, 0x05, 0x13, 0xc0, 0x40, 0x6a, 0x2b, 0x33, 0x5e, 0x09,
+	0xbf, 0x83, 0x45, 0xe9, 0xf0, 0x1d, 0xdb, 0xc2, 0x0c, 0xad, 0xa7, 0x84, 0xc4, 0x43, 0x64, 0x74,
+	0xd8, 0x1b, 0x28, 0x09, 0x47, 0x4b, 0xd2, 0x5b, 0xda, 0x40, 0xcc, 0x42, 0xd9, 0x01, 0xd8, 0xb1,
+	0x39, 0x71, 0x25, 0xe7, 0xed, 0x44, 0xce, 0x31, 0x20, 0x73, 0x60, 0x57, 0x0d, 0x22, 0xd2, 0xc3,
+	0xd4, 0x6d, 0x19, 0x87, 0x65, 0x14, 0xe8, 0x01, 0xda, 0x31, 0xcd, 0xf1, 0xb4, 0xa7, 0x16, 0xb1,
+	0x4d, 0x4d, 0x60, 0x27, 0x81, 0x19, 0x45, 0x3e, 0x8b, 0x9a, 0x98, 0x13, 0x37, 0xb4, 0x88, 0x3b,
+	0x7a, 0xff, 0x9c, 0xe7, 0x68, 0xc9, 0x1d, 0xd0, 0xc2, 0x1c, 0x7b, 0x29, 0x7d, 0x33, 0x65, 0x9b,
+	0x04, 0xa0, 0x8c, 0xe4, 0xef, 0xa1, 0x2c, 0x76, 0x82, 0xa2, 0x6e, 0x68, 0x37, 0xcb, 0x8c, 0xc4,
+	0x1f, 0x61, 0xc9, 0x5b, 0xae, 0x62, 0xde, 0xd0, 0xbb, 0x64, 0x46, 0xea, 0x43, 0x58, 0x7a, 0x69,
+	0x31, 0x1e, 0xcc, 0x62, 0x1a, 0xea, 0x08, 0x26, 0xa0, 0xde, 0xcc, 0x02, 0x55, 0xc7, 0xd3, 0x81,
+	0x95, 0xce, 0x21, 0x3d, 0x19, 0x87, 0x8c, 0x69, 0x22, 0x1b, 0x43, 0x05, 0x6a, 0x77, 0xb3, 0x81,
+	0x95, 0xde, 0x67, 0x58, 0x91, 0x51, 0x7c, 0x1d, 0x14, 0xb5, 0x1a, 0xbd, 0x18, 0x2a, 0x7b, 0x4c,
+	0x44, 0x24, 0xc7, 0xe4, 0x1b, 0xda, 0x68, 0xcf, 0x4a, 0xfd, 0x19, 0xca, 0xcf, 0x31, 0x1b, 0x33,
+	0x37, 0x74, 0x19, 0x7a, 0x82, 0x38, 0x53, 0x82, 0x3e, 0x82, 0x65, 0xe1, 0x35, 0x35, 0x99, 0x69,
+	0xce, 0x40, 0x14, 0x14, 0x48, 0xdc, 0xc9, 0x84, 0x0d, 0x47, 0x3d, 0x56, 0x1e, 0x6a, 0xa2, 0x10,
+	0x43, 0xa5, 0x47, 0x7d, 0x02, 0xac, 0xf4, 0x08, 0x94, 0x85, 0x2d, 0x9d, 0xe0, 0x0f, 0xb1, 0xa1,
+	0x35, 0x37, 0xf6, 0xfb, 0x56, 0xdd, 0xc8, 0x80, 0x0c, 0x5d, 0x72, 0xab, 0x31, 0x1b, 0x18, 0xda,
+	0xca, 0x5e, 0x1f, 0x4b, 0xc5, 0xfb, 0xb3, 0x16, 0xd4, 0xe1, 0x4b, 0xce, 0xfb, 0x5f, 0x48, 0xbd,
+	0xe4, 0x3c, 0x44, 0xf6, 0x34, 0x10, 0x88, 0x4a, 0xe2, 0x8d, 0x54, 0xbf, 0x47, 0xa8, 0x37, 0xb3,
+	0x40, 0xd5, 0x02, 0xfc, 0xeb, 0x54, 0xaa, 0xe8, 0xaf, 0xd3, 0x59, 0x8c, 0xff, 0xea, 0xbf, 0xd0,
+	0xa8, 0x47, 0x22, 0x74, 0x4f, 0xe7, 0xd9, 0xc4, 0xe7, 0xaa, 0x6a, 0x33, 0x2b, 0x5c, 0xad, 0xe2,
+	0x13, 0x2c, 0xf8, 0x4f, 0x37, 0xe8, 0x76, 0xea, 0x64, 0xf5, 0x6a, 0x54, 0x5d, 0x9f, 0x8a, 0x53,
+	0xec, 0x18, 0xd6, 0xde, 0x0d, 0x4d, 0x51, 0x3a, 0xca, 0x02, 0x35, 0x28, 0x91, 0xd1, 0x86, 0xa6,
+	0xaa, 0x8d, 0xe1, 0xf6, 0x59, 0x7f, 0x9a, 0xcf, 0x6c, 0xb8, 0x6a, 0x10, 0x9b, 0x60, 0x46, 0x5a,
+	0x6f, 0x5e, 0xee, 0x13, 0xc6, 0x70, 0x9f, 0x74, 0xb8, 0x4b, 0xf0, 0x20, 0x5e, 0x3a, 0xcb, 0x37,
+	0x41, 0x0d, 0x38, 0x73, 0xe9, 0xb0, 0xe6, 0xef, 0xe5, 0xa7, 0xf6, 0x88, 0x1d, 0x8a, 0xbf, 0x06,
+	0x9b, 0x70, 0x62, 0xc6, 0x73, 0x81, 0x78, 0x2e, 0x6a, 0x26, 0x22, 0x33, 0x2c, 0xa9, 0x0b, 0xf0,
+	0x8c, 0xf0, 0x7d, 0xc2, 0x5d, 0xab, 0xa7, 0xab, 0xaa, 0xc6, 0x00, 0x4d, 0x58, 0x12, 0x70, 0x2a,
+	0x2c, 0x1d, 0x98, 0x97, 0xef, 0x53, 0xa8, 0x9e, 0x38, 0x29, 0x78, 0x5d, 0x4b, 0xfb, 0x9b, 0x08,
+	0x30, 0xe1, 0x6c, 0xfc, 0x8c, 0xf0, 0xd0, 0xbb, 0x97, 0x26, 0x1b, 0x47, 0x41, 0xe9, 0xd9, 0x38,
+	0x8e, 0x0d, 0x67, 0x63, 0x71, 0x3d, 0xcb, 0xc1, 0xb7, 0x98, 0x1d, 0xe9, 0xee, 0xe0, 0x18, 0x2a,
+	0x3d, 0x1b, 0x4f, 0x80, 0x95, 0x5e, 0x1f, 0xca, 0x7b, 0xd8, 0xe9, 0x11, 0xdb, 0xf7, 0x5b, 0x72,
+	0x36, 0x0e, 0x43, 0xce, 0xb9, 0xb0, 0x0e, 0x94, 0x0d, 0x22, 0x06, 0x7c, 0x21, 0xed, 0x1b, 0x41,
+	0xf8, 0x05, 0x74, 0xda, 0x86, 0xfa, 0xa0, 0x7e, 0xf4, 0xd4, 0x3f, 0x3d, 0xba, 0xa5, 0x3b, 0x81,
+	0x0a, 0x22, 0x9e, 0x1f, 0x32, 0x30, 0xfb, 0x07, 0xfc, 0x7b, 0x33, 0x77, 0xc5, 0xc5, 0x24, 0x4e,
+	0x4c, 0x88, 0x59, 0x77, 0x87, 0x46, 0x61, 0xb3, 0x15, 0x8c, 0x62, 0xde, 0x3b, 0x46, 0xdc, 0xb4,
+	0x82, 0x51, 0x61, 0xa6, 0x17, 0x8c, 0x21, 0xa8, 0x8a, 0xe9, 0x2b, 0x28, 0x76, 0xf0, 0x31, 0x31,
+	0xa8, 0x4d, 0xd0, 0xcd, 0xc4, 0x99, 0xc1, 0x70, 0x46, 0xd3, 0x5f, 0x41, 0x51, 0x5c, 0x2d, 0x29,
+	0x84, 0xc1, 0x70, 0x46, 0xc2, 0x4f, 0x50, 0x12, 0xc6, 0x8b, 0x19, 0xba, 0x5f, 0x43, 0x35, 0xae,
+	0x79, 0x49, 0x99, 0x84, 0xa9, 0xf5, 0x7f, 0x84, 0x25, 0xb1, 0x40, 0xe1, 0x16, 0xa9, 0xb0, 0xa1,
+	0x75, 0x82, 0xc2, 0xcc, 0x16, 0xc4, 0x69, 0xd4, 0x11, 0xcc, 0xf4, 0x20, 0x86, 0xa0, 0xe1, 0x7a,
+	0xcc, 0xff, 0xe5, 0x7e, 0xdd, 0x7e, 0x41, 0xce, 0x74, 0x19, 0x20, 0x04, 0x49, 0xaf, 0xc7, 0xa2,
+	0x48, 0x25, 0xf3, 0x5e, 0x9c, 0xff, 0x63, 0x7a, 0x94, 0x2e, 0x13, 0x86, 0x64, 0xf4, 0x94, 0x03,
+	0x4b, 0x91, 0x47, 0x3d, 0x74, 0x57, 0x97, 0x59, 0x92, 0x9e, 0x18, 0xab, 0xf7, 0x32, 0xa2, 0x83,
+	0x85, 0xec, 0x3e, 0xfa, 0xf9, 0x61, 0xdf, 0xe2, 0x87, 0xa3, 0x03, 0x61, 0xc9, 0x96, 0x9c, 0x7c,
+	0xcf, 0xa2, 0xfe, 0xd7, 0x56, 0x90, 0x15, 0xb6, 0x3c, 0xbe, 0x2d, 0xc5, 0x37, 0x3c, 0x38, 0x98,
+	0xf7, 0xba, 0x1e, 0xfc, 0x3b, 0x00, 0x58, 0x83, 0xa4, 0xf8, 0x1b, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -814,6 +827,13 @@ type RootCoordClient interface {
 	CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AddCollectionField(ctx context.Context, in *milvuspb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AlterDatabase(ctx context.Context, in *milvuspb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error)
 	//*
 	// @brief This method is used to list all collections.
 	//
@@ -858,12 +878,20 @@ type RootCoordClient interface {
 	Import(ctx context.Context, in *milvuspb.ImportRequest, opts ...grpc.CallOption) (*milvuspb.ImportResponse, error)
 	GetImportState(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error)
 	ListImportTasks(ctx context.Context, in *milvuspb.ListImportTasksRequest, opts ...grpc.CallOption) (*milvuspb.ListImportTasksResponse, error)
+	CancelImport(ctx context.Context, in *milvuspb.CancelImportRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error)
 	ReportImport(ctx context.Context, in *ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+27+--+Support+Basic+Authentication
 	CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error)
 	UpdateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error)
+	SaveRole(ctx context.Context, in *milvuspb.SaveRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListRoles(ctx context.Context, in *milvuspb.ListRolesRequest, opts ...grpc.CallOption) (*milvuspb.ListRolesResponse, error)
+	SaveUserRoles(ctx context.Context, in *milvuspb.SaveUserRolesRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListUserRoles(ctx context.Context, in *milvuspb.ListUserRolesRequest, opts ...grpc.CallOption) (*milvuspb.ListUserRolesResponse, error)
+	CreateAPIKey(ctx context.Context, in *milvuspb.CreateAPIKeyRequest, opts ...grpc.CallOption) (*milvuspb.CreateAPIKeyResponse, error)
+	RevokeAPIKey(ctx context.Context, in *milvuspb.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// userd by proxy, not exposed to sdk
 	GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error)
 }
@@ -966,6 +994,69 @@ func (c *rootCoordClient) AlterAlias(ctx context.Context, in *milvuspb.AlterAlia
 	return out, nil
 }
 
+func (c *rootCoordClient) RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RenameCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) AddCollectionField(ctx context.Context, in *milvuspb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AddCollectionField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AlterCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) AlterDatabase(ctx context.Context, in *milvuspb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AlterDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error) {
+	out := new(milvuspb.ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error) {
 	out := new(milvuspb.ShowCollectionsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ShowCollections", in, out, opts...)
@@ -1146,6 +1237,15 @@ func (c *rootCoordClient) ListImportTasks(ctx context.Context, in *milvuspb.List
 	return out, nil
 }
 
+func (c *rootCoordClient) CancelImport(ctx context.Context, in *milvuspb.CancelImportRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error) {
+	out := new(milvuspb.GetImportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CancelImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) ReportImport(ctx context.Context, in *ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ReportImport", in, out, opts...)
@@ -1191,6 +1291,69 @@ func (c *rootCoordClient) ListCredUsers(ctx context.Context, in *milvuspb.ListCr
 	return out, nil
 }
 
+func (c *rootCoordClient) SaveRole(ctx context.Context, in *milvuspb.SaveRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/SaveRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListRoles(ctx context.Context, in *milvuspb.ListRolesRequest, opts ...grpc.CallOption) (*milvuspb.ListRolesResponse, error) {
+	out := new(milvuspb.ListRolesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) SaveUserRoles(ctx context.Context, in *milvuspb.SaveUserRolesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/SaveUserRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListUserRoles(ctx context.Context, in *milvuspb.ListUserRolesRequest, opts ...grpc.CallOption) (*milvuspb.ListUserRolesResponse, error) {
+	out := new(milvuspb.ListUserRolesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListUserRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateAPIKey(ctx context.Context, in *milvuspb.CreateAPIKeyRequest, opts ...grpc.CallOption) (*milvuspb.CreateAPIKeyResponse, error) {
+	out := new(milvuspb.CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) RevokeAPIKey(ctx context.Context, in *milvuspb.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RevokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error) {
 	out := new(GetCredentialResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetCredential", in, out, opts...)
@@ -1236,6 +1399,13 @@ type RootCoordServer interface {
 	CreateAlias(context.Context, *milvuspb.CreateAliasRequest) (*commonpb.Status, error)
 	DropAlias(context.Context, *milvuspb.DropAliasRequest) (*commonpb.Status, error)
 	AlterAlias(context.Context, *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
+	RenameCollection(context.Context, *milvuspb.RenameCollectionRequest) (*commonpb.Status, error)
+	AddCollectionField(context.Context, *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error)
+	AlterCollection(context.Context, *milvuspb.AlterCollectionRequest) (*commonpb.Status, error)
+	CreateDatabase(context.Context, *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+	DropDatabase(context.Context, *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+	AlterDatabase(context.Context, *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error)
+	ListDatabases(context.Context, *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
 	//*
 	// @brief This method is used to list all collections.
 	//
@@ -1280,12 +1450,20 @@ type RootCoordServer interface {
 	Import(context.Context, *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error)
 	GetImportState(context.Context, *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error)
 	ListImportTasks(context.Context, *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error)
+	CancelImport(context.Context, *milvuspb.CancelImportRequest) (*milvuspb.GetImportStateResponse, error)
 	ReportImport(context.Context, *ImportResult) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+27+--+Support+Basic+Authentication
 	CreateCredential(context.Context, *internalpb.CredentialInfo) (*commonpb.Status, error)
 	UpdateCredential(context.Context, *internalpb.CredentialInfo) (*commonpb.Status, error)
 	DeleteCredential(context.Context, *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error)
 	ListCredUsers(context.Context, *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error)
+	SaveRole(context.Context, *milvuspb.SaveRoleRequest) (*commonpb.Status, error)
+	DropRole(context.Context, *milvuspb.DropRoleRequest) (*commonpb.Status, error)
+	ListRoles(context.Context, *milvuspb.ListRolesRequest) (*milvuspb.ListRolesResponse, error)
+	SaveUserRoles(context.Context, *milvuspb.SaveUserRolesRequest) (*commonpb.Status, error)
+	ListUserRoles(context.Context, *milvuspb.ListUserRolesRequest) (*milvuspb.ListUserRolesResponse, error)
+	CreateAPIKey(context.Context, *milvuspb.CreateAPIKeyRequest) (*milvuspb.CreateAPIKeyResponse, error)
+	RevokeAPIKey(context.Context, *milvuspb.RevokeAPIKeyRequest) (*commonpb.Status, error)
 	// userd by proxy, not exposed to sdk
 	GetCredential(context.Context, *GetCredentialRequest) (*GetCredentialResponse, error)
 }
@@ -1324,6 +1502,27 @@ func (*UnimplementedRootCoordServer) DropAlias(ctx context.Context, req *milvusp
 func (*UnimplementedRootCoordServer) AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AlterAlias not implemented")
 }
+func (*UnimplementedRootCoordServer) RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
+}
+func (*UnimplementedRootCoordServer) AddCollectionField(ctx context.Context, req *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollectionField not implemented")
+}
+func (*UnimplementedRootCoordServer) AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterCollection not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) AlterDatabase(ctx context.Context, req *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
 func (*UnimplementedRootCoordServer) ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowCollections not implemented")
 }
@@ -1384,6 +1583,9 @@ func (*UnimplementedRootCoordServer) GetImportState(ctx context.Context, req *mi
 func (*UnimplementedRootCoordServer) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListImportTasks not implemented")
 }
+func (*UnimplementedRootCoordServer) CancelImport(ctx context.Context, req *milvuspb.CancelImportRequest) (*milvuspb.GetImportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelImport not implemented")
+}
 func (*UnimplementedRootCoordServer) ReportImport(ctx context.Context, req *ImportResult) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportImport not implemented")
 }
@@ -1399,6 +1601,27 @@ func (*UnimplementedRootCoordServer) DeleteCredential(ctx context.Context, req *
 func (*UnimplementedRootCoordServer) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCredUsers not implemented")
 }
+func (*UnimplementedRootCoordServer) SaveRole(ctx context.Context, req *milvuspb.SaveRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveRole not implemented")
+}
+func (*UnimplementedRootCoordServer) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRole not implemented")
+}
+func (*UnimplementedRootCoordServer) ListRoles(ctx context.Context, req *milvuspb.ListRolesRequest) (*milvuspb.ListRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (*UnimplementedRootCoordServer) SaveUserRoles(ctx context.Context, req *milvuspb.SaveUserRolesRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveUserRoles not implemented")
+}
+func (*UnimplementedRootCoordServer) ListUserRoles(ctx context.Context, req *milvuspb.ListUserRolesRequest) (*milvuspb.ListUserRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserRoles not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateAPIKey(ctx context.Context, req *milvuspb.CreateAPIKeyRequest) (*milvuspb.CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (*UnimplementedRootCoordServer) RevokeAPIKey(ctx context.Context, req *milvuspb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
 func (*UnimplementedRootCoordServer) GetCredential(ctx context.Context, req *GetCredentialRequest) (*GetCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCredential not implemented")
 }
@@ -1587,6 +1810,132 @@ func _RootCoord_AlterAlias_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.RenameCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RenameCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RenameCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RenameCollection(ctx, req.(*milvuspb.RenameCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_AddCollectionField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AddCollectionFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AddCollectionField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AddCollectionField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AddCollectionField(ctx, req.(*milvuspb.AddCollectionFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_AlterCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AlterCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AlterCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AlterCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AlterCollection(ctx, req.(*milvuspb.AlterCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateDatabase(ctx, req.(*milvuspb.CreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropDatabase(ctx, req.(*milvuspb.DropDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_AlterDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AlterDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AlterDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AlterDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AlterDatabase(ctx, req.(*milvuspb.AlterDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListDatabases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListDatabases(ctx, req.(*milvuspb.ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_ShowCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.ShowCollectionsRequest)
 	if err := dec(in); err != nil {
@@ -1947,6 +2296,24 @@ func _RootCoord_ListImportTasks_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_CancelImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CancelImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CancelImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CancelImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CancelImport(ctx, req.(*milvuspb.CancelImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_ReportImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ImportResult)
 	if err := dec(in); err != nil {
@@ -2037,6 +2404,132 @@ func _RootCoord_ListCredUsers_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_SaveRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.SaveRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).SaveRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/SaveRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).SaveRole(ctx, req.(*milvuspb.SaveRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropRole(ctx, req.(*milvuspb.DropRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListRoles(ctx, req.(*milvuspb.ListRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_SaveUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.SaveUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).SaveUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/SaveUserRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).SaveUserRoles(ctx, req.(*milvuspb.SaveUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListUserRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListUserRoles(ctx, req.(*milvuspb.ListUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateAPIKey(ctx, req.(*milvuspb.CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RevokeAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RevokeAPIKey(ctx, req.(*milvuspb.RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_GetCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCredentialRequest)
 	if err := dec(in); err != nil {
@@ -2099,6 +2592,34 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AlterAlias",
 			Handler:    _RootCoord_AlterAlias_Handler,
 		},
+		{
+			MethodName: "RenameCollection",
+			Handler:    _RootCoord_RenameCollection_Handler,
+		},
+		{
+			MethodName: "AddCollectionField",
+			Handler:    _RootCoord_AddCollectionField_Handler,
+		},
+		{
+			MethodName: "AlterCollection",
+			Handler:    _RootCoord_AlterCollection_Handler,
+		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _RootCoord_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _RootCoord_DropDatabase_Handler,
+		},
+		{
+			MethodName: "AlterDatabase",
+			Handler:    _RootCoord_AlterDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _RootCoord_ListDatabases_Handler,
+		},
 		{
 			MethodName: "ShowCollections",
 			Handler:    _RootCoord_ShowCollections_Handler,
@@ -2179,6 +2700,10 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListImportTasks",
 			Handler:    _RootCoord_ListImportTasks_Handler,
 		},
+		{
+			MethodName: "CancelImport",
+			Handler:    _RootCoord_CancelImport_Handler,
+		},
 		{
 			MethodName: "ReportImport",
 			Handler:    _RootCoord_ReportImport_Handler,
@@ -2199,6 +2724,34 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListCredUsers",
 			Handler:    _RootCoord_ListCredUsers_Handler,
 		},
+		{
+			MethodName: "SaveRole",
+			Handler:    _RootCoord_SaveRole_Handler,
+		},
+		{
+			MethodName: "DropRole",
+			Handler:    _RootCoord_DropRole_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _RootCoord_ListRoles_Handler,
+		},
+		{
+			MethodName: "SaveUserRoles",
+			Handler:    _RootCoord_SaveUserRoles_Handler,
+		},
+		{
+			MethodName: "ListUserRoles",
+			Handler:    _RootCoord_ListUserRoles_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _RootCoord_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _RootCoord_RevokeAPIKey_Handler,
+		},
 		{
 			MethodName: "GetCredential",
 			Handler:    _RootCoord_GetCredential_Handler,
@@ -178,13 +178,14 @@ func (m *FieldSchema) GetAutoID() bool {
 //*
 // @brief Collection schema
 type CollectionSchema struct {
-	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description          string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	AutoID               bool           `protobuf:"varint,3,opt,name=autoID,proto3" json:"autoID,omitempty"`
-	Fields               []*FieldSchema `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Name                 string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AutoID               bool                     `protobuf:"varint,3,opt,name=autoID,proto3" json:"autoID,omitempty"`
+	Fields               []*FieldSchema           `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	Properties           []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *CollectionSchema) Reset()         { *m = CollectionSchema{} }
@@ -240,6 +241,13 @@ func (m *CollectionSchema) GetFields() []*FieldSchema {
 	return nil
 }
 
+func (m *CollectionSchema) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
 type BoolArray struct {
 	Data                 []bool   `protobuf:"varint,1,rep,packed,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1041,68 +1049,68 @@ func init() {
 func init() { proto.RegisterFile("schema.proto", fileDescriptor_1c5fb4d8cc22d66a) }
 
 var fileDescriptor_1c5fb4d8cc22d66a = []byte{
-	// 995 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x5d, 0x6f, 0xe3, 0x44,
-	0x17, 0xce, 0xc4, 0xf9, 0xb0, 0x8f, 0xf3, 0xf6, 0xb5, 0x66, 0x17, 0x64, 0x90, 0x76, 0x9b, 0x8d,
-	0x40, 0x8a, 0x56, 0xa2, 0xd5, 0xb6, 0x68, 0x59, 0x56, 0xac, 0x80, 0x34, 0xaa, 0x12, 0x15, 0xad,
-	0x82, 0x8b, 0x8a, 0xc4, 0x4d, 0x34, 0x89, 0x67, 0xdb, 0x51, 0x6d, 0x8f, 0x99, 0x99, 0xac, 0xc8,
-	0x0f, 0xe0, 0x1f, 0x70, 0x85, 0xb8, 0xe0, 0x8f, 0x71, 0x81, 0xf8, 0x1d, 0x48, 0x68, 0x3e, 0x92,
-	0x18, 0xe2, 0xad, 0x7a, 0x77, 0x66, 0x7c, 0x9e, 0x67, 0xce, 0x79, 0xce, 0x87, 0xa1, 0x27, 0x97,
-	0x37, 0x34, 0x27, 0x47, 0xa5, 0xe0, 0x8a, 0xe3, 0x07, 0x39, 0xcb, 0xde, 0xae, 0xa4, 0x3d, 0x1d,
-	0xd9, 0x4f, 0x1f, 0xf6, 0x96, 0x3c, 0xcf, 0x79, 0x61, 0x2f, 0x07, 0x7f, 0x36, 0x21, 0x3c, 0x67,
-	0x34, 0x4b, 0x2f, 0xcd, 0x57, 0x1c, 0x43, 0xf7, 0x8d, 0x3e, 0x4e, 0xc7, 0x31, 0xea, 0xa3, 0xa1,
-	0x97, 0x6c, 0x8e, 0x18, 0x43, 0xab, 0x20, 0x39, 0x8d, 0x9b, 0x7d, 0x34, 0x0c, 0x12, 0x63, 0xe3,
-	0x8f, 0xe0, 0x80, 0xc9, 0x79, 0x29, 0x58, 0x4e, 0xc4, 0x7a, 0x7e, 0x4b, 0xd7, 0xb1, 0xd7, 0x47,
-	0x43, 0x3f, 0xe9, 0x31, 0x39, 0xb3, 0x97, 0x17, 0x74, 0x8d, 0xfb, 0x10, 0xa6, 0x54, 0x2e, 0x05,
-	0x2b, 0x15, 0xe3, 0x45, 0xdc, 0x32, 0x04, 0xd5, 0x2b, 0xfc, 0x12, 0x82, 0x94, 0x28, 0x32, 0x57,
-	0xeb, 0x92, 0xc6, 0xed, 0x3e, 0x1a, 0x1e, 0x9c, 0x3c, 0x3a, 0xaa, 0x09, 0xfe, 0x68, 0x4c, 0x14,
-	0xf9, 0x6e, 0x5d, 0xd2, 0xc4, 0x4f, 0x9d, 0x85, 0x47, 0x10, 0x6a, 0xd8, 0xbc, 0x24, 0x82, 0xe4,
-	0x32, 0xee, 0xf4, 0xbd, 0x61, 0x78, 0xf2, 0xe4, 0xdf, 0x68, 0x97, 0xf2, 0x05, 0x5d, 0x5f, 0x91,
-	0x6c, 0x45, 0x67, 0x84, 0x89, 0x04, 0x34, 0x6a, 0x66, 0x40, 0x78, 0x0c, 0x3d, 0x56, 0xa4, 0xf4,
-	0xa7, 0x0d, 0x49, 0xf7, 0xbe, 0x24, 0xa1, 0x81, 0x39, 0x96, 0xf7, 0xa1, 0x43, 0x56, 0x8a, 0x4f,
-	0xc7, 0xb1, 0x6f, 0x54, 0x70, 0xa7, 0xc1, 0xaf, 0x08, 0xa2, 0x33, 0x9e, 0x65, 0x74, 0xa9, 0x93,
-	0x75, 0x42, 0x6f, 0xe4, 0x44, 0x15, 0x39, 0xff, 0x23, 0x54, 0x73, 0x5f, 0xa8, 0xdd, 0x13, 0x5e,
-	0xf5, 0x09, 0xfc, 0x02, 0x3a, 0xa6, 0x4e, 0x32, 0x6e, 0x99, 0xd0, 0xfb, 0xb5, 0xea, 0x55, 0x0a,
-	0x9d, 0x38, 0xff, 0xc1, 0x21, 0x04, 0x23, 0xce, 0xb3, 0xaf, 0x85, 0x20, 0x6b, 0x1d, 0x94, 0xd6,
-	0x35, 0x46, 0x7d, 0x6f, 0xe8, 0x27, 0xc6, 0x1e, 0x3c, 0x06, 0x7f, 0x5a, 0xa8, 0xfd, 0xef, 0x6d,
-	0xf7, 0xfd, 0x10, 0x82, 0x6f, 0x78, 0x71, 0xbd, 0xef, 0xe0, 0x39, 0x87, 0x3e, 0xc0, 0x79, 0xc6,
-	0x49, 0x0d, 0x45, 0xd3, 0x79, 0x3c, 0x81, 0x70, 0xcc, 0x57, 0x8b, 0x8c, 0xee, 0xbb, 0xa0, 0x1d,
-	0xc9, 0x68, 0xad, 0xa8, 0xdc, 0xf7, 0xe8, 0xed, 0x48, 0x2e, 0x95, 0x60, 0x75, 0x91, 0x04, 0xce,
-	0xe5, 0x0f, 0x0f, 0xc2, 0xcb, 0x25, 0xc9, 0x88, 0x30, 0x4a, 0xe0, 0x57, 0x10, 0x2c, 0x38, 0xcf,
-	0xe6, 0xce, 0x11, 0x0d, 0xc3, 0x93, 0xc7, 0xb5, 0xc2, 0x6d, 0x15, 0x9a, 0x34, 0x12, 0x5f, 0x43,
-	0x74, 0x1f, 0xe2, 0x97, 0xe0, 0xb3, 0x42, 0x59, 0x74, 0xd3, 0xa0, 0xeb, 0x9b, 0x76, 0x23, 0xdf,
-	0xa4, 0x91, 0x74, 0x59, 0xa1, 0x0c, 0xf6, 0x15, 0x04, 0x19, 0x2f, 0xae, 0x2d, 0xd8, 0xbb, 0xe3,
-	0xe9, 0xad, 0xb6, 0xfa, 0x69, 0x0d, 0x31, 0xf0, 0xaf, 0x00, 0xde, 0x68, 0x4d, 0x2d, 0xbe, 0x65,
-	0xf0, 0x87, 0xf5, 0x35, 0xdf, 0x4a, 0x3f, 0x69, 0x24, 0x81, 0x01, 0x19, 0x86, 0x33, 0x08, 0x53,
-	0xa3, 0xb9, 0xa5, 0x68, 0x1b, 0x8a, 0xfa, 0xb6, 0xa9, 0xd4, 0x66, 0xd2, 0x48, 0xc0, 0xc2, 0x36,
-	0x24, 0xd2, 0x68, 0x6e, 0x49, 0x3a, 0x77, 0x90, 0x54, 0x6a, 0xa3, 0x49, 0x2c, 0x6c, 0x93, 0xcb,
-	0x42, 0x97, 0xd6, 0x72, 0x74, 0xef, 0xc8, 0x65, 0xd7, 0x01, 0x3a, 0x17, 0x03, 0xd2, 0x0c, 0xa3,
-	0x8e, 0xad, 0xf5, 0xe0, 0x17, 0x04, 0xe1, 0x15, 0x5d, 0x2a, 0xee, 0xea, 0x1b, 0x81, 0x97, 0xb2,
-	0xdc, 0x2d, 0x32, 0x6d, 0xea, 0x41, 0xb7, 0xba, 0xbd, 0x35, 0x6e, 0xae, 0x6c, 0xf7, 0x50, 0x2e,
-	0x34, 0x30, 0x4b, 0x8e, 0x3f, 0x86, 0xff, 0x2d, 0x58, 0xa1, 0x57, 0x9e, 0xa3, 0xd1, 0x05, 0xec,
-	0x4d, 0x1a, 0x49, 0xcf, 0x5e, 0x5b, 0xb7, 0x6d, 0x58, 0x7f, 0x23, 0x08, 0x4c, 0x40, 0x26, 0xdd,
-	0x67, 0xd0, 0x32, 0x6b, 0x0e, 0xdd, 0x67, 0xcd, 0x19, 0x57, 0xfc, 0x08, 0xc0, 0x4c, 0xeb, 0xbc,
-	0xb2, 0x80, 0x03, 0x73, 0xf3, 0x5a, 0xaf, 0x8d, 0x2f, 0xa0, 0x2b, 0x4d, 0x57, 0x4b, 0xd7, 0x49,
-	0xef, 0xa8, 0xc0, 0xae, 0xf3, 0x75, 0x27, 0x3a, 0x88, 0x46, 0xdb, 0x2c, 0xa4, 0xeb, 0xa3, 0x7a,
-	0x74, 0x45, 0x57, 0x8d, 0x76, 0x10, 0xfc, 0x01, 0xf8, 0x36, 0x34, 0x96, 0x9a, 0x1e, 0xda, 0xfe,
-	0x30, 0xd2, 0x51, 0x17, 0xda, 0xc6, 0x1c, 0xfc, 0x8c, 0xc0, 0x9b, 0x8e, 0x25, 0xfe, 0x0c, 0x3a,
-	0x7a, 0x5e, 0x58, 0x7a, 0xe7, 0xac, 0x55, 0x1b, 0xbe, 0xcd, 0x0a, 0x35, 0x4d, 0xf1, 0xe7, 0xd0,
-	0x91, 0x4a, 0x68, 0x60, 0xf3, 0xde, 0x1d, 0xd6, 0x96, 0x4a, 0x4c, 0xd3, 0x11, 0x80, 0xcf, 0xd2,
-	0xb9, 0x8d, 0xe3, 0x2f, 0x04, 0xd1, 0x25, 0x25, 0x62, 0x79, 0x93, 0x50, 0xb9, 0xca, 0xec, 0x1c,
-	0x1c, 0x42, 0x58, 0xac, 0xf2, 0xf9, 0x8f, 0x2b, 0x2a, 0x18, 0x95, 0xae, 0x57, 0xa0, 0x58, 0xe5,
-	0xdf, 0xda, 0x1b, 0xfc, 0x00, 0xda, 0x8a, 0x97, 0xf3, 0x5b, 0xf3, 0xb6, 0x97, 0xb4, 0x14, 0x2f,
-	0x2f, 0xf0, 0x97, 0x10, 0xda, 0xfd, 0xb9, 0x19, 0x60, 0xef, 0x9d, 0xf9, 0x6c, 0x2b, 0x9f, 0xd8,
-	0x22, 0x9a, 0x96, 0xd5, 0x8b, 0x5c, 0x2e, 0xb9, 0xa0, 0x76, 0x61, 0x37, 0x13, 0x77, 0xc2, 0x4f,
-	0xc1, 0x63, 0xa9, 0x74, 0xe3, 0x18, 0xd7, 0xaf, 0x93, 0xb1, 0x4c, 0xb4, 0x13, 0x7e, 0x68, 0x22,
-	0xbb, 0xb5, 0xff, 0x3c, 0x2f, 0xb1, 0x87, 0xa7, 0xbf, 0x21, 0xf0, 0x37, 0xfd, 0x83, 0x7d, 0x68,
-	0xbd, 0xe6, 0x05, 0x8d, 0x1a, 0xda, 0xd2, 0x5b, 0x2c, 0x42, 0xda, 0x9a, 0x16, 0xea, 0x45, 0xd4,
-	0xc4, 0x01, 0xb4, 0xa7, 0x85, 0x7a, 0xf6, 0x3c, 0xf2, 0x9c, 0x79, 0x7a, 0x12, 0xb5, 0x9c, 0xf9,
-	0xfc, 0xd3, 0xa8, 0xad, 0x4d, 0x33, 0x05, 0x11, 0x60, 0x80, 0x8e, 0xdd, 0x03, 0x51, 0xa8, 0x6d,
-	0x2b, 0x76, 0xf4, 0x10, 0x87, 0xd0, 0xbd, 0x22, 0xe2, 0xec, 0x86, 0x88, 0xe8, 0x3d, 0x1c, 0x41,
-	0x6f, 0x54, 0x99, 0x80, 0x28, 0xc5, 0xff, 0x87, 0xf0, 0x7c, 0x37, 0x39, 0x11, 0x1d, 0x7d, 0x0f,
-	0x07, 0x8c, 0x6f, 0xf2, 0xba, 0x16, 0xe5, 0x72, 0x14, 0xda, 0x3f, 0xd2, 0x4c, 0xe7, 0x38, 0x43,
-	0x3f, 0x9c, 0x5e, 0x33, 0x75, 0xb3, 0x5a, 0xe8, 0xdf, 0xed, 0xb1, 0x75, 0xfb, 0x84, 0x71, 0x67,
-	0x1d, 0xb3, 0x42, 0x51, 0x51, 0x90, 0xec, 0xd8, 0x28, 0x72, 0x6c, 0x15, 0x29, 0x17, 0xbf, 0x23,
-	0xb4, 0xe8, 0x98, 0xab, 0xd3, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xcc, 0x21, 0x94, 0x03,
-	0x09, 0x00, 0x00,
+	// 1006 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x6e, 0xdb, 0x36,
+	0x14, 0x36, 0x2d, 0xcb, 0x96, 0x8e, 0xbc, 0x4c, 0x60, 0xbb, 0x41, 0x1b, 0xd0, 0x46, 0x35, 0x36,
+	0xc0, 0x28, 0xb0, 0x04, 0x4d, 0x86, 0xae, 0x2b, 0x56, 0x6c, 0x75, 0x8c, 0x20, 0x46, 0x86, 0x22,
+	0x53, 0x86, 0x0c, 0xd8, 0x8d, 0x41, 0x5b, 0x6c, 0x42, 0x44, 0x12, 0x35, 0x92, 0x2e, 0xe6, 0x07,
+	0xd8, 0x1b, 0xec, 0x72, 0x17, 0x7b, 0xb1, 0x5e, 0x0c, 0x7b, 0x8e, 0x01, 0x03, 0x7f, 0x1c, 0xab,
+	0x8d, 0x6b, 0xe4, 0xee, 0x90, 0x3c, 0xdf, 0x47, 0x9e, 0xef, 0xfc, 0x10, 0xfa, 0x72, 0x7e, 0x45,
+	0x4b, 0xb2, 0x57, 0x0b, 0xae, 0x38, 0xbe, 0x57, 0xb2, 0xe2, 0xcd, 0x42, 0xda, 0xd5, 0x9e, 0x3d,
+	0xfa, 0xbc, 0x3f, 0xe7, 0x65, 0xc9, 0x2b, 0xbb, 0x39, 0xf8, 0xa7, 0x0d, 0xd1, 0x31, 0xa3, 0x45,
+	0x7e, 0x6e, 0x4e, 0x71, 0x02, 0xbd, 0xd7, 0x7a, 0x39, 0x19, 0x27, 0x28, 0x45, 0x43, 0x2f, 0x5b,
+	0x2d, 0x31, 0x86, 0x4e, 0x45, 0x4a, 0x9a, 0xb4, 0x53, 0x34, 0x0c, 0x33, 0x63, 0xe3, 0x2f, 0x60,
+	0x87, 0xc9, 0x69, 0x2d, 0x58, 0x49, 0xc4, 0x72, 0x7a, 0x4d, 0x97, 0x89, 0x97, 0xa2, 0x61, 0x90,
+	0xf5, 0x99, 0x3c, 0xb3, 0x9b, 0xa7, 0x74, 0x89, 0x53, 0x88, 0x72, 0x2a, 0xe7, 0x82, 0xd5, 0x8a,
+	0xf1, 0x2a, 0xe9, 0x18, 0x82, 0xe6, 0x16, 0x7e, 0x0e, 0x61, 0x4e, 0x14, 0x99, 0xaa, 0x65, 0x4d,
+	0x13, 0x3f, 0x45, 0xc3, 0x9d, 0x83, 0x07, 0x7b, 0x1b, 0x1e, 0xbf, 0x37, 0x26, 0x8a, 0xfc, 0xbc,
+	0xac, 0x69, 0x16, 0xe4, 0xce, 0xc2, 0x23, 0x88, 0x34, 0x6c, 0x5a, 0x13, 0x41, 0x4a, 0x99, 0x74,
+	0x53, 0x6f, 0x18, 0x1d, 0x3c, 0x7a, 0x17, 0xed, 0x42, 0x3e, 0xa5, 0xcb, 0x0b, 0x52, 0x2c, 0xe8,
+	0x19, 0x61, 0x22, 0x03, 0x8d, 0x3a, 0x33, 0x20, 0x3c, 0x86, 0x3e, 0xab, 0x72, 0xfa, 0xfb, 0x8a,
+	0xa4, 0x77, 0x57, 0x92, 0xc8, 0xc0, 0x1c, 0xcb, 0xa7, 0xd0, 0x25, 0x0b, 0xc5, 0x27, 0xe3, 0x24,
+	0x30, 0x2a, 0xb8, 0xd5, 0xe0, 0x2d, 0x82, 0xf8, 0x88, 0x17, 0x05, 0x9d, 0xeb, 0x60, 0x9d, 0xd0,
+	0x2b, 0x39, 0x51, 0x43, 0xce, 0xf7, 0x84, 0x6a, 0xdf, 0x16, 0x6a, 0x7d, 0x85, 0xd7, 0xbc, 0x02,
+	0x3f, 0x83, 0xae, 0xc9, 0x93, 0x4c, 0x3a, 0xe6, 0xe9, 0xe9, 0x46, 0xf5, 0x1a, 0x89, 0xce, 0x9c,
+	0x3f, 0x7e, 0x09, 0x50, 0x0b, 0x5e, 0x53, 0xa1, 0x18, 0x95, 0x89, 0x7f, 0x67, 0xf5, 0xd6, 0xa0,
+	0xc1, 0x2e, 0x84, 0x23, 0xce, 0x8b, 0x97, 0x42, 0x90, 0xa5, 0x8e, 0x4b, 0xa7, 0x26, 0x41, 0xa9,
+	0x37, 0x0c, 0x32, 0x63, 0x0f, 0x1e, 0x42, 0x30, 0xa9, 0xd4, 0xed, 0x73, 0xdf, 0x9d, 0xef, 0x42,
+	0xf8, 0x23, 0xaf, 0x2e, 0x6f, 0x3b, 0x78, 0xce, 0x21, 0x05, 0x38, 0x2e, 0x38, 0xd9, 0x40, 0xd1,
+	0x76, 0x1e, 0x8f, 0x20, 0x1a, 0xf3, 0xc5, 0xac, 0xa0, 0xb7, 0x5d, 0xd0, 0x9a, 0x64, 0xb4, 0x54,
+	0x54, 0xde, 0xf6, 0xe8, 0xaf, 0x49, 0xce, 0x95, 0x60, 0x9b, 0x5e, 0x12, 0x3a, 0x97, 0xb7, 0x1e,
+	0x44, 0xe7, 0x73, 0x52, 0x10, 0x61, 0xc4, 0xc4, 0x2f, 0x20, 0x9c, 0x71, 0x5e, 0x4c, 0x9d, 0x23,
+	0x1a, 0x46, 0x07, 0x0f, 0x37, 0x6a, 0x7f, 0xa3, 0xd0, 0x49, 0x2b, 0x0b, 0x34, 0x44, 0x97, 0x32,
+	0x7e, 0x0e, 0x01, 0xab, 0x94, 0x45, 0xb7, 0x0d, 0x7a, 0x73, 0xdd, 0xaf, 0xe4, 0x3b, 0x69, 0x65,
+	0x3d, 0x56, 0x29, 0x83, 0x7d, 0x01, 0x61, 0xc1, 0xab, 0x4b, 0x0b, 0xf6, 0xb6, 0x5c, 0x7d, 0xa3,
+	0xad, 0xbe, 0x5a, 0x43, 0x0c, 0xfc, 0x07, 0x80, 0xd7, 0x5a, 0x53, 0x8b, 0xef, 0x18, 0xfc, 0xee,
+	0xe6, 0xb2, 0xb9, 0x91, 0xfe, 0xa4, 0x95, 0x85, 0x06, 0x64, 0x18, 0x8e, 0x20, 0xca, 0x8d, 0xe6,
+	0x96, 0xc2, 0x4f, 0xd1, 0x07, 0x2b, 0xaf, 0x91, 0x9b, 0x93, 0x56, 0x06, 0x16, 0xb6, 0x22, 0x91,
+	0x46, 0x73, 0x4b, 0xd2, 0xdd, 0x42, 0xd2, 0xc8, 0x8d, 0x26, 0xb1, 0xb0, 0x55, 0x2c, 0x33, 0x9d,
+	0x5a, 0xcb, 0xd1, 0xdb, 0x12, 0xcb, 0xba, 0x02, 0x74, 0x2c, 0x06, 0xa4, 0x19, 0x46, 0x5d, 0x9b,
+	0xeb, 0xc1, 0x9f, 0x08, 0xa2, 0x0b, 0x3a, 0x57, 0xdc, 0xe5, 0x37, 0x06, 0x2f, 0x67, 0xa5, 0x9b,
+	0x85, 0xda, 0xd4, 0xb3, 0xc2, 0xea, 0xf6, 0xc6, 0xb8, 0x25, 0xed, 0x2d, 0xb7, 0xbd, 0xa3, 0x5c,
+	0x64, 0x60, 0x96, 0x1c, 0x7f, 0x09, 0x1f, 0xcd, 0x58, 0xa5, 0xa7, 0xa6, 0xa3, 0xd1, 0x09, 0xec,
+	0x9f, 0xb4, 0xb2, 0xbe, 0xdd, 0xb6, 0x6e, 0x37, 0xcf, 0xfa, 0x0f, 0x41, 0x68, 0x1e, 0x64, 0xc2,
+	0x7d, 0x02, 0x1d, 0x33, 0x29, 0xd1, 0x5d, 0x26, 0xa5, 0x71, 0xc5, 0x0f, 0x00, 0x4c, 0xc3, 0x4f,
+	0x1b, 0x33, 0x3c, 0x34, 0x3b, 0xaf, 0xf4, 0xe4, 0xf9, 0x0e, 0x7a, 0xd2, 0x54, 0xb5, 0x4c, 0xbc,
+	0x6d, 0x19, 0x58, 0x57, 0xbe, 0xae, 0x44, 0x07, 0xd1, 0x68, 0x1b, 0x85, 0x4c, 0x3a, 0x5b, 0xd0,
+	0x0d, 0x5d, 0x35, 0xda, 0x41, 0xf0, 0x67, 0x10, 0xd8, 0xa7, 0xb1, 0x3c, 0xf1, 0x9b, 0x7f, 0x4e,
+	0x3e, 0xea, 0x81, 0x6f, 0xcc, 0xc1, 0x1f, 0x08, 0xbc, 0xc9, 0x58, 0xe2, 0x6f, 0xa0, 0xab, 0xfb,
+	0x85, 0xe5, 0x09, 0xba, 0x63, 0xc1, 0xfb, 0xac, 0x52, 0x93, 0x1c, 0x7f, 0x0b, 0x5d, 0xa9, 0x84,
+	0x06, 0xb6, 0xef, 0x5c, 0x61, 0xbe, 0x54, 0x62, 0x92, 0x8f, 0x00, 0x02, 0x96, 0x4f, 0xed, 0x3b,
+	0xfe, 0x45, 0x10, 0x9f, 0x53, 0x22, 0xe6, 0x57, 0x19, 0x95, 0x8b, 0xc2, 0xf6, 0xc1, 0x2e, 0x44,
+	0xd5, 0xa2, 0x9c, 0xfe, 0xb6, 0xa0, 0x42, 0xcf, 0x50, 0x5b, 0x2b, 0x50, 0x2d, 0xca, 0x9f, 0xec,
+	0x0e, 0xbe, 0x07, 0xbe, 0xe2, 0xf5, 0xf4, 0xda, 0xdc, 0xed, 0x65, 0x1d, 0xc5, 0xeb, 0x53, 0xfc,
+	0x3d, 0x44, 0x76, 0x04, 0xaf, 0x1a, 0xd8, 0xfb, 0x60, 0x3c, 0x37, 0x99, 0xcf, 0x6c, 0x12, 0x4d,
+	0xc9, 0xea, 0xbf, 0x40, 0xce, 0xb9, 0xa0, 0x76, 0xe6, 0xb7, 0x33, 0xb7, 0xc2, 0x8f, 0xc1, 0x63,
+	0xb9, 0x74, 0xed, 0x98, 0x6c, 0x1e, 0x27, 0x63, 0x99, 0x69, 0x27, 0x7c, 0xdf, 0xbc, 0xec, 0xda,
+	0x7e, 0x9b, 0x5e, 0x66, 0x17, 0x8f, 0xff, 0x42, 0x10, 0xac, 0xea, 0x07, 0x07, 0xd0, 0x79, 0xc5,
+	0x2b, 0x1a, 0xb7, 0xb4, 0xa5, 0xa7, 0x58, 0x8c, 0xb4, 0x35, 0xa9, 0xd4, 0xb3, 0xb8, 0x8d, 0x43,
+	0xf0, 0x27, 0x95, 0x7a, 0xf2, 0x34, 0xf6, 0x9c, 0x79, 0x78, 0x10, 0x77, 0x9c, 0xf9, 0xf4, 0xeb,
+	0xd8, 0xd7, 0xa6, 0xe9, 0x82, 0x18, 0x30, 0x40, 0xd7, 0xce, 0x81, 0x38, 0xd2, 0xb6, 0x15, 0x3b,
+	0xbe, 0x8f, 0x23, 0xe8, 0x5d, 0x10, 0x71, 0x74, 0x45, 0x44, 0xfc, 0x09, 0x8e, 0xa1, 0x3f, 0x6a,
+	0x74, 0x40, 0x9c, 0xe3, 0x8f, 0x21, 0x3a, 0x5e, 0x77, 0x4e, 0x4c, 0x47, 0xbf, 0xc0, 0x0e, 0xe3,
+	0xab, 0xb8, 0x2e, 0x45, 0x3d, 0x1f, 0x45, 0xf6, 0x53, 0x3b, 0xd3, 0x31, 0x9e, 0xa1, 0x5f, 0x0f,
+	0x2f, 0x99, 0xba, 0x5a, 0xcc, 0xf4, 0xc7, 0xb5, 0x6f, 0xdd, 0xbe, 0x62, 0xdc, 0x59, 0xfb, 0xac,
+	0x52, 0x54, 0x54, 0xa4, 0xd8, 0x37, 0x8a, 0xec, 0x5b, 0x45, 0xea, 0xd9, 0xdf, 0x08, 0xcd, 0xba,
+	0x66, 0xeb, 0xf0, 0xff, 0x01, 0x00, 0x07, 0xf4, 0xa0, 0x03, 0x46, 0x09, 0x00, 0x00,
 }
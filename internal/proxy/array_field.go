@@ -0,0 +1,194 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+
+	ant_ast "github.com/antonmedv/expr/ast"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// validateArrayFields checks the fields marked as array fields of the collection to create
+func validateArrayFields(schema *schemapb.CollectionSchema) error {
+	for _, field := range schema.GetFields() {
+		elementType, err := typeutil.GetArrayElementType(field)
+		if err != nil {
+			return err
+		}
+		if elementType == schemapb.DataType_None {
+			continue
+		}
+		if field.GetIsPrimaryKey() || typeutil.IsPartitionKeyField(field) {
+			return fmt.Errorf("the array field %s couldn't be the primary key or the partition key", field.GetName())
+		}
+		if typeutil.IsJSONField(field) {
+			return fmt.Errorf("the array field %s couldn't be a JSON field", field.GetName())
+		}
+	}
+	return nil
+}
+
+// validateArrayFieldsData checks each value of the array fields to insert is a JSON array of the element type
+func validateArrayFieldsData(schema *schemapb.CollectionSchema, fieldsData []*schemapb.FieldData) error {
+	elementTypes := make(map[string]schemapb.DataType)
+	for _, field := range schema.GetFields() {
+		if elementType, err := typeutil.GetArrayElementType(field); err == nil && elementType != schemapb.DataType_None {
+			elementTypes[field.GetName()] = elementType
+		}
+	}
+	for _, fieldData := range fieldsData {
+		elementType, ok := elementTypes[fieldData.GetFieldName()]
+		if !ok {
+			continue
+		}
+		for i, row := range fieldData.GetScalars().GetStringData().GetData() {
+			elements, err := typeutil.UnmarshalArray(row)
+			if err == nil {
+				err = typeutil.ValidateArrayElements(elements, elementType)
+			}
+			if err != nil {
+				return fmt.Errorf("invalid value of the array field %s at row %d: %w", fieldData.GetFieldName(), i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// isArrayFieldExpr returns whether the node is array_contains or array_contains_any of a field, or a comparison on
+// array_length of it
+func isArrayFieldExpr(node ant_ast.Node) bool {
+	isFunc := func(node ant_ast.Node, names ...string) bool {
+		funcNode, ok := node.(*ant_ast.FunctionNode)
+		if !ok {
+			return false
+		}
+		for _, name := range names {
+			if funcNode.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+	switch n := node.(type) {
+	case *ant_ast.FunctionNode:
+		return isFunc(n, typeutil.ArrayContains, typeutil.ArrayContainsAny)
+	case *ant_ast.BinaryNode:
+		switch n.Operator {
+		case "==", "!=", ">", ">=", "<", "<=":
+			return isFunc(n.Left, typeutil.ArrayLength) || isFunc(n.Right, typeutil.ArrayLength)
+		}
+	}
+	return false
+}
+
+// handleArrayField returns the array field and its element type
+func (pc *parserContext) handleArrayField(node ant_ast.Node) (*schemapb.FieldSchema, schemapb.DataType, error) {
+	idNode, ok := node.(*ant_ast.IdentifierNode)
+	if !ok {
+		return nil, schemapb.DataType_None, fmt.Errorf("the first argument should be an array field")
+	}
+	field, err := pc.schema.GetFieldFromName(idNode.Value)
+	if err != nil {
+		return nil, schemapb.DataType_None, err
+	}
+	elementType, err := typeutil.GetArrayElementType(field)
+	if err != nil {
+		return nil, schemapb.DataType_None, err
+	}
+	if elementType == schemapb.DataType_None {
+		return nil, schemapb.DataType_None, fmt.Errorf("field %s is not an array field", field.GetName())
+	}
+	return field, elementType, nil
+}
+
+// getGenericValue returns the value held by the GenericValue
+func getGenericValue(gv *planpb.GenericValue) interface{} {
+	switch v := gv.GetVal().(type) {
+	case *planpb.GenericValue_BoolVal:
+		return v.BoolVal
+	case *planpb.GenericValue_Int64Val:
+		return v.Int64Val
+	case *planpb.GenericValue_FloatVal:
+		return v.FloatVal
+	case *planpb.GenericValue_StringVal:
+		return v.StringVal
+	}
+	return nil
+}
+
+// handleArrayFieldExpr creates the ArrayExpr on the array field
+func (pc *parserContext) handleArrayFieldExpr(node ant_ast.Node, not bool) (*planpb.Expr, error) {
+
+	var field *schemapb.FieldSchema
+	predicate := &typeutil.ArrayPredicate{Not: not}
+	switch n := node.(type) {
+	case *ant_ast.FunctionNode:
+		if len(n.Arguments) != 2 {
+			return nil, fmt.Errorf("%s expects an array field and the values", n.Name)
+		}
+		var elementType schemapb.DataType
+		var err error
+		field, elementType, err = pc.handleArrayField(n.Arguments[0])
+		if err != nil {
+			return nil, err
+		}
+		predicate.Op = n.Name
+		var values []*planpb.GenericValue
+		if n.Name == typeutil.ArrayContainsAny {
+			values, err = pc.handleArrayExpr(&n.Arguments[1], elementType)
+		} else {
+			var value *planpb.GenericValue
+			value, err = pc.handleLeafValue(&n.Arguments[1], elementType)
+			values = []*planpb.GenericValue{value}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid values of %s on field %s: %w", n.Name, field.GetName(), err)
+		}
+		for _, value := range values {
+			predicate.Values = append(predicate.Values, getGenericValue(value))
+		}
+	case *ant_ast.BinaryNode:
+		funcNode, lengthNode, op := n.Left, n.Right, n.Operator
+		if f, ok := funcNode.(*ant_ast.FunctionNode); !ok || f.Name != typeutil.ArrayLength {
+			funcNode, lengthNode, op = n.Right, n.Left, opMap[getCompareOpType(n.Operator, true)]
+		}
+		args := funcNode.(*ant_ast.FunctionNode).Arguments
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%s expects an array field", typeutil.ArrayLength)
+		}
+		var err error
+		field, _, err = pc.handleArrayField(args[0])
+		if err != nil {
+			return nil, err
+		}
+		length, ok := lengthNode.(*ant_ast.IntegerNode)
+		if !ok {
+			return nil, fmt.Errorf("%s should be compared with an integer", typeutil.ArrayLength)
+		}
+		predicate.Op = typeutil.ArrayLength
+		predicate.CompareOp = op
+		predicate.Length = int64(length.Value)
+	default:
+		return nil, fmt.Errorf("unsupported predicate on the array field")
+	}
+
+	return predicate.Expr(createColumnInfo(field))
+}
@@ -0,0 +1,156 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func newArrayFieldSchema() *schemapb.CollectionSchema {
+	schema := newJSONFieldSchema()
+	schema.Fields = append(schema.Fields,
+		&schemapb.FieldSchema{FieldID: 104, Name: "tags", DataType: schemapb.DataType_VarChar,
+			TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.ArrayElementTypeParam, Value: "VarChar"},
+				{Key: "max_length", Value: "1024"},
+			}},
+		&schemapb.FieldSchema{FieldID: 105, Name: "ids", DataType: schemapb.DataType_VarChar,
+			TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.ArrayElementTypeParam, Value: "Int32"},
+				{Key: "max_length", Value: "1024"},
+			}},
+	)
+	return schema
+}
+
+func TestValidateArrayFields(t *testing.T) {
+	assert.NoError(t, validateArrayFields(newArrayFieldSchema()))
+
+	schema := newArrayFieldSchema()
+	schema.Fields[4].TypeParams[0].Value = "FloatVector"
+	assert.Error(t, validateArrayFields(schema))
+
+	schema = newArrayFieldSchema()
+	schema.Fields[4].DataType = schemapb.DataType_Int64
+	assert.Error(t, validateArrayFields(schema))
+
+	schema = newArrayFieldSchema()
+	schema.Fields[4].IsPrimaryKey = true
+	assert.Error(t, validateArrayFields(schema))
+
+	schema = newArrayFieldSchema()
+	schema.Fields[4].TypeParams = append(schema.Fields[4].TypeParams, &commonpb.KeyValuePair{Key: common.JSONFieldParam, Value: "true"})
+	assert.Error(t, validateArrayFields(schema))
+}
+
+func TestValidateArrayFieldsData(t *testing.T) {
+	schema := newArrayFieldSchema()
+	assert.NoError(t, validateArrayFieldsData(schema, []*schemapb.FieldData{
+		newStringFieldData("tags", `["a", "b"]`, ""),
+		newStringFieldData("ids", `[1, 2]`, `[]`),
+	}))
+	assert.Error(t, validateArrayFieldsData(schema, []*schemapb.FieldData{
+		newStringFieldData("tags", `["a"]`, `[1]`),
+	}))
+	assert.Error(t, validateArrayFieldsData(schema, []*schemapb.FieldData{
+		newStringFieldData("ids", `[2147483648]`),
+	}))
+	assert.Error(t, validateArrayFieldsData(schema, []*schemapb.FieldData{
+		newStringFieldData("ids", `{}`),
+	}))
+}
+
+func TestCreateExprPlan_ArrayField(t *testing.T) {
+	schema := newArrayFieldSchema()
+
+	getPredicate := func(expr *planpb.Expr) *typeutil.ArrayPredicate {
+		array := expr.GetArrayExpr()
+		assert.NotNil(t, array)
+		assert.True(t, typeutil.IsArrayField(schema.Fields[array.GetColumnInfo().GetFieldId()-100]))
+		p, err := typeutil.NewArrayPredicate(array)
+		assert.NoError(t, err)
+		return p
+	}
+
+	plan, err := createExprPlan(schema, `pk > 1 && array_contains(tags, "a")`)
+	assert.NoError(t, err)
+	p := getPredicate(plan.GetPredicates().GetBinaryExpr().GetRight())
+	assert.Equal(t, typeutil.ArrayContains, p.Op)
+	assert.Equal(t, []interface{}{"a"}, p.Values)
+
+	plan, err = createExprPlan(schema, `pk > 1 && not array_contains_any(ids, [1, 2])`)
+	assert.NoError(t, err)
+	p = getPredicate(plan.GetPredicates().GetBinaryExpr().GetRight())
+	assert.Equal(t, typeutil.ArrayContainsAny, p.Op)
+	assert.Len(t, p.Values, 2)
+	assert.True(t, p.Not)
+
+	plan, err = createExprPlan(schema, `3 < array_length(tags) && pk < 10`)
+	assert.NoError(t, err)
+	assert.Equal(t, &typeutil.ArrayPredicate{Op: typeutil.ArrayLength, CompareOp: ">", Length: 3},
+		getPredicate(plan.GetPredicates().GetBinaryExpr().GetLeft()))
+
+	plan, err = createExprPlan(schema, `array_length(ids) == 0 && exists(meta["a"]) && pk > 1`)
+	assert.NoError(t, err)
+	assert.Equal(t, &typeutil.ArrayPredicate{Op: typeutil.ArrayLength, CompareOp: "==", Length: 0},
+		getPredicate(plan.GetPredicates().GetBinaryExpr().GetLeft().GetBinaryExpr().GetLeft()))
+
+	invalids := []string{
+		`array_contains(tags, 1)`,
+		`array_contains(ids, "a")`,
+		`array_contains(pk, 1)`,
+		`array_contains(tags)`,
+		`array_contains_any(tags, "a")`,
+		`array_length(tags) > 1.5`,
+		`array_length(tags, ids) > 1`,
+		`tags == "[]"`,
+		`ids in ["[]"]`,
+	}
+	for _, expr := range invalids {
+		_, err := createExprPlan(schema, expr)
+		assert.Error(t, err, expr)
+	}
+
+	// the predicates on array fields are evaluated by segcore wherever they are, in searches as well
+	valids := []string{
+		`array_contains(tags, "a")`,
+		`array_length(ids) == 0 || exists(meta["a"])`,
+		`pk > 1 || array_contains(tags, "a")`,
+		`not (pk > 1 && array_contains(tags, "a"))`,
+		`pk > 1 && (pk < 10 || array_contains(tags, "a"))`,
+	}
+	for _, expr := range valids {
+		_, err := createExprPlan(schema, expr)
+		assert.NoError(t, err, expr)
+	}
+	plan, err = createQueryPlan(schema, `pk > 1 && array_contains(tags, "a")`, "vec", &planpb.QueryInfo{Topk: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, &typeutil.ArrayPredicate{Op: typeutil.ArrayContains, Values: []interface{}{"a"}},
+		getPredicate(plan.GetVectorAnns().GetPredicates().GetBinaryExpr().GetRight()))
+	plan, err = createQueryPlan(schema, `array_length(tags) >= 2`, "vec", &planpb.QueryInfo{Topk: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, &typeutil.ArrayPredicate{Op: typeutil.ArrayLength, CompareOp: ">=", Length: 2},
+		getPredicate(plan.GetVectorAnns().GetPredicates()))
+}
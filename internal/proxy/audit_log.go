@@ -0,0 +1,226 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+const (
+	auditKindSearch = "search"
+	auditKindQuery  = "query"
+)
+
+// auditRecord records who accessed what data and when
+type auditRecord struct {
+	kind         string
+	reqID        UniqueID
+	user         string
+	clientAddr   string
+	dbName       string
+	collection   string
+	partitions   []string
+	expr         string
+	outputFields []string
+	receivedAt   time.Time
+	elapsed      time.Duration
+	status       *commonpb.Status
+}
+
+// newAuditRecord returns the record of the request received now
+func newAuditRecord(ctx context.Context, kind string, dbName string, collection string, partitions []string, expr string,
+	outputFields []string) *auditRecord {
+	return &auditRecord{
+		kind:         kind,
+		user:         getCurUser(ctx),
+		clientAddr:   getClientAddr(ctx),
+		dbName:       dbName,
+		collection:   collection,
+		partitions:   partitions,
+		expr:         expr,
+		outputFields: outputFields,
+		receivedAt:   time.Now(),
+	}
+}
+
+// done completes the record with the ID and the result status of the request
+func (r *auditRecord) done(reqID UniqueID, status *commonpb.Status) *auditRecord {
+	r.reqID = reqID
+	r.elapsed = time.Since(r.receivedAt)
+	r.status = status
+	return r
+}
+
+// auditLogger writes the audit records asynchronously, so that serving the requests is never blocked by the writing.
+// The records are dropped if the buffer is full.
+type auditLogger struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	logger  *zap.Logger
+	records chan *auditRecord
+	dropped int64 // number of the records dropped, accessed atomically
+}
+
+func newAuditLogger(ctx context.Context, logger *zap.Logger, bufferSize int) *auditLogger {
+	ctx1, cancel := context.WithCancel(ctx)
+	return &auditLogger{
+		ctx:     ctx1,
+		cancel:  cancel,
+		logger:  logger,
+		records: make(chan *auditRecord, bufferSize),
+	}
+}
+
+// newAuditZapLogger returns the logger of the audit log file, or the proxy logger if no file configured
+func newAuditZapLogger() *zap.Logger {
+	filename := Params.ProxyCfg.AuditLogFile
+	if filename == "" {
+		rootPath := Params.ProxyCfg.Base.LoadWithDefault("log.file.rootPath", "")
+		if rootPath == "" {
+			return log.L()
+		}
+		filename = path.Join(rootPath, fmt.Sprintf("proxy-%d-audit.log", Params.ProxyCfg.GetNodeID()))
+	}
+
+	// rotated as the proxy log
+	cfg := Params.ProxyCfg.Base.Log
+	cfg.Level = "info"
+	cfg.File.Filename = filename
+	logger, _, err := log.InitLogger(&cfg)
+	if err != nil {
+		log.Warn("failed to init audit log, write audit records to proxy log", zap.String("filename", filename), zap.Error(err))
+		return log.L()
+	}
+	return logger
+}
+
+func (l *auditLogger) start() {
+	l.wg.Add(1)
+	go l.writeLoop()
+	log.Info("audit logger started", zap.Int("bufferSize", cap(l.records)))
+}
+
+// close writes the buffered records and stops the logger
+func (l *auditLogger) close() {
+	l.cancel()
+	l.wg.Wait()
+	_ = l.logger.Sync()
+}
+
+func (l *auditLogger) writeLoop() {
+	defer l.wg.Done()
+	for {
+		select {
+		case <-l.ctx.Done():
+			for {
+				select {
+				case record := <-l.records:
+					l.write(record)
+				default:
+					log.Info("audit logger write loop exit", zap.Int64("dropped", atomic.LoadInt64(&l.dropped)))
+					return
+				}
+			}
+		case record := <-l.records:
+			l.write(record)
+		}
+	}
+}
+
+// record buffers the record to be written, or drops it if the buffer is full
+func (l *auditLogger) record(record *auditRecord) {
+	select {
+	case l.records <- record:
+	default:
+		dropped := atomic.AddInt64(&l.dropped, 1)
+		log.RatedWarn(10, "audit log buffer is full, drop the audit record", zap.Int64("dropped", dropped))
+	}
+}
+
+func (l *auditLogger) write(r *auditRecord) {
+	fields := []zap.Field{
+		zap.String("kind", r.kind),
+		zap.Int64("reqID", r.reqID),
+		zap.String("user", r.user),
+		zap.String("clientAddr", r.clientAddr),
+		zap.Time("receivedAt", r.receivedAt),
+		zap.String("db", r.dbName),
+		zap.String("collection", r.collection),
+		zap.Strings("partitions", r.partitions),
+		zap.String("expr", r.expr),
+		zap.Strings("outputFields", r.outputFields),
+		zap.Duration("elapsed", r.elapsed),
+		zap.String("errorCode", r.status.GetErrorCode().String()),
+	}
+	if r.status.GetErrorCode() != commonpb.ErrorCode_Success {
+		fields = append(fields, zap.String("reason", r.status.GetReason()))
+	}
+	l.logger.Info("audit", fields...)
+}
+
+type curUserKey struct{}
+
+// withCurUser records the user authenticated by the API key in ctx
+func withCurUser(ctx context.Context, username string) context.Context {
+	return context.WithValue(ctx, curUserKey{}, username)
+}
+
+// getCurUser returns the authenticated user of the request, or empty if the request carries no credential
+func getCurUser(ctx context.Context) string {
+	if username, ok := ctx.Value(curUserKey{}).(string); ok {
+		return username
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	authorization := md[strings.ToLower(util.HeaderAuthorize)]
+	if len(authorization) < 1 {
+		return ""
+	}
+	rawToken, err := crypto.Base64Decode(authorization[0])
+	if err != nil {
+		return ""
+	}
+	return strings.SplitN(rawToken, util.CredentialSeperator, 2)[0]
+}
+
+// getClientAddr returns the address of the client issuing the request
+func getClientAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	return p.Addr.String()
+}
@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+func TestAuditLogger(t *testing.T) {
+	md := metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode("alice"+util.CredentialSeperator+"password"))
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 5000}})
+
+	t.Run("write records", func(t *testing.T) {
+		core, logs := observer.New(zap.InfoLevel)
+		l := newAuditLogger(context.Background(), zap.New(core), 10)
+		l.start()
+
+		record := newAuditRecord(ctx, auditKindSearch, "db", "collection", []string{"p1"}, "pk > 1", []string{"field"})
+		l.record(record.done(100, &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}))
+		record = newAuditRecord(ctx, auditKindQuery, "db", "collection", nil, "pk in [1]", nil)
+		l.record(record.done(101, &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock error"}))
+		l.close()
+
+		require.Equal(t, 2, logs.Len())
+		fields := logs.All()[0].ContextMap()
+		assert.Equal(t, auditKindSearch, fields["kind"])
+		assert.Equal(t, int64(100), fields["reqID"])
+		assert.Equal(t, "alice", fields["user"])
+		assert.Equal(t, "127.0.0.1:5000", fields["clientAddr"])
+		assert.Equal(t, "collection", fields["collection"])
+		assert.Equal(t, "pk > 1", fields["expr"])
+		assert.Equal(t, []interface{}{"field"}, fields["outputFields"])
+		assert.NotContains(t, fields, "reason")
+
+		fields = logs.All()[1].ContextMap()
+		assert.Equal(t, auditKindQuery, fields["kind"])
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError.String(), fields["errorCode"])
+		assert.Equal(t, "mock error", fields["reason"])
+	})
+
+	t.Run("drop records if buffer full", func(t *testing.T) {
+		core, logs := observer.New(zap.InfoLevel)
+		l := newAuditLogger(context.Background(), zap.New(core), 1)
+		for i := 0; i < 3; i++ {
+			l.record(newAuditRecord(ctx, auditKindSearch, "", "collection", nil, "", nil).done(UniqueID(i), nil))
+		}
+		assert.Equal(t, int64(2), l.dropped)
+
+		l.start()
+		l.close()
+		assert.Equal(t, 1, logs.Len())
+	})
+
+	t.Run("audit failed template requests", func(t *testing.T) {
+		core, logs := observer.New(zap.InfoLevel)
+		node := &Proxy{
+			auditLogger: newAuditLogger(context.Background(), zap.New(core), 10),
+			templates:   newPlanTemplateRegistry(nil, "/root", 10, time.Minute),
+		}
+		node.UpdateStateCode(internalpb.StateCode_Healthy)
+		node.auditLogger.start()
+		// the template is registered by another user
+		node.templates.add(&registeredTemplate{id: 1, user: "bob", collectionName: "collection", expr: "pk > 1"},
+			time.Now().Add(time.Minute))
+
+		searchResp, err := node.Search(ctx, &milvuspb.SearchRequest{CollectionName: "collection", TemplateID: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, searchResp.GetStatus().GetErrorCode())
+		queryResp, err := node.Query(ctx, &milvuspb.QueryRequest{CollectionName: "collection", TemplateID: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, queryResp.GetStatus().GetErrorCode())
+		node.auditLogger.close()
+
+		require.Equal(t, 2, logs.Len())
+		fields := logs.All()[0].ContextMap()
+		assert.Equal(t, auditKindSearch, fields["kind"])
+		assert.Equal(t, "alice", fields["user"])
+		assert.Equal(t, searchResp.GetStatus().GetReason(), fields["reason"])
+		fields = logs.All()[1].ContextMap()
+		assert.Equal(t, auditKindQuery, fields["kind"])
+		assert.Equal(t, queryResp.GetStatus().GetReason(), fields["reason"])
+	})
+}
+
+func TestGetCurUser(t *testing.T) {
+	assert.Equal(t, "", getCurUser(context.Background()))
+	assert.Equal(t, "", getClientAddr(context.Background()))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("key", "value"))
+	assert.Equal(t, "", getCurUser(ctx))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderAuthorize, "invalid base64"))
+	assert.Equal(t, "", getCurUser(ctx))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode("root"+util.CredentialSeperator+"Milvus")))
+	assert.Equal(t, "root", getCurUser(ctx))
+}
@@ -11,7 +11,10 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/crypto"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 // validAuth validates the authentication
@@ -54,6 +57,40 @@ func validSourceID(ctx context.Context, authorization []string) bool {
 	return sourceID == util.MemberCredID
 }
 
+type memberKey struct{}
+
+// MemberInterceptor returns a unary server interceptor which marks the requests over the connections authenticated by
+// the client certificates, see isMember. It must only be chained on the internal server, whose client certificates
+// are issued by the internal CA to the Milvus members.
+func MemberInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if p, ok := peer.FromContext(ctx); ok {
+			if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 {
+				ctx = context.WithValue(ctx, memberKey{}, true)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// isMember returns whether the request is from a Milvus member. The sourceId header is public, so it's only trusted
+// over a connection authenticated by the client certificate of a member, see MemberInterceptor.
+func isMember(ctx context.Context) bool {
+	if verified, _ := ctx.Value(memberKey{}).(bool); !verified {
+		return false
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	return validSourceID(ctx, md[strings.ToLower(util.HeaderSourceID)])
+}
+
+// validAPIKey returns the user of the API key
+func validAPIKey(apiKey []string) (string, bool) {
+	if len(apiKey) < 1 {
+		return "", false
+	}
+	return globalPrivilegeCache.getAPIKeyUser(apiKey[0])
+}
+
 // AuthenticationInterceptor verify based on kv pair <"authorization": "token"> in header
 func AuthenticationInterceptor(ctx context.Context) (context.Context, error) {
 	// The keys within metadata.MD are normalized to lowercase.
@@ -68,11 +105,16 @@ func AuthenticationInterceptor(ctx context.Context) (context.Context, error) {
 	// check:
 	//	1. if rpc call from a member (like index/query/data component)
 	// 	2. if rpc call from sdk
+	//	3. if rpc call with an API key
 	if Params.CommonCfg.AuthorizationEnabled {
-		if !validSourceID(ctx, md[strings.ToLower(util.HeaderSourceID)]) &&
-			!validAuth(ctx, md[strings.ToLower(util.HeaderAuthorize)]) {
-			return nil, ErrUnauthenticated()
+		if validSourceID(ctx, md[strings.ToLower(util.HeaderSourceID)]) ||
+			validAuth(ctx, md[strings.ToLower(util.HeaderAuthorize)]) {
+			return ctx, nil
+		}
+		if username, ok := validAPIKey(md[strings.ToLower(util.HeaderAPIKey)]); ok {
+			return withCurUser(ctx, username), nil
 		}
+		return nil, ErrUnauthenticated()
 	}
 	return ctx, nil
 }
@@ -0,0 +1,196 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+// globalBackpressure is nil until proxy initialized, no collection is pressured then.
+var globalBackpressure *backpressureWatcher
+
+// backpressureError is returned when the datanodes consuming the collection are under memory pressure
+type backpressureError struct {
+	collectionName string
+	nodeID         UniqueID
+}
+
+func (e *backpressureError) Error() string {
+	return fmt.Sprintf("%s: datanode %d is under memory pressure, dml of collection %s is throttled",
+		rateLimitPrefix, e.nodeID, e.collectionName)
+}
+
+// backpressureWatcher watches the collections reported by the datanodes whose insert buffers exceed the
+// high watermark, the key is {MetaRootPath}/datanode/backpressure/{nodeID} and the value is a json encoded
+// list of collection IDs, the key is removed once the datanode flushed its buffers below the low watermark.
+type backpressureWatcher struct {
+	etcdCli *clientv3.Client
+	prefix  string
+
+	mu        sync.RWMutex
+	pressured map[UniqueID][]UniqueID // datanode ID -> collection IDs
+}
+
+func newBackpressureWatcher(etcdCli *clientv3.Client, metaRootPath string) *backpressureWatcher {
+	return &backpressureWatcher{
+		etcdCli:   etcdCli,
+		prefix:    path.Join(metaRootPath, util.DataNodeBackpressurePrefix) + "/",
+		pressured: make(map[UniqueID][]UniqueID),
+	}
+}
+
+// start loads the pressured collections and watches the changes until ctx done
+func (w *backpressureWatcher) start(ctx context.Context) error {
+	revision, err := w.reload(ctx)
+	if err != nil {
+		return err
+	}
+	go w.watch(ctx, revision)
+	return nil
+}
+
+func (w *backpressureWatcher) reload(ctx context.Context) (int64, error) {
+	resp, err := w.etcdCli.Get(ctx, w.prefix, clientv3.WithPrefix())
+	if err != nil {
+		return 0, err
+	}
+	pressured := make(map[UniqueID][]UniqueID, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		nodeID, collections, err := w.parse(kv.Key, kv.Value)
+		if err != nil {
+			log.Warn("invalid datanode backpressure, ignored", zap.String("key", string(kv.Key)), zap.Error(err))
+			continue
+		}
+		pressured[nodeID] = collections
+	}
+
+	w.mu.Lock()
+	w.pressured = pressured
+	w.mu.Unlock()
+	log.Info("datanode backpressure loaded", zap.Int("num", len(pressured)))
+	return resp.Header.Revision, nil
+}
+
+func (w *backpressureWatcher) watch(ctx context.Context, revision int64) {
+	watchChan := w.etcdCli.Watch(ctx, w.prefix, clientv3.WithPrefix(), clientv3.WithRev(revision+1))
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("datanode backpressure watch loop exit")
+			return
+		case resp, ok := <-watchChan:
+			if !ok {
+				return
+			}
+			if err := resp.Err(); err != nil {
+				log.Warn("watch datanode backpressure failed, reload", zap.Error(err))
+				revision, err = w.reload(ctx)
+				if err != nil {
+					log.Warn("reload datanode backpressure failed", zap.Error(err))
+					return
+				}
+				watchChan = w.etcdCli.Watch(ctx, w.prefix, clientv3.WithPrefix(), clientv3.WithRev(revision+1))
+				continue
+			}
+			for _, event := range resp.Events {
+				w.apply(event)
+			}
+		}
+	}
+}
+
+func (w *backpressureWatcher) apply(event *clientv3.Event) {
+	if event.Type == clientv3.EventTypeDelete {
+		nodeID, err := strconv.ParseInt(strings.TrimPrefix(string(event.Kv.Key), w.prefix), 10, 64)
+		if err != nil {
+			return
+		}
+		w.mu.Lock()
+		delete(w.pressured, nodeID)
+		w.mu.Unlock()
+		log.Info("datanode backpressure relieved", zap.Int64("nodeID", nodeID))
+		return
+	}
+	nodeID, collections, err := w.parse(event.Kv.Key, event.Kv.Value)
+	if err != nil {
+		log.Warn("invalid datanode backpressure, ignored", zap.String("key", string(event.Kv.Key)), zap.Error(err))
+		return
+	}
+	w.mu.Lock()
+	w.pressured[nodeID] = collections
+	w.mu.Unlock()
+	log.Warn("datanode under backpressure", zap.Int64("nodeID", nodeID), zap.Int64s("collections", collections))
+}
+
+func (w *backpressureWatcher) parse(key, value []byte) (UniqueID, []UniqueID, error) {
+	nodeID, err := strconv.ParseInt(strings.TrimPrefix(string(key), w.prefix), 10, 64)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid datanode id in key %s", string(key))
+	}
+	var collections []UniqueID
+	if err := json.Unmarshal(value, &collections); err != nil {
+		return 0, nil, err
+	}
+	return nodeID, collections, nil
+}
+
+// pressuredBy returns the datanode pressured and consuming the collection, false if there is none
+func (w *backpressureWatcher) pressuredBy(collectionID UniqueID) (UniqueID, bool) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	for nodeID, collections := range w.pressured {
+		for _, id := range collections {
+			if id == collectionID {
+				return nodeID, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func (w *backpressureWatcher) empty() bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return len(w.pressured) == 0
+}
+
+// checkDML returns a backpressureError if the collection is consumed by a datanode under memory pressure
+func (w *backpressureWatcher) checkDML(ctx context.Context, database string, collectionName string) error {
+	if w == nil || w.empty() {
+		return nil
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, database, collectionName)
+	if err != nil {
+		return err
+	}
+	if nodeID, ok := w.pressuredBy(collectionID); ok {
+		return &backpressureError{collectionName: collectionName, nodeID: nodeID}
+	}
+	return nil
+}
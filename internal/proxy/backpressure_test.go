@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestBackpressureWatcher_parse(t *testing.T) {
+	w := newBackpressureWatcher(nil, "by-dev/meta")
+
+	nodeID, collections, err := w.parse([]byte(w.prefix+"10"), []byte(`[1,2]`))
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(10), nodeID)
+	assert.Equal(t, []UniqueID{1, 2}, collections)
+
+	_, _, err = w.parse([]byte(w.prefix+"node"), []byte(`[1]`))
+	assert.Error(t, err)
+	_, _, err = w.parse([]byte(w.prefix+"10"), []byte(`invalid`))
+	assert.Error(t, err)
+}
+
+func TestBackpressureWatcher_checkDML(t *testing.T) {
+	cache := newMockCache()
+	cache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		if collectionName == "coll" {
+			return 1, nil
+		}
+		return 2, nil
+	})
+	oldCache := globalMetaCache
+	globalMetaCache = cache
+	defer func() {
+		globalMetaCache = oldCache
+	}()
+
+	var nilWatcher *backpressureWatcher
+	assert.NoError(t, nilWatcher.checkDML(context.Background(), "", "coll"))
+
+	w := newBackpressureWatcher(nil, "by-dev/meta")
+	assert.NoError(t, w.checkDML(context.Background(), "", "coll"))
+
+	w.apply(&clientv3.Event{Type: clientv3.EventTypePut, Kv: &mvccpb.KeyValue{Key: []byte(w.prefix + "10"), Value: []byte(`[1]`)}})
+	err := w.checkDML(context.Background(), "", "coll")
+	assert.Error(t, err)
+	assert.True(t, isRateLimitError(err))
+	assert.True(t, strings.HasPrefix(err.Error(), rateLimitPrefix))
+	assert.NoError(t, w.checkDML(context.Background(), "", "other"))
+
+	w.apply(&clientv3.Event{Type: clientv3.EventTypeDelete, Kv: &mvccpb.KeyValue{Key: []byte(w.prefix + "10")}})
+	assert.NoError(t, w.checkDML(context.Background(), "", "coll"))
+}
@@ -0,0 +1,275 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// collectionLimitsPrefix is the etcd prefix of per-collection limits, the key is
+// {MetaRootPath}/proxy/collection-limits/{collectionID} and the value is a json encoded collectionLimits,
+// all proxies watch the prefix so that the limits could be changed at runtime. The limits are keyed by the
+// collection id, so that they follow the collection when renamed, and are not inherited by another collection
+// created later with the same name or in another database. They are removed once the collection dropped.
+const collectionLimitsPrefix = "proxy/collection-limits"
+
+// globalCollectionLimiter is nil until proxy initialized, the global default limits are used then.
+var globalCollectionLimiter *collectionLimiter
+
+// collectionLimits overrides the global quotas of proxy for a collection, zero value means using the global one.
+// The max insert batch size and the max topk of a collection are its properties instead, set by AlterCollection.
+type collectionLimits struct {
+	// quotas enforced when proxy.quota.enabled is true
+	DMLRowsPerSec       float64 `json:"dmlRowsPerSec"`
+	SearchQPS           float64 `json:"searchQPS"`
+	MaxQueryConcurrency int64   `json:"maxQueryConcurrency"`
+}
+
+// collectionLimiter maintains the per-collection limits configured in etcd
+type collectionLimiter struct {
+	etcdCli *clientv3.Client
+	prefix  string
+
+	mu     sync.RWMutex
+	limits map[UniqueID]*collectionLimits // collection id -> limits
+}
+
+func newCollectionLimiter(etcdCli *clientv3.Client, metaRootPath string) *collectionLimiter {
+	return &collectionLimiter{
+		etcdCli: etcdCli,
+		prefix:  path.Join(metaRootPath, collectionLimitsPrefix) + "/",
+		limits:  make(map[UniqueID]*collectionLimits),
+	}
+}
+
+// start loads all the collection limits and watches the changes until ctx done
+func (l *collectionLimiter) start(ctx context.Context) error {
+	revision, err := l.reload(ctx)
+	if err != nil {
+		return err
+	}
+	go l.watch(ctx, revision)
+	return nil
+}
+
+func (l *collectionLimiter) reload(ctx context.Context) (int64, error) {
+	resp, err := l.etcdCli.Get(ctx, l.prefix, clientv3.WithPrefix())
+	if err != nil {
+		return 0, err
+	}
+	limits := make(map[UniqueID]*collectionLimits, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		collectionID, limit, err := l.parse(kv.Key, kv.Value)
+		if err != nil {
+			log.Warn("invalid collection limits, ignored", zap.String("key", string(kv.Key)), zap.Error(err))
+			continue
+		}
+		limits[collectionID] = limit
+	}
+
+	l.mu.Lock()
+	l.limits = limits
+	l.mu.Unlock()
+	log.Info("collection limits loaded", zap.Int("num", len(limits)))
+	return resp.Header.Revision, nil
+}
+
+func (l *collectionLimiter) watch(ctx context.Context, revision int64) {
+	watchChan := l.etcdCli.Watch(ctx, l.prefix, clientv3.WithPrefix(), clientv3.WithRev(revision+1))
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("collection limits watch loop exit")
+			return
+		case resp, ok := <-watchChan:
+			if !ok {
+				return
+			}
+			if err := resp.Err(); err != nil {
+				log.Warn("watch collection limits failed, reload", zap.Error(err))
+				revision, err = l.reload(ctx)
+				if err != nil {
+					log.Warn("reload collection limits failed", zap.Error(err))
+					return
+				}
+				watchChan = l.etcdCli.Watch(ctx, l.prefix, clientv3.WithPrefix(), clientv3.WithRev(revision+1))
+				continue
+			}
+			for _, event := range resp.Events {
+				switch event.Type {
+				case clientv3.EventTypePut:
+					collectionID, limit, err := l.parse(event.Kv.Key, event.Kv.Value)
+					if err != nil {
+						log.Warn("invalid collection limits, ignored", zap.String("key", string(event.Kv.Key)), zap.Error(err))
+						continue
+					}
+					l.set(collectionID, limit)
+				case clientv3.EventTypeDelete:
+					collectionID, err := l.parseKey(event.Kv.Key)
+					if err != nil {
+						continue
+					}
+					l.remove(collectionID)
+					// the collection is dropped by another proxy or the limits are reset, restart its quotas
+					globalQuotaLimiter.removeCollection(collectionID)
+				}
+			}
+		}
+	}
+}
+
+func (l *collectionLimiter) parseKey(key []byte) (UniqueID, error) {
+	collectionID, err := strconv.ParseInt(strings.TrimPrefix(string(key), l.prefix), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid collection id of key %s", string(key))
+	}
+	return collectionID, nil
+}
+
+func (l *collectionLimiter) parse(key, value []byte) (UniqueID, *collectionLimits, error) {
+	collectionID, err := l.parseKey(key)
+	if err != nil {
+		return 0, nil, err
+	}
+	limit := &collectionLimits{}
+	if err := json.Unmarshal(value, limit); err != nil {
+		return collectionID, nil, err
+	}
+	if limit.DMLRowsPerSec < 0 || limit.SearchQPS < 0 || limit.MaxQueryConcurrency < 0 {
+		return collectionID, nil, fmt.Errorf("negative limit is not allowed")
+	}
+	return collectionID, limit, nil
+}
+
+func (l *collectionLimiter) set(collectionID UniqueID, limit *collectionLimits) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limits[collectionID] = limit
+	log.Info("collection limits updated", zap.Int64("collectionID", collectionID),
+		zap.Float64("dmlRowsPerSec", limit.DMLRowsPerSec), zap.Float64("searchQPS", limit.SearchQPS),
+		zap.Int64("maxQueryConcurrency", limit.MaxQueryConcurrency))
+}
+
+func (l *collectionLimiter) remove(collectionID UniqueID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.limits, collectionID)
+	log.Info("collection limits removed", zap.Int64("collectionID", collectionID))
+}
+
+// drop removes the limits of the dropped collection, the other proxies remove them as they watch the deletion
+func (l *collectionLimiter) drop(ctx context.Context, collectionID UniqueID) {
+	if l == nil {
+		return
+	}
+	l.remove(collectionID)
+	if l.etcdCli == nil {
+		return
+	}
+	if _, err := l.etcdCli.Delete(ctx, l.prefix+strconv.FormatInt(collectionID, 10)); err != nil {
+		log.Warn("remove limits of dropped collection failed", zap.Int64("collectionID", collectionID), zap.Error(err))
+	}
+}
+
+func (l *collectionLimiter) get(collectionID UniqueID) *collectionLimits {
+	if l == nil {
+		return nil
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.limits[collectionID]
+}
+
+// getDMLRowsPerSec returns the max number of rows inserted and deleted per second of the collection, 0 means unlimited
+func (l *collectionLimiter) getDMLRowsPerSec(collectionID UniqueID) float64 {
+	if limit := l.get(collectionID); limit != nil && limit.DMLRowsPerSec > 0 {
+		return limit.DMLRowsPerSec
+	}
+	return Params.ProxyCfg.CollectionDMLRowsPerSec
+}
+
+// getSearchQPS returns the max number of searches per second of the collection, 0 means unlimited
+func (l *collectionLimiter) getSearchQPS(collectionID UniqueID) float64 {
+	if limit := l.get(collectionID); limit != nil && limit.SearchQPS > 0 {
+		return limit.SearchQPS
+	}
+	return Params.ProxyCfg.CollectionSearchQPS
+}
+
+// getMaxQueryConcurrency returns the max number of queries running at the same time of the collection, 0 means unlimited
+func (l *collectionLimiter) getMaxQueryConcurrency(collectionID UniqueID) int64 {
+	if limit := l.get(collectionID); limit != nil && limit.MaxQueryConcurrency > 0 {
+		return limit.MaxQueryConcurrency
+	}
+	return Params.ProxyCfg.CollectionMaxQueryConcurrency
+}
+
+// getMaxInsertBatchSize returns the max number of rows per insert request of the collection, which is set by the
+// max_insert_batch_size property of the collection, 0 means unlimited
+func getMaxInsertBatchSize(schema *schemapb.CollectionSchema) (int64, error) {
+	maxSize, err := typeutil.GetCollectionMaxInsertBatchSize(schema)
+	if err != nil || maxSize > 0 {
+		return maxSize, err
+	}
+	return Params.ProxyCfg.MaxInsertBatchSize, nil
+}
+
+// getMaxTopK returns the max topK of the collection, which is set by the max_topk property of the collection,
+// 0 means unlimited
+func getMaxTopK(schema *schemapb.CollectionSchema) (int64, error) {
+	maxTopK, err := typeutil.GetCollectionMaxTopK(schema)
+	if err != nil || maxTopK > 0 {
+		return maxTopK, err
+	}
+	return Params.ProxyCfg.MaxTopK, nil
+}
+
+func checkInsertBatchSize(schema *schemapb.CollectionSchema, rowNum int64) error {
+	maxSize, err := getMaxInsertBatchSize(schema)
+	if err != nil {
+		return err
+	}
+	if maxSize > 0 && rowNum > maxSize {
+		return fmt.Errorf("number of rows (%d) exceeds the max insert batch size (%d) of collection %s",
+			rowNum, maxSize, schema.GetName())
+	}
+	return nil
+}
+
+func checkTopK(schema *schemapb.CollectionSchema, topK int64) error {
+	maxTopK, err := getMaxTopK(schema)
+	if err != nil {
+		return err
+	}
+	if maxTopK > 0 && topK > maxTopK {
+		return fmt.Errorf("topk (%d) exceeds the max topk (%d) of collection %s", topK, maxTopK, schema.GetName())
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestCollectionLimiter_defaults(t *testing.T) {
+	var limiter *collectionLimiter
+	assert.Equal(t, Params.ProxyCfg.CollectionDMLRowsPerSec, limiter.getDMLRowsPerSec(1))
+	assert.Equal(t, Params.ProxyCfg.CollectionSearchQPS, limiter.getSearchQPS(1))
+	assert.Equal(t, Params.ProxyCfg.CollectionMaxQueryConcurrency, limiter.getMaxQueryConcurrency(1))
+	limiter.drop(context.Background(), 1)
+
+	// collections without the properties use the global limits
+	schema := &schemapb.CollectionSchema{Name: "coll"}
+	assert.NoError(t, checkTopK(schema, Params.ProxyCfg.MaxTopK))
+	assert.Error(t, checkTopK(schema, Params.ProxyCfg.MaxTopK+1))
+	assert.NoError(t, checkInsertBatchSize(schema, Params.ProxyCfg.MaxInsertBatchSize))
+	assert.Error(t, checkInsertBatchSize(schema, Params.ProxyCfg.MaxInsertBatchSize+1))
+}
+
+func TestCollectionLimiter_check(t *testing.T) {
+	limiter := newCollectionLimiter(nil, "/root")
+	limiter.set(1, &collectionLimits{SearchQPS: 10, MaxQueryConcurrency: 2})
+	assert.Equal(t, float64(10), limiter.getSearchQPS(1))
+	assert.Equal(t, int64(2), limiter.getMaxQueryConcurrency(1))
+
+	// other collections use the global limits
+	assert.Equal(t, Params.ProxyCfg.CollectionSearchQPS, limiter.getSearchQPS(2))
+
+	limiter.remove(1)
+	assert.Equal(t, Params.ProxyCfg.CollectionSearchQPS, limiter.getSearchQPS(1))
+
+	// the limits of the dropped collection are gone
+	limiter.set(1, &collectionLimits{SearchQPS: 10})
+	limiter.drop(context.Background(), 1)
+	assert.Nil(t, limiter.get(1))
+}
+
+func TestCollectionLimiter_properties(t *testing.T) {
+	schema := &schemapb.CollectionSchema{Name: "coll"}
+	err := typeutil.SetCollectionProperties(schema, map[string]string{
+		common.CollectionMaxInsertBatchSizeParam: "10",
+		common.CollectionMaxTopKParam:            "100",
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, checkInsertBatchSize(schema, 10))
+	assert.Error(t, checkInsertBatchSize(schema, 11))
+	assert.NoError(t, checkTopK(schema, 100))
+	assert.Error(t, checkTopK(schema, 101))
+
+	// invalid properties fail the requests
+	schema.Properties = []*commonpb.KeyValuePair{
+		{Key: common.CollectionMaxInsertBatchSizeParam, Value: "invalid"},
+		{Key: common.CollectionMaxTopKParam, Value: "-1"},
+	}
+	assert.Error(t, checkInsertBatchSize(schema, 1))
+	assert.Error(t, checkTopK(schema, 1))
+}
+
+func TestCollectionLimiter_parse(t *testing.T) {
+	limiter := newCollectionLimiter(nil, "/root")
+
+	collectionID, limit, err := limiter.parse([]byte(limiter.prefix+"100"), []byte(`{"dmlRowsPerSec": 10}`))
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(100), collectionID)
+	assert.Equal(t, float64(10), limit.DMLRowsPerSec)
+	assert.Equal(t, float64(0), limit.SearchQPS)
+
+	// the limits are keyed by the collection id
+	_, _, err = limiter.parse([]byte(limiter.prefix+"coll"), []byte(`{"dmlRowsPerSec": 10}`))
+	assert.Error(t, err)
+
+	_, _, err = limiter.parse([]byte(limiter.prefix+"100"), []byte(`invalid`))
+	assert.Error(t, err)
+
+	_, _, err = limiter.parse([]byte(limiter.prefix+"100"), []byte(`{"maxQueryConcurrency": -1}`))
+	assert.Error(t, err)
+
+	_, limit, err = limiter.parse([]byte(limiter.prefix+"100"), []byte(`{"searchQPS": 10, "maxQueryConcurrency": 2}`))
+	assert.NoError(t, err)
+	assert.Equal(t, float64(10), limit.SearchQPS)
+	assert.Equal(t, int64(2), limit.MaxQueryConcurrency)
+
+	_, _, err = limiter.parse([]byte(limiter.prefix+"100"), []byte(`{"dmlRowsPerSec": -1}`))
+	assert.Error(t, err)
+}
+
+func TestCollectionLimiter_watch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	Params.Init()
+	etcdCli, err := etcd.GetEtcdClient(&Params.EtcdCfg)
+	assert.NoError(t, err)
+	defer etcdCli.Close()
+
+	rootPath := path.Join(Params.EtcdCfg.MetaRootPath, funcutil.RandomString(8))
+	limiter := newCollectionLimiter(etcdCli, rootPath)
+	defer etcdCli.Delete(ctx, limiter.prefix, clientv3.WithPrefix())
+
+	_, err = etcdCli.Put(ctx, limiter.prefix+"1", `{"dmlRowsPerSec": 5}`)
+	assert.NoError(t, err)
+
+	err = limiter.start(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(5), limiter.getDMLRowsPerSec(1))
+
+	_, err = etcdCli.Put(ctx, limiter.prefix+"2", `{"searchQPS": 7}`)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return limiter.getSearchQPS(2) == 7
+	}, 5*time.Second, 50*time.Millisecond)
+
+	_, err = etcdCli.Delete(ctx, limiter.prefix+"1")
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return limiter.get(1) == nil
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// dropping the collection removes its limits from etcd, so that the other proxies remove them too
+	other := newCollectionLimiter(etcdCli, rootPath)
+	assert.NoError(t, other.start(ctx))
+	assert.Equal(t, float64(7), other.getSearchQPS(2))
+	limiter.drop(ctx, 2)
+	assert.Nil(t, limiter.get(2))
+	assert.Eventually(t, func() bool {
+		return other.get(2) == nil
+	}, 5*time.Second, 50*time.Millisecond)
+}
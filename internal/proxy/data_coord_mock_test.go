@@ -131,7 +131,12 @@ func (coord *DataCoordMock) GetSegmentInfoChannel(ctx context.Context) (*milvusp
 }
 
 func (coord *DataCoordMock) GetCollectionStatistics(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
-	panic("implement me")
+	return &datapb.GetCollectionStatisticsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Stats: []*commonpb.KeyValuePair{{Key: "row_count", Value: "0"}},
+	}, nil
 }
 
 func (coord *DataCoordMock) GetPartitionStatistics(ctx context.Context, req *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error) {
@@ -221,6 +226,13 @@ func (coord *DataCoordMock) UpdateSegmentStatistics(ctx context.Context, req *da
 	}, nil
 }
 
+func (coord *DataCoordMock) RefreshCollection(ctx context.Context, req *datapb.RefreshCollectionRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 func NewDataCoordMock() *DataCoordMock {
 	return &DataCoordMock{
 		nodeID:            typeutil.UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt()),
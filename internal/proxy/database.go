@@ -0,0 +1,214 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/rootcoord/model"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+// globalDatabaseCache is nil until proxy initialized, only the default database is available then.
+var globalDatabaseCache *databaseCache
+
+// databaseCache maintains the databases and their quotas stored by root coord, the key is
+// {MetaRootPath}/root-coord/database/{name} and the value is a json encoded model.Database.
+type databaseCache struct {
+	etcdCli *clientv3.Client
+	prefix  string
+
+	mu        sync.RWMutex
+	databases map[string]model.DatabaseQuotas // database -> quotas
+}
+
+func newDatabaseCache(etcdCli *clientv3.Client, metaRootPath string) *databaseCache {
+	return &databaseCache{
+		etcdCli:   etcdCli,
+		prefix:    path.Join(metaRootPath, util.DatabasePrefix) + "/",
+		databases: make(map[string]model.DatabaseQuotas),
+	}
+}
+
+// start loads the databases and watches the changes until ctx done
+func (c *databaseCache) start(ctx context.Context) error {
+	revision, err := c.reload(ctx)
+	if err != nil {
+		return err
+	}
+	go c.watch(ctx, revision)
+	return nil
+}
+
+func (c *databaseCache) reload(ctx context.Context) (int64, error) {
+	resp, err := c.etcdCli.Get(ctx, c.prefix, clientv3.WithPrefix())
+	if err != nil {
+		return 0, err
+	}
+	databases := make(map[string]model.DatabaseQuotas, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		db, err := c.parse(kv.Value)
+		if err != nil {
+			log.Warn("invalid database, ignored", zap.String("key", string(kv.Key)), zap.Error(err))
+			continue
+		}
+		databases[db.Name] = db.Quotas
+	}
+
+	c.mu.Lock()
+	c.databases = databases
+	c.mu.Unlock()
+	log.Info("databases loaded", zap.Int("num", len(databases)))
+	return resp.Header.Revision, nil
+}
+
+func (c *databaseCache) parse(value []byte) (*model.Database, error) {
+	db := &model.Database{}
+	if err := json.Unmarshal(value, db); err != nil {
+		return nil, err
+	}
+	if db.Name == "" {
+		return nil, fmt.Errorf("name of database is empty")
+	}
+	return db, nil
+}
+
+// watch reloads all of them on any change, as the databases are few and rarely changed
+func (c *databaseCache) watch(ctx context.Context, revision int64) {
+	watchChan := c.etcdCli.Watch(ctx, c.prefix, clientv3.WithPrefix(), clientv3.WithRev(revision+1))
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("database cache watch loop exit")
+			return
+		case resp, ok := <-watchChan:
+			if !ok {
+				return
+			}
+			if err := resp.Err(); err != nil {
+				log.Warn("watch databases failed, reload", zap.Error(err))
+				revision, err = c.reload(ctx)
+				if err != nil {
+					log.Warn("reload databases failed", zap.Error(err))
+					return
+				}
+				watchChan = c.etcdCli.Watch(ctx, c.prefix, clientv3.WithPrefix(), clientv3.WithRev(revision+1))
+				continue
+			}
+			if _, err := c.reload(ctx); err != nil {
+				log.Warn("reload databases failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// exists returns whether the database is available, the default database always exists
+func (c *databaseCache) exists(database string) bool {
+	if database == common.DefaultDatabase {
+		return true
+	}
+	if c == nil {
+		return false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	_, ok := c.databases[database]
+	return ok
+}
+
+// getQuotas returns the quotas of the database, the default database is unlimited
+func (c *databaseCache) getQuotas(database string) model.DatabaseQuotas {
+	if c == nil || database == common.DefaultDatabase {
+		return model.DatabaseQuotas{}
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.databases[database]
+}
+
+// requestDatabase returns the database of the request, the db_name of the request takes precedence over
+// the dbname header, which is set by the clients using a database. The default database if neither is set.
+func requestDatabase(ctx context.Context, req interface{}) string {
+	if r, ok := req.(interface{ GetDbName() string }); ok && r.GetDbName() != "" {
+		return r.GetDbName()
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return common.DefaultDatabase
+	}
+	if dbName := md[strings.ToLower(util.HeaderDBName)]; len(dbName) > 0 && dbName[0] != "" {
+		return dbName[0]
+	}
+	return common.DefaultDatabase
+}
+
+// setRequestDatabase sets the database to the db_name of the request, so that the collections of the request are
+// looked up in the database by all the components
+func setRequestDatabase(database string, req interface{}) {
+	v := reflect.ValueOf(req)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+	if f := v.Elem().FieldByName("DbName"); f.IsValid() && f.Kind() == reflect.String && f.CanSet() {
+		f.SetString(database)
+	}
+}
+
+// databaseRPCs are the requests managing the databases, the database of which is the one to manage
+var databaseRPCs = map[string]struct{}{
+	"CreateDatabase": {},
+	"DropDatabase":   {},
+	"AlterDatabase":  {},
+	"ListDatabases":  {},
+}
+
+// DatabaseInterceptor returns a unary server interceptor which sets the database of the request to its db_name, from
+// the dbname header if it's not set, so the collections are scoped in the database. It must be chained before the
+// authorization so that the grants are checked per database. The requests from Milvus members are not changed.
+func DatabaseInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, "/milvus.proto.milvus.MilvusService/") {
+			return handler(ctx, req)
+		}
+		if _, ok := databaseRPCs[path.Base(info.FullMethod)]; ok {
+			return handler(ctx, req)
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		if validSourceID(ctx, md[strings.ToLower(util.HeaderSourceID)]) {
+			return handler(ctx, req)
+		}
+		database := requestDatabase(ctx, req)
+		if !globalDatabaseCache.exists(database) {
+			return nil, fmt.Errorf("database %s not found", database)
+		}
+		setRequestDatabase(database, req)
+		return handler(ctx, req)
+	}
+}
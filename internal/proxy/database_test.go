@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/rootcoord/model"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+func TestDatabaseCache(t *testing.T) {
+	c := newDatabaseCache(nil, "/root")
+	db, err := c.parse([]byte(`{"name":"db1","quotas":{"search_qps":10}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, &model.Database{Name: "db1", Quotas: model.DatabaseQuotas{SearchQPS: 10}}, db)
+	_, err = c.parse([]byte(`{"quotas":{}}`))
+	assert.Error(t, err)
+	_, err = c.parse([]byte(`invalid`))
+	assert.Error(t, err)
+
+	c.databases[db.Name] = db.Quotas
+	assert.True(t, c.exists(common.DefaultDatabase))
+	assert.True(t, c.exists("db1"))
+	assert.False(t, c.exists("db2"))
+	assert.Equal(t, float64(10), c.getQuotas("db1").SearchQPS)
+	assert.Equal(t, model.DatabaseQuotas{}, c.getQuotas(common.DefaultDatabase))
+
+	var nilCache *databaseCache
+	assert.True(t, nilCache.exists(common.DefaultDatabase))
+	assert.False(t, nilCache.exists("db1"))
+	assert.Equal(t, model.DatabaseQuotas{}, nilCache.getQuotas("db1"))
+}
+
+func TestRequestDatabase(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderDBName, "db1"))
+	assert.Equal(t, "db1", requestDatabase(ctx, &milvuspb.SearchRequest{}))
+	assert.Equal(t, "db2", requestDatabase(ctx, &milvuspb.SearchRequest{DbName: "db2"}))
+	assert.Equal(t, common.DefaultDatabase, requestDatabase(context.Background(), &milvuspb.SearchRequest{}))
+	assert.Equal(t, common.DefaultDatabase, requestDatabase(context.Background(), nil))
+}
+
+func TestSetRequestDatabase(t *testing.T) {
+	search := &milvuspb.SearchRequest{CollectionName: "coll"}
+	setRequestDatabase("db1", search)
+	assert.Equal(t, "db1", search.DbName)
+	assert.Equal(t, "coll", search.CollectionName)
+
+	// the requests without db_name are not changed
+	setRequestDatabase("db1", &milvuspb.ListDatabasesRequest{})
+	setRequestDatabase("db1", nil)
+	setRequestDatabase("db1", "request")
+}
+
+func TestDatabaseInterceptor(t *testing.T) {
+	defer func(cache *databaseCache) {
+		globalDatabaseCache = cache
+	}(globalDatabaseCache)
+	globalDatabaseCache = newDatabaseCache(nil, "/root")
+	globalDatabaseCache.databases["db1"] = model.DatabaseQuotas{}
+
+	interceptor := DatabaseInterceptor()
+	var database string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		database = req.(interface{ GetDbName() string }).GetDbName()
+		return "ok", nil
+	}
+	searchInfo := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Search"}
+	dbContext := func(database string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderDBName, database))
+	}
+
+	resp, err := interceptor(dbContext("db1"), &milvuspb.SearchRequest{CollectionName: "coll"}, searchInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+	assert.Equal(t, "db1", database)
+
+	_, err = interceptor(context.Background(), &milvuspb.SearchRequest{CollectionName: "coll"}, searchInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, common.DefaultDatabase, database)
+
+	// the db_name of the request takes precedence over the header
+	_, err = interceptor(dbContext("db2"), &milvuspb.SearchRequest{DbName: "db1", CollectionName: "coll"}, searchInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "db1", database)
+
+	_, err = interceptor(dbContext("db2"), &milvuspb.SearchRequest{CollectionName: "coll"}, searchInfo, handler)
+	assert.Error(t, err)
+
+	// the database to create is not required to exist
+	createInfo := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/CreateDatabase"}
+	_, err = interceptor(context.Background(), &milvuspb.CreateDatabaseRequest{DbName: "db2"}, createInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "db2", database)
+
+	// the other services are not scoped
+	otherInfo := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.proxy.Proxy/GetDdChannel"}
+	_, err = interceptor(dbContext("db2"), &milvuspb.SearchRequest{CollectionName: "coll"}, otherInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "", database)
+}
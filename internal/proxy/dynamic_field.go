@@ -0,0 +1,353 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	ant_ast "github.com/antonmedv/expr/ast"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// isDynamicField returns whether the field is the dynamic field
+func isDynamicField(field *schemapb.FieldSchema) bool {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(common.DynamicFieldParam, field.GetTypeParams())
+	if err != nil {
+		return false
+	}
+	isDynamic, err := strconv.ParseBool(value)
+	return err == nil && isDynamic
+}
+
+// getDynamicField returns the dynamic field of the collection, nil if none
+func getDynamicField(schema *schemapb.CollectionSchema) *schemapb.FieldSchema {
+	for _, field := range schema.GetFields() {
+		if isDynamicField(field) {
+			return field
+		}
+	}
+	return nil
+}
+
+// validateDynamicField checks the dynamic field of the collection to create
+func validateDynamicField(schema *schemapb.CollectionSchema) error {
+	var dynamicField *schemapb.FieldSchema
+	for _, field := range schema.GetFields() {
+		if !isDynamicField(field) {
+			continue
+		}
+		if dynamicField != nil {
+			return fmt.Errorf("there are more than one dynamic field, field name = %s, %s", dynamicField.GetName(), field.GetName())
+		}
+		dynamicField = field
+	}
+	if dynamicField == nil {
+		return nil
+	}
+
+	if dynamicField.GetDataType() != schemapb.DataType_VarChar {
+		return fmt.Errorf("the data type of the dynamic field %s should be VarChar", dynamicField.GetName())
+	}
+	if dynamicField.GetIsPrimaryKey() || typeutil.IsPartitionKeyField(dynamicField) {
+		return fmt.Errorf("the dynamic field %s couldn't be the primary key or the partition key", dynamicField.GetName())
+	}
+	return nil
+}
+
+// addHiddenDynamicField appends the hidden dynamic field to the schema of the collection to create if the collection
+// enables it and declares no dynamic field itself
+func addHiddenDynamicField(schema *schemapb.CollectionSchema) error {
+	enabled, err := typeutil.IsDynamicFieldEnabled(schema)
+	if err != nil || !enabled {
+		return err
+	}
+	if getDynamicField(schema) != nil {
+		return nil
+	}
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		Name:        common.MetaFieldName,
+		Description: "the keys undeclared in the schema",
+		DataType:    schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: common.DynamicFieldParam, Value: "true"},
+			{Key: maxVarCharLengthKey, Value: strconv.Itoa(defaultMaxVarCharLength)},
+		},
+	})
+	return nil
+}
+
+// isHiddenDynamicField returns whether the field is the dynamic field added by addHiddenDynamicField, which is not
+// described to the users
+func isHiddenDynamicField(field *schemapb.FieldSchema) bool {
+	return field.GetName() == common.MetaFieldName && isDynamicField(field)
+}
+
+// patchDynamicKeys replaces the identifiers undeclared in the schema with the keys of the dynamic field, e.g. color
+// with $meta["color"], so that they are filtered as the paths of it. It runs after the placeholders are patched, which
+// the walker of ant doesn't know, and doesn't touch the values in arrays.
+func (pc *parserContext) patchDynamicKeys(node *ant_ast.Node) {
+	dynamicField := pc.schema.GetDynamicField()
+	if dynamicField == nil {
+		return
+	}
+	var patch func(node *ant_ast.Node)
+	patch = func(node *ant_ast.Node) {
+		switch n := (*node).(type) {
+		case *ant_ast.IdentifierNode:
+			if parseBoolNode(node) != nil {
+				return
+			}
+			if _, err := pc.schema.GetFieldFromName(n.Value); err == nil {
+				return
+			}
+			ant_ast.Patch(node, &ant_ast.IndexNode{
+				Node:  &ant_ast.IdentifierNode{Value: dynamicField.GetName()},
+				Index: &ant_ast.StringNode{Value: n.Value},
+			})
+		case *ant_ast.UnaryNode:
+			patch(&n.Node)
+		case *ant_ast.BinaryNode:
+			patch(&n.Left)
+			patch(&n.Right)
+		case *ant_ast.IndexNode:
+			patch(&n.Node)
+		case *ant_ast.FunctionNode:
+			for i := range n.Arguments {
+				patch(&n.Arguments[i])
+			}
+		}
+	}
+	patch(node)
+}
+
+// parseDynamicRow returns the keys of the JSON object stored in the dynamic field, an empty string is an empty object
+func parseDynamicRow(row string) (map[string]json.RawMessage, error) {
+	obj := make(map[string]json.RawMessage)
+	if row == "" {
+		return obj, nil
+	}
+	if err := json.Unmarshal([]byte(row), &obj); err != nil {
+		return nil, fmt.Errorf("the dynamic field should be a JSON object, error = %w", err)
+	}
+	return obj, nil
+}
+
+// fieldDataValue returns the value of the i-th row of the column
+func fieldDataValue(fieldData *schemapb.FieldData, i int) (interface{}, error) {
+	switch field := fieldData.GetField().(type) {
+	case *schemapb.FieldData_Scalars:
+		switch data := field.Scalars.GetData().(type) {
+		case *schemapb.ScalarField_BoolData:
+			return data.BoolData.GetData()[i], nil
+		case *schemapb.ScalarField_IntData:
+			return data.IntData.GetData()[i], nil
+		case *schemapb.ScalarField_LongData:
+			return data.LongData.GetData()[i], nil
+		case *schemapb.ScalarField_FloatData:
+			return data.FloatData.GetData()[i], nil
+		case *schemapb.ScalarField_DoubleData:
+			return data.DoubleData.GetData()[i], nil
+		case *schemapb.ScalarField_StringData:
+			return data.StringData.GetData()[i], nil
+		}
+	case *schemapb.FieldData_Vectors:
+		if data, ok := field.Vectors.GetData().(*schemapb.VectorField_FloatVector); ok {
+			dim := int(field.Vectors.GetDim())
+			return data.FloatVector.GetData()[i*dim : (i+1)*dim], nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported data of the undeclared field %s", fieldData.GetFieldName())
+}
+
+// fillDynamicField packs the columns undeclared in the schema into the dynamic field, the keys are merged into the
+// objects of the dynamic field if it's provided as well. The columns are returned unchanged if the collection has no
+// dynamic field.
+func fillDynamicField(schema *schemapb.CollectionSchema, fieldsData []*schemapb.FieldData, numRows uint64) ([]*schemapb.FieldData, error) {
+	dynamicField := getDynamicField(schema)
+	if dynamicField == nil {
+		return fieldsData, nil
+	}
+	declared := make(map[string]struct{})
+	for _, field := range schema.GetFields() {
+		declared[field.GetName()] = struct{}{}
+	}
+
+	columns := make([]*schemapb.FieldData, 0, len(fieldsData))
+	undeclared := make([]*schemapb.FieldData, 0)
+	var dynamicColumn *schemapb.FieldData
+	for _, fieldData := range fieldsData {
+		if _, ok := declared[fieldData.GetFieldName()]; !ok {
+			n, err := funcutil.GetNumRowOfFieldData(fieldData)
+			if err != nil {
+				return nil, err
+			}
+			if n != numRows {
+				return nil, fmt.Errorf("the num_rows(%d) of field %s is not equal to passed NumRows(%d)", n, fieldData.GetFieldName(), numRows)
+			}
+			undeclared = append(undeclared, fieldData)
+			continue
+		}
+		if fieldData.GetFieldName() == dynamicField.GetName() {
+			dynamicColumn = fieldData
+		}
+		columns = append(columns, fieldData)
+	}
+	if dynamicColumn != nil && len(undeclared) == 0 {
+		return fieldsData, nil
+	}
+
+	var rows []string
+	if dynamicColumn != nil {
+		rows = dynamicColumn.GetScalars().GetStringData().GetData()
+		if uint64(len(rows)) != numRows {
+			return nil, fmt.Errorf("the num_rows(%d) of field %s is not equal to passed NumRows(%d)", len(rows), dynamicField.GetName(), numRows)
+		}
+	} else {
+		rows = make([]string, numRows)
+		dynamicColumn = &schemapb.FieldData{
+			Type:      schemapb.DataType_VarChar,
+			FieldName: dynamicField.GetName(),
+			FieldId:   dynamicField.GetFieldID(),
+		}
+		columns = append(columns, dynamicColumn)
+	}
+
+	data := make([]string, 0, numRows)
+	for i, row := range rows {
+		obj, err := parseDynamicRow(row)
+		if err != nil {
+			return nil, err
+		}
+		for _, fieldData := range undeclared {
+			key := fieldData.GetFieldName()
+			if _, ok := obj[key]; ok {
+				return nil, fmt.Errorf("the key %s of the dynamic field %s is duplicated", key, dynamicField.GetName())
+			}
+			value, err := fieldDataValue(fieldData, i)
+			if err != nil {
+				return nil, err
+			}
+			obj[key], err = json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, string(b))
+	}
+	dynamicColumn.Field = &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+		Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}},
+	}}
+	return columns, nil
+}
+
+// translateDynamicOutputFields replaces the output fields undeclared in the schema with the dynamic field, they are
+// returned as the keys to extract from it. keepDynamicField is false if the dynamic field is not required itself.
+func translateDynamicOutputFields(schema *schemapb.CollectionSchema, outputFields []string) (fields []string, keys []string, keepDynamicField bool) {
+	dynamicField := getDynamicField(schema)
+	if dynamicField == nil {
+		return outputFields, nil, true
+	}
+	declared := make(map[string]struct{})
+	for _, field := range schema.GetFields() {
+		declared[field.GetName()] = struct{}{}
+	}
+
+	fields = make([]string, 0, len(outputFields))
+	for _, name := range outputFields {
+		if _, ok := declared[name]; ok {
+			if name == dynamicField.GetName() {
+				keepDynamicField = true
+			}
+			fields = append(fields, name)
+			continue
+		}
+		keys = append(keys, name)
+	}
+	if len(keys) > 0 && !keepDynamicField {
+		fields = append(fields, dynamicField.GetName())
+	}
+	if len(keys) == 0 {
+		keepDynamicField = true
+	}
+	return fields, keys, keepDynamicField
+}
+
+// outputDynamicFields extracts the keys from the dynamic field into the VarChar columns named after them, each value
+// is the JSON of the key, null if the entity doesn't have the key. The dynamic field is removed unless keepDynamicField.
+func outputDynamicFields(schema *schemapb.CollectionSchema, fieldsData []*schemapb.FieldData, keys []string, keepDynamicField bool) ([]*schemapb.FieldData, error) {
+	dynamicField := getDynamicField(schema)
+	if dynamicField == nil || len(keys) == 0 {
+		return fieldsData, nil
+	}
+	index := -1
+	for i, fieldData := range fieldsData {
+		if fieldData.GetFieldId() == dynamicField.GetFieldID() {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return fieldsData, nil
+	}
+
+	rows := fieldsData[index].GetScalars().GetStringData().GetData()
+	values := make([][]string, len(keys))
+	for j := range keys {
+		values[j] = make([]string, 0, len(rows))
+	}
+	for _, row := range rows {
+		obj, err := parseDynamicRow(row)
+		if err != nil {
+			return nil, err
+		}
+		for j, key := range keys {
+			value, ok := obj[key]
+			if !ok {
+				value = json.RawMessage("null")
+			}
+			values[j] = append(values[j], string(value))
+		}
+	}
+
+	result := make([]*schemapb.FieldData, 0, len(fieldsData)+len(keys))
+	for i, fieldData := range fieldsData {
+		if i != index || keepDynamicField {
+			result = append(result, fieldData)
+		}
+	}
+	for j, key := range keys {
+		result = append(result, &schemapb.FieldData{
+			Type:      schemapb.DataType_VarChar,
+			FieldName: key,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: values[j]}},
+			}},
+		})
+	}
+	return result, nil
+}
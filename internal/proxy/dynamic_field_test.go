@@ -0,0 +1,248 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func newDynamicFieldSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "extra", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.DynamicFieldParam, Value: "true"},
+				{Key: "max_length", Value: "256"},
+			}},
+			{FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+}
+
+func newStringFieldData(name string, data ...string) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:      schemapb.DataType_VarChar,
+		FieldName: name,
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}},
+		}},
+	}
+}
+
+func TestValidateDynamicField(t *testing.T) {
+	assert.NoError(t, validateDynamicField(newDynamicFieldSchema()))
+	assert.NoError(t, validateDynamicField(&schemapb.CollectionSchema{}))
+
+	// more than one dynamic field
+	schema := newDynamicFieldSchema()
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 103, Name: "extra2", DataType: schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.DynamicFieldParam, Value: "true"}}})
+	assert.Error(t, validateDynamicField(schema))
+
+	// not VarChar
+	schema = newDynamicFieldSchema()
+	schema.Fields[1].DataType = schemapb.DataType_Int64
+	assert.Error(t, validateDynamicField(schema))
+
+	// the partition key
+	schema = newDynamicFieldSchema()
+	schema.Fields[1].TypeParams = append(schema.Fields[1].TypeParams, &commonpb.KeyValuePair{Key: common.PartitionKeyParam, Value: "true"})
+	assert.Error(t, validateDynamicField(schema))
+}
+
+func TestAddHiddenDynamicField(t *testing.T) {
+	newSchema := func(enabled string) *schemapb.CollectionSchema {
+		return &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64,
+					TypeParams: []*commonpb.KeyValuePair{{Key: common.EnableDynamicFieldParam, Value: enabled}}},
+			},
+		}
+	}
+
+	schema := newSchema("false")
+	assert.NoError(t, addHiddenDynamicField(schema))
+	assert.Len(t, schema.Fields, 1)
+
+	schema = newSchema("true")
+	assert.NoError(t, addHiddenDynamicField(schema))
+	assert.Len(t, schema.Fields, 2)
+	assert.True(t, isHiddenDynamicField(schema.Fields[1]))
+	assert.NoError(t, validateDynamicField(schema))
+	assert.NoError(t, validateMaxLengthPerRow("coll", schema.Fields[1]))
+
+	// the declared dynamic field is used
+	schema = newDynamicFieldSchema()
+	schema.Fields[0].TypeParams = []*commonpb.KeyValuePair{{Key: common.EnableDynamicFieldParam, Value: "true"}}
+	assert.NoError(t, addHiddenDynamicField(schema))
+	assert.Len(t, schema.Fields, 3)
+	assert.False(t, isHiddenDynamicField(schema.Fields[1]))
+
+	assert.Error(t, addHiddenDynamicField(newSchema("yes")))
+}
+
+func TestCreateExprPlan_DynamicKeys(t *testing.T) {
+	schema := newDynamicFieldSchema()
+
+	plan, err := createExprPlan(schema, `pk > 1 && color == "red" && not exists(size["w"])`)
+	assert.NoError(t, err)
+	and := plan.GetPredicates().GetBinaryExpr()
+	p, err := typeutil.NewJSONPathPredicate(and.GetLeft().GetBinaryExpr().GetRight().GetJsonPathExpr())
+	assert.NoError(t, err)
+	assert.Equal(t, &typeutil.JSONPathPredicate{Path: []string{"color"}, Op: "==", Value: "red"}, p)
+	assert.Equal(t, int64(101), and.GetRight().GetJsonPathExpr().GetColumnInfo().GetFieldId())
+	p, err = typeutil.NewJSONPathPredicate(and.GetRight().GetJsonPathExpr())
+	assert.NoError(t, err)
+	assert.Equal(t, &typeutil.JSONPathPredicate{Path: []string{"size", "w"}, Op: typeutil.JSONPathExists, Not: true}, p)
+
+	// the booleans are not keys
+	plan, err = createExprPlan(schema, `pk > 1 && color == true`)
+	assert.NoError(t, err)
+	p, err = typeutil.NewJSONPathPredicate(plan.GetPredicates().GetBinaryExpr().GetRight().GetJsonPathExpr())
+	assert.NoError(t, err)
+	assert.Equal(t, true, p.Value)
+
+	// the keys are filtered by themselves, in searches as well
+	plan, err = createExprPlan(schema, `color == "red"`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), plan.GetPredicates().GetJsonPathExpr().GetColumnInfo().GetFieldId())
+	plan, err = createQueryPlan(schema, `color == "red" || size["w"] > 1`, "vec", &planpb.QueryInfo{Topk: 10})
+	assert.NoError(t, err)
+	assert.NotNil(t, plan.GetVectorAnns().GetPredicates().GetBinaryExpr().GetLeft().GetJsonPathExpr())
+
+	// unknown fields without the dynamic field
+	schema.Fields = schema.Fields[:1]
+	_, err = createExprPlan(schema, `color == "red"`)
+	assert.Error(t, err)
+}
+
+func TestFillDynamicField(t *testing.T) {
+	schema := newDynamicFieldSchema()
+	pk := &schemapb.FieldData{
+		Type:      schemapb.DataType_Int64,
+		FieldName: "pk",
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}},
+		}},
+	}
+	vec := &schemapb.FieldData{
+		Type:      schemapb.DataType_FloatVector,
+		FieldName: "vec",
+		Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+			Dim:  2,
+			Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: []float32{1, 2, 3, 4}}},
+		}},
+	}
+	age := &schemapb.FieldData{
+		FieldName: "age",
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{10, 20}}},
+		}},
+	}
+
+	// the dynamic field is created from the undeclared fields
+	fieldsData, err := fillDynamicField(schema, []*schemapb.FieldData{pk, vec, age}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(fieldsData))
+	assert.Equal(t, "extra", fieldsData[2].GetFieldName())
+	assert.Equal(t, []string{`{"age":10}`, `{"age":20}`}, fieldsData[2].GetScalars().GetStringData().GetData())
+
+	// the undeclared fields are merged into the dynamic field
+	fieldsData, err = fillDynamicField(schema, []*schemapb.FieldData{pk, vec, age,
+		newStringFieldData("extra", `{"color":"red"}`, ""), newStringFieldData("size", "L", "M")}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(fieldsData))
+	assert.Equal(t, []string{`{"age":10,"color":"red","size":"L"}`, `{"age":20,"size":"M"}`},
+		fieldsData[2].GetScalars().GetStringData().GetData())
+
+	// the provided dynamic field is kept as it is
+	extra := newStringFieldData("extra", `{"color":"red"}`, `{}`)
+	fieldsData, err = fillDynamicField(schema, []*schemapb.FieldData{pk, vec, extra}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []*schemapb.FieldData{pk, vec, extra}, fieldsData)
+
+	// duplicated key
+	_, err = fillDynamicField(schema, []*schemapb.FieldData{pk, vec, newStringFieldData("extra", `{"age":1}`, `{}`), age}, 2)
+	assert.Error(t, err)
+
+	// not an object
+	_, err = fillDynamicField(schema, []*schemapb.FieldData{pk, vec, newStringFieldData("extra", `[]`, `{}`), age}, 2)
+	assert.Error(t, err)
+
+	// the rows are not aligned
+	_, err = fillDynamicField(schema, []*schemapb.FieldData{pk, vec, newStringFieldData("size", "L")}, 2)
+	assert.Error(t, err)
+
+	// no dynamic field
+	schema.Fields = schema.Fields[:1]
+	fieldsData, err = fillDynamicField(schema, []*schemapb.FieldData{pk, age}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []*schemapb.FieldData{pk, age}, fieldsData)
+}
+
+func TestOutputDynamicFields(t *testing.T) {
+	schema := newDynamicFieldSchema()
+
+	fields, keys, keep := translateDynamicOutputFields(schema, []string{"pk", "color", "size"})
+	assert.Equal(t, []string{"pk", "extra"}, fields)
+	assert.Equal(t, []string{"color", "size"}, keys)
+	assert.False(t, keep)
+
+	fields, keys, keep = translateDynamicOutputFields(schema, []string{"color", "extra"})
+	assert.Equal(t, []string{"extra"}, fields)
+	assert.Equal(t, []string{"color"}, keys)
+	assert.True(t, keep)
+
+	fields, keys, keep = translateDynamicOutputFields(schema, []string{"pk"})
+	assert.Equal(t, []string{"pk"}, fields)
+	assert.Empty(t, keys)
+	assert.True(t, keep)
+
+	extra := newStringFieldData("extra", `{"color":"red","size":{"w":1}}`, `{}`)
+	extra.FieldId = 101
+	pk := &schemapb.FieldData{FieldId: 100, FieldName: "pk"}
+	fieldsData, err := outputDynamicFields(schema, []*schemapb.FieldData{pk, extra}, []string{"color", "size"}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(fieldsData))
+	assert.Equal(t, pk, fieldsData[0])
+	assert.Equal(t, "color", fieldsData[1].GetFieldName())
+	assert.Equal(t, []string{`"red"`, "null"}, fieldsData[1].GetScalars().GetStringData().GetData())
+	assert.Equal(t, "size", fieldsData[2].GetFieldName())
+	assert.Equal(t, []string{`{"w":1}`, "null"}, fieldsData[2].GetScalars().GetStringData().GetData())
+
+	fieldsData, err = outputDynamicFields(schema, []*schemapb.FieldData{pk, extra}, []string{"color"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(fieldsData))
+	assert.Equal(t, extra, fieldsData[1])
+
+	_, err = outputDynamicFields(schema, []*schemapb.FieldData{newStringFieldData("extra", "1")}, []string{"color"}, true)
+	assert.NoError(t, err)
+	bad := newStringFieldData("extra", "1")
+	bad.FieldId = 101
+	_, err = outputDynamicFields(schema, []*schemapb.FieldData{bad}, []string{"color"}, true)
+	assert.Error(t, err)
+}
@@ -80,6 +80,13 @@ func ErrUnauthenticated() error {
 	return fmt.Errorf("unauthenticated: invalid credential")
 }
 
+func ErrPermissionDenied(username string, operation string, collection string) error {
+	if collection == "" {
+		return fmt.Errorf("permission denied: user %q is not allowed to %s", username, operation)
+	}
+	return fmt.Errorf("permission denied: user %q is not allowed to %s on collection %s", username, operation, collection)
+}
+
 func ErrProxyNotReady() error {
 	return fmt.Errorf("internal: Milvus Proxy is not ready yet. please wait")
 }
@@ -0,0 +1,182 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// explainQueryPlan parses the search or query into plan, and estimates its cost by the segments loaded in query nodes
+func explainQueryPlan(ctx context.Context, queryCoord types.QueryCoord, req *milvuspb.ExplainRequest) (*milvuspb.ExplainResponse, error) {
+	if err := validateCollectionName(req.GetCollectionName()); err != nil {
+		return nil, err
+	}
+	database := requestDatabase(ctx, req)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, database, req.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, database, req.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+
+	partitionIDs := make(map[UniqueID]struct{})
+	if len(req.GetPartitionNames()) > 0 {
+		partitionsMap, err := globalMetaCache.GetPartitions(ctx, database, req.GetCollectionName())
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range req.GetPartitionNames() {
+			partitionID, ok := partitionsMap[name]
+			if !ok {
+				return nil, fmt.Errorf("partition name %s not found", name)
+			}
+			partitionIDs[partitionID] = struct{}{}
+		}
+	}
+
+	explanation := &milvuspb.ExplainResponse{
+		CollectionID: collectionID,
+		IsSearch:     req.GetAnnsField() != "",
+	}
+	var plan *planpb.PlanNode
+	if explanation.IsSearch {
+		if req.GetTopk() <= 0 {
+			return nil, fmt.Errorf("invalid topk %d", req.GetTopk())
+		}
+		plan, err = createQueryPlan(schema, req.GetExpr(), req.GetAnnsField(), &planpb.QueryInfo{
+			Topk:         req.GetTopk(),
+			SearchParams: req.GetSearchParams(),
+		})
+	} else {
+		if req.GetExpr() == "" {
+			return nil, errors.New("query expression is empty")
+		}
+		plan, err = createExprPlan(schema, req.GetExpr())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create query plan: %v", err)
+	}
+	explanation.Plan = proto.MarshalTextString(plan)
+
+	resp, err := queryCoord.GetSegmentInfo(ctx, &querypb.GetSegmentInfoRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_SegmentInfo,
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+
+	explanation.Segments = explainSegments(resp.GetInfos(), partitionIDs, plan)
+	explanation.EstimatedCost = estimateCost(explanation.Segments, req.GetNq())
+	return explanation, nil
+}
+
+// explainSegments returns the segments of the partitions scanned by the plan, all partitions are scanned if empty.
+// A segment loaded by several replicas is scanned only once, by one of its nodes.
+func explainSegments(infos []*querypb.SegmentInfo, partitionIDs map[UniqueID]struct{}, plan *planpb.PlanNode) []*milvuspb.ExplainedSegment {
+	anns := plan.GetVectorAnns()
+	segments := make(map[UniqueID]*milvuspb.ExplainedSegment)
+	for _, info := range infos {
+		if _, ok := partitionIDs[info.GetPartitionID()]; len(partitionIDs) > 0 && !ok {
+			continue
+		}
+		nodeIDs := info.GetNodeIds()
+		if len(nodeIDs) == 0 {
+			nodeIDs = []UniqueID{info.GetNodeID()}
+		}
+		if segment, ok := segments[info.GetSegmentID()]; ok {
+			segment.NodeIds = append(segment.NodeIds, nodeIDs...)
+			continue
+		}
+
+		segment := &milvuspb.ExplainedSegment{
+			SegmentID:   info.GetSegmentID(),
+			PartitionID: info.GetPartitionID(),
+			NodeIds:     append([]UniqueID{}, nodeIDs...),
+			State:       info.GetSegmentState(),
+			NumRows:     info.GetNumRows(),
+			Scan:        milvuspb.SegmentScan_ScanByFilter,
+		}
+		if anns != nil {
+			segment.Scan = milvuspb.SegmentScan_ScanByBruteForce
+			// growing segments are always searched by brute force
+			if info.GetSegmentState() != commonpb.SegmentState_Growing {
+				for _, indexInfo := range info.GetIndexInfos() {
+					if indexInfo.GetFieldID() == anns.GetFieldId() && indexInfo.GetEnableIndex() {
+						segment.Scan = milvuspb.SegmentScan_ScanByIndex
+						segment.IndexName = indexInfo.GetIndexName()
+						segment.IndexID = indexInfo.GetIndexID()
+						break
+					}
+				}
+			}
+		}
+		segments[info.GetSegmentID()] = segment
+	}
+
+	explained := make([]*milvuspb.ExplainedSegment, 0, len(segments))
+	for _, segment := range segments {
+		sort.Slice(segment.NodeIds, func(i, j int) bool {
+			return segment.NodeIds[i] < segment.NodeIds[j]
+		})
+		explained = append(explained, segment)
+	}
+	sort.Slice(explained, func(i, j int) bool {
+		return explained[i].SegmentID < explained[j].SegmentID
+	})
+	return explained
+}
+
+// estimateCost estimates the rows scanned and the distances computed by brute force of the segments
+func estimateCost(segments []*milvuspb.ExplainedSegment, nq int64) *milvuspb.ExplainCost {
+	if nq <= 0 {
+		nq = 1
+	}
+	cost := &milvuspb.ExplainCost{
+		Segments: int64(len(segments)),
+	}
+	for _, segment := range segments {
+		cost.Rows += segment.NumRows
+		switch segment.Scan {
+		case milvuspb.SegmentScan_ScanByIndex:
+			cost.IndexedSegments++
+		case milvuspb.SegmentScan_ScanByBruteForce:
+			cost.BruteForceRows += segment.NumRows
+		}
+	}
+	cost.Distances = nq * cost.BruteForceRows
+	return cost
+}
@@ -0,0 +1,144 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestExplainQueryPlan(t *testing.T) {
+	ctx := context.Background()
+	collectionID := UniqueID(1)
+	partitionID := UniqueID(10)
+	otherPartitionID := UniqueID(11)
+
+	cache := newMockCache()
+	cache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		return collectionID, nil
+	})
+	cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		return &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}}},
+			},
+		}, nil
+	})
+	cache.setGetPartitionsFunc(func(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
+		return map[string]typeutil.UniqueID{"p1": partitionID, "p2": otherPartitionID}, nil
+	})
+	oldCache := globalMetaCache
+	globalMetaCache = cache
+	defer func() {
+		globalMetaCache = oldCache
+	}()
+
+	indexInfos := []*querypb.FieldIndexInfo{{FieldID: 101, EnableIndex: true, IndexName: "idx", IndexID: 1000}}
+	qc := NewQueryCoordMock(SetQueryCoordGetSegmentInfoFunc(func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
+		return &querypb.GetSegmentInfoResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Infos: []*querypb.SegmentInfo{
+				{SegmentID: 1, PartitionID: partitionID, NodeID: 2, NodeIds: []int64{2}, NumRows: 100, SegmentState: commonpb.SegmentState_Sealed, IndexInfos: indexInfos},
+				{SegmentID: 1, PartitionID: partitionID, NodeID: 1, NodeIds: []int64{1}, NumRows: 100, SegmentState: commonpb.SegmentState_Sealed, IndexInfos: indexInfos},
+				{SegmentID: 2, PartitionID: partitionID, NodeID: 1, NumRows: 50, SegmentState: commonpb.SegmentState_Sealed},
+				{SegmentID: 3, PartitionID: partitionID, NodeID: 1, NumRows: 10, SegmentState: commonpb.SegmentState_Growing},
+				{SegmentID: 4, PartitionID: otherPartitionID, NodeID: 1, NumRows: 20, SegmentState: commonpb.SegmentState_Sealed},
+			},
+		}, nil
+	}))
+	qc.Start()
+	defer qc.Stop()
+
+	t.Run("search", func(t *testing.T) {
+		explanation, err := explainQueryPlan(ctx, qc, &milvuspb.ExplainRequest{
+			CollectionName: "collection",
+			PartitionNames: []string{"p1"},
+			Expr:           "pk > 1",
+			AnnsField:      "vec",
+			Topk:           10,
+			Nq:             2,
+		})
+		require.NoError(t, err)
+		assert.True(t, explanation.IsSearch)
+		assert.Equal(t, collectionID, explanation.CollectionID)
+		assert.Contains(t, explanation.Plan, "vector_anns")
+		assert.Contains(t, explanation.Plan, "unary_range_expr")
+
+		require.Equal(t, 3, len(explanation.Segments))
+		assert.Equal(t, []int64{1, 2}, explanation.Segments[0].NodeIds)
+		assert.Equal(t, milvuspb.SegmentScan_ScanByIndex, explanation.Segments[0].Scan)
+		assert.Equal(t, "idx", explanation.Segments[0].IndexName)
+		assert.Equal(t, milvuspb.SegmentScan_ScanByBruteForce, explanation.Segments[1].Scan)
+		assert.Equal(t, milvuspb.SegmentScan_ScanByBruteForce, explanation.Segments[2].Scan)
+		assert.Equal(t, commonpb.SegmentState_Growing, explanation.Segments[2].State)
+
+		assert.Equal(t, &milvuspb.ExplainCost{
+			Segments:        3,
+			Rows:            160,
+			IndexedSegments: 1,
+			BruteForceRows:  60,
+			Distances:       120,
+		}, explanation.EstimatedCost)
+	})
+
+	t.Run("query", func(t *testing.T) {
+		explanation, err := explainQueryPlan(ctx, qc, &milvuspb.ExplainRequest{
+			CollectionName: "collection",
+			Expr:           "pk in [1, 2]",
+		})
+		require.NoError(t, err)
+		assert.False(t, explanation.IsSearch)
+		assert.Contains(t, explanation.Plan, "term_expr")
+		require.Equal(t, 4, len(explanation.Segments))
+		for _, segment := range explanation.Segments {
+			assert.Equal(t, milvuspb.SegmentScan_ScanByFilter, segment.Scan)
+		}
+		assert.Equal(t, int64(180), explanation.EstimatedCost.Rows)
+		assert.Equal(t, int64(0), explanation.EstimatedCost.Distances)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		_, err := explainQueryPlan(ctx, qc, &milvuspb.ExplainRequest{CollectionName: "collection"})
+		assert.Error(t, err)
+
+		_, err = explainQueryPlan(ctx, qc, &milvuspb.ExplainRequest{CollectionName: "collection", AnnsField: "vec"})
+		assert.Error(t, err)
+
+		_, err = explainQueryPlan(ctx, qc, &milvuspb.ExplainRequest{CollectionName: "collection", AnnsField: "pk", Topk: 10})
+		assert.Error(t, err)
+
+		_, err = explainQueryPlan(ctx, qc, &milvuspb.ExplainRequest{CollectionName: "collection", PartitionNames: []string{"p3"}, Expr: "pk > 1"})
+		assert.Error(t, err)
+	})
+
+	t.Run("query coord failed", func(t *testing.T) {
+		qc.Stop()
+		defer qc.Start()
+		_, err := explainQueryPlan(ctx, qc, &milvuspb.ExplainRequest{CollectionName: "collection", Expr: "pk > 1"})
+		assert.Error(t, err)
+	})
+}
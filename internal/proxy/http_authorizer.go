@@ -0,0 +1,57 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+// httpBasicAuthPrefix is the scheme of the HTTP basic authentication, the credentials following it are
+// base64<username:password>, the same as the token of the SDKs
+const httpBasicAuthPrefix = "Basic "
+
+// HTTPAuthorizer authenticates and authorizes the requests of the HTTP server of proxy,
+// the same as the interceptors do for the gRPC requests.
+type HTTPAuthorizer struct{}
+
+// Authenticate verifies the credentials in the authorization header or the API key header, and returns the
+// context carrying them for the proxy.
+func (HTTPAuthorizer) Authenticate(ctx context.Context, header http.Header) (context.Context, error) {
+	md := metadata.MD{}
+	if authorization := header.Get(util.HeaderAuthorize); authorization != "" {
+		md.Set(util.HeaderAuthorize, strings.TrimPrefix(authorization, httpBasicAuthPrefix))
+	}
+	if apiKey := header.Get(util.HeaderAPIKey); apiKey != "" {
+		md.Set(util.HeaderAPIKey, apiKey)
+	}
+	return AuthenticationInterceptor(metadata.NewIncomingContext(ctx, md))
+}
+
+// Authorize returns error unless the authenticated user of ctx is allowed to do the operation with req,
+// the operation is named after the method of the gRPC service.
+func (HTTPAuthorizer) Authorize(ctx context.Context, operation string, req interface{}) error {
+	if !rbacEnabled() {
+		return nil
+	}
+	return authorizeRequest(ctx, operation, req)
+}
@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+func TestHTTPAuthorizer(t *testing.T) {
+	defer func(enabled bool, rbacEnabled bool, cache *privilegeCache) {
+		Params.CommonCfg.AuthorizationEnabled = enabled
+		Params.CommonCfg.RBACEnabled = rbacEnabled
+		globalPrivilegeCache = cache
+	}(Params.CommonCfg.AuthorizationEnabled, Params.CommonCfg.RBACEnabled, globalPrivilegeCache)
+	Params.CommonCfg.RBACEnabled = true
+	globalPrivilegeCache = newTestPrivilegeCache()
+	err := InitMetaCache(&MockRootCoordClientInterface{})
+	assert.NoError(t, err)
+	authorizer := HTTPAuthorizer{}
+	apiKeyHeader := func(key string) http.Header {
+		header := http.Header{}
+		header.Set(util.HeaderAPIKey, key)
+		return header
+	}
+
+	// not checked if the authorization is disabled
+	Params.CommonCfg.AuthorizationEnabled = false
+	ctx, err := authorizer.Authenticate(context.Background(), http.Header{})
+	assert.NoError(t, err)
+	assert.NoError(t, authorizer.Authorize(ctx, "Insert", &milvuspb.InsertRequest{CollectionName: "coll"}))
+
+	Params.CommonCfg.AuthorizationEnabled = true
+	_, err = authorizer.Authenticate(context.Background(), http.Header{})
+	assert.Error(t, err)
+	_, err = authorizer.Authenticate(context.Background(), apiKeyHeader("invalid"))
+	assert.Error(t, err)
+
+	ctx, err = authorizer.Authenticate(context.Background(), apiKeyHeader("key"))
+	assert.NoError(t, err)
+	assert.NoError(t, authorizer.Authorize(ctx, "Search", &milvuspb.SearchRequest{CollectionName: "coll"}))
+	assert.Error(t, authorizer.Authorize(ctx, "Insert", &milvuspb.InsertRequest{CollectionName: "coll"}))
+}
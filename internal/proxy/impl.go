@@ -34,6 +34,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
@@ -107,7 +108,7 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 
 	collectionName := request.CollectionName
 	if globalMetaCache != nil {
-		globalMetaCache.RemoveCollection(ctx, collectionName) // no need to return error, though collection may be not cached
+		globalMetaCache.RemoveCollection(ctx, request.GetDbName(), collectionName) // no need to return error, though collection may be not cached
 	}
 	logutil.Logger(ctx).Debug("complete to invalidate collection meta cache",
 		zap.String("role", typeutil.ProxyRole),
@@ -1619,6 +1620,7 @@ func (node *Proxy) CreateIndex(ctx context.Context, request *milvuspb.CreateInde
 		Condition:          NewTaskCondition(ctx),
 		CreateIndexRequest: request,
 		rootCoord:          node.rootCoord,
+		dataCoord:          node.dataCoord,
 	}
 
 	method := "CreateIndex"
@@ -1933,6 +1935,36 @@ func (node *Proxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexReq
 	return dit.result, nil
 }
 
+// CancelIndexBuild cancels the unfinished index builds of the collection, the one of index_buildID, or all of the
+// index of index_name.
+func (node *Proxy) CancelIndexBuild(ctx context.Context, req *milvuspb.CancelIndexBuildRequest) (*milvuspb.CancelIndexBuildResponse, error) {
+	log.Info("received cancel index build request", zap.String("collection name", req.GetCollectionName()),
+		zap.String("index name", req.GetIndexName()), zap.Int64("index build id", req.GetIndexBuildID()))
+	resp := &milvuspb.CancelIndexBuildResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, requestDatabase(ctx, req), req.GetCollectionName())
+	if err != nil {
+		log.Warn("failed to get collection id", zap.String("collection name", req.GetCollectionName()), zap.Error(err))
+		resp.Status = &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		return resp, nil
+	}
+	return node.indexCoord.CancelIndexBuild(ctx, &indexpb.CancelIndexBuildRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		CollectionID: collectionID,
+		IndexName:    req.GetIndexName(),
+		IndexBuildID: req.GetIndexBuildID(),
+	})
+}
+
 // GetIndexBuildProgress gets index build progress with filed_name and index_name.
 // IndexRows is the num of indexed rows. And TotalRows is the total number of segment rows.
 func (node *Proxy) GetIndexBuildProgress(ctx context.Context, request *milvuspb.GetIndexBuildProgressRequest) (*milvuspb.GetIndexBuildProgressResponse, error) {
@@ -2195,6 +2227,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 					MsgID:    0,
 					SourceID: Params.ProxyCfg.GetNodeID(),
 				},
+				DbName:         request.DbName,
 				CollectionName: request.CollectionName,
 				PartitionName:  request.PartitionName,
 				FieldsData:     request.FieldsData,
@@ -2287,6 +2320,116 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 	return it.result, nil
 }
 
+// Upsert replaces the records with the same primary keys in collection, or inserts them if not exist.
+// The records are deleted and inserted with the same timestamp, a deletion only applies to the rows inserted before it,
+// so the new records survive the deletion and the readers never observe the records missing.
+func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Upsert")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+	log.Info("Start processing upsert request in Proxy", zap.String("traceID", traceID))
+	defer log.Info("Finish processing upsert request in Proxy", zap.String("traceID", traceID))
+
+	if !node.checkHealthy() {
+		return &milvuspb.MutationResult{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	method := "Upsert"
+	tr := timerecord.NewTimeRecorder(method)
+
+	defer func() {
+		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+			metrics.TotalLabel).Inc()
+	}()
+
+	ut := &upsertTask{
+		insertTask: &insertTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			BaseInsertTask: BaseInsertTask{
+				BaseMsg: msgstream.BaseMsg{
+					HashValues: request.HashKeys,
+				},
+				InsertRequest: internalpb.InsertRequest{
+					Base: &commonpb.MsgBase{
+						MsgType:  commonpb.MsgType_Insert,
+						MsgID:    0,
+						SourceID: Params.ProxyCfg.GetNodeID(),
+					},
+					DbName:         request.DbName,
+					CollectionName: request.CollectionName,
+					PartitionName:  request.PartitionName,
+					FieldsData:     request.FieldsData,
+					NumRows:        uint64(request.NumRows),
+					Version:        internalpb.InsertDataVersion_ColumnBased,
+				},
+			},
+			rowIDAllocator: node.idAllocator,
+			segIDAssigner:  node.segAssigner,
+			chMgr:          node.chMgr,
+			chTicker:       node.chTicker,
+		},
+		tsoAllocator: node.tsoAllocator,
+	}
+
+	if len(ut.PartitionName) <= 0 {
+		ut.PartitionName = Params.CommonCfg.DefaultPartitionName
+	}
+
+	constructFailedResponse := func(err error) *milvuspb.MutationResult {
+		numRows := request.NumRows
+		errIndex := make([]uint32, numRows)
+		for i := uint32(0); i < numRows; i++ {
+			errIndex[i] = i
+		}
+
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ErrIndex: errIndex,
+		}
+	}
+
+	log.Debug("Enqueue upsert request in Proxy",
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Uint32("NumRows", request.NumRows),
+		zap.String("traceID", traceID))
+
+	if err := node.sched.dmQueue.Enqueue(ut); err != nil {
+		log.Debug("Failed to enqueue upsert task: " + err.Error())
+		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+			metrics.AbandonLabel).Inc()
+		return constructFailedResponse(err), nil
+	}
+
+	if err := ut.WaitToFinish(); err != nil {
+		log.Debug("Failed to execute upsert task in task scheduler: "+err.Error(), zap.String("traceID", traceID))
+		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+			metrics.FailLabel).Inc()
+		return constructFailedResponse(err), nil
+	}
+
+	if ut.result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		numRows := request.NumRows
+		errIndex := make([]uint32, numRows)
+		for i := uint32(0); i < numRows; i++ {
+			errIndex[i] = i
+		}
+		ut.result.ErrIndex = errIndex
+	}
+
+	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+		metrics.SuccessLabel).Inc()
+	metrics.ProxyMutationLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.UpsertLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return ut.result, nil
+}
+
 // Delete delete records from collection, then these records cannot be searched.
 func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Delete")
@@ -2381,7 +2524,22 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 }
 
 // Search search the most similar records of requests.
-func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (resp *milvuspb.SearchResults, err error) {
+	// the failed requests are audited as well, the expression and output fields are the ones of the template if any
+	var qt *searchTask
+	if node.auditLogger != nil {
+		record := newAuditRecord(ctx, auditKindSearch, request.DbName, request.CollectionName, request.PartitionNames,
+			request.Dsl, request.OutputFields)
+		defer func() {
+			var reqID UniqueID
+			if qt != nil {
+				reqID = qt.ID()
+			}
+			record.expr, record.outputFields = request.Dsl, request.OutputFields
+			node.auditLogger.record(record.done(reqID, resp.GetStatus()))
+		}()
+	}
+
 	if !node.checkHealthy() {
 		return &milvuspb.SearchResults{
 			Status: unhealthyStatus(),
@@ -2396,7 +2554,23 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	defer sp.Finish()
 	traceID, _, _ := trace.InfoFromSpan(sp)
 
-	qt := &searchTask{
+	var template *registeredTemplate
+	if request.GetTemplateID() != 0 {
+		template, err = node.templates.get(ctx, request.GetTemplateID(), getCurUser(ctx))
+		if err == nil {
+			err = applySearchTemplate(request, template)
+		}
+		if err != nil {
+			return &milvuspb.SearchResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	qt = &searchTask{
 		ctx:       ctx,
 		Condition: NewTaskCondition(ctx),
 		SearchRequest: &internalpb.SearchRequest{
@@ -2409,9 +2583,22 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		request:            request,
 		qc:                 node.queryCoord,
 		tr:                 timerecord.NewTimeRecorder("search"),
+		template:           template,
 		getQueryNodePolicy: defaultGetQueryNodePolicy,
 	}
 
+	if err := globalQuotaLimiter.checkSearch(ctx, request.GetDbName(), request.CollectionName); err != nil {
+		log.Warn("search rejected by quota", zap.String("traceID", traceID), zap.String("collection", request.CollectionName), zap.Error(err))
+		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+			metrics.AbandonLabel).Inc()
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
 	travelTs := request.TravelTimestamp
 	guaranteeTs := request.GuaranteeTimestamp
 
@@ -2623,7 +2810,22 @@ func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*
 }
 
 // Query get the records by primary keys.
-func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (resp *milvuspb.QueryResults, err error) {
+	// the failed requests are audited as well, the expression and output fields are the ones of the template if any
+	var qt *queryTask
+	if node.auditLogger != nil {
+		record := newAuditRecord(ctx, auditKindQuery, request.DbName, request.CollectionName, request.PartitionNames,
+			request.Expr, request.OutputFields)
+		defer func() {
+			var reqID UniqueID
+			if qt != nil {
+				reqID = qt.ID()
+			}
+			record.expr, record.outputFields = request.Expr, request.OutputFields
+			node.auditLogger.record(record.done(reqID, resp.GetStatus()))
+		}()
+	}
+
 	if !node.checkHealthy() {
 		return &milvuspb.QueryResults{
 			Status: unhealthyStatus(),
@@ -2635,7 +2837,23 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	traceID, _, _ := trace.InfoFromSpan(sp)
 	tr := timerecord.NewTimeRecorder("Query")
 
-	qt := &queryTask{
+	var template *registeredTemplate
+	if request.GetTemplateID() != 0 {
+		template, err = node.templates.get(ctx, request.GetTemplateID(), getCurUser(ctx))
+		if err == nil {
+			err = applyQueryTemplate(request, template)
+		}
+		if err != nil {
+			return &milvuspb.QueryResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	qt = &queryTask{
 		ctx:       ctx,
 		Condition: NewTaskCondition(ctx),
 		RetrieveRequest: &internalpb.RetrieveRequest{
@@ -2647,6 +2865,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		},
 		request:            request,
 		qc:                 node.queryCoord,
+		template:           template,
 		getQueryNodePolicy: defaultGetQueryNodePolicy,
 		queryShardPolicy:   roundRobinPolicy,
 	}
@@ -2656,6 +2875,20 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.TotalLabel).Inc()
 
+	release, err := globalQuotaLimiter.acquireQuery(ctx, request.GetDbName(), request.CollectionName)
+	if err != nil {
+		log.Warn("query rejected by quota", zap.String("traceID", traceID), zap.String("collection", request.CollectionName), zap.Error(err))
+		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+			metrics.AbandonLabel).Inc()
+		return &milvuspb.QueryResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	defer release()
+
 	log.Debug(
 		rpcReceived(method),
 		zap.String("traceID", traceID),
@@ -2745,6 +2978,80 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	}, nil
 }
 
+// Explain returns the plan of the search or query in request without executing it, with the segments to be scanned
+// and its estimated cost. The request is a search if anns_field is set.
+// RegisterTemplate registers a search or query template, which is executed by the template id returned.
+func (node *Proxy) RegisterTemplate(ctx context.Context, req *milvuspb.RegisterTemplateRequest) (*milvuspb.RegisterTemplateResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.RegisterTemplateResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	template, err := func() (*registeredTemplate, error) {
+		id, err := node.idAllocator.AllocOne()
+		if err != nil {
+			return nil, err
+		}
+		template, err := newRegisteredTemplate(ctx, id, getCurUser(ctx), req)
+		if err != nil {
+			return nil, err
+		}
+		return template, node.templates.register(ctx, template)
+	}()
+	if err != nil {
+		log.Warn("Proxy.RegisterTemplate failed", zap.String("collection", req.GetCollectionName()), zap.String("expr", req.GetExpr()), zap.Error(err))
+		return &milvuspb.RegisterTemplateResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Info("Proxy.RegisterTemplate", zap.Int64("templateID", template.id), zap.String("collection", template.collectionName),
+		zap.String("expr", template.expr))
+	return &milvuspb.RegisterTemplateResponse{
+		Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		TemplateID: template.id,
+	}, nil
+}
+
+// DropTemplate drops a template registered by RegisterTemplate, only the user registering it or root drops it on the
+// collection it's registered for.
+func (node *Proxy) DropTemplate(ctx context.Context, req *milvuspb.DropTemplateRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := node.templates.drop(ctx, req.GetTemplateID(), getCurUser(ctx), req.GetDbName(), req.GetCollectionName()); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (node *Proxy) Explain(ctx context.Context, req *milvuspb.ExplainRequest) (*milvuspb.ExplainResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.ExplainResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	explanation, err := explainQueryPlan(ctx, node.queryCoord, req)
+	if err != nil {
+		log.Warn("Proxy.Explain failed", zap.String("collection", req.GetCollectionName()), zap.String("expr", req.GetExpr()), zap.Error(err))
+		return &milvuspb.ExplainResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	explanation.Status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	return explanation, nil
+}
+
 // CreateAlias create alias for collection, then you can search the collection with alias.
 func (node *Proxy) CreateAlias(ctx context.Context, request *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
@@ -2836,27 +3143,667 @@ func (node *Proxy) CreateAlias(ctx context.Context, request *milvuspb.CreateAlia
 
 	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
 	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
-	return cat.result, nil
+	return cat.result, nil
+}
+
+// DropAlias alter the alias of collection.
+func (node *Proxy) DropAlias(ctx context.Context, request *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropAlias")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	dat := &DropAliasTask{
+		ctx:              ctx,
+		Condition:        NewTaskCondition(ctx),
+		DropAliasRequest: request,
+		rootCoord:        node.rootCoord,
+	}
+
+	method := "DropAlias"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("alias", request.Alias))
+
+	if err := node.sched.ddQueue.Enqueue(dat); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName),
+			zap.String("alias", request.Alias))
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", dat.ID()),
+		zap.Uint64("BeginTs", dat.BeginTs()),
+		zap.Uint64("EndTs", dat.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("alias", request.Alias))
+
+	if err := dat.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", dat.ID()),
+			zap.Uint64("BeginTs", dat.BeginTs()),
+			zap.Uint64("EndTs", dat.EndTs()),
+			zap.String("db", request.DbName),
+			zap.String("alias", request.Alias))
+
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", dat.ID()),
+		zap.Uint64("BeginTs", dat.BeginTs()),
+		zap.Uint64("EndTs", dat.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("alias", request.Alias))
+
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return dat.result, nil
+}
+
+// AlterAlias alter alias of collection.
+func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-AlterAlias")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	aat := &AlterAliasTask{
+		ctx:               ctx,
+		Condition:         NewTaskCondition(ctx),
+		AlterAliasRequest: request,
+		rootCoord:         node.rootCoord,
+	}
+
+	method := "AlterAlias"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("alias", request.Alias),
+		zap.String("collection", request.CollectionName))
+
+	if err := node.sched.ddQueue.Enqueue(aat); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName),
+			zap.String("alias", request.Alias),
+			zap.String("collection", request.CollectionName))
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", aat.ID()),
+		zap.Uint64("BeginTs", aat.BeginTs()),
+		zap.Uint64("EndTs", aat.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("alias", request.Alias),
+		zap.String("collection", request.CollectionName))
+
+	if err := aat.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", aat.ID()),
+			zap.Uint64("BeginTs", aat.BeginTs()),
+			zap.Uint64("EndTs", aat.EndTs()),
+			zap.String("db", request.DbName),
+			zap.String("alias", request.Alias),
+			zap.String("collection", request.CollectionName))
+
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", aat.ID()),
+		zap.Uint64("BeginTs", aat.BeginTs()),
+		zap.Uint64("EndTs", aat.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("alias", request.Alias),
+		zap.String("collection", request.CollectionName))
+
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return aat.result, nil
+}
+
+// RenameCollection renames a collection, its aliases are kept pointing to it.
+func (node *Proxy) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-RenameCollection")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	rct := &RenameCollectionTask{
+		ctx:                     ctx,
+		Condition:               NewTaskCondition(ctx),
+		RenameCollectionRequest: request,
+		rootCoord:               node.rootCoord,
+	}
+
+	method := "RenameCollection"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("oldName", request.OldName),
+		zap.String("newName", request.NewName))
+
+	if err := node.sched.ddQueue.Enqueue(rct); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName),
+			zap.String("oldName", request.OldName),
+			zap.String("newName", request.NewName))
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", rct.ID()),
+		zap.Uint64("BeginTs", rct.BeginTs()),
+		zap.Uint64("EndTs", rct.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("oldName", request.OldName),
+		zap.String("newName", request.NewName))
+
+	if err := rct.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", rct.ID()),
+			zap.Uint64("BeginTs", rct.BeginTs()),
+			zap.Uint64("EndTs", rct.EndTs()),
+			zap.String("db", request.DbName),
+			zap.String("oldName", request.OldName),
+			zap.String("newName", request.NewName))
+
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", rct.ID()),
+		zap.Uint64("BeginTs", rct.BeginTs()),
+		zap.Uint64("EndTs", rct.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("oldName", request.OldName),
+		zap.String("newName", request.NewName))
+
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return rct.result, nil
+}
+
+// AddCollectionField adds a scalar field with a default value to a collection, the loaded collection serves the
+// field after being released and loaded again.
+func (node *Proxy) AddCollectionField(ctx context.Context, request *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-AddCollectionField")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	aft := &AddCollectionFieldTask{
+		ctx:                       ctx,
+		Condition:                 NewTaskCondition(ctx),
+		AddCollectionFieldRequest: request,
+		rootCoord:                 node.rootCoord,
+	}
+
+	method := "AddCollectionField"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.GetField().GetName()))
+
+	if err := node.sched.ddQueue.Enqueue(aft); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.String("field", request.GetField().GetName()))
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", aft.ID()),
+		zap.Uint64("BeginTs", aft.BeginTs()),
+		zap.Uint64("EndTs", aft.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.GetField().GetName()))
+
+	if err := aft.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", aft.ID()),
+			zap.Uint64("BeginTs", aft.BeginTs()),
+			zap.Uint64("EndTs", aft.EndTs()),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.String("field", request.GetField().GetName()))
+
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", aft.ID()),
+		zap.Uint64("BeginTs", aft.BeginTs()),
+		zap.Uint64("EndTs", aft.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.GetField().GetName()))
+
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return aft.result, nil
+}
+
+// AlterCollection sets or removes the properties of a collection, the altered properties are applied to the loaded
+// collection at once.
+func (node *Proxy) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-AlterCollection")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	act := &AlterCollectionTask{
+		ctx:                    ctx,
+		Condition:              NewTaskCondition(ctx),
+		AlterCollectionRequest: request,
+		rootCoord:              node.rootCoord,
+	}
+
+	method := "AlterCollection"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("properties", request.GetProperties()))
+
+	if err := node.sched.ddQueue.Enqueue(act); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.Any("properties", request.GetProperties()))
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", act.ID()),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("properties", request.GetProperties()))
+
+	if err := act.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", act.ID()),
+			zap.Uint64("BeginTs", act.BeginTs()),
+			zap.Uint64("EndTs", act.EndTs()),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.Any("properties", request.GetProperties()))
+
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", act.ID()),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("properties", request.GetProperties()))
+
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return act.result, nil
+}
+
+// CreateDatabase creates a database, the collections in which are named in the scope of it
+func (node *Proxy) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CreateDatabase")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	cdt := &CreateDatabaseTask{
+		ctx:                   ctx,
+		Condition:             NewTaskCondition(ctx),
+		CreateDatabaseRequest: request,
+		rootCoord:             node.rootCoord,
+	}
+
+	method := "CreateDatabase"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.Any("properties", request.GetProperties()))
+
+	if err := node.sched.ddQueue.Enqueue(cdt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName),
+			zap.Any("properties", request.GetProperties()))
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", cdt.ID()),
+		zap.Uint64("BeginTs", cdt.BeginTs()),
+		zap.Uint64("EndTs", cdt.EndTs()),
+		zap.String("db", request.DbName),
+		zap.Any("properties", request.GetProperties()))
+
+	if err := cdt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", cdt.ID()),
+			zap.Uint64("BeginTs", cdt.BeginTs()),
+			zap.Uint64("EndTs", cdt.EndTs()),
+			zap.String("db", request.DbName),
+			zap.Any("properties", request.GetProperties()))
+
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", cdt.ID()),
+		zap.Uint64("BeginTs", cdt.BeginTs()),
+		zap.Uint64("EndTs", cdt.EndTs()),
+		zap.String("db", request.DbName),
+		zap.Any("properties", request.GetProperties()))
+
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return cdt.result, nil
+}
+
+// DropDatabase drops a database without collections
+func (node *Proxy) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropDatabase")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	ddt := &DropDatabaseTask{
+		ctx:                 ctx,
+		Condition:           NewTaskCondition(ctx),
+		DropDatabaseRequest: request,
+		rootCoord:           node.rootCoord,
+	}
+
+	method := "DropDatabase"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName))
+
+	if err := node.sched.ddQueue.Enqueue(ddt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName))
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", ddt.ID()),
+		zap.Uint64("BeginTs", ddt.BeginTs()),
+		zap.Uint64("EndTs", ddt.EndTs()),
+		zap.String("db", request.DbName))
+
+	if err := ddt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", ddt.ID()),
+			zap.Uint64("BeginTs", ddt.BeginTs()),
+			zap.Uint64("EndTs", ddt.EndTs()),
+			zap.String("db", request.DbName))
+
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", ddt.ID()),
+		zap.Uint64("BeginTs", ddt.BeginTs()),
+		zap.Uint64("EndTs", ddt.EndTs()),
+		zap.String("db", request.DbName))
+
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return ddt.result, nil
 }
 
-// DropAlias alter the alias of collection.
-func (node *Proxy) DropAlias(ctx context.Context, request *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
+// AlterDatabase sets the quotas in the properties to a database, the other quotas are unchanged
+func (node *Proxy) AlterDatabase(ctx context.Context, request *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
 
-	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropAlias")
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-AlterDatabase")
 	defer sp.Finish()
 	traceID, _, _ := trace.InfoFromSpan(sp)
 
-	dat := &DropAliasTask{
-		ctx:              ctx,
-		Condition:        NewTaskCondition(ctx),
-		DropAliasRequest: request,
-		rootCoord:        node.rootCoord,
+	adt := &AlterDatabaseTask{
+		ctx:                  ctx,
+		Condition:            NewTaskCondition(ctx),
+		AlterDatabaseRequest: request,
+		rootCoord:            node.rootCoord,
 	}
 
-	method := "DropAlias"
+	method := "AlterDatabase"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
 
@@ -2865,16 +3812,16 @@ func (node *Proxy) DropAlias(ctx context.Context, request *milvuspb.DropAliasReq
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
-		zap.String("alias", request.Alias))
+		zap.Any("properties", request.GetProperties()))
 
-	if err := node.sched.ddQueue.Enqueue(dat); err != nil {
+	if err := node.sched.ddQueue.Enqueue(adt); err != nil {
 		log.Warn(
 			rpcFailedToEnqueue(method),
 			zap.Error(err),
 			zap.String("traceID", traceID),
 			zap.String("role", typeutil.ProxyRole),
 			zap.String("db", request.DbName),
-			zap.String("alias", request.Alias))
+			zap.Any("properties", request.GetProperties()))
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
 
 		return &commonpb.Status{
@@ -2887,23 +3834,23 @@ func (node *Proxy) DropAlias(ctx context.Context, request *milvuspb.DropAliasReq
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
-		zap.Int64("MsgID", dat.ID()),
-		zap.Uint64("BeginTs", dat.BeginTs()),
-		zap.Uint64("EndTs", dat.EndTs()),
+		zap.Int64("MsgID", adt.ID()),
+		zap.Uint64("BeginTs", adt.BeginTs()),
+		zap.Uint64("EndTs", adt.EndTs()),
 		zap.String("db", request.DbName),
-		zap.String("alias", request.Alias))
+		zap.Any("properties", request.GetProperties()))
 
-	if err := dat.WaitToFinish(); err != nil {
+	if err := adt.WaitToFinish(); err != nil {
 		log.Warn(
 			rpcFailedToWaitToFinish(method),
 			zap.Error(err),
 			zap.String("traceID", traceID),
 			zap.String("role", typeutil.ProxyRole),
-			zap.Int64("MsgID", dat.ID()),
-			zap.Uint64("BeginTs", dat.BeginTs()),
-			zap.Uint64("EndTs", dat.EndTs()),
+			zap.Int64("MsgID", adt.ID()),
+			zap.Uint64("BeginTs", adt.BeginTs()),
+			zap.Uint64("EndTs", adt.EndTs()),
 			zap.String("db", request.DbName),
-			zap.String("alias", request.Alias))
+			zap.Any("properties", request.GetProperties()))
 
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
 
@@ -2917,60 +3864,58 @@ func (node *Proxy) DropAlias(ctx context.Context, request *milvuspb.DropAliasReq
 		rpcDone(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
-		zap.Int64("MsgID", dat.ID()),
-		zap.Uint64("BeginTs", dat.BeginTs()),
-		zap.Uint64("EndTs", dat.EndTs()),
+		zap.Int64("MsgID", adt.ID()),
+		zap.Uint64("BeginTs", adt.BeginTs()),
+		zap.Uint64("EndTs", adt.EndTs()),
 		zap.String("db", request.DbName),
-		zap.String("alias", request.Alias))
+		zap.Any("properties", request.GetProperties()))
 
 	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
 	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
-	return dat.result, nil
+	return adt.result, nil
 }
 
-// AlterAlias alter alias of collection.
-func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
+// ListDatabases returns the names of the databases, including the default one
+func (node *Proxy) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
 	if !node.checkHealthy() {
-		return unhealthyStatus(), nil
+		return &milvuspb.ListDatabasesResponse{
+			Status: unhealthyStatus(),
+		}, nil
 	}
 
-	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-AlterAlias")
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ListDatabases")
 	defer sp.Finish()
 	traceID, _, _ := trace.InfoFromSpan(sp)
 
-	aat := &AlterAliasTask{
-		ctx:               ctx,
-		Condition:         NewTaskCondition(ctx),
-		AlterAliasRequest: request,
-		rootCoord:         node.rootCoord,
+	ldt := &ListDatabasesTask{
+		ctx:                  ctx,
+		Condition:            NewTaskCondition(ctx),
+		ListDatabasesRequest: request,
+		rootCoord:            node.rootCoord,
 	}
 
-	method := "AlterAlias"
+	method := "ListDatabases"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
 
 	log.Debug(
 		rpcReceived(method),
 		zap.String("traceID", traceID),
-		zap.String("role", typeutil.ProxyRole),
-		zap.String("db", request.DbName),
-		zap.String("alias", request.Alias),
-		zap.String("collection", request.CollectionName))
+		zap.String("role", typeutil.ProxyRole))
 
-	if err := node.sched.ddQueue.Enqueue(aat); err != nil {
+	if err := node.sched.ddQueue.Enqueue(ldt); err != nil {
 		log.Warn(
 			rpcFailedToEnqueue(method),
 			zap.Error(err),
 			zap.String("traceID", traceID),
-			zap.String("role", typeutil.ProxyRole),
-			zap.String("db", request.DbName),
-			zap.String("alias", request.Alias),
-			zap.String("collection", request.CollectionName))
+			zap.String("role", typeutil.ProxyRole))
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
 
-		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
-			Reason:    err.Error(),
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
 		}, nil
 	}
 
@@ -2978,31 +3923,27 @@ func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasR
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
-		zap.Int64("MsgID", aat.ID()),
-		zap.Uint64("BeginTs", aat.BeginTs()),
-		zap.Uint64("EndTs", aat.EndTs()),
-		zap.String("db", request.DbName),
-		zap.String("alias", request.Alias),
-		zap.String("collection", request.CollectionName))
+		zap.Int64("MsgID", ldt.ID()),
+		zap.Uint64("BeginTs", ldt.BeginTs()),
+		zap.Uint64("EndTs", ldt.EndTs()))
 
-	if err := aat.WaitToFinish(); err != nil {
+	if err := ldt.WaitToFinish(); err != nil {
 		log.Warn(
 			rpcFailedToWaitToFinish(method),
 			zap.Error(err),
 			zap.String("traceID", traceID),
 			zap.String("role", typeutil.ProxyRole),
-			zap.Int64("MsgID", aat.ID()),
-			zap.Uint64("BeginTs", aat.BeginTs()),
-			zap.Uint64("EndTs", aat.EndTs()),
-			zap.String("db", request.DbName),
-			zap.String("alias", request.Alias),
-			zap.String("collection", request.CollectionName))
+			zap.Int64("MsgID", ldt.ID()),
+			zap.Uint64("BeginTs", ldt.BeginTs()),
+			zap.Uint64("EndTs", ldt.EndTs()))
 
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
 
-		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
-			Reason:    err.Error(),
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
 		}, nil
 	}
 
@@ -3010,16 +3951,13 @@ func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasR
 		rpcDone(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
-		zap.Int64("MsgID", aat.ID()),
-		zap.Uint64("BeginTs", aat.BeginTs()),
-		zap.Uint64("EndTs", aat.EndTs()),
-		zap.String("db", request.DbName),
-		zap.String("alias", request.Alias),
-		zap.String("collection", request.CollectionName))
+		zap.Int64("MsgID", ldt.ID()),
+		zap.Uint64("BeginTs", ldt.BeginTs()),
+		zap.Uint64("EndTs", ldt.EndTs()))
 
 	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
 	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
-	return aat.result, nil
+	return ldt.result, nil
 }
 
 // CalcDistance calculates the distances between vectors.
@@ -3145,7 +4083,8 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 			if fieldData.FieldName == ids.FieldName {
 				retrievedVectors = fieldData.GetVectors()
 			}
-			if fieldData.Type == schemapb.DataType_Int64 {
+			// only the primary key is retrieved besides the vectors
+			if fieldData.Type == schemapb.DataType_Int64 || fieldData.Type == schemapb.DataType_VarChar {
 				retrievedIds = fieldData.GetScalars()
 			}
 		}
@@ -3154,12 +4093,18 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 			return nil, errors.New("failed to fetch vectors")
 		}
 
-		dict := make(map[int64]int)
-		for index, id := range retrievedIds.GetLongData().Data {
+		dict := make(map[interface{}]int)
+		for index, id := range retrievedIds.GetLongData().GetData() {
+			dict[id] = index
+		}
+		for index, id := range retrievedIds.GetStringData().GetData() {
 			dict[id] = index
 		}
 
-		inputIds := ids.IdArray.GetIntId().Data
+		inputIds := make([]interface{}, typeutil.GetSizeOfIDs(ids.IdArray))
+		for i := range inputIds {
+			inputIds[i] = typeutil.GetPK(ids.IdArray, int64(i))
+		}
 		if retrievedVectors.GetFloatVector() != nil {
 			floatArr := retrievedVectors.GetFloatVector().Data
 			element := retrievedVectors.GetDim()
@@ -3167,7 +4112,7 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 			for _, id := range inputIds {
 				index, ok := dict[id]
 				if !ok {
-					log.Error("id not found in CalcDistance", zap.Int64("id", id))
+					log.Error("id not found in CalcDistance", zap.Any("id", id))
 					return nil, errors.New("failed to fetch vectors by id: " + fmt.Sprintln(id))
 				}
 				result = append(result, floatArr[int64(index)*element:int64(index+1)*element]...)
@@ -3194,7 +4139,7 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 			for _, id := range inputIds {
 				index, ok := dict[id]
 				if !ok {
-					log.Error("id not found in CalcDistance", zap.Int64("id", id))
+					log.Error("id not found in CalcDistance", zap.Any("id", id))
 					return nil, errors.New("failed to fetch vectors by id: " + fmt.Sprintln(id))
 				}
 				result = append(result, binaryArr[int64(index)*element:int64(index+1)*element]...)
@@ -3549,7 +4494,7 @@ func (node *Proxy) GetQuerySegmentInfo(ctx context.Context, req *milvuspb.GetQue
 		resp.Status.Reason = err.Error()
 		return resp, nil
 	}
-	collID, err := globalMetaCache.GetCollectionID(ctx, req.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.CollectionName)
 	if err != nil {
 		resp.Status.Reason = err.Error()
 		return resp, nil
@@ -3798,6 +4743,21 @@ func (node *Proxy) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsReque
 		return metrics, nil
 	}
 
+	if metricType == metricsinfo.CollectionLifecycleMetrics {
+		// the load/release history of collections is maintained by query coord
+		return node.queryCoord.GetMetrics(ctx, req)
+	}
+
+	if metricType == metricsinfo.ImportTasksMetrics {
+		// the import tasks are managed by root coord
+		return node.rootCoord.GetMetrics(ctx, req)
+	}
+
+	if metricType == metricsinfo.IndexBuildTasksMetrics {
+		// the index builds are scheduled by index coord
+		return node.indexCoord.GetMetrics(ctx, req)
+	}
+
 	log.Debug("Proxy.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("node_id", Params.ProxyCfg.GetNodeID()),
 		zap.String("req", req.Request),
@@ -3826,7 +4786,7 @@ func (node *Proxy) LoadBalance(ctx context.Context, req *milvuspb.LoadBalanceReq
 		ErrorCode: commonpb.ErrorCode_UnexpectedError,
 	}
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, requestDatabase(ctx, req), req.GetCollectionName())
 	if err != nil {
 		log.Error("failed to get collection id", zap.String("collection name", req.GetCollectionName()), zap.Error(err))
 		status.Reason = err.Error()
@@ -3861,7 +4821,61 @@ func (node *Proxy) LoadBalance(ctx context.Context, req *milvuspb.LoadBalanceReq
 	return status, nil
 }
 
-//GetCompactionState gets the compaction state of multiple segments
+// CordonNode cordons the query node, no new segments or channels are assigned to it and the ones it serves are drained
+func (node *Proxy) CordonNode(ctx context.Context, req *milvuspb.CordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	log.Info("received CordonNode request", zap.Int64("nodeID", req.GetNodeID()))
+	if !node.checkHealthy() {
+		return &milvuspb.CordonedNodesResponse{Status: unhealthyStatus()}, nil
+	}
+	return node.queryCoord.CordonNode(ctx, &milvuspb.CordonNodeRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		NodeID: req.GetNodeID(),
+	})
+}
+
+// UncordonNode makes the cordoned query node assignable again
+func (node *Proxy) UncordonNode(ctx context.Context, req *milvuspb.UncordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	log.Info("received UncordonNode request", zap.Int64("nodeID", req.GetNodeID()))
+	if !node.checkHealthy() {
+		return &milvuspb.CordonedNodesResponse{Status: unhealthyStatus()}, nil
+	}
+	return node.queryCoord.UncordonNode(ctx, &milvuspb.UncordonNodeRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		NodeID: req.GetNodeID(),
+	})
+}
+
+// GetCordonedNodes gets the cordoned query nodes and their drain states
+func (node *Proxy) GetCordonedNodes(ctx context.Context, req *milvuspb.GetCordonedNodesRequest) (*milvuspb.CordonedNodesResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.CordonedNodesResponse{Status: unhealthyStatus()}, nil
+	}
+	return node.queryCoord.GetCordonedNodes(ctx, &milvuspb.GetCordonedNodesRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+	})
+}
+
+// Balance triggers a balance round of the query nodes, the planned moves are not executed if it's a dry run
+func (node *Proxy) Balance(ctx context.Context, req *milvuspb.BalanceRequest) (*milvuspb.BalanceResponse, error) {
+	log.Info("received Balance request", zap.Bool("dryRun", req.GetDryRun()))
+	if !node.checkHealthy() {
+		return &milvuspb.BalanceResponse{Status: unhealthyStatus()}, nil
+	}
+	return node.queryCoord.Balance(ctx, &milvuspb.BalanceRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		DryRun: req.GetDryRun(),
+	})
+}
+
+// GetCompactionState gets the compaction state of multiple segments
 func (node *Proxy) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	log.Info("received GetCompactionState request", zap.Int64("compactionID", req.GetCompactionID()))
 	resp := &milvuspb.GetCompactionStateResponse{}
@@ -3929,7 +4943,7 @@ func (node *Proxy) checkHealthy() bool {
 	return code == internalpb.StateCode_Healthy
 }
 
-//unhealthyStatus returns the proxy not healthy status
+// unhealthyStatus returns the proxy not healthy status
 func unhealthyStatus() *commonpb.Status {
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -3953,7 +4967,7 @@ func (node *Proxy) Import(ctx context.Context, req *milvuspb.ImportRequest) (*mi
 		return resp, nil
 	}
 	// Get collection ID and then channel names.
-	collID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	collID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
 	if err != nil {
 		log.Error("collection ID not found",
 			zap.String("collection name", req.GetCollectionName()),
@@ -3996,6 +5010,20 @@ func (node *Proxy) GetImportState(ctx context.Context, req *milvuspb.GetImportSt
 	return resp, err
 }
 
+// CancelImport cancels a pending or working import task by rootcoord
+func (node *Proxy) CancelImport(ctx context.Context, req *milvuspb.CancelImportRequest) (*milvuspb.GetImportStateResponse, error) {
+	log.Info("received cancel import request", zap.Int64("taskID", req.GetTask()))
+	resp := &milvuspb.GetImportStateResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	resp, err := node.rootCoord.CancelImport(ctx, req)
+	log.Info("received cancel import response", zap.Int64("taskID", req.GetTask()), zap.Any("resp", resp), zap.Error(err))
+	return resp, err
+}
+
 // ListImportTasks get id array of all import tasks from rootcoord
 func (node *Proxy) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error) {
 	log.Info("received list import tasks request")
@@ -4029,6 +5057,62 @@ func (node *Proxy) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasReq
 	return resp, err
 }
 
+// GetLoadingProgress gets the progress of loading a collection
+func (node *Proxy) GetLoadingProgress(ctx context.Context, req *milvuspb.GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error) {
+	log.Debug("received get loading progress request", zap.String("collection name", req.GetCollectionName()))
+	resp := &milvuspb.GetLoadingProgressResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, requestDatabase(ctx, req), req.GetCollectionName())
+	if err != nil {
+		log.Warn("failed to get collection id", zap.String("collection name", req.GetCollectionName()), zap.Error(err))
+		resp.Status = &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		return resp, nil
+	}
+	return node.queryCoord.GetLoadingProgress(ctx, &querypb.GetLoadingProgressRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		CollectionID: collectionID,
+	})
+}
+
+// GetReleaseJobs gets the release jobs of a collection, or of all the collections if the collection name is empty
+func (node *Proxy) GetReleaseJobs(ctx context.Context, req *milvuspb.GetReleaseJobsRequest) (*milvuspb.GetReleaseJobsResponse, error) {
+	log.Debug("received get release jobs request", zap.String("collection name", req.GetCollectionName()))
+	resp := &milvuspb.GetReleaseJobsResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	var collectionID UniqueID
+	if req.GetCollectionName() != "" {
+		var err error
+		collectionID, err = globalMetaCache.GetCollectionID(ctx, requestDatabase(ctx, req), req.GetCollectionName())
+		if err != nil {
+			log.Warn("failed to get collection id", zap.String("collection name", req.GetCollectionName()), zap.Error(err))
+			resp.Status = &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			}
+			return resp, nil
+		}
+	}
+	return node.queryCoord.GetReleaseJobs(ctx, &querypb.GetReleaseJobsRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		CollectionID: collectionID,
+	})
+}
+
 // InvalidateCredentialCache invalidate the credential cache of specified username.
 func (node *Proxy) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
 	ctx = logutil.WithModule(ctx, moduleName)
@@ -4243,6 +5327,142 @@ func (node *Proxy) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUser
 	}, nil
 }
 
+// SaveRole creates the role or replaces the grants of it, the changes are watched by all the proxies
+func (node *Proxy) SaveRole(ctx context.Context, req *milvuspb.SaveRoleRequest) (*commonpb.Status, error) {
+	log.Debug("SaveRole", zap.String("role", typeutil.ProxyRole), zap.String("role name", req.GetRole().GetName()))
+	if req.GetRole().GetName() == "" {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    "role name is empty",
+		}, nil
+	}
+	result, err := node.rootCoord.SaveRole(ctx, req)
+	if err != nil { // for error like conntext timeout etc.
+		log.Error("save role fail", zap.String("role name", req.GetRole().GetName()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, err
+}
+
+// DropRole drops the role and unbinds it from the users
+func (node *Proxy) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	log.Debug("DropRole", zap.String("role", typeutil.ProxyRole), zap.String("role name", req.GetRoleName()))
+	if req.GetRoleName() == "" {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    "role name is empty",
+		}, nil
+	}
+	result, err := node.rootCoord.DropRole(ctx, req)
+	if err != nil { // for error like conntext timeout etc.
+		log.Error("drop role fail", zap.String("role name", req.GetRoleName()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, err
+}
+
+// ListRoles returns the roles and their grants
+func (node *Proxy) ListRoles(ctx context.Context, req *milvuspb.ListRolesRequest) (*milvuspb.ListRolesResponse, error) {
+	log.Debug("ListRoles", zap.String("role", typeutil.ProxyRole))
+	result, err := node.rootCoord.ListRoles(ctx, req)
+	if err != nil { // for error like conntext timeout etc.
+		log.Error("list roles fail", zap.Error(err))
+		return &milvuspb.ListRolesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return result, err
+}
+
+// SaveUserRoles binds the roles to the user, an empty list of roles unbinds them
+func (node *Proxy) SaveUserRoles(ctx context.Context, req *milvuspb.SaveUserRolesRequest) (*commonpb.Status, error) {
+	log.Debug("SaveUserRoles", zap.String("role", typeutil.ProxyRole), zap.String("username", req.GetUsername()))
+	if req.GetUsername() == "" {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    "username is empty",
+		}, nil
+	}
+	result, err := node.rootCoord.SaveUserRoles(ctx, req)
+	if err != nil { // for error like conntext timeout etc.
+		log.Error("save user roles fail", zap.String("username", req.GetUsername()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, err
+}
+
+// ListUserRoles returns the roles bound to the users
+func (node *Proxy) ListUserRoles(ctx context.Context, req *milvuspb.ListUserRolesRequest) (*milvuspb.ListUserRolesResponse, error) {
+	log.Debug("ListUserRoles", zap.String("role", typeutil.ProxyRole))
+	result, err := node.rootCoord.ListUserRoles(ctx, req)
+	if err != nil { // for error like conntext timeout etc.
+		log.Error("list user roles fail", zap.Error(err))
+		return &milvuspb.ListUserRolesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return result, err
+}
+
+// CreateAPIKey creates an API key authenticating the requests as the user, the key is only returned here
+func (node *Proxy) CreateAPIKey(ctx context.Context, req *milvuspb.CreateAPIKeyRequest) (*milvuspb.CreateAPIKeyResponse, error) {
+	log.Debug("CreateAPIKey", zap.String("role", typeutil.ProxyRole), zap.String("username", req.GetUsername()))
+	if req.GetUsername() == "" {
+		return &milvuspb.CreateAPIKeyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    "username is empty",
+			},
+		}, nil
+	}
+	result, err := node.rootCoord.CreateAPIKey(ctx, req)
+	if err != nil { // for error like conntext timeout etc.
+		log.Error("create api key fail", zap.String("username", req.GetUsername()), zap.Error(err))
+		return &milvuspb.CreateAPIKeyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return result, err
+}
+
+// RevokeAPIKey revokes the API key
+func (node *Proxy) RevokeAPIKey(ctx context.Context, req *milvuspb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	log.Debug("RevokeAPIKey", zap.String("role", typeutil.ProxyRole))
+	if req.GetApiKey() == "" {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    "api key is empty",
+		}, nil
+	}
+	result, err := node.rootCoord.RevokeAPIKey(ctx, req)
+	if err != nil { // for error like conntext timeout etc.
+		log.Error("revoke api key fail", zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, err
+}
+
 // SendSearchResult needs to be removed TODO
 func (node *Proxy) SendSearchResult(ctx context.Context, req *internalpb.SearchResults) (*commonpb.Status, error) {
 	return &commonpb.Status{
@@ -0,0 +1,204 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"strconv"
+
+	ant_ast "github.com/antonmedv/expr/ast"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// jsonExistsFunc is the function checking the existence of a path of the JSON field, e.g. exists(field["a"]["b"])
+const jsonExistsFunc = "exists"
+
+// validateJSONFields checks the fields marked as JSON fields of the collection to create
+func validateJSONFields(schema *schemapb.CollectionSchema) error {
+	for _, field := range schema.GetFields() {
+		value, err := funcutil.GetAttrByKeyFromRepeatedKV(common.JSONFieldParam, field.GetTypeParams())
+		if err != nil {
+			continue
+		}
+		isJSON, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s %s of field %s, should be a boolean", common.JSONFieldParam, value, field.GetName())
+		}
+		if !isJSON {
+			continue
+		}
+		if field.GetDataType() != schemapb.DataType_VarChar {
+			return fmt.Errorf("the data type of the JSON field %s should be VarChar", field.GetName())
+		}
+		if field.GetIsPrimaryKey() || typeutil.IsPartitionKeyField(field) {
+			return fmt.Errorf("the JSON field %s couldn't be the primary key or the partition key", field.GetName())
+		}
+	}
+	return nil
+}
+
+// validateJSONFieldsData checks each value of the JSON fields to insert is a JSON object
+func validateJSONFieldsData(schema *schemapb.CollectionSchema, fieldsData []*schemapb.FieldData) error {
+	jsonFields := make(map[string]struct{})
+	for _, field := range schema.GetFields() {
+		if typeutil.IsJSONField(field) {
+			jsonFields[field.GetName()] = struct{}{}
+		}
+	}
+	for _, fieldData := range fieldsData {
+		if _, ok := jsonFields[fieldData.GetFieldName()]; !ok {
+			continue
+		}
+		for i, row := range fieldData.GetScalars().GetStringData().GetData() {
+			if _, err := typeutil.UnmarshalJSONObject(row); err != nil {
+				return fmt.Errorf("invalid value of the JSON field %s at row %d: %w", fieldData.GetFieldName(), i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// isQueryFilterExpr returns whether the node is a predicate on a JSON path or an array field
+func isQueryFilterExpr(node ant_ast.Node) bool {
+	return isJSONPathExpr(node) || isArrayFieldExpr(node)
+}
+
+// isQueryFilterFunction returns whether the node is a function on JSON paths or arrays, rather than an arithmetic one
+func isQueryFilterFunction(node ant_ast.Node) bool {
+	funcNode, ok := node.(*ant_ast.FunctionNode)
+	if !ok {
+		return false
+	}
+	switch funcNode.Name {
+	case jsonExistsFunc, typeutil.ArrayContains, typeutil.ArrayContainsAny, typeutil.ArrayLength:
+		return true
+	}
+	return false
+}
+
+// handleQueryFilterExpr creates the predicate on the JSON path or the array field, which is negated by not
+func (pc *parserContext) handleQueryFilterExpr(node ant_ast.Node, not bool) (*planpb.Expr, error) {
+	if isJSONPathExpr(node) {
+		return pc.handleJSONPathExpr(node, not)
+	}
+	return pc.handleArrayFieldExpr(node, not)
+}
+
+// isJSONPathExpr returns whether the node is a comparison on the path of a field, or the existence check of it
+func isJSONPathExpr(node ant_ast.Node) bool {
+	switch n := node.(type) {
+	case *ant_ast.FunctionNode:
+		return n.Name == jsonExistsFunc
+	case *ant_ast.BinaryNode:
+		switch n.Operator {
+		case "==", "!=", ">", ">=", "<", "<=":
+			_, leftPath := n.Left.(*ant_ast.IndexNode)
+			_, rightPath := n.Right.(*ant_ast.IndexNode)
+			return leftPath || rightPath
+		}
+	}
+	return false
+}
+
+// handleJSONPath returns the JSON field and the keys of the path, e.g. field["a"]["b"]
+func (pc *parserContext) handleJSONPath(node ant_ast.Node) (*schemapb.FieldSchema, []string, error) {
+	var path []string
+	for {
+		indexNode, ok := node.(*ant_ast.IndexNode)
+		if !ok {
+			break
+		}
+		key, ok := indexNode.Index.(*ant_ast.StringNode)
+		if !ok {
+			return nil, nil, fmt.Errorf("the keys of the path of the JSON field should be strings")
+		}
+		path = append([]string{key.Value}, path...)
+		node = indexNode.Node
+	}
+	idNode, ok := node.(*ant_ast.IdentifierNode)
+	if !ok || len(path) == 0 {
+		return nil, nil, fmt.Errorf("invalid path of the JSON field")
+	}
+	field, err := pc.schema.GetFieldFromName(idNode.Value)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !typeutil.IsJSONField(field) {
+		return nil, nil, fmt.Errorf("field %s is not a JSON field", field.GetName())
+	}
+	return field, path, nil
+}
+
+// handleJSONValue returns the value to compare with the path of the JSON field, the numbers are compared as float64
+func handleJSONValue(node ant_ast.Node) (interface{}, error) {
+	if boolNode := parseBoolNode(&node); boolNode != nil {
+		return boolNode.Value, nil
+	}
+	switch n := node.(type) {
+	case *ant_ast.IntegerNode:
+		return float64(n.Value), nil
+	case *ant_ast.FloatNode:
+		return n.Value, nil
+	case *ant_ast.StringNode:
+		return n.Value, nil
+	case *ant_ast.BoolNode:
+		return n.Value, nil
+	}
+	return nil, fmt.Errorf("the value compared with the path of the JSON field should be a number, a string or a bool")
+}
+
+// handleJSONPathExpr creates the JSONPathExpr on the path of the JSON field
+func (pc *parserContext) handleJSONPathExpr(node ant_ast.Node, not bool) (*planpb.Expr, error) {
+
+	var field *schemapb.FieldSchema
+	predicate := &typeutil.JSONPathPredicate{Not: not}
+	var err error
+	switch n := node.(type) {
+	case *ant_ast.FunctionNode:
+		if len(n.Arguments) != 1 {
+			return nil, fmt.Errorf("%s expects a path of the JSON field", jsonExistsFunc)
+		}
+		predicate.Op = typeutil.JSONPathExists
+		field, predicate.Path, err = pc.handleJSONPath(n.Arguments[0])
+		if err != nil {
+			return nil, err
+		}
+	case *ant_ast.BinaryNode:
+		pathNode, valueNode, op := n.Left, n.Right, n.Operator
+		if _, ok := pathNode.(*ant_ast.IndexNode); !ok {
+			pathNode, valueNode, op = n.Right, n.Left, opMap[getCompareOpType(n.Operator, true)]
+		}
+		predicate.Op = op
+		field, predicate.Path, err = pc.handleJSONPath(pathNode)
+		if err != nil {
+			return nil, err
+		}
+		predicate.Value, err = handleJSONValue(valueNode)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported predicate on the path of the JSON field")
+	}
+
+	return predicate.Expr(createColumnInfo(field))
+}
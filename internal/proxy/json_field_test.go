@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func newJSONFieldSchema() *schemapb.CollectionSchema {
+	schema := newDynamicFieldSchema()
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 103, Name: "meta", DataType: schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: common.JSONFieldParam, Value: "true"},
+			{Key: "max_length", Value: "1024"},
+		}})
+	return schema
+}
+
+func TestValidateJSONFields(t *testing.T) {
+	assert.NoError(t, validateJSONFields(newJSONFieldSchema()))
+
+	schema := newJSONFieldSchema()
+	schema.Fields[3].TypeParams[0].Value = "yes"
+	assert.Error(t, validateJSONFields(schema))
+
+	schema = newJSONFieldSchema()
+	schema.Fields[3].DataType = schemapb.DataType_Int64
+	assert.Error(t, validateJSONFields(schema))
+
+	schema = newJSONFieldSchema()
+	schema.Fields[3].IsPrimaryKey = true
+	assert.Error(t, validateJSONFields(schema))
+}
+
+func TestValidateJSONFieldsData(t *testing.T) {
+	schema := newJSONFieldSchema()
+	assert.NoError(t, validateJSONFieldsData(schema, []*schemapb.FieldData{
+		newStringFieldData("meta", `{"a": {"b": 1}}`, ""),
+		newStringFieldData("extra", `{}`, `{"c": "x"}`),
+	}))
+	assert.Error(t, validateJSONFieldsData(schema, []*schemapb.FieldData{
+		newStringFieldData("meta", `{"a": 1}`, `[1]`),
+	}))
+	assert.Error(t, validateJSONFieldsData(schema, []*schemapb.FieldData{
+		newStringFieldData("extra", `abc`),
+	}))
+}
+
+func TestCreateExprPlan_JSONPath(t *testing.T) {
+	schema := newJSONFieldSchema()
+
+	getPredicate := func(expr *planpb.Expr) *typeutil.JSONPathPredicate {
+		jsonPath := expr.GetJsonPathExpr()
+		assert.NotNil(t, jsonPath)
+		assert.True(t, typeutil.IsJSONField(schema.Fields[jsonPath.GetColumnInfo().GetFieldId()-100]))
+		p, err := typeutil.NewJSONPathPredicate(jsonPath)
+		assert.NoError(t, err)
+		return p
+	}
+
+	plan, err := createExprPlan(schema, `pk > 1 && meta["a"]["b"] > 1`)
+	assert.NoError(t, err)
+	assert.Equal(t, &typeutil.JSONPathPredicate{Path: []string{"a", "b"}, Op: ">", Value: float64(1)},
+		getPredicate(plan.GetPredicates().GetBinaryExpr().GetRight()))
+
+	plan, err = createExprPlan(schema, `"x" <= meta["a"] && pk > 1`)
+	assert.NoError(t, err)
+	assert.Equal(t, &typeutil.JSONPathPredicate{Path: []string{"a"}, Op: ">=", Value: "x"},
+		getPredicate(plan.GetPredicates().GetBinaryExpr().GetLeft()))
+
+	plan, err = createExprPlan(schema, `pk > 1 && exists(meta["a"]) && not exists(extra["c"])`)
+	assert.NoError(t, err)
+	and := plan.GetPredicates().GetBinaryExpr()
+	assert.NotNil(t, and)
+	assert.Equal(t, &typeutil.JSONPathPredicate{Path: []string{"c"}, Op: typeutil.JSONPathExists, Not: true},
+		getPredicate(and.GetRight()))
+	assert.Equal(t, &typeutil.JSONPathPredicate{Path: []string{"a"}, Op: typeutil.JSONPathExists},
+		getPredicate(and.GetLeft().GetBinaryExpr().GetRight()))
+
+	plan, err = createExprPlan(schema, `pk in [1, 2] && meta["a"] == true`)
+	assert.NoError(t, err)
+	assert.Equal(t, &typeutil.JSONPathPredicate{Path: []string{"a"}, Op: "==", Value: true},
+		getPredicate(plan.GetPredicates().GetBinaryExpr().GetRight()))
+
+	invalids := []string{
+		`pk["a"] > 1`,
+		`meta[1] > 1`,
+		`meta["a"] > true`,
+		`meta["a"] > pk`,
+		`exists(meta)`,
+		`exists(meta["a"], meta["b"])`,
+		`unknown(meta["a"])`,
+		`meta == "{}"`,
+		`extra in ["{}"]`,
+	}
+	for _, expr := range invalids {
+		_, err := createExprPlan(schema, expr)
+		assert.Error(t, err, expr)
+	}
+
+	// the predicates on JSON paths are evaluated by segcore wherever they are, in searches as well
+	valids := []string{
+		`meta["a"]["b"] == 1`,
+		`exists(meta["a"]) && not exists(extra["c"])`,
+		`pk > 1 || meta["a"] > 1`,
+		`not (pk > 1 && meta["a"] > 1)`,
+		`pk > 1 && (pk < 10 || meta["a"] > 1)`,
+	}
+	for _, expr := range valids {
+		_, err := createExprPlan(schema, expr)
+		assert.NoError(t, err, expr)
+	}
+	plan, err = createQueryPlan(schema, `pk > 1 && meta["a"] > 1`, "vec", &planpb.QueryInfo{Topk: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, &typeutil.JSONPathPredicate{Path: []string{"a"}, Op: ">", Value: float64(1)},
+		getPredicate(plan.GetVectorAnns().GetPredicates().GetBinaryExpr().GetRight()))
+	plan, err = createQueryPlan(schema, `meta["a"]["b"] == 1`, "vec", &planpb.QueryInfo{Topk: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, &typeutil.JSONPathPredicate{Path: []string{"a", "b"}, Op: "==", Value: float64(1)},
+		getPredicate(plan.GetVectorAnns().GetPredicates()))
+}
+
+func TestCreateExprPlan_JSONPathNull(t *testing.T) {
+	schema := newJSONFieldSchema()
+
+	plan, err := createExprPlan(schema, `meta["a"]["b"] is null`)
+	assert.NoError(t, err)
+	nullExpr := plan.GetPredicates().GetNullExpr()
+	assert.Equal(t, planpb.NullExpr_IsNull, nullExpr.GetOp())
+	assert.Equal(t, int64(103), nullExpr.GetColumnInfo().GetFieldId())
+	assert.Equal(t, []string{"a", "b"}, nullExpr.GetPath())
+
+	// the keys of the dynamic field are the paths of it
+	plan, err = createExprPlan(schema, `pk > 1 && color IS NOT NULL`)
+	assert.NoError(t, err)
+	nullExpr = plan.GetPredicates().GetBinaryExpr().GetRight().GetNullExpr()
+	assert.Equal(t, planpb.NullExpr_IsNotNull, nullExpr.GetOp())
+	assert.Equal(t, int64(101), nullExpr.GetColumnInfo().GetFieldId())
+	assert.Equal(t, []string{"color"}, nullExpr.GetPath())
+
+	invalids := []string{
+		`meta is null`,
+		`meta[1] is null`,
+		`pk["a"] is null`,
+		`exists(meta["a"]) is null`,
+	}
+	for _, expr := range invalids {
+		_, err := createExprPlan(schema, expr)
+		assert.Error(t, err, expr)
+	}
+}
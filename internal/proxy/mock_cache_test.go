@@ -9,27 +9,36 @@ import (
 
 type getCollectionIDFunc func(ctx context.Context, collectionName string) (typeutil.UniqueID, error)
 type getCollectionSchemaFunc func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error)
+type getPartitionsFunc func(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error)
 
 type mockCache struct {
 	Cache
 	getIDFunc     getCollectionIDFunc
 	getSchemaFunc getCollectionSchemaFunc
+	getPartsFunc  getPartitionsFunc
 }
 
-func (m *mockCache) GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+func (m *mockCache) GetCollectionID(ctx context.Context, database string, collectionName string) (typeutil.UniqueID, error) {
 	if m.getIDFunc != nil {
 		return m.getIDFunc(ctx, collectionName)
 	}
 	return 0, nil
 }
 
-func (m *mockCache) GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+func (m *mockCache) GetCollectionSchema(ctx context.Context, database string, collectionName string) (*schemapb.CollectionSchema, error) {
 	if m.getSchemaFunc != nil {
 		return m.getSchemaFunc(ctx, collectionName)
 	}
 	return nil, nil
 }
 
+func (m *mockCache) GetPartitions(ctx context.Context, database string, collectionName string) (map[string]typeutil.UniqueID, error) {
+	if m.getPartsFunc != nil {
+		return m.getPartsFunc(ctx, collectionName)
+	}
+	return nil, nil
+}
+
 func (m *mockCache) setGetIDFunc(f getCollectionIDFunc) {
 	m.getIDFunc = f
 }
@@ -38,6 +47,10 @@ func (m *mockCache) setGetSchemaFunc(f getCollectionSchemaFunc) {
 	m.getSchemaFunc = f
 }
 
+func (m *mockCache) setGetPartitionsFunc(f getPartitionsFunc) {
+	m.getPartsFunc = f
+}
+
 func newMockCache() *mockCache {
 	return &mockCache{}
 }
@@ -0,0 +1,205 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// getPartitionKeyPartitionName returns the name of the implicit partition of the index
+func getPartitionKeyPartitionName(index int64) string {
+	return typeutil.GetPartitionKeyPartitionName(Params.CommonCfg.DefaultPartitionName, index)
+}
+
+// validatePartitionKey checks the partition key of the collection to create, the number of the implicit partitions
+// is set to the default if not specified.
+func validatePartitionKey(schema *schemapb.CollectionSchema) error {
+	var keyField *schemapb.FieldSchema
+	for _, field := range schema.GetFields() {
+		if !typeutil.IsPartitionKeyField(field) {
+			continue
+		}
+		if keyField != nil {
+			return fmt.Errorf("there are more than one partition key, field name = %s, %s", keyField.GetName(), field.GetName())
+		}
+		keyField = field
+	}
+	if keyField == nil {
+		return nil
+	}
+
+	if keyField.GetIsPrimaryKey() {
+		return fmt.Errorf("the primary key %s couldn't be the partition key", keyField.GetName())
+	}
+	if keyField.GetDataType() != schemapb.DataType_Int64 && keyField.GetDataType() != schemapb.DataType_VarChar {
+		return fmt.Errorf("the data type of the partition key %s should be Int64 or VarChar", keyField.GetName())
+	}
+	if _, err := funcutil.GetAttrByKeyFromRepeatedKV(common.PartitionKeyNumParam, keyField.GetTypeParams()); err != nil {
+		keyField.TypeParams = append(keyField.TypeParams, &commonpb.KeyValuePair{
+			Key:   common.PartitionKeyNumParam,
+			Value: strconv.FormatInt(Params.ProxyCfg.PartitionKeyNum, 10),
+		})
+	}
+	num, err := typeutil.GetPartitionKeyNum(keyField)
+	if err != nil {
+		return err
+	}
+	if num <= 0 || num > Params.RootCoordCfg.MaxPartitionNum-1 {
+		return fmt.Errorf("%s of the partition key %s should be in range [1, %d]", common.PartitionKeyNumParam,
+			keyField.GetName(), Params.RootCoordCfg.MaxPartitionNum-1)
+	}
+	return nil
+}
+
+// checkPartitionKeyNotSet returns error if the partitions of the collection are managed by the partition key
+func checkPartitionKeyNotSet(ctx context.Context, database string, collectionName string) error {
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, database, collectionName)
+	if err != nil {
+		return err
+	}
+	if keyField := typeutil.GetPartitionKeyFieldSchema(schema); keyField != nil {
+		return fmt.Errorf("the partitions of collection %s are managed by the partition key %s", collectionName, keyField.GetName())
+	}
+	return nil
+}
+
+// hashPartitionKey returns the index of the implicit partition the key is routed to
+func hashPartitionKey(key interface{}, num int64) (int64, error) {
+	switch v := key.(type) {
+	case int64:
+		hash, err := typeutil.Hash32Int64(v)
+		if err != nil {
+			return 0, err
+		}
+		return int64(hash) % num, nil
+	case string:
+		return int64(typeutil.HashString2Uint32(v)) % num, nil
+	default:
+		return 0, fmt.Errorf("unsupported partition key %v", key)
+	}
+}
+
+// hashPartitionKeys returns the indexes of the implicit partitions of the rows
+func hashPartitionKeys(fieldData *schemapb.FieldData, num int64) ([]int64, error) {
+	indexes := make([]int64, 0)
+	switch fieldData.GetType() {
+	case schemapb.DataType_Int64:
+		for _, key := range fieldData.GetScalars().GetLongData().GetData() {
+			index, err := hashPartitionKey(key, num)
+			if err != nil {
+				return nil, err
+			}
+			indexes = append(indexes, index)
+		}
+	case schemapb.DataType_VarChar:
+		for _, key := range fieldData.GetScalars().GetStringData().GetData() {
+			index, err := hashPartitionKey(key, num)
+			if err != nil {
+				return nil, err
+			}
+			indexes = append(indexes, index)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported data type %s of the partition key %s", fieldData.GetType().String(), fieldData.GetFieldName())
+	}
+	return indexes, nil
+}
+
+// partitionKeyValues returns the values of the partition key the entities matching the expression take,
+// false if the expression doesn't restrict the partition key to some values.
+func partitionKeyValues(expr *planpb.Expr, fieldID int64) ([]*planpb.GenericValue, bool) {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_TermExpr:
+		if e.TermExpr.GetColumnInfo().GetFieldId() == fieldID {
+			return e.TermExpr.GetValues(), true
+		}
+	case *planpb.Expr_UnaryRangeExpr:
+		if e.UnaryRangeExpr.GetColumnInfo().GetFieldId() == fieldID && e.UnaryRangeExpr.GetOp() == planpb.OpType_Equal {
+			return []*planpb.GenericValue{e.UnaryRangeExpr.GetValue()}, true
+		}
+	case *planpb.Expr_BinaryExpr:
+		left, leftOk := partitionKeyValues(e.BinaryExpr.GetLeft(), fieldID)
+		right, rightOk := partitionKeyValues(e.BinaryExpr.GetRight(), fieldID)
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			// either side restricts the matched entities
+			if leftOk {
+				return left, true
+			}
+			return right, rightOk
+		case planpb.BinaryExpr_LogicalOr:
+			if leftOk && rightOk {
+				return append(append([]*planpb.GenericValue{}, left...), right...), true
+			}
+		}
+	}
+	return nil, false
+}
+
+// prunePartitionsByKey returns the IDs of the implicit partitions the entities matching the expression are in,
+// false if the collection has no partition key or the expression doesn't restrict it.
+func prunePartitionsByKey(schema *schemapb.CollectionSchema, expr *planpb.Expr, partitionsMap map[string]UniqueID) ([]UniqueID, bool, error) {
+	keyField := typeutil.GetPartitionKeyFieldSchema(schema)
+	if keyField == nil {
+		return nil, false, nil
+	}
+	values, ok := partitionKeyValues(expr, keyField.GetFieldID())
+	if !ok || len(values) == 0 {
+		return nil, false, nil
+	}
+	num, err := typeutil.GetPartitionKeyNum(keyField)
+	if err != nil {
+		return nil, false, err
+	}
+
+	partitionIDs := make([]UniqueID, 0, len(values))
+	pruned := make(map[UniqueID]struct{})
+	for _, value := range values {
+		var key interface{}
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			key = v.Int64Val
+		case *planpb.GenericValue_StringVal:
+			key = v.StringVal
+		default:
+			return nil, false, nil
+		}
+		index, err := hashPartitionKey(key, num)
+		if err != nil {
+			return nil, false, err
+		}
+		partitionName := getPartitionKeyPartitionName(index)
+		partitionID, ok := partitionsMap[partitionName]
+		if !ok {
+			return nil, false, fmt.Errorf("partition %s of the partition key not found", partitionName)
+		}
+		if _, ok := pruned[partitionID]; !ok {
+			pruned[partitionID] = struct{}{}
+			partitionIDs = append(partitionIDs, partitionID)
+		}
+	}
+	return partitionIDs, true, nil
+}
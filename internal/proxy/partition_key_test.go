@@ -0,0 +1,224 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func newPartitionKeySchema(keyType schemapb.DataType, keyParams ...*commonpb.KeyValuePair) *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "key", DataType: keyType, TypeParams: append([]*commonpb.KeyValuePair{
+				{Key: common.PartitionKeyParam, Value: "true"},
+				{Key: "max_length", Value: "16"},
+			}, keyParams...)},
+			{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int64},
+			{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+}
+
+func TestValidatePartitionKey(t *testing.T) {
+	Params.Init()
+
+	// the default number of the partitions is set
+	schema := newPartitionKeySchema(schemapb.DataType_Int64)
+	assert.NoError(t, validatePartitionKey(schema))
+	num, err := typeutil.GetPartitionKeyNum(typeutil.GetPartitionKeyFieldSchema(schema))
+	assert.NoError(t, err)
+	assert.Equal(t, Params.ProxyCfg.PartitionKeyNum, num)
+
+	schema = newPartitionKeySchema(schemapb.DataType_VarChar, &commonpb.KeyValuePair{Key: common.PartitionKeyNumParam, Value: "4"})
+	assert.NoError(t, validatePartitionKey(schema))
+	num, err = typeutil.GetPartitionKeyNum(typeutil.GetPartitionKeyFieldSchema(schema))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), num)
+
+	// no partition key
+	assert.NoError(t, validatePartitionKey(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64,
+			TypeParams: []*commonpb.KeyValuePair{{Key: common.PartitionKeyParam, Value: "false"}}},
+	}}))
+
+	assert.Error(t, validatePartitionKey(newPartitionKeySchema(schemapb.DataType_Float)))
+	assert.Error(t, validatePartitionKey(newPartitionKeySchema(schemapb.DataType_Int64,
+		&commonpb.KeyValuePair{Key: common.PartitionKeyNumParam, Value: "0"})))
+	assert.Error(t, validatePartitionKey(newPartitionKeySchema(schemapb.DataType_Int64,
+		&commonpb.KeyValuePair{Key: common.PartitionKeyNumParam, Value: "a"})))
+
+	// more than one partition key
+	schema = newPartitionKeySchema(schemapb.DataType_Int64)
+	schema.Fields[2].TypeParams = []*commonpb.KeyValuePair{{Key: common.PartitionKeyParam, Value: "true"}}
+	assert.Error(t, validatePartitionKey(schema))
+
+	// the primary key
+	schema = newPartitionKeySchema(schemapb.DataType_Int64)
+	schema.Fields[0].TypeParams = []*commonpb.KeyValuePair{{Key: common.PartitionKeyParam, Value: "true"}}
+	schema.Fields[1].TypeParams = nil
+	assert.Error(t, validatePartitionKey(schema))
+}
+
+func TestHashPartitionKeys(t *testing.T) {
+	indexes, err := hashPartitionKeys(&schemapb.FieldData{
+		Type: schemapb.DataType_Int64,
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 1}}},
+		}},
+	}, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(indexes))
+	assert.Equal(t, indexes[0], indexes[2])
+	for _, index := range indexes {
+		assert.True(t, index >= 0 && index < 4)
+	}
+	index, err := hashPartitionKey(int64(2), 4)
+	assert.NoError(t, err)
+	assert.Equal(t, indexes[1], index)
+
+	indexes, err = hashPartitionKeys(&schemapb.FieldData{
+		Type: schemapb.DataType_VarChar,
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "b"}}},
+		}},
+	}, 4)
+	assert.NoError(t, err)
+	index, err = hashPartitionKey("b", 4)
+	assert.NoError(t, err)
+	assert.Equal(t, indexes[1], index)
+
+	_, err = hashPartitionKeys(&schemapb.FieldData{Type: schemapb.DataType_Float}, 4)
+	assert.Error(t, err)
+	_, err = hashPartitionKey(1.0, 4)
+	assert.Error(t, err)
+}
+
+func TestPrunePartitionsByKey(t *testing.T) {
+	Params.Init()
+	schema := newPartitionKeySchema(schemapb.DataType_Int64, &commonpb.KeyValuePair{Key: common.PartitionKeyNumParam, Value: "4"})
+	partitionsMap := map[string]UniqueID{}
+	for i := int64(0); i < 4; i++ {
+		partitionsMap[getPartitionKeyPartitionName(i)] = 1000 + i
+	}
+	partitionOf := func(key int64) UniqueID {
+		index, err := hashPartitionKey(key, 4)
+		assert.NoError(t, err)
+		return 1000 + index
+	}
+
+	prune := func(expr string) ([]UniqueID, bool) {
+		plan, err := createExprPlan(schema, expr)
+		assert.NoError(t, err)
+		partitionIDs, pruned, err := prunePartitionsByKey(schema, plan.GetPredicates(), partitionsMap)
+		assert.NoError(t, err)
+		return partitionIDs, pruned
+	}
+
+	partitionIDs, pruned := prune("key == 1")
+	assert.True(t, pruned)
+	assert.Equal(t, []UniqueID{partitionOf(1)}, partitionIDs)
+
+	partitionIDs, pruned = prune("age > 10 && key in [1, 2]")
+	assert.True(t, pruned)
+	assert.ElementsMatch(t, uniquePartitionIDs(partitionOf(1), partitionOf(2)), partitionIDs)
+
+	partitionIDs, pruned = prune("key == 1 || key == 3")
+	assert.True(t, pruned)
+	assert.ElementsMatch(t, uniquePartitionIDs(partitionOf(1), partitionOf(3)), partitionIDs)
+
+	// not restricted
+	for _, expr := range []string{"age > 10", "key > 1", "key == 1 || age == 3", "not (key == 1)"} {
+		_, pruned = prune(expr)
+		assert.False(t, pruned, expr)
+	}
+
+	// the implicit partition missing
+	plan, err := createExprPlan(schema, "key == 1")
+	assert.NoError(t, err)
+	_, _, err = prunePartitionsByKey(schema, plan.GetPredicates(), map[string]UniqueID{})
+	assert.Error(t, err)
+
+	// no partition key
+	schema.Fields[1].TypeParams = nil
+	_, pruned = prune("key == 1")
+	assert.False(t, pruned)
+}
+
+func uniquePartitionIDs(ids ...UniqueID) []UniqueID {
+	set := make(map[UniqueID]struct{})
+	unique := make([]UniqueID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := set[id]; !ok {
+			set[id] = struct{}{}
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+func TestInsertTask_groupByPartition(t *testing.T) {
+	Params.Init()
+	schema := newPartitionKeySchema(schemapb.DataType_Int64, &commonpb.KeyValuePair{Key: common.PartitionKeyNumParam, Value: "4"})
+	it := &insertTask{}
+	it.CollectionName = "coll"
+	it.FieldsData = []*schemapb.FieldData{{
+		Type:    schemapb.DataType_Int64,
+		FieldId: 101,
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 1, 3}}},
+		}},
+	}}
+	assert.NoError(t, it.hashPartitionKeys(schema))
+	it.partitionKeyIDs = []UniqueID{1000, 1001, 1002, 1003}
+
+	rows := 0
+	for _, group := range it.groupByPartition([]int{0, 1, 2, 3}) {
+		for _, offset := range group.rowOffsets {
+			assert.Equal(t, it.partitionKeyIDs[it.partitionKeyIndexes[offset]], group.partitionID)
+			assert.Equal(t, getPartitionKeyPartitionName(it.partitionKeyIndexes[offset]), group.partitionName)
+			rows++
+		}
+	}
+	assert.Equal(t, 4, rows)
+
+	// not allowed to specify the partition
+	it.PartitionName = "p1"
+	assert.Error(t, it.hashPartitionKeys(schema))
+
+	// the partition key missing
+	it.PartitionName = ""
+	it.FieldsData = nil
+	assert.Error(t, it.hashPartitionKeys(schema))
+
+	// no partition key
+	schema.Fields[1].TypeParams = nil
+	assert.NoError(t, it.hashPartitionKeys(schema))
+	it.PartitionID = 1
+	groups := it.groupByPartition([]int{0, 1})
+	assert.Equal(t, 1, len(groups))
+	assert.Equal(t, UniqueID(1), groups[0].partitionID)
+}
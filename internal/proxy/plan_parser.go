@@ -22,7 +22,8 @@ import (
 	"strings"
 
 	ant_ast "github.com/antonmedv/expr/ast"
-	ant_parser "github.com/antonmedv/expr/parser"
+	"github.com/milvus-io/milvus/internal/parser/exprparser"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -30,6 +31,8 @@ import (
 
 type parserContext struct {
 	schema *typeutil.SchemaHelper
+	// template collects the placeholders while compiling an expression template, nil for plain expressions
+	template *planTemplate
 }
 
 type optimizer struct {
@@ -99,7 +102,7 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 				patch(&ant_ast.FunctionNode{Name: funcName, Arguments: []ant_ast.Node{identifierNodeRight, floatNodeLeft}})
 			} else if leftInteger && rightIdentifier {
 				patch(&ant_ast.FunctionNode{Name: funcName, Arguments: []ant_ast.Node{identifierNodeRight, integerNodeLeft}})
-			} else {
+			} else if !isArithOperand(node.Left) || !isArithOperand(node.Right) {
 				optimizer.err = fmt.Errorf("invalid data type")
 				return
 			}
@@ -121,13 +124,7 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 				patch(&ant_ast.FunctionNode{Name: funcName, Arguments: []ant_ast.Node{identifierNodeLeft, floatNodeRight}})
 			} else if leftIdentifier && rightInteger {
 				patch(&ant_ast.FunctionNode{Name: funcName, Arguments: []ant_ast.Node{identifierNodeLeft, integerNodeRight}})
-			} else if leftFloat && rightIdentifier {
-				optimizer.err = fmt.Errorf("field as right operand is not yet supported for (%s) operator", node.Operator)
-				return
-			} else if leftInteger && rightIdentifier {
-				optimizer.err = fmt.Errorf("field as right operand is not yet supported for (%s) operator", node.Operator)
-				return
-			} else {
+			} else if !isArithOperand(node.Left) || !isArithOperand(node.Right) {
 				optimizer.err = fmt.Errorf("invalid data type")
 				return
 			}
@@ -153,7 +150,7 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 				patch(&ant_ast.FunctionNode{Name: funcName, Arguments: []ant_ast.Node{identifierNodeRight, floatNodeLeft}})
 			} else if leftInteger && rightIdentifier {
 				patch(&ant_ast.FunctionNode{Name: funcName, Arguments: []ant_ast.Node{identifierNodeRight, integerNodeLeft}})
-			} else {
+			} else if !isArithOperand(node.Left) || !isArithOperand(node.Right) {
 				optimizer.err = fmt.Errorf("invalid data type")
 				return
 			}
@@ -199,13 +196,7 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 					return
 				}
 				patch(&ant_ast.FunctionNode{Name: funcName, Arguments: []ant_ast.Node{identifierNodeLeft, integerNodeRight}})
-			} else if leftFloat && rightIdentifier {
-				optimizer.err = fmt.Errorf("field as right operand is not yet supported for (%s) operator", node.Operator)
-				return
-			} else if leftInteger && rightIdentifier {
-				optimizer.err = fmt.Errorf("field as right operand is not yet supported for (%s) operator", node.Operator)
-				return
-			} else {
+			} else if !isArithOperand(node.Left) || !isArithOperand(node.Right) {
 				optimizer.err = fmt.Errorf("invalid data type")
 				return
 			}
@@ -227,10 +218,7 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 					return
 				}
 				patch(&ant_ast.FunctionNode{Name: funcName, Arguments: []ant_ast.Node{identifierNodeLeft, integerNodeRight}})
-			} else if leftInteger && rightIdentifier {
-				optimizer.err = fmt.Errorf("field as right operand is not yet supported for (%s) operator", node.Operator)
-				return
-			} else {
+			} else if !isArithOperand(node.Left) || !isArithOperand(node.Right) {
 				optimizer.err = fmt.Errorf("invalid data type")
 				return
 			}
@@ -252,26 +240,34 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 }
 
 func parseExpr(schema *typeutil.SchemaHelper, exprStr string) (*planpb.Expr, error) {
+	return parseExprWithContext(&parserContext{schema: schema}, exprStr)
+}
+
+func parseExprWithContext(pc *parserContext, exprStr string) (*planpb.Expr, error) {
 	if exprStr == "" {
 		return nil, nil
 	}
-	ast, err := ant_parser.Parse(exprStr)
+	node, err := exprparser.Parse(exprStr)
 	if err != nil {
 		return nil, err
 	}
 
 	optimizer := &optimizer{}
-	ant_ast.Walk(&ast.Node, optimizer)
+	ant_ast.Walk(&node, optimizer)
 	if optimizer.err != nil {
 		return nil, optimizer.err
 	}
 
-	pc := parserContext{schema}
-	expr, err := pc.handleExpr(&ast.Node)
+	// the walker of ant doesn't know placeholder nodes, so they are patched at last
+	ant_ast.Walk(&node, &placeholderPatcher{})
+
+	// the keys of the dynamic field are filtered by their names as if they were declared
+	pc.patchDynamicKeys(&node)
+
+	expr, err := pc.handleExpr(&node)
 	if err != nil {
 		return nil, err
 	}
-
 	return expr, nil
 }
 
@@ -390,6 +386,38 @@ func getFuncNameByNodeOp(nodeOp string) (string, error) {
 	return funcName, nil
 }
 
+// isArithExpr returns whether the node is an arithmetic expression left by the optimizer, the ones of a field and a
+// constant are optimized to function nodes, the others are evaluated row by row, e.g. a + b
+func isArithExpr(node ant_ast.Node) bool {
+	binNode, ok := node.(*ant_ast.BinaryNode)
+	if !ok {
+		return false
+	}
+	_, err := getFuncNameByNodeOp(binNode.Operator)
+	return err == nil
+}
+
+// isArithOperand returns whether the node could be an operand of the arithmetic expressions
+func isArithOperand(node ant_ast.Node) bool {
+	switch n := node.(type) {
+	case *ant_ast.IdentifierNode, *ant_ast.IntegerNode, *ant_ast.FloatNode:
+		return true
+	case *ant_ast.FunctionNode:
+		_, err := getArithOpType(n.Name)
+		return err == nil
+	}
+	return isArithExpr(node)
+}
+
+// isLeafValue returns whether the node is a constant or a placeholder
+func isLeafValue(node ant_ast.Node) bool {
+	switch node.(type) {
+	case *ant_ast.IntegerNode, *ant_ast.FloatNode, *ant_ast.BoolNode, *ant_ast.StringNode, *placeholderNode:
+		return true
+	}
+	return false
+}
+
 func parseBoolNode(nodeRaw *ant_ast.Node) *ant_ast.BoolNode {
 	switch node := (*nodeRaw).(type) {
 	case *ant_ast.IdentifierNode:
@@ -490,7 +518,7 @@ func (pc *parserContext) createCmpExpr(left, right ant_ast.Node, operator string
 
 func (pc *parserContext) createBinaryArithOpEvalExpr(left *ant_ast.FunctionNode, right *ant_ast.Node, operator string) (*planpb.Expr, error) {
 	switch operator {
-	case "==", "!=":
+	case "==", "!=", "<", "<=", ">", ">=":
 		binArithOp, err := pc.handleFunction(left)
 		if err != nil {
 			return nil, fmt.Errorf("createBinaryArithOpEvalExpr: %v", err)
@@ -525,8 +553,9 @@ func (pc *parserContext) handleBinaryArithCmpExpr(node *ant_ast.BinaryNode) (*pl
 	leftNode, funcNodeLeft := node.Left.(*ant_ast.FunctionNode)
 	rightNode, funcNodeRight := node.Right.(*ant_ast.FunctionNode)
 
-	if funcNodeLeft && funcNodeRight {
-		return nil, fmt.Errorf("left and right are both expression are not supported")
+	if funcNodeLeft && !isLeafValue(node.Right) || funcNodeRight && !isLeafValue(node.Left) {
+		// the function node is compared with a field or another arithmetic expression
+		return pc.handleArithCompareExpr(node)
 	} else if funcNodeRight {
 		// Only the right node is a function node
 		op := getCompareOpType(node.Operator, true)
@@ -543,12 +572,195 @@ func (pc *parserContext) handleBinaryArithCmpExpr(node *ant_ast.BinaryNode) (*pl
 	}
 }
 
+// handleArithCompareExpr creates the comparison of the arithmetic expressions of the numeric fields, which is
+// evaluated row by row, e.g. a + b > c * 2. The constant is always the right side, and compared as the data type of
+// the left side, the same as planparserv2.
+func (pc *parserContext) handleArithCompareExpr(node *ant_ast.BinaryNode) (*planpb.Expr, error) {
+	left, right, operator := node.Left, node.Right, node.Operator
+	if isLeafValue(left) {
+		left, right, operator = right, left, opMap[getCompareOpType(operator, true)]
+	}
+	op := getCompareOpType(operator, false)
+	switch op {
+	case planpb.OpType_Equal, planpb.OpType_NotEqual, planpb.OpType_GreaterThan, planpb.OpType_GreaterEqual,
+		planpb.OpType_LessThan, planpb.OpType_LessEqual:
+	default:
+		return nil, fmt.Errorf("operator(%s) not yet supported for arithmetic expressions", node.Operator)
+	}
+
+	leftExpr, leftType, err := pc.handleArithOperand(left)
+	if err != nil {
+		return nil, err
+	}
+	var rightExpr *planpb.Expr
+	if isLeafValue(right) {
+		val, err := pc.handleLeafValue(&right, leftType)
+		if err != nil {
+			return nil, err
+		}
+		rightExpr = &planpb.Expr{
+			Expr: &planpb.Expr_ValueExpr{
+				ValueExpr: &planpb.ValueExpr{
+					Value: val,
+				},
+			},
+		}
+	} else {
+		rightExpr, _, err = pc.handleArithOperand(right)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_ArithCompareExpr{
+			ArithCompareExpr: &planpb.ArithCompareExpr{
+				Left:  leftExpr,
+				Right: rightExpr,
+				Op:    op,
+			},
+		},
+	}
+	return expr, nil
+}
+
+// handleArithOperand returns the operand of the arithmetic expressions and its data type, the constants are kept as
+// they are written, and the arithmetic expressions are computed as int64 or double
+func (pc *parserContext) handleArithOperand(node ant_ast.Node) (*planpb.Expr, schemapb.DataType, error) {
+	switch n := node.(type) {
+	case *ant_ast.IdentifierNode:
+		field, err := pc.handleIdentifier(n)
+		if err != nil {
+			return nil, schemapb.DataType_None, err
+		}
+		if !typeutil.IsArithmetic(field.DataType) {
+			return nil, schemapb.DataType_None, fmt.Errorf("field %s of %s couldn't be used in arithmetic expressions",
+				field.Name, field.DataType.String())
+		}
+		expr := &planpb.Expr{
+			Expr: &planpb.Expr_ColumnExpr{
+				ColumnExpr: &planpb.ColumnExpr{
+					Info: createColumnInfo(field),
+				},
+			},
+		}
+		return expr, field.DataType, nil
+	case *ant_ast.IntegerNode:
+		return createValueExpr(&planpb.GenericValue{
+			Val: &planpb.GenericValue_Int64Val{
+				Int64Val: int64(n.Value),
+			},
+		}), schemapb.DataType_Int64, nil
+	case *ant_ast.FloatNode:
+		return createValueExpr(&planpb.GenericValue{
+			Val: &planpb.GenericValue_FloatVal{
+				FloatVal: n.Value,
+			},
+		}), schemapb.DataType_Double, nil
+	case *ant_ast.FunctionNode:
+		op, err := getArithOpType(n.Name)
+		if err != nil {
+			return nil, schemapb.DataType_None, err
+		}
+		if len(n.Arguments) != 2 {
+			return nil, schemapb.DataType_None, fmt.Errorf("function %s expects 2 operands", n.Name)
+		}
+		return pc.handleArithExpr(op, n.Arguments[0], n.Arguments[1])
+	case *ant_ast.BinaryNode:
+		funcName, err := getFuncNameByNodeOp(n.Operator)
+		if err != nil {
+			return nil, schemapb.DataType_None, err
+		}
+		op, err := getArithOpType(funcName)
+		if err != nil {
+			return nil, schemapb.DataType_None, err
+		}
+		return pc.handleArithExpr(op, n.Left, n.Right)
+	}
+	return nil, schemapb.DataType_None, fmt.Errorf("unsupported operand of arithmetic expressions")
+}
+
+func (pc *parserContext) handleArithExpr(op planpb.ArithOpType, leftNode, rightNode ant_ast.Node) (*planpb.Expr, schemapb.DataType, error) {
+	left, leftType, err := pc.handleArithOperand(leftNode)
+	if err != nil {
+		return nil, schemapb.DataType_None, err
+	}
+	right, rightType, err := pc.handleArithOperand(rightNode)
+	if err != nil {
+		return nil, schemapb.DataType_None, err
+	}
+
+	switch op {
+	case planpb.ArithOpType_Div:
+		if isZeroValueExpr(right) {
+			return nil, schemapb.DataType_None, fmt.Errorf("divide by zero")
+		}
+	case planpb.ArithOpType_Mod:
+		if !typeutil.IsIntegerType(leftType) || !typeutil.IsIntegerType(rightType) {
+			return nil, schemapb.DataType_None, fmt.Errorf("modulo can only apply on integer types")
+		}
+		if isZeroValueExpr(right) {
+			return nil, schemapb.DataType_None, fmt.Errorf("modulo by zero")
+		}
+	}
+
+	dataType, err := getArithDataType(leftType, rightType, left.GetValueExpr() != nil, right.GetValueExpr() != nil)
+	if err != nil {
+		return nil, schemapb.DataType_None, err
+	}
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_BinaryArithExpr{
+			BinaryArithExpr: &planpb.BinaryArithExpr{
+				Left:  left,
+				Right: right,
+				Op:    op,
+			},
+		},
+	}
+	return expr, dataType, nil
+}
+
+// getArithDataType returns the data type of the arithmetic expression, the integers are computed as int64 and the
+// others as double. The floating constants couldn't apply on the integer fields, the same as the comparisons.
+func getArithDataType(left, right schemapb.DataType, leftConstant, rightConstant bool) (schemapb.DataType, error) {
+	if leftConstant || !rightConstant && typeutil.IsFloatingType(right) {
+		left, right = right, left
+	}
+	if typeutil.IsFloatingType(left) && typeutil.IsArithmetic(right) {
+		return schemapb.DataType_Double, nil
+	}
+	if typeutil.IsIntegerType(left) && typeutil.IsIntegerType(right) {
+		return schemapb.DataType_Int64, nil
+	}
+	return schemapb.DataType_None, fmt.Errorf("incompatible data type, %s, %s", left.String(), right.String())
+}
+
+func createValueExpr(value *planpb.GenericValue) *planpb.Expr {
+	return &planpb.Expr{
+		Expr: &planpb.Expr_ValueExpr{
+			ValueExpr: &planpb.ValueExpr{
+				Value: value,
+			},
+		},
+	}
+}
+
+func isZeroValueExpr(expr *planpb.Expr) bool {
+	value := expr.GetValueExpr().GetValue()
+	switch v := value.GetVal().(type) {
+	case *planpb.GenericValue_Int64Val:
+		return v.Int64Val == 0
+	case *planpb.GenericValue_FloatVal:
+		return v.FloatVal == 0
+	}
+	return false
+}
+
 func (pc *parserContext) handleLogicalExpr(node *ant_ast.BinaryNode) (*planpb.Expr, error) {
 	op := getLogicalOpType(node.Operator)
 	if op == planpb.BinaryExpr_Invalid {
 		return nil, fmt.Errorf("invalid logical operator(%s)", node.Operator)
 	}
-
 	leftExpr, err := pc.handleExpr(&node.Left)
 	if err != nil {
 		return nil, err
@@ -601,17 +813,23 @@ func (pc *parserContext) handleInExpr(node *ant_ast.BinaryNode) (*planpb.Expr, e
 	if err != nil {
 		return nil, err
 	}
-	arrayData, err := pc.handleArrayExpr(&node.Right, field.DataType)
-	if err != nil {
-		return nil, err
+	termExpr := &planpb.TermExpr{
+		ColumnInfo: createColumnInfo(field),
+	}
+	if placeholder, ok := node.Right.(*placeholderNode); ok {
+		if err := pc.handleTermPlaceholder(placeholder, field.DataType, termExpr); err != nil {
+			return nil, err
+		}
+	} else {
+		termExpr.Values, err = pc.handleArrayExpr(&node.Right, field.DataType)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	expr := &planpb.Expr{
 		Expr: &planpb.Expr_TermExpr{
-			TermExpr: &planpb.TermExpr{
-				ColumnInfo: createColumnInfo(field),
-				Values:     arrayData,
-			},
+			TermExpr: termExpr,
 		},
 	}
 
@@ -704,8 +922,19 @@ func (pc *parserContext) handleMultiCmpExpr(node *ant_ast.BinaryNode) (*planpb.E
 }
 
 func (pc *parserContext) handleBinaryExpr(node *ant_ast.BinaryNode) (*planpb.Expr, error) {
+	if isQueryFilterExpr(node) {
+		return pc.handleQueryFilterExpr(node, false)
+	}
+
+	if isArithExpr(node.Left) || isArithExpr(node.Right) {
+		return pc.handleArithCompareExpr(node)
+	}
+
 	_, leftArithExpr := node.Left.(*ant_ast.FunctionNode)
 	_, rightArithExpr := node.Right.(*ant_ast.FunctionNode)
+	// the functions on JSON paths and arrays are function nodes as well
+	leftArithExpr = leftArithExpr && !isQueryFilterFunction(node.Left)
+	rightArithExpr = rightArithExpr && !isQueryFilterFunction(node.Right)
 
 	if leftArithExpr || rightArithExpr {
 		return pc.handleBinaryArithCmpExpr(node)
@@ -720,10 +949,86 @@ func (pc *parserContext) handleBinaryExpr(node *ant_ast.BinaryNode) (*planpb.Exp
 		return pc.handleLogicalExpr(node)
 	case "in", "not in":
 		return pc.handleInExpr(node)
+	case exprparser.LikeOperator:
+		return pc.handleLikeExpr(node)
 	}
 	return nil, fmt.Errorf("unsupported binary operator %s", node.Operator)
 }
 
+// handleLikeExpr translates the pattern of like to a prefix, postfix or equal match, the same as planparserv2
+func (pc *parserContext) handleLikeExpr(node *ant_ast.BinaryNode) (*planpb.Expr, error) {
+	idNode, ok := node.Left.(*ant_ast.IdentifierNode)
+	if !ok {
+		return nil, fmt.Errorf("the left operand of like is invalid")
+	}
+	field, err := pc.handleIdentifier(idNode)
+	if err != nil {
+		return nil, err
+	}
+	if !typeutil.IsStringType(field.DataType) {
+		return nil, fmt.Errorf("like operation on non-text field is unsupported")
+	}
+	patternNode, ok := node.Right.(*ant_ast.StringNode)
+	if !ok {
+		return nil, fmt.Errorf("the pattern of like should be a string literal")
+	}
+
+	op, operand, err := planparserv2.TranslatePatternMatch(patternNode.Value)
+	if err != nil {
+		return nil, err
+	}
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: createColumnInfo(field),
+				Op:         op,
+				Value: &planpb.GenericValue{
+					Val: &planpb.GenericValue_StringVal{
+						StringVal: operand,
+					},
+				},
+			},
+		},
+	}
+	return expr, nil
+}
+
+// handleNullExpr creates the check whether the value is null, e.g. info["a"] is null. The paths of the JSON fields and
+// the keys of the dynamic field are null if they don't exist or their values are null, the other fields aren't
+// nullable.
+func (pc *parserContext) handleNullExpr(node *ant_ast.UnaryNode) (*planpb.Expr, error) {
+	nullExpr := &planpb.NullExpr{
+		Op: planpb.NullExpr_IsNull,
+	}
+	if node.Operator == exprparser.IsNotNullOperator {
+		nullExpr.Op = planpb.NullExpr_IsNotNull
+	}
+	switch n := node.Node.(type) {
+	case *ant_ast.IndexNode:
+		field, path, err := pc.handleJSONPath(n)
+		if err != nil {
+			return nil, err
+		}
+		nullExpr.ColumnInfo = createColumnInfo(field)
+		nullExpr.Path = path
+	case *ant_ast.IdentifierNode:
+		field, err := pc.handleIdentifier(n)
+		if err != nil {
+			return nil, err
+		}
+		nullExpr.ColumnInfo = createColumnInfo(field)
+	default:
+		return nil, fmt.Errorf("%s expects a field or a path of a JSON field", node.Operator)
+	}
+
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_NullExpr{
+			NullExpr: nullExpr,
+		},
+	}
+	return expr, nil
+}
+
 func (pc *parserContext) createNotExpr(childExpr *planpb.Expr) (*planpb.Expr, error) {
 	expr := &planpb.Expr{
 		Expr: &planpb.Expr_UnaryExpr{
@@ -784,6 +1089,8 @@ func (pc *parserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType schemap
 		} else {
 			return nil, fmt.Errorf("type mismatch")
 		}
+	case *placeholderNode:
+		return pc.handleValuePlaceholder(node, dataType)
 	default:
 		return nil, fmt.Errorf("unsupported leaf node")
 	}
@@ -825,17 +1132,33 @@ func (pc *parserContext) handleFunction(node *ant_ast.FunctionNode) (*planpb.Bin
 func (pc *parserContext) handleIdentifier(node *ant_ast.IdentifierNode) (*schemapb.FieldSchema, error) {
 	fieldName := node.Value
 	field, err := pc.schema.GetFieldFromName(fieldName)
-	return field, err
+	if err != nil {
+		return nil, err
+	}
+	// the predicates on JSON and array fields are encoded as the comparisons of them
+	if typeutil.IsJSONField(field) {
+		return nil, fmt.Errorf("JSON field %s could only be filtered by its paths, e.g. %s[\"key\"]", fieldName, fieldName)
+	}
+	if typeutil.IsArrayField(field) {
+		return nil, fmt.Errorf("array field %s could only be filtered by %s, %s and %s", fieldName,
+			typeutil.ArrayContains, typeutil.ArrayContainsAny, typeutil.ArrayLength)
+	}
+	return field, nil
 }
 
 func (pc *parserContext) handleUnaryExpr(node *ant_ast.UnaryNode) (*planpb.Expr, error) {
 	switch node.Operator {
 	case "!", "not":
+		if isQueryFilterExpr(node.Node) {
+			return pc.handleQueryFilterExpr(node.Node, true)
+		}
 		subExpr, err := pc.handleExpr(&node.Node)
 		if err != nil {
 			return nil, err
 		}
 		return pc.createNotExpr(subExpr)
+	case exprparser.IsNullOperator, exprparser.IsNotNullOperator:
+		return pc.handleNullExpr(node)
 	default:
 		return nil, fmt.Errorf("invalid unary operator(%s)", node.Operator)
 	}
@@ -857,6 +1180,11 @@ func (pc *parserContext) handleExpr(nodeRaw *ant_ast.Node) (*planpb.Expr, error)
 		return expr, nil
 	case *ant_ast.BinaryNode:
 		return pc.handleBinaryExpr(node)
+	case *ant_ast.FunctionNode:
+		if isQueryFilterExpr(node) {
+			return pc.handleQueryFilterExpr(node, false)
+		}
+		return nil, fmt.Errorf("unsupported function (%s)", node.Name)
 	default:
 		return nil, fmt.Errorf("unsupported node (%s)", node.Type().String())
 	}
@@ -872,6 +1200,10 @@ func createQueryPlan(schemaPb *schemapb.CollectionSchema, exprStr string, vector
 	if err != nil {
 		return nil, err
 	}
+	return createVectorANNSPlan(schema, expr, vectorFieldName, queryInfo)
+}
+
+func createVectorANNSPlan(schema *typeutil.SchemaHelper, expr *planpb.Expr, vectorFieldName string, queryInfo *planpb.QueryInfo) (*planpb.PlanNode, error) {
 	vectorField, err := schema.GetFieldFromName(vectorFieldName)
 	if err != nil {
 		return nil, err
@@ -903,7 +1235,7 @@ func createExprPlan(schemaPb *schemapb.CollectionSchema, exprStr string) (*planp
 		return nil, err
 	}
 
-	expr, err := parseExpr(schema, exprStr)
+	expr, err := parseExprWithContext(&parserContext{schema: schema}, exprStr)
 	if err != nil {
 		return nil, err
 	}
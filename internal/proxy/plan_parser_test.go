@@ -164,6 +164,70 @@ func TestParseExpr_Naive(t *testing.T) {
 		}
 	})
 
+	t.Run("test like", func(t *testing.T) {
+		exprStrs := []string{
+			`VarCharField like "prefix%"`,
+			`VarCharField LIKE "equal"`,
+			`VarCharField like "%postfix"`,
+			`VarCharField like "%"`,
+			`Int64Field > 1 and VarCharField like "like%"`,
+			`not (VarCharField like "%postfix")`,
+			"Int64Field > 1 &&\n\tVarCharField like \"a%\"",
+			// like in the string literals isn't an operator
+			`VarCharField == "a like b" && VarCharField like "a%"`,
+			`VarCharField like "like%" || VarCharField == "LIKE"`,
+			`VarCharField in ["like", "b"] && VarCharField like "%like"`,
+		}
+		for _, exprStr := range exprStrs {
+			assertValidExprV2(t, schema, exprStr)
+		}
+	})
+
+	t.Run("test null", func(t *testing.T) {
+		expr, err := parseExpr(schema, `Int64Field is null`)
+		assert.NoError(t, err)
+		assert.Equal(t, planpb.NullExpr_IsNull, expr.GetNullExpr().GetOp())
+		assert.Equal(t, schemapb.DataType_Int64, expr.GetNullExpr().GetColumnInfo().GetDataType())
+		assert.Empty(t, expr.GetNullExpr().GetPath())
+
+		expr, err = parseExpr(schema, `Int64Field > 1 and VarCharField IS NOT NULL`)
+		assert.NoError(t, err)
+		assert.Equal(t, planpb.NullExpr_IsNotNull, expr.GetBinaryExpr().GetRight().GetNullExpr().GetOp())
+
+		expr, err = parseExpr(schema, `not (VarCharField is null) || VarCharField == "is null"`)
+		assert.NoError(t, err)
+		assert.Equal(t, planpb.NullExpr_IsNull, expr.GetBinaryExpr().GetLeft().GetUnaryExpr().GetChild().GetNullExpr().GetOp())
+
+		exprStrs := []string{
+			`value is null`,
+			`Int64Field + 1 is null`,
+			`1 is null`,
+			`Int64Field is not 1`,
+			`Int64Field is`,
+			`Int64Field["a"] is null`,
+		}
+		for _, exprStr := range exprStrs {
+			_, err := parseExpr(schema, exprStr)
+			assert.Error(t, err, exprStr)
+		}
+	})
+
+	t.Run("test like invalid", func(t *testing.T) {
+		exprStrs := []string{
+			`VarCharField like "not_%_supported"`,
+			`VarCharField like "%not_supported%"`,
+			`FloatField like "prefix%"`,
+			`value like "prefix%"`,
+			// contains written by users isn't like
+			`VarCharField contains "prefix%"`,
+			`VarCharField like "like" && VarCharField contains "prefix%"`,
+			"VarCharField like \"中文\" &&\nVarCharField contains \"prefix%\"",
+		}
+		for _, exprStr := range exprStrs {
+			assertInvalidExpr(t, schema, exprStr)
+		}
+	})
+
 	t.Run("test UnaryNode invalid", func(t *testing.T) {
 		exprStrs := []string{
 			"Int64Field > +aa",
@@ -261,13 +325,17 @@ func TestParseExpr_Naive(t *testing.T) {
 			"Int64Field / 3 != 5",
 			// "%"
 			"Int64Field % 7 == 5",
+			// range
+			"Int64Field + 3 < 5",
+			"FloatField - 1.2 >= 3.5",
+			"1.2 * FloatField > 3.5",
 		}
 		for _, exprStr := range exprStrs {
 			assertValidExprV2(t, schema, exprStr)
 		}
 	})
 
-	t.Run("test BinaryArithOpNode invalid", func(t *testing.T) {
+	t.Run("test ArithCompareExpr", func(t *testing.T) {
 		exprStrs := []string{
 			// "+"
 			"FloatField + FloatField == 20",
@@ -283,13 +351,38 @@ func TestParseExpr_Naive(t *testing.T) {
 			// "/"
 			"FloatField / FloatField == 20",
 			"Int64Field / Int64Field != 10",
-			"FloatField / 0 == 20",
-			"Int64Field / 0 != 10",
+			"2.5 / FloatField > 1",
 			// "%"
 			"Int64Field % Int64Field != 10",
+			"30 % Int64Field == 0",
+			// fields and nested expressions
+			"Int64Field + 1 > Int64Field",
+			"Int64Field * 2 <= FloatField",
+			"(Int64Field + Int64Field) * 2 < FloatField - 1.5",
+			"1 > Int64Field - Int64Field",
+			"Int64Field + FloatField >= DoubleField",
+		}
+		for _, exprStr := range exprStrs {
+			assertValidExprV2(t, schema, exprStr)
+		}
+	})
+
+	t.Run("test BinaryArithOpNode invalid", func(t *testing.T) {
+		exprStrs := []string{
+			"FloatField / 0 == 20",
+			"Int64Field / 0 != 10",
+			"(Int64Field + Int64Field) / 0 != 10",
 			"FloatField % 0 == 20",
 			"Int64Field % 0 != 10",
 			"FloatField % 2.3 == 20",
+			"FloatField % Int64Field == 20",
+			"Int64Field + Int64Field == 1.5",
+			"Int64Field + 1.5 > FloatField",
+			"Int64Field + Int64Field > VarCharField",
+			"Int64Field + BoolField > 1",
+			"Int64Field + Int64Field startsWith 1",
+			"Int64Field ** Int64Field > 1",
+			"-Int64Field > 1",
 		}
 		for _, exprStr := range exprStrs {
 			exprProto, err := parseExpr(schema, exprStr)
@@ -479,18 +572,22 @@ func TestExprBinaryArithOp_Str(t *testing.T) {
 		"(9 * FloatN) != 0",
 		// Functional nodes at the right can be reversed
 		"0 == (age1 + 3)",
-	}
-
-	unsupportedExprStrs := []string{
-		// Comparison operators except for "==" and "!=" are unsupported
+		"4 > (age1 * 2)",
+		// Range comparisons
 		"(age1 + 2) > 4",
-		"(age1 + 2) >= 4",
-		"(age1 + 2) < 4",
-		"(age1 + 2) <= 4",
-		// Field as the right operand for -, /, and % operators are not supported
+		"(age1 - 2) >= 4",
+		"(FloatN * 2) < 4.5",
+		"(age1 % 2) <= 0",
+		// Field as the right operand for -, /, and % operators
 		"(10 - age1) == 0",
 		"(20 / age1) == 0",
 		"(30 % age1) == 0",
+		// Fields at both sides
+		"(age1 + 1) > FloatN",
+		"(age1 * age1) != (FloatN / 2)",
+	}
+
+	unsupportedExprStrs := []string{
 		// Modulo is not supported in the parser but the engine can handle it since fmod is used
 		"(FloatN % 2.1) == 0",
 		// Different data types are not supported
@@ -0,0 +1,753 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ant_ast "github.com/antonmedv/expr/ast"
+	"github.com/golang/protobuf/proto"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// The search and query templates are registered by RegisterTemplate with an expression whose placeholders are
+// written as `$name`, e.g. `age > $min_age && id in $ids`, and executed by the template id with the json encoded
+// parameters, e.g. {"min_age": 18, "ids": [1, 2, 3]}. The template is compiled once by proxy, so that the
+// following requests only bind the parameters.
+
+// placeholderPrefix is the prefix of placeholders, field names never start with it
+const placeholderPrefix = "$"
+
+// globalPlanTemplateCache is nil until proxy initialized, templates are compiled for every request then.
+var globalPlanTemplateCache *planTemplateCache
+
+// placeholderNode is a named parameter of an expression template
+type placeholderNode struct {
+	ant_ast.NilNode
+	name string
+}
+
+// placeholderPatcher replaces the `$name` identifiers with placeholder nodes
+type placeholderPatcher struct{}
+
+func (*placeholderPatcher) Enter(*ant_ast.Node) {}
+
+func (*placeholderPatcher) Exit(node *ant_ast.Node) {
+	if idNode, ok := (*node).(*ant_ast.IdentifierNode); ok && len(idNode.Value) > len(placeholderPrefix) &&
+		strings.HasPrefix(idNode.Value, placeholderPrefix) {
+		ant_ast.Patch(node, &placeholderNode{name: strings.TrimPrefix(idNode.Value, placeholderPrefix)})
+	}
+}
+
+type valueSlot struct {
+	name     string
+	dataType schemapb.DataType
+	value    *planpb.GenericValue
+}
+
+type termSlot struct {
+	name     string
+	dataType schemapb.DataType
+	term     *planpb.TermExpr
+}
+
+// planTemplate is a compiled expression template, the placeholders are the slots to fill when binding
+type planTemplate struct {
+	mu     sync.Mutex
+	expr   *planpb.Expr
+	names  map[string]struct{}
+	values []valueSlot
+	terms  []termSlot
+}
+
+func compilePlanTemplate(schema *typeutil.SchemaHelper, exprStr string) (*planTemplate, error) {
+	t := &planTemplate{names: make(map[string]struct{})}
+	expr, err := parseExprWithContext(&parserContext{schema: schema, template: t}, exprStr)
+	if err != nil {
+		return nil, err
+	}
+	t.expr = expr
+	return t, nil
+}
+
+// bind fills the placeholders with params and returns a new expression, the template itself is reusable
+func (t *planTemplate) bind(params map[string]interface{}) (*planpb.Expr, error) {
+	for name := range params {
+		if _, ok := t.names[name]; !ok {
+			return nil, fmt.Errorf("unknown parameter %s of expression template", name)
+		}
+	}
+	if t.expr == nil {
+		return nil, nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, slot := range t.values {
+		param, ok := params[slot.name]
+		if !ok {
+			return nil, fmt.Errorf("parameter %s of expression template is not bound", slot.name)
+		}
+		gv, err := bindGenericValue(param, slot.dataType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind parameter %s: %w", slot.name, err)
+		}
+		slot.value.Val = gv.Val
+	}
+	for _, slot := range t.terms {
+		param, ok := params[slot.name]
+		if !ok {
+			return nil, fmt.Errorf("parameter %s of expression template is not bound", slot.name)
+		}
+		elements, ok := param.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("parameter %s of expression template must be an array", slot.name)
+		}
+		values := make([]*planpb.GenericValue, 0, len(elements))
+		for _, element := range elements {
+			gv, err := bindGenericValue(element, slot.dataType)
+			if err != nil {
+				return nil, fmt.Errorf("failed to bind parameter %s: %w", slot.name, err)
+			}
+			values = append(values, gv)
+		}
+		slot.term.Values = values
+	}
+	expr := proto.Clone(t.expr).(*planpb.Expr)
+	// don't hold the values of large arrays in the cached template
+	for _, slot := range t.terms {
+		slot.term.Values = nil
+	}
+	return expr, nil
+}
+
+func bindGenericValue(param interface{}, dataType schemapb.DataType) (*planpb.GenericValue, error) {
+	switch v := param.(type) {
+	case json.Number:
+		if typeutil.IsIntegerType(dataType) {
+			i, err := v.Int64()
+			if err != nil {
+				return nil, fmt.Errorf("type mismatch")
+			}
+			return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: i}}, nil
+		}
+		if typeutil.IsFloatingType(dataType) {
+			f, err := v.Float64()
+			if err != nil {
+				return nil, fmt.Errorf("type mismatch")
+			}
+			return &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: f}}, nil
+		}
+	case bool:
+		if typeutil.IsBoolType(dataType) {
+			return &planpb.GenericValue{Val: &planpb.GenericValue_BoolVal{BoolVal: v}}, nil
+		}
+	case string:
+		if typeutil.IsStringType(dataType) {
+			return &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: v}}, nil
+		}
+	}
+	return nil, fmt.Errorf("type mismatch")
+}
+
+// parseExprParams decodes the json encoded parameters, numbers are kept as json.Number to not lose int64 precision
+func parseExprParams(exprParams string) (map[string]interface{}, error) {
+	params := make(map[string]interface{})
+	decoder := json.NewDecoder(strings.NewReader(exprParams))
+	decoder.UseNumber()
+	if err := decoder.Decode(&params); err != nil {
+		return nil, fmt.Errorf("invalid template params: %v", err)
+	}
+	return params, nil
+}
+
+func (pc *parserContext) handleValuePlaceholder(node *placeholderNode, dataType schemapb.DataType) (*planpb.GenericValue, error) {
+	if pc.template == nil {
+		return nil, fmt.Errorf("placeholder %s%s is only allowed in expression template", placeholderPrefix, node.name)
+	}
+	gv := &planpb.GenericValue{}
+	pc.template.names[node.name] = struct{}{}
+	pc.template.values = append(pc.template.values, valueSlot{name: node.name, dataType: dataType, value: gv})
+	return gv, nil
+}
+
+func (pc *parserContext) handleTermPlaceholder(node *placeholderNode, dataType schemapb.DataType, termExpr *planpb.TermExpr) error {
+	if pc.template == nil {
+		return fmt.Errorf("placeholder %s%s is only allowed in expression template", placeholderPrefix, node.name)
+	}
+	pc.template.names[node.name] = struct{}{}
+	pc.template.terms = append(pc.template.terms, termSlot{name: node.name, dataType: dataType, term: termExpr})
+	return nil
+}
+
+type planTemplateKey struct {
+	collectionID UniqueID
+	expr         string
+}
+
+type planTemplateEntry struct {
+	key planTemplateKey
+	// schema is the one the template is compiled against, proxy meta cache replaces the schema of a collection
+	// whenever it's refreshed, so a template compiled against another schema is stale
+	schema   *schemapb.CollectionSchema
+	template *planTemplate
+}
+
+// planTemplateCache is a LRU cache of the compiled expression templates
+type planTemplateCache struct {
+	mu        sync.Mutex
+	capacity  int
+	evictList *list.List
+	items     map[planTemplateKey]*list.Element
+}
+
+func newPlanTemplateCache(capacity int) *planTemplateCache {
+	return &planTemplateCache{
+		capacity:  capacity,
+		evictList: list.New(),
+		items:     make(map[planTemplateKey]*list.Element),
+	}
+}
+
+// get returns the template compiled against schema, the stale one compiled against another schema is ignored
+func (c *planTemplateCache) get(key planTemplateKey, schema *schemapb.CollectionSchema) (*planTemplate, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	entry := e.Value.(*planTemplateEntry)
+	if entry.schema != schema {
+		return nil, false
+	}
+	c.evictList.MoveToFront(e)
+	return entry.template, true
+}
+
+// add caches the template compiled against schema, the stale template of the key is replaced
+func (c *planTemplateCache) add(key planTemplateKey, schema *schemapb.CollectionSchema, template *planTemplate) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		entry := e.Value.(*planTemplateEntry)
+		entry.schema = schema
+		entry.template = template
+		c.evictList.MoveToFront(e)
+		return
+	}
+	c.items[key] = c.evictList.PushFront(&planTemplateEntry{key: key, schema: schema, template: template})
+	for c.evictList.Len() > c.capacity {
+		oldest := c.evictList.Back()
+		c.evictList.Remove(oldest)
+		delete(c.items, oldest.Value.(*planTemplateEntry).key)
+	}
+}
+
+func (c *planTemplateCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.evictList.Len()
+}
+
+// getOrCompile returns the cached template of the collection, the template is compiled and cached if missing or
+// compiled against another schema
+func (c *planTemplateCache) getOrCompile(collectionID UniqueID, schemaPb *schemapb.CollectionSchema, exprStr string) (*planTemplate, error) {
+	if c == nil || c.capacity <= 0 {
+		return compileSchemaPlanTemplate(schemaPb, exprStr)
+	}
+	key := planTemplateKey{collectionID: collectionID, expr: exprStr}
+	if template, ok := c.get(key, schemaPb); ok {
+		return template, nil
+	}
+	template, err := compileSchemaPlanTemplate(schemaPb, exprStr)
+	if err != nil {
+		return nil, err
+	}
+	c.add(key, schemaPb, template)
+	return template, nil
+}
+
+func compileSchemaPlanTemplate(schemaPb *schemapb.CollectionSchema, exprStr string) (*planTemplate, error) {
+	schema, err := typeutil.CreateSchemaHelper(schemaPb)
+	if err != nil {
+		return nil, err
+	}
+	return compilePlanTemplate(schema, exprStr)
+}
+
+// planTemplatePrefix is the etcd prefix of the registered templates, the key is
+// {MetaRootPath}/proxy/templates/{templateID} and the value is a json encoded templateMeta. The templates are stored
+// with a lease of proxy.templateTTL, so that they are executed by any proxy until they expire, even if the proxy
+// registering them restarts.
+const planTemplatePrefix = "proxy/templates"
+
+// templateMeta is a registered template stored in etcd
+type templateMeta struct {
+	ID int64 `json:"id"`
+	// User is the user registering the template, only the user and root execute or drop it
+	User           string   `json:"user"`
+	DbName         string   `json:"db_name"`
+	CollectionName string   `json:"collection_name"`
+	CollectionID   int64    `json:"collection_id"`
+	Expr           string   `json:"expr"`
+	AnnsField      string   `json:"anns_field,omitempty"`
+	OutputFields   []string `json:"output_fields,omitempty"`
+}
+
+// registeredTemplate is a search or query template registered to proxy, whose expression, anns field and output
+// fields are fixed
+type registeredTemplate struct {
+	id             UniqueID
+	user           string
+	dbName         string
+	collectionName string
+	collectionID   UniqueID
+	expr           string
+	// annsField is the vector field to search, empty for the templates of queries
+	annsField    string
+	outputFields []string
+
+	mu sync.Mutex
+	// schema is the one the template is compiled against, the template is recompiled once it's changed
+	schema   *schemapb.CollectionSchema
+	template *planTemplate
+}
+
+// newRegisteredTemplate checks the template of the user against the schema of its collection and compiles it
+func newRegisteredTemplate(ctx context.Context, id UniqueID, user string, req *milvuspb.RegisterTemplateRequest) (*registeredTemplate, error) {
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+	schemaPb, err := globalMetaCache.GetCollectionSchema(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetAnnsField() != "" {
+		schema, err := typeutil.CreateSchemaHelper(schemaPb)
+		if err != nil {
+			return nil, err
+		}
+		field, err := schema.GetFieldFromName(req.GetAnnsField())
+		if err != nil {
+			return nil, err
+		}
+		if !typeutil.IsVectorType(field.DataType) {
+			return nil, fmt.Errorf("anns field %s is not a vector field", req.GetAnnsField())
+		}
+	} else if req.GetExpr() == "" {
+		return nil, fmt.Errorf("query expression is empty")
+	}
+	if _, err := translateOutputFields(req.GetOutputFields(), schemaPb, false); err != nil {
+		return nil, err
+	}
+
+	template := &registeredTemplate{
+		id:             id,
+		user:           user,
+		dbName:         req.GetDbName(),
+		collectionName: req.GetCollectionName(),
+		collectionID:   collectionID,
+		expr:           req.GetExpr(),
+		annsField:      req.GetAnnsField(),
+		outputFields:   req.GetOutputFields(),
+	}
+	if _, err := template.compile(schemaPb); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+func newRegisteredTemplateFromMeta(meta *templateMeta) *registeredTemplate {
+	return &registeredTemplate{
+		id:             meta.ID,
+		user:           meta.User,
+		dbName:         meta.DbName,
+		collectionName: meta.CollectionName,
+		collectionID:   meta.CollectionID,
+		expr:           meta.Expr,
+		annsField:      meta.AnnsField,
+		outputFields:   meta.OutputFields,
+	}
+}
+
+func (r *registeredTemplate) meta() *templateMeta {
+	return &templateMeta{
+		ID:             r.id,
+		User:           r.user,
+		DbName:         r.dbName,
+		CollectionName: r.collectionName,
+		CollectionID:   r.collectionID,
+		Expr:           r.expr,
+		AnnsField:      r.annsField,
+		OutputFields:   r.outputFields,
+	}
+}
+
+func (r *registeredTemplate) isQuery() bool {
+	return r.annsField == ""
+}
+
+// checkUser checks the template is executed or dropped by the user registering it, or by root
+func (r *registeredTemplate) checkUser(user string) error {
+	if user != r.user && user != util.UserRoot {
+		return fmt.Errorf("template %d is not registered by user %s", r.id, user)
+	}
+	return nil
+}
+
+// checkCollection checks the template is executed on the collection it's registered for, which is authorized by
+// the privilege interceptor before the template is looked up
+func (r *registeredTemplate) checkCollection(dbName string, collectionName string) error {
+	if dbName != r.dbName || collectionName != r.collectionName {
+		return fmt.Errorf("template %d is registered for collection %s", r.id, r.collectionName)
+	}
+	return nil
+}
+
+// compile returns the expression template compiled against schemaPb
+func (r *registeredTemplate) compile(schemaPb *schemapb.CollectionSchema) (*planTemplate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.template != nil && r.schema == schemaPb {
+		return r.template, nil
+	}
+	template, err := compileSchemaPlanTemplate(schemaPb, r.expr)
+	if err != nil {
+		return nil, err
+	}
+	r.schema, r.template = schemaPb, template
+	return template, nil
+}
+
+// planTemplateRegistry stores the registered templates in etcd, and caches the compiled ones of this proxy in a LRU
+// cache of the capacity. The templates missing in the cache are loaded from etcd, and the dropped ones are evicted
+// from the caches of all the proxies by watching etcd.
+type planTemplateRegistry struct {
+	etcdCli *clientv3.Client
+	prefix  string
+	// ttl is the lease of the templates in etcd, 0 means they never expire
+	ttl time.Duration
+
+	mu        sync.Mutex
+	capacity  int
+	evictList *list.List
+	items     map[UniqueID]*list.Element
+}
+
+type planTemplateRegistryEntry struct {
+	template *registeredTemplate
+	// expireAt is when the lease of the template expires, zero if it never expires
+	expireAt time.Time
+}
+
+func newPlanTemplateRegistry(etcdCli *clientv3.Client, metaRootPath string, capacity int, ttl time.Duration) *planTemplateRegistry {
+	return &planTemplateRegistry{
+		etcdCli:   etcdCli,
+		prefix:    path.Join(metaRootPath, planTemplatePrefix) + "/",
+		ttl:       ttl,
+		capacity:  capacity,
+		evictList: list.New(),
+		items:     make(map[UniqueID]*list.Element),
+	}
+}
+
+// start watches the templates dropped until ctx done
+func (r *planTemplateRegistry) start(ctx context.Context) {
+	go r.watch(ctx)
+}
+
+func (r *planTemplateRegistry) watch(ctx context.Context) {
+	watchChan := r.etcdCli.Watch(ctx, r.prefix, clientv3.WithPrefix())
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("template registry watch loop exit")
+			return
+		case resp, ok := <-watchChan:
+			if !ok {
+				return
+			}
+			if err := resp.Err(); err != nil {
+				// the dropped templates may be missed, evict all of them to load them again
+				log.Warn("watch templates failed, clear the cached templates", zap.Error(err))
+				r.clear()
+				watchChan = r.etcdCli.Watch(ctx, r.prefix, clientv3.WithPrefix())
+				continue
+			}
+			for _, event := range resp.Events {
+				if event.Type != clientv3.EventTypeDelete {
+					continue
+				}
+				id, err := strconv.ParseInt(strings.TrimPrefix(string(event.Kv.Key), r.prefix), 10, 64)
+				if err != nil {
+					continue
+				}
+				r.evict(id)
+			}
+		}
+	}
+}
+
+func (r *planTemplateRegistry) key(id UniqueID) string {
+	return r.prefix + strconv.FormatInt(id, 10)
+}
+
+// register stores the template in etcd with the lease of ttl, and caches it
+func (r *planTemplateRegistry) register(ctx context.Context, template *registeredTemplate) error {
+	value, err := json.Marshal(template.meta())
+	if err != nil {
+		return err
+	}
+	var opts []clientv3.OpOption
+	var expireAt time.Time
+	if r.ttl > 0 {
+		lease, err := r.etcdCli.Grant(ctx, int64(r.ttl.Seconds()))
+		if err != nil {
+			return err
+		}
+		opts = append(opts, clientv3.WithLease(lease.ID))
+		expireAt = time.Now().Add(time.Duration(lease.TTL) * time.Second)
+	}
+	if _, err := r.etcdCli.Put(ctx, r.key(template.id), string(value), opts...); err != nil {
+		return err
+	}
+	r.add(template, expireAt)
+	return nil
+}
+
+// get returns the template of the user, which is loaded from etcd if it's not cached
+func (r *planTemplateRegistry) get(ctx context.Context, id UniqueID, user string) (*registeredTemplate, error) {
+	template, ok := r.getCached(id)
+	if !ok {
+		var err error
+		template, err = r.load(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := template.checkUser(user); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+func (r *planTemplateRegistry) getCached(id UniqueID) (*registeredTemplate, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.items[id]
+	if !ok {
+		return nil, false
+	}
+	entry := e.Value.(*planTemplateRegistryEntry)
+	if !entry.expireAt.IsZero() && time.Now().After(entry.expireAt) {
+		r.evictList.Remove(e)
+		delete(r.items, id)
+		return nil, false
+	}
+	r.evictList.MoveToFront(e)
+	return entry.template, true
+}
+
+func (r *planTemplateRegistry) load(ctx context.Context, id UniqueID) (*registeredTemplate, error) {
+	resp, err := r.etcdCli.Get(ctx, r.key(id))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, fmt.Errorf("template %d is not registered or expired", id)
+	}
+	meta := &templateMeta{}
+	if err := json.Unmarshal(resp.Kvs[0].Value, meta); err != nil {
+		return nil, err
+	}
+	var expireAt time.Time
+	if leaseID := clientv3.LeaseID(resp.Kvs[0].Lease); leaseID != clientv3.NoLease {
+		lease, err := r.etcdCli.TimeToLive(ctx, leaseID)
+		if err != nil {
+			return nil, err
+		}
+		expireAt = time.Now().Add(time.Duration(lease.TTL) * time.Second)
+	}
+	template := newRegisteredTemplateFromMeta(meta)
+	r.add(template, expireAt)
+	return template, nil
+}
+
+func (r *planTemplateRegistry) add(template *registeredTemplate, expireAt time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if e, ok := r.items[template.id]; ok {
+		r.evictList.Remove(e)
+	}
+	r.items[template.id] = r.evictList.PushFront(&planTemplateRegistryEntry{template: template, expireAt: expireAt})
+	for r.evictList.Len() > r.capacity {
+		oldest := r.evictList.Back()
+		r.evictList.Remove(oldest)
+		delete(r.items, oldest.Value.(*planTemplateRegistryEntry).template.id)
+	}
+}
+
+func (r *planTemplateRegistry) evict(id UniqueID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if e, ok := r.items[id]; ok {
+		r.evictList.Remove(e)
+		delete(r.items, id)
+	}
+}
+
+func (r *planTemplateRegistry) clear() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.evictList.Init()
+	r.items = make(map[UniqueID]*list.Element)
+}
+
+func (r *planTemplateRegistry) len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.evictList.Len()
+}
+
+// drop removes the template of the user on the collection from etcd
+func (r *planTemplateRegistry) drop(ctx context.Context, id UniqueID, user string, dbName string, collectionName string) error {
+	template, err := r.get(ctx, id, user)
+	if err != nil {
+		return err
+	}
+	if err := template.checkCollection(dbName, collectionName); err != nil {
+		return err
+	}
+	if _, err := r.etcdCli.Delete(ctx, r.key(id)); err != nil {
+		return err
+	}
+	r.evict(id)
+	return nil
+}
+
+// applySearchTemplate fixes the dsl, anns field and output fields of the search by the template
+func applySearchTemplate(request *milvuspb.SearchRequest, template *registeredTemplate) error {
+	if template.isQuery() {
+		return fmt.Errorf("template %d is a query template", template.id)
+	}
+	if err := template.checkCollection(request.GetDbName(), request.GetCollectionName()); err != nil {
+		return err
+	}
+	request.Dsl = template.expr
+	request.DslType = commonpb.DslType_BoolExprV1
+	request.OutputFields = template.outputFields
+	params := make([]*commonpb.KeyValuePair, 0, len(request.GetSearchParams())+1)
+	for _, kv := range request.GetSearchParams() {
+		if kv.GetKey() != AnnsFieldKey {
+			params = append(params, kv)
+		}
+	}
+	request.SearchParams = append(params, &commonpb.KeyValuePair{Key: AnnsFieldKey, Value: template.annsField})
+	return nil
+}
+
+// applyQueryTemplate fixes the expr and output fields of the query by the template
+func applyQueryTemplate(request *milvuspb.QueryRequest, template *registeredTemplate) error {
+	if !template.isQuery() {
+		return fmt.Errorf("template %d is a search template", template.id)
+	}
+	if err := template.checkCollection(request.GetDbName(), request.GetCollectionName()); err != nil {
+		return err
+	}
+	request.Expr = template.expr
+	request.OutputFields = template.outputFields
+	return nil
+}
+
+// createQueryPlanFromTemplate creates the search plan by binding templateParams to the registered template
+func createQueryPlanFromTemplate(template *registeredTemplate, schemaPb *schemapb.CollectionSchema, templateParams string,
+	queryInfo *planpb.QueryInfo) (*planpb.PlanNode, error) {
+	schema, err := typeutil.CreateSchemaHelper(schemaPb)
+	if err != nil {
+		return nil, err
+	}
+	expr, err := bindRegisteredTemplate(template, schemaPb, templateParams)
+	if err != nil {
+		return nil, err
+	}
+	return createVectorANNSPlan(schema, expr, template.annsField, queryInfo)
+}
+
+// createRetrievePlanFromTemplate creates the query plan by binding templateParams to the registered template
+func createRetrievePlanFromTemplate(template *registeredTemplate, schemaPb *schemapb.CollectionSchema, templateParams string) (*planpb.PlanNode, error) {
+	expr, err := bindRegisteredTemplate(template, schemaPb, templateParams)
+	if err != nil {
+		return nil, err
+	}
+	return &planpb.PlanNode{
+		Node: &planpb.PlanNode_Predicates{
+			Predicates: expr,
+		},
+	}, nil
+}
+
+func bindRegisteredTemplate(template *registeredTemplate, schemaPb *schemapb.CollectionSchema, templateParams string) (*planpb.Expr, error) {
+	compiled, err := template.compile(schemaPb)
+	if err != nil {
+		return nil, err
+	}
+	params := make(map[string]interface{})
+	if templateParams != "" {
+		params, err = parseExprParams(templateParams)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return compiled.bind(params)
+}
+
+// createExprPlanFromTemplate creates the query plan of the expression by the cached template, the expression is a
+// template without placeholders, so that the plans of the repeated queries are not parsed again
+func createExprPlanFromTemplate(collectionID UniqueID, schemaPb *schemapb.CollectionSchema, exprStr string) (*planpb.PlanNode, error) {
+	template, err := globalPlanTemplateCache.getOrCompile(collectionID, schemaPb, exprStr)
+	if err != nil {
+		return nil, err
+	}
+	expr, err := template.bind(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &planpb.PlanNode{
+		Node: &planpb.PlanNode_Predicates{
+			Predicates: expr,
+		},
+	}, nil
+}
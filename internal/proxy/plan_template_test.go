@@ -0,0 +1,294 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestPlanTemplate_Bind(t *testing.T) {
+	schema, err := typeutil.CreateSchemaHelper(newTestSchema())
+	assert.Nil(t, err)
+
+	template, err := compilePlanTemplate(schema, `Int64Field > $min && $max >= FloatField && VarCharField in $names && Int8Field not in $ids`)
+	assert.Nil(t, err)
+
+	bindAndCheck := func(exprParams string, exprStr string) {
+		params, err := parseExprParams(exprParams)
+		assert.Nil(t, err)
+		bound, err := template.bind(params)
+		assert.Nil(t, err)
+		expected, err := parseExpr(schema, exprStr)
+		assert.Nil(t, err)
+		assert.True(t, proto.Equal(expected, bound), bound.String())
+	}
+	bindAndCheck(`{"min": 10, "max": 1.5, "names": ["a", "b"], "ids": [1, 2, 3]}`,
+		`Int64Field > 10 && 1.5 >= FloatField && VarCharField in ["a", "b"] && Int8Field not in [1, 2, 3]`)
+	// the template is reusable
+	bindAndCheck(`{"min": 9007199254740993, "max": 2, "names": [], "ids": [4]}`,
+		`Int64Field > 9007199254740993 && 2 >= FloatField && VarCharField in [] && Int8Field not in [4]`)
+
+	invalidParams := []string{
+		`{"min": 10, "max": 1.5, "names": ["a"]}`,
+		`{"min": 10, "max": 1.5, "names": ["a"], "ids": [1], "unknown": 1}`,
+		`{"min": 1.5, "max": 1.5, "names": ["a"], "ids": [1]}`,
+		`{"min": "10", "max": 1.5, "names": ["a"], "ids": [1]}`,
+		`{"min": 10, "max": 1.5, "names": "a", "ids": [1]}`,
+		`{"min": 10, "max": 1.5, "names": [1], "ids": [1]}`,
+	}
+	for _, exprParams := range invalidParams {
+		params, err := parseExprParams(exprParams)
+		assert.Nil(t, err)
+		_, err = template.bind(params)
+		assert.NotNil(t, err, exprParams)
+	}
+
+	_, err = parseExprParams(`[1, 2]`)
+	assert.NotNil(t, err)
+
+	// placeholders are not allowed in plain expressions
+	_, err = parseExpr(schema, `Int64Field > $min`)
+	assert.NotNil(t, err)
+	_, err = compilePlanTemplate(schema, `-$min < Int64Field`)
+	assert.NotNil(t, err)
+}
+
+func TestPlanTemplateCache(t *testing.T) {
+	schema := newTestSchema()
+	exprStr := `Int64Field > $min`
+
+	cache := newPlanTemplateCache(2)
+	t1, err := cache.getOrCompile(1, schema, exprStr)
+	assert.Nil(t, err)
+	t2, err := cache.getOrCompile(1, schema, exprStr)
+	assert.Nil(t, err)
+	assert.Same(t, t1, t2)
+
+	_, err = cache.getOrCompile(2, schema, exprStr)
+	assert.Nil(t, err)
+	_, err = cache.getOrCompile(3, schema, exprStr)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, cache.len())
+	// collection 1 is evicted
+	t3, err := cache.getOrCompile(1, schema, exprStr)
+	assert.Nil(t, err)
+	assert.NotSame(t, t1, t3)
+
+	_, err = cache.getOrCompile(1, schema, `Int64Field > $`)
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, cache.len())
+
+	// the template compiled against the stale schema is replaced
+	newSchema := proto.Clone(schema).(*schemapb.CollectionSchema)
+	t4, err := cache.getOrCompile(1, newSchema, exprStr)
+	assert.Nil(t, err)
+	assert.NotSame(t, t3, t4)
+	assert.Equal(t, 2, cache.len())
+	t5, err := cache.getOrCompile(1, newSchema, exprStr)
+	assert.Nil(t, err)
+	assert.Same(t, t4, t5)
+
+	var nilCache *planTemplateCache
+	_, err = nilCache.getOrCompile(1, schema, exprStr)
+	assert.Nil(t, err)
+}
+
+func TestCreateExprPlanFromTemplate(t *testing.T) {
+	defer func(cache *planTemplateCache) { globalPlanTemplateCache = cache }(globalPlanTemplateCache)
+	globalPlanTemplateCache = newPlanTemplateCache(2)
+
+	schemaPb := newTestSchema()
+	exprStr := `Int64Field > 10 && VarCharField in ["a", "b"]`
+	expected, err := createExprPlan(schemaPb, exprStr)
+	assert.Nil(t, err)
+	for i := 0; i < 2; i++ {
+		plan, err := createExprPlanFromTemplate(1, schemaPb, exprStr)
+		assert.Nil(t, err)
+		assert.True(t, proto.Equal(expected, plan))
+	}
+	assert.Equal(t, 1, globalPlanTemplateCache.len())
+
+	_, err = createExprPlanFromTemplate(1, schemaPb, `Int64Field > $min`)
+	assert.NotNil(t, err)
+	_, err = createExprPlanFromTemplate(1, schemaPb, `Int64Field >`)
+	assert.NotNil(t, err)
+}
+
+func TestCreateQueryPlanFromTemplate(t *testing.T) {
+	schemaPb := newTestSchema()
+	queryInfo := &planpb.QueryInfo{
+		Topk:         10,
+		MetricType:   "L2",
+		SearchParams: "{\"nprobe\": 10}",
+	}
+	template := &registeredTemplate{id: 1, expr: `Int64Field > $min`, annsField: "FloatVectorField"}
+	plan, err := createQueryPlanFromTemplate(template, schemaPb, `{"min": 10}`, queryInfo)
+	assert.Nil(t, err)
+	expected, err := createQueryPlan(schemaPb, `Int64Field > 10`, "FloatVectorField", queryInfo)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(expected, plan))
+	compiled := template.template
+
+	// the template is compiled once, until the schema is changed
+	_, err = createQueryPlanFromTemplate(template, schemaPb, `{"min": 20}`, queryInfo)
+	assert.Nil(t, err)
+	assert.Same(t, compiled, template.template)
+	_, err = createQueryPlanFromTemplate(template, newTestSchema(), `{"min": 20}`, queryInfo)
+	assert.Nil(t, err)
+	assert.NotSame(t, compiled, template.template)
+
+	plan, err = createQueryPlanFromTemplate(&registeredTemplate{id: 2, annsField: "FloatVectorField"}, schemaPb, "", queryInfo)
+	assert.Nil(t, err)
+	assert.Nil(t, plan.GetVectorAnns().GetPredicates())
+
+	_, err = createQueryPlanFromTemplate(template, schemaPb, `{"min": 10`, queryInfo)
+	assert.NotNil(t, err)
+	_, err = createQueryPlanFromTemplate(template, schemaPb, `{}`, queryInfo)
+	assert.NotNil(t, err)
+	_, err = createQueryPlanFromTemplate(&registeredTemplate{id: 3, expr: `Int64Field > $min`, annsField: "Int64Field"},
+		schemaPb, `{"min": 10}`, queryInfo)
+	assert.NotNil(t, err)
+}
+
+func TestCreateRetrievePlanFromTemplate(t *testing.T) {
+	schemaPb := newTestSchema()
+	template := &registeredTemplate{id: 1, expr: `Int64Field in $ids`}
+	plan, err := createRetrievePlanFromTemplate(template, schemaPb, `{"ids": [1, 2]}`)
+	assert.Nil(t, err)
+	expected, err := createExprPlan(schemaPb, `Int64Field in [1, 2]`)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(expected, plan))
+
+	_, err = createRetrievePlanFromTemplate(template, schemaPb, `{"ids": 1}`)
+	assert.NotNil(t, err)
+}
+
+func TestPlanTemplateRegistry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	Params.Init()
+	etcdCli, err := etcd.GetEtcdClient(&Params.EtcdCfg)
+	assert.NoError(t, err)
+	defer etcdCli.Close()
+
+	rootPath := path.Join(Params.EtcdCfg.MetaRootPath, funcutil.RandomString(8))
+	registry := newPlanTemplateRegistry(etcdCli, rootPath, 1, time.Hour)
+	defer etcdCli.Delete(ctx, registry.prefix, clientv3.WithPrefix())
+	registry.start(ctx)
+
+	search := &registeredTemplate{id: 1, user: "alice", collectionName: "coll", expr: `Int64Field > $min`,
+		annsField: "FloatVectorField", outputFields: []string{"Int64Field"}}
+	query := &registeredTemplate{id: 2, user: "alice", collectionName: "coll", expr: `Int64Field in $ids`}
+	assert.NoError(t, registry.register(ctx, search))
+	template, err := registry.get(ctx, 1, "alice")
+	assert.NoError(t, err)
+	assert.Same(t, search, template)
+
+	// the least recently used template is evicted beyond the capacity, and loaded from etcd again
+	assert.NoError(t, registry.register(ctx, query))
+	assert.Equal(t, 1, registry.len())
+	template, err = registry.get(ctx, 1, "alice")
+	assert.NoError(t, err)
+	assert.NotSame(t, search, template)
+	assert.Equal(t, search.meta(), template.meta())
+
+	// the templates registered by the other proxies or before restart are loaded from etcd
+	other := newPlanTemplateRegistry(etcdCli, rootPath, 10, time.Hour)
+	template, err = other.get(ctx, 2, "alice")
+	assert.NoError(t, err)
+	assert.Equal(t, query.meta(), template.meta())
+
+	// only the user registering the template and root access it
+	_, err = registry.get(ctx, 1, "bob")
+	assert.Error(t, err)
+	_, err = registry.get(ctx, 1, util.UserRoot)
+	assert.NoError(t, err)
+	_, err = registry.get(ctx, 3, "alice")
+	assert.Error(t, err)
+
+	// the template is dropped on the collection it's registered for, and evicted from the other proxies
+	other.start(ctx)
+	assert.Error(t, registry.drop(ctx, 2, "bob", "", "coll"))
+	assert.Error(t, registry.drop(ctx, 2, "alice", "", "other"))
+	assert.NoError(t, registry.drop(ctx, 2, "alice", "", "coll"))
+	assert.Error(t, registry.drop(ctx, 2, "alice", "", "coll"))
+	_, err = registry.get(ctx, 2, "alice")
+	assert.Error(t, err)
+	assert.Eventually(t, func() bool {
+		return other.len() == 0
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// the templates expire with their leases
+	expiring := newPlanTemplateRegistry(etcdCli, rootPath, 10, time.Second)
+	assert.NoError(t, expiring.register(ctx, &registeredTemplate{id: 4, user: "alice", collectionName: "coll", expr: `Int64Field > $min`}))
+	_, err = expiring.get(ctx, 4, "alice")
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		_, err := expiring.get(ctx, 4, "alice")
+		return err != nil
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func TestApplyTemplate(t *testing.T) {
+	search := &registeredTemplate{id: 1, collectionName: "coll", expr: `Int64Field > $min`, annsField: "FloatVectorField",
+		outputFields: []string{"Int64Field"}}
+	query := &registeredTemplate{id: 2, collectionName: "coll", expr: `Int64Field in $ids`, outputFields: []string{"FloatField"}}
+
+	searchReq := &milvuspb.SearchRequest{
+		CollectionName: "coll",
+		Dsl:            "Int64Field > 0",
+		OutputFields:   []string{"FloatField"},
+		SearchParams: []*commonpb.KeyValuePair{
+			{Key: AnnsFieldKey, Value: "other"},
+			{Key: TopKKey, Value: "10"},
+		},
+	}
+	assert.Nil(t, applySearchTemplate(searchReq, search))
+	assert.Equal(t, search.expr, searchReq.Dsl)
+	assert.Equal(t, commonpb.DslType_BoolExprV1, searchReq.DslType)
+	assert.Equal(t, search.outputFields, searchReq.OutputFields)
+	annsField, err := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, searchReq.SearchParams)
+	assert.Nil(t, err)
+	assert.Equal(t, search.annsField, annsField)
+	assert.Equal(t, 2, len(searchReq.SearchParams))
+	assert.NotNil(t, applySearchTemplate(&milvuspb.SearchRequest{CollectionName: "coll"}, query))
+	assert.NotNil(t, applySearchTemplate(&milvuspb.SearchRequest{CollectionName: "other"}, search))
+
+	queryReq := &milvuspb.QueryRequest{CollectionName: "coll", Expr: "Int64Field > 0"}
+	assert.Nil(t, applyQueryTemplate(queryReq, query))
+	assert.Equal(t, query.expr, queryReq.Expr)
+	assert.Equal(t, query.outputFields, queryReq.OutputFields)
+	assert.NotNil(t, applyQueryTemplate(&milvuspb.QueryRequest{CollectionName: "coll"}, search))
+	assert.NotNil(t, applyQueryTemplate(&milvuspb.QueryRequest{DbName: "db", CollectionName: "coll"}, query))
+}
@@ -0,0 +1,331 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/rootcoord/model"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+const (
+	privilegeReloadBackoff    = 100 * time.Millisecond
+	privilegeReloadMaxBackoff = 10 * time.Second
+)
+
+// globalPrivilegeCache is nil until proxy initialized, all the requests of the users except root are denied then.
+var globalPrivilegeCache *privilegeCache
+
+// publicOperations are allowed for all the authenticated users
+var publicOperations = map[string]struct{}{
+	"GetComponentStates":   {},
+	"GetStatisticsChannel": {},
+}
+
+// rootOperations manage the databases, the roles and the API keys, only root is allowed to do them
+var rootOperations = map[string]struct{}{
+	"CreateDatabase": {},
+	"DropDatabase":   {},
+	"AlterDatabase":  {},
+	"SaveRole":       {},
+	"DropRole":       {},
+	"ListRoles":      {},
+	"SaveUserRoles":  {},
+	"ListUserRoles":  {},
+	"CreateAPIKey":   {},
+	"RevokeAPIKey":   {},
+}
+
+// readMetricTypes only get the states, which are allowed by the privilege of GetMetrics,
+// the other metric types are only allowed for root
+var readMetricTypes = map[string]struct{}{
+	metricsinfo.SystemInfoMetrics:          {},
+	metricsinfo.CollectionLifecycleMetrics: {},
+	metricsinfo.NodeLoadMetrics:            {},
+	metricsinfo.ShardClustersMetrics:       {},
+	metricsinfo.SegmentEventsMetrics:       {},
+	metricsinfo.ShardStatsMetrics:          {},
+	metricsinfo.ImportTasksMetrics:         {},
+	metricsinfo.IndexBuildTasksMetrics:     {},
+	metricsinfo.ConsumerLagsMetrics:        {},
+}
+
+// privilegeCache maintains the roles, the roles bound to users and the API keys stored by root coord,
+// all proxies watch the prefix so that the changes take effect at runtime.
+type privilegeCache struct {
+	etcdCli *clientv3.Client
+	prefix  string
+
+	mu        sync.RWMutex
+	roles     map[string][]model.Grant // role -> grants
+	userRoles map[string][]string      // username -> roles
+	apiKeys   map[string]string        // hash of API key -> username
+}
+
+func newPrivilegeCache(etcdCli *clientv3.Client, metaRootPath string) *privilegeCache {
+	return &privilegeCache{
+		etcdCli:   etcdCli,
+		prefix:    path.Join(metaRootPath, util.RBACPrefix) + "/",
+		roles:     make(map[string][]model.Grant),
+		userRoles: make(map[string][]string),
+		apiKeys:   make(map[string]string),
+	}
+}
+
+// start loads all the roles and the API keys and watches the changes until ctx done
+func (c *privilegeCache) start(ctx context.Context) error {
+	revision, err := c.reload(ctx)
+	if err != nil {
+		return err
+	}
+	go c.watch(ctx, revision)
+	return nil
+}
+
+func (c *privilegeCache) reload(ctx context.Context) (int64, error) {
+	resp, err := c.etcdCli.Get(ctx, c.prefix, clientv3.WithPrefix())
+	if err != nil {
+		return 0, err
+	}
+	roles := make(map[string][]model.Grant)
+	userRoles := make(map[string][]string)
+	apiKeys := make(map[string]string)
+	for _, kv := range resp.Kvs {
+		if err := c.parse(string(kv.Key), kv.Value, roles, userRoles, apiKeys); err != nil {
+			log.Warn("invalid rbac meta, ignored", zap.String("key", string(kv.Key)), zap.Error(err))
+		}
+	}
+
+	c.mu.Lock()
+	c.roles, c.userRoles, c.apiKeys = roles, userRoles, apiKeys
+	c.mu.Unlock()
+	log.Info("roles and api keys loaded", zap.Int("roles", len(roles)), zap.Int("users", len(userRoles)),
+		zap.Int("apiKeys", len(apiKeys)))
+	return resp.Header.Revision, nil
+}
+
+// parse puts the role, the roles of the user or the API key stored in the key into the maps
+func (c *privilegeCache) parse(key string, value []byte, roles map[string][]model.Grant,
+	userRoles map[string][]string, apiKeys map[string]string) error {
+	dir, name := path.Split(strings.TrimPrefix(key, c.prefix))
+	switch path.Join(util.RBACPrefix, dir) {
+	case util.RBACRolePrefix:
+		role := model.Role{}
+		if err := json.Unmarshal(value, &role); err != nil {
+			return err
+		}
+		roles[name] = role.Grants
+	case util.RBACUserRolePrefix:
+		var userRole []string
+		if err := json.Unmarshal(value, &userRole); err != nil {
+			return err
+		}
+		userRoles[name] = userRole
+	case util.RBACAPIKeyPrefix:
+		apiKeys[name] = string(value)
+	}
+	return nil
+}
+
+// watch reloads all of them on any change, as the roles and the API keys are few and rarely changed.
+// The cache is reloaded and watched again once the watch is broken or a reload failed, until ctx done.
+func (c *privilegeCache) watch(ctx context.Context, revision int64) {
+	watchChan := c.etcdCli.Watch(ctx, c.prefix, clientv3.WithPrefix(), clientv3.WithRev(revision+1))
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("privilege cache watch loop exit")
+			return
+		case resp, ok := <-watchChan:
+			if !ok || resp.Err() != nil {
+				log.Warn("watch roles and api keys failed, reload and watch again", zap.Bool("closed", !ok),
+					zap.Error(resp.Err()))
+			} else if _, err := c.reload(ctx); err != nil {
+				log.Warn("reload roles and api keys failed, reload and watch again", zap.Error(err))
+			} else {
+				continue
+			}
+			revision, ok = c.reloadWithBackoff(ctx)
+			if !ok {
+				log.Info("privilege cache watch loop exit")
+				return
+			}
+			watchChan = c.etcdCli.Watch(ctx, c.prefix, clientv3.WithPrefix(), clientv3.WithRev(revision+1))
+		}
+	}
+}
+
+// reloadWithBackoff reloads the cache until succeeded, the retry interval doubles up to privilegeReloadMaxBackoff,
+// false is returned if ctx is done
+func (c *privilegeCache) reloadWithBackoff(ctx context.Context) (int64, bool) {
+	backoff := privilegeReloadBackoff
+	for {
+		revision, err := c.reload(ctx)
+		if err == nil {
+			return revision, true
+		}
+		log.Warn("reload roles and api keys failed, retry later", zap.Duration("backoff", backoff), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return 0, false
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > privilegeReloadMaxBackoff {
+			backoff = privilegeReloadMaxBackoff
+		}
+	}
+}
+
+// getAPIKeyUser returns the user of the API key, false if the key is unknown
+func (c *privilegeCache) getAPIKeyUser(key string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	username, ok := c.apiKeys[crypto.HashAPIKey(key)]
+	return username, ok
+}
+
+// checkPrivilege returns error unless the roles of the user grant the operation on the collection of the database,
+// the operations without collection require the grants on all the collections of the database. The empty database
+// is the default one. Root is allowed to do anything.
+func (c *privilegeCache) checkPrivilege(username string, operation string, database string, collection string) error {
+	if username == util.UserRoot {
+		return nil
+	}
+	if _, ok := publicOperations[operation]; ok && username != "" {
+		return nil
+	}
+	if c == nil {
+		return ErrProxyNotReady()
+	}
+	if database == "" {
+		database = common.DefaultDatabase
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, role := range c.userRoles[username] {
+		for _, grant := range c.roles[role] {
+			if !grantDatabase(grant, database) {
+				continue
+			}
+			if grant.Collection != util.AnyObject && (collection == "" || grant.Collection != collection) {
+				continue
+			}
+			for _, op := range grant.Operations {
+				if op == util.AnyObject || op == operation {
+					return nil
+				}
+			}
+		}
+	}
+	if collection != "" && database != common.DefaultDatabase {
+		collection = fmt.Sprintf("%s/%s", database, collection)
+	}
+	return ErrPermissionDenied(username, operation, collection)
+}
+
+// grantDatabase returns whether the grant is on the database, the grants without database are on the default one
+func grantDatabase(grant model.Grant, database string) bool {
+	switch grant.Database {
+	case util.AnyObject:
+		return true
+	case "":
+		return database == common.DefaultDatabase
+	default:
+		return grant.Database == database
+	}
+}
+
+// requestCollection returns the collection the request operates on, empty if none
+func requestCollection(req interface{}) string {
+	switch r := req.(type) {
+	case interface{ GetCollectionName() string }:
+		return r.GetCollectionName()
+	case *milvuspb.RenameCollectionRequest:
+		return r.GetOldName()
+	}
+	return ""
+}
+
+// authorizeRequest returns error unless the authenticated user of ctx is allowed to do the operation with req,
+// the requests from Milvus members authenticated by their client certificates are not checked, see isMember.
+func authorizeRequest(ctx context.Context, operation string, req interface{}) error {
+	if isMember(ctx) {
+		return nil
+	}
+	username := getCurUser(ctx)
+	if _, ok := rootOperations[operation]; ok && username != util.UserRoot {
+		return ErrPermissionDenied(username, operation, "")
+	}
+	database, collection := requestDatabase(ctx, req), requestCollection(req)
+	if r, ok := req.(*milvuspb.GetMetricsRequest); ok {
+		metricType, _ := metricsinfo.ParseMetricType(r.GetRequest())
+		if _, ok := readMetricTypes[metricType]; !ok && username != util.UserRoot {
+			return ErrPermissionDenied(username, metricType, "")
+		}
+		// the collection names in the metric requests are in the database of the request
+		if name, _ := metricsinfo.ParseCollectionName(r.GetRequest()); name != "" {
+			collection = name
+		}
+	}
+	if err := globalPrivilegeCache.checkPrivilege(username, operation, database, collection); err != nil {
+		log.RatedWarn(10, "request denied", zap.String("username", username), zap.String("operation", operation),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// rbacEnabled returns whether the requests are authorized with the roles, which requires the authentication too.
+// It's off by default, so the existing deployments with authentication keep working before the grants are created.
+func rbacEnabled() bool {
+	return Params.CommonCfg.AuthorizationEnabled && Params.CommonCfg.RBACEnabled
+}
+
+// PrivilegeInterceptor returns a unary server interceptor which authorizes the requests of the users with the grants
+// of their roles, it must be chained after the authentication. The requests from Milvus members are not checked, see
+// isMember.
+func PrivilegeInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !rbacEnabled() || !strings.HasPrefix(info.FullMethod, "/milvus.proto.milvus.MilvusService/") {
+			return handler(ctx, req)
+		}
+		if err := authorizeRequest(ctx, path.Base(info.FullMethod), req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
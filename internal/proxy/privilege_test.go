@@ -0,0 +1,316 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/rootcoord/model"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+func newTestPrivilegeCache() *privilegeCache {
+	c := newPrivilegeCache(nil, "/root")
+	c.roles["reader"] = []model.Grant{{Collection: "coll", Operations: []string{"Search", "Query"}}}
+	c.roles["admin"] = []model.Grant{{Collection: util.AnyObject, Operations: []string{util.AnyObject}}}
+	c.userRoles["alice"] = []string{"reader"}
+	c.userRoles["bob"] = []string{"admin"}
+	c.apiKeys[crypto.HashAPIKey("key")] = "alice"
+	return c
+}
+
+func TestPrivilegeCache_parse(t *testing.T) {
+	c := newPrivilegeCache(nil, "/root")
+	roles := make(map[string][]model.Grant)
+	userRoles := make(map[string][]string)
+	apiKeys := make(map[string]string)
+
+	err := c.parse(c.prefix+"roles/reader", []byte(`{"name":"reader","grants":[{"collection":"*","operations":["Search"]}]}`),
+		roles, userRoles, apiKeys)
+	assert.NoError(t, err)
+	err = c.parse(c.prefix+"user-roles/alice", []byte(`["reader"]`), roles, userRoles, apiKeys)
+	assert.NoError(t, err)
+	err = c.parse(c.prefix+"api-keys/hash", []byte(`alice`), roles, userRoles, apiKeys)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.Grant{{Collection: "*", Operations: []string{"Search"}}}, roles["reader"])
+	assert.Equal(t, []string{"reader"}, userRoles["alice"])
+	assert.Equal(t, "alice", apiKeys["hash"])
+
+	err = c.parse(c.prefix+"user-roles/bob", []byte(`invalid`), roles, userRoles, apiKeys)
+	assert.Error(t, err)
+}
+
+func TestPrivilegeCache_checkPrivilege(t *testing.T) {
+	c := newTestPrivilegeCache()
+
+	assert.NoError(t, c.checkPrivilege(util.UserRoot, "DropCollection", "", "coll"))
+	assert.NoError(t, c.checkPrivilege("alice", "Search", "", "coll"))
+	assert.NoError(t, c.checkPrivilege("alice", "GetComponentStates", "", ""))
+	assert.Error(t, c.checkPrivilege("alice", "Insert", "", "coll"))
+	assert.Error(t, c.checkPrivilege("alice", "Search", "", "other"))
+	// the operations without collection require the grants on all the collections
+	assert.Error(t, c.checkPrivilege("alice", "ShowCollections", "", ""))
+	assert.NoError(t, c.checkPrivilege("bob", "ShowCollections", "", ""))
+	assert.NoError(t, c.checkPrivilege("bob", "Insert", "", "other"))
+	assert.Error(t, c.checkPrivilege("carol", "Search", "", "coll"))
+	assert.Error(t, c.checkPrivilege("", "GetComponentStates", "", ""))
+
+	// the grants without database are on the default database
+	assert.Error(t, c.checkPrivilege("alice", "Search", "db1", "coll"))
+	assert.Error(t, c.checkPrivilege("bob", "ShowCollections", "db1", ""))
+	c.roles["db1_reader"] = []model.Grant{{Database: "db1", Collection: util.AnyObject, Operations: []string{"Search", "ShowCollections"}}}
+	c.roles["any_reader"] = []model.Grant{{Database: util.AnyObject, Collection: "coll", Operations: []string{"Query"}}}
+	c.userRoles["carol"] = []string{"db1_reader", "any_reader"}
+	assert.NoError(t, c.checkPrivilege("carol", "Search", "db1", "coll"))
+	assert.NoError(t, c.checkPrivilege("carol", "ShowCollections", "db1", ""))
+	assert.Error(t, c.checkPrivilege("carol", "ShowCollections", "", ""))
+	assert.NoError(t, c.checkPrivilege("carol", "Query", "db2", "coll"))
+	assert.NoError(t, c.checkPrivilege("carol", "Query", common.DefaultDatabase, "coll"))
+	assert.Error(t, c.checkPrivilege("carol", "Query", "db2", "other"))
+
+	var nilCache *privilegeCache
+	assert.NoError(t, nilCache.checkPrivilege(util.UserRoot, "Search", "", "coll"))
+	assert.Error(t, nilCache.checkPrivilege("alice", "Search", "", "coll"))
+	_, ok := nilCache.getAPIKeyUser("key")
+	assert.False(t, ok)
+}
+
+func TestPrivilegeInterceptor(t *testing.T) {
+	defer func(enabled bool, rbacEnabled bool, cache *privilegeCache) {
+		Params.CommonCfg.AuthorizationEnabled = enabled
+		Params.CommonCfg.RBACEnabled = rbacEnabled
+		globalPrivilegeCache = cache
+	}(Params.CommonCfg.AuthorizationEnabled, Params.CommonCfg.RBACEnabled, globalPrivilegeCache)
+	Params.CommonCfg.RBACEnabled = true
+	globalPrivilegeCache = newTestPrivilegeCache()
+
+	interceptor := PrivilegeInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	searchInfo := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Search"}
+	insertInfo := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Insert"}
+	metricsInfo := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/GetMetrics"}
+	userContext := func(user string) context.Context {
+		return metadata.NewIncomingContext(context.Background(),
+			metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode(user+util.CredentialSeperator+"Milvus")))
+	}
+
+	// not checked if the authorization is disabled
+	Params.CommonCfg.AuthorizationEnabled = false
+	_, err := interceptor(userContext("alice"), &milvuspb.InsertRequest{CollectionName: "coll"}, insertInfo, handler)
+	assert.NoError(t, err)
+
+	// not checked if rbac is disabled even the authorization is enabled
+	Params.CommonCfg.AuthorizationEnabled = true
+	Params.CommonCfg.RBACEnabled = false
+	_, err = interceptor(userContext("alice"), &milvuspb.InsertRequest{CollectionName: "coll"}, insertInfo, handler)
+	assert.NoError(t, err)
+
+	Params.CommonCfg.RBACEnabled = true
+	resp, err := interceptor(userContext("alice"), &milvuspb.SearchRequest{CollectionName: "coll"}, searchInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+	_, err = interceptor(userContext("alice"), &milvuspb.InsertRequest{CollectionName: "coll"}, insertInfo, handler)
+	assert.Error(t, err)
+
+	// the user authenticated by the API key
+	_, err = interceptor(withCurUser(context.Background(), "alice"), &milvuspb.SearchRequest{CollectionName: "coll"}, searchInfo, handler)
+	assert.NoError(t, err)
+
+	// the sourceId header is forged without the client certificate of a member
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderSourceID, crypto.Base64Encode(util.MemberCredID)))
+	_, err = interceptor(ctx, &milvuspb.InsertRequest{CollectionName: "coll"}, insertInfo, handler)
+	assert.Error(t, err)
+	_, err = MemberInterceptor()(ctx, &milvuspb.InsertRequest{CollectionName: "coll"}, insertInfo,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor(ctx, req, insertInfo, handler)
+		})
+	assert.Error(t, err)
+
+	// the members authenticated by their client certificates are not checked
+	memberCtx := peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{
+		State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{&x509.Certificate{}}}},
+	}})
+	_, err = MemberInterceptor()(memberCtx, &milvuspb.InsertRequest{CollectionName: "coll"}, insertInfo,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor(ctx, req, insertInfo, handler)
+		})
+	assert.NoError(t, err)
+	// but the users are, even over the connections with client certificates
+	userMemberCtx := peer.NewContext(userContext("alice"), &peer.Peer{AuthInfo: credentials.TLSInfo{
+		State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{&x509.Certificate{}}}},
+	}})
+	_, err = MemberInterceptor()(userMemberCtx, &milvuspb.InsertRequest{CollectionName: "coll"}, insertInfo,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor(ctx, req, insertInfo, handler)
+		})
+	assert.Error(t, err)
+
+	// only root manages the roles
+	saveRoleInfo := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/SaveRole"}
+	_, err = interceptor(userContext("bob"), &milvuspb.SaveRoleRequest{}, saveRoleInfo, handler)
+	assert.Error(t, err)
+	_, err = interceptor(userContext(util.UserRoot), &milvuspb.SaveRoleRequest{}, saveRoleInfo, handler)
+	assert.NoError(t, err)
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	assert.NoError(t, err)
+	_, err = interceptor(userContext("bob"), req, metricsInfo, handler)
+	assert.NoError(t, err)
+
+	// the metric requests of a collection are authorized on the collection
+	metricRequest := func(params map[string]interface{}) *milvuspb.GetMetricsRequest {
+		b, err := json.Marshal(params)
+		assert.NoError(t, err)
+		return &milvuspb.GetMetricsRequest{Request: string(b)}
+	}
+	globalPrivilegeCache.roles["alterer"] = []model.Grant{{Collection: "coll", Operations: []string{"GetMetrics", "RenameCollection"}}}
+	globalPrivilegeCache.userRoles["dave"] = []string{"alterer"}
+	req = metricRequest(map[string]interface{}{metricsinfo.MetricTypeKey: metricsinfo.CollectionLifecycleMetrics,
+		metricsinfo.CollectionNameKey: "coll"})
+	_, err = interceptor(userContext("dave"), req, metricsInfo, handler)
+	assert.NoError(t, err)
+	_, err = interceptor(userContext("alice"), req, metricsInfo, handler)
+	assert.Error(t, err)
+
+	// the collection renamed is authorized by its old name
+	renameInfo := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/RenameCollection"}
+	_, err = interceptor(userContext("dave"), &milvuspb.RenameCollectionRequest{OldName: "coll", NewName: "coll2"}, renameInfo, handler)
+	assert.NoError(t, err)
+	_, err = interceptor(userContext("dave"), &milvuspb.RenameCollectionRequest{OldName: "coll2", NewName: "coll"}, renameInfo, handler)
+	assert.Error(t, err)
+
+	// the unknown metric types are only allowed for root
+	req, err = metricsinfo.ConstructRequestByMetricType("unknown")
+	assert.NoError(t, err)
+	_, err = interceptor(userContext("bob"), req, metricsInfo, handler)
+	assert.Error(t, err)
+	_, err = interceptor(userContext(util.UserRoot), req, metricsInfo, handler)
+	assert.NoError(t, err)
+}
+
+func TestAuthenticationInterceptor_APIKey(t *testing.T) {
+	defer func(enabled bool, rbacEnabled bool, cache *privilegeCache) {
+		Params.CommonCfg.AuthorizationEnabled = enabled
+		Params.CommonCfg.RBACEnabled = rbacEnabled
+		globalPrivilegeCache = cache
+	}(Params.CommonCfg.AuthorizationEnabled, Params.CommonCfg.RBACEnabled, globalPrivilegeCache)
+	Params.CommonCfg.RBACEnabled = true
+	Params.CommonCfg.AuthorizationEnabled = true
+	globalPrivilegeCache = newTestPrivilegeCache()
+	err := InitMetaCache(&MockRootCoordClientInterface{})
+	assert.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderAPIKey, "key"))
+	ctx, err = AuthenticationInterceptor(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", getCurUser(ctx))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderAPIKey, "invalid"))
+	_, err = AuthenticationInterceptor(ctx)
+	assert.Error(t, err)
+}
+
+func TestPrivilegeCache_watch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	Params.Init()
+	etcdCli, err := etcd.GetEtcdClient(&Params.EtcdCfg)
+	assert.NoError(t, err)
+	defer etcdCli.Close()
+
+	rootPath := path.Join(Params.EtcdCfg.MetaRootPath, funcutil.RandomString(8))
+	c := newPrivilegeCache(etcdCli, rootPath)
+	defer etcdCli.Delete(ctx, c.prefix, clientv3.WithPrefix())
+
+	_, err = etcdCli.Put(ctx, c.prefix+"api-keys/"+crypto.HashAPIKey("key"), "alice")
+	assert.NoError(t, err)
+
+	err = c.start(ctx)
+	assert.NoError(t, err)
+	username, ok := c.getAPIKeyUser("key")
+	assert.True(t, ok)
+	assert.Equal(t, "alice", username)
+
+	_, err = etcdCli.Put(ctx, c.prefix+"roles/reader", `{"name":"reader","grants":[{"collection":"coll","operations":["Search"]}]}`)
+	assert.NoError(t, err)
+	_, err = etcdCli.Put(ctx, c.prefix+"user-roles/alice", `["reader"]`)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return c.checkPrivilege("alice", "Search", "", "coll") == nil
+	}, 5*time.Second, 50*time.Millisecond)
+
+	_, err = etcdCli.Delete(ctx, c.prefix+"api-keys/"+crypto.HashAPIKey("key"))
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		_, ok := c.getAPIKeyUser("key")
+		return !ok
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestPrivilegeCache_rewatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	Params.Init()
+	etcdCli, err := etcd.GetEtcdClient(&Params.EtcdCfg)
+	assert.NoError(t, err)
+	defer etcdCli.Close()
+
+	rootPath := path.Join(Params.EtcdCfg.MetaRootPath, funcutil.RandomString(8))
+	c := newPrivilegeCache(etcdCli, rootPath)
+	defer etcdCli.Delete(ctx, c.prefix, clientv3.WithPrefix())
+
+	resp, err := etcdCli.Put(ctx, c.prefix+"api-keys/"+crypto.HashAPIKey("key"), "alice")
+	assert.NoError(t, err)
+	_, err = etcdCli.Compact(ctx, resp.Header.Revision)
+	assert.NoError(t, err)
+
+	// the watch from the compacted revision fails, the cache is reloaded and watched again
+	go c.watch(ctx, 0)
+	assert.Eventually(t, func() bool {
+		_, ok := c.getAPIKeyUser("key")
+		return ok
+	}, 5*time.Second, 50*time.Millisecond)
+
+	_, err = etcdCli.Put(ctx, c.prefix+"api-keys/"+crypto.HashAPIKey("key2"), "bob")
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		username, ok := c.getAPIKeyUser("key2")
+		return ok && username == "bob"
+	}, 5*time.Second, 50*time.Millisecond)
+}
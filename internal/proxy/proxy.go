@@ -96,6 +96,12 @@ type Proxy struct {
 
 	searchResultCh chan *internalpb.SearchResults
 
+	// audit logger of the searches and queries, nil if disabled
+	auditLogger *auditLogger
+
+	// templates are the search and query templates registered, which are stored in etcd and shared by the proxies
+	templates *planTemplateRegistry
+
 	// Add callback functions at different stages
 	startCallbacks []func()
 	closeCallbacks []func()
@@ -251,6 +257,21 @@ func (node *Proxy) Init() error {
 	}
 	log.Debug("init meta cache done", zap.String("role", typeutil.ProxyRole))
 
+	globalCollectionLimiter = newCollectionLimiter(node.etcdCli, Params.EtcdCfg.MetaRootPath)
+	globalBackpressure = newBackpressureWatcher(node.etcdCli, Params.EtcdCfg.MetaRootPath)
+	globalPrivilegeCache = newPrivilegeCache(node.etcdCli, Params.EtcdCfg.MetaRootPath)
+	globalDatabaseCache = newDatabaseCache(node.etcdCli, Params.EtcdCfg.MetaRootPath)
+	if Params.ProxyCfg.QuotaEnabled {
+		globalQuotaLimiter = newQuotaLimiter()
+	}
+	globalPlanTemplateCache = newPlanTemplateCache(int(Params.ProxyCfg.PlanTemplateCacheSize))
+	node.templates = newPlanTemplateRegistry(node.etcdCli, Params.EtcdCfg.MetaRootPath,
+		int(Params.ProxyCfg.MaxTemplateNum), Params.ProxyCfg.TemplateTTL)
+
+	if Params.ProxyCfg.AuditLogEnabled {
+		node.auditLogger = newAuditLogger(node.ctx, newAuditZapLogger(), Params.ProxyCfg.AuditLogBufferSize)
+	}
+
 	return nil
 }
 
@@ -352,8 +373,42 @@ func (node *Proxy) Start() error {
 	}
 	log.Debug("start channels time ticker done", zap.String("role", typeutil.ProxyRole))
 
+	log.Debug("start collection limiter", zap.String("role", typeutil.ProxyRole))
+	if err := globalCollectionLimiter.start(node.ctx); err != nil {
+		log.Warn("failed to start collection limiter", zap.Error(err), zap.String("role", typeutil.ProxyRole))
+		return err
+	}
+	log.Debug("start collection limiter done", zap.String("role", typeutil.ProxyRole))
+
+	log.Debug("start datanode backpressure watcher", zap.String("role", typeutil.ProxyRole))
+	if err := globalBackpressure.start(node.ctx); err != nil {
+		log.Warn("failed to start datanode backpressure watcher", zap.Error(err), zap.String("role", typeutil.ProxyRole))
+		return err
+	}
+	log.Debug("start datanode backpressure watcher done", zap.String("role", typeutil.ProxyRole))
+
+	log.Debug("start privilege cache", zap.String("role", typeutil.ProxyRole))
+	if err := globalPrivilegeCache.start(node.ctx); err != nil {
+		log.Warn("failed to start privilege cache", zap.Error(err), zap.String("role", typeutil.ProxyRole))
+		return err
+	}
+	log.Debug("start privilege cache done", zap.String("role", typeutil.ProxyRole))
+
+	log.Debug("start database cache", zap.String("role", typeutil.ProxyRole))
+	if err := globalDatabaseCache.start(node.ctx); err != nil {
+		log.Warn("failed to start database cache", zap.Error(err), zap.String("role", typeutil.ProxyRole))
+		return err
+	}
+	log.Debug("start database cache done", zap.String("role", typeutil.ProxyRole))
+
+	node.templates.start(node.ctx)
+
 	node.sendChannelsTimeTickLoop()
 
+	if node.auditLogger != nil {
+		node.auditLogger.start()
+	}
+
 	// Start callbacks
 	for _, cb := range node.startCallbacks {
 		cb()
@@ -396,6 +451,11 @@ func (node *Proxy) Stop() error {
 		log.Info("close channels time ticker", zap.String("role", typeutil.ProxyRole))
 	}
 
+	if node.auditLogger != nil {
+		node.auditLogger.close()
+		log.Info("close audit logger", zap.String("role", typeutil.ProxyRole))
+	}
+
 	node.wg.Wait()
 
 	for _, cb := range node.closeCallbacks {
@@ -18,6 +18,7 @@ package proxy
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"os"
 	"strconv"
@@ -853,7 +854,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("describe collection", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
@@ -1022,7 +1023,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show partitions", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1257,7 +1258,7 @@ func TestProxy(t *testing.T) {
 	t.Run("get replicas", func(t *testing.T) {
 		defer wg.Done()
 
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.GetReplicas(ctx, &milvuspb.GetReplicasRequest{
@@ -1267,6 +1268,131 @@ func TestProxy(t *testing.T) {
 		assert.Equal(t, 1, len(resp.Replicas))
 	})
 
+	wg.Add(1)
+	t.Run("get loading progress", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.GetLoadingProgress(ctx, &milvuspb.GetLoadingProgressRequest{
+			CollectionName: collectionName,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, int64(100), resp.Percentage)
+
+		resp, err = proxy.GetLoadingProgress(ctx, &milvuspb.GetLoadingProgressRequest{
+			CollectionName: otherCollectionName,
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("get release jobs", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.GetReleaseJobs(ctx, &milvuspb.GetReleaseJobsRequest{
+			CollectionName: collectionName,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+		resp, err = proxy.GetReleaseJobs(ctx, &milvuspb.GetReleaseJobsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+		resp, err = proxy.GetReleaseJobs(ctx, &milvuspb.GetReleaseJobsRequest{
+			CollectionName: otherCollectionName,
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("cordon node", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.GetCordonedNodes(ctx, &milvuspb.GetCordonedNodesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, 0, len(resp.Nodes))
+
+		resp, err = proxy.CordonNode(ctx, &milvuspb.CordonNodeRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+		resp, err = proxy.UncordonNode(ctx, &milvuspb.UncordonNodeRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("balance", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.Balance(ctx, &milvuspb.BalanceRequest{DryRun: true})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.True(t, resp.DryRun)
+	})
+
+	wg.Add(1)
+	t.Run("register template", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.RegisterTemplate(ctx, &milvuspb.RegisterTemplateRequest{
+			CollectionName: collectionName,
+			Expr:           fmt.Sprintf("%s > $min", int64Field),
+			AnnsField:      floatVecField,
+			OutputFields:   []string{int64Field},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.NotEqual(t, int64(0), resp.TemplateID)
+
+		// the template is dropped on the collection it's registered for
+		status, err := proxy.DropTemplate(ctx, &milvuspb.DropTemplateRequest{TemplateID: resp.TemplateID, CollectionName: otherCollectionName})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		status, err = proxy.DropTemplate(ctx, &milvuspb.DropTemplateRequest{TemplateID: resp.TemplateID, CollectionName: collectionName})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		status, err = proxy.DropTemplate(ctx, &milvuspb.DropTemplateRequest{TemplateID: resp.TemplateID, CollectionName: collectionName})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		resp, err = proxy.RegisterTemplate(ctx, &milvuspb.RegisterTemplateRequest{
+			CollectionName: collectionName,
+			Expr:           fmt.Sprintf("%s > $min", int64Field),
+			AnnsField:      int64Field,
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+		resp, err = proxy.RegisterTemplate(ctx, &milvuspb.RegisterTemplateRequest{
+			CollectionName: otherCollectionName,
+			Expr:           fmt.Sprintf("%s > $min", int64Field),
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("explain", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.Explain(ctx, &milvuspb.ExplainRequest{
+			CollectionName: collectionName,
+			Expr:           fmt.Sprintf("%s > 0", int64Field),
+			AnnsField:      floatVecField,
+			Topk:           10,
+			Nq:             1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.True(t, resp.IsSearch)
+
+		resp, err = proxy.Explain(ctx, &milvuspb.ExplainRequest{
+			CollectionName: otherCollectionName,
+			Expr:           fmt.Sprintf("%s > 0", int64Field),
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	// nprobe := 10
 	// topk := 10
 	// roundDecimal := 6
@@ -1611,7 +1737,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("release collection", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ReleaseCollection(ctx, &milvuspb.ReleaseCollectionRequest{
@@ -1688,7 +1814,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show in-memory partitions", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1745,7 +1871,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show in-memory partitions after release partition", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1841,7 +1967,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show partitions after drop partition", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1858,6 +1984,26 @@ func TestProxy(t *testing.T) {
 		assert.Equal(t, 1, len(resp.PartitionNames))
 	})
 
+	wg.Add(1)
+	t.Run("cancel index build", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.CancelIndexBuild(ctx, &milvuspb.CancelIndexBuildRequest{
+			DbName:         dbName,
+			CollectionName: collectionName,
+			IndexName:      indexName,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+		resp, err = proxy.CancelIndexBuild(ctx, &milvuspb.CancelIndexBuildRequest{
+			DbName:         dbName,
+			CollectionName: otherCollectionName,
+			IndexName:      indexName,
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("drop index", func(t *testing.T) {
 		defer wg.Done()
@@ -1888,7 +2034,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("drop collection", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.DropCollection(ctx, &milvuspb.DropCollectionRequest{
@@ -2348,6 +2494,102 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("GetLoadingProgress fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.GetLoadingProgress(ctx, &milvuspb.GetLoadingProgressRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("GetReleaseJobs fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.GetReleaseJobs(ctx, &milvuspb.GetReleaseJobsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("RegisterTemplate fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.RegisterTemplate(ctx, &milvuspb.RegisterTemplateRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("DropTemplate fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.DropTemplate(ctx, &milvuspb.DropTemplateRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("Explain fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.Explain(ctx, &milvuspb.ExplainRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("CordonNode fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.CordonNode(ctx, &milvuspb.CordonNodeRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+		resp, err = proxy.UncordonNode(ctx, &milvuspb.UncordonNodeRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+		resp, err = proxy.GetCordonedNodes(ctx, &milvuspb.GetCordonedNodesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("Balance fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.Balance(ctx, &milvuspb.BalanceRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("CancelIndexBuild fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.CancelIndexBuild(ctx, &milvuspb.CancelIndexBuildRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("RenameCollection fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("AddCollectionField fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AddCollectionField(ctx, &milvuspb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("AlterCollection fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("GetPersistentSegmentInfo fail, unhealthy", func(t *testing.T) {
 		defer wg.Done()
@@ -2587,6 +2829,30 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("RenameCollection fail, dd queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("AddCollectionField fail, dd queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AddCollectionField(ctx, &milvuspb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("AlterCollection fail, dd queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	proxy.sched.ddQueue.setMaxTaskNum(ddParallel)
 
 	dmParallelism := proxy.sched.dmQueue.getMaxTaskNum()
@@ -2863,6 +3129,30 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("RenameCollection fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.RenameCollection(shortCtx, &milvuspb.RenameCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("AddCollectionField fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AddCollectionField(shortCtx, &milvuspb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("AlterCollection fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AlterCollection(shortCtx, &milvuspb.AlterCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("CreateCredential fail, timeout", func(t *testing.T) {
 		defer wg.Done()
@@ -3089,6 +3379,30 @@ func TestProxy_GetImportState(t *testing.T) {
 	})
 }
 
+func TestProxy_CancelImport(t *testing.T) {
+	req := &milvuspb.CancelImportRequest{
+		Task: 1,
+	}
+	rootCoord := &RootCoordMock{}
+	rootCoord.state.Store(internalpb.StateCode_Healthy)
+	t.Run("test cancel import", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+
+		resp, err := proxy.CancelImport(context.TODO(), req)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, req.GetTask(), resp.GetId())
+		assert.Nil(t, err)
+	})
+	t.Run("test cancel import with unhealthy", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(internalpb.StateCode_Abnormal)
+		resp, err := proxy.CancelImport(context.TODO(), req)
+		assert.EqualValues(t, unhealthyStatus(), resp.Status)
+		assert.Nil(t, err)
+	})
+}
+
 func TestProxy_ListImportTasks(t *testing.T) {
 	req := &milvuspb.ListImportTasksRequest{}
 	rootCoord := &RootCoordMock{}
@@ -3109,3 +3423,52 @@ func TestProxy_ListImportTasks(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestProxy_RBAC(t *testing.T) {
+	ctx := context.Background()
+	proxy := &Proxy{rootCoord: &RootCoordMock{}}
+
+	status, err := proxy.SaveRole(ctx, &milvuspb.SaveRoleRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+	status, err = proxy.SaveRole(ctx, &milvuspb.SaveRoleRequest{Role: &milvuspb.RoleEntity{Name: "reader"}})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	status, err = proxy.DropRole(ctx, &milvuspb.DropRoleRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+	status, err = proxy.DropRole(ctx, &milvuspb.DropRoleRequest{RoleName: "reader"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	roles, err := proxy.ListRoles(ctx, &milvuspb.ListRolesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, roles.GetStatus().GetErrorCode())
+
+	status, err = proxy.SaveUserRoles(ctx, &milvuspb.SaveUserRolesRequest{RoleNames: []string{"reader"}})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+	status, err = proxy.SaveUserRoles(ctx, &milvuspb.SaveUserRolesRequest{Username: "alice", RoleNames: []string{"reader"}})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	userRoles, err := proxy.ListUserRoles(ctx, &milvuspb.ListUserRolesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, userRoles.GetStatus().GetErrorCode())
+
+	apiKey, err := proxy.CreateAPIKey(ctx, &milvuspb.CreateAPIKeyRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, apiKey.GetStatus().GetErrorCode())
+	apiKey, err = proxy.CreateAPIKey(ctx, &milvuspb.CreateAPIKeyRequest{Username: "alice"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, apiKey.GetStatus().GetErrorCode())
+	assert.NotEmpty(t, apiKey.GetApiKey())
+
+	status, err = proxy.RevokeAPIKey(ctx, &milvuspb.RevokeAPIKeyRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+	status, err = proxy.RevokeAPIKey(ctx, &milvuspb.RevokeAPIKeyRequest{ApiKey: apiKey.GetApiKey()})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+}
@@ -36,6 +36,8 @@ type QueryCoordMockOption func(mock *QueryCoordMock)
 
 type queryCoordShowCollectionsFuncType func(ctx context.Context, request *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error)
 
+type queryCoordGetSegmentInfoFuncType func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
+
 type queryCoordShowPartitionsFuncType func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error)
 
 func SetQueryCoordShowCollectionsFunc(f queryCoordShowCollectionsFuncType) QueryCoordMockOption {
@@ -44,6 +46,12 @@ func SetQueryCoordShowCollectionsFunc(f queryCoordShowCollectionsFuncType) Query
 	}
 }
 
+func SetQueryCoordGetSegmentInfoFunc(f queryCoordGetSegmentInfoFuncType) QueryCoordMockOption {
+	return func(mock *QueryCoordMock) {
+		mock.getSegmentInfoFunc = f
+	}
+}
+
 func withValidShardLeaders() QueryCoordMockOption {
 	return func(mock *QueryCoordMock) {
 		mock.validShardLeaders = true
@@ -63,6 +71,7 @@ type QueryCoordMock struct {
 	showCollectionsFunc queryCoordShowCollectionsFuncType
 	getMetricsFunc      getMetricsFuncType
 	showPartitionsFunc  queryCoordShowPartitionsFuncType
+	getSegmentInfoFunc  queryCoordGetSegmentInfoFuncType
 
 	statisticsChannel string
 	timeTickChannel   string
@@ -317,6 +326,10 @@ func (coord *QueryCoordMock) GetSegmentInfo(ctx context.Context, req *querypb.Ge
 		}, nil
 	}
 
+	if coord.getSegmentInfoFunc != nil {
+		return coord.getSegmentInfoFunc(ctx, req)
+	}
+
 	panic("implement me")
 }
 
@@ -406,6 +419,113 @@ func (coord *QueryCoordMock) GetShardLeaders(ctx context.Context, req *querypb.G
 	}, nil
 }
 
+func (coord *QueryCoordMock) SyncNewCreatedPartition(ctx context.Context, req *querypb.SyncNewCreatedPartitionRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "unhealthy",
+		}, nil
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (coord *QueryCoordMock) RefreshCollection(ctx context.Context, req *querypb.RefreshCollectionRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "unhealthy",
+		}, nil
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (coord *QueryCoordMock) GetLoadingProgress(ctx context.Context, req *querypb.GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error) {
+	if !coord.healthy() {
+		return &milvuspb.GetLoadingProgressResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "unhealthy",
+			},
+		}, nil
+	}
+	return &milvuspb.GetLoadingProgressResponse{
+		Status:       &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		CollectionID: req.GetCollectionID(),
+		Percentage:   100,
+	}, nil
+}
+
+func (coord *QueryCoordMock) GetReleaseJobs(ctx context.Context, req *querypb.GetReleaseJobsRequest) (*milvuspb.GetReleaseJobsResponse, error) {
+	if !coord.healthy() {
+		return &milvuspb.GetReleaseJobsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "unhealthy",
+			},
+		}, nil
+	}
+	return &milvuspb.GetReleaseJobsResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func (coord *QueryCoordMock) CordonNode(ctx context.Context, req *milvuspb.CordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	if !coord.healthy() {
+		return &milvuspb.CordonedNodesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "unhealthy",
+			},
+		}, nil
+	}
+	return &milvuspb.CordonedNodesResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func (coord *QueryCoordMock) UncordonNode(ctx context.Context, req *milvuspb.UncordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	if !coord.healthy() {
+		return &milvuspb.CordonedNodesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "unhealthy",
+			},
+		}, nil
+	}
+	return &milvuspb.CordonedNodesResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func (coord *QueryCoordMock) GetCordonedNodes(ctx context.Context, req *milvuspb.GetCordonedNodesRequest) (*milvuspb.CordonedNodesResponse, error) {
+	if !coord.healthy() {
+		return &milvuspb.CordonedNodesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "unhealthy",
+			},
+		}, nil
+	}
+	return &milvuspb.CordonedNodesResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func (coord *QueryCoordMock) Balance(ctx context.Context, req *milvuspb.BalanceRequest) (*milvuspb.BalanceResponse, error) {
+	if !coord.healthy() {
+		return &milvuspb.BalanceResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "unhealthy",
+			},
+		}, nil
+	}
+	return &milvuspb.BalanceResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		DryRun: req.GetDryRun(),
+	}, nil
+}
+
 func NewQueryCoordMock(opts ...QueryCoordMockOption) *QueryCoordMock {
 	coord := &QueryCoordMock{
 		nodeID:              UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt()),
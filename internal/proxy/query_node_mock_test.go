@@ -53,6 +53,18 @@ func (m *QueryNodeMock) SyncReplicaSegments(ctx context.Context, req *querypb.Sy
 	return &commonpb.Status{}, nil
 }
 
+func (m *QueryNodeMock) RefreshCollection(ctx context.Context, req *querypb.LoadPartitionsRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (m *QueryNodeMock) Drain(ctx context.Context, req *querypb.DrainRequest) (*querypb.DrainResponse, error) {
+	return &querypb.DrainResponse{}, nil
+}
+
+func (m *QueryNodeMock) GetSegmentLoadingProgress(ctx context.Context, req *querypb.GetLoadingProgressRequest) (*querypb.GetSegmentLoadingProgressResponse, error) {
+	return &querypb.GetSegmentLoadingProgressResponse{}, nil
+}
+
 // TODO
 func (m *QueryNodeMock) AddQueryChannel(ctx context.Context, req *querypb.AddQueryChannelRequest) (*commonpb.Status, error) {
 	return nil, nil
@@ -93,6 +105,10 @@ func (m *QueryNodeMock) ReleaseSegments(ctx context.Context, req *querypb.Releas
 	return nil, nil
 }
 
+func (m *QueryNodeMock) ReleaseChannels(ctx context.Context, req *querypb.ReleaseChannelsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 // TODO
 func (m *QueryNodeMock) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 	return nil, nil
@@ -0,0 +1,244 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+const (
+	quotaScopeCollection = "collection"
+	quotaScopeDatabase   = "database"
+	quotaScopeUser       = "user"
+
+	quotaDMLRows          = "dml rows per second"
+	quotaSearchQPS        = "search qps"
+	quotaQueryConcurrency = "query concurrency"
+)
+
+// rateLimitPrefix is the prefix of the reason of the requests rejected by the quotas, so that the clients could
+// tell them from the other failures and retry later.
+const rateLimitPrefix = "rate limit exceeded"
+
+// globalQuotaLimiter is nil if the quotas are disabled, all the requests are allowed then.
+var globalQuotaLimiter *quotaLimiter
+
+// rateLimitError is returned when a request exceeds a quota of its collection, its database or its user
+type rateLimitError struct {
+	scope string
+	name  string
+	quota string
+	limit float64
+}
+
+func (e *rateLimitError) Error() string {
+	return fmt.Sprintf("%s: %s of %s %s exceeds the quota %v", rateLimitPrefix, e.quota, e.scope, e.name, e.limit)
+}
+
+// isRateLimitError returns whether the request is rejected by the quotas or the backpressure of datanodes
+func isRateLimitError(err error) bool {
+	var e *rateLimitError
+	var be *backpressureError
+	return errors.As(err, &e) || errors.As(err, &be)
+}
+
+// tokenBucket is refilled at the rate of the quota and holds the tokens of one second at most. The rate is passed
+// per request, as the collection quotas could be changed at runtime.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func (b *tokenBucket) refill(now time.Time, rate float64) {
+	if b.last.IsZero() {
+		b.tokens = rate
+	} else {
+		b.tokens += now.Sub(b.last).Seconds() * rate
+	}
+	if b.tokens > rate {
+		b.tokens = rate
+	}
+	b.last = now
+}
+
+// available returns whether n tokens could be taken, a request larger than the bucket is allowed
+// once the bucket is full, the tokens are then owed by the following requests.
+func (b *tokenBucket) available(n float64, rate float64) bool {
+	if n > rate {
+		n = rate
+	}
+	return b.tokens >= n
+}
+
+// quotaLimit is a quota to check for a request, the limit is 0 if unlimited. The key identifies the quota, which is
+// the collection id for the collections, and the name for the others.
+type quotaLimit struct {
+	scope string
+	key   string
+	name  string
+	limit float64
+}
+
+// quotaLimiter enforces the DML rows per second, the search QPS and the query concurrency per collection,
+// per database and per authenticated user, so that a noisy collection, database or user couldn't exhaust the cluster.
+type quotaLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket // {quota}/{scope}/{key} -> bucket
+	running map[string]int64        // {scope}/{key} -> number of queries running
+
+	now func() time.Time
+}
+
+func newQuotaLimiter() *quotaLimiter {
+	return &quotaLimiter{
+		buckets: make(map[string]*tokenBucket),
+		running: make(map[string]int64),
+		now:     time.Now,
+	}
+}
+
+// limits returns the quotas of the collection, the database and the user of the request, the default database
+// is not limited and the unauthenticated requests are not limited per user. The collection quotas are kept by the
+// collection id, so that a renamed collection keeps its quota and a new collection of the same name starts afresh.
+// The collections of the other databases are named {database}/{collection} in the errors.
+func (l *quotaLimiter) limits(ctx context.Context, database string, collectionName string, collectionID UniqueID, collectionLimit, databaseLimit, userLimit float64) []quotaLimit {
+	limits := []quotaLimit{{scope: quotaScopeCollection, key: strconv.FormatInt(collectionID, 10), name: collectionName, limit: collectionLimit}}
+	if database = funcutil.DatabaseName(database); database != common.DefaultDatabase {
+		limits[0].name = fmt.Sprintf("%s/%s", database, collectionName)
+		limits = append(limits, quotaLimit{scope: quotaScopeDatabase, key: database, name: database, limit: databaseLimit})
+	}
+	if user := getCurUser(ctx); user != "" {
+		limits = append(limits, quotaLimit{scope: quotaScopeUser, key: user, name: user, limit: userLimit})
+	}
+	return limits
+}
+
+// take takes n tokens from the buckets of all the limits, nothing is taken if any of them is exhausted
+func (l *quotaLimiter) take(quota string, limits []quotaLimit, n float64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	buckets := make([]*tokenBucket, len(limits))
+	for i, limit := range limits {
+		if limit.limit <= 0 {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s/%s", quota, limit.scope, limit.key)
+		bucket, ok := l.buckets[key]
+		if !ok {
+			bucket = &tokenBucket{}
+			l.buckets[key] = bucket
+		}
+		bucket.refill(now, limit.limit)
+		if !bucket.available(n, limit.limit) {
+			return &rateLimitError{scope: limit.scope, name: limit.name, quota: quota, limit: limit.limit}
+		}
+		buckets[i] = bucket
+	}
+	for _, bucket := range buckets {
+		if bucket != nil {
+			bucket.tokens -= n
+		}
+	}
+	return nil
+}
+
+// checkDML takes the rows inserted or deleted from the DML quotas
+func (l *quotaLimiter) checkDML(ctx context.Context, database string, collectionName string, rows int64) error {
+	if l == nil {
+		return nil
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, database, collectionName)
+	if err != nil {
+		return err
+	}
+	limits := l.limits(ctx, database, collectionName, collectionID, globalCollectionLimiter.getDMLRowsPerSec(collectionID),
+		globalDatabaseCache.getQuotas(database).DMLRowsPerSec, Params.ProxyCfg.UserDMLRowsPerSec)
+	return l.take(quotaDMLRows, limits, float64(rows))
+}
+
+// checkSearch takes a search from the search QPS quotas
+func (l *quotaLimiter) checkSearch(ctx context.Context, database string, collectionName string) error {
+	if l == nil {
+		return nil
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, database, collectionName)
+	if err != nil {
+		return err
+	}
+	limits := l.limits(ctx, database, collectionName, collectionID, globalCollectionLimiter.getSearchQPS(collectionID),
+		globalDatabaseCache.getQuotas(database).SearchQPS, Params.ProxyCfg.UserSearchQPS)
+	return l.take(quotaSearchQPS, limits, 1)
+}
+
+// acquireQuery counts a running query against the query concurrency quotas,
+// the returned function must be called once the query is done.
+func (l *quotaLimiter) acquireQuery(ctx context.Context, database string, collectionName string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, database, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	limits := l.limits(ctx, database, collectionName, collectionID, float64(globalCollectionLimiter.getMaxQueryConcurrency(collectionID)),
+		float64(globalDatabaseCache.getQuotas(database).MaxQueryConcurrency), float64(Params.ProxyCfg.UserMaxQueryConcurrency))
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	keys := make([]string, 0, len(limits))
+	for _, limit := range limits {
+		key := fmt.Sprintf("%s/%s", limit.scope, limit.key)
+		if limit.limit > 0 && float64(l.running[key]) >= limit.limit {
+			return nil, &rateLimitError{scope: limit.scope, name: limit.name, quota: quotaQueryConcurrency, limit: limit.limit}
+		}
+		keys = append(keys, key)
+	}
+	for _, key := range keys {
+		l.running[key]++
+	}
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		for _, key := range keys {
+			if l.running[key]--; l.running[key] <= 0 {
+				delete(l.running, key)
+			}
+		}
+	}, nil
+}
+
+// removeCollection drops the quotas of the dropped collection, the running queries release their counts by themselves
+func (l *quotaLimiter) removeCollection(collectionID UniqueID) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, quota := range []string{quotaDMLRows, quotaSearchQPS} {
+		delete(l.buckets, fmt.Sprintf("%s/%s/%d", quota, quotaScopeCollection, collectionID))
+	}
+}
@@ -0,0 +1,204 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/rootcoord/model"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+func userContext(user string) context.Context {
+	return metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode(user+util.CredentialSeperator+"Milvus")))
+}
+
+// setTestCollectionIDs makes the meta cache return the collection ids by the names, the returned function restores it
+func setTestCollectionIDs(ids map[string]UniqueID) func() {
+	cache := newMockCache()
+	cache.setGetIDFunc(func(ctx context.Context, collectionName string) (UniqueID, error) {
+		if id, ok := ids[collectionName]; ok {
+			return id, nil
+		}
+		return 0, fmt.Errorf("collection %s not found", collectionName)
+	})
+	oldCache := globalMetaCache
+	globalMetaCache = cache
+	return func() { globalMetaCache = oldCache }
+}
+
+func newTestQuotaLimiter() (*quotaLimiter, *time.Time) {
+	now := time.Now()
+	l := newQuotaLimiter()
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func TestQuotaLimiter_disabled(t *testing.T) {
+	var l *quotaLimiter
+	assert.NoError(t, l.checkDML(context.Background(), "", "coll", 100))
+	assert.NoError(t, l.checkSearch(context.Background(), "", "coll"))
+	release, err := l.acquireQuery(context.Background(), "", "coll")
+	assert.NoError(t, err)
+	release()
+}
+
+func TestQuotaLimiter_checkDML(t *testing.T) {
+	defer func(rate float64) { Params.ProxyCfg.CollectionDMLRowsPerSec = rate }(Params.ProxyCfg.CollectionDMLRowsPerSec)
+	Params.ProxyCfg.CollectionDMLRowsPerSec = 100
+	defer setTestCollectionIDs(map[string]UniqueID{"coll": 1, "other": 2})()
+	l, now := newTestQuotaLimiter()
+	ctx := context.Background()
+
+	assert.NoError(t, l.checkDML(ctx, "", "coll", 60))
+	err := l.checkDML(ctx, "", "coll", 60)
+	assert.True(t, isRateLimitError(err))
+	assert.True(t, strings.HasPrefix(err.Error(), rateLimitPrefix))
+	// other collections have their own quotas
+	assert.NoError(t, l.checkDML(ctx, "", "other", 60))
+
+	*now = now.Add(200 * time.Millisecond)
+	assert.NoError(t, l.checkDML(ctx, "", "coll", 60))
+
+	// a batch larger than the quota is allowed once the bucket is full, then owed
+	*now = now.Add(time.Second)
+	assert.NoError(t, l.checkDML(ctx, "", "coll", 300))
+	*now = now.Add(time.Second)
+	assert.Error(t, l.checkDML(ctx, "", "coll", 1))
+	*now = now.Add(2 * time.Second)
+	assert.NoError(t, l.checkDML(ctx, "", "coll", 1))
+}
+
+func TestQuotaLimiter_checkSearch(t *testing.T) {
+	defer func(rate float64) { Params.ProxyCfg.UserSearchQPS = rate }(Params.ProxyCfg.UserSearchQPS)
+	Params.ProxyCfg.UserSearchQPS = 2
+	defer setTestCollectionIDs(map[string]UniqueID{"coll1": 1, "coll2": 2, "coll3": 3})()
+	l, now := newTestQuotaLimiter()
+	ctx := userContext("alice")
+
+	// the collection is unlimited
+	assert.NoError(t, l.checkSearch(ctx, "", "coll1"))
+	assert.NoError(t, l.checkSearch(ctx, "", "coll2"))
+	err := l.checkSearch(ctx, "", "coll3")
+	assert.Equal(t, &rateLimitError{scope: quotaScopeUser, name: "alice", quota: quotaSearchQPS, limit: 2}, err)
+
+	// other users and the unauthenticated requests are not affected
+	assert.NoError(t, l.checkSearch(userContext("bob"), "", "coll1"))
+	assert.NoError(t, l.checkSearch(context.Background(), "", "coll1"))
+
+	*now = now.Add(500 * time.Millisecond)
+	assert.NoError(t, l.checkSearch(ctx, "", "coll1"))
+}
+
+func TestQuotaLimiter_collectionOverride(t *testing.T) {
+	limiter := globalCollectionLimiter
+	defer func() { globalCollectionLimiter = limiter }()
+	globalCollectionLimiter = newCollectionLimiter(nil, "/root")
+	globalCollectionLimiter.set(1, &collectionLimits{SearchQPS: 1})
+	defer setTestCollectionIDs(map[string]UniqueID{"coll": 1, "other": 2})()
+
+	l, _ := newTestQuotaLimiter()
+	assert.NoError(t, l.checkSearch(context.Background(), "", "coll"))
+	assert.Error(t, l.checkSearch(context.Background(), "", "coll"))
+	assert.NoError(t, l.checkSearch(context.Background(), "", "other"))
+}
+
+func TestQuotaLimiter_collectionID(t *testing.T) {
+	defer func(rate float64) { Params.ProxyCfg.CollectionSearchQPS = rate }(Params.ProxyCfg.CollectionSearchQPS)
+	Params.ProxyCfg.CollectionSearchQPS = 1
+	ids := map[string]UniqueID{"coll": 1}
+	defer setTestCollectionIDs(ids)()
+	l, _ := newTestQuotaLimiter()
+	ctx := context.Background()
+
+	assert.NoError(t, l.checkSearch(ctx, "", "coll"))
+	err := l.checkSearch(ctx, "", "coll")
+	assert.Equal(t, &rateLimitError{scope: quotaScopeCollection, name: "coll", quota: quotaSearchQPS, limit: 1}, err)
+
+	// the renamed collection keeps its quota
+	ids["renamed"] = 1
+	delete(ids, "coll")
+	assert.Error(t, l.checkSearch(ctx, "", "renamed"))
+
+	// a new collection of the dropped one's name starts afresh
+	l.removeCollection(1)
+	assert.Empty(t, l.buckets)
+	ids["renamed"] = 2
+	assert.NoError(t, l.checkSearch(ctx, "", "renamed"))
+}
+
+func TestQuotaLimiter_database(t *testing.T) {
+	cache := globalDatabaseCache
+	defer func() { globalDatabaseCache = cache }()
+	globalDatabaseCache = newDatabaseCache(nil, "/root")
+	globalDatabaseCache.databases["db1"] = model.DatabaseQuotas{SearchQPS: 1}
+	defer setTestCollectionIDs(map[string]UniqueID{"coll1": 1, "coll2": 2})()
+
+	// the collections of the database share its quotas
+	l, _ := newTestQuotaLimiter()
+	assert.NoError(t, l.checkSearch(context.Background(), "db1", "coll1"))
+	err := l.checkSearch(context.Background(), "db1", "coll2")
+	assert.Equal(t, &rateLimitError{scope: quotaScopeDatabase, name: "db1", quota: quotaSearchQPS, limit: 1}, err)
+	assert.NoError(t, l.checkSearch(context.Background(), "db2", "coll1"))
+	assert.NoError(t, l.checkSearch(context.Background(), "", "coll1"))
+	assert.NoError(t, l.checkSearch(context.Background(), "", "coll1"))
+}
+
+func TestQuotaLimiter_acquireQuery(t *testing.T) {
+	defer func(collectionLimit, userLimit int64) {
+		Params.ProxyCfg.CollectionMaxQueryConcurrency = collectionLimit
+		Params.ProxyCfg.UserMaxQueryConcurrency = userLimit
+	}(Params.ProxyCfg.CollectionMaxQueryConcurrency, Params.ProxyCfg.UserMaxQueryConcurrency)
+	Params.ProxyCfg.CollectionMaxQueryConcurrency = 2
+	Params.ProxyCfg.UserMaxQueryConcurrency = 1
+	defer setTestCollectionIDs(map[string]UniqueID{"coll": 1})()
+	l, _ := newTestQuotaLimiter()
+
+	release1, err := l.acquireQuery(userContext("alice"), "", "coll")
+	assert.NoError(t, err)
+	_, err = l.acquireQuery(userContext("alice"), "", "coll")
+	assert.True(t, isRateLimitError(err))
+
+	release2, err := l.acquireQuery(userContext("bob"), "", "coll")
+	assert.NoError(t, err)
+	_, err = l.acquireQuery(context.Background(), "", "coll")
+	assert.True(t, isRateLimitError(err))
+
+	release1()
+	release2()
+	assert.Empty(t, l.running)
+	release, err := l.acquireQuery(userContext("alice"), "", "coll")
+	assert.NoError(t, err)
+	release()
+}
+
+func TestIsRateLimitError(t *testing.T) {
+	err := &rateLimitError{scope: quotaScopeCollection, name: "coll", quota: quotaDMLRows, limit: 10}
+	assert.True(t, isRateLimitError(fmt.Errorf("insert failed: %w", err)))
+	assert.False(t, isRateLimitError(errors.New(err.Error())))
+	assert.False(t, isRateLimitError(nil))
+}
@@ -0,0 +1,81 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"path"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+)
+
+// SizeInterceptor returns a unary server interceptor which records the request and response size of each method,
+// messages close to the grpc size limits are counted and logged with the client address.
+func SizeInterceptor(maxRecvSize, maxSendSize int) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := path.Base(info.FullMethod)
+		observeMessageSize(ctx, method, metrics.RequestLabel, req, maxRecvSize)
+		resp, err := handler(ctx, req)
+		if err == nil {
+			observeMessageSize(ctx, method, metrics.ResponseLabel, resp, maxSendSize)
+		}
+		return resp, err
+	}
+}
+
+func observeMessageSize(ctx context.Context, method string, direction string, msg interface{}, limit int) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return
+	}
+	size := proto.Size(m)
+	nodeID := strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)
+	if direction == metrics.RequestLabel {
+		metrics.ProxyRequestSize.WithLabelValues(nodeID, method).Observe(float64(size))
+	} else {
+		metrics.ProxyResponseSize.WithLabelValues(nodeID, method).Observe(float64(size))
+	}
+
+	if !isNearLimit(size, limit) {
+		return
+	}
+	metrics.ProxyNearGrpcLimitCount.WithLabelValues(nodeID, method, direction).Inc()
+	client := "unknown"
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		client = p.Addr.String()
+	}
+	log.RatedWarn(10, "message size is close to the grpc limit",
+		zap.String("method", method),
+		zap.String("direction", direction),
+		zap.String("client", client),
+		zap.Int("size", size),
+		zap.Int("limit", limit))
+}
+
+func isNearLimit(size int, limit int) bool {
+	if limit <= 0 || Params.ProxyCfg.GrpcLimitWarnRatio <= 0 {
+		return false
+	}
+	return float64(size) >= float64(limit)*Params.ProxyCfg.GrpcLimitWarnRatio
+}
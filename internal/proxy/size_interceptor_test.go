@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+func TestSizeInterceptor(t *testing.T) {
+	req := &milvuspb.HasCollectionRequest{CollectionName: "collection"}
+	resp := &milvuspb.BoolResponse{Status: &commonpb.Status{}, Value: true}
+	info := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/HasCollection"}
+
+	interceptor := SizeInterceptor(proto.Size(req), 1024)
+	ret, err := interceptor(context.Background(), req, info, func(ctx context.Context, r interface{}) (interface{}, error) {
+		return resp, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, resp, ret)
+
+	_, err = interceptor(context.Background(), req, info, func(ctx context.Context, r interface{}) (interface{}, error) {
+		return nil, errors.New("mock")
+	})
+	assert.Error(t, err)
+}
+
+func TestIsNearLimit(t *testing.T) {
+	ratio := Params.ProxyCfg.GrpcLimitWarnRatio
+	defer func() {
+		Params.ProxyCfg.GrpcLimitWarnRatio = ratio
+	}()
+
+	Params.ProxyCfg.GrpcLimitWarnRatio = 0.8
+	assert.True(t, isNearLimit(80, 100))
+	assert.False(t, isNearLimit(79, 100))
+	assert.False(t, isNearLimit(80, 0))
+
+	Params.ProxyCfg.GrpcLimitWarnRatio = 0
+	assert.False(t, isNearLimit(100, 100))
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -54,6 +55,7 @@ import (
 
 const (
 	InsertTaskName                  = "InsertTask"
+	UpsertTaskName                  = "UpsertTask"
 	CreateCollectionTaskName        = "CreateCollectionTask"
 	DropCollectionTaskName          = "DropCollectionTask"
 	SearchTaskName                  = "SearchTask"
@@ -87,6 +89,13 @@ const (
 	CreateAliasTaskName             = "CreateAliasTask"
 	DropAliasTaskName               = "DropAliasTask"
 	AlterAliasTaskName              = "AlterAliasTask"
+	RenameCollectionTaskName        = "RenameCollectionTask"
+	AddCollectionFieldTaskName      = "AddCollectionFieldTask"
+	AlterCollectionTaskName         = "AlterCollectionTask"
+	CreateDatabaseTaskName          = "CreateDatabaseTask"
+	DropDatabaseTaskName            = "DropDatabaseTask"
+	AlterDatabaseTaskName           = "AlterDatabaseTask"
+	ListDatabasesTaskName           = "ListDatabasesTask"
 
 	// minFloat32 minimum float.
 	minFloat32 = -1 * float32(math.MaxFloat32)
@@ -131,6 +140,11 @@ type insertTask struct {
 	vChannels      []vChan
 	pChannels      []pChan
 	schema         *schemapb.CollectionSchema
+
+	// partitionKeyIndexes are the indexes of the implicit partitions the rows are routed to by the partition key,
+	// nil if the collection has no partition key. partitionKeyIDs are the IDs of the implicit partitions.
+	partitionKeyIndexes []int64
+	partitionKeyIDs     []UniqueID
 }
 
 // TraceCtx returns insertTask context
@@ -188,7 +202,7 @@ func (it *insertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
 }
 
 func (it *insertTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.GetDbName(), it.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -295,13 +309,28 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, it.GetDbName(), collectionName)
 	if err != nil {
 		log.Error("get collection schema from global meta cache failed", zap.String("collection name", collectionName), zap.Error(err))
 		return err
 	}
 	it.schema = collSchema
 
+	if err := checkInsertBatchSize(collSchema, int64(it.NRows())); err != nil {
+		log.Error("insert batch size exceeds limit", zap.String("collection name", collectionName), zap.Error(err))
+		return err
+	}
+
+	if err := globalQuotaLimiter.checkDML(ctx, it.GetDbName(), collectionName, int64(it.NRows())); err != nil {
+		log.Warn("insert rejected by quota", zap.String("collection name", collectionName), zap.Error(err))
+		return err
+	}
+
+	if err := globalBackpressure.checkDML(ctx, it.GetDbName(), collectionName); err != nil {
+		log.Warn("insert rejected by datanode backpressure", zap.String("collection name", collectionName), zap.Error(err))
+		return err
+	}
+
 	rowNums := uint32(it.NRows())
 	// set insertTask.rowIDs
 	var rowIDBegin UniqueID
@@ -329,6 +358,28 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 	}
 	it.result.SuccIndex = sliceIndex
 
+	// pack the fields undeclared in the schema into the dynamic field
+	it.FieldsData, err = fillDynamicField(collSchema, it.GetFieldsData(), it.NRows())
+	if err != nil {
+		log.Error("fill dynamic field failed", zap.Int64("msgID", it.Base.MsgID), zap.String("collection name", collectionName), zap.Error(err))
+		return err
+	}
+	if err := validateJSONFieldsData(collSchema, it.GetFieldsData()); err != nil {
+		log.Error("invalid JSON field data", zap.Int64("msgID", it.Base.MsgID), zap.String("collection name", collectionName), zap.Error(err))
+		return err
+	}
+	if err := validateArrayFieldsData(collSchema, it.GetFieldsData()); err != nil {
+		log.Error("invalid array field data", zap.Int64("msgID", it.Base.MsgID), zap.String("collection name", collectionName), zap.Error(err))
+		return err
+	}
+
+	// the fields with default values are allowed to be omitted
+	it.FieldsData, err = typeutil.FillDefaultFieldsData(it.GetFieldsData(), collSchema, int(it.NRows()))
+	if err != nil {
+		log.Error("fill default fields failed", zap.Int64("msgID", it.Base.MsgID), zap.String("collection name", collectionName), zap.Error(err))
+		return err
+	}
+
 	// check primaryFieldData whether autoID is true or not
 	// set rowIDs as primary data if autoID == true
 	err = it.checkPrimaryFieldData()
@@ -350,11 +401,70 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if err = it.hashPartitionKeys(collSchema); err != nil {
+		log.Error("hash partition keys failed", zap.Int64("msgID", it.Base.MsgID), zap.String("collection name", collectionName), zap.Error(err))
+		return err
+	}
+
 	log.Debug("Proxy Insert PreExecute done", zap.Int64("msgID", it.Base.MsgID), zap.String("collection name", collectionName))
 
 	return nil
 }
 
+// hashPartitionKeys routes the rows to the implicit partitions by the partition key of the collection
+func (it *insertTask) hashPartitionKeys(schema *schemapb.CollectionSchema) error {
+	it.partitionKeyIndexes = nil
+	keyField := typeutil.GetPartitionKeyFieldSchema(schema)
+	if keyField == nil {
+		return nil
+	}
+	if len(it.PartitionName) > 0 {
+		return fmt.Errorf("not allowed to insert into partition %s, the partitions of collection %s are managed by the partition key %s",
+			it.PartitionName, it.CollectionName, keyField.GetName())
+	}
+	num, err := typeutil.GetPartitionKeyNum(keyField)
+	if err != nil {
+		return err
+	}
+	for _, fieldData := range it.GetFieldsData() {
+		if fieldData.GetFieldId() == keyField.GetFieldID() {
+			it.partitionKeyIndexes, err = hashPartitionKeys(fieldData, num)
+			return err
+		}
+	}
+	return fmt.Errorf("the partition key %s not found in the inserted fields", keyField.GetName())
+}
+
+// partitionRows are the offsets of the rows inserted into the partition
+type partitionRows struct {
+	partitionID   UniqueID
+	partitionName string
+	rowOffsets    []int
+}
+
+// groupByPartition groups the rows by the partitions they are inserted into
+func (it *insertTask) groupByPartition(rowOffsets []int) []*partitionRows {
+	if it.partitionKeyIndexes == nil {
+		return []*partitionRows{{partitionID: it.PartitionID, partitionName: it.PartitionName, rowOffsets: rowOffsets}}
+	}
+	groups := make([]*partitionRows, 0)
+	index2Group := make(map[int64]*partitionRows)
+	for _, offset := range rowOffsets {
+		index := it.partitionKeyIndexes[offset]
+		group, ok := index2Group[index]
+		if !ok {
+			group = &partitionRows{
+				partitionID:   it.partitionKeyIDs[index],
+				partitionName: getPartitionKeyPartitionName(index),
+			}
+			index2Group[index] = group
+			groups = append(groups, group)
+		}
+		group.rowOffsets = append(group.rowOffsets, offset)
+	}
+	return groups
+}
+
 func (it *insertTask) assignSegmentID(channelNames []string) (*msgstream.MsgPack, error) {
 	threshold := Params.PulsarCfg.MaxMessageSize
 
@@ -390,7 +500,7 @@ func (it *insertTask) assignSegmentID(channelNames []string) (*msgstream.MsgPack
 	}
 
 	// create empty insert message
-	createInsertMsg := func(segmentID UniqueID, channelName string) *msgstream.InsertMsg {
+	createInsertMsg := func(segmentID UniqueID, channelName string, partitionID UniqueID, partitionName string) *msgstream.InsertMsg {
 		insertReq := internalpb.InsertRequest{
 			Base: &commonpb.MsgBase{
 				MsgType:   commonpb.MsgType_Insert,
@@ -399,9 +509,9 @@ func (it *insertTask) assignSegmentID(channelNames []string) (*msgstream.MsgPack
 				SourceID:  it.Base.SourceID,
 			},
 			CollectionID:   it.CollectionID,
-			PartitionID:    it.PartitionID,
+			PartitionID:    partitionID,
 			CollectionName: it.CollectionName,
-			PartitionName:  it.PartitionName,
+			PartitionName:  partitionName,
 			SegmentID:      segmentID,
 			ShardName:      channelName,
 			Version:        internalpb.InsertDataVersion_ColumnBased,
@@ -419,10 +529,10 @@ func (it *insertTask) assignSegmentID(channelNames []string) (*msgstream.MsgPack
 	}
 
 	// repack the row data corresponding to the offset to insertMsg
-	getInsertMsgsBySegmentID := func(segmentID UniqueID, rowOffsets []int, channelName string, mexMessageSize int) ([]msgstream.TsMsg, error) {
+	getInsertMsgsBySegmentID := func(segmentID UniqueID, rowOffsets []int, channelName string, partition *partitionRows, mexMessageSize int) ([]msgstream.TsMsg, error) {
 		repackedMsgs := make([]msgstream.TsMsg, 0)
 		requestSize := 0
-		insertMsg := createInsertMsg(segmentID, channelName)
+		insertMsg := createInsertMsg(segmentID, channelName, partition.partitionID, partition.partitionName)
 		for _, offset := range rowOffsets {
 			curRowMessageSize, err := typeutil.EstimateEntitySize(it.InsertRequest.GetFieldsData(), offset)
 			if err != nil {
@@ -432,7 +542,7 @@ func (it *insertTask) assignSegmentID(channelNames []string) (*msgstream.MsgPack
 			// if insertMsg's size is greater than the threshold, split into multiple insertMsgs
 			if requestSize+curRowMessageSize >= mexMessageSize {
 				repackedMsgs = append(repackedMsgs, insertMsg)
-				insertMsg = createInsertMsg(segmentID, channelName)
+				insertMsg = createInsertMsg(segmentID, channelName, partition.partitionID, partition.partitionName)
 				requestSize = 0
 			}
 
@@ -449,56 +559,95 @@ func (it *insertTask) assignSegmentID(channelNames []string) (*msgstream.MsgPack
 	}
 
 	// get allocated segmentID info for every dmChannel and repack insertMsgs for every segmentID
-	for channelName, rowOffsets := range channel2RowOffsets {
-		assignedSegmentInfos, err := it.segIDAssigner.GetSegmentID(it.CollectionID, it.PartitionID, channelName, uint32(len(rowOffsets)), channelMaxTSMap[channelName])
-		if err != nil {
-			log.Error("allocate segmentID for insert data failed",
-				zap.Int64("collectionID", it.CollectionID),
-				zap.String("channel name", channelName),
-				zap.Int("allocate count", len(rowOffsets)),
-				zap.Error(err))
-			return nil, err
-		}
-
-		startPos := 0
-		for segmentID, count := range assignedSegmentInfos {
-			subRowOffsets := rowOffsets[startPos : startPos+int(count)]
-			insertMsgs, err := getInsertMsgsBySegmentID(segmentID, subRowOffsets, channelName, threshold)
+	for channelName, channelRowOffsets := range channel2RowOffsets {
+		for _, partition := range it.groupByPartition(channelRowOffsets) {
+			rowOffsets := partition.rowOffsets
+			assignedSegmentInfos, err := it.segIDAssigner.GetSegmentID(it.CollectionID, partition.partitionID, channelName, uint32(len(rowOffsets)), channelMaxTSMap[channelName])
 			if err != nil {
-				log.Error("repack insert data to insert msgs failed",
+				log.Error("allocate segmentID for insert data failed",
 					zap.Int64("collectionID", it.CollectionID),
+					zap.Int64("partitionID", partition.partitionID),
+					zap.String("channel name", channelName),
+					zap.Int("allocate count", len(rowOffsets)),
 					zap.Error(err))
 				return nil, err
 			}
-			result.Msgs = append(result.Msgs, insertMsgs...)
-			startPos += int(count)
+
+			startPos := 0
+			for segmentID, count := range assignedSegmentInfos {
+				subRowOffsets := rowOffsets[startPos : startPos+int(count)]
+				insertMsgs, err := getInsertMsgsBySegmentID(segmentID, subRowOffsets, channelName, partition, threshold)
+				if err != nil {
+					log.Error("repack insert data to insert msgs failed",
+						zap.Int64("collectionID", it.CollectionID),
+						zap.Error(err))
+					return nil, err
+				}
+				result.Msgs = append(result.Msgs, insertMsgs...)
+				startPos += int(count)
+			}
 		}
 	}
 
 	return result, nil
 }
 
+// getPartitionKeyIDs gets the IDs of the implicit partitions the rows are routed to
+func (it *insertTask) getPartitionKeyIDs(ctx context.Context) error {
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, it.GetDbName(), it.CollectionName)
+	if err != nil {
+		return err
+	}
+	var maxIndex int64 = -1
+	for _, index := range it.partitionKeyIndexes {
+		if index > maxIndex {
+			maxIndex = index
+		}
+	}
+	it.partitionKeyIDs = make([]UniqueID, maxIndex+1)
+	for index := int64(0); index <= maxIndex; index++ {
+		partitionName := getPartitionKeyPartitionName(index)
+		partitionID, ok := partitionsMap[partitionName]
+		if !ok {
+			return fmt.Errorf("partition %s of the partition key not found in collection %s", partitionName, it.CollectionName)
+		}
+		it.partitionKeyIDs[index] = partitionID
+	}
+	return nil
+}
+
 func (it *insertTask) Execute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(it.ctx, "Proxy-Insert-Execute")
 	defer sp.Finish()
 
-	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute insert %d", it.ID()))
-	defer tr.Elapse("insert execute done")
+	return it.execute(ctx, metrics.InsertLabel, nil)
+}
+
+// execute produces the insert messages, followed by the messages returned by extraMsgs in the same msg pack if it's
+// not nil. Nothing is produced if it fails before producing the msg pack.
+func (it *insertTask) execute(ctx context.Context, label string,
+	extraMsgs func(collID UniqueID, channelNames []string) []msgstream.TsMsg) error {
+	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute %s %d", label, it.ID()))
+	defer tr.Elapse(label + " execute done")
 
 	collectionName := it.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, it.GetDbName(), collectionName)
 	if err != nil {
 		return err
 	}
 	it.CollectionID = collID
 	var partitionID UniqueID
-	if len(it.PartitionName) > 0 {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, it.PartitionName)
+	if it.partitionKeyIndexes != nil {
+		if err := it.getPartitionKeyIDs(ctx); err != nil {
+			return err
+		}
+	} else if len(it.PartitionName) > 0 {
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, it.GetDbName(), collectionName, it.PartitionName)
 		if err != nil {
 			return err
 		}
 	} else {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, Params.CommonCfg.DefaultPartitionName)
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, it.GetDbName(), collectionName, Params.CommonCfg.DefaultPartitionName)
 		if err != nil {
 			return err
 		}
@@ -541,14 +690,17 @@ func (it *insertTask) Execute(ctx context.Context) error {
 	}
 	log.Debug("assign segmentID for insert data success", zap.Int64("msgID", it.Base.MsgID), zap.Int64("collectionID", collID), zap.String("collection name", it.CollectionName))
 	tr.Record("assign segment id")
+	if extraMsgs != nil {
+		msgPack.Msgs = append(msgPack.Msgs, extraMsgs(collID, channelNames)...)
+	}
 	err = stream.Produce(msgPack)
 	if err != nil {
 		it.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
 		it.result.Status.Reason = err.Error()
 		return err
 	}
-	sendMsgDur := tr.Record("send insert request to dml channel")
-	metrics.ProxySendMutationReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.InsertLabel).Observe(float64(sendMsgDur.Milliseconds()))
+	sendMsgDur := tr.Record("send " + label + " request to dml channel")
+	metrics.ProxySendMutationReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), label).Observe(float64(sendMsgDur.Milliseconds()))
 
 	log.Debug("Proxy Insert Execute done", zap.Int64("msgID", it.Base.MsgID), zap.String("collection name", collectionName))
 
@@ -671,12 +823,60 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 				return err
 			}
 		}
+		if _, _, err := typeutil.GetDefaultValue(field); err != nil {
+			return err
+		}
 	}
 
 	if err := validateMultipleVectorFields(cct.schema); err != nil {
 		return err
 	}
 
+	// add the hidden dynamic field if the collection enables it
+	if err := addHiddenDynamicField(cct.schema); err != nil {
+		return err
+	}
+
+	// validate partition key definition
+	if err := validatePartitionKey(cct.schema); err != nil {
+		return err
+	}
+
+	// validate dynamic field definition
+	if err := validateDynamicField(cct.schema); err != nil {
+		return err
+	}
+
+	// validate JSON field definitions
+	if err := validateJSONFields(cct.schema); err != nil {
+		return err
+	}
+
+	// validate array field definitions
+	if err := validateArrayFields(cct.schema); err != nil {
+		return err
+	}
+
+	// validate bloom filter parameters of primary key
+	if err := validateBloomFilterParams(cct.schema); err != nil {
+		return err
+	}
+
+	// validate the annotations of the collection and the fields
+	if err := typeutil.ValidateAnnotations(cct.schema); err != nil {
+		return err
+	}
+
+	// the properties of the collection could be set on the primary key, they're kept in the schema properties
+	if err := typeutil.MoveCollectionProperties(cct.schema); err != nil {
+		return err
+	}
+	for key, value := range typeutil.GetCollectionProperties(cct.schema) {
+		if err := typeutil.ValidateCollectionProperty(key, value); err != nil {
+			return err
+		}
+	}
+
 	cct.CreateCollectionRequest.Schema, err = proto.Marshal(cct.schema)
 	if err != nil {
 		return err
@@ -753,7 +953,7 @@ func (dct *dropCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (dct *dropCollectionTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, dct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, dct.GetDbName(), dct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -765,23 +965,30 @@ func (dct *dropCollectionTask) Execute(ctx context.Context) error {
 
 	_ = dct.chMgr.removeDMLStream(collID)
 	_ = dct.chMgr.removeDQLStream(collID)
+	if dct.result.GetErrorCode() == commonpb.ErrorCode_Success {
+		globalCollectionLimiter.drop(ctx, collID)
+		globalQuotaLimiter.removeCollection(collID)
+	}
 	return nil
 }
 
 func (dct *dropCollectionTask) PostExecute(ctx context.Context) error {
-	globalMetaCache.RemoveCollection(ctx, dct.CollectionName)
+	globalMetaCache.RemoveCollection(ctx, dct.GetDbName(), dct.CollectionName)
 	return nil
 }
 
 // Support wildcard in output fields:
-//   "*" - all scalar fields
-//   "%" - all vector fields
+//
+//	"*" - all scalar fields
+//	"%" - all vector fields
+//
 // For example, A and B are scalar fields, C and D are vector fields, duplicated fields will automatically be removed.
-//   output_fields=["*"] 	 ==> [A,B]
-//   output_fields=["%"] 	 ==> [C,D]
-//   output_fields=["*","%"] ==> [A,B,C,D]
-//   output_fields=["*",A] 	 ==> [A,B]
-//   output_fields=["*",C]   ==> [A,B,C]
+//
+//	output_fields=["*"] 	 ==> [A,B]
+//	output_fields=["%"] 	 ==> [C,D]
+//	output_fields=["*","%"] ==> [A,B,C,D]
+//	output_fields=["*",A] 	 ==> [A,B]
+//	output_fields=["*",C]   ==> [A,B,C]
 func translateOutputFields(outputFields []string, schema *schemapb.CollectionSchema, addPrimary bool) ([]string, error) {
 	var primaryFieldName string
 	scalarFieldNameMap := make(map[string]bool)
@@ -992,7 +1199,7 @@ func (dct *describeCollectionTask) Execute(ctx context.Context) error {
 		dct.result.ShardsNum = result.ShardsNum
 		dct.result.ConsistencyLevel = result.ConsistencyLevel
 		for _, field := range result.Schema.Fields {
-			if field.FieldID >= common.StartOfUserFieldID {
+			if field.FieldID >= common.StartOfUserFieldID && !isHiddenDynamicField(field) {
 				dct.result.Schema.Fields = append(dct.result.Schema.Fields, &schemapb.FieldSchema{
 					FieldID:      field.FieldID,
 					Name:         field.Name,
@@ -1067,7 +1274,7 @@ func (g *getCollectionStatisticsTask) PreExecute(ctx context.Context) error {
 }
 
 func (g *getCollectionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.GetDbName(), g.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -1157,12 +1364,12 @@ func (g *getPartitionStatisticsTask) PreExecute(ctx context.Context) error {
 }
 
 func (g *getPartitionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.GetDbName(), g.CollectionName)
 	if err != nil {
 		return err
 	}
 	g.collectionID = collID
-	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.CollectionName, g.PartitionName)
+	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.GetDbName(), g.CollectionName, g.PartitionName)
 	if err != nil {
 		return err
 	}
@@ -1281,7 +1488,7 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 		}
 		collectionIDs := make([]UniqueID, 0)
 		for _, collectionName := range sct.CollectionNames {
-			collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+			collectionID, err := globalMetaCache.GetCollectionID(ctx, sct.GetDbName(), collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -1335,7 +1542,7 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
 				return errors.New("failed to show collections")
 			}
-			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, collectionName)
+			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, sct.GetDbName(), collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection info.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -1417,7 +1624,7 @@ func (cpt *createPartitionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	return nil
+	return checkPartitionKeyNotSet(ctx, cpt.GetDbName(), collName)
 }
 
 func (cpt *createPartitionTask) Execute(ctx context.Context) (err error) {
@@ -1494,7 +1701,7 @@ func (dpt *dropPartitionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	return nil
+	return checkPartitionKeyNotSet(ctx, dpt.GetDbName(), collName)
 }
 
 func (dpt *dropPartitionTask) Execute(ctx context.Context) (err error) {
@@ -1669,7 +1876,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 
 	if spt.GetType() == milvuspb.ShowType_InMemory {
 		collectionName := spt.CollectionName
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, spt.GetDbName(), collectionName)
 		if err != nil {
 			log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 				zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -1682,7 +1889,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 		}
 		partitionIDs := make([]UniqueID, 0)
 		for _, partitionName := range spt.PartitionNames {
-			partitionID, err := globalMetaCache.GetPartitionID(ctx, collectionName, partitionName)
+			partitionID, err := globalMetaCache.GetPartitionID(ctx, spt.GetDbName(), collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -1730,7 +1937,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
 				return errors.New("failed to show partitions")
 			}
-			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, collectionName, partitionName)
+			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, spt.GetDbName(), collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -1758,6 +1965,7 @@ type createIndexTask struct {
 	*milvuspb.CreateIndexRequest
 	ctx       context.Context
 	rootCoord types.RootCoord
+	dataCoord types.DataCoord
 	result    *commonpb.Status
 
 	collectionID UniqueID
@@ -1800,9 +2008,13 @@ func (cit *createIndexTask) OnEnqueue() error {
 	return nil
 }
 
-func parseIndexParams(m []*commonpb.KeyValuePair) (map[string]string, error) {
+func parseIndexParams(field *schemapb.FieldSchema, m []*commonpb.KeyValuePair) (map[string]string, error) {
 	indexParams := make(map[string]string)
 	for _, kv := range m {
+		if kv.Key == common.IndexRebuildParam {
+			// not an index param, passed to rootcoord as is
+			continue
+		}
 		if kv.Key == "params" { // TODO(dragondriver): change `params` to const variable
 			params, err := funcutil.ParseIndexParamsMap(kv.Value)
 			if err != nil {
@@ -1817,13 +2029,17 @@ func parseIndexParams(m []*commonpb.KeyValuePair) (map[string]string, error) {
 	}
 	_, exist := indexParams["index_type"] // TODO(dragondriver): change `index_type` to const variable
 	if !exist {
-		indexParams["index_type"] = indexparamcheck.IndexFaissIvfPQ // IVF_PQ is the default index type
+		if typeutil.IsVectorType(field.GetDataType()) {
+			indexParams["index_type"] = indexparamcheck.IndexFaissIvfPQ // IVF_PQ is the default index type
+		} else {
+			indexParams["index_type"] = indexparamcheck.DefaultScalarIndexType(field.GetDataType())
+		}
 	}
 	return indexParams, nil
 }
 
 func (cit *createIndexTask) getIndexedField(ctx context.Context) (*schemapb.FieldSchema, error) {
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, cit.GetCollectionName())
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, cit.GetDbName(), cit.GetCollectionName())
 	if err != nil {
 		log.Error("failed to get collection schema", zap.Error(err))
 		return nil, fmt.Errorf("failed to get collection schema: %s", err)
@@ -1876,6 +2092,10 @@ func checkTrain(field *schemapb.FieldSchema, indexParams map[string]string) erro
 		schemapb.DataType_BinaryVector,
 	}
 	if !funcutil.SliceContain(vecDataTypes, field.GetDataType()) {
+		// the predicates on JSON and array fields aren't evaluated by segcore, where the scalar indexes are used
+		if typeutil.IsJSONField(field) || typeutil.IsArrayField(field) {
+			return fmt.Errorf("scalar index is not supported on JSON or array field %s", field.GetName())
+		}
 		return indexparamcheck.CheckIndexValid(field.GetDataType(), indexType, indexParams)
 	}
 
@@ -1904,7 +2124,7 @@ func (cit *createIndexTask) PreExecute(ctx context.Context) error {
 
 	collName := cit.CollectionName
 
-	collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, cit.GetDbName(), collName)
 	if err != nil {
 		return err
 	}
@@ -1916,15 +2136,70 @@ func (cit *createIndexTask) PreExecute(ctx context.Context) error {
 	}
 
 	// check index param, not accurate, only some static rules
-	indexParams, err := parseIndexParams(cit.GetExtraParams())
+	indexParams, err := parseIndexParams(field, cit.GetExtraParams())
 	if err != nil {
 		log.Error("failed to parse index params", zap.Error(err))
 		return fmt.Errorf("failed to parse index params: %s", err)
 	}
+	if indexParams["index_type"] == indexparamcheck.IndexAUTOINDEX {
+		if indexParams, err = cit.resolveAutoIndex(ctx, field, indexParams); err != nil {
+			return err
+		}
+	}
 
 	return checkTrain(field, indexParams)
 }
 
+// resolveAutoIndex replaces the index params of AUTOINDEX in the request with the index type and params picked by the
+// dimension and the metric type of the field, and the number of rows of the collection.
+func (cit *createIndexTask) resolveAutoIndex(ctx context.Context, field *schemapb.FieldSchema, indexParams map[string]string) (map[string]string, error) {
+	if err := fillDimension(field, indexParams); err != nil {
+		return nil, err
+	}
+	dim, err := strconv.ParseInt(indexParams["dim"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dimension: %s", indexParams["dim"])
+	}
+	resp, err := cit.dataCoord.GetCollectionStatistics(ctx, &datapb.GetCollectionStatisticsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_GetCollectionStatistics,
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		CollectionID: cit.collectionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	var numRows int64
+	if rowCount, err := funcutil.GetAttrByKeyFromRepeatedKV("row_count", resp.GetStats()); err == nil {
+		numRows, _ = strconv.ParseInt(rowCount, 10, 64)
+	}
+
+	autoParams, err := indexparamcheck.AutoIndexParams(field.GetDataType(), dim, indexParams[indexparamcheck.Metric], numRows)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("auto index picked", zap.String("collection", cit.GetCollectionName()), zap.String("field", cit.GetFieldName()),
+		zap.Int64("num_rows", numRows), zap.Any("index_params", autoParams))
+	keys := make([]string, 0, len(autoParams))
+	for key := range autoParams {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	extraParams := make([]*commonpb.KeyValuePair, 0, len(keys)+1)
+	for _, key := range keys {
+		extraParams = append(extraParams, &commonpb.KeyValuePair{Key: key, Value: autoParams[key]})
+	}
+	if rebuild, err := funcutil.GetAttrByKeyFromRepeatedKV(common.IndexRebuildParam, cit.GetExtraParams()); err == nil {
+		extraParams = append(extraParams, &commonpb.KeyValuePair{Key: common.IndexRebuildParam, Value: rebuild})
+	}
+	cit.ExtraParams = extraParams
+	return autoParams, nil
+}
+
 func (cit *createIndexTask) Execute(ctx context.Context) error {
 	var err error
 	cit.result, err = cit.rootCoord.CreateIndex(ctx, cit.CreateIndexRequest)
@@ -1996,7 +2271,7 @@ func (dit *describeIndexTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	collID, _ := globalMetaCache.GetCollectionID(ctx, dit.CollectionName)
+	collID, _ := globalMetaCache.GetCollectionID(ctx, dit.GetDbName(), dit.CollectionName)
 	dit.collectionID = collID
 	return nil
 }
@@ -2082,7 +2357,7 @@ func (dit *dropIndexTask) PreExecute(ctx context.Context) error {
 		dit.IndexName = Params.CommonCfg.DefaultIndexName
 	}
 
-	collID, _ := globalMetaCache.GetCollectionID(ctx, dit.CollectionName)
+	collID, _ := globalMetaCache.GetCollectionID(ctx, dit.GetDbName(), dit.CollectionName)
 	dit.collectionID = collID
 
 	return nil
@@ -2166,7 +2441,7 @@ func (gibpt *getIndexBuildProgressTask) PreExecute(ctx context.Context) error {
 
 func (gibpt *getIndexBuildProgressTask) Execute(ctx context.Context) error {
 	collectionName := gibpt.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gibpt.GetDbName(), collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -2488,7 +2763,7 @@ func (gist *getIndexStateTask) getIndexBuildIDs(ctx context.Context, collectionI
 
 func (gist *getIndexStateTask) Execute(ctx context.Context) error {
 	collectionName := gist.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gist.GetDbName(), collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -2639,7 +2914,7 @@ func (ft *flushTask) PreExecute(ctx context.Context) error {
 func (ft *flushTask) Execute(ctx context.Context) error {
 	coll2Segments := make(map[string]*schemapb.LongArray)
 	for _, collName := range ft.CollectionNames {
-		collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+		collID, err := globalMetaCache.GetCollectionID(ctx, ft.GetDbName(), collName)
 		if err != nil {
 			return err
 		}
@@ -2740,12 +3015,12 @@ func (lct *loadCollectionTask) PreExecute(ctx context.Context) error {
 
 func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
 	log.Debug("loadCollectionTask Execute", zap.String("role", typeutil.ProxyRole), zap.Int64("msgID", lct.Base.MsgID))
-	collID, err := globalMetaCache.GetCollectionID(ctx, lct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lct.GetDbName(), lct.CollectionName)
 	if err != nil {
 		return err
 	}
 	lct.collectionID = collID
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.GetDbName(), lct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -2761,6 +3036,7 @@ func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
 		CollectionID:  collID,
 		Schema:        collSchema,
 		ReplicaNumber: lct.ReplicaNumber,
+		LoadFields:    lct.LoadFields,
 	}
 	log.Debug("send LoadCollectionRequest to query coordinator", zap.String("role", typeutil.ProxyRole),
 		zap.Int64("msgID", request.Base.MsgID), zap.Int64("collectionID", request.CollectionID),
@@ -2840,7 +3116,7 @@ func (rct *releaseCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (rct *releaseCollectionTask) Execute(ctx context.Context) (err error) {
-	collID, err := globalMetaCache.GetCollectionID(ctx, rct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rct.GetDbName(), rct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -2929,17 +3205,17 @@ func (lpt *loadPartitionsTask) PreExecute(ctx context.Context) error {
 
 func (lpt *loadPartitionsTask) Execute(ctx context.Context) error {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.GetDbName(), lpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	lpt.collectionID = collID
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.GetDbName(), lpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	for _, partitionName := range lpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.GetDbName(), lpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -2957,6 +3233,7 @@ func (lpt *loadPartitionsTask) Execute(ctx context.Context) error {
 		PartitionIDs:  partitionIDs,
 		Schema:        collSchema,
 		ReplicaNumber: lpt.ReplicaNumber,
+		LoadFields:    lpt.LoadFields,
 	}
 	lpt.result, err = lpt.queryCoord.LoadPartitions(ctx, request)
 	return err
@@ -3028,13 +3305,13 @@ func (rpt *releasePartitionsTask) PreExecute(ctx context.Context) error {
 
 func (rpt *releasePartitionsTask) Execute(ctx context.Context) (err error) {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.GetDbName(), rpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	rpt.collectionID = collID
 	for _, partitionName := range rpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.GetDbName(), rpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -3135,7 +3412,7 @@ func (dt *deleteTask) getPChanStats() (map[pChan]pChanStatistics, error) {
 }
 
 func (dt *deleteTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(dt.ctx, dt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(dt.ctx, dt.GetDbName(), dt.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -3217,7 +3494,7 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 		log.Error("Invalid collection name", zap.String("collectionName", collName))
 		return err
 	}
-	collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, dt.GetDbName(), collName)
 	if err != nil {
 		log.Debug("Failed to get collection id", zap.String("collectionName", collName))
 		return err
@@ -3232,7 +3509,7 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 			log.Error("Invalid partition name", zap.String("partitionName", partName))
 			return err
 		}
-		partID, err := globalMetaCache.GetPartitionID(ctx, collName, partName)
+		partID, err := globalMetaCache.GetPartitionID(ctx, dt.GetDbName(), collName, partName)
 		if err != nil {
 			log.Debug("Failed to get partition id", zap.String("collectionName", collName), zap.String("partitionName", partName))
 			return err
@@ -3242,7 +3519,7 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 		dt.DeleteRequest.PartitionID = common.InvalidPartitionID
 	}
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, collName)
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dt.GetDbName(), collName)
 	if err != nil {
 		log.Error("Failed to get collection schema", zap.String("collectionName", collName))
 		return err
@@ -3256,6 +3533,16 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if err := globalQuotaLimiter.checkDML(ctx, dt.GetDbName(), collName, numRow); err != nil {
+		log.Warn("delete rejected by quota", zap.String("collectionName", collName), zap.Error(err))
+		return err
+	}
+
+	if err := globalBackpressure.checkDML(ctx, dt.GetDbName(), collName); err != nil {
+		log.Warn("delete rejected by datanode backpressure", zap.String("collectionName", collName), zap.Error(err))
+		return err
+	}
+
 	dt.DeleteRequest.NumRows = numRow
 	dt.DeleteRequest.PrimaryKeys = primaryKeys
 	log.Debug("get primary keys from expr", zap.Int64("len of primary keys", dt.DeleteRequest.NumRows))
@@ -3601,3 +3888,485 @@ func (a *AlterAliasTask) Execute(ctx context.Context) error {
 func (a *AlterAliasTask) PostExecute(ctx context.Context) error {
 	return nil
 }
+
+// RenameCollectionTask is the task to rename collection
+type RenameCollectionTask struct {
+	Condition
+	*milvuspb.RenameCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (r *RenameCollectionTask) TraceCtx() context.Context {
+	return r.ctx
+}
+
+func (r *RenameCollectionTask) ID() UniqueID {
+	return r.Base.MsgID
+}
+
+func (r *RenameCollectionTask) SetID(uid UniqueID) {
+	r.Base.MsgID = uid
+}
+
+func (r *RenameCollectionTask) Name() string {
+	return RenameCollectionTaskName
+}
+
+func (r *RenameCollectionTask) Type() commonpb.MsgType {
+	return r.Base.MsgType
+}
+
+func (r *RenameCollectionTask) BeginTs() Timestamp {
+	return r.Base.Timestamp
+}
+
+func (r *RenameCollectionTask) EndTs() Timestamp {
+	return r.Base.Timestamp
+}
+
+func (r *RenameCollectionTask) SetTs(ts Timestamp) {
+	r.Base.Timestamp = ts
+}
+
+func (r *RenameCollectionTask) OnEnqueue() error {
+	r.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (r *RenameCollectionTask) PreExecute(ctx context.Context) error {
+	r.Base.MsgType = commonpb.MsgType_RenameCollection
+	r.Base.SourceID = Params.ProxyCfg.GetNodeID()
+
+	if err := validateCollectionName(r.OldName); err != nil {
+		return err
+	}
+
+	if err := validateCollectionName(r.NewName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RenameCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	r.result, err = r.rootCoord.RenameCollection(ctx, r.RenameCollectionRequest)
+	return err
+}
+
+func (r *RenameCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// AddCollectionFieldTask is the task to add a field to collection
+type AddCollectionFieldTask struct {
+	Condition
+	*milvuspb.AddCollectionFieldRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (a *AddCollectionFieldTask) TraceCtx() context.Context {
+	return a.ctx
+}
+
+func (a *AddCollectionFieldTask) ID() UniqueID {
+	return a.Base.MsgID
+}
+
+func (a *AddCollectionFieldTask) SetID(uid UniqueID) {
+	a.Base.MsgID = uid
+}
+
+func (a *AddCollectionFieldTask) Name() string {
+	return AddCollectionFieldTaskName
+}
+
+func (a *AddCollectionFieldTask) Type() commonpb.MsgType {
+	return a.Base.MsgType
+}
+
+func (a *AddCollectionFieldTask) BeginTs() Timestamp {
+	return a.Base.Timestamp
+}
+
+func (a *AddCollectionFieldTask) EndTs() Timestamp {
+	return a.Base.Timestamp
+}
+
+func (a *AddCollectionFieldTask) SetTs(ts Timestamp) {
+	a.Base.Timestamp = ts
+}
+
+func (a *AddCollectionFieldTask) OnEnqueue() error {
+	a.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (a *AddCollectionFieldTask) PreExecute(ctx context.Context) error {
+	a.Base.MsgType = commonpb.MsgType_AddCollectionField
+	a.Base.SourceID = Params.ProxyCfg.GetNodeID()
+
+	if err := validateCollectionName(a.CollectionName); err != nil {
+		return err
+	}
+
+	if a.Field == nil {
+		return errors.New("field to add is not specified")
+	}
+
+	// the data type and the default value of the field are validated by root coord
+	return validateFieldName(a.Field.GetName())
+}
+
+func (a *AddCollectionFieldTask) Execute(ctx context.Context) error {
+	var err error
+	a.result, err = a.rootCoord.AddCollectionField(ctx, a.AddCollectionFieldRequest)
+	return err
+}
+
+func (a *AddCollectionFieldTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// AlterCollectionTask is the task to set or remove the properties of collection
+type AlterCollectionTask struct {
+	Condition
+	*milvuspb.AlterCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (a *AlterCollectionTask) TraceCtx() context.Context {
+	return a.ctx
+}
+
+func (a *AlterCollectionTask) ID() UniqueID {
+	return a.Base.MsgID
+}
+
+func (a *AlterCollectionTask) SetID(uid UniqueID) {
+	a.Base.MsgID = uid
+}
+
+func (a *AlterCollectionTask) Name() string {
+	return AlterCollectionTaskName
+}
+
+func (a *AlterCollectionTask) Type() commonpb.MsgType {
+	return a.Base.MsgType
+}
+
+func (a *AlterCollectionTask) BeginTs() Timestamp {
+	return a.Base.Timestamp
+}
+
+func (a *AlterCollectionTask) EndTs() Timestamp {
+	return a.Base.Timestamp
+}
+
+func (a *AlterCollectionTask) SetTs(ts Timestamp) {
+	a.Base.Timestamp = ts
+}
+
+func (a *AlterCollectionTask) OnEnqueue() error {
+	a.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (a *AlterCollectionTask) PreExecute(ctx context.Context) error {
+	a.Base.MsgType = commonpb.MsgType_AlterCollection
+	a.Base.SourceID = Params.ProxyCfg.GetNodeID()
+
+	if err := validateCollectionName(a.CollectionName); err != nil {
+		return err
+	}
+
+	if len(a.Properties) == 0 {
+		return errors.New("properties to alter are not specified")
+	}
+	for _, kv := range a.Properties {
+		if err := typeutil.ValidateCollectionProperty(kv.GetKey(), kv.GetValue()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *AlterCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	a.result, err = a.rootCoord.AlterCollection(ctx, a.AlterCollectionRequest)
+	return err
+}
+
+func (a *AlterCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// CreateDatabaseTask is the task to create a database
+type CreateDatabaseTask struct {
+	Condition
+	*milvuspb.CreateDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (cdt *CreateDatabaseTask) TraceCtx() context.Context {
+	return cdt.ctx
+}
+
+func (cdt *CreateDatabaseTask) ID() UniqueID {
+	return cdt.Base.MsgID
+}
+
+func (cdt *CreateDatabaseTask) SetID(uid UniqueID) {
+	cdt.Base.MsgID = uid
+}
+
+func (cdt *CreateDatabaseTask) Name() string {
+	return CreateDatabaseTaskName
+}
+
+func (cdt *CreateDatabaseTask) Type() commonpb.MsgType {
+	return cdt.Base.MsgType
+}
+
+func (cdt *CreateDatabaseTask) BeginTs() Timestamp {
+	return cdt.Base.Timestamp
+}
+
+func (cdt *CreateDatabaseTask) EndTs() Timestamp {
+	return cdt.Base.Timestamp
+}
+
+func (cdt *CreateDatabaseTask) SetTs(ts Timestamp) {
+	cdt.Base.Timestamp = ts
+}
+
+func (cdt *CreateDatabaseTask) OnEnqueue() error {
+	cdt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (cdt *CreateDatabaseTask) PreExecute(ctx context.Context) error {
+	cdt.Base.MsgType = commonpb.MsgType_CreateDatabase
+	cdt.Base.SourceID = Params.ProxyCfg.GetNodeID()
+
+	if cdt.DbName == "" || cdt.DbName == common.DefaultDatabase {
+		return fmt.Errorf("invalid database name: %q", cdt.DbName)
+	}
+	return nil
+}
+
+func (cdt *CreateDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	cdt.result, err = cdt.rootCoord.CreateDatabase(ctx, cdt.CreateDatabaseRequest)
+	return err
+}
+
+func (cdt *CreateDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// DropDatabaseTask is the task to drop a database without collections
+type DropDatabaseTask struct {
+	Condition
+	*milvuspb.DropDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (ddt *DropDatabaseTask) TraceCtx() context.Context {
+	return ddt.ctx
+}
+
+func (ddt *DropDatabaseTask) ID() UniqueID {
+	return ddt.Base.MsgID
+}
+
+func (ddt *DropDatabaseTask) SetID(uid UniqueID) {
+	ddt.Base.MsgID = uid
+}
+
+func (ddt *DropDatabaseTask) Name() string {
+	return DropDatabaseTaskName
+}
+
+func (ddt *DropDatabaseTask) Type() commonpb.MsgType {
+	return ddt.Base.MsgType
+}
+
+func (ddt *DropDatabaseTask) BeginTs() Timestamp {
+	return ddt.Base.Timestamp
+}
+
+func (ddt *DropDatabaseTask) EndTs() Timestamp {
+	return ddt.Base.Timestamp
+}
+
+func (ddt *DropDatabaseTask) SetTs(ts Timestamp) {
+	ddt.Base.Timestamp = ts
+}
+
+func (ddt *DropDatabaseTask) OnEnqueue() error {
+	ddt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (ddt *DropDatabaseTask) PreExecute(ctx context.Context) error {
+	ddt.Base.MsgType = commonpb.MsgType_DropDatabase
+	ddt.Base.SourceID = Params.ProxyCfg.GetNodeID()
+
+	if ddt.DbName == "" || ddt.DbName == common.DefaultDatabase {
+		return fmt.Errorf("invalid database name: %q", ddt.DbName)
+	}
+	return nil
+}
+
+func (ddt *DropDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	ddt.result, err = ddt.rootCoord.DropDatabase(ctx, ddt.DropDatabaseRequest)
+	return err
+}
+
+func (ddt *DropDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// AlterDatabaseTask is the task to set the quotas of a database
+type AlterDatabaseTask struct {
+	Condition
+	*milvuspb.AlterDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (adt *AlterDatabaseTask) TraceCtx() context.Context {
+	return adt.ctx
+}
+
+func (adt *AlterDatabaseTask) ID() UniqueID {
+	return adt.Base.MsgID
+}
+
+func (adt *AlterDatabaseTask) SetID(uid UniqueID) {
+	adt.Base.MsgID = uid
+}
+
+func (adt *AlterDatabaseTask) Name() string {
+	return AlterDatabaseTaskName
+}
+
+func (adt *AlterDatabaseTask) Type() commonpb.MsgType {
+	return adt.Base.MsgType
+}
+
+func (adt *AlterDatabaseTask) BeginTs() Timestamp {
+	return adt.Base.Timestamp
+}
+
+func (adt *AlterDatabaseTask) EndTs() Timestamp {
+	return adt.Base.Timestamp
+}
+
+func (adt *AlterDatabaseTask) SetTs(ts Timestamp) {
+	adt.Base.Timestamp = ts
+}
+
+func (adt *AlterDatabaseTask) OnEnqueue() error {
+	adt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (adt *AlterDatabaseTask) PreExecute(ctx context.Context) error {
+	adt.Base.MsgType = commonpb.MsgType_AlterDatabase
+	adt.Base.SourceID = Params.ProxyCfg.GetNodeID()
+
+	if adt.DbName == "" || adt.DbName == common.DefaultDatabase {
+		return fmt.Errorf("invalid database name: %q", adt.DbName)
+	}
+
+	if len(adt.Properties) == 0 {
+		return errors.New("properties to alter are not specified")
+	}
+	return nil
+}
+
+func (adt *AlterDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	adt.result, err = adt.rootCoord.AlterDatabase(ctx, adt.AlterDatabaseRequest)
+	return err
+}
+
+func (adt *AlterDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// ListDatabasesTask is the task to list the databases
+type ListDatabasesTask struct {
+	Condition
+	*milvuspb.ListDatabasesRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *milvuspb.ListDatabasesResponse
+}
+
+func (ldt *ListDatabasesTask) TraceCtx() context.Context {
+	return ldt.ctx
+}
+
+func (ldt *ListDatabasesTask) ID() UniqueID {
+	return ldt.Base.MsgID
+}
+
+func (ldt *ListDatabasesTask) SetID(uid UniqueID) {
+	ldt.Base.MsgID = uid
+}
+
+func (ldt *ListDatabasesTask) Name() string {
+	return ListDatabasesTaskName
+}
+
+func (ldt *ListDatabasesTask) Type() commonpb.MsgType {
+	return ldt.Base.MsgType
+}
+
+func (ldt *ListDatabasesTask) BeginTs() Timestamp {
+	return ldt.Base.Timestamp
+}
+
+func (ldt *ListDatabasesTask) EndTs() Timestamp {
+	return ldt.Base.Timestamp
+}
+
+func (ldt *ListDatabasesTask) SetTs(ts Timestamp) {
+	ldt.Base.Timestamp = ts
+}
+
+func (ldt *ListDatabasesTask) OnEnqueue() error {
+	ldt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (ldt *ListDatabasesTask) PreExecute(ctx context.Context) error {
+	ldt.Base.MsgType = commonpb.MsgType_ListDatabases
+	ldt.Base.SourceID = Params.ProxyCfg.GetNodeID()
+	return nil
+}
+
+func (ldt *ListDatabasesTask) Execute(ctx context.Context) error {
+	var err error
+	ldt.result, err = ldt.rootCoord.ListDatabases(ctx, ldt.ListDatabasesRequest)
+	return err
+}
+
+func (ldt *ListDatabasesTask) PostExecute(ctx context.Context) error {
+	return nil
+}
@@ -5,9 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
-	"time"
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
@@ -15,6 +15,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/logutil"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -22,6 +23,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
@@ -41,6 +43,8 @@ type queryTask struct {
 	qc             types.QueryCoord
 	ids            *schemapb.IDs
 	collectionName string
+	// template is the registered template queried by, nil if the request has its own expr
+	template *registeredTemplate
 
 	resultBuf       chan *internalpb.RetrieveResults
 	toReduceResults []*internalpb.RetrieveResults
@@ -49,6 +53,10 @@ type queryTask struct {
 
 	getQueryNodePolicy getQueryNodePolicy
 	queryShardPolicy   pickShardPolicy
+
+	// dynamicKeys are the output fields undeclared in the schema, which are extracted from the dynamic field
+	dynamicKeys      []string
+	keepDynamicField bool
 }
 
 func (t *queryTask) PreExecute(ctx context.Context) error {
@@ -66,44 +74,44 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	collectionName := t.request.CollectionName
 	t.collectionName = collectionName
 	if err := validateCollectionName(collectionName); err != nil {
-		log.Warn("Invalid collection name.", zap.String("collectionName", collectionName),
+		logutil.Logger(ctx).Warn("Invalid collection name.", zap.String("collectionName", collectionName),
 			zap.Int64("requestID", t.Base.MsgID), zap.String("requestType", "query"))
 		return err
 	}
 
-	log.Info("Validate collection name.", zap.Any("collectionName", collectionName),
+	logutil.Logger(ctx).Info("Validate collection name.", zap.Any("collectionName", collectionName),
 		zap.Any("requestID", t.Base.MsgID), zap.Any("requestType", "query"))
 
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, t.request.GetDbName(), collectionName)
 	if err != nil {
-		log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
+		logutil.Logger(ctx).Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 			zap.Any("requestID", t.Base.MsgID), zap.Any("requestType", "query"))
 		return err
 	}
 
 	t.CollectionID = collID
-	log.Info("Get collection ID by name",
+	logutil.Logger(ctx).Info("Get collection ID by name",
 		zap.Int64("collectionID", t.CollectionID), zap.String("collection name", collectionName),
 		zap.Any("requestID", t.Base.MsgID), zap.Any("requestType", "query"))
 
 	for _, tag := range t.request.PartitionNames {
 		if err := validatePartitionTag(tag, false); err != nil {
-			log.Warn("invalid partition name", zap.String("partition name", tag),
+			logutil.Logger(ctx).Warn("invalid partition name", zap.String("partition name", tag),
 				zap.Any("requestID", t.Base.MsgID), zap.Any("requestType", "query"))
 			return err
 		}
 	}
-	log.Debug("Validate partition names.",
+	logutil.Logger(ctx).Debug("Validate partition names.",
 		zap.Any("requestID", t.Base.MsgID), zap.Any("requestType", "query"))
 
 	t.PartitionIDs = make([]UniqueID, 0)
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, t.request.GetDbName(), collectionName)
 	if err != nil {
-		log.Warn("failed to get partitions in collection.", zap.String("collection name", collectionName),
+		logutil.Logger(ctx).Warn("failed to get partitions in collection.", zap.String("collection name", collectionName),
 			zap.Any("requestID", t.Base.MsgID), zap.Any("requestType", "query"))
 		return err
 	}
-	log.Debug("Get partitions in collection.", zap.Any("collectionName", collectionName),
+	logutil.Logger(ctx).Debug("Get partitions in collection.", zap.Any("collectionName", collectionName),
 		zap.Any("requestID", t.Base.MsgID), zap.Any("requestType", "query"))
 
 	// Check if partitions are valid partitions in collection
@@ -112,7 +120,7 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		pattern := fmt.Sprintf("^%s$", partitionName)
 		re, err := regexp.Compile(pattern)
 		if err != nil {
-			log.Debug("failed to compile partition name regex expression.", zap.Any("partition name", partitionName),
+			logutil.Logger(ctx).Debug("failed to compile partition name regex expression.", zap.Any("partition name", partitionName),
 				zap.Any("requestID", t.Base.MsgID), zap.Any("requestType", "query"))
 			return errors.New("invalid partition names")
 		}
@@ -137,7 +145,7 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("collection:%v or partition:%v not loaded into memory", collectionName, t.request.GetPartitionNames())
 	}
 
-	schema, _ := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	schema, _ := globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), collectionName)
 
 	if t.ids != nil {
 		pkField := ""
@@ -153,15 +161,36 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("query expression is empty")
 	}
 
-	plan, err := createExprPlan(schema, t.request.Expr)
+	var plan *planpb.PlanNode
+	if t.ids != nil {
+		// the expressions of the ids are hardly repeated, don't flush the cached templates with them
+		plan, err = createExprPlan(schema, t.request.Expr)
+	} else if t.template != nil {
+		plan, err = createRetrievePlanFromTemplate(t.template, schema, t.request.GetTemplateParams())
+	} else {
+		plan, err = createExprPlanFromTemplate(collID, schema, t.request.Expr)
+	}
 	if err != nil {
 		return err
 	}
+	// query the implicit partitions of the partition key in the expression only
+	if len(t.request.PartitionNames) == 0 {
+		partitionIDs, pruned, err := prunePartitionsByKey(schema, plan.GetPredicates(), partitionsMap)
+		if err != nil {
+			return err
+		}
+		if pruned {
+			t.PartitionIDs = partitionIDs
+			logutil.Logger(ctx).Debug("partitions pruned by partition key", zap.Int64s("partitionIDs", partitionIDs),
+				zap.Any("requestID", t.Base.MsgID), zap.Any("requestType", "query"))
+		}
+	}
 	t.request.OutputFields, err = translateOutputFields(t.request.OutputFields, schema, true)
 	if err != nil {
 		return err
 	}
-	log.Debug("translate output fields", zap.Any("OutputFields", t.request.OutputFields),
+	t.request.OutputFields, t.dynamicKeys, t.keepDynamicField = translateDynamicOutputFields(schema, t.request.OutputFields)
+	logutil.Logger(ctx).Debug("translate output fields", zap.Any("OutputFields", t.request.OutputFields),
 		zap.Any("requestID", t.Base.MsgID), zap.Any("requestType", "query"))
 
 	if len(t.request.OutputFields) == 0 {
@@ -195,7 +224,7 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 			}
 		}
 	}
-	log.Debug("translate output fields to field ids", zap.Any("OutputFieldsID", t.OutputFieldsId),
+	logutil.Logger(ctx).Debug("translate output fields to field ids", zap.Any("OutputFieldsID", t.OutputFieldsId),
 		zap.Any("requestID", t.Base.MsgID), zap.Any("requestType", "query"))
 
 	t.RetrieveRequest.SerializedExprPlan, err = proto.Marshal(plan)
@@ -203,22 +232,11 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	if t.request.TravelTimestamp == 0 {
-		t.TravelTimestamp = t.BeginTs()
-	} else {
-		durationSeconds := tsoutil.CalculateDuration(t.BeginTs(), t.request.TravelTimestamp) / 1000
-		if durationSeconds > Params.CommonCfg.RetentionDuration {
-			duration := time.Second * time.Duration(durationSeconds)
-			return fmt.Errorf("only support to travel back to %s so far", duration.String())
-		}
-		t.TravelTimestamp = t.request.TravelTimestamp
-	}
-
-	if t.request.GuaranteeTimestamp == 0 {
-		t.GuaranteeTimestamp = t.BeginTs()
-	} else {
-		t.GuaranteeTimestamp = t.request.GuaranteeTimestamp
+	t.TravelTimestamp, err = getTravelTimestamp(t.request.TravelTimestamp, t.BeginTs())
+	if err != nil {
+		return err
 	}
+	t.GuaranteeTimestamp = getGuaranteeTimestamp(t.request.GuaranteeTimestamp, t.TravelTimestamp, t.BeginTs())
 
 	deadline, ok := t.TraceCtx().Deadline()
 	if ok {
@@ -226,7 +244,7 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	}
 
 	t.DbID = 0 // TODO
-	log.Info("Query PreExecute done.",
+	logutil.Logger(ctx).Info("Query PreExecute done.",
 		zap.Any("requestID", t.Base.MsgID), zap.Any("requestType", "query"))
 	return nil
 }
@@ -236,7 +254,7 @@ func (t *queryTask) Execute(ctx context.Context) error {
 	defer tr.Elapse("done")
 
 	executeQuery := func(withCache bool) error {
-		shards, err := globalMetaCache.GetShards(ctx, withCache, t.collectionName, t.qc)
+		shards, err := globalMetaCache.GetShards(ctx, withCache, t.request.GetDbName(), t.collectionName, t.qc)
 		if err != nil {
 			return err
 		}
@@ -247,7 +265,7 @@ func (t *queryTask) Execute(ctx context.Context) error {
 		for _, shard := range shards {
 			s := shard
 			t.runningGroup.Go(func() error {
-				log.Debug("proxy starting to query one shard",
+				logutil.Logger(ctx).Debug("proxy starting to query one shard",
 					zap.Int64("collectionID", t.CollectionID),
 					zap.String("collection name", t.collectionName),
 					zap.String("shard channel", s.GetChannelName()),
@@ -267,14 +285,14 @@ func (t *queryTask) Execute(ctx context.Context) error {
 
 	err := executeQuery(WithCache)
 	if err == errInvalidShardLeaders {
-		log.Warn("invalid shard leaders cache, updating shardleader caches and retry search")
+		logutil.Logger(ctx).Warn("invalid shard leaders cache, updating shardleader caches and retry search")
 		return executeQuery(WithoutCache)
 	}
 	if err != nil {
 		return fmt.Errorf("fail to search on all shard leaders, err=%s", err.Error())
 	}
 
-	log.Info("Query Execute done.",
+	logutil.Logger(ctx).Info("Query Execute done.",
 		zap.Any("requestID", t.Base.MsgID), zap.Any("requestType", "query"))
 	return nil
 }
@@ -292,14 +310,14 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		for {
 			select {
 			case <-t.TraceCtx().Done():
-				log.Warn("proxy", zap.Int64("Query: wait to finish failed, timeout!, taskID:", t.ID()))
+				logutil.Logger(ctx).Warn("proxy", zap.Int64("Query: wait to finish failed, timeout!, taskID:", t.ID()))
 				return
 			case <-t.runningGroupCtx.Done():
-				log.Debug("all queries are finished or canceled", zap.Any("taskID", t.ID()))
+				logutil.Logger(ctx).Debug("all queries are finished or canceled", zap.Any("taskID", t.ID()))
 				close(t.resultBuf)
 				for res := range t.resultBuf {
 					t.toReduceResults = append(t.toReduceResults, res)
-					log.Debug("proxy receives one query result", zap.Int64("sourceID", res.GetBase().GetSourceID()), zap.Any("taskID", t.ID()))
+					logutil.Logger(ctx).Debug("proxy receives one query result", zap.Int64("sourceID", res.GetBase().GetSourceID()), zap.Any("taskID", t.ID()))
 				}
 				wg.Done()
 				return
@@ -319,7 +337,7 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 			ErrorCode: commonpb.ErrorCode_Success,
 		}
 	} else {
-		log.Info("Query result is nil", zap.Any("requestID", t.Base.MsgID), zap.Any("requestType", "query"))
+		logutil.Logger(ctx).Info("Query result is nil", zap.Any("requestID", t.Base.MsgID), zap.Any("requestType", "query"))
 		t.result.Status = &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_EmptyCollection,
 			Reason:    "emptly collection", // TODO
@@ -327,7 +345,7 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		return nil
 	}
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.request.CollectionName)
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), t.request.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -340,7 +358,11 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 			}
 		}
 	}
-	log.Info("Query PostExecute done", zap.Any("requestID", t.Base.MsgID), zap.String("requestType", "query"))
+	t.result.FieldsData, err = outputDynamicFields(schema, t.result.FieldsData, t.dynamicKeys, t.keepDynamicField)
+	if err != nil {
+		return err
+	}
+	logutil.Logger(ctx).Info("Query PostExecute done", zap.Any("requestID", t.Base.MsgID), zap.String("requestType", "query"))
 	return nil
 }
 
@@ -354,24 +376,24 @@ func (t *queryTask) queryShard(ctx context.Context, leaders *querypb.ShardLeader
 
 		result, err := qn.Query(ctx, req)
 		if err != nil || result.GetStatus().GetErrorCode() == commonpb.ErrorCode_NotShardLeader {
-			log.Warn("QueryNode query returns error", zap.Int64("nodeID", nodeID),
+			logutil.Logger(ctx).Warn("QueryNode query returns error", zap.Int64("nodeID", nodeID),
 				zap.Error(err))
 			return errInvalidShardLeaders
 		}
 		if result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
-			log.Warn("QueryNode query result error", zap.Int64("nodeID", nodeID),
+			logutil.Logger(ctx).Warn("QueryNode query result error", zap.Int64("nodeID", nodeID),
 				zap.String("reason", result.GetStatus().GetReason()))
 			return fmt.Errorf("fail to Query, QueryNode ID = %d, reason=%s", nodeID, result.GetStatus().GetReason())
 		}
 
-		log.Debug("get query result", zap.Int64("nodeID", nodeID), zap.String("channelID", leaders.GetChannelName()))
+		logutil.Logger(ctx).Debug("get query result", zap.Int64("nodeID", nodeID), zap.String("channelID", leaders.GetChannelName()))
 		t.resultBuf <- result
 		return nil
 	}
 
 	err := t.queryShardPolicy(t.TraceCtx(), t.getQueryNodePolicy, query, leaders)
 	if err != nil {
-		log.Warn("fail to Query to all shard leaders", zap.Int64("taskID", t.ID()), zap.Any("shard leaders", leaders.GetNodeIds()))
+		logutil.Logger(ctx).Warn("fail to Query to all shard leaders", zap.Int64("taskID", t.ID()), zap.Any("shard leaders", leaders.GetNodeIds()))
 		return err
 	}
 
@@ -487,7 +509,12 @@ func IDs2Expr(fieldName string, ids *schemapb.IDs) string {
 	case *schemapb.IDs_IntId:
 		idsStr = strings.Trim(strings.Join(strings.Fields(fmt.Sprint(ids.GetIntId().GetData())), ", "), "[]")
 	case *schemapb.IDs_StrId:
-		idsStr = strings.Trim(strings.Join(ids.GetStrId().GetData(), ", "), "[]")
+		// the string literals of the expression are quoted
+		strs := make([]string, 0, len(ids.GetStrId().GetData()))
+		for _, id := range ids.GetStrId().GetData() {
+			strs = append(strs, strconv.Quote(id))
+		}
+		idsStr = strings.Join(strs, ", ")
 	}
 
 	return fieldName + " in [ " + idsStr + " ]"
@@ -548,6 +575,10 @@ func (t *queryTask) ID() UniqueID {
 
 func (t *queryTask) SetID(uid UniqueID) {
 	t.Base.MsgID = uid
+	// the ID is sent to query nodes as the msgID of the request, and logged with the logs of the request across components
+	if t.ctx != nil {
+		t.ctx = logutil.WithReqID(t.ctx, uid)
+	}
 }
 
 func (t *queryTask) Name() string {
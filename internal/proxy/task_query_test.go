@@ -85,7 +85,7 @@ func TestQueryTask_all(t *testing.T) {
 	require.NoError(t, createColT.Execute(ctx))
 	require.NoError(t, createColT.PostExecute(ctx))
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 	assert.NoError(t, err)
 
 	status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{
@@ -167,3 +167,11 @@ func TestQueryTask_all(t *testing.T) {
 
 	assert.NoError(t, task.PostExecute(ctx))
 }
+
+func TestIDs2Expr(t *testing.T) {
+	intIDs := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2}}}}
+	assert.Equal(t, "pk in [ 1, 2 ]", IDs2Expr("pk", intIDs))
+
+	strIDs := &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a", `b"c`}}}}
+	assert.Equal(t, `pk in [ "a", "b\"c" ]`, IDs2Expr("pk", strIDs))
+}
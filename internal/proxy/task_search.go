@@ -5,9 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"runtime"
 	"strconv"
 	"sync"
-	"time"
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
@@ -19,6 +19,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/util/distance"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/logutil"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
@@ -43,6 +44,8 @@ type searchTask struct {
 	tr             *timerecord.TimeRecorder
 	collectionName string
 	schema         *schemapb.CollectionSchema
+	// template is the registered template searched by, nil if the request has its own dsl
+	template *registeredTemplate
 
 	resultBuf       chan *internalpb.SearchResults
 	toReduceResults []*internalpb.SearchResults
@@ -51,6 +54,10 @@ type searchTask struct {
 
 	getQueryNodePolicy getQueryNodePolicy
 	searchShardPolicy  pickShardPolicy
+
+	// dynamicKeys are the output fields undeclared in the schema, which are extracted from the dynamic field
+	dynamicKeys      []string
+	keepDynamicField bool
 }
 
 func (t *searchTask) PreExecute(ctx context.Context) error {
@@ -73,7 +80,7 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, t.request.GetDbName(), collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -87,7 +94,7 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		}
 	}
 
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, t.request.GetDbName(), collectionName)
 	if err != nil {
 		return err
 	}
@@ -122,14 +129,14 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	// TODO(dragondriver): necessary to check if partition was loaded into query node?
 	t.Base.MsgType = commonpb.MsgType_Search
 
-	t.schema, _ = globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	t.schema, _ = globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), collectionName)
 
 	outputFields, err := translateOutputFields(t.request.OutputFields, t.schema, false)
 	if err != nil {
 		return err
 	}
-	log.Debug("translate output fields", zap.Any("OutputFields", outputFields))
-	t.request.OutputFields = outputFields
+	logutil.Logger(ctx).Debug("translate output fields", zap.Any("OutputFields", outputFields))
+	t.request.OutputFields, t.dynamicKeys, t.keepDynamicField = translateDynamicOutputFields(t.schema, outputFields)
 
 	if t.request.GetDslType() == commonpb.DslType_BoolExprV1 {
 		annsField, err := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, t.request.SearchParams)
@@ -145,6 +152,9 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		if err != nil {
 			return errors.New(TopKKey + " " + topKStr + " is not invalid")
 		}
+		if err := checkTopK(t.schema, int64(topK)); err != nil {
+			return err
+		}
 
 		metricType, err := funcutil.GetAttrByKeyFromRepeatedKV(MetricTypeKey, t.request.SearchParams)
 		if err != nil {
@@ -175,15 +185,20 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 			RoundDecimal: int64(roundDecimal),
 		}
 
-		log.Debug("create query plan",
+		logutil.Logger(ctx).Debug("create query plan",
 			//zap.Any("schema", schema),
 			zap.String("dsl", t.request.Dsl),
 			zap.String("anns field", annsField),
 			zap.Any("query info", queryInfo))
 
-		plan, err := createQueryPlan(t.schema, t.request.Dsl, annsField, queryInfo)
+		var plan *planpb.PlanNode
+		if t.template != nil {
+			plan, err = createQueryPlanFromTemplate(t.template, t.schema, t.request.GetTemplateParams(), queryInfo)
+		} else {
+			plan, err = createQueryPlan(t.schema, t.request.Dsl, annsField, queryInfo)
+		}
 		if err != nil {
-			log.Debug("failed to create query plan",
+			logutil.Logger(ctx).Debug("failed to create query plan",
 				zap.Error(err),
 				//zap.Any("schema", schema),
 				zap.String("dsl", t.request.Dsl),
@@ -192,6 +207,17 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 
 			return fmt.Errorf("failed to create query plan: %v", err)
 		}
+		// search the implicit partitions of the partition key in the expression only
+		if len(t.request.PartitionNames) == 0 {
+			partitionIDs, pruned, err := prunePartitionsByKey(t.schema, plan.GetVectorAnns().GetPredicates(), partitionsMap)
+			if err != nil {
+				return err
+			}
+			if pruned {
+				t.PartitionIDs = partitionIDs
+				logutil.Logger(ctx).Debug("partitions pruned by partition key", zap.Int64s("partitionIDs", partitionIDs))
+			}
+		}
 		for _, name := range t.request.OutputFields {
 			hitField := false
 			for _, field := range t.schema.Fields {
@@ -217,25 +243,14 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
-		log.Debug("Proxy::searchTask::PreExecute", zap.Any("plan.OutputFieldIds", plan.OutputFieldIds),
+		logutil.Logger(ctx).Debug("Proxy::searchTask::PreExecute", zap.Any("plan.OutputFieldIds", plan.OutputFieldIds),
 			zap.Any("plan", plan.String()))
 	}
-	travelTimestamp := t.request.TravelTimestamp
-	if travelTimestamp == 0 {
-		travelTimestamp = t.BeginTs()
-	} else {
-		durationSeconds := tsoutil.CalculateDuration(t.BeginTs(), travelTimestamp) / 1000
-		if durationSeconds > Params.CommonCfg.RetentionDuration {
-			duration := time.Second * time.Duration(durationSeconds)
-			return fmt.Errorf("only support to travel back to %s so far", duration.String())
-		}
-	}
-	guaranteeTimestamp := t.request.GuaranteeTimestamp
-	if guaranteeTimestamp == 0 {
-		guaranteeTimestamp = t.BeginTs()
+	t.TravelTimestamp, err = getTravelTimestamp(t.request.TravelTimestamp, t.BeginTs())
+	if err != nil {
+		return err
 	}
-	t.TravelTimestamp = travelTimestamp
-	t.GuaranteeTimestamp = guaranteeTimestamp
+	t.GuaranteeTimestamp = getGuaranteeTimestamp(t.request.GuaranteeTimestamp, t.TravelTimestamp, t.BeginTs())
 	deadline, ok := t.TraceCtx().Deadline()
 	if ok {
 		t.SearchRequest.TimeoutTimestamp = tsoutil.ComposeTSByTime(deadline, 0)
@@ -245,7 +260,7 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	t.SearchRequest.Dsl = t.request.Dsl
 	t.SearchRequest.PlaceholderGroup = t.request.PlaceholderGroup
 
-	log.Info("search PreExecute done.",
+	logutil.Logger(ctx).Info("search PreExecute done.",
 		zap.Any("requestID", t.Base.MsgID), zap.Any("requestType", "search"))
 	return nil
 }
@@ -258,7 +273,7 @@ func (t *searchTask) Execute(ctx context.Context) error {
 	defer tr.Elapse("done")
 
 	executeSearch := func(withCache bool) error {
-		shards, err := globalMetaCache.GetShards(ctx, withCache, t.collectionName, t.qc)
+		shards, err := globalMetaCache.GetShards(ctx, withCache, t.request.GetDbName(), t.collectionName, t.qc)
 		if err != nil {
 			return err
 		}
@@ -272,7 +287,7 @@ func (t *searchTask) Execute(ctx context.Context) error {
 		for _, shard := range shards {
 			s := shard
 			t.runningGroup.Go(func() error {
-				log.Debug("proxy starting to query one shard",
+				logutil.Logger(ctx).Debug("proxy starting to query one shard",
 					zap.Int64("collectionID", t.CollectionID),
 					zap.String("collection name", t.collectionName),
 					zap.String("shard channel", s.GetChannelName()),
@@ -292,14 +307,14 @@ func (t *searchTask) Execute(ctx context.Context) error {
 
 	err := executeSearch(WithCache)
 	if err == errInvalidShardLeaders {
-		log.Warn("invalid shard leaders from cache, updating shardleader caches and retry search")
+		logutil.Logger(ctx).Warn("invalid shard leaders from cache, updating shardleader caches and retry search")
 		return executeSearch(WithoutCache)
 	}
 	if err != nil {
 		return fmt.Errorf("fail to search on all shard leaders, err=%s", err.Error())
 	}
 
-	log.Info("Search Execute done.",
+	logutil.Logger(ctx).Info("Search Execute done.",
 		zap.Any("requestID", t.Base.MsgID), zap.Any("requestType", "search"))
 	return nil
 }
@@ -318,14 +333,14 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		for {
 			select {
 			case <-t.TraceCtx().Done():
-				log.Debug("wait to finish timeout!", zap.Int64("taskID", t.ID()))
+				logutil.Logger(ctx).Debug("wait to finish timeout!", zap.Int64("taskID", t.ID()))
 				return
 			case <-t.runningGroupCtx.Done():
-				log.Debug("all searches are finished or canceled", zap.Any("taskID", t.ID()))
+				logutil.Logger(ctx).Debug("all searches are finished or canceled", zap.Any("taskID", t.ID()))
 				close(t.resultBuf)
 				for res := range t.resultBuf {
 					t.toReduceResults = append(t.toReduceResults, res)
-					log.Debug("proxy receives one query result", zap.Int64("sourceID", res.GetBase().GetSourceID()), zap.Any("taskID", t.ID()))
+					logutil.Logger(ctx).Debug("proxy receives one query result", zap.Int64("sourceID", res.GetBase().GetSourceID()), zap.Any("taskID", t.ID()))
 				}
 				wg.Done()
 				return
@@ -340,9 +355,9 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		return err
 	}
 	metrics.ProxyDecodeSearchResultLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
-	log.Debug("proxy search post execute stage 2", zap.Any("len(validSearchResults)", len(validSearchResults)))
+	logutil.Logger(ctx).Debug("proxy search post execute stage 2", zap.Any("len(validSearchResults)", len(validSearchResults)))
 	if len(validSearchResults) <= 0 {
-		log.Warn("search result is empty", zap.Any("requestID", t.Base.MsgID), zap.String("requestType", "search"))
+		logutil.Logger(ctx).Warn("search result is empty", zap.Any("requestID", t.Base.MsgID), zap.String("requestType", "search"))
 
 		t.result = &milvuspb.SearchResults{
 			Status: &commonpb.Status{
@@ -374,7 +389,7 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	metrics.ProxyReduceSearchResultLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.SuccessLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 	t.result.CollectionName = t.collectionName
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.request.CollectionName)
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), t.request.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -388,8 +403,12 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 				}
 			}
 		}
+		t.result.Results.FieldsData, err = outputDynamicFields(schema, t.result.Results.FieldsData, t.dynamicKeys, t.keepDynamicField)
+		if err != nil {
+			return err
+		}
 	}
-	log.Info("Search post execute done", zap.Any("requestID", t.Base.MsgID), zap.String("requestType", "search"))
+	logutil.Logger(ctx).Info("Search post execute done", zap.Any("requestID", t.Base.MsgID), zap.String("requestType", "search"))
 	return nil
 }
 
@@ -404,12 +423,12 @@ func (t *searchTask) searchShard(ctx context.Context, leaders *querypb.ShardLead
 
 		result, err := qn.Search(ctx, req)
 		if err != nil || result.GetStatus().GetErrorCode() == commonpb.ErrorCode_NotShardLeader {
-			log.Warn("QueryNode search returns error", zap.Int64("nodeID", nodeID),
+			logutil.Logger(ctx).Warn("QueryNode search returns error", zap.Int64("nodeID", nodeID),
 				zap.Error(err))
 			return errInvalidShardLeaders
 		}
 		if result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
-			log.Warn("QueryNode search result error", zap.Int64("nodeID", nodeID),
+			logutil.Logger(ctx).Warn("QueryNode search result error", zap.Int64("nodeID", nodeID),
 				zap.String("reason", result.GetStatus().GetReason()))
 			return fmt.Errorf("fail to Search, QueryNode ID=%d, reason=%s", nodeID, result.GetStatus().GetReason())
 		}
@@ -420,7 +439,7 @@ func (t *searchTask) searchShard(ctx context.Context, leaders *querypb.ShardLead
 
 	err := t.searchShardPolicy(t.TraceCtx(), t.getQueryNodePolicy, search, leaders)
 	if err != nil {
-		log.Warn("fail to search to all shard leaders", zap.Any("shard leaders", leaders.GetNodeIds()))
+		logutil.Logger(ctx).Warn("fail to search to all shard leaders", zap.Any("shard leaders", leaders.GetNodeIds()))
 		return err
 	}
 
@@ -529,21 +548,30 @@ func (t *searchTask) checkIfLoaded(collectionID UniqueID, searchPartitionIDs []U
 	return loaded
 }
 
+// decodeSearchResults unmarshals the results of the shards in parallel, the empty results are skipped
 func decodeSearchResults(searchResults []*internalpb.SearchResults) ([]*schemapb.SearchResultData, error) {
 	tr := timerecord.NewTimeRecorder("decodeSearchResults")
-	results := make([]*schemapb.SearchResultData, 0)
-	for _, partialSearchResult := range searchResults {
-		if partialSearchResult.SlicedBlob == nil {
-			continue
+	decoded := make([]*schemapb.SearchResultData, len(searchResults))
+	err := funcutil.ProcessFuncParallel(len(searchResults), runtime.GOMAXPROCS(0), func(idx int) error {
+		if searchResults[idx].SlicedBlob == nil {
+			return nil
 		}
-
 		var partialResultData schemapb.SearchResultData
-		err := proto.Unmarshal(partialSearchResult.SlicedBlob, &partialResultData)
-		if err != nil {
-			return nil, err
+		if err := proto.Unmarshal(searchResults[idx].SlicedBlob, &partialResultData); err != nil {
+			return err
 		}
+		decoded[idx] = &partialResultData
+		return nil
+	}, "decodeSearchResults")
+	if err != nil {
+		return nil, err
+	}
 
-		results = append(results, &partialResultData)
+	results := make([]*schemapb.SearchResultData, 0, len(decoded))
+	for _, partialResultData := range decoded {
+		if partialResultData != nil {
+			results = append(results, partialResultData)
+		}
 	}
 	tr.Elapse("decodeSearchResults done")
 	return results, nil
@@ -582,38 +610,69 @@ func selectSearchResultData(dataArray []*schemapb.SearchResultData, resultOffset
 	return sel
 }
 
-func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType) (*milvuspb.SearchResults, error) {
+// reduceHitsPerRoutine is the least number of the hits merged by a routine, the queries are reduced in parallel only
+// if the results are large enough to pay for the routines
+const reduceHitsPerRoutine = 8192
 
-	tr := timerecord.NewTimeRecorder("reduceSearchResultData")
-	defer func() {
-		tr.Elapse("done")
-	}()
+// reduceRoutineNum returns the number of the routines reducing the queries, no more than the number of CPUs
+func reduceRoutineNum(nq int64, topk int64, shardNum int) int64 {
+	num := nq * topk * int64(shardNum) / reduceHitsPerRoutine
+	if maxNum := int64(runtime.GOMAXPROCS(0)); num > maxNum {
+		num = maxNum
+	}
+	if num > nq {
+		num = nq
+	}
+	if num < 1 {
+		num = 1
+	}
+	return num
+}
 
-	log.Debug("reduceSearchResultData", zap.Int("len(searchResultData)", len(searchResultData)),
-		zap.Int64("nq", nq), zap.Int64("topk", topk), zap.String("metricType", metricType))
+// reduceBuffer is the buffer of merging the results of a query, it's pooled to save the allocation of the id sets
+type reduceBuffer struct {
+	offsets []int64
+	idSet   map[interface{}]struct{}
+}
 
-	ret := &milvuspb.SearchResults{
-		Status: &commonpb.Status{
-			ErrorCode: 0,
-		},
-		Results: &schemapb.SearchResultData{
-			NumQueries: nq,
-			TopK:       topk,
-			FieldsData: make([]*schemapb.FieldData, len(searchResultData[0].FieldsData)),
-			Scores:     make([]float32, 0),
-			Ids:        &schemapb.IDs{},
-			Topks:      make([]int64, 0),
-		},
+var reduceBufferPool = sync.Pool{
+	New: func() interface{} {
+		return &reduceBuffer{idSet: make(map[interface{}]struct{})}
+	},
+}
+
+func (b *reduceBuffer) reset(ways int) {
+	if cap(b.offsets) < ways {
+		b.offsets = make([]int64, ways)
+	}
+	b.offsets = b.offsets[:ways]
+	for i := range b.offsets {
+		b.offsets[i] = 0
+	}
+	for id := range b.idSet {
+		delete(b.idSet, id)
+	}
+}
+
+// newReducedSearchResultData returns the empty result with the ids of pkType
+func newReducedSearchResultData(nq int64, topk int64, fieldNum int, pkType schemapb.DataType) (*schemapb.SearchResultData, error) {
+	data := &schemapb.SearchResultData{
+		NumQueries: nq,
+		TopK:       topk,
+		FieldsData: make([]*schemapb.FieldData, fieldNum),
+		Scores:     make([]float32, 0),
+		Ids:        &schemapb.IDs{},
+		Topks:      make([]int64, 0),
 	}
 	switch pkType {
 	case schemapb.DataType_Int64:
-		ret.GetResults().Ids.IdField = &schemapb.IDs_IntId{
+		data.Ids.IdField = &schemapb.IDs_IntId{
 			IntId: &schemapb.LongArray{
 				Data: make([]int64, 0),
 			},
 		}
 	case schemapb.DataType_VarChar:
-		ret.GetResults().Ids.IdField = &schemapb.IDs_StrId{
+		data.Ids.IdField = &schemapb.IDs_StrId{
 			StrId: &schemapb.StringArray{
 				Data: make([]string, 0),
 			},
@@ -621,6 +680,95 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 	default:
 		return nil, errors.New("unsupported pk type")
 	}
+	return data, nil
+}
+
+// reduceSearchResultBatch merges the results of the queries in [begin, end) into dst,
+// returns the number of the duplicated hits skipped.
+func reduceSearchResultBatch(dst *schemapb.SearchResultData, searchResultData []*schemapb.SearchResultData,
+	resultOffsets [][]int64, begin int64, end int64, topk int64) int64 {
+	buf := reduceBufferPool.Get().(*reduceBuffer)
+	defer reduceBufferPool.Put(buf)
+
+	var skipDupCnt int64
+	for i := begin; i < end; i++ {
+		buf.reset(len(searchResultData))
+		offsets, idSet := buf.offsets, buf.idSet
+
+		var j int64
+		for j = 0; j < topk; {
+			sel := selectSearchResultData(searchResultData, resultOffsets, offsets, i)
+			if sel == -1 {
+				break
+			}
+			idx := resultOffsets[sel][i] + offsets[sel]
+
+			id := typeutil.GetPK(searchResultData[sel].GetIds(), idx)
+			score := searchResultData[sel].Scores[idx]
+
+			// remove duplicates
+			if _, ok := idSet[id]; !ok {
+				typeutil.AppendFieldData(dst.FieldsData, searchResultData[sel].FieldsData, idx)
+				typeutil.AppendPKs(dst.Ids, id)
+				dst.Scores = append(dst.Scores, score)
+				idSet[id] = struct{}{}
+				j++
+			} else {
+				// skip entity with same id
+				skipDupCnt++
+			}
+			offsets[sel]++
+		}
+		dst.Topks = append(dst.Topks, j)
+	}
+	return skipDupCnt
+}
+
+// mergeReducedSearchResultData appends the reduced results of the following queries to dst
+func mergeReducedSearchResultData(dst *schemapb.SearchResultData, src *schemapb.SearchResultData) {
+	dst.Scores = append(dst.Scores, src.Scores...)
+	dst.Topks = append(dst.Topks, src.Topks...)
+	switch ids := src.GetIds().GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		dst.Ids.GetIntId().Data = append(dst.Ids.GetIntId().Data, ids.IntId.GetData()...)
+	case *schemapb.IDs_StrId:
+		dst.Ids.GetStrId().Data = append(dst.Ids.GetStrId().Data, ids.StrId.GetData()...)
+	}
+	for i, fieldData := range src.FieldsData {
+		if fieldData == nil {
+			continue
+		}
+		if dst.FieldsData[i] == nil {
+			dst.FieldsData[i] = fieldData
+			continue
+		}
+		typeutil.MergeFieldData(dst.FieldsData[i:i+1], []*schemapb.FieldData{fieldData})
+	}
+}
+
+// reduceSearchResultData merges the results of the shards, the queries are split into batches reduced in parallel
+// when the results are large.
+func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType) (*milvuspb.SearchResults, error) {
+
+	tr := timerecord.NewTimeRecorder("reduceSearchResultData")
+	defer func() {
+		tr.Elapse("done")
+	}()
+
+	log.Debug("reduceSearchResultData", zap.Int("len(searchResultData)", len(searchResultData)),
+		zap.Int64("nq", nq), zap.Int64("topk", topk), zap.String("metricType", metricType))
+
+	fieldNum := len(searchResultData[0].FieldsData)
+	results, err := newReducedSearchResultData(nq, topk, fieldNum, pkType)
+	if err != nil {
+		return nil, err
+	}
+	ret := &milvuspb.SearchResults{
+		Status: &commonpb.Status{
+			ErrorCode: 0,
+		},
+		Results: results,
+	}
 
 	for i, sData := range searchResultData {
 		log.Debug("reduceSearchResultData",
@@ -645,43 +793,43 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 	}
 
 	var skipDupCnt int64
-	var realTopK int64 = -1
-	for i := int64(0); i < nq; i++ {
-		offsets := make([]int64, len(searchResultData))
-
-		var idSet = make(map[interface{}]struct{})
-		var j int64
-		for j = 0; j < topk; {
-			sel := selectSearchResultData(searchResultData, resultOffsets, offsets, i)
-			if sel == -1 {
-				break
-			}
-			idx := resultOffsets[sel][i] + offsets[sel]
-
-			id := typeutil.GetPK(searchResultData[sel].GetIds(), idx)
-			score := searchResultData[sel].Scores[idx]
-
-			// remove duplicates
-			if _, ok := idSet[id]; !ok {
-				typeutil.AppendFieldData(ret.Results.FieldsData, searchResultData[sel].FieldsData, idx)
-				typeutil.AppendPKs(ret.Results.Ids, id)
-				ret.Results.Scores = append(ret.Results.Scores, score)
-				idSet[id] = struct{}{}
-				j++
-			} else {
-				// skip entity with same id
-				skipDupCnt++
+	routineNum := reduceRoutineNum(nq, topk, len(searchResultData))
+	if routineNum == 1 {
+		skipDupCnt = reduceSearchResultBatch(ret.Results, searchResultData, resultOffsets, 0, nq, topk)
+	} else {
+		batchSize := (nq + routineNum - 1) / routineNum
+		routineNum = (nq + batchSize - 1) / batchSize
+		batches := make([]*schemapb.SearchResultData, routineNum)
+		skipDupCnts := make([]int64, routineNum)
+		err := funcutil.ProcessFuncParallel(int(routineNum), int(routineNum), func(idx int) error {
+			begin := int64(idx) * batchSize
+			end := begin + batchSize
+			if end > nq {
+				end = nq
 			}
-			offsets[sel]++
+			// pkType is checked already
+			batches[idx], _ = newReducedSearchResultData(end-begin, topk, fieldNum, pkType)
+			skipDupCnts[idx] = reduceSearchResultBatch(batches[idx], searchResultData, resultOffsets, begin, end, topk)
+			return nil
+		}, "reduceSearchResultData")
+		if err != nil {
+			return ret, err
+		}
+		for idx, batch := range batches {
+			mergeReducedSearchResultData(ret.Results, batch)
+			skipDupCnt += skipDupCnts[idx]
 		}
+	}
+	log.Debug("skip duplicated search result", zap.Int64("count", skipDupCnt), zap.Int64("routineNum", routineNum))
+
+	var realTopK int64 = -1
+	for _, j := range ret.Results.Topks {
 		if realTopK != -1 && realTopK != j {
 			log.Warn("Proxy Reduce Search Result", zap.Error(errors.New("the length (topk) between all result of query is different")))
 			// return nil, errors.New("the length (topk) between all result of query is different")
 		}
 		realTopK = j
-		ret.Results.Topks = append(ret.Results.Topks, realTopK)
 	}
-	log.Debug("skip duplicated search result", zap.Int64("count", skipDupCnt))
 	ret.Results.TopK = realTopK
 
 	if !distance.PositivelyRelated(metricType) {
@@ -727,6 +875,10 @@ func (t *searchTask) ID() UniqueID {
 
 func (t *searchTask) SetID(uid UniqueID) {
 	t.Base.MsgID = uid
+	// the ID is sent to query nodes as the msgID of the request, and logged with the logs of the request across components
+	if t.ctx != nil {
+		t.ctx = logutil.WithReqID(t.ctx, uid)
+	}
 }
 
 func (t *searchTask) Name() string {
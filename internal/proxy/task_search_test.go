@@ -3,12 +3,15 @@ package proxy
 import (
 	"context"
 	"errors"
+	"runtime"
 	"testing"
 	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 
 	"github.com/milvus-io/milvus/internal/types"
 
@@ -20,6 +23,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/util/distance"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/logutil"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -195,7 +199,7 @@ func TestSearchTask_PreExecute(t *testing.T) {
 	t.Run("test checkIfLoaded error", func(t *testing.T) {
 		collName := "test_checkIfLoaded_error" + funcutil.GenRandomStr()
 		createColl(t, collName, rc)
-		collID, err := globalMetaCache.GetCollectionID(context.TODO(), collName)
+		collID, err := globalMetaCache.GetCollectionID(context.TODO(), "", collName)
 		require.NoError(t, err)
 		task := getSearchTask(t, collName)
 
@@ -335,7 +339,7 @@ func TestSearchTask_PreExecute(t *testing.T) {
 			t.Run(test.description, func(t *testing.T) {
 				collName := "collection_" + test.description
 				createColl(t, collName, rc)
-				collID, err := globalMetaCache.GetCollectionID(context.TODO(), collName)
+				collID, err := globalMetaCache.GetCollectionID(context.TODO(), "", collName)
 				require.NoError(t, err)
 				task := getSearchTask(t, collName)
 				task.request.DslType = commonpb.DslType_BoolExprV1
@@ -356,7 +360,7 @@ func TestSearchTask_PreExecute(t *testing.T) {
 	t.Run("search with timeout", func(t *testing.T) {
 		collName := "search_with_timeout" + funcutil.GenRandomStr()
 		createColl(t, collName, rc)
-		collID, err := globalMetaCache.GetCollectionID(context.TODO(), collName)
+		collID, err := globalMetaCache.GetCollectionID(context.TODO(), "", collName)
 		require.NoError(t, err)
 		status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -565,7 +569,7 @@ func TestSearchTaskWithInvalidRoundDecimal(t *testing.T) {
 	// query := newMockGetChannelsService()
 	// factory := newSimpleMockMsgStreamFactory()
 	//
-	// collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	// collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 	// assert.NoError(t, err)
 	//
 	// qc := NewQueryCoordMock()
@@ -809,7 +813,7 @@ func TestSearchTaskV2_all(t *testing.T) {
 	// query := newMockGetChannelsService()
 	// factory := newSimpleMockMsgStreamFactory()
 	//
-	// collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	// collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 	// assert.NoError(t, err)
 	//
 	// qc := NewQueryCoordMock()
@@ -1047,7 +1051,7 @@ func TestSearchTaskV2_7803_reduce(t *testing.T) {
 	// query := newMockGetChannelsService()
 	// factory := newSimpleMockMsgStreamFactory()
 	//
-	// collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	// collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 	// assert.NoError(t, err)
 	//
 	// qc := NewQueryCoordMock()
@@ -1534,3 +1538,78 @@ func Test_reduceSearchResultData_str(t *testing.T) {
 	// hard to compare floating point value.
 	// TODO: compare scores.
 }
+
+func Test_reduceSearchResultData_parallel(t *testing.T) {
+	nq, topk, shardNum := int64(64), int64(256), 4
+	results := make([]*schemapb.SearchResultData, 0, shardNum)
+	for s := 0; s < shardNum; s++ {
+		ids := make([]int64, 0, nq*topk)
+		scores := make([]float32, 0, nq*topk)
+		topks := make([]int64, 0, nq)
+		for q := int64(0); q < nq; q++ {
+			for k := int64(0); k < topk; k++ {
+				id := q*100000 + k*int64(shardNum) + int64(s)
+				// the shards 0 and 1 return the same entities
+				if s == 1 {
+					id--
+				}
+				ids = append(ids, id)
+				scores = append(scores, float32(topk-k))
+			}
+			topks = append(topks, topk)
+		}
+		results = append(results, &schemapb.SearchResultData{
+			NumQueries: nq,
+			TopK:       topk,
+			FieldsData: []*schemapb.FieldData{{
+				Type:      schemapb.DataType_Int64,
+				FieldName: "pk",
+				FieldId:   100,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: ids}},
+				}},
+			}},
+			Ids:    &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+			Scores: scores,
+			Topks:  topks,
+		})
+	}
+	if runtime.GOMAXPROCS(0) > 1 {
+		assert.Greater(t, reduceRoutineNum(nq, topk, shardNum), int64(1))
+	}
+
+	reduced, err := reduceSearchResultData(results, nq, topk, distance.IP, schemapb.DataType_Int64)
+	assert.NoError(t, err)
+	ids := reduced.GetResults().GetIds().GetIntId().GetData()
+	assert.Equal(t, int(nq*topk), len(ids))
+	assert.Equal(t, int(nq*topk), len(reduced.GetResults().GetScores()))
+	assert.Equal(t, ids, reduced.GetResults().GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, topk, reduced.GetResults().GetTopK())
+	for q := int64(0); q < nq; q++ {
+		assert.Equal(t, topk, reduced.GetResults().GetTopks()[q])
+		// the hits with the highest score of all the shards, the duplicated one is skipped
+		assert.Equal(t, []int64{q * 100000, q*100000 + 2, q*100000 + 3}, ids[q*topk:q*topk+3])
+	}
+}
+
+func Test_reduceRoutineNum(t *testing.T) {
+	assert.Equal(t, int64(1), reduceRoutineNum(0, 10, 2))
+	assert.Equal(t, int64(1), reduceRoutineNum(10, 10, 2))
+	// no more than nq
+	assert.Equal(t, int64(1), reduceRoutineNum(1, 16384, 16))
+	assert.LessOrEqual(t, reduceRoutineNum(1024, 1024, 16), int64(runtime.GOMAXPROCS(0)))
+}
+
+func TestSearchTask_SetID(t *testing.T) {
+	core, logs := observer.New(zap.DebugLevel)
+	task := &searchTask{
+		ctx:           logutil.WithLogger(context.Background(), zap.New(core)),
+		SearchRequest: &internalpb.SearchRequest{Base: &commonpb.MsgBase{}},
+	}
+	task.SetID(100)
+	assert.Equal(t, UniqueID(100), task.ID())
+
+	logutil.Logger(task.TraceCtx()).Info("search")
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, int64(100), logs.All()[0].ContextMap()["reqID"])
+}
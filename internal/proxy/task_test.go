@@ -1057,6 +1057,41 @@ func TestCreateCollectionTask(t *testing.T) {
 		err = task.PreExecute(ctx)
 		assert.Error(t, err)
 
+		// the annotations of the collection are only allowed on the primary key
+		schema = proto.Clone(schemaBackup).(*schemapb.CollectionSchema)
+		for idx := range schema.Fields {
+			if !schema.Fields[idx].IsPrimaryKey {
+				schema.Fields[idx].TypeParams = append(schema.Fields[idx].TypeParams, &commonpb.KeyValuePair{
+					Key:   common.CollectionAnnotationPrefix + "owner",
+					Value: "search-team",
+				})
+			}
+		}
+		misplacedAnnotationSchema, err := proto.Marshal(schema)
+		assert.NoError(t, err)
+		task.CreateCollectionRequest.Schema = misplacedAnnotationSchema
+		err = task.PreExecute(ctx)
+		assert.Error(t, err)
+
+		// the properties of the collection set on the primary key are moved to the schema properties
+		schema = proto.Clone(schemaBackup).(*schemapb.CollectionSchema)
+		for idx := range schema.Fields {
+			if schema.Fields[idx].IsPrimaryKey {
+				schema.Fields[idx].TypeParams = append(schema.Fields[idx].TypeParams, &commonpb.KeyValuePair{
+					Key:   common.CollectionTTLParam,
+					Value: "3600",
+				})
+			}
+		}
+		ttlSchema, err := proto.Marshal(schema)
+		assert.NoError(t, err)
+		task.CreateCollectionRequest.Schema = ttlSchema
+		err = task.PreExecute(ctx)
+		assert.NoError(t, err)
+		movedSchema := &schemapb.CollectionSchema{}
+		assert.NoError(t, proto.Unmarshal(task.CreateCollectionRequest.Schema, movedSchema))
+		assert.Equal(t, map[string]string{common.CollectionTTLParam: "3600"}, typeutil.GetCollectionProperties(movedSchema))
+
 		schema = proto.Clone(schemaBackup).(*schemapb.CollectionSchema)
 		schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
 			FieldID:      0,
@@ -1153,7 +1188,7 @@ func TestDropCollectionTask(t *testing.T) {
 	assert.NotNil(t, err)
 	// createCollection in RootCood and fill GlobalMetaCache
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, "", collectionName)
 
 	// success to drop collection
 	err = task.Execute(ctx)
@@ -1231,7 +1266,7 @@ func TestHasCollectionTask(t *testing.T) {
 	assert.Equal(t, false, task.result.Value)
 	// createCollection in RootCood and fill GlobalMetaCache
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, "", collectionName)
 
 	// success to drop collection
 	err = task.Execute(ctx)
@@ -1344,7 +1379,7 @@ func TestDescribeCollectionTask_ShardsNum1(t *testing.T) {
 	}
 
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, "", collectionName)
 
 	//CreateCollection
 	task := &describeCollectionTask{
@@ -1403,7 +1438,7 @@ func TestDescribeCollectionTask_ShardsNum2(t *testing.T) {
 	}
 
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, "", collectionName)
 
 	//CreateCollection
 	task := &describeCollectionTask{
@@ -1440,6 +1475,7 @@ func TestCreatePartitionTask(t *testing.T) {
 	rc.Start()
 	defer rc.Stop()
 	ctx := context.Background()
+	assert.NoError(t, InitMetaCache(rc))
 	prefix := "TestCreatePartitionTask"
 	dbName := ""
 	collectionName := prefix + funcutil.GenRandomStr()
@@ -1487,6 +1523,7 @@ func TestDropPartitionTask(t *testing.T) {
 	rc.Start()
 	defer rc.Stop()
 	ctx := context.Background()
+	assert.NoError(t, InitMetaCache(rc))
 	prefix := "TestDropPartitionTask"
 	dbName := ""
 	collectionName := prefix + funcutil.GenRandomStr()
@@ -1701,7 +1738,7 @@ func TestTask_Int64PrimaryKey(t *testing.T) {
 		})
 	})
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 	assert.NoError(t, err)
 
 	dmlChannelsFunc := getDmlChannelsFunc(ctx, rc)
@@ -1881,6 +1918,115 @@ func TestTask_Int64PrimaryKey(t *testing.T) {
 		}
 		assert.Error(t, task2.PreExecute(ctx))
 	})
+
+	t.Run("upsert", func(t *testing.T) {
+		task := &upsertTask{
+			insertTask: &insertTask{
+				BaseInsertTask: BaseInsertTask{
+					BaseMsg: msgstream.BaseMsg{
+						HashValues: generateHashKeys(nb),
+					},
+					InsertRequest: internalpb.InsertRequest{
+						Base: &commonpb.MsgBase{
+							MsgType:  commonpb.MsgType_Insert,
+							SourceID: Params.ProxyCfg.GetNodeID(),
+						},
+						DbName:         dbName,
+						CollectionName: collectionName,
+						PartitionName:  partitionName,
+						NumRows:        uint64(nb),
+						Version:        internalpb.InsertDataVersion_ColumnBased,
+					},
+				},
+				Condition:      NewTaskCondition(ctx),
+				ctx:            ctx,
+				rowIDAllocator: idAllocator,
+				segIDAssigner:  segAllocator,
+				chMgr:          chMgr,
+				chTicker:       ticker,
+			},
+			tsoAllocator: tso,
+		}
+		for fieldName, dataType := range fieldName2Types {
+			task.FieldsData = append(task.FieldsData, generateFieldData(dataType, fieldName, nb))
+		}
+		assert.Equal(t, UpsertTaskName, task.Name())
+
+		assert.NoError(t, task.OnEnqueue())
+		ts, err := tso.AllocOne()
+		assert.NoError(t, err)
+		task.SetTs(ts)
+		assert.Equal(t, ts, task.BeginTs())
+
+		// the rows are deleted before they are inserted, and the time ticks are held below the deletion
+		assert.Less(t, task.deleteTs, ts)
+		stats, err := task.getPChanStats()
+		assert.NoError(t, err)
+		assert.NotEmpty(t, stats)
+		for _, stat := range stats {
+			assert.Equal(t, task.deleteTs, stat.minTs)
+			assert.Equal(t, ts, stat.maxTs)
+		}
+
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.Equal(t, ts, task.BeginTs())
+		assert.Equal(t, ts, task.EndTs())
+		assert.Equal(t, ts, task.result.Timestamp)
+		assert.Equal(t, int64(nb), task.result.UpsertCnt)
+
+		channels, err := chMgr.getVChannels(collectionID)
+		assert.NoError(t, err)
+		numRows := int64(0)
+		for _, msg := range task.deleteMsgs(ctx, collectionID, channels) {
+			deleteMsg := msg.(*msgstream.DeleteMsg)
+			assert.Equal(t, common.InvalidPartitionID, deleteMsg.PartitionID)
+			for _, deleteTs := range deleteMsg.Timestamps {
+				assert.Equal(t, task.deleteTs, deleteTs)
+			}
+			numRows += deleteMsg.NumRows
+		}
+		assert.Equal(t, int64(nb), numRows)
+
+		insertMsgPack, err := task.assignSegmentID(channels)
+		assert.NoError(t, err)
+		assert.Equal(t, ts, insertMsgPack.BeginTs)
+		assert.Equal(t, ts, insertMsgPack.EndTs)
+		for _, msg := range insertMsgPack.Msgs {
+			insertMsg := msg.(*msgstream.InsertMsg)
+			for _, rowTs := range insertMsg.Timestamps {
+				assert.Equal(t, ts, rowTs)
+			}
+		}
+
+		stream, err := chMgr.getDMLStream(collectionID)
+		assert.NoError(t, err)
+		mockStream := stream.(*simpleMockMsgStream)
+		for len(mockStream.msgChan) > 0 {
+			<-mockStream.msgChan
+		}
+
+		// nothing is produced if the insertion fails, so the old rows are kept
+		task.PartitionName = "not_exist_partition"
+		assert.Error(t, task.Execute(ctx))
+		assert.Equal(t, 0, len(mockStream.msgChan))
+
+		// the deletion is produced after the insertion in the same msg pack
+		task.PartitionName = partitionName
+		assert.NoError(t, task.Execute(ctx))
+		assert.NoError(t, task.PostExecute(ctx))
+		assert.Equal(t, 1, len(mockStream.msgChan))
+		msgPack := <-mockStream.msgChan
+		deleted := false
+		for _, msg := range msgPack.Msgs {
+			if msg.Type() == commonpb.MsgType_Delete {
+				deleted = true
+				continue
+			}
+			assert.Equal(t, commonpb.MsgType_Insert, msg.Type())
+			assert.False(t, deleted)
+		}
+		assert.True(t, deleted)
+	})
 }
 
 func TestTask_VarCharPrimaryKey(t *testing.T) {
@@ -1955,7 +2101,7 @@ func TestTask_VarCharPrimaryKey(t *testing.T) {
 		})
 	})
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 	assert.NoError(t, err)
 
 	dmlChannelsFunc := getDmlChannelsFunc(ctx, rc)
@@ -2262,6 +2408,226 @@ func TestAlterAlias_all(t *testing.T) {
 	assert.NoError(t, task.PostExecute(ctx))
 }
 
+func TestRenameCollection_all(t *testing.T) {
+	Params.Init()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	prefix := "TestRenameCollection_all"
+	collectionName := prefix + funcutil.GenRandomStr()
+	task := &RenameCollectionTask{
+		Condition: NewTaskCondition(ctx),
+		RenameCollectionRequest: &milvuspb.RenameCollectionRequest{
+			Base:    nil,
+			OldName: collectionName,
+			NewName: collectionName + "_new",
+		},
+		ctx: ctx,
+		result: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		rootCoord: rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+
+	assert.NotNil(t, task.TraceCtx())
+
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+
+	task.Base.MsgType = commonpb.MsgType_RenameCollection
+	assert.Equal(t, commonpb.MsgType_RenameCollection, task.Type())
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+
+	task.NewName = "$invalid"
+	assert.Error(t, task.PreExecute(ctx))
+}
+
+func TestAddCollectionField_all(t *testing.T) {
+	Params.Init()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	prefix := "TestAddCollectionField_all"
+	collectionName := prefix + funcutil.GenRandomStr()
+	task := &AddCollectionFieldTask{
+		Condition: NewTaskCondition(ctx),
+		AddCollectionFieldRequest: &milvuspb.AddCollectionFieldRequest{
+			Base:           nil,
+			CollectionName: collectionName,
+			Field: &schemapb.FieldSchema{
+				Name:       "age",
+				DataType:   schemapb.DataType_Int64,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueParam, Value: "0"}},
+			},
+		},
+		ctx: ctx,
+		result: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		rootCoord: rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+
+	assert.NotNil(t, task.TraceCtx())
+
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+
+	task.Base.MsgType = commonpb.MsgType_AddCollectionField
+	assert.Equal(t, commonpb.MsgType_AddCollectionField, task.Type())
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+
+	task.Field.Name = "$invalid"
+	assert.Error(t, task.PreExecute(ctx))
+	task.Field = nil
+	assert.Error(t, task.PreExecute(ctx))
+}
+
+func TestAlterCollection_all(t *testing.T) {
+	Params.Init()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	prefix := "TestAlterCollection_all"
+	collectionName := prefix + funcutil.GenRandomStr()
+	task := &AlterCollectionTask{
+		Condition: NewTaskCondition(ctx),
+		AlterCollectionRequest: &milvuspb.AlterCollectionRequest{
+			Base:           nil,
+			CollectionName: collectionName,
+			Properties:     []*commonpb.KeyValuePair{{Key: common.CollectionTTLParam, Value: "3600"}},
+		},
+		ctx: ctx,
+		result: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		rootCoord: rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+
+	assert.NotNil(t, task.TraceCtx())
+
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+
+	task.Base.MsgType = commonpb.MsgType_AlterCollection
+	assert.Equal(t, commonpb.MsgType_AlterCollection, task.Type())
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+
+	task.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionTTLParam, Value: "1h"}}
+	assert.Error(t, task.PreExecute(ctx))
+	task.Properties = []*commonpb.KeyValuePair{{Key: common.BloomFilterFPRParam, Value: "0.1"}}
+	assert.Error(t, task.PreExecute(ctx))
+	task.Properties = nil
+	assert.Error(t, task.PreExecute(ctx))
+}
+
+func TestDatabaseTasks_all(t *testing.T) {
+	Params.Init()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+
+	create := &CreateDatabaseTask{
+		Condition:             NewTaskCondition(ctx),
+		CreateDatabaseRequest: &milvuspb.CreateDatabaseRequest{DbName: "db1"},
+		ctx:                   ctx,
+		rootCoord:             rc,
+	}
+	assert.NoError(t, create.OnEnqueue())
+	assert.NotNil(t, create.TraceCtx())
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	create.SetID(id)
+	assert.Equal(t, id, create.ID())
+	ts := Timestamp(time.Now().UnixNano())
+	create.SetTs(ts)
+	assert.Equal(t, ts, create.BeginTs())
+	assert.Equal(t, ts, create.EndTs())
+	assert.NoError(t, create.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_CreateDatabase, create.Type())
+	assert.NoError(t, create.Execute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, create.result.GetErrorCode())
+	assert.NoError(t, create.PostExecute(ctx))
+	create.DbName = common.DefaultDatabase
+	assert.Error(t, create.PreExecute(ctx))
+
+	alter := &AlterDatabaseTask{
+		Condition: NewTaskCondition(ctx),
+		AlterDatabaseRequest: &milvuspb.AlterDatabaseRequest{
+			DbName:     "db1",
+			Properties: []*commonpb.KeyValuePair{{Key: common.DatabaseSearchQPSParam, Value: "10"}},
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+	assert.NoError(t, alter.OnEnqueue())
+	assert.NoError(t, alter.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_AlterDatabase, alter.Type())
+	assert.NoError(t, alter.Execute(ctx))
+	assert.NoError(t, alter.PostExecute(ctx))
+	alter.Properties = nil
+	assert.Error(t, alter.PreExecute(ctx))
+
+	list := &ListDatabasesTask{
+		Condition:            NewTaskCondition(ctx),
+		ListDatabasesRequest: &milvuspb.ListDatabasesRequest{},
+		ctx:                  ctx,
+		rootCoord:            rc,
+	}
+	assert.NoError(t, list.OnEnqueue())
+	assert.NoError(t, list.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_ListDatabases, list.Type())
+	assert.NoError(t, list.Execute(ctx))
+	assert.Contains(t, list.result.GetDbNames(), common.DefaultDatabase)
+	assert.NoError(t, list.PostExecute(ctx))
+
+	drop := &DropDatabaseTask{
+		Condition:           NewTaskCondition(ctx),
+		DropDatabaseRequest: &milvuspb.DropDatabaseRequest{DbName: "db1"},
+		ctx:                 ctx,
+		rootCoord:           rc,
+	}
+	assert.NoError(t, drop.OnEnqueue())
+	assert.NoError(t, drop.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_DropDatabase, drop.Type())
+	assert.NoError(t, drop.Execute(ctx))
+	assert.NoError(t, drop.PostExecute(ctx))
+	drop.DbName = ""
+	assert.Error(t, drop.PreExecute(ctx))
+}
+
 func Test_createIndexTask_getIndexedField(t *testing.T) {
 	collectionName := "test"
 	fieldName := "test"
@@ -2418,6 +2784,35 @@ func Test_checkTrain(t *testing.T) {
 			"index_type": "scalar",
 		}
 		assert.NoError(t, checkTrain(f, m))
+
+		m["index_type"] = "STL_SORT"
+		assert.NoError(t, checkTrain(f, m))
+
+		m["index_type"] = "INVERTED"
+		assert.Error(t, checkTrain(f, m))
+
+		f = &schemapb.FieldSchema{
+			DataType: schemapb.DataType_VarChar,
+		}
+		assert.NoError(t, checkTrain(f, m))
+
+		f.TypeParams = []*commonpb.KeyValuePair{{Key: common.JSONFieldParam, Value: "true"}}
+		assert.Error(t, checkTrain(f, m))
+	})
+
+	t.Run("default scalar index type", func(t *testing.T) {
+		f := &schemapb.FieldSchema{
+			DataType: schemapb.DataType_VarChar,
+		}
+		m, err := parseIndexParams(f, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "INVERTED", m["index_type"])
+		assert.NoError(t, checkTrain(f, m))
+
+		f.DataType = schemapb.DataType_FloatVector
+		m, err = parseIndexParams(f, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "IVF_PQ", m["index_type"])
 	})
 
 	t.Run("dimension mismatch", func(t *testing.T) {
@@ -2514,6 +2909,39 @@ func Test_createIndexTask_PreExecute(t *testing.T) {
 		assert.NoError(t, cit.PreExecute(context.Background()))
 	})
 
+	t.Run("auto index", func(t *testing.T) {
+		cit.dataCoord = NewDataCoordMock()
+		cit.CreateIndexRequest.ExtraParams = []*commonpb.KeyValuePair{
+			{
+				Key:   "index_type",
+				Value: "AUTOINDEX",
+			},
+			{
+				Key:   "metric_type",
+				Value: "IP",
+			},
+		}
+		assert.NoError(t, cit.PreExecute(context.Background()))
+		indexType, err := funcutil.GetAttrByKeyFromRepeatedKV("index_type", cit.GetExtraParams())
+		assert.NoError(t, err)
+		assert.Equal(t, "HNSW", indexType)
+		metricType, err := funcutil.GetAttrByKeyFromRepeatedKV("metric_type", cit.GetExtraParams())
+		assert.NoError(t, err)
+		assert.Equal(t, "IP", metricType)
+
+		cit.CreateIndexRequest.ExtraParams = []*commonpb.KeyValuePair{
+			{
+				Key:   "index_type",
+				Value: "AUTOINDEX",
+			},
+			{
+				Key:   "metric_type",
+				Value: "HAMMING",
+			},
+		}
+		assert.Error(t, cit.PreExecute(context.Background()))
+	})
+
 	t.Run("collection not found", func(t *testing.T) {
 		cache := newMockCache()
 		cache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
@@ -0,0 +1,156 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// upsertTask inserts the rows, and deletes the rows with their primary keys from the whole collection. The deletion
+// takes a timestamp allocated on enqueue, the insertion takes the later one assigned by the dml queue, so only the
+// old rows are deleted. As the task holds the time tick of its channels below the timestamp of the deletion until
+// both of them are produced, the readers observe either the old rows or the new ones, but never the rows missing.
+type upsertTask struct {
+	*insertTask
+	tsoAllocator tsoAllocator
+
+	deleteTs Timestamp
+}
+
+func (ut *upsertTask) Name() string {
+	return UpsertTaskName
+}
+
+// OnEnqueue allocates the timestamp of the deletion, which is before the one assigned to the task by the dml queue
+func (ut *upsertTask) OnEnqueue() error {
+	ts, err := ut.tsoAllocator.AllocOne()
+	if err != nil {
+		return err
+	}
+	ut.deleteTs = ts
+	return ut.insertTask.OnEnqueue()
+}
+
+// getPChanStats holds the time ticks of the channels below the timestamp of the deletion
+func (ut *upsertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
+	stats, err := ut.insertTask.getPChanStats()
+	if err != nil {
+		return stats, err
+	}
+	for channel, stat := range stats {
+		stat.minTs = ut.deleteTs
+		stats[channel] = stat
+	}
+	return stats, nil
+}
+
+func (ut *upsertTask) PreExecute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ut.ctx, "Proxy-Upsert-PreExecute")
+	defer sp.Finish()
+
+	collectionName := ut.CollectionName
+	if err := validateCollectionName(collectionName); err != nil {
+		log.Error("valid collection name failed", zap.String("collection name", collectionName), zap.Error(err))
+		return err
+	}
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, ut.GetDbName(), collectionName)
+	if err != nil {
+		log.Error("get collection schema from global meta cache failed", zap.String("collection name", collectionName), zap.Error(err))
+		return err
+	}
+	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(collSchema)
+	if err != nil {
+		return err
+	}
+	// the rows to replace couldn't be identified if the primary keys are generated
+	if primaryFieldSchema.AutoID {
+		return fmt.Errorf("upsert is not supported by collection %s, the primary field %s is auto id",
+			collectionName, primaryFieldSchema.Name)
+	}
+
+	if err := ut.insertTask.PreExecute(ctx); err != nil {
+		return err
+	}
+	ut.result.UpsertCnt = int64(ut.NRows())
+
+	log.Debug("Proxy Upsert PreExecute done", zap.Int64("msgID", ut.Base.MsgID), zap.String("collection name", collectionName),
+		zap.Uint64("deleteTs", ut.deleteTs), zap.Uint64("insertTs", ut.BeginTs()))
+	return nil
+}
+
+// deleteMsgs repacks the deletion of the primary keys of the inserted rows by the dml channels
+func (ut *upsertTask) deleteMsgs(ctx context.Context, collID UniqueID, channelNames []string) []msgstream.TsMsg {
+	primaryKeys := ut.result.IDs
+	hashValues := typeutil.HashPK2Channels(primaryKeys, channelNames)
+
+	result := make(map[uint32]*msgstream.DeleteMsg)
+	var msgs []msgstream.TsMsg
+	for index, key := range hashValues {
+		deleteMsg, ok := result[key]
+		if !ok {
+			deleteMsg = &msgstream.DeleteMsg{
+				BaseMsg: msgstream.BaseMsg{
+					Ctx: ctx,
+				},
+				DeleteRequest: internalpb.DeleteRequest{
+					Base: &commonpb.MsgBase{
+						MsgType:   commonpb.MsgType_Delete,
+						MsgID:     ut.Base.MsgID,
+						Timestamp: ut.deleteTs,
+						SourceID:  Params.ProxyCfg.GetNodeID(),
+					},
+					CollectionID:   collID,
+					PartitionID:    common.InvalidPartitionID,
+					CollectionName: ut.CollectionName,
+					PrimaryKeys:    &schemapb.IDs{},
+				},
+			}
+			result[key] = deleteMsg
+			msgs = append(msgs, deleteMsg)
+		}
+		deleteMsg.HashValues = append(deleteMsg.HashValues, key)
+		deleteMsg.Timestamps = append(deleteMsg.Timestamps, ut.deleteTs)
+		typeutil.AppendIDs(deleteMsg.PrimaryKeys, primaryKeys, index)
+		deleteMsg.NumRows++
+	}
+	return msgs
+}
+
+// Execute produces the deletion after the insertion in the same msg pack, the deletion is not produced if the
+// insertion fails, so the old rows are kept then. The messages of a row share the dml channel of its primary key,
+// on which the insert message is always produced before the delete message.
+func (ut *upsertTask) Execute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ut.ctx, "Proxy-Upsert-Execute")
+	defer sp.Finish()
+
+	return ut.insertTask.execute(ctx, metrics.UpsertLabel, func(collID UniqueID, channelNames []string) []msgstream.TsMsg {
+		return ut.deleteMsgs(ctx, collID, channelNames)
+	})
+}
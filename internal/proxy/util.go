@@ -21,9 +21,14 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 )
 
 // enableMultipleVectorFields indicates whether to enable multiple vector fields.
@@ -184,8 +189,9 @@ func validateDimension(field *schemapb.FieldSchema) error {
 func validateMaxLengthPerRow(collectionName string, field *schemapb.FieldSchema) error {
 	exist := false
 	for _, param := range field.TypeParams {
+		// the other type params of VarChar fields, e.g. the dynamic field, are validated by their own
 		if param.Key != maxVarCharLengthKey {
-			return fmt.Errorf("type param key(max_length_per_row) should be specified for varChar field, not %s", param.Key)
+			continue
 		}
 
 		maxLengthPerRow, err := strconv.ParseInt(param.Value, 10, 64)
@@ -288,6 +294,24 @@ func validatePrimaryKey(coll *schemapb.CollectionSchema) error {
 	return nil
 }
 
+// validateBloomFilterParams checks the bloom filter parameters, which are allowed on the primary key field only
+func validateBloomFilterParams(coll *schemapb.CollectionSchema) error {
+	for _, field := range coll.Fields {
+		if field.IsPrimaryKey {
+			if _, err := storage.GetBloomFilterParams(field); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, key := range []string{common.BloomFilterCardinalityParam, common.BloomFilterFPRParam} {
+			if _, err := funcutil.GetAttrByKeyFromRepeatedKV(key, field.GetTypeParams()); err == nil {
+				return fmt.Errorf("%s is only allowed on the primary key, field name = %s", key, field.Name)
+			}
+		}
+	}
+	return nil
+}
+
 // RepeatedKeyValToMap transfer the kv pairs to map.
 func RepeatedKeyValToMap(kvPairs []*commonpb.KeyValuePair) (map[string]string, error) {
 	resMap := make(map[string]string)
@@ -559,3 +583,34 @@ func ValidatePassword(password string) error {
 func ReplaceID2Name(oldStr string, id int64, name string) string {
 	return strings.ReplaceAll(oldStr, strconv.FormatInt(id, 10), name)
 }
+
+// getTravelTimestamp returns the timestamp the entities are read at, which is the begin timestamp of the request if
+// travelTs is not specified. The inserts and the deletes after it are invisible to the request. It should be within
+// the retention duration, as the older history may have been compacted.
+func getTravelTimestamp(travelTs, beginTs Timestamp) (Timestamp, error) {
+	if travelTs == 0 {
+		return beginTs, nil
+	}
+	if travelTs > beginTs {
+		return 0, fmt.Errorf("travel timestamp %d is later than the current timestamp %d", travelTs, beginTs)
+	}
+	durationSeconds := tsoutil.CalculateDuration(beginTs, travelTs) / 1000
+	if durationSeconds > Params.CommonCfg.RetentionDuration {
+		retention := time.Second * time.Duration(Params.CommonCfg.RetentionDuration)
+		return 0, fmt.Errorf("only support to travel back to %s so far", retention.String())
+	}
+	return travelTs, nil
+}
+
+// getGuaranteeTimestamp returns the timestamp the querynodes wait to serve until, which is the begin timestamp of the
+// request if guaranteeTs is not specified. It's no earlier than the travel timestamp, so that all the entities
+// before it are visible.
+func getGuaranteeTimestamp(guaranteeTs, travelTs, beginTs Timestamp) Timestamp {
+	if guaranteeTs == 0 {
+		guaranteeTs = beginTs
+	}
+	if guaranteeTs < travelTs {
+		return travelTs
+	}
+	return guaranteeTs
+}
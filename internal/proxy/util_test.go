@@ -19,9 +19,12 @@ package proxy
 import (
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -29,6 +32,7 @@ func TestValidateCollectionName(t *testing.T) {
 	assert.Nil(t, validateCollectionName("abc"))
 	assert.Nil(t, validateCollectionName("_123abc"))
 	assert.Nil(t, validateCollectionName("abc123_$"))
+	assert.Nil(t, validateCollectionName("db1.abc"))
 
 	longName := make([]byte, 256)
 	for i := 0; i < len(longName); i++ {
@@ -42,6 +46,10 @@ func TestValidateCollectionName(t *testing.T) {
 		"",
 		string(longName),
 		"中文",
+		"default.abc",
+		"1db.abc",
+		"db1.",
+		"db1.abc.def",
 	}
 
 	for _, name := range invalidNames {
@@ -267,6 +275,31 @@ func TestValidatePrimaryKey(t *testing.T) {
 	}))
 }
 
+func TestValidateBloomFilterParams(t *testing.T) {
+	pkField := &schemapb.FieldSchema{
+		Name:         "pk",
+		IsPrimaryKey: true,
+		DataType:     schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: common.BloomFilterCardinalityParam, Value: "1000000"},
+			{Key: common.BloomFilterFPRParam, Value: "0.001"},
+		},
+	}
+	floatField := &schemapb.FieldSchema{
+		Name:     "float",
+		DataType: schemapb.DataType_Float,
+	}
+	schema := &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{pkField, floatField}}
+	assert.NoError(t, validateBloomFilterParams(schema))
+
+	pkField.TypeParams[1].Value = "1.5"
+	assert.Error(t, validateBloomFilterParams(schema))
+	pkField.TypeParams[1].Value = "0.001"
+
+	floatField.TypeParams = []*commonpb.KeyValuePair{{Key: common.BloomFilterFPRParam, Value: "0.001"}}
+	assert.Error(t, validateBloomFilterParams(schema))
+}
+
 func TestValidateFieldType(t *testing.T) {
 	type testCase struct {
 		dt       schemapb.DataType
@@ -616,3 +649,34 @@ func TestReplaceID2Name(t *testing.T) {
 	dstStr := "collection default_collection has not been loaded to memory or load failed"
 	assert.Equal(t, dstStr, ReplaceID2Name(srcStr, int64(432682805904801793), "default_collection"))
 }
+
+func TestGetTravelTimestamp(t *testing.T) {
+	Params.Init()
+	now := time.Now()
+	beginTs := tsoutil.ComposeTSByTime(now, 0)
+
+	ts, err := getTravelTimestamp(0, beginTs)
+	assert.NoError(t, err)
+	assert.Equal(t, beginTs, ts)
+
+	travelTs := tsoutil.ComposeTSByTime(now.Add(-time.Hour), 0)
+	ts, err = getTravelTimestamp(travelTs, beginTs)
+	assert.NoError(t, err)
+	assert.Equal(t, travelTs, ts)
+
+	// out of the retention duration
+	retention := time.Duration(Params.CommonCfg.RetentionDuration) * time.Second
+	_, err = getTravelTimestamp(tsoutil.ComposeTSByTime(now.Add(-retention-time.Hour), 0), beginTs)
+	assert.Error(t, err)
+
+	// in the future
+	_, err = getTravelTimestamp(tsoutil.ComposeTSByTime(now.Add(time.Hour), 0), beginTs)
+	assert.Error(t, err)
+}
+
+func TestGetGuaranteeTimestamp(t *testing.T) {
+	assert.Equal(t, Timestamp(100), getGuaranteeTimestamp(0, 50, 100))
+	assert.Equal(t, Timestamp(80), getGuaranteeTimestamp(80, 50, 100))
+	// eventually consistent, but the entities before the travel timestamp must be visible
+	assert.Equal(t, Timestamp(50), getGuaranteeTimestamp(1, 50, 100))
+}
@@ -0,0 +1,120 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+// memoryBalanceReason is the reason of the moves planned by the memory usage rate of the nodes
+const memoryBalanceReason = "memory"
+
+// newBalanceTask returns the task migrating the segment or the dml channel of the move
+func (qc *QueryCoord) newBalanceTask(move *milvuspb.BalanceMove) (*loadBalanceTask, error) {
+	req := &querypb.LoadBalanceRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_LoadBalanceSegments,
+		},
+		BalanceReason: querypb.TriggerCondition_LoadBalance,
+		SourceNodeIDs: []UniqueID{move.SourceNodeID},
+		DstNodeIDs:    []UniqueID{move.DstNodeID},
+	}
+	balanceTask := &loadBalanceTask{
+		baseTask:           newBaseTask(qc.loopCtx, querypb.TriggerCondition_LoadBalance),
+		LoadBalanceRequest: req,
+		broker:             qc.broker,
+		cluster:            qc.cluster,
+		meta:               qc.meta,
+	}
+	if move.Channel == "" {
+		req.SealedSegmentIDs = []UniqueID{move.SegmentID}
+		return balanceTask, nil
+	}
+
+	msgID, err := qc.idAllocator()
+	if err != nil {
+		return nil, err
+	}
+	req.Base.MsgID = msgID
+	req.CollectionID = move.CollectionID
+	balanceTask.dmChannels = []string{move.Channel}
+	if err := qc.meta.saveBalanceChannels(msgID, balanceTask.dmChannels); err != nil {
+		return nil, err
+	}
+	return balanceTask, nil
+}
+
+// executeBalanceMoves migrates the segments and dml channels of the moves one by one
+func (qc *QueryCoord) executeBalanceMoves(moves []*milvuspb.BalanceMove) {
+	for _, move := range moves {
+		t, err := qc.newBalanceTask(move)
+		if err == nil {
+			err = qc.scheduler.Enqueue(t)
+		}
+		if err != nil {
+			log.Warn("balance task enqueue failed", zap.Any("move", move), zap.Error(err))
+			continue
+		}
+		if err := t.waitToFinish(); err != nil {
+			// if failed, wait for next balance round
+			// it may be that the collection/partition of the balanced segment has been released or handed off
+			log.Warn("balance task execute failed", zap.Any("move", move), zap.Error(err))
+		} else {
+			log.Info("balance task execute success", zap.Any("move", move))
+		}
+	}
+}
+
+// planBalance plans a balance round over all the loaded collections, by the memory usage rate of the nodes first,
+// and then by the skew of the segment bytes and QPS within the replicas, and by the dml channels watched by the nodes.
+// A segment is moved at most once in a round.
+func (qc *QueryCoord) planBalance(ctx context.Context) []*milvuspb.BalanceMove {
+	moves := qc.planMemoryBalance(ctx, qc.meta.showCollections())
+	planned := make(map[UniqueID]struct{}, len(moves))
+	for _, move := range moves {
+		planned[move.SegmentID] = struct{}{}
+	}
+	for _, move := range qc.planSkewBalanceMoves(ctx) {
+		if _, ok := planned[move.SegmentID]; ok {
+			continue
+		}
+		moves = append(moves, move)
+	}
+	return append(moves, qc.planChannelBalanceMoves()...)
+}
+
+// balance triggers a balance round on demand and returns the planned moves,
+// the moves are executed in the background unless it's a dry run
+func (qc *QueryCoord) balance(ctx context.Context, dryRun bool) []*milvuspb.BalanceMove {
+	moves := qc.planBalance(ctx)
+	log.Info("manual balance planned", zap.Bool("dryRun", dryRun), zap.Int("moves", len(moves)))
+	if !dryRun && len(moves) > 0 {
+		qc.loopWg.Add(1)
+		go func() {
+			defer qc.loopWg.Done()
+			qc.executeBalanceMoves(moves)
+		}()
+	}
+	return moves
+}
@@ -0,0 +1,285 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+// channelBalanceReason is the reason of the moves planned by the number of dml channels watched by the nodes
+const channelBalanceReason = "dml channels"
+
+// balanceChannelsPrefix records the dml channels migrated by the load balance tasks, the key is {prefix}/{msgID}.
+// LoadBalanceRequest carries no channel, so the channels are recovered by the msg id when the task is reloaded.
+const balanceChannelsPrefix = "queryCoord-balanceChannels"
+
+func balanceChannelsKey(msgID UniqueID) string {
+	return fmt.Sprintf("%s/%d", balanceChannelsPrefix, msgID)
+}
+
+func saveBalanceChannels(kv kv.MetaKv, msgID UniqueID, channels []string) error {
+	value, err := json.Marshal(channels)
+	if err != nil {
+		return err
+	}
+	return kv.Save(balanceChannelsKey(msgID), string(value))
+}
+
+// loadBalanceChannels returns the dml channels migrated by the load balance task, nil if it migrates segments
+func loadBalanceChannels(kv kv.MetaKv, msgID UniqueID) ([]string, error) {
+	_, values, err := kv.LoadWithPrefix(balanceChannelsKey(msgID))
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	var channels []string
+	if err := json.Unmarshal([]byte(values[0]), &channels); err != nil {
+		return nil, err
+	}
+	return channels, nil
+}
+
+func removeBalanceChannels(kv kv.MetaKv, msgID UniqueID) error {
+	return kv.Remove(balanceChannelsKey(msgID))
+}
+
+// channelBalancePlan migrates a dml channel from the node watching the most dml channels to the one watching the fewest
+type channelBalancePlan struct {
+	sourceNodeID int64
+	dstNodeID    int64
+	channel      string
+}
+
+// channelBalanceLoop migrates the dml channels between the nodes of a replica, since the streaming CPU
+// concentrates on the nodes watching many dml channels while the segment balance doesn't take channels into account.
+func (qc *QueryCoord) channelBalanceLoop() {
+	ctx, cancel := context.WithCancel(qc.loopCtx)
+	defer cancel()
+	defer qc.loopWg.Done()
+	log.Info("QueryCoord start channel balance loop")
+
+	ticker := time.NewTicker(Params.QueryCoordCfg.ChannelBalanceInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			qc.executeBalanceMoves(qc.planChannelBalanceMoves())
+		}
+	}
+}
+
+// planChannelBalanceMoves plans at most one dml channel move per replica, the moves are not executed.
+// The nodes are compared by the dml channels of all collections they watch.
+func (qc *QueryCoord) planChannelBalanceMoves() []*milvuspb.BalanceMove {
+	nodeChannelNum := make(map[int64]int)
+	for _, nodeID := range qc.cluster.onlineNodeIDs() {
+		if qc.cluster.isCordoned(nodeID) {
+			continue
+		}
+		nodeChannelNum[nodeID] = len(qc.meta.getDmChannelInfosByNodeID(nodeID))
+	}
+
+	moves := make([]*milvuspb.BalanceMove, 0)
+	for _, info := range qc.meta.showCollections() {
+		replicas, err := qc.meta.getReplicasByCollectionID(info.GetCollectionID())
+		if err != nil {
+			log.Warn("channelBalance: unable to get replicas of collection", zap.Int64("collectionID", info.GetCollectionID()), zap.Error(err))
+			continue
+		}
+		for _, replica := range replicas {
+			replicaChannels := make(map[int64][]string)
+			for _, nodeID := range replica.GetNodeIds() {
+				if _, ok := nodeChannelNum[nodeID]; !ok {
+					continue
+				}
+				replicaChannels[nodeID] = []string{}
+				for _, channelInfo := range qc.meta.getDmChannelInfosByNodeID(nodeID) {
+					if channelInfo.GetCollectionID() == replica.GetCollectionID() {
+						replicaChannels[nodeID] = append(replicaChannels[nodeID], channelInfo.GetDmChannel())
+					}
+				}
+			}
+			plan := planChannelBalance(nodeChannelNum, replicaChannels)
+			if plan == nil {
+				continue
+			}
+			log.Info("channelBalance: plan a dml channel move",
+				zap.Int64("collection", replica.GetCollectionID()), zap.Int64("replica", replica.GetReplicaID()),
+				zap.String("channel", plan.channel), zap.Int64("sourceNodeID", plan.sourceNodeID), zap.Int64("dstNodeID", plan.dstNodeID),
+				zap.Int("sourceChannelNum", nodeChannelNum[plan.sourceNodeID]), zap.Int("dstChannelNum", nodeChannelNum[plan.dstNodeID]))
+			moves = append(moves, &milvuspb.BalanceMove{
+				CollectionID: replica.GetCollectionID(),
+				ReplicaID:    replica.GetReplicaID(),
+				Channel:      plan.channel,
+				SourceNodeID: plan.sourceNodeID,
+				DstNodeID:    plan.dstNodeID,
+				Reason:       channelBalanceReason,
+			})
+			nodeChannelNum[plan.sourceNodeID]--
+			nodeChannelNum[plan.dstNodeID]++
+		}
+	}
+	return moves
+}
+
+// planChannelBalance returns the dml channel to migrate within a replica, replicaChannels are the channels of the replica
+// watched by its nodes. The channel is moved from the node watching the most dml channels in total among the ones
+// watching any channel of the replica, to the node watching the fewest, if they differ by more than one.
+func planChannelBalance(nodeChannelNum map[int64]int, replicaChannels map[int64][]string) *channelBalancePlan {
+	nodeIDs := make([]int64, 0, len(replicaChannels))
+	for nodeID := range replicaChannels {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	if len(nodeIDs) <= 1 {
+		return nil
+	}
+	sort.Slice(nodeIDs, func(i, j int) bool { return nodeIDs[i] < nodeIDs[j] })
+
+	var source, dst int64 = -1, -1
+	for _, nodeID := range nodeIDs {
+		if dst == -1 || nodeChannelNum[nodeID] < nodeChannelNum[dst] {
+			dst = nodeID
+		}
+		if len(replicaChannels[nodeID]) > 0 && (source == -1 || nodeChannelNum[nodeID] > nodeChannelNum[source]) {
+			source = nodeID
+		}
+	}
+	if source == -1 || nodeChannelNum[source]-nodeChannelNum[dst] <= 1 {
+		return nil
+	}
+	channels := append([]string{}, replicaChannels[source]...)
+	sort.Strings(channels)
+	return &channelBalancePlan{sourceNodeID: source, dstNodeID: dst, channel: channels[0]}
+}
+
+// balanceDmChannels lets the dst node watch the dml channels from the checkpoints, the source node keeps serving them
+// until the child tasks are done and the shard leaders are switched, then releases them in globalPostExecute.
+func (lbt *loadBalanceTask) balanceDmChannels(ctx context.Context) error {
+	collectionID := lbt.GetCollectionID()
+	collectionInfo, err := lbt.meta.getCollectionInfoByID(collectionID)
+	if err != nil {
+		log.Error("loadBalanceTask: can't find collectionID in meta", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return err
+	}
+
+	watchedChannels := make(map[string]struct{})
+	for _, nodeID := range lbt.SourceNodeIDs {
+		for _, info := range lbt.meta.getDmChannelInfosByNodeID(nodeID) {
+			watchedChannels[info.GetDmChannel()] = struct{}{}
+		}
+	}
+	for _, channel := range lbt.dmChannels {
+		if _, ok := watchedChannels[channel]; !ok {
+			return fmt.Errorf("loadBalanceTask: unwatched dml channel %s", channel)
+		}
+	}
+
+	var toRecoverPartitionIDs []UniqueID
+	if collectionInfo.LoadType == querypb.LoadType_LoadCollection {
+		toRecoverPartitionIDs, err = lbt.broker.showPartitionIDs(ctx, collectionID)
+		if err != nil {
+			log.Error("loadBalanceTask: show collection's partitionIDs failed", zap.Int64("collectionID", collectionID), zap.Error(err))
+			return err
+		}
+	} else {
+		toRecoverPartitionIDs = collectionInfo.PartitionIDs
+	}
+	var dmChannelInfos []*datapb.VchannelInfo
+	for _, partitionID := range toRecoverPartitionIDs {
+		vChannelInfos, _, err := lbt.broker.getRecoveryInfo(lbt.ctx, collectionID, partitionID)
+		if err != nil {
+			log.Error("loadBalanceTask: getRecoveryInfo failed", zap.Int64("collectionID", collectionID), zap.Int64("partitionID", partitionID), zap.Error(err))
+			return err
+		}
+		dmChannelInfos = append(dmChannelInfos, vChannelInfos...)
+	}
+
+	mergedDmChannel := mergeDmChannelInfo(dmChannelInfos)
+	watchDmChannelReqs := make([]*querypb.WatchDmChannelsRequest, 0, len(lbt.dmChannels))
+	for _, channel := range lbt.dmChannels {
+		vChannelInfo, ok := mergedDmChannel[channel]
+		if !ok {
+			return fmt.Errorf("loadBalanceTask: dml channel %s not found in recovery info of collection %d", channel, collectionID)
+		}
+		msgBase := proto.Clone(lbt.Base).(*commonpb.MsgBase)
+		msgBase.MsgType = commonpb.MsgType_WatchDmChannels
+		watchRequest := &querypb.WatchDmChannelsRequest{
+			Base:         msgBase,
+			CollectionID: collectionID,
+			Infos:        []*datapb.VchannelInfo{vChannelInfo},
+			Schema:       collectionInfo.Schema,
+			LoadMeta: &querypb.LoadMetaInfo{
+				LoadType:     collectionInfo.LoadType,
+				CollectionID: collectionID,
+				PartitionIDs: toRecoverPartitionIDs,
+			},
+			ReplicaID: lbt.replicaID,
+		}
+		if collectionInfo.LoadType == querypb.LoadType_LoadPartition {
+			watchRequest.PartitionIDs = toRecoverPartitionIDs
+		}
+		watchDmChannelReqs = append(watchDmChannelReqs, watchRequest)
+	}
+
+	internalTasks, err := assignInternalTask(ctx, lbt, lbt.meta, lbt.cluster, nil, watchDmChannelReqs, false, lbt.SourceNodeIDs, lbt.DstNodeIDs, lbt.replicaID)
+	if err != nil {
+		log.Error("loadBalanceTask: assign child task failed", zap.Any("balance request", lbt.LoadBalanceRequest), zap.Strings("dmChannels", lbt.dmChannels))
+		return err
+	}
+	for _, internalTask := range internalTasks {
+		lbt.addChildTask(internalTask)
+		log.Info("loadBalanceTask: add a childTask", zap.Int32("task type", int32(internalTask.msgType())), zap.Strings("dmChannels", lbt.dmChannels))
+	}
+	log.Info("loadBalanceTask: assign child task done", zap.Any("balance request", lbt.LoadBalanceRequest), zap.Strings("dmChannels", lbt.dmChannels))
+	return nil
+}
+
+// releaseBalancedDmChannels releases the dml channels on the source nodes after the dst nodes watched them
+func (lbt *loadBalanceTask) releaseBalancedDmChannels(ctx context.Context) error {
+	for _, nodeID := range lbt.SourceNodeIDs {
+		err := lbt.cluster.releaseChannels(ctx, nodeID, lbt.GetCollectionID(), lbt.dmChannels)
+		if err != nil {
+			log.Warn("loadBalanceTask: release dml channels on source node failed",
+				zap.Int64("nodeID", nodeID), zap.Strings("dmChannels", lbt.dmChannels), zap.Error(err))
+			return err
+		}
+		for _, channel := range lbt.dmChannels {
+			if err := lbt.meta.removeDmChannelNode(channel, nodeID); err != nil {
+				return err
+			}
+		}
+	}
+	return lbt.meta.removeBalanceChannels(lbt.GetBase().GetMsgID())
+}
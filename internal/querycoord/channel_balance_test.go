@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanChannelBalance(t *testing.T) {
+	// too few nodes
+	assert.Nil(t, planChannelBalance(map[int64]int{1: 4}, map[int64][]string{1: {"dml_0", "dml_1"}}))
+
+	// balanced
+	assert.Nil(t, planChannelBalance(map[int64]int{1: 2, 2: 1}, map[int64][]string{1: {"dml_0", "dml_1"}, 2: {"dml_2"}}))
+
+	plan := planChannelBalance(map[int64]int{1: 2, 2: 0}, map[int64][]string{1: {"dml_1", "dml_0"}, 2: {}})
+	assert.NotNil(t, plan)
+	assert.Equal(t, int64(1), plan.sourceNodeID)
+	assert.Equal(t, int64(2), plan.dstNodeID)
+	assert.Equal(t, "dml_0", plan.channel)
+
+	// the channels of other collections count, but only the ones of the replica could be moved
+	plan = planChannelBalance(map[int64]int{1: 5, 2: 4, 3: 1}, map[int64][]string{1: {}, 2: {"dml_2"}, 3: {}})
+	assert.NotNil(t, plan)
+	assert.Equal(t, int64(2), plan.sourceNodeID)
+	assert.Equal(t, int64(3), plan.dstNodeID)
+	assert.Equal(t, "dml_2", plan.channel)
+
+	assert.Nil(t, planChannelBalance(map[int64]int{1: 5, 2: 1}, map[int64][]string{1: {}, 2: {"dml_2"}}))
+}
+
+func TestChannelBalance(t *testing.T) {
+	refreshParams()
+	baseCtx := context.Background()
+
+	queryCoord, err := startQueryCoord(baseCtx)
+	assert.Nil(t, err)
+
+	queryNode1, err := startQueryNodeServer(baseCtx)
+	assert.Nil(t, err)
+	waitQueryNodeOnline(queryCoord.cluster, queryNode1.queryNodeID)
+
+	loadCollectionTask := genLoadCollectionTask(baseCtx, queryCoord)
+	err = queryCoord.scheduler.Enqueue(loadCollectionTask)
+	assert.Nil(t, err)
+	waitTaskFinalState(loadCollectionTask, taskExpired)
+
+	queryNode2, err := startQueryNodeServer(baseCtx)
+	assert.Nil(t, err)
+	waitQueryNodeOnline(queryCoord.cluster, queryNode2.queryNodeID)
+	for {
+		replicas, err := queryCoord.meta.getReplicasByNodeID(queryNode2.queryNodeID)
+		assert.Nil(t, err)
+		if len(replicas) > 0 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	sourceChannels := queryCoord.meta.getDmChannelInfosByNodeID(queryNode1.queryNodeID)
+	assert.Less(t, 1, len(sourceChannels))
+	moves := queryCoord.planChannelBalanceMoves()
+	assert.Equal(t, 1, len(moves))
+	assert.Equal(t, defaultCollectionID, moves[0].CollectionID)
+	assert.Equal(t, queryNode1.queryNodeID, moves[0].SourceNodeID)
+	assert.Equal(t, queryNode2.queryNodeID, moves[0].DstNodeID)
+	assert.Equal(t, channelBalanceReason, moves[0].Reason)
+
+	queryCoord.executeBalanceMoves(moves)
+	dstChannels := queryCoord.meta.getDmChannelInfosByNodeID(queryNode2.queryNodeID)
+	assert.Equal(t, 1, len(dstChannels))
+	assert.Equal(t, moves[0].Channel, dstChannels[0].GetDmChannel())
+	assert.Equal(t, len(sourceChannels)-1, len(queryCoord.meta.getDmChannelInfosByNodeID(queryNode1.queryNodeID)))
+
+	// the channels of a load balance task are recorded by msg id
+	err = queryCoord.meta.saveBalanceChannels(1, []string{moves[0].Channel})
+	assert.Nil(t, err)
+	channels, err := queryCoord.meta.getBalanceChannels(1)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{moves[0].Channel}, channels)
+	err = queryCoord.meta.removeBalanceChannels(1)
+	assert.Nil(t, err)
+	channels, err = queryCoord.meta.getBalanceChannels(1)
+	assert.Nil(t, err)
+	assert.Nil(t, channels)
+
+	queryNode1.stop()
+	queryNode2.stop()
+	queryCoord.Stop()
+	err = removeAllSession()
+	assert.Nil(t, err)
+}
@@ -61,6 +61,8 @@ type Cluster interface {
 	removeQueryChannel(ctx context.Context, nodeID int64, in *querypb.RemoveQueryChannelRequest) error
 	releaseCollection(ctx context.Context, nodeID int64, in *querypb.ReleaseCollectionRequest) error
 	releasePartitions(ctx context.Context, nodeID int64, in *querypb.ReleasePartitionsRequest) error
+	refreshCollection(ctx context.Context, nodeID int64, in *querypb.LoadPartitionsRequest) error
+	releaseChannels(ctx context.Context, nodeID int64, collectionID UniqueID, channels []string) error
 	getSegmentInfo(ctx context.Context, in *querypb.GetSegmentInfoRequest) ([]*querypb.SegmentInfo, error)
 	getSegmentInfoByNode(ctx context.Context, nodeID int64, in *querypb.GetSegmentInfoRequest) ([]*querypb.SegmentInfo, error)
 	getSegmentInfoByID(ctx context.Context, segmentID UniqueID) (*querypb.SegmentInfo, error)
@@ -78,11 +80,18 @@ type Cluster interface {
 	allocateSegmentsToQueryNode(ctx context.Context, reqs []*querypb.LoadSegmentsRequest, wait bool, excludeNodeIDs []int64, includeNodeIDs []int64, replicaID int64) error
 	allocateChannelsToQueryNode(ctx context.Context, reqs []*querypb.WatchDmChannelsRequest, wait bool, excludeNodeIDs []int64, includeNodeIDs []int64, replicaID int64) error
 
-	assignNodesToReplicas(ctx context.Context, replicas []*milvuspb.ReplicaInfo, collectionSize uint64) error
+	assignNodesToReplicas(ctx context.Context, replicas []*milvuspb.ReplicaInfo, collectionSize uint64, excludeNodeIDs ...int64) error
+
+	cordonNode(nodeID int64) error
+	uncordonNode(nodeID int64) error
+	isCordoned(nodeID int64) bool
+	setCordonedNodeDrained(nodeID int64) error
+	getCordonedNodes() []*milvuspb.CordonedNode
 
 	getSessionVersion() int64
 
 	getMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest) []queryNodeGetMetricsResponse
+	getSegmentLoadingProgress(ctx context.Context, in *querypb.GetLoadingProgressRequest) []queryNodeLoadingProgressResponse
 }
 
 type newQueryNodeFn func(ctx context.Context, address string, id UniqueID, kv *etcdkv.EtcdKV) (Node, error)
@@ -110,6 +119,8 @@ type queryNodeCluster struct {
 	newNodeFn        newQueryNodeFn
 	segmentAllocator SegmentAllocatePolicy
 	channelAllocator ChannelAllocatePolicy
+	// cordonedNodes are the drain states of the cordoned nodes, which are never assigned new segments or channels
+	cordonedNodes map[int64]string
 }
 
 func newQueryNodeCluster(ctx context.Context, clusterMeta Meta, kv *etcdkv.EtcdKV, newNodeFn newQueryNodeFn, session *sessionutil.Session, handler *channelUnsubscribeHandler) (Cluster, error) {
@@ -131,6 +142,10 @@ func newQueryNodeCluster(ctx context.Context, clusterMeta Meta, kv *etcdkv.EtcdK
 	if err != nil {
 		return nil, err
 	}
+	err = c.reloadCordonedNodes()
+	if err != nil {
+		return nil, err
+	}
 
 	return c, nil
 }
@@ -409,6 +424,43 @@ func (c *queryNodeCluster) releasePartitions(ctx context.Context, nodeID int64,
 	return fmt.Errorf("releasePartitions: can't find QueryNode by nodeID, nodeID = %d", nodeID)
 }
 
+func (c *queryNodeCluster) refreshCollection(ctx context.Context, nodeID int64, in *querypb.LoadPartitionsRequest) error {
+	c.RLock()
+	var targetNode Node
+	if node, ok := c.nodes[nodeID]; ok {
+		targetNode = node
+	}
+	c.RUnlock()
+
+	if targetNode != nil {
+		return targetNode.refreshCollection(ctx, in)
+	}
+
+	return fmt.Errorf("refreshCollection: can't find QueryNode by nodeID, nodeID = %d", nodeID)
+}
+
+// releaseChannels releases the dml channels of the collection on the node
+func (c *queryNodeCluster) releaseChannels(ctx context.Context, nodeID int64, collectionID UniqueID, channels []string) error {
+	c.RLock()
+	var targetNode Node
+	if node, ok := c.nodes[nodeID]; ok {
+		targetNode = node
+	}
+	c.RUnlock()
+
+	if targetNode == nil {
+		return fmt.Errorf("releaseChannels: can't find QueryNode by nodeID, nodeID = %d", nodeID)
+	}
+	return targetNode.releaseChannels(ctx, &querypb.ReleaseChannelsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_ReleaseCollection,
+		},
+		NodeID:       nodeID,
+		CollectionID: collectionID,
+		Channels:     channels,
+	})
+}
+
 func (c *queryNodeCluster) getSegmentInfoByID(ctx context.Context, segmentID UniqueID) (*querypb.SegmentInfo, error) {
 	segmentInfo, err := c.clusterMeta.getSegmentInfoByID(segmentID)
 	if err != nil {
@@ -541,6 +593,43 @@ func (c *queryNodeCluster) getMetrics(ctx context.Context, in *milvuspb.GetMetri
 	return ret
 }
 
+type queryNodeLoadingProgressResponse struct {
+	nodeID int64
+	resp   *querypb.GetSegmentLoadingProgressResponse
+	err    error
+}
+
+// getSegmentLoadingProgress collects the progress of the segments being loaded from all the query nodes
+func (c *queryNodeCluster) getSegmentLoadingProgress(ctx context.Context, in *querypb.GetLoadingProgressRequest) []queryNodeLoadingProgressResponse {
+	c.RLock()
+	var wg sync.WaitGroup
+	cnt := len(c.nodes)
+	wg.Add(cnt)
+	respChan := make(chan queryNodeLoadingProgressResponse, cnt)
+	for nodeID, node := range c.nodes {
+		go func(nodeID int64, node Node) {
+			defer wg.Done()
+			resp, err := node.getSegmentLoadingProgress(ctx, in)
+			respChan <- queryNodeLoadingProgressResponse{
+				nodeID: nodeID,
+				resp:   resp,
+				err:    err,
+			}
+		}(nodeID, node)
+	}
+	c.RUnlock()
+
+	wg.Wait()
+	close(respChan)
+
+	ret := make([]queryNodeLoadingProgressResponse, 0, cnt)
+	for res := range respChan {
+		ret = append(ret, res)
+	}
+
+	return ret
+}
+
 // setNodeState update queryNode state, which may be offline, disconnect, online
 // when queryCoord restart, it will call setNodeState via the registerNode function
 // when the new queryNode starts, queryCoord calls setNodeState via the registerNode function
@@ -706,16 +795,17 @@ func (c *queryNodeCluster) isOnline(nodeID int64) (bool, error) {
 //}
 
 func (c *queryNodeCluster) allocateSegmentsToQueryNode(ctx context.Context, reqs []*querypb.LoadSegmentsRequest, wait bool, excludeNodeIDs []int64, includeNodeIDs []int64, replicaID int64) error {
-	return c.segmentAllocator(ctx, reqs, c, c.clusterMeta, wait, excludeNodeIDs, includeNodeIDs, replicaID)
+	return c.segmentAllocator(ctx, reqs, c, c.clusterMeta, wait, c.excludeCordonedNodes(excludeNodeIDs), includeNodeIDs, replicaID)
 }
 
 func (c *queryNodeCluster) allocateChannelsToQueryNode(ctx context.Context, reqs []*querypb.WatchDmChannelsRequest, wait bool, excludeNodeIDs []int64, includeNodeIDs []int64, replicaID int64) error {
-	return c.channelAllocator(ctx, reqs, c, c.clusterMeta, wait, excludeNodeIDs, includeNodeIDs, replicaID)
+	return c.channelAllocator(ctx, reqs, c, c.clusterMeta, wait, c.excludeCordonedNodes(excludeNodeIDs), includeNodeIDs, replicaID)
 }
 
-// Return error if no enough nodes/resources to create replicas
-func (c *queryNodeCluster) assignNodesToReplicas(ctx context.Context, replicas []*milvuspb.ReplicaInfo, collectionSize uint64) error {
-	nodeIds := c.onlineNodeIDs()
+// Return error if no enough nodes/resources to create replicas,
+// the excluded nodes are not assigned, such as the ones serving the other replicas of the collection and the cordoned ones
+func (c *queryNodeCluster) assignNodesToReplicas(ctx context.Context, replicas []*milvuspb.ReplicaInfo, collectionSize uint64, excludeNodeIDs ...int64) error {
+	nodeIds := removeFromSlice(c.onlineNodeIDs(), c.excludeCordonedNodes(excludeNodeIDs)...)
 	if len(nodeIds) < len(replicas) {
 		return fmt.Errorf("no enough nodes to create replicas, node_num=%d replica_num=%d", len(nodeIds), len(replicas))
 	}
@@ -729,11 +819,85 @@ func (c *queryNodeCluster) assignNodesToReplicas(ctx context.Context, replicas [
 		return nodeInfos[i].totalMem-nodeInfos[i].memUsage > nodeInfos[j].totalMem-nodeInfos[j].memUsage
 	})
 
+	// place the replicas zone by zone, so that the shard leaders could search the replicas in their own zones
+	replicaZones := tagReplicaZones(len(replicas), nodeInfos)
+	memCapCount := distributeNodesToReplicas(replicas, nodeInfos, replicaZones)
+	for _, replica := range replicas {
+		if len(replica.NodeIds) == 0 {
+			// the zones are too unbalanced to place the replicas zone by zone
+			log.Warn("failed to place replicas by zones", zap.Strings("zones", replicaZones))
+			for _, replica := range replicas {
+				replica.NodeIds = nil
+			}
+			replicaZones = nil
+			memCapCount = distributeNodesToReplicas(replicas, nodeInfos, nil)
+			break
+		}
+	}
+	for i, zone := range replicaZones {
+		log.Info("place replica in zone", zap.Int64("replicaID", replicas[i].GetReplicaID()), zap.String("zone", zone))
+	}
+
+	for _, memCap := range memCapCount {
+		if memCap < collectionSize {
+			return fmt.Errorf("no enough memory to load collection/partitions, collectionSize=%v, replicasNum=%v", collectionSize, len(replicas))
+		}
+	}
+
+	return nil
+}
+
+// tagReplicaZones labels the replicas with the zones of nodes round robin, the zones with more free memory go first.
+// Returns nil if the zones of nodes are unknown.
+func tagReplicaZones(replicaNum int, nodeInfos []*queryNode) []string {
+	zoneMemCap := make(map[string]uint64)
+	for _, info := range nodeInfos {
+		if info.zone == "" {
+			continue
+		}
+		zoneMemCap[info.zone] += info.totalMem - info.memUsage
+	}
+	if len(zoneMemCap) == 0 {
+		return nil
+	}
+	zones := make([]string, 0, len(zoneMemCap))
+	for zone := range zoneMemCap {
+		zones = append(zones, zone)
+	}
+	sort.Slice(zones, func(i, j int) bool {
+		if zoneMemCap[zones[i]] != zoneMemCap[zones[j]] {
+			return zoneMemCap[zones[i]] > zoneMemCap[zones[j]]
+		}
+		return zones[i] < zones[j]
+	})
+
+	replicaZones := make([]string, replicaNum)
+	for i := range replicaZones {
+		replicaZones[i] = zones[i%len(zones)]
+	}
+	return replicaZones
+}
+
+// distributeNodesToReplicas assigns each node to the replica with the least memory capacity among the replicas
+// labeled with the zone of the node, or among all replicas if there is no such replica.
+// Returns the memory capacity of the replicas.
+func distributeNodesToReplicas(replicas []*milvuspb.ReplicaInfo, nodeInfos []*queryNode, replicaZones []string) []uint64 {
 	memCapCount := make([]uint64, len(replicas))
 	for _, info := range nodeInfos {
+		sameZone := false
+		for _, zone := range replicaZones {
+			if zone == info.zone {
+				sameZone = true
+				break
+			}
+		}
+
 		i := 0
 		minMemCap := uint64(math.MaxUint64)
 		for j, memCap := range memCapCount {
+			if sameZone && replicaZones[j] != info.zone {
+				continue
+			}
 			if memCap < minMemCap {
 				minMemCap = memCap
 				i = j
@@ -743,14 +907,7 @@ func (c *queryNodeCluster) assignNodesToReplicas(ctx context.Context, replicas [
 		replicas[i].NodeIds = append(replicas[i].NodeIds, info.id)
 		memCapCount[i] += info.totalMem - info.memUsage
 	}
-
-	for _, memCap := range memCapCount {
-		if memCap < collectionSize {
-			return fmt.Errorf("no enough memory to load collection/partitions, collectionSize=%v, replicasNum=%v", collectionSize, len(replicas))
-		}
-	}
-
-	return nil
+	return memCapCount
 }
 
 // It's a helper method to concurrently get nodes' info
@@ -36,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -660,3 +661,37 @@ func TestSetNodeState(t *testing.T) {
 	removeAllSession()
 	cancel()
 }
+
+func TestPlaceReplicasByZones(t *testing.T) {
+	genNode := func(id int64, zone string, freeMem uint64) *queryNode {
+		return &queryNode{id: id, zone: zone, totalMem: freeMem}
+	}
+
+	// zones unknown
+	assert.Nil(t, tagReplicaZones(2, []*queryNode{genNode(1, "", 100), genNode(2, "", 100)}))
+
+	nodeInfos := []*queryNode{
+		genNode(1, "az-1", 300),
+		genNode(2, "az-2", 200),
+		genNode(3, "az-1", 100),
+		genNode(4, "az-2", 100),
+		genNode(5, "", 50),
+	}
+	replicaZones := tagReplicaZones(3, nodeInfos)
+	assert.Equal(t, []string{"az-1", "az-2", "az-1"}, replicaZones)
+
+	replicas := []*milvuspb.ReplicaInfo{{ReplicaID: 1}, {ReplicaID: 2}, {ReplicaID: 3}}
+	memCapCount := distributeNodesToReplicas(replicas, nodeInfos, replicaZones)
+	assert.ElementsMatch(t, []int64{1}, replicas[0].NodeIds)
+	assert.ElementsMatch(t, []int64{2, 4}, replicas[1].NodeIds)
+	// the node without zone goes to the replica with the least memory capacity
+	assert.ElementsMatch(t, []int64{3, 5}, replicas[2].NodeIds)
+	assert.Equal(t, []uint64{300, 300, 150}, memCapCount)
+
+	// no zone labels
+	replicas = []*milvuspb.ReplicaInfo{{ReplicaID: 1}, {ReplicaID: 2}}
+	memCapCount = distributeNodesToReplicas(replicas, nodeInfos, nil)
+	assert.ElementsMatch(t, []int64{1, 4}, replicas[0].NodeIds)
+	assert.ElementsMatch(t, []int64{2, 3, 5}, replicas[1].NodeIds)
+	assert.Equal(t, []uint64{400, 350}, memCapCount)
+}
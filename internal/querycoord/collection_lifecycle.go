@@ -0,0 +1,207 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+const collectionLifecyclePrefix = "queryCoord-collectionLifecycle"
+
+// maxCollectionLifecycles is the max number of the collections whose lifecycles are kept, QueryCoord isn't notified of
+// the collections dropped, so the lifecycles of the collections without recent events are removed beyond it
+const maxCollectionLifecycles = 1024
+
+// collectionLifecycleRecorder persists the recent load/release events of collections,
+// so that users could learn why a collection isn't loaded without correlating logs.
+type collectionLifecycleRecorder struct {
+	client   *etcdkv.EtcdKV
+	capacity int
+
+	mu         sync.RWMutex
+	lifecycles map[UniqueID]*metricsinfo.CollectionLifecycle
+}
+
+func newCollectionLifecycleRecorder(kv *etcdkv.EtcdKV) (*collectionLifecycleRecorder, error) {
+	r := &collectionLifecycleRecorder{
+		client:     kv,
+		capacity:   maxCollectionLifecycles,
+		lifecycles: make(map[UniqueID]*metricsinfo.CollectionLifecycle),
+	}
+	_, values, err := kv.LoadWithPrefix(collectionLifecyclePrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		lifecycle := &metricsinfo.CollectionLifecycle{}
+		if err := json.Unmarshal([]byte(value), lifecycle); err != nil {
+			log.Warn("invalid collection lifecycle, ignored", zap.String("value", value), zap.Error(err))
+			continue
+		}
+		r.lifecycles[lifecycle.CollectionID] = lifecycle
+	}
+	return r, nil
+}
+
+func (r *collectionLifecycleRecorder) update(collectionID UniqueID, fn func(lifecycle *metricsinfo.CollectionLifecycle)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lifecycle, ok := r.lifecycles[collectionID]
+	if !ok {
+		lifecycle = &metricsinfo.CollectionLifecycle{CollectionID: collectionID}
+		r.lifecycles[collectionID] = lifecycle
+	}
+	fn(lifecycle)
+
+	// the lifecycle is for diagnosis only, failing to persist it shouldn't fail the task
+	value, err := json.Marshal(lifecycle)
+	if err != nil {
+		log.Warn("failed to marshal collection lifecycle", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return
+	}
+	if err = r.client.Save(collectionLifecycleKey(collectionID), string(value)); err != nil {
+		log.Warn("failed to save collection lifecycle", zap.Int64("collectionID", collectionID), zap.Error(err))
+	}
+	if len(r.lifecycles) > r.capacity {
+		r.evictOldest()
+	}
+}
+
+func collectionLifecycleKey(collectionID UniqueID) string {
+	return fmt.Sprintf("%s/%d", collectionLifecyclePrefix, collectionID)
+}
+
+// evictOldest removes the lifecycle whose last event is the earliest, it must be called with mu held
+func (r *collectionLifecycleRecorder) evictOldest() {
+	var oldestID UniqueID
+	var oldest time.Time
+	first := true
+	for collectionID, lifecycle := range r.lifecycles {
+		if t := lastEventTime(lifecycle); first || t.Before(oldest) {
+			oldestID, oldest, first = collectionID, t, false
+		}
+	}
+	delete(r.lifecycles, oldestID)
+	if err := r.client.Remove(collectionLifecycleKey(oldestID)); err != nil {
+		log.Warn("failed to remove collection lifecycle", zap.Int64("collectionID", oldestID), zap.Error(err))
+	}
+}
+
+// lastEventTime returns the time of the latest event of the lifecycle, the times failed to parse are ignored
+func lastEventTime(lifecycle *metricsinfo.CollectionLifecycle) time.Time {
+	var last time.Time
+	for _, value := range []string{lifecycle.LastLoadTime, lifecycle.LastReleaseTime, lifecycle.LastFailureTime} {
+		if t, err := time.Parse(time.RFC3339, value); err == nil && t.After(last) {
+			last = t
+		}
+	}
+	return last
+}
+
+func (r *collectionLifecycleRecorder) recordLoad(collectionID UniqueID) {
+	r.update(collectionID, func(lifecycle *metricsinfo.CollectionLifecycle) {
+		lifecycle.LastLoadTime = time.Now().Format(time.RFC3339)
+	})
+}
+
+func (r *collectionLifecycleRecorder) recordRelease(collectionID UniqueID) {
+	r.update(collectionID, func(lifecycle *metricsinfo.CollectionLifecycle) {
+		lifecycle.LastReleaseTime = time.Now().Format(time.RFC3339)
+	})
+}
+
+func (r *collectionLifecycleRecorder) recordFailure(collectionID UniqueID, operation commonpb.MsgType, reason string) {
+	r.update(collectionID, func(lifecycle *metricsinfo.CollectionLifecycle) {
+		lifecycle.LastFailedOperation = operation.String()
+		lifecycle.LastFailureTime = time.Now().Format(time.RFC3339)
+		lifecycle.LastError = reason
+	})
+}
+
+// recordTask records the result of a load or release trigger task, other tasks are ignored
+func (r *collectionLifecycleRecorder) recordTask(t task, status *commonpb.Status) {
+	if r == nil {
+		return
+	}
+	var collectionID UniqueID
+	switch t := t.(type) {
+	case *loadCollectionTask:
+		collectionID = t.CollectionID
+	case *loadPartitionTask:
+		collectionID = t.CollectionID
+	case *releaseCollectionTask:
+		collectionID = t.CollectionID
+	case *releasePartitionTask:
+		collectionID = t.CollectionID
+	default:
+		return
+	}
+
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		r.recordFailure(collectionID, t.msgType(), status.GetReason())
+		return
+	}
+	switch t.msgType() {
+	case commonpb.MsgType_LoadCollection, commonpb.MsgType_LoadPartitions:
+		r.recordLoad(collectionID)
+	case commonpb.MsgType_ReleaseCollection, commonpb.MsgType_ReleasePartitions:
+		r.recordRelease(collectionID)
+	}
+}
+
+// get returns a copy of the lifecycle of the collection, nil if nothing recorded
+func (r *collectionLifecycleRecorder) get(collectionID UniqueID) *metricsinfo.CollectionLifecycle {
+	if r == nil {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	lifecycle, ok := r.lifecycles[collectionID]
+	if !ok {
+		return nil
+	}
+	ret := *lifecycle
+	return &ret
+}
+
+// getAll returns the lifecycles of all the collections ordered by collection id
+func (r *collectionLifecycleRecorder) getAll() []metricsinfo.CollectionLifecycle {
+	if r == nil {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ret := make([]metricsinfo.CollectionLifecycle, 0, len(r.lifecycles))
+	for _, lifecycle := range r.lifecycles {
+		ret = append(ret, *lifecycle)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].CollectionID < ret[j].CollectionID
+	})
+	return ret
+}
@@ -0,0 +1,100 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+func TestCollectionLifecycleRecorder(t *testing.T) {
+	refreshParams()
+	etcdCli, err := etcd.GetEtcdClient(&Params.EtcdCfg)
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+	kv := etcdkv.NewEtcdKV(etcdCli, Params.EtcdCfg.MetaRootPath)
+	defer kv.RemoveWithPrefix(collectionLifecyclePrefix)
+
+	recorder, err := newCollectionLifecycleRecorder(kv)
+	assert.Nil(t, err)
+	assert.Nil(t, recorder.get(defaultCollectionID))
+
+	loadTask := &loadCollectionTask{
+		baseTask: &baseTask{},
+		LoadCollectionRequest: &querypb.LoadCollectionRequest{
+			Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_LoadCollection},
+			CollectionID: defaultCollectionID,
+		},
+	}
+	recorder.recordTask(loadTask, &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success})
+	lifecycle := recorder.get(defaultCollectionID)
+	assert.NotNil(t, lifecycle)
+	assert.NotEmpty(t, lifecycle.LastLoadTime)
+	assert.Empty(t, lifecycle.LastError)
+
+	releaseTask := &releaseCollectionTask{
+		baseTask: &baseTask{},
+		ReleaseCollectionRequest: &querypb.ReleaseCollectionRequest{
+			Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_ReleaseCollection},
+			CollectionID: defaultCollectionID,
+		},
+	}
+	recorder.recordTask(releaseTask, &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success})
+	assert.NotEmpty(t, recorder.get(defaultCollectionID).LastReleaseTime)
+
+	loadErr := errors.New("OOM if load")
+	recorder.recordTask(loadTask, &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: loadErr.Error()})
+	lifecycle = recorder.get(defaultCollectionID)
+	assert.Equal(t, commonpb.MsgType_LoadCollection.String(), lifecycle.LastFailedOperation)
+	assert.Equal(t, loadErr.Error(), lifecycle.LastError)
+
+	// other tasks are ignored
+	recorder.recordTask(&loadBalanceTask{baseTask: &baseTask{}}, &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success})
+	assert.Equal(t, 1, len(recorder.getAll()))
+
+	// reload from kv
+	reloaded, err := newCollectionLifecycleRecorder(kv)
+	assert.Nil(t, err)
+	assert.Equal(t, recorder.getAll(), reloaded.getAll())
+
+	// the lifecycle without recent events is evicted beyond the capacity
+	_, err = time.Parse(time.RFC3339, lifecycle.LastLoadTime)
+	assert.NoError(t, err)
+	recorder.capacity = 1
+	recorder.update(defaultCollectionID+1, func(lifecycle *metricsinfo.CollectionLifecycle) {
+		lifecycle.LastLoadTime = time.Now().Add(time.Hour).Format(time.RFC3339)
+	})
+	assert.Nil(t, recorder.get(defaultCollectionID))
+	assert.NotNil(t, recorder.get(defaultCollectionID+1))
+	reloaded, err = newCollectionLifecycleRecorder(kv)
+	assert.Nil(t, err)
+	assert.Equal(t, recorder.getAll(), reloaded.getAll())
+
+	var nilRecorder *collectionLifecycleRecorder
+	nilRecorder.recordTask(loadTask, &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success})
+	assert.Nil(t, nilRecorder.get(defaultCollectionID))
+	assert.Nil(t, nilRecorder.getAll())
+}
@@ -0,0 +1,45 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// The properties of a collection are carried by its schema, the replica number and the load priority are read
+// by QueryCoord when the collection is loaded, and the query nodes read the ttl and the load mode. The altered
+// properties are received by RefreshCollection.
+
+// getLoadReplicaNumber returns the number of replicas to load the collection with, the replica number property
+// of the collection is used if the load request doesn't specify it
+func getLoadReplicaNumber(replicaNumber int32, schema *schemapb.CollectionSchema) int32 {
+	if replicaNumber > 0 {
+		return replicaNumber
+	}
+	n, err := typeutil.GetCollectionReplicaNumber(schema)
+	if err != nil || n <= 0 {
+		return replicaNumber
+	}
+	return n
+}
+
+// refreshLoadPriority applies the load priority property of the refreshed schema to the queued load tasks of the
+// collection at once, the priority is reset to 0 if the property is removed
+func (qc *QueryCoord) refreshLoadPriority(collectionID UniqueID, schema *schemapb.CollectionSchema) {
+	qc.scheduler.setLoadPriority(collectionID, getLoadPriority(collectionID, schema))
+}
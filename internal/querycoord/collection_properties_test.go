@@ -0,0 +1,40 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestGetLoadReplicaNumber(t *testing.T) {
+	schema := genDefaultCollectionSchema(false)
+	schema.Fields[2].IsPrimaryKey = true
+	assert.Equal(t, int32(0), getLoadReplicaNumber(0, schema))
+	assert.Equal(t, int32(2), getLoadReplicaNumber(2, schema))
+
+	err := typeutil.SetCollectionProperties(schema, map[string]string{common.CollectionReplicaNumberParam: "3"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), getLoadReplicaNumber(0, schema))
+	assert.Equal(t, int32(3), getLoadReplicaNumber(-1, schema))
+	// the replica number of the load request is preferred
+	assert.Equal(t, int32(2), getLoadReplicaNumber(2, schema))
+}
@@ -43,8 +43,13 @@ func (tc *taskCondition) waitToFinish() error {
 	}
 }
 
+// notify sets the result of the task, the result is dropped if the task has been notified and not waited yet,
+// as the task may be failed in advance by its query node down, then notified again by the returned request
 func (tc *taskCondition) notify(err error) {
-	tc.done <- err
+	select {
+	case tc.done <- err:
+	default:
+	}
 }
 
 func (tc *taskCondition) Ctx() context.Context {
@@ -195,6 +195,26 @@ func (broker *globalMetaBroker) getIndexFilePaths(ctx context.Context, buildID i
 	return pathResponse.FilePaths, nil
 }
 
+// prioritizeIndexBuild requests IndexCoord to assign the index builds of the collection being loaded ahead of the others
+func (broker *globalMetaBroker) prioritizeIndexBuild(ctx context.Context, collectionID UniqueID) error {
+	req := &indexpb.PrioritizeIndexBuildRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_LoadCollection,
+		},
+		CollectionID: collectionID,
+	}
+	ctx2, cancel2 := context.WithTimeout(ctx, timeoutForRPC)
+	defer cancel2()
+	status, err := broker.indexCoord.PrioritizeIndexBuild(ctx2, req)
+	if err != nil {
+		return err
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("prioritize index build failed, collectionID = %d, reason = %s", collectionID, status.GetReason())
+	}
+	return nil
+}
+
 func (broker *globalMetaBroker) parseIndexInfo(ctx context.Context, segmentID UniqueID, indexInfo *querypb.FieldIndexInfo) error {
 	if !indexInfo.EnableIndex {
 		log.Debug(fmt.Sprintf("fieldID %d of segment %d don't has index", indexInfo.FieldID, segmentID))
@@ -426,7 +446,7 @@ func (broker *globalMetaBroker) generateSegmentLoadInfo(ctx context.Context,
 		SegmentID:     segmentID,
 		PartitionID:   partitionID,
 		CollectionID:  collectionID,
-		BinlogPaths:   segmentBinlog.FieldBinlogs,
+		BinlogPaths:   filterLoadedFieldBinlogs(segmentBinlog.FieldBinlogs, schema),
 		NumOfRows:     segmentBinlog.NumOfRows,
 		Statslogs:     segmentBinlog.Statslogs,
 		Deltalogs:     segmentBinlog.Deltalogs,
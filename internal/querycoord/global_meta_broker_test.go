@@ -133,6 +133,7 @@ func TestGlobalMetaBroker_IndexCoord(t *testing.T) {
 		indexInfos, err := handler.getIndexInfo(ctx, defaultCollectionID, defaultSegmentID, genDefaultCollectionSchema(false))
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(indexInfos))
+		assert.NoError(t, handler.prioritizeIndexBuild(ctx, defaultCollectionID))
 	})
 
 	t.Run("returnError", func(t *testing.T) {
@@ -143,6 +144,7 @@ func TestGlobalMetaBroker_IndexCoord(t *testing.T) {
 		indexInfos, err := handler.getIndexInfo(ctx, defaultCollectionID, defaultSegmentID, genDefaultCollectionSchema(false))
 		assert.Error(t, err)
 		assert.Nil(t, indexInfos)
+		assert.Error(t, handler.prioritizeIndexBuild(ctx, defaultCollectionID))
 		indexCoord.returnError = false
 	})
 
@@ -154,6 +156,7 @@ func TestGlobalMetaBroker_IndexCoord(t *testing.T) {
 		indexInfos, err := handler.getIndexInfo(ctx, defaultCollectionID, defaultSegmentID, genDefaultCollectionSchema(false))
 		assert.Error(t, err)
 		assert.Nil(t, indexInfos)
+		assert.Error(t, handler.prioritizeIndexBuild(ctx, defaultCollectionID))
 		indexCoord.returnGrpcError = false
 	})
 
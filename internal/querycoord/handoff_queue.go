@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+// handoffPriorityRefreshInterval is the interval to collect the QPS of the collections again to prioritize the handoffs
+const handoffPriorityRefreshInterval = 10 * time.Second
+
+// handoffQueue holds the indexed segments waiting to be handed off. The segments of the collection
+// with the highest QPS are handed off first, and in the arrival order within the same collection,
+// so that a flood of handoffs after large compactions doesn't delay the actively queried collections.
+type handoffQueue struct {
+	segments      []*querypb.SegmentInfo
+	collectionQPS map[UniqueID]float64
+	refreshedAt   time.Time
+}
+
+func newHandoffQueue() *handoffQueue {
+	return &handoffQueue{
+		collectionQPS: make(map[UniqueID]float64),
+	}
+}
+
+func (q *handoffQueue) len() int {
+	return len(q.segments)
+}
+
+func (q *handoffQueue) push(segment *querypb.SegmentInfo) {
+	q.segments = append(q.segments, segment)
+}
+
+// pop returns the first segment of the collection with the highest QPS, nil if the queue is empty
+func (q *handoffQueue) pop() *querypb.SegmentInfo {
+	if len(q.segments) == 0 {
+		return nil
+	}
+	index := 0
+	for i, segment := range q.segments {
+		if q.collectionQPS[segment.GetCollectionID()] > q.collectionQPS[q.segments[index].GetCollectionID()] {
+			index = i
+		}
+	}
+	segment := q.segments[index]
+	q.segments = append(q.segments[:index], q.segments[index+1:]...)
+	return segment
+}
+
+// needRefresh returns whether the QPS of the collections are out of date
+func (q *handoffQueue) needRefresh() bool {
+	return time.Since(q.refreshedAt) >= handoffPriorityRefreshInterval
+}
+
+func (q *handoffQueue) setCollectionQPS(collectionQPS map[UniqueID]float64) {
+	q.collectionQPS = collectionQPS
+	q.refreshedAt = time.Now()
+}
+
+// getCollectionsQPS sums the QPS of the shards reported by the online query nodes per collection
+func getCollectionsQPS(ctx context.Context, cluster Cluster) map[UniqueID]float64 {
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.ShardStatsMetrics)
+	if err != nil {
+		log.Warn("failed to construct shard stats request", zap.Error(err))
+		return make(map[UniqueID]float64)
+	}
+	return mergeCollectionsQPS(cluster.getMetrics(ctx, req))
+}
+
+func mergeCollectionsQPS(nodesMetrics []queryNodeGetMetricsResponse) map[UniqueID]float64 {
+	collectionQPS := make(map[UniqueID]float64)
+	for _, nodeMetrics := range nodesMetrics {
+		if nodeMetrics.err != nil || nodeMetrics.resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			continue
+		}
+		var shardStats []metricsinfo.QueryShardStats
+		if err := json.Unmarshal([]byte(nodeMetrics.resp.GetResponse()), &shardStats); err != nil {
+			log.Warn("invalid shard stats of query node was found",
+				zap.String("component", nodeMetrics.resp.GetComponentName()), zap.Error(err))
+			continue
+		}
+		for _, stats := range shardStats {
+			collectionQPS[stats.CollectionID] += stats.QPS
+		}
+	}
+	return collectionQPS
+}
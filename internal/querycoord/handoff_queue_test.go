@@ -0,0 +1,70 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+func TestHandoffQueue(t *testing.T) {
+	q := newHandoffQueue()
+	assert.Nil(t, q.pop())
+	assert.True(t, q.needRefresh())
+
+	for i, collectionID := range []UniqueID{1, 2, 1, 3, 2} {
+		q.push(&querypb.SegmentInfo{SegmentID: UniqueID(i + 1), CollectionID: collectionID})
+	}
+	assert.Equal(t, 5, q.len())
+
+	// in arrival order without QPS
+	assert.Equal(t, UniqueID(1), q.pop().GetSegmentID())
+
+	q.setCollectionQPS(map[UniqueID]float64{2: 100, 3: 10})
+	assert.False(t, q.needRefresh())
+	var segmentIDs []UniqueID
+	for q.len() > 0 {
+		segmentIDs = append(segmentIDs, q.pop().GetSegmentID())
+	}
+	assert.Equal(t, []UniqueID{2, 5, 4, 3}, segmentIDs)
+}
+
+func TestMergeCollectionsQPS(t *testing.T) {
+	genResponse := func(stats []metricsinfo.QueryShardStats) queryNodeGetMetricsResponse {
+		resp, err := json.Marshal(stats)
+		assert.Nil(t, err)
+		return queryNodeGetMetricsResponse{resp: &milvuspb.GetMetricsResponse{
+			Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Response: string(resp),
+		}}
+	}
+	nodesMetrics := []queryNodeGetMetricsResponse{
+		genResponse([]metricsinfo.QueryShardStats{{CollectionID: 1, QPS: 10}, {CollectionID: 2, QPS: 5}}),
+		genResponse([]metricsinfo.QueryShardStats{{CollectionID: 1, QPS: 20}}),
+		{err: errors.New("mock error")},
+		{resp: &milvuspb.GetMetricsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, Response: "invalid"}},
+	}
+	assert.Equal(t, map[UniqueID]float64{1: 30, 2: 5}, mergeCollectionsQPS(nodesMetrics))
+}
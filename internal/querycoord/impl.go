@@ -18,9 +18,11 @@ package querycoord
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/common"
@@ -30,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -116,7 +119,7 @@ func (qc *QueryCoord) ShowCollections(ctx context.Context, req *querypb.ShowColl
 	inMemoryPercentages := make([]int64, 0)
 	if len(req.CollectionIDs) == 0 {
 		for _, id := range inMemoryCollectionIDs {
-			inMemoryPercentages = append(inMemoryPercentages, ID2collectionInfo[id].InMemoryPercentage)
+			inMemoryPercentages = append(inMemoryPercentages, qc.inMemoryPercentage(ID2collectionInfo[id]))
 		}
 		log.Info("show collection end",
 			zap.String("role", typeutil.QueryCoordRole),
@@ -133,6 +136,10 @@ func (qc *QueryCoord) ShowCollections(ctx context.Context, req *querypb.ShowColl
 		if _, ok := ID2collectionInfo[id]; !ok {
 			status.ErrorCode = commonpb.ErrorCode_UnexpectedError
 			err := fmt.Errorf("collection %d has not been loaded to memory or load failed", id)
+			if lifecycle := qc.scheduler.lifecycle.get(id); lifecycle != nil && lifecycle.LastError != "" {
+				err = fmt.Errorf("%w, last failed operation %s at %s: %s",
+					err, lifecycle.LastFailedOperation, lifecycle.LastFailureTime, lifecycle.LastError)
+			}
 			status.Reason = err.Error()
 			log.Warn("show collection failed",
 				zap.String("role", typeutil.QueryCoordRole),
@@ -143,7 +150,7 @@ func (qc *QueryCoord) ShowCollections(ctx context.Context, req *querypb.ShowColl
 				Status: status,
 			}, nil
 		}
-		inMemoryPercentages = append(inMemoryPercentages, ID2collectionInfo[id].InMemoryPercentage)
+		inMemoryPercentages = append(inMemoryPercentages, qc.inMemoryPercentage(ID2collectionInfo[id]))
 	}
 	log.Info("show collection end",
 		zap.String("role", typeutil.QueryCoordRole),
@@ -181,34 +188,55 @@ func (qc *QueryCoord) LoadCollection(ctx context.Context, req *querypb.LoadColle
 		return status, nil
 	}
 
+	if jobID, ok := qc.scheduler.releaseJobs.running(collectionID); ok {
+		err := fmt.Errorf("collection %d is being released by job %d", collectionID, jobID)
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		status.Reason = err.Error()
+		log.Warn("load collection failed", zap.String("role", typeutil.QueryCoordRole), zap.Int64("msgID", req.Base.MsgID), zap.Error(err))
+
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel).Inc()
+		return status, nil
+	}
+
+	schema, err := qc.getLoadSchema(collectionID, req.Schema, req.GetLoadFields())
+	if err != nil {
+		status.ErrorCode = commonpb.ErrorCode_IllegalArgument
+		status.Reason = err.Error()
+		log.Warn("load collection failed", zap.String("role", typeutil.QueryCoordRole), zap.Int64("msgID", req.Base.MsgID), zap.Error(err))
+
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel).Inc()
+		return status, nil
+	}
+	req.Schema = schema
+	req.ReplicaNumber = getLoadReplicaNumber(req.ReplicaNumber, schema)
+	if err := qc.broker.prioritizeIndexBuild(ctx, collectionID); err != nil {
+		log.Warn("failed to prioritize the index builds of collection", zap.Int64("collectionID", collectionID), zap.Error(err))
+	}
+
 	if collectionInfo, err := qc.meta.getCollectionInfoByID(collectionID); err == nil {
 		// if collection has been loaded by load collection request, return success
 		if collectionInfo.LoadType == querypb.LoadType_LoadCollection {
-			if collectionInfo.ReplicaNumber != req.ReplicaNumber {
-				msg := fmt.Sprintf("collection has already been loaded, and the number of replicas %v is not same as the request's %v. Should release first then reload with the new number of replicas",
-					collectionInfo.ReplicaNumber,
-					req.ReplicaNumber)
-				log.Warn(msg,
+			replicaNumber := req.ReplicaNumber
+			if replicaNumber < 1 {
+				replicaNumber = 1
+			}
+			if collectionInfo.ReplicaNumber == replicaNumber {
+				log.Info("collection has already been loaded, return load success directly",
 					zap.String("role", typeutil.QueryCoordRole),
 					zap.Int64("collectionID", collectionID),
-					zap.Int64("msgID", req.Base.MsgID),
-					zap.Int32("collectionReplicaNumber", collectionInfo.ReplicaNumber),
-					zap.Int32("requestReplicaNumber", req.ReplicaNumber))
+					zap.Int64("msgID", req.Base.MsgID))
 
-				status.ErrorCode = commonpb.ErrorCode_IllegalArgument
-				status.Reason = msg
-
-				metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel).Inc()
+				metrics.QueryCoordLoadCount.WithLabelValues(metrics.SuccessLabel).Inc()
 				return status, nil
 			}
 
-			log.Info("collection has already been loaded, return load success directly",
+			// the replicas are added or removed online by the load collection task
+			log.Info("collection has already been loaded, change the number of replicas",
 				zap.String("role", typeutil.QueryCoordRole),
 				zap.Int64("collectionID", collectionID),
-				zap.Int64("msgID", req.Base.MsgID))
-
-			metrics.QueryCoordLoadCount.WithLabelValues(metrics.SuccessLabel).Inc()
-			return status, nil
+				zap.Int64("msgID", req.Base.MsgID),
+				zap.Int32("collectionReplicaNumber", collectionInfo.ReplicaNumber),
+				zap.Int32("requestReplicaNumber", replicaNumber))
 		}
 		// if some partitions of the collection have been loaded by load partitions request, return error
 		// should release partitions first, then load collection again
@@ -237,7 +265,7 @@ func (qc *QueryCoord) LoadCollection(ctx context.Context, req *querypb.LoadColle
 		cluster:               qc.cluster,
 		meta:                  qc.meta,
 	}
-	err := qc.scheduler.Enqueue(loadCollectionTask)
+	err = qc.scheduler.Enqueue(loadCollectionTask)
 	if err != nil {
 		log.Error("loadCollectionRequest failed to add execute task to scheduler",
 			zap.String("role", typeutil.QueryCoordRole),
@@ -296,9 +324,11 @@ func (qc *QueryCoord) ReleaseCollection(ctx context.Context, req *querypb.Releas
 		return status, nil
 	}
 
+	qc.releaseMu.Lock()
 	// if collection has not been loaded into memory, return release collection successfully
 	hasCollection := qc.meta.hasCollection(collectionID)
 	if !hasCollection {
+		qc.releaseMu.Unlock()
 		log.Info("release collection end, the collection has not been loaded into QueryNode",
 			zap.String("role", typeutil.QueryCoordRole),
 			zap.Int64("collectionID", collectionID),
@@ -308,6 +338,33 @@ func (qc *QueryCoord) ReleaseCollection(ctx context.Context, req *querypb.Releas
 		return status, nil
 	}
 
+	// the collection has been released by a later release, and loaded again
+	if qc.scheduler.releaseJobs.isReleased(collectionID, req.Base.GetTimestamp()) {
+		qc.releaseMu.Unlock()
+		log.Info("release collection end, the collection has been released by a later request",
+			zap.String("role", typeutil.QueryCoordRole),
+			zap.Int64("collectionID", collectionID),
+			zap.Uint64("timestamp", req.Base.GetTimestamp()),
+			zap.Int64("msgID", req.Base.MsgID))
+
+		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.SuccessLabel).Inc()
+		return status, nil
+	}
+
+	// duplicate release attaches to the running release job
+	if jobID, ok := qc.scheduler.releaseJobs.running(collectionID); ok {
+		qc.releaseMu.Unlock()
+		log.Info("release collection is already running",
+			zap.String("role", typeutil.QueryCoordRole),
+			zap.Int64("collectionID", collectionID),
+			zap.Int64("jobID", jobID),
+			zap.Int64("msgID", req.Base.MsgID))
+		if Params.QueryCoordCfg.AsyncRelease {
+			return status, nil
+		}
+		return qc.waitReleaseJob(ctx, jobID), nil
+	}
+
 	baseTask := newBaseTask(qc.loopCtx, querypb.TriggerCondition_GrpcRequest)
 	releaseCollectionTask := &releaseCollectionTask{
 		baseTask:                 baseTask,
@@ -318,6 +375,7 @@ func (qc *QueryCoord) ReleaseCollection(ctx context.Context, req *querypb.Releas
 	}
 	err := qc.scheduler.Enqueue(releaseCollectionTask)
 	if err != nil {
+		qc.releaseMu.Unlock()
 		log.Error("releaseCollectionRequest failed to add execute task to scheduler",
 			zap.String("role", typeutil.QueryCoordRole),
 			zap.Int64("collectionID", collectionID),
@@ -329,8 +387,55 @@ func (qc *QueryCoord) ReleaseCollection(ctx context.Context, req *querypb.Releas
 		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
 		return status, nil
 	}
+	qc.scheduler.releaseJobs.start(releaseCollectionTask)
+	qc.releaseMu.Unlock()
+
+	if Params.QueryCoordCfg.AsyncRelease {
+		go qc.waitReleaseCollection(releaseCollectionTask)
+
+		jobID := releaseCollectionTask.getTaskID()
+		log.Info("releaseCollectionRequest scheduled",
+			zap.String("role", typeutil.QueryCoordRole),
+			zap.Int64("collectionID", collectionID),
+			zap.Int64("jobID", jobID),
+			zap.Int64("msgID", req.Base.MsgID))
+		return status, nil
+	}
+
+	return qc.waitReleaseCollection(releaseCollectionTask), nil
+}
+
+// waitReleaseJob waits for the running release job which a duplicate release attaches to
+func (qc *QueryCoord) waitReleaseJob(ctx context.Context, jobID UniqueID) *commonpb.Status {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	job, err := qc.scheduler.releaseJobs.wait(ctx, jobID)
+	if err != nil {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		status.Reason = err.Error()
+		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
+		return status
+	}
+	if job != nil && job.State == releaseJobFailed {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		status.Reason = job.Reason
+		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
+		return status
+	}
+	metrics.QueryCoordReleaseCount.WithLabelValues(metrics.SuccessLabel).Inc()
+	return status
+}
+
+// waitReleaseCollection waits for the release collection task to finish and records the result
+func (qc *QueryCoord) waitReleaseCollection(releaseCollectionTask *releaseCollectionTask) *commonpb.Status {
+	req := releaseCollectionTask.ReleaseCollectionRequest
+	collectionID := req.CollectionID
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
 
-	err = releaseCollectionTask.waitToFinish()
+	err := releaseCollectionTask.waitToFinish()
 	if err != nil {
 		log.Error("release collection from query nodes failed",
 			zap.String("role", typeutil.QueryCoordRole),
@@ -341,7 +446,7 @@ func (qc *QueryCoord) ReleaseCollection(ctx context.Context, req *querypb.Releas
 		status.Reason = err.Error()
 
 		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
-		return status, nil
+		return status
 	}
 
 	log.Info("releaseCollectionRequest completed",
@@ -353,7 +458,7 @@ func (qc *QueryCoord) ReleaseCollection(ctx context.Context, req *querypb.Releas
 
 	metrics.QueryCoordReleaseCount.WithLabelValues(metrics.SuccessLabel).Inc()
 	metrics.QueryCoordReleaseLatency.WithLabelValues().Observe(float64(releaseCollectionTask.elapseSpan().Milliseconds()))
-	return status, nil
+	return status
 }
 
 // ShowPartitions return all the partitions that have been loaded
@@ -486,6 +591,25 @@ func (qc *QueryCoord) LoadPartitions(ctx context.Context, req *querypb.LoadParti
 		return status, nil
 	}
 
+	schema, err := qc.getLoadSchema(collectionID, req.Schema, req.GetLoadFields())
+	if err != nil {
+		status.ErrorCode = commonpb.ErrorCode_IllegalArgument
+		status.Reason = err.Error()
+		log.Warn("loadPartitionRequest failed",
+			zap.String("role", typeutil.QueryCoordRole),
+			zap.Int64("collectionID", req.CollectionID),
+			zap.Int64("msgID", req.Base.MsgID),
+			zap.Error(err))
+
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel).Inc()
+		return status, nil
+	}
+	req.Schema = schema
+	req.ReplicaNumber = getLoadReplicaNumber(req.ReplicaNumber, schema)
+	if err := qc.broker.prioritizeIndexBuild(ctx, collectionID); err != nil {
+		log.Warn("failed to prioritize the index builds of collection", zap.Int64("collectionID", collectionID), zap.Error(err))
+	}
+
 	if collectionInfo, err := qc.meta.getCollectionInfoByID(collectionID); err == nil {
 		// if the collection has been loaded into memory by load collection request, return error
 		// should release collection first, then load partitions again
@@ -563,7 +687,7 @@ func (qc *QueryCoord) LoadPartitions(ctx context.Context, req *querypb.LoadParti
 		cluster:               qc.cluster,
 		meta:                  qc.meta,
 	}
-	err := qc.scheduler.Enqueue(loadPartitionTask)
+	err = qc.scheduler.Enqueue(loadPartitionTask)
 	if err != nil {
 		log.Error("loadPartitionRequest failed to add execute task to scheduler",
 			zap.String("role", typeutil.QueryCoordRole),
@@ -1042,6 +1166,26 @@ func (qc *QueryCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRe
 		getMetricsResponse.Status.ErrorCode = commonpb.ErrorCode_Success
 		return getMetricsResponse, nil
 	}
+	if metricType == metricsinfo.CollectionLifecycleMetrics {
+		lifecycles, err := json.Marshal(qc.scheduler.lifecycle.getAll())
+		if err != nil {
+			getMetricsResponse.Status.Reason = err.Error()
+			return getMetricsResponse, nil
+		}
+		getMetricsResponse.Response = string(lifecycles)
+		getMetricsResponse.Status.ErrorCode = commonpb.ErrorCode_Success
+		return getMetricsResponse, nil
+	}
+	if metricType == metricsinfo.ShardClustersMetrics {
+		shardClusters, err := getShardClustersMetrics(ctx, req, qc)
+		if err != nil {
+			getMetricsResponse.Status.Reason = err.Error()
+			return getMetricsResponse, nil
+		}
+		getMetricsResponse.Response = shardClusters
+		getMetricsResponse.Status.ErrorCode = commonpb.ErrorCode_Success
+		return getMetricsResponse, nil
+	}
 	err = errors.New(metricsinfo.MsgUnimplementedMetric)
 	getMetricsResponse.Status.Reason = err.Error()
 
@@ -1153,45 +1297,44 @@ func (qc *QueryCoord) GetShardLeaders(ctx context.Context, req *querypb.GetShard
 		}, nil
 	}
 
+	standbyReplicas, err := getStandbyReplicas(qc.kvClient, req.CollectionID)
+	if err != nil {
+		// serving the requests by the standby replicas is better than failing them
+		log.Warn("GetShardLeaders failed to get standby replicas, all replicas are routed",
+			zap.Int64("collectionID", req.CollectionID),
+			zap.Int64("msgID", req.Base.MsgID),
+			zap.Error(err))
+	}
+
 	shards := make(map[string]*querypb.ShardLeadersList)
 	shardNodes := getShardNodes(req.CollectionID, qc.meta)
+	var standbys []*milvuspb.ReplicaInfo
 	for _, replica := range replicas {
-		for _, shard := range replica.ShardReplicas {
-			list, ok := shards[shard.DmChannelName]
-			if !ok {
-				list = &querypb.ShardLeadersList{
-					ChannelName: shard.DmChannelName,
-					NodeIds:     make([]int64, 0),
-					NodeAddrs:   make([]string, 0),
-				}
-			}
-
-			isShardAvailable, err := qc.cluster.isOnline(shard.LeaderID)
-			if err != nil || !isShardAvailable {
-				log.Warn("shard leader is unavailable",
-					zap.Int64("collectionID", replica.CollectionID),
-					zap.Int64("replicaID", replica.ReplicaID),
-					zap.String("DmChannel", shard.DmChannelName),
-					zap.Int64("shardLeaderID", shard.LeaderID),
-					zap.Error(err))
-				continue
-			}
-
-			nodes := shardNodes[shard.DmChannelName]
-			for _, nodeID := range replica.NodeIds {
-				if _, ok := nodes[nodeID]; ok {
-					if ok, err := qc.cluster.isOnline(nodeID); err != nil || !ok {
-						isShardAvailable = false
-						break
-					}
-				}
-			}
+		if _, ok := standbyReplicas[replica.ReplicaID]; ok {
+			standbys = append(standbys, replica)
+			continue
+		}
+		qc.appendShardLeaders(shards, replica, shardNodes, nil)
+	}
 
-			if isShardAvailable {
-				list.NodeIds = append(list.NodeIds, shard.LeaderID)
-				list.NodeAddrs = append(list.NodeAddrs, shard.LeaderAddr)
-				shards[shard.DmChannelName] = list
-			}
+	// fail over to the standby replicas for the shards which have no serving replica available
+	for _, replica := range standbys {
+		channels := qc.appendShardLeaders(shards, replica, shardNodes, func(channel string) bool {
+			_, ok := shards[channel]
+			return !ok
+		})
+		if len(channels) == 0 {
+			continue
+		}
+		log.Info("promote standby replica for unavailable shards",
+			zap.Int64("collectionID", replica.CollectionID),
+			zap.Int64("replicaID", replica.ReplicaID),
+			zap.Strings("DmChannels", channels))
+		if err := promoteStandbyReplica(qc.kvClient, replica.CollectionID, replica.ReplicaID); err != nil {
+			log.Warn("failed to promote standby replica",
+				zap.Int64("collectionID", replica.CollectionID),
+				zap.Int64("replicaID", replica.ReplicaID),
+				zap.Error(err))
 		}
 	}
 
@@ -1220,3 +1363,372 @@ func (qc *QueryCoord) GetShardLeaders(ctx context.Context, req *querypb.GetShard
 		Shards: shardLeaderLists,
 	}, nil
 }
+
+// appendShardLeaders appends the available shard leaders of the replica to shards,
+// only the shards accepted by accept are appended if it's not nil, returns the DmChannels appended.
+func (qc *QueryCoord) appendShardLeaders(shards map[string]*querypb.ShardLeadersList, replica *milvuspb.ReplicaInfo,
+	shardNodes map[string]map[UniqueID]struct{}, accept func(channel string) bool) []string {
+	var channels []string
+	for _, shard := range replica.ShardReplicas {
+		if accept != nil && !accept(shard.DmChannelName) {
+			continue
+		}
+		list, ok := shards[shard.DmChannelName]
+		if !ok {
+			list = &querypb.ShardLeadersList{
+				ChannelName: shard.DmChannelName,
+				NodeIds:     make([]int64, 0),
+				NodeAddrs:   make([]string, 0),
+			}
+		}
+
+		isShardAvailable, err := qc.cluster.isOnline(shard.LeaderID)
+		if err != nil || !isShardAvailable {
+			log.Warn("shard leader is unavailable",
+				zap.Int64("collectionID", replica.CollectionID),
+				zap.Int64("replicaID", replica.ReplicaID),
+				zap.String("DmChannel", shard.DmChannelName),
+				zap.Int64("shardLeaderID", shard.LeaderID),
+				zap.Error(err))
+			continue
+		}
+
+		nodes := shardNodes[shard.DmChannelName]
+		for _, nodeID := range replica.NodeIds {
+			if _, ok := nodes[nodeID]; ok {
+				if ok, err := qc.cluster.isOnline(nodeID); err != nil || !ok {
+					isShardAvailable = false
+					break
+				}
+			}
+		}
+
+		if isShardAvailable {
+			list.NodeIds = append(list.NodeIds, shard.LeaderID)
+			list.NodeAddrs = append(list.NodeAddrs, shard.LeaderAddr)
+			shards[shard.DmChannelName] = list
+			channels = append(channels, shard.DmChannelName)
+		}
+	}
+	return channels
+}
+
+// SyncNewCreatedPartition adds the partition created to the collection loaded by LoadCollection, so the partition
+// is served without loading the collection again. It's a no-op if the collection is not loaded or loaded by partitions.
+func (qc *QueryCoord) SyncNewCreatedPartition(ctx context.Context, req *querypb.SyncNewCreatedPartitionRequest) (*commonpb.Status, error) {
+	log.Info("SyncNewCreatedPartition received",
+		zap.String("role", typeutil.QueryCoordRole),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("partitionID", req.GetPartitionID()),
+		zap.Int64("msgID", req.GetBase().GetMsgID()))
+
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("QueryCoord is not healthy")
+		status.Reason = err.Error()
+		log.Warn("SyncNewCreatedPartition failed", zap.String("role", typeutil.QueryCoordRole), zap.Error(err))
+		return status, nil
+	}
+
+	if err := qc.syncNewCreatedPartition(ctx, req); err != nil {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		status.Reason = err.Error()
+		log.Warn("SyncNewCreatedPartition failed",
+			zap.String("role", typeutil.QueryCoordRole),
+			zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Int64("partitionID", req.GetPartitionID()),
+			zap.Error(err))
+		return status, nil
+	}
+	return status, nil
+}
+
+func (qc *QueryCoord) syncNewCreatedPartition(ctx context.Context, req *querypb.SyncNewCreatedPartitionRequest) error {
+	collectionID, partitionID := req.GetCollectionID(), req.GetPartitionID()
+	info, err := qc.meta.getCollectionInfoByID(collectionID)
+	if err != nil || info.GetLoadType() != querypb.LoadType_LoadCollection {
+		// the new partitions are loaded only by LoadPartitions if the collection is loaded by partitions
+		return nil
+	}
+	if !qc.meta.hasPartition(collectionID, partitionID) {
+		if err := qc.meta.addPartitions(collectionID, []UniqueID{partitionID}); err != nil {
+			return err
+		}
+		// the new partition has nothing to load, it's as loaded as the collection
+		if err := qc.meta.setLoadPercentage(collectionID, partitionID, info.GetInMemoryPercentage(), querypb.LoadType_LoadCollection); err != nil {
+			return err
+		}
+	}
+
+	replicas, err := qc.meta.getReplicasByCollectionID(collectionID)
+	if err != nil {
+		return err
+	}
+	refreshReq := &querypb.LoadPartitionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_LoadPartitions,
+			MsgID:    req.GetBase().GetMsgID(),
+			SourceID: qc.session.ServerID,
+		},
+		CollectionID: collectionID,
+		PartitionIDs: []UniqueID{partitionID},
+	}
+	for _, replica := range replicas {
+		for _, nodeID := range replica.GetNodeIds() {
+			// the partition is added by the query nodes on the first insert anyway, refreshing is best effort
+			if err := qc.cluster.refreshCollection(ctx, nodeID, refreshReq); err != nil {
+				log.Warn("failed to refresh the collection on query node",
+					zap.Int64("collectionID", collectionID),
+					zap.Int64("partitionID", partitionID),
+					zap.Int64("nodeID", nodeID),
+					zap.Error(err))
+			}
+		}
+	}
+	return nil
+}
+
+// RefreshCollection replaces the schema of a loaded collection once it's changed by RootCoord, e.g. renamed or
+// altered, and sends it to the query nodes serving the collection. Only the load priority of the collection is
+// applied if it's not loaded.
+func (qc *QueryCoord) RefreshCollection(ctx context.Context, req *querypb.RefreshCollectionRequest) (*commonpb.Status, error) {
+	log.Info("RefreshCollection received",
+		zap.String("role", typeutil.QueryCoordRole),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("msgID", req.GetBase().GetMsgID()))
+
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("QueryCoord is not healthy")
+		status.Reason = err.Error()
+		log.Warn("RefreshCollection failed", zap.String("role", typeutil.QueryCoordRole), zap.Error(err))
+		return status, nil
+	}
+
+	if err := qc.refreshCollection(ctx, req); err != nil {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		status.Reason = err.Error()
+		log.Warn("RefreshCollection failed",
+			zap.String("role", typeutil.QueryCoordRole),
+			zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(err))
+		return status, nil
+	}
+	return status, nil
+}
+
+func (qc *QueryCoord) refreshCollection(ctx context.Context, req *querypb.RefreshCollectionRequest) error {
+	collectionID := req.GetCollectionID()
+	if req.GetSchema() == nil {
+		return fmt.Errorf("schema is required to refresh collection %d", collectionID)
+	}
+	info, err := qc.meta.getCollectionInfoByID(collectionID)
+	if err != nil {
+		// only the load priority is applied if the collection is not loaded, its load task may be queued
+		qc.refreshLoadPriority(collectionID, req.GetSchema())
+		return nil
+	}
+	qc.refreshLoadPriority(collectionID, req.GetSchema())
+	// the segments are loaded with the fields of the loaded schema, the fields added later are served once the
+	// collection is released and loaded again
+	schema := excludeAddedFields(info.GetSchema(), req.GetSchema())
+	if len(schema.GetFields()) != len(req.GetSchema().GetFields()) {
+		log.Info("the fields added to the loaded collection are served after it's loaded again",
+			zap.Int64("collectionID", collectionID))
+	}
+	if err := qc.meta.setCollectionSchema(collectionID, schema); err != nil {
+		return err
+	}
+
+	replicas, err := qc.meta.getReplicasByCollectionID(collectionID)
+	if err != nil {
+		return err
+	}
+	refreshReq := &querypb.LoadPartitionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_LoadCollection,
+			MsgID:    req.GetBase().GetMsgID(),
+			SourceID: qc.session.ServerID,
+		},
+		CollectionID: collectionID,
+		Schema:       schema,
+	}
+	for _, replica := range replicas {
+		for _, nodeID := range replica.GetNodeIds() {
+			// the query nodes get the schema saved above once they load the collection again
+			if err := qc.cluster.refreshCollection(ctx, nodeID, refreshReq); err != nil {
+				log.Warn("failed to refresh the collection on query node",
+					zap.Int64("collectionID", collectionID),
+					zap.Int64("nodeID", nodeID),
+					zap.Error(err))
+			}
+		}
+	}
+	return nil
+}
+
+// excludeAddedFields returns the schema without the fields not in the loaded schema
+func excludeAddedFields(loaded *schemapb.CollectionSchema, schema *schemapb.CollectionSchema) *schemapb.CollectionSchema {
+	loadedFields := make(map[int64]struct{}, len(loaded.GetFields()))
+	for _, field := range loaded.GetFields() {
+		loadedFields[field.GetFieldID()] = struct{}{}
+	}
+	fields := make([]*schemapb.FieldSchema, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		if _, ok := loadedFields[field.GetFieldID()]; ok {
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) == len(schema.GetFields()) {
+		return schema
+	}
+	ret := proto.Clone(schema).(*schemapb.CollectionSchema)
+	ret.Fields = fields
+	return ret
+}
+
+// GetLoadingProgress returns the loaded percentage and the segments pending to load of the collection
+func (qc *QueryCoord) GetLoadingProgress(ctx context.Context, req *querypb.GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error) {
+	log.Info("GetLoadingProgress received",
+		zap.String("role", typeutil.QueryCoordRole),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("msgID", req.GetBase().GetMsgID()))
+
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("QueryCoord is not healthy")
+		status.Reason = err.Error()
+		log.Warn("GetLoadingProgress failed", zap.String("role", typeutil.QueryCoordRole), zap.Error(err))
+		return &milvuspb.GetLoadingProgressResponse{
+			Status: status,
+		}, nil
+	}
+
+	progress, err := qc.getLoadingProgress(ctx, req.GetCollectionID())
+	if err != nil {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		status.Reason = err.Error()
+		log.Warn("GetLoadingProgress failed",
+			zap.String("role", typeutil.QueryCoordRole),
+			zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(err))
+		return &milvuspb.GetLoadingProgressResponse{
+			Status: status,
+		}, nil
+	}
+	progress.Status = status
+	return progress, nil
+}
+
+// GetReleaseJobs returns the release jobs of the collection, or of all the collections if the collection id is 0,
+// the asynchronous releases are polled by them
+func (qc *QueryCoord) GetReleaseJobs(ctx context.Context, req *querypb.GetReleaseJobsRequest) (*milvuspb.GetReleaseJobsResponse, error) {
+	log.Info("GetReleaseJobs received",
+		zap.String("role", typeutil.QueryCoordRole),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("msgID", req.GetBase().GetMsgID()))
+
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("QueryCoord is not healthy")
+		status.Reason = err.Error()
+		log.Warn("GetReleaseJobs failed", zap.String("role", typeutil.QueryCoordRole), zap.Error(err))
+		return &milvuspb.GetReleaseJobsResponse{
+			Status: status,
+		}, nil
+	}
+
+	jobs := qc.scheduler.releaseJobs.getAll(req.GetCollectionID())
+	resp := &milvuspb.GetReleaseJobsResponse{
+		Status: status,
+		Jobs:   make([]*milvuspb.ReleaseJob, 0, len(jobs)),
+	}
+	for i := range jobs {
+		resp.Jobs = append(resp.Jobs, jobs[i].toProto())
+	}
+	return resp, nil
+}
+
+// CordonNode stops assigning segments and channels to the query node, and drains the ones it serves off to the other nodes
+func (qc *QueryCoord) CordonNode(ctx context.Context, req *milvuspb.CordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	log.Info("CordonNode received",
+		zap.String("role", typeutil.QueryCoordRole),
+		zap.Int64("nodeID", req.GetNodeID()),
+		zap.Int64("msgID", req.GetBase().GetMsgID()))
+
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		return qc.cordonedNodesResponse("CordonNode", errors.New("QueryCoord is not healthy")), nil
+	}
+	if req.GetNodeID() <= 0 {
+		return qc.cordonedNodesResponse("CordonNode", fmt.Errorf("invalid node id %d", req.GetNodeID())), nil
+	}
+	return qc.cordonedNodesResponse("CordonNode", qc.cluster.cordonNode(req.GetNodeID())), nil
+}
+
+// UncordonNode makes the cordoned query node assignable again
+func (qc *QueryCoord) UncordonNode(ctx context.Context, req *milvuspb.UncordonNodeRequest) (*milvuspb.CordonedNodesResponse, error) {
+	log.Info("UncordonNode received",
+		zap.String("role", typeutil.QueryCoordRole),
+		zap.Int64("nodeID", req.GetNodeID()),
+		zap.Int64("msgID", req.GetBase().GetMsgID()))
+
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		return qc.cordonedNodesResponse("UncordonNode", errors.New("QueryCoord is not healthy")), nil
+	}
+	if req.GetNodeID() <= 0 {
+		return qc.cordonedNodesResponse("UncordonNode", fmt.Errorf("invalid node id %d", req.GetNodeID())), nil
+	}
+	return qc.cordonedNodesResponse("UncordonNode", qc.uncordonNode(req.GetNodeID())), nil
+}
+
+// GetCordonedNodes returns the cordoned query nodes and their drain states
+func (qc *QueryCoord) GetCordonedNodes(ctx context.Context, req *milvuspb.GetCordonedNodesRequest) (*milvuspb.CordonedNodesResponse, error) {
+	log.Info("GetCordonedNodes received",
+		zap.String("role", typeutil.QueryCoordRole),
+		zap.Int64("msgID", req.GetBase().GetMsgID()))
+
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		return qc.cordonedNodesResponse("GetCordonedNodes", errors.New("QueryCoord is not healthy")), nil
+	}
+	return qc.cordonedNodesResponse("GetCordonedNodes", nil), nil
+}
+
+// Balance plans a balance round of the query nodes on demand and returns the planned moves,
+// the moves are executed in the background unless it's a dry run
+func (qc *QueryCoord) Balance(ctx context.Context, req *milvuspb.BalanceRequest) (*milvuspb.BalanceResponse, error) {
+	log.Info("Balance received",
+		zap.String("role", typeutil.QueryCoordRole),
+		zap.Bool("dryRun", req.GetDryRun()),
+		zap.Int64("msgID", req.GetBase().GetMsgID()))
+
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		err := errors.New("QueryCoord is not healthy")
+		log.Warn("Balance failed", zap.String("role", typeutil.QueryCoordRole), zap.Error(err))
+		return &milvuspb.BalanceResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	return &milvuspb.BalanceResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		DryRun: req.GetDryRun(),
+		Moves:  qc.balance(ctx, req.GetDryRun()),
+	}, nil
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -28,13 +29,17 @@ import (
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"go.uber.org/zap"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func waitLoadPartitionDone(ctx context.Context, queryCoord *QueryCoord, collectionID UniqueID, partitionIDs []UniqueID) error {
@@ -344,6 +349,20 @@ func TestGrpcTask(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Test GetReleaseJobs", func(t *testing.T) {
+		resp, err := queryCoord.GetReleaseJobs(ctx, &querypb.GetReleaseJobsRequest{
+			Base:         &commonpb.MsgBase{},
+			CollectionID: defaultCollectionID,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.NotEmpty(t, resp.Jobs)
+		for _, job := range resp.Jobs {
+			assert.Equal(t, defaultCollectionID, job.CollectionID)
+			assert.Equal(t, milvuspb.ReleaseJobState_ReleaseJobCompleted, job.State)
+		}
+	})
+
 	t.Run("Test GetStatisticsChannel", func(t *testing.T) {
 		_, err = queryCoord.GetStatisticsChannel(ctx)
 		assert.Nil(t, err)
@@ -792,6 +811,55 @@ func TestGrpcTaskBeforeHealthy(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
 	})
 
+	t.Run("Test GetLoadingProgress", func(t *testing.T) {
+		resp, err := unHealthyCoord.GetLoadingProgress(ctx, &querypb.GetLoadingProgressRequest{
+			Base:         &commonpb.MsgBase{},
+			CollectionID: defaultCollectionID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+	})
+
+	t.Run("Test GetReleaseJobs", func(t *testing.T) {
+		resp, err := unHealthyCoord.GetReleaseJobs(ctx, &querypb.GetReleaseJobsRequest{
+			Base:         &commonpb.MsgBase{},
+			CollectionID: defaultCollectionID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+	})
+
+	t.Run("Test CordonNode", func(t *testing.T) {
+		resp, err := unHealthyCoord.CordonNode(ctx, &milvuspb.CordonNodeRequest{
+			Base:   &commonpb.MsgBase{},
+			NodeID: 1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+
+		resp, err = unHealthyCoord.UncordonNode(ctx, &milvuspb.UncordonNodeRequest{
+			Base:   &commonpb.MsgBase{},
+			NodeID: 1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+
+		resp, err = unHealthyCoord.GetCordonedNodes(ctx, &milvuspb.GetCordonedNodesRequest{
+			Base: &commonpb.MsgBase{},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+	})
+
+	t.Run("Test Balance", func(t *testing.T) {
+		resp, err := unHealthyCoord.Balance(ctx, &milvuspb.BalanceRequest{
+			Base:   &commonpb.MsgBase{},
+			DryRun: true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+	})
+
 	t.Run("Test GetShardLeaders", func(t *testing.T) {
 		resp, err := unHealthyCoord.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
 			Base:         &commonpb.MsgBase{},
@@ -952,11 +1020,42 @@ func TestLoadCollectionWithReplicas(t *testing.T) {
 		assert.Equal(t, loadCollectionReq.CollectionID, replicas[i].CollectionID)
 	}
 
-	// Load the loaded collection with different replica number should fail
+	// Load the loaded collection with less replicas should remove the replicas online
 	loadCollectionReq.ReplicaNumber = 2
 	status, err = queryCoord.LoadCollection(ctx, loadCollectionReq)
 	assert.NoError(t, err)
-	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.ErrorCode)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	replicas, err = queryCoord.meta.getReplicasByCollectionID(loadCollectionReq.CollectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(replicas))
+	collectionInfo, err := queryCoord.meta.getCollectionInfoByID(loadCollectionReq.CollectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), collectionInfo.ReplicaNumber)
+	for _, segment := range queryCoord.meta.showSegmentInfos(loadCollectionReq.CollectionID, nil) {
+		assert.Equal(t, 2, len(segment.ReplicaIds))
+	}
+
+	// no enough querynodes to add 2 replicas
+	loadCollectionReq.ReplicaNumber = 4
+	status, err = queryCoord.LoadCollection(ctx, loadCollectionReq)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+	collectionInfo, err = queryCoord.meta.getCollectionInfoByID(loadCollectionReq.CollectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), collectionInfo.ReplicaNumber)
+
+	// Load the loaded collection with more replicas should add the replicas online
+	loadCollectionReq.ReplicaNumber = 3
+	status, err = queryCoord.LoadCollection(ctx, loadCollectionReq)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	replicas, err = queryCoord.meta.getReplicasByCollectionID(loadCollectionReq.CollectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(replicas))
+	assert.Eventually(t, func() bool {
+		standbyReplicas, err := getStandbyReplicas(queryCoord.kvClient, loadCollectionReq.CollectionID)
+		return err == nil && len(standbyReplicas) == 0
+	}, 10*time.Second, 100*time.Millisecond)
 
 	status, err = queryCoord.ReleaseCollection(ctx, &querypb.ReleaseCollectionRequest{
 		Base: &commonpb.MsgBase{
@@ -1248,6 +1347,242 @@ func Test_LoadAndReleaseCollection(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestSyncNewCreatedPartition(t *testing.T) {
+	refreshParams()
+	ctx := context.Background()
+	queryCoord, err := startQueryCoord(ctx)
+	assert.Nil(t, err)
+
+	node, err := startQueryNodeServer(ctx)
+	assert.Nil(t, err)
+	refreshed := make(chan struct{}, 1)
+	node.refreshCollection = func() (*commonpb.Status, error) {
+		refreshed <- struct{}{}
+		return returnSuccessResult()
+	}
+
+	waitQueryNodeOnline(queryCoord.cluster, node.queryNodeID)
+	newPartitionID := defaultPartitionID + 100
+	syncReq := &querypb.SyncNewCreatedPartitionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_CreatePartition,
+		},
+		CollectionID: defaultCollectionID,
+		PartitionID:  newPartitionID,
+	}
+
+	// no-op if the collection is not loaded
+	status, err := queryCoord.SyncNewCreatedPartition(ctx, syncReq)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	assert.False(t, queryCoord.meta.hasPartition(defaultCollectionID, newPartitionID))
+
+	loadCollectionReq := &querypb.LoadCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_LoadCollection,
+		},
+		CollectionID:  defaultCollectionID,
+		Schema:        genDefaultCollectionSchema(false),
+		ReplicaNumber: 1,
+	}
+	status, err = queryCoord.LoadCollection(ctx, loadCollectionReq)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	waitLoadCollectionDone(ctx, queryCoord, defaultCollectionID)
+
+	status, err = queryCoord.SyncNewCreatedPartition(ctx, syncReq)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	assert.True(t, queryCoord.meta.hasPartition(defaultCollectionID, newPartitionID))
+	partitionState, err := queryCoord.meta.getPartitionStatesByID(defaultCollectionID, newPartitionID)
+	assert.Nil(t, err)
+	assert.Equal(t, querypb.PartitionState_InMemory, partitionState.State)
+	select {
+	case <-refreshed:
+	default:
+		t.Error("the collection is not refreshed on the query node")
+	}
+
+	queryCoord.stateCode.Store(internalpb.StateCode_Abnormal)
+	status, err = queryCoord.SyncNewCreatedPartition(ctx, syncReq)
+	assert.Nil(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+	node.stop()
+	queryCoord.Stop()
+	err = removeAllSession()
+	assert.Nil(t, err)
+}
+
+func TestRefreshCollection(t *testing.T) {
+	refreshParams()
+	ctx := context.Background()
+	queryCoord, err := startQueryCoord(ctx)
+	assert.Nil(t, err)
+
+	node, err := startQueryNodeServer(ctx)
+	assert.Nil(t, err)
+	refreshed := make(chan struct{}, 1)
+	node.refreshCollection = func() (*commonpb.Status, error) {
+		refreshed <- struct{}{}
+		return returnSuccessResult()
+	}
+
+	waitQueryNodeOnline(queryCoord.cluster, node.queryNodeID)
+	schema := genDefaultCollectionSchema(false)
+	schema.Name = "renamed"
+	refreshReq := &querypb.RefreshCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_RenameCollection,
+		},
+		CollectionID: defaultCollectionID,
+		Schema:       schema,
+	}
+
+	// no-op if the collection is not loaded
+	status, err := queryCoord.RefreshCollection(ctx, refreshReq)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+	loadCollectionReq := &querypb.LoadCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_LoadCollection,
+		},
+		CollectionID:  defaultCollectionID,
+		Schema:        genDefaultCollectionSchema(false),
+		ReplicaNumber: 1,
+	}
+	status, err = queryCoord.LoadCollection(ctx, loadCollectionReq)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	waitLoadCollectionDone(ctx, queryCoord, defaultCollectionID)
+
+	status, err = queryCoord.RefreshCollection(ctx, &querypb.RefreshCollectionRequest{CollectionID: defaultCollectionID})
+	assert.Nil(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+	status, err = queryCoord.RefreshCollection(ctx, refreshReq)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	info, err := queryCoord.meta.getCollectionInfoByID(defaultCollectionID)
+	assert.Nil(t, err)
+	assert.Equal(t, "renamed", info.Schema.Name)
+	select {
+	case <-refreshed:
+	default:
+		t.Error("the collection is not refreshed on the query node")
+	}
+
+	// the fields added are excluded until the collection is loaded again
+	numFields := len(schema.Fields)
+	schema = proto.Clone(schema).(*schemapb.CollectionSchema)
+	schema.Name = "added"
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 1000, Name: "added", DataType: schemapb.DataType_Int64})
+	refreshReq.Schema = schema
+	status, err = queryCoord.RefreshCollection(ctx, refreshReq)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	info, err = queryCoord.meta.getCollectionInfoByID(defaultCollectionID)
+	assert.Nil(t, err)
+	assert.Equal(t, "added", info.Schema.Name)
+	assert.Len(t, info.Schema.Fields, numFields)
+
+	// the load priority property is applied at once, and reset once it's removed
+	schema = proto.Clone(schema).(*schemapb.CollectionSchema)
+	err = typeutil.SetCollectionProperties(schema, map[string]string{common.CollectionLoadPriorityParam: "5"})
+	assert.Nil(t, err)
+	refreshReq.Schema = schema
+	status, err = queryCoord.RefreshCollection(ctx, refreshReq)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	schema = proto.Clone(schema).(*schemapb.CollectionSchema)
+	err = typeutil.SetCollectionProperties(schema, map[string]string{common.CollectionLoadPriorityParam: ""})
+	assert.Nil(t, err)
+	refreshReq.Schema = schema
+	status, err = queryCoord.RefreshCollection(ctx, refreshReq)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+	queryCoord.stateCode.Store(internalpb.StateCode_Abnormal)
+	status, err = queryCoord.RefreshCollection(ctx, refreshReq)
+	assert.Nil(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+	node.stop()
+	queryCoord.Stop()
+	err = removeAllSession()
+	assert.Nil(t, err)
+}
+
+func Test_DuplicateReleaseCollection(t *testing.T) {
+	refreshParams()
+	ctx := context.Background()
+	queryCoord, err := startQueryCoord(ctx)
+	assert.Nil(t, err)
+
+	node, err := startQueryNodeServer(ctx)
+	assert.Nil(t, err)
+
+	waitQueryNodeOnline(queryCoord.cluster, node.queryNodeID)
+	loadCollectionReq := &querypb.LoadCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_LoadCollection,
+		},
+		CollectionID:  defaultCollectionID,
+		Schema:        genDefaultCollectionSchema(false),
+		ReplicaNumber: 1,
+	}
+	genReleaseCollectionReq := func(ts Timestamp) *querypb.ReleaseCollectionRequest {
+		return &querypb.ReleaseCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_ReleaseCollection,
+				Timestamp: ts,
+			},
+			CollectionID: defaultCollectionID,
+		}
+	}
+
+	status, err := queryCoord.LoadCollection(ctx, loadCollectionReq)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	waitLoadCollectionDone(ctx, queryCoord, defaultCollectionID)
+
+	// the duplicate releases attach to the running one
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			status, err := queryCoord.ReleaseCollection(ctx, genReleaseCollectionReq(100))
+			assert.Nil(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		}()
+	}
+	wg.Wait()
+	assert.False(t, queryCoord.meta.hasCollection(defaultCollectionID))
+
+	status, err = queryCoord.LoadCollection(ctx, loadCollectionReq)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	waitLoadCollectionDone(ctx, queryCoord, defaultCollectionID)
+
+	// the stale release doesn't release the collection loaded again
+	status, err = queryCoord.ReleaseCollection(ctx, genReleaseCollectionReq(50))
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	assert.True(t, queryCoord.meta.hasCollection(defaultCollectionID))
+
+	status, err = queryCoord.ReleaseCollection(ctx, genReleaseCollectionReq(200))
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	assert.False(t, queryCoord.meta.hasCollection(defaultCollectionID))
+
+	node.stop()
+	queryCoord.Stop()
+	err = removeAllSession()
+	assert.Nil(t, err)
+}
+
 func Test_LoadAndReleasePartitions(t *testing.T) {
 	refreshParams()
 	ctx := context.Background()
@@ -1724,3 +2059,82 @@ func TestGetShardLeaders(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, commonpb.ErrorCode_MetaFailed, resp.Status.ErrorCode)
 }
+
+func TestGetShardLeaders_standby(t *testing.T) {
+	refreshParams()
+	ctx := context.Background()
+	queryCoord, err := startQueryCoord(ctx)
+	assert.Nil(t, err)
+	defer queryCoord.Stop()
+
+	node1, err := startQueryNodeServer(ctx)
+	assert.Nil(t, err)
+	node2, err := startQueryNodeServer(ctx)
+	assert.Nil(t, err)
+	waitQueryNodeOnline(queryCoord.cluster, node1.queryNodeID)
+	waitQueryNodeOnline(queryCoord.cluster, node2.queryNodeID)
+	defer node1.stop()
+	defer node2.stop()
+	defer removeAllSession()
+
+	loadCollectionReq := &querypb.LoadCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_LoadCollection,
+		},
+		CollectionID:  defaultCollectionID,
+		Schema:        genDefaultCollectionSchema(false),
+		ReplicaNumber: 2,
+	}
+	status, err := queryCoord.LoadCollection(ctx, loadCollectionReq)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	waitLoadCollectionDone(ctx, queryCoord, defaultCollectionID)
+
+	replicas, err := queryCoord.meta.getReplicasByCollectionID(defaultCollectionID)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(replicas))
+	serving, standby := replicas[0], replicas[1]
+	err = queryCoord.kvClient.Save(standbyReplicaKey(defaultCollectionID, standby.ReplicaID), "")
+	require.NoError(t, err)
+
+	getShardLeadersReq := &querypb.GetShardLeadersRequest{
+		Base:         &commonpb.MsgBase{},
+		CollectionID: defaultCollectionID,
+	}
+	resp, err := queryCoord.GetShardLeaders(ctx, getShardLeadersReq)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	for _, shard := range resp.Shards {
+		assert.ElementsMatch(t, serving.NodeIds, shard.NodeIds)
+	}
+	standbyReplicas, err := getStandbyReplicas(queryCoord.kvClient, defaultCollectionID)
+	assert.NoError(t, err)
+	assert.Contains(t, standbyReplicas, standby.ReplicaID)
+
+	// the standby replica takes over the shards once the serving replica is down
+	for _, nodeID := range serving.NodeIds {
+		queryCoord.cluster.stopNode(nodeID)
+	}
+	resp, err = queryCoord.GetShardLeaders(ctx, getShardLeadersReq)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	for _, shard := range resp.Shards {
+		assert.ElementsMatch(t, standby.NodeIds, shard.NodeIds)
+	}
+	standbyReplicas, err = getStandbyReplicas(queryCoord.kvClient, defaultCollectionID)
+	assert.NoError(t, err)
+	assert.Empty(t, standbyReplicas)
+
+	// the standby replicas are removed with the collection
+	err = queryCoord.kvClient.Save(standbyReplicaKey(defaultCollectionID, serving.ReplicaID), "")
+	require.NoError(t, err)
+	status, err = queryCoord.ReleaseCollection(ctx, &querypb.ReleaseCollectionRequest{
+		Base:         &commonpb.MsgBase{},
+		CollectionID: defaultCollectionID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	standbyReplicas, err = getStandbyReplicas(queryCoord.kvClient, defaultCollectionID)
+	assert.NoError(t, err)
+	assert.Empty(t, standbyReplicas)
+}
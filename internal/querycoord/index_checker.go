@@ -26,6 +26,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 )
@@ -50,6 +51,11 @@ type IndexChecker struct {
 	unIndexedSegmentsChan chan *querypb.SegmentInfo
 	indexedSegmentsChan   chan *querypb.SegmentInfo
 
+	// pendingHandoffs are the indexed segments waiting for a handoff token, accessed by processHandoffAfterIndexDone only
+	pendingHandoffs *handoffQueue
+	// handoffTokens limits the number of the running handoff tasks
+	handoffTokens chan struct{}
+
 	meta      Meta
 	scheduler *TaskScheduler
 	cluster   Cluster
@@ -64,6 +70,10 @@ func newIndexChecker(ctx context.Context, client kv.MetaKv, meta Meta, cluster C
 	reqChan := make(chan *querypb.SegmentInfo, 1024)
 	unIndexChan := make(chan *querypb.SegmentInfo, 1024)
 	indexedChan := make(chan *querypb.SegmentInfo, 1024)
+	concurrency := Params.QueryCoordCfg.HandoffConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
 
 	checker := &IndexChecker{
 		ctx:    childCtx,
@@ -74,6 +84,9 @@ func newIndexChecker(ctx context.Context, client kv.MetaKv, meta Meta, cluster C
 		unIndexedSegmentsChan: unIndexChan,
 		indexedSegmentsChan:   indexedChan,
 
+		pendingHandoffs: newHandoffQueue(),
+		handoffTokens:   make(chan struct{}, concurrency),
+
 		meta:      meta,
 		scheduler: scheduler,
 		cluster:   cluster,
@@ -225,55 +238,74 @@ func (ic *IndexChecker) checkIndexLoop() {
 	}
 }
 
+// processHandoffAfterIndexDone hands off the indexed segments with at most HandoffConcurrency handoff tasks running,
+// the segments of the actively queried collections are handed off first
 func (ic *IndexChecker) processHandoffAfterIndexDone() {
 	defer ic.wg.Done()
 
 	for {
+		// only try to take a token if there is a segment to hand off
+		var handoffTokens chan struct{}
+		if ic.pendingHandoffs.len() > 0 {
+			handoffTokens = ic.handoffTokens
+		}
 		select {
 		case <-ic.ctx.Done():
 			return
 		case segmentInfo := <-ic.indexedSegmentsChan:
-			collectionID := segmentInfo.CollectionID
-			partitionID := segmentInfo.PartitionID
-			segmentID := segmentInfo.SegmentID
-			log.Info("processHandoffAfterIndexDone: handoff segment start", zap.Any("segmentInfo", segmentInfo))
-			baseTask := newBaseTask(ic.ctx, querypb.TriggerCondition_Handoff)
-			handoffReq := &querypb.HandoffSegmentsRequest{
-				Base: &commonpb.MsgBase{
-					MsgType: commonpb.MsgType_HandoffSegments,
-				},
-				SegmentInfos: []*querypb.SegmentInfo{segmentInfo},
-			}
-			handoffTask := &handoffTask{
-				baseTask:               baseTask,
-				HandoffSegmentsRequest: handoffReq,
-				broker:                 ic.broker,
-				cluster:                ic.cluster,
-				meta:                   ic.meta,
-			}
-			err := ic.scheduler.Enqueue(handoffTask)
-			if err != nil {
-				log.Error("processHandoffAfterIndexDone: handoffTask enqueue failed", zap.Error(err))
-				panic(err)
+			ic.pendingHandoffs.push(segmentInfo)
+		case handoffTokens <- struct{}{}:
+			if ic.cluster != nil && ic.pendingHandoffs.needRefresh() {
+				ic.pendingHandoffs.setCollectionQPS(getCollectionsQPS(ic.ctx, ic.cluster))
 			}
+			ic.handoff(ic.pendingHandoffs.pop())
+		}
+		metrics.QueryCoordNumPendingHandoffs.WithLabelValues().Set(float64(ic.pendingHandoffs.len()))
+	}
+}
 
-			go func() {
-				err := handoffTask.waitToFinish()
-				if err != nil {
-					// collection or partition may have been released before handoffTask enqueue
-					log.Warn("processHandoffAfterIndexDone: handoffTask failed", zap.Error(err))
-				}
-
-				log.Info("processHandoffAfterIndexDone: handoffTask completed", zap.Any("segment infos", handoffTask.SegmentInfos))
-			}()
+// handoff enqueues the handoff task of the segment, the token is returned after the task finished
+func (ic *IndexChecker) handoff(segmentInfo *querypb.SegmentInfo) {
+	collectionID := segmentInfo.CollectionID
+	partitionID := segmentInfo.PartitionID
+	segmentID := segmentInfo.SegmentID
+	log.Info("processHandoffAfterIndexDone: handoff segment start", zap.Any("segmentInfo", segmentInfo))
+	baseTask := newBaseTask(ic.ctx, querypb.TriggerCondition_Handoff)
+	handoffReq := &querypb.HandoffSegmentsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_HandoffSegments,
+		},
+		SegmentInfos: []*querypb.SegmentInfo{segmentInfo},
+	}
+	handoffTask := &handoffTask{
+		baseTask:               baseTask,
+		HandoffSegmentsRequest: handoffReq,
+		broker:                 ic.broker,
+		cluster:                ic.cluster,
+		meta:                   ic.meta,
+	}
+	err := ic.scheduler.Enqueue(handoffTask)
+	if err != nil {
+		log.Error("processHandoffAfterIndexDone: handoffTask enqueue failed", zap.Error(err))
+		panic(err)
+	}
 
-			// once task enqueue, etcd data can be cleaned, handoffTask will recover from taskScheduler's reloadFromKV()
-			buildQuerySegmentPath := fmt.Sprintf("%s/%d/%d/%d", handoffSegmentPrefix, collectionID, partitionID, segmentID)
-			err = ic.client.Remove(buildQuerySegmentPath)
-			if err != nil {
-				log.Error("processHandoffAfterIndexDone: remove handoff segment from etcd failed", zap.Error(err))
-				panic(err)
-			}
+	go func() {
+		defer func() { <-ic.handoffTokens }()
+		err := handoffTask.waitToFinish()
+		if err != nil {
+			// collection or partition may have been released before handoffTask enqueue
+			log.Warn("processHandoffAfterIndexDone: handoffTask failed", zap.Error(err))
 		}
+
+		log.Info("processHandoffAfterIndexDone: handoffTask completed", zap.Any("segment infos", handoffTask.SegmentInfos))
+	}()
+
+	// once task enqueue, etcd data can be cleaned, handoffTask will recover from taskScheduler's reloadFromKV()
+	buildQuerySegmentPath := fmt.Sprintf("%s/%d/%d/%d", handoffSegmentPrefix, collectionID, partitionID, segmentID)
+	err = ic.client.Remove(buildQuerySegmentPath)
+	if err != nil {
+		log.Error("processHandoffAfterIndexDone: remove handoff segment from etcd failed", zap.Error(err))
+		panic(err)
 	}
 }
@@ -0,0 +1,100 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// pruneSchema returns a copy of the schema with only the fields in names, the system fields and the primary key
+// are always kept. The schema is returned as is if names is empty.
+func pruneSchema(schema *schemapb.CollectionSchema, names []string) (*schemapb.CollectionSchema, error) {
+	if len(names) == 0 {
+		return schema, nil
+	}
+	toLoad := make(map[string]bool, len(names))
+	for _, name := range names {
+		toLoad[name] = false
+	}
+	pruned := proto.Clone(schema).(*schemapb.CollectionSchema)
+	pruned.Fields = make([]*schemapb.FieldSchema, 0, len(names))
+	for _, field := range schema.GetFields() {
+		_, ok := toLoad[field.GetName()]
+		if ok {
+			toLoad[field.GetName()] = true
+		}
+		if ok || field.GetFieldID() < common.StartOfUserFieldID || field.GetIsPrimaryKey() {
+			pruned.Fields = append(pruned.Fields, proto.Clone(field).(*schemapb.FieldSchema))
+		}
+	}
+	for name, found := range toLoad {
+		if !found {
+			return nil, fmt.Errorf("field %s to load not found in collection %s", name, schema.GetName())
+		}
+	}
+	return pruned, nil
+}
+
+// sameFields returns whether the two schemas have the same fields
+func sameFields(schema1, schema2 *schemapb.CollectionSchema) bool {
+	if len(schema1.GetFields()) != len(schema2.GetFields()) {
+		return false
+	}
+	for i, field := range schema1.GetFields() {
+		if field.GetFieldID() != schema2.GetFields()[i].GetFieldID() {
+			return false
+		}
+	}
+	return true
+}
+
+// filterLoadedFieldBinlogs returns the binlogs of the fields in the schema, all of them if the schema is nil
+func filterLoadedFieldBinlogs(fieldBinlogs []*datapb.FieldBinlog, schema *schemapb.CollectionSchema) []*datapb.FieldBinlog {
+	if schema == nil {
+		return fieldBinlogs
+	}
+	loaded := make(map[int64]struct{}, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		loaded[field.GetFieldID()] = struct{}{}
+	}
+	ret := make([]*datapb.FieldBinlog, 0, len(fieldBinlogs))
+	for _, fieldBinlog := range fieldBinlogs {
+		if _, ok := loaded[fieldBinlog.GetFieldID()]; ok {
+			ret = append(ret, fieldBinlog)
+		}
+	}
+	return ret
+}
+
+// getLoadSchema prunes the schema of the load request to the fields to load, and returns error if the collection
+// has been loaded with other fields. The loaded collection keeps its fields until it's released and loaded again.
+func (qc *QueryCoord) getLoadSchema(collectionID UniqueID, schema *schemapb.CollectionSchema, fields []string) (*schemapb.CollectionSchema, error) {
+	schema, err := pruneSchema(schema, fields)
+	if err != nil {
+		return nil, err
+	}
+	if collectionInfo, err := qc.meta.getCollectionInfoByID(collectionID); err == nil && !sameFields(collectionInfo.GetSchema(), schema) {
+		return nil, fmt.Errorf("collection %d has been loaded with other fields, please release it before loading fields %v", collectionID, fields)
+	}
+	return schema, nil
+}
@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func TestPruneSchema(t *testing.T) {
+	schema := genDefaultCollectionSchema(false)
+
+	pruned, err := pruneSchema(schema, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, schema, pruned)
+
+	// the system fields are always kept
+	pruned, err = pruneSchema(schema, []string{"vec"})
+	assert.Nil(t, err)
+	fieldIDs := make([]int64, 0, len(pruned.GetFields()))
+	for _, field := range pruned.GetFields() {
+		fieldIDs = append(fieldIDs, field.GetFieldID())
+	}
+	assert.Equal(t, []int64{0, 1, defaultVecFieldID}, fieldIDs)
+	assert.Equal(t, 4, len(schema.GetFields()))
+	assert.False(t, sameFields(schema, pruned))
+	assert.True(t, sameFields(pruned, pruned))
+
+	// the primary key is always kept
+	schema.Fields[2].IsPrimaryKey = true
+	pruned, err = pruneSchema(schema, []string{"vec"})
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(pruned.GetFields()))
+
+	_, err = pruneSchema(schema, []string{"vec", "not_exist"})
+	assert.NotNil(t, err)
+}
+
+func TestFilterLoadedFieldBinlogs(t *testing.T) {
+	fieldBinlogs := generateInsertBinLog(defaultSegmentID).GetFieldBinlogs()
+	assert.Equal(t, fieldBinlogs, filterLoadedFieldBinlogs(fieldBinlogs, nil))
+
+	schema, err := pruneSchema(genDefaultCollectionSchema(false), []string{"vec"})
+	assert.Nil(t, err)
+	filtered := filterLoadedFieldBinlogs(fieldBinlogs, schema)
+	assert.Equal(t, 3, len(filtered))
+	for _, fieldBinlog := range filtered {
+		assert.NotEqual(t, int64(100), fieldBinlog.GetFieldID())
+	}
+	assert.Less(t, estimateSegmentSize(&querypb.SegmentLoadInfo{BinlogPaths: filtered}),
+		estimateSegmentSize(&querypb.SegmentLoadInfo{BinlogPaths: fieldBinlogs}))
+	assert.Empty(t, filterLoadedFieldBinlogs([]*datapb.FieldBinlog{{FieldID: 100}}, schema))
+}
+
+func TestLoadFields(t *testing.T) {
+	refreshParams()
+	baseCtx := context.Background()
+
+	queryCoord, err := startQueryCoord(baseCtx)
+	assert.Nil(t, err)
+
+	queryNode, err := startQueryNodeServer(baseCtx)
+	assert.Nil(t, err)
+	waitQueryNodeOnline(queryCoord.cluster, queryNode.queryNodeID)
+
+	loadCollection := func(fields []string) *commonpb.Status {
+		status, err := queryCoord.LoadCollection(baseCtx, &querypb.LoadCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadCollection,
+			},
+			CollectionID:  defaultCollectionID,
+			Schema:        genDefaultCollectionSchema(false),
+			ReplicaNumber: 1,
+			LoadFields:    fields,
+		})
+		assert.Nil(t, err)
+		return status
+	}
+
+	// unknown fields fail the loading
+	status := loadCollection([]string{"not_exist"})
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+
+	status = loadCollection([]string{"vec"})
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Nil(t, waitLoadCollectionDone(baseCtx, queryCoord, defaultCollectionID))
+
+	collectionInfo, err := queryCoord.meta.getCollectionInfoByID(defaultCollectionID)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(collectionInfo.GetSchema().GetFields()))
+
+	// the loaded collection keeps its fields until it's released
+	status = loadCollection([]string{"vec"})
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	status = loadCollection(nil)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+
+	queryNode.stop()
+	queryCoord.Stop()
+	err = removeAllSession()
+	assert.Nil(t, err)
+}
@@ -0,0 +1,78 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// The load tasks of the collection with a higher priority are scheduled first, so that the critical collections
+// become queryable first when many of them are loaded, such as after the cluster restarts. The priority of a
+// collection is only set by its load_priority property, which is carried by the schema of the load request, and
+// applied to the queued load tasks once altered. The priority of the collection without the property is 0.
+
+// loadTaskCollectionID returns the collection loaded by the task, false if it's not a load task
+func loadTaskCollectionID(t task) (UniqueID, bool) {
+	switch t := t.(type) {
+	case *loadCollectionTask:
+		return t.CollectionID, true
+	case *loadPartitionTask:
+		return t.CollectionID, true
+	default:
+		return 0, false
+	}
+}
+
+// loadTaskSchema returns the schema of the collection loaded by the task, false if it's not a load task
+func loadTaskSchema(t task) (*schemapb.CollectionSchema, bool) {
+	switch t := t.(type) {
+	case *loadCollectionTask:
+		return t.GetSchema(), true
+	case *loadPartitionTask:
+		return t.GetSchema(), true
+	default:
+		return nil, false
+	}
+}
+
+// getLoadPriority returns the load priority property of the collection, 0 if it's not set or invalid
+func getLoadPriority(collectionID UniqueID, schema *schemapb.CollectionSchema) int64 {
+	priority, _, err := typeutil.GetCollectionLoadPriority(schema)
+	if err != nil {
+		log.Warn("invalid load priority of collection, ignored", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return 0
+	}
+	return priority
+}
+
+// assignLoadPriority sets the load priority property in the schema of the load task to the task before it's queued
+func (scheduler *TaskScheduler) assignLoadPriority(t task) {
+	if schema, ok := loadTaskSchema(t); ok {
+		collectionID, _ := loadTaskCollectionID(t)
+		t.setLoadPriority(getLoadPriority(collectionID, schema))
+	}
+}
+
+// setLoadPriority sets the load priority to the queued load tasks of the collection and reorders them
+func (scheduler *TaskScheduler) setLoadPriority(collectionID UniqueID, priority int64) {
+	scheduler.triggerTaskQueue.updateLoadPriority(collectionID, priority)
+	log.Info("load priority of collection updated", zap.Int64("collectionID", collectionID), zap.Int64("priority", priority))
+}
@@ -0,0 +1,134 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func genLoadTaskOfCollection(collectionID UniqueID, taskID UniqueID) *loadCollectionTask {
+	t := &loadCollectionTask{
+		baseTask: newBaseTask(context.Background(), querypb.TriggerCondition_GrpcRequest),
+		LoadCollectionRequest: &querypb.LoadCollectionRequest{
+			Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_LoadCollection},
+			CollectionID: collectionID,
+		},
+	}
+	t.setTaskID(taskID)
+	return t
+}
+
+func popTaskIDs(queue *taskQueue) []UniqueID {
+	var taskIDs []UniqueID
+	for t := queue.popTask(); t != nil; t = queue.popTask() {
+		taskIDs = append(taskIDs, t.getTaskID())
+	}
+	return taskIDs
+}
+
+func TestTaskQueue_LoadPriority(t *testing.T) {
+	queue := newTaskQueue()
+	for i, priority := range []int64{0, 10, 0, 5} {
+		task := genLoadTaskOfCollection(UniqueID(i), UniqueID(i))
+		task.setLoadPriority(priority)
+		queue.addTask(task)
+	}
+	// the node down handoff is still scheduled before the load tasks
+	nodeDownTask := &loadBalanceTask{baseTask: newBaseTask(context.Background(), querypb.TriggerCondition_NodeDown)}
+	nodeDownTask.setTaskID(4)
+	queue.addTask(nodeDownTask)
+	assert.Equal(t, []UniqueID{4, 1, 3, 0, 2}, popTaskIDs(queue))
+
+	for i := 0; i < 4; i++ {
+		queue.addTask(genLoadTaskOfCollection(UniqueID(i), UniqueID(i)))
+	}
+	queue.updateLoadPriority(2, 1)
+	assert.Equal(t, []UniqueID{2, 0, 1, 3}, popTaskIDs(queue))
+
+	// the task done before restart is kept in the front
+	doneTask := genLoadTaskOfCollection(0, 0)
+	doneTask.setState(taskDone)
+	queue.addTaskToFront(doneTask)
+	queue.addTask(genLoadTaskOfCollection(1, 1))
+	queue.updateLoadPriority(1, 1)
+	assert.Equal(t, []UniqueID{0, 1}, popTaskIDs(queue))
+}
+
+func TestAssignLoadPriority(t *testing.T) {
+	scheduler := &TaskScheduler{triggerTaskQueue: newTaskQueue()}
+
+	task := genLoadTaskOfCollection(1, 1)
+	scheduler.assignLoadPriority(task)
+	assert.Equal(t, int64(0), task.getLoadPriority())
+
+	// the priority is read from the schema carried by the load request, so it's kept after restart
+	schema := &schemapb.CollectionSchema{}
+	err := typeutil.SetCollectionProperties(schema, map[string]string{common.CollectionLoadPriorityParam: "10"})
+	assert.Nil(t, err)
+	task.Schema = schema
+	scheduler.assignLoadPriority(task)
+	assert.Equal(t, int64(10), task.getLoadPriority())
+
+	partitionTask := &loadPartitionTask{
+		baseTask: newBaseTask(context.Background(), querypb.TriggerCondition_GrpcRequest),
+		LoadPartitionsRequest: &querypb.LoadPartitionsRequest{
+			CollectionID: 2,
+			Schema:       schema,
+		},
+	}
+	scheduler.assignLoadPriority(partitionTask)
+	assert.Equal(t, int64(10), partitionTask.getLoadPriority())
+
+	// the invalid priority is ignored
+	invalid := &schemapb.CollectionSchema{Properties: []*commonpb.KeyValuePair{
+		{Key: common.CollectionLoadPriorityParam, Value: "high"},
+	}}
+	task.Schema = invalid
+	scheduler.assignLoadPriority(task)
+	assert.Equal(t, int64(0), task.getLoadPriority())
+
+	// the other tasks are not changed
+	releaseTask := &releaseCollectionTask{
+		baseTask:                 newBaseTask(context.Background(), querypb.TriggerCondition_GrpcRequest),
+		ReleaseCollectionRequest: &querypb.ReleaseCollectionRequest{CollectionID: 1},
+	}
+	scheduler.assignLoadPriority(releaseTask)
+	assert.Equal(t, int64(0), releaseTask.getLoadPriority())
+
+	// the queued tasks are reordered once the property is altered, and reset once it's removed
+	qc := &QueryCoord{scheduler: scheduler}
+	tasks := make([]*loadCollectionTask, 0, 3)
+	for i := 0; i < 3; i++ {
+		tasks = append(tasks, genLoadTaskOfCollection(UniqueID(i), UniqueID(i)))
+		scheduler.triggerTaskQueue.addTask(tasks[i])
+	}
+	qc.refreshLoadPriority(2, schema)
+	assert.Equal(t, int64(10), tasks[2].getLoadPriority())
+	qc.refreshLoadPriority(2, &schemapb.CollectionSchema{})
+	assert.Equal(t, int64(0), tasks[2].getLoadPriority())
+	qc.refreshLoadPriority(1, schema)
+	assert.Equal(t, []UniqueID{1, 0, 2}, popTaskIDs(scheduler.triggerTaskQueue))
+}
@@ -0,0 +1,187 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func (scheduler *TaskScheduler) setProcessingTask(t task) {
+	scheduler.processingMu.Lock()
+	defer scheduler.processingMu.Unlock()
+	scheduler.processingTask = t
+}
+
+func (scheduler *TaskScheduler) getProcessingTask() task {
+	scheduler.processingMu.RLock()
+	defer scheduler.processingMu.RUnlock()
+	return scheduler.processingTask
+}
+
+// loadingSegments returns the segments to load by the processing load task of the collection,
+// the loaded ones are with the progress 1. False is returned if the collection is not being loaded.
+func (scheduler *TaskScheduler) loadingSegments(collectionID UniqueID) ([]*milvuspb.SegmentLoadingProgress, bool) {
+	var loadTask task
+	switch t := scheduler.getProcessingTask().(type) {
+	case *loadCollectionTask:
+		if t.CollectionID == collectionID {
+			loadTask = t
+		}
+	case *loadPartitionTask:
+		if t.CollectionID == collectionID {
+			loadTask = t
+		}
+	}
+	if loadTask == nil {
+		return nil, false
+	}
+
+	segments := make([]*milvuspb.SegmentLoadingProgress, 0)
+	for _, childTask := range loadTask.getChildTask() {
+		loadSegment, ok := childTask.(*loadSegmentTask)
+		if !ok {
+			continue
+		}
+		var progress float64
+		if loadSegment.getState() == taskDone {
+			progress = 1
+		}
+		for _, info := range loadSegment.Infos {
+			segments = append(segments, &milvuspb.SegmentLoadingProgress{
+				SegmentID:    info.SegmentID,
+				CollectionID: collectionID,
+				NodeID:       loadSegment.DstNodeID,
+				NumRows:      info.NumOfRows,
+				Progress:     progress,
+			})
+		}
+	}
+	return segments, true
+}
+
+// loadingPercentage returns the loaded percentage of the rows of the segments, weighted by the number of segments
+// if the rows are unknown. It's never 100 since the collection is loaded only after the load task is done.
+func loadingPercentage(segments []*milvuspb.SegmentLoadingProgress) int64 {
+	var loaded, total float64
+	for _, segment := range segments {
+		loaded += float64(segment.NumRows) * segment.Progress
+		total += float64(segment.NumRows)
+	}
+	if total == 0 {
+		for _, segment := range segments {
+			loaded += segment.Progress
+		}
+		total = float64(len(segments))
+	}
+	if total == 0 {
+		return 0
+	}
+	percentage := int64(loaded * 100 / total)
+	if percentage > 99 {
+		percentage = 99
+	}
+	return percentage
+}
+
+// inMemoryPercentage returns the loaded percentage of the collection, which is estimated by the loaded segments
+// while the collection is being loaded
+func (qc *QueryCoord) inMemoryPercentage(info *querypb.CollectionInfo) int64 {
+	if info.InMemoryPercentage >= 100 {
+		return info.InMemoryPercentage
+	}
+	segments, ok := qc.scheduler.loadingSegments(info.CollectionID)
+	if !ok || len(segments) == 0 {
+		return info.InMemoryPercentage
+	}
+	return loadingPercentage(segments)
+}
+
+// getLoadingProgress returns the loaded percentage and the pending segments of the collection, the progress of the
+// segments being loaded is collected from the query nodes.
+func (qc *QueryCoord) getLoadingProgress(ctx context.Context, collectionID UniqueID) (*milvuspb.GetLoadingProgressResponse, error) {
+	progress := &milvuspb.GetLoadingProgressResponse{
+		CollectionID:    collectionID,
+		PendingSegments: make([]*milvuspb.SegmentLoadingProgress, 0),
+	}
+	info, err := qc.meta.getCollectionInfoByID(collectionID)
+	segments, loading := qc.scheduler.loadingSegments(collectionID)
+	if err != nil && !loading {
+		return nil, fmt.Errorf("collection %d has not been loaded to memory or load failed", collectionID)
+	}
+	if err == nil && info.InMemoryPercentage >= 100 {
+		progress.Percentage = 100
+		return progress, nil
+	}
+	if len(segments) == 0 {
+		if info != nil {
+			progress.Percentage = info.InMemoryPercentage
+		}
+		return progress, nil
+	}
+
+	req := &querypb.GetLoadingProgressRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: qc.session.ServerID,
+		},
+		CollectionID: collectionID,
+	}
+	mergeLoadingProgress(progress, segments, qc.cluster.getSegmentLoadingProgress(ctx, req))
+	return progress, nil
+}
+
+// mergeLoadingProgress fills the progress of the segments being loaded reported by query nodes,
+// and computes the percentage and the pending segments
+func mergeLoadingProgress(progress *milvuspb.GetLoadingProgressResponse, segments []*milvuspb.SegmentLoadingProgress,
+	nodesProgress []queryNodeLoadingProgressResponse) {
+	nodeProgresses := make(map[UniqueID]*milvuspb.SegmentLoadingProgress)
+	for _, nodeProgress := range nodesProgress {
+		if nodeProgress.err != nil {
+			log.Warn("failed to get loading progress of query node", zap.Int64("nodeID", nodeProgress.nodeID), zap.Error(nodeProgress.err))
+			progress.Errors = append(progress.Errors, fmt.Sprintf("querynode%d: %s", nodeProgress.nodeID, nodeProgress.err.Error()))
+			continue
+		}
+		if nodeProgress.resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			log.Warn("failed to get loading progress of query node",
+				zap.Int64("nodeID", nodeProgress.nodeID),
+				zap.String("reason", nodeProgress.resp.GetStatus().GetReason()))
+			progress.Errors = append(progress.Errors, fmt.Sprintf("querynode%d: %s", nodeProgress.nodeID, nodeProgress.resp.GetStatus().GetReason()))
+			continue
+		}
+		for _, segmentProgress := range nodeProgress.resp.GetSegments() {
+			nodeProgresses[segmentProgress.GetSegmentID()] = segmentProgress
+		}
+	}
+
+	for _, segment := range segments {
+		if segment.Progress >= 1 {
+			continue
+		}
+		if nodeProgress, ok := nodeProgresses[segment.SegmentID]; ok && nodeProgress.GetNodeID() == segment.NodeID {
+			segment.Progress = nodeProgress.GetProgress()
+		}
+		progress.PendingSegments = append(progress.PendingSegments, segment)
+	}
+	progress.Percentage = loadingPercentage(segments)
+}
@@ -0,0 +1,162 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func TestLoadingSegments(t *testing.T) {
+	ctx := context.Background()
+	scheduler := &TaskScheduler{}
+	_, loading := scheduler.loadingSegments(defaultCollectionID)
+	assert.False(t, loading)
+
+	loadTask := &loadCollectionTask{
+		baseTask:              newBaseTask(ctx, querypb.TriggerCondition_GrpcRequest),
+		LoadCollectionRequest: &querypb.LoadCollectionRequest{CollectionID: defaultCollectionID},
+	}
+	genLoadSegmentTask := func(nodeID int64, infos ...*querypb.SegmentLoadInfo) *loadSegmentTask {
+		return &loadSegmentTask{
+			baseTask: newBaseTask(ctx, querypb.TriggerCondition_GrpcRequest),
+			LoadSegmentsRequest: &querypb.LoadSegmentsRequest{
+				DstNodeID: nodeID,
+				Infos:     infos,
+			},
+		}
+	}
+	loaded := genLoadSegmentTask(1, &querypb.SegmentLoadInfo{SegmentID: 1, NumOfRows: 100})
+	loaded.setState(taskDone)
+	loadTask.addChildTask(loaded)
+	loadTask.addChildTask(genLoadSegmentTask(2,
+		&querypb.SegmentLoadInfo{SegmentID: 2, NumOfRows: 200},
+		&querypb.SegmentLoadInfo{SegmentID: 3, NumOfRows: 100}))
+	loadTask.addChildTask(&watchDmChannelTask{baseTask: newBaseTask(ctx, querypb.TriggerCondition_GrpcRequest)})
+	scheduler.setProcessingTask(loadTask)
+
+	_, loading = scheduler.loadingSegments(defaultCollectionID + 1)
+	assert.False(t, loading)
+	segments, loading := scheduler.loadingSegments(defaultCollectionID)
+	assert.True(t, loading)
+	assert.Equal(t, 3, len(segments))
+	assert.Equal(t, float64(1), segments[0].Progress)
+	assert.Equal(t, int64(2), segments[1].NodeID)
+	assert.Equal(t, float64(0), segments[1].Progress)
+	assert.Equal(t, int64(25), loadingPercentage(segments))
+
+	qc := &QueryCoord{scheduler: scheduler}
+	assert.Equal(t, int64(25), qc.inMemoryPercentage(&querypb.CollectionInfo{CollectionID: defaultCollectionID}))
+	assert.Equal(t, int64(100), qc.inMemoryPercentage(&querypb.CollectionInfo{CollectionID: defaultCollectionID, InMemoryPercentage: 100}))
+	assert.Equal(t, int64(0), qc.inMemoryPercentage(&querypb.CollectionInfo{CollectionID: defaultCollectionID + 1}))
+
+	scheduler.setProcessingTask(nil)
+	_, loading = scheduler.loadingSegments(defaultCollectionID)
+	assert.False(t, loading)
+}
+
+func TestLoadingPercentage(t *testing.T) {
+	assert.Equal(t, int64(0), loadingPercentage(nil))
+	assert.Equal(t, int64(50), loadingPercentage([]*milvuspb.SegmentLoadingProgress{
+		{SegmentID: 1, Progress: 1},
+		{SegmentID: 2, Progress: 0},
+	}))
+	assert.Equal(t, int64(99), loadingPercentage([]*milvuspb.SegmentLoadingProgress{
+		{SegmentID: 1, NumRows: 100, Progress: 1},
+	}))
+}
+
+func TestMergeLoadingProgress(t *testing.T) {
+	genResponse := func(nodeID int64, progresses ...*milvuspb.SegmentLoadingProgress) queryNodeLoadingProgressResponse {
+		return queryNodeLoadingProgressResponse{
+			nodeID: nodeID,
+			resp: &querypb.GetSegmentLoadingProgressResponse{
+				Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				Segments: progresses,
+			},
+		}
+	}
+
+	segments := []*milvuspb.SegmentLoadingProgress{
+		{SegmentID: 1, NodeID: 1, NumRows: 100, Progress: 1},
+		{SegmentID: 2, NodeID: 2, NumRows: 100},
+		{SegmentID: 3, NodeID: 2, NumRows: 200},
+	}
+	progress := &milvuspb.GetLoadingProgressResponse{CollectionID: defaultCollectionID}
+	mergeLoadingProgress(progress, segments, []queryNodeLoadingProgressResponse{
+		genResponse(2, &milvuspb.SegmentLoadingProgress{SegmentID: 2, NodeID: 2, Progress: 0.5}),
+		// the segment loaded by another node is ignored
+		genResponse(3, &milvuspb.SegmentLoadingProgress{SegmentID: 3, NodeID: 3, Progress: 0.9}),
+		{nodeID: 4, err: errors.New("node down")},
+		{nodeID: 5, resp: &querypb.GetSegmentLoadingProgressResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "not healthy"},
+		}},
+	})
+	assert.Equal(t, int64(37), progress.Percentage)
+	assert.Equal(t, 2, len(progress.PendingSegments))
+	assert.Equal(t, 0.5, progress.PendingSegments[0].Progress)
+	assert.Equal(t, float64(0), progress.PendingSegments[1].Progress)
+	assert.Equal(t, 2, len(progress.Errors))
+	assert.Equal(t, "querynode5: not healthy", progress.Errors[1])
+}
+
+func TestGetLoadingProgress(t *testing.T) {
+	refreshParams()
+	ctx := context.Background()
+	queryCoord, err := startQueryCoord(ctx)
+	assert.NoError(t, err)
+	node, err := startQueryNodeServer(ctx)
+	assert.NoError(t, err)
+	waitQueryNodeOnline(queryCoord.cluster, node.queryNodeID)
+
+	req := &querypb.GetLoadingProgressRequest{
+		Base:         &commonpb.MsgBase{},
+		CollectionID: defaultCollectionID,
+	}
+	resp, err := queryCoord.GetLoadingProgress(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+
+	status, err := queryCoord.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_LoadCollection,
+		},
+		CollectionID: defaultCollectionID,
+		Schema:       genDefaultCollectionSchema(false),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	waitLoadCollectionDone(ctx, queryCoord, defaultCollectionID)
+
+	resp, err = queryCoord.GetLoadingProgress(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	assert.Equal(t, int64(100), resp.Percentage)
+	assert.Empty(t, resp.PendingSegments)
+
+	node.stop()
+	queryCoord.Stop()
+	err = removeAllSession()
+	assert.NoError(t, err)
+}
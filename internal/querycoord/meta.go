@@ -82,6 +82,11 @@ type Meta interface {
 
 	getDmChannelInfosByNodeID(nodeID int64) []*querypb.DmChannelWatchInfo
 	setDmChannelInfos(channelInfos []*querypb.DmChannelWatchInfo) error
+	removeDmChannelNode(dmChannel string, nodeID int64) error
+
+	saveBalanceChannels(msgID UniqueID, channels []string) error
+	getBalanceChannels(msgID UniqueID) ([]string, error)
+	removeBalanceChannels(msgID UniqueID) error
 
 	getDeltaChannelsByCollectionID(collectionID UniqueID) ([]*datapb.VchannelInfo, error)
 	setDeltaChannel(collectionID UniqueID, info []*datapb.VchannelInfo) error
@@ -90,6 +95,7 @@ type Meta interface {
 	getQueryStreamByID(collectionID UniqueID, queryChannel string) (msgstream.MsgStream, error)
 
 	setLoadType(collectionID UniqueID, loadType querypb.LoadType) error
+	setCollectionSchema(collectionID UniqueID, schema *schemapb.CollectionSchema) error
 	setLoadPercentage(collectionID UniqueID, partitionID UniqueID, percentage int64, loadType querypb.LoadType) error
 	//printMeta()
 	saveGlobalSealedSegInfos(saves col2SegmentInfos) (col2SealedSegmentChangeInfos, error)
@@ -100,6 +106,9 @@ type Meta interface {
 
 	generateReplica(collectionID int64, partitionIds []int64) (*milvuspb.ReplicaInfo, error)
 	addReplica(replica *milvuspb.ReplicaInfo) error
+	addStandbyReplica(replica *milvuspb.ReplicaInfo) error
+	promoteReplica(collectionID, replicaID UniqueID) error
+	removeReplica(replica *milvuspb.ReplicaInfo) error
 	setReplicaInfo(info *milvuspb.ReplicaInfo) error
 	getReplicaByID(replicaID int64) (*milvuspb.ReplicaInfo, error)
 	getReplicasByCollectionID(collectionID int64) ([]*milvuspb.ReplicaInfo, error)
@@ -947,6 +956,43 @@ func (m *MetaReplica) setDmChannelInfos(dmChannelWatchInfos []*querypb.DmChannel
 	return nil
 }
 
+// removeDmChannelNode removes the node from the nodes watching the dm channel, after the channel is migrated to another node
+func (m *MetaReplica) removeDmChannelNode(dmChannel string, nodeID int64) error {
+	m.dmChannelMu.Lock()
+	defer m.dmChannelMu.Unlock()
+
+	old, ok := m.dmChannelInfos[dmChannel]
+	if !ok {
+		return nil
+	}
+	info := proto.Clone(old).(*querypb.DmChannelWatchInfo)
+	info.NodeIds = removeFromSlice(info.NodeIds, nodeID)
+	if info.NodeIDLoaded == nodeID && len(info.NodeIds) > 0 {
+		info.NodeIDLoaded = info.NodeIds[0]
+	}
+	err := saveDmChannelWatchInfos([]*querypb.DmChannelWatchInfo{info}, m.getKvClient())
+	if err != nil {
+		return err
+	}
+	m.dmChannelInfos[dmChannel] = info
+
+	return nil
+}
+
+// saveBalanceChannels records the dm channels migrated by the load balance task with the msg id
+func (m *MetaReplica) saveBalanceChannels(msgID UniqueID, channels []string) error {
+	return saveBalanceChannels(m.getKvClient(), msgID, channels)
+}
+
+// getBalanceChannels returns the dm channels migrated by the load balance task with the msg id, nil if none
+func (m *MetaReplica) getBalanceChannels(msgID UniqueID) ([]string, error) {
+	return loadBalanceChannels(m.getKvClient(), msgID)
+}
+
+func (m *MetaReplica) removeBalanceChannels(msgID UniqueID) error {
+	return removeBalanceChannels(m.getKvClient(), msgID)
+}
+
 // createQueryChannel creates topic names for search channel and search result channel
 // Search channel's suffix is fixed with "-0"
 // Search result channel's suffix is fixed with "-0"
@@ -1062,6 +1108,25 @@ func (m *MetaReplica) setLoadType(collectionID UniqueID, loadType querypb.LoadTy
 	return errors.New("setLoadType: can't find collection in collectionInfos")
 }
 
+// setCollectionSchema replaces the schema of the loaded collection, it's a no-op if the collection is not loaded
+func (m *MetaReplica) setCollectionSchema(collectionID UniqueID, schema *schemapb.CollectionSchema) error {
+	m.collectionMu.Lock()
+	defer m.collectionMu.Unlock()
+
+	if _, ok := m.collectionInfos[collectionID]; !ok {
+		return nil
+	}
+	info := proto.Clone(m.collectionInfos[collectionID]).(*querypb.CollectionInfo)
+	info.Schema = schema
+	err := saveGlobalCollectionInfo(collectionID, info, m.getKvClient())
+	if err != nil {
+		log.Error("save collectionInfo error", zap.Any("error", err.Error()), zap.Int64("collectionID", collectionID))
+		return err
+	}
+	m.collectionInfos[collectionID] = info
+	return nil
+}
+
 func (m *MetaReplica) setLoadPercentage(collectionID UniqueID, partitionID UniqueID, percentage int64, loadType querypb.LoadType) error {
 	m.collectionMu.Lock()
 	defer m.collectionMu.Unlock()
@@ -1233,6 +1298,98 @@ func (m *MetaReplica) addReplica(replica *milvuspb.ReplicaInfo) error {
 	return nil
 }
 
+// addStandbyReplica adds the replica which is not returned to the proxies until it's promoted
+func (m *MetaReplica) addStandbyReplica(replica *milvuspb.ReplicaInfo) error {
+	err := m.getKvClient().Save(standbyReplicaKey(replica.CollectionID, replica.ReplicaID), "")
+	if err != nil {
+		return err
+	}
+	return m.addReplica(replica)
+}
+
+// promoteReplica makes the standby replica serve the requests
+func (m *MetaReplica) promoteReplica(collectionID, replicaID UniqueID) error {
+	return promoteStandbyReplica(m.getKvClient(), collectionID, replicaID)
+}
+
+// removeReplica removes the replica from the collection, and the nodes of the replica from the segments and dm channels of the collection
+func (m *MetaReplica) removeReplica(replica *milvuspb.ReplicaInfo) error {
+	collectionInfo, err := m.getCollectionInfoByID(replica.CollectionID)
+	if err != nil {
+		return err
+	}
+
+	for _, segment := range m.showSegmentInfos(replica.CollectionID, nil) {
+		if !funcutil.SliceContain(segment.ReplicaIds, replica.ReplicaID) {
+			continue
+		}
+		segment.ReplicaIds = removeFromSlice(segment.ReplicaIds, replica.ReplicaID)
+		segment.NodeIds = removeFromSlice(segment.NodeIds, replica.NodeIds...)
+		segment.NodeID = -1
+		if len(segment.NodeIds) > 0 {
+			segment.NodeID = segment.NodeIds[0]
+		}
+		err = m.saveSegmentInfo(segment)
+		if err != nil {
+			return err
+		}
+	}
+
+	m.dmChannelMu.Lock()
+	dmChannelInfos := make([]*querypb.DmChannelWatchInfo, 0)
+	for _, info := range m.dmChannelInfos {
+		if info.CollectionID != replica.CollectionID {
+			continue
+		}
+		info = proto.Clone(info).(*querypb.DmChannelWatchInfo)
+		info.NodeIds = removeFromSlice(info.NodeIds, replica.NodeIds...)
+		if funcutil.SliceContain(replica.NodeIds, info.NodeIDLoaded) && len(info.NodeIds) > 0 {
+			info.NodeIDLoaded = info.NodeIds[0]
+		}
+		dmChannelInfos = append(dmChannelInfos, info)
+	}
+	err = saveDmChannelWatchInfos(dmChannelInfos, m.getKvClient())
+	if err == nil {
+		for _, info := range dmChannelInfos {
+			m.dmChannelInfos[info.DmChannel] = info
+		}
+	}
+	m.dmChannelMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	replicaIds := make([]UniqueID, 0, len(collectionInfo.ReplicaIds))
+	for _, replicaID := range collectionInfo.ReplicaIds {
+		if replicaID != replica.ReplicaID {
+			replicaIds = append(replicaIds, replicaID)
+		}
+	}
+	if len(replicaIds) < len(collectionInfo.ReplicaIds) {
+		collectionInfo.ReplicaNumber--
+	}
+	collectionInfo.ReplicaIds = replicaIds
+	err = saveGlobalCollectionInfo(collectionInfo.CollectionID, collectionInfo, m.getKvClient())
+	if err != nil {
+		return err
+	}
+
+	m.collectionMu.Lock()
+	m.collectionInfos[collectionInfo.CollectionID] = collectionInfo
+	m.collectionMu.Unlock()
+
+	err = m.getKvClient().MultiRemove([]string{
+		fmt.Sprintf("%s/%d", ReplicaMetaPrefix, replica.ReplicaID),
+		standbyReplicaKey(replica.CollectionID, replica.ReplicaID),
+	})
+	if err != nil {
+		return err
+	}
+
+	m.replicas.Remove(replica.ReplicaID)
+	return nil
+}
+
 func (m *MetaReplica) setReplicaInfo(info *milvuspb.ReplicaInfo) error {
 	err := saveReplicaInfo(info, m.getKvClient())
 	if err != nil {
@@ -1360,6 +1517,8 @@ func removeCollectionMeta(collectionID UniqueID, replicas []UniqueID, kv kv.Meta
 		prefixes = append(prefixes, replicaPrefix)
 	}
 
+	prefixes = append(prefixes, standbyReplicaCollectionPrefix(collectionID))
+
 	return kv.MultiRemoveWithPrefix(prefixes)
 }
 
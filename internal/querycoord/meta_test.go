@@ -32,6 +32,7 @@ import (
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 )
@@ -101,6 +102,92 @@ func TestReplica_Release(t *testing.T) {
 	meta.releaseCollection(1)
 }
 
+func TestReplica_AddRemove(t *testing.T) {
+	refreshParams()
+	etcdCli, err := etcd.GetEtcdClient(&Params.EtcdCfg)
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+	etcdKV := etcdkv.NewEtcdKV(etcdCli, Params.EtcdCfg.MetaRootPath)
+	id := UniqueID(rand.Int31())
+	idAllocator := func() (UniqueID, error) {
+		newID := atomic.AddInt64(&id, 1)
+		return newID, nil
+	}
+	meta, err := newMeta(context.Background(), etcdKV, nil, idAllocator)
+	assert.Nil(t, err)
+	err = meta.addCollection(defaultCollectionID, querypb.LoadType_LoadCollection, nil)
+	require.NoError(t, err)
+	defer meta.releaseCollection(defaultCollectionID)
+
+	serving, err := meta.generateReplica(defaultCollectionID, nil)
+	require.NoError(t, err)
+	serving.NodeIds = []UniqueID{1}
+	err = meta.addReplica(serving)
+	require.NoError(t, err)
+	standby, err := meta.generateReplica(defaultCollectionID, nil)
+	require.NoError(t, err)
+	standby.NodeIds = []UniqueID{2}
+	err = meta.addStandbyReplica(standby)
+	require.NoError(t, err)
+
+	collectionInfo, err := meta.getCollectionInfoByID(defaultCollectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), collectionInfo.ReplicaNumber)
+	standbyReplicas, err := getStandbyReplicas(etcdKV, defaultCollectionID)
+	assert.NoError(t, err)
+	assert.Contains(t, standbyReplicas, standby.ReplicaID)
+
+	err = meta.promoteReplica(defaultCollectionID, standby.ReplicaID)
+	assert.NoError(t, err)
+	standbyReplicas, err = getStandbyReplicas(etcdKV, defaultCollectionID)
+	assert.NoError(t, err)
+	assert.Empty(t, standbyReplicas)
+
+	segment := &querypb.SegmentInfo{
+		CollectionID: defaultCollectionID,
+		PartitionID:  defaultPartitionID,
+		SegmentID:    defaultSegmentID,
+		NodeID:       2,
+		NodeIds:      []UniqueID{1, 2},
+		ReplicaIds:   []UniqueID{serving.ReplicaID, standby.ReplicaID},
+	}
+	err = meta.saveSegmentInfo(segment)
+	require.NoError(t, err)
+	err = meta.setDmChannelInfos([]*querypb.DmChannelWatchInfo{{
+		CollectionID: defaultCollectionID,
+		DmChannel:    "testDm1",
+		NodeIDLoaded: 2,
+		ReplicaID:    standby.ReplicaID,
+		NodeIds:      []UniqueID{1, 2},
+	}})
+	require.NoError(t, err)
+
+	err = meta.removeReplica(standby)
+	assert.NoError(t, err)
+	collectionInfo, err = meta.getCollectionInfoByID(defaultCollectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), collectionInfo.ReplicaNumber)
+	assert.Equal(t, []UniqueID{serving.ReplicaID}, collectionInfo.ReplicaIds)
+	_, err = meta.getReplicaByID(standby.ReplicaID)
+	assert.Error(t, err)
+	segment, err = meta.getSegmentInfoByID(defaultSegmentID)
+	assert.NoError(t, err)
+	assert.Equal(t, []UniqueID{serving.ReplicaID}, segment.ReplicaIds)
+	assert.Equal(t, []UniqueID{1}, segment.NodeIds)
+	assert.Equal(t, UniqueID(1), segment.NodeID)
+	dmChannelInfos := meta.getDmChannelInfosByNodeID(1)
+	assert.Equal(t, 1, len(dmChannelInfos))
+	assert.Equal(t, UniqueID(1), dmChannelInfos[0].NodeIDLoaded)
+	assert.Empty(t, meta.getDmChannelInfosByNodeID(2))
+
+	// removing the removed replica changes nothing
+	err = meta.removeReplica(standby)
+	assert.NoError(t, err)
+	collectionInfo, err = meta.getCollectionInfoByID(defaultCollectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), collectionInfo.ReplicaNumber)
+}
+
 func TestMetaFunc(t *testing.T) {
 	refreshParams()
 	etcdCli, err := etcd.GetEtcdClient(&Params.EtcdCfg)
@@ -180,12 +267,32 @@ func TestMetaFunc(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 
+	t.Run("Test SetCollectionSchemaNotLoaded", func(t *testing.T) {
+		err := meta.setCollectionSchema(defaultCollectionID, genDefaultCollectionSchema(false))
+		assert.Nil(t, err)
+	})
+
 	t.Run("Test AddCollection", func(t *testing.T) {
 		schema := genDefaultCollectionSchema(false)
 		err := meta.addCollection(defaultCollectionID, querypb.LoadType_LoadCollection, schema)
 		assert.Nil(t, err)
 	})
 
+	t.Run("Test SetCollectionSchema", func(t *testing.T) {
+		info, err := meta.getCollectionInfoByID(defaultCollectionID)
+		assert.Nil(t, err)
+		schema := info.Schema
+		renamed := proto.Clone(schema).(*schemapb.CollectionSchema)
+		renamed.Name = "renamed"
+		err = meta.setCollectionSchema(defaultCollectionID, renamed)
+		assert.Nil(t, err)
+		info, err = meta.getCollectionInfoByID(defaultCollectionID)
+		assert.Nil(t, err)
+		assert.Equal(t, "renamed", info.Schema.Name)
+		err = meta.setCollectionSchema(defaultCollectionID, schema)
+		assert.Nil(t, err)
+	})
+
 	t.Run("Test HasCollection", func(t *testing.T) {
 		hasCollection := meta.hasCollection(defaultCollectionID)
 		assert.Equal(t, true, hasCollection)
@@ -294,6 +401,7 @@ func TestMetaFunc(t *testing.T) {
 		err := meta.releaseCollection(defaultCollectionID)
 		assert.Nil(t, err)
 	})
+
 }
 
 func TestReloadMetaFromKV(t *testing.T) {
@@ -18,6 +18,9 @@ package querycoord
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
 
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 
@@ -132,3 +135,54 @@ func getSystemInfoMetrics(
 
 	return resp, nil
 }
+
+// getShardClustersMetrics collects the shard clusters led by all query nodes
+func getShardClustersMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest, qc *QueryCoord) (string, error) {
+	topology := mergeShardClusters(qc.cluster.getMetrics(ctx, req))
+	resp, err := json.Marshal(topology)
+	if err != nil {
+		return "", err
+	}
+	return string(resp), nil
+}
+
+// mergeShardClusters merges the shard clusters reported by query nodes, ordered by collection, vchannel and replica
+func mergeShardClusters(nodesMetrics []queryNodeGetMetricsResponse) metricsinfo.ShardClusterTopology {
+	topology := metricsinfo.ShardClusterTopology{
+		ShardClusters: make([]metricsinfo.ShardClusterInfo, 0),
+	}
+	for _, nodeMetrics := range nodesMetrics {
+		if nodeMetrics.err != nil {
+			log.Warn("failed to get shard clusters of query node", zap.Error(nodeMetrics.err))
+			topology.Errors = append(topology.Errors, nodeMetrics.err.Error())
+			continue
+		}
+		if nodeMetrics.resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			log.Warn("failed to get shard clusters of query node",
+				zap.String("component", nodeMetrics.resp.GetComponentName()),
+				zap.String("reason", nodeMetrics.resp.GetStatus().GetReason()))
+			topology.Errors = append(topology.Errors, fmt.Sprintf("%s: %s", nodeMetrics.resp.GetComponentName(), nodeMetrics.resp.GetStatus().GetReason()))
+			continue
+		}
+		var infos []metricsinfo.ShardClusterInfo
+		if err := json.Unmarshal([]byte(nodeMetrics.resp.GetResponse()), &infos); err != nil {
+			log.Warn("invalid shard clusters of query node was found",
+				zap.String("component", nodeMetrics.resp.GetComponentName()),
+				zap.Error(err))
+			topology.Errors = append(topology.Errors, fmt.Sprintf("%s: %s", nodeMetrics.resp.GetComponentName(), err.Error()))
+			continue
+		}
+		topology.ShardClusters = append(topology.ShardClusters, infos...)
+	}
+	sort.Slice(topology.ShardClusters, func(i, j int) bool {
+		a, b := topology.ShardClusters[i], topology.ShardClusters[j]
+		if a.CollectionID != b.CollectionID {
+			return a.CollectionID < b.CollectionID
+		}
+		if a.VChannel != b.VChannel {
+			return a.VChannel < b.VChannel
+		}
+		return a.ReplicaID < b.ReplicaID
+	})
+	return topology
+}
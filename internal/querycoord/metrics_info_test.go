@@ -17,11 +17,54 @@
 package querycoord
 
 import (
+	"encoding/json"
+	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 )
 
 func TestGetSystemInfoMetrics(t *testing.T) {
 	log.Info("TestGetSystemInfoMetrics, todo")
 }
+
+func TestMergeShardClusters(t *testing.T) {
+	genResponse := func(infos ...metricsinfo.ShardClusterInfo) queryNodeGetMetricsResponse {
+		resp, err := json.Marshal(infos)
+		assert.NoError(t, err)
+		return queryNodeGetMetricsResponse{
+			resp: &milvuspb.GetMetricsResponse{
+				Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				Response: string(resp),
+			},
+		}
+	}
+
+	topology := mergeShardClusters([]queryNodeGetMetricsResponse{
+		genResponse(metricsinfo.ShardClusterInfo{CollectionID: 2, VChannel: "dml_0", LeaderID: 1}),
+		genResponse(
+			metricsinfo.ShardClusterInfo{CollectionID: 1, VChannel: "dml_1", LeaderID: 2},
+			metricsinfo.ShardClusterInfo{CollectionID: 1, VChannel: "dml_0", LeaderID: 2},
+		),
+		{err: errors.New("node down")},
+		{resp: &milvuspb.GetMetricsResponse{
+			Status:        &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "not healthy"},
+			ComponentName: "querynode3",
+		}},
+		{resp: &milvuspb.GetMetricsResponse{
+			Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Response: "invalid",
+		}},
+	})
+	assert.Equal(t, 3, len(topology.ShardClusters))
+	assert.Equal(t, "dml_0", topology.ShardClusters[0].VChannel)
+	assert.Equal(t, "dml_1", topology.ShardClusters[1].VChannel)
+	assert.Equal(t, int64(2), topology.ShardClusters[2].CollectionID)
+	assert.Equal(t, 3, len(topology.Errors))
+	assert.Equal(t, "querynode3: not healthy", topology.Errors[1])
+}
@@ -436,6 +436,21 @@ func newIndexCoordMock(path string) (*indexCoordMock, error) {
 	}, nil
 }
 
+func (c *indexCoordMock) PrioritizeIndexBuild(ctx context.Context, req *indexpb.PrioritizeIndexBuildRequest) (*commonpb.Status, error) {
+	if c.returnGrpcError {
+		return nil, errors.New("prioritize index build failed")
+	}
+	if c.returnError {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "prioritize index build failed",
+		}, nil
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 func (c *indexCoordMock) GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
 	if c.returnGrpcError {
 		return nil, errors.New("get index file paths failed")
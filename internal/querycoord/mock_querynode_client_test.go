@@ -146,6 +146,10 @@ func (client *queryNodeClientMock) ReleasePartitions(ctx context.Context, req *q
 	return client.grpcClient.ReleasePartitions(ctx, req)
 }
 
+func (client *queryNodeClientMock) ReleaseChannels(ctx context.Context, req *querypb.ReleaseChannelsRequest) (*commonpb.Status, error) {
+	return client.grpcClient.ReleaseChannels(ctx, req)
+}
+
 func (client *queryNodeClientMock) ReleaseSegments(ctx context.Context, req *querypb.ReleaseSegmentsRequest) (*commonpb.Status, error) {
 	return client.grpcClient.ReleaseSegments(ctx, req)
 }
@@ -169,3 +173,15 @@ func (client *queryNodeClientMock) Query(ctx context.Context, req *querypb.Query
 func (client *queryNodeClientMock) SyncReplicaSegments(ctx context.Context, req *querypb.SyncReplicaSegmentsRequest) (*commonpb.Status, error) {
 	return client.grpcClient.SyncReplicaSegments(ctx, req)
 }
+
+func (client *queryNodeClientMock) RefreshCollection(ctx context.Context, req *querypb.LoadPartitionsRequest) (*commonpb.Status, error) {
+	return client.grpcClient.RefreshCollection(ctx, req)
+}
+
+func (client *queryNodeClientMock) Drain(ctx context.Context, req *querypb.DrainRequest) (*querypb.DrainResponse, error) {
+	return client.grpcClient.Drain(ctx, req)
+}
+
+func (client *queryNodeClientMock) GetSegmentLoadingProgress(ctx context.Context, req *querypb.GetLoadingProgressRequest) (*querypb.GetSegmentLoadingProgressResponse, error) {
+	return client.grpcClient.GetSegmentLoadingProgress(ctx, req)
+}
@@ -0,0 +1,314 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// queryNodeCordonPrefix is the prefix of the cordoned QueryNodes, the key is {prefix}/{nodeID} and the value is the drain state.
+// A cordoned node keeps online, but no new segments or channels are assigned to it,
+// and the ones it serves are moved to the other nodes of the same replicas.
+const queryNodeCordonPrefix = "queryCoord-cordonedNode"
+
+// drain states of the cordoned nodes saved in etcd
+const (
+	cordonedNodeDraining = "Draining"
+	cordonedNodeDrained  = "Drained"
+)
+
+var cordonedNodeStates = map[string]milvuspb.CordonedNodeState{
+	cordonedNodeDraining: milvuspb.CordonedNodeState_CordonedNodeDraining,
+	cordonedNodeDrained:  milvuspb.CordonedNodeState_CordonedNodeDrained,
+}
+
+func cordonedNodeKey(nodeID int64) string {
+	return fmt.Sprintf("%s/%d", queryNodeCordonPrefix, nodeID)
+}
+
+func (c *queryNodeCluster) reloadCordonedNodes() error {
+	keys, values, err := c.client.LoadWithPrefix(queryNodeCordonPrefix)
+	if err != nil {
+		log.Warn("reloadCordonedNodes: load cordoned nodes from etcd error", zap.Error(err))
+		return err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	c.cordonedNodes = make(map[int64]string, len(keys))
+	for i, key := range keys {
+		nodeID, err := strconv.ParseInt(path.Base(key), 10, 64)
+		if err != nil {
+			log.Warn("invalid cordoned node key", zap.String("key", key))
+			continue
+		}
+		c.cordonedNodes[nodeID] = values[i]
+	}
+	return nil
+}
+
+// cordonNode stops assigning segments and channels to the node, it does nothing if the node has been cordoned
+func (c *queryNodeCluster) cordonNode(nodeID int64) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if _, ok := c.cordonedNodes[nodeID]; ok {
+		return nil
+	}
+	if _, ok := c.nodes[nodeID]; !ok {
+		return fmt.Errorf("cordonNode: QueryNode %d not exist", nodeID)
+	}
+	if err := c.client.Save(cordonedNodeKey(nodeID), cordonedNodeDraining); err != nil {
+		return err
+	}
+	if c.cordonedNodes == nil {
+		c.cordonedNodes = make(map[int64]string)
+	}
+	c.cordonedNodes[nodeID] = cordonedNodeDraining
+	log.Info("cordonNode: queryNode cordoned", zap.Int64("nodeID", nodeID))
+	return nil
+}
+
+func (c *queryNodeCluster) uncordonNode(nodeID int64) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if _, ok := c.cordonedNodes[nodeID]; !ok {
+		return nil
+	}
+	if err := c.client.Remove(cordonedNodeKey(nodeID)); err != nil {
+		return err
+	}
+	delete(c.cordonedNodes, nodeID)
+	log.Info("uncordonNode: queryNode uncordoned", zap.Int64("nodeID", nodeID))
+	return nil
+}
+
+func (c *queryNodeCluster) isCordoned(nodeID int64) bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	_, ok := c.cordonedNodes[nodeID]
+	return ok
+}
+
+func (c *queryNodeCluster) setCordonedNodeDrained(nodeID int64) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if _, ok := c.cordonedNodes[nodeID]; !ok {
+		return fmt.Errorf("setCordonedNodeDrained: QueryNode %d is not cordoned", nodeID)
+	}
+	if err := c.client.Save(cordonedNodeKey(nodeID), cordonedNodeDrained); err != nil {
+		return err
+	}
+	c.cordonedNodes[nodeID] = cordonedNodeDrained
+	return nil
+}
+
+// getCordonedNodes returns the cordoned nodes sorted by node id
+func (c *queryNodeCluster) getCordonedNodes() []*milvuspb.CordonedNode {
+	c.RLock()
+	defer c.RUnlock()
+
+	nodes := make([]*milvuspb.CordonedNode, 0, len(c.cordonedNodes))
+	for nodeID, state := range c.cordonedNodes {
+		nodes = append(nodes, &milvuspb.CordonedNode{NodeID: nodeID, State: cordonedNodeStates[state]})
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].NodeID < nodes[j].NodeID })
+	return nodes
+}
+
+// excludeCordonedNodes returns the excluded nodes with the cordoned ones appended, excludeNodeIDs is not modified
+func (c *queryNodeCluster) excludeCordonedNodes(excludeNodeIDs []int64) []int64 {
+	c.RLock()
+	defer c.RUnlock()
+
+	if len(c.cordonedNodes) == 0 {
+		return excludeNodeIDs
+	}
+	ret := make([]int64, 0, len(excludeNodeIDs)+len(c.cordonedNodes))
+	ret = append(ret, excludeNodeIDs...)
+	for nodeID := range c.cordonedNodes {
+		ret = append(ret, nodeID)
+	}
+	return ret
+}
+
+// cordonedNodesResponse returns the cordoned nodes after the request of method, or the error if it failed
+func (qc *QueryCoord) cordonedNodesResponse(method string, err error) *milvuspb.CordonedNodesResponse {
+	if err != nil {
+		log.Warn(method+" failed", zap.String("role", typeutil.QueryCoordRole), zap.Error(err))
+		return &milvuspb.CordonedNodesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}
+	}
+	return &milvuspb.CordonedNodesResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Nodes: qc.cluster.getCordonedNodes(),
+	}
+}
+
+// uncordonNode makes the node assignable again, the drained node is allocated to the replicas as a new node
+func (qc *QueryCoord) uncordonNode(nodeID int64) error {
+	if err := qc.cluster.uncordonNode(nodeID); err != nil {
+		return err
+	}
+	if online, err := qc.cluster.isOnline(nodeID); err != nil || !online {
+		return nil
+	}
+	replicas, err := qc.meta.getReplicasByNodeID(nodeID)
+	if err != nil {
+		return err
+	}
+	if len(replicas) == 0 {
+		return qc.allocateNode(nodeID)
+	}
+	return nil
+}
+
+// drainCordonedNodes moves the segments and channels off the cordoned nodes one by one
+func (qc *QueryCoord) drainCordonedNodes() {
+	for _, node := range qc.cluster.getCordonedNodes() {
+		if node.State != milvuspb.CordonedNodeState_CordonedNodeDraining {
+			continue
+		}
+		if err := qc.drainCordonedNode(node.NodeID); err != nil {
+			log.Warn("failed to drain the cordoned query node, retry later", zap.Int64("nodeID", node.NodeID), zap.Error(err))
+			continue
+		}
+		if err := qc.cluster.setCordonedNodeDrained(node.NodeID); err != nil {
+			log.Warn("failed to save the drain state of cordoned query node", zap.Int64("nodeID", node.NodeID), zap.Error(err))
+			continue
+		}
+		log.Info("cordoned query node drained", zap.Int64("nodeID", node.NodeID))
+	}
+}
+
+// drainCordonedNode hands off the segments and channels of the node in the same way as the node down,
+// except that the node is kept in cluster, then releases them on the node and removes it from the replicas
+func (qc *QueryCoord) drainCordonedNode(nodeID int64) error {
+	if !qc.cluster.hasNode(nodeID) {
+		log.Info("cordoned query node is not in cluster, nothing to drain", zap.Int64("nodeID", nodeID))
+		return nil
+	}
+	if online, err := qc.cluster.isOnline(nodeID); err != nil || !online {
+		// the offline node is handed off by the node down load balance
+		return fmt.Errorf("query node %d is not online", nodeID)
+	}
+
+	replicas, err := qc.meta.getReplicasByNodeID(nodeID)
+	if err != nil {
+		return err
+	}
+	collectionIDs := make(typeutil.UniqueSet)
+	for _, replica := range replicas {
+		if !qc.hasAssignableNodes(replica.GetNodeIds()) {
+			return fmt.Errorf("no other assignable node in replica %d to drain the node to", replica.GetReplicaID())
+		}
+		collectionIDs.Insert(replica.GetCollectionID())
+	}
+	segmentInfos := qc.meta.getSegmentInfosByNode(nodeID)
+	for _, info := range segmentInfos {
+		collectionIDs.Insert(info.GetCollectionID())
+	}
+	dmChannelInfos := qc.meta.getDmChannelInfosByNodeID(nodeID)
+	for _, info := range dmChannelInfos {
+		collectionIDs.Insert(info.GetCollectionID())
+	}
+
+	if len(segmentInfos) > 0 || len(dmChannelInfos) > 0 {
+		loadBalanceTask := &loadBalanceTask{
+			baseTask: newBaseTask(qc.loopCtx, querypb.TriggerCondition_NodeDown),
+			LoadBalanceRequest: &querypb.LoadBalanceRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:  commonpb.MsgType_LoadBalanceSegments,
+					SourceID: qc.session.ServerID,
+				},
+				SourceNodeIDs: []int64{nodeID},
+				BalanceReason: querypb.TriggerCondition_NodeDown,
+			},
+			broker:          qc.broker,
+			cluster:         qc.cluster,
+			meta:            qc.meta,
+			keepSourceNodes: true,
+		}
+		if err = qc.scheduler.Enqueue(loadBalanceTask); err != nil {
+			return err
+		}
+		log.Info("start a loadBalance task to drain cordoned query node", zap.Int64("nodeID", nodeID), zap.Int64("taskID", loadBalanceTask.getTaskID()))
+		if err = loadBalanceTask.waitToFinish(); err != nil {
+			return err
+		}
+	}
+
+	// the node may be still in the replicas which it serves nothing of
+	replicas, err = qc.meta.getReplicasByNodeID(nodeID)
+	if err != nil {
+		return err
+	}
+	for _, replica := range replicas {
+		if err = qc.meta.setReplicaInfo(removeNodeFromReplica(replica, nodeID)); err != nil {
+			return err
+		}
+	}
+
+	for collectionID := range collectionIDs {
+		req := &querypb.ReleaseCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_ReleaseCollection,
+				SourceID: qc.session.ServerID,
+			},
+			CollectionID: collectionID,
+			NodeID:       nodeID,
+		}
+		if err = qc.cluster.releaseCollection(qc.loopCtx, nodeID, req); err != nil {
+			return err
+		}
+	}
+	qc.metricsCacheManager.InvalidateSystemInfoMetrics()
+	return nil
+}
+
+// hasAssignableNodes returns whether any of the nodes is online and not cordoned
+func (qc *QueryCoord) hasAssignableNodes(nodeIDs []int64) bool {
+	for _, nodeID := range nodeIDs {
+		if qc.cluster.isCordoned(nodeID) {
+			continue
+		}
+		if online, err := qc.cluster.isOnline(nodeID); err == nil && online {
+			return true
+		}
+	}
+	return false
+}
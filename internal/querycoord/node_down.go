@@ -0,0 +1,62 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// internalTaskNodeID returns the query node the internal task is sent to, false if it's not a load or watch task
+func internalTaskNodeID(t task) (int64, bool) {
+	switch t := t.(type) {
+	case *loadSegmentTask:
+		return t.DstNodeID, true
+	case *watchDmChannelTask:
+		return t.NodeID, true
+	case *watchDeltaChannelTask:
+		return t.NodeID, true
+	case *watchQueryChannelTask:
+		return t.NodeID, true
+	default:
+		return 0, false
+	}
+}
+
+// failNodeTasks fails the unfinished load and watch tasks of the processing trigger task sent to the query node
+// once its session is gone, rather than waiting for the requests to time out. The query node is offline then,
+// so the load segments and watch dm channels tasks are rescheduled to the other query nodes right away.
+// The query nodes skip the segments loaded already, so the segments loaded before the failure are not loaded twice.
+func (scheduler *TaskScheduler) failNodeTasks(nodeID int64) {
+	triggerTask := scheduler.getProcessingTask()
+	if triggerTask == nil {
+		return
+	}
+	for _, childTask := range triggerTask.getChildTask() {
+		if taskNodeID, ok := internalTaskNodeID(childTask); !ok || taskNodeID != nodeID || childTask.getState() == taskDone {
+			continue
+		}
+		log.Info("failNodeTasks: fail the task of the down query node",
+			zap.Int64("taskID", childTask.getTaskID()),
+			zap.Int64("triggerTaskID", triggerTask.getTaskID()),
+			zap.Int64("nodeID", nodeID))
+		childTask.notify(fmt.Errorf("query node %d is down", nodeID))
+	}
+}
@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func TestTaskCondition_NotifyTwice(t *testing.T) {
+	tc := newTaskCondition(context.Background())
+	tc.notify(errors.New("node down"))
+	// the result of the returned request is dropped
+	tc.notify(nil)
+	assert.NotNil(t, tc.waitToFinish())
+}
+
+func TestFailNodeTasks(t *testing.T) {
+	ctx := context.Background()
+	scheduler := &TaskScheduler{}
+	// no processing task
+	scheduler.failNodeTasks(1)
+
+	triggerTask := genLoadTaskOfCollection(defaultCollectionID, 1)
+	genChildTask := func(nodeID int64) *loadSegmentTask {
+		return &loadSegmentTask{
+			baseTask:            newBaseTask(ctx, querypb.TriggerCondition_GrpcRequest),
+			LoadSegmentsRequest: &querypb.LoadSegmentsRequest{DstNodeID: nodeID},
+		}
+	}
+	downTask := genChildTask(1)
+	doneTask := genChildTask(1)
+	doneTask.setState(taskDone)
+	otherNodeTask := genChildTask(2)
+	watchTask := &watchDmChannelTask{
+		baseTask:               newBaseTask(ctx, querypb.TriggerCondition_GrpcRequest),
+		WatchDmChannelsRequest: &querypb.WatchDmChannelsRequest{NodeID: 1},
+	}
+	for _, childTask := range []task{downTask, doneTask, otherNodeTask, watchTask} {
+		triggerTask.addChildTask(childTask)
+	}
+	scheduler.setProcessingTask(triggerTask)
+
+	scheduler.failNodeTasks(1)
+	assert.NotNil(t, downTask.waitToFinish())
+	assert.NotNil(t, watchTask.waitToFinish())
+	assert.Empty(t, doneTask.condition.(*taskCondition).done)
+	assert.Empty(t, otherNodeTask.condition.(*taskCondition).done)
+
+	nodeID, ok := internalTaskNodeID(otherNodeTask)
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), nodeID)
+	_, ok = internalTaskNodeID(triggerTask)
+	assert.False(t, ok)
+}
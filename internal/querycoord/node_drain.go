@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+const drainCheckInterval = 3 * time.Second
+
+func (qc *QueryCoord) watchNodeDrainLoop() {
+	ctx, cancel := context.WithCancel(qc.loopCtx)
+	defer cancel()
+	defer qc.loopWg.Done()
+	log.Info("QueryCoord start watch node drain loop")
+
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			qc.handOffDrainingNodes()
+			qc.drainCordonedNodes()
+		}
+	}
+}
+
+// handOffDrainingNodes hands off the segments and channels of the draining QueryNodes, each in its own goroutine so
+// that a slow hand off neither blocks the others nor the drain of the cordoned nodes. The nodes being handed off are
+// skipped until their hand offs are done, and the failed ones are retried by the next check.
+func (qc *QueryCoord) handOffDrainingNodes() {
+	keys, values, err := qc.kvClient.LoadWithPrefix(util.QueryNodeDrainPrefix)
+	if err != nil {
+		log.Warn("failed to load the drain states of query nodes", zap.Error(err))
+		return
+	}
+	for i, key := range keys {
+		if values[i] != util.QueryNodeDrainStateDraining {
+			continue
+		}
+		nodeID, err := strconv.ParseInt(path.Base(key), 10, 64)
+		if err != nil {
+			log.Warn("invalid drain state key of query node", zap.String("key", key))
+			continue
+		}
+		if !qc.startHandOff(nodeID) {
+			continue
+		}
+		qc.loopWg.Add(1)
+		go qc.handOffDrainingNode(nodeID)
+	}
+}
+
+// startHandOff marks the node being handed off, returns false if it's been already
+func (qc *QueryCoord) startHandOff(nodeID int64) bool {
+	qc.handOffMu.Lock()
+	defer qc.handOffMu.Unlock()
+	if _, ok := qc.handingOffNodes[nodeID]; ok {
+		return false
+	}
+	qc.handingOffNodes[nodeID] = struct{}{}
+	return true
+}
+
+func (qc *QueryCoord) finishHandOff(nodeID int64) {
+	qc.handOffMu.Lock()
+	defer qc.handOffMu.Unlock()
+	delete(qc.handingOffNodes, nodeID)
+}
+
+func (qc *QueryCoord) isHandingOff(nodeID int64) bool {
+	qc.handOffMu.Lock()
+	defer qc.handOffMu.Unlock()
+	_, ok := qc.handingOffNodes[nodeID]
+	return ok
+}
+
+func (qc *QueryCoord) handOffDrainingNode(nodeID int64) {
+	defer qc.loopWg.Done()
+	defer qc.finishHandOff(nodeID)
+
+	if err := qc.handOffNode(nodeID); err != nil {
+		log.Warn("failed to hand off the draining query node, retry later", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return
+	}
+	// the drain key is attached to the session of the node, which is kept until the session expires, so that the
+	// drained node is known until it's gone
+	err := qc.kvClient.SaveWithIgnoreLease(fmt.Sprintf("%s/%d", util.QueryNodeDrainPrefix, nodeID), util.QueryNodeDrainStateHandedOff)
+	if err != nil {
+		log.Warn("failed to save the drain state of query node", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return
+	}
+	log.Info("draining query node handed off", zap.Int64("nodeID", nodeID))
+}
+
+// handOffNode moves the segments and channels of the draining node to other nodes in the same way as the node down,
+// except that the node keeps online and serving until the other nodes have loaded them.
+func (qc *QueryCoord) handOffNode(nodeID int64) error {
+	node, err := qc.cluster.getNodeInfoByID(nodeID)
+	if err != nil {
+		log.Info("draining query node is not in cluster, nothing to hand off", zap.Int64("nodeID", nodeID))
+		return nil
+	}
+
+	loadBalanceSegment := &querypb.LoadBalanceRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_LoadBalanceSegments,
+			SourceID: qc.session.ServerID,
+		},
+		SourceNodeIDs: []int64{nodeID},
+		BalanceReason: querypb.TriggerCondition_NodeDown,
+	}
+	baseTask := newBaseTask(qc.loopCtx, querypb.TriggerCondition_NodeDown)
+	loadBalanceTask := &loadBalanceTask{
+		baseTask:           baseTask,
+		LoadBalanceRequest: loadBalanceSegment,
+		broker:             qc.broker,
+		cluster:            qc.cluster,
+		meta:               qc.meta,
+	}
+	if err = qc.scheduler.Enqueue(loadBalanceTask); err != nil {
+		return err
+	}
+	log.Info("start a loadBalance task to hand off draining query node", zap.Int64("nodeID", nodeID), zap.Int64("taskID", loadBalanceTask.getTaskID()))
+	if err = loadBalanceTask.waitToFinish(); err != nil {
+		return err
+	}
+
+	// the node info has been removed from cluster by the load balance task
+	node.stop()
+	qc.metricsCacheManager.InvalidateSystemInfoMetrics()
+	return nil
+}
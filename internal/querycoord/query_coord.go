@@ -103,12 +103,30 @@ type QueryCoord struct {
 	factory       dependency.Factory
 	chunkManager  storage.ChunkManager
 	groupBalancer balancer
+
+	// releaseMu serializes the admission of release collection requests, so that the duplicate ones attach to the running release
+	releaseMu sync.Mutex
+
+	// handOffMu protects handingOffNodes, the draining query nodes whose segments and channels are being handed off
+	handOffMu       sync.Mutex
+	handingOffNodes map[int64]struct{}
+
+	// standby is true until the QueryCoord in active-standby mode becomes active, nothing is loaded or started before
+	standby bool
 }
 
-// Register register query service at etcd
+// Register register query service at etcd, the standby QueryCoord blocks here until it becomes active
 func (qc *QueryCoord) Register() error {
+	if qc.standby {
+		return qc.session.ProcessActiveStandBy(qc.activate)
+	}
 	qc.session.Register()
-	go qc.session.LivenessCheck(qc.loopCtx, func() {
+	go qc.livenessCheck()
+	return nil
+}
+
+func (qc *QueryCoord) livenessCheck() {
+	qc.session.LivenessCheck(qc.loopCtx, func() {
 		log.Error("Query Coord disconnected from etcd, process will exit", zap.Int64("Server Id", qc.session.ServerID))
 		if err := qc.Stop(); err != nil {
 			log.Fatal("failed to stop server", zap.Error(err))
@@ -120,7 +138,18 @@ func (qc *QueryCoord) Register() error {
 			}
 		}
 	})
-	return nil
+}
+
+// activate takes over from the previous active QueryCoord, the meta, cluster and tasks left by it are replayed from etcd
+func (qc *QueryCoord) activate() error {
+	log.Info("QueryCoord becomes active, replay the meta", zap.Int64("serverID", qc.session.ServerID))
+	go qc.livenessCheck()
+	if err := qc.initMeta(); err != nil {
+		log.Error("QueryCoord failed to init the meta after becoming active", zap.Error(err))
+		return err
+	}
+	qc.standby = false
+	return qc.start()
 }
 
 func (qc *QueryCoord) initSession() error {
@@ -149,83 +178,106 @@ func (qc *QueryCoord) Init() error {
 		qc.kvClient = etcdKV
 		log.Debug("query coordinator try to connect etcd success")
 
-		// init id allocator
-		idAllocatorKV := tsoutil.NewTSOKVBase(qc.etcdCli, Params.EtcdCfg.KvRootPath, "queryCoordTaskID")
-		idAllocator := allocator.NewGlobalIDAllocator("idTimestamp", idAllocatorKV)
-		initError = idAllocator.Initialize()
-		if initError != nil {
-			log.Error("query coordinator idAllocator initialize failed", zap.Error(initError))
+		if Params.QueryCoordCfg.EnableActiveStandby {
+			// the meta may be being changed by the active QueryCoord, it's loaded after this one becomes active
+			qc.standby = true
+			log.Info("QueryCoord is in active-standby mode, init the meta after it becomes active")
 			return
 		}
-		qc.idAllocator = func() (UniqueID, error) {
-			return idAllocator.AllocOne()
-		}
+		initError = qc.initMeta()
+	})
+	log.Info("QueryCoord init success")
+	return initError
+}
 
-		qc.factory.Init(&Params)
+// initMeta loads the meta, cluster and tasks from etcd and initializes the components depending on them
+func (qc *QueryCoord) initMeta() error {
+	var initError error
 
-		// init meta
-		qc.meta, initError = newMeta(qc.loopCtx, qc.kvClient, qc.factory, qc.idAllocator)
-		if initError != nil {
-			log.Error("query coordinator init meta failed", zap.Error(initError))
-			return
-		}
+	// init id allocator
+	idAllocatorKV := tsoutil.NewTSOKVBase(qc.etcdCli, Params.EtcdCfg.KvRootPath, "queryCoordTaskID")
+	idAllocator := allocator.NewGlobalIDAllocator("idTimestamp", idAllocatorKV)
+	initError = idAllocator.Initialize()
+	if initError != nil {
+		log.Error("query coordinator idAllocator initialize failed", zap.Error(initError))
+		return initError
+	}
+	qc.idAllocator = func() (UniqueID, error) {
+		return idAllocator.AllocOne()
+	}
 
-		// init channelUnsubscribeHandler
-		qc.handler, initError = newChannelUnsubscribeHandler(qc.loopCtx, qc.kvClient, qc.factory)
-		if initError != nil {
-			log.Error("query coordinator init channelUnsubscribeHandler failed", zap.Error(initError))
-			return
-		}
+	qc.factory.Init(&Params)
 
-		// init cluster
-		qc.cluster, initError = newQueryNodeCluster(qc.loopCtx, qc.meta, qc.kvClient, qc.newNodeFn, qc.session, qc.handler)
-		if initError != nil {
-			log.Error("query coordinator init cluster failed", zap.Error(initError))
-			return
-		}
+	// init meta
+	qc.meta, initError = newMeta(qc.loopCtx, qc.kvClient, qc.factory, qc.idAllocator)
+	if initError != nil {
+		log.Error("query coordinator init meta failed", zap.Error(initError))
+		return initError
+	}
 
-		qc.groupBalancer = newReplicaBalancer(qc.meta, qc.cluster)
+	// init channelUnsubscribeHandler
+	qc.handler, initError = newChannelUnsubscribeHandler(qc.loopCtx, qc.kvClient, qc.factory)
+	if initError != nil {
+		log.Error("query coordinator init channelUnsubscribeHandler failed", zap.Error(initError))
+		return initError
+	}
 
-		// NOTE: ignore the returned error
-		// we only try best to reload the leader addresses
-		reloadShardLeaderAddress(qc.meta, qc.cluster)
+	// init cluster
+	qc.cluster, initError = newQueryNodeCluster(qc.loopCtx, qc.meta, qc.kvClient, qc.newNodeFn, qc.session, qc.handler)
+	if initError != nil {
+		log.Error("query coordinator init cluster failed", zap.Error(initError))
+		return initError
+	}
 
-		qc.chunkManager, initError = qc.factory.NewVectorStorageChunkManager(qc.loopCtx)
+	qc.groupBalancer = newReplicaBalancer(qc.meta, qc.cluster)
 
-		if initError != nil {
-			log.Error("query coordinator init cluster failed", zap.Error(initError))
-			return
-		}
+	// NOTE: ignore the returned error
+	// we only try best to reload the leader addresses
+	reloadShardLeaderAddress(qc.meta, qc.cluster)
 
-		//init globalMetaBroker
-		qc.broker, initError = newGlobalMetaBroker(qc.loopCtx, qc.rootCoordClient, qc.dataCoordClient, qc.indexCoordClient, qc.chunkManager)
-		if initError != nil {
-			log.Error("query coordinator init globalMetaBroker failed", zap.Error(initError))
-			return
-		}
+	qc.chunkManager, initError = qc.factory.NewVectorStorageChunkManager(qc.loopCtx)
 
-		// init task scheduler
-		qc.scheduler, initError = newTaskScheduler(qc.loopCtx, qc.meta, qc.cluster, qc.kvClient, qc.broker, qc.idAllocator)
-		if initError != nil {
-			log.Error("query coordinator init task scheduler failed", zap.Error(initError))
-			return
-		}
+	if initError != nil {
+		log.Error("query coordinator init cluster failed", zap.Error(initError))
+		return initError
+	}
 
-		// init index checker
-		qc.indexChecker, initError = newIndexChecker(qc.loopCtx, qc.kvClient, qc.meta, qc.cluster, qc.scheduler, qc.broker)
-		if initError != nil {
-			log.Error("query coordinator init index checker failed", zap.Error(initError))
-			return
-		}
+	//init globalMetaBroker
+	qc.broker, initError = newGlobalMetaBroker(qc.loopCtx, qc.rootCoordClient, qc.dataCoordClient, qc.indexCoordClient, qc.chunkManager)
+	if initError != nil {
+		log.Error("query coordinator init globalMetaBroker failed", zap.Error(initError))
+		return initError
+	}
 
-		qc.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
-	})
-	log.Info("QueryCoord init success")
-	return initError
+	// init task scheduler
+	qc.scheduler, initError = newTaskScheduler(qc.loopCtx, qc.meta, qc.cluster, qc.kvClient, qc.broker, qc.idAllocator)
+	if initError != nil {
+		log.Error("query coordinator init task scheduler failed", zap.Error(initError))
+		return initError
+	}
+
+	// init index checker
+	qc.indexChecker, initError = newIndexChecker(qc.loopCtx, qc.kvClient, qc.meta, qc.cluster, qc.scheduler, qc.broker)
+	if initError != nil {
+		log.Error("query coordinator init index checker failed", zap.Error(initError))
+		return initError
+	}
+
+	qc.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
+	return nil
 }
 
-// Start function starts the goroutines to watch the meta and node updates
+// Start function starts the goroutines to watch the meta and node updates,
+// the standby QueryCoord starts after it becomes active
 func (qc *QueryCoord) Start() error {
+	if qc.standby {
+		log.Info("QueryCoord is standby, start after it becomes active")
+		return nil
+	}
+	return qc.start()
+}
+
+func (qc *QueryCoord) start() error {
 	qc.scheduler.Start()
 	log.Info("start scheduler ...")
 
@@ -244,11 +296,24 @@ func (qc *QueryCoord) Start() error {
 	qc.loopWg.Add(1)
 	go qc.watchHandoffSegmentLoop()
 
+	qc.loopWg.Add(1)
+	go qc.watchNodeDrainLoop()
+
 	if Params.QueryCoordCfg.AutoBalance {
 		qc.loopWg.Add(1)
 		go qc.loadBalanceSegmentLoop()
 	}
 
+	if Params.QueryCoordCfg.SkewBalanceEnabled {
+		qc.loopWg.Add(1)
+		go qc.skewBalanceLoop()
+	}
+
+	if Params.QueryCoordCfg.ChannelBalanceEnabled {
+		qc.loopWg.Add(1)
+		go qc.channelBalanceLoop()
+	}
+
 	qc.UpdateStateCode(internalpb.StateCode_Healthy)
 
 	return nil
@@ -298,6 +363,8 @@ func NewQueryCoord(ctx context.Context, factory dependency.Factory) (*QueryCoord
 		loopCancel: cancel,
 		factory:    factory,
 		newNodeFn:  newQueryNode,
+
+		handingOffNodes: make(map[int64]struct{}),
 	}
 
 	service.UpdateStateCode(internalpb.StateCode_Abnormal)
@@ -382,6 +449,10 @@ func (qc *QueryCoord) watchNodeLoop() {
 }
 
 func (qc *QueryCoord) allocateNode(nodeID int64) error {
+	if qc.cluster.isCordoned(nodeID) {
+		log.Info("query node is cordoned, skip allocating it to replicas", zap.Int64("nodeID", nodeID))
+		return nil
+	}
 	plans, err := qc.groupBalancer.addNode(nodeID)
 	if err != nil {
 		return err
@@ -486,6 +557,7 @@ func (qc *QueryCoord) handleNodeEvent(ctx context.Context) {
 				}
 
 				qc.cluster.stopNode(serverID)
+				qc.scheduler.failNodeTasks(serverID)
 				loadBalanceSegment := &querypb.LoadBalanceRequest{
 					Base: &commonpb.MsgBase{
 						MsgType:  commonpb.MsgType_LoadBalanceSegments,
@@ -576,149 +648,140 @@ func (qc *QueryCoord) loadBalanceSegmentLoop() {
 				pos = 0
 				collectionInfos = qc.meta.showCollections()
 			}
-			// get mem info of online nodes from cluster
-			nodeID2MemUsageRate := make(map[int64]float64)
-			nodeID2MemUsage := make(map[int64]uint64)
-			nodeID2TotalMem := make(map[int64]uint64)
-			loadBalanceTasks := make([]*loadBalanceTask, 0)
 			// balance at most 20 collections in a round
-			for i := 0; pos < len(collectionInfos) && i < 20; i, pos = i+1, pos+1 {
-				info := collectionInfos[pos]
-				replicas, err := qc.meta.getReplicasByCollectionID(info.GetCollectionID())
-				if err != nil {
-					log.Warn("unable to get replicas of collection", zap.Int64("collectionID", info.GetCollectionID()))
-					continue
-				}
-				for _, replica := range replicas {
-					// auto balance is executed on replica level
-					onlineNodeIDs := replica.GetNodeIds()
-					if len(onlineNodeIDs) == 0 {
-						log.Error("loadBalanceSegmentLoop: there are no online QueryNode to balance", zap.Int64("collection", replica.CollectionID), zap.Int64("replica", replica.ReplicaID))
-						continue
-					}
-					var availableNodeIDs []int64
-					nodeID2SegmentInfos := make(map[int64]map[UniqueID]*querypb.SegmentInfo)
-					for _, nodeID := range onlineNodeIDs {
-						if _, ok := nodeID2MemUsage[nodeID]; !ok {
-							nodeInfo, err := qc.cluster.getNodeInfoByID(nodeID)
-							if err != nil {
-								log.Warn("loadBalanceSegmentLoop: get node info from QueryNode failed",
-									zap.Int64("nodeID", nodeID), zap.Int64("collection", replica.CollectionID), zap.Int64("replica", replica.ReplicaID),
-									zap.Error(err))
-								continue
-							}
-							nodeID2MemUsageRate[nodeID] = nodeInfo.(*queryNode).memUsageRate
-							nodeID2MemUsage[nodeID] = nodeInfo.(*queryNode).memUsage
-							nodeID2TotalMem[nodeID] = nodeInfo.(*queryNode).totalMem
-						}
+			end := pos + 20
+			if end > len(collectionInfos) {
+				end = len(collectionInfos)
+			}
+			moves := qc.planMemoryBalance(ctx, collectionInfos[pos:end])
+			pos = end
+			qc.executeBalanceMoves(moves)
+		}
+	}
+}
 
-						updateSegmentInfoDone := true
-						leastSegmentInfos := make(map[UniqueID]*querypb.SegmentInfo)
-						segmentInfos := qc.meta.getSegmentInfosByNodeAndCollection(nodeID, replica.GetCollectionID())
-						for _, segmentInfo := range segmentInfos {
-							leastInfo, err := qc.cluster.getSegmentInfoByID(ctx, segmentInfo.SegmentID)
-							if err != nil {
-								log.Warn("loadBalanceSegmentLoop: get segment info from QueryNode failed", zap.Int64("nodeID", nodeID),
-									zap.Int64("collection", replica.CollectionID), zap.Int64("replica", replica.ReplicaID),
-									zap.Error(err))
-								updateSegmentInfoDone = false
-								break
-							}
-							leastSegmentInfos[segmentInfo.SegmentID] = leastInfo
-						}
-						if updateSegmentInfoDone {
-							availableNodeIDs = append(availableNodeIDs, nodeID)
-							nodeID2SegmentInfos[nodeID] = leastSegmentInfos
-						}
-					}
-					log.Info("loadBalanceSegmentLoop: memory usage rate of all online QueryNode", zap.Int64("collection", replica.CollectionID),
-						zap.Int64("replica", replica.ReplicaID), zap.Any("mem rate", nodeID2MemUsageRate))
-					if len(availableNodeIDs) <= 1 {
-						log.Info("loadBalanceSegmentLoop: there are too few available query nodes to balance",
-							zap.Int64("collection", replica.CollectionID), zap.Int64("replica", replica.ReplicaID),
-							zap.Int64s("onlineNodeIDs", onlineNodeIDs), zap.Int64s("availableNodeIDs", availableNodeIDs))
+// planMemoryBalance plans the segment moves from the nodes with high memory usage rate to the low ones
+// within each replica of the collections, the moves are not executed.
+func (qc *QueryCoord) planMemoryBalance(ctx context.Context, collectionInfos []*querypb.CollectionInfo) []*milvuspb.BalanceMove {
+	// get mem info of online nodes from cluster
+	nodeID2MemUsageRate := make(map[int64]float64)
+	nodeID2MemUsage := make(map[int64]uint64)
+	nodeID2TotalMem := make(map[int64]uint64)
+	moves := make([]*milvuspb.BalanceMove, 0)
+	for _, info := range collectionInfos {
+		replicas, err := qc.meta.getReplicasByCollectionID(info.GetCollectionID())
+		if err != nil {
+			log.Warn("unable to get replicas of collection", zap.Int64("collectionID", info.GetCollectionID()))
+			continue
+		}
+		for _, replica := range replicas {
+			// auto balance is executed on replica level
+			onlineNodeIDs := replica.GetNodeIds()
+			if len(onlineNodeIDs) == 0 {
+				log.Error("loadBalanceSegmentLoop: there are no online QueryNode to balance", zap.Int64("collection", replica.CollectionID), zap.Int64("replica", replica.ReplicaID))
+				continue
+			}
+			var availableNodeIDs []int64
+			nodeID2SegmentInfos := make(map[int64]map[UniqueID]*querypb.SegmentInfo)
+			for _, nodeID := range onlineNodeIDs {
+				if _, ok := nodeID2MemUsage[nodeID]; !ok {
+					nodeInfo, err := qc.cluster.getNodeInfoByID(nodeID)
+					if err != nil {
+						log.Warn("loadBalanceSegmentLoop: get node info from QueryNode failed",
+							zap.Int64("nodeID", nodeID), zap.Int64("collection", replica.CollectionID), zap.Int64("replica", replica.ReplicaID),
+							zap.Error(err))
 						continue
 					}
+					nodeID2MemUsageRate[nodeID] = nodeInfo.(*queryNode).memUsageRate
+					nodeID2MemUsage[nodeID] = nodeInfo.(*queryNode).memUsage
+					nodeID2TotalMem[nodeID] = nodeInfo.(*queryNode).totalMem
+				}
 
-					// check which nodes need balance and determine which segments on these nodes need to be migrated to other nodes
-					memoryInsufficient := false
-					for {
-						sort.Slice(availableNodeIDs, func(i, j int) bool {
-							return nodeID2MemUsageRate[availableNodeIDs[i]] > nodeID2MemUsageRate[availableNodeIDs[j]]
-						})
-
-						// the memoryUsageRate of the sourceNode is higher than other query node
-						sourceNodeID := availableNodeIDs[0]
-						dstNodeID := availableNodeIDs[len(availableNodeIDs)-1]
-						memUsageRateDiff := nodeID2MemUsageRate[sourceNodeID] - nodeID2MemUsageRate[dstNodeID]
-						if nodeID2MemUsageRate[sourceNodeID] <= Params.QueryCoordCfg.OverloadedMemoryThresholdPercentage &&
-							memUsageRateDiff <= Params.QueryCoordCfg.MemoryUsageMaxDifferencePercentage {
-							break
-						}
-						// if memoryUsageRate of source node is greater than 90%, and the max memUsageDiff is greater than 30%
-						// then migrate the segments on source node to other query nodes
-						segmentInfos := nodeID2SegmentInfos[sourceNodeID]
-						// select the segment that needs balance on the source node
-						selectedSegmentInfo, err := chooseSegmentToBalance(sourceNodeID, dstNodeID, segmentInfos, nodeID2MemUsage, nodeID2TotalMem, nodeID2MemUsageRate)
-						if err != nil {
-							// no enough memory on query nodes to balance, then notify proxy to stop insert
-							memoryInsufficient = true
-							break
-						}
-						if selectedSegmentInfo == nil {
-							break
-						}
-						// select a segment to balance successfully, then recursive traversal whether there are other segments that can balance
-						req := &querypb.LoadBalanceRequest{
-							Base: &commonpb.MsgBase{
-								MsgType: commonpb.MsgType_LoadBalanceSegments,
-							},
-							BalanceReason:    querypb.TriggerCondition_LoadBalance,
-							SourceNodeIDs:    []UniqueID{sourceNodeID},
-							DstNodeIDs:       []UniqueID{dstNodeID},
-							SealedSegmentIDs: []UniqueID{selectedSegmentInfo.SegmentID},
-						}
-						baseTask := newBaseTask(qc.loopCtx, querypb.TriggerCondition_LoadBalance)
-						balanceTask := &loadBalanceTask{
-							baseTask:           baseTask,
-							LoadBalanceRequest: req,
-							broker:             qc.broker,
-							cluster:            qc.cluster,
-							meta:               qc.meta,
-						}
-						log.Info("loadBalanceSegmentLoop: generate a loadBalance task",
+				updateSegmentInfoDone := true
+				leastSegmentInfos := make(map[UniqueID]*querypb.SegmentInfo)
+				segmentInfos := qc.meta.getSegmentInfosByNodeAndCollection(nodeID, replica.GetCollectionID())
+				for _, segmentInfo := range segmentInfos {
+					leastInfo, err := qc.cluster.getSegmentInfoByID(ctx, segmentInfo.SegmentID)
+					if err != nil {
+						log.Warn("loadBalanceSegmentLoop: get segment info from QueryNode failed", zap.Int64("nodeID", nodeID),
 							zap.Int64("collection", replica.CollectionID), zap.Int64("replica", replica.ReplicaID),
-							zap.Any("task", balanceTask))
-						loadBalanceTasks = append(loadBalanceTasks, balanceTask)
-						nodeID2MemUsage[sourceNodeID] -= uint64(selectedSegmentInfo.MemSize)
-						nodeID2MemUsage[dstNodeID] += uint64(selectedSegmentInfo.MemSize)
-						nodeID2MemUsageRate[sourceNodeID] = float64(nodeID2MemUsage[sourceNodeID]) / float64(nodeID2TotalMem[sourceNodeID])
-						nodeID2MemUsageRate[dstNodeID] = float64(nodeID2MemUsage[dstNodeID]) / float64(nodeID2TotalMem[dstNodeID])
-						delete(nodeID2SegmentInfos[sourceNodeID], selectedSegmentInfo.SegmentID)
-						nodeID2SegmentInfos[dstNodeID][selectedSegmentInfo.SegmentID] = selectedSegmentInfo
-						continue
-					}
-					if memoryInsufficient {
-						// no enough memory on query nodes to balance, then notify proxy to stop insert
-						//TODO:: xige-16
-						log.Warn("loadBalanceSegmentLoop: QueryNode has insufficient memory, stop inserting data", zap.Int64("collection", replica.CollectionID), zap.Int64("replica", replica.ReplicaID))
+							zap.Error(err))
+						updateSegmentInfoDone = false
+						break
 					}
+					leastSegmentInfos[segmentInfo.SegmentID] = leastInfo
+				}
+				if updateSegmentInfoDone {
+					availableNodeIDs = append(availableNodeIDs, nodeID)
+					nodeID2SegmentInfos[nodeID] = leastSegmentInfos
 				}
 			}
-			for _, t := range loadBalanceTasks {
-				qc.scheduler.Enqueue(t)
-				err := t.waitToFinish()
+			log.Info("loadBalanceSegmentLoop: memory usage rate of all online QueryNode", zap.Int64("collection", replica.CollectionID),
+				zap.Int64("replica", replica.ReplicaID), zap.Any("mem rate", nodeID2MemUsageRate))
+			if len(availableNodeIDs) <= 1 {
+				log.Info("loadBalanceSegmentLoop: there are too few available query nodes to balance",
+					zap.Int64("collection", replica.CollectionID), zap.Int64("replica", replica.ReplicaID),
+					zap.Int64s("onlineNodeIDs", onlineNodeIDs), zap.Int64s("availableNodeIDs", availableNodeIDs))
+				continue
+			}
+
+			// check which nodes need balance and determine which segments on these nodes need to be migrated to other nodes
+			memoryInsufficient := false
+			for {
+				sort.Slice(availableNodeIDs, func(i, j int) bool {
+					return nodeID2MemUsageRate[availableNodeIDs[i]] > nodeID2MemUsageRate[availableNodeIDs[j]]
+				})
+
+				// the memoryUsageRate of the sourceNode is higher than other query node
+				sourceNodeID := availableNodeIDs[0]
+				dstNodeID := availableNodeIDs[len(availableNodeIDs)-1]
+				memUsageRateDiff := nodeID2MemUsageRate[sourceNodeID] - nodeID2MemUsageRate[dstNodeID]
+				if nodeID2MemUsageRate[sourceNodeID] <= Params.QueryCoordCfg.OverloadedMemoryThresholdPercentage &&
+					memUsageRateDiff <= Params.QueryCoordCfg.MemoryUsageMaxDifferencePercentage {
+					break
+				}
+				// if memoryUsageRate of source node is greater than 90%, and the max memUsageDiff is greater than 30%
+				// then migrate the segments on source node to other query nodes
+				segmentInfos := nodeID2SegmentInfos[sourceNodeID]
+				// select the segment that needs balance on the source node
+				selectedSegmentInfo, err := chooseSegmentToBalance(sourceNodeID, dstNodeID, segmentInfos, nodeID2MemUsage, nodeID2TotalMem, nodeID2MemUsageRate)
 				if err != nil {
-					// if failed, wait for next balance loop
-					// it may be that the collection/partition of the balanced segment has been released
-					// it also may be other abnormal errors
-					log.Error("loadBalanceSegmentLoop: balance task execute failed", zap.Any("task", t))
-				} else {
-					log.Info("loadBalanceSegmentLoop: balance task execute success", zap.Any("task", t))
+					// no enough memory on query nodes to balance, then notify proxy to stop insert
+					memoryInsufficient = true
+					break
+				}
+				if selectedSegmentInfo == nil {
+					break
 				}
+				// select a segment to balance successfully, then recursive traversal whether there are other segments that can balance
+				move := &milvuspb.BalanceMove{
+					CollectionID: replica.GetCollectionID(),
+					ReplicaID:    replica.GetReplicaID(),
+					SegmentID:    selectedSegmentInfo.SegmentID,
+					SourceNodeID: sourceNodeID,
+					DstNodeID:    dstNodeID,
+					Reason:       memoryBalanceReason,
+				}
+				log.Info("loadBalanceSegmentLoop: plan a segment move",
+					zap.Int64("collection", replica.CollectionID), zap.Int64("replica", replica.ReplicaID),
+					zap.Any("move", move))
+				moves = append(moves, move)
+				nodeID2MemUsage[sourceNodeID] -= uint64(selectedSegmentInfo.MemSize)
+				nodeID2MemUsage[dstNodeID] += uint64(selectedSegmentInfo.MemSize)
+				nodeID2MemUsageRate[sourceNodeID] = float64(nodeID2MemUsage[sourceNodeID]) / float64(nodeID2TotalMem[sourceNodeID])
+				nodeID2MemUsageRate[dstNodeID] = float64(nodeID2MemUsage[dstNodeID]) / float64(nodeID2TotalMem[dstNodeID])
+				delete(nodeID2SegmentInfos[sourceNodeID], selectedSegmentInfo.SegmentID)
+				nodeID2SegmentInfos[dstNodeID][selectedSegmentInfo.SegmentID] = selectedSegmentInfo
+				continue
+			}
+			if memoryInsufficient {
+				// no enough memory on query nodes to balance, then notify proxy to stop insert
+				//TODO:: xige-16
+				log.Warn("loadBalanceSegmentLoop: QueryNode has insufficient memory, stop inserting data", zap.Int64("collection", replica.CollectionID), zap.Int64("replica", replica.ReplicaID))
 			}
 		}
 	}
+	return moves
 }
 
 func chooseSegmentToBalance(sourceNodeID int64, dstNodeID int64,
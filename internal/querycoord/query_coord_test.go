@@ -36,7 +36,10 @@ import (
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -443,6 +446,40 @@ func TestHandoffSegmentLoop(t *testing.T) {
 		waitTaskFinalState(handoffTask, taskFailed)
 	})
 
+	t.Run("Test swapSegmentIndex", func(t *testing.T) {
+		loadedSegment, err := queryCoord.meta.getSegmentInfoByID(defaultSegmentID)
+		assert.Nil(t, err)
+		baseTask := newBaseTask(baseCtx, querypb.TriggerCondition_Handoff)
+		segmentInfo := &querypb.SegmentInfo{
+			SegmentID:    defaultSegmentID,
+			CollectionID: defaultCollectionID,
+			PartitionID:  defaultPartitionID,
+			SegmentState: commonpb.SegmentState_Sealed,
+			IndexID:      indexID + 1,
+		}
+		handoffReq := &querypb.HandoffSegmentsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_HandoffSegments,
+			},
+			SegmentInfos: []*querypb.SegmentInfo{segmentInfo},
+		}
+		handoffTask := &handoffTask{
+			baseTask:               baseTask,
+			HandoffSegmentsRequest: handoffReq,
+			broker:                 queryCoord.broker,
+			cluster:                queryCoord.cluster,
+			meta:                   queryCoord.meta,
+		}
+		err = queryCoord.scheduler.Enqueue(handoffTask)
+		assert.Nil(t, err)
+
+		waitTaskFinalState(handoffTask, taskExpired)
+
+		swappedSegment, err := queryCoord.meta.getSegmentInfoByID(defaultSegmentID)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, loadedSegment.GetNodeIds(), swappedSegment.GetNodeIds())
+	})
+
 	t.Run("Test handoffCompactionSegment", func(t *testing.T) {
 		infos := queryCoord.meta.showSegmentInfos(defaultCollectionID, nil)
 		assert.NotEqual(t, 0, len(infos))
@@ -628,3 +665,239 @@ func TestLoadBalanceSegmentLoop(t *testing.T) {
 	err = removeAllSession()
 	assert.Nil(t, err)
 }
+
+func TestHandOffDrainingNodes(t *testing.T) {
+	refreshParams()
+	baseCtx := context.Background()
+
+	queryCoord, err := startQueryCoord(baseCtx)
+	assert.Nil(t, err)
+
+	queryNode1, err := startQueryNodeServer(baseCtx)
+	assert.Nil(t, err)
+	waitQueryNodeOnline(queryCoord.cluster, queryNode1.queryNodeID)
+
+	loadCollectionTask := genLoadCollectionTask(baseCtx, queryCoord)
+	err = queryCoord.scheduler.Enqueue(loadCollectionTask)
+	assert.Nil(t, err)
+	waitTaskFinalState(loadCollectionTask, taskExpired)
+
+	queryNode2, err := startQueryNodeServer(baseCtx)
+	assert.Nil(t, err)
+	waitQueryNodeOnline(queryCoord.cluster, queryNode2.queryNodeID)
+
+	drainKey := fmt.Sprintf("%s/%d", util.QueryNodeDrainPrefix, queryNode1.queryNodeID)
+	err = queryCoord.kvClient.Save(drainKey, util.QueryNodeDrainStateDraining)
+	assert.Nil(t, err)
+
+	queryCoord.handOffDrainingNodes()
+	// the node being handed off isn't handed off again
+	queryCoord.handOffDrainingNodes()
+
+	assert.Eventually(t, func() bool {
+		return !queryCoord.isHandingOff(queryNode1.queryNodeID)
+	}, 30*time.Second, 10*time.Millisecond)
+	value, err := queryCoord.kvClient.Load(drainKey)
+	assert.Nil(t, err)
+	assert.Equal(t, util.QueryNodeDrainStateHandedOff, value)
+	assert.False(t, queryCoord.cluster.hasNode(queryNode1.queryNodeID))
+	segmentInfos := queryCoord.meta.getSegmentInfosByNode(queryNode1.queryNodeID)
+	assert.Equal(t, 0, len(segmentInfos))
+
+	// handed off node is skipped
+	queryCoord.handOffDrainingNodes()
+	assert.False(t, queryCoord.isHandingOff(queryNode1.queryNodeID))
+
+	err = queryCoord.kvClient.Remove(drainKey)
+	assert.Nil(t, err)
+	queryNode1.stop()
+	queryNode2.stop()
+	queryCoord.Stop()
+	err = removeAllSession()
+	assert.Nil(t, err)
+}
+
+func TestQueryCoordActiveStandby(t *testing.T) {
+	refreshParams()
+	baseCtx := context.Background()
+
+	active, err := startQueryCoord(baseCtx)
+	assert.Nil(t, err)
+	queryNode, err := startQueryNodeServer(baseCtx)
+	assert.Nil(t, err)
+	waitQueryNodeOnline(active.cluster, queryNode.queryNodeID)
+
+	loadCollectionTask := genLoadCollectionTask(baseCtx, active)
+	err = active.scheduler.Enqueue(loadCollectionTask)
+	assert.Nil(t, err)
+	waitTaskFinalState(loadCollectionTask, taskExpired)
+
+	Params.QueryCoordCfg.EnableActiveStandby = true
+	defer func() {
+		Params.QueryCoordCfg.EnableActiveStandby = false
+	}()
+	standby, err := NewQueryCoordTest(baseCtx, dependency.NewDefaultFactory(true))
+	assert.Nil(t, err)
+	rootCoord := newRootCoordMock(baseCtx)
+	rootCoord.createCollection(defaultCollectionID)
+	rootCoord.createPartition(defaultCollectionID, defaultPartitionID)
+	standby.SetRootCoord(rootCoord)
+	standby.SetDataCoord(newDataCoordMock(baseCtx))
+	indexCoord, err := newIndexCoordMock(queryCoordTestDir)
+	assert.Nil(t, err)
+	standby.SetIndexCoord(indexCoord)
+	etcdCli, err := etcd.GetEtcdClient(&Params.EtcdCfg)
+	assert.Nil(t, err)
+	standby.SetEtcdClient(etcdCli)
+	err = standby.Init()
+	assert.Nil(t, err)
+	err = standby.Start()
+	assert.Nil(t, err)
+	registered := make(chan error, 1)
+	go func() {
+		registered <- standby.Register()
+	}()
+
+	// nothing is loaded or served before the standby one becomes active
+	time.Sleep(time.Second)
+	assert.True(t, standby.standby)
+	assert.Nil(t, standby.meta)
+	assert.NotEqual(t, internalpb.StateCode_Healthy, standby.stateCode.Load().(internalpb.StateCode))
+
+	active.Stop()
+	select {
+	case err = <-registered:
+		assert.Nil(t, err)
+	case <-time.After(time.Minute):
+		t.Fatal("standby QueryCoord is not activated after the active one stopped")
+	}
+	assert.False(t, standby.standby)
+	assert.Equal(t, internalpb.StateCode_Healthy, standby.stateCode.Load().(internalpb.StateCode))
+	assert.True(t, standby.meta.hasCollection(defaultCollectionID))
+
+	queryNode.stop()
+	standby.Stop()
+	err = removeAllSession()
+	assert.Nil(t, err)
+}
+
+func TestManualBalance(t *testing.T) {
+	refreshParams()
+	baseCtx := context.Background()
+
+	queryCoord, err := startQueryCoord(baseCtx)
+	assert.Nil(t, err)
+
+	queryNode1, err := startQueryNodeServer(baseCtx)
+	assert.Nil(t, err)
+	waitQueryNodeOnline(queryCoord.cluster, queryNode1.queryNodeID)
+
+	loadCollectionTask := genLoadCollectionTask(baseCtx, queryCoord)
+	err = queryCoord.scheduler.Enqueue(loadCollectionTask)
+	assert.Nil(t, err)
+	waitTaskFinalState(loadCollectionTask, taskExpired)
+
+	queryNode2, err := startQueryNodeServer(baseCtx)
+	assert.Nil(t, err)
+	waitQueryNodeOnline(queryCoord.cluster, queryNode2.queryNodeID)
+
+	// the planned moves are not executed in dry run
+	plan, err := queryCoord.Balance(baseCtx, &milvuspb.BalanceRequest{Base: &commonpb.MsgBase{}, DryRun: true})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, plan.GetStatus().GetErrorCode())
+	assert.True(t, plan.DryRun)
+	assert.NotNil(t, plan.Moves)
+	for _, move := range plan.Moves {
+		assert.Equal(t, defaultCollectionID, move.CollectionID)
+		assert.NotEqual(t, move.SourceNodeID, move.DstNodeID)
+		info, err := queryCoord.meta.getSegmentInfoByID(move.SegmentID)
+		assert.Nil(t, err)
+		assert.Contains(t, info.GetNodeIds(), move.SourceNodeID)
+	}
+
+	plan, err = queryCoord.Balance(baseCtx, &milvuspb.BalanceRequest{Base: &commonpb.MsgBase{}})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, plan.GetStatus().GetErrorCode())
+	assert.False(t, plan.DryRun)
+
+	queryNode1.stop()
+	queryNode2.stop()
+	queryCoord.Stop()
+	err = removeAllSession()
+	assert.Nil(t, err)
+}
+
+func TestCordonQueryNode(t *testing.T) {
+	refreshParams()
+	baseCtx := context.Background()
+
+	queryCoord, err := startQueryCoord(baseCtx)
+	assert.Nil(t, err)
+
+	queryNode1, err := startQueryNodeServer(baseCtx)
+	assert.Nil(t, err)
+	waitQueryNodeOnline(queryCoord.cluster, queryNode1.queryNodeID)
+
+	loadCollectionTask := genLoadCollectionTask(baseCtx, queryCoord)
+	err = queryCoord.scheduler.Enqueue(loadCollectionTask)
+	assert.Nil(t, err)
+	waitTaskFinalState(loadCollectionTask, taskExpired)
+
+	queryNode2, err := startQueryNodeServer(baseCtx)
+	assert.Nil(t, err)
+	waitQueryNodeOnline(queryCoord.cluster, queryNode2.queryNodeID)
+
+	// node id is required
+	resp, err := queryCoord.CordonNode(baseCtx, &milvuspb.CordonNodeRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	resp, err = queryCoord.CordonNode(baseCtx, &milvuspb.CordonNodeRequest{NodeID: -1})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	resp, err = queryCoord.UncordonNode(baseCtx, &milvuspb.UncordonNodeRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+	resp, err = queryCoord.CordonNode(baseCtx, &milvuspb.CordonNodeRequest{NodeID: queryNode1.queryNodeID})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 1, len(resp.GetNodes()))
+	assert.Equal(t, queryNode1.queryNodeID, resp.GetNodes()[0].GetNodeID())
+	assert.Equal(t, milvuspb.CordonedNodeState_CordonedNodeDraining, resp.GetNodes()[0].GetState())
+	assert.True(t, queryCoord.cluster.isCordoned(queryNode1.queryNodeID))
+	assert.ElementsMatch(t, []int64{1, queryNode1.queryNodeID}, queryCoord.cluster.(*queryNodeCluster).excludeCordonedNodes([]int64{1}))
+
+	queryCoord.drainCordonedNodes()
+
+	resp, err = queryCoord.GetCordonedNodes(baseCtx, &milvuspb.GetCordonedNodesRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp.GetNodes()))
+	assert.Equal(t, milvuspb.CordonedNodeState_CordonedNodeDrained, resp.GetNodes()[0].GetState())
+	assert.True(t, queryCoord.cluster.hasNode(queryNode1.queryNodeID))
+	assert.Equal(t, 0, len(queryCoord.meta.getSegmentInfosByNode(queryNode1.queryNodeID)))
+	assert.Equal(t, 0, len(queryCoord.meta.getDmChannelInfosByNodeID(queryNode1.queryNodeID)))
+	replicas, err := queryCoord.meta.getReplicasByNodeID(queryNode1.queryNodeID)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(replicas))
+
+	// cordoned nodes are reloaded
+	cluster := &queryNodeCluster{client: queryCoord.kvClient}
+	err = cluster.reloadCordonedNodes()
+	assert.Nil(t, err)
+	assert.True(t, cluster.isCordoned(queryNode1.queryNodeID))
+
+	resp, err = queryCoord.UncordonNode(baseCtx, &milvuspb.UncordonNodeRequest{NodeID: queryNode1.queryNodeID})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 0, len(resp.GetNodes()))
+	assert.False(t, queryCoord.cluster.isCordoned(queryNode1.queryNodeID))
+	replicas, err = queryCoord.meta.getReplicasByNodeID(queryNode1.queryNodeID)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(replicas))
+
+	queryNode1.stop()
+	queryNode2.stop()
+	queryCoord.Stop()
+	err = removeAllSession()
+	assert.Nil(t, err)
+}
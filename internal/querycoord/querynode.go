@@ -44,6 +44,8 @@ type Node interface {
 
 	releaseCollection(ctx context.Context, in *querypb.ReleaseCollectionRequest) error
 	releasePartitions(ctx context.Context, in *querypb.ReleasePartitionsRequest) error
+	releaseChannels(ctx context.Context, in *querypb.ReleaseChannelsRequest) error
+	refreshCollection(ctx context.Context, in *querypb.LoadPartitionsRequest) error
 
 	watchDmChannels(ctx context.Context, in *querypb.WatchDmChannelsRequest) error
 	watchDeltaChannels(ctx context.Context, in *querypb.WatchDeltaChannelsRequest) error
@@ -67,6 +69,7 @@ type Node interface {
 	getComponentInfo(ctx context.Context) *internalpb.ComponentInfo
 
 	getMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	getSegmentLoadingProgress(ctx context.Context, in *querypb.GetLoadingProgressRequest) (*querypb.GetSegmentLoadingProgressResponse, error)
 }
 
 type queryNode struct {
@@ -87,6 +90,9 @@ type queryNode struct {
 	memUsage     uint64
 	memUsageRate float64
 	cpuUsage     float64
+	// diskCacheUsageRate is the usage rate of the local vector cache of the node
+	diskCacheUsageRate float64
+	zone               string
 }
 
 func newQueryNode(ctx context.Context, address string, id UniqueID, kv *etcdkv.EtcdKV) (Node, error) {
@@ -332,6 +338,38 @@ func (qn *queryNode) releasePartitions(ctx context.Context, in *querypb.ReleaseP
 	return nil
 }
 
+func (qn *queryNode) releaseChannels(ctx context.Context, in *querypb.ReleaseChannelsRequest) error {
+	if !qn.isOnline() {
+		return nil
+	}
+
+	status, err := qn.client.ReleaseChannels(qn.ctx, in)
+	if err != nil {
+		return err
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(status.Reason)
+	}
+
+	return nil
+}
+
+func (qn *queryNode) refreshCollection(ctx context.Context, in *querypb.LoadPartitionsRequest) error {
+	if !qn.isOnline() {
+		return nil
+	}
+
+	status, err := qn.client.RefreshCollection(qn.ctx, in)
+	if err != nil {
+		return err
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(status.Reason)
+	}
+
+	return nil
+}
+
 func (qn *queryNode) getSegmentInfo(ctx context.Context, in *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 	if !qn.isOnline() {
 		return nil, fmt.Errorf("getSegmentInfo: queryNode %d is offline", qn.id)
@@ -375,6 +413,14 @@ func (qn *queryNode) getMetrics(ctx context.Context, in *milvuspb.GetMetricsRequ
 	return qn.client.GetMetrics(qn.ctx, in)
 }
 
+func (qn *queryNode) getSegmentLoadingProgress(ctx context.Context, in *querypb.GetLoadingProgressRequest) (*querypb.GetSegmentLoadingProgressResponse, error) {
+	if !qn.isOnline() {
+		return nil, fmt.Errorf("getSegmentLoadingProgress: queryNode %d is offline", qn.id)
+	}
+
+	return qn.client.GetSegmentLoadingProgress(qn.ctx, in)
+}
+
 func (qn *queryNode) loadSegments(ctx context.Context, in *querypb.LoadSegmentsRequest) error {
 	if !qn.isOnline() {
 		return errors.New("LoadSegments: queryNode is offline")
@@ -435,15 +481,22 @@ func (qn *queryNode) getNodeInfo() (Node, error) {
 	qn.totalMem = infos.HardwareInfos.Memory
 	qn.memUsage = infos.HardwareInfos.MemoryUsage
 	qn.memUsageRate = float64(qn.memUsage) / float64(qn.totalMem)
+	qn.diskCacheUsageRate = 0
+	if infos.DiskCacheCapacity > 0 {
+		qn.diskCacheUsageRate = float64(infos.DiskCacheUsage) / float64(infos.DiskCacheCapacity)
+	}
+	qn.zone = infos.SystemConfigurations.Zone
 	return &queryNode{
 		id:      qn.id,
 		address: qn.address,
 		state:   qn.state,
 
-		totalMem:     qn.totalMem,
-		memUsage:     qn.memUsage,
-		memUsageRate: qn.memUsageRate,
-		cpuUsage:     qn.cpuUsage,
+		totalMem:           qn.totalMem,
+		memUsage:           qn.memUsage,
+		memUsageRate:       qn.memUsageRate,
+		cpuUsage:           qn.cpuUsage,
+		diskCacheUsageRate: qn.diskCacheUsageRate,
+		zone:               qn.zone,
 	}, nil
 }
 
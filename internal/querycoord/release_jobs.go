@@ -0,0 +1,326 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+const releaseJobPrefix = "queryCoord-releaseJobs"
+
+// maxFinishedReleaseJobs is the number of finished release jobs kept for polling
+const maxFinishedReleaseJobs = 128
+
+// releaseJob states
+const (
+	releaseJobRunning   = "Running"
+	releaseJobCompleted = "Completed"
+	releaseJobFailed    = "Failed"
+)
+
+var releaseJobStates = map[string]milvuspb.ReleaseJobState{
+	releaseJobRunning:   milvuspb.ReleaseJobState_ReleaseJobRunning,
+	releaseJobCompleted: milvuspb.ReleaseJobState_ReleaseJobCompleted,
+	releaseJobFailed:    milvuspb.ReleaseJobState_ReleaseJobFailed,
+}
+
+// releaseJob records the progress of an asynchronous release, it's saved in etcd as json.
+type releaseJob struct {
+	JobID        int64  `json:"job_id"`
+	CollectionID int64  `json:"collection_id"`
+	State        string `json:"state"`
+	Reason       string `json:"reason,omitempty"`
+	StartTime    string `json:"start_time,omitempty"`
+	EndTime      string `json:"end_time,omitempty"`
+}
+
+func (job *releaseJob) toProto() *milvuspb.ReleaseJob {
+	return &milvuspb.ReleaseJob{
+		JobID:        job.JobID,
+		CollectionID: job.CollectionID,
+		State:        releaseJobStates[job.State],
+		Reason:       job.Reason,
+		StartTime:    job.StartTime,
+		EndTime:      job.EndTime,
+	}
+}
+
+// releaseJobManager tracks the release collection tasks, so that the asynchronous releases could be polled
+// by the job id, which is the id of the release task, and the duplicate releases could attach to the running one.
+type releaseJobManager struct {
+	client *etcdkv.EtcdKV
+	// saveMu serializes the writes to etcd, so that they are applied in the order of the changes,
+	// it's acquired before mu and held across the etcd writes, which are done without holding mu
+	saveMu   sync.Mutex
+	mu       sync.RWMutex
+	jobs     map[UniqueID]*releaseJob
+	finished []UniqueID
+	// done channels of the running jobs, closed when the jobs finish
+	done map[UniqueID]chan struct{}
+	// timestamps of the latest succeeded releases by collection
+	releasedTs map[UniqueID]Timestamp
+}
+
+func newReleaseJobManager(kv *etcdkv.EtcdKV) (*releaseJobManager, error) {
+	m := &releaseJobManager{
+		client:     kv,
+		jobs:       make(map[UniqueID]*releaseJob),
+		done:       make(map[UniqueID]chan struct{}),
+		releasedTs: make(map[UniqueID]Timestamp),
+	}
+	_, values, err := kv.LoadWithPrefix(releaseJobPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		job := &releaseJob{}
+		if err := json.Unmarshal([]byte(value), job); err != nil {
+			log.Warn("invalid release job, ignored", zap.String("value", value), zap.Error(err))
+			continue
+		}
+		m.jobs[job.JobID] = job
+		if job.State == releaseJobRunning {
+			m.done[job.JobID] = make(chan struct{})
+		} else {
+			m.finished = append(m.finished, job.JobID)
+		}
+	}
+	sort.Slice(m.finished, func(i, j int) bool { return m.finished[i] < m.finished[j] })
+	return m, nil
+}
+
+func releaseJobKey(jobID UniqueID) string {
+	return fmt.Sprintf("%s/%d", releaseJobPrefix, jobID)
+}
+
+// persist saves the jobs and removes the trimmed ones, so that the jobs could still be polled after QueryCoord restarts,
+// it must be called with saveMu held and mu not held
+func (m *releaseJobManager) persist(jobs []releaseJob, removed []UniqueID) {
+	// the jobs are for polling only, failing to persist them shouldn't fail the release
+	for i := range jobs {
+		value, err := json.Marshal(&jobs[i])
+		if err != nil {
+			log.Warn("failed to marshal release job", zap.Int64("jobID", jobs[i].JobID), zap.Error(err))
+			continue
+		}
+		if err = m.client.Save(releaseJobKey(jobs[i].JobID), string(value)); err != nil {
+			log.Warn("failed to save release job", zap.Int64("jobID", jobs[i].JobID), zap.Error(err))
+		}
+	}
+	for _, jobID := range removed {
+		if err := m.client.Remove(releaseJobKey(jobID)); err != nil {
+			log.Warn("failed to remove release job", zap.Int64("jobID", jobID), zap.Error(err))
+		}
+	}
+}
+
+// failOrphans fails the running jobs reloaded from etcd whose tasks are not reloaded, they will never be finished,
+// taskIDs are the ids of the trigger tasks reloaded
+func (m *releaseJobManager) failOrphans(taskIDs map[UniqueID]struct{}) {
+	if m == nil {
+		return
+	}
+	m.saveMu.Lock()
+	defer m.saveMu.Unlock()
+	var failed []releaseJob
+	var removed []UniqueID
+	m.mu.Lock()
+	for id, ch := range m.done {
+		if _, ok := taskIDs[id]; ok {
+			continue
+		}
+		job := m.jobs[id]
+		job.State = releaseJobFailed
+		job.Reason = "the release task is lost after QueryCoord restarted"
+		job.EndTime = time.Now().Format(time.RFC3339)
+		close(ch)
+		delete(m.done, id)
+		failed = append(failed, *job)
+		removed = append(removed, m.appendFinished(id)...)
+	}
+	m.mu.Unlock()
+	m.persist(failed, removed)
+}
+
+// appendFinished records the finished job, and removes the earliest finished ones beyond the limit,
+// it must be called with mu held, the ids of the removed jobs are returned to be removed from etcd
+func (m *releaseJobManager) appendFinished(jobID UniqueID) []UniqueID {
+	var removed []UniqueID
+	m.finished = append(m.finished, jobID)
+	for len(m.finished) > maxFinishedReleaseJobs {
+		delete(m.jobs, m.finished[0])
+		removed = append(removed, m.finished[0])
+		m.finished = m.finished[1:]
+	}
+	return removed
+}
+
+// start records a running release job of the task
+func (m *releaseJobManager) start(t *releaseCollectionTask) {
+	if m == nil {
+		return
+	}
+	m.saveMu.Lock()
+	defer m.saveMu.Unlock()
+	m.mu.Lock()
+	if _, ok := m.jobs[t.getTaskID()]; ok {
+		// the task may have been finished by the scheduler already
+		m.mu.Unlock()
+		return
+	}
+	job := &releaseJob{
+		JobID:        t.getTaskID(),
+		CollectionID: t.CollectionID,
+		State:        releaseJobRunning,
+		StartTime:    time.Now().Format(time.RFC3339),
+	}
+	m.jobs[job.JobID] = job
+	m.done[job.JobID] = make(chan struct{})
+	m.mu.Unlock()
+	m.persist([]releaseJob{*job}, nil)
+}
+
+// running returns the id of the running release job of the collection
+func (m *releaseJobManager) running(collectionID UniqueID) (UniqueID, bool) {
+	if m == nil {
+		return 0, false
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for id, job := range m.jobs {
+		if job.CollectionID == collectionID && job.State == releaseJobRunning {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// finish records the result of a release collection task, other tasks are ignored
+func (m *releaseJobManager) finish(t task, status *commonpb.Status) {
+	if m == nil {
+		return
+	}
+	releaseTask, ok := t.(*releaseCollectionTask)
+	if !ok {
+		return
+	}
+	m.saveMu.Lock()
+	defer m.saveMu.Unlock()
+	m.mu.Lock()
+	job, ok := m.jobs[releaseTask.getTaskID()]
+	if !ok {
+		// the task is reloaded from etcd after QueryCoord restarted
+		job = &releaseJob{
+			JobID:        releaseTask.getTaskID(),
+			CollectionID: releaseTask.CollectionID,
+		}
+		m.jobs[job.JobID] = job
+	}
+	job.State = releaseJobCompleted
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		job.State = releaseJobFailed
+		job.Reason = status.GetReason()
+	}
+	job.EndTime = time.Now().Format(time.RFC3339)
+	if job.State == releaseJobCompleted && releaseTask.Base.GetTimestamp() > m.releasedTs[job.CollectionID] {
+		m.releasedTs[job.CollectionID] = releaseTask.Base.GetTimestamp()
+	}
+	if ch, ok := m.done[job.JobID]; ok {
+		close(ch)
+		delete(m.done, job.JobID)
+	}
+	finished := *job
+	removed := m.appendFinished(job.JobID)
+	m.mu.Unlock()
+	m.persist([]releaseJob{finished}, removed)
+}
+
+// isReleased returns true if the collection has been released by a release whose timestamp is not older than ts,
+// the release requests with such timestamp are duplicate.
+func (m *releaseJobManager) isReleased(collectionID UniqueID, ts Timestamp) bool {
+	if m == nil || ts == 0 {
+		return false
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return ts <= m.releasedTs[collectionID]
+}
+
+// wait waits for the release job to finish, and returns a copy of the finished job, nil if not found
+func (m *releaseJobManager) wait(ctx context.Context, jobID UniqueID) (*releaseJob, error) {
+	if m == nil {
+		return nil, nil
+	}
+	m.mu.RLock()
+	ch, ok := m.done[jobID]
+	m.mu.RUnlock()
+	if ok {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ch:
+		}
+	}
+	return m.get(jobID), nil
+}
+
+// get returns a copy of the release job, nil if not found
+func (m *releaseJobManager) get(jobID UniqueID) *releaseJob {
+	if m == nil {
+		return nil
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	job, ok := m.jobs[jobID]
+	if !ok {
+		return nil
+	}
+	ret := *job
+	return &ret
+}
+
+// getAll returns the release jobs of the collection ordered by job id, all the jobs if collectionID is 0
+func (m *releaseJobManager) getAll(collectionID UniqueID) []releaseJob {
+	if m == nil {
+		return nil
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ret := make([]releaseJob, 0, len(m.jobs))
+	for _, job := range m.jobs {
+		if collectionID != 0 && job.CollectionID != collectionID {
+			continue
+		}
+		ret = append(ret, *job)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].JobID < ret[j].JobID
+	})
+	return ret
+}
@@ -0,0 +1,192 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+)
+
+func genReleaseCollectionTaskWithID(taskID UniqueID, collectionID UniqueID) *releaseCollectionTask {
+	task := &releaseCollectionTask{
+		baseTask: &baseTask{},
+		ReleaseCollectionRequest: &querypb.ReleaseCollectionRequest{
+			Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_ReleaseCollection, Timestamp: Timestamp(taskID)},
+			CollectionID: collectionID,
+		},
+	}
+	task.setTaskID(taskID)
+	return task
+}
+
+func newTestReleaseJobManager(t *testing.T) (*releaseJobManager, *etcdkv.EtcdKV) {
+	refreshParams()
+	etcdCli, err := etcd.GetEtcdClient(&Params.EtcdCfg)
+	assert.Nil(t, err)
+	t.Cleanup(func() { etcdCli.Close() })
+	kv := etcdkv.NewEtcdKV(etcdCli, Params.EtcdCfg.MetaRootPath)
+	kv.RemoveWithPrefix(releaseJobPrefix)
+	t.Cleanup(func() { kv.RemoveWithPrefix(releaseJobPrefix) })
+
+	m, err := newReleaseJobManager(kv)
+	assert.Nil(t, err)
+	return m, kv
+}
+
+func TestReleaseJobManager_duplicate(t *testing.T) {
+	m, _ := newTestReleaseJobManager(t)
+
+	task1 := genReleaseCollectionTaskWithID(10, defaultCollectionID)
+	m.start(task1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := m.wait(ctx, 10)
+	assert.Error(t, err)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		m.finish(task1, &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success})
+	}()
+	job, err := m.wait(context.Background(), 10)
+	assert.Nil(t, err)
+	assert.Equal(t, releaseJobCompleted, job.State)
+	// finished job returns immediately
+	job, err = m.wait(context.Background(), 10)
+	assert.Nil(t, err)
+	assert.Equal(t, releaseJobCompleted, job.State)
+
+	assert.True(t, m.isReleased(defaultCollectionID, 10))
+	assert.True(t, m.isReleased(defaultCollectionID, 5))
+	assert.False(t, m.isReleased(defaultCollectionID, 11))
+	assert.False(t, m.isReleased(defaultCollectionID, 0))
+	assert.False(t, m.isReleased(defaultCollectionID+1, 5))
+
+	// failed release doesn't make the requests duplicate
+	task2 := genReleaseCollectionTaskWithID(20, defaultCollectionID)
+	m.start(task2)
+	m.finish(task2, &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError})
+	assert.False(t, m.isReleased(defaultCollectionID, 20))
+}
+
+func TestReleaseJobManager(t *testing.T) {
+	m, kv := newTestReleaseJobManager(t)
+
+	task1 := genReleaseCollectionTaskWithID(1, defaultCollectionID)
+	m.start(task1)
+	jobID, ok := m.running(defaultCollectionID)
+	assert.True(t, ok)
+	assert.Equal(t, UniqueID(1), jobID)
+	_, ok = m.running(defaultCollectionID + 1)
+	assert.False(t, ok)
+
+	m.finish(task1, &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "query node down"})
+	job := m.get(1)
+	assert.Equal(t, releaseJobFailed, job.State)
+	assert.Equal(t, "query node down", job.Reason)
+	_, err := time.Parse(time.RFC3339, job.EndTime)
+	assert.NoError(t, err)
+	_, ok = m.running(defaultCollectionID)
+	assert.False(t, ok)
+
+	// re-drive the failed release
+	task2 := genReleaseCollectionTaskWithID(2, defaultCollectionID)
+	m.start(task2)
+	m.finish(task2, &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success})
+	assert.Equal(t, releaseJobCompleted, m.get(2).State)
+
+	// finished before started, or reloaded from etcd
+	task3 := genReleaseCollectionTaskWithID(3, defaultCollectionID)
+	m.finish(task3, &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success})
+	m.start(task3)
+	assert.Equal(t, releaseJobCompleted, m.get(3).State)
+
+	// other tasks are ignored
+	m.finish(&loadBalanceTask{baseTask: &baseTask{}}, &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success})
+	jobs := m.getAll(0)
+	assert.Equal(t, 3, len(jobs))
+	assert.Equal(t, UniqueID(1), jobs[0].JobID)
+	assert.Equal(t, UniqueID(3), jobs[2].JobID)
+
+	// filtered by collection
+	task4 := genReleaseCollectionTaskWithID(4, defaultCollectionID+1)
+	m.start(task4)
+	jobs = m.getAll(defaultCollectionID + 1)
+	assert.Equal(t, 1, len(jobs))
+	assert.Equal(t, releaseJobRunning, jobs[0].State)
+	_, err = time.Parse(time.RFC3339, jobs[0].StartTime)
+	assert.NoError(t, err)
+	assert.Equal(t, milvuspb.ReleaseJobState_ReleaseJobRunning, jobs[0].toProto().GetState())
+	assert.Equal(t, jobs[0].StartTime, jobs[0].toProto().GetStartTime())
+
+	// reload from kv, the running job is finished by the reloaded task
+	reloaded, err := newReleaseJobManager(kv)
+	assert.Nil(t, err)
+	assert.Equal(t, m.getAll(0), reloaded.getAll(0))
+	reloaded.failOrphans(map[UniqueID]struct{}{4: {}})
+	jobID, ok = reloaded.running(defaultCollectionID + 1)
+	assert.True(t, ok)
+	assert.Equal(t, UniqueID(4), jobID)
+	reloaded.finish(task4, &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success})
+	assert.Equal(t, releaseJobCompleted, reloaded.get(4).State)
+	m.finish(task4, &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success})
+
+	// the running job whose task is lost fails
+	task5 := genReleaseCollectionTaskWithID(5, defaultCollectionID+1)
+	m.start(task5)
+	reloaded, err = newReleaseJobManager(kv)
+	assert.Nil(t, err)
+	reloaded.failOrphans(map[UniqueID]struct{}{})
+	job, err = reloaded.wait(context.Background(), 5)
+	assert.Nil(t, err)
+	assert.Equal(t, releaseJobFailed, job.State)
+	_, ok = reloaded.running(defaultCollectionID + 1)
+	assert.False(t, ok)
+	m.finish(task5, &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError})
+
+	for i := 0; i < maxFinishedReleaseJobs; i++ {
+		task := genReleaseCollectionTaskWithID(UniqueID(100+i), defaultCollectionID)
+		m.finish(task, &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success})
+	}
+	assert.Nil(t, m.get(1))
+	assert.Equal(t, maxFinishedReleaseJobs, len(m.getAll(0)))
+	keys, _, err := kv.LoadWithPrefix(releaseJobPrefix)
+	assert.Nil(t, err)
+	assert.Equal(t, maxFinishedReleaseJobs, len(keys))
+
+	var nilManager *releaseJobManager
+	assert.False(t, nilManager.isReleased(defaultCollectionID, 1))
+	job, err = nilManager.wait(context.Background(), 1)
+	assert.Nil(t, err)
+	assert.Nil(t, job)
+	nilManager.start(task1)
+	nilManager.finish(task1, &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success})
+	_, ok = nilManager.running(defaultCollectionID)
+	assert.False(t, ok)
+	assert.Nil(t, nilManager.get(1))
+	assert.Nil(t, nilManager.getAll(0))
+	nilManager.failOrphans(nil)
+}
@@ -0,0 +1,198 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+// changeReplicaNumber adds or removes the replicas of the loaded collection online, the collection is served
+// by the other replicas during the change.
+func (lct *loadCollectionTask) changeReplicaNumber(ctx context.Context, collectionInfo *querypb.CollectionInfo) error {
+	log.Info("loadCollectionTask: change the replica number of the loaded collection",
+		zap.Int64("collectionID", lct.CollectionID),
+		zap.Int32("from", collectionInfo.ReplicaNumber),
+		zap.Int32("to", lct.ReplicaNumber),
+		zap.Int64("msgID", lct.Base.MsgID))
+	if lct.ReplicaNumber > collectionInfo.ReplicaNumber {
+		return lct.addReplicas(ctx, collectionInfo, int(lct.ReplicaNumber-collectionInfo.ReplicaNumber))
+	}
+	return lct.removeReplicas(ctx, collectionInfo, int(collectionInfo.ReplicaNumber-lct.ReplicaNumber))
+}
+
+// addReplicas loads the collection as new replicas on the nodes not serving the collection, the new replicas
+// are standby until all the segments and channels are loaded.
+func (lct *loadCollectionTask) addReplicas(ctx context.Context, collectionInfo *querypb.CollectionInfo, num int) error {
+	collectionID := lct.CollectionID
+	partitionIds := collectionInfo.PartitionIDs
+	segmentLoadInfos, _, mergedDmChannel, collectionSize, err := lct.getRecoveryInfos(ctx, partitionIds)
+	if err != nil {
+		return err
+	}
+
+	replicas, err := lct.meta.getReplicasByCollectionID(collectionID)
+	if err != nil {
+		return err
+	}
+	var servingNodes []UniqueID
+	for _, replica := range replicas {
+		servingNodes = append(servingNodes, replica.NodeIds...)
+	}
+
+	newReplicas := make([]*milvuspb.ReplicaInfo, num)
+	for i := range newReplicas {
+		newReplicas[i], err = lct.meta.generateReplica(collectionID, partitionIds)
+		if err != nil {
+			return err
+		}
+	}
+	err = lct.cluster.assignNodesToReplicas(ctx, newReplicas, collectionSize, servingNodes...)
+	if err != nil {
+		log.Error("failed to assign nodes to new replicas",
+			zap.Int64("collectionID", collectionID),
+			zap.Int64("msgID", lct.Base.MsgID),
+			zap.Int("replicaNumber", num),
+			zap.Error(err))
+		return err
+	}
+
+	for _, replica := range newReplicas {
+		err = lct.assignReplicaChildTasks(ctx, replica, partitionIds, segmentLoadInfos, mergedDmChannel)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, replica := range newReplicas {
+		err = lct.meta.addStandbyReplica(replica)
+		if err != nil {
+			// the added replicas are removed in postExecute
+			log.Error("failed to add new replica", zap.Int64("collectionID", collectionID), zap.Int64("replicaID", replica.ReplicaID), zap.Error(err))
+			return err
+		}
+		lct.addedReplicas = append(lct.addedReplicas, replica)
+		log.Info("loadCollectionTask: add a standby replica",
+			zap.Int64("collectionID", collectionID),
+			zap.Int64("replicaID", replica.ReplicaID),
+			zap.Int64s("nodeIDs", replica.NodeIds))
+	}
+	return nil
+}
+
+// removeReplicas removes the latest replicas of the collection from meta, so that they are not routed any more,
+// then releases the collection on their nodes.
+func (lct *loadCollectionTask) removeReplicas(ctx context.Context, collectionInfo *querypb.CollectionInfo, num int) error {
+	replicaIDs := collectionInfo.ReplicaIds
+	if num > len(replicaIDs) {
+		return fmt.Errorf("failed to remove %d replicas of collection %d, which has %d replicas", num, lct.CollectionID, len(replicaIDs))
+	}
+	for _, replicaID := range replicaIDs[len(replicaIDs)-num:] {
+		replica, err := lct.meta.getReplicaByID(replicaID)
+		if err != nil {
+			return err
+		}
+		err = lct.meta.removeReplica(replica)
+		if err != nil {
+			log.Error("failed to remove replica", zap.Int64("collectionID", lct.CollectionID), zap.Int64("replicaID", replicaID), zap.Error(err))
+			return err
+		}
+		lct.removedReplicas = append(lct.removedReplicas, replica)
+		log.Info("loadCollectionTask: remove a replica",
+			zap.Int64("collectionID", lct.CollectionID),
+			zap.Int64("replicaID", replicaID),
+			zap.Int64s("nodeIDs", replica.NodeIds))
+
+		for _, task := range lct.releaseReplicaTasks(ctx, replica) {
+			lct.addChildTask(task)
+		}
+	}
+	return nil
+}
+
+// releaseReplicaTasks returns the tasks releasing the collection on the nodes of the replica
+func (lct *loadCollectionTask) releaseReplicaTasks(ctx context.Context, replica *milvuspb.ReplicaInfo) []task {
+	tasks := make([]task, 0, len(replica.NodeIds))
+	for _, nodeID := range replica.NodeIds {
+		msgBase := proto.Clone(lct.Base).(*commonpb.MsgBase)
+		msgBase.MsgType = commonpb.MsgType_ReleaseCollection
+		req := &querypb.ReleaseCollectionRequest{
+			Base:         msgBase,
+			DbID:         lct.DbID,
+			CollectionID: lct.CollectionID,
+			NodeID:       nodeID,
+		}
+		baseTask := newBaseTask(ctx, querypb.TriggerCondition_GrpcRequest)
+		baseTask.setParentTask(lct)
+		tasks = append(tasks, &releaseCollectionTask{
+			baseTask:                 baseTask,
+			ReleaseCollectionRequest: req,
+			cluster:                  lct.cluster,
+		})
+	}
+	return tasks
+}
+
+// removeAddedReplicas removes the replicas added by the task from meta
+func (lct *loadCollectionTask) removeAddedReplicas() {
+	for _, replica := range lct.addedReplicas {
+		err := lct.meta.removeReplica(replica)
+		if err != nil {
+			log.Error("failed to remove the added replica", zap.Int64("collectionID", lct.CollectionID), zap.Int64("replicaID", replica.ReplicaID), zap.Error(err))
+			panic(err)
+		}
+	}
+}
+
+// rollBackReplicaChange releases the replicas added by the task, the removed replicas are not recovered
+// since their nodes may have released the collection.
+func (lct *loadCollectionTask) rollBackReplicaChange(ctx context.Context) []task {
+	lct.removeAddedReplicas()
+	resultTasks := make([]task, 0)
+	for _, replica := range lct.addedReplicas {
+		resultTasks = append(resultTasks, lct.releaseReplicaTasks(ctx, replica)...)
+	}
+	lct.addedReplicas = nil
+
+	log.Info("loadCollectionTask: generate rollBack task for replica change", zap.Int64("collectionID", lct.CollectionID), zap.Int64("msgID", lct.Base.MsgID))
+	return resultTasks
+}
+
+// globalPostExecute promotes the added replicas to serve the requests after they are loaded
+func (lct *loadCollectionTask) globalPostExecute(ctx context.Context) error {
+	if lct.getResultInfo().ErrorCode != commonpb.ErrorCode_Success {
+		return nil
+	}
+	for _, replica := range lct.addedReplicas {
+		err := lct.meta.promoteReplica(replica.CollectionID, replica.ReplicaID)
+		if err != nil {
+			return err
+		}
+		log.Info("loadCollectionTask: the added replica is loaded and promoted",
+			zap.Int64("collectionID", replica.CollectionID),
+			zap.Int64("replicaID", replica.ReplicaID))
+	}
+	return nil
+}
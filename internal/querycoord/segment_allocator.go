@@ -27,8 +27,16 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	segmentAllocatePolicyResource = "resource"
+	segmentAllocatePolicyMemory   = "memory"
+)
+
 func defaultSegAllocatePolicy() SegmentAllocatePolicy {
-	return shuffleSegmentsToQueryNodeV2
+	if Params.QueryCoordCfg.SegmentAllocatePolicy == segmentAllocatePolicyMemory {
+		return shuffleSegmentsToQueryNodeV2
+	}
+	return shuffleSegmentsToQueryNodeByResource
 }
 
 const shuffleWaitInterval = 1 * time.Second
@@ -96,18 +104,10 @@ func shuffleSegmentsToQueryNode(ctx context.Context, reqs []*querypb.LoadSegment
 }
 
 func shuffleSegmentsToQueryNodeV2(ctx context.Context, reqs []*querypb.LoadSegmentsRequest, cluster Cluster, metaCache Meta, wait bool, excludeNodeIDs []int64, includeNodeIDs []int64, replicaID int64) error {
-	// key = offset, value = segmentSize
 	if len(reqs) == 0 {
 		return nil
 	}
-	dataSizePerReq := make([]int64, len(reqs))
-	for offset, req := range reqs {
-		reqSize := int64(0)
-		for _, loadInfo := range req.Infos {
-			reqSize += loadInfo.SegmentSize
-		}
-		dataSizePerReq[offset] = reqSize
-	}
+	dataSizePerReq := getDataSizePerReq(reqs)
 
 	log.Info("shuffleSegmentsToQueryNodeV2: get the segment size of loadReqs end", zap.Int64s("segment size of reqs", dataSizePerReq))
 	for {
@@ -115,20 +115,9 @@ func shuffleSegmentsToQueryNodeV2(ctx context.Context, reqs []*querypb.LoadSegme
 		totalMem := make(map[int64]uint64)
 		memUsage := make(map[int64]uint64)
 		memUsageRate := make(map[int64]float64)
-		var onlineNodeIDs []int64
-		if replicaID == -1 {
-			onlineNodeIDs = cluster.onlineNodeIDs()
-		} else {
-			replica, err := metaCache.getReplicaByID(replicaID)
-			if err != nil {
-				return err
-			}
-			replicaNodes := replica.GetNodeIds()
-			for _, nodeID := range replicaNodes {
-				if ok, err := cluster.isOnline(nodeID); err == nil && ok {
-					onlineNodeIDs = append(onlineNodeIDs, nodeID)
-				}
-			}
+		onlineNodeIDs, nodes, err := getAllocatableNodes(cluster, metaCache, excludeNodeIDs, includeNodeIDs, replicaID)
+		if err != nil {
+			return err
 		}
 		if len(onlineNodeIDs) == 0 && !wait {
 			err := errors.New("no online queryNode to allocate")
@@ -137,32 +126,10 @@ func shuffleSegmentsToQueryNodeV2(ctx context.Context, reqs []*querypb.LoadSegme
 		}
 
 		var availableNodeIDs []int64
-		for _, nodeID := range onlineNodeIDs {
-			// nodeID not in includeNodeIDs
-			if len(includeNodeIDs) > 0 && !nodeIncluded(nodeID, includeNodeIDs) {
-				continue
-			}
-
-			// nodeID in excludeNodeIDs
-			if nodeIncluded(nodeID, excludeNodeIDs) {
-				continue
-			}
-			// statistic nodeInfo, used memory, memory usage of every query node
-			nodeInfo, err := cluster.getNodeInfoByID(nodeID)
-			if err != nil {
-				log.Warn("shuffleSegmentsToQueryNodeV2: getNodeInfoByID failed", zap.Error(err))
-				continue
-			}
-			queryNodeInfo := nodeInfo.(*queryNode)
-			// avoid allocate segment to node which memUsageRate is high
-			if queryNodeInfo.memUsageRate >= Params.QueryCoordCfg.OverloadedMemoryThresholdPercentage {
-				log.Info("shuffleSegmentsToQueryNodeV2: queryNode memUsageRate large than MaxMemUsagePerNode", zap.Int64("nodeID", nodeID), zap.Float64("current rate", queryNodeInfo.memUsageRate))
-				continue
-			}
-
+		for _, node := range nodes {
 			// update totalMem, memUsage, memUsageRate
-			totalMem[nodeID], memUsage[nodeID], memUsageRate[nodeID] = queryNodeInfo.totalMem, queryNodeInfo.memUsage, queryNodeInfo.memUsageRate
-			availableNodeIDs = append(availableNodeIDs, nodeID)
+			totalMem[node.id], memUsage[node.id], memUsageRate[node.id] = node.totalMem, node.memUsage, node.memUsageRate
+			availableNodeIDs = append(availableNodeIDs, node.id)
 		}
 		if len(availableNodeIDs) > 0 {
 			log.Info("shuffleSegmentsToQueryNodeV2: shuffle segment to available QueryNode", zap.Int64s("available nodeIDs", availableNodeIDs))
@@ -218,6 +185,145 @@ func shuffleSegmentsToQueryNodeV2(ctx context.Context, reqs []*querypb.LoadSegme
 	}
 }
 
+// shuffleSegmentsToQueryNodeByResource allocates each load segment request to the query node with the lowest load score,
+// which weighs the memory usage after loading, the CPU usage and the local vector cache usage reported by the nodes,
+// so that the nodes of different sizes and the skewed segments don't overload some of the nodes.
+func shuffleSegmentsToQueryNodeByResource(ctx context.Context, reqs []*querypb.LoadSegmentsRequest, cluster Cluster, metaCache Meta, wait bool, excludeNodeIDs []int64, includeNodeIDs []int64, replicaID int64) error {
+	if len(reqs) == 0 {
+		return nil
+	}
+	dataSizePerReq := getDataSizePerReq(reqs)
+
+	for {
+		onlineNodeIDs, nodes, err := getAllocatableNodes(cluster, metaCache, excludeNodeIDs, includeNodeIDs, replicaID)
+		if err != nil {
+			return err
+		}
+		if len(nodes) > 0 {
+			dstNodeIDs, ok := allocateByResource(dataSizePerReq, nodes)
+			if ok {
+				for offset, nodeID := range dstNodeIDs {
+					reqs[offset].DstNodeID = nodeID
+				}
+				log.Info("shuffleSegmentsToQueryNodeByResource: shuffle segment to query node success",
+					zap.Int64s("segment size of reqs", dataSizePerReq), zap.Int64s("dst nodeIDs", dstNodeIDs))
+				return nil
+			}
+			if !wait {
+				err := errors.New("shuffleSegmentsToQueryNodeByResource: insufficient memory of available node")
+				log.Error("shuffleSegmentsToQueryNode failed", zap.Int64s("online nodeIDs", onlineNodeIDs), zap.Int64s("exclude nodeIDs", excludeNodeIDs), zap.Int64s("include nodeIDs", includeNodeIDs), zap.Error(err))
+				return err
+			}
+		} else if !wait {
+			err := errors.New("no available queryNode to allocate")
+			log.Error("shuffleSegmentsToQueryNode failed", zap.Int64s("online nodeIDs", onlineNodeIDs), zap.Int64s("exclude nodeIDs", excludeNodeIDs), zap.Int64s("include nodeIDs", includeNodeIDs), zap.Error(err))
+			return err
+		}
+
+		time.Sleep(shuffleWaitInterval)
+	}
+}
+
+// allocateByResource returns the node to allocate each request to, the node with the lowest load score after loading the
+// previous requests is chosen. False is returned if some request can't be allocated without overloading the memory.
+func allocateByResource(dataSizePerReq []int64, nodes []*queryNode) ([]int64, bool) {
+	memUsage := make(map[int64]uint64, len(nodes))
+	for _, node := range nodes {
+		memUsage[node.id] = node.memUsage
+	}
+	dstNodeIDs := make([]int64, len(dataSizePerReq))
+	for offset, sizeOfReq := range dataSizePerReq {
+		var selected *queryNode
+		var minScore float64
+		for _, node := range nodes {
+			if node.totalMem == 0 {
+				continue
+			}
+			memUsageRateAfterLoad := float64(memUsage[node.id]+uint64(sizeOfReq)) / float64(node.totalMem)
+			if memUsageRateAfterLoad > Params.QueryCoordCfg.OverloadedMemoryThresholdPercentage {
+				continue
+			}
+			score := resourceScore(memUsageRateAfterLoad, node.cpuUsage, node.diskCacheUsageRate)
+			if selected == nil || score < minScore {
+				selected, minScore = node, score
+			}
+		}
+		if selected == nil {
+			return nil, false
+		}
+		dstNodeIDs[offset] = selected.id
+		memUsage[selected.id] += uint64(sizeOfReq)
+	}
+	return dstNodeIDs, true
+}
+
+// resourceScore returns the load score of a query node, cpuUsage is in percentage
+func resourceScore(memUsageRate float64, cpuUsage float64, diskCacheUsageRate float64) float64 {
+	return Params.QueryCoordCfg.ResourceBalanceMemoryWeight*memUsageRate +
+		Params.QueryCoordCfg.ResourceBalanceCPUWeight*cpuUsage/100 +
+		Params.QueryCoordCfg.ResourceBalanceDiskCacheWeight*diskCacheUsageRate
+}
+
+// getDataSizePerReq returns the size of the segments of each load segment request
+func getDataSizePerReq(reqs []*querypb.LoadSegmentsRequest) []int64 {
+	dataSizePerReq := make([]int64, len(reqs))
+	for offset, req := range reqs {
+		reqSize := int64(0)
+		for _, loadInfo := range req.Infos {
+			reqSize += loadInfo.SegmentSize
+		}
+		dataSizePerReq[offset] = reqSize
+	}
+	return dataSizePerReq
+}
+
+// getAllocatableNodes returns the online nodes of the replica, or of the cluster if replicaID is -1,
+// and the infos of the ones the segments could be allocated to, which are not overloaded by memory.
+func getAllocatableNodes(cluster Cluster, metaCache Meta, excludeNodeIDs []int64, includeNodeIDs []int64, replicaID int64) ([]int64, []*queryNode, error) {
+	var onlineNodeIDs []int64
+	if replicaID == -1 {
+		onlineNodeIDs = cluster.onlineNodeIDs()
+	} else {
+		replica, err := metaCache.getReplicaByID(replicaID)
+		if err != nil {
+			return nil, nil, err
+		}
+		replicaNodes := replica.GetNodeIds()
+		for _, nodeID := range replicaNodes {
+			if ok, err := cluster.isOnline(nodeID); err == nil && ok {
+				onlineNodeIDs = append(onlineNodeIDs, nodeID)
+			}
+		}
+	}
+
+	var nodes []*queryNode
+	for _, nodeID := range onlineNodeIDs {
+		// nodeID not in includeNodeIDs
+		if len(includeNodeIDs) > 0 && !nodeIncluded(nodeID, includeNodeIDs) {
+			continue
+		}
+
+		// nodeID in excludeNodeIDs
+		if nodeIncluded(nodeID, excludeNodeIDs) {
+			continue
+		}
+		// statistic nodeInfo, used memory, memory usage of every query node
+		nodeInfo, err := cluster.getNodeInfoByID(nodeID)
+		if err != nil {
+			log.Warn("getAllocatableNodes: getNodeInfoByID failed", zap.Error(err))
+			continue
+		}
+		queryNodeInfo := nodeInfo.(*queryNode)
+		// avoid allocate segment to node which memUsageRate is high
+		if queryNodeInfo.memUsageRate >= Params.QueryCoordCfg.OverloadedMemoryThresholdPercentage {
+			log.Info("getAllocatableNodes: queryNode memUsageRate large than MaxMemUsagePerNode", zap.Int64("nodeID", nodeID), zap.Float64("current rate", queryNodeInfo.memUsageRate))
+			continue
+		}
+		nodes = append(nodes, queryNodeInfo)
+	}
+	return onlineNodeIDs, nodes, nil
+}
+
 func nodeIncluded(nodeID int64, includeNodeIDs []int64) bool {
 	for _, id := range includeNodeIDs {
 		if id == nodeID {
@@ -132,6 +132,51 @@ func TestShuffleSegmentsToQueryNode(t *testing.T) {
 		assert.Equal(t, node2ID, secondReq.DstNodeID)
 	})
 
+	t.Run("Test shuffleSegmentsToQueryNodeByResource", func(t *testing.T) {
+		err = shuffleSegmentsToQueryNodeByResource(baseCtx, reqs, cluster, meta, false, nil, nil, -1)
+		assert.Nil(t, err)
+
+		assert.Equal(t, node2ID, firstReq.DstNodeID)
+		assert.Equal(t, node2ID, secondReq.DstNodeID)
+
+		err = shuffleSegmentsToQueryNodeByResource(baseCtx, reqs, cluster, meta, false, []int64{node2ID}, nil, -1)
+		assert.NotNil(t, err)
+	})
+
 	err = removeAllSession()
 	assert.Nil(t, err)
 }
+
+func TestAllocateByResource(t *testing.T) {
+	refreshParams()
+
+	small := &queryNode{id: 1, totalMem: 100, memUsage: 10, cpuUsage: 10}
+	large := &queryNode{id: 2, totalMem: 1000, memUsage: 300, cpuUsage: 10}
+	busy := &queryNode{id: 3, totalMem: 1000, memUsage: 100, cpuUsage: 100, diskCacheUsageRate: 1}
+
+	t.Run("prefer the node of low memory usage rate rather than the one of few bytes used", func(t *testing.T) {
+		dstNodeIDs, ok := allocateByResource([]int64{10}, []*queryNode{small, large})
+		assert.True(t, ok)
+		assert.Equal(t, []int64{1}, dstNodeIDs)
+	})
+
+	t.Run("the memory usage after loading the previous requests counts", func(t *testing.T) {
+		dstNodeIDs, ok := allocateByResource([]int64{20, 20, 20}, []*queryNode{small, large})
+		assert.True(t, ok)
+		assert.Equal(t, []int64{1, 2, 2}, dstNodeIDs)
+	})
+
+	t.Run("avoid the node of high cpu and disk cache usage", func(t *testing.T) {
+		dstNodeIDs, ok := allocateByResource([]int64{10}, []*queryNode{large, busy})
+		assert.True(t, ok)
+		assert.Equal(t, []int64{2}, dstNodeIDs)
+	})
+
+	t.Run("insufficient memory", func(t *testing.T) {
+		_, ok := allocateByResource([]int64{1000}, []*queryNode{small, large, busy})
+		assert.False(t, ok)
+
+		_, ok = allocateByResource([]int64{10}, []*queryNode{{id: 4}})
+		assert.False(t, ok)
+	})
+}
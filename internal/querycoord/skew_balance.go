@@ -0,0 +1,217 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+const (
+	skewReasonSegmentBytes = "segment bytes"
+	skewReasonQPS          = "qps"
+)
+
+// nodeSkewLoad is the load of a query node within a replica
+type nodeSkewLoad struct {
+	nodeID   int64
+	bytes    int64
+	qps      float64
+	segments []*querypb.SegmentInfo
+}
+
+func newNodeSkewLoad(nodeID int64, segments []*querypb.SegmentInfo, qps float64) *nodeSkewLoad {
+	load := &nodeSkewLoad{
+		nodeID:   nodeID,
+		qps:      qps,
+		segments: segments,
+	}
+	for _, segment := range segments {
+		load.bytes += segment.GetMemSize()
+	}
+	return load
+}
+
+// skewBalancePlan migrates a segment from the hot node to the cold node of a replica
+type skewBalancePlan struct {
+	sourceNodeID int64
+	dstNodeID    int64
+	segment      *querypb.SegmentInfo
+	reason       string
+}
+
+// skewBalanceLoop migrates segments between the nodes of a replica when the segment bytes or QPS
+// of a node far exceed the others, while the memory based balance only kicks in when the memory is nearly used up.
+func (qc *QueryCoord) skewBalanceLoop() {
+	ctx, cancel := context.WithCancel(qc.loopCtx)
+	defer cancel()
+	defer qc.loopWg.Done()
+	log.Info("QueryCoord start skew balance loop")
+
+	ticker := time.NewTicker(Params.QueryCoordCfg.SkewBalanceInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			qc.balanceSkewedReplicas(ctx)
+		}
+	}
+}
+
+// balanceSkewedReplicas migrates at most one segment per replica in a round,
+// the loads are collected again in the next round to see whether the replica is still skewed.
+func (qc *QueryCoord) balanceSkewedReplicas(ctx context.Context) {
+	qc.executeBalanceMoves(qc.planSkewBalanceMoves(ctx))
+}
+
+// planSkewBalanceMoves plans at most one segment move per skewed replica, the moves are not executed
+func (qc *QueryCoord) planSkewBalanceMoves(ctx context.Context) []*milvuspb.BalanceMove {
+	nodesQPS := qc.getNodesQPS(ctx)
+	moves := make([]*milvuspb.BalanceMove, 0)
+	for _, info := range qc.meta.showCollections() {
+		replicas, err := qc.meta.getReplicasByCollectionID(info.GetCollectionID())
+		if err != nil {
+			log.Warn("skewBalance: unable to get replicas of collection", zap.Int64("collectionID", info.GetCollectionID()), zap.Error(err))
+			continue
+		}
+		for _, replica := range replicas {
+			var loads []*nodeSkewLoad
+			for _, nodeID := range replica.GetNodeIds() {
+				if online, err := qc.cluster.isOnline(nodeID); err != nil || !online {
+					continue
+				}
+				segments := qc.meta.getSegmentInfosByNodeAndCollection(nodeID, replica.GetCollectionID())
+				loads = append(loads, newNodeSkewLoad(nodeID, segments, nodesQPS[nodeID]))
+			}
+			plan := planSkewBalance(loads)
+			if plan == nil {
+				continue
+			}
+			log.Info("skewBalance: plan a segment move",
+				zap.Int64("collection", replica.GetCollectionID()), zap.Int64("replica", replica.GetReplicaID()),
+				zap.String("reason", plan.reason), zap.Int64("sourceNodeID", plan.sourceNodeID), zap.Int64("dstNodeID", plan.dstNodeID),
+				zap.Int64("segmentID", plan.segment.GetSegmentID()), zap.Int64("memSize", plan.segment.GetMemSize()))
+			moves = append(moves, &milvuspb.BalanceMove{
+				CollectionID: replica.GetCollectionID(),
+				ReplicaID:    replica.GetReplicaID(),
+				SegmentID:    plan.segment.GetSegmentID(),
+				SourceNodeID: plan.sourceNodeID,
+				DstNodeID:    plan.dstNodeID,
+				Reason:       plan.reason,
+			})
+		}
+	}
+	return moves
+}
+
+// getNodesQPS collects the QPS reported by the online query nodes
+func (qc *QueryCoord) getNodesQPS(ctx context.Context) map[int64]float64 {
+	nodesQPS := make(map[int64]float64)
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.NodeLoadMetrics)
+	if err != nil {
+		log.Warn("skewBalance: failed to construct node load request", zap.Error(err))
+		return nodesQPS
+	}
+	for _, nodeMetrics := range qc.cluster.getMetrics(ctx, req) {
+		if nodeMetrics.err != nil || nodeMetrics.resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			continue
+		}
+		load := metricsinfo.QueryNodeLoad{}
+		if err := json.Unmarshal([]byte(nodeMetrics.resp.GetResponse()), &load); err != nil {
+			log.Warn("skewBalance: invalid node load of query node was found",
+				zap.String("component", nodeMetrics.resp.GetComponentName()), zap.Error(err))
+			continue
+		}
+		nodesQPS[load.NodeID] = load.QPS
+	}
+	return nodesQPS
+}
+
+// planSkewBalance returns the segment to migrate if the replica is skewed, the segment bytes are checked before the QPS.
+// A node is skewed if its load exceeds SkewBalanceRatio times the average load of the nodes of the replica,
+// the QPS skew is ignored if the average QPS is lower than SkewBalanceMinQPS.
+func planSkewBalance(loads []*nodeSkewLoad) *skewBalancePlan {
+	if len(loads) <= 1 {
+		return nil
+	}
+	ratio := Params.QueryCoordCfg.SkewBalanceRatio
+	var totalBytes int64
+	var totalQPS float64
+	for _, load := range loads {
+		totalBytes += load.bytes
+		totalQPS += load.qps
+	}
+	avgBytes := float64(totalBytes) / float64(len(loads))
+	avgQPS := totalQPS / float64(len(loads))
+
+	sort.Slice(loads, func(i, j int) bool { return loads[i].bytes > loads[j].bytes })
+	source, dst := loads[0], loads[len(loads)-1]
+	if avgBytes > 0 && float64(source.bytes) > ratio*avgBytes {
+		// the bytes diff decreases only if the segment is smaller than the diff, the best one is the closest to the half
+		diff := source.bytes - dst.bytes
+		segment := chooseSkewedSegment(source.segments, float64(diff)/2, func(segment *querypb.SegmentInfo) bool {
+			return segment.GetMemSize() > 0 && segment.GetMemSize() < diff
+		})
+		if segment != nil {
+			return &skewBalancePlan{sourceNodeID: source.nodeID, dstNodeID: dst.nodeID, segment: segment, reason: skewReasonSegmentBytes}
+		}
+	}
+
+	sort.Slice(loads, func(i, j int) bool { return loads[i].qps > loads[j].qps })
+	source, dst = loads[0], loads[len(loads)-1]
+	if avgQPS >= Params.QueryCoordCfg.SkewBalanceMinQPS && source.qps > ratio*avgQPS && source.bytes > 0 {
+		// assume the QPS served by a node is proportional to its segment bytes,
+		// the migration must not make the bytes of the cold node skewed
+		target := float64(source.bytes) * (source.qps - dst.qps) / (2 * source.qps)
+		segment := chooseSkewedSegment(source.segments, target, func(segment *querypb.SegmentInfo) bool {
+			return segment.GetMemSize() > 0 && float64(dst.bytes+segment.GetMemSize()) <= ratio*avgBytes
+		})
+		if segment != nil {
+			return &skewBalancePlan{sourceNodeID: source.nodeID, dstNodeID: dst.nodeID, segment: segment, reason: skewReasonQPS}
+		}
+	}
+	return nil
+}
+
+// chooseSkewedSegment returns the segment whose size is the closest to target among the ones satisfying filter
+func chooseSkewedSegment(segments []*querypb.SegmentInfo, target float64, filter func(segment *querypb.SegmentInfo) bool) *querypb.SegmentInfo {
+	var selected *querypb.SegmentInfo
+	minDistance := math.MaxFloat64
+	for _, segment := range segments {
+		if !filter(segment) {
+			continue
+		}
+		distance := math.Abs(float64(segment.GetMemSize()) - target)
+		if distance < minDistance || distance == minDistance && segment.GetSegmentID() < selected.GetSegmentID() {
+			selected, minDistance = segment, distance
+		}
+	}
+	return selected
+}
@@ -0,0 +1,89 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func genSkewSegments(sizes map[UniqueID]int64) []*querypb.SegmentInfo {
+	segments := make([]*querypb.SegmentInfo, 0, len(sizes))
+	for segmentID, size := range sizes {
+		segments = append(segments, &querypb.SegmentInfo{SegmentID: segmentID, MemSize: size})
+	}
+	return segments
+}
+
+func TestPlanSkewBalance(t *testing.T) {
+	refreshParams()
+	Params.QueryCoordCfg.SkewBalanceRatio = 1.5
+	Params.QueryCoordCfg.SkewBalanceMinQPS = 10
+
+	// too few nodes
+	assert.Nil(t, planSkewBalance([]*nodeSkewLoad{newNodeSkewLoad(1, genSkewSegments(map[UniqueID]int64{1: 100}), 100)}))
+
+	// balanced
+	assert.Nil(t, planSkewBalance([]*nodeSkewLoad{
+		newNodeSkewLoad(1, genSkewSegments(map[UniqueID]int64{1: 100, 2: 100}), 20),
+		newNodeSkewLoad(2, genSkewSegments(map[UniqueID]int64{3: 150}), 20),
+	}))
+
+	// segment bytes skewed, the segment closest to the half of the diff is migrated
+	plan := planSkewBalance([]*nodeSkewLoad{
+		newNodeSkewLoad(1, genSkewSegments(map[UniqueID]int64{1: 100, 2: 400, 3: 500, 4: 1000}), 0),
+		newNodeSkewLoad(2, genSkewSegments(map[UniqueID]int64{5: 100}), 0),
+		newNodeSkewLoad(3, genSkewSegments(map[UniqueID]int64{6: 200}), 0),
+	})
+	assert.NotNil(t, plan)
+	assert.Equal(t, skewReasonSegmentBytes, plan.reason)
+	assert.Equal(t, int64(1), plan.sourceNodeID)
+	assert.Equal(t, int64(2), plan.dstNodeID)
+	assert.Equal(t, UniqueID(4), plan.segment.GetSegmentID())
+
+	// the only segment is too large to reduce the skew
+	assert.Nil(t, planSkewBalance([]*nodeSkewLoad{
+		newNodeSkewLoad(1, genSkewSegments(map[UniqueID]int64{1: 1000}), 0),
+		newNodeSkewLoad(2, nil, 0),
+	}))
+
+	// QPS skewed
+	plan = planSkewBalance([]*nodeSkewLoad{
+		newNodeSkewLoad(1, genSkewSegments(map[UniqueID]int64{1: 100, 2: 300}), 100),
+		newNodeSkewLoad(2, genSkewSegments(map[UniqueID]int64{3: 200, 4: 200}), 10),
+		newNodeSkewLoad(3, genSkewSegments(map[UniqueID]int64{5: 400}), 10),
+	})
+	assert.NotNil(t, plan)
+	assert.Equal(t, skewReasonQPS, plan.reason)
+	assert.Equal(t, int64(1), plan.sourceNodeID)
+	assert.Equal(t, UniqueID(1), plan.segment.GetSegmentID())
+
+	// the migration makes the bytes of the cold node skewed
+	assert.Nil(t, planSkewBalance([]*nodeSkewLoad{
+		newNodeSkewLoad(1, genSkewSegments(map[UniqueID]int64{1: 400}), 100),
+		newNodeSkewLoad(2, genSkewSegments(map[UniqueID]int64{2: 400}), 10),
+	}))
+
+	// QPS too low
+	assert.Nil(t, planSkewBalance([]*nodeSkewLoad{
+		newNodeSkewLoad(1, genSkewSegments(map[UniqueID]int64{1: 100, 2: 300}), 9),
+		newNodeSkewLoad(2, genSkewSegments(map[UniqueID]int64{3: 400}), 0),
+	}))
+}
@@ -0,0 +1,64 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// standbyReplicaPrefix is the prefix of the standby replicas, the key is {prefix}/{collectionID}/{replicaID}.
+// A standby replica loads the segments and channels as usual, but its shard leaders are not returned to the proxies
+// until it's promoted, either by removing the key or by QueryCoord when no serving replica is available for a shard.
+const standbyReplicaPrefix = "queryCoord-standbyReplica"
+
+func standbyReplicaCollectionPrefix(collectionID UniqueID) string {
+	return fmt.Sprintf("%s/%d/", standbyReplicaPrefix, collectionID)
+}
+
+func standbyReplicaKey(collectionID, replicaID UniqueID) string {
+	return fmt.Sprintf("%s/%d/%d", standbyReplicaPrefix, collectionID, replicaID)
+}
+
+// getStandbyReplicas returns the IDs of the standby replicas of the collection
+func getStandbyReplicas(kv kv.MetaKv, collectionID UniqueID) (map[UniqueID]struct{}, error) {
+	keys, _, err := kv.LoadWithPrefix(standbyReplicaCollectionPrefix(collectionID))
+	if err != nil {
+		return nil, err
+	}
+	replicaIDs := make(map[UniqueID]struct{}, len(keys))
+	for _, key := range keys {
+		replicaID, err := strconv.ParseInt(path.Base(key), 10, 64)
+		if err != nil {
+			log.Warn("invalid standby replica key", zap.String("key", key))
+			continue
+		}
+		replicaIDs[replicaID] = struct{}{}
+	}
+	return replicaIDs, nil
+}
+
+// promoteStandbyReplica makes the standby replica serve the requests as other replicas
+func promoteStandbyReplica(kv kv.MetaKv, collectionID, replicaID UniqueID) error {
+	return kv.Remove(standbyReplicaKey(collectionID, replicaID))
+}
@@ -84,6 +84,8 @@ type task interface {
 	waitToFinish() error
 	notify(err error)
 	taskPriority() querypb.TriggerCondition
+	getLoadPriority() int64
+	setLoadPriority(priority int64)
 	setParentTask(t task)
 	getParentTask() task
 	getChildTask() []task
@@ -118,6 +120,8 @@ type baseTask struct {
 	parentTask       task
 	childTasks       []task
 	childTasksMu     sync.RWMutex
+	// loadPriority orders the load tasks of the same trigger condition in the queue, the higher first
+	loadPriority int64
 
 	timeRecorder *timerecord.TimeRecorder
 }
@@ -172,6 +176,14 @@ func (bt *baseTask) taskPriority() querypb.TriggerCondition {
 	return bt.triggerCondition
 }
 
+func (bt *baseTask) getLoadPriority() int64 {
+	return bt.loadPriority
+}
+
+func (bt *baseTask) setLoadPriority(priority int64) {
+	bt.loadPriority = priority
+}
+
 func (bt *baseTask) setParentTask(t task) {
 	bt.parentTask = t
 }
@@ -298,6 +310,10 @@ type loadCollectionTask struct {
 	cluster Cluster
 	meta    Meta
 	once    sync.Once
+	// replicaChange is set if the task changes the replica number of the loaded collection instead of loading it
+	replicaChange   bool
+	addedReplicas   []*milvuspb.ReplicaInfo
+	removedReplicas []*milvuspb.ReplicaInfo
 }
 
 func (lct *loadCollectionTask) msgBase() *commonpb.MsgBase {
@@ -393,6 +409,17 @@ func (lct *loadCollectionTask) execute(ctx context.Context) error {
 	defer lct.reduceRetryCount()
 	collectionID := lct.CollectionID
 
+	if collectionInfo, err := lct.meta.getCollectionInfoByID(collectionID); err == nil &&
+		collectionInfo.LoadType == querypb.LoadType_LoadCollection && collectionInfo.ReplicaNumber != lct.ReplicaNumber {
+		lct.replicaChange = true
+		err = lct.changeReplicaNumber(ctx, collectionInfo)
+		if err != nil {
+			lct.setResultInfo(err)
+			return err
+		}
+		return nil
+	}
+
 	partitionIds, err := lct.broker.showPartitionIDs(ctx, collectionID)
 	if err != nil {
 		log.Error("loadCollectionTask: showPartition failed", zap.Int64("collectionID", collectionID), zap.Int64("msgID", lct.Base.MsgID), zap.Error(err))
@@ -402,41 +429,15 @@ func (lct *loadCollectionTask) execute(ctx context.Context) error {
 	log.Info("loadCollectionTask: get collection's all partitionIDs", zap.Int64("collectionID", collectionID), zap.Int64s("partitionIDs", partitionIds), zap.Int64("msgID", lct.Base.MsgID))
 
 	var (
-		replicas          = make([]*milvuspb.ReplicaInfo, lct.ReplicaNumber)
-		replicaIds        = make([]int64, lct.ReplicaNumber)
-		segmentLoadInfos  = make([]*querypb.SegmentLoadInfo, 0)
-		deltaChannelInfos = make([]*datapb.VchannelInfo, 0)
-		dmChannelInfos    = make([]*datapb.VchannelInfo, 0)
-		collectionSize    uint64
+		replicas   = make([]*milvuspb.ReplicaInfo, lct.ReplicaNumber)
+		replicaIds = make([]int64, lct.ReplicaNumber)
 	)
 
-	for _, partitionID := range partitionIds {
-		vChannelInfos, binlogs, err := lct.broker.getRecoveryInfo(lct.ctx, collectionID, partitionID)
-		if err != nil {
-			log.Error("loadCollectionTask: getRecoveryInfo failed", zap.Int64("collectionID", collectionID), zap.Int64("partitionID", partitionID), zap.Int64("msgID", lct.Base.MsgID), zap.Error(err))
-			lct.setResultInfo(err)
-			return err
-		}
-
-		for _, segmentBinlog := range binlogs {
-			segmentLoadInfo := lct.broker.generateSegmentLoadInfo(ctx, collectionID, partitionID, segmentBinlog, true, lct.Schema)
-			collectionSize += uint64(segmentLoadInfo.SegmentSize)
-			segmentLoadInfos = append(segmentLoadInfos, segmentLoadInfo)
-		}
-
-		for _, info := range vChannelInfos {
-			deltaChannelInfo, err := generateWatchDeltaChannelInfo(info)
-			if err != nil {
-				log.Error("loadCollectionTask: generateWatchDeltaChannelInfo failed", zap.Int64("collectionID", collectionID), zap.String("channelName", info.ChannelName), zap.Int64("msgID", lct.Base.MsgID), zap.Error(err))
-				lct.setResultInfo(err)
-				return err
-			}
-			deltaChannelInfos = append(deltaChannelInfos, deltaChannelInfo)
-			dmChannelInfos = append(dmChannelInfos, info)
-		}
+	segmentLoadInfos, mergedDeltaChannels, mergedDmChannel, collectionSize, err := lct.getRecoveryInfos(ctx, partitionIds)
+	if err != nil {
+		lct.setResultInfo(err)
+		return err
 	}
-
-	mergedDeltaChannels := mergeWatchDeltaChannelInfo(deltaChannelInfos)
 	// If meta is not updated here, deltaChannel meta will not be available when loadSegment reschedule
 	err = lct.meta.setDeltaChannel(collectionID, mergedDeltaChannels)
 	if err != nil {
@@ -445,8 +446,6 @@ func (lct *loadCollectionTask) execute(ctx context.Context) error {
 		return err
 	}
 
-	mergedDmChannel := mergeDmChannelInfo(dmChannelInfos)
-
 	for i := range replicas {
 		replica, err := lct.meta.generateReplica(lct.CollectionID, partitionIds)
 		if err != nil {
@@ -471,81 +470,11 @@ func (lct *loadCollectionTask) execute(ctx context.Context) error {
 	}
 
 	for _, replica := range replicas {
-		var (
-			loadSegmentReqs    = []*querypb.LoadSegmentsRequest{}
-			watchDmChannelReqs = []*querypb.WatchDmChannelsRequest{}
-		)
-
-		for _, segmentLoadInfo := range segmentLoadInfos {
-			msgBase := proto.Clone(lct.Base).(*commonpb.MsgBase)
-			msgBase.MsgType = commonpb.MsgType_LoadSegments
-			loadSegmentReq := &querypb.LoadSegmentsRequest{
-				Base:         msgBase,
-				Infos:        []*querypb.SegmentLoadInfo{segmentLoadInfo},
-				Schema:       lct.Schema,
-				CollectionID: collectionID,
-				LoadMeta: &querypb.LoadMetaInfo{
-					LoadType:     querypb.LoadType_LoadCollection,
-					CollectionID: collectionID,
-					PartitionIDs: partitionIds,
-				},
-				ReplicaID: replica.ReplicaID,
-			}
-
-			loadSegmentReqs = append(loadSegmentReqs, loadSegmentReq)
-		}
-
-		//TODO:: queryNode receive dm message according partitionID cache
-		//TODO:: queryNode add partitionID to cache if receive create partition message from dmChannel
-		for _, info := range mergedDmChannel {
-			msgBase := proto.Clone(lct.Base).(*commonpb.MsgBase)
-			msgBase.MsgType = commonpb.MsgType_WatchDmChannels
-			watchRequest := &querypb.WatchDmChannelsRequest{
-				Base:         msgBase,
-				CollectionID: collectionID,
-				//PartitionIDs: toLoadPartitionIDs,
-				Infos:  []*datapb.VchannelInfo{info},
-				Schema: lct.Schema,
-				LoadMeta: &querypb.LoadMetaInfo{
-					LoadType:     querypb.LoadType_LoadCollection,
-					CollectionID: collectionID,
-					PartitionIDs: partitionIds,
-				},
-				ReplicaID: replica.GetReplicaID(),
-			}
-
-			watchDmChannelReqs = append(watchDmChannelReqs, watchRequest)
-		}
-
-		internalTasks, err := assignInternalTask(ctx, lct, lct.meta, lct.cluster, loadSegmentReqs, watchDmChannelReqs, false, nil, replica.GetNodeIds(), -1)
+		err = lct.assignReplicaChildTasks(ctx, replica, partitionIds, segmentLoadInfos, mergedDmChannel)
 		if err != nil {
-			log.Error("loadCollectionTask: assign child task failed", zap.Int64("collectionID", collectionID), zap.Int64("msgID", lct.Base.MsgID), zap.Error(err))
 			lct.setResultInfo(err)
 			return err
 		}
-		for _, internalTask := range internalTasks {
-			lct.addChildTask(internalTask)
-			if task, ok := internalTask.(*watchDmChannelTask); ok {
-				nodeInfo, err := lct.cluster.getNodeInfoByID(task.NodeID)
-				if err != nil {
-					log.Error("loadCollectionTask: get shard leader node info failed",
-						zap.Int64("collectionID", collectionID),
-						zap.Int64("msgID", lct.Base.MsgID),
-						zap.Int64("nodeID", task.NodeID),
-						zap.Error(err))
-					lct.setResultInfo(err)
-					return err
-				}
-				replica.ShardReplicas = append(replica.ShardReplicas, &milvuspb.ShardReplica{
-					LeaderID:      task.NodeID,
-					LeaderAddr:    nodeInfo.(*queryNode).address,
-					DmChannelName: task.WatchDmChannelsRequest.Infos[0].ChannelName,
-				})
-			}
-			log.Info("loadCollectionTask: add a childTask", zap.Int64("collectionID", collectionID), zap.Int32("task type", int32(internalTask.msgType())), zap.Int64("msgID", lct.Base.MsgID))
-		}
-		metrics.QueryCoordNumChildTasks.WithLabelValues().Add(float64(len(internalTasks)))
-		log.Info("loadCollectionTask: assign child task done", zap.Int64("collectionID", collectionID), zap.Int64("msgID", lct.Base.MsgID))
 	}
 
 	err = lct.meta.addCollection(collectionID, querypb.LoadType_LoadCollection, lct.Schema)
@@ -577,14 +506,138 @@ func (lct *loadCollectionTask) execute(ctx context.Context) error {
 	return nil
 }
 
+// getRecoveryInfos returns the segments to load, the merged delta and dm channels of the partitions, and the size of the segments
+func (lct *loadCollectionTask) getRecoveryInfos(ctx context.Context, partitionIds []UniqueID) ([]*querypb.SegmentLoadInfo, []*datapb.VchannelInfo, map[string]*datapb.VchannelInfo, uint64, error) {
+	var (
+		collectionID      = lct.CollectionID
+		segmentLoadInfos  = make([]*querypb.SegmentLoadInfo, 0)
+		deltaChannelInfos = make([]*datapb.VchannelInfo, 0)
+		dmChannelInfos    = make([]*datapb.VchannelInfo, 0)
+		collectionSize    uint64
+	)
+
+	for _, partitionID := range partitionIds {
+		vChannelInfos, binlogs, err := lct.broker.getRecoveryInfo(lct.ctx, collectionID, partitionID)
+		if err != nil {
+			log.Error("loadCollectionTask: getRecoveryInfo failed", zap.Int64("collectionID", collectionID), zap.Int64("partitionID", partitionID), zap.Int64("msgID", lct.Base.MsgID), zap.Error(err))
+			return nil, nil, nil, 0, err
+		}
+
+		for _, segmentBinlog := range binlogs {
+			segmentLoadInfo := lct.broker.generateSegmentLoadInfo(ctx, collectionID, partitionID, segmentBinlog, true, lct.Schema)
+			collectionSize += uint64(segmentLoadInfo.SegmentSize)
+			segmentLoadInfos = append(segmentLoadInfos, segmentLoadInfo)
+		}
+
+		for _, info := range vChannelInfos {
+			deltaChannelInfo, err := generateWatchDeltaChannelInfo(info)
+			if err != nil {
+				log.Error("loadCollectionTask: generateWatchDeltaChannelInfo failed", zap.Int64("collectionID", collectionID), zap.String("channelName", info.ChannelName), zap.Int64("msgID", lct.Base.MsgID), zap.Error(err))
+				return nil, nil, nil, 0, err
+			}
+			deltaChannelInfos = append(deltaChannelInfos, deltaChannelInfo)
+			dmChannelInfos = append(dmChannelInfos, info)
+		}
+	}
+
+	return segmentLoadInfos, mergeWatchDeltaChannelInfo(deltaChannelInfos), mergeDmChannelInfo(dmChannelInfos), collectionSize, nil
+}
+
+// assignReplicaChildTasks assigns the segments and channels to the nodes of the replica as the child tasks,
+// and records the shard leaders in the replica
+func (lct *loadCollectionTask) assignReplicaChildTasks(ctx context.Context, replica *milvuspb.ReplicaInfo, partitionIds []UniqueID,
+	segmentLoadInfos []*querypb.SegmentLoadInfo, mergedDmChannel map[string]*datapb.VchannelInfo) error {
+	collectionID := lct.CollectionID
+	var (
+		loadSegmentReqs    = []*querypb.LoadSegmentsRequest{}
+		watchDmChannelReqs = []*querypb.WatchDmChannelsRequest{}
+	)
+
+	for _, segmentLoadInfo := range segmentLoadInfos {
+		msgBase := proto.Clone(lct.Base).(*commonpb.MsgBase)
+		msgBase.MsgType = commonpb.MsgType_LoadSegments
+		loadSegmentReq := &querypb.LoadSegmentsRequest{
+			Base:         msgBase,
+			Infos:        []*querypb.SegmentLoadInfo{segmentLoadInfo},
+			Schema:       lct.Schema,
+			CollectionID: collectionID,
+			LoadMeta: &querypb.LoadMetaInfo{
+				LoadType:     querypb.LoadType_LoadCollection,
+				CollectionID: collectionID,
+				PartitionIDs: partitionIds,
+			},
+			ReplicaID: replica.ReplicaID,
+		}
+
+		loadSegmentReqs = append(loadSegmentReqs, loadSegmentReq)
+	}
+
+	//TODO:: queryNode receive dm message according partitionID cache
+	//TODO:: queryNode add partitionID to cache if receive create partition message from dmChannel
+	for _, info := range mergedDmChannel {
+		msgBase := proto.Clone(lct.Base).(*commonpb.MsgBase)
+		msgBase.MsgType = commonpb.MsgType_WatchDmChannels
+		watchRequest := &querypb.WatchDmChannelsRequest{
+			Base:         msgBase,
+			CollectionID: collectionID,
+			//PartitionIDs: toLoadPartitionIDs,
+			Infos:  []*datapb.VchannelInfo{info},
+			Schema: lct.Schema,
+			LoadMeta: &querypb.LoadMetaInfo{
+				LoadType:     querypb.LoadType_LoadCollection,
+				CollectionID: collectionID,
+				PartitionIDs: partitionIds,
+			},
+			ReplicaID: replica.GetReplicaID(),
+		}
+
+		watchDmChannelReqs = append(watchDmChannelReqs, watchRequest)
+	}
+
+	internalTasks, err := assignInternalTask(ctx, lct, lct.meta, lct.cluster, loadSegmentReqs, watchDmChannelReqs, false, nil, replica.GetNodeIds(), -1)
+	if err != nil {
+		log.Error("loadCollectionTask: assign child task failed", zap.Int64("collectionID", collectionID), zap.Int64("msgID", lct.Base.MsgID), zap.Error(err))
+		return err
+	}
+	for _, internalTask := range internalTasks {
+		lct.addChildTask(internalTask)
+		if task, ok := internalTask.(*watchDmChannelTask); ok {
+			nodeInfo, err := lct.cluster.getNodeInfoByID(task.NodeID)
+			if err != nil {
+				log.Error("loadCollectionTask: get shard leader node info failed",
+					zap.Int64("collectionID", collectionID),
+					zap.Int64("msgID", lct.Base.MsgID),
+					zap.Int64("nodeID", task.NodeID),
+					zap.Error(err))
+				return err
+			}
+			replica.ShardReplicas = append(replica.ShardReplicas, &milvuspb.ShardReplica{
+				LeaderID:      task.NodeID,
+				LeaderAddr:    nodeInfo.(*queryNode).address,
+				DmChannelName: task.WatchDmChannelsRequest.Infos[0].ChannelName,
+			})
+		}
+		log.Info("loadCollectionTask: add a childTask", zap.Int64("collectionID", collectionID), zap.Int32("task type", int32(internalTask.msgType())), zap.Int64("msgID", lct.Base.MsgID))
+	}
+	metrics.QueryCoordNumChildTasks.WithLabelValues().Add(float64(len(internalTasks)))
+	log.Info("loadCollectionTask: assign child task done", zap.Int64("collectionID", collectionID), zap.Int64("msgID", lct.Base.MsgID))
+	return nil
+}
+
 func (lct *loadCollectionTask) postExecute(ctx context.Context) error {
 	collectionID := lct.CollectionID
 	if lct.getResultInfo().ErrorCode != commonpb.ErrorCode_Success {
 		lct.clearChildTasks()
-		err := lct.meta.releaseCollection(collectionID)
-		if err != nil {
-			log.Error("loadCollectionTask: occur error when release collection info from meta", zap.Int64("collectionID", collectionID), zap.Int64("msgID", lct.Base.MsgID), zap.Error(err))
-			panic(err)
+		if lct.replicaChange {
+			// the collection is still served by the replicas before the change
+			lct.removeAddedReplicas()
+			lct.addedReplicas = nil
+		} else {
+			err := lct.meta.releaseCollection(collectionID)
+			if err != nil {
+				log.Error("loadCollectionTask: occur error when release collection info from meta", zap.Int64("collectionID", collectionID), zap.Int64("msgID", lct.Base.MsgID), zap.Error(err))
+				panic(err)
+			}
 		}
 	}
 
@@ -595,6 +648,9 @@ func (lct *loadCollectionTask) postExecute(ctx context.Context) error {
 }
 
 func (lct *loadCollectionTask) rollBack(ctx context.Context) []task {
+	if lct.replicaChange {
+		return lct.rollBackReplicaChange(ctx)
+	}
 	onlineNodeIDs := lct.cluster.onlineNodeIDs()
 	resultTasks := make([]task, 0)
 	for _, nodeID := range onlineNodeIDs {
@@ -1716,7 +1772,7 @@ func (ht *handoffTask) execute(ctx context.Context) error {
 		}
 
 		// segment which is compacted to should not exist in query node
-		_, err = ht.meta.getSegmentInfoByID(segmentID)
+		loadedSegment, err := ht.meta.getSegmentInfoByID(segmentID)
 		if err != nil {
 			dmChannelInfos, binlogs, err := ht.broker.getRecoveryInfo(ht.ctx, collectionID, partitionID)
 			if err != nil {
@@ -1793,6 +1849,18 @@ func (ht *handoffTask) execute(ctx context.Context) error {
 				ht.addChildTask(internalTask)
 				log.Info("handoffTask: add a childTask", zap.Int32("task type", int32(internalTask.msgType())), zap.Int64("segmentID", segmentID))
 			}
+		} else if segmentInfo.GetIndexID() != 0 {
+			// the index of the loaded segment is rebuilt by rootcoord, swap it in place on the query nodes holding the segment
+			internalTasks, err := ht.swapSegmentIndex(ctx, collectionInfo, segmentInfo, loadedSegment)
+			if err != nil {
+				log.Error("handoffTask: swap segment index failed", zap.Int64("collectionID", collectionID), zap.Int64("segmentID", segmentID), zap.Error(err))
+				ht.setResultInfo(err)
+				return err
+			}
+			for _, internalTask := range internalTasks {
+				ht.addChildTask(internalTask)
+				log.Info("handoffTask: add a childTask", zap.Int32("task type", int32(internalTask.msgType())), zap.Int64("segmentID", segmentID))
+			}
 		} else {
 			err = fmt.Errorf("sealed segment has been exist on query node, segmentID is %d", segmentID)
 			log.Error("handoffTask: handoff segment failed", zap.Int64("segmentID", segmentID), zap.Error(err))
@@ -1805,6 +1873,59 @@ func (ht *handoffTask) execute(ctx context.Context) error {
 	return nil
 }
 
+// swapSegmentIndex generates the tasks reloading the segment with the index of the handoff info on each node holding it,
+// the query nodes replace the loaded segment once the new one is loaded so that searches are served during the swap
+func (ht *handoffTask) swapSegmentIndex(ctx context.Context, collectionInfo *querypb.CollectionInfo, segmentInfo *querypb.SegmentInfo, loadedSegment *querypb.SegmentInfo) ([]task, error) {
+	collectionID := segmentInfo.CollectionID
+	partitionID := segmentInfo.PartitionID
+	segmentID := segmentInfo.SegmentID
+
+	_, binlogs, err := ht.broker.getRecoveryInfo(ht.ctx, collectionID, partitionID)
+	if err != nil {
+		return nil, err
+	}
+	var segmentBinlog *datapb.SegmentBinlogs
+	for _, binlog := range binlogs {
+		if binlog.SegmentID == segmentID {
+			segmentBinlog = binlog
+			break
+		}
+	}
+	if segmentBinlog == nil {
+		return nil, fmt.Errorf("segmnet has not been flushed, segmentID is %d", segmentID)
+	}
+
+	replicas, err := ht.meta.getReplicasByCollectionID(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	var internalTasks []task
+	for _, replica := range replicas {
+		for _, nodeID := range replica.GetNodeIds() {
+			if !funcutil.SliceContain(loadedSegment.GetNodeIds(), nodeID) {
+				continue
+			}
+			segmentLoadInfo := ht.broker.generateSegmentLoadInfo(ctx, collectionID, partitionID, segmentBinlog, false, nil)
+			segmentLoadInfo.IndexInfos = segmentInfo.IndexInfos
+			msgBase := proto.Clone(ht.Base).(*commonpb.MsgBase)
+			msgBase.MsgType = commonpb.MsgType_LoadSegments
+			loadSegmentReq := &querypb.LoadSegmentsRequest{
+				Base:         msgBase,
+				Infos:        []*querypb.SegmentLoadInfo{segmentLoadInfo},
+				Schema:       collectionInfo.Schema,
+				CollectionID: collectionID,
+				ReplicaID:    replica.GetReplicaID(),
+			}
+			tasks, err := assignInternalTask(ctx, ht, ht.meta, ht.cluster, []*querypb.LoadSegmentsRequest{loadSegmentReq}, nil, true, nil, []UniqueID{nodeID}, replica.GetReplicaID())
+			if err != nil {
+				return nil, err
+			}
+			internalTasks = append(internalTasks, tasks...)
+		}
+	}
+	return internalTasks, nil
+}
+
 func (ht *handoffTask) postExecute(context.Context) error {
 	if ht.getResultInfo().ErrorCode != commonpb.ErrorCode_Success {
 		ht.clearChildTasks()
@@ -1833,6 +1954,11 @@ type loadBalanceTask struct {
 	cluster   Cluster
 	meta      Meta
 	replicaID int64
+	// keepSourceNodes keeps the source nodes in cluster after they are handed off as down, such as the cordoned ones
+	keepSourceNodes bool
+	// dmChannels are the dml channels migrated from the source nodes to the dst nodes instead of the segments,
+	// recorded in meta by the msg id to be recovered with the task
+	dmChannels []string
 }
 
 func (lbt *loadBalanceTask) msgBase() *commonpb.MsgBase {
@@ -2082,6 +2208,14 @@ func (lbt *loadBalanceTask) execute(ctx context.Context) error {
 			return err
 		}
 
+		if len(lbt.dmChannels) > 0 {
+			err := lbt.balanceDmChannels(ctx)
+			if err != nil {
+				lbt.setResultInfo(err)
+			}
+			return err
+		}
+
 		balancedSegmentInfos := make(map[UniqueID]*querypb.SegmentInfo)
 		balancedSegmentIDs := make([]UniqueID, 0)
 
@@ -2229,12 +2363,17 @@ func (lbt *loadBalanceTask) getReplica(nodeID, collectionID int64) (*milvuspb.Re
 func (lbt *loadBalanceTask) postExecute(context.Context) error {
 	if lbt.getResultInfo().ErrorCode != commonpb.ErrorCode_Success {
 		lbt.clearChildTasks()
+		if len(lbt.dmChannels) > 0 {
+			if err := lbt.meta.removeBalanceChannels(lbt.GetBase().GetMsgID()); err != nil {
+				log.Warn("loadBalanceTask: remove balance channels from meta failed", zap.Int64("taskID", lbt.getTaskID()), zap.Error(err))
+			}
+		}
 	}
 
 	// if loadBalanceTask execute failed after query node down, the lbt.getResultInfo().ErrorCode will be set to commonpb.ErrorCode_UnexpectedError
 	// then the queryCoord will panic, and the nodeInfo should not be removed immediately
 	// after queryCoord recovery, the balanceTask will redo
-	if lbt.triggerCondition == querypb.TriggerCondition_NodeDown && lbt.getResultInfo().ErrorCode == commonpb.ErrorCode_Success {
+	if lbt.triggerCondition == querypb.TriggerCondition_NodeDown && lbt.getResultInfo().ErrorCode == commonpb.ErrorCode_Success && !lbt.keepSourceNodes {
 		for _, offlineNodeID := range lbt.SourceNodeIDs {
 			err := lbt.cluster.removeNodeInfo(offlineNodeID)
 			if err != nil {
@@ -2354,6 +2493,12 @@ func (lbt *loadBalanceTask) globalPostExecute(ctx context.Context) error {
 				}
 			}
 		}
+
+		if lbt.triggerCondition == querypb.TriggerCondition_LoadBalance && len(lbt.dmChannels) > 0 {
+			if err := lbt.releaseBalancedDmChannels(ctx); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strconv"
 	"sync"
 
@@ -77,7 +78,7 @@ func (queue *taskQueue) addTask(t task) {
 	}
 
 	for e := queue.tasks.Back(); e != nil; e = e.Prev() {
-		if t.taskPriority() > e.Value.(task).taskPriority() {
+		if higherPriority(t, e.Value.(task)) {
 			if e.Prev() == nil {
 				queue.taskChan <- 1
 				queue.tasks.InsertBefore(t, e)
@@ -105,6 +106,44 @@ func (queue *taskQueue) addTaskToFront(t task) {
 	metrics.QueryCoordNumParentTasks.WithLabelValues().Inc()
 }
 
+// higherPriority returns whether t1 should be scheduled before t2, the load tasks of the same trigger condition
+// are ordered by the load priorities of their collections
+func higherPriority(t1, t2 task) bool {
+	if t1.taskPriority() != t2.taskPriority() {
+		return t1.taskPriority() > t2.taskPriority()
+	}
+	return t1.getLoadPriority() > t2.getLoadPriority()
+}
+
+// updateLoadPriority sets the load priority of the queued load tasks of the collection and reorders the queue,
+// the tasks done before QueryCoord restarts are kept in the front
+func (queue *taskQueue) updateLoadPriority(collectionID UniqueID, priority int64) {
+	queue.Lock()
+	defer queue.Unlock()
+
+	tasks := make([]task, 0, queue.tasks.Len())
+	for e := queue.tasks.Front(); e != nil; e = e.Next() {
+		t := e.Value.(task)
+		if id, ok := loadTaskCollectionID(t); ok && id == collectionID {
+			t.setLoadPriority(priority)
+		}
+		tasks = append(tasks, t)
+	}
+	done := 0
+	for done < len(tasks) && tasks[done].getState() == taskDone {
+		done++
+	}
+	toSort := tasks[done:]
+	sort.SliceStable(toSort, func(i, j int) bool {
+		return higherPriority(toSort[i], toSort[j])
+	})
+
+	queue.tasks.Init()
+	for _, t := range tasks {
+		queue.tasks.PushBack(t)
+	}
+}
+
 // PopTask pops a trigger task from task list
 func (queue *taskQueue) popTask() task {
 	queue.Lock()
@@ -143,6 +182,14 @@ type TaskScheduler struct {
 
 	broker *globalMetaBroker
 
+	// lifecycle records the results of load and release tasks
+	lifecycle *collectionLifecycleRecorder
+	// releaseJobs tracks the release collection tasks for polling
+	releaseJobs *releaseJobManager
+	// processingTask is the trigger task being processed by the schedule loop
+	processingTask task
+	processingMu   sync.RWMutex
+
 	wg     sync.WaitGroup
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -171,7 +218,19 @@ func newTaskScheduler(ctx context.Context,
 	}
 	s.triggerTaskQueue = newTaskQueue()
 
-	err := s.reloadFromKV()
+	lifecycle, err := newCollectionLifecycleRecorder(kv)
+	if err != nil {
+		log.Error("reload collection lifecycle from kv failed", zap.Error(err))
+		return nil, err
+	}
+	s.lifecycle = lifecycle
+	s.releaseJobs, err = newReleaseJobManager(kv)
+	if err != nil {
+		log.Error("reload release jobs from kv failed", zap.Error(err))
+		return nil, err
+	}
+
+	err = s.reloadFromKV()
 	if err != nil {
 		log.Error("reload task from kv failed", zap.Error(err))
 		return nil, err
@@ -239,8 +298,17 @@ func (scheduler *TaskScheduler) reloadFromKV() error {
 		triggerTasks[taskID].setState(state)
 	}
 
+	// the tasks are queued in the order they were enqueued, then by the priorities
+	triggerTaskIDs := make([]UniqueID, 0, len(triggerTasks))
+	for taskID := range triggerTasks {
+		triggerTaskIDs = append(triggerTaskIDs, taskID)
+	}
+	sort.Slice(triggerTaskIDs, func(i, j int) bool { return triggerTaskIDs[i] < triggerTaskIDs[j] })
+
 	var doneTriggerTask task
-	for _, t := range triggerTasks {
+	for _, taskID := range triggerTaskIDs {
+		t := triggerTasks[taskID]
+		scheduler.assignLoadPriority(t)
 		if t.getState() == taskDone {
 			doneTriggerTask = t
 			for _, childTask := range activeTasks {
@@ -257,6 +325,12 @@ func (scheduler *TaskScheduler) reloadFromKV() error {
 		scheduler.triggerTaskQueue.addTaskToFront(doneTriggerTask)
 	}
 
+	reloadedTaskIDs := make(map[UniqueID]struct{}, len(triggerTasks))
+	for taskID := range triggerTasks {
+		reloadedTaskIDs[taskID] = struct{}{}
+	}
+	scheduler.releaseJobs.failOrphans(reloadedTaskIDs)
+
 	return nil
 }
 
@@ -410,6 +484,12 @@ func (scheduler *TaskScheduler) unmarshalTask(taskID UniqueID, t string) (task,
 			cluster:            scheduler.cluster,
 			meta:               scheduler.meta,
 		}
+		if loadReq.BalanceReason == querypb.TriggerCondition_LoadBalance {
+			loadBalanceTask.dmChannels, err = scheduler.meta.getBalanceChannels(loadReq.GetBase().GetMsgID())
+			if err != nil {
+				return nil, err
+			}
+		}
 		newTask = loadBalanceTask
 	case commonpb.MsgType_HandoffSegments:
 		handoffReq := querypb.HandoffSegmentsRequest{}
@@ -460,6 +540,7 @@ func (scheduler *TaskScheduler) Enqueue(t task) error {
 		return err
 	}
 	t.setState(taskUndo)
+	scheduler.assignLoadPriority(t)
 	scheduler.triggerTaskQueue.addTask(t)
 	log.Debug("EnQueue a triggerTask and save to etcd", zap.Int64("taskID", t.getTaskID()))
 
@@ -481,6 +562,7 @@ func (scheduler *TaskScheduler) processTask(t task) error {
 				return err
 			}
 			childTask.setTaskID(id)
+			setSegmentRequestVersion(childTask)
 			childTaskKey := fmt.Sprintf("%s/%d", activeTaskPrefix, childTask.getTaskID())
 			var protoSize int
 			switch childTask.msgType() {
@@ -626,6 +708,7 @@ func (scheduler *TaskScheduler) scheduleLoop() {
 				break
 			}
 			log.Info("scheduleLoop: pop a triggerTask from triggerTaskQueue", zap.Int64("triggerTaskID", triggerTask.getTaskID()))
+			scheduler.setProcessingTask(triggerTask)
 			alreadyNotify := true
 			if triggerTask.getState() == taskUndo || triggerTask.getState() == taskDoing {
 				err = scheduler.processTask(triggerTask)
@@ -701,6 +784,8 @@ func (scheduler *TaskScheduler) scheduleLoop() {
 			}
 
 			resultStatus := triggerTask.getResultInfo()
+			scheduler.lifecycle.recordTask(triggerTask, resultStatus)
+			scheduler.releaseJobs.finish(triggerTask, resultStatus)
 			if resultStatus.ErrorCode != commonpb.ErrorCode_Success {
 				triggerTask.setState(taskFailed)
 				if !alreadyNotify {
@@ -713,6 +798,7 @@ func (scheduler *TaskScheduler) scheduleLoop() {
 					triggerTask.notify(nil)
 				}
 			}
+			scheduler.setProcessingTask(nil)
 		}
 	}
 }
@@ -753,6 +839,7 @@ func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task,
 						return
 					}
 					rt.setTaskID(id)
+					setSegmentRequestVersion(rt)
 					log.Info("waitActivateTaskDone: reScheduler set id", zap.Int64("id", rt.getTaskID()))
 					taskKey := fmt.Sprintf("%s/%d", activeTaskPrefix, rt.getTaskID())
 					blobs, err := rt.marshal()
@@ -932,6 +1019,10 @@ func updateSegmentInfoFromTask(ctx context.Context, triggerTask task, meta Meta)
 					segmentID := loadInfo.SegmentID
 
 					segment, err := meta.getSegmentInfoByID(segmentID)
+					if err == nil && triggerTask.msgType() == commonpb.MsgType_HandoffSegments {
+						// the index of the loaded segment is swapped in place, the segment stays on the same nodes
+						continue
+					}
 					if err != nil {
 						segment = &querypb.SegmentInfo{
 							SegmentID:      segmentID,
@@ -584,3 +584,52 @@ func Test_generateDerivedInternalTasks(t *testing.T) {
 	err = removeAllSession()
 	assert.Nil(t, err)
 }
+
+func TestSetSegmentRequestVersion(t *testing.T) {
+	sharedBase := &commonpb.MsgBase{
+		MsgType: commonpb.MsgType_LoadSegments,
+		MsgID:   1,
+	}
+	loadTask1 := &loadSegmentTask{
+		baseTask:            &baseTask{},
+		LoadSegmentsRequest: &querypb.LoadSegmentsRequest{Base: sharedBase},
+	}
+	loadTask1.setTaskID(100)
+	loadTask2 := &loadSegmentTask{
+		baseTask:            &baseTask{},
+		LoadSegmentsRequest: &querypb.LoadSegmentsRequest{Base: sharedBase},
+	}
+	loadTask2.setTaskID(101)
+	setSegmentRequestVersion(loadTask1)
+	setSegmentRequestVersion(loadTask2)
+	assert.Equal(t, int64(100), loadTask1.Base.MsgID)
+	assert.Equal(t, int64(101), loadTask2.Base.MsgID)
+	assert.Equal(t, commonpb.MsgType_LoadSegments, loadTask2.Base.MsgType)
+	// the shared msg base is not modified
+	assert.Equal(t, int64(1), sharedBase.MsgID)
+
+	releaseTask := &releaseSegmentTask{
+		baseTask:               &baseTask{},
+		ReleaseSegmentsRequest: &querypb.ReleaseSegmentsRequest{},
+	}
+	releaseTask.setTaskID(102)
+	setSegmentRequestVersion(releaseTask)
+	assert.Equal(t, int64(102), releaseTask.Base.MsgID)
+
+	watchTask := &watchDmChannelTask{
+		baseTask:               &baseTask{},
+		WatchDmChannelsRequest: &querypb.WatchDmChannelsRequest{Base: sharedBase},
+	}
+	watchTask.setTaskID(103)
+	setSegmentRequestVersion(watchTask)
+	assert.Equal(t, int64(103), watchTask.Base.MsgID)
+
+	// other tasks are not versioned
+	releaseCollection := &releaseCollectionTask{
+		baseTask:                 &baseTask{},
+		ReleaseCollectionRequest: &querypb.ReleaseCollectionRequest{Base: sharedBase},
+	}
+	releaseCollection.setTaskID(104)
+	setSegmentRequestVersion(releaseCollection)
+	assert.Equal(t, int64(1), releaseCollection.Base.MsgID)
+}
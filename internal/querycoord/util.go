@@ -19,6 +19,8 @@ package querycoord
 import (
 	"context"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
@@ -68,10 +70,16 @@ func estimateSegmentSize(segmentLoadInfo *querypb.SegmentLoadInfo) int64 {
 	return segmentSize
 }
 
+// getFieldSizeFromFieldBinlog returns the memory size of the binlogs, which is the decompressed size of them,
+// the binlogs written before the memory sizes recorded only have the log sizes
 func getFieldSizeFromFieldBinlog(fieldBinlog *datapb.FieldBinlog) int64 {
 	fieldSize := int64(0)
 	for _, binlog := range fieldBinlog.Binlogs {
-		fieldSize += binlog.LogSize
+		if binlog.GetMemorySize() > 0 {
+			fieldSize += binlog.GetMemorySize()
+		} else {
+			fieldSize += binlog.GetLogSize()
+		}
 	}
 
 	return fieldSize
@@ -109,6 +117,30 @@ func getDstNodeIDByTask(t task) int64 {
 	return nodeID
 }
 
+// setSegmentRequestVersion stamps the msgID of the load/release segments request with the id of the task,
+// the ids are allocated from the global tso allocator and increase across QueryCoord restarts, so that QueryNode
+// could ignore a delayed release which is older than the load of the segment.
+func setSegmentRequestVersion(t task) {
+	versionedBase := func(base *commonpb.MsgBase) *commonpb.MsgBase {
+		// the msg base may be shared by the child tasks of the same parent
+		ret := &commonpb.MsgBase{}
+		if base != nil {
+			ret = proto.Clone(base).(*commonpb.MsgBase)
+		}
+		ret.MsgID = t.getTaskID()
+		return ret
+	}
+	switch t := t.(type) {
+	case *loadSegmentTask:
+		t.Base = versionedBase(t.Base)
+	case *releaseSegmentTask:
+		t.Base = versionedBase(t.Base)
+	case *watchDmChannelTask:
+		// the unflushed segments are loaded by WatchDmChannels
+		t.Base = versionedBase(t.Base)
+	}
+}
+
 func syncReplicaSegments(ctx context.Context, cluster Cluster, childTasks []task) error {
 	type SegmentIndex struct {
 		NodeID      UniqueID
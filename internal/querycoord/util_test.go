@@ -0,0 +1,48 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func TestEstimateSegmentSize(t *testing.T) {
+	// the compressed binlogs are estimated by the decompressed sizes
+	compressed := &datapb.FieldBinlog{
+		FieldID: 100,
+		Binlogs: []*datapb.Binlog{{LogSize: 10, MemorySize: 100}, {LogSize: 20, MemorySize: 200}},
+	}
+	assert.Equal(t, int64(300), getFieldSizeFromFieldBinlog(compressed))
+
+	// the binlogs without the memory sizes are estimated by the log sizes
+	legacy := &datapb.FieldBinlog{
+		FieldID: 101,
+		Binlogs: []*datapb.Binlog{{LogSize: 50}},
+	}
+	assert.Equal(t, int64(50), getFieldSizeFromFieldBinlog(legacy))
+
+	assert.Equal(t, int64(360), estimateSegmentSize(&querypb.SegmentLoadInfo{
+		BinlogPaths: []*datapb.FieldBinlog{compressed, legacy},
+		Statslogs:   []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogSize: 1, MemorySize: 4}}}},
+		Deltalogs:   []*datapb.FieldBinlog{{FieldID: 0, Binlogs: []*datapb.Binlog{{LogSize: 2, MemorySize: 6}}}},
+	}))
+}
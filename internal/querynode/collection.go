@@ -33,8 +33,10 @@ import (
 	"fmt"
 	"math"
 	"sync"
+	"time"
 	"unsafe"
 
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/milvus-io/milvus/internal/metrics"
@@ -52,7 +54,9 @@ type Collection struct {
 	collectionPtr C.CCollection
 	id            UniqueID
 	partitionIDs  []UniqueID
-	schema        *schemapb.CollectionSchema
+
+	schemaMu sync.RWMutex // guards schema
+	schema   *schemapb.CollectionSchema
 
 	channelMu      sync.RWMutex
 	vChannels      []Channel
@@ -65,6 +69,9 @@ type Collection struct {
 	releaseMu          sync.RWMutex // guards release
 	releasedPartitions map[UniqueID]struct{}
 	releaseTime        Timestamp
+
+	// plans are the compiled search and retrieve plans cached
+	plans *planCache
 }
 
 // ID returns collection id
@@ -74,9 +81,62 @@ func (c *Collection) ID() UniqueID {
 
 // Schema returns the schema of collection
 func (c *Collection) Schema() *schemapb.CollectionSchema {
+	c.schemaMu.RLock()
+	defer c.schemaMu.RUnlock()
 	return c.schema
 }
 
+// getExpireTimestamp returns the timestamp before which the entities are expired by the ttl of the collection,
+// zero is returned if the collection has no ttl
+func (c *Collection) getExpireTimestamp() Timestamp {
+	ttl, err := typeutil.GetCollectionTTL(c.Schema())
+	if err != nil || ttl <= 0 {
+		return 0
+	}
+	return tsoutil.ComposeTSByTime(time.Now().Add(-ttl), 0)
+}
+
+// getSwapEnabled returns whether the idle sealed segments of the collection are swapped out to the local disk,
+// false is returned as the second value if the property of the collection is not set
+func (c *Collection) getSwapEnabled() (bool, bool) {
+	enabled, set, err := typeutil.GetCollectionSwapEnabled(c.Schema())
+	if err != nil {
+		return false, false
+	}
+	return enabled, set
+}
+
+// updateSchema replaces the schema of collection in place, only the changes that don't touch
+// the fields are allowed, since the segments are created with the fields of the old schema.
+func (c *Collection) updateSchema(schema *schemapb.CollectionSchema) error {
+	c.schemaMu.Lock()
+	defer c.schemaMu.Unlock()
+	if err := checkFieldsUnchanged(c.schema, schema); err != nil {
+		return fmt.Errorf("failed to update schema of collection %d, %s", c.id, err)
+	}
+	c.schema = schema
+	log.Info("update schema of collection", zap.Int64("collectionID", c.id))
+	return nil
+}
+
+// checkFieldsUnchanged returns error if the fields of newSchema are different from oldSchema,
+// the descriptions of fields are ignored.
+func checkFieldsUnchanged(oldSchema, newSchema *schemapb.CollectionSchema) error {
+	if len(oldSchema.GetFields()) != len(newSchema.GetFields()) {
+		return fmt.Errorf("number of fields changed from %d to %d", len(oldSchema.GetFields()), len(newSchema.GetFields()))
+	}
+	for i, oldField := range oldSchema.GetFields() {
+		oldField = proto.Clone(oldField).(*schemapb.FieldSchema)
+		newField := proto.Clone(newSchema.GetFields()[i]).(*schemapb.FieldSchema)
+		oldField.Description = ""
+		newField.Description = ""
+		if !proto.Equal(oldField, newField) {
+			return fmt.Errorf("field %d changed", oldField.GetFieldID())
+		}
+	}
+	return nil
+}
+
 // addPartitionID would add a partition id to partition id list of collection
 func (c *Collection) addPartitionID(partitionID UniqueID) {
 	c.releaseMu.Lock()
@@ -99,6 +159,31 @@ func (c *Collection) removePartitionID(partitionID UniqueID) {
 	c.partitionIDs = tmpIDs
 }
 
+// addReleasedPartition records the released partition, whose dml messages would be filtered out
+func (c *Collection) addReleasedPartition(partitionID UniqueID) {
+	c.releaseMu.Lock()
+	defer c.releaseMu.Unlock()
+	c.releasedPartitions[partitionID] = struct{}{}
+	log.Info("add released partition to collection",
+		zap.Int64("collectionID", c.ID()),
+		zap.Int64("partitionID", partitionID))
+}
+
+// deleteReleasedPartition removes the released partition record when the partition is loaded again
+func (c *Collection) deleteReleasedPartition(partitionID UniqueID) {
+	c.releaseMu.Lock()
+	defer c.releaseMu.Unlock()
+	delete(c.releasedPartitions, partitionID)
+}
+
+// isReleasedPartition returns true if the partition has been released
+func (c *Collection) isReleasedPartition(partitionID UniqueID) bool {
+	c.releaseMu.RLock()
+	defer c.releaseMu.RUnlock()
+	_, ok := c.releasedPartitions[partitionID]
+	return ok
+}
+
 // addVChannels adds virtual channels to collection
 func (c *Collection) addVChannels(channels []Channel) {
 	c.channelMu.Lock()
@@ -293,7 +378,7 @@ func (c *Collection) getLoadType() loadType {
 
 // getFieldType get the field type according to the field id.
 func (c *Collection) getFieldType(fieldID FieldID) (schemapb.DataType, error) {
-	helper, err := typeutil.CreateSchemaHelper(c.schema)
+	helper, err := typeutil.CreateSchemaHelper(c.Schema())
 	if err != nil {
 		return schemapb.DataType_None, err
 	}
@@ -320,6 +405,7 @@ func newCollection(collectionID UniqueID, schema *schemapb.CollectionSchema) *Co
 		id:                 collectionID,
 		schema:             schema,
 		releasedPartitions: make(map[UniqueID]struct{}),
+		plans:              newPlanCache(int(Params.QueryNodeCfg.PlanCacheSize)),
 	}
 	C.free(unsafe.Pointer(cSchemaBlob))
 
@@ -335,6 +421,7 @@ func deleteCollection(collection *Collection) {
 		void
 		deleteCollection(CCollection collection);
 	*/
+	collection.plans.clear()
 	cPtr := collection.collectionPtr
 	C.DeleteCollection(cPtr)
 
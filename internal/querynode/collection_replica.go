@@ -44,6 +44,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 )
 
 // ReplicaInterface specifies all the methods that the Collection object needs to implement in QueryNode.
@@ -71,6 +72,10 @@ type ReplicaInterface interface {
 	getPKFieldIDByCollectionID(collectionID UniqueID) (FieldID, error)
 	// getSegmentInfosByColID return segments info by collectionID
 	getSegmentInfosByColID(collectionID UniqueID) ([]*querypb.SegmentInfo, error)
+	// getFieldIndexParams returns the index params of the field loaded by the segments of the collection
+	getFieldIndexParams(collectionID UniqueID, fieldID FieldID) map[string]string
+	// refreshCollection updates the schema and adds the new partitions of collection in place
+	refreshCollection(collectionID UniqueID, schema *schemapb.CollectionSchema, partitionIDs []UniqueID) error
 
 	// partition
 	// addPartition adds a new partition to collection
@@ -93,6 +98,8 @@ type ReplicaInterface interface {
 	addSegment(segmentID UniqueID, partitionID UniqueID, collectionID UniqueID, vChannelID Channel, segType segmentType, onService bool) error
 	// setSegment adds a segment to collectionReplica
 	setSegment(segment *Segment) error
+	// replaceSegment replaces the segment of the same id in collectionReplica
+	replaceSegment(segment *Segment) error
 	// removeSegment removes a segment from collectionReplica
 	removeSegment(segmentID UniqueID) error
 	// getSegmentByID returns the segment which id is segmentID
@@ -107,11 +114,21 @@ type ReplicaInterface interface {
 	// excluded segments
 	//  removeExcludedSegments will remove excludedSegments from collectionReplica
 	removeExcludedSegments(collectionID UniqueID)
+	// removeExcludedSegmentsByPartitionIDs will remove excludedSegments of the partitions from collectionReplica
+	removeExcludedSegmentsByPartitionIDs(collectionID UniqueID, partitionIDs []UniqueID)
 	// addExcludedSegments will add excludedSegments to collectionReplica
 	addExcludedSegments(collectionID UniqueID, segmentInfos []*datapb.SegmentInfo)
 	// getExcludedSegments returns excludedSegments of collectionReplica
 	getExcludedSegments(collectionID UniqueID) ([]*datapb.SegmentInfo, error)
 
+	// applied positions
+	// getAppliedPosition returns the position of the last insert pack applied on the virtual channel
+	getAppliedPosition(channel Channel) *internalpb.MsgPosition
+	// setAppliedPosition records the position of the last insert pack applied on the virtual channel
+	setAppliedPosition(channel Channel, position *internalpb.MsgPosition)
+	// removeAppliedPosition removes the applied position of the virtual channel
+	removeAppliedPosition(channel Channel)
+
 	// query mu
 	// queryLock guards query and delete operations
 	queryLock()
@@ -139,7 +156,8 @@ type collectionReplica struct {
 	segments    map[UniqueID]*Segment
 
 	queryMu          sync.RWMutex
-	excludedSegments map[UniqueID][]*datapb.SegmentInfo // map[collectionID]segmentIDs
+	excludedSegments map[UniqueID][]*datapb.SegmentInfo  // map[collectionID]segmentIDs
+	appliedPositions map[Channel]*internalpb.MsgPosition // map[vChannel]position
 
 	etcdKV *etcdkv.EtcdKV
 }
@@ -236,6 +254,10 @@ func (colReplica *collectionReplica) removeCollectionPrivate(collectionID Unique
 		_ = colReplica.removePartitionPrivate(partitionID)
 	}
 
+	for _, vChannel := range collection.getVChannels() {
+		delete(colReplica.appliedPositions, vChannel)
+	}
+
 	deleteCollection(collection)
 	delete(colReplica.collections, collectionID)
 
@@ -394,7 +416,61 @@ func (colReplica *collectionReplica) getSegmentInfosByColID(collectionID UniqueI
 	return segmentInfos, nil
 }
 
+// getFieldIndexParams returns the index params of the field loaded by the segments of the collection, nil if none
+// of the segments loads the index of the field. The segments of a collection load the same index of a field.
+func (colReplica *collectionReplica) getFieldIndexParams(collectionID UniqueID, fieldID FieldID) map[string]string {
+	colReplica.mu.RLock()
+	defer colReplica.mu.RUnlock()
+
+	collection, ok := colReplica.collections[collectionID]
+	if !ok {
+		return nil
+	}
+	for _, partitionID := range collection.partitionIDs {
+		partition, ok := colReplica.partitions[partitionID]
+		if !ok {
+			continue
+		}
+		for _, segmentID := range partition.segmentIDs {
+			segment, ok := colReplica.segments[segmentID]
+			if !ok || !segment.hasLoadIndexForIndexedField(fieldID) {
+				continue
+			}
+			if info, err := segment.getIndexedFieldInfo(fieldID); err == nil {
+				return funcutil.KeyValuePair2Map(info.indexInfo.GetIndexParams())
+			}
+		}
+	}
+	return nil
+}
+
 //----------------------------------------------------------------------------------------------------- partition
+// refreshCollection updates the schema and adds the new partitions of collection in place,
+// the released partitions are skipped, nil schema means the schema is unchanged.
+func (colReplica *collectionReplica) refreshCollection(collectionID UniqueID, schema *schemapb.CollectionSchema, partitionIDs []UniqueID) error {
+	colReplica.mu.Lock()
+	defer colReplica.mu.Unlock()
+
+	collection, err := colReplica.getCollectionByIDPrivate(collectionID)
+	if err != nil {
+		return err
+	}
+	if schema != nil {
+		if err = collection.updateSchema(schema); err != nil {
+			return err
+		}
+	}
+	for _, partitionID := range partitionIDs {
+		if collection.isReleasedPartition(partitionID) || colReplica.hasPartitionPrivate(partitionID) {
+			continue
+		}
+		if err = colReplica.addPartitionPrivate(collectionID, partitionID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // addPartition adds a new partition to collection
 func (colReplica *collectionReplica) addPartition(collectionID UniqueID, partitionID UniqueID) error {
 	colReplica.mu.Lock()
@@ -409,6 +485,10 @@ func (colReplica *collectionReplica) addPartitionPrivate(collectionID UniqueID,
 		return err
 	}
 
+	if collection.isReleasedPartition(partitionID) {
+		return fmt.Errorf("partition %d of collection %d has been released", partitionID, collectionID)
+	}
+
 	if !colReplica.hasPartitionPrivate(partitionID) {
 		collection.addPartitionID(partitionID)
 		var newPartition = newPartition(collectionID, partitionID)
@@ -572,6 +652,23 @@ func (colReplica *collectionReplica) setSegment(segment *Segment) error {
 	return colReplica.addSegmentPrivate(segment.segmentID, segment.partitionID, segment)
 }
 
+// replaceSegment replaces the segment of the same id in collectionReplica, the deletes applied to the replaced one
+// are forwarded to segment, which is loaded with the rebuilt index
+func (colReplica *collectionReplica) replaceSegment(segment *Segment) error {
+	colReplica.mu.Lock()
+	defer colReplica.mu.Unlock()
+	old, err := colReplica.getSegmentByIDPrivate(segment.segmentID)
+	if err != nil {
+		return err
+	}
+	if err := old.forwardDeletes(segment); err != nil {
+		return err
+	}
+	colReplica.segments[segment.segmentID] = segment
+	deleteSegment(old)
+	return nil
+}
+
 // removeSegment removes a segment from collectionReplica
 func (colReplica *collectionReplica) removeSegment(segmentID UniqueID) error {
 	colReplica.mu.Lock()
@@ -670,6 +767,29 @@ func (colReplica *collectionReplica) removeExcludedSegments(collectionID UniqueI
 	delete(colReplica.excludedSegments, collectionID)
 }
 
+// removeExcludedSegmentsByPartitionIDs will remove excludedSegments of the partitions from collectionReplica
+func (colReplica *collectionReplica) removeExcludedSegmentsByPartitionIDs(collectionID UniqueID, partitionIDs []UniqueID) {
+	colReplica.mu.Lock()
+	defer colReplica.mu.Unlock()
+
+	segmentInfos, ok := colReplica.excludedSegments[collectionID]
+	if !ok {
+		return
+	}
+	partitions := make(map[UniqueID]struct{}, len(partitionIDs))
+	for _, partitionID := range partitionIDs {
+		partitions[partitionID] = struct{}{}
+	}
+	// keep the entry of collection even if empty, insert messages are filtered out without it
+	remained := make([]*datapb.SegmentInfo, 0, len(segmentInfos))
+	for _, segmentInfo := range segmentInfos {
+		if _, ok := partitions[segmentInfo.GetPartitionID()]; !ok {
+			remained = append(remained, segmentInfo)
+		}
+	}
+	colReplica.excludedSegments[collectionID] = remained
+}
+
 // addExcludedSegments will add excludedSegments to collectionReplica
 func (colReplica *collectionReplica) addExcludedSegments(collectionID UniqueID, segmentInfos []*datapb.SegmentInfo) {
 	colReplica.mu.Lock()
@@ -694,6 +814,30 @@ func (colReplica *collectionReplica) getExcludedSegments(collectionID UniqueID)
 	return colReplica.excludedSegments[collectionID], nil
 }
 
+// getAppliedPosition returns the position of the last insert pack applied on the virtual channel, nil if nothing applied yet
+func (colReplica *collectionReplica) getAppliedPosition(channel Channel) *internalpb.MsgPosition {
+	colReplica.mu.RLock()
+	defer colReplica.mu.RUnlock()
+
+	return colReplica.appliedPositions[channel]
+}
+
+// setAppliedPosition records the position of the last insert pack applied on the virtual channel
+func (colReplica *collectionReplica) setAppliedPosition(channel Channel, position *internalpb.MsgPosition) {
+	colReplica.mu.Lock()
+	defer colReplica.mu.Unlock()
+
+	colReplica.appliedPositions[channel] = position
+}
+
+// removeAppliedPosition removes the applied position of the virtual channel
+func (colReplica *collectionReplica) removeAppliedPosition(channel Channel) {
+	colReplica.mu.Lock()
+	defer colReplica.mu.Unlock()
+
+	delete(colReplica.appliedPositions, channel)
+}
+
 // freeAll will free all meta info from collectionReplica
 func (colReplica *collectionReplica) freeAll() {
 	colReplica.queryMu.Lock() // wait for current search/query finish
@@ -708,6 +852,7 @@ func (colReplica *collectionReplica) freeAll() {
 	colReplica.collections = make(map[UniqueID]*Collection)
 	colReplica.partitions = make(map[UniqueID]*Partition)
 	colReplica.segments = make(map[UniqueID]*Segment)
+	colReplica.appliedPositions = make(map[Channel]*internalpb.MsgPosition)
 }
 
 // newCollectionReplica returns a new ReplicaInterface
@@ -716,6 +861,7 @@ func newCollectionReplica(etcdKv *etcdkv.EtcdKV) ReplicaInterface {
 	partitions := make(map[UniqueID]*Partition)
 	segments := make(map[UniqueID]*Segment)
 	excludedSegments := make(map[UniqueID][]*datapb.SegmentInfo)
+	appliedPositions := make(map[Channel]*internalpb.MsgPosition)
 
 	var replica ReplicaInterface = &collectionReplica{
 		collections: collections,
@@ -723,6 +869,7 @@ func newCollectionReplica(etcdKv *etcdkv.EtcdKV) ReplicaInterface {
 		segments:    segments,
 
 		excludedSegments: excludedSegments,
+		appliedPositions: appliedPositions,
 		etcdKV:           etcdKv,
 	}
 
@@ -21,6 +21,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
@@ -117,6 +119,108 @@ func TestCollectionReplica_addPartition(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCollectionReplica_addReleasedPartition(t *testing.T) {
+	node := newQueryNodeMock()
+	collectionID := UniqueID(0)
+	initTestMeta(t, node, collectionID, 0)
+
+	col, err := node.historical.replica.getCollectionByID(collectionID)
+	assert.NoError(t, err)
+	col.addReleasedPartition(defaultPartitionID + 1)
+	err = node.historical.replica.addPartition(collectionID, defaultPartitionID+1)
+	assert.Error(t, err)
+	assert.False(t, node.historical.replica.hasPartition(defaultPartitionID+1))
+
+	col.deleteReleasedPartition(defaultPartitionID + 1)
+	err = node.historical.replica.addPartition(collectionID, defaultPartitionID+1)
+	assert.NoError(t, err)
+	err = node.Stop()
+	assert.NoError(t, err)
+}
+
+func TestCollectionReplica_refreshCollection(t *testing.T) {
+	node := newQueryNodeMock()
+	collectionID := UniqueID(0)
+	initTestMeta(t, node, collectionID, 0)
+
+	col, err := node.historical.replica.getCollectionByID(collectionID)
+	assert.NoError(t, err)
+	col.addReleasedPartition(defaultPartitionID + 2)
+
+	// nil schema keeps the schema unchanged
+	schema := col.Schema()
+	err = node.historical.replica.refreshCollection(collectionID, nil, []UniqueID{defaultPartitionID, defaultPartitionID + 1, defaultPartitionID + 2})
+	assert.NoError(t, err)
+	assert.Equal(t, schema, col.Schema())
+	assert.True(t, node.historical.replica.hasPartition(defaultPartitionID+1))
+	assert.False(t, node.historical.replica.hasPartition(defaultPartitionID+2))
+	partitionIDs, err := node.historical.replica.getPartitionIDs(collectionID)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []UniqueID{defaultPartitionID, defaultPartitionID + 1}, partitionIDs)
+
+	invalidSchema := genTestCollectionSchema(schemapb.DataType_VarChar)
+	err = node.historical.replica.refreshCollection(collectionID, invalidSchema, nil)
+	assert.Error(t, err)
+
+	err = node.historical.replica.refreshCollection(collectionID+1, nil, nil)
+	assert.Error(t, err)
+	err = node.Stop()
+	assert.NoError(t, err)
+}
+
+func TestCollectionReplica_removeExcludedSegmentsByPartitionIDs(t *testing.T) {
+	node := newQueryNodeMock()
+	collectionID := UniqueID(0)
+	initTestMeta(t, node, collectionID, 0)
+
+	node.historical.replica.addExcludedSegments(collectionID, []*datapb.SegmentInfo{
+		{ID: 1, CollectionID: collectionID, PartitionID: 1},
+		{ID: 2, CollectionID: collectionID, PartitionID: 2},
+		{ID: 3, CollectionID: collectionID, PartitionID: 3},
+	})
+	node.historical.replica.removeExcludedSegmentsByPartitionIDs(collectionID, []UniqueID{1, 3})
+	segmentInfos, err := node.historical.replica.getExcludedSegments(collectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(segmentInfos))
+	assert.Equal(t, UniqueID(2), segmentInfos[0].ID)
+
+	node.historical.replica.removeExcludedSegmentsByPartitionIDs(collectionID, []UniqueID{2})
+	segmentInfos, err = node.historical.replica.getExcludedSegments(collectionID)
+	assert.NoError(t, err)
+	assert.Empty(t, segmentInfos)
+
+	// do nothing if the collection has no excluded segments
+	node.historical.replica.removeExcludedSegmentsByPartitionIDs(collectionID+1, []UniqueID{2})
+	_, err = node.historical.replica.getExcludedSegments(collectionID + 1)
+	assert.Error(t, err)
+	err = node.Stop()
+	assert.NoError(t, err)
+}
+
+func TestCollectionReplica_appliedPosition(t *testing.T) {
+	node := newQueryNodeMock()
+	collectionID := UniqueID(0)
+	initTestMeta(t, node, collectionID, 0)
+
+	assert.Nil(t, node.historical.replica.getAppliedPosition(defaultDMLChannel))
+	node.historical.replica.setAppliedPosition(defaultDMLChannel, &internalpb.MsgPosition{ChannelName: defaultDMLChannel, Timestamp: 100})
+	assert.Equal(t, Timestamp(100), node.historical.replica.getAppliedPosition(defaultDMLChannel).GetTimestamp())
+
+	node.historical.replica.removeAppliedPosition(defaultDMLChannel)
+	assert.Nil(t, node.historical.replica.getAppliedPosition(defaultDMLChannel))
+
+	// the applied positions of the collection are removed together with it
+	collection, err := node.historical.replica.getCollectionByID(collectionID)
+	assert.NoError(t, err)
+	collection.addVChannels([]Channel{defaultDMLChannel})
+	node.historical.replica.setAppliedPosition(defaultDMLChannel, &internalpb.MsgPosition{ChannelName: defaultDMLChannel, Timestamp: 100})
+	err = node.historical.replica.removeCollection(collectionID)
+	assert.NoError(t, err)
+	assert.Nil(t, node.historical.replica.getAppliedPosition(defaultDMLChannel))
+	err = node.Stop()
+	assert.NoError(t, err)
+}
+
 func TestCollectionReplica_removePartition(t *testing.T) {
 	node := newQueryNodeMock()
 	collectionID := UniqueID(0)
@@ -282,6 +386,46 @@ func TestCollectionReplica_getSegmentInfosByColID(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCollectionReplica_replaceSegment(t *testing.T) {
+	replica, err := genSimpleReplica()
+	assert.NoError(t, err)
+	defer replica.freeAll()
+
+	segment, err := genSimpleSealedSegment(defaultMsgLength)
+	assert.NoError(t, err)
+	segment.setLoadInfo(&querypb.SegmentLoadInfo{SegmentID: defaultSegmentID})
+	err = replica.setSegment(segment)
+	assert.NoError(t, err)
+
+	pks := []primaryKey{newInt64PrimaryKey(0)}
+	offset := segment.segmentPreDelete(len(pks))
+	err = segment.segmentDelete(offset, pks, []Timestamp{1000})
+	assert.NoError(t, err)
+
+	replacement, err := genSimpleSealedSegment(defaultMsgLength)
+	assert.NoError(t, err)
+	err = replica.replaceSegment(replacement)
+	assert.NoError(t, err)
+	target, err := replica.getSegmentByID(defaultSegmentID)
+	assert.NoError(t, err)
+	assert.Equal(t, replacement, target)
+	assert.Equal(t, int64(1), replacement.getDeletedCount())
+
+	// deletes applied to the replaced segment are forwarded
+	pks = []primaryKey{newInt64PrimaryKey(1)}
+	offset = segment.segmentPreDelete(len(pks))
+	err = segment.segmentDelete(offset, pks, []Timestamp{1000})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), replacement.getDeletedCount())
+
+	replacement, err = genSimpleSealedSegment(defaultMsgLength)
+	assert.NoError(t, err)
+	replacement.segmentID = defaultSegmentID + 1
+	defer deleteSegment(replacement)
+	err = replica.replaceSegment(replacement)
+	assert.Error(t, err)
+}
+
 func TestCollectionReplica_hasSegment(t *testing.T) {
 	node := newQueryNodeMock()
 	collectionID := UniqueID(0)
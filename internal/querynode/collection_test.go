@@ -18,10 +18,16 @@ package querynode
 
 import (
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func TestCollection_newCollection(t *testing.T) {
@@ -55,6 +61,68 @@ func TestCollection_schema(t *testing.T) {
 	deleteCollection(collection)
 }
 
+func TestCollection_updateSchema(t *testing.T) {
+	collectionID := UniqueID(0)
+	pkType := schemapb.DataType_Int64
+	schema := genTestCollectionSchema(pkType)
+
+	collection := newCollection(collectionID, schema)
+	defer deleteCollection(collection)
+
+	newSchema := proto.Clone(schema).(*schemapb.CollectionSchema)
+	newSchema.Description = "new description"
+	newSchema.Fields[0].Description = "new field description"
+	err := collection.updateSchema(newSchema)
+	assert.NoError(t, err)
+	assert.Equal(t, "new description", collection.Schema().Description)
+
+	// fields must not be changed
+	fieldChanged := proto.Clone(newSchema).(*schemapb.CollectionSchema)
+	fieldChanged.Fields[0].Name = "changed"
+	err = collection.updateSchema(fieldChanged)
+	assert.Error(t, err)
+	fieldAdded := proto.Clone(newSchema).(*schemapb.CollectionSchema)
+	fieldAdded.Fields = append(fieldAdded.Fields, &schemapb.FieldSchema{FieldID: 999, Name: "added", DataType: schemapb.DataType_Int64})
+	err = collection.updateSchema(fieldAdded)
+	assert.Error(t, err)
+	assert.Equal(t, newSchema, collection.Schema())
+}
+
+func TestCollection_getSwapEnabled(t *testing.T) {
+	schema := genTestCollectionSchema(schemapb.DataType_Int64)
+	collection := newCollection(UniqueID(0), schema)
+	defer deleteCollection(collection)
+	_, set := collection.getSwapEnabled()
+	assert.False(t, set)
+
+	// the properties of the collection could be updated, the load mode doesn't enable swapping
+	altered := proto.Clone(schema).(*schemapb.CollectionSchema)
+	err := typeutil.SetCollectionProperties(altered, map[string]string{common.CollectionMmapEnabledParam: "true"})
+	assert.NoError(t, err)
+	assert.NoError(t, collection.updateSchema(altered))
+	_, set = collection.getSwapEnabled()
+	assert.False(t, set)
+
+	altered = proto.Clone(altered).(*schemapb.CollectionSchema)
+	err = typeutil.SetCollectionProperties(altered, map[string]string{common.CollectionSwapEnabledParam: "true"})
+	assert.NoError(t, err)
+	assert.NoError(t, collection.updateSchema(altered))
+	enabled, set := collection.getSwapEnabled()
+	assert.True(t, set)
+	assert.True(t, enabled)
+}
+
+func TestCollection_getExpireTimestamp(t *testing.T) {
+	schema := genTestCollectionSchema(schemapb.DataType_Int64)
+	collection := newCollection(UniqueID(0), schema)
+	defer deleteCollection(collection)
+	assert.Equal(t, Timestamp(0), collection.getExpireTimestamp())
+
+	schema.Properties = append(schema.Properties, &commonpb.KeyValuePair{Key: common.CollectionTTLParam, Value: "3600"})
+	expireTime, _ := tsoutil.ParseTS(collection.getExpireTimestamp())
+	assert.WithinDuration(t, time.Now().Add(-time.Hour), expireTime, time.Minute)
+}
+
 func TestCollection_vChannel(t *testing.T) {
 	collectionID := UniqueID(0)
 	pkType := schemapb.DataType_Int64
@@ -146,6 +214,19 @@ func TestCollection_loadType(t *testing.T) {
 	assert.Equal(t, loadTypePartition, lt)
 }
 
+func TestCollection_releasedPartition(t *testing.T) {
+	collectionID := UniqueID(0)
+	pkType := schemapb.DataType_Int64
+	schema := genTestCollectionSchema(pkType)
+
+	collection := newCollection(collectionID, schema)
+	assert.False(t, collection.isReleasedPartition(defaultPartitionID))
+	collection.addReleasedPartition(defaultPartitionID)
+	assert.True(t, collection.isReleasedPartition(defaultPartitionID))
+	collection.deleteReleasedPartition(defaultPartitionID)
+	assert.False(t, collection.isReleasedPartition(defaultPartitionID))
+}
+
 func TestCollection_getFieldType(t *testing.T) {
 	coll := &Collection{schema: nil}
 	_, err := coll.getFieldType(100)
@@ -0,0 +1,195 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// configReloadEtcdPath is the etcd path under the meta root, the keys under it override the params in the config file,
+// e.g. {metaRootPath}/config/querynode/queryNode.slowQueryLog.threshold
+const configReloadEtcdPath = "config/querynode"
+
+// the backoff to watch etcd again once the watch fails, doubled on every failure in a row
+const (
+	configRewatchMinBackoff = 100 * time.Millisecond
+	configRewatchMaxBackoff = 30 * time.Second
+)
+
+// configReloader watches the config file and the etcd path, and applies the changes of the reloadable params at runtime,
+// so that they can be tuned without restarting QueryNode and reloading the segments.
+type configReloader struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	configFile string
+	etcdCli    *clientv3.Client
+	etcdPrefix string
+
+	// only accessed by the reload loop after started
+	fileModTime time.Time
+	fileKVs     map[string]string
+	etcdKVs     map[string]string
+}
+
+func newConfigReloader(ctx context.Context, etcdCli *clientv3.Client) *configReloader {
+	ctx1, cancel := context.WithCancel(ctx)
+	return &configReloader{
+		ctx:        ctx1,
+		cancel:     cancel,
+		configFile: Params.BaseTable.GetConfigFile(),
+		etcdCli:    etcdCli,
+		etcdPrefix: path.Join(Params.EtcdCfg.MetaRootPath, configReloadEtcdPath) + "/",
+		etcdKVs:    make(map[string]string),
+	}
+}
+
+func (r *configReloader) start() {
+	r.loadFile()
+	var watchCh clientv3.WatchChan
+	if r.etcdCli != nil {
+		watchCh = r.loadAndWatchEtcd()
+	}
+	r.reload()
+
+	r.wg.Add(1)
+	go r.reloadLoop(watchCh)
+	log.Info("config reloader started", zap.String("configFile", r.configFile), zap.String("etcdPrefix", r.etcdPrefix),
+		zap.Duration("interval", Params.QueryNodeCfg.ConfigReloadInterval))
+}
+
+func (r *configReloader) close() {
+	r.cancel()
+	r.wg.Wait()
+}
+
+func (r *configReloader) reloadLoop(watchCh clientv3.WatchChan) {
+	defer r.wg.Done()
+	ticker := time.NewTicker(Params.QueryNodeCfg.ConfigReloadInterval)
+	defer ticker.Stop()
+	// rewatch fires after the backoff once the watch fails, the watch channel is nil meanwhile
+	var rewatch <-chan time.Time
+	backoff := configRewatchMinBackoff
+	for {
+		select {
+		case <-r.ctx.Done():
+			log.Info("config reloader loop exit")
+			return
+		case <-ticker.C:
+			if r.loadFile() {
+				r.reload()
+			}
+		case <-rewatch:
+			rewatch = nil
+			watchCh = r.loadAndWatchEtcd()
+			r.reload()
+		case resp, ok := <-watchCh:
+			if !ok || resp.Err() != nil {
+				log.Warn("config reloader failed to watch etcd, rewatch after backoff", zap.String("prefix", r.etcdPrefix),
+					zap.Duration("backoff", backoff), zap.Error(resp.Err()))
+				watchCh = nil
+				rewatch = time.After(backoff)
+				backoff *= 2
+				if backoff > configRewatchMaxBackoff {
+					backoff = configRewatchMaxBackoff
+				}
+				continue
+			}
+			backoff = configRewatchMinBackoff
+			for _, event := range resp.Events {
+				key := strings.ToLower(strings.TrimPrefix(string(event.Kv.Key), r.etcdPrefix))
+				switch event.Type {
+				case mvccpb.PUT:
+					r.etcdKVs[key] = string(event.Kv.Value)
+				case mvccpb.DELETE:
+					delete(r.etcdKVs, key)
+				}
+			}
+			r.reload()
+		}
+	}
+}
+
+// loadFile reads the config file if modified, returns whether it's reloaded
+func (r *configReloader) loadFile() bool {
+	info, err := os.Stat(r.configFile)
+	if err != nil {
+		log.Warn("config reloader failed to stat config file", zap.String("configFile", r.configFile), zap.Error(err))
+		return false
+	}
+	if r.fileKVs != nil && info.ModTime().Equal(r.fileModTime) {
+		return false
+	}
+	kvs, err := paramtable.ReadYaml(r.configFile)
+	if err != nil {
+		log.Warn("config reloader failed to read config file", zap.String("configFile", r.configFile), zap.Error(err))
+		return false
+	}
+	r.fileModTime = info.ModTime()
+	r.fileKVs = kvs
+	return true
+}
+
+// loadAndWatchEtcd loads the params under the etcd prefix, and watches the changes after the loaded revision
+func (r *configReloader) loadAndWatchEtcd() clientv3.WatchChan {
+	resp, err := r.etcdCli.Get(r.ctx, r.etcdPrefix, clientv3.WithPrefix())
+	if err != nil {
+		log.Warn("config reloader failed to load params from etcd", zap.String("prefix", r.etcdPrefix), zap.Error(err))
+		return r.etcdCli.Watch(r.ctx, r.etcdPrefix, clientv3.WithPrefix())
+	}
+	r.etcdKVs = make(map[string]string, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		r.etcdKVs[strings.ToLower(strings.TrimPrefix(string(kv.Key), r.etcdPrefix))] = string(kv.Value)
+	}
+	return r.etcdCli.Watch(r.ctx, r.etcdPrefix, clientv3.WithPrefix(), clientv3.WithRev(resp.Header.GetRevision()+1))
+}
+
+// reload applies the params in the config file overridden by the ones in etcd
+func (r *configReloader) reload() {
+	if r.fileKVs == nil {
+		// the reloadable params would be reset to default without the config file
+		return
+	}
+	kvs := make(map[string]string, len(r.fileKVs)+len(r.etcdKVs))
+	for key, value := range r.fileKVs {
+		kvs[key] = value
+	}
+	for key, value := range r.etcdKVs {
+		kvs[key] = value
+	}
+
+	changed, err := Params.QueryNodeCfg.Reload(kvs)
+	if err != nil {
+		log.Warn("config reloader rejected invalid params", zap.Error(err))
+	}
+	for _, key := range changed {
+		log.Info("querynode param reloaded", zap.String("key", key), zap.String("value", Params.QueryNodeCfg.Base.LoadWithDefault(key, "")))
+	}
+}
@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigReloader(t *testing.T) {
+	content, err := os.ReadFile(Params.BaseTable.GetConfigFile())
+	require.NoError(t, err)
+	configFile := filepath.Join(t.TempDir(), "milvus.yaml")
+	writeConfig := func(content string, modTime time.Time) {
+		require.NoError(t, os.WriteFile(configFile, []byte(content), 0600))
+		require.NoError(t, os.Chtimes(configFile, modTime, modTime))
+	}
+	now := time.Now()
+	writeConfig(string(content), now)
+
+	reloader := newConfigReloader(context.Background(), nil)
+	reloader.configFile = configFile
+	defer func() {
+		reloader.close()
+		// restore the params of the original config file
+		reloader.configFile = Params.BaseTable.GetConfigFile()
+		reloader.etcdKVs = make(map[string]string)
+		reloader.loadFile()
+		reloader.reload()
+		assert.Equal(t, time.Duration(0), Params.QueryNodeCfg.SlowQueryThreshold.Load())
+	}()
+	reloader.start()
+	assert.Equal(t, time.Duration(0), Params.QueryNodeCfg.SlowQueryThreshold.Load())
+	assert.False(t, reloader.loadFile())
+
+	t.Run("file changed", func(t *testing.T) {
+		writeConfig(strings.Replace(string(content), "threshold: 0 # Searches", "threshold: 100 # Searches", 1), now.Add(time.Second))
+		assert.True(t, reloader.loadFile())
+		reloader.reload()
+		assert.Equal(t, 100*time.Millisecond, Params.QueryNodeCfg.SlowQueryThreshold.Load())
+	})
+
+	t.Run("etcd overrides file", func(t *testing.T) {
+		reloader.etcdKVs["querynode.slowquerylog.threshold"] = "200"
+		reloader.reload()
+		assert.Equal(t, 200*time.Millisecond, Params.QueryNodeCfg.SlowQueryThreshold.Load())
+
+		reloader.etcdKVs["querynode.slowquerylog.threshold"] = "invalid"
+		reloader.reload()
+		assert.Equal(t, 200*time.Millisecond, Params.QueryNodeCfg.SlowQueryThreshold.Load())
+
+		delete(reloader.etcdKVs, "querynode.slowquerylog.threshold")
+		reloader.reload()
+		assert.Equal(t, 100*time.Millisecond, Params.QueryNodeCfg.SlowQueryThreshold.Load())
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		writeConfig("queryNode: [", now.Add(2*time.Second))
+		assert.False(t, reloader.loadFile())
+		reloader.reload()
+		assert.Equal(t, 100*time.Millisecond, Params.QueryNodeCfg.SlowQueryThreshold.Load())
+	})
+}
@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+)
+
+// consumerLagKey is the label values of a consumer reported to prometheus
+type consumerLagKey struct {
+	channel      string
+	subscription string
+}
+
+// consumerLagReporter reports the lags of the msgstream consumers of the flow graphs to prometheus periodically,
+// so that the data invisible to searches could be traced to the lag of the mq.
+type consumerLagReporter struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	consumerLags func() []*msgstream.ConsumerLag
+
+	// reported is the consumers reported last time, whose metrics are deleted once they are gone
+	reported map[consumerLagKey]struct{}
+}
+
+func newConsumerLagReporter(ctx context.Context, consumerLags func() []*msgstream.ConsumerLag) *consumerLagReporter {
+	ctx1, cancel := context.WithCancel(ctx)
+	return &consumerLagReporter{
+		ctx:          ctx1,
+		cancel:       cancel,
+		consumerLags: consumerLags,
+		reported:     make(map[consumerLagKey]struct{}),
+	}
+}
+
+func (r *consumerLagReporter) start() {
+	r.wg.Add(1)
+	go r.reportLoop()
+	log.Info("consumer lag reporter started", zap.Duration("interval", Params.CommonCfg.MsgStreamLagReportInterval))
+}
+
+func (r *consumerLagReporter) close() {
+	r.cancel()
+	r.wg.Wait()
+}
+
+func (r *consumerLagReporter) reportLoop() {
+	defer r.wg.Done()
+	ticker := time.NewTicker(Params.CommonCfg.MsgStreamLagReportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			log.Info("consumer lag reporter loop exit")
+			return
+		case <-ticker.C:
+			r.report()
+		}
+	}
+}
+
+func (r *consumerLagReporter) report() {
+	nodeID := strconv.FormatInt(Params.QueryNodeCfg.GetNodeID(), 10)
+	reported := make(map[consumerLagKey]struct{})
+	for _, lag := range r.consumerLags() {
+		metrics.QueryNodeConsumerMsgLag.WithLabelValues(nodeID, lag.Channel, lag.Subscription).Set(float64(lag.MsgLag))
+		metrics.QueryNodeConsumerTimeLag.WithLabelValues(nodeID, lag.Channel, lag.Subscription).Set(lag.TimeLag.Seconds())
+		reported[consumerLagKey{channel: lag.Channel, subscription: lag.Subscription}] = struct{}{}
+	}
+	for key := range r.reported {
+		if _, ok := reported[key]; !ok {
+			metrics.QueryNodeConsumerMsgLag.DeleteLabelValues(nodeID, key.channel, key.subscription)
+			metrics.QueryNodeConsumerTimeLag.DeleteLabelValues(nodeID, key.channel, key.subscription)
+		}
+	}
+	r.reported = reported
+}
@@ -0,0 +1,79 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+func TestConsumerLagReporter(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lags := []*msgstream.ConsumerLag{
+		{Channel: "ch-1", Subscription: "sub-1", MsgLag: 10, TimeLag: 2 * time.Second},
+		{Channel: "ch-2", Subscription: "sub-2", MsgLag: -1, TimeLag: time.Second},
+	}
+	reporter := newConsumerLagReporter(ctx, func() []*msgstream.ConsumerLag { return lags })
+	reporter.report()
+
+	nodeID := strconv.FormatInt(Params.QueryNodeCfg.GetNodeID(), 10)
+	assert.Equal(t, float64(10), testutil.ToFloat64(metrics.QueryNodeConsumerMsgLag.WithLabelValues(nodeID, "ch-1", "sub-1")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.QueryNodeConsumerTimeLag.WithLabelValues(nodeID, "ch-1", "sub-1")))
+	assert.Equal(t, float64(-1), testutil.ToFloat64(metrics.QueryNodeConsumerMsgLag.WithLabelValues(nodeID, "ch-2", "sub-2")))
+
+	// the metrics of the consumers gone are deleted
+	lags = lags[:1]
+	reporter.report()
+	assert.False(t, metrics.QueryNodeConsumerMsgLag.DeleteLabelValues(nodeID, "ch-2", "sub-2"))
+	assert.False(t, metrics.QueryNodeConsumerTimeLag.DeleteLabelValues(nodeID, "ch-2", "sub-2"))
+	assert.Len(t, reporter.reported, 1)
+
+	reporter.start()
+	reporter.close()
+}
+
+func TestGetConsumerLagsMetrics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.ConsumerLagsMetrics)
+	assert.NoError(t, err)
+	resp, err := node.GetMetrics(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+	var lags []metricsinfo.ConsumerLag
+	err = json.Unmarshal([]byte(resp.Response), &lags)
+	assert.NoError(t, err)
+	assert.Empty(t, lags)
+}
@@ -20,12 +20,14 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/util/flowgraph"
 )
 
 // dataSyncService manages a lot of flow graphs
@@ -228,6 +230,43 @@ func newDataSyncService(ctx context.Context,
 	}
 }
 
+// getLastMsgTimes returns the time of the last message pack consumed by the flow graph of each DML and delta channel
+func (dsService *dataSyncService) getLastMsgTimes() map[Channel]time.Time {
+	dsService.mu.Lock()
+	defer dsService.mu.Unlock()
+
+	lastMsgTimes := make(map[Channel]time.Time, len(dsService.dmlChannel2FlowGraph)+len(dsService.deltaChannel2FlowGraph))
+	for _, channel2FlowGraph := range []map[Channel]*queryNodeFlowGraph{dsService.dmlChannel2FlowGraph, dsService.deltaChannel2FlowGraph} {
+		for channel, fg := range channel2FlowGraph {
+			if fg != nil && fg.inputNode != nil {
+				lastMsgTimes[channel] = fg.inputNode.LastMsgTime()
+			}
+		}
+	}
+	return lastMsgTimes
+}
+
+// getConsumerLags returns the lags of the consumers of the flow graphs of all DML and delta channels
+func (dsService *dataSyncService) getConsumerLags() []*msgstream.ConsumerLag {
+	dsService.mu.Lock()
+	inputNodes := make([]*flowgraph.InputNode, 0, len(dsService.dmlChannel2FlowGraph)+len(dsService.deltaChannel2FlowGraph))
+	for _, channel2FlowGraph := range []map[Channel]*queryNodeFlowGraph{dsService.dmlChannel2FlowGraph, dsService.deltaChannel2FlowGraph} {
+		for _, fg := range channel2FlowGraph {
+			if fg != nil && fg.inputNode != nil {
+				inputNodes = append(inputNodes, fg.inputNode)
+			}
+		}
+	}
+	dsService.mu.Unlock()
+
+	// the latest message ids are requested from the mq, out of the lock
+	var lags []*msgstream.ConsumerLag
+	for _, inputNode := range inputNodes {
+		lags = append(lags, inputNode.ConsumerLags()...)
+	}
+	return lags
+}
+
 // close would close and remove all flow graphs in dataSyncService
 func (dsService *dataSyncService) close() {
 	// close DML flow graphs
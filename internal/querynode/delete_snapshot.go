@@ -0,0 +1,152 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"path"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// deleteSnapshotPrefix is the storage prefix of delete snapshots,
+// the snapshot of a segment is saved as {prefix}/{collectionID}/{partitionID}/{segmentID}/{timestamp},
+// which contains all the deletes applied to the segment until the timestamp.
+// DataCoord garbage collects the snapshots of the segments dropped.
+const deleteSnapshotPrefix = "delete_snapshot"
+
+func getDeleteSnapshotPrefix(segment *Segment) string {
+	return path.Join(Params.MinioCfg.RootPath, deleteSnapshotPrefix,
+		strconv.FormatInt(segment.collectionID, 10),
+		strconv.FormatInt(segment.partitionID, 10),
+		strconv.FormatInt(segment.segmentID, 10)) + "/"
+}
+
+// listDeleteSnapshots returns the keys of all the delete snapshots of the segment
+func (loader *segmentLoader) listDeleteSnapshots(segment *Segment) ([]string, error) {
+	prefix := getDeleteSnapshotPrefix(segment)
+	keys, err := loader.cm.ListWithPrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	// local chunk manager matches the prefix without the trailing slash, filter out other segments
+	segmentDir := path.Base(prefix)
+	ret := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if path.Base(path.Dir(key)) == segmentDir {
+			ret = append(ret, key)
+		}
+	}
+	return ret, nil
+}
+
+// saveDeleteSnapshot persists the deletes applied to the sealed segment,
+// so that the node loading the segment next time only replays the delta logs after the snapshot.
+func (loader *segmentLoader) saveDeleteSnapshot(segment *Segment) error {
+	pks, tss, snapshotTs, err := segment.getAppliedDeletes()
+	if err != nil {
+		return err
+	}
+	if len(pks) == 0 {
+		return nil
+	}
+
+	dCodec := storage.DeleteCodec{}
+	deleteData := &storage.DeleteData{
+		Pks:      pks,
+		Tss:      tss,
+		RowCount: int64(len(pks)),
+	}
+	blob, err := dCodec.Serialize(segment.collectionID, segment.partitionID, segment.segmentID, deleteData)
+	if err != nil {
+		return err
+	}
+
+	// only the latest snapshot is needed
+	staleKeys, err := loader.listDeleteSnapshots(segment)
+	if err != nil {
+		return err
+	}
+	snapshotKey := getDeleteSnapshotPrefix(segment) + strconv.FormatUint(snapshotTs, 10)
+	if err = loader.cm.Write(snapshotKey, blob.Value); err != nil {
+		return err
+	}
+	for i := 0; i < len(staleKeys); i++ {
+		if path.Base(staleKeys[i]) == path.Base(snapshotKey) {
+			staleKeys = append(staleKeys[:i], staleKeys[i+1:]...)
+			i--
+		}
+	}
+	if err = loader.cm.MultiRemove(staleKeys); err != nil {
+		log.Warn("failed to remove stale delete snapshots", zap.Int64("segmentID", segment.segmentID), zap.Error(err))
+	}
+	log.Info("save delete snapshot done",
+		zap.Int64("collectionID", segment.collectionID),
+		zap.Int64("segmentID", segment.segmentID),
+		zap.Int("numDeletes", len(pks)),
+		zap.Uint64("snapshotTs", snapshotTs))
+	return nil
+}
+
+// loadDeleteSnapshot loads the latest delete snapshot of the sealed segment if exists,
+// returns the timestamp of the snapshot, 0 if not found.
+func (loader *segmentLoader) loadDeleteSnapshot(segment *Segment) (Timestamp, error) {
+	keys, err := loader.listDeleteSnapshots(segment)
+	if err != nil {
+		return 0, err
+	}
+
+	var snapshotKey string
+	var snapshotTs Timestamp
+	for _, key := range keys {
+		ts, err := strconv.ParseUint(path.Base(key), 10, 64)
+		if err != nil {
+			log.Warn("invalid delete snapshot key", zap.String("key", key))
+			continue
+		}
+		if ts > snapshotTs {
+			snapshotKey, snapshotTs = key, ts
+		}
+	}
+	if snapshotKey == "" {
+		return 0, nil
+	}
+
+	value, err := loader.cm.Read(snapshotKey)
+	if err != nil {
+		return 0, err
+	}
+	dCodec := storage.DeleteCodec{}
+	_, _, deleteData, err := dCodec.Deserialize([]*storage.Blob{{Key: snapshotKey, Value: value}})
+	if err != nil {
+		return 0, err
+	}
+	if err = segment.segmentLoadDeletedRecord(deleteData.Pks, deleteData.Tss, deleteData.RowCount); err != nil {
+		return 0, err
+	}
+	segment.recordLoadedDeletes(deleteData.Pks, deleteData.Tss)
+
+	log.Info("load delete snapshot done",
+		zap.Int64("collectionID", segment.collectionID),
+		zap.Int64("segmentID", segment.segmentID),
+		zap.Int64("numDeletes", deleteData.RowCount),
+		zap.Uint64("snapshotTs", snapshotTs))
+	return snapshotTs, nil
+}
@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestDeleteSnapshot(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	Params.QueryNodeCfg.DeleteSnapshotEnabled = true
+	defer func() {
+		Params.QueryNodeCfg.DeleteSnapshotEnabled = false
+	}()
+
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+	loader := node.loader
+
+	schema := genTestCollectionSchema(schemapb.DataType_Int64)
+	genSegment := func(segmentID UniqueID) *Segment {
+		segment, err := genSealedSegment(schema, defaultCollectionID, defaultPartitionID, segmentID, defaultDMLChannel, defaultMsgLength)
+		assert.NoError(t, err)
+		segment.setLoadInfo(&querypb.SegmentLoadInfo{SegmentID: segmentID})
+		return segment
+	}
+
+	t.Run("test save and load", func(t *testing.T) {
+		segment := genSegment(defaultSegmentID)
+		defer deleteSegment(segment)
+
+		// nothing to save
+		err := loader.saveDeleteSnapshot(segment)
+		assert.NoError(t, err)
+
+		pks := []primaryKey{newInt64PrimaryKey(0), newInt64PrimaryKey(1)}
+		tss := []Timestamp{1000, 1001}
+		offset := segment.segmentPreDelete(len(pks))
+		err = segment.segmentDelete(offset, pks, tss)
+		assert.NoError(t, err)
+
+		err = loader.saveDeleteSnapshot(segment)
+		assert.NoError(t, err)
+		// save again, stale snapshot is removed
+		err = loader.saveDeleteSnapshot(segment)
+		assert.NoError(t, err)
+		keys, err := loader.listDeleteSnapshots(segment)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(keys))
+
+		reloaded := genSegment(defaultSegmentID)
+		defer deleteSegment(reloaded)
+		snapshotTs, err := loader.loadDeleteSnapshot(reloaded)
+		assert.NoError(t, err)
+		assert.Equal(t, Timestamp(1001), snapshotTs)
+		assert.Equal(t, int64(len(pks)), reloaded.getDeletedCount())
+
+		loadedPks, _, maxTs, err := reloaded.getAppliedDeletes()
+		assert.NoError(t, err)
+		assert.Equal(t, len(pks), len(loadedPks))
+		assert.Equal(t, Timestamp(1001), maxTs)
+	})
+
+	t.Run("test load without snapshot", func(t *testing.T) {
+		segment := genSegment(defaultSegmentID * 10)
+		defer deleteSegment(segment)
+
+		snapshotTs, err := loader.loadDeleteSnapshot(segment)
+		assert.NoError(t, err)
+		assert.Equal(t, Timestamp(0), snapshotTs)
+	})
+}
@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	queryPb "github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+const drainCheckInterval = time.Second
+
+// DrainState is the state of a QueryNode being drained for scale-in
+type DrainState int32
+
+const (
+	// DrainStateNone means the node is serving normally
+	DrainStateNone DrainState = iota
+	// DrainStateDraining means the node rejects new load tasks and waits for QueryCoord to hand off its data
+	DrainStateDraining
+	// DrainStateHandedOff means the data has been served by other nodes, new search/query requests are rejected,
+	// the node keeps in it until the in-flight requests are finished
+	DrainStateHandedOff
+	// DrainStateSafeToTerminate means the in-flight requests are finished and the node could be terminated
+	DrainStateSafeToTerminate
+)
+
+var drainStateNames = map[DrainState]string{
+	DrainStateNone:            "none",
+	DrainStateDraining:        "draining",
+	DrainStateHandedOff:       "handed_off",
+	DrainStateSafeToTerminate: "safe_to_terminate",
+}
+
+// String returns the readable name of the drain state
+func (state DrainState) String() string {
+	return drainStateNames[state]
+}
+
+// Drain starts draining the QueryNode for graceful scale-in, it's idempotent and returns immediately,
+// the progress could be checked by the state in response or GetDrainState.
+func (node *QueryNode) Drain(ctx context.Context, req *queryPb.DrainRequest) (*queryPb.DrainResponse, error) {
+	if err := node.startDrain(); err != nil {
+		log.Warn("failed to drain query node", zap.Int64("nodeID", Params.QueryNodeCfg.GetNodeID()), zap.Error(err))
+		return &queryPb.DrainResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			State: node.GetDrainState().String(),
+		}, nil
+	}
+	return &queryPb.DrainResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		State: node.GetDrainState().String(),
+	}, nil
+}
+
+func (node *QueryNode) startDrain() error {
+	if !node.isHealthy() {
+		return fmt.Errorf("query node %d is not ready", Params.QueryNodeCfg.GetNodeID())
+	}
+	if !atomic.CompareAndSwapInt32(&node.drainState, int32(DrainStateNone), int32(DrainStateDraining)) {
+		return nil
+	}
+	// the drain key is attached to the session, so that QueryCoord knows the node is drained until it's gone
+	key := fmt.Sprintf("%s/%d", util.QueryNodeDrainPrefix, Params.QueryNodeCfg.GetNodeID())
+	if err := node.etcdKV.SaveWithLease(key, util.QueryNodeDrainStateDraining, node.session.LeaseID()); err != nil {
+		node.setDrainState(DrainStateNone)
+		return err
+	}
+	log.Info("query node start draining", zap.Int64("nodeID", Params.QueryNodeCfg.GetNodeID()))
+
+	node.wg.Add(1)
+	go node.waitHandOff(key)
+	return nil
+}
+
+// GetDrainState returns the drain state of the QueryNode
+func (node *QueryNode) GetDrainState() DrainState {
+	return DrainState(atomic.LoadInt32(&node.drainState))
+}
+
+func (node *QueryNode) setDrainState(state DrainState) {
+	atomic.StoreInt32(&node.drainState, int32(state))
+}
+
+func (node *QueryNode) isDraining() bool {
+	return node.GetDrainState() != DrainStateNone
+}
+
+func (node *QueryNode) isHandedOff() bool {
+	return node.GetDrainState() >= DrainStateHandedOff
+}
+
+// waitHandOff waits for QueryCoord to hand off the data, then waits for the in-flight requests to finish. The node
+// isn't safe to terminate until they are finished, however long it takes.
+func (node *QueryNode) waitHandOff(key string) {
+	defer node.wg.Done()
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-node.queryNodeLoopCtx.Done():
+			return
+		case <-ticker.C:
+			if !node.isHandedOff() {
+				value, err := node.etcdKV.Load(key)
+				if err != nil {
+					log.Warn("failed to load the drain state of query node", zap.String("key", key), zap.Error(err))
+					continue
+				}
+				if value != util.QueryNodeDrainStateHandedOff {
+					continue
+				}
+				node.setDrainState(DrainStateHandedOff)
+				log.Info("query node handed off, wait for the in-flight requests", zap.Int64("nodeID", Params.QueryNodeCfg.GetNodeID()))
+			}
+
+			maxWait := Params.QueryNodeCfg.GracefulReleaseMaxWait
+			if !node.inFlightRequests.drainAll(maxWait) {
+				log.Warn("in-flight requests are not finished, keep waiting before terminating", zap.Duration("maxWait", maxWait))
+				continue
+			}
+			node.setDrainState(DrainStateSafeToTerminate)
+			log.Info("query node drained, safe to terminate", zap.Int64("nodeID", Params.QueryNodeCfg.GetNodeID()))
+			return
+		}
+	}
+}
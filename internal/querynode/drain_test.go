@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	queryPb "github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+func TestQueryNode_Drain(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("test drain", func(t *testing.T) {
+		node, err := genSimpleQueryNode(ctx)
+		require.NoError(t, err)
+		defer node.Stop()
+
+		assert.Equal(t, DrainStateNone, node.GetDrainState())
+		key := fmt.Sprintf("%s/%d", util.QueryNodeDrainPrefix, Params.QueryNodeCfg.GetNodeID())
+		defer node.etcdKV.Remove(key)
+
+		resp, err := node.Drain(ctx, &queryPb.DrainRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, "draining", resp.State)
+		assert.Equal(t, DrainStateDraining, node.GetDrainState())
+		// drain is idempotent
+		resp, err = node.Drain(ctx, &queryPb.DrainRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, "draining", resp.State)
+
+		value, err := node.etcdKV.Load(key)
+		assert.NoError(t, err)
+		assert.Equal(t, util.QueryNodeDrainStateDraining, value)
+
+		// new tasks are rejected while draining
+		status, err := node.LoadSegments(ctx, &queryPb.LoadSegmentsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+		assert.Equal(t, msgQueryNodeIsDraining(Params.QueryNodeCfg.GetNodeID()), status.Reason)
+		status, err = node.WatchDmChannels(ctx, &queryPb.WatchDmChannelsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+		status, err = node.WatchDeltaChannels(ctx, &queryPb.WatchDeltaChannelsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+
+		// in-flight request blocks the termination, even beyond the max wait
+		maxWait := Params.QueryNodeCfg.GracefulReleaseMaxWait
+		Params.QueryNodeCfg.GracefulReleaseMaxWait = 100 * time.Millisecond
+		defer func() { Params.QueryNodeCfg.GracefulReleaseMaxWait = maxWait }()
+		done := node.inFlightRequests.add(defaultCollectionID)
+		err = node.etcdKV.Save(key, util.QueryNodeDrainStateHandedOff)
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			return node.GetDrainState() == DrainStateHandedOff
+		}, 10*time.Second, 10*time.Millisecond)
+
+		// new requests are rejected after handed off
+		schema := genTestCollectionSchema(schemapb.DataType_Int64)
+		searchReq, err := genSearchRequest(defaultNQ, IndexFaissIDMap, schema)
+		require.NoError(t, err)
+		searchResult, err := node.Search(ctx, &queryPb.SearchRequest{Req: searchReq, DmlChannel: defaultDMLChannel})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotShardLeader, searchResult.Status.ErrorCode)
+		retrieveReq, err := genRetrieveRequest(schema)
+		require.NoError(t, err)
+		retrieveResult, err := node.Query(ctx, &queryPb.QueryRequest{Req: retrieveReq, DmlChannel: defaultDMLChannel})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotShardLeader, retrieveResult.Status.ErrorCode)
+
+		assert.Never(t, func() bool {
+			return node.GetDrainState() == DrainStateSafeToTerminate
+		}, 3*drainCheckInterval, 100*time.Millisecond)
+		assert.Equal(t, DrainStateHandedOff, node.GetDrainState())
+
+		done()
+		assert.Eventually(t, func() bool {
+			return node.GetDrainState() == DrainStateSafeToTerminate
+		}, 10*time.Second, 10*time.Millisecond)
+		assert.Equal(t, "safe_to_terminate", node.GetDrainState().String())
+
+		// the drain key is kept until the session expires
+		value, err = node.etcdKV.Load(key)
+		assert.NoError(t, err)
+		assert.Equal(t, util.QueryNodeDrainStateHandedOff, value)
+	})
+
+	t.Run("test drain unhealthy node", func(t *testing.T) {
+		node, err := genSimpleQueryNode(ctx)
+		require.NoError(t, err)
+		defer node.Stop()
+
+		node.UpdateStateCode(internalpb.StateCode_Abnormal)
+		resp, err := node.Drain(ctx, &queryPb.DrainRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+		assert.Equal(t, "none", resp.State)
+		assert.Equal(t, DrainStateNone, node.GetDrainState())
+	})
+}
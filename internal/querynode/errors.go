@@ -26,6 +26,16 @@ func msgQueryNodeIsUnhealthy(nodeID UniqueID) string {
 	return fmt.Sprintf("query node %d is not ready", nodeID)
 }
 
+// msgQueryNodeIsDraining is the error msg of query node rejecting new tasks for scale-in
+func msgQueryNodeIsDraining(nodeID UniqueID) string {
+	return fmt.Sprintf("query node %d is draining", nodeID)
+}
+
+// msgQueryNodeIsDrained is the error msg of query node whose data has been handed off to other nodes
+func msgQueryNodeIsDrained(nodeID UniqueID) string {
+	return fmt.Sprintf("query node %d has been drained", nodeID)
+}
+
 // errQueryNodeIsUnhealthy is the error of query node is unhealthy
 func errQueryNodeIsUnhealthy(nodeID UniqueID) error {
 	return errors.New(msgQueryNodeIsUnhealthy(nodeID))
@@ -137,6 +137,12 @@ func (fdmNode *filterDmNode) filterInvalidDeleteMessage(msg *msgstream.DeleteMsg
 			return nil
 		}
 	}
+	if col.isReleasedPartition(msg.PartitionID) {
+		log.Debug("filter invalid delete message, partition has been released",
+			zap.Any("collectionID", msg.CollectionID),
+			zap.Any("partitionID", msg.PartitionID))
+		return nil
+	}
 
 	if len(msg.Timestamps) <= 0 {
 		log.Debug("filter invalid delete message, no message",
@@ -183,6 +189,12 @@ func (fdmNode *filterDmNode) filterInvalidInsertMessage(msg *msgstream.InsertMsg
 			return nil
 		}
 	}
+	if col.isReleasedPartition(msg.PartitionID) {
+		log.Debug("filter invalid insert message, partition has been released",
+			zap.Any("collectionID", msg.CollectionID),
+			zap.Any("partitionID", msg.PartitionID))
+		return nil
+	}
 
 	// Check if the segment is in excluded segments,
 	// messages after seekPosition may contain the redundant data from flushed slice of segment,
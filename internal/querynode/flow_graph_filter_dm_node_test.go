@@ -89,6 +89,20 @@ func TestFlowGraphFilterDmNode_filterInvalidInsertMessage(t *testing.T) {
 		assert.Nil(t, res)
 	})
 
+	t.Run("test released partition", func(t *testing.T) {
+		msg, err := genSimpleInsertMsg(schema, defaultMsgLength)
+		assert.NoError(t, err)
+		fg, err := getFilterDMNode(ctx)
+		assert.NoError(t, err)
+
+		col, err := fg.replica.getCollectionByID(defaultCollectionID)
+		assert.NoError(t, err)
+		col.addReleasedPartition(msg.PartitionID)
+
+		res := fg.filterInvalidInsertMessage(msg)
+		assert.Nil(t, res)
+	})
+
 	t.Run("test not target collection", func(t *testing.T) {
 		msg, err := genSimpleInsertMsg(schema, defaultMsgLength)
 		assert.NoError(t, err)
@@ -186,6 +200,19 @@ func TestFlowGraphFilterDmNode_filterInvalidDeleteMessage(t *testing.T) {
 		assert.Nil(t, res)
 	})
 
+	t.Run("test delete released partition", func(t *testing.T) {
+		msg := genDeleteMsg(defaultCollectionID, schemapb.DataType_Int64, defaultDelLength)
+		fg, err := getFilterDMNode(ctx)
+		assert.NoError(t, err)
+
+		col, err := fg.replica.getCollectionByID(defaultCollectionID)
+		assert.NoError(t, err)
+		col.addReleasedPartition(msg.PartitionID)
+
+		res := fg.filterInvalidDeleteMessage(msg)
+		assert.Nil(t, res)
+	})
+
 	t.Run("test delete not target collection", func(t *testing.T) {
 		msg := genDeleteMsg(defaultCollectionID, schemapb.DataType_Int64, defaultDelLength)
 		fg, err := getFilterDMNode(ctx)
@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -104,6 +105,9 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		msg.SetTraceCtx(ctx)
 	}
 
+	// skip the messages which are replayed after seek but have been applied already
+	iMsg.insertMessages = iNode.filterAppliedInsertMessages(iMsg.insertMessages)
+
 	// 1. hash insertMessages to insertData
 	// sort timestamps ensures that the data in iData.insertRecords is sorted in ascending order of timestamp
 	// avoiding re-sorting in segCore, which will need data copying
@@ -134,11 +138,13 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			}
 		}
 
-		insertRecord, err := storage.TransferInsertMsgToInsertRecord(col.schema, insertMsg)
+		insertRecord, err := storage.TransferInsertMsgToInsertRecord(col.Schema(), insertMsg)
 		if err != nil {
 			log.Warn("failed to transfer msgStream.insertMsg to segcorepb.InsertRecord", zap.Error(err))
 			return []Msg{}
 		}
+		// the schema may be pruned to the fields to load, the data of the other fields are dropped
+		insertRecord.FieldsData = filterFieldsDataBySchema(insertRecord.FieldsData, col.Schema())
 
 		iData.insertIDs[insertMsg.SegmentID] = append(iData.insertIDs[insertMsg.SegmentID], insertMsg.RowIDs...)
 		iData.insertTimestamps[insertMsg.SegmentID] = append(iData.insertTimestamps[insertMsg.SegmentID], insertMsg.Timestamps...)
@@ -183,6 +189,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		go iNode.insert(&iData, segmentID, &wg)
 	}
 	wg.Wait()
+	iNode.updateAppliedPositions(iMsg.insertMessages, iMsg.timeRange.timestampMax)
 
 	delData := &deleteData{
 		deleteIDs:        make(map[UniqueID][]primaryKey),
@@ -229,6 +236,63 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 	return []Msg{res}
 }
 
+// filterAppliedInsertMessages drops the insert messages which have been applied to the growing segments,
+// that is, messages not newer than the applied position of their channel, or duplicated in the same pack
+func (iNode *insertNode) filterAppliedInsertMessages(msgs []*msgstream.InsertMsg) []*msgstream.InsertMsg {
+	type msgKey struct {
+		position string
+		msgID    UniqueID
+	}
+	seen := make(map[msgKey]struct{})
+	appliedPositions := make(map[Channel]*internalpb.MsgPosition)
+	filtered := make([]*msgstream.InsertMsg, 0, len(msgs))
+	for _, msg := range msgs {
+		appliedPosition, ok := appliedPositions[msg.ShardName]
+		if !ok {
+			appliedPosition = iNode.streamingReplica.getAppliedPosition(msg.ShardName)
+			appliedPositions[msg.ShardName] = appliedPosition
+		}
+		if appliedPosition != nil && msg.EndTs() <= appliedPosition.GetTimestamp() {
+			log.Debug("skip applied insert message",
+				zap.String("channel", msg.ShardName),
+				zap.Int64("msgID", msg.Base.GetMsgID()),
+				zap.Uint64("endTs", msg.EndTs()),
+				zap.Uint64("appliedTs", appliedPosition.GetTimestamp()))
+			continue
+		}
+		if msg.Position() != nil {
+			key := msgKey{position: string(msg.Position().GetMsgID()), msgID: msg.Base.GetMsgID()}
+			if _, ok := seen[key]; ok {
+				log.Debug("skip duplicated insert message",
+					zap.String("channel", msg.ShardName),
+					zap.Int64("msgID", msg.Base.GetMsgID()))
+				continue
+			}
+			seen[key] = struct{}{}
+		}
+		filtered = append(filtered, msg)
+	}
+	return filtered
+}
+
+// updateAppliedPositions records the end of the pack as the applied position of the channels of the insert messages
+func (iNode *insertNode) updateAppliedPositions(msgs []*msgstream.InsertMsg, ts Timestamp) {
+	positions := make(map[Channel]*internalpb.MsgPosition)
+	for _, msg := range msgs {
+		position := &internalpb.MsgPosition{
+			ChannelName: msg.ShardName,
+			Timestamp:   ts,
+		}
+		if msg.Position() != nil {
+			position.MsgID = msg.Position().GetMsgID()
+		}
+		positions[msg.ShardName] = position
+	}
+	for channel, position := range positions {
+		iNode.streamingReplica.setAppliedPosition(channel, position)
+	}
+}
+
 // processDeleteMessages would execute delete operations for growing segments
 func processDeleteMessages(replica ReplicaInterface, msg *msgstream.DeleteMsg, delData *deleteData) {
 	var partitionIDs []UniqueID
@@ -282,21 +346,11 @@ func filterSegmentsByPKs(pks []primaryKey, timestamps []Timestamp, segment *Segm
 
 	retPks := make([]primaryKey, 0)
 	retTss := make([]Timestamp, 0)
-	buf := make([]byte, 8)
 	for index, pk := range pks {
-		exist := false
-		switch pk.Type() {
-		case schemapb.DataType_Int64:
-			int64Pk := pk.(*int64PrimaryKey)
-			common.Endian.PutUint64(buf, uint64(int64Pk.Value))
-			exist = segment.pkFilter.Test(buf)
-		case schemapb.DataType_VarChar:
-			varCharPk := pk.(*varCharPrimaryKey)
-			exist = segment.pkFilter.TestString(varCharPk.Value)
-		default:
+		if pk.Type() != schemapb.DataType_Int64 && pk.Type() != schemapb.DataType_VarChar {
 			return nil, nil, fmt.Errorf("invalid data type of delete primary keys")
 		}
-		if exist {
+		if segment.mayContainPK(pk) {
 			retPks = append(retPks, pk)
 			retTss = append(retTss, timestamps[index])
 		}
@@ -366,6 +420,21 @@ func (iNode *insertNode) delete(deleteData *deleteData, segmentID UniqueID, wg *
 }
 
 // TODO: remove this function to proper file
+// filterFieldsDataBySchema returns the data of the fields in the schema
+func filterFieldsDataBySchema(fieldsData []*schemapb.FieldData, schema *schemapb.CollectionSchema) []*schemapb.FieldData {
+	fieldIDs := make(map[FieldID]struct{}, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		fieldIDs[field.GetFieldID()] = struct{}{}
+	}
+	ret := make([]*schemapb.FieldData, 0, len(fieldsData))
+	for _, fieldData := range fieldsData {
+		if _, ok := fieldIDs[fieldData.GetFieldId()]; ok {
+			ret = append(ret, fieldData)
+		}
+	}
+	return ret
+}
+
 // getPrimaryKeys would get primary keys by insert messages
 func getPrimaryKeys(msg *msgstream.InsertMsg, streamingReplica ReplicaInterface) ([]primaryKey, error) {
 	if err := msg.CheckAligned(); err != nil {
@@ -380,7 +449,7 @@ func getPrimaryKeys(msg *msgstream.InsertMsg, streamingReplica ReplicaInterface)
 		return nil, err
 	}
 
-	return getPKs(msg, collection.schema)
+	return getPKs(msg, collection.Schema())
 }
 
 func getPKs(msg *msgstream.InsertMsg, schema *schemapb.CollectionSchema) ([]primaryKey, error) {
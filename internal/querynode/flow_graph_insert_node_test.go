@@ -375,6 +375,52 @@ func TestFlowGraphInsertNode_operate(t *testing.T) {
 		msg := []flowgraph.Msg{&iMsg, &iMsg}
 		insertNode.Operate(msg)
 	})
+
+	t.Run("test replayed messages", func(t *testing.T) {
+		streaming, err := genSimpleReplica()
+		assert.NoError(t, err)
+		insertNode := newInsertNode(streaming)
+
+		collection, err := streaming.getCollectionByID(defaultCollectionID)
+		assert.NoError(t, err)
+		msgInsertMsg, err := genSimpleInsertMsg(collection.schema, defaultMsgLength)
+		assert.NoError(t, err)
+		msgInsertMsg.EndTimestamp = 50
+		msgInsertMsg.MsgPosition.MsgID = []byte{1}
+
+		// the message delivered twice in the same pack is applied once
+		iMsg := insertMsg{
+			insertMessages: []*msgstream.InsertMsg{msgInsertMsg, msgInsertMsg},
+			timeRange:      TimeRange{timestampMin: 0, timestampMax: 100},
+		}
+		insertNode.Operate([]flowgraph.Msg{&iMsg})
+		s, err := streaming.getSegmentByID(defaultSegmentID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(defaultMsgLength), s.getRowCount())
+		position := streaming.getAppliedPosition(defaultDMLChannel)
+		assert.NotNil(t, position)
+		assert.Equal(t, Timestamp(100), position.GetTimestamp())
+		assert.Equal(t, []byte{1}, position.GetMsgID())
+
+		// the pack replayed after seek is skipped
+		iMsg = insertMsg{
+			insertMessages: []*msgstream.InsertMsg{msgInsertMsg},
+			timeRange:      TimeRange{timestampMin: 0, timestampMax: 100},
+		}
+		insertNode.Operate([]flowgraph.Msg{&iMsg})
+		assert.Equal(t, int64(defaultMsgLength), s.getRowCount())
+
+		newInsertMsg, err := genSimpleInsertMsg(collection.schema, defaultMsgLength)
+		assert.NoError(t, err)
+		newInsertMsg.EndTimestamp = 150
+		iMsg = insertMsg{
+			insertMessages: []*msgstream.InsertMsg{msgInsertMsg, newInsertMsg},
+			timeRange:      TimeRange{timestampMin: 100, timestampMax: 200},
+		}
+		insertNode.Operate([]flowgraph.Msg{&iMsg})
+		assert.Equal(t, int64(2*defaultMsgLength), s.getRowCount())
+		assert.Equal(t, Timestamp(200), streaming.getAppliedPosition(defaultDMLChannel).GetTimestamp())
+	})
 }
 
 func TestFilterSegmentsByPKs(t *testing.T) {
@@ -440,3 +486,19 @@ func TestFilterSegmentsByPKs(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
+
+func TestFilterFieldsDataBySchema(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: simpleInt64Field.id},
+			{FieldID: simpleFloatVecField.id},
+		},
+	}
+	fieldsData := []*schemapb.FieldData{
+		{FieldId: simpleInt64Field.id},
+		{FieldId: simpleInt32Field.id},
+		{FieldId: simpleFloatVecField.id},
+	}
+	filtered := filterFieldsDataBySchema(fieldsData, schema)
+	assert.Equal(t, []*schemapb.FieldData{fieldsData[0], fieldsData[2]}, filtered)
+}
@@ -39,6 +39,7 @@ type queryNodeFlowGraph struct {
 	channel      Channel
 	flowGraph    *flowgraph.TimeTickedFlowGraph
 	dmlStream    msgstream.MsgStream
+	inputNode    *flowgraph.InputNode
 	consumerCnt  int
 }
 
@@ -195,6 +196,7 @@ func (q *queryNodeFlowGraph) newDmInputNode(ctx context.Context, factory msgstre
 	maxParallelism := Params.QueryNodeCfg.FlowGraphMaxParallelism
 	name := fmt.Sprintf("dmInputNode-query-%d-%s", collectionID, channel)
 	node := flowgraph.NewInputNode(insertStream, name, maxQueueLength, maxParallelism)
+	q.inputNode = node
 	return node, nil
 }
 
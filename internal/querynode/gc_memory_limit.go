@@ -0,0 +1,31 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.19
+// +build go1.19
+
+package querynode
+
+import "runtime/debug"
+
+// memoryLimitSupported is true if the runtime provides GOMEMLIMIT, which bounds the heap while serving, so that GOGC
+// needn't be lowered for it
+const memoryLimitSupported = true
+
+// setMemoryLimit sets GOMEMLIMIT and returns the previous one
+func setMemoryLimit(limit int64) int64 {
+	return debug.SetMemoryLimit(limit)
+}
@@ -0,0 +1,196 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"math"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+)
+
+// gcTuneInterval is the interval to adjust GOGC
+const gcTuneInterval = time.Second
+
+type gcMode int
+
+const (
+	gcModeServing gcMode = iota
+	gcModeLoading
+)
+
+func (m gcMode) String() string {
+	if m == gcModeLoading {
+		return metrics.GCModeLoadingLabel
+	}
+	return metrics.GCModeServingLabel
+}
+
+// gcTuner adjusts GOGC and GOMEMLIMIT according to the load state of QueryNode. GC is relaxed during segment loading
+// to prioritize the throughput, and tightened during serving so that the Go heap is bounded by
+// memoryThreshold of the total memory. GOMEMLIMIT is only managed if built by go 1.19 or later, see
+// memoryLimitSupported, otherwise the heap is bounded by lowering GOGC instead.
+type gcTuner struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	isLoading func() bool
+
+	mode                gcMode
+	gcPercent           int
+	originalGC          int
+	memoryLimit         int64
+	originalMemoryLimit int64
+}
+
+func newGCTuner(ctx context.Context, isLoading func() bool) *gcTuner {
+	ctx1, cancel := context.WithCancel(ctx)
+	return &gcTuner{
+		ctx:       ctx1,
+		cancel:    cancel,
+		isLoading: isLoading,
+		mode:      gcModeServing,
+	}
+}
+
+func (t *gcTuner) start() {
+	t.gcPercent = int(Params.QueryNodeCfg.GCTunerServingGOGC.Load())
+	t.originalGC = debug.SetGCPercent(t.gcPercent)
+	t.memoryLimit = computeMemoryLimit(gcModeServing, getTotalMemory())
+	t.originalMemoryLimit = setMemoryLimit(t.memoryLimit)
+	t.setMetrics()
+
+	t.wg.Add(1)
+	go t.tuneLoop()
+	log.Info("gc tuner started", zap.Int("originalGOGC", t.originalGC), zap.Int("GOGC", t.gcPercent),
+		zap.Bool("memoryLimitSupported", memoryLimitSupported), zap.Int64("GOMEMLIMIT", t.memoryLimit))
+}
+
+// close stops tuning and restores the original GOGC and GOMEMLIMIT
+func (t *gcTuner) close() {
+	t.cancel()
+	t.wg.Wait()
+	debug.SetGCPercent(t.originalGC)
+	setMemoryLimit(t.originalMemoryLimit)
+}
+
+func (t *gcTuner) tuneLoop() {
+	defer t.wg.Done()
+	ticker := time.NewTicker(gcTuneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.ctx.Done():
+			log.Info("gc tuner loop exit")
+			return
+		case <-ticker.C:
+			var stats runtime.MemStats
+			runtime.ReadMemStats(&stats)
+			t.tune(stats.HeapAlloc, getTotalMemory())
+		}
+	}
+}
+
+func (t *gcTuner) tune(heapAlloc uint64, totalMem uint64) {
+	mode := gcModeServing
+	if t.isLoading() {
+		mode = gcModeLoading
+	}
+	gcPercent := computeGCPercent(mode, heapAlloc, totalMem)
+	memoryLimit := computeMemoryLimit(mode, totalMem)
+	if mode == t.mode && gcPercent == t.gcPercent && memoryLimit == t.memoryLimit {
+		return
+	}
+	if mode != t.mode {
+		log.Info("gc tuner mode changed", zap.Stringer("from", t.mode), zap.Stringer("to", mode),
+			zap.Int("GOGC", gcPercent), zap.Int64("GOMEMLIMIT", memoryLimit))
+	}
+	t.mode = mode
+	if gcPercent != t.gcPercent {
+		t.gcPercent = gcPercent
+		debug.SetGCPercent(gcPercent)
+	}
+	if memoryLimit != t.memoryLimit {
+		t.memoryLimit = memoryLimit
+		setMemoryLimit(memoryLimit)
+	}
+	t.setMetrics()
+}
+
+func (t *gcTuner) setMetrics() {
+	nodeID := strconv.FormatInt(Params.QueryNodeCfg.GetNodeID(), 10)
+	for _, mode := range []gcMode{gcModeServing, gcModeLoading} {
+		value := 0.0
+		if mode == t.mode {
+			value = 1
+		}
+		metrics.QueryNodeGCMode.WithLabelValues(nodeID, mode.String()).Set(value)
+	}
+	metrics.QueryNodeGOGC.WithLabelValues(nodeID).Set(float64(t.gcPercent))
+	memoryLimit := 0.0
+	if t.memoryLimit != math.MaxInt64 {
+		memoryLimit = float64(t.memoryLimit)
+	}
+	metrics.QueryNodeGOMEMLIMIT.WithLabelValues(nodeID).Set(memoryLimit)
+}
+
+// computeGCPercent returns the GOGC of mode. While serving without GOMEMLIMIT, GOGC is lowered so that the next GC
+// is triggered before the heap exceeds the memory threshold, but not lower than the minimum GOGC.
+func computeGCPercent(mode gcMode, heapAlloc uint64, totalMem uint64) int {
+	if mode == gcModeLoading {
+		return int(Params.QueryNodeCfg.GCTunerLoadingGOGC.Load())
+	}
+	servingGC := int(Params.QueryNodeCfg.GCTunerServingGOGC.Load())
+	minimumGC := int(Params.QueryNodeCfg.GCTunerMinimumGOGC.Load())
+	if memoryLimitSupported || heapAlloc == 0 || totalMem == 0 {
+		return servingGC
+	}
+	limit := uint64(float64(totalMem) * Params.QueryNodeCfg.GCTunerMemoryThreshold.Load())
+	if heapAlloc >= limit {
+		return minimumGC
+	}
+	gcPercent := int((limit - heapAlloc) * 100 / heapAlloc)
+	if gcPercent > servingGC {
+		return servingGC
+	}
+	if gcPercent < minimumGC {
+		return minimumGC
+	}
+	return gcPercent
+}
+
+// computeMemoryLimit returns the GOMEMLIMIT of mode, math.MaxInt64 for no limit. The heap is bounded by the memory
+// threshold while serving, and by the total memory while loading, so that loading relaxes GC without running out
+// of memory.
+func computeMemoryLimit(mode gcMode, totalMem uint64) int64 {
+	if !memoryLimitSupported || totalMem == 0 {
+		return math.MaxInt64
+	}
+	if mode == gcModeLoading {
+		return int64(totalMem)
+	}
+	return int64(float64(totalMem) * Params.QueryNodeCfg.GCTunerMemoryThreshold.Load())
+}
@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"math"
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeGCPercent(t *testing.T) {
+	const gb = uint64(1 << 30)
+	// memory threshold is 7 GB of 10 GB
+	totalMem := 10 * gb
+
+	assert.Equal(t, int(Params.QueryNodeCfg.GCTunerLoadingGOGC.Load()), computeGCPercent(gcModeLoading, 8*gb, totalMem))
+	assert.Equal(t, int(Params.QueryNodeCfg.GCTunerServingGOGC.Load()), computeGCPercent(gcModeServing, gb, totalMem))
+	assert.Equal(t, int(Params.QueryNodeCfg.GCTunerServingGOGC.Load()), computeGCPercent(gcModeServing, 0, totalMem))
+	if memoryLimitSupported {
+		// the heap is bounded by GOMEMLIMIT instead
+		assert.Equal(t, int(Params.QueryNodeCfg.GCTunerServingGOGC.Load()), computeGCPercent(gcModeServing, 8*gb, totalMem))
+		return
+	}
+	assert.Equal(t, 40, computeGCPercent(gcModeServing, 5*gb, totalMem))
+	assert.Equal(t, int(Params.QueryNodeCfg.GCTunerMinimumGOGC.Load()), computeGCPercent(gcModeServing, 6*gb, totalMem))
+	assert.Equal(t, int(Params.QueryNodeCfg.GCTunerMinimumGOGC.Load()), computeGCPercent(gcModeServing, 8*gb, totalMem))
+}
+
+func TestComputeMemoryLimit(t *testing.T) {
+	const gb = uint64(1 << 30)
+	totalMem := 10 * gb
+
+	if !memoryLimitSupported {
+		assert.Equal(t, int64(math.MaxInt64), computeMemoryLimit(gcModeServing, totalMem))
+		assert.Equal(t, int64(math.MaxInt64), computeMemoryLimit(gcModeLoading, totalMem))
+		return
+	}
+	// memory threshold is 7 GB of 10 GB
+	assert.Equal(t, int64(7*gb), computeMemoryLimit(gcModeServing, totalMem))
+	assert.Equal(t, int64(totalMem), computeMemoryLimit(gcModeLoading, totalMem))
+	assert.Equal(t, int64(math.MaxInt64), computeMemoryLimit(gcModeServing, 0))
+}
+
+func TestGCTuner(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	loading := false
+	tuner := newGCTuner(ctx, func() bool { return loading })
+	tuner.start()
+	assert.Equal(t, gcModeServing, tuner.mode)
+
+	loading = true
+	tuner.tune(1<<20, 1<<30)
+	assert.Equal(t, gcModeLoading, tuner.mode)
+	assert.Equal(t, int(Params.QueryNodeCfg.GCTunerLoadingGOGC.Load()), tuner.gcPercent)
+	assert.Equal(t, computeMemoryLimit(gcModeLoading, 1<<30), tuner.memoryLimit)
+
+	loading = false
+	tuner.tune(1<<20, 1<<30)
+	assert.Equal(t, gcModeServing, tuner.mode)
+	assert.Equal(t, int(Params.QueryNodeCfg.GCTunerServingGOGC.Load()), tuner.gcPercent)
+	assert.Equal(t, computeMemoryLimit(gcModeServing, 1<<30), tuner.memoryLimit)
+
+	originalGC, originalMemoryLimit := tuner.originalGC, tuner.originalMemoryLimit
+	tuner.close()
+	assert.Equal(t, originalGC, debug.SetGCPercent(originalGC))
+	assert.Equal(t, originalMemoryLimit, setMemoryLimit(originalMemoryLimit))
+}
@@ -0,0 +1,233 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+// The indexes of the GPU index types, see indexparamcheck.IsGPUIndexType, are placed on GPU memory if querynode is
+// built with the gpu tag and queryNode.gpu.enabled is set, and knowhere dispatches the searches on them to the GPU
+// kernels. The GPU memory taken by the indexes is limited by queryNode.gpu.memoryLimit, beyond which the indexes of
+// the least recently searched segments are moved back to CPU memory in the background, while the index being loaded,
+// which doesn't fit until they are moved, is loaded to CPU memory. An index which fails to be placed on GPU is loaded
+// to CPU memory as well.
+
+// gpuEnabled returns whether the indexes are placed on GPU memory
+func gpuEnabled() bool {
+	return gpuBuild && Params.QueryNodeCfg.GPUEnabled
+}
+
+// withIndexMode returns a copy of the index info which loads the index on the device of mode
+func withIndexMode(indexInfo *querypb.FieldIndexInfo, mode string) *querypb.FieldIndexInfo {
+	ret := proto.Clone(indexInfo).(*querypb.FieldIndexInfo)
+	params := make([]*commonpb.KeyValuePair, 0, len(ret.IndexParams)+1)
+	for _, kv := range ret.IndexParams {
+		if kv.GetKey() != indexparamcheck.IndexMode {
+			params = append(params, kv)
+		}
+	}
+	ret.IndexParams = append(params, &commonpb.KeyValuePair{Key: indexparamcheck.IndexMode, Value: mode})
+	return ret
+}
+
+// gpuIndex is the index of the vector field of the segment placed on GPU memory
+type gpuIndex struct {
+	segment  *Segment
+	fieldID  FieldID
+	size     int64
+	evicting bool // being moved back to CPU memory, the GPU memory is still taken until it's moved
+}
+
+// gpuIndexManager accounts the GPU memory taken by the indexes placed on GPU and picks the ones to evict
+type gpuIndexManager struct {
+	mu      sync.Mutex
+	used    int64
+	indexes []*gpuIndex
+}
+
+func newGPUIndexManager() *gpuIndexManager {
+	return &gpuIndexManager{}
+}
+
+// reserve reserves the GPU memory of size for the index of the field of the segment, returns false if it doesn't fit.
+// If it doesn't fit, the indexes of the least recently searched segments to move back to CPU memory to make room are
+// marked evicting and returned, they keep taking the GPU memory until moved, see evicted.
+func (m *gpuIndexManager) reserve(segment *Segment, fieldID FieldID, size int64) ([]*gpuIndex, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pruneLocked()
+
+	limit := Params.QueryNodeCfg.GPUMemoryLimit
+	if size > limit {
+		return nil, false
+	}
+	if m.used+size <= limit {
+		m.indexes = append(m.indexes, &gpuIndex{segment: segment, fieldID: fieldID, size: size})
+		m.used += size
+		return nil, true
+	}
+
+	// the GPU memory to be freed by the evictions in progress
+	evicting := int64(0)
+	for _, index := range m.indexes {
+		if index.evicting {
+			evicting += index.size
+		}
+	}
+	sort.SliceStable(m.indexes, func(i, j int) bool {
+		return m.indexes[i].segment.getLastAccessTime().Before(m.indexes[j].segment.getLastAccessTime())
+	})
+	var evicted []*gpuIndex
+	for _, index := range m.indexes {
+		if m.used-evicting+size <= limit {
+			break
+		}
+		if index.evicting {
+			continue
+		}
+		index.evicting = true
+		evicting += index.size
+		evicted = append(evicted, index)
+	}
+	return evicted, false
+}
+
+// evicted releases the GPU memory of the index marked evicting once it's moved back to CPU memory, the index stays
+// on GPU memory and counted if it failed to be moved.
+func (m *gpuIndexManager) evicted(index *gpuIndex, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err != nil {
+		index.evicting = false
+		return
+	}
+	for i, other := range m.indexes {
+		if other == index {
+			m.used -= index.size
+			m.indexes = append(m.indexes[:i], m.indexes[i+1:]...)
+			return
+		}
+	}
+}
+
+// release releases the GPU memory reserved for the index of the field of the segment
+func (m *gpuIndexManager) release(segment *Segment, fieldID FieldID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, index := range m.indexes {
+		if index.segment == segment && index.fieldID == fieldID {
+			m.used -= index.size
+			m.indexes = append(m.indexes[:i], m.indexes[i+1:]...)
+			return
+		}
+	}
+}
+
+// transfer moves the indexes of from to the segment to, which takes over the C segment of from on swap in
+func (m *gpuIndexManager) transfer(from *Segment, to *Segment) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, index := range m.indexes {
+		if index.segment == from {
+			index.segment = to
+		}
+	}
+}
+
+// pruneLocked releases the GPU memory of the indexes of the segments freed, which are released or swapped out
+func (m *gpuIndexManager) pruneLocked() {
+	indexes := m.indexes[:0]
+	for _, index := range m.indexes {
+		if index.segment.isFreed() {
+			m.used -= index.size
+			continue
+		}
+		indexes = append(indexes, index)
+	}
+	m.indexes = indexes
+}
+
+// usage returns the GPU memory taken by the indexes placed on GPU
+func (m *gpuIndexManager) usage() int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pruneLocked()
+	return m.used
+}
+
+// placeIndexOnGPU returns the index info which loads the index of size on GPU memory if it fits. It returns the index
+// info unchanged if the index is loaded on CPU, the indexes evicted to make room for the next loads are moved back to
+// CPU memory in the background, so that the load doesn't wait for the indexes of other segments to be reloaded.
+func (loader *segmentLoader) placeIndexOnGPU(segment *Segment, indexInfo *querypb.FieldIndexInfo, size int64) (*querypb.FieldIndexInfo, bool) {
+	evicted, ok := loader.gpuIndexes.reserve(segment, indexInfo.FieldID, size)
+	if ok {
+		return withIndexMode(indexInfo, indexparamcheck.GPUMode), true
+	}
+	log.Info("index doesn't fit in GPU memory, load it on CPU", zap.Int64("segmentID", segment.ID()),
+		zap.Int64("fieldID", indexInfo.FieldID), zap.Int64("size", size), zap.Int64("limit", Params.QueryNodeCfg.GPUMemoryLimit),
+		zap.Int("evicted", len(evicted)))
+	if len(evicted) > 0 {
+		go loader.evictGPUIndexes(evicted)
+	}
+	return indexInfo, false
+}
+
+// evictGPUIndexes moves the indexes marked evicting back to CPU memory
+func (loader *segmentLoader) evictGPUIndexes(evicted []*gpuIndex) {
+	for _, index := range evicted {
+		err := loader.moveIndexToCPU(context.Background(), index.segment, index.fieldID)
+		if err != nil {
+			log.Warn("failed to move index back to CPU memory", zap.Int64("segmentID", index.segment.ID()),
+				zap.Int64("fieldID", index.fieldID), zap.Error(err))
+		}
+		loader.gpuIndexes.evicted(index, err)
+	}
+}
+
+// moveIndexToCPU reloads the index of the field of the segment placed on GPU memory to CPU memory
+func (loader *segmentLoader) moveIndexToCPU(ctx context.Context, segment *Segment, fieldID FieldID) error {
+	fieldInfo, err := segment.getIndexedFieldInfo(fieldID)
+	if err != nil {
+		return err
+	}
+	indexBuffer, indexPaths, err := loader.readFieldIndexData(ctx, segment, fieldInfo.indexInfo, loader.cm)
+	if err != nil {
+		return err
+	}
+	fieldType, err := loader.getFieldType(segment, fieldID)
+	if err != nil {
+		return err
+	}
+	indexInfo := withIndexMode(fieldInfo.indexInfo, indexparamcheck.CPUMode)
+	indexInfo.IndexFilePaths = indexPaths
+	if err := segment.replaceSegmentIndex(indexBuffer, indexInfo, fieldType); err != nil {
+		return err
+	}
+	log.Info("move index back to CPU memory", zap.Int64("segmentID", segment.ID()), zap.Int64("fieldID", fieldID))
+	return nil
+}
@@ -0,0 +1,119 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+func TestGPUIndexManager(t *testing.T) {
+	limit := Params.QueryNodeCfg.GPUMemoryLimit
+	Params.QueryNodeCfg.GPUMemoryLimit = 100
+	defer func() {
+		Params.QueryNodeCfg.GPUMemoryLimit = limit
+	}()
+
+	schema := genTestCollectionSchema(schemapb.DataType_Int64)
+	genSegment := func(segmentID UniqueID) *Segment {
+		segment, err := genSealedSegment(schema, defaultCollectionID, defaultPartitionID, segmentID, defaultDMLChannel, defaultMsgLength)
+		assert.NoError(t, err)
+		return segment
+	}
+	seg1, seg2, seg3 := genSegment(1), genSegment(2), genSegment(3)
+	defer deleteSegment(seg1)
+	defer deleteSegment(seg2)
+	defer deleteSegment(seg3)
+
+	m := newGPUIndexManager()
+	evicted, ok := m.reserve(seg1, 101, 40)
+	assert.True(t, ok)
+	assert.Empty(t, evicted)
+	evicted, ok = m.reserve(seg2, 101, 40)
+	assert.True(t, ok)
+	assert.Empty(t, evicted)
+	assert.Equal(t, int64(80), m.usage())
+
+	// the index of the least recently searched segment is evicted, while the index which doesn't fit yet is not placed
+	seg1.touch()
+	time.Sleep(time.Millisecond)
+	seg2.touch()
+	time.Sleep(time.Millisecond)
+	seg1.touch()
+	evicted, ok = m.reserve(seg3, 101, 40)
+	assert.False(t, ok)
+	assert.Equal(t, 1, len(evicted))
+	assert.Equal(t, seg2, evicted[0].segment)
+	assert.Equal(t, int64(80), m.usage())
+
+	// the index being evicted is not evicted again
+	evicted2, ok := m.reserve(seg3, 101, 40)
+	assert.False(t, ok)
+	assert.Empty(t, evicted2)
+
+	// the index failed to be moved back to CPU memory stays counted
+	m.evicted(evicted[0], errors.New("mock error"))
+	assert.Equal(t, int64(80), m.usage())
+	evicted, ok = m.reserve(seg3, 101, 40)
+	assert.False(t, ok)
+	assert.Equal(t, 1, len(evicted))
+
+	m.evicted(evicted[0], nil)
+	assert.Equal(t, int64(40), m.usage())
+	evicted, ok = m.reserve(seg3, 101, 40)
+	assert.True(t, ok)
+	assert.Empty(t, evicted)
+	assert.Equal(t, int64(80), m.usage())
+
+	// the index larger than the limit is not placed
+	evicted, ok = m.reserve(seg2, 101, 101)
+	assert.False(t, ok)
+	assert.Empty(t, evicted)
+
+	m.release(seg3, 101)
+	assert.Equal(t, int64(40), m.usage())
+
+	// the indexes of the freed segments are pruned
+	seg4 := genSegment(4)
+	m.transfer(seg1, seg4)
+	assert.Equal(t, int64(40), m.usage())
+	deleteSegment(seg4)
+	assert.Equal(t, int64(0), m.usage())
+}
+
+func TestWithIndexMode(t *testing.T) {
+	indexInfo := &querypb.FieldIndexInfo{
+		IndexParams: []*commonpb.KeyValuePair{
+			{Key: "index_type", Value: indexparamcheck.IndexFaissIvfFlat},
+			{Key: indexparamcheck.IndexMode, Value: indexparamcheck.CPUMode},
+		},
+	}
+	gpuIndexInfo := withIndexMode(indexInfo, indexparamcheck.GPUMode)
+	assert.Equal(t, map[string]string{"index_type": indexparamcheck.IndexFaissIvfFlat, indexparamcheck.IndexMode: indexparamcheck.GPUMode},
+		funcutil.KeyValuePair2Map(gpuIndexInfo.IndexParams))
+	assert.Equal(t, indexparamcheck.CPUMode, funcutil.KeyValuePair2Map(indexInfo.IndexParams)[indexparamcheck.IndexMode])
+	assert.False(t, gpuEnabled() && !gpuBuild)
+}
@@ -0,0 +1,211 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// subsystems of querynode reported in the subcomponent states
+const (
+	subsystemMsgStream     = "msgstream"
+	subsystemObjectStorage = "object_storage"
+	subsystemTSafe         = "tsafe"
+	subsystemTaskQueue     = "task_queue"
+)
+
+// healthCheckReasonKey is the key of the reason in the extra info of an abnormal subsystem
+const healthCheckReasonKey = "reason"
+
+type tSafeProgress struct {
+	tSafe      Timestamp
+	advancedAt time.Time
+}
+
+// healthChecker checks the subsystems of QueryNode periodically, so that the orchestration can tell
+// which part of an unhealthy node is broken from its component states.
+type healthChecker struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	lastMsgTimes func() map[Channel]time.Time
+	tSafeReplica TSafeReplicaInterface
+	storage      storage.ChunkManager
+	queue        taskQueue
+
+	// tSafes is the last advancement of the tSafe of each channel, only accessed by the check loop
+	tSafes map[Channel]tSafeProgress
+
+	mu     sync.RWMutex // guards states
+	states []*internalpb.ComponentInfo
+}
+
+func newHealthChecker(ctx context.Context, lastMsgTimes func() map[Channel]time.Time, tSafeReplica TSafeReplicaInterface,
+	storage storage.ChunkManager, queue taskQueue) *healthChecker {
+	ctx1, cancel := context.WithCancel(ctx)
+	return &healthChecker{
+		ctx:          ctx1,
+		cancel:       cancel,
+		lastMsgTimes: lastMsgTimes,
+		tSafeReplica: tSafeReplica,
+		storage:      storage,
+		queue:        queue,
+		tSafes:       make(map[Channel]tSafeProgress),
+	}
+}
+
+func (c *healthChecker) start() {
+	c.check()
+
+	c.wg.Add(1)
+	go c.checkLoop()
+	log.Info("health checker started", zap.Duration("interval", Params.QueryNodeCfg.HealthCheckInterval))
+}
+
+func (c *healthChecker) close() {
+	c.cancel()
+	c.wg.Wait()
+}
+
+func (c *healthChecker) checkLoop() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(Params.QueryNodeCfg.HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			log.Info("health checker loop exit")
+			return
+		case <-ticker.C:
+			c.check()
+		}
+	}
+}
+
+// getStates returns the states of the subsystems in the last check
+func (c *healthChecker) getStates() []*internalpb.ComponentInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.states
+}
+
+func (c *healthChecker) check() {
+	now := time.Now()
+	lastMsgTimes := c.lastMsgTimes()
+	states := []*internalpb.ComponentInfo{
+		c.checkMsgStream(now, lastMsgTimes),
+		c.checkObjectStorage(),
+		c.checkTSafe(now, lastMsgTimes),
+		c.checkTaskQueue(),
+	}
+	for _, state := range states {
+		if state.GetStateCode() != internalpb.StateCode_Healthy {
+			log.Warn("querynode subsystem is unhealthy", zap.String("subsystem", state.GetRole()), zap.Any("extraInfo", state.GetExtraInfo()))
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.states = states
+}
+
+// checkMsgStream reports the channels from which no message is consumed within the stale timeout,
+// the time ticks are consumed continuously if the msgstream is connected
+func (c *healthChecker) checkMsgStream(now time.Time, lastMsgTimes map[Channel]time.Time) *internalpb.ComponentInfo {
+	var reasons []string
+	for channel, lastMsgTime := range lastMsgTimes {
+		if elapsed := now.Sub(lastMsgTime); elapsed > Params.QueryNodeCfg.HealthCheckStaleTimeout.Load() {
+			reasons = append(reasons, fmt.Sprintf("no message consumed from channel %s for %s", channel, elapsed))
+		}
+	}
+	return newSubsystemState(subsystemMsgStream, reasons,
+		&commonpb.KeyValuePair{Key: "channels", Value: fmt.Sprint(len(lastMsgTimes))})
+}
+
+// checkObjectStorage lists a non-existent prefix to check whether the object storage is reachable
+func (c *healthChecker) checkObjectStorage() *internalpb.ComponentInfo {
+	var reasons []string
+	if _, err := c.storage.ListWithPrefix(path.Join("health_check", fmt.Sprint(Params.QueryNodeCfg.GetNodeID()))); err != nil {
+		reasons = append(reasons, fmt.Sprintf("object storage unreachable: %s", err.Error()))
+	}
+	return newSubsystemState(subsystemObjectStorage, reasons)
+}
+
+// checkTSafe reports the channels whose tSafe doesn't advance within the stale timeout
+func (c *healthChecker) checkTSafe(now time.Time, channels map[Channel]time.Time) *internalpb.ComponentInfo {
+	var reasons []string
+	tSafes := make(map[Channel]tSafeProgress, len(channels))
+	for channel := range channels {
+		ts, err := c.tSafeReplica.getTSafe(channel)
+		if err != nil {
+			continue
+		}
+		progress, ok := c.tSafes[channel]
+		if !ok || progress.tSafe != ts {
+			progress = tSafeProgress{tSafe: ts, advancedAt: now}
+		}
+		tSafes[channel] = progress
+		if elapsed := now.Sub(progress.advancedAt); elapsed > Params.QueryNodeCfg.HealthCheckStaleTimeout.Load() {
+			reasons = append(reasons, fmt.Sprintf("tSafe of channel %s hasn't advanced for %s", channel, elapsed))
+		}
+	}
+	// the released channels are forgotten
+	c.tSafes = tSafes
+	return newSubsystemState(subsystemTSafe, reasons)
+}
+
+// checkTaskQueue reports whether the pending tasks saturate the task queue
+func (c *healthChecker) checkTaskQueue() *internalpb.ComponentInfo {
+	var reasons []string
+	pending, capacity := c.queue.utLen(), c.queue.utCapacity()
+	if capacity > 0 && float64(pending) >= float64(capacity)*Params.QueryNodeCfg.HealthCheckTaskQueueThreshold.Load() {
+		reasons = append(reasons, fmt.Sprintf("task queue saturated, %d of %d pending", pending, capacity))
+	}
+	return newSubsystemState(subsystemTaskQueue, reasons,
+		&commonpb.KeyValuePair{Key: "pending", Value: fmt.Sprint(pending)},
+		&commonpb.KeyValuePair{Key: "capacity", Value: fmt.Sprint(capacity)})
+}
+
+// newSubsystemState returns the state of the subsystem, which is abnormal if there are any reasons
+func newSubsystemState(subsystem string, reasons []string, extraInfo ...*commonpb.KeyValuePair) *internalpb.ComponentInfo {
+	state := &internalpb.ComponentInfo{
+		NodeID:    Params.QueryNodeCfg.GetNodeID(),
+		Role:      subsystem,
+		StateCode: internalpb.StateCode_Healthy,
+		ExtraInfo: extraInfo,
+	}
+	if len(reasons) > 0 {
+		sort.Strings(reasons)
+		state.StateCode = internalpb.StateCode_Abnormal
+		state.ExtraInfo = append(state.ExtraInfo, &commonpb.KeyValuePair{Key: healthCheckReasonKey, Value: strings.Join(reasons, "; ")})
+	}
+	return state
+}
@@ -0,0 +1,132 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+type mockListChunkManager struct {
+	storage.ChunkManager
+	err error
+}
+
+func (m *mockListChunkManager) ListWithPrefix(prefix string) ([]string, error) {
+	return nil, m.err
+}
+
+type mockSizedTaskQueue struct {
+	taskQueue
+	pending  int
+	capacity int
+}
+
+func (q *mockSizedTaskQueue) utLen() int {
+	return q.pending
+}
+
+func (q *mockSizedTaskQueue) utCapacity() int {
+	return q.capacity
+}
+
+func getSubsystemState(t *testing.T, checker *healthChecker, subsystem string) *internalpb.ComponentInfo {
+	for _, state := range checker.getStates() {
+		if state.GetRole() == subsystem {
+			return state
+		}
+	}
+	require.FailNow(t, "subsystem not found", subsystem)
+	return nil
+}
+
+func TestHealthChecker(t *testing.T) {
+	staleTimeout := Params.QueryNodeCfg.HealthCheckStaleTimeout.Load()
+	now := time.Now()
+	lastMsgTimes := map[Channel]time.Time{
+		"channel-1": now,
+		"channel-2": now.Add(-2 * staleTimeout),
+	}
+	tSafeReplica := newTSafeReplica()
+	tSafeReplica.addTSafe("channel-1")
+	tSafeReplica.addTSafe("channel-2")
+	cm := &mockListChunkManager{}
+	queue := &mockSizedTaskQueue{capacity: 10}
+
+	checker := newHealthChecker(context.Background(), func() map[Channel]time.Time { return lastMsgTimes },
+		tSafeReplica, cm, queue)
+	assert.Empty(t, checker.getStates())
+
+	checker.check()
+	assert.Equal(t, 4, len(checker.getStates()))
+	state := getSubsystemState(t, checker, subsystemMsgStream)
+	assert.Equal(t, internalpb.StateCode_Abnormal, state.GetStateCode())
+	assert.Contains(t, state.GetExtraInfo()[len(state.GetExtraInfo())-1].GetValue(), "channel-2")
+	assert.NotContains(t, state.GetExtraInfo()[len(state.GetExtraInfo())-1].GetValue(), "channel-1")
+	for _, subsystem := range []string{subsystemObjectStorage, subsystemTSafe, subsystemTaskQueue} {
+		assert.Equal(t, internalpb.StateCode_Healthy, getSubsystemState(t, checker, subsystem).GetStateCode())
+	}
+
+	t.Run("tSafe not advanced", func(t *testing.T) {
+		progress := checker.tSafes["channel-1"]
+		progress.advancedAt = progress.advancedAt.Add(-2 * staleTimeout)
+		checker.tSafes["channel-1"] = progress
+		checker.check()
+		state := getSubsystemState(t, checker, subsystemTSafe)
+		assert.Equal(t, internalpb.StateCode_Abnormal, state.GetStateCode())
+		assert.Contains(t, state.GetExtraInfo()[0].GetValue(), "channel-1")
+
+		err := tSafeReplica.setTSafe("channel-1", 100)
+		assert.NoError(t, err)
+		checker.check()
+		assert.Equal(t, internalpb.StateCode_Healthy, getSubsystemState(t, checker, subsystemTSafe).GetStateCode())
+	})
+
+	t.Run("object storage unreachable", func(t *testing.T) {
+		cm.err = errors.New("mock error")
+		defer func() {
+			cm.err = nil
+		}()
+		checker.check()
+		assert.Equal(t, internalpb.StateCode_Abnormal, getSubsystemState(t, checker, subsystemObjectStorage).GetStateCode())
+	})
+
+	t.Run("task queue saturated", func(t *testing.T) {
+		queue.pending = 9
+		defer func() {
+			queue.pending = 0
+		}()
+		checker.check()
+		assert.Equal(t, internalpb.StateCode_Abnormal, getSubsystemState(t, checker, subsystemTaskQueue).GetStateCode())
+	})
+
+	t.Run("start and close", func(t *testing.T) {
+		checker := newHealthChecker(context.Background(), func() map[Channel]time.Time { return nil },
+			tSafeReplica, cm, queue)
+		checker.start()
+		assert.Equal(t, 4, len(checker.getStates()))
+		checker.close()
+	})
+}
@@ -22,13 +22,16 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/logutil"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
+	"github.com/milvus-io/milvus/internal/util/trace"
 )
 
 // historical is in charge of historical data in query node
@@ -37,6 +40,8 @@ type historical struct {
 
 	replica      ReplicaInterface
 	tSafeReplica TSafeReplicaInterface
+
+	swapper *segmentSwapper // nil if cold segment swap is disabled
 }
 
 // newHistorical returns a new historical
@@ -53,12 +58,29 @@ func newHistorical(ctx context.Context,
 
 // close would release all resources in historical
 func (h *historical) close() {
+	if h.swapper != nil {
+		h.swapper.close()
+	}
 	// free collectionReplica
 	h.replica.freeAll()
 }
 
+// ensureResident re-materializes the segment if it has been swapped out, and pins it in memory until the returned
+// func is called, so that it's not swapped out by the swapper while being searched
+func (h *historical) ensureResident(ctx context.Context, seg *Segment) (func(), error) {
+	for !seg.pin() {
+		if h.swapper == nil {
+			return nil, fmt.Errorf("segment %d is swapped out without swapper", seg.ID())
+		}
+		if err := h.swapper.swapIn(ctx, seg); err != nil {
+			return nil, err
+		}
+	}
+	return seg.releaseRef, nil
+}
+
 // // retrieve will retrieve from the segments in historical
-func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.ChunkManager,
+func (h *historical) retrieve(ctx context.Context, collID UniqueID, partIDs []UniqueID, vcm storage.ChunkManager,
 	plan *RetrievePlan) (retrieveResults []*segcorepb.RetrieveResults, retrieveSegmentIDs []UniqueID, retrievePartIDs []UniqueID, err error) {
 
 	// get historical partition ids
@@ -67,7 +89,7 @@ func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.C
 		return retrieveResults, retrieveSegmentIDs, retrievePartIDs, err
 	}
 
-	log.Debug("retrieve target partitions", zap.Int64("collectionID", collID), zap.Int64s("partitionIDs", retrievePartIDs))
+	logutil.Logger(ctx).Debug("retrieve target partitions", zap.Int64("collectionID", collID), zap.Int64s("partitionIDs", retrievePartIDs))
 
 	for _, partID := range retrievePartIDs {
 		segIDs, err := h.replica.getSegmentIDs(partID)
@@ -79,7 +101,12 @@ func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.C
 			if err != nil {
 				return retrieveResults, retrieveSegmentIDs, retrievePartIDs, err
 			}
-			result, err := seg.retrieve(plan)
+			unpin, err := h.ensureResident(ctx, seg)
+			if err != nil {
+				return retrieveResults, retrieveSegmentIDs, retrievePartIDs, err
+			}
+			result, err := seg.retrieve(ctx, plan)
+			unpin()
 			if err != nil {
 				return retrieveResults, retrieveSegmentIDs, retrievePartIDs, err
 			}
@@ -96,7 +123,7 @@ func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.C
 }
 
 // retrieveBySegmentIDs retrieves records from segments specified by their IDs
-func (h *historical) retrieveBySegmentIDs(collID UniqueID, segmentIDs []UniqueID, vcm storage.ChunkManager, plan *RetrievePlan) (
+func (h *historical) retrieveBySegmentIDs(ctx context.Context, collID UniqueID, segmentIDs []UniqueID, vcm storage.ChunkManager, plan *RetrievePlan) (
 	retrieveResults []*segcorepb.RetrieveResults, err error) {
 
 	for _, segID := range segmentIDs {
@@ -104,11 +131,7 @@ func (h *historical) retrieveBySegmentIDs(collID UniqueID, segmentIDs []UniqueID
 		if err != nil {
 			return nil, err
 		}
-		result, err := seg.retrieve(plan)
-		if err != nil {
-			return nil, err
-		}
-		err = seg.fillIndexedFieldsData(collID, vcm, result)
+		result, err := h.retrieveSegment(ctx, collID, seg, vcm, plan)
 		if err != nil {
 			return nil, err
 		}
@@ -118,8 +141,34 @@ func (h *historical) retrieveBySegmentIDs(collID UniqueID, segmentIDs []UniqueID
 	return retrieveResults, nil
 }
 
+func (h *historical) retrieveSegment(ctx context.Context, collID UniqueID, seg *Segment, vcm storage.ChunkManager, plan *RetrievePlan) (*segcorepb.RetrieveResults, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "retrieveSegment",
+		opentracing.Tags{
+			"segmentID": seg.segmentID,
+		})
+	defer sp.Finish()
+
+	unpin, err := h.ensureResident(ctx, seg)
+	if err != nil {
+		trace.LogError(sp, err)
+		return nil, err
+	}
+	result, err := seg.retrieve(ctx, plan)
+	unpin()
+	if err != nil {
+		trace.LogError(sp, err)
+		return nil, err
+	}
+	err = seg.fillIndexedFieldsData(collID, vcm, result)
+	if err != nil {
+		trace.LogError(sp, err)
+		return nil, err
+	}
+	return result, nil
+}
+
 // search will search all the target segments in historical
-func (h *historical) search(searchReqs []*searchRequest, collID UniqueID, partIDs []UniqueID, plan *SearchPlan,
+func (h *historical) search(ctx context.Context, searchReqs []*searchRequest, collID UniqueID, partIDs []UniqueID, plan *SearchPlan,
 	searchTs Timestamp) (searchResults []*SearchResult, searchSegmentIDs []UniqueID, searchPartIDs []UniqueID, err error) {
 
 	searchPartIDs, err = h.getTargetPartIDs(collID, partIDs)
@@ -127,7 +176,7 @@ func (h *historical) search(searchReqs []*searchRequest, collID UniqueID, partID
 		return searchResults, searchSegmentIDs, searchPartIDs, err
 	}
 
-	log.Debug("search target partitions", zap.Int64("collectionID", collID), zap.Int64s("partitionIDs", searchPartIDs))
+	logutil.Logger(ctx).Debug("search target partitions", zap.Int64("collectionID", collID), zap.Int64s("partitionIDs", searchPartIDs))
 
 	col, err := h.replica.getCollectionByID(collID)
 	if err != nil {
@@ -154,7 +203,7 @@ func (h *historical) search(searchReqs []*searchRequest, collID UniqueID, partID
 		segmentIDs = append(segmentIDs, segIDs...)
 	}
 
-	searchResults, searchSegmentIDs, err = h.searchSegments(segmentIDs, searchReqs, plan, searchTs)
+	searchResults, searchSegmentIDs, err = h.searchSegments(ctx, segmentIDs, searchReqs, plan, searchTs)
 
 	return searchResults, searchSegmentIDs, searchPartIDs, err
 }
@@ -219,7 +268,7 @@ func (h *historical) getTargetPartIDs(collID UniqueID, partIDs []UniqueID) ([]Un
 
 // searchSegments performs search on listed segments
 // all segment ids are validated before calling this function
-func (h *historical) searchSegments(segIDs []UniqueID, searchReqs []*searchRequest, plan *SearchPlan, searchTs Timestamp) ([]*SearchResult, []UniqueID, error) {
+func (h *historical) searchSegments(ctx context.Context, segIDs []UniqueID, searchReqs []*searchRequest, plan *SearchPlan, searchTs Timestamp) ([]*SearchResult, []UniqueID, error) {
 	// pre-fetch all the segment
 	// if error found, return before executing segment search
 	segments := make([]*Segment, 0, len(segIDs))
@@ -244,12 +293,36 @@ func (h *historical) searchSegments(segIDs []UniqueID, searchReqs []*searchReque
 		go func(seg *Segment) {
 			defer wg.Done()
 			if !seg.getOnService() {
-				log.Warn("segment no on service", zap.Int64("segmentID", seg.segmentID))
+				logutil.Logger(ctx).Warn("segment no on service", zap.Int64("segmentID", seg.segmentID))
+				return
+			}
+			sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "searchSegment",
+				opentracing.Tags{
+					"segmentID": seg.segmentID,
+				})
+			defer sp.Finish()
+			unpin, err := h.ensureResident(ctx, seg)
+			if err != nil {
+				trace.LogError(sp, err)
+				lock.Lock()
+				serr = err
+				lock.Unlock()
+				return
+			}
+			if err = segmentSearchWorkers.acquire(ctx); err != nil {
+				unpin()
+				trace.LogError(sp, err)
+				lock.Lock()
+				serr = err
+				lock.Unlock()
 				return
 			}
 			// record search time
 			tr := timerecord.NewTimeRecorder("searchOnSealed")
-			searchResult, err := seg.search(plan, searchReqs, []Timestamp{searchTs})
+			searchResult, err := seg.search(ctx, plan, searchReqs, []Timestamp{searchTs})
+			segmentSearchWorkers.release()
+			unpin()
+			trace.LogError(sp, err)
 
 			// update metrics
 			metrics.QueryNodeSQSegmentLatency.WithLabelValues(fmt.Sprint(Params.QueryNodeCfg.GetNodeID()),
@@ -269,6 +342,8 @@ func (h *historical) searchSegments(segIDs []UniqueID, searchReqs []*searchReque
 	}
 	wg.Wait()
 	if serr != nil {
+		// release the segments referred by the partial results
+		deleteSearchResults(searchResults)
 		return nil, nil, serr
 	}
 	return searchResults, searchSegmentIDs, nil
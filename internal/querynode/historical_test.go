@@ -38,7 +38,7 @@ func TestHistorical_Search(t *testing.T) {
 		plan, searchReqs, err := genSearchPlanAndRequests(collection, IndexFaissIDMap)
 		assert.NoError(t, err)
 
-		_, _, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0))
+		_, _, _, err = his.search(ctx, searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0))
 		assert.NoError(t, err)
 	})
 
@@ -55,7 +55,7 @@ func TestHistorical_Search(t *testing.T) {
 		err = his.replica.removeCollection(defaultCollectionID)
 		assert.NoError(t, err)
 
-		_, _, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0))
+		_, _, _, err = his.search(ctx, searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0))
 		assert.Error(t, err)
 	})
 
@@ -72,7 +72,7 @@ func TestHistorical_Search(t *testing.T) {
 		err = his.replica.removeCollection(defaultCollectionID)
 		assert.NoError(t, err)
 
-		_, _, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0))
+		_, _, _, err = his.search(ctx, searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0))
 		assert.Error(t, err)
 	})
 
@@ -93,7 +93,7 @@ func TestHistorical_Search(t *testing.T) {
 		err = his.replica.removePartition(defaultPartitionID)
 		assert.NoError(t, err)
 
-		_, _, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0))
+		_, _, _, err = his.search(ctx, searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0))
 		assert.Error(t, err)
 	})
 
@@ -110,7 +110,7 @@ func TestHistorical_Search(t *testing.T) {
 		err = his.replica.removePartition(defaultPartitionID)
 		assert.NoError(t, err)
 
-		res, ids, _, err := his.search(searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0))
+		res, ids, _, err := his.search(ctx, searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0))
 		assert.Equal(t, 0, len(res))
 		assert.Equal(t, 0, len(ids))
 		assert.NoError(t, err)
@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	queryPb "github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/logutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -59,6 +60,9 @@ func (node *QueryNode) GetComponentStates(ctx context.Context) (*internalpb.Comp
 		StateCode: code,
 	}
 	stats.State = info
+	if node.healthChecker != nil {
+		stats.SubcomponentStates = node.healthChecker.getStates()
+	}
 	log.Debug("Get QueryNode component state done", zap.Any("stateCode", info.StateCode))
 	return stats, nil
 }
@@ -208,6 +212,13 @@ func (node *QueryNode) WatchDmChannels(ctx context.Context, in *queryPb.WatchDmC
 		}
 		return status, nil
 	}
+	if node.isDraining() {
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgQueryNodeIsDraining(Params.QueryNodeCfg.GetNodeID()),
+		}
+		return status, nil
+	}
 	dct := &watchDmChannelsTask{
 		baseTask: baseTask{
 			ctx:  ctx,
@@ -257,6 +268,13 @@ func (node *QueryNode) WatchDeltaChannels(ctx context.Context, in *queryPb.Watch
 		}
 		return status, nil
 	}
+	if node.isDraining() {
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgQueryNodeIsDraining(Params.QueryNodeCfg.GetNodeID()),
+		}
+		return status, nil
+	}
 	dct := &watchDeltaChannelsTask{
 		baseTask: baseTask{
 			ctx:  ctx,
@@ -309,6 +327,13 @@ func (node *QueryNode) LoadSegments(ctx context.Context, in *queryPb.LoadSegment
 		}
 		return status, nil
 	}
+	if node.isDraining() {
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgQueryNodeIsDraining(Params.QueryNodeCfg.GetNodeID()),
+		}
+		return status, nil
+	}
 	dct := &loadSegmentsTask{
 		baseTask: baseTask{
 			ctx:  ctx,
@@ -444,6 +469,107 @@ func (node *QueryNode) ReleasePartitions(ctx context.Context, in *queryPb.Releas
 	return status, nil
 }
 
+// ReleaseChannels releases the dml channels of the collection and their delta channels, including the flow graphs,
+// tSafes, growing segments and query shards, while the other channels and the sealed segments are kept.
+// It's used to move some shards of a collection away from the query node, instead of releasing the whole collection.
+func (node *QueryNode) ReleaseChannels(ctx context.Context, in *queryPb.ReleaseChannelsRequest) (*commonpb.Status, error) {
+	code := node.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		err := fmt.Errorf("query node %d is not ready", Params.QueryNodeCfg.GetNodeID())
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		return status, nil
+	}
+	if len(in.GetChannels()) == 0 {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("no channel to release of collection %d", in.GetCollectionID()),
+		}, nil
+	}
+	dct := &releaseChannelsTask{
+		baseTask: baseTask{
+			ctx:  ctx,
+			done: make(chan error),
+		},
+		req:  in,
+		node: node,
+	}
+
+	err := node.scheduler.queue.Enqueue(dct)
+	if err != nil {
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		log.Warn(err.Error())
+		return status, nil
+	}
+	log.Info("releaseChannelsTask Enqueue done", zap.Int64("collectionID", in.CollectionID), zap.Strings("channels", in.Channels))
+
+	err = dct.WaitToFinish()
+	if err != nil {
+		log.Warn(err.Error())
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Info("releaseChannelsTask WaitToFinish done", zap.Int64("collectionID", in.CollectionID), zap.Strings("channels", in.Channels))
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// RefreshCollection refreshes the metadata of a loaded collection in place, including the schema and the loaded partitions.
+// It's used to apply the metadata changes, e.g. new partitions of collection, without a full release and load cycle.
+func (node *QueryNode) RefreshCollection(ctx context.Context, in *queryPb.LoadPartitionsRequest) (*commonpb.Status, error) {
+	code := node.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		err := fmt.Errorf("query node %d is not ready", Params.QueryNodeCfg.GetNodeID())
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		return status, nil
+	}
+	dct := &refreshCollectionTask{
+		baseTask: baseTask{
+			ctx:  ctx,
+			done: make(chan error),
+		},
+		req:  in,
+		node: node,
+	}
+
+	err := node.scheduler.queue.Enqueue(dct)
+	if err != nil {
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		log.Warn(err.Error())
+		return status, nil
+	}
+	log.Info("refreshCollectionTask Enqueue done", zap.Int64("collectionID", in.CollectionID), zap.Int64s("partitionIDs", in.PartitionIDs))
+
+	err = dct.WaitToFinish()
+	if err != nil {
+		log.Warn(err.Error())
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Info("refreshCollectionTask WaitToFinish done", zap.Int64("collectionID", in.CollectionID))
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 // ReleaseSegments remove the specified segments from query node according segmentIDs, partitionIDs, and collectionID
 func (node *QueryNode) ReleaseSegments(ctx context.Context, in *queryPb.ReleaseSegmentsRequest) (*commonpb.Status, error) {
 	code := node.stateCode.Load().(internalpb.StateCode)
@@ -459,6 +585,13 @@ func (node *QueryNode) ReleaseSegments(ctx context.Context, in *queryPb.ReleaseS
 		ErrorCode: commonpb.ErrorCode_Success,
 	}
 	for _, id := range in.SegmentIDs {
+		if node.isStaleRelease(id, in.GetBase().GetMsgID()) {
+			continue
+		}
+		node.saveDeleteSnapshot(id)
+		cause := fmt.Sprintf("release segments request %d", in.GetBase().GetMsgID())
+		recordSegmentReleased(node.historical.replica, id, cause)
+		recordSegmentReleased(node.streaming.replica, id, cause)
 		err := node.historical.replica.removeSegment(id)
 		if err != nil {
 			// not return, try to release all segments
@@ -477,6 +610,29 @@ func (node *QueryNode) ReleaseSegments(ctx context.Context, in *queryPb.ReleaseS
 	return status, nil
 }
 
+// isStaleRelease returns true if the segment has been reloaded by a request newer than the release request,
+// e.g. a delayed release sent by the former QueryCoord. The releases without version, such as the ones of handoff
+// and compaction sent by the shard cluster, are never stale.
+func (node *QueryNode) isStaleRelease(segmentID UniqueID, version UniqueID) bool {
+	if version == 0 {
+		return false
+	}
+	for _, replica := range []ReplicaInterface{node.historical.replica, node.streaming.replica} {
+		segment, err := replica.getSegmentByID(segmentID)
+		if err != nil {
+			continue
+		}
+		if version < segment.loadVersion {
+			log.Warn("ignore stale release of segment",
+				zap.Int64("segmentID", segmentID),
+				zap.Int64("releaseVersion", version),
+				zap.Int64("loadVersion", segment.loadVersion))
+			return true
+		}
+	}
+	return false
+}
+
 // GetSegmentInfo returns segment information of the collection on the queryNode, and the information includes memSize, numRow, indexName, indexID ...
 func (node *QueryNode) GetSegmentInfo(ctx context.Context, in *queryPb.GetSegmentInfoRequest) (*queryPb.GetSegmentInfoResponse, error) {
 	code := node.stateCode.Load().(internalpb.StateCode)
@@ -557,6 +713,8 @@ func (node *QueryNode) isHealthy() bool {
 
 // Search performs replica search tasks.
 func (node *QueryNode) Search(ctx context.Context, req *queryPb.SearchRequest) (*internalpb.SearchResults, error) {
+	// the msgID of the request is the id of the proxy task, which is logged with the logs of the request across components
+	ctx = logutil.WithReqID(ctx, req.GetReq().GetBase().GetMsgID())
 	if !node.isHealthy() {
 		return &internalpb.SearchResults{
 			Status: &commonpb.Status{
@@ -566,7 +724,7 @@ func (node *QueryNode) Search(ctx context.Context, req *queryPb.SearchRequest) (
 		}, nil
 	}
 
-	log.Debug("Received SearchRequest", zap.String("vchannel", req.GetDmlChannel()), zap.Int64s("segmentIDs", req.GetSegmentIDs()))
+	logutil.Logger(ctx).Debug("Received SearchRequest", zap.String("vchannel", req.GetDmlChannel()), zap.Int64s("segmentIDs", req.GetSegmentIDs()))
 
 	if node.queryShardService == nil {
 		return &internalpb.SearchResults{
@@ -577,9 +735,20 @@ func (node *QueryNode) Search(ctx context.Context, req *queryPb.SearchRequest) (
 		}, nil
 	}
 
+	defer node.inFlightRequests.add(req.GetReq().GetCollectionID())()
+	if node.isHandedOff() {
+		return &internalpb.SearchResults{
+			Status: &commonpb.Status{
+				// NotShardLeader will make proxy refresh the shard leader cache
+				ErrorCode: commonpb.ErrorCode_NotShardLeader,
+				Reason:    msgQueryNodeIsDrained(Params.QueryNodeCfg.GetNodeID()),
+			},
+		}, nil
+	}
+
 	qs, err := node.queryShardService.getQueryShard(req.GetDmlChannel())
 	if err != nil {
-		log.Warn("Search failed, failed to get query shard", zap.String("dml channel", req.GetDmlChannel()), zap.Error(err))
+		logutil.Logger(ctx).Warn("Search failed, failed to get query shard", zap.String("dml channel", req.GetDmlChannel()), zap.Error(err))
 		return &internalpb.SearchResults{
 			Status: &commonpb.Status{
 				// NotShardLeader will make proxy refresh the shard leader cache
@@ -591,7 +760,7 @@ func (node *QueryNode) Search(ctx context.Context, req *queryPb.SearchRequest) (
 
 	results, err := qs.search(ctx, req)
 	if err != nil {
-		log.Warn("QueryService failed to search", zap.String("vchannel", req.GetDmlChannel()), zap.Int64s("segmentIDs", req.GetSegmentIDs()), zap.Error(err))
+		logutil.Logger(ctx).Warn("QueryService failed to search", zap.String("vchannel", req.GetDmlChannel()), zap.Int64s("segmentIDs", req.GetSegmentIDs()), zap.Error(err))
 		return &internalpb.SearchResults{
 			Status: &commonpb.Status{
 				ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -599,13 +768,15 @@ func (node *QueryNode) Search(ctx context.Context, req *queryPb.SearchRequest) (
 			},
 		}, nil
 	}
-	log.Debug("Search Shard Done", zap.String("vchannel", req.GetDmlChannel()), zap.Int64s("segmentIDs", req.GetSegmentIDs()))
+	logutil.Logger(ctx).Debug("Search Shard Done", zap.String("vchannel", req.GetDmlChannel()), zap.Int64s("segmentIDs", req.GetSegmentIDs()))
 
 	return results, err
 }
 
 // Query performs replica query tasks.
 func (node *QueryNode) Query(ctx context.Context, req *queryPb.QueryRequest) (*internalpb.RetrieveResults, error) {
+	// the msgID of the request is the id of the proxy task, which is logged with the logs of the request across components
+	ctx = logutil.WithReqID(ctx, req.GetReq().GetBase().GetMsgID())
 	if !node.isHealthy() {
 		return &internalpb.RetrieveResults{
 			Status: &commonpb.Status{
@@ -614,7 +785,7 @@ func (node *QueryNode) Query(ctx context.Context, req *queryPb.QueryRequest) (*i
 			},
 		}, nil
 	}
-	log.Debug("Received QueryRequest", zap.String("vchannel", req.GetDmlChannel()), zap.Int64s("segmentIDs", req.GetSegmentIDs()))
+	logutil.Logger(ctx).Debug("Received QueryRequest", zap.String("vchannel", req.GetDmlChannel()), zap.Int64s("segmentIDs", req.GetSegmentIDs()))
 
 	if node.queryShardService == nil {
 		return &internalpb.RetrieveResults{
@@ -625,9 +796,19 @@ func (node *QueryNode) Query(ctx context.Context, req *queryPb.QueryRequest) (*i
 		}, nil
 	}
 
+	defer node.inFlightRequests.add(req.GetReq().GetCollectionID())()
+	if node.isHandedOff() {
+		return &internalpb.RetrieveResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_NotShardLeader,
+				Reason:    msgQueryNodeIsDrained(Params.QueryNodeCfg.GetNodeID()),
+			},
+		}, nil
+	}
+
 	qs, err := node.queryShardService.getQueryShard(req.GetDmlChannel())
 	if err != nil {
-		log.Warn("Query failed, failed to get query shard", zap.String("dml channel", req.GetDmlChannel()), zap.Error(err))
+		logutil.Logger(ctx).Warn("Query failed, failed to get query shard", zap.String("dml channel", req.GetDmlChannel()), zap.Error(err))
 		return &internalpb.RetrieveResults{
 			Status: &commonpb.Status{
 				ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -638,7 +819,7 @@ func (node *QueryNode) Query(ctx context.Context, req *queryPb.QueryRequest) (*i
 
 	results, err := qs.query(ctx, req)
 	if err != nil {
-		log.Warn("QueryService failed to query", zap.String("vchannel", req.GetDmlChannel()), zap.Int64s("segmentIDs", req.GetSegmentIDs()), zap.Error(err))
+		logutil.Logger(ctx).Warn("QueryService failed to query", zap.String("vchannel", req.GetDmlChannel()), zap.Int64s("segmentIDs", req.GetSegmentIDs()), zap.Error(err))
 		return &internalpb.RetrieveResults{
 			Status: &commonpb.Status{
 				ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -646,7 +827,7 @@ func (node *QueryNode) Query(ctx context.Context, req *queryPb.QueryRequest) (*i
 			},
 		}, nil
 	}
-	log.Debug("Query Shard Done", zap.String("vchannel", req.GetDmlChannel()), zap.Int64s("segmentIDs", req.GetSegmentIDs()))
+	logutil.Logger(ctx).Debug("Query Shard Done", zap.String("vchannel", req.GetDmlChannel()), zap.Int64s("segmentIDs", req.GetSegmentIDs()))
 
 	return results, nil
 }
@@ -723,6 +904,22 @@ func (node *QueryNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsR
 		return metrics, nil
 	}
 
+	if metricType == metricsinfo.NodeLoadMetrics {
+		return getNodeLoadMetrics(node)
+	}
+	if metricType == metricsinfo.ShardClustersMetrics {
+		return getShardClustersMetrics(node)
+	}
+	if metricType == metricsinfo.SegmentEventsMetrics {
+		return getSegmentEventsMetrics(req)
+	}
+	if metricType == metricsinfo.ShardStatsMetrics {
+		return getShardStatsMetrics(node)
+	}
+	if metricType == metricsinfo.ConsumerLagsMetrics {
+		return getConsumerLagsMetrics(node)
+	}
+
 	log.Debug("QueryNode.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("node_id", Params.QueryNodeCfg.GetNodeID()),
 		zap.String("req", req.Request),
@@ -205,6 +205,81 @@ func TestImpl_ReleaseCollection(t *testing.T) {
 	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
 }
 
+func TestImpl_ReleaseChannels(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+
+	req := &queryPb.ReleaseChannelsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_ReleaseCollection,
+			MsgID:   rand.Int63(),
+		},
+		CollectionID: defaultCollectionID,
+		Channels:     []Channel{defaultDMLChannel},
+	}
+
+	status, err := node.ReleaseChannels(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	assert.True(t, node.streaming.replica.hasCollection(defaultCollectionID))
+
+	// channels are required
+	status, err = node.ReleaseChannels(ctx, &queryPb.ReleaseChannelsRequest{CollectionID: defaultCollectionID})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+
+	req.CollectionID = defaultCollectionID + 1
+	status, err = node.ReleaseChannels(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+
+	node.UpdateStateCode(internalpb.StateCode_Abnormal)
+	status, err = node.ReleaseChannels(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+}
+
+func TestImpl_RefreshCollection(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+
+	schema := genTestCollectionSchema(schemapb.DataType_Int64)
+	schema.Description = "refreshed"
+	req := &queryPb.LoadPartitionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_LoadPartitions,
+			MsgID:   rand.Int63(),
+		},
+		CollectionID: defaultCollectionID,
+		PartitionIDs: []UniqueID{defaultPartitionID, defaultPartitionID + 1},
+		Schema:       schema,
+	}
+
+	status, err := node.RefreshCollection(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	for _, replica := range []ReplicaInterface{node.historical.replica, node.streaming.replica} {
+		col, err := replica.getCollectionByID(defaultCollectionID)
+		assert.NoError(t, err)
+		assert.Equal(t, "refreshed", col.Schema().Description)
+		assert.True(t, replica.hasPartition(defaultPartitionID+1))
+	}
+
+	req.CollectionID = defaultCollectionID + 1
+	status, err = node.RefreshCollection(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+
+	node.UpdateStateCode(internalpb.StateCode_Abnormal)
+	status, err = node.RefreshCollection(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+}
+
 func TestImpl_ReleasePartitions(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -555,6 +630,57 @@ func TestImpl_ReleaseSegments(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	wg.Add(1)
+	t.Run("test stale release", func(t *testing.T) {
+		defer wg.Done()
+		node, err := genSimpleQueryNode(ctx)
+		assert.NoError(t, err)
+
+		segment, err := node.historical.replica.getSegmentByID(defaultSegmentID)
+		assert.NoError(t, err)
+		segment.loadVersion = 100
+
+		req := &queryPb.ReleaseSegmentsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_ReleaseSegments,
+				MsgID:   99,
+			},
+			CollectionID: defaultCollectionID,
+			PartitionIDs: []UniqueID{defaultPartitionID},
+			SegmentIDs:   []UniqueID{defaultSegmentID},
+		}
+		status, err := node.ReleaseSegments(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		assert.True(t, node.historical.replica.hasSegment(defaultSegmentID))
+
+		req.Base.MsgID = 101
+		_, err = node.ReleaseSegments(ctx, req)
+		assert.NoError(t, err)
+		assert.False(t, node.historical.replica.hasSegment(defaultSegmentID))
+	})
+
+	wg.Add(1)
+	t.Run("test unversioned release", func(t *testing.T) {
+		defer wg.Done()
+		node, err := genSimpleQueryNode(ctx)
+		assert.NoError(t, err)
+
+		segment, err := node.historical.replica.getSegmentByID(defaultSegmentID)
+		assert.NoError(t, err)
+		segment.loadVersion = 100
+
+		// the handoff and compaction releases of shard cluster carry no base
+		req := &queryPb.ReleaseSegmentsRequest{
+			CollectionID: defaultCollectionID,
+			SegmentIDs:   []UniqueID{defaultSegmentID},
+		}
+		status, err := node.ReleaseSegments(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		assert.False(t, node.historical.replica.hasSegment(defaultSegmentID))
+	})
+
 	wg.Add(1)
 	t.Run("test segment not exists", func(t *testing.T) {
 		defer wg.Done()
@@ -0,0 +1,246 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// qpsWindowSeconds is the length of the sliding window the QPS is computed over
+const qpsWindowSeconds = 10
+
+// inFlightRequests tracks the search/query requests being executed per collection,
+// so that releasing a collection could wait for them to finish instead of sleeping blindly.
+type inFlightRequests struct {
+	mu          sync.Mutex
+	collections map[UniqueID]*collectionRequests
+	// requests started in each second of the sliding window
+	window qpsWindow
+}
+
+// qpsWindow counts the requests started in each second of the sliding window, indexed by unix second modulo the window
+type qpsWindow [qpsWindowSeconds]requestBucket
+
+type requestBucket struct {
+	second int64
+	count  int64
+}
+
+func (w *qpsWindow) record(second int64) {
+	bucket := &w[second%qpsWindowSeconds]
+	if bucket.second != second {
+		bucket.second = second
+		bucket.count = 0
+	}
+	bucket.count++
+}
+
+// qpsAt returns the average number of requests started per second in the sliding window ending at now
+func (w *qpsWindow) qpsAt(now int64) float64 {
+	var count int64
+	for _, bucket := range w {
+		if now-bucket.second < qpsWindowSeconds {
+			count += bucket.count
+		}
+	}
+	return float64(count) / qpsWindowSeconds
+}
+
+type collectionRequests struct {
+	count int
+	// epoch is the one the new requests join, it's sealed and replaced by a drain
+	epoch *requestEpoch
+	// sealed are the epochs sealed by the drains whose requests are not finished yet
+	sealed []*requestEpoch
+}
+
+// requestEpoch is a group of the requests started between two drains, so that a drain only waits for the requests
+// started before it, instead of the ones keep coming during the drain.
+type requestEpoch struct {
+	count int
+	// done is closed when the epoch is sealed and count drops to zero
+	done chan struct{}
+}
+
+func newRequestEpoch() *requestEpoch {
+	return &requestEpoch{done: make(chan struct{})}
+}
+
+func newInFlightRequests() *inFlightRequests {
+	return &inFlightRequests{
+		collections: make(map[UniqueID]*collectionRequests),
+	}
+}
+
+// add records a request of the collection, the returned func must be called when the request is done
+func (r *inFlightRequests) add(collectionID UniqueID) func() {
+	if r == nil {
+		return func() {}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	requests, ok := r.collections[collectionID]
+	if !ok {
+		requests = &collectionRequests{epoch: newRequestEpoch()}
+		r.collections[collectionID] = requests
+	}
+	requests.count++
+	epoch := requests.epoch
+	epoch.count++
+	r.window.record(time.Now().Unix())
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { r.done(collectionID, epoch) })
+	}
+}
+
+func (r *inFlightRequests) done(collectionID UniqueID, epoch *requestEpoch) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	requests, ok := r.collections[collectionID]
+	if !ok {
+		return
+	}
+	requests.count--
+	epoch.count--
+	if epoch.count == 0 && epoch != requests.epoch {
+		close(epoch.done)
+		for i, sealed := range requests.sealed {
+			if sealed == epoch {
+				requests.sealed = append(requests.sealed[:i], requests.sealed[i+1:]...)
+				break
+			}
+		}
+	}
+	if requests.count == 0 {
+		delete(r.collections, collectionID)
+	}
+}
+
+// count returns the number of in-flight requests of the collection
+func (r *inFlightRequests) count(collectionID UniqueID) int {
+	if r == nil {
+		return 0
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if requests, ok := r.collections[collectionID]; ok {
+		return requests.count
+	}
+	return 0
+}
+
+// total returns the number of in-flight requests of all collections
+func (r *inFlightRequests) total() int {
+	if r == nil {
+		return 0
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	total := 0
+	for _, requests := range r.collections {
+		total += requests.count
+	}
+	return total
+}
+
+// qps returns the average number of requests started per second in the sliding window
+func (r *inFlightRequests) qps() float64 {
+	if r == nil {
+		return 0
+	}
+	return r.qpsAt(time.Now().Unix())
+}
+
+func (r *inFlightRequests) qpsAt(now int64) float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.window.qpsAt(now)
+}
+
+// drain waits for the requests of the collection in flight when it's called to finish at most maxWait,
+// the requests started during the drain are not waited. It returns false if some requests are still running after maxWait.
+func (r *inFlightRequests) drain(collectionID UniqueID, maxWait time.Duration) bool {
+	if r == nil {
+		return true
+	}
+	r.mu.Lock()
+	requests, ok := r.collections[collectionID]
+	if !ok {
+		r.mu.Unlock()
+		return true
+	}
+	if requests.epoch.count > 0 {
+		requests.sealed = append(requests.sealed, requests.epoch)
+		requests.epoch = newRequestEpoch()
+	}
+	waits := make([]chan struct{}, 0, len(requests.sealed))
+	for _, epoch := range requests.sealed {
+		waits = append(waits, epoch.done)
+	}
+	r.mu.Unlock()
+
+	timer := time.NewTimer(maxWait)
+	defer timer.Stop()
+	for _, ch := range waits {
+		select {
+		case <-ch:
+		case <-timer.C:
+			return false
+		}
+	}
+	return true
+}
+
+// drainAll waits for the in-flight requests of all collections to finish at most maxWait,
+// returns false if some requests are still running after maxWait.
+func (r *inFlightRequests) drainAll(maxWait time.Duration) bool {
+	if r == nil {
+		return true
+	}
+	r.mu.Lock()
+	collectionIDs := make([]UniqueID, 0, len(r.collections))
+	for collectionID := range r.collections {
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	r.mu.Unlock()
+
+	deadline := time.Now().Add(maxWait)
+	for _, collectionID := range collectionIDs {
+		if !r.drain(collectionID, time.Until(deadline)) {
+			return false
+		}
+	}
+	return true
+}
+
+// drainInFlightRequests waits for the in-flight search/query requests of the collection before releasing it
+func (node *QueryNode) drainInFlightRequests(collectionID UniqueID) {
+	maxWait := Params.QueryNodeCfg.GracefulReleaseMaxWait
+	if !node.inFlightRequests.drain(collectionID, maxWait) {
+		log.Warn("in-flight requests are not finished before release",
+			zap.Int64("collectionID", collectionID),
+			zap.Int("count", node.inFlightRequests.count(collectionID)),
+			zap.Duration("maxWait", maxWait))
+	}
+}
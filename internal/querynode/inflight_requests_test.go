@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInFlightRequests(t *testing.T) {
+	requests := newInFlightRequests()
+
+	// nothing to drain
+	assert.True(t, requests.drain(defaultCollectionID, 0))
+
+	done1 := requests.add(defaultCollectionID)
+	done2 := requests.add(defaultCollectionID)
+	doneOther := requests.add(defaultCollectionID + 1)
+	defer doneOther()
+	assert.Equal(t, 2, requests.count(defaultCollectionID))
+	assert.Equal(t, 3, requests.total())
+
+	assert.False(t, requests.drain(defaultCollectionID, 10*time.Millisecond))
+
+	done1()
+	// done is idempotent
+	done1()
+	assert.Equal(t, 1, requests.count(defaultCollectionID))
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		done2()
+	}()
+	assert.True(t, requests.drain(defaultCollectionID, 10*time.Second))
+	assert.Equal(t, 0, requests.count(defaultCollectionID))
+	assert.Equal(t, 1, requests.count(defaultCollectionID+1))
+
+	// drain all collections
+	assert.False(t, requests.drainAll(10*time.Millisecond))
+	doneOther()
+	assert.True(t, requests.drainAll(0))
+
+	var nilRequests *inFlightRequests
+	nilRequests.add(defaultCollectionID)()
+	assert.Equal(t, 0, nilRequests.count(defaultCollectionID))
+	assert.True(t, nilRequests.drain(defaultCollectionID, time.Second))
+	assert.True(t, nilRequests.drainAll(time.Second))
+	assert.Equal(t, 0, nilRequests.total())
+	assert.Equal(t, 0.0, nilRequests.qps())
+}
+
+func TestInFlightRequests_drainEpoch(t *testing.T) {
+	requests := newInFlightRequests()
+	done1 := requests.add(defaultCollectionID)
+
+	drained := make(chan bool)
+	go func() {
+		drained <- requests.drain(defaultCollectionID, 10*time.Second)
+	}()
+	// the requests started during the drain are not waited
+	assert.Eventually(t, func() bool {
+		requests.mu.Lock()
+		defer requests.mu.Unlock()
+		return len(requests.collections[defaultCollectionID].sealed) == 1
+	}, time.Second, time.Millisecond)
+	done2 := requests.add(defaultCollectionID)
+	defer done2()
+	done1()
+	assert.True(t, <-drained)
+	assert.Equal(t, 1, requests.count(defaultCollectionID))
+
+	// the later drain waits for the requests started before it
+	assert.False(t, requests.drain(defaultCollectionID, 10*time.Millisecond))
+	assert.False(t, requests.drain(defaultCollectionID, 10*time.Millisecond))
+	done2()
+	assert.True(t, requests.drain(defaultCollectionID, 0))
+	assert.Equal(t, 0, requests.count(defaultCollectionID))
+}
+
+func TestInFlightRequests_qps(t *testing.T) {
+	requests := newInFlightRequests()
+	assert.Equal(t, 0.0, requests.qps())
+
+	now := time.Now().Unix()
+	for i := 0; i < 20; i++ {
+		requests.window.record(now - 1)
+	}
+	for i := 0; i < 10; i++ {
+		requests.window.record(now)
+	}
+	assert.Equal(t, 3.0, requests.qpsAt(now))
+	// the bucket of the same slot is reset
+	requests.window.record(now + qpsWindowSeconds - 1)
+	assert.Equal(t, 1.1, requests.qpsAt(now+qpsWindowSeconds-1))
+	// out of the window
+	assert.Equal(t, 0.0, requests.qpsAt(now+3*qpsWindowSeconds))
+}
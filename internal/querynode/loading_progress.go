@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+// loaded fractions of a segment after the steps of loading
+const (
+	loadingProgressIndexLoaded  = 0.5
+	loadingProgressBinlogLoaded = 0.8
+	loadingProgressStatsLoaded  = 0.9
+)
+
+// segmentLoadingProgress tracks the progress of the segments being loaded, so that QueryCoord could
+// report the progress of loading a collection instead of whether it is loaded.
+type segmentLoadingProgress struct {
+	mu       sync.RWMutex
+	segments map[UniqueID]*milvuspb.SegmentLoadingProgress
+}
+
+func newSegmentLoadingProgress() *segmentLoadingProgress {
+	return &segmentLoadingProgress{
+		segments: make(map[UniqueID]*milvuspb.SegmentLoadingProgress),
+	}
+}
+
+// start starts tracking the segments to load
+func (p *segmentLoadingProgress) start(infos []*querypb.SegmentLoadInfo) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, info := range infos {
+		p.segments[info.GetSegmentID()] = &milvuspb.SegmentLoadingProgress{
+			SegmentID:    info.GetSegmentID(),
+			CollectionID: info.GetCollectionID(),
+			NodeID:       Params.QueryNodeCfg.GetNodeID(),
+			NumRows:      info.GetNumOfRows(),
+		}
+	}
+}
+
+// update sets the loaded fraction of the segment
+func (p *segmentLoadingProgress) update(segmentID UniqueID, progress float64) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if segment, ok := p.segments[segmentID]; ok {
+		segment.Progress = progress
+	}
+}
+
+// finish stops tracking the segments, whether they are loaded or failed
+func (p *segmentLoadingProgress) finish(infos []*querypb.SegmentLoadInfo) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, info := range infos {
+		delete(p.segments, info.GetSegmentID())
+	}
+}
+
+// list returns the progress of the segments of the collection ordered by segment id, all segments are returned if collectionID is 0
+func (p *segmentLoadingProgress) list(collectionID UniqueID) []*milvuspb.SegmentLoadingProgress {
+	progresses := make([]*milvuspb.SegmentLoadingProgress, 0)
+	if p == nil {
+		return progresses
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for _, segment := range p.segments {
+		if collectionID == 0 || segment.CollectionID == collectionID {
+			progresses = append(progresses, proto.Clone(segment).(*milvuspb.SegmentLoadingProgress))
+		}
+	}
+	sort.Slice(progresses, func(i, j int) bool {
+		return progresses[i].SegmentID < progresses[j].SegmentID
+	})
+	return progresses
+}
+
+// GetSegmentLoadingProgress returns the progress of the segments of the collection being loaded by QueryNode
+func (node *QueryNode) GetSegmentLoadingProgress(ctx context.Context, req *querypb.GetLoadingProgressRequest) (*querypb.GetSegmentLoadingProgressResponse, error) {
+	if !node.isHealthy() {
+		return &querypb.GetSegmentLoadingProgressResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("query node %d is not ready", Params.QueryNodeCfg.GetNodeID()),
+			},
+		}, nil
+	}
+	return &querypb.GetSegmentLoadingProgressResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Segments: node.loader.progress.list(req.GetCollectionID()),
+	}, nil
+}
@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func TestSegmentLoadingProgress(t *testing.T) {
+	p := newSegmentLoadingProgress()
+	infos := []*querypb.SegmentLoadInfo{
+		{SegmentID: 2, CollectionID: defaultCollectionID, NumOfRows: 100},
+		{SegmentID: 1, CollectionID: defaultCollectionID, NumOfRows: 200},
+		{SegmentID: 3, CollectionID: defaultCollectionID + 1, NumOfRows: 300},
+	}
+	p.start(infos)
+	p.update(1, loadingProgressBinlogLoaded)
+	p.update(4, loadingProgressBinlogLoaded)
+
+	progresses := p.list(defaultCollectionID)
+	assert.Equal(t, 2, len(progresses))
+	assert.Equal(t, int64(1), progresses[0].SegmentID)
+	assert.Equal(t, int64(200), progresses[0].NumRows)
+	assert.Equal(t, loadingProgressBinlogLoaded, progresses[0].Progress)
+	assert.Equal(t, int64(2), progresses[1].SegmentID)
+	assert.Equal(t, float64(0), progresses[1].Progress)
+	assert.Equal(t, 3, len(p.list(0)))
+
+	p.finish(infos[:2])
+	assert.Equal(t, 0, len(p.list(defaultCollectionID)))
+	assert.Equal(t, 1, len(p.list(0)))
+
+	var nilProgress *segmentLoadingProgress
+	nilProgress.start(infos)
+	nilProgress.update(1, loadingProgressBinlogLoaded)
+	nilProgress.finish(infos)
+	assert.Equal(t, 0, len(nilProgress.list(0)))
+}
+
+func TestGetSegmentLoadingProgress(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+	infos := []*querypb.SegmentLoadInfo{
+		{SegmentID: defaultSegmentID, CollectionID: defaultCollectionID, NumOfRows: defaultMsgLength},
+	}
+	node.loader.progress.start(infos)
+	defer node.loader.progress.finish(infos)
+	node.loader.progress.update(defaultSegmentID, loadingProgressIndexLoaded)
+
+	req := &querypb.GetLoadingProgressRequest{CollectionID: defaultCollectionID}
+	resp, err := node.GetSegmentLoadingProgress(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	assert.Equal(t, 1, len(resp.Segments))
+	assert.Equal(t, defaultSegmentID, resp.Segments[0].SegmentID)
+	assert.Equal(t, loadingProgressIndexLoaded, resp.Segments[0].Progress)
+
+	node.UpdateStateCode(internalpb.StateCode_Abnormal)
+	resp, err = node.GetSegmentLoadingProgress(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+}
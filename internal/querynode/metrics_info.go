@@ -18,6 +18,8 @@ package querynode
 
 import (
 	"context"
+	"encoding/json"
+	"sort"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
@@ -25,10 +27,19 @@ import (
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
+// getTotalMemory returns the memory used for load admission and memory watermarks of QueryNode,
+// the configured total memory overrides the one detected from the cgroup limit or the host.
+func getTotalMemory() uint64 {
+	if Params.QueryNodeCfg.TotalMemory > 0 {
+		return Params.QueryNodeCfg.TotalMemory
+	}
+	return metricsinfo.GetMemoryCount()
+}
+
 // getSystemInfoMetrics returns metrics info of QueryNode
 func getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest, node *QueryNode) (*milvuspb.GetMetricsResponse, error) {
 	usedMem := metricsinfo.GetUsedMemoryCount()
-	totalMem := metricsinfo.GetMemoryCount()
+	totalMem := getTotalMemory()
 	nodeInfos := metricsinfo.QueryNodeInfos{
 		BaseComponentInfos: metricsinfo.BaseComponentInfos{
 			Name: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeCfg.GetNodeID()),
@@ -56,8 +67,10 @@ func getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest,
 			RetrieveResultReceiveBufSize: Params.QueryNodeCfg.RetrieveResultReceiveBufSize,
 
 			SimdType: Params.CommonCfg.SimdType,
+			Zone:     Params.QueryNodeCfg.Zone,
 		},
 	}
+	nodeInfos.DiskCacheUsage, nodeInfos.DiskCacheCapacity = node.queryShardService.diskCacheUsage()
 	metricsinfo.FillDeployMetricsWithEnv(&nodeInfos.SystemInfo)
 
 	resp, err := metricsinfo.MarshalComponentInfos(nodeInfos)
@@ -81,3 +94,145 @@ func getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest,
 		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeCfg.GetNodeID()),
 	}, nil
 }
+
+// getNodeLoadMetrics returns the load of QueryNode, which is used by shard leaders to route the sub-search requests
+func getNodeLoadMetrics(node *QueryNode) (*milvuspb.GetMetricsResponse, error) {
+	load := metricsinfo.QueryNodeLoad{
+		NodeID:           Params.QueryNodeCfg.GetNodeID(),
+		CPUUsage:         metricsinfo.GetCPUUsage(),
+		QueueDepth:       int64(node.inFlightRequests.total()),
+		QPS:              node.inFlightRequests.qps(),
+		Zone:             Params.QueryNodeCfg.Zone,
+		ServiceableTimes: node.queryShardService.serviceableTimes(),
+	}
+	resp, err := json.Marshal(load)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			Response:      "",
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeCfg.GetNodeID()),
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeCfg.GetNodeID()),
+	}, nil
+}
+
+// getShardClustersMetrics returns the state of the shard clusters led by QueryNode
+func getShardClustersMetrics(node *QueryNode) (*milvuspb.GetMetricsResponse, error) {
+	resp, err := json.Marshal(node.ShardClusterService.describe())
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			Response:      "",
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeCfg.GetNodeID()),
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeCfg.GetNodeID()),
+	}, nil
+}
+
+// getSegmentEventsMetrics returns the lifecycle events of the segment in request, or all the segments if not specified
+func getSegmentEventsMetrics(req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	segmentID, err := metricsinfo.ParseSegmentID(req.GetRequest())
+	var resp []byte
+	if err == nil {
+		resp, err = json.Marshal(getSegmentEventLog().list(segmentID))
+	}
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			Response:      "",
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeCfg.GetNodeID()),
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeCfg.GetNodeID()),
+	}, nil
+}
+
+// getShardStatsMetrics returns the statistics of the searches and queries per shard served by QueryNode
+func getShardStatsMetrics(node *QueryNode) (*milvuspb.GetMetricsResponse, error) {
+	resp, err := json.Marshal(node.queryShardService.shardStats())
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			Response:      "",
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeCfg.GetNodeID()),
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeCfg.GetNodeID()),
+	}, nil
+}
+
+// getConsumerLagsMetrics returns the lags of the msgstream consumers of the flow graphs of QueryNode
+func getConsumerLagsMetrics(node *QueryNode) (*milvuspb.GetMetricsResponse, error) {
+	lags := make([]metricsinfo.ConsumerLag, 0)
+	for _, lag := range node.dataSyncService.getConsumerLags() {
+		lags = append(lags, metricsinfo.ConsumerLag{
+			Channel:        lag.Channel,
+			Subscription:   lag.Subscription,
+			MsgLag:         lag.MsgLag,
+			TimeLagSeconds: lag.TimeLag.Seconds(),
+		})
+	}
+	sort.Slice(lags, func(i, j int) bool { return lags[i].Channel < lags[j].Channel })
+	resp, err := json.Marshal(lags)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			Response:      "",
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeCfg.GetNodeID()),
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeCfg.GetNodeID()),
+	}, nil
+}
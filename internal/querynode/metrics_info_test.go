@@ -18,13 +18,17 @@ package querynode
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 )
 
@@ -47,3 +51,115 @@ func TestGetSystemInfoMetrics(t *testing.T) {
 	assert.NoError(t, err)
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
 }
+
+func TestGetNodeLoadMetrics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+	defer node.inFlightRequests.add(defaultCollectionID)()
+
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.NodeLoadMetrics)
+	assert.NoError(t, err)
+	resp, err := node.GetMetrics(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+	load := metricsinfo.QueryNodeLoad{}
+	err = json.Unmarshal([]byte(resp.Response), &load)
+	assert.NoError(t, err)
+	assert.Equal(t, Params.QueryNodeCfg.GetNodeID(), load.NodeID)
+	assert.Equal(t, int64(1), load.QueueDepth)
+	assert.Greater(t, load.QPS, 0.0)
+}
+
+func TestGetShardClustersMetrics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+	node.ShardClusterService.addShardCluster(defaultCollectionID, defaultReplicaID, defaultDMLChannel)
+	defer node.ShardClusterService.releaseShardCluster(defaultDMLChannel)
+
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.ShardClustersMetrics)
+	assert.NoError(t, err)
+	resp, err := node.GetMetrics(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+	var infos []metricsinfo.ShardClusterInfo
+	err = json.Unmarshal([]byte(resp.Response), &infos)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(infos))
+	assert.Equal(t, defaultDMLChannel, infos[0].VChannel)
+}
+
+func TestGetShardStatsMetrics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+	err = node.queryShardService.addQueryShard(defaultCollectionID, defaultDMLChannel, defaultReplicaID)
+	assert.NoError(t, err)
+	defer node.queryShardService.releaseQueryShard(defaultDMLChannel)
+	qs, err := node.queryShardService.getQueryShard(defaultDMLChannel)
+	assert.NoError(t, err)
+	qs.stats.observe(&slowQueryRecord{kind: slowQueryKindQuery, rowsScanned: 10}, time.Millisecond, nil)
+
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.ShardStatsMetrics)
+	assert.NoError(t, err)
+	resp, err := node.GetMetrics(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+	var stats []metricsinfo.QueryShardStats
+	err = json.Unmarshal([]byte(resp.Response), &stats)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(stats))
+	assert.Equal(t, defaultDMLChannel, stats[0].Channel)
+	assert.Equal(t, int64(1), stats[0].QueryCount)
+	assert.Equal(t, int64(10), stats[0].RowsScanned)
+}
+
+func TestGetTotalMemory(t *testing.T) {
+	assert.Equal(t, metricsinfo.GetMemoryCount(), getTotalMemory())
+
+	Params.QueryNodeCfg.TotalMemory = 1024
+	defer func() {
+		Params.QueryNodeCfg.TotalMemory = 0
+	}()
+	assert.Equal(t, uint64(1024), getTotalMemory())
+}
+
+func TestGetSegmentEventsMetrics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+
+	recordSegmentEvent(segmentEventEvicted, defaultCollectionID, defaultPartitionID, defaultSegmentID, "idle")
+
+	req := &milvuspb.GetMetricsRequest{
+		Request: `{"metric_type":"segment_events","segment_id":` + fmt.Sprint(defaultSegmentID) + `}`,
+	}
+	resp, err := node.GetMetrics(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+	var events []metricsinfo.SegmentEvent
+	err = json.Unmarshal([]byte(resp.Response), &events)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, events)
+	for _, event := range events {
+		assert.Equal(t, int64(defaultSegmentID), event.SegmentID)
+	}
+
+	req.Request = `{"metric_type":"segment_events","segment_id":"invalid"}`
+	resp, err = node.GetMetrics(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+}
@@ -339,7 +339,7 @@ func loadIndexForSegment(ctx context.Context, node *QueryNode, segmentID UniqueI
 		},
 	}
 
-	err = loader.loadSegment(req, segmentTypeSealed)
+	err = loader.loadSegment(ctx, req, segmentTypeSealed)
 	if err != nil {
 		return err
 	}
@@ -606,7 +606,7 @@ func genVectorChunkManager(ctx context.Context, col *Collection) (*storage.Vecto
 	vcm, err := storage.NewVectorChunkManager(lcm, rcm, &etcdpb.CollectionMeta{
 		ID:     col.id,
 		Schema: col.schema,
-	}, Params.QueryNodeCfg.CacheMemoryLimit, false)
+	}, Params.QueryNodeCfg.CacheMemoryLimit.Load(), false)
 	if err != nil {
 		return nil, err
 	}
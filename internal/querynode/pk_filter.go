@@ -0,0 +1,87 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+)
+
+// The queries by primary keys, i.e. "pk in [...]" or "pk == x", only need to scan the segments whose pk bloom
+// filters may contain any of the keys, the others are skipped without calling segcore. Both int64 and VarChar
+// primary keys are supported.
+
+// getTermPKs returns the primary keys queried if the serialized plan only filters by primary keys, false otherwise
+func getTermPKs(serializedPlan []byte) ([]primaryKey, bool) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return nil, false
+	}
+	var column *planpb.ColumnInfo
+	var values []*planpb.GenericValue
+	switch expr := plan.GetPredicates().GetExpr().(type) {
+	case *planpb.Expr_TermExpr:
+		column, values = expr.TermExpr.GetColumnInfo(), expr.TermExpr.GetValues()
+	case *planpb.Expr_UnaryRangeExpr:
+		if expr.UnaryRangeExpr.GetOp() != planpb.OpType_Equal {
+			return nil, false
+		}
+		column, values = expr.UnaryRangeExpr.GetColumnInfo(), []*planpb.GenericValue{expr.UnaryRangeExpr.GetValue()}
+	default:
+		return nil, false
+	}
+	if !column.GetIsPrimaryKey() {
+		return nil, false
+	}
+
+	pks := make([]primaryKey, 0, len(values))
+	for _, value := range values {
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			pks = append(pks, newInt64PrimaryKey(v.Int64Val))
+		case *planpb.GenericValue_StringVal:
+			pks = append(pks, newVarCharPrimaryKey(v.StringVal))
+		default:
+			return nil, false
+		}
+	}
+	return pks, true
+}
+
+// mayContainAnyPK returns whether the pk bloom filter of the segment may contain any of the primary keys
+func mayContainAnyPK(segment *Segment, pks []primaryKey) bool {
+	for _, pk := range pks {
+		if segment.mayContainPK(pk) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterSegmentIDsByPKs returns the segments of the replica which may contain any of the primary keys,
+// the segments not found in the replica are kept to fail the query as before
+func filterSegmentIDsByPKs(replica ReplicaInterface, segmentIDs []UniqueID, pks []primaryKey) []UniqueID {
+	ret := make([]UniqueID, 0, len(segmentIDs))
+	for _, segmentID := range segmentIDs {
+		segment, err := replica.getSegmentByID(segmentID)
+		if err != nil || mayContainAnyPK(segment, pks) {
+			ret = append(ret, segmentID)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,85 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/bits-and-blooms/bloom/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func serializePredicates(t *testing.T, expr *planpb.Expr) []byte {
+	plan, err := proto.Marshal(&planpb.PlanNode{Node: &planpb.PlanNode_Predicates{Predicates: expr}})
+	assert.NoError(t, err)
+	return plan
+}
+
+func TestGetTermPKs(t *testing.T) {
+	pkColumn := &planpb.ColumnInfo{FieldId: 100, DataType: schemapb.DataType_VarChar, IsPrimaryKey: true}
+	term := serializePredicates(t, &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: &planpb.TermExpr{
+		ColumnInfo: pkColumn,
+		Values: []*planpb.GenericValue{
+			{Val: &planpb.GenericValue_StringVal{StringVal: "a"}},
+			{Val: &planpb.GenericValue_StringVal{StringVal: "b"}},
+		},
+	}}})
+	pks, ok := getTermPKs(term)
+	assert.True(t, ok)
+	assert.Equal(t, []primaryKey{newVarCharPrimaryKey("a"), newVarCharPrimaryKey("b")}, pks)
+
+	equal := serializePredicates(t, &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+		ColumnInfo: &planpb.ColumnInfo{FieldId: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+		Op:         planpb.OpType_Equal,
+		Value:      &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: 1}},
+	}}})
+	pks, ok = getTermPKs(equal)
+	assert.True(t, ok)
+	assert.Equal(t, []primaryKey{newInt64PrimaryKey(1)}, pks)
+
+	// not filtered by primary keys only
+	greater := serializePredicates(t, &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+		ColumnInfo: pkColumn,
+		Op:         planpb.OpType_GreaterThan,
+		Value:      &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: "a"}},
+	}}})
+	_, ok = getTermPKs(greater)
+	assert.False(t, ok)
+	notPK := serializePredicates(t, &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: &planpb.TermExpr{
+		ColumnInfo: &planpb.ColumnInfo{FieldId: 101, DataType: schemapb.DataType_VarChar},
+		Values:     []*planpb.GenericValue{{Val: &planpb.GenericValue_StringVal{StringVal: "a"}}},
+	}}})
+	_, ok = getTermPKs(notPK)
+	assert.False(t, ok)
+	_, ok = getTermPKs([]byte("invalid"))
+	assert.False(t, ok)
+}
+
+func TestMayContainAnyPK(t *testing.T) {
+	filter := bloom.NewWithEstimates(1000, 0.001)
+	segment := &Segment{segmentID: 1, pkFilter: filter}
+	segment.updateBloomFilter([]primaryKey{newVarCharPrimaryKey("a"), newInt64PrimaryKey(1)})
+
+	assert.True(t, mayContainAnyPK(segment, []primaryKey{newVarCharPrimaryKey("a")}))
+	assert.True(t, mayContainAnyPK(segment, []primaryKey{newVarCharPrimaryKey("b"), newInt64PrimaryKey(1)}))
+	assert.False(t, mayContainAnyPK(segment, []primaryKey{newVarCharPrimaryKey("b"), newInt64PrimaryKey(2)}))
+	assert.False(t, mayContainAnyPK(segment, nil))
+}
@@ -31,6 +31,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"unsafe"
 )
@@ -39,6 +40,8 @@ import (
 type SearchPlan struct {
 	cSearchPlan C.CSearchPlan
 	pkType      schemapb.DataType
+	// searchRange is the range of the range search, nil if the search is not a range search
+	searchRange *indexparamcheck.SearchRange
 }
 
 // createSearchPlan returns a new SearchPlan and error
@@ -47,7 +50,7 @@ func createSearchPlan(col *Collection, dsl string) (*SearchPlan, error) {
 		return nil, errors.New("nil collection ptr, collectionID = " + fmt.Sprintln(col.id))
 	}
 
-	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(col.schema)
+	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(col.Schema())
 	if err != nil {
 		return nil, err
 	}
@@ -63,6 +66,7 @@ func createSearchPlan(col *Collection, dsl string) (*SearchPlan, error) {
 	}
 
 	var newPlan = &SearchPlan{cSearchPlan: cPlan, pkType: primaryFieldSchema.DataType}
+	newPlan.setExpireTimestamp(col.getExpireTimestamp())
 	return newPlan, nil
 }
 
@@ -70,7 +74,11 @@ func createSearchPlanByExpr(col *Collection, expr []byte) (*SearchPlan, error) {
 	if col.collectionPtr == nil {
 		return nil, errors.New("nil collection ptr, collectionID = " + fmt.Sprintln(col.id))
 	}
-	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(col.schema)
+	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(col.Schema())
+	if err != nil {
+		return nil, err
+	}
+	searchRange, err := parseSearchRange(expr)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +91,8 @@ func createSearchPlanByExpr(col *Collection, expr []byte) (*SearchPlan, error) {
 		return nil, err1
 	}
 
-	var newPlan = &SearchPlan{cSearchPlan: cPlan, pkType: primaryFieldSchema.DataType}
+	var newPlan = &SearchPlan{cSearchPlan: cPlan, pkType: primaryFieldSchema.DataType, searchRange: searchRange}
+	newPlan.setExpireTimestamp(col.getExpireTimestamp())
 	return newPlan, nil
 }
 
@@ -99,6 +108,13 @@ func (plan *SearchPlan) getMetricType() string {
 	return metricType
 }
 
+// setExpireTimestamp filters the entities inserted before expireTs from the search results, 0 means never expire
+func (plan *SearchPlan) setExpireTimestamp(expireTs Timestamp) {
+	if expireTs > 0 {
+		C.SetSearchPlanExpireTimestamp(plan.cSearchPlan, C.uint64_t(expireTs))
+	}
+}
+
 func (plan *SearchPlan) delete() {
 	C.DeleteSearchPlan(plan.cSearchPlan)
 }
@@ -167,9 +183,17 @@ func createRetrievePlanByExpr(col *Collection, expr []byte, timestamp Timestamp)
 		cRetrievePlan: cPlan,
 		Timestamp:     timestamp,
 	}
+	newPlan.setExpireTimestamp(col.getExpireTimestamp())
 	return newPlan, nil
 }
 
+// setExpireTimestamp filters the entities inserted before expireTs from the retrieve results, 0 means never expire
+func (plan *RetrievePlan) setExpireTimestamp(expireTs Timestamp) {
+	if expireTs > 0 {
+		C.SetRetrievePlanExpireTimestamp(plan.cRetrievePlan, C.uint64_t(expireTs))
+	}
+}
+
 func (plan *RetrievePlan) delete() {
 	C.DeleteRetrievePlan(plan.cRetrievePlan)
 }
@@ -0,0 +1,184 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"container/list"
+	"sync"
+)
+
+// cachedPlan is a plan compiled by segcore
+type cachedPlan interface {
+	delete()
+}
+
+type planCacheKey struct {
+	retrieve bool
+	// expr is the serialized plan
+	expr string
+}
+
+type planCacheEntry struct {
+	key     planCacheKey
+	plan    cachedPlan
+	refs    int
+	evicted bool
+}
+
+// planCache is a LRU cache of the plans compiled by segcore for a collection, keyed by the serialized plans, so that
+// the repeated searches and queries, e.g. the ones by the templates registered to proxy, skip compiling their plans.
+// The plans are shared by the requests, a plan evicted is deleted once it's released by all of them.
+type planCache struct {
+	mu        sync.Mutex
+	capacity  int
+	evictList *list.List
+	items     map[planCacheKey]*list.Element
+}
+
+func newPlanCache(capacity int) *planCache {
+	return &planCache{
+		capacity:  capacity,
+		evictList: list.New(),
+		items:     make(map[planCacheKey]*list.Element),
+	}
+}
+
+// acquire returns the plan of key, which is created by create and cached if missing.
+// release must be called once the plan is no longer used.
+func (c *planCache) acquire(key planCacheKey, create func() (cachedPlan, error)) (cachedPlan, func(), error) {
+	if c == nil || c.capacity <= 0 {
+		plan, err := create()
+		if err != nil {
+			return nil, nil, err
+		}
+		return plan, plan.delete, nil
+	}
+
+	if entry := c.get(key); entry != nil {
+		return entry.plan, func() { c.release(entry) }, nil
+	}
+	plan, err := create()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		// compiled by another request meanwhile
+		plan.delete()
+		entry := e.Value.(*planCacheEntry)
+		entry.refs++
+		c.evictList.MoveToFront(e)
+		return entry.plan, func() { c.release(entry) }, nil
+	}
+	entry := &planCacheEntry{key: key, plan: plan, refs: 1}
+	c.items[key] = c.evictList.PushFront(entry)
+	for c.evictList.Len() > c.capacity {
+		c.evictLocked(c.evictList.Back())
+	}
+	return plan, func() { c.release(entry) }, nil
+}
+
+func (c *planCache) get(key planCacheKey) *planCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return nil
+	}
+	entry := e.Value.(*planCacheEntry)
+	entry.refs++
+	c.evictList.MoveToFront(e)
+	return entry
+}
+
+func (c *planCache) release(entry *planCacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry.refs--
+	if entry.evicted && entry.refs == 0 {
+		entry.plan.delete()
+	}
+}
+
+func (c *planCache) evictLocked(e *list.Element) {
+	entry := e.Value.(*planCacheEntry)
+	c.evictList.Remove(e)
+	delete(c.items, entry.key)
+	entry.evicted = true
+	if entry.refs == 0 {
+		entry.plan.delete()
+	}
+}
+
+// clear evicts all the plans cached, the ones in use are deleted once released
+func (c *planCache) clear() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for c.evictList.Len() > 0 {
+		c.evictLocked(c.evictList.Front())
+	}
+}
+
+func (c *planCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.evictList.Len()
+}
+
+// acquireSearchPlan returns the search plan of expr cached by the collection, release must be called once it's used.
+// The plans of the collections with ttl aren't cached, since their expire timestamps change by the requests.
+func (c *Collection) acquireSearchPlan(expr []byte) (*SearchPlan, func(), error) {
+	if c.getExpireTimestamp() > 0 {
+		plan, err := createSearchPlanByExpr(c, expr)
+		if err != nil {
+			return nil, nil, err
+		}
+		return plan, plan.delete, nil
+	}
+	plan, release, err := c.plans.acquire(planCacheKey{expr: string(expr)}, func() (cachedPlan, error) {
+		return createSearchPlanByExpr(c, expr)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return plan.(*SearchPlan), release, nil
+}
+
+// acquireRetrievePlan returns the retrieve plan of expr at timestamp cached by the collection, release must be called
+// once it's used. The plans of the collections with ttl aren't cached, the same as the search plans.
+func (c *Collection) acquireRetrievePlan(expr []byte, timestamp Timestamp) (*RetrievePlan, func(), error) {
+	if c.getExpireTimestamp() > 0 {
+		plan, err := createRetrievePlanByExpr(c, expr, timestamp)
+		if err != nil {
+			return nil, nil, err
+		}
+		return plan, plan.delete, nil
+	}
+	plan, release, err := c.plans.acquire(planCacheKey{retrieve: true, expr: string(expr)}, func() (cachedPlan, error) {
+		return createRetrievePlanByExpr(c, expr, 0)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	// the timestamp is passed to segcore by every retrieve, rather than kept in the plan shared
+	return &RetrievePlan{cRetrievePlan: plan.(*RetrievePlan).cRetrievePlan, Timestamp: timestamp}, release, nil
+}
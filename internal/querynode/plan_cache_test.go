@@ -0,0 +1,107 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCachedPlan struct {
+	deleted int
+}
+
+func (p *mockCachedPlan) delete() {
+	p.deleted++
+}
+
+func TestPlanCache(t *testing.T) {
+	cache := newPlanCache(1)
+	created := 0
+	create := func() (cachedPlan, error) {
+		created++
+		return &mockCachedPlan{}, nil
+	}
+
+	p1, release1, err := cache.acquire(planCacheKey{expr: "a"}, create)
+	assert.NoError(t, err)
+	p2, release2, err := cache.acquire(planCacheKey{expr: "a"}, create)
+	assert.NoError(t, err)
+	assert.Same(t, p1, p2)
+	assert.Equal(t, 1, created)
+
+	// the plan evicted is deleted once it's released
+	p3, release3, err := cache.acquire(planCacheKey{retrieve: true, expr: "a"}, create)
+	assert.NoError(t, err)
+	assert.NotSame(t, p1, p3)
+	assert.Equal(t, 1, cache.len())
+	release1()
+	assert.Equal(t, 0, p1.(*mockCachedPlan).deleted)
+	release2()
+	assert.Equal(t, 1, p1.(*mockCachedPlan).deleted)
+
+	release3()
+	assert.Equal(t, 0, p3.(*mockCachedPlan).deleted)
+	cache.clear()
+	assert.Equal(t, 1, p3.(*mockCachedPlan).deleted)
+	assert.Equal(t, 0, cache.len())
+
+	_, _, err = cache.acquire(planCacheKey{expr: "b"}, func() (cachedPlan, error) {
+		return nil, errors.New("mock error")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 0, cache.len())
+
+	// the plans aren't cached if disabled
+	disabled := newPlanCache(0)
+	p4, release4, err := disabled.acquire(planCacheKey{expr: "a"}, create)
+	assert.NoError(t, err)
+	release4()
+	assert.Equal(t, 1, p4.(*mockCachedPlan).deleted)
+	assert.Equal(t, 0, disabled.len())
+}
+
+func TestCollection_acquireRetrievePlan(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tSafe := newTSafeReplica()
+	historical, err := genSimpleHistorical(ctx, tSafe)
+	assert.NoError(t, err)
+	col, err := historical.replica.getCollectionByID(defaultCollectionID)
+	assert.NoError(t, err)
+	expr, err := genSimpleRetrievePlanExpr(col.Schema())
+	assert.NoError(t, err)
+
+	plan1, release1, err := col.acquireRetrievePlan(expr, 100)
+	assert.NoError(t, err)
+	defer release1()
+	plan2, release2, err := col.acquireRetrievePlan(expr, 200)
+	assert.NoError(t, err)
+	defer release2()
+	assert.Equal(t, plan1.cRetrievePlan, plan2.cRetrievePlan)
+	assert.Equal(t, Timestamp(100), plan1.Timestamp)
+	assert.Equal(t, Timestamp(200), plan2.Timestamp)
+	assert.Equal(t, 1, col.plans.len())
+
+	_, _, err = col.acquireRetrievePlan([]byte("invalid"), 100)
+	assert.Error(t, err)
+	assert.Equal(t, 1, col.plans.len())
+}
@@ -138,6 +138,8 @@ func (qc *queryChannel) adjustByChangeInfo(msg *msgstream.SealedSegmentsChangeIn
 					},
 				},
 			})
+			recordSegmentEvent(segmentEventExcluded, segment.CollectionID, segment.PartitionID, segment.SegmentID,
+				fmt.Sprintf("growing data filtered out since the sealed segment is online on node %d", segment.NodeID))
 		}
 
 		log.Info("Successfully changed global sealed segment info ",
@@ -422,6 +422,8 @@ func (q *queryCollection) adjustByChangeInfo(msg *msgstream.SealedSegmentsChange
 					},
 				},
 			})
+			recordSegmentEvent(segmentEventExcluded, segment.CollectionID, segment.PartitionID, segment.SegmentID,
+				fmt.Sprintf("growing data filtered out since the sealed segment is online on node %d", segment.NodeID))
 		}
 
 		// for OfflineSegments:
@@ -774,7 +776,7 @@ func (q *queryCollection) search(msg queryMsg) error {
 	}()
 	// historical search
 	log.Debug("historical search start", zap.Int64("msgID", searchMsg.ID()))
-	hisSearchResults, sealedSegmentSearched, sealedPartitionSearched, err := q.historical.search(searchRequests, collection.id, searchMsg.PartitionIDs, plan, travelTimestamp)
+	hisSearchResults, sealedSegmentSearched, sealedPartitionSearched, err := q.historical.search(ctx, searchRequests, collection.id, searchMsg.PartitionIDs, plan, travelTimestamp)
 	if err != nil {
 		return err
 	}
@@ -784,7 +786,7 @@ func (q *queryCollection) search(msg queryMsg) error {
 
 	log.Debug("streaming search start", zap.Int64("msgID", searchMsg.ID()))
 	for _, channel := range collection.getVChannels() {
-		strSearchResults, growingSegmentSearched, growingPartitionSearched, err := q.streaming.search(searchRequests, collection.id, searchMsg.PartitionIDs, channel, plan, travelTimestamp)
+		strSearchResults, growingSegmentSearched, growingPartitionSearched, err := q.streaming.search(ctx, searchRequests, collection.id, searchMsg.PartitionIDs, channel, plan, travelTimestamp)
 		if err != nil {
 			return err
 		}
@@ -972,8 +974,8 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 		q.vectorChunkManager, err = storage.NewVectorChunkManager(q.localChunkManager, q.remoteChunkManager,
 			&etcdpb.CollectionMeta{
 				ID:     collection.id,
-				Schema: collection.schema,
-			}, Params.QueryNodeCfg.CacheMemoryLimit, Params.QueryNodeCfg.CacheEnabled)
+				Schema: collection.Schema(),
+			}, Params.QueryNodeCfg.CacheMemoryLimit.Load(), Params.QueryNodeCfg.CacheEnabled)
 		if err != nil {
 			return err
 		}
@@ -981,7 +983,7 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 
 	// historical retrieve
 	log.Debug("historical retrieve start", zap.Int64("msgID", retrieveMsg.ID()))
-	hisRetrieveResults, sealedSegmentRetrieved, sealedPartitionRetrieved, err := q.historical.retrieve(collectionID, retrieveMsg.PartitionIDs, q.vectorChunkManager, plan)
+	hisRetrieveResults, sealedSegmentRetrieved, sealedPartitionRetrieved, err := q.historical.retrieve(ctx, collectionID, retrieveMsg.PartitionIDs, q.vectorChunkManager, plan)
 	if err != nil {
 		return err
 	}
@@ -991,7 +993,7 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 
 	// streaming retrieve
 	log.Debug("streaming retrieve start", zap.Int64("msgID", retrieveMsg.ID()))
-	strRetrieveResults, streamingSegmentRetrived, streamingPartitionRetrived, err := q.streaming.retrieve(collectionID, retrieveMsg.PartitionIDs, plan)
+	strRetrieveResults, streamingSegmentRetrived, streamingPartitionRetrived, err := q.streaming.retrieve(ctx, collectionID, retrieveMsg.PartitionIDs, plan)
 	if err != nil {
 		return err
 	}
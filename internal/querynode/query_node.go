@@ -71,6 +71,10 @@ var _ types.QueryNodeComponent = (*QueryNode)(nil)
 
 var Params paramtable.ComponentParam
 
+// localDiskQuota is the quota of the local disk shared by the disk cache and the swap cache of the cold segments,
+// nil before init
+var localDiskQuota *storage.DiskQuota
+
 // QueryNode communicates with outside services and union all
 // services in querynode package.
 //
@@ -106,6 +110,17 @@ type QueryNode struct {
 	// segment loader
 	loader *segmentLoader
 
+	// gc tuner, nil if disabled
+	gcTuner *gcTuner
+
+	consumerLagReporter *consumerLagReporter
+
+	// health checker of the subsystems reported in the component states
+	healthChecker *healthChecker
+
+	// config reloader applying the reloadable params at runtime, nil if disabled
+	configReloader *configReloader
+
 	// etcd client
 	etcdCli *clientv3.Client
 
@@ -124,6 +139,12 @@ type QueryNode struct {
 	ShardClusterService *ShardClusterService
 	//shard query service, handles shard-level query & search
 	queryShardService *queryShardService
+
+	// in-flight search/query requests, drained before releasing collections
+	inFlightRequests *inFlightRequests
+
+	// drain state for graceful scale-in, accessed atomically
+	drainState int32
 }
 
 // NewQueryNode will return a QueryNode with abnormal state.
@@ -133,6 +154,7 @@ func NewQueryNode(ctx context.Context, factory dependency.Factory) *QueryNode {
 		queryNodeLoopCtx:    ctx1,
 		queryNodeLoopCancel: cancel,
 		factory:             factory,
+		inFlightRequests:    newInFlightRequests(),
 	}
 
 	node.scheduler = newTaskScheduler(ctx1)
@@ -300,6 +322,20 @@ func (node *QueryNode) Init() error {
 			return
 		}
 
+		// the segments and the indexes are loaded through the disk cache if enabled,
+		// the health checker keeps probing the remote storage
+		localDiskQuota = storage.NewDiskQuota(Params.QueryNodeCfg.DiskQuota)
+		loaderStorage := node.vectorStorage
+		if Params.QueryNodeCfg.DiskCacheEnabled {
+			loaderStorage, err = storage.NewCachedChunkManager(node.vectorStorage,
+				Params.QueryNodeCfg.DiskCachePath, Params.QueryNodeCfg.DiskCacheCapacity, localDiskQuota)
+			if err != nil {
+				log.Error("QueryNode init disk cache failed", zap.Error(err))
+				initError = err
+				return
+			}
+		}
+
 		node.etcdKV = etcdkv.NewEtcdKV(node.etcdCli, Params.EtcdCfg.MetaRootPath)
 		log.Info("queryNode try to connect etcd success", zap.Any("MetaRootPath", Params.EtcdCfg.MetaRootPath))
 		node.tSafeReplica = newTSafeReplica()
@@ -322,11 +358,24 @@ func (node *QueryNode) Init() error {
 			node.historical.replica,
 			node.streaming.replica,
 			node.etcdKV,
-			node.vectorStorage,
+			loaderStorage,
 			node.factory)
 
+		if Params.QueryNodeCfg.ColdSegmentSwapEnabled {
+			node.historical.swapper = newSegmentSwapper(node.queryNodeLoopCtx, historicalReplica, node.loader, node.cacheStorage)
+		}
+		if Params.QueryNodeCfg.GCTunerEnabled {
+			node.gcTuner = newGCTuner(node.queryNodeLoopCtx, node.loader.isLoading)
+		}
+
 		// node.statsService = newStatsService(node.queryNodeLoopCtx, node.historical.replica, node.factory)
 		node.dataSyncService = newDataSyncService(node.queryNodeLoopCtx, streamingReplica, historicalReplica, node.tSafeReplica, node.factory)
+		node.healthChecker = newHealthChecker(node.queryNodeLoopCtx, node.dataSyncService.getLastMsgTimes, node.tSafeReplica,
+			node.vectorStorage, node.scheduler.queue)
+		node.consumerLagReporter = newConsumerLagReporter(node.queryNodeLoopCtx, node.dataSyncService.getConsumerLags)
+		if Params.QueryNodeCfg.ConfigReloadInterval > 0 {
+			node.configReloader = newConfigReloader(node.queryNodeLoopCtx, node.etcdCli)
+		}
 
 		node.InitSegcore()
 
@@ -347,6 +396,8 @@ func (node *QueryNode) Init() error {
 			zap.Any("queryNodeID", Params.QueryNodeCfg.GetNodeID()),
 			zap.Any("IP", Params.QueryNodeCfg.QueryNodeIP),
 			zap.Any("Port", Params.QueryNodeCfg.QueryNodePort),
+			zap.Uint64("totalMemory", getTotalMemory()),
+			zap.Bool("totalMemoryOverridden", Params.QueryNodeCfg.TotalMemory > 0),
 		)
 	})
 
@@ -361,6 +412,21 @@ func (node *QueryNode) Start() error {
 	// start services
 	go node.watchChangeInfo()
 	//go node.statsService.start()
+	if node.historical.swapper != nil {
+		node.historical.swapper.start()
+	}
+	if node.gcTuner != nil {
+		node.gcTuner.start()
+	}
+	if node.healthChecker != nil {
+		node.healthChecker.start()
+	}
+	if node.consumerLagReporter != nil {
+		node.consumerLagReporter.start()
+	}
+	if node.configReloader != nil {
+		node.configReloader.start()
+	}
 
 	// watch proxy
 	if err := node.initServiceDiscovery(); err != nil {
@@ -411,6 +477,18 @@ func (node *QueryNode) Stop() error {
 	if node.queryShardService != nil {
 		node.queryShardService.close()
 	}
+	if node.gcTuner != nil {
+		node.gcTuner.close()
+	}
+	if node.healthChecker != nil {
+		node.healthChecker.close()
+	}
+	if node.consumerLagReporter != nil {
+		node.consumerLagReporter.close()
+	}
+	if node.configReloader != nil {
+		node.configReloader.close()
+	}
 	//if node.statsService != nil {
 	//	node.statsService.close()
 	//}
@@ -472,7 +550,34 @@ func (node *QueryNode) handleSealedSegmentsChangeInfo(info *querypb.SealedSegmen
 			continue
 		}
 
+		if _, ok := node.ShardClusterService.getShardCluster(vchannel); !ok {
+			// growing segments are only searched by the shard leader
+			continue
+		}
 		node.ShardClusterService.HandoffVChannelSegments(vchannel, line)
+		// the online segments are searchable in historical now, retire the growing ones
+		node.retireGrowingSegments(line)
+	}
+}
+
+// retireGrowingSegments removes the growing segments handed off to historical. The searches whose sealed snapshots
+// don't contain the segments are still searching the growing ones, so they are removed after these searches are done.
+func (node *QueryNode) retireGrowingSegments(info *querypb.SegmentChangeInfo) {
+	node.streaming.replica.queryLock()
+	defer node.streaming.replica.queryUnlock()
+	for _, segmentInfo := range info.GetOnlineSegments() {
+		if !node.streaming.replica.hasSegment(segmentInfo.GetSegmentID()) {
+			continue
+		}
+		if err := node.streaming.replica.removeSegment(segmentInfo.GetSegmentID()); err != nil {
+			log.Warn("failed to retire growing segment", zap.Int64("collectionID", segmentInfo.GetCollectionID()),
+				zap.Int64("segmentID", segmentInfo.GetSegmentID()), zap.Error(err))
+			continue
+		}
+		recordSegmentEvent(segmentEventHandedOff, segmentInfo.GetCollectionID(), segmentInfo.GetPartitionID(), segmentInfo.GetSegmentID(),
+			fmt.Sprintf("growing segment handed off to sealed segment on node %d", segmentInfo.GetNodeID()))
+		log.Info("retire growing segment handed off to historical", zap.Int64("collectionID", segmentInfo.GetCollectionID()),
+			zap.Int64("segmentID", segmentInfo.GetSegmentID()))
 	}
 }
 
@@ -505,6 +610,14 @@ func validateChangeChannel(info *querypb.SegmentChangeInfo) (string, error) {
 
 // remove the segments since it's already compacted or balanced to other QueryNodes
 func (node *QueryNode) removeSegments(segmentChangeInfos *querypb.SealedSegmentsChangeInfo) error {
+	// save delete snapshots of the segments to be loaded by other nodes
+	for _, info := range segmentChangeInfos.Infos {
+		if info.OfflineNodeID == Params.QueryNodeCfg.GetNodeID() {
+			for _, segmentInfo := range info.OfflineSegments {
+				node.saveDeleteSnapshot(segmentInfo.SegmentID)
+			}
+		}
+	}
 
 	node.streaming.replica.queryLock()
 	node.historical.replica.queryLock()
@@ -520,6 +633,8 @@ func (node *QueryNode) removeSegments(segmentChangeInfos *querypb.SealedSegments
 				if err != nil {
 					return err
 				}
+				recordSegmentEvent(segmentEventHandedOff, segmentInfo.CollectionID, segmentInfo.PartitionID, segmentInfo.SegmentID,
+					fmt.Sprintf("growing segment handed off to sealed segment on node %d by change info %d", segmentInfo.NodeID, segmentChangeInfos.Base.GetMsgID()))
 				log.Info("remove growing segment in removeSegments",
 					zap.Any("collectionID", segmentInfo.CollectionID),
 					zap.Any("segmentID", segmentInfo.SegmentID),
@@ -536,6 +651,8 @@ func (node *QueryNode) removeSegments(segmentChangeInfos *querypb.SealedSegments
 				if err != nil {
 					return err
 				}
+				recordSegmentEvent(segmentEventReleased, segmentInfo.CollectionID, segmentInfo.PartitionID, segmentInfo.SegmentID,
+					fmt.Sprintf("offline by change info %d of load balance or compaction", segmentChangeInfos.Base.GetMsgID()))
 				log.Info("remove sealed segment", zap.Any("collectionID", segmentInfo.CollectionID),
 					zap.Any("segmentID", segmentInfo.SegmentID),
 					zap.Any("infoID", segmentChangeInfos.Base.GetMsgID()),
@@ -545,3 +662,22 @@ func (node *QueryNode) removeSegments(segmentChangeInfos *querypb.SealedSegments
 	}
 	return nil
 }
+
+// saveDeleteSnapshot persists the applied deletes of the sealed segment being released in background, the deletes are
+// kept by the segment after it's released. Failure won't block the release since the delta logs could always be replayed.
+func (node *QueryNode) saveDeleteSnapshot(segmentID UniqueID) {
+	if !Params.QueryNodeCfg.DeleteSnapshotEnabled {
+		return
+	}
+	segment, err := node.historical.replica.getSegmentByID(segmentID)
+	if err != nil {
+		return
+	}
+	node.wg.Add(1)
+	go func() {
+		defer node.wg.Done()
+		if err := node.loader.saveDeleteSnapshot(segment); err != nil {
+			log.Warn("failed to save delete snapshot", zap.Int64("segmentID", segmentID), zap.Error(err))
+		}
+	}()
+}
@@ -655,3 +655,34 @@ func TestQueryNode_handleSealedSegmentsChangeInfo(t *testing.T) {
 
 	})
 }
+
+func TestQueryNode_retireGrowingSegments(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	qn, err := genSimpleQueryNode(ctx)
+	require.NoError(t, err)
+	require.True(t, qn.streaming.replica.hasSegment(defaultSegmentID))
+
+	// wait for the in-flight searches holding the query lock
+	qn.streaming.replica.queryRLock()
+	retired := make(chan struct{})
+	go func() {
+		defer close(retired)
+		qn.retireGrowingSegments(&querypb.SegmentChangeInfo{
+			OnlineSegments: []*querypb.SegmentInfo{
+				{CollectionID: defaultCollectionID, SegmentID: defaultSegmentID},
+				{CollectionID: defaultCollectionID, SegmentID: defaultSegmentID + 1},
+			},
+		})
+	}()
+	select {
+	case <-retired:
+		t.Fatal("growing segment retired while searching")
+	case <-time.After(10 * time.Millisecond):
+	}
+	assert.True(t, qn.streaming.replica.hasSegment(defaultSegmentID))
+	qn.streaming.replica.queryRUnlock()
+
+	<-retired
+	assert.False(t, qn.streaming.replica.hasSegment(defaultSegmentID))
+}
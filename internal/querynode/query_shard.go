@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/opentracing/opentracing-go"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
@@ -37,6 +38,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/logutil"
+	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -65,6 +68,8 @@ type queryShard struct {
 	vectorChunkManager *storage.VectorChunkManager
 	localCacheEnabled  bool
 	localCacheSize     int64
+
+	stats *queryShardStats
 }
 
 func newQueryShard(
@@ -93,8 +98,8 @@ func newQueryShard(
 	vectorChunkManager, err := storage.NewVectorChunkManager(localChunkManager, remoteChunkManager,
 		&etcdpb.CollectionMeta{
 			ID:     collectionID,
-			Schema: collection.schema,
-		}, Params.QueryNodeCfg.CacheMemoryLimit, localCacheEnabled)
+			Schema: collection.Schema(),
+		}, Params.QueryNodeCfg.CacheMemoryLimit.Load(), localCacheEnabled)
 	if err != nil {
 		return nil, err
 	}
@@ -110,6 +115,7 @@ func newQueryShard(
 		historical:         historical,
 		streaming:          streaming,
 		vectorChunkManager: vectorChunkManager,
+		stats:              newQueryShardStats(collectionID, channel, vectorChunkManager),
 
 		watcherCond: sync.NewCond(&sync.Mutex{}),
 	}
@@ -125,6 +131,7 @@ func newQueryShard(
 // Close cleans query shard
 func (q *queryShard) Close() {
 	q.cancel()
+	q.stats.removeMetrics()
 }
 
 func (q *queryShard) watchDMLTSafe() error {
@@ -237,9 +244,18 @@ func (q *queryShard) getNewTSafe(tp tsType) (Timestamp, error) {
 	return t, nil
 }
 
-func (q *queryShard) waitUntilServiceable(ctx context.Context, guaranteeTs Timestamp, tp tsType) {
+// waitUntilServiceable waits until the serviceable time reaches the guarantee timestamp, and returns the time waited
+func (q *queryShard) waitUntilServiceable(ctx context.Context, guaranteeTs Timestamp, tp tsType) time.Duration {
+	start := time.Now()
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "waitUntilServiceable",
+		opentracing.Tags{
+			"tsType":      tp.String(),
+			"guaranteeTs": guaranteeTs,
+		})
+	defer sp.Finish()
+
 	st := q.getServiceableTime(tp)
-	log.Debug("serviceable check start", zap.String("tsType", tp.String()), zap.Uint64("guarantee ts", guaranteeTs), zap.Uint64("serviceable ts", st), zap.String("channel", q.channel))
+	logutil.Logger(ctx).Debug("serviceable check start", zap.String("tsType", tp.String()), zap.Uint64("guarantee ts", guaranteeTs), zap.Uint64("serviceable ts", st), zap.String("channel", q.channel))
 	serviceable := func() bool {
 		st = q.getServiceableTime(tp)
 		return st >= guaranteeTs
@@ -248,16 +264,17 @@ func (q *queryShard) waitUntilServiceable(ctx context.Context, guaranteeTs Times
 	q.watcherCond.L.Lock()
 	defer q.watcherCond.L.Unlock()
 	for !serviceable() {
-		log.Debug("serviceable ts before guarantee ts", zap.Uint64("serviceable ts", st), zap.Uint64("guarantee ts", guaranteeTs), zap.String("channel", q.channel))
+		logutil.Logger(ctx).Debug("serviceable ts before guarantee ts", zap.Uint64("serviceable ts", st), zap.Uint64("guarantee ts", guaranteeTs), zap.String("channel", q.channel))
 		q.watcherCond.Wait()
 		if err := ctx.Err(); err != nil {
-			log.Warn("waitUntilServiceable timeout", zap.Uint64("serviceable ts", st), zap.Uint64("guarantee ts", guaranteeTs), zap.String("channel", q.channel))
+			logutil.Logger(ctx).Warn("waitUntilServiceable timeout", zap.Uint64("serviceable ts", st), zap.Uint64("guarantee ts", guaranteeTs), zap.String("channel", q.channel))
 			// TODO: implement timeout logic
-			return
+			return time.Since(start)
 		}
 		st = q.getServiceableTime(tp)
 	}
-	log.Debug("wait serviceable ts done", zap.String("tsType", tp.String()), zap.Uint64("guarantee ts", guaranteeTs), zap.Uint64("serviceable ts", st), zap.String("channel", q.channel))
+	logutil.Logger(ctx).Debug("wait serviceable ts done", zap.String("tsType", tp.String()), zap.Uint64("guarantee ts", guaranteeTs), zap.Uint64("serviceable ts", st), zap.String("channel", q.channel))
+	return time.Since(start)
 }
 
 func (q *queryShard) getServiceableTime(tp tsType) Timestamp {
@@ -303,11 +320,38 @@ func (q *queryShard) setServiceableTime(t Timestamp, tp tsType) {
 	}
 }
 
-func (q *queryShard) search(ctx context.Context, req *querypb.SearchRequest) (*internalpb.SearchResults, error) {
+func (q *queryShard) search(ctx context.Context, req *querypb.SearchRequest) (results *internalpb.SearchResults, err error) {
 	collectionID := req.Req.CollectionID
 	partitionIDs := req.Req.PartitionIDs
 	timestamp := req.Req.TravelTimestamp
 
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "queryShard.search",
+		opentracing.Tags{
+			"collectionID":  collectionID,
+			"channel":       q.channel,
+			"isShardLeader": req.IsShardLeader,
+		})
+	defer sp.Finish()
+
+	start := time.Now()
+	record := &slowQueryRecord{
+		kind:          slowQueryKindSearch,
+		reqID:         req.GetReq().GetBase().GetMsgID(),
+		collectionID:  collectionID,
+		channel:       q.channel,
+		isShardLeader: req.IsShardLeader,
+		dsl:           req.Req.Dsl,
+	}
+	if req.Req.GetDslType() == commonpb.DslType_BoolExprV1 {
+		record.serializedPlan = req.Req.SerializedExprPlan
+	}
+	defer func() {
+		trace.LogError(sp, err)
+		elapsed := time.Since(start)
+		record.log(elapsed, err)
+		q.stats.observe(record, elapsed, err)
+	}()
+
 	// check ctx timeout
 	if !funcutil.CheckCtxValid(ctx) {
 		return nil, errors.New("search context timeout")
@@ -329,28 +373,36 @@ func (q *queryShard) search(ctx context.Context, req *querypb.SearchRequest) (*i
 		return nil, err
 	}
 	if req.GetReq().GetGuaranteeTimestamp() >= collection.getReleaseTime() {
-		log.Warn("collection release before search", zap.Int64("collectionID", collectionID))
+		logutil.Logger(ctx).Warn("collection release before search", zap.Int64("collectionID", collectionID))
 		return nil, fmt.Errorf("retrieve failed, collection has been released, collectionID = %d", collectionID)
 	}
 
 	// deserialize query plan
 	var plan *SearchPlan
 	if req.Req.GetDslType() == commonpb.DslType_BoolExprV1 {
-		expr := req.Req.SerializedExprPlan
-		plan, err = createSearchPlanByExpr(collection, expr)
+		// validate the search params against the index loaded, and fill the ef of the search on HNSW indexes
+		expr, err := fillSearchParams(req.Req.SerializedExprPlan, func(fieldID int64) map[string]string {
+			return q.historical.replica.getFieldIndexParams(collectionID, fieldID)
+		})
+		if err != nil {
+			return nil, err
+		}
+		var release func()
+		plan, release, err = collection.acquireSearchPlan(expr)
 		if err != nil {
 			return nil, err
 		}
+		defer release()
 	} else {
 		dsl := req.Req.Dsl
 		plan, err = createSearchPlan(collection, dsl)
 		if err != nil {
 			return nil, err
 		}
+		defer plan.delete()
 	}
-	defer plan.delete()
 
-	schemaHelper, err := typeutil.CreateSchemaHelper(collection.schema)
+	schemaHelper, err := typeutil.CreateSchemaHelper(collection.Schema())
 	if err != nil {
 		return nil, err
 	}
@@ -370,25 +422,34 @@ func (q *queryShard) search(ctx context.Context, req *querypb.SearchRequest) (*i
 	queryNum := searchReq.getNumOfQuery()
 	searchRequests := []*searchRequest{searchReq}
 
+	record.topK, record.nq = topK, queryNum
+
 	if req.IsShardLeader {
-		return q.searchLeader(ctx, req, searchRequests, collectionID, partitionIDs, schemaHelper, plan, topK, queryNum, timestamp)
+		return q.searchLeader(ctx, req, searchRequests, collectionID, partitionIDs, schemaHelper, plan, topK, queryNum, timestamp, record)
 	}
-	return q.searchFollower(ctx, req, searchRequests, collectionID, partitionIDs, schemaHelper, plan, topK, queryNum, timestamp)
+	return q.searchFollower(ctx, req, searchRequests, collectionID, partitionIDs, schemaHelper, plan, topK, queryNum, timestamp, record)
 }
 
 func (q *queryShard) searchLeader(ctx context.Context, req *querypb.SearchRequest, searchRequests []*searchRequest, collectionID UniqueID, partitionIDs []UniqueID,
-	schemaHelper *typeutil.SchemaHelper, plan *SearchPlan, topK int64, queryNum int64, timestamp Timestamp) (*internalpb.SearchResults, error) {
+	schemaHelper *typeutil.SchemaHelper, plan *SearchPlan, topK int64, queryNum int64, timestamp Timestamp, record *slowQueryRecord) (*internalpb.SearchResults, error) {
 	cluster, ok := q.clusterService.getShardCluster(req.GetDmlChannel())
 	if !ok {
 		return nil, fmt.Errorf("channel %s leader is not here", req.GetDmlChannel())
 	}
 
+	// the sealed segments and the growing segments to search are decided at once
+	snapshot, err := cluster.acquireSealed(req.GetDmlChannel(), partitionIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer cluster.releaseSealed(snapshot)
+	record.numSegments = len(snapshot.segments)
+
 	searchCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	var results []*internalpb.SearchResults
 	var streamingResults []*SearchResult
-	var err error
 	var mut sync.Mutex
 	var wg sync.WaitGroup
 
@@ -397,11 +458,11 @@ func (q *queryShard) searchLeader(ctx context.Context, req *querypb.SearchReques
 	go func() {
 		defer wg.Done()
 		// shard leader dispatches request to its shard cluster
-		cResults, cErr := cluster.Search(searchCtx, req)
+		cResults, cErr := cluster.searchSealed(searchCtx, req, snapshot)
 		mut.Lock()
 		defer mut.Unlock()
 		if cErr != nil {
-			log.Warn("search cluster failed", zap.Int64("collectionID", q.collectionID), zap.Error(cErr))
+			logutil.Logger(ctx).Warn("search cluster failed", zap.Int64("collectionID", q.collectionID), zap.Error(cErr))
 			err = cErr
 			cancel()
 			return
@@ -413,14 +474,23 @@ func (q *queryShard) searchLeader(ctx context.Context, req *querypb.SearchReques
 	go func() {
 		defer wg.Done()
 		guaranteeTs := req.GetReq().GetGuaranteeTimestamp()
-		q.waitUntilServiceable(ctx, guaranteeTs, tsTypeDML) // wait until guarantee timestamp >= service timestamp
+		waitTSafe := q.waitUntilServiceable(ctx, guaranteeTs, tsTypeDML) // wait until guarantee timestamp >= service timestamp
 		// shard leader queries its own streaming data
 		// TODO add context
-		sResults, _, _, sErr := q.streaming.search(searchRequests, collectionID, partitionIDs, req.DmlChannel, plan, timestamp)
+		streamingSp, _ := trace.StartSpanFromContextWithOperationName(ctx, "searchStreaming")
+		segcoreStart := time.Now()
+		sResults, searchedSegments, _, sErr := q.streaming.search(ctx, searchRequests, collectionID, partitionIDs, req.DmlChannel, plan, timestamp,
+			func(segment *Segment) bool { return !snapshot.contains(segment.segmentID) })
+		segcore := time.Since(segcoreStart)
+		trace.LogError(streamingSp, sErr)
+		streamingSp.Finish()
 		mut.Lock()
 		defer mut.Unlock()
+		record.waitTSafe, record.segcore = waitTSafe, segcore
+		record.numSegments += len(searchedSegments)
+		record.rowsScanned += rowsOfSegments(q.streaming.replica, searchedSegments)
 		if sErr != nil {
-			log.Warn("failed to search streaming data", zap.Int64("collectionID", q.collectionID), zap.Error(sErr))
+			logutil.Logger(ctx).Warn("failed to search streaming data", zap.Int64("collectionID", q.collectionID), zap.Error(sErr))
 			err = sErr
 			cancel()
 			return
@@ -429,12 +499,11 @@ func (q *queryShard) searchLeader(ctx context.Context, req *querypb.SearchReques
 	}()
 
 	wg.Wait()
+	defer deleteSearchResults(streamingResults)
 	if err != nil {
 		return nil, err
 	}
 
-	defer deleteSearchResults(streamingResults)
-
 	results = append(results, &internalpb.SearchResults{
 		Status:         &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
 		MetricType:     plan.getMetricType(),
@@ -446,6 +515,8 @@ func (q *queryShard) searchLeader(ctx context.Context, req *querypb.SearchReques
 	})
 
 	// reduce streaming results and transform to blob
+	reduceStart := time.Now()
+	defer func() { record.reduce = time.Since(reduceStart) }()
 	if len(streamingResults) > 0 {
 		numSegment := int64(len(streamingResults))
 		err = reduceSearchResultsAndFillData(plan, streamingResults, numSegment)
@@ -458,21 +529,21 @@ func (q *queryShard) searchLeader(ctx context.Context, req *querypb.SearchReques
 		nqPerSlice := nq
 		reqSlices, err := getReqSlices(nqOfReqs, nqPerSlice)
 		if err != nil {
-			log.Warn("getReqSlices for streaming results error", zap.Error(err))
+			logutil.Logger(ctx).Warn("getReqSlices for streaming results error", zap.Error(err))
 			return nil, err
 		}
 
 		blobs, err := marshal(collectionID, 0, streamingResults, plan, int(numSegment), reqSlices)
 		defer deleteSearchResultDataBlobs(blobs)
 		if err != nil {
-			log.Warn("marshal for streaming results error", zap.Error(err))
+			logutil.Logger(ctx).Warn("marshal for streaming results error", zap.Error(err))
 			return nil, err
 		}
 
 		// assume only one blob will be sent back
 		blob, err := getSearchResultDataBlob(blobs, 0)
 		if err != nil {
-			log.Warn("getSearchResultDataBlob for streaming results error", zap.Error(err))
+			logutil.Logger(ctx).Warn("getSearchResultDataBlob for streaming results error", zap.Error(err))
 		}
 
 		results[len(results)-1].SlicedBlob = blob
@@ -481,13 +552,13 @@ func (q *queryShard) searchLeader(ctx context.Context, req *querypb.SearchReques
 	// reduce shard search results: unmarshal -> reduce -> marshal
 	searchResultData, err := decodeSearchResults(results)
 	if err != nil {
-		log.Warn("shard leader decode search results errors", zap.Error(err))
+		logutil.Logger(ctx).Warn("shard leader decode search results errors", zap.Error(err))
 		return nil, err
 	}
-	log.Debug("shard leader get valid search results", zap.Int("numbers", len(searchResultData)))
+	logutil.Logger(ctx).Debug("shard leader get valid search results", zap.Int("numbers", len(searchResultData)))
 
 	for i, sData := range searchResultData {
-		log.Debug("reduceSearchResultData",
+		logutil.Logger(ctx).Debug("reduceSearchResultData",
 			zap.Int("result No.", i),
 			zap.Int64("nq", sData.NumQueries),
 			zap.Int64("topk", sData.TopK),
@@ -496,19 +567,21 @@ func (q *queryShard) searchLeader(ctx context.Context, req *querypb.SearchReques
 
 	reducedResultData, err := reduceSearchResultData(searchResultData, queryNum, plan.getTopK(), plan)
 	if err != nil {
-		log.Warn("shard leader reduce errors", zap.Error(err))
+		logutil.Logger(ctx).Warn("shard leader reduce errors", zap.Error(err))
 		return nil, err
 	}
 	searchResults, err := encodeSearchResultData(reducedResultData, queryNum, plan.getTopK(), plan.getMetricType())
+	// reduced result has been marshaled into blob, give its buffers back
+	recycleSearchResultData(reducedResultData)
 	if err != nil {
-		log.Warn("shard leader encode search result errors", zap.Error(err))
+		logutil.Logger(ctx).Warn("shard leader encode search result errors", zap.Error(err))
 		return nil, err
 	}
 	if searchResults.SlicedBlob == nil {
-		log.Debug("shard leader send nil results to proxy",
+		logutil.Logger(ctx).Debug("shard leader send nil results to proxy",
 			zap.String("shard", q.channel))
 	} else {
-		log.Debug("shard leader send non-nil results to proxy",
+		logutil.Logger(ctx).Debug("shard leader send non-nil results to proxy",
 			zap.String("shard", q.channel))
 		// printSearchResultData(reducedResultData, q.channel)
 	}
@@ -516,26 +589,32 @@ func (q *queryShard) searchLeader(ctx context.Context, req *querypb.SearchReques
 }
 
 func (q *queryShard) searchFollower(ctx context.Context, req *querypb.SearchRequest, searchRequests []*searchRequest, collectionID UniqueID, partitionIDs []UniqueID,
-	schemaHelper *typeutil.SchemaHelper, plan *SearchPlan, topK int64, queryNum int64, timestamp Timestamp) (*internalpb.SearchResults, error) {
+	schemaHelper *typeutil.SchemaHelper, plan *SearchPlan, topK int64, queryNum int64, timestamp Timestamp, record *slowQueryRecord) (*internalpb.SearchResults, error) {
 	segmentIDs := req.GetSegmentIDs()
 	// hold request until guarantee timestamp >= service timestamp
 	guaranteeTs := req.GetReq().GetGuaranteeTimestamp()
-	q.waitUntilServiceable(ctx, guaranteeTs, tsTypeDelta)
+	record.waitTSafe = q.waitUntilServiceable(ctx, guaranteeTs, tsTypeDelta)
 
 	// validate segmentIDs in request
 	err := q.historical.validateSegmentIDs(segmentIDs, collectionID, partitionIDs)
 	if err != nil {
-		log.Warn("segmentIDs in search request fails validation", zap.Int64s("segmentIDs", segmentIDs))
+		logutil.Logger(ctx).Warn("segmentIDs in search request fails validation", zap.Int64s("segmentIDs", segmentIDs))
 		return nil, err
 	}
 
-	historicalResults, _, err := q.historical.searchSegments(segmentIDs, searchRequests, plan, timestamp)
+	segcoreStart := time.Now()
+	historicalResults, searchedSegments, err := q.historical.searchSegments(ctx, segmentIDs, searchRequests, plan, timestamp)
+	record.segcore = time.Since(segcoreStart)
+	record.numSegments = len(searchedSegments)
+	record.rowsScanned = rowsOfSegments(q.historical.replica, searchedSegments)
 	if err != nil {
 		return nil, err
 	}
 	defer deleteSearchResults(historicalResults)
 
 	// reduce search results
+	reduceStart := time.Now()
+	defer func() { record.reduce = time.Since(reduceStart) }()
 	numSegment := int64(len(historicalResults))
 	err = reduceSearchResultsAndFillData(plan, historicalResults, numSegment)
 	if err != nil {
@@ -547,21 +626,21 @@ func (q *queryShard) searchFollower(ctx context.Context, req *querypb.SearchRequ
 	nqPerSlice := nq
 	reqSlices, err := getReqSlices(nqOfReqs, nqPerSlice)
 	if err != nil {
-		log.Warn("getReqSlices for historical results error", zap.Error(err))
+		logutil.Logger(ctx).Warn("getReqSlices for historical results error", zap.Error(err))
 		return nil, err
 	}
 
 	blobs, err := marshal(collectionID, 0, historicalResults, plan, int(numSegment), reqSlices)
 	defer deleteSearchResultDataBlobs(blobs)
 	if err != nil {
-		log.Warn("marshal for historical results error", zap.Error(err))
+		logutil.Logger(ctx).Warn("marshal for historical results error", zap.Error(err))
 		return nil, err
 	}
 
 	// assume only one blob will be sent back
 	blob, err := getSearchResultDataBlob(blobs, 0)
 	if err != nil {
-		log.Warn("getSearchResultDataBlob for historical results error", zap.Error(err))
+		logutil.Logger(ctx).Warn("getSearchResultDataBlob for historical results error", zap.Error(err))
 	}
 	bs := make([]byte, len(blob))
 	copy(bs, blob)
@@ -575,7 +654,7 @@ func (q *queryShard) searchFollower(ctx context.Context, req *querypb.SearchRequ
 		SlicedOffset:   1,
 		SlicedNumCount: 1,
 	}
-	log.Debug("shard follower send search result to leader")
+	logutil.Logger(ctx).Debug("shard follower send search result to leader")
 	return resp, nil
 }
 
@@ -590,28 +669,68 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 			Topks:      make([]int64, 0),
 		}, nil
 	}
+	// the result size is bounded by both nq * topk and the number of input results
+	var resultSize int64
+	for _, data := range searchResultData {
+		resultSize += int64(len(data.Scores))
+	}
+	if nq*topk < resultSize {
+		resultSize = nq * topk
+	}
 	ret := &schemapb.SearchResultData{
 		NumQueries: nq,
 		TopK:       topk,
 		FieldsData: make([]*schemapb.FieldData, len(searchResultData[0].FieldsData)),
-		Scores:     make([]float32, 0),
+		Scores:     getFloat32Slice(int(resultSize)),
 		Ids:        &schemapb.IDs{},
-		Topks:      make([]int64, 0),
+		Topks:      getInt64Slice(int(nq)),
+	}
+	switch searchResultData[0].GetIds().GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		ret.Ids.IdField = &schemapb.IDs_IntId{
+			IntId: &schemapb.LongArray{
+				Data: getInt64Slice(int(resultSize)),
+			},
+		}
+	case *schemapb.IDs_StrId:
+		// the ids are VarChar primary keys, which are not pooled
+		ret.Ids.IdField = &schemapb.IDs_StrId{
+			StrId: &schemapb.StringArray{
+				Data: make([]string, 0, resultSize),
+			},
+		}
 	}
 
 	resultOffsets := make([][]int64, len(searchResultData))
 	for i := 0; i < len(searchResultData); i++ {
-		resultOffsets[i] = make([]int64, len(searchResultData[i].Topks))
+		resultOffsets[i] = getInt64Slice(len(searchResultData[i].Topks))[:len(searchResultData[i].Topks)]
+		if len(resultOffsets[i]) > 0 {
+			resultOffsets[i][0] = 0
+		}
 		for j := int64(1); j < nq; j++ {
 			resultOffsets[i][j] = resultOffsets[i][j-1] + searchResultData[i].Topks[j-1]
 		}
 	}
+	offsets := getInt64Slice(len(searchResultData))[:len(searchResultData)]
+	idSet := getIDSet()
+	defer func() {
+		for _, resultOffset := range resultOffsets {
+			putInt64Slice(resultOffset)
+		}
+		putInt64Slice(offsets)
+		putIDSet(idSet)
+	}()
 
-	var skipDupCnt int64
+	searchRange := plan.searchRange
+	var skipDupCnt, skipOutOfRangeCnt int64
 	for i := int64(0); i < nq; i++ {
-		offsets := make([]int64, len(searchResultData))
+		for k := range offsets {
+			offsets[k] = 0
+		}
+		for id := range idSet {
+			delete(idSet, id)
+		}
 
-		var idSet = make(map[interface{}]struct{})
 		var j int64
 		for j = 0; j < topk; {
 			sel := selectSearchResultData(searchResultData, resultOffsets, offsets, i)
@@ -623,6 +742,16 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 			id := typeutil.GetPK(searchResultData[sel].GetIds(), idx)
 			score := searchResultData[sel].Scores[idx]
 
+			// the results are merged in the order of the scores, the ones after the first beyond the radius are too
+			if searchRange != nil && !searchRange.Contains(score) {
+				if searchRange.Beyond(score) {
+					break
+				}
+				skipOutOfRangeCnt++
+				offsets[sel]++
+				continue
+			}
+
 			// remove duplicates
 			if _, ok := idSet[id]; !ok {
 				typeutil.AppendFieldData(ret.FieldsData, searchResultData[sel].FieldsData, idx)
@@ -638,7 +767,7 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 		}
 		ret.Topks = append(ret.Topks, j)
 	}
-	log.Debug("skip duplicated search result", zap.Int64("count", skipDupCnt), zap.Any("ret", ret))
+	log.Debug("skip duplicated search result", zap.Int64("count", skipDupCnt), zap.Int64("outOfRange", skipOutOfRangeCnt), zap.Any("ret", ret))
 	// Note: query shard does not check whether the metricType is positively related, proxy will do the job
 	return ret, nil
 }
@@ -688,23 +817,48 @@ func encodeSearchResultData(searchResultData *schemapb.SearchResultData, nq int6
 		MetricType: metricType,
 		SlicedBlob: nil,
 	}
-	slicedBlob, err := proto.Marshal(searchResultData)
+	// skip marshaling empty result
+	if searchResultData == nil || searchResultData.Ids == nil || typeutil.GetSizeOfIDs(searchResultData.Ids) == 0 {
+		return
+	}
+	searchResults.SlicedBlob, err = proto.Marshal(searchResultData)
 	if err != nil {
 		return nil, err
 	}
-	if searchResultData != nil && searchResultData.Ids != nil && typeutil.GetSizeOfIDs(searchResultData.Ids) != 0 {
-		searchResults.SlicedBlob = slicedBlob
-	}
 	return
 }
 
-func (q *queryShard) query(ctx context.Context, req *querypb.QueryRequest) (*internalpb.RetrieveResults, error) {
+func (q *queryShard) query(ctx context.Context, req *querypb.QueryRequest) (result *internalpb.RetrieveResults, err error) {
 	collectionID := req.Req.CollectionID
 	segmentIDs := req.SegmentIDs
 	partitionIDs := req.Req.PartitionIDs
 	expr := req.Req.SerializedExprPlan
 	timestamp := req.Req.TravelTimestamp
 
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "queryShard.query",
+		opentracing.Tags{
+			"collectionID":  collectionID,
+			"channel":       q.channel,
+			"isShardLeader": req.IsShardLeader,
+		})
+	defer sp.Finish()
+
+	start := time.Now()
+	record := &slowQueryRecord{
+		kind:           slowQueryKindQuery,
+		reqID:          req.GetReq().GetBase().GetMsgID(),
+		collectionID:   collectionID,
+		channel:        q.channel,
+		isShardLeader:  req.IsShardLeader,
+		serializedPlan: expr,
+	}
+	defer func() {
+		trace.LogError(sp, err)
+		elapsed := time.Since(start)
+		record.log(elapsed, err)
+		q.stats.observe(record, elapsed, err)
+	}()
+
 	// check ctx timeout
 	if !funcutil.CheckCtxValid(ctx) {
 		return nil, errors.New("search context timeout")
@@ -727,15 +881,17 @@ func (q *queryShard) query(ctx context.Context, req *querypb.QueryRequest) (*int
 	}
 
 	if req.GetReq().GetGuaranteeTimestamp() >= collection.getReleaseTime() {
-		log.Warn("collection release before query", zap.Int64("collectionID", collectionID))
+		logutil.Logger(ctx).Warn("collection release before query", zap.Int64("collectionID", collectionID))
 		return nil, fmt.Errorf("retrieve failed, collection has been released, collectionID = %d", collectionID)
 	}
 	// deserialize query plan
-	plan, err := createRetrievePlanByExpr(collection, expr, timestamp)
+	plan, release, err := collection.acquireRetrievePlan(expr, timestamp)
 	if err != nil {
 		return nil, err
 	}
-	defer plan.delete()
+	defer release()
+	// the queries by primary keys skip the segments which couldn't contain any of the keys
+	pks, byPKs := getTermPKs(expr)
 
 	if req.IsShardLeader {
 		cluster, ok := q.clusterService.getShardCluster(req.GetDmlChannel())
@@ -743,13 +899,20 @@ func (q *queryShard) query(ctx context.Context, req *querypb.QueryRequest) (*int
 			return nil, fmt.Errorf("channel %s leader is not here", req.GetDmlChannel())
 		}
 
+		// the sealed segments and the growing segments to query are decided at once
+		snapshot, err := cluster.acquireSealed(req.GetDmlChannel(), partitionIDs)
+		if err != nil {
+			return nil, err
+		}
+		defer cluster.releaseSealed(snapshot)
+		record.numSegments = len(snapshot.segments)
+
 		// add cancel when error occurs
 		queryCtx, cancel := context.WithCancel(ctx)
 		defer cancel()
 
 		var results []*internalpb.RetrieveResults
 		var streamingResults []*segcorepb.RetrieveResults
-		var err error
 		var mut sync.Mutex
 		var wg sync.WaitGroup
 
@@ -758,12 +921,12 @@ func (q *queryShard) query(ctx context.Context, req *querypb.QueryRequest) (*int
 		go func() {
 			defer wg.Done()
 			// shard leader dispatches request to its shard cluster
-			cResults, cErr := cluster.Query(queryCtx, req)
+			cResults, cErr := cluster.querySealed(queryCtx, req, snapshot)
 			mut.Lock()
 			defer mut.Unlock()
 			if cErr != nil {
 				err = cErr
-				log.Warn("failed to query cluster", zap.Int64("collectionID", q.collectionID), zap.Error(cErr))
+				logutil.Logger(ctx).Warn("failed to query cluster", zap.Int64("collectionID", q.collectionID), zap.Error(cErr))
 				cancel()
 				return
 			}
@@ -774,15 +937,27 @@ func (q *queryShard) query(ctx context.Context, req *querypb.QueryRequest) (*int
 			defer wg.Done()
 			// hold request until guarantee timestamp >= service timestamp
 			guaranteeTs := req.GetReq().GetGuaranteeTimestamp()
-			q.waitUntilServiceable(ctx, guaranteeTs, tsTypeDML)
+			waitTSafe := q.waitUntilServiceable(ctx, guaranteeTs, tsTypeDML)
 			// shard leader queries its own streaming data
 			// TODO add context
-			sResults, _, _, sErr := q.streaming.retrieve(collectionID, partitionIDs, plan, func(segment *Segment) bool { return segment.vChannelID == q.channel })
+			streamingSp, _ := trace.StartSpanFromContextWithOperationName(ctx, "retrieveStreaming")
+			segcoreStart := time.Now()
+			sResults, retrievedSegments, _, sErr := q.streaming.retrieve(ctx, collectionID, partitionIDs, plan,
+				func(segment *Segment) bool {
+					return segment.vChannelID == q.channel && !snapshot.contains(segment.segmentID) &&
+						(!byPKs || mayContainAnyPK(segment, pks))
+				})
+			segcore := time.Since(segcoreStart)
+			trace.LogError(streamingSp, sErr)
+			streamingSp.Finish()
 			mut.Lock()
 			defer mut.Unlock()
+			record.waitTSafe, record.segcore = waitTSafe, segcore
+			record.numSegments += len(retrievedSegments)
+			record.rowsScanned += rowsOfSegments(q.streaming.replica, retrievedSegments)
 			if sErr != nil {
 				err = sErr
-				log.Warn("failed to query streaming", zap.Int64("collectionID", q.collectionID), zap.Error(err))
+				logutil.Logger(ctx).Warn("failed to query streaming", zap.Int64("collectionID", q.collectionID), zap.Error(err))
 				cancel()
 				return
 			}
@@ -794,6 +969,8 @@ func (q *queryShard) query(ctx context.Context, req *querypb.QueryRequest) (*int
 			return nil, err
 		}
 
+		reduceStart := time.Now()
+		defer func() { record.reduce = time.Since(reduceStart) }()
 		streamingResult, err := mergeRetrieveResults(streamingResults)
 		if err != nil {
 			return nil, err
@@ -810,30 +987,39 @@ func (q *queryShard) query(ctx context.Context, req *querypb.QueryRequest) (*int
 		if err != nil {
 			return nil, err
 		}
-		log.Debug("leader retrieve result", zap.String("channel", req.DmlChannel), zap.String("ids", mergedResults.Ids.String()))
+		logutil.Logger(ctx).Debug("leader retrieve result", zap.String("channel", req.DmlChannel), zap.String("ids", mergedResults.Ids.String()))
 		return mergedResults, nil
 	}
 
 	// hold request until guarantee timestamp >= service timestamp
 	guaranteeTs := req.GetReq().GetGuaranteeTimestamp()
-	q.waitUntilServiceable(ctx, guaranteeTs, tsTypeDelta)
+	record.waitTSafe = q.waitUntilServiceable(ctx, guaranteeTs, tsTypeDelta)
 
 	// validate segmentIDs in request
 	err = q.historical.validateSegmentIDs(segmentIDs, collectionID, partitionIDs)
 	if err != nil {
-		log.Warn("segmentIDs in query request fails validation", zap.Int64s("segmentIDs", segmentIDs))
+		logutil.Logger(ctx).Warn("segmentIDs in query request fails validation", zap.Int64s("segmentIDs", segmentIDs))
 		return nil, err
 	}
-	retrieveResults, err := q.historical.retrieveBySegmentIDs(collectionID, segmentIDs, q.vectorChunkManager, plan)
+	if byPKs {
+		segmentIDs = filterSegmentIDsByPKs(q.historical.replica, segmentIDs, pks)
+	}
+	segcoreStart := time.Now()
+	retrieveResults, err := q.historical.retrieveBySegmentIDs(ctx, collectionID, segmentIDs, q.vectorChunkManager, plan)
+	record.segcore = time.Since(segcoreStart)
+	record.numSegments = len(segmentIDs)
+	record.rowsScanned = rowsOfSegments(q.historical.replica, segmentIDs)
 	if err != nil {
 		return nil, err
 	}
+	reduceStart := time.Now()
 	mergedResult, err := mergeRetrieveResults(retrieveResults)
+	record.reduce = time.Since(reduceStart)
 	if err != nil {
 		return nil, err
 	}
 
-	log.Debug("follower retrieve result", zap.String("ids", mergedResult.Ids.String()))
+	logutil.Logger(ctx).Debug("follower retrieve result", zap.String("ids", mergedResult.Ids.String()))
 	RetrieveResults := &internalpb.RetrieveResults{
 		Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
 		Ids:        mergedResult.Ids,
@@ -842,6 +1028,21 @@ func (q *queryShard) query(ctx context.Context, req *querypb.QueryRequest) (*int
 	return RetrieveResults, nil
 }
 
+// rowsOfSegments returns the total number of rows of the segments in the replica
+func rowsOfSegments(replica ReplicaInterface, segmentIDs []UniqueID) int64 {
+	var rows int64
+	for _, segmentID := range segmentIDs {
+		segment, err := replica.getSegmentByID(segmentID)
+		if err != nil {
+			continue
+		}
+		if rowCount := segment.getRowCount(); rowCount > 0 {
+			rows += rowCount
+		}
+	}
+	return rows
+}
+
 // TODO: largely based on function mergeRetrieveResults, need rewriting
 func mergeInternalRetrieveResults(retrieveResults []*internalpb.RetrieveResults) (*internalpb.RetrieveResults, error) {
 	var ret *internalpb.RetrieveResults
@@ -20,12 +20,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"go.uber.org/zap"
 )
 
@@ -112,6 +114,19 @@ func (q *queryShardService) removeQueryShard(channel Channel) error {
 	return nil
 }
 
+// releaseQueryShard closes the query shard of the channel and removes it, do nothing if the shard does not exist
+func (q *queryShardService) releaseQueryShard(channel Channel) {
+	q.queryShardsMu.Lock()
+	defer q.queryShardsMu.Unlock()
+	queryShard, ok := q.queryShards[channel]
+	if !ok {
+		return
+	}
+	queryShard.Close()
+	delete(q.queryShards, channel)
+	log.Info("release query shard in query shard service", zap.String("channel", channel))
+}
+
 func (q *queryShardService) hasQueryShard(channel Channel) bool {
 	q.queryShardsMu.Lock()
 	defer q.queryShardsMu.Unlock()
@@ -128,6 +143,52 @@ func (q *queryShardService) getQueryShard(channel Channel) (*queryShard, error)
 	return q.queryShards[channel], nil
 }
 
+// serviceableTimes returns the serviceable time of the sealed segments of each vchannel
+func (q *queryShardService) serviceableTimes() map[string]Timestamp {
+	if q == nil {
+		return nil
+	}
+	q.queryShardsMu.Lock()
+	defer q.queryShardsMu.Unlock()
+	times := make(map[string]Timestamp, len(q.queryShards))
+	for channel, qs := range q.queryShards {
+		times[channel] = qs.getServiceableTime(tsTypeDelta)
+	}
+	return times
+}
+
+// diskCacheUsage returns the bytes of the vector data cached locally by the query shards and the limit of them
+func (q *queryShardService) diskCacheUsage() (usage int64, capacity int64) {
+	if q == nil {
+		return 0, 0
+	}
+	q.queryShardsMu.Lock()
+	defer q.queryShardsMu.Unlock()
+	for _, qs := range q.queryShards {
+		size, limit := qs.vectorChunkManager.CacheUsage()
+		usage += size
+		capacity += limit
+	}
+	return usage, capacity
+}
+
+// shardStats returns the statistics of the searches and queries of each query shard
+func (q *queryShardService) shardStats() []metricsinfo.QueryShardStats {
+	if q == nil {
+		return nil
+	}
+	q.queryShardsMu.Lock()
+	defer q.queryShardsMu.Unlock()
+	stats := make([]metricsinfo.QueryShardStats, 0, len(q.queryShards))
+	for _, qs := range q.queryShards {
+		stats = append(stats, qs.stats.describe())
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Channel < stats[j].Channel
+	})
+	return stats
+}
+
 func (q *queryShardService) close() {
 	log.Warn("Close query shard service")
 	q.cancel()
@@ -33,8 +33,10 @@ func TestQueryShardService(t *testing.T) {
 	assert.NoError(t, err)
 	found1 := qss.hasQueryShard("vchan1")
 	assert.Equal(t, true, found1)
-	_, err = qss.getQueryShard("vchan1")
+	qs, err := qss.getQueryShard("vchan1")
 	assert.NoError(t, err)
+	qs.setServiceableTime(100, tsTypeDelta)
+	assert.Equal(t, map[string]Timestamp{"vchan1": qs.getServiceableTime(tsTypeDelta)}, qss.serviceableTimes())
 	err = qss.removeQueryShard("vchan1")
 	assert.NoError(t, err)
 
@@ -44,6 +46,9 @@ func TestQueryShardService(t *testing.T) {
 	assert.Error(t, err)
 	err = qss.removeQueryShard("vchan2")
 	assert.Error(t, err)
+
+	var nilService *queryShardService
+	assert.Nil(t, nilService.serviceableTimes())
 }
 
 func TestQueryShardService_InvalidChunkManager(t *testing.T) {
@@ -0,0 +1,166 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+// shardLatencyBucketsMs are the upper bounds of the latency buckets of the shard statistics in milliseconds
+var shardLatencyBucketsMs = [...]int64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000, 2000, 5000}
+
+// queryShardStats accumulates the statistics of the searches and queries served by a query shard,
+// so that the hot shards could be identified and balanced.
+type queryShardStats struct {
+	collectionID UniqueID
+	channel      Channel
+	// vcm is the local vector cache the query results are filled with, nil if not used
+	vcm *storage.VectorChunkManager
+
+	mu          sync.Mutex
+	window      qpsWindow
+	searchCount int64
+	queryCount  int64
+	failedCount int64
+	// latencyBuckets counts the requests per bucket of shardLatencyBucketsMs, the last one is without bound
+	latencyBuckets [len(shardLatencyBucketsMs) + 1]int64
+	totalLatency   time.Duration
+	maxLatency     time.Duration
+	rowsScanned    int64
+	totalReduce    time.Duration
+}
+
+func newQueryShardStats(collectionID UniqueID, channel Channel, vcm *storage.VectorChunkManager) *queryShardStats {
+	return &queryShardStats{
+		collectionID: collectionID,
+		channel:      channel,
+		vcm:          vcm,
+	}
+}
+
+// observe records the search or query served by the shard
+func (s *queryShardStats) observe(record *slowQueryRecord, elapsed time.Duration, err error) {
+	s.mu.Lock()
+	s.window.record(time.Now().Unix())
+	if record.kind == slowQueryKindSearch {
+		s.searchCount++
+	} else {
+		s.queryCount++
+	}
+	if err != nil {
+		s.failedCount++
+	}
+	s.latencyBuckets[latencyBucketOf(elapsed)]++
+	s.totalLatency += elapsed
+	if elapsed > s.maxLatency {
+		s.maxLatency = elapsed
+	}
+	s.rowsScanned += record.rowsScanned
+	s.totalReduce += record.reduce
+	s.mu.Unlock()
+
+	nodeID := fmt.Sprint(Params.QueryNodeCfg.GetNodeID())
+	collectionID := fmt.Sprint(s.collectionID)
+	queryType := metrics.SearchLabel
+	if record.kind == slowQueryKindQuery {
+		queryType = metrics.QueryLabel
+	}
+	status := metrics.SuccessLabel
+	if err != nil {
+		status = metrics.FailLabel
+	}
+	metrics.QueryNodeShardSQCount.WithLabelValues(nodeID, collectionID, s.channel, queryType, status).Inc()
+	metrics.QueryNodeShardSQLatency.WithLabelValues(nodeID, collectionID, s.channel, queryType).Observe(float64(elapsed.Milliseconds()))
+	metrics.QueryNodeShardReduceLatency.WithLabelValues(nodeID, collectionID, s.channel, queryType).Observe(float64(record.reduce.Milliseconds()))
+	metrics.QueryNodeShardScannedRows.WithLabelValues(nodeID, collectionID, s.channel, queryType).Add(float64(record.rowsScanned))
+	if hitRate, ok := s.cacheHitRate(); ok {
+		metrics.QueryNodeShardCacheHitRatio.WithLabelValues(nodeID, collectionID, s.channel).Set(hitRate)
+	}
+}
+
+func latencyBucketOf(elapsed time.Duration) int {
+	ms := elapsed.Milliseconds()
+	for i, bound := range shardLatencyBucketsMs {
+		if ms <= bound {
+			return i
+		}
+	}
+	return len(shardLatencyBucketsMs)
+}
+
+// cacheHitRate returns the hit rate of the local vector cache, false if the cache has never been read
+func (s *queryShardStats) cacheHitRate() (float64, bool) {
+	if s.vcm == nil {
+		return 0, false
+	}
+	hits, reads := s.vcm.CacheStats()
+	if reads == 0 {
+		return 0, false
+	}
+	return float64(hits) / float64(reads), true
+}
+
+// describe returns the statistics accumulated since the shard is created
+func (s *queryShardStats) describe() metricsinfo.QueryShardStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := metricsinfo.QueryShardStats{
+		CollectionID:   s.collectionID,
+		Channel:        s.channel,
+		SearchCount:    s.searchCount,
+		QueryCount:     s.queryCount,
+		FailedCount:    s.failedCount,
+		QPS:            s.window.qpsAt(time.Now().Unix()),
+		MaxLatencyMs:   float64(s.maxLatency) / float64(time.Millisecond),
+		LatencyBuckets: make([]metricsinfo.LatencyBucket, 0, len(s.latencyBuckets)),
+		RowsScanned:    s.rowsScanned,
+	}
+	for i, count := range s.latencyBuckets {
+		var bound int64
+		if i < len(shardLatencyBucketsMs) {
+			bound = shardLatencyBucketsMs[i]
+		}
+		stats.LatencyBuckets = append(stats.LatencyBuckets, metricsinfo.LatencyBucket{UpperBoundMs: bound, Count: count})
+	}
+	if total := s.searchCount + s.queryCount; total > 0 {
+		stats.AvgLatencyMs = float64(s.totalLatency) / float64(time.Millisecond) / float64(total)
+		stats.AvgReduceMs = float64(s.totalReduce) / float64(time.Millisecond) / float64(total)
+	}
+	stats.CacheHitRate, _ = s.cacheHitRate()
+	return stats
+}
+
+// removeMetrics removes the prometheus metrics of the shard after it is closed
+func (s *queryShardStats) removeMetrics() {
+	nodeID := fmt.Sprint(Params.QueryNodeCfg.GetNodeID())
+	collectionID := fmt.Sprint(s.collectionID)
+	for _, queryType := range []string{metrics.SearchLabel, metrics.QueryLabel} {
+		for _, status := range []string{metrics.SuccessLabel, metrics.FailLabel} {
+			metrics.QueryNodeShardSQCount.DeleteLabelValues(nodeID, collectionID, s.channel, queryType, status)
+		}
+		metrics.QueryNodeShardSQLatency.DeleteLabelValues(nodeID, collectionID, s.channel, queryType)
+		metrics.QueryNodeShardReduceLatency.DeleteLabelValues(nodeID, collectionID, s.channel, queryType)
+		metrics.QueryNodeShardScannedRows.DeleteLabelValues(nodeID, collectionID, s.channel, queryType)
+	}
+	metrics.QueryNodeShardCacheHitRatio.DeleteLabelValues(nodeID, collectionID, s.channel)
+}
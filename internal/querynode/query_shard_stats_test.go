@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+func TestQueryShardStats(t *testing.T) {
+	stats := newQueryShardStats(defaultCollectionID, defaultDMLChannel, nil)
+	defer stats.removeMetrics()
+
+	empty := stats.describe()
+	assert.Zero(t, empty.AvgLatencyMs)
+	assert.Zero(t, empty.CacheHitRate)
+	assert.Equal(t, len(shardLatencyBucketsMs)+1, len(empty.LatencyBuckets))
+
+	stats.observe(&slowQueryRecord{kind: slowQueryKindSearch, rowsScanned: 100, reduce: 2 * time.Millisecond}, 10*time.Millisecond, nil)
+	stats.observe(&slowQueryRecord{kind: slowQueryKindSearch, rowsScanned: 100, reduce: 4 * time.Millisecond}, 30*time.Millisecond, nil)
+	stats.observe(&slowQueryRecord{kind: slowQueryKindQuery, rowsScanned: 50}, 10*time.Second, errors.New("mock error"))
+
+	described := stats.describe()
+	assert.Equal(t, defaultCollectionID, described.CollectionID)
+	assert.Equal(t, defaultDMLChannel, described.Channel)
+	assert.Equal(t, int64(2), described.SearchCount)
+	assert.Equal(t, int64(1), described.QueryCount)
+	assert.Equal(t, int64(1), described.FailedCount)
+	assert.Equal(t, 0.3, described.QPS)
+	assert.InDelta(t, 10040.0/3, described.AvgLatencyMs, 0.001)
+	assert.Equal(t, 10000.0, described.MaxLatencyMs)
+	assert.Equal(t, int64(250), described.RowsScanned)
+	assert.Equal(t, 2.0, described.AvgReduceMs)
+
+	buckets := described.LatencyBuckets
+	assert.Equal(t, metricsinfo.LatencyBucket{UpperBoundMs: 10, Count: 1}, buckets[3])
+	assert.Equal(t, metricsinfo.LatencyBucket{UpperBoundMs: 50, Count: 1}, buckets[5])
+	// 10s is beyond the bounds
+	assert.Equal(t, metricsinfo.LatencyBucket{UpperBoundMs: 0, Count: 1}, buckets[len(buckets)-1])
+}
+
+func TestLatencyBucketOf(t *testing.T) {
+	assert.Equal(t, 0, latencyBucketOf(0))
+	assert.Equal(t, 0, latencyBucketOf(time.Millisecond))
+	assert.Equal(t, 1, latencyBucketOf(2*time.Millisecond))
+	assert.Equal(t, 2, latencyBucketOf(3*time.Millisecond))
+	assert.Equal(t, len(shardLatencyBucketsMs), latencyBucketOf(time.Minute))
+}
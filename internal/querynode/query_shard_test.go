@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 )
 
 func genSimpleQueryShard(ctx context.Context) (*queryShard, error) {
@@ -160,6 +161,11 @@ func TestQueryShard_Search(t *testing.T) {
 		_, err = qs.search(context.Background(), request)
 		assert.NoError(t, err)
 	})
+
+	stats := qs.stats.describe()
+	assert.Equal(t, int64(2), stats.SearchCount)
+	assert.Zero(t, stats.FailedCount)
+	assert.Greater(t, stats.RowsScanned, int64(0))
 }
 
 func TestQueryShard_Query(t *testing.T) {
@@ -289,6 +295,29 @@ func TestReduceSearchResultData(t *testing.T) {
 		assert.Nil(t, err)
 		assert.ElementsMatch(t, []int64{1, 5, 2, 3}, res.Ids.GetIntId().Data)
 	})
+	t.Run("range search", func(t *testing.T) {
+		// the scores of the hamming distances are negated, the results in [1, 3) are kept
+		searchRange, err := indexparamcheck.ParseSearchRange(indexparamcheck.HAMMING,
+			map[string]interface{}{indexparamcheck.Radius: float64(3), indexparamcheck.RangeFilter: float64(1)})
+		assert.NoError(t, err)
+		rangePlan := &SearchPlan{pkType: schemapb.DataType_Int64, searchRange: searchRange}
+		ids1 := []int64{1, 2, 3, 4}
+		scores1 := []float32{0, -1.0, -2.0, -3.0}
+		ids2 := []int64{5, 6, 7, 8}
+		scores2 := []float32{-1.0, -2.0, -3.0, -4.0}
+		data1 := genSearchResultData(nq, topk, ids1, scores1, []int64{int64(len(ids1))})
+		data2 := genSearchResultData(nq, topk, ids2, scores2, []int64{int64(len(ids2))})
+		res, err := reduceSearchResultData([]*schemapb.SearchResultData{data1, data2}, nq, topk, rangePlan)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []int64{2, 5, 3, 6}, res.Ids.GetIntId().Data)
+		assert.Equal(t, []float32{-1.0, -1.0, -2.0, -2.0}, res.Scores)
+		assert.Equal(t, []int64{4}, res.Topks)
+
+		// at most top k results in range are returned
+		res, err = reduceSearchResultData([]*schemapb.SearchResultData{data1, data2}, nq, 2, rangePlan)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []int64{2, 5}, res.Ids.GetIntId().Data)
+	})
 }
 
 func TestMergeInternalRetrieveResults(t *testing.T) {
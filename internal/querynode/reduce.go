@@ -38,6 +38,8 @@ import (
 // SearchResult contains a pointer to the search result in C++ memory
 type SearchResult struct {
 	cSearchResult C.CSearchResult
+	// segment is referred by the C search result, it's released when the result is deleted
+	segment *Segment
 }
 
 // searchResultDataBlobs is the CSearchResultsDataBlobs in C++
@@ -128,5 +130,9 @@ func deleteSearchResultDataBlobs(cSearchResultDataBlobs searchResultDataBlobs) {
 func deleteSearchResults(results []*SearchResult) {
 	for _, result := range results {
 		C.DeleteSearchResult(result.cSearchResult)
+		if result.segment != nil {
+			result.segment.releaseRef()
+			result.segment = nil
+		}
 	}
 }
@@ -81,7 +81,7 @@ func TestReduce_AllFunc(t *testing.T) {
 	placeholderGroups := make([]*searchRequest, 0)
 	placeholderGroups = append(placeholderGroups, holder)
 
-	searchResult, err := segment.search(plan, placeholderGroups, []Timestamp{0})
+	searchResult, err := segment.search(context.Background(), plan, placeholderGroups, []Timestamp{0})
 	assert.NoError(t, err)
 
 	err = checkSearchResult(nq, plan, searchResult)
@@ -0,0 +1,114 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// maxPooledBufferLen is the max length of buffer kept in pools,
+// larger buffers are left to GC so that a few huge requests won't pin memory.
+const maxPooledBufferLen = 1 << 20
+
+// Buffers used when reducing search results are pooled to cut the allocation rate of search path at high QPS.
+var (
+	int64SlicePool = sync.Pool{
+		New: func() interface{} {
+			s := make([]int64, 0)
+			return &s
+		},
+	}
+
+	float32SlicePool = sync.Pool{
+		New: func() interface{} {
+			s := make([]float32, 0)
+			return &s
+		},
+	}
+
+	idSetPool = sync.Pool{
+		New: func() interface{} {
+			return make(map[interface{}]struct{})
+		},
+	}
+)
+
+// getInt64Slice returns an empty int64 slice, the capacity of which is at least size
+func getInt64Slice(size int) []int64 {
+	s := *int64SlicePool.Get().(*[]int64)
+	if cap(s) < size {
+		return make([]int64, 0, size)
+	}
+	return s[:0]
+}
+
+func putInt64Slice(s []int64) {
+	if s == nil || cap(s) > maxPooledBufferLen {
+		return
+	}
+	s = s[:0]
+	int64SlicePool.Put(&s)
+}
+
+// getFloat32Slice returns an empty float32 slice, the capacity of which is at least size
+func getFloat32Slice(size int) []float32 {
+	s := *float32SlicePool.Get().(*[]float32)
+	if cap(s) < size {
+		return make([]float32, 0, size)
+	}
+	return s[:0]
+}
+
+func putFloat32Slice(s []float32) {
+	if s == nil || cap(s) > maxPooledBufferLen {
+		return
+	}
+	s = s[:0]
+	float32SlicePool.Put(&s)
+}
+
+func getIDSet() map[interface{}]struct{} {
+	return idSetPool.Get().(map[interface{}]struct{})
+}
+
+func putIDSet(m map[interface{}]struct{}) {
+	if len(m) > maxPooledBufferLen {
+		return
+	}
+	for k := range m {
+		delete(m, k)
+	}
+	idSetPool.Put(m)
+}
+
+// recycleSearchResultData gives the buffers of reduced search result back to pools,
+// data must not be used after recycled.
+func recycleSearchResultData(data *schemapb.SearchResultData) {
+	if data == nil {
+		return
+	}
+	putFloat32Slice(data.Scores)
+	putInt64Slice(data.Topks)
+	data.Scores = nil
+	data.Topks = nil
+	if intIDs := data.GetIds().GetIntId(); intIDs != nil {
+		putInt64Slice(intIDs.Data)
+		intIDs.Data = nil
+	}
+}
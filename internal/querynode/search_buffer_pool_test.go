@@ -0,0 +1,76 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestSearchBufferPool(t *testing.T) {
+	t.Run("test slices", func(t *testing.T) {
+		int64s := getInt64Slice(10)
+		assert.Equal(t, 0, len(int64s))
+		assert.GreaterOrEqual(t, cap(int64s), 10)
+		int64s = append(int64s, 1, 2, 3)
+		putInt64Slice(int64s)
+
+		float32s := getFloat32Slice(10)
+		assert.Equal(t, 0, len(float32s))
+		assert.GreaterOrEqual(t, cap(float32s), 10)
+		putFloat32Slice(float32s)
+
+		// nil and oversize slices are not pooled
+		putInt64Slice(nil)
+		putFloat32Slice(make([]float32, 0, maxPooledBufferLen+1))
+	})
+
+	t.Run("test id set", func(t *testing.T) {
+		idSet := getIDSet()
+		idSet[int64(1)] = struct{}{}
+		putIDSet(idSet)
+
+		idSet = getIDSet()
+		assert.Equal(t, 0, len(idSet))
+		putIDSet(idSet)
+	})
+
+	t.Run("test recycle search result data", func(t *testing.T) {
+		recycleSearchResultData(nil)
+
+		data := genSearchResultData(1, 2, []int64{1, 2}, []float32{1.0, 2.0}, []int64{2})
+		recycleSearchResultData(data)
+		assert.Nil(t, data.Scores)
+		assert.Nil(t, data.Topks)
+		assert.Nil(t, data.GetIds().GetIntId().GetData())
+	})
+
+	t.Run("test reduce with pooled buffers", func(t *testing.T) {
+		plan := &SearchPlan{pkType: schemapb.DataType_Int64}
+		for i := 0; i < 3; i++ {
+			data := genSearchResultData(1, 2, []int64{1, 2}, []float32{-1.0, -2.0}, []int64{2})
+			res, err := reduceSearchResultData([]*schemapb.SearchResultData{data}, 1, 2, plan)
+			assert.NoError(t, err)
+			assert.Equal(t, []int64{1, 2}, res.Ids.GetIntId().Data)
+			assert.Equal(t, []int64{2}, res.Topks)
+			recycleSearchResultData(res)
+		}
+	})
+}
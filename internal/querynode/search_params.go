@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+// The search params, such as nprobe, ef and search_k, are passed per search to trade the recall against the latency,
+// overriding the ones fixed at index build time. Querynode validates them against the index type of the vector field
+// loaded before passing the plan to segcore. The searches may ask for a recall level instead, from which querynode
+// derives the search params of the index loaded, see indexparamcheck.AutoSearchParams. The searches on HNSW indexes
+// take ef, the size of the dynamic candidate list, which knowhere requires, so querynode fills the default of the
+// index params into the plan of the search without ef.
+
+// fillSearchParams returns the serialized search plan with the search params validated against the index of the
+// vector field, the ones derived from the search level filled, and with the ef of the search on HNSW indexes, which
+// is the greater of the default ef of the index and the top k if the search doesn't specify it. getIndexParams returns the index params of the vector field loaded,
+// nil if the field is not indexed. The plan is returned unchanged if the field isn't indexed.
+func fillSearchParams(serializedPlan []byte, getIndexParams func(fieldID int64) map[string]string) ([]byte, error) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil || plan.GetVectorAnns() == nil {
+		// leave the invalid plans to segcore
+		return serializedPlan, nil
+	}
+	indexParams := getIndexParams(plan.GetVectorAnns().GetFieldId())
+	if indexParams["index_type"] == "" {
+		return serializedPlan, nil
+	}
+
+	queryInfo := plan.GetVectorAnns().GetQueryInfo()
+	searchParams := make(map[string]interface{})
+	if queryInfo.GetSearchParams() != "" {
+		if err := json.Unmarshal([]byte(queryInfo.GetSearchParams()), &searchParams); err != nil {
+			return nil, fmt.Errorf("invalid search params %s, error = %w", queryInfo.GetSearchParams(), err)
+		}
+	}
+	topK := queryInfo.GetTopk()
+	filled := false
+	if value, ok := searchParams[indexparamcheck.SearchLevel]; ok {
+		// the params derived from the level don't override the ones specified
+		level, err := indexparamcheck.ParseIntSearchParam(indexparamcheck.SearchLevel, value)
+		if err != nil {
+			return nil, err
+		}
+		autoParams, err := indexparamcheck.AutoSearchParams(indexParams, level, topK)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range autoParams {
+			if _, ok := searchParams[key]; !ok {
+				searchParams[key] = value
+			}
+		}
+		delete(searchParams, indexparamcheck.SearchLevel)
+		filled = true
+	}
+	if err := indexparamcheck.CheckSearchParams(indexParams, searchParams, topK); err != nil {
+		return nil, err
+	}
+	if _, ok := searchParams[indexparamcheck.HNSWEf]; !ok && indexparamcheck.IsHNSWIndexType(indexParams["index_type"]) {
+		ef := topK
+		if value, ok := indexParams[indexparamcheck.HNSWEf]; ok {
+			if defaultEf, err := strconv.ParseInt(value, 10, 64); err == nil && defaultEf > ef {
+				ef = defaultEf
+			}
+		}
+		searchParams[indexparamcheck.HNSWEf] = ef
+		filled = true
+	}
+	if !filled {
+		return serializedPlan, nil
+	}
+
+	b, err := json.Marshal(searchParams)
+	if err != nil {
+		return nil, err
+	}
+	queryInfo.SearchParams = string(b)
+	return proto.Marshal(plan)
+}
+
+// parseSearchRange returns the range of the range search of the serialized search plan, nil if the search is not a
+// range search, see indexparamcheck.ParseSearchRange. The search params are passed to segcore unchanged, which
+// searches the top k results, and the shard leader drops the ones out of range while merging the results.
+func parseSearchRange(serializedPlan []byte) (*indexparamcheck.SearchRange, error) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil || plan.GetVectorAnns() == nil {
+		// leave the invalid plans to segcore
+		return nil, nil
+	}
+	queryInfo := plan.GetVectorAnns().GetQueryInfo()
+	if queryInfo.GetSearchParams() == "" {
+		return nil, nil
+	}
+	searchParams := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(queryInfo.GetSearchParams()), &searchParams); err != nil {
+		return nil, fmt.Errorf("invalid search params %s, error = %w", queryInfo.GetSearchParams(), err)
+	}
+	return indexparamcheck.ParseSearchRange(queryInfo.GetMetricType(), searchParams)
+}
@@ -0,0 +1,132 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+func TestFillSearchParams(t *testing.T) {
+	marshal := func(searchParams string) []byte {
+		b, err := proto.Marshal(&planpb.PlanNode{Node: &planpb.PlanNode_VectorAnns{VectorAnns: &planpb.VectorANNS{
+			FieldId:   101,
+			QueryInfo: &planpb.QueryInfo{Topk: 10, MetricType: "L2", SearchParams: searchParams},
+		}}})
+		assert.NoError(t, err)
+		return b
+	}
+	getSearchParams := func(b []byte) string {
+		plan := &planpb.PlanNode{}
+		assert.NoError(t, proto.Unmarshal(b, plan))
+		return plan.GetVectorAnns().GetQueryInfo().GetSearchParams()
+	}
+	indexed := func(indexParams map[string]string) func(int64) map[string]string {
+		return func(fieldID int64) map[string]string {
+			assert.Equal(t, int64(101), fieldID)
+			return indexParams
+		}
+	}
+
+	// unchanged without indexes or the ef to fill
+	serialized := marshal(`{"nprobe": 10}`)
+	ret, err := fillSearchParams(serialized, indexed(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, serialized, ret)
+	ivfParams := map[string]string{"index_type": indexparamcheck.IndexFaissIvfFlat, indexparamcheck.NLIST: "16"}
+	ret, err = fillSearchParams(serialized, indexed(ivfParams))
+	assert.NoError(t, err)
+	assert.Equal(t, serialized, ret)
+
+	// the nprobe of the search is validated against the nlist of the index
+	_, err = fillSearchParams(marshal(`{"nprobe": 17}`), indexed(ivfParams))
+	assert.Error(t, err)
+	_, err = fillSearchParams(marshal(`{"search_k": 5}`), indexed(map[string]string{"index_type": indexparamcheck.IndexANNOY}))
+	assert.Error(t, err)
+
+	// the ef is filled for the other HNSW index types too
+	ret, err = fillSearchParams(marshal(""), indexed(map[string]string{"index_type": indexparamcheck.IndexRHNSWSQ}))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"ef": 10}`, getSearchParams(ret))
+
+	// the ef of the search overrides the default
+	indexParams := map[string]string{"index_type": indexparamcheck.IndexHNSW, indexparamcheck.HNSWEf: "64"}
+	serialized = marshal(`{"ef": 16}`)
+	ret, err = fillSearchParams(serialized, indexed(indexParams))
+	assert.NoError(t, err)
+	assert.Equal(t, serialized, ret)
+
+	// the default ef is filled, which is at least the top k
+	ret, err = fillSearchParams(marshal(""), indexed(indexParams))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"ef": 64}`, getSearchParams(ret))
+	ret, err = fillSearchParams(marshal(`{"a": 1}`), indexed(map[string]string{"index_type": indexparamcheck.IndexHNSW}))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"a": 1, "ef": 10}`, getSearchParams(ret))
+
+	// the search params are derived from the level
+	ret, err = fillSearchParams(marshal(`{"level": 2}`), indexed(ivfParams))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"nprobe": 1}`, getSearchParams(ret))
+	ret, err = fillSearchParams(marshal(`{"level": 5}`), indexed(indexParams))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"ef": 512}`, getSearchParams(ret))
+	ret, err = fillSearchParams(marshal(`{"level": 5, "ef": 16}`), indexed(indexParams))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"ef": 16}`, getSearchParams(ret))
+
+	for _, searchParams := range []string{`{"level": 0}`, `{"level": "1"}`, `{"ef": 9}`, `{"ef": 32769}`, `{"ef": "16"}`, `{"ef": 16.5}`, `ef`} {
+		_, err = fillSearchParams(marshal(searchParams), indexed(indexParams))
+		assert.Error(t, err, searchParams)
+	}
+}
+
+func TestParseSearchRange(t *testing.T) {
+	marshal := func(metricType string, searchParams string) []byte {
+		b, err := proto.Marshal(&planpb.PlanNode{Node: &planpb.PlanNode_VectorAnns{VectorAnns: &planpb.VectorANNS{
+			FieldId:   101,
+			QueryInfo: &planpb.QueryInfo{Topk: 10, MetricType: metricType, SearchParams: searchParams},
+		}}})
+		assert.NoError(t, err)
+		return b
+	}
+
+	r, err := parseSearchRange(marshal(indexparamcheck.HAMMING, `{"nprobe": 16}`))
+	assert.NoError(t, err)
+	assert.Nil(t, r)
+	r, err = parseSearchRange(marshal(indexparamcheck.HAMMING, ""))
+	assert.NoError(t, err)
+	assert.Nil(t, r)
+
+	r, err = parseSearchRange(marshal(indexparamcheck.JACCARD, `{"nprobe": 16, "radius": 0.5}`))
+	assert.NoError(t, err)
+	assert.NotNil(t, r)
+	assert.True(t, r.Contains(-0.2))
+	assert.True(t, r.Beyond(-0.5))
+
+	_, err = parseSearchRange(marshal(indexparamcheck.JACCARD, `{"radius": 2}`))
+	assert.Error(t, err)
+	_, err = parseSearchRange(marshal(indexparamcheck.SUBSTRUCTURE, `{"radius": 1}`))
+	assert.Error(t, err)
+	_, err = parseSearchRange(marshal(indexparamcheck.HAMMING, `{"radius": 3`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"sync"
+)
+
+// searchWorkers bounds the segment searches running at the same time on the query node.
+// The limit is read from queryNode.search.workerNum on every acquire, so a reload takes effect
+// for the next segment search. A limit not greater than 0 means no limit.
+type searchWorkers struct {
+	mu      sync.Mutex
+	running int64
+	// released is closed and replaced whenever a worker is released
+	released chan struct{}
+	limit    func() int64
+}
+
+func newSearchWorkers(limit func() int64) *searchWorkers {
+	return &searchWorkers{
+		released: make(chan struct{}),
+		limit:    limit,
+	}
+}
+
+// acquire blocks until a worker is free or ctx is done
+func (w *searchWorkers) acquire(ctx context.Context) error {
+	for {
+		w.mu.Lock()
+		limit := w.limit()
+		if limit <= 0 || w.running < limit {
+			w.running++
+			w.mu.Unlock()
+			return nil
+		}
+		released := w.released
+		w.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-released:
+		}
+	}
+}
+
+// release frees a worker taken by acquire
+func (w *searchWorkers) release() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.running--
+	close(w.released)
+	w.released = make(chan struct{})
+}
+
+var segmentSearchWorkers = newSearchWorkers(func() int64 {
+	return Params.QueryNodeCfg.SearchWorkerNum.Load()
+})
@@ -0,0 +1,59 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+)
+
+func TestSearchWorkers(t *testing.T) {
+	limit := atomic.NewInt64(1)
+	workers := newSearchWorkers(limit.Load)
+
+	ctx := context.Background()
+	assert.NoError(t, workers.acquire(ctx))
+
+	// the only worker is taken
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, workers.acquire(timeoutCtx), context.DeadlineExceeded)
+
+	// a waiting search gets the worker once released
+	acquired := make(chan error, 1)
+	go func() {
+		acquired <- workers.acquire(ctx)
+	}()
+	workers.release()
+	assert.NoError(t, <-acquired)
+
+	// a raised limit takes effect for the next acquire
+	limit.Store(2)
+	assert.NoError(t, workers.acquire(ctx))
+
+	// no limit
+	limit.Store(0)
+	assert.NoError(t, workers.acquire(ctx))
+	workers.release()
+	workers.release()
+	workers.release()
+	assert.Equal(t, int64(0), workers.running)
+}
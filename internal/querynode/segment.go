@@ -30,10 +30,13 @@ package querynode
 import "C"
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"github.com/milvus-io/milvus/internal/util/funcutil"
@@ -54,6 +57,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/cgoconverter"
+	"github.com/milvus-io/milvus/internal/util/logutil"
 )
 
 type segmentType = commonpb.SegmentState
@@ -63,11 +67,6 @@ const (
 	segmentTypeSealed  = commonpb.SegmentState_Sealed
 )
 
-const (
-	bloomFilterSize       uint    = 100000
-	maxBloomFalsePositive float64 = 0.005
-)
-
 // IndexedFieldInfo contains binlog info of vector field
 type IndexedFieldInfo struct {
 	fieldBinlog *datapb.FieldBinlog
@@ -101,6 +100,37 @@ type Segment struct {
 	indexedFieldInfos map[UniqueID]*IndexedFieldInfo
 
 	pkFilter *bloom.BloomFilter //  bloom filter of pk inside a segment
+
+	// cold segment swap
+	lastAccessTs int64                    // unix nano of the last search/retrieve, accessed atomically
+	loadInfo     *querypb.SegmentLoadInfo // load info of sealed segment, used to re-materialize it
+	swappedOut   bool                     // guarded by segPtrMu, true if segmentPtr was freed by the swapper
+
+	// deletes applied after the sealed segment was loaded, they are not contained in the delta logs
+	// and have to be replayed when the segment is swapped in
+	deleteRecordMu sync.Mutex // guards the fields below until loadedDeleteTss
+	deletedPks     []primaryKey
+	deletedTss     []Timestamp
+	// the max timestamp of the rows of the sealed segment, each pk only keeps the earliest delete after it
+	maxInsertTs Timestamp
+	// deletes exceed the cap and are no longer recorded, the segment can't be swapped out or snapshot then
+	deletesOverflow bool
+	// deletes loaded from delta logs or delete snapshot, kept to persist the delete snapshot
+	loadedDeletePks []primaryKey
+	loadedDeleteTss []Timestamp
+
+	// search results referring to the C segment, accessed atomically. The C segment is only freed
+	// when the last search result is deleted, deleteSegment defers the free by setting pendingDelete.
+	refCount      int32
+	pendingDelete bool // guarded by segPtrMu
+
+	// the segment loaded with the rebuilt index which replaces this one in the replica, guarded by segPtrMu.
+	// The deletes applied to this segment by the callers getting it before the replacement are forwarded to it.
+	replacedBy *Segment
+
+	// msgID of the load request, QueryCoord stamps it with an increasing version,
+	// the release requests older than it are stale. 0 if the segment is not loaded by request.
+	loadVersion UniqueID
 }
 
 // ID returns the identity number.
@@ -140,6 +170,253 @@ func (s *Segment) getType() segmentType {
 	return s.segmentType
 }
 
+// touch records the segment was accessed just now
+func (s *Segment) touch() {
+	atomic.StoreInt64(&s.lastAccessTs, time.Now().UnixNano())
+}
+
+func (s *Segment) getLastAccessTime() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&s.lastAccessTs))
+}
+
+func (s *Segment) setLoadInfo(loadInfo *querypb.SegmentLoadInfo) {
+	s.segPtrMu.Lock()
+	defer s.segPtrMu.Unlock()
+	s.loadInfo = loadInfo
+}
+
+func (s *Segment) getLoadInfo() *querypb.SegmentLoadInfo {
+	s.segPtrMu.RLock()
+	defer s.segPtrMu.RUnlock()
+	return s.loadInfo
+}
+
+// recordLoadedDeletes keeps the deletes loaded from storage if the segment could be snapshot
+func (s *Segment) recordLoadedDeletes(pks []primaryKey, tss []Timestamp) {
+	if !Params.QueryNodeCfg.DeleteSnapshotEnabled || s.getLoadInfo() == nil {
+		return
+	}
+	s.deleteRecordMu.Lock()
+	defer s.deleteRecordMu.Unlock()
+	s.loadedDeletePks = append(s.loadedDeletePks, pks...)
+	s.loadedDeleteTss = append(s.loadedDeleteTss, tss...)
+}
+
+// setMaxInsertTs records the max timestamp of the rows loaded into the sealed segment
+func (s *Segment) setMaxInsertTs(ts Timestamp) {
+	s.deleteRecordMu.Lock()
+	defer s.deleteRecordMu.Unlock()
+	if ts > s.maxInsertTs {
+		s.maxInsertTs = ts
+	}
+}
+
+// recordDeletes keeps the deletes applied after the segment was loaded, they are compacted once exceeding the cap,
+// and no longer recorded if still exceeding half of the cap after compaction
+func (s *Segment) recordDeletes(pks []primaryKey, tss []Timestamp) {
+	s.deleteRecordMu.Lock()
+	defer s.deleteRecordMu.Unlock()
+	if s.deletesOverflow {
+		return
+	}
+	s.deletedPks = append(s.deletedPks, pks...)
+	s.deletedTss = append(s.deletedTss, tss...)
+	maxRecorded := Params.QueryNodeCfg.ColdSegmentMaxRecordedDeletes
+	if len(s.deletedPks) <= maxRecorded {
+		return
+	}
+	s.deletedPks, s.deletedTss = compactDeletes(s.deletedPks, s.deletedTss, s.maxInsertTs)
+	if len(s.deletedPks) > maxRecorded/2 {
+		log.Warn("too many deletes applied to the sealed segment, stop recording them",
+			zap.Int64("collectionID", s.collectionID), zap.Int64("segmentID", s.segmentID),
+			zap.Int("numDeletes", len(s.deletedPks)), zap.Int("maxRecordedDeletes", maxRecorded))
+		s.deletedPks, s.deletedTss = nil, nil
+		s.deletesOverflow = true
+	}
+}
+
+// compactDeletes keeps only the earliest delete of each pk among the ones after maxInsertTs, as all the rows
+// are inserted before them, the later ones don't delete anything more. The deletes before maxInsertTs are all kept.
+func compactDeletes(pks []primaryKey, tss []Timestamp, maxInsertTs Timestamp) ([]primaryKey, []Timestamp) {
+	if maxInsertTs == 0 {
+		return pks, tss
+	}
+	earliest := make(map[interface{}]int) // pk value -> index of the earliest delete after maxInsertTs
+	n := 0
+	for i, pk := range pks {
+		var key interface{}
+		switch pk := pk.(type) {
+		case *int64PrimaryKey:
+			key = pk.Value
+		case *varCharPrimaryKey:
+			key = pk.Value
+		}
+		if key != nil && tss[i] > maxInsertTs {
+			if j, ok := earliest[key]; ok {
+				if tss[i] < tss[j] {
+					tss[j] = tss[i]
+				}
+				continue
+			}
+			earliest[key] = n
+		}
+		pks[n], tss[n] = pk, tss[i]
+		n++
+	}
+	return pks[:n], tss[:n]
+}
+
+// getAppliedDeletes returns all the deletes applied to the sealed segment and the max timestamp of them,
+// error if the deletes exceed the cap and are not recorded
+func (s *Segment) getAppliedDeletes() ([]primaryKey, []Timestamp, Timestamp, error) {
+	s.deleteRecordMu.Lock()
+	defer s.deleteRecordMu.Unlock()
+	if s.deletesOverflow {
+		return nil, nil, 0, fmt.Errorf("deletes applied to segment %d exceed %d and are not recorded",
+			s.segmentID, Params.QueryNodeCfg.ColdSegmentMaxRecordedDeletes)
+	}
+	pks := make([]primaryKey, 0, len(s.loadedDeletePks)+len(s.deletedPks))
+	tss := make([]Timestamp, 0, len(s.loadedDeleteTss)+len(s.deletedTss))
+	pks = append(append(pks, s.loadedDeletePks...), s.deletedPks...)
+	tss = append(append(tss, s.loadedDeleteTss...), s.deletedTss...)
+	var maxTs Timestamp
+	for _, ts := range tss {
+		if ts > maxTs {
+			maxTs = ts
+		}
+	}
+	return pks, tss, maxTs, nil
+}
+
+func (s *Segment) isSwappedOut() bool {
+	s.segPtrMu.RLock()
+	defer s.segPtrMu.RUnlock()
+	return s.swappedOut
+}
+
+// isFreed returns whether the C segment is freed, including the segment swapped out
+func (s *Segment) isFreed() bool {
+	s.segPtrMu.RLock()
+	defer s.segPtrMu.RUnlock()
+	return s.segmentPtr == nil
+}
+
+// pin keeps the C segment of the resident segment in memory until releaseRef is called, returns false if the segment
+// is swapped out. The segment is touched, so that it's not idle while being searched.
+func (s *Segment) pin() bool {
+	s.segPtrMu.RLock()
+	defer s.segPtrMu.RUnlock()
+	if s.swappedOut {
+		return false
+	}
+	s.touch()
+	s.acquireRef()
+	return true
+}
+
+// swapOut frees the underlying C segment while keeping the go side meta if it's not accessed for idleTimeout,
+// the segment can be re-materialized later by swapIn. It returns false if the segment is not idle.
+func (s *Segment) swapOut(idleTimeout time.Duration) (bool, error) {
+	s.segPtrMu.Lock()
+	defer s.segPtrMu.Unlock()
+	if s.swappedOut {
+		return true, nil
+	}
+	// the idleness is checked under the lock, as the segment is pinned and touched with the lock held
+	if time.Since(s.getLastAccessTime()) < idleTimeout {
+		return false, nil
+	}
+	if s.segmentType != segmentTypeSealed {
+		return false, fmt.Errorf("only sealed segment can be swapped out, segmentID = %d", s.segmentID)
+	}
+	if s.loadInfo == nil {
+		return false, fmt.Errorf("segment %d has no load info, cannot be swapped out", s.segmentID)
+	}
+	if s.segmentPtr == nil {
+		return false, errors.New("null seg core pointer")
+	}
+	if atomic.LoadInt32(&s.refCount) > 0 {
+		return false, fmt.Errorf("segment %d is pinned or referred by search results, cannot be swapped out", s.segmentID)
+	}
+	s.deleteRecordMu.Lock()
+	overflow := s.deletesOverflow
+	s.deleteRecordMu.Unlock()
+	if overflow {
+		return false, fmt.Errorf("deletes applied to segment %d are not recorded, cannot be swapped out", s.segmentID)
+	}
+
+	C.DeleteSegment(s.segmentPtr)
+	s.segmentPtr = nil
+	s.swappedOut = true
+
+	log.Info("swap out segment", zap.Int64("collectionID", s.collectionID), zap.Int64("segmentID", s.segmentID))
+	return true, nil
+}
+
+// swapIn takes over the C segment of loaded, which must be loaded with the same load info.
+// Deletes applied after the segment was loaded are replayed before it becomes visible.
+// Returns false if the segment is not swapped out, the caller is responsible to free loaded then.
+func (s *Segment) swapIn(loaded *Segment) (bool, error) {
+	s.segPtrMu.Lock()
+	defer s.segPtrMu.Unlock()
+	if !s.swappedOut {
+		return false, nil
+	}
+
+	s.deleteRecordMu.Lock()
+	pks, tss := s.deletedPks, s.deletedTss
+	s.deleteRecordMu.Unlock()
+	if len(pks) > 0 {
+		offset := loaded.segmentPreDelete(len(pks))
+		if err := loaded.segmentDelete(offset, pks, tss); err != nil {
+			return false, err
+		}
+	}
+
+	loaded.segPtrMu.Lock()
+	s.segmentPtr = loaded.segmentPtr
+	loaded.segmentPtr = nil
+	loaded.segPtrMu.Unlock()
+
+	loaded.indexedFieldMutex.RLock()
+	s.indexedFieldMutex.Lock()
+	s.indexedFieldInfos = loaded.indexedFieldInfos
+	s.indexedFieldMutex.Unlock()
+	loaded.indexedFieldMutex.RUnlock()
+
+	s.idBinlogRowSizes = loaded.idBinlogRowSizes
+	s.swappedOut = false
+
+	log.Info("swap in segment", zap.Int64("collectionID", s.collectionID), zap.Int64("segmentID", s.segmentID),
+		zap.Int("replayedDeletes", len(pks)))
+	return true, nil
+}
+
+// forwardDeletes replays the deletes applied after the segment was loaded on the replacement loaded with the rebuilt index,
+// the later deletes applied to the segment are forwarded to the replacement
+func (s *Segment) forwardDeletes(replacement *Segment) error {
+	s.segPtrMu.Lock()
+	defer s.segPtrMu.Unlock()
+
+	s.deleteRecordMu.Lock()
+	pks, tss, overflow := s.deletedPks, s.deletedTss, s.deletesOverflow
+	s.deleteRecordMu.Unlock()
+	if overflow {
+		return fmt.Errorf("deletes applied to segment %d are not recorded, cannot be forwarded", s.segmentID)
+	}
+	if len(pks) > 0 {
+		offset := replacement.segmentPreDelete(len(pks))
+		if err := replacement.segmentDelete(offset, pks, tss); err != nil {
+			return err
+		}
+	}
+	s.replacedBy = replacement
+
+	log.Info("forward deletes to the replacement of segment", zap.Int64("collectionID", s.collectionID), zap.Int64("segmentID", s.segmentID),
+		zap.Int("replayedDeletes", len(pks)))
+	return nil
+}
+
 func (s *Segment) getOnService() bool {
 	return s.onService
 }
@@ -215,7 +492,9 @@ func newSegment(collection *Collection, segmentID UniqueID, partitionID UniqueID
 		onService:         onService,
 		indexedFieldInfos: make(map[UniqueID]*IndexedFieldInfo),
 
-		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
+		pkFilter: storage.GetCollectionBloomFilterParams(collection.Schema()).NewBloomFilter(),
+
+		lastAccessTs: time.Now().UnixNano(),
 	}
 
 	return segment, nil
@@ -232,13 +511,46 @@ func deleteSegment(segment *Segment) {
 
 	segment.segPtrMu.Lock()
 	defer segment.segPtrMu.Unlock()
-	cPtr := segment.segmentPtr
-	C.DeleteSegment(cPtr)
-	segment.segmentPtr = nil
+	if atomic.LoadInt32(&segment.refCount) > 0 {
+		// freed by the last search result referring to it
+		segment.pendingDelete = true
+		log.Info("segment is referred by search results, delay deleting it from memory",
+			zap.Int64("collectionID", segment.collectionID), zap.Int64("segmentID", segment.ID()),
+			zap.Int32("refCount", atomic.LoadInt32(&segment.refCount)))
+		return
+	}
+	segment.free()
+}
 
-	log.Info("delete segment from memory", zap.Int64("collectionID", segment.collectionID), zap.Int64("partitionID", segment.partitionID), zap.Int64("segmentID", segment.ID()))
+// free deletes the C segment, the caller must hold segPtrMu
+func (s *Segment) free() {
+	if s.segmentPtr == nil {
+		return
+	}
+	C.DeleteSegment(s.segmentPtr)
+	s.segmentPtr = nil
 
-	segment = nil
+	log.Info("delete segment from memory", zap.Int64("collectionID", s.collectionID), zap.Int64("partitionID", s.partitionID), zap.Int64("segmentID", s.ID()))
+}
+
+// acquireRef is called with segPtrMu held by search, so that deleteSegment won't free the C segment
+// before the search result is deleted
+func (s *Segment) acquireRef() {
+	atomic.AddInt32(&s.refCount, 1)
+}
+
+// releaseRef is called after the search result is deleted, the C segment is freed if
+// deleteSegment has been called and this is the last reference
+func (s *Segment) releaseRef() {
+	s.segPtrMu.Lock()
+	defer s.segPtrMu.Unlock()
+	if atomic.AddInt32(&s.refCount, -1) > 0 {
+		return
+	}
+	if s.pendingDelete {
+		s.pendingDelete = false
+		s.free()
+	}
 }
 
 func (s *Segment) getRowCount() int64 {
@@ -284,7 +596,7 @@ func (s *Segment) getMemSize() int64 {
 	return int64(memoryUsageInBytes)
 }
 
-func (s *Segment) search(plan *SearchPlan,
+func (s *Segment) search(ctx context.Context, plan *SearchPlan,
 	searchRequests []*searchRequest,
 	timestamp []Timestamp) (*SearchResult, error) {
 	/*
@@ -301,6 +613,7 @@ func (s *Segment) search(plan *SearchPlan,
 	if s.segmentPtr == nil {
 		return nil, errors.New("null seg core pointer")
 	}
+	s.touch()
 	cPlaceholderGroups := make([]C.CPlaceholderGroup, 0)
 	for _, pg := range searchRequests {
 		cPlaceholderGroups = append(cPlaceholderGroups, (*pg).cPlaceholderGroup)
@@ -310,7 +623,7 @@ func (s *Segment) search(plan *SearchPlan,
 	ts := C.uint64_t(timestamp[0])
 	cPlaceHolderGroup := cPlaceholderGroups[0]
 
-	log.Debug("do search on segment", zap.Int64("segmentID", s.segmentID), zap.Int32("segmentType", int32(s.segmentType)))
+	logutil.Logger(ctx).Debug("do search on segment", zap.Int64("segmentID", s.segmentID), zap.Int32("segmentType", int32(s.segmentType)))
 	tr := timerecord.NewTimeRecorder("cgoSearch")
 	status := C.Search(s.segmentPtr, plan.cSearchPlan, cPlaceHolderGroup, ts, &searchResult.cSearchResult, C.int64_t(s.segmentID))
 	metrics.QueryNodeSQSegmentLatencyInCore.WithLabelValues(fmt.Sprint(Params.QueryNodeCfg.GetNodeID()), metrics.SearchLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -318,6 +631,9 @@ func (s *Segment) search(plan *SearchPlan,
 		return nil, err
 	}
 
+	// the search result refers to the C segment until it's deleted
+	s.acquireRef()
+	searchResult.segment = s
 	return &searchResult, nil
 }
 
@@ -332,12 +648,13 @@ func HandleCProto(cRes *C.CProto, msg proto.Message) error {
 	return proto.Unmarshal(blob, msg)
 }
 
-func (s *Segment) retrieve(plan *RetrievePlan) (*segcorepb.RetrieveResults, error) {
+func (s *Segment) retrieve(ctx context.Context, plan *RetrievePlan) (*segcorepb.RetrieveResults, error) {
 	s.segPtrMu.RLock()
 	defer s.segPtrMu.RUnlock()
 	if s.segmentPtr == nil {
 		return nil, errors.New("null seg core pointer")
 	}
+	s.touch()
 
 	var retrieveResult RetrieveResult
 	ts := C.uint64_t(plan.Timestamp)
@@ -345,7 +662,7 @@ func (s *Segment) retrieve(plan *RetrievePlan) (*segcorepb.RetrieveResults, erro
 	status := C.Retrieve(s.segmentPtr, plan.cRetrievePlan, ts, &retrieveResult.cRetrieveResult)
 	metrics.QueryNodeSQSegmentLatencyInCore.WithLabelValues(fmt.Sprint(Params.QueryNodeCfg.GetNodeID()),
 		metrics.QueryLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
-	log.Debug("do retrieve on segment", zap.Int64("segmentID", s.segmentID), zap.Int32("segmentType", int32(s.segmentType)))
+	logutil.Logger(ctx).Debug("do retrieve on segment", zap.Int64("segmentID", s.segmentID), zap.Int32("segmentType", int32(s.segmentType)))
 	if err := HandleCStatus(&status, "Retrieve failed"); err != nil {
 		return nil, err
 	}
@@ -576,6 +893,20 @@ func (s *Segment) updateBloomFilter(pks []primaryKey) {
 	}
 }
 
+// mayContainPK returns whether the pk bloom filter of the segment may contain the primary key
+func (s *Segment) mayContainPK(pk primaryKey) bool {
+	switch pk.Type() {
+	case schemapb.DataType_Int64:
+		buf := make([]byte, 8)
+		common.Endian.PutUint64(buf, uint64(pk.(*int64PrimaryKey).Value))
+		return s.pkFilter.Test(buf)
+	case schemapb.DataType_VarChar:
+		return s.pkFilter.TestString(pk.(*varCharPrimaryKey).Value)
+	default:
+		return false
+	}
+}
+
 //-------------------------------------------------------------------------------------- interfaces for growing segment
 func (s *Segment) segmentPreInsert(numOfRecords int) (int64, error) {
 	/*
@@ -603,6 +934,10 @@ func (s *Segment) segmentPreDelete(numOfRecords int) int64 {
 	*/
 	s.segPtrMu.RLock()
 	defer s.segPtrMu.RUnlock() // thread safe guaranteed by segCore, use RLock
+	if s.segmentPtr == nil {
+		// swapped out segment records deletes only, the offset is meaningless
+		return 0
+	}
 	var offset = C.PreDelete(s.segmentPtr, C.int64_t(int64(numOfRecords)))
 
 	return int64(offset)
@@ -660,14 +995,27 @@ func (s *Segment) segmentDelete(offset int64, entityIDs []primaryKey, timestamps
 
 	s.segPtrMu.RLock()
 	defer s.segPtrMu.RUnlock() // thread safe guaranteed by segCore, use RLock
-	if s.segmentPtr == nil {
-		return errors.New("null seg core pointer")
-	}
-
 	if len(entityIDs) != len(timestamps) {
 		return errors.New("length of entityIDs not equal to length of timestamps")
 	}
 
+	if s.replacedBy != nil {
+		replacement := s.replacedBy
+		return replacement.segmentDelete(replacement.segmentPreDelete(len(entityIDs)), entityIDs, timestamps)
+	}
+
+	if s.loadInfo != nil {
+		// segment could be swapped out or snapshot, keep the deletes
+		s.recordDeletes(entityIDs, timestamps)
+	}
+	if s.swappedOut {
+		return nil
+	}
+
+	if s.segmentPtr == nil {
+		return errors.New("null seg core pointer")
+	}
+
 	var cOffset = C.int64_t(offset)
 	var cSize = C.int64_t(len(entityIDs))
 	var cTimestampsPtr = (*C.uint64_t)(&(timestamps)[0])
@@ -848,3 +1196,39 @@ func (s *Segment) segmentLoadIndexData(bytesIndex [][]byte, indexInfo *querypb.F
 
 	return nil
 }
+
+// replaceSegmentIndex replaces the loaded index of the vector field with the one of indexInfo, such as the same index
+// placed on another device, the searches see either of them
+func (s *Segment) replaceSegmentIndex(bytesIndex [][]byte, indexInfo *querypb.FieldIndexInfo, fieldType schemapb.DataType) error {
+	loadIndexInfo, err := newLoadIndexInfo()
+	if err != nil {
+		return err
+	}
+	defer deleteLoadIndexInfo(loadIndexInfo)
+
+	err = loadIndexInfo.appendIndexInfo(bytesIndex, indexInfo, fieldType)
+	if err != nil {
+		return err
+	}
+
+	s.segPtrMu.Lock()
+	defer s.segPtrMu.Unlock()
+	if s.segmentPtr == nil {
+		return errors.New("null seg core pointer")
+	}
+	if s.segmentType != segmentTypeSealed {
+		return fmt.Errorf("replaceSegmentIndex failed, illegal segment type %s, segmentID = %d", s.segmentType.String(), s.ID())
+	}
+
+	status := C.DropSealedSegmentIndex(s.segmentPtr, C.int64_t(indexInfo.FieldID))
+	if err := HandleCStatus(&status, "DropSealedSegmentIndex failed"); err != nil {
+		return err
+	}
+	status = C.UpdateSealedSegmentIndex(s.segmentPtr, loadIndexInfo.cLoadIndexInfo)
+	if err := HandleCStatus(&status, "UpdateSealedSegmentIndex failed"); err != nil {
+		return err
+	}
+
+	log.Info("replaceSegmentIndex done", zap.Int64("segmentID", s.ID()), zap.Int64("fieldID", indexInfo.FieldID))
+	return nil
+}
@@ -0,0 +1,149 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+// lifecycle events of a segment on querynode
+const (
+	segmentEventLoaded    = "Loaded"
+	segmentEventHandedOff = "HandedOff"
+	segmentEventExcluded  = "Excluded"
+	segmentEventReleased  = "Released"
+	segmentEventEvicted   = "Evicted"
+)
+
+// segmentEventLog is a ring buffer of the latest segment lifecycle events, which answers
+// why a segment disappeared from this querynode
+type segmentEventLog struct {
+	mu     sync.Mutex
+	events []metricsinfo.SegmentEvent
+	next   int // position of the next event
+	full   bool
+}
+
+func newSegmentEventLog(capacity int) *segmentEventLog {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &segmentEventLog{
+		events: make([]metricsinfo.SegmentEvent, capacity),
+	}
+}
+
+var segmentEvents struct {
+	once sync.Once
+	log  *segmentEventLog
+}
+
+// getSegmentEventLog returns the segment event log of querynode, which is created on the first use
+// so that the capacity is loaded from the params.
+func getSegmentEventLog() *segmentEventLog {
+	segmentEvents.once.Do(func() {
+		segmentEvents.log = newSegmentEventLog(Params.QueryNodeCfg.SegmentEventLogCapacity)
+	})
+	return segmentEvents.log
+}
+
+// record appends an event to the ring buffer and writes it to the log
+func (l *segmentEventLog) record(event string, collectionID, partitionID, segmentID UniqueID, cause string) {
+	log.Info("segment event", zap.String("event", event),
+		zap.Int64("collectionID", collectionID),
+		zap.Int64("partitionID", partitionID),
+		zap.Int64("segmentID", segmentID),
+		zap.String("cause", cause))
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events[l.next] = metricsinfo.SegmentEvent{
+		SegmentID:    segmentID,
+		CollectionID: collectionID,
+		PartitionID:  partitionID,
+		Event:        event,
+		Cause:        cause,
+		Time:         time.Now().String(),
+	}
+	l.next = (l.next + 1) % len(l.events)
+	if l.next == 0 {
+		l.full = true
+	}
+}
+
+// list returns the events of the segment from the oldest to the latest, all events are returned if segmentID is 0
+func (l *segmentEventLog) list(segmentID UniqueID) []metricsinfo.SegmentEvent {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var ordered []metricsinfo.SegmentEvent
+	if l.full {
+		ordered = append(ordered, l.events[l.next:]...)
+	}
+	ordered = append(ordered, l.events[:l.next]...)
+
+	events := make([]metricsinfo.SegmentEvent, 0, len(ordered))
+	for _, event := range ordered {
+		if segmentID == 0 || event.SegmentID == segmentID {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// recordSegmentEvent records a lifecycle event of the segment in the segment event log of querynode
+func recordSegmentEvent(event string, collectionID, partitionID, segmentID UniqueID, cause string) {
+	getSegmentEventLog().record(event, collectionID, partitionID, segmentID, cause)
+}
+
+// recordSegmentsExcluded records the segments whose insert messages are filtered out from the dml channels as excluded
+func recordSegmentsExcluded(segmentInfos []*datapb.SegmentInfo, cause string) {
+	for _, info := range segmentInfos {
+		recordSegmentEvent(segmentEventExcluded, info.GetCollectionID(), info.GetPartitionID(), info.GetID(), cause)
+	}
+}
+
+// recordPartitionsReleased records the segments of the partitions in replica as released,
+// which is called before the partitions are removed from replica
+func recordPartitionsReleased(replica ReplicaInterface, collectionID UniqueID, partitionIDs []UniqueID, cause string) {
+	for _, partitionID := range partitionIDs {
+		segmentIDs, err := replica.getSegmentIDs(partitionID)
+		if err != nil {
+			continue
+		}
+		for _, segmentID := range segmentIDs {
+			recordSegmentEvent(segmentEventReleased, collectionID, partitionID, segmentID, cause)
+		}
+	}
+}
+
+// recordSegmentReleased records the segment in replica as released if it exists,
+// which is called before the segment is removed from replica
+func recordSegmentReleased(replica ReplicaInterface, segmentID UniqueID, cause string) {
+	segment, err := replica.getSegmentByID(segmentID)
+	if err != nil {
+		return
+	}
+	recordSegmentEvent(segmentEventReleased, segment.collectionID, segment.partitionID, segmentID, cause)
+}
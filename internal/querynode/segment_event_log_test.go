@@ -0,0 +1,72 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSegmentEventLog(t *testing.T) {
+	l := newSegmentEventLog(3)
+	assert.Empty(t, l.list(0))
+
+	l.record(segmentEventLoaded, defaultCollectionID, defaultPartitionID, 1, "load")
+	l.record(segmentEventLoaded, defaultCollectionID, defaultPartitionID, 2, "load")
+	events := l.list(0)
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, int64(1), events[0].SegmentID)
+	assert.Equal(t, int64(2), events[1].SegmentID)
+
+	// the oldest events are overwritten
+	l.record(segmentEventEvicted, defaultCollectionID, defaultPartitionID, 1, "idle")
+	l.record(segmentEventReleased, defaultCollectionID, defaultPartitionID, 1, "release")
+	events = l.list(0)
+	require.Equal(t, 3, len(events))
+	assert.Equal(t, int64(2), events[0].SegmentID)
+	assert.Equal(t, segmentEventEvicted, events[1].Event)
+	assert.Equal(t, segmentEventReleased, events[2].Event)
+
+	events = l.list(1)
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, segmentEventEvicted, events[0].Event)
+	assert.Equal(t, "idle", events[0].Cause)
+	assert.Equal(t, segmentEventReleased, events[1].Event)
+	assert.Empty(t, l.list(3))
+}
+
+func TestSegmentEventLog_recordSegmentReleased(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tSafe := newTSafeReplica()
+	historical, err := genSimpleHistorical(ctx, tSafe)
+	require.NoError(t, err)
+
+	recordSegmentReleased(historical.replica, defaultSegmentID, "release")
+	events := getSegmentEventLog().list(defaultSegmentID)
+	require.NotEmpty(t, events)
+	assert.Equal(t, segmentEventReleased, events[len(events)-1].Event)
+	assert.Equal(t, defaultPartitionID, events[len(events)-1].PartitionID)
+
+	recordPartitionsReleased(historical.replica, defaultCollectionID, []UniqueID{defaultPartitionID}, "release partition")
+	events = getSegmentEventLog().list(defaultSegmentID)
+	assert.Equal(t, "release partition", events[len(events)-1].Cause)
+}
@@ -24,7 +24,10 @@ import (
 	"runtime"
 	"strconv"
 	"sync"
+	"sync/atomic"
 
+	"github.com/opentracing/opentracing-go"
+	oplog "github.com/opentracing/opentracing-go/log"
 	"github.com/panjf2000/ants/v2"
 	"go.uber.org/zap"
 
@@ -42,8 +45,11 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/concurrency"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
+	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // segmentLoader is only responsible for loading the field data from binlog
@@ -60,6 +66,18 @@ type segmentLoader struct {
 	cpuPool *concurrency.Pool
 
 	factory msgstream.Factory
+
+	// number of load requests in progress
+	loadingCount int32
+	// progress of the segments being loaded
+	progress *segmentLoadingProgress
+	// GPU memory taken by the indexes placed on GPU
+	gpuIndexes *gpuIndexManager
+}
+
+// isLoading returns whether there are segments being loaded
+func (loader *segmentLoader) isLoading() bool {
+	return atomic.LoadInt32(&loader.loadingCount) > 0
 }
 
 func (loader *segmentLoader) getFieldType(segment *Segment, fieldID FieldID) (schemapb.DataType, error) {
@@ -84,7 +102,7 @@ func (loader *segmentLoader) getFieldType(segment *Segment, fieldID FieldID) (sc
 	return coll.getFieldType(fieldID)
 }
 
-func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, segmentType segmentType) error {
+func (loader *segmentLoader) loadSegment(ctx context.Context, req *querypb.LoadSegmentsRequest, segmentType segmentType) error {
 	if req.Base == nil {
 		return fmt.Errorf("nil base message when load segment, collectionID = %d", req.CollectionID)
 	}
@@ -108,27 +126,59 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, segme
 		return err
 	}
 
+	// the segments loaded by the previous attempts are skipped, so that the retried or rescheduled request is idempotent
+	infos := make([]*querypb.SegmentLoadInfo, 0, len(req.Infos))
+	// the loaded segments whose index is rebuilt, they are reloaded and replaced in place
+	swaps := make(map[UniqueID]bool)
+	for _, info := range req.Infos {
+		if metaReplica.hasSegment(info.GetSegmentID()) {
+			if segmentType == segmentTypeSealed && loader.indexRebuilt(info) {
+				log.Info("index of the loaded segment is rebuilt, reload it",
+					zap.Int64("collectionID", info.GetCollectionID()),
+					zap.Int64("segmentID", info.GetSegmentID()),
+					zap.Int64("loadSegmentRequest msgID", req.Base.MsgID))
+				swaps[info.GetSegmentID()] = true
+				infos = append(infos, info)
+				continue
+			}
+			log.Info("segment has been loaded, skip it",
+				zap.Int64("collectionID", info.GetCollectionID()),
+				zap.Int64("segmentID", info.GetSegmentID()),
+				zap.Int64("loadSegmentRequest msgID", req.Base.MsgID))
+			continue
+		}
+		infos = append(infos, info)
+	}
+	if len(infos) == 0 {
+		return nil
+	}
+
+	atomic.AddInt32(&loader.loadingCount, 1)
+	defer atomic.AddInt32(&loader.loadingCount, -1)
+	loader.progress.start(infos)
+	defer loader.progress.finish(infos)
+
 	log.Info("segmentLoader start loading...",
 		zap.Any("collectionID", req.CollectionID),
-		zap.Any("numOfSegments", len(req.Infos)),
+		zap.Any("numOfSegments", len(infos)),
 		zap.Any("loadType", segmentType),
 	)
 	// check memory limit
 	concurrencyLevel := loader.cpuPool.Cap()
-	if len(req.Infos) > 0 && len(req.Infos[0].BinlogPaths) > 0 {
-		concurrencyLevel /= len(req.Infos[0].BinlogPaths)
+	if len(infos) > 0 && len(infos[0].BinlogPaths) > 0 {
+		concurrencyLevel /= len(infos[0].BinlogPaths)
 		if concurrencyLevel <= 0 {
 			concurrencyLevel = 1
 		}
 	}
 	for ; concurrencyLevel > 1; concurrencyLevel /= 2 {
-		err := loader.checkSegmentSize(req.CollectionID, req.Infos, concurrencyLevel)
+		err := loader.checkSegmentSize(req.CollectionID, infos, concurrencyLevel)
 		if err == nil {
 			break
 		}
 	}
 
-	err := loader.checkSegmentSize(req.CollectionID, req.Infos, concurrencyLevel)
+	err := loader.checkSegmentSize(req.CollectionID, infos, concurrencyLevel)
 	if err != nil {
 		log.Error("load failed, OOM if loaded",
 			zap.Int64("loadSegmentRequest msgID", req.Base.MsgID),
@@ -143,7 +193,7 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, segme
 		}
 	}
 
-	for _, info := range req.Infos {
+	for _, info := range infos {
 		segmentID := info.SegmentID
 		partitionID := info.PartitionID
 		collectionID := info.CollectionID
@@ -165,20 +215,36 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, segme
 			segmentGC()
 			return err
 		}
+		segment.loadVersion = req.GetBase().GetMsgID()
 
 		newSegments[segmentID] = segment
 	}
 
 	loadSegmentFunc := func(idx int) error {
-		loadInfo := req.Infos[idx]
+		loadInfo := infos[idx]
 		collectionID := loadInfo.CollectionID
 		partitionID := loadInfo.PartitionID
 		segmentID := loadInfo.SegmentID
 		segment := newSegments[segmentID]
 
+		if segmentType == segmentTypeSealed {
+			// keep the load info so that the segment could be swapped out, snapshot or replaced by the rebuilt index
+			segment.setLoadInfo(loadInfo)
+		}
+
+		sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "loadSegment",
+			opentracing.Tags{
+				"collectionID": collectionID,
+				"partitionID":  partitionID,
+				"segmentID":    segmentID,
+				"segmentType":  segmentType.String(),
+			})
+		defer sp.Finish()
+
 		tr := timerecord.NewTimeRecorder("loadDurationPerSegment")
-		err := loader.loadSegmentInternal(segment, loadInfo)
+		err := loader.loadSegmentInternal(ctx, segment, loadInfo, loader.cm)
 		if err != nil {
+			trace.LogError(sp, err)
 			log.Error("load segment failed when load data into memory",
 				zap.Int64("collectionID", collectionID),
 				zap.Int64("partitionID", partitionID),
@@ -194,7 +260,7 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, segme
 	}
 	// start to load
 	// Make sure we can always benefit from concurrency, and not spawn too many idle goroutines
-	err = funcutil.ProcessFuncParallel(len(req.Infos),
+	err = funcutil.ProcessFuncParallel(len(infos),
 		concurrencyLevel,
 		loadSegmentFunc, "loadSegmentFunc")
 	if err != nil {
@@ -204,7 +270,14 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, segme
 
 	// set segment to meta replica
 	for _, s := range newSegments {
-		err = metaReplica.setSegment(s)
+		if swaps[s.segmentID] {
+			// searches see the segment either with the old index or with the rebuilt one
+			metaReplica.queryLock()
+			err = metaReplica.replaceSegment(s)
+			metaReplica.queryUnlock()
+		} else {
+			err = metaReplica.setSegment(s)
+		}
 		if err != nil {
 			log.Error("load segment failed, set segment to meta failed",
 				zap.Int64("collectionID", s.collectionID),
@@ -215,13 +288,40 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, segme
 			segmentGC()
 			return err
 		}
+		if swaps[s.segmentID] {
+			recordSegmentEvent(segmentEventLoaded, s.collectionID, s.partitionID, s.segmentID,
+				fmt.Sprintf("reloaded with rebuilt index by request %d", req.GetBase().GetMsgID()))
+			continue
+		}
+		recordSegmentEvent(segmentEventLoaded, s.collectionID, s.partitionID, s.segmentID,
+			fmt.Sprintf("loaded as %s segment by request %d", segmentType.String(), req.GetBase().GetMsgID()))
 	}
 
 	return nil
 }
 
-func (loader *segmentLoader) loadSegmentInternal(segment *Segment,
-	loadInfo *querypb.SegmentLoadInfo) error {
+// indexRebuilt returns true if the loaded sealed segment has no index or another index of the indexed fields of info
+func (loader *segmentLoader) indexRebuilt(info *querypb.SegmentLoadInfo) bool {
+	segment, err := loader.historicalReplica.getSegmentByID(info.GetSegmentID())
+	if err != nil {
+		return false
+	}
+	for _, indexInfo := range info.GetIndexInfos() {
+		if !indexInfo.GetEnableIndex() {
+			continue
+		}
+		loaded, err := segment.getIndexedFieldInfo(indexInfo.GetFieldID())
+		if err != nil || loaded.indexInfo.GetIndexID() != indexInfo.GetIndexID() {
+			return true
+		}
+	}
+	return false
+}
+
+// loadSegmentInternal loads the segment from the files of the load info read by cm, which is the remote storage
+// normally and the local cache when swapping the segment in
+func (loader *segmentLoader) loadSegmentInternal(ctx context.Context, segment *Segment,
+	loadInfo *querypb.SegmentLoadInfo, cm storage.ChunkManager) error {
 	collectionID := loadInfo.CollectionID
 	partitionID := loadInfo.PartitionID
 	segmentID := loadInfo.SegmentID
@@ -234,6 +334,12 @@ func (loader *segmentLoader) loadSegmentInternal(segment *Segment,
 	if err != nil {
 		return err
 	}
+	collection, err := loader.historicalReplica.getCollectionByID(collectionID)
+	if err != nil {
+		return err
+	}
+	// the schema may be pruned to the fields to load, the binlogs of the other fields are skipped
+	binlogPaths := filterFieldBinlogsBySchema(loadInfo.BinlogPaths, collection.Schema())
 
 	var fieldBinlogs []*datapb.FieldBinlog
 	if segment.getType() == segmentTypeSealed {
@@ -245,7 +351,7 @@ func (loader *segmentLoader) loadSegmentInternal(segment *Segment,
 
 		indexedFieldInfos := make(map[int64]*IndexedFieldInfo)
 
-		for _, fieldBinlog := range loadInfo.BinlogPaths {
+		for _, fieldBinlog := range binlogPaths {
 			fieldID := fieldBinlog.FieldID
 			if indexInfo, ok := fieldID2IndexInfo[fieldID]; ok {
 				fieldInfo := &IndexedFieldInfo{
@@ -264,33 +370,97 @@ func (loader *segmentLoader) loadSegmentInternal(segment *Segment,
 			}
 		}
 
-		if err := loader.loadIndexedFieldData(segment, indexedFieldInfos); err != nil {
+		if err := loader.loadIndexedFieldData(ctx, segment, indexedFieldInfos, cm); err != nil {
 			return err
 		}
+		loader.progress.update(segmentID, loadingProgressIndexLoaded)
 	} else {
-		fieldBinlogs = loadInfo.BinlogPaths
+		fieldBinlogs = binlogPaths
 	}
 
-	if err := loader.loadFiledBinlogData(segment, fieldBinlogs); err != nil {
+	if err := loader.loadFiledBinlogData(ctx, segment, fieldBinlogs, cm); err != nil {
 		return err
 	}
+	if segment.getType() == segmentTypeSealed {
+		if err := loader.loadDefaultFieldData(segment, collection.Schema(), binlogPaths, loadInfo.GetNumOfRows()); err != nil {
+			return err
+		}
+	}
+	loader.progress.update(segmentID, loadingProgressBinlogLoaded)
 
 	if pkFieldID == common.InvalidFieldID {
 		log.Warn("segment primary key field doesn't exist when load segment")
 	} else {
 		log.Debug("loading bloom filter...", zap.Int64("segmentID", segmentID))
 		pkStatsBinlogs := loader.filterPKStatsBinlogs(loadInfo.Statslogs, pkFieldID)
-		err = loader.loadSegmentBloomFilter(segment, pkStatsBinlogs)
+		err = loader.loadSegmentBloomFilter(segment, pkStatsBinlogs, cm)
+		if err != nil {
+			return err
+		}
+	}
+	loader.progress.update(segmentID, loadingProgressStatsLoaded)
+
+	var snapshotTs Timestamp
+	if segment.getType() == segmentTypeSealed && Params.QueryNodeCfg.DeleteSnapshotEnabled {
+		log.Debug("loading delete snapshot...", zap.Int64("segmentID", segmentID))
+		snapshotTs, err = loader.loadDeleteSnapshot(segment)
 		if err != nil {
 			return err
 		}
 	}
 
 	log.Debug("loading delta...", zap.Int64("segmentID", segmentID))
-	err = loader.loadDeltaLogs(segment, loadInfo.Deltalogs)
+	err = loader.loadDeltaLogs(ctx, segment, loadInfo.Deltalogs, snapshotTs, cm)
 	return err
 }
 
+// loadDefaultFieldData loads the default values of the fields added to the collection after the segment was written,
+// which have no binlog in the segment
+func (loader *segmentLoader) loadDefaultFieldData(segment *Segment, schema *schemapb.CollectionSchema,
+	fieldBinlogs []*datapb.FieldBinlog, numRows int64) error {
+	loaded := make(map[FieldID]struct{}, len(fieldBinlogs))
+	for _, fieldBinlog := range fieldBinlogs {
+		loaded[fieldBinlog.GetFieldID()] = struct{}{}
+	}
+	for _, field := range schema.GetFields() {
+		if _, ok := loaded[field.GetFieldID()]; ok {
+			continue
+		}
+		_, ok, err := typeutil.GetDefaultValue(field)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		fieldData, err := typeutil.GenDefaultFieldData(field, int(numRows))
+		if err != nil {
+			return err
+		}
+		if err := segment.segmentLoadFieldData(field.GetFieldID(), numRows, fieldData); err != nil {
+			return err
+		}
+		log.Debug("load default value of field done", zap.Int64("segmentID", segment.ID()),
+			zap.Int64("fieldID", field.GetFieldID()))
+	}
+	return nil
+}
+
+// filterFieldBinlogsBySchema returns the binlogs of the fields in the schema
+func filterFieldBinlogsBySchema(fieldBinlogs []*datapb.FieldBinlog, schema *schemapb.CollectionSchema) []*datapb.FieldBinlog {
+	fieldIDs := make(map[FieldID]struct{}, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		fieldIDs[field.GetFieldID()] = struct{}{}
+	}
+	ret := make([]*datapb.FieldBinlog, 0, len(fieldBinlogs))
+	for _, fieldBinlog := range fieldBinlogs {
+		if _, ok := fieldIDs[fieldBinlog.GetFieldID()]; ok {
+			ret = append(ret, fieldBinlog)
+		}
+	}
+	return ret
+}
+
 func (loader *segmentLoader) filterPKStatsBinlogs(fieldBinlogs []*datapb.FieldBinlog, pkFieldID int64) []string {
 	result := make([]string, 0)
 	for _, fieldBinlog := range fieldBinlogs {
@@ -303,18 +473,26 @@ func (loader *segmentLoader) filterPKStatsBinlogs(fieldBinlogs []*datapb.FieldBi
 	return result
 }
 
-func (loader *segmentLoader) loadFiledBinlogData(segment *Segment, fieldBinlogs []*datapb.FieldBinlog) error {
+func (loader *segmentLoader) loadFiledBinlogData(ctx context.Context, segment *Segment, fieldBinlogs []*datapb.FieldBinlog,
+	cm storage.ChunkManager) error {
 	if len(fieldBinlogs) <= 0 {
 		return nil
 	}
 
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "loadFieldBinlogs",
+		opentracing.Tags{
+			"segmentID":   segment.segmentID,
+			"numOfFields": len(fieldBinlogs),
+		})
+	defer sp.Finish()
+
 	segmentType := segment.getType()
 	iCodec := storage.InsertCodec{}
 
 	// change all field bin log loading into concurrent
 	loadFutures := make([]*concurrency.Future, 0)
 	for _, fieldBinlog := range fieldBinlogs {
-		futures := loader.loadFieldBinlogsAsync(fieldBinlog)
+		futures := loader.loadFieldBinlogsAsync(fieldBinlog, cm)
 		loadFutures = append(loadFutures, futures...)
 	}
 
@@ -322,12 +500,14 @@ func (loader *segmentLoader) loadFiledBinlogData(segment *Segment, fieldBinlogs
 	blobs := make([]*storage.Blob, len(loadFutures))
 	for index, future := range loadFutures {
 		if !future.OK() {
+			trace.LogError(sp, future.Err())
 			return future.Err()
 		}
 
 		blob := future.Value().(*storage.Blob)
 		blobs[index] = blob
 	}
+	sp.LogFields(oplog.String("statistical time", "binlogs downloaded"), oplog.Int("numOfBinlogs", len(blobs)))
 	log.Info("log field binlogs done",
 		zap.Int64("collection", segment.collectionID),
 		zap.Int64("segment", segment.segmentID),
@@ -357,6 +537,9 @@ func (loader *segmentLoader) loadFiledBinlogData(segment *Segment, fieldBinlogs
 
 		return loader.loadGrowingSegments(segment, rowIDData.(*storage.Int64FieldData).Data, utss, insertData)
 	case segmentTypeSealed:
+		if err := loader.fillPartialDefaultFields(segment, fieldBinlogs, insertData); err != nil {
+			return err
+		}
 		return loader.loadSealedSegments(segment, insertData)
 	default:
 		err := errors.New(fmt.Sprintln("illegal segment type when load segment, collectionID = ", segment.collectionID))
@@ -364,13 +547,37 @@ func (loader *segmentLoader) loadFiledBinlogData(segment *Segment, fieldBinlogs
 	}
 }
 
+// fillPartialDefaultFields fills the rows absent from the binlogs of the fields added to the collection
+// while the segment was being written, the absent rows are the ones written before the field was added
+func (loader *segmentLoader) fillPartialDefaultFields(segment *Segment, fieldBinlogs []*datapb.FieldBinlog,
+	insertData *storage.InsertData) error {
+	tsData, ok := insertData.Data[common.TimeStampField]
+	if !ok {
+		return nil
+	}
+	collection, err := loader.historicalReplica.getCollectionByID(segment.collectionID)
+	if err != nil {
+		return err
+	}
+	schema := &schemapb.CollectionSchema{}
+	for _, field := range collection.Schema().GetFields() {
+		for _, fieldBinlog := range fieldBinlogs {
+			if fieldBinlog.GetFieldID() == field.GetFieldID() {
+				schema.Fields = append(schema.Fields, field)
+				break
+			}
+		}
+	}
+	return storage.FillDefaultInsertData(insertData, schema, tsData.RowNum())
+}
+
 // Load binlogs concurrently into memory from KV storage asyncly
-func (loader *segmentLoader) loadFieldBinlogsAsync(field *datapb.FieldBinlog) []*concurrency.Future {
+func (loader *segmentLoader) loadFieldBinlogsAsync(field *datapb.FieldBinlog, cm storage.ChunkManager) []*concurrency.Future {
 	futures := make([]*concurrency.Future, 0, len(field.Binlogs))
 	for i := range field.Binlogs {
 		path := field.Binlogs[i].GetLogPath()
 		future := loader.ioPool.Submit(func() (interface{}, error) {
-			binLog, err := loader.cm.Read(path)
+			binLog, err := cm.Read(path)
 			if err != nil {
 				return nil, err
 			}
@@ -387,18 +594,19 @@ func (loader *segmentLoader) loadFieldBinlogsAsync(field *datapb.FieldBinlog) []
 	return futures
 }
 
-func (loader *segmentLoader) loadIndexedFieldData(segment *Segment, vecFieldInfos map[int64]*IndexedFieldInfo) error {
+func (loader *segmentLoader) loadIndexedFieldData(ctx context.Context, segment *Segment, vecFieldInfos map[int64]*IndexedFieldInfo,
+	cm storage.ChunkManager) error {
 	for fieldID, fieldInfo := range vecFieldInfos {
 		if fieldInfo.indexInfo == nil || !fieldInfo.indexInfo.EnableIndex {
 			fieldBinlog := fieldInfo.fieldBinlog
-			err := loader.loadFiledBinlogData(segment, []*datapb.FieldBinlog{fieldBinlog})
+			err := loader.loadFiledBinlogData(ctx, segment, []*datapb.FieldBinlog{fieldBinlog}, cm)
 			if err != nil {
 				return err
 			}
 			log.Debug("load vector field's binlog data done", zap.Int64("segmentID", segment.ID()), zap.Int64("fieldID", fieldID))
 		} else {
 			indexInfo := fieldInfo.indexInfo
-			err := loader.loadFieldIndexData(segment, indexInfo)
+			err := loader.loadFieldIndexData(ctx, segment, indexInfo, cm)
 			if err != nil {
 				return err
 			}
@@ -410,7 +618,49 @@ func (loader *segmentLoader) loadIndexedFieldData(segment *Segment, vecFieldInfo
 	return nil
 }
 
-func (loader *segmentLoader) loadFieldIndexData(segment *Segment, indexInfo *querypb.FieldIndexInfo) error {
+func (loader *segmentLoader) loadFieldIndexData(ctx context.Context, segment *Segment, indexInfo *querypb.FieldIndexInfo,
+	cm storage.ChunkManager) error {
+	indexBuffer, indexPaths, err := loader.readFieldIndexData(ctx, segment, indexInfo, cm)
+	if err != nil {
+		return err
+	}
+
+	// 2. use index bytes and index path to update segment
+	indexInfo.IndexFilePaths = indexPaths
+	fieldType, err := loader.getFieldType(segment, indexInfo.FieldID)
+	if err != nil {
+		return err
+	}
+	indexType := funcutil.KeyValuePair2Map(indexInfo.IndexParams)["index_type"]
+	if gpuEnabled() && indexparamcheck.IsGPUIndexType(indexType) {
+		size := int64(0)
+		for _, b := range indexBuffer {
+			size += int64(len(b))
+		}
+		if gpuIndexInfo, ok := loader.placeIndexOnGPU(segment, indexInfo, size); ok {
+			err = segment.segmentLoadIndexData(indexBuffer, gpuIndexInfo, fieldType)
+			if err == nil {
+				return nil
+			}
+			loader.gpuIndexes.release(segment, indexInfo.FieldID)
+			log.Warn("failed to load index on GPU, load it on CPU", zap.Int64("segmentID", segment.ID()),
+				zap.Int64("fieldID", indexInfo.FieldID), zap.Error(err))
+		}
+	}
+	return segment.segmentLoadIndexData(indexBuffer, withIndexMode(indexInfo, indexparamcheck.CPUMode), fieldType)
+}
+
+// readFieldIndexData reads the index files of the field, returns the index data and the paths of the files read
+func (loader *segmentLoader) readFieldIndexData(ctx context.Context, segment *Segment, indexInfo *querypb.FieldIndexInfo,
+	cm storage.ChunkManager) ([][]byte, []string, error) {
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "loadFieldIndex",
+		opentracing.Tags{
+			"segmentID": segment.segmentID,
+			"fieldID":   indexInfo.FieldID,
+			"indexID":   indexInfo.IndexID,
+		})
+	defer sp.Finish()
+
 	indexBuffer := make([][]byte, 0, len(indexInfo.IndexFilePaths))
 	filteredPaths := make([]string, 0, len(indexInfo.IndexFilePaths))
 	futures := make([]*concurrency.Future, 0, len(indexInfo.IndexFilePaths))
@@ -422,7 +672,7 @@ func (loader *segmentLoader) loadFieldIndexData(segment *Segment, indexInfo *que
 			indexFuture := loader.cpuPool.Submit(func() (interface{}, error) {
 				indexBlobFuture := loader.ioPool.Submit(func() (interface{}, error) {
 					log.Debug("load index file", zap.String("path", indexPath))
-					return loader.cm.Read(indexPath)
+					return cm.Read(indexPath)
 				})
 
 				indexBlob, err := indexBlobFuture.Await()
@@ -441,21 +691,16 @@ func (loader *segmentLoader) loadFieldIndexData(segment *Segment, indexInfo *que
 
 	err := concurrency.AwaitAll(futures...)
 	if err != nil {
-		return err
+		trace.LogError(sp, err)
+		return nil, nil, err
 	}
+	sp.LogFields(oplog.String("statistical time", "index files downloaded"), oplog.Int("numOfFiles", len(futures)))
 
 	for _, index := range futures {
 		blobs := index.Value().([]*storage.Blob)
 		indexBuffer = append(indexBuffer, blobs[0].Value)
 	}
-
-	// 2. use index bytes and index path to update segment
-	indexInfo.IndexFilePaths = filteredPaths
-	fieldType, err := loader.getFieldType(segment, indexInfo.FieldID)
-	if err != nil {
-		return err
-	}
-	return segment.segmentLoadIndexData(indexBuffer, indexInfo, fieldType)
+	return indexBuffer, filteredPaths, nil
 }
 
 func (loader *segmentLoader) loadGrowingSegments(segment *Segment,
@@ -523,6 +768,13 @@ func (loader *segmentLoader) loadSealedSegments(segment *Segment, insertData *st
 		if fieldID == common.TimeStampField {
 			timestampsData := insertData.Data[fieldID].(*storage.Int64FieldData)
 			segment.setIDBinlogRowSizes(timestampsData.NumRows)
+			var maxTs int64
+			for _, ts := range timestampsData.Data {
+				if ts > maxTs {
+					maxTs = ts
+				}
+			}
+			segment.setMaxInsertTs(Timestamp(maxTs))
 		}
 
 		err := segment.segmentLoadFieldData(fieldID, numRows, fieldData)
@@ -534,13 +786,13 @@ func (loader *segmentLoader) loadSealedSegments(segment *Segment, insertData *st
 	return nil
 }
 
-func (loader *segmentLoader) loadSegmentBloomFilter(segment *Segment, binlogPaths []string) error {
+func (loader *segmentLoader) loadSegmentBloomFilter(segment *Segment, binlogPaths []string, cm storage.ChunkManager) error {
 	if len(binlogPaths) == 0 {
 		log.Info("there are no stats logs saved with segment", zap.Any("segmentID", segment.segmentID))
 		return nil
 	}
 
-	values, err := loader.cm.MultiRead(binlogPaths)
+	values, err := cm.MultiRead(binlogPaths)
 	if err != nil {
 		return err
 	}
@@ -558,7 +810,8 @@ func (loader *segmentLoader) loadSegmentBloomFilter(segment *Segment, binlogPath
 			log.Warn("stat log with nil bloom filter", zap.Int64("segmentID", segment.segmentID), zap.Any("stat", stat))
 			continue
 		}
-		err = segment.pkFilter.Merge(stat.BF)
+		// the statslogs may be written with other parameters than the collection ones, such as the default ones
+		segment.pkFilter, err = storage.MergeBloomFilter(segment.pkFilter, stat.BF)
 		if err != nil {
 			return err
 		}
@@ -566,13 +819,26 @@ func (loader *segmentLoader) loadSegmentBloomFilter(segment *Segment, binlogPath
 	return nil
 }
 
-func (loader *segmentLoader) loadDeltaLogs(segment *Segment, deltaLogs []*datapb.FieldBinlog) error {
+// loadDeltaLogs loads the delete records in delta logs, the delta logs covered by the delete snapshot,
+// whose timestamps are not after snapshotTs, are skipped.
+func (loader *segmentLoader) loadDeltaLogs(ctx context.Context, segment *Segment, deltaLogs []*datapb.FieldBinlog, snapshotTs Timestamp,
+	cm storage.ChunkManager) error {
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "loadDeltaLogs",
+		opentracing.Tags{
+			"segmentID": segment.segmentID,
+		})
+	defer sp.Finish()
+
 	dCodec := storage.DeleteCodec{}
 	var blobs []*storage.Blob
 	for _, deltaLog := range deltaLogs {
 		for _, bLog := range deltaLog.GetBinlogs() {
-			value, err := loader.cm.Read(bLog.GetLogPath())
+			if snapshotTs > 0 && bLog.GetTimestampTo() > 0 && bLog.GetTimestampTo() <= snapshotTs {
+				continue
+			}
+			value, err := cm.Read(bLog.GetLogPath())
 			if err != nil {
+				trace.LogError(sp, err)
 				return err
 			}
 			blob := &storage.Blob{
@@ -582,6 +848,7 @@ func (loader *segmentLoader) loadDeltaLogs(segment *Segment, deltaLogs []*datapb
 			blobs = append(blobs, blob)
 		}
 	}
+	sp.LogFields(oplog.String("statistical time", "delta logs downloaded"), oplog.Int("numOfDeltaLogs", len(blobs)))
 	if len(blobs) == 0 {
 		log.Info("there are no delta logs saved with segment, skip loading delete record", zap.Any("segmentID", segment.segmentID))
 		return nil
@@ -595,6 +862,7 @@ func (loader *segmentLoader) loadDeltaLogs(segment *Segment, deltaLogs []*datapb
 	if err != nil {
 		return err
 	}
+	segment.recordLoadedDeletes(deltaData.Pks, deltaData.Tss)
 	return nil
 }
 
@@ -741,7 +1009,7 @@ func JoinIDPath(ids ...UniqueID) string {
 
 func (loader *segmentLoader) checkSegmentSize(collectionID UniqueID, segmentLoadInfos []*querypb.SegmentLoadInfo, concurrency int) error {
 	usedMem := metricsinfo.GetUsedMemoryCount()
-	totalMem := metricsinfo.GetMemoryCount()
+	totalMem := getTotalMemory()
 	if len(segmentLoadInfos) < concurrency {
 		concurrency = len(segmentLoadInfos)
 	}
@@ -765,9 +1033,9 @@ func (loader *segmentLoader) checkSegmentSize(collectionID UniqueID, segmentLoad
 	}
 
 	// when load segment, data will be copied from go memory to c++ memory
-	if usedMemAfterLoad+maxSegmentSize*uint64(concurrency) > uint64(float64(totalMem)*Params.QueryNodeCfg.OverloadedMemoryThresholdPercentage) {
+	if usedMemAfterLoad+maxSegmentSize*uint64(concurrency) > uint64(float64(totalMem)*Params.QueryNodeCfg.OverloadedMemoryThresholdPercentage.Load()) {
 		return fmt.Errorf("load segment failed, OOM if load, collectionID = %d, maxSegmentSize = %.2f MB, concurrency = %d, usedMemAfterLoad = %.2f MB, totalMem = %.2f MB, thresholdFactor = %f",
-			collectionID, toMB(maxSegmentSize), concurrency, toMB(usedMemAfterLoad), toMB(totalMem), Params.QueryNodeCfg.OverloadedMemoryThresholdPercentage)
+			collectionID, toMB(maxSegmentSize), concurrency, toMB(usedMemAfterLoad), toMB(totalMem), Params.QueryNodeCfg.OverloadedMemoryThresholdPercentage.Load())
 	}
 
 	return nil
@@ -808,6 +1076,9 @@ func newSegmentLoader(
 		cpuPool: cpuPool,
 
 		factory: factory,
+
+		progress:   newSegmentLoadingProgress(),
+		gpuIndexes: newGPUIndexManager(),
 	}
 
 	return loader
@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
@@ -72,10 +73,40 @@ func TestSegmentLoader_loadSegment(t *testing.T) {
 			},
 		}
 
-		err = loader.loadSegment(req, segmentTypeSealed)
+		err = loader.loadSegment(ctx, req, segmentTypeSealed)
 		assert.NoError(t, err)
 	})
 
+	t.Run("test load loaded segment", func(t *testing.T) {
+		node, err := genSimpleQueryNode(ctx)
+		assert.NoError(t, err)
+
+		loader := node.loader
+		assert.NotNil(t, loader)
+
+		// the loaded segment is skipped without reading the binlogs
+		req := &querypb.LoadSegmentsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadSegments,
+				MsgID:   rand.Int63(),
+			},
+			DstNodeID: 0,
+			Schema:    schema,
+			Infos: []*querypb.SegmentLoadInfo{
+				{
+					SegmentID:    defaultSegmentID,
+					PartitionID:  defaultPartitionID,
+					CollectionID: defaultCollectionID,
+					BinlogPaths:  []*datapb.FieldBinlog{{FieldID: simpleInt64Field.id, Binlogs: []*datapb.Binlog{{LogPath: "not_exist"}}}},
+				},
+			},
+		}
+
+		err = loader.loadSegment(ctx, req, segmentTypeSealed)
+		assert.NoError(t, err)
+		assert.True(t, node.historical.replica.hasSegment(defaultSegmentID))
+	})
+
 	t.Run("test set segment error due to without partition", func(t *testing.T) {
 		node, err := genSimpleQueryNode(ctx)
 		assert.NoError(t, err)
@@ -103,7 +134,7 @@ func TestSegmentLoader_loadSegment(t *testing.T) {
 			},
 		}
 
-		err = loader.loadSegment(req, segmentTypeSealed)
+		err = loader.loadSegment(ctx, req, segmentTypeSealed)
 		assert.Error(t, err)
 	})
 
@@ -116,9 +147,40 @@ func TestSegmentLoader_loadSegment(t *testing.T) {
 
 		req := &querypb.LoadSegmentsRequest{}
 
-		err = loader.loadSegment(req, segmentTypeSealed)
+		err = loader.loadSegment(ctx, req, segmentTypeSealed)
 		assert.Error(t, err)
 	})
+
+	t.Run("test load segment with nil base and valid infos", func(t *testing.T) {
+		node, err := genSimpleQueryNode(ctx)
+		assert.NoError(t, err)
+
+		err = node.historical.replica.removeSegment(defaultSegmentID)
+		assert.NoError(t, err)
+
+		loader := node.loader
+		assert.NotNil(t, loader)
+
+		req := &querypb.LoadSegmentsRequest{
+			DstNodeID: 0,
+			Schema:    schema,
+			Infos: []*querypb.SegmentLoadInfo{
+				{
+					SegmentID:    defaultSegmentID,
+					PartitionID:  defaultPartitionID,
+					CollectionID: defaultCollectionID,
+					BinlogPaths:  fieldBinlog,
+				},
+			},
+		}
+
+		err = loader.loadSegment(ctx, req, segmentTypeSealed)
+		assert.NoError(t, err)
+
+		segment, err := node.historical.replica.getSegmentByID(defaultSegmentID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), segment.loadVersion)
+	})
 }
 
 func TestSegmentLoader_loadSegmentFieldsData(t *testing.T) {
@@ -186,7 +248,7 @@ func TestSegmentLoader_loadSegmentFieldsData(t *testing.T) {
 		binlog, err := saveBinLog(ctx, defaultCollectionID, defaultPartitionID, defaultSegmentID, defaultMsgLength, schema)
 		assert.NoError(t, err)
 
-		err = loader.loadFiledBinlogData(segment, binlog)
+		err = loader.loadFiledBinlogData(ctx, segment, binlog, loader.cm)
 		assert.NoError(t, err)
 	}
 
@@ -211,6 +273,54 @@ func TestSegmentLoader_loadSegmentFieldsData(t *testing.T) {
 	//})
 }
 
+func TestSegmentLoader_loadDefaultFieldData(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+	loader := node.loader
+	assert.NotNil(t, loader)
+
+	fieldPk := genPKFieldSchema(simpleInt64Field)
+	fieldAdded := genConstantFieldSchema(simpleInt32Field)
+	fieldAdded.TypeParams = []*commonpb.KeyValuePair{{Key: common.DefaultValueParam, Value: "1"}}
+	schema := &schemapb.CollectionSchema{
+		Name:   defaultCollectionName,
+		AutoID: true,
+		Fields: []*schemapb.FieldSchema{fieldPk, fieldAdded},
+	}
+
+	col := newCollection(defaultCollectionID, schema)
+	assert.NotNil(t, col)
+	segment, err := newSegment(col,
+		defaultSegmentID,
+		defaultPartitionID,
+		defaultCollectionID,
+		defaultDMLChannel,
+		segmentTypeSealed,
+		true)
+	assert.Nil(t, err)
+
+	// the segment is written before the field is added
+	oldSchema := &schemapb.CollectionSchema{
+		Name:   defaultCollectionName,
+		AutoID: true,
+		Fields: []*schemapb.FieldSchema{fieldPk},
+	}
+	binlog, err := saveBinLog(ctx, defaultCollectionID, defaultPartitionID, defaultSegmentID, defaultMsgLength, oldSchema)
+	assert.NoError(t, err)
+	err = loader.loadFiledBinlogData(ctx, segment, binlog, loader.cm)
+	assert.NoError(t, err)
+
+	err = loader.loadDefaultFieldData(segment, schema, binlog, defaultMsgLength)
+	assert.NoError(t, err)
+
+	fieldAdded.TypeParams = []*commonpb.KeyValuePair{{Key: common.DefaultValueParam, Value: "one"}}
+	err = loader.loadDefaultFieldData(segment, schema, binlog, defaultMsgLength)
+	assert.Error(t, err)
+}
+
 func TestSegmentLoader_invalid(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -239,7 +349,7 @@ func TestSegmentLoader_invalid(t *testing.T) {
 			},
 		}
 
-		err = loader.loadSegment(req, segmentTypeSealed)
+		err = loader.loadSegment(ctx, req, segmentTypeSealed)
 		assert.Error(t, err)
 	})
 
@@ -312,7 +422,7 @@ func TestSegmentLoader_invalid(t *testing.T) {
 				},
 			},
 		}
-		err = loader.loadSegment(req, segmentTypeSealed)
+		err = loader.loadSegment(ctx, req, segmentTypeSealed)
 		assert.Error(t, err)
 	})
 
@@ -337,7 +447,7 @@ func TestSegmentLoader_invalid(t *testing.T) {
 			},
 		}
 
-		err = loader.loadSegment(req, commonpb.SegmentState_Dropped)
+		err = loader.loadSegment(ctx, req, commonpb.SegmentState_Dropped)
 		assert.Error(t, err)
 	})
 }
@@ -455,7 +565,7 @@ func TestSegmentLoader_testLoadGrowingAndSealed(t *testing.T) {
 			},
 		}
 
-		err = loader.loadSegment(req1, segmentTypeSealed)
+		err = loader.loadSegment(ctx, req1, segmentTypeSealed)
 		assert.NoError(t, err)
 
 		segment1, err := loader.historicalReplica.getSegmentByID(segmentID1)
@@ -481,7 +591,7 @@ func TestSegmentLoader_testLoadGrowingAndSealed(t *testing.T) {
 			},
 		}
 
-		err = loader.loadSegment(req2, segmentTypeSealed)
+		err = loader.loadSegment(ctx, req2, segmentTypeSealed)
 		assert.NoError(t, err)
 
 		segment2, err := loader.historicalReplica.getSegmentByID(segmentID2)
@@ -515,7 +625,7 @@ func TestSegmentLoader_testLoadGrowingAndSealed(t *testing.T) {
 			},
 		}
 
-		err = loader.loadSegment(req1, segmentTypeGrowing)
+		err = loader.loadSegment(ctx, req1, segmentTypeGrowing)
 		assert.NoError(t, err)
 
 		segment1, err := loader.streamingReplica.getSegmentByID(segmentID1)
@@ -541,7 +651,7 @@ func TestSegmentLoader_testLoadGrowingAndSealed(t *testing.T) {
 			},
 		}
 
-		err = loader.loadSegment(req2, segmentTypeGrowing)
+		err = loader.loadSegment(ctx, req2, segmentTypeGrowing)
 		assert.NoError(t, err)
 
 		segment2, err := loader.streamingReplica.getSegmentByID(segmentID2)
@@ -601,7 +711,7 @@ func TestSegmentLoader_testLoadSealedSegmentWithIndex(t *testing.T) {
 		},
 	}
 
-	err = loader.loadSegment(req, segmentTypeSealed)
+	err = loader.loadSegment(ctx, req, segmentTypeSealed)
 	assert.NoError(t, err)
 
 	segment, err := node.historical.replica.getSegmentByID(segmentID)
@@ -833,3 +943,20 @@ func TestSegmentLoader_getFieldType(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, schemapb.DataType_Int64, fieldType)
 }
+
+func TestSegmentLoader_filterFieldBinlogsBySchema(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: simpleInt64Field.id},
+			{FieldID: simpleFloatVecField.id},
+		},
+	}
+	fieldBinlogs := []*datapb.FieldBinlog{
+		{FieldID: simpleInt64Field.id},
+		{FieldID: simpleInt32Field.id},
+		{FieldID: simpleFloatVecField.id},
+	}
+	filtered := filterFieldBinlogsBySchema(fieldBinlogs, schema)
+	assert.Equal(t, []*datapb.FieldBinlog{fieldBinlogs[0], fieldBinlogs[2]}, filtered)
+	assert.Equal(t, 3, len(fieldBinlogs))
+}
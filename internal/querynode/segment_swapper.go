@@ -0,0 +1,326 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/trace"
+)
+
+// segmentSwapper drops the memory of sealed segments which are not searched for a while,
+// the binlogs of swapped out segments are kept in the local cache storage,
+// so the segments can be re-materialized from local disk on next access. The files kept take the local disk quota
+// shared with the disk cache.
+type segmentSwapper struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	replica ReplicaInterface
+	loader  *segmentLoader
+	cacheCM storage.ChunkManager // local disk cache
+
+	idleTimeout   time.Duration
+	checkInterval time.Duration
+
+	mu      sync.Mutex               // guards cached and charged
+	cached  map[UniqueID][]string    // segmentID -> files kept in cacheCM
+	charged map[UniqueID]int64       // segmentID -> bytes of the local disk quota taken by the files kept
+	locks   map[UniqueID]*sync.Mutex // segmentID -> lock serializing swap operations
+	lockMu  sync.Mutex               // guards locks
+}
+
+func newSegmentSwapper(ctx context.Context, replica ReplicaInterface, loader *segmentLoader, cacheCM storage.ChunkManager) *segmentSwapper {
+	ctx1, cancel := context.WithCancel(ctx)
+	return &segmentSwapper{
+		ctx:    ctx1,
+		cancel: cancel,
+
+		replica: replica,
+		loader:  loader,
+		cacheCM: cacheCM,
+
+		idleTimeout:   Params.QueryNodeCfg.ColdSegmentIdleTimeout,
+		checkInterval: Params.QueryNodeCfg.ColdSegmentCheckInterval,
+
+		cached:  make(map[UniqueID][]string),
+		charged: make(map[UniqueID]int64),
+		locks:   make(map[UniqueID]*sync.Mutex),
+	}
+}
+
+func (sw *segmentSwapper) start() {
+	sw.wg.Add(1)
+	go sw.checkLoop()
+	log.Info("segment swapper started", zap.Duration("idleTimeout", sw.idleTimeout), zap.Duration("checkInterval", sw.checkInterval))
+}
+
+func (sw *segmentSwapper) close() {
+	sw.cancel()
+	sw.wg.Wait()
+}
+
+func (sw *segmentSwapper) checkLoop() {
+	defer sw.wg.Done()
+	ticker := time.NewTicker(sw.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-sw.ctx.Done():
+			log.Info("segment swapper check loop exit")
+			return
+		case <-ticker.C:
+			sw.swapOutIdleSegments()
+			sw.removeStaleCache()
+		}
+	}
+}
+
+func (sw *segmentSwapper) segmentLock(segmentID UniqueID) *sync.Mutex {
+	sw.lockMu.Lock()
+	defer sw.lockMu.Unlock()
+	l, ok := sw.locks[segmentID]
+	if !ok {
+		l = &sync.Mutex{}
+		sw.locks[segmentID] = l
+	}
+	return l
+}
+
+// getSealedSegments returns all the sealed segments which could be swapped out
+func (sw *segmentSwapper) getSealedSegments() []*Segment {
+	var segments []*Segment
+	for _, collectionID := range sw.replica.getCollectionIDs() {
+		partitionIDs, err := sw.replica.getPartitionIDs(collectionID)
+		if err != nil {
+			continue
+		}
+		for _, partitionID := range partitionIDs {
+			segmentIDs, err := sw.replica.getSegmentIDs(partitionID)
+			if err != nil {
+				continue
+			}
+			for _, segmentID := range segmentIDs {
+				segment, err := sw.replica.getSegmentByID(segmentID)
+				if err != nil || segment.getType() != segmentTypeSealed {
+					continue
+				}
+				segments = append(segments, segment)
+			}
+		}
+	}
+	return segments
+}
+
+func (sw *segmentSwapper) swapOutIdleSegments() {
+	now := time.Now()
+	swapped := 0
+	for _, segment := range sw.getSealedSegments() {
+		if segment.isSwappedOut() {
+			swapped++
+			continue
+		}
+		idleTimeout := sw.getIdleTimeout(segment.collectionID)
+		if now.Sub(segment.getLastAccessTime()) < idleTimeout {
+			continue
+		}
+		ok, err := sw.swapOut(segment, idleTimeout)
+		if err != nil {
+			log.Warn("failed to swap out idle segment", zap.Int64("segmentID", segment.ID()), zap.Error(err))
+			continue
+		}
+		if ok {
+			swapped++
+		}
+	}
+	metrics.QueryNodeNumSwappedOutSegments.WithLabelValues(fmt.Sprint(Params.QueryNodeCfg.GetNodeID())).Set(float64(swapped))
+}
+
+// getIdleTimeout returns the idle time before the segments of the collection are swapped out, the segments are swapped
+// out once not accessed for a check interval if swapping is enabled by the collection, and kept in memory if disabled
+func (sw *segmentSwapper) getIdleTimeout(collectionID UniqueID) time.Duration {
+	collection, err := sw.replica.getCollectionByID(collectionID)
+	if err != nil {
+		return sw.idleTimeout
+	}
+	enabled, set := collection.getSwapEnabled()
+	switch {
+	case !set:
+		return sw.idleTimeout
+	case enabled:
+		return sw.checkInterval
+	default:
+		return time.Duration(math.MaxInt64)
+	}
+}
+
+// swapOut keeps all the files of the segment in local cache and frees the segment memory if it's still idle for
+// idleTimeout after the files are kept, returns false if the segment is accessed meanwhile
+func (sw *segmentSwapper) swapOut(segment *Segment, idleTimeout time.Duration) (bool, error) {
+	l := sw.segmentLock(segment.ID())
+	l.Lock()
+	defer l.Unlock()
+
+	loadInfo := segment.getLoadInfo()
+	if loadInfo == nil {
+		return false, fmt.Errorf("segment %d has no load info", segment.ID())
+	}
+	paths := getLoadInfoFilePaths(loadInfo)
+	// recorded ahead, so that the files kept are removed with the segment even if some of them fail
+	sw.mu.Lock()
+	sw.cached[segment.ID()] = paths
+	sw.mu.Unlock()
+	for _, p := range paths {
+		if sw.cacheCM.Exist(p) {
+			continue
+		}
+		content, err := sw.loader.cm.Read(p)
+		if err != nil {
+			return false, err
+		}
+		size := int64(len(content))
+		if !localDiskQuota.TryAcquire(size) {
+			used, limit := localDiskQuota.Usage()
+			return false, fmt.Errorf("local disk quota exceeded, segmentID = %d, size = %d, used = %d, quota = %d",
+				segment.ID(), size, used, limit)
+		}
+		if err = sw.cacheCM.Write(p, content); err != nil {
+			localDiskQuota.Release(size)
+			return false, err
+		}
+		sw.mu.Lock()
+		sw.charged[segment.ID()] += size
+		sw.mu.Unlock()
+	}
+
+	idleTime := time.Since(segment.getLastAccessTime())
+	ok, err := segment.swapOut(idleTimeout)
+	if !ok || err != nil {
+		return false, err
+	}
+	recordSegmentEvent(segmentEventEvicted, segment.collectionID, segment.partitionID, segment.ID(),
+		fmt.Sprintf("swapped out to local cache after being idle for %s", idleTime))
+	return true, nil
+}
+
+// swapIn re-materializes the segment from local cache if it has been swapped out
+func (sw *segmentSwapper) swapIn(ctx context.Context, segment *Segment) error {
+	if !segment.isSwappedOut() {
+		return nil
+	}
+	l := sw.segmentLock(segment.ID())
+	l.Lock()
+	defer l.Unlock()
+	if !segment.isSwappedOut() {
+		return nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "swapInSegment",
+		opentracing.Tags{
+			"segmentID": segment.segmentID,
+		})
+	defer sp.Finish()
+
+	collection, err := sw.replica.getCollectionByID(segment.collectionID)
+	if err != nil {
+		return err
+	}
+	loaded, err := newSegment(collection, segment.segmentID, segment.partitionID, segment.collectionID, segment.vChannelID, segmentTypeSealed, true)
+	if err != nil {
+		return err
+	}
+
+	// all files have been kept in local cache when swapping out
+	atomic.AddInt32(&sw.loader.loadingCount, 1)
+	defer atomic.AddInt32(&sw.loader.loadingCount, -1)
+	loadInfo := proto.Clone(segment.getLoadInfo()).(*querypb.SegmentLoadInfo)
+	if err = sw.loader.loadSegmentInternal(ctx, loaded, loadInfo, sw.cacheCM); err != nil {
+		trace.LogError(sp, err)
+		deleteSegment(loaded)
+		return err
+	}
+
+	ok, err := segment.swapIn(loaded)
+	if !ok {
+		deleteSegment(loaded)
+	} else {
+		sw.loader.gpuIndexes.transfer(loaded, segment)
+	}
+	if err == nil {
+		segment.touch()
+	}
+	return err
+}
+
+// removeStaleCache removes the local files of swapped out segments which have been released
+func (sw *segmentSwapper) removeStaleCache() {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	for segmentID, paths := range sw.cached {
+		if sw.replica.hasSegment(segmentID) {
+			continue
+		}
+		if err := sw.cacheCM.MultiRemove(paths); err != nil {
+			log.Warn("failed to remove swapped segment files from local cache", zap.Int64("segmentID", segmentID), zap.Error(err))
+			continue
+		}
+		delete(sw.cached, segmentID)
+		localDiskQuota.Release(sw.charged[segmentID])
+		delete(sw.charged, segmentID)
+		sw.lockMu.Lock()
+		delete(sw.locks, segmentID)
+		sw.lockMu.Unlock()
+	}
+}
+
+// getLoadInfoFilePaths returns all the file paths needed to load the segment
+func getLoadInfoFilePaths(loadInfo *querypb.SegmentLoadInfo) []string {
+	var paths []string
+	for _, fieldBinlog := range loadInfo.GetBinlogPaths() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			paths = append(paths, binlog.GetLogPath())
+		}
+	}
+	for _, fieldBinlog := range loadInfo.GetStatslogs() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			paths = append(paths, binlog.GetLogPath())
+		}
+	}
+	for _, fieldBinlog := range loadInfo.GetDeltalogs() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			paths = append(paths, binlog.GetLogPath())
+		}
+	}
+	for _, indexInfo := range loadInfo.GetIndexInfos() {
+		paths = append(paths, indexInfo.GetIndexFilePaths()...)
+	}
+	return paths
+}
@@ -0,0 +1,192 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestSegmentSwapper(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	Params.QueryNodeCfg.ColdSegmentSwapEnabled = true
+	defer func() {
+		Params.QueryNodeCfg.ColdSegmentSwapEnabled = false
+	}()
+
+	pkType := schemapb.DataType_Int64
+	schema := genTestCollectionSchema(pkType)
+	fieldBinlog, err := saveBinLog(ctx, defaultCollectionID, defaultPartitionID, defaultSegmentID, defaultMsgLength, schema)
+	assert.NoError(t, err)
+
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+	err = node.historical.replica.removeSegment(defaultSegmentID)
+	assert.NoError(t, err)
+
+	req := &querypb.LoadSegmentsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_LoadSegments,
+			MsgID:   rand.Int63(),
+		},
+		Schema: schema,
+		Infos: []*querypb.SegmentLoadInfo{
+			{
+				SegmentID:    defaultSegmentID,
+				PartitionID:  defaultPartitionID,
+				CollectionID: defaultCollectionID,
+				BinlogPaths:  fieldBinlog,
+			},
+		},
+	}
+	err = node.loader.loadSegment(ctx, req, segmentTypeSealed)
+	assert.NoError(t, err)
+
+	segment, err := node.historical.replica.getSegmentByID(defaultSegmentID)
+	assert.NoError(t, err)
+	assert.NotNil(t, segment.getLoadInfo())
+
+	cacheCM := storage.NewLocalChunkManager(storage.RootPath(defaultLocalStorage + "_swap"))
+	swapper := newSegmentSwapper(ctx, node.historical.replica, node.loader, cacheCM)
+	node.historical.swapper = swapper
+
+	localDiskQuota = storage.NewDiskQuota(1)
+	defer func() {
+		localDiskQuota = nil
+	}()
+
+	t.Run("test swap out exceeding the local disk quota", func(t *testing.T) {
+		_, err := swapper.swapOut(segment, 0)
+		assert.Error(t, err)
+		assert.False(t, segment.isSwappedOut())
+		localDiskQuota = storage.NewDiskQuota(1024 * 1024 * 1024)
+	})
+
+	t.Run("test swap out idle segment", func(t *testing.T) {
+		swapper.idleTimeout = 0
+		swapper.swapOutIdleSegments()
+		assert.True(t, segment.isSwappedOut())
+		assert.Equal(t, int64(-1), segment.getRowCount())
+		for _, p := range getLoadInfoFilePaths(segment.getLoadInfo()) {
+			assert.True(t, cacheCM.Exist(p))
+		}
+		used, _ := localDiskQuota.Usage()
+		assert.Greater(t, used, int64(0))
+	})
+
+	t.Run("test delete on swapped out segment", func(t *testing.T) {
+		pks := []primaryKey{newInt64PrimaryKey(0)}
+		tss := []Timestamp{1000}
+		offset := segment.segmentPreDelete(len(pks))
+		err := segment.segmentDelete(offset, pks, tss)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test swap in on access", func(t *testing.T) {
+		unpin, err := node.historical.ensureResident(ctx, segment)
+		assert.NoError(t, err)
+		assert.False(t, segment.isSwappedOut())
+		assert.Equal(t, int64(defaultMsgLength), segment.getRowCount())
+		assert.Equal(t, int64(1), segment.getDeletedCount())
+
+		// the pinned segment is not swapped out
+		swapper.swapOutIdleSegments()
+		assert.False(t, segment.isSwappedOut())
+		unpin()
+
+		// no-op for resident segment
+		unpin, err = node.historical.ensureResident(ctx, segment)
+		assert.NoError(t, err)
+		unpin()
+	})
+
+	t.Run("test segment accessed during swap out", func(t *testing.T) {
+		swapper.idleTimeout = time.Hour
+		segment.touch()
+		ok, err := swapper.swapOut(segment, swapper.idleTimeout)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.False(t, segment.isSwappedOut())
+		swapper.idleTimeout = 0
+	})
+
+	t.Run("test remove stale cache", func(t *testing.T) {
+		swapper.swapOutIdleSegments()
+		assert.True(t, segment.isSwappedOut())
+		paths := getLoadInfoFilePaths(segment.getLoadInfo())
+
+		err := node.historical.replica.removeSegment(defaultSegmentID)
+		assert.NoError(t, err)
+		swapper.removeStaleCache()
+		for _, p := range paths {
+			assert.False(t, cacheCM.Exist(p))
+		}
+		used, _ := localDiskQuota.Usage()
+		assert.Equal(t, int64(0), used)
+	})
+
+	t.Run("test swap out segment without load info", func(t *testing.T) {
+		seg, err := genSimpleSealedSegment(defaultMsgLength)
+		assert.NoError(t, err)
+		defer deleteSegment(seg)
+		_, err = swapper.swapOut(seg, 0)
+		assert.Error(t, err)
+		assert.False(t, seg.isSwappedOut())
+	})
+}
+
+func TestSegmentSwapper_getIdleTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+	swapper := newSegmentSwapper(ctx, node.historical.replica, node.loader, nil)
+	swapper.idleTimeout = time.Hour
+	swapper.checkInterval = time.Minute
+	assert.Equal(t, time.Hour, swapper.getIdleTimeout(defaultCollectionID))
+	assert.Equal(t, time.Hour, swapper.getIdleTimeout(defaultCollectionID+1))
+
+	collection, err := node.historical.replica.getCollectionByID(defaultCollectionID)
+	assert.NoError(t, err)
+	schema := proto.Clone(collection.Schema()).(*schemapb.CollectionSchema)
+	err = typeutil.SetCollectionProperties(schema, map[string]string{common.CollectionSwapEnabledParam: "true"})
+	assert.NoError(t, err)
+	assert.NoError(t, collection.updateSchema(schema))
+	assert.Equal(t, time.Minute, swapper.getIdleTimeout(defaultCollectionID))
+
+	schema = proto.Clone(schema).(*schemapb.CollectionSchema)
+	err = typeutil.SetCollectionProperties(schema, map[string]string{common.CollectionSwapEnabledParam: "false"})
+	assert.NoError(t, err)
+	assert.NoError(t, collection.updateSchema(schema))
+	assert.Equal(t, time.Duration(math.MaxInt64), swapper.getIdleTimeout(defaultCollectionID))
+}
@@ -87,6 +87,25 @@ func TestSegment_deleteSegment(t *testing.T) {
 		s.segmentPtr = nil
 		deleteSegment(s)
 	})
+
+	t.Run("test delete referred segment", func(t *testing.T) {
+		s, err := genSimpleSealedSegment(defaultMsgLength)
+		assert.NoError(t, err)
+		s.acquireRef()
+		s.acquireRef()
+
+		_, err = s.swapOut(0)
+		assert.Error(t, err)
+
+		// freed by the last reference
+		deleteSegment(s)
+		assert.NotNil(t, s.segmentPtr)
+		s.releaseRef()
+		assert.NotNil(t, s.segmentPtr)
+		s.releaseRef()
+		assert.Nil(t, s.segmentPtr)
+		assert.Equal(t, int32(0), s.refCount)
+	})
 }
 
 //-------------------------------------------------------------------------------------- stats functions
@@ -207,7 +226,7 @@ func TestSegment_retrieve(t *testing.T) {
 	defer plan.delete()
 	assert.NoError(t, err)
 
-	res, err := segment.retrieve(plan)
+	res, err := segment.retrieve(context.Background(), plan)
 	assert.NoError(t, err)
 
 	assert.Equal(t, res.GetFieldsData()[0].GetScalars().Data.(*schemapb.ScalarField_IntData).IntData.Data, []int32{1, 2, 3})
@@ -431,7 +450,7 @@ func TestSegment_segmentSearch(t *testing.T) {
 	placeholderGroups := make([]*searchRequest, 0)
 	placeholderGroups = append(placeholderGroups, holder)
 
-	searchResult, err := segment.search(plan, placeholderGroups, []Timestamp{0})
+	searchResult, err := segment.search(context.Background(), plan, placeholderGroups, []Timestamp{0})
 	assert.NoError(t, err)
 
 	err = checkSearchResult(nq, plan, searchResult)
@@ -744,6 +763,51 @@ func TestSegment_fillIndexedFieldsData(t *testing.T) {
 	})
 }
 
+func Test_compactDeletes(t *testing.T) {
+	pks := []primaryKey{newInt64PrimaryKey(1), newInt64PrimaryKey(1), newInt64PrimaryKey(2), newInt64PrimaryKey(1),
+		newVarCharPrimaryKey("a"), newVarCharPrimaryKey("a"), newInt64PrimaryKey(2)}
+	tss := []Timestamp{5, 20, 30, 15, 40, 35, 25}
+	pks, tss = compactDeletes(pks, tss, 10)
+	// the deletes before the max insert ts are all kept
+	assert.Equal(t, []primaryKey{newInt64PrimaryKey(1), newInt64PrimaryKey(1), newInt64PrimaryKey(2), newVarCharPrimaryKey("a")}, pks)
+	assert.Equal(t, []Timestamp{5, 15, 25, 35}, tss)
+
+	// unknown max insert ts
+	pks = []primaryKey{newInt64PrimaryKey(1), newInt64PrimaryKey(1)}
+	pks, tss = compactDeletes(pks, []Timestamp{20, 30}, 0)
+	assert.Equal(t, 2, len(pks))
+	assert.Equal(t, []Timestamp{20, 30}, tss)
+}
+
+func TestSegment_recordDeletes(t *testing.T) {
+	maxRecorded := Params.QueryNodeCfg.ColdSegmentMaxRecordedDeletes
+	defer func() { Params.QueryNodeCfg.ColdSegmentMaxRecordedDeletes = maxRecorded }()
+	Params.QueryNodeCfg.ColdSegmentMaxRecordedDeletes = 4
+
+	s := &Segment{segmentID: defaultSegmentID}
+	s.setMaxInsertTs(10)
+	for ts := Timestamp(11); ts < 20; ts++ {
+		s.recordDeletes([]primaryKey{newInt64PrimaryKey(1)}, []Timestamp{ts})
+	}
+	// compacted to the earliest delete of the pk
+	pks, tss, maxTs, err := s.getAppliedDeletes()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pks))
+	assert.Equal(t, []Timestamp{11}, tss)
+	assert.Equal(t, Timestamp(11), maxTs)
+
+	// stop recording if still exceeding half of the cap after compaction
+	for pk := int64(2); pk < 6; pk++ {
+		s.recordDeletes([]primaryKey{newInt64PrimaryKey(pk)}, []Timestamp{20})
+	}
+	_, _, _, err = s.getAppliedDeletes()
+	assert.Error(t, err)
+	s.recordDeletes([]primaryKey{newInt64PrimaryKey(6)}, []Timestamp{21})
+	assert.Nil(t, s.deletedPks)
+	err = s.forwardDeletes(&Segment{})
+	assert.Error(t, err)
+}
+
 func Test_getFieldDataPath(t *testing.T) {
 	indexedFieldInfo := &IndexedFieldInfo{
 		fieldBinlog: &datapb.FieldBinlog{
@@ -18,10 +18,15 @@ package querynode
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/opentracing/opentracing-go"
 
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -29,8 +34,12 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/util/errorutil"
+	"github.com/milvus-io/milvus/internal/util/logutil"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/trace"
 )
 
 type shardClusterState int32
@@ -40,6 +49,17 @@ const (
 	unavailable shardClusterState = 2
 )
 
+func (s shardClusterState) String() string {
+	switch s {
+	case available:
+		return "Available"
+	case unavailable:
+		return "Unavailable"
+	default:
+		return "Unknown"
+	}
+}
+
 type nodeEventType int32
 
 const (
@@ -63,6 +83,19 @@ const (
 	segmentStateLoaded  segmentState = 3
 )
 
+func (s segmentState) String() string {
+	switch s {
+	case segmentStateOffline:
+		return "Offline"
+	case segmentStateLoading:
+		return "Loading"
+	case segmentStateLoaded:
+		return "Loaded"
+	default:
+		return "None"
+	}
+}
+
 type nodeEvent struct {
 	eventType nodeEventType
 	nodeID    int64
@@ -81,6 +114,7 @@ type shardQueryNode interface {
 	Search(context.Context, *querypb.SearchRequest) (*internalpb.SearchResults, error)
 	Query(context.Context, *querypb.QueryRequest) (*internalpb.RetrieveResults, error)
 	ReleaseSegments(ctx context.Context, in *querypb.ReleaseSegmentsRequest) (*commonpb.Status, error)
+	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	Stop() error
 }
 
@@ -131,6 +165,9 @@ type ShardCluster struct {
 	lastToken      *atomic.Int32                        // last token used for segment change info
 	segmentCond    *sync.Cond                           // segment state change condition
 	rcCond         *sync.Cond                           // segment rc change condition
+	segmentNodes   map[int64][]int64                    // segment id => nodes of all replicas loaded it
+	balancer       *shardLoadBalancer                   // nil if search routing across replicas is disabled
+	breakers       *shardNodeBreakers                   // circuit breakers of the searched nodes
 
 	closeOnce sync.Once
 	closeCh   chan struct{}
@@ -150,10 +187,12 @@ func NewShardCluster(collectionID int64, replicaID int64, vchannelName string,
 		segmentDetector: segmentDetector,
 		nodeBuilder:     nodeBuilder,
 
-		nodes:     make(map[int64]*shardNode),
-		segments:  make(map[int64]*shardSegmentInfo),
-		handoffs:  make(map[int32]*querypb.SegmentChangeInfo),
-		lastToken: atomic.NewInt32(0),
+		nodes:        make(map[int64]*shardNode),
+		segments:     make(map[int64]*shardSegmentInfo),
+		handoffs:     make(map[int32]*querypb.SegmentChangeInfo),
+		lastToken:    atomic.NewInt32(0),
+		segmentNodes: make(map[int64][]int64),
+		breakers:     newShardNodeBreakers(),
 
 		closeCh: make(chan struct{}),
 	}
@@ -191,6 +230,7 @@ func (sc *ShardCluster) addNode(evt nodeEvent) {
 		defer oldNode.client.Stop()
 	}
 
+	sc.breakers.reset(evt.nodeID)
 	sc.nodes[evt.nodeID] = &shardNode{
 		nodeID:   evt.nodeID,
 		nodeAddr: evt.nodeAddr,
@@ -361,6 +401,7 @@ func (sc *ShardCluster) init() {
 	// list segments
 	segments, segmentEvtCh := sc.segmentDetector.watchSegments(sc.collectionID, sc.replicaID, sc.vchannelName)
 	for _, segment := range segments {
+		sc.updateSegmentNodes(segment)
 		info, ok := sc.pickNode(segment)
 		if ok {
 			sc.updateSegment(info)
@@ -371,6 +412,67 @@ func (sc *ShardCluster) init() {
 	sc.healthCheck()
 }
 
+// updateSegmentNodes records the nodes of all replicas which have loaded the segment
+func (sc *ShardCluster) updateSegmentNodes(evt segmentEvent) {
+	sc.mut.Lock()
+	defer sc.mut.Unlock()
+	switch evt.eventType {
+	case segmentAdd:
+		sc.segmentNodes[evt.segmentID] = append([]int64(nil), evt.nodeIDs...)
+	case segmentDel:
+		delete(sc.segmentNodes, evt.segmentID)
+	}
+}
+
+// enableLoadBalance enables routing the sub-search requests across the replicas by the loads of the nodes,
+// the addresses of the nodes of other replicas are resolved by resolver.
+func (sc *ShardCluster) enableLoadBalance(resolver addrResolver) {
+	balancer := newShardLoadBalancer(resolver, sc.nodeBuilder)
+	sc.mut.Lock()
+	sc.balancer = balancer
+	sc.mut.Unlock()
+	go sc.refreshLoadsLoop(balancer)
+}
+
+// refreshLoadsLoop refreshes the peers and the loads of the nodes periodically
+func (sc *ShardCluster) refreshLoadsLoop(balancer *shardLoadBalancer) {
+	ticker := time.NewTicker(Params.QueryNodeCfg.ReplicaLoadReportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			sc.refreshLoads(balancer)
+		case <-sc.closeCh:
+			balancer.close()
+			log.Info("ShardCluster refreshLoadsLoop quit", zap.Int64("collectionID", sc.collectionID), zap.Int64("replicaID", sc.replicaID), zap.String("vchannelName", sc.vchannelName))
+			return
+		}
+	}
+}
+
+// refreshLoads updates the peers of other replicas by the segment events, then polls the loads of all nodes
+func (sc *ShardCluster) refreshLoads(balancer *shardLoadBalancer) {
+	sc.mut.RLock()
+	nodes := make([]*shardNode, 0, len(sc.nodes))
+	for _, node := range sc.nodes {
+		nodes = append(nodes, node)
+	}
+	peerIDs := make(map[int64]struct{})
+	for _, nodeIDs := range sc.segmentNodes {
+		for _, nodeID := range nodeIDs {
+			if _, ok := sc.nodes[nodeID]; !ok {
+				peerIDs[nodeID] = struct{}{}
+			}
+		}
+	}
+	sc.mut.RUnlock()
+
+	balancer.updatePeers(peerIDs)
+	ctx, cancel := context.WithTimeout(context.Background(), Params.QueryNodeCfg.ReplicaLoadReportInterval)
+	defer cancel()
+	balancer.refresh(ctx, nodes)
+}
+
 // pickNode selects node id in cluster
 func (sc *ShardCluster) pickNode(evt segmentEvent) (shardSegmentInfo, bool) {
 	for _, nodeID := range evt.nodeIDs {
@@ -429,6 +531,7 @@ func (sc *ShardCluster) watchSegments(evtCh <-chan segmentEvent) {
 				log.Warn("ShardCluster segment channel closed", zap.Int64("collectionID", sc.collectionID), zap.Int64("replicaID", sc.replicaID))
 				return
 			}
+			sc.updateSegmentNodes(evt)
 			info, ok := sc.pickNode(evt)
 			if !ok {
 				continue
@@ -499,6 +602,59 @@ func (sc *ShardCluster) segmentAllocations(partitionIDs []int64) map[int64][]int
 	return result
 }
 
+// routeAllocations routes the segments to the least loaded nodes among all replicas which have loaded them,
+// the nodes lagging behind guaranteeTs are skipped, returns the node to segments mappings and the owner node in this replica of each segment.
+func (sc *ShardCluster) routeAllocations(allocs map[int64][]int64, guaranteeTs Timestamp) (map[int64][]int64, map[int64]int64) {
+	owners := make(map[int64]int64)
+	for nodeID, segments := range allocs {
+		for _, segmentID := range segments {
+			owners[segmentID] = nodeID
+		}
+	}
+
+	sc.mut.RLock()
+	defer sc.mut.RUnlock()
+	if sc.balancer == nil {
+		return allocs, owners
+	}
+	routes := make(map[int64][]int64)
+	for segmentID, ownerID := range owners {
+		// route around the nodes whose circuit breakers are open or lagging behind
+		candidates := make([]int64, 0, len(sc.segmentNodes[segmentID]))
+		for _, nodeID := range sc.segmentNodes[segmentID] {
+			if !sc.avoided(nodeID, guaranteeTs) {
+				candidates = append(candidates, nodeID)
+			}
+		}
+		nodeID := sc.balancer.pick(ownerID, candidates)
+		if nodeID == ownerID && sc.avoided(ownerID, guaranteeTs) {
+			if peerID, ok := sc.balancer.pickPeer(candidates); ok {
+				nodeID = peerID
+			}
+		}
+		routes[nodeID] = append(routes[nodeID], segmentID)
+	}
+	return routes, owners
+}
+
+// avoided returns true if the node shall be routed around
+// Note that sc.mut RLock is assumed to be hold outside of this function!
+func (sc *ShardCluster) avoided(nodeID int64, guaranteeTs Timestamp) bool {
+	return sc.breakers.tripped(nodeID) || sc.balancer.lagging(nodeID, sc.vchannelName, guaranteeTs)
+}
+
+// admit checks whether the request could be sent to the node,
+// the node lagging behind guaranteeTs is rejected instead of blocking the request.
+func (sc *ShardCluster) admit(balancer *shardLoadBalancer, nodeID int64, guaranteeTs Timestamp) error {
+	if balancer.lagging(nodeID, sc.vchannelName, guaranteeTs) {
+		return fmt.Errorf("serviceable time of node %d lags behind guarantee timestamp %d", nodeID, guaranteeTs)
+	}
+	if !sc.breakers.allow(nodeID) {
+		return errCircuitOpen(nodeID)
+	}
+	return nil
+}
+
 // inHandoffOffline checks whether segment is pending handoff offline list
 // Note that sc.mut Lock is assumed to be hold outside of this function!
 // legacySegments will no be checked as same segment is in another node with loaded state
@@ -695,23 +851,117 @@ func (sc *ShardCluster) segmentsInUse(segments []shardSegmentInfo) bool {
 	return false
 }
 
-// Search preforms search operation on shard cluster.
-func (sc *ShardCluster) Search(ctx context.Context, req *querypb.SearchRequest) ([]*internalpb.SearchResults, error) {
+// describe returns the members, segment distribution and health of the shard cluster
+func (sc *ShardCluster) describe() metricsinfo.ShardClusterInfo {
+	sc.mut.RLock()
+	defer sc.mut.RUnlock()
+
+	info := metricsinfo.ShardClusterInfo{
+		CollectionID:    sc.collectionID,
+		ReplicaID:       sc.replicaID,
+		VChannel:        sc.vchannelName,
+		State:           shardClusterState(sc.state.Load()).String(),
+		Nodes:           make([]metricsinfo.ShardClusterNode, 0, len(sc.nodes)),
+		Segments:        make([]metricsinfo.ShardClusterSegment, 0, len(sc.segments)),
+		PendingHandoffs: len(sc.handoffs),
+	}
+	for _, node := range sc.nodes {
+		info.Nodes = append(info.Nodes, metricsinfo.ShardClusterNode{
+			NodeID:         node.nodeID,
+			Address:        node.nodeAddr,
+			CircuitBreaker: sc.breakers.state(node.nodeID).String(),
+		})
+	}
+	var reasons []string
+	for _, segment := range sc.segments {
+		info.Segments = append(info.Segments, metricsinfo.ShardClusterSegment{
+			SegmentID:   segment.segmentID,
+			PartitionID: segment.partitionID,
+			NodeID:      segment.nodeID,
+			State:       segment.state.String(),
+			InUse:       segment.inUse,
+		})
+		if segment.state != segmentStateLoaded {
+			reasons = append(reasons, fmt.Sprintf("segment %d is %s on node %d", segment.segmentID, segment.state.String(), segment.nodeID))
+		} else if _, ok := sc.nodes[segment.nodeID]; !ok {
+			reasons = append(reasons, fmt.Sprintf("node %d of segment %d is offline", segment.nodeID, segment.segmentID))
+		}
+	}
+	sort.Slice(info.Nodes, func(i, j int) bool {
+		return info.Nodes[i].NodeID < info.Nodes[j].NodeID
+	})
+	sort.Slice(info.Segments, func(i, j int) bool {
+		return info.Segments[i].SegmentID < info.Segments[j].SegmentID
+	})
+	if info.State != available.String() {
+		sort.Strings(reasons)
+		info.UnavailableReason = strings.Join(reasons, "; ")
+	}
+	return info
+}
+
+// sealedSnapshot is the sealed segments visible to a search/query request.
+// The growing segments of the shard leader with the same ids are excluded from the request,
+// so a segment is searched exactly once even if it's being handed off from streaming to historical.
+type sealedSnapshot struct {
+	allocs   map[int64][]int64 // nodeID => segmentIDs
+	segments map[int64]struct{}
+}
+
+// contains returns true if the segment is searched as sealed segment
+func (s *sealedSnapshot) contains(segmentID int64) bool {
+	_, ok := s.segments[segmentID]
+	return ok
+}
+
+// acquireSealed takes the snapshot of sealed segments and increases the reference count of them,
+// releaseSealed must be called after the request is done.
+func (sc *ShardCluster) acquireSealed(dmlChannel string, partitionIDs []int64) (*sealedSnapshot, error) {
 	if sc.state.Load() != int32(available) {
 		return nil, fmt.Errorf("ShardCluster for %s replicaID %d is no available", sc.vchannelName, sc.replicaID)
 	}
 
-	if sc.vchannelName != req.GetDmlChannel() {
-		return nil, fmt.Errorf("ShardCluster for %s does not match to request channel :%s", sc.vchannelName, req.GetDmlChannel())
+	// handles only the dml channel part, segment ids is dispatch by cluster itself
+	if sc.vchannelName != dmlChannel {
+		return nil, fmt.Errorf("ShardCluster for %s does not match to request channel :%s", sc.vchannelName, dmlChannel)
 	}
 
 	// get node allocation and maintains the inUse reference count
-	segAllocs := sc.segmentAllocations(req.GetReq().GetPartitionIDs())
-	defer sc.finishUsage(segAllocs)
+	snapshot := &sealedSnapshot{
+		allocs:   sc.segmentAllocations(partitionIDs),
+		segments: make(map[int64]struct{}),
+	}
+	for _, segmentIDs := range snapshot.allocs {
+		for _, segmentID := range segmentIDs {
+			snapshot.segments[segmentID] = struct{}{}
+		}
+	}
+	return snapshot, nil
+}
+
+// releaseSealed decreases the reference count of the segments in snapshot
+func (sc *ShardCluster) releaseSealed(snapshot *sealedSnapshot) {
+	sc.finishUsage(snapshot.allocs)
+}
+
+// Search preforms search operation on shard cluster.
+func (sc *ShardCluster) Search(ctx context.Context, req *querypb.SearchRequest) ([]*internalpb.SearchResults, error) {
+	snapshot, err := sc.acquireSealed(req.GetDmlChannel(), req.GetReq().GetPartitionIDs())
+	if err != nil {
+		return nil, err
+	}
+	defer sc.releaseSealed(snapshot)
+	return sc.searchSealed(ctx, req, snapshot)
+}
 
-	log.Debug("cluster segment distribution", zap.Int("len", len(segAllocs)))
-	for nodeID, segmentIDs := range segAllocs {
-		log.Debug("segments distribution", zap.Int64("nodeID", nodeID), zap.Int64s("segments", segmentIDs))
+// searchSealed performs search operation on the sealed segments of snapshot.
+func (sc *ShardCluster) searchSealed(ctx context.Context, req *querypb.SearchRequest, snapshot *sealedSnapshot) ([]*internalpb.SearchResults, error) {
+	// segments keep in use on the owner nodes even if routed to other replicas, in case of falling back
+	routes, owners := sc.routeAllocations(snapshot.allocs, req.GetReq().GetGuaranteeTimestamp())
+
+	logutil.Logger(ctx).Debug("cluster segment distribution", zap.Int("len", len(routes)))
+	for nodeID, segmentIDs := range routes {
+		logutil.Logger(ctx).Debug("segments distribution", zap.Int64("nodeID", nodeID), zap.Int64s("segments", segmentIDs))
 	}
 
 	// concurrent visiting nodes
@@ -721,55 +971,135 @@ func (sc *ShardCluster) Search(ctx context.Context, req *querypb.SearchRequest)
 
 	var err error
 	var resultMut sync.Mutex
-	results := make([]*internalpb.SearchResults, 0, len(segAllocs)+1) // count(nodes) + 1(growing)
+	results := make([]*internalpb.SearchResults, 0, len(routes)+1) // count(nodes) + 1(growing)
 
-	for nodeID, segments := range segAllocs {
-		nodeReq := proto.Clone(req).(*querypb.SearchRequest)
-		nodeReq.IsShardLeader = false
-		nodeReq.SegmentIDs = segments
-		node, ok := sc.getNode(nodeID)
+	for nodeID, segments := range routes {
+		_, ok := sc.getNode(nodeID)
+		if !ok {
+			_, ok = sc.getBalancer().getPeer(nodeID)
+		}
 		if !ok { // meta dismatch, report error
 			return nil, fmt.Errorf("ShardCluster for %s replicaID %d is no available", sc.vchannelName, sc.replicaID)
 		}
+		nodeID, segments := nodeID, segments
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			partialResult, nodeErr := node.client.Search(reqCtx, nodeReq)
+			partialResults, nodeErr := sc.searchRouted(reqCtx, req, nodeID, segments, owners)
 			resultMut.Lock()
 			defer resultMut.Unlock()
-			if nodeErr != nil || partialResult.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			if nodeErr != nil {
 				cancel()
-				err = fmt.Errorf("Search %d failed, reason %s err %w", node.nodeID, partialResult.GetStatus().GetReason(), nodeErr)
+				err = nodeErr
 				return
 			}
-			results = append(results, partialResult)
+			results = append(results, partialResults...)
 		}()
 	}
 
 	wg.Wait()
 	if err != nil {
-		log.Error(err.Error())
+		logutil.Logger(ctx).Error(err.Error())
 		return nil, err
 	}
 
 	return results, nil
 }
 
-// Query performs query operation on shard cluster.
-func (sc *ShardCluster) Query(ctx context.Context, req *querypb.QueryRequest) ([]*internalpb.RetrieveResults, error) {
-	if sc.state.Load() != int32(available) {
-		return nil, fmt.Errorf("ShardCluster for %s replicaID %d is no available", sc.vchannelName, sc.replicaID)
+// getBalancer returns the load balancer, nil if routing across replicas is disabled
+func (sc *ShardCluster) getBalancer() *shardLoadBalancer {
+	sc.mut.RLock()
+	defer sc.mut.RUnlock()
+	return sc.balancer
+}
+
+// searchRouted searches the segments on the routed node,
+// the segments routed to the node of other replica fall back to the owner nodes if the search failed.
+func (sc *ShardCluster) searchRouted(ctx context.Context, req *querypb.SearchRequest, nodeID int64, segments []int64, owners map[int64]int64) ([]*internalpb.SearchResults, error) {
+	balancer := sc.getBalancer()
+	guaranteeTs := req.GetReq().GetGuaranteeTimestamp()
+	if node, ok := sc.getNode(nodeID); ok {
+		if err := sc.admit(balancer, nodeID, guaranteeTs); err != nil {
+			return nil, err
+		}
+		done := balancer.dispatch(nodeID)
+		defer done()
+		result, err := searchShardNode(ctx, node, req, segments)
+		sc.breakers.report(ctx, nodeID, err)
+		if err != nil {
+			return nil, err
+		}
+		return []*internalpb.SearchResults{result}, nil
 	}
 
-	// handles only the dml channel part, segment ids is dispatch by cluster itself
-	if sc.vchannelName != req.GetDmlChannel() {
-		return nil, fmt.Errorf("ShardCluster for %s does not match to request channel :%s", sc.vchannelName, req.GetDmlChannel())
+	if peer, ok := balancer.getPeer(nodeID); ok && sc.admit(balancer, nodeID, guaranteeTs) == nil {
+		done := balancer.dispatch(nodeID)
+		result, err := searchShardNode(ctx, peer, req, segments)
+		done()
+		sc.breakers.report(ctx, nodeID, err)
+		if err == nil {
+			return []*internalpb.SearchResults{result}, nil
+		}
+		logutil.Logger(ctx).Warn("search on other replica failed, fall back to owner nodes", zap.Int64("collectionID", sc.collectionID), zap.Int64("replicaID", sc.replicaID),
+			zap.Int64("nodeID", nodeID), zap.Int64s("segments", segments), zap.Error(err))
 	}
 
-	// get node allocation and maintains the inUse reference count
-	segAllocs := sc.segmentAllocations(req.GetReq().GetPartitionIDs())
-	defer sc.finishUsage(segAllocs)
+	ownerAllocs := make(map[int64][]int64)
+	for _, segmentID := range segments {
+		ownerAllocs[owners[segmentID]] = append(ownerAllocs[owners[segmentID]], segmentID)
+	}
+	results := make([]*internalpb.SearchResults, 0, len(ownerAllocs))
+	for ownerID, ownerSegments := range ownerAllocs {
+		node, ok := sc.getNode(ownerID)
+		if !ok {
+			return nil, fmt.Errorf("ShardCluster for %s replicaID %d is no available", sc.vchannelName, sc.replicaID)
+		}
+		if err := sc.admit(balancer, ownerID, guaranteeTs); err != nil {
+			return nil, err
+		}
+		result, err := searchShardNode(ctx, node, req, ownerSegments)
+		sc.breakers.report(ctx, ownerID, err)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// searchShardNode searches the segments on the node
+func searchShardNode(ctx context.Context, node *shardNode, req *querypb.SearchRequest, segments []int64) (*internalpb.SearchResults, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "searchShardNode",
+		opentracing.Tags{
+			"nodeID":        node.nodeID,
+			"numOfSegments": len(segments),
+		})
+	defer sp.Finish()
+
+	nodeReq := proto.Clone(req).(*querypb.SearchRequest)
+	nodeReq.IsShardLeader = false
+	nodeReq.SegmentIDs = segments
+	result, err := node.client.Search(ctx, nodeReq)
+	if err != nil || result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		err = fmt.Errorf("Search %d failed, reason %s err %w", node.nodeID, result.GetStatus().GetReason(), err)
+		trace.LogError(sp, err)
+		return nil, err
+	}
+	return result, nil
+}
+
+// Query performs query operation on shard cluster.
+func (sc *ShardCluster) Query(ctx context.Context, req *querypb.QueryRequest) ([]*internalpb.RetrieveResults, error) {
+	snapshot, err := sc.acquireSealed(req.GetDmlChannel(), req.GetReq().GetPartitionIDs())
+	if err != nil {
+		return nil, err
+	}
+	defer sc.releaseSealed(snapshot)
+	return sc.querySealed(ctx, req, snapshot)
+}
 
+// querySealed performs query operation on the sealed segments of snapshot.
+func (sc *ShardCluster) querySealed(ctx context.Context, req *querypb.QueryRequest, snapshot *sealedSnapshot) ([]*internalpb.RetrieveResults, error) {
 	// concurrent visiting nodes
 	var wg sync.WaitGroup
 	reqCtx, cancel := context.WithCancel(ctx)
@@ -777,9 +1107,10 @@ func (sc *ShardCluster) Query(ctx context.Context, req *querypb.QueryRequest) ([
 
 	var err error
 	var resultMut sync.Mutex
-	results := make([]*internalpb.RetrieveResults, 0, len(segAllocs)+1) // count(nodes) + 1(growing)
+	results := make([]*internalpb.RetrieveResults, 0, len(snapshot.allocs)+1) // count(nodes) + 1(growing)
 
-	for nodeID, segments := range segAllocs {
+	balancer := sc.getBalancer()
+	for nodeID, segments := range snapshot.allocs {
 		nodeReq := proto.Clone(req).(*querypb.QueryRequest)
 		nodeReq.IsShardLeader = false
 		nodeReq.SegmentIDs = segments
@@ -787,13 +1118,27 @@ func (sc *ShardCluster) Query(ctx context.Context, req *querypb.QueryRequest) ([
 		if !ok { // meta dismatch, report error
 			return nil, fmt.Errorf("SharcCluster for %s replicaID %d is no available", sc.vchannelName, sc.replicaID)
 		}
+		if err := sc.admit(balancer, nodeID, req.GetReq().GetGuaranteeTimestamp()); err != nil {
+			return nil, err
+		}
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			partialResult, nodeErr := node.client.Query(reqCtx, nodeReq)
+			sp, nodeCtx := trace.StartSpanFromContextWithOperationName(reqCtx, "queryShardNode",
+				opentracing.Tags{
+					"nodeID":        node.nodeID,
+					"numOfSegments": len(nodeReq.SegmentIDs),
+				})
+			defer sp.Finish()
+			partialResult, nodeErr := node.client.Query(nodeCtx, nodeReq)
+			if nodeErr == nil && partialResult.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+				nodeErr = errors.New(partialResult.GetStatus().GetReason())
+			}
+			trace.LogError(sp, nodeErr)
+			sc.breakers.report(reqCtx, node.nodeID, nodeErr)
 			resultMut.Lock()
 			defer resultMut.Unlock()
-			if nodeErr != nil || partialResult.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			if nodeErr != nil {
 				cancel()
 				err = fmt.Errorf("Query %d failed, reason %s err %w", node.nodeID, partialResult.GetStatus().GetReason(), nodeErr)
 				return
@@ -804,7 +1149,7 @@ func (sc *ShardCluster) Query(ctx context.Context, req *querypb.QueryRequest) ([
 
 	wg.Wait()
 	if err != nil {
-		log.Error(err.Error())
+		logutil.Logger(ctx).Error(err.Error())
 		return nil, err
 	}
 
@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"sort"
 	"strconv"
 	"sync"
 
+	"github.com/golang/protobuf/proto"
 	grpcquerynodeclient "github.com/milvus-io/milvus/internal/distributed/querynode/client"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -19,6 +23,8 @@ import (
 
 const (
 	ReplicaMetaPrefix = "queryCoord-ReplicaMeta"
+	// standbyReplicaPrefix is the prefix of the standby replicas written by QueryCoord, the key is {prefix}/{collectionID}/{replicaID}
+	standbyReplicaPrefix = "queryCoord-standbyReplica"
 )
 
 // shardQueryNodeWrapper wraps a querynode to shardQueryNode and preventing it been closed
@@ -50,18 +56,18 @@ func newShardClusterService(client *clientv3.Client, session *sessionutil.Sessio
 
 // addShardCluster adds shardCluster into service.
 func (s *ShardClusterService) addShardCluster(collectionID, replicaID int64, vchannelName string) {
-	nodeDetector := NewEtcdShardNodeDetector(s.client, path.Join(Params.EtcdCfg.MetaRootPath, ReplicaMetaPrefix),
-		func() (map[int64]string, error) {
-			result := make(map[int64]string)
-			sessions, _, err := s.session.GetSessions(typeutil.QueryNodeRole)
-			if err != nil {
-				return nil, err
-			}
-			for _, session := range sessions {
-				result[session.ServerID] = session.Address
-			}
-			return result, nil
-		})
+	resolver := func() (map[int64]string, error) {
+		result := make(map[int64]string)
+		sessions, _, err := s.session.GetSessions(typeutil.QueryNodeRole)
+		if err != nil {
+			return nil, err
+		}
+		for _, session := range sessions {
+			result[session.ServerID] = session.Address
+		}
+		return result, nil
+	}
+	nodeDetector := NewEtcdShardNodeDetector(s.client, path.Join(Params.EtcdCfg.MetaRootPath, ReplicaMetaPrefix), resolver)
 
 	segmentDetector := NewEtcdShardSegmentDetector(s.client, path.Join(Params.EtcdCfg.MetaRootPath, util.SegmentMetaPrefix, strconv.FormatInt(collectionID, 10)))
 
@@ -75,11 +81,55 @@ func (s *ShardClusterService) addShardCluster(collectionID, replicaID int64, vch
 			qn, _ := grpcquerynodeclient.NewClient(ctx, addr)
 			return qn
 		})
+	if Params.QueryNodeCfg.ReplicaLoadBalanceEnabled {
+		// the nodes of standby replicas are excluded from routing until promoted
+		cs.enableLoadBalance(func() (map[int64]string, error) {
+			addrs, err := resolver()
+			if err != nil {
+				return nil, err
+			}
+			standbyNodes, err := s.getStandbyNodes(collectionID)
+			if err != nil {
+				return nil, err
+			}
+			for nodeID := range standbyNodes {
+				delete(addrs, nodeID)
+			}
+			return addrs, nil
+		})
+	}
 
 	s.clusters.Store(vchannelName, cs)
 	log.Info("successfully add shard cluster", zap.Int64("collectionID", collectionID), zap.Int64("replica", replicaID), zap.String("vchan", vchannelName))
 }
 
+// getStandbyNodes returns the nodes of the standby replicas of the collection
+func (s *ShardClusterService) getStandbyNodes(collectionID int64) (map[int64]struct{}, error) {
+	ctx := context.Background()
+	prefix := path.Join(Params.EtcdCfg.MetaRootPath, standbyReplicaPrefix, strconv.FormatInt(collectionID, 10)) + "/"
+	resp, err := s.client.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	nodes := make(map[int64]struct{})
+	for _, kv := range resp.Kvs {
+		replicaResp, err := s.client.Get(ctx, path.Join(Params.EtcdCfg.MetaRootPath, ReplicaMetaPrefix, path.Base(string(kv.Key))))
+		if err != nil {
+			return nil, err
+		}
+		for _, replicaKv := range replicaResp.Kvs {
+			info := &milvuspb.ReplicaInfo{}
+			if err := proto.Unmarshal(replicaKv.Value, info); err != nil {
+				return nil, err
+			}
+			for _, nodeID := range info.GetNodeIds() {
+				nodes[nodeID] = struct{}{}
+			}
+		}
+	}
+	return nodes, nil
+}
+
 // getShardCluster gets shardCluster of specified vchannel if exists.
 func (s *ShardClusterService) getShardCluster(vchannelName string) (*ShardCluster, bool) {
 	raw, ok := s.clusters.Load(vchannelName)
@@ -89,6 +139,21 @@ func (s *ShardClusterService) getShardCluster(vchannelName string) (*ShardCluste
 	return raw.(*ShardCluster), true
 }
 
+// describe returns the state of the shard clusters led by this querynode ordered by vchannel
+func (s *ShardClusterService) describe() []metricsinfo.ShardClusterInfo {
+	infos := make([]metricsinfo.ShardClusterInfo, 0)
+	s.clusters.Range(func(k, v interface{}) bool {
+		info := v.(*ShardCluster).describe()
+		info.LeaderID = s.session.ServerID
+		infos = append(infos, info)
+		return true
+	})
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].VChannel < infos[j].VChannel
+	})
+	return infos
+}
+
 // releaseShardCluster removes shardCluster from service and stops it.
 func (s *ShardClusterService) releaseShardCluster(vchannelName string) error {
 	raw, ok := s.clusters.LoadAndDelete(vchannelName)
@@ -2,8 +2,12 @@ package querynode
 
 import (
 	"context"
+	"path"
+	"strconv"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/stretchr/testify/assert"
@@ -28,8 +32,15 @@ func TestShardClusterService(t *testing.T) {
 	_, ok = clusterService.getShardCluster("non-exist-channel")
 	assert.False(t, ok)
 
+	infos := clusterService.describe()
+	require.Equal(t, 1, len(infos))
+	assert.Equal(t, int64(defaultCollectionID), infos[0].CollectionID)
+	assert.Equal(t, defaultDMLChannel, infos[0].VChannel)
+	assert.Equal(t, session.ServerID, infos[0].LeaderID)
+
 	err := clusterService.releaseShardCluster(defaultDMLChannel)
 	assert.NoError(t, err)
+	assert.Empty(t, clusterService.describe())
 
 	err = clusterService.releaseShardCluster("non-exist-channel")
 	assert.Error(t, err)
@@ -108,3 +119,40 @@ func TestShardClusterService_HandoffVChannelSegments(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestShardClusterService_getStandbyNodes(t *testing.T) {
+	ctx := context.Background()
+	client := v3client.New(embedetcdServer.Server)
+	defer client.Close()
+	session := sessionutil.NewSession(ctx, "/by-dev/sessions/unittest/querynode/", client)
+	clusterService := newShardClusterService(client, session, nil)
+
+	nodes, err := clusterService.getStandbyNodes(defaultCollectionID)
+	assert.NoError(t, err)
+	assert.Empty(t, nodes)
+
+	replicaKey := path.Join(Params.EtcdCfg.MetaRootPath, ReplicaMetaPrefix, "100")
+	standbyKey := path.Join(Params.EtcdCfg.MetaRootPath, standbyReplicaPrefix, strconv.FormatInt(defaultCollectionID, 10), "100")
+	bs, err := proto.Marshal(&milvuspb.ReplicaInfo{ReplicaID: 100, CollectionID: defaultCollectionID, NodeIds: []int64{1, 2}})
+	require.NoError(t, err)
+	_, err = client.Put(ctx, replicaKey, string(bs))
+	require.NoError(t, err)
+	defer client.Delete(ctx, replicaKey)
+	_, err = client.Put(ctx, standbyKey, "")
+	require.NoError(t, err)
+	defer client.Delete(ctx, standbyKey)
+
+	nodes, err = clusterService.getStandbyNodes(defaultCollectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]struct{}{1: {}, 2: {}}, nodes)
+
+	// other collections are not affected
+	nodes, err = clusterService.getStandbyNodes(defaultCollectionID + 1)
+	assert.NoError(t, err)
+	assert.Empty(t, nodes)
+
+	_, err = client.Put(ctx, replicaKey, "invalid")
+	require.NoError(t, err)
+	_, err = clusterService.getStandbyNodes(defaultCollectionID)
+	assert.Error(t, err)
+}
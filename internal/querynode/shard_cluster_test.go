@@ -24,6 +24,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -54,6 +55,8 @@ type mockShardQueryNode struct {
 	queryErr              error
 	releaseSegmentsResult *commonpb.Status
 	releaseSegmentsErr    error
+	getMetricsResult      *milvuspb.GetMetricsResponse
+	getMetricsErr         error
 }
 
 func (m *mockShardQueryNode) Search(_ context.Context, _ *querypb.SearchRequest) (*internalpb.SearchResults, error) {
@@ -68,6 +71,10 @@ func (m *mockShardQueryNode) ReleaseSegments(ctx context.Context, in *querypb.Re
 	return m.releaseSegmentsResult, m.releaseSegmentsErr
 }
 
+func (m *mockShardQueryNode) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	return m.getMetricsResult, m.getMetricsErr
+}
+
 func (m *mockShardQueryNode) Stop() error {
 	return nil
 }
@@ -1964,3 +1971,121 @@ func TestShardCluster_HandoffSegments(t *testing.T) {
 
 	})
 }
+
+func TestShardCluster_describe(t *testing.T) {
+	collectionID := int64(1)
+	vchannelName := "dml_1_1_v0"
+	replicaID := int64(0)
+
+	nodeEvents := []nodeEvent{
+		{
+			nodeID:   1,
+			nodeAddr: "addr_1",
+		},
+		{
+			nodeID:   2,
+			nodeAddr: "addr_2",
+		},
+	}
+
+	t.Run("available cluster", func(t *testing.T) {
+		sc := NewShardCluster(collectionID, replicaID, vchannelName,
+			&mockNodeDetector{initNodes: nodeEvents}, &mockSegmentDetector{
+				initSegments: []segmentEvent{
+					{
+						segmentID:   2,
+						partitionID: defaultPartitionID,
+						nodeIDs:     []int64{2},
+						state:       segmentStateLoaded,
+					},
+					{
+						segmentID:   1,
+						partitionID: defaultPartitionID,
+						nodeIDs:     []int64{1},
+						state:       segmentStateLoaded,
+					},
+				},
+			}, buildMockQueryNode)
+		defer sc.Close()
+
+		info := sc.describe()
+		assert.Equal(t, collectionID, info.CollectionID)
+		assert.Equal(t, replicaID, info.ReplicaID)
+		assert.Equal(t, vchannelName, info.VChannel)
+		assert.Equal(t, "Available", info.State)
+		assert.Empty(t, info.UnavailableReason)
+		require.Equal(t, 2, len(info.Nodes))
+		assert.Equal(t, int64(1), info.Nodes[0].NodeID)
+		assert.Equal(t, "addr_1", info.Nodes[0].Address)
+		require.Equal(t, 2, len(info.Segments))
+		assert.Equal(t, int64(1), info.Segments[0].SegmentID)
+		assert.Equal(t, int64(2), info.Segments[1].NodeID)
+		assert.Equal(t, "Loaded", info.Segments[1].State)
+		assert.Equal(t, 0, info.PendingHandoffs)
+	})
+
+	t.Run("unavailable cluster", func(t *testing.T) {
+		sc := NewShardCluster(collectionID, replicaID, vchannelName,
+			&mockNodeDetector{initNodes: nodeEvents}, &mockSegmentDetector{
+				initSegments: []segmentEvent{
+					{
+						segmentID: 1,
+						nodeIDs:   []int64{1},
+						state:     segmentStateLoaded,
+					},
+					{
+						segmentID: 2,
+						nodeIDs:   []int64{2},
+						state:     segmentStateLoading,
+					},
+				},
+			}, buildMockQueryNode)
+		defer sc.Close()
+
+		info := sc.describe()
+		assert.Equal(t, "Unavailable", info.State)
+		assert.Equal(t, "segment 2 is Loading on node 2", info.UnavailableReason)
+	})
+}
+
+func TestShardCluster_acquireSealed(t *testing.T) {
+	collectionID := int64(1)
+	vchannelName := "dml_1_1_v0"
+	replicaID := int64(0)
+
+	sc := NewShardCluster(collectionID, replicaID, vchannelName,
+		&mockNodeDetector{initNodes: []nodeEvent{{nodeID: 1, nodeAddr: "addr_1"}}},
+		&mockSegmentDetector{initSegments: []segmentEvent{
+			{
+				segmentID:   1,
+				partitionID: 1,
+				nodeIDs:     []int64{1},
+				state:       segmentStateLoaded,
+			},
+			{
+				segmentID:   2,
+				partitionID: 2,
+				nodeIDs:     []int64{1},
+				state:       segmentStateLoaded,
+			},
+		}}, buildMockQueryNode)
+	defer sc.Close()
+
+	_, err := sc.acquireSealed(vchannelName+"_suffix", nil)
+	assert.Error(t, err)
+
+	snapshot, err := sc.acquireSealed(vchannelName, []int64{1})
+	require.NoError(t, err)
+	assert.True(t, snapshot.contains(1))
+	assert.False(t, snapshot.contains(2))
+	segment, ok := sc.segments[1]
+	require.True(t, ok)
+	assert.Equal(t, int32(1), segment.inUse)
+
+	sc.releaseSealed(snapshot)
+	assert.Equal(t, int32(0), segment.inUse)
+
+	sc.state.Store(int32(unavailable))
+	_, err = sc.acquireSealed(vchannelName, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,272 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+const (
+	// nodeLoadStaleFactor the load reports older than nodeLoadStaleFactor * report interval are ignored
+	nodeLoadStaleFactor = 3
+	// cpuUsagePerRequest is the cpu usage treated as the cost of one queued request
+	cpuUsagePerRequest = 10.0
+)
+
+type nodeLoad struct {
+	metricsinfo.QueryNodeLoad
+	updateTime time.Time
+}
+
+// shardLoadBalancer routes the sub-search requests of the shard leader to the least loaded nodes,
+// the candidates are the nodes of all replicas which have loaded the segments.
+type shardLoadBalancer struct {
+	resolver    addrResolver
+	nodeBuilder ShardNodeBuilder
+
+	mut        sync.RWMutex
+	peers      map[int64]*shardNode    // nodes of other replicas
+	loads      map[int64]nodeLoad      // latest reported loads
+	dispatched map[int64]*atomic.Int64 // sub-search requests dispatched by this leader and not returned yet
+}
+
+func newShardLoadBalancer(resolver addrResolver, nodeBuilder ShardNodeBuilder) *shardLoadBalancer {
+	return &shardLoadBalancer{
+		resolver:    resolver,
+		nodeBuilder: nodeBuilder,
+		peers:       make(map[int64]*shardNode),
+		loads:       make(map[int64]nodeLoad),
+		dispatched:  make(map[int64]*atomic.Int64),
+	}
+}
+
+// score returns the load score of the node, false if the load is unknown or stale
+func (b *shardLoadBalancer) score(nodeID int64) (float64, bool) {
+	load, ok := b.loads[nodeID]
+	if !ok || time.Since(load.updateTime) > nodeLoadStaleFactor*Params.QueryNodeCfg.ReplicaLoadReportInterval {
+		return 0, false
+	}
+	score := float64(load.QueueDepth) + load.CPUUsage/cpuUsagePerRequest
+	if dispatched, ok := b.dispatched[nodeID]; ok {
+		score += float64(dispatched.Load())
+	}
+	return score, true
+}
+
+// crossZone returns true if the node is known to be in another zone than this node
+func (b *shardLoadBalancer) crossZone(nodeID int64) bool {
+	zone := Params.QueryNodeCfg.Zone
+	load, ok := b.loads[nodeID]
+	return zone != "" && ok && load.Zone != "" && load.Zone != zone
+}
+
+// lagging returns true if the reported serviceable time of the vchannel on the node lags behind guaranteeTs more than MaxFollowerLag,
+// the nodes whose serviceable times are unknown are not lagging.
+func (b *shardLoadBalancer) lagging(nodeID int64, vchannel string, guaranteeTs Timestamp) bool {
+	maxLag := Params.QueryNodeCfg.MaxFollowerLag.Load()
+	if b == nil || maxLag <= 0 {
+		return false
+	}
+	b.mut.RLock()
+	defer b.mut.RUnlock()
+	load, ok := b.loads[nodeID]
+	if !ok || time.Since(load.updateTime) > nodeLoadStaleFactor*Params.QueryNodeCfg.ReplicaLoadReportInterval {
+		return false
+	}
+	serviceableTs, ok := load.ServiceableTimes[vchannel]
+	if !ok || serviceableTs >= guaranteeTs {
+		return false
+	}
+	guaranteeTime, _ := tsoutil.ParseTS(guaranteeTs)
+	serviceableTime, _ := tsoutil.ParseTS(serviceableTs)
+	return guaranteeTime.Sub(serviceableTime) > maxLag
+}
+
+// pick returns the least loaded node among the owner node and the peers in candidates,
+// the nodes in the same zone as this node are preferred to the nodes in other zones,
+// the owner node is kept when the loads are unknown or equal.
+func (b *shardLoadBalancer) pick(ownerID int64, candidates []int64) int64 {
+	b.mut.RLock()
+	defer b.mut.RUnlock()
+	best := ownerID
+	bestScore, ok := b.score(ownerID)
+	if !ok {
+		return ownerID
+	}
+	bestCrossZone := b.crossZone(ownerID)
+	for _, nodeID := range candidates {
+		if _, ok := b.peers[nodeID]; !ok {
+			continue
+		}
+		score, ok := b.score(nodeID)
+		if !ok {
+			continue
+		}
+		crossZone := b.crossZone(nodeID)
+		if crossZone == bestCrossZone && score < bestScore || bestCrossZone && !crossZone {
+			best, bestScore, bestCrossZone = nodeID, score, crossZone
+		}
+	}
+	return best
+}
+
+// pickPeer returns the least loaded peer in candidates, the peers with unknown loads are picked only if no load is known
+func (b *shardLoadBalancer) pickPeer(candidates []int64) (int64, bool) {
+	b.mut.RLock()
+	defer b.mut.RUnlock()
+	var best int64
+	var bestScore float64
+	found, bestKnown := false, false
+	for _, nodeID := range candidates {
+		if _, ok := b.peers[nodeID]; !ok {
+			continue
+		}
+		score, known := b.score(nodeID)
+		if !found || known && (!bestKnown || score < bestScore) {
+			best, bestScore, found, bestKnown = nodeID, score, true, known
+		}
+	}
+	return best, found
+}
+
+// dispatch records a sub-search request sent to the node, the returned func must be called when it returns
+func (b *shardLoadBalancer) dispatch(nodeID int64) func() {
+	if b == nil {
+		return func() {}
+	}
+	b.mut.Lock()
+	dispatched, ok := b.dispatched[nodeID]
+	if !ok {
+		dispatched = atomic.NewInt64(0)
+		b.dispatched[nodeID] = dispatched
+	}
+	b.mut.Unlock()
+	dispatched.Inc()
+	return func() { dispatched.Dec() }
+}
+
+// getPeer returns the node of other replica
+func (b *shardLoadBalancer) getPeer(nodeID int64) (*shardNode, bool) {
+	if b == nil {
+		return nil, false
+	}
+	b.mut.RLock()
+	defer b.mut.RUnlock()
+	peer, ok := b.peers[nodeID]
+	return peer, ok
+}
+
+// updatePeers builds the clients of new peers and stops the ones not in peerIDs any more
+func (b *shardLoadBalancer) updatePeers(peerIDs map[int64]struct{}) {
+	var addrs map[int64]string
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	for nodeID, peer := range b.peers {
+		if _, ok := peerIDs[nodeID]; !ok {
+			peer.client.Stop()
+			delete(b.peers, nodeID)
+			delete(b.loads, nodeID)
+		}
+	}
+	for nodeID := range peerIDs {
+		if _, ok := b.peers[nodeID]; ok {
+			continue
+		}
+		if addrs == nil {
+			var err error
+			addrs, err = b.resolver()
+			if err != nil {
+				log.Warn("failed to resolve addresses of other replicas", zap.Error(err))
+				return
+			}
+		}
+		addr, ok := addrs[nodeID]
+		if !ok {
+			continue
+		}
+		b.peers[nodeID] = &shardNode{
+			nodeID:   nodeID,
+			nodeAddr: addr,
+			client:   b.nodeBuilder(nodeID, addr),
+		}
+	}
+}
+
+// refresh polls the loads of the nodes of this replica and the peers
+func (b *shardLoadBalancer) refresh(ctx context.Context, nodes []*shardNode) {
+	b.mut.RLock()
+	for _, peer := range b.peers {
+		nodes = append(nodes, peer)
+	}
+	b.mut.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, node := range nodes {
+		wg.Add(1)
+		go func(node *shardNode) {
+			defer wg.Done()
+			load, err := getShardNodeLoad(ctx, node)
+			if err != nil {
+				log.Debug("failed to get node load", zap.Int64("nodeID", node.nodeID), zap.Error(err))
+				return
+			}
+			b.mut.Lock()
+			b.loads[node.nodeID] = nodeLoad{QueryNodeLoad: load, updateTime: time.Now()}
+			b.mut.Unlock()
+		}(node)
+	}
+	wg.Wait()
+}
+
+// close stops the clients of the peers
+func (b *shardLoadBalancer) close() {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	for nodeID, peer := range b.peers {
+		peer.client.Stop()
+		delete(b.peers, nodeID)
+	}
+}
+
+func getShardNodeLoad(ctx context.Context, node *shardNode) (metricsinfo.QueryNodeLoad, error) {
+	load := metricsinfo.QueryNodeLoad{}
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.NodeLoadMetrics)
+	if err != nil {
+		return load, err
+	}
+	resp, err := node.client.GetMetrics(ctx, req)
+	if err != nil {
+		return load, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return load, errors.New(resp.GetStatus().GetReason())
+	}
+	err = json.Unmarshal([]byte(resp.GetResponse()), &load)
+	return load, err
+}
@@ -0,0 +1,352 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func buildLoadReportQueryNode(load metricsinfo.QueryNodeLoad, searchResult *internalpb.SearchResults) *mockShardQueryNode {
+	resp, _ := json.Marshal(load)
+	return &mockShardQueryNode{
+		searchResult: searchResult,
+		queryResult:  &internalpb.RetrieveResults{},
+		getMetricsResult: &milvuspb.GetMetricsResponse{
+			Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Response: string(resp),
+		},
+	}
+}
+
+func TestShardLoadBalancer_pick(t *testing.T) {
+	b := newShardLoadBalancer(func() (map[int64]string, error) {
+		return map[int64]string{2: "addr_2", 3: "addr_3"}, nil
+	}, buildMockQueryNode)
+
+	// loads unknown
+	assert.Equal(t, int64(1), b.pick(1, []int64{1, 2, 3}))
+
+	b.updatePeers(map[int64]struct{}{2: {}, 3: {}, 4: {}})
+	_, ok := b.getPeer(2)
+	assert.True(t, ok)
+	// address not resolved
+	_, ok = b.getPeer(4)
+	assert.False(t, ok)
+
+	now := time.Now()
+	b.loads[1] = nodeLoad{QueryNodeLoad: metricsinfo.QueryNodeLoad{NodeID: 1, QueueDepth: 5}, updateTime: now}
+	// loads of peers unknown
+	assert.Equal(t, int64(1), b.pick(1, []int64{1, 2, 3}))
+
+	b.loads[2] = nodeLoad{QueryNodeLoad: metricsinfo.QueryNodeLoad{NodeID: 2, QueueDepth: 2}, updateTime: now}
+	b.loads[3] = nodeLoad{QueryNodeLoad: metricsinfo.QueryNodeLoad{NodeID: 3, QueueDepth: 1, CPUUsage: 50}, updateTime: now}
+	assert.Equal(t, int64(2), b.pick(1, []int64{1, 2, 3}))
+	// not a candidate
+	assert.Equal(t, int64(3), b.pick(1, []int64{1, 3}))
+	assert.Equal(t, int64(1), b.pick(1, []int64{1}))
+
+	// owner excluded
+	peerID, ok := b.pickPeer([]int64{1, 2, 3})
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), peerID)
+	_, ok = b.pickPeer([]int64{1})
+	assert.False(t, ok)
+
+	// dispatched requests are counted, the owner is kept on ties
+	done1 := b.dispatch(2)
+	done2 := b.dispatch(2)
+	done3 := b.dispatch(2)
+	assert.Equal(t, int64(1), b.pick(1, []int64{1, 2, 3}))
+	done1()
+	done2()
+	done3()
+	assert.Equal(t, int64(2), b.pick(1, []int64{1, 2, 3}))
+
+	// stale loads are ignored
+	b.loads[2] = nodeLoad{QueryNodeLoad: metricsinfo.QueryNodeLoad{NodeID: 2}, updateTime: now.Add(-time.Hour)}
+	assert.Equal(t, int64(1), b.pick(1, []int64{1, 2, 3}))
+
+	b.updatePeers(map[int64]struct{}{3: {}})
+	_, ok = b.getPeer(2)
+	assert.False(t, ok)
+	b.close()
+	_, ok = b.getPeer(3)
+	assert.False(t, ok)
+
+	var nilBalancer *shardLoadBalancer
+	assert.NotPanics(t, func() { nilBalancer.dispatch(1)() })
+	_, ok = nilBalancer.getPeer(1)
+	assert.False(t, ok)
+}
+
+func TestShardLoadBalancer_pickZone(t *testing.T) {
+	zone := Params.QueryNodeCfg.Zone
+	defer func() { Params.QueryNodeCfg.Zone = zone }()
+	Params.QueryNodeCfg.Zone = "az-1"
+
+	b := newShardLoadBalancer(func() (map[int64]string, error) {
+		return map[int64]string{2: "addr_2", 3: "addr_3"}, nil
+	}, buildMockQueryNode)
+	b.updatePeers(map[int64]struct{}{2: {}, 3: {}})
+
+	now := time.Now()
+	b.loads[1] = nodeLoad{QueryNodeLoad: metricsinfo.QueryNodeLoad{NodeID: 1, QueueDepth: 5, Zone: "az-1"}, updateTime: now}
+	b.loads[2] = nodeLoad{QueryNodeLoad: metricsinfo.QueryNodeLoad{NodeID: 2, QueueDepth: 0, Zone: "az-2"}, updateTime: now}
+	b.loads[3] = nodeLoad{QueryNodeLoad: metricsinfo.QueryNodeLoad{NodeID: 3, QueueDepth: 8, Zone: "az-1"}, updateTime: now}
+	// the less loaded node in other zone is not picked
+	assert.Equal(t, int64(1), b.pick(1, []int64{1, 2, 3}))
+
+	// the owner in other zone gives way to the node in the same zone
+	b.loads[1] = nodeLoad{QueryNodeLoad: metricsinfo.QueryNodeLoad{NodeID: 1, QueueDepth: 5, Zone: "az-3"}, updateTime: now}
+	assert.Equal(t, int64(3), b.pick(1, []int64{1, 2, 3}))
+	assert.Equal(t, int64(2), b.pick(1, []int64{1, 2}))
+
+	// zone unknown
+	Params.QueryNodeCfg.Zone = ""
+	assert.Equal(t, int64(2), b.pick(1, []int64{1, 2, 3}))
+}
+
+func TestShardLoadBalancer_lagging(t *testing.T) {
+	maxLag := Params.QueryNodeCfg.MaxFollowerLag.Load()
+	defer func() { Params.QueryNodeCfg.MaxFollowerLag.Store(maxLag) }()
+	Params.QueryNodeCfg.MaxFollowerLag.Store(time.Second)
+
+	vchannel := "dml_1_1_v0"
+	now := time.Now()
+	guaranteeTs := tsoutil.ComposeTSByTime(now, 0)
+	b := newShardLoadBalancer(func() (map[int64]string, error) { return nil, nil }, buildMockQueryNode)
+	b.loads[1] = nodeLoad{QueryNodeLoad: metricsinfo.QueryNodeLoad{NodeID: 1,
+		ServiceableTimes: map[string]uint64{vchannel: tsoutil.ComposeTSByTime(now.Add(-time.Minute), 0)}}, updateTime: now}
+	b.loads[2] = nodeLoad{QueryNodeLoad: metricsinfo.QueryNodeLoad{NodeID: 2,
+		ServiceableTimes: map[string]uint64{vchannel: tsoutil.ComposeTSByTime(now.Add(-time.Millisecond), 0)}}, updateTime: now}
+	b.loads[3] = nodeLoad{QueryNodeLoad: metricsinfo.QueryNodeLoad{NodeID: 3}, updateTime: now}
+
+	assert.True(t, b.lagging(1, vchannel, guaranteeTs))
+	// within max lag
+	assert.False(t, b.lagging(2, vchannel, guaranteeTs))
+	// serviceable time unknown
+	assert.False(t, b.lagging(3, vchannel, guaranteeTs))
+	assert.False(t, b.lagging(4, vchannel, guaranteeTs))
+	assert.False(t, b.lagging(1, "other", guaranteeTs))
+	// guarantee timestamp satisfied
+	assert.False(t, b.lagging(1, vchannel, tsoutil.ComposeTSByTime(now.Add(-time.Hour), 0)))
+
+	Params.QueryNodeCfg.MaxFollowerLag.Store(0)
+	assert.False(t, b.lagging(1, vchannel, guaranteeTs))
+	var nilBalancer *shardLoadBalancer
+	assert.False(t, nilBalancer.lagging(1, vchannel, guaranteeTs))
+}
+
+func TestShardLoadBalancer_refresh(t *testing.T) {
+	b := newShardLoadBalancer(func() (map[int64]string, error) {
+		return nil, errors.New("mocked")
+	}, buildMockQueryNode)
+	// resolve failed
+	b.updatePeers(map[int64]struct{}{2: {}})
+	_, ok := b.getPeer(2)
+	assert.False(t, ok)
+
+	b.refresh(context.Background(), []*shardNode{
+		{nodeID: 1, client: buildLoadReportQueryNode(metricsinfo.QueryNodeLoad{NodeID: 1, QueueDepth: 3}, nil)},
+		{nodeID: 2, client: &mockShardQueryNode{getMetricsErr: errors.New("mocked")}},
+		{nodeID: 3, client: &mockShardQueryNode{getMetricsResult: &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError},
+		}}},
+	})
+	assert.Equal(t, int64(3), b.loads[1].QueueDepth)
+	_, ok = b.loads[2]
+	assert.False(t, ok)
+	_, ok = b.loads[3]
+	assert.False(t, ok)
+}
+
+func TestShardCluster_SearchAcrossReplicas(t *testing.T) {
+	collectionID := int64(1)
+	vchannelName := "dml_1_1_v0"
+	replicaID := int64(0)
+	ctx := context.Background()
+
+	nodeEvents := []nodeEvent{
+		{
+			nodeID:   1,
+			nodeAddr: "addr_1",
+		},
+	}
+	// segment 1 is loaded by node 2 of other replica as well
+	segmentEvents := []segmentEvent{
+		{
+			eventType: segmentAdd,
+			segmentID: 1,
+			nodeIDs:   []int64{1, 2},
+			state:     segmentStateLoaded,
+		},
+		{
+			eventType: segmentAdd,
+			segmentID: 2,
+			nodeIDs:   []int64{1},
+			state:     segmentStateLoaded,
+		},
+	}
+
+	own := buildLoadReportQueryNode(metricsinfo.QueryNodeLoad{NodeID: 1, QueueDepth: 10},
+		&internalpb.SearchResults{MetricType: "own"})
+	peer := buildLoadReportQueryNode(metricsinfo.QueryNodeLoad{NodeID: 2},
+		&internalpb.SearchResults{MetricType: "peer"})
+	sc := NewShardCluster(collectionID, replicaID, vchannelName,
+		&mockNodeDetector{initNodes: nodeEvents}, &mockSegmentDetector{initSegments: segmentEvents},
+		func(nodeID int64, addr string) shardQueryNode {
+			if nodeID == 1 {
+				return own
+			}
+			return peer
+		})
+	defer sc.Close()
+	require.EqualValues(t, available, sc.state.Load())
+
+	search := func() ([]string, error) {
+		results, err := sc.Search(ctx, &querypb.SearchRequest{DmlChannel: vchannelName})
+		if err != nil {
+			return nil, err
+		}
+		var routed []string
+		for _, result := range results {
+			routed = append(routed, result.GetMetricType())
+		}
+		return routed, nil
+	}
+
+	// routing disabled
+	routed, err := search()
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"own"}, routed)
+
+	sc.enableLoadBalance(func() (map[int64]string, error) {
+		return map[int64]string{1: "addr_1", 2: "addr_2"}, nil
+	})
+	sc.refreshLoads(sc.getBalancer())
+
+	routed, err = search()
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"own", "peer"}, routed)
+
+	// fall back to the owner node
+	peer.searchErr = errors.New("mocked")
+	routed, err = search()
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"own", "own"}, routed)
+
+	own.searchErr = errors.New("mocked")
+	_, err = search()
+	assert.Error(t, err)
+}
+
+func TestShardCluster_SkipLaggingFollowers(t *testing.T) {
+	maxLag := Params.QueryNodeCfg.MaxFollowerLag.Load()
+	defer func() { Params.QueryNodeCfg.MaxFollowerLag.Store(maxLag) }()
+	Params.QueryNodeCfg.MaxFollowerLag.Store(time.Second)
+
+	collectionID := int64(1)
+	vchannelName := "dml_1_1_v0"
+	replicaID := int64(0)
+	ctx := context.Background()
+
+	nodeEvents := []nodeEvent{
+		{
+			nodeID:   1,
+			nodeAddr: "addr_1",
+		},
+	}
+	// segment 1 is loaded by node 2 of other replica as well
+	segmentEvents := []segmentEvent{
+		{
+			eventType: segmentAdd,
+			segmentID: 1,
+			nodeIDs:   []int64{1, 2},
+			state:     segmentStateLoaded,
+		},
+	}
+
+	now := time.Now()
+	guaranteeTs := tsoutil.ComposeTSByTime(now, 0)
+	lagged := map[string]uint64{vchannelName: tsoutil.ComposeTSByTime(now.Add(-time.Minute), 0)}
+	fresh := map[string]uint64{vchannelName: guaranteeTs}
+	own := buildLoadReportQueryNode(metricsinfo.QueryNodeLoad{NodeID: 1, ServiceableTimes: lagged},
+		&internalpb.SearchResults{MetricType: "own"})
+	peer := buildLoadReportQueryNode(metricsinfo.QueryNodeLoad{NodeID: 2, QueueDepth: 10, ServiceableTimes: fresh},
+		&internalpb.SearchResults{MetricType: "peer"})
+	sc := NewShardCluster(collectionID, replicaID, vchannelName,
+		&mockNodeDetector{initNodes: nodeEvents}, &mockSegmentDetector{initSegments: segmentEvents},
+		func(nodeID int64, addr string) shardQueryNode {
+			if nodeID == 1 {
+				return own
+			}
+			return peer
+		})
+	defer sc.Close()
+	require.EqualValues(t, available, sc.state.Load())
+	sc.enableLoadBalance(func() (map[int64]string, error) {
+		return map[int64]string{1: "addr_1", 2: "addr_2"}, nil
+	})
+	sc.refreshLoads(sc.getBalancer())
+
+	search := func(guaranteeTs Timestamp) (string, error) {
+		results, err := sc.Search(ctx, &querypb.SearchRequest{
+			Req:        &internalpb.SearchRequest{GuaranteeTimestamp: guaranteeTs},
+			DmlChannel: vchannelName,
+		})
+		if err != nil {
+			return "", err
+		}
+		require.Equal(t, 1, len(results))
+		return results[0].GetMetricType(), nil
+	}
+
+	// the lagging follower is routed around
+	routed, err := search(guaranteeTs)
+	assert.NoError(t, err)
+	assert.Equal(t, "peer", routed)
+
+	// eventual consistency
+	routed, err = search(1)
+	assert.NoError(t, err)
+	assert.Equal(t, "own", routed)
+
+	// no follower could satisfy the guarantee timestamp
+	peer.getMetricsResult = own.getMetricsResult
+	sc.refreshLoads(sc.getBalancer())
+	_, err = search(guaranteeTs)
+	assert.Error(t, err)
+	_, err = sc.Query(ctx, &querypb.QueryRequest{
+		Req:        &internalpb.RetrieveRequest{GuaranteeTimestamp: guaranteeTs},
+		DmlChannel: vchannelName,
+	})
+	assert.Error(t, err)
+}
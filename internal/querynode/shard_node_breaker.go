@@ -0,0 +1,153 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type breakerState int32
+
+const (
+	breakerClosed   breakerState = 0 // requests are sent to the node
+	breakerOpen     breakerState = 1 // requests are routed around the node
+	breakerHalfOpen breakerState = 2 // a probe request is sent to the node
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case breakerClosed:
+		return "closed"
+	case breakerOpen:
+		return "open"
+	case breakerHalfOpen:
+		return "half_open"
+	default:
+		return "unknown"
+	}
+}
+
+type nodeBreaker struct {
+	state    breakerState
+	failures int
+	openTime time.Time
+}
+
+// shardNodeBreakers is the circuit breakers of the nodes searched by a shard leader.
+// The breaker of a node trips after consecutive failures and the node is routed around,
+// a probe request is let through every probe interval to check whether the node recovers.
+type shardNodeBreakers struct {
+	mut      sync.Mutex
+	breakers map[int64]*nodeBreaker
+}
+
+func newShardNodeBreakers() *shardNodeBreakers {
+	return &shardNodeBreakers{
+		breakers: make(map[int64]*nodeBreaker),
+	}
+}
+
+// tripped returns true if the requests to the node shall be routed around, no probe is consumed
+func (b *shardNodeBreakers) tripped(nodeID int64) bool {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	breaker, ok := b.breakers[nodeID]
+	if !ok || breaker.state == breakerClosed {
+		return false
+	}
+	return breaker.state == breakerHalfOpen || time.Since(breaker.openTime) < Params.QueryNodeCfg.CircuitBreakerProbeInterval.Load()
+}
+
+// allow returns true if a request could be sent to the node,
+// only one probe request is allowed when the probe interval of the tripped node elapses.
+func (b *shardNodeBreakers) allow(nodeID int64) bool {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	breaker, ok := b.breakers[nodeID]
+	if !ok {
+		return true
+	}
+	switch breaker.state {
+	case breakerOpen:
+		if time.Since(breaker.openTime) < Params.QueryNodeCfg.CircuitBreakerProbeInterval.Load() {
+			return false
+		}
+		breaker.state = breakerHalfOpen
+		return true
+	case breakerHalfOpen:
+		return false
+	default:
+		return true
+	}
+}
+
+// report records the result of a request sent to the node,
+// the failures caused by the cancellation of the request itself are ignored.
+func (b *shardNodeBreakers) report(ctx context.Context, nodeID int64, err error) {
+	threshold := int(Params.QueryNodeCfg.CircuitBreakerFailureThreshold.Load())
+	if threshold <= 0 {
+		return
+	}
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	breaker, ok := b.breakers[nodeID]
+	if err != nil && errors.Is(ctx.Err(), context.Canceled) {
+		// the probe is not finished, let the next request probe again
+		if ok && breaker.state == breakerHalfOpen {
+			breaker.state = breakerOpen
+		}
+		return
+	}
+	if err == nil {
+		delete(b.breakers, nodeID)
+		return
+	}
+	if !ok {
+		breaker = &nodeBreaker{}
+		b.breakers[nodeID] = breaker
+	}
+	breaker.failures++
+	if breaker.state == breakerHalfOpen || breaker.failures >= threshold {
+		breaker.state = breakerOpen
+		breaker.openTime = time.Now()
+	}
+}
+
+// reset closes the breaker of the node
+func (b *shardNodeBreakers) reset(nodeID int64) {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	delete(b.breakers, nodeID)
+}
+
+// state returns the state of the breaker of the node
+func (b *shardNodeBreakers) state(nodeID int64) breakerState {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	if breaker, ok := b.breakers[nodeID]; ok {
+		return breaker.state
+	}
+	return breakerClosed
+}
+
+func errCircuitOpen(nodeID int64) error {
+	return fmt.Errorf("circuit breaker of node %d is open", nodeID)
+}
@@ -0,0 +1,189 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+func TestShardNodeBreakers(t *testing.T) {
+	threshold, interval := Params.QueryNodeCfg.CircuitBreakerFailureThreshold.Load(), Params.QueryNodeCfg.CircuitBreakerProbeInterval.Load()
+	defer func() {
+		Params.QueryNodeCfg.CircuitBreakerFailureThreshold.Store(threshold)
+		Params.QueryNodeCfg.CircuitBreakerProbeInterval.Store(interval)
+	}()
+	Params.QueryNodeCfg.CircuitBreakerFailureThreshold.Store(2)
+	Params.QueryNodeCfg.CircuitBreakerProbeInterval.Store(time.Hour)
+
+	ctx := context.Background()
+	mockErr := errors.New("mocked")
+	b := newShardNodeBreakers()
+	assert.True(t, b.allow(1))
+	assert.False(t, b.tripped(1))
+
+	// failures must be consecutive
+	b.report(ctx, 1, mockErr)
+	b.report(ctx, 1, nil)
+	b.report(ctx, 1, mockErr)
+	assert.Equal(t, breakerClosed, b.state(1))
+	assert.True(t, b.allow(1))
+
+	b.report(ctx, 1, mockErr)
+	assert.Equal(t, breakerOpen, b.state(1))
+	assert.True(t, b.tripped(1))
+	assert.False(t, b.allow(1))
+	// other nodes are not affected
+	assert.True(t, b.allow(2))
+
+	// cancelled requests are ignored
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	b.report(cancelledCtx, 2, mockErr)
+	b.report(cancelledCtx, 2, mockErr)
+	assert.Equal(t, breakerClosed, b.state(2))
+
+	// probe after the interval, only one probe at a time
+	Params.QueryNodeCfg.CircuitBreakerProbeInterval.Store(0)
+	assert.False(t, b.tripped(1))
+	assert.True(t, b.allow(1))
+	assert.Equal(t, breakerHalfOpen, b.state(1))
+	assert.True(t, b.tripped(1))
+	assert.False(t, b.allow(1))
+
+	// unfinished probe
+	b.report(cancelledCtx, 1, mockErr)
+	assert.Equal(t, breakerOpen, b.state(1))
+
+	// failed probe
+	assert.True(t, b.allow(1))
+	b.report(ctx, 1, mockErr)
+	assert.Equal(t, breakerOpen, b.state(1))
+
+	// succeeded probe
+	assert.True(t, b.allow(1))
+	b.report(ctx, 1, nil)
+	assert.Equal(t, breakerClosed, b.state(1))
+
+	b.report(ctx, 1, mockErr)
+	b.report(ctx, 1, mockErr)
+	assert.Equal(t, breakerOpen, b.state(1))
+	b.reset(1)
+	assert.Equal(t, breakerClosed, b.state(1))
+
+	// disabled
+	Params.QueryNodeCfg.CircuitBreakerFailureThreshold.Store(0)
+	b.report(ctx, 1, mockErr)
+	b.report(ctx, 1, mockErr)
+	assert.Equal(t, breakerClosed, b.state(1))
+}
+
+func TestShardCluster_CircuitBreaker(t *testing.T) {
+	threshold, interval := Params.QueryNodeCfg.CircuitBreakerFailureThreshold.Load(), Params.QueryNodeCfg.CircuitBreakerProbeInterval.Load()
+	defer func() {
+		Params.QueryNodeCfg.CircuitBreakerFailureThreshold.Store(threshold)
+		Params.QueryNodeCfg.CircuitBreakerProbeInterval.Store(interval)
+	}()
+	Params.QueryNodeCfg.CircuitBreakerFailureThreshold.Store(2)
+	Params.QueryNodeCfg.CircuitBreakerProbeInterval.Store(time.Hour)
+
+	collectionID := int64(1)
+	vchannelName := "dml_1_1_v0"
+	replicaID := int64(0)
+	ctx := context.Background()
+
+	nodeEvents := []nodeEvent{
+		{
+			nodeID:   1,
+			nodeAddr: "addr_1",
+		},
+	}
+	// segment 1 is loaded by node 2 of other replica as well
+	segmentEvents := []segmentEvent{
+		{
+			eventType: segmentAdd,
+			segmentID: 1,
+			nodeIDs:   []int64{1, 2},
+			state:     segmentStateLoaded,
+		},
+	}
+
+	own := buildLoadReportQueryNode(metricsinfo.QueryNodeLoad{NodeID: 1},
+		&internalpb.SearchResults{MetricType: "own"})
+	peer := buildLoadReportQueryNode(metricsinfo.QueryNodeLoad{NodeID: 2, QueueDepth: 10},
+		&internalpb.SearchResults{MetricType: "peer"})
+	sc := NewShardCluster(collectionID, replicaID, vchannelName,
+		&mockNodeDetector{initNodes: nodeEvents}, &mockSegmentDetector{initSegments: segmentEvents},
+		func(nodeID int64, addr string) shardQueryNode {
+			if nodeID == 1 {
+				return own
+			}
+			return peer
+		})
+	defer sc.Close()
+	require.EqualValues(t, available, sc.state.Load())
+
+	search := func() (string, error) {
+		results, err := sc.Search(ctx, &querypb.SearchRequest{DmlChannel: vchannelName})
+		if err != nil {
+			return "", err
+		}
+		require.Equal(t, 1, len(results))
+		return results[0].GetMetricType(), nil
+	}
+
+	// trip the breaker of the owner node
+	own.searchErr = errors.New("mocked")
+	for i := 0; i < 2; i++ {
+		_, err := search()
+		assert.Error(t, err)
+	}
+	assert.Equal(t, breakerOpen, sc.breakers.state(1))
+	assert.Equal(t, breakerOpen.String(), sc.describe().Nodes[0].CircuitBreaker)
+
+	// fail fast without other replicas
+	own.searchErr = nil
+	_, err := search()
+	assert.Error(t, err)
+	_, err = sc.Query(ctx, &querypb.QueryRequest{DmlChannel: vchannelName})
+	assert.Error(t, err)
+
+	// route around the tripped node
+	sc.enableLoadBalance(func() (map[int64]string, error) {
+		return map[int64]string{1: "addr_1", 2: "addr_2"}, nil
+	})
+	sc.refreshLoads(sc.getBalancer())
+	routed, err := search()
+	assert.NoError(t, err)
+	assert.Equal(t, "peer", routed)
+
+	// the node recovers after probed
+	Params.QueryNodeCfg.CircuitBreakerProbeInterval.Store(0)
+	routed, err = search()
+	assert.NoError(t, err)
+	assert.Equal(t, "own", routed)
+	assert.Equal(t, breakerClosed, sc.breakers.state(1))
+}
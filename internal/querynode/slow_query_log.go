@@ -0,0 +1,143 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+)
+
+const (
+	slowQueryKindSearch = "search"
+	slowQueryKindQuery  = "query"
+)
+
+// slowQueryRecord is the statistics of a search or query request written to the slow query log
+type slowQueryRecord struct {
+	kind          string
+	reqID         UniqueID // msgID of the request, logged with the logs of the request across components
+	collectionID  UniqueID
+	channel       Channel
+	isShardLeader bool
+	// the expression is decoded from the serialized plan only if the request is slow
+	dsl            string
+	serializedPlan []byte
+	topK           int64
+	nq             int64
+	numSegments    int           // sealed and growing segments scanned
+	rowsScanned    int64         // rows of the segments scanned on this node
+	waitTSafe      time.Duration // time waiting for the serviceable time to reach the guarantee timestamp
+	segcore        time.Duration // time spent in segcore searching or retrieving the local segments
+	reduce         time.Duration // time spent reducing or merging the results
+}
+
+var slowQueryLog struct {
+	once   sync.Once
+	logger *zap.Logger
+}
+
+// getSlowQueryLogger returns the logger of the slow queries, which is created on the first slow query
+// so that the node ID is known.
+func getSlowQueryLogger() *zap.Logger {
+	slowQueryLog.once.Do(func() {
+		slowQueryLog.logger = newSlowQueryLogger()
+	})
+	return slowQueryLog.logger
+}
+
+func newSlowQueryLogger() *zap.Logger {
+	filename := Params.QueryNodeCfg.SlowQueryLogFile
+	if filename == "" {
+		rootPath := Params.QueryNodeCfg.Base.LoadWithDefault("log.file.rootPath", "")
+		if rootPath == "" {
+			return log.L()
+		}
+		filename = path.Join(rootPath, fmt.Sprintf("querynode-%d-slow.log", Params.QueryNodeCfg.GetNodeID()))
+	}
+
+	// rotated as the querynode log
+	cfg := Params.QueryNodeCfg.Base.Log
+	cfg.Level = "info"
+	cfg.File.Filename = filename
+	logger, _, err := log.InitLogger(&cfg)
+	if err != nil {
+		log.Warn("failed to init slow query log, write slow queries to querynode log", zap.String("filename", filename), zap.Error(err))
+		return log.L()
+	}
+	return logger
+}
+
+// log writes the request to the slow query log if it takes longer than the threshold
+func (r *slowQueryRecord) log(elapsed time.Duration, err error) {
+	threshold := Params.QueryNodeCfg.SlowQueryThreshold.Load()
+	if threshold <= 0 || elapsed < threshold {
+		return
+	}
+	expr := r.dsl
+	if len(r.serializedPlan) > 0 {
+		expr = exprOfPlan(r.serializedPlan)
+	}
+	fields := []zap.Field{
+		zap.String("kind", r.kind),
+		zap.Int64("reqID", r.reqID),
+		zap.Int64("nodeID", Params.QueryNodeCfg.GetNodeID()),
+		zap.Int64("collectionID", r.collectionID),
+		zap.String("channel", r.channel),
+		zap.Bool("isShardLeader", r.isShardLeader),
+		zap.String("expr", expr),
+		zap.Int("numSegments", r.numSegments),
+		zap.Int64("rowsScanned", r.rowsScanned),
+		zap.Duration("elapsed", elapsed),
+		zap.Duration("waitTSafe", r.waitTSafe),
+		zap.Duration("segcore", r.segcore),
+		zap.Duration("reduce", r.reduce),
+	}
+	if r.kind == slowQueryKindSearch {
+		fields = append(fields, zap.Int64("topK", r.topK), zap.Int64("nq", r.nq))
+	}
+	if err != nil {
+		fields = append(fields, zap.Error(err))
+	}
+	getSlowQueryLogger().Warn("slow query", fields...)
+}
+
+// exprOfPlan returns the text of the filter expression of the serialized plan
+func exprOfPlan(serializedPlan []byte) string {
+	if len(serializedPlan) == 0 {
+		return ""
+	}
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return ""
+	}
+	predicates := plan.GetPredicates()
+	if anns := plan.GetVectorAnns(); anns != nil {
+		predicates = anns.GetPredicates()
+	}
+	if predicates == nil {
+		return ""
+	}
+	return predicates.String()
+}
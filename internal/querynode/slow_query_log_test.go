@@ -0,0 +1,65 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestSlowQueryLog_exprOfPlan(t *testing.T) {
+	assert.Equal(t, "", exprOfPlan(nil))
+	assert.Equal(t, "", exprOfPlan([]byte{0xff}))
+
+	schema := genTestCollectionSchema(schemapb.DataType_Int64)
+	planExpr, err := genSimpleRetrievePlanExpr(schema)
+	require.NoError(t, err)
+	assert.Contains(t, exprOfPlan(planExpr), "term_expr")
+}
+
+func TestSlowQueryLog_log(t *testing.T) {
+	threshold := Params.QueryNodeCfg.SlowQueryThreshold.Load()
+	defer func() {
+		Params.QueryNodeCfg.SlowQueryThreshold.Store(threshold)
+	}()
+
+	record := &slowQueryRecord{
+		kind:         slowQueryKindSearch,
+		collectionID: defaultCollectionID,
+		channel:      defaultDMLChannel,
+		dsl:          "dsl",
+		topK:         10,
+		nq:           1,
+		numSegments:  2,
+		waitTSafe:    time.Millisecond,
+		segcore:      time.Millisecond,
+	}
+
+	Params.QueryNodeCfg.SlowQueryThreshold.Store(0)
+	record.log(time.Second, nil)
+
+	Params.QueryNodeCfg.SlowQueryThreshold.Store(time.Millisecond)
+	record.log(time.Second, nil)
+	record.log(time.Second, errors.New("mock error"))
+	assert.NotNil(t, getSlowQueryLogger())
+}
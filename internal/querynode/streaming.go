@@ -25,10 +25,10 @@ import (
 	"go.uber.org/zap"
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
-	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/util/logutil"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 )
 
@@ -61,7 +61,7 @@ func (s *streaming) close() {
 	s.replica.freeAll()
 }
 
-func (s *streaming) retrieve(collID UniqueID, partIDs []UniqueID, plan *RetrievePlan, filters ...func(segment *Segment) bool) ([]*segcorepb.RetrieveResults, []UniqueID, []UniqueID, error) {
+func (s *streaming) retrieve(ctx context.Context, collID UniqueID, partIDs []UniqueID, plan *RetrievePlan, filters ...func(segment *Segment) bool) ([]*segcorepb.RetrieveResults, []UniqueID, []UniqueID, error) {
 	retrieveResults := make([]*segcorepb.RetrieveResults, 0)
 	retrieveSegmentIDs := make([]UniqueID, 0)
 
@@ -100,7 +100,7 @@ func (s *streaming) retrieve(collID UniqueID, partIDs []UniqueID, plan *Retrieve
 			if filtered {
 				continue
 			}
-			result, err := seg.retrieve(plan)
+			result, err := seg.retrieve(ctx, plan)
 			if err != nil {
 				return retrieveResults, retrieveSegmentIDs, retrievePartIDs, err
 			}
@@ -114,8 +114,8 @@ func (s *streaming) retrieve(collID UniqueID, partIDs []UniqueID, plan *Retrieve
 }
 
 // search will search all the target segments in streaming
-func (s *streaming) search(searchReqs []*searchRequest, collID UniqueID, partIDs []UniqueID, vChannel Channel,
-	plan *SearchPlan, searchTs Timestamp) ([]*SearchResult, []UniqueID, []UniqueID, error) {
+func (s *streaming) search(ctx context.Context, searchReqs []*searchRequest, collID UniqueID, partIDs []UniqueID, vChannel Channel,
+	plan *SearchPlan, searchTs Timestamp, filters ...func(segment *Segment) bool) ([]*SearchResult, []UniqueID, []UniqueID, error) {
 
 	searchResults := make([]*SearchResult, 0)
 	searchSegmentIDs := make([]UniqueID, 0)
@@ -129,10 +129,10 @@ func (s *streaming) search(searchReqs []*searchRequest, collID UniqueID, partIDs
 			return searchResults, searchSegmentIDs, searchPartIDs, nil
 		}
 		if err != nil {
-			log.Error(err.Error())
+			logutil.Logger(ctx).Error(err.Error())
 			return searchResults, searchSegmentIDs, searchPartIDs, err
 		}
-		log.Debug("no partition specified, search all partitions",
+		logutil.Logger(ctx).Debug("no partition specified, search all partitions",
 			zap.Any("collectionID", collID),
 			zap.Any("vChannel", vChannel),
 			zap.Any("all partitions", strPartIDs),
@@ -142,7 +142,7 @@ func (s *streaming) search(searchReqs []*searchRequest, collID UniqueID, partIDs
 		for _, id := range partIDs {
 			_, err := s.replica.getPartitionByID(id)
 			if err == nil {
-				log.Debug("append search partition id",
+				logutil.Logger(ctx).Debug("append search partition id",
 					zap.Any("collectionID", collID),
 					zap.Any("vChannel", vChannel),
 					zap.Any("partitionID", id),
@@ -170,15 +170,16 @@ func (s *streaming) search(searchReqs []*searchRequest, collID UniqueID, partIDs
 	var segmentLock sync.RWMutex
 	for _, partID := range searchPartIDs {
 		segIDs, err := s.replica.getSegmentIDsByVChannel(partID, vChannel)
-		log.Debug("get segmentIDs by vChannel",
+		logutil.Logger(ctx).Debug("get segmentIDs by vChannel",
 			zap.Any("collectionID", collID),
 			zap.Any("vChannel", vChannel),
 			zap.Any("partitionID", partID),
 			zap.Any("segmentIDs", segIDs),
 		)
 		if err != nil {
-			log.Warn(err.Error())
-			return searchResults, searchSegmentIDs, searchPartIDs, err
+			logutil.Logger(ctx).Warn(err.Error())
+			deleteSearchResults(searchResults)
+			return nil, nil, searchPartIDs, err
 		}
 
 		var err2 error
@@ -190,10 +191,15 @@ func (s *streaming) search(searchReqs []*searchRequest, collID UniqueID, partIDs
 				defer wg.Done()
 				seg, err := s.replica.getSegmentByID(segID2)
 				if err != nil {
-					log.Warn(err.Error())
+					logutil.Logger(ctx).Warn(err.Error())
 					err2 = err
 					return
 				}
+				for _, filter := range filters {
+					if !filter(seg) {
+						return
+					}
+				}
 
 				// TSafe less than searchTs means this vChannel is not available
 				//ts := s.tSafeReplica.getTSafe(seg.vChannelID)
@@ -204,7 +210,7 @@ func (s *streaming) search(searchReqs []*searchRequest, collID UniqueID, partIDs
 				//}
 				//tsp, _ := tsoutil.ParseTS(ts)
 				//stp, _ := tsoutil.ParseTS(searchTs)
-				//log.Debug("timestamp check in streaming search",
+				//logutil.Logger(ctx).Debug("timestamp check in streaming search",
 				//	zap.Any("collectionID", collID),
 				//	zap.Any("serviceTime_l", ts),
 				//	zap.Any("searchTime_l", searchTs),
@@ -215,8 +221,13 @@ func (s *streaming) search(searchReqs []*searchRequest, collID UniqueID, partIDs
 				//	continue
 				//}
 
+				if err = segmentSearchWorkers.acquire(ctx); err != nil {
+					err2 = err
+					return
+				}
 				tr := timerecord.NewTimeRecorder("searchOnGrowing")
-				searchResult, err := seg.search(plan, searchReqs, []Timestamp{searchTs})
+				searchResult, err := seg.search(ctx, plan, searchReqs, []Timestamp{searchTs})
+				segmentSearchWorkers.release()
 				if err != nil {
 					err2 = err
 					return
@@ -233,7 +244,9 @@ func (s *streaming) search(searchReqs []*searchRequest, collID UniqueID, partIDs
 		}
 		wg.Wait()
 		if err2 != nil {
-			return searchResults, searchSegmentIDs, searchPartIDs, err2
+			// release the segments referred by the partial results
+			deleteSearchResults(searchResults)
+			return nil, nil, searchPartIDs, err2
 		}
 	}
 
@@ -52,7 +52,7 @@ func TestStreaming_search(t *testing.T) {
 		plan, searchReqs, err := genSearchPlanAndRequests(collection, IndexFaissIDMap)
 		assert.NoError(t, err)
 
-		res, _, _, err := streaming.search(searchReqs,
+		res, _, _, err := streaming.search(context.Background(), searchReqs,
 			defaultCollectionID,
 			[]UniqueID{defaultPartitionID},
 			defaultDMLChannel,
@@ -62,6 +62,29 @@ func TestStreaming_search(t *testing.T) {
 		assert.Len(t, res, 1)
 	})
 
+	t.Run("test search filtered", func(t *testing.T) {
+		tSafe := newTSafeReplica()
+		streaming, err := genSimpleStreaming(ctx, tSafe)
+		assert.NoError(t, err)
+		defer streaming.close()
+
+		collection, err := streaming.replica.getCollectionByID(defaultCollectionID)
+		assert.NoError(t, err)
+		plan, searchReqs, err := genSearchPlanAndRequests(collection, IndexFaissIDMap)
+		assert.NoError(t, err)
+
+		res, ids, _, err := streaming.search(context.Background(), searchReqs,
+			defaultCollectionID,
+			[]UniqueID{defaultPartitionID},
+			defaultDMLChannel,
+			plan,
+			Timestamp(0),
+			func(segment *Segment) bool { return segment.segmentID != defaultSegmentID })
+		assert.NoError(t, err)
+		assert.Len(t, res, 0)
+		assert.Len(t, ids, 0)
+	})
+
 	t.Run("test run empty partition", func(t *testing.T) {
 		tSafe := newTSafeReplica()
 		streaming, err := genSimpleStreaming(ctx, tSafe)
@@ -73,7 +96,7 @@ func TestStreaming_search(t *testing.T) {
 		plan, searchReqs, err := genSearchPlanAndRequests(collection, IndexFaissIDMap)
 		assert.NoError(t, err)
 
-		res, _, _, err := streaming.search(searchReqs,
+		res, _, _, err := streaming.search(context.Background(), searchReqs,
 			defaultCollectionID,
 			[]UniqueID{},
 			defaultDMLChannel,
@@ -101,7 +124,7 @@ func TestStreaming_search(t *testing.T) {
 		err = streaming.replica.removePartition(defaultPartitionID)
 		assert.NoError(t, err)
 
-		res, _, _, err := streaming.search(searchReqs,
+		res, _, _, err := streaming.search(context.Background(), searchReqs,
 			defaultCollectionID,
 			[]UniqueID{defaultPartitionID},
 			defaultDMLChannel,
@@ -129,7 +152,7 @@ func TestStreaming_search(t *testing.T) {
 		err = streaming.replica.removePartition(defaultPartitionID)
 		assert.NoError(t, err)
 
-		_, _, _, err = streaming.search(searchReqs,
+		_, _, _, err = streaming.search(context.Background(), searchReqs,
 			defaultCollectionID,
 			[]UniqueID{defaultPartitionID},
 			defaultDMLChannel,
@@ -152,7 +175,7 @@ func TestStreaming_search(t *testing.T) {
 		err = streaming.replica.removePartition(defaultPartitionID)
 		assert.NoError(t, err)
 
-		res, _, _, err := streaming.search(searchReqs,
+		res, _, _, err := streaming.search(context.Background(), searchReqs,
 			defaultCollectionID,
 			[]UniqueID{},
 			defaultDMLChannel,
@@ -178,7 +201,7 @@ func TestStreaming_search(t *testing.T) {
 
 		seg.segmentPtr = nil
 
-		_, _, _, err = streaming.search(searchReqs,
+		_, _, _, err = streaming.search(context.Background(), searchReqs,
 			defaultCollectionID,
 			[]UniqueID{},
 			defaultDMLChannel,
@@ -218,7 +241,7 @@ func TestStreaming_retrieve(t *testing.T) {
 	assert.NoError(t, err)
 
 	t.Run("test retrieve", func(t *testing.T) {
-		res, ids, _, err := streaming.retrieve(defaultCollectionID,
+		res, ids, _, err := streaming.retrieve(context.Background(), defaultCollectionID,
 			[]UniqueID{defaultPartitionID},
 			plan)
 		assert.NoError(t, err)
@@ -230,7 +253,7 @@ func TestStreaming_retrieve(t *testing.T) {
 	})
 
 	t.Run("test empty partition", func(t *testing.T) {
-		res, ids, _, err := streaming.retrieve(defaultCollectionID,
+		res, ids, _, err := streaming.retrieve(context.Background(), defaultCollectionID,
 			[]UniqueID{},
 			plan)
 		assert.NoError(t, err)
@@ -22,7 +22,6 @@ import (
 	"fmt"
 	"math/rand"
 	"runtime/debug"
-	"time"
 
 	"go.uber.org/zap"
 
@@ -32,11 +31,13 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	queryPb "github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/logutil"
 )
 
 type task interface {
 	ID() UniqueID       // return ReqID
 	SetID(uid UniqueID) // set ReqID
+	Ctx() context.Context
 	Timestamp() Timestamp
 	PreExecute(ctx context.Context) error
 	Execute(ctx context.Context) error
@@ -88,6 +89,22 @@ type releasePartitionsTask struct {
 	node *QueryNode
 }
 
+// releaseChannelsTask releases the dml channels of a collection, and the delta channels of them,
+// the other channels and the sealed segments of the collection are kept.
+type releaseChannelsTask struct {
+	baseTask
+	req  *queryPb.ReleaseChannelsRequest
+	node *QueryNode
+}
+
+// refreshCollectionTask refreshes the schema and the partitions of a loaded collection
+// in streaming and historical replicas, without releasing the loaded data.
+type refreshCollectionTask struct {
+	baseTask
+	req  *queryPb.LoadPartitionsRequest
+	node *QueryNode
+}
+
 func (b *baseTask) ID() UniqueID {
 	return b.id
 }
@@ -96,6 +113,10 @@ func (b *baseTask) SetID(uid UniqueID) {
 	b.id = uid
 }
 
+func (b *baseTask) Ctx() context.Context {
+	return b.ctx
+}
+
 func (b *baseTask) WaitToFinish() error {
 	err := <-b.done
 	return err
@@ -128,7 +149,7 @@ func (r *addQueryChannelTask) PreExecute(ctx context.Context) error {
 }
 
 func (r *addQueryChannelTask) Execute(ctx context.Context) error {
-	log.Info("Execute addQueryChannelTask",
+	logutil.Logger(ctx).Info("Execute addQueryChannelTask",
 		zap.Any("collectionID", r.req.CollectionID))
 
 	collectionID := r.req.CollectionID
@@ -137,13 +158,13 @@ func (r *addQueryChannelTask) Execute(ctx context.Context) error {
 	}
 
 	qc := r.node.queryShardService.getQueryChannel(collectionID)
-	log.Info("add query channel for collection", zap.Int64("collectionID", collectionID))
+	logutil.Logger(ctx).Info("add query channel for collection", zap.Int64("collectionID", collectionID))
 
 	consumeSubName := funcutil.GenChannelSubName(Params.CommonCfg.QueryNodeSubName, collectionID, Params.QueryNodeCfg.GetNodeID())
 
 	err := qc.AsConsumer(r.req.QueryChannel, consumeSubName, r.req.SeekPosition)
 	if err != nil {
-		log.Warn("query channel as consumer failed", zap.Int64("collectionID", collectionID), zap.String("channel", r.req.QueryChannel), zap.Error(err))
+		logutil.Logger(ctx).Warn("query channel as consumer failed", zap.Int64("collectionID", collectionID), zap.String("channel", r.req.QueryChannel), zap.Error(err))
 		return err
 	}
 
@@ -154,7 +175,7 @@ func (r *addQueryChannelTask) Execute(ctx context.Context) error {
 		}*/
 
 	qc.Start()
-	log.Info("addQueryChannelTask done",
+	logutil.Logger(ctx).Info("addQueryChannelTask done",
 		zap.Any("collectionID", r.req.CollectionID),
 	)
 	return nil
@@ -216,7 +237,7 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 		return errors.New("get physical channels failed, illegal channel length, collectionID = " + fmt.Sprintln(collectionID))
 	}
 
-	log.Info("Starting WatchDmChannels ...",
+	logutil.Logger(ctx).Info("Starting WatchDmChannels ...",
 		zap.String("collectionName", w.req.Schema.Name),
 		zap.Int64("collectionID", collectionID),
 		zap.Int64("replicaID", w.req.GetReplicaID()),
@@ -266,6 +287,12 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 	}
 
 	// update partition info from unFlushedSegments and loadMeta
+	loadPartitionIDs := make([]UniqueID, 0, len(req.Infos)+len(req.GetLoadMeta().GetPartitionIDs()))
+	for _, info := range req.Infos {
+		loadPartitionIDs = append(loadPartitionIDs, info.PartitionID)
+	}
+	loadPartitionIDs = append(loadPartitionIDs, req.GetLoadMeta().GetPartitionIDs()...)
+	w.node.unmarkReleasedPartitions(collectionID, loadPartitionIDs)
 	for _, info := range req.Infos {
 		w.node.streaming.replica.addPartition(collectionID, info.PartitionID)
 		w.node.historical.replica.addPartition(collectionID, info.PartitionID)
@@ -275,16 +302,16 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 		w.node.streaming.replica.addPartition(collectionID, partitionID)
 	}
 
-	log.Info("loading growing segments in WatchDmChannels...",
+	logutil.Logger(ctx).Info("loading growing segments in WatchDmChannels...",
 		zap.Int64("collectionID", collectionID),
 		zap.Int64s("unFlushedSegmentIDs", unFlushedSegmentIDs),
 	)
-	err := w.node.loader.loadSegment(req, segmentTypeGrowing)
+	err := w.node.loader.loadSegment(ctx, req, segmentTypeGrowing)
 	if err != nil {
-		log.Warn(err.Error())
+		logutil.Logger(ctx).Warn(err.Error())
 		return err
 	}
-	log.Info("successfully load growing segments done in WatchDmChannels",
+	logutil.Logger(ctx).Info("successfully load growing segments done in WatchDmChannels",
 		zap.Int64("collectionID", collectionID),
 		zap.Int64s("unFlushedSegmentIDs", unFlushedSegmentIDs),
 	)
@@ -293,6 +320,7 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 	defer func() {
 		if err != nil {
 			for _, segmentID := range unFlushedSegmentIDs {
+				recordSegmentReleased(w.node.streaming.replica, segmentID, fmt.Sprintf("watch dm channels request %d failed", w.req.GetBase().GetMsgID()))
 				w.node.streaming.replica.removeSegment(segmentID)
 			}
 		}
@@ -311,7 +339,7 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 		info.SeekPosition.MsgGroup = consumeSubName
 		channel2SeekPosition[info.ChannelName] = info.SeekPosition
 	}
-	log.Info("watchDMChannel, group channels done", zap.Int64("collectionID", collectionID))
+	logutil.Logger(ctx).Info("watchDMChannel, group channels done", zap.Int64("collectionID", collectionID))
 
 	// add excluded segments for unFlushed segments,
 	// unFlushed segments before check point should be filtered out.
@@ -320,11 +348,12 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 		unFlushedCheckPointInfos = append(unFlushedCheckPointInfos, info.UnflushedSegments...)
 	}
 	w.node.streaming.replica.addExcludedSegments(collectionID, unFlushedCheckPointInfos)
+	recordSegmentsExcluded(unFlushedCheckPointInfos, "unflushed segment data before the check point filtered out")
 	unflushedSegmentIDs := make([]UniqueID, 0)
 	for i := 0; i < len(unFlushedCheckPointInfos); i++ {
 		unflushedSegmentIDs = append(unflushedSegmentIDs, unFlushedCheckPointInfos[i].GetID())
 	}
-	log.Info("watchDMChannel, add check points info for unFlushed segments done",
+	logutil.Logger(ctx).Info("watchDMChannel, add check points info for unFlushed segments done",
 		zap.Int64("collectionID", collectionID),
 		zap.Any("unflushedSegmentIDs", unflushedSegmentIDs),
 	)
@@ -344,7 +373,8 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 		}
 	}
 	w.node.streaming.replica.addExcludedSegments(collectionID, flushedCheckPointInfos)
-	log.Info("watchDMChannel, add check points info for flushed segments done",
+	recordSegmentsExcluded(flushedCheckPointInfos, "flushed segment data after the seek position filtered out")
+	logutil.Logger(ctx).Info("watchDMChannel, add check points info for flushed segments done",
 		zap.Int64("collectionID", collectionID),
 		zap.Any("flushedCheckPointInfos", flushedCheckPointInfos),
 	)
@@ -364,7 +394,8 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 		}
 	}
 	w.node.streaming.replica.addExcludedSegments(collectionID, droppedCheckPointInfos)
-	log.Info("watchDMChannel, add check points info for dropped segments done",
+	recordSegmentsExcluded(droppedCheckPointInfos, "dropped segment data after the seek position filtered out")
+	logutil.Logger(ctx).Info("watchDMChannel, add check points info for dropped segments done",
 		zap.Int64("collectionID", collectionID),
 		zap.Any("droppedCheckPointInfos", droppedCheckPointInfos),
 	)
@@ -372,10 +403,10 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 	// add flow graph
 	channel2FlowGraph, err := w.node.dataSyncService.addFlowGraphsForDMLChannels(collectionID, vChannels)
 	if err != nil {
-		log.Warn("watchDMChannel, add flowGraph for dmChannels failed", zap.Int64("collectionID", collectionID), zap.Strings("vChannels", vChannels), zap.Error(err))
+		logutil.Logger(ctx).Warn("watchDMChannel, add flowGraph for dmChannels failed", zap.Int64("collectionID", collectionID), zap.Strings("vChannels", vChannels), zap.Error(err))
 		return err
 	}
-	log.Info("Query node add DML flow graphs", zap.Int64("collectionID", collectionID), zap.Any("channels", vChannels))
+	logutil.Logger(ctx).Info("Query node add DML flow graphs", zap.Int64("collectionID", collectionID), zap.Any("channels", vChannels))
 
 	// channels as consumer
 	for channel, fg := range channel2FlowGraph {
@@ -383,7 +414,7 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 			// use pChannel to consume
 			err = fg.consumeFlowGraph(VPChannels[channel], consumeSubName)
 			if err != nil {
-				log.Error("msgStream as consumer failed for dmChannels", zap.Int64("collectionID", collectionID), zap.String("vChannel", channel))
+				logutil.Logger(ctx).Error("msgStream as consumer failed for dmChannels", zap.Int64("collectionID", collectionID), zap.String("vChannel", channel))
 				break
 			}
 		}
@@ -394,14 +425,14 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 			pos.ChannelName = VPChannels[channel]
 			err = fg.seekQueryNodeFlowGraph(pos)
 			if err != nil {
-				log.Error("msgStream seek failed for dmChannels", zap.Int64("collectionID", collectionID), zap.String("vChannel", channel))
+				logutil.Logger(ctx).Error("msgStream seek failed for dmChannels", zap.Int64("collectionID", collectionID), zap.String("vChannel", channel))
 				break
 			}
 		}
 	}
 
 	if err != nil {
-		log.Warn("watchDMChannel, add flowGraph for dmChannels failed", zap.Int64("collectionID", collectionID), zap.Strings("vChannels", vChannels), zap.Error(err))
+		logutil.Logger(ctx).Warn("watchDMChannel, add flowGraph for dmChannels failed", zap.Int64("collectionID", collectionID), zap.Strings("vChannels", vChannels), zap.Error(err))
 		for _, fg := range channel2FlowGraph {
 			fg.flowGraph.Close()
 		}
@@ -413,7 +444,7 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	log.Info("watchDMChannel, add flowGraph for dmChannels success", zap.Int64("collectionID", collectionID), zap.Strings("vChannels", vChannels))
+	logutil.Logger(ctx).Info("watchDMChannel, add flowGraph for dmChannels success", zap.Int64("collectionID", collectionID), zap.Strings("vChannels", vChannels))
 
 	sCol.addVChannels(vChannels)
 	sCol.addPChannels(pChannels)
@@ -422,7 +453,7 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 	hCol.addVChannels(vChannels)
 	hCol.addPChannels(pChannels)
 	hCol.setLoadType(lType)
-	log.Info("watchDMChannel, init replica done", zap.Int64("collectionID", collectionID), zap.Strings("vChannels", vChannels))
+	logutil.Logger(ctx).Info("watchDMChannel, init replica done", zap.Int64("collectionID", collectionID), zap.Strings("vChannels", vChannels))
 
 	// create tSafe
 	for _, channel := range vChannels {
@@ -437,12 +468,12 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 
 		qs, err := w.node.queryShardService.getQueryShard(dmlChannel)
 		if err != nil {
-			log.Warn("failed to get query shard", zap.String("dmlChannel", dmlChannel), zap.Error(err))
+			logutil.Logger(ctx).Warn("failed to get query shard", zap.String("dmlChannel", dmlChannel), zap.Error(err))
 			continue
 		}
 		err = qs.watchDMLTSafe()
 		if err != nil {
-			log.Warn("failed to start query shard watch dml tsafe", zap.Error(err))
+			logutil.Logger(ctx).Warn("failed to start query shard watch dml tsafe", zap.Error(err))
 		}
 	}
 
@@ -451,7 +482,7 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 		fg.flowGraph.Start()
 	}
 
-	log.Info("WatchDmChannels done", zap.Int64("collectionID", collectionID), zap.Strings("vChannels", vChannels))
+	logutil.Logger(ctx).Info("WatchDmChannels done", zap.Int64("collectionID", collectionID), zap.Strings("vChannels", vChannels))
 	return nil
 }
 
@@ -497,7 +528,7 @@ func (w *watchDeltaChannelsTask) Execute(ctx context.Context) error {
 		VPDeltaChannels[v] = p
 		vChannel2SeekPosition[v] = info.SeekPosition
 	}
-	log.Info("Starting WatchDeltaChannels ...",
+	logutil.Logger(ctx).Info("Starting WatchDeltaChannels ...",
 		zap.Any("collectionID", collectionID),
 		zap.Any("vDeltaChannels", vDeltaChannels),
 		zap.Any("pChannels", pDeltaChannels),
@@ -505,7 +536,7 @@ func (w *watchDeltaChannelsTask) Execute(ctx context.Context) error {
 	if len(VPDeltaChannels) != len(vDeltaChannels) {
 		return errors.New("get physical channels failed, illegal channel length, collectionID = " + fmt.Sprintln(collectionID))
 	}
-	log.Info("Get physical channels done",
+	logutil.Logger(ctx).Info("Get physical channels done",
 		zap.Any("collectionID", collectionID),
 	)
 
@@ -527,7 +558,7 @@ func (w *watchDeltaChannelsTask) Execute(ctx context.Context) error {
 
 	channel2FlowGraph, err := w.node.dataSyncService.addFlowGraphsForDeltaChannels(collectionID, vDeltaChannels)
 	if err != nil {
-		log.Warn("watchDeltaChannel, add flowGraph for deltaChannel failed", zap.Int64("collectionID", collectionID), zap.Strings("vDeltaChannels", vDeltaChannels), zap.Error(err))
+		logutil.Logger(ctx).Warn("watchDeltaChannel, add flowGraph for deltaChannel failed", zap.Int64("collectionID", collectionID), zap.Strings("vDeltaChannels", vDeltaChannels), zap.Error(err))
 		return err
 	}
 	consumeSubName := funcutil.GenChannelSubName(Params.CommonCfg.QueryNodeSubName, collectionID, Params.QueryNodeCfg.GetNodeID())
@@ -536,17 +567,17 @@ func (w *watchDeltaChannelsTask) Execute(ctx context.Context) error {
 		// use pChannel to consume
 		err = fg.consumeFlowGraphFromLatest(VPDeltaChannels[channel], consumeSubName)
 		if err != nil {
-			log.Error("msgStream as consumer failed for deltaChannels", zap.Int64("collectionID", collectionID), zap.Strings("vDeltaChannels", vDeltaChannels))
+			logutil.Logger(ctx).Error("msgStream as consumer failed for deltaChannels", zap.Int64("collectionID", collectionID), zap.Strings("vDeltaChannels", vDeltaChannels))
 			break
 		}
 		err = w.node.loader.FromDmlCPLoadDelete(w.ctx, collectionID, vChannel2SeekPosition[channel])
 		if err != nil {
-			log.Error("watchDeltaChannelsTask from dml cp load delete failed", zap.Int64("collectionID", collectionID), zap.Strings("vDeltaChannels", vDeltaChannels))
+			logutil.Logger(ctx).Error("watchDeltaChannelsTask from dml cp load delete failed", zap.Int64("collectionID", collectionID), zap.Strings("vDeltaChannels", vDeltaChannels))
 			break
 		}
 	}
 	if err != nil {
-		log.Warn("watchDeltaChannel, add flowGraph for deltaChannel failed", zap.Int64("collectionID", collectionID), zap.Strings("vDeltaChannels", vDeltaChannels), zap.Error(err))
+		logutil.Logger(ctx).Warn("watchDeltaChannel, add flowGraph for deltaChannel failed", zap.Int64("collectionID", collectionID), zap.Strings("vDeltaChannels", vDeltaChannels), zap.Error(err))
 		for _, fg := range channel2FlowGraph {
 			fg.flowGraph.Close()
 		}
@@ -558,7 +589,7 @@ func (w *watchDeltaChannelsTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	log.Info("watchDeltaChannel, add flowGraph for deltaChannel success", zap.Int64("collectionID", collectionID), zap.Strings("vDeltaChannels", vDeltaChannels))
+	logutil.Logger(ctx).Info("watchDeltaChannel, add flowGraph for deltaChannel success", zap.Int64("collectionID", collectionID), zap.Strings("vDeltaChannels", vDeltaChannels))
 
 	//set collection replica
 	hCol.addVDeltaChannels(vDeltaChannels)
@@ -576,7 +607,7 @@ func (w *watchDeltaChannelsTask) Execute(ctx context.Context) error {
 	for _, channel := range vDeltaChannels {
 		dmlChannel, err := funcutil.ConvertChannelName(channel, Params.CommonCfg.RootCoordDelta, Params.CommonCfg.RootCoordDml)
 		if err != nil {
-			log.Warn("failed to convert delta channel to dml", zap.String("channel", channel), zap.Error(err))
+			logutil.Logger(ctx).Warn("failed to convert delta channel to dml", zap.String("channel", channel), zap.Error(err))
 			continue
 		}
 		if !w.node.queryShardService.hasQueryShard(dmlChannel) {
@@ -585,12 +616,12 @@ func (w *watchDeltaChannelsTask) Execute(ctx context.Context) error {
 
 		qs, err := w.node.queryShardService.getQueryShard(dmlChannel)
 		if err != nil {
-			log.Warn("failed to get query shard", zap.String("dmlChannel", dmlChannel), zap.Error(err))
+			logutil.Logger(ctx).Warn("failed to get query shard", zap.String("dmlChannel", dmlChannel), zap.Error(err))
 			continue
 		}
 		err = qs.watchDeltaTSafe()
 		if err != nil {
-			log.Warn("failed to start query shard watch delta tsafe", zap.Error(err))
+			logutil.Logger(ctx).Warn("failed to start query shard watch delta tsafe", zap.Error(err))
 		}
 	}
 
@@ -599,7 +630,7 @@ func (w *watchDeltaChannelsTask) Execute(ctx context.Context) error {
 		fg.flowGraph.Start()
 	}
 
-	log.Info("WatchDeltaChannels done", zap.Int64("collectionID", collectionID), zap.String("ChannelIDs", fmt.Sprintln(vDeltaChannels)))
+	logutil.Logger(ctx).Info("WatchDeltaChannels done", zap.Int64("collectionID", collectionID), zap.String("ChannelIDs", fmt.Sprintln(vDeltaChannels)))
 	return nil
 }
 
@@ -631,13 +662,14 @@ func (l *loadSegmentsTask) PreExecute(ctx context.Context) error {
 
 func (l *loadSegmentsTask) Execute(ctx context.Context) error {
 	// TODO: support db
-	log.Info("LoadSegment start", zap.Int64("msgID", l.req.Base.MsgID))
+	logutil.Logger(ctx).Info("LoadSegment start", zap.Int64("msgID", l.req.Base.MsgID))
 	var err error
 
 	// init meta
 	collectionID := l.req.GetCollectionID()
 	l.node.historical.replica.addCollection(collectionID, l.req.GetSchema())
 	l.node.streaming.replica.addCollection(collectionID, l.req.GetSchema())
+	l.node.unmarkReleasedPartitions(collectionID, l.req.GetLoadMeta().GetPartitionIDs())
 	for _, partitionID := range l.req.GetLoadMeta().GetPartitionIDs() {
 		err = l.node.historical.replica.addPartition(collectionID, partitionID)
 		if err != nil {
@@ -649,13 +681,13 @@ func (l *loadSegmentsTask) Execute(ctx context.Context) error {
 		}
 	}
 
-	err = l.node.loader.loadSegment(l.req, segmentTypeSealed)
+	err = l.node.loader.loadSegment(ctx, l.req, segmentTypeSealed)
 	if err != nil {
-		log.Warn(err.Error())
+		logutil.Logger(ctx).Warn(err.Error())
 		return err
 	}
 
-	log.Info("LoadSegments done", zap.Int64("msgID", l.req.Base.MsgID))
+	logutil.Logger(ctx).Info("LoadSegments done", zap.Int64("msgID", l.req.Base.MsgID))
 	return nil
 }
 
@@ -663,6 +695,19 @@ func (l *loadSegmentsTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+// unmarkReleasedPartitions clears the released records of the partitions which are loaded again
+func (node *QueryNode) unmarkReleasedPartitions(collectionID UniqueID, partitionIDs []UniqueID) {
+	for _, replica := range []ReplicaInterface{node.historical.replica, node.streaming.replica} {
+		col, err := replica.getCollectionByID(collectionID)
+		if err != nil {
+			continue
+		}
+		for _, partitionID := range partitionIDs {
+			col.deleteReleasedPartition(partitionID)
+		}
+	}
+}
+
 // releaseCollectionTask
 func (r *releaseCollectionTask) Timestamp() Timestamp {
 	if r.req.Base == nil {
@@ -694,11 +739,10 @@ const (
 )
 
 func (r *releaseCollectionTask) Execute(ctx context.Context) error {
-	log.Info("Execute release collection task", zap.Any("collectionID", r.req.CollectionID))
-	// sleep to wait for query tasks done
-	const gracefulReleaseTime = 1
-	time.Sleep(gracefulReleaseTime * time.Second)
-	log.Info("Starting release collection...",
+	logutil.Logger(ctx).Info("Execute release collection task", zap.Any("collectionID", r.req.CollectionID))
+	// wait for query tasks done
+	r.node.drainInFlightRequests(r.req.CollectionID)
+	logutil.Logger(ctx).Info("Starting release collection...",
 		zap.Any("collectionID", r.req.CollectionID),
 	)
 
@@ -708,7 +752,7 @@ func (r *releaseCollectionTask) Execute(ctx context.Context) error {
 	}
 
 	// remove collection metas in streaming and historical
-	log.Info("release historical", zap.Any("collectionID", r.req.CollectionID))
+	logutil.Logger(ctx).Info("release historical", zap.Any("collectionID", r.req.CollectionID))
 	err = r.releaseReplica(r.node.historical.replica, replicaHistorical)
 	if err != nil {
 		return fmt.Errorf("release collection failed, collectionID = %d, err = %s", r.req.CollectionID, err)
@@ -718,7 +762,7 @@ func (r *releaseCollectionTask) Execute(ctx context.Context) error {
 
 	r.node.queryShardService.releaseCollection(r.req.CollectionID)
 
-	log.Info("ReleaseCollection done", zap.Int64("collectionID", r.req.CollectionID))
+	logutil.Logger(ctx).Info("ReleaseCollection done", zap.Int64("collectionID", r.req.CollectionID))
 	return nil
 }
 
@@ -758,6 +802,9 @@ func (r *releaseCollectionTask) releaseReplica(replica ReplicaInterface, replica
 
 	// remove excludedSegments record
 	replica.removeExcludedSegments(r.req.CollectionID)
+	if partitionIDs, err := replica.getPartitionIDs(r.req.CollectionID); err == nil {
+		recordPartitionsReleased(replica, r.req.CollectionID, partitionIDs, fmt.Sprintf("release collection request %d", r.req.GetBase().GetMsgID()))
+	}
 	err = replica.removeCollection(r.req.CollectionID)
 	if err != nil {
 		return err
@@ -792,33 +839,39 @@ func (r *releasePartitionsTask) PreExecute(ctx context.Context) error {
 }
 
 func (r *releasePartitionsTask) Execute(ctx context.Context) error {
-	log.Info("Execute release partition task",
+	logutil.Logger(ctx).Info("Execute release partition task",
 		zap.Any("collectionID", r.req.CollectionID),
 		zap.Any("partitionIDs", r.req.PartitionIDs))
 
-	// sleep to wait for query tasks done
-	const gracefulReleaseTime = 1
-	time.Sleep(gracefulReleaseTime * time.Second)
+	// wait for query tasks done
+	r.node.drainInFlightRequests(r.req.CollectionID)
 
 	// get collection from streaming and historical
-	_, err := r.node.historical.replica.getCollectionByID(r.req.CollectionID)
+	hCol, err := r.node.historical.replica.getCollectionByID(r.req.CollectionID)
 	if err != nil {
 		return fmt.Errorf("release partitions failed, collectionID = %d, err = %s", r.req.CollectionID, err)
 	}
-	_, err = r.node.streaming.replica.getCollectionByID(r.req.CollectionID)
+	sCol, err := r.node.streaming.replica.getCollectionByID(r.req.CollectionID)
 	if err != nil {
 		return fmt.Errorf("release partitions failed, collectionID = %d, err = %s", r.req.CollectionID, err)
 	}
-	log.Info("start release partition", zap.Any("collectionID", r.req.CollectionID))
+	logutil.Logger(ctx).Info("start release partition", zap.Any("collectionID", r.req.CollectionID))
 
 	for _, id := range r.req.PartitionIDs {
+		// mark the partition released before removing it, so that the flow graphs won't add it back
+		hCol.addReleasedPartition(id)
+		sCol.addReleasedPartition(id)
+
 		// remove partition from streaming and historical
+		cause := fmt.Sprintf("release partitions request %d", r.req.GetBase().GetMsgID())
+		recordPartitionsReleased(r.node.historical.replica, r.req.CollectionID, []UniqueID{id}, cause)
+		recordPartitionsReleased(r.node.streaming.replica, r.req.CollectionID, []UniqueID{id}, cause)
 		hasPartitionInHistorical := r.node.historical.replica.hasPartition(id)
 		if hasPartitionInHistorical {
 			err := r.node.historical.replica.removePartition(id)
 			if err != nil {
 				// not return, try to release all partitions
-				log.Warn(err.Error())
+				logutil.Logger(ctx).Warn(err.Error())
 			}
 		}
 		hasPartitionInStreaming := r.node.streaming.replica.hasPartition(id)
@@ -826,12 +879,15 @@ func (r *releasePartitionsTask) Execute(ctx context.Context) error {
 			err := r.node.streaming.replica.removePartition(id)
 			if err != nil {
 				// not return, try to release all partitions
-				log.Warn(err.Error())
+				logutil.Logger(ctx).Warn(err.Error())
 			}
 		}
 	}
 
-	log.Info("Release partition task done",
+	// the flushed segments of the released partitions won't be consumed any more
+	r.node.streaming.replica.removeExcludedSegmentsByPartitionIDs(r.req.CollectionID, r.req.PartitionIDs)
+
+	logutil.Logger(ctx).Info("Release partition task done",
 		zap.Any("collectionID", r.req.CollectionID),
 		zap.Any("partitionIDs", r.req.PartitionIDs))
 	return nil
@@ -840,3 +896,156 @@ func (r *releasePartitionsTask) Execute(ctx context.Context) error {
 func (r *releasePartitionsTask) PostExecute(ctx context.Context) error {
 	return nil
 }
+
+// releaseChannelsTask
+func (r *releaseChannelsTask) Timestamp() Timestamp {
+	if r.req.Base == nil {
+		log.Warn("nil base req in releaseChannelsTask", zap.Any("collectionID", r.req.CollectionID))
+		return 0
+	}
+	return r.req.Base.Timestamp
+}
+
+func (r *releaseChannelsTask) OnEnqueue() error {
+	if r.req == nil || r.req.Base == nil {
+		r.SetID(rand.Int63n(100000000000))
+	} else {
+		r.SetID(r.req.Base.MsgID)
+	}
+	return nil
+}
+
+func (r *releaseChannelsTask) PreExecute(ctx context.Context) error {
+	return nil
+}
+
+func (r *releaseChannelsTask) Execute(ctx context.Context) error {
+	logutil.Logger(ctx).Info("Execute release channels task",
+		zap.Int64("collectionID", r.req.CollectionID),
+		zap.Strings("channels", r.req.GetChannels()))
+
+	// wait for query tasks done
+	r.node.drainInFlightRequests(r.req.CollectionID)
+
+	sCol, err := r.node.streaming.replica.getCollectionByID(r.req.CollectionID)
+	if err != nil {
+		return fmt.Errorf("release channels failed, collectionID = %d, err = %s", r.req.CollectionID, err)
+	}
+	hCol, err := r.node.historical.replica.getCollectionByID(r.req.CollectionID)
+	if err != nil {
+		return fmt.Errorf("release channels failed, collectionID = %d, err = %s", r.req.CollectionID, err)
+	}
+
+	// release the dml channels, and the growing segments consumed from them
+	r.node.dataSyncService.removeFlowGraphsByDMLChannels(r.req.GetChannels())
+	for _, channel := range r.req.GetChannels() {
+		r.node.tSafeReplica.removeTSafe(channel)
+		if err := r.releaseGrowingSegments(channel); err != nil {
+			return fmt.Errorf("release channels failed, collectionID = %d, err = %s", r.req.CollectionID, err)
+		}
+		r.node.streaming.replica.removeAppliedPosition(channel)
+		sCol.removeVChannel(channel)
+	}
+
+	// release the delta channels of the dml channels
+	deltaChannels := make([]Channel, 0, len(r.req.GetChannels()))
+	for _, channel := range r.req.GetChannels() {
+		deltaChannel, err := funcutil.ConvertChannelName(channel, Params.CommonCfg.RootCoordDml, Params.CommonCfg.RootCoordDelta)
+		if err != nil {
+			logutil.Logger(ctx).Warn("failed to convert dml channel to delta", zap.String("channel", channel), zap.Error(err))
+			continue
+		}
+		deltaChannels = append(deltaChannels, deltaChannel)
+	}
+	r.node.dataSyncService.removeFlowGraphsByDeltaChannels(deltaChannels)
+	for _, channel := range deltaChannels {
+		r.node.tSafeReplica.removeTSafe(channel)
+		hCol.removeVDeltaChannel(channel)
+		sCol.removeVDeltaChannel(channel)
+	}
+
+	for _, channel := range r.req.GetChannels() {
+		r.node.queryShardService.releaseQueryShard(channel)
+	}
+
+	logutil.Logger(ctx).Info("Release channels task done",
+		zap.Int64("collectionID", r.req.CollectionID),
+		zap.Strings("channels", r.req.GetChannels()))
+	return nil
+}
+
+// releaseGrowingSegments removes the growing segments of the collection which are consumed from the channel
+func (r *releaseChannelsTask) releaseGrowingSegments(channel Channel) error {
+	replica := r.node.streaming.replica
+	partitionIDs, err := replica.getPartitionIDs(r.req.CollectionID)
+	if err != nil {
+		return err
+	}
+	for _, partitionID := range partitionIDs {
+		segmentIDs, err := replica.getSegmentIDsByVChannel(partitionID, channel)
+		if err != nil {
+			return err
+		}
+		for _, segmentID := range segmentIDs {
+			if err := replica.removeSegment(segmentID); err != nil {
+				return err
+			}
+			recordSegmentEvent(segmentEventReleased, r.req.CollectionID, partitionID, segmentID,
+				fmt.Sprintf("dml channel %s released by request %d", channel, r.req.GetBase().GetMsgID()))
+		}
+	}
+	return nil
+}
+
+func (r *releaseChannelsTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// refreshCollectionTask
+func (r *refreshCollectionTask) Timestamp() Timestamp {
+	if r.req.Base == nil {
+		log.Warn("nil base req in refreshCollectionTask", zap.Any("collectionID", r.req.CollectionID))
+		return 0
+	}
+	return r.req.Base.Timestamp
+}
+
+func (r *refreshCollectionTask) OnEnqueue() error {
+	if r.req == nil || r.req.Base == nil {
+		r.SetID(rand.Int63n(100000000000))
+	} else {
+		r.SetID(r.req.Base.MsgID)
+	}
+	return nil
+}
+
+func (r *refreshCollectionTask) PreExecute(ctx context.Context) error {
+	return nil
+}
+
+func (r *refreshCollectionTask) Execute(ctx context.Context) error {
+	logutil.Logger(ctx).Info("Execute refresh collection task",
+		zap.Int64("collectionID", r.req.CollectionID),
+		zap.Int64s("partitionIDs", r.req.PartitionIDs))
+
+	hasCollection := false
+	for _, replica := range []ReplicaInterface{r.node.historical.replica, r.node.streaming.replica} {
+		if !replica.hasCollection(r.req.CollectionID) {
+			continue
+		}
+		hasCollection = true
+		if err := replica.refreshCollection(r.req.CollectionID, r.req.Schema, r.req.PartitionIDs); err != nil {
+			return fmt.Errorf("refresh collection failed, collectionID = %d, err = %s", r.req.CollectionID, err)
+		}
+	}
+	if !hasCollection {
+		return fmt.Errorf("refresh collection failed, collection %d has not been loaded", r.req.CollectionID)
+	}
+
+	logutil.Logger(ctx).Info("Refresh collection task done", zap.Int64("collectionID", r.req.CollectionID))
+	return nil
+}
+
+func (r *refreshCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
@@ -32,6 +32,8 @@ type taskQueue interface {
 	utChan() <-chan int
 	utEmpty() bool
 	utFull() bool
+	utLen() int
+	utCapacity() int
 	addUnissuedTask(t task) error
 	PopUnissuedTask() task
 	AddActiveTask(t task)
@@ -74,6 +76,16 @@ func (queue *baseTaskQueue) utFull() bool {
 	return int64(queue.unissuedTasks.Len()) >= queue.maxTaskNum
 }
 
+func (queue *baseTaskQueue) utLen() int {
+	queue.utMu.RLock()
+	defer queue.utMu.RUnlock()
+	return queue.unissuedTasks.Len()
+}
+
+func (queue *baseTaskQueue) utCapacity() int {
+	return int(queue.maxTaskNum)
+}
+
 func (queue *baseTaskQueue) addUnissuedTask(t task) error {
 	if queue.utFull() {
 		return errors.New("task queue is full")
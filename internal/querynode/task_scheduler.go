@@ -18,9 +18,14 @@ package querynode
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"sync"
 
-	"github.com/milvus-io/milvus/internal/log"
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/milvus-io/milvus/internal/util/logutil"
+	"github.com/milvus-io/milvus/internal/util/trace"
 )
 
 type taskScheduler struct {
@@ -42,14 +47,24 @@ func newTaskScheduler(ctx context.Context) *taskScheduler {
 }
 
 func (s *taskScheduler) processTask(t task, q taskQueue) {
-	// TODO: ctx?
-	err := t.PreExecute(s.ctx)
+	name := taskName(t)
+	span, ctx := trace.StartSpanFromContextWithOperationName(s.traceCtx(t), name,
+		opentracing.Tags{
+			"Type": name,
+			"ID":   t.ID(),
+		})
+	defer span.Finish()
+	// the task ID is the msgID of the request, see the OnEnqueue of the tasks
+	ctx = logutil.WithReqID(ctx, t.ID())
+
+	err := processTaskPhase(ctx, name+".PreExecute", t.PreExecute)
 
 	defer func() {
+		trace.LogError(span, err)
 		t.Notify(err)
 	}()
 	if err != nil {
-		log.Warn(err.Error())
+		logutil.Logger(ctx).Warn(err.Error())
 		return
 	}
 
@@ -58,12 +73,38 @@ func (s *taskScheduler) processTask(t task, q taskQueue) {
 		q.PopActiveTask(t.ID())
 	}()
 
-	err = t.Execute(s.ctx)
+	err = processTaskPhase(ctx, name+".Execute", t.Execute)
 	if err != nil {
-		log.Warn(err.Error())
+		logutil.Logger(ctx).Warn(err.Error())
 		return
 	}
-	err = t.PostExecute(s.ctx)
+	err = processTaskPhase(ctx, name+".PostExecute", t.PostExecute)
+}
+
+// traceCtx returns the scheduler ctx carrying the span of the request which issued the task,
+// so the task is traced as a child of the request and still cancelled with the scheduler.
+func (s *taskScheduler) traceCtx(t task) context.Context {
+	if t.Ctx() == nil {
+		return s.ctx
+	}
+	if parent := opentracing.SpanFromContext(t.Ctx()); parent != nil {
+		return opentracing.ContextWithSpan(s.ctx, parent)
+	}
+	return s.ctx
+}
+
+// processTaskPhase runs a phase of the task in its own span
+func processTaskPhase(ctx context.Context, name string, phase func(ctx context.Context) error) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, name)
+	defer sp.Finish()
+	err := phase(ctx)
+	trace.LogError(sp, err)
+	return err
+}
+
+func taskName(t task) string {
+	name := fmt.Sprintf("%T", t)
+	return name[strings.LastIndexByte(name, '.')+1:]
 }
 
 func (s *taskScheduler) taskLoop() {
@@ -20,6 +20,11 @@ import (
 	"context"
 	"errors"
 	"testing"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type mockTask struct {
@@ -78,3 +83,54 @@ func TestTaskScheduler(t *testing.T) {
 
 	ts.Close()
 }
+
+func TestTaskScheduler_trace(t *testing.T) {
+	tracer := mocktracer.New()
+	globalTracer := opentracing.GlobalTracer()
+	opentracing.SetGlobalTracer(tracer)
+	defer opentracing.SetGlobalTracer(globalTracer)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ts := newTaskScheduler(ctx)
+
+	parent := tracer.StartSpan("request")
+	task := &mockTask{
+		baseTask: baseTask{
+			ctx:  opentracing.ContextWithSpan(context.Background(), parent),
+			done: make(chan error, 1024),
+		},
+		executeError: true,
+	}
+	ts.processTask(task, ts.queue)
+	parent.Finish()
+
+	spans := make(map[string]*mocktracer.MockSpan)
+	for _, span := range tracer.FinishedSpans() {
+		spans[span.OperationName] = span
+	}
+	taskSpan, ok := spans["mockTask"]
+	require.True(t, ok)
+	assert.Equal(t, parent.(*mocktracer.MockSpan).SpanContext.SpanID, taskSpan.ParentID)
+	assert.Equal(t, "mockTask", taskSpan.Tag("Type"))
+
+	preExecute, ok := spans["mockTask.PreExecute"]
+	require.True(t, ok)
+	assert.Equal(t, taskSpan.SpanContext.SpanID, preExecute.ParentID)
+	execute, ok := spans["mockTask.Execute"]
+	require.True(t, ok)
+	assert.Equal(t, taskSpan.SpanContext.SpanID, execute.ParentID)
+	// the error is logged
+	assert.Greater(t, len(execute.Logs()), len(preExecute.Logs()))
+	// stopped after the failed execution
+	_, ok = spans["mockTask.PostExecute"]
+	assert.False(t, ok)
+
+	// tasks without a request span are traced from the scheduler
+	tracer.Reset()
+	task.ctx = nil
+	task.executeError = false
+	ts.processTask(task, ts.queue)
+	assert.Equal(t, 4, len(tracer.FinishedSpans()))
+	ts.Close()
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -618,6 +619,9 @@ func TestTask_loadSegmentsTask(t *testing.T) {
 
 		totalRAM := Params.QueryNodeCfg.CacheSize * 1024 * 1024 * 1024
 
+		err = node.historical.replica.removeSegment(defaultSegmentID)
+		assert.NoError(t, err)
+
 		col, err := node.historical.replica.getCollectionByID(defaultCollectionID)
 		assert.NoError(t, err)
 
@@ -737,6 +741,113 @@ func TestTask_releaseCollectionTask(t *testing.T) {
 	})
 }
 
+func TestTask_releaseChannelsTask(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	genReleaseChannelsRequest := func(channels ...Channel) *querypb.ReleaseChannelsRequest {
+		req := &querypb.ReleaseChannelsRequest{
+			Base:         genCommonMsgBase(commonpb.MsgType_ReleaseCollection),
+			CollectionID: defaultCollectionID,
+			Channels:     channels,
+		}
+		return req
+	}
+
+	t.Run("test timestamp", func(t *testing.T) {
+		task := releaseChannelsTask{
+			req: genReleaseChannelsRequest(),
+		}
+		timestamp := Timestamp(1000)
+		task.req.Base.Timestamp = timestamp
+		resT := task.Timestamp()
+		assert.Equal(t, timestamp, resT)
+		task.req.Base = nil
+		resT = task.Timestamp()
+		assert.Equal(t, Timestamp(0), resT)
+	})
+
+	t.Run("test OnEnqueue", func(t *testing.T) {
+		task := releaseChannelsTask{
+			req: genReleaseChannelsRequest(),
+		}
+		err := task.OnEnqueue()
+		assert.NoError(t, err)
+		task.req.Base = nil
+		err = task.OnEnqueue()
+		assert.NoError(t, err)
+	})
+
+	t.Run("test execute", func(t *testing.T) {
+		node, err := genSimpleQueryNode(ctx)
+		assert.NoError(t, err)
+
+		otherChannel := defaultDMLChannel + "-other"
+		col, err := node.streaming.replica.getCollectionByID(defaultCollectionID)
+		assert.NoError(t, err)
+		col.addVChannels([]Channel{otherChannel})
+		node.tSafeReplica.addTSafe(otherChannel)
+		err = node.queryShardService.addQueryShard(defaultCollectionID, defaultDMLChannel, defaultReplicaID)
+		assert.NoError(t, err)
+
+		task := releaseChannelsTask{
+			req:  genReleaseChannelsRequest(defaultDMLChannel),
+			node: node,
+		}
+		err = task.Execute(ctx)
+		assert.NoError(t, err)
+
+		assert.False(t, node.streaming.replica.hasSegment(defaultSegmentID))
+		assert.ElementsMatch(t, []Channel{otherChannel}, col.getVChannels())
+		_, err = node.tSafeReplica.getTSafe(defaultDMLChannel)
+		assert.Error(t, err)
+		_, err = node.tSafeReplica.getTSafe(otherChannel)
+		assert.NoError(t, err)
+		assert.False(t, node.queryShardService.hasQueryShard(defaultDMLChannel))
+		assert.True(t, node.streaming.replica.hasCollection(defaultCollectionID))
+		assert.True(t, node.historical.replica.hasCollection(defaultCollectionID))
+	})
+
+	t.Run("test execute release delta channel", func(t *testing.T) {
+		node, err := genSimpleQueryNode(ctx)
+		assert.NoError(t, err)
+
+		dmlChannel := Params.CommonCfg.RootCoordDml + "_0v0"
+		deltaChannel, err := funcutil.ConvertChannelName(dmlChannel, Params.CommonCfg.RootCoordDml, Params.CommonCfg.RootCoordDelta)
+		assert.NoError(t, err)
+		col, err := node.historical.replica.getCollectionByID(defaultCollectionID)
+		assert.NoError(t, err)
+		col.addVDeltaChannels([]Channel{deltaChannel})
+		node.tSafeReplica.addTSafe(deltaChannel)
+
+		task := releaseChannelsTask{
+			req:  genReleaseChannelsRequest(dmlChannel),
+			node: node,
+		}
+		err = task.Execute(ctx)
+		assert.NoError(t, err)
+
+		assert.Empty(t, col.getVDeltaChannels())
+		_, err = node.tSafeReplica.getTSafe(deltaChannel)
+		assert.Error(t, err)
+	})
+
+	t.Run("test execute no collection", func(t *testing.T) {
+		node, err := genSimpleQueryNode(ctx)
+		assert.NoError(t, err)
+
+		err = node.streaming.replica.removeCollection(defaultCollectionID)
+		assert.NoError(t, err)
+
+		task := releaseChannelsTask{
+			req:  genReleaseChannelsRequest(defaultDMLChannel),
+			node: node,
+		}
+		err = task.Execute(ctx)
+		assert.Error(t, err)
+	})
+}
+
 func TestTask_releasePartitionTask(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -792,6 +903,41 @@ func TestTask_releasePartitionTask(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("test execute purge partition", func(t *testing.T) {
+		node, err := genSimpleQueryNode(ctx)
+		assert.NoError(t, err)
+
+		node.streaming.replica.addExcludedSegments(defaultCollectionID, []*datapb.SegmentInfo{
+			{ID: defaultSegmentID, CollectionID: defaultCollectionID, PartitionID: defaultPartitionID},
+			{ID: defaultSegmentID + 1, CollectionID: defaultCollectionID, PartitionID: defaultPartitionID + 1},
+		})
+
+		task := releasePartitionsTask{
+			req:  genReleasePartitionsRequest(),
+			node: node,
+		}
+		err = task.Execute(ctx)
+		assert.NoError(t, err)
+
+		assert.False(t, node.streaming.replica.hasSegment(defaultSegmentID))
+		segmentInfos, err := node.streaming.replica.getExcludedSegments(defaultCollectionID)
+		assert.NoError(t, err)
+		for _, segmentInfo := range segmentInfos {
+			assert.NotEqual(t, defaultPartitionID, segmentInfo.GetPartitionID())
+		}
+
+		// the flow graph is not able to add the released partition back
+		err = node.streaming.replica.addPartition(defaultCollectionID, defaultPartitionID)
+		assert.Error(t, err)
+
+		// load the released partition again
+		node.unmarkReleasedPartitions(defaultCollectionID, []UniqueID{defaultPartitionID})
+		err = node.streaming.replica.addPartition(defaultCollectionID, defaultPartitionID)
+		assert.NoError(t, err)
+		err = node.historical.replica.addPartition(defaultCollectionID, defaultPartitionID)
+		assert.NoError(t, err)
+	})
+
 	t.Run("test execute no collection", func(t *testing.T) {
 		node, err := genSimpleQueryNode(ctx)
 		assert.NoError(t, err)
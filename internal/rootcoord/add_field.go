@@ -0,0 +1,156 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// A scalar field with a default value could be added to an existing collection. The segments written before
+// have no binlog of the field, the default value is filled in when they are loaded or compacted, and so are
+// the insert messages without the field. The query nodes keep serving the loaded collection with the fields it's
+// loaded with, the added field is served once the collection is released and loaded again.
+
+const maxLengthPerRowKey = "max_length_per_row"
+
+// newAddedFieldSchema validates the field to add and returns its schema, the field ID is assigned by MetaTable.AddField
+func newAddedFieldSchema(field *schemapb.FieldSchema) (*schemapb.FieldSchema, error) {
+	if field == nil {
+		return nil, fmt.Errorf("field to add is not specified")
+	}
+	if field.GetName() == "" {
+		return nil, fmt.Errorf("name of the field to add is empty")
+	}
+	if field.GetIsPrimaryKey() || field.GetAutoID() {
+		return nil, fmt.Errorf("field %s to add should be neither primary key nor auto id", field.GetName())
+	}
+	defaultValue, ok := getTypeParam(field, common.DefaultValueParam)
+	if !ok {
+		return nil, fmt.Errorf("%s of field %s is required", common.DefaultValueParam, field.GetName())
+	}
+	fieldSchema := &schemapb.FieldSchema{
+		Name:        field.GetName(),
+		Description: field.GetDescription(),
+		DataType:    field.GetDataType(),
+		TypeParams:  []*commonpb.KeyValuePair{{Key: common.DefaultValueParam, Value: defaultValue}},
+	}
+	switch fieldSchema.DataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_Float, schemapb.DataType_Double:
+	case schemapb.DataType_VarChar:
+		value, _ := getTypeParam(field, maxLengthPerRowKey)
+		maxLength, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || maxLength <= 0 {
+			return nil, fmt.Errorf("%s of VarChar field %s should be positive", maxLengthPerRowKey, field.GetName())
+		}
+		if int64(len(defaultValue)) > maxLength {
+			return nil, fmt.Errorf("default value of field %s exceeds the %s %d", field.GetName(), maxLengthPerRowKey, maxLength)
+		}
+		fieldSchema.TypeParams = append(fieldSchema.TypeParams, &commonpb.KeyValuePair{
+			Key:   maxLengthPerRowKey,
+			Value: strconv.FormatInt(maxLength, 10),
+		})
+	default:
+		return nil, fmt.Errorf("only scalar field could be added, field %s is %s", field.GetName(), field.GetDataType())
+	}
+	if _, _, err := typeutil.GetDefaultValue(fieldSchema); err != nil {
+		return nil, err
+	}
+	return fieldSchema, nil
+}
+
+func getTypeParam(field *schemapb.FieldSchema, key string) (string, bool) {
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == key {
+			return kv.GetValue(), true
+		}
+	}
+	return "", false
+}
+
+// AddField appends the field to the schema of the collection with the next field ID
+func (mt *MetaTable) AddField(collID typeutil.UniqueID, field *schemapb.FieldSchema, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection. id = %d", collID)
+	}
+
+	fieldID := typeutil.UniqueID(common.StartOfUserFieldID)
+	for _, f := range coll.GetSchema().GetFields() {
+		if f.GetName() == field.GetName() {
+			return fmt.Errorf("field name = %s already exists", field.GetName())
+		}
+		if f.GetFieldID() >= fieldID {
+			fieldID = f.GetFieldID() + 1
+		}
+	}
+	field.FieldID = fieldID
+
+	updated := proto.Clone(&coll).(*pb.CollectionInfo)
+	updated.Schema.Fields = append(updated.Schema.Fields, field)
+	k := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
+	v, err := proto.Marshal(updated)
+	if err != nil {
+		return fmt.Errorf("metaTable AddField Marshal fail, key:%s, err:%w", k, err)
+	}
+	if err := mt.snapshot.Save(k, string(v), ts); err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		return err
+	}
+	mt.collID2Meta[collID] = *updated
+	return nil
+}
+
+// addField adds the field to the collection, expires the meta cache of the proxies and sends the schema with the
+// field to the coordinators
+func (c *Core) addField(ctx context.Context, database string, collName string, field *schemapb.FieldSchema) error {
+	fieldSchema, err := newAddedFieldSchema(field)
+	if err != nil {
+		return err
+	}
+	collID, err := c.MetaTable.GetCollectionIDByName(database, collName)
+	if err != nil {
+		return err
+	}
+	ts, err := c.TSOAllocator(1)
+	if err != nil {
+		return err
+	}
+	if err := c.MetaTable.AddField(collID, fieldSchema, ts); err != nil {
+		return err
+	}
+	log.Info("field added to collection", zap.Int64("collectionID", collID), zap.String("collection", collName),
+		zap.String("field", fieldSchema.GetName()), zap.Int64("fieldID", fieldSchema.GetFieldID()))
+
+	c.ExpireMetaCache(ctx, database, append(c.MetaTable.ListAliases(collID), collName), ts)
+	c.refreshCollection(ctx, collID)
+	return nil
+}
@@ -0,0 +1,193 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func fieldToAdd(name string, dataType schemapb.DataType, params ...string) *schemapb.FieldSchema {
+	field := &schemapb.FieldSchema{Name: name, DataType: dataType}
+	for i := 0; i+1 < len(params); i += 2 {
+		field.TypeParams = append(field.TypeParams, &commonpb.KeyValuePair{Key: params[i], Value: params[i+1]})
+	}
+	return field
+}
+
+func TestNewAddedFieldSchema(t *testing.T) {
+	field, err := newAddedFieldSchema(fieldToAdd("age", schemapb.DataType_Int32, common.DefaultValueParam, "18"))
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_Int32, field.GetDataType())
+	value, ok, err := typeutil.GetDefaultValue(field)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int32(18), value)
+
+	field, err = newAddedFieldSchema(fieldToAdd("tag", schemapb.DataType_VarChar, common.DefaultValueParam, "none", maxLengthPerRowKey, "16"))
+	assert.NoError(t, err)
+	maxLength, err := typeutil.GetMaxLengthOfVarLengthField(field)
+	assert.NoError(t, err)
+	assert.Equal(t, 16, maxLength)
+
+	pk := fieldToAdd("id", schemapb.DataType_Int64, common.DefaultValueParam, "0")
+	pk.IsPrimaryKey = true
+	invalids := []*schemapb.FieldSchema{
+		nil,
+		fieldToAdd("", schemapb.DataType_Int32, common.DefaultValueParam, "0"),
+		fieldToAdd("age", schemapb.DataType_Int32, common.DefaultValueParam, "zero"),
+		fieldToAdd("age", schemapb.DataType_Int32),
+		fieldToAdd("vec", schemapb.DataType_FloatVector, common.DefaultValueParam, "0"),
+		fieldToAdd("tag", schemapb.DataType_VarChar, common.DefaultValueParam, "none"),
+		fieldToAdd("tag", schemapb.DataType_VarChar, common.DefaultValueParam, "none", maxLengthPerRowKey, "2"),
+		pk,
+	}
+	for _, invalid := range invalids {
+		_, err = newAddedFieldSchema(invalid)
+		assert.Error(t, err)
+	}
+}
+
+func TestMetaTable_AddField(t *testing.T) {
+	var saved string
+	snapshot := &mockTestKV{
+		save: func(key, value string, ts typeutil.Timestamp) error {
+			saved = key
+			return nil
+		},
+	}
+	mt := &MetaTable{
+		snapshot: snapshot,
+		collID2Meta: map[typeutil.UniqueID]pb.CollectionInfo{
+			1: {
+				ID: 1,
+				Schema: &schemapb.CollectionSchema{
+					Name: "coll",
+					Fields: []*schemapb.FieldSchema{
+						{FieldID: common.RowIDField, Name: "RowID"},
+						{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+						{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+					},
+				},
+			},
+		},
+	}
+
+	field, err := newAddedFieldSchema(fieldToAdd("age", schemapb.DataType_Int64, common.DefaultValueParam, "0"))
+	assert.NoError(t, err)
+	assert.Error(t, mt.AddField(2, field, 100))
+	assert.NoError(t, mt.AddField(1, field, 100))
+	assert.Equal(t, typeutil.UniqueID(102), field.GetFieldID())
+	assert.Equal(t, CollectionMetaPrefix+"/1", saved)
+
+	coll, err := mt.GetCollectionByID(1, 0)
+	assert.NoError(t, err)
+	assert.Len(t, coll.GetSchema().GetFields(), 4)
+	assert.Equal(t, "age", coll.GetSchema().GetFields()[3].GetName())
+
+	// duplicated name
+	field, err = newAddedFieldSchema(fieldToAdd("age", schemapb.DataType_Int64, common.DefaultValueParam, "0"))
+	assert.NoError(t, err)
+	assert.Error(t, mt.AddField(1, field, 101))
+
+	snapshot.save = func(key, value string, ts typeutil.Timestamp) error {
+		return errors.New("mock")
+	}
+	field, err = newAddedFieldSchema(fieldToAdd("score", schemapb.DataType_Double, common.DefaultValueParam, "0"))
+	assert.NoError(t, err)
+	assert.Error(t, mt.AddField(1, field, 102))
+	coll, err = mt.GetCollectionByID(1, 0)
+	assert.NoError(t, err)
+	assert.Len(t, coll.GetSchema().GetFields(), 4)
+}
+
+func TestCore_AddCollectionField(t *testing.T) {
+	mt := &MetaTable{
+		snapshot: &mockTestKV{
+			save: func(key, value string, ts typeutil.Timestamp) error {
+				return nil
+			},
+		},
+		collID2Meta: map[typeutil.UniqueID]pb.CollectionInfo{
+			1: {ID: 1, Schema: &schemapb.CollectionSchema{
+				Name: "coll",
+				Fields: []*schemapb.FieldSchema{
+					{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				},
+			}},
+		},
+		collName2ID:  map[string]map[string]typeutil.UniqueID{common.DefaultDatabase: {"coll": 1}},
+		collAlias2ID: map[string]map[string]typeutil.UniqueID{},
+	}
+	var refreshed []*schemapb.CollectionSchema
+	core := &Core{
+		MetaTable: mt,
+		TSOAllocator: func(count uint32) (typeutil.Timestamp, error) {
+			return 100, nil
+		},
+		session: &sessionutil.Session{ServerID: 1},
+		CallDataCoordRefreshCollection: func(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema) error {
+			refreshed = append(refreshed, schema)
+			return nil
+		},
+		CallQueryCoordRefreshCollection: func(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema) error {
+			return nil
+		},
+	}
+	core.proxyClientManager = newProxyClientManager(core)
+	ctx := context.Background()
+
+	req := &milvuspb.AddCollectionFieldRequest{
+		CollectionName: "coll",
+		Field:          fieldToAdd("age", schemapb.DataType_Int64, common.DefaultValueParam, "0"),
+	}
+	core.UpdateStateCode(internalpb.StateCode_Abnormal)
+	status, err := core.AddCollectionField(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+	core.UpdateStateCode(internalpb.StateCode_Healthy)
+	for _, invalid := range []*milvuspb.AddCollectionFieldRequest{
+		{CollectionName: "coll"},
+		{CollectionName: "none", Field: req.Field},
+		{CollectionName: "coll", Field: fieldToAdd("pk", schemapb.DataType_Int64, common.DefaultValueParam, "0")},
+	} {
+		status, err = core.AddCollectionField(ctx, invalid)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	}
+	assert.Empty(t, refreshed)
+
+	status, err = core.AddCollectionField(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Len(t, refreshed, 1)
+	assert.Len(t, refreshed[0].GetFields(), 2)
+	assert.Equal(t, int64(101), refreshed[0].GetFields()[1].GetFieldID())
+}
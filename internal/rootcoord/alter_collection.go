@@ -0,0 +1,95 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// The properties of a collection are persisted in the properties of its schema, so that they are carried by the
+// schema to the coordinators and the nodes. The coordinators get the altered schema by RefreshCollection, and
+// QueryCoord applies it to the loaded collection on the query nodes.
+
+// AlterCollection sets the properties to the collection in the database, the property with an empty value is removed
+func (mt *MetaTable) AlterCollection(database string, collName string, properties map[string]string, ts typeutil.Timestamp) (typeutil.UniqueID, error) {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	collID, ok := mt.getCollectionID(database, collName)
+	if !ok {
+		return 0, fmt.Errorf("can't find collection: %s", collName)
+	}
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return 0, fmt.Errorf("can't find collection %s with id %d", collName, collID)
+	}
+
+	updated := proto.Clone(&coll).(*pb.CollectionInfo)
+	if err := typeutil.SetCollectionProperties(updated.Schema, properties); err != nil {
+		return 0, err
+	}
+	k := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
+	v, err := proto.Marshal(updated)
+	if err != nil {
+		return 0, fmt.Errorf("metaTable AlterCollection Marshal fail, key:%s, err:%w", k, err)
+	}
+	if err := mt.snapshot.Save(k, string(v), ts); err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		return 0, err
+	}
+	mt.collID2Meta[collID] = *updated
+	return collID, nil
+}
+
+// alterCollection sets the properties to the collection, expires the meta cache of the proxies and sends the
+// altered schema to the coordinators
+func (c *Core) alterCollection(ctx context.Context, database string, collName string, kvs []*commonpb.KeyValuePair) error {
+	if collName == "" || len(kvs) == 0 {
+		return errors.New("both the collection name and the properties are required to alter a collection")
+	}
+	properties := make(map[string]string, len(kvs))
+	for _, kv := range kvs {
+		if err := typeutil.ValidateCollectionProperty(kv.GetKey(), kv.GetValue()); err != nil {
+			return err
+		}
+		properties[kv.GetKey()] = kv.GetValue()
+	}
+	ts, err := c.TSOAllocator(1)
+	if err != nil {
+		return err
+	}
+	collID, err := c.MetaTable.AlterCollection(database, collName, properties, ts)
+	if err != nil {
+		return err
+	}
+	log.Info("collection altered", zap.Int64("collectionID", collID), zap.String("collectionName", collName),
+		zap.Any("properties", properties))
+
+	c.ExpireMetaCache(ctx, database, append([]string{collName}, c.MetaTable.ListAliases(collID)...), ts)
+	c.refreshCollection(ctx, collID)
+	return nil
+}
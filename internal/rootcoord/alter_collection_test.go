@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestMetaTable_AlterCollection(t *testing.T) {
+	var saved string
+	snapshot := &mockTestKV{
+		save: func(key, value string, ts typeutil.Timestamp) error {
+			saved = key
+			return nil
+		},
+	}
+	mt := &MetaTable{
+		snapshot: snapshot,
+		collID2Meta: map[typeutil.UniqueID]pb.CollectionInfo{
+			1: {
+				ID: 1,
+				Schema: &schemapb.CollectionSchema{
+					Name: "coll",
+					Fields: []*schemapb.FieldSchema{
+						{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+						{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+					},
+				},
+			},
+		},
+		collName2ID: map[string]map[string]typeutil.UniqueID{common.DefaultDatabase: {"coll": 1}},
+	}
+
+	_, err := mt.AlterCollection("", "none", map[string]string{common.CollectionReplicaNumberParam: "2"}, 100)
+	assert.Error(t, err)
+	_, err = mt.AlterCollection("", "coll", map[string]string{common.CollectionReplicaNumberParam: "two"}, 100)
+	assert.Error(t, err)
+
+	collID, err := mt.AlterCollection("", "coll", map[string]string{
+		common.CollectionReplicaNumberParam: "2",
+		common.CollectionMmapEnabledParam:   "true",
+	}, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), collID)
+	assert.Equal(t, CollectionMetaPrefix+"/1", saved)
+	assert.Len(t, mt.collID2Meta[1].Schema.GetProperties(), 2)
+	replicaNumber, err := typeutil.GetCollectionReplicaNumber(mt.collID2Meta[1].Schema)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), replicaNumber)
+	// the fields are not changed
+	assert.Empty(t, mt.collID2Meta[1].Schema.GetFields()[0].GetTypeParams())
+
+	snapshot.save = func(key, value string, ts typeutil.Timestamp) error {
+		return errors.New("mock")
+	}
+	_, err = mt.AlterCollection("", "coll", map[string]string{common.CollectionMmapEnabledParam: ""}, 101)
+	assert.Error(t, err)
+	assert.Len(t, typeutil.GetCollectionProperties(mt.collID2Meta[1].Schema), 2)
+}
+
+func TestCore_AlterCollection(t *testing.T) {
+	mt := &MetaTable{
+		snapshot: &mockTestKV{
+			save: func(key, value string, ts typeutil.Timestamp) error {
+				return nil
+			},
+		},
+		collID2Meta: map[typeutil.UniqueID]pb.CollectionInfo{
+			1: {ID: 1, Schema: &schemapb.CollectionSchema{
+				Name: "coll",
+				Fields: []*schemapb.FieldSchema{
+					{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				},
+			}},
+		},
+		collName2ID:  map[string]map[string]typeutil.UniqueID{common.DefaultDatabase: {"coll": 1}},
+		collAlias2ID: map[string]map[string]typeutil.UniqueID{},
+	}
+	var refreshed []*schemapb.CollectionSchema
+	core := &Core{
+		MetaTable: mt,
+		TSOAllocator: func(count uint32) (typeutil.Timestamp, error) {
+			return 100, nil
+		},
+		session: &sessionutil.Session{ServerID: 1},
+		CallDataCoordRefreshCollection: func(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema) error {
+			return nil
+		},
+		CallQueryCoordRefreshCollection: func(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema) error {
+			refreshed = append(refreshed, schema)
+			return nil
+		},
+	}
+	core.proxyClientManager = newProxyClientManager(core)
+	ctx := context.Background()
+
+	req := &milvuspb.AlterCollectionRequest{
+		CollectionName: "coll",
+		Properties:     []*commonpb.KeyValuePair{{Key: common.CollectionLoadPriorityParam, Value: "1"}},
+	}
+	core.UpdateStateCode(internalpb.StateCode_Abnormal)
+	status, err := core.AlterCollection(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+	core.UpdateStateCode(internalpb.StateCode_Healthy)
+	for _, invalid := range []*milvuspb.AlterCollectionRequest{
+		{CollectionName: "coll"},
+		{CollectionName: "none", Properties: req.Properties},
+		{CollectionName: "coll", Properties: []*commonpb.KeyValuePair{{Key: common.BloomFilterFPRParam, Value: "0.1"}}},
+		{CollectionName: "coll", Properties: []*commonpb.KeyValuePair{{Key: common.CollectionLoadPriorityParam, Value: "high"}}},
+	} {
+		status, err = core.AlterCollection(ctx, invalid)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	}
+	assert.Empty(t, refreshed)
+
+	status, err = core.AlterCollection(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Len(t, refreshed, 1)
+	priority, set, err := typeutil.GetCollectionLoadPriority(refreshed[0])
+	assert.NoError(t, err)
+	assert.True(t, set)
+	assert.Equal(t, int64(1), priority)
+}
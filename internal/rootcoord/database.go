@@ -0,0 +1,191 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/rootcoord/model"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// The databases are stored under util.DatabasePrefix, and the proxies watch the prefix. The collections and the
+// aliases are named in the scope of their database, which is the db_name of the requests and is kept in the meta of
+// the collections. The collections without a database belong to the default one.
+
+func databaseKey(name string) string {
+	return fmt.Sprintf("%s/%s", util.DatabasePrefix, name)
+}
+
+func validateDatabaseName(name string) error {
+	if name == "" || name == common.DefaultDatabase {
+		return fmt.Errorf("invalid database name: %q", name)
+	}
+	for i, c := range name {
+		if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (i > 0 && c >= '0' && c <= '9') {
+			continue
+		}
+		return fmt.Errorf("invalid database name: %q, only numbers, letters and underscores are allowed, "+
+			"and the first character must not be a number", name)
+	}
+	return nil
+}
+
+func validateDatabaseQuotas(quotas *model.DatabaseQuotas) error {
+	if quotas.DMLRowsPerSec < 0 || quotas.SearchQPS < 0 || quotas.MaxQueryConcurrency < 0 || quotas.MaxCollections < 0 {
+		return fmt.Errorf("quotas of database should not be negative")
+	}
+	return nil
+}
+
+// setDatabaseQuotas sets the quotas in the properties of the request to the database
+func setDatabaseQuotas(quotas *model.DatabaseQuotas, properties []*commonpb.KeyValuePair) error {
+	for _, kv := range properties {
+		var err error
+		switch kv.GetKey() {
+		case common.DatabaseDMLRowsPerSecParam:
+			quotas.DMLRowsPerSec, err = strconv.ParseFloat(kv.GetValue(), 64)
+		case common.DatabaseSearchQPSParam:
+			quotas.SearchQPS, err = strconv.ParseFloat(kv.GetValue(), 64)
+		case common.DatabaseMaxQueryConcurrencyParam:
+			quotas.MaxQueryConcurrency, err = strconv.ParseInt(kv.GetValue(), 10, 64)
+		case common.DatabaseMaxCollectionsParam:
+			quotas.MaxCollections, err = strconv.ParseInt(kv.GetValue(), 10, 64)
+		default:
+			return fmt.Errorf("unknown property of database: %s", kv.GetKey())
+		}
+		if err != nil {
+			return fmt.Errorf("invalid %s of database: %s", kv.GetKey(), kv.GetValue())
+		}
+	}
+	return validateDatabaseQuotas(quotas)
+}
+
+// loadDatabase returns the database stored, the caller holds ddLock
+func (mt *MetaTable) loadDatabase(name string) (*model.Database, error) {
+	v, err := mt.txn.Load(databaseKey(name))
+	if err != nil {
+		return nil, fmt.Errorf("database %s not found", name)
+	}
+	db := &model.Database{}
+	if err := json.Unmarshal([]byte(v), db); err != nil {
+		return nil, fmt.Errorf("metaTable unmarshal database fail database:%s, err:%w", name, err)
+	}
+	return db, nil
+}
+
+func (mt *MetaTable) saveDatabase(db *model.Database) error {
+	v, err := json.Marshal(db)
+	if err != nil {
+		return fmt.Errorf("metaTable marshal database fail database:%s, err:%w", db.Name, err)
+	}
+	return mt.txn.Save(databaseKey(db.Name), string(v))
+}
+
+// checkDatabase checks the database to create a collection in exists and has room for it, the caller holds ddLock
+func (mt *MetaTable) checkDatabase(database string) error {
+	database = funcutil.DatabaseName(database)
+	if database == common.DefaultDatabase {
+		return nil
+	}
+	db, err := mt.loadDatabase(database)
+	if err != nil {
+		return err
+	}
+	if num := int64(len(mt.collName2ID[database])); db.Quotas.MaxCollections > 0 && num >= db.Quotas.MaxCollections {
+		return fmt.Errorf("the number of collections in database %s reaches the limit %d", database, db.Quotas.MaxCollections)
+	}
+	return nil
+}
+
+// CreateDatabase saves the database with the quotas in the properties, fails if it exists
+func (mt *MetaTable) CreateDatabase(name string, properties []*commonpb.KeyValuePair) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if err := validateDatabaseName(name); err != nil {
+		return err
+	}
+	db := &model.Database{Name: name}
+	if err := setDatabaseQuotas(&db.Quotas, properties); err != nil {
+		return err
+	}
+	if _, err := mt.txn.Load(databaseKey(name)); err == nil {
+		return fmt.Errorf("database %s exists", name)
+	}
+	return mt.saveDatabase(db)
+}
+
+// AlterDatabase sets the quotas in the properties to the database, the other quotas are unchanged
+func (mt *MetaTable) AlterDatabase(name string, properties []*commonpb.KeyValuePair) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	db, err := mt.loadDatabase(name)
+	if err != nil {
+		return err
+	}
+	if err := setDatabaseQuotas(&db.Quotas, properties); err != nil {
+		return err
+	}
+	return mt.saveDatabase(db)
+}
+
+// DropDatabase removes the database, fails if there are collections in it
+func (mt *MetaTable) DropDatabase(name string) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if _, err := mt.loadDatabase(name); err != nil {
+		return err
+	}
+	if num := len(mt.collName2ID[name]); num > 0 {
+		return fmt.Errorf("database %s has %d collections, drop them first", name, num)
+	}
+	return mt.txn.Remove(databaseKey(name))
+}
+
+// ListDatabases returns the default database and all the databases created sorted by name
+func (mt *MetaTable) ListDatabases() ([]model.Database, error) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	_, values, err := mt.txn.LoadWithPrefix(util.DatabasePrefix)
+	if err != nil {
+		return nil, err
+	}
+	dbs := make([]model.Database, 0, len(values))
+	for _, value := range values {
+		db := model.Database{}
+		if err := json.Unmarshal([]byte(value), &db); err != nil {
+			log.Warn("invalid database, ignored", zap.String("value", value), zap.Error(err))
+			continue
+		}
+		dbs = append(dbs, db)
+	}
+	sort.Slice(dbs, func(i, j int) bool { return dbs[i].Name < dbs[j].Name })
+	return append([]model.Database{{Name: common.DefaultDatabase}}, dbs...), nil
+}
@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/rootcoord/model"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestMetaTable_Database(t *testing.T) {
+	mt := &MetaTable{
+		txn:         memkv.NewMemoryKV(),
+		collName2ID: map[string]map[string]typeutil.UniqueID{common.DefaultDatabase: {"coll": 1}},
+	}
+	property := func(key string, value string) []*commonpb.KeyValuePair {
+		return []*commonpb.KeyValuePair{{Key: key, Value: value}}
+	}
+
+	// invalid databases
+	assert.NotNil(t, mt.CreateDatabase("", nil))
+	assert.NotNil(t, mt.CreateDatabase(common.DefaultDatabase, nil))
+	assert.NotNil(t, mt.CreateDatabase("db.1", nil))
+	assert.NotNil(t, mt.CreateDatabase("1db", nil))
+	assert.NotNil(t, mt.CreateDatabase("db1", property(common.DatabaseSearchQPSParam, "-1")))
+	assert.NotNil(t, mt.CreateDatabase("db1", property(common.DatabaseSearchQPSParam, "abc")))
+	assert.NotNil(t, mt.CreateDatabase("db1", property("unknown", "1")))
+
+	assert.Nil(t, mt.CreateDatabase("db1", property(common.DatabaseMaxCollectionsParam, "1")))
+	assert.NotNil(t, mt.CreateDatabase("db1", nil))
+	db1 := model.Database{Name: "db1", Quotas: model.DatabaseQuotas{MaxCollections: 1}}
+	dbs, err := mt.ListDatabases()
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Database{{Name: common.DefaultDatabase}, db1}, dbs)
+
+	// the collections of the default database are not limited
+	assert.Nil(t, mt.checkDatabase(""))
+	assert.Nil(t, mt.checkDatabase(common.DefaultDatabase))
+	assert.NotNil(t, mt.checkDatabase("db2"))
+	assert.Nil(t, mt.checkDatabase("db1"))
+	putNameID(mt.collName2ID, "db1", "coll", 2)
+	assert.NotNil(t, mt.checkDatabase("db1"))
+
+	// the quotas not in the properties are unchanged
+	assert.NotNil(t, mt.AlterDatabase("db2", nil))
+	assert.NotNil(t, mt.AlterDatabase("db1", property(common.DatabaseMaxCollectionsParam, "-1")))
+	assert.Nil(t, mt.AlterDatabase("db1", property(common.DatabaseSearchQPSParam, "10")))
+	assert.NotNil(t, mt.checkDatabase("db1"))
+	assert.Nil(t, mt.AlterDatabase("db1", property(common.DatabaseMaxCollectionsParam, "2")))
+	assert.Nil(t, mt.checkDatabase("db1"))
+	db1.Quotas = model.DatabaseQuotas{SearchQPS: 10, MaxCollections: 2}
+	dbs, err = mt.ListDatabases()
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Database{{Name: common.DefaultDatabase}, db1}, dbs)
+
+	assert.NotNil(t, mt.DropDatabase("db2"))
+	assert.NotNil(t, mt.DropDatabase("db1"))
+	deleteNameID(mt.collName2ID, "db1", "coll")
+	assert.Nil(t, mt.DropDatabase("db1"))
+	dbs, err = mt.ListDatabases()
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Database{{Name: common.DefaultDatabase}}, dbs)
+}
+
+func TestCore_Database(t *testing.T) {
+	ctx := context.Background()
+	core := &Core{MetaTable: &MetaTable{
+		txn:         memkv.NewMemoryKV(),
+		collName2ID: map[string]map[string]typeutil.UniqueID{},
+	}}
+	core.UpdateStateCode(internalpb.StateCode_Healthy)
+
+	status, err := core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+		DbName:     "db1",
+		Properties: []*commonpb.KeyValuePair{{Key: common.DatabaseMaxCollectionsParam, Value: "1"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	status, err = core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{DbName: "db1"})
+	assert.Nil(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	status, err = core.AlterDatabase(ctx, &milvuspb.AlterDatabaseRequest{
+		DbName:     "db1",
+		Properties: []*commonpb.KeyValuePair{{Key: common.DatabaseSearchQPSParam, Value: "10"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	resp, err := core.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, []string{common.DefaultDatabase, "db1"}, resp.GetDbNames())
+
+	status, err = core.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{DbName: "db1"})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	status, err = core.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{DbName: "db1"})
+	assert.Nil(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	// unhealthy
+	core.UpdateStateCode(internalpb.StateCode_Abnormal)
+	status, err = core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{DbName: "db2"})
+	assert.Nil(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	resp, err = core.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{})
+	assert.Nil(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+}
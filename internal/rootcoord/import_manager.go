@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -32,6 +33,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
@@ -39,10 +42,14 @@ import (
 const (
 	Bucket               = "bucket"
 	FailedReason         = "failed_reason"
+	FailedFile           = importutil.FailedFileKey
 	Files                = "files"
+	FilesParsed          = importutil.FilesParsedKey
+	SegmentsIndexed      = "segments_indexed"
 	MaxPendingCount      = 32
 	delimiter            = "/"
 	taskExpiredMsgPrefix = "task has expired after "
+	taskCanceledMsg      = "task has been canceled"
 )
 
 // CheckPendingTasksInterval is the default interval to check and send out pending tasks,
@@ -62,6 +69,14 @@ type importTaskState struct {
 	failedReason string               // failed reason
 }
 
+// importTaskProgress is the progress of a working task. It's kept in memory only, since the reports of datanode are
+// cumulative, the progress is restored by the next report after RootCoord restarts.
+type importTaskProgress struct {
+	filesParsed     int    // how many files are parsed by datanode
+	failedFile      string // the file failed to import
+	segmentsIndexed int    // how many persisted segments are indexed
+}
+
 // importManager manager for import tasks
 type importManager struct {
 	ctx       context.Context // reserved
@@ -71,6 +86,7 @@ type importManager struct {
 	// TODO: Make pendingTask a map to improve look up performance.
 	pendingTasks  []*datapb.ImportTaskInfo         // pending tasks
 	workingTasks  map[int64]*datapb.ImportTaskInfo // in-progress tasks
+	progress      map[int64]*importTaskProgress    // progress of in-progress tasks, guarded by workingLock
 	pendingLock   sync.RWMutex                     // lock pending task list
 	workingLock   sync.RWMutex                     // lock working task map
 	busyNodesLock sync.RWMutex                     // lock for working nodes.
@@ -91,6 +107,7 @@ func newImportManager(ctx context.Context, client kv.MetaKv,
 		taskStore:         client,
 		pendingTasks:      make([]*datapb.ImportTaskInfo, 0, MaxPendingCount), // currently task queue max size is 32
 		workingTasks:      make(map[int64]*datapb.ImportTaskInfo),
+		progress:          make(map[int64]*importTaskProgress),
 		busyNodes:         make(map[int64]bool),
 		pendingLock:       sync.RWMutex{},
 		workingLock:       sync.RWMutex{},
@@ -405,20 +422,31 @@ func (m *importManager) updateTaskState(ir *rootcoordpb.ImportResult) (*datapb.I
 	defer m.workingLock.Unlock()
 	ok := false
 	if v, ok = m.workingTasks[ir.GetTaskId()]; ok {
-		// If the task has already been marked failed. Prevent further state updating and return an error.
-		if v.GetState().GetStateCode() == commonpb.ImportState_ImportFailed {
-			log.Warn("trying to update an already failed task which will end up being a no-op")
-			return nil, errors.New("trying to update an already failed task " + strconv.FormatInt(ir.GetTaskId(), 10))
+		// If the task has already been marked failed or completed, e.g. canceled, prevent further state updating and
+		// return an error, so that datanode aborts the task.
+		if isImportTaskFinished(v) {
+			log.Warn("trying to update an already finished task which will end up being a no-op",
+				zap.Int64("task ID", ir.GetTaskId()),
+				zap.String("state", v.GetState().GetStateCode().String()))
+			return nil, fmt.Errorf("trying to update an already finished task %d, state: %s, reason: %s",
+				ir.GetTaskId(), v.GetState().GetStateCode().String(), v.GetState().GetErrorMessage())
 		}
 		found = true
 		v.State.StateCode = ir.GetState()
 		v.State.Segments = ir.GetSegments()
 		v.State.RowCount = ir.GetRowCount()
 		v.State.RowIds = ir.AutoIds
+		progress := m.getProgress(v.GetId())
 		for _, kv := range ir.GetInfos() {
-			if kv.GetKey() == FailedReason {
+			switch kv.GetKey() {
+			case FailedReason:
 				v.State.ErrorMessage = kv.GetValue()
-				break
+			case FailedFile:
+				progress.failedFile = kv.GetValue()
+			case FilesParsed:
+				if n, err := strconv.Atoi(kv.GetValue()); err == nil {
+					progress.filesParsed = n
+				}
 			}
 		}
 		// Update task in task store.
@@ -432,6 +460,132 @@ func (m *importManager) updateTaskState(ir *rootcoordpb.ImportResult) (*datapb.I
 	return v, nil
 }
 
+// setTaskIndexedSegments sets the number of task's segments that are indexed.
+func (m *importManager) setTaskIndexedSegments(taskID int64, count int) {
+	m.workingLock.Lock()
+	defer m.workingLock.Unlock()
+	if _, ok := m.workingTasks[taskID]; ok {
+		m.getProgress(taskID).segmentsIndexed = count
+	}
+}
+
+// getProgress returns the progress of a working task, it should be called with workingLock held.
+func (m *importManager) getProgress(taskID int64) *importTaskProgress {
+	progress, ok := m.progress[taskID]
+	if !ok {
+		progress = &importTaskProgress{}
+		m.progress[taskID] = progress
+	}
+	return progress
+}
+
+// progressInfos returns the progress of a working task as the infos of GetImportStateResponse, it should be called
+// with workingLock held.
+func (m *importManager) progressInfos(taskID int64) []*commonpb.KeyValuePair {
+	progress := m.getProgress(taskID)
+	infos := []*commonpb.KeyValuePair{
+		{Key: FilesParsed, Value: strconv.Itoa(progress.filesParsed)},
+		{Key: SegmentsIndexed, Value: strconv.Itoa(progress.segmentsIndexed)},
+	}
+	if progress.failedFile != "" {
+		infos = append(infos, &commonpb.KeyValuePair{Key: FailedFile, Value: progress.failedFile})
+	}
+	return infos
+}
+
+// cancelTask cancels a pending or working task by marking it failed. A pending task is never sent out, and a working
+// task is aborted by datanode once its next report is rejected. The tasks persisted can't be canceled.
+func (m *importManager) cancelTask(tID int64) error {
+	m.pendingLock.Lock()
+	defer m.pendingLock.Unlock()
+	m.busyNodesLock.Lock()
+	defer m.busyNodesLock.Unlock()
+	m.workingLock.Lock()
+	defer m.workingLock.Unlock()
+
+	for i, t := range m.pendingTasks {
+		if t.GetId() == tID {
+			m.pendingTasks = append(m.pendingTasks[:i], m.pendingTasks[i+1:]...)
+			// kept as a working task to be listed until it expires, the same as it's reloaded from task store
+			m.workingTasks[tID] = t
+			m.markTaskCanceled(t)
+			return nil
+		}
+	}
+
+	t, ok := m.workingTasks[tID]
+	if !ok {
+		return errors.New("import task id doesn't exist")
+	}
+	if isImportTaskFinished(t) || t.GetState().GetStateCode() == commonpb.ImportState_ImportPersisted {
+		return fmt.Errorf("import task %d can't be canceled, state: %s", tID, t.GetState().GetStateCode().String())
+	}
+	m.markTaskCanceled(t)
+	// the dataNode takes new tasks without waiting for the abort
+	delete(m.busyNodes, t.GetDatanodeId())
+	return nil
+}
+
+func (m *importManager) markTaskCanceled(t *datapb.ImportTaskInfo) {
+	log.Info("import task canceled", zap.Int64("task ID", t.GetId()), zap.Int64("dataNode ID", t.GetDatanodeId()))
+	t.State.StateCode = commonpb.ImportState_ImportFailed
+	t.State.ErrorMessage = taskCanceledMsg
+	m.updateImportTaskStore(t)
+}
+
+// listTaskProgress returns the progress of the task with the given ID, or of all the tasks if the ID is 0.
+func (m *importManager) listTaskProgress(tID int64) ([]*metricsinfo.ImportTaskProgress, error) {
+	tasks := make([]*metricsinfo.ImportTaskProgress, 0)
+
+	func() {
+		m.pendingLock.Lock()
+		defer m.pendingLock.Unlock()
+		for _, t := range m.pendingTasks {
+			if tID == 0 || tID == t.GetId() {
+				tasks = append(tasks, newImportTaskProgress(t, &importTaskProgress{}))
+			}
+		}
+	}()
+
+	func() {
+		m.workingLock.Lock()
+		defer m.workingLock.Unlock()
+		for _, v := range m.workingTasks {
+			if tID == 0 || tID == v.GetId() {
+				tasks = append(tasks, newImportTaskProgress(v, m.getProgress(v.GetId())))
+			}
+		}
+	}()
+
+	if tID != 0 && len(tasks) == 0 {
+		return nil, errors.New("import task id doesn't exist")
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].TaskID < tasks[j].TaskID })
+	return tasks, nil
+}
+
+func newImportTaskProgress(t *datapb.ImportTaskInfo, progress *importTaskProgress) *metricsinfo.ImportTaskProgress {
+	ret := &metricsinfo.ImportTaskProgress{
+		TaskID:          t.GetId(),
+		RequestID:       t.GetRequestId(),
+		CollectionID:    t.GetCollectionId(),
+		PartitionID:     t.GetPartitionId(),
+		DataNodeID:      t.GetDatanodeId(),
+		State:           t.GetState().GetStateCode().String(),
+		Files:           t.GetFiles(),
+		FilesParsed:     progress.filesParsed,
+		RowsPersisted:   t.GetState().GetRowCount(),
+		Segments:        len(t.GetState().GetSegments()),
+		SegmentsIndexed: progress.segmentsIndexed,
+		FailedReason:    t.GetState().GetErrorMessage(),
+		Canceled:        t.GetState().GetErrorMessage() == taskCanceledMsg,
+	}
+	if progress.failedFile != "" {
+		ret.FileErrors = map[string]string{progress.failedFile: t.GetState().GetErrorMessage()}
+	}
+	return ret
+}
+
 // getTaskState looks for task with the given ID and returns its import state.
 func (m *importManager) getTaskState(tID int64) *milvuspb.GetImportStateResponse {
 	resp := &milvuspb.GetImportStateResponse{
@@ -483,6 +637,7 @@ func (m *importManager) getTaskState(tID int64) *milvuspb.GetImportStateResponse
 				Key:   FailedReason,
 				Value: v.GetState().GetErrorMessage(),
 			})
+			resp.Infos = append(resp.Infos, m.progressInfos(tID)...)
 			resp.HeuristicDataQueryable = v.GetHeuristicDataQueryable()
 			resp.HeuristicDataIndexed = v.GetHeuristicDataIndexed()
 		}
@@ -642,6 +797,7 @@ func (m *importManager) listAllTasks() []*milvuspb.GetImportStateResponse {
 				Key:   FailedReason,
 				Value: v.GetState().GetErrorMessage(),
 			})
+			resp.Infos = append(resp.Infos, m.progressInfos(v.GetId())...)
 			tasks = append(tasks, resp)
 		}
 		log.Info("tasks in working list", zap.Int("count", len(m.workingTasks)))
@@ -655,6 +811,12 @@ func BuildImportTaskKey(taskID int64) string {
 	return fmt.Sprintf("%s%s%d", Params.RootCoordCfg.ImportTaskSubPath, delimiter, taskID)
 }
 
+// isImportTaskFinished returns true if the task has already failed or completed.
+func isImportTaskFinished(ti *datapb.ImportTaskInfo) bool {
+	return ti.GetState().GetStateCode() == commonpb.ImportState_ImportFailed ||
+		ti.GetState().GetStateCode() == commonpb.ImportState_ImportCompleted
+}
+
 // taskExpired returns true if the task has already expired.
 func taskExpired(ti *datapb.ImportTaskInfo) bool {
 	return Params.RootCoordCfg.ImportTaskExpiration <= float64(time.Now().Unix()-ti.GetCreateTs())
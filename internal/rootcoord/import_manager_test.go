@@ -505,3 +505,125 @@ func TestImportManager_ListAllTasks(t *testing.T) {
 	}
 	assert.Equal(t, 0, len(ids))
 }
+
+func TestImportManager_CancelTask(t *testing.T) {
+	var countLock sync.RWMutex
+	var globalCount = typeutil.UniqueID(0)
+
+	var idAlloc = func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		countLock.Lock()
+		defer countLock.Unlock()
+		globalCount++
+		return globalCount, 0, nil
+	}
+	Params.RootCoordCfg.ImportTaskSubPath = "test_import_task"
+	colID := int64(100)
+	mockKv := &kv.MockMetaKV{}
+	mockKv.InMemKv = make(map[string]string)
+
+	// only the first task is sent out, the others are left pending
+	sent := 0
+	fn := func(ctx context.Context, req *datapb.ImportTaskRequest) *datapb.ImportTaskResponse {
+		if sent > 0 {
+			return &datapb.ImportTaskResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				},
+			}
+		}
+		sent++
+		return &datapb.ImportTaskResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			DatanodeId: 5,
+		}
+	}
+
+	rowReq := &milvuspb.ImportRequest{
+		CollectionName: "c1",
+		PartitionName:  "p1",
+		RowBased:       true,
+		Files:          []string{"f1", "f2", "f3"},
+	}
+
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, fn)
+	mgr.importJob(context.TODO(), rowReq, colID, 0)
+	assert.Equal(t, 2, len(mgr.pendingTasks))
+	assert.Equal(t, 1, len(mgr.workingTasks))
+
+	_, err := mgr.updateTaskState(&rootcoordpb.ImportResult{
+		TaskId: 1,
+		State:  commonpb.ImportState_ImportParsed,
+		Infos:  []*commonpb.KeyValuePair{{Key: FilesParsed, Value: "1"}},
+	})
+	assert.NoError(t, err)
+	mgr.setTaskIndexedSegments(1, 2)
+	resp := mgr.getTaskState(1)
+	assert.Contains(t, resp.Infos, &commonpb.KeyValuePair{Key: FilesParsed, Value: "1"})
+	assert.Contains(t, resp.Infos, &commonpb.KeyValuePair{Key: SegmentsIndexed, Value: "2"})
+
+	// cancel working task
+	assert.NoError(t, mgr.cancelTask(1))
+	assert.NotContains(t, mgr.busyNodes, int64(5))
+	_, err = mgr.updateTaskState(&rootcoordpb.ImportResult{
+		TaskId: 1,
+		State:  commonpb.ImportState_ImportParsed,
+	})
+	assert.Error(t, err)
+	assert.Error(t, mgr.cancelTask(1))
+
+	// cancel pending task
+	assert.NoError(t, mgr.cancelTask(2))
+	assert.Equal(t, 1, len(mgr.pendingTasks))
+	assert.Equal(t, 2, len(mgr.workingTasks))
+	assert.Equal(t, commonpb.ImportState_ImportFailed, mgr.getTaskState(2).State)
+
+	assert.Error(t, mgr.cancelTask(10000))
+
+	tasks, err := mgr.listTaskProgress(0)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(tasks))
+	tasks, err = mgr.listTaskProgress(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(tasks))
+	assert.True(t, tasks[0].Canceled)
+	assert.Equal(t, 1, tasks[0].FilesParsed)
+	assert.Equal(t, 2, tasks[0].SegmentsIndexed)
+	assert.Equal(t, commonpb.ImportState_ImportFailed.String(), tasks[0].State)
+	_, err = mgr.listTaskProgress(10000)
+	assert.Error(t, err)
+}
+
+func TestImportManager_FailedFile(t *testing.T) {
+	Params.RootCoordCfg.ImportTaskSubPath = "test_import_task"
+	mockKv := &kv.MockMetaKV{}
+	mockKv.InMemKv = make(map[string]string)
+	mgr := newImportManager(context.TODO(), mockKv, nil, nil)
+	mgr.workingTasks[1] = &datapb.ImportTaskInfo{
+		Id:    1,
+		Files: []string{"f1", "f2"},
+		State: &datapb.ImportTaskState{StateCode: commonpb.ImportState_ImportStarted},
+	}
+
+	_, err := mgr.updateTaskState(&rootcoordpb.ImportResult{
+		TaskId: 1,
+		State:  commonpb.ImportState_ImportFailed,
+		Infos: []*commonpb.KeyValuePair{
+			{Key: FilesParsed, Value: "1"},
+			{Key: FailedFile, Value: "f2"},
+			{Key: FailedReason, Value: "invalid row"},
+		},
+	})
+	assert.NoError(t, err)
+
+	resp := mgr.getTaskState(1)
+	assert.Contains(t, resp.Infos, &commonpb.KeyValuePair{Key: FailedFile, Value: "f2"})
+	tasks, err := mgr.listTaskProgress(1)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"f2": "invalid row"}, tasks[0].FileErrors)
+	assert.False(t, tasks[0].Canceled)
+
+	// failed task can't be canceled
+	assert.Error(t, mgr.cancelTask(1))
+}
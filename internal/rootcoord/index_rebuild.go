@@ -0,0 +1,454 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// An index is rebuilt with new params as a new index of the same name on the field, which is built on the segments
+// as usual while the old one keeps serving. Once the new index is built on a segment, the segment is swapped to it:
+// DescribeSegments reports the new index of the segment instead of the old one, and the segment is handed off to
+// querycoord again, which reloads it with the new index on the querynodes holding it. The old index is dropped after
+// all the segments are swapped.
+
+const (
+	// handoffSegmentPrefix is the prefix of the segments to be handed off, watched by querycoord
+	handoffSegmentPrefix = "querycoord-handoff"
+
+	// indexRebuildCheckInterval is the interval to swap the segments of the rebuilt indexes
+	indexRebuildCheckInterval = 30 * time.Second
+)
+
+// indexRebuild is the rebuild of an index, persisted as JSON
+type indexRebuild struct {
+	CollectionID      typeutil.UniqueID   `json:"collection_id"`
+	FieldID           typeutil.UniqueID   `json:"field_id"`
+	IndexName         string              `json:"index_name"`
+	OldIndexID        typeutil.UniqueID   `json:"old_index_id"`
+	NewIndexID        typeutil.UniqueID   `json:"new_index_id"`
+	SwappedSegmentIDs []typeutil.UniqueID `json:"swapped_segment_ids"`
+}
+
+func (r *indexRebuild) key() string {
+	return fmt.Sprintf("%s/%d/%d", IndexRebuildPrefix, r.CollectionID, r.NewIndexID)
+}
+
+func (r *indexRebuild) isSwapped(segID typeutil.UniqueID) bool {
+	for _, id := range r.SwappedSegmentIDs {
+		if id == segID {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *indexRebuild) clone() *indexRebuild {
+	cloned := *r
+	cloned.SwappedSegmentIDs = append([]typeutil.UniqueID(nil), r.SwappedSegmentIDs...)
+	return &cloned
+}
+
+// splitIndexRebuildParam returns the index params without the rebuild param, and whether the index is rebuilt
+func splitIndexRebuildParam(extraParams []*commonpb.KeyValuePair) ([]*commonpb.KeyValuePair, bool, error) {
+	indexParams := make([]*commonpb.KeyValuePair, 0, len(extraParams))
+	rebuild := false
+	for _, kv := range extraParams {
+		if kv.GetKey() != common.IndexRebuildParam {
+			indexParams = append(indexParams, kv)
+			continue
+		}
+		var err error
+		if rebuild, err = strconv.ParseBool(kv.GetValue()); err != nil {
+			return nil, false, fmt.Errorf("invalid %s: %s", common.IndexRebuildParam, kv.GetValue())
+		}
+	}
+	return indexParams, rebuild, nil
+}
+
+// buildHandoffSegmentPath returns the key of the segment to be handed off
+func buildHandoffSegmentPath(collectionID, partitionID, segmentID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d/%d/%d", handoffSegmentPrefix, collectionID, partitionID, segmentID)
+}
+
+// unlockGetServingSegmentIndexes returns the indexes of the segment without the one not serving it during rebuilds,
+// which is the new index before the segment is swapped, and the old one after.
+func (mt *MetaTable) unlockGetServingSegmentIndexes(segID typeutil.UniqueID, segIdxMap map[typeutil.UniqueID]pb.SegmentIndexInfo) map[typeutil.UniqueID]pb.SegmentIndexInfo {
+	hidden := make(map[typeutil.UniqueID]struct{})
+	for _, rebuild := range mt.indexRebuilds {
+		if rebuild.isSwapped(segID) {
+			hidden[rebuild.OldIndexID] = struct{}{}
+		} else {
+			hidden[rebuild.NewIndexID] = struct{}{}
+		}
+	}
+	if len(hidden) == 0 {
+		return segIdxMap
+	}
+	ret := make(map[typeutil.UniqueID]pb.SegmentIndexInfo, len(segIdxMap))
+	for indexID, info := range segIdxMap {
+		if _, ok := hidden[indexID]; !ok {
+			ret[indexID] = info
+		}
+	}
+	return ret
+}
+
+// AddRebuildIndex adds the index taking the place of the existing index of the same name on the field, and returns
+// the segment ids which have no index of the new params
+func (mt *MetaTable) AddRebuildIndex(database string, collName string, fieldName string, idxInfo *pb.IndexInfo, segIDs []typeutil.UniqueID) ([]typeutil.UniqueID, schemapb.FieldSchema, error) {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	collMeta, err := mt.unlockGetCollectionInfo(database, collName)
+	if err != nil {
+		return nil, schemapb.FieldSchema{}, err
+	}
+	fieldSchema, err := mt.unlockGetFieldSchema(database, collName, fieldName)
+	if err != nil {
+		return nil, schemapb.FieldSchema{}, err
+	}
+
+	if !typeutil.IsVectorType(fieldSchema.GetDataType()) {
+		indexType := getScalarIndexType(fieldSchema.GetDataType(), idxInfo.GetIndexParams())
+		idxInfo.IndexParams = []*commonpb.KeyValuePair{{Key: "index_type", Value: indexType}}
+	}
+	if idxInfo.IndexParams == nil {
+		return nil, schemapb.FieldSchema{}, fmt.Errorf("index param is nil")
+	}
+
+	var oldIdxInfo *pb.IndexInfo
+	for _, f := range collMeta.FieldIndexes {
+		if f.GetFiledID() != fieldSchema.GetFieldID() {
+			continue
+		}
+		info, ok := mt.indexID2Meta[f.GetIndexID()]
+		if !ok || info.GetIndexName() != idxInfo.GetIndexName() {
+			continue
+		}
+		if oldIdxInfo != nil {
+			return nil, schemapb.FieldSchema{}, fmt.Errorf("index %s of field %s is being rebuilt", idxInfo.GetIndexName(), fieldName)
+		}
+		oldIdxInfo = &info
+	}
+	if oldIdxInfo == nil {
+		return nil, schemapb.FieldSchema{}, fmt.Errorf("index %s doesn't exist on field %s, nothing to rebuild", idxInfo.GetIndexName(), fieldName)
+	}
+	if EqualKeyPairArray(oldIdxInfo.GetIndexParams(), idxInfo.GetIndexParams()) {
+		return nil, schemapb.FieldSchema{}, fmt.Errorf("index %s of field %s has the same params already", idxInfo.GetIndexName(), fieldName)
+	}
+
+	rebuild := &indexRebuild{
+		CollectionID: collMeta.ID,
+		FieldID:      fieldSchema.FieldID,
+		IndexName:    idxInfo.GetIndexName(),
+		OldIndexID:   oldIdxInfo.GetIndexID(),
+		NewIndexID:   idxInfo.GetIndexID(),
+	}
+	collMeta.FieldIndexes = append(collMeta.FieldIndexes, &pb.FieldIndexInfo{
+		FiledID: fieldSchema.FieldID,
+		IndexID: idxInfo.IndexID,
+	})
+	k1 := path.Join(CollectionMetaPrefix, strconv.FormatInt(collMeta.ID, 10))
+	v1, err := proto.Marshal(&collMeta)
+	if err != nil {
+		return nil, schemapb.FieldSchema{}, fmt.Errorf("metaTable AddRebuildIndex Marshal collMeta fail key:%s, err:%w", k1, err)
+	}
+	k2 := fmt.Sprintf("%s/%d/%d", IndexMetaPrefix, collMeta.ID, idxInfo.IndexID)
+	v2, err := proto.Marshal(idxInfo)
+	if err != nil {
+		return nil, schemapb.FieldSchema{}, fmt.Errorf("metaTable AddRebuildIndex Marshal idxInfo fail key:%s, err:%w", k2, err)
+	}
+	v3, err := json.Marshal(rebuild)
+	if err != nil {
+		return nil, schemapb.FieldSchema{}, fmt.Errorf("metaTable AddRebuildIndex Marshal rebuild fail key:%s, err:%w", rebuild.key(), err)
+	}
+
+	err = mt.txn.MultiSave(map[string]string{k1: string(v1), k2: string(v2), rebuild.key(): string(v3)})
+	if err != nil {
+		log.Error("TxnKV MultiSave fail", zap.Error(err))
+		panic("TxnKV MultiSave fail")
+	}
+	mt.collID2Meta[collMeta.ID] = collMeta
+	mt.indexID2Meta[idxInfo.IndexID] = *idxInfo
+	mt.indexRebuilds[rebuild.NewIndexID] = rebuild
+
+	rstID := make([]typeutil.UniqueID, 0, len(segIDs))
+	for _, segID := range segIDs {
+		if exist := mt.unlockIsSegmentIndexed(segID, &fieldSchema, idxInfo.IndexParams); !exist {
+			rstID = append(rstID, segID)
+		}
+	}
+	return rstID, fieldSchema, nil
+}
+
+// listIndexRebuilds returns the copies of the index rebuilds in progress
+func (mt *MetaTable) listIndexRebuilds() []*indexRebuild {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	rebuilds := make([]*indexRebuild, 0, len(mt.indexRebuilds))
+	for _, rebuild := range mt.indexRebuilds {
+		rebuilds = append(rebuilds, rebuild.clone())
+	}
+	return rebuilds
+}
+
+// getSegmentIndexInfo returns the info of the index built on the segment
+func (mt *MetaTable) getSegmentIndexInfo(segID typeutil.UniqueID, indexID typeutil.UniqueID) (pb.SegmentIndexInfo, bool) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	info, ok := mt.segID2IndexMeta[segID][indexID]
+	return info, ok
+}
+
+// SwapSegmentIndex swaps the segments to the index rebuilt, the segments are handed off together
+func (mt *MetaTable) SwapSegmentIndex(newIndexID typeutil.UniqueID, segIDs []typeutil.UniqueID, handoffs map[string]string) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	rebuild, ok := mt.indexRebuilds[newIndexID]
+	if !ok {
+		return fmt.Errorf("index %d is not being rebuilt", newIndexID)
+	}
+	swapped := rebuild.clone()
+	for _, segID := range segIDs {
+		if !swapped.isSwapped(segID) {
+			swapped.SwappedSegmentIDs = append(swapped.SwappedSegmentIDs, segID)
+		}
+	}
+	v, err := json.Marshal(swapped)
+	if err != nil {
+		return fmt.Errorf("metaTable SwapSegmentIndex Marshal rebuild fail key:%s, err:%w", swapped.key(), err)
+	}
+	saves := make(map[string]string, len(handoffs)+1)
+	for k, v := range handoffs {
+		saves[k] = v
+	}
+	saves[swapped.key()] = string(v)
+	if err := mt.txn.MultiSave(saves); err != nil {
+		return err
+	}
+	mt.indexRebuilds[newIndexID] = swapped
+	return nil
+}
+
+// hasPendingHandoffs returns whether any segment swapped to the rebuilt index is not taken by querycoord yet,
+// querycoord removes the handoff key once the handoff task is persisted, which reloads the segment with the new index
+func (mt *MetaTable) hasPendingHandoffs(rebuild *indexRebuild) (bool, error) {
+	keys, _, err := mt.txn.LoadWithPrefix(fmt.Sprintf("%s/%d/", handoffSegmentPrefix, rebuild.CollectionID))
+	if err != nil {
+		return false, err
+	}
+	for _, key := range keys {
+		segID, err := strconv.ParseInt(path.Base(key), 10, 64)
+		if err != nil {
+			continue
+		}
+		if rebuild.isSwapped(segID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// FinishIndexRebuild removes the index replaced after all the segments are swapped to the new one
+func (mt *MetaTable) FinishIndexRebuild(newIndexID typeutil.UniqueID) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	rebuild, ok := mt.indexRebuilds[newIndexID]
+	if !ok {
+		return fmt.Errorf("index %d is not being rebuilt", newIndexID)
+	}
+	collMeta, ok := mt.collID2Meta[rebuild.CollectionID]
+	if !ok {
+		return fmt.Errorf("collection id = %d not found", rebuild.CollectionID)
+	}
+	fieldIndexes := make([]*pb.FieldIndexInfo, 0, len(collMeta.FieldIndexes))
+	for _, info := range collMeta.FieldIndexes {
+		if info.GetIndexID() != rebuild.OldIndexID {
+			fieldIndexes = append(fieldIndexes, info)
+		}
+	}
+	collMeta.FieldIndexes = fieldIndexes
+	k := path.Join(CollectionMetaPrefix, strconv.FormatInt(collMeta.ID, 10))
+	v, err := proto.Marshal(&collMeta)
+	if err != nil {
+		return fmt.Errorf("metaTable FinishIndexRebuild Marshal collMeta fail key:%s, err:%w", k, err)
+	}
+	delMeta := []string{
+		fmt.Sprintf("%s/%d/%d", SegmentIndexMetaPrefix, collMeta.ID, rebuild.OldIndexID),
+		fmt.Sprintf("%s/%d/%d", IndexMetaPrefix, collMeta.ID, rebuild.OldIndexID),
+		rebuild.key(),
+	}
+	if err := mt.txn.MultiSaveAndRemoveWithPrefix(map[string]string{k: string(v)}, delMeta); err != nil {
+		return err
+	}
+
+	mt.collID2Meta[collMeta.ID] = collMeta
+	delete(mt.indexID2Meta, rebuild.OldIndexID)
+	for _, partID := range collMeta.PartitionIDs {
+		for segID := range mt.partID2SegID[partID] {
+			delete(mt.segID2IndexMeta[segID], rebuild.OldIndexID)
+		}
+	}
+	delete(mt.indexRebuilds, newIndexID)
+	return nil
+}
+
+func (c *Core) checkIndexRebuildLoop() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(indexRebuildCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			log.Debug("RootCoord context done, exit check index rebuild loop")
+			return
+		case <-ticker.C:
+			for _, rebuild := range c.MetaTable.listIndexRebuilds() {
+				if err := c.checkIndexRebuild(c.ctx, rebuild); err != nil {
+					log.Warn("failed to check index rebuild",
+						zap.Int64("collection id", rebuild.CollectionID),
+						zap.Int64("index id", rebuild.NewIndexID),
+						zap.Error(err))
+				}
+			}
+		}
+	}
+}
+
+// checkIndexRebuild swaps the flushed segments the new index is built on, and finishes the rebuild if all of them
+// are swapped
+func (c *Core) checkIndexRebuild(ctx context.Context, rebuild *indexRebuild) error {
+	ctx2, cancel := context.WithTimeout(ctx, 3*time.Minute)
+	defer cancel()
+
+	segID2PartID, err := c.getSegments(ctx2, rebuild.CollectionID)
+	if err != nil {
+		return err
+	}
+	pending := 0
+	buildIDs := make([]typeutil.UniqueID, 0, len(segID2PartID))
+	buildID2Info := make(map[typeutil.UniqueID]pb.SegmentIndexInfo)
+	var swapped []typeutil.UniqueID
+	for segID := range segID2PartID {
+		if rebuild.isSwapped(segID) {
+			continue
+		}
+		info, ok := c.MetaTable.getSegmentIndexInfo(segID, rebuild.NewIndexID)
+		if !ok {
+			// not built yet
+			pending++
+			continue
+		}
+		if !info.EnableIndex {
+			// the segment is too small to be indexed, nothing to load again
+			swapped = append(swapped, segID)
+			continue
+		}
+		buildIDs = append(buildIDs, info.BuildID)
+		buildID2Info[info.BuildID] = info
+	}
+
+	handoffs := make(map[string]string)
+	if len(buildIDs) > 0 {
+		states, err := c.CallGetIndexStatesService(ctx2, buildIDs)
+		if err != nil {
+			return err
+		}
+		for _, state := range states {
+			info, ok := buildID2Info[state.GetIndexBuildID()]
+			if !ok || state.GetState() != commonpb.IndexState_Finished {
+				continue
+			}
+			handoffInfo := &querypb.SegmentInfo{
+				SegmentID:    info.SegmentID,
+				CollectionID: info.CollectionID,
+				PartitionID:  segID2PartID[info.SegmentID],
+				SegmentState: commonpb.SegmentState_Sealed,
+				// marks the handoff as an index swap of the loaded segment
+				IndexID:   rebuild.NewIndexID,
+				IndexName: rebuild.IndexName,
+			}
+			v, err := proto.Marshal(handoffInfo)
+			if err != nil {
+				return err
+			}
+			handoffs[buildHandoffSegmentPath(handoffInfo.CollectionID, handoffInfo.PartitionID, handoffInfo.SegmentID)] = string(v)
+			swapped = append(swapped, info.SegmentID)
+		}
+		pending += len(buildIDs) - len(handoffs)
+	}
+
+	if len(swapped) > 0 {
+		if err := c.MetaTable.SwapSegmentIndex(rebuild.NewIndexID, swapped, handoffs); err != nil {
+			return err
+		}
+		log.Info("segments swapped to the rebuilt index",
+			zap.Int64("collection id", rebuild.CollectionID),
+			zap.Int64("index id", rebuild.NewIndexID),
+			zap.Int64s("segment ids", swapped),
+			zap.Int("pending", pending))
+	}
+	if pending > 0 || len(swapped) > 0 {
+		// the old index is dropped in the next round, after the segments swapped are handed off
+		return nil
+	}
+	// the old index keeps serving until querycoord takes all the handoffs of the segments swapped
+	handoffPending, err := c.MetaTable.hasPendingHandoffs(rebuild)
+	if err != nil {
+		return err
+	}
+	if handoffPending {
+		log.Info("waiting for querycoord to take the handoffs of the rebuilt index",
+			zap.Int64("collection id", rebuild.CollectionID),
+			zap.Int64("index id", rebuild.NewIndexID))
+		return nil
+	}
+
+	if err := c.CallDropIndexService(ctx2, rebuild.OldIndexID); err != nil {
+		return err
+	}
+	if err := c.MetaTable.FinishIndexRebuild(rebuild.NewIndexID); err != nil {
+		return err
+	}
+	log.Info("index rebuild finished",
+		zap.Int64("collection id", rebuild.CollectionID),
+		zap.String("index name", rebuild.IndexName),
+		zap.Int64("old index id", rebuild.OldIndexID),
+		zap.Int64("new index id", rebuild.NewIndexID))
+	return nil
+}
@@ -0,0 +1,188 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestSplitIndexRebuildParam(t *testing.T) {
+	params, rebuild, err := splitIndexRebuildParam([]*commonpb.KeyValuePair{
+		{Key: "index_type", Value: "HNSW"},
+		{Key: common.IndexRebuildParam, Value: "true"},
+	})
+	assert.NoError(t, err)
+	assert.True(t, rebuild)
+	assert.Equal(t, []*commonpb.KeyValuePair{{Key: "index_type", Value: "HNSW"}}, params)
+
+	_, rebuild, err = splitIndexRebuildParam([]*commonpb.KeyValuePair{{Key: "index_type", Value: "HNSW"}})
+	assert.NoError(t, err)
+	assert.False(t, rebuild)
+
+	_, _, err = splitIndexRebuildParam([]*commonpb.KeyValuePair{{Key: common.IndexRebuildParam, Value: "abc"}})
+	assert.Error(t, err)
+}
+
+func TestMetaTable_IndexRebuild(t *testing.T) {
+	txn := memkv.NewMemoryKV()
+	oldParams := []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}, {Key: "nlist", Value: "128"}}
+	newParams := []*commonpb.KeyValuePair{{Key: "index_type", Value: "HNSW"}, {Key: "M", Value: "16"}}
+	mt := &MetaTable{
+		txn: txn,
+		collID2Meta: map[typeutil.UniqueID]pb.CollectionInfo{
+			1: {
+				ID: 1,
+				Schema: &schemapb.CollectionSchema{Name: "coll", Fields: []*schemapb.FieldSchema{
+					{FieldID: 100, Name: "vec", DataType: schemapb.DataType_FloatVector},
+				}},
+				PartitionIDs: []typeutil.UniqueID{10},
+				FieldIndexes: []*pb.FieldIndexInfo{{FiledID: 100, IndexID: 1000}},
+			},
+		},
+		collName2ID:  map[string]map[string]typeutil.UniqueID{common.DefaultDatabase: {"coll": 1}},
+		partID2SegID: map[typeutil.UniqueID]map[typeutil.UniqueID]bool{10: {11: true, 12: true}},
+		segID2IndexMeta: map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo{
+			11: {1000: {CollectionID: 1, SegmentID: 11, FieldID: 100, IndexID: 1000, BuildID: 1, EnableIndex: true}},
+			12: {1000: {CollectionID: 1, SegmentID: 12, FieldID: 100, IndexID: 1000, BuildID: 2, EnableIndex: true}},
+		},
+		indexID2Meta: map[typeutil.UniqueID]pb.IndexInfo{
+			1000: {IndexName: "idx", IndexID: 1000, IndexParams: oldParams},
+		},
+		indexRebuilds: map[typeutil.UniqueID]*indexRebuild{},
+	}
+
+	_, _, err := mt.AddRebuildIndex("", "coll", "vec", &pb.IndexInfo{IndexName: "none", IndexID: 1001, IndexParams: newParams}, nil)
+	assert.Error(t, err)
+	_, _, err = mt.AddRebuildIndex("", "coll", "vec", &pb.IndexInfo{IndexName: "idx", IndexID: 1001, IndexParams: oldParams}, nil)
+	assert.Error(t, err)
+
+	segIDs, field, err := mt.AddRebuildIndex("", "coll", "vec", &pb.IndexInfo{IndexName: "idx", IndexID: 1001, IndexParams: newParams}, []typeutil.UniqueID{11, 12})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []typeutil.UniqueID{11, 12}, segIDs)
+	assert.Equal(t, int64(100), field.FieldID)
+	assert.Len(t, mt.collID2Meta[1].FieldIndexes, 2)
+	// rebuilt once at a time
+	_, _, err = mt.AddRebuildIndex("", "coll", "vec", &pb.IndexInfo{IndexName: "idx", IndexID: 1002, IndexParams: oldParams}, nil)
+	assert.Error(t, err)
+
+	// the old index keeps serving before the segment is swapped
+	assert.NoError(t, mt.AddIndex(&pb.SegmentIndexInfo{CollectionID: 1, PartitionID: 10, SegmentID: 11, FieldID: 100, IndexID: 1001, BuildID: 3, EnableIndex: true}))
+	infos, err := mt.GetSegmentIndexInfos(11)
+	assert.NoError(t, err)
+	assert.Len(t, infos, 1)
+	assert.Contains(t, infos, int64(1000))
+
+	handoffKey := buildHandoffSegmentPath(1, 10, 11)
+	handoff, err := proto.Marshal(&querypb.SegmentInfo{SegmentID: 11, CollectionID: 1, PartitionID: 10, IndexID: 1001})
+	assert.NoError(t, err)
+	assert.NoError(t, mt.SwapSegmentIndex(1001, []typeutil.UniqueID{11}, map[string]string{handoffKey: string(handoff)}))
+	assert.Error(t, mt.SwapSegmentIndex(1000, []typeutil.UniqueID{11}, nil))
+	infos, err = mt.GetSegmentIndexInfos(11)
+	assert.NoError(t, err)
+	assert.Len(t, infos, 1)
+	assert.Contains(t, infos, int64(1001))
+	info, err := mt.GetSegmentIndexInfoByID(11, 100, "idx")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1001), info.IndexID)
+	value, err := txn.Load(handoffKey)
+	assert.NoError(t, err)
+	assert.Equal(t, string(handoff), value)
+
+	// the old index keeps serving until querycoord takes the handoff
+	pending, err := mt.hasPendingHandoffs(mt.indexRebuilds[1001])
+	assert.NoError(t, err)
+	assert.True(t, pending)
+	assert.NoError(t, txn.Save(buildHandoffSegmentPath(1, 10, 13), string(handoff)))
+	assert.NoError(t, txn.Remove(handoffKey))
+	pending, err = mt.hasPendingHandoffs(mt.indexRebuilds[1001])
+	assert.NoError(t, err)
+	assert.False(t, pending)
+
+	// the rebuild is reloaded
+	snapshot := &mockTestKV{
+		loadWithPrefix: func(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+			return nil, nil, nil
+		},
+	}
+	reloaded, err := NewMetaTable(txn, snapshot)
+	assert.NoError(t, err)
+	assert.Len(t, reloaded.indexRebuilds, 1)
+	assert.Equal(t, []typeutil.UniqueID{11}, reloaded.indexRebuilds[1001].SwappedSegmentIDs)
+	assert.Len(t, reloaded.indexID2Meta, 1)
+
+	assert.NoError(t, mt.FinishIndexRebuild(1001))
+	assert.Empty(t, mt.indexRebuilds)
+	assert.Len(t, mt.collID2Meta[1].FieldIndexes, 1)
+	assert.Equal(t, int64(1001), mt.collID2Meta[1].FieldIndexes[0].IndexID)
+	_, ok := mt.indexID2Meta[1000]
+	assert.False(t, ok)
+	infos, err = mt.GetSegmentIndexInfos(12)
+	assert.NoError(t, err)
+	assert.Empty(t, infos)
+	assert.Error(t, mt.FinishIndexRebuild(1001))
+
+	reloaded, err = NewMetaTable(txn, snapshot)
+	assert.NoError(t, err)
+	assert.Empty(t, reloaded.indexRebuilds)
+}
+
+func TestMetaTable_DropRebuildingIndex(t *testing.T) {
+	txn := memkv.NewMemoryKV()
+	params := []*commonpb.KeyValuePair{{Key: "index_type", Value: "HNSW"}}
+	mt := &MetaTable{
+		txn: txn,
+		collID2Meta: map[typeutil.UniqueID]pb.CollectionInfo{
+			1: {
+				ID: 1,
+				Schema: &schemapb.CollectionSchema{Name: "coll", Fields: []*schemapb.FieldSchema{
+					{FieldID: 100, Name: "vec", DataType: schemapb.DataType_FloatVector},
+				}},
+				FieldIndexes: []*pb.FieldIndexInfo{{FiledID: 100, IndexID: 1000}},
+			},
+		},
+		collName2ID:     map[string]map[string]typeutil.UniqueID{common.DefaultDatabase: {"coll": 1}},
+		partID2SegID:    map[typeutil.UniqueID]map[typeutil.UniqueID]bool{},
+		segID2IndexMeta: map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo{},
+		indexID2Meta: map[typeutil.UniqueID]pb.IndexInfo{
+			1000: {IndexName: "idx", IndexID: 1000},
+		},
+		indexRebuilds: map[typeutil.UniqueID]*indexRebuild{},
+	}
+	_, _, err := mt.AddRebuildIndex("", "coll", "vec", &pb.IndexInfo{IndexName: "idx", IndexID: 1001, IndexParams: params}, nil)
+	assert.NoError(t, err)
+
+	_, dropped, err := mt.DropIndex("", "coll", "vec", "idx")
+	assert.NoError(t, err)
+	assert.True(t, dropped)
+	assert.Empty(t, mt.collID2Meta[1].FieldIndexes)
+	assert.Empty(t, mt.indexID2Meta)
+	assert.Empty(t, mt.indexRebuilds)
+	_, values, err := txn.LoadWithPrefix(IndexRebuildPrefix)
+	assert.NoError(t, err)
+	assert.Empty(t, values)
+}
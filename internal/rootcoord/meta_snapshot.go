@@ -385,7 +385,16 @@ func (ms *metaSnapshot) LoadWithPrefix(key string, ts typeutil.Timestamp) ([]str
 	return keys, values, nil
 }
 
+// MultiSaveAndRemove saves the kvs and removes the keys exactly, unlike MultiSaveAndRemoveWithPrefix
+func (ms *metaSnapshot) MultiSaveAndRemove(saves map[string]string, removals []string, ts typeutil.Timestamp) error {
+	return ms.multiSaveAndRemove(saves, removals, ts)
+}
+
 func (ms *metaSnapshot) MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string, ts typeutil.Timestamp) error {
+	return ms.multiSaveAndRemove(saves, removals, ts, clientv3.WithPrefix())
+}
+
+func (ms *metaSnapshot) multiSaveAndRemove(saves map[string]string, removals []string, ts typeutil.Timestamp, removeOpts ...clientv3.OpOption) error {
 	ms.lock.Lock()
 	defer ms.lock.Unlock()
 	ctx, cancel := context.WithTimeout(context.Background(), RequestTimeout)
@@ -398,7 +407,7 @@ func (ms *metaSnapshot) MultiSaveAndRemoveWithPrefix(saves map[string]string, re
 
 	strTs := strconv.FormatInt(int64(ts), 10)
 	for _, key := range removals {
-		ops = append(ops, clientv3.OpDelete(path.Join(ms.root, key), clientv3.WithPrefix()))
+		ops = append(ops, clientv3.OpDelete(path.Join(ms.root, key), removeOpts...))
 	}
 	ops = append(ops, clientv3.OpPut(path.Join(ms.root, ms.tsKey), strTs))
 	resp, err := ms.cli.Txn(ctx).If().Then(ops...).Commit()
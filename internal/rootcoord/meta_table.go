@@ -27,6 +27,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -34,6 +35,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -53,6 +56,9 @@ const (
 	// IndexMetaPrefix prefix for index meta
 	IndexMetaPrefix = ComponentPrefix + "/index"
 
+	// IndexRebuildPrefix prefix for the rebuilds of indexes, which mustn't start with IndexMetaPrefix
+	IndexRebuildPrefix = ComponentPrefix + "/rebuilding-index"
+
 	// CollectionAliasMetaPrefix prefix for collection alias meta
 	CollectionAliasMetaPrefix = ComponentPrefix + "/collection-alias"
 
@@ -82,12 +88,6 @@ const (
 
 	// CredentialPrefix prefix for credential user
 	CredentialPrefix = ComponentPrefix + UserSubPrefix
-
-	// DefaultIndexType name of default index type for scalar field
-	DefaultIndexType = "STL_SORT"
-
-	// DefaultStringIndexType name of default index type for varChar/string field
-	DefaultStringIndexType = "Trie"
 )
 
 // MetaTable store all rootCoord meta info
@@ -96,11 +96,12 @@ type MetaTable struct {
 	snapshot        kv.SnapShotKV                                                   // client of a reliable snapshotkv service, i.e. etcd client
 	proxyID2Meta    map[typeutil.UniqueID]pb.ProxyMeta                              // proxy id to proxy meta
 	collID2Meta     map[typeutil.UniqueID]pb.CollectionInfo                         // collection id -> collection meta
-	collName2ID     map[string]typeutil.UniqueID                                    // collection name to collection id
-	collAlias2ID    map[string]typeutil.UniqueID                                    // collection alias to collection id
+	collName2ID     map[string]map[string]typeutil.UniqueID                         // database -> collection name -> collection id
+	collAlias2ID    map[string]map[string]typeutil.UniqueID                         // database -> collection alias -> collection id
 	partID2SegID    map[typeutil.UniqueID]map[typeutil.UniqueID]bool                // partition id -> segment_id -> bool
 	segID2IndexMeta map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo // collection id/index_id/partition_id/segment_id -> meta
 	indexID2Meta    map[typeutil.UniqueID]pb.IndexInfo                              // collection id/index_id -> meta
+	indexRebuilds   map[typeutil.UniqueID]*indexRebuild                             // collection id/new index_id -> rebuild
 
 	proxyLock sync.RWMutex
 	ddLock    sync.RWMutex
@@ -127,11 +128,12 @@ func NewMetaTable(txn kv.TxnKV, snap kv.SnapShotKV) (*MetaTable, error) {
 func (mt *MetaTable) reloadFromKV() error {
 	mt.proxyID2Meta = make(map[typeutil.UniqueID]pb.ProxyMeta)
 	mt.collID2Meta = make(map[typeutil.UniqueID]pb.CollectionInfo)
-	mt.collName2ID = make(map[string]typeutil.UniqueID)
-	mt.collAlias2ID = make(map[string]typeutil.UniqueID)
+	mt.collName2ID = make(map[string]map[string]typeutil.UniqueID)
+	mt.collAlias2ID = make(map[string]map[string]typeutil.UniqueID)
 	mt.partID2SegID = make(map[typeutil.UniqueID]map[typeutil.UniqueID]bool)
 	mt.segID2IndexMeta = make(map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo)
 	mt.indexID2Meta = make(map[typeutil.UniqueID]pb.IndexInfo)
+	mt.indexRebuilds = make(map[typeutil.UniqueID]*indexRebuild)
 
 	_, values, err := mt.txn.LoadWithPrefix(ProxyMetaPrefix)
 	if err != nil {
@@ -163,7 +165,7 @@ func (mt *MetaTable) reloadFromKV() error {
 			return fmt.Errorf("rootcoord Unmarshal pb.CollectionInfo err:%w", err)
 		}
 		mt.collID2Meta[collInfo.ID] = collInfo
-		mt.collName2ID[collInfo.Schema.Name] = collInfo.ID
+		putNameID(mt.collName2ID, collInfo.DbName, collInfo.Schema.Name, collInfo.ID)
 	}
 
 	_, values, err = mt.txn.LoadWithPrefix(SegmentIndexMetaPrefix)
@@ -219,6 +221,19 @@ func (mt *MetaTable) reloadFromKV() error {
 		mt.indexID2Meta[meta.IndexID] = meta
 	}
 
+	_, values, err = mt.txn.LoadWithPrefix(IndexRebuildPrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		rebuild := &indexRebuild{}
+		err = json.Unmarshal([]byte(value), rebuild)
+		if err != nil {
+			return fmt.Errorf("rootcoord Unmarshal indexRebuild err:%w", err)
+		}
+		mt.indexRebuilds[rebuild.NewIndexID] = rebuild
+	}
+
 	_, values, err = mt.snapshot.LoadWithPrefix(CollectionAliasMetaPrefix, 0)
 	if err != nil {
 		return err
@@ -229,13 +244,62 @@ func (mt *MetaTable) reloadFromKV() error {
 		if err != nil {
 			return fmt.Errorf("rootcoord Unmarshal pb.AliasInfo err:%w", err)
 		}
-		mt.collAlias2ID[aliasInfo.Schema.Name] = aliasInfo.ID
+		putNameID(mt.collAlias2ID, aliasInfo.DbName, aliasInfo.Schema.Name, aliasInfo.ID)
 	}
 
 	log.Debug("reload meta table from KV successfully")
 	return nil
 }
 
+// putNameID maps the name of a collection or an alias in the database to the collection id
+func putNameID(names map[string]map[string]typeutil.UniqueID, database string, name string, id typeutil.UniqueID) {
+	database = funcutil.DatabaseName(database)
+	if names[database] == nil {
+		names[database] = make(map[string]typeutil.UniqueID)
+	}
+	names[database][name] = id
+}
+
+// deleteNameID removes the name of a collection or an alias in the database
+func deleteNameID(names map[string]map[string]typeutil.UniqueID, database string, name string) {
+	database = funcutil.DatabaseName(database)
+	delete(names[database], name)
+	if len(names[database]) == 0 {
+		delete(names, database)
+	}
+}
+
+// getCollectionID returns the id of the collection of the name in the database, the caller holds ddLock
+func (mt *MetaTable) getCollectionID(database string, name string) (typeutil.UniqueID, bool) {
+	id, ok := mt.collName2ID[funcutil.DatabaseName(database)][name]
+	return id, ok
+}
+
+// getAliasID returns the id of the collection of the alias in the database, the caller holds ddLock
+func (mt *MetaTable) getAliasID(database string, alias string) (typeutil.UniqueID, bool) {
+	id, ok := mt.collAlias2ID[funcutil.DatabaseName(database)][alias]
+	return id, ok
+}
+
+// getCollectionOrAliasID returns the id of the collection of the name or the alias in the database,
+// the caller holds ddLock
+func (mt *MetaTable) getCollectionOrAliasID(database string, name string) (typeutil.UniqueID, bool) {
+	if id, ok := mt.getCollectionID(database, name); ok {
+		return id, ok
+	}
+	return mt.getAliasID(database, name)
+}
+
+// aliasKey returns the key of the alias in the database, the aliases of the default database are
+// {prefix}/{alias} as before the databases are supported. The key of an alias of the default database is the prefix
+// of the keys of the other databases, so the aliases are removed by the exact keys rather than the prefixes.
+func aliasKey(database string, alias string) string {
+	if database = funcutil.DatabaseName(database); database == common.DefaultDatabase {
+		return fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, alias)
+	}
+	return fmt.Sprintf("%s/%s/%s", CollectionAliasMetaPrefix, database, alias)
+}
+
 // AddProxy add proxy
 func (mt *MetaTable) AddProxy(po *pb.ProxyMeta) error {
 	mt.proxyLock.Lock()
@@ -263,23 +327,29 @@ func (mt *MetaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestam
 	defer mt.ddLock.Unlock()
 
 	if len(coll.PartitionIDs) != len(coll.PartitionNames) ||
-		len(coll.PartitionIDs) != len(coll.PartitionCreatedTimestamps) ||
-		(len(coll.PartitionIDs) != 1 && len(coll.PartitionIDs) != 0) {
+		len(coll.PartitionIDs) != len(coll.PartitionCreatedTimestamps) {
 		return fmt.Errorf("partition parameters' length mis-match when creating collection")
 	}
-	if _, ok := mt.collName2ID[coll.Schema.Name]; ok {
+	// the collections with partition key are created with the implicit partitions besides the default one
+	if int64(len(coll.PartitionIDs)) > Params.RootCoordCfg.MaxPartitionNum {
+		return fmt.Errorf("maximum partition's number should be limit to %d", Params.RootCoordCfg.MaxPartitionNum)
+	}
+	if _, ok := mt.getCollectionID(coll.DbName, coll.Schema.Name); ok {
 		return fmt.Errorf("collection %s exist", coll.Schema.Name)
 	}
+	if err := mt.checkDatabase(coll.DbName); err != nil {
+		return err
+	}
 	if len(coll.FieldIndexes) != len(idx) {
 		return fmt.Errorf("incorrect index id when creating collection")
 	}
 
 	coll.CreateTime = ts
-	if len(coll.PartitionCreatedTimestamps) == 1 {
-		coll.PartitionCreatedTimestamps[0] = ts
+	for i := range coll.PartitionCreatedTimestamps {
+		coll.PartitionCreatedTimestamps[i] = ts
 	}
 	mt.collID2Meta[coll.ID] = *coll
-	mt.collName2ID[coll.Schema.Name] = coll.ID
+	putNameID(mt.collName2ID, coll.DbName, coll.Schema.Name, coll.ID)
 	for _, i := range idx {
 		mt.indexID2Meta[i.IndexID] = *i
 	}
@@ -328,7 +398,7 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	}
 
 	delete(mt.collID2Meta, collID)
-	delete(mt.collName2ID, collMeta.Schema.Name)
+	deleteNameID(mt.collName2ID, collMeta.DbName, collMeta.Schema.Name)
 
 	// update segID2IndexMeta
 	for partID := range collMeta.PartitionIDs {
@@ -351,10 +421,11 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 			continue
 		}
 		delete(mt.indexID2Meta, idxInfo.IndexID)
+		delete(mt.indexRebuilds, idxInfo.IndexID)
 	}
 	var aliases []string
 	// delete collection aliases
-	for alias, cid := range mt.collAlias2ID {
+	for alias, cid := range mt.collAlias2ID[funcutil.DatabaseName(collMeta.DbName)] {
 		if cid == collID {
 			aliases = append(aliases, alias)
 		}
@@ -366,13 +437,12 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	delMetaKeysTxn := []string{
 		fmt.Sprintf("%s/%d", SegmentIndexMetaPrefix, collID),
 		fmt.Sprintf("%s/%d", IndexMetaPrefix, collID),
+		fmt.Sprintf("%s/%d", IndexRebuildPrefix, collID),
 	}
 
 	for _, alias := range aliases {
-		delete(mt.collAlias2ID, alias)
-		delMetakeysSnap = append(delMetakeysSnap,
-			fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, alias),
-		)
+		deleteNameID(mt.collAlias2ID, collMeta.DbName, alias)
+		delMetakeysSnap = append(delMetakeysSnap, aliasKey(collMeta.DbName, alias))
 	}
 
 	// save ddOpStr into etcd
@@ -381,10 +451,11 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 		DDOperationPrefix: ddOpStr,
 	}
 
-	err := mt.snapshot.MultiSaveAndRemoveWithPrefix(map[string]string{}, delMetakeysSnap, ts)
+	// the keys are removed exactly, the aliases of the other databases are prefixed by the aliases of the default one
+	err := mt.snapshot.MultiSaveAndRemove(map[string]string{}, delMetakeysSnap, ts)
 	if err != nil {
-		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
-		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+		log.Error("SnapShotKV MultiSaveAndRemove fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemove fail")
 	}
 	err = mt.txn.MultiSaveAndRemoveWithPrefix(saveMeta, delMetaKeysTxn)
 	if err != nil {
@@ -408,14 +479,14 @@ func (mt *MetaTable) HasCollection(collID typeutil.UniqueID, ts typeutil.Timesta
 	return err == nil
 }
 
-// GetCollectionIDByName returns the collection ID according to its name.
+// GetCollectionIDByName returns the collection ID according to its name in the database.
 // Returns an error if no matching ID is found.
-func (mt *MetaTable) GetCollectionIDByName(cName string) (typeutil.UniqueID, error) {
+func (mt *MetaTable) GetCollectionIDByName(database string, cName string) (typeutil.UniqueID, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 	var cID UniqueID
 	var ok bool
-	if cID, ok = mt.collName2ID[cName]; !ok {
+	if cID, ok = mt.getCollectionID(database, cName); !ok {
 		return 0, fmt.Errorf("collection ID not found for collection name %s", cName)
 	}
 	return cID, nil
@@ -447,17 +518,15 @@ func (mt *MetaTable) GetCollectionByID(collectionID typeutil.UniqueID, ts typeut
 	return &colMeta, nil
 }
 
-// GetCollectionByName return collection meta by collection name
-func (mt *MetaTable) GetCollectionByName(collectionName string, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
+// GetCollectionByName return collection meta by collection name or alias in the database
+func (mt *MetaTable) GetCollectionByName(database string, collectionName string, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
 	if ts == 0 {
-		vid, ok := mt.collName2ID[collectionName]
+		vid, ok := mt.getCollectionOrAliasID(database, collectionName)
 		if !ok {
-			if vid, ok = mt.collAlias2ID[collectionName]; !ok {
-				return nil, fmt.Errorf("can't find collection: " + collectionName)
-			}
+			return nil, fmt.Errorf("can't find collection: " + collectionName)
 		}
 		col, ok := mt.collID2Meta[vid]
 		if !ok {
@@ -478,21 +547,21 @@ func (mt *MetaTable) GetCollectionByName(collectionName string, ts typeutil.Time
 			log.Warn("unmarshal collection info failed", zap.Error(err))
 			continue
 		}
-		if collMeta.Schema.Name == collectionName {
+		if collMeta.Schema.Name == collectionName && funcutil.DatabaseName(collMeta.DbName) == funcutil.DatabaseName(database) {
 			return &collMeta, nil
 		}
 	}
 	return nil, fmt.Errorf("can't find collection: %s, at timestamp = %d", collectionName, ts)
 }
 
-// ListCollections list all collection names
-func (mt *MetaTable) ListCollections(ts typeutil.Timestamp) (map[string]*pb.CollectionInfo, error) {
+// ListCollections list all collection names in the database
+func (mt *MetaTable) ListCollections(database string, ts typeutil.Timestamp) (map[string]*pb.CollectionInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 	colls := make(map[string]*pb.CollectionInfo)
 
 	if ts == 0 {
-		for collName, collID := range mt.collName2ID {
+		for collName, collID := range mt.collName2ID[funcutil.DatabaseName(database)] {
 			coll := mt.collID2Meta[collID]
 			colCopy := proto.Clone(&coll)
 			colls[collName] = colCopy.(*pb.CollectionInfo)
@@ -510,6 +579,9 @@ func (mt *MetaTable) ListCollections(ts typeutil.Timestamp) (map[string]*pb.Coll
 		if err != nil {
 			log.Debug("unmarshal collection info failed", zap.Error(err))
 		}
+		if funcutil.DatabaseName(collMeta.DbName) != funcutil.DatabaseName(database) {
+			continue
+		}
 		colls[collMeta.Schema.Name] = &collMeta
 	}
 	return colls, nil
@@ -520,7 +592,7 @@ func (mt *MetaTable) ListAliases(collID typeutil.UniqueID) []string {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 	var aliases []string
-	for alias, cid := range mt.collAlias2ID {
+	for alias, cid := range mt.collAlias2ID[funcutil.DatabaseName(mt.collID2Meta[collID].DbName)] {
 		if cid == collID {
 			aliases = append(aliases, alias)
 		}
@@ -841,28 +913,26 @@ func (mt *MetaTable) AddIndex(segIdxInfo *pb.SegmentIndexInfo) error {
 }
 
 // DropIndex drop index
-func (mt *MetaTable) DropIndex(collName, fieldName, indexName string) (typeutil.UniqueID, bool, error) {
+func (mt *MetaTable) DropIndex(database, collName, fieldName, indexName string) (typeutil.UniqueID, bool, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
-	collID, ok := mt.collName2ID[collName]
+	collID, ok := mt.getCollectionOrAliasID(database, collName)
 	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return 0, false, fmt.Errorf("collection name = %s not exist", collName)
-		}
+		return 0, false, fmt.Errorf("collection name = %s not exist", collName)
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
 		return 0, false, fmt.Errorf("collection name  = %s not has meta", collName)
 	}
-	fieldSch, err := mt.unlockGetFieldSchema(collName, fieldName)
+	fieldSch, err := mt.unlockGetFieldSchema(database, collName, fieldName)
 	if err != nil {
 		return 0, false, err
 	}
 	fieldIdxInfo := make([]*pb.FieldIndexInfo, 0, len(collMeta.FieldIndexes))
-	var dropIdxID typeutil.UniqueID
-	for i, info := range collMeta.FieldIndexes {
+	// the index being rebuilt has the same name as the one it replaces, both are dropped
+	var dropIdxIDs []typeutil.UniqueID
+	for _, info := range collMeta.FieldIndexes {
 		if info.FiledID != fieldSch.FieldID {
 			fieldIdxInfo = append(fieldIdxInfo, info)
 			continue
@@ -877,9 +947,7 @@ func (mt *MetaTable) DropIndex(collName, fieldName, indexName string) (typeutil.
 			fieldIdxInfo = append(fieldIdxInfo, info)
 			continue
 		}
-		dropIdxID = info.IndexID
-		fieldIdxInfo = append(fieldIdxInfo, collMeta.FieldIndexes[i+1:]...)
-		break
+		dropIdxIDs = append(dropIdxIDs, info.IndexID)
 	}
 	if len(fieldIdxInfo) == len(collMeta.FieldIndexes) {
 		log.Warn("drop index,index not found", zap.String("collection name", collName), zap.String("filed name", fieldName), zap.String("index name", indexName))
@@ -896,22 +964,29 @@ func (mt *MetaTable) DropIndex(collName, fieldName, indexName string) (typeutil.
 	}
 	saveMeta := map[string]string{k: string(v)}
 
-	delete(mt.indexID2Meta, dropIdxID)
+	var delMeta []string
+	for _, dropIdxID := range dropIdxIDs {
+		delete(mt.indexID2Meta, dropIdxID)
 
-	// update segID2IndexMeta
-	for _, partID := range collMeta.PartitionIDs {
-		if segIDMap, ok := mt.partID2SegID[partID]; ok {
-			for segID := range segIDMap {
-				if segIndexInfos, ok := mt.segID2IndexMeta[segID]; ok {
-					delete(segIndexInfos, dropIdxID)
+		// update segID2IndexMeta
+		for _, partID := range collMeta.PartitionIDs {
+			if segIDMap, ok := mt.partID2SegID[partID]; ok {
+				for segID := range segIDMap {
+					if segIndexInfos, ok := mt.segID2IndexMeta[segID]; ok {
+						delete(segIndexInfos, dropIdxID)
+					}
 				}
 			}
 		}
-	}
 
-	delMeta := []string{
-		fmt.Sprintf("%s/%d/%d", SegmentIndexMetaPrefix, collMeta.ID, dropIdxID),
-		fmt.Sprintf("%s/%d/%d", IndexMetaPrefix, collMeta.ID, dropIdxID),
+		delMeta = append(delMeta,
+			fmt.Sprintf("%s/%d/%d", SegmentIndexMetaPrefix, collMeta.ID, dropIdxID),
+			fmt.Sprintf("%s/%d/%d", IndexMetaPrefix, collMeta.ID, dropIdxID),
+		)
+		if _, ok := mt.indexRebuilds[dropIdxID]; ok {
+			delete(mt.indexRebuilds, dropIdxID)
+			delMeta = append(delMeta, fmt.Sprintf("%s/%d/%d", IndexRebuildPrefix, collMeta.ID, dropIdxID))
+		}
 	}
 
 	err = mt.txn.MultiSaveAndRemoveWithPrefix(saveMeta, delMeta)
@@ -920,7 +995,7 @@ func (mt *MetaTable) DropIndex(collName, fieldName, indexName string) (typeutil.
 		panic("TxnKV MultiSaveAndRemoveWithPrefix fail")
 	}
 
-	return dropIdxID, true, nil
+	return dropIdxIDs[0], true, nil
 }
 
 // GetSegmentIndexInfoByID return segment index info by segment id
@@ -941,6 +1016,7 @@ func (mt *MetaTable) GetSegmentIndexInfoByID(segID typeutil.UniqueID, fieldID in
 	if len(segIdxMap) == 0 {
 		return pb.SegmentIndexInfo{}, fmt.Errorf("segment id %d not has any index", segID)
 	}
+	segIdxMap = mt.unlockGetServingSegmentIndexes(segID, segIdxMap)
 
 	if fieldID == -1 && idxName == "" { // return default index
 		for _, seg := range segIdxMap {
@@ -975,24 +1051,21 @@ func (mt *MetaTable) GetSegmentIndexInfos(segID typeutil.UniqueID) (map[typeutil
 		return nil, fmt.Errorf("segment not found in meta, segment: %d", segID)
 	}
 
-	return ret, nil
+	return mt.unlockGetServingSegmentIndexes(segID, ret), nil
 }
 
 // GetFieldSchema return field schema
-func (mt *MetaTable) GetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
+func (mt *MetaTable) GetFieldSchema(database string, collName string, fieldName string) (schemapb.FieldSchema, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	return mt.unlockGetFieldSchema(collName, fieldName)
+	return mt.unlockGetFieldSchema(database, collName, fieldName)
 }
 
-func (mt *MetaTable) unlockGetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
-	collID, ok := mt.collName2ID[collName]
+func (mt *MetaTable) unlockGetFieldSchema(database string, collName string, fieldName string) (schemapb.FieldSchema, error) {
+	collID, ok := mt.getCollectionOrAliasID(database, collName)
 	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
-		}
+		return schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
@@ -1036,13 +1109,10 @@ func (mt *MetaTable) unlockIsSegmentIndexed(segID typeutil.UniqueID, fieldSchema
 	return exist
 }
 
-func (mt *MetaTable) unlockGetCollectionInfo(collName string) (pb.CollectionInfo, error) {
-	collID, ok := mt.collName2ID[collName]
+func (mt *MetaTable) unlockGetCollectionInfo(database string, collName string) (pb.CollectionInfo, error) {
+	collID, ok := mt.getCollectionOrAliasID(database, collName)
 	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return pb.CollectionInfo{}, fmt.Errorf("collection not found: %s", collName)
-		}
+		return pb.CollectionInfo{}, fmt.Errorf("collection not found: %s", collName)
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
@@ -1093,31 +1163,43 @@ func (mt *MetaTable) checkFieldIndexDuplicate(collMeta pb.CollectionInfo, fieldS
 	return false, nil
 }
 
+// getScalarIndexType returns the index type in the params of creating index, which may be in the JSON of `params`,
+// or the default one of the data type if it's not specified or unknown
+func getScalarIndexType(dataType schemapb.DataType, indexParams []*commonpb.KeyValuePair) string {
+	for _, kv := range indexParams {
+		if kv.GetKey() == "index_type" && indexparamcheck.IsScalarIndexType(kv.GetValue()) {
+			return kv.GetValue()
+		}
+		if kv.GetKey() == "params" {
+			if params, err := funcutil.ParseIndexParamsMap(kv.GetValue()); err == nil && indexparamcheck.IsScalarIndexType(params["index_type"]) {
+				return params["index_type"]
+			}
+		}
+	}
+	return indexparamcheck.DefaultScalarIndexType(dataType)
+}
+
 // GetNotIndexedSegments return segment ids which have no index
-func (mt *MetaTable) GetNotIndexedSegments(collName string, fieldName string, idxInfo *pb.IndexInfo, segIDs []typeutil.UniqueID) ([]typeutil.UniqueID, schemapb.FieldSchema, error) {
+func (mt *MetaTable) GetNotIndexedSegments(database string, collName string, fieldName string, idxInfo *pb.IndexInfo, segIDs []typeutil.UniqueID) ([]typeutil.UniqueID, schemapb.FieldSchema, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
-	collMeta, err := mt.unlockGetCollectionInfo(collName)
+	collMeta, err := mt.unlockGetCollectionInfo(database, collName)
 	if err != nil {
 		// error here if collection not found.
 		return nil, schemapb.FieldSchema{}, err
 	}
 
-	fieldSchema, err := mt.unlockGetFieldSchema(collName, fieldName)
+	fieldSchema, err := mt.unlockGetFieldSchema(database, collName, fieldName)
 	if err != nil {
 		// error here if field not found.
 		return nil, fieldSchema, err
 	}
 
-	//TODO:: check index params for sclar field
-	// set default index type for scalar index
+	// scalar index takes the index type only, which is checked by proxy, set the default one if it's not specified
 	if !typeutil.IsVectorType(fieldSchema.GetDataType()) {
-		if fieldSchema.DataType == schemapb.DataType_VarChar {
-			idxInfo.IndexParams = []*commonpb.KeyValuePair{{Key: "index_type", Value: DefaultStringIndexType}}
-		} else {
-			idxInfo.IndexParams = []*commonpb.KeyValuePair{{Key: "index_type", Value: DefaultIndexType}}
-		}
+		indexType := getScalarIndexType(fieldSchema.GetDataType(), idxInfo.GetIndexParams())
+		idxInfo.IndexParams = []*commonpb.KeyValuePair{{Key: "index_type", Value: indexType}}
 	}
 
 	if idxInfo.IndexParams == nil {
@@ -1179,16 +1261,13 @@ func (mt *MetaTable) GetNotIndexedSegments(collName string, fieldName string, id
 }
 
 // GetIndexByName return index info by index name
-func (mt *MetaTable) GetIndexByName(collName, indexName string) (pb.CollectionInfo, []pb.IndexInfo, error) {
+func (mt *MetaTable) GetIndexByName(database, collName, indexName string) (pb.CollectionInfo, []pb.IndexInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	collID, ok := mt.collName2ID[collName]
+	collID, ok := mt.getCollectionOrAliasID(database, collName)
 	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return pb.CollectionInfo{}, nil, fmt.Errorf("collection %s not found", collName)
-		}
+		return pb.CollectionInfo{}, nil, fmt.Errorf("collection %s not found", collName)
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
@@ -1246,26 +1325,26 @@ func (mt *MetaTable) dupMeta() (
 	return collID2Meta, segID2IndexMeta, indexID2Meta
 }
 
-// AddAlias add collection alias
-func (mt *MetaTable) AddAlias(collectionAlias string, collectionName string, ts typeutil.Timestamp) error {
+// AddAlias add collection alias in the database of the collection
+func (mt *MetaTable) AddAlias(database string, collectionAlias string, collectionName string, ts typeutil.Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
-	if _, ok := mt.collAlias2ID[collectionAlias]; ok {
+	if _, ok := mt.getAliasID(database, collectionAlias); ok {
 		return fmt.Errorf("duplicate collection alias, alias = %s", collectionAlias)
 	}
 
-	if _, ok := mt.collName2ID[collectionAlias]; ok {
+	if _, ok := mt.getCollectionID(database, collectionAlias); ok {
 		return fmt.Errorf("collection alias collides with existing collection name. collection = %s, alias = %s", collectionAlias, collectionAlias)
 	}
 
-	id, ok := mt.collName2ID[collectionName]
+	id, ok := mt.getCollectionID(database, collectionName)
 	if !ok {
 		return fmt.Errorf("aliased collection name does not exist, name = %s", collectionName)
 	}
-	mt.collAlias2ID[collectionAlias] = id
+	putNameID(mt.collAlias2ID, database, collectionAlias, id)
 
-	k := fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, collectionAlias)
-	v, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}})
+	k := aliasKey(database, collectionAlias)
+	v, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}, DbName: database})
 	if err != nil {
 		log.Error("MetaTable AddAlias Marshal CollectionInfo fail",
 			zap.String("key", k), zap.Error(err))
@@ -1280,62 +1359,79 @@ func (mt *MetaTable) AddAlias(collectionAlias string, collectionName string, ts
 	return nil
 }
 
-// DropAlias drop collection alias
-func (mt *MetaTable) DropAlias(collectionAlias string, ts typeutil.Timestamp) error {
+// DropAlias drop collection alias in the database
+func (mt *MetaTable) DropAlias(database string, collectionAlias string, ts typeutil.Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
-	if _, ok := mt.collAlias2ID[collectionAlias]; !ok {
+	if _, ok := mt.getAliasID(database, collectionAlias); !ok {
 		return fmt.Errorf("alias does not exist, alias = %s", collectionAlias)
 	}
-	delete(mt.collAlias2ID, collectionAlias)
+	deleteNameID(mt.collAlias2ID, database, collectionAlias)
 
 	delMetakeys := []string{
-		fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, collectionAlias),
+		aliasKey(database, collectionAlias),
 	}
 	meta := make(map[string]string)
-	err := mt.snapshot.MultiSaveAndRemoveWithPrefix(meta, delMetakeys, ts)
+	err := mt.snapshot.MultiSaveAndRemove(meta, delMetakeys, ts)
 	if err != nil {
-		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
-		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+		log.Error("SnapShotKV MultiSaveAndRemove fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemove fail")
 	}
 	return nil
 }
 
-// AlterAlias alter collection alias
-func (mt *MetaTable) AlterAlias(collectionAlias string, collectionName string, ts typeutil.Timestamp) error {
+// AlterAlias alter collection alias. If collectionName is another alias rather than a collection, the collections of
+// the two aliases are swapped, which is how the blue/green deployment switches the serving collection. The aliases
+// are saved in one transaction, so the clients reading by them never find either one missing.
+func (mt *MetaTable) AlterAlias(database string, collectionAlias string, collectionName string, ts typeutil.Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
-	if _, ok := mt.collAlias2ID[collectionAlias]; !ok {
+	oldID, ok := mt.getAliasID(database, collectionAlias)
+	if !ok {
 		return fmt.Errorf("alias does not exist, alias = %s", collectionAlias)
 	}
 
-	id, ok := mt.collName2ID[collectionName]
-	if !ok {
+	aliases := make(map[string]typeutil.UniqueID)
+	if id, ok := mt.getCollectionID(database, collectionName); ok {
+		aliases[collectionAlias] = id
+	} else if id, ok := mt.getAliasID(database, collectionName); ok {
+		if collectionName == collectionAlias {
+			return fmt.Errorf("alias could not be swapped with itself, alias = %s", collectionAlias)
+		}
+		aliases[collectionAlias] = id
+		aliases[collectionName] = oldID
+	} else {
 		return fmt.Errorf("aliased collection name does not exist, name = %s", collectionName)
 	}
-	mt.collAlias2ID[collectionAlias] = id
 
-	k := fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, collectionAlias)
-	v, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}})
-	if err != nil {
-		log.Error("MetaTable AlterAlias Marshal CollectionInfo fail",
-			zap.String("key", k), zap.Error(err))
-		return fmt.Errorf("metaTable AlterAlias Marshal CollectionInfo fail key:%s, err:%w", k, err)
+	meta := make(map[string]string)
+	for alias, id := range aliases {
+		k := aliasKey(database, alias)
+		v, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: alias}, DbName: database})
+		if err != nil {
+			log.Error("MetaTable AlterAlias Marshal CollectionInfo fail",
+				zap.String("key", k), zap.Error(err))
+			return fmt.Errorf("metaTable AlterAlias Marshal CollectionInfo fail key:%s, err:%w", k, err)
+		}
+		meta[k] = string(v)
 	}
 
-	err = mt.snapshot.Save(k, string(v), ts)
+	err := mt.snapshot.MultiSave(meta, ts)
 	if err != nil {
-		log.Error("SnapShotKV Save fail", zap.Error(err))
-		panic("SnapShotKV Save fail")
+		log.Error("SnapShotKV MultiSave fail", zap.Error(err))
+		panic("SnapShotKV MultiSave fail")
+	}
+	for alias, id := range aliases {
+		putNameID(mt.collAlias2ID, database, alias, id)
 	}
 	return nil
 }
 
-// IsAlias returns true if specific `collectionAlias` is an alias of collection.
-func (mt *MetaTable) IsAlias(collectionAlias string) bool {
+// IsAlias returns true if specific `collectionAlias` is an alias of collection in the database.
+func (mt *MetaTable) IsAlias(database string, collectionAlias string) bool {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
-	_, ok := mt.collAlias2ID[collectionAlias]
+	_, ok := mt.getAliasID(database, collectionAlias)
 	return ok
 }
 
@@ -1388,7 +1484,13 @@ func (mt *MetaTable) DeleteCredential(username string) error {
 
 	k := fmt.Sprintf("%s/%s", CredentialPrefix, username)
 
-	err := mt.txn.Remove(k)
+	// the roles bound to the user and the API keys of the user are removed along with it
+	removes, err := mt.userRBACKeys(username)
+	if err != nil {
+		log.Error("MetaTable load api keys fail", zap.Error(err))
+		return fmt.Errorf("remove credential fail key:%s, err:%w", username, err)
+	}
+	err = mt.txn.MultiRemove(append(removes, k))
 	if err != nil {
 		log.Error("MetaTable remove fail", zap.Error(err))
 		return fmt.Errorf("remove credential fail key:%s, err:%w", username, err)
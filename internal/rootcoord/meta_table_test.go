@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
@@ -46,6 +47,7 @@ type mockTestKV struct {
 	loadWithPrefix               func(key string, ts typeutil.Timestamp) ([]string, []string, error)
 	save                         func(key, value string, ts typeutil.Timestamp) error
 	multiSave                    func(kvs map[string]string, ts typeutil.Timestamp) error
+	multiSaveAndRemove           func(saves map[string]string, removals []string, ts typeutil.Timestamp) error
 	multiSaveAndRemoveWithPrefix func(saves map[string]string, removals []string, ts typeutil.Timestamp) error
 }
 
@@ -64,6 +66,10 @@ func (m *mockTestKV) MultiSave(kvs map[string]string, ts typeutil.Timestamp) err
 	return m.multiSave(kvs, ts)
 }
 
+func (m *mockTestKV) MultiSaveAndRemove(saves map[string]string, removals []string, ts typeutil.Timestamp) error {
+	return m.multiSaveAndRemove(saves, removals, ts)
+}
+
 func (m *mockTestKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string, ts typeutil.Timestamp) error {
 	return m.multiSaveAndRemoveWithPrefix(saves, removals, ts)
 }
@@ -75,6 +81,7 @@ type mockTestTxnKV struct {
 	multiSave                    func(kvs map[string]string) error
 	multiSaveAndRemoveWithPrefix func(saves map[string]string, removals []string) error
 	remove                       func(key string) error
+	multiRemove                  func(keys []string) error
 }
 
 func (m *mockTestTxnKV) LoadWithPrefix(key string) ([]string, []string, error) {
@@ -97,6 +104,10 @@ func (m *mockTestTxnKV) Remove(key string) error {
 	return m.remove(key)
 }
 
+func (m *mockTestTxnKV) MultiRemove(keys []string) error {
+	return m.multiRemove(keys)
+}
+
 func Test_MockKV(t *testing.T) {
 	k1 := &mockTestKV{}
 	kt := &mockTestTxnKV{}
@@ -294,7 +305,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, uint64(1), ts)
 
-		collMeta, err := mt.GetCollectionByName(collName, 0)
+		collMeta, err := mt.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, collMeta.CreateTime, ts)
 		assert.Equal(t, collMeta.PartitionCreatedTimestamps[0], ts)
@@ -303,7 +314,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		assert.True(t, mt.HasCollection(collInfo.ID, 0))
 
-		field, err := mt.GetFieldSchema(collName, "field110")
+		field, err := mt.GetFieldSchema("", collName, "field110")
 		assert.Nil(t, err)
 		assert.Equal(t, collInfo.Schema.Fields[0].FieldID, field.FieldID)
 
@@ -317,22 +328,22 @@ func TestMetaTable(t *testing.T) {
 	t.Run("add alias", func(t *testing.T) {
 		defer wg.Done()
 		ts := ftso()
-		exists := mt.IsAlias(aliasName1)
+		exists := mt.IsAlias("", aliasName1)
 		assert.False(t, exists)
-		err = mt.AddAlias(aliasName1, collName, ts)
+		err = mt.AddAlias("", aliasName1, collName, ts)
 		assert.Nil(t, err)
 		aliases := mt.ListAliases(collID)
 		assert.Equal(t, aliases, []string{aliasName1})
-		exists = mt.IsAlias(aliasName1)
+		exists = mt.IsAlias("", aliasName1)
 		assert.True(t, exists)
 	})
 	wg.Add(1)
 	t.Run("alter alias", func(t *testing.T) {
 		defer wg.Done()
 		ts := ftso()
-		err = mt.AlterAlias(aliasName1, collName, ts)
+		err = mt.AlterAlias("", aliasName1, collName, ts)
 		assert.Nil(t, err)
-		err = mt.AlterAlias(aliasName1, collNameInvalid, ts)
+		err = mt.AlterAlias("", aliasName1, collNameInvalid, ts)
 		assert.NotNil(t, err)
 	})
 
@@ -340,7 +351,7 @@ func TestMetaTable(t *testing.T) {
 	t.Run("delete alias", func(t *testing.T) {
 		defer wg.Done()
 		ts := ftso()
-		err = mt.DropAlias(aliasName1, ts)
+		err = mt.DropAlias("", aliasName1, ts)
 		assert.Nil(t, err)
 	})
 
@@ -407,7 +418,7 @@ func TestMetaTable(t *testing.T) {
 			IndexParams: params,
 		}
 
-		_, _, err := mt.GetNotIndexedSegments("collTest", "field110", idxInfo, nil)
+		_, _, err := mt.GetNotIndexedSegments("", "collTest", "field110", idxInfo, nil)
 		assert.NotNil(t, err)
 	})
 
@@ -431,9 +442,9 @@ func TestMetaTable(t *testing.T) {
 			IndexParams: params,
 		}
 
-		_, _, err := mt.GetNotIndexedSegments("collTest", "field110", idxInfo, nil)
+		_, _, err := mt.GetNotIndexedSegments("", "collTest", "field110", idxInfo, nil)
 		assert.NotNil(t, err)
-		_, _, err = mt.GetNotIndexedSegments(collName, "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
+		_, _, err = mt.GetNotIndexedSegments("", collName, "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
 		assert.NotNil(t, err)
 
 		params = []*commonpb.KeyValuePair{
@@ -446,14 +457,14 @@ func TestMetaTable(t *testing.T) {
 		idxInfo.IndexID = 2001
 		idxInfo.IndexName = "field110-1"
 
-		_, _, err = mt.GetNotIndexedSegments(collName, "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
+		_, _, err = mt.GetNotIndexedSegments("", collName, "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
 		assert.NotNil(t, err)
 	})
 
 	wg.Add(1)
 	t.Run("get index by name", func(t *testing.T) {
 		defer wg.Done()
-		_, idx, err := mt.GetIndexByName(collName, indexName)
+		_, idx, err := mt.GetIndexByName("", collName, indexName)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(idx))
 		assert.Equal(t, idxInfo[0].IndexID, idx[0].IndexID)
@@ -469,7 +480,7 @@ func TestMetaTable(t *testing.T) {
 		}
 		assert.True(t, EqualKeyPairArray(idx[0].IndexParams, params))
 
-		_, idx, err = mt.GetIndexByName(collName, "idx201")
+		_, idx, err = mt.GetIndexByName("", collName, "idx201")
 		assert.Nil(t, err)
 		assert.Zero(t, len(idx))
 	})
@@ -490,20 +501,20 @@ func TestMetaTable(t *testing.T) {
 	wg.Add(1)
 	t.Run("drop index", func(t *testing.T) {
 		defer wg.Done()
-		idx, ok, err := mt.DropIndex(collName, "field110", indexName)
+		idx, ok, err := mt.DropIndex("", collName, "field110", indexName)
 		assert.Nil(t, err)
 		assert.True(t, ok)
 		assert.Equal(t, idxInfo[0].IndexID, idx)
 
-		_, ok, err = mt.DropIndex(collName, "field110", "field110-error")
+		_, ok, err = mt.DropIndex("", collName, "field110", "field110-error")
 		assert.Nil(t, err)
 		assert.False(t, ok)
 
-		_, idxs, err := mt.GetIndexByName(collName, "field110")
+		_, idxs, err := mt.GetIndexByName("", collName, "field110")
 		assert.Nil(t, err)
 		assert.Zero(t, len(idxs))
 
-		_, idxs, err = mt.GetIndexByName(collName, "field110-1")
+		_, idxs, err = mt.GetIndexByName("", collName, "field110-1")
 		assert.Nil(t, err)
 		assert.Zero(t, len(idxs))
 
@@ -532,12 +543,12 @@ func TestMetaTable(t *testing.T) {
 		err = mt.DeleteCollection(collIDInvalid, ts, "")
 		assert.NotNil(t, err)
 		ts2 := ftso()
-		err = mt.AddAlias(aliasName2, collName, ts2)
+		err = mt.AddAlias("", aliasName2, collName, ts2)
 		assert.Nil(t, err)
 		err = mt.DeleteCollection(collID, ts, "")
 		assert.Nil(t, err)
 		ts3 := ftso()
-		err = mt.DropAlias(aliasName2, ts3)
+		err = mt.DropAlias("", aliasName2, ts3)
 		assert.NotNil(t, err)
 
 		// check DD operation flag
@@ -594,8 +605,8 @@ func TestMetaTable(t *testing.T) {
 		mockKV.multiSave = func(kvs map[string]string, ts typeutil.Timestamp) error {
 			return nil
 		}
-		mockKV.multiSaveAndRemoveWithPrefix = func(save map[string]string, keys []string, ts typeutil.Timestamp) error {
-			return fmt.Errorf("multi save and remove with prefix error")
+		mockKV.multiSaveAndRemove = func(save map[string]string, keys []string, ts typeutil.Timestamp) error {
+			return fmt.Errorf("multi save and remove error")
 		}
 		ts := ftso()
 		assert.Panics(t, func() { mt.DeleteCollection(collInfo.ID, ts, "") })
@@ -616,7 +627,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		mt.collID2Meta = make(map[int64]pb.CollectionInfo)
-		_, err = mt.GetCollectionByName(collInfo.Schema.Name, 0)
+		_, err = mt.GetCollectionByName("", collInfo.Schema.Name, 0)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("can't find collection %s with id %d", collInfo.Schema.Name, collInfo.ID))
 
@@ -828,16 +839,16 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, "")
 		assert.Nil(t, err)
 
-		_, _, err = mt.DropIndex("abc", "abc", "abc")
+		_, _, err = mt.DropIndex("", "abc", "abc", "abc")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection name = abc not exist")
 
-		mt.collName2ID["abc"] = 2
-		_, _, err = mt.DropIndex("abc", "abc", "abc")
+		putNameID(mt.collName2ID, "", "abc", 2)
+		_, _, err = mt.DropIndex("", "abc", "abc", "abc")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection name  = abc not has meta")
 
-		_, _, err = mt.DropIndex(collInfo.Schema.Name, "abc", "abc")
+		_, _, err = mt.DropIndex("", collInfo.Schema.Name, "abc", "abc")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s doesn't have filed abc", collInfo.Schema.Name))
 
@@ -854,7 +865,7 @@ func TestMetaTable(t *testing.T) {
 		}
 		mt.collID2Meta[coll.ID] = coll
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		idxID, isDroped, err := mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName)
+		idxID, isDroped, err := mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName)
 		assert.Zero(t, idxID)
 		assert.False(t, isDroped)
 		assert.Nil(t, err)
@@ -870,7 +881,7 @@ func TestMetaTable(t *testing.T) {
 		mockTxnKV.multiSaveAndRemoveWithPrefix = func(saves map[string]string, removals []string) error {
 			return fmt.Errorf("multi save and remove with prefix error")
 		}
-		assert.Panics(t, func() { mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName) })
+		assert.Panics(t, func() { mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName) })
 	})
 
 	wg.Add(1)
@@ -947,12 +958,12 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		mt.collID2Meta = make(map[int64]pb.CollectionInfo)
-		_, err = mt.unlockGetFieldSchema(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
+		_, err = mt.unlockGetFieldSchema("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s not found", collInfo.Schema.Name))
 
-		mt.collName2ID = make(map[string]int64)
-		_, err = mt.unlockGetFieldSchema(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
+		mt.collName2ID = make(map[string]map[string]typeutil.UniqueID)
+		_, err = mt.unlockGetFieldSchema("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s not found", collInfo.Schema.Name))
 	})
@@ -1001,8 +1012,8 @@ func TestMetaTable(t *testing.T) {
 			},
 		}
 
-		mt.collName2ID["abc"] = 123
-		_, _, err = mt.GetNotIndexedSegments("abc", "no-field", idx, nil)
+		putNameID(mt.collName2ID, "", "abc", 123)
+		_, _, err = mt.GetNotIndexedSegments("", "abc", "no-field", idx, nil)
 		assert.NotNil(t, err)
 
 		mockKV.multiSave = func(kvs map[string]string, ts typeutil.Timestamp) error {
@@ -1021,7 +1032,7 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, "")
 		assert.Nil(t, err)
 
-		_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, "no-field", idx, nil)
+		_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, "no-field", idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s doesn't have filed no-field", collInfo.Schema.Name))
 
@@ -1031,7 +1042,7 @@ func TestMetaTable(t *testing.T) {
 			return fmt.Errorf("multi save error")
 		}
 		assert.Panics(t, func() {
-			_, _, _ = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0], []UniqueID{10001, 10002})
+			_, _, _ = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0], []UniqueID{10001, 10002})
 		})
 		mt.indexID2Meta = bakMeta
 	})
@@ -1045,8 +1056,8 @@ func TestMetaTable(t *testing.T) {
 		err := mt.reloadFromKV()
 		assert.Nil(t, err)
 
-		mt.collName2ID["abc"] = 123
-		_, _, err = mt.GetIndexByName("abc", "hij")
+		putNameID(mt.collName2ID, "", "abc", 123)
+		_, _, err = mt.GetIndexByName("", "abc", "hij")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection abc not found")
 
@@ -1066,7 +1077,7 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, "")
 		assert.Nil(t, err)
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		_, _, err = mt.GetIndexByName(collInfo.Schema.Name, idxInfo[0].IndexName)
+		_, _, err = mt.GetIndexByName("", collInfo.Schema.Name, idxInfo[0].IndexName)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("index id = %d not found", idxInfo[0].IndexID))
 
@@ -1088,11 +1099,20 @@ func TestMetaTable(t *testing.T) {
 	wg.Add(1)
 	t.Run("delete credential failed", func(t *testing.T) {
 		defer wg.Done()
-		mockTxnKV.remove = func(key string) error {
-			return fmt.Errorf("delete error")
+		mockTxnKV.loadWithPrefix = func(key string) ([]string, []string, error) {
+			return nil, nil, fmt.Errorf("load error")
 		}
 		err := mt.DeleteCredential("")
 		assert.Error(t, err)
+
+		mockTxnKV.loadWithPrefix = func(key string) ([]string, []string, error) {
+			return nil, nil, nil
+		}
+		mockTxnKV.multiRemove = func(keys []string) error {
+			return fmt.Errorf("delete error")
+		}
+		err = mt.DeleteCredential("")
+		assert.Error(t, err)
 	})
 	wg.Wait()
 }
@@ -1191,29 +1211,29 @@ func TestMetaWithTimestamp(t *testing.T) {
 	c2, err = mt.GetCollectionByID(collID2, tsoStart)
 	assert.NotNil(t, err)
 
-	c1, err = mt.GetCollectionByName(collName1, 0)
+	c1, err = mt.GetCollectionByName("", collName1, 0)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, 0)
+	c2, err = mt.GetCollectionByName("", collName2, 0)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 	assert.Equal(t, int64(2), c2.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, t2)
+	c1, err = mt.GetCollectionByName("", collName1, t2)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, t2)
+	c2, err = mt.GetCollectionByName("", collName2, t2)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 	assert.Equal(t, int64(2), c2.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, t1)
+	c1, err = mt.GetCollectionByName("", collName1, t1)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, t1)
+	c2, err = mt.GetCollectionByName("", collName2, t1)
 	assert.NotNil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, tsoStart)
+	c1, err = mt.GetCollectionByName("", collName1, tsoStart)
 	assert.NotNil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, tsoStart)
+	c2, err = mt.GetCollectionByName("", collName2, tsoStart)
 	assert.NotNil(t, err)
 
 	getKeys := func(m map[string]*pb.CollectionInfo) []string {
@@ -1224,22 +1244,22 @@ func TestMetaWithTimestamp(t *testing.T) {
 		return keys
 	}
 
-	s1, err := mt.ListCollections(0)
+	s1, err := mt.ListCollections("", 0)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1, collName2})
 
-	s1, err = mt.ListCollections(t2)
+	s1, err = mt.ListCollections("", t2)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1, collName2})
 
-	s1, err = mt.ListCollections(t1)
+	s1, err = mt.ListCollections("", t1)
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1})
 
-	s1, err = mt.ListCollections(tsoStart)
+	s1, err = mt.ListCollections("", tsoStart)
 	assert.Nil(t, err)
 	assert.Equal(t, 0, len(s1))
 
@@ -1270,14 +1290,119 @@ func TestMetaWithTimestamp(t *testing.T) {
 	assert.NotNil(t, err)
 
 	var cID UniqueID
-	cID, err = mt.GetCollectionIDByName(collName1)
+	cID, err = mt.GetCollectionIDByName("", collName1)
 	assert.NoError(t, err)
 	assert.Equal(t, collID1, cID)
 
-	_, err = mt.GetCollectionIDByName("badname")
+	_, err = mt.GetCollectionIDByName("", "badname")
 	assert.Error(t, err)
 }
 
+func TestMetaTable_AlterAliasSwap(t *testing.T) {
+	const (
+		collName1  = "blue"
+		collName2  = "green"
+		aliasName1 = "serving"
+		aliasName2 = "staging"
+	)
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	var vtso typeutil.Timestamp = 100
+	ftso := func() typeutil.Timestamp {
+		vtso++
+		return vtso
+	}
+	etcdCli, err := etcd.GetEtcdClient(&Params.EtcdCfg)
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	txnKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	for i, collName := range []string{collName1, collName2} {
+		err = mt.AddCollection(&pb.CollectionInfo{
+			ID:     typeutil.UniqueID(i + 1),
+			Schema: &schemapb.CollectionSchema{Name: collName},
+		}, ftso(), nil, "")
+		assert.Nil(t, err)
+	}
+	err = mt.AddAlias("", aliasName1, collName1, ftso())
+	assert.Nil(t, err)
+	err = mt.AddAlias("", aliasName2, collName2, ftso())
+	assert.Nil(t, err)
+
+	err = mt.AlterAlias("", aliasName1, aliasName1, ftso())
+	assert.NotNil(t, err)
+
+	err = mt.AlterAlias("", aliasName1, aliasName2, ftso())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{aliasName2}, mt.ListAliases(1))
+	assert.Equal(t, []string{aliasName1}, mt.ListAliases(2))
+
+	// the swapped aliases are persisted
+	mt, err = NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+	coll, err := mt.GetCollectionByName("", aliasName1, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(2), coll.ID)
+	coll, err = mt.GetCollectionByName("", aliasName2, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), coll.ID)
+
+	err = mt.AlterAlias("", aliasName2, collName2, ftso())
+	assert.Nil(t, err)
+	assert.Empty(t, mt.ListAliases(1))
+	assert.ElementsMatch(t, []string{aliasName1, aliasName2}, mt.ListAliases(2))
+}
+
+func TestMetaTable_DropAliasCollidingWithDatabase(t *testing.T) {
+	var vtso typeutil.Timestamp = 100
+	ftso := func() typeutil.Timestamp {
+		vtso++
+		return vtso
+	}
+	txnKV := memkv.NewMemoryKV()
+	skv, err := newSuffixSnapshot(memkv.NewMemoryKV(), "_ts", "", snapshotPrefix)
+	assert.Nil(t, err)
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	assert.Nil(t, mt.CreateDatabase("db1", nil))
+	err = mt.AddCollection(&pb.CollectionInfo{ID: 1, Schema: &schemapb.CollectionSchema{Name: "coll"}}, ftso(), nil, "")
+	assert.Nil(t, err)
+	err = mt.AddCollection(&pb.CollectionInfo{ID: 2, Schema: &schemapb.CollectionSchema{Name: "coll"}, DbName: "db1"}, ftso(), nil, "")
+	assert.Nil(t, err)
+
+	// the keys of the aliases of the default database prefix the keys of the others
+	assert.Nil(t, mt.AddAlias("", "db1", "coll", ftso()))
+	assert.Nil(t, mt.AddAlias("", "db1x", "coll", ftso()))
+	assert.Nil(t, mt.AddAlias("db1", "alias", "coll", ftso()))
+
+	checkAliases := func(database string, collID typeutil.UniqueID, expected []string) {
+		mt, err := NewMetaTable(txnKV, skv)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, expected, mt.ListAliases(collID), database)
+		for _, alias := range expected {
+			assert.True(t, mt.IsAlias(database, alias))
+		}
+	}
+
+	assert.Nil(t, mt.DropAlias("", "db1", ftso()))
+	checkAliases(common.DefaultDatabase, 1, []string{"db1x"})
+	checkAliases("db1", 2, []string{"alias"})
+
+	assert.Nil(t, mt.AddAlias("", "db1", "coll", ftso()))
+	assert.Nil(t, mt.DeleteCollection(1, ftso(), ""))
+	checkAliases(common.DefaultDatabase, 1, nil)
+	checkAliases("db1", 2, []string{"alias"})
+}
+
 func TestFixIssue10540(t *testing.T) {
 	rand.Seed(time.Now().UnixNano())
 	randVal := rand.Int()
@@ -1338,12 +1463,12 @@ func TestMetaTable_GetSegmentIndexInfos(t *testing.T) {
 func TestMetaTable_unlockGetCollectionInfo(t *testing.T) {
 	t.Run("normal case", func(t *testing.T) {
 		mt := &MetaTable{
-			collName2ID: map[string]typeutil.UniqueID{"test": 100},
+			collName2ID: map[string]map[string]typeutil.UniqueID{common.DefaultDatabase: {"test": 100}},
 			collID2Meta: map[typeutil.UniqueID]pb.CollectionInfo{
 				100: {ID: 100, Schema: &schemapb.CollectionSchema{Name: "test"}},
 			},
 		}
-		info, err := mt.unlockGetCollectionInfo("test")
+		info, err := mt.unlockGetCollectionInfo("", "test")
 		assert.NoError(t, err)
 		assert.Equal(t, UniqueID(100), info.ID)
 		assert.Equal(t, "test", info.GetSchema().GetName())
@@ -1351,27 +1476,27 @@ func TestMetaTable_unlockGetCollectionInfo(t *testing.T) {
 
 	t.Run("collection name not found", func(t *testing.T) {
 		mt := &MetaTable{collName2ID: nil, collAlias2ID: nil}
-		_, err := mt.unlockGetCollectionInfo("test")
+		_, err := mt.unlockGetCollectionInfo("", "test")
 		assert.Error(t, err)
 	})
 
 	t.Run("name found, meta not found", func(t *testing.T) {
 		mt := &MetaTable{
-			collName2ID:  map[string]typeutil.UniqueID{"test": 100},
+			collName2ID:  map[string]map[string]typeutil.UniqueID{common.DefaultDatabase: {"test": 100}},
 			collAlias2ID: nil,
 			collID2Meta:  nil,
 		}
-		_, err := mt.unlockGetCollectionInfo("test")
+		_, err := mt.unlockGetCollectionInfo("", "test")
 		assert.Error(t, err)
 	})
 
 	t.Run("alias found, meta not found", func(t *testing.T) {
 		mt := &MetaTable{
 			collName2ID:  nil,
-			collAlias2ID: map[string]typeutil.UniqueID{"test": 100},
+			collAlias2ID: map[string]map[string]typeutil.UniqueID{common.DefaultDatabase: {"test": 100}},
 			collID2Meta:  nil,
 		}
-		_, err := mt.unlockGetCollectionInfo("test")
+		_, err := mt.unlockGetCollectionInfo("", "test")
 		assert.Error(t, err)
 	})
 }
@@ -1489,3 +1614,14 @@ func TestMetaTable_checkFieldIndexDuplicate(t *testing.T) {
 		assert.False(t, duplicate)
 	})
 }
+
+func TestGetScalarIndexType(t *testing.T) {
+	assert.Equal(t, "STL_SORT", getScalarIndexType(schemapb.DataType_Int64, nil))
+	assert.Equal(t, "INVERTED", getScalarIndexType(schemapb.DataType_VarChar, nil))
+	assert.Equal(t, "Trie", getScalarIndexType(schemapb.DataType_VarChar,
+		[]*commonpb.KeyValuePair{{Key: "index_type", Value: "Trie"}}))
+	assert.Equal(t, "Trie", getScalarIndexType(schemapb.DataType_VarChar,
+		[]*commonpb.KeyValuePair{{Key: "params", Value: `{"index_type": "Trie"}`}}))
+	assert.Equal(t, "STL_SORT", getScalarIndexType(schemapb.DataType_Int64,
+		[]*commonpb.KeyValuePair{{Key: "index_type", Value: "scalar"}}))
+}
@@ -0,0 +1,31 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// DatabaseQuotas limits the requests to all the collections of a database, zero means unlimited.
+type DatabaseQuotas struct {
+	DMLRowsPerSec       float64 `json:"dml_rows_per_sec,omitempty"`
+	SearchQPS           float64 `json:"search_qps,omitempty"`
+	MaxQueryConcurrency int64   `json:"max_query_concurrency,omitempty"`
+	MaxCollections      int64   `json:"max_collections,omitempty"`
+}
+
+// Database is a namespace of collections with its quotas.
+type Database struct {
+	Name   string         `json:"name"`
+	Quotas DatabaseQuotas `json:"quotas"`
+}
@@ -0,0 +1,39 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package model holds the meta rootcoord stores in etcd as json, which the proxies watch.
+package model
+
+// Grant allows the operations on the collection, "*" matches all the collections or all the operations.
+// The operations are the names of the RPCs of proxy, such as Search and Insert.
+type Grant struct {
+	// Database of the collection, the default database if empty and all the databases if "*"
+	Database   string   `json:"database,omitempty"`
+	Collection string   `json:"collection"`
+	Operations []string `json:"operations"`
+}
+
+// Role is a named set of grants bound to users.
+type Role struct {
+	Name   string  `json:"name"`
+	Grants []Grant `json:"grants"`
+}
+
+// UserRoles is the roles bound to a user.
+type UserRoles struct {
+	User  string   `json:"user"`
+	Roles []string `json:"roles"`
+}
@@ -0,0 +1,313 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/rootcoord/model"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// The roles, the roles bound to users and the API keys are stored under util.RBACPrefix,
+// the proxies watch the prefix and authorize every request with them.
+
+func roleKey(role string) string {
+	return fmt.Sprintf("%s/%s", util.RBACRolePrefix, role)
+}
+
+func userRoleKey(username string) string {
+	return fmt.Sprintf("%s/%s", util.RBACUserRolePrefix, username)
+}
+
+func apiKeyKey(hash string) string {
+	return fmt.Sprintf("%s/%s", util.RBACAPIKeyPrefix, hash)
+}
+
+func validateGrants(grants []model.Grant) error {
+	for _, grant := range grants {
+		if grant.Collection == "" {
+			return fmt.Errorf("collection of grant is empty")
+		}
+		if len(grant.Operations) == 0 {
+			return fmt.Errorf("no operation granted on collection %s", grant.Collection)
+		}
+		for _, operation := range grant.Operations {
+			if operation == "" {
+				return fmt.Errorf("empty operation granted on collection %s", grant.Collection)
+			}
+		}
+	}
+	return nil
+}
+
+// SaveRole creates the role or replaces the grants of it
+func (mt *MetaTable) SaveRole(role *model.Role) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if role.Name == "" || strings.Contains(role.Name, "/") {
+		return fmt.Errorf("invalid role name: %q", role.Name)
+	}
+	if len(role.Grants) == 0 {
+		return fmt.Errorf("no grant of role %s", role.Name)
+	}
+	if err := validateGrants(role.Grants); err != nil {
+		return err
+	}
+	v, err := json.Marshal(role)
+	if err != nil {
+		return fmt.Errorf("metaTable marshal role fail role:%s, err:%w", role.Name, err)
+	}
+	return mt.txn.Save(roleKey(role.Name), string(v))
+}
+
+// DropRole removes the role and unbinds it from the users in one transaction
+func (mt *MetaTable) DropRole(name string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, err := mt.txn.Load(roleKey(name)); err != nil {
+		return fmt.Errorf("role %s not found", name)
+	}
+	keys, values, err := mt.txn.LoadWithPrefix(util.RBACUserRolePrefix)
+	if err != nil {
+		return err
+	}
+	saves := make(map[string]string)
+	removes := []string{roleKey(name)}
+	for i, value := range values {
+		var roles []string
+		if err := json.Unmarshal([]byte(value), &roles); err != nil {
+			continue
+		}
+		remains := make([]string, 0, len(roles))
+		for _, role := range roles {
+			if role != name {
+				remains = append(remains, role)
+			}
+		}
+		if len(remains) == len(roles) {
+			continue
+		}
+		key := userRoleKey(path.Base(keys[i]))
+		if len(remains) == 0 {
+			removes = append(removes, key)
+			continue
+		}
+		v, err := json.Marshal(remains)
+		if err != nil {
+			return fmt.Errorf("metaTable marshal user roles fail key:%s, err:%w", key, err)
+		}
+		saves[key] = string(v)
+	}
+	return mt.txn.MultiSaveAndRemove(saves, removes)
+}
+
+// ListRoles returns all the roles sorted by name
+func (mt *MetaTable) ListRoles() ([]model.Role, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	_, values, err := mt.txn.LoadWithPrefix(util.RBACRolePrefix)
+	if err != nil {
+		return nil, err
+	}
+	roles := make([]model.Role, 0, len(values))
+	for _, value := range values {
+		role := model.Role{}
+		if err := json.Unmarshal([]byte(value), &role); err != nil {
+			log.Warn("invalid role, ignored", zap.String("value", value), zap.Error(err))
+			continue
+		}
+		roles = append(roles, role)
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Name < roles[j].Name })
+	return roles, nil
+}
+
+// SaveUserRoles binds the roles to the user, the roles bound before are unbound if roles is empty
+func (mt *MetaTable) SaveUserRoles(username string, roles []string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, err := mt.txn.Load(fmt.Sprintf("%s/%s", CredentialPrefix, username)); err != nil {
+		return fmt.Errorf("user %s not found", username)
+	}
+	if len(roles) == 0 {
+		return mt.txn.Remove(userRoleKey(username))
+	}
+	for _, role := range roles {
+		if _, err := mt.txn.Load(roleKey(role)); err != nil {
+			return fmt.Errorf("role %s not found", role)
+		}
+	}
+	v, err := json.Marshal(roles)
+	if err != nil {
+		return fmt.Errorf("metaTable marshal user roles fail username:%s, err:%w", username, err)
+	}
+	return mt.txn.Save(userRoleKey(username), string(v))
+}
+
+// ListUserRoles returns the roles bound to users sorted by username
+func (mt *MetaTable) ListUserRoles() ([]model.UserRoles, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	keys, values, err := mt.txn.LoadWithPrefix(util.RBACUserRolePrefix)
+	if err != nil {
+		return nil, err
+	}
+	userRoles := make([]model.UserRoles, 0, len(values))
+	for i, value := range values {
+		var roles []string
+		if err := json.Unmarshal([]byte(value), &roles); err != nil {
+			log.Warn("invalid user roles, ignored", zap.String("key", keys[i]), zap.Error(err))
+			continue
+		}
+		userRoles = append(userRoles, model.UserRoles{User: path.Base(keys[i]), Roles: roles})
+	}
+	sort.Slice(userRoles, func(i, j int) bool { return userRoles[i].User < userRoles[j].User })
+	return userRoles, nil
+}
+
+// AddAPIKey records the user of the API key by the hash of the key
+func (mt *MetaTable) AddAPIKey(username string, hash string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, err := mt.txn.Load(fmt.Sprintf("%s/%s", CredentialPrefix, username)); err != nil {
+		return fmt.Errorf("user %s not found", username)
+	}
+	return mt.txn.Save(apiKeyKey(hash), username)
+}
+
+// DeleteAPIKey removes the API key by the hash of the key, and returns the user of it
+func (mt *MetaTable) DeleteAPIKey(hash string) (string, error) {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	username, err := mt.txn.Load(apiKeyKey(hash))
+	if err != nil {
+		return "", fmt.Errorf("api key not found")
+	}
+	if err := mt.txn.Remove(apiKeyKey(hash)); err != nil {
+		return "", err
+	}
+	return username, nil
+}
+
+// userRBACKeys returns the keys of the roles and the API keys of the user, which are removed along with the user
+func (mt *MetaTable) userRBACKeys(username string) ([]string, error) {
+	keys, values, err := mt.txn.LoadWithPrefix(util.RBACAPIKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	removes := []string{userRoleKey(username)}
+	for i, value := range values {
+		if value == username {
+			removes = append(removes, apiKeyKey(path.Base(keys[i])))
+		}
+	}
+	return removes, nil
+}
+
+// RenameGrants moves the grants on the collection of the database to its new name, so that the renamed collection
+// is still granted to the roles while a collection created later with the old name is not. The grants on all the
+// databases are kept since they refer to the collections with the name in every database.
+func (mt *MetaTable) RenameGrants(database string, oldName string, newName string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	database = funcutil.DatabaseName(database)
+	_, values, err := mt.txn.LoadWithPrefix(util.RBACRolePrefix)
+	if err != nil {
+		return err
+	}
+	saves := make(map[string]string)
+	for _, value := range values {
+		role := model.Role{}
+		if err := json.Unmarshal([]byte(value), &role); err != nil {
+			continue
+		}
+		renamed := false
+		for i, grant := range role.Grants {
+			if grant.Collection != oldName || funcutil.DatabaseName(grant.Database) != database {
+				continue
+			}
+			role.Grants[i].Collection = newName
+			renamed = true
+		}
+		if !renamed {
+			continue
+		}
+		v, err := json.Marshal(role)
+		if err != nil {
+			return fmt.Errorf("metaTable marshal role fail role:%s, err:%w", role.Name, err)
+		}
+		saves[roleKey(role.Name)] = string(v)
+	}
+	if len(saves) == 0 {
+		return nil
+	}
+	return mt.txn.MultiSave(saves)
+}
+
+func grantsFromEntities(entities []*milvuspb.GrantEntity) []model.Grant {
+	grants := make([]model.Grant, 0, len(entities))
+	for _, entity := range entities {
+		grants = append(grants, model.Grant{
+			Database:   entity.GetDbName(),
+			Collection: entity.GetCollectionName(),
+			Operations: entity.GetOperations(),
+		})
+	}
+	return grants
+}
+
+func roleEntities(roles []model.Role) []*milvuspb.RoleEntity {
+	entities := make([]*milvuspb.RoleEntity, 0, len(roles))
+	for _, role := range roles {
+		entity := &milvuspb.RoleEntity{Name: role.Name}
+		for _, grant := range role.Grants {
+			entity.Grants = append(entity.Grants, &milvuspb.GrantEntity{
+				DbName:         grant.Database,
+				CollectionName: grant.Collection,
+				Operations:     grant.Operations,
+			})
+		}
+		entities = append(entities, entity)
+	}
+	return entities
+}
+
+func userRolesEntities(userRoles []model.UserRoles) []*milvuspb.UserRolesEntity {
+	entities := make([]*milvuspb.UserRolesEntity, 0, len(userRoles))
+	for _, ur := range userRoles {
+		entities = append(entities, &milvuspb.UserRolesEntity{Username: ur.User, RoleNames: ur.Roles})
+	}
+	return entities
+}
@@ -0,0 +1,187 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/rootcoord/model"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+func TestMetaTable_RBAC(t *testing.T) {
+	mt := &MetaTable{txn: memkv.NewMemoryKV()}
+	err := mt.AddCredential(&internalpb.CredentialInfo{Username: "alice", EncryptedPassword: "pwd"})
+	assert.Nil(t, err)
+
+	// invalid roles
+	assert.NotNil(t, mt.SaveRole(&model.Role{Name: ""}))
+	assert.NotNil(t, mt.SaveRole(&model.Role{Name: "a/b"}))
+	assert.NotNil(t, mt.SaveRole(&model.Role{Name: "reader"}))
+	assert.NotNil(t, mt.SaveRole(&model.Role{Name: "reader", Grants: []model.Grant{{Collection: "coll"}}}))
+	assert.NotNil(t, mt.SaveRole(&model.Role{Name: "reader", Grants: []model.Grant{{Operations: []string{"Search"}}}}))
+
+	reader := model.Role{Name: "reader", Grants: []model.Grant{{Collection: "*", Operations: []string{"Search", "Query"}}}}
+	assert.Nil(t, mt.SaveRole(&reader))
+	roles, err := mt.ListRoles()
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Role{reader}, roles)
+
+	assert.NotNil(t, mt.SaveUserRoles("bob", []string{"reader"}))
+	assert.NotNil(t, mt.SaveUserRoles("alice", []string{"writer"}))
+	assert.Nil(t, mt.SaveUserRoles("alice", []string{"reader"}))
+	userRoles, err := mt.ListUserRoles()
+	assert.Nil(t, err)
+	assert.Equal(t, []model.UserRoles{{User: "alice", Roles: []string{"reader"}}}, userRoles)
+
+	assert.NotNil(t, mt.AddAPIKey("bob", "hash"))
+	assert.Nil(t, mt.AddAPIKey("alice", "hash1"))
+	assert.Nil(t, mt.AddAPIKey("alice", "hash2"))
+	username, err := mt.DeleteAPIKey("hash1")
+	assert.Nil(t, err)
+	assert.Equal(t, "alice", username)
+	_, err = mt.DeleteAPIKey("hash1")
+	assert.NotNil(t, err)
+
+	// the roles and the API keys are removed along with the user
+	assert.Nil(t, mt.DeleteCredential("alice"))
+	userRoles, err = mt.ListUserRoles()
+	assert.Nil(t, err)
+	assert.Empty(t, userRoles)
+	_, err = mt.DeleteAPIKey("hash2")
+	assert.NotNil(t, err)
+}
+
+func TestMetaTable_DropRole(t *testing.T) {
+	mt := &MetaTable{txn: memkv.NewMemoryKV()}
+	assert.Nil(t, mt.AddCredential(&internalpb.CredentialInfo{Username: "alice", EncryptedPassword: "pwd"}))
+	assert.Nil(t, mt.AddCredential(&internalpb.CredentialInfo{Username: "bob", EncryptedPassword: "pwd"}))
+	grants := []model.Grant{{Collection: "*", Operations: []string{"Search"}}}
+	assert.Nil(t, mt.SaveRole(&model.Role{Name: "reader", Grants: grants}))
+	assert.Nil(t, mt.SaveRole(&model.Role{Name: "writer", Grants: grants}))
+	assert.Nil(t, mt.SaveUserRoles("alice", []string{"reader", "writer"}))
+	assert.Nil(t, mt.SaveUserRoles("bob", []string{"reader"}))
+
+	assert.NotNil(t, mt.DropRole("admin"))
+	assert.Nil(t, mt.DropRole("reader"))
+	roles, err := mt.ListRoles()
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Role{{Name: "writer", Grants: grants}}, roles)
+
+	// the role is unbound from the users
+	userRoles, err := mt.ListUserRoles()
+	assert.Nil(t, err)
+	assert.Equal(t, []model.UserRoles{{User: "alice", Roles: []string{"writer"}}}, userRoles)
+}
+
+func TestMetaTable_RenameGrants(t *testing.T) {
+	mt := &MetaTable{txn: memkv.NewMemoryKV()}
+	reader := model.Role{Name: "reader", Grants: []model.Grant{
+		{Collection: "coll", Operations: []string{"Search"}},
+		{Database: "db", Collection: "coll", Operations: []string{"Query"}},
+		{Database: "*", Collection: "coll", Operations: []string{"Insert"}},
+		{Collection: "other", Operations: []string{"Search"}},
+	}}
+	assert.Nil(t, mt.SaveRole(&reader))
+	writer := model.Role{Name: "writer", Grants: []model.Grant{{Collection: "*", Operations: []string{"Insert"}}}}
+	assert.Nil(t, mt.SaveRole(&writer))
+
+	assert.Nil(t, mt.RenameGrants(common.DefaultDatabase, "coll", "renamed"))
+	roles, err := mt.ListRoles()
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Role{{Name: "reader", Grants: []model.Grant{
+		{Collection: "renamed", Operations: []string{"Search"}},
+		{Database: "db", Collection: "coll", Operations: []string{"Query"}},
+		{Database: "*", Collection: "coll", Operations: []string{"Insert"}},
+		{Collection: "other", Operations: []string{"Search"}},
+	}}, writer}, roles)
+
+	// only the grants of the database are renamed
+	assert.Nil(t, mt.RenameGrants("db", "coll", "renamed"))
+	roles, err = mt.ListRoles()
+	assert.Nil(t, err)
+	assert.Equal(t, model.Grant{Database: "db", Collection: "renamed", Operations: []string{"Query"}}, roles[0].Grants[1])
+	assert.Equal(t, model.Grant{Database: "*", Collection: "coll", Operations: []string{"Insert"}}, roles[0].Grants[2])
+}
+
+func TestCore_RBAC(t *testing.T) {
+	ctx := context.Background()
+	mt := &MetaTable{txn: memkv.NewMemoryKV()}
+	err := mt.AddCredential(&internalpb.CredentialInfo{Username: "alice", EncryptedPassword: "pwd"})
+	assert.Nil(t, err)
+	c := &Core{MetaTable: mt, session: &sessionutil.Session{ServerID: 1}}
+
+	reader := &milvuspb.RoleEntity{Name: "reader", Grants: []*milvuspb.GrantEntity{
+		{DbName: "db", CollectionName: "coll", Operations: []string{"Search"}},
+	}}
+	status, err := c.SaveRole(ctx, &milvuspb.SaveRoleRequest{Role: reader})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	status, err = c.SaveRole(ctx, &milvuspb.SaveRoleRequest{Role: &milvuspb.RoleEntity{Name: "writer"}})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	roles, err := c.ListRoles(ctx, &milvuspb.ListRolesRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, roles.GetStatus().GetErrorCode())
+	assert.Equal(t, []*milvuspb.RoleEntity{reader}, roles.GetRoles())
+
+	status, err = c.SaveUserRoles(ctx, &milvuspb.SaveUserRolesRequest{RoleNames: []string{"reader"}})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	status, err = c.SaveUserRoles(ctx, &milvuspb.SaveUserRolesRequest{Username: "alice", RoleNames: []string{"reader"}})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	userRoles, err := c.ListUserRoles(ctx, &milvuspb.ListUserRolesRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, []*milvuspb.UserRolesEntity{{Username: "alice", RoleNames: []string{"reader"}}}, userRoles.GetUserRoles())
+
+	status, err = c.DropRole(ctx, &milvuspb.DropRoleRequest{RoleName: "reader"})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	userRoles, err = c.ListUserRoles(ctx, &milvuspb.ListUserRolesRequest{})
+	assert.Nil(t, err)
+	assert.Empty(t, userRoles.GetUserRoles())
+
+	apiKey, err := c.CreateAPIKey(ctx, &milvuspb.CreateAPIKeyRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, apiKey.GetStatus().GetErrorCode())
+	apiKey, err = c.CreateAPIKey(ctx, &milvuspb.CreateAPIKeyRequest{Username: "alice"})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, apiKey.GetStatus().GetErrorCode())
+	assert.NotEmpty(t, apiKey.GetApiKey())
+
+	// only the hash of the key is stored
+	username, err := mt.txn.Load(apiKeyKey(crypto.HashAPIKey(apiKey.GetApiKey())))
+	assert.Nil(t, err)
+	assert.Equal(t, "alice", username)
+
+	status, err = c.RevokeAPIKey(ctx, &milvuspb.RevokeAPIKeyRequest{ApiKey: apiKey.GetApiKey()})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	status, err = c.RevokeAPIKey(ctx, &milvuspb.RevokeAPIKeyRequest{ApiKey: apiKey.GetApiKey()})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+}
@@ -0,0 +1,123 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// The collections are referred by ID in the other coordinators and the nodes, only the name in the schema
+// and the meta caches of the proxies are changed by renaming, the coordinators get the renamed schema by
+// RefreshCollection.
+
+// RenameCollection changes the name of the collection in the database, the aliases of the collection still refer to it
+func (mt *MetaTable) RenameCollection(database string, oldName string, newName string, ts typeutil.Timestamp) (typeutil.UniqueID, error) {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	collID, ok := mt.getCollectionID(database, oldName)
+	if !ok {
+		return 0, fmt.Errorf("can't find collection: %s", oldName)
+	}
+	if _, ok := mt.getCollectionID(database, newName); ok {
+		return 0, fmt.Errorf("collection %s exists", newName)
+	}
+	if _, ok := mt.getAliasID(database, newName); ok {
+		return 0, fmt.Errorf("collection name %s conflicts with an existing alias", newName)
+	}
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return 0, fmt.Errorf("can't find collection %s with id %d", oldName, collID)
+	}
+
+	updated := proto.Clone(&coll).(*pb.CollectionInfo)
+	updated.Schema.Name = newName
+	k := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
+	v, err := proto.Marshal(updated)
+	if err != nil {
+		return 0, fmt.Errorf("metaTable RenameCollection Marshal fail, key:%s, err:%w", k, err)
+	}
+	if err := mt.snapshot.Save(k, string(v), ts); err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		return 0, err
+	}
+	mt.collID2Meta[collID] = *updated
+	deleteNameID(mt.collName2ID, database, oldName)
+	putNameID(mt.collName2ID, database, newName, collID)
+	return collID, nil
+}
+
+// renameCollection renames the collection in the database, expires the meta cache of the proxies and sends the
+// renamed schema to the coordinators
+func (c *Core) renameCollection(ctx context.Context, database string, oldName string, newName string) error {
+	if oldName == "" || newName == "" {
+		return errors.New("both the old name and the new name are required to rename a collection")
+	}
+	if oldName == newName {
+		return fmt.Errorf("new name of collection %s is unchanged", oldName)
+	}
+	ts, err := c.TSOAllocator(1)
+	if err != nil {
+		return err
+	}
+	collID, err := c.MetaTable.RenameCollection(database, oldName, newName, ts)
+	if err != nil {
+		return err
+	}
+	log.Info("collection renamed", zap.Int64("collectionID", collID), zap.String("database", database),
+		zap.String("oldName", oldName), zap.String("newName", newName))
+	grantErr := c.MetaTable.RenameGrants(database, oldName, newName)
+
+	// the aliases are cached with the schema of the collection as well
+	c.ExpireMetaCache(ctx, database, append([]string{oldName, newName}, c.MetaTable.ListAliases(collID)...), ts)
+	c.refreshCollection(ctx, collID)
+	if grantErr != nil {
+		log.Error("failed to move the grants to the renamed collection", zap.Int64("collectionID", collID),
+			zap.String("oldName", oldName), zap.String("newName", newName), zap.Error(grantErr))
+		return fmt.Errorf("collection %s is renamed to %s, but the grants on it are not moved: %w", oldName, newName, grantErr)
+	}
+	return nil
+}
+
+// refreshCollection sends the schema of the collection to the coordinators after it's changed, a failed call leaves
+// the stale schema cached by the coordinator, which is not fatal since the collection is referred by ID there
+func (c *Core) refreshCollection(ctx context.Context, collID typeutil.UniqueID) {
+	coll, err := c.MetaTable.GetCollectionByID(collID, 0)
+	if err != nil {
+		log.Warn("failed to get the collection to refresh", zap.Int64("collectionID", collID), zap.Error(err))
+		return
+	}
+	if c.CallDataCoordRefreshCollection != nil {
+		if err := c.CallDataCoordRefreshCollection(ctx, collID, coll.GetSchema()); err != nil {
+			log.Warn("failed to refresh the collection on datacoord", zap.Int64("collectionID", collID), zap.Error(err))
+		}
+	}
+	if c.CallQueryCoordRefreshCollection != nil {
+		if err := c.CallQueryCoordRefreshCollection(ctx, collID, coll.GetSchema()); err != nil {
+			log.Warn("failed to refresh the collection on querycoord", zap.Int64("collectionID", collID), zap.Error(err))
+		}
+	}
+}
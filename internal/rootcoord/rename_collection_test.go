@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/rootcoord/model"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestMetaTable_RenameCollection(t *testing.T) {
+	var saved string
+	snapshot := &mockTestKV{
+		save: func(key, value string, ts typeutil.Timestamp) error {
+			saved = key
+			return nil
+		},
+	}
+	mt := &MetaTable{
+		snapshot: snapshot,
+		collID2Meta: map[typeutil.UniqueID]pb.CollectionInfo{
+			1: {ID: 1, Schema: &schemapb.CollectionSchema{Name: "coll"}},
+			2: {ID: 2, Schema: &schemapb.CollectionSchema{Name: "other"}},
+		},
+		collName2ID:  map[string]map[string]typeutil.UniqueID{common.DefaultDatabase: {"coll": 1, "other": 2}},
+		collAlias2ID: map[string]map[string]typeutil.UniqueID{common.DefaultDatabase: {"alias": 1}},
+	}
+
+	_, err := mt.RenameCollection("", "none", "renamed", 100)
+	assert.Error(t, err)
+	_, err = mt.RenameCollection("", "coll", "other", 100)
+	assert.Error(t, err)
+	_, err = mt.RenameCollection("", "coll", "alias", 100)
+	assert.Error(t, err)
+
+	snapshot.save = func(key, value string, ts typeutil.Timestamp) error {
+		return errors.New("mock")
+	}
+	_, err = mt.RenameCollection("", "coll", "renamed", 100)
+	assert.Error(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), mt.collName2ID[common.DefaultDatabase]["coll"])
+
+	snapshot.save = func(key, value string, ts typeutil.Timestamp) error {
+		saved = key
+		return nil
+	}
+	collID, err := mt.RenameCollection("", "coll", "renamed", 100)
+	assert.NoError(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), collID)
+	assert.Equal(t, CollectionMetaPrefix+"/1", saved)
+	_, ok := mt.collName2ID[common.DefaultDatabase]["coll"]
+	assert.False(t, ok)
+	assert.Equal(t, typeutil.UniqueID(1), mt.collName2ID[common.DefaultDatabase]["renamed"])
+	assert.Equal(t, "renamed", mt.collID2Meta[1].Schema.Name)
+	assert.Equal(t, typeutil.UniqueID(1), mt.collAlias2ID[common.DefaultDatabase]["alias"])
+}
+
+func TestCore_RenameCollection(t *testing.T) {
+	mt := &MetaTable{
+		snapshot: &mockTestKV{
+			save: func(key, value string, ts typeutil.Timestamp) error {
+				return nil
+			},
+		},
+		collID2Meta: map[typeutil.UniqueID]pb.CollectionInfo{
+			1: {ID: 1, Schema: &schemapb.CollectionSchema{Name: "coll"}},
+		},
+		collName2ID:  map[string]map[string]typeutil.UniqueID{common.DefaultDatabase: {"coll": 1}},
+		collAlias2ID: map[string]map[string]typeutil.UniqueID{},
+		txn:          memkv.NewMemoryKV(),
+	}
+	err := mt.SaveRole(&model.Role{Name: "reader", Grants: []model.Grant{{Collection: "coll", Operations: []string{"Search"}}}})
+	assert.NoError(t, err)
+	var refreshed []string
+	core := &Core{
+		MetaTable: mt,
+		TSOAllocator: func(count uint32) (typeutil.Timestamp, error) {
+			return 100, nil
+		},
+		session: &sessionutil.Session{ServerID: 1},
+		CallDataCoordRefreshCollection: func(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema) error {
+			refreshed = append(refreshed, schema.GetName())
+			return nil
+		},
+		// the failure of the coordinators doesn't fail the rename
+		CallQueryCoordRefreshCollection: func(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema) error {
+			return errors.New("mock")
+		},
+	}
+	core.proxyClientManager = newProxyClientManager(core)
+	ctx := context.Background()
+
+	core.UpdateStateCode(internalpb.StateCode_Abnormal)
+	status, err := core.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{OldName: "coll", NewName: "renamed"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+	core.UpdateStateCode(internalpb.StateCode_Healthy)
+	for _, req := range []*milvuspb.RenameCollectionRequest{
+		{OldName: "coll"},
+		{OldName: "coll", NewName: "coll"},
+		{OldName: "none", NewName: "renamed"},
+	} {
+		status, err = core.RenameCollection(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	}
+	assert.Empty(t, refreshed)
+
+	status, err = core.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{OldName: "coll", NewName: "renamed"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Equal(t, []string{"renamed"}, refreshed)
+	assert.Equal(t, typeutil.UniqueID(1), mt.collName2ID[common.DefaultDatabase]["renamed"])
+	roles, err := mt.ListRoles()
+	assert.NoError(t, err)
+	assert.Equal(t, "renamed", roles[0].Grants[0].Collection)
+}
@@ -51,6 +51,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/rootcoord/model"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util"
@@ -153,6 +154,13 @@ type Core struct {
 	// Seals segments in collection cID, so they can get flushed later.
 	CallFlushOnCollection func(ctx context.Context, cID int64, segIDs []int64) error
 
+	// Sends the changed schema of a collection to the coordinators.
+	CallDataCoordRefreshCollection  func(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema) error
+	CallQueryCoordRefreshCollection func(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema) error
+
+	// Notifies querycoord of the new partition, so it's served by the loaded collection without loading it again.
+	CallSyncNewCreatedPartition func(ctx context.Context, collectionID int64, partitionID int64) error
+
 	//Proxy manager
 	proxyManager *proxyManager
 
@@ -737,6 +745,24 @@ func (c *Core) SetDataCoord(ctx context.Context, s types.DataCoord) error {
 		return nil
 	}
 
+	c.CallDataCoordRefreshCollection = func(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema) error {
+		<-initCh
+		resp, err := s.RefreshCollection(ctx, &datapb.RefreshCollectionRequest{
+			Base: &commonpb.MsgBase{
+				SourceID: c.session.ServerID,
+			},
+			CollectionID: collectionID,
+			Schema:       schema,
+		})
+		if err != nil {
+			return err
+		}
+		if resp.GetErrorCode() != commonpb.ErrorCode_Success {
+			return errors.New(resp.GetReason())
+		}
+		return nil
+	}
+
 	return nil
 }
 
@@ -841,6 +867,41 @@ func (c *Core) SetQueryCoord(s types.QueryCoord) error {
 			log.Debug("Retrying RootCoord connection to QueryCoord")
 		}
 	}()
+	c.CallQueryCoordRefreshCollection = func(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema) error {
+		<-initCh
+		resp, err := s.RefreshCollection(ctx, &querypb.RefreshCollectionRequest{
+			Base: &commonpb.MsgBase{
+				SourceID: c.session.ServerID,
+			},
+			CollectionID: collectionID,
+			Schema:       schema,
+		})
+		if err != nil {
+			return err
+		}
+		if resp.GetErrorCode() != commonpb.ErrorCode_Success {
+			return errors.New(resp.GetReason())
+		}
+		return nil
+	}
+	c.CallSyncNewCreatedPartition = func(ctx context.Context, collectionID int64, partitionID int64) error {
+		<-initCh
+		rsp, err := s.SyncNewCreatedPartition(ctx, &querypb.SyncNewCreatedPartitionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_CreatePartition,
+				SourceID: c.session.ServerID,
+			},
+			CollectionID: collectionID,
+			PartitionID:  partitionID,
+		})
+		if err != nil {
+			return err
+		}
+		if rsp.GetErrorCode() != commonpb.ErrorCode_Success {
+			return fmt.Errorf("syncNewCreatedPartition from query service failed, error = %s", rsp.GetReason())
+		}
+		return nil
+	}
 	c.CallReleaseCollectionService = func(ctx context.Context, ts typeutil.Timestamp, dbID typeutil.UniqueID, collectionID typeutil.UniqueID) (retErr error) {
 		defer func() {
 			if err := recover(); err != nil {
@@ -947,8 +1008,8 @@ func (c *Core) BuildIndex(ctx context.Context, segID typeutil.UniqueID, field *s
 }
 
 // RemoveIndex will call drop index service
-func (c *Core) RemoveIndex(ctx context.Context, collName string, indexName string) error {
-	_, indexInfos, err := c.MetaTable.GetIndexByName(collName, indexName)
+func (c *Core) RemoveIndex(ctx context.Context, database string, collName string, indexName string) error {
+	_, indexInfos, err := c.MetaTable.GetIndexByName(database, collName, indexName)
 	if err != nil {
 		log.Error("GetIndexByName failed,", zap.String("collection name", collName),
 			zap.String("index name", indexName), zap.Error(err))
@@ -964,7 +1025,7 @@ func (c *Core) RemoveIndex(ctx context.Context, collName string, indexName strin
 }
 
 // ExpireMetaCache will call invalidate collection meta cache
-func (c *Core) ExpireMetaCache(ctx context.Context, collNames []string, ts typeutil.Timestamp) {
+func (c *Core) ExpireMetaCache(ctx context.Context, database string, collNames []string, ts typeutil.Timestamp) {
 	for _, collName := range collNames {
 		req := proxypb.InvalidateCollMetaCacheRequest{
 			Base: &commonpb.MsgBase{
@@ -973,12 +1034,27 @@ func (c *Core) ExpireMetaCache(ctx context.Context, collNames []string, ts typeu
 				Timestamp: ts,
 				SourceID:  c.session.ServerID,
 			},
+			DbName:         database,
 			CollectionName: collName,
 		}
 		c.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
 	}
 }
 
+// syncNewCreatedPartition notifies querycoord of the new partition, the failure is logged only since the query nodes
+// add the partition on the first insert anyway
+func (c *Core) syncNewCreatedPartition(ctx context.Context, collectionID typeutil.UniqueID, partitionID typeutil.UniqueID) {
+	if c.CallSyncNewCreatedPartition == nil {
+		return
+	}
+	if err := c.CallSyncNewCreatedPartition(ctx, collectionID, partitionID); err != nil {
+		log.Warn("failed to sync the new partition to querycoord",
+			zap.Int64("collection id", collectionID),
+			zap.Int64("partition id", partitionID),
+			zap.Error(err))
+	}
+}
+
 // Register register rootcoord at etcd
 func (c *Core) Register() error {
 	c.session.Register()
@@ -1185,7 +1261,7 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 
 	invalidateCache := false
 	var ts typeutil.Timestamp
-	var collName string
+	var dbName, collName string
 
 	switch ddOp.Type {
 	// TODO remove create collection resend
@@ -1195,7 +1271,7 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 		if err = proto.Unmarshal(ddOp.Body, &ddReq); err != nil {
 			return err
 		}
-		if _, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0); err != nil {
+		if _, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0); err != nil {
 			if _, err = c.SendDdCreateCollectionReq(ctx, &ddReq, ddReq.PhysicalChannelNames); err != nil {
 				return err
 			}
@@ -1209,8 +1285,8 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 			return err
 		}
 		ts = ddReq.Base.Timestamp
-		collName = ddReq.CollectionName
-		if collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0); err == nil {
+		dbName, collName = ddReq.DbName, ddReq.CollectionName
+		if collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0); err == nil {
 			if err = c.SendDdDropCollectionReq(ctx, &ddReq, collInfo.PhysicalChannelNames); err != nil {
 				return err
 			}
@@ -1225,8 +1301,8 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 			return err
 		}
 		ts = ddReq.Base.Timestamp
-		collName = ddReq.CollectionName
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		dbName, collName = ddReq.DbName, ddReq.CollectionName
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1245,8 +1321,8 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 			return err
 		}
 		ts = ddReq.Base.Timestamp
-		collName = ddReq.CollectionName
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		dbName, collName = ddReq.DbName, ddReq.CollectionName
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1264,7 +1340,7 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 	}
 
 	if invalidateCache {
-		c.ExpireMetaCache(ctx, []string{collName}, ts)
+		c.ExpireMetaCache(ctx, dbName, []string{collName}, ts)
 	}
 
 	// Update DDOperation in etcd
@@ -1290,11 +1366,12 @@ func (c *Core) Start() error {
 			log.Fatal("RootCoord Start reSendDdMsg failed", zap.Error(err))
 			panic(err)
 		}
-		c.wg.Add(6)
+		c.wg.Add(7)
 		go c.startTimeTickLoop()
 		go c.tsLoop()
 		go c.chanTimeTick.startWatch(&c.wg)
 		go c.checkFlushedSegmentsLoop()
+		go c.checkIndexRebuildLoop()
 		go c.importManager.expireOldTasksLoop(&c.wg)
 		go c.importManager.sendOutTasksLoop(&c.wg)
 		Params.RootCoordCfg.CreatedTime = time.Now()
@@ -2160,6 +2237,10 @@ func (c *Core) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest) (
 		return systemInfoMetrics, err
 	}
 
+	if metricType == metricsinfo.ImportTasksMetrics {
+		return c.getImportMetrics(in.Request), nil
+	}
+
 	log.Error("GetMetrics failed, metric type not implemented", zap.String("role", typeutil.RootCoordRole),
 		zap.String("metric_type", metricType), zap.Int64("msgID", in.Base.MsgID))
 
@@ -2236,7 +2317,7 @@ func (c *Core) DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest) (*c
 
 // AlterAlias alter collection alias
 func (c *Core) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
-	metrics.RootCoordDDLReqCounter.WithLabelValues("DropAlias", metrics.TotalLabel).Inc()
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterAlias", metrics.TotalLabel).Inc()
 	if code, ok := c.checkHealthy(); !ok {
 		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
 	}
@@ -2268,6 +2349,188 @@ func (c *Core) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (
 	return succStatus(), nil
 }
 
+// RenameCollection renames a collection, the aliases of the collection still refer to it
+func (c *Core) RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RenameCollection", metrics.TotalLabel).Inc()
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+	tr := timerecord.NewTimeRecorder("RenameCollection")
+	log.Debug("RenameCollection", zap.String("role", typeutil.RootCoordRole),
+		zap.String("oldName", in.GetOldName()), zap.String("newName", in.GetNewName()),
+		zap.Int64("msgID", in.GetBase().GetMsgID()))
+	if err := c.renameCollection(ctx, in.GetDbName(), in.GetOldName(), in.GetNewName()); err != nil {
+		log.Error("RenameCollection failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("oldName", in.GetOldName()), zap.String("newName", in.GetNewName()),
+			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RenameCollection", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "RenameCollection failed: "+err.Error()), nil
+	}
+	log.Debug("RenameCollection success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("oldName", in.GetOldName()), zap.String("newName", in.GetNewName()),
+		zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RenameCollection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("RenameCollection").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
+// AddCollectionField adds a scalar field with a default value to the collection
+func (c *Core) AddCollectionField(ctx context.Context, in *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.TotalLabel).Inc()
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+	tr := timerecord.NewTimeRecorder("AddCollectionField")
+	log.Debug("AddCollectionField", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.GetCollectionName()), zap.String("field", in.GetField().GetName()),
+		zap.Int64("msgID", in.GetBase().GetMsgID()))
+	if err := c.addField(ctx, in.GetDbName(), in.GetCollectionName(), in.GetField()); err != nil {
+		log.Error("AddCollectionField failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("collection name", in.GetCollectionName()), zap.String("field", in.GetField().GetName()),
+			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "AddCollectionField failed: "+err.Error()), nil
+	}
+	log.Debug("AddCollectionField success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.GetCollectionName()), zap.String("field", in.GetField().GetName()),
+		zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("AddCollectionField").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
+// AlterCollection sets or removes the properties of a collection
+func (c *Core) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterCollection", metrics.TotalLabel).Inc()
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+	tr := timerecord.NewTimeRecorder("AlterCollection")
+	log.Debug("AlterCollection", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.GetCollectionName()), zap.Any("properties", in.GetProperties()),
+		zap.Int64("msgID", in.GetBase().GetMsgID()))
+	if err := c.alterCollection(ctx, in.GetDbName(), in.GetCollectionName(), in.GetProperties()); err != nil {
+		log.Error("AlterCollection failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("collection name", in.GetCollectionName()), zap.Any("properties", in.GetProperties()),
+			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AlterCollection", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "AlterCollection failed: "+err.Error()), nil
+	}
+	log.Debug("AlterCollection success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.GetCollectionName()), zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterCollection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("AlterCollection").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
+// CreateDatabase creates a database with the quotas in the properties
+func (c *Core) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateDatabase", metrics.TotalLabel).Inc()
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+	tr := timerecord.NewTimeRecorder("CreateDatabase")
+	log.Debug("CreateDatabase", zap.String("role", typeutil.RootCoordRole),
+		zap.String("db name", in.GetDbName()), zap.Any("properties", in.GetProperties()),
+		zap.Int64("msgID", in.GetBase().GetMsgID()))
+	if err := c.MetaTable.CreateDatabase(in.GetDbName(), in.GetProperties()); err != nil {
+		log.Error("CreateDatabase failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("db name", in.GetDbName()), zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CreateDatabase", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "CreateDatabase failed: "+err.Error()), nil
+	}
+	log.Debug("CreateDatabase success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("db name", in.GetDbName()), zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateDatabase", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("CreateDatabase").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
+// DropDatabase drops a database without collections
+func (c *Core) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropDatabase", metrics.TotalLabel).Inc()
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+	tr := timerecord.NewTimeRecorder("DropDatabase")
+	log.Debug("DropDatabase", zap.String("role", typeutil.RootCoordRole),
+		zap.String("db name", in.GetDbName()), zap.Int64("msgID", in.GetBase().GetMsgID()))
+	if err := c.MetaTable.DropDatabase(in.GetDbName()); err != nil {
+		log.Error("DropDatabase failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("db name", in.GetDbName()), zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("DropDatabase", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "DropDatabase failed: "+err.Error()), nil
+	}
+	log.Debug("DropDatabase success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("db name", in.GetDbName()), zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropDatabase", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("DropDatabase").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
+// AlterDatabase sets the quotas in the properties to a database
+func (c *Core) AlterDatabase(ctx context.Context, in *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterDatabase", metrics.TotalLabel).Inc()
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+	tr := timerecord.NewTimeRecorder("AlterDatabase")
+	log.Debug("AlterDatabase", zap.String("role", typeutil.RootCoordRole),
+		zap.String("db name", in.GetDbName()), zap.Any("properties", in.GetProperties()),
+		zap.Int64("msgID", in.GetBase().GetMsgID()))
+	if err := c.MetaTable.AlterDatabase(in.GetDbName(), in.GetProperties()); err != nil {
+		log.Error("AlterDatabase failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("db name", in.GetDbName()), zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AlterDatabase", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "AlterDatabase failed: "+err.Error()), nil
+	}
+	log.Debug("AlterDatabase success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("db name", in.GetDbName()), zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterDatabase", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("AlterDatabase").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
+// ListDatabases returns the names of the default database and the databases created
+func (c *Core) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	metrics.RootCoordDDLReqCounter.WithLabelValues("ListDatabases", metrics.TotalLabel).Inc()
+	if code, ok := c.checkHealthy(); !ok {
+		return &milvuspb.ListDatabasesResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	tr := timerecord.NewTimeRecorder("ListDatabases")
+	log.Debug("ListDatabases", zap.String("role", typeutil.RootCoordRole), zap.Int64("msgID", in.GetBase().GetMsgID()))
+	dbs, err := c.MetaTable.ListDatabases()
+	if err != nil {
+		log.Error("ListDatabases failed", zap.String("role", typeutil.RootCoordRole),
+			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("ListDatabases", metrics.FailLabel).Inc()
+		return &milvuspb.ListDatabasesResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "ListDatabases failed: "+err.Error()),
+		}, nil
+	}
+	names := make([]string, 0, len(dbs))
+	for _, db := range dbs {
+		names = append(names, db.Name)
+	}
+	log.Debug("ListDatabases success", zap.String("role", typeutil.RootCoordRole),
+		zap.Strings("db names", names), zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("ListDatabases", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("ListDatabases").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &milvuspb.ListDatabasesResponse{
+		Status:  succStatus(),
+		DbNames: names,
+	}, nil
+}
+
 // Import imports large files (json, numpy, etc.) on MinIO/S3 storage into Milvus storage.
 func (c *Core) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
 	if code, ok := c.checkHealthy(); !ok {
@@ -2279,7 +2542,7 @@ func (c *Core) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvus
 	// Get collection/partition ID from collection/partition name.
 	var cID UniqueID
 	var err error
-	if cID, err = c.MetaTable.GetCollectionIDByName(req.GetCollectionName()); err != nil {
+	if cID, err = c.MetaTable.GetCollectionIDByName(req.GetDbName(), req.GetCollectionName()); err != nil {
 		log.Error("failed to find collection ID from its name",
 			zap.String("collection name", req.GetCollectionName()),
 			zap.Error(err))
@@ -2331,6 +2594,54 @@ func (c *Core) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTask
 	return resp, nil
 }
 
+// CancelImport cancels a pending or running import task and returns its state.
+func (c *Core) CancelImport(ctx context.Context, req *milvuspb.CancelImportRequest) (*milvuspb.GetImportStateResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &milvuspb.GetImportStateResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	if req.GetTask() == 0 {
+		return &milvuspb.GetImportStateResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "task id is required to cancel the import task"),
+		}, nil
+	}
+	if err := c.importManager.cancelTask(req.GetTask()); err != nil {
+		log.Warn("failed to cancel import task", zap.Int64("task ID", req.GetTask()), zap.Error(err))
+		return &milvuspb.GetImportStateResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+	return c.importManager.getTaskState(req.GetTask()), nil
+}
+
+// getImportMetrics handles the GetMetrics requests listing the progress of the import tasks
+func (c *Core) getImportMetrics(req string) *milvuspb.GetMetricsResponse {
+	var ret interface{}
+	taskID, err := metricsinfo.ParseTaskID(req)
+	if err == nil {
+		ret, err = c.importManager.listTaskProgress(taskID)
+	}
+	if err != nil {
+		log.Warn("GetMetrics failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("metric_type", metricsinfo.ImportTasksMetrics), zap.Error(err))
+		return &milvuspb.GetMetricsResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}
+	}
+	resp, err := json.Marshal(ret)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status:        succStatus(),
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.RootCoordRole, c.session.ServerID),
+	}
+}
+
 // ReportImport reports import task state to RootCoord.
 func (c *Core) ReportImport(ctx context.Context, ir *rootcoordpb.ImportResult) (*commonpb.Status, error) {
 	log.Info("receive import state report",
@@ -2548,7 +2859,11 @@ func (c *Core) checkCompleteIndexLoop(ctx context.Context, taskID int64, colID i
 			log.Info("(in check complete index loop) context done, exiting checkCompleteIndexLoop")
 			return
 		case <-ticker.C:
-			if ct, err := c.CountCompleteIndex(ctx, colName, colID, segIDs); err == nil && heuristicSegmentsReady(ct, len(segIDs)) {
+			ct, err := c.CountCompleteIndex(ctx, colName, colID, segIDs)
+			if err == nil {
+				c.importManager.setTaskIndexedSegments(taskID, ct)
+			}
+			if err == nil && heuristicSegmentsReady(ct, len(segIDs)) {
 				log.Info("(in check complete index loop) all segment indices are ready!",
 					zap.Int64("task ID", taskID))
 				c.importManager.setTaskDataIndexed(taskID)
@@ -2754,6 +3069,165 @@ func (c *Core) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequ
 	}, nil
 }
 
+// SaveRole creates the role or replaces the grants of it, the proxies watch the roles to authorize the requests
+func (c *Core) SaveRole(ctx context.Context, in *milvuspb.SaveRoleRequest) (*commonpb.Status, error) {
+	method := "SaveRole"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	log.Debug("SaveRole", zap.String("role", typeutil.RootCoordRole),
+		zap.String("role name", in.GetRole().GetName()), zap.Any("grants", in.GetRole().GetGrants()))
+
+	role := &model.Role{Name: in.GetRole().GetName(), Grants: grantsFromEntities(in.GetRole().GetGrants())}
+	if err := c.MetaTable.SaveRole(role); err != nil {
+		log.Error("SaveRole failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("role name", in.GetRole().GetName()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "SaveRole failed: "+err.Error()), nil
+	}
+	log.Info("SaveRole success", zap.String("role", typeutil.RootCoordRole), zap.String("role name", in.GetRole().GetName()))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
+// DropRole drops the role and unbinds it from the users
+func (c *Core) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	method := "DropRole"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	log.Debug("DropRole", zap.String("role", typeutil.RootCoordRole), zap.String("role name", in.GetRoleName()))
+
+	if err := c.MetaTable.DropRole(in.GetRoleName()); err != nil {
+		log.Error("DropRole failed", zap.String("role", typeutil.RootCoordRole), zap.String("role name", in.GetRoleName()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "DropRole failed: "+err.Error()), nil
+	}
+	log.Info("DropRole success", zap.String("role", typeutil.RootCoordRole), zap.String("role name", in.GetRoleName()))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
+// ListRoles returns the roles and their grants
+func (c *Core) ListRoles(ctx context.Context, in *milvuspb.ListRolesRequest) (*milvuspb.ListRolesResponse, error) {
+	method := "ListRoles"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+
+	roles, err := c.MetaTable.ListRoles()
+	if err != nil {
+		log.Error("ListRoles failed", zap.String("role", typeutil.RootCoordRole),
+			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &milvuspb.ListRolesResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "ListRoles failed: "+err.Error()),
+		}, nil
+	}
+	log.Debug("ListRoles success", zap.String("role", typeutil.RootCoordRole))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &milvuspb.ListRolesResponse{
+		Status: succStatus(),
+		Roles:  roleEntities(roles),
+	}, nil
+}
+
+// SaveUserRoles binds the roles to the user, the roles bound before are unbound if none is specified
+func (c *Core) SaveUserRoles(ctx context.Context, in *milvuspb.SaveUserRolesRequest) (*commonpb.Status, error) {
+	method := "SaveUserRoles"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	log.Debug("SaveUserRoles", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.GetUsername()), zap.Strings("roles", in.GetRoleNames()))
+
+	if err := c.MetaTable.SaveUserRoles(in.GetUsername(), in.GetRoleNames()); err != nil {
+		log.Error("SaveUserRoles failed", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.GetUsername()), zap.Strings("roles", in.GetRoleNames()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "SaveUserRoles failed: "+err.Error()), nil
+	}
+	log.Info("SaveUserRoles success", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.GetUsername()), zap.Strings("roles", in.GetRoleNames()))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
+// ListUserRoles returns the roles bound to the users
+func (c *Core) ListUserRoles(ctx context.Context, in *milvuspb.ListUserRolesRequest) (*milvuspb.ListUserRolesResponse, error) {
+	method := "ListUserRoles"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+
+	userRoles, err := c.MetaTable.ListUserRoles()
+	if err != nil {
+		log.Error("ListUserRoles failed", zap.String("role", typeutil.RootCoordRole),
+			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &milvuspb.ListUserRolesResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "ListUserRoles failed: "+err.Error()),
+		}, nil
+	}
+	log.Debug("ListUserRoles success", zap.String("role", typeutil.RootCoordRole))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &milvuspb.ListUserRolesResponse{
+		Status:    succStatus(),
+		UserRoles: userRolesEntities(userRoles),
+	}, nil
+}
+
+// CreateAPIKey creates an API key authenticating the requests as the user, only the hash of the key is stored
+// so the key is only returned here
+func (c *Core) CreateAPIKey(ctx context.Context, in *milvuspb.CreateAPIKeyRequest) (*milvuspb.CreateAPIKeyResponse, error) {
+	method := "CreateAPIKey"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	log.Debug("CreateAPIKey", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.GetUsername()))
+
+	key, err := crypto.GenerateAPIKey()
+	if err == nil {
+		err = c.MetaTable.AddAPIKey(in.GetUsername(), crypto.HashAPIKey(key))
+	}
+	if err != nil {
+		log.Error("CreateAPIKey failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("username", in.GetUsername()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &milvuspb.CreateAPIKeyResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "CreateAPIKey failed: "+err.Error()),
+		}, nil
+	}
+	log.Info("CreateAPIKey success", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.GetUsername()))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &milvuspb.CreateAPIKeyResponse{
+		Status: succStatus(),
+		ApiKey: key,
+	}, nil
+}
+
+// RevokeAPIKey revokes the API key
+func (c *Core) RevokeAPIKey(ctx context.Context, in *milvuspb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	method := "RevokeAPIKey"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+
+	username, err := c.MetaTable.DeleteAPIKey(crypto.HashAPIKey(in.GetApiKey()))
+	if err != nil {
+		log.Error("RevokeAPIKey failed", zap.String("role", typeutil.RootCoordRole), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "RevokeAPIKey failed: "+err.Error()), nil
+	}
+	log.Info("RevokeAPIKey success", zap.String("role", typeutil.RootCoordRole), zap.String("username", username))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
 // heuristicSegmentsReady checks and returns if segments are ready based on count in a heuristic way.
 // We do this to avoid accidentally compacted segments.
 // This is just a temporary solution.
@@ -212,6 +212,7 @@ func (d *dataMock) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb
 type queryMock struct {
 	types.QueryCoord
 	collID []typeutil.UniqueID
+	partID []typeutil.UniqueID
 	mutex  sync.Mutex
 }
 
@@ -240,6 +241,16 @@ func (q *queryMock) ReleasePartitions(ctx context.Context, req *querypb.ReleaseP
 	}, nil
 }
 
+func (q *queryMock) SyncNewCreatedPartition(ctx context.Context, req *querypb.SyncNewCreatedPartitionRequest) (*commonpb.Status, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.partID = append(q.partID, req.PartitionID)
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 type indexMock struct {
 	types.IndexCoord
 	fileArray  []string
@@ -840,7 +851,7 @@ func TestRootCoord_Base(t *testing.T) {
 
 		assert.Equal(t, shardsNum, int32(core.chanTimeTick.getDmlChannelNum()))
 
-		createMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		createMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 		dmlStream.AsConsumer([]string{createMeta.PhysicalChannelNames[0]}, Params.CommonCfg.RootCoordSubName)
 		dmlStream.Start()
@@ -986,7 +997,7 @@ func TestRootCoord_Base(t *testing.T) {
 	wg.Add(1)
 	t.Run("describe collection", func(t *testing.T) {
 		defer wg.Done()
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -1046,7 +1057,7 @@ func TestRootCoord_Base(t *testing.T) {
 		assert.NoError(t, err)
 		t.Log(status.Reason)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 		assert.Equal(t, 2, len(collMeta.PartitionIDs))
 		partNameIdx1, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[1], 0)
@@ -1063,6 +1074,11 @@ func TestRootCoord_Base(t *testing.T) {
 		assert.Equal(t, 1, len(pnm.GetCollArray()))
 		assert.Equal(t, collName, pnm.GetCollArray()[0])
 
+		// querycoord is notified of the new partition
+		qm.mutex.Lock()
+		assert.Equal(t, []typeutil.UniqueID{collMeta.PartitionIDs[1]}, qm.partID)
+		qm.mutex.Unlock()
+
 		// check DD operation info
 		flag, err := core.MetaTable.txn.Load(DDMsgSendPrefix)
 		assert.NoError(t, err)
@@ -1107,7 +1123,7 @@ func TestRootCoord_Base(t *testing.T) {
 	wg.Add(1)
 	t.Run("show partition", func(t *testing.T) {
 		defer wg.Done()
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 		req := &milvuspb.ShowPartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -1130,7 +1146,7 @@ func TestRootCoord_Base(t *testing.T) {
 	wg.Add(1)
 	t.Run("show segment", func(t *testing.T) {
 		defer wg.Done()
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 		partID := coll.PartitionIDs[1]
 		dm.mu.Lock()
@@ -1179,7 +1195,7 @@ func TestRootCoord_Base(t *testing.T) {
 				},
 			},
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 		assert.Equal(t, 0, len(collMeta.FieldIndexes))
 
@@ -1196,7 +1212,7 @@ func TestRootCoord_Base(t *testing.T) {
 				"file0-100", "file1-100", "file2-100",
 				"file0-100", "file1-100", "file2-100",
 				"file0-100", "file1-100", "file2-100"})
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 		idxMeta, err := core.MetaTable.GetIndexByID(collMeta.FieldIndexes[0].IndexID)
@@ -1212,7 +1228,7 @@ func TestRootCoord_Base(t *testing.T) {
 	wg.Add(1)
 	t.Run("describe segment", func(t *testing.T) {
 		defer wg.Done()
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 
 		req := &milvuspb.DescribeSegmentRequest{
@@ -1278,7 +1294,7 @@ func TestRootCoord_Base(t *testing.T) {
 	wg.Add(1)
 	t.Run("count complete index", func(t *testing.T) {
 		defer wg.Done()
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 		// Normal case.
 		count, err := core.CountCompleteIndex(context.WithValue(ctx, ctxKey{}, ""),
@@ -1302,7 +1318,7 @@ func TestRootCoord_Base(t *testing.T) {
 	wg.Add(1)
 	t.Run("flush segment", func(t *testing.T) {
 		defer wg.Done()
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 		partID := coll.PartitionIDs[1]
 
@@ -1353,9 +1369,9 @@ func TestRootCoord_Base(t *testing.T) {
 			RowBased:       true,
 			Files:          []string{"f1", "f2", "f3"},
 		}
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
-		core.MetaTable.collName2ID[collName] = coll.GetID()
+		putNameID(core.MetaTable.collName2ID, "", collName, coll.GetID())
 		rsp, err := core.Import(ctx, req)
 		assert.NoError(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
@@ -1394,10 +1410,24 @@ func TestRootCoord_Base(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("cancel import task", func(t *testing.T) {
+		defer wg.Done()
+		rsp, err := core.CancelImport(ctx, &milvuspb.CancelImportRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+
+		rsp, err = core.CancelImport(ctx, &milvuspb.CancelImportRequest{
+			Task: 10000,
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("report import task timeout", func(t *testing.T) {
 		defer wg.Done()
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 		req := &rootcoordpb.ImportResult{
 			TaskId:   1,
@@ -1442,7 +1472,7 @@ func TestRootCoord_Base(t *testing.T) {
 			tID++
 			return tID, 0, nil
 		}
-		core.MetaTable.collName2ID["new"+collName] = 123
+		putNameID(core.MetaTable.collName2ID, "", "new"+collName, 123)
 		core.MetaTable.collID2Meta[123] = etcdpb.CollectionInfo{
 			ID:             123,
 			PartitionIDs:   []int64{456},
@@ -1456,7 +1486,7 @@ func TestRootCoord_Base(t *testing.T) {
 		rsp, err := core.Import(ctx, req)
 		assert.NoError(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
-		delete(core.MetaTable.collName2ID, "new"+collName)
+		deleteNameID(core.MetaTable.collName2ID, "", "new"+collName)
 		delete(core.MetaTable.collID2Meta, 123)
 
 		reqIR := &rootcoordpb.ImportResult{
@@ -1571,7 +1601,7 @@ func TestRootCoord_Base(t *testing.T) {
 			},
 		}
 
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 
@@ -1595,7 +1625,7 @@ func TestRootCoord_Base(t *testing.T) {
 			FieldName:      "vector",
 			IndexName:      Params.CommonCfg.DefaultIndexName,
 		}
-		_, idx, err := core.MetaTable.GetIndexByName(collName, Params.CommonCfg.DefaultIndexName)
+		_, idx, err := core.MetaTable.GetIndexByName("", collName, Params.CommonCfg.DefaultIndexName)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(idx))
 
@@ -1608,7 +1638,7 @@ func TestRootCoord_Base(t *testing.T) {
 		assert.Equal(t, idx[0].IndexID, im.idxDropID[0])
 		im.mutex.Unlock()
 
-		_, idx, err = core.MetaTable.GetIndexByName(collName, Params.CommonCfg.DefaultIndexName)
+		_, idx, err = core.MetaTable.GetIndexByName("", collName, Params.CommonCfg.DefaultIndexName)
 		assert.NoError(t, err)
 		assert.Equal(t, 0, len(idx))
 	})
@@ -1627,13 +1657,13 @@ func TestRootCoord_Base(t *testing.T) {
 			CollectionName: collName,
 			PartitionName:  partName,
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 		dropPartID := collMeta.PartitionIDs[1]
 		status, err := core.DropPartition(ctx, req)
 		assert.NoError(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		partName, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[0], 0)
@@ -1674,7 +1704,7 @@ func TestRootCoord_Base(t *testing.T) {
 	wg.Add(1)
 	t.Run("remove DQL msgstream", func(t *testing.T) {
 		defer wg.Done()
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 
 		req := &proxypb.ReleaseDQLMessageStreamRequest{
@@ -1702,7 +1732,7 @@ func TestRootCoord_Base(t *testing.T) {
 			DbName:         dbName,
 			CollectionName: collName,
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 		status, err := core.DropCollection(ctx, req)
 		assert.NoError(t, err)
@@ -2641,6 +2671,12 @@ func TestRootCoord_Base(t *testing.T) {
 		rsp12, err := core.ListImportTasks(ctx, &milvuspb.ListImportTasksRequest{})
 		assert.NoError(t, err)
 		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp12.Status.ErrorCode)
+
+		rsp13, err := core.CancelImport(ctx, &milvuspb.CancelImportRequest{
+			Task: 1,
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp13.Status.ErrorCode)
 	})
 
 	wg.Add(1)
@@ -2778,7 +2814,7 @@ func TestRootCoord2(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
 
-		collInfo, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collInfo, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 		dmlStream, _ := msFactory.NewMsgStream(ctx)
 		dmlStream.AsConsumer([]string{collInfo.PhysicalChannelNames[0]}, Params.CommonCfg.RootCoordSubName)
@@ -2795,7 +2831,7 @@ func TestRootCoord2(t *testing.T) {
 	wg.Add(1)
 	t.Run("describe collection", func(t *testing.T) {
 		defer wg.Done()
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -2816,6 +2852,54 @@ func TestRootCoord2(t *testing.T) {
 		assert.Equal(t, common.DefaultShardsNum, int32(len(rsp.PhysicalChannelNames)))
 		assert.Equal(t, common.DefaultShardsNum, rsp.ShardsNum)
 	})
+	wg.Add(1)
+	t.Run("create collection with partition key", func(t *testing.T) {
+		defer wg.Done()
+		const keyCollName = "testPartitionKeyColl"
+		schema := schemapb.CollectionSchema{
+			Name: keyCollName,
+			Fields: []*schemapb.FieldSchema{
+				{Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{Name: "key", DataType: schemapb.DataType_Int64, TypeParams: []*commonpb.KeyValuePair{
+					{Key: common.PartitionKeyParam, Value: "true"},
+					{Key: common.PartitionKeyNumParam, Value: "0"},
+				}},
+			},
+		}
+		createReq := func() *milvuspb.CreateCollectionRequest {
+			sbf, err := proto.Marshal(&schema)
+			assert.NoError(t, err)
+			return &milvuspb.CreateCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+				DbName:         dbName,
+				CollectionName: keyCollName,
+				Schema:         sbf,
+			}
+		}
+
+		status, err := core.CreateCollection(ctx, createReq())
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+		_, err = core.MetaTable.GetCollectionByName("", keyCollName, 0)
+		assert.Error(t, err)
+
+		// the implicit partitions are created along with the collection
+		schema.Fields[1].TypeParams[1].Value = "4"
+		status, err = core.CreateCollection(ctx, createReq())
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		collInfo, err := core.MetaTable.GetCollectionByName("", keyCollName, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{Params.CommonCfg.DefaultPartitionName, Params.CommonCfg.DefaultPartitionName + "_0",
+			Params.CommonCfg.DefaultPartitionName + "_1", Params.CommonCfg.DefaultPartitionName + "_2",
+			Params.CommonCfg.DefaultPartitionName + "_3"}, collInfo.PartitionNames)
+		assert.Equal(t, 5, len(collInfo.PartitionIDs))
+		for _, ts := range collInfo.PartitionCreatedTimestamps {
+			assert.Equal(t, collInfo.CreateTime, ts)
+		}
+	})
+
 	wg.Wait()
 	err = core.Stop()
 	assert.NoError(t, err)
@@ -3190,7 +3274,7 @@ func TestRootCoord_CheckZeroShardsNum(t *testing.T) {
 	createCollectionInMeta(dbName, collName, core, shardsNum, modifyFunc)
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.NoError(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
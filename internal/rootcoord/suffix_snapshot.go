@@ -481,6 +481,40 @@ func (ss *suffixSnapshot) LoadWithPrefix(key string, ts typeutil.Timestamp) ([]s
 	return resultKeys, resultValues, nil
 }
 
+// MultiSaveAndRemove save muiltple kvs and remove the keys exactly
+// if ts == 0, act like TxnKV
+// each key-value will be treated in same logic like Save
+func (ss *suffixSnapshot) MultiSaveAndRemove(saves map[string]string, removals []string, ts typeutil.Timestamp) error {
+	// if ts == 0, act like TxnKV
+	if ts == 0 {
+		return ss.TxnKV.MultiSaveAndRemove(saves, removals)
+	}
+	ss.Lock()
+	defer ss.Unlock()
+
+	// process each key, checks whether is the latest
+	execute, updateList, err := ss.generateSaveExecute(saves, ts)
+	if err != nil {
+		return err
+	}
+
+	// add tombstone to each removal and add ts entry
+	for _, key := range removals {
+		execute[key] = string(suffixSnapshotTombstone)
+		execute[ss.composeTSKey(key, ts)] = string(suffixSnapshotTombstone)
+		updateList = append(updateList, key)
+	}
+
+	// multi save execute map; if succeeds, update ts in the update list
+	err = ss.TxnKV.MultiSave(execute)
+	if err == nil {
+		for _, key := range updateList {
+			ss.lastestTS[key] = ts
+		}
+	}
+	return err
+}
+
 // MultiSaveAndRemoveWithPrefix save muiltple kvs and remove as well
 // if ts == 0, act like TxnKV
 // each key-value will be treated in same logic like Save
@@ -135,11 +135,34 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("alloc partition id error = %w", err)
 	}
+	partIDs := []typeutil.UniqueID{partID}
+	partNames := []string{Params.CommonCfg.DefaultPartitionName}
+
+	// the implicit partitions of the partition key are created along with the collection, in the same meta transaction
+	if keyField := typeutil.GetPartitionKeyFieldSchema(&schema); keyField != nil {
+		num, err := typeutil.GetPartitionKeyNum(keyField)
+		if err != nil {
+			return err
+		}
+		if num <= 0 || num > Params.RootCoordCfg.MaxPartitionNum-1 {
+			return fmt.Errorf("%s of the partition key %s should be in range [1, %d]", common.PartitionKeyNumParam,
+				keyField.GetName(), Params.RootCoordCfg.MaxPartitionNum-1)
+		}
+		keyPartID, _, err := t.core.IDAllocator(uint32(num))
+		if err != nil {
+			return fmt.Errorf("alloc partition id error = %w", err)
+		}
+		for i := int64(0); i < num; i++ {
+			partIDs = append(partIDs, keyPartID+i)
+			partNames = append(partNames, typeutil.GetPartitionKeyPartitionName(Params.CommonCfg.DefaultPartitionName, i))
+		}
+	}
 
 	log.Debug("collection name -> id",
 		zap.String("collection name", t.Req.CollectionName),
 		zap.Int64("collection_id", collID),
-		zap.Int64("default partition id", partID))
+		zap.Int64("default partition id", partID),
+		zap.Int("number of partitions", len(partIDs)))
 
 	vchanNames := make([]string, t.Req.ShardsNum)
 	chanNames := make([]string, t.Req.ShardsNum)
@@ -157,14 +180,15 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 
 	collInfo := etcdpb.CollectionInfo{
 		ID:                         collID,
+		DbName:                     t.Req.DbName,
 		Schema:                     &schema,
-		PartitionIDs:               []typeutil.UniqueID{partID},
-		PartitionNames:             []string{Params.CommonCfg.DefaultPartitionName},
+		PartitionIDs:               partIDs,
+		PartitionNames:             partNames,
 		FieldIndexes:               make([]*etcdpb.FieldIndexInfo, 0, 16),
 		VirtualChannelNames:        vchanNames,
 		PhysicalChannelNames:       chanNames,
 		ShardsNum:                  t.Req.ShardsNum,
-		PartitionCreatedTimestamps: []uint64{0},
+		PartitionCreatedTimestamps: make([]uint64, len(partIDs)),
 		ConsistencyLevel:           t.Req.ConsistencyLevel,
 	}
 
@@ -276,11 +300,11 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropCollection {
 		return fmt.Errorf("drop collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	if t.core.MetaTable.IsAlias(t.Req.CollectionName) {
+	if t.core.MetaTable.IsAlias(t.Req.DbName, t.Req.CollectionName) {
 		return fmt.Errorf("cannot drop the collection via alias = %s", t.Req.CollectionName)
 	}
 
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -306,7 +330,7 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 	}
 
 	// drop all indices
-	if err = t.core.RemoveIndex(ctx, t.Req.CollectionName, ""); err != nil {
+	if err = t.core.RemoveIndex(ctx, t.Req.DbName, t.Req.CollectionName, ""); err != nil {
 		return err
 	}
 
@@ -375,8 +399,8 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	t.core.ExpireMetaCache(ctx, []string{t.Req.CollectionName}, ts)
-	t.core.ExpireMetaCache(ctx, aliases, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, []string{t.Req.CollectionName}, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, aliases, ts)
 
 	// Update DDOperation in etcd
 	return t.core.MetaTable.txn.Save(DDMsgSendPrefix, strconv.FormatBool(true))
@@ -399,7 +423,7 @@ func (t *HasCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_HasCollection {
 		return fmt.Errorf("has collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	_, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, t.Req.TimeStamp)
+	_, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 	if err == nil {
 		t.HasCollection = true
 	} else {
@@ -429,7 +453,7 @@ func (t *DescribeCollectionReqTask) Execute(ctx context.Context) error {
 	var err error
 
 	if t.Req.CollectionName != "" {
-		collInfo, err = t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, t.Req.TimeStamp)
+		collInfo, err = t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 		if err != nil {
 			return err
 		}
@@ -476,7 +500,7 @@ func (t *ShowCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_ShowCollections {
 		return fmt.Errorf("show collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, err := t.core.MetaTable.ListCollections(t.Req.TimeStamp)
+	coll, err := t.core.MetaTable.ListCollections(t.Req.DbName, t.Req.TimeStamp)
 	if err != nil {
 		return err
 	}
@@ -506,7 +530,7 @@ func (t *CreatePartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_CreatePartition {
 		return fmt.Errorf("create partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -571,7 +595,8 @@ func (t *CreatePartitionReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	t.core.ExpireMetaCache(ctx, []string{t.Req.CollectionName}, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, []string{t.Req.CollectionName}, ts)
+	t.core.syncNewCreatedPartition(ctx, collMeta.ID, partID)
 
 	// Update DDOperation in etcd
 	return t.core.MetaTable.txn.Save(DDMsgSendPrefix, strconv.FormatBool(true))
@@ -593,7 +618,7 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropPartition {
 		return fmt.Errorf("drop partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -658,7 +683,7 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	t.core.ExpireMetaCache(ctx, []string{t.Req.CollectionName}, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, []string{t.Req.CollectionName}, ts)
 
 	//notify query service to release partition
 	// TODO::xige-16, reOpen when queryCoord support release partitions after load collection
@@ -688,7 +713,7 @@ func (t *HasPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_HasPartition {
 		return fmt.Errorf("has partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	coll, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -718,7 +743,7 @@ func (t *ShowPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Req.CollectionName == "" {
 		coll, err = t.core.MetaTable.GetCollectionByID(t.Req.CollectionID, 0)
 	} else {
-		coll, err = t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+		coll, err = t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	}
 	if err != nil {
 		return err
@@ -931,6 +956,10 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 	if len(indexName) <= 0 {
 		indexName = Params.CommonCfg.DefaultIndexName //TODO, get name from request
 	}
+	indexParams, rebuild, err := splitIndexRebuildParam(t.Req.GetExtraParams())
+	if err != nil {
+		return err
+	}
 	indexID, _, err := t.core.IDAllocator(1)
 	log.Debug("RootCoord CreateIndexReqTask", zap.Any("indexID", indexID), zap.Error(err))
 	if err != nil {
@@ -939,15 +968,16 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 	idxInfo := &etcdpb.IndexInfo{
 		IndexName:   indexName,
 		IndexID:     indexID,
-		IndexParams: t.Req.ExtraParams,
+		IndexParams: indexParams,
 	}
 	log.Info("create index for collection",
 		zap.String("collection", t.Req.GetCollectionName()),
 		zap.String("field", t.Req.GetFieldName()),
 		zap.String("index", indexName),
 		zap.Int64("index_id", indexID),
-		zap.Any("params", t.Req.GetExtraParams()))
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+		zap.Any("params", indexParams),
+		zap.Bool("rebuild", rebuild))
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -961,7 +991,14 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	segIDs, field, err := t.core.MetaTable.GetNotIndexedSegments(t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs)
+	var segIDs []typeutil.UniqueID
+	var field schemapb.FieldSchema
+	if rebuild {
+		// the existing index keeps serving until the new one is built and swapped in by checkIndexRebuildLoop
+		segIDs, field, err = t.core.MetaTable.AddRebuildIndex(t.Req.DbName, t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs)
+	} else {
+		segIDs, field, err = t.core.MetaTable.GetNotIndexedSegments(t.Req.DbName, t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs)
+	}
 	if err != nil {
 		log.Debug("RootCoord CreateIndexReqTask metaTable.GetNotIndexedSegments", zap.Error(err))
 		return err
@@ -1012,7 +1049,7 @@ func (t *DescribeIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DescribeIndex {
 		return fmt.Errorf("describe index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, idx, err := t.core.MetaTable.GetIndexByName(t.Req.CollectionName, t.Req.IndexName)
+	coll, idx, err := t.core.MetaTable.GetIndexByName(t.Req.DbName, t.Req.CollectionName, t.Req.IndexName)
 	if err != nil {
 		return err
 	}
@@ -1049,10 +1086,10 @@ func (t *DropIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropIndex {
 		return fmt.Errorf("drop index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	if err := t.core.RemoveIndex(ctx, t.Req.CollectionName, t.Req.IndexName); err != nil {
+	if err := t.core.RemoveIndex(ctx, t.Req.DbName, t.Req.CollectionName, t.Req.IndexName); err != nil {
 		return err
 	}
-	_, _, err := t.core.MetaTable.DropIndex(t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName)
+	_, _, err := t.core.MetaTable.DropIndex(t.Req.DbName, t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName)
 	return err
 }
 
@@ -1077,7 +1114,7 @@ func (t *CreateAliasReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("TSO alloc fail, error = %w", err)
 	}
-	err = t.core.MetaTable.AddAlias(t.Req.Alias, t.Req.CollectionName, ts)
+	err = t.core.MetaTable.AddAlias(t.Req.DbName, t.Req.Alias, t.Req.CollectionName, ts)
 	if err != nil {
 		return fmt.Errorf("meta table add alias failed, error = %w", err)
 	}
@@ -1106,12 +1143,12 @@ func (t *DropAliasReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("TSO alloc fail, error = %w", err)
 	}
-	err = t.core.MetaTable.DropAlias(t.Req.Alias, ts)
+	err = t.core.MetaTable.DropAlias(t.Req.DbName, t.Req.Alias, ts)
 	if err != nil {
 		return fmt.Errorf("meta table drop alias failed, error = %w", err)
 	}
 
-	t.core.ExpireMetaCache(ctx, []string{t.Req.Alias}, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, []string{t.Req.Alias}, ts)
 
 	return nil
 }
@@ -1137,12 +1174,17 @@ func (t *AlterAliasReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("TSO alloc fail, error = %w", err)
 	}
-	err = t.core.MetaTable.AlterAlias(t.Req.Alias, t.Req.CollectionName, ts)
+	// the collection name is another alias if the two aliases are swapped
+	aliases := []string{t.Req.Alias}
+	if t.core.MetaTable.IsAlias(t.Req.DbName, t.Req.CollectionName) {
+		aliases = append(aliases, t.Req.CollectionName)
+	}
+	err = t.core.MetaTable.AlterAlias(t.Req.DbName, t.Req.Alias, t.Req.CollectionName, ts)
 	if err != nil {
 		return fmt.Errorf("meta table alter alias failed, error = %w", err)
 	}
 
-	t.core.ExpireMetaCache(ctx, []string{t.Req.Alias}, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, aliases, ts)
 
 	return nil
 }
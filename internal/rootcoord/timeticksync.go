@@ -59,6 +59,10 @@ type timetickSync struct {
 	ddlLock  sync.RWMutex
 	ddlMinTs typeutil.Timestamp
 	ddlTsSet map[typeutil.Timestamp]struct{}
+
+	// record the last timetick sent to each dml channel, used to coalesce timeticks
+	sentLock      sync.Mutex
+	sentChanTsMap map[string]typeutil.Timestamp
 }
 
 type chanTsMsg struct {
@@ -124,6 +128,9 @@ func newTimeTickSync(ctx context.Context, sourceID int64, factory msgstream.Fact
 		ddlLock:  sync.RWMutex{},
 		ddlMinTs: typeutil.Timestamp(math.MaxUint64),
 		ddlTsSet: make(map[typeutil.Timestamp]struct{}),
+
+		sentLock:      sync.Mutex{},
+		sentChanTsMap: make(map[string]typeutil.Timestamp),
 	}
 }
 
@@ -324,8 +331,14 @@ func (t *timetickSync) startWatch(wg *sync.WaitGroup) {
 							mints = currTs
 						}
 					}
+					if t.coalesceTimeTick(chanName, mints) {
+						wg.Done()
+						return
+					}
 					if err := t.sendTimeTickToChannel([]string{chanName}, mints); err != nil {
 						log.Warn("SendTimeTickToChannel fail", zap.Error(err))
+					} else {
+						t.recordSentTimeTick(chanName, mints)
 					}
 					wg.Done()
 				}(chanName, ts)
@@ -342,6 +355,39 @@ func (t *timetickSync) startWatch(wg *sync.WaitGroup) {
 	}
 }
 
+// coalesceTimeTick returns whether the timetick of the channel could be skipped,
+// a timetick is skipped if it doesn't advance the last sent one or is within
+// Params.RootCoordCfg.TimeTickCoalesceInterval since the last sent one, the later
+// timetick covers it, so the consumers' tSafe only advances less frequently
+func (t *timetickSync) coalesceTimeTick(chanName string, ts typeutil.Timestamp) bool {
+	interval := Params.RootCoordCfg.TimeTickCoalesceInterval
+	if interval <= 0 {
+		return false
+	}
+	t.sentLock.Lock()
+	defer t.sentLock.Unlock()
+	lastTs, ok := t.sentChanTsMap[chanName]
+	if !ok {
+		return false
+	}
+	if ts <= lastTs {
+		return true
+	}
+	return tsoutil.CalculateDuration(ts, lastTs) < interval.Milliseconds()
+}
+
+// recordSentTimeTick records the last timetick sent to the channel
+func (t *timetickSync) recordSentTimeTick(chanName string, ts typeutil.Timestamp) {
+	if Params.RootCoordCfg.TimeTickCoalesceInterval <= 0 {
+		return
+	}
+	t.sentLock.Lock()
+	defer t.sentLock.Unlock()
+	if ts > t.sentChanTsMap[chanName] {
+		t.sentChanTsMap[chanName] = ts
+	}
+}
+
 // SendTimeTickToChannel send each channel's min timetick to msg stream
 func (t *timetickSync) sendTimeTickToChannel(chanNames []string, ts typeutil.Timestamp) error {
 	msgPack := msgstream.MsgPack{}
@@ -405,6 +451,12 @@ func (t *timetickSync) addDmlChannels(names ...string) {
 // RemoveDmlChannels remove dml channels
 func (t *timetickSync) removeDmlChannels(names ...string) {
 	t.dmlChannels.removeChannels(names...)
+
+	t.sentLock.Lock()
+	defer t.sentLock.Unlock()
+	for _, name := range names {
+		delete(t.sentChanTsMap, name)
+	}
 }
 
 // BroadcastDmlChannels broadcasts msg pack into dml channels
@@ -20,12 +20,14 @@ import (
 	"context"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 )
 
 func TestTimetickSync(t *testing.T) {
@@ -113,5 +115,31 @@ func TestTimetickSync(t *testing.T) {
 		ret := minTimeTick(tts...)
 		assert.Equal(t, ret, tts[1])
 	})
+	wg.Add(1)
+	t.Run("coalesceTimeTick", func(t *testing.T) {
+		defer wg.Done()
+		defer func(interval time.Duration) {
+			Params.RootCoordCfg.TimeTickCoalesceInterval = interval
+		}(Params.RootCoordCfg.TimeTickCoalesceInterval)
+
+		chanName := ttSync.getDmlChannelName()
+		ttSync.addDmlChannels(chanName)
+
+		Params.RootCoordCfg.TimeTickCoalesceInterval = 0
+		ts := tsoutil.ComposeTS(1000, 0)
+		ttSync.recordSentTimeTick(chanName, ts)
+		assert.False(t, ttSync.coalesceTimeTick(chanName, ts))
+
+		Params.RootCoordCfg.TimeTickCoalesceInterval = time.Second
+		assert.False(t, ttSync.coalesceTimeTick(chanName, ts))
+		ttSync.recordSentTimeTick(chanName, ts)
+		assert.True(t, ttSync.coalesceTimeTick(chanName, ts))
+		assert.True(t, ttSync.coalesceTimeTick(chanName, tsoutil.ComposeTS(1999, 0)))
+		assert.False(t, ttSync.coalesceTimeTick(chanName, tsoutil.ComposeTS(2000, 0)))
+		assert.False(t, ttSync.coalesceTimeTick("other", ts))
+
+		ttSync.removeDmlChannels(chanName)
+		assert.False(t, ttSync.coalesceTimeTick(chanName, ts))
+	})
 	wg.Wait()
 }
@@ -0,0 +1,286 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"go.uber.org/zap"
+	"golang.org/x/exp/mmap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+// AzureChunkManager is responsible for read and write data stored in azure blob storage.
+type AzureChunkManager struct {
+	client azblob.ContainerClient
+
+	ctx           context.Context
+	containerName string
+}
+
+var _ ChunkManager = (*AzureChunkManager)(nil)
+
+func newAzureChunkManagerWithConfig(ctx context.Context, c *config) (*AzureChunkManager, error) {
+	client, err := newAzureContainerClient(c)
+	// invalid connection string or no credential, don't need to retry
+	if err != nil {
+		return nil, err
+	}
+	// check valid in first query
+	checkContainerFn := func() error {
+		_, err := client.GetProperties(ctx, nil)
+		if err == nil {
+			return nil
+		}
+		if !isAzureStorageError(err, azblob.StorageErrorCodeContainerNotFound) {
+			return err
+		}
+		log.Debug("azure chunk manager new container client", zap.Any("Check container", "container not exist"))
+		if !c.createBucket {
+			return fmt.Errorf("container %s not Existed", c.bucketName)
+		}
+		log.Debug("azure chunk manager create container.", zap.Any("container name", c.bucketName))
+		_, err = client.Create(ctx, nil)
+		// the container may be created by other component concurrently
+		if err != nil && !isAzureStorageError(err, azblob.StorageErrorCodeContainerAlreadyExists) {
+			return err
+		}
+		return nil
+	}
+	err = retry.Do(ctx, checkContainerFn, retry.Attempts(100))
+	if err != nil {
+		return nil, err
+	}
+
+	acm := &AzureChunkManager{
+		client:        client,
+		ctx:           ctx,
+		containerName: c.bucketName,
+	}
+	log.Debug("azure chunk manager new container client success.")
+
+	return acm, nil
+}
+
+// newAzureContainerClient authenticates by the connection string if set, otherwise by the managed identity
+func newAzureContainerClient(c *config) (azblob.ContainerClient, error) {
+	if c.azureConnectionString != "" {
+		return azblob.NewContainerClientFromConnectionString(c.azureConnectionString, c.bucketName, nil)
+	}
+
+	endpoint := c.azureEndpoint
+	if endpoint == "" {
+		if c.azureAccountName == "" {
+			return azblob.ContainerClient{}, errors.New("azure connection string or account name is required")
+		}
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net/", c.azureAccountName)
+	}
+	opts := &azidentity.ManagedIdentityCredentialOptions{}
+	if c.azureManagedIdentityClientID != "" {
+		opts.ID = azidentity.ClientID(c.azureManagedIdentityClientID)
+	}
+	cred, err := azidentity.NewManagedIdentityCredential(opts)
+	if err != nil {
+		return azblob.ContainerClient{}, err
+	}
+	return azblob.NewContainerClient(strings.TrimSuffix(endpoint, "/")+"/"+c.bucketName, cred, nil)
+}
+
+func isAzureStorageError(err error, code azblob.StorageErrorCode) bool {
+	var storageErr *azblob.StorageError
+	return errors.As(err, &storageErr) && storageErr.ErrorCode == code
+}
+
+// Path returns the path of azure blob if exists.
+func (acm *AzureChunkManager) Path(filePath string) (string, error) {
+	if !acm.Exist(filePath) {
+		return "", errors.New("azure file manage cannot be found with filePath:" + filePath)
+	}
+	return filePath, nil
+}
+
+// Reader returns the reader of azure blob if exists.
+func (acm *AzureChunkManager) Reader(filePath string) (FileReader, error) {
+	resp, err := acm.client.NewBlobClient(filePath).Download(acm.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body(nil), nil
+}
+
+func (acm *AzureChunkManager) Size(filePath string) (int64, error) {
+	props, err := acm.client.NewBlobClient(filePath).GetProperties(acm.ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	if props.ContentLength == nil {
+		return 0, errors.New("azure blob content length is missing, filePath:" + filePath)
+	}
+	return *props.ContentLength, nil
+}
+
+// Write writes the data to azure blob storage, the blob is uploaded in blocks if it's too large to upload at once.
+func (acm *AzureChunkManager) Write(filePath string, content []byte) error {
+	_, err := acm.client.NewBlockBlobClient(filePath).UploadBufferToBlockBlob(acm.ctx, content, azblob.HighLevelUploadToBlockBlobOption{})
+	return err
+}
+
+// MultiWrite saves multiple objects, the path is the key of @kvs.
+// The object value is the value of @kvs.
+func (acm *AzureChunkManager) MultiWrite(kvs map[string][]byte) error {
+	var el errorutil.ErrorList
+	for key, value := range kvs {
+		err := acm.Write(key, value)
+		if err != nil {
+			el = append(el, err)
+		}
+	}
+	if len(el) == 0 {
+		return nil
+	}
+	return el
+}
+
+// Exist checks whether chunk is saved to azure blob storage.
+func (acm *AzureChunkManager) Exist(filePath string) bool {
+	_, err := acm.client.NewBlobClient(filePath).GetProperties(acm.ctx, nil)
+	return err == nil
+}
+
+// Read reads the azure blob if exists.
+func (acm *AzureChunkManager) Read(filePath string) ([]byte, error) {
+	resp, err := acm.client.NewBlobClient(filePath).Download(acm.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	body := resp.Body(nil)
+	defer body.Close()
+
+	return ioutil.ReadAll(body)
+}
+
+func (acm *AzureChunkManager) MultiRead(keys []string) ([][]byte, error) {
+	var el errorutil.ErrorList
+	var objectsValues [][]byte
+	for _, key := range keys {
+		objectValue, err := acm.Read(key)
+		if err != nil {
+			el = append(el, err)
+		}
+		objectsValues = append(objectsValues, objectValue)
+	}
+
+	if len(el) == 0 {
+		return objectsValues, nil
+	}
+	return objectsValues, el
+}
+
+func (acm *AzureChunkManager) ReadWithPrefix(prefix string) ([]string, [][]byte, error) {
+	objectsKeys, err := acm.ListWithPrefix(prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	objectsValues, err := acm.MultiRead(objectsKeys)
+	if err != nil {
+		log.Error(fmt.Sprintf("Azure load with prefix error. path = %s", prefix), zap.Error(err))
+		return nil, nil, err
+	}
+
+	return objectsKeys, objectsValues, nil
+}
+
+func (acm *AzureChunkManager) Mmap(filePath string) (*mmap.ReaderAt, error) {
+	return nil, errors.New("this method has not been implemented")
+}
+
+// ReadAt reads specific position data of azure blob if exists.
+func (acm *AzureChunkManager) ReadAt(filePath string, off int64, length int64) ([]byte, error) {
+	if off < 0 || length < 0 {
+		return nil, io.EOF
+	}
+
+	resp, err := acm.client.NewBlobClient(filePath).Download(acm.ctx, &azblob.DownloadBlobOptions{
+		Offset: &off,
+		Count:  &length,
+	})
+	if err != nil {
+		return nil, err
+	}
+	body := resp.Body(nil)
+	defer body.Close()
+	return ioutil.ReadAll(body)
+}
+
+// Remove deletes an object with @key, it's not an error if the object doesn't exist.
+func (acm *AzureChunkManager) Remove(key string) error {
+	_, err := acm.client.NewBlobClient(key).Delete(acm.ctx, nil)
+	if err != nil && !isAzureStorageError(err, azblob.StorageErrorCodeBlobNotFound) {
+		return err
+	}
+	return nil
+}
+
+// MultiRemove deletes a objects with @keys.
+func (acm *AzureChunkManager) MultiRemove(keys []string) error {
+	var el errorutil.ErrorList
+	for _, key := range keys {
+		err := acm.Remove(key)
+		if err != nil {
+			el = append(el, err)
+		}
+	}
+	if len(el) == 0 {
+		return nil
+	}
+	return el
+}
+
+// RemoveWithPrefix removes all objects with the same prefix @prefix from azure blob storage.
+func (acm *AzureChunkManager) RemoveWithPrefix(prefix string) error {
+	objectsKeys, err := acm.ListWithPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	return acm.MultiRemove(objectsKeys)
+}
+
+// ListWithPrefix lists all the blobs with the same prefix @prefix, as azure blob storage has no directory.
+func (acm *AzureChunkManager) ListWithPrefix(prefix string) ([]string, error) {
+	pager := acm.client.ListBlobsFlat(&azblob.ContainerListBlobFlatSegmentOptions{Prefix: &prefix})
+
+	var objectsKeys []string
+	for pager.NextPage(acm.ctx) {
+		for _, blob := range pager.PageResponse().Segment.BlobItems {
+			objectsKeys = append(objectsKeys, *blob.Name)
+		}
+	}
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+	return objectsKeys, nil
+}
@@ -0,0 +1,144 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAzureChunkManager(ctx context.Context, connectionString string) (*AzureChunkManager, error) {
+	c := newDefaultConfig()
+	for _, opt := range []Option{AzureConnectionString(connectionString), BucketName("milvus-azure-ut"), CreateBucket(true)} {
+		opt(c)
+	}
+	return newAzureChunkManagerWithConfig(ctx, c)
+}
+
+func TestAzureCMFail(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := newAzureChunkManagerWithConfig(ctx, newDefaultConfig())
+	assert.Error(t, err)
+	assert.Nil(t, client)
+
+	client, err = newAzureChunkManager(ctx, "invalid connection string")
+	assert.Error(t, err)
+	assert.Nil(t, client)
+}
+
+func TestAzureCM(t *testing.T) {
+	Params.Init()
+	// e.g. the connection string of azurite, the azure storage emulator
+	connectionString := Params.LoadWithDefault("azure.connectionString", "")
+	if connectionString == "" {
+		t.Skip("azure is not configured")
+	}
+	testAzureRoot := path.Join(Params.LoadWithDefault("minio.rootPath", "files"), "milvus-azure-ut-root")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	testCM, err := newAzureChunkManager(ctx, connectionString)
+	require.NoError(t, err)
+	defer testCM.RemoveWithPrefix(testAzureRoot)
+
+	t.Run("test read write", func(t *testing.T) {
+		kvs := map[string][]byte{
+			path.Join(testAzureRoot, "rw", "key_1"):        []byte("111"),
+			path.Join(testAzureRoot, "rw", "key_2"):        []byte("222"),
+			path.Join(testAzureRoot, "rw", "sub", "key_3"): []byte("333"),
+		}
+		require.NoError(t, testCM.MultiWrite(kvs))
+
+		for key, value := range kvs {
+			got, err := testCM.Read(key)
+			assert.NoError(t, err)
+			assert.Equal(t, value, got)
+			assert.True(t, testCM.Exist(key))
+		}
+
+		keys, values, err := testCM.ReadWithPrefix(path.Join(testAzureRoot, "rw"))
+		assert.NoError(t, err)
+		assert.Len(t, keys, 3)
+		for i, key := range keys {
+			assert.Equal(t, kvs[key], values[i])
+		}
+
+		_, err = testCM.Read(path.Join(testAzureRoot, "rw", "key_not_exist"))
+		assert.Error(t, err)
+		assert.False(t, testCM.Exist(path.Join(testAzureRoot, "rw", "key_not_exist")))
+	})
+
+	t.Run("test ReadAt Size Path", func(t *testing.T) {
+		key := path.Join(testAzureRoot, "partial", "key")
+		value := []byte("TestAzureCM_ReadAt_value")
+		require.NoError(t, testCM.Write(key, value))
+
+		partial, err := testCM.ReadAt(key, 1, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, value[1:4], partial)
+		_, err = testCM.ReadAt(key, -1, 2)
+		assert.Error(t, err)
+
+		size, err := testCM.Size(key)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(len(value)), size)
+
+		p, err := testCM.Path(key)
+		assert.NoError(t, err)
+		assert.Equal(t, key, p)
+
+		reader, err := testCM.Reader(key)
+		assert.NoError(t, err)
+		reader.Close()
+
+		key2 := path.Join(testAzureRoot, "partial", "key_not_exist")
+		_, err = testCM.Size(key2)
+		assert.Error(t, err)
+		_, err = testCM.Path(key2)
+		assert.Error(t, err)
+	})
+
+	t.Run("test Remove", func(t *testing.T) {
+		keys := []string{
+			path.Join(testAzureRoot, "remove", "key_1"),
+			path.Join(testAzureRoot, "remove", "key_2"),
+			path.Join(testAzureRoot, "remove", "prefix_1"),
+			path.Join(testAzureRoot, "remove", "prefix_2"),
+		}
+		for _, key := range keys {
+			require.NoError(t, testCM.Write(key, []byte("value")))
+		}
+
+		assert.NoError(t, testCM.Remove(keys[0]))
+		assert.False(t, testCM.Exist(keys[0]))
+		// removing a blob not existed is ok
+		assert.NoError(t, testCM.Remove(keys[0]))
+
+		assert.NoError(t, testCM.MultiRemove(keys[1:2]))
+		assert.False(t, testCM.Exist(keys[1]))
+
+		assert.NoError(t, testCM.RemoveWithPrefix(path.Join(testAzureRoot, "remove", "prefix")))
+		listed, err := testCM.ListWithPrefix(path.Join(testAzureRoot, "remove"))
+		assert.NoError(t, err)
+		assert.Empty(t, listed)
+	})
+}
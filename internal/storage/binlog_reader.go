@@ -111,8 +111,12 @@ func (reader *BinlogReader) Close() {
 	reader.isClose = true
 }
 
-// NewBinlogReader creates binlogReader to read binlog file.
+// NewBinlogReader creates binlogReader to read binlog file, the compressed binlog file is decompressed first.
 func NewBinlogReader(data []byte) (*BinlogReader, error) {
+	data, err := decompressIfNeeded(data)
+	if err != nil {
+		return nil, err
+	}
 	reader := &BinlogReader{
 		buffer:  bytes.NewBuffer(data),
 		isClose: false,
@@ -0,0 +1,458 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"container/list"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/exp/mmap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
+)
+
+// cachedFileTmpSuffix is the suffix of the cached files being written, they are renamed once complete,
+// so a crash never leaves a partial file in the cache
+const cachedFileTmpSuffix = ".caching"
+
+type cachedFile struct {
+	key  string
+	size int64
+}
+
+// CachedChunkManager is a ChunkManager caching the objects read from the remote ChunkManager on the local disk,
+// the least recently used objects are evicted once the cached size exceeds the capacity.
+// The objects are assumed immutable once written, such as the binlogs and the index files,
+// the writes and removes through it invalidate the cached objects.
+// The cached objects are reloaded on creation, so the cache survives restarts, the cache dir must not be shared.
+// The cached files take the local disk quota shared with the other stores of the node as well, the least recently
+// used objects are evicted to make room in it.
+type CachedChunkManager struct {
+	ChunkManager
+	cacheDir string
+	capacity int64
+	quota    *DiskQuota
+
+	mu        sync.Mutex
+	size      int64
+	evictList *list.List
+	items     map[string]*list.Element
+
+	// number of the reads through the cache and the ones hit, accessed atomically
+	cacheReads int64
+	cacheHits  int64
+}
+
+var _ ChunkManager = (*CachedChunkManager)(nil)
+
+// NewCachedChunkManager creates a CachedChunkManager in front of the remote ChunkManager,
+// caching at most capacity bytes in cacheDir within the quota, nil if the cache takes no quota.
+func NewCachedChunkManager(remote ChunkManager, cacheDir string, capacity int64, quota *DiskQuota) (*CachedChunkManager, error) {
+	if capacity <= 0 {
+		return nil, errors.New("cache capacity must be positive")
+	}
+	if err := os.MkdirAll(cacheDir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	ccm := &CachedChunkManager{
+		ChunkManager: remote,
+		cacheDir:     cacheDir,
+		capacity:     capacity,
+		quota:        quota,
+		evictList:    list.New(),
+		items:        make(map[string]*list.Element),
+	}
+	if err := ccm.reload(); err != nil {
+		return nil, err
+	}
+	log.Info("cached chunk manager reloaded the cache",
+		zap.String("cacheDir", cacheDir),
+		zap.Int("numFiles", ccm.evictList.Len()),
+		zap.Int64("size", ccm.size))
+	return ccm, nil
+}
+
+// reload adds the files cached before in the order of the modification time, as the files are touched once read
+func (ccm *CachedChunkManager) reload() error {
+	var files []os.FileInfo
+	var keys []string
+	err := filepath.Walk(ccm.cacheDir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		if strings.HasSuffix(filePath, cachedFileTmpSuffix) {
+			return os.Remove(filePath)
+		}
+		key, err := filepath.Rel(ccm.cacheDir, filePath)
+		if err != nil {
+			return err
+		}
+		files = append(files, info)
+		keys = append(keys, filepath.ToSlash(key))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	idx := make([]int, len(files))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool {
+		return files[idx[i]].ModTime().Before(files[idx[j]].ModTime())
+	})
+
+	var evicted []string
+	ccm.mu.Lock()
+	for _, i := range idx {
+		victims, ok := ccm.reserveLocked(files[i].Size())
+		evicted = append(evicted, victims...)
+		if !ok {
+			evicted = append(evicted, keys[i])
+			continue
+		}
+		evicted = append(evicted, ccm.addLocked(keys[i], files[i].Size())...)
+	}
+	ccm.mu.Unlock()
+	ccm.removeFiles(evicted)
+	return nil
+}
+
+func (ccm *CachedChunkManager) localPath(key string) string {
+	return filepath.Join(ccm.cacheDir, filepath.FromSlash(key))
+}
+
+// reserveLocked acquires size bytes of the quota, evicting the least recently used files until they fit. It returns
+// the keys of the files evicted, and false if the bytes don't fit even if the cache is empty.
+func (ccm *CachedChunkManager) reserveLocked(size int64) ([]string, bool) {
+	var evicted []string
+	for !ccm.quota.TryAcquire(size) {
+		oldest := ccm.evictList.Back()
+		if oldest == nil {
+			return evicted, false
+		}
+		key := oldest.Value.(*cachedFile).key
+		ccm.removeLocked(key)
+		evicted = append(evicted, key)
+	}
+	return evicted, true
+}
+
+// addLocked adds the cached file, whose bytes of the quota are acquired, to the front of the lru list, and evicts the
+// least recently used files beyond the capacity. It returns the keys of the files evicted.
+func (ccm *CachedChunkManager) addLocked(key string, size int64) []string {
+	// the file replaced has been overwritten, only its entry is removed
+	ccm.removeLocked(key)
+	ccm.items[key] = ccm.evictList.PushFront(&cachedFile{key: key, size: size})
+	ccm.size += size
+
+	var evicted []string
+	for ccm.size > ccm.capacity {
+		oldest := ccm.evictList.Back()
+		if oldest == nil {
+			break
+		}
+		key := oldest.Value.(*cachedFile).key
+		ccm.removeLocked(key)
+		evicted = append(evicted, key)
+	}
+	return evicted
+}
+
+// removeLocked removes the cached file from the lru list and releases its bytes of the quota, the file itself is
+// removed by removeFiles after unlocking
+func (ccm *CachedChunkManager) removeLocked(key string) bool {
+	e, ok := ccm.items[key]
+	if !ok {
+		return false
+	}
+	file := e.Value.(*cachedFile)
+	ccm.evictList.Remove(e)
+	delete(ccm.items, key)
+	ccm.size -= file.size
+	ccm.quota.Release(file.size)
+	return true
+}
+
+// removeFiles removes the files of the keys removed from the lru list. A file cached again meanwhile is removed as
+// well, the reads of it invalidate the entry and fall back to the remote then.
+func (ccm *CachedChunkManager) removeFiles(keys []string) {
+	for _, key := range keys {
+		if err := os.Remove(ccm.localPath(key)); err != nil && !os.IsNotExist(err) {
+			log.Warn("failed to remove the cached file", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
+
+// getCached returns the local path of the cached object and marks it as recently used
+func (ccm *CachedChunkManager) getCached(key string) (string, bool) {
+	ccm.mu.Lock()
+	e, ok := ccm.items[key]
+	atomic.AddInt64(&ccm.cacheReads, 1)
+	if !ok {
+		ccm.mu.Unlock()
+		return "", false
+	}
+	atomic.AddInt64(&ccm.cacheHits, 1)
+	ccm.evictList.MoveToFront(e)
+	ccm.mu.Unlock()
+
+	// touch the file to keep the lru order across restarts, it's fine to lose the touch if the file is evicted meanwhile
+	localPath := ccm.localPath(key)
+	now := time.Now()
+	_ = os.Chtimes(localPath, now, now)
+	return localPath, true
+}
+
+// cache writes the object to the local disk, the objects larger than the capacity or the quota are not cached
+func (ccm *CachedChunkManager) cache(key string, content []byte) {
+	size := int64(len(content))
+	if size > ccm.capacity {
+		return
+	}
+	ccm.mu.Lock()
+	evicted, ok := ccm.reserveLocked(size)
+	ccm.mu.Unlock()
+	ccm.removeFiles(evicted)
+	if !ok {
+		return
+	}
+
+	localPath := ccm.localPath(key)
+	err := os.MkdirAll(filepath.Dir(localPath), os.ModePerm)
+	if err == nil {
+		err = writeFileAtomically(localPath, content)
+	}
+	if err != nil {
+		ccm.quota.Release(size)
+		log.Warn("failed to cache the object", zap.String("key", key), zap.Error(err))
+		return
+	}
+
+	ccm.mu.Lock()
+	evicted = ccm.addLocked(key, size)
+	ccm.mu.Unlock()
+	ccm.removeFiles(evicted)
+}
+
+// writeFileAtomically writes the content to a unique temp file and renames it to the path,
+// so the concurrent readers caching the same object never write to the same file
+func writeFileAtomically(path string, content []byte) error {
+	tmpFile, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*"+cachedFileTmpSuffix)
+	if err != nil {
+		return err
+	}
+	_, err = tmpFile.Write(content)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpFile.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmpFile.Name())
+	}
+	return err
+}
+
+func (ccm *CachedChunkManager) invalidate(keys ...string) {
+	removed := make([]string, 0, len(keys))
+	ccm.mu.Lock()
+	for _, key := range keys {
+		if ccm.removeLocked(key) {
+			removed = append(removed, key)
+		}
+	}
+	ccm.mu.Unlock()
+	ccm.removeFiles(removed)
+}
+
+// CacheStats returns the number of the reads through the cache and the ones hit the cache.
+func (ccm *CachedChunkManager) CacheStats() (hits int64, reads int64) {
+	return atomic.LoadInt64(&ccm.cacheHits), atomic.LoadInt64(&ccm.cacheReads)
+}
+
+// CacheUsage returns the bytes of the cached objects and the capacity.
+func (ccm *CachedChunkManager) CacheUsage() (size int64, limit int64) {
+	ccm.mu.Lock()
+	defer ccm.mu.Unlock()
+	return ccm.size, ccm.capacity
+}
+
+// Size returns the size of the cached object if cached, otherwise the remote one.
+func (ccm *CachedChunkManager) Size(filePath string) (int64, error) {
+	ccm.mu.Lock()
+	e, ok := ccm.items[filePath]
+	ccm.mu.Unlock()
+	if ok {
+		return e.Value.(*cachedFile).size, nil
+	}
+	return ccm.ChunkManager.Size(filePath)
+}
+
+// Write writes the object to the remote, and invalidates the cached one.
+func (ccm *CachedChunkManager) Write(filePath string, content []byte) error {
+	defer ccm.invalidate(filePath)
+	return ccm.ChunkManager.Write(filePath, content)
+}
+
+// MultiWrite writes the objects to the remote, and invalidates the cached ones.
+func (ccm *CachedChunkManager) MultiWrite(contents map[string][]byte) error {
+	keys := make([]string, 0, len(contents))
+	for key := range contents {
+		keys = append(keys, key)
+	}
+	defer ccm.invalidate(keys...)
+	return ccm.ChunkManager.MultiWrite(contents)
+}
+
+// Read reads the object from the local disk if cached, otherwise reads it from the remote and caches it.
+func (ccm *CachedChunkManager) Read(filePath string) ([]byte, error) {
+	if localPath, ok := ccm.getCached(filePath); ok {
+		content, err := ioutil.ReadFile(localPath)
+		if err == nil {
+			return content, nil
+		}
+		log.Warn("failed to read the cached file, read it from remote", zap.String("key", filePath), zap.Error(err))
+		ccm.invalidate(filePath)
+	}
+
+	content, err := ccm.ChunkManager.Read(filePath)
+	if err != nil {
+		return nil, err
+	}
+	ccm.cache(filePath, content)
+	return content, nil
+}
+
+// MultiRead reads the objects through the cache.
+func (ccm *CachedChunkManager) MultiRead(filePaths []string) ([][]byte, error) {
+	var el errorutil.ErrorList
+	results := make([][]byte, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		content, err := ccm.Read(filePath)
+		if err != nil {
+			el = append(el, err)
+		}
+		results = append(results, content)
+	}
+	if len(el) == 0 {
+		return results, nil
+	}
+	return results, el
+}
+
+func (ccm *CachedChunkManager) ReadWithPrefix(prefix string) ([]string, [][]byte, error) {
+	filePaths, err := ccm.ListWithPrefix(prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	results, err := ccm.MultiRead(filePaths)
+	if err != nil {
+		return nil, nil, err
+	}
+	return filePaths, results, nil
+}
+
+// ReadAt reads specific position data of the object from the local disk if cached,
+// otherwise reads the range from the remote without caching the object.
+func (ccm *CachedChunkManager) ReadAt(filePath string, off int64, length int64) ([]byte, error) {
+	if localPath, ok := ccm.getCached(filePath); ok {
+		p, err := readLocalFileAt(localPath, off, length)
+		if err == nil {
+			return p, nil
+		}
+		log.Warn("failed to read the cached file, read it from remote", zap.String("key", filePath), zap.Error(err))
+		ccm.invalidate(filePath)
+	}
+	return ccm.ChunkManager.ReadAt(filePath, off, length)
+}
+
+func readLocalFileAt(localPath string, off int64, length int64) ([]byte, error) {
+	file, err := os.Open(localPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	p := make([]byte, length)
+	_, err = file.ReadAt(p, off)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Mmap maps the cached file, the object must be cached. The object is cached again from the remote if the cached
+// file fails to open, such as the one removed by an eviction racing with the cache of it.
+func (ccm *CachedChunkManager) Mmap(filePath string) (*mmap.ReaderAt, error) {
+	localPath, ok := ccm.getCached(filePath)
+	if !ok {
+		return nil, errors.New("the file mmap has not been cached")
+	}
+	reader, err := mmap.Open(localPath)
+	if err == nil {
+		return reader, nil
+	}
+	log.Warn("failed to open the cached file, cache it from remote again", zap.String("key", filePath), zap.Error(err))
+	ccm.invalidate(filePath)
+	if _, err = ccm.Read(filePath); err != nil {
+		return nil, err
+	}
+	if localPath, ok = ccm.getCached(filePath); ok {
+		return mmap.Open(localPath)
+	}
+	return nil, errors.New("the file mmap has not been cached")
+}
+
+// Remove removes the object from the remote and the cache.
+func (ccm *CachedChunkManager) Remove(filePath string) error {
+	defer ccm.invalidate(filePath)
+	return ccm.ChunkManager.Remove(filePath)
+}
+
+// MultiRemove removes the objects from the remote and the cache.
+func (ccm *CachedChunkManager) MultiRemove(filePaths []string) error {
+	defer ccm.invalidate(filePaths...)
+	return ccm.ChunkManager.MultiRemove(filePaths)
+}
+
+// RemoveWithPrefix removes the objects with the prefix from the remote and the cache.
+func (ccm *CachedChunkManager) RemoveWithPrefix(prefix string) error {
+	defer func() {
+		var removed []string
+		ccm.mu.Lock()
+		for key := range ccm.items {
+			if strings.HasPrefix(key, prefix) && ccm.removeLocked(key) {
+				removed = append(removed, key)
+			}
+		}
+		ccm.mu.Unlock()
+		ccm.removeFiles(removed)
+	}()
+	return ccm.ChunkManager.RemoveWithPrefix(prefix)
+}
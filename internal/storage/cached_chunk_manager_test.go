@@ -0,0 +1,246 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedChunkManager(t *testing.T) {
+	remote := NewLocalChunkManager(RootPath(t.TempDir()))
+	cacheDir := t.TempDir()
+
+	_, err := NewCachedChunkManager(remote, cacheDir, 0, nil)
+	assert.Error(t, err)
+
+	ccm, err := NewCachedChunkManager(remote, cacheDir, 10, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, remote.Write("a/key_1", []byte("1111")))
+	require.NoError(t, remote.Write("a/key_2", []byte("2222")))
+	require.NoError(t, remote.Write("b/key_3", []byte("3333")))
+	require.NoError(t, remote.Write("b/large", []byte("01234567890")))
+
+	t.Run("test read", func(t *testing.T) {
+		content, err := ccm.Read("a/key_1")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("1111"), content)
+		content, err = ccm.Read("a/key_1")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("1111"), content)
+		hits, reads := ccm.CacheStats()
+		assert.Equal(t, int64(1), hits)
+		assert.Equal(t, int64(2), reads)
+
+		size, err := ccm.Size("a/key_1")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), size)
+		p, err := ccm.ReadAt("a/key_1", 1, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("11"), p)
+		reader, err := ccm.Mmap("a/key_1")
+		assert.NoError(t, err)
+		reader.Close()
+
+		// the objects larger than the capacity are not cached
+		content, err = ccm.Read("b/large")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("01234567890"), content)
+		used, limit := ccm.CacheUsage()
+		assert.Equal(t, int64(4), used)
+		assert.Equal(t, int64(10), limit)
+		p, err = ccm.ReadAt("b/large", 1, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("12"), p)
+		_, err = ccm.Mmap("b/large")
+		assert.Error(t, err)
+
+		_, err = ccm.Read("not_exist")
+		assert.Error(t, err)
+		_, err = ccm.MultiRead([]string{"a/key_1", "not_exist"})
+		assert.Error(t, err)
+	})
+
+	t.Run("test evict", func(t *testing.T) {
+		_, err := ccm.Read("a/key_2")
+		assert.NoError(t, err)
+
+		// key_1 is the least recently used one
+		_, err = ccm.Read("b/key_3")
+		assert.NoError(t, err)
+		used, _ := ccm.CacheUsage()
+		assert.Equal(t, int64(8), used)
+		_, err = os.Stat(filepath.Join(cacheDir, "a", "key_1"))
+		assert.True(t, os.IsNotExist(err))
+		_, err = os.Stat(filepath.Join(cacheDir, "b", "key_3"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("test invalidate", func(t *testing.T) {
+		assert.NoError(t, ccm.Write("b/key_3", []byte("3")))
+		content, err := ccm.Read("b/key_3")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("3"), content)
+
+		assert.NoError(t, ccm.MultiWrite(map[string][]byte{"a/key_2": []byte("2")}))
+		content, err = ccm.Read("a/key_2")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("2"), content)
+
+		assert.NoError(t, ccm.Remove("b/key_3"))
+		_, err = ccm.Read("b/key_3")
+		assert.Error(t, err)
+
+		assert.NoError(t, ccm.RemoveWithPrefix("a/"))
+		used, _ := ccm.CacheUsage()
+		assert.Equal(t, int64(0), used)
+		_, err = ccm.Read("a/key_2")
+		assert.Error(t, err)
+	})
+
+	t.Run("test concurrent read", func(t *testing.T) {
+		require.NoError(t, remote.Write("d/key_7", []byte("7777")))
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				content, err := ccm.Read("d/key_7")
+				assert.NoError(t, err)
+				assert.Equal(t, []byte("7777"), content)
+			}()
+		}
+		wg.Wait()
+
+		content, err := ioutil.ReadFile(filepath.Join(cacheDir, "d", "key_7"))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("7777"), content)
+		files, err := ioutil.ReadDir(filepath.Join(cacheDir, "d"))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(files))
+		assert.NoError(t, ccm.Remove("d/key_7"))
+	})
+
+	t.Run("test reload", func(t *testing.T) {
+		require.NoError(t, remote.Write("c/key_4", []byte("4444")))
+		require.NoError(t, remote.Write("c/key_5", []byte("5555")))
+		_, err := ccm.MultiRead([]string{"c/key_4", "c/key_5"})
+		assert.NoError(t, err)
+		// the partial files are removed on reload
+		require.NoError(t, ioutil.WriteFile(filepath.Join(cacheDir, "c", "key_6"+cachedFileTmpSuffix), []byte("6"), 0600))
+
+		reloaded, err := NewCachedChunkManager(remote, cacheDir, 4, nil)
+		require.NoError(t, err)
+		used, _ := reloaded.CacheUsage()
+		assert.Equal(t, int64(4), used)
+		_, err = os.Stat(filepath.Join(cacheDir, "c", "key_6"+cachedFileTmpSuffix))
+		assert.True(t, os.IsNotExist(err))
+
+		_, err = reloaded.Read("c/key_5")
+		assert.NoError(t, err)
+		hits, reads := reloaded.CacheStats()
+		assert.Equal(t, int64(1), hits)
+		assert.Equal(t, int64(1), reads)
+	})
+}
+
+func TestCachedChunkManager_MissingFile(t *testing.T) {
+	remote := NewLocalChunkManager(RootPath(t.TempDir()))
+	cacheDir := t.TempDir()
+	require.NoError(t, remote.Write("key_1", []byte("1111")))
+	quota := NewDiskQuota(10)
+	ccm, err := NewCachedChunkManager(remote, cacheDir, 10, quota)
+	require.NoError(t, err)
+
+	// the cached file is removed as if by an eviction racing with the cache of it, the entry is invalidated
+	_, err = ccm.Read("key_1")
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(filepath.Join(cacheDir, "key_1")))
+	p, err := ccm.ReadAt("key_1", 1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("11"), p)
+	used, _ := ccm.CacheUsage()
+	assert.Equal(t, int64(0), used)
+	used, _ = quota.Usage()
+	assert.Equal(t, int64(0), used)
+
+	// and cached again from the remote to be mapped
+	_, err = ccm.Read("key_1")
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(filepath.Join(cacheDir, "key_1")))
+	reader, err := ccm.Mmap("key_1")
+	require.NoError(t, err)
+	assert.Equal(t, 4, reader.Len())
+	reader.Close()
+	used, _ = quota.Usage()
+	assert.Equal(t, int64(4), used)
+}
+
+func TestCachedChunkManager_Quota(t *testing.T) {
+	remote := NewLocalChunkManager(RootPath(t.TempDir()))
+	cacheDir := t.TempDir()
+	require.NoError(t, remote.Write("key_1", []byte("1111")))
+	require.NoError(t, remote.Write("key_2", []byte("2222")))
+
+	// the other stores take 4 bytes of the quota
+	quota := NewDiskQuota(10)
+	require.True(t, quota.TryAcquire(4))
+	ccm, err := NewCachedChunkManager(remote, cacheDir, 10, quota)
+	require.NoError(t, err)
+
+	// key_1 is evicted to make room in the quota, though the capacity is not exceeded
+	_, err = ccm.Read("key_1")
+	assert.NoError(t, err)
+	_, err = ccm.Read("key_2")
+	assert.NoError(t, err)
+	used, _ := ccm.CacheUsage()
+	assert.Equal(t, int64(4), used)
+	used, _ = quota.Usage()
+	assert.Equal(t, int64(8), used)
+	_, err = os.Stat(filepath.Join(cacheDir, "key_1"))
+	assert.True(t, os.IsNotExist(err))
+
+	// the quota is released by the objects invalidated
+	require.NoError(t, ccm.Remove("key_2"))
+	used, _ = quota.Usage()
+	assert.Equal(t, int64(4), used)
+
+	// the objects don't fit in the quota are not cached
+	require.True(t, quota.TryAcquire(3))
+	_, err = ccm.Read("key_1")
+	assert.NoError(t, err)
+	used, _ = ccm.CacheUsage()
+	assert.Equal(t, int64(0), used)
+	_, err = os.Stat(filepath.Join(cacheDir, "key_1"))
+	assert.True(t, os.IsNotExist(err))
+
+	// the quota is taken by the files reloaded
+	quota.Release(3)
+	_, err = ccm.Read("key_1")
+	assert.NoError(t, err)
+	reloaded, err := NewCachedChunkManager(remote, cacheDir, 10, NewDiskQuota(10))
+	require.NoError(t, err)
+	used, _ = reloaded.quota.Usage()
+	assert.Equal(t, int64(4), used)
+}
@@ -0,0 +1,50 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"github.com/milvus-io/milvus/internal/util/compressor"
+)
+
+// compressBlobs compresses the whole values of the binlog blobs, the empty compress type leaves them as they are.
+// The compressed blobs are told from the plain ones by the magic number of the compressed frame, which never
+// collides with the magic number of binlog, so that the readers could decompress them transparently.
+// The sizes of the values before compressed are kept as the memory sizes of the blobs.
+func compressBlobs(typ compressor.CompressType, blobs ...*Blob) error {
+	for _, blob := range blobs {
+		blob.MemorySize = int64(len(blob.Value))
+	}
+	if typ == "" {
+		return nil
+	}
+	for _, blob := range blobs {
+		compressed, err := compressor.CompressBytes(typ, blob.Value, nil)
+		if err != nil {
+			return err
+		}
+		blob.Value = compressed
+	}
+	return nil
+}
+
+// decompressIfNeeded returns the decompressed data if it is compressed, otherwise the data itself
+func decompressIfNeeded(data []byte) ([]byte, error) {
+	if _, ok := compressor.DetectCompressType(data); !ok {
+		return data, nil
+	}
+	return compressor.DecompressBytes(data, nil)
+}
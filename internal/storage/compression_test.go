@@ -0,0 +1,64 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/compressor"
+)
+
+func TestCompressBlobs(t *testing.T) {
+	value := []byte(`{"fieldID":100,"max":10,"min":1}`)
+
+	blob := &Blob{Key: "100", Value: value}
+	assert.NoError(t, compressBlobs("", blob))
+	assert.Equal(t, value, blob.Value)
+	assert.Equal(t, int64(len(value)), blob.MemorySize)
+
+	for _, typ := range []compressor.CompressType{compressor.CompressTypeZstd, compressor.CompressTypeLz4} {
+		blob := &Blob{Key: "100", Value: value}
+		assert.NoError(t, compressBlobs(typ, blob))
+		assert.NotEqual(t, value, blob.Value)
+		assert.Equal(t, int64(len(value)), blob.MemorySize)
+
+		decompressed, err := decompressIfNeeded(blob.Value)
+		assert.NoError(t, err)
+		assert.Equal(t, value, decompressed)
+	}
+
+	assert.Error(t, compressBlobs("unknown", &Blob{Value: value}))
+
+	plain, err := decompressIfNeeded(value)
+	assert.NoError(t, err)
+	assert.Equal(t, value, plain)
+}
+
+func TestDeserializeStats_compressed(t *testing.T) {
+	sw := &StatsWriter{}
+	assert.NoError(t, sw.generatePrimaryKeyStats(100, schemapb.DataType_Int64, &Int64FieldData{Data: []int64{1, 10}}))
+	blob := &Blob{Key: "100", Value: sw.GetBuffer()}
+	assert.NoError(t, compressBlobs(compressor.CompressTypeLz4, blob))
+
+	stats, err := DeserializeStats([]*Blob{blob})
+	assert.NoError(t, err)
+	assert.Len(t, stats, 1)
+	assert.Equal(t, int64(100), stats[0].FieldID)
+}
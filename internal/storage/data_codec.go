@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/compressor"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -71,6 +72,8 @@ const InvalidUniqueID = UniqueID(-1)
 type Blob struct {
 	Key   string
 	Value []byte
+	// MemorySize is the size of the value before compressed, set by the codecs which compress the values
+	MemorySize int64
 }
 
 // BlobList implements sort.Interface for a list of Blob
@@ -259,6 +262,8 @@ type InsertData struct {
 // ${tenant}/insert_log/${collection_id}/${partition_id}/${segment_id}/${field_id}/${log_idx}
 type InsertCodec struct {
 	Schema *etcdpb.CollectionMeta
+	// CompressType compresses the serialized insert and stats binlogs, empty means no compression
+	CompressType compressor.CompressType
 }
 
 // NewInsertCodec creates an InsertCodec with provided collection meta
@@ -418,7 +423,11 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 
 		// stats fields
 		if field.GetIsPrimaryKey() {
-			statsWriter := &StatsWriter{}
+			bfParams, err := GetBloomFilterParams(field)
+			if err != nil {
+				return nil, nil, err
+			}
+			statsWriter := &StatsWriter{bfParams: bfParams}
 			err = statsWriter.generatePrimaryKeyStats(field.FieldID, field.DataType, singleData)
 			if err != nil {
 				return nil, nil, err
@@ -431,6 +440,12 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		}
 	}
 
+	if err := compressBlobs(insertCodec.CompressType, blobs...); err != nil {
+		return nil, nil, err
+	}
+	if err := compressBlobs(insertCodec.CompressType, statsBlobs...); err != nil {
+		return nil, nil, err
+	}
 	return blobs, statsBlobs, nil
 }
 
@@ -733,6 +748,8 @@ func (data *DeleteData) Append(pk PrimaryKey, ts Timestamp) {
 
 // DeleteCodec serializes and deserializes the delete data
 type DeleteCodec struct {
+	// CompressType compresses the serialized delta binlogs, empty means no compression
+	CompressType compressor.CompressType
 }
 
 // NewDeleteCodec returns a DeleteCodec
@@ -802,6 +819,9 @@ func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID Uni
 	blob := &Blob{
 		Value: buffer,
 	}
+	if err := compressBlobs(deleteCodec.CompressType, blob); err != nil {
+		return nil, err
+	}
 	return blob, nil
 }
 
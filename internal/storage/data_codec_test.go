@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/util/compressor"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 
@@ -383,6 +384,29 @@ func TestDeleteCodec(t *testing.T) {
 		assert.Equal(t, sid, int64(1))
 		assert.Equal(t, data, deleteData)
 	})
+
+	t.Run("compressed", func(t *testing.T) {
+		deleteData := &DeleteData{}
+		deleteData.Append(&Int64PrimaryKey{Value: 1}, 43757345)
+		deleteData.Append(&Int64PrimaryKey{Value: 2}, 23578294723)
+
+		for _, typ := range []compressor.CompressType{compressor.CompressTypeZstd, compressor.CompressTypeLz4} {
+			deleteCodec := NewDeleteCodec()
+			deleteCodec.CompressType = typ
+			blob, err := deleteCodec.Serialize(CollectionID, 1, 1, deleteData)
+			assert.Nil(t, err)
+			detected, ok := compressor.DetectCompressType(blob.Value)
+			assert.True(t, ok)
+			assert.Equal(t, typ, detected)
+
+			// the plain codec reads the compressed binlog as well
+			pid, sid, data, err := NewDeleteCodec().Deserialize([]*Blob{blob})
+			assert.Nil(t, err)
+			assert.Equal(t, pid, int64(1))
+			assert.Equal(t, sid, int64(1))
+			assert.Equal(t, data, deleteData)
+		}
+	})
 }
 
 func TestUpgradeDeleteLog(t *testing.T) {
@@ -548,16 +572,16 @@ func TestIndexCodec(t *testing.T) {
 	indexCodec := NewIndexCodec()
 	blobs := []*Blob{
 		{
-			"12345",
-			[]byte{1, 2, 3, 4, 5, 6, 7, 1, 2, 3, 4, 5, 6, 7},
+			Key:   "12345",
+			Value: []byte{1, 2, 3, 4, 5, 6, 7, 1, 2, 3, 4, 5, 6, 7},
 		},
 		{
-			"6666",
-			[]byte{6, 6, 6, 6, 6, 1, 2, 3, 4, 5, 6, 7},
+			Key:   "6666",
+			Value: []byte{6, 6, 6, 6, 6, 1, 2, 3, 4, 5, 6, 7},
 		},
 		{
-			"8885",
-			[]byte{8, 8, 8, 8, 8, 8, 8, 8, 2, 3, 4, 5, 6, 7},
+			Key:   "8885",
+			Value: []byte{8, 8, 8, 8, 8, 8, 8, 8, 2, 3, 4, 5, 6, 7},
 		},
 	}
 	indexParams := map[string]string{
@@ -0,0 +1,64 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import "sync/atomic"
+
+// DiskQuota is the quota of the local disk shared by the stores of a node, such as the cache of the remote objects
+// and the files of the swapped out segments. The stores acquire the bytes of the files
+// before writing them, and release them once the files are removed. A nil DiskQuota is unlimited.
+type DiskQuota struct {
+	limit int64
+	used  int64 // accessed atomically
+}
+
+// NewDiskQuota creates a DiskQuota of limit bytes.
+func NewDiskQuota(limit int64) *DiskQuota {
+	return &DiskQuota{limit: limit}
+}
+
+// TryAcquire acquires size bytes, returns false without acquiring them if the quota would be exceeded.
+func (q *DiskQuota) TryAcquire(size int64) bool {
+	if q == nil {
+		return true
+	}
+	for {
+		used := atomic.LoadInt64(&q.used)
+		if used+size > q.limit {
+			return false
+		}
+		if atomic.CompareAndSwapInt64(&q.used, used, used+size) {
+			return true
+		}
+	}
+}
+
+// Release releases size bytes acquired before.
+func (q *DiskQuota) Release(size int64) {
+	if q == nil {
+		return
+	}
+	atomic.AddInt64(&q.used, -size)
+}
+
+// Usage returns the bytes acquired and the limit, a nil DiskQuota has no limit.
+func (q *DiskQuota) Usage() (used int64, limit int64) {
+	if q == nil {
+		return 0, 0
+	}
+	return atomic.LoadInt64(&q.used), q.limit
+}
@@ -0,0 +1,44 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiskQuota(t *testing.T) {
+	quota := NewDiskQuota(10)
+	assert.True(t, quota.TryAcquire(6))
+	assert.False(t, quota.TryAcquire(5))
+	assert.True(t, quota.TryAcquire(4))
+	used, limit := quota.Usage()
+	assert.Equal(t, int64(10), used)
+	assert.Equal(t, int64(10), limit)
+	quota.Release(6)
+	used, _ = quota.Usage()
+	assert.Equal(t, int64(4), used)
+
+	// nil quota is unlimited
+	var unlimited *DiskQuota
+	assert.True(t, unlimited.TryAcquire(1<<40))
+	unlimited.Release(1 << 40)
+	used, limit = unlimited.Usage()
+	assert.Equal(t, int64(0), used)
+	assert.Equal(t, int64(0), limit)
+}
@@ -29,6 +29,10 @@ func (f *ChunkManagerFactory) newChunkManager(ctx context.Context, engine string
 		return NewLocalChunkManager(RootPath(f.config.rootPath)), nil
 	case "minio":
 		return newMinioChunkManagerWithConfig(ctx, f.config)
+	case "azure":
+		return newAzureChunkManagerWithConfig(ctx, f.config)
+	case "gcs":
+		return newGCSChunkManagerWithConfig(ctx, f.config)
 	default:
 		return nil, errors.New("no chunk manager implemented with engine: " + engine)
 	}
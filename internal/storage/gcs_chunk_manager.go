@@ -0,0 +1,266 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	gcs "cloud.google.com/go/storage"
+	"go.uber.org/zap"
+	"golang.org/x/exp/mmap"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+// GCSChunkManager is responsible for read and write data stored in google cloud storage.
+type GCSChunkManager struct {
+	client *gcs.Client
+	bucket *gcs.BucketHandle
+
+	ctx             context.Context
+	bucketName      string
+	uploadChunkSize int
+}
+
+var _ ChunkManager = (*GCSChunkManager)(nil)
+
+// newGCSChunkManagerWithConfig authenticates by the service account key file if set, otherwise by the
+// application default credentials, which is the workload identity on GKE.
+func newGCSChunkManagerWithConfig(ctx context.Context, c *config) (*GCSChunkManager, error) {
+	var opts []option.ClientOption
+	if c.gcsCredentialsFile != "" {
+		opts = append(opts, option.WithCredentialsFile(c.gcsCredentialsFile))
+	}
+	if c.gcsEndpoint != "" {
+		opts = append(opts, option.WithEndpoint(c.gcsEndpoint))
+	}
+	client, err := gcs.NewClient(ctx, opts...)
+	// no credential found, don't need to retry
+	if err != nil {
+		return nil, err
+	}
+	bucket := client.Bucket(c.bucketName)
+	// check valid in first query
+	checkBucketFn := func() error {
+		_, err := bucket.Attrs(ctx)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, gcs.ErrBucketNotExist) {
+			return err
+		}
+		log.Debug("gcs chunk manager new gcs client", zap.Any("Check bucket", "bucket not exist"))
+		if !c.createBucket {
+			return fmt.Errorf("bucket %s not Existed", c.bucketName)
+		}
+		if c.gcsProjectID == "" {
+			return retry.Unrecoverable(fmt.Errorf("bucket %s not Existed, project id is required to create it", c.bucketName))
+		}
+		log.Debug("gcs chunk manager create gcs bucket.", zap.Any("bucket name", c.bucketName))
+		return bucket.Create(ctx, c.gcsProjectID, nil)
+	}
+	err = retry.Do(ctx, checkBucketFn, retry.Attempts(100))
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	gcm := &GCSChunkManager{
+		client:          client,
+		bucket:          bucket,
+		ctx:             ctx,
+		bucketName:      c.bucketName,
+		uploadChunkSize: c.gcsUploadChunkSize,
+	}
+	log.Debug("gcs chunk manager new gcs client success.")
+
+	return gcm, nil
+}
+
+// Path returns the path of gcs data if exists.
+func (gcm *GCSChunkManager) Path(filePath string) (string, error) {
+	if !gcm.Exist(filePath) {
+		return "", errors.New("gcs file manage cannot be found with filePath:" + filePath)
+	}
+	return filePath, nil
+}
+
+// Reader returns the reader of gcs data if exists.
+func (gcm *GCSChunkManager) Reader(filePath string) (FileReader, error) {
+	return gcm.bucket.Object(filePath).NewReader(gcm.ctx)
+}
+
+func (gcm *GCSChunkManager) Size(filePath string) (int64, error) {
+	attrs, err := gcm.bucket.Object(filePath).Attrs(gcm.ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return attrs.Size, nil
+}
+
+// Write writes the data to gcs, the objects larger than the upload chunk size are uploaded by resumable uploads.
+func (gcm *GCSChunkManager) Write(filePath string, content []byte) error {
+	writer := gcm.bucket.Object(filePath).NewWriter(gcm.ctx)
+	writer.ChunkSize = gcm.uploadChunkSize
+	if _, err := writer.Write(content); err != nil {
+		writer.Close()
+		return err
+	}
+	return writer.Close()
+}
+
+// MultiWrite saves multiple objects, the path is the key of @kvs.
+// The object value is the value of @kvs.
+func (gcm *GCSChunkManager) MultiWrite(kvs map[string][]byte) error {
+	var el errorutil.ErrorList
+	for key, value := range kvs {
+		err := gcm.Write(key, value)
+		if err != nil {
+			el = append(el, err)
+		}
+	}
+	if len(el) == 0 {
+		return nil
+	}
+	return el
+}
+
+// Exist checks whether chunk is saved to gcs.
+func (gcm *GCSChunkManager) Exist(filePath string) bool {
+	_, err := gcm.bucket.Object(filePath).Attrs(gcm.ctx)
+	return err == nil
+}
+
+// Read reads the gcs data if exists.
+func (gcm *GCSChunkManager) Read(filePath string) ([]byte, error) {
+	reader, err := gcm.bucket.Object(filePath).NewReader(gcm.ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return ioutil.ReadAll(reader)
+}
+
+func (gcm *GCSChunkManager) MultiRead(keys []string) ([][]byte, error) {
+	var el errorutil.ErrorList
+	var objectsValues [][]byte
+	for _, key := range keys {
+		objectValue, err := gcm.Read(key)
+		if err != nil {
+			el = append(el, err)
+		}
+		objectsValues = append(objectsValues, objectValue)
+	}
+
+	if len(el) == 0 {
+		return objectsValues, nil
+	}
+	return objectsValues, el
+}
+
+func (gcm *GCSChunkManager) ReadWithPrefix(prefix string) ([]string, [][]byte, error) {
+	objectsKeys, err := gcm.ListWithPrefix(prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	objectsValues, err := gcm.MultiRead(objectsKeys)
+	if err != nil {
+		log.Error(fmt.Sprintf("GCS load with prefix error. path = %s", prefix), zap.Error(err))
+		return nil, nil, err
+	}
+
+	return objectsKeys, objectsValues, nil
+}
+
+func (gcm *GCSChunkManager) Mmap(filePath string) (*mmap.ReaderAt, error) {
+	return nil, errors.New("this method has not been implemented")
+}
+
+// ReadAt reads specific position data of gcs if exists, only the range is downloaded.
+func (gcm *GCSChunkManager) ReadAt(filePath string, off int64, length int64) ([]byte, error) {
+	if off < 0 || length < 0 {
+		return nil, io.EOF
+	}
+
+	reader, err := gcm.bucket.Object(filePath).NewRangeReader(gcm.ctx, off, length)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
+// Remove deletes an object with @key, it's not an error if the object doesn't exist.
+func (gcm *GCSChunkManager) Remove(key string) error {
+	err := gcm.bucket.Object(key).Delete(gcm.ctx)
+	if err != nil && !errors.Is(err, gcs.ErrObjectNotExist) {
+		return err
+	}
+	return nil
+}
+
+// MultiRemove deletes a objects with @keys.
+func (gcm *GCSChunkManager) MultiRemove(keys []string) error {
+	var el errorutil.ErrorList
+	for _, key := range keys {
+		err := gcm.Remove(key)
+		if err != nil {
+			el = append(el, err)
+		}
+	}
+	if len(el) == 0 {
+		return nil
+	}
+	return el
+}
+
+// RemoveWithPrefix removes all objects with the same prefix @prefix from gcs.
+func (gcm *GCSChunkManager) RemoveWithPrefix(prefix string) error {
+	objectsKeys, err := gcm.ListWithPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	return gcm.MultiRemove(objectsKeys)
+}
+
+// ListWithPrefix lists all the objects with the same prefix @prefix.
+func (gcm *GCSChunkManager) ListWithPrefix(prefix string) ([]string, error) {
+	it := gcm.bucket.Objects(gcm.ctx, &gcs.Query{Prefix: prefix})
+
+	var objectsKeys []string
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		objectsKeys = append(objectsKeys, attrs.Name)
+	}
+	return objectsKeys, nil
+}
@@ -0,0 +1,140 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGCSChunkManager(ctx context.Context, opts ...Option) (*GCSChunkManager, error) {
+	c := newDefaultConfig()
+	for _, opt := range append([]Option{BucketName("milvus-gcs-ut"), CreateBucket(true), GCSProjectID("milvus")}, opts...) {
+		opt(c)
+	}
+	return newGCSChunkManagerWithConfig(ctx, c)
+}
+
+func TestGCSCMFail(t *testing.T) {
+	client, err := newGCSChunkManager(context.Background(), GCSCredentialsFile(path.Join(t.TempDir(), "not-exist.json")))
+	assert.Error(t, err)
+	assert.Nil(t, client)
+}
+
+func TestGCSCM(t *testing.T) {
+	Params.Init()
+	// e.g. localhost:4443 of fake-gcs-server, the gcs emulator
+	emulatorHost := os.Getenv("STORAGE_EMULATOR_HOST")
+	if emulatorHost == "" {
+		t.Skip("gcs emulator is not configured")
+	}
+	testGCSRoot := path.Join(Params.LoadWithDefault("minio.rootPath", "files"), "milvus-gcs-ut-root")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// a small chunk size to upload by resumable uploads
+	testCM, err := newGCSChunkManager(ctx, GCSEndpoint("http://"+emulatorHost+"/storage/v1/"), GCSUploadChunkSize(256*1024))
+	require.NoError(t, err)
+	defer testCM.RemoveWithPrefix(testGCSRoot)
+
+	t.Run("test read write", func(t *testing.T) {
+		kvs := map[string][]byte{
+			path.Join(testGCSRoot, "rw", "key_1"):        []byte("111"),
+			path.Join(testGCSRoot, "rw", "key_2"):        []byte("222"),
+			path.Join(testGCSRoot, "rw", "sub", "key_3"): make([]byte, 1024*1024),
+		}
+		require.NoError(t, testCM.MultiWrite(kvs))
+
+		for key, value := range kvs {
+			got, err := testCM.Read(key)
+			assert.NoError(t, err)
+			assert.Equal(t, value, got)
+			assert.True(t, testCM.Exist(key))
+		}
+
+		keys, values, err := testCM.ReadWithPrefix(path.Join(testGCSRoot, "rw"))
+		assert.NoError(t, err)
+		assert.Len(t, keys, 3)
+		for i, key := range keys {
+			assert.Equal(t, kvs[key], values[i])
+		}
+
+		_, err = testCM.Read(path.Join(testGCSRoot, "rw", "key_not_exist"))
+		assert.Error(t, err)
+		assert.False(t, testCM.Exist(path.Join(testGCSRoot, "rw", "key_not_exist")))
+	})
+
+	t.Run("test ReadAt Size Path", func(t *testing.T) {
+		key := path.Join(testGCSRoot, "partial", "key")
+		value := []byte("TestGCSCM_ReadAt_value")
+		require.NoError(t, testCM.Write(key, value))
+
+		partial, err := testCM.ReadAt(key, 1, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, value[1:4], partial)
+		_, err = testCM.ReadAt(key, -1, 2)
+		assert.Error(t, err)
+
+		size, err := testCM.Size(key)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(len(value)), size)
+
+		p, err := testCM.Path(key)
+		assert.NoError(t, err)
+		assert.Equal(t, key, p)
+
+		reader, err := testCM.Reader(key)
+		assert.NoError(t, err)
+		reader.Close()
+
+		key2 := path.Join(testGCSRoot, "partial", "key_not_exist")
+		_, err = testCM.Size(key2)
+		assert.Error(t, err)
+		_, err = testCM.Path(key2)
+		assert.Error(t, err)
+	})
+
+	t.Run("test Remove", func(t *testing.T) {
+		keys := []string{
+			path.Join(testGCSRoot, "remove", "key_1"),
+			path.Join(testGCSRoot, "remove", "key_2"),
+			path.Join(testGCSRoot, "remove", "prefix_1"),
+			path.Join(testGCSRoot, "remove", "prefix_2"),
+		}
+		for _, key := range keys {
+			require.NoError(t, testCM.Write(key, []byte("value")))
+		}
+
+		assert.NoError(t, testCM.Remove(keys[0]))
+		assert.False(t, testCM.Exist(keys[0]))
+		// removing an object not existed is ok
+		assert.NoError(t, testCM.Remove(keys[0]))
+
+		assert.NoError(t, testCM.MultiRemove(keys[1:2]))
+		assert.False(t, testCM.Exist(keys[1]))
+
+		assert.NoError(t, testCM.RemoveWithPrefix(path.Join(testGCSRoot, "remove", "prefix")))
+		listed, err := testCM.ListWithPrefix(path.Join(testGCSRoot, "remove"))
+		assert.NoError(t, err)
+		assert.Empty(t, listed)
+	})
+}
@@ -9,6 +9,18 @@ type config struct {
 	useSSL            bool
 	createBucket      bool
 	rootPath          string
+
+	// azure blob storage, the bucket is the container
+	azureConnectionString        string
+	azureAccountName             string
+	azureEndpoint                string
+	azureManagedIdentityClientID string
+
+	// google cloud storage
+	gcsProjectID       string
+	gcsCredentialsFile string
+	gcsEndpoint        string
+	gcsUploadChunkSize int
 }
 
 func newDefaultConfig() *config {
@@ -58,3 +70,63 @@ func RootPath(rootPath string) Option {
 		c.rootPath = rootPath
 	}
 }
+
+// AzureConnectionString authenticates to azure blob storage by the connection string,
+// it takes precedence over the managed identity.
+func AzureConnectionString(connectionString string) Option {
+	return func(c *config) {
+		c.azureConnectionString = connectionString
+	}
+}
+
+// AzureAccountName is the storage account authenticated by the managed identity.
+func AzureAccountName(accountName string) Option {
+	return func(c *config) {
+		c.azureAccountName = accountName
+	}
+}
+
+// AzureEndpoint is the blob service endpoint, https://{accountName}.blob.core.windows.net/ by default.
+func AzureEndpoint(endpoint string) Option {
+	return func(c *config) {
+		c.azureEndpoint = endpoint
+	}
+}
+
+// AzureManagedIdentityClientID is the client id of the user-assigned managed identity,
+// the system-assigned one is used if empty.
+func AzureManagedIdentityClientID(clientID string) Option {
+	return func(c *config) {
+		c.azureManagedIdentityClientID = clientID
+	}
+}
+
+// GCSProjectID is the project the bucket is created in if not existed.
+func GCSProjectID(projectID string) Option {
+	return func(c *config) {
+		c.gcsProjectID = projectID
+	}
+}
+
+// GCSCredentialsFile is the key file of the service account,
+// the application default credentials are used if empty.
+func GCSCredentialsFile(credentialsFile string) Option {
+	return func(c *config) {
+		c.gcsCredentialsFile = credentialsFile
+	}
+}
+
+// GCSEndpoint overrides the endpoint of the json api, e.g. http://localhost:4443/storage/v1/ of an emulator.
+func GCSEndpoint(endpoint string) Option {
+	return func(c *config) {
+		c.gcsEndpoint = endpoint
+	}
+}
+
+// GCSUploadChunkSize is the chunk size of the resumable uploads,
+// the objects are uploaded in a single request if 0.
+func GCSUploadChunkSize(chunkSize int) Option {
+	return func(c *config) {
+		c.gcsUploadChunkSize = chunkSize
+	}
+}
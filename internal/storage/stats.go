@@ -18,18 +18,92 @@ package storage
 
 import (
 	"encoding/json"
+	"fmt"
+	"strconv"
 
 	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 )
 
 const (
-	// TODO silverxia maybe need set from config
-	bloomFilterSize       uint    = 100000
-	maxBloomFalsePositive float64 = 0.005
+	// DefaultBloomFilterCardinality is the expected cardinality of the pk bloom filter if not set in the schema
+	DefaultBloomFilterCardinality uint = 100000
+	// DefaultBloomFilterFPR is the false positive rate of the pk bloom filter if not set in the schema
+	DefaultBloomFilterFPR float64 = 0.005
 )
 
+// BloomFilterParams are the parameters the pk bloom filters of a collection are built with, set by the type params
+// of the primary key field. A larger cardinality or a lower false positive rate routes the deletes more accurately
+// at the cost of memory.
+type BloomFilterParams struct {
+	Cardinality uint
+	FPR         float64
+}
+
+// DefaultBloomFilterParams returns the parameters used if not set in the schema
+func DefaultBloomFilterParams() BloomFilterParams {
+	return BloomFilterParams{Cardinality: DefaultBloomFilterCardinality, FPR: DefaultBloomFilterFPR}
+}
+
+// GetBloomFilterParams returns the bloom filter parameters set in the type params of the primary key field,
+// the defaults are used for the ones not set.
+func GetBloomFilterParams(pkField *schemapb.FieldSchema) (BloomFilterParams, error) {
+	params := DefaultBloomFilterParams()
+	if value, err := funcutil.GetAttrByKeyFromRepeatedKV(common.BloomFilterCardinalityParam, pkField.GetTypeParams()); err == nil {
+		cardinality, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || cardinality == 0 {
+			return params, fmt.Errorf("invalid %s %s of field %s, should be a positive integer",
+				common.BloomFilterCardinalityParam, value, pkField.GetName())
+		}
+		params.Cardinality = uint(cardinality)
+	}
+	if value, err := funcutil.GetAttrByKeyFromRepeatedKV(common.BloomFilterFPRParam, pkField.GetTypeParams()); err == nil {
+		fpr, err := strconv.ParseFloat(value, 64)
+		if err != nil || fpr <= 0 || fpr >= 1 {
+			return params, fmt.Errorf("invalid %s %s of field %s, should be in range (0, 1)",
+				common.BloomFilterFPRParam, value, pkField.GetName())
+		}
+		params.FPR = fpr
+	}
+	return params, nil
+}
+
+// GetCollectionBloomFilterParams returns the bloom filter parameters of the collection, the defaults are used
+// if the schema is nil or the parameters are invalid.
+func GetCollectionBloomFilterParams(schema *schemapb.CollectionSchema) BloomFilterParams {
+	for _, field := range schema.GetFields() {
+		if !field.GetIsPrimaryKey() {
+			continue
+		}
+		if params, err := GetBloomFilterParams(field); err == nil {
+			return params
+		}
+		break
+	}
+	return DefaultBloomFilterParams()
+}
+
+// NewBloomFilter creates an empty bloom filter with the parameters
+func (p BloomFilterParams) NewBloomFilter() *bloom.BloomFilter {
+	return bloom.NewWithEstimates(p.Cardinality, p.FPR)
+}
+
+// MergeBloomFilter merges src into dst and returns the merged one. The bloom filters built with different
+// parameters couldn't be merged, a copy of src is returned instead if nothing is added to dst yet, so that
+// the parameters of the statslogs are honored.
+func MergeBloomFilter(dst, src *bloom.BloomFilter) (*bloom.BloomFilter, error) {
+	if dst.Cap() == src.Cap() && dst.K() == src.K() {
+		return dst, dst.Merge(src)
+	}
+	if !dst.Equal(bloom.New(dst.Cap(), dst.K())) {
+		return nil, fmt.Errorf("couldn't merge the bloom filter of m %d, k %d into the one of m %d, k %d",
+			src.Cap(), src.K(), dst.Cap(), dst.K())
+	}
+	return src.Copy(), nil
+}
+
 // PrimaryKeyStats contains statistics data for pk column
 type PrimaryKeyStats struct {
 	FieldID int64              `json:"fieldID"`
@@ -109,7 +183,7 @@ func (stats *PrimaryKeyStats) UnmarshalJSON(data []byte) error {
 		}
 	}
 
-	stats.BF = bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive)
+	stats.BF = DefaultBloomFilterParams().NewBloomFilter()
 	if bfMessage, ok := messageMap["bf"]; ok && bfMessage != nil {
 		err = stats.BF.UnmarshalJSON(*bfMessage)
 		if err != nil {
@@ -138,6 +212,8 @@ func (stats *PrimaryKeyStats) updatePk(pk PrimaryKey) {
 // StatsWriter writes stats to buffer
 type StatsWriter struct {
 	buffer []byte
+	// bfParams the bloom filter is built with, the defaults are used if zero
+	bfParams BloomFilterParams
 }
 
 // GetBuffer returns buffer
@@ -152,7 +228,11 @@ func (sw *StatsWriter) generatePrimaryKeyStats(fieldID int64, pkType schemapb.Da
 		PkType:  int64(pkType),
 	}
 
-	stats.BF = bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive)
+	bfParams := sw.bfParams
+	if bfParams.Cardinality == 0 || bfParams.FPR == 0 {
+		bfParams = DefaultBloomFilterParams()
+	}
+	stats.BF = bfParams.NewBloomFilter()
 	switch pkType {
 	case schemapb.DataType_Int64:
 		data := msgs.(*Int64FieldData).Data
@@ -221,8 +301,12 @@ func DeserializeStats(blobs []*Blob) ([]*PrimaryKeyStats, error) {
 		if blob.Value == nil {
 			continue
 		}
+		value, err := decompressIfNeeded(blob.Value)
+		if err != nil {
+			return nil, err
+		}
 		sr := &StatsReader{}
-		sr.SetBuffer(blob.Value)
+		sr.SetBuffer(value)
 		stats, err := sr.GetPrimaryKeyStats()
 		if err != nil {
 			return nil, err
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
@@ -98,7 +99,7 @@ func TestStatsWriter_UpgradePrimaryKey(t *testing.T) {
 		FieldID: common.RowIDField,
 		Min:     1,
 		Max:     9,
-		BF:      bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
+		BF:      bloom.NewWithEstimates(DefaultBloomFilterCardinality, DefaultBloomFilterFPR),
 	}
 
 	b := make([]byte, 8)
@@ -126,3 +127,64 @@ func TestStatsWriter_UpgradePrimaryKey(t *testing.T) {
 		assert.True(t, unmarshaledStats.BF.Test(buffer))
 	}
 }
+
+func TestGetBloomFilterParams(t *testing.T) {
+	field := &schemapb.FieldSchema{Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64}
+	params, err := GetBloomFilterParams(field)
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultBloomFilterParams(), params)
+
+	field.TypeParams = []*commonpb.KeyValuePair{
+		{Key: common.BloomFilterCardinalityParam, Value: "1000"},
+		{Key: common.BloomFilterFPRParam, Value: "0.01"},
+	}
+	params, err = GetBloomFilterParams(field)
+	assert.NoError(t, err)
+	assert.Equal(t, BloomFilterParams{Cardinality: 1000, FPR: 0.01}, params)
+	assert.Equal(t, params, GetCollectionBloomFilterParams(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}}))
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: common.BloomFilterCardinalityParam, Value: "0"}}
+	_, err = GetBloomFilterParams(field)
+	assert.Error(t, err)
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: common.BloomFilterFPRParam, Value: "1"}}
+	_, err = GetBloomFilterParams(field)
+	assert.Error(t, err)
+	assert.Equal(t, DefaultBloomFilterParams(), GetCollectionBloomFilterParams(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}}))
+	assert.Equal(t, DefaultBloomFilterParams(), GetCollectionBloomFilterParams(nil))
+}
+
+func TestStatsWriter_BloomFilterParams(t *testing.T) {
+	params := BloomFilterParams{Cardinality: 1000, FPR: 0.01}
+	sw := &StatsWriter{bfParams: params}
+	err := sw.generatePrimaryKeyStats(common.RowIDField, schemapb.DataType_Int64, &Int64FieldData{Data: []int64{1, 2, 3}})
+	assert.NoError(t, err)
+
+	sr := &StatsReader{}
+	sr.SetBuffer(sw.GetBuffer())
+	stats, err := sr.GetPrimaryKeyStats()
+	assert.NoError(t, err)
+	expected := params.NewBloomFilter()
+	assert.Equal(t, expected.Cap(), stats.BF.Cap())
+	assert.Equal(t, expected.K(), stats.BF.K())
+}
+
+func TestMergeBloomFilter(t *testing.T) {
+	src := BloomFilterParams{Cardinality: 1000, FPR: 0.01}.NewBloomFilter()
+	src.AddString("a")
+
+	// the empty filter takes the parameters of src
+	merged, err := MergeBloomFilter(DefaultBloomFilterParams().NewBloomFilter(), src)
+	assert.NoError(t, err)
+	assert.Equal(t, src.Cap(), merged.Cap())
+	assert.True(t, merged.TestString("a"))
+
+	other := BloomFilterParams{Cardinality: 1000, FPR: 0.01}.NewBloomFilter()
+	other.AddString("b")
+	merged, err = MergeBloomFilter(merged, other)
+	assert.NoError(t, err)
+	assert.True(t, merged.TestString("a"))
+	assert.True(t, merged.TestString("b"))
+
+	_, err = MergeBloomFilter(merged, DefaultBloomFilterParams().NewBloomFilter())
+	assert.Error(t, err)
+}
@@ -395,8 +395,13 @@ func RowBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *schemap
 }
 
 func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *schemapb.CollectionSchema) (idata *InsertData, err error) {
+	// the insert msg written before a field was added to the collection comes without it
+	fieldsData, err := typeutil.FillDefaultFieldsData(msg.FieldsData, collSchema, int(msg.NRows()))
+	if err != nil {
+		return nil, err
+	}
 	srcFields := make(map[FieldID]*schemapb.FieldData)
-	for _, field := range msg.FieldsData {
+	for _, field := range fieldsData {
 		srcFields[field.FieldId] = field
 	}
 
@@ -724,6 +729,87 @@ func MergeFieldData(data *InsertData, fid FieldID, field FieldData) {
 	}
 }
 
+// FillDefaultInsertData fills the fields with default values up to numRows rows. The missing rows are the earliest
+// ones written before the field was added to the collection, so the default values are put ahead of the existing ones.
+func FillDefaultInsertData(data *InsertData, schema *schemapb.CollectionSchema, numRows int) error {
+	for _, field := range schema.GetFields() {
+		value, ok, err := typeutil.GetDefaultValue(field)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		fid := field.GetFieldID()
+		existing, ok := data.Data[fid]
+		missing := numRows
+		if ok {
+			missing -= existing.RowNum()
+		}
+		if missing <= 0 {
+			continue
+		}
+		filled := &InsertData{Data: map[FieldID]FieldData{fid: genDefaultFieldData(value, missing)}}
+		MergeFieldData(filled, fid, existing)
+		data.Data[fid] = filled.Data[fid]
+	}
+	return nil
+}
+
+func genDefaultFieldData(value interface{}, numRows int) FieldData {
+	switch v := value.(type) {
+	case bool:
+		data := make([]bool, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		return &BoolFieldData{NumRows: []int64{int64(numRows)}, Data: data}
+	case int8:
+		data := make([]int8, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		return &Int8FieldData{NumRows: []int64{int64(numRows)}, Data: data}
+	case int16:
+		data := make([]int16, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		return &Int16FieldData{NumRows: []int64{int64(numRows)}, Data: data}
+	case int32:
+		data := make([]int32, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		return &Int32FieldData{NumRows: []int64{int64(numRows)}, Data: data}
+	case int64:
+		data := make([]int64, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		return &Int64FieldData{NumRows: []int64{int64(numRows)}, Data: data}
+	case float32:
+		data := make([]float32, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		return &FloatFieldData{NumRows: []int64{int64(numRows)}, Data: data}
+	case float64:
+		data := make([]float64, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		return &DoubleFieldData{NumRows: []int64{int64(numRows)}, Data: data}
+	case string:
+		data := make([]string, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		return &StringFieldData{NumRows: []int64{int64(numRows)}, Data: data}
+	}
+	return nil
+}
+
 // MergeInsertData merge insert datas. Maybe there are large write zoom if frequent inserts are met.
 func MergeInsertData(datas ...*InsertData) *InsertData {
 	ret := &InsertData{
@@ -1011,7 +1097,11 @@ func TransferInsertMsgToInsertRecord(schema *schemapb.CollectionSchema, msg *msg
 		NumRows: int64(msg.NumRows),
 	}
 
-	insertRecord.FieldsData = append(insertRecord.FieldsData, msg.FieldsData...)
+	fieldsData, err := typeutil.FillDefaultFieldsData(msg.FieldsData, schema, int(msg.NumRows))
+	if err != nil {
+		return nil, err
+	}
+	insertRecord.FieldsData = append(insertRecord.FieldsData, fieldsData...)
 
 	return insertRecord, nil
 }
@@ -1008,6 +1008,34 @@ func TestMergeInsertData(t *testing.T) {
 	assert.Equal(t, []float32{0, 0}, f.(*FloatVectorFieldData).Data)
 }
 
+func TestFillDefaultInsertData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: Int64Field, Name: "int64", DataType: schemapb.DataType_Int64},
+			{FieldID: Int32Field, Name: "int32", DataType: schemapb.DataType_Int32,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueParam, Value: "7"}}},
+			{FieldID: StringField, Name: "string", DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueParam, Value: "none"}}},
+		},
+	}
+	data := &InsertData{
+		Data: map[int64]FieldData{
+			Int64Field:  &Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}},
+			StringField: &StringFieldData{NumRows: []int64{1}, Data: []string{"c"}},
+		},
+	}
+
+	err := FillDefaultInsertData(data, schema, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, &Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}}, data.Data[Int64Field])
+	assert.Equal(t, &Int32FieldData{NumRows: []int64{3}, Data: []int32{7, 7, 7}}, data.Data[Int32Field])
+	assert.Equal(t, &StringFieldData{NumRows: []int64{3}, Data: []string{"none", "none", "c"}}, data.Data[StringField])
+
+	schema.Fields[1].TypeParams = []*commonpb.KeyValuePair{{Key: common.DefaultValueParam, Value: "seven"}}
+	err = FillDefaultInsertData(data, schema, 4)
+	assert.Error(t, err)
+}
+
 func TestGetPkFromInsertData(t *testing.T) {
 	var nilSchema *schemapb.CollectionSchema
 	_, err := GetPkFromInsertData(nilSchema, nil)
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"io"
 	"sync"
+	"sync/atomic"
 
 	"go.uber.org/zap"
 	"golang.org/x/exp/mmap"
@@ -47,6 +48,10 @@ type VectorChunkManager struct {
 	cacheSize      int64
 	cacheSizeMutex sync.Mutex
 	fixSize        bool // Prevent cache capactiy from changing too frequently
+
+	// number of the reads through the cache and the ones hit, accessed atomically
+	cacheReads int64
+	cacheHits  int64
 }
 
 var _ ChunkManager = (*VectorChunkManager)(nil)
@@ -180,10 +185,34 @@ func (vcm *VectorChunkManager) readWithCache(filePath string) ([]byte, error) {
 	return results, nil
 }
 
+func (vcm *VectorChunkManager) recordCacheRead(hit bool) {
+	atomic.AddInt64(&vcm.cacheReads, 1)
+	if hit {
+		atomic.AddInt64(&vcm.cacheHits, 1)
+	}
+}
+
+// CacheStats returns the number of the reads through the cache and the ones hit the cache.
+func (vcm *VectorChunkManager) CacheStats() (hits int64, reads int64) {
+	return atomic.LoadInt64(&vcm.cacheHits), atomic.LoadInt64(&vcm.cacheReads)
+}
+
+// CacheUsage returns the bytes of the cached vector data and the limit of them, zeros if the cache is disabled.
+func (vcm *VectorChunkManager) CacheUsage() (size int64, limit int64) {
+	if !vcm.cacheEnable {
+		return 0, 0
+	}
+	vcm.cacheSizeMutex.Lock()
+	defer vcm.cacheSizeMutex.Unlock()
+	return vcm.cacheSize, vcm.cacheLimit
+}
+
 // Read reads the pure vector data. If cached, it reads from local.
 func (vcm *VectorChunkManager) Read(filePath string) ([]byte, error) {
 	if vcm.cacheEnable {
-		if r, ok := vcm.cache.Get(filePath); ok {
+		r, ok := vcm.cache.Get(filePath)
+		vcm.recordCacheRead(ok)
+		if ok {
 			at := r.(*mmap.ReaderAt)
 			p := make([]byte, at.Len())
 			_, err := at.ReadAt(p, 0)
@@ -247,7 +276,9 @@ func (vcm *VectorChunkManager) Reader(filePath string) (FileReader, error) {
 // ReadAt reads specific position data of vector. If cached, it reads from local.
 func (vcm *VectorChunkManager) ReadAt(filePath string, off int64, length int64) ([]byte, error) {
 	if vcm.cacheEnable {
-		if r, ok := vcm.cache.Get(filePath); ok {
+		r, ok := vcm.cache.Get(filePath)
+		vcm.recordCacheRead(ok)
+		if ok {
 			at := r.(*mmap.ReaderAt)
 			p := make([]byte, length)
 			_, err := at.ReadAt(p, off)
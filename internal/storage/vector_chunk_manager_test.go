@@ -409,6 +409,20 @@ func TestVectorChunkManager_Read(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, 32, len(content))
 
+		hits, reads := vcm.CacheStats()
+		size, limit := vcm.CacheUsage()
+		if localCache {
+			assert.Equal(t, int64(6), hits)
+			assert.Equal(t, int64(11), reads)
+			assert.Greater(t, size, int64(0))
+			assert.Greater(t, limit, int64(0))
+		} else {
+			assert.Zero(t, hits)
+			assert.Zero(t, reads)
+			assert.Zero(t, size)
+			assert.Zero(t, limit)
+		}
+
 		if localCache {
 			r, err := vcm.Mmap("109")
 			assert.Nil(t, err)
@@ -277,6 +277,9 @@ type DataCoord interface {
 
 	// UpdateSegmentStatistics updates a segment's stats.
 	UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error)
+
+	// RefreshCollection replaces the schema cached for the collection once it's changed by RootCoord
+	RefreshCollection(ctx context.Context, req *datapb.RefreshCollectionRequest) (*commonpb.Status, error)
 }
 
 // DataCoordComponent defines the interface of DataCoord component.
@@ -329,6 +332,14 @@ type IndexCoord interface {
 	// index tasks. Therefore, when DropIndex is called, delete all tasks corresponding to IndexBuildID corresponding to IndexID.
 	DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error)
 
+	// CancelIndexBuild sets the unfinished index builds of the collection to be failed, the one of IndexBuildID, or all
+	// of the index of IndexName. The index nodes building them abandon the results.
+	CancelIndexBuild(ctx context.Context, req *indexpb.CancelIndexBuildRequest) (*milvuspb.CancelIndexBuildResponse, error)
+
+	// PrioritizeIndexBuild assigns the index builds of the collection to index nodes ahead of the others until none of
+	// them is pending, which is requested by QueryCoord when the collection is being loaded.
+	PrioritizeIndexBuild(ctx context.Context, req *indexpb.PrioritizeIndexBuildRequest) (*commonpb.Status, error)
+
 	// GetIndexStates gets the index states of the IndexBuildIDs in the request from RootCoordinator.
 	GetIndexStates(ctx context.Context, req *indexpb.GetIndexStatesRequest) (*indexpb.GetIndexStatesResponse, error)
 
@@ -521,6 +532,75 @@ type RootCoord interface {
 	// error is always nil
 	AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
 
+	// RenameCollection notifies RootCoord to rename the collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the old name and the new name of the collection
+	//
+	// The `ErrorCode` of `Status` is `Success` if rename collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error)
+
+	// AddCollectionField notifies RootCoord to add a scalar field with a default value to the collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the collection name and the schema of the field
+	//
+	// The `ErrorCode` of `Status` is `Success` if add field successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AddCollectionField(ctx context.Context, req *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error)
+
+	// AlterCollection notifies RootCoord to set or remove the properties of the collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the collection name and the properties, an empty value removes the property
+	//
+	// The `ErrorCode` of `Status` is `Success` if alter collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error)
+
+	// CreateDatabase notifies RootCoord to create a database
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the database name and the quotas of the database
+	//
+	// The `ErrorCode` of `Status` is `Success` if create database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+
+	// DropDatabase notifies RootCoord to drop a database without collections
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+
+	// AlterDatabase notifies RootCoord to set the quotas of a database
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the database name and the quotas to set
+	//
+	// The `ErrorCode` of `Status` is `Success` if alter database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AlterDatabase(ctx context.Context, req *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error)
+
+	// ListDatabases lists the databases, including the default one
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `ListDatabasesResponse` indicates if this operation is processed successfully or fail cause;
+	// error is always nil
+	ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
+
 	// AllocTimestamp notifies RootCoord to alloc timestamps
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -630,6 +710,16 @@ type RootCoord interface {
 	// error is always nil
 	ListImportTasks(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error)
 
+	// CancelImport cancels a pending or working import task
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including a task id
+	//
+	// The `Status` in response struct `GetImportStateResponse` indicates if this operation is processed successfully or fail cause;
+	// the `state` in `GetImportStateResponse` return the state of the import task after it's canceled.
+	// error is always nil
+	CancelImport(ctx context.Context, req *milvuspb.CancelImportRequest) (*milvuspb.GetImportStateResponse, error)
+
 	// ReportImport reports import task state to rootCoord
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -649,6 +739,21 @@ type RootCoord interface {
 	ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error)
 	// GetCredential get credential by username
 	GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error)
+
+	// SaveRole creates the role or replaces the grants of it
+	SaveRole(ctx context.Context, req *milvuspb.SaveRoleRequest) (*commonpb.Status, error)
+	// DropRole drops the role and unbinds it from the users
+	DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error)
+	// ListRoles lists the roles and their grants
+	ListRoles(ctx context.Context, req *milvuspb.ListRolesRequest) (*milvuspb.ListRolesResponse, error)
+	// SaveUserRoles binds the roles to the user
+	SaveUserRoles(ctx context.Context, req *milvuspb.SaveUserRolesRequest) (*commonpb.Status, error)
+	// ListUserRoles lists the roles bound to the users
+	ListUserRoles(ctx context.Context, req *milvuspb.ListUserRolesRequest) (*milvuspb.ListUserRolesResponse, error)
+	// CreateAPIKey creates an API key authenticating the requests as the user
+	CreateAPIKey(ctx context.Context, req *milvuspb.CreateAPIKeyRequest) (*milvuspb.CreateAPIKeyResponse, error)
+	// RevokeAPIKey revokes the API key
+	RevokeAPIKey(ctx context.Context, req *milvuspb.RevokeAPIKeyRequest) (*commonpb.Status, error)
 }
 
 // RootCoordComponent is used by grpc server of RootCoord
@@ -942,6 +1047,17 @@ type ProxyComponent interface {
 	// error is always nil
 	DropIndex(ctx context.Context, request *milvuspb.DropIndexRequest) (*commonpb.Status, error)
 
+	// CancelIndexBuild notifies Proxy to cancel the unfinished index builds of a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name, and the index build ID
+	// or the index name
+	//
+	// The `Status` in response struct `CancelIndexBuildResponse` indicates if this operation is processed successfully or fail cause;
+	// the `CanceledIndexBuildIDs` are the index builds canceled.
+	// error is always nil
+	CancelIndexBuild(ctx context.Context, request *milvuspb.CancelIndexBuildRequest) (*milvuspb.CancelIndexBuildResponse, error)
+
 	// DescribeIndex notifies Proxy to return index's description
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -985,6 +1101,17 @@ type ProxyComponent interface {
 	// error is always nil
 	Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error)
 
+	// Upsert notifies Proxy to replace the rows with the same primary keys, or to insert them if not exist
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name, partition name(optional), fields data
+	//
+	// The `Status` in response struct `MutationResult` indicates if this operation is processed successfully or fail cause;
+	// the `IDs` in `MutationResult` return the id list of upserted rows.
+	// the `UpsertCnt` in `MutationResult` return the number of upserted rows.
+	// error is always nil
+	Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error)
+
 	// Delete notifies Proxy to delete rows
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1026,6 +1153,38 @@ type ProxyComponent interface {
 	// error is always nil
 	Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error)
 
+	// RegisterTemplate notifies Proxy to register a search or query template
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name, collection name, the expression with placeholders,
+	// the anns field if it's a search template, and the output fields
+	//
+	// The `Status` in response struct `RegisterTemplateResponse` indicates if this operation is processed successfully or fail cause;
+	// the `TemplateID` in `RegisterTemplateResponse` is the id to search or query by, with the parameters bound.
+	// error is always nil
+	RegisterTemplate(ctx context.Context, req *milvuspb.RegisterTemplateRequest) (*milvuspb.RegisterTemplateResponse, error)
+
+	// DropTemplate notifies Proxy to drop a template registered
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the template id
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop template successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropTemplate(ctx context.Context, req *milvuspb.DropTemplateRequest) (*commonpb.Status, error)
+
+	// Explain notifies Proxy to explain the plan of a search or query without executing it
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name, collection name, partition names(optional),
+	// filter expression, and the anns field, topk, nq and search params if it's a search
+	//
+	// The `Status` in response struct `ExplainResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Plan`, `Segments` and `EstimatedCost` in `ExplainResponse` return the plan, the segments to scan and its cost.
+	// error is always nil
+	Explain(ctx context.Context, req *milvuspb.ExplainRequest) (*milvuspb.ExplainResponse, error)
+
 	// CalcDistance notifies Proxy to calculate distance between specified vectors
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1083,6 +1242,47 @@ type ProxyComponent interface {
 	// error is always nil
 	LoadBalance(ctx context.Context, request *milvuspb.LoadBalanceRequest) (*commonpb.Status, error)
 
+	// CordonNode notifies Proxy to cordon a query node, no new segments or channels are assigned to it
+	// and the ones it serves are drained off to the other nodes
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the query node id
+	//
+	// The `Status` in response struct `CordonedNodesResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Nodes` in `CordonedNodesResponse` return the cordoned nodes afterwards.
+	// error is always nil
+	CordonNode(ctx context.Context, req *milvuspb.CordonNodeRequest) (*milvuspb.CordonedNodesResponse, error)
+
+	// UncordonNode notifies Proxy to make a cordoned query node assignable again
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the query node id
+	//
+	// The `Status` in response struct `CordonedNodesResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Nodes` in `CordonedNodesResponse` return the cordoned nodes afterwards.
+	// error is always nil
+	UncordonNode(ctx context.Context, req *milvuspb.UncordonNodeRequest) (*milvuspb.CordonedNodesResponse, error)
+
+	// GetCordonedNodes gets the cordoned query nodes and their drain states
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `CordonedNodesResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Nodes` in `CordonedNodesResponse` return the cordoned nodes.
+	// error is always nil
+	GetCordonedNodes(ctx context.Context, req *milvuspb.GetCordonedNodesRequest) (*milvuspb.CordonedNodesResponse, error)
+
+	// Balance notifies Proxy to trigger a balance round of the query nodes
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, the planned moves are not executed if dry_run is true
+	//
+	// The `Status` in response struct `BalanceResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Moves` in `BalanceResponse` return the segments and the channels planned to move.
+	// error is always nil
+	Balance(ctx context.Context, req *milvuspb.BalanceRequest) (*milvuspb.BalanceResponse, error)
+
 	// CreateAlias notifies Proxy to create alias for a collection
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1112,6 +1312,79 @@ type ProxyComponent interface {
 	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
 	// error is always nil
 	AlterAlias(ctx context.Context, request *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
+
+	// RenameCollection notifies Proxy to rename a collection, the aliases of the collection still refer to it
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), the old name and the new name
+	//
+	// The `ErrorCode` of `Status` is `Success` if rename collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error)
+
+	// AddCollectionField notifies Proxy to add a scalar field with a default value to a collection, the entities
+	// written before read the default value of the field
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name and the field schema
+	//
+	// The `ErrorCode` of `Status` is `Success` if add field successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AddCollectionField(ctx context.Context, request *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error)
+
+	// AlterCollection notifies Proxy to set or remove the properties of a collection, such as the ttl, the number of
+	// replicas, the load mode and the load priority
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name and the properties,
+	// an empty value removes the property
+	//
+	// The `ErrorCode` of `Status` is `Success` if alter collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error)
+
+	// CreateDatabase notifies Proxy to create a database, the collections are named in the scope of their database,
+	// which is the db_name of the requests
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the database name and the quotas of the database
+	//
+	// The `ErrorCode` of `Status` is `Success` if create database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+
+	// DropDatabase notifies Proxy to drop a database, the collections in it must be dropped first
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+
+	// AlterDatabase notifies Proxy to set the quotas of a database
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the database name and the quotas to set
+	//
+	// The `ErrorCode` of `Status` is `Success` if alter database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AlterDatabase(ctx context.Context, request *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error)
+
+	// ListDatabases lists the databases, including the default one
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `ListDatabasesResponse` indicates if this operation is processed successfully or fail cause;
+	// error is always nil
+	ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
 	GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
 	ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
@@ -1148,8 +1421,39 @@ type ProxyComponent interface {
 	// error is always nil
 	ListImportTasks(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error)
 
+	// CancelImport cancels a pending or working import task
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including a task id
+	//
+	// The `Status` in response struct `GetImportStateResponse` indicates if this operation is processed successfully or fail cause;
+	// the `state` in `GetImportStateResponse` return the state of the import task after it's canceled.
+	// error is always nil
+	CancelImport(ctx context.Context, req *milvuspb.CancelImportRequest) (*milvuspb.GetImportStateResponse, error)
+
 	GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error)
 
+	// GetLoadingProgress gets the progress of loading a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved) and collection name
+	//
+	// The `Status` in response struct `GetLoadingProgressResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Percentage` is the loaded percentage of the rows and the `PendingSegments` are the segments not loaded yet.
+	// error is always nil
+	GetLoadingProgress(ctx context.Context, req *milvuspb.GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error)
+
+	// GetReleaseJobs gets the release jobs of a collection, by which the asynchronous releases are polled
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved) and collection name,
+	// the jobs of all the collections are returned if the collection name is empty
+	//
+	// The `Status` in response struct `GetReleaseJobsResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Jobs` are the running and the recently finished release jobs ordered by job id.
+	// error is always nil
+	GetReleaseJobs(ctx context.Context, req *milvuspb.GetReleaseJobsRequest) (*milvuspb.GetReleaseJobsResponse, error)
+
 	// CreateCredential create new user and password
 	CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error)
 	// UpdateCredential update password for a user
@@ -1158,6 +1462,21 @@ type ProxyComponent interface {
 	DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error)
 	// ListCredUsers list all usernames
 	ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error)
+
+	// SaveRole creates the role or replaces the grants of it
+	SaveRole(ctx context.Context, req *milvuspb.SaveRoleRequest) (*commonpb.Status, error)
+	// DropRole drops the role and unbinds it from the users
+	DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error)
+	// ListRoles lists the roles and their grants
+	ListRoles(ctx context.Context, req *milvuspb.ListRolesRequest) (*milvuspb.ListRolesResponse, error)
+	// SaveUserRoles binds the roles to the user
+	SaveUserRoles(ctx context.Context, req *milvuspb.SaveUserRolesRequest) (*commonpb.Status, error)
+	// ListUserRoles lists the roles bound to the users
+	ListUserRoles(ctx context.Context, req *milvuspb.ListUserRolesRequest) (*milvuspb.ListUserRolesResponse, error)
+	// CreateAPIKey creates an API key authenticating the requests as the user
+	CreateAPIKey(ctx context.Context, req *milvuspb.CreateAPIKeyRequest) (*milvuspb.CreateAPIKeyResponse, error)
+	// RevokeAPIKey revokes the API key
+	RevokeAPIKey(ctx context.Context, req *milvuspb.RevokeAPIKeyRequest) (*commonpb.Status, error)
 }
 
 // QueryNode is the interface `querynode` package implements
@@ -1189,11 +1508,20 @@ type QueryNode interface {
 	ReleaseCollection(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error)
 	ReleasePartitions(ctx context.Context, req *querypb.ReleasePartitionsRequest) (*commonpb.Status, error)
 	ReleaseSegments(ctx context.Context, req *querypb.ReleaseSegmentsRequest) (*commonpb.Status, error)
+	// ReleaseChannels releases the dml channels of a collection and their delta channels, the sealed segments are kept
+	ReleaseChannels(ctx context.Context, req *querypb.ReleaseChannelsRequest) (*commonpb.Status, error)
 	GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
 
 	Search(ctx context.Context, req *querypb.SearchRequest) (*internalpb.SearchResults, error)
 	Query(ctx context.Context, req *querypb.QueryRequest) (*internalpb.RetrieveResults, error)
 	SyncReplicaSegments(ctx context.Context, req *querypb.SyncReplicaSegmentsRequest) (*commonpb.Status, error)
+	// RefreshCollection refreshes the schema and adds the new partitions of a loaded collection in place
+	RefreshCollection(ctx context.Context, req *querypb.LoadPartitionsRequest) (*commonpb.Status, error)
+	// Drain starts draining QueryNode for graceful scale-in, new tasks are rejected and the data are handed off to
+	// other nodes. It's idempotent, the `State` in response indicates the progress of the drain.
+	Drain(ctx context.Context, req *querypb.DrainRequest) (*querypb.DrainResponse, error)
+	// GetSegmentLoadingProgress returns the progress of the segments of the collection being loaded by QueryNode
+	GetSegmentLoadingProgress(ctx context.Context, req *querypb.GetLoadingProgressRequest) (*querypb.GetSegmentLoadingProgressResponse, error)
 
 	// GetMetrics gets the metrics about QueryNode.
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
@@ -1231,6 +1559,32 @@ type QueryCoord interface {
 
 	GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error)
 	GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error)
+
+	// SyncNewCreatedPartition adds the partition created to the collection loaded, so it's served without loading
+	SyncNewCreatedPartition(ctx context.Context, req *querypb.SyncNewCreatedPartitionRequest) (*commonpb.Status, error)
+
+	// RefreshCollection replaces the schema of a loaded collection once it's changed by RootCoord,
+	// and sends it to the query nodes serving the collection
+	RefreshCollection(ctx context.Context, req *querypb.RefreshCollectionRequest) (*commonpb.Status, error)
+
+	// GetLoadingProgress returns the loaded percentage and the segments pending to load of the collection,
+	// the progress of the segments being loaded is collected from the query nodes
+	GetLoadingProgress(ctx context.Context, req *querypb.GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error)
+
+	// GetReleaseJobs returns the running and the recently finished release jobs of the collection,
+	// or of all the collections if the collection id is 0
+	GetReleaseJobs(ctx context.Context, req *querypb.GetReleaseJobsRequest) (*milvuspb.GetReleaseJobsResponse, error)
+
+	// CordonNode stops assigning segments and channels to the query node, the ones it serves are drained off to the
+	// other nodes of the same replicas. UncordonNode makes it assignable again. Both return the cordoned nodes afterwards.
+	CordonNode(ctx context.Context, req *milvuspb.CordonNodeRequest) (*milvuspb.CordonedNodesResponse, error)
+	UncordonNode(ctx context.Context, req *milvuspb.UncordonNodeRequest) (*milvuspb.CordonedNodesResponse, error)
+	// GetCordonedNodes returns the cordoned query nodes and their drain states
+	GetCordonedNodes(ctx context.Context, req *milvuspb.GetCordonedNodesRequest) (*milvuspb.CordonedNodesResponse, error)
+
+	// Balance plans a balance round of the query nodes on demand and returns the planned moves,
+	// the moves are executed in the background unless it's a dry run
+	Balance(ctx context.Context, req *milvuspb.BalanceRequest) (*milvuspb.BalanceResponse, error)
 }
 
 // QueryCoordComponent is used by grpc server of QueryCoord
@@ -1,15 +1,21 @@
 package compressor
 
 import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
 	"io"
 
 	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4"
 )
 
 type CompressType string
 
 const (
 	CompressTypeZstd CompressType = "zstd"
+	CompressTypeLz4  CompressType = "lz4"
 
 	DefaultCompressAlgorithm CompressType = CompressTypeZstd
 )
@@ -34,8 +40,31 @@ type Decompressor interface {
 var (
 	_ Compressor   = (*ZstdCompressor)(nil)
 	_ Decompressor = (*ZstdDecompressor)(nil)
+	_ Compressor   = (*Lz4Compressor)(nil)
+	_ Decompressor = (*Lz4Decompressor)(nil)
 )
 
+// The magic numbers of the zstd frame and the lz4 frame, both little endian
+const (
+	zstdMagicNumber uint32 = 0xFD2FB528
+	lz4MagicNumber  uint32 = 0x184D2204
+)
+
+// DetectCompressType returns the compress type of the data by the magic number of its frame,
+// false if the data is not compressed by any known algorithm
+func DetectCompressType(data []byte) (CompressType, bool) {
+	if len(data) < 4 {
+		return "", false
+	}
+	switch binary.LittleEndian.Uint32(data) {
+	case zstdMagicNumber:
+		return CompressTypeZstd, true
+	case lz4MagicNumber:
+		return CompressTypeLz4, true
+	}
+	return "", false
+}
+
 type ZstdCompressor struct {
 	encoder *zstd.Encoder
 }
@@ -193,3 +222,127 @@ func ZstdCompressBytes(src, dst []byte) []byte {
 func ZstdDecompressBytes(src, dst []byte) ([]byte, error) {
 	return globalZstdDecompressor.DecodeAll(src, dst)
 }
+
+type Lz4Compressor struct {
+	writer *lz4.Writer
+}
+
+// For compressing small blocks, pass nil to the `out` parameter
+func NewLz4Compressor(out io.Writer) *Lz4Compressor {
+	return &Lz4Compressor{lz4.NewWriter(out)}
+}
+
+// Use case: compress stream
+// Call Close() to make sure the data is flushed to the underlying writer
+// after the last Compress() call
+func (c *Lz4Compressor) Compress(in io.Reader) error {
+	_, err := io.Copy(c.writer, in)
+	if err != nil {
+		c.writer.Close()
+		return err
+	}
+
+	return nil
+}
+
+// Use case: compress small blocks
+// This compresses the src bytes and appends it to the dst bytes, then return the result
+// This can be called concurrently
+func (c *Lz4Compressor) CompressBytes(src []byte, dst []byte) []byte {
+	return Lz4CompressBytes(src, dst)
+}
+
+// Reset the writer to reuse the compressor
+func (c *Lz4Compressor) ResetWriter(out io.Writer) {
+	c.writer.Reset(out)
+}
+
+// The compressor is still re-used after calling this
+func (c *Lz4Compressor) Close() error {
+	return c.writer.Close()
+}
+
+func (c *Lz4Compressor) GetType() CompressType {
+	return CompressTypeLz4
+}
+
+type Lz4Decompressor struct {
+	reader *lz4.Reader
+}
+
+// For decompressing small blocks, pass nil to the `in` parameter
+func NewLz4Decompressor(in io.Reader) *Lz4Decompressor {
+	return &Lz4Decompressor{lz4.NewReader(in)}
+}
+
+// Usa case: decompress stream
+// Write the decompressed data into `out`
+func (dec *Lz4Decompressor) Decompress(out io.Writer) error {
+	_, err := io.Copy(out, dec.reader)
+	return err
+}
+
+// Use case: decompress small blocks
+// This decompresses the src bytes and appends it to the dst bytes, then return the result
+// This can be called concurrently
+func (dec *Lz4Decompressor) DecompressBytes(src []byte, dst []byte) ([]byte, error) {
+	return Lz4DecompressBytes(src, dst)
+}
+
+// Reset the reader to reuse the decompressor
+func (dec *Lz4Decompressor) ResetReader(in io.Reader) {
+	dec.reader.Reset(in)
+}
+
+func (dec *Lz4Decompressor) Close() {}
+
+func (dec *Lz4Decompressor) GetType() CompressType {
+	return CompressTypeLz4
+}
+
+// Use case: compress small blocks
+// This can be called concurrently
+func Lz4CompressBytes(src, dst []byte) []byte {
+	out := bytes.NewBuffer(dst)
+	writer := lz4.NewWriter(out)
+	// writing to a bytes.Buffer never fails
+	writer.Write(src)
+	writer.Close()
+	return out.Bytes()
+}
+
+// Use case: decompress small blocks
+// This can be called concurrently
+func Lz4DecompressBytes(src, dst []byte) ([]byte, error) {
+	out := bytes.NewBuffer(dst)
+	if _, err := io.Copy(out, lz4.NewReader(bytes.NewReader(src))); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// CompressBytes compresses the src bytes by the compress type and appends it to the dst bytes
+func CompressBytes(typ CompressType, src, dst []byte) ([]byte, error) {
+	switch typ {
+	case CompressTypeZstd:
+		return ZstdCompressBytes(src, dst), nil
+	case CompressTypeLz4:
+		return Lz4CompressBytes(src, dst), nil
+	}
+	return nil, fmt.Errorf("unknown compress type %s", typ)
+}
+
+// DecompressBytes decompresses the src bytes by the compress type detected from its magic number
+// and appends it to the dst bytes
+func DecompressBytes(src, dst []byte) ([]byte, error) {
+	typ, ok := DetectCompressType(src)
+	if !ok {
+		return nil, errors.New("unknown compressed data")
+	}
+	switch typ {
+	case CompressTypeZstd:
+		return ZstdDecompressBytes(src, dst)
+	default:
+		return Lz4DecompressBytes(src, dst)
+	}
+}
@@ -162,3 +162,77 @@ func TestCurrencyGlobalMethods(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestLz4Compress(t *testing.T) {
+	data := "hello lz4 algorithm!"
+	compressed := new(bytes.Buffer)
+	origin := new(bytes.Buffer)
+
+	enc := NewLz4Compressor(compressed)
+	err := enc.Compress(strings.NewReader(data))
+	assert.NoError(t, err)
+	err = enc.Close()
+	assert.NoError(t, err)
+
+	dec := NewLz4Decompressor(bytes.NewReader(compressed.Bytes()))
+	err = dec.Decompress(origin)
+	assert.NoError(t, err)
+	assert.Equal(t, data, origin.String())
+
+	compressedBytes := enc.CompressBytes([]byte(data), nil)
+	originBytes, err := dec.DecompressBytes(compressedBytes, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, data, string(originBytes))
+
+	// Reuse test
+	compressed.Reset()
+	origin.Reset()
+	enc.ResetWriter(compressed)
+	err = enc.Compress(strings.NewReader(data + ": reuse"))
+	assert.NoError(t, err)
+	err = enc.Close()
+	assert.NoError(t, err)
+	dec.ResetReader(bytes.NewReader(compressed.Bytes()))
+	err = dec.Decompress(origin)
+	assert.NoError(t, err)
+	assert.Equal(t, data+": reuse", origin.String())
+
+	// Mock error writer
+	errWriter := &mock.ErrWriter{Err: io.ErrShortWrite}
+	dec.ResetReader(bytes.NewReader(compressed.Bytes()))
+	err = dec.Decompress(errWriter)
+	assert.ErrorIs(t, err, errWriter.Err)
+
+	_, err = Lz4DecompressBytes([]byte("not lz4 frame"), nil)
+	assert.Error(t, err)
+
+	assert.Equal(t, enc.GetType(), CompressTypeLz4)
+	assert.Equal(t, dec.GetType(), CompressTypeLz4)
+}
+
+func TestCompressBytes(t *testing.T) {
+	data := []byte("hello compress bytes!")
+
+	for _, typ := range []CompressType{CompressTypeZstd, CompressTypeLz4} {
+		compressed, err := CompressBytes(typ, data, nil)
+		assert.NoError(t, err)
+
+		detected, ok := DetectCompressType(compressed)
+		assert.True(t, ok)
+		assert.Equal(t, typ, detected)
+
+		origin, err := DecompressBytes(compressed, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, data, origin)
+	}
+
+	_, err := CompressBytes("unknown", data, nil)
+	assert.Error(t, err)
+
+	_, ok := DetectCompressType(data)
+	assert.False(t, ok)
+	_, ok = DetectCompressType(nil)
+	assert.False(t, ok)
+	_, err = DecompressBytes(data, nil)
+	assert.Error(t, err)
+}
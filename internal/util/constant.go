@@ -23,6 +23,34 @@ const (
 	HeaderAuthorize      = "authorization"
 	// HeaderSourceID identify requests from Milvus members and client requests
 	HeaderSourceID = "sourceId"
+	// HeaderAPIKey is the header of the API key authenticating the request instead of the username and password
+	HeaderAPIKey = "apikey"
+	// RBACPrefix is the prefix of the roles, the roles bound to users and the API keys in the meta of RootCoord,
+	// the proxies watch it to authorize the requests
+	RBACPrefix = "root-coord/rbac"
+	// RBACRolePrefix is the prefix of the grants of roles, the key is {prefix}/{role}
+	RBACRolePrefix = RBACPrefix + "/roles"
+	// RBACUserRolePrefix is the prefix of the roles bound to users, the key is {prefix}/{username}
+	RBACUserRolePrefix = RBACPrefix + "/user-roles"
+	// RBACAPIKeyPrefix is the prefix of the users of API keys, the key is {prefix}/{sha256 of the API key}
+	RBACAPIKeyPrefix = RBACPrefix + "/api-keys"
+	// DatabasePrefix is the prefix of the databases in the meta of RootCoord, the key is {prefix}/{database},
+	// the proxies watch it to resolve the database of the requests
+	DatabasePrefix = "root-coord/database"
+	// HeaderDBName is the header of the database the request uses, the default database if not specified
+	HeaderDBName = "dbname"
+	// QueryNodeDrainPrefix is the prefix of the drain states of QueryNodes, the key is {prefix}/{nodeID}.
+	// QueryNode writes QueryNodeDrainStateDraining, then QueryCoord hands off the segments and channels of the node
+	// to other nodes and writes QueryNodeDrainStateHandedOff. The key is attached to the session of the QueryNode, and
+	// kept until the session expires.
+	QueryNodeDrainPrefix         = "queryNode-drain"
+	QueryNodeDrainStateDraining  = "draining"
+	QueryNodeDrainStateHandedOff = "handed_off"
+	// DataNodeBackpressurePrefix is the prefix of the collections whose insert buffers exceed the memory watermark
+	// of datanodes, the key is {prefix}/{nodeID}, the proxies watch it to reject the inserts to the collections
+	DataNodeBackpressurePrefix = "datanode/backpressure"
+	// AnyObject matches all the collections or all the operations in the grants of roles
+	AnyObject = "*"
 	// MemberCredID id for Milvus members (data/index/query node/coord component)
 	MemberCredID        = "@@milvus-member@@"
 	CredentialSeperator = ":"
@@ -1,7 +1,10 @@
 package crypto
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"go.uber.org/zap"
@@ -41,3 +44,18 @@ func Base64Decode(pwd string) (string, error) {
 func Base64Encode(pwd string) string {
 	return base64.StdEncoding.EncodeToString([]byte(pwd))
 }
+
+// GenerateAPIKey returns a random API key
+func GenerateAPIKey() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+// HashAPIKey returns the SHA-256 of the API key, only the hash is stored so that the key couldn't be recovered from the meta
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -40,3 +40,15 @@ func TestJsonMarshalAndPasswordVerify(t *testing.T) {
 	json.Unmarshal(v, &credentialInfo)
 	assert.True(t, PasswordVerify(util.DefaultRootPassword, credentialInfo.EncryptedPassword))
 }
+
+func TestAPIKey(t *testing.T) {
+	key1, err := GenerateAPIKey()
+	assert.NoError(t, err)
+	key2, err := GenerateAPIKey()
+	assert.NoError(t, err)
+	assert.NotEqual(t, key1, key2)
+
+	assert.Equal(t, HashAPIKey(key1), HashAPIKey(key1))
+	assert.NotEqual(t, HashAPIKey(key1), HashAPIKey(key2))
+	assert.NotEqual(t, key1, HashAPIKey(key1))
+}
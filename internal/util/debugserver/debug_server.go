@@ -0,0 +1,146 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package debugserver serves the runtime debug endpoints on a dedicated admin port,
+// so that the CPU and memory of a running component could be investigated.
+package debugserver
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"path/filepath"
+	"runtime"
+	runtimepprof "runtime/pprof"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+const (
+	// PprofRouterPath is the path prefix of the pprof endpoints
+	PprofRouterPath = "/debug/pprof/"
+	// ExpvarRouterPath is the path of the expvar endpoint
+	ExpvarRouterPath = "/debug/vars"
+	// DumpRouterPath is the path of the endpoint writing the goroutine or heap dump to the dump dir, by POST
+	DumpRouterPath = "/debug/dump"
+
+	// DumpTypeGoroutine dumps the stacks of all goroutines
+	DumpTypeGoroutine = "goroutine"
+	// DumpTypeHeap dumps the heap profile after a GC
+	DumpTypeHeap = "heap"
+)
+
+// Server serves pprof, expvar and the dump trigger
+type Server struct {
+	name    string
+	dumpDir string
+	server  *http.Server
+}
+
+// NewServer returns the debug server listening on addr, the dumps are written to dumpDir and named with the name
+func NewServer(name string, addr string, dumpDir string) *Server {
+	s := &Server{
+		name:    name,
+		dumpDir: dumpDir,
+	}
+	s.server = &http.Server{
+		Addr:    addr,
+		Handler: s.newServeMux(),
+	}
+	return s
+}
+
+func (s *Server) newServeMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc(PprofRouterPath, pprof.Index)
+	mux.HandleFunc(PprofRouterPath+"cmdline", pprof.Cmdline)
+	mux.HandleFunc(PprofRouterPath+"profile", pprof.Profile)
+	mux.HandleFunc(PprofRouterPath+"symbol", pprof.Symbol)
+	mux.HandleFunc(PprofRouterPath+"trace", pprof.Trace)
+	mux.Handle(ExpvarRouterPath, expvar.Handler())
+	mux.HandleFunc(DumpRouterPath, s.handleDump)
+	return mux
+}
+
+// Start serves in the background
+func (s *Server) Start() {
+	go func() {
+		log.Info("debug server listen", zap.String("addr", s.server.Addr), zap.String("dumpDir", s.dumpDir))
+		if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Error("debug server failed", zap.Error(err))
+		}
+	}()
+}
+
+// Stop stops serving
+func (s *Server) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return s.server.Shutdown(ctx)
+}
+
+// handleDump writes the dump of the type in the query to a file under the dump dir, and responds with its path
+func (s *Server) handleDump(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, fmt.Sprintf("method %s is not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	dumpType := r.URL.Query().Get("type")
+	if dumpType == "" {
+		dumpType = DumpTypeGoroutine
+	}
+	file, err := s.dump(dumpType)
+	if err != nil {
+		log.Warn("failed to dump", zap.String("type", dumpType), zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	log.Info("dump written", zap.String("type", dumpType), zap.String("file", file))
+	_, _ = fmt.Fprint(w, file)
+}
+
+func (s *Server) dump(dumpType string) (string, error) {
+	var debug int
+	switch dumpType {
+	case DumpTypeGoroutine:
+		// the stacks in the format of an unrecovered panic
+		debug = 2
+	case DumpTypeHeap:
+		runtime.GC()
+	default:
+		return "", fmt.Errorf("unknown dump type %s, should be %s or %s", dumpType, DumpTypeGoroutine, DumpTypeHeap)
+	}
+
+	if err := os.MkdirAll(s.dumpDir, 0755); err != nil {
+		return "", err
+	}
+	filename := filepath.Join(s.dumpDir, fmt.Sprintf("%s-%d-%s-%s.pprof", s.name, os.Getpid(), dumpType, time.Now().Format("20060102150405.000")))
+	f, err := os.Create(filename)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if err := runtimepprof.Lookup(dumpType).WriteTo(f, debug); err != nil {
+		return "", err
+	}
+	return filename, nil
+}
@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debugserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer(t *testing.T) {
+	dumpDir := filepath.Join(t.TempDir(), "dump")
+	s := NewServer("querynode", "localhost:0", dumpDir)
+	mux := s.newServeMux()
+
+	serve := func(method string, target string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(method, target, nil))
+		return w
+	}
+
+	t.Run("pprof", func(t *testing.T) {
+		w := serve(http.MethodGet, PprofRouterPath)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "goroutine")
+
+		w = serve(http.MethodGet, PprofRouterPath+"goroutine?debug=1")
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("expvar", func(t *testing.T) {
+		w := serve(http.MethodGet, ExpvarRouterPath)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "memstats")
+	})
+
+	t.Run("dump", func(t *testing.T) {
+		for _, dumpType := range []string{DumpTypeGoroutine, DumpTypeHeap} {
+			w := serve(http.MethodPost, DumpRouterPath+"?type="+dumpType)
+			require.Equal(t, http.StatusOK, w.Code)
+			file := w.Body.String()
+			assert.True(t, strings.HasPrefix(filepath.Base(file), "querynode-"))
+			assert.Contains(t, file, dumpType)
+			info, err := os.Stat(file)
+			require.NoError(t, err)
+			assert.NotZero(t, info.Size())
+		}
+
+		w := serve(http.MethodPost, DumpRouterPath)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), DumpTypeGoroutine)
+
+		w = serve(http.MethodPost, DumpRouterPath+"?type=unknown")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = serve(http.MethodGet, DumpRouterPath)
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("start and stop", func(t *testing.T) {
+		s.Start()
+		assert.NoError(t, s.Stop())
+	})
+}
@@ -28,9 +28,10 @@ func NewFactory(standAlone bool) *DefaultFactory {
 }
 
 // Init create a msg factory(TODO only support one mq at the same time.)
-// In order to guarantee backward compatibility of config file, we still support multiple mq configs.
+// The msgstream implementation named by common.msgStream.type is created from the msgstream registry, if not set,
+// in order to guarantee backward compatibility of config file, we still support multiple mq configs.
 // 1. Rocksmq only run on local mode, and it has the highest priority
-// 2. Pulsar has higher priority than Kafka within remote msg
+// 2. Pulsar has higher priority than Kafka within remote msg, and Kafka has higher priority than NATS
 func (f *DefaultFactory) Init(params *paramtable.ComponentParam) {
 	// skip if using default factory
 	if f.msgStreamFactory != nil {
@@ -38,10 +39,29 @@ func (f *DefaultFactory) Init(params *paramtable.ComponentParam) {
 	}
 
 	// init storage
-	if params.CommonCfg.StorageType == "local" {
+	switch params.CommonCfg.StorageType {
+	case "local":
 		f.chunkManagerFactory = storage.NewChunkManagerFactory("local", "local",
 			storage.RootPath(params.LocalStorageCfg.Path))
-	} else {
+	case "azure":
+		f.chunkManagerFactory = storage.NewChunkManagerFactory("local", "azure",
+			storage.RootPath(params.LocalStorageCfg.Path),
+			storage.AzureConnectionString(params.AzureCfg.ConnectionString),
+			storage.AzureAccountName(params.AzureCfg.AccountName),
+			storage.AzureEndpoint(params.AzureCfg.Endpoint),
+			storage.AzureManagedIdentityClientID(params.AzureCfg.ManagedIdentityClientID),
+			storage.BucketName(params.AzureCfg.ContainerName),
+			storage.CreateBucket(true))
+	case "gcs":
+		f.chunkManagerFactory = storage.NewChunkManagerFactory("local", "gcs",
+			storage.RootPath(params.LocalStorageCfg.Path),
+			storage.GCSProjectID(params.GCSCfg.ProjectID),
+			storage.GCSCredentialsFile(params.GCSCfg.CredentialsFile),
+			storage.GCSEndpoint(params.GCSCfg.Endpoint),
+			storage.GCSUploadChunkSize(params.GCSCfg.UploadChunkSize),
+			storage.BucketName(params.GCSCfg.BucketName),
+			storage.CreateBucket(true))
+	default:
 		f.chunkManagerFactory = storage.NewChunkManagerFactory("local", "minio",
 			storage.RootPath(params.LocalStorageCfg.Path),
 			storage.Address(params.MinioCfg.Address),
@@ -53,42 +73,50 @@ func (f *DefaultFactory) Init(params *paramtable.ComponentParam) {
 	}
 
 	// init mq storage
-	if f.standAlone {
-		f.msgStreamFactory = f.initMQLocalService(params)
-		if f.msgStreamFactory == nil {
-			f.msgStreamFactory = f.initMQRemoteService(params)
-			if f.msgStreamFactory == nil {
-				panic("no available mq configuration, must config rocksmq, Pulsar or Kafka at least one of these!")
-			}
-		}
-		return
+	mqType := params.CommonCfg.MsgStreamType
+	if mqType == "" {
+		mqType = f.selectMQType(params)
 	}
-
-	f.msgStreamFactory = f.initMQRemoteService(params)
-	if f.msgStreamFactory == nil {
-		panic("no available remote mq configuration, must config Pulsar or Kafka at least one of these!")
+	factory, err := msgstream.NewFactoryByName(mqType, params)
+	if err != nil {
+		panic(err)
 	}
+	f.msgStreamFactory = factory
 }
 
-func (f *DefaultFactory) initMQLocalService(params *paramtable.ComponentParam) msgstream.Factory {
-	if params.RocksmqEnable() {
-		path, _ := params.Load("_RocksmqPath")
-		return msgstream.NewRmsFactory(path)
+// selectMQType picks the builtin msgstream implementation by the enabled mq configs if common.msgStream.type is not set,
+// rocksmq only runs on local mode, and it has the highest priority.
+func (f *DefaultFactory) selectMQType(params *paramtable.ComponentParam) string {
+	if f.standAlone {
+		if params.RocksmqEnable() {
+			return msgstream.RocksmqFactoryName
+		}
+		mqType := selectRemoteMQType(params)
+		if mqType == "" {
+			panic("no available mq configuration, must config rocksmq, Pulsar, Kafka or NATS at least one of these!")
+		}
+		return mqType
+	}
+
+	mqType := selectRemoteMQType(params)
+	if mqType == "" {
+		panic("no available remote mq configuration, must config Pulsar, Kafka or NATS at least one of these!")
 	}
-	return nil
+	return mqType
 }
 
-// initRemoteService Pulsar has higher priority than Kafka.
-func (f *DefaultFactory) initMQRemoteService(params *paramtable.ComponentParam) msgstream.Factory {
+// selectRemoteMQType Pulsar has higher priority than Kafka, and Kafka has higher priority than NATS.
+func selectRemoteMQType(params *paramtable.ComponentParam) string {
 	if params.PulsarEnable() {
-		return msgstream.NewPmsFactory(&params.PulsarCfg)
+		return msgstream.PulsarFactoryName
 	}
-
 	if params.KafkaEnable() {
-		return msgstream.NewKmsFactory(&params.KafkaCfg)
+		return msgstream.KafkaFactoryName
 	}
-
-	return nil
+	if params.NatsEnable() {
+		return msgstream.NatsFactoryName
+	}
+	return ""
 }
 
 func (f *DefaultFactory) NewMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
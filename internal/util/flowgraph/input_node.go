@@ -17,6 +17,9 @@
 package flowgraph
 
 import (
+	"sync/atomic"
+	"time"
+
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -30,6 +33,8 @@ type InputNode struct {
 	BaseNode
 	inStream msgstream.MsgStream
 	name     string
+	// lastMsgTime is the unix nano time of the last message pack received, or the creation time if none
+	lastMsgTime int64
 }
 
 // IsInputNode returns whether Node is InputNode
@@ -55,6 +60,19 @@ func (inNode *InputNode) Name() string {
 	return inNode.name
 }
 
+// LastMsgTime returns the time of the last message pack received from the MsgStream
+func (inNode *InputNode) LastMsgTime() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&inNode.lastMsgTime))
+}
+
+// ConsumerLags returns the lags of the consumers of the MsgStream, nil if it doesn't report them
+func (inNode *InputNode) ConsumerLags() []*msgstream.ConsumerLag {
+	if reporter, ok := inNode.inStream.(msgstream.ConsumerLagReporter); ok {
+		return reporter.GetConsumerLags()
+	}
+	return nil
+}
+
 // InStream returns the internal MsgStream
 func (inNode *InputNode) InStream() msgstream.MsgStream {
 	return inNode.inStream
@@ -72,6 +90,8 @@ func (inNode *InputNode) Operate(in []Msg) []Msg {
 	if msgPack == nil {
 		return nil
 	}
+	atomic.StoreInt64(&inNode.lastMsgTime, time.Now().UnixNano())
+	msgPack = inNode.coalesceTimeTicks(msgPack)
 	var spans []opentracing.Span
 	for _, msg := range msgPack.Msgs {
 		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
@@ -95,6 +115,29 @@ func (inNode *InputNode) Operate(in []Msg) []Msg {
 	return []Msg{msgStreamMsg}
 }
 
+// coalesceTimeTicks merges the msg packs pending in the msgstream into msgPack while it carries time ticks only,
+// the packs pile up only if the flowgraph lags behind, and the later time tick covers the earlier ones
+func (inNode *InputNode) coalesceTimeTicks(msgPack *msgstream.MsgPack) *msgstream.MsgPack {
+	for len(msgPack.Msgs) == 0 {
+		select {
+		case next, ok := <-inNode.inStream.Chan():
+			if !ok || next == nil {
+				return msgPack
+			}
+			msgPack = &msgstream.MsgPack{
+				BeginTs:        msgPack.BeginTs,
+				EndTs:          next.EndTs,
+				Msgs:           next.Msgs,
+				StartPositions: msgPack.StartPositions,
+				EndPositions:   next.EndPositions,
+			}
+		default:
+			return msgPack
+		}
+	}
+	return msgPack
+}
+
 // NewInputNode composes an InputNode with provided MsgStream, name and parameters
 func NewInputNode(inStream msgstream.MsgStream, nodeName string, maxQueueLength int32, maxParallelism int32) *InputNode {
 	baseNode := BaseNode{}
@@ -102,8 +145,9 @@ func NewInputNode(inStream msgstream.MsgStream, nodeName string, maxQueueLength
 	baseNode.SetMaxParallelism(maxParallelism)
 
 	return &InputNode{
-		BaseNode: baseNode,
-		inStream: inStream,
-		name:     nodeName,
+		BaseNode:    baseNode,
+		inStream:    inStream,
+		name:        nodeName,
+		lastMsgTime: time.Now().UnixNano(),
 	}
 }
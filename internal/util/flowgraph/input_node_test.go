@@ -20,9 +20,11 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 )
 
@@ -58,6 +60,62 @@ func TestInputNode(t *testing.T) {
 
 	output := inputNode.Operate([]Msg{})
 	assert.Greater(t, len(output), 0)
+	assert.WithinDuration(t, time.Now(), inputNode.LastMsgTime(), time.Minute)
+
+	lags := inputNode.ConsumerLags()
+	assert.Len(t, lags, 1)
+	assert.Equal(t, "cc", lags[0].Channel)
+	assert.Equal(t, "sub", lags[0].Subscription)
+	// the message ids of rocksmq are not numbered one by one
+	assert.Equal(t, int64(-1), lags[0].MsgLag)
+}
+
+type chanMsgStream struct {
+	msgstream.MsgStream
+	ch chan *msgstream.MsgPack
+}
+
+func (ms *chanMsgStream) Chan() <-chan *msgstream.MsgPack {
+	return ms.ch
+}
+
+func TestInputNode_CoalesceTimeTicks(t *testing.T) {
+	position := func(ts uint64) []*msgstream.MsgPosition {
+		return []*msgstream.MsgPosition{{ChannelName: "cc", Timestamp: ts}}
+	}
+	insertMsg := &msgstream.InsertMsg{BaseMsg: msgstream.BaseMsg{BeginTimestamp: 35, EndTimestamp: 35}}
+
+	ch := make(chan *msgstream.MsgPack, 10)
+	ch <- &msgstream.MsgPack{BeginTs: 10, EndTs: 20, StartPositions: position(10), EndPositions: position(20)}
+	ch <- &msgstream.MsgPack{BeginTs: 20, EndTs: 30, StartPositions: position(20), EndPositions: position(30)}
+	ch <- &msgstream.MsgPack{BeginTs: 30, EndTs: 40, Msgs: []msgstream.TsMsg{insertMsg}, StartPositions: position(30), EndPositions: position(40)}
+	ch <- &msgstream.MsgPack{BeginTs: 40, EndTs: 50, StartPositions: position(40), EndPositions: position(50)}
+	inputNode := NewInputNode(&chanMsgStream{ch: ch}, "input_node", 0, 0)
+
+	// the time ticks are coalesced into the pack carrying messages
+	output := inputNode.Operate([]Msg{})
+	assert.Len(t, output, 1)
+	msg := output[0].(*MsgStreamMsg)
+	assert.Equal(t, []msgstream.TsMsg{insertMsg}, msg.TsMessages())
+	assert.Equal(t, uint64(10), msg.TimestampMin())
+	assert.Equal(t, uint64(40), msg.TimestampMax())
+	assert.Equal(t, position(10), msg.StartPositions())
+	assert.Equal(t, position(40), msg.EndPositions())
+
+	// no more packs pending
+	output = inputNode.Operate([]Msg{})
+	assert.Len(t, output, 1)
+	msg = output[0].(*MsgStreamMsg)
+	assert.Empty(t, msg.TsMessages())
+	assert.Equal(t, uint64(40), msg.TimestampMin())
+	assert.Equal(t, uint64(50), msg.TimestampMax())
+
+	ch <- &msgstream.MsgPack{BeginTs: 50, EndTs: 60, StartPositions: position(50), EndPositions: position(60)}
+	close(ch)
+	output = inputNode.Operate([]Msg{})
+	assert.Len(t, output, 1)
+	assert.Equal(t, uint64(60), output[0].(*MsgStreamMsg).TimestampMax())
+	assert.Empty(t, inputNode.Operate([]Msg{}))
 }
 
 func Test_NewInputNode(t *testing.T) {
@@ -69,4 +127,5 @@ func Test_NewInputNode(t *testing.T) {
 	assert.Equal(t, node.name, nodeName)
 	assert.Equal(t, node.maxQueueLength, maxQueueLength)
 	assert.Equal(t, node.maxParallelism, maxParallelism)
+	assert.False(t, node.LastMsgTime().After(time.Now()))
 }
@@ -33,6 +33,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/go-basic/ipv4"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -269,6 +270,15 @@ func ConvertChannelName(chanName string, tokenFrom string, tokenTo string) (stri
 	return "", fmt.Errorf("cannot find token '%s' in '%s'", tokenFrom, chanName)
 }
 
+// DatabaseName returns the database of the request or the collection with the db name,
+// the default database if the db name is empty
+func DatabaseName(dbName string) string {
+	if dbName == "" {
+		return common.DefaultDatabase
+	}
+	return dbName
+}
+
 func getNumRowsOfScalarField(datas interface{}) uint64 {
 	realTypeDatas := reflect.ValueOf(datas)
 	return uint64(realTypeDatas.Len())
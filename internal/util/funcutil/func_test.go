@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/jarcoal/httpmock"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
@@ -463,3 +464,9 @@ func Test_ReadBinary(t *testing.T) {
 	assert.NoError(t, ReadBinary(endian, bs, &fs))
 	assert.ElementsMatch(t, []float32{0, 0}, fs)
 }
+
+func TestDatabaseName(t *testing.T) {
+	assert.Equal(t, common.DefaultDatabase, DatabaseName(""))
+	assert.Equal(t, common.DefaultDatabase, DatabaseName(common.DefaultDatabase))
+	assert.Equal(t, "db1", DatabaseName("db1"))
+}
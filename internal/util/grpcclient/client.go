@@ -18,6 +18,7 @@ package grpcclient
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"sync"
 	"time"
@@ -33,6 +34,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 )
 
@@ -63,6 +65,9 @@ type ClientBase struct {
 	DialTimeout      time.Duration
 	KeepAliveTime    time.Duration
 	KeepAliveTimeout time.Duration
+
+	// TLSConfig is the config of the internal TLS, the connection is insecure if nil
+	TLSConfig *tls.Config
 }
 
 // SetRole sets role of client
@@ -151,10 +156,15 @@ func (c *ClientBase) connect(ctx context.Context) error {
 		  }
 		}]}`
 
+	transportOpt := grpc.WithInsecure()
+	if c.TLSConfig != nil {
+		transportOpt = grpc.WithTransportCredentials(credentials.NewTLS(c.TLSConfig))
+	}
+
 	conn, err := grpc.DialContext(
 		dialContext,
 		addr,
-		grpc.WithInsecure(),
+		transportOpt,
 		grpc.WithBlock(),
 		grpc.WithDefaultCallOptions(
 			grpc.MaxCallRecvMsgSize(c.ClientMaxRecvSize),
@@ -18,3 +18,6 @@ package healthz
 
 // HealthzRouterPath is default path for check health state.
 const HealthzRouterPath = "/healthz"
+
+// DrainRouterPath is default path for checking whether a draining query node is safe to terminate.
+const DrainRouterPath = "/drain"
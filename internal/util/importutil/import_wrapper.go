@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"context"
 	"errors"
+	"fmt"
 	"path"
 	"strconv"
 	"strings"
@@ -23,11 +24,24 @@ import (
 )
 
 const (
-	JSONFileExt  = ".json"
-	NumpyFileExt = ".npy"
-	MaxFileSize  = 4 * 1024 * 1024 * 1024 // maximum size of each file
+	JSONFileExt    = ".json"
+	NumpyFileExt   = ".npy"
+	ParquetFileExt = ".parquet"
+	MaxFileSize    = 4 * 1024 * 1024 * 1024 // maximum size of each file
 )
 
+const (
+	// FailedReasonKey is the key of the reason of the failure in the infos of import result
+	FailedReasonKey = "failed_reason"
+	// FailedFileKey is the key of the file failed to import in the infos of import result
+	FailedFileKey = "failed_file"
+	// FilesParsedKey is the key of the number of files parsed in the infos of import result
+	FilesParsedKey = "files_parsed"
+)
+
+// errImportAborted is returned if rootcoord rejects the progress of the import task, e.g. the task is canceled
+var errImportAborted = errors.New("import task is aborted")
+
 type ImportWrapper struct {
 	ctx              context.Context            // for canceling parse process
 	cancel           context.CancelFunc         // for canceling parse process
@@ -41,6 +55,7 @@ type ImportWrapper struct {
 
 	importResult *rootcoordpb.ImportResult                 // import result
 	reportFunc   func(res *rootcoordpb.ImportResult) error // report import state to rootcoord
+	filesParsed  int                                       // how many files are parsed
 }
 
 func NewImportWrapper(ctx context.Context, collectionSchema *schemapb.CollectionSchema, shardNum int32, segmentSize int64,
@@ -132,7 +147,7 @@ func (p *ImportWrapper) fileValidation(filePaths []string, rowBased bool) error
 				return errors.New("unsupported file type for row-based mode: " + filePath)
 			}
 		} else {
-			if fileType != JSONFileExt && fileType != NumpyFileExt {
+			if fileType != JSONFileExt && fileType != NumpyFileExt && fileType != ParquetFileExt {
 				return errors.New("unsupported file type for column-based mode: " + filePath)
 			}
 		}
@@ -208,16 +223,17 @@ func (p *ImportWrapper) Import(filePaths []string, rowBased bool, onlyValidate b
 						p.importResult.AutoIds = append(p.importResult.AutoIds, consumer.IDRange()...)
 					}
 
-					// report file process state
-					p.importResult.State = commonpb.ImportState_ImportParsed
-					p.reportFunc(p.importResult)
+					// report file process state, stop if the task is canceled
+					if err := p.reportParsed(); err != nil {
+						return err
+					}
 
 					tr.Record("parsed")
 					return nil
 				}()
 
 				if err != nil {
-					log.Error("import error: "+err.Error(), zap.String("filePath", filePath))
+					p.reportFailedFile(filePath, err)
 					return err
 				}
 			}
@@ -304,16 +320,17 @@ func (p *ImportWrapper) Import(filePaths []string, rowBased bool, onlyValidate b
 						return err
 					}
 
-					// report file process state
-					p.importResult.State = commonpb.ImportState_ImportParsed
-					p.reportFunc(p.importResult)
+					// report file process state, stop if the task is canceled
+					if err := p.reportParsed(); err != nil {
+						return err
+					}
 
 					tr.Record("parsed")
 					return nil
 				}()
 
 				if err != nil {
-					log.Error("import error: "+err.Error(), zap.String("filePath", filePath))
+					p.reportFailedFile(filePath, err)
 					return err
 				}
 			} else if fileType == NumpyFileExt {
@@ -355,16 +372,54 @@ func (p *ImportWrapper) Import(filePaths []string, rowBased bool, onlyValidate b
 						return err
 					}
 
+					// report file process state, stop if the task is canceled
+					if err := p.reportParsed(); err != nil {
+						return err
+					}
+
+					tr.Record("parsed")
+					return nil
+				}()
+
+				if err != nil {
+					p.reportFailedFile(filePath, err)
+					return err
+				}
+			} else if fileType == ParquetFileExt {
+				err := func() error {
+					tr := timerecord.NewTimeRecorder("parquet parser: " + filePath)
+
+					// for minio storage, chunkManager will download file into local memory
+					// for local storage, chunkManager open the file directly
+					file, err := p.chunkManager.Reader(filePath)
+					if err != nil {
+						return err
+					}
+					defer file.Close()
+					tr.Record("downloaded")
+
 					// report file process state
-					p.importResult.State = commonpb.ImportState_ImportParsed
+					p.importResult.State = commonpb.ImportState_ImportDownloaded
 					p.reportFunc(p.importResult)
 
+					// a parquet file could contain several columns, each column is named after a field
+					parser := NewParquetParser(p.ctx, p.collectionSchema, combineFunc)
+					err = parser.Parse(file, onlyValidate)
+					if err != nil {
+						return err
+					}
+
+					// report file process state, stop if the task is canceled
+					if err := p.reportParsed(); err != nil {
+						return err
+					}
+
 					tr.Record("parsed")
 					return nil
 				}()
 
 				if err != nil {
-					log.Error("import error: "+err.Error(), zap.String("filePath", filePath))
+					p.reportFailedFile(filePath, err)
 					return err
 				}
 			}
@@ -383,6 +438,36 @@ func (p *ImportWrapper) Import(filePaths []string, rowBased bool, onlyValidate b
 	return p.reportFunc(p.importResult)
 }
 
+// reportParsed reports a file is parsed, returns errImportAborted if rootcoord rejects the report
+func (p *ImportWrapper) reportParsed() error {
+	p.filesParsed++
+	setImportInfo(p.importResult, FilesParsedKey, strconv.Itoa(p.filesParsed))
+	p.importResult.State = commonpb.ImportState_ImportParsed
+	if err := p.reportFunc(p.importResult); err != nil {
+		return fmt.Errorf("%w: %s", errImportAborted, err.Error())
+	}
+	return nil
+}
+
+// reportFailedFile records the file failed to import, which is reported along with the failed reason
+func (p *ImportWrapper) reportFailedFile(filePath string, err error) {
+	log.Error("import error: "+err.Error(), zap.String("filePath", filePath))
+	if !errors.Is(err, errImportAborted) {
+		setImportInfo(p.importResult, FailedFileKey, filePath)
+	}
+}
+
+// setImportInfo sets the value of the key in the infos of import result
+func setImportInfo(res *rootcoordpb.ImportResult, key string, value string) {
+	for _, kv := range res.Infos {
+		if kv.GetKey() == key {
+			kv.Value = value
+			return
+		}
+	}
+	res.Infos = append(res.Infos, &commonpb.KeyValuePair{Key: key, Value: value})
+}
+
 func (p *ImportWrapper) appendFunc(schema *schemapb.FieldSchema) func(src storage.FieldData, n int, target storage.FieldData) error {
 	switch schema.DataType {
 	case schemapb.DataType_Bool:
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"strconv"
 	"testing"
 
@@ -181,6 +182,23 @@ func Test_ImportRowBased(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 5, rowCount)
 	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+	assert.Contains(t, importResult.Infos, &commonpb.KeyValuePair{Key: FilesParsedKey, Value: "1"})
+
+	// report rejected, e.g. the task is canceled
+	rejectFunc := func(res *rootcoordpb.ImportResult) error {
+		if res.State == commonpb.ImportState_ImportParsed {
+			return errors.New("task is canceled")
+		}
+		return nil
+	}
+	rejectedResult := &rootcoordpb.ImportResult{State: commonpb.ImportState_ImportStarted}
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, flushFunc, rejectedResult, rejectFunc)
+	err = wrapper.Import(files, true, false)
+	assert.ErrorIs(t, err, errImportAborted)
+	assert.NotEqual(t, commonpb.ImportState_ImportPersisted, rejectedResult.State)
+	for _, kv := range rejectedResult.Infos {
+		assert.NotEqual(t, FailedFileKey, kv.GetKey())
+	}
 
 	// parse error
 	content = []byte(`{
@@ -200,6 +218,7 @@ func Test_ImportRowBased(t *testing.T) {
 	err = wrapper.Import(files, true, false)
 	assert.NotNil(t, err)
 	assert.NotEqual(t, commonpb.ImportState_ImportPersisted, importResult.State)
+	assert.Contains(t, importResult.Infos, &commonpb.KeyValuePair{Key: FailedFileKey, Value: filePath})
 
 	// file doesn't exist
 	files = make([]string, 0)
@@ -510,6 +529,60 @@ func perfSchema(dim int) *schemapb.CollectionSchema {
 	return schema
 }
 
+func Test_ImportColumnBased_parquet(t *testing.T) {
+	f := dependency.NewDefaultFactory(true)
+	ctx := context.Background()
+	cm, err := f.NewVectorStorageChunkManager(ctx)
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix("")
+
+	idAllocator := newIDAllocator(ctx, t)
+
+	filePath := TempFilesPath + "fields.parquet"
+	err = cm.Write(filePath, sampleParquetData(t))
+	assert.NoError(t, err)
+
+	rowCount := 0
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardNum int) error {
+		count := 0
+		for _, data := range fields {
+			assert.Less(t, 0, data.RowNum())
+			if count == 0 {
+				count = data.RowNum()
+			} else {
+				assert.Equal(t, count, data.RowNum())
+			}
+		}
+		rowCount += count
+		return nil
+	}
+
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+	wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, flushFunc, importResult, reportFunc)
+
+	err = wrapper.Import([]string{filePath}, false, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+
+	// the parquet file is not allowed in row-based mode
+	err = wrapper.Import([]string{filePath}, true, false)
+	assert.NotNil(t, err)
+}
+
 func Test_ImportRowBased_perf(t *testing.T) {
 	f := dependency.NewDefaultFactory(true)
 	ctx := context.Background()
@@ -764,6 +837,14 @@ func Test_FileValidation(t *testing.T) {
 	err = wrapper.fileValidation(files[:], false)
 	assert.Nil(t, err)
 
+	// parquet files are column-based
+	files[1] = "2.parquet"
+	err = wrapper.fileValidation(files[:], false)
+	assert.Nil(t, err)
+	err = wrapper.fileValidation(files[:], true)
+	assert.NotNil(t, err)
+	files[1] = "2.npy"
+
 	// empty file
 	cm = &MockChunkManager{
 		size: 0,
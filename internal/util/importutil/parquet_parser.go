@@ -0,0 +1,331 @@
+package importutil
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// ParquetParser reads a column-based parquet file, each column of the file is named after a field of the collection.
+// The scalar fields are stored in the primitive columns, the float vectors are stored in the list columns of
+// float or double, the binary vectors are stored in the binary columns or in the list columns of uint8.
+type ParquetParser struct {
+	ctx              context.Context            // for canceling parse process
+	collectionSchema *schemapb.CollectionSchema // collection schema
+
+	callFlushFunc func(fields map[storage.FieldID]storage.FieldData) error // call back function to output columns data
+}
+
+// NewParquetParser helper function to create a ParquetParser
+func NewParquetParser(ctx context.Context, collectionSchema *schemapb.CollectionSchema,
+	flushFunc func(fields map[storage.FieldID]storage.FieldData) error) *ParquetParser {
+	if collectionSchema == nil || flushFunc == nil {
+		return nil
+	}
+
+	return &ParquetParser{
+		ctx:              ctx,
+		collectionSchema: collectionSchema,
+		callFlushFunc:    flushFunc,
+	}
+}
+
+func (p *ParquetParser) logError(msg string) error {
+	log.Error(msg)
+	return errors.New(msg)
+}
+
+// toReaderAtSeeker returns the reader itself if it supports random access, such as the local files and the
+// objects of MinIO/S3, otherwise the whole file is read into memory.
+func toReaderAtSeeker(reader io.Reader) (parquet.ReaderAtSeeker, error) {
+	if r, ok := reader.(parquet.ReaderAtSeeker); ok {
+		return r, nil
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+// validate returns the schema of the field stored in the column, the columns not matching any field are rejected,
+// as well as the auto id primary key which is generated by import.
+func (p *ParquetParser) validate(field arrow.Field) (*schemapb.FieldSchema, error) {
+	for _, schema := range p.collectionSchema.Fields {
+		if schema.GetName() != field.Name {
+			continue
+		}
+		if schema.GetIsPrimaryKey() && schema.GetAutoID() {
+			return nil, errors.New("the field " + field.Name + " is auto id, no need to provide")
+		}
+		return schema, nil
+	}
+	return nil, errors.New("the field " + field.Name + " doesn't exist")
+}
+
+func illegalColumnType(field arrow.Field, schema *schemapb.FieldSchema) error {
+	return errors.New("illegal data type " + field.Type.Name() + " for field " + schema.GetName())
+}
+
+// listValues returns the values of the list column and the offsets of the rows in them
+func listValues(column arrow.Array) (arrow.Array, func(i int) (int, int), bool) {
+	switch arr := column.(type) {
+	case *array.List:
+		offsets := arr.Offsets()
+		return arr.ListValues(), func(i int) (int, int) { return int(offsets[i]), int(offsets[i+1]) }, true
+	case *array.FixedSizeList:
+		n := arr.DataType().(*arrow.FixedSizeListType).Len()
+		begin := arr.Data().Offset()
+		return arr.ListValues(), func(i int) (int, int) {
+			return (begin + i) * int(n), (begin + i + 1) * int(n)
+		}, true
+	default:
+		return nil, nil, false
+	}
+}
+
+// appendFloatVector appends the rows of the list column of float or double to the float vectors
+func appendFloatVector(column arrow.Array, dim int, data []float32) ([]float32, bool, error) {
+	values, bounds, ok := listValues(column)
+	if !ok {
+		return nil, false, nil
+	}
+	for i := 0; i < column.Len(); i++ {
+		begin, end := bounds(i)
+		if end-begin != dim {
+			return nil, true, errors.New("illegal row width " + strconv.Itoa(end-begin) + " dimension " + strconv.Itoa(dim))
+		}
+		switch v := values.(type) {
+		case *array.Float32:
+			data = append(data, v.Float32Values()[begin:end]...)
+		case *array.Float64:
+			for _, f64 := range v.Float64Values()[begin:end] {
+				data = append(data, float32(f64))
+			}
+		default:
+			return nil, false, nil
+		}
+	}
+	return data, true, nil
+}
+
+// appendBinaryVector appends the rows of the binary column or the list column of uint8 to the binary vectors
+func appendBinaryVector(column arrow.Array, dim int, data []byte) ([]byte, bool, error) {
+	var value func(i int) []byte
+	switch arr := column.(type) {
+	case *array.Binary:
+		value = arr.Value
+	case *array.FixedSizeBinary:
+		value = arr.Value
+	default:
+		values, bounds, ok := listValues(column)
+		if !ok {
+			return nil, false, nil
+		}
+		bytesValues, ok := values.(*array.Uint8)
+		if !ok {
+			return nil, false, nil
+		}
+		value = func(i int) []byte {
+			begin, end := bounds(i)
+			return bytesValues.Uint8Values()[begin:end]
+		}
+	}
+	for i := 0; i < column.Len(); i++ {
+		row := value(i)
+		if len(row) != dim/8 {
+			return nil, true, errors.New("illegal row width " + strconv.Itoa(len(row)) + " dimension " + strconv.Itoa(dim))
+		}
+		data = append(data, row...)
+	}
+	return data, true, nil
+}
+
+// consume converts the chunks of the column into a storage.FieldData
+func (p *ParquetParser) consume(field arrow.Field, schema *schemapb.FieldSchema, chunks []arrow.Array) (storage.FieldData, error) {
+	rowCount := 0
+	for _, chunk := range chunks {
+		if chunk.NullN() > 0 {
+			return nil, errors.New("null value is not allowed for field " + schema.GetName())
+		}
+		rowCount += chunk.Len()
+	}
+	numRows := []int64{int64(rowCount)}
+
+	switch schema.GetDataType() {
+	case schemapb.DataType_Bool:
+		data := make([]bool, 0, rowCount)
+		for _, chunk := range chunks {
+			arr, ok := chunk.(*array.Boolean)
+			if !ok {
+				return nil, illegalColumnType(field, schema)
+			}
+			for i := 0; i < arr.Len(); i++ {
+				data = append(data, arr.Value(i))
+			}
+		}
+		return &storage.BoolFieldData{NumRows: numRows, Data: data}, nil
+	case schemapb.DataType_Int8:
+		data := make([]int8, 0, rowCount)
+		for _, chunk := range chunks {
+			arr, ok := chunk.(*array.Int8)
+			if !ok {
+				return nil, illegalColumnType(field, schema)
+			}
+			data = append(data, arr.Int8Values()...)
+		}
+		return &storage.Int8FieldData{NumRows: numRows, Data: data}, nil
+	case schemapb.DataType_Int16:
+		data := make([]int16, 0, rowCount)
+		for _, chunk := range chunks {
+			arr, ok := chunk.(*array.Int16)
+			if !ok {
+				return nil, illegalColumnType(field, schema)
+			}
+			data = append(data, arr.Int16Values()...)
+		}
+		return &storage.Int16FieldData{NumRows: numRows, Data: data}, nil
+	case schemapb.DataType_Int32:
+		data := make([]int32, 0, rowCount)
+		for _, chunk := range chunks {
+			arr, ok := chunk.(*array.Int32)
+			if !ok {
+				return nil, illegalColumnType(field, schema)
+			}
+			data = append(data, arr.Int32Values()...)
+		}
+		return &storage.Int32FieldData{NumRows: numRows, Data: data}, nil
+	case schemapb.DataType_Int64:
+		data := make([]int64, 0, rowCount)
+		for _, chunk := range chunks {
+			arr, ok := chunk.(*array.Int64)
+			if !ok {
+				return nil, illegalColumnType(field, schema)
+			}
+			data = append(data, arr.Int64Values()...)
+		}
+		return &storage.Int64FieldData{NumRows: numRows, Data: data}, nil
+	case schemapb.DataType_Float:
+		data := make([]float32, 0, rowCount)
+		for _, chunk := range chunks {
+			arr, ok := chunk.(*array.Float32)
+			if !ok {
+				return nil, illegalColumnType(field, schema)
+			}
+			data = append(data, arr.Float32Values()...)
+		}
+		return &storage.FloatFieldData{NumRows: numRows, Data: data}, nil
+	case schemapb.DataType_Double:
+		data := make([]float64, 0, rowCount)
+		for _, chunk := range chunks {
+			arr, ok := chunk.(*array.Float64)
+			if !ok {
+				return nil, illegalColumnType(field, schema)
+			}
+			data = append(data, arr.Float64Values()...)
+		}
+		return &storage.DoubleFieldData{NumRows: numRows, Data: data}, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		data := make([]string, 0, rowCount)
+		for _, chunk := range chunks {
+			arr, ok := chunk.(*array.String)
+			if !ok {
+				return nil, illegalColumnType(field, schema)
+			}
+			for i := 0; i < arr.Len(); i++ {
+				data = append(data, arr.Value(i))
+			}
+		}
+		return &storage.StringFieldData{NumRows: numRows, Data: data}, nil
+	case schemapb.DataType_FloatVector:
+		dim, err := getFieldDimension(schema)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]float32, 0, rowCount*dim)
+		for _, chunk := range chunks {
+			var ok bool
+			data, ok, err = appendFloatVector(chunk, dim, data)
+			if !ok {
+				return nil, illegalColumnType(field, schema)
+			}
+			if err != nil {
+				return nil, errors.New(err.Error() + " of field " + schema.GetName())
+			}
+		}
+		return &storage.FloatVectorFieldData{NumRows: numRows, Data: data, Dim: dim}, nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := getFieldDimension(schema)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, 0, rowCount*dim/8)
+		for _, chunk := range chunks {
+			var ok bool
+			data, ok, err = appendBinaryVector(chunk, dim, data)
+			if !ok {
+				return nil, illegalColumnType(field, schema)
+			}
+			if err != nil {
+				return nil, errors.New(err.Error() + " of field " + schema.GetName())
+			}
+		}
+		return &storage.BinaryVectorFieldData{NumRows: numRows, Data: data, Dim: dim}, nil
+	default:
+		return nil, errors.New("unsupported data type: " + strconv.Itoa(int(schema.GetDataType())))
+	}
+}
+
+// Parse reads all the columns of the parquet file, the columns are validated against the collection schema and
+// output to the flush function together. Only the schema of the file is checked if onlyValidate is true.
+// Please note it requires the memory as large as the decoded columns.
+func (p *ParquetParser) Parse(reader io.Reader, onlyValidate bool) error {
+	r, err := toReaderAtSeeker(reader)
+	if err != nil {
+		return p.logError("Parquet parse: " + err.Error())
+	}
+	table, err := pqarrow.ReadTable(p.ctx, r, parquet.NewReaderProperties(memory.DefaultAllocator),
+		pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		return p.logError("Parquet parse: " + err.Error())
+	}
+	defer table.Release()
+
+	schemas := make([]*schemapb.FieldSchema, table.NumCols())
+	for i := 0; i < int(table.NumCols()); i++ {
+		schemas[i], err = p.validate(table.Schema().Field(i))
+		if err != nil {
+			return p.logError("Parquet parse: " + err.Error())
+		}
+	}
+	if onlyValidate {
+		return nil
+	}
+
+	fields := make(map[storage.FieldID]storage.FieldData)
+	for i, schema := range schemas {
+		if _, ok := fields[schema.GetFieldID()]; ok {
+			return p.logError("Parquet parse: the field " + schema.GetName() + " is duplicated")
+		}
+		data, err := p.consume(table.Schema().Field(i), schema, table.Column(i).Data().Chunks())
+		if err != nil {
+			return p.logError("Parquet parse: " + err.Error())
+		}
+		fields[schema.GetFieldID()] = data
+	}
+
+	return p.callFlushFunc(fields)
+}
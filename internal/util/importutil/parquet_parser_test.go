@@ -0,0 +1,189 @@
+package importutil
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// CreateParquetData writes the columns built by the append functions into a parquet file
+func CreateParquetData(fields []arrow.Field, appendFuncs []func(b array.Builder)) ([]byte, error) {
+	schema := arrow.NewSchema(fields, nil)
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+	for i, appendFunc := range appendFuncs {
+		appendFunc(builder.Field(i))
+	}
+	record := builder.NewRecord()
+	defer record.Release()
+	table := array.NewTableFromRecords(schema, []array.Record{record})
+	defer table.Release()
+
+	buf := &bytes.Buffer{}
+	err := pqarrow.WriteTable(table, buf, 1024, nil, pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func sampleParquetData(t *testing.T) []byte {
+	fields := []arrow.Field{
+		{Name: "field_bool", Type: arrow.FixedWidthTypes.Boolean},
+		{Name: "field_int8", Type: arrow.PrimitiveTypes.Int8},
+		{Name: "field_int16", Type: arrow.PrimitiveTypes.Int16},
+		{Name: "field_int32", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "field_int64", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "field_float", Type: arrow.PrimitiveTypes.Float32},
+		{Name: "field_double", Type: arrow.PrimitiveTypes.Float64},
+		{Name: "field_string", Type: arrow.BinaryTypes.String},
+		{Name: "field_binary_vector", Type: &arrow.FixedSizeBinaryType{ByteWidth: 2}},
+		{Name: "field_float_vector", Type: arrow.ListOf(arrow.PrimitiveTypes.Float32)},
+	}
+	appendFuncs := []func(b array.Builder){
+		func(b array.Builder) { b.(*array.BooleanBuilder).AppendValues([]bool{true, false, true}, nil) },
+		func(b array.Builder) { b.(*array.Int8Builder).AppendValues([]int8{10, 11, 12}, nil) },
+		func(b array.Builder) { b.(*array.Int16Builder).AppendValues([]int16{100, 101, 102}, nil) },
+		func(b array.Builder) { b.(*array.Int32Builder).AppendValues([]int32{1000, 1001, 1002}, nil) },
+		func(b array.Builder) { b.(*array.Int64Builder).AppendValues([]int64{10000, 10001, 10002}, nil) },
+		func(b array.Builder) { b.(*array.Float32Builder).AppendValues([]float32{3.14, 3.15, 3.16}, nil) },
+		func(b array.Builder) { b.(*array.Float64Builder).AppendValues([]float64{5.1, 5.2, 5.3}, nil) },
+		func(b array.Builder) { b.(*array.StringBuilder).AppendValues([]string{"a", "b", "c"}, nil) },
+		func(b array.Builder) {
+			b.(*array.FixedSizeBinaryBuilder).AppendValues([][]byte{{1, 2}, {3, 4}, {5, 6}}, nil)
+		},
+		func(b array.Builder) {
+			lb := b.(*array.ListBuilder)
+			vb := lb.ValueBuilder().(*array.Float32Builder)
+			for _, row := range [][]float32{{1, 2, 3, 4}, {3, 4, 5, 6}, {5, 6, 7, 8}} {
+				lb.Append(true)
+				vb.AppendValues(row, nil)
+			}
+		},
+	}
+	content, err := CreateParquetData(fields, appendFuncs)
+	assert.Nil(t, err)
+	return content
+}
+
+func Test_NewParquetParser(t *testing.T) {
+	ctx := context.Background()
+
+	parser := NewParquetParser(ctx, nil, nil)
+	assert.Nil(t, parser)
+}
+
+func Test_ParquetParserParse(t *testing.T) {
+	ctx := context.Background()
+	content := sampleParquetData(t)
+
+	var fields map[storage.FieldID]storage.FieldData
+	flushFunc := func(data map[storage.FieldID]storage.FieldData) error {
+		fields = data
+		return nil
+	}
+
+	// only validate, nothing output
+	parser := NewParquetParser(ctx, sampleSchema(), flushFunc)
+	err := parser.Parse(bytes.NewReader(content), true)
+	assert.Nil(t, err)
+	assert.Nil(t, fields)
+
+	// the reader without random access is read into memory
+	err = parser.Parse(bytes.NewBuffer(content), false)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(fields))
+	for _, data := range fields {
+		assert.Equal(t, 3, data.RowNum())
+	}
+	assert.Equal(t, []bool{true, false, true}, fields[102].(*storage.BoolFieldData).Data)
+	assert.Equal(t, []int64{10000, 10001, 10002}, fields[106].(*storage.Int64FieldData).Data)
+	assert.Equal(t, []string{"a", "b", "c"}, fields[109].(*storage.StringFieldData).Data)
+	assert.Equal(t, []byte{1, 2, 3, 4, 5, 6}, fields[110].(*storage.BinaryVectorFieldData).Data)
+	assert.Equal(t, []float32{1, 2, 3, 4, 3, 4, 5, 6, 5, 6, 7, 8}, fields[111].(*storage.FloatVectorFieldData).Data)
+	assert.Equal(t, 4, fields[111].(*storage.FloatVectorFieldData).Dim)
+
+	// not a parquet file
+	err = parser.Parse(bytes.NewReader([]byte("dummy")), false)
+	assert.NotNil(t, err)
+}
+
+func Test_ParquetParserValidate(t *testing.T) {
+	ctx := context.Background()
+	flushFunc := func(data map[storage.FieldID]storage.FieldData) error {
+		return nil
+	}
+	parse := func(field arrow.Field, appendFunc func(b array.Builder), onlyValidate bool) error {
+		content, err := CreateParquetData([]arrow.Field{field}, []func(b array.Builder){appendFunc})
+		assert.Nil(t, err)
+		parser := NewParquetParser(ctx, sampleSchema(), flushFunc)
+		return parser.Parse(bytes.NewReader(content), onlyValidate)
+	}
+	appendInt64 := func(b array.Builder) { b.(*array.Int64Builder).AppendValues([]int64{1, 2}, nil) }
+
+	// the column doesn't match any field
+	err := parse(arrow.Field{Name: "dummy", Type: arrow.PrimitiveTypes.Int64}, appendInt64, true)
+	assert.NotNil(t, err)
+
+	// the type of the column doesn't match the field
+	err = parse(arrow.Field{Name: "field_int32", Type: arrow.PrimitiveTypes.Int64}, appendInt64, false)
+	assert.NotNil(t, err)
+
+	// null values
+	err = parse(arrow.Field{Name: "field_int64", Type: arrow.PrimitiveTypes.Int64, Nullable: true}, func(b array.Builder) {
+		b.(*array.Int64Builder).AppendValues([]int64{1, 2}, []bool{true, false})
+	}, false)
+	assert.NotNil(t, err)
+
+	// the dimension of the float vector is 4
+	err = parse(arrow.Field{Name: "field_float_vector", Type: arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Float64)}, func(b array.Builder) {
+		lb := b.(*array.FixedSizeListBuilder)
+		lb.Append(true)
+		lb.ValueBuilder().(*array.Float64Builder).AppendValues([]float64{1, 2}, nil)
+	}, false)
+	assert.NotNil(t, err)
+
+	// the float vector stored by double
+	err = parse(arrow.Field{Name: "field_float_vector", Type: arrow.FixedSizeListOf(4, arrow.PrimitiveTypes.Float64)}, func(b array.Builder) {
+		lb := b.(*array.FixedSizeListBuilder)
+		lb.Append(true)
+		lb.ValueBuilder().(*array.Float64Builder).AppendValues([]float64{1, 2, 3, 4}, nil)
+	}, false)
+	assert.Nil(t, err)
+
+	// the binary vector stored by the list of uint8
+	err = parse(arrow.Field{Name: "field_binary_vector", Type: arrow.ListOf(arrow.PrimitiveTypes.Uint8)}, func(b array.Builder) {
+		lb := b.(*array.ListBuilder)
+		lb.Append(true)
+		lb.ValueBuilder().(*array.Uint8Builder).AppendValues([]uint8{1, 2}, nil)
+	}, false)
+	assert.Nil(t, err)
+
+	// the auto id primary key is generated
+	schema := sampleSchema()
+	for _, field := range schema.Fields {
+		if field.GetIsPrimaryKey() {
+			field.AutoID = true
+		}
+	}
+	content, err := CreateParquetData([]arrow.Field{{Name: "field_int64", Type: arrow.PrimitiveTypes.Int64}},
+		[]func(b array.Builder){appendInt64})
+	assert.Nil(t, err)
+	parser := NewParquetParser(ctx, schema, flushFunc)
+	assert.NotNil(t, parser.Parse(bytes.NewReader(content), true))
+
+	// unsupported data type
+	parser = NewParquetParser(ctx, &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{FieldID: 100, Name: "field_int64", DataType: schemapb.DataType_None}},
+	}, flushFunc)
+	assert.NotNil(t, parser.Parse(bytes.NewReader(content), false))
+}
@@ -0,0 +1,142 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexparamcheck
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+const (
+	// SearchLevel is the search param of the recall level asked for, from MinSearchLevel, the fastest, to
+	// MaxSearchLevel, the most accurate, which the search params of the index are derived from
+	SearchLevel = "level"
+
+	// MinSearchLevel is the lower limit of the search level
+	MinSearchLevel = 1
+	// MaxSearchLevel is the upper limit of the search level
+	MaxSearchLevel = 5
+
+	// AutoIndexHNSWMaxRows is the number of rows up to which the float vectors are indexed by HNSW, the larger
+	// collections are indexed by IVF_SQ8 taking less memory
+	AutoIndexHNSWMaxRows = 1000000
+	// AutoIndexEfConstruction is the efConstruction of the HNSW indexes picked
+	AutoIndexEfConstruction = 256
+)
+
+// AutoIndexParams returns the index type and params picked for the vector field of the data type and the dimension
+// with the metric type, the collection of which has numRows rows. The metric type defaults to L2 for float vectors
+// and HAMMING for binary vectors.
+func AutoIndexParams(dataType schemapb.DataType, dim int64, metricType string, numRows int64) (map[string]string, error) {
+	indexParams := map[string]string{
+		DIM: strconv.FormatInt(dim, 10),
+	}
+	switch dataType {
+	case schemapb.DataType_FloatVector:
+		if metricType == "" {
+			metricType = L2
+		}
+		if metricType != L2 && metricType != IP {
+			return nil, fmt.Errorf("metric type %s is not supported on float vectors", metricType)
+		}
+		if numRows <= AutoIndexHNSWMaxRows {
+			m := 16
+			if dim > 256 {
+				m = 32
+			}
+			indexParams["index_type"] = IndexHNSW
+			indexParams[HNSWM] = strconv.Itoa(m)
+			indexParams[EFConstruction] = strconv.Itoa(AutoIndexEfConstruction)
+		} else {
+			indexParams["index_type"] = IndexFaissIvfSQ8
+			indexParams[NLIST] = strconv.FormatInt(autoIndexNList(numRows), 10)
+		}
+	case schemapb.DataType_BinaryVector:
+		if metricType == "" {
+			metricType = HAMMING
+		}
+		switch metricType {
+		case HAMMING, JACCARD, TANIMOTO:
+			indexParams["index_type"] = IndexFaissBinIvfFlat
+			indexParams[NLIST] = strconv.FormatInt(autoIndexNList(numRows), 10)
+		case SUBSTRUCTURE, SUPERSTRUCTURE:
+			// only the brute force search supports them
+			indexParams["index_type"] = IndexFaissBinIDMap
+		default:
+			return nil, fmt.Errorf("metric type %s is not supported on binary vectors", metricType)
+		}
+	default:
+		return nil, fmt.Errorf("auto index is not supported on data type %s", dataType.String())
+	}
+	indexParams[Metric] = metricType
+	return indexParams, nil
+}
+
+// autoIndexNList returns 4 * sqrt(numRows), the usual nlist of the IVF indexes, within [MinNList, MaxNList]
+func autoIndexNList(numRows int64) int64 {
+	nlist := int64(4 * math.Sqrt(float64(numRows)))
+	if nlist < MinNList {
+		return MinNList
+	}
+	if nlist > MaxNList {
+		return MaxNList
+	}
+	return nlist
+}
+
+// AutoSearchParams returns the search params derived from the search level for a search with top k on the index
+// built with the index params, nothing if the index type takes no search params.
+func AutoSearchParams(indexParams map[string]string, level int64, topK int64) (map[string]interface{}, error) {
+	if level < MinSearchLevel || level > MaxSearchLevel {
+		return nil, fmt.Errorf("%s should be in range [%d, %d], but got %d", SearchLevel, MinSearchLevel, MaxSearchLevel, level)
+	}
+	searchParams := make(map[string]interface{})
+	switch indexParams["index_type"] {
+	case IndexFaissIvfFlat, IndexFaissIvfPQ, IndexFaissIvfSQ8, IndexFaissIvfSQ8H, IndexFaissBinIvfFlat:
+		// nlist/128 clusters are searched at level 1, doubled per level up to nlist/8
+		nlist, err := strconv.ParseInt(indexParams[NLIST], 10, 64)
+		if err != nil || nlist <= 0 {
+			return searchParams, nil
+		}
+		nprobe := nlist >> (8 - level)
+		if nprobe < MinNProbe {
+			nprobe = MinNProbe
+		}
+		searchParams[NPROBE] = nprobe
+	case IndexHNSW, IndexRHNSWFlat, IndexRHNSWPQ, IndexRHNSWSQ:
+		// ef is 32 at level 1, doubled per level up to 512, and at least the top k
+		ef := int64(16) << level
+		if ef < topK {
+			ef = topK
+		}
+		if ef > HNSWMaxEf {
+			ef = HNSWMaxEf
+		}
+		searchParams[HNSWEf] = ef
+	case IndexANNOY:
+		nTrees, err := strconv.ParseInt(indexParams[NTREES], 10, 64)
+		if err != nil || nTrees <= 0 {
+			return searchParams, nil
+		}
+		// n_trees * top k nodes are inspected at level 1, the default of knowhere
+		searchParams[SearchK] = nTrees * topK * level
+	}
+	return searchParams, nil
+}
@@ -0,0 +1,91 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexparamcheck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestAutoIndexParams(t *testing.T) {
+	cases := []struct {
+		dataType   schemapb.DataType
+		metricType string
+		numRows    int64
+		expected   map[string]string
+	}{
+		{schemapb.DataType_FloatVector, "", 1000, map[string]string{
+			"index_type": IndexHNSW, DIM: "128", Metric: L2, HNSWM: "16", EFConstruction: "256"}},
+		{schemapb.DataType_FloatVector, IP, 10000000, map[string]string{
+			"index_type": IndexFaissIvfSQ8, DIM: "128", Metric: IP, NLIST: "12649"}},
+		{schemapb.DataType_BinaryVector, "", 0, map[string]string{
+			"index_type": IndexFaissBinIvfFlat, DIM: "128", Metric: HAMMING, NLIST: "1"}},
+		{schemapb.DataType_BinaryVector, SUBSTRUCTURE, 100, map[string]string{
+			"index_type": IndexFaissBinIDMap, DIM: "128", Metric: SUBSTRUCTURE}},
+	}
+	adapterMgr := GetConfAdapterMgrInstance()
+	for _, c := range cases {
+		indexParams, err := AutoIndexParams(c.dataType, 128, c.metricType, c.numRows)
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, indexParams)
+		adapter, err := adapterMgr.GetAdapter(indexParams["index_type"])
+		assert.NoError(t, err)
+		assert.True(t, adapter.CheckTrain(indexParams), "%v", indexParams)
+	}
+
+	indexParams, err := AutoIndexParams(schemapb.DataType_FloatVector, 512, L2, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, "32", indexParams[HNSWM])
+	assert.Equal(t, int64(MaxNList), autoIndexNList(1<<40))
+
+	_, err = AutoIndexParams(schemapb.DataType_FloatVector, 128, HAMMING, 100)
+	assert.Error(t, err)
+	_, err = AutoIndexParams(schemapb.DataType_BinaryVector, 128, L2, 100)
+	assert.Error(t, err)
+	_, err = AutoIndexParams(schemapb.DataType_Int64, 128, L2, 100)
+	assert.Error(t, err)
+}
+
+func TestAutoSearchParams(t *testing.T) {
+	cases := []struct {
+		indexParams map[string]string
+		level       int64
+		expected    map[string]interface{}
+	}{
+		{map[string]string{"index_type": IndexFaissIvfSQ8, NLIST: "1024"}, 1, map[string]interface{}{NPROBE: int64(8)}},
+		{map[string]string{"index_type": IndexFaissIvfSQ8, NLIST: "1024"}, 5, map[string]interface{}{NPROBE: int64(128)}},
+		{map[string]string{"index_type": IndexFaissBinIvfFlat, NLIST: "16"}, 1, map[string]interface{}{NPROBE: int64(1)}},
+		{map[string]string{"index_type": IndexFaissIvfFlat}, 1, map[string]interface{}{}},
+		{map[string]string{"index_type": IndexHNSW}, 1, map[string]interface{}{HNSWEf: int64(100)}},
+		{map[string]string{"index_type": IndexHNSW}, 5, map[string]interface{}{HNSWEf: int64(512)}},
+		{map[string]string{"index_type": IndexANNOY, NTREES: "8"}, 2, map[string]interface{}{SearchK: int64(1600)}},
+		{map[string]string{"index_type": IndexFaissIDMap}, 3, map[string]interface{}{}},
+	}
+	for _, c := range cases {
+		searchParams, err := AutoSearchParams(c.indexParams, c.level, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, searchParams, "%v %d", c.indexParams, c.level)
+	}
+
+	_, err := AutoSearchParams(map[string]string{"index_type": IndexHNSW}, 0, 10)
+	assert.Error(t, err)
+	_, err = AutoSearchParams(map[string]string{"index_type": IndexHNSW}, MaxSearchLevel+1, 10)
+	assert.Error(t, err)
+}
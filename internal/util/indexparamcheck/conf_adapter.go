@@ -65,6 +65,8 @@ const (
 	HNSWMaxEfConstruction = 512
 	HNSWMinM              = 4
 	HNSWMaxM              = 64
+	HNSWMinEf             = 1
+	HNSWMaxEf             = 32768
 
 	MinKNNG              = 5
 	MaxKNNG              = 300
@@ -95,6 +97,9 @@ const (
 
 	EFConstruction = "efConstruction"
 	HNSWM          = "M"
+	// HNSWEf is the size of the dynamic candidate list of the searches on HNSW, the index params may carry the
+	// default of the searches which a search overrides by its own search params
+	HNSWEf = "ef"
 
 	PQM    = "PQM"
 	NTREES = "n_trees"
@@ -346,6 +351,11 @@ func (adapter *HNSWConfAdapter) CheckTrain(params map[string]string) bool {
 		return false
 	}
 
+	// the default ef of the searches is optional
+	if _, ok := params[HNSWEf]; ok && !CheckIntByRange(params, HNSWEf, HNSWMinEf, HNSWMaxEf) {
+		return false
+	}
+
 	return adapter.BaseConfAdapter.CheckTrain(params)
 }
 
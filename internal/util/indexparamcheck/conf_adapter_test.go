@@ -334,6 +334,12 @@ func TestHNSWConfAdapter_CheckTrain(t *testing.T) {
 	invalidMParamsMax := copyParams(validParams)
 	invalidMParamsMax[HNSWM] = strconv.Itoa(HNSWMaxM + 1)
 
+	validSearchEfParams := copyParams(validParams)
+	validSearchEfParams[HNSWEf] = strconv.Itoa(64)
+
+	invalidSearchEfParams := copyParams(validParams)
+	invalidSearchEfParams[HNSWEf] = strconv.Itoa(HNSWMaxEf + 1)
+
 	cases := []struct {
 		params map[string]string
 		want   bool
@@ -343,6 +349,8 @@ func TestHNSWConfAdapter_CheckTrain(t *testing.T) {
 		{invalidEfParamsMax, false},
 		{invalidMParamsMin, false},
 		{invalidMParamsMax, false},
+		{validSearchEfParams, true},
+		{invalidSearchEfParams, false},
 	}
 
 	adapter := newHNSWConfAdapter()
@@ -31,4 +31,24 @@ const (
 	IndexANNOY           IndexType = "ANNOY"
 	IndexNGTPANNG        IndexType = "NGT_PANNG"
 	IndexNGTONNG         IndexType = "NGT_ONNG"
+	IndexAUTOINDEX       IndexType = "AUTOINDEX" // resolved to one of the above by proxy, see AutoIndexParams
+
+	// scalar index types
+	IndexSTLSORT  IndexType = "STL_SORT" // sorted index on numeric and bool fields
+	IndexINVERTED IndexType = "INVERTED" // inverted index on string fields, mapping each value to its offsets
+	IndexTrie     IndexType = "Trie"     // the former name of the inverted index on string fields
 )
+
+// IsScalarIndexType returns whether the index of the type is built on scalar fields
+func IsScalarIndexType(indexType IndexType) bool {
+	return indexType == IndexSTLSORT || indexType == IndexINVERTED || indexType == IndexTrie
+}
+
+// IsGPUIndexType returns whether the index of the type could be placed on GPU memory and searched by the GPU kernels
+func IsGPUIndexType(indexType IndexType) bool {
+	switch indexType {
+	case IndexFaissIDMap, IndexFaissIvfFlat, IndexFaissIvfPQ, IndexFaissIvfSQ8, IndexFaissIvfSQ8H:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexparamcheck
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// Radius is the search param of the range searches, the results not within it are dropped
+	Radius = "radius"
+	// RangeFilter is the search param of the range searches, the results closer than it are dropped
+	RangeFilter = "range_filter"
+)
+
+// A search with the radius search param is a range search, which returns at most top k results in the range of the
+// metric, instead of the top k nearest ones. For the distance metrics, L2, HAMMING, JACCARD and TANIMOTO, a result
+// is in range if range_filter <= distance < radius, so a HAMMING radius of 3 takes the vectors differing in at most
+// 2 bits. For IP, a result is in range if radius < similarity <= range_filter. The range_filter is optional.
+// SUBSTRUCTURE and SUPERSTRUCTURE only tell whether a vector matches, so they have no range.
+
+// SearchRange is the range of a range search, see ParseSearchRange
+type SearchRange struct {
+	positivelyRelated bool
+	radius            float64
+	rangeFilter       float64
+	hasRangeFilter    bool
+}
+
+// ParseSearchRange returns the range of the search with the metric type and the search params, nil if the search
+// is not a range search. It returns error if the metric type has no range or the range is invalid for the metric.
+func ParseSearchRange(metricType string, searchParams map[string]interface{}) (*SearchRange, error) {
+	value, ok := searchParams[Radius]
+	if !ok {
+		if _, ok := searchParams[RangeFilter]; ok {
+			return nil, fmt.Errorf("%s is only allowed with %s", RangeFilter, Radius)
+		}
+		return nil, nil
+	}
+	radius, err := parseFloatSearchParam(Radius, value)
+	if err != nil {
+		return nil, err
+	}
+	r := &SearchRange{radius: radius}
+	if value, ok := searchParams[RangeFilter]; ok {
+		r.rangeFilter, err = parseFloatSearchParam(RangeFilter, value)
+		if err != nil {
+			return nil, err
+		}
+		r.hasRangeFilter = true
+	}
+
+	metricType = strings.ToUpper(metricType)
+	switch metricType {
+	case IP:
+		r.positivelyRelated = true
+		if r.hasRangeFilter && r.rangeFilter <= radius {
+			return nil, fmt.Errorf("%s %v of %s should be greater than %s %v", RangeFilter, r.rangeFilter, metricType, Radius, radius)
+		}
+		return r, nil
+	case L2, HAMMING, TANIMOTO:
+		if radius <= 0 {
+			return nil, fmt.Errorf("%s of %s should be positive, but got %v", Radius, metricType, radius)
+		}
+	case JACCARD:
+		// the jaccard distances are in [0, 1]
+		if radius <= 0 || radius > 1 {
+			return nil, fmt.Errorf("%s of %s should be in range (0, 1], but got %v", Radius, metricType, radius)
+		}
+	default:
+		return nil, fmt.Errorf("range search is not supported with metric type %s", metricType)
+	}
+	if r.hasRangeFilter && (r.rangeFilter < 0 || r.rangeFilter >= radius) {
+		return nil, fmt.Errorf("%s of %s should be in range [0, %v), but got %v", RangeFilter, metricType, radius, r.rangeFilter)
+	}
+	return r, nil
+}
+
+func parseFloatSearchParam(key string, value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case int64:
+		return float64(v), nil
+	}
+	return 0, fmt.Errorf("invalid %s %v, should be a number", key, value)
+}
+
+// Contains returns whether the result with the score is in the range. The score is the similarity for IP, and the
+// negated distance for the distance metrics, as segcore returns them, so that the greater scores rank higher. The
+// scores are compared in the precision of float32, the one of the distances.
+func (r *SearchRange) Contains(score float32) bool {
+	if r.Beyond(score) {
+		return false
+	}
+	if !r.hasRangeFilter {
+		return true
+	}
+	if r.positivelyRelated {
+		return score <= float32(r.rangeFilter)
+	}
+	return -score >= float32(r.rangeFilter)
+}
+
+// Beyond returns whether the result with the score is not within the radius, so are the results ranking lower
+func (r *SearchRange) Beyond(score float32) bool {
+	if r.positivelyRelated {
+		return score <= float32(r.radius)
+	}
+	return -score >= float32(r.radius)
+}
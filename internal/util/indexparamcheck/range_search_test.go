@@ -0,0 +1,88 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexparamcheck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSearchRange(t *testing.T) {
+	cases := []struct {
+		metricType   string
+		searchParams map[string]interface{}
+		valid        bool
+	}{
+		{L2, map[string]interface{}{Radius: 1.5}, true},
+		{L2, map[string]interface{}{Radius: 1.5, RangeFilter: 0.5}, true},
+		{L2, map[string]interface{}{Radius: float64(0)}, false},
+		{L2, map[string]interface{}{Radius: 1.5, RangeFilter: 1.5}, false},
+		{L2, map[string]interface{}{RangeFilter: 0.5}, false},
+		{L2, map[string]interface{}{Radius: "1.5"}, false},
+		{IP, map[string]interface{}{Radius: -0.5, RangeFilter: 0.8}, true},
+		{IP, map[string]interface{}{Radius: 0.8, RangeFilter: 0.5}, false},
+		{HAMMING, map[string]interface{}{Radius: int64(3)}, true},
+		{HAMMING, map[string]interface{}{Radius: float64(3), RangeFilter: float64(1)}, true},
+		{HAMMING, map[string]interface{}{Radius: float64(-1)}, false},
+		{HAMMING, map[string]interface{}{Radius: float64(3), RangeFilter: float64(-1)}, false},
+		{"jaccard", map[string]interface{}{Radius: float64(1)}, true},
+		{JACCARD, map[string]interface{}{Radius: 0.6, RangeFilter: 0.2}, true},
+		{JACCARD, map[string]interface{}{Radius: 1.2}, false},
+		{TANIMOTO, map[string]interface{}{Radius: 2.5}, true},
+		{SUBSTRUCTURE, map[string]interface{}{Radius: float64(1)}, false},
+		{SUPERSTRUCTURE, map[string]interface{}{Radius: float64(1)}, false},
+	}
+	for _, c := range cases {
+		r, err := ParseSearchRange(c.metricType, c.searchParams)
+		assert.Equal(t, c.valid, err == nil, "%s %v", c.metricType, c.searchParams)
+		assert.Equal(t, c.valid, r != nil, "%s %v", c.metricType, c.searchParams)
+	}
+
+	r, err := ParseSearchRange(SUBSTRUCTURE, map[string]interface{}{NPROBE: float64(16)})
+	assert.NoError(t, err)
+	assert.Nil(t, r)
+}
+
+func TestSearchRange(t *testing.T) {
+	// the scores of the distance metrics are the negated distances
+	r, err := ParseSearchRange(HAMMING, map[string]interface{}{Radius: float64(3), RangeFilter: float64(1)})
+	assert.NoError(t, err)
+	assert.False(t, r.Contains(0))
+	assert.False(t, r.Beyond(0))
+	assert.True(t, r.Contains(-1))
+	assert.True(t, r.Contains(-2))
+	assert.False(t, r.Contains(-3))
+	assert.True(t, r.Beyond(-3))
+	assert.True(t, r.Beyond(-4))
+
+	r, err = ParseSearchRange(JACCARD, map[string]interface{}{Radius: 0.5})
+	assert.NoError(t, err)
+	assert.True(t, r.Contains(0))
+	assert.True(t, r.Contains(-0.4))
+	assert.False(t, r.Contains(-0.5))
+	assert.True(t, r.Beyond(-0.5))
+
+	r, err = ParseSearchRange(IP, map[string]interface{}{Radius: 0.2, RangeFilter: 0.8})
+	assert.NoError(t, err)
+	assert.False(t, r.Contains(0.9))
+	assert.False(t, r.Beyond(0.9))
+	assert.True(t, r.Contains(0.8))
+	assert.True(t, r.Contains(0.5))
+	assert.False(t, r.Contains(0.2))
+	assert.True(t, r.Beyond(0.2))
+}
@@ -1,8 +1,46 @@
 package indexparamcheck
 
-import "github.com/milvus-io/milvus/internal/proto/schemapb"
+import (
+	"fmt"
 
-// TODO: check index parameters according to the index type & data type.
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// DefaultScalarIndexType returns the index type built on the scalar field when it's not specified
+func DefaultScalarIndexType(dType schemapb.DataType) IndexType {
+	if typeutil.IsStringType(dType) {
+		return IndexINVERTED
+	}
+	return IndexSTLSORT
+}
+
+// CheckIndexValid checks the index type is supported on the scalar data type. Indexnode builds the sorted
+// index on numerics and the inverted index, a marisa trie, on strings, which querynode looks up instead of scanning
+// the whole column when filtering sealed segments. The unknown index types, which were accepted and replaced by the
+// default one before, are still accepted with a warning.
 func CheckIndexValid(dType schemapb.DataType, indexType IndexType, indexParams map[string]string) error {
-	return nil
+	switch {
+	case typeutil.IsBoolType(dType) || typeutil.IsIntegerType(dType) || typeutil.IsFloatingType(dType):
+		if indexType == IndexSTLSORT {
+			return nil
+		}
+	case typeutil.IsStringType(dType):
+		if indexType == IndexINVERTED || indexType == IndexTrie {
+			return nil
+		}
+	default:
+		return fmt.Errorf("scalar index is not supported on data type %s", dType.String())
+	}
+	if !IsScalarIndexType(indexType) {
+		log.Warn("unknown scalar index type, the default one is built instead",
+			zap.String("indexType", indexType),
+			zap.String("dataType", dType.String()),
+			zap.String("defaultIndexType", DefaultScalarIndexType(dType)))
+		return nil
+	}
+	return fmt.Errorf("index type %s is not supported on data type %s", indexType, dType.String())
 }
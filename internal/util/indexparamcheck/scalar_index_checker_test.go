@@ -8,5 +8,24 @@ import (
 )
 
 func TestCheckIndexValid(t *testing.T) {
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_Int64, IndexSTLSORT, nil))
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_Double, IndexSTLSORT, nil))
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_Bool, IndexSTLSORT, nil))
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_VarChar, IndexINVERTED, nil))
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_VarChar, IndexTrie, nil))
+	// unknown index types are replaced by the default one
 	assert.NoError(t, CheckIndexValid(schemapb.DataType_Int64, "inverted_index", nil))
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_VarChar, "scalar", nil))
+
+	assert.Error(t, CheckIndexValid(schemapb.DataType_Int64, IndexINVERTED, nil))
+	assert.Error(t, CheckIndexValid(schemapb.DataType_VarChar, IndexSTLSORT, nil))
+	assert.Error(t, CheckIndexValid(schemapb.DataType_FloatVector, IndexSTLSORT, nil))
+}
+
+func TestDefaultScalarIndexType(t *testing.T) {
+	assert.Equal(t, IndexSTLSORT, DefaultScalarIndexType(schemapb.DataType_Int32))
+	assert.Equal(t, IndexINVERTED, DefaultScalarIndexType(schemapb.DataType_VarChar))
+	for _, dType := range []schemapb.DataType{schemapb.DataType_Bool, schemapb.DataType_Float, schemapb.DataType_String} {
+		assert.NoError(t, CheckIndexValid(dType, DefaultScalarIndexType(dType), nil))
+	}
 }
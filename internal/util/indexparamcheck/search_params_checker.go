@@ -0,0 +1,98 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexparamcheck
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+const (
+	// NPROBE is the number of the clusters to search in Index IVFxxx, at most the nlist of the index
+	NPROBE = "nprobe"
+	// SearchK is the number of the nodes to inspect in Index ANNOY, -1 means n_trees * top k
+	SearchK = "search_k"
+
+	// MinNProbe is the lower limit of nprobe
+	MinNProbe = 1
+	// DefaultSearchK means the search_k is decided by knowhere
+	DefaultSearchK = -1
+)
+
+// IsHNSWIndexType returns whether the searches on the index type take ef
+func IsHNSWIndexType(indexType IndexType) bool {
+	return indexType == IndexHNSW || indexType == IndexRHNSWFlat || indexType == IndexRHNSWPQ || indexType == IndexRHNSWSQ
+}
+
+// CheckSearchParams validates the search params of a search with top k on the index built with the index params,
+// which override the ones fixed at index build time. The params not taken by the index type are ignored.
+func CheckSearchParams(indexParams map[string]string, searchParams map[string]interface{}, topK int64) error {
+	indexType := indexParams["index_type"]
+	switch indexType {
+	case IndexFaissIvfFlat, IndexFaissIvfPQ, IndexFaissIvfSQ8, IndexFaissIvfSQ8H, IndexFaissBinIvfFlat:
+		value, ok := searchParams[NPROBE]
+		if !ok {
+			return nil
+		}
+		maxNProbe := int64(MaxNList)
+		if nlist, err := strconv.ParseInt(indexParams[NLIST], 10, 64); err == nil && nlist > 0 {
+			maxNProbe = nlist
+		}
+		return checkSearchParamRange(indexType, NPROBE, value, MinNProbe, maxNProbe)
+	case IndexHNSW, IndexRHNSWFlat, IndexRHNSWPQ, IndexRHNSWSQ:
+		value, ok := searchParams[HNSWEf]
+		if !ok {
+			return nil
+		}
+		return checkSearchParamRange(indexType, HNSWEf, value, topK, HNSWMaxEf)
+	case IndexANNOY:
+		value, ok := searchParams[SearchK]
+		if !ok {
+			return nil
+		}
+		if searchK, err := ParseIntSearchParam(SearchK, value); err == nil && searchK == DefaultSearchK {
+			return nil
+		}
+		return checkSearchParamRange(indexType, SearchK, value, topK, math.MaxInt64)
+	}
+	return nil
+}
+
+func checkSearchParamRange(indexType IndexType, key string, value interface{}, min, max int64) error {
+	v, err := ParseIntSearchParam(key, value)
+	if err != nil {
+		return err
+	}
+	if v < min || v > max {
+		return fmt.Errorf("%s of %s should be in range [%d, %d], but got %d", key, indexType, min, max, v)
+	}
+	return nil
+}
+
+// ParseIntSearchParam returns the integer search param decoded from JSON, or derived by AutoSearchParams
+func ParseIntSearchParam(key string, value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case float64:
+		if v == float64(int64(v)) {
+			return int64(v), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid %s %v, should be an integer", key, value)
+}
@@ -0,0 +1,60 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexparamcheck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckSearchParams(t *testing.T) {
+	ivf := map[string]string{"index_type": IndexFaissIvfFlat, NLIST: "128"}
+	hnsw := map[string]string{"index_type": IndexRHNSWFlat}
+	annoy := map[string]string{"index_type": IndexANNOY}
+	cases := []struct {
+		indexParams  map[string]string
+		searchParams map[string]interface{}
+		valid        bool
+	}{
+		{ivf, map[string]interface{}{NPROBE: float64(16)}, true},
+		{ivf, map[string]interface{}{NPROBE: float64(128)}, true},
+		{ivf, map[string]interface{}{}, true},
+		{ivf, map[string]interface{}{NPROBE: int64(16)}, true},
+		{ivf, map[string]interface{}{NPROBE: float64(129)}, false},
+		{ivf, map[string]interface{}{NPROBE: float64(0)}, false},
+		{ivf, map[string]interface{}{NPROBE: "16"}, false},
+		{map[string]string{"index_type": IndexFaissIvfPQ}, map[string]interface{}{NPROBE: float64(MaxNList)}, true},
+		{hnsw, map[string]interface{}{HNSWEf: float64(10)}, true},
+		{hnsw, map[string]interface{}{HNSWEf: float64(9)}, false},
+		{hnsw, map[string]interface{}{HNSWEf: float64(HNSWMaxEf + 1)}, false},
+		{hnsw, map[string]interface{}{HNSWEf: 16.5}, false},
+		{annoy, map[string]interface{}{SearchK: float64(DefaultSearchK)}, true},
+		{annoy, map[string]interface{}{SearchK: float64(100)}, true},
+		{annoy, map[string]interface{}{SearchK: float64(5)}, false},
+		{map[string]string{"index_type": IndexFaissIDMap}, map[string]interface{}{NPROBE: "x"}, true},
+		{nil, map[string]interface{}{HNSWEf: float64(1)}, true},
+	}
+	for _, c := range cases {
+		err := CheckSearchParams(c.indexParams, c.searchParams, 10)
+		assert.Equal(t, c.valid, err == nil, "%v %v", c.indexParams, c.searchParams)
+	}
+	assert.True(t, IsHNSWIndexType(IndexHNSW))
+	assert.False(t, IsHNSWIndexType(IndexANNOY))
+	assert.True(t, IsGPUIndexType(IndexFaissIvfSQ8))
+	assert.False(t, IsGPUIndexType(IndexHNSW))
+}
@@ -12,14 +12,32 @@
 package metricsinfo
 
 import (
+	"bufio"
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/containerd/cgroups"
 )
 
+const (
+	// cgroupV2Root is the mount point of the cgroup v2 unified hierarchy
+	cgroupV2Root = "/sys/fs/cgroup"
+	// cgroupV2Unlimited is the content of memory.max without limit
+	cgroupV2Unlimited = "max"
+)
+
 // inContainer checks if the service is running inside a container.
 func inContainer() (bool, error) {
+	if cgroups.Mode() == cgroups.Unified {
+		// there is no devices controller path in cgroup v2, the service is treated as in a container
+		// once the memory of its cgroup is limited.
+		_, err := getCgroupV2MemLimit(getCgroupV2Dir())
+		return err == nil, nil
+	}
 	paths, err := cgroups.ParseCgroupFile("/proc/1/cgroup")
 	if err != nil {
 		return false, err
@@ -30,6 +48,9 @@ func inContainer() (bool, error) {
 
 // getContainerMemLimit returns memory limit and error
 func getContainerMemLimit() (uint64, error) {
+	if cgroups.Mode() == cgroups.Unified {
+		return getCgroupV2MemLimit(getCgroupV2Dir())
+	}
 	control, err := cgroups.Load(cgroups.V1, cgroups.RootPath)
 	if err != nil {
 		return 0, err
@@ -46,6 +67,9 @@ func getContainerMemLimit() (uint64, error) {
 
 // getContainerMemUsed returns memory usage and error
 func getContainerMemUsed() (uint64, error) {
+	if cgroups.Mode() == cgroups.Unified {
+		return getCgroupV2MemUsed(getCgroupV2Dir())
+	}
 	control, err := cgroups.Load(cgroups.V1, cgroups.RootPath)
 	if err != nil {
 		return 0, err
@@ -65,3 +89,76 @@ func getContainerMemUsed() (uint64, error) {
 	}
 	return usage, nil
 }
+
+// getCgroupV2Dir returns the cgroup v2 directory of the current process.
+// The cgroup path in /proc/self/cgroup is "/" with cgroup namespace, and the full path of the host otherwise.
+func getCgroupV2Dir() string {
+	f, err := os.Open("/proc/self/cgroup")
+	if err != nil {
+		return cgroupV2Root
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		// the only entry of cgroup v2 is "0::$PATH"
+		if path := strings.TrimPrefix(s.Text(), "0::"); path != s.Text() {
+			dir := filepath.Join(cgroupV2Root, path)
+			if _, err := os.Stat(filepath.Join(dir, "memory.max")); err == nil {
+				return dir
+			}
+			break
+		}
+	}
+	return cgroupV2Root
+}
+
+func readCgroupFile(dir, file string) (string, error) {
+	content, err := ioutil.ReadFile(filepath.Join(dir, file))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+// getCgroupV2MemLimit returns the memory limit of the cgroup v2 directory, error if not limited
+func getCgroupV2MemLimit(dir string) (uint64, error) {
+	content, err := readCgroupFile(dir, "memory.max")
+	if err != nil {
+		return 0, err
+	}
+	if content == cgroupV2Unlimited {
+		return 0, errors.New("memory of cgroup is not limited")
+	}
+	return strconv.ParseUint(content, 10, 64)
+}
+
+// getCgroupV2MemUsed returns the memory usage of the cgroup v2 directory, excluding the inactive file cache
+func getCgroupV2MemUsed(dir string) (uint64, error) {
+	content, err := readCgroupFile(dir, "memory.current")
+	if err != nil {
+		return 0, err
+	}
+	usage, err := strconv.ParseUint(content, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	stat, err := readCgroupFile(dir, "memory.stat")
+	if err != nil {
+		return 0, err
+	}
+	for _, line := range strings.Split(stat, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 || fields[0] != "inactive_file" {
+			continue
+		}
+		inactiveFile, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		if inactiveFile < usage {
+			return usage - inactiveFile, nil
+		}
+		break
+	}
+	return usage, nil
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package metricsinfo
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCgroupV2Memory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cgroup_v2")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeFile := func(file, content string) {
+		err := ioutil.WriteFile(filepath.Join(dir, file), []byte(content), 0644)
+		assert.NoError(t, err)
+	}
+
+	// no cgroup files
+	_, err = getCgroupV2MemLimit(dir)
+	assert.Error(t, err)
+	_, err = getCgroupV2MemUsed(dir)
+	assert.Error(t, err)
+
+	writeFile("memory.max", "max\n")
+	_, err = getCgroupV2MemLimit(dir)
+	assert.Error(t, err)
+
+	writeFile("memory.max", "4294967296\n")
+	limit, err := getCgroupV2MemLimit(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4294967296), limit)
+
+	writeFile("memory.current", "1073741824\n")
+	writeFile("memory.stat", "anon 536870912\nfile 536870912\ninactive_file 268435456\n")
+	used, err := getCgroupV2MemUsed(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1073741824-268435456), used)
+
+	writeFile("memory.stat", "anon 536870912\n")
+	used, err = getCgroupV2MemUsed(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1073741824), used)
+}
@@ -14,6 +14,8 @@ package metricsinfo
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
@@ -25,6 +27,48 @@ const (
 
 	// SystemInfoMetrics means users request for system information metrics.
 	SystemInfoMetrics = "system_info"
+
+	// CollectionLifecycleMetrics means users request for the load/release history of collections.
+	CollectionLifecycleMetrics = "collection_lifecycle"
+
+	// NodeLoadMetrics means shard leaders request for the load of query nodes to route the sub-search requests.
+	NodeLoadMetrics = "node_load"
+
+	// ShardClustersMetrics means users request for the state of the shard clusters led by query nodes.
+	ShardClustersMetrics = "shard_clusters"
+
+	// SegmentEventsMetrics means users request for the lifecycle events of the segments on a query node.
+	SegmentEventsMetrics = "segment_events"
+
+	// ShardStatsMetrics means users request for the statistics of the searches and queries per shard on a query node.
+	ShardStatsMetrics = "shard_stats"
+
+	// ImportTasksMetrics means users request for the progress and the failures of the import tasks,
+	// or of a single one if task_id is specified.
+	ImportTasksMetrics = "import_tasks"
+
+	// IndexBuildTasksMetrics means users request for the per-segment progress of the index builds,
+	// optionally of the collection or the index specified by collection_id or index_id.
+	IndexBuildTasksMetrics = "index_build_tasks"
+
+	// ConsumerLagsMetrics means users request for the lags of the msgstream consumers on a query node or a data node.
+	ConsumerLagsMetrics = "consumer_lags"
+
+	// SegmentIDKey is the optional key of the segment to filter the segment events in GetMetrics request.
+	SegmentIDKey = "segment_id"
+
+	// CollectionIDKey is the key of the collection in GetMetrics request.
+	CollectionIDKey = "collection_id"
+
+	// TaskIDKey is the key of the import task to get the progress of in GetMetrics request.
+	TaskIDKey = "task_id"
+
+	// CollectionNameKey is the optional key of the collection in GetMetrics request, which proxy authorizes the
+	// request on.
+	CollectionNameKey = "collection_name"
+
+	// IndexIDKey is the key of the index to filter the index builds of in GetMetrics request.
+	IndexIDKey = "index_id"
 )
 
 // ParseMetricType returns the metric type of req
@@ -41,6 +85,72 @@ func ParseMetricType(req string) (string, error) {
 	return metricType.(string), nil
 }
 
+// ParseSegmentID returns the segment id in req, 0 if not specified
+func ParseSegmentID(req string) (int64, error) {
+	return parseID(req, SegmentIDKey)
+}
+
+// ParseCollectionID returns the collection id in req, 0 if not specified
+func ParseCollectionID(req string) (int64, error) {
+	return parseID(req, CollectionIDKey)
+}
+
+// ParseTaskID returns the import task id in req, 0 if not specified
+func ParseTaskID(req string) (int64, error) {
+	return parseID(req, TaskIDKey)
+}
+
+// ParseIndexID returns the index id in req, 0 if not specified
+func ParseIndexID(req string) (int64, error) {
+	return parseID(req, IndexIDKey)
+}
+
+// ParseCollectionName returns the collection name in req, empty if not specified
+func ParseCollectionName(req string) (string, error) {
+	return parseString(req, CollectionNameKey)
+}
+
+// parseID returns the id of key in req, 0 if not specified. The numbers are decoded as json.Number,
+// as the ids allocated by the timestamp oracle are beyond the precision of float64
+func parseID(req string, key string) (int64, error) {
+	m := make(map[string]interface{})
+	decoder := json.NewDecoder(strings.NewReader(req))
+	decoder.UseNumber()
+	if err := decoder.Decode(&m); err != nil {
+		return 0, fmt.Errorf("failed to decode the request: %s", err.Error())
+	}
+	value, exist := m[key]
+	if !exist {
+		return 0, nil
+	}
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("invalid %s: %v", key, value)
+	}
+	i, err := strconv.ParseInt(number.String(), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %v", key, value)
+	}
+	return i, nil
+}
+
+func parseString(req string, key string) (string, error) {
+	m := make(map[string]interface{})
+	err := json.Unmarshal([]byte(req), &m)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode the request: %s", err.Error())
+	}
+	value, exist := m[key]
+	if !exist {
+		return "", nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid %s: %v", key, value)
+	}
+	return str, nil
+}
+
 // ConstructRequestByMetricType constructs a request according to the metric type
 func ConstructRequestByMetricType(metricType string) (*milvuspb.GetMetricsRequest, error) {
 	m := make(map[string]interface{})
@@ -61,6 +61,109 @@ func Test_ParseMetricType(t *testing.T) {
 
 }
 
+func Test_ParseSegmentID(t *testing.T) {
+	cases := []struct {
+		s        string
+		want     int64
+		errIsNil bool
+	}{
+		{"not in json format", 0, false},
+		{`{"metric_type":"segment_events"}`, 0, true},
+		{`{"metric_type":"segment_events","segment_id":100}`, 100, true},
+		{`{"metric_type":"segment_events","segment_id":434849384094744577}`, 434849384094744577, true},
+		{`{"metric_type":"segment_events","segment_id":1e3}`, 0, false},
+		{`{"metric_type":"segment_events","segment_id":"100"}`, 0, false},
+	}
+
+	for _, test := range cases {
+		got, err := ParseSegmentID(test.s)
+		assert.Equal(t, test.errIsNil, err == nil)
+		assert.Equal(t, test.want, got)
+	}
+}
+
+func Test_ParseTaskID(t *testing.T) {
+	cases := []struct {
+		s        string
+		want     int64
+		errIsNil bool
+	}{
+		{"not in json format", 0, false},
+		{`{"metric_type":"import_tasks"}`, 0, true},
+		{`{"metric_type":"import_tasks","task_id":100}`, 100, true},
+		{`{"metric_type":"import_tasks","task_id":434849384094744577}`, 434849384094744577, true},
+		{`{"metric_type":"import_tasks","task_id":1e3}`, 0, false},
+		{`{"metric_type":"import_tasks","task_id":"100"}`, 0, false},
+	}
+
+	for _, test := range cases {
+		got, err := ParseTaskID(test.s)
+		assert.Equal(t, test.errIsNil, err == nil)
+		assert.Equal(t, test.want, got)
+	}
+}
+
+func Test_ParseIndexID(t *testing.T) {
+	cases := []struct {
+		s        string
+		want     int64
+		errIsNil bool
+	}{
+		{"not in json format", 0, false},
+		{`{"metric_type":"index_build_tasks"}`, 0, true},
+		{`{"metric_type":"index_build_tasks","index_id":10}`, 10, true},
+		{`{"metric_type":"index_build_tasks","index_id":434849384094744577}`, 434849384094744577, true},
+		{`{"metric_type":"index_build_tasks","index_id":1e3}`, 0, false},
+		{`{"metric_type":"index_build_tasks","index_id":"100"}`, 0, false},
+	}
+
+	for _, test := range cases {
+		got, err := ParseIndexID(test.s)
+		assert.Equal(t, test.errIsNil, err == nil)
+		assert.Equal(t, test.want, got)
+	}
+}
+
+func Test_ParseCollectionID(t *testing.T) {
+	cases := []struct {
+		s        string
+		want     int64
+		errIsNil bool
+	}{
+		{"not in json format", 0, false},
+		{`{"metric_type":"index_build_tasks"}`, 0, true},
+		{`{"metric_type":"index_build_tasks","collection_id":100}`, 100, true},
+		{`{"metric_type":"index_build_tasks","collection_id":434849384094744577}`, 434849384094744577, true},
+		{`{"metric_type":"index_build_tasks","collection_id":1e3}`, 0, false},
+		{`{"metric_type":"index_build_tasks","collection_id":"100"}`, 0, false},
+	}
+
+	for _, test := range cases {
+		got, err := ParseCollectionID(test.s)
+		assert.Equal(t, test.errIsNil, err == nil)
+		assert.Equal(t, test.want, got)
+	}
+}
+
+func Test_ParseCollectionName(t *testing.T) {
+	cases := []struct {
+		s        string
+		want     string
+		errIsNil bool
+	}{
+		{"not in json format", "", false},
+		{`{"metric_type":"load_fields"}`, "", true},
+		{`{"metric_type":"load_fields","collection_name":"coll"}`, "coll", true},
+		{`{"metric_type":"load_fields","collection_name":1}`, "", false},
+	}
+
+	for _, test := range cases {
+		got, err := ParseCollectionName(test.s)
+		assert.Equal(t, test.errIsNil, err == nil)
+		assert.Equal(t, test.want, got)
+	}
+}
+
 func Test_ConstructRequestByMetricType(t *testing.T) {
 	cases := []struct {
 		metricType string
@@ -101,12 +101,18 @@ type QueryNodeConfiguration struct {
 	RetrieveResultReceiveBufSize int64 `json:"retrieve_result_receive_buf_size"`
 
 	SimdType string `json:"simd_type"`
+
+	// Zone is the failure domain label of QueryNode
+	Zone string `json:"zone,omitempty"`
 }
 
 // QueryNodeInfos implements ComponentInfos
 type QueryNodeInfos struct {
 	BaseComponentInfos
 	SystemConfigurations QueryNodeConfiguration `json:"system_configurations"`
+	// DiskCacheUsage and DiskCacheCapacity are the bytes used and limited of the local vector cache
+	DiskCacheUsage    int64 `json:"disk_cache_usage,omitempty"`
+	DiskCacheCapacity int64 `json:"disk_cache_capacity,omitempty"`
 }
 
 // QueryCoordConfiguration records the configuration of QueryCoord.
@@ -189,3 +195,161 @@ type RootCoordInfos struct {
 	BaseComponentInfos
 	SystemConfigurations RootCoordConfiguration `json:"system_configurations"`
 }
+
+// CollectionLifecycle records the recent load/release events of a collection in QueryCoord.
+type CollectionLifecycle struct {
+	CollectionID        int64  `json:"collection_id"`
+	LastLoadTime        string `json:"last_load_time,omitempty"`
+	LastReleaseTime     string `json:"last_release_time,omitempty"`
+	LastFailedOperation string `json:"last_failed_operation,omitempty"`
+	LastFailureTime     string `json:"last_failure_time,omitempty"`
+	LastError           string `json:"last_error,omitempty"`
+}
+
+// QueryNodeLoad is the load reported by a query node, used to route the sub-search requests among the replicas.
+type QueryNodeLoad struct {
+	NodeID int64 `json:"node_id"`
+	// CPUUsage is the cpu usage in percentage
+	CPUUsage float64 `json:"cpu_usage"`
+	// QueueDepth is the number of in-flight search/query requests
+	QueueDepth int64 `json:"queue_depth"`
+	// QPS is the number of search/query requests per second averaged over the last seconds
+	QPS float64 `json:"qps"`
+	// Zone is the failure domain label of the query node
+	Zone string `json:"zone,omitempty"`
+	// ServiceableTimes is the serviceable timestamp of the sealed segments per DML vchannel
+	ServiceableTimes map[string]uint64 `json:"serviceable_times,omitempty"`
+}
+
+// LatencyBucket is the number of requests whose latency falls in the bucket.
+type LatencyBucket struct {
+	// UpperBoundMs is the upper bound of the latency in milliseconds, 0 for the bucket without bound
+	UpperBoundMs int64 `json:"upper_bound_ms"`
+	Count        int64 `json:"count"`
+}
+
+// QueryShardStats is the statistics of the searches and queries served by a query shard on a query node.
+type QueryShardStats struct {
+	CollectionID int64  `json:"collection_id"`
+	Channel      string `json:"channel"`
+	SearchCount  int64  `json:"search_count"`
+	QueryCount   int64  `json:"query_count"`
+	FailedCount  int64  `json:"failed_count"`
+	// QPS is the number of searches and queries per second averaged over the last seconds
+	QPS            float64         `json:"qps"`
+	AvgLatencyMs   float64         `json:"avg_latency_ms"`
+	MaxLatencyMs   float64         `json:"max_latency_ms"`
+	LatencyBuckets []LatencyBucket `json:"latency_buckets"`
+	// RowsScanned is the number of rows of the segments scanned on the node
+	RowsScanned int64 `json:"rows_scanned"`
+	// CacheHitRate is the hit rate of the local vector cache the query results are filled with
+	CacheHitRate float64 `json:"cache_hit_rate"`
+	AvgReduceMs  float64 `json:"avg_reduce_ms"`
+}
+
+// ShardClusterNode is a member node of a shard cluster.
+type ShardClusterNode struct {
+	NodeID  int64  `json:"node_id"`
+	Address string `json:"address"`
+	// CircuitBreaker is the state of the circuit breaker of the node, closed, open or half_open
+	CircuitBreaker string `json:"circuit_breaker,omitempty"`
+}
+
+// ShardClusterSegment is the distribution of a sealed segment in a shard cluster.
+type ShardClusterSegment struct {
+	SegmentID   int64  `json:"segment_id"`
+	PartitionID int64  `json:"partition_id"`
+	NodeID      int64  `json:"node_id"`
+	State       string `json:"state"`
+	InUse       int32  `json:"in_use"`
+}
+
+// ShardClusterInfo is the state of a shard cluster maintained by the shard leader in QueryNode.
+type ShardClusterInfo struct {
+	CollectionID int64  `json:"collection_id"`
+	ReplicaID    int64  `json:"replica_id"`
+	VChannel     string `json:"vchannel"`
+	LeaderID     int64  `json:"leader_id"`
+	State        string `json:"state"`
+	// UnavailableReason explains why the shard cluster is not available
+	UnavailableReason string                `json:"unavailable_reason,omitempty"`
+	Nodes             []ShardClusterNode    `json:"nodes"`
+	Segments          []ShardClusterSegment `json:"segments"`
+	PendingHandoffs   int                   `json:"pending_handoffs"`
+}
+
+// ShardClusterTopology is the shard clusters collected from all query nodes by QueryCoord.
+type ShardClusterTopology struct {
+	ShardClusters []ShardClusterInfo `json:"shard_clusters"`
+	// Errors are the reasons of the query nodes failed to report
+	Errors []string `json:"errors,omitempty"`
+}
+
+// SegmentEvent is a lifecycle event of a segment on a query node, such as loaded, handed off or released.
+type SegmentEvent struct {
+	SegmentID    int64  `json:"segment_id"`
+	CollectionID int64  `json:"collection_id"`
+	PartitionID  int64  `json:"partition_id"`
+	Event        string `json:"event"`
+	Cause        string `json:"cause,omitempty"`
+	Time         string `json:"time"`
+}
+
+// ImportTaskProgress is the progress of an import task
+type ImportTaskProgress struct {
+	TaskID       int64    `json:"task_id"`
+	RequestID    int64    `json:"request_id"`
+	CollectionID int64    `json:"collection_id"`
+	PartitionID  int64    `json:"partition_id"`
+	DataNodeID   int64    `json:"datanode_id,omitempty"`
+	State        string   `json:"state"`
+	Files        []string `json:"files"`
+	FilesParsed  int      `json:"files_parsed"`
+	// RowsPersisted are the rows imported once the task is persisted, or the rows parsed before
+	RowsPersisted   int64 `json:"rows_persisted"`
+	Segments        int   `json:"segments"`
+	SegmentsIndexed int   `json:"segments_indexed"`
+	// FileErrors are the reasons of the files failed to import, keyed by the file paths
+	FileErrors   map[string]string `json:"file_errors,omitempty"`
+	FailedReason string            `json:"failed_reason,omitempty"`
+	Canceled     bool              `json:"canceled,omitempty"`
+}
+
+// IndexBuildTask is the progress of building the index of a segment
+type IndexBuildTask struct {
+	IndexBuildID int64  `json:"index_build_id"`
+	IndexID      int64  `json:"index_id"`
+	IndexName    string `json:"index_name"`
+	CollectionID int64  `json:"collection_id"`
+	SegmentID    int64  `json:"segment_id"`
+	NumRows      int64  `json:"num_rows"`
+	State        string `json:"state"`
+	// NodeID is the index node building the index, or built it
+	NodeID int64 `json:"node_id,omitempty"`
+	// Attempts is the number of times the build is assigned to index nodes
+	Attempts     int64  `json:"attempts"`
+	Prioritized  bool   `json:"prioritized,omitempty"`
+	FailedReason string `json:"failed_reason,omitempty"`
+	Canceled     bool   `json:"canceled,omitempty"`
+}
+
+// IndexBuildProgress is the progress of the index builds, the rows are summed over the segments
+type IndexBuildProgress struct {
+	Tasks       []IndexBuildTask `json:"tasks"`
+	Pending     int              `json:"pending"`
+	InProgress  int              `json:"in_progress"`
+	Finished    int              `json:"finished"`
+	Failed      int              `json:"failed"`
+	TotalRows   int64            `json:"total_rows"`
+	IndexedRows int64            `json:"indexed_rows"`
+}
+
+// ConsumerLag is how far a msgstream consumer of a node falls behind its channel
+type ConsumerLag struct {
+	Channel      string `json:"channel"`
+	Subscription string `json:"subscription"`
+	// MsgLag is the number of the messages not consumed yet, -1 if the mq can't tell it, such as pulsar and rocksmq
+	MsgLag int64 `json:"msg_lag"`
+	// TimeLagSeconds is the time elapsed since the timestamp of the last message consumed
+	TimeLagSeconds float64 `json:"time_lag_seconds"`
+}
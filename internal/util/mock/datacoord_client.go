@@ -130,3 +130,7 @@ func (m *DataCoordClient) Import(ctx context.Context, req *datapb.ImportTaskRequ
 func (m *DataCoordClient) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
+
+func (m *DataCoordClient) RefreshCollection(ctx context.Context, req *datapb.RefreshCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
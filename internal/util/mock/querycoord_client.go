@@ -97,3 +97,35 @@ func (m *QueryCoordClient) GetReplicas(ctx context.Context, in *milvuspb.GetRepl
 func (m *QueryCoordClient) GetShardLeaders(ctx context.Context, in *querypb.GetShardLeadersRequest, opts ...grpc.CallOption) (*querypb.GetShardLeadersResponse, error) {
 	return &querypb.GetShardLeadersResponse{}, m.Err
 }
+
+func (m *QueryCoordClient) SyncNewCreatedPartition(ctx context.Context, in *querypb.SyncNewCreatedPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *QueryCoordClient) RefreshCollection(ctx context.Context, in *querypb.RefreshCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *QueryCoordClient) GetLoadingProgress(ctx context.Context, in *querypb.GetLoadingProgressRequest, opts ...grpc.CallOption) (*milvuspb.GetLoadingProgressResponse, error) {
+	return &milvuspb.GetLoadingProgressResponse{}, m.Err
+}
+
+func (m *QueryCoordClient) GetReleaseJobs(ctx context.Context, in *querypb.GetReleaseJobsRequest, opts ...grpc.CallOption) (*milvuspb.GetReleaseJobsResponse, error) {
+	return &milvuspb.GetReleaseJobsResponse{}, m.Err
+}
+
+func (m *QueryCoordClient) CordonNode(ctx context.Context, in *milvuspb.CordonNodeRequest, opts ...grpc.CallOption) (*milvuspb.CordonedNodesResponse, error) {
+	return &milvuspb.CordonedNodesResponse{}, m.Err
+}
+
+func (m *QueryCoordClient) UncordonNode(ctx context.Context, in *milvuspb.UncordonNodeRequest, opts ...grpc.CallOption) (*milvuspb.CordonedNodesResponse, error) {
+	return &milvuspb.CordonedNodesResponse{}, m.Err
+}
+
+func (m *QueryCoordClient) GetCordonedNodes(ctx context.Context, in *milvuspb.GetCordonedNodesRequest, opts ...grpc.CallOption) (*milvuspb.CordonedNodesResponse, error) {
+	return &milvuspb.CordonedNodesResponse{}, m.Err
+}
+
+func (m *QueryCoordClient) Balance(ctx context.Context, in *milvuspb.BalanceRequest, opts ...grpc.CallOption) (*milvuspb.BalanceResponse, error) {
+	return &milvuspb.BalanceResponse{}, m.Err
+}
@@ -74,6 +74,10 @@ func (m *QueryNodeClient) ReleaseSegments(ctx context.Context, in *querypb.Relea
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *QueryNodeClient) ReleaseChannels(ctx context.Context, in *querypb.ReleaseChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *QueryNodeClient) GetSegmentInfo(ctx context.Context, in *querypb.GetSegmentInfoRequest, opts ...grpc.CallOption) (*querypb.GetSegmentInfoResponse, error) {
 	return &querypb.GetSegmentInfoResponse{}, m.Err
 }
@@ -90,6 +94,18 @@ func (m *QueryNodeClient) SyncReplicaSegments(ctx context.Context, in *querypb.S
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *QueryNodeClient) RefreshCollection(ctx context.Context, in *querypb.LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *QueryNodeClient) Drain(ctx context.Context, in *querypb.DrainRequest, opts ...grpc.CallOption) (*querypb.DrainResponse, error) {
+	return &querypb.DrainResponse{}, m.Err
+}
+
+func (m *QueryNodeClient) GetSegmentLoadingProgress(ctx context.Context, in *querypb.GetLoadingProgressRequest, opts ...grpc.CallOption) (*querypb.GetSegmentLoadingProgressResponse, error) {
+	return &querypb.GetSegmentLoadingProgressResponse{}, m.Err
+}
+
 func (m *QueryNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	return &milvuspb.GetMetricsResponse{}, m.Err
 }
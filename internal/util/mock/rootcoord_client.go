@@ -71,6 +71,34 @@ func (m *RootCoordClient) AlterAlias(ctx context.Context, in *milvuspb.AlterAlia
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *RootCoordClient) RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *RootCoordClient) AddCollectionField(ctx context.Context, in *milvuspb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *RootCoordClient) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *RootCoordClient) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *RootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *RootCoordClient) AlterDatabase(ctx context.Context, in *milvuspb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *RootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error) {
+	return &milvuspb.ListDatabasesResponse{}, m.Err
+}
+
 func (m *RootCoordClient) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error) {
 	return &milvuspb.ShowCollectionsResponse{}, m.Err
 }
@@ -151,6 +179,10 @@ func (m *RootCoordClient) ListImportTasks(ctx context.Context, req *milvuspb.Lis
 	return &milvuspb.ListImportTasksResponse{}, m.Err
 }
 
+func (m *RootCoordClient) CancelImport(ctx context.Context, req *milvuspb.CancelImportRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error) {
+	return &milvuspb.GetImportStateResponse{}, m.Err
+}
+
 func (m *RootCoordClient) ReportImport(ctx context.Context, req *rootcoordpb.ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
@@ -174,3 +206,31 @@ func (m *RootCoordClient) ListCredUsers(ctx context.Context, in *milvuspb.ListCr
 func (m *RootCoordClient) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialRequest, opts ...grpc.CallOption) (*rootcoordpb.GetCredentialResponse, error) {
 	return &rootcoordpb.GetCredentialResponse{}, m.Err
 }
+
+func (m *RootCoordClient) SaveRole(ctx context.Context, in *milvuspb.SaveRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *RootCoordClient) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *RootCoordClient) ListRoles(ctx context.Context, in *milvuspb.ListRolesRequest, opts ...grpc.CallOption) (*milvuspb.ListRolesResponse, error) {
+	return &milvuspb.ListRolesResponse{}, m.Err
+}
+
+func (m *RootCoordClient) SaveUserRoles(ctx context.Context, in *milvuspb.SaveUserRolesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *RootCoordClient) ListUserRoles(ctx context.Context, in *milvuspb.ListUserRolesRequest, opts ...grpc.CallOption) (*milvuspb.ListUserRolesResponse, error) {
+	return &milvuspb.ListUserRolesResponse{}, m.Err
+}
+
+func (m *RootCoordClient) CreateAPIKey(ctx context.Context, in *milvuspb.CreateAPIKeyRequest, opts ...grpc.CallOption) (*milvuspb.CreateAPIKeyResponse, error) {
+	return &milvuspb.CreateAPIKeyResponse{}, m.Err
+}
+
+func (m *RootCoordClient) RevokeAPIKey(ctx context.Context, in *milvuspb.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
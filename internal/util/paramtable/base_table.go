@@ -97,6 +97,11 @@ func (gp *BaseTable) GetConfigDir() string {
 	return gp.configDir
 }
 
+// GetConfigFile returns the path of the yaml file the param table is initialized with
+func (gp *BaseTable) GetConfigFile() string {
+	return gp.configDir + defaultYaml
+}
+
 // LoadFromKVPair saves given kv pair to paramtable
 func (gp *BaseTable) LoadFromKVPair(kvPairs []*commonpb.KeyValuePair) error {
 	for _, pair := range kvPairs {
@@ -180,17 +185,35 @@ func (gp *BaseTable) LoadRange(key, endKey string, limit int) ([]string, []strin
 }
 
 func (gp *BaseTable) LoadYaml(fileName string) error {
-	config := viper.New()
 	configFile := gp.configDir + fileName
 	if _, err := os.Stat(configFile); err != nil {
 		panic("cannot access config file: " + configFile)
 	}
 
+	kvs, err := ReadYaml(configFile)
+	if err != nil {
+		panic(err)
+	}
+	for key, value := range kvs {
+		err = gp.params.Save(key, value)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return nil
+}
+
+// ReadYaml reads the config file into flattened key-value pairs, the keys are lower-cased and
+// the list values are joined by comma.
+func ReadYaml(configFile string) (map[string]string, error) {
+	config := viper.New()
 	config.SetConfigFile(configFile)
 	if err := config.ReadInConfig(); err != nil {
-		panic(err)
+		return nil, err
 	}
 
+	kvs := make(map[string]string)
 	for _, key := range config.AllKeys() {
 		val := config.Get(key)
 		str, err := cast.ToStringE(val)
@@ -201,7 +224,7 @@ func (gp *BaseTable) LoadYaml(fileName string) error {
 				for _, v := range val {
 					ss, err := cast.ToStringE(v)
 					if err != nil {
-						panic(err)
+						return nil, err
 					}
 					if str == "" {
 						str = ss
@@ -211,17 +234,12 @@ func (gp *BaseTable) LoadYaml(fileName string) error {
 				}
 
 			default:
-				panic("undefined config type, key=" + key)
+				return nil, fmt.Errorf("undefined config type, key=%s", key)
 			}
 		}
-		err = gp.params.Save(strings.ToLower(key), str)
-		if err != nil {
-			panic(err)
-		}
-
+		kvs[strings.ToLower(key)] = str
 	}
-
-	return nil
+	return kvs, nil
 }
 
 func (gp *BaseTable) Get(key string) string {
@@ -431,6 +449,14 @@ func (gp *BaseTable) loadKafkaConfig() {
 	gp.Save("_KafkaBrokerList", brokerList)
 }
 
+func (gp *BaseTable) loadNatsConfig() {
+	natsAddress := os.Getenv("NATS_ADDRESS")
+	if natsAddress == "" {
+		natsAddress = gp.Get("nats.address")
+	}
+	gp.Save("_NatsAddress", natsAddress)
+}
+
 func (gp *BaseTable) loadPulsarConfig() {
 	pulsarAddress := os.Getenv("PULSAR_ADDRESS")
 	if pulsarAddress == "" {
@@ -438,7 +464,11 @@ func (gp *BaseTable) loadPulsarConfig() {
 		port := gp.Get("pulsar.port")
 
 		if len(pulsarHost) != 0 && len(port) != 0 {
-			pulsarAddress = "pulsar://" + pulsarHost + ":" + port
+			scheme := "pulsar://"
+			if gp.ParseBool("pulsar.tlsEnabled", false) {
+				scheme = "pulsar+ssl://"
+			}
+			pulsarAddress = scheme + pulsarHost + ":" + port
 		}
 	}
 
@@ -456,6 +486,7 @@ func (gp *BaseTable) loadRocksMQConfig() {
 func (gp *BaseTable) loadMQConfig() {
 	gp.loadPulsarConfig()
 	gp.loadKafkaConfig()
+	gp.loadNatsConfig()
 	gp.loadRocksMQConfig()
 }
 
@@ -509,6 +540,22 @@ func (gp *BaseTable) loadDataNodeConfig() {
 	gp.Save("_DATANODE_INSERTBUFSIZE", insertBufferFlushSize)
 }
 
+// LoadFromEnv returns the value of the key set by the environment variable with DefaultEnvPrefix, which overrides
+// the config file, the key is case-insensitive
+func (gp *BaseTable) LoadFromEnv(key string) (string, bool) {
+	for _, e := range os.Environ() {
+		parts := strings.SplitN(e, "=", 2)
+		if len(parts) != 2 || !strings.Contains(parts[0], DefaultEnvPrefix) {
+			continue
+		}
+		keyParts := strings.SplitAfterN(parts[0], ".", 2)
+		if len(keyParts) == 2 && strings.EqualFold(keyParts[1], key) {
+			return parts[1], true
+		}
+	}
+	return "", false
+}
+
 func (gp *BaseTable) loadOtherEnvs() {
 	// try to load environment start with ENV_PREFIX
 	for _, e := range os.Environ() {
@@ -157,6 +157,16 @@ func TestBaseTable_LoadYaml(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestReadYaml(t *testing.T) {
+	kvs, err := ReadYaml(baseParams.GetConfigFile())
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost:2379", kvs["etcd.endpoints"])
+	assert.Contains(t, kvs, "querynode.gracefultime")
+
+	_, err = ReadYaml(filepath.Join(baseParams.GetConfigDir(), "not_exist.yaml"))
+	assert.Error(t, err)
+}
+
 func TestBaseTable_ConfDir(t *testing.T) {
 	rightConfig := baseParams.configDir
 	// fake dir
@@ -12,15 +12,17 @@
 package paramtable
 
 import (
+	"fmt"
 	"math"
 	"os"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
-	"sync/atomic"
 	"time"
 
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -92,6 +94,10 @@ func (p *ComponentParam) KafkaEnable() bool {
 	return p.KafkaCfg.Address != ""
 }
 
+func (p *ComponentParam) NatsEnable() bool {
+	return p.NatsCfg.Address != ""
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // --- common ---
 type commonConfig struct {
@@ -129,6 +135,15 @@ type commonConfig struct {
 	StorageType    string
 
 	AuthorizationEnabled bool
+	RBACEnabled          bool
+
+	DebugServerEnabled bool
+	DebugServerPort    int
+	DebugServerDumpDir string
+
+	MsgStreamType              string
+	MsgStreamCompression       string
+	MsgStreamLagReportInterval time.Duration
 }
 
 func (p *commonConfig) init(base *BaseTable) {
@@ -166,6 +181,15 @@ func (p *commonConfig) init(base *BaseTable) {
 	p.initStorageType()
 
 	p.initEnableAuthorization()
+	p.initEnableRBAC()
+
+	p.initDebugServerEnabled()
+	p.initDebugServerPort()
+	p.initDebugServerDumpDir()
+
+	p.initMsgStreamType()
+	p.initMsgStreamCompression()
+	p.initMsgStreamLagReportInterval()
 }
 
 func (p *commonConfig) initClusterPrefix() {
@@ -353,6 +377,19 @@ func (p *commonConfig) initEntityExpiration() {
 	}
 }
 
+// GetEntityTTL returns the ttl of the entities of a collection, collectionTTL is the ttl set on the collection which
+// is zero if not set. The ttl of the collection overrides common.entityExpiration and is extended to the retention
+// duration the same way to ensure time travel works.
+func (p *commonConfig) GetEntityTTL(collectionTTL time.Duration) time.Duration {
+	if collectionTTL <= 0 {
+		return p.EntityExpirationTTL
+	}
+	if retention := time.Duration(p.RetentionDuration) * time.Second; collectionTTL < retention {
+		return retention
+	}
+	return collectionTTL
+}
+
 func (p *commonConfig) initSimdType() {
 	keys := []string{
 		"common.simdType",
@@ -373,6 +410,48 @@ func (p *commonConfig) initEnableAuthorization() {
 	p.AuthorizationEnabled = p.Base.ParseBool("common.security.authorizationEnabled", false)
 }
 
+func (p *commonConfig) initEnableRBAC() {
+	p.RBACEnabled = p.Base.ParseBool("common.security.rbacEnabled", false)
+}
+
+func (p *commonConfig) initDebugServerEnabled() {
+	p.DebugServerEnabled = p.Base.ParseBool("common.debugServer.enabled", false)
+}
+
+func (p *commonConfig) initDebugServerPort() {
+	p.DebugServerPort = p.Base.ParseIntWithDefault("common.debugServer.port", 9099)
+}
+
+func (p *commonConfig) initDebugServerDumpDir() {
+	p.DebugServerDumpDir = p.Base.LoadWithDefault("common.debugServer.dumpDir", "/tmp/milvus/dump")
+}
+
+// initMsgStreamType the name of the msgstream implementation registered to msgstream, if empty, it's picked by
+// the enabled mq configs.
+func (p *commonConfig) initMsgStreamType() {
+	p.MsgStreamType = strings.TrimSpace(p.Base.LoadWithDefault("common.msgStream.type", ""))
+}
+
+// initMsgStreamCompression the insert and delete message payloads are compressed by zstd or lz4 before producing,
+// and decompressed transparently by the consumers.
+func (p *commonConfig) initMsgStreamCompression() {
+	compression := strings.ToLower(p.Base.LoadWithDefault("common.msgStream.compression", "none"))
+	switch compression {
+	case "none", "":
+		p.MsgStreamCompression = ""
+	case "zstd", "lz4":
+		p.MsgStreamCompression = compression
+	default:
+		panic(fmt.Errorf("invalid common.msgStream.compression %s, should be none, zstd or lz4", compression))
+	}
+}
+
+// initMsgStreamLagReportInterval the lags of the msgstream consumers of querynode and datanode are reported
+// to prometheus in this interval.
+func (p *commonConfig) initMsgStreamLagReportInterval() {
+	p.MsgStreamLagReportInterval = time.Duration(p.Base.ParseInt64WithDefault("common.msgStream.lagReportInterval", 15000)) * time.Millisecond
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // --- rootcoord ---
 type rootCoordConfig struct {
@@ -390,6 +469,9 @@ type rootCoordConfig struct {
 	ImportSegmentStateWaitLimit     float64
 	ImportIndexCheckInterval        float64
 	ImportIndexWaitLimit            float64
+	// TimeTickCoalesceInterval is the minimal interval between the time ticks sent to a dml channel,
+	// the time ticks within it are coalesced into the later one, 0 means every time tick is sent
+	TimeTickCoalesceInterval time.Duration
 
 	// --- ETCD Path ---
 	ImportTaskSubPath string
@@ -409,6 +491,7 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 	p.ImportSegmentStateWaitLimit = p.Base.ParseFloatWithDefault("rootCoord.importSegmentStateWaitLimit", 60)
 	p.ImportIndexCheckInterval = p.Base.ParseFloatWithDefault("rootCoord.importIndexCheckInterval", 60*5)
 	p.ImportIndexWaitLimit = p.Base.ParseFloatWithDefault("rootCoord.importIndexWaitLimit", 60*20)
+	p.TimeTickCoalesceInterval = time.Duration(p.Base.ParseInt64WithDefault("rootCoord.timeTickCoalesceInterval", 0)) * time.Millisecond
 	p.ImportTaskSubPath = "importtask"
 }
 
@@ -435,6 +518,30 @@ type proxyConfig struct {
 	MaxShardNum              int32
 	MaxDimension             int64
 	GinLogging               bool
+	MaxInsertBatchSize       int64
+	MaxTopK                  int64
+	GrpcLimitWarnRatio       float64
+	PlanTemplateCacheSize    int64
+	MaxTemplateNum           int64
+	TemplateTTL              time.Duration
+	PartitionKeyNum          int64
+
+	// audit log of the searches and queries served
+	AuditLogEnabled bool
+	// AuditLogFile is the file the audit records are written to, it's {log.file.rootPath}/proxy-{nodeID}-audit.log if empty
+	AuditLogFile string
+	// AuditLogBufferSize is the number of the audit records buffered to be written, the records are dropped if the buffer is full
+	AuditLogBufferSize int
+
+	// quotas of the collections and the authenticated users, 0 means unlimited,
+	// the collection quotas could be overridden per collection at runtime
+	QuotaEnabled                  bool
+	CollectionDMLRowsPerSec       float64
+	CollectionSearchQPS           float64
+	CollectionMaxQueryConcurrency int64
+	UserDMLRowsPerSec             float64
+	UserSearchQPS                 float64
+	UserMaxQueryConcurrency       int64
 
 	// required from QueryCoord
 	SearchResultChannelNames   []string
@@ -462,6 +569,19 @@ func (p *proxyConfig) init(base *BaseTable) {
 
 	p.initMaxTaskNum()
 	p.initGinLogging()
+	p.initMaxInsertBatchSize()
+	p.initMaxTopK()
+	p.initGrpcLimitWarnRatio()
+	p.initPlanTemplateCacheSize()
+	p.initMaxTemplateNum()
+	p.initTemplateTTL()
+	p.initPartitionKeyNum()
+
+	p.initAuditLogEnabled()
+	p.initAuditLogFile()
+	p.initAuditLogBufferSize()
+
+	p.initQuota()
 }
 
 // InitAlias initialize Alias member.
@@ -550,6 +670,67 @@ func (p *proxyConfig) initGinLogging() {
 	p.GinLogging = p.Base.ParseBool("proxy.ginLogging", true)
 }
 
+// initMaxInsertBatchSize sets the default max number of rows per insert request, 0 means unlimited.
+// It could be overridden per collection at runtime.
+func (p *proxyConfig) initMaxInsertBatchSize() {
+	p.MaxInsertBatchSize = p.Base.ParseInt64WithDefault("proxy.maxInsertBatchSize", 0)
+}
+
+// initMaxTopK sets the default max topK of search request, it could be overridden per collection at runtime.
+func (p *proxyConfig) initMaxTopK() {
+	p.MaxTopK = p.Base.ParseInt64WithDefault("proxy.maxTopK", 16384)
+}
+
+func (p *proxyConfig) initGrpcLimitWarnRatio() {
+	p.GrpcLimitWarnRatio = p.Base.ParseFloatWithDefault("proxy.grpcLimitWarnRatio", 0.8)
+}
+
+// initPlanTemplateCacheSize sets the max number of compiled expression templates cached, 0 disables the cache.
+func (p *proxyConfig) initPlanTemplateCacheSize() {
+	p.PlanTemplateCacheSize = p.Base.ParseInt64WithDefault("proxy.planTemplateCacheSize", 1024)
+}
+
+// initMaxTemplateNum sets the max number of the registered templates cached by a proxy, the least recently used ones
+// are evicted beyond it and loaded from etcd again once executed.
+func (p *proxyConfig) initMaxTemplateNum() {
+	p.MaxTemplateNum = p.Base.ParseInt64WithDefault("proxy.maxTemplateNum", 1024)
+}
+
+// initTemplateTTL sets how long the registered templates are kept, 0 means they are kept until dropped.
+func (p *proxyConfig) initTemplateTTL() {
+	p.TemplateTTL = time.Duration(p.Base.ParseInt64WithDefault("proxy.templateTTL", 24)) * time.Hour
+}
+
+// initPartitionKeyNum sets the number of the implicit partitions of the collections with partition key,
+// unless specified by the partition key field.
+func (p *proxyConfig) initPartitionKeyNum() {
+	p.PartitionKeyNum = p.Base.ParseInt64WithDefault("proxy.partitionKeyNum", 16)
+}
+
+func (p *proxyConfig) initAuditLogEnabled() {
+	p.AuditLogEnabled = p.Base.ParseBool("proxy.auditLog.enabled", false)
+}
+
+func (p *proxyConfig) initAuditLogFile() {
+	p.AuditLogFile = p.Base.LoadWithDefault("proxy.auditLog.filename", "")
+}
+
+func (p *proxyConfig) initAuditLogBufferSize() {
+	p.AuditLogBufferSize = p.Base.ParseIntWithDefault("proxy.auditLog.bufferSize", 10000)
+}
+
+// initQuota sets the rate limits of the DML rows, the searches and the concurrent queries
+// per collection and per authenticated user, the requests beyond the limits are rejected.
+func (p *proxyConfig) initQuota() {
+	p.QuotaEnabled = p.Base.ParseBool("proxy.quota.enabled", false)
+	p.CollectionDMLRowsPerSec = p.Base.ParseFloatWithDefault("proxy.quota.collection.dmlRowsPerSec", 0)
+	p.CollectionSearchQPS = p.Base.ParseFloatWithDefault("proxy.quota.collection.searchQPS", 0)
+	p.CollectionMaxQueryConcurrency = p.Base.ParseInt64WithDefault("proxy.quota.collection.maxQueryConcurrency", 0)
+	p.UserDMLRowsPerSec = p.Base.ParseFloatWithDefault("proxy.quota.user.dmlRowsPerSec", 0)
+	p.UserSearchQPS = p.Base.ParseFloatWithDefault("proxy.quota.user.searchQPS", 0)
+	p.UserMaxQueryConcurrency = p.Base.ParseInt64WithDefault("proxy.quota.user.maxQueryConcurrency", 0)
+}
+
 func (p *proxyConfig) SetNodeID(id UniqueID) {
 	p.NodeID.Store(id)
 }
@@ -576,12 +757,42 @@ type queryCoordConfig struct {
 
 	//---- Handoff ---
 	AutoHandoff bool
+	// HandoffConcurrency is the max number of the handoff tasks running at the same time
+	HandoffConcurrency int
 
 	//---- Balance ---
 	AutoBalance                         bool
 	OverloadedMemoryThresholdPercentage float64
 	BalanceIntervalSeconds              int64
 	MemoryUsageMaxDifferencePercentage  float64
+	// SegmentAllocatePolicy is the policy placing the segments to load on the query nodes, resource or memory
+	SegmentAllocatePolicy string
+	// ResourceBalance*Weight are the weights of the resource usages in the load score of a query node
+	ResourceBalanceMemoryWeight    float64
+	ResourceBalanceCPUWeight       float64
+	ResourceBalanceDiskCacheWeight float64
+
+	//---- Skew Balance ---
+	SkewBalanceEnabled  bool
+	SkewBalanceInterval time.Duration
+	// SkewBalanceRatio is the ratio of the max node load to the average one beyond which the replica is skewed
+	SkewBalanceRatio float64
+	// SkewBalanceMinQPS is the average QPS below which the QPS skew is ignored
+	SkewBalanceMinQPS float64
+
+	//---- Channel Balance ---
+	// ChannelBalanceEnabled migrates the dml channels between the nodes of a replica by the number of dml channels they watch
+	ChannelBalanceEnabled  bool
+	ChannelBalanceInterval time.Duration
+
+	//---- Release ---
+	// AsyncRelease makes ReleaseCollection return once the release task is scheduled
+	AsyncRelease bool
+
+	//---- HA ---
+	// EnableActiveStandby allows running multiple QueryCoords, only the one registered in etcd first serves
+	// and the others take over once it's gone
+	EnableActiveStandby bool
 }
 
 func (p *queryCoordConfig) init(base *BaseTable) {
@@ -589,12 +800,31 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 	p.NodeID.Store(UniqueID(0))
 	//---- Handoff ---
 	p.initAutoHandoff()
+	p.initHandoffConcurrency()
 
 	//---- Balance ---
 	p.initAutoBalance()
 	p.initOverloadedMemoryThresholdPercentage()
 	p.initBalanceIntervalSeconds()
 	p.initMemoryUsageMaxDifferencePercentage()
+	p.initSegmentAllocatePolicy()
+	p.initResourceBalanceWeights()
+
+	//---- Skew Balance ---
+	p.initSkewBalanceEnabled()
+	p.initSkewBalanceInterval()
+	p.initSkewBalanceRatio()
+	p.initSkewBalanceMinQPS()
+
+	//---- Channel Balance ---
+	p.initChannelBalanceEnabled()
+	p.initChannelBalanceInterval()
+
+	//---- Release ---
+	p.initAsyncRelease()
+
+	//---- HA ---
+	p.initEnableActiveStandby()
 }
 
 func (p *queryCoordConfig) initAutoHandoff() {
@@ -608,6 +838,10 @@ func (p *queryCoordConfig) initAutoHandoff() {
 	}
 }
 
+func (p *queryCoordConfig) initHandoffConcurrency() {
+	p.HandoffConcurrency = p.Base.ParseIntWithDefault("queryCoord.handoffConcurrency", 4)
+}
+
 func (p *queryCoordConfig) initAutoBalance() {
 	balanceStr := p.Base.LoadWithDefault("queryCoord.autoBalance", "false")
 	autoBalance, err := strconv.ParseBool(balanceStr)
@@ -644,6 +878,48 @@ func (p *queryCoordConfig) initMemoryUsageMaxDifferencePercentage() {
 	p.MemoryUsageMaxDifferencePercentage = float64(diffPercentage) / 100
 }
 
+func (p *queryCoordConfig) initSegmentAllocatePolicy() {
+	p.SegmentAllocatePolicy = p.Base.LoadWithDefault("queryCoord.segmentAllocatePolicy", "resource")
+}
+
+func (p *queryCoordConfig) initResourceBalanceWeights() {
+	p.ResourceBalanceMemoryWeight = p.Base.ParseFloatWithDefault("queryCoord.resourceBalance.memoryWeight", 1)
+	p.ResourceBalanceCPUWeight = p.Base.ParseFloatWithDefault("queryCoord.resourceBalance.cpuWeight", 0.5)
+	p.ResourceBalanceDiskCacheWeight = p.Base.ParseFloatWithDefault("queryCoord.resourceBalance.diskCacheWeight", 0.2)
+}
+
+func (p *queryCoordConfig) initSkewBalanceEnabled() {
+	p.SkewBalanceEnabled = p.Base.ParseBool("queryCoord.skewBalance.enabled", false)
+}
+
+func (p *queryCoordConfig) initSkewBalanceInterval() {
+	p.SkewBalanceInterval = time.Duration(p.Base.ParseInt64WithDefault("queryCoord.skewBalance.intervalSeconds", 60)) * time.Second
+}
+
+func (p *queryCoordConfig) initSkewBalanceRatio() {
+	p.SkewBalanceRatio = p.Base.ParseFloatWithDefault("queryCoord.skewBalance.ratio", 1.5)
+}
+
+func (p *queryCoordConfig) initSkewBalanceMinQPS() {
+	p.SkewBalanceMinQPS = p.Base.ParseFloatWithDefault("queryCoord.skewBalance.minQPS", 10)
+}
+
+func (p *queryCoordConfig) initChannelBalanceEnabled() {
+	p.ChannelBalanceEnabled = p.Base.ParseBool("queryCoord.channelBalance.enabled", false)
+}
+
+func (p *queryCoordConfig) initChannelBalanceInterval() {
+	p.ChannelBalanceInterval = time.Duration(p.Base.ParseInt64WithDefault("queryCoord.channelBalance.intervalSeconds", 60)) * time.Second
+}
+
+func (p *queryCoordConfig) initAsyncRelease() {
+	p.AsyncRelease = p.Base.ParseBool("queryCoord.asyncRelease", false)
+}
+
+func (p *queryCoordConfig) initEnableActiveStandby() {
+	p.EnableActiveStandby = p.Base.ParseBool("queryCoord.enableActiveStandby", false)
+}
+
 func (p *queryCoordConfig) SetNodeID(id UniqueID) {
 	p.NodeID.Store(id)
 }
@@ -691,6 +967,12 @@ type queryNodeConfig struct {
 	GracefulTime int64
 	SliceIndex   int
 
+	// GracefulReleaseMaxWait is the max time to wait for in-flight search/query requests before releasing
+	GracefulReleaseMaxWait time.Duration
+
+	// PlanCacheSize is the max number of the compiled search and retrieve plans cached per collection
+	PlanCacheSize int64
+
 	// segcore
 	ChunkRows        int64
 	SmallIndexNlist  int64
@@ -700,11 +982,75 @@ type queryNodeConfig struct {
 	UpdatedTime time.Time
 
 	// memory limit
-	OverloadedMemoryThresholdPercentage float64
+	OverloadedMemoryThresholdPercentage atomic.Float64
+	// TotalMemory overrides the detected memory in bytes, 0 means using the cgroup limit or host memory
+	TotalMemory uint64
 
 	// cache limit
 	CacheEnabled     bool
-	CacheMemoryLimit int64
+	CacheMemoryLimit atomic.Int64
+
+	// cold segment swap
+	ColdSegmentSwapEnabled   bool
+	ColdSegmentIdleTimeout   time.Duration
+	ColdSegmentCheckInterval time.Duration
+	// ColdSegmentMaxRecordedDeletes is the max number of the deletes kept per sealed segment to replay on swap in
+	ColdSegmentMaxRecordedDeletes int
+
+	// delete snapshot
+	DeleteSnapshotEnabled bool
+
+	// local disk cache of the binlogs and index files loaded
+	DiskCacheEnabled  bool
+	DiskCachePath     string
+	DiskCacheCapacity int64
+
+	// quota of the local disk shared by the disk cache and the swap cache of the cold segments
+	DiskQuota int64
+
+	// gpu placement of the indexes, which takes effect only if querynode is built with the gpu tag
+	GPUEnabled     bool
+	GPUMemoryLimit int64
+
+	// gc tuner
+	GCTunerEnabled         bool
+	GCTunerLoadingGOGC     atomic.Int64
+	GCTunerServingGOGC     atomic.Int64
+	GCTunerMinimumGOGC     atomic.Int64
+	GCTunerMemoryThreshold atomic.Float64
+
+	// replica load balance
+	ReplicaLoadBalanceEnabled bool
+	ReplicaLoadReportInterval time.Duration
+	// MaxFollowerLag is the max lag of the serviceable time of a follower behind the guarantee timestamp,
+	// the followers lagging further are skipped by the shard leader, 0 means disabled
+	MaxFollowerLag atomic.Duration
+
+	// Zone is the failure domain (zone or rack) the node is deployed in
+	Zone string
+
+	// circuit breaker of the nodes searched by shard leaders, 0 failure threshold disables it
+	CircuitBreakerFailureThreshold atomic.Int64
+	CircuitBreakerProbeInterval    atomic.Duration
+
+	// slow query log, 0 threshold disables it
+	SlowQueryThreshold atomic.Duration
+	// SlowQueryLogFile is the file the slow queries are written to, it's {log.file.rootPath}/querynode-{nodeID}-slow.log if empty
+	SlowQueryLogFile string
+
+	// SegmentEventLogCapacity is the number of the latest segment lifecycle events kept in memory
+	SegmentEventLogCapacity int
+
+	// health check of the subsystems reported in the component states
+	HealthCheckInterval           time.Duration
+	HealthCheckStaleTimeout       atomic.Duration
+	HealthCheckTaskQueueThreshold atomic.Float64
+
+	// SearchWorkerNum is the max number of the segments searched at the same time, 0 means unlimited
+	SearchWorkerNum atomic.Int64
+
+	// ConfigReloadInterval is the interval to check the config file for the changes of the reloadable params, 0 disables it
+	ConfigReloadInterval time.Duration
 }
 
 func (p *queryNodeConfig) init(base *BaseTable) {
@@ -712,6 +1058,8 @@ func (p *queryNodeConfig) init(base *BaseTable) {
 	p.NodeID.Store(UniqueID(0))
 	p.initCacheSize()
 	p.initGracefulTime()
+	p.initGracefulReleaseMaxWait()
+	p.initPlanCacheSize()
 
 	p.initFlowGraphMaxQueueLength()
 	p.initFlowGraphMaxParallelism()
@@ -725,9 +1073,51 @@ func (p *queryNodeConfig) init(base *BaseTable) {
 	p.initSmallIndexParams()
 
 	p.initOverloadedMemoryThresholdPercentage()
+	p.initTotalMemory()
 
 	p.initCacheMemoryLimit()
 	p.initCacheEnabled()
+
+	p.initColdSegmentSwapEnabled()
+	p.initColdSegmentIdleTimeout()
+	p.initColdSegmentCheckInterval()
+	p.initColdSegmentMaxRecordedDeletes()
+
+	p.initDeleteSnapshotEnabled()
+
+	p.initDiskCacheEnabled()
+	p.initDiskCachePath()
+	p.initDiskCacheCapacity()
+	p.initDiskQuota()
+	p.initGPUEnabled()
+	p.initGPUMemoryLimit()
+
+	p.initGCTunerEnabled()
+	p.initGCTunerLoadingGOGC()
+	p.initGCTunerServingGOGC()
+	p.initGCTunerMinimumGOGC()
+	p.initGCTunerMemoryThreshold()
+
+	p.initReplicaLoadBalanceEnabled()
+	p.initReplicaLoadReportInterval()
+	p.initMaxFollowerLag()
+
+	p.initZone()
+
+	p.initCircuitBreakerFailureThreshold()
+	p.initCircuitBreakerProbeInterval()
+
+	p.initSlowQueryThreshold()
+	p.initSlowQueryLogFile()
+
+	p.initSegmentEventLogCapacity()
+
+	p.initHealthCheckInterval()
+	p.initHealthCheckStaleTimeout()
+	p.initHealthCheckTaskQueueThreshold()
+
+	p.initSearchWorkerNum()
+	p.initConfigReloadInterval()
 }
 
 // InitAlias initializes an alias for the QueryNode role.
@@ -789,6 +1179,15 @@ func (p *queryNodeConfig) initGracefulTime() {
 	log.Debug("query node init gracefulTime", zap.Any("gracefulTime", p.GracefulTime))
 }
 
+func (p *queryNodeConfig) initGracefulReleaseMaxWait() {
+	p.GracefulReleaseMaxWait = time.Duration(p.Base.ParseInt64WithDefault("queryNode.gracefulRelease.maxWaitTime", 10)) * time.Second
+}
+
+// initPlanCacheSize sets the max number of the compiled plans cached per collection, 0 disables the cache.
+func (p *queryNodeConfig) initPlanCacheSize() {
+	p.PlanCacheSize = p.Base.ParseInt64WithDefault("queryNode.planCacheSize", 256)
+}
+
 func (p *queryNodeConfig) initSmallIndexParams() {
 	p.ChunkRows = p.Base.ParseInt64WithDefault("queryNode.segcore.chunkRows", 32768)
 	if p.ChunkRows < 1024 {
@@ -825,7 +1224,15 @@ func (p *queryNodeConfig) initOverloadedMemoryThresholdPercentage() {
 	if err != nil {
 		panic(err)
 	}
-	p.OverloadedMemoryThresholdPercentage = float64(thresholdPercentage) / 100
+	p.OverloadedMemoryThresholdPercentage.Store(float64(thresholdPercentage) / 100)
+}
+
+func (p *queryNodeConfig) initTotalMemory() {
+	totalMemory := p.Base.ParseInt64WithDefault("queryNode.totalMemory", 0)
+	if totalMemory < 0 {
+		panic("queryNode.totalMemory must not be negative")
+	}
+	p.TotalMemory = uint64(totalMemory)
 }
 
 func (p *queryNodeConfig) initCacheMemoryLimit() {
@@ -834,7 +1241,7 @@ func (p *queryNodeConfig) initCacheMemoryLimit() {
 	if err != nil {
 		panic(err)
 	}
-	p.CacheMemoryLimit = cacheMemoryLimit
+	p.CacheMemoryLimit.Store(cacheMemoryLimit)
 }
 
 func (p *queryNodeConfig) initCacheEnabled() {
@@ -846,6 +1253,279 @@ func (p *queryNodeConfig) initCacheEnabled() {
 	}
 }
 
+// -- cold segment swap --
+func (p *queryNodeConfig) initColdSegmentSwapEnabled() {
+	p.ColdSegmentSwapEnabled = p.Base.ParseBool("queryNode.coldSegment.enabled", false)
+}
+
+func (p *queryNodeConfig) initColdSegmentIdleTimeout() {
+	p.ColdSegmentIdleTimeout = time.Duration(p.Base.ParseInt64WithDefault("queryNode.coldSegment.idleTimeout", 30)) * time.Minute
+}
+
+func (p *queryNodeConfig) initColdSegmentCheckInterval() {
+	p.ColdSegmentCheckInterval = time.Duration(p.Base.ParseInt64WithDefault("queryNode.coldSegment.checkInterval", 60)) * time.Second
+}
+
+func (p *queryNodeConfig) initColdSegmentMaxRecordedDeletes() {
+	p.ColdSegmentMaxRecordedDeletes = p.Base.ParseIntWithDefault("queryNode.coldSegment.maxRecordedDeletes", 1000000)
+}
+
+// -- delete snapshot --
+func (p *queryNodeConfig) initDeleteSnapshotEnabled() {
+	p.DeleteSnapshotEnabled = p.Base.ParseBool("queryNode.deleteSnapshot.enabled", false)
+}
+
+// -- disk cache --
+func (p *queryNodeConfig) initDiskCacheEnabled() {
+	p.DiskCacheEnabled = p.Base.ParseBool("queryNode.diskCache.enabled", false)
+}
+
+func (p *queryNodeConfig) initDiskCachePath() {
+	defaultPath := path.Join(p.Base.LoadWithDefault("localStorage.path", "/var/lib/milvus/data"), "chunk_cache")
+	p.DiskCachePath = p.Base.LoadWithDefault("queryNode.diskCache.path", defaultPath)
+}
+
+func (p *queryNodeConfig) initDiskCacheCapacity() {
+	p.DiskCacheCapacity = p.Base.ParseInt64WithDefault("queryNode.diskCache.capacity", 64) * 1024 * 1024 * 1024
+}
+
+func (p *queryNodeConfig) initDiskQuota() {
+	p.DiskQuota = p.Base.ParseInt64WithDefault("queryNode.diskQuota", 512) * 1024 * 1024 * 1024
+}
+
+func (p *queryNodeConfig) initGPUEnabled() {
+	p.GPUEnabled = p.Base.ParseBool("queryNode.gpu.enabled", false)
+}
+
+func (p *queryNodeConfig) initGPUMemoryLimit() {
+	p.GPUMemoryLimit = p.Base.ParseInt64WithDefault("queryNode.gpu.memoryLimit", 8) * 1024 * 1024 * 1024
+}
+
+// -- gc tuner --
+func (p *queryNodeConfig) initGCTunerEnabled() {
+	p.GCTunerEnabled = p.Base.ParseBool("queryNode.gcTuner.enabled", false)
+}
+
+func (p *queryNodeConfig) initGCTunerLoadingGOGC() {
+	p.GCTunerLoadingGOGC.Store(p.Base.ParseInt64WithDefault("queryNode.gcTuner.loadingGOGC", 400))
+}
+
+func (p *queryNodeConfig) parseGCTunerServingGOGC() int64 {
+	return p.Base.ParseInt64WithDefault("queryNode.gcTuner.servingGOGC", 100)
+}
+
+func (p *queryNodeConfig) initGCTunerServingGOGC() {
+	p.GCTunerServingGOGC.Store(p.parseGCTunerServingGOGC())
+}
+
+func (p *queryNodeConfig) parseGCTunerMinimumGOGC() int64 {
+	return p.Base.ParseInt64WithDefault("queryNode.gcTuner.minimumGOGC", 30)
+}
+
+func (p *queryNodeConfig) initGCTunerMinimumGOGC() {
+	minimumGOGC, servingGOGC := p.parseGCTunerMinimumGOGC(), p.GCTunerServingGOGC.Load()
+	if minimumGOGC > servingGOGC {
+		log.Warn("gc tuner minimumGOGC must not be greater than servingGOGC, force set to", zap.Int64("minimumGOGC", servingGOGC))
+		minimumGOGC = servingGOGC
+	}
+	p.GCTunerMinimumGOGC.Store(minimumGOGC)
+}
+
+func (p *queryNodeConfig) initGCTunerMemoryThreshold() {
+	p.GCTunerMemoryThreshold.Store(p.Base.ParseFloatWithDefault("queryNode.gcTuner.memoryThreshold", 0.7))
+}
+
+func (p *queryNodeConfig) initReplicaLoadBalanceEnabled() {
+	p.ReplicaLoadBalanceEnabled = p.Base.ParseBool("queryNode.replicaLoadBalance.enabled", false)
+}
+
+func (p *queryNodeConfig) initReplicaLoadReportInterval() {
+	p.ReplicaLoadReportInterval = time.Duration(p.Base.ParseInt64WithDefault("queryNode.replicaLoadBalance.reportInterval", 1000)) * time.Millisecond
+}
+
+func (p *queryNodeConfig) initMaxFollowerLag() {
+	p.MaxFollowerLag.Store(time.Duration(p.Base.ParseInt64WithDefault("queryNode.replicaLoadBalance.maxFollowerLag", 0)) * time.Millisecond)
+}
+
+func (p *queryNodeConfig) initZone() {
+	p.Zone = p.Base.LoadWithDefault("queryNode.zone", "")
+}
+
+func (p *queryNodeConfig) initCircuitBreakerFailureThreshold() {
+	p.CircuitBreakerFailureThreshold.Store(p.Base.ParseInt64WithDefault("queryNode.circuitBreaker.failureThreshold", 5))
+}
+
+func (p *queryNodeConfig) initCircuitBreakerProbeInterval() {
+	p.CircuitBreakerProbeInterval.Store(time.Duration(p.Base.ParseInt64WithDefault("queryNode.circuitBreaker.probeInterval", 10000)) * time.Millisecond)
+}
+
+func (p *queryNodeConfig) initSlowQueryThreshold() {
+	p.SlowQueryThreshold.Store(time.Duration(p.Base.ParseInt64WithDefault("queryNode.slowQueryLog.threshold", 0)) * time.Millisecond)
+}
+
+func (p *queryNodeConfig) initSlowQueryLogFile() {
+	p.SlowQueryLogFile = p.Base.LoadWithDefault("queryNode.slowQueryLog.filename", "")
+}
+
+func (p *queryNodeConfig) initSegmentEventLogCapacity() {
+	p.SegmentEventLogCapacity = p.Base.ParseIntWithDefault("queryNode.segmentEventLog.capacity", 10000)
+}
+
+func (p *queryNodeConfig) initHealthCheckInterval() {
+	p.HealthCheckInterval = time.Duration(p.Base.ParseInt64WithDefault("queryNode.healthCheck.interval", 10000)) * time.Millisecond
+}
+
+func (p *queryNodeConfig) initHealthCheckStaleTimeout() {
+	p.HealthCheckStaleTimeout.Store(time.Duration(p.Base.ParseInt64WithDefault("queryNode.healthCheck.staleTimeout", 60000)) * time.Millisecond)
+}
+
+func (p *queryNodeConfig) initHealthCheckTaskQueueThreshold() {
+	p.HealthCheckTaskQueueThreshold.Store(p.Base.ParseFloatWithDefault("queryNode.healthCheck.taskQueueThreshold", 0.9))
+}
+
+func (p *queryNodeConfig) initSearchWorkerNum() {
+	p.SearchWorkerNum.Store(p.Base.ParseInt64WithDefault("queryNode.search.workerNum", 0))
+}
+
+func (p *queryNodeConfig) initConfigReloadInterval() {
+	p.ConfigReloadInterval = time.Duration(p.Base.ParseInt64WithDefault("queryNode.configReload.interval", 10000)) * time.Millisecond
+}
+
+// reloadableParam is a param safe to change at runtime, it's stored in an atomic as it's read concurrently
+type reloadableParam struct {
+	key      string  // lower-cased
+	float    bool    // the value is a float, or an integer otherwise
+	min, max float64 // the valid range of the value, both inclusive
+	initFunc func()
+}
+
+// reloadableParams returns the params which are safe to change at runtime, in the order they are initialized.
+// The params read on every use take effect immediately, the others take effect on the next use, e.g. the cache memory limit
+// applies to the shards watched after the change.
+func (p *queryNodeConfig) reloadableParams() []reloadableParam {
+	return []reloadableParam{
+		{"querycoord.overloadedmemorythresholdpercentage", false, 1, 100, p.initOverloadedMemoryThresholdPercentage},
+		{"querynode.cache.memorylimit", false, 1, math.MaxInt64, p.initCacheMemoryLimit},
+		{"querynode.gctuner.loadinggogc", false, 1, math.MaxInt32, p.initGCTunerLoadingGOGC},
+		// minimumGOGC is clamped by servingGOGC, so it's initialized after
+		{"querynode.gctuner.servinggogc", false, 1, math.MaxInt32, p.initGCTunerServingGOGC},
+		{"querynode.gctuner.minimumgogc", false, 1, math.MaxInt32, p.initGCTunerMinimumGOGC},
+		{"querynode.gctuner.memorythreshold", true, 0, 1, p.initGCTunerMemoryThreshold},
+		{"querynode.replicaloadbalance.maxfollowerlag", false, 0, math.MaxInt32, p.initMaxFollowerLag},
+		{"querynode.circuitbreaker.failurethreshold", false, 0, math.MaxInt32, p.initCircuitBreakerFailureThreshold},
+		{"querynode.circuitbreaker.probeinterval", false, 1, math.MaxInt32, p.initCircuitBreakerProbeInterval},
+		{"querynode.slowquerylog.threshold", false, 0, math.MaxInt32, p.initSlowQueryThreshold},
+		{"querynode.healthcheck.staletimeout", false, 1, math.MaxInt32, p.initHealthCheckStaleTimeout},
+		{"querynode.healthcheck.taskqueuethreshold", true, 0, 1, p.initHealthCheckTaskQueueThreshold},
+		{"querynode.search.workernum", false, 0, math.MaxInt32, p.initSearchWorkerNum},
+	}
+}
+
+// reloadableChange is the change of a reloadable param, which is rolled back to the old value if rejected
+type reloadableChange struct {
+	param   reloadableParam
+	value   string
+	removed bool
+	old     string
+	existed bool
+}
+
+func (p *queryNodeConfig) saveReloadable(key string, value string, removed bool) {
+	if removed {
+		_ = p.Base.Remove(key)
+	} else {
+		_ = p.Base.Save(key, value)
+	}
+}
+
+// Reload applies the changes of the reloadable params in kvs, which is the whole config with lower-cased keys,
+// a reloadable param absent from kvs is reset to its default value. The params set by the environment variables
+// override kvs as they do the config file. The invalid values, and the values violating the relations between the
+// params on the new values, are rejected and the previous values are kept. It returns the keys of the params changed,
+// and the error of the rejected ones.
+func (p *queryNodeConfig) Reload(kvs map[string]string) ([]string, error) {
+	var changes []*reloadableChange
+	var errs []string
+	for _, param := range p.reloadableParams() {
+		value, ok := kvs[param.key]
+		if envValue, set := p.Base.LoadFromEnv(param.key); set {
+			value, ok = envValue, true
+		}
+		old, err := p.Base.Load(param.key)
+		existed := err == nil
+		if ok == existed && value == old {
+			continue
+		}
+		if ok {
+			if err := param.validate(value); err != nil {
+				errs = append(errs, fmt.Sprintf("invalid value %q of %s: %s", value, param.key, err.Error()))
+				continue
+			}
+		}
+		changes = append(changes, &reloadableChange{param: param, value: value, removed: !ok, old: old, existed: existed})
+	}
+
+	for _, change := range changes {
+		p.saveReloadable(change.param.key, change.value, change.removed)
+	}
+	// the relations are checked on the new values regardless of the order the params are changed
+	if minimumGOGC, servingGOGC := p.parseGCTunerMinimumGOGC(), p.parseGCTunerServingGOGC(); minimumGOGC > servingGOGC {
+		errs = append(errs, fmt.Sprintf("invalid gc tuner minimumGOGC %d greater than servingGOGC %d", minimumGOGC, servingGOGC))
+		changes = p.rollbackReloadable(changes, "querynode.gctuner.minimumgogc", "querynode.gctuner.servinggogc")
+	}
+
+	var changed []string
+	for _, change := range changes {
+		change.param.initFunc()
+		changed = append(changed, change.param.key)
+	}
+	sort.Strings(changed)
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return changed, fmt.Errorf("failed to reload params: %s", strings.Join(errs, "; "))
+	}
+	return changed, nil
+}
+
+// rollbackReloadable restores the old values of the changes of the keys, and returns the other changes
+func (p *queryNodeConfig) rollbackReloadable(changes []*reloadableChange, keys ...string) []*reloadableChange {
+	rollback := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		rollback[key] = struct{}{}
+	}
+	var kept []*reloadableChange
+	for _, change := range changes {
+		if _, ok := rollback[change.param.key]; !ok {
+			kept = append(kept, change)
+			continue
+		}
+		p.saveReloadable(change.param.key, change.old, !change.existed)
+	}
+	return kept
+}
+
+// validate returns error if the value can't be parsed by the init function of the param, or it's out of the range
+func (p reloadableParam) validate(value string) error {
+	var v float64
+	if p.float {
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		v = f
+	} else {
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		v = float64(i)
+	}
+	if v < p.min || v > p.max {
+		return fmt.Errorf("out of range [%v, %v]", p.min, p.max)
+	}
+	return nil
+}
+
 func (p *queryNodeConfig) SetNodeID(id UniqueID) {
 	p.NodeID.Store(id)
 }
@@ -872,9 +1552,15 @@ type dataCoordConfig struct {
 	// --- ETCD ---
 	ChannelWatchSubPath string
 
+	// --- CHANNELS ---
+	ChannelBalanceEnabled  bool
+	ChannelBalanceInterval time.Duration
+	ChannelBalanceMaxMoves int
+
 	// --- SEGMENTS ---
 	SegmentMaxSize          float64
 	SegmentSealProportion   float64
+	SegmentSmallProportion  float64
 	SegAssignmentExpiration int64
 	SegmentMaxLifetime      time.Duration
 
@@ -885,28 +1571,45 @@ type dataCoordConfig struct {
 	EnableAutoCompaction    bool
 	EnableGarbageCollection bool
 
+	// Compaction
+	MinSegmentToMerge        int
+	GlobalCompactionInterval time.Duration
+	SingleCompactionRatio    float64
+	SingleCompactionDeltaLog int64
+
 	// Garbage Collection
 	GCInterval         time.Duration
 	GCMissingTolerance time.Duration
 	GCDropTolerance    time.Duration
+	GCDryRun           bool
 }
 
 func (p *dataCoordConfig) init(base *BaseTable) {
 	p.Base = base
 	p.initChannelWatchPrefix()
 
+	p.initChannelBalanceEnabled()
+	p.initChannelBalanceInterval()
+	p.initChannelBalanceMaxMoves()
+
 	p.initSegmentMaxSize()
 	p.initSegmentSealProportion()
+	p.initSegmentSmallProportion()
 	p.initSegAssignmentExpiration()
 	p.initSegmentMaxLifetime()
 
 	p.initEnableCompaction()
 	p.initEnableAutoCompaction()
+	p.initMinSegmentToMerge()
+	p.initGlobalCompactionInterval()
+	p.initSingleCompactionRatio()
+	p.initSingleCompactionDeltaLog()
 
 	p.initEnableGarbageCollection()
 	p.initGCInterval()
 	p.initGCMissingTolerance()
 	p.initGCDropTolerance()
+	p.initGCDryRun()
 }
 
 func (p *dataCoordConfig) initSegmentMaxSize() {
@@ -917,6 +1620,11 @@ func (p *dataCoordConfig) initSegmentSealProportion() {
 	p.SegmentSealProportion = p.Base.ParseFloatWithDefault("dataCoord.segment.sealProportion", 0.75)
 }
 
+// initSegmentSmallProportion the flushed segments with fewer rows than the proportion of the max are merged by compaction
+func (p *dataCoordConfig) initSegmentSmallProportion() {
+	p.SegmentSmallProportion = p.Base.ParseFloatWithDefault("dataCoord.segment.smallProportion", 0.5)
+}
+
 func (p *dataCoordConfig) initSegAssignmentExpiration() {
 	p.SegAssignmentExpiration = p.Base.ParseInt64WithDefault("dataCoord.segment.assignmentExpiration", 2000)
 }
@@ -931,6 +1639,19 @@ func (p *dataCoordConfig) initChannelWatchPrefix() {
 	p.ChannelWatchSubPath = "channelwatch"
 }
 
+func (p *dataCoordConfig) initChannelBalanceEnabled() {
+	p.ChannelBalanceEnabled = p.Base.ParseBool("dataCoord.channel.balance.enabled", false)
+}
+
+func (p *dataCoordConfig) initChannelBalanceInterval() {
+	p.ChannelBalanceInterval = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.channel.balance.interval", 60)) * time.Second
+}
+
+// initChannelBalanceMaxMoves the channels moved in a round of balance, each of them pauses consuming during the handover
+func (p *dataCoordConfig) initChannelBalanceMaxMoves() {
+	p.ChannelBalanceMaxMoves = p.Base.ParseIntWithDefault("dataCoord.channel.balance.maxMoves", 1)
+}
+
 func (p *dataCoordConfig) initEnableCompaction() {
 	p.EnableCompaction = p.Base.ParseBool("dataCoord.enableCompaction", false)
 }
@@ -952,10 +1673,33 @@ func (p *dataCoordConfig) initGCDropTolerance() {
 	p.GCDropTolerance = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.gc.dropTolerance", 24*60*60)) * time.Second
 }
 
+func (p *dataCoordConfig) initGCDryRun() {
+	p.GCDryRun = p.Base.ParseBool("dataCoord.gc.dryRun", false)
+}
+
 func (p *dataCoordConfig) initEnableAutoCompaction() {
 	p.EnableAutoCompaction = p.Base.ParseBool("dataCoord.compaction.enableAutoCompaction", false)
 }
 
+// initMinSegmentToMerge the small segments of a channel and partition are merged once there are so many of them
+func (p *dataCoordConfig) initMinSegmentToMerge() {
+	p.MinSegmentToMerge = p.Base.ParseIntWithDefault("dataCoord.compaction.minSegmentToMerge", 10)
+}
+
+func (p *dataCoordConfig) initGlobalCompactionInterval() {
+	p.GlobalCompactionInterval = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.compaction.globalInterval", 60)) * time.Second
+}
+
+// initSingleCompactionRatio the deleted rows of a segment are removed once they exceed the ratio of its rows
+func (p *dataCoordConfig) initSingleCompactionRatio() {
+	p.SingleCompactionRatio = p.Base.ParseFloatWithDefault("dataCoord.compaction.single.ratio.threshold", 0.2)
+}
+
+// initSingleCompactionDeltaLog the deleted rows of a segment are removed once its delta logs exceed the size in bytes
+func (p *dataCoordConfig) initSingleCompactionDeltaLog() {
+	p.SingleCompactionDeltaLog = p.Base.ParseInt64WithDefault("dataCoord.compaction.single.deltalog.maxsize", 10*1024*1024)
+}
+
 func (p *dataCoordConfig) SetNodeID(id UniqueID) {
 	p.NodeID.Store(id)
 }
@@ -992,6 +1736,14 @@ type dataNodeConfig struct {
 	// etcd
 	ChannelWatchSubPath string
 
+	// backpressure by the memory of the insert buffers
+	BackpressureEnabled       bool
+	BackpressureHighWatermark int64 // bytes
+	BackpressureLowWatermark  int64 // bytes
+
+	// compress type of the insert, stats and delta binlogs, empty means no compression
+	BinlogCompression string
+
 	CreatedTime time.Time
 	UpdatedTime time.Time
 }
@@ -1007,6 +1759,34 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	p.initDeleteBinlogRootPath()
 
 	p.initChannelWatchPath()
+
+	p.initBackpressure()
+	p.initBinlogCompression()
+}
+
+// initBackpressure the writes are throttled once the insert buffers exceed the high watermark in MB,
+// until they're flushed below the low watermark.
+func (p *dataNodeConfig) initBackpressure() {
+	p.BackpressureEnabled = p.Base.ParseBool("dataNode.backpressure.enabled", false)
+	p.BackpressureHighWatermark = p.Base.ParseInt64WithDefault("dataNode.backpressure.highWatermark", 4096) * 1024 * 1024
+	p.BackpressureLowWatermark = p.Base.ParseInt64WithDefault("dataNode.backpressure.lowWatermark", 3072) * 1024 * 1024
+	if p.BackpressureLowWatermark > p.BackpressureHighWatermark {
+		p.BackpressureLowWatermark = p.BackpressureHighWatermark
+	}
+}
+
+// initBinlogCompression the binlogs are compressed by zstd or lz4 before uploading, and decompressed transparently
+// by their readers.
+func (p *dataNodeConfig) initBinlogCompression() {
+	compression := strings.ToLower(p.Base.LoadWithDefault("dataNode.binlog.compression", "none"))
+	switch compression {
+	case "none", "":
+		p.BinlogCompression = ""
+	case "zstd", "lz4":
+		p.BinlogCompression = compression
+	default:
+		panic(fmt.Errorf("invalid dataNode.binlog.compression %s, should be none, zstd or lz4", compression))
+	}
 }
 
 // InitAlias init this DataNode alias
@@ -1077,6 +1857,12 @@ type indexCoordConfig struct {
 
 	IndexStorageRootPath string
 
+	// Garbage Collection of the orphaned index files
+	EnableGarbageCollection bool
+	GCInterval              time.Duration
+	GCMissingTolerance      time.Duration
+	GCDryRun                bool
+
 	CreatedTime time.Time
 	UpdatedTime time.Time
 }
@@ -1085,6 +1871,28 @@ func (p *indexCoordConfig) init(base *BaseTable) {
 	p.Base = base
 
 	p.initIndexStorageRootPath()
+
+	p.initEnableGarbageCollection()
+	p.initGCInterval()
+	p.initGCMissingTolerance()
+	p.initGCDryRun()
+}
+
+func (p *indexCoordConfig) initEnableGarbageCollection() {
+	p.EnableGarbageCollection = p.Base.ParseBool("indexCoord.gc.enabled", false)
+}
+
+func (p *indexCoordConfig) initGCInterval() {
+	p.GCInterval = time.Duration(p.Base.ParseInt64WithDefault("indexCoord.gc.interval", 60*60)) * time.Second
+}
+
+// initGCMissingTolerance the index files of a build unknown to meta are removed once found so for the duration
+func (p *indexCoordConfig) initGCMissingTolerance() {
+	p.GCMissingTolerance = time.Duration(p.Base.ParseInt64WithDefault("indexCoord.gc.missingTolerance", 24*60*60)) * time.Second
+}
+
+func (p *indexCoordConfig) initGCDryRun() {
+	p.GCDryRun = p.Base.ParseBool("indexCoord.gc.dryRun", false)
 }
 
 // initIndexStorageRootPath initializes the root path of index files.
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func shouldPanic(t *testing.T, name string, f func()) {
@@ -49,6 +50,9 @@ func TestComponentParam(t *testing.T) {
 		Params.Base.Save("common.entityExpiration", "50")
 		Params.initEntityExpiration()
 		assert.Equal(t, int64(Params.EntityExpirationTTL.Seconds()), int64(DefaultRetentionDuration))
+		assert.Equal(t, Params.EntityExpirationTTL, Params.GetEntityTTL(0))
+		assert.Equal(t, int64(Params.GetEntityTTL(time.Second).Seconds()), int64(DefaultRetentionDuration))
+		assert.Equal(t, 30*24*time.Hour, Params.GetEntityTTL(30*24*time.Hour))
 
 		assert.NotEqual(t, Params.SimdType, "")
 		t.Logf("knowhere simd type = %s", Params.SimdType)
@@ -56,6 +60,28 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, Params.IndexSliceSize, int64(DefaultIndexSliceSize))
 		t.Logf("knowhere index slice size = %d", Params.IndexSliceSize)
 
+		assert.False(t, Params.DebugServerEnabled)
+		assert.Equal(t, 9099, Params.DebugServerPort)
+		assert.Equal(t, "/tmp/milvus/dump", Params.DebugServerDumpDir)
+
+		assert.Equal(t, "", Params.MsgStreamType)
+		Params.Base.Save("common.msgStream.type", " pulsar ")
+		Params.initMsgStreamType()
+		assert.Equal(t, "pulsar", Params.MsgStreamType)
+		Params.Base.Save("common.msgStream.type", "")
+		Params.initMsgStreamType()
+
+		assert.Equal(t, "", Params.MsgStreamCompression)
+		Params.Base.Save("common.msgStream.compression", "LZ4")
+		Params.initMsgStreamCompression()
+		assert.Equal(t, "lz4", Params.MsgStreamCompression)
+		Params.Base.Save("common.msgStream.compression", "gzip")
+		assert.Panics(t, Params.initMsgStreamCompression)
+		Params.Base.Save("common.msgStream.compression", "none")
+		Params.initMsgStreamCompression()
+		assert.Equal(t, "", Params.MsgStreamCompression)
+		assert.Equal(t, 15*time.Second, Params.MsgStreamLagReportInterval)
+
 		// -- proxy --
 		assert.Equal(t, Params.ProxySubName, "by-dev-proxy")
 		t.Logf("ProxySubName: %s", Params.ProxySubName)
@@ -122,6 +148,7 @@ func TestComponentParam(t *testing.T) {
 		t.Logf("master ImportIndexCheckInterval = %f", Params.ImportIndexCheckInterval)
 		assert.NotEqual(t, Params.ImportIndexWaitLimit, 0)
 		t.Logf("master ImportIndexWaitLimit = %f", Params.ImportIndexWaitLimit)
+		assert.Equal(t, time.Duration(0), Params.TimeTickCoalesceInterval)
 
 		Params.CreatedTime = time.Now()
 		Params.UpdatedTime = time.Now()
@@ -145,6 +172,26 @@ func TestComponentParam(t *testing.T) {
 		t.Logf("MaxDimension: %d", Params.MaxDimension)
 
 		t.Logf("MaxTaskNum: %d", Params.MaxTaskNum)
+
+		assert.Equal(t, int64(0), Params.MaxInsertBatchSize)
+		assert.Equal(t, int64(16384), Params.MaxTopK)
+		assert.Equal(t, 0.8, Params.GrpcLimitWarnRatio)
+		assert.Equal(t, int64(1024), Params.PlanTemplateCacheSize)
+		assert.Equal(t, int64(1024), Params.MaxTemplateNum)
+		assert.Equal(t, 24*time.Hour, Params.TemplateTTL)
+		assert.Equal(t, int64(16), Params.PartitionKeyNum)
+
+		assert.False(t, Params.AuditLogEnabled)
+		assert.Equal(t, "", Params.AuditLogFile)
+		assert.Equal(t, 10000, Params.AuditLogBufferSize)
+
+		assert.False(t, Params.QuotaEnabled)
+		assert.Equal(t, float64(0), Params.CollectionDMLRowsPerSec)
+		assert.Equal(t, float64(0), Params.CollectionSearchQPS)
+		assert.Equal(t, int64(0), Params.CollectionMaxQueryConcurrency)
+		assert.Equal(t, float64(0), Params.UserDMLRowsPerSec)
+		assert.Equal(t, float64(0), Params.UserSearchQPS)
+		assert.Equal(t, int64(0), Params.UserMaxQueryConcurrency)
 	})
 
 	t.Run("test proxyConfig panic", func(t *testing.T) {
@@ -202,7 +249,22 @@ func TestComponentParam(t *testing.T) {
 	})
 
 	t.Run("test queryCoordConfig", func(t *testing.T) {
-		//Params := CParams.QueryCoordCfg
+		Params := CParams.QueryCoordCfg
+		assert.False(t, Params.AsyncRelease)
+		assert.Equal(t, 4, Params.HandoffConcurrency)
+		assert.False(t, Params.EnableActiveStandby)
+
+		assert.False(t, Params.SkewBalanceEnabled)
+		assert.Equal(t, time.Minute, Params.SkewBalanceInterval)
+		assert.Equal(t, 1.5, Params.SkewBalanceRatio)
+		assert.Equal(t, 10.0, Params.SkewBalanceMinQPS)
+		assert.False(t, Params.ChannelBalanceEnabled)
+		assert.Equal(t, time.Minute, Params.ChannelBalanceInterval)
+
+		assert.Equal(t, "resource", Params.SegmentAllocatePolicy)
+		assert.Equal(t, 1.0, Params.ResourceBalanceMemoryWeight)
+		assert.Equal(t, 0.5, Params.ResourceBalanceCPUWeight)
+		assert.Equal(t, 0.2, Params.ResourceBalanceDiskCacheWeight)
 	})
 
 	t.Run("test queryNodeConfig", func(t *testing.T) {
@@ -273,11 +335,145 @@ func TestComponentParam(t *testing.T) {
 
 		nprobe = Params.SmallIndexNProbe
 		assert.Equal(t, int64(4), nprobe)
+
+		assert.False(t, Params.ColdSegmentSwapEnabled)
+		assert.Equal(t, 30*time.Minute, Params.ColdSegmentIdleTimeout)
+		assert.Equal(t, 60*time.Second, Params.ColdSegmentCheckInterval)
+		assert.Equal(t, 1000000, Params.ColdSegmentMaxRecordedDeletes)
+
+		assert.False(t, Params.DeleteSnapshotEnabled)
+		assert.False(t, Params.DiskCacheEnabled)
+		assert.Equal(t, "/var/lib/milvus/data/chunk_cache", Params.DiskCachePath)
+		assert.Equal(t, int64(64*1024*1024*1024), Params.DiskCacheCapacity)
+		assert.Equal(t, int64(512*1024*1024*1024), Params.DiskQuota)
+		assert.False(t, Params.GPUEnabled)
+		assert.Equal(t, int64(8*1024*1024*1024), Params.GPUMemoryLimit)
+		assert.Equal(t, uint64(0), Params.TotalMemory)
+
+		assert.False(t, Params.GCTunerEnabled)
+		assert.Equal(t, int64(400), Params.GCTunerLoadingGOGC.Load())
+		assert.Equal(t, int64(100), Params.GCTunerServingGOGC.Load())
+		assert.Equal(t, int64(30), Params.GCTunerMinimumGOGC.Load())
+		assert.Equal(t, 0.7, Params.GCTunerMemoryThreshold.Load())
+
+		assert.Equal(t, 10*time.Second, Params.GracefulReleaseMaxWait)
+		assert.Equal(t, int64(256), Params.PlanCacheSize)
+
+		assert.False(t, Params.ReplicaLoadBalanceEnabled)
+		assert.Equal(t, time.Second, Params.ReplicaLoadReportInterval)
+		assert.Equal(t, time.Duration(0), Params.MaxFollowerLag.Load())
+
+		assert.Equal(t, "", Params.Zone)
+
+		assert.Equal(t, int64(5), Params.CircuitBreakerFailureThreshold.Load())
+		assert.Equal(t, 10*time.Second, Params.CircuitBreakerProbeInterval.Load())
+
+		assert.Equal(t, time.Duration(0), Params.SlowQueryThreshold.Load())
+		assert.Equal(t, "", Params.SlowQueryLogFile)
+
+		assert.Equal(t, 10000, Params.SegmentEventLogCapacity)
+
+		assert.Equal(t, 10*time.Second, Params.HealthCheckInterval)
+		assert.Equal(t, time.Minute, Params.HealthCheckStaleTimeout.Load())
+		assert.Equal(t, 0.9, Params.HealthCheckTaskQueueThreshold.Load())
+
+		assert.Equal(t, int64(0), Params.SearchWorkerNum.Load())
+		assert.Equal(t, 10*time.Second, Params.ConfigReloadInterval)
+	})
+
+	t.Run("test queryNodeConfig reload", func(t *testing.T) {
+		var params ComponentParam
+		params.Init()
+		Params := &params.QueryNodeCfg
+
+		kvs, err := ReadYaml(params.BaseTable.GetConfigFile())
+		require.NoError(t, err)
+		changed, err := Params.Reload(kvs)
+		assert.NoError(t, err)
+		assert.Empty(t, changed)
+
+		kvs["querynode.slowquerylog.threshold"] = "100"
+		kvs["querynode.healthcheck.interval"] = "100"
+		changed, err = Params.Reload(kvs)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"querynode.slowquerylog.threshold"}, changed)
+		assert.Equal(t, 100*time.Millisecond, Params.SlowQueryThreshold.Load())
+		// not reloadable
+		assert.Equal(t, 10*time.Second, Params.HealthCheckInterval)
+
+		// invalid values are rejected
+		kvs["querynode.slowquerylog.threshold"] = "abc"
+		kvs["querynode.cache.memorylimit"] = "1024"
+		changed, err = Params.Reload(kvs)
+		assert.Error(t, err)
+		assert.Equal(t, []string{"querynode.cache.memorylimit"}, changed)
+		assert.Equal(t, 100*time.Millisecond, Params.SlowQueryThreshold.Load())
+		assert.Equal(t, int64(1024), Params.CacheMemoryLimit.Load())
+
+		// absent params are reset to default
+		delete(kvs, "querynode.slowquerylog.threshold")
+		changed, err = Params.Reload(kvs)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"querynode.slowquerylog.threshold"}, changed)
+		assert.Equal(t, time.Duration(0), Params.SlowQueryThreshold.Load())
+
+		// out of range values are rejected
+		kvs["querycoord.overloadedmemorythresholdpercentage"] = "101"
+		kvs["querynode.healthcheck.taskqueuethreshold"] = "1.5"
+		kvs["querynode.slowquerylog.threshold"] = "-1"
+		kvs["querynode.search.workernum"] = "4"
+		changed, err = Params.Reload(kvs)
+		assert.Error(t, err)
+		assert.Equal(t, []string{"querynode.search.workernum"}, changed)
+		assert.Equal(t, int64(4), Params.SearchWorkerNum.Load())
+		assert.Equal(t, 0.9, Params.HealthCheckTaskQueueThreshold.Load())
+		assert.Equal(t, time.Duration(0), Params.SlowQueryThreshold.Load())
+		yamlKVs, err := ReadYaml(params.BaseTable.GetConfigFile())
+		require.NoError(t, err)
+		kvs["querycoord.overloadedmemorythresholdpercentage"] = yamlKVs["querycoord.overloadedmemorythresholdpercentage"]
+		kvs["querynode.healthcheck.taskqueuethreshold"] = yamlKVs["querynode.healthcheck.taskqueuethreshold"]
+		delete(kvs, "querynode.slowquerylog.threshold")
+
+		// minimumGOGC is validated against the new servingGOGC
+		kvs["querynode.gctuner.minimumgogc"] = "150"
+		kvs["querynode.gctuner.servinggogc"] = "200"
+		changed, err = Params.Reload(kvs)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"querynode.gctuner.minimumgogc", "querynode.gctuner.servinggogc"}, changed)
+		assert.Equal(t, int64(150), Params.GCTunerMinimumGOGC.Load())
+		assert.Equal(t, int64(200), Params.GCTunerServingGOGC.Load())
+		kvs["querynode.gctuner.servinggogc"] = "100"
+		kvs["querynode.slowquerylog.threshold"] = "100"
+		changed, err = Params.Reload(kvs)
+		assert.Error(t, err)
+		assert.Equal(t, []string{"querynode.slowquerylog.threshold"}, changed)
+		assert.Equal(t, int64(150), Params.GCTunerMinimumGOGC.Load())
+		assert.Equal(t, int64(200), Params.GCTunerServingGOGC.Load())
+		servingGOGC, err := Params.Base.Load("queryNode.gcTuner.servingGOGC")
+		assert.NoError(t, err)
+		assert.Equal(t, "200", servingGOGC)
+
+		// the params set by the environment variables aren't overridden
+		require.NoError(t, os.Setenv("milvus.queryNode.slowQueryLog.threshold", "300"))
+		defer os.Unsetenv("milvus.queryNode.slowQueryLog.threshold")
+		changed, err = Params.Reload(kvs)
+		assert.Error(t, err)
+		assert.Equal(t, []string{"querynode.slowquerylog.threshold"}, changed)
+		assert.Equal(t, 300*time.Millisecond, Params.SlowQueryThreshold.Load())
 	})
 
 	t.Run("test dataCoordConfig", func(t *testing.T) {
 		Params := CParams.DataCoordCfg
+		assert.True(t, Params.ChannelBalanceEnabled)
+		assert.Equal(t, 60*time.Second, Params.ChannelBalanceInterval)
+		assert.Equal(t, 1, Params.ChannelBalanceMaxMoves)
 		assert.Equal(t, 24*60*60*time.Second, Params.SegmentMaxLifetime)
+		assert.Equal(t, 0.5, Params.SegmentSmallProportion)
+		assert.Equal(t, 10, Params.MinSegmentToMerge)
+		assert.Equal(t, 60*time.Second, Params.GlobalCompactionInterval)
+		assert.Equal(t, 0.2, Params.SingleCompactionRatio)
+		assert.Equal(t, int64(10*1024*1024), Params.SingleCompactionDeltaLog)
+		assert.False(t, Params.GCDryRun)
 	})
 
 	t.Run("test dataNodeConfig", func(t *testing.T) {
@@ -300,6 +496,20 @@ func TestComponentParam(t *testing.T) {
 		size := Params.FlushInsertBufferSize
 		t.Logf("FlushInsertBufferSize: %d", size)
 
+		assert.False(t, Params.BackpressureEnabled)
+		assert.Equal(t, int64(4096*1024*1024), Params.BackpressureHighWatermark)
+		assert.Equal(t, int64(3072*1024*1024), Params.BackpressureLowWatermark)
+
+		assert.Equal(t, "", Params.BinlogCompression)
+		Params.Base.Save("dataNode.binlog.compression", "ZSTD")
+		Params.initBinlogCompression()
+		assert.Equal(t, "zstd", Params.BinlogCompression)
+		Params.Base.Save("dataNode.binlog.compression", "snappy")
+		assert.Panics(t, Params.initBinlogCompression)
+		Params.Base.Save("dataNode.binlog.compression", "none")
+		Params.initBinlogCompression()
+		assert.Equal(t, "", Params.BinlogCompression)
+
 		path1 := Params.InsertBinlogRootPath
 		t.Logf("InsertBinlogRootPath: %s", path1)
 
@@ -328,6 +538,11 @@ func TestComponentParam(t *testing.T) {
 		t.Logf("UpdatedTime: %v", Params.UpdatedTime)
 
 		t.Logf("IndexStorageRootPath: %v", Params.IndexStorageRootPath)
+
+		assert.False(t, Params.EnableGarbageCollection)
+		assert.Equal(t, time.Hour, Params.GCInterval)
+		assert.Equal(t, 24*time.Hour, Params.GCMissingTolerance)
+		assert.False(t, Params.GCDryRun)
 	})
 
 	t.Run("test indexNodeConfig", func(t *testing.T) {
@@ -12,6 +12,7 @@
 package paramtable
 
 import (
+	"crypto/tls"
 	"math"
 	"strconv"
 	"sync"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/go-basic/ipv4"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"go.uber.org/zap"
 )
 
@@ -61,6 +63,14 @@ type grpcConfig struct {
 	ServerPemPath string
 	ServerKeyPath string
 	CaPemPath     string
+
+	// InternalTLSEnabled enables the mutual TLS of the gRPC among the components, they present and verify the
+	// certificates issued by the same CA. InternalTLSServerName overrides the name the servers are verified by.
+	InternalTLSEnabled    bool
+	InternalServerPemPath string
+	InternalServerKeyPath string
+	InternalCaPemPath     string
+	InternalTLSServerName string
 }
 
 func (p *grpcConfig) init(domain string) {
@@ -93,6 +103,28 @@ func (p *grpcConfig) initTLSPath() {
 	p.ServerPemPath = p.Get("tls.serverPemPath")
 	p.ServerKeyPath = p.Get("tls.serverKeyPath")
 	p.CaPemPath = p.Get("tls.caPemPath")
+
+	p.InternalTLSEnabled = p.ParseBool("common.security.internalTlsEnabled", false)
+	p.InternalServerPemPath = p.Get("internaltls.serverPemPath")
+	p.InternalServerKeyPath = p.Get("internaltls.serverKeyPath")
+	p.InternalCaPemPath = p.Get("internaltls.caPemPath")
+	p.InternalTLSServerName = p.Get("internaltls.sni")
+}
+
+// InternalServerTLSConfig returns the TLS config of the gRPC server of the component, nil if internal TLS is disabled
+func (p *grpcConfig) InternalServerTLSConfig() (*tls.Config, error) {
+	if !p.InternalTLSEnabled {
+		return nil, nil
+	}
+	return tlsutil.NewServerConfig(p.InternalServerPemPath, p.InternalServerKeyPath, p.InternalCaPemPath)
+}
+
+// InternalClientTLSConfig returns the TLS config of the gRPC client to the component, nil if internal TLS is disabled
+func (p *grpcConfig) InternalClientTLSConfig() (*tls.Config, error) {
+	if !p.InternalTLSEnabled {
+		return nil, nil
+	}
+	return tlsutil.NewClientConfig(p.InternalServerPemPath, p.InternalServerKeyPath, p.InternalCaPemPath, p.InternalTLSServerName)
 }
 
 // GetAddress return grpc address
@@ -48,6 +48,23 @@ func TestGrpcServerParams(t *testing.T) {
 	Params.Remove(role + ".grpc.serverMaxSendSize")
 	Params.initServerMaxSendSize()
 	assert.Equal(t, Params.ServerMaxSendSize, DefaultServerMaxSendSize)
+
+	assert.False(t, Params.InternalTLSEnabled)
+	tlsConfig, err := Params.InternalServerTLSConfig()
+	assert.NoError(t, err)
+	assert.Nil(t, tlsConfig)
+
+	Params.Save("common.security.internalTlsEnabled", "true")
+	Params.Save("internaltls.serverPemPath", "/not/exist/server.pem")
+	Params.initTLSPath()
+	assert.True(t, Params.InternalTLSEnabled)
+	assert.Equal(t, "/not/exist/server.pem", Params.InternalServerPemPath)
+	_, err = Params.InternalServerTLSConfig()
+	assert.Error(t, err)
+	_, err = Params.InternalClientTLSConfig()
+	assert.Error(t, err)
+	Params.Remove("common.security.internalTlsEnabled")
+	Params.initTLSPath()
 }
 
 func TestGrpcClientParams(t *testing.T) {
@@ -17,6 +17,8 @@
 package paramtable
 
 import (
+	"fmt"
+	"io/ioutil"
 	"os"
 	"path"
 	"strconv"
@@ -40,8 +42,11 @@ type ServiceParam struct {
 	EtcdCfg         EtcdConfig
 	PulsarCfg       PulsarConfig
 	KafkaCfg        KafkaConfig
+	NatsCfg         NatsConfig
 	RocksmqCfg      RocksmqConfig
 	MinioCfg        MinioConfig
+	AzureCfg        AzureConfig
+	GCSCfg          GCSConfig
 }
 
 func (p *ServiceParam) Init() {
@@ -51,8 +56,11 @@ func (p *ServiceParam) Init() {
 	p.EtcdCfg.init(&p.BaseTable)
 	p.PulsarCfg.init(&p.BaseTable)
 	p.KafkaCfg.init(&p.BaseTable)
+	p.NatsCfg.init(&p.BaseTable)
 	p.RocksmqCfg.init(&p.BaseTable)
 	p.MinioCfg.init(&p.BaseTable)
+	p.AzureCfg.init(&p.BaseTable)
+	p.GCSCfg.init(&p.BaseTable)
 }
 
 ///////////////////////////////////////////////////////////////////////////////
@@ -201,6 +209,12 @@ type PulsarConfig struct {
 
 	Address        string
 	MaxMessageSize int
+
+	AuthPlugin                 string
+	AuthParams                 string
+	TLSTrustCertsFilePath      string
+	TLSAllowInsecureConnection bool
+	TLSValidateHostname        bool
 }
 
 func (p *PulsarConfig) init(base *BaseTable) {
@@ -208,6 +222,8 @@ func (p *PulsarConfig) init(base *BaseTable) {
 
 	p.initAddress()
 	p.initMaxMessageSize()
+	p.initAuth()
+	p.initTLS()
 }
 
 func (p *PulsarConfig) initAddress() {
@@ -232,15 +248,40 @@ func (p *PulsarConfig) initMaxMessageSize() {
 	}
 }
 
+// initAuth the auth plugin is the name accepted by pulsar.NewAuthentication, such as token, tls or oauth2,
+// and the auth params may be read from pulsar.authParamsFile to keep the credentials out of the config file
+func (p *PulsarConfig) initAuth() {
+	p.AuthPlugin = p.Base.LoadWithDefault("pulsar.authPlugin", "")
+	p.AuthParams = loadSecret(p.Base, "pulsar.authParams")
+}
+
+func (p *PulsarConfig) initTLS() {
+	p.TLSTrustCertsFilePath = p.Base.LoadWithDefault("pulsar.tlsTrustCertsFilePath", "")
+	p.TLSAllowInsecureConnection = p.Base.ParseBool("pulsar.tlsAllowInsecureConnection", false)
+	p.TLSValidateHostname = p.Base.ParseBool("pulsar.tlsValidateHostname", false)
+}
+
 // --- kafka ---
 type KafkaConfig struct {
 	Base    *BaseTable
 	Address string
+
+	SecurityProtocol       string
+	SaslMechanisms         string
+	SaslUsername           string
+	SaslPassword           string
+	SaslOauthbearerConfig  string
+	SSLCaLocation          string
+	SSLCertificateLocation string
+	SSLKeyLocation         string
+	SSLKeyPassword         string
 }
 
 func (k *KafkaConfig) init(base *BaseTable) {
 	k.Base = base
 	k.initAddress()
+	k.initSasl()
+	k.initSSL()
 }
 
 func (k *KafkaConfig) initAddress() {
@@ -251,6 +292,55 @@ func (k *KafkaConfig) initAddress() {
 	k.Address = addr
 }
 
+// initSasl the passwords may be read from the files configured by the keys with File suffix, such as kafka.saslPasswordFile
+func (k *KafkaConfig) initSasl() {
+	k.SecurityProtocol = k.Base.LoadWithDefault("kafka.securityProtocol", "")
+	k.SaslMechanisms = k.Base.LoadWithDefault("kafka.saslMechanisms", "")
+	k.SaslUsername = k.Base.LoadWithDefault("kafka.saslUsername", "")
+	k.SaslPassword = loadSecret(k.Base, "kafka.saslPassword")
+	k.SaslOauthbearerConfig = loadSecret(k.Base, "kafka.saslOauthbearerConfig")
+}
+
+func (k *KafkaConfig) initSSL() {
+	k.SSLCaLocation = k.Base.LoadWithDefault("kafka.sslCaLocation", "")
+	k.SSLCertificateLocation = k.Base.LoadWithDefault("kafka.sslCertificateLocation", "")
+	k.SSLKeyLocation = k.Base.LoadWithDefault("kafka.sslKeyLocation", "")
+	k.SSLKeyPassword = loadSecret(k.Base, "kafka.sslKeyPassword")
+}
+
+// --- nats ---
+type NatsConfig struct {
+	Base    *BaseTable
+	Address string
+}
+
+func (n *NatsConfig) init(base *BaseTable) {
+	n.Base = base
+	n.initAddress()
+}
+
+func (n *NatsConfig) initAddress() {
+	addr, err := n.Base.Load("_NatsAddress")
+	if err != nil {
+		panic(err)
+	}
+	n.Address = addr
+}
+
+// loadSecret loads the secret of the key, from the file configured by the key with File suffix if set,
+// so that the secrets can be mounted as files instead of being written in the config file
+func loadSecret(base *BaseTable, key string) string {
+	file := base.LoadWithDefault(key+"File", "")
+	if file == "" {
+		return base.LoadWithDefault(key, "")
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		panic(fmt.Errorf("failed to read the secret file of %s, %w", key, err))
+	}
+	return strings.TrimSpace(string(data))
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // --- rocksmq ---
 type RocksmqConfig struct {
@@ -344,3 +434,78 @@ func (p *MinioConfig) initRootPath() {
 	}
 	p.RootPath = rootPath
 }
+
+///////////////////////////////////////////////////////////////////////////////
+// --- azure ---
+type AzureConfig struct {
+	Base *BaseTable
+
+	// ConnectionString takes precedence over the managed identity if set
+	ConnectionString string
+	// AccountName and Endpoint locate the blob service if authenticated by the managed identity,
+	// the endpoint is https://{AccountName}.blob.core.windows.net/ if not set
+	AccountName string
+	Endpoint    string
+	// ManagedIdentityClientID is the client id of the user-assigned managed identity, empty for the system-assigned one
+	ManagedIdentityClientID string
+	ContainerName           string
+}
+
+func (p *AzureConfig) init(base *BaseTable) {
+	p.Base = base
+
+	p.initAuth()
+	p.initContainerName()
+}
+
+// initAuth the connection string may be read from the file configured by azure.connectionStringFile
+func (p *AzureConfig) initAuth() {
+	p.ConnectionString = loadSecret(p.Base, "azure.connectionString")
+	p.AccountName = p.Base.LoadWithDefault("azure.accountName", "")
+	p.Endpoint = p.Base.LoadWithDefault("azure.endpoint", "")
+	p.ManagedIdentityClientID = p.Base.LoadWithDefault("azure.managedIdentityClientID", "")
+}
+
+func (p *AzureConfig) initContainerName() {
+	p.ContainerName = p.Base.LoadWithDefault("azure.containerName", "a-bucket")
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// --- gcs ---
+type GCSConfig struct {
+	Base *BaseTable
+
+	BucketName string
+	// ProjectID is the project the bucket is created in if not existed
+	ProjectID string
+	// CredentialsFile is the key file of the service account,
+	// the application default credentials are used if empty, e.g. the workload identity on GKE
+	CredentialsFile string
+	// Endpoint overrides the endpoint of the json api, e.g. a private endpoint
+	Endpoint string
+	// UploadChunkSize is the chunk size of the resumable uploads in bytes,
+	// the objects are uploaded in a single request if 0
+	UploadChunkSize int
+}
+
+func (p *GCSConfig) init(base *BaseTable) {
+	p.Base = base
+
+	p.initBucketName()
+	p.initAuth()
+	p.initUploadChunkSize()
+}
+
+func (p *GCSConfig) initBucketName() {
+	p.BucketName = p.Base.LoadWithDefault("gcs.bucketName", "a-bucket")
+}
+
+func (p *GCSConfig) initAuth() {
+	p.ProjectID = p.Base.LoadWithDefault("gcs.projectID", "")
+	p.CredentialsFile = p.Base.LoadWithDefault("gcs.credentialsFile", "")
+	p.Endpoint = p.Base.LoadWithDefault("gcs.endpoint", "")
+}
+
+func (p *GCSConfig) initUploadChunkSize() {
+	p.UploadChunkSize = p.Base.ParseIntWithDefault("gcs.uploadChunkSizeInMB", 16) * 1024 * 1024
+}
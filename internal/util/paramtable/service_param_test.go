@@ -12,7 +12,9 @@
 package paramtable
 
 import (
+	"io/ioutil"
 	"os"
+	"path"
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
@@ -66,6 +68,49 @@ func TestServiceParam(t *testing.T) {
 		t.Logf("pulsar address = %s", Params.Address)
 
 		assert.Equal(t, Params.MaxMessageSize, SuggestPulsarMaxMessageSize)
+
+		assert.Equal(t, "", Params.AuthPlugin)
+		assert.Equal(t, "", Params.AuthParams)
+		assert.False(t, Params.TLSAllowInsecureConnection)
+		assert.False(t, Params.TLSValidateHostname)
+
+		secretFile := path.Join(t.TempDir(), "pulsar-auth-params")
+		assert.NoError(t, ioutil.WriteFile(secretFile, []byte(`{"token":"abc"}`+"\n"), 0600))
+		Params.Base.Save("pulsar.authPlugin", "token")
+		Params.Base.Save("pulsar.authParamsFile", secretFile)
+		defer Params.Base.Remove("pulsar.authPlugin")
+		defer Params.Base.Remove("pulsar.authParamsFile")
+		Params.initAuth()
+		assert.Equal(t, "token", Params.AuthPlugin)
+		assert.Equal(t, `{"token":"abc"}`, Params.AuthParams)
+
+		Params.Base.Save("pulsar.authParamsFile", path.Join(t.TempDir(), "not-exist"))
+		assert.Panics(t, Params.initAuth)
+	})
+
+	t.Run("test kafkaConfig", func(t *testing.T) {
+		Params := SParams.KafkaCfg
+
+		assert.Equal(t, "", Params.SecurityProtocol)
+		assert.Equal(t, "", Params.SaslPassword)
+
+		Params.Base.Save("kafka.securityProtocol", "SASL_SSL")
+		Params.Base.Save("kafka.saslMechanisms", "PLAIN")
+		Params.Base.Save("kafka.saslUsername", "milvus")
+		Params.Base.Save("kafka.saslPassword", "abc")
+		Params.Base.Save("kafka.sslCaLocation", "/path/to/ca.pem")
+		defer func() {
+			for _, key := range []string{"kafka.securityProtocol", "kafka.saslMechanisms", "kafka.saslUsername", "kafka.saslPassword", "kafka.sslCaLocation"} {
+				Params.Base.Remove(key)
+			}
+		}()
+		Params.initSasl()
+		Params.initSSL()
+		assert.Equal(t, "SASL_SSL", Params.SecurityProtocol)
+		assert.Equal(t, "PLAIN", Params.SaslMechanisms)
+		assert.Equal(t, "milvus", Params.SaslUsername)
+		assert.Equal(t, "abc", Params.SaslPassword)
+		assert.Equal(t, "/path/to/ca.pem", Params.SSLCaLocation)
 	})
 
 	t.Run("test rocksmqConfig", func(t *testing.T) {
@@ -93,4 +138,48 @@ func TestServiceParam(t *testing.T) {
 
 		t.Logf("Minio rootpath = %s", Params.RootPath)
 	})
+
+	t.Run("test azureConfig", func(t *testing.T) {
+		Params := SParams.AzureCfg
+
+		assert.Equal(t, "", Params.ConnectionString)
+		assert.Equal(t, "a-bucket", Params.ContainerName)
+
+		Params.Base.Save("azure.accountName", "milvus")
+		Params.Base.Save("azure.managedIdentityClientID", "client-id")
+		connStrFile := path.Join(t.TempDir(), "conn")
+		assert.NoError(t, ioutil.WriteFile(connStrFile, []byte("AccountName=milvus;AccountKey=abc\n"), 0600))
+		Params.Base.Save("azure.connectionStringFile", connStrFile)
+		defer func() {
+			for _, key := range []string{"azure.accountName", "azure.managedIdentityClientID", "azure.connectionStringFile"} {
+				Params.Base.Remove(key)
+			}
+		}()
+		Params.initAuth()
+		assert.Equal(t, "milvus", Params.AccountName)
+		assert.Equal(t, "client-id", Params.ManagedIdentityClientID)
+		assert.Equal(t, "AccountName=milvus;AccountKey=abc", Params.ConnectionString)
+	})
+
+	t.Run("test gcsConfig", func(t *testing.T) {
+		Params := SParams.GCSCfg
+
+		assert.Equal(t, "a-bucket", Params.BucketName)
+		assert.Equal(t, "", Params.CredentialsFile)
+		assert.Equal(t, 16*1024*1024, Params.UploadChunkSize)
+
+		Params.Base.Save("gcs.projectID", "milvus")
+		Params.Base.Save("gcs.credentialsFile", "/path/to/key.json")
+		Params.Base.Save("gcs.uploadChunkSizeInMB", "0")
+		defer func() {
+			for _, key := range []string{"gcs.projectID", "gcs.credentialsFile", "gcs.uploadChunkSizeInMB"} {
+				Params.Base.Remove(key)
+			}
+		}()
+		Params.initAuth()
+		Params.initUploadChunkSize()
+		assert.Equal(t, "milvus", Params.ProjectID)
+		assert.Equal(t, "/path/to/key.json", Params.CredentialsFile)
+		assert.Equal(t, 0, Params.UploadChunkSize)
+	})
 }
@@ -29,6 +29,9 @@ const (
 	DefaultTTL = 60
 )
 
+// errSessionRegistered means the exclusive session has been registered by another server
+var errSessionRegistered = errors.New("session has been registered")
+
 // SessionEventType session event type
 type SessionEventType int
 
@@ -199,55 +202,113 @@ func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, er
 	var ch <-chan *clientv3.LeaseKeepAliveResponse
 	log.Debug("DataNode begin to register to etcd", zap.String("serverName", s.ServerName))
 	registerFn := func() error {
-		resp, err := s.etcdCli.Grant(s.ctx, DefaultTTL)
-		if err != nil {
-			log.Error("register service", zap.Error(err))
-			return err
-		}
-		s.leaseID = &resp.ID
+		var err error
+		ch, err = s.tryRegisterService()
+		return err
+	}
+	err := retry.Do(s.ctx, registerFn, retry.Attempts(DefaultRetryTimes))
+	if err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
 
-		sessionJSON, err := json.Marshal(s)
-		if err != nil {
-			return err
-		}
+// tryRegisterService registers the session once, errSessionRegistered is returned if the key is taken by another server
+func (s *Session) tryRegisterService() (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+	resp, err := s.etcdCli.Grant(s.ctx, DefaultTTL)
+	if err != nil {
+		log.Error("register service", zap.Error(err))
+		return nil, err
+	}
+	s.leaseID = &resp.ID
 
-		key := s.ServerName
-		if !s.Exclusive {
-			key = key + "-" + strconv.FormatInt(s.ServerID, 10)
-		}
-		txnResp, err := s.etcdCli.Txn(s.ctx).If(
-			clientv3.Compare(
-				clientv3.Version(path.Join(s.metaRoot, DefaultServiceRoot, key)),
-				"=",
-				0)).
-			Then(clientv3.OpPut(path.Join(s.metaRoot, DefaultServiceRoot, key), string(sessionJSON), clientv3.WithLease(resp.ID))).Commit()
+	sessionJSON, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
 
-		if err != nil {
-			log.Warn("compare and swap error, maybe the key has already been registered", zap.Error(err))
-			return err
-		}
+	key := s.ServerName
+	if !s.Exclusive {
+		key = key + "-" + strconv.FormatInt(s.ServerID, 10)
+	}
+	txnResp, err := s.etcdCli.Txn(s.ctx).If(
+		clientv3.Compare(
+			clientv3.Version(path.Join(s.metaRoot, DefaultServiceRoot, key)),
+			"=",
+			0)).
+		Then(clientv3.OpPut(path.Join(s.metaRoot, DefaultServiceRoot, key), string(sessionJSON), clientv3.WithLease(resp.ID))).Commit()
 
-		if !txnResp.Succeeded {
-			return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s", key)
-		}
+	if err != nil {
+		log.Warn("compare and swap error, maybe the key has already been registered", zap.Error(err))
+		return nil, err
+	}
 
-		keepAliveCtx, keepAliveCancel := context.WithCancel(context.Background())
-		s.keepAliveCancel = keepAliveCancel
-		ch, err = s.etcdCli.KeepAlive(keepAliveCtx, resp.ID)
-		if err != nil {
-			fmt.Printf("got error during keeping alive with etcd, err: %s\n", err)
-			return err
-		}
-		log.Info("DataNode registered successfully", zap.Int64("serverID", s.ServerID))
-		return nil
+	if !txnResp.Succeeded {
+		// the lease is useless, it would expire after the TTL otherwise
+		_, _ = s.etcdCli.Revoke(s.ctx, resp.ID)
+		return nil, fmt.Errorf("function CompareAndSwap error for compare is false for key: %s, %w", key, errSessionRegistered)
 	}
-	err := retry.Do(s.ctx, registerFn, retry.Attempts(DefaultRetryTimes))
+
+	keepAliveCtx, keepAliveCancel := context.WithCancel(context.Background())
+	s.keepAliveCancel = keepAliveCancel
+	ch, err := s.etcdCli.KeepAlive(keepAliveCtx, resp.ID)
 	if err != nil {
+		fmt.Printf("got error during keeping alive with etcd, err: %s\n", err)
 		return nil, err
 	}
+	log.Info("DataNode registered successfully", zap.Int64("serverID", s.ServerID))
 	return ch, nil
 }
 
+// ProcessActiveStandBy keeps the session standby until the exclusive session of the active server is gone,
+// then registers it as the active one and calls activateFunc. It blocks until the session becomes active,
+// and returns the error of activateFunc, or the error if the session context is done.
+func (s *Session) ProcessActiveStandBy(activateFunc func() error) error {
+	key := path.Join(s.metaRoot, DefaultServiceRoot, s.ServerName)
+	for {
+		ch, err := s.tryRegisterService()
+		if err == nil {
+			s.liveCh = s.processKeepAliveResponse(ch)
+			s.UpdateRegistered(true)
+			break
+		}
+		if s.ctx.Err() != nil {
+			return s.ctx.Err()
+		}
+		if !errors.Is(err, errSessionRegistered) {
+			log.Warn("session failed to register, retry later", zap.String("key", key), zap.Error(err))
+			time.Sleep(time.Second)
+			continue
+		}
+		log.Info("session is standby, wait for the active one to be gone", zap.String("key", key), zap.Int64("serverID", s.ServerID))
+		s.waitSessionDeleted(key)
+	}
+	log.Info("session becomes active", zap.String("key", key), zap.Int64("serverID", s.ServerID))
+	return activateFunc()
+}
+
+// waitSessionDeleted blocks until the key is deleted, or the watch is broken and the key should be checked again
+func (s *Session) waitSessionDeleted(key string) {
+	resp, err := s.etcdCli.Get(s.ctx, key)
+	if err != nil || len(resp.Kvs) == 0 {
+		return
+	}
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+	watchCh := s.etcdCli.Watch(ctx, key, clientv3.WithRev(resp.Header.Revision+1))
+	for wresp := range watchCh {
+		if wresp.Err() != nil {
+			log.Warn("session watch failed", zap.String("key", key), zap.Error(wresp.Err()))
+			return
+		}
+		for _, ev := range wresp.Events {
+			if ev.Type == mvccpb.DELETE {
+				return
+			}
+		}
+	}
+}
+
 // processKeepAliveResponse processes the response of etcd keepAlive interface
 // If keepAlive fails for unexpected error, it will send a signal to the channel.
 func (s *Session) processKeepAliveResponse(ch <-chan *clientv3.LeaseKeepAliveResponse) (failChannel <-chan bool) {
@@ -459,6 +520,15 @@ func (s *Session) LivenessCheck(ctx context.Context, callback func()) {
 	}
 }
 
+// LeaseID returns the lease of the session key, the keys attached to it are removed once the session is gone.
+// Zero is returned if the session isn't registered.
+func (s *Session) LeaseID() clientv3.LeaseID {
+	if s == nil || s.leaseID == nil {
+		return 0
+	}
+	return *s.leaseID
+}
+
 // Revoke revokes the internal leaseID for the session key
 func (s *Session) Revoke(timeout time.Duration) {
 	if s == nil {
@@ -394,6 +394,64 @@ func TestSessionRevoke(t *testing.T) {
 	})
 }
 
+func TestSessionProcessActiveStandBy(t *testing.T) {
+	ctx := context.Background()
+	Params.Init()
+
+	endpoints, err := Params.Load("_EtcdEndpoints")
+	if err != nil {
+		panic(err)
+	}
+	metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
+
+	etcdEndpoints := strings.Split(endpoints, ",")
+	etcdCli, err := etcd.GetRemoteEtcdClient(etcdEndpoints)
+	require.NoError(t, err)
+	defer etcdCli.Close()
+	etcdKV := etcdkv.NewEtcdKV(etcdCli, metaRoot)
+	err = etcdKV.RemoveWithPrefix("")
+	assert.NoError(t, err)
+
+	defer etcdKV.Close()
+	defer etcdKV.RemoveWithPrefix("")
+
+	active := NewSession(ctx, metaRoot, etcdCli)
+	active.Init("activestandbytest", "activeAddr", true, false)
+	err = active.ProcessActiveStandBy(func() error { return nil })
+	assert.NoError(t, err)
+	assert.True(t, active.Registered())
+
+	standby := NewSession(ctx, metaRoot, etcdCli)
+	standby.Init("activestandbytest", "standbyAddr", true, false)
+	activated := make(chan struct{})
+	go func() {
+		err := standby.ProcessActiveStandBy(func() error {
+			close(activated)
+			return nil
+		})
+		assert.NoError(t, err)
+	}()
+
+	select {
+	case <-activated:
+		t.Fatal("standby session is activated while the active one is alive")
+	case <-time.After(500 * time.Millisecond):
+	}
+	assert.False(t, standby.Registered())
+
+	active.Revoke(time.Second)
+	select {
+	case <-activated:
+	case <-time.After(10 * time.Second):
+		t.Fatal("standby session is not activated after the active one is gone")
+	}
+	assert.True(t, standby.Registered())
+	sessions, _, err := standby.GetSessions("activestandbytest")
+	assert.NoError(t, err)
+	assert.Equal(t, "standbyAddr", sessions["activestandbytest"].Address)
+	standby.Revoke(time.Second)
+}
+
 func TestSession_Registered(t *testing.T) {
 	session := &Session{}
 	session.UpdateRegistered(false)
@@ -406,3 +464,15 @@ func TestSession_String(t *testing.T) {
 	s := &Session{}
 	log.Debug("log session", zap.Any("session", s))
 }
+
+func TestSession_LeaseID(t *testing.T) {
+	var nilSession *Session
+	assert.Equal(t, clientv3.LeaseID(0), nilSession.LeaseID())
+
+	s := &Session{}
+	assert.Equal(t, clientv3.LeaseID(0), s.LeaseID())
+
+	leaseID := clientv3.LeaseID(100)
+	s.leaseID = &leaseID
+	assert.Equal(t, leaseID, s.LeaseID())
+}
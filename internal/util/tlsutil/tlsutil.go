@@ -0,0 +1,190 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// certReloader loads the key pair and the CA certificates from the files, and reloads them once any of the files is
+// modified, so that the rotated certificates take effect without restart.
+type certReloader struct {
+	certPath string
+	keyPath  string
+	caPath   string
+
+	mu      sync.Mutex
+	modTime time.Time
+	cert    *tls.Certificate
+	pool    *x509.CertPool
+}
+
+func newCertReloader(certPath, keyPath, caPath string) (*certReloader, error) {
+	r := &certReloader{
+		certPath: certPath,
+		keyPath:  keyPath,
+		caPath:   caPath,
+	}
+	modTime, err := r.latestModTime()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.load(modTime); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// latestModTime returns the latest modification time of the files
+func (r *certReloader) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, path := range []string{r.certPath, r.keyPath, r.caPath} {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+func (r *certReloader) load(modTime time.Time) error {
+	cert := &tls.Certificate{}
+	if r.certPath != "" || r.keyPath != "" {
+		keyPair, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+		if err != nil {
+			return fmt.Errorf("failed to load x509 key pair, error = %w", err)
+		}
+		cert = &keyPair
+	}
+
+	var pool *x509.CertPool
+	if r.caPath != "" {
+		caPem, err := ioutil.ReadFile(r.caPath)
+		if err != nil {
+			return fmt.Errorf("failed to read ca pem, error = %w", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPem) {
+			return errors.New("failed to append ca to cert pool")
+		}
+	}
+
+	r.modTime, r.cert, r.pool = modTime, cert, pool
+	return nil
+}
+
+// get returns the current certificates, which are reloaded first if the files are modified. The previous ones are
+// kept if failing to reload, e.g. the files are being rewritten.
+func (r *certReloader) get() (*tls.Certificate, *x509.CertPool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	modTime, err := r.latestModTime()
+	if err == nil && !modTime.Equal(r.modTime) {
+		err = r.load(modTime)
+		if err == nil {
+			log.Info("certificates reloaded", zap.String("cert", r.certPath), zap.String("ca", r.caPath))
+		}
+	}
+	if err != nil {
+		log.RatedWarn(60, "failed to reload certificates, the current ones are kept", zap.String("cert", r.certPath),
+			zap.String("ca", r.caPath), zap.Error(err))
+	}
+	return r.cert, r.pool
+}
+
+// NewServerConfig returns the TLS config of the gRPC server with the key pair, the client certificates are required
+// and verified by the CA certificates if caPath is not empty. The files are reloaded once modified.
+func NewServerConfig(certPath, keyPath, caPath string) (*tls.Config, error) {
+	r, err := newCertReloader(certPath, keyPath, caPath)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS13,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := r.get()
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS13,
+				Certificates: []tls.Certificate{*cert},
+				NextProtos:   []string{"h2"},
+			}
+			if pool != nil {
+				config.ClientAuth = tls.RequireAndVerifyClientCert
+				config.ClientCAs = pool
+			}
+			return config, nil
+		},
+	}, nil
+}
+
+// NewClientConfig returns the TLS config of the gRPC client, which presents the key pair if the paths are not empty
+// and verifies the server by the CA certificates, or by the system ones if caPath is empty. The server certificate
+// must be issued to serverName, or to the host dialed if serverName is empty. The files are reloaded once modified.
+func NewClientConfig(certPath, keyPath, caPath, serverName string) (*tls.Config, error) {
+	r, err := newCertReloader(certPath, keyPath, caPath)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS13,
+		ServerName: serverName,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := r.get()
+			return cert, nil
+		},
+		// the server certificate is verified by VerifyConnection against the current CA certificates,
+		// as RootCAs could not be reloaded
+		InsecureSkipVerify: true,
+		VerifyConnection: func(state tls.ConnectionState) error {
+			_, pool := r.get()
+			return verifyServer(state, pool)
+		},
+	}, nil
+}
+
+func verifyServer(state tls.ConnectionState, pool *x509.CertPool) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("no certificate presented by the server")
+	}
+	opts := x509.VerifyOptions{
+		Roots:         pool,
+		DNSName:       state.ServerName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range state.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := state.PeerCertificates[0].Verify(opts)
+	return err
+}
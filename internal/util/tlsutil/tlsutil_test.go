@@ -0,0 +1,207 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue writes the key pair issued by the CA to the files
+func (ca *testCA) issue(t *testing.T, certPath, keyPath string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "milvus"},
+		DNSNames:     []string{"milvus"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+}
+
+// touch makes the modification time of the file differ from the previous one
+func touch(t *testing.T, path string, modTime time.Time) {
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+// handshake returns the errors of the client and the server handshaking with each other
+func handshake(t *testing.T, serverConfig, clientConfig *tls.Config) (error, error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+		serverErr <- tls.Server(conn, serverConfig).Handshake()
+	}()
+
+	conn, err := tls.Dial("tcp", lis.Addr().String(), clientConfig)
+	if err == nil {
+		// the server verifies the client certificate after the client finishes its handshake in TLS 1.3
+		_, _ = conn.Read(make([]byte, 1))
+		conn.Close()
+	}
+	return err, <-serverErr
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	path := func(name string) string { return filepath.Join(dir, name) }
+	ca := newTestCA(t, "ca")
+	require.NoError(t, ioutil.WriteFile(path("ca.pem"), ca.pem, 0600))
+	ca.issue(t, path("server.pem"), path("server.key"))
+	ca.issue(t, path("client.pem"), path("client.key"))
+
+	serverConfig, err := NewServerConfig(path("server.pem"), path("server.key"), path("ca.pem"))
+	require.NoError(t, err)
+	clientConfig, err := NewClientConfig(path("client.pem"), path("client.key"), path("ca.pem"), "")
+	require.NoError(t, err)
+	clientErr, serverErr := handshake(t, serverConfig, clientConfig)
+	assert.NoError(t, clientErr)
+	assert.NoError(t, serverErr)
+
+	// the client certificate is required
+	clientConfig, err = NewClientConfig("", "", path("ca.pem"), "milvus")
+	require.NoError(t, err)
+	_, serverErr = handshake(t, serverConfig, clientConfig)
+	assert.Error(t, serverErr)
+
+	// the server name doesn't match
+	clientConfig, err = NewClientConfig(path("client.pem"), path("client.key"), path("ca.pem"), "unknown")
+	require.NoError(t, err)
+	clientErr, _ = handshake(t, serverConfig, clientConfig)
+	assert.Error(t, clientErr)
+
+	// the client certificate is not required without the CA of the server
+	serverConfig, err = NewServerConfig(path("server.pem"), path("server.key"), "")
+	require.NoError(t, err)
+	clientConfig, err = NewClientConfig("", "", path("ca.pem"), "milvus")
+	require.NoError(t, err)
+	_, serverErr = handshake(t, serverConfig, clientConfig)
+	assert.NoError(t, serverErr)
+
+	_, err = NewServerConfig(path("server.pem"), path("none.key"), "")
+	assert.Error(t, err)
+	_, err = NewClientConfig("", "", path("server.key"), "")
+	assert.Error(t, err)
+}
+
+func TestReloadCertificates(t *testing.T) {
+	dir := t.TempDir()
+	path := func(name string) string { return filepath.Join(dir, name) }
+	ca := newTestCA(t, "ca")
+	require.NoError(t, ioutil.WriteFile(path("server-ca.pem"), ca.pem, 0600))
+	require.NoError(t, ioutil.WriteFile(path("client-ca.pem"), ca.pem, 0600))
+	ca.issue(t, path("server.pem"), path("server.key"))
+	ca.issue(t, path("client.pem"), path("client.key"))
+
+	serverConfig, err := NewServerConfig(path("server.pem"), path("server.key"), path("server-ca.pem"))
+	require.NoError(t, err)
+	clientConfig, err := NewClientConfig(path("client.pem"), path("client.key"), path("client-ca.pem"), "milvus")
+	require.NoError(t, err)
+	clientErr, serverErr := handshake(t, serverConfig, clientConfig)
+	assert.NoError(t, clientErr)
+	assert.NoError(t, serverErr)
+
+	// rotate the certificate of the server, the client rejects it until the CA of the client is rotated too
+	rotated := newTestCA(t, "rotated")
+	rotated.issue(t, path("server.pem"), path("server.key"))
+	touch(t, path("server.pem"), time.Now().Add(time.Minute))
+	clientErr, _ = handshake(t, serverConfig, clientConfig)
+	assert.Error(t, clientErr)
+
+	require.NoError(t, ioutil.WriteFile(path("client-ca.pem"), append(append([]byte{}, ca.pem...), rotated.pem...), 0600))
+	touch(t, path("client-ca.pem"), time.Now().Add(time.Minute))
+	clientErr, serverErr = handshake(t, serverConfig, clientConfig)
+	assert.NoError(t, clientErr)
+	assert.NoError(t, serverErr)
+
+	// rotate the certificate of the client, the server rejects it until the CA of the server is rotated too
+	rotated.issue(t, path("client.pem"), path("client.key"))
+	touch(t, path("client.pem"), time.Now().Add(2*time.Minute))
+	_, serverErr = handshake(t, serverConfig, clientConfig)
+	assert.Error(t, serverErr)
+
+	require.NoError(t, ioutil.WriteFile(path("server-ca.pem"), rotated.pem, 0600))
+	touch(t, path("server-ca.pem"), time.Now().Add(2*time.Minute))
+	clientErr, serverErr = handshake(t, serverConfig, clientConfig)
+	assert.NoError(t, clientErr)
+	assert.NoError(t, serverErr)
+
+	// the current certificates are kept if the files are broken
+	require.NoError(t, ioutil.WriteFile(path("server.key"), []byte("broken"), 0600))
+	touch(t, path("server.key"), time.Now().Add(3*time.Minute))
+	clientErr, serverErr = handshake(t, serverConfig, clientConfig)
+	assert.NoError(t, clientErr)
+	assert.NoError(t, serverErr)
+}
@@ -0,0 +1,99 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+const (
+	// MaxAnnotationKeyLength is the max length of the keys of annotations, without the prefix
+	MaxAnnotationKeyLength = 256
+	// MaxAnnotationValueLength is the max length of the values of annotations
+	MaxAnnotationValueLength = 4096
+)
+
+// IsCollectionAnnotation returns whether the type param is an annotation of the collection
+func IsCollectionAnnotation(key string) bool {
+	return strings.HasPrefix(key, common.CollectionAnnotationPrefix)
+}
+
+// IsFieldAnnotation returns whether the type param is an annotation of the field
+func IsFieldAnnotation(key string) bool {
+	return strings.HasPrefix(key, common.FieldAnnotationPrefix)
+}
+
+// validateAnnotation checks the length of the key without the prefix and the value of an annotation
+func validateAnnotation(prefix, key, value string) error {
+	name := strings.TrimPrefix(key, prefix)
+	if name == "" || len(name) > MaxAnnotationKeyLength {
+		return fmt.Errorf("invalid annotation %s, the length of the key after %s should be in [1, %d]", key, prefix, MaxAnnotationKeyLength)
+	}
+	if len(value) > MaxAnnotationValueLength {
+		return fmt.Errorf("invalid annotation %s, the length of the value should be at most %d", key, MaxAnnotationValueLength)
+	}
+	return nil
+}
+
+// ValidateAnnotations checks the annotations of the collection and its fields, the annotations of the collection
+// are only allowed on the primary key field
+func ValidateAnnotations(schema *schemapb.CollectionSchema) error {
+	for _, field := range schema.GetFields() {
+		for _, kv := range field.GetTypeParams() {
+			switch {
+			case IsCollectionAnnotation(kv.GetKey()):
+				if !field.GetIsPrimaryKey() {
+					return fmt.Errorf("%s is only allowed on the primary key, field name = %s", kv.GetKey(), field.GetName())
+				}
+				if err := validateAnnotation(common.CollectionAnnotationPrefix, kv.GetKey(), kv.GetValue()); err != nil {
+					return err
+				}
+			case IsFieldAnnotation(kv.GetKey()):
+				if err := validateAnnotation(common.FieldAnnotationPrefix, kv.GetKey(), kv.GetValue()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// GetCollectionAnnotations returns the annotations of the collection by the keys without the prefix
+func GetCollectionAnnotations(schema *schemapb.CollectionSchema) map[string]string {
+	annotations := make(map[string]string)
+	for key, value := range GetCollectionProperties(schema) {
+		if IsCollectionAnnotation(key) {
+			annotations[strings.TrimPrefix(key, common.CollectionAnnotationPrefix)] = value
+		}
+	}
+	return annotations
+}
+
+// GetFieldAnnotations returns the annotations of the field by the keys without the prefix
+func GetFieldAnnotations(field *schemapb.FieldSchema) map[string]string {
+	annotations := make(map[string]string)
+	for _, kv := range field.GetTypeParams() {
+		if IsFieldAnnotation(kv.GetKey()) {
+			annotations[strings.TrimPrefix(kv.GetKey(), common.FieldAnnotationPrefix)] = kv.GetValue()
+		}
+	}
+	return annotations
+}
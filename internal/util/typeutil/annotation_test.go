@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestAnnotations(t *testing.T) {
+	newSchema := func() *schemapb.CollectionSchema {
+		return &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64,
+					TypeParams: []*commonpb.KeyValuePair{
+						{Key: common.CollectionAnnotationPrefix + "owner", Value: "search-team"},
+						{Key: common.CollectionTTLParam, Value: "3600"},
+					}},
+				{FieldID: 101, Name: "price", DataType: schemapb.DataType_Float,
+					TypeParams: []*commonpb.KeyValuePair{{Key: common.FieldAnnotationPrefix + "unit", Value: "USD"}}},
+			},
+		}
+	}
+
+	schema := newSchema()
+	assert.NoError(t, ValidateAnnotations(schema))
+	assert.NoError(t, MoveCollectionProperties(schema))
+	assert.Equal(t, map[string]string{"owner": "search-team"}, GetCollectionAnnotations(schema))
+	assert.Equal(t, map[string]string{"unit": "USD"}, GetFieldAnnotations(schema.Fields[1]))
+	assert.Empty(t, GetFieldAnnotations(schema.Fields[0]))
+
+	// the annotations of the collection are altered as its properties
+	assert.True(t, IsCollectionProperty(common.CollectionAnnotationPrefix+"owner"))
+	assert.False(t, IsCollectionProperty(common.FieldAnnotationPrefix+"owner"))
+	assert.NoError(t, SetCollectionProperties(schema, map[string]string{
+		common.CollectionAnnotationPrefix + "owner": "",
+		common.CollectionAnnotationPrefix + "tier":  "gold",
+	}))
+	assert.Equal(t, map[string]string{"tier": "gold"}, GetCollectionAnnotations(schema))
+	assert.Error(t, ValidateCollectionProperty(common.CollectionAnnotationPrefix, "x"))
+	assert.Error(t, ValidateCollectionProperty(common.CollectionAnnotationPrefix+"x", strings.Repeat("a", MaxAnnotationValueLength+1)))
+
+	schema = newSchema()
+	schema.Fields[1].TypeParams[0].Key = common.CollectionAnnotationPrefix + "unit"
+	assert.Error(t, ValidateAnnotations(schema))
+
+	schema = newSchema()
+	schema.Fields[1].TypeParams[0].Key = common.FieldAnnotationPrefix + strings.Repeat("k", MaxAnnotationKeyLength+1)
+	assert.Error(t, ValidateAnnotations(schema))
+
+	schema = newSchema()
+	schema.Fields[1].TypeParams[0].Key = common.FieldAnnotationPrefix
+	assert.Error(t, ValidateAnnotations(schema))
+}
@@ -0,0 +1,251 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// The operators of ArrayPredicate, which are the functions in expressions
+const (
+	ArrayContains    = "array_contains"
+	ArrayContainsAny = "array_contains_any"
+	ArrayLength      = "array_length"
+)
+
+var arrayOps = map[string]planpb.ArrayExpr_ArrayOp{
+	ArrayContains:    planpb.ArrayExpr_Contains,
+	ArrayContainsAny: planpb.ArrayExpr_ContainsAny,
+	ArrayLength:      planpb.ArrayExpr_Length,
+}
+
+// IsArrayElementType returns whether the data type could be the element type of array fields
+func IsArrayElementType(dataType schemapb.DataType) bool {
+	return IsBoolType(dataType) || IsIntegerType(dataType) || IsFloatingType(dataType) || dataType == schemapb.DataType_VarChar
+}
+
+// GetArrayElementType returns the element type of the array field, DataType_None if the field is not an array field
+func GetArrayElementType(field *schemapb.FieldSchema) (schemapb.DataType, error) {
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() != common.ArrayElementTypeParam {
+			continue
+		}
+		if field.GetDataType() != schemapb.DataType_VarChar {
+			return schemapb.DataType_None, fmt.Errorf("the data type of the array field %s should be VarChar", field.GetName())
+		}
+		value, ok := schemapb.DataType_value[kv.GetValue()]
+		if !ok || !IsArrayElementType(schemapb.DataType(value)) {
+			return schemapb.DataType_None, fmt.Errorf("invalid %s %s of field %s, should be a scalar type",
+				common.ArrayElementTypeParam, kv.GetValue(), field.GetName())
+		}
+		return schemapb.DataType(value), nil
+	}
+	return schemapb.DataType_None, nil
+}
+
+// IsArrayField returns whether the field is a VarChar field storing JSON arrays of the element type
+func IsArrayField(field *schemapb.FieldSchema) bool {
+	elementType, err := GetArrayElementType(field)
+	return err == nil && elementType != schemapb.DataType_None
+}
+
+// UnmarshalArray returns the elements of a row of the array field, an empty row is an empty array. The numbers are
+// json.Number so that int64 elements keep their precision.
+func UnmarshalArray(row string) ([]interface{}, error) {
+	elements := make([]interface{}, 0)
+	if row == "" {
+		return elements, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(row)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&elements); err != nil {
+		return nil, fmt.Errorf("the value of the array field should be a JSON array, error = %w", err)
+	}
+	return elements, nil
+}
+
+// ValidateArrayElements checks the elements are of the element type of the array field
+func ValidateArrayElements(elements []interface{}, elementType schemapb.DataType) error {
+	for _, element := range elements {
+		if err := validateArrayElement(element, elementType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateArrayElement(element interface{}, elementType schemapb.DataType) error {
+	switch {
+	case IsBoolType(elementType):
+		if _, ok := element.(bool); ok {
+			return nil
+		}
+	case elementType == schemapb.DataType_VarChar:
+		if _, ok := element.(string); ok {
+			return nil
+		}
+	case IsIntegerType(elementType):
+		if n, ok := element.(json.Number); ok {
+			i, err := n.Int64()
+			if err != nil {
+				break
+			}
+			var min, max int64 = math.MinInt64, math.MaxInt64
+			switch elementType {
+			case schemapb.DataType_Int8:
+				min, max = math.MinInt8, math.MaxInt8
+			case schemapb.DataType_Int16:
+				min, max = math.MinInt16, math.MaxInt16
+			case schemapb.DataType_Int32:
+				min, max = math.MinInt32, math.MaxInt32
+			}
+			if i < min || i > max {
+				return fmt.Errorf("element %d overflows %s", i, elementType.String())
+			}
+			return nil
+		}
+	case IsFloatingType(elementType):
+		if n, ok := element.(json.Number); ok {
+			if _, err := n.Float64(); err == nil {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("element %v is not of the element type %s", element, elementType.String())
+}
+
+// ArrayPredicate is a predicate on the elements or the length of an array field, which is carried in the plan as a
+// planpb.ArrayExpr and evaluated by segcore. The numbers are compared as int64 if both are integers, otherwise as
+// float64.
+type ArrayPredicate struct {
+	Op string
+	// Values are the elements to look for by ArrayContains and ArrayContainsAny
+	Values []interface{}
+	// CompareOp and Length compare the length of the array by ArrayLength, e.g. ">" and 3
+	CompareOp string
+	Length    int64
+	// Not negates the predicate
+	Not bool
+}
+
+// Validate checks the operands of the operator
+func (p *ArrayPredicate) Validate() error {
+	switch p.Op {
+	case ArrayContains, ArrayContainsAny:
+		if len(p.Values) == 0 || (p.Op == ArrayContains && len(p.Values) != 1) {
+			return fmt.Errorf("invalid number of the values of %s: %d", p.Op, len(p.Values))
+		}
+		for _, value := range p.Values {
+			switch value.(type) {
+			case json.Number, float64, int64, string, bool:
+			default:
+				return fmt.Errorf("the values of %s should be numbers, strings or bools", p.Op)
+			}
+		}
+	case ArrayLength:
+		if _, ok := jsonPathCompareOps[p.CompareOp]; !ok {
+			return fmt.Errorf("unsupported operator %s on %s", p.CompareOp, ArrayLength)
+		}
+	default:
+		return fmt.Errorf("unsupported operator %s on the array field", p.Op)
+	}
+	return nil
+}
+
+// Expr returns the ArrayExpr of the predicate on the array field of column
+func (p *ArrayPredicate) Expr(column *planpb.ColumnInfo) (*planpb.Expr, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	expr := &planpb.ArrayExpr{
+		ColumnInfo: column,
+		Op:         arrayOps[p.Op],
+		Not:        p.Not,
+	}
+	if p.Op == ArrayLength {
+		expr.LengthOp = jsonPathCompareOps[p.CompareOp]
+		expr.Length = p.Length
+	}
+	for _, value := range p.Values {
+		gv := &planpb.GenericValue{}
+		switch v := value.(type) {
+		case json.Number:
+			if i, err := v.Int64(); err == nil {
+				gv.Val = &planpb.GenericValue_Int64Val{Int64Val: i}
+			} else if f, err := v.Float64(); err == nil {
+				gv.Val = &planpb.GenericValue_FloatVal{FloatVal: f}
+			} else {
+				return nil, fmt.Errorf("invalid number %s of %s", v.String(), p.Op)
+			}
+		case int64:
+			gv.Val = &planpb.GenericValue_Int64Val{Int64Val: v}
+		case float64:
+			gv.Val = &planpb.GenericValue_FloatVal{FloatVal: v}
+		case string:
+			gv.Val = &planpb.GenericValue_StringVal{StringVal: v}
+		case bool:
+			gv.Val = &planpb.GenericValue_BoolVal{BoolVal: v}
+		}
+		expr.Values = append(expr.Values, gv)
+	}
+	return &planpb.Expr{Expr: &planpb.Expr_ArrayExpr{ArrayExpr: expr}}, nil
+}
+
+// NewArrayPredicate returns the predicate of the ArrayExpr, the numbers are json.Number the same as the elements
+// returned by UnmarshalArray
+func NewArrayPredicate(expr *planpb.ArrayExpr) (*ArrayPredicate, error) {
+	p := &ArrayPredicate{Not: expr.GetNot()}
+	for name, op := range arrayOps {
+		if op == expr.GetOp() {
+			p.Op = name
+		}
+	}
+	if p.Op == ArrayLength {
+		compareOp, err := compareOpName(expr.GetLengthOp())
+		if err != nil {
+			return nil, fmt.Errorf("invalid predicate on the array field: %w", err)
+		}
+		p.CompareOp = compareOp
+		p.Length = expr.GetLength()
+	}
+	for _, value := range expr.GetValues() {
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			p.Values = append(p.Values, json.Number(strconv.FormatInt(v.Int64Val, 10)))
+		case *planpb.GenericValue_FloatVal:
+			p.Values = append(p.Values, json.Number(strconv.FormatFloat(v.FloatVal, 'g', -1, 64)))
+		case *planpb.GenericValue_StringVal:
+			p.Values = append(p.Values, v.StringVal)
+		case *planpb.GenericValue_BoolVal:
+			p.Values = append(p.Values, v.BoolVal)
+		default:
+			return nil, fmt.Errorf("the values of %s should be numbers, strings or bools", p.Op)
+		}
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
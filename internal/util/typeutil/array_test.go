@@ -0,0 +1,121 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestGetArrayElementType(t *testing.T) {
+	newField := func(dataType schemapb.DataType, elementType string) *schemapb.FieldSchema {
+		return &schemapb.FieldSchema{
+			Name:       "tags",
+			DataType:   dataType,
+			TypeParams: []*commonpb.KeyValuePair{{Key: common.ArrayElementTypeParam, Value: elementType}},
+		}
+	}
+
+	elementType, err := GetArrayElementType(newField(schemapb.DataType_VarChar, "Int64"))
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_Int64, elementType)
+	assert.True(t, IsArrayField(newField(schemapb.DataType_VarChar, "VarChar")))
+
+	elementType, err = GetArrayElementType(&schemapb.FieldSchema{DataType: schemapb.DataType_VarChar})
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_None, elementType)
+
+	_, err = GetArrayElementType(newField(schemapb.DataType_Int64, "Int64"))
+	assert.Error(t, err)
+	_, err = GetArrayElementType(newField(schemapb.DataType_VarChar, "FloatVector"))
+	assert.Error(t, err)
+	_, err = GetArrayElementType(newField(schemapb.DataType_VarChar, "Int"))
+	assert.Error(t, err)
+	assert.False(t, IsArrayField(newField(schemapb.DataType_VarChar, "Int")))
+}
+
+func TestUnmarshalArray(t *testing.T) {
+	elements, err := UnmarshalArray("")
+	assert.NoError(t, err)
+	assert.Empty(t, elements)
+
+	elements, err = UnmarshalArray(`[1, 9007199254740993]`)
+	assert.NoError(t, err)
+	assert.NoError(t, ValidateArrayElements(elements, schemapb.DataType_Int64))
+	assert.Error(t, ValidateArrayElements(elements, schemapb.DataType_Int32))
+	assert.NoError(t, ValidateArrayElements(elements, schemapb.DataType_Double))
+	assert.Error(t, ValidateArrayElements(elements, schemapb.DataType_VarChar))
+
+	elements, err = UnmarshalArray(`[1.5]`)
+	assert.NoError(t, err)
+	assert.Error(t, ValidateArrayElements(elements, schemapb.DataType_Int64))
+	assert.NoError(t, ValidateArrayElements(elements, schemapb.DataType_Float))
+
+	elements, err = UnmarshalArray(`["a", true]`)
+	assert.NoError(t, err)
+	assert.Error(t, ValidateArrayElements(elements, schemapb.DataType_VarChar))
+	assert.Error(t, ValidateArrayElements(elements, schemapb.DataType_Bool))
+
+	_, err = UnmarshalArray(`{"a": 1}`)
+	assert.Error(t, err)
+}
+
+func TestArrayPredicate(t *testing.T) {
+	invalids := []*ArrayPredicate{
+		{Op: ArrayContains},
+		{Op: ArrayContains, Values: []interface{}{int64(1), int64(2)}},
+		{Op: ArrayContainsAny, Values: []interface{}{[]int64{1}}},
+		{Op: ArrayLength, CompareOp: "in"},
+		{Op: "array_contains_all", Values: []interface{}{int64(1)}},
+	}
+	column := &planpb.ColumnInfo{FieldId: 100, DataType: schemapb.DataType_VarChar}
+	for _, p := range invalids {
+		_, err := p.Expr(column)
+		assert.Error(t, err)
+	}
+	_, err := NewArrayPredicate(&planpb.ArrayExpr{})
+	assert.Error(t, err)
+	_, err = NewArrayPredicate(&planpb.ArrayExpr{Op: planpb.ArrayExpr_Contains, Values: []*planpb.GenericValue{{}}})
+	assert.Error(t, err)
+	_, err = NewArrayPredicate(&planpb.ArrayExpr{Op: planpb.ArrayExpr_Length, LengthOp: planpb.OpType_Match})
+	assert.Error(t, err)
+
+	// the predicates are carried in the plan as they are
+	valids := []*ArrayPredicate{
+		{Op: ArrayContains, Values: []interface{}{int64(9007199254740993)}},
+		{Op: ArrayContains, Values: []interface{}{2.5}},
+		{Op: ArrayContains, Values: []interface{}{"a"}, Not: true},
+		{Op: ArrayContainsAny, Values: []interface{}{int64(3), "b", true}},
+		{Op: ArrayLength, CompareOp: "<=", Length: 4},
+	}
+	for _, p := range valids {
+		expr, err := p.Expr(column)
+		assert.NoError(t, err)
+		predicate, err := NewArrayPredicate(expr.GetArrayExpr())
+		assert.NoError(t, err)
+		expected, err := predicate.Expr(column)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(expected, expr), "%+v", p)
+	}
+}
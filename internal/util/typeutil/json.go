@@ -0,0 +1,179 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// The operators of JSONPathPredicate, the comparisons are written as they are in expressions
+const (
+	JSONPathExists = "exists"
+)
+
+var jsonPathCompareOps = map[string]planpb.OpType{
+	"==": planpb.OpType_Equal,
+	"!=": planpb.OpType_NotEqual,
+	">":  planpb.OpType_GreaterThan,
+	">=": planpb.OpType_GreaterEqual,
+	"<":  planpb.OpType_LessThan,
+	"<=": planpb.OpType_LessEqual,
+}
+
+// compareOpName returns the comparison written in expressions of the OpType
+func compareOpName(op planpb.OpType) (string, error) {
+	for name, opType := range jsonPathCompareOps {
+		if opType == op {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported comparison %s", op.String())
+}
+
+// IsJSONField returns whether the field is a VarChar field storing JSON objects, which is marked by
+// common.JSONFieldParam or is the dynamic field
+func IsJSONField(field *schemapb.FieldSchema) bool {
+	if field.GetDataType() != schemapb.DataType_VarChar {
+		return false
+	}
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() != common.JSONFieldParam && kv.GetKey() != common.DynamicFieldParam {
+			continue
+		}
+		if isJSON, err := strconv.ParseBool(kv.GetValue()); err == nil && isJSON {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDynamicField returns whether the field is the dynamic field marked by common.DynamicFieldParam
+func IsDynamicField(field *schemapb.FieldSchema) bool {
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() != common.DynamicFieldParam {
+			continue
+		}
+		if isDynamic, err := strconv.ParseBool(kv.GetValue()); err == nil && isDynamic {
+			return true
+		}
+	}
+	return false
+}
+
+// UnmarshalJSONObject returns the JSON object of a row of the JSON field, an empty row is an empty object
+func UnmarshalJSONObject(row string) (map[string]interface{}, error) {
+	obj := make(map[string]interface{})
+	if row == "" {
+		return obj, nil
+	}
+	if err := json.Unmarshal([]byte(row), &obj); err != nil {
+		return nil, fmt.Errorf("the value of the JSON field should be a JSON object, error = %w", err)
+	}
+	return obj, nil
+}
+
+// JSONPathPredicate is a predicate on the value at the path of a JSON field, which is carried in the plan as a
+// planpb.JSONPathExpr and evaluated by segcore. The path exists if its value isn't null, and the comparisons are false
+// if the path doesn't exist or its value is of another type than the value compared with.
+type JSONPathPredicate struct {
+	Path []string
+	Op   string
+	// Value is a float64, a string or a bool to compare with, nil for JSONPathExists
+	Value interface{}
+	// Not negates the predicate
+	Not bool
+}
+
+// Validate checks the path is not empty and the value is comparable by the operator
+func (p *JSONPathPredicate) Validate() error {
+	if len(p.Path) == 0 {
+		return fmt.Errorf("the path of the JSON field is empty")
+	}
+	if p.Op == JSONPathExists {
+		if p.Value != nil {
+			return fmt.Errorf("%s has no value to compare with", JSONPathExists)
+		}
+		return nil
+	}
+	if _, ok := jsonPathCompareOps[p.Op]; !ok {
+		return fmt.Errorf("unsupported operator %s on the path of the JSON field", p.Op)
+	}
+	switch p.Value.(type) {
+	case float64, string:
+	case bool:
+		if p.Op != "==" && p.Op != "!=" {
+			return fmt.Errorf("operator %s is not supported on bool", p.Op)
+		}
+	default:
+		return fmt.Errorf("the value compared with the path of the JSON field should be a number, a string or a bool")
+	}
+	return nil
+}
+
+// Expr returns the JSONPathExpr of the predicate on the JSON field of column
+func (p *JSONPathPredicate) Expr(column *planpb.ColumnInfo) (*planpb.Expr, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	expr := &planpb.JSONPathExpr{
+		ColumnInfo: column,
+		Path:       p.Path,
+		Op:         jsonPathCompareOps[p.Op],
+		Not:        p.Not,
+	}
+	switch v := p.Value.(type) {
+	case float64:
+		expr.Value = &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: v}}
+	case string:
+		expr.Value = &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: v}}
+	case bool:
+		expr.Value = &planpb.GenericValue{Val: &planpb.GenericValue_BoolVal{BoolVal: v}}
+	}
+	return &planpb.Expr{Expr: &planpb.Expr_JsonPathExpr{JsonPathExpr: expr}}, nil
+}
+
+// NewJSONPathPredicate returns the predicate of the JSONPathExpr, the Invalid operator checks the existence of the path
+func NewJSONPathPredicate(expr *planpb.JSONPathExpr) (*JSONPathPredicate, error) {
+	p := &JSONPathPredicate{Path: expr.GetPath(), Op: JSONPathExists, Not: expr.GetNot()}
+	if expr.GetOp() != planpb.OpType_Invalid {
+		op, err := compareOpName(expr.GetOp())
+		if err != nil {
+			return nil, fmt.Errorf("invalid predicate on the path of the JSON field: %w", err)
+		}
+		p.Op = op
+	}
+	switch v := expr.GetValue().GetVal().(type) {
+	case *planpb.GenericValue_FloatVal:
+		p.Value = v.FloatVal
+	case *planpb.GenericValue_Int64Val:
+		p.Value = float64(v.Int64Val)
+	case *planpb.GenericValue_StringVal:
+		p.Value = v.StringVal
+	case *planpb.GenericValue_BoolVal:
+		p.Value = v.BoolVal
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
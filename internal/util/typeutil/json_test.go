@@ -0,0 +1,114 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestIsJSONField(t *testing.T) {
+	assert.False(t, IsJSONField(&schemapb.FieldSchema{DataType: schemapb.DataType_VarChar}))
+	assert.True(t, IsJSONField(&schemapb.FieldSchema{
+		DataType:   schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.JSONFieldParam, Value: "true"}},
+	}))
+	dynamicField := &schemapb.FieldSchema{
+		DataType:   schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.DynamicFieldParam, Value: "true"}},
+	}
+	assert.True(t, IsJSONField(dynamicField))
+	assert.True(t, IsDynamicField(dynamicField))
+	helper, err := CreateSchemaHelper(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{dynamicField}})
+	assert.NoError(t, err)
+	assert.Equal(t, dynamicField, helper.GetDynamicField())
+	jsonField := &schemapb.FieldSchema{
+		DataType:   schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.JSONFieldParam, Value: "true"}},
+	}
+	assert.False(t, IsDynamicField(jsonField))
+	helper, err = CreateSchemaHelper(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{jsonField}})
+	assert.NoError(t, err)
+	assert.Nil(t, helper.GetDynamicField())
+	assert.False(t, IsJSONField(&schemapb.FieldSchema{
+		DataType:   schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.JSONFieldParam, Value: "false"}},
+	}))
+	assert.False(t, IsJSONField(&schemapb.FieldSchema{
+		DataType:   schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.JSONFieldParam, Value: "true"}},
+	}))
+}
+
+func TestUnmarshalJSONObject(t *testing.T) {
+	obj, err := UnmarshalJSONObject("")
+	assert.Nil(t, err)
+	assert.Empty(t, obj)
+
+	obj, err = UnmarshalJSONObject(`{"a": {"b": 1}}`)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"a": map[string]interface{}{"b": float64(1)}}, obj)
+
+	_, err = UnmarshalJSONObject(`[1, 2]`)
+	assert.NotNil(t, err)
+	_, err = UnmarshalJSONObject(`{"a"`)
+	assert.NotNil(t, err)
+}
+
+func TestJSONPathPredicate_Expr(t *testing.T) {
+	column := &planpb.ColumnInfo{FieldId: 100, DataType: schemapb.DataType_VarChar}
+	valids := []*JSONPathPredicate{
+		{Path: []string{"a", "b"}, Op: ">", Value: float64(1)},
+		{Path: []string{"a"}, Op: "!=", Value: "x", Not: true},
+		{Path: []string{"a"}, Op: "==", Value: true},
+		{Path: []string{"a"}, Op: JSONPathExists},
+	}
+	for _, p := range valids {
+		expr, err := p.Expr(column)
+		assert.Nil(t, err)
+		assert.Equal(t, column, expr.GetJsonPathExpr().GetColumnInfo())
+		decoded, err := NewJSONPathPredicate(expr.GetJsonPathExpr())
+		assert.Nil(t, err)
+		assert.Equal(t, p, decoded)
+	}
+
+	invalids := []*JSONPathPredicate{
+		{Op: JSONPathExists},
+		{Path: []string{"a"}, Op: JSONPathExists, Value: "x"},
+		{Path: []string{"a"}, Op: "in", Value: "x"},
+		{Path: []string{"a"}, Op: ">", Value: true},
+		{Path: []string{"a"}, Op: "==", Value: []interface{}{1}},
+		{Path: []string{"a"}, Op: "=="},
+	}
+	for _, p := range invalids {
+		_, err := p.Expr(column)
+		assert.NotNil(t, err)
+	}
+	_, err := NewJSONPathPredicate(&planpb.JSONPathExpr{})
+	assert.NotNil(t, err)
+	_, err = NewJSONPathPredicate(&planpb.JSONPathExpr{Path: []string{"a"}, Op: planpb.OpType_PrefixMatch,
+		Value: &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: "x"}}})
+	assert.NotNil(t, err)
+	_, err = NewJSONPathPredicate(&planpb.JSONPathExpr{Path: []string{"a"}, Op: planpb.OpType_Equal})
+	assert.NotNil(t, err)
+}
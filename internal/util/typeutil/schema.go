@@ -19,9 +19,13 @@ package typeutil
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"time"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"go.uber.org/zap"
 )
@@ -177,6 +181,16 @@ func (helper *SchemaHelper) GetFieldFromName(fieldName string) (*schemapb.FieldS
 	return helper.schema.Fields[offset], nil
 }
 
+// GetDynamicField returns the dynamic field of the collection, nil if none
+func (helper *SchemaHelper) GetDynamicField() *schemapb.FieldSchema {
+	for _, field := range helper.schema.GetFields() {
+		if IsDynamicField(field) {
+			return field
+		}
+	}
+	return nil
+}
+
 // GetFieldFromID returns the schema of specified field
 func (helper *SchemaHelper) GetFieldFromID(fieldID int64) (*schemapb.FieldSchema, error) {
 	offset, ok := helper.idOffset[fieldID]
@@ -530,6 +544,420 @@ func GetPrimaryFieldSchema(schema *schemapb.CollectionSchema) (*schemapb.FieldSc
 	return nil, errors.New("primary field is not found")
 }
 
+// GetCollectionTTL returns the time to live of the entities set by the property of the collection,
+// zero is returned if the collection has no ttl
+func GetCollectionTTL(schema *schemapb.CollectionSchema) (time.Duration, error) {
+	value, ok := GetCollectionProperties(schema)[common.CollectionTTLParam]
+	if !ok {
+		return 0, nil
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds <= 0 {
+		return 0, fmt.Errorf("invalid %s %s, should be a positive number of seconds", common.CollectionTTLParam, value)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// IsDynamicFieldEnabled returns whether the collection enables the hidden dynamic field by the type param of the
+// primary key field
+func IsDynamicFieldEnabled(schema *schemapb.CollectionSchema) (bool, error) {
+	for _, fieldSchema := range schema.GetFields() {
+		for _, kv := range fieldSchema.GetTypeParams() {
+			if kv.GetKey() != common.EnableDynamicFieldParam {
+				continue
+			}
+			if !fieldSchema.GetIsPrimaryKey() {
+				return false, fmt.Errorf("%s is only allowed on the primary key, field name = %s", common.EnableDynamicFieldParam, fieldSchema.GetName())
+			}
+			enabled, err := strconv.ParseBool(kv.GetValue())
+			if err != nil {
+				return false, fmt.Errorf("invalid %s %s, should be a boolean", common.EnableDynamicFieldParam, kv.GetValue())
+			}
+			return enabled, nil
+		}
+	}
+	return false, nil
+}
+
+// IsPartitionKeyField returns whether the field is the partition key
+func IsPartitionKeyField(fieldSchema *schemapb.FieldSchema) bool {
+	for _, kv := range fieldSchema.GetTypeParams() {
+		if kv.GetKey() == common.PartitionKeyParam {
+			isKey, err := strconv.ParseBool(kv.GetValue())
+			return err == nil && isKey
+		}
+	}
+	return false
+}
+
+// GetPartitionKeyFieldSchema returns the partition key field of the collection, nil if none
+func GetPartitionKeyFieldSchema(schema *schemapb.CollectionSchema) *schemapb.FieldSchema {
+	for _, fieldSchema := range schema.GetFields() {
+		if IsPartitionKeyField(fieldSchema) {
+			return fieldSchema
+		}
+	}
+	return nil
+}
+
+// GetPartitionKeyNum returns the number of the implicit partitions of the partition key field
+func GetPartitionKeyNum(fieldSchema *schemapb.FieldSchema) (int64, error) {
+	for _, kv := range fieldSchema.GetTypeParams() {
+		if kv.GetKey() != common.PartitionKeyNumParam {
+			continue
+		}
+		num, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s %s of the partition key %s", common.PartitionKeyNumParam, kv.GetValue(), fieldSchema.GetName())
+		}
+		return num, nil
+	}
+	return 0, fmt.Errorf("%s of the partition key %s not found", common.PartitionKeyNumParam, fieldSchema.GetName())
+}
+
+// GetPartitionKeyPartitionName returns the name of the implicit partition of the index, which is named after
+// the default partition
+func GetPartitionKeyPartitionName(defaultPartitionName string, index int64) string {
+	return fmt.Sprintf("%s_%d", defaultPartitionName, index)
+}
+
+// collectionPropertyKeys are the properties of the collection which could be altered after it's created
+var collectionPropertyKeys = map[string]struct{}{
+	common.CollectionTTLParam:                {},
+	common.CollectionReplicaNumberParam:      {},
+	common.CollectionMmapEnabledParam:        {},
+	common.CollectionSwapEnabledParam:        {},
+	common.CollectionLoadPriorityParam:       {},
+	common.CollectionMaxInsertBatchSizeParam: {},
+	common.CollectionMaxTopKParam:            {},
+}
+
+// IsCollectionProperty returns true if the key is a property of the collection, including the annotations
+func IsCollectionProperty(key string) bool {
+	_, ok := collectionPropertyKeys[key]
+	return ok || IsCollectionAnnotation(key)
+}
+
+// ValidateCollectionProperty returns error if the key is not a property of the collection or the value is invalid,
+// an empty value is valid since it removes the property
+func ValidateCollectionProperty(key, value string) error {
+	if !IsCollectionProperty(key) {
+		return fmt.Errorf("unknown collection property %s", key)
+	}
+	if value == "" {
+		return nil
+	}
+	if IsCollectionAnnotation(key) {
+		return validateAnnotation(common.CollectionAnnotationPrefix, key, value)
+	}
+	switch key {
+	case common.CollectionTTLParam:
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || seconds <= 0 {
+			return fmt.Errorf("invalid %s %s, should be a positive number of seconds", key, value)
+		}
+	case common.CollectionReplicaNumberParam:
+		n, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid %s %s, should be a positive integer", key, value)
+		}
+	case common.CollectionMmapEnabledParam, common.CollectionSwapEnabledParam:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("invalid %s %s, should be a boolean", key, value)
+		}
+	case common.CollectionLoadPriorityParam:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("invalid %s %s, should be an integer", key, value)
+		}
+	case common.CollectionMaxInsertBatchSizeParam, common.CollectionMaxTopKParam:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid %s %s, should be a positive integer", key, value)
+		}
+	}
+	return nil
+}
+
+// GetCollectionProperties returns the properties of the collection
+func GetCollectionProperties(schema *schemapb.CollectionSchema) map[string]string {
+	properties := make(map[string]string, len(schema.GetProperties()))
+	for _, kv := range schema.GetProperties() {
+		properties[kv.GetKey()] = kv.GetValue()
+	}
+	return properties
+}
+
+// SetCollectionProperties sets the properties to the schema in place, the property with an empty value is removed
+func SetCollectionProperties(schema *schemapb.CollectionSchema, properties map[string]string) error {
+	for key, value := range properties {
+		if err := ValidateCollectionProperty(key, value); err != nil {
+			return err
+		}
+	}
+	ret := make([]*commonpb.KeyValuePair, 0, len(schema.GetProperties())+len(properties))
+	for _, kv := range schema.GetProperties() {
+		if _, ok := properties[kv.GetKey()]; !ok {
+			ret = append(ret, kv)
+		}
+	}
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if properties[key] != "" {
+			ret = append(ret, &commonpb.KeyValuePair{Key: key, Value: properties[key]})
+		}
+	}
+	schema.Properties = ret
+	return nil
+}
+
+// MoveCollectionProperties moves the collection properties set as the type params of the primary key field to
+// the properties of the schema in place, the collection properties are not allowed on the other fields
+func MoveCollectionProperties(schema *schemapb.CollectionSchema) error {
+	properties := make(map[string]string)
+	for _, field := range schema.GetFields() {
+		typeParams := make([]*commonpb.KeyValuePair, 0, len(field.GetTypeParams()))
+		for _, kv := range field.GetTypeParams() {
+			if !IsCollectionProperty(kv.GetKey()) {
+				typeParams = append(typeParams, kv)
+				continue
+			}
+			if !field.GetIsPrimaryKey() {
+				return fmt.Errorf("%s is only allowed on the primary key, field name = %s", kv.GetKey(), field.GetName())
+			}
+			if kv.GetValue() == "" {
+				return fmt.Errorf("empty value of collection property %s", kv.GetKey())
+			}
+			properties[kv.GetKey()] = kv.GetValue()
+		}
+		field.TypeParams = typeParams
+	}
+	if len(properties) == 0 {
+		return nil
+	}
+	return SetCollectionProperties(schema, properties)
+}
+
+// GetCollectionReplicaNumber returns the number of replicas set by the property of the collection,
+// zero is returned if it's not set
+func GetCollectionReplicaNumber(schema *schemapb.CollectionSchema) (int32, error) {
+	value, ok := GetCollectionProperties(schema)[common.CollectionReplicaNumberParam]
+	if !ok {
+		return 0, nil
+	}
+	if err := ValidateCollectionProperty(common.CollectionReplicaNumberParam, value); err != nil {
+		return 0, err
+	}
+	n, _ := strconv.ParseInt(value, 10, 32)
+	return int32(n), nil
+}
+
+// GetCollectionMmapEnabled returns whether the sealed segments of the collection are memory mapped,
+// false is returned as the second value if the property is not set
+func GetCollectionMmapEnabled(schema *schemapb.CollectionSchema) (bool, bool, error) {
+	return getCollectionBoolProperty(schema, common.CollectionMmapEnabledParam)
+}
+
+// GetCollectionSwapEnabled returns whether the idle sealed segments of the collection are swapped out,
+// false is returned as the second value if the property is not set
+func GetCollectionSwapEnabled(schema *schemapb.CollectionSchema) (bool, bool, error) {
+	return getCollectionBoolProperty(schema, common.CollectionSwapEnabledParam)
+}
+
+func getCollectionBoolProperty(schema *schemapb.CollectionSchema, key string) (bool, bool, error) {
+	value, ok := GetCollectionProperties(schema)[key]
+	if !ok {
+		return false, false, nil
+	}
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, false, fmt.Errorf("invalid %s %s, should be a boolean", key, value)
+	}
+	return enabled, true, nil
+}
+
+// GetCollectionLoadPriority returns the load priority set by the property of the collection,
+// false is returned as the second value if the property is not set
+func GetCollectionLoadPriority(schema *schemapb.CollectionSchema) (int64, bool, error) {
+	value, ok := GetCollectionProperties(schema)[common.CollectionLoadPriorityParam]
+	if !ok {
+		return 0, false, nil
+	}
+	priority, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid %s %s, should be an integer", common.CollectionLoadPriorityParam, value)
+	}
+	return priority, true, nil
+}
+
+// GetCollectionMaxInsertBatchSize returns the max number of rows per insert request set by the property of the
+// collection, zero is returned if the property is not set
+func GetCollectionMaxInsertBatchSize(schema *schemapb.CollectionSchema) (int64, error) {
+	return getCollectionPositiveIntProperty(schema, common.CollectionMaxInsertBatchSizeParam)
+}
+
+// GetCollectionMaxTopK returns the max topk of the searches set by the property of the collection,
+// zero is returned if the property is not set
+func GetCollectionMaxTopK(schema *schemapb.CollectionSchema) (int64, error) {
+	return getCollectionPositiveIntProperty(schema, common.CollectionMaxTopKParam)
+}
+
+func getCollectionPositiveIntProperty(schema *schemapb.CollectionSchema, key string) (int64, error) {
+	value, ok := GetCollectionProperties(schema)[key]
+	if !ok {
+		return 0, nil
+	}
+	if err := ValidateCollectionProperty(key, value); err != nil {
+		return 0, err
+	}
+	n, _ := strconv.ParseInt(value, 10, 64)
+	return n, nil
+}
+
+// GetDefaultValue returns the default value set by the type param of the scalar field, false is returned if
+// the field has no default value
+func GetDefaultValue(fieldSchema *schemapb.FieldSchema) (interface{}, bool, error) {
+	for _, kv := range fieldSchema.GetTypeParams() {
+		if kv.GetKey() != common.DefaultValueParam {
+			continue
+		}
+		value, err := parseDefaultValue(fieldSchema.GetDataType(), kv.GetValue())
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid %s %s of field %s: %w", common.DefaultValueParam, kv.GetValue(), fieldSchema.GetName(), err)
+		}
+		return value, true, nil
+	}
+	return nil, false, nil
+}
+
+func parseDefaultValue(dataType schemapb.DataType, value string) (interface{}, error) {
+	switch dataType {
+	case schemapb.DataType_Bool:
+		return strconv.ParseBool(value)
+	case schemapb.DataType_Int8:
+		v, err := strconv.ParseInt(value, 10, 8)
+		return int8(v), err
+	case schemapb.DataType_Int16:
+		v, err := strconv.ParseInt(value, 10, 16)
+		return int16(v), err
+	case schemapb.DataType_Int32:
+		v, err := strconv.ParseInt(value, 10, 32)
+		return int32(v), err
+	case schemapb.DataType_Int64:
+		return strconv.ParseInt(value, 10, 64)
+	case schemapb.DataType_Float:
+		v, err := strconv.ParseFloat(value, 32)
+		return float32(v), err
+	case schemapb.DataType_Double:
+		return strconv.ParseFloat(value, 64)
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return value, nil
+	default:
+		return nil, fmt.Errorf("default value is not supported by data type %s", dataType.String())
+	}
+}
+
+// GenDefaultFieldData returns the field data of numRows rows filled with the default value of the field
+func GenDefaultFieldData(fieldSchema *schemapb.FieldSchema, numRows int) (*schemapb.FieldData, error) {
+	value, ok, err := GetDefaultValue(fieldSchema)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("field %s has no default value", fieldSchema.GetName())
+	}
+	scalars := &schemapb.ScalarField{}
+	switch v := value.(type) {
+	case bool:
+		data := make([]bool, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+	case int8, int16, int32:
+		var n int32
+		switch i := v.(type) {
+		case int8:
+			n = int32(i)
+		case int16:
+			n = int32(i)
+		case int32:
+			n = i
+		}
+		data := make([]int32, numRows)
+		for i := range data {
+			data[i] = n
+		}
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+	case int64:
+		data := make([]int64, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+	case float32:
+		data := make([]float32, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}
+	case float64:
+		data := make([]float64, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}
+	case string:
+		data := make([]string, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}
+	}
+	return &schemapb.FieldData{
+		Type:      fieldSchema.GetDataType(),
+		FieldName: fieldSchema.GetName(),
+		FieldId:   fieldSchema.GetFieldID(),
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+	}, nil
+}
+
+// FillDefaultFieldsData appends the default field data of the fields with default values absent from fieldsData,
+// the fields are matched by ID, or by name if the ID is not set
+func FillDefaultFieldsData(fieldsData []*schemapb.FieldData, schema *schemapb.CollectionSchema, numRows int) ([]*schemapb.FieldData, error) {
+	// limit the capacity so that appending never writes into the array of the caller
+	fieldsData = fieldsData[:len(fieldsData):len(fieldsData)]
+	for _, fieldSchema := range schema.GetFields() {
+		found := false
+		for _, fieldData := range fieldsData {
+			if fieldData.GetFieldId() == fieldSchema.GetFieldID() ||
+				(fieldData.GetFieldId() == 0 && fieldData.GetFieldName() == fieldSchema.GetName()) {
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+		_, ok, err := GetDefaultValue(fieldSchema)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		fieldData, err := GenDefaultFieldData(fieldSchema, numRows)
+		if err != nil {
+			return nil, err
+		}
+		fieldsData = append(fieldsData, fieldData)
+	}
+	return fieldsData, nil
+}
+
 // GetPrimaryFieldData get primary field data from all field data inserted from sdk
 func GetPrimaryFieldData(datas []*schemapb.FieldData, primaryFieldSchema *schemapb.FieldSchema) (*schemapb.FieldData, error) {
 	primaryFieldID := primaryFieldSchema.FieldID
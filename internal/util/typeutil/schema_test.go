@@ -19,6 +19,7 @@ package typeutil
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -540,6 +541,275 @@ func TestGetPrimaryFieldSchema(t *testing.T) {
 	assert.Equal(t, schemapb.DataType_Int64, primaryField.DataType)
 }
 
+func TestGetCollectionTTL(t *testing.T) {
+	pkField := &schemapb.FieldSchema{
+		FieldID:      100,
+		Name:         "pk",
+		IsPrimaryKey: true,
+		DataType:     schemapb.DataType_Int64,
+	}
+	floatField := &schemapb.FieldSchema{
+		FieldID:  101,
+		Name:     "floatField",
+		DataType: schemapb.DataType_Float,
+	}
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{pkField, floatField},
+	}
+
+	ttl, err := GetCollectionTTL(schema)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), ttl)
+
+	schema.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionTTLParam, Value: "3600"}}
+	ttl, err = GetCollectionTTL(schema)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, ttl)
+
+	for _, value := range []string{"0", "-1", "1h"} {
+		schema.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionTTLParam, Value: value}}
+		_, err = GetCollectionTTL(schema)
+		assert.Error(t, err)
+	}
+}
+
+func TestIsDynamicFieldEnabled(t *testing.T) {
+	pkField := &schemapb.FieldSchema{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64}
+	floatField := &schemapb.FieldSchema{FieldID: 101, Name: "floatField", DataType: schemapb.DataType_Float}
+	schema := &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{pkField, floatField}}
+
+	enabled, err := IsDynamicFieldEnabled(schema)
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	pkField.TypeParams = []*commonpb.KeyValuePair{{Key: common.EnableDynamicFieldParam, Value: "true"}}
+	enabled, err = IsDynamicFieldEnabled(schema)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	pkField.TypeParams = []*commonpb.KeyValuePair{{Key: common.EnableDynamicFieldParam, Value: "yes"}}
+	_, err = IsDynamicFieldEnabled(schema)
+	assert.Error(t, err)
+
+	pkField.TypeParams = nil
+	floatField.TypeParams = []*commonpb.KeyValuePair{{Key: common.EnableDynamicFieldParam, Value: "true"}}
+	_, err = IsDynamicFieldEnabled(schema)
+	assert.Error(t, err)
+}
+
+func TestCollectionProperties(t *testing.T) {
+	pkField := &schemapb.FieldSchema{
+		FieldID:      100,
+		Name:         "pk",
+		IsPrimaryKey: true,
+		DataType:     schemapb.DataType_Int64,
+		TypeParams:   []*commonpb.KeyValuePair{{Key: common.BloomFilterFPRParam, Value: "0.01"}},
+	}
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{pkField, {FieldID: 101, Name: "floatField", DataType: schemapb.DataType_Float}},
+	}
+	assert.Empty(t, GetCollectionProperties(schema))
+	replicaNumber, err := GetCollectionReplicaNumber(schema)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), replicaNumber)
+	_, set, err := GetCollectionMmapEnabled(schema)
+	assert.NoError(t, err)
+	assert.False(t, set)
+	_, set, err = GetCollectionSwapEnabled(schema)
+	assert.NoError(t, err)
+	assert.False(t, set)
+	_, set, err = GetCollectionLoadPriority(schema)
+	assert.NoError(t, err)
+	assert.False(t, set)
+	maxInsertBatchSize, err := GetCollectionMaxInsertBatchSize(schema)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), maxInsertBatchSize)
+
+	err = SetCollectionProperties(schema, map[string]string{
+		common.CollectionTTLParam:                "3600",
+		common.CollectionReplicaNumberParam:      "2",
+		common.CollectionMmapEnabledParam:        "true",
+		common.CollectionSwapEnabledParam:        "false",
+		common.CollectionLoadPriorityParam:       "-1",
+		common.CollectionMaxInsertBatchSizeParam: "1000",
+		common.CollectionMaxTopKParam:            "100",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, GetCollectionProperties(schema), 7)
+	assert.Len(t, schema.GetProperties(), 7)
+	assert.Len(t, pkField.GetTypeParams(), 1)
+	ttl, err := GetCollectionTTL(schema)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, ttl)
+	replicaNumber, err = GetCollectionReplicaNumber(schema)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), replicaNumber)
+	enabled, set, err := GetCollectionMmapEnabled(schema)
+	assert.NoError(t, err)
+	assert.True(t, set)
+	assert.True(t, enabled)
+	enabled, set, err = GetCollectionSwapEnabled(schema)
+	assert.NoError(t, err)
+	assert.True(t, set)
+	assert.False(t, enabled)
+	priority, set, err := GetCollectionLoadPriority(schema)
+	assert.NoError(t, err)
+	assert.True(t, set)
+	assert.Equal(t, int64(-1), priority)
+	maxInsertBatchSize, err = GetCollectionMaxInsertBatchSize(schema)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), maxInsertBatchSize)
+	maxTopK, err := GetCollectionMaxTopK(schema)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), maxTopK)
+
+	// empty value removes the property
+	err = SetCollectionProperties(schema, map[string]string{
+		common.CollectionTTLParam:                "",
+		common.CollectionMmapEnabledParam:        "false",
+		common.CollectionSwapEnabledParam:        "",
+		common.CollectionMaxInsertBatchSizeParam: "",
+		common.CollectionMaxTopKParam:            "",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		common.CollectionReplicaNumberParam: "2",
+		common.CollectionMmapEnabledParam:   "false",
+		common.CollectionLoadPriorityParam:  "-1",
+	}, GetCollectionProperties(schema))
+	assert.Equal(t, common.BloomFilterFPRParam, pkField.GetTypeParams()[0].GetKey())
+
+	invalids := []map[string]string{
+		{common.BloomFilterFPRParam: "0.1"},
+		{common.CollectionTTLParam: "1h"},
+		{common.CollectionReplicaNumberParam: "0"},
+		{common.CollectionMmapEnabledParam: "yes"},
+		{common.CollectionSwapEnabledParam: "no"},
+		{common.CollectionLoadPriorityParam: "high"},
+		{common.CollectionMaxInsertBatchSizeParam: "0"},
+		{common.CollectionMaxTopKParam: "-1"},
+	}
+	for _, properties := range invalids {
+		assert.Error(t, SetCollectionProperties(schema, properties))
+	}
+	assert.Len(t, GetCollectionProperties(schema), 3)
+}
+
+func TestPartitionKey(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.PartitionKeyParam, Value: "false"}}},
+			{FieldID: 101, Name: "key", DataType: schemapb.DataType_Int64,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.PartitionKeyParam, Value: "true"}}},
+		},
+	}
+	assert.False(t, IsPartitionKeyField(schema.Fields[0]))
+	keyField := GetPartitionKeyFieldSchema(schema)
+	assert.Equal(t, schema.Fields[1], keyField)
+	assert.Nil(t, GetPartitionKeyFieldSchema(&schemapb.CollectionSchema{Fields: schema.Fields[:1]}))
+
+	_, err := GetPartitionKeyNum(keyField)
+	assert.Error(t, err)
+	keyField.TypeParams = append(keyField.TypeParams, &commonpb.KeyValuePair{Key: common.PartitionKeyNumParam, Value: "a"})
+	_, err = GetPartitionKeyNum(keyField)
+	assert.Error(t, err)
+	keyField.TypeParams[1].Value = "4"
+	num, err := GetPartitionKeyNum(keyField)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), num)
+
+	assert.Equal(t, "_default_3", GetPartitionKeyPartitionName("_default", 3))
+}
+
+func TestMoveCollectionProperties(t *testing.T) {
+	pkField := &schemapb.FieldSchema{
+		FieldID:      100,
+		Name:         "pk",
+		IsPrimaryKey: true,
+		DataType:     schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: common.BloomFilterFPRParam, Value: "0.01"},
+			{Key: common.CollectionTTLParam, Value: "3600"},
+		},
+	}
+	floatField := &schemapb.FieldSchema{FieldID: 101, Name: "floatField", DataType: schemapb.DataType_Float}
+	schema := &schemapb.CollectionSchema{
+		Fields:     []*schemapb.FieldSchema{pkField, floatField},
+		Properties: []*commonpb.KeyValuePair{{Key: common.CollectionReplicaNumberParam, Value: "2"}},
+	}
+	assert.NoError(t, MoveCollectionProperties(schema))
+	assert.Equal(t, []*commonpb.KeyValuePair{{Key: common.BloomFilterFPRParam, Value: "0.01"}}, pkField.GetTypeParams())
+	assert.Equal(t, map[string]string{
+		common.CollectionTTLParam:           "3600",
+		common.CollectionReplicaNumberParam: "2",
+	}, GetCollectionProperties(schema))
+	ttl, err := GetCollectionTTL(schema)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, ttl)
+
+	pkField.TypeParams = []*commonpb.KeyValuePair{{Key: common.CollectionTTLParam, Value: "1h"}}
+	assert.Error(t, MoveCollectionProperties(schema))
+
+	pkField.TypeParams = []*commonpb.KeyValuePair{{Key: common.CollectionTTLParam, Value: ""}}
+	assert.Error(t, MoveCollectionProperties(schema))
+
+	pkField.TypeParams = nil
+	floatField.TypeParams = []*commonpb.KeyValuePair{{Key: common.CollectionTTLParam, Value: "3600"}}
+	assert.Error(t, MoveCollectionProperties(schema))
+}
+
+func TestGetDefaultValue(t *testing.T) {
+	field := &schemapb.FieldSchema{FieldID: 101, Name: "field", DataType: schemapb.DataType_Int16}
+	_, ok, err := GetDefaultValue(field)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: common.DefaultValueParam, Value: "12"}}
+	value, ok, err := GetDefaultValue(field)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int16(12), value)
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: common.DefaultValueParam, Value: "100000"}}
+	_, _, err = GetDefaultValue(field)
+	assert.Error(t, err)
+
+	field.DataType = schemapb.DataType_FloatVector
+	_, _, err = GetDefaultValue(field)
+	assert.Error(t, err)
+}
+
+func TestFillDefaultFieldsData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "bool", DataType: schemapb.DataType_Bool,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueParam, Value: "true"}}},
+			{FieldID: 102, Name: "int8", DataType: schemapb.DataType_Int8,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueParam, Value: "-1"}}},
+			{FieldID: 103, Name: "double", DataType: schemapb.DataType_Double,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueParam, Value: "1.5"}}},
+			{FieldID: 104, Name: "varchar", DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueParam, Value: "abc"}}},
+		},
+	}
+	fieldsData := []*schemapb.FieldData{
+		genFieldData("pk", 100, schemapb.DataType_Int64, []int64{1, 2}, 1),
+		genFieldData("bool", 101, schemapb.DataType_Bool, []bool{false, false}, 1),
+	}
+
+	filled, err := FillDefaultFieldsData(fieldsData, schema, 2)
+	assert.NoError(t, err)
+	assert.Len(t, filled, 5)
+	assert.Equal(t, []bool{false, false}, filled[1].GetScalars().GetBoolData().GetData())
+	assert.Equal(t, int64(102), filled[2].GetFieldId())
+	assert.Equal(t, schemapb.DataType_Int8, filled[2].GetType())
+	assert.Equal(t, []int32{-1, -1}, filled[2].GetScalars().GetIntData().GetData())
+	assert.Equal(t, []float64{1.5, 1.5}, filled[3].GetScalars().GetDoubleData().GetData())
+	assert.Equal(t, []string{"abc", "abc"}, filled[4].GetScalars().GetStringData().GetData())
+}
+
 func TestGetPK(t *testing.T) {
 	type args struct {
 		data *schemapb.IDs